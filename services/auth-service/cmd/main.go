@@ -3,17 +3,26 @@ package main
 import (
 	"context"
 	"log"
-	"net"
+	"net/http"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/encryption"
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/events"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/geoip"
 	grpc_handler "github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/httpapi"
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/repository"
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/grpcserver"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	authProto "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
 	protoUser "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
@@ -24,6 +33,12 @@ import (
 func main() {
 	cfg := config.NewConfig()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("auth-service"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -45,7 +60,23 @@ func main() {
 		log.Fatalf("Failed to ping redis: %v", err)
 	}
 
-	authRepo := repository.NewAuthRepository(postgresClient, redisClient)
+	contextCipher, err := encryption.NewContextCipher(cfg.SessionContextKey)
+	if err != nil {
+		log.Fatalf("Failed to create session context cipher: %v", err)
+	}
+
+	authRepo := repository.NewAuthRepository(postgresClient, redisClient, contextCipher)
+
+	// Seal any collection contexts stored in plaintext before encryption was enabled
+	go func() {
+		migrated, err := authRepo.(*repository.Repository).EncryptLegacyCollectionContexts(ctx, 500)
+		if err != nil {
+			log.Printf("Failed to encrypt legacy collection contexts: %v", err)
+		}
+		if migrated > 0 {
+			log.Printf("Encrypted %d legacy collection contexts", migrated)
+		}
+	}()
 
 	dialOptions := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -85,19 +116,100 @@ func main() {
 		[]byte(cfg.RefreshKey),
 		cfg.Features.EnableCollectionContext,
 	)
+	authService.(*service.Service).SetTokenIdentity(cfg.JWTIssuer, cfg.JWTAudience, cfg.JWTAcceptedIssuers)
+	authService.(*service.Service).SetImpersonationPolicy(cfg.AdminUserIDs, time.Duration(cfg.ImpersonationTTLMinutes)*time.Minute)
+	authService.(*service.Service).SetSubscriptionTickets(redisClient)
+	switch cfg.NonceStore {
+	case "redis":
+		authService.(*service.Service).SetNonceStore(repository.NewRedisNonceStore(redisClient))
+	case "postgres":
+	default:
+		log.Fatalf("Invalid nonce store %q: must be redis or postgres", cfg.NonceStore)
+	}
+	if err := authService.(*service.Service).SetSessionLimit(cfg.MaxConcurrentSessions, domain.SessionLimitPolicy(cfg.SessionLimitPolicy)); err != nil {
+		log.Fatalf("Invalid session limit: %v", err)
+	}
+	var geoLocator domain.GeoLocator
+	if cfg.GeoIP.LicenseKey != "" {
+		geoLocator = geoip.NewMaxMindLocator(cfg.GeoIP.URL, cfg.GeoIP.AccountID, cfg.GeoIP.LicenseKey, domain.GeoPrivacyMode(cfg.GeoIP.PrivacyMode))
+	}
+	if err := authService.(*service.Service).SetGeoLocation(geoLocator, domain.GeoPrivacyMode(cfg.GeoIP.PrivacyMode)); err != nil {
+		log.Fatalf("Invalid geo-IP config: %v", err)
+	}
 
-	server := grpc.NewServer()
+	authService.(*service.Service).SetContractWalletLogins(cfg.ContractWalletLogins)
+
+	if cfg.APIKeys.Enabled {
+		plans := []domain.APIPlanLimits{
+			apiPlanLimits(domain.APIPlanFree, cfg.APIKeys.Free),
+			apiPlanLimits(domain.APIPlanPro, cfg.APIKeys.Pro),
+		}
+		counter := repository.NewRedisAPIUsageCounter(redisClient)
+		if err := authService.(*service.Service).SetAPIKeys(repository.NewAPIKeyRepository(postgresClient), counter, plans); err != nil {
+			log.Fatalf("Invalid API key plans: %v", err)
+		}
+		if cfg.APIKeys.UsageFlushMinutes <= 0 {
+			log.Fatalf("Invalid API_USAGE_FLUSH_MINUTES: %d", cfg.APIKeys.UsageFlushMinutes)
+		}
+		// Persist hourly API key usage from the Redis counters
+		go authService.(*service.Service).RunAPIUsageFlusher(ctx, time.Duration(cfg.APIKeys.UsageFlushMinutes)*time.Minute)
+	}
 
-	handler := grpc_handler.NewgRPCHandler(server, authService)
-	authProto.RegisterAuthServiceServer(server, handler)
+	// Health reports wallet-service under its own name, so probes can tell a login that
+	// links its wallet later from one that is down
+	healthServer := health.NewServer()
+	if cfg.DeferWalletLinks {
+		walletHealth := func(up bool) {
+			status := healthpb.HealthCheckResponse_SERVING
+			if !up {
+				status = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+			healthServer.SetServingStatus(protoWallet.WalletService_ServiceDesc.ServiceName, status)
+		}
+		walletHealth(true)
+		outbox := repository.NewWalletLinkOutbox(postgresClient)
+		retry := time.Duration(cfg.WalletLinkRetrySeconds) * time.Second
+		if err := authService.(*service.Service).SetWalletLinkOutbox(outbox, retry, walletHealth); err != nil {
+			log.Fatalf("Invalid wallet link outbox: %v", err)
+		}
+		// Retry wallet links queued while wallet-service was unavailable
+		go authService.(*service.Service).RunWalletLinkReconciler(ctx)
+	}
 
-	lis, err := net.Listen("tcp", cfg.GRPCConfig.Port)
-	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+	// Expose deferred wallet link metrics for scraping
+	if cfg.HTTPPort != "" {
+		httpServer := &http.Server{
+			Addr:              cfg.HTTPPort,
+			Handler:           httpapi.NewHandler(authService.(*service.Service)),
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+		go func() {
+			log.Printf("Metrics endpoint listening on %s", cfg.HTTPPort)
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Printf("Metrics endpoint stopped: %v", err)
+			}
+		}()
+		defer httpServer.Close()
 	}
 
+	serverConfig := grpcserver.LoadConfig("auth-service")
+	serverConfig.Health = healthServer
+	server := grpcserver.New(serverConfig)
+
+	handler := grpc_handler.NewgRPCHandler(server, authService)
+	authProto.RegisterAuthServiceServer(server, handler)
+
 	log.Printf("Auth service listening on %s", cfg.GRPCConfig.Port)
-	if err := server.Serve(lis); err != nil {
+	if err := grpcserver.ListenAndServe(server, cfg.GRPCConfig.Port); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
+
+func apiPlanLimits(plan domain.APIPlan, cfg config.APIPlanConfig) domain.APIPlanLimits {
+	return domain.APIPlanLimits{
+		Plan:           plan,
+		DailyQuota:     int64(cfg.DailyQuota),
+		BurstPerSecond: int64(cfg.BurstPerSecond),
+		Enforcement:    domain.QuotaEnforcement(cfg.Enforcement),
+	}
+}
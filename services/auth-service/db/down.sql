@@ -6,11 +6,21 @@ DROP FUNCTION IF EXISTS cleanup_expired_sessions();
 DROP FUNCTION IF EXISTS cleanup_old_login_events(integer);
 DROP FUNCTION IF EXISTS cleanup_expired_nonces();
 
+DROP INDEX IF EXISTS idx_sessions_impersonator;
+ALTER TABLE IF EXISTS sessions DROP CONSTRAINT IF EXISTS chk_session_impersonation;
+ALTER TABLE IF EXISTS sessions DROP COLUMN IF EXISTS impersonation_reason;
+ALTER TABLE IF EXISTS sessions DROP COLUMN IF EXISTS impersonator_id;
+ALTER TABLE IF EXISTS sessions DROP COLUMN IF EXISTS issuer;
+
 -- Remove index/column added for collection context support
+DROP INDEX IF EXISTS idx_sessions_collection_context_enc;
 DROP INDEX IF EXISTS idx_sessions_collection_context;
+ALTER TABLE IF EXISTS sessions DROP COLUMN IF EXISTS collection_intent_context_enc;
 ALTER TABLE IF EXISTS sessions DROP COLUMN IF EXISTS collection_intent_context;
 
 -- Xoá bảng (indexes/constraints sẽ đi kèm)
+DROP TABLE IF EXISTS api_key_usage;
+DROP TABLE IF EXISTS api_keys;
 DROP TABLE IF EXISTS login_events;
 DROP TABLE IF EXISTS sessions;
 DROP TABLE IF EXISTS auth_nonces;
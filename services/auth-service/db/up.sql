@@ -86,6 +86,94 @@ CREATE INDEX IF NOT EXISTS idx_sessions_collection_context
   ON sessions(user_id)
   WHERE collection_intent_context IS NOT NULL;
 
+-- Context mới được mã hoá AES-GCM ở tầng ứng dụng; cột JSONB cũ chỉ còn chờ migration dọn
+ALTER TABLE sessions
+  ADD COLUMN IF NOT EXISTS collection_intent_context_enc BYTEA DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_sessions_collection_context_enc
+  ON sessions(user_id)
+  WHERE collection_intent_context_enc IS NOT NULL;
+
+-- Issuer of the environment that created the session; NULL for sessions created before it was recorded
+ALTER TABLE sessions
+  ADD COLUMN IF NOT EXISTS issuer TEXT DEFAULT NULL;
+
+-- Admin impersonation: the admin acting as user_id and why; NULL for the user's own sessions
+ALTER TABLE sessions
+  ADD COLUMN IF NOT EXISTS impersonator_id uuid DEFAULT NULL,
+  ADD COLUMN IF NOT EXISTS impersonation_reason TEXT DEFAULT NULL;
+
+ALTER TABLE sessions
+  DROP CONSTRAINT IF EXISTS chk_session_impersonation,
+  ADD  CONSTRAINT chk_session_impersonation
+  CHECK (impersonator_id IS NULL OR (impersonation_reason IS NOT NULL AND impersonator_id <> user_id));
+
+CREATE INDEX IF NOT EXISTS idx_sessions_impersonator
+  ON sessions(impersonator_id)
+  WHERE impersonator_id IS NOT NULL;
+
+-- Geo-IP location of the login IP; geo_city stays NULL in country-only privacy mode
+ALTER TABLE sessions
+  ADD COLUMN IF NOT EXISTS geo_country varchar(2) DEFAULT NULL,
+  ADD COLUMN IF NOT EXISTS geo_city TEXT DEFAULT NULL;
+
+-- New-location detection reads the distinct locations of a user's own sessions
+CREATE INDEX IF NOT EXISTS idx_sessions_user_location
+  ON sessions(user_id, geo_country, geo_city)
+  WHERE geo_country IS NOT NULL AND impersonator_id IS NULL;
+
+-- Set while the login's wallet link waits in wallet_link_outbox for wallet-service
+ALTER TABLE sessions
+  ADD COLUMN IF NOT EXISTS wallet_link_pending BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- ======================= WALLET LINK OUTBOX =======================
+-- Wallet links logins could not make while wallet-service was unavailable; the reconciler
+-- retries them with backoff and clears the sessions' wallet_link_pending once they land
+CREATE TABLE IF NOT EXISTS wallet_link_outbox (
+    id              uuid         PRIMARY KEY,
+    user_id         uuid         NOT NULL,
+    account_id      varchar(42)  NOT NULL,
+    address         varchar(42)  NOT NULL,
+    chain_id        varchar(32)  NOT NULL,
+    session_id      uuid         NOT NULL,
+    attempts        integer      NOT NULL DEFAULT 0,
+    last_error      text,
+    next_attempt_at timestamptz  NOT NULL DEFAULT now(),
+    created_at      timestamptz  NOT NULL DEFAULT now()
+);
+
+-- A user logging in again during an outage queues the same link once
+CREATE UNIQUE INDEX IF NOT EXISTS uq_wallet_link_outbox_link
+  ON wallet_link_outbox(user_id, address, chain_id);
+CREATE INDEX IF NOT EXISTS idx_wallet_link_outbox_due
+  ON wallet_link_outbox(next_attempt_at);
+
+-- ======================= API KEYS =======================
+-- Developer keys acting as their user; only the SHA-256 of the secret is kept
+CREATE TABLE IF NOT EXISTS api_keys (
+    id           uuid         PRIMARY KEY,
+    user_id      uuid         NOT NULL,
+    name         varchar(64)  NOT NULL,
+    prefix       varchar(16)  NOT NULL,
+    key_hash     char(64)     NOT NULL UNIQUE,
+    plan         varchar(16)  NOT NULL DEFAULT 'free',
+    created_at   timestamptz  NOT NULL DEFAULT now(),
+    last_used_at timestamptz,
+    revoked_at   timestamptz,
+    CONSTRAINT chk_api_key_plan CHECK (plan IN ('free','pro'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user ON api_keys(user_id, created_at DESC);
+
+-- Requests per key and hour, persisted from the Redis counters once the hour is over
+CREATE TABLE IF NOT EXISTS api_key_usage (
+    key_id    uuid         NOT NULL REFERENCES api_keys(id) ON DELETE CASCADE,
+    hour      timestamptz  NOT NULL,
+    requests  bigint       NOT NULL DEFAULT 0,
+    rejected  bigint       NOT NULL DEFAULT 0,
+    PRIMARY KEY (key_id, hour)
+);
+
 -- ======================= AUDIT LOGGING =======================
 CREATE TABLE IF NOT EXISTS login_events (
     id           uuid         PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -206,7 +294,10 @@ COMMENT ON TABLE  sessions IS 'User sessions after successful SIWE verification'
 COMMENT ON COLUMN sessions.user_id       IS 'References users table in user service';
 COMMENT ON COLUMN sessions.refresh_hash  IS 'HMAC/SHA-256 hash of refresh token';
 COMMENT ON COLUMN sessions.device_id     IS 'Optional device fingerprint for multi-device tracking';
-COMMENT ON COLUMN sessions.collection_intent_context IS 'Optional JSONB storing collection creation context for auth-to-collection flow';
+COMMENT ON COLUMN sessions.collection_intent_context IS 'Legacy plaintext collection context; emptied by the startup encryption migration';
+COMMENT ON COLUMN sessions.geo_country   IS 'ISO 3166-1 alpha-2 country of the login IP';
+COMMENT ON COLUMN sessions.geo_city      IS 'City of the login IP; NULL in country-only privacy mode';
+COMMENT ON COLUMN sessions.collection_intent_context_enc IS 'Collection creation context sealed with AES-256-GCM (version || nonce || ciphertext), bound to session_id';
 
 COMMENT ON TABLE  login_events IS 'Audit log of all authentication attempts';
 COMMENT ON COLUMN login_events.result    IS 'Authentication result enum';
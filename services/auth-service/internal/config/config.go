@@ -7,6 +7,7 @@ import (
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 // GRPCConfig holds gRPC server configuration
@@ -16,33 +17,73 @@ type GRPCConfig struct {
 
 // Config contains configuration for Auth Service
 type Config struct {
-	GRPCConfig       GRPCConfig
-	JWTKey           string
-	RefreshKey       string
+	GRPCConfig  GRPCConfig
+	JWTKey      string
+	JWTIssuer   string
+	JWTAudience string
+	// JWTAcceptedIssuers also admits sessions from issuers being migrated away from
+	JWTAcceptedIssuers []string
+	RefreshKey         string
+	SessionContextKey  string
+	// AdminUserIDs may impersonate other users, the same list the gateway checks admins against
+	AdminUserIDs            []string
+	ImpersonationTTLMinutes int
+	// MaxConcurrentSessions caps a user's active sessions; 0 leaves them unlimited
+	MaxConcurrentSessions int
+	// SessionLimitPolicy is "evict_lru" or "reject"
+	SessionLimitPolicy string
+	// NonceStore is "redis", or "postgres" to keep SIWE nonces in the database
+	NonceStore       string
+	GeoIP            GeoIPConfig
 	UserServiceURL   string
 	WalletServiceURL string
 	PostgresConfig   postgres.PostgresConfig
 	RedisConfig      redis.RedisConfig
 	RabbitMQ         messaging.RabbitMQConfig
 	Features         Features
+
+	// DeferWalletLinks lets logins through while wallet-service is down, queueing their link
+	DeferWalletLinks       bool
+	WalletLinkRetrySeconds int
+	HTTPPort               string // metrics endpoint; empty disables it
+	// ContractWalletLogins checks SIWE signatures of contract wallets with EIP-1271
+	ContractWalletLogins bool
+	APIKeys              APIKeysConfig
 }
 
 // NewConfig creates and loads configuration from environment variables
 func NewConfig() *Config {
 	log.Println("Loading Auth Service configuration...")
 
+	issuer := env.GetString("JWT_ISSUER", "nft-marketplace-auth")
+
 	config := &Config{
 		GRPCConfig: GRPCConfig{
 			Port: env.GetString("AUTH_GRPC_PORT", ":50051"),
 		},
-		JWTKey:           env.GetString("JWT_SECRET", "default-jwt-secret-for-development"),
-		RefreshKey:       env.GetString("REFRESH_SECRET", "default-refresh-secret-for-development"),
-		UserServiceURL:   env.GetString("USER_SERVICE_URL", "user-service:50052"),
-		WalletServiceURL: env.GetString("WALLET_SERVICE_URL", "wallet-service:50053"),
-		PostgresConfig:   loadPostgresConfig(),
-		RedisConfig:      loadRedisConfig(),
-		RabbitMQ:         loadRabbitMQConfig(),
-		Features:         loadFeatures(),
+		JWTKey:                  env.GetString("JWT_SECRET", "default-jwt-secret-for-development"),
+		JWTIssuer:               issuer,
+		JWTAudience:             env.GetString("JWT_AUDIENCE", "nft-marketplace-api"),
+		JWTAcceptedIssuers:      env.GetStringList("JWT_ACCEPTED_ISSUERS", []string{issuer}),
+		RefreshKey:              env.GetString("REFRESH_SECRET", "default-refresh-secret-for-development"),
+		SessionContextKey:       env.GetString("SESSION_CONTEXT_SECRET", "default-session-context-secret-for-development"),
+		AdminUserIDs:            env.GetStringList("ADMIN_USER_IDS", nil),
+		ImpersonationTTLMinutes: env.GetInt("IMPERSONATION_TTL_MINUTES", 15),
+		MaxConcurrentSessions:   env.GetInt("MAX_CONCURRENT_SESSIONS", 0),
+		SessionLimitPolicy:      env.GetString("SESSION_LIMIT_POLICY", "evict_lru"),
+		NonceStore:              env.GetString("NONCE_STORE", "redis"),
+		GeoIP:                   loadGeoIPConfig(),
+		UserServiceURL:          env.GetString("USER_SERVICE_URL", "user-service:50052"),
+		WalletServiceURL:        env.GetString("WALLET_SERVICE_URL", "wallet-service:50053"),
+		PostgresConfig:          loadPostgresConfig(),
+		RedisConfig:             loadRedisConfig(),
+		RabbitMQ:                loadRabbitMQConfig(),
+		Features:                loadFeatures(),
+		DeferWalletLinks:        env.GetBool("DEFER_WALLET_LINKS", true),
+		WalletLinkRetrySeconds:  env.GetInt("WALLET_LINK_RETRY_SECONDS", 15),
+		HTTPPort:                env.GetString("AUTH_HTTP_PORT", ":8089"),
+		ContractWalletLogins:    env.GetBool("CONTRACT_WALLET_LOGINS", true),
+		APIKeys:                 loadAPIKeysConfig(),
 	}
 
 	return config
@@ -61,6 +102,7 @@ func loadPostgresConfig() postgres.PostgresConfig {
 		PostgresUser:     env.GetString("POSTGRES_USER", "postgres"),
 		PostgresPassword: env.GetString("POSTGRES_PASSWORD", "postgres"),
 		PostgresDatabase: env.GetString("POSTGRES_DATABASE", "nft_marketplace"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
 
@@ -69,6 +111,7 @@ func loadRedisConfig() redis.RedisConfig {
 	return redis.RedisConfig{
 		RedisHost: env.GetString("REDIS_HOST", "localhost"),
 		RedisPort: env.GetInt("REDIS_PORT", 6379),
+		Tenant:    tenant.FromEnv(),
 	}
 }
 
@@ -79,6 +122,62 @@ func loadRabbitMQConfig() messaging.RabbitMQConfig {
 		RabbitMQPort:     env.GetInt("RABBITMQ_PORT", 5671),
 		RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
 		RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
+		Tenant:           tenant.FromEnv(),
+	}
+}
+
+// GeoIPConfig holds the MaxMind web service used to locate login IPs
+type GeoIPConfig struct {
+	URL        string
+	AccountID  string
+	LicenseKey string // lookups are disabled while empty
+	// PrivacyMode is "city", or "country" to keep only the country and drop session IPs
+	PrivacyMode string
+}
+
+// loadGeoIPConfig loads geo-IP configuration
+func loadGeoIPConfig() GeoIPConfig {
+	return GeoIPConfig{
+		URL:         env.GetString("GEOIP_URL", "https://geolite.info"),
+		AccountID:   env.GetString("GEOIP_ACCOUNT_ID", ""),
+		LicenseKey:  env.GetString("GEOIP_LICENSE_KEY", ""),
+		PrivacyMode: env.GetString("GEOIP_PRIVACY_MODE", "city"),
+	}
+}
+
+// APIKeysConfig holds developer API keys and the limits of their plans
+type APIKeysConfig struct {
+	Enabled bool
+	// UsageFlushMinutes is how often finished hours of usage are persisted
+	UsageFlushMinutes int
+	Free              APIPlanConfig
+	Pro               APIPlanConfig
+}
+
+// APIPlanConfig holds the limits of one plan; 0 leaves a limit off
+type APIPlanConfig struct {
+	DailyQuota     int
+	BurstPerSecond int
+	// Enforcement is "hard" to reject requests over the daily quota, or "soft" to let them
+	// through flagged as overage
+	Enforcement string
+}
+
+// loadAPIKeysConfig loads API key configuration
+func loadAPIKeysConfig() APIKeysConfig {
+	return APIKeysConfig{
+		Enabled:           env.GetBool("API_KEYS_ENABLED", true),
+		UsageFlushMinutes: env.GetInt("API_USAGE_FLUSH_MINUTES", 10),
+		Free: APIPlanConfig{
+			DailyQuota:     env.GetInt("API_PLAN_FREE_DAILY_QUOTA", 1000),
+			BurstPerSecond: env.GetInt("API_PLAN_FREE_BURST", 5),
+			Enforcement:    env.GetString("API_PLAN_FREE_ENFORCEMENT", "hard"),
+		},
+		Pro: APIPlanConfig{
+			DailyQuota:     env.GetInt("API_PLAN_PRO_DAILY_QUOTA", 100000),
+			BurstPerSecond: env.GetInt("API_PLAN_PRO_BURST", 50),
+			Enforcement:    env.GetString("API_PLAN_PRO_ENFORCEMENT", "soft"),
+		},
 	}
 }
 
@@ -101,6 +200,12 @@ func (c *Config) Validate() error {
 	if c.JWTKey == "" {
 		log.Fatal("JWT_SECRET is required")
 	}
+	if c.JWTIssuer == "" || c.JWTAudience == "" {
+		log.Fatal("JWT_ISSUER and JWT_AUDIENCE are required")
+	}
+	if c.SessionContextKey == "" {
+		log.Fatal("SESSION_CONTEXT_SECRET is required")
+	}
 	if c.PostgresConfig.PostgresHost == "" {
 		log.Fatal("POSTGRES_HOST is required")
 	}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// APIPlan is the tier an API key is billed on
+type APIPlan string
+
+const (
+	APIPlanFree APIPlan = "free"
+	APIPlanPro  APIPlan = "pro"
+)
+
+// APIPlans are the plans a key can be put on
+var APIPlans = []APIPlan{APIPlanFree, APIPlanPro}
+
+// QuotaEnforcement decides what happens to requests past a plan's daily quota
+type QuotaEnforcement string
+
+const (
+	// QuotaHard rejects requests over the quota until the day resets
+	QuotaHard QuotaEnforcement = "hard"
+	// QuotaSoft lets requests over the quota through, flagged as overage
+	QuotaSoft QuotaEnforcement = "soft"
+)
+
+// APIPlanLimits are the limits of a plan. The burst limit is always enforced; Enforcement
+// only applies to the daily quota.
+type APIPlanLimits struct {
+	Plan APIPlan
+	// DailyQuota is the requests allowed per UTC day; 0 leaves it unlimited
+	DailyQuota int64
+	// BurstPerSecond is the requests allowed in any one second; 0 leaves it unlimited
+	BurstPerSecond int64
+	Enforcement    QuotaEnforcement
+}
+
+// APIKey is a developer credential acting as its user. The secret is only known when the
+// key is created; afterwards it is looked up by hash.
+type APIKey struct {
+	ID     string
+	UserID UserID
+	Name   string
+	// Prefix is the start of the secret, shown so users can tell their keys apart
+	Prefix     string
+	Plan       APIPlan
+	CreatedAt  time.Time
+	LastUsedAt *time.Time // to the hour, updated when usage is persisted
+	RevokedAt  *time.Time
+}
+
+// CreatedAPIKey is a new key with its secret, which is never shown again
+type CreatedAPIKey struct {
+	Key    *APIKey
+	Secret string
+}
+
+// APIKeyReject is why a request was refused
+type APIKeyReject string
+
+const (
+	APIKeyRejectBurst APIKeyReject = "burst"
+	APIKeyRejectQuota APIKeyReject = "quota"
+)
+
+// APIKeyDecision is the outcome of authorizing one request made with an API key
+type APIKeyDecision struct {
+	Key     *APIKey
+	Limits  APIPlanLimits
+	Allowed bool
+	// Reject is set when the request was refused
+	Reject APIKeyReject
+	// OverQuota is set when the key is past its daily quota, whether or not the request was let through
+	OverQuota bool
+	UsedToday int64
+	// ResetsAt is when the daily quota starts over
+	ResetsAt time.Time
+	// RetryAfter is how long a refused caller should wait
+	RetryAfter time.Duration
+}
+
+// APIUsageHour counts a key's requests in the hour starting at Hour
+type APIUsageHour struct {
+	KeyID    string
+	Hour     time.Time
+	Requests int64
+	Rejected int64
+}
+
+// APIKeyUsage is a key's plan, today's use and its hourly history
+type APIKeyUsage struct {
+	Key       *APIKey
+	Limits    APIPlanLimits
+	UsedToday int64
+	Hours     []APIUsageHour
+}
+
+// APIKeyRepository keeps API keys and their persisted hourly usage
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key *APIKey, secretHash string) error
+	// GetAPIKeyByHash returns ErrAPIKeyNotFound for unknown keys, revoked ones included
+	GetAPIKeyByHash(ctx context.Context, secretHash string) (*APIKey, error)
+	GetAPIKey(ctx context.Context, id string) (*APIKey, error)
+	// ListAPIKeys returns the user's keys, revoked ones included, newest first
+	ListAPIKeys(ctx context.Context, userID UserID) ([]*APIKey, error)
+	CountActiveAPIKeys(ctx context.Context, userID UserID) (int, error)
+	// RevokeAPIKey returns ErrAPIKeyNotFound unless the user has the key unrevoked
+	RevokeAPIKey(ctx context.Context, userID UserID, id string, at time.Time) error
+	SetAPIKeyPlan(ctx context.Context, id string, plan APIPlan) (*APIKey, error)
+	// SaveAPIUsage stores the counts of each hour, replacing what was saved for it before,
+	// and moves the keys' last use up to the hour
+	SaveAPIUsage(ctx context.Context, hours []APIUsageHour) error
+	ListAPIUsage(ctx context.Context, keyIDs []string, since time.Time) ([]APIUsageHour, error)
+}
+
+// APIUsageCounter counts API key requests as they happen, shared by all replicas
+type APIUsageCounter interface {
+	// Hit counts a request against the key's burst and daily limits, and its hour's usage.
+	// Requests refused for either limit are counted as rejected and don't use up the quota.
+	Hit(ctx context.Context, keyID string, limits APIPlanLimits, now time.Time) (*APIKeyDecision, error)
+	UsedToday(ctx context.Context, keyID string, now time.Time) (int64, error)
+	// PendingUsage returns the counted hours not yet persisted, the current one included
+	PendingUsage(ctx context.Context) ([]APIUsageHour, error)
+	// DropUsage forgets an hour once it is persisted
+	DropUsage(ctx context.Context, hour time.Time) error
+}
@@ -2,7 +2,10 @@ package domain
 
 import (
 	"context"
+	"net"
 	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 )
 
 type UserID = string
@@ -17,6 +20,26 @@ type AuthUserLoggedInEvent struct {
 	ChainID    ChainID
 	SessionID  SessionID
 	LoggedInAt time.Time
+	// Location of the login IP; nil when geo-IP is off or the IP is unknown
+	Location *Location
+	// NewLocation is set when the user has logged in before, never from this location
+	NewLocation bool
+}
+
+type AuthSessionRevokedEvent struct {
+	UserID    UserID
+	SessionID SessionID
+	RevokedAt time.Time
+}
+
+// AuthSessionEvictedEvent is published when a login pushes out a session under the
+// concurrent session limit
+type AuthSessionEvictedEvent struct {
+	UserID    UserID
+	SessionID SessionID
+	// EvictedBy is the new session that took its place
+	EvictedBy SessionID
+	EvictedAt time.Time
 }
 
 type Nonce struct {
@@ -29,6 +52,50 @@ type Nonce struct {
 	CreatedAt time.Time
 }
 
+// Token identity used when none is configured
+const (
+	DefaultTokenIssuer   = "nft-marketplace-auth"
+	DefaultTokenAudience = "nft-marketplace-api"
+)
+
+// SessionLimitPolicy decides what a login does once the user is at the concurrent session limit
+type SessionLimitPolicy string
+
+const (
+	// SessionLimitReject refuses the login until the user signs out elsewhere
+	SessionLimitReject SessionLimitPolicy = "reject"
+	// SessionLimitEvictLRU revokes the least recently used sessions to make room
+	SessionLimitEvictLRU SessionLimitPolicy = "evict_lru"
+)
+
+// ClientInfo is the client a login came from, as seen by the gateway
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// Location is where a client IP is; City is empty in country-only privacy mode
+type Location struct {
+	Country string // ISO 3166-1 alpha-2
+	City    string
+}
+
+// GeoPrivacyMode decides how precisely client locations are kept
+type GeoPrivacyMode string
+
+const (
+	// GeoPrivacyCity keeps the IP, country and city of sessions
+	GeoPrivacyCity GeoPrivacyMode = "city"
+	// GeoPrivacyCountry keeps only the country; IPs and cities are never stored
+	GeoPrivacyCountry GeoPrivacyMode = "country"
+)
+
+// GeoLocator resolves client IPs to locations
+type GeoLocator interface {
+	// Locate returns nil when the IP has no known location
+	Locate(ctx context.Context, ip net.IP) (*Location, error)
+}
+
 type Session struct {
 	ID          SessionID
 	UserID      UserID
@@ -39,9 +106,85 @@ type Session struct {
 	DeviceID    *string
 	IP          *string
 	UA          *string
-	LastUsedAt  *time.Time
+	// Location of IP at login; nil when geo-IP is off or the IP is unknown
+	Location   *Location
+	LastUsedAt *time.Time
+	// Issuer of the environment the session was created in; empty for sessions that predate it
+	Issuer string
 	// Optional JSON context for collection preparation, stored as JSON string
 	CollectionIntentContext *string
+	// ImpersonatorID is the admin acting as UserID; empty for the user's own sessions
+	ImpersonatorID      UserID
+	ImpersonationReason string
+	// WalletLinkPending is set while the login's wallet link waits in the outbox
+	WalletLinkPending bool
+}
+
+// PendingWalletLink is a login's primary wallet link, queued while wallet-service was unavailable
+type PendingWalletLink struct {
+	ID            string
+	UserID        UserID
+	AccountID     string
+	Address       Address
+	ChainID       ChainID
+	SessionID     SessionID
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// WalletLinkOutbox queues wallet links until wallet-service takes them
+type WalletLinkOutbox interface {
+	// EnqueueWalletLink queues a link; a link already queued for the user, address and chain
+	// is made due again instead
+	EnqueueWalletLink(ctx context.Context, link *PendingWalletLink) error
+	// ClaimDueWalletLinks returns up to limit links due at now, holding them back from other
+	// replicas until now+lease
+	ClaimDueWalletLinks(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*PendingWalletLink, error)
+	// CompleteWalletLink drops a made link and clears wallet_link_pending from the user's
+	// sessions once none of their links are queued
+	CompleteWalletLink(ctx context.Context, link *PendingWalletLink) error
+	// RetryWalletLink records a failed attempt and when to try again
+	RetryWalletLink(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error
+	CountPendingWalletLinks(ctx context.Context) (int, error)
+}
+
+// WalletLinkStats counts wallet links deferred while wallet-service was unavailable
+type WalletLinkStats struct {
+	Deferred   uint64 // logins whose link was queued
+	Reconciled uint64 // queued links made
+	Retries    uint64 // failed attempts at queued links
+	Pending    int    // links queued now
+}
+
+// ImpersonationResult is the token an admin uses to act as another user
+type ImpersonationResult struct {
+	AccessToken    string
+	ExpiresAt      time.Time
+	UserID         UserID
+	ImpersonatorID UserID
+	SessionID      SessionID
+}
+
+// SubscriptionTicket is a single-use credential for opening a subscription socket
+type SubscriptionTicket struct {
+	Ticket    string
+	ExpiresAt time.Time
+}
+
+// SubscriptionTicketStore keeps issued subscription tickets until the subscription worker
+// redeems them; *redis.Redis implements it
+type SubscriptionTicketStore interface {
+	StoreSubscriptionTicket(ctx context.Context, ticket string, rec contracts.SubscriptionTicket, ttl time.Duration) error
+}
+
+// NonceStore keeps SIWE nonces until they are used or expire. The AuthRepository keeps
+// them in Postgres; a Redis store takes the writes off the database.
+type NonceStore interface {
+	CreateNonce(ctx context.Context, nonce *Nonce) error
+	// TryUseNonce consumes the nonce if it is unused, unexpired and was issued for the
+	// account, chain and domain, reporting whether it did
+	TryUseNonce(ctx context.Context, value, accountID, chainID, domain string, usedAt time.Time) (bool, error)
 }
 
 type AuthResult struct {
@@ -55,14 +198,27 @@ type AuthResult struct {
 
 type AuthService interface {
 	GetNonce(ctx context.Context, accountID, chainID, domain string) (string, error)
-	VerifySiwe(ctx context.Context, accountID, message, signature string) (*AuthResult, error)
+	VerifySiwe(ctx context.Context, accountID, message, signature string, client ClientInfo) (*AuthResult, error)
 	Refresh(ctx context.Context, refreshToken string) (*AuthResult, error)
 	Logout(ctx context.Context, sessionID string) error
 	LogoutByRefreshToken(ctx context.Context, refreshToken string) error
+	StartImpersonation(ctx context.Context, adminUserID, targetUserID, reason string) (*ImpersonationResult, error)
+	EndImpersonation(ctx context.Context, sessionID string) error
+	IssueSubscriptionTicket(ctx context.Context, userID, sessionID, origin string) (*SubscriptionTicket, error)
+	ValidateSession(ctx context.Context, userID, sessionID string) (*Session, error)
+
+	CreateAPIKey(ctx context.Context, userID, name string) (*CreatedAPIKey, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]*APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID string) error
+	SetAPIKeyPlan(ctx context.Context, adminUserID, keyID string, plan APIPlan) (*APIKey, error)
+	AuthorizeAPIKey(ctx context.Context, secret string) (*APIKeyDecision, error)
+	GetAPIUsage(ctx context.Context, userID string, days int) ([]*APIKeyUsage, error)
 }
 
 type AuthEventPublisher interface {
 	PublishUserLoggedIn(ctx context.Context, event *AuthUserLoggedInEvent) error
+	PublishSessionRevoked(ctx context.Context, event *AuthSessionRevokedEvent) error
+	PublishSessionEvicted(ctx context.Context, event *AuthSessionEvictedEvent) error
 }
 
 type AuthRepository interface {
@@ -78,4 +234,9 @@ type AuthRepository interface {
 	GetSessionByRefreshHash(ctx context.Context, refreshHash string) (*Session, error)
 	UpdateSessionLastUsed(ctx context.Context, sessionID SessionID) error
 	RevokeSession(ctx context.Context, sessionID SessionID) error
+	// ListActiveSessions returns the user's unrevoked, unexpired sessions, least recently used first
+	ListActiveSessions(ctx context.Context, userID UserID) ([]*Session, error)
+	// ListLoginLocations returns the distinct locations of the user's own sessions, revoked
+	// and expired ones included
+	ListLoginLocations(ctx context.Context, userID UserID) ([]Location, error)
 }
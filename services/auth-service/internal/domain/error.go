@@ -1,15 +1,33 @@
 package domain
 
-import "errors"
+import "github.com/quangdang46/NFT-Marketplace/shared/errs"
 
 var (
-	ErrNonceNotFound       = errors.New("Nonce not found")
-	ErrNonceUsed           = errors.New("Nonce used")
-	ErrNonceExpired        = errors.New("Nonce expired")
-	ErrNonceInvalid        = errors.New("Nonce invalid")
-	ErrNonceAlreadyUsed    = errors.New("Nonce already used")
-	ErrNonceAlreadyExpired = errors.New("Nonce already expired")
-	ErrNonceAlreadyInvalid = errors.New("Nonce already invalid")
-	ErrInvalidAccountID    = errors.New("Invalid account ID")
-	ErrInvalidChainID      = errors.New("Invalid chain ID")
+	// A login whose nonce cannot be used fails like any other bad signature
+	ErrNonceNotFound       = errs.New(errs.Unauthenticated, "Nonce not found")
+	ErrNonceUsed           = errs.New(errs.Unauthenticated, "Nonce used")
+	ErrNonceExpired        = errs.New(errs.Unauthenticated, "Nonce expired")
+	ErrNonceInvalid        = errs.New(errs.Unauthenticated, "Nonce invalid")
+	ErrNonceAlreadyUsed    = errs.New(errs.Unauthenticated, "Nonce already used")
+	ErrNonceAlreadyExpired = errs.New(errs.Unauthenticated, "Nonce already expired")
+	ErrNonceAlreadyInvalid = errs.New(errs.Unauthenticated, "Nonce already invalid")
+	ErrInvalidAccountID    = errs.New(errs.InvalidArgument, "Invalid account ID")
+	ErrInvalidChainID      = errs.New(errs.InvalidArgument, "Invalid chain ID")
+
+	ErrImpersonationForbidden = errs.New(errs.PermissionDenied, "Impersonation not allowed")
+	ErrImpersonationReason    = errs.New(errs.InvalidArgument, "Impersonation reason is required")
+	ErrNotImpersonating       = errs.New(errs.FailedPrecondition, "Session is not an impersonation session")
+
+	ErrSessionLimitReached = errs.New(errs.FailedPrecondition, "Concurrent session limit reached")
+
+	ErrSubscriptionTicketsDisabled = errs.New(errs.Unavailable, "Subscription tickets are not enabled")
+	ErrSessionInactive             = errs.New(errs.Unauthenticated, "Session is not active")
+
+	ErrAPIKeysDisabled  = errs.New(errs.Unavailable, "API keys are not enabled")
+	ErrAPIKeyNotFound   = errs.New(errs.NotFound, "API key not found")
+	ErrAPIKeyInvalid    = errs.New(errs.Unauthenticated, "API key invalid")
+	ErrAPIKeyName       = errs.New(errs.InvalidArgument, "API key name must be 1 to 64 characters")
+	ErrAPIKeyLimit      = errs.New(errs.FailedPrecondition, "API key limit reached")
+	ErrAPIPlanInvalid   = errs.New(errs.InvalidArgument, "Invalid API plan")
+	ErrAPIPlanForbidden = errs.New(errs.PermissionDenied, "Only admins may change API plans")
 )
@@ -0,0 +1,67 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// sealedV1 prefixes blobs sealed with AES-256-GCM; a new byte marks any future key or format change
+const sealedV1 byte = 0x01
+
+var ErrMalformedBlob = errors.New("malformed sealed blob")
+
+// ContextCipher seals session context blobs with AES-256-GCM. The session ID is bound as
+// additional data so a blob copied onto another session row fails to open.
+type ContextCipher struct {
+	aead cipher.AEAD
+}
+
+// NewContextCipher derives the AES-256 key from the configured secret
+func NewContextCipher(secret string) (*ContextCipher, error) {
+	if secret == "" {
+		return nil, errors.New("session context secret is empty")
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return &ContextCipher{aead: aead}, nil
+}
+
+// Seal returns version || nonce || ciphertext
+func (c *ContextCipher) Seal(plaintext []byte, sessionID string) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, 1+len(nonce)+len(plaintext)+c.aead.Overhead())
+	out = append(out, sealedV1)
+	out = append(out, nonce...)
+	return c.aead.Seal(out, nonce, plaintext, []byte(sessionID)), nil
+}
+
+// Open reverses Seal for the same session ID
+func (c *ContextCipher) Open(sealed []byte, sessionID string) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < 1+nonceSize+c.aead.Overhead() || sealed[0] != sealedV1 {
+		return nil, ErrMalformedBlob
+	}
+
+	nonce := sealed[1 : 1+nonceSize]
+	plaintext, err := c.aead.Open(nil, nonce, sealed[1+nonceSize:], []byte(sessionID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session context: %w", err)
+	}
+	return plaintext, nil
+}
@@ -34,6 +34,13 @@ func (p *EventPublisher) PublishUserLoggedIn(ctx context.Context, event *domain.
 		"chain_id":     event.ChainID,
 		"session_id":   event.SessionID,
 		"logged_in_at": event.LoggedInAt.Format(time.RFC3339),
+		"new_location": event.NewLocation,
+	}
+	if event.Location != nil {
+		payload["country"] = event.Location.Country
+		if event.Location.City != "" {
+			payload["city"] = event.Location.City
+		}
 	}
 	body, err := json.Marshal(payload)
 	if err != nil {
@@ -52,3 +59,65 @@ func (p *EventPublisher) PublishUserLoggedIn(ctx context.Context, event *domain.
 		},
 	})
 }
+
+// PublishSessionRevoked publishes a session.revoked event after logout
+func (p *EventPublisher) PublishSessionRevoked(ctx context.Context, event *domain.AuthSessionRevokedEvent) error {
+	if p.amqp == nil {
+		fmt.Printf("AMQP not available, skipping session_revoked event: %+v\n", event)
+		return nil
+	}
+
+	payload := map[string]interface{}{
+		"user_id":    event.UserID,
+		"session_id": event.SessionID,
+		"revoked_at": event.RevokedAt.Format(time.RFC3339),
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session_revoked event: %w", err)
+	}
+
+	return p.amqp.Publish(ctx, contracts.AMQPMessage{
+		Exchange:   contracts.AuthExchange,
+		RoutingKey: contracts.SessionRevokedKey,
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   "session.revoked",
+			"schema":       "auth.session_revoked.v1",
+			"published_at": time.Now().Format(time.RFC3339),
+			"service":      "auth-service",
+		},
+	})
+}
+
+// PublishSessionEvicted publishes a session.evicted event after a login pushed a session
+// out under the concurrent session limit
+func (p *EventPublisher) PublishSessionEvicted(ctx context.Context, event *domain.AuthSessionEvictedEvent) error {
+	if p.amqp == nil {
+		fmt.Printf("AMQP not available, skipping session_evicted event: %+v\n", event)
+		return nil
+	}
+
+	payload := map[string]interface{}{
+		"user_id":    event.UserID,
+		"session_id": event.SessionID,
+		"evicted_by": event.EvictedBy,
+		"evicted_at": event.EvictedAt.Format(time.RFC3339),
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session_evicted event: %w", err)
+	}
+
+	return p.amqp.Publish(ctx, contracts.AMQPMessage{
+		Exchange:   contracts.AuthExchange,
+		RoutingKey: contracts.SessionEvictedKey,
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   "session.evicted",
+			"schema":       "auth.session_evicted.v1",
+			"published_at": time.Now().Format(time.RFC3339),
+			"service":      "auth-service",
+		},
+	})
+}
@@ -0,0 +1,98 @@
+package geoip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+)
+
+const lookupTimeout = 2 * time.Second
+
+// maxMindLocator resolves IPs through the MaxMind GeoIP2 / GeoLite2 web service
+type maxMindLocator struct {
+	baseURL    string
+	accountID  string
+	licenseKey string
+	endpoint   string // "city" or "country"
+	client     *http.Client
+}
+
+// NewMaxMindLocator creates a locator against baseURL, https://geolite.info for GeoLite2 or
+// https://geoip.maxmind.com for GeoIP2. Country privacy mode queries the country endpoint,
+// so cities are never even fetched.
+func NewMaxMindLocator(baseURL, accountID, licenseKey string, mode domain.GeoPrivacyMode) domain.GeoLocator {
+	endpoint := "city"
+	if mode == domain.GeoPrivacyCountry {
+		endpoint = "country"
+	}
+	return &maxMindLocator{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		accountID:  accountID,
+		licenseKey: licenseKey,
+		endpoint:   endpoint,
+		client:     &http.Client{Timeout: lookupTimeout},
+	}
+}
+
+type maxMindResponse struct {
+	Country struct {
+		ISOCode string `json:"iso_code"`
+	} `json:"country"`
+	City struct {
+		Names map[string]string `json:"names"`
+	} `json:"city"`
+}
+
+type maxMindError struct {
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+func (l *maxMindLocator) Locate(ctx context.Context, ip net.IP) (*domain.Location, error) {
+	// Internal addresses have no location and would only cost a query
+	if ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
+		return nil, nil
+	}
+
+	url := fmt.Sprintf("%s/geoip/v2.1/%s/%s", l.baseURL, l.endpoint, ip.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build geo-IP request: %w", err)
+	}
+	req.SetBasicAuth(l.accountID, l.licenseKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("geo-IP lookup failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var body maxMindError
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+		switch body.Code {
+		case "IP_ADDRESS_NOT_FOUND", "IP_ADDRESS_RESERVED":
+			return nil, nil
+		}
+		return nil, fmt.Errorf("geo-IP lookup returned %d: %s %s", resp.StatusCode, body.Code, body.Error)
+	}
+
+	var body maxMindResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode geo-IP response: %w", err)
+	}
+	if body.Country.ISOCode == "" {
+		return nil, nil
+	}
+	return &domain.Location{
+		Country: body.Country.ISOCode,
+		City:    body.City.Names["en"],
+	}, nil
+}
@@ -0,0 +1,149 @@
+package grpc_handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	authProto "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+func (g *gRPCHandler) CreateApiKey(ctx context.Context, req *authProto.CreateApiKeyRequest) (*authProto.CreateApiKeyResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	created, err := g.authService.CreateAPIKey(ctx, req.GetUserId(), req.GetName())
+	if err != nil {
+		return nil, apiKeyError("create api key", err)
+	}
+	return &authProto.CreateApiKeyResponse{Key: toProtoAPIKey(created.Key), Secret: created.Secret}, nil
+}
+
+func (g *gRPCHandler) ListApiKeys(ctx context.Context, req *authProto.ListApiKeysRequest) (*authProto.ListApiKeysResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	keys, err := g.authService.ListAPIKeys(ctx, req.GetUserId())
+	if err != nil {
+		return nil, apiKeyError("list api keys", err)
+	}
+	resp := &authProto.ListApiKeysResponse{Keys: make([]*authProto.ApiKey, 0, len(keys))}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, toProtoAPIKey(key))
+	}
+	return resp, nil
+}
+
+func (g *gRPCHandler) RevokeApiKey(ctx context.Context, req *authProto.RevokeApiKeyRequest) (*authProto.RevokeApiKeyResponse, error) {
+	if req.GetUserId() == "" || req.GetKeyId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and key_id are required")
+	}
+
+	if err := g.authService.RevokeAPIKey(ctx, req.GetUserId(), req.GetKeyId()); err != nil {
+		return nil, apiKeyError("revoke api key", err)
+	}
+	return &authProto.RevokeApiKeyResponse{}, nil
+}
+
+func (g *gRPCHandler) SetApiKeyPlan(ctx context.Context, req *authProto.SetApiKeyPlanRequest) (*authProto.SetApiKeyPlanResponse, error) {
+	if req.GetAdminUserId() == "" || req.GetKeyId() == "" || req.GetPlan() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "admin_user_id, key_id and plan are required")
+	}
+
+	key, err := g.authService.SetAPIKeyPlan(ctx, req.GetAdminUserId(), req.GetKeyId(), domain.APIPlan(req.GetPlan()))
+	if err != nil {
+		return nil, apiKeyError("set api key plan", err)
+	}
+	return &authProto.SetApiKeyPlanResponse{Key: toProtoAPIKey(key)}, nil
+}
+
+func (g *gRPCHandler) AuthorizeApiKey(ctx context.Context, req *authProto.AuthorizeApiKeyRequest) (*authProto.AuthorizeApiKeyResponse, error) {
+	if req.GetKey() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "key is required")
+	}
+
+	d, err := g.authService.AuthorizeAPIKey(ctx, req.GetKey())
+	if err != nil {
+		return nil, apiKeyError("authorize api key", err)
+	}
+	return &authProto.AuthorizeApiKeyResponse{
+		Allowed:      d.Allowed,
+		UserId:       string(d.Key.UserID),
+		KeyId:        d.Key.ID,
+		Limits:       toProtoPlanLimits(d.Limits),
+		UsedToday:    d.UsedToday,
+		OverQuota:    d.OverQuota,
+		RejectReason: string(d.Reject),
+		ResetsAt:     d.ResetsAt.Format(time.RFC3339),
+		RetryAfterMs: d.RetryAfter.Milliseconds(),
+	}, nil
+}
+
+func (g *gRPCHandler) GetApiUsage(ctx context.Context, req *authProto.GetApiUsageRequest) (*authProto.GetApiUsageResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	usage, err := g.authService.GetAPIUsage(ctx, req.GetUserId(), int(req.GetDays()))
+	if err != nil {
+		return nil, apiKeyError("get api usage", err)
+	}
+	resp := &authProto.GetApiUsageResponse{Keys: make([]*authProto.ApiKeyUsage, 0, len(usage))}
+	for _, u := range usage {
+		out := &authProto.ApiKeyUsage{
+			Key:       toProtoAPIKey(u.Key),
+			Limits:    toProtoPlanLimits(u.Limits),
+			UsedToday: u.UsedToday,
+			Hours:     make([]*authProto.ApiUsageHour, 0, len(u.Hours)),
+		}
+		for _, h := range u.Hours {
+			out.Hours = append(out.Hours, &authProto.ApiUsageHour{
+				Hour:     h.Hour.UTC().Format(time.RFC3339),
+				Requests: h.Requests,
+				Rejected: h.Rejected,
+			})
+		}
+		resp.Keys = append(resp.Keys, out)
+	}
+	return resp, nil
+}
+
+func toProtoAPIKey(key *domain.APIKey) *authProto.ApiKey {
+	out := &authProto.ApiKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Plan:      string(key.Plan),
+		CreatedAt: key.CreatedAt.Format(time.RFC3339),
+	}
+	if key.LastUsedAt != nil {
+		out.LastUsedAt = key.LastUsedAt.Format(time.RFC3339)
+	}
+	if key.RevokedAt != nil {
+		out.RevokedAt = key.RevokedAt.Format(time.RFC3339)
+	}
+	return out
+}
+
+func toProtoPlanLimits(limits domain.APIPlanLimits) *authProto.ApiPlanLimits {
+	return &authProto.ApiPlanLimits{
+		Plan:           string(limits.Plan),
+		DailyQuota:     limits.DailyQuota,
+		BurstPerSecond: limits.BurstPerSecond,
+		Enforcement:    string(limits.Enforcement),
+	}
+}
+
+func apiKeyError(op string, err error) error {
+	if _, ok := errs.As(err); ok {
+		return errs.ToGRPC(err)
+	}
+	return errs.ToGRPC(fmt.Errorf("failed to %s: %w", op, err))
+}
@@ -2,9 +2,12 @@ package grpc_handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
 	authProto "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -34,7 +37,7 @@ func (g *gRPCHandler) GetNonce(ctx context.Context, req *authProto.GetNonceReque
 
 	nonce, err := g.authService.GetNonce(ctx, accountID, chainID, domain)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get nonce: %v", err)
+		return nil, errs.ToGRPC(fmt.Errorf("failed to get nonce: %w", err))
 	}
 
 	return &authProto.GetNonceResponse{
@@ -51,9 +54,12 @@ func (g *gRPCHandler) VerifySiwe(ctx context.Context, req *authProto.VerifySiweR
 		return nil, status.Errorf(codes.InvalidArgument, "account_id, message, and signature are required")
 	}
 
-	result, err := g.authService.VerifySiwe(ctx, accountID, message, signature)
+	result, err := g.authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{
+		IP:        req.GetIpAddress(),
+		UserAgent: req.GetUserAgent(),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to verify SIWE: %v", err)
+		return nil, errs.ToGRPC(fmt.Errorf("failed to verify SIWE: %w", err))
 	}
 
 	response := &authProto.VerifySiweResponse{
@@ -97,7 +103,7 @@ func (g *gRPCHandler) RevokeSession(ctx context.Context, req *authProto.RevokeSe
 
 	err := g.authService.Logout(ctx, sessionID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
+		return nil, errs.ToGRPC(fmt.Errorf("failed to revoke session: %w", err))
 	}
 
 	return &authProto.RevokeSessionResponse{
@@ -120,3 +126,83 @@ func (g *gRPCHandler) RevokeSessionByRefreshToken(ctx context.Context, req *auth
 		Success: true,
 	}, nil
 }
+
+func (g *gRPCHandler) StartImpersonation(ctx context.Context, req *authProto.StartImpersonationRequest) (*authProto.StartImpersonationResponse, error) {
+	if req.GetAdminUserId() == "" || req.GetTargetUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "admin_user_id and target_user_id are required")
+	}
+
+	result, err := g.authService.StartImpersonation(ctx, req.GetAdminUserId(), req.GetTargetUserId(), req.GetReason())
+	if err != nil {
+		return nil, impersonationError(err)
+	}
+
+	return &authProto.StartImpersonationResponse{
+		AccessToken:    result.AccessToken,
+		ExpiresAt:      result.ExpiresAt.Format(time.RFC3339),
+		UserId:         result.UserID,
+		ImpersonatorId: result.ImpersonatorID,
+		SessionId:      result.SessionID,
+	}, nil
+}
+
+func (g *gRPCHandler) EndImpersonation(ctx context.Context, req *authProto.EndImpersonationRequest) (*authProto.EndImpersonationResponse, error) {
+	if req.GetSessionId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "session_id is required")
+	}
+
+	if err := g.authService.EndImpersonation(ctx, req.GetSessionId()); err != nil {
+		return nil, impersonationError(err)
+	}
+
+	return &authProto.EndImpersonationResponse{
+		Success: true,
+	}, nil
+}
+
+func (g *gRPCHandler) IssueSubscriptionTicket(ctx context.Context, req *authProto.IssueSubscriptionTicketRequest) (*authProto.IssueSubscriptionTicketResponse, error) {
+	if req.GetUserId() == "" || req.GetSessionId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and session_id are required")
+	}
+
+	ticket, err := g.authService.IssueSubscriptionTicket(ctx, req.GetUserId(), req.GetSessionId(), req.GetOrigin())
+	if err != nil {
+		if _, ok := errs.As(err); ok {
+			return nil, errs.ToGRPC(err)
+		}
+		return nil, errs.ToGRPC(fmt.Errorf("failed to issue subscription ticket: %w", err))
+	}
+
+	return &authProto.IssueSubscriptionTicketResponse{
+		Ticket:    ticket.Ticket,
+		ExpiresAt: ticket.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ValidateSession answers whether a session is active for the user; an inactive session is
+// a normal answer, not an error
+func (g *gRPCHandler) ValidateSession(ctx context.Context, req *authProto.ValidateSessionRequest) (*authProto.ValidateSessionResponse, error) {
+	if req.GetUserId() == "" || req.GetSessionId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and session_id are required")
+	}
+
+	session, err := g.authService.ValidateSession(ctx, req.GetUserId(), req.GetSessionId())
+	if errors.Is(err, domain.ErrSessionInactive) {
+		return &authProto.ValidateSessionResponse{Active: false}, nil
+	}
+	if err != nil {
+		return nil, errs.ToGRPC(fmt.Errorf("failed to validate session: %w", err))
+	}
+
+	return &authProto.ValidateSessionResponse{
+		Active:    true,
+		ExpiresAt: session.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+func impersonationError(err error) error {
+	if _, ok := errs.As(err); ok {
+		return errs.ToGRPC(err)
+	}
+	return errs.ToGRPC(fmt.Errorf("impersonation failed: %w", err))
+}
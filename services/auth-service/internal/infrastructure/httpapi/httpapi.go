@@ -0,0 +1,49 @@
+// Package httpapi serves the auth service's operational endpoints: deferred wallet link
+// metrics at /metrics in the Prometheus text format.
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+)
+
+const MetricsRoute = "/metrics"
+
+// Auth is what the endpoints read; service.Service implements it
+type Auth interface {
+	WalletLinkStats(ctx context.Context) (domain.WalletLinkStats, error)
+}
+
+// NewHandler routes the operational endpoints
+func NewHandler(auth Auth) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(MetricsRoute, func(w http.ResponseWriter, r *http.Request) {
+		serveMetrics(w, r, auth)
+	})
+	return mux
+}
+
+func serveMetrics(w http.ResponseWriter, r *http.Request, auth Auth) {
+	stats, err := auth.WalletLinkStats(r.Context())
+	if err != nil {
+		// The counters are still worth scraping without the outbox size
+		log.Printf("Failed to count pending wallet links: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	monitoring.WriteMetricHeader(w, "auth_wallet_links_deferred_total", "counter", "Logins whose wallet link was queued while wallet-service was unavailable.")
+	fmt.Fprintf(w, "auth_wallet_links_deferred_total %d\n", stats.Deferred)
+	monitoring.WriteMetricHeader(w, "auth_wallet_links_reconciled_total", "counter", "Queued wallet links made once wallet-service was back.")
+	fmt.Fprintf(w, "auth_wallet_links_reconciled_total %d\n", stats.Reconciled)
+	monitoring.WriteMetricHeader(w, "auth_wallet_link_retries_total", "counter", "Failed attempts at queued wallet links.")
+	fmt.Fprintf(w, "auth_wallet_link_retries_total %d\n", stats.Retries)
+	if err == nil {
+		monitoring.WriteMetricHeader(w, "auth_wallet_links_pending", "gauge", "Wallet links queued for reconciliation.")
+		fmt.Fprintf(w, "auth_wallet_links_pending %d\n", stats.Pending)
+	}
+}
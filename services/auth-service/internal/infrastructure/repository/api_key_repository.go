@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// APIKeyRepository keeps API keys and their hourly usage in Postgres
+type APIKeyRepository struct {
+	postgres *postgres.Postgres
+}
+
+// NewAPIKeyRepository creates a Postgres-backed API key repository
+func NewAPIKeyRepository(postgres *postgres.Postgres) *APIKeyRepository {
+	return &APIKeyRepository{postgres: postgres}
+}
+
+var _ domain.APIKeyRepository = (*APIKeyRepository)(nil)
+
+const apiKeyColumns = `id, user_id, name, prefix, plan, created_at, last_used_at, revoked_at`
+
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey, secretHash string) error {
+	query := `
+		INSERT INTO api_keys (id, user_id, name, prefix, key_hash, plan, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.postgres.GetClient().ExecContext(ctx, query,
+		key.ID, key.UserID, key.Name, key.Prefix, secretHash, string(key.Plan), key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) GetAPIKeyByHash(ctx context.Context, secretHash string) (*domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1 AND revoked_at IS NULL`
+	key, err := scanAPIKey(r.postgres.GetClient().QueryRowContext(ctx, query, secretHash))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *APIKeyRepository) GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = $1`
+	key, err := scanAPIKey(r.postgres.GetClient().QueryRowContext(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context, userID domain.UserID) ([]*domain.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE user_id = $1 ORDER BY created_at DESC`
+	rows, err := r.postgres.GetClient().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*domain.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *APIKeyRepository) CountActiveAPIKeys(ctx context.Context, userID domain.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM api_keys WHERE user_id = $1 AND revoked_at IS NULL`
+	var n int
+	if err := r.postgres.GetClient().QueryRowContext(ctx, query, userID).Scan(&n); err != nil {
+		return 0, fmt.Errorf("failed to count api keys: %w", err)
+	}
+	return n, nil
+}
+
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, userID domain.UserID, id string, at time.Time) error {
+	query := `UPDATE api_keys SET revoked_at = $3 WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`
+	res, err := r.postgres.GetClient().ExecContext(ctx, query, id, userID, at)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) SetAPIKeyPlan(ctx context.Context, id string, plan domain.APIPlan) (*domain.APIKey, error) {
+	query := `UPDATE api_keys SET plan = $2 WHERE id = $1 RETURNING ` + apiKeyColumns
+	key, err := scanAPIKey(r.postgres.GetClient().QueryRowContext(ctx, query, id, string(plan)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set api key plan: %w", err)
+	}
+	return key, nil
+}
+
+func (r *APIKeyRepository) SaveAPIUsage(ctx context.Context, hours []domain.APIUsageHour) error {
+	if len(hours) == 0 {
+		return nil
+	}
+	tx, err := r.postgres.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin usage transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Counts are absolute, so saving an hour twice, say from two replicas, changes nothing.
+	// Keys deleted since are skipped rather than failing the whole batch.
+	upsert := `
+		INSERT INTO api_key_usage (key_id, hour, requests, rejected)
+		SELECT id, $2, $3, $4 FROM api_keys WHERE id = $1
+		ON CONFLICT (key_id, hour) DO UPDATE
+		SET requests = EXCLUDED.requests, rejected = EXCLUDED.rejected
+	`
+	touch := `UPDATE api_keys SET last_used_at = $2 WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $2)`
+	for _, h := range hours {
+		if _, err := tx.ExecContext(ctx, upsert, h.KeyID, h.Hour, h.Requests, h.Rejected); err != nil {
+			return fmt.Errorf("failed to save api usage: %w", err)
+		}
+		if h.Requests == 0 {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, touch, h.KeyID, h.Hour); err != nil {
+			return fmt.Errorf("failed to update api key last use: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit api usage: %w", err)
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) ListAPIUsage(ctx context.Context, keyIDs []string, since time.Time) ([]domain.APIUsageHour, error) {
+	if len(keyIDs) == 0 {
+		return nil, nil
+	}
+	query := `
+		SELECT key_id, hour, requests, rejected
+		FROM api_key_usage
+		WHERE key_id = ANY($1::uuid[]) AND hour >= $2
+		ORDER BY hour
+	`
+	rows, err := r.postgres.GetClient().QueryContext(ctx, query, pq.Array(keyIDs), since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api usage: %w", err)
+	}
+	defer rows.Close()
+
+	var hours []domain.APIUsageHour
+	for rows.Next() {
+		var h domain.APIUsageHour
+		if err := rows.Scan(&h.KeyID, &h.Hour, &h.Requests, &h.Rejected); err != nil {
+			return nil, fmt.Errorf("failed to scan api usage: %w", err)
+		}
+		hours = append(hours, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list api usage: %w", err)
+	}
+	return hours, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(row rowScanner) (*domain.APIKey, error) {
+	var key domain.APIKey
+	var plan string
+	err := row.Scan(&key.ID, &key.UserID, &key.Name, &key.Prefix, &plan, &key.CreatedAt, &key.LastUsedAt, &key.RevokedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	key.Plan = domain.APIPlan(plan)
+	return &key, nil
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+const (
+	apiKeyDailyPrefix  = "auth:apikey:daily:"
+	apiKeyBurstPrefix  = "auth:apikey:burst:"
+	apiKeyUsagePrefix  = "auth:apikey:usage:"
+	apiKeyUsageHours   = "auth:apikey:usage_hours"
+	apiKeyDailyTTL     = 48 * time.Hour
+	apiKeyUsageTTL     = 8 * 24 * time.Hour // outlives a flusher that is down for a week
+	apiKeyUsageDateFmt = "20060102"
+)
+
+// hitScript counts one request. The burst window is checked first, then the daily quota
+// when it is hard; a refused request is counted as rejected and leaves the quota alone.
+// Returns {allowed, used today, reject: 0 none, 1 burst, 2 quota}.
+//
+// KEYS[1] burst window; KEYS[2] daily counter; KEYS[3] hour usage hash; KEYS[4] hours set
+// ARGV[1] burst limit; ARGV[2] daily quota; ARGV[3] 1 if the quota is hard; ARGV[4] key ID;
+// ARGV[5] hour; ARGV[6] daily TTL; ARGV[7] usage TTL (seconds)
+var hitScript = redislib.NewScript(`
+local function count(field)
+	redis.call('HINCRBY', KEYS[3], ARGV[4] .. ':' .. field, 1)
+	redis.call('EXPIRE', KEYS[3], ARGV[7])
+	redis.call('SADD', KEYS[4], ARGV[5])
+end
+local used = tonumber(redis.call('GET', KEYS[2]) or '0')
+local burst = redis.call('INCR', KEYS[1])
+if burst == 1 then
+	redis.call('EXPIRE', KEYS[1], 2)
+end
+local burstLimit = tonumber(ARGV[1])
+if burstLimit > 0 and burst > burstLimit then
+	count('rejected')
+	return {0, used, 1}
+end
+local quota = tonumber(ARGV[2])
+if quota > 0 and used >= quota and ARGV[3] == '1' then
+	count('rejected')
+	return {0, used, 2}
+end
+used = redis.call('INCR', KEYS[2])
+if used == 1 then
+	redis.call('EXPIRE', KEYS[2], ARGV[6])
+end
+count('requests')
+return {1, used, 0}
+`)
+
+// RedisAPIUsageCounter counts API key requests in Redis. Burst limits use one-second fixed
+// windows and quotas reset at UTC midnight. Hourly usage stays in Redis until persisted.
+type RedisAPIUsageCounter struct {
+	redis *redis.Redis
+}
+
+func NewRedisAPIUsageCounter(redis *redis.Redis) *RedisAPIUsageCounter {
+	return &RedisAPIUsageCounter{redis: redis}
+}
+
+var _ domain.APIUsageCounter = (*RedisAPIUsageCounter)(nil)
+
+func (c *RedisAPIUsageCounter) Hit(ctx context.Context, keyID string, limits domain.APIPlanLimits, now time.Time) (*domain.APIKeyDecision, error) {
+	now = now.UTC()
+	hour := now.Truncate(time.Hour).Unix()
+	hard := "0"
+	if limits.Enforcement == domain.QuotaHard {
+		hard = "1"
+	}
+	keys := []string{
+		apiKeyBurstPrefix + keyID + ":" + strconv.FormatInt(now.Unix(), 10),
+		apiKeyDailyPrefix + keyID + ":" + now.Format(apiKeyUsageDateFmt),
+		apiKeyUsagePrefix + strconv.FormatInt(hour, 10),
+		apiKeyUsageHours,
+	}
+	res, err := hitScript.Run(ctx, c.redis.GetClient(), keys,
+		limits.BurstPerSecond, limits.DailyQuota, hard, keyID, hour,
+		int(apiKeyDailyTTL.Seconds()), int(apiKeyUsageTTL.Seconds())).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to count api key request: %w", err)
+	}
+	if len(res) != 3 {
+		return nil, fmt.Errorf("unexpected api key counter reply: %v", res)
+	}
+
+	resetsAt := now.Truncate(24 * time.Hour).Add(24 * time.Hour)
+	d := &domain.APIKeyDecision{
+		Limits:    limits,
+		Allowed:   res[0] == 1,
+		UsedToday: res[1],
+		OverQuota: limits.DailyQuota > 0 && res[1] >= limits.DailyQuota,
+		ResetsAt:  resetsAt,
+	}
+	if d.Allowed {
+		// The request that takes the last unit of quota is still within it
+		d.OverQuota = limits.DailyQuota > 0 && res[1] > limits.DailyQuota
+	}
+	switch res[2] {
+	case 1:
+		d.Reject = domain.APIKeyRejectBurst
+		d.RetryAfter = now.Truncate(time.Second).Add(time.Second).Sub(now)
+	case 2:
+		d.Reject = domain.APIKeyRejectQuota
+		d.RetryAfter = resetsAt.Sub(now)
+	}
+	return d, nil
+}
+
+func (c *RedisAPIUsageCounter) UsedToday(ctx context.Context, keyID string, now time.Time) (int64, error) {
+	used, err := c.redis.GetClient().Get(ctx, apiKeyDailyPrefix+keyID+":"+now.UTC().Format(apiKeyUsageDateFmt)).Int64()
+	if err == redislib.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read api key usage: %w", err)
+	}
+	return used, nil
+}
+
+func (c *RedisAPIUsageCounter) PendingUsage(ctx context.Context) ([]domain.APIUsageHour, error) {
+	client := c.redis.GetClient()
+	members, err := client.SMembers(ctx, apiKeyUsageHours).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api usage hours: %w", err)
+	}
+
+	var out []domain.APIUsageHour
+	for _, member := range members {
+		unix, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		fields, err := client.HGetAll(ctx, apiKeyUsagePrefix+member).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read api usage: %w", err)
+		}
+
+		byKey := make(map[string]*domain.APIUsageHour)
+		for field, value := range fields {
+			keyID, kind, ok := strings.Cut(field, ":")
+			if !ok {
+				continue
+			}
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			h, ok := byKey[keyID]
+			if !ok {
+				h = &domain.APIUsageHour{KeyID: keyID, Hour: time.Unix(unix, 0).UTC()}
+				byKey[keyID] = h
+			}
+			switch kind {
+			case "requests":
+				h.Requests = n
+			case "rejected":
+				h.Rejected = n
+			}
+		}
+		for _, h := range byKey {
+			out = append(out, *h)
+		}
+	}
+	return out, nil
+}
+
+func (c *RedisAPIUsageCounter) DropUsage(ctx context.Context, hour time.Time) error {
+	member := strconv.FormatInt(hour.UTC().Unix(), 10)
+	pipe := c.redis.GetClient().TxPipeline()
+	pipe.Del(ctx, apiKeyUsagePrefix+member)
+	pipe.SRem(ctx, apiKeyUsageHours, member)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to drop api usage: %w", err)
+	}
+	return nil
+}
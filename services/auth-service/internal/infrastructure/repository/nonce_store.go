@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+// nonceKeyPrefix keys Redis-held nonces, apart from the siwe:nonce: cache kept beside
+// Postgres nonces
+const nonceKeyPrefix = "auth:nonce:"
+
+// useNonceScript deletes the nonce if it was issued for the account, chain and domain,
+// returning 1 when it did. Expired nonces are already gone.
+//
+// KEYS[1] nonce key; ARGV[1] account; ARGV[2] chain; ARGV[3] domain
+var useNonceScript = redislib.NewScript(`
+local payload = redis.call('GET', KEYS[1])
+if not payload then
+	return 0
+end
+local ok, nonce = pcall(cjson.decode, payload)
+if not ok or nonce.account_id ~= ARGV[1] or nonce.chain_id ~= ARGV[2] or nonce.domain ~= ARGV[3] then
+	return 0
+end
+redis.call('DEL', KEYS[1])
+return 1
+`)
+
+// redisNonce is what a nonce key holds
+type redisNonce struct {
+	AccountID string `json:"account_id"`
+	ChainID   string `json:"chain_id"`
+	Domain    string `json:"domain"`
+}
+
+// RedisNonceStore keeps SIWE nonces in Redis, each expiring with the nonce, and consumes
+// them in a single round trip. Nonces do not survive a Redis restart; users then ask for
+// a new one.
+type RedisNonceStore struct {
+	redis *redis.Redis
+}
+
+func NewRedisNonceStore(redis *redis.Redis) domain.NonceStore {
+	return &RedisNonceStore{redis: redis}
+}
+
+func (s *RedisNonceStore) CreateNonce(ctx context.Context, nonce *domain.Nonce) error {
+	ttl := time.Until(nonce.ExpiresAt)
+	if ttl <= 0 {
+		return fmt.Errorf("nonce already expired")
+	}
+	payload, err := json.Marshal(redisNonce{
+		AccountID: nonce.AccountID,
+		ChainID:   string(nonce.ChainID),
+		Domain:    nonce.Domain,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode nonce: %w", err)
+	}
+
+	ok, err := s.redis.GetClient().SetNX(ctx, nonceKeyPrefix+nonce.Value, payload, ttl).Result()
+	if err != nil {
+		return fmt.Errorf("failed to create nonce: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("nonce already exists")
+	}
+	return nil
+}
+
+func (s *RedisNonceStore) TryUseNonce(ctx context.Context, nonceValue, accountID, chainID, domain string, usedAt time.Time) (bool, error) {
+	used, err := useNonceScript.Run(ctx, s.redis.GetClient(), []string{nonceKeyPrefix + nonceValue}, accountID, chainID, domain).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to use nonce: %w", err)
+	}
+	return used == 1, nil
+}
@@ -8,17 +8,19 @@ import (
 
 	"github.com/lib/pq"
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/encryption"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
 )
 
 type Repository struct {
-	postgres *postgres.Postgres
-	redis    *redis.Redis
+	postgres      *postgres.Postgres
+	redis         *redis.Redis
+	contextCipher *encryption.ContextCipher
 }
 
-func NewAuthRepository(postgres *postgres.Postgres, redis *redis.Redis) domain.AuthRepository {
-	return &Repository{postgres: postgres, redis: redis}
+func NewAuthRepository(postgres *postgres.Postgres, redis *redis.Redis, contextCipher *encryption.ContextCipher) domain.AuthRepository {
+	return &Repository{postgres: postgres, redis: redis, contextCipher: contextCipher}
 }
 
 // Nonce operations
@@ -122,10 +124,26 @@ func (r *Repository) TryUseNonce(ctx context.Context, nonceValue, accountID, cha
 
 func (r *Repository) CreateSession(ctx context.Context, session *domain.Session) error {
 	query := `
-		INSERT INTO sessions (session_id, user_id, device_id, refresh_hash, ip_address, user_agent, created_at, expires_at, last_used_at, collection_intent_context)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO sessions (session_id, user_id, device_id, refresh_hash, ip_address, user_agent, created_at, expires_at, last_used_at, collection_intent_context_enc, issuer, impersonator_id, impersonation_reason,
+		                      geo_country, geo_city, wallet_link_pending)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''), NULLIF($12, '')::uuid, NULLIF($13, ''), NULLIF($14, ''), NULLIF($15, ''), $16)
 	`
 
+	var country, city string
+	if session.Location != nil {
+		country, city = session.Location.Country, session.Location.City
+	}
+
+	// Collection context is only ever stored sealed
+	var sealedContext []byte
+	if session.CollectionIntentContext != nil {
+		var err error
+		sealedContext, err = r.contextCipher.Seal([]byte(*session.CollectionIntentContext), session.ID)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt collection context: %w", err)
+		}
+	}
+
 	_, err := r.postgres.GetClient().ExecContext(ctx, query,
 		session.ID,
 		session.UserID,
@@ -136,7 +154,13 @@ func (r *Repository) CreateSession(ctx context.Context, session *domain.Session)
 		session.CreatedAt,
 		session.ExpiresAt,
 		session.LastUsedAt,
-		session.CollectionIntentContext,
+		sealedContext,
+		session.Issuer,
+		session.ImpersonatorID,
+		session.ImpersonationReason,
+		country,
+		city,
+		session.WalletLinkPending,
 	)
 
 	if err != nil {
@@ -148,7 +172,9 @@ func (r *Repository) CreateSession(ctx context.Context, session *domain.Session)
 
 func (r *Repository) GetSession(ctx context.Context, sessionID domain.SessionID) (*domain.Session, error) {
 	query := `
-		SELECT session_id, user_id, device_id, refresh_hash, ip_address, user_agent, created_at, expires_at, revoked_at, last_used_at
+		SELECT session_id, user_id, device_id, refresh_hash, ip_address, user_agent, created_at, expires_at, revoked_at, last_used_at, COALESCE(issuer, ''),
+		       COALESCE(impersonator_id::text, ''), COALESCE(impersonation_reason, ''),
+		       COALESCE(geo_country, ''), COALESCE(geo_city, ''), wallet_link_pending
 		FROM sessions
 		WHERE session_id = $1 AND revoked_at IS NULL
 	`
@@ -156,6 +182,7 @@ func (r *Repository) GetSession(ctx context.Context, sessionID domain.SessionID)
 	row := r.postgres.GetClient().QueryRowContext(ctx, query, sessionID)
 
 	var session domain.Session
+	var country, city string
 	err := row.Scan(
 		&session.ID,
 		&session.UserID,
@@ -167,6 +194,12 @@ func (r *Repository) GetSession(ctx context.Context, sessionID domain.SessionID)
 		&session.ExpiresAt,
 		&session.RevokedAt,
 		&session.LastUsedAt,
+		&session.Issuer,
+		&session.ImpersonatorID,
+		&session.ImpersonationReason,
+		&country,
+		&city,
+		&session.WalletLinkPending,
 	)
 
 	if err == sql.ErrNoRows {
@@ -176,12 +209,15 @@ func (r *Repository) GetSession(ctx context.Context, sessionID domain.SessionID)
 		return nil, fmt.Errorf("failed to get session: %w", err)
 	}
 
+	session.Location = sessionLocation(country, city)
 	return &session, nil
 }
 
 func (r *Repository) GetSessionByRefreshHash(ctx context.Context, refreshHash string) (*domain.Session, error) {
 	query := `
-		SELECT session_id, user_id, device_id, refresh_hash, ip_address, user_agent, created_at, expires_at, revoked_at, last_used_at
+		SELECT session_id, user_id, device_id, refresh_hash, ip_address, user_agent, created_at, expires_at, revoked_at, last_used_at, COALESCE(issuer, ''),
+		       COALESCE(impersonator_id::text, ''), COALESCE(impersonation_reason, ''),
+		       COALESCE(geo_country, ''), COALESCE(geo_city, ''), wallet_link_pending
 		FROM sessions
 		WHERE refresh_hash = $1 AND revoked_at IS NULL AND expires_at > now()
 	`
@@ -189,6 +225,7 @@ func (r *Repository) GetSessionByRefreshHash(ctx context.Context, refreshHash st
 	row := r.postgres.GetClient().QueryRowContext(ctx, query, refreshHash)
 
 	var session domain.Session
+	var country, city string
 	err := row.Scan(
 		&session.ID,
 		&session.UserID,
@@ -200,6 +237,12 @@ func (r *Repository) GetSessionByRefreshHash(ctx context.Context, refreshHash st
 		&session.ExpiresAt,
 		&session.RevokedAt,
 		&session.LastUsedAt,
+		&session.Issuer,
+		&session.ImpersonatorID,
+		&session.ImpersonationReason,
+		&country,
+		&city,
+		&session.WalletLinkPending,
 	)
 
 	if err == sql.ErrNoRows {
@@ -209,6 +252,7 @@ func (r *Repository) GetSessionByRefreshHash(ctx context.Context, refreshHash st
 		return nil, fmt.Errorf("failed to get session by refresh hash: %w", err)
 	}
 
+	session.Location = sessionLocation(country, city)
 	return &session, nil
 }
 
@@ -259,3 +303,164 @@ func (r *Repository) RevokeSession(ctx context.Context, sessionID domain.Session
 
 	return nil
 }
+
+func (r *Repository) ListActiveSessions(ctx context.Context, userID domain.UserID) ([]*domain.Session, error) {
+	query := `
+		SELECT session_id, user_id, device_id, refresh_hash, ip_address, user_agent, created_at, expires_at, revoked_at, last_used_at, COALESCE(issuer, ''),
+		       COALESCE(impersonator_id::text, ''), COALESCE(impersonation_reason, ''),
+		       COALESCE(geo_country, ''), COALESCE(geo_city, '')
+		FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > now()
+		ORDER BY COALESCE(last_used_at, created_at), created_at
+	`
+
+	rows, err := r.postgres.GetClient().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*domain.Session
+	for rows.Next() {
+		var session domain.Session
+		var country, city string
+		if err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.DeviceID,
+			&session.RefreshHash,
+			&session.IP,
+			&session.UA,
+			&session.CreatedAt,
+			&session.ExpiresAt,
+			&session.RevokedAt,
+			&session.LastUsedAt,
+			&session.Issuer,
+			&session.ImpersonatorID,
+			&session.ImpersonationReason,
+			&country,
+			&city,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		session.Location = sessionLocation(country, city)
+		sessions = append(sessions, &session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func (r *Repository) ListLoginLocations(ctx context.Context, userID domain.UserID) ([]domain.Location, error) {
+	query := `
+		SELECT DISTINCT geo_country, COALESCE(geo_city, '')
+		FROM sessions
+		WHERE user_id = $1 AND impersonator_id IS NULL AND geo_country IS NOT NULL
+	`
+
+	rows, err := r.postgres.GetClient().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list login locations: %w", err)
+	}
+	defer rows.Close()
+
+	var locations []domain.Location
+	for rows.Next() {
+		var location domain.Location
+		if err := rows.Scan(&location.Country, &location.City); err != nil {
+			return nil, fmt.Errorf("failed to scan login location: %w", err)
+		}
+		locations = append(locations, location)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list login locations: %w", err)
+	}
+
+	return locations, nil
+}
+
+// sessionLocation is nil for sessions stored without a location
+func sessionLocation(country, city string) *domain.Location {
+	if country == "" {
+		return nil
+	}
+	return &domain.Location{Country: country, City: city}
+}
+
+// EncryptLegacyCollectionContexts seals collection contexts written as plain JSONB before
+// encryption was introduced and clears the plaintext, batchSize rows per transaction.
+// It is safe to run from several instances at once.
+func (r *Repository) EncryptLegacyCollectionContexts(ctx context.Context, batchSize int) (int, error) {
+	total := 0
+	for {
+		n, err := r.encryptLegacyContextBatch(ctx, batchSize)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < batchSize {
+			return total, nil
+		}
+	}
+}
+
+func (r *Repository) encryptLegacyContextBatch(ctx context.Context, batchSize int) (int, error) {
+	tx, err := r.postgres.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT session_id, collection_intent_context::text
+		FROM sessions
+		WHERE collection_intent_context IS NOT NULL
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select legacy collection contexts: %w", err)
+	}
+
+	type legacyContext struct {
+		sessionID string
+		plaintext string
+	}
+	var batch []legacyContext
+	for rows.Next() {
+		var c legacyContext
+		if err := rows.Scan(&c.sessionID, &c.plaintext); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan legacy collection context: %w", err)
+		}
+		batch = append(batch, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate legacy collection contexts: %w", err)
+	}
+
+	for _, c := range batch {
+		sealed, err := r.contextCipher.Seal([]byte(c.plaintext), c.sessionID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encrypt collection context: %w", err)
+		}
+		// A row that already has a sealed copy keeps it; the plaintext goes either way
+		_, err = tx.ExecContext(ctx, `
+			UPDATE sessions
+			SET collection_intent_context_enc = COALESCE(collection_intent_context_enc, $2),
+			    collection_intent_context = NULL
+			WHERE session_id = $1
+		`, c.sessionID, sealed)
+		if err != nil {
+			return 0, fmt.Errorf("failed to store encrypted collection context: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return len(batch), nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// WalletLinkOutbox keeps deferred wallet links in the wallet_link_outbox table
+type WalletLinkOutbox struct {
+	postgres *postgres.Postgres
+}
+
+// NewWalletLinkOutbox creates a Postgres-backed wallet link outbox
+func NewWalletLinkOutbox(postgres *postgres.Postgres) *WalletLinkOutbox {
+	return &WalletLinkOutbox{postgres: postgres}
+}
+
+var _ domain.WalletLinkOutbox = (*WalletLinkOutbox)(nil)
+
+func (o *WalletLinkOutbox) EnqueueWalletLink(ctx context.Context, link *domain.PendingWalletLink) error {
+	query := `
+		INSERT INTO wallet_link_outbox (id, user_id, account_id, address, chain_id, session_id, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id, address, chain_id) DO UPDATE
+		SET session_id = EXCLUDED.session_id, next_attempt_at = EXCLUDED.next_attempt_at
+	`
+	_, err := o.postgres.GetClient().ExecContext(ctx, query,
+		link.ID, link.UserID, link.AccountID, link.Address, link.ChainID, link.SessionID, link.NextAttemptAt, link.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to queue wallet link: %w", err)
+	}
+	return nil
+}
+
+func (o *WalletLinkOutbox) ClaimDueWalletLinks(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.PendingWalletLink, error) {
+	query := `
+		UPDATE wallet_link_outbox
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM wallet_link_outbox
+			WHERE next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, user_id, account_id, address, chain_id, session_id, attempts, next_attempt_at, created_at
+	`
+	rows, err := o.postgres.GetClient().QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim wallet links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*domain.PendingWalletLink
+	for rows.Next() {
+		var link domain.PendingWalletLink
+		if err := rows.Scan(&link.ID, &link.UserID, &link.AccountID, &link.Address, &link.ChainID, &link.SessionID,
+			&link.Attempts, &link.NextAttemptAt, &link.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan wallet link: %w", err)
+		}
+		links = append(links, &link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to claim wallet links: %w", err)
+	}
+	return links, nil
+}
+
+func (o *WalletLinkOutbox) CompleteWalletLink(ctx context.Context, link *domain.PendingWalletLink) error {
+	tx, err := o.postgres.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM wallet_link_outbox WHERE id = $1`, link.ID); err != nil {
+		return fmt.Errorf("failed to drop wallet link: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE sessions SET wallet_link_pending = FALSE
+		WHERE user_id = $1 AND wallet_link_pending
+		  AND NOT EXISTS (SELECT 1 FROM wallet_link_outbox WHERE user_id = $1)
+	`, link.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to clear pending wallet link: %w", err)
+	}
+	return tx.Commit()
+}
+
+func (o *WalletLinkOutbox) RetryWalletLink(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	query := `
+		UPDATE wallet_link_outbox
+		SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3
+		WHERE id = $1
+	`
+	if _, err := o.postgres.GetClient().ExecContext(ctx, query, id, nextAttemptAt, lastError); err != nil {
+		return fmt.Errorf("failed to reschedule wallet link: %w", err)
+	}
+	return nil
+}
+
+func (o *WalletLinkOutbox) CountPendingWalletLinks(ctx context.Context) (int, error) {
+	var n int
+	if err := o.postgres.GetClient().QueryRowContext(ctx, `SELECT count(*) FROM wallet_link_outbox`).Scan(&n); err != nil {
+		return 0, fmt.Errorf("failed to count wallet links: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+)
+
+const (
+	// apiKeySecretPrefix starts every key secret, so leaked keys are easy to scan for
+	apiKeySecretPrefix = "zk_"
+	// apiKeyShownPrefix is how much of the secret is kept to tell keys apart
+	apiKeyShownPrefix  = len(apiKeySecretPrefix) + 8
+	maxAPIKeysPerUser  = 10
+	maxAPIKeyNameLen   = 64
+	maxAPIUsageDays    = 30
+	defaultAPIUsageDay = 7
+	// apiKeyCacheTTL is how long a replica trusts a looked-up key; revoking a key or
+	// changing its plan reaches other replicas within it
+	apiKeyCacheTTL = time.Minute
+)
+
+// apiKeys is the state of API key authorization
+type apiKeys struct {
+	repo    domain.APIKeyRepository
+	counter domain.APIUsageCounter
+	plans   map[domain.APIPlan]domain.APIPlanLimits
+
+	mu    sync.Mutex
+	cache map[string]cachedAPIKey // by secret hash
+}
+
+type cachedAPIKey struct {
+	key     *domain.APIKey
+	expires time.Time
+}
+
+// SetAPIKeys enables API keys, kept in repo and counted in counter. Every plan needs limits.
+func (s *Service) SetAPIKeys(repo domain.APIKeyRepository, counter domain.APIUsageCounter, plans []domain.APIPlanLimits) error {
+	byPlan := make(map[domain.APIPlan]domain.APIPlanLimits, len(plans))
+	for _, limits := range plans {
+		if !validAPIPlan(limits.Plan) {
+			return fmt.Errorf("unknown api plan %q", limits.Plan)
+		}
+		if limits.Enforcement != domain.QuotaHard && limits.Enforcement != domain.QuotaSoft {
+			return fmt.Errorf("api plan %s: enforcement must be hard or soft, got %q", limits.Plan, limits.Enforcement)
+		}
+		if limits.DailyQuota < 0 || limits.BurstPerSecond < 0 {
+			return fmt.Errorf("api plan %s: limits must not be negative", limits.Plan)
+		}
+		byPlan[limits.Plan] = limits
+	}
+	for _, plan := range domain.APIPlans {
+		if _, ok := byPlan[plan]; !ok {
+			return fmt.Errorf("api plan %s has no limits", plan)
+		}
+	}
+	s.apiKeys = &apiKeys{repo: repo, counter: counter, plans: byPlan, cache: make(map[string]cachedAPIKey)}
+	return nil
+}
+
+func validAPIPlan(plan domain.APIPlan) bool {
+	for _, p := range domain.APIPlans {
+		if p == plan {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateAPIKey issues a free-plan key for the user. The secret is returned once; only its
+// hash is stored.
+func (s *Service) CreateAPIKey(ctx context.Context, userID, name string) (*domain.CreatedAPIKey, error) {
+	if s.apiKeys == nil {
+		return nil, domain.ErrAPIKeysDisabled
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxAPIKeyNameLen {
+		return nil, domain.ErrAPIKeyName
+	}
+	active, err := s.apiKeys.repo.CountActiveAPIKeys(ctx, domain.UserID(userID))
+	if err != nil {
+		return nil, err
+	}
+	if active >= maxAPIKeysPerUser {
+		return nil, domain.ErrAPIKeyLimit
+	}
+
+	secret := apiKeySecretPrefix + s.generateRefreshToken()
+	key := &domain.APIKey{
+		ID:        uuid.New().String(),
+		UserID:    domain.UserID(userID),
+		Name:      name,
+		Prefix:    secret[:apiKeyShownPrefix],
+		Plan:      domain.APIPlanFree,
+		CreatedAt: time.Now(),
+	}
+	if err := s.apiKeys.repo.CreateAPIKey(ctx, key, s.hashRefreshToken(secret)); err != nil {
+		return nil, err
+	}
+
+	log.Printf("audit|event=api_key_created|user_id=%s|key_id=%s|timestamp=%s",
+		userID, key.ID, key.CreatedAt.UTC().Format(time.RFC3339Nano))
+	return &domain.CreatedAPIKey{Key: key, Secret: secret}, nil
+}
+
+func (s *Service) ListAPIKeys(ctx context.Context, userID string) ([]*domain.APIKey, error) {
+	if s.apiKeys == nil {
+		return nil, domain.ErrAPIKeysDisabled
+	}
+	return s.apiKeys.repo.ListAPIKeys(ctx, domain.UserID(userID))
+}
+
+func (s *Service) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	if s.apiKeys == nil {
+		return domain.ErrAPIKeysDisabled
+	}
+	if _, err := uuid.Parse(keyID); err != nil {
+		return domain.ErrAPIKeyNotFound
+	}
+	now := time.Now()
+	if err := s.apiKeys.repo.RevokeAPIKey(ctx, domain.UserID(userID), keyID, now); err != nil {
+		return err
+	}
+	s.apiKeys.forget(keyID)
+
+	log.Printf("audit|event=api_key_revoked|user_id=%s|key_id=%s|timestamp=%s",
+		userID, keyID, now.UTC().Format(time.RFC3339Nano))
+	return nil
+}
+
+// SetAPIKeyPlan moves a key to another plan; only admins may
+func (s *Service) SetAPIKeyPlan(ctx context.Context, adminUserID, keyID string, plan domain.APIPlan) (*domain.APIKey, error) {
+	if s.apiKeys == nil {
+		return nil, domain.ErrAPIKeysDisabled
+	}
+	if !s.isAdmin(adminUserID) {
+		return nil, domain.ErrAPIPlanForbidden
+	}
+	if !validAPIPlan(plan) {
+		return nil, domain.ErrAPIPlanInvalid
+	}
+	if _, err := uuid.Parse(keyID); err != nil {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	key, err := s.apiKeys.repo.SetAPIKeyPlan(ctx, keyID, plan)
+	if err != nil {
+		return nil, err
+	}
+	s.apiKeys.forget(keyID)
+
+	log.Printf("audit|event=api_key_plan_changed|admin_id=%s|user_id=%s|key_id=%s|plan=%s|timestamp=%s",
+		adminUserID, key.UserID, keyID, plan, time.Now().UTC().Format(time.RFC3339Nano))
+	return key, nil
+}
+
+// AuthorizeAPIKey counts a request made with secret against its key's plan. A refused
+// request is a decision, not an error; unknown and revoked keys are ErrAPIKeyInvalid.
+func (s *Service) AuthorizeAPIKey(ctx context.Context, secret string) (*domain.APIKeyDecision, error) {
+	if s.apiKeys == nil {
+		return nil, domain.ErrAPIKeysDisabled
+	}
+	if !strings.HasPrefix(secret, apiKeySecretPrefix) {
+		return nil, domain.ErrAPIKeyInvalid
+	}
+	key, err := s.apiKeys.lookup(ctx, s.hashRefreshToken(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	decision, err := s.apiKeys.counter.Hit(ctx, key.ID, s.apiKeys.plans[key.Plan], time.Now())
+	if err != nil {
+		return nil, err
+	}
+	decision.Key = key
+	return decision, nil
+}
+
+// lookup finds the unrevoked key with the secret hash, from cache when it can
+func (k *apiKeys) lookup(ctx context.Context, hash string) (*domain.APIKey, error) {
+	now := time.Now()
+	k.mu.Lock()
+	cached, ok := k.cache[hash]
+	k.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.key, nil
+	}
+
+	key, err := k.repo.GetAPIKeyByHash(ctx, hash)
+	if errors.Is(err, domain.ErrAPIKeyNotFound) {
+		return nil, domain.ErrAPIKeyInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k.mu.Lock()
+	k.cache[hash] = cachedAPIKey{key: key, expires: now.Add(apiKeyCacheTTL)}
+	for h, c := range k.cache {
+		if now.After(c.expires) {
+			delete(k.cache, h)
+		}
+	}
+	k.mu.Unlock()
+	return key, nil
+}
+
+// forget drops a changed key from this replica's cache
+func (k *apiKeys) forget(keyID string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	for h, c := range k.cache {
+		if c.key.ID == keyID {
+			delete(k.cache, h)
+		}
+	}
+}
+
+// GetAPIUsage returns the plan, today's use and the last days of hourly usage of each of
+// the user's keys. Hours not yet persisted are read from the live counters.
+func (s *Service) GetAPIUsage(ctx context.Context, userID string, days int) ([]*domain.APIKeyUsage, error) {
+	if s.apiKeys == nil {
+		return nil, domain.ErrAPIKeysDisabled
+	}
+	if days <= 0 {
+		days = defaultAPIUsageDay
+	}
+	days = min(days, maxAPIUsageDays)
+
+	keys, err := s.apiKeys.repo.ListAPIKeys(ctx, domain.UserID(userID))
+	if err != nil || len(keys) == 0 {
+		return nil, err
+	}
+	ids := make([]string, len(keys))
+	hours := make(map[string]map[int64]domain.APIUsageHour, len(keys))
+	for i, key := range keys {
+		ids[i] = key.ID
+		hours[key.ID] = make(map[int64]domain.APIUsageHour)
+	}
+
+	now := time.Now().UTC()
+	since := now.Truncate(time.Hour).Add(-time.Duration(days) * 24 * time.Hour)
+	persisted, err := s.apiKeys.repo.ListAPIUsage(ctx, ids, since)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := s.apiKeys.counter.PendingUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Pending counts win: an hour still in Redis may have been persisted before it ended
+	for _, h := range append(persisted, pending...) {
+		byHour, ok := hours[h.KeyID]
+		if !ok || h.Hour.Before(since) {
+			continue
+		}
+		byHour[h.Hour.Unix()] = h
+	}
+
+	usage := make([]*domain.APIKeyUsage, 0, len(keys))
+	for _, key := range keys {
+		u := &domain.APIKeyUsage{Key: key, Limits: s.apiKeys.plans[key.Plan]}
+		if u.UsedToday, err = s.apiKeys.counter.UsedToday(ctx, key.ID, now); err != nil {
+			return nil, err
+		}
+		for _, h := range hours[key.ID] {
+			u.Hours = append(u.Hours, h)
+		}
+		sort.Slice(u.Hours, func(i, j int) bool { return u.Hours[i].Hour.Before(u.Hours[j].Hour) })
+		usage = append(usage, u)
+	}
+	return usage, nil
+}
+
+// RunAPIUsageFlusher persists finished hours of API key usage every interval until ctx is done
+func (s *Service) RunAPIUsageFlusher(ctx context.Context, interval time.Duration) {
+	if s.apiKeys == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.FlushAPIUsage(ctx, time.Now()); err != nil {
+				log.Printf("Failed to persist api key usage: %v", err)
+			}
+		}
+	}
+}
+
+// FlushAPIUsage persists the counted hours that ended by now, then drops them from the
+// counters, and returns how many key-hours it saved. Replicas flushing the same hour save
+// the same counts.
+func (s *Service) FlushAPIUsage(ctx context.Context, now time.Time) (int, error) {
+	if s.apiKeys == nil {
+		return 0, nil
+	}
+	pending, err := s.apiKeys.counter.PendingUsage(ctx)
+	if err != nil {
+		return 0, err
+	}
+	current := now.UTC().Truncate(time.Hour)
+	var done []domain.APIUsageHour
+	ended := make(map[int64]time.Time)
+	for _, h := range pending {
+		if h.Hour.Before(current) {
+			done = append(done, h)
+			ended[h.Hour.Unix()] = h.Hour
+		}
+	}
+	if err := s.apiKeys.repo.SaveAPIUsage(ctx, done); err != nil {
+		return 0, err
+	}
+	for _, hour := range ended {
+		if err := s.apiKeys.counter.DropUsage(ctx, hour); err != nil {
+			return len(done), err
+		}
+	}
+	return len(done), nil
+}
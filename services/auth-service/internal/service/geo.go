@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+)
+
+// SetGeoLocation enables geo-IP enrichment of sessions and login events. In country mode
+// only the country is kept: session IPs are dropped and cities are never stored.
+func (s *Service) SetGeoLocation(locator domain.GeoLocator, mode domain.GeoPrivacyMode) error {
+	switch mode {
+	case domain.GeoPrivacyCity, domain.GeoPrivacyCountry:
+	default:
+		return fmt.Errorf("unknown geo privacy mode %q", mode)
+	}
+	s.geoLocator = locator
+	s.geoPrivacy = mode
+	return nil
+}
+
+// applyClient records on the session where the login came from. A failed lookup costs
+// the location, never the login.
+func (s *Service) applyClient(ctx context.Context, session *domain.Session, client domain.ClientInfo) {
+	if ua := strings.TrimSpace(client.UserAgent); ua != "" {
+		session.UA = &ua
+	}
+
+	ip := parseClientIP(client.IP)
+	if ip == nil {
+		return
+	}
+	if s.geoPrivacy != domain.GeoPrivacyCountry {
+		addr := ip.String()
+		session.IP = &addr
+	}
+	if s.geoLocator == nil {
+		return
+	}
+
+	location, err := s.geoLocator.Locate(ctx, ip)
+	if err != nil {
+		log.Printf("Geo-IP lookup failed for session %s: %v", session.ID, err)
+		return
+	}
+	if location == nil || location.Country == "" {
+		return
+	}
+	if s.geoPrivacy == domain.GeoPrivacyCountry {
+		location = &domain.Location{Country: location.Country}
+	}
+	session.Location = location
+}
+
+// isNewLocation reports whether the user has logged in before, but never from location.
+// Past sessions match at the precision they were stored with, so a country-only session
+// covers every city of its country.
+func (s *Service) isNewLocation(ctx context.Context, userID domain.UserID, location *domain.Location) bool {
+	if location == nil {
+		return false
+	}
+
+	known, err := s.authRepo.ListLoginLocations(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to load login locations of user %s: %v", userID, err)
+		return false
+	}
+	if len(known) == 0 {
+		return false
+	}
+
+	for _, k := range known {
+		if k.Country == location.Country && (k.City == "" || location.City == "" || strings.EqualFold(k.City, location.City)) {
+			return false
+		}
+	}
+	return true
+}
+
+// locationAudit formats a location for the audit log; empty when there is none
+func locationAudit(location *domain.Location) string {
+	if location == nil {
+		return ""
+	}
+	if location.City == "" {
+		return "|country=" + location.Country
+	}
+	return fmt.Sprintf("|country=%s|city=%s", location.Country, location.City)
+}
+
+// parseClientIP accepts a bare IP or host:port, as the gateway takes it from X-Real-IP,
+// X-Forwarded-For or the remote address
+func parseClientIP(raw string) net.IP {
+	raw = strings.TrimSpace(raw)
+	if host, _, err := net.SplitHostPort(raw); err == nil {
+		raw = host
+	}
+	return net.ParseIP(raw)
+}
@@ -12,8 +12,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/spruceid/siwe-go"
@@ -32,9 +30,23 @@ type Service struct {
 	publisher               domain.AuthEventPublisher       // event publisher
 	jwtSecret               []byte
 	refreshSecret           []byte
+	issuer                  string
+	audience                string
+	acceptedIssuers         []string
 	nonceTTL                time.Duration
 	sessionTTL              time.Duration
 	enableCollectionContext bool
+	adminUserIDs            []string
+	impersonationTTL        time.Duration
+	maxSessions             int
+	sessionLimitPolicy      domain.SessionLimitPolicy
+	geoLocator              domain.GeoLocator // nil leaves sessions unlocated
+	geoPrivacy              domain.GeoPrivacyMode
+	subscriptionTickets     domain.SubscriptionTicketStore // nil disables subscription tickets
+	nonceStore              domain.NonceStore              // nil keeps nonces in authRepo
+	walletLinks             *walletLinks                   // nil fails logins while wallet-service is down
+	contractWalletLogins    bool                           // EIP-1271 logins through wallet-service
+	apiKeys                 *apiKeys                       // nil disables API keys
 }
 
 func NewAuthService(
@@ -52,12 +64,40 @@ func NewAuthService(
 		publisher:               publisher,
 		jwtSecret:               jwtSecret,
 		refreshSecret:           refreshSecret,
+		issuer:                  domain.DefaultTokenIssuer,
+		audience:                domain.DefaultTokenAudience,
+		acceptedIssuers:         []string{domain.DefaultTokenIssuer},
 		nonceTTL:                5 * time.Minute,
 		sessionTTL:              24 * time.Hour,
 		enableCollectionContext: enableCollectionContext,
+		impersonationTTL:        DefaultImpersonationTTL,
+		sessionLimitPolicy:      domain.SessionLimitEvictLRU,
+		geoPrivacy:              domain.GeoPrivacyCity,
 	}
 }
 
+// SetNonceStore keeps SIWE nonces in store instead of the auth repository
+func (s *Service) SetNonceStore(store domain.NonceStore) {
+	s.nonceStore = store
+}
+
+// nonces is where SIWE nonces are kept
+func (s *Service) nonces() domain.NonceStore {
+	if s.nonceStore != nil {
+		return s.nonceStore
+	}
+	return s.authRepo
+}
+
+// SetTokenIdentity sets the issuer and audience stamped on access tokens. Sessions from
+// acceptedIssuers can still refresh, which lets environments migrate issuers without
+// logging everyone out; the current issuer is always accepted.
+func (s *Service) SetTokenIdentity(issuer, audience string, acceptedIssuers []string) {
+	s.issuer = issuer
+	s.audience = audience
+	s.acceptedIssuers = append([]string{issuer}, acceptedIssuers...)
+}
+
 func (s *Service) GetNonce(ctx context.Context, accountID, chainID, domainName string) (string, error) {
 	// Validate inputs
 	if err := s.validateGetNonceInputs(accountID, chainID, domainName); err != nil {
@@ -82,15 +122,15 @@ func (s *Service) GetNonce(ctx context.Context, accountID, chainID, domainName s
 		CreatedAt: now,
 	}
 
-	// Store nonce in repository
-	if err := s.authRepo.CreateNonce(ctx, nonceRecord); err != nil {
+	// Store nonce
+	if err := s.nonces().CreateNonce(ctx, nonceRecord); err != nil {
 		return "", fmt.Errorf("failed to create nonce: %w", err)
 	}
 
 	return nonce, nil
 }
 
-func (s *Service) VerifySiwe(ctx context.Context, accountID, message, signature string) (*domain.AuthResult, error) {
+func (s *Service) VerifySiwe(ctx context.Context, accountID, message, signature string, client domain.ClientInfo) (*domain.AuthResult, error) {
 	// Parse SIWE message
 	siweMessage, err := siwe.ParseMessage(message)
 	if err != nil {
@@ -102,24 +142,16 @@ func (s *Service) VerifySiwe(ctx context.Context, accountID, message, signature
 		return nil, err
 	}
 
-	// Verify signature
-	publicKey, err := siweMessage.VerifyEIP191(signature)
-	if err != nil {
-		return nil, fmt.Errorf("failed to verify signature: %w", err)
-	}
-
-	// Verify the recovered address matches the expected account
-	recoveredAddress := crypto.PubkeyToAddress(*publicKey)
-	expectedAddress := common.HexToAddress(accountID)
-	if recoveredAddress != expectedAddress {
-		return nil, fmt.Errorf("signature verification failed: address mismatch")
+	// Verify the account signed it, with its key or as a contract wallet
+	if err := s.verifySiweSigner(ctx, siweMessage, message, accountID, signature); err != nil {
+		return nil, err
 	}
 
 	// Convert chain ID to string
 	chainIDStr := fmt.Sprintf("eip155:%d", siweMessage.GetChainID())
 
 	// Validate and consume nonce
-	success, err := s.authRepo.TryUseNonce(ctx, siweMessage.GetNonce(), accountID, chainIDStr, siweMessage.GetDomain(), time.Now())
+	success, err := s.nonces().TryUseNonce(ctx, siweMessage.GetNonce(), accountID, chainIDStr, siweMessage.GetDomain(), time.Now())
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate nonce: %w", err)
 	}
@@ -137,31 +169,27 @@ func (s *Service) VerifySiwe(ctx context.Context, accountID, message, signature
 		return nil, fmt.Errorf("failed to ensure user: %w", err)
 	}
 
-	// Link wallet
-	_, err = s.walletService.UpsertLink(ctx, &protoWallet.UpsertLinkRequest{
-		UserId:    userResp.GetUserId(),
-		AccountId: accountID,
-		Address:   strings.ToLower(accountID),
-		ChainId:   chainIDStr,
-		IsPrimary: true,
-	})
+	// Link wallet, queueing the link if wallet-service is unavailable
+	sessionID := uuid.New().String()
+	linkDeferred, err := s.linkWallet(ctx, userResp.GetUserId(), accountID, chainIDStr, domain.SessionID(sessionID))
 	if err != nil {
-		return nil, fmt.Errorf("failed to link wallet: %w", err)
+		return nil, err
 	}
 
 	// Create session
-	sessionID := uuid.New().String()
 	refreshToken := s.generateRefreshToken()
 	refreshHash := s.hashRefreshToken(refreshToken)
 
 	now := time.Now()
 	session := &domain.Session{
-		ID:          domain.SessionID(sessionID),
-		UserID:      domain.UserID(userResp.GetUserId()),
-		RefreshHash: refreshHash,
-		ExpiresAt:   now.Add(s.sessionTTL),
-		CreatedAt:   now,
-		LastUsedAt:  &now,
+		ID:                domain.SessionID(sessionID),
+		UserID:            domain.UserID(userResp.GetUserId()),
+		RefreshHash:       refreshHash,
+		ExpiresAt:         now.Add(s.sessionTTL),
+		CreatedAt:         now,
+		LastUsedAt:        &now,
+		Issuer:            s.issuer,
+		WalletLinkPending: linkDeferred,
 	}
 
 	// Optionally attach collection intent context when feature enabled and header present
@@ -183,21 +211,33 @@ func (s *Service) VerifySiwe(ctx context.Context, accountID, message, signature
 		}
 	}
 
+	s.applyClient(ctx, session, client)
+	// Checked before the session is stored so it does not count as a known location
+	newLocation := s.isNewLocation(ctx, session.UserID, session.Location)
+
+	if err := s.enforceSessionLimit(ctx, session.UserID, session.ID); err != nil {
+		return nil, err
+	}
+
 	if err := s.authRepo.CreateSession(ctx, session); err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
-	// Audit: session create
+	// Audit: session create. The context blob is encrypted at rest, so it never reaches the logs
 	if session.CollectionIntentContext != nil {
-		log.Printf("audit|event=session_create|session_id=%s|user_id=%s|collection_context=%s|timestamp=%s",
-			sessionID, userResp.GetUserId(), *session.CollectionIntentContext, now.UTC().Format(time.RFC3339Nano))
+		log.Printf("audit|event=session_create|session_id=%s|user_id=%s|collection_context=[redacted]%s|timestamp=%s",
+			sessionID, userResp.GetUserId(), locationAudit(session.Location), now.UTC().Format(time.RFC3339Nano))
 	} else {
-		log.Printf("audit|event=session_create|session_id=%s|user_id=%s|timestamp=%s",
-			sessionID, userResp.GetUserId(), now.UTC().Format(time.RFC3339Nano))
+		log.Printf("audit|event=session_create|session_id=%s|user_id=%s%s|timestamp=%s",
+			sessionID, userResp.GetUserId(), locationAudit(session.Location), now.UTC().Format(time.RFC3339Nano))
+	}
+	if newLocation {
+		log.Printf("audit|event=login_new_location|session_id=%s|user_id=%s%s|timestamp=%s",
+			sessionID, userResp.GetUserId(), locationAudit(session.Location), now.UTC().Format(time.RFC3339Nano))
 	}
 
 	// Generate JWT access token
-	accessToken, expiresAt, err := s.generateAccessToken(userResp.GetUserId(), sessionID)
+	accessToken, expiresAt, err := s.generateAccessToken(userResp.GetUserId(), sessionID, s.orgClaims(ctx, userResp.GetUserId()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -206,12 +246,14 @@ func (s *Service) VerifySiwe(ctx context.Context, accountID, message, signature
 	if s.publisher != nil {
 		go func() {
 			_ = s.publisher.PublishUserLoggedIn(context.Background(), &domain.AuthUserLoggedInEvent{
-				UserID:     domain.UserID(userResp.GetUserId()),
-				AccountID:  accountID,
-				Address:    domain.Address(strings.ToLower(accountID)),
-				ChainID:    domain.ChainID(chainIDStr),
-				SessionID:  domain.SessionID(sessionID),
-				LoggedInAt: now,
+				UserID:      domain.UserID(userResp.GetUserId()),
+				AccountID:   accountID,
+				Address:     domain.Address(strings.ToLower(accountID)),
+				ChainID:     domain.ChainID(chainIDStr),
+				SessionID:   domain.SessionID(sessionID),
+				LoggedInAt:  now,
+				Location:    session.Location,
+				NewLocation: newLocation,
 			})
 		}()
 	}
@@ -251,6 +293,16 @@ func (s *Service) Refresh(ctx context.Context, refreshToken string) (*domain.Aut
 		return nil, fmt.Errorf("session has expired")
 	}
 
+	// Sessions from another environment sharing this database or secret never refresh here
+	if !s.acceptsIssuer(session.Issuer) {
+		return nil, fmt.Errorf("session was issued by another environment")
+	}
+
+	// Impersonation sessions end with their access token
+	if session.ImpersonatorID != "" {
+		return nil, domain.ErrImpersonationForbidden
+	}
+
 	// Update session last used timestamp
 	if err := s.authRepo.UpdateSessionLastUsed(ctx, session.ID); err != nil {
 		// Log warning but don't fail the refresh operation
@@ -258,7 +310,8 @@ func (s *Service) Refresh(ctx context.Context, refreshToken string) (*domain.Aut
 	}
 
 	// Generate new access token
-	accessToken, expiresAt, err := s.generateAccessToken(string(session.UserID), string(session.ID))
+	// Org claims are reloaded so membership changes apply from the next refresh
+	accessToken, expiresAt, err := s.generateAccessToken(string(session.UserID), string(session.ID), s.orgClaims(ctx, string(session.UserID)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate new access token: %w", err)
 	}
@@ -296,11 +349,20 @@ func (s *Service) Logout(ctx context.Context, sessionID string) error {
 		return fmt.Errorf("invalid session ID format: %w", err)
 	}
 
+	// Look up the owner first; revoked sessions are no longer readable
+	var userID domain.UserID
+	if s.publisher != nil {
+		if session, err := s.authRepo.GetSession(ctx, domain.SessionID(sessionID)); err == nil {
+			userID = session.UserID
+		}
+	}
+
 	// Revoke the session
 	if err := s.authRepo.RevokeSession(ctx, domain.SessionID(sessionID)); err != nil {
 		return fmt.Errorf("failed to revoke session: %w", err)
 	}
 
+	s.publishSessionRevoked(userID, domain.SessionID(sessionID))
 	return nil
 }
 
@@ -328,9 +390,26 @@ func (s *Service) LogoutByRefreshToken(ctx context.Context, refreshToken string)
 		return fmt.Errorf("failed to revoke session: %w", err)
 	}
 
+	s.publishSessionRevoked(session.UserID, session.ID)
 	return nil
 }
 
+// publishSessionRevoked publishes session.revoked (non-blocking) so the user's other
+// devices learn about the logout
+func (s *Service) publishSessionRevoked(userID domain.UserID, sessionID domain.SessionID) {
+	if s.publisher == nil || userID == "" {
+		return
+	}
+	revokedAt := time.Now()
+	go func() {
+		_ = s.publisher.PublishSessionRevoked(context.Background(), &domain.AuthSessionRevokedEvent{
+			UserID:    userID,
+			SessionID: sessionID,
+			RevokedAt: revokedAt,
+		})
+	}()
+}
+
 // validateGetNonceInputs validates the input parameters for GetNonce
 func (s *Service) validateGetNonceInputs(accountID, chainID, domainName string) error {
 	if accountID == "" {
@@ -436,8 +515,41 @@ func (s *Service) hashRefreshToken(token string) string {
 	return hex.EncodeToString(hash[:])
 }
 
-// generateAccessToken creates a JWT access token
-func (s *Service) generateAccessToken(userID, sessionID string) (string, time.Time, error) {
+// orgClaims maps the user's organization ids to their role. Tokens are still issued
+// without org context when user-service cannot be reached.
+func (s *Service) orgClaims(ctx context.Context, userID string) map[string]string {
+	if s.userService == nil {
+		return nil
+	}
+
+	resp, err := s.userService.ListUserOrganizations(ctx, &protoUser.ListUserOrganizationsRequest{UserId: userID})
+	if err != nil {
+		log.Printf("failed to load organizations for user %s: %v", userID, err)
+		return nil
+	}
+
+	orgs := make(map[string]string, len(resp.GetMemberships()))
+	for _, m := range resp.GetMemberships() {
+		orgs[m.GetOrganization().GetId()] = m.GetRole()
+	}
+	return orgs
+}
+
+// acceptsIssuer reports whether a session's issuer may refresh in this environment
+func (s *Service) acceptsIssuer(issuer string) bool {
+	if issuer == "" {
+		issuer = domain.DefaultTokenIssuer
+	}
+	for _, accepted := range s.acceptedIssuers {
+		if accepted == issuer {
+			return true
+		}
+	}
+	return false
+}
+
+// generateAccessToken creates a JWT access token; orgs becomes the "orgs" claim (org id -> role)
+func (s *Service) generateAccessToken(userID, sessionID string, orgs map[string]string) (string, time.Time, error) {
 	now := time.Now()
 	expiresAt := now.Add(1 * time.Hour) // Access tokens expire in 1 hour
 
@@ -446,7 +558,11 @@ func (s *Service) generateAccessToken(userID, sessionID string) (string, time.Ti
 		"session_id": sessionID,
 		"iat":        now.Unix(),
 		"exp":        expiresAt.Unix(),
-		"iss":        "nft-marketplace-auth",
+		"iss":        s.issuer,
+		"aud":        s.audience,
+	}
+	if len(orgs) > 0 {
+		claims["orgs"] = orgs
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
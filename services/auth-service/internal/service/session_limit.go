@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+)
+
+// SetSessionLimit caps how many sessions a user keeps at once and what a login over the cap
+// does. A max of 0 leaves sessions unlimited.
+func (s *Service) SetSessionLimit(max int, policy domain.SessionLimitPolicy) error {
+	if max < 0 {
+		return fmt.Errorf("max concurrent sessions cannot be negative: %d", max)
+	}
+	switch policy {
+	case domain.SessionLimitReject, domain.SessionLimitEvictLRU:
+	default:
+		return fmt.Errorf("unknown session limit policy %q", policy)
+	}
+	s.maxSessions = max
+	s.sessionLimitPolicy = policy
+	return nil
+}
+
+// enforceSessionLimit makes room for newSessionID before it is created. Impersonation
+// sessions belong to the admin, so they neither count nor get evicted. Two logins racing
+// each other can briefly leave the user one session over the cap; the next login trims it.
+func (s *Service) enforceSessionLimit(ctx context.Context, userID domain.UserID, newSessionID domain.SessionID) error {
+	if s.maxSessions == 0 {
+		return nil
+	}
+
+	sessions, err := s.authRepo.ListActiveSessions(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+	own := sessions[:0]
+	for _, session := range sessions {
+		if session.ImpersonatorID == "" {
+			own = append(own, session)
+		}
+	}
+
+	excess := len(own) - s.maxSessions + 1
+	if excess <= 0 {
+		return nil
+	}
+	if s.sessionLimitPolicy == domain.SessionLimitReject {
+		return domain.ErrSessionLimitReached
+	}
+
+	for _, session := range own[:excess] {
+		if err := s.authRepo.RevokeSession(ctx, session.ID); err != nil {
+			return fmt.Errorf("failed to evict session %s: %w", session.ID, err)
+		}
+		evictedAt := time.Now()
+		log.Printf("audit|event=session_evicted|session_id=%s|user_id=%s|evicted_by=%s|timestamp=%s",
+			session.ID, userID, newSessionID, evictedAt.UTC().Format(time.RFC3339Nano))
+		s.publishSessionEvicted(userID, session.ID, newSessionID, evictedAt)
+	}
+	return nil
+}
+
+// publishSessionEvicted publishes session.evicted (non-blocking) so the evicted device
+// learns it was signed out
+func (s *Service) publishSessionEvicted(userID domain.UserID, sessionID, evictedBy domain.SessionID, evictedAt time.Time) {
+	if s.publisher == nil {
+		return
+	}
+	go func() {
+		_ = s.publisher.PublishSessionEvicted(context.Background(), &domain.AuthSessionEvictedEvent{
+			UserID:    userID,
+			SessionID: sessionID,
+			EvictedBy: evictedBy,
+			EvictedAt: evictedAt,
+		})
+	}()
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+)
+
+// ValidateSession returns the session if it is an unrevoked, unexpired session of userID,
+// and ErrSessionInactive otherwise
+func (s *Service) ValidateSession(ctx context.Context, userID, sessionID string) (*domain.Session, error) {
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return nil, domain.ErrSessionInactive
+	}
+
+	session, err := s.authRepo.GetSession(ctx, domain.SessionID(sessionID))
+	if err != nil || session.UserID != userID {
+		return nil, domain.ErrSessionInactive
+	}
+	if !session.ExpiresAt.After(time.Now()) {
+		return nil, domain.ErrSessionInactive
+	}
+	return session, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// SetSubscriptionTickets enables subscription tickets, kept in store until redeemed
+func (s *Service) SetSubscriptionTickets(store domain.SubscriptionTicketStore) {
+	s.subscriptionTickets = store
+}
+
+// IssueSubscriptionTicket hands out a random single-use ticket for opening a subscription
+// socket as userID. It is bound to the caller's session and origin and lives for
+// contracts.SubscriptionTicketTTL, so a ticket that leaks into a log is useless by the time
+// anyone reads it.
+func (s *Service) IssueSubscriptionTicket(ctx context.Context, userID, sessionID, origin string) (*domain.SubscriptionTicket, error) {
+	if s.subscriptionTickets == nil {
+		return nil, domain.ErrSubscriptionTicketsDisabled
+	}
+	if _, err := s.ValidateSession(ctx, userID, sessionID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ticket := s.generateRefreshToken()
+	rec := contracts.SubscriptionTicket{
+		UserID:    userID,
+		SessionID: sessionID,
+		Origin:    origin,
+		IssuedAt:  now,
+	}
+	if err := s.subscriptionTickets.StoreSubscriptionTicket(ctx, ticket, rec, contracts.SubscriptionTicketTTL); err != nil {
+		return nil, fmt.Errorf("failed to store subscription ticket: %w", err)
+	}
+
+	log.Printf("audit|event=subscription_ticket_issued|session_id=%s|user_id=%s|origin=%q|timestamp=%s",
+		sessionID, userID, origin, now.UTC().Format(time.RFC3339Nano))
+
+	return &domain.SubscriptionTicket{
+		Ticket:    ticket,
+		ExpiresAt: now.Add(contracts.SubscriptionTicketTTL),
+	}, nil
+}
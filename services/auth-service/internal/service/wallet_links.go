@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+
+	protoWallet "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const (
+	// walletLinkBatch is how many queued links one reconcile pass takes
+	walletLinkBatch = 50
+	// walletLinkLease holds claimed links back from other replicas while they are attempted
+	walletLinkLease = time.Minute
+	// maxWalletLinkBackoff caps the wait between attempts at a queued link
+	maxWalletLinkBackoff = 10 * time.Minute
+)
+
+// walletLinks is the state of deferred wallet linking
+type walletLinks struct {
+	outbox        domain.WalletLinkOutbox
+	retryInterval time.Duration
+	onHealth      func(up bool) // told whether wallet-service answered the last link attempt
+
+	deferred   atomic.Uint64
+	reconciled atomic.Uint64
+	retries    atomic.Uint64
+}
+
+// SetWalletLinkOutbox lets logins go through while wallet-service is unavailable. Their
+// wallet link is queued in outbox, the session is marked for reconciliation and the link is
+// retried every retryInterval, backing off per failed attempt. onHealth, if set, is told
+// whether wallet-service answered each link attempt.
+func (s *Service) SetWalletLinkOutbox(outbox domain.WalletLinkOutbox, retryInterval time.Duration, onHealth func(up bool)) error {
+	if retryInterval <= 0 {
+		return fmt.Errorf("wallet link retry interval must be positive: %s", retryInterval)
+	}
+	s.walletLinks = &walletLinks{outbox: outbox, retryInterval: retryInterval, onHealth: onHealth}
+	return nil
+}
+
+// linkWallet links the login's wallet as the user's primary one. When wallet-service is
+// unavailable and an outbox is set, the link is queued instead and deferred is true; any
+// other failure fails the login.
+func (s *Service) linkWallet(ctx context.Context, userID, accountID, chainID string, sessionID domain.SessionID) (deferred bool, err error) {
+	_, err = s.walletService.UpsertLink(ctx, &protoWallet.UpsertLinkRequest{
+		UserId:    userID,
+		AccountId: accountID,
+		Address:   strings.ToLower(accountID),
+		ChainId:   chainID,
+		IsPrimary: true,
+	})
+	s.reportWalletHealth(err)
+	if err == nil {
+		return false, nil
+	}
+	if s.walletLinks == nil || !walletUnavailable(err) {
+		return false, fmt.Errorf("failed to link wallet: %w", err)
+	}
+
+	now := time.Now()
+	link := &domain.PendingWalletLink{
+		ID:            uuid.New().String(),
+		UserID:        domain.UserID(userID),
+		AccountID:     accountID,
+		Address:       domain.Address(strings.ToLower(accountID)),
+		ChainID:       domain.ChainID(chainID),
+		SessionID:     sessionID,
+		NextAttemptAt: now.Add(s.walletLinks.retryInterval),
+		CreatedAt:     now,
+	}
+	if qerr := s.walletLinks.outbox.EnqueueWalletLink(ctx, link); qerr != nil {
+		return false, fmt.Errorf("failed to link wallet: %w (queueing it failed: %v)", err, qerr)
+	}
+	s.walletLinks.deferred.Add(1)
+	log.Printf("audit|event=wallet_link_deferred|session_id=%s|user_id=%s|chain_id=%s|reason=%s|timestamp=%s",
+		sessionID, userID, chainID, status.Code(err), now.UTC().Format(time.RFC3339Nano))
+	return true, nil
+}
+
+// walletUnavailable reports whether err means wallet-service could not be reached, rather
+// than that it refused the link
+func walletUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func (s *Service) reportWalletHealth(err error) {
+	if s.walletLinks == nil || s.walletLinks.onHealth == nil {
+		return
+	}
+	s.walletLinks.onHealth(err == nil || !walletUnavailable(err))
+}
+
+// RunWalletLinkReconciler retries queued wallet links until ctx is done
+func (s *Service) RunWalletLinkReconciler(ctx context.Context) {
+	if s.walletLinks == nil {
+		return
+	}
+	ticker := time.NewTicker(s.walletLinks.retryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ReconcileWalletLinks(ctx, time.Now()); err != nil {
+				log.Printf("Failed to reconcile wallet links: %v", err)
+			}
+		}
+	}
+}
+
+// ReconcileWalletLinks attempts the queued links due at now and returns how many were made.
+// A link wallet-service refuses outright is retried like an unreachable one, so it stays
+// visible in the outbox rather than being dropped.
+func (s *Service) ReconcileWalletLinks(ctx context.Context, now time.Time) (int, error) {
+	if s.walletLinks == nil {
+		return 0, nil
+	}
+	links, err := s.walletLinks.outbox.ClaimDueWalletLinks(ctx, now, walletLinkBatch, walletLinkLease)
+	if err != nil {
+		return 0, err
+	}
+
+	made := 0
+	for _, link := range links {
+		_, err := s.walletService.UpsertLink(ctx, &protoWallet.UpsertLinkRequest{
+			UserId:    string(link.UserID),
+			AccountId: link.AccountID,
+			Address:   string(link.Address),
+			ChainId:   string(link.ChainID),
+			IsPrimary: true,
+		})
+		s.reportWalletHealth(err)
+		if err != nil {
+			s.walletLinks.retries.Add(1)
+			next := now.Add(s.walletLinkBackoff(link.Attempts))
+			if rerr := s.walletLinks.outbox.RetryWalletLink(ctx, link.ID, next, err.Error()); rerr != nil {
+				return made, rerr
+			}
+			continue
+		}
+
+		if err := s.walletLinks.outbox.CompleteWalletLink(ctx, link); err != nil {
+			return made, err
+		}
+		made++
+		s.walletLinks.reconciled.Add(1)
+		log.Printf("audit|event=wallet_link_reconciled|session_id=%s|user_id=%s|chain_id=%s|attempts=%d|timestamp=%s",
+			link.SessionID, link.UserID, link.ChainID, link.Attempts+1, time.Now().UTC().Format(time.RFC3339Nano))
+	}
+	return made, nil
+}
+
+// walletLinkBackoff is the wait after a link's attempts-th failed retry
+func (s *Service) walletLinkBackoff(attempts int) time.Duration {
+	backoff := s.walletLinks.retryInterval
+	for i := 0; i < attempts && backoff < maxWalletLinkBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxWalletLinkBackoff)
+}
+
+// WalletLinkStats reports deferred wallet linking for metrics
+func (s *Service) WalletLinkStats(ctx context.Context) (domain.WalletLinkStats, error) {
+	if s.walletLinks == nil {
+		return domain.WalletLinkStats{}, nil
+	}
+	stats := domain.WalletLinkStats{
+		Deferred:   s.walletLinks.deferred.Load(),
+		Reconciled: s.walletLinks.reconciled.Load(),
+		Retries:    s.walletLinks.retries.Load(),
+	}
+	pending, err := s.walletLinks.outbox.CountPendingWalletLinks(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.Pending = pending
+	return stats, nil
+}
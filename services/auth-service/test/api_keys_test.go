@@ -0,0 +1,428 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
+)
+
+const (
+	apiKeyUserID  = "33333333-3333-3333-3333-333333333333"
+	apiKeyAdminID = "44444444-4444-4444-4444-444444444444"
+)
+
+var testAPIPlans = []domain.APIPlanLimits{
+	{Plan: domain.APIPlanFree, DailyQuota: 3, BurstPerSecond: 2, Enforcement: domain.QuotaHard},
+	{Plan: domain.APIPlanPro, DailyQuota: 100, BurstPerSecond: 10, Enforcement: domain.QuotaSoft},
+}
+
+// memoryAPIKeyRepository keeps keys and persisted usage in maps
+type memoryAPIKeyRepository struct {
+	keys   map[string]*domain.APIKey
+	hashes map[string]string // hash -> key ID
+	usage  map[string]domain.APIUsageHour
+}
+
+func newMemoryAPIKeyRepository() *memoryAPIKeyRepository {
+	return &memoryAPIKeyRepository{
+		keys:   make(map[string]*domain.APIKey),
+		hashes: make(map[string]string),
+		usage:  make(map[string]domain.APIUsageHour),
+	}
+}
+
+func (r *memoryAPIKeyRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey, secretHash string) error {
+	stored := *key
+	r.keys[key.ID] = &stored
+	r.hashes[secretHash] = key.ID
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, secretHash string) (*domain.APIKey, error) {
+	key, ok := r.keys[r.hashes[secretHash]]
+	if !ok || key.RevokedAt != nil {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	out := *key
+	return &out, nil
+}
+
+func (r *memoryAPIKeyRepository) GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	out := *key
+	return &out, nil
+}
+
+func (r *memoryAPIKeyRepository) ListAPIKeys(ctx context.Context, userID domain.UserID) ([]*domain.APIKey, error) {
+	var out []*domain.APIKey
+	for _, key := range r.keys {
+		if key.UserID == userID {
+			k := *key
+			out = append(out, &k)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryAPIKeyRepository) CountActiveAPIKeys(ctx context.Context, userID domain.UserID) (int, error) {
+	n := 0
+	for _, key := range r.keys {
+		if key.UserID == userID && key.RevokedAt == nil {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memoryAPIKeyRepository) RevokeAPIKey(ctx context.Context, userID domain.UserID, id string, at time.Time) error {
+	key, ok := r.keys[id]
+	if !ok || key.UserID != userID || key.RevokedAt != nil {
+		return domain.ErrAPIKeyNotFound
+	}
+	key.RevokedAt = &at
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) SetAPIKeyPlan(ctx context.Context, id string, plan domain.APIPlan) (*domain.APIKey, error) {
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	key.Plan = plan
+	out := *key
+	return &out, nil
+}
+
+func (r *memoryAPIKeyRepository) SaveAPIUsage(ctx context.Context, hours []domain.APIUsageHour) error {
+	for _, h := range hours {
+		r.usage[h.KeyID+h.Hour.Format(time.RFC3339)] = h
+	}
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) ListAPIUsage(ctx context.Context, keyIDs []string, since time.Time) ([]domain.APIUsageHour, error) {
+	var out []domain.APIUsageHour
+	for _, h := range r.usage {
+		for _, id := range keyIDs {
+			if h.KeyID == id && !h.Hour.Before(since) {
+				out = append(out, h)
+			}
+		}
+	}
+	return out, nil
+}
+
+// memoryUsageCounter allows every request and records the limits it was asked to apply
+type memoryUsageCounter struct {
+	hits    map[string]int64
+	limits  []domain.APIPlanLimits
+	pending []domain.APIUsageHour
+	dropped []time.Time
+}
+
+func (c *memoryUsageCounter) Hit(ctx context.Context, keyID string, limits domain.APIPlanLimits, now time.Time) (*domain.APIKeyDecision, error) {
+	if c.hits == nil {
+		c.hits = make(map[string]int64)
+	}
+	c.hits[keyID]++
+	c.limits = append(c.limits, limits)
+	return &domain.APIKeyDecision{Limits: limits, Allowed: true, UsedToday: c.hits[keyID]}, nil
+}
+
+func (c *memoryUsageCounter) UsedToday(ctx context.Context, keyID string, now time.Time) (int64, error) {
+	return c.hits[keyID], nil
+}
+
+func (c *memoryUsageCounter) PendingUsage(ctx context.Context) ([]domain.APIUsageHour, error) {
+	return c.pending, nil
+}
+
+func (c *memoryUsageCounter) DropUsage(ctx context.Context, hour time.Time) error {
+	c.dropped = append(c.dropped, hour)
+	return nil
+}
+
+func newAPIKeyService(t *testing.T, repo domain.APIKeyRepository, counter domain.APIUsageCounter) *service.Service {
+	t.Helper()
+	authService := service.NewAuthService(new(MockAuthRepository), nil, nil, nil, []byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	authService.SetImpersonationPolicy([]string{apiKeyAdminID}, 0)
+	require.NoError(t, authService.SetAPIKeys(repo, counter, testAPIPlans))
+	return authService
+}
+
+func TestSetAPIKeys_ValidatesPlans(t *testing.T) {
+	authService := service.NewAuthService(new(MockAuthRepository), nil, nil, nil, []byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	repo := newMemoryAPIKeyRepository()
+
+	err := authService.SetAPIKeys(repo, &memoryUsageCounter{}, testAPIPlans[:1])
+	assert.ErrorContains(t, err, "pro has no limits")
+
+	bad := []domain.APIPlanLimits{testAPIPlans[0], {Plan: domain.APIPlanPro, Enforcement: "lenient"}}
+	assert.ErrorContains(t, authService.SetAPIKeys(repo, &memoryUsageCounter{}, bad), "enforcement")
+
+	_, err = service.NewAuthService(new(MockAuthRepository), nil, nil, nil, nil, nil, false).(*service.Service).CreateAPIKey(context.Background(), apiKeyUserID, "ci")
+	assert.ErrorIs(t, err, domain.ErrAPIKeysDisabled)
+}
+
+func TestCreateAPIKey_StoresOnlyTheHash(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryAPIKeyRepository()
+	authService := newAPIKeyService(t, repo, &memoryUsageCounter{})
+
+	created, err := authService.CreateAPIKey(ctx, apiKeyUserID, "  indexer  ")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(created.Secret, "zk_"))
+	assert.Equal(t, created.Secret[:11], created.Key.Prefix)
+	assert.Equal(t, "indexer", created.Key.Name)
+	assert.Equal(t, domain.APIPlanFree, created.Key.Plan)
+	require.Len(t, repo.hashes, 1)
+	for hash := range repo.hashes {
+		assert.NotContains(t, hash, created.Secret[3:])
+	}
+
+	_, err = authService.CreateAPIKey(ctx, apiKeyUserID, " ")
+	assert.ErrorIs(t, err, domain.ErrAPIKeyName)
+}
+
+func TestCreateAPIKey_LimitsActiveKeys(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryAPIKeyRepository()
+	authService := newAPIKeyService(t, repo, &memoryUsageCounter{})
+
+	var first *domain.CreatedAPIKey
+	for i := 0; i < 10; i++ {
+		created, err := authService.CreateAPIKey(ctx, apiKeyUserID, "key")
+		require.NoError(t, err)
+		if first == nil {
+			first = created
+		}
+	}
+	_, err := authService.CreateAPIKey(ctx, apiKeyUserID, "one too many")
+	assert.ErrorIs(t, err, domain.ErrAPIKeyLimit)
+
+	// Revoked keys don't count
+	require.NoError(t, authService.RevokeAPIKey(ctx, apiKeyUserID, first.Key.ID))
+	_, err = authService.CreateAPIKey(ctx, apiKeyUserID, "replacement")
+	assert.NoError(t, err)
+}
+
+func TestAuthorizeAPIKey(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryAPIKeyRepository()
+	counter := &memoryUsageCounter{}
+	authService := newAPIKeyService(t, repo, counter)
+
+	created, err := authService.CreateAPIKey(ctx, apiKeyUserID, "bot")
+	require.NoError(t, err)
+
+	d, err := authService.AuthorizeAPIKey(ctx, created.Secret)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+	assert.Equal(t, created.Key.ID, d.Key.ID)
+	assert.Equal(t, domain.UserID(apiKeyUserID), d.Key.UserID)
+	assert.Equal(t, testAPIPlans[0], d.Limits)
+
+	_, err = authService.AuthorizeAPIKey(ctx, "zk_unknown")
+	assert.ErrorIs(t, err, domain.ErrAPIKeyInvalid)
+	_, err = authService.AuthorizeAPIKey(ctx, "not-a-key")
+	assert.ErrorIs(t, err, domain.ErrAPIKeyInvalid)
+
+	// A plan change applies to the next request on this replica
+	_, err = authService.SetAPIKeyPlan(ctx, apiKeyUserID, created.Key.ID, domain.APIPlanPro)
+	assert.ErrorIs(t, err, domain.ErrAPIPlanForbidden)
+	_, err = authService.SetAPIKeyPlan(ctx, apiKeyAdminID, created.Key.ID, "enterprise")
+	assert.ErrorIs(t, err, domain.ErrAPIPlanInvalid)
+	key, err := authService.SetAPIKeyPlan(ctx, apiKeyAdminID, created.Key.ID, domain.APIPlanPro)
+	require.NoError(t, err)
+	assert.Equal(t, domain.APIPlanPro, key.Plan)
+
+	d, err = authService.AuthorizeAPIKey(ctx, created.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, testAPIPlans[1], d.Limits)
+
+	// So does revoking it
+	require.NoError(t, authService.RevokeAPIKey(ctx, apiKeyUserID, created.Key.ID))
+	_, err = authService.AuthorizeAPIKey(ctx, created.Secret)
+	assert.ErrorIs(t, err, domain.ErrAPIKeyInvalid)
+	assert.ErrorIs(t, authService.RevokeAPIKey(ctx, apiKeyUserID, created.Key.ID), domain.ErrAPIKeyNotFound)
+}
+
+func TestGetAPIUsage_MergesPendingHours(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryAPIKeyRepository()
+	counter := &memoryUsageCounter{}
+	authService := newAPIKeyService(t, repo, counter)
+
+	created, err := authService.CreateAPIKey(ctx, apiKeyUserID, "bot")
+	require.NoError(t, err)
+	other, err := authService.CreateAPIKey(ctx, apiKeyAdminID, "not mine")
+	require.NoError(t, err)
+	_, err = authService.AuthorizeAPIKey(ctx, created.Secret)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	id := created.Key.ID
+	require.NoError(t, repo.SaveAPIUsage(ctx, []domain.APIUsageHour{
+		{KeyID: id, Hour: now.Add(-30 * 24 * time.Hour), Requests: 99}, // before the window
+		{KeyID: id, Hour: now.Add(-2 * time.Hour), Requests: 5, Rejected: 1},
+		{KeyID: id, Hour: now.Add(-time.Hour), Requests: 2},
+	}))
+	counter.pending = []domain.APIUsageHour{
+		{KeyID: id, Hour: now.Add(-time.Hour), Requests: 4}, // persisted mid-hour, still counting
+		{KeyID: id, Hour: now, Requests: 1},
+		{KeyID: other.Key.ID, Hour: now, Requests: 7},
+	}
+
+	usage, err := authService.GetAPIUsage(ctx, apiKeyUserID, 0)
+	require.NoError(t, err)
+	require.Len(t, usage, 1)
+	u := usage[0]
+	assert.Equal(t, id, u.Key.ID)
+	assert.Equal(t, testAPIPlans[0], u.Limits)
+	assert.Equal(t, int64(1), u.UsedToday)
+	require.Len(t, u.Hours, 3)
+	assert.Equal(t, []int64{5, 4, 1}, []int64{u.Hours[0].Requests, u.Hours[1].Requests, u.Hours[2].Requests})
+	assert.Equal(t, int64(1), u.Hours[0].Rejected)
+}
+
+func TestFlushAPIUsage_PersistsFinishedHours(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemoryAPIKeyRepository()
+	now := time.Date(2026, 10, 15, 14, 20, 0, 0, time.UTC)
+	counter := &memoryUsageCounter{pending: []domain.APIUsageHour{
+		{KeyID: "a", Hour: now.Add(-2 * time.Hour).Truncate(time.Hour), Requests: 3},
+		{KeyID: "b", Hour: now.Add(-2 * time.Hour).Truncate(time.Hour), Requests: 1, Rejected: 2},
+		{KeyID: "a", Hour: now.Truncate(time.Hour), Requests: 8},
+	}}
+	authService := newAPIKeyService(t, repo, counter)
+
+	saved, err := authService.FlushAPIUsage(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, saved)
+	assert.Len(t, repo.usage, 2)
+	assert.Equal(t, []time.Time{time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)}, counter.dropped)
+}
+
+func TestAuthorizeApiKeyHandler(t *testing.T) {
+	ctx := context.Background()
+	mockService := new(MockAuthService)
+	handler := grpcHandler.NewgRPCHandler(grpc.NewServer(), mockService)
+
+	resetsAt := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	mockService.On("AuthorizeAPIKey", ctx, "zk_spent").Return(&domain.APIKeyDecision{
+		Key:        &domain.APIKey{ID: "key-1", UserID: apiKeyUserID, Plan: domain.APIPlanFree},
+		Limits:     testAPIPlans[0],
+		Reject:     domain.APIKeyRejectQuota,
+		OverQuota:  true,
+		UsedToday:  3,
+		ResetsAt:   resetsAt,
+		RetryAfter: 90 * time.Second,
+	}, nil)
+	mockService.On("AuthorizeAPIKey", ctx, "zk_gone").Return(nil, domain.ErrAPIKeyInvalid)
+
+	resp, err := handler.AuthorizeApiKey(ctx, &authpb.AuthorizeApiKeyRequest{Key: "zk_spent"})
+	require.NoError(t, err)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, apiKeyUserID, resp.UserId)
+	assert.Equal(t, "quota", resp.RejectReason)
+	assert.Equal(t, int64(90000), resp.RetryAfterMs)
+	assert.Equal(t, "hard", resp.Limits.Enforcement)
+	assert.Equal(t, resetsAt.Format(time.RFC3339), resp.ResetsAt)
+
+	_, err = handler.AuthorizeApiKey(ctx, &authpb.AuthorizeApiKeyRequest{Key: "zk_gone"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = handler.AuthorizeApiKey(ctx, &authpb.AuthorizeApiKeyRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRedisAPIUsageCounter(t *testing.T) {
+	h := testharness.New(t)
+	rds, _ := h.Redis(t)
+	counter := repository.NewRedisAPIUsageCounter(rds)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	hit := func(keyID string, limits domain.APIPlanLimits, at time.Time) *domain.APIKeyDecision {
+		t.Helper()
+		d, err := counter.Hit(ctx, keyID, limits, at)
+		require.NoError(t, err)
+		return d
+	}
+
+	t.Run("burst", func(t *testing.T) {
+		limits := domain.APIPlanLimits{Plan: domain.APIPlanFree, BurstPerSecond: 2, Enforcement: domain.QuotaHard}
+		assert.True(t, hit("burst", limits, now).Allowed)
+		assert.True(t, hit("burst", limits, now).Allowed)
+		d := hit("burst", limits, now)
+		assert.False(t, d.Allowed)
+		assert.Equal(t, domain.APIKeyRejectBurst, d.Reject)
+		assert.Equal(t, time.Second, d.RetryAfter)
+		// The next second is a new window, and refused requests took no quota
+		d = hit("burst", limits, now.Add(time.Second))
+		assert.True(t, d.Allowed)
+		assert.Equal(t, int64(3), d.UsedToday)
+	})
+
+	t.Run("hard quota", func(t *testing.T) {
+		limits := domain.APIPlanLimits{Plan: domain.APIPlanFree, DailyQuota: 2, Enforcement: domain.QuotaHard}
+		hit("hard", limits, now)
+		d := hit("hard", limits, now)
+		assert.True(t, d.Allowed)
+		assert.False(t, d.OverQuota)
+		d = hit("hard", limits, now)
+		assert.False(t, d.Allowed)
+		assert.True(t, d.OverQuota)
+		assert.Equal(t, domain.APIKeyRejectQuota, d.Reject)
+		assert.Equal(t, d.ResetsAt.Sub(now), d.RetryAfter)
+		used, err := counter.UsedToday(ctx, "hard", now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), used)
+	})
+
+	t.Run("soft quota", func(t *testing.T) {
+		limits := domain.APIPlanLimits{Plan: domain.APIPlanPro, DailyQuota: 1, Enforcement: domain.QuotaSoft}
+		assert.False(t, hit("soft", limits, now).OverQuota)
+		d := hit("soft", limits, now)
+		assert.True(t, d.Allowed)
+		assert.True(t, d.OverQuota)
+		assert.Equal(t, int64(2), d.UsedToday)
+	})
+
+	t.Run("pending usage", func(t *testing.T) {
+		pending, err := counter.PendingUsage(ctx)
+		require.NoError(t, err)
+		byKey := make(map[string]domain.APIUsageHour)
+		for _, h := range pending {
+			if h.Hour.Equal(now.Truncate(time.Hour)) {
+				byKey[h.KeyID] = h
+			}
+		}
+		assert.Equal(t, int64(1), byKey["hard"].Rejected)
+		assert.Equal(t, int64(2), byKey["soft"].Requests)
+
+		for _, h := range pending {
+			require.NoError(t, counter.DropUsage(ctx, h.Hour))
+		}
+		pending, err = counter.PendingUsage(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+}
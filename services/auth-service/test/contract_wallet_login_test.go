@@ -0,0 +1,108 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spruceid/siwe-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	protoWallet "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const safeWallet = "0x5afe00000000000000000000000000000000cafe"
+
+// contractWalletClient answers signature challenges as wallet-service would for a contract wallet
+type contractWalletClient struct {
+	linkingWalletClient
+	verifyErr  error
+	challenges []*protoWallet.CreateSignatureChallengeRequest
+}
+
+func (c *contractWalletClient) CreateSignatureChallenge(ctx context.Context, in *protoWallet.CreateSignatureChallengeRequest, opts ...grpc.CallOption) (*protoWallet.CreateSignatureChallengeResponse, error) {
+	c.challenges = append(c.challenges, in)
+	return &protoWallet.CreateSignatureChallengeResponse{ChallengeId: "challenge-1", Message: in.GetMessage()}, nil
+}
+
+func (c *contractWalletClient) VerifySignatureChallenge(ctx context.Context, in *protoWallet.VerifySignatureChallengeRequest, opts ...grpc.CallOption) (*protoWallet.VerifySignatureChallengeResponse, error) {
+	if c.verifyErr != nil {
+		return nil, c.verifyErr
+	}
+	return &protoWallet.VerifySignatureChallengeResponse{Address: safeWallet, ChainId: "eip155:1", Purpose: in.GetPurpose(), Method: "eip1271"}, nil
+}
+
+// contractWalletSignIn builds a SIWE login for the Safe with a signature no key recovers to it
+func contractWalletSignIn(t *testing.T, repo *MockAuthRepository) (message, signature string) {
+	msg, err := siwe.InitMessage("marketplace.test", "0x5AFE00000000000000000000000000000000CAfe", "https://marketplace.test", "contractwallet01", map[string]interface{}{
+		"chainId":        1,
+		"issuedAt":       time.Now().UTC().Format(time.RFC3339),
+		"expirationTime": time.Now().UTC().Add(5 * time.Minute).Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+	repo.On("TryUseNonce", mock.Anything, "contractwallet01", safeWallet, "eip155:1", "marketplace.test", mock.AnythingOfType("time.Time")).
+		Return(true, nil).Maybe()
+	return msg.String(), "0x" + strings.Repeat("ab", 65)
+}
+
+func newContractWalletService(repo *MockAuthRepository, wallet protoWallet.WalletServiceClient, enabled bool) *service.Service {
+	authService := service.NewAuthService(repo, &loginUserClient{}, wallet, nil,
+		[]byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	authService.SetContractWalletLogins(enabled)
+	return authService
+}
+
+func TestVerifySiwe_ContractWalletSignsInThroughWalletService(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	message, signature := contractWalletSignIn(t, repo)
+	repo.On("CreateSession", ctx, mock.AnythingOfType("*domain.Session")).Return(nil)
+
+	wallet := &contractWalletClient{}
+	result, err := newContractWalletService(repo, wallet, true).VerifySiwe(ctx, safeWallet, message, signature, domain.ClientInfo{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+
+	require.Len(t, wallet.challenges, 1)
+	challenge := wallet.challenges[0]
+	assert.Equal(t, "siwe_login", challenge.GetPurpose())
+	assert.Equal(t, safeWallet, challenge.GetAddress())
+	assert.Equal(t, "eip155:1", challenge.GetChainId())
+	assert.Equal(t, message, challenge.GetMessage())
+}
+
+func TestVerifySiwe_ContractWalletLoginsDisabled(t *testing.T) {
+	repo := new(MockAuthRepository)
+	message, signature := contractWalletSignIn(t, repo)
+	wallet := &contractWalletClient{}
+
+	_, err := newContractWalletService(repo, wallet, false).VerifySiwe(context.Background(), safeWallet, message, signature, domain.ClientInfo{})
+	assert.ErrorContains(t, err, "failed to verify signature")
+	assert.Empty(t, wallet.challenges)
+}
+
+func TestVerifySiwe_ContractWalletRejectsSignature(t *testing.T) {
+	repo := new(MockAuthRepository)
+	message, signature := contractWalletSignIn(t, repo)
+	wallet := &contractWalletClient{verifyErr: status.Error(codes.PermissionDenied, "invalid_signature")}
+
+	_, err := newContractWalletService(repo, wallet, true).VerifySiwe(context.Background(), safeWallet, message, signature, domain.ClientInfo{})
+	assert.EqualError(t, err, "signature verification failed: address mismatch")
+}
+
+func TestVerifySiwe_ContractWalletCheckUnavailable(t *testing.T) {
+	repo := new(MockAuthRepository)
+	message, signature := contractWalletSignIn(t, repo)
+	wallet := &contractWalletClient{verifyErr: status.Error(codes.Unavailable, "rpc unavailable")}
+
+	_, err := newContractWalletService(repo, wallet, true).VerifySiwe(context.Background(), safeWallet, message, signature, domain.ClientInfo{})
+	assert.ErrorContains(t, err, "failed to verify signature")
+}
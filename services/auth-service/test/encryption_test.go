@@ -0,0 +1,58 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/encryption"
+)
+
+func TestContextCipher_RoundTrip(t *testing.T) {
+	c, err := encryption.NewContextCipher("test-session-context-secret")
+	require.NoError(t, err)
+
+	plaintext := []byte(`{"prepareCollection":true,"correlationId":"c-1"}`)
+	sealed, err := c.Seal(plaintext, "session-123")
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "prepareCollection")
+
+	opened, err := c.Open(sealed, "session-123")
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, opened)
+}
+
+func TestContextCipher_RejectsOtherSessionAndTampering(t *testing.T) {
+	c, err := encryption.NewContextCipher("test-session-context-secret")
+	require.NoError(t, err)
+
+	sealed, err := c.Seal([]byte(`{"prepareCollection":true}`), "session-123")
+	require.NoError(t, err)
+
+	_, err = c.Open(sealed, "session-456")
+	assert.Error(t, err)
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = c.Open(sealed, "session-123")
+	assert.Error(t, err)
+
+	_, err = c.Open([]byte{0x02, 0x00}, "session-123")
+	assert.ErrorIs(t, err, encryption.ErrMalformedBlob)
+}
+
+func TestContextCipher_WrongKeyFails(t *testing.T) {
+	a, err := encryption.NewContextCipher("key-a")
+	require.NoError(t, err)
+	b, err := encryption.NewContextCipher("key-b")
+	require.NoError(t, err)
+
+	sealed, err := a.Seal([]byte("{}"), "session-123")
+	require.NoError(t, err)
+
+	_, err = b.Open(sealed, "session-123")
+	assert.Error(t, err)
+
+	_, err = encryption.NewContextCipher("")
+	assert.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+)
+
+// fakeLocator places every IP in Berlin unless told to fail
+type fakeLocator struct {
+	err    error
+	lookup net.IP
+}
+
+func (l *fakeLocator) Locate(ctx context.Context, ip net.IP) (*domain.Location, error) {
+	l.lookup = ip
+	if l.err != nil {
+		return nil, l.err
+	}
+	return &domain.Location{Country: "DE", City: "Berlin"}, nil
+}
+
+func newGeoService(t *testing.T, repo *MockAuthRepository, publisher domain.AuthEventPublisher, locator domain.GeoLocator, mode domain.GeoPrivacyMode) *service.Service {
+	authService := service.NewAuthService(repo, &loginUserClient{}, &linkingWalletClient{}, publisher,
+		[]byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	require.NoError(t, authService.SetGeoLocation(locator, mode))
+	return authService
+}
+
+// expectLogin captures the stored session and the published login event
+func expectLogin(repo *MockAuthRepository, publisher *MockAuthEventPublisher) (*domain.Session, chan *domain.AuthUserLoggedInEvent) {
+	created := new(domain.Session)
+	repo.On("CreateSession", mock.Anything, mock.AnythingOfType("*domain.Session")).
+		Run(func(args mock.Arguments) { *created = *args.Get(1).(*domain.Session) }).
+		Return(nil)
+	events := make(chan *domain.AuthUserLoggedInEvent, 1)
+	publisher.On("PublishUserLoggedIn", mock.Anything, mock.AnythingOfType("*domain.AuthUserLoggedInEvent")).
+		Run(func(args mock.Arguments) { events <- args.Get(1).(*domain.AuthUserLoggedInEvent) }).
+		Return(nil)
+	return created, events
+}
+
+func loggedIn(t *testing.T, events chan *domain.AuthUserLoggedInEvent) *domain.AuthUserLoggedInEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("user_logged_in was not published")
+		return nil
+	}
+}
+
+func TestVerifySiwe_LocatesSessionInCityMode(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	publisher := new(MockAuthEventPublisher)
+	accountID, message, signature := signIn(t, repo)
+	repo.On("ListLoginLocations", ctx, domain.UserID(sessionLimitUser)).
+		Return([]domain.Location{{Country: "DE", City: "Berlin"}}, nil)
+	created, events := expectLogin(repo, publisher)
+
+	locator := &fakeLocator{}
+	authService := newGeoService(t, repo, publisher, locator, domain.GeoPrivacyCity)
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{IP: "203.0.113.7:52100", UserAgent: "Mozilla/5.0"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "203.0.113.7", locator.lookup.String())
+	require.NotNil(t, created.IP)
+	assert.Equal(t, "203.0.113.7", *created.IP)
+	require.NotNil(t, created.UA)
+	assert.Equal(t, "Mozilla/5.0", *created.UA)
+	assert.Equal(t, &domain.Location{Country: "DE", City: "Berlin"}, created.Location)
+
+	event := loggedIn(t, events)
+	assert.Equal(t, created.Location, event.Location)
+	assert.False(t, event.NewLocation)
+}
+
+func TestVerifySiwe_CountryModeKeepsOnlyCountry(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	publisher := new(MockAuthEventPublisher)
+	accountID, message, signature := signIn(t, repo)
+	repo.On("ListLoginLocations", ctx, domain.UserID(sessionLimitUser)).Return(nil, nil)
+	created, events := expectLogin(repo, publisher)
+
+	authService := newGeoService(t, repo, publisher, &fakeLocator{}, domain.GeoPrivacyCountry)
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{IP: "203.0.113.7"})
+	require.NoError(t, err)
+
+	assert.Nil(t, created.IP)
+	assert.Equal(t, &domain.Location{Country: "DE"}, created.Location)
+	// A first login has nothing to compare against
+	assert.False(t, loggedIn(t, events).NewLocation)
+}
+
+func TestVerifySiwe_FlagsLoginFromNewLocation(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	publisher := new(MockAuthEventPublisher)
+	accountID, message, signature := signIn(t, repo)
+	repo.On("ListLoginLocations", ctx, domain.UserID(sessionLimitUser)).
+		Return([]domain.Location{{Country: "DE", City: "Munich"}, {Country: "FR"}}, nil)
+	_, events := expectLogin(repo, publisher)
+
+	authService := newGeoService(t, repo, publisher, &fakeLocator{}, domain.GeoPrivacyCity)
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{IP: "203.0.113.7"})
+	require.NoError(t, err)
+
+	event := loggedIn(t, events)
+	assert.True(t, event.NewLocation)
+	assert.Equal(t, &domain.Location{Country: "DE", City: "Berlin"}, event.Location)
+}
+
+func TestVerifySiwe_CountryOnlyHistoryCoversEveryCity(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	publisher := new(MockAuthEventPublisher)
+	accountID, message, signature := signIn(t, repo)
+	repo.On("ListLoginLocations", ctx, domain.UserID(sessionLimitUser)).
+		Return([]domain.Location{{Country: "DE"}}, nil)
+	_, events := expectLogin(repo, publisher)
+
+	authService := newGeoService(t, repo, publisher, &fakeLocator{}, domain.GeoPrivacyCity)
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{IP: "203.0.113.7"})
+	require.NoError(t, err)
+	assert.False(t, loggedIn(t, events).NewLocation)
+}
+
+func TestVerifySiwe_LocatorFailureDoesNotBlockLogin(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	publisher := new(MockAuthEventPublisher)
+	accountID, message, signature := signIn(t, repo)
+	created, events := expectLogin(repo, publisher)
+
+	authService := newGeoService(t, repo, publisher, &fakeLocator{err: errors.New("geo-IP service down")}, domain.GeoPrivacyCity)
+	result, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{IP: "203.0.113.7"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+
+	assert.Nil(t, created.Location)
+	require.NotNil(t, created.IP)
+	assert.False(t, loggedIn(t, events).NewLocation)
+	repo.AssertNotCalled(t, "ListLoginLocations", mock.Anything, mock.Anything)
+}
+
+func TestSetGeoLocation_RejectsUnknownMode(t *testing.T) {
+	authService := service.NewAuthService(new(MockAuthRepository), nil, nil, nil, []byte("a"), []byte("b"), false).(*service.Service)
+	assert.Error(t, authService.SetGeoLocation(&fakeLocator{}, "region"))
+	assert.NoError(t, authService.SetGeoLocation(nil, domain.GeoPrivacyCountry))
+}
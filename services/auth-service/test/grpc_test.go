@@ -28,8 +28,8 @@ func (m *MockAuthService) GetNonce(ctx context.Context, accountID, chainID, doma
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockAuthService) VerifySiwe(ctx context.Context, accountID, message, signature string) (*domain.AuthResult, error) {
-	args := m.Called(ctx, accountID, message, signature)
+func (m *MockAuthService) VerifySiwe(ctx context.Context, accountID, message, signature string, client domain.ClientInfo) (*domain.AuthResult, error) {
+	args := m.Called(ctx, accountID, message, signature, client)
 	return args.Get(0).(*domain.AuthResult), args.Error(1)
 }
 
@@ -48,6 +48,80 @@ func (m *MockAuthService) LogoutByRefreshToken(ctx context.Context, refreshToken
 	return args.Error(0)
 }
 
+func (m *MockAuthService) StartImpersonation(ctx context.Context, adminUserID, targetUserID, reason string) (*domain.ImpersonationResult, error) {
+	args := m.Called(ctx, adminUserID, targetUserID, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ImpersonationResult), args.Error(1)
+}
+
+func (m *MockAuthService) EndImpersonation(ctx context.Context, sessionID string) error {
+	args := m.Called(ctx, sessionID)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) IssueSubscriptionTicket(ctx context.Context, userID, sessionID, origin string) (*domain.SubscriptionTicket, error) {
+	args := m.Called(ctx, userID, sessionID, origin)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SubscriptionTicket), args.Error(1)
+}
+
+func (m *MockAuthService) ValidateSession(ctx context.Context, userID, sessionID string) (*domain.Session, error) {
+	args := m.Called(ctx, userID, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Session), args.Error(1)
+}
+
+func (m *MockAuthService) CreateAPIKey(ctx context.Context, userID, name string) (*domain.CreatedAPIKey, error) {
+	args := m.Called(ctx, userID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CreatedAPIKey), args.Error(1)
+}
+
+func (m *MockAuthService) ListAPIKeys(ctx context.Context, userID string) ([]*domain.APIKey, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.APIKey), args.Error(1)
+}
+
+func (m *MockAuthService) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	args := m.Called(ctx, userID, keyID)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) SetAPIKeyPlan(ctx context.Context, adminUserID, keyID string, plan domain.APIPlan) (*domain.APIKey, error) {
+	args := m.Called(ctx, adminUserID, keyID, plan)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIKey), args.Error(1)
+}
+
+func (m *MockAuthService) AuthorizeAPIKey(ctx context.Context, secret string) (*domain.APIKeyDecision, error) {
+	args := m.Called(ctx, secret)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIKeyDecision), args.Error(1)
+}
+
+func (m *MockAuthService) GetAPIUsage(ctx context.Context, userID string, days int) ([]*domain.APIKeyUsage, error) {
+	args := m.Called(ctx, userID, days)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.APIKeyUsage), args.Error(1)
+}
+
 // AuthGRPCTestSuite defines the test suite for Auth gRPC handler
 type AuthGRPCTestSuite struct {
 	suite.Suite
@@ -149,7 +223,7 @@ func (suite *AuthGRPCTestSuite) TestVerifySiwe_Success() {
 		ChainID:      "eip155:1",
 	}
 
-	suite.mockService.On("VerifySiwe", ctx, req.AccountId, req.Message, req.Signature).
+	suite.mockService.On("VerifySiwe", ctx, req.AccountId, req.Message, req.Signature, domain.ClientInfo{}).
 		Return(expectedResult, nil)
 
 	resp, err := suite.handler.VerifySiwe(ctx, req)
@@ -217,7 +291,7 @@ func (suite *AuthGRPCTestSuite) TestVerifySiwe_ServiceError() {
 		Signature: "0xinvalid",
 	}
 
-	suite.mockService.On("VerifySiwe", ctx, req.AccountId, req.Message, req.Signature).
+	suite.mockService.On("VerifySiwe", ctx, req.AccountId, req.Message, req.Signature, domain.ClientInfo{}).
 		Return((*domain.AuthResult)(nil), errors.New("invalid signature"))
 
 	resp, err := suite.handler.VerifySiwe(ctx, req)
@@ -389,7 +463,7 @@ func BenchmarkVerifySiwe(b *testing.B) {
 		UserID:       "user-123",
 		ExpiresAt:    time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC),
 	}
-	mockService.On("VerifySiwe", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+	mockService.On("VerifySiwe", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(expectedResult, nil)
 
 	b.ResetTimer()
@@ -0,0 +1,177 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+	protoUser "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+const (
+	impersonationAdmin  = "11111111-1111-1111-1111-111111111111"
+	impersonationTarget = "22222222-2222-2222-2222-222222222222"
+	impersonationSecret = "test-jwt-secret"
+)
+
+// knownUserClient answers GetUsersByIDs for a fixed set of users
+type knownUserClient struct {
+	protoUser.UserServiceClient
+	users map[string]bool
+}
+
+func (c *knownUserClient) GetUsersByIDs(ctx context.Context, in *protoUser.GetUsersByIDsRequest, opts ...grpc.CallOption) (*protoUser.GetUsersByIDsResponse, error) {
+	var cards []*protoUser.UserCard
+	for _, id := range in.GetUserIds() {
+		cards = append(cards, &protoUser.UserCard{Found: c.users[id], User: &protoUser.User{Id: id}})
+	}
+	return &protoUser.GetUsersByIDsResponse{Users: cards}, nil
+}
+
+func (c *knownUserClient) ListUserOrganizations(ctx context.Context, in *protoUser.ListUserOrganizationsRequest, opts ...grpc.CallOption) (*protoUser.ListUserOrganizationsResponse, error) {
+	return &protoUser.ListUserOrganizationsResponse{}, nil
+}
+
+func newImpersonationService(repo *MockAuthRepository) *service.Service {
+	authService := service.NewAuthService(repo, &knownUserClient{users: map[string]bool{impersonationTarget: true}},
+		nil, nil, []byte(impersonationSecret), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	authService.SetImpersonationPolicy([]string{impersonationAdmin}, 10*time.Minute)
+	return authService
+}
+
+func TestStartImpersonation_IssuesFlaggedShortLivedToken(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	var created *domain.Session
+	repo.On("CreateSession", ctx, mock.AnythingOfType("*domain.Session")).
+		Run(func(args mock.Arguments) { created = args.Get(1).(*domain.Session) }).
+		Return(nil)
+
+	result, err := newImpersonationService(repo).StartImpersonation(ctx, impersonationAdmin, impersonationTarget, "  ticket #42  ")
+	require.NoError(t, err)
+
+	require.NotNil(t, created)
+	assert.Equal(t, domain.UserID(impersonationTarget), created.UserID)
+	assert.Equal(t, domain.UserID(impersonationAdmin), created.ImpersonatorID)
+	assert.Equal(t, "ticket #42", created.ImpersonationReason)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), result.ExpiresAt, 5*time.Second)
+	assert.Equal(t, created.ID, result.SessionID)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(result.AccessToken, claims, func(*jwt.Token) (interface{}, error) {
+		return []byte(impersonationSecret), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, impersonationTarget, claims["sub"])
+	assert.Equal(t, impersonationAdmin, claims["impersonator"])
+	assert.Equal(t, "ticket #42", claims["impersonation_reason"])
+	assert.Equal(t, string(created.ID), claims["session_id"])
+}
+
+func TestStartImpersonation_Refusals(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name   string
+		admin  string
+		target string
+		reason string
+		err    error
+	}{
+		{"missing reason", impersonationAdmin, impersonationTarget, "   ", domain.ErrImpersonationReason},
+		{"not an admin", impersonationTarget, impersonationAdmin, "ticket", domain.ErrImpersonationForbidden},
+		{"self", impersonationAdmin, impersonationAdmin, "ticket", domain.ErrImpersonationForbidden},
+		{"unknown user", impersonationAdmin, "33333333-3333-3333-3333-333333333333", "ticket", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := new(MockAuthRepository)
+			_, err := newImpersonationService(repo).StartImpersonation(ctx, tc.admin, tc.target, tc.reason)
+			require.Error(t, err)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			}
+			repo.AssertNotCalled(t, "CreateSession", mock.Anything, mock.Anything)
+		})
+	}
+
+	// No configured admins disables impersonation altogether
+	repo := new(MockAuthRepository)
+	authService := service.NewAuthService(repo, nil, nil, nil, []byte(impersonationSecret), []byte("r"), false)
+	_, err := authService.StartImpersonation(ctx, impersonationAdmin, impersonationTarget, "ticket")
+	assert.ErrorIs(t, err, domain.ErrImpersonationForbidden)
+}
+
+func TestImpersonationSession_CannotRefresh(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	repo.On("GetSessionByRefreshHash", ctx, mock.AnythingOfType("string")).Return(&domain.Session{
+		ID:             domain.SessionID("550e8400-e29b-41d4-a716-446655440000"),
+		UserID:         domain.UserID(impersonationTarget),
+		ExpiresAt:      time.Now().Add(time.Hour),
+		ImpersonatorID: domain.UserID(impersonationAdmin),
+	}, nil)
+
+	_, err := newImpersonationService(repo).Refresh(ctx, "dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd")
+	assert.ErrorIs(t, err, domain.ErrImpersonationForbidden)
+}
+
+func TestEndImpersonation(t *testing.T) {
+	ctx := context.Background()
+	impersonated := "550e8400-e29b-41d4-a716-446655440000"
+	regular := "550e8400-e29b-41d4-a716-446655440001"
+
+	repo := new(MockAuthRepository)
+	repo.On("GetSession", ctx, domain.SessionID(impersonated)).Return(&domain.Session{
+		ID: domain.SessionID(impersonated), UserID: impersonationTarget, ImpersonatorID: impersonationAdmin,
+	}, nil)
+	repo.On("GetSession", ctx, domain.SessionID(regular)).Return(&domain.Session{
+		ID: domain.SessionID(regular), UserID: impersonationTarget,
+	}, nil)
+	repo.On("RevokeSession", ctx, domain.SessionID(impersonated)).Return(nil)
+
+	authService := newImpersonationService(repo)
+	assert.NoError(t, authService.EndImpersonation(ctx, impersonated))
+	assert.ErrorIs(t, authService.EndImpersonation(ctx, regular), domain.ErrNotImpersonating)
+	repo.AssertNotCalled(t, "RevokeSession", ctx, domain.SessionID(regular))
+}
+
+func TestStartImpersonationHandler_MapsErrors(t *testing.T) {
+	ctx := context.Background()
+	mockService := new(MockAuthService)
+	handler := grpcHandler.NewgRPCHandler(grpc.NewServer(), mockService)
+
+	mockService.On("StartImpersonation", ctx, impersonationTarget, impersonationAdmin, "ticket").
+		Return(nil, domain.ErrImpersonationForbidden)
+	_, err := handler.StartImpersonation(ctx, &authpb.StartImpersonationRequest{
+		AdminUserId: impersonationTarget, TargetUserId: impersonationAdmin, Reason: "ticket",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = handler.StartImpersonation(ctx, &authpb.StartImpersonationRequest{AdminUserId: impersonationAdmin})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	mockService.On("StartImpersonation", ctx, impersonationAdmin, impersonationTarget, "ticket").
+		Return(&domain.ImpersonationResult{
+			AccessToken: "token", ExpiresAt: expiresAt, UserID: impersonationTarget,
+			ImpersonatorID: impersonationAdmin, SessionID: "session-1",
+		}, nil)
+	resp, err := handler.StartImpersonation(ctx, &authpb.StartImpersonationRequest{
+		AdminUserId: impersonationAdmin, TargetUserId: impersonationTarget, Reason: "ticket",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, impersonationAdmin, resp.GetImpersonatorId())
+	assert.Equal(t, expiresAt.Format(time.RFC3339), resp.GetExpiresAt())
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
+)
+
+// memoryNonceStore records created nonces
+type memoryNonceStore struct {
+	nonces map[string]*domain.Nonce
+}
+
+func (s *memoryNonceStore) CreateNonce(ctx context.Context, nonce *domain.Nonce) error {
+	if s.nonces == nil {
+		s.nonces = make(map[string]*domain.Nonce)
+	}
+	s.nonces[nonce.Value] = nonce
+	return nil
+}
+
+func (s *memoryNonceStore) TryUseNonce(ctx context.Context, value, accountID, chainID, domain string, usedAt time.Time) (bool, error) {
+	return false, nil
+}
+
+func TestGetNonce_UsesNonceStore(t *testing.T) {
+	// No repository expectations: a nonce written to Postgres would fail the mock
+	repo := new(MockAuthRepository)
+	store := &memoryNonceStore{}
+	authService := service.NewAuthService(repo, nil, nil, nil, []byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	authService.SetNonceStore(store)
+
+	nonce, err := authService.GetNonce(context.Background(), "0xABCDEF1234567890ABCDEF1234567890ABCDEF12", "eip155:1", "marketplace.test")
+	require.NoError(t, err)
+
+	require.Contains(t, store.nonces, nonce)
+	assert.Equal(t, "0xabcdef1234567890abcdef1234567890abcdef12", store.nonces[nonce].AccountID)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), store.nonces[nonce].ExpiresAt, time.Minute)
+	repo.AssertExpectations(t)
+}
+
+func TestRedisNonceStore(t *testing.T) {
+	h := testharness.New(t)
+	rds, _ := h.Redis(t)
+	store := repository.NewRedisNonceStore(rds)
+	ctx := context.Background()
+
+	create := func(t *testing.T, value string, ttl time.Duration) {
+		t.Helper()
+		require.NoError(t, store.CreateNonce(ctx, &domain.Nonce{
+			Value:     value,
+			AccountID: "0xabc",
+			ChainID:   "eip155:1",
+			Domain:    "marketplace.test",
+			ExpiresAt: time.Now().Add(ttl),
+			CreatedAt: time.Now(),
+		}))
+	}
+
+	t.Run("SingleUse", func(t *testing.T) {
+		create(t, "single-use", time.Minute)
+
+		used, err := store.TryUseNonce(ctx, "single-use", "0xabc", "eip155:1", "marketplace.test", time.Now())
+		require.NoError(t, err)
+		assert.True(t, used)
+
+		used, err = store.TryUseNonce(ctx, "single-use", "0xabc", "eip155:1", "marketplace.test", time.Now())
+		require.NoError(t, err)
+		assert.False(t, used)
+	})
+
+	t.Run("DuplicateRejected", func(t *testing.T) {
+		create(t, "duplicate", time.Minute)
+		err := store.CreateNonce(ctx, &domain.Nonce{Value: "duplicate", ExpiresAt: time.Now().Add(time.Minute)})
+		assert.Error(t, err)
+	})
+
+	t.Run("MismatchKeepsNonce", func(t *testing.T) {
+		create(t, "mismatch", time.Minute)
+
+		for _, args := range [][3]string{
+			{"0xdef", "eip155:1", "marketplace.test"},
+			{"0xabc", "eip155:137", "marketplace.test"},
+			{"0xabc", "eip155:1", "evil.test"},
+		} {
+			used, err := store.TryUseNonce(ctx, "mismatch", args[0], args[1], args[2], time.Now())
+			require.NoError(t, err)
+			assert.False(t, used, "%v", args)
+		}
+
+		used, err := store.TryUseNonce(ctx, "mismatch", "0xabc", "eip155:1", "marketplace.test", time.Now())
+		require.NoError(t, err)
+		assert.True(t, used)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		create(t, "expired", 50*time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+
+		used, err := store.TryUseNonce(ctx, "expired", "0xabc", "eip155:1", "marketplace.test", time.Now())
+		require.NoError(t, err)
+		assert.False(t, used)
+	})
+
+	t.Run("ConcurrentUseWinsOnce", func(t *testing.T) {
+		create(t, "contended", time.Minute)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		wins := 0
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				used, err := store.TryUseNonce(ctx, "contended", "0xabc", "eip155:1", "marketplace.test", time.Now())
+				if err == nil && used {
+					mu.Lock()
+					wins++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, wins)
+	})
+}
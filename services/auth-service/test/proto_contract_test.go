@@ -0,0 +1,12 @@
+package test
+
+import (
+	"testing"
+
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/protocheck"
+)
+
+func TestProtoContract(t *testing.T) {
+	protocheck.Check(t, authpb.File_auth_proto)
+}
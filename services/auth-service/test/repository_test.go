@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/encryption"
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/repository"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
@@ -33,7 +34,10 @@ func (suite *AuthRepositoryTestSuite) SetupTest() {
 	suite.mockPG = &postgres.Postgres{} // You'd need to implement a proper mock
 	suite.mockRedis = &redis.Redis{}    // You'd need to implement a proper mock
 
-	suite.repo = repository.NewAuthRepository(suite.mockPG, suite.mockRedis)
+	contextCipher, err := encryption.NewContextCipher("test-session-context-secret")
+	suite.Require().NoError(err)
+
+	suite.repo = repository.NewAuthRepository(suite.mockPG, suite.mockRedis, contextCipher)
 }
 
 func (suite *AuthRepositoryTestSuite) TearDownTest() {
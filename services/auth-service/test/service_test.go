@@ -5,11 +5,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	protoUser "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
 )
 
 // MockAuthRepository is a mock implementation of domain.AuthRepository
@@ -61,11 +64,47 @@ func (m *MockAuthRepository) UpdateSessionLastUsed(ctx context.Context, sessionI
 	return args.Error(0)
 }
 
+func (m *MockAuthRepository) ListActiveSessions(ctx context.Context, userID domain.UserID) ([]*domain.Session, error) {
+	args := m.Called(ctx, userID)
+	if got := args.Get(0); got != nil {
+		return got.([]*domain.Session), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockAuthRepository) ListLoginLocations(ctx context.Context, userID domain.UserID) ([]domain.Location, error) {
+	args := m.Called(ctx, userID)
+	if got := args.Get(0); got != nil {
+		return got.([]domain.Location), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (m *MockAuthRepository) RevokeSession(ctx context.Context, sessionID domain.SessionID) error {
 	args := m.Called(ctx, sessionID)
 	return args.Error(0)
 }
 
+// MockAuthEventPublisher is a mock implementation of domain.AuthEventPublisher
+type MockAuthEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockAuthEventPublisher) PublishUserLoggedIn(ctx context.Context, event *domain.AuthUserLoggedInEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockAuthEventPublisher) PublishSessionRevoked(ctx context.Context, event *domain.AuthSessionRevokedEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockAuthEventPublisher) PublishSessionEvicted(ctx context.Context, event *domain.AuthSessionEvictedEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
 // AuthServiceTestSuite defines the test suite for AuthService
 type AuthServiceTestSuite struct {
 	suite.Suite
@@ -131,6 +170,95 @@ func (suite *AuthServiceTestSuite) TestRefreshSession_Success() {
 	suite.mockRepo.AssertExpectations(suite.T())
 }
 
+// orgUserClient answers ListUserOrganizations; other user-service calls are not expected
+type orgUserClient struct {
+	protoUser.UserServiceClient
+	memberships []*protoUser.OrganizationMembership
+}
+
+func (c *orgUserClient) ListUserOrganizations(ctx context.Context, in *protoUser.ListUserOrganizationsRequest, opts ...grpc.CallOption) (*protoUser.ListUserOrganizationsResponse, error) {
+	return &protoUser.ListUserOrganizationsResponse{Memberships: c.memberships}, nil
+}
+
+func (suite *AuthServiceTestSuite) TestRefreshSession_IncludesOrgClaims() {
+	ctx := context.Background()
+	refreshToken := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+	authService := service.NewAuthService(
+		suite.mockRepo,
+		&orgUserClient{memberships: []*protoUser.OrganizationMembership{
+			{Organization: &protoUser.Organization{Id: "org-1"}, Role: "admin"},
+		}},
+		nil,
+		nil,
+		[]byte("test-jwt-secret"),
+		[]byte("test-refresh-jwt-secret"),
+		false,
+	)
+
+	suite.mockRepo.On("GetSessionByRefreshHash", ctx, mock.AnythingOfType("string")).Return(&domain.Session{
+		ID:        domain.SessionID("550e8400-e29b-41d4-a716-446655440000"),
+		UserID:    domain.UserID("user-123"),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	suite.mockRepo.On("UpdateSessionLastUsed", ctx, mock.Anything).Return(nil)
+
+	result, err := authService.Refresh(ctx, refreshToken)
+	suite.Require().NoError(err)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(result.AccessToken, claims, func(*jwt.Token) (interface{}, error) {
+		return []byte("test-jwt-secret"), nil
+	})
+	suite.Require().NoError(err)
+	suite.Equal(map[string]interface{}{"org-1": "admin"}, claims["orgs"])
+}
+
+func (suite *AuthServiceTestSuite) TestRefreshSession_StampsIssuerAndAudience() {
+	ctx := context.Background()
+	refreshToken := "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+
+	suite.authService.(*service.Service).SetTokenIdentity("marketplace-prod", "marketplace-prod-api", []string{"nft-marketplace-auth"})
+	suite.mockRepo.On("GetSessionByRefreshHash", ctx, mock.AnythingOfType("string")).Return(&domain.Session{
+		ID:        domain.SessionID("550e8400-e29b-41d4-a716-446655440000"),
+		UserID:    domain.UserID("user-123"),
+		ExpiresAt: time.Now().Add(time.Hour),
+		Issuer:    "nft-marketplace-auth",
+	}, nil)
+	suite.mockRepo.On("UpdateSessionLastUsed", ctx, mock.Anything).Return(nil)
+
+	// A session from the issuer being migrated away from still refreshes, under the new issuer
+	result, err := suite.authService.Refresh(ctx, refreshToken)
+	suite.Require().NoError(err)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(result.AccessToken, claims, func(*jwt.Token) (interface{}, error) {
+		return []byte("test-jwt-secret"), nil
+	})
+	suite.Require().NoError(err)
+	suite.Equal("marketplace-prod", claims["iss"])
+	suite.Equal("marketplace-prod-api", claims["aud"])
+}
+
+func (suite *AuthServiceTestSuite) TestRefreshSession_RejectsOtherEnvironment() {
+	ctx := context.Background()
+	refreshToken := "cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"
+
+	suite.authService.(*service.Service).SetTokenIdentity("marketplace-prod", "marketplace-prod-api", nil)
+	suite.mockRepo.On("GetSessionByRefreshHash", ctx, mock.AnythingOfType("string")).Return(&domain.Session{
+		ID:        domain.SessionID("550e8400-e29b-41d4-a716-446655440000"),
+		UserID:    domain.UserID("user-123"),
+		ExpiresAt: time.Now().Add(time.Hour),
+		Issuer:    "marketplace-staging",
+	}, nil)
+
+	result, err := suite.authService.Refresh(ctx, refreshToken)
+
+	suite.Error(err)
+	suite.Nil(result)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateSessionLastUsed", mock.Anything, mock.Anything)
+}
+
 func (suite *AuthServiceTestSuite) TestRefreshSession_InvalidToken() {
 	ctx := context.Background()
 	refreshToken := "short"
@@ -153,6 +281,34 @@ func (suite *AuthServiceTestSuite) TestRevokeSession_Success() {
 	suite.mockRepo.AssertExpectations(suite.T())
 }
 
+func (suite *AuthServiceTestSuite) TestLogout_PublishesSessionRevoked() {
+	ctx := context.Background()
+	sessionID := "550e8400-e29b-41d4-a716-446655440000"
+	publisher := new(MockAuthEventPublisher)
+	authService := service.NewAuthService(suite.mockRepo, nil, nil, publisher,
+		[]byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false)
+
+	suite.mockRepo.On("GetSession", ctx, domain.SessionID(sessionID)).
+		Return(&domain.Session{ID: domain.SessionID(sessionID), UserID: "user-123"}, nil)
+	suite.mockRepo.On("RevokeSession", ctx, domain.SessionID(sessionID)).Return(nil)
+
+	published := make(chan *domain.AuthSessionRevokedEvent, 1)
+	publisher.On("PublishSessionRevoked", mock.Anything, mock.AnythingOfType("*domain.AuthSessionRevokedEvent")).
+		Run(func(args mock.Arguments) { published <- args.Get(1).(*domain.AuthSessionRevokedEvent) }).
+		Return(nil)
+
+	err := authService.Logout(ctx, sessionID)
+
+	suite.NoError(err)
+	select {
+	case event := <-published:
+		suite.Equal(domain.UserID("user-123"), event.UserID)
+		suite.Equal(domain.SessionID(sessionID), event.SessionID)
+	case <-time.After(time.Second):
+		suite.Fail("session revoked event was not published")
+	}
+}
+
 func TestAuthServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(AuthServiceTestSuite))
 }
@@ -0,0 +1,142 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	protoUser "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	protoWallet "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
+)
+
+const sessionLimitUser = "33333333-3333-3333-3333-333333333333"
+
+// loginUserClient resolves every login to sessionLimitUser
+type loginUserClient struct {
+	protoUser.UserServiceClient
+}
+
+func (c *loginUserClient) EnsureUser(ctx context.Context, in *protoUser.EnsureUserRequest, opts ...grpc.CallOption) (*protoUser.EnsureUserResponse, error) {
+	return &protoUser.EnsureUserResponse{UserId: sessionLimitUser}, nil
+}
+
+func (c *loginUserClient) ListUserOrganizations(ctx context.Context, in *protoUser.ListUserOrganizationsRequest, opts ...grpc.CallOption) (*protoUser.ListUserOrganizationsResponse, error) {
+	return &protoUser.ListUserOrganizationsResponse{}, nil
+}
+
+// linkingWalletClient accepts every wallet link
+type linkingWalletClient struct {
+	protoWallet.WalletServiceClient
+}
+
+func (c *linkingWalletClient) UpsertLink(ctx context.Context, in *protoWallet.UpsertLinkRequest, opts ...grpc.CallOption) (*protoWallet.UpsertLinkResponse, error) {
+	return &protoWallet.UpsertLinkResponse{}, nil
+}
+
+// activeSessions returns n sessions of sessionLimitUser, least recently used first
+func activeSessions(n int) []*domain.Session {
+	sessions := make([]*domain.Session, n)
+	for i := range sessions {
+		lastUsed := time.Now().Add(time.Duration(i-n) * time.Hour)
+		sessions[i] = &domain.Session{ID: domain.SessionID(fmt.Sprintf("session-%c", 'a'+i)), UserID: sessionLimitUser, LastUsedAt: &lastUsed}
+	}
+	return sessions
+}
+
+func newSessionLimitService(t *testing.T, repo *MockAuthRepository, publisher domain.AuthEventPublisher, policy domain.SessionLimitPolicy) *service.Service {
+	authService := service.NewAuthService(repo, &loginUserClient{}, &linkingWalletClient{}, publisher,
+		[]byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	require.NoError(t, authService.SetSessionLimit(2, policy))
+	return authService
+}
+
+// signIn signs a fresh login and makes its nonce usable
+func signIn(t *testing.T, repo *MockAuthRepository) (accountID, message, signature string) {
+	account := testharness.NewSiweAccount(t)
+	message, signature = account.SignIn(t, "marketplace.test", "sessionlimitnonce1", 1)
+	repo.On("TryUseNonce", mock.Anything, "sessionlimitnonce1", account.AccountID(), "eip155:1", "marketplace.test", mock.AnythingOfType("time.Time")).
+		Return(true, nil)
+	return account.AccountID(), message, signature
+}
+
+func TestVerifySiwe_EvictsLeastRecentlyUsedSession(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	publisher := new(MockAuthEventPublisher)
+	accountID, message, signature := signIn(t, repo)
+
+	sessions := activeSessions(2)
+	// The admin's impersonation session neither counts nor gets evicted
+	sessions = append(sessions, &domain.Session{ID: "impersonation", UserID: sessionLimitUser, ImpersonatorID: impersonationAdmin})
+	repo.On("ListActiveSessions", ctx, domain.UserID(sessionLimitUser)).Return(sessions, nil)
+	repo.On("RevokeSession", ctx, domain.SessionID("session-a")).Return(nil)
+	var created *domain.Session
+	repo.On("CreateSession", ctx, mock.AnythingOfType("*domain.Session")).
+		Run(func(args mock.Arguments) { created = args.Get(1).(*domain.Session) }).
+		Return(nil)
+
+	evicted := make(chan *domain.AuthSessionEvictedEvent, 1)
+	publisher.On("PublishSessionEvicted", mock.Anything, mock.AnythingOfType("*domain.AuthSessionEvictedEvent")).
+		Run(func(args mock.Arguments) { evicted <- args.Get(1).(*domain.AuthSessionEvictedEvent) }).
+		Return(nil)
+	publisher.On("PublishUserLoggedIn", mock.Anything, mock.Anything).Return(nil)
+
+	authService := newSessionLimitService(t, repo, publisher, domain.SessionLimitEvictLRU)
+	result, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+	repo.AssertNotCalled(t, "RevokeSession", ctx, domain.SessionID("session-b"))
+	repo.AssertNotCalled(t, "RevokeSession", ctx, domain.SessionID("impersonation"))
+
+	select {
+	case event := <-evicted:
+		assert.Equal(t, domain.UserID(sessionLimitUser), event.UserID)
+		assert.Equal(t, domain.SessionID("session-a"), event.SessionID)
+		require.NotNil(t, created)
+		assert.Equal(t, created.ID, event.EvictedBy)
+	case <-time.After(time.Second):
+		t.Fatal("session.evicted was not published")
+	}
+}
+
+func TestVerifySiwe_RejectsLoginAtSessionLimit(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	accountID, message, signature := signIn(t, repo)
+	repo.On("ListActiveSessions", ctx, domain.UserID(sessionLimitUser)).Return(activeSessions(2), nil)
+
+	authService := newSessionLimitService(t, repo, nil, domain.SessionLimitReject)
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{})
+	assert.ErrorIs(t, err, domain.ErrSessionLimitReached)
+	repo.AssertNotCalled(t, "CreateSession", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "RevokeSession", mock.Anything, mock.Anything)
+}
+
+func TestVerifySiwe_UnderSessionLimit(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	accountID, message, signature := signIn(t, repo)
+	repo.On("ListActiveSessions", ctx, domain.UserID(sessionLimitUser)).Return(activeSessions(1), nil)
+	repo.On("CreateSession", ctx, mock.AnythingOfType("*domain.Session")).Return(nil)
+
+	authService := newSessionLimitService(t, repo, nil, domain.SessionLimitReject)
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{})
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "RevokeSession", mock.Anything, mock.Anything)
+}
+
+func TestSetSessionLimit_RejectsUnknownPolicy(t *testing.T) {
+	authService := service.NewAuthService(new(MockAuthRepository), nil, nil, nil, []byte("a"), []byte("b"), false).(*service.Service)
+	assert.Error(t, authService.SetSessionLimit(3, "evict_newest"))
+	assert.Error(t, authService.SetSessionLimit(-1, domain.SessionLimitReject))
+	assert.NoError(t, authService.SetSessionLimit(0, domain.SessionLimitReject))
+}
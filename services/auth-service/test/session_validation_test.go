@@ -0,0 +1,82 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/grpc"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+func TestValidateSession(t *testing.T) {
+	ctx := context.Background()
+	expired := "550e8400-e29b-41d4-a716-446655440001"
+	revoked := "550e8400-e29b-41d4-a716-446655440002"
+	expiresAt := time.Now().Add(time.Hour)
+
+	repo := new(MockAuthRepository)
+	repo.On("GetSession", ctx, domain.SessionID(ticketSessionID)).Return(&domain.Session{
+		ID: ticketSessionID, UserID: ticketUserID, ExpiresAt: expiresAt,
+	}, nil)
+	repo.On("GetSession", ctx, domain.SessionID(expired)).Return(&domain.Session{
+		ID: expired, UserID: ticketUserID, ExpiresAt: time.Now().Add(-time.Minute),
+	}, nil)
+	repo.On("GetSession", ctx, domain.SessionID(revoked)).Return(nil, errors.New("session not found"))
+	svc := newTicketService(repo, nil)
+
+	session, err := svc.ValidateSession(ctx, ticketUserID, ticketSessionID)
+	require.NoError(t, err)
+	assert.Equal(t, expiresAt, session.ExpiresAt)
+
+	cases := []struct {
+		name      string
+		userID    string
+		sessionID string
+	}{
+		{"malformed session", ticketUserID, "session-1"},
+		{"expired session", ticketUserID, expired},
+		{"revoked session", ticketUserID, revoked},
+		{"another user's session", "33333333-3333-3333-3333-333333333333", ticketSessionID},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.ValidateSession(ctx, tc.userID, tc.sessionID)
+			assert.ErrorIs(t, err, domain.ErrSessionInactive)
+		})
+	}
+}
+
+func TestValidateSessionHandler(t *testing.T) {
+	ctx := context.Background()
+	mockService := new(MockAuthService)
+	handler := grpcHandler.NewgRPCHandler(grpc.NewServer(), mockService)
+
+	_, err := handler.ValidateSession(ctx, &authpb.ValidateSessionRequest{UserId: ticketUserID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockService.On("ValidateSession", ctx, ticketUserID, "revoked").Return(nil, domain.ErrSessionInactive)
+	resp, err := handler.ValidateSession(ctx, &authpb.ValidateSessionRequest{UserId: ticketUserID, SessionId: "revoked"})
+	require.NoError(t, err)
+	assert.False(t, resp.GetActive())
+
+	mockService.On("ValidateSession", ctx, ticketUserID, "broken").Return(nil, errors.New("db down"))
+	_, err = handler.ValidateSession(ctx, &authpb.ValidateSessionRequest{UserId: ticketUserID, SessionId: "broken"})
+	assert.Error(t, err)
+
+	expiresAt := time.Now().Add(time.Hour)
+	mockService.On("ValidateSession", ctx, ticketUserID, ticketSessionID).
+		Return(&domain.Session{ID: ticketSessionID, UserID: ticketUserID, ExpiresAt: expiresAt}, nil)
+	resp, err = handler.ValidateSession(ctx, &authpb.ValidateSessionRequest{UserId: ticketUserID, SessionId: ticketSessionID})
+	require.NoError(t, err)
+	assert.True(t, resp.GetActive())
+	assert.Equal(t, expiresAt.Format(time.RFC3339), resp.GetExpiresAt())
+}
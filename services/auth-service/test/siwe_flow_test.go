@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/encryption"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	protoUser "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	protoWallet "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
+)
+
+// TestSiweLoginFlow signs in with a fresh key against real user and wallet services and
+// checks the user, wallet link and session it leaves behind
+func TestSiweLoginFlow(t *testing.T) {
+	h := testharness.New(t)
+	pg, pgCfg := h.Postgres(t,
+		testharness.Migration("auth-service"),
+		testharness.Migration("user-service"),
+		testharness.Migration("wallet-service"),
+	)
+	rds, rdsCfg := h.Redis(t)
+	_, rmqCfg := h.RabbitMQ(t)
+
+	env := testharness.InfraEnv(pgCfg, rdsCfg, rmqCfg)
+	userAddr := testharness.FreeAddr(t)
+	testharness.StartService(t, "user-service", userAddr, env.With("USER_GRPC_PORT", listenPort(userAddr)))
+	walletAddr := testharness.FreeAddr(t)
+	testharness.StartService(t, "wallet-service", walletAddr, env.With("WALLET_GRPC_PORT", listenPort(walletAddr)))
+
+	userClient := protoUser.NewUserServiceClient(dial(t, userAddr))
+	walletClient := protoWallet.NewWalletServiceClient(dial(t, walletAddr))
+
+	cipher, err := encryption.NewContextCipher("integration-session-context-secret")
+	require.NoError(t, err)
+	jwtSecret := []byte("integration-jwt-secret")
+	authService := service.NewAuthService(
+		repository.NewAuthRepository(pg, rds, cipher),
+		userClient,
+		walletClient,
+		nil,
+		jwtSecret,
+		[]byte("integration-refresh-secret"),
+		false,
+	)
+
+	ctx := context.Background()
+	account := testharness.NewSiweAccount(t)
+
+	nonce, err := authService.GetNonce(ctx, account.AccountID(), "eip155:1", "marketplace.test")
+	require.NoError(t, err)
+
+	message, signature := account.SignIn(t, "marketplace.test", nonce, 1)
+	result, err := authService.VerifySiwe(ctx, account.AccountID(), message, signature, domain.ClientInfo{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+	assert.NotEmpty(t, result.RefreshToken)
+	assert.Equal(t, account.AccountID(), string(result.Address))
+	assert.Equal(t, "eip155:1", string(result.ChainID))
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(result.AccessToken, claims, func(*jwt.Token) (interface{}, error) {
+		return jwtSecret, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, string(result.UserID), claims["sub"])
+
+	t.Run("UserCreated", func(t *testing.T) {
+		resp, err := userClient.EnsureUser(ctx, &protoUser.EnsureUserRequest{
+			AccountId: account.AccountID(),
+			Address:   account.AccountID(),
+			ChainId:   "eip155:1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, string(result.UserID), resp.GetUserId())
+		assert.False(t, resp.GetCreated())
+	})
+
+	t.Run("WalletLinked", func(t *testing.T) {
+		resp, err := walletClient.ListLinks(ctx, &protoWallet.ListLinksRequest{UserId: string(result.UserID)})
+		require.NoError(t, err)
+		require.Len(t, resp.GetLinks(), 1)
+		assert.Equal(t, account.AccountID(), resp.GetLinks()[0].GetAddress())
+		assert.True(t, resp.GetLinks()[0].GetIsPrimary())
+	})
+
+	t.Run("NonceSingleUse", func(t *testing.T) {
+		_, err := authService.VerifySiwe(ctx, account.AccountID(), message, signature, domain.ClientInfo{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		refreshed, err := authService.Refresh(ctx, result.RefreshToken)
+		require.NoError(t, err)
+		assert.Equal(t, result.UserID, refreshed.UserID)
+		assert.NotEmpty(t, refreshed.AccessToken)
+		assert.Equal(t, result.RefreshToken, refreshed.RefreshToken)
+	})
+}
+
+// listenPort turns a reserved host:port into the ":port" form the service configs take
+func listenPort(addr string) string {
+	return addr[strings.LastIndex(addr, ":"):]
+}
+
+func dial(t *testing.T, addr string) *grpc.ClientConn {
+	t.Helper()
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
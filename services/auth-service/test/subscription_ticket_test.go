@@ -0,0 +1,131 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+const (
+	ticketUserID    = "22222222-2222-2222-2222-222222222222"
+	ticketSessionID = "550e8400-e29b-41d4-a716-446655440000"
+)
+
+// memoryTicketStore records stored tickets
+type memoryTicketStore struct {
+	tickets map[string]contracts.SubscriptionTicket
+	ttl     time.Duration
+}
+
+func (s *memoryTicketStore) StoreSubscriptionTicket(ctx context.Context, ticket string, rec contracts.SubscriptionTicket, ttl time.Duration) error {
+	if s.tickets == nil {
+		s.tickets = make(map[string]contracts.SubscriptionTicket)
+	}
+	s.tickets[ticket] = rec
+	s.ttl = ttl
+	return nil
+}
+
+func newTicketService(repo *MockAuthRepository, store domain.SubscriptionTicketStore) *service.Service {
+	authService := service.NewAuthService(repo, nil, nil, nil, []byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	if store != nil {
+		authService.SetSubscriptionTickets(store)
+	}
+	return authService
+}
+
+func TestIssueSubscriptionTicket_BindsSessionAndOrigin(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	repo.On("GetSession", ctx, domain.SessionID(ticketSessionID)).Return(&domain.Session{
+		ID: ticketSessionID, UserID: ticketUserID, ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	store := &memoryTicketStore{}
+
+	first, err := newTicketService(repo, store).IssueSubscriptionTicket(ctx, ticketUserID, ticketSessionID, "https://app.zuno.io")
+	require.NoError(t, err)
+	second, err := newTicketService(repo, store).IssueSubscriptionTicket(ctx, ticketUserID, ticketSessionID, "https://app.zuno.io")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.Ticket, second.Ticket)
+	assert.WithinDuration(t, time.Now().Add(contracts.SubscriptionTicketTTL), first.ExpiresAt, 5*time.Second)
+	assert.Equal(t, contracts.SubscriptionTicketTTL, store.ttl)
+	rec := store.tickets[first.Ticket]
+	assert.Equal(t, ticketUserID, rec.UserID)
+	assert.Equal(t, ticketSessionID, rec.SessionID)
+	assert.Equal(t, "https://app.zuno.io", rec.Origin)
+}
+
+func TestIssueSubscriptionTicket_Refusals(t *testing.T) {
+	ctx := context.Background()
+	expired := "550e8400-e29b-41d4-a716-446655440001"
+	revoked := "550e8400-e29b-41d4-a716-446655440002"
+
+	repo := new(MockAuthRepository)
+	repo.On("GetSession", ctx, domain.SessionID(ticketSessionID)).Return(&domain.Session{
+		ID: ticketSessionID, UserID: ticketUserID, ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	repo.On("GetSession", ctx, domain.SessionID(expired)).Return(&domain.Session{
+		ID: expired, UserID: ticketUserID, ExpiresAt: time.Now().Add(-time.Minute),
+	}, nil)
+	repo.On("GetSession", ctx, domain.SessionID(revoked)).Return(nil, errors.New("session not found"))
+
+	cases := []struct {
+		name      string
+		userID    string
+		sessionID string
+	}{
+		{"malformed session", ticketUserID, "session-1"},
+		{"expired session", ticketUserID, expired},
+		{"revoked session", ticketUserID, revoked},
+		{"another user's session", "33333333-3333-3333-3333-333333333333", ticketSessionID},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &memoryTicketStore{}
+			_, err := newTicketService(repo, store).IssueSubscriptionTicket(ctx, tc.userID, tc.sessionID, "")
+			assert.ErrorIs(t, err, domain.ErrSessionInactive)
+			assert.Empty(t, store.tickets)
+		})
+	}
+
+	_, err := newTicketService(repo, nil).IssueSubscriptionTicket(ctx, ticketUserID, ticketSessionID, "")
+	assert.ErrorIs(t, err, domain.ErrSubscriptionTicketsDisabled)
+}
+
+func TestIssueSubscriptionTicketHandler_MapsErrors(t *testing.T) {
+	ctx := context.Background()
+	mockService := new(MockAuthService)
+	handler := grpcHandler.NewgRPCHandler(grpc.NewServer(), mockService)
+
+	_, err := handler.IssueSubscriptionTicket(ctx, &authpb.IssueSubscriptionTicketRequest{UserId: ticketUserID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockService.On("IssueSubscriptionTicket", ctx, ticketUserID, "revoked", mock.Anything).Return(nil, domain.ErrSessionInactive)
+	_, err = handler.IssueSubscriptionTicket(ctx, &authpb.IssueSubscriptionTicketRequest{UserId: ticketUserID, SessionId: "revoked"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	expiresAt := time.Now().Add(contracts.SubscriptionTicketTTL)
+	mockService.On("IssueSubscriptionTicket", ctx, ticketUserID, ticketSessionID, "https://app.zuno.io").
+		Return(&domain.SubscriptionTicket{Ticket: "ticket-1", ExpiresAt: expiresAt}, nil)
+	resp, err := handler.IssueSubscriptionTicket(ctx, &authpb.IssueSubscriptionTicketRequest{
+		UserId: ticketUserID, SessionId: ticketSessionID, Origin: "https://app.zuno.io",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ticket-1", resp.GetTicket())
+	assert.Equal(t, expiresAt.Format(time.RFC3339), resp.GetExpiresAt())
+}
@@ -0,0 +1,236 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/auth-service/internal/service"
+	protoWallet "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+// flakyWalletClient fails wallet links with err until it is cleared
+type flakyWalletClient struct {
+	protoWallet.WalletServiceClient
+	mu    sync.Mutex
+	err   error
+	links []*protoWallet.UpsertLinkRequest
+}
+
+func (c *flakyWalletClient) UpsertLink(ctx context.Context, in *protoWallet.UpsertLinkRequest, opts ...grpc.CallOption) (*protoWallet.UpsertLinkResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.links = append(c.links, in)
+	return &protoWallet.UpsertLinkResponse{}, nil
+}
+
+func (c *flakyWalletClient) setErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+// memoryOutbox keeps queued wallet links in memory
+type memoryOutbox struct {
+	links     map[string]*domain.PendingWalletLink
+	completed []string
+	lastError map[string]string
+}
+
+func newMemoryOutbox() *memoryOutbox {
+	return &memoryOutbox{links: map[string]*domain.PendingWalletLink{}, lastError: map[string]string{}}
+}
+
+func (o *memoryOutbox) EnqueueWalletLink(ctx context.Context, link *domain.PendingWalletLink) error {
+	o.links[link.ID] = link
+	return nil
+}
+
+func (o *memoryOutbox) ClaimDueWalletLinks(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.PendingWalletLink, error) {
+	var due []*domain.PendingWalletLink
+	for _, link := range o.links {
+		if !link.NextAttemptAt.After(now) && len(due) < limit {
+			claimed := *link
+			link.NextAttemptAt = now.Add(lease)
+			due = append(due, &claimed)
+		}
+	}
+	return due, nil
+}
+
+func (o *memoryOutbox) CompleteWalletLink(ctx context.Context, link *domain.PendingWalletLink) error {
+	delete(o.links, link.ID)
+	o.completed = append(o.completed, link.ID)
+	return nil
+}
+
+func (o *memoryOutbox) RetryWalletLink(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	o.links[id].Attempts++
+	o.links[id].NextAttemptAt = nextAttemptAt
+	o.lastError[id] = lastError
+	return nil
+}
+
+func (o *memoryOutbox) CountPendingWalletLinks(ctx context.Context) (int, error) {
+	return len(o.links), nil
+}
+
+func (o *memoryOutbox) only(t *testing.T) *domain.PendingWalletLink {
+	require.Len(t, o.links, 1)
+	for _, link := range o.links {
+		return link
+	}
+	return nil
+}
+
+func newWalletLinkService(t *testing.T, repo *MockAuthRepository, wallet *flakyWalletClient, outbox domain.WalletLinkOutbox, health func(bool)) *service.Service {
+	authService := service.NewAuthService(repo, &loginUserClient{}, wallet, nil,
+		[]byte("test-jwt-secret"), []byte("test-refresh-jwt-secret"), false).(*service.Service)
+	if outbox != nil {
+		require.NoError(t, authService.SetWalletLinkOutbox(outbox, 10*time.Second, health))
+	}
+	return authService
+}
+
+func TestVerifySiwe_DefersWalletLinkWhileWalletServiceUnavailable(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	accountID, message, signature := signIn(t, repo)
+	var created *domain.Session
+	repo.On("CreateSession", ctx, mock.AnythingOfType("*domain.Session")).
+		Run(func(args mock.Arguments) { created = args.Get(1).(*domain.Session) }).
+		Return(nil)
+
+	wallet := &flakyWalletClient{err: status.Error(codes.Unavailable, "connection refused")}
+	outbox := newMemoryOutbox()
+	var health []bool
+	authService := newWalletLinkService(t, repo, wallet, outbox, func(up bool) { health = append(health, up) })
+
+	result, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+
+	require.NotNil(t, created)
+	assert.True(t, created.WalletLinkPending)
+	link := outbox.only(t)
+	assert.Equal(t, domain.UserID(sessionLimitUser), link.UserID)
+	assert.Equal(t, created.ID, link.SessionID)
+	assert.Equal(t, domain.ChainID("eip155:1"), link.ChainID)
+	assert.Equal(t, []bool{false}, health)
+
+	stats, err := authService.WalletLinkStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WalletLinkStats{Deferred: 1, Pending: 1}, stats)
+}
+
+func TestVerifySiwe_LinksWalletWhenAvailable(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	accountID, message, signature := signIn(t, repo)
+	var created *domain.Session
+	repo.On("CreateSession", ctx, mock.AnythingOfType("*domain.Session")).
+		Run(func(args mock.Arguments) { created = args.Get(1).(*domain.Session) }).
+		Return(nil)
+
+	wallet := &flakyWalletClient{}
+	outbox := newMemoryOutbox()
+	authService := newWalletLinkService(t, repo, wallet, outbox, nil)
+
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{})
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.False(t, created.WalletLinkPending)
+	assert.Empty(t, outbox.links)
+	assert.Len(t, wallet.links, 1)
+}
+
+func TestVerifySiwe_FailsWhileWalletServiceUnavailableWithoutOutbox(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	accountID, message, signature := signIn(t, repo)
+
+	wallet := &flakyWalletClient{err: status.Error(codes.Unavailable, "connection refused")}
+	authService := newWalletLinkService(t, repo, wallet, nil, nil)
+
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{})
+	assert.ErrorContains(t, err, "failed to link wallet")
+	repo.AssertNotCalled(t, "CreateSession", mock.Anything, mock.Anything)
+}
+
+func TestVerifySiwe_DoesNotDeferRefusedWalletLink(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	accountID, message, signature := signIn(t, repo)
+
+	wallet := &flakyWalletClient{err: status.Error(codes.InvalidArgument, "wallet already linked to another user")}
+	outbox := newMemoryOutbox()
+	authService := newWalletLinkService(t, repo, wallet, outbox, nil)
+
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{})
+	assert.ErrorContains(t, err, "failed to link wallet")
+	assert.Empty(t, outbox.links)
+	repo.AssertNotCalled(t, "CreateSession", mock.Anything, mock.Anything)
+}
+
+func TestReconcileWalletLinks(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAuthRepository)
+	accountID, message, signature := signIn(t, repo)
+	repo.On("CreateSession", ctx, mock.AnythingOfType("*domain.Session")).Return(nil)
+
+	wallet := &flakyWalletClient{err: status.Error(codes.DeadlineExceeded, "deadline exceeded")}
+	outbox := newMemoryOutbox()
+	var health []bool
+	authService := newWalletLinkService(t, repo, wallet, outbox, func(up bool) { health = append(health, up) })
+
+	_, err := authService.VerifySiwe(ctx, accountID, message, signature, domain.ClientInfo{})
+	require.NoError(t, err)
+	link := outbox.only(t)
+	due := link.NextAttemptAt
+
+	// Nothing is attempted before the link is due
+	made, err := authService.ReconcileWalletLinks(ctx, due.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Zero(t, made)
+
+	// Still down: the link backs off, doubling per failed attempt
+	made, err = authService.ReconcileWalletLinks(ctx, due)
+	require.NoError(t, err)
+	assert.Zero(t, made)
+	assert.Equal(t, 1, link.Attempts)
+	assert.Equal(t, due.Add(10*time.Second), link.NextAttemptAt)
+	assert.Contains(t, outbox.lastError[link.ID], "deadline exceeded")
+
+	made, err = authService.ReconcileWalletLinks(ctx, link.NextAttemptAt)
+	require.NoError(t, err)
+	assert.Zero(t, made)
+	assert.Equal(t, 2, link.Attempts)
+	assert.Equal(t, due.Add(30*time.Second), link.NextAttemptAt)
+
+	// Back up: the link is made and leaves the outbox
+	wallet.setErr(nil)
+	made, err = authService.ReconcileWalletLinks(ctx, link.NextAttemptAt)
+	require.NoError(t, err)
+	assert.Equal(t, 1, made)
+	assert.Equal(t, []string{link.ID}, outbox.completed)
+	require.Len(t, wallet.links, 1)
+	assert.Equal(t, sessionLimitUser, wallet.links[0].GetUserId())
+	assert.True(t, wallet.links[0].GetIsPrimary())
+	assert.Equal(t, []bool{false, false, false, true}, health)
+
+	stats, err := authService.WalletLinkStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WalletLinkStats{Deferred: 1, Reconciled: 1, Retries: 2}, stats)
+}
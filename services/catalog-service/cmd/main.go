@@ -3,23 +3,43 @@ package main
 import (
 	"context"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/artifacts"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/chain"
 	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/events"
+	grpc_handler "github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/httpapi"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/metadata"
 	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/repository"
 	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/grpcserver"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	chainregistrypb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
 	cfg := config.NewConfig()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("catalog-service"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -59,6 +79,14 @@ func main() {
 	// Initialize repositories
 	collectionRepo := repository.NewCollectionRepository(postgresClient, redisClient)
 	processedEventRepo := repository.NewProcessedEventRepository(postgresClient, redisClient)
+	moderationRepo := repository.NewModerationRepository(postgresClient, redisClient)
+	reportRepo := repository.NewReportRepository(postgresClient)
+	earningsRepo := repository.NewEarningsRepository(postgresClient)
+	schedulerRepo := repository.NewSchedulerRepository(redisClient)
+	auctionRepo := repository.NewAuctionRepository(postgresClient)
+	watchlistRepo := repository.NewWatchlistRepository(postgresClient)
+	tokenSupplyRepo := repository.NewTokenSupplyRepository(postgresClient)
+	walletActivityRepo := repository.NewWalletActivityRepository(postgresClient)
 
 	// Initialize event consumer and publisher
 	consumer := events.NewEventConsumer(amqpClient, cfg.ConsumerConfig)
@@ -68,11 +96,87 @@ func main() {
 	catalogService := service.NewCatalogService(
 		collectionRepo,
 		processedEventRepo,
+		moderationRepo,
+		reportRepo,
+		earningsRepo,
+		schedulerRepo,
+		auctionRepo,
+		watchlistRepo,
+		tokenSupplyRepo,
+		walletActivityRepo,
 		publisher,
 	)
+	catalogService.SetReportRateLimit(cfg.ReportConfig.RateLimit, time.Duration(cfg.ReportConfig.WindowMinutes)*time.Minute)
+	catalogService.SetExpiryLead(time.Duration(cfg.SchedulerConfig.ExpiryLeadMinutes) * time.Minute)
+	catalogService.SetDropLead(time.Duration(cfg.SchedulerConfig.DropLeadMinutes) * time.Minute)
+	catalogService.SetMetadataFetcher(metadata.NewFetcher(cfg.IPFSGatewayURL))
+	catalogService.SetSystemStatus(amqpClient, redisClient, cfg.StatusQueues)
+	if err := catalogService.SetActivityPartitions(
+		repository.NewActivityPartitionRepository(postgresClient, cfg.PartitionConfig.ColdTablespace),
+		domain.PartitionPolicy{
+			AheadMonths:     cfg.PartitionConfig.AheadMonths,
+			HotMonths:       cfg.PartitionConfig.HotMonths,
+			RetentionMonths: cfg.PartitionConfig.RetentionMonths,
+		},
+	); err != nil {
+		log.Fatalf("Invalid activity partition policy: %v", err)
+	}
+
+	// Holder snapshot and collection exports are stored by media-service; the connection is
+	// lazy, so snapshots still index ownership while it is down
+	mediaConn, err := grpc.Dial(cfg.MediaServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to media-service: %v", err)
+	}
+	defer mediaConn.Close()
+	mediaStore := artifacts.NewMediaStore(mediapb.NewMediaServiceClient(mediaConn))
+	catalogService.SetHolderSnapshots(repository.NewHolderSnapshotRepository(postgresClient), mediaStore)
+	catalogService.SetHoldingsNotifier(publisher)
+	catalogService.SetCollectionExports(repository.NewCollectionExportRepository(postgresClient), mediaStore, publisher)
+	catalogService.SetRentals(repository.NewRentalRepository(postgresClient))
+	if cfg.TokenGateSecret != "" {
+		catalogService.SetTokenGates([]byte(cfg.TokenGateSecret), time.Duration(cfg.TokenGateTTLSeconds)*time.Second)
+	}
+
+	// Resyncs read the chain over the endpoints the chain registry lists; the connection is
+	// lazy like media-service's
+	registryConn, err := grpc.Dial(cfg.ChainRegistryURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to chain-registry-service: %v", err)
+	}
+	defer registryConn.Close()
+	catalogService.SetResync(
+		repository.NewResyncRepository(postgresClient),
+		chain.NewReader(chainregistrypb.NewChainRegistryServiceClient(registryConn)),
+	)
+	catalogService.SetDelegations(
+		chain.NewDelegations(chainregistrypb.NewChainRegistryServiceClient(registryConn), cfg.DelegationRegistry),
+		time.Duration(cfg.DelegationCacheTTLSeconds)*time.Second,
+	)
+
+	catalogService.SetLocalizedContent(repository.NewLocalizedContentRepository(postgresClient))
+	catalogService.SetPayoutSplits(repository.NewPayoutSplitRepository(postgresClient))
+	catalogService.SetDrops(repository.NewDropRepository(postgresClient))
+	catalogService.SetMintStats(repository.NewMintStatsRepository(postgresClient), redisClient)
+	catalogService.SetRecommendations(repository.NewRecommendationRepository(postgresClient), domain.SimilarityWeights{
+		Holders:  cfg.Recommendations.HolderWeight,
+		Category: cfg.Recommendations.CategoryWeight,
+		Tags:     cfg.Recommendations.TagWeight,
+	})
+
+	// Apply each collection's, token's and intent's events in the order their producer stamped
+	catalogService.SetEventSequencing(repository.NewEventSequenceRepository(postgresClient),
+		time.Duration(cfg.ParkedEventTimeoutSeconds)*time.Second)
 
 	// Setup event handlers
-	consumer.RegisterCollectionEventHandler(catalogService.HandleCollectionCreated)
+	consumer.RegisterCollectionEventHandler(catalogService.Sequenced("collection_created", catalogService.HandleCollectionCreated))
+	consumer.RegisterCollectionUpdatedHandler(catalogService.Sequenced("collection_updated", catalogService.HandleCollectionUpdated))
+	consumer.RegisterConfirmationsHandler(catalogService.Sequenced("collection_confirmations", catalogService.HandleCollectionConfirmations))
+	consumer.RegisterDecodedEventHandler(catalogService.Sequenced("collection_decoded", catalogService.HandleDecodedEvent))
+	consumer.RegisterSaleIndexedHandler(catalogService.Sequenced("sale_indexed", catalogService.HandleSaleIndexed))
+	consumer.RegisterMarketEventHandler(catalogService.HandleMarketEvent)
+	consumer.RegisterIntentTrackedHandler(catalogService.Sequenced("intent_tx_tracked", catalogService.HandleIntentTxTracked))
+	consumer.RegisterCollectionImportedHandler(catalogService.HandleCollectionImported)
 
 	// Start consuming events in a separate goroutine
 	go func() {
@@ -82,6 +186,54 @@ func main() {
 		}
 	}()
 
+	// Apply events parked ahead of a gap that did not fill in time
+	go catalogService.RunParkedEventRelease(ctx, time.Duration(cfg.ParkedEventPollSeconds)*time.Second)
+
+	// Report consumer lag for the systemStatus query
+	go consumer.ReportLag(ctx, time.Duration(cfg.ConsumerConfig.LagReportSeconds)*time.Second, redisClient.WriteConsumerLag)
+
+	// Fire expiring offer/listing, auction ended and drop starting alerts
+	go catalogService.RunScheduler(ctx, time.Duration(cfg.SchedulerConfig.PollIntervalSeconds)*time.Second)
+
+	// Fire watchlist "floor dropped below" alerts
+	go catalogService.RunWatchlistAlerts(ctx, time.Duration(cfg.WatchlistConfig.AlertIntervalSeconds)*time.Second)
+
+	// Keep wallet_activity partitions ahead of writes and archive the cold ones
+	go catalogService.RunActivityPartitions(ctx, time.Duration(cfg.PartitionConfig.IntervalMinutes)*time.Minute)
+
+	// Recompute related collections from shared holders, categories and tags
+	go catalogService.RunRecommendations(ctx, time.Duration(cfg.Recommendations.RefreshMinutes)*time.Minute)
+
+	// Generate large sales and mints exports and notify their requesters
+	go catalogService.RunCollectionExports(ctx, time.Duration(cfg.ExportPollSeconds)*time.Second)
+
+	// Expose event deduplication metrics for scraping
+	if cfg.HTTPPort != "" {
+		httpServer := &http.Server{
+			Addr:              cfg.HTTPPort,
+			Handler:           httpapi.NewHandler(catalogService),
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+		go func() {
+			log.Printf("Metrics endpoint listening on %s", cfg.HTTPPort)
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Printf("Metrics endpoint stopped: %v", err)
+			}
+		}()
+		defer httpServer.Close()
+	}
+
+	// Serve catalog queries and moderation over gRPC
+	server := grpcserver.New(grpcserver.LoadConfig("catalog-service"))
+	catalogpb.RegisterCatalogServiceServer(server, grpc_handler.NewGRPCHandler(catalogService))
+
+	go func() {
+		log.Printf("Catalog gRPC server listening on %s", cfg.GRPCPort)
+		if err := grpcserver.ListenAndServe(server, cfg.GRPCPort); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
 	log.Println("Catalog service started successfully")
 
 	// Wait for shutdown signal
@@ -92,6 +244,8 @@ func main() {
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
 
+	server.GracefulStop()
+
 	// Stop the consumer gracefully
 	if err := consumer.Stop(shutdownCtx); err != nil {
 		log.Printf("Error during consumer shutdown: %v", err)
@@ -1,6 +1,8 @@
 -- catalog_db schema
 -- Recommended extensions (optional)
 -- CREATE EXTENSION IF NOT EXISTS "pgcrypto"; -- for gen_random_uuid()
+-- Trigram indexes back search autocomplete
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
 
 -- =========================
 -- Collections & bindings
@@ -51,6 +53,93 @@ CREATE INDEX IF NOT EXISTS idx_collections_chain_contract ON collections(chain_i
 CREATE INDEX IF NOT EXISTS idx_collections_creator ON collections(creator);
 CREATE INDEX IF NOT EXISTS idx_collections_tx_hash ON collections(tx_hash);
 
+-- Organization (user-service) managing the collection; NULL when the creator manages it alone
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS owner_org_id uuid;
+CREATE INDEX IF NOT EXISTS idx_collections_owner_org ON collections(owner_org_id) WHERE owner_org_id IS NOT NULL;
+
+-- Orchestrator intent that deployed the collection and the user who sent it; NULL for
+-- collections deployed outside the marketplace
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS intent_id text;
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS created_by_user_id uuid;
+CREATE INDEX IF NOT EXISTS idx_collections_created_by_user ON collections(created_by_user_id, created_at DESC) WHERE created_by_user_id IS NOT NULL;
+
+-- Depth of the deployment block, refreshed from indexer events until it reaches the chain's
+-- registry confirmations; reads skip unconfirmed collections unless asked to include them
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS confirmations integer NOT NULL DEFAULT 0;
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS required_confirmations integer NOT NULL DEFAULT 0;
+
+-- Numeric floor and volume for the listing filters and sorts; the text columns keep wei as written
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS floor_price_wei numeric
+  GENERATED ALWAYS AS (NULLIF(floor_price, '')::numeric) STORED;
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS volume_traded_wei numeric
+  GENERATED ALWAYS AS (NULLIF(volume_traded, '')::numeric) STORED;
+CREATE INDEX IF NOT EXISTS idx_collections_chain_created ON collections(chain_id, created_at DESC, id);
+CREATE INDEX IF NOT EXISTS idx_collections_chain_floor ON collections(chain_id, floor_price_wei, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_collections_chain_volume ON collections(chain_id, volume_traded_wei DESC, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_collections_verified_created ON collections(created_at DESC) WHERE is_verified;
+CREATE INDEX IF NOT EXISTS idx_collections_creator_lower ON collections(lower(creator), created_at DESC);
+-- contractURI the contract returned at the last resync; NULL until one ran
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS contract_uri text;
+-- Autocomplete matches names by prefix and by trigram word similarity
+CREATE INDEX IF NOT EXISTS idx_collections_name_trgm ON collections USING gin (lower(name) gin_trgm_ops);
+-- Creator-set classification, compared by related-collection recommendations
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS category text;
+ALTER TABLE collections ADD COLUMN IF NOT EXISTS tags text[] NOT NULL DEFAULT '{}';
+
+-- Related collections ranked by shared holders, category and tags; rebuilt on a schedule
+CREATE TABLE IF NOT EXISTS collection_similarities (
+  collection_id  uuid NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  related_id     uuid NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  score          double precision NOT NULL,
+  shared_holders integer NOT NULL DEFAULT 0,
+  refreshed_at   timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (collection_id, related_id)
+);
+CREATE INDEX IF NOT EXISTS idx_collection_similarities_rank ON collection_similarities(collection_id, score DESC);
+
+-- Intent links from intent_tx_tracked, keyed by deployment tx; applied to the collection
+-- row whichever of the link and the indexed collection arrives last
+CREATE TABLE IF NOT EXISTS collection_intent_links (
+  chain_id           text NOT NULL,
+  tx_hash            text NOT NULL, -- lowercase
+  intent_id          text NOT NULL,
+  created_by_user_id uuid,
+  created_at         timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (chain_id, tx_hash)
+);
+
+-- Former slugs of renamed collections, so old links keep resolving. A slug here is never
+-- handed to another collection.
+CREATE TABLE IF NOT EXISTS collection_slug_redirects (
+  slug          text PRIMARY KEY,
+  collection_id uuid NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  created_at    timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_collection_slug_redirects_collection ON collection_slug_redirects(collection_id);
+
+-- Creator-written description and tagline per locale, shown over the on-chain description
+-- to viewers who prefer the locale
+CREATE TABLE IF NOT EXISTS collection_localized_content (
+  chain_id           text NOT NULL,
+  contract_address   text NOT NULL,
+  locale             text NOT NULL, -- BCP 47
+  description        text NOT NULL DEFAULT '',
+  tagline            text NOT NULL DEFAULT '',
+  updated_by_user_id uuid,
+  updated_at         timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (chain_id, contract_address, locale)
+);
+
+CREATE TABLE IF NOT EXISTS collection_payout_splits (
+  chain_id         text NOT NULL,
+  contract_address text NOT NULL,
+  position         integer NOT NULL, -- order of the recipient on-chain
+  recipient        text NOT NULL,
+  bps              integer NOT NULL CHECK (bps > 0 AND bps <= 10000),
+  updated_at       timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (chain_id, contract_address, position)
+);
+
 CREATE TABLE IF NOT EXISTS collection_roles (
   chain_id     text NOT NULL,
   address      text NOT NULL,
@@ -113,6 +202,13 @@ CREATE UNIQUE INDEX IF NOT EXISTS uq_tokens_collection_token_number
   ON tokens(collection_id, token_number);
 CREATE INDEX IF NOT EXISTS idx_tokens_contract_token
   ON tokens(chain_id, contract_address, token_number);
+-- ListTokens: newest mints of a collection, and tokens by owner
+CREATE INDEX IF NOT EXISTS idx_tokens_collection_minted
+  ON tokens(collection_id, minted_at DESC, token_number) WHERE NOT COALESCE(burned, false);
+CREATE INDEX IF NOT EXISTS idx_tokens_owner_lower
+  ON tokens(lower(owner_address)) WHERE NOT COALESCE(burned, false);
+CREATE INDEX IF NOT EXISTS idx_tokens_name_trgm
+  ON tokens USING gin (lower(name) gin_trgm_ops) WHERE NOT COALESCE(burned, false) AND name IS NOT NULL;
 
 CREATE TABLE IF NOT EXISTS traits (
   id               uuid PRIMARY KEY,
@@ -150,6 +246,11 @@ CREATE TABLE IF NOT EXISTS token_trait_links (
   trait_value_id  uuid NOT NULL REFERENCES trait_values(id) ON DELETE CASCADE,
   PRIMARY KEY (token_id, trait_id, trait_value_id)
 );
+-- Trait filters probe a token's links to one trait
+CREATE INDEX IF NOT EXISTS idx_token_trait_links_trait_value
+  ON token_trait_links(trait_id, trait_value_id, token_id);
+CREATE INDEX IF NOT EXISTS idx_trait_values_normalized
+  ON trait_values(trait_id, normalized_value);
 
 -- =========================
 -- Balances, ownership & flags
@@ -178,6 +279,33 @@ CREATE TABLE IF NOT EXISTS ownership_transfers (
   PRIMARY KEY (chain_id, contract, token_id, tx_hash, log_index)
 );
 CREATE INDEX IF NOT EXISTS idx_transfers_to ON ownership_transfers(to_addr);
+-- Block and amount moved, so holders can be computed as of any block (holder snapshots)
+ALTER TABLE ownership_transfers ADD COLUMN IF NOT EXISTS block_number bigint NOT NULL DEFAULT 0;
+ALTER TABLE ownership_transfers ADD COLUMN IF NOT EXISTS quantity numeric(78,0) NOT NULL DEFAULT 1;
+CREATE INDEX IF NOT EXISTS idx_transfers_contract_block ON ownership_transfers(chain_id, contract, block_number);
+
+-- Holders of a collection as of a block, materialized for airdrops
+CREATE TABLE IF NOT EXISTS holder_snapshots (
+  id                uuid PRIMARY KEY,
+  chain_id          text NOT NULL,
+  contract_address  text NOT NULL,
+  block_number      bigint NOT NULL,
+  requested_by      text NOT NULL,
+  holder_count      integer NOT NULL DEFAULT 0,
+  total_quantity    numeric(78,0) NOT NULL DEFAULT 0,
+  csv_artifact_id   text NOT NULL DEFAULT '',
+  json_artifact_id  text NOT NULL DEFAULT '',
+  created_at        timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_holder_snapshots_collection ON holder_snapshots(chain_id, contract_address, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS holder_snapshot_entries (
+  snapshot_id  uuid NOT NULL REFERENCES holder_snapshots(id) ON DELETE CASCADE,
+  holder       text NOT NULL,
+  balance      numeric(78,0) NOT NULL,
+  token_count  integer NOT NULL,
+  PRIMARY KEY (snapshot_id, holder)
+);
 
 CREATE TABLE IF NOT EXISTS nft_flags (
   chain_id     text NOT NULL,
@@ -193,6 +321,98 @@ CREATE TABLE IF NOT EXISTS nft_flags (
   PRIMARY KEY (chain_id, contract, token_id)
 );
 
+-- Moderation: stolen/infringing items hidden from public queries.
+-- token_id = '' marks the whole collection.
+CREATE TABLE IF NOT EXISTS moderation_flags (
+  id               uuid PRIMARY KEY,
+  chain_id         text NOT NULL,
+  contract_address text NOT NULL,
+  token_id         text NOT NULL DEFAULT '',
+  status           text NOT NULL CHECK (status IN ('reported','flagged','cleared')),
+  reason           text NOT NULL,
+  note             text,
+  source           text NOT NULL CHECK (source IN ('admin','report')),
+  actor_id         text,
+  created_at       timestamptz NOT NULL DEFAULT now(),
+  updated_at       timestamptz NOT NULL DEFAULT now(),
+  UNIQUE (chain_id, contract_address, token_id)
+);
+CREATE INDEX IF NOT EXISTS idx_moderation_flags_status ON moderation_flags(status);
+
+-- User reports; open reports from the same user on the same target collapse into one
+CREATE TABLE IF NOT EXISTS reports (
+  id               uuid PRIMARY KEY,
+  target_type      text NOT NULL CHECK (target_type IN ('collection','token')),
+  target_id        text NOT NULL,
+  reporter_id      text NOT NULL,
+  reason           text NOT NULL,
+  details          text,
+  status           text NOT NULL DEFAULT 'open' CHECK (status IN ('open','upheld','dismissed')),
+  resolved_by      text,
+  resolution_note  text,
+  created_at       timestamptz NOT NULL DEFAULT now(),
+  updated_at       timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_reports_open_per_reporter
+  ON reports(target_type, target_id, reporter_id) WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_reports_reporter_created ON reports(reporter_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports(status);
+
+-- Drop calendar: off-platform drops creators submit for curation; approved ones are listed
+-- next to indexed collections whose mint_start_time is in the future
+CREATE TABLE IF NOT EXISTS drop_submissions (
+  id               uuid PRIMARY KEY,
+  chain_id         text NOT NULL,
+  contract_address text NOT NULL DEFAULT '', -- may be unknown before deployment
+  name             text NOT NULL,
+  description      text NOT NULL DEFAULT '',
+  image_url        text NOT NULL DEFAULT '',
+  external_url     text NOT NULL,
+  mint_price       text NOT NULL DEFAULT '', -- wei
+  starts_at        timestamptz NOT NULL,
+  ends_at          timestamptz,
+  submitted_by     text NOT NULL,
+  status           text NOT NULL DEFAULT 'pending' CHECK (status IN ('pending','approved','rejected')),
+  reviewed_by      text NOT NULL DEFAULT '',
+  review_note      text NOT NULL DEFAULT '',
+  created_at       timestamptz NOT NULL DEFAULT now(),
+  updated_at       timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_drop_submissions_approved_start ON drop_submissions(starts_at) WHERE status = 'approved';
+CREATE INDEX IF NOT EXISTS idx_drop_submissions_status_created ON drop_submissions(status, created_at DESC);
+-- mint_start_time holds unix seconds as text; the calendar ranges over its numeric value
+-- and the expression must match the repository's
+CREATE INDEX IF NOT EXISTS idx_collections_mint_start
+  ON collections ((CASE WHEN mint_start_time ~ '^[0-9]{1,12}$' THEN mint_start_time::bigint END));
+
+-- Watchlist: favorited collections and tokens per user; token_id = '' marks a collection.
+-- floor_at_add is the collection floor when favorited, the baseline for floor deltas.
+CREATE TABLE IF NOT EXISTS watchlist_items (
+  id                 uuid PRIMARY KEY,
+  user_id            text NOT NULL,
+  target_type        text NOT NULL CHECK (target_type IN ('collection','token')),
+  chain_id           text NOT NULL,
+  contract_address   text NOT NULL,
+  token_id           text NOT NULL DEFAULT '',
+  floor_at_add       numeric(78,0) NOT NULL DEFAULT 0,
+  floor_alert_below  numeric(78,0) CHECK (floor_alert_below > 0),
+  alert_fired_at     timestamptz,
+  created_at         timestamptz NOT NULL DEFAULT now(),
+  UNIQUE (user_id, chain_id, contract_address, token_id)
+);
+CREATE INDEX IF NOT EXISTS idx_watchlist_items_user ON watchlist_items(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_watchlist_items_alerts ON watchlist_items(id) WHERE floor_alert_below IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS saved_searches (
+  id          uuid PRIMARY KEY,
+  user_id     text NOT NULL,
+  name        text NOT NULL,
+  query       text NOT NULL DEFAULT '',
+  filters     jsonb NOT NULL DEFAULT '{}'::jsonb,
+  created_at  timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user ON saved_searches(user_id, created_at DESC);
+
 -- =========================
 -- Market data: marketplaces, listings, offers, sales
 -- =========================
@@ -218,6 +438,8 @@ CREATE TABLE IF NOT EXISTS listings (
 );
 CREATE INDEX IF NOT EXISTS idx_listings_token_active ON listings(token_id) WHERE is_active = true;
 CREATE INDEX IF NOT EXISTS idx_listings_market_active ON listings(marketplace_id) WHERE is_active = true;
+-- Lowest active price per token without touching the heap
+CREATE INDEX IF NOT EXISTS idx_listings_token_price_active ON listings(token_id, price_native) WHERE is_active = true;
 
 CREATE TABLE IF NOT EXISTS offers (
   id                 uuid PRIMARY KEY,
@@ -246,6 +468,171 @@ CREATE TABLE IF NOT EXISTS sales (
 );
 CREATE INDEX IF NOT EXISTS idx_sales_token_time ON sales(token_id, occurred_at DESC);
 
+-- Royalty ledger fed by sale.indexed events; one row per sale event
+CREATE TABLE IF NOT EXISTS royalty_earnings (
+  event_id          text PRIMARY KEY,
+  chain_id          text NOT NULL,
+  contract_address  text NOT NULL,
+  token_id          text NOT NULL DEFAULT '',
+  recipient         text NOT NULL,
+  currency          text NOT NULL,
+  amount            numeric(78,0) NOT NULL CHECK (amount >= 0),
+  sale_price        numeric(78,0) NOT NULL,
+  tx_hash           text NOT NULL,
+  occurred_at       timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_royalty_earnings_recipient_time ON royalty_earnings(recipient, occurred_at DESC);
+
+-- =========================
+-- Auctions (folded from AuctionHouse events)
+-- =========================
+CREATE TABLE IF NOT EXISTS auctions (
+  chain_id          text NOT NULL,
+  auction_id        text NOT NULL,
+  auction_house     text NOT NULL,
+  contract_address  text NOT NULL,
+  token_id          text NOT NULL,
+  seller            text NOT NULL,
+  auction_type      text NOT NULL CHECK (auction_type IN ('english','dutch')),
+  start_price       numeric(78,0) NOT NULL,
+  reserve_price     numeric(78,0) NOT NULL DEFAULT 0,
+  end_price         numeric(78,0) NOT NULL DEFAULT 0,
+  highest_bid       numeric(78,0) NOT NULL DEFAULT 0,
+  highest_bidder    text NOT NULL DEFAULT '',
+  bid_count         integer NOT NULL DEFAULT 0,
+  status            text NOT NULL DEFAULT 'active' CHECK (status IN ('active','settled','cancelled')),
+  winner            text NOT NULL DEFAULT '',
+  start_time        timestamptz NOT NULL,
+  end_time          timestamptz NOT NULL,
+  updated_at        timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (chain_id, auction_id)
+);
+CREATE INDEX IF NOT EXISTS idx_auctions_token ON auctions(chain_id, contract_address, token_id);
+
+CREATE TABLE IF NOT EXISTS auction_bids (
+  event_id    text PRIMARY KEY,
+  chain_id    text NOT NULL,
+  auction_id  text NOT NULL,
+  bidder      text NOT NULL,
+  amount      numeric(78,0) NOT NULL CHECK (amount > 0),
+  tx_hash     text NOT NULL,
+  placed_at   timestamptz NOT NULL,
+  FOREIGN KEY (chain_id, auction_id) REFERENCES auctions(chain_id, auction_id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_auction_bids_auction ON auction_bids(chain_id, auction_id, placed_at DESC);
+
+-- =========================
+-- ERC-1155 supply (folded from TransferSingle/TransferBatch mints and burns)
+-- =========================
+CREATE TABLE IF NOT EXISTS token_supply (
+  chain_id          text NOT NULL,
+  contract_address  text NOT NULL,
+  token_id          text NOT NULL,
+  minted            numeric(78,0) NOT NULL DEFAULT 0,
+  burned            numeric(78,0) NOT NULL DEFAULT 0,
+  updated_at        timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (chain_id, contract_address, token_id)
+);
+
+-- Transfer events already folded into token_supply, so redeliveries don't count twice
+CREATE TABLE IF NOT EXISTS token_supply_events (
+  event_id    text PRIMARY KEY,
+  applied_at  timestamptz NOT NULL DEFAULT now()
+);
+
+-- =========================
+-- Rentals: ERC-4907 users (record_id '') and ERC-5006 user records, latest event wins
+-- =========================
+CREATE TABLE IF NOT EXISTS token_rentals (
+  chain_id          text NOT NULL,
+  contract_address  text NOT NULL,
+  token_id          text NOT NULL,
+  record_id         text NOT NULL DEFAULT '',
+  standard          text NOT NULL,
+  renter            text NOT NULL DEFAULT '', -- '' once cleared
+  owner             text NOT NULL DEFAULT '',
+  amount            numeric(78,0) NOT NULL DEFAULT 1,
+  expires_at        timestamptz,
+  block_number      bigint NOT NULL,
+  log_index         integer NOT NULL,
+  updated_at        timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (chain_id, contract_address, token_id, record_id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_token_rentals_record
+  ON token_rentals(chain_id, contract_address, record_id) WHERE record_id <> '';
+
+-- =========================
+-- Mint analytics per collection and minute (live drop dashboards)
+-- =========================
+CREATE TABLE IF NOT EXISTS mint_stats (
+  chain_id          text NOT NULL,
+  contract_address  text NOT NULL,
+  minute            timestamptz NOT NULL,
+  mints             numeric(78,0) NOT NULL DEFAULT 0,
+  revenue           numeric(78,0) NOT NULL DEFAULT 0,
+  PRIMARY KEY (chain_id, contract_address, minute)
+);
+
+-- Wallets that minted in each bucket, for unique minter counts across windows
+CREATE TABLE IF NOT EXISTS mint_stats_minters (
+  chain_id          text NOT NULL,
+  contract_address  text NOT NULL,
+  minute            timestamptz NOT NULL,
+  minter            text NOT NULL,
+  PRIMARY KEY (chain_id, contract_address, minute, minter)
+);
+
+-- Mint events already folded into mint_stats, so redeliveries don't count twice
+CREATE TABLE IF NOT EXISTS mint_stats_events (
+  event_id    text PRIMARY KEY,
+  applied_at  timestamptz NOT NULL DEFAULT now()
+);
+
+-- Transfers and sales per wallet for activity timelines; one row per token moved.
+-- Partitioned by month: the catalog creates upcoming partitions, detaches ones past the hot
+-- window to cold storage and drops them after retention. Rows outside every attached month
+-- land in wallet_activity_default until their partition is created.
+CREATE TABLE IF NOT EXISTS wallet_activity (
+  id                text NOT NULL,
+  chain_id          text NOT NULL,
+  contract_address  text NOT NULL,
+  token_id          text NOT NULL,
+  kind              text NOT NULL,     -- mint | burn | transfer | sale
+  from_address      text NOT NULL DEFAULT '',
+  to_address        text NOT NULL DEFAULT '',
+  quantity          numeric(78,0) NOT NULL DEFAULT 1,
+  price             numeric(78,0),
+  currency          text NOT NULL DEFAULT '',
+  tx_hash           text NOT NULL,
+  occurred_at       timestamptz NOT NULL,
+  PRIMARY KEY (id, occurred_at)
+) PARTITION BY RANGE (occurred_at);
+CREATE TABLE IF NOT EXISTS wallet_activity_default PARTITION OF wallet_activity DEFAULT;
+CREATE INDEX IF NOT EXISTS idx_wallet_activity_from_time ON wallet_activity(from_address, occurred_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_wallet_activity_to_time ON wallet_activity(to_address, occurred_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_wallet_activity_collection_kind_time ON wallet_activity(chain_id, contract_address, kind, occurred_at, id);
+
+-- Sales and mints CSV exports of a collection. Large periods are generated in the background:
+-- pending exports are claimed (running, claimed_at) and ready ones point at a media-service
+-- artifact; an expired artifact sends the export back to pending.
+CREATE TABLE IF NOT EXISTS collection_exports (
+  id                uuid PRIMARY KEY,
+  kind              text NOT NULL,     -- sales | mints
+  chain_id          text NOT NULL,
+  contract_address  text NOT NULL,
+  period_from       timestamptz NOT NULL,
+  period_to         timestamptz NOT NULL,
+  requested_by      text NOT NULL,
+  status            text NOT NULL,     -- pending | running | ready | failed
+  row_count         integer NOT NULL DEFAULT 0,
+  artifact_id       text NOT NULL DEFAULT '',
+  error             text NOT NULL DEFAULT '',
+  claimed_at        timestamptz,
+  created_at        timestamptz NOT NULL DEFAULT now(),
+  completed_at      timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_collection_exports_pending ON collection_exports(created_at) WHERE status IN ('pending', 'running');
+
 -- =========================
 -- Orders (optional generalization) & fills
 -- =========================
@@ -293,6 +680,7 @@ CREATE TABLE IF NOT EXISTS token_rarity (
   token_id               uuid PRIMARY KEY REFERENCES tokens(id) ON DELETE CASCADE,
   rarity_score_product   double precision
 );
+CREATE INDEX IF NOT EXISTS idx_token_rarity_score ON token_rarity(rarity_score_product DESC) INCLUDE (token_id);
 
 CREATE TABLE IF NOT EXISTS rarity_scores (
   token_id   uuid NOT NULL REFERENCES tokens(id) ON DELETE CASCADE,
@@ -357,6 +745,31 @@ CREATE TABLE IF NOT EXISTS processed_events (
 );
 -- Helpful for dedupe/inspection
 CREATE INDEX IF NOT EXISTS idx_processed_chain_block ON processed_events(chain_id, block_hash);
+-- Replays and backfills may re-deliver a log under another event id; a log is processed
+-- once per event type
+ALTER TABLE processed_events ADD COLUMN IF NOT EXISTS event_type text;
+ALTER TABLE processed_events ADD COLUMN IF NOT EXISTS tx_hash text;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_processed_events_log
+  ON processed_events(chain_id, tx_hash, log_index, event_type)
+  WHERE tx_hash IS NOT NULL AND log_index IS NOT NULL;
+
+-- Producers stamp each collection, token and intent event with its aggregate's next
+-- sequence; the last one applied is kept per aggregate
+CREATE TABLE IF NOT EXISTS applied_event_sequences (
+  aggregate_id   text PRIMARY KEY,
+  last_sequence  bigint NOT NULL,
+  updated_at     timestamptz NOT NULL DEFAULT now()
+);
+-- Events that arrived ahead of a gap wait here for the events before them
+CREATE TABLE IF NOT EXISTS parked_events (
+  aggregate_id  text NOT NULL,
+  sequence      bigint NOT NULL,
+  kind          text NOT NULL,
+  event         jsonb NOT NULL,
+  parked_at     timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (aggregate_id, sequence)
+);
+CREATE INDEX IF NOT EXISTS idx_parked_events_parked_at ON parked_events(parked_at);
 
 -- =========================
 -- Triggers (optional): touch updated_at on collections
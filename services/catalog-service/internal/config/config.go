@@ -6,6 +6,7 @@ import (
 	"github.com/quangdang46/NFT-Marketplace/shared/mongo"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 type ConsumerConfig struct {
@@ -14,33 +15,161 @@ type ConsumerConfig struct {
 	ConsumerTag   string
 	PrefetchCount int
 	AutoAck       bool
+	// How often processing lag is reported for the systemStatus query
+	LagReportSeconds int
+	// SingleActiveConsumer keeps collection events in order across replicas by letting
+	// one consume at a time. Turning it on for an existing queue requires deleting the
+	// queue first, as RabbitMQ fixes queue arguments when it is declared.
+	SingleActiveConsumer bool
+}
+
+type ReportConfig struct {
+	// Max reports a user may file per window
+	RateLimit     int
+	WindowMinutes int
+}
+
+type SchedulerConfig struct {
+	PollIntervalSeconds int
+	// How long before an offer or listing expires its expiring_soon alert fires
+	ExpiryLeadMinutes int
+	// How long before a calendar drop starts its starting_soon alert fires
+	DropLeadMinutes int
+}
+
+type WatchlistConfig struct {
+	// How often floor alerts are evaluated against collection floors
+	AlertIntervalSeconds int
+}
+
+type RecommendationConfig struct {
+	// How often related collections are recomputed
+	RefreshMinutes int
+	// Weights of shared holders, a shared category and shared tags in the similarity score
+	HolderWeight   float64
+	CategoryWeight float64
+	TagWeight      float64
+}
+
+type ActivityPartitionConfig struct {
+	// How often wallet_activity partitions are created, archived and dropped
+	IntervalMinutes int
+	AheadMonths     int
+	HotMonths       int
+	// Archived partitions older than this are dropped; 0 keeps them
+	RetentionMonths int
+	// Tablespace archived partitions move to; empty keeps them in place
+	ColdTablespace string
 }
 
 type Config struct {
+	GRPCPort       string
+	HTTPPort       string // metrics endpoint; empty disables it
 	PostgresConfig postgres.PostgresConfig
 	RabbitMQ       messaging.RabbitMQConfig
 	MongoConfig    mongo.MongoConfig
 	RedisConfig    redis.RedisConfig
 
-	ConsumerConfig ConsumerConfig
+	ConsumerConfig  ConsumerConfig
+	ReportConfig    ReportConfig
+	SchedulerConfig SchedulerConfig
+	WatchlistConfig WatchlistConfig
+	PartitionConfig ActivityPartitionConfig
+	Recommendations RecommendationConfig
+
+	// IPFSGatewayURL resolves ipfs:// contract metadata of imported collections
+	IPFSGatewayURL string
+
+	// StatusQueues are the queues whose depth the systemStatus query reports
+	StatusQueues []string
+
+	// MediaServiceURL stores holder snapshot and collection exports as signed artifacts
+	MediaServiceURL string
+
+	// How often pending collection exports are picked up for background generation
+	ExportPollSeconds int
+
+	// ChainRegistryURL lists the RPC endpoints collection resyncs read the chain through
+	ChainRegistryURL string
+
+	// TokenGateSecret signs the gate tokens media-service honors; empty disables token gating
+	TokenGateSecret     string
+	TokenGateTTLSeconds int
+
+	// DelegationRegistry is the delegate.cash registry address; reads are cached for
+	// DelegationCacheTTLSeconds
+	DelegationRegistry        string
+	DelegationCacheTTLSeconds int
+
+	// Sequenced events ahead of a gap are parked for up to ParkedEventTimeoutSeconds, then
+	// applied without the missing ones; stale parked events are looked for every
+	// ParkedEventPollSeconds
+	ParkedEventTimeoutSeconds int
+	ParkedEventPollSeconds    int
 }
 
 func NewConfig() Config {
 	return Config{
+		GRPCPort:       env.GetString("CATALOG_GRPC_PORT", ":50057"),
+		HTTPPort:       env.GetString("CATALOG_HTTP_PORT", ":8088"),
 		PostgresConfig: loadPostgresConfig(),
 		RedisConfig:    loadRedisConfig(),
 		RabbitMQ:       loadRabbitMQConfig(),
 		ConsumerConfig: loadConsumerConfig(),
+		ReportConfig: ReportConfig{
+			RateLimit:     env.GetInt("REPORT_RATE_LIMIT", 10),
+			WindowMinutes: env.GetInt("REPORT_RATE_WINDOW_MINUTES", 60),
+		},
+		SchedulerConfig: SchedulerConfig{
+			PollIntervalSeconds: env.GetInt("SCHEDULER_POLL_INTERVAL_SECONDS", 5),
+			ExpiryLeadMinutes:   env.GetInt("SCHEDULER_EXPIRY_LEAD_MINUTES", 60),
+			DropLeadMinutes:     env.GetInt("SCHEDULER_DROP_LEAD_MINUTES", 15),
+		},
+		WatchlistConfig: WatchlistConfig{
+			AlertIntervalSeconds: env.GetInt("WATCHLIST_ALERT_INTERVAL_SECONDS", 60),
+		},
+		PartitionConfig: ActivityPartitionConfig{
+			IntervalMinutes: env.GetInt("ACTIVITY_PARTITION_INTERVAL_MINUTES", 60),
+			AheadMonths:     env.GetInt("ACTIVITY_PARTITION_AHEAD_MONTHS", 2),
+			HotMonths:       env.GetInt("ACTIVITY_HOT_MONTHS", 6),
+			RetentionMonths: env.GetInt("ACTIVITY_RETENTION_MONTHS", 24),
+			ColdTablespace:  env.GetString("ACTIVITY_COLD_TABLESPACE", ""),
+		},
+		Recommendations: RecommendationConfig{
+			RefreshMinutes: env.GetInt("RECOMMENDATION_REFRESH_MINUTES", 360),
+			HolderWeight:   env.GetFloat("RECOMMENDATION_HOLDER_WEIGHT", 0.6),
+			CategoryWeight: env.GetFloat("RECOMMENDATION_CATEGORY_WEIGHT", 0.25),
+			TagWeight:      env.GetFloat("RECOMMENDATION_TAG_WEIGHT", 0.15),
+		},
+		IPFSGatewayURL:   env.GetString("IPFS_GATEWAY_URL", "https://ipfs.io/ipfs/"),
+		StatusQueues:     env.GetStringList("STATUS_QUEUES", []string{"catalog-service-queue", "subscription.collections.domain"}),
+		MediaServiceURL:  env.GetString("MEDIA_SERVICE_URL", "media-service:50055"),
+		ChainRegistryURL: env.GetString("CHAIN_REGISTRY_URL", "chain-registry-service:50056"),
+
+		ExportPollSeconds: env.GetInt("EXPORT_POLL_INTERVAL_SECONDS", 30),
+
+		TokenGateSecret:     env.GetString("TOKEN_GATE_SECRET", ""),
+		TokenGateTTLSeconds: env.GetInt("TOKEN_GATE_TTL_SECONDS", 600),
+
+		DelegationRegistry:        env.GetString("DELEGATION_REGISTRY_ADDRESS", "0x00000000000000447e69651d841bD8D104Bed493"),
+		DelegationCacheTTLSeconds: env.GetInt("DELEGATION_CACHE_TTL_SECONDS", 300),
+
+		ParkedEventTimeoutSeconds: env.GetInt("PARKED_EVENT_TIMEOUT_SECONDS", 300),
+		ParkedEventPollSeconds:    env.GetInt("PARKED_EVENT_POLL_SECONDS", 30),
 	}
 }
 
 func loadConsumerConfig() ConsumerConfig {
 	return ConsumerConfig{
-		QueueName:     env.GetString("CATALOG_QUEUE_NAME", "catalog-service-queue"),
-		RoutingKeys:   []string{"collections.events.created.*", "collections.events.updated.*"},
-		ConsumerTag:   env.GetString("CATALOG_CONSUMER_TAG", "catalog-service-consumer"),
-		PrefetchCount: env.GetInt("CATALOG_PREFETCH_COUNT", 10),
-		AutoAck:       env.GetBool("CATALOG_AUTO_ACK", false),
+		QueueName: env.GetString("CATALOG_QUEUE_NAME", "catalog-service-queue"),
+		RoutingKeys: []string{"collections.events.created.*", "collections.events.updated.*", "collections.events.confirmations.*",
+			"collections.events.decoded.*", "collections.events.imported.*", "sales.events.indexed.*", "offers.events.*.*", "listings.events.*.*", "auctions.events.*.*", "intents.events.tx_tracked.*"},
+		ConsumerTag:      env.GetString("CATALOG_CONSUMER_TAG", "catalog-service-consumer"),
+		PrefetchCount:    env.GetInt("CATALOG_PREFETCH_COUNT", 10),
+		AutoAck:          env.GetBool("CATALOG_AUTO_ACK", false),
+		LagReportSeconds: env.GetInt("CATALOG_LAG_REPORT_SECONDS", 15),
+
+		SingleActiveConsumer: env.GetBool("CATALOG_SINGLE_ACTIVE_CONSUMER", false),
 	}
 }
 
@@ -50,6 +179,7 @@ func loadRabbitMQConfig() messaging.RabbitMQConfig {
 		RabbitMQPort:     env.GetInt("RABBITMQ_PORT", 5671),
 		RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
 		RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
 
@@ -61,6 +191,7 @@ func loadPostgresConfig() postgres.PostgresConfig {
 		PostgresPassword: env.GetString("POSTGRES_PASSWORD", "postgres"),
 		PostgresDatabase: env.GetString("POSTGRES_DATABASE", "nft_marketplace"),
 		PostgresSSLMode:  env.GetString("POSTGRES_SSL_MODE", "disable"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
 
@@ -71,5 +202,6 @@ func loadRedisConfig() redis.RedisConfig {
 		RedisPort:     env.GetInt("REDIS_PORT", 6379),
 		RedisPassword: env.GetString("REDIS_PASSWORD", ""),
 		RedisDB:       env.GetInt("REDIS_DB", 0),
+		Tenant:        tenant.FromEnv(),
 	}
 }
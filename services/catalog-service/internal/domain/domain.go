@@ -3,7 +3,19 @@ package domain
 import (
 	"context"
 	"math/big"
+	"strings"
 	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+)
+
+var (
+	ErrInvalidInput = errs.New(errs.InvalidArgument, "invalid input")
+	ErrNotFound     = errs.New(errs.NotFound, "not found")
+	ErrRateLimited  = errs.New(errs.ResourceExhausted, "rate limited")
+	ErrNotIndexed   = errs.New(errs.FailedPrecondition, "ownership not indexed")
+	ErrUnavailable  = errs.New(errs.Unavailable, "unavailable")
 )
 
 type ChainID string
@@ -38,6 +50,23 @@ type Collection struct {
 	AllowlistStageDuration *big.Int `db:"allowlist_stage_duration" json:"allowlist_stage_duration"`
 	TokenURI               string   `db:"token_uri" json:"token_uri"`
 
+	// OwnerOrgID is the user-service organization managing the collection, empty for creator-managed
+	OwnerOrgID string `db:"owner_org_id" json:"owner_org_id,omitempty"`
+
+	// Category and Tags are set by the creator; related collections compare them
+	Category string   `db:"category" json:"category,omitempty"`
+	Tags     []string `db:"tags" json:"tags,omitempty"`
+
+	// IntentID and CreatedByUserID identify the orchestrator intent that deployed the
+	// collection; empty for collections deployed outside the marketplace
+	IntentID        string `db:"intent_id" json:"intent_id,omitempty"`
+	CreatedByUserID string `db:"created_by_user_id" json:"created_by_user_id,omitempty"`
+
+	// Confirmations is the depth of the deployment block; the collection is pending finality
+	// until it reaches RequiredConfirmations, the chain's registry setting when indexed
+	Confirmations         int `db:"confirmations" json:"confirmations"`
+	RequiredConfirmations int `db:"required_confirmations" json:"required_confirmations"`
+
 	IsVerified   bool      `db:"is_verified" json:"is_verified"`
 	IsExplicit   bool      `db:"is_explicit" json:"is_explicit"`
 	IsFeatured   bool      `db:"is_featured" json:"is_featured"`
@@ -52,6 +81,982 @@ type Collection struct {
 	VolumeTraded *big.Int  `db:"volume_traded" json:"volume_traded"`
 	CreatedAt    time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
+
+	// Moderation overlay, empty when the collection has no flag
+	ModerationStatus ModerationStatus `db:"-" json:"moderation_status,omitempty"`
+
+	// Localized is the creator's content per locale, loaded by single collection reads only
+	Localized []LocalizedContent `db:"-" json:"localized,omitempty"`
+
+	// PayoutSplits is the on-chain payout split, loaded by single collection reads only
+	PayoutSplits []PayoutSplit `db:"-" json:"payout_splits,omitempty"`
+}
+
+// PayoutSplit is one recipient's share of a collection's payouts; the shares of a
+// collection add up to 10000 bps
+type PayoutSplit struct {
+	Recipient string `db:"recipient" json:"recipient"`
+	Bps       uint64 `db:"bps" json:"bps"`
+}
+
+// LocalizedContent is the creator's description and tagline in one locale
+type LocalizedContent struct {
+	Locale          string    `db:"locale" json:"locale"` // BCP 47
+	Description     string    `db:"description" json:"description"`
+	Tagline         string    `db:"tagline" json:"tagline"`
+	UpdatedByUserID string    `db:"updated_by_user_id" json:"updated_by_user_id,omitempty"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// SetCollectionContentInput writes one locale of a collection's content; an empty
+// description and tagline remove the locale
+type SetCollectionContentInput struct {
+	ChainID         ChainID
+	ContractAddress Address
+	Locale          string
+	Description     string
+	Tagline         string
+	ActorID         string
+}
+
+// Collection categories a creator can pick
+const (
+	CategoryArt           = "art"
+	CategoryCollectibles  = "collectibles"
+	CategoryGaming        = "gaming"
+	CategoryMemberships   = "memberships"
+	CategoryMusic         = "music"
+	CategoryPFP           = "pfp"
+	CategoryPhotography   = "photography"
+	CategorySports        = "sports"
+	CategoryUtility       = "utility"
+	CategoryVirtualWorlds = "virtual_worlds"
+)
+
+// ValidCategory reports whether category is one of the collection categories
+func ValidCategory(category string) bool {
+	switch category {
+	case CategoryArt, CategoryCollectibles, CategoryGaming, CategoryMemberships, CategoryMusic,
+		CategoryPFP, CategoryPhotography, CategorySports, CategoryUtility, CategoryVirtualWorlds:
+		return true
+	}
+	return false
+}
+
+// SetCollectionClassificationInput replaces a collection's category and tags; an empty
+// category clears it
+type SetCollectionClassificationInput struct {
+	ChainID         ChainID
+	ContractAddress Address
+	Category        string
+	Tags            []string
+	ActorID         string
+}
+
+// SimilarityWeights weigh the signals of a related-collection score, each in [0, 1]:
+// the Jaccard overlap of the holders, a shared category and the Jaccard overlap of the tags
+type SimilarityWeights struct {
+	Holders  float64
+	Category float64
+	Tags     float64
+}
+
+// RelatedCollection is a collection ranked as similar to another one
+type RelatedCollection struct {
+	ChainID         string
+	ContractAddress string
+	Score           float64
+	SharedHolders   int
+}
+
+// RecommendationRepository stores the related collections of every collection
+type RecommendationRepository interface {
+	// Refresh recomputes the related collections of all confirmed collections, keeping
+	// the best perCollection of each, and returns how many pairs it stored
+	Refresh(ctx context.Context, weights SimilarityWeights, perCollection int) (int, error)
+	// ListRelated returns a collection's related collections, best first
+	ListRelated(ctx context.Context, collectionID string, limit int) ([]RelatedCollection, error)
+}
+
+// Flagged reports whether moderation hides the collection from public queries
+func (c Collection) Flagged() bool {
+	return c.ModerationStatus == ModerationFlagged
+}
+
+// Reported reports whether the collection carries the "reported" badge
+func (c Collection) Reported() bool {
+	return c.ModerationStatus == ModerationReported
+}
+
+// PendingFinality reports whether the deployment block is still shallower than the chain's
+// required confirmations; such collections are hidden from default reads
+func (c Collection) PendingFinality() bool {
+	return c.Confirmations < c.RequiredConfirmations
+}
+
+type ModerationStatus string
+
+const (
+	ModerationReported ModerationStatus = "reported"
+	ModerationFlagged  ModerationStatus = "flagged"
+	ModerationCleared  ModerationStatus = "cleared"
+)
+
+const (
+	ModerationSourceAdmin  = "admin"
+	ModerationSourceReport = "report"
+)
+
+// ModerationFlag marks a collection (empty TokenID) or a single token as stolen or infringing
+type ModerationFlag struct {
+	ID              string           `db:"id" json:"id"`
+	ChainID         string           `db:"chain_id" json:"chain_id"`
+	ContractAddress string           `db:"contract_address" json:"contract_address"`
+	TokenID         string           `db:"token_id" json:"token_id"`
+	Status          ModerationStatus `db:"status" json:"status"`
+	Reason          string           `db:"reason" json:"reason"`
+	Note            string           `db:"note" json:"note"`
+	Source          string           `db:"source" json:"source"`
+	ActorID         string           `db:"actor_id" json:"actor_id"`
+	CreatedAt       time.Time        `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time        `db:"updated_at" json:"updated_at"`
+}
+
+type FlagItemInput struct {
+	ChainID         string
+	ContractAddress string
+	TokenID         string
+	Reason          string
+	Note            string
+	Source          string // ModerationSourceAdmin | ModerationSourceReport
+	ActorID         string
+}
+
+type UnflagItemInput struct {
+	ChainID         string
+	ContractAddress string
+	TokenID         string
+	Note            string
+	ActorID         string
+}
+
+type ReportStatus string
+
+const (
+	ReportOpen      ReportStatus = "open"
+	ReportUpheld    ReportStatus = "upheld"
+	ReportDismissed ReportStatus = "dismissed"
+)
+
+const (
+	ReportTargetCollection = "collection"
+	ReportTargetToken      = "token"
+)
+
+const (
+	ReportActionUphold  = "uphold"
+	ReportActionDismiss = "dismiss"
+)
+
+// Report is a user complaint about a collection or token. TargetID is
+// <chain_id>/<contract> for collections and <chain_id>/<contract>/<token_id> for tokens.
+type Report struct {
+	ID             string       `db:"id" json:"id"`
+	TargetType     string       `db:"target_type" json:"target_type"`
+	TargetID       string       `db:"target_id" json:"target_id"`
+	ReporterID     string       `db:"reporter_id" json:"reporter_id"`
+	Reason         string       `db:"reason" json:"reason"`
+	Details        string       `db:"details" json:"details"`
+	Status         ReportStatus `db:"status" json:"status"`
+	ResolvedBy     string       `db:"resolved_by" json:"resolved_by"`
+	ResolutionNote string       `db:"resolution_note" json:"resolution_note"`
+	CreatedAt      time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+// ReportQueueItem collapses the open reports on one target for admin triage
+type ReportQueueItem struct {
+	TargetType      string    `json:"target_type"`
+	TargetID        string    `json:"target_id"`
+	ReportCount     int       `json:"report_count"`
+	Reasons         []string  `json:"reasons"`
+	FirstReportedAt time.Time `json:"first_reported_at"`
+	LastReportedAt  time.Time `json:"last_reported_at"`
+}
+
+type ReportContentInput struct {
+	TargetType string
+	TargetID   string
+	ReporterID string
+	Reason     string
+	Details    string
+}
+
+type ResolveReportsInput struct {
+	TargetType string
+	TargetID   string
+	Action     string // ReportActionUphold | ReportActionDismiss
+	Note       string
+	ActorID    string
+}
+
+const (
+	EarningsPeriodDay   = "day"
+	EarningsPeriodWeek  = "week"
+	EarningsPeriodMonth = "month"
+	EarningsPeriodYear  = "year"
+	EarningsPeriodAll   = "all"
+)
+
+// RoyaltyEarning is the royalty paid to one recipient by one indexed sale
+type RoyaltyEarning struct {
+	EventID         string    `db:"event_id" json:"event_id"`
+	ChainID         string    `db:"chain_id" json:"chain_id"`
+	ContractAddress string    `db:"contract_address" json:"contract_address"`
+	TokenID         string    `db:"token_id" json:"token_id"`
+	Recipient       string    `db:"recipient" json:"recipient"`
+	Currency        string    `db:"currency" json:"currency"`
+	Amount          *big.Int  `db:"amount" json:"amount"`
+	SalePrice       *big.Int  `db:"sale_price" json:"sale_price"`
+	TxHash          string    `db:"tx_hash" json:"tx_hash"`
+	OccurredAt      time.Time `db:"occurred_at" json:"occurred_at"`
+}
+
+// EarningsTotal sums royalties per collection and currency
+type EarningsTotal struct {
+	ChainID         string   `json:"chain_id"`
+	ContractAddress string   `json:"contract_address"`
+	CollectionName  string   `json:"collection_name"`
+	Currency        string   `json:"currency"`
+	Amount          *big.Int `json:"amount"`
+	SaleCount       int      `json:"sale_count"`
+}
+
+// Scheduled market alerts
+const (
+	JobOfferExpiringSoon   = "offer.expiring_soon"
+	JobListingExpiringSoon = "listing.expiring_soon"
+	JobAuctionEnded        = "auction.ended"
+	JobDropStartingSoon    = "drop.starting_soon"
+)
+
+// ScheduledJob is a market alert that fires once at FireAt. ID is
+// <kind-subject>:<chain_id>:<subject_id>, so rescheduling the same offer,
+// listing or auction replaces the pending job.
+type ScheduledJob struct {
+	ID              string    `json:"id"`
+	Kind            string    `json:"kind"`
+	ChainID         string    `json:"chain_id"`
+	ContractAddress string    `json:"contract_address"`
+	TokenID         string    `json:"token_id"`
+	SubjectID       string    `json:"subject_id"` // offer, listing or auction id; collection or submission id for drops
+	Recipients      []string  `json:"recipients"` // bidder/seller addresses to notify
+	EndsAt          time.Time `json:"ends_at"`    // offer/listing expiry, auction end or drop start
+	FireAt          time.Time `json:"fire_at"`
+}
+
+// EventAuctionBidPlaced is published after an indexed bid updates an auction
+const EventAuctionBidPlaced = "auction.bid_placed"
+
+// Auction statuses
+const (
+	AuctionActive    = "active"
+	AuctionSettled   = "settled"
+	AuctionCancelled = "cancelled"
+)
+
+// Auction is the state of one AuctionHouse auction folded from indexed events.
+// Auction ids are unique per chain since each chain registers one auction house.
+type Auction struct {
+	ChainID         string    `json:"chain_id"`
+	AuctionID       string    `json:"auction_id"`
+	AuctionHouse    string    `json:"auction_house"`
+	ContractAddress string    `json:"contract_address"` // NFT collection
+	TokenID         string    `json:"token_id"`
+	Seller          string    `json:"seller"`
+	AuctionType     string    `json:"auction_type"` // "english" | "dutch"
+	StartPrice      *big.Int  `json:"start_price"`
+	ReservePrice    *big.Int  `json:"reserve_price"`
+	EndPrice        *big.Int  `json:"end_price"`
+	HighestBid      *big.Int  `json:"highest_bid"`
+	HighestBidder   string    `json:"highest_bidder"`
+	BidCount        int       `json:"bid_count"`
+	Status          string    `json:"status"`
+	Winner          string    `json:"winner"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// AuctionBid is one indexed BidPlaced event
+type AuctionBid struct {
+	EventID   string    `json:"event_id"`
+	ChainID   string    `json:"chain_id"`
+	AuctionID string    `json:"auction_id"`
+	Bidder    string    `json:"bidder"`
+	Amount    *big.Int  `json:"amount"`
+	EndTime   time.Time `json:"end_time"` // zero when the bid did not extend the auction
+	TxHash    string    `json:"tx_hash"`
+	PlacedAt  time.Time `json:"placed_at"`
+}
+
+// EventTokenSupplyChanged is published when a mint or burn changes an ERC-1155 token's supply
+const EventTokenSupplyChanged = "token.supply_changed"
+
+// TokenSupply is the minted and burned amount of one ERC-1155 token id, folded from
+// TransferSingle/TransferBatch events
+type TokenSupply struct {
+	ChainID         string    `json:"chain_id"`
+	ContractAddress string    `json:"contract_address"`
+	TokenID         string    `json:"token_id"`
+	Minted          *big.Int  `json:"minted"`
+	Burned          *big.Int  `json:"burned"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Rental standards
+const (
+	RentalERC4907 = "ERC4907" // one user per token, set by UpdateUser
+	RentalERC5006 = "ERC5006" // user records over amounts of an ERC-1155 token
+)
+
+// EventRentalStarted is published when a token is rented to a new renter; JobRentalExpired
+// is scheduled at its expiry and published early when the rental is cleared
+const (
+	EventRentalStarted = "rental.started"
+	JobRentalExpired   = "rental.expired"
+)
+
+// Rental is the current user rights to a token: its ERC-4907 user, or one ERC-5006 user
+// record. A rental without a renter was cleared.
+type Rental struct {
+	ChainID         string    `json:"chain_id"`
+	ContractAddress string    `json:"contract_address"`
+	TokenID         string    `json:"token_id"`
+	RecordID        string    `json:"record_id,omitempty"` // ERC-5006 only
+	Standard        string    `json:"standard"`
+	Renter          string    `json:"renter"`
+	Owner           string    `json:"owner,omitempty"` // ERC-5006 only
+	Amount          *big.Int  `json:"amount"`          // 1 for ERC-4907
+	ExpiresAt       time.Time `json:"expires_at"`
+	BlockNumber     uint64    `json:"block_number"`
+	LogIndex        int       `json:"log_index"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Active reports whether the renter holds the rights at now
+func (r Rental) Active(now time.Time) bool {
+	return r.Renter != "" && r.ExpiresAt.After(now)
+}
+
+// TokenRef identifies a token across collections
+type TokenRef struct {
+	ChainID         string
+	ContractAddress string
+	TokenID         string
+}
+
+// Circulating is the amount minted and not burned
+func (t TokenSupply) Circulating() *big.Int {
+	if t.Minted == nil || t.Burned == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Sub(t.Minted, t.Burned)
+}
+
+// SetSupply fills the token's supply from the ERC-1155 ledger, capped by the collection's
+// maxSupply when it has one. ERC-721 tokens are unique and ignore both.
+func (t *Token) SetSupply(supply TokenSupply, maxSupply *big.Int) {
+	if !strings.EqualFold(t.Standard, "ERC1155") {
+		t.Supply, t.MaxSupply = big.NewInt(1), big.NewInt(1)
+		t.Minted, t.Burned = big.NewInt(1), big.NewInt(0)
+		return
+	}
+	t.Minted, t.Burned = supply.Minted, supply.Burned
+	if t.Minted == nil {
+		t.Minted = big.NewInt(0)
+	}
+	if t.Burned == nil {
+		t.Burned = big.NewInt(0)
+	}
+	t.Supply = supply.Circulating()
+	if maxSupply != nil && maxSupply.Sign() > 0 {
+		t.MaxSupply = maxSupply
+	}
+}
+
+// SupplyDelta is what one transfer mints or burns of a token id
+type SupplyDelta struct {
+	TokenID string
+	Minted  *big.Int
+	Burned  *big.Int
+}
+
+// Mint stats windows, each ending now
+const (
+	MintStatsWindow15m = "15m"
+	MintStatsWindow1h  = "1h"
+	MintStatsWindow24h = "24h"
+)
+
+// MintRecord is one mint event folded into a collection's minute bucket
+type MintRecord struct {
+	EventID         string
+	ChainID         string
+	ContractAddress string
+	Minter          string
+	Quantity        *big.Int
+	Revenue         *big.Int // wei paid at the collection's mint price
+	MintedAt        time.Time
+}
+
+// MintStatsBucket aggregates a collection's mints in one minute
+type MintStatsBucket struct {
+	Minute        time.Time `json:"minute"`
+	Mints         *big.Int  `json:"mints"`
+	UniqueMinters int64     `json:"unique_minters"`
+	Revenue       *big.Int  `json:"revenue"`
+}
+
+// MintStats is a collection's mints over a window. Buckets are oldest first and skip
+// minutes without mints; UniqueMinters counts each wallet once across the window.
+type MintStats struct {
+	ChainID         string            `json:"chain_id"`
+	ContractAddress string            `json:"contract_address"`
+	Window          string            `json:"window"`
+	Since           time.Time         `json:"since"`
+	Mints           *big.Int          `json:"mints"`
+	UniqueMinters   int64             `json:"unique_minters"`
+	Revenue         *big.Int          `json:"revenue"`
+	Buckets         []MintStatsBucket `json:"buckets"`
+}
+
+// Token is the catalog view of one token. ERC-721 tokens always have a supply of one.
+type Token struct {
+	ChainID          string           `json:"chain_id"`
+	ContractAddress  string           `json:"contract_address"`
+	TokenID          string           `json:"token_id"`
+	Standard         string           `json:"standard"`
+	Supply           *big.Int         `json:"supply"`
+	MaxSupply        *big.Int         `json:"max_supply"` // nil when uncapped
+	Minted           *big.Int         `json:"minted"`
+	Burned           *big.Int         `json:"burned"`
+	ModerationStatus ModerationStatus `json:"moderation_status,omitempty"`
+
+	// Listing fields, set by ListTokens
+	Name        string   `json:"name,omitempty"`
+	ImageURL    string   `json:"image_url,omitempty"`
+	Owner       string   `json:"owner,omitempty"`
+	RarityScore *float64 `json:"rarity_score,omitempty"` // higher is rarer; nil when unscored
+	Price       *big.Int `json:"price,omitempty"`        // lowest active listing in wei; nil when unlisted
+
+	// Rentals in effect, when rental indexing is enabled
+	Rentals []Rental `json:"rentals,omitempty"`
+}
+
+// Wallet activity kinds
+const (
+	ActivityMint     = "mint"
+	ActivityBurn     = "burn"
+	ActivityTransfer = "transfer"
+	ActivitySale     = "sale"
+)
+
+// WalletActivity is one indexed transfer or sale; batch transfers record one per token
+type WalletActivity struct {
+	ID              string    `json:"id"` // event id, suffixed with the token id for batches
+	ChainID         string    `json:"chain_id"`
+	ContractAddress string    `json:"contract_address"`
+	TokenID         string    `json:"token_id"`
+	Kind            string    `json:"kind"`
+	FromAddress     string    `json:"from_address"`
+	ToAddress       string    `json:"to_address"`
+	Quantity        *big.Int  `json:"quantity"`
+	Price           *big.Int  `json:"price,omitempty"` // sales only
+	Currency        string    `json:"currency,omitempty"`
+	TxHash          string    `json:"tx_hash"`
+	OccurredAt      time.Time `json:"occurred_at"`
+}
+
+// OwnershipTransfer is one token id moved by an indexed transfer. Together they form the
+// ownership index holder snapshots are computed from.
+type OwnershipTransfer struct {
+	ChainID     string
+	Contract    string
+	TokenID     string
+	From        string
+	To          string
+	Quantity    *big.Int
+	TxHash      string
+	LogIndex    int
+	BlockNumber uint64
+	At          time.Time
+}
+
+// HolderSnapshot is the balance of every holder of a collection as of a block, kept for
+// airdrops. CSV and JSON exports are stored as media-service artifacts.
+type HolderSnapshot struct {
+	ID              string    `json:"id"`
+	ChainID         string    `json:"chain_id"`
+	ContractAddress string    `json:"contract_address"`
+	BlockNumber     uint64    `json:"block_number"`
+	RequestedBy     string    `json:"requested_by"`
+	HolderCount     int       `json:"holder_count"`
+	TotalQuantity   *big.Int  `json:"total_quantity"`
+	CSVArtifactID   string    `json:"csv_artifact_id,omitempty"`
+	JSONArtifactID  string    `json:"json_artifact_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+
+	// Download links of the exports; nil while an export is unavailable
+	CSV  *SnapshotExport `json:"-"`
+	JSON *SnapshotExport `json:"-"`
+}
+
+// HolderBalance is one holder of a snapshot. TokenCount is how many token ids they hold.
+type HolderBalance struct {
+	Holder     string   `json:"holder"`
+	Balance    *big.Int `json:"balance"`
+	TokenCount int      `json:"token_count"`
+}
+
+// SnapshotExport is a stored export with a signed download URL
+type SnapshotExport struct {
+	ArtifactID   string
+	DownloadURL  string
+	URLExpiresAt time.Time
+	Bytes        int64
+}
+
+type CreateHolderSnapshotInput struct {
+	ChainID     ChainID
+	Contract    Address
+	BlockNumber *uint64 // nil snapshots the last indexed block
+	RequestedBy string
+}
+
+// Collection export kinds
+const (
+	ExportSales = "sales"
+	ExportMints = "mints"
+)
+
+// Collection export statuses
+const (
+	ExportPending = "pending"
+	ExportRunning = "running"
+	ExportReady   = "ready"
+	ExportFailed  = "failed"
+)
+
+// Collection export limits. Exports are stored as media-service artifacts, which cap their
+// size; periods holding more than ExportInlineRows rows are generated in the background.
+const (
+	MaxExportPeriod  = 366 * 24 * time.Hour
+	MaxExportRows    = 10000
+	MaxExportBytes   = 3 << 20
+	ExportInlineRows = 1000
+)
+
+// CollectionExport is a collection's sales or mints in [From, To) rendered as CSV and
+// stored as a media-service artifact
+type CollectionExport struct {
+	ID              string     `json:"id"`
+	Kind            string     `json:"kind"`
+	ChainID         string     `json:"chain_id"`
+	ContractAddress string     `json:"contract_address"`
+	From            time.Time  `json:"from"`
+	To              time.Time  `json:"to"`
+	RequestedBy     string     `json:"requested_by"`
+	Status          string     `json:"status"`
+	RowCount        int        `json:"row_count"`
+	ArtifactID      string     `json:"artifact_id,omitempty"`
+	Error           string     `json:"error,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+
+	// Download link of a ready export
+	CSV *SnapshotExport `json:"-"`
+}
+
+type CreateCollectionExportInput struct {
+	Kind        string
+	ChainID     ChainID
+	Contract    Address
+	From        time.Time
+	To          time.Time
+	RequestedBy string
+}
+
+// VerifyTokenGateInput asks whether Owners, the user's signed wallets, together hold at
+// least MinBalance of a collection
+type VerifyTokenGateInput struct {
+	UserID     string
+	Owners     []string
+	ChainID    ChainID
+	Contract   Address
+	MinBalance *big.Int // nil = 1
+}
+
+// TokenGateResult is the owners' balance and, when it meets the minimum, a gate token
+// media-service accepts until ExpiresAt
+type TokenGateResult struct {
+	Held      bool
+	Balance   *big.Int
+	Token     string
+	ExpiresAt time.Time
+}
+
+// DriftKind names what a resync found out of step with the chain
+type DriftKind string
+
+const (
+	DriftTotalSupply DriftKind = "total_supply"
+	DriftContractURI DriftKind = "contract_uri"
+	// DriftOwner is an ERC-721 token the ownership index assigns to another owner
+	DriftOwner DriftKind = "owner"
+	// DriftBalance is an ERC-1155 holder whose indexed balance differs from balanceOf
+	DriftBalance DriftKind = "balance"
+)
+
+// Drift is one catalog value that differs from the chain. Catalog and Chain are as read;
+// an empty owner means the token does not exist there.
+type Drift struct {
+	Kind    DriftKind
+	TokenID string
+	Holder  string
+	Catalog string
+	Chain   string
+}
+
+// ResyncReport is the outcome of comparing a collection with its on-chain state at one block.
+// Repaired is set once the drifts were written back to the catalog.
+type ResyncReport struct {
+	ID            string
+	ChainID       string
+	Contract      string
+	Standard      string
+	BlockNumber   uint64
+	TokensSampled int
+	Drifts        []Drift
+	Repaired      bool
+	RequestedBy   string
+	CheckedAt     time.Time
+}
+
+type ResyncCollectionInput struct {
+	ChainID     ChainID
+	Contract    Address
+	SampleSize  int // tokens whose ownership is checked; 0 uses the default
+	Repair      bool
+	RequestedBy string
+}
+
+// TokenHoldings is who the ownership index says holds a token, by lowercase holder
+type TokenHoldings struct {
+	TokenID string
+	Holders map[string]*big.Int
+}
+
+// CollectionRepair writes a resync back: collection fields that drifted, and transfers that
+// move the ownership index to what the chain holds
+type CollectionRepair struct {
+	ChainID     string
+	Contract    string
+	TotalSupply *big.Int // nil leaves it
+	ContractURI *string  // nil leaves it
+	Transfers   []OwnershipTransfer
+}
+
+// Drop sources
+const (
+	DropSourceCollection = "collection"
+	DropSourceSubmission = "submission"
+)
+
+// UpcomingDrop is one entry of the drop calendar: an indexed collection whose mint starts
+// in the future, or an approved off-platform submission
+type UpcomingDrop struct {
+	ID              string // collection or submission id
+	Source          string
+	ChainID         string
+	ContractAddress string
+	Name            string
+	Description     string
+	ImageURL        string
+	ExternalURL     string
+	Slug            string
+	MintPrice       string // wei; empty when unknown
+	IsVerified      bool
+	StartsAt        time.Time
+	EndsAt          *time.Time
+}
+
+// DropCalendarFilter selects drops starting in [From, To); an empty ChainID matches every chain
+type DropCalendarFilter struct {
+	ChainID string
+	From    time.Time
+	To      time.Time
+	Limit   int
+}
+
+type DropSubmissionStatus string
+
+const (
+	DropPending  DropSubmissionStatus = "pending"
+	DropApproved DropSubmissionStatus = "approved"
+	DropRejected DropSubmissionStatus = "rejected"
+)
+
+const (
+	DropActionApprove = "approve"
+	DropActionReject  = "reject"
+)
+
+// DropSubmission is an off-platform drop a creator submitted for the calendar. It is
+// listed once an admin approves it.
+type DropSubmission struct {
+	ID              string               `db:"id" json:"id"`
+	ChainID         string               `db:"chain_id" json:"chain_id"`
+	ContractAddress string               `db:"contract_address" json:"contract_address"`
+	Name            string               `db:"name" json:"name"`
+	Description     string               `db:"description" json:"description"`
+	ImageURL        string               `db:"image_url" json:"image_url"`
+	ExternalURL     string               `db:"external_url" json:"external_url"`
+	MintPrice       string               `db:"mint_price" json:"mint_price"`
+	StartsAt        time.Time            `db:"starts_at" json:"starts_at"`
+	EndsAt          *time.Time           `db:"ends_at" json:"ends_at"`
+	SubmittedBy     string               `db:"submitted_by" json:"submitted_by"`
+	Status          DropSubmissionStatus `db:"status" json:"status"`
+	ReviewedBy      string               `db:"reviewed_by" json:"reviewed_by"`
+	ReviewNote      string               `db:"review_note" json:"review_note"`
+	CreatedAt       time.Time            `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time            `db:"updated_at" json:"updated_at"`
+}
+
+type SubmitDropInput struct {
+	ChainID         string
+	ContractAddress string
+	Name            string
+	Description     string
+	ImageURL        string
+	ExternalURL     string
+	MintPrice       string
+	StartsAt        time.Time
+	EndsAt          *time.Time
+	SubmittedBy     string
+}
+
+// DropSubmissionFilter pages submissions newest first; empty fields match everything
+type DropSubmissionFilter struct {
+	Status      DropSubmissionStatus
+	SubmittedBy string
+	Limit       int
+	Offset      int
+}
+
+type ReviewDropSubmissionInput struct {
+	ID      string
+	Action  string // DropActionApprove | DropActionReject
+	Note    string
+	ActorID string
+}
+
+// ActivityPartition is one monthly range partition of wallet_activity covering [From, To).
+// Archived partitions are detached and no longer serve feed queries.
+type ActivityPartition struct {
+	Name     string
+	From     time.Time
+	To       time.Time
+	Archived bool
+}
+
+// PartitionPolicy sets how long wallet_activity partitions stay hot and archived
+type PartitionPolicy struct {
+	// AheadMonths partitions are created past the current month
+	AheadMonths int
+	// HotMonths, current month included, stay attached and indexed for feed queries
+	HotMonths int
+	// RetentionMonths is the age past which archived partitions are dropped; 0 keeps them
+	RetentionMonths int
+}
+
+// ActivityCursor is the position of the last activity already returned
+type ActivityCursor struct {
+	OccurredAt time.Time
+	ID         string
+}
+
+// Watchlist targets
+const (
+	WatchTargetCollection = "collection"
+	WatchTargetToken      = "token"
+)
+
+// EventWatchlistFloorBelow is published when a watched collection's floor drops below
+// the user's alert threshold
+const EventWatchlistFloorBelow = "watchlist.floor_below"
+
+// WatchlistItem is a collection or token a user favorited. Tokens track the floor of
+// their collection.
+type WatchlistItem struct {
+	ID              string     `json:"id"`
+	UserID          string     `json:"user_id"`
+	TargetType      string     `json:"target_type"` // WatchTargetCollection | WatchTargetToken
+	ChainID         string     `json:"chain_id"`
+	ContractAddress string     `json:"contract_address"`
+	TokenID         string     `json:"token_id"`          // empty for collections
+	FloorAtAdd      *big.Int   `json:"floor_at_add"`      // collection floor when favorited
+	FloorAlertBelow *big.Int   `json:"floor_alert_below"` // nil when no alert is set
+	AlertFiredAt    *time.Time `json:"alert_fired_at"`    // set while the floor stays below the threshold
+	CreatedAt       time.Time  `json:"created_at"`
+
+	// Read from the collection at query time
+	CollectionName string   `json:"collection_name"`
+	CurrentFloor   *big.Int `json:"current_floor"`
+}
+
+// FloorDelta is the change of the collection floor since the item was favorited
+func (w WatchlistItem) FloorDelta() *big.Int {
+	if w.CurrentFloor == nil || w.FloorAtAdd == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Sub(w.CurrentFloor, w.FloorAtAdd)
+}
+
+// SavedSearch is a search query and filter set a user stored for reuse
+type SavedSearch struct {
+	ID        string            `json:"id"`
+	UserID    string            `json:"user_id"`
+	Name      string            `json:"name"`
+	Query     string            `json:"query"`
+	Filters   map[string]string `json:"filters"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type FavoriteInput struct {
+	UserID          string
+	TargetType      string
+	ChainID         string
+	ContractAddress string
+	TokenID         string
+	FloorAlertBelow *big.Int // optional "floor dropped below" trigger
+}
+
+type SaveSearchInput struct {
+	UserID  string
+	Name    string
+	Query   string
+	Filters map[string]string
+}
+
+type CollectionFilter struct {
+	ChainID         string
+	CreatedByUserID string
+	Creator         string
+	VerifiedOnly    bool
+	FloorPrice      PriceRange
+	Sort            CollectionSort
+	Descending      bool
+	Limit           int
+	Offset          int
+	IncludeFlagged  bool
+	// IncludeUnconfirmed also lists collections pending finality
+	IncludeUnconfirmed bool
+}
+
+// CollectionSort is a sort key of ListCollections; ties fall back to newest first
+type CollectionSort string
+
+const (
+	CollectionSortCreatedAt CollectionSort = "created_at"
+	CollectionSortFloor     CollectionSort = "floor"
+	CollectionSortVolume    CollectionSort = "volume"
+)
+
+// Valid reports whether the repository knows how to order by s
+func (s CollectionSort) Valid() bool {
+	switch s {
+	case CollectionSortCreatedAt, CollectionSortFloor, CollectionSortVolume:
+		return true
+	}
+	return false
+}
+
+// PriceRange bounds a price in wei; nil ends are open
+type PriceRange struct {
+	Min *big.Int
+	Max *big.Int
+}
+
+// TraitFilter matches tokens having any of Values for the trait; names and values are
+// matched on their normalized (trimmed, lower-cased) form
+type TraitFilter struct {
+	Name   string
+	Values []string
+}
+
+type TokenFilter struct {
+	ChainID         string
+	ContractAddress string
+	Owner           string
+	// Owners matches tokens held by any of the addresses, next to Owner
+	Owners []string
+	// Price bounds the lowest active listing; a bounded range skips unlisted tokens
+	Price          PriceRange
+	Traits         []TraitFilter
+	Sort           TokenSort
+	Descending     bool
+	Limit          int
+	Offset         int
+	IncludeFlagged bool
+}
+
+// TokenSort is a sort key of ListTokens; ties fall back to the token id
+type TokenSort string
+
+const (
+	TokenSortCreatedAt TokenSort = "created_at" // mint time
+	TokenSortPrice     TokenSort = "price"      // lowest active listing, unlisted last
+	TokenSortRarity    TokenSort = "rarity"     // rarity score, unscored last
+)
+
+// Valid reports whether the repository knows how to order by s
+func (s TokenSort) Valid() bool {
+	switch s {
+	case TokenSortCreatedAt, TokenSortPrice, TokenSortRarity:
+		return true
+	}
+	return false
+}
+
+// SuggestionKind is what an autocomplete suggestion points at
+type SuggestionKind string
+
+const (
+	SuggestionCollection SuggestionKind = "collection"
+	SuggestionToken      SuggestionKind = "token"
+)
+
+// Suggestion is one autocomplete match over collection and token names. Score ranks
+// matches across kinds: prefix matches first, then by trigram word similarity.
+type Suggestion struct {
+	Kind            SuggestionKind
+	ChainID         ChainID
+	ContractAddress Address
+	TokenID         string // tokens only
+	Slug            string // collections only
+	Label           string
+	CollectionName  string // tokens only
+	ImageURL        string
+	Score           float64
+}
+
+// IntentLink ties a collection deployment tx to the orchestrator intent and user that sent it
+type IntentLink struct {
+	ChainID         string
+	TxHash          string
+	IntentID        string
+	CreatedByUserID string
 }
 
 // ProcessedEvent tracks which events have been processed to ensure idempotency
@@ -63,6 +1068,42 @@ type ProcessedEvent struct {
 	ProcessedAt time.Time `db:"processed_at" json:"processed_at"`
 }
 
+// ProcessedEventKey identifies a consumed event for deduplication. Replays and backfills
+// may deliver a log again under another event id (a fallback message id, a differently
+// formatted chain id), so an event emitted by a log is also keyed by its chain, tx hash,
+// log index and type; events of different types may come from the same log.
+type ProcessedEventKey struct {
+	EventID   string
+	EventType string
+	ChainID   string
+	TxHash    string
+	LogIndex  *uint64 // nil when the event was not emitted by a log
+}
+
+// HasLog reports whether the key carries the log part
+func (k ProcessedEventKey) HasLog() bool {
+	return k.TxHash != "" && k.LogIndex != nil
+}
+
+// DuplicateReason says why an event was dropped as a duplicate
+type DuplicateReason string
+
+const (
+	NotDuplicate     DuplicateReason = ""
+	DuplicateEventID DuplicateReason = "event_id"
+	DuplicateLog     DuplicateReason = "log"
+	// DuplicateSequence drops an event at or below its aggregate's last applied sequence
+	DuplicateSequence DuplicateReason = "sequence"
+)
+
+// DedupCount is how many events of a type were processed (Reason NotDuplicate) or dropped
+// as duplicates since the service started
+type DedupCount struct {
+	EventType string
+	Reason    DuplicateReason
+	Count     uint64
+}
+
 // CollectionEvent represents an incoming collection event from the indexer
 type CollectionEvent struct {
 	Schema    string                 `json:"schema"`
@@ -74,6 +1115,28 @@ type CollectionEvent struct {
 	Contract  string                 `json:"contract"`
 	Data      map[string]interface{} `json:"data"`
 	Timestamp time.Time              `json:"timestamp"`
+	// AggregateID is the collection, token or intent the event is about and Sequence its
+	// place among the aggregate's events; unsequenced events are applied as they arrive
+	AggregateID string `json:"aggregate_id,omitempty"`
+	Sequence    uint64 `json:"sequence,omitempty"`
+}
+
+// ParkedEvent is a sequenced event that arrived ahead of a gap in its aggregate's sequence.
+// It is applied once the events before it are, or once it has waited too long for them.
+type ParkedEvent struct {
+	AggregateID string
+	Sequence    uint64
+	// Kind names the handler that applies the event
+	Kind     string
+	Event    *CollectionEvent
+	ParkedAt time.Time
+}
+
+// SequenceStats counts how sequenced events were applied since the service started
+type SequenceStats struct {
+	Parked      uint64 // events parked ahead of a gap
+	GapsSkipped uint64 // parked events applied after their gap timed out
+	Waiting     int    // events parked now
 }
 
 // DomainEvent represents a domain event published by the catalog service
@@ -93,6 +1156,94 @@ type CollectionEventHandler func(ctx context.Context, event *CollectionEvent) er
 
 type CatalogService interface {
 	HandleCollectionCreated(ctx context.Context, evt *CollectionEvent) error
+	HandleCollectionUpdated(ctx context.Context, evt *CollectionEvent) error
+	// HandleCollectionConfirmations records the depth of a collection's deployment block
+	HandleCollectionConfirmations(ctx context.Context, evt *CollectionEvent) error
+
+	GetCollection(ctx context.Context, chainID ChainID, contract Address, includeFlagged, includeUnconfirmed bool) (*Collection, error)
+	// GetCollectionBySlug is GetCollection by slug; slugs from before a rename still resolve
+	GetCollectionBySlug(ctx context.Context, slug string, includeFlagged, includeUnconfirmed bool) (*Collection, error)
+	ListCollections(ctx context.Context, filter CollectionFilter) ([]Collection, error)
+	// ListTokens lists tokens of confirmed collections, hiding flagged ones unless filter.IncludeFlagged
+	ListTokens(ctx context.Context, filter TokenFilter) ([]Token, error)
+	// SetCollectionOrganization hands management of a collection to an organization, or back to
+	// its creator when orgID is empty. Callers authorize the actor.
+	SetCollectionOrganization(ctx context.Context, chainID ChainID, contract Address, orgID, actorID string) (*Collection, error)
+	// SetCollectionContent writes one locale of the collection's content and returns the
+	// collection with all of it. Callers authorize the creator.
+	SetCollectionContent(ctx context.Context, in SetCollectionContentInput) (*Collection, error)
+	// SetCollectionClassification replaces the collection's category and tags. Callers
+	// authorize the creator.
+	SetCollectionClassification(ctx context.Context, in SetCollectionClassificationInput) (*Collection, error)
+	// ListRelatedCollections returns the public collections most similar to the one at
+	// slug, as of the last recommendation refresh
+	ListRelatedCollections(ctx context.Context, slug string, limit int) ([]Collection, error)
+
+	FlagItem(ctx context.Context, in FlagItemInput) (*ModerationFlag, error)
+	UnflagItem(ctx context.Context, in UnflagItemInput) (*ModerationFlag, error)
+
+	ReportContent(ctx context.Context, in ReportContentInput) (report *Report, duplicate bool, err error)
+	ListReportQueue(ctx context.Context, limit, offset int) ([]ReportQueueItem, error)
+	ResolveReports(ctx context.Context, in ResolveReportsInput) (resolved int, flag *ModerationFlag, err error)
+
+	HandleSaleIndexed(ctx context.Context, evt *CollectionEvent) error
+	// HandleMarketEvent schedules or cancels alerts for offer, listing and auction lifecycle events
+	HandleMarketEvent(ctx context.Context, evt *CollectionEvent) error
+	GetAuction(ctx context.Context, chainID ChainID, auctionID string) (*Auction, error)
+	// HandleDecodedEvent folds ABI-decoded collection events, e.g. ERC-1155 transfers into token supply
+	HandleDecodedEvent(ctx context.Context, evt *CollectionEvent) error
+	// GetToken returns a token with its supply; flagged tokens are hidden unless includeFlagged
+	GetToken(ctx context.Context, chainID ChainID, contract Address, tokenID string, includeFlagged bool) (*Token, error)
+	// ListWalletActivity pages the transfers and sales of a wallet, newest first
+	ListWalletActivity(ctx context.Context, address string, before *ActivityCursor, limit int) ([]WalletActivity, error)
+	// Suggest autocompletes collection and token names, tolerating typos
+	Suggest(ctx context.Context, query string, limit int) ([]Suggestion, error)
+	// GetEarnings totals royalties paid to recipients since the start of period (zero since for "all")
+	GetEarnings(ctx context.Context, recipients []string, period string) (totals []EarningsTotal, since time.Time, err error)
+
+	// Favorite adds a collection or token to the user's watchlist, or updates its alert threshold
+	Favorite(ctx context.Context, in FavoriteInput) (*WatchlistItem, error)
+	RemoveFavorite(ctx context.Context, userID, id string) error
+	SaveSearch(ctx context.Context, in SaveSearchInput) (*SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, id string) error
+	// GetWatchlist returns the user's favorites with current floors and their saved searches
+	GetWatchlist(ctx context.Context, userID string) ([]WatchlistItem, []SavedSearch, error)
+
+	// GetSystemStatus reports queue depths and consumer lag. Callers authorize the admin.
+	GetSystemStatus(ctx context.Context) (*SystemStatus, error)
+
+	// CreateHolderSnapshot materializes the holders of a collection as of a block and
+	// exports them. Callers authorize the creator.
+	CreateHolderSnapshot(ctx context.Context, in CreateHolderSnapshotInput) (*HolderSnapshot, error)
+	// GetHolderSnapshot returns a snapshot with fresh download links
+	GetHolderSnapshot(ctx context.Context, id string) (*HolderSnapshot, error)
+	// CreateCollectionExport exports a collection's sales or mints over a period as CSV.
+	// Callers authorize the creator or admin.
+	CreateCollectionExport(ctx context.Context, in CreateCollectionExportInput) (*CollectionExport, error)
+	// GetCollectionExport returns an export with a fresh download link once it is ready
+	GetCollectionExport(ctx context.Context, id string) (*CollectionExport, error)
+	// VerifyTokenGate checks the owners' holdings in the ownership index and signs a gate
+	// token when they hold enough
+	VerifyTokenGate(ctx context.Context, in VerifyTokenGateInput) (*TokenGateResult, error)
+	// ListDelegatedVaults returns the vaults delegating to the wallets in the delegation
+	// registry, wallet-wide or for contract when it is set
+	ListDelegatedVaults(ctx context.Context, chainID string, delegates []string, contract string) ([]string, error)
+
+	// ResyncCollection compares a collection with its on-chain state and optionally repairs
+	// the catalog. Callers authorize the admin.
+	ResyncCollection(ctx context.Context, in ResyncCollectionInput) (*ResyncReport, error)
+
+	// ListUpcomingDrops merges collections minting in the window with approved submissions,
+	// soonest first
+	ListUpcomingDrops(ctx context.Context, filter DropCalendarFilter) ([]UpcomingDrop, error)
+	// SubmitDrop queues an off-platform drop for review
+	SubmitDrop(ctx context.Context, in SubmitDropInput) (*DropSubmission, error)
+	ListDropSubmissions(ctx context.Context, filter DropSubmissionFilter) ([]DropSubmission, error)
+	// ReviewDropSubmission approves or rejects a submission. Callers authorize the admin.
+	ReviewDropSubmission(ctx context.Context, in ReviewDropSubmissionInput) (*DropSubmission, error)
+
+	// GetMintStats aggregates a collection's mints per minute over the window
+	GetMintStats(ctx context.Context, chainID ChainID, contract Address, window string) (*MintStats, error)
 }
 
 type UnitOfWork interface {
@@ -108,13 +1259,354 @@ type CollectionsRepository interface {
 	Upsert(ctx context.Context, c Collection) (created bool, err error)
 
 	GetByPK(ctx context.Context, chainID ChainID, contract Address) (Collection, error)
+
+	// List returns collections with their moderation overlay in filter.Sort order
+	List(ctx context.Context, filter CollectionFilter) ([]Collection, error)
+	// ListTokens returns unburned tokens with their lowest listing and rarity in filter.Sort order
+	ListTokens(ctx context.Context, filter TokenFilter) ([]Token, error)
+
+	// SetOwnerOrg sets or clears owner_org_id; returns sql.ErrNoRows for unknown collections
+	SetOwnerOrg(ctx context.Context, chainID ChainID, contract Address, orgID string) error
+
+	// SetClassification replaces the category and tags; returns sql.ErrNoRows for unknown
+	// collections
+	SetClassification(ctx context.Context, chainID ChainID, contract Address, category string, tags []string) error
+
+	// SetConfirmations records the depth of a collection's deployment block; returns
+	// sql.ErrNoRows for unknown collections
+	SetConfirmations(ctx context.Context, chainID ChainID, contract Address, confirmations, required int) error
+
+	// LinkIntent stores the link and applies it to the collection deployed by the tx, if
+	// indexed already; Upsert applies stored links to newly created collections
+	LinkIntent(ctx context.Context, link IntentLink) error
+
+	// SlugOwner returns the id of the collection holding slug, as its current slug or as a
+	// redirect left by a rename; empty when the slug is free
+	SlugOwner(ctx context.Context, slug string) (string, error)
+	// AddSlugRedirect keeps a former slug pointing at its collection
+	AddSlugRedirect(ctx context.Context, slug, collectionID string) error
+	// ResolveSlug returns the collection a current or former slug names; sql.ErrNoRows when unknown
+	ResolveSlug(ctx context.Context, slug string) (ChainID, Address, error)
+
+	// Suggest matches visible collection and token names against a normalized query, up to
+	// limit of each kind, best score first
+	Suggest(ctx context.Context, query string, limit int) ([]Suggestion, error)
+}
+
+type LocalizedContentRepository interface {
+	// List returns a collection's localized content ordered by locale
+	List(ctx context.Context, chainID ChainID, contract Address) ([]LocalizedContent, error)
+	// Put stores content in one locale, replacing what the locale had
+	Put(ctx context.Context, chainID ChainID, contract Address, content LocalizedContent) error
+	Delete(ctx context.Context, chainID ChainID, contract Address, locale string) error
+}
+
+type PayoutSplitRepository interface {
+	// List returns a collection's payout split in on-chain order
+	List(ctx context.Context, chainID ChainID, contract Address) ([]PayoutSplit, error)
+	// Replace swaps the collection's split for splits; an empty split removes it
+	Replace(ctx context.Context, chainID ChainID, contract Address, splits []PayoutSplit) error
+}
+
+type DropRepository interface {
+	// ListCollectionDrops returns visible collections whose mint starts in the window, soonest first
+	ListCollectionDrops(ctx context.Context, filter DropCalendarFilter) ([]UpcomingDrop, error)
+	// ListApprovedDrops returns approved submissions starting in the window, soonest first
+	ListApprovedDrops(ctx context.Context, filter DropCalendarFilter) ([]UpcomingDrop, error)
+
+	CreateSubmission(ctx context.Context, sub DropSubmission) (DropSubmission, error)
+	ListSubmissions(ctx context.Context, filter DropSubmissionFilter) ([]DropSubmission, error)
+	// ReviewSubmission records the decision; returns ErrNotFound for unknown submissions
+	ReviewSubmission(ctx context.Context, id string, status DropSubmissionStatus, actorID, note string) (DropSubmission, error)
+}
+
+type ModerationRepository interface {
+	Upsert(ctx context.Context, f ModerationFlag) (ModerationFlag, error)
+
+	// Get returns sql.ErrNoRows when the item was never flagged
+	Get(ctx context.Context, chainID ChainID, contract Address, tokenID string) (ModerationFlag, error)
+}
+
+type ReportsRepository interface {
+	// Create inserts an open report; when the reporter already has one open on the
+	// target it returns that report with created=false
+	Create(ctx context.Context, r Report) (report Report, created bool, err error)
+
+	CountByReporterSince(ctx context.Context, reporterID string, since time.Time) (int, error)
+	ListOpenQueue(ctx context.Context, limit, offset int) ([]ReportQueueItem, error)
+
+	// ResolveOpen closes every open report on the target and returns them
+	ResolveOpen(ctx context.Context, targetType, targetID string, status ReportStatus, actorID, note string) ([]Report, error)
+}
+
+type EarningsRepository interface {
+	// Record stores a royalty once per sale event; replays return created=false
+	Record(ctx context.Context, e RoyaltyEarning) (created bool, err error)
+
+	// TotalsByRecipients sums royalties paid to any of recipients since the given time
+	TotalsByRecipients(ctx context.Context, recipients []string, since time.Time) ([]EarningsTotal, error)
+}
+
+type SchedulerRepository interface {
+	// Schedule stores the job, replacing any pending job with the same ID
+	Schedule(ctx context.Context, job ScheduledJob) error
+	// Get returns ErrNotFound when no job is pending
+	Get(ctx context.Context, id string) (ScheduledJob, error)
+	Cancel(ctx context.Context, id string) error
+	// ClaimDue removes and returns up to limit jobs due at now; a job is claimed by one caller only
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]ScheduledJob, error)
+}
+
+type AuctionRepository interface {
+	// Create stores a new auction; replays leave the existing auction untouched
+	Create(ctx context.Context, a Auction) error
+	// RecordBid stores the bid once per event and raises the highest bid; replays
+	// return recorded=false. Returns ErrNotFound when the auction is unknown.
+	RecordBid(ctx context.Context, bid AuctionBid) (auction Auction, recorded bool, err error)
+	// Close marks an active auction settled or cancelled
+	Close(ctx context.Context, chainID, auctionID, status, winner string) error
+	// Get returns ErrNotFound when the auction is unknown
+	Get(ctx context.Context, chainID, auctionID string) (Auction, error)
+}
+
+type TokenSupplyRepository interface {
+	// ApplyTransfer adds the deltas once per event id and returns the updated supplies;
+	// replays return applied=false
+	ApplyTransfer(ctx context.Context, eventID, chainID, contract string, deltas []SupplyDelta) (supplies []TokenSupply, applied bool, err error)
+	// Get returns ErrNotFound when no mint of the token was indexed
+	Get(ctx context.Context, chainID, contract, tokenID string) (TokenSupply, error)
+}
+
+type RentalRepository interface {
+	// Apply stores the rental unless an event at or after its block and log index was
+	// applied to the same token or record, and returns the state it replaced (nil when
+	// new); replays and stale events return applied=false
+	Apply(ctx context.Context, r Rental) (previous *Rental, applied bool, err error)
+	// Get returns ErrNotFound when no rental of the token (and record) was indexed
+	Get(ctx context.Context, chainID, contract, tokenID, recordID string) (Rental, error)
+	// GetRecord finds an ERC-5006 record by id, as its delete event names no token
+	GetRecord(ctx context.Context, chainID, contract, recordID string) (Rental, error)
+	// ListActive returns the rentals of the tokens in effect at now
+	ListActive(ctx context.Context, tokens []TokenRef, now time.Time) ([]Rental, error)
+}
+
+type MintStatsRepository interface {
+	// RecordMint adds the mint to its minute bucket once per event id and returns the
+	// bucket; replays return applied=false
+	RecordMint(ctx context.Context, m MintRecord) (bucket MintStatsBucket, applied bool, err error)
+	// GetMintStats aggregates the collection's buckets from since on
+	GetMintStats(ctx context.Context, chainID, contract string, since time.Time) (MintStats, error)
+}
+
+type HolderSnapshotRepository interface {
+	// RecordTransfers stores transfers once per token, tx and log index; replays are skipped
+	RecordTransfers(ctx context.Context, transfers []OwnershipTransfer) error
+	// LatestBlock is the last block with an indexed transfer of the collection; ok=false
+	// when none was indexed
+	LatestBlock(ctx context.Context, chainID, contract string) (block uint64, ok bool, err error)
+	// CreateSnapshot stores the snapshot with the balance of every holder as of its block
+	CreateSnapshot(ctx context.Context, snapshot HolderSnapshot) (*HolderSnapshot, error)
+	SetExports(ctx context.Context, id, csvArtifactID, jsonArtifactID string) error
+	// Get returns ErrNotFound for unknown snapshots
+	Get(ctx context.Context, id string) (*HolderSnapshot, error)
+	// ListBalances returns the holders of a snapshot, largest balance first
+	ListBalances(ctx context.Context, id string) ([]HolderBalance, error)
+	// HeldBalance sums what the owners hold of the collection as of the last indexed transfer
+	HeldBalance(ctx context.Context, chainID, contract string, owners []string) (*big.Int, error)
+	// TokenBalances returns what each holder holds of each token as of the last indexed
+	// transfer, keyed by holder then token id; tokens a holder never held are left out
+	TokenBalances(ctx context.Context, chainID, contract string, holders, tokenIDs []string) (map[string]map[string]*big.Int, error)
+}
+
+type CollectionExportRepository interface {
+	// CountRows counts the collection's wallet activity of a kind in [from, to)
+	CountRows(ctx context.Context, chainID, contract, kind string, from, to time.Time) (int, error)
+	// StreamRows hands the collection's wallet activity of a kind in [from, to) to fn,
+	// oldest first; an error from fn stops it and is returned
+	StreamRows(ctx context.Context, chainID, contract, kind string, from, to time.Time, fn func(WalletActivity) error) error
+	Create(ctx context.Context, export CollectionExport) (*CollectionExport, error)
+	// Get returns ErrNotFound for unknown exports
+	Get(ctx context.Context, id string) (*CollectionExport, error)
+	// ClaimPending marks up to limit pending exports, and running ones claimed before
+	// staleBefore by a worker that died, as running and returns them
+	ClaimPending(ctx context.Context, limit int, staleBefore time.Time) ([]CollectionExport, error)
+	Complete(ctx context.Context, id, artifactID string, rowCount int) error
+	Fail(ctx context.Context, id, reason string) error
+	// Requeue sets a ready export whose artifact expired back to pending
+	Requeue(ctx context.Context, id string) error
+}
+
+type ResyncRepository interface {
+	// GetContractURI returns the contractURI the catalog last recorded, "" when none
+	GetContractURI(ctx context.Context, chainID, contract string) (string, error)
+	// SampleHoldings picks up to limit random indexed tokens of the collection with their
+	// current holders
+	SampleHoldings(ctx context.Context, chainID, contract string, limit int) ([]TokenHoldings, error)
+	// ApplyRepair writes the repair in one transaction; its transfers are stored like indexed ones
+	ApplyRepair(ctx context.Context, repair CollectionRepair) error
+}
+
+// ChainReader reads collection contracts at a given block
+type ChainReader interface {
+	BlockNumber(ctx context.Context, chainID string) (uint64, error)
+	// TotalSupply returns ok=false when the contract has no totalSupply
+	TotalSupply(ctx context.Context, chainID, contract string, block uint64) (supply *big.Int, ok bool, err error)
+	// ContractURI returns "" when the contract has no contractURI
+	ContractURI(ctx context.Context, chainID, contract string, block uint64) (string, error)
+	// OwnerOf returns the lowercase owner, or "" for tokens that do not exist
+	OwnerOf(ctx context.Context, chainID, contract, tokenID string, block uint64) (string, error)
+	BalanceOf(ctx context.Context, chainID, contract, owner, tokenID string, block uint64) (*big.Int, error)
+}
+
+// Delegation is a vault's grant, in the delegation registry, letting a hot wallet act for
+// it: for all its tokens, or for one collection's when Contract is set
+type Delegation struct {
+	Vault    string
+	Delegate string
+	Contract string
+}
+
+// DelegationRegistry reads the on-chain delegation registry (delegate.cash)
+type DelegationRegistry interface {
+	// IncomingDelegations lists the wallet and collection delegations made to delegate
+	IncomingDelegations(ctx context.Context, chainID, delegate string) ([]Delegation, error)
+}
+
+// ArtifactStore keeps exports downloadable through signed URLs
+type ArtifactStore interface {
+	Store(ctx context.Context, name, mime string, content []byte, ownerID string) (*SnapshotExport, error)
+	// Get signs a fresh URL; ErrNotFound once the artifact expired
+	Get(ctx context.Context, artifactID string) (*SnapshotExport, error)
+}
+
+type ActivityPartitionRepository interface {
+	// ListPartitions returns the attached and archived monthly partitions, oldest first
+	ListPartitions(ctx context.Context) ([]ActivityPartition, error)
+	// CreatePartition attaches the month starting at from, moving its rows out of the default partition
+	CreatePartition(ctx context.Context, from time.Time) error
+	// ArchivePartition detaches a partition, drops its indexes and moves it to cold storage
+	ArchivePartition(ctx context.Context, name string) error
+	// DropPartition removes an archived partition for good
+	DropPartition(ctx context.Context, name string) error
+}
+
+type WalletActivityRepository interface {
+	// Record stores activities once per id; replayed events are skipped
+	Record(ctx context.Context, activities []WalletActivity) error
+	// ListByAddress pages activities sent or received by address, newest first
+	ListByAddress(ctx context.Context, address string, before *ActivityCursor, limit int) ([]WalletActivity, error)
+}
+
+type WatchlistRepository interface {
+	// AddFavorite stores the item; favoriting the same target again updates the alert
+	// threshold and keeps the original floor baseline
+	AddFavorite(ctx context.Context, item WatchlistItem) (WatchlistItem, error)
+	// RemoveFavorite returns ErrNotFound when the user has no such item
+	RemoveFavorite(ctx context.Context, userID, id string) error
+	// ListFavorites returns the user's items with the current collection floor, newest first
+	ListFavorites(ctx context.Context, userID string) ([]WatchlistItem, error)
+	// ListFloorAlerts returns up to limit items with an alert threshold, ordered by id after afterID
+	ListFloorAlerts(ctx context.Context, afterID string, limit int) ([]WatchlistItem, error)
+	// SetAlertFired records (or clears, when firedAt is nil) that the alert fired
+	SetAlertFired(ctx context.Context, id string, firedAt *time.Time) error
+
+	SaveSearch(ctx context.Context, s SavedSearch) (SavedSearch, error)
+	CountSavedSearches(ctx context.Context, userID string) (int, error)
+	// DeleteSavedSearch returns ErrNotFound when the user has no such search
+	DeleteSavedSearch(ctx context.Context, userID, id string) error
+	ListSavedSearches(ctx context.Context, userID string) ([]SavedSearch, error)
 }
 
 type ProcessedEventsRepository interface {
-	MarkProcessed(ctx context.Context, eventID string) (bool, error)
+	// MarkProcessed records key and returns NotDuplicate the first time it sees the event,
+	// or which part of the key matched an event processed before
+	MarkProcessed(ctx context.Context, key ProcessedEventKey) (DuplicateReason, error)
+}
+
+// EventSequenceRepository keeps the last sequence applied per aggregate and the events
+// parked ahead of a gap
+type EventSequenceRepository interface {
+	// LastApplied returns the aggregate's last applied sequence, 0 before its first event
+	LastApplied(ctx context.Context, aggregateID string) (uint64, error)
+	// Advance records sequence as applied; it never moves an aggregate backwards
+	Advance(ctx context.Context, aggregateID string, sequence uint64) error
+	// Park keeps an event until its turn; parking it again is a no-op
+	Park(ctx context.Context, event *ParkedEvent) error
+	// NextParked returns the aggregate's parked event with the lowest sequence, nil when none is
+	NextParked(ctx context.Context, aggregateID string) (*ParkedEvent, error)
+	// Unpark drops a parked event once it was applied or found stale
+	Unpark(ctx context.Context, aggregateID string, sequence uint64) error
+	// StaleAggregates lists aggregates whose oldest parked event was parked before cutoff
+	StaleAggregates(ctx context.Context, cutoff time.Time, limit int) ([]string, error)
+	CountParked(ctx context.Context) (int, error)
 }
 
 type MessagePublisher interface {
 	PublishCollectionUpserted(ctx context.Context, collection *Collection) error
 	PublishDomainEvent(ctx context.Context, event *DomainEvent) error
 }
+
+// ContractMetadata is the collection-level metadata a contractURI points to (OpenSea's
+// contract-level metadata format)
+type ContractMetadata struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Image        string `json:"image"`
+	BannerImage  string `json:"banner_image"`
+	ExternalLink string `json:"external_link"`
+}
+
+// MetadataFetcher reads off-chain metadata of collections imported from existing contracts
+type MetadataFetcher interface {
+	FetchContractMetadata(ctx context.Context, uri string) (*ContractMetadata, error)
+}
+
+// SystemStatus is how far the event consumers are behind the indexer
+type SystemStatus struct {
+	Queues    []QueueStatus
+	Consumers []ConsumerStatus
+	CheckedAt time.Time
+}
+
+// QueueStatus is a queue's backlog; Error is set when the broker could not be asked
+type QueueStatus struct {
+	contracts.QueueDepth
+	Error string
+}
+
+// ConsumerStatus is a consumer's last lag report. Stale consumers stopped reporting;
+// FallingBehind ones hold old messages or cannot drain their queue within LagThreshold.
+type ConsumerStatus struct {
+	contracts.ConsumerLag
+	Stale         bool
+	FallingBehind bool
+}
+
+// LagThreshold is how far behind a consumer may run before it is reported as falling behind
+const LagThreshold = time.Minute
+
+// MintStatsNotifier announces updated mint stats buckets to live dashboards
+type MintStatsNotifier interface {
+	PublishMintStats(ctx context.Context, update contracts.MintStatsUpdate) error
+}
+
+// ExportNotifier tells the requester that a background collection export finished
+type ExportNotifier interface {
+	PublishExportReady(ctx context.Context, event contracts.CollectionExportReadyEvent) error
+}
+
+// HoldingsNotifier tells profile stats how many distinct tokens the wallets of a transfer
+// gained or lost
+type HoldingsNotifier interface {
+	PublishHoldingsChanged(ctx context.Context, event contracts.HoldingsChangedEvent) error
+}
+
+// QueueInspector reads queue depths from the broker
+type QueueInspector interface {
+	InspectQueue(name string) (contracts.QueueDepth, error)
+}
+
+// ConsumerLagReader reads the lag reports of the queue consumers
+type ConsumerLagReader interface {
+	ReadConsumerLags(ctx context.Context) ([]contracts.ConsumerLag, error)
+}
@@ -0,0 +1,56 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+)
+
+// MediaStore keeps exports as media-service artifacts, downloadable through signed URLs
+type MediaStore struct {
+	client mediapb.MediaServiceClient
+}
+
+// NewMediaStore creates an artifact store backed by media-service
+func NewMediaStore(client mediapb.MediaServiceClient) *MediaStore {
+	return &MediaStore{client: client}
+}
+
+// Store keeps content for media-service's default artifact retention
+func (m *MediaStore) Store(ctx context.Context, name, mime string, content []byte, ownerID string) (*domain.SnapshotExport, error) {
+	resp, err := m.client.StoreArtifact(ctx, &mediapb.StoreArtifactRequest{
+		Name:    name,
+		Mime:    mime,
+		Content: content,
+		OwnerId: ownerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store artifact: %w", err)
+	}
+	return toExport(resp.Artifact), nil
+}
+
+func (m *MediaStore) Get(ctx context.Context, artifactID string) (*domain.SnapshotExport, error) {
+	resp, err := m.client.GetArtifact(ctx, &mediapb.GetArtifactRequest{Id: artifactID})
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artifact: %w", err)
+	}
+	return toExport(resp.Artifact), nil
+}
+
+func toExport(a *mediapb.Artifact) *domain.SnapshotExport {
+	return &domain.SnapshotExport{
+		ArtifactID:   a.GetId(),
+		DownloadURL:  a.GetDownloadUrl(),
+		URLExpiresAt: a.GetUrlExpiresAt().AsTime(),
+		Bytes:        int64(a.GetBytes()),
+	}
+}
@@ -0,0 +1,120 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/chainrpc"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// DefaultDelegationRegistry is where delegate.cash v2 is deployed on every chain it supports
+const DefaultDelegationRegistry = "0x00000000000000447e69651d841bD8D104Bed493"
+
+const delegationRegistryABI = `[
+	{"type":"function","name":"getIncomingDelegations","stateMutability":"view",
+	 "inputs":[{"name":"to","type":"address"}],
+	 "outputs":[{"type":"tuple[]","components":[
+		{"name":"type_","type":"uint8"},
+		{"name":"to","type":"address"},
+		{"name":"from","type":"address"},
+		{"name":"rights","type":"bytes32"},
+		{"name":"contract_","type":"address"},
+		{"name":"tokenId","type":"uint256"},
+		{"name":"amount","type":"uint256"}]}]}
+]`
+
+// delegate.cash v2 delegation types
+const (
+	delegationAll      = 1
+	delegationContract = 2
+)
+
+type registryDelegation struct {
+	Type     uint8 `abi:"type_"`
+	To       common.Address
+	From     common.Address
+	Rights   [32]byte
+	Contract common.Address `abi:"contract_"`
+	TokenId  *big.Int
+	Amount   *big.Int
+}
+
+// Delegations reads the delegate.cash registry over the chain registry's RPC endpoints
+type Delegations struct {
+	rpc      *chainrpc.Clients
+	abi      abi.ABI
+	registry common.Address
+}
+
+func NewDelegations(registry protoChainRegistry.ChainRegistryServiceClient, address string) domain.DelegationRegistry {
+	parsed, err := abi.JSON(strings.NewReader(delegationRegistryABI))
+	if err != nil {
+		panic(fmt.Sprintf("invalid delegation registry abi: %v", err))
+	}
+	if address == "" {
+		address = DefaultDelegationRegistry
+	}
+	return &Delegations{rpc: chainrpc.NewClients(registry), abi: parsed, registry: common.HexToAddress(address)}
+}
+
+// IncomingDelegations reads at the chain head. Token-level delegations and ones limited
+// to specific rights are left out: they don't hand over a whole holding.
+func (d *Delegations) IncomingDelegations(ctx context.Context, chainID, delegate string) ([]domain.Delegation, error) {
+	client, err := d.rpc.Client(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := d.abi.Pack("getIncomingDelegations", common.HexToAddress(delegate))
+	if err != nil {
+		return nil, fmt.Errorf("pack getIncomingDelegations: %w", err)
+	}
+
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &d.registry, Data: data}, nil)
+	if err != nil {
+		if reverted(err) {
+			// No registry deployed on this chain
+			return nil, nil
+		}
+		d.rpc.Drop(chainID, client)
+		return nil, fmt.Errorf("call getIncomingDelegations: %w", err)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	values, err := d.abi.Unpack("getIncomingDelegations", result)
+	if err != nil || len(values) != 1 {
+		return nil, fmt.Errorf("unpack getIncomingDelegations: %w", errNoResult)
+	}
+	var raw []registryDelegation
+	if err := d.abi.Methods["getIncomingDelegations"].Outputs.Copy(&raw, values); err != nil {
+		return nil, fmt.Errorf("unpack getIncomingDelegations: %w", err)
+	}
+
+	var delegations []domain.Delegation
+	for _, r := range raw {
+		if r.Rights != ([32]byte{}) {
+			continue
+		}
+		delegation := domain.Delegation{
+			Vault:    strings.ToLower(r.From.Hex()),
+			Delegate: strings.ToLower(r.To.Hex()),
+		}
+		switch r.Type {
+		case delegationAll:
+		case delegationContract:
+			delegation.Contract = strings.ToLower(r.Contract.Hex())
+		default:
+			continue
+		}
+		delegations = append(delegations, delegation)
+	}
+	return delegations, nil
+}
@@ -0,0 +1,142 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/chainrpc"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// collectionABI holds the view calls a resync compares the catalog with
+const collectionABI = `[
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"type":"uint256"}]},
+	{"type":"function","name":"contractURI","stateMutability":"view","inputs":[],"outputs":[{"type":"string"}]},
+	{"type":"function","name":"ownerOf","stateMutability":"view","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"type":"address"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"},{"name":"id","type":"uint256"}],"outputs":[{"type":"uint256"}]}
+]`
+
+// errNoResult is a call the contract reverted or answered with nothing to unpack, which
+// the optional methods treat as not implemented
+var errNoResult = errors.New("call has no result")
+
+// Reader reads collection contracts over the RPC endpoints the chain registry lists
+type Reader struct {
+	rpc *chainrpc.Clients
+	abi abi.ABI
+}
+
+func NewReader(registry protoChainRegistry.ChainRegistryServiceClient) domain.ChainReader {
+	parsed, err := abi.JSON(strings.NewReader(collectionABI))
+	if err != nil {
+		panic(fmt.Sprintf("invalid collection abi: %v", err))
+	}
+	return &Reader{rpc: chainrpc.NewClients(registry), abi: parsed}
+}
+
+func (r *Reader) BlockNumber(ctx context.Context, chainID string) (uint64, error) {
+	client, err := r.rpc.Client(ctx, chainID)
+	if err != nil {
+		return 0, err
+	}
+	block, err := client.BlockNumber(ctx)
+	if err != nil {
+		r.rpc.Drop(chainID, client)
+		return 0, fmt.Errorf("get block number: %w", err)
+	}
+	return block, nil
+}
+
+func (r *Reader) TotalSupply(ctx context.Context, chainID, contract string, block uint64) (*big.Int, bool, error) {
+	var supply *big.Int
+	err := r.call(ctx, chainID, contract, block, &supply, "totalSupply")
+	if errors.Is(err, errNoResult) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return supply, true, nil
+}
+
+func (r *Reader) ContractURI(ctx context.Context, chainID, contract string, block uint64) (string, error) {
+	var uri string
+	err := r.call(ctx, chainID, contract, block, &uri, "contractURI")
+	if errors.Is(err, errNoResult) {
+		return "", nil
+	}
+	return uri, err
+}
+
+// OwnerOf treats a revert as a token that does not exist: ERC-721 requires ownerOf to
+// revert for burned and unminted tokens
+func (r *Reader) OwnerOf(ctx context.Context, chainID, contract, tokenID string, block uint64) (string, error) {
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid token id %q", tokenID)
+	}
+	var owner common.Address
+	err := r.call(ctx, chainID, contract, block, &owner, "ownerOf", id)
+	if errors.Is(err, errNoResult) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(owner.Hex()), nil
+}
+
+func (r *Reader) BalanceOf(ctx context.Context, chainID, contract, owner, tokenID string, block uint64) (*big.Int, error) {
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid token id %q", tokenID)
+	}
+	var balance *big.Int
+	if err := r.call(ctx, chainID, contract, block, &balance, "balanceOf", common.HexToAddress(owner), id); err != nil {
+		return nil, err
+	}
+	return balance, nil
+}
+
+// call runs a view method at block and unpacks its single return value into out. Reverts
+// and empty results come back as errNoResult; transport failures drop the client.
+func (r *Reader) call(ctx context.Context, chainID, contract string, block uint64, out interface{}, method string, args ...interface{}) error {
+	client, err := r.rpc.Client(ctx, chainID)
+	if err != nil {
+		return err
+	}
+	data, err := r.abi.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("pack %s: %w", method, err)
+	}
+
+	address := common.HexToAddress(contract)
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &address, Data: data}, new(big.Int).SetUint64(block))
+	if err != nil {
+		if reverted(err) {
+			return fmt.Errorf("call %s: %w", method, errNoResult)
+		}
+		r.rpc.Drop(chainID, client)
+		return fmt.Errorf("call %s: %w", method, err)
+	}
+	values, err := r.abi.Unpack(method, result)
+	if err != nil || len(values) != 1 {
+		return fmt.Errorf("unpack %s: %w", method, errNoResult)
+	}
+	return r.abi.Methods[method].Outputs.Copy(out, values)
+}
+
+// reverted reports whether a call failed inside the contract rather than reaching it
+func reverted(err error) bool {
+	var dataErr rpc.DataError
+	return errors.As(err, &dataErr) || strings.Contains(err.Error(), "execution reverted")
+}
@@ -17,15 +17,25 @@ import (
 )
 
 type EventConsumer struct {
-	amqp                   *messaging.RabbitMQ
-	config                 config.ConsumerConfig
-	collectionEventHandler domain.CollectionEventHandler
-	channel                *amqp.Channel
-	deliveries             <-chan amqp.Delivery
-	done                   chan error
-	consumerTag            string
-	mu                     sync.RWMutex
-	isRunning              bool
+	amqp                     *messaging.RabbitMQ
+	config                   config.ConsumerConfig
+	collectionEventHandler   domain.CollectionEventHandler
+	collectionUpdatedHandler domain.CollectionEventHandler
+	saleIndexedHandler       domain.CollectionEventHandler
+	marketEventHandler       domain.CollectionEventHandler
+	intentTrackedHandler     domain.CollectionEventHandler
+	confirmationsHandler     domain.CollectionEventHandler
+	decodedEventHandler      domain.CollectionEventHandler
+	importedHandler          domain.CollectionEventHandler
+	channel                  *amqp.Channel
+	deliveries               <-chan amqp.Delivery
+	done                     chan error
+	drained                  chan struct{} // closed once processMessages returns
+	consumerTag              string
+	lag                      *messaging.LagTracker
+	mu                       sync.RWMutex
+	isRunning                bool
+	stopping                 bool
 }
 
 // NewEventConsumer creates a new RabbitMQ event consumer
@@ -35,6 +45,7 @@ func NewEventConsumer(amqp *messaging.RabbitMQ, config config.ConsumerConfig) *E
 		config:      config,
 		done:        make(chan error),
 		consumerTag: config.ConsumerTag,
+		lag:         messaging.NewLagTracker(config.ConsumerTag, config.QueueName),
 	}
 }
 
@@ -45,6 +56,57 @@ func (c *EventConsumer) RegisterCollectionEventHandler(handler domain.Collection
 	c.collectionEventHandler = handler
 }
 
+// RegisterCollectionUpdatedHandler registers a handler for collection admin changes
+// (ownership transfer, royalty and base URI updates)
+func (c *EventConsumer) RegisterCollectionUpdatedHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.collectionUpdatedHandler = handler
+}
+
+// RegisterConfirmationsHandler registers a handler for collection deployment depth updates
+func (c *EventConsumer) RegisterConfirmationsHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.confirmationsHandler = handler
+}
+
+// RegisterDecodedEventHandler registers a handler for ABI-decoded collection events
+func (c *EventConsumer) RegisterDecodedEventHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.decodedEventHandler = handler
+}
+
+// RegisterCollectionImportedHandler registers a handler for collections imported from
+// existing contracts
+func (c *EventConsumer) RegisterCollectionImportedHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.importedHandler = handler
+}
+
+// RegisterSaleIndexedHandler registers a handler for sale.indexed events
+func (c *EventConsumer) RegisterSaleIndexedHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.saleIndexedHandler = handler
+}
+
+// RegisterMarketEventHandler registers a handler for offer, listing and auction lifecycle events
+func (c *EventConsumer) RegisterMarketEventHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.marketEventHandler = handler
+}
+
+// RegisterIntentTrackedHandler registers a handler for orchestrator intent tx tracking events
+func (c *EventConsumer) RegisterIntentTrackedHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.intentTrackedHandler = handler
+}
+
 // Start begins consuming events
 func (c *EventConsumer) Start(ctx context.Context) error {
 	c.mu.Lock()
@@ -89,16 +151,21 @@ func (c *EventConsumer) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to declare exchange: %w", err)
 	}
 
-	// Declare the queue
+	// Declare the queue. Collection events must apply in order per aggregate, so with a
+	// single active consumer only one replica consumes and the others stand by.
+	queueConfig := messaging.QueueConfig{
+		Name:                 c.config.QueueName,
+		Durable:              true,
+		DLX:                  c.amqp.GetExchange() + ".dlx",
+		SingleActiveConsumer: c.config.SingleActiveConsumer,
+	}
 	queue, err := c.channel.QueueDeclare(
-		c.config.QueueName, // queue name
-		true,               // durable
-		false,              // delete when unused
-		false,              // exclusive
-		false,              // no-wait
-		amqp.Table{
-			"x-dead-letter-exchange": c.amqp.GetExchange() + ".dlx",
-		}, // arguments
+		queueConfig.Name,    // queue name
+		queueConfig.Durable, // durable
+		false,               // delete when unused
+		false,               // exclusive
+		false,               // no-wait
+		queueConfig.Args(),  // arguments
 	)
 	if err != nil {
 		return fmt.Errorf("failed to declare queue: %w", err)
@@ -136,7 +203,11 @@ func (c *EventConsumer) Start(ctx context.Context) error {
 	log.Printf("Started consuming events from queue %s with consumer tag %s", queue.Name, c.consumerTag)
 
 	// Process messages
-	go c.processMessages(ctx)
+	drained := make(chan struct{})
+	c.mu.Lock()
+	c.drained = drained
+	c.mu.Unlock()
+	go c.processMessages(ctx, drained)
 
 	// Wait for done signal or context cancellation
 	select {
@@ -149,27 +220,36 @@ func (c *EventConsumer) Start(ctx context.Context) error {
 	}
 }
 
-// Stop gracefully shuts down event consumption
+// Stop drains the consumer: it stops fetching, finishes and acks the deliveries already
+// received, then closes the channel. Deliveries still unacked when ctx ends are
+// redelivered by the broker, in order, to the next active consumer.
 func (c *EventConsumer) Stop(ctx context.Context) error {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	if !c.isRunning {
+	if !c.isRunning || c.stopping {
+		c.mu.Unlock()
 		return nil
 	}
+	c.stopping = true
+	drained := c.drained
+	c.mu.Unlock()
 
-	log.Println("Stopping event consumer...")
+	log.Println("Draining event consumer...")
 
-	// Cancel the consumer
+	var drainErr error
 	if c.channel != nil {
-		err := c.channel.Cancel(c.consumerTag, false)
-		if err != nil {
+		// Handlers read the registered handlers under mu, so it is not held while draining
+		if err := c.channel.Cancel(c.consumerTag, false); err != nil {
 			log.Printf("Error cancelling consumer: %v", err)
 		}
+		if drained != nil {
+			select {
+			case <-drained:
+			case <-ctx.Done():
+				drainErr = fmt.Errorf("consumer still processing after drain timeout: %w", ctx.Err())
+			}
+		}
 
-		// Close the channel
-		err = c.channel.Close()
-		if err != nil {
+		if err := c.channel.Close(); err != nil {
 			log.Printf("Error closing channel: %v", err)
 		}
 	}
@@ -180,28 +260,39 @@ func (c *EventConsumer) Stop(ctx context.Context) error {
 	default:
 	}
 
+	c.mu.Lock()
 	c.isRunning = false
+	c.stopping = false
+	c.mu.Unlock()
 	log.Println("Event consumer stopped")
 
-	return nil
+	return drainErr
 }
 
-// processMessages processes incoming messages
-func (c *EventConsumer) processMessages(ctx context.Context) {
+// processMessages processes incoming messages until the delivery channel closes
+func (c *EventConsumer) processMessages(ctx context.Context, drained chan struct{}) {
+	defer close(drained)
+
 	for {
 		select {
 		case <-ctx.Done():
-			c.done <- ctx.Err()
+			// Start returns on the same cancellation
 			return
 
 		case delivery, ok := <-c.deliveries:
 			if !ok {
+				// Cancelled by Stop, which signals done once the channel is closed
+				if c.isStopping() {
+					return
+				}
 				c.done <- fmt.Errorf("delivery channel closed")
 				return
 			}
 
 			// Process the message
+			c.lag.Received(delivery)
 			err := c.processMessage(ctx, delivery)
+			c.lag.Settled(delivery, err)
 			if err != nil {
 				log.Printf("Error processing message: %v", err)
 				// Reject the message and send to DLQ if not auto-ack
@@ -218,6 +309,12 @@ func (c *EventConsumer) processMessages(ctx context.Context) {
 	}
 }
 
+func (c *EventConsumer) isStopping() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.stopping
+}
+
 // processMessage processes a single message
 func (c *EventConsumer) processMessage(ctx context.Context, delivery amqp.Delivery) error {
 	// Add timeout to message processing
@@ -234,6 +331,22 @@ func (c *EventConsumer) processMessage(ctx context.Context, delivery amqp.Delive
 	switch eventType {
 	case "collection_created":
 		return c.processCollectionEvent(msgCtx, delivery)
+	case "collection_updated":
+		return c.processCollectionUpdatedEvent(msgCtx, delivery)
+	case "collection_confirmations":
+		return c.processConfirmationsEvent(msgCtx, delivery)
+	case "collection_decoded":
+		return c.processDecodedEvent(msgCtx, delivery)
+	case "collection_imported":
+		return c.processImportedEvent(msgCtx, delivery)
+	case "sale_indexed":
+		return c.processSaleIndexedEvent(msgCtx, delivery)
+	case "intent_tx_tracked":
+		return c.processIntentTrackedEvent(msgCtx, delivery)
+	case "offer_created", "offer_updated", "offer_accepted", "offer_cancelled", "offer_expired",
+		"listing_created", "listing_updated", "listing_sold", "listing_cancelled", "listing_expired",
+		"auction_created", "auction_bid", "auction_extended", "auction_settled", "auction_cancelled":
+		return c.processMarketEvent(msgCtx, delivery)
 	default:
 		log.Printf("Unknown event type for routing key: %s", delivery.RoutingKey)
 		return nil // Don't reject unknown events, just ignore them
@@ -242,6 +355,110 @@ func (c *EventConsumer) processMessage(ctx context.Context, delivery amqp.Delive
 
 // processCollectionEvent processes collection-related events
 func (c *EventConsumer) processCollectionEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.collectionEventHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no collection event handler registered")
+	}
+
+	return c.dispatchCollectionEvent(ctx, delivery, handler)
+}
+
+// processCollectionUpdatedEvent processes collection admin change events
+func (c *EventConsumer) processCollectionUpdatedEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.collectionUpdatedHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no collection updated handler registered")
+	}
+
+	return c.dispatchCollectionEvent(ctx, delivery, handler)
+}
+
+// processConfirmationsEvent processes collection deployment depth updates
+func (c *EventConsumer) processConfirmationsEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.confirmationsHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no confirmations handler registered")
+	}
+
+	return c.dispatchCollectionEvent(ctx, delivery, handler)
+}
+
+// processDecodedEvent processes ABI-decoded collection events such as ERC-1155 transfers
+func (c *EventConsumer) processDecodedEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.decodedEventHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no decoded event handler registered")
+	}
+
+	return c.dispatchCollectionEvent(ctx, delivery, handler)
+}
+
+// processImportedEvent processes collections imported through the orchestrator
+func (c *EventConsumer) processImportedEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.importedHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no collection imported handler registered")
+	}
+
+	return c.dispatchCollectionEvent(ctx, delivery, handler)
+}
+
+// processSaleIndexedEvent processes sales reported by the indexer
+func (c *EventConsumer) processSaleIndexedEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.saleIndexedHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no sale indexed handler registered")
+	}
+
+	return c.dispatchCollectionEvent(ctx, delivery, handler)
+}
+
+// processIntentTrackedEvent processes intent tx tracking events from the orchestrator
+func (c *EventConsumer) processIntentTrackedEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.intentTrackedHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no intent tracked handler registered")
+	}
+
+	return c.dispatchCollectionEvent(ctx, delivery, handler)
+}
+
+// processMarketEvent processes offer, listing and auction events for the alert scheduler
+func (c *EventConsumer) processMarketEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.marketEventHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no market event handler registered")
+	}
+
+	return c.dispatchCollectionEvent(ctx, delivery, handler)
+}
+
+// dispatchCollectionEvent decodes, validates and hands a collection event to handler
+func (c *EventConsumer) dispatchCollectionEvent(ctx context.Context, delivery amqp.Delivery, handler domain.CollectionEventHandler) error {
 	// Parse the message body
 	var collectionEvent domain.CollectionEvent
 	err := json.Unmarshal(delivery.Body, &collectionEvent)
@@ -273,15 +490,6 @@ func (c *EventConsumer) processCollectionEvent(ctx context.Context, delivery amq
 		}
 	}
 
-	// Call the registered handler
-	c.mu.RLock()
-	handler := c.collectionEventHandler
-	c.mu.RUnlock()
-
-	if handler == nil {
-		return fmt.Errorf("no collection event handler registered")
-	}
-
 	log.Printf("Processing collection event: EventID=%s, Type=%s, ChainID=%s, Contract=%s",
 		collectionEvent.EventID, collectionEvent.EventType, collectionEvent.ChainID, collectionEvent.Contract)
 
@@ -294,7 +502,8 @@ func (c *EventConsumer) validateCollectionEvent(event *domain.CollectionEvent) e
 		return fmt.Errorf("event_type is required")
 	}
 
-	if event.Contract == "" {
+	// Intent events are sent before the deployment is mined, so the contract may be unknown
+	if event.Contract == "" && event.EventType != "intent_tx_tracked" {
 		return fmt.Errorf("contract address is required")
 	}
 
@@ -311,6 +520,49 @@ func (c *EventConsumer) validateCollectionEvent(event *domain.CollectionEvent) e
 				return fmt.Errorf("required field '%s' is missing from event data", field)
 			}
 		}
+	case "collection_imported":
+		requiredFields := []string{"collection_address", "owner", "collection_type"}
+		for _, field := range requiredFields {
+			if _, exists := event.Data[field]; !exists {
+				return fmt.Errorf("required field '%s' is missing from event data", field)
+			}
+		}
+	case "collection_confirmations":
+		requiredFields := []string{"collection_address", "confirmations", "required_confirmations"}
+		for _, field := range requiredFields {
+			if _, exists := event.Data[field]; !exists {
+				return fmt.Errorf("required field '%s' is missing from event data", field)
+			}
+		}
+	case "transfer", "transfer_single", "transfer_batch", "update_user", "create_user_record", "delete_user_record":
+		if _, exists := event.Data["args"]; !exists {
+			return fmt.Errorf("required field 'args' is missing from event data")
+		}
+	case "collection_ownership_transferred", "collection_royalty_updated", "collection_base_uri_updated", "collection_payout_splits_updated":
+		if _, exists := event.Data["collection_address"]; !exists {
+			return fmt.Errorf("required field 'collection_address' is missing from event data")
+		}
+	case "intent_tx_tracked":
+		if event.TxHash == "" {
+			return fmt.Errorf("tx_hash is required")
+		}
+		if _, exists := event.Data["intent_id"]; !exists {
+			return fmt.Errorf("required field 'intent_id' is missing from event data")
+		}
+	case "sale_indexed":
+		requiredFields := []string{"token_id", "price"}
+		for _, field := range requiredFields {
+			if _, exists := event.Data[field]; !exists {
+				return fmt.Errorf("required field '%s' is missing from event data", field)
+			}
+		}
+	default:
+		// offer_*, listing_* and auction_* events carry their subject id
+		if subject, _, ok := strings.Cut(event.EventType, "_"); ok && (subject == "offer" || subject == "listing" || subject == "auction") {
+			if _, exists := event.Data[subject+"_id"]; !exists {
+				return fmt.Errorf("required field '%s_id' is missing from event data", subject)
+			}
+		}
 	}
 
 	return nil
@@ -319,7 +571,19 @@ func (c *EventConsumer) validateCollectionEvent(event *domain.CollectionEvent) e
 // getEventTypeFromRoutingKey extracts event type from routing key
 func (c *EventConsumer) getEventTypeFromRoutingKey(routingKey string) string {
 	// Expected format: collections.events.created.eip155-1 (per CREATE.md line 68)
+	// or sales.events.indexed.eip155-1 for sale.indexed
+	// or offers|listings|auctions.events.<action>.eip155-1 for market events
 	parts := strings.Split(routingKey, ".")
+	// or intents.events.tx_tracked.eip155-1 for orchestrator intents
+	if len(parts) >= 3 && parts[0] == "intents" {
+		return "intent_" + parts[2] // "intent_tx_tracked"
+	}
+	if len(parts) >= 3 && parts[0] == "sales" {
+		return "sale_" + parts[2] // "sale_indexed"
+	}
+	if len(parts) >= 3 && (parts[0] == "offers" || parts[0] == "listings" || parts[0] == "auctions") {
+		return strings.TrimSuffix(parts[0], "s") + "_" + parts[2] // "offer_created"
+	}
 	if len(parts) >= 3 {
 		eventType := parts[2]            // "created"
 		return "collection_" + eventType // return "collection_created"
@@ -337,6 +601,11 @@ func (c *EventConsumer) extractChainIDFromRoutingKey(routingKey string) string {
 	return ""
 }
 
+// ReportLag reports the consumer's processing lag through report every interval until ctx is done
+func (c *EventConsumer) ReportLag(ctx context.Context, interval time.Duration, report messaging.LagReporter) {
+	c.lag.Report(ctx, interval, report)
+}
+
 // Health check for the consumer
 func (c *EventConsumer) HealthCheck() error {
 	c.mu.RLock()
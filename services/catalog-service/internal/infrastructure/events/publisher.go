@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 )
 
@@ -62,6 +63,24 @@ func (p *EventPublisher) PublishDomainEvent(ctx context.Context, event *domain.D
 		} else {
 			routingKey = fmt.Sprintf("%s.%s", collectionDomainPrefix, event.ChainID)
 		}
+	case "catalog.item_flagged", "catalog.item_unflagged":
+		// Moderation events keep their own namespace so search and cache layers can bind to catalog.#
+		routingKey = fmt.Sprintf("%s.%s", event.EventType, event.ChainID)
+	case domain.JobOfferExpiringSoon, domain.JobListingExpiringSoon, domain.JobAuctionEnded, domain.JobDropStartingSoon:
+		// Scheduled alerts, consumed by the subscription worker: offer.expiring_soon.eip155-1
+		routingKey = fmt.Sprintf("%s.%s", event.EventType, event.ChainID)
+	case domain.EventAuctionBidPlaced:
+		// Live bids, streamed to auction watchers by the subscription worker
+		routingKey = fmt.Sprintf("%s.%s", event.EventType, event.ChainID)
+	case domain.EventWatchlistFloorBelow:
+		// Per-user watchlist alerts for notification consumers: watchlist.floor_below.eip155-1
+		routingKey = fmt.Sprintf("%s.%s", event.EventType, event.ChainID)
+	case domain.EventTokenSupplyChanged:
+		// Live ERC-1155 supply counters: token.supply_changed.eip155-1.<contract>
+		routingKey = fmt.Sprintf("%s.%s.%s", event.EventType, event.ChainID, contractAddr)
+	case domain.EventRentalStarted, domain.JobRentalExpired:
+		// Rental lifecycle for rental marketplaces: rental.started.eip155-1.<contract>
+		routingKey = fmt.Sprintf("%s.%s.%s", event.EventType, event.ChainID, contractAddr)
 	default:
 		routingKey = fmt.Sprintf("collections.domain.%s.%s", event.EventType, event.ChainID)
 	}
@@ -101,6 +120,59 @@ func (p *EventPublisher) PublishDomainEvent(ctx context.Context, event *domain.D
 	return nil
 }
 
+// PublishExportReady tells the requester of a background collection export that it
+// finished; the flat payload carries user_id for the account event stream
+func (p *EventPublisher) PublishExportReady(ctx context.Context, event contracts.CollectionExportReadyEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal export ready event: %w", err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   contracts.CollectionsExchange,
+		RoutingKey: contracts.CollectionExportReadyKey,
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   contracts.AccountEventExportReady,
+			"content_type": "application/json",
+		},
+		Timestamp: event.CompletedAt,
+		MessageID: "export_ready_" + event.ExportID + "_" + event.Status,
+	}
+
+	if err := p.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("failed to publish export ready event: %w", err)
+	}
+	return nil
+}
+
+// PublishHoldingsChanged announces how an indexed transfer changed the token counts of its
+// wallets, for profile stats
+func (p *EventPublisher) PublishHoldingsChanged(ctx context.Context, event contracts.HoldingsChangedEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal holdings changed event: %w", err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   contracts.CollectionsExchange,
+		RoutingKey: "catalog.holdings_changed." + event.ChainID,
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   "holdings_changed",
+			"chain_id":     event.ChainID,
+			"content_type": "application/json",
+		},
+		Timestamp: event.OccurredAt,
+		MessageID: event.EventID,
+	}
+
+	if err := p.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("failed to publish holdings changed event: %w", err)
+	}
+	return nil
+}
+
 // PublishCollectionUpserted publishes a collection upserted event
 func (p *EventPublisher) PublishCollectionUpserted(ctx context.Context, collection *domain.Collection) error {
 	if collection == nil {
@@ -0,0 +1,977 @@
+package grpc_handler
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type GRPCHandler struct {
+	catalogpb.UnimplementedCatalogServiceServer
+	svc domain.CatalogService
+}
+
+func NewGRPCHandler(svc domain.CatalogService) *GRPCHandler {
+	return &GRPCHandler{svc: svc}
+}
+
+func (h *GRPCHandler) GetCollection(ctx context.Context, req *catalogpb.GetCollectionRequest) (*catalogpb.GetCollectionResponse, error) {
+	collection, err := h.svc.GetCollection(ctx, domain.ChainID(req.ChainId), domain.Address(req.ContractAddress), req.IncludeFlagged, req.IncludeUnconfirmed)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.GetCollectionResponse{Collection: domainToProtoCollection(collection)}, nil
+}
+
+func (h *GRPCHandler) GetCollectionBySlug(ctx context.Context, req *catalogpb.GetCollectionBySlugRequest) (*catalogpb.GetCollectionResponse, error) {
+	collection, err := h.svc.GetCollectionBySlug(ctx, req.Slug, req.IncludeFlagged, req.IncludeUnconfirmed)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.GetCollectionResponse{Collection: domainToProtoCollection(collection)}, nil
+}
+
+func (h *GRPCHandler) ListCollections(ctx context.Context, req *catalogpb.ListCollectionsRequest) (*catalogpb.ListCollectionsResponse, error) {
+	floorPrice, err := protoToPriceRange("floor_price", req.FloorPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := h.svc.ListCollections(ctx, domain.CollectionFilter{
+		ChainID:            req.ChainId,
+		CreatedByUserID:    req.CreatedByUserId,
+		Creator:            req.Creator,
+		VerifiedOnly:       req.VerifiedOnly,
+		FloorPrice:         floorPrice,
+		Sort:               domain.CollectionSort(req.Sort),
+		Descending:         req.Descending,
+		Limit:              int(req.Limit),
+		Offset:             int(req.Offset),
+		IncludeFlagged:     req.IncludeFlagged,
+		IncludeUnconfirmed: req.IncludeUnconfirmed,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.Collection, len(collections))
+	for i := range collections {
+		out[i] = domainToProtoCollection(&collections[i])
+	}
+
+	return &catalogpb.ListCollectionsResponse{Collections: out}, nil
+}
+
+func (h *GRPCHandler) SetCollectionOrganization(ctx context.Context, req *catalogpb.SetCollectionOrganizationRequest) (*catalogpb.SetCollectionOrganizationResponse, error) {
+	collection, err := h.svc.SetCollectionOrganization(ctx, domain.ChainID(req.ChainId), domain.Address(req.ContractAddress), req.OrgId, req.ActorId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.SetCollectionOrganizationResponse{Collection: domainToProtoCollection(collection)}, nil
+}
+
+func (h *GRPCHandler) SetCollectionContent(ctx context.Context, req *catalogpb.SetCollectionContentRequest) (*catalogpb.SetCollectionContentResponse, error) {
+	collection, err := h.svc.SetCollectionContent(ctx, domain.SetCollectionContentInput{
+		ChainID:         domain.ChainID(req.ChainId),
+		ContractAddress: domain.Address(req.ContractAddress),
+		Locale:          req.Locale,
+		Description:     req.Description,
+		Tagline:         req.Tagline,
+		ActorID:         req.ActorId,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.SetCollectionContentResponse{Collection: domainToProtoCollection(collection)}, nil
+}
+
+func (h *GRPCHandler) SetCollectionClassification(ctx context.Context, req *catalogpb.SetCollectionClassificationRequest) (*catalogpb.SetCollectionClassificationResponse, error) {
+	collection, err := h.svc.SetCollectionClassification(ctx, domain.SetCollectionClassificationInput{
+		ChainID:         domain.ChainID(req.ChainId),
+		ContractAddress: domain.Address(req.ContractAddress),
+		Category:        req.Category,
+		Tags:            req.Tags,
+		ActorID:         req.ActorId,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.SetCollectionClassificationResponse{Collection: domainToProtoCollection(collection)}, nil
+}
+
+func (h *GRPCHandler) ListRelatedCollections(ctx context.Context, req *catalogpb.ListRelatedCollectionsRequest) (*catalogpb.ListRelatedCollectionsResponse, error) {
+	collections, err := h.svc.ListRelatedCollections(ctx, req.Slug, int(req.Limit))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.Collection, len(collections))
+	for i := range collections {
+		out[i] = domainToProtoCollection(&collections[i])
+	}
+	return &catalogpb.ListRelatedCollectionsResponse{Collections: out}, nil
+}
+
+func (h *GRPCHandler) FlagItem(ctx context.Context, req *catalogpb.FlagItemRequest) (*catalogpb.FlagItemResponse, error) {
+	flag, err := h.svc.FlagItem(ctx, domain.FlagItemInput{
+		ChainID:         req.ChainId,
+		ContractAddress: req.ContractAddress,
+		TokenID:         req.TokenId,
+		Reason:          req.Reason,
+		Note:            req.Note,
+		Source:          req.Source,
+		ActorID:         req.ActorId,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.FlagItemResponse{Flag: domainToProtoFlag(flag)}, nil
+}
+
+func (h *GRPCHandler) UnflagItem(ctx context.Context, req *catalogpb.UnflagItemRequest) (*catalogpb.UnflagItemResponse, error) {
+	flag, err := h.svc.UnflagItem(ctx, domain.UnflagItemInput{
+		ChainID:         req.ChainId,
+		ContractAddress: req.ContractAddress,
+		TokenID:         req.TokenId,
+		Note:            req.Note,
+		ActorID:         req.ActorId,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.UnflagItemResponse{Flag: domainToProtoFlag(flag)}, nil
+}
+
+func (h *GRPCHandler) ReportContent(ctx context.Context, req *catalogpb.ReportContentRequest) (*catalogpb.ReportContentResponse, error) {
+	report, duplicate, err := h.svc.ReportContent(ctx, domain.ReportContentInput{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetId,
+		ReporterID: req.ReporterId,
+		Reason:     req.Reason,
+		Details:    req.Details,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.ReportContentResponse{Report: domainToProtoReport(report), Duplicate: duplicate}, nil
+}
+
+func (h *GRPCHandler) ListReportQueue(ctx context.Context, req *catalogpb.ListReportQueueRequest) (*catalogpb.ListReportQueueResponse, error) {
+	items, err := h.svc.ListReportQueue(ctx, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.ReportQueueItem, len(items))
+	for i, item := range items {
+		out[i] = &catalogpb.ReportQueueItem{
+			TargetType:      item.TargetType,
+			TargetId:        item.TargetID,
+			ReportCount:     int32(item.ReportCount),
+			Reasons:         item.Reasons,
+			FirstReportedAt: timestamppb.New(item.FirstReportedAt),
+			LastReportedAt:  timestamppb.New(item.LastReportedAt),
+		}
+	}
+
+	return &catalogpb.ListReportQueueResponse{Items: out}, nil
+}
+
+func (h *GRPCHandler) ResolveReports(ctx context.Context, req *catalogpb.ResolveReportsRequest) (*catalogpb.ResolveReportsResponse, error) {
+	resolved, flag, err := h.svc.ResolveReports(ctx, domain.ResolveReportsInput{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetId,
+		Action:     req.Action,
+		Note:       req.Note,
+		ActorID:    req.ActorId,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	resp := &catalogpb.ResolveReportsResponse{Resolved: int32(resolved)}
+	if flag != nil {
+		resp.Flag = domainToProtoFlag(flag)
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) GetEarnings(ctx context.Context, req *catalogpb.GetEarningsRequest) (*catalogpb.GetEarningsResponse, error) {
+	totals, since, err := h.svc.GetEarnings(ctx, req.Recipients, req.Period)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.EarningsTotal, len(totals))
+	for i, t := range totals {
+		out[i] = &catalogpb.EarningsTotal{
+			ChainId:         t.ChainID,
+			ContractAddress: t.ContractAddress,
+			CollectionName:  t.CollectionName,
+			Currency:        t.Currency,
+			Amount:          t.Amount.String(),
+			SaleCount:       int32(t.SaleCount),
+		}
+	}
+
+	resp := &catalogpb.GetEarningsResponse{Totals: out}
+	if !since.IsZero() {
+		resp.Since = timestamppb.New(since)
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) GetAuction(ctx context.Context, req *catalogpb.GetAuctionRequest) (*catalogpb.GetAuctionResponse, error) {
+	auction, err := h.svc.GetAuction(ctx, domain.ChainID(req.ChainId), req.AuctionId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.GetAuctionResponse{Auction: domainToProtoAuction(auction)}, nil
+}
+
+func (h *GRPCHandler) GetToken(ctx context.Context, req *catalogpb.GetTokenRequest) (*catalogpb.GetTokenResponse, error) {
+	token, err := h.svc.GetToken(ctx, domain.ChainID(req.ChainId), domain.Address(req.ContractAddress), req.TokenId, req.IncludeFlagged)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.GetTokenResponse{Token: domainToProtoToken(token)}, nil
+}
+
+func (h *GRPCHandler) ListTokens(ctx context.Context, req *catalogpb.ListTokensRequest) (*catalogpb.ListTokensResponse, error) {
+	price, err := protoToPriceRange("price", req.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	traits := make([]domain.TraitFilter, len(req.Traits))
+	for i, t := range req.Traits {
+		traits[i] = domain.TraitFilter{Name: t.Name, Values: t.Values}
+	}
+
+	tokens, err := h.svc.ListTokens(ctx, domain.TokenFilter{
+		ChainID:         req.ChainId,
+		ContractAddress: req.ContractAddress,
+		Owner:           req.Owner,
+		Owners:          req.Owners,
+		Price:           price,
+		Traits:          traits,
+		Sort:            domain.TokenSort(req.Sort),
+		Descending:      req.Descending,
+		Limit:           int(req.Limit),
+		Offset:          int(req.Offset),
+		IncludeFlagged:  req.IncludeFlagged,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.Token, len(tokens))
+	for i := range tokens {
+		out[i] = domainToProtoToken(&tokens[i])
+	}
+	return &catalogpb.ListTokensResponse{Tokens: out}, nil
+}
+
+func (h *GRPCHandler) Suggest(ctx context.Context, req *catalogpb.SuggestRequest) (*catalogpb.SuggestResponse, error) {
+	suggestions, err := h.svc.Suggest(ctx, req.Query, int(req.Limit))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.Suggestion, len(suggestions))
+	for i, s := range suggestions {
+		out[i] = &catalogpb.Suggestion{
+			Kind:            string(s.Kind),
+			ChainId:         string(s.ChainID),
+			ContractAddress: string(s.ContractAddress),
+			TokenId:         s.TokenID,
+			Slug:            s.Slug,
+			Label:           s.Label,
+			CollectionName:  s.CollectionName,
+			ImageUrl:        s.ImageURL,
+			Score:           s.Score,
+		}
+	}
+	return &catalogpb.SuggestResponse{Suggestions: out}, nil
+}
+
+func (h *GRPCHandler) ListWalletActivity(ctx context.Context, req *catalogpb.ListWalletActivityRequest) (*catalogpb.ListWalletActivityResponse, error) {
+	var before *domain.ActivityCursor
+	if req.Before != nil {
+		before = &domain.ActivityCursor{OccurredAt: req.Before.AsTime(), ID: req.BeforeId}
+	}
+
+	activities, err := h.svc.ListWalletActivity(ctx, req.Address, before, int(req.Limit))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.WalletActivity, len(activities))
+	for i := range activities {
+		out[i] = domainToProtoWalletActivity(&activities[i])
+	}
+	return &catalogpb.ListWalletActivityResponse{Activities: out}, nil
+}
+
+func (h *GRPCHandler) Favorite(ctx context.Context, req *catalogpb.FavoriteRequest) (*catalogpb.FavoriteResponse, error) {
+	in := domain.FavoriteInput{
+		UserID:          req.UserId,
+		TargetType:      req.TargetType,
+		ChainID:         req.ChainId,
+		ContractAddress: req.ContractAddress,
+		TokenID:         req.TokenId,
+	}
+	if req.FloorAlertBelow != "" {
+		threshold, ok := new(big.Int).SetString(req.FloorAlertBelow, 10)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "floor_alert_below must be a base-10 integer")
+		}
+		in.FloorAlertBelow = threshold
+	}
+
+	item, err := h.svc.Favorite(ctx, in)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.FavoriteResponse{Item: domainToProtoWatchlistItem(item)}, nil
+}
+
+func (h *GRPCHandler) RemoveFavorite(ctx context.Context, req *catalogpb.RemoveFavoriteRequest) (*catalogpb.RemoveFavoriteResponse, error) {
+	if err := h.svc.RemoveFavorite(ctx, req.UserId, req.Id); err != nil {
+		return nil, h.handleError(err)
+	}
+	return &catalogpb.RemoveFavoriteResponse{}, nil
+}
+
+func (h *GRPCHandler) SaveSearch(ctx context.Context, req *catalogpb.SaveSearchRequest) (*catalogpb.SaveSearchResponse, error) {
+	search, err := h.svc.SaveSearch(ctx, domain.SaveSearchInput{
+		UserID:  req.UserId,
+		Name:    req.Name,
+		Query:   req.Query,
+		Filters: req.Filters,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.SaveSearchResponse{Search: domainToProtoSavedSearch(search)}, nil
+}
+
+func (h *GRPCHandler) DeleteSavedSearch(ctx context.Context, req *catalogpb.DeleteSavedSearchRequest) (*catalogpb.DeleteSavedSearchResponse, error) {
+	if err := h.svc.DeleteSavedSearch(ctx, req.UserId, req.Id); err != nil {
+		return nil, h.handleError(err)
+	}
+	return &catalogpb.DeleteSavedSearchResponse{}, nil
+}
+
+func (h *GRPCHandler) GetWatchlist(ctx context.Context, req *catalogpb.GetWatchlistRequest) (*catalogpb.GetWatchlistResponse, error) {
+	items, searches, err := h.svc.GetWatchlist(ctx, req.UserId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	resp := &catalogpb.GetWatchlistResponse{
+		Items:         make([]*catalogpb.WatchlistItem, len(items)),
+		SavedSearches: make([]*catalogpb.SavedSearch, len(searches)),
+	}
+	for i := range items {
+		resp.Items[i] = domainToProtoWatchlistItem(&items[i])
+	}
+	for i := range searches {
+		resp.SavedSearches[i] = domainToProtoSavedSearch(&searches[i])
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) GetSystemStatus(ctx context.Context, req *catalogpb.GetSystemStatusRequest) (*catalogpb.GetSystemStatusResponse, error) {
+	systemStatus, err := h.svc.GetSystemStatus(ctx)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	resp := &catalogpb.GetSystemStatusResponse{CheckedAt: timestamppb.New(systemStatus.CheckedAt)}
+	for _, q := range systemStatus.Queues {
+		resp.Queues = append(resp.Queues, &catalogpb.QueueStatus{
+			Name:      q.Name,
+			Messages:  int32(q.Messages),
+			Consumers: int32(q.Consumers),
+			Error:     q.Error,
+		})
+	}
+	for _, c := range systemStatus.Consumers {
+		resp.Consumers = append(resp.Consumers, &catalogpb.ConsumerStatus{
+			Consumer:             c.Consumer,
+			Queue:                c.Queue,
+			MessagesPerSecond:    c.MessagesPerSecond,
+			InFlight:             int32(c.InFlight),
+			OldestUnackedSeconds: c.OldestUnackedSeconds,
+			Processed:            c.Processed,
+			Failed:               c.Failed,
+			ReportedAt:           timestamppb.New(c.ReportedAt),
+			Stale:                c.Stale,
+			FallingBehind:        c.FallingBehind,
+		})
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) CreateHolderSnapshot(ctx context.Context, req *catalogpb.CreateHolderSnapshotRequest) (*catalogpb.CreateHolderSnapshotResponse, error) {
+	in := domain.CreateHolderSnapshotInput{
+		ChainID:     domain.ChainID(req.ChainId),
+		Contract:    domain.Address(req.ContractAddress),
+		RequestedBy: req.RequestedBy,
+	}
+	if req.BlockNumber != nil {
+		block := req.BlockNumber.Value
+		in.BlockNumber = &block
+	}
+
+	snapshot, err := h.svc.CreateHolderSnapshot(ctx, in)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+	return &catalogpb.CreateHolderSnapshotResponse{Snapshot: domainToProtoHolderSnapshot(snapshot)}, nil
+}
+
+func (h *GRPCHandler) GetHolderSnapshot(ctx context.Context, req *catalogpb.GetHolderSnapshotRequest) (*catalogpb.GetHolderSnapshotResponse, error) {
+	snapshot, err := h.svc.GetHolderSnapshot(ctx, req.Id)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+	return &catalogpb.GetHolderSnapshotResponse{Snapshot: domainToProtoHolderSnapshot(snapshot)}, nil
+}
+
+func (h *GRPCHandler) CreateCollectionExport(ctx context.Context, req *catalogpb.CreateCollectionExportRequest) (*catalogpb.CreateCollectionExportResponse, error) {
+	in := domain.CreateCollectionExportInput{
+		Kind:        req.Kind,
+		ChainID:     domain.ChainID(req.ChainId),
+		Contract:    domain.Address(req.ContractAddress),
+		RequestedBy: req.RequestedBy,
+	}
+	if req.From != nil {
+		in.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		in.To = req.To.AsTime()
+	}
+
+	export, err := h.svc.CreateCollectionExport(ctx, in)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+	return &catalogpb.CreateCollectionExportResponse{Export: domainToProtoCollectionExport(export)}, nil
+}
+
+func (h *GRPCHandler) GetCollectionExport(ctx context.Context, req *catalogpb.GetCollectionExportRequest) (*catalogpb.GetCollectionExportResponse, error) {
+	export, err := h.svc.GetCollectionExport(ctx, req.Id)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+	return &catalogpb.GetCollectionExportResponse{Export: domainToProtoCollectionExport(export)}, nil
+}
+
+func (h *GRPCHandler) VerifyTokenGate(ctx context.Context, req *catalogpb.VerifyTokenGateRequest) (*catalogpb.VerifyTokenGateResponse, error) {
+	in := domain.VerifyTokenGateInput{
+		UserID:   req.UserId,
+		Owners:   req.Owners,
+		ChainID:  domain.ChainID(req.ChainId),
+		Contract: domain.Address(req.ContractAddress),
+	}
+	if req.MinBalance != "" {
+		minBalance, ok := new(big.Int).SetString(req.MinBalance, 10)
+		if !ok {
+			return nil, h.handleError(domain.ErrInvalidInput.WithMessage("min_balance must be a decimal integer"))
+		}
+		in.MinBalance = minBalance
+	}
+
+	result, err := h.svc.VerifyTokenGate(ctx, in)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+	resp := &catalogpb.VerifyTokenGateResponse{
+		Held:    result.Held,
+		Balance: result.Balance.String(),
+	}
+	if result.Held {
+		resp.GateToken = result.Token
+		resp.ExpiresAt = timestamppb.New(result.ExpiresAt)
+	}
+	return resp, nil
+}
+
+func (h *GRPCHandler) ListDelegatedVaults(ctx context.Context, req *catalogpb.ListDelegatedVaultsRequest) (*catalogpb.ListDelegatedVaultsResponse, error) {
+	vaults, err := h.svc.ListDelegatedVaults(ctx, req.ChainId, req.Delegates, req.ContractAddress)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+	return &catalogpb.ListDelegatedVaultsResponse{Vaults: vaults}, nil
+}
+
+func (h *GRPCHandler) ResyncCollection(ctx context.Context, req *catalogpb.ResyncCollectionRequest) (*catalogpb.ResyncCollectionResponse, error) {
+	report, err := h.svc.ResyncCollection(ctx, domain.ResyncCollectionInput{
+		ChainID:     domain.ChainID(req.ChainId),
+		Contract:    domain.Address(req.ContractAddress),
+		SampleSize:  int(req.SampleSize),
+		Repair:      req.Repair,
+		RequestedBy: req.RequestedBy,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := &catalogpb.ResyncCollectionResponse{
+		Id:              report.ID,
+		ChainId:         report.ChainID,
+		ContractAddress: report.Contract,
+		Standard:        report.Standard,
+		BlockNumber:     report.BlockNumber,
+		TokensSampled:   int32(report.TokensSampled),
+		Repaired:        report.Repaired,
+		CheckedAt:       timestamppb.New(report.CheckedAt),
+	}
+	for _, d := range report.Drifts {
+		out.Drifts = append(out.Drifts, &catalogpb.ResyncDrift{
+			Kind:    string(d.Kind),
+			TokenId: d.TokenID,
+			Holder:  d.Holder,
+			Catalog: d.Catalog,
+			Chain:   d.Chain,
+		})
+	}
+	return out, nil
+}
+
+func (h *GRPCHandler) ListUpcomingDrops(ctx context.Context, req *catalogpb.ListUpcomingDropsRequest) (*catalogpb.ListUpcomingDropsResponse, error) {
+	filter := domain.DropCalendarFilter{ChainID: req.ChainId, Limit: int(req.Limit)}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+
+	drops, err := h.svc.ListUpcomingDrops(ctx, filter)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.UpcomingDrop, len(drops))
+	for i := range drops {
+		out[i] = domainToProtoUpcomingDrop(&drops[i])
+	}
+	return &catalogpb.ListUpcomingDropsResponse{Drops: out}, nil
+}
+
+func (h *GRPCHandler) SubmitDrop(ctx context.Context, req *catalogpb.SubmitDropRequest) (*catalogpb.SubmitDropResponse, error) {
+	in := domain.SubmitDropInput{
+		ChainID:         req.ChainId,
+		ContractAddress: req.ContractAddress,
+		Name:            req.Name,
+		Description:     req.Description,
+		ImageURL:        req.ImageUrl,
+		ExternalURL:     req.ExternalUrl,
+		MintPrice:       req.MintPrice,
+		SubmittedBy:     req.SubmittedBy,
+	}
+	if req.StartsAt != nil {
+		in.StartsAt = req.StartsAt.AsTime()
+	}
+	if req.EndsAt != nil {
+		endsAt := req.EndsAt.AsTime()
+		in.EndsAt = &endsAt
+	}
+
+	sub, err := h.svc.SubmitDrop(ctx, in)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.SubmitDropResponse{Submission: domainToProtoDropSubmission(sub)}, nil
+}
+
+func (h *GRPCHandler) ListDropSubmissions(ctx context.Context, req *catalogpb.ListDropSubmissionsRequest) (*catalogpb.ListDropSubmissionsResponse, error) {
+	subs, err := h.svc.ListDropSubmissions(ctx, domain.DropSubmissionFilter{
+		Status:      domain.DropSubmissionStatus(req.Status),
+		SubmittedBy: req.SubmittedBy,
+		Limit:       int(req.Limit),
+		Offset:      int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	out := make([]*catalogpb.DropSubmission, len(subs))
+	for i := range subs {
+		out[i] = domainToProtoDropSubmission(&subs[i])
+	}
+	return &catalogpb.ListDropSubmissionsResponse{Submissions: out}, nil
+}
+
+func (h *GRPCHandler) ReviewDropSubmission(ctx context.Context, req *catalogpb.ReviewDropSubmissionRequest) (*catalogpb.ReviewDropSubmissionResponse, error) {
+	sub, err := h.svc.ReviewDropSubmission(ctx, domain.ReviewDropSubmissionInput{
+		ID:      req.Id,
+		Action:  req.Action,
+		Note:    req.Note,
+		ActorID: req.ActorId,
+	})
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &catalogpb.ReviewDropSubmissionResponse{Submission: domainToProtoDropSubmission(sub)}, nil
+}
+
+func (h *GRPCHandler) GetMintStats(ctx context.Context, req *catalogpb.GetMintStatsRequest) (*catalogpb.GetMintStatsResponse, error) {
+	stats, err := h.svc.GetMintStats(ctx, domain.ChainID(req.ChainId), domain.Address(req.ContractAddress), req.Window)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	buckets := make([]*catalogpb.MintStatsBucket, len(stats.Buckets))
+	for i, b := range stats.Buckets {
+		buckets[i] = &catalogpb.MintStatsBucket{
+			Minute:        timestamppb.New(b.Minute),
+			Mints:         b.Mints.String(),
+			UniqueMinters: b.UniqueMinters,
+			Revenue:       b.Revenue.String(),
+		}
+	}
+
+	return &catalogpb.GetMintStatsResponse{
+		ChainId:         stats.ChainID,
+		ContractAddress: stats.ContractAddress,
+		Window:          stats.Window,
+		Since:           timestamppb.New(stats.Since),
+		Mints:           stats.Mints.String(),
+		UniqueMinters:   stats.UniqueMinters,
+		Revenue:         stats.Revenue.String(),
+		Buckets:         buckets,
+	}, nil
+}
+
+func (h *GRPCHandler) handleError(err error) error {
+	return errs.ToGRPC(err)
+}
+
+func domainToProtoCollection(c *domain.Collection) *catalogpb.Collection {
+	out := &catalogpb.Collection{
+		Id:                    c.ID,
+		Slug:                  c.Slug,
+		Name:                  c.Name,
+		Description:           c.Description,
+		ChainId:               c.ChainID,
+		ContractAddress:       c.ContractAddress,
+		Creator:               c.Creator,
+		Owner:                 c.Owner,
+		CollectionType:        c.CollectionType,
+		RoyaltyRecipient:      c.RoyaltyRecipient,
+		RoyaltyPercentage:     uint32(c.RoyaltyPercentage),
+		TokenUri:              c.TokenURI,
+		ImageUrl:              c.ImageURL,
+		OwnerOrgId:            c.OwnerOrgID,
+		IntentId:              c.IntentID,
+		CreatedByUserId:       c.CreatedByUserID,
+		Confirmations:         int32(c.Confirmations),
+		RequiredConfirmations: int32(c.RequiredConfirmations),
+		Category:              c.Category,
+		Tags:                  c.Tags,
+		IsVerified:            c.IsVerified,
+		Flagged:               c.Flagged(),
+		Reported:              c.Reported(),
+		CreatedAt:             timestamppb.New(c.CreatedAt),
+		UpdatedAt:             timestamppb.New(c.UpdatedAt),
+	}
+	if c.MaxSupply != nil {
+		out.MaxSupply = c.MaxSupply.String()
+	}
+	if c.TotalSupply != nil {
+		out.TotalSupply = c.TotalSupply.String()
+	}
+	for _, l := range c.Localized {
+		out.Localized = append(out.Localized, &catalogpb.LocalizedContent{
+			Locale:          l.Locale,
+			Description:     l.Description,
+			Tagline:         l.Tagline,
+			UpdatedByUserId: l.UpdatedByUserID,
+			UpdatedAt:       timestamppb.New(l.UpdatedAt),
+		})
+	}
+	for _, split := range c.PayoutSplits {
+		out.PayoutSplits = append(out.PayoutSplits, &catalogpb.PayoutSplit{Recipient: split.Recipient, Bps: split.Bps})
+	}
+	return out
+}
+
+func domainToProtoFlag(f *domain.ModerationFlag) *catalogpb.ModerationFlag {
+	return &catalogpb.ModerationFlag{
+		Id:              f.ID,
+		ChainId:         f.ChainID,
+		ContractAddress: f.ContractAddress,
+		TokenId:         f.TokenID,
+		Status:          string(f.Status),
+		Reason:          f.Reason,
+		Note:            f.Note,
+		Source:          f.Source,
+		ActorId:         f.ActorID,
+		CreatedAt:       timestamppb.New(f.CreatedAt),
+		UpdatedAt:       timestamppb.New(f.UpdatedAt),
+	}
+}
+
+func domainToProtoReport(r *domain.Report) *catalogpb.Report {
+	return &catalogpb.Report{
+		Id:         r.ID,
+		TargetType: r.TargetType,
+		TargetId:   r.TargetID,
+		Reason:     r.Reason,
+		Details:    r.Details,
+		Status:     string(r.Status),
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+	}
+}
+
+func domainToProtoAuction(a *domain.Auction) *catalogpb.Auction {
+	return &catalogpb.Auction{
+		ChainId:         a.ChainID,
+		AuctionId:       a.AuctionID,
+		AuctionHouse:    a.AuctionHouse,
+		ContractAddress: a.ContractAddress,
+		TokenId:         a.TokenID,
+		Seller:          a.Seller,
+		AuctionType:     a.AuctionType,
+		StartPrice:      a.StartPrice.String(),
+		ReservePrice:    a.ReservePrice.String(),
+		EndPrice:        a.EndPrice.String(),
+		HighestBid:      a.HighestBid.String(),
+		HighestBidder:   a.HighestBidder,
+		BidCount:        int32(a.BidCount),
+		Status:          a.Status,
+		Winner:          a.Winner,
+		StartTime:       timestamppb.New(a.StartTime),
+		EndTime:         timestamppb.New(a.EndTime),
+		UpdatedAt:       timestamppb.New(a.UpdatedAt),
+	}
+}
+
+func domainToProtoToken(t *domain.Token) *catalogpb.Token {
+	out := &catalogpb.Token{
+		ChainId:          t.ChainID,
+		ContractAddress:  t.ContractAddress,
+		TokenId:          t.TokenID,
+		Standard:         t.Standard,
+		Supply:           t.Supply.String(),
+		Minted:           t.Minted.String(),
+		Burned:           t.Burned.String(),
+		ModerationStatus: string(t.ModerationStatus),
+	}
+	if t.MaxSupply != nil {
+		out.MaxSupply = t.MaxSupply.String()
+	}
+	out.Name = t.Name
+	out.ImageUrl = t.ImageURL
+	out.Owner = t.Owner
+	if t.RarityScore != nil {
+		out.RarityScore = wrapperspb.Double(*t.RarityScore)
+	}
+	if t.Price != nil {
+		out.Price = t.Price.String()
+	}
+	for _, r := range t.Rentals {
+		out.Rentals = append(out.Rentals, &catalogpb.TokenRental{
+			Standard:  r.Standard,
+			Renter:    r.Renter,
+			Amount:    r.Amount.String(),
+			ExpiresAt: timestamppb.New(r.ExpiresAt),
+			RecordId:  r.RecordID,
+			Owner:     r.Owner,
+		})
+	}
+	return out
+}
+
+// protoToPriceRange parses the base-10 wei bounds of a price filter
+func protoToPriceRange(field string, r *catalogpb.PriceRange) (domain.PriceRange, error) {
+	var out domain.PriceRange
+	if r == nil {
+		return out, nil
+	}
+	var ok bool
+	if r.Min != "" {
+		if out.Min, ok = new(big.Int).SetString(r.Min, 10); !ok {
+			return domain.PriceRange{}, status.Error(codes.InvalidArgument, field+".min must be a base-10 integer")
+		}
+	}
+	if r.Max != "" {
+		if out.Max, ok = new(big.Int).SetString(r.Max, 10); !ok {
+			return domain.PriceRange{}, status.Error(codes.InvalidArgument, field+".max must be a base-10 integer")
+		}
+	}
+	return out, nil
+}
+
+func domainToProtoWalletActivity(a *domain.WalletActivity) *catalogpb.WalletActivity {
+	out := &catalogpb.WalletActivity{
+		Id:              a.ID,
+		ChainId:         a.ChainID,
+		ContractAddress: a.ContractAddress,
+		TokenId:         a.TokenID,
+		Kind:            a.Kind,
+		FromAddress:     a.FromAddress,
+		ToAddress:       a.ToAddress,
+		Quantity:        a.Quantity.String(),
+		Currency:        a.Currency,
+		TxHash:          a.TxHash,
+		OccurredAt:      timestamppb.New(a.OccurredAt),
+	}
+	if a.Price != nil {
+		out.Price = a.Price.String()
+	}
+	return out
+}
+
+func domainToProtoWatchlistItem(w *domain.WatchlistItem) *catalogpb.WatchlistItem {
+	out := &catalogpb.WatchlistItem{
+		Id:              w.ID,
+		TargetType:      w.TargetType,
+		ChainId:         w.ChainID,
+		ContractAddress: w.ContractAddress,
+		TokenId:         w.TokenID,
+		CollectionName:  w.CollectionName,
+		FloorAtAdd:      w.FloorAtAdd.String(),
+		CurrentFloor:    w.CurrentFloor.String(),
+		FloorDelta:      w.FloorDelta().String(),
+		CreatedAt:       timestamppb.New(w.CreatedAt),
+	}
+	if w.FloorAlertBelow != nil {
+		out.FloorAlertBelow = w.FloorAlertBelow.String()
+	}
+	if w.AlertFiredAt != nil {
+		out.AlertFiredAt = timestamppb.New(*w.AlertFiredAt)
+	}
+	return out
+}
+
+func domainToProtoSavedSearch(s *domain.SavedSearch) *catalogpb.SavedSearch {
+	return &catalogpb.SavedSearch{
+		Id:        s.ID,
+		Name:      s.Name,
+		Query:     s.Query,
+		Filters:   s.Filters,
+		CreatedAt: timestamppb.New(s.CreatedAt),
+	}
+}
+
+func domainToProtoHolderSnapshot(s *domain.HolderSnapshot) *catalogpb.HolderSnapshot {
+	out := &catalogpb.HolderSnapshot{
+		Id:              s.ID,
+		ChainId:         s.ChainID,
+		ContractAddress: s.ContractAddress,
+		BlockNumber:     s.BlockNumber,
+		RequestedBy:     s.RequestedBy,
+		HolderCount:     int32(s.HolderCount),
+		Csv:             domainToProtoSnapshotExport(s.CSV),
+		Json:            domainToProtoSnapshotExport(s.JSON),
+		CreatedAt:       timestamppb.New(s.CreatedAt),
+	}
+	if s.TotalQuantity != nil {
+		out.TotalQuantity = s.TotalQuantity.String()
+	}
+	return out
+}
+
+func domainToProtoCollectionExport(e *domain.CollectionExport) *catalogpb.CollectionExport {
+	out := &catalogpb.CollectionExport{
+		Id:              e.ID,
+		Kind:            e.Kind,
+		ChainId:         e.ChainID,
+		ContractAddress: e.ContractAddress,
+		From:            timestamppb.New(e.From),
+		To:              timestamppb.New(e.To),
+		RequestedBy:     e.RequestedBy,
+		Status:          e.Status,
+		RowCount:        int32(e.RowCount),
+		Error:           e.Error,
+		Csv:             domainToProtoSnapshotExport(e.CSV),
+		CreatedAt:       timestamppb.New(e.CreatedAt),
+	}
+	if e.CompletedAt != nil {
+		out.CompletedAt = timestamppb.New(*e.CompletedAt)
+	}
+	return out
+}
+
+func domainToProtoSnapshotExport(e *domain.SnapshotExport) *catalogpb.SnapshotExport {
+	if e == nil {
+		return nil
+	}
+	return &catalogpb.SnapshotExport{
+		ArtifactId:   e.ArtifactID,
+		DownloadUrl:  e.DownloadURL,
+		UrlExpiresAt: timestamppb.New(e.URLExpiresAt),
+		Bytes:        e.Bytes,
+	}
+}
+
+func domainToProtoUpcomingDrop(d *domain.UpcomingDrop) *catalogpb.UpcomingDrop {
+	out := &catalogpb.UpcomingDrop{
+		Id:              d.ID,
+		Source:          d.Source,
+		ChainId:         d.ChainID,
+		ContractAddress: d.ContractAddress,
+		Name:            d.Name,
+		Description:     d.Description,
+		ImageUrl:        d.ImageURL,
+		ExternalUrl:     d.ExternalURL,
+		Slug:            d.Slug,
+		MintPrice:       d.MintPrice,
+		IsVerified:      d.IsVerified,
+		StartsAt:        timestamppb.New(d.StartsAt),
+	}
+	if d.EndsAt != nil {
+		out.EndsAt = timestamppb.New(*d.EndsAt)
+	}
+	return out
+}
+
+func domainToProtoDropSubmission(s *domain.DropSubmission) *catalogpb.DropSubmission {
+	out := &catalogpb.DropSubmission{
+		Id:              s.ID,
+		ChainId:         s.ChainID,
+		ContractAddress: s.ContractAddress,
+		Name:            s.Name,
+		Description:     s.Description,
+		ImageUrl:        s.ImageURL,
+		ExternalUrl:     s.ExternalURL,
+		MintPrice:       s.MintPrice,
+		StartsAt:        timestamppb.New(s.StartsAt),
+		SubmittedBy:     s.SubmittedBy,
+		Status:          string(s.Status),
+		ReviewedBy:      s.ReviewedBy,
+		ReviewNote:      s.ReviewNote,
+		CreatedAt:       timestamppb.New(s.CreatedAt),
+		UpdatedAt:       timestamppb.New(s.UpdatedAt),
+	}
+	if s.EndsAt != nil {
+		out.EndsAt = timestamppb.New(*s.EndsAt)
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+// Package httpapi serves the catalog's operational endpoints: event deduplication and
+// ordering metrics at /metrics in the Prometheus text format.
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+)
+
+const MetricsRoute = "/metrics"
+
+// Catalog is what the endpoints read; service.CatalogService implements it
+type Catalog interface {
+	DedupCounts() []domain.DedupCount
+	SequenceStats(ctx context.Context) (domain.SequenceStats, error)
+}
+
+// NewHandler routes the operational endpoints
+func NewHandler(catalog Catalog) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(MetricsRoute, func(w http.ResponseWriter, r *http.Request) {
+		serveMetrics(w, r, catalog)
+	})
+	return mux
+}
+
+func serveMetrics(w http.ResponseWriter, r *http.Request, catalog Catalog) {
+	var processed, dropped []domain.DedupCount
+	for _, c := range catalog.DedupCounts() {
+		if c.Reason == domain.NotDuplicate {
+			processed = append(processed, c)
+		} else {
+			dropped = append(dropped, c)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	monitoring.WriteMetricHeader(w, "catalog_events_processed_total", "counter", "Events processed for the first time since the service started.")
+	for _, c := range processed {
+		fmt.Fprintf(w, "catalog_events_processed_total{event_type=%q} %d\n", c.EventType, c.Count)
+	}
+	monitoring.WriteMetricHeader(w, "catalog_events_duplicates_dropped_total", "counter", "Replayed or backfilled events dropped as duplicates, by the key that matched.")
+	for _, c := range dropped {
+		fmt.Fprintf(w, "catalog_events_duplicates_dropped_total{event_type=%q,reason=%q} %d\n", c.EventType, c.Reason, c.Count)
+	}
+
+	stats, err := catalog.SequenceStats(r.Context())
+	if err != nil {
+		// The counters are still worth scraping without the parked event count
+		log.Printf("Failed to count parked events: %v", err)
+	}
+	monitoring.WriteMetricHeader(w, "catalog_events_parked_total", "counter", "Sequenced events parked because they arrived ahead of a gap.")
+	fmt.Fprintf(w, "catalog_events_parked_total %d\n", stats.Parked)
+	monitoring.WriteMetricHeader(w, "catalog_event_gaps_skipped_total", "counter", "Sequence gaps that did not fill within the park timeout and were skipped.")
+	fmt.Fprintf(w, "catalog_event_gaps_skipped_total %d\n", stats.GapsSkipped)
+	if err == nil {
+		monitoring.WriteMetricHeader(w, "catalog_events_parked", "gauge", "Sequenced events waiting for the events before them.")
+		fmt.Fprintf(w, "catalog_events_parked %d\n", stats.Waiting)
+	}
+}
@@ -0,0 +1,112 @@
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// maxMetadataBytes bounds a contract metadata document; they are a few hundred bytes in practice
+const maxMetadataBytes = 1 << 20
+
+// Fetcher reads contract metadata over HTTP(S), IPFS through a gateway, and inline data URIs
+type Fetcher struct {
+	client      *http.Client
+	ipfsGateway string
+}
+
+// NewFetcher creates a metadata fetcher resolving ipfs:// URIs against ipfsGateway,
+// e.g. https://ipfs.io/ipfs/
+func NewFetcher(ipfsGateway string) *Fetcher {
+	return &Fetcher{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		ipfsGateway: strings.TrimSuffix(ipfsGateway, "/") + "/",
+	}
+}
+
+// WithHTTPClient replaces the client metadata is fetched with
+func (f *Fetcher) WithHTTPClient(client *http.Client) *Fetcher {
+	f.client = client
+	return f
+}
+
+func (f *Fetcher) FetchContractMetadata(ctx context.Context, uri string) (*domain.ContractMetadata, error) {
+	body, err := f.read(ctx, strings.TrimSpace(uri))
+	if err != nil {
+		return nil, err
+	}
+
+	var meta domain.ContractMetadata
+	if err := json.Unmarshal(body, &meta); err != nil {
+		return nil, fmt.Errorf("decode contract metadata: %w", err)
+	}
+	return &meta, nil
+}
+
+func (f *Fetcher) read(ctx context.Context, uri string) ([]byte, error) {
+	if strings.HasPrefix(uri, "data:") {
+		return decodeDataURI(uri)
+	}
+
+	target, err := f.resolve(uri)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata uri: %w", err)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch contract metadata: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("contract metadata returned %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read contract metadata: %w", err)
+	}
+	if len(body) > maxMetadataBytes {
+		return nil, fmt.Errorf("contract metadata larger than %d bytes", maxMetadataBytes)
+	}
+	return body, nil
+}
+
+// resolve maps ipfs:// URIs onto the gateway and only lets http(s) through otherwise
+func (f *Fetcher) resolve(uri string) (string, error) {
+	if path, ok := strings.CutPrefix(uri, "ipfs://"); ok {
+		return f.ipfsGateway + strings.TrimPrefix(path, "ipfs/"), nil
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", fmt.Errorf("unsupported metadata uri %q", uri)
+	}
+	return uri, nil
+}
+
+// decodeDataURI reads data:application/json URIs, base64 encoded or not
+func decodeDataURI(uri string) ([]byte, error) {
+	header, data, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok {
+		return nil, fmt.Errorf("malformed data uri")
+	}
+	if strings.HasSuffix(header, ";base64") {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	decoded, err := url.PathUnescape(data)
+	if err != nil {
+		return nil, fmt.Errorf("malformed data uri: %w", err)
+	}
+	return []byte(decoded), nil
+}
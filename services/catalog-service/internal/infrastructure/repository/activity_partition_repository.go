@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+const (
+	activityTable            = "wallet_activity"
+	activityDefaultPartition = "wallet_activity_default"
+	activityPartitionLayout  = "200601"
+)
+
+// activityPartitionName matches the monthly partitions, wallet_activity_pYYYYMM
+var activityPartitionName = regexp.MustCompile(`^wallet_activity_p([0-9]{6})$`)
+
+type ActivityPartitionRepository struct {
+	postgresDb *postgres.Postgres
+	// coldTablespace receives archived partitions; empty keeps them in place
+	coldTablespace string
+}
+
+// NewActivityPartitionRepository creates a repository managing the wallet_activity partitions
+func NewActivityPartitionRepository(postgresDb *postgres.Postgres, coldTablespace string) domain.ActivityPartitionRepository {
+	return &ActivityPartitionRepository{postgresDb: postgresDb, coldTablespace: coldTablespace}
+}
+
+func (r *ActivityPartitionRepository) ListPartitions(ctx context.Context) ([]domain.ActivityPartition, error) {
+	query := `
+		SELECT c.relname,
+		       NOT EXISTS (
+		           SELECT 1 FROM pg_inherits i
+		           WHERE i.inhrelid = c.oid AND i.inhparent = $1::regclass
+		       ) AS archived
+		FROM pg_class c
+		WHERE c.relnamespace = current_schema()::regnamespace
+		  AND c.relkind = 'r'
+		  AND c.relname ~ '^wallet_activity_p[0-9]{6}$'
+		ORDER BY c.relname
+	`
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, activityTable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activity partitions: %w", err)
+	}
+	defer rows.Close()
+
+	var partitions []domain.ActivityPartition
+	for rows.Next() {
+		var p domain.ActivityPartition
+		if err := rows.Scan(&p.Name, &p.Archived); err != nil {
+			return nil, fmt.Errorf("failed to scan activity partition: %w", err)
+		}
+		match := activityPartitionName.FindStringSubmatch(p.Name)
+		from, err := time.Parse(activityPartitionLayout, match[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid activity partition name %s: %w", p.Name, err)
+		}
+		p.From, p.To = from, from.AddDate(0, 1, 0)
+		partitions = append(partitions, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list activity partitions: %w", err)
+	}
+	return partitions, nil
+}
+
+// CreatePartition builds the month as a standalone table, moves the month's rows out of
+// the default partition into it, then attaches it. Attaching a range the default partition
+// still holds rows for would fail.
+func (r *ActivityPartitionRepository) CreatePartition(ctx context.Context, from time.Time) error {
+	from = time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	name := pq.QuoteIdentifier(activityTable + "_p" + from.Format(activityPartitionLayout))
+	bounds := fmt.Sprintf("FROM ('%s') TO ('%s')", from.Format(time.RFC3339), to.Format(time.RFC3339))
+
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	statements := []string{
+		fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS)`, name, activityTable),
+		fmt.Sprintf(`
+			WITH moved AS (
+				DELETE FROM %s WHERE occurred_at >= '%s' AND occurred_at < '%s' RETURNING *
+			)
+			INSERT INTO %s SELECT * FROM moved`,
+			activityDefaultPartition, from.Format(time.RFC3339), to.Format(time.RFC3339), name),
+		fmt.Sprintf(`ALTER TABLE %s ATTACH PARTITION %s FOR VALUES %s`, activityTable, name, bounds),
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to create activity partition %s: %w", name, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit activity partition %s: %w", name, err)
+	}
+	return nil
+}
+
+func (r *ActivityPartitionRepository) ArchivePartition(ctx context.Context, name string) error {
+	if !activityPartitionName.MatchString(name) {
+		return domain.ErrInvalidInput
+	}
+	quoted := pq.QuoteIdentifier(name)
+
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s DETACH PARTITION %s`, activityTable, quoted)); err != nil {
+		return fmt.Errorf("failed to detach activity partition %s: %w", name, err)
+	}
+
+	// Feed indexes are only worth their space while the partition serves queries; the
+	// primary key stays so the archive keeps its ids unique
+	rows, err := tx.QueryContext(ctx, `
+		SELECT i.relname
+		FROM pg_index x
+		JOIN pg_class i ON i.oid = x.indexrelid
+		WHERE x.indrelid = $1::regclass AND NOT x.indisprimary
+	`, name)
+	if err != nil {
+		return fmt.Errorf("failed to list indexes of %s: %w", name, err)
+	}
+	var indexes []string
+	for rows.Next() {
+		var index string
+		if err := rows.Scan(&index); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan index of %s: %w", name, err)
+		}
+		indexes = append(indexes, index)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to list indexes of %s: %w", name, err)
+	}
+	for _, index := range indexes {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DROP INDEX %s`, pq.QuoteIdentifier(index))); err != nil {
+			return fmt.Errorf("failed to drop index %s: %w", index, err)
+		}
+	}
+
+	if r.coldTablespace != "" {
+		statement := fmt.Sprintf(`ALTER TABLE %s SET TABLESPACE %s`, quoted, pq.QuoteIdentifier(r.coldTablespace))
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to move %s to tablespace %s: %w", name, r.coldTablespace, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit archive of %s: %w", name, err)
+	}
+	return nil
+}
+
+func (r *ActivityPartitionRepository) DropPartition(ctx context.Context, name string) error {
+	if !activityPartitionName.MatchString(name) {
+		return domain.ErrInvalidInput
+	}
+	if _, err := r.postgresDb.GetClient().ExecContext(ctx, fmt.Sprintf(`DROP TABLE IF EXISTS %s`, pq.QuoteIdentifier(name))); err != nil {
+		return fmt.Errorf("failed to drop activity partition %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+const auctionColumns = `
+	chain_id, auction_id, auction_house, contract_address, token_id, seller, auction_type,
+	start_price::text, reserve_price::text, end_price::text, highest_bid::text, highest_bidder,
+	bid_count, status, winner, start_time, end_time, updated_at`
+
+type AuctionRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewAuctionRepository creates a new PostgreSQL auction repository
+func NewAuctionRepository(postgresDb *postgres.Postgres) domain.AuctionRepository {
+	return &AuctionRepository{postgresDb: postgresDb}
+}
+
+func (r *AuctionRepository) Create(ctx context.Context, a domain.Auction) error {
+	query := `
+		INSERT INTO auctions (
+			chain_id, auction_id, auction_house, contract_address, token_id, seller, auction_type,
+			start_price, reserve_price, end_price, start_time, end_time, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, now())
+		ON CONFLICT (chain_id, auction_id) DO NOTHING
+	`
+
+	_, err := r.postgresDb.GetClient().ExecContext(ctx, query,
+		a.ChainID, a.AuctionID, a.AuctionHouse, a.ContractAddress, a.TokenID, a.Seller, a.AuctionType,
+		a.StartPrice.String(), a.ReservePrice.String(), a.EndPrice.String(), a.StartTime, a.EndTime,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert auction: %w", err)
+	}
+	return nil
+}
+
+func (r *AuctionRepository) RecordBid(ctx context.Context, bid domain.AuctionBid) (domain.Auction, bool, error) {
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Auction{}, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	insert := `
+		INSERT INTO auction_bids (event_id, chain_id, auction_id, bidder, amount, tx_hash, placed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (event_id) DO NOTHING
+	`
+	result, err := tx.ExecContext(ctx, insert,
+		bid.EventID, bid.ChainID, bid.AuctionID, bid.Bidder, bid.Amount.String(), bid.TxHash, bid.PlacedAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return domain.Auction{}, false, domain.ErrNotFound
+		}
+		return domain.Auction{}, false, fmt.Errorf("failed to insert auction bid: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domain.Auction{}, false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		// Redelivered bid: report the current state without counting it twice
+		auction, err := scanAuction(tx.QueryRowContext(ctx,
+			`SELECT `+auctionColumns+` FROM auctions WHERE chain_id = $1 AND auction_id = $2`,
+			bid.ChainID, bid.AuctionID,
+		))
+		return auction, false, err
+	}
+
+	// Bids can arrive out of order, so only a higher amount takes the lead
+	update := `
+		UPDATE auctions SET
+			highest_bidder = CASE WHEN $3::numeric > highest_bid THEN $4 ELSE highest_bidder END,
+			highest_bid    = GREATEST(highest_bid, $3::numeric),
+			bid_count      = bid_count + 1,
+			end_time       = CASE WHEN $5::timestamptz IS NULL THEN end_time ELSE GREATEST(end_time, $5::timestamptz) END,
+			updated_at     = now()
+		WHERE chain_id = $1 AND auction_id = $2
+		RETURNING ` + auctionColumns
+
+	var endTime sql.NullTime
+	if !bid.EndTime.IsZero() {
+		endTime = sql.NullTime{Time: bid.EndTime, Valid: true}
+	}
+	auction, err := scanAuction(tx.QueryRowContext(ctx, update,
+		bid.ChainID, bid.AuctionID, bid.Amount.String(), bid.Bidder, endTime,
+	))
+	if err != nil {
+		return domain.Auction{}, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Auction{}, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return auction, true, nil
+}
+
+func (r *AuctionRepository) Close(ctx context.Context, chainID, auctionID, status, winner string) error {
+	query := `
+		UPDATE auctions SET status = $3, winner = $4, updated_at = now()
+		WHERE chain_id = $1 AND auction_id = $2 AND status = 'active'
+	`
+
+	if _, err := r.postgresDb.GetClient().ExecContext(ctx, query, chainID, auctionID, status, winner); err != nil {
+		return fmt.Errorf("failed to close auction: %w", err)
+	}
+	return nil
+}
+
+func (r *AuctionRepository) Get(ctx context.Context, chainID, auctionID string) (domain.Auction, error) {
+	query := `SELECT ` + auctionColumns + ` FROM auctions WHERE chain_id = $1 AND auction_id = $2`
+	return scanAuction(r.postgresDb.GetClient().QueryRowContext(ctx, query, chainID, auctionID))
+}
+
+func scanAuction(row *sql.Row) (domain.Auction, error) {
+	var a domain.Auction
+	var startPrice, reservePrice, endPrice, highestBid sql.NullString
+
+	err := row.Scan(
+		&a.ChainID, &a.AuctionID, &a.AuctionHouse, &a.ContractAddress, &a.TokenID, &a.Seller, &a.AuctionType,
+		&startPrice, &reservePrice, &endPrice, &highestBid, &a.HighestBidder,
+		&a.BidCount, &a.Status, &a.Winner, &a.StartTime, &a.EndTime, &a.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Auction{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Auction{}, fmt.Errorf("failed to scan auction: %w", err)
+	}
+
+	a.StartPrice = parseBigInt(startPrice)
+	a.ReservePrice = parseBigInt(reservePrice)
+	a.EndPrice = parseBigInt(endPrice)
+	a.HighestBid = parseBigInt(highestBid)
+	return a, nil
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+const collectionExportColumns = `id, kind, chain_id, contract_address, period_from, period_to, requested_by,
+	status, row_count, artifact_id, error, created_at, completed_at`
+
+type CollectionExportRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewCollectionExportRepository creates a new PostgreSQL collection export repository
+func NewCollectionExportRepository(postgresDb *postgres.Postgres) domain.CollectionExportRepository {
+	return &CollectionExportRepository{postgresDb: postgresDb}
+}
+
+func (r *CollectionExportRepository) CountRows(ctx context.Context, chainID, contract, kind string, from, to time.Time) (int, error) {
+	var count int
+	err := r.postgresDb.GetClient().QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM wallet_activity
+		WHERE chain_id = $1 AND contract_address = $2 AND kind = $3
+		  AND occurred_at >= $4 AND occurred_at < $5`,
+		chainID, contract, kind, from, to,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count export rows: %w", err)
+	}
+	return count, nil
+}
+
+func (r *CollectionExportRepository) StreamRows(ctx context.Context, chainID, contract, kind string, from, to time.Time, fn func(domain.WalletActivity) error) error {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		SELECT id, chain_id, contract_address, token_id, kind, from_address, to_address,
+		       quantity::text, price::text, currency, tx_hash, occurred_at
+		FROM wallet_activity
+		WHERE chain_id = $1 AND contract_address = $2 AND kind = $3
+		  AND occurred_at >= $4 AND occurred_at < $5
+		ORDER BY occurred_at, id`,
+		chainID, contract, kind, from, to,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to read export rows: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a domain.WalletActivity
+		var quantity, price sql.NullString
+		if err := rows.Scan(
+			&a.ID, &a.ChainID, &a.ContractAddress, &a.TokenID, &a.Kind, &a.FromAddress, &a.ToAddress,
+			&quantity, &price, &a.Currency, &a.TxHash, &a.OccurredAt,
+		); err != nil {
+			return fmt.Errorf("failed to scan export row: %w", err)
+		}
+		a.Quantity = parseBigInt(quantity)
+		if price.Valid {
+			a.Price = parseBigInt(price)
+		}
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read export rows: %w", err)
+	}
+	return nil
+}
+
+func (r *CollectionExportRepository) Create(ctx context.Context, e domain.CollectionExport) (*domain.CollectionExport, error) {
+	// Exports created running are generated by the caller right away
+	return scanCollectionExport(r.postgresDb.GetClient().QueryRowContext(ctx, `
+		INSERT INTO collection_exports (
+			id, kind, chain_id, contract_address, period_from, period_to, requested_by, status, row_count, claimed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CASE WHEN $8 = 'running' THEN now() END)
+		RETURNING `+collectionExportColumns,
+		uuid.New().String(), e.Kind, e.ChainID, e.ContractAddress, e.From, e.To, e.RequestedBy, e.Status, e.RowCount,
+	))
+}
+
+func (r *CollectionExportRepository) Get(ctx context.Context, id string) (*domain.CollectionExport, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, domain.ErrNotFound
+	}
+	return scanCollectionExport(r.postgresDb.GetClient().QueryRowContext(ctx,
+		`SELECT `+collectionExportColumns+` FROM collection_exports WHERE id = $1`, id,
+	))
+}
+
+func (r *CollectionExportRepository) ClaimPending(ctx context.Context, limit int, staleBefore time.Time) ([]domain.CollectionExport, error) {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		UPDATE collection_exports SET status = 'running', claimed_at = now()
+		WHERE id IN (
+			SELECT id FROM collection_exports
+			WHERE status = 'pending' OR (status = 'running' AND claimed_at < $2)
+			ORDER BY created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+collectionExportColumns,
+		limit, staleBefore,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim collection exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []domain.CollectionExport
+	for rows.Next() {
+		e, err := scanCollectionExport(rows)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, *e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to claim collection exports: %w", err)
+	}
+	return exports, nil
+}
+
+func (r *CollectionExportRepository) Complete(ctx context.Context, id, artifactID string, rowCount int) error {
+	_, err := r.postgresDb.GetClient().ExecContext(ctx, `
+		UPDATE collection_exports
+		SET status = 'ready', artifact_id = $2, row_count = $3, error = '', completed_at = now()
+		WHERE id = $1`,
+		id, artifactID, rowCount,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to complete collection export: %w", err)
+	}
+	return nil
+}
+
+func (r *CollectionExportRepository) Fail(ctx context.Context, id, reason string) error {
+	_, err := r.postgresDb.GetClient().ExecContext(ctx, `
+		UPDATE collection_exports SET status = 'failed', error = $2, completed_at = now() WHERE id = $1`,
+		id, reason,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to fail collection export: %w", err)
+	}
+	return nil
+}
+
+func (r *CollectionExportRepository) Requeue(ctx context.Context, id string) error {
+	_, err := r.postgresDb.GetClient().ExecContext(ctx, `
+		UPDATE collection_exports
+		SET status = 'pending', artifact_id = '', claimed_at = NULL, completed_at = NULL
+		WHERE id = $1 AND status = 'ready'`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to requeue collection export: %w", err)
+	}
+	return nil
+}
+
+func scanCollectionExport(row rowScanner) (*domain.CollectionExport, error) {
+	var e domain.CollectionExport
+	var completedAt sql.NullTime
+
+	err := row.Scan(&e.ID, &e.Kind, &e.ChainID, &e.ContractAddress, &e.From, &e.To, &e.RequestedBy,
+		&e.Status, &e.RowCount, &e.ArtifactID, &e.Error, &e.CreatedAt, &completedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan collection export: %w", err)
+	}
+	if completedAt.Valid {
+		e.CompletedAt = &completedAt.Time
+	}
+	return &e, nil
+}
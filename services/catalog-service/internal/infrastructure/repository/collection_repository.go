@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
@@ -84,9 +86,9 @@ func (r *CollectionRepository) Upsert(ctx context.Context, c domain.Collection)
 				allowlist_mint_price, public_mint_price, allowlist_stage_duration, token_uri,
 				is_verified, is_explicit, is_featured, image_url, banner_url, external_url,
 				discord_url, twitter_url, instagram_url, telegram_url, floor_price, volume_traded,
-				created_at, updated_at
+				created_at, updated_at, confirmations, required_confirmations
 			) VALUES (
-				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38
 			)
 		`
 
@@ -97,7 +99,7 @@ func (r *CollectionRepository) Upsert(ctx context.Context, c domain.Collection)
 			c.AllowlistMintPrice.String(), c.PublicMintPrice.String(), c.AllowlistStageDuration.String(), c.TokenURI,
 			c.IsVerified, c.IsExplicit, c.IsFeatured, c.ImageURL, c.BannerURL, c.ExternalURL,
 			c.DiscordURL, c.TwitterURL, c.InstagramURL, c.TelegramURL, c.FloorPrice.String(), c.VolumeTraded.String(),
-			c.CreatedAt, c.UpdatedAt,
+			c.CreatedAt, c.UpdatedAt, c.Confirmations, c.RequiredConfirmations,
 		)
 		if err != nil {
 			return false, fmt.Errorf("failed to insert collection: %w", err)
@@ -116,6 +118,17 @@ func (r *CollectionRepository) Upsert(ctx context.Context, c domain.Collection)
 			return false, fmt.Errorf("failed to insert collection binding: %w", err)
 		}
 
+		// The intent link may have arrived before the indexer saw the deployment
+		linkQuery := `
+			UPDATE collections c
+			SET intent_id = l.intent_id, created_by_user_id = l.created_by_user_id
+			FROM collection_intent_links l
+			WHERE c.id = $1 AND l.chain_id = c.chain_id AND l.tx_hash = lower(c.tx_hash)
+		`
+		if _, err = r.postgresDb.GetClient().ExecContext(ctx, linkQuery, c.ID); err != nil {
+			return false, fmt.Errorf("failed to apply intent link: %w", err)
+		}
+
 		created = true
 	} else {
 		// Update existing collection
@@ -172,7 +185,9 @@ func (r *CollectionRepository) GetByPK(ctx context.Context, chainID domain.Chain
 			c.allowlist_mint_price, c.public_mint_price, c.allowlist_stage_duration, c.token_uri,
 			c.is_verified, c.is_explicit, c.is_featured, c.image_url, c.banner_url, c.external_url,
 			c.discord_url, c.twitter_url, c.instagram_url, c.telegram_url, c.floor_price, c.volume_traded,
-			c.created_at, c.updated_at
+			c.created_at, c.updated_at, COALESCE(c.owner_org_id::text, ''),
+			COALESCE(c.intent_id, ''), COALESCE(c.created_by_user_id::text, ''),
+			c.confirmations, c.required_confirmations, COALESCE(c.category, ''), c.tags
 		FROM collections c
 		WHERE c.chain_id = $1 AND c.contract_address = $2
 	`
@@ -189,7 +204,9 @@ func (r *CollectionRepository) GetByPK(ctx context.Context, chainID domain.Chain
 		&allowlistMintPriceStr, &publicMintPriceStr, &allowlistStageDurationStr, &collection.TokenURI,
 		&collection.IsVerified, &collection.IsExplicit, &collection.IsFeatured, &collection.ImageURL, &collection.BannerURL, &collection.ExternalURL,
 		&collection.DiscordURL, &collection.TwitterURL, &collection.InstagramURL, &collection.TelegramURL, &floorPriceStr, &volumeTradedStr,
-		&collection.CreatedAt, &collection.UpdatedAt,
+		&collection.CreatedAt, &collection.UpdatedAt, &collection.OwnerOrgID,
+		&collection.IntentID, &collection.CreatedByUserID,
+		&collection.Confirmations, &collection.RequiredConfirmations, &collection.Category, pq.Array(&collection.Tags),
 	)
 
 	if err != nil {
@@ -303,3 +320,433 @@ func (r *CollectionRepository) GetByPK(ctx context.Context, chainID domain.Chain
 
 	return collection, nil
 }
+
+func (r *CollectionRepository) List(ctx context.Context, filter domain.CollectionFilter) ([]domain.Collection, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+	if filter.Sort == "" {
+		filter.Sort, filter.Descending = domain.CollectionSortCreatedAt, true
+	}
+	sortColumn, ok := collectionSortColumns[filter.Sort]
+	if !ok {
+		return nil, fmt.Errorf("unsupported collection sort %q", filter.Sort)
+	}
+
+	q := &filterQuery{}
+	if filter.ChainID != "" {
+		q.where("c.chain_id = " + q.bind(filter.ChainID))
+	}
+	if !filter.IncludeFlagged {
+		q.where("COALESCE(m.status, '') <> 'flagged'")
+	}
+	if filter.CreatedByUserID != "" {
+		q.where("c.created_by_user_id::text = " + q.bind(filter.CreatedByUserID))
+	}
+	if filter.Creator != "" {
+		q.where("lower(c.creator) = " + q.bind(strings.ToLower(filter.Creator)))
+	}
+	if !filter.IncludeUnconfirmed {
+		q.where("c.confirmations >= c.required_confirmations")
+	}
+	if filter.VerifiedOnly {
+		q.where("c.is_verified")
+	}
+	q.priceRange("c.floor_price_wei", filter.FloorPrice)
+
+	// Collection-level flags have an empty token_id
+	query := `
+		SELECT
+			c.id, c.slug, c.name, c.description, c.chain_id, c.contract_address, c.creator, c.tx_hash, c.owner,
+			c.collection_type, c.max_supply, c.total_supply, c.royalty_recipient, c.royalty_percentage, c.token_uri,
+			c.is_verified, c.is_explicit, c.is_featured, c.image_url, c.banner_url, c.external_url,
+			c.floor_price, c.volume_traded, c.created_at, c.updated_at,
+			COALESCE(c.owner_org_id::text, ''), COALESCE(m.status, ''),
+			COALESCE(c.intent_id, ''), COALESCE(c.created_by_user_id::text, ''),
+			c.confirmations, c.required_confirmations, COALESCE(c.category, ''), c.tags
+		FROM collections c
+		LEFT JOIN moderation_flags m
+			ON m.chain_id = c.chain_id AND m.contract_address = c.contract_address AND m.token_id = ''
+		` + q.whereClause() + `
+		` + orderBy(sortColumn, filter.Descending, "c.created_at DESC, c.id") + `
+		` + q.page(filter.Limit, filter.Offset)
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	defer rows.Close()
+
+	var collections []domain.Collection
+	for rows.Next() {
+		var collection domain.Collection
+		var description, txHash, owner, royaltyRecipient, tokenURI sql.NullString
+		var imageURL, bannerURL, externalURL sql.NullString
+		var maxSupplyStr, totalSupplyStr, floorPriceStr, volumeTradedStr sql.NullString
+		var moderationStatus string
+
+		if err := rows.Scan(
+			&collection.ID, &collection.Slug, &collection.Name, &description, &collection.ChainID, &collection.ContractAddress, &collection.Creator, &txHash, &owner,
+			&collection.CollectionType, &maxSupplyStr, &totalSupplyStr, &royaltyRecipient, &collection.RoyaltyPercentage, &tokenURI,
+			&collection.IsVerified, &collection.IsExplicit, &collection.IsFeatured, &imageURL, &bannerURL, &externalURL,
+			&floorPriceStr, &volumeTradedStr, &collection.CreatedAt, &collection.UpdatedAt,
+			&collection.OwnerOrgID, &moderationStatus,
+			&collection.IntentID, &collection.CreatedByUserID,
+			&collection.Confirmations, &collection.RequiredConfirmations, &collection.Category, pq.Array(&collection.Tags),
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan collection: %w", err)
+		}
+
+		collection.Description = description.String
+		collection.TxHash = txHash.String
+		collection.Owner = owner.String
+		collection.RoyaltyRecipient = royaltyRecipient.String
+		collection.TokenURI = tokenURI.String
+		collection.ImageURL = imageURL.String
+		collection.BannerURL = bannerURL.String
+		collection.ExternalURL = externalURL.String
+		collection.MaxSupply = parseBigInt(maxSupplyStr)
+		collection.TotalSupply = parseBigInt(totalSupplyStr)
+		collection.FloorPrice = parseBigInt(floorPriceStr)
+		collection.VolumeTraded = parseBigInt(volumeTradedStr)
+		collection.ModerationStatus = domain.ModerationStatus(moderationStatus)
+
+		collections = append(collections, collection)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate collections: %w", err)
+	}
+
+	return collections, nil
+}
+
+func (r *CollectionRepository) ListTokens(ctx context.Context, filter domain.TokenFilter) ([]domain.Token, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+	if filter.Sort == "" {
+		filter.Sort, filter.Descending = domain.TokenSortCreatedAt, true
+	}
+	sortColumn, ok := tokenSortColumns[filter.Sort]
+	if !ok {
+		return nil, fmt.Errorf("unsupported token sort %q", filter.Sort)
+	}
+
+	q := &filterQuery{}
+	q.where("NOT COALESCE(t.burned, false)")
+	q.where("c.confirmations >= c.required_confirmations")
+	if filter.ChainID != "" {
+		q.where("c.chain_id = " + q.bind(filter.ChainID))
+	}
+	if filter.ContractAddress != "" {
+		q.where("c.contract_address = " + q.bind(filter.ContractAddress))
+	}
+	if filter.Owner != "" {
+		q.where("lower(t.owner_address) = " + q.bind(strings.ToLower(filter.Owner)))
+	}
+	if len(filter.Owners) > 0 {
+		owners := make([]string, len(filter.Owners))
+		for i, owner := range filter.Owners {
+			owners[i] = strings.ToLower(owner)
+		}
+		q.where("lower(t.owner_address) = ANY(" + q.bind(pq.Array(owners)) + ")")
+	}
+	if !filter.IncludeFlagged {
+		q.where("COALESCE(cm.status, '') <> 'flagged' AND COALESCE(tm.status, '') <> 'flagged'")
+	}
+	q.priceRange("p.price", filter.Price)
+	for _, trait := range filter.Traits {
+		q.where(`EXISTS (
+				SELECT 1 FROM token_trait_links ttl
+				JOIN traits tr ON tr.id = ttl.trait_id
+				JOIN trait_values tv ON tv.id = ttl.trait_value_id
+				WHERE ttl.token_id = t.id
+					AND tr.normalized_name = ` + q.bind(trait.Name) + `
+					AND tv.normalized_value = ANY(` + q.bind(pq.Array(trait.Values)) + `)
+			)`)
+	}
+
+	// cm is the collection-level flag, tm the token's own
+	query := `
+		SELECT
+			c.chain_id, c.contract_address, t.token_number, COALESCE(t.token_standard, c.collection_type),
+			ts.minted::text, ts.burned::text, c.max_supply, COALESCE(t.name, ''), COALESCE(t.image_url, ''), COALESCE(t.owner_address, ''),
+			r.rarity_score_product, p.price::text, COALESCE(tm.status, '')
+		FROM tokens t
+		JOIN collections c ON c.id = t.collection_id
+		LEFT JOIN moderation_flags cm
+			ON cm.chain_id = c.chain_id AND cm.contract_address = c.contract_address AND cm.token_id = ''
+		LEFT JOIN moderation_flags tm
+			ON tm.chain_id = c.chain_id AND tm.contract_address = c.contract_address AND tm.token_id = t.token_number
+		LEFT JOIN token_rarity r ON r.token_id = t.id
+		LEFT JOIN token_supply ts
+			ON ts.chain_id = c.chain_id AND ts.contract_address = c.contract_address AND ts.token_id = t.token_number
+		LEFT JOIN LATERAL (
+			SELECT min(l.price_native) AS price FROM listings l WHERE l.token_id = t.id AND l.is_active
+		) p ON true
+		` + q.whereClause() + `
+		` + orderBy(sortColumn, filter.Descending, "t.collection_id, t.token_number") + `
+		` + q.page(filter.Limit, filter.Offset)
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []domain.Token
+	for rows.Next() {
+		var token domain.Token
+		var minted, burned, maxSupply sql.NullString
+		var rarity sql.NullFloat64
+		var price sql.NullString
+		var moderationStatus string
+
+		if err := rows.Scan(
+			&token.ChainID, &token.ContractAddress, &token.TokenID, &token.Standard,
+			&minted, &burned, &maxSupply, &token.Name, &token.ImageURL, &token.Owner,
+			&rarity, &price, &moderationStatus,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan token: %w", err)
+		}
+
+		token.SetSupply(domain.TokenSupply{Minted: parseBigInt(minted), Burned: parseBigInt(burned)}, parseBigInt(maxSupply))
+		if rarity.Valid {
+			token.RarityScore = &rarity.Float64
+		}
+		if price.Valid {
+			token.Price = parseBigInt(price)
+		}
+		token.ModerationStatus = domain.ModerationStatus(moderationStatus)
+
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// Suggest ranks prefix matches above fuzzy ones; both are served by the trigram indexes on
+// lower(name). Each kind is capped at limit, which is all the best limit overall can need.
+func (r *CollectionRepository) Suggest(ctx context.Context, query string, limit int) ([]domain.Suggestion, error) {
+	// cm is the collection-level flag, tm the token's own
+	sqlQuery := `
+		(
+			SELECT 'collection', c.chain_id, c.contract_address, '', COALESCE(c.slug, ''), c.name, '',
+				COALESCE(c.image_url, ''),
+				CASE WHEN lower(c.name) LIKE $2 THEN 1 ELSE 0 END + word_similarity($1, lower(c.name)) AS score
+			FROM collections c
+			LEFT JOIN moderation_flags cm
+				ON cm.chain_id = c.chain_id AND cm.contract_address = c.contract_address AND cm.token_id = ''
+			WHERE ($1 <% lower(c.name) OR lower(c.name) LIKE $2)
+				AND COALESCE(cm.status, '') <> 'flagged'
+				AND c.confirmations >= c.required_confirmations
+			ORDER BY score DESC, c.volume_traded_wei DESC NULLS LAST, c.id
+			LIMIT $3
+		)
+		UNION ALL
+		(
+			SELECT 'token', c.chain_id, c.contract_address, t.token_number, '', t.name, c.name,
+				COALESCE(t.image_url, ''),
+				CASE WHEN lower(t.name) LIKE $2 THEN 1 ELSE 0 END + word_similarity($1, lower(t.name)) AS score
+			FROM tokens t
+			JOIN collections c ON c.id = t.collection_id
+			LEFT JOIN moderation_flags cm
+				ON cm.chain_id = c.chain_id AND cm.contract_address = c.contract_address AND cm.token_id = ''
+			LEFT JOIN moderation_flags tm
+				ON tm.chain_id = c.chain_id AND tm.contract_address = c.contract_address AND tm.token_id = t.token_number
+			WHERE t.name IS NOT NULL AND NOT COALESCE(t.burned, false)
+				AND ($1 <% lower(t.name) OR lower(t.name) LIKE $2)
+				AND COALESCE(cm.status, '') <> 'flagged' AND COALESCE(tm.status, '') <> 'flagged'
+				AND c.confirmations >= c.required_confirmations
+			ORDER BY score DESC, t.collection_id, t.token_number
+			LIMIT $3
+		)
+		ORDER BY score DESC`
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, sqlQuery, query, likePrefix(query), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest names: %w", err)
+	}
+	defer rows.Close()
+
+	var suggestions []domain.Suggestion
+	for rows.Next() {
+		var s domain.Suggestion
+		if err := rows.Scan(
+			&s.Kind, &s.ChainID, &s.ContractAddress, &s.TokenID, &s.Slug, &s.Label, &s.CollectionName,
+			&s.ImageURL, &s.Score,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan suggestion: %w", err)
+		}
+		suggestions = append(suggestions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate suggestions: %w", err)
+	}
+
+	return suggestions, nil
+}
+
+func (r *CollectionRepository) SetClassification(ctx context.Context, chainID domain.ChainID, contract domain.Address, category string, tags []string) error {
+	query := `
+		UPDATE collections SET category = NULLIF($3, ''), tags = $4, updated_at = now()
+		WHERE chain_id = $1 AND contract_address = $2
+	`
+
+	if tags == nil {
+		tags = []string{}
+	}
+	res, err := r.postgresDb.GetClient().ExecContext(ctx, query, string(chainID), string(contract), category, pq.Array(tags))
+	if err != nil {
+		return fmt.Errorf("failed to set collection classification: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+
+	cacheKey := fmt.Sprintf("collection:%s:%s", chainID, contract)
+	r.redisDb.Delete(ctx, cacheKey)
+	return nil
+}
+
+func (r *CollectionRepository) SetOwnerOrg(ctx context.Context, chainID domain.ChainID, contract domain.Address, orgID string) error {
+	query := `
+		UPDATE collections SET owner_org_id = NULLIF($3, '')::uuid, updated_at = now()
+		WHERE chain_id = $1 AND contract_address = $2
+	`
+
+	res, err := r.postgresDb.GetClient().ExecContext(ctx, query, string(chainID), string(contract), orgID)
+	if err != nil {
+		return fmt.Errorf("failed to set collection owner org: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+
+	cacheKey := fmt.Sprintf("collection:%s:%s", chainID, contract)
+	r.redisDb.Delete(ctx, cacheKey)
+	return nil
+}
+
+func (r *CollectionRepository) SetConfirmations(ctx context.Context, chainID domain.ChainID, contract domain.Address, confirmations, required int) error {
+	query := `
+		UPDATE collections SET confirmations = $3, required_confirmations = $4, updated_at = now()
+		WHERE chain_id = $1 AND contract_address = $2
+	`
+
+	res, err := r.postgresDb.GetClient().ExecContext(ctx, query, string(chainID), string(contract), confirmations, required)
+	if err != nil {
+		return fmt.Errorf("failed to set collection confirmations: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+
+	cacheKey := fmt.Sprintf("collection:%s:%s", chainID, contract)
+	r.redisDb.Delete(ctx, cacheKey)
+	return nil
+}
+
+func (r *CollectionRepository) LinkIntent(ctx context.Context, link domain.IntentLink) error {
+	txHash := strings.ToLower(link.TxHash)
+
+	linkQuery := `
+		INSERT INTO collection_intent_links (chain_id, tx_hash, intent_id, created_by_user_id)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid)
+		ON CONFLICT (chain_id, tx_hash) DO UPDATE
+		SET intent_id = EXCLUDED.intent_id, created_by_user_id = EXCLUDED.created_by_user_id
+	`
+	if _, err := r.postgresDb.GetClient().ExecContext(ctx, linkQuery, link.ChainID, txHash, link.IntentID, link.CreatedByUserID); err != nil {
+		return fmt.Errorf("failed to store intent link: %w", err)
+	}
+
+	applyQuery := `
+		UPDATE collections
+		SET intent_id = $3, created_by_user_id = NULLIF($4, '')::uuid
+		WHERE chain_id = $1 AND lower(tx_hash) = $2
+		RETURNING contract_address
+	`
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, applyQuery, link.ChainID, txHash, link.IntentID, link.CreatedByUserID)
+	if err != nil {
+		return fmt.Errorf("failed to apply intent link: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contract string
+		if err := rows.Scan(&contract); err != nil {
+			return fmt.Errorf("failed to scan linked collection: %w", err)
+		}
+		r.redisDb.Delete(ctx, fmt.Sprintf("collection:%s:%s", link.ChainID, contract))
+	}
+	return rows.Err()
+}
+
+// parseBigInt parses a numeric text column, defaulting to zero
+func parseBigInt(value sql.NullString) *big.Int {
+	n := new(big.Int)
+	if !value.Valid || value.String == "" {
+		return n
+	}
+	if _, ok := n.SetString(value.String, 10); !ok {
+		n.SetInt64(0)
+	}
+	return n
+}
+
+func (r *CollectionRepository) SlugOwner(ctx context.Context, slug string) (string, error) {
+	query := `
+		SELECT id::text FROM collections WHERE slug = $1
+		UNION ALL
+		SELECT collection_id::text FROM collection_slug_redirects WHERE slug = $1
+		LIMIT 1
+	`
+
+	var collectionID string
+	err := r.postgresDb.GetClient().QueryRowContext(ctx, query, slug).Scan(&collectionID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to look up slug %s: %w", slug, err)
+	}
+	return collectionID, nil
+}
+
+func (r *CollectionRepository) AddSlugRedirect(ctx context.Context, slug, collectionID string) error {
+	query := `
+		INSERT INTO collection_slug_redirects (slug, collection_id)
+		VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET collection_id = EXCLUDED.collection_id, created_at = now()
+	`
+	if _, err := r.postgresDb.GetClient().ExecContext(ctx, query, slug, collectionID); err != nil {
+		return fmt.Errorf("failed to store slug redirect %s: %w", slug, err)
+	}
+	return nil
+}
+
+// ResolveSlug prefers a current slug over a redirect, so a collection renamed back to an
+// old name wins over the stale redirect it left
+func (r *CollectionRepository) ResolveSlug(ctx context.Context, slug string) (domain.ChainID, domain.Address, error) {
+	query := `
+		SELECT chain_id, contract_address FROM (
+			SELECT chain_id, contract_address, 0 AS rank FROM collections WHERE slug = $1
+			UNION ALL
+			SELECT c.chain_id, c.contract_address, 1 AS rank
+			FROM collection_slug_redirects rd
+			JOIN collections c ON c.id = rd.collection_id
+			WHERE rd.slug = $1
+		) s
+		ORDER BY rank
+		LIMIT 1
+	`
+
+	var chainID, contract string
+	if err := r.postgresDb.GetClient().QueryRowContext(ctx, query, slug).Scan(&chainID, &contract); err != nil {
+		return "", "", err
+	}
+	return domain.ChainID(chainID), domain.Address(contract), nil
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// mintStartExpr is collections.mint_start_time as unix seconds, NULL when unset. It must
+// match the expression of idx_collections_mint_start.
+const mintStartExpr = `(CASE WHEN c.mint_start_time ~ '^[0-9]{1,12}$' THEN c.mint_start_time::bigint END)`
+
+const dropSubmissionColumns = `
+	id, chain_id, contract_address, name, description, image_url, external_url, mint_price,
+	starts_at, ends_at, submitted_by, status, reviewed_by, review_note, created_at, updated_at`
+
+type DropRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewDropRepository creates a new PostgreSQL repository for the drop calendar
+func NewDropRepository(postgresDb *postgres.Postgres) domain.DropRepository {
+	return &DropRepository{postgresDb: postgresDb}
+}
+
+func (r *DropRepository) ListCollectionDrops(ctx context.Context, filter domain.DropCalendarFilter) ([]domain.UpcomingDrop, error) {
+	q := &filterQuery{}
+	q.where(mintStartExpr + " >= " + q.bind(filter.From.Unix()))
+	q.where(mintStartExpr + " < " + q.bind(filter.To.Unix()))
+	q.where("COALESCE(m.status, '') <> 'flagged'")
+	q.where("c.confirmations >= c.required_confirmations")
+	if filter.ChainID != "" {
+		q.where("c.chain_id = " + q.bind(filter.ChainID))
+	}
+
+	// Collection-level flags have an empty token_id
+	query := `
+		SELECT
+			c.id, c.chain_id, c.contract_address, c.name, COALESCE(c.description, ''),
+			COALESCE(c.image_url, ''), COALESCE(c.external_url, ''), COALESCE(c.slug, ''),
+			COALESCE(c.public_mint_price, ''), COALESCE(c.mint_price, ''), c.is_verified,
+			` + mintStartExpr + `
+		FROM collections c
+		LEFT JOIN moderation_flags m
+			ON m.chain_id = c.chain_id AND m.contract_address = c.contract_address AND m.token_id = ''
+		` + q.whereClause() + `
+		ORDER BY ` + mintStartExpr + `, c.id
+		LIMIT ` + q.bind(filter.Limit)
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collection drops: %w", err)
+	}
+	defer rows.Close()
+
+	var drops []domain.UpcomingDrop
+	for rows.Next() {
+		d := domain.UpcomingDrop{Source: domain.DropSourceCollection}
+		var publicPrice, mintPrice string
+		var startsAt int64
+		if err := rows.Scan(
+			&d.ID, &d.ChainID, &d.ContractAddress, &d.Name, &d.Description,
+			&d.ImageURL, &d.ExternalURL, &d.Slug,
+			&publicPrice, &mintPrice, &d.IsVerified,
+			&startsAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan collection drop: %w", err)
+		}
+		d.MintPrice = dropPrice(publicPrice, mintPrice)
+		d.StartsAt = time.Unix(startsAt, 0).UTC()
+		drops = append(drops, d)
+	}
+	return drops, rows.Err()
+}
+
+// dropPrice prefers the public mint price; zero and unset prices are unknown
+func dropPrice(prices ...string) string {
+	for _, p := range prices {
+		if p != "" && p != "0" {
+			return p
+		}
+	}
+	return ""
+}
+
+func (r *DropRepository) ListApprovedDrops(ctx context.Context, filter domain.DropCalendarFilter) ([]domain.UpcomingDrop, error) {
+	q := &filterQuery{}
+	q.where("status = 'approved'")
+	q.where("starts_at >= " + q.bind(filter.From))
+	q.where("starts_at < " + q.bind(filter.To))
+	if filter.ChainID != "" {
+		q.where("chain_id = " + q.bind(filter.ChainID))
+	}
+
+	query := `SELECT ` + dropSubmissionColumns + `
+		FROM drop_submissions
+		` + q.whereClause() + `
+		ORDER BY starts_at, id
+		LIMIT ` + q.bind(filter.Limit)
+
+	subs, err := r.querySubmissions(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	drops := make([]domain.UpcomingDrop, len(subs))
+	for i, s := range subs {
+		drops[i] = domain.UpcomingDrop{
+			ID:              s.ID,
+			Source:          domain.DropSourceSubmission,
+			ChainID:         s.ChainID,
+			ContractAddress: s.ContractAddress,
+			Name:            s.Name,
+			Description:     s.Description,
+			ImageURL:        s.ImageURL,
+			ExternalURL:     s.ExternalURL,
+			MintPrice:       s.MintPrice,
+			StartsAt:        s.StartsAt,
+			EndsAt:          s.EndsAt,
+		}
+	}
+	return drops, nil
+}
+
+func (r *DropRepository) CreateSubmission(ctx context.Context, sub domain.DropSubmission) (domain.DropSubmission, error) {
+	now := time.Now().UTC()
+	sub.ID = uuid.New().String()
+	sub.Status = domain.DropPending
+	sub.CreatedAt = now
+	sub.UpdatedAt = now
+
+	_, err := r.postgresDb.GetClient().ExecContext(ctx, `
+		INSERT INTO drop_submissions (`+dropSubmissionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, '', '', $13, $13)
+	`, sub.ID, sub.ChainID, sub.ContractAddress, sub.Name, sub.Description, sub.ImageURL, sub.ExternalURL, sub.MintPrice,
+		sub.StartsAt, sub.EndsAt, sub.SubmittedBy, string(sub.Status), now,
+	)
+	if err != nil {
+		return domain.DropSubmission{}, fmt.Errorf("failed to insert drop submission: %w", err)
+	}
+	return sub, nil
+}
+
+func (r *DropRepository) ListSubmissions(ctx context.Context, filter domain.DropSubmissionFilter) ([]domain.DropSubmission, error) {
+	q := &filterQuery{}
+	if filter.Status != "" {
+		q.where("status = " + q.bind(string(filter.Status)))
+	}
+	if filter.SubmittedBy != "" {
+		q.where("submitted_by = " + q.bind(filter.SubmittedBy))
+	}
+
+	query := `SELECT ` + dropSubmissionColumns + `
+		FROM drop_submissions
+		` + q.whereClause() + `
+		ORDER BY created_at DESC, id
+		` + q.page(filter.Limit, filter.Offset)
+
+	return r.querySubmissions(ctx, query, q.args...)
+}
+
+func (r *DropRepository) ReviewSubmission(ctx context.Context, id string, status domain.DropSubmissionStatus, actorID, note string) (domain.DropSubmission, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return domain.DropSubmission{}, domain.ErrNotFound
+	}
+	subs, err := r.querySubmissions(ctx, `
+		UPDATE drop_submissions
+		SET status = $2, reviewed_by = $3, review_note = $4, updated_at = now()
+		WHERE id = $1
+		RETURNING `+dropSubmissionColumns, id, string(status), actorID, note)
+	if err != nil {
+		return domain.DropSubmission{}, fmt.Errorf("failed to review drop submission: %w", err)
+	}
+	if len(subs) == 0 {
+		return domain.DropSubmission{}, domain.ErrNotFound
+	}
+	return subs[0], nil
+}
+
+func (r *DropRepository) querySubmissions(ctx context.Context, query string, args ...interface{}) ([]domain.DropSubmission, error) {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query drop submissions: %w", err)
+	}
+	defer rows.Close()
+
+	var subs []domain.DropSubmission
+	for rows.Next() {
+		var s domain.DropSubmission
+		var status string
+		var endsAt sql.NullTime
+		if err := rows.Scan(
+			&s.ID, &s.ChainID, &s.ContractAddress, &s.Name, &s.Description, &s.ImageURL, &s.ExternalURL, &s.MintPrice,
+			&s.StartsAt, &endsAt, &s.SubmittedBy, &status, &s.ReviewedBy, &s.ReviewNote, &s.CreatedAt, &s.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan drop submission: %w", err)
+		}
+		s.Status = domain.DropSubmissionStatus(status)
+		if endsAt.Valid {
+			s.EndsAt = &endsAt.Time
+		}
+		subs = append(subs, s)
+	}
+	return subs, rows.Err()
+}
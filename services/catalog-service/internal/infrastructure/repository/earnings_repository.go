@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type EarningsRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewEarningsRepository creates a new PostgreSQL royalty earnings repository
+func NewEarningsRepository(postgresDb *postgres.Postgres) domain.EarningsRepository {
+	return &EarningsRepository{postgresDb: postgresDb}
+}
+
+func (r *EarningsRepository) Record(ctx context.Context, e domain.RoyaltyEarning) (bool, error) {
+	query := `
+		INSERT INTO royalty_earnings (
+			event_id, chain_id, contract_address, token_id, recipient, currency, amount, sale_price, tx_hash, occurred_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (event_id) DO NOTHING
+	`
+
+	result, err := r.postgresDb.GetClient().ExecContext(ctx, query,
+		e.EventID, e.ChainID, e.ContractAddress, e.TokenID, e.Recipient, e.Currency,
+		e.Amount.String(), e.SalePrice.String(), e.TxHash, e.OccurredAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert royalty earning: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *EarningsRepository) TotalsByRecipients(ctx context.Context, recipients []string, since time.Time) ([]domain.EarningsTotal, error) {
+	query := `
+		SELECT e.chain_id, e.contract_address, COALESCE(MAX(c.name), ''), e.currency,
+		       SUM(e.amount)::text, COUNT(*)
+		FROM royalty_earnings e
+		LEFT JOIN collections c
+		  ON c.chain_id = e.chain_id AND LOWER(c.contract_address) = e.contract_address
+		WHERE e.recipient = ANY($1) AND e.occurred_at >= $2
+		GROUP BY e.chain_id, e.contract_address, e.currency
+		ORDER BY SUM(e.amount) DESC
+	`
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, pq.Array(recipients), since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum royalty earnings: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []domain.EarningsTotal
+	for rows.Next() {
+		var t domain.EarningsTotal
+		var amount sql.NullString
+		if err := rows.Scan(&t.ChainID, &t.ContractAddress, &t.CollectionName, &t.Currency, &amount, &t.SaleCount); err != nil {
+			return nil, fmt.Errorf("failed to scan earnings total: %w", err)
+		}
+		t.Amount = parseBigInt(amount)
+		totals = append(totals, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate earnings totals: %w", err)
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type EventSequenceRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewEventSequenceRepository creates a new PostgreSQL repository for applied event sequences
+// and parked events
+func NewEventSequenceRepository(postgresDb *postgres.Postgres) domain.EventSequenceRepository {
+	return &EventSequenceRepository{postgresDb: postgresDb}
+}
+
+func (r *EventSequenceRepository) LastApplied(ctx context.Context, aggregateID string) (uint64, error) {
+	var last uint64
+	err := r.postgresDb.GetClient().QueryRowContext(ctx,
+		`SELECT last_sequence FROM applied_event_sequences WHERE aggregate_id = $1`, aggregateID,
+	).Scan(&last)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get applied sequence of %s: %w", aggregateID, err)
+	}
+	return last, nil
+}
+
+func (r *EventSequenceRepository) Advance(ctx context.Context, aggregateID string, sequence uint64) error {
+	_, err := r.postgresDb.GetClient().ExecContext(ctx, `
+		INSERT INTO applied_event_sequences (aggregate_id, last_sequence, updated_at)
+		VALUES ($1, $2, now())
+		ON CONFLICT (aggregate_id) DO UPDATE SET
+			last_sequence = GREATEST(applied_event_sequences.last_sequence, EXCLUDED.last_sequence),
+			updated_at = now()
+	`, aggregateID, sequence)
+	if err != nil {
+		return fmt.Errorf("failed to advance sequence of %s: %w", aggregateID, err)
+	}
+	return nil
+}
+
+func (r *EventSequenceRepository) Park(ctx context.Context, event *domain.ParkedEvent) error {
+	body, err := json.Marshal(event.Event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal parked event: %w", err)
+	}
+	_, err = r.postgresDb.GetClient().ExecContext(ctx, `
+		INSERT INTO parked_events (aggregate_id, sequence, kind, event, parked_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (aggregate_id, sequence) DO NOTHING
+	`, event.AggregateID, event.Sequence, event.Kind, body, event.ParkedAt)
+	if err != nil {
+		return fmt.Errorf("failed to park event %s: %w", event.Event.EventID, err)
+	}
+	return nil
+}
+
+func (r *EventSequenceRepository) NextParked(ctx context.Context, aggregateID string) (*domain.ParkedEvent, error) {
+	var parked domain.ParkedEvent
+	var body []byte
+	err := r.postgresDb.GetClient().QueryRowContext(ctx, `
+		SELECT aggregate_id, sequence, kind, event, parked_at
+		FROM parked_events
+		WHERE aggregate_id = $1
+		ORDER BY sequence
+		LIMIT 1
+	`, aggregateID).Scan(&parked.AggregateID, &parked.Sequence, &parked.Kind, &body, &parked.ParkedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parked events of %s: %w", aggregateID, err)
+	}
+	if err := json.Unmarshal(body, &parked.Event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal parked event: %w", err)
+	}
+	return &parked, nil
+}
+
+func (r *EventSequenceRepository) Unpark(ctx context.Context, aggregateID string, sequence uint64) error {
+	_, err := r.postgresDb.GetClient().ExecContext(ctx,
+		`DELETE FROM parked_events WHERE aggregate_id = $1 AND sequence = $2`, aggregateID, sequence)
+	if err != nil {
+		return fmt.Errorf("failed to unpark event: %w", err)
+	}
+	return nil
+}
+
+func (r *EventSequenceRepository) StaleAggregates(ctx context.Context, cutoff time.Time, limit int) ([]string, error) {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		SELECT aggregate_id
+		FROM parked_events
+		GROUP BY aggregate_id
+		HAVING min(parked_at) < $1
+		ORDER BY min(parked_at)
+		LIMIT $2
+	`, cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stale parked events: %w", err)
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var aggregateID string
+		if err := rows.Scan(&aggregateID); err != nil {
+			return nil, fmt.Errorf("failed to scan parked aggregate: %w", err)
+		}
+		out = append(out, aggregateID)
+	}
+	return out, rows.Err()
+}
+
+func (r *EventSequenceRepository) CountParked(ctx context.Context) (int, error) {
+	var n int
+	if err := r.postgresDb.GetClient().QueryRowContext(ctx, `SELECT count(*) FROM parked_events`).Scan(&n); err != nil {
+		return 0, fmt.Errorf("failed to count parked events: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+const holderSnapshotColumns = `id, chain_id, contract_address, block_number, requested_by, holder_count,
+	total_quantity::text, csv_artifact_id, json_artifact_id, created_at`
+
+// zeroHolder receives burns; it never counts as a holder
+const zeroHolder = "0x0000000000000000000000000000000000000000"
+
+type HolderSnapshotRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewHolderSnapshotRepository creates a new PostgreSQL ownership index and holder snapshot repository
+func NewHolderSnapshotRepository(postgresDb *postgres.Postgres) domain.HolderSnapshotRepository {
+	return &HolderSnapshotRepository{postgresDb: postgresDb}
+}
+
+func (r *HolderSnapshotRepository) RecordTransfers(ctx context.Context, transfers []domain.OwnershipTransfer) error {
+	query := `
+		INSERT INTO ownership_transfers (
+			chain_id, contract, token_id, from_addr, to_addr, tx_hash, log_index, at, block_number, quantity
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::numeric)
+		ON CONFLICT (chain_id, contract, token_id, tx_hash, log_index) DO NOTHING
+	`
+
+	for _, t := range transfers {
+		if _, err := r.postgresDb.GetClient().ExecContext(ctx, query,
+			t.ChainID, t.Contract, t.TokenID, t.From, t.To, t.TxHash, t.LogIndex, t.At,
+			int64(t.BlockNumber), t.Quantity.String(),
+		); err != nil {
+			return fmt.Errorf("failed to insert ownership transfer: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *HolderSnapshotRepository) HeldBalance(ctx context.Context, chainID, contract string, owners []string) (*big.Int, error) {
+	if len(owners) == 0 {
+		return new(big.Int), nil
+	}
+	var balance string
+	err := r.postgresDb.GetClient().QueryRowContext(ctx, `
+		WITH moves AS (
+			SELECT to_addr AS holder, quantity
+			FROM ownership_transfers
+			WHERE chain_id = $1 AND contract = $2 AND to_addr = ANY($3)
+			UNION ALL
+			SELECT from_addr, -quantity
+			FROM ownership_transfers
+			WHERE chain_id = $1 AND contract = $2 AND from_addr = ANY($3)
+		)
+		SELECT COALESCE(SUM(quantity), 0)::text FROM moves`,
+		chainID, contract, pq.Array(owners),
+	).Scan(&balance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum held balance: %w", err)
+	}
+	held, ok := new(big.Int).SetString(balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid held balance %q", balance)
+	}
+	return held, nil
+}
+
+func (r *HolderSnapshotRepository) TokenBalances(ctx context.Context, chainID, contract string, holders, tokenIDs []string) (map[string]map[string]*big.Int, error) {
+	balances := make(map[string]map[string]*big.Int, len(holders))
+	if len(holders) == 0 || len(tokenIDs) == 0 {
+		return balances, nil
+	}
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		WITH moves AS (
+			SELECT to_addr AS holder, token_id, quantity
+			FROM ownership_transfers
+			WHERE chain_id = $1 AND contract = $2 AND token_id = ANY($4) AND to_addr = ANY($3)
+			UNION ALL
+			SELECT from_addr, token_id, -quantity
+			FROM ownership_transfers
+			WHERE chain_id = $1 AND contract = $2 AND token_id = ANY($4) AND from_addr = ANY($3)
+		)
+		SELECT holder, token_id, SUM(quantity)::text FROM moves GROUP BY holder, token_id`,
+		chainID, contract, pq.Array(holders), pq.Array(tokenIDs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum token balances: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var holder, tokenID, balance string
+		if err := rows.Scan(&holder, &tokenID, &balance); err != nil {
+			return nil, fmt.Errorf("failed to scan token balance: %w", err)
+		}
+		held, ok := new(big.Int).SetString(balance, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid token balance %q", balance)
+		}
+		if balances[holder] == nil {
+			balances[holder] = make(map[string]*big.Int)
+		}
+		balances[holder][tokenID] = held
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read token balances: %w", err)
+	}
+	return balances, nil
+}
+
+func (r *HolderSnapshotRepository) LatestBlock(ctx context.Context, chainID, contract string) (uint64, bool, error) {
+	var block sql.NullInt64
+	err := r.postgresDb.GetClient().QueryRowContext(ctx,
+		`SELECT MAX(block_number) FROM ownership_transfers WHERE chain_id = $1 AND contract = $2`,
+		chainID, contract,
+	).Scan(&block)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read latest indexed block: %w", err)
+	}
+	if !block.Valid {
+		return 0, false, nil
+	}
+	return uint64(block.Int64), true, nil
+}
+
+// CreateSnapshot folds every transfer up to the snapshot block into per-token balances and
+// sums them per holder, in one transaction with the snapshot row
+func (r *HolderSnapshotRepository) CreateSnapshot(ctx context.Context, s domain.HolderSnapshot) (*domain.HolderSnapshot, error) {
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	id := uuid.New().String()
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO holder_snapshots (id, chain_id, contract_address, block_number, requested_by)
+		VALUES ($1, $2, $3, $4, $5)`,
+		id, s.ChainID, s.ContractAddress, int64(s.BlockNumber), s.RequestedBy,
+	); err != nil {
+		return nil, fmt.Errorf("failed to insert holder snapshot: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		WITH moves AS (
+			SELECT to_addr AS holder, token_id, quantity
+			FROM ownership_transfers
+			WHERE chain_id = $2 AND contract = $3 AND block_number <= $4
+			UNION ALL
+			SELECT from_addr, token_id, -quantity
+			FROM ownership_transfers
+			WHERE chain_id = $2 AND contract = $3 AND block_number <= $4
+		), held AS (
+			SELECT holder, token_id, SUM(quantity) AS balance
+			FROM moves
+			WHERE holder <> $5
+			GROUP BY holder, token_id
+			HAVING SUM(quantity) > 0
+		)
+		INSERT INTO holder_snapshot_entries (snapshot_id, holder, balance, token_count)
+		SELECT $1, holder, SUM(balance), COUNT(*)
+		FROM held
+		GROUP BY holder`,
+		id, s.ChainID, s.ContractAddress, int64(s.BlockNumber), zeroHolder,
+	); err != nil {
+		return nil, fmt.Errorf("failed to materialize holders: %w", err)
+	}
+
+	snapshot, err := scanHolderSnapshot(tx.QueryRowContext(ctx, `
+		UPDATE holder_snapshots SET
+			holder_count   = totals.holders,
+			total_quantity = totals.quantity
+		FROM (
+			SELECT COUNT(*) AS holders, COALESCE(SUM(balance), 0) AS quantity
+			FROM holder_snapshot_entries
+			WHERE snapshot_id = $1
+		) AS totals
+		WHERE id = $1
+		RETURNING `+holderSnapshotColumns,
+		id,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return snapshot, nil
+}
+
+func (r *HolderSnapshotRepository) SetExports(ctx context.Context, id, csvArtifactID, jsonArtifactID string) error {
+	_, err := r.postgresDb.GetClient().ExecContext(ctx,
+		`UPDATE holder_snapshots SET csv_artifact_id = $2, json_artifact_id = $3 WHERE id = $1`,
+		id, csvArtifactID, jsonArtifactID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update snapshot exports: %w", err)
+	}
+	return nil
+}
+
+func (r *HolderSnapshotRepository) Get(ctx context.Context, id string) (*domain.HolderSnapshot, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, domain.ErrNotFound
+	}
+	return scanHolderSnapshot(r.postgresDb.GetClient().QueryRowContext(ctx,
+		`SELECT `+holderSnapshotColumns+` FROM holder_snapshots WHERE id = $1`, id,
+	))
+}
+
+func (r *HolderSnapshotRepository) ListBalances(ctx context.Context, id string) ([]domain.HolderBalance, error) {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		SELECT holder, balance::text, token_count
+		FROM holder_snapshot_entries
+		WHERE snapshot_id = $1
+		ORDER BY balance DESC, holder`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshot holders: %w", err)
+	}
+	defer rows.Close()
+
+	balances := []domain.HolderBalance{}
+	for rows.Next() {
+		var b domain.HolderBalance
+		var balance sql.NullString
+		if err := rows.Scan(&b.Holder, &balance, &b.TokenCount); err != nil {
+			return nil, fmt.Errorf("failed to scan snapshot holder: %w", err)
+		}
+		b.Balance = parseBigInt(balance)
+		balances = append(balances, b)
+	}
+	return balances, rows.Err()
+}
+
+func scanHolderSnapshot(row *sql.Row) (*domain.HolderSnapshot, error) {
+	var s domain.HolderSnapshot
+	var block int64
+	var total sql.NullString
+
+	err := row.Scan(&s.ID, &s.ChainID, &s.ContractAddress, &block, &s.RequestedBy, &s.HolderCount,
+		&total, &s.CSVArtifactID, &s.JSONArtifactID, &s.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan holder snapshot: %w", err)
+	}
+
+	s.BlockNumber = uint64(block)
+	s.TotalQuantity = parseBigInt(total)
+	return &s, nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type LocalizedContentRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewLocalizedContentRepository creates a new PostgreSQL repository for localized collection content
+func NewLocalizedContentRepository(postgresDb *postgres.Postgres) domain.LocalizedContentRepository {
+	return &LocalizedContentRepository{postgresDb: postgresDb}
+}
+
+func (r *LocalizedContentRepository) List(ctx context.Context, chainID domain.ChainID, contract domain.Address) ([]domain.LocalizedContent, error) {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		SELECT locale, description, tagline, updated_by_user_id, updated_at
+		FROM collection_localized_content
+		WHERE chain_id = $1 AND contract_address = $2
+		ORDER BY locale
+	`, string(chainID), string(contract))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list localized content: %w", err)
+	}
+	defer rows.Close()
+
+	var out []domain.LocalizedContent
+	for rows.Next() {
+		var c domain.LocalizedContent
+		var updatedBy sql.NullString
+		if err := rows.Scan(&c.Locale, &c.Description, &c.Tagline, &updatedBy, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan localized content: %w", err)
+		}
+		c.UpdatedByUserID = updatedBy.String
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (r *LocalizedContentRepository) Put(ctx context.Context, chainID domain.ChainID, contract domain.Address, content domain.LocalizedContent) error {
+	_, err := r.postgresDb.GetClient().ExecContext(ctx, `
+		INSERT INTO collection_localized_content (
+			chain_id, contract_address, locale, description, tagline, updated_by_user_id, updated_at
+		) VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, $7)
+		ON CONFLICT (chain_id, contract_address, locale) DO UPDATE SET
+			description = EXCLUDED.description,
+			tagline = EXCLUDED.tagline,
+			updated_by_user_id = EXCLUDED.updated_by_user_id,
+			updated_at = EXCLUDED.updated_at
+	`, string(chainID), string(contract), content.Locale, content.Description, content.Tagline, content.UpdatedByUserID, content.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store localized content: %w", err)
+	}
+	return nil
+}
+
+func (r *LocalizedContentRepository) Delete(ctx context.Context, chainID domain.ChainID, contract domain.Address, locale string) error {
+	_, err := r.postgresDb.GetClient().ExecContext(ctx, `
+		DELETE FROM collection_localized_content
+		WHERE chain_id = $1 AND contract_address = $2 AND locale = $3
+	`, string(chainID), string(contract), locale)
+	if err != nil {
+		return fmt.Errorf("failed to delete localized content: %w", err)
+	}
+	return nil
+}
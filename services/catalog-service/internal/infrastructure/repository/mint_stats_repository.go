@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type MintStatsRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewMintStatsRepository creates a new PostgreSQL per-minute mint stats repository
+func NewMintStatsRepository(postgresDb *postgres.Postgres) domain.MintStatsRepository {
+	return &MintStatsRepository{postgresDb: postgresDb}
+}
+
+func (r *MintStatsRepository) RecordMint(ctx context.Context, m domain.MintRecord) (domain.MintStatsBucket, bool, error) {
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return domain.MintStatsBucket{}, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(ctx,
+		`INSERT INTO mint_stats_events (event_id) VALUES ($1) ON CONFLICT (event_id) DO NOTHING`,
+		m.EventID,
+	)
+	if err != nil {
+		return domain.MintStatsBucket{}, false, fmt.Errorf("failed to record mint event: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domain.MintStatsBucket{}, false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.MintStatsBucket{}, false, nil
+	}
+
+	minute := m.MintedAt.UTC().Truncate(time.Minute)
+	revenue := m.Revenue
+	if revenue == nil {
+		revenue = new(big.Int)
+	}
+
+	bucket := domain.MintStatsBucket{Minute: minute}
+	var mints, revenueTotal sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO mint_stats (chain_id, contract_address, minute, mints, revenue)
+		VALUES ($1, $2, $3, $4::numeric, $5::numeric)
+		ON CONFLICT (chain_id, contract_address, minute) DO UPDATE SET
+			mints   = mint_stats.mints + EXCLUDED.mints,
+			revenue = mint_stats.revenue + EXCLUDED.revenue
+		RETURNING mints::text, revenue::text`,
+		m.ChainID, m.ContractAddress, minute, m.Quantity.String(), revenue.String(),
+	).Scan(&mints, &revenueTotal)
+	if err != nil {
+		return domain.MintStatsBucket{}, false, fmt.Errorf("failed to upsert mint stats: %w", err)
+	}
+	bucket.Mints = parseBigInt(mints)
+	bucket.Revenue = parseBigInt(revenueTotal)
+
+	if m.Minter != "" {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO mint_stats_minters (chain_id, contract_address, minute, minter)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING`,
+			m.ChainID, m.ContractAddress, minute, m.Minter,
+		)
+		if err != nil {
+			return domain.MintStatsBucket{}, false, fmt.Errorf("failed to record minter: %w", err)
+		}
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT count(*) FROM mint_stats_minters
+		WHERE chain_id = $1 AND contract_address = $2 AND minute = $3`,
+		m.ChainID, m.ContractAddress, minute,
+	).Scan(&bucket.UniqueMinters)
+	if err != nil {
+		return domain.MintStatsBucket{}, false, fmt.Errorf("failed to count minters: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.MintStatsBucket{}, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return bucket, true, nil
+}
+
+func (r *MintStatsRepository) GetMintStats(ctx context.Context, chainID, contract string, since time.Time) (domain.MintStats, error) {
+	stats := domain.MintStats{
+		ChainID:         chainID,
+		ContractAddress: contract,
+		Since:           since,
+		Mints:           new(big.Int),
+		Revenue:         new(big.Int),
+		Buckets:         []domain.MintStatsBucket{},
+	}
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		SELECT s.minute, s.mints::text, s.revenue::text,
+			(SELECT count(*) FROM mint_stats_minters m
+			 WHERE m.chain_id = s.chain_id AND m.contract_address = s.contract_address AND m.minute = s.minute)
+		FROM mint_stats s
+		WHERE s.chain_id = $1 AND s.contract_address = $2 AND s.minute >= $3
+		ORDER BY s.minute`,
+		chainID, contract, since,
+	)
+	if err != nil {
+		return domain.MintStats{}, fmt.Errorf("failed to query mint stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var b domain.MintStatsBucket
+		var mints, revenue sql.NullString
+		if err := rows.Scan(&b.Minute, &mints, &revenue, &b.UniqueMinters); err != nil {
+			return domain.MintStats{}, fmt.Errorf("failed to scan mint stats: %w", err)
+		}
+		b.Mints = parseBigInt(mints)
+		b.Revenue = parseBigInt(revenue)
+		stats.Mints.Add(stats.Mints, b.Mints)
+		stats.Revenue.Add(stats.Revenue, b.Revenue)
+		stats.Buckets = append(stats.Buckets, b)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.MintStats{}, fmt.Errorf("failed to iterate mint stats: %w", err)
+	}
+
+	err = r.postgresDb.GetClient().QueryRowContext(ctx, `
+		SELECT count(DISTINCT minter) FROM mint_stats_minters
+		WHERE chain_id = $1 AND contract_address = $2 AND minute >= $3`,
+		chainID, contract, since,
+	).Scan(&stats.UniqueMinters)
+	if err != nil {
+		return domain.MintStats{}, fmt.Errorf("failed to count minters: %w", err)
+	}
+	return stats, nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+type ModerationRepository struct {
+	postgresDb *postgres.Postgres
+	redisDb    *redis.Redis
+}
+
+// NewModerationRepository creates a new PostgreSQL moderation flag repository
+func NewModerationRepository(postgresDb *postgres.Postgres, redisDb *redis.Redis) domain.ModerationRepository {
+	return &ModerationRepository{
+		postgresDb: postgresDb,
+		redisDb:    redisDb,
+	}
+}
+
+func (r *ModerationRepository) Upsert(ctx context.Context, f domain.ModerationFlag) (domain.ModerationFlag, error) {
+	now := time.Now()
+	if f.ID == "" {
+		f.ID = uuid.New().String()
+	}
+
+	query := `
+		INSERT INTO moderation_flags (
+			id, chain_id, contract_address, token_id, status, reason, note, source, actor_id, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+		ON CONFLICT (chain_id, contract_address, token_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			reason = EXCLUDED.reason,
+			note = EXCLUDED.note,
+			source = EXCLUDED.source,
+			actor_id = EXCLUDED.actor_id,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.postgresDb.GetClient().QueryRowContext(ctx, query,
+		f.ID, f.ChainID, f.ContractAddress, f.TokenID, string(f.Status), f.Reason, f.Note, f.Source, f.ActorID, now,
+	).Scan(&f.ID, &f.CreatedAt, &f.UpdatedAt)
+	if err != nil {
+		return domain.ModerationFlag{}, fmt.Errorf("failed to upsert moderation flag: %w", err)
+	}
+
+	// Invalidate the cached collection so the overlay is re-read
+	cacheKey := fmt.Sprintf("collection:%s:%s", f.ChainID, f.ContractAddress)
+	r.redisDb.Delete(ctx, cacheKey)
+
+	return f, nil
+}
+
+func (r *ModerationRepository) Get(ctx context.Context, chainID domain.ChainID, contract domain.Address, tokenID string) (domain.ModerationFlag, error) {
+	query := `
+		SELECT id, chain_id, contract_address, token_id, status, reason, note, source, actor_id, created_at, updated_at
+		FROM moderation_flags
+		WHERE chain_id = $1 AND contract_address = $2 AND token_id = $3
+	`
+
+	var f domain.ModerationFlag
+	var status string
+	var note, actorID sql.NullString
+	err := r.postgresDb.GetClient().QueryRowContext(ctx, query, string(chainID), string(contract), tokenID).Scan(
+		&f.ID, &f.ChainID, &f.ContractAddress, &f.TokenID, &status, &f.Reason, &note, &f.Source, &actorID, &f.CreatedAt, &f.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ModerationFlag{}, err
+	}
+
+	f.Status = domain.ModerationStatus(status)
+	f.Note = note.String
+	f.ActorID = actorID.String
+	return f, nil
+}
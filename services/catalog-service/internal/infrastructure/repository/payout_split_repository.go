@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type PayoutSplitRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewPayoutSplitRepository creates a new PostgreSQL repository for collection payout splits
+func NewPayoutSplitRepository(postgresDb *postgres.Postgres) domain.PayoutSplitRepository {
+	return &PayoutSplitRepository{postgresDb: postgresDb}
+}
+
+func (r *PayoutSplitRepository) List(ctx context.Context, chainID domain.ChainID, contract domain.Address) ([]domain.PayoutSplit, error) {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		SELECT recipient, bps
+		FROM collection_payout_splits
+		WHERE chain_id = $1 AND contract_address = $2
+		ORDER BY position
+	`, string(chainID), string(contract))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payout splits: %w", err)
+	}
+	defer rows.Close()
+
+	var out []domain.PayoutSplit
+	for rows.Next() {
+		var split domain.PayoutSplit
+		if err := rows.Scan(&split.Recipient, &split.Bps); err != nil {
+			return nil, fmt.Errorf("failed to scan payout split: %w", err)
+		}
+		out = append(out, split)
+	}
+	return out, rows.Err()
+}
+
+func (r *PayoutSplitRepository) Replace(ctx context.Context, chainID domain.ChainID, contract domain.Address, splits []domain.PayoutSplit) error {
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM collection_payout_splits
+		WHERE chain_id = $1 AND contract_address = $2
+	`, string(chainID), string(contract)); err != nil {
+		return fmt.Errorf("failed to clear payout splits: %w", err)
+	}
+	for i, split := range splits {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO collection_payout_splits (chain_id, contract_address, position, recipient, bps)
+			VALUES ($1, $2, $3, $4, $5)
+		`, string(chainID), string(contract), i, split.Recipient, split.Bps); err != nil {
+			return fmt.Errorf("failed to store payout split: %w", err)
+		}
+	}
+	return tx.Commit()
+}
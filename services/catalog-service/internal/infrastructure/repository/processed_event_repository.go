@@ -24,58 +24,106 @@ func NewProcessedEventRepository(postgresDb *postgres.Postgres, redisDb *redis.R
 	}
 }
 
-func (r *ProcessedEventRepository) MarkProcessed(ctx context.Context, eventID string) (bool, error) {
-	// Check if already processed using cache first
-	cacheKey := fmt.Sprintf("processed_event:%s", eventID)
-	exists, err := r.redisDb.Exists(ctx, cacheKey)
-	if err == nil && exists > 0 {
-		return false, nil // Already processed
+// processedEventTTL is how long a processed event stays in the Redis pre-check; older
+// duplicates fall through to Postgres, which keeps every key
+const processedEventTTL = 24 * time.Hour
+
+func processedEventCacheKey(eventID string) string {
+	return fmt.Sprintf("processed_event:%s", eventID)
+}
+
+func processedLogCacheKey(key domain.ProcessedEventKey) string {
+	return fmt.Sprintf("processed_log:%s:%s:%d:%s", key.ChainID, key.TxHash, *key.LogIndex, key.EventType)
+}
+
+func (r *ProcessedEventRepository) MarkProcessed(ctx context.Context, key domain.ProcessedEventKey) (domain.DuplicateReason, error) {
+	cacheKeys := []string{processedEventCacheKey(key.EventID)}
+	if key.HasLog() {
+		cacheKeys = append(cacheKeys, processedLogCacheKey(key))
 	}
 
-	// Try to insert into database
+	// Replays are mostly recent events, so one round trip to Redis answers them without
+	// touching Postgres. A Redis failure only costs the shortcut.
+	if cached, err := r.redisDb.GetClient().MGet(ctx, cacheKeys...).Result(); err == nil {
+		for i, v := range cached {
+			if v == nil {
+				continue
+			}
+			if i == 0 {
+				return domain.DuplicateEventID, nil
+			}
+			return domain.DuplicateLog, nil
+		}
+	}
+
+	var chainID, txHash sql.NullString
+	var logIndex sql.NullInt64
+	if key.HasLog() {
+		chainID = sql.NullString{String: key.ChainID, Valid: true}
+		txHash = sql.NullString{String: key.TxHash, Valid: true}
+		logIndex = sql.NullInt64{Int64: int64(*key.LogIndex), Valid: true}
+	}
+
+	// Conflicts on either the event id or the log index are duplicates
 	query := `
-		INSERT INTO processed_events (event_id, event_version, processed_at)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (event_id) DO NOTHING
+		INSERT INTO processed_events (event_id, event_version, event_type, chain_id, tx_hash, log_index, processed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT DO NOTHING
 	`
 
-	result, err := r.postgresDb.GetClient().ExecContext(ctx, query, eventID, 1, time.Now())
+	result, err := r.postgresDb.GetClient().ExecContext(ctx, query,
+		key.EventID, 1, key.EventType, chainID, txHash, logIndex, time.Now())
 	if err != nil {
-		return false, fmt.Errorf("failed to mark event as processed: %w", err)
+		return domain.NotDuplicate, fmt.Errorf("failed to mark event as processed: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return false, fmt.Errorf("failed to get rows affected: %w", err)
+		return domain.NotDuplicate, fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
 	if rowsAffected > 0 {
 		// Event was newly processed, cache it
-		r.redisDb.SetWithExpiration(ctx, cacheKey, "processed", 24*time.Hour)
-		return true, nil
+		for _, cacheKey := range cacheKeys {
+			r.redisDb.SetWithExpiration(ctx, cacheKey, "processed", processedEventTTL)
+		}
+		return domain.NotDuplicate, nil
 	}
 
-	// Event was already processed
-	return false, nil
+	// Only the metrics care which key matched, so a failed lookup counts as an id match
+	reason := domain.DuplicateEventID
+	var sameID bool
+	err = r.postgresDb.GetClient().QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM processed_events WHERE event_id = $1)`, key.EventID,
+	).Scan(&sameID)
+	if err == nil && !sameID {
+		reason = domain.DuplicateLog
+	}
+	// Cache the key that matched so the next replay stops at Redis
+	r.redisDb.SetWithExpiration(ctx, cacheKeys[0], "processed", processedEventTTL)
+	if reason == domain.DuplicateLog {
+		r.redisDb.SetWithExpiration(ctx, cacheKeys[1], "processed", processedEventTTL)
+	}
+	return reason, nil
 }
 
 // GetProcessedEvent retrieves a processed event by ID
 func (r *ProcessedEventRepository) GetProcessedEvent(ctx context.Context, eventID string) (*domain.ProcessedEvent, error) {
 	query := `
-		SELECT event_id, event_version, chain_id, block_hash, log_index, processed_at
+		SELECT event_id, COALESCE(event_type, ''), chain_id, tx_hash, log_index, processed_at
 		FROM processed_events
 		WHERE event_id = $1
 	`
 
 	var processedEvent domain.ProcessedEvent
-	var chainID, blockHash sql.NullString
+	var chainID, txHash sql.NullString
 	var logIndex sql.NullInt32
 
 	err := r.postgresDb.GetClient().QueryRowContext(ctx, query, eventID).Scan(
 		&processedEvent.EventID,
-		&processedEvent.EventType, // This will be empty as we don't store it
+		&processedEvent.EventType,
 		&chainID,
-		&blockHash,
+		&txHash,
 		&logIndex,
 		&processedEvent.ProcessedAt,
 	)
@@ -90,8 +138,8 @@ func (r *ProcessedEventRepository) GetProcessedEvent(ctx context.Context, eventI
 	if chainID.Valid {
 		processedEvent.ChainID = chainID.String
 	}
-	if blockHash.Valid {
-		processedEvent.TxHash = blockHash.String
+	if txHash.Valid {
+		processedEvent.TxHash = txHash.String
 	}
 
 	return &processedEvent, nil
@@ -100,8 +148,7 @@ func (r *ProcessedEventRepository) GetProcessedEvent(ctx context.Context, eventI
 // IsProcessed checks if an event has been processed
 func (r *ProcessedEventRepository) IsProcessed(ctx context.Context, eventID string) (bool, error) {
 	// Check cache first
-	cacheKey := fmt.Sprintf("processed_event:%s", eventID)
-	exists, err := r.redisDb.Exists(ctx, cacheKey)
+	exists, err := r.redisDb.Exists(ctx, processedEventCacheKey(eventID))
 	if err == nil && exists > 0 {
 		return true, nil
 	}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// Sort keys map to fixed SQL expressions; request values never reach the query text
+var collectionSortColumns = map[domain.CollectionSort]string{
+	domain.CollectionSortCreatedAt: "c.created_at",
+	domain.CollectionSortFloor:     "c.floor_price_wei",
+	domain.CollectionSortVolume:    "c.volume_traded_wei",
+}
+
+var tokenSortColumns = map[domain.TokenSort]string{
+	domain.TokenSortCreatedAt: "t.minted_at",
+	domain.TokenSortPrice:     "p.price",
+	domain.TokenSortRarity:    "r.rarity_score_product",
+}
+
+// filterQuery collects the WHERE conditions of a listing query. Values are bound as
+// placeholders, so conditions only ever hold SQL written in this package.
+type filterQuery struct {
+	conds []string
+	args  []interface{}
+}
+
+// bind adds a query argument and returns its placeholder
+func (q *filterQuery) bind(value interface{}) string {
+	q.args = append(q.args, value)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+func (q *filterQuery) where(cond string) {
+	q.conds = append(q.conds, cond)
+}
+
+// priceRange bounds a numeric column by the range ends that are set
+func (q *filterQuery) priceRange(column string, r domain.PriceRange) {
+	if r.Min != nil {
+		q.where(column + " >= " + q.bind(r.Min.String()) + "::numeric")
+	}
+	if r.Max != nil {
+		q.where(column + " <= " + q.bind(r.Max.String()) + "::numeric")
+	}
+}
+
+func (q *filterQuery) whereClause() string {
+	if len(q.conds) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(q.conds, "\n\t\t\tAND ")
+}
+
+// page binds limit and offset and returns the LIMIT clause
+func (q *filterQuery) page(limit, offset int) string {
+	return "LIMIT " + q.bind(limit) + " OFFSET " + q.bind(offset)
+}
+
+// orderBy sorts by column with rows missing a value last, then by the tiebreak columns
+// so pages are stable
+func orderBy(column string, descending bool, tiebreak string) string {
+	direction := "ASC"
+	if descending {
+		direction = "DESC"
+	}
+	return fmt.Sprintf("ORDER BY %s %s NULLS LAST, %s", column, direction, tiebreak)
+}
+
+// likePrefix returns a LIKE pattern matching values that start with s literally
+func likePrefix(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s) + "%"
+}
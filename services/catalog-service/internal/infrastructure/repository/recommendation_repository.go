@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// refreshSimilaritiesQuery scores every pair of confirmed collections that share a holder,
+// the category or a tag. Holders are the addresses with a positive balance in the
+// ownership index, so the score follows transfers without reading token rows.
+const refreshSimilaritiesQuery = `
+	WITH confirmed AS (
+		SELECT id, chain_id, contract_address, category, tags
+		FROM collections
+		WHERE confirmations >= required_confirmations
+	),
+	holders AS (
+		SELECT c.id, moves.holder
+		FROM (
+			SELECT chain_id, contract, to_addr AS holder, quantity FROM ownership_transfers
+			UNION ALL
+			SELECT chain_id, contract, from_addr, -quantity FROM ownership_transfers
+		) moves
+		JOIN confirmed c ON c.chain_id = moves.chain_id AND c.contract_address = moves.contract
+		WHERE moves.holder <> $5
+		GROUP BY c.id, moves.holder
+		HAVING SUM(moves.quantity) > 0
+	),
+	holder_counts AS (
+		SELECT id, count(*) AS holders FROM holders GROUP BY id
+	),
+	shared AS (
+		SELECT a.id AS collection_id, b.id AS related_id, count(*) AS holders
+		FROM holders a
+		JOIN holders b ON b.holder = a.holder AND b.id <> a.id
+		GROUP BY a.id, b.id
+	),
+	candidates AS (
+		SELECT collection_id, related_id FROM shared
+		UNION
+		SELECT a.id, b.id
+		FROM confirmed a
+		JOIN confirmed b ON b.id <> a.id AND (a.category = b.category OR a.tags && b.tags)
+	),
+	scored AS (
+		SELECT p.collection_id, p.related_id, COALESCE(s.holders, 0) AS shared_holders,
+			$1 * COALESCE(s.holders::float8 / NULLIF(ha.holders + hb.holders - s.holders, 0), 0)
+			+ $2 * CASE WHEN a.category = b.category THEN 1 ELSE 0 END
+			+ $3 * COALESCE(
+				cardinality(ARRAY(SELECT unnest(a.tags) INTERSECT SELECT unnest(b.tags)))::float8
+				/ NULLIF(cardinality(ARRAY(SELECT unnest(a.tags) UNION SELECT unnest(b.tags))), 0), 0) AS score
+		FROM candidates p
+		JOIN confirmed a ON a.id = p.collection_id
+		JOIN confirmed b ON b.id = p.related_id
+		LEFT JOIN shared s ON s.collection_id = p.collection_id AND s.related_id = p.related_id
+		LEFT JOIN holder_counts ha ON ha.id = p.collection_id
+		LEFT JOIN holder_counts hb ON hb.id = p.related_id
+	),
+	ranked AS (
+		SELECT *, row_number() OVER (
+			PARTITION BY collection_id ORDER BY score DESC, shared_holders DESC, related_id
+		) AS rank
+		FROM scored
+		WHERE score > 0
+	)
+	INSERT INTO collection_similarities (collection_id, related_id, score, shared_holders, refreshed_at)
+	SELECT collection_id, related_id, score, shared_holders, now()
+	FROM ranked
+	WHERE rank <= $4`
+
+type RecommendationRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewRecommendationRepository creates a new PostgreSQL related-collection repository
+func NewRecommendationRepository(postgresDb *postgres.Postgres) domain.RecommendationRepository {
+	return &RecommendationRepository{postgresDb: postgresDb}
+}
+
+// Refresh swaps in the new scores in one transaction, so readers never see a partial table
+func (r *RecommendationRepository) Refresh(ctx context.Context, weights domain.SimilarityWeights, perCollection int) (int, error) {
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_similarities`); err != nil {
+		return 0, fmt.Errorf("failed to clear collection similarities: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, refreshSimilaritiesQuery,
+		weights.Holders, weights.Category, weights.Tags, perCollection, zeroHolder)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute collection similarities: %w", err)
+	}
+	stored, _ := res.RowsAffected()
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return int(stored), nil
+}
+
+func (r *RecommendationRepository) ListRelated(ctx context.Context, collectionID string, limit int) ([]domain.RelatedCollection, error) {
+	query := `
+		SELECT c.chain_id, c.contract_address, s.score, s.shared_holders
+		FROM collection_similarities s
+		JOIN collections c ON c.id = s.related_id
+		WHERE s.collection_id = $1
+		ORDER BY s.score DESC, s.shared_holders DESC, s.related_id
+		LIMIT $2`
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, collectionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list related collections: %w", err)
+	}
+	defer rows.Close()
+
+	var related []domain.RelatedCollection
+	for rows.Next() {
+		var rc domain.RelatedCollection
+		if err := rows.Scan(&rc.ChainID, &rc.ContractAddress, &rc.Score, &rc.SharedHolders); err != nil {
+			return nil, fmt.Errorf("failed to scan related collection: %w", err)
+		}
+		related = append(related, rc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate related collections: %w", err)
+	}
+	return related, nil
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+const rentalColumns = `chain_id, contract_address, token_id, record_id, standard, renter, owner,
+	amount::text, expires_at, block_number, log_index, updated_at`
+
+type RentalRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewRentalRepository creates a new PostgreSQL ERC-4907/5006 rental repository
+func NewRentalRepository(postgresDb *postgres.Postgres) domain.RentalRepository {
+	return &RentalRepository{postgresDb: postgresDb}
+}
+
+func (r *RentalRepository) Apply(ctx context.Context, rental domain.Rental) (*domain.Rental, bool, error) {
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var previous *domain.Rental
+	current, err := scanRental(tx.QueryRowContext(ctx, `SELECT `+rentalColumns+` FROM token_rentals
+		WHERE chain_id = $1 AND contract_address = $2 AND token_id = $3 AND record_id = $4
+		FOR UPDATE`,
+		rental.ChainID, rental.ContractAddress, rental.TokenID, rental.RecordID,
+	))
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+	case err != nil:
+		return nil, false, err
+	default:
+		if current.BlockNumber > rental.BlockNumber ||
+			(current.BlockNumber == rental.BlockNumber && current.LogIndex >= rental.LogIndex) {
+			return nil, false, nil
+		}
+		previous = &current
+	}
+
+	var expiresAt sql.NullTime
+	if !rental.ExpiresAt.IsZero() {
+		expiresAt = sql.NullTime{Time: rental.ExpiresAt, Valid: true}
+	}
+	amount := "1"
+	if rental.Amount != nil {
+		amount = rental.Amount.String()
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO token_rentals (chain_id, contract_address, token_id, record_id, standard, renter, owner,
+			amount, expires_at, block_number, log_index, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8::numeric, $9, $10, $11, now())
+		ON CONFLICT (chain_id, contract_address, token_id, record_id) DO UPDATE SET
+			standard     = EXCLUDED.standard,
+			renter       = EXCLUDED.renter,
+			owner        = EXCLUDED.owner,
+			amount       = EXCLUDED.amount,
+			expires_at   = EXCLUDED.expires_at,
+			block_number = EXCLUDED.block_number,
+			log_index    = EXCLUDED.log_index,
+			updated_at   = now()`,
+		rental.ChainID, rental.ContractAddress, rental.TokenID, rental.RecordID, rental.Standard,
+		rental.Renter, rental.Owner, amount, expiresAt, rental.BlockNumber, rental.LogIndex,
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to store rental: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return previous, true, nil
+}
+
+func (r *RentalRepository) Get(ctx context.Context, chainID, contract, tokenID, recordID string) (domain.Rental, error) {
+	query := `SELECT ` + rentalColumns + ` FROM token_rentals
+		WHERE chain_id = $1 AND contract_address = $2 AND token_id = $3 AND record_id = $4`
+	return scanRental(r.postgresDb.GetClient().QueryRowContext(ctx, query, chainID, contract, tokenID, recordID))
+}
+
+func (r *RentalRepository) GetRecord(ctx context.Context, chainID, contract, recordID string) (domain.Rental, error) {
+	query := `SELECT ` + rentalColumns + ` FROM token_rentals
+		WHERE chain_id = $1 AND contract_address = $2 AND record_id = $3 AND record_id <> ''`
+	return scanRental(r.postgresDb.GetClient().QueryRowContext(ctx, query, chainID, contract, recordID))
+}
+
+func (r *RentalRepository) ListActive(ctx context.Context, tokens []domain.TokenRef, now time.Time) ([]domain.Rental, error) {
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	chains := make([]string, len(tokens))
+	contracts := make([]string, len(tokens))
+	tokenIDs := make([]string, len(tokens))
+	for i, t := range tokens {
+		chains[i], contracts[i], tokenIDs[i] = t.ChainID, t.ContractAddress, t.TokenID
+	}
+
+	query := `SELECT ` + rentalColumns + ` FROM token_rentals
+		WHERE (chain_id, contract_address, token_id) IN (
+			SELECT * FROM unnest($1::text[], $2::text[], $3::text[]))
+		AND renter <> '' AND expires_at > $4
+		ORDER BY chain_id, contract_address, token_id, expires_at, record_id`
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, pq.Array(chains), pq.Array(contracts), pq.Array(tokenIDs), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rentals: %w", err)
+	}
+	defer rows.Close()
+
+	var rentals []domain.Rental
+	for rows.Next() {
+		rental, err := scanRental(rows)
+		if err != nil {
+			return nil, err
+		}
+		rentals = append(rentals, rental)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list rentals: %w", err)
+	}
+	return rentals, nil
+}
+
+func scanRental(row rowScanner) (domain.Rental, error) {
+	var rental domain.Rental
+	var amount sql.NullString
+	var expiresAt sql.NullTime
+
+	err := row.Scan(&rental.ChainID, &rental.ContractAddress, &rental.TokenID, &rental.RecordID, &rental.Standard,
+		&rental.Renter, &rental.Owner, &amount, &expiresAt, &rental.BlockNumber, &rental.LogIndex, &rental.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Rental{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Rental{}, fmt.Errorf("failed to scan rental: %w", err)
+	}
+
+	rental.Amount = parseBigInt(amount)
+	if expiresAt.Valid {
+		rental.ExpiresAt = expiresAt.Time
+	}
+	return rental, nil
+}
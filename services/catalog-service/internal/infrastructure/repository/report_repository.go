@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type ReportRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewReportRepository creates a new PostgreSQL report repository
+func NewReportRepository(postgresDb *postgres.Postgres) domain.ReportsRepository {
+	return &ReportRepository{postgresDb: postgresDb}
+}
+
+func (r *ReportRepository) Create(ctx context.Context, report domain.Report) (domain.Report, bool, error) {
+	now := time.Now()
+	report.ID = uuid.New().String()
+	report.Status = domain.ReportOpen
+	report.CreatedAt = now
+	report.UpdatedAt = now
+
+	query := `
+		INSERT INTO reports (id, target_type, target_id, reporter_id, reason, details, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+		ON CONFLICT (target_type, target_id, reporter_id) WHERE status = 'open' DO NOTHING
+	`
+
+	result, err := r.postgresDb.GetClient().ExecContext(ctx, query,
+		report.ID, report.TargetType, report.TargetID, report.ReporterID, report.Reason, report.Details, string(report.Status), now,
+	)
+	if err != nil {
+		return domain.Report{}, false, fmt.Errorf("failed to insert report: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domain.Report{}, false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected > 0 {
+		return report, true, nil
+	}
+
+	// Duplicate: collapse into the reporter's existing open report
+	existing, err := r.getOpen(ctx, report.TargetType, report.TargetID, report.ReporterID)
+	if err != nil {
+		return domain.Report{}, false, fmt.Errorf("failed to load existing report: %w", err)
+	}
+	return existing, false, nil
+}
+
+func (r *ReportRepository) CountByReporterSince(ctx context.Context, reporterID string, since time.Time) (int, error) {
+	query := `SELECT COUNT(*) FROM reports WHERE reporter_id = $1 AND created_at >= $2`
+
+	var count int
+	if err := r.postgresDb.GetClient().QueryRowContext(ctx, query, reporterID, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count reports: %w", err)
+	}
+	return count, nil
+}
+
+func (r *ReportRepository) ListOpenQueue(ctx context.Context, limit, offset int) ([]domain.ReportQueueItem, error) {
+	query := `
+		SELECT target_type, target_id, COUNT(*), ARRAY_AGG(DISTINCT reason), MIN(created_at), MAX(created_at)
+		FROM reports
+		WHERE status = 'open'
+		GROUP BY target_type, target_id
+		ORDER BY COUNT(*) DESC, MIN(created_at) ASC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list report queue: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domain.ReportQueueItem
+	for rows.Next() {
+		var item domain.ReportQueueItem
+		if err := rows.Scan(&item.TargetType, &item.TargetID, &item.ReportCount, pq.Array(&item.Reasons), &item.FirstReportedAt, &item.LastReportedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan report queue item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate report queue: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *ReportRepository) ResolveOpen(ctx context.Context, targetType, targetID string, status domain.ReportStatus, actorID, note string) ([]domain.Report, error) {
+	query := `
+		UPDATE reports
+		SET status = $1, resolved_by = $2, resolution_note = $3, updated_at = $4
+		WHERE target_type = $5 AND target_id = $6 AND status = 'open'
+		RETURNING id, target_type, target_id, reporter_id, reason, details, status, resolved_by, resolution_note, created_at, updated_at
+	`
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, string(status), actorID, note, time.Now(), targetType, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve reports: %w", err)
+	}
+	defer rows.Close()
+
+	var reports []domain.Report
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate resolved reports: %w", err)
+	}
+
+	return reports, nil
+}
+
+func (r *ReportRepository) getOpen(ctx context.Context, targetType, targetID, reporterID string) (domain.Report, error) {
+	query := `
+		SELECT id, target_type, target_id, reporter_id, reason, details, status, resolved_by, resolution_note, created_at, updated_at
+		FROM reports
+		WHERE target_type = $1 AND target_id = $2 AND reporter_id = $3 AND status = 'open'
+	`
+
+	return scanReport(r.postgresDb.GetClient().QueryRowContext(ctx, query, targetType, targetID, reporterID))
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanReport(row rowScanner) (domain.Report, error) {
+	var report domain.Report
+	var status string
+	var details, resolvedBy, resolutionNote sql.NullString
+	if err := row.Scan(
+		&report.ID, &report.TargetType, &report.TargetID, &report.ReporterID, &report.Reason, &details,
+		&status, &resolvedBy, &resolutionNote, &report.CreatedAt, &report.UpdatedAt,
+	); err != nil {
+		return domain.Report{}, err
+	}
+
+	report.Status = domain.ReportStatus(status)
+	report.Details = details.String
+	report.ResolvedBy = resolvedBy.String
+	report.ResolutionNote = resolutionNote.String
+	return report, nil
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type ResyncRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewResyncRepository creates a new PostgreSQL repository for collection resyncs
+func NewResyncRepository(postgresDb *postgres.Postgres) domain.ResyncRepository {
+	return &ResyncRepository{postgresDb: postgresDb}
+}
+
+func (r *ResyncRepository) GetContractURI(ctx context.Context, chainID, contract string) (string, error) {
+	var uri sql.NullString
+	err := r.postgresDb.GetClient().QueryRowContext(ctx,
+		`SELECT contract_uri FROM collections WHERE chain_id = $1 AND contract_address = $2`,
+		chainID, contract,
+	).Scan(&uri)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read contract uri: %w", err)
+	}
+	return uri.String, nil
+}
+
+// SampleHoldings folds the whole ownership index of the sampled tokens, so their holders
+// are as of the last indexed transfer. Tokens nobody holds come back with no holders.
+func (r *ResyncRepository) SampleHoldings(ctx context.Context, chainID, contract string, limit int) ([]domain.TokenHoldings, error) {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, `
+		WITH sampled AS (
+			SELECT token_id
+			FROM (
+				SELECT DISTINCT token_id
+				FROM ownership_transfers
+				WHERE chain_id = $1 AND contract = $2
+			) AS indexed
+			ORDER BY random()
+			LIMIT $3
+		), moves AS (
+			SELECT t.token_id, t.to_addr AS holder, t.quantity
+			FROM ownership_transfers t JOIN sampled USING (token_id)
+			WHERE t.chain_id = $1 AND t.contract = $2
+			UNION ALL
+			SELECT t.token_id, t.from_addr, -t.quantity
+			FROM ownership_transfers t JOIN sampled USING (token_id)
+			WHERE t.chain_id = $1 AND t.contract = $2
+		), held AS (
+			SELECT token_id, holder, SUM(quantity) AS balance
+			FROM moves
+			WHERE holder <> $4
+			GROUP BY token_id, holder
+			HAVING SUM(quantity) > 0
+		)
+		SELECT s.token_id, COALESCE(h.holder, ''), COALESCE(h.balance::text, '0')
+		FROM sampled s LEFT JOIN held h USING (token_id)
+		ORDER BY s.token_id, h.holder`,
+		chainID, contract, limit, zeroHolder,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample holdings: %w", err)
+	}
+	defer rows.Close()
+
+	var holdings []domain.TokenHoldings
+	for rows.Next() {
+		var tokenID, holder, balance string
+		if err := rows.Scan(&tokenID, &holder, &balance); err != nil {
+			return nil, fmt.Errorf("failed to scan holding: %w", err)
+		}
+		if len(holdings) == 0 || holdings[len(holdings)-1].TokenID != tokenID {
+			holdings = append(holdings, domain.TokenHoldings{TokenID: tokenID, Holders: map[string]*big.Int{}})
+		}
+		if holder == "" {
+			continue
+		}
+		amount, ok := new(big.Int).SetString(balance, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid balance %q of token %s", balance, tokenID)
+		}
+		holdings[len(holdings)-1].Holders[holder] = amount
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to sample holdings: %w", err)
+	}
+	return holdings, nil
+}
+
+func (r *ResyncRepository) ApplyRepair(ctx context.Context, repair domain.CollectionRepair) error {
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if repair.TotalSupply != nil {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE collections SET total_supply = $3, updated_at = now() WHERE chain_id = $1 AND contract_address = $2`,
+			repair.ChainID, repair.Contract, repair.TotalSupply.String(),
+		); err != nil {
+			return fmt.Errorf("failed to repair total supply: %w", err)
+		}
+	}
+	if repair.ContractURI != nil {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE collections SET contract_uri = $3, updated_at = now() WHERE chain_id = $1 AND contract_address = $2`,
+			repair.ChainID, repair.Contract, *repair.ContractURI,
+		); err != nil {
+			return fmt.Errorf("failed to repair contract uri: %w", err)
+		}
+	}
+
+	for _, t := range repair.Transfers {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO ownership_transfers (
+				chain_id, contract, token_id, from_addr, to_addr, tx_hash, log_index, at, block_number, quantity
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::numeric)
+			ON CONFLICT (chain_id, contract, token_id, tx_hash, log_index) DO NOTHING`,
+			t.ChainID, t.Contract, t.TokenID, t.From, t.To, t.TxHash, t.LogIndex, t.At,
+			int64(t.BlockNumber), t.Quantity.String(),
+		); err != nil {
+			return fmt.Errorf("failed to insert corrective transfer: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+const (
+	// Sorted set of job ids scored by fire time (unix ms)
+	schedulerQueueKey = "catalog:scheduler:due"
+	// Hash of job id -> JSON job body
+	schedulerJobsKey = "catalog:scheduler:jobs"
+)
+
+type SchedulerRepository struct {
+	redisDb *redis.Redis
+}
+
+// NewSchedulerRepository creates a Redis ZSET backed alert scheduler
+func NewSchedulerRepository(redisDb *redis.Redis) domain.SchedulerRepository {
+	return &SchedulerRepository{redisDb: redisDb}
+}
+
+func (r *SchedulerRepository) Schedule(ctx context.Context, job domain.ScheduledJob) error {
+	body, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scheduled job: %w", err)
+	}
+
+	_, err = r.redisDb.GetClient().TxPipelined(ctx, func(pipe redislib.Pipeliner) error {
+		pipe.HSet(ctx, schedulerJobsKey, job.ID, body)
+		pipe.ZAdd(ctx, schedulerQueueKey, redislib.Z{Score: float64(job.FireAt.UnixMilli()), Member: job.ID})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule job %s: %w", job.ID, err)
+	}
+	return nil
+}
+
+func (r *SchedulerRepository) Get(ctx context.Context, id string) (domain.ScheduledJob, error) {
+	body, err := r.redisDb.GetClient().HGet(ctx, schedulerJobsKey, id).Result()
+	if err == redislib.Nil {
+		return domain.ScheduledJob{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.ScheduledJob{}, fmt.Errorf("failed to load scheduled job %s: %w", id, err)
+	}
+
+	var job domain.ScheduledJob
+	if err := json.Unmarshal([]byte(body), &job); err != nil {
+		return domain.ScheduledJob{}, fmt.Errorf("failed to unmarshal scheduled job %s: %w", id, err)
+	}
+	return job, nil
+}
+
+func (r *SchedulerRepository) Cancel(ctx context.Context, id string) error {
+	_, err := r.redisDb.GetClient().TxPipelined(ctx, func(pipe redislib.Pipeliner) error {
+		pipe.ZRem(ctx, schedulerQueueKey, id)
+		pipe.HDel(ctx, schedulerJobsKey, id)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cancel job %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *SchedulerRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledJob, error) {
+	client := r.redisDb.GetClient()
+
+	ids, err := client.ZRangeByScore(ctx, schedulerQueueKey, &redislib.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due jobs: %w", err)
+	}
+
+	jobs := make([]domain.ScheduledJob, 0, len(ids))
+	for _, id := range ids {
+		// ZREM succeeds for exactly one replica, which then owns the job
+		removed, err := client.ZRem(ctx, schedulerQueueKey, id).Result()
+		if err != nil {
+			return jobs, fmt.Errorf("failed to claim job %s: %w", id, err)
+		}
+		if removed == 0 {
+			continue
+		}
+
+		job, err := r.Get(ctx, id)
+		client.HDel(ctx, schedulerJobsKey, id)
+		if err != nil {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
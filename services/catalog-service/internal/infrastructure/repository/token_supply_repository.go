@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+const tokenSupplyColumns = `chain_id, contract_address, token_id, minted::text, burned::text, updated_at`
+
+type TokenSupplyRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewTokenSupplyRepository creates a new PostgreSQL ERC-1155 supply repository
+func NewTokenSupplyRepository(postgresDb *postgres.Postgres) domain.TokenSupplyRepository {
+	return &TokenSupplyRepository{postgresDb: postgresDb}
+}
+
+func (r *TokenSupplyRepository) ApplyTransfer(ctx context.Context, eventID, chainID, contract string, deltas []domain.SupplyDelta) ([]domain.TokenSupply, bool, error) {
+	tx, err := r.postgresDb.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(ctx,
+		`INSERT INTO token_supply_events (event_id) VALUES ($1) ON CONFLICT (event_id) DO NOTHING`,
+		eventID,
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to record supply event: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return nil, false, nil
+	}
+
+	upsert := `
+		INSERT INTO token_supply (chain_id, contract_address, token_id, minted, burned, updated_at)
+		VALUES ($1, $2, $3, $4::numeric, $5::numeric, now())
+		ON CONFLICT (chain_id, contract_address, token_id) DO UPDATE SET
+			minted     = token_supply.minted + EXCLUDED.minted,
+			burned     = token_supply.burned + EXCLUDED.burned,
+			updated_at = now()
+		RETURNING ` + tokenSupplyColumns
+
+	supplies := make([]domain.TokenSupply, 0, len(deltas))
+	for _, d := range deltas {
+		supply, err := scanTokenSupply(tx.QueryRowContext(ctx, upsert,
+			chainID, contract, d.TokenID, d.Minted.String(), d.Burned.String(),
+		))
+		if err != nil {
+			return nil, false, err
+		}
+		supplies = append(supplies, supply)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return supplies, true, nil
+}
+
+func (r *TokenSupplyRepository) Get(ctx context.Context, chainID, contract, tokenID string) (domain.TokenSupply, error) {
+	query := `SELECT ` + tokenSupplyColumns + ` FROM token_supply
+		WHERE chain_id = $1 AND contract_address = $2 AND token_id = $3`
+	return scanTokenSupply(r.postgresDb.GetClient().QueryRowContext(ctx, query, chainID, contract, tokenID))
+}
+
+func scanTokenSupply(row *sql.Row) (domain.TokenSupply, error) {
+	var t domain.TokenSupply
+	var minted, burned sql.NullString
+
+	err := row.Scan(&t.ChainID, &t.ContractAddress, &t.TokenID, &minted, &burned, &t.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.TokenSupply{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.TokenSupply{}, fmt.Errorf("failed to scan token supply: %w", err)
+	}
+
+	t.Minted = parseBigInt(minted)
+	t.Burned = parseBigInt(burned)
+	return t, nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type WalletActivityRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewWalletActivityRepository creates a new PostgreSQL wallet activity repository
+func NewWalletActivityRepository(postgresDb *postgres.Postgres) domain.WalletActivityRepository {
+	return &WalletActivityRepository{postgresDb: postgresDb}
+}
+
+func (r *WalletActivityRepository) Record(ctx context.Context, activities []domain.WalletActivity) error {
+	query := `
+		INSERT INTO wallet_activity (
+			id, chain_id, contract_address, token_id, kind, from_address, to_address,
+			quantity, price, currency, tx_hash, occurred_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8::numeric, $9::numeric, $10, $11, $12)
+		ON CONFLICT (id, occurred_at) DO NOTHING
+	`
+
+	for _, a := range activities {
+		var price sql.NullString
+		if a.Price != nil {
+			price = sql.NullString{String: a.Price.String(), Valid: true}
+		}
+		if _, err := r.postgresDb.GetClient().ExecContext(ctx, query,
+			a.ID, a.ChainID, a.ContractAddress, a.TokenID, a.Kind, a.FromAddress, a.ToAddress,
+			a.Quantity.String(), price, a.Currency, a.TxHash, a.OccurredAt,
+		); err != nil {
+			return fmt.Errorf("failed to insert wallet activity: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *WalletActivityRepository) ListByAddress(ctx context.Context, address string, before *domain.ActivityCursor, limit int) ([]domain.WalletActivity, error) {
+	query := `
+		SELECT id, chain_id, contract_address, token_id, kind, from_address, to_address,
+		       quantity::text, price::text, currency, tx_hash, occurred_at
+		FROM wallet_activity
+		WHERE (from_address = $1 OR to_address = $1)
+		  AND ($2::timestamptz IS NULL OR (occurred_at, id) < ($2, $3))
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $4
+	`
+
+	var beforeTime *time.Time
+	var beforeID string
+	if before != nil {
+		beforeTime, beforeID = &before.OccurredAt, before.ID
+	}
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, address, beforeTime, beforeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallet activity: %w", err)
+	}
+	defer rows.Close()
+
+	activities := []domain.WalletActivity{}
+	for rows.Next() {
+		var a domain.WalletActivity
+		var quantity, price sql.NullString
+		if err := rows.Scan(
+			&a.ID, &a.ChainID, &a.ContractAddress, &a.TokenID, &a.Kind, &a.FromAddress, &a.ToAddress,
+			&quantity, &price, &a.Currency, &a.TxHash, &a.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan wallet activity: %w", err)
+		}
+		a.Quantity = parseBigInt(quantity)
+		if price.Valid {
+			a.Price = parseBigInt(price)
+		}
+		activities = append(activities, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list wallet activity: %w", err)
+	}
+	return activities, nil
+}
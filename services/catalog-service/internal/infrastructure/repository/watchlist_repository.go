@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+const watchlistItemColumns = `
+	w.id, w.user_id, w.target_type, w.chain_id, w.contract_address, w.token_id,
+	w.floor_at_add::text, w.floor_alert_below::text, w.alert_fired_at, w.created_at,
+	COALESCE(c.name, ''), COALESCE(c.floor_price, '0')
+`
+
+type WatchlistRepository struct {
+	postgresDb *postgres.Postgres
+}
+
+// NewWatchlistRepository creates a new PostgreSQL watchlist repository
+func NewWatchlistRepository(postgresDb *postgres.Postgres) domain.WatchlistRepository {
+	return &WatchlistRepository{postgresDb: postgresDb}
+}
+
+func (r *WatchlistRepository) AddFavorite(ctx context.Context, item domain.WatchlistItem) (domain.WatchlistItem, error) {
+	query := `
+		INSERT INTO watchlist_items (
+			id, user_id, target_type, chain_id, contract_address, token_id, floor_at_add, floor_alert_below, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now())
+		ON CONFLICT (user_id, chain_id, contract_address, token_id) DO UPDATE
+		SET floor_alert_below = EXCLUDED.floor_alert_below, alert_fired_at = NULL
+		RETURNING id, floor_at_add::text, created_at
+	`
+
+	floorAtAdd := "0"
+	if item.FloorAtAdd != nil {
+		floorAtAdd = item.FloorAtAdd.String()
+	}
+	var threshold sql.NullString
+	if item.FloorAlertBelow != nil {
+		threshold = sql.NullString{String: item.FloorAlertBelow.String(), Valid: true}
+	}
+
+	var storedFloor sql.NullString
+	err := r.postgresDb.GetClient().QueryRowContext(ctx, query,
+		uuid.New().String(), item.UserID, item.TargetType, item.ChainID, item.ContractAddress, item.TokenID, floorAtAdd, threshold,
+	).Scan(&item.ID, &storedFloor, &item.CreatedAt)
+	if err != nil {
+		return domain.WatchlistItem{}, fmt.Errorf("failed to upsert watchlist item: %w", err)
+	}
+	item.FloorAtAdd = parseBigInt(storedFloor)
+	item.AlertFiredAt = nil
+
+	return item, nil
+}
+
+func (r *WatchlistRepository) RemoveFavorite(ctx context.Context, userID, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return domain.ErrNotFound
+	}
+
+	result, err := r.postgresDb.GetClient().ExecContext(ctx,
+		`DELETE FROM watchlist_items WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete watchlist item: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *WatchlistRepository) ListFavorites(ctx context.Context, userID string) ([]domain.WatchlistItem, error) {
+	query := `SELECT ` + watchlistItemColumns + `
+		FROM watchlist_items w
+		LEFT JOIN collections c ON c.chain_id = w.chain_id AND c.contract_address = w.contract_address
+		WHERE w.user_id = $1
+		ORDER BY w.created_at DESC
+	`
+	return r.queryItems(ctx, query, userID)
+}
+
+func (r *WatchlistRepository) ListFloorAlerts(ctx context.Context, afterID string, limit int) ([]domain.WatchlistItem, error) {
+	if afterID == "" {
+		afterID = uuid.Nil.String()
+	}
+
+	query := `SELECT ` + watchlistItemColumns + `
+		FROM watchlist_items w
+		LEFT JOIN collections c ON c.chain_id = w.chain_id AND c.contract_address = w.contract_address
+		WHERE w.floor_alert_below IS NOT NULL AND w.id > $1
+		ORDER BY w.id
+		LIMIT $2
+	`
+	return r.queryItems(ctx, query, afterID, limit)
+}
+
+func (r *WatchlistRepository) SetAlertFired(ctx context.Context, id string, firedAt *time.Time) error {
+	var fired sql.NullTime
+	if firedAt != nil {
+		fired = sql.NullTime{Time: *firedAt, Valid: true}
+	}
+
+	if _, err := r.postgresDb.GetClient().ExecContext(ctx,
+		`UPDATE watchlist_items SET alert_fired_at = $2 WHERE id = $1`, id, fired); err != nil {
+		return fmt.Errorf("failed to update watchlist alert: %w", err)
+	}
+	return nil
+}
+
+func (r *WatchlistRepository) SaveSearch(ctx context.Context, s domain.SavedSearch) (domain.SavedSearch, error) {
+	if s.Filters == nil {
+		s.Filters = map[string]string{}
+	}
+	filters, err := json.Marshal(s.Filters)
+	if err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("failed to marshal search filters: %w", err)
+	}
+
+	s.ID = uuid.New().String()
+	query := `
+		INSERT INTO saved_searches (id, user_id, name, query, filters, created_at)
+		VALUES ($1, $2, $3, $4, $5, now())
+		RETURNING created_at
+	`
+	if err := r.postgresDb.GetClient().QueryRowContext(ctx, query, s.ID, s.UserID, s.Name, s.Query, filters).Scan(&s.CreatedAt); err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("failed to insert saved search: %w", err)
+	}
+	return s, nil
+}
+
+func (r *WatchlistRepository) CountSavedSearches(ctx context.Context, userID string) (int, error) {
+	var count int
+	if err := r.postgresDb.GetClient().QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM saved_searches WHERE user_id = $1`, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count saved searches: %w", err)
+	}
+	return count, nil
+}
+
+func (r *WatchlistRepository) DeleteSavedSearch(ctx context.Context, userID, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return domain.ErrNotFound
+	}
+
+	result, err := r.postgresDb.GetClient().ExecContext(ctx,
+		`DELETE FROM saved_searches WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *WatchlistRepository) ListSavedSearches(ctx context.Context, userID string) ([]domain.SavedSearch, error) {
+	query := `
+		SELECT id, user_id, name, query, filters, created_at
+		FROM saved_searches
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	defer rows.Close()
+
+	var searches []domain.SavedSearch
+	for rows.Next() {
+		var s domain.SavedSearch
+		var filters []byte
+		if err := rows.Scan(&s.ID, &s.UserID, &s.Name, &s.Query, &filters, &s.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan saved search: %w", err)
+		}
+		if err := json.Unmarshal(filters, &s.Filters); err != nil {
+			return nil, fmt.Errorf("failed to decode search filters: %w", err)
+		}
+		searches = append(searches, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate saved searches: %w", err)
+	}
+
+	return searches, nil
+}
+
+func (r *WatchlistRepository) queryItems(ctx context.Context, query string, args ...interface{}) ([]domain.WatchlistItem, error) {
+	rows, err := r.postgresDb.GetClient().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list watchlist items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domain.WatchlistItem
+	for rows.Next() {
+		var item domain.WatchlistItem
+		var floorAtAdd, threshold, currentFloor sql.NullString
+		var firedAt sql.NullTime
+		if err := rows.Scan(&item.ID, &item.UserID, &item.TargetType, &item.ChainID, &item.ContractAddress, &item.TokenID,
+			&floorAtAdd, &threshold, &firedAt, &item.CreatedAt, &item.CollectionName, &currentFloor); err != nil {
+			return nil, fmt.Errorf("failed to scan watchlist item: %w", err)
+		}
+		item.FloorAtAdd = parseBigInt(floorAtAdd)
+		item.CurrentFloor = parseBigInt(currentFloor)
+		if threshold.Valid {
+			item.FloorAlertBelow = parseBigInt(threshold)
+		}
+		if firedAt.Valid {
+			item.AlertFiredAt = &firedAt.Time
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate watchlist items: %w", err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// SetActivityPartitions enables wallet_activity partition upkeep under policy
+func (s *CatalogService) SetActivityPartitions(repo domain.ActivityPartitionRepository, policy domain.PartitionPolicy) error {
+	if policy.AheadMonths < 0 || policy.HotMonths < 1 || policy.RetentionMonths < 0 {
+		return fmt.Errorf("%w: partition months must be positive", domain.ErrInvalidInput)
+	}
+	if policy.RetentionMonths > 0 && policy.RetentionMonths < policy.HotMonths {
+		return fmt.Errorf("%w: retention cannot be shorter than the hot window", domain.ErrInvalidInput)
+	}
+	s.partitionRepo = repo
+	s.partitionPolicy = policy
+	return nil
+}
+
+// RunActivityPartitions maintains the partitions at startup and then every interval
+func (s *CatalogService) RunActivityPartitions(ctx context.Context, interval time.Duration) {
+	if s.partitionRepo == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.MaintainActivityPartitions(ctx, time.Now()); err != nil {
+			log.Printf("Activity partition maintenance failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// MaintainActivityPartitions creates the months of the hot window and the ones ahead,
+// archives attached partitions that fell out of the hot window and drops archived ones
+// past retention. Each step is independent, so one failing partition doesn't block the rest.
+func (s *CatalogService) MaintainActivityPartitions(ctx context.Context, now time.Time) error {
+	if s.partitionRepo == nil {
+		return nil
+	}
+
+	partitions, err := s.partitionRepo.ListPartitions(ctx)
+	if err != nil {
+		return err
+	}
+	existing := make(map[time.Time]bool, len(partitions))
+	for _, p := range partitions {
+		existing[p.From] = true
+	}
+
+	current := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	hotFrom := current.AddDate(0, 1-s.partitionPolicy.HotMonths, 0)
+
+	var errs []error
+	for month := hotFrom; !month.After(current.AddDate(0, s.partitionPolicy.AheadMonths, 0)); month = month.AddDate(0, 1, 0) {
+		if existing[month] {
+			continue
+		}
+		if err := s.partitionRepo.CreatePartition(ctx, month); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, p := range partitions {
+		switch {
+		case !p.Archived && !p.To.After(hotFrom):
+			if err := s.partitionRepo.ArchivePartition(ctx, p.Name); err != nil {
+				errs = append(errs, err)
+			}
+		case p.Archived && s.partitionPolicy.RetentionMonths > 0 && !p.To.After(current.AddDate(0, -s.partitionPolicy.RetentionMonths, 0)):
+			if err := s.partitionRepo.DropPartition(ctx, p.Name); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
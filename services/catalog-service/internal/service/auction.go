@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// GetAuction returns the folded state of one auction
+func (s *CatalogService) GetAuction(ctx context.Context, chainID domain.ChainID, auctionID string) (*domain.Auction, error) {
+	auctionID = strings.TrimSpace(auctionID)
+	if chainID == "" || auctionID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	auction, err := s.auctionRepo.Get(ctx, string(normalizeChainID(string(chainID))), auctionID)
+	if err != nil {
+		return nil, err
+	}
+	return &auction, nil
+}
+
+// applyAuctionEvent folds an indexed AuctionHouse event into the stored auction
+func (s *CatalogService) applyAuctionEvent(ctx context.Context, chainID, auctionID, action string, evt *domain.CollectionEvent) error {
+	switch action {
+	case "created":
+		auction, err := auctionFromEvent(chainID, auctionID, evt)
+		if err != nil {
+			return err
+		}
+		return s.auctionRepo.Create(ctx, auction)
+
+	case "bid":
+		amount, ok := bigFromData(evt.Data, "amount")
+		if !ok || amount.Sign() == 0 {
+			return fmt.Errorf("bid event %s has no valid amount", evt.EventID)
+		}
+		bidder := strings.ToLower(stringFromData(evt.Data, "bidder"))
+		endTime, _ := timeFromData(evt.Data, "end_time")
+
+		auction, recorded, err := s.auctionRepo.RecordBid(ctx, domain.AuctionBid{
+			EventID:   evt.EventID,
+			ChainID:   chainID,
+			AuctionID: auctionID,
+			Bidder:    bidder,
+			Amount:    amount,
+			EndTime:   endTime,
+			TxHash:    evt.TxHash,
+			PlacedAt:  evt.Timestamp,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record bid on auction %s: %w", auctionID, err)
+		}
+		if !recorded {
+			return nil
+		}
+		return s.publishAuctionBidPlaced(ctx, &auction, bidder, amount, evt)
+
+	case "settled":
+		return s.auctionRepo.Close(ctx, chainID, auctionID, domain.AuctionSettled, strings.ToLower(stringFromData(evt.Data, "winner")))
+
+	case "cancelled":
+		return s.auctionRepo.Close(ctx, chainID, auctionID, domain.AuctionCancelled, "")
+	}
+
+	// Extensions only move the end time, which the bid that caused them already recorded
+	return nil
+}
+
+func auctionFromEvent(chainID, auctionID string, evt *domain.CollectionEvent) (domain.Auction, error) {
+	startPrice, ok := bigFromData(evt.Data, "start_price")
+	if !ok {
+		return domain.Auction{}, fmt.Errorf("auction event %s has no valid start_price", evt.EventID)
+	}
+	endTime, ok := timeFromData(evt.Data, "end_time")
+	if !ok {
+		return domain.Auction{}, fmt.Errorf("auction event %s has no end_time", evt.EventID)
+	}
+	startTime, ok := timeFromData(evt.Data, "start_time")
+	if !ok {
+		startTime = evt.Timestamp
+	}
+
+	reservePrice, ok := bigFromData(evt.Data, "reserve_price")
+	if !ok {
+		reservePrice = new(big.Int)
+	}
+	endPrice, ok := bigFromData(evt.Data, "end_price")
+	if !ok {
+		endPrice = new(big.Int)
+	}
+
+	auctionType := stringFromData(evt.Data, "auction_type")
+	if auctionType != "dutch" {
+		auctionType = "english"
+	}
+
+	return domain.Auction{
+		ChainID:         chainID,
+		AuctionID:       auctionID,
+		AuctionHouse:    strings.ToLower(evt.Contract),
+		ContractAddress: marketEventContract(evt),
+		TokenID:         stringFromData(evt.Data, "token_id"),
+		Seller:          strings.ToLower(stringFromData(evt.Data, "seller")),
+		AuctionType:     auctionType,
+		StartPrice:      startPrice,
+		ReservePrice:    reservePrice,
+		EndPrice:        endPrice,
+		HighestBid:      new(big.Int),
+		Status:          domain.AuctionActive,
+		StartTime:       startTime,
+		EndTime:         endTime,
+	}, nil
+}
+
+// publishAuctionBidPlaced publishes the auction state after a new bid
+func (s *CatalogService) publishAuctionBidPlaced(ctx context.Context, auction *domain.Auction, bidder string, amount *big.Int, evt *domain.CollectionEvent) error {
+	domainEvent := &domain.DomainEvent{
+		Schema:      "marketplace.domain.v1",
+		Version:     "1.0",
+		EventID:     fmt.Sprintf("auction_bid_placed_%s", evt.EventID),
+		EventType:   domain.EventAuctionBidPlaced,
+		AggregateID: auction.AuctionID,
+		ChainID:     auction.ChainID,
+		Data: map[string]interface{}{
+			"auction_id":       auction.AuctionID,
+			"chain_id":         auction.ChainID,
+			"auction_house":    auction.AuctionHouse,
+			"contract_address": auction.ContractAddress,
+			"token_id":         auction.TokenID,
+			"seller":           auction.Seller,
+			"bidder":           bidder,
+			"amount":           amount.String(),
+			"highest_bid":      auction.HighestBid.String(),
+			"highest_bidder":   auction.HighestBidder,
+			"bid_count":        auction.BidCount,
+			"end_time":         auction.EndTime,
+			"tx_hash":          evt.TxHash,
+		},
+		Timestamp: time.Now(),
+	}
+
+	return s.publisher.PublishDomainEvent(ctx, domainEvent)
+}
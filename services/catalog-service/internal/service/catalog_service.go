@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"log"
 	"math/big"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,14 +19,99 @@ import (
 type CatalogService struct {
 	collectionRepo     domain.CollectionsRepository
 	processedEventRepo domain.ProcessedEventsRepository
+	moderationRepo     domain.ModerationRepository
+	reportsRepo        domain.ReportsRepository
+	earningsRepo       domain.EarningsRepository
+	schedulerRepo      domain.SchedulerRepository
+	auctionRepo        domain.AuctionRepository
+	watchlistRepo      domain.WatchlistRepository
+	tokenSupplyRepo    domain.TokenSupplyRepository
+	walletActivityRepo domain.WalletActivityRepository
 	publisher          domain.MessagePublisher
 	unitOfWork         domain.UnitOfWork
+
+	// Per-user report intake limit
+	reportLimit  int
+	reportWindow time.Duration
+
+	// How long before expiry offer/listing alerts fire, and before drops start
+	expiryLead time.Duration
+	dropLead   time.Duration
+
+	// wallet_activity partition upkeep; nil disables it
+	partitionRepo   domain.ActivityPartitionRepository
+	partitionPolicy domain.PartitionPolicy
+
+	// contractURI crawl of imported collections; nil skips it
+	metadataFetcher domain.MetadataFetcher
+
+	// systemStatus sources; nil reports nothing
+	queueInspector domain.QueueInspector
+	consumerLags   domain.ConsumerLagReader
+	statusQueues   []string
+
+	// Ownership index and holder snapshots; nil disables them
+	holderSnapshotRepo domain.HolderSnapshotRepository
+	artifactStore      domain.ArtifactStore
+	holdingsNotifier   domain.HoldingsNotifier // nil announces no holdings changes
+
+	// Sales and mints CSV exports; nil disables them, a nil notifier only skips the
+	// completion notices of background exports
+	collectionExportRepo domain.CollectionExportRepository
+	exportArtifacts      domain.ArtifactStore
+	exportNotifier       domain.ExportNotifier
+
+	// Gate tokens for holders-only content; an empty secret disables them
+	tokenGateSecret []byte
+	tokenGateTTL    time.Duration
+
+	// Collection resyncs against the chain; nil disables them
+	resyncRepo  domain.ResyncRepository
+	chainReader domain.ChainReader
+
+	// Creator-written content per locale; nil disables it
+	localizedContentRepo domain.LocalizedContentRepository
+	payoutSplitRepo      domain.PayoutSplitRepository
+
+	// Drop calendar; nil disables it
+	dropRepo domain.DropRepository
+
+	// ERC-4907/5006 rental state; nil disables it
+	rentalRepo domain.RentalRepository
+
+	// Related collections; nil disables them
+	recommendationRepo domain.RecommendationRepository
+	similarityWeights  domain.SimilarityWeights
+
+	// Delegation registry reads, cached per chain and delegate; nil disables delegations
+	delegations   domain.DelegationRegistry
+	delegationTTL time.Duration
+	delegationMu  sync.Mutex
+	delegateCache map[string]cachedDelegations
+
+	// Per-minute mint analytics; nil disables them, a nil notifier only skips live updates
+	mintStatsRepo     domain.MintStatsRepository
+	mintStatsNotifier domain.MintStatsNotifier
+
+	// Processed and duplicate-dropped event counts
+	dedup dedupCounter
+
+	// Per-aggregate event ordering; nil applies events as they arrive
+	sequencing *sequencing
 }
 
 // NewCatalogService creates a new catalog service
 func NewCatalogService(
 	collectionRepo domain.CollectionsRepository,
 	processedEventRepo domain.ProcessedEventsRepository,
+	moderationRepo domain.ModerationRepository,
+	reportsRepo domain.ReportsRepository,
+	earningsRepo domain.EarningsRepository,
+	schedulerRepo domain.SchedulerRepository,
+	auctionRepo domain.AuctionRepository,
+	watchlistRepo domain.WatchlistRepository,
+	tokenSupplyRepo domain.TokenSupplyRepository,
+	walletActivityRepo domain.WalletActivityRepository,
 	publisher domain.MessagePublisher,
 ) *CatalogService {
 	unitOfWork := repository.NewUnitOfWork(collectionRepo, processedEventRepo)
@@ -31,15 +119,27 @@ func NewCatalogService(
 	return &CatalogService{
 		collectionRepo:     collectionRepo,
 		processedEventRepo: processedEventRepo,
+		moderationRepo:     moderationRepo,
+		reportsRepo:        reportsRepo,
+		earningsRepo:       earningsRepo,
+		schedulerRepo:      schedulerRepo,
+		auctionRepo:        auctionRepo,
+		watchlistRepo:      watchlistRepo,
+		tokenSupplyRepo:    tokenSupplyRepo,
+		walletActivityRepo: walletActivityRepo,
 		publisher:          publisher,
 		unitOfWork:         unitOfWork,
+		reportLimit:        defaultReportLimit,
+		reportWindow:       defaultReportWindow,
+		expiryLead:         defaultExpiryLead,
+		dropLead:           defaultDropLead,
 	}
 }
 
 // HandleCollectionCreated handles collection creation events
 func (s *CatalogService) HandleCollectionCreated(ctx context.Context, evt *domain.CollectionEvent) error {
 	// Check if event has already been processed
-	processed, err := s.processedEventRepo.MarkProcessed(ctx, evt.EventID)
+	processed, err := s.markProcessed(ctx, evt)
 	if err != nil {
 		return fmt.Errorf("failed to check if event is processed: %w", err)
 	}
@@ -57,6 +157,10 @@ func (s *CatalogService) HandleCollectionCreated(ctx context.Context, evt *domai
 
 	// Use unit of work to ensure data consistency
 	err = s.unitOfWork.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if err := s.assignSlug(ctx, tx.CollectionsRepo(), &collection); err != nil {
+			return err
+		}
+
 		// Upsert collection
 		created, err := tx.CollectionsRepo().Upsert(ctx, collection)
 		if err != nil {
@@ -76,6 +180,114 @@ func (s *CatalogService) HandleCollectionCreated(ctx context.Context, evt *domai
 		return fmt.Errorf("failed to process collection event: %w", err)
 	}
 
+	// The event is already marked processed, so a failed alert is not worth a redelivery
+	if err := s.scheduleCollectionDrop(ctx, collection); err != nil {
+		log.Printf("Failed to schedule drop alert for %s on chain %s: %v", collection.ContractAddress, collection.ChainID, err)
+	}
+
+	return nil
+}
+
+// HandleCollectionUpdated applies ownership, royalty, base URI and payout split changes emitted by a deployed collection
+func (s *CatalogService) HandleCollectionUpdated(ctx context.Context, evt *domain.CollectionEvent) error {
+	processed, err := s.markProcessed(ctx, evt)
+	if err != nil {
+		return fmt.Errorf("failed to check if event is processed: %w", err)
+	}
+
+	if !processed {
+		// Event already processed, skip
+		return nil
+	}
+
+	contract := evt.Contract
+	if collectionAddress, ok := evt.Data["collection_address"].(string); ok && collectionAddress != "" {
+		contract = collectionAddress
+	}
+	if evt.EventType == "collection_payout_splits_updated" {
+		return s.applyPayoutSplits(ctx, evt, contract)
+	}
+
+	return s.unitOfWork.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		collection, err := tx.CollectionsRepo().GetByPK(ctx, domain.ChainID(evt.ChainID), domain.Address(contract))
+		if err != nil {
+			return fmt.Errorf("failed to load collection %s: %w", contract, err)
+		}
+
+		switch evt.EventType {
+		case "collection_ownership_transferred":
+			if newOwner, ok := evt.Data["new_owner"].(string); ok {
+				collection.Owner = newOwner
+			}
+		case "collection_royalty_updated":
+			if royaltyRecipient, ok := evt.Data["royalty_recipient"].(string); ok {
+				collection.RoyaltyRecipient = royaltyRecipient
+			}
+			if royaltyPercentageStr, ok := evt.Data["royalty_percentage"].(string); ok {
+				if royaltyPercentage, err := strconv.ParseUint(royaltyPercentageStr, 10, 16); err == nil {
+					collection.RoyaltyPercentage = uint16(royaltyPercentage)
+				}
+			}
+		case "collection_base_uri_updated":
+			if tokenURI, ok := evt.Data["token_uri"].(string); ok {
+				collection.TokenURI = tokenURI
+			}
+		default:
+			return fmt.Errorf("unsupported collection update: %s", evt.EventType)
+		}
+
+		if _, err := tx.CollectionsRepo().Upsert(ctx, collection); err != nil {
+			return fmt.Errorf("failed to update collection: %w", err)
+		}
+
+		if err := s.publishCollectionUpdatedEvent(ctx, &collection); err != nil {
+			return fmt.Errorf("failed to publish domain event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// HandleCollectionConfirmations records the depth the indexer reports for a collection's
+// deployment block. A reorged deployment reports zero and stays pending finality.
+func (s *CatalogService) HandleCollectionConfirmations(ctx context.Context, evt *domain.CollectionEvent) error {
+	contract := evt.Contract
+	if collectionAddress, ok := evt.Data["collection_address"].(string); ok && collectionAddress != "" {
+		contract = collectionAddress
+	}
+	confirmations, _ := evt.Data["confirmations"].(float64)
+	required, _ := evt.Data["required_confirmations"].(float64)
+
+	err := s.collectionRepo.SetConfirmations(ctx, domain.ChainID(evt.ChainID), domain.Address(contract), int(confirmations), int(required))
+	if errors.Is(err, sql.ErrNoRows) {
+		// Depth updates may overtake the creation event; its own count is used then
+		log.Printf("Confirmations for unknown collection %s on %s skipped", contract, evt.ChainID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record confirmations for %s: %w", contract, err)
+	}
+	return nil
+}
+
+// HandleIntentTxTracked records which orchestrator intent and user sent a collection
+// deployment tx. The link is applied whether the indexer has seen the deployment yet or not.
+func (s *CatalogService) HandleIntentTxTracked(ctx context.Context, evt *domain.CollectionEvent) error {
+	intentID, _ := evt.Data["intent_id"].(string)
+	createdBy, _ := evt.Data["created_by"].(string)
+	if intentID == "" || evt.TxHash == "" || evt.ChainID == "" {
+		return fmt.Errorf("%w: intent_id, tx_hash and chain_id are required", domain.ErrInvalidInput)
+	}
+
+	link := domain.IntentLink{
+		ChainID:         string(normalizeChainID(evt.ChainID)),
+		TxHash:          evt.TxHash,
+		IntentID:        intentID,
+		CreatedByUserID: createdBy,
+	}
+	if err := s.collectionRepo.LinkIntent(ctx, link); err != nil {
+		return fmt.Errorf("failed to link intent %s: %w", intentID, err)
+	}
 	return nil
 }
 
@@ -94,13 +306,19 @@ func (s *CatalogService) extractCollectionFromEvent(evt *domain.CollectionEvent)
 		collection.ContractAddress = collectionAddress
 	}
 
+	if confirmations, ok := evt.Data["confirmations"].(float64); ok {
+		collection.Confirmations = int(confirmations)
+	}
+	if required, ok := evt.Data["required_confirmations"].(float64); ok {
+		collection.RequiredConfirmations = int(required)
+	}
+
 	if creator, ok := evt.Data["creator"].(string); ok {
 		collection.Creator = creator
 	}
 
 	if name, ok := evt.Data["name"].(string); ok {
 		collection.Name = name
-		collection.Slug = s.generateSlug(name)
 	}
 
 	if collectionType, ok := evt.Data["collection_type"].(string); ok {
@@ -128,9 +346,23 @@ func (s *CatalogService) extractCollectionFromEvent(evt *domain.CollectionEvent)
 		collection.RoyaltyRecipient = royaltyRecipient
 	}
 
-	if royaltyPercentageStr, ok := evt.Data["royalty_percentage"].(string); ok {
-		if royaltyPercentage, err := strconv.ParseUint(royaltyPercentageStr, 10, 16); err == nil {
-			collection.RoyaltyPercentage = uint16(royaltyPercentage)
+	// Mint configuration, when the producer knows it; a future mint_start_time (unix
+	// seconds) puts the collection on the drop calendar
+	if mintPriceStr, ok := evt.Data["mint_price"].(string); ok {
+		if mintPrice, ok := new(big.Int).SetString(mintPriceStr, 10); ok {
+			collection.MintPrice = mintPrice
+		}
+	}
+
+	if publicMintPriceStr, ok := evt.Data["public_mint_price"].(string); ok {
+		if publicMintPrice, ok := new(big.Int).SetString(publicMintPriceStr, 10); ok {
+			collection.PublicMintPrice = publicMintPrice
+		}
+	}
+
+	if mintStartTimeStr, ok := evt.Data["mint_start_time"].(string); ok {
+		if mintStartTime, ok := new(big.Int).SetString(mintStartTimeStr, 10); ok {
+			collection.MintStartTime = mintStartTime
 		}
 	}
 
@@ -169,39 +401,9 @@ func (s *CatalogService) extractCollectionFromEvent(evt *domain.CollectionEvent)
 		collection.ID = uuid.New().String()
 	}
 
-	if collection.Slug == "" {
-		collection.Slug = s.generateSlug(collection.Name)
-	}
-
 	return collection, nil
 }
 
-// generateSlug generates a URL-friendly slug from a name
-func (s *CatalogService) generateSlug(name string) string {
-	// Convert to lowercase and replace spaces with hyphens
-	slug := strings.ToLower(name)
-	slug = strings.ReplaceAll(slug, " ", "-")
-
-	// Remove special characters except hyphens
-	var result strings.Builder
-	for _, char := range slug {
-		if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-' {
-			result.WriteRune(char)
-		}
-	}
-
-	// Remove multiple consecutive hyphens
-	slug = result.String()
-	for strings.Contains(slug, "--") {
-		slug = strings.ReplaceAll(slug, "--", "-")
-	}
-
-	// Remove leading and trailing hyphens
-	slug = strings.Trim(slug, "-")
-
-	return slug
-}
-
 // publishCollectionCreatedEvent publishes a collection created domain event
 func (s *CatalogService) publishCollectionCreatedEvent(ctx context.Context, collection *domain.Collection) error {
 	domainEvent := &domain.DomainEvent{
@@ -240,24 +442,27 @@ func (s *CatalogService) publishCollectionUpdatedEvent(ctx context.Context, coll
 		AggregateID: collection.ID,
 		ChainID:     collection.ChainID,
 		Data: map[string]interface{}{
-			"id":            collection.ID,
-			"slug":          collection.Slug,
-			"name":          collection.Name,
-			"description":   collection.Description,
-			"owner":         collection.Owner,
-			"is_verified":   collection.IsVerified,
-			"is_explicit":   collection.IsExplicit,
-			"is_featured":   collection.IsFeatured,
-			"image_url":     collection.ImageURL,
-			"banner_url":    collection.BannerURL,
-			"external_url":  collection.ExternalURL,
-			"discord_url":   collection.DiscordURL,
-			"twitter_url":   collection.TwitterURL,
-			"instagram_url": collection.InstagramURL,
-			"telegram_url":  collection.TelegramURL,
-			"floor_price":   collection.FloorPrice.String(),
-			"volume_traded": collection.VolumeTraded.String(),
-			"updated_at":    collection.UpdatedAt,
+			"id":                 collection.ID,
+			"slug":               collection.Slug,
+			"name":               collection.Name,
+			"description":        collection.Description,
+			"owner":              collection.Owner,
+			"royalty_recipient":  collection.RoyaltyRecipient,
+			"royalty_percentage": collection.RoyaltyPercentage,
+			"token_uri":          collection.TokenURI,
+			"is_verified":        collection.IsVerified,
+			"is_explicit":        collection.IsExplicit,
+			"is_featured":        collection.IsFeatured,
+			"image_url":          collection.ImageURL,
+			"banner_url":         collection.BannerURL,
+			"external_url":       collection.ExternalURL,
+			"discord_url":        collection.DiscordURL,
+			"twitter_url":        collection.TwitterURL,
+			"instagram_url":      collection.InstagramURL,
+			"telegram_url":       collection.TelegramURL,
+			"floor_price":        collection.FloorPrice.String(),
+			"volume_traded":      collection.VolumeTraded.String(),
+			"updated_at":         collection.UpdatedAt,
 		},
 		Timestamp: time.Now(),
 	}
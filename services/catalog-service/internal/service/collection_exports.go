@@ -0,0 +1,295 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+const (
+	// exportBatch is how many background exports one run claims
+	exportBatch = 5
+	// exportStaleAfter is how long a claimed export may run before another worker retries it
+	exportStaleAfter = 15 * time.Minute
+)
+
+// errExportTooLarge stops rendering once the CSV outgrows what media-service stores
+var errExportTooLarge = errors.New("export is larger than the artifact size limit")
+
+// SetCollectionExports enables sales and mints exports, stored in artifacts. notifier
+// announces background exports to their requester and may be nil.
+func (s *CatalogService) SetCollectionExports(repo domain.CollectionExportRepository, artifacts domain.ArtifactStore, notifier domain.ExportNotifier) {
+	s.collectionExportRepo = repo
+	s.exportArtifacts = artifacts
+	s.exportNotifier = notifier
+}
+
+// CreateCollectionExport exports the collection's sales or mints in [in.From, in.To). Periods
+// with few rows are rendered right away and returned ready; larger ones are returned pending
+// and generated by RunCollectionExports.
+func (s *CatalogService) CreateCollectionExport(ctx context.Context, in domain.CreateCollectionExportInput) (*domain.CollectionExport, error) {
+	if s.collectionExportRepo == nil || s.exportArtifacts == nil {
+		return nil, domain.ErrUnavailable.WithMessage("collection exports are disabled")
+	}
+	activityKind, ok := exportActivityKind(in.Kind)
+	if !ok {
+		return nil, domain.ErrInvalidInput.WithMessage("export kind must be sales or mints")
+	}
+	if in.ChainID == "" || in.Contract == "" || in.RequestedBy == "" || in.From.IsZero() || in.To.IsZero() {
+		return nil, domain.ErrInvalidInput
+	}
+	if !in.From.Before(in.To) {
+		return nil, domain.ErrInvalidInput.WithMessage("export period must end after it starts")
+	}
+	if in.To.Sub(in.From) > domain.MaxExportPeriod {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("export period may span at most %d days", int(domain.MaxExportPeriod.Hours()/24)))
+	}
+
+	collection, err := s.GetCollection(ctx, in.ChainID, in.Contract, true, false)
+	if err != nil {
+		return nil, err
+	}
+	chainID := collection.ChainID
+	contract := strings.ToLower(collection.ContractAddress)
+
+	rows, err := s.collectionExportRepo.CountRows(ctx, chainID, contract, activityKind, in.From, in.To)
+	if err != nil {
+		return nil, err
+	}
+	if rows > domain.MaxExportRows {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("the period holds %d %s, more than the %d an export may hold; narrow it", rows, in.Kind, domain.MaxExportRows))
+	}
+
+	inline := rows <= domain.ExportInlineRows
+	status := domain.ExportPending
+	if inline {
+		status = domain.ExportRunning
+	}
+	export, err := s.collectionExportRepo.Create(ctx, domain.CollectionExport{
+		Kind:            in.Kind,
+		ChainID:         chainID,
+		ContractAddress: contract,
+		From:            in.From.UTC(),
+		To:              in.To.UTC(),
+		RequestedBy:     in.RequestedBy,
+		Status:          status,
+		RowCount:        rows,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if inline {
+		s.generateCollectionExport(ctx, export)
+	}
+	return export, nil
+}
+
+// GetCollectionExport returns an export with a freshly signed download link. A ready export
+// whose artifact expired goes back to pending and is generated again in the background.
+func (s *CatalogService) GetCollectionExport(ctx context.Context, id string) (*domain.CollectionExport, error) {
+	if s.collectionExportRepo == nil || s.exportArtifacts == nil {
+		return nil, domain.ErrUnavailable.WithMessage("collection exports are disabled")
+	}
+	if id == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	export, err := s.collectionExportRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if export.Status != domain.ExportReady {
+		return export, nil
+	}
+
+	csvExport, err := s.exportArtifacts.Get(ctx, export.ArtifactID)
+	if errors.Is(err, domain.ErrNotFound) {
+		if err := s.collectionExportRepo.Requeue(ctx, export.ID); err != nil {
+			return nil, err
+		}
+		export.Status, export.ArtifactID, export.CompletedAt = domain.ExportPending, "", nil
+		return export, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign collection export: %w", err)
+	}
+	export.CSV = csvExport
+	return export, nil
+}
+
+// RunCollectionExports generates pending exports at startup and then every interval
+func (s *CatalogService) RunCollectionExports(ctx context.Context, interval time.Duration) {
+	if s.collectionExportRepo == nil || s.exportArtifacts == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.ProcessCollectionExports(ctx); err != nil {
+			log.Printf("Collection export run failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessCollectionExports claims pending exports batch by batch until none is left,
+// generates them and notifies their requesters
+func (s *CatalogService) ProcessCollectionExports(ctx context.Context) error {
+	if s.collectionExportRepo == nil || s.exportArtifacts == nil {
+		return nil
+	}
+	for {
+		exports, err := s.collectionExportRepo.ClaimPending(ctx, exportBatch, time.Now().Add(-exportStaleAfter))
+		if err != nil {
+			return err
+		}
+		for i := range exports {
+			export := &exports[i]
+			s.generateCollectionExport(ctx, export)
+			s.notifyCollectionExport(ctx, export)
+		}
+		if len(exports) < exportBatch {
+			return nil
+		}
+	}
+}
+
+// generateCollectionExport renders and stores a claimed export and records the outcome on
+// it; a failure is kept on the export rather than returned
+func (s *CatalogService) generateCollectionExport(ctx context.Context, export *domain.CollectionExport) {
+	content, rows, err := s.renderCollectionExport(ctx, export)
+	var stored *domain.SnapshotExport
+	if err == nil {
+		name := fmt.Sprintf("%s-%s-%s-%s-%s.csv", export.Kind, export.ChainID, export.ContractAddress,
+			export.From.Format("20060102"), export.To.Format("20060102"))
+		stored, err = s.exportArtifacts.Store(ctx, name, "text/csv", content, export.RequestedBy)
+		if err != nil {
+			err = fmt.Errorf("failed to store collection export: %w", err)
+		}
+	}
+
+	now := time.Now().UTC()
+	if err != nil {
+		log.Printf("Collection export %s failed: %v", export.ID, err)
+		reason := "export could not be generated"
+		if errors.Is(err, errExportTooLarge) {
+			reason = fmt.Sprintf("export is larger than %d MiB; narrow the period", domain.MaxExportBytes>>20)
+		}
+		if err := s.collectionExportRepo.Fail(ctx, export.ID, reason); err != nil {
+			log.Printf("Failed to record collection export %s failure: %v", export.ID, err)
+		}
+		export.Status, export.Error, export.CompletedAt = domain.ExportFailed, reason, &now
+		return
+	}
+
+	if err := s.collectionExportRepo.Complete(ctx, export.ID, stored.ArtifactID, rows); err != nil {
+		log.Printf("Failed to record collection export %s: %v", export.ID, err)
+		return
+	}
+	export.Status, export.RowCount, export.ArtifactID, export.CompletedAt = domain.ExportReady, rows, stored.ArtifactID, &now
+	export.CSV = stored
+}
+
+// notifyCollectionExport tells the requester a background export finished
+func (s *CatalogService) notifyCollectionExport(ctx context.Context, export *domain.CollectionExport) {
+	if s.exportNotifier == nil || export.CompletedAt == nil {
+		return
+	}
+	if err := s.exportNotifier.PublishExportReady(ctx, contracts.CollectionExportReadyEvent{
+		UserID:          export.RequestedBy,
+		ExportID:        export.ID,
+		Kind:            export.Kind,
+		ChainID:         export.ChainID,
+		ContractAddress: export.ContractAddress,
+		Status:          export.Status,
+		RowCount:        export.RowCount,
+		Error:           export.Error,
+		CompletedAt:     *export.CompletedAt,
+	}); err != nil {
+		log.Printf("Failed to announce collection export %s: %v", export.ID, err)
+	}
+}
+
+// renderCollectionExport streams the export's rows into CSV, stopping with errExportTooLarge
+// once it outgrows MaxExportBytes
+func (s *CatalogService) renderCollectionExport(ctx context.Context, export *domain.CollectionExport) ([]byte, int, error) {
+	activityKind, ok := exportActivityKind(export.Kind)
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown export kind %q", export.Kind)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	write := func(record []string) error {
+		if err := w.Write(record); err != nil {
+			return fmt.Errorf("failed to render CSV export: %w", err)
+		}
+		w.Flush()
+		if buf.Len() > domain.MaxExportBytes {
+			return errExportTooLarge
+		}
+		return nil
+	}
+
+	if err := write(exportHeader(export.Kind)); err != nil {
+		return nil, 0, err
+	}
+	rows := 0
+	err := s.collectionExportRepo.StreamRows(ctx, export.ChainID, export.ContractAddress, activityKind, export.From, export.To,
+		func(a domain.WalletActivity) error {
+			rows++
+			return write(exportRecord(export.Kind, a))
+		})
+	if err != nil {
+		return nil, 0, err
+	}
+	return buf.Bytes(), rows, nil
+}
+
+// exportActivityKind maps an export kind to the wallet activity kind it exports
+func exportActivityKind(kind string) (string, bool) {
+	switch kind {
+	case domain.ExportSales:
+		return domain.ActivitySale, true
+	case domain.ExportMints:
+		return domain.ActivityMint, true
+	}
+	return "", false
+}
+
+func exportHeader(kind string) []string {
+	if kind == domain.ExportMints {
+		return []string{"occurred_at", "tx_hash", "token_id", "minter", "quantity"}
+	}
+	return []string{"occurred_at", "tx_hash", "token_id", "seller", "buyer", "quantity", "price", "currency"}
+}
+
+// exportRecord renders one row; amounts are decimal wei strings
+func exportRecord(kind string, a domain.WalletActivity) []string {
+	occurredAt := a.OccurredAt.UTC().Format(time.RFC3339)
+	quantity := ""
+	if a.Quantity != nil {
+		quantity = a.Quantity.String()
+	}
+	if kind == domain.ExportMints {
+		return []string{occurredAt, a.TxHash, a.TokenID, a.ToAddress, quantity}
+	}
+	price := ""
+	if a.Price != nil {
+		price = a.Price.String()
+	}
+	return []string{occurredAt, a.TxHash, a.TokenID, a.FromAddress, a.ToAddress, quantity, price, a.Currency}
+}
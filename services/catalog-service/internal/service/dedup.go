@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// dedupCounter counts processed and duplicate-dropped events per event type and reason
+type dedupCounter struct {
+	mu     sync.Mutex
+	counts map[domain.DedupCount]uint64 // keyed with Count zero
+}
+
+func (c *dedupCounter) add(eventType string, reason domain.DuplicateReason) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts == nil {
+		c.counts = make(map[domain.DedupCount]uint64)
+	}
+	c.counts[domain.DedupCount{EventType: eventType, Reason: reason}]++
+}
+
+func (c *dedupCounter) snapshot() []domain.DedupCount {
+	c.mu.Lock()
+	out := make([]domain.DedupCount, 0, len(c.counts))
+	for k, n := range c.counts {
+		k.Count = n
+		out = append(out, k)
+	}
+	c.mu.Unlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].EventType != out[j].EventType {
+			return out[i].EventType < out[j].EventType
+		}
+		return out[i].Reason < out[j].Reason
+	})
+	return out
+}
+
+// markProcessed records evt as processed and reports whether this is its first delivery;
+// replays and backfills of an event already processed are dropped
+func (s *CatalogService) markProcessed(ctx context.Context, evt *domain.CollectionEvent) (bool, error) {
+	key := processedEventKey(evt)
+	reason, err := s.processedEventRepo.MarkProcessed(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	s.dedup.add(evt.EventType, reason)
+	if reason != domain.NotDuplicate {
+		log.Printf("Dropped duplicate %s event %s (matched by %s)", evt.EventType, evt.EventID, reason)
+		return false, nil
+	}
+	return true, nil
+}
+
+// processedEventKey keys evt by its id and, when it was emitted by a log, by the log in the
+// forms the indexer uses: eip155-1 chain ids and lowercase tx hashes
+func processedEventKey(evt *domain.CollectionEvent) domain.ProcessedEventKey {
+	key := domain.ProcessedEventKey{
+		EventID:   evt.EventID,
+		EventType: evt.EventType,
+		ChainID:   string(normalizeChainID(evt.ChainID)),
+		TxHash:    strings.ToLower(evt.TxHash),
+	}
+	if key.TxHash == "" {
+		txHash, _ := evt.Data["tx_hash"].(string)
+		key.TxHash = strings.ToLower(txHash)
+	}
+	if logIndex, ok := uint64FromData(evt.Data, "log_index"); ok {
+		key.LogIndex = &logIndex
+	}
+	return key
+}
+
+// DedupCounts returns how many events of each type were processed and dropped as
+// duplicates since the service started
+func (s *CatalogService) DedupCounts() []domain.DedupCount {
+	return s.dedup.snapshot()
+}
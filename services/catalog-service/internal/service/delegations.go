@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// DefaultDelegationTTL is how long registry reads are reused
+const DefaultDelegationTTL = 5 * time.Minute
+
+// MaxDelegates bounds the wallets one ListDelegatedVaults call looks up
+const MaxDelegates = 20
+
+type cachedDelegations struct {
+	delegations []domain.Delegation
+	fetchedAt   time.Time
+}
+
+// SetDelegations enables delegation registry lookups, reusing each read for ttl
+func (s *CatalogService) SetDelegations(registry domain.DelegationRegistry, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultDelegationTTL
+	}
+	s.delegations = registry
+	s.delegationTTL = ttl
+	s.delegateCache = make(map[string]cachedDelegations)
+}
+
+// ListDelegatedVaults returns the vaults that delegated to any of the wallets, for all
+// their tokens or, when contract is set, for that collection. A wallet whose registry read
+// fails falls back to its last read, or is skipped, so delegations only ever add holders.
+func (s *CatalogService) ListDelegatedVaults(ctx context.Context, chainID string, delegates []string, contract string) ([]string, error) {
+	if s.delegations == nil {
+		return nil, domain.ErrUnavailable.WithMessage("delegations are disabled")
+	}
+	if chainID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if len(delegates) > MaxDelegates {
+		return nil, domain.ErrInvalidInput.WithMessage("too many delegates")
+	}
+	contract = strings.ToLower(contract)
+
+	own := make(map[string]bool, len(delegates))
+	for _, delegate := range delegates {
+		own[strings.ToLower(delegate)] = true
+	}
+
+	var vaults []string
+	seen := make(map[string]bool)
+	for delegate := range own {
+		if delegate == "" {
+			continue
+		}
+		for _, d := range s.incomingDelegations(ctx, chainID, delegate) {
+			if d.Contract != "" && d.Contract != contract {
+				continue
+			}
+			if own[d.Vault] || seen[d.Vault] {
+				continue
+			}
+			seen[d.Vault] = true
+			vaults = append(vaults, d.Vault)
+		}
+	}
+	return vaults, nil
+}
+
+func (s *CatalogService) incomingDelegations(ctx context.Context, chainID, delegate string) []domain.Delegation {
+	key := string(normalizeChainID(chainID)) + "/" + delegate
+
+	s.delegationMu.Lock()
+	cached, ok := s.delegateCache[key]
+	s.delegationMu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < s.delegationTTL {
+		return cached.delegations
+	}
+
+	delegations, err := s.delegations.IncomingDelegations(ctx, chainID, delegate)
+	if err != nil {
+		log.Printf("Failed to read delegations to %s on %s: %v", delegate, chainID, err)
+		return cached.delegations
+	}
+	for i := range delegations {
+		delegations[i].Vault = strings.ToLower(delegations[i].Vault)
+		delegations[i].Contract = strings.ToLower(delegations[i].Contract)
+	}
+
+	s.delegationMu.Lock()
+	s.delegateCache[key] = cachedDelegations{delegations: delegations, fetchedAt: time.Now()}
+	s.delegationMu.Unlock()
+	return delegations
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	defaultDropLead   = 15 * time.Minute
+	defaultDropWindow = 30 * 24 * time.Hour
+	maxDropWindow     = 90 * 24 * time.Hour
+	defaultDropLimit  = 50
+	maxDropLimit      = 200
+
+	// Submissions may announce drops up to a year out
+	maxDropHorizon        = 365 * 24 * time.Hour
+	maxDropName           = 200
+	maxDropDescription    = 5000
+	maxPendingSubmissions = 10
+)
+
+var contractAddressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// SetDrops enables the drop calendar and off-platform drop submissions
+func (s *CatalogService) SetDrops(repo domain.DropRepository) {
+	s.dropRepo = repo
+}
+
+// SetDropLead overrides how long before a calendar drop starts the starting_soon alert fires
+func (s *CatalogService) SetDropLead(lead time.Duration) {
+	if lead > 0 {
+		s.dropLead = lead
+	}
+}
+
+// ListUpcomingDrops returns collections whose mint starts in [From, To) and approved
+// submissions in the same window, soonest first. From defaults to now and the window to
+// 30 days.
+func (s *CatalogService) ListUpcomingDrops(ctx context.Context, filter domain.DropCalendarFilter) ([]domain.UpcomingDrop, error) {
+	if s.dropRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("drop calendar is not enabled")
+	}
+
+	if filter.ChainID != "" {
+		filter.ChainID = string(normalizeChainID(filter.ChainID))
+	}
+	if filter.From.IsZero() {
+		filter.From = time.Now()
+	}
+	if filter.To.IsZero() {
+		filter.To = filter.From.Add(defaultDropWindow)
+	}
+	if !filter.To.After(filter.From) {
+		return nil, domain.ErrInvalidInput.WithMessage("to must be after from")
+	}
+	if filter.To.Sub(filter.From) > maxDropWindow {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("drop window is limited to %d days", int(maxDropWindow.Hours()/24)))
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultDropLimit
+	}
+	if filter.Limit > maxDropLimit {
+		filter.Limit = maxDropLimit
+	}
+
+	drops, err := s.dropRepo.ListCollectionDrops(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	submitted, err := s.dropRepo.ListApprovedDrops(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	drops = append(drops, submitted...)
+	sort.SliceStable(drops, func(i, j int) bool {
+		if !drops[i].StartsAt.Equal(drops[j].StartsAt) {
+			return drops[i].StartsAt.Before(drops[j].StartsAt)
+		}
+		return drops[i].ID < drops[j].ID
+	})
+	if len(drops) > filter.Limit {
+		drops = drops[:filter.Limit]
+	}
+	return drops, nil
+}
+
+// SubmitDrop queues an off-platform drop for admin review. A submitter may have a
+// handful of submissions pending at once.
+func (s *CatalogService) SubmitDrop(ctx context.Context, in domain.SubmitDropInput) (*domain.DropSubmission, error) {
+	if s.dropRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("drop calendar is not enabled")
+	}
+	sub, err := validateDropSubmission(in, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.dropRepo.ListSubmissions(ctx, domain.DropSubmissionFilter{
+		Status:      domain.DropPending,
+		SubmittedBy: in.SubmittedBy,
+		Limit:       maxPendingSubmissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) >= maxPendingSubmissions {
+		return nil, domain.ErrRateLimited.WithMessage(fmt.Sprintf("at most %d drop submissions may await review", maxPendingSubmissions))
+	}
+
+	created, err := s.dropRepo.CreateSubmission(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("audit|event=drop_submitted|submission_id=%s|chain_id=%s|submitted_by=%s|starts_at=%s",
+		created.ID, created.ChainID, created.SubmittedBy, created.StartsAt.Format(time.RFC3339))
+
+	return &created, nil
+}
+
+func validateDropSubmission(in domain.SubmitDropInput, now time.Time) (domain.DropSubmission, error) {
+	name := strings.TrimSpace(in.Name)
+	description := strings.TrimSpace(in.Description)
+	if in.ChainID == "" || in.SubmittedBy == "" || name == "" {
+		return domain.DropSubmission{}, domain.ErrInvalidInput
+	}
+	if utf8.RuneCountInString(name) > maxDropName {
+		return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("name is longer than %d characters", maxDropName))
+	}
+	if utf8.RuneCountInString(description) > maxDropDescription {
+		return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("description is longer than %d characters", maxDropDescription))
+	}
+	if in.ContractAddress != "" && !contractAddressPattern.MatchString(in.ContractAddress) {
+		return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage("invalid contract address")
+	}
+	if !isWebURL(in.ExternalURL) {
+		return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage("externalUrl must be an http(s) URL")
+	}
+	if in.ImageURL != "" && !isWebURL(in.ImageURL) && !strings.HasPrefix(in.ImageURL, "ipfs://") {
+		return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage("imageUrl must be an http(s) or ipfs URL")
+	}
+	if in.MintPrice != "" {
+		if price, ok := new(big.Int).SetString(in.MintPrice, 10); !ok || price.Sign() < 0 {
+			return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage("mintPrice must be a wei amount")
+		}
+	}
+	if !in.StartsAt.After(now) {
+		return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage("drop must start in the future")
+	}
+	if in.StartsAt.Sub(now) > maxDropHorizon {
+		return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage("drop starts more than a year out")
+	}
+	if in.EndsAt != nil && !in.EndsAt.After(in.StartsAt) {
+		return domain.DropSubmission{}, domain.ErrInvalidInput.WithMessage("drop must end after it starts")
+	}
+
+	return domain.DropSubmission{
+		ChainID:         string(normalizeChainID(in.ChainID)),
+		ContractAddress: strings.ToLower(in.ContractAddress),
+		Name:            name,
+		Description:     description,
+		ImageURL:        in.ImageURL,
+		ExternalURL:     in.ExternalURL,
+		MintPrice:       in.MintPrice,
+		StartsAt:        in.StartsAt.UTC(),
+		EndsAt:          in.EndsAt,
+		SubmittedBy:     in.SubmittedBy,
+	}, nil
+}
+
+func isWebURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+// ListDropSubmissions pages submissions newest first
+func (s *CatalogService) ListDropSubmissions(ctx context.Context, filter domain.DropSubmissionFilter) ([]domain.DropSubmission, error) {
+	if s.dropRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("drop calendar is not enabled")
+	}
+	switch filter.Status {
+	case "", domain.DropPending, domain.DropApproved, domain.DropRejected:
+	default:
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("unknown submission status %q", filter.Status))
+	}
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 20
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return s.dropRepo.ListSubmissions(ctx, filter)
+}
+
+// ReviewDropSubmission approves or rejects a submission. Approved drops are listed and get
+// a starting_soon alert; rejecting an approved drop takes it off the calendar again.
+func (s *CatalogService) ReviewDropSubmission(ctx context.Context, in domain.ReviewDropSubmissionInput) (*domain.DropSubmission, error) {
+	if s.dropRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("drop calendar is not enabled")
+	}
+
+	var status domain.DropSubmissionStatus
+	switch in.Action {
+	case domain.DropActionApprove:
+		status = domain.DropApproved
+	case domain.DropActionReject:
+		status = domain.DropRejected
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+
+	sub, err := s.dropRepo.ReviewSubmission(ctx, in.ID, status, in.ActorID, strings.TrimSpace(in.Note))
+	if err != nil {
+		return nil, err
+	}
+
+	jobID := dropJobID(sub.ChainID, sub.ID)
+	if status == domain.DropApproved {
+		err = s.scheduleDropStart(ctx, domain.ScheduledJob{
+			ID:              jobID,
+			ChainID:         sub.ChainID,
+			ContractAddress: sub.ContractAddress,
+			SubjectID:       sub.ID,
+		}, sub.StartsAt)
+	} else {
+		err = s.schedulerRepo.Cancel(ctx, jobID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update drop alert: %w", err)
+	}
+
+	log.Printf("audit|event=drop_reviewed|submission_id=%s|status=%s|actor_id=%s|timestamp=%s",
+		sub.ID, sub.Status, in.ActorID, time.Now().UTC().Format(time.RFC3339Nano))
+
+	return &sub, nil
+}
+
+// scheduleCollectionDrop schedules the starting_soon alert of a collection whose mint
+// starts in the future; its creator is notified along with calendar subscribers
+func (s *CatalogService) scheduleCollectionDrop(ctx context.Context, collection domain.Collection) error {
+	if collection.MintStartTime == nil || !collection.MintStartTime.IsInt64() || collection.MintStartTime.Sign() <= 0 {
+		return nil
+	}
+	chainID := string(normalizeChainID(collection.ChainID))
+	contract := strings.ToLower(collection.ContractAddress)
+	return s.scheduleDropStart(ctx, domain.ScheduledJob{
+		ID:              dropJobID(chainID, contract),
+		ChainID:         chainID,
+		ContractAddress: contract,
+		SubjectID:       collection.ID,
+		Recipients:      appendRecipients(nil, collection.Creator),
+	}, time.Unix(collection.MintStartTime.Int64(), 0))
+}
+
+// scheduleDropStart fires job dropLead before startsAt, or right away when that has passed.
+// Drops that already started need no alert.
+func (s *CatalogService) scheduleDropStart(ctx context.Context, job domain.ScheduledJob, startsAt time.Time) error {
+	now := time.Now()
+	if !startsAt.After(now) {
+		return s.schedulerRepo.Cancel(ctx, job.ID)
+	}
+
+	fireAt := startsAt.Add(-s.dropLead)
+	if fireAt.Before(now) {
+		fireAt = now
+	}
+
+	job.Kind = domain.JobDropStartingSoon
+	job.EndsAt = startsAt
+	job.FireAt = fireAt
+	if job.Recipients == nil {
+		job.Recipients = []string{}
+	}
+	return s.schedulerRepo.Schedule(ctx, job)
+}
+
+func dropJobID(chainID, subject string) string {
+	return fmt.Sprintf("drop:%s:%s", chainID, subject)
+}
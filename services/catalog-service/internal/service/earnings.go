@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const defaultSaleCurrency = "ETH"
+
+// HandleSaleIndexed records an indexed sale as seller and buyer activity and records the
+// royalty it paid. Marketplaces that report the EIP-2981 payout send
+// royalty_recipient/royalty_amount; otherwise the royalty is derived from the
+// collection's default royalty settings.
+func (s *CatalogService) HandleSaleIndexed(ctx context.Context, evt *domain.CollectionEvent) error {
+	contract := evt.Contract
+	if collectionAddress, ok := evt.Data["collection_address"].(string); ok && collectionAddress != "" {
+		contract = collectionAddress
+	}
+	contract = strings.ToLower(contract)
+	chainID := normalizeChainID(evt.ChainID)
+
+	price, ok := bigFromData(evt.Data, "price")
+	if !ok {
+		return fmt.Errorf("sale event %s has no valid price", evt.EventID)
+	}
+
+	currency, _ := evt.Data["currency"].(string)
+	if currency == "" {
+		currency = defaultSaleCurrency
+	}
+	currency = strings.ToUpper(currency)
+	tokenID, _ := evt.Data["token_id"].(string)
+
+	if err := s.recordSaleActivity(ctx, evt, chainID, contract, tokenID, currency, price); err != nil {
+		return err
+	}
+
+	recipient, _ := evt.Data["royalty_recipient"].(string)
+	amount, hasAmount := bigFromData(evt.Data, "royalty_amount")
+	if !hasAmount || recipient == "" {
+		collection, err := s.collectionRepo.GetByPK(ctx, chainID, domain.Address(contract))
+		if err != nil {
+			return fmt.Errorf("failed to load collection %s: %w", contract, err)
+		}
+		recipient = collection.RoyaltyRecipient
+		amount = new(big.Int).Mul(price, big.NewInt(int64(collection.RoyaltyPercentage)))
+		amount.Div(amount, big.NewInt(10000))
+	}
+
+	if recipient == "" || amount.Sign() <= 0 {
+		// No royalty configured for this sale
+		return nil
+	}
+
+	occurredAt := evt.Timestamp
+	if occurredAtStr, ok := evt.Data["occurred_at"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, occurredAtStr); err == nil {
+			occurredAt = t
+		}
+	}
+
+	// The ledger is keyed by event id, so redelivered events are no-ops
+	_, err := s.earningsRepo.Record(ctx, domain.RoyaltyEarning{
+		EventID:         evt.EventID,
+		ChainID:         string(chainID),
+		ContractAddress: contract,
+		TokenID:         tokenID,
+		Recipient:       strings.ToLower(recipient),
+		Currency:        currency,
+		Amount:          amount,
+		SalePrice:       price,
+		TxHash:          evt.TxHash,
+		OccurredAt:      occurredAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record royalty earning: %w", err)
+	}
+
+	return nil
+}
+
+// GetEarnings totals royalties paid to recipients per collection and currency
+func (s *CatalogService) GetEarnings(ctx context.Context, recipients []string, period string) ([]domain.EarningsTotal, time.Time, error) {
+	since, err := earningsPeriodStart(period, time.Now())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	addresses := make([]string, 0, len(recipients))
+	seen := make(map[string]bool, len(recipients))
+	for _, r := range recipients {
+		addr := strings.ToLower(strings.TrimSpace(r))
+		if addr == "" || seen[addr] {
+			continue
+		}
+		seen[addr] = true
+		addresses = append(addresses, addr)
+	}
+	if len(addresses) == 0 {
+		return []domain.EarningsTotal{}, since, nil
+	}
+
+	totals, err := s.earningsRepo.TotalsByRecipients(ctx, addresses, since)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return totals, since, nil
+}
+
+// earningsPeriodStart returns the start of a rolling period ending at now
+func earningsPeriodStart(period string, now time.Time) (time.Time, error) {
+	switch strings.ToLower(period) {
+	case domain.EarningsPeriodDay:
+		return now.AddDate(0, 0, -1), nil
+	case domain.EarningsPeriodWeek:
+		return now.AddDate(0, 0, -7), nil
+	case domain.EarningsPeriodMonth:
+		return now.AddDate(0, -1, 0), nil
+	case domain.EarningsPeriodYear:
+		return now.AddDate(-1, 0, 0), nil
+	case "", domain.EarningsPeriodAll:
+		return time.Time{}, nil
+	default:
+		return time.Time{}, domain.ErrInvalidInput
+	}
+}
+
+// bigFromData reads a base-10 integer string from event data
+func bigFromData(data map[string]interface{}, key string) (*big.Int, bool) {
+	value, ok := data[key].(string)
+	if !ok || value == "" {
+		return nil, false
+	}
+	n, ok := new(big.Int).SetString(value, 10)
+	if !ok || n.Sign() < 0 {
+		return nil, false
+	}
+	return n, true
+}
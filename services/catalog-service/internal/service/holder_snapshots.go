@@ -0,0 +1,320 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// SetHolderSnapshots enables the ownership index and holder snapshots; exports are stored
+// in artifacts, and skipped when it is nil
+func (s *CatalogService) SetHolderSnapshots(repo domain.HolderSnapshotRepository, artifacts domain.ArtifactStore) {
+	s.holderSnapshotRepo = repo
+	s.artifactStore = artifacts
+}
+
+// SetHoldingsNotifier announces how transfers indexed in the ownership index change the
+// number of tokens their wallets hold
+func (s *CatalogService) SetHoldingsNotifier(notifier domain.HoldingsNotifier) {
+	s.holdingsNotifier = notifier
+}
+
+// recordOwnershipTransfers adds a transfer event to the ownership index. A batch can repeat
+// an id, so amounts are summed per id to keep one row per token and log.
+func (s *CatalogService) recordOwnershipTransfers(ctx context.Context, evt *domain.CollectionEvent, chainID, contract, from, to string, moved []tokenAmount) error {
+	if s.holderSnapshotRepo == nil {
+		return nil
+	}
+	block, ok := uint64FromData(evt.Data, "block_number")
+	if !ok {
+		log.Printf("Transfer event %s has no block number, not indexing ownership", evt.EventID)
+		return nil
+	}
+	logIndex, _ := uint64FromData(evt.Data, "log_index")
+
+	var transfers []domain.OwnershipTransfer
+	index := make(map[string]int)
+	for _, m := range moved {
+		if j, seen := index[m.TokenID]; seen {
+			transfers[j].Quantity.Add(transfers[j].Quantity, m.Amount)
+			continue
+		}
+		index[m.TokenID] = len(transfers)
+		transfers = append(transfers, domain.OwnershipTransfer{
+			ChainID:     chainID,
+			Contract:    contract,
+			TokenID:     m.TokenID,
+			From:        from,
+			To:          to,
+			Quantity:    new(big.Int).Set(m.Amount),
+			TxHash:      evt.TxHash,
+			LogIndex:    int(logIndex),
+			BlockNumber: block,
+			At:          activityTime(evt),
+		})
+	}
+
+	if err := s.holderSnapshotRepo.RecordTransfers(ctx, transfers); err != nil {
+		return fmt.Errorf("failed to index ownership: %w", err)
+	}
+	return s.announceHoldings(ctx, evt, chainID, contract, from, to, transfers)
+}
+
+// announceHoldings publishes how many distinct tokens the sender and recipient of an indexed
+// transfer gained or lost. It reads their balances after the transfer, so a redelivered
+// event announces the same deltas under the same event id.
+func (s *CatalogService) announceHoldings(ctx context.Context, evt *domain.CollectionEvent, chainID, contract, from, to string, transfers []domain.OwnershipTransfer) error {
+	if s.holdingsNotifier == nil || from == to {
+		return nil
+	}
+	var holders []string
+	for _, holder := range []string{from, to} {
+		if holder != "" && holder != zeroAddress {
+			holders = append(holders, holder)
+		}
+	}
+	if len(holders) == 0 {
+		return nil
+	}
+	tokenIDs := make([]string, len(transfers))
+	for i, t := range transfers {
+		tokenIDs[i] = t.TokenID
+	}
+
+	balances, err := s.holderSnapshotRepo.TokenBalances(ctx, chainID, contract, holders, tokenIDs)
+	if err != nil {
+		return fmt.Errorf("failed to read token balances: %w", err)
+	}
+	deltas := make(map[string]int64, len(holders))
+	for _, t := range transfers {
+		for _, holder := range holders {
+			balance := balances[holder][t.TokenID]
+			switch {
+			case holder == from && (balance == nil || balance.Sign() <= 0):
+				// The sender moved its last unit
+				deltas[holder]--
+			case holder == to && balance != nil && balance.Cmp(t.Quantity) == 0:
+				// The recipient holds nothing but what it just received
+				deltas[holder]++
+			}
+		}
+	}
+	for holder, delta := range deltas {
+		if delta == 0 {
+			delete(deltas, holder)
+		}
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	if err := s.holdingsNotifier.PublishHoldingsChanged(ctx, contracts.HoldingsChangedEvent{
+		EventID:    "holdings_changed_" + evt.EventID,
+		ChainID:    chainID,
+		Contract:   contract,
+		Deltas:     deltas,
+		OccurredAt: activityTime(evt),
+	}); err != nil {
+		return fmt.Errorf("failed to announce holdings change: %w", err)
+	}
+	return nil
+}
+
+// CreateHolderSnapshot computes the holders of a collection as of in.BlockNumber, or the
+// last indexed block, and exports them as CSV and JSON. The snapshot is kept when an
+// export fails; GetHolderSnapshot retries it.
+func (s *CatalogService) CreateHolderSnapshot(ctx context.Context, in domain.CreateHolderSnapshotInput) (*domain.HolderSnapshot, error) {
+	if s.holderSnapshotRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("holder snapshots are disabled")
+	}
+	if in.ChainID == "" || in.Contract == "" || in.RequestedBy == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if in.BlockNumber != nil && *in.BlockNumber > math.MaxInt64 {
+		return nil, domain.ErrInvalidInput.WithMessage("block number out of range")
+	}
+
+	collection, err := s.GetCollection(ctx, in.ChainID, in.Contract, true, false)
+	if err != nil {
+		return nil, err
+	}
+	chainID := collection.ChainID
+	contract := strings.ToLower(collection.ContractAddress)
+
+	latest, indexed, err := s.holderSnapshotRepo.LatestBlock(ctx, chainID, contract)
+	if err != nil {
+		return nil, err
+	}
+	if !indexed {
+		return nil, domain.ErrNotIndexed.WithMessage("no transfers of the collection are indexed yet")
+	}
+	block := latest
+	if in.BlockNumber != nil {
+		if *in.BlockNumber > latest {
+			return nil, domain.ErrNotIndexed.WithMessage(fmt.Sprintf("ownership is indexed up to block %d", latest))
+		}
+		block = *in.BlockNumber
+	}
+
+	snapshot, err := s.holderSnapshotRepo.CreateSnapshot(ctx, domain.HolderSnapshot{
+		ChainID:         chainID,
+		ContractAddress: contract,
+		BlockNumber:     block,
+		RequestedBy:     in.RequestedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.exportHolderSnapshot(ctx, snapshot); err != nil {
+		log.Printf("Exports of holder snapshot %s failed: %v", snapshot.ID, err)
+	}
+	return snapshot, nil
+}
+
+// GetHolderSnapshot returns a snapshot with freshly signed download links, exporting it
+// again when a stored export expired or was never written
+func (s *CatalogService) GetHolderSnapshot(ctx context.Context, id string) (*domain.HolderSnapshot, error) {
+	if s.holderSnapshotRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("holder snapshots are disabled")
+	}
+	if id == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	snapshot, err := s.holderSnapshotRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.artifactStore == nil {
+		return snapshot, nil
+	}
+
+	csvExport, csvErr := s.lookupExport(ctx, snapshot.CSVArtifactID)
+	jsonExport, jsonErr := s.lookupExport(ctx, snapshot.JSONArtifactID)
+	if csvErr == nil && jsonErr == nil {
+		snapshot.CSV, snapshot.JSON = csvExport, jsonExport
+		return snapshot, nil
+	}
+	if !errors.Is(csvErr, domain.ErrNotFound) && !errors.Is(jsonErr, domain.ErrNotFound) {
+		return nil, fmt.Errorf("failed to sign snapshot exports: %w", errors.Join(csvErr, jsonErr))
+	}
+
+	if err := s.exportHolderSnapshot(ctx, snapshot); err != nil {
+		log.Printf("Exports of holder snapshot %s failed: %v", snapshot.ID, err)
+	}
+	return snapshot, nil
+}
+
+// lookupExport signs a stored export; ErrNotFound when there is none
+func (s *CatalogService) lookupExport(ctx context.Context, artifactID string) (*domain.SnapshotExport, error) {
+	if artifactID == "" {
+		return nil, domain.ErrNotFound
+	}
+	return s.artifactStore.Get(ctx, artifactID)
+}
+
+// exportHolderSnapshot renders the snapshot's holders, stores both exports and records them
+// on the snapshot
+func (s *CatalogService) exportHolderSnapshot(ctx context.Context, snapshot *domain.HolderSnapshot) error {
+	if s.artifactStore == nil {
+		return nil
+	}
+	balances, err := s.holderSnapshotRepo.ListBalances(ctx, snapshot.ID)
+	if err != nil {
+		return err
+	}
+
+	csvContent, err := holdersCSV(balances)
+	if err != nil {
+		return err
+	}
+	jsonContent, err := holdersJSON(snapshot, balances)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("holders-%s-%s-%d", snapshot.ChainID, snapshot.ContractAddress, snapshot.BlockNumber)
+	csvExport, err := s.artifactStore.Store(ctx, name+".csv", "text/csv", csvContent, snapshot.RequestedBy)
+	if err != nil {
+		return fmt.Errorf("failed to store CSV export: %w", err)
+	}
+	jsonExport, err := s.artifactStore.Store(ctx, name+".json", "application/json", jsonContent, snapshot.RequestedBy)
+	if err != nil {
+		return fmt.Errorf("failed to store JSON export: %w", err)
+	}
+
+	if err := s.holderSnapshotRepo.SetExports(ctx, snapshot.ID, csvExport.ArtifactID, jsonExport.ArtifactID); err != nil {
+		return err
+	}
+	snapshot.CSVArtifactID, snapshot.JSONArtifactID = csvExport.ArtifactID, jsonExport.ArtifactID
+	snapshot.CSV, snapshot.JSON = csvExport, jsonExport
+	return nil
+}
+
+// holdersCSV renders one holder,balance,token_count row per holder
+func holdersCSV(balances []domain.HolderBalance) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	rows := [][]string{{"holder", "balance", "token_count"}}
+	for _, b := range balances {
+		rows = append(rows, []string{b.Holder, b.Balance.String(), strconv.Itoa(b.TokenCount)})
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to render CSV export: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// holdersJSON renders the snapshot with its holders; amounts are decimal strings so they
+// survive JSON number precision
+func holdersJSON(snapshot *domain.HolderSnapshot, balances []domain.HolderBalance) ([]byte, error) {
+	type holder struct {
+		Holder     string `json:"holder"`
+		Balance    string `json:"balance"`
+		TokenCount int    `json:"token_count"`
+	}
+	holders := make([]holder, len(balances))
+	for i, b := range balances {
+		holders[i] = holder{Holder: b.Holder, Balance: b.Balance.String(), TokenCount: b.TokenCount}
+	}
+
+	content, err := json.Marshal(map[string]interface{}{
+		"snapshot_id":      snapshot.ID,
+		"chain_id":         snapshot.ChainID,
+		"contract_address": snapshot.ContractAddress,
+		"block_number":     strconv.FormatUint(snapshot.BlockNumber, 10),
+		"holder_count":     snapshot.HolderCount,
+		"total_quantity":   snapshot.TotalQuantity.String(),
+		"holders":          holders,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render JSON export: %w", err)
+	}
+	return content, nil
+}
+
+// uint64FromData reads a decimal string or JSON number
+func uint64FromData(data map[string]interface{}, key string) (uint64, bool) {
+	switch v := data[key].(type) {
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		return n, err == nil
+	case float64:
+		if v < 0 || v != math.Trunc(v) {
+			return 0, false
+		}
+		return uint64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// SetMetadataFetcher enables the metadata crawl of imported collections; without it they
+// are listed with their on-chain name only
+func (s *CatalogService) SetMetadataFetcher(fetcher domain.MetadataFetcher) {
+	s.metadataFetcher = fetcher
+}
+
+// HandleCollectionImported lists a collection deployed outside the marketplace once the
+// orchestrator verified its owner. Its history arrives from the indexer's backfill, and its
+// description and artwork from the contractURI metadata when the contract has one.
+func (s *CatalogService) HandleCollectionImported(ctx context.Context, evt *domain.CollectionEvent) error {
+	processed, err := s.markProcessed(ctx, evt)
+	if err != nil {
+		return fmt.Errorf("failed to check if event is processed: %w", err)
+	}
+
+	if !processed {
+		// Event already processed, skip
+		return nil
+	}
+
+	collection := importedCollectionFromEvent(evt)
+	if contractURI, _ := evt.Data["contract_uri"].(string); contractURI != "" {
+		s.crawlContractMetadata(ctx, &collection, contractURI)
+	}
+
+	err = s.unitOfWork.WithinTx(ctx, func(ctx context.Context, tx domain.Tx) error {
+		if err := s.assignSlug(ctx, tx.CollectionsRepo(), &collection); err != nil {
+			return err
+		}
+
+		created, err := tx.CollectionsRepo().Upsert(ctx, collection)
+		if err != nil {
+			return fmt.Errorf("failed to upsert collection: %w", err)
+		}
+
+		if err := s.publishCollectionUpsertedEvent(ctx, &collection, created); err != nil {
+			return fmt.Errorf("failed to publish domain event: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to process collection import: %w", err)
+	}
+
+	log.Printf("Imported collection %s on chain %s for user %s", collection.ContractAddress, collection.ChainID, evt.Data["imported_by"])
+	return nil
+}
+
+// importedCollectionFromEvent builds the listing of an imported collection. Its deployment is
+// long final, so it carries no confirmation depth to wait for.
+func importedCollectionFromEvent(evt *domain.CollectionEvent) domain.Collection {
+	collection := domain.Collection{
+		ID:              uuid.New().String(),
+		ChainID:         string(normalizeChainID(evt.ChainID)),
+		ContractAddress: strings.ToLower(evt.Contract),
+		CreatedAt:       evt.Timestamp,
+		UpdatedAt:       evt.Timestamp,
+	}
+	if address, ok := evt.Data["collection_address"].(string); ok && address != "" {
+		collection.ContractAddress = strings.ToLower(address)
+	}
+	if owner, ok := evt.Data["owner"].(string); ok {
+		collection.Owner = strings.ToLower(owner)
+		collection.Creator = collection.Owner
+	}
+	if collectionType, ok := evt.Data["collection_type"].(string); ok {
+		collection.CollectionType = collectionType
+	}
+	collection.Name, _ = evt.Data["name"].(string)
+	if collection.Name == "" {
+		collection.Name = collection.ContractAddress
+	}
+	return collection
+}
+
+// crawlContractMetadata fills in what the contract metadata describes. A failed crawl is only
+// logged: the collection is listed either way and its owner can complete the details.
+func (s *CatalogService) crawlContractMetadata(ctx context.Context, collection *domain.Collection, contractURI string) {
+	if s.metadataFetcher == nil {
+		return
+	}
+	meta, err := s.metadataFetcher.FetchContractMetadata(ctx, contractURI)
+	if err != nil {
+		log.Printf("Failed to crawl metadata of imported collection %s on chain %s: %v", collection.ContractAddress, collection.ChainID, err)
+		return
+	}
+
+	collection.Description = meta.Description
+	collection.ImageURL = meta.Image
+	collection.BannerURL = meta.BannerImage
+	collection.ExternalURL = meta.ExternalLink
+	// Prefer the metadata name when the contract has none of its own
+	if meta.Name != "" && collection.Name == collection.ContractAddress {
+		collection.Name = meta.Name
+	}
+}
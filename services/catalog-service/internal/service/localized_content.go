@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/locale"
+)
+
+// Bounds of a collection's localized content
+const (
+	maxContentLocales     = 20
+	maxContentDescription = 5000
+	maxContentTagline     = 140
+)
+
+// SetLocalizedContent enables creator-written content per locale; without it collections
+// only carry their on-chain description
+func (s *CatalogService) SetLocalizedContent(repo domain.LocalizedContentRepository) {
+	s.localizedContentRepo = repo
+}
+
+// SetCollectionContent stores the creator's description and tagline in one locale, or
+// removes the locale when both are empty. The gateway checks that the actor created or
+// manages the collection.
+func (s *CatalogService) SetCollectionContent(ctx context.Context, in domain.SetCollectionContentInput) (*domain.Collection, error) {
+	if s.localizedContentRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("localized content is not enabled")
+	}
+	if in.ChainID == "" || in.ContractAddress == "" || in.ActorID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	tag, ok := locale.ParseLocale(in.Locale)
+	if !ok {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("invalid locale %q", in.Locale))
+	}
+	description := strings.TrimSpace(in.Description)
+	tagline := strings.TrimSpace(in.Tagline)
+	if utf8.RuneCountInString(description) > maxContentDescription {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("description is longer than %d characters", maxContentDescription))
+	}
+	if utf8.RuneCountInString(tagline) > maxContentTagline {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("tagline is longer than %d characters", maxContentTagline))
+	}
+
+	collection, err := s.GetCollection(ctx, in.ChainID, in.ContractAddress, true, true)
+	if err != nil {
+		return nil, err
+	}
+	chainID, contract := domain.ChainID(collection.ChainID), domain.Address(collection.ContractAddress)
+
+	if description == "" && tagline == "" {
+		err = s.localizedContentRepo.Delete(ctx, chainID, contract, tag)
+	} else {
+		if !hasLocale(collection.Localized, tag) && len(collection.Localized) >= maxContentLocales {
+			return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("collections may have content in at most %d locales", maxContentLocales))
+		}
+		err = s.localizedContentRepo.Put(ctx, chainID, contract, domain.LocalizedContent{
+			Locale:          tag,
+			Description:     description,
+			Tagline:         tagline,
+			UpdatedByUserID: in.ActorID,
+			UpdatedAt:       time.Now().UTC(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("audit|event=collection_content_set|chain_id=%s|contract=%s|locale=%s|actor_id=%s|timestamp=%s",
+		chainID, contract, tag, in.ActorID, time.Now().UTC().Format(time.RFC3339Nano))
+
+	return s.GetCollection(ctx, chainID, contract, true, true)
+}
+
+// loadLocalizedContent attaches the collection's localized content when it is enabled
+func (s *CatalogService) loadLocalizedContent(ctx context.Context, collection *domain.Collection) error {
+	if s.localizedContentRepo == nil {
+		return nil
+	}
+	localized, err := s.localizedContentRepo.List(ctx, domain.ChainID(collection.ChainID), domain.Address(collection.ContractAddress))
+	if err != nil {
+		return fmt.Errorf("failed to load localized content: %w", err)
+	}
+	collection.Localized = localized
+	return nil
+}
+
+func hasLocale(contents []domain.LocalizedContent, tag string) bool {
+	for _, c := range contents {
+		if c.Locale == tag {
+			return true
+		}
+	}
+	return false
+}
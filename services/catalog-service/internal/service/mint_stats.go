@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// SetMintStats enables per-minute mint analytics. Updated buckets are announced through
+// notifier for live dashboards; a nil notifier only records them.
+func (s *CatalogService) SetMintStats(repo domain.MintStatsRepository, notifier domain.MintStatsNotifier) {
+	s.mintStatsRepo = repo
+	s.mintStatsNotifier = notifier
+}
+
+// GetMintStats aggregates a collection's mints per minute over a window ending now. The
+// window starts on a minute boundary, so its oldest bucket is complete.
+func (s *CatalogService) GetMintStats(ctx context.Context, chainID domain.ChainID, contract domain.Address, window string) (*domain.MintStats, error) {
+	if s.mintStatsRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("mint stats are not enabled")
+	}
+	if chainID == "" || !contractAddressPattern.MatchString(string(contract)) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	window = strings.ToLower(window)
+	if window == "" {
+		window = domain.MintStatsWindow1h
+	}
+	length, err := mintStatsWindowLength(window)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().UTC().Add(-length).Truncate(time.Minute)
+
+	stats, err := s.mintStatsRepo.GetMintStats(ctx, string(normalizeChainID(string(chainID))), strings.ToLower(string(contract)), since)
+	if err != nil {
+		return nil, err
+	}
+	stats.Window = window
+	return &stats, nil
+}
+
+func mintStatsWindowLength(window string) (time.Duration, error) {
+	switch window {
+	case domain.MintStatsWindow15m:
+		return 15 * time.Minute, nil
+	case domain.MintStatsWindow1h:
+		return time.Hour, nil
+	case domain.MintStatsWindow24h:
+		return 24 * time.Hour, nil
+	default:
+		return 0, domain.ErrInvalidInput.WithMessage("window must be one of 15m, 1h, 24h")
+	}
+}
+
+// recordMintStats counts a mint to minter in its minute bucket. Revenue is the quantity at
+// the collection's public mint price, or its base mint price when no public price is set;
+// mints of collections the catalog has not stored yet count no revenue.
+func (s *CatalogService) recordMintStats(ctx context.Context, evt *domain.CollectionEvent, chainID, contract, minter string, moved []tokenAmount) error {
+	if s.mintStatsRepo == nil {
+		return nil
+	}
+
+	quantity := new(big.Int)
+	for _, m := range moved {
+		quantity.Add(quantity, m.Amount)
+	}
+
+	revenue := new(big.Int)
+	collection, err := s.collectionRepo.GetByPK(ctx, domain.ChainID(chainID), domain.Address(contract))
+	switch {
+	case err == nil:
+		price := collection.PublicMintPrice
+		if price == nil || price.Sign() <= 0 {
+			price = collection.MintPrice
+		}
+		if price != nil && price.Sign() > 0 {
+			revenue.Mul(quantity, price)
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return fmt.Errorf("failed to load collection %s: %w", contract, err)
+	}
+
+	bucket, applied, err := s.mintStatsRepo.RecordMint(ctx, domain.MintRecord{
+		EventID:         evt.EventID,
+		ChainID:         chainID,
+		ContractAddress: contract,
+		Minter:          minter,
+		Quantity:        quantity,
+		Revenue:         revenue,
+		MintedAt:        activityTime(evt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record mint stats: %w", err)
+	}
+	if !applied || s.mintStatsNotifier == nil {
+		return nil
+	}
+
+	// Dashboards refetch on the next update or poll, so a lost announcement is not retried
+	update := contracts.MintStatsUpdate{
+		ChainID:         chainID,
+		ContractAddress: contract,
+		Minute:          bucket.Minute,
+		Mints:           bucket.Mints.String(),
+		UniqueMinters:   bucket.UniqueMinters,
+		Revenue:         bucket.Revenue.String(),
+		EmittedAt:       time.Now(),
+	}
+	if err := s.mintStatsNotifier.PublishMintStats(ctx, update); err != nil {
+		log.Printf("failed to announce mint stats of %s: %v", contract, err)
+	}
+	return nil
+}
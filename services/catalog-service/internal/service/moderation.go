@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+
+	// Bounds of a token trait filter
+	maxTraitFilters      = 10
+	maxTraitFilterValues = 50
+)
+
+// GetCollection returns a collection with its moderation overlay, localized content and
+// payout split. Flagged collections and collections pending finality are reported as not
+// found unless includeFlagged or includeUnconfirmed is set.
+func (s *CatalogService) GetCollection(ctx context.Context, chainID domain.ChainID, contract domain.Address, includeFlagged, includeUnconfirmed bool) (*domain.Collection, error) {
+	if chainID == "" || contract == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	chainID = normalizeChainID(string(chainID))
+	contract = domain.Address(strings.ToLower(string(contract)))
+
+	collection, err := s.collectionRepo.GetByPK(ctx, chainID, contract)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to load collection: %w", err)
+	}
+
+	flag, err := s.moderationRepo.Get(ctx, chainID, contract, "")
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to load moderation flag: %w", err)
+	}
+	collection.ModerationStatus = flag.Status
+
+	if collection.Flagged() && !includeFlagged {
+		return nil, domain.ErrNotFound
+	}
+	if collection.PendingFinality() && !includeUnconfirmed {
+		return nil, domain.ErrNotFound
+	}
+	if err := s.loadLocalizedContent(ctx, &collection); err != nil {
+		return nil, err
+	}
+	if err := s.loadPayoutSplits(ctx, &collection); err != nil {
+		return nil, err
+	}
+
+	return &collection, nil
+}
+
+// ListCollections lists collections, hiding flagged ones unless filter.IncludeFlagged is set
+// and ones pending finality unless filter.IncludeUnconfirmed is set
+func (s *CatalogService) ListCollections(ctx context.Context, filter domain.CollectionFilter) ([]domain.Collection, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	if filter.ChainID != "" {
+		filter.ChainID = string(normalizeChainID(filter.ChainID))
+	}
+	if filter.Sort == "" {
+		filter.Sort, filter.Descending = domain.CollectionSortCreatedAt, true
+	}
+	if !filter.Sort.Valid() {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("unsupported collection sort %q", filter.Sort))
+	}
+	if err := validatePriceRange("floorPrice", filter.FloorPrice); err != nil {
+		return nil, err
+	}
+
+	return s.collectionRepo.List(ctx, filter)
+}
+
+// ListTokens lists tokens of confirmed collections, hiding flagged ones unless
+// filter.IncludeFlagged is set. Trait filters are ANDed; the values of one trait are ORed.
+func (s *CatalogService) ListTokens(ctx context.Context, filter domain.TokenFilter) ([]domain.Token, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	if filter.ChainID != "" {
+		filter.ChainID = string(normalizeChainID(filter.ChainID))
+	}
+	filter.ContractAddress = strings.ToLower(filter.ContractAddress)
+	if filter.Sort == "" {
+		filter.Sort, filter.Descending = domain.TokenSortCreatedAt, true
+	}
+	if !filter.Sort.Valid() {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("unsupported token sort %q", filter.Sort))
+	}
+	if err := validatePriceRange("price", filter.Price); err != nil {
+		return nil, err
+	}
+
+	traits, err := normalizeTraitFilters(filter.Traits)
+	if err != nil {
+		return nil, err
+	}
+	filter.Traits = traits
+
+	tokens, err := s.collectionRepo.ListTokens(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachRentals(ctx, tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func validatePriceRange(field string, r domain.PriceRange) error {
+	if (r.Min != nil && r.Min.Sign() < 0) || (r.Max != nil && r.Max.Sign() < 0) {
+		return domain.ErrInvalidInput.WithMessage(field + " cannot be negative")
+	}
+	if r.Min != nil && r.Max != nil && r.Min.Cmp(r.Max) > 0 {
+		return domain.ErrInvalidInput.WithMessage(field + " min is above max")
+	}
+	return nil
+}
+
+// normalizeTraitFilters trims and lower-cases names and values to match the stored
+// normalized forms, merging filters on the same trait
+func normalizeTraitFilters(filters []domain.TraitFilter) ([]domain.TraitFilter, error) {
+	if len(filters) > maxTraitFilters {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("at most %d trait filters", maxTraitFilters))
+	}
+
+	var out []domain.TraitFilter
+	index := make(map[string]int, len(filters))
+	for _, f := range filters {
+		name := strings.ToLower(strings.TrimSpace(f.Name))
+		if name == "" {
+			return nil, domain.ErrInvalidInput.WithMessage("trait filter needs a name")
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(out)
+			index[name] = i
+			out = append(out, domain.TraitFilter{Name: name})
+		}
+		for _, v := range f.Values {
+			if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+				out[i].Values = append(out[i].Values, v)
+			}
+		}
+	}
+
+	for _, f := range out {
+		if len(f.Values) == 0 {
+			return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("trait filter %q needs a value", f.Name))
+		}
+		if len(f.Values) > maxTraitFilterValues {
+			return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("trait filter %q has more than %d values", f.Name, maxTraitFilterValues))
+		}
+	}
+	return out, nil
+}
+
+// FlagItem records a moderation flag. Admin flags hide the item from public queries;
+// report intake only sets the "reported" badge and never downgrades an admin flag.
+func (s *CatalogService) FlagItem(ctx context.Context, in domain.FlagItemInput) (*domain.ModerationFlag, error) {
+	if in.ChainID == "" || in.ContractAddress == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	source := in.Source
+	if source == "" {
+		source = domain.ModerationSourceAdmin
+	}
+
+	var status domain.ModerationStatus
+	switch source {
+	case domain.ModerationSourceAdmin:
+		status = domain.ModerationFlagged
+	case domain.ModerationSourceReport:
+		status = domain.ModerationReported
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+
+	reason := in.Reason
+	if reason == "" {
+		reason = "other"
+	}
+
+	chainID := normalizeChainID(in.ChainID)
+	contract := domain.Address(strings.ToLower(in.ContractAddress))
+
+	existing, err := s.moderationRepo.Get(ctx, chainID, contract, in.TokenID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to load moderation flag: %w", err)
+	}
+	if status == domain.ModerationReported && existing.Status == domain.ModerationFlagged {
+		return &existing, nil
+	}
+
+	flag, err := s.moderationRepo.Upsert(ctx, domain.ModerationFlag{
+		ID:              existing.ID,
+		ChainID:         string(chainID),
+		ContractAddress: string(contract),
+		TokenID:         in.TokenID,
+		Status:          status,
+		Reason:          reason,
+		Note:            in.Note,
+		Source:          source,
+		ActorID:         in.ActorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publishModerationEvent(ctx, "catalog.item_flagged", &flag); err != nil {
+		return nil, fmt.Errorf("failed to publish domain event: %w", err)
+	}
+
+	return &flag, nil
+}
+
+// UnflagItem clears a moderation flag so the item shows up in public queries again
+func (s *CatalogService) UnflagItem(ctx context.Context, in domain.UnflagItemInput) (*domain.ModerationFlag, error) {
+	if in.ChainID == "" || in.ContractAddress == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	chainID := normalizeChainID(in.ChainID)
+	contract := domain.Address(strings.ToLower(in.ContractAddress))
+
+	existing, err := s.moderationRepo.Get(ctx, chainID, contract, in.TokenID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to load moderation flag: %w", err)
+	}
+
+	existing.Status = domain.ModerationCleared
+	existing.Source = domain.ModerationSourceAdmin
+	existing.Note = in.Note
+	existing.ActorID = in.ActorID
+
+	flag, err := s.moderationRepo.Upsert(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publishModerationEvent(ctx, "catalog.item_unflagged", &flag); err != nil {
+		return nil, fmt.Errorf("failed to publish domain event: %w", err)
+	}
+
+	return &flag, nil
+}
+
+// publishModerationEvent publishes a flag change so search and cache layers invalidate the item
+func (s *CatalogService) publishModerationEvent(ctx context.Context, eventType string, flag *domain.ModerationFlag) error {
+	domainEvent := &domain.DomainEvent{
+		Schema:      "marketplace.domain.v1",
+		Version:     "1.0",
+		EventID:     fmt.Sprintf("%s_%s_%d", eventType, flag.ID, time.Now().UnixNano()),
+		EventType:   eventType,
+		AggregateID: flag.ID,
+		ChainID:     flag.ChainID,
+		Data: map[string]interface{}{
+			"chain_id":         flag.ChainID,
+			"contract_address": flag.ContractAddress,
+			"token_id":         flag.TokenID,
+			"status":           flag.Status,
+			"reason":           flag.Reason,
+			"source":           flag.Source,
+			"hidden":           flag.Status == domain.ModerationFlagged,
+			"updated_at":       flag.UpdatedAt,
+		},
+		Timestamp: time.Now(),
+	}
+
+	return s.publisher.PublishDomainEvent(ctx, domainEvent)
+}
+
+// normalizeChainID maps CAIP-2 ids (eip155:1) to the indexer form stored by the catalog (eip155-1)
+func normalizeChainID(chainID string) domain.ChainID {
+	return domain.ChainID(strings.ReplaceAll(chainID, ":", "-"))
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// SetCollectionOrganization records which organization manages a collection. The gateway
+// checks that the actor is the collection creator and an owner or admin of the organization.
+func (s *CatalogService) SetCollectionOrganization(ctx context.Context, chainID domain.ChainID, contract domain.Address, orgID, actorID string) (*domain.Collection, error) {
+	if chainID == "" || contract == "" || actorID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if orgID != "" {
+		if _, err := uuid.Parse(orgID); err != nil {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+	chainID = normalizeChainID(string(chainID))
+	contract = domain.Address(strings.ToLower(string(contract)))
+
+	if err := s.collectionRepo.SetOwnerOrg(ctx, chainID, contract, orgID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to set collection organization: %w", err)
+	}
+
+	log.Printf("audit|event=collection_org_set|chain_id=%s|contract=%s|org_id=%s|actor_id=%s|timestamp=%s",
+		chainID, contract, orgID, actorID, time.Now().UTC().Format(time.RFC3339Nano))
+
+	return s.GetCollection(ctx, chainID, contract, true, true)
+}
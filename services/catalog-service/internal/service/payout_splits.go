@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// SetPayoutSplits enables tracking of collections' on-chain payout splits; without it
+// split updates are skipped and collections carry no split
+func (s *CatalogService) SetPayoutSplits(repo domain.PayoutSplitRepository) {
+	s.payoutSplitRepo = repo
+}
+
+// loadPayoutSplits attaches the collection's payout split when splits are tracked
+func (s *CatalogService) loadPayoutSplits(ctx context.Context, collection *domain.Collection) error {
+	if s.payoutSplitRepo == nil {
+		return nil
+	}
+	splits, err := s.payoutSplitRepo.List(ctx, domain.ChainID(collection.ChainID), domain.Address(collection.ContractAddress))
+	if err != nil {
+		return fmt.Errorf("failed to load payout splits: %w", err)
+	}
+	collection.PayoutSplits = splits
+	return nil
+}
+
+// applyPayoutSplits stores the split a collection emitted. Splits are kept apart from the
+// collection row, so an update may arrive before the collection is indexed.
+func (s *CatalogService) applyPayoutSplits(ctx context.Context, evt *domain.CollectionEvent, contract string) error {
+	if s.payoutSplitRepo == nil {
+		log.Printf("Payout split update for %s on %s skipped: splits are not tracked", contract, evt.ChainID)
+		return nil
+	}
+	raw, _ := evt.Data["payout_splits"].([]interface{})
+	splits := make([]domain.PayoutSplit, 0, len(raw))
+	for _, entry := range raw {
+		fields, _ := entry.(map[string]interface{})
+		recipient, _ := fields["recipient"].(string)
+		bpsStr, _ := fields["bps"].(string)
+		bps, err := strconv.ParseUint(bpsStr, 10, 16)
+		if recipient == "" || err != nil {
+			return fmt.Errorf("%w: malformed payout split %v", domain.ErrInvalidInput, entry)
+		}
+		splits = append(splits, domain.PayoutSplit{Recipient: strings.ToLower(recipient), Bps: bps})
+	}
+
+	chainID := normalizeChainID(evt.ChainID)
+	if err := s.payoutSplitRepo.Replace(ctx, chainID, domain.Address(strings.ToLower(contract)), splits); err != nil {
+		return fmt.Errorf("failed to store payout splits for %s: %w", contract, err)
+	}
+	return nil
+}
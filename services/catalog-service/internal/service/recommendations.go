@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// Related collections per request and per collection
+const (
+	DefaultRelatedLimit = 10
+	MaxRelatedLimit     = 20
+	// relatedPerCollection is how many are stored per collection, so hidden ones can be
+	// skipped and a full rail still returned
+	relatedPerCollection = 2 * MaxRelatedLimit
+)
+
+// Bounds of a collection's tags
+const (
+	maxCollectionTags = 10
+	maxTagLength      = 32
+)
+
+// DefaultSimilarityWeights favor shared holders, the strongest signal of overlapping audiences
+var DefaultSimilarityWeights = domain.SimilarityWeights{Holders: 0.6, Category: 0.25, Tags: 0.15}
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// SetRecommendations enables related collections, scored with weights; zero weights use
+// DefaultSimilarityWeights
+func (s *CatalogService) SetRecommendations(repo domain.RecommendationRepository, weights domain.SimilarityWeights) {
+	if weights == (domain.SimilarityWeights{}) {
+		weights = DefaultSimilarityWeights
+	}
+	s.recommendationRepo = repo
+	s.similarityWeights = weights
+}
+
+// RunRecommendations refreshes the related collections at startup and then every interval
+func (s *CatalogService) RunRecommendations(ctx context.Context, interval time.Duration) {
+	if s.recommendationRepo == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RefreshRecommendations(ctx); err != nil {
+			log.Printf("Related collection refresh failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RefreshRecommendations recomputes the related collections of every collection
+func (s *CatalogService) RefreshRecommendations(ctx context.Context) error {
+	if s.recommendationRepo == nil {
+		return nil
+	}
+	started := time.Now()
+	stored, err := s.recommendationRepo.Refresh(ctx, s.similarityWeights, relatedPerCollection)
+	if err != nil {
+		return err
+	}
+	log.Printf("Refreshed related collections: %d pairs in %s", stored, time.Since(started).Round(time.Millisecond))
+	return nil
+}
+
+// ListRelatedCollections returns the collections most similar to the one at slug. Flagged
+// and unconfirmed collections are skipped, like in public listings.
+func (s *CatalogService) ListRelatedCollections(ctx context.Context, slug string, limit int) ([]domain.Collection, error) {
+	if s.recommendationRepo == nil {
+		return nil, domain.ErrUnavailable.WithMessage("related collections are disabled")
+	}
+	if limit <= 0 {
+		limit = DefaultRelatedLimit
+	}
+	if limit > MaxRelatedLimit {
+		limit = MaxRelatedLimit
+	}
+
+	collection, err := s.GetCollectionBySlug(ctx, slug, false, false)
+	if err != nil {
+		return nil, err
+	}
+	related, err := s.recommendationRepo.ListRelated(ctx, collection.ID, relatedPerCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make([]domain.Collection, 0, limit)
+	for _, rc := range related {
+		if len(collections) == limit {
+			break
+		}
+		c, err := s.GetCollection(ctx, domain.ChainID(rc.ChainID), domain.Address(rc.ContractAddress), false, false)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, *c)
+	}
+	return collections, nil
+}
+
+// SetCollectionClassification replaces the category and tags of a collection. Tags are
+// lowercased with spaces turned into dashes, so "Pixel Art" and "pixel-art" match. The
+// gateway checks that the actor created or manages the collection.
+func (s *CatalogService) SetCollectionClassification(ctx context.Context, in domain.SetCollectionClassificationInput) (*domain.Collection, error) {
+	if in.ChainID == "" || in.ContractAddress == "" || in.ActorID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	category := strings.ToLower(strings.TrimSpace(in.Category))
+	if category != "" && !domain.ValidCategory(category) {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("unknown category %q", in.Category))
+	}
+	tags, err := normalizeTags(in.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := normalizeChainID(string(in.ChainID))
+	contract := domain.Address(strings.ToLower(string(in.ContractAddress)))
+	if err := s.collectionRepo.SetClassification(ctx, chainID, contract, category, tags); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	log.Printf("audit|event=collection_classification_set|chain_id=%s|contract=%s|category=%s|tags=%s|actor_id=%s|timestamp=%s",
+		chainID, contract, category, strings.Join(tags, ","), in.ActorID, time.Now().UTC().Format(time.RFC3339Nano))
+
+	return s.GetCollection(ctx, chainID, contract, true, true)
+}
+
+func normalizeTags(raw []string) ([]string, error) {
+	tags := make([]string, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for _, tag := range raw {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len(tag) > maxTagLength || !tagPattern.MatchString(tag) {
+			return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("invalid tag %q: use up to %d letters, digits and dashes", tag, maxTagLength))
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxCollectionTags {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("collections may have at most %d tags", maxCollectionTags))
+	}
+	return tags, nil
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// Decoded rental events: ERC-4907 UpdateUser and ERC-5006 user records
+const (
+	decodedUpdateUser       = "update_user"
+	decodedCreateUserRecord = "create_user_record"
+	decodedDeleteUserRecord = "delete_user_record"
+)
+
+// SetRentals enables ERC-4907/5006 rental indexing
+func (s *CatalogService) SetRentals(repo domain.RentalRepository) {
+	s.rentalRepo = repo
+}
+
+// handleRentalEvent folds a decoded rental event into the token's rental state. New
+// renters are announced with rental.started, and rental.expired is scheduled for the
+// expiry, or published right away when the rental is cleared or taken over early.
+func (s *CatalogService) handleRentalEvent(ctx context.Context, evt *domain.CollectionEvent) error {
+	if s.rentalRepo == nil {
+		return nil
+	}
+	args, ok := evt.Data["args"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s event %s has no args", evt.EventType, evt.EventID)
+	}
+	block, ok := uint64FromData(evt.Data, "block_number")
+	if !ok {
+		// Without a block, events of a token can't be ordered
+		log.Printf("Rental event %s has no block number, not indexing it", evt.EventID)
+		return nil
+	}
+	logIndex, _ := uint64FromData(evt.Data, "log_index")
+
+	chainID := string(normalizeChainID(evt.ChainID))
+	contract := strings.ToLower(evt.Contract)
+	rental, err := s.rentalFromEvent(ctx, evt.EventType, chainID, contract, args)
+	if errors.Is(err, domain.ErrNotFound) {
+		log.Printf("Rental event %s deletes an unindexed record, skipping it", evt.EventID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s event %s: %w", evt.EventType, evt.EventID, err)
+	}
+	rental.BlockNumber = block
+	rental.LogIndex = int(logIndex)
+
+	previous, applied, err := s.rentalRepo.Apply(ctx, rental)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nil
+	}
+
+	now := time.Now()
+	active := rental.Active(now)
+	previousActive := previous != nil && previous.Active(now)
+	if previousActive && (!active || previous.Renter != rental.Renter) {
+		if err := s.publishRentalEvent(ctx, domain.JobRentalExpired, *previous, rentalExpiryEventID(*previous), ""); err != nil {
+			return err
+		}
+	}
+
+	jobID := rentalJobID(rental)
+	if !active {
+		return s.schedulerRepo.Cancel(ctx, jobID)
+	}
+	// Renewals by the same renter only move the expiry
+	if !previousActive || previous.Renter != rental.Renter {
+		if err := s.publishRentalEvent(ctx, domain.EventRentalStarted, rental, "rental_started_"+evt.EventID, evt.TxHash); err != nil {
+			return err
+		}
+	}
+	return s.schedulerRepo.Schedule(ctx, domain.ScheduledJob{
+		ID:              jobID,
+		Kind:            domain.JobRentalExpired,
+		ChainID:         chainID,
+		ContractAddress: contract,
+		TokenID:         rental.TokenID,
+		SubjectID:       rental.RecordID,
+		Recipients:      appendRecipients(nil, rental.Renter, rental.Owner),
+		EndsAt:          rental.ExpiresAt,
+		FireAt:          rental.ExpiresAt,
+	})
+}
+
+// rentalFromEvent reads the rental state an event sets. A zero user or expiry clears it.
+func (s *CatalogService) rentalFromEvent(ctx context.Context, eventType, chainID, contract string, args map[string]interface{}) (domain.Rental, error) {
+	rental := domain.Rental{ChainID: chainID, ContractAddress: contract}
+
+	switch eventType {
+	case decodedUpdateUser:
+		tokenID, ok := new(big.Int).SetString(stringFromData(args, "tokenId"), 10)
+		if !ok {
+			return rental, fmt.Errorf("invalid token id %v", args["tokenId"])
+		}
+		expires, _ := uint64FromData(args, "expires")
+		rental.Standard = domain.RentalERC4907
+		rental.TokenID = tokenID.String()
+		rental.Amount = big.NewInt(1)
+		rental.Renter = strings.ToLower(stringFromData(args, "user"))
+		rental.ExpiresAt = time.Unix(int64(expires), 0).UTC()
+
+	case decodedCreateUserRecord:
+		recordID, ok := new(big.Int).SetString(stringFromData(args, "recordId"), 10)
+		if !ok {
+			return rental, fmt.Errorf("invalid record id %v", args["recordId"])
+		}
+		tokenID, ok := new(big.Int).SetString(stringFromData(args, "tokenId"), 10)
+		if !ok {
+			return rental, fmt.Errorf("invalid token id %v", args["tokenId"])
+		}
+		amount, ok := new(big.Int).SetString(stringFromData(args, "amount"), 10)
+		if !ok || amount.Sign() < 0 {
+			return rental, fmt.Errorf("invalid amount %v", args["amount"])
+		}
+		expiry, _ := uint64FromData(args, "expiry")
+		rental.Standard = domain.RentalERC5006
+		rental.RecordID = recordID.String()
+		rental.TokenID = tokenID.String()
+		rental.Amount = amount
+		rental.Owner = strings.ToLower(stringFromData(args, "owner"))
+		rental.Renter = strings.ToLower(stringFromData(args, "user"))
+		rental.ExpiresAt = time.Unix(int64(expiry), 0).UTC()
+
+	case decodedDeleteUserRecord:
+		recordID, ok := new(big.Int).SetString(stringFromData(args, "recordId"), 10)
+		if !ok {
+			return rental, fmt.Errorf("invalid record id %v", args["recordId"])
+		}
+		existing, err := s.rentalRepo.GetRecord(ctx, chainID, contract, recordID.String())
+		if err != nil {
+			return rental, err
+		}
+		rental = existing
+		rental.Renter = ""
+
+	default:
+		return rental, fmt.Errorf("unsupported rental event")
+	}
+
+	if rental.Renter == zeroAddress || rental.Renter == "" || rental.ExpiresAt.Unix() <= 0 {
+		rental.Renter = ""
+		rental.ExpiresAt = time.Time{}
+	}
+	return rental, nil
+}
+
+// publishRentalExpiry publishes a scheduled rental.expired unless the rental was cleared
+// or renewed since it was scheduled
+func (s *CatalogService) publishRentalExpiry(ctx context.Context, job domain.ScheduledJob) error {
+	if s.rentalRepo == nil {
+		return nil
+	}
+	rental, err := s.rentalRepo.Get(ctx, job.ChainID, job.ContractAddress, job.TokenID, job.SubjectID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rental.Renter == "" || rental.ExpiresAt.Unix() != job.EndsAt.Unix() {
+		return nil
+	}
+	return s.publishRentalEvent(ctx, domain.JobRentalExpired, rental, rentalExpiryEventID(rental), "")
+}
+
+func (s *CatalogService) publishRentalEvent(ctx context.Context, eventType string, rental domain.Rental, eventID, txHash string) error {
+	data := map[string]interface{}{
+		"chain_id":         rental.ChainID,
+		"contract_address": rental.ContractAddress,
+		"token_id":         rental.TokenID,
+		"standard":         rental.Standard,
+		"renter":           rental.Renter,
+		"amount":           rental.Amount.String(),
+		"expires_at":       rental.ExpiresAt,
+	}
+	if rental.RecordID != "" {
+		data["record_id"] = rental.RecordID
+		data["owner"] = rental.Owner
+	}
+	if txHash != "" {
+		data["tx_hash"] = txHash
+	}
+
+	domainEvent := &domain.DomainEvent{
+		Schema:      "marketplace.domain.v1",
+		Version:     "1.0",
+		EventID:     eventID,
+		EventType:   eventType,
+		AggregateID: fmt.Sprintf("%s/%s/%s", rental.ChainID, rental.ContractAddress, rental.TokenID),
+		ChainID:     rental.ChainID,
+		Data:        data,
+		Timestamp:   time.Now(),
+	}
+	if err := s.publisher.PublishDomainEvent(ctx, domainEvent); err != nil {
+		return fmt.Errorf("failed to publish %s: %w", eventType, err)
+	}
+	return nil
+}
+
+// attachRentals sets the rentals in effect on tokens
+func (s *CatalogService) attachRentals(ctx context.Context, tokens []domain.Token) error {
+	if s.rentalRepo == nil || len(tokens) == 0 {
+		return nil
+	}
+	refs := make([]domain.TokenRef, len(tokens))
+	for i, t := range tokens {
+		refs[i] = domain.TokenRef{ChainID: t.ChainID, ContractAddress: t.ContractAddress, TokenID: t.TokenID}
+	}
+	rentals, err := s.rentalRepo.ListActive(ctx, refs, time.Now())
+	if err != nil {
+		return err
+	}
+
+	byToken := make(map[domain.TokenRef][]domain.Rental)
+	for _, r := range rentals {
+		ref := domain.TokenRef{ChainID: r.ChainID, ContractAddress: r.ContractAddress, TokenID: r.TokenID}
+		byToken[ref] = append(byToken[ref], r)
+	}
+	for i := range tokens {
+		tokens[i].Rentals = byToken[refs[i]]
+	}
+	return nil
+}
+
+// rentalJobID keys the expiry alert of a token's ERC-4907 user or of an ERC-5006 record
+func rentalJobID(r domain.Rental) string {
+	return fmt.Sprintf("rental:%s:%s/%s/%s", r.ChainID, r.ContractAddress, r.TokenID, r.RecordID)
+}
+
+// rentalExpiryEventID is stable per rental term, so an expiry is announced once
+func rentalExpiryEventID(r domain.Rental) string {
+	return fmt.Sprintf("rental_expired_%s_%s_%s_%s_%d", r.ChainID, r.ContractAddress, r.TokenID, r.RecordID, r.ExpiresAt.Unix())
+}
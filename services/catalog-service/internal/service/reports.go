@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	defaultReportLimit  = 10
+	defaultReportWindow = time.Hour
+
+	maxReportDetailsLength = 2000
+)
+
+// SetReportRateLimit overrides how many reports a user may file per window
+func (s *CatalogService) SetReportRateLimit(limit int, window time.Duration) {
+	if limit > 0 {
+		s.reportLimit = limit
+	}
+	if window > 0 {
+		s.reportWindow = window
+	}
+}
+
+// ReportContent files a user report. Repeated open reports from the same user on the
+// same target collapse into one; new reports put the "reported" badge on the item.
+func (s *CatalogService) ReportContent(ctx context.Context, in domain.ReportContentInput) (*domain.Report, bool, error) {
+	if in.ReporterID == "" || in.Reason == "" || len(in.Details) > maxReportDetailsLength {
+		return nil, false, domain.ErrInvalidInput
+	}
+
+	target, err := parseReportTarget(in.TargetType, in.TargetID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	count, err := s.reportsRepo.CountByReporterSince(ctx, in.ReporterID, time.Now().Add(-s.reportWindow))
+	if err != nil {
+		return nil, false, err
+	}
+	if count >= s.reportLimit {
+		return nil, false, domain.ErrRateLimited
+	}
+
+	report, created, err := s.reportsRepo.Create(ctx, domain.Report{
+		TargetType: in.TargetType,
+		TargetID:   in.TargetID,
+		ReporterID: in.ReporterID,
+		Reason:     in.Reason,
+		Details:    in.Details,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if created {
+		if _, err := s.FlagItem(ctx, domain.FlagItemInput{
+			ChainID:         target.ChainID,
+			ContractAddress: target.ContractAddress,
+			TokenID:         target.TokenID,
+			Reason:          in.Reason,
+			Source:          domain.ModerationSourceReport,
+			ActorID:         in.ReporterID,
+		}); err != nil {
+			return nil, false, fmt.Errorf("failed to mark item as reported: %w", err)
+		}
+	}
+
+	return &report, !created, nil
+}
+
+// ListReportQueue returns open reports grouped by target, most reported first
+func (s *CatalogService) ListReportQueue(ctx context.Context, limit, offset int) ([]domain.ReportQueueItem, error) {
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.reportsRepo.ListOpenQueue(ctx, limit, offset)
+}
+
+// ResolveReports closes every open report on a target. Upholding flags the item so it is
+// hidden from public queries; dismissing clears the "reported" badge unless an admin flag exists.
+func (s *CatalogService) ResolveReports(ctx context.Context, in domain.ResolveReportsInput) (int, *domain.ModerationFlag, error) {
+	target, err := parseReportTarget(in.TargetType, in.TargetID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var status domain.ReportStatus
+	switch in.Action {
+	case domain.ReportActionUphold:
+		status = domain.ReportUpheld
+	case domain.ReportActionDismiss:
+		status = domain.ReportDismissed
+	default:
+		return 0, nil, domain.ErrInvalidInput
+	}
+
+	reports, err := s.reportsRepo.ResolveOpen(ctx, in.TargetType, in.TargetID, status, in.ActorID, in.Note)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(reports) == 0 {
+		return 0, nil, domain.ErrNotFound
+	}
+
+	if status == domain.ReportUpheld {
+		flag, err := s.FlagItem(ctx, domain.FlagItemInput{
+			ChainID:         target.ChainID,
+			ContractAddress: target.ContractAddress,
+			TokenID:         target.TokenID,
+			Reason:          reports[0].Reason,
+			Note:            in.Note,
+			Source:          domain.ModerationSourceAdmin,
+			ActorID:         in.ActorID,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return len(reports), flag, nil
+	}
+
+	existing, err := s.moderationRepo.Get(ctx, normalizeChainID(target.ChainID), domain.Address(strings.ToLower(target.ContractAddress)), target.TokenID)
+	if err != nil || existing.Status != domain.ModerationReported {
+		// Nothing to clear (never badged, or an admin flag takes precedence)
+		return len(reports), nil, nil
+	}
+
+	flag, err := s.UnflagItem(ctx, domain.UnflagItemInput{
+		ChainID:         target.ChainID,
+		ContractAddress: target.ContractAddress,
+		TokenID:         target.TokenID,
+		Note:            in.Note,
+		ActorID:         in.ActorID,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return len(reports), flag, nil
+}
+
+type reportTarget struct {
+	ChainID         string
+	ContractAddress string
+	TokenID         string
+}
+
+// parseReportTarget splits <chain_id>/<contract>[/<token_id>] according to the target type
+func parseReportTarget(targetType, targetID string) (reportTarget, error) {
+	parts := strings.Split(targetID, "/")
+	for _, part := range parts {
+		if part == "" {
+			return reportTarget{}, domain.ErrInvalidInput
+		}
+	}
+
+	switch {
+	case targetType == domain.ReportTargetCollection && len(parts) == 2:
+		return reportTarget{ChainID: parts[0], ContractAddress: parts[1]}, nil
+	case targetType == domain.ReportTargetToken && len(parts) == 3:
+		return reportTarget{ChainID: parts[0], ContractAddress: parts[1], TokenID: parts[2]}, nil
+	default:
+		return reportTarget{}, domain.ErrInvalidInput
+	}
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	// DefaultResyncSample is how many tokens a resync checks when not told otherwise
+	DefaultResyncSample = 100
+	// MaxResyncSample bounds the RPC calls one resync makes
+	MaxResyncSample = 1000
+)
+
+// SetResync enables collection resyncs, reading the chain through reader
+func (s *CatalogService) SetResync(repo domain.ResyncRepository, reader domain.ChainReader) {
+	s.resyncRepo = repo
+	s.chainReader = reader
+}
+
+// ResyncCollection compares a collection with the chain at its current head: totalSupply of
+// ERC-721 collections, contractURI, and the owners or balances of a random sample of indexed
+// tokens. ERC-1155 balances are checked for the holders the catalog knows, so a holder it
+// never saw is not found. Transfers still being indexed show up as drift, which is why
+// repairing is a separate choice: it records transfers that move the ownership index to
+// the chain's state, so later holder snapshots see it too.
+func (s *CatalogService) ResyncCollection(ctx context.Context, in domain.ResyncCollectionInput) (*domain.ResyncReport, error) {
+	if s.resyncRepo == nil || s.chainReader == nil {
+		return nil, domain.ErrUnavailable.WithMessage("collection resync is disabled")
+	}
+	if in.RequestedBy == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	sample := in.SampleSize
+	if sample == 0 {
+		sample = DefaultResyncSample
+	}
+	if sample < 0 || sample > MaxResyncSample {
+		return nil, domain.ErrInvalidInput.WithMessage(fmt.Sprintf("sample size must be between 1 and %d", MaxResyncSample))
+	}
+
+	collection, err := s.GetCollection(ctx, in.ChainID, in.Contract, true, true)
+	if err != nil {
+		return nil, err
+	}
+	chainID := collection.ChainID
+	contract := strings.ToLower(collection.ContractAddress)
+
+	block, err := s.chainReader.BlockNumber(ctx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain head: %w", err)
+	}
+	report := &domain.ResyncReport{
+		ID:          uuid.New().String(),
+		ChainID:     chainID,
+		Contract:    contract,
+		Standard:    strings.ToUpper(collection.CollectionType),
+		BlockNumber: block,
+		RequestedBy: in.RequestedBy,
+		CheckedAt:   time.Now(),
+	}
+	repair := domain.CollectionRepair{ChainID: chainID, Contract: contract}
+
+	if report.Standard == "ERC721" {
+		supply, ok, err := s.chainReader.TotalSupply(ctx, chainID, contract, block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read total supply: %w", err)
+		}
+		catalogSupply := collection.TotalSupply
+		if catalogSupply == nil {
+			catalogSupply = new(big.Int)
+		}
+		if ok && supply.Cmp(catalogSupply) != 0 {
+			report.Drifts = append(report.Drifts, domain.Drift{
+				Kind: domain.DriftTotalSupply, Catalog: catalogSupply.String(), Chain: supply.String(),
+			})
+			repair.TotalSupply = supply
+		}
+	}
+
+	uri, err := s.chainReader.ContractURI(ctx, chainID, contract, block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read contract uri: %w", err)
+	}
+	recorded, err := s.resyncRepo.GetContractURI(ctx, chainID, contract)
+	if err != nil {
+		return nil, err
+	}
+	if uri != recorded {
+		report.Drifts = append(report.Drifts, domain.Drift{Kind: domain.DriftContractURI, Catalog: recorded, Chain: uri})
+		repair.ContractURI = &uri
+	}
+
+	if report.Standard == "ERC721" || report.Standard == "ERC1155" {
+		holdings, err := s.resyncRepo.SampleHoldings(ctx, chainID, contract, sample)
+		if err != nil {
+			return nil, err
+		}
+		report.TokensSampled = len(holdings)
+		for _, h := range holdings {
+			want, drifts, err := s.chainHoldings(ctx, report, h)
+			if err != nil {
+				return nil, err
+			}
+			if len(drifts) == 0 {
+				continue
+			}
+			report.Drifts = append(report.Drifts, drifts...)
+			repair.Transfers = append(repair.Transfers, reconcileHoldings(report, h, want, len(repair.Transfers))...)
+		}
+	}
+
+	if in.Repair && len(report.Drifts) > 0 {
+		if err := s.resyncRepo.ApplyRepair(ctx, repair); err != nil {
+			return nil, fmt.Errorf("failed to repair collection: %w", err)
+		}
+		report.Repaired = true
+	}
+
+	log.Printf("audit|event=collection_resync|resync_id=%s|chain_id=%s|contract=%s|requested_by=%s|block=%d|sampled=%d|drifts=%d|repaired=%t|timestamp=%s",
+		report.ID, chainID, contract, in.RequestedBy, block, report.TokensSampled, len(report.Drifts), report.Repaired,
+		report.CheckedAt.UTC().Format(time.RFC3339Nano))
+	return report, nil
+}
+
+// chainHoldings reads what the chain says about the holders of a sampled token and
+// returns it with the drifts from the ownership index
+func (s *CatalogService) chainHoldings(ctx context.Context, report *domain.ResyncReport, h domain.TokenHoldings) (map[string]*big.Int, []domain.Drift, error) {
+	want := make(map[string]*big.Int)
+	holders := sortedHolders(h.Holders)
+
+	if report.Standard == "ERC721" {
+		owner, err := s.chainReader.OwnerOf(ctx, report.ChainID, report.Contract, h.TokenID, report.BlockNumber)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read owner of token %s: %w", h.TokenID, err)
+		}
+		if owner == zeroAddress {
+			owner = ""
+		}
+		if owner == "" && len(holders) == 0 {
+			return want, nil, nil
+		}
+		if owner != "" {
+			want[owner] = big.NewInt(1)
+			if held := h.Holders[owner]; len(holders) == 1 && held != nil && held.Cmp(want[owner]) == 0 {
+				return want, nil, nil
+			}
+		}
+		return want, []domain.Drift{{
+			Kind: domain.DriftOwner, TokenID: h.TokenID, Catalog: strings.Join(holders, ","), Chain: owner,
+		}}, nil
+	}
+
+	var drifts []domain.Drift
+	for _, holder := range holders {
+		balance, err := s.chainReader.BalanceOf(ctx, report.ChainID, report.Contract, holder, h.TokenID, report.BlockNumber)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read balance of %s for token %s: %w", holder, h.TokenID, err)
+		}
+		want[holder] = balance
+		if balance.Cmp(h.Holders[holder]) != 0 {
+			drifts = append(drifts, domain.Drift{
+				Kind: domain.DriftBalance, TokenID: h.TokenID, Holder: holder,
+				Catalog: h.Holders[holder].String(), Chain: balance.String(),
+			})
+		}
+	}
+	return want, drifts, nil
+}
+
+// reconcileHoldings returns the transfers that take a token from the indexed holders to the
+// wanted ones, minting and burning through the zero address. They share a resync tx hash,
+// numbered from logIndex.
+func reconcileHoldings(report *domain.ResyncReport, h domain.TokenHoldings, want map[string]*big.Int, logIndex int) []domain.OwnershipTransfer {
+	all := make(map[string]*big.Int, len(h.Holders)+len(want))
+	for holder := range h.Holders {
+		all[holder] = nil
+	}
+	for holder := range want {
+		all[holder] = nil
+	}
+
+	var transfers []domain.OwnershipTransfer
+	for _, holder := range sortedHolders(all) {
+		diff := new(big.Int)
+		if w := want[holder]; w != nil {
+			diff.Set(w)
+		}
+		if have := h.Holders[holder]; have != nil {
+			diff.Sub(diff, have)
+		}
+		if diff.Sign() == 0 {
+			continue
+		}
+		t := domain.OwnershipTransfer{
+			ChainID:     report.ChainID,
+			Contract:    report.Contract,
+			TokenID:     h.TokenID,
+			From:        zeroAddress,
+			To:          holder,
+			Quantity:    new(big.Int).Abs(diff),
+			TxHash:      "resync:" + report.ID,
+			LogIndex:    logIndex + len(transfers),
+			BlockNumber: report.BlockNumber,
+			At:          report.CheckedAt,
+		}
+		if diff.Sign() < 0 {
+			t.From, t.To = holder, zeroAddress
+		}
+		transfers = append(transfers, t)
+	}
+	return transfers
+}
+
+func sortedHolders(holders map[string]*big.Int) []string {
+	out := make([]string, 0, len(holders))
+	for holder := range holders {
+		out = append(out, holder)
+	}
+	sort.Strings(out)
+	return out
+}
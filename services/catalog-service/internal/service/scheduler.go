@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	defaultExpiryLead      = time.Hour
+	defaultSchedulerBatch  = 100
+	schedulerRetryInterval = 30 * time.Second
+)
+
+// SetExpiryLead overrides how long before an offer or listing expires the
+// expiring_soon alert fires
+func (s *CatalogService) SetExpiryLead(lead time.Duration) {
+	if lead > 0 {
+		s.expiryLead = lead
+	}
+}
+
+// HandleMarketEvent keeps the alert schedule in sync with offer, listing and auction
+// lifecycle events (offer_created, listing_cancelled, auction_extended, ...).
+// Auction events also update the stored auction state.
+func (s *CatalogService) HandleMarketEvent(ctx context.Context, evt *domain.CollectionEvent) error {
+	subject, action, ok := strings.Cut(evt.EventType, "_")
+	if !ok {
+		return fmt.Errorf("unsupported market event: %s", evt.EventType)
+	}
+
+	chainID := string(normalizeChainID(evt.ChainID))
+	subjectID, _ := evt.Data[subject+"_id"].(string)
+	if subjectID == "" {
+		return fmt.Errorf("%s event %s has no %s_id", subject, evt.EventID, subject)
+	}
+	id := fmt.Sprintf("%s:%s:%s", subject, chainID, subjectID)
+
+	switch subject {
+	case "offer", "listing":
+		switch action {
+		case "created", "updated":
+			return s.scheduleExpiry(ctx, id, subject, subjectID, chainID, evt)
+		default: // accepted, cancelled, filled, expired
+			return s.schedulerRepo.Cancel(ctx, id)
+		}
+
+	case "auction":
+		if err := s.applyAuctionEvent(ctx, chainID, subjectID, action, evt); err != nil {
+			return err
+		}
+		switch action {
+		case "created", "bid", "extended":
+			return s.scheduleAuctionEnd(ctx, id, subjectID, chainID, evt)
+		default: // settled, cancelled
+			return s.schedulerRepo.Cancel(ctx, id)
+		}
+
+	default:
+		return fmt.Errorf("unsupported market event: %s", evt.EventType)
+	}
+}
+
+func (s *CatalogService) scheduleExpiry(ctx context.Context, id, subject, subjectID, chainID string, evt *domain.CollectionEvent) error {
+	expiresAt, ok := timeFromData(evt.Data, "expires_at")
+	if !ok {
+		// Offers and listings without an expiry never need an alert
+		return s.schedulerRepo.Cancel(ctx, id)
+	}
+
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return s.schedulerRepo.Cancel(ctx, id)
+	}
+
+	fireAt := expiresAt.Add(-s.expiryLead)
+	if fireAt.Before(now) {
+		fireAt = now
+	}
+
+	kind := domain.JobOfferExpiringSoon
+	if subject == "listing" {
+		kind = domain.JobListingExpiringSoon
+	}
+
+	return s.schedulerRepo.Schedule(ctx, domain.ScheduledJob{
+		ID:              id,
+		Kind:            kind,
+		ChainID:         chainID,
+		ContractAddress: marketEventContract(evt),
+		TokenID:         stringFromData(evt.Data, "token_id"),
+		SubjectID:       subjectID,
+		Recipients:      appendRecipients(nil, stringFromData(evt.Data, "maker"), stringFromData(evt.Data, "owner")),
+		EndsAt:          expiresAt,
+		FireAt:          fireAt,
+	})
+}
+
+func (s *CatalogService) scheduleAuctionEnd(ctx context.Context, id, subjectID, chainID string, evt *domain.CollectionEvent) error {
+	job, err := s.schedulerRepo.Get(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		job = domain.ScheduledJob{
+			ID:              id,
+			Kind:            domain.JobAuctionEnded,
+			ChainID:         chainID,
+			ContractAddress: marketEventContract(evt),
+			TokenID:         stringFromData(evt.Data, "token_id"),
+			SubjectID:       subjectID,
+		}
+	} else if err != nil {
+		return err
+	}
+
+	// Bids and anti-sniping extensions may move the end time
+	if endTime, ok := timeFromData(evt.Data, "end_time"); ok {
+		job.EndsAt = endTime
+		job.FireAt = endTime
+	}
+	if job.FireAt.IsZero() {
+		return fmt.Errorf("auction event %s has no end_time", evt.EventID)
+	}
+
+	job.Recipients = appendRecipients(job.Recipients, stringFromData(evt.Data, "seller"), stringFromData(evt.Data, "bidder"))
+
+	return s.schedulerRepo.Schedule(ctx, job)
+}
+
+// RunScheduler fires due alerts every interval until ctx is cancelled
+func (s *CatalogService) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.FireDueAlerts(ctx, time.Now()); err != nil {
+				log.Printf("Scheduler tick failed: %v", err)
+			}
+		}
+	}
+}
+
+// FireDueAlerts publishes every alert due at now and returns how many were sent.
+// Alerts that fail to publish are retried shortly after.
+func (s *CatalogService) FireDueAlerts(ctx context.Context, now time.Time) (int, error) {
+	jobs, err := s.schedulerRepo.ClaimDue(ctx, now, defaultSchedulerBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	fired := 0
+	for _, job := range jobs {
+		publish := s.publishMarketAlert
+		if job.Kind == domain.JobRentalExpired {
+			publish = s.publishRentalExpiry
+		}
+		if err := publish(ctx, job); err != nil {
+			log.Printf("Failed to publish %s for %s: %v", job.Kind, job.ID, err)
+			job.FireAt = now.Add(schedulerRetryInterval)
+			if err := s.schedulerRepo.Schedule(ctx, job); err != nil {
+				log.Printf("Failed to reschedule %s: %v", job.ID, err)
+			}
+			continue
+		}
+		fired++
+	}
+
+	return fired, nil
+}
+
+func (s *CatalogService) publishMarketAlert(ctx context.Context, job domain.ScheduledJob) error {
+	data := map[string]interface{}{
+		"subject_id":       job.SubjectID,
+		"chain_id":         job.ChainID,
+		"contract_address": job.ContractAddress,
+		"token_id":         job.TokenID,
+		"recipients":       job.Recipients,
+		"ends_at":          job.EndsAt,
+	}
+	if job.Kind == domain.JobDropStartingSoon {
+		// A drop alert counts down to its start
+		delete(data, "ends_at")
+		data["starts_at"] = job.EndsAt
+	}
+
+	domainEvent := &domain.DomainEvent{
+		Schema:      "marketplace.domain.v1",
+		Version:     "1.0",
+		EventID:     fmt.Sprintf("%s_%s_%d", job.Kind, job.ID, job.EndsAt.Unix()),
+		EventType:   job.Kind,
+		AggregateID: job.SubjectID,
+		ChainID:     job.ChainID,
+		Data:        data,
+		Timestamp:   time.Now(),
+	}
+
+	return s.publisher.PublishDomainEvent(ctx, domainEvent)
+}
+
+func marketEventContract(evt *domain.CollectionEvent) string {
+	if collectionAddress, ok := evt.Data["collection_address"].(string); ok && collectionAddress != "" {
+		return strings.ToLower(collectionAddress)
+	}
+	return strings.ToLower(evt.Contract)
+}
+
+func stringFromData(data map[string]interface{}, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
+
+// timeFromData reads an RFC3339 string or unix seconds (string or number)
+func timeFromData(data map[string]interface{}, key string) (time.Time, bool) {
+	switch v := data[key].(type) {
+	case string:
+		if v == "" {
+			return time.Time{}, false
+		}
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t, true
+		}
+		if secs, err := strconv.ParseInt(v, 10, 64); err == nil && secs > 0 {
+			return time.Unix(secs, 0), true
+		}
+	case float64:
+		if v > 0 {
+			return time.Unix(int64(v), 0), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// appendRecipients adds lowercase addresses that are not already present
+func appendRecipients(recipients []string, addresses ...string) []string {
+	for _, addr := range addresses {
+		addr = strings.ToLower(strings.TrimSpace(addr))
+		if addr == "" {
+			continue
+		}
+		exists := false
+		for _, r := range recipients {
+			if r == addr {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			recipients = append(recipients, addr)
+		}
+	}
+	return recipients
+}
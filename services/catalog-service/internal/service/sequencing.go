@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// staleAggregateBatch is how many aggregates one release pass unblocks
+const staleAggregateBatch = 100
+
+// sequencing applies each aggregate's sequenced events in order
+type sequencing struct {
+	repo        domain.EventSequenceRepository
+	parkTimeout time.Duration
+	handlers    map[string]domain.CollectionEventHandler // by kind, for parked events
+
+	// An aggregate's events are applied one at a time; aggregates share a lock per stripe
+	locks [64]sync.Mutex
+
+	parked      atomic.Uint64
+	gapsSkipped atomic.Uint64
+}
+
+func (q *sequencing) lock(aggregateID string) func() {
+	h := fnv.New32a()
+	h.Write([]byte(aggregateID))
+	mu := &q.locks[h.Sum32()%uint32(len(q.locks))]
+	mu.Lock()
+	return mu.Unlock
+}
+
+// SetEventSequencing applies sequenced events in their aggregate's order. An event at or below
+// the aggregate's last applied sequence is dropped; one ahead of a gap is parked until the
+// events before it are applied, or for at most parkTimeout before the gap is skipped.
+func (s *CatalogService) SetEventSequencing(repo domain.EventSequenceRepository, parkTimeout time.Duration) {
+	s.sequencing = &sequencing{
+		repo:        repo,
+		parkTimeout: parkTimeout,
+		handlers:    make(map[string]domain.CollectionEventHandler),
+	}
+}
+
+// Sequenced wraps the handler of one kind of event so sequenced events reach it in order.
+// Register every handler before consuming starts; kind names it for events parked in between.
+func (s *CatalogService) Sequenced(kind string, handler domain.CollectionEventHandler) domain.CollectionEventHandler {
+	if s.sequencing == nil {
+		return handler
+	}
+	s.sequencing.handlers[kind] = handler
+	return func(ctx context.Context, evt *domain.CollectionEvent) error {
+		if evt.AggregateID == "" || evt.Sequence == 0 {
+			// Producers that do not stamp sequences yet
+			return handler(ctx, evt)
+		}
+		return s.applyInOrder(ctx, kind, handler, evt)
+	}
+}
+
+func (s *CatalogService) applyInOrder(ctx context.Context, kind string, handler domain.CollectionEventHandler, evt *domain.CollectionEvent) error {
+	q := s.sequencing
+	defer q.lock(evt.AggregateID)()
+
+	last, err := q.repo.LastApplied(ctx, evt.AggregateID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case evt.Sequence <= last:
+		s.dedup.add(evt.EventType, domain.DuplicateSequence)
+		log.Printf("Dropped %s event %s: %s is at sequence %d already, event has %d",
+			evt.EventType, evt.EventID, evt.AggregateID, last, evt.Sequence)
+		return nil
+	case evt.Sequence > last+1:
+		err := q.repo.Park(ctx, &domain.ParkedEvent{
+			AggregateID: evt.AggregateID,
+			Sequence:    evt.Sequence,
+			Kind:        kind,
+			Event:       evt,
+			ParkedAt:    time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		q.parked.Add(1)
+		log.Printf("Parked %s event %s: %s is at sequence %d, event has %d",
+			evt.EventType, evt.EventID, evt.AggregateID, last, evt.Sequence)
+		return nil
+	}
+
+	if err := handler(ctx, evt); err != nil {
+		return err
+	}
+	if err := q.repo.Advance(ctx, evt.AggregateID, evt.Sequence); err != nil {
+		return err
+	}
+
+	// The event is applied, so a parked one failing must not redeliver it; the release
+	// loop retries the parked event
+	if err := s.applyParked(ctx, evt.AggregateID, evt.Sequence, false); err != nil {
+		log.Printf("Failed to apply events parked behind %s #%d: %v", evt.AggregateID, evt.Sequence, err)
+	}
+	return nil
+}
+
+// applyParked applies the aggregate's parked events that follow last in sequence. With
+// skipGap set the first parked event is applied even if events before it never arrived.
+func (s *CatalogService) applyParked(ctx context.Context, aggregateID string, last uint64, skipGap bool) error {
+	q := s.sequencing
+	for {
+		parked, err := q.repo.NextParked(ctx, aggregateID)
+		if err != nil || parked == nil {
+			return err
+		}
+
+		if parked.Sequence <= last {
+			// Applied while it waited, through a redelivery
+			if err := q.repo.Unpark(ctx, aggregateID, parked.Sequence); err != nil {
+				return err
+			}
+			continue
+		}
+		if parked.Sequence > last+1 {
+			if !skipGap {
+				return nil
+			}
+			q.gapsSkipped.Add(1)
+			log.Printf("Skipping %s #%d-#%d: not received within %s, applying #%d",
+				aggregateID, last+1, parked.Sequence-1, q.parkTimeout, parked.Sequence)
+		}
+		skipGap = false
+
+		handler, ok := q.handlers[parked.Kind]
+		if !ok {
+			return fmt.Errorf("no handler for parked %s event %s", parked.Kind, parked.Event.EventID)
+		}
+		if err := handler(ctx, parked.Event); err != nil {
+			return fmt.Errorf("failed to apply parked event %s: %w", parked.Event.EventID, err)
+		}
+		if err := q.repo.Advance(ctx, aggregateID, parked.Sequence); err != nil {
+			return err
+		}
+		if err := q.repo.Unpark(ctx, aggregateID, parked.Sequence); err != nil {
+			return err
+		}
+		last = parked.Sequence
+	}
+}
+
+// RunParkedEventRelease applies events parked longer than the park timeout every interval
+func (s *CatalogService) RunParkedEventRelease(ctx context.Context, interval time.Duration) {
+	if s.sequencing == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ReleaseParkedEvents(ctx, time.Now()); err != nil {
+				log.Printf("Parked event release failed: %v", err)
+			}
+		}
+	}
+}
+
+// ReleaseParkedEvents unblocks aggregates whose oldest parked event has waited longer than
+// the park timeout by skipping the gap in front of it. It returns how many aggregates it
+// unblocked.
+func (s *CatalogService) ReleaseParkedEvents(ctx context.Context, now time.Time) (int, error) {
+	if s.sequencing == nil {
+		return 0, nil
+	}
+	q := s.sequencing
+
+	aggregates, err := q.repo.StaleAggregates(ctx, now.Add(-q.parkTimeout), staleAggregateBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, aggregateID := range aggregates {
+		err := func() error {
+			defer q.lock(aggregateID)()
+			last, err := q.repo.LastApplied(ctx, aggregateID)
+			if err != nil {
+				return err
+			}
+			return s.applyParked(ctx, aggregateID, last, true)
+		}()
+		if err != nil {
+			log.Printf("Failed to release events parked for %s: %v", aggregateID, err)
+			continue
+		}
+		released++
+	}
+	return released, nil
+}
+
+// SequenceStats reports parked and gap-skipped events for metrics
+func (s *CatalogService) SequenceStats(ctx context.Context) (domain.SequenceStats, error) {
+	if s.sequencing == nil {
+		return domain.SequenceStats{}, nil
+	}
+	stats := domain.SequenceStats{
+		Parked:      s.sequencing.parked.Load(),
+		GapsSkipped: s.sequencing.gapsSkipped.Load(),
+	}
+	waiting, err := s.sequencing.repo.CountParked(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.Waiting = waiting
+	return stats, nil
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	// maxSlugBaseLength keeps room for the chain and counter suffixes
+	maxSlugBaseLength = 48
+
+	// maxSlugAttempts bounds the numbered candidates tried before falling back to the contract
+	maxSlugAttempts = 20
+
+	// fallbackSlug names collections whose name has nothing to transliterate
+	fallbackSlug = "collection"
+)
+
+// reservedSlugs are top-level routes and words a collection slug must not take
+var reservedSlugs = map[string]bool{
+	"about": true, "account": true, "activity": true, "admin": true, "api": true,
+	"assets": true, "auth": true, "collection": true, "collections": true, "create": true,
+	"drops": true, "edit": true, "explore": true, "graphql": true, "help": true,
+	"login": true, "logout": true, "me": true, "new": true, "null": true,
+	"playground": true, "privacy": true, "profile": true, "rankings": true, "search": true,
+	"settings": true, "static": true, "stats": true, "terms": true, "trending": true,
+	"undefined": true, "www": true,
+}
+
+// chainSlugs names the chains used as slug suffixes; other chains use their CAIP-2 reference
+var chainSlugs = map[string]string{
+	"eip155-1":        "ethereum",
+	"eip155-10":       "optimism",
+	"eip155-56":       "bsc",
+	"eip155-137":      "polygon",
+	"eip155-8453":     "base",
+	"eip155-42161":    "arbitrum",
+	"eip155-11155111": "sepolia",
+	"eip155-31337":    "local",
+}
+
+// transliterations covers letters that do not decompose into an ASCII base letter
+var transliterations = map[rune]string{
+	'đ': "d", 'ð': "d", 'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'ł': "l", 'þ': "th", 'ı': "i",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh",
+	'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye",
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i",
+	'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s",
+	'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// Slugify turns a collection name into a URL slug: lowercase ASCII letters and digits
+// separated by single hyphens. Accents are stripped and Cyrillic and Greek transliterated;
+// characters with no Latin form are dropped. Returns "" when nothing is left.
+func Slugify(name string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), name)
+	if err != nil {
+		stripped = name
+	}
+
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(stripped) {
+		var part string
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			part = string(r)
+		case transliterations[r] != "":
+			part = transliterations[r]
+		default:
+			hyphen = b.Len() > 0
+			continue
+		}
+		if hyphen {
+			b.WriteByte('-')
+			hyphen = false
+		}
+		b.WriteString(part)
+	}
+
+	slug := b.String()
+	if len(slug) > maxSlugBaseLength {
+		slug = slug[:maxSlugBaseLength]
+		if cut := strings.LastIndexByte(slug, '-'); cut > maxSlugBaseLength/2 {
+			slug = slug[:cut]
+		}
+		slug = strings.TrimRight(slug, "-")
+	}
+	return slug
+}
+
+// ChainSlug is the suffix that tells apart same-named collections on different chains
+func ChainSlug(chainID string) string {
+	chainID = string(normalizeChainID(chainID))
+	if name, ok := chainSlugs[chainID]; ok {
+		return name
+	}
+	if _, reference, ok := strings.Cut(chainID, "-"); ok {
+		return Slugify(reference)
+	}
+	return Slugify(chainID)
+}
+
+// SlugCandidates lists, in order of preference, the slugs a collection may take: the name,
+// then name-chain, then name-chain-2 and up. Reserved names skip straight to name-chain.
+func SlugCandidates(name, chainID string) []string {
+	base := Slugify(name)
+	if base == "" {
+		base = fallbackSlug
+	}
+	withChain := base + "-" + ChainSlug(chainID)
+
+	candidates := make([]string, 0, maxSlugAttempts+1)
+	if !reservedSlugs[base] {
+		candidates = append(candidates, base)
+	}
+	candidates = append(candidates, withChain)
+	for i := 2; len(candidates) <= maxSlugAttempts; i++ {
+		candidates = append(candidates, fmt.Sprintf("%s-%d", withChain, i))
+	}
+	return candidates
+}
+
+// assignSlug gives a collection its slug. A collection keeps its slug while its name is
+// unchanged; a rename takes the first free candidate and leaves the old slug redirecting.
+// Slugs held, currently or formerly, by another collection are never reused.
+func (s *CatalogService) assignSlug(ctx context.Context, repo domain.CollectionsRepository, c *domain.Collection) error {
+	existing, err := repo.GetByPK(ctx, domain.ChainID(c.ChainID), domain.Address(c.ContractAddress))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to load collection: %w", err)
+	}
+	if existing.Slug != "" && existing.Name == c.Name {
+		c.Slug = existing.Slug
+		return nil
+	}
+
+	slug := ""
+	for _, candidate := range SlugCandidates(c.Name, c.ChainID) {
+		owner, err := repo.SlugOwner(ctx, candidate)
+		if err != nil {
+			return fmt.Errorf("failed to check slug %s: %w", candidate, err)
+		}
+		if owner == "" || (existing.ID != "" && owner == existing.ID) {
+			slug = candidate
+			break
+		}
+	}
+	if slug == "" {
+		// Every numbered candidate is taken; the contract address is unique per chain
+		contract := strings.TrimPrefix(strings.ToLower(c.ContractAddress), "0x")
+		if len(contract) > 8 {
+			contract = contract[:8]
+		}
+		slug = SlugCandidates(c.Name, c.ChainID)[0] + "-" + contract
+	}
+
+	if existing.Slug != "" && existing.Slug != slug {
+		if err := repo.AddSlugRedirect(ctx, existing.Slug, existing.ID); err != nil {
+			return fmt.Errorf("failed to keep redirect from %s: %w", existing.Slug, err)
+		}
+	}
+	c.Slug = slug
+	return nil
+}
+
+// GetCollectionBySlug returns the collection a slug names, following the redirects left by
+// renames, with the same visibility rules as GetCollection
+func (s *CatalogService) GetCollectionBySlug(ctx context.Context, slug string, includeFlagged, includeUnconfirmed bool) (*domain.Collection, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	chainID, contract, err := s.collectionRepo.ResolveSlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to resolve slug %s: %w", slug, err)
+	}
+	return s.GetCollection(ctx, chainID, contract, includeFlagged, includeUnconfirmed)
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	// Shorter queries match too much to rank usefully
+	minSuggestQueryLength = 2
+	maxSuggestQueryLength = 64
+	defaultSuggestLimit   = 8
+	maxSuggestLimit       = 20
+)
+
+// Suggest autocompletes collection and token names. The query is lower-cased with its
+// whitespace collapsed; queries too short to rank return no suggestions.
+func (s *CatalogService) Suggest(ctx context.Context, query string, limit int) ([]domain.Suggestion, error) {
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	query = normalizeSuggestQuery(query)
+	if utf8.RuneCountInString(query) < minSuggestQueryLength {
+		return []domain.Suggestion{}, nil
+	}
+
+	suggestions, err := s.collectionRepo.Suggest(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest: %w", err)
+	}
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// normalizeSuggestQuery lower-cases query, collapses its whitespace and caps its length
+func normalizeSuggestQuery(query string) string {
+	query = strings.Join(strings.Fields(strings.ToLower(query)), " ")
+	if utf8.RuneCountInString(query) > maxSuggestQueryLength {
+		query = string([]rune(query)[:maxSuggestQueryLength])
+	}
+	return query
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+// SetSystemStatus enables the systemStatus report over queueNames; without it the report is empty
+func (s *CatalogService) SetSystemStatus(queues domain.QueueInspector, lags domain.ConsumerLagReader, queueNames []string) {
+	s.queueInspector = queues
+	s.consumerLags = lags
+	s.statusQueues = queueNames
+}
+
+// GetSystemStatus reports the depth of every status queue and the last lag report of every
+// consumer. A queue the broker cannot be asked about is reported with its error rather than
+// failing the whole report.
+func (s *CatalogService) GetSystemStatus(ctx context.Context) (*domain.SystemStatus, error) {
+	now := time.Now()
+	status := &domain.SystemStatus{CheckedAt: now}
+	if s.queueInspector == nil || s.consumerLags == nil {
+		return status, nil
+	}
+
+	depths := make(map[string]domain.QueueStatus, len(s.statusQueues))
+	for _, name := range s.statusQueues {
+		queue := domain.QueueStatus{}
+		queue.Name = name
+		if depth, err := s.queueInspector.InspectQueue(name); err != nil {
+			queue.Error = err.Error()
+		} else {
+			queue.QueueDepth = depth
+		}
+		depths[name] = queue
+		status.Queues = append(status.Queues, queue)
+	}
+
+	lags, err := s.consumerLags.ReadConsumerLags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read consumer lag: %w", err)
+	}
+	sort.Slice(lags, func(i, j int) bool { return lags[i].Consumer < lags[j].Consumer })
+
+	for _, lag := range lags {
+		consumer := domain.ConsumerStatus{
+			ConsumerLag: lag,
+			// Consumers report several times per threshold; one that missed all of them is down
+			Stale: now.Sub(lag.ReportedAt) > domain.LagThreshold,
+		}
+		queue, known := depths[lag.Queue]
+		consumer.FallingBehind = fallingBehind(consumer, queue, known && queue.Error == "")
+		status.Consumers = append(status.Consumers, consumer)
+	}
+	return status, nil
+}
+
+// fallingBehind reports whether a consumer holds a message older than the threshold, or
+// cannot drain its queue's backlog within the threshold at its current rate
+func fallingBehind(consumer domain.ConsumerStatus, queue domain.QueueStatus, knownDepth bool) bool {
+	if consumer.OldestUnackedSeconds > domain.LagThreshold.Seconds() {
+		return true
+	}
+	if !knownDepth || queue.Messages == 0 {
+		return false
+	}
+	if consumer.Stale {
+		return true
+	}
+	return float64(queue.Messages) > consumer.MessagesPerSecond*domain.LagThreshold.Seconds()
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/tokengate"
+)
+
+// SetTokenGates enables gate tokens, signed with secret and valid for ttl; the ownership
+// index must be enabled too
+func (s *CatalogService) SetTokenGates(secret []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = tokengate.DefaultTTL
+	}
+	s.tokenGateSecret = secret
+	s.tokenGateTTL = ttl
+}
+
+// VerifyTokenGate sums what the owners hold of the collection and signs a gate token for
+// the user when it reaches the minimum. The balance is as of the last indexed transfer.
+func (s *CatalogService) VerifyTokenGate(ctx context.Context, in domain.VerifyTokenGateInput) (*domain.TokenGateResult, error) {
+	if s.holderSnapshotRepo == nil || len(s.tokenGateSecret) == 0 {
+		return nil, domain.ErrUnavailable.WithMessage("token gating is disabled")
+	}
+	if in.UserID == "" || in.ChainID == "" || in.Contract == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	minBalance := in.MinBalance
+	if minBalance == nil {
+		minBalance = big.NewInt(1)
+	}
+	if minBalance.Sign() <= 0 {
+		return nil, domain.ErrInvalidInput.WithMessage("minimum balance must be positive")
+	}
+
+	owners := make([]string, 0, len(in.Owners))
+	for _, owner := range in.Owners {
+		if owner != "" {
+			owners = append(owners, strings.ToLower(owner))
+		}
+	}
+	balance, err := s.holderSnapshotRepo.HeldBalance(ctx, string(normalizeChainID(string(in.ChainID))), strings.ToLower(string(in.Contract)), owners)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.TokenGateResult{Balance: balance}
+	if balance.Cmp(minBalance) < 0 {
+		return result, nil
+	}
+
+	now := time.Now().UTC()
+	result.ExpiresAt = now.Add(s.tokenGateTTL)
+	result.Token, err = tokengate.Sign(s.tokenGateSecret, tokengate.Claims{
+		UserID:     in.UserID,
+		ChainID:    string(in.ChainID),
+		Contract:   string(in.Contract),
+		MinBalance: minBalance,
+		ExpiresAt:  result.ExpiresAt,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+	result.Held = true
+	return result, nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+// Decoded transfer events (collections.events.decoded.<chain>)
+const (
+	decodedTransfer       = "transfer" // ERC-721
+	decodedTransferSingle = "transfer_single"
+	decodedTransferBatch  = "transfer_batch"
+)
+
+// HandleDecodedEvent records transfers as wallet activity and in the ownership index, counts
+// mints in the collection's mint stats, and folds ERC-1155 mints and burns into per-token
+// supply. Other decoded events are ignored.
+func (s *CatalogService) HandleDecodedEvent(ctx context.Context, evt *domain.CollectionEvent) error {
+	switch evt.EventType {
+	case decodedUpdateUser, decodedCreateUserRecord, decodedDeleteUserRecord:
+		return s.handleRentalEvent(ctx, evt)
+	}
+	if evt.EventType != decodedTransfer && evt.EventType != decodedTransferSingle && evt.EventType != decodedTransferBatch {
+		return nil
+	}
+
+	args, ok := evt.Data["args"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s event %s has no args", evt.EventType, evt.EventID)
+	}
+	moved, err := transferredTokens(evt.EventType, args)
+	if err != nil {
+		return fmt.Errorf("%s event %s: %w", evt.EventType, evt.EventID, err)
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+
+	chainID := string(normalizeChainID(evt.ChainID))
+	contract := strings.ToLower(evt.Contract)
+	from := strings.ToLower(stringFromData(args, "from"))
+	to := strings.ToLower(stringFromData(args, "to"))
+
+	if err := s.recordTransferActivity(ctx, evt, chainID, contract, from, to, moved); err != nil {
+		return err
+	}
+	if err := s.recordOwnershipTransfers(ctx, evt, chainID, contract, from, to, moved); err != nil {
+		return err
+	}
+	if from == zeroAddress && to != zeroAddress {
+		if err := s.recordMintStats(ctx, evt, chainID, contract, to, moved); err != nil {
+			return err
+		}
+	}
+	if evt.EventType == decodedTransfer {
+		return nil
+	}
+
+	deltas := supplyDeltas(from, to, moved)
+	if len(deltas) == 0 {
+		// Plain transfer between holders
+		return nil
+	}
+
+	supplies, applied, err := s.tokenSupplyRepo.ApplyTransfer(ctx, evt.EventID, chainID, contract, deltas)
+	if err != nil {
+		return fmt.Errorf("failed to apply supply change: %w", err)
+	}
+	if !applied {
+		return nil
+	}
+
+	for i := range supplies {
+		if err := s.publishTokenSupplyChanged(ctx, &supplies[i], deltas[i], evt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetToken returns a token of an unflagged, confirmed collection with its supply
+func (s *CatalogService) GetToken(ctx context.Context, chainID domain.ChainID, contract domain.Address, tokenID string, includeFlagged bool) (*domain.Token, error) {
+	tokenID = strings.TrimSpace(tokenID)
+	if tokenID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if _, ok := new(big.Int).SetString(tokenID, 10); !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	collection, err := s.GetCollection(ctx, chainID, contract, includeFlagged, false)
+	if err != nil {
+		return nil, err
+	}
+
+	flag, err := s.moderationRepo.Get(ctx, domain.ChainID(collection.ChainID), domain.Address(collection.ContractAddress), tokenID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to load moderation flag: %w", err)
+	}
+	if flag.Status == domain.ModerationFlagged && !includeFlagged {
+		return nil, domain.ErrNotFound
+	}
+
+	token := &domain.Token{
+		ChainID:          collection.ChainID,
+		ContractAddress:  collection.ContractAddress,
+		TokenID:          tokenID,
+		Standard:         collection.CollectionType,
+		ModerationStatus: flag.Status,
+	}
+	tokens := []domain.Token{*token}
+	if err := s.attachRentals(ctx, tokens); err != nil {
+		return nil, err
+	}
+	token.Rentals = tokens[0].Rentals
+
+	var supply domain.TokenSupply
+	if strings.EqualFold(collection.CollectionType, "ERC1155") {
+		supply, err = s.tokenSupplyRepo.Get(ctx, collection.ChainID, collection.ContractAddress, tokenID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	token.SetSupply(supply, collection.MaxSupply)
+	return token, nil
+}
+
+// tokenAmount is one id moved by a transfer event
+type tokenAmount struct {
+	TokenID string
+	Amount  *big.Int
+}
+
+// transferredTokens reads the ids and amounts moved by a decoded Transfer(from, to, tokenId),
+// TransferSingle(operator, from, to, id, value) or TransferBatch(operator, from, to, ids, values).
+// Zero amounts are skipped.
+func transferredTokens(eventType string, args map[string]interface{}) ([]tokenAmount, error) {
+	var ids, values []interface{}
+	switch eventType {
+	case decodedTransfer:
+		ids, values = []interface{}{args["tokenId"]}, []interface{}{"1"}
+	case decodedTransferSingle:
+		ids, values = []interface{}{args["id"]}, []interface{}{args["value"]}
+	default:
+		ids, _ = args["ids"].([]interface{})
+		values, _ = args["values"].([]interface{})
+	}
+	if len(ids) != len(values) {
+		return nil, fmt.Errorf("%d ids but %d values", len(ids), len(values))
+	}
+
+	moved := make([]tokenAmount, 0, len(ids))
+	for i := range ids {
+		id, _ := ids[i].(string)
+		value, _ := values[i].(string)
+		tokenID, ok := new(big.Int).SetString(id, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid token id %v", ids[i])
+		}
+		amount, ok := new(big.Int).SetString(value, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid amount %v", values[i])
+		}
+		if amount.Sign() == 0 {
+			continue
+		}
+		moved = append(moved, tokenAmount{TokenID: tokenID.String(), Amount: amount})
+	}
+	return moved, nil
+}
+
+// supplyDeltas counts mints (from the zero address) and burns (to it). A batch can repeat
+// an id, so amounts are summed per id in first-seen order.
+func supplyDeltas(from, to string, moved []tokenAmount) []domain.SupplyDelta {
+	mint, burn := from == zeroAddress, to == zeroAddress
+	if !mint && !burn {
+		return nil
+	}
+
+	var deltas []domain.SupplyDelta
+	index := make(map[string]int)
+	for _, m := range moved {
+		j, seen := index[m.TokenID]
+		if !seen {
+			j = len(deltas)
+			index[m.TokenID] = j
+			deltas = append(deltas, domain.SupplyDelta{TokenID: m.TokenID, Minted: new(big.Int), Burned: new(big.Int)})
+		}
+		if mint {
+			deltas[j].Minted.Add(deltas[j].Minted, m.Amount)
+		}
+		if burn {
+			deltas[j].Burned.Add(deltas[j].Burned, m.Amount)
+		}
+	}
+	return deltas
+}
+
+// publishTokenSupplyChanged publishes the new supply of a token for live counters
+func (s *CatalogService) publishTokenSupplyChanged(ctx context.Context, supply *domain.TokenSupply, delta domain.SupplyDelta, evt *domain.CollectionEvent) error {
+	domainEvent := &domain.DomainEvent{
+		Schema:      "marketplace.domain.v1",
+		Version:     "1.0",
+		EventID:     fmt.Sprintf("token_supply_changed_%s_%s", evt.EventID, supply.TokenID),
+		EventType:   domain.EventTokenSupplyChanged,
+		AggregateID: fmt.Sprintf("%s/%s/%s", supply.ChainID, supply.ContractAddress, supply.TokenID),
+		ChainID:     supply.ChainID,
+		Data: map[string]interface{}{
+			"chain_id":         supply.ChainID,
+			"contract_address": supply.ContractAddress,
+			"token_id":         supply.TokenID,
+			"supply":           supply.Circulating().String(),
+			"minted":           supply.Minted.String(),
+			"burned":           supply.Burned.String(),
+			"minted_delta":     delta.Minted.String(),
+			"burned_delta":     delta.Burned.String(),
+			"tx_hash":          evt.TxHash,
+		},
+		Timestamp: time.Now(),
+	}
+
+	if err := s.publisher.PublishDomainEvent(ctx, domainEvent); err != nil {
+		return fmt.Errorf("failed to publish supply change: %w", err)
+	}
+	return nil
+}
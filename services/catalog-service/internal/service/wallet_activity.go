@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	defaultActivityPageSize = 20
+	maxActivityPageSize     = 100
+)
+
+// ListWalletActivity pages the transfers and sales of a wallet, newest first
+func (s *CatalogService) ListWalletActivity(ctx context.Context, address string, before *domain.ActivityCursor, limit int) ([]domain.WalletActivity, error) {
+	address = strings.TrimSpace(address)
+	if !common.IsHexAddress(address) {
+		return nil, domain.ErrInvalidInput
+	}
+	if before != nil && before.ID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if limit <= 0 {
+		limit = defaultActivityPageSize
+	}
+	if limit > maxActivityPageSize {
+		limit = maxActivityPageSize
+	}
+
+	return s.walletActivityRepo.ListByAddress(ctx, strings.ToLower(address), before, limit)
+}
+
+// recordTransferActivity stores one activity per token moved. Batch entries are keyed by
+// position so a batch repeating an id keeps every leg.
+func (s *CatalogService) recordTransferActivity(ctx context.Context, evt *domain.CollectionEvent, chainID, contract, from, to string, moved []tokenAmount) error {
+	kind := domain.ActivityTransfer
+	switch {
+	case from == zeroAddress:
+		kind = domain.ActivityMint
+	case to == zeroAddress:
+		kind = domain.ActivityBurn
+	}
+
+	activities := make([]domain.WalletActivity, len(moved))
+	for i, m := range moved {
+		id := evt.EventID
+		if evt.EventType == decodedTransferBatch {
+			id = fmt.Sprintf("%s:%d", evt.EventID, i)
+		}
+		activities[i] = domain.WalletActivity{
+			ID:              id,
+			ChainID:         chainID,
+			ContractAddress: contract,
+			TokenID:         m.TokenID,
+			Kind:            kind,
+			FromAddress:     from,
+			ToAddress:       to,
+			Quantity:        m.Amount,
+			TxHash:          evt.TxHash,
+			OccurredAt:      activityTime(evt),
+		}
+	}
+
+	if err := s.walletActivityRepo.Record(ctx, activities); err != nil {
+		return fmt.Errorf("failed to record wallet activity: %w", err)
+	}
+	return nil
+}
+
+// recordSaleActivity stores a sale for its seller and buyer; sales naming neither are
+// not attributable to a wallet and are skipped
+func (s *CatalogService) recordSaleActivity(ctx context.Context, evt *domain.CollectionEvent, chainID domain.ChainID, contract, tokenID, currency string, price *big.Int) error {
+	seller := strings.ToLower(stringFromData(evt.Data, "seller"))
+	buyer := strings.ToLower(stringFromData(evt.Data, "buyer"))
+	if seller == "" && buyer == "" {
+		return nil
+	}
+
+	quantity, ok := bigFromData(evt.Data, "quantity")
+	if !ok || quantity.Sign() == 0 {
+		quantity = big.NewInt(1)
+	}
+
+	err := s.walletActivityRepo.Record(ctx, []domain.WalletActivity{{
+		ID:              evt.EventID,
+		ChainID:         string(chainID),
+		ContractAddress: contract,
+		TokenID:         tokenID,
+		Kind:            domain.ActivitySale,
+		FromAddress:     seller,
+		ToAddress:       buyer,
+		Quantity:        quantity,
+		Price:           price,
+		Currency:        currency,
+		TxHash:          evt.TxHash,
+		OccurredAt:      activityTime(evt),
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to record sale activity: %w", err)
+	}
+	return nil
+}
+
+// activityTime prefers the occurred_at reported by the indexer over the publish time
+func activityTime(evt *domain.CollectionEvent) time.Time {
+	if occurredAt, ok := evt.Data["occurred_at"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, occurredAt); err == nil {
+			return t.UTC()
+		}
+	}
+	if evt.Timestamp.IsZero() {
+		return time.Now().UTC()
+	}
+	return evt.Timestamp.UTC()
+}
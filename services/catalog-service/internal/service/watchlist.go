@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+)
+
+const (
+	maxSavedSearches     = 50
+	maxSearchQueryLength = 500
+	maxSearchFilters     = 20
+	maxSearchNameLength  = 100
+)
+
+// Favorite adds a collection or token to the user's watchlist. The current collection
+// floor becomes the baseline for the floor delta; favoriting the same target again only
+// replaces the alert threshold.
+func (s *CatalogService) Favorite(ctx context.Context, in domain.FavoriteInput) (*domain.WatchlistItem, error) {
+	if in.UserID == "" || in.ChainID == "" || in.ContractAddress == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	switch in.TargetType {
+	case domain.WatchTargetCollection:
+		if in.TokenID != "" {
+			return nil, domain.ErrInvalidInput
+		}
+	case domain.WatchTargetToken:
+		if in.TokenID == "" {
+			return nil, domain.ErrInvalidInput
+		}
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+	if in.FloorAlertBelow != nil && in.FloorAlertBelow.Sign() <= 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	collection, err := s.GetCollection(ctx, domain.ChainID(in.ChainID), domain.Address(in.ContractAddress), false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.watchlistRepo.AddFavorite(ctx, domain.WatchlistItem{
+		UserID:          in.UserID,
+		TargetType:      in.TargetType,
+		ChainID:         collection.ChainID,
+		ContractAddress: collection.ContractAddress,
+		TokenID:         in.TokenID,
+		FloorAtAdd:      collection.FloorPrice,
+		FloorAlertBelow: in.FloorAlertBelow,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add favorite: %w", err)
+	}
+	item.CollectionName = collection.Name
+	item.CurrentFloor = collection.FloorPrice
+
+	return &item, nil
+}
+
+func (s *CatalogService) RemoveFavorite(ctx context.Context, userID, id string) error {
+	if userID == "" || id == "" {
+		return domain.ErrInvalidInput
+	}
+	return s.watchlistRepo.RemoveFavorite(ctx, userID, id)
+}
+
+// SaveSearch stores a search query with its filters. Name defaults to the query.
+func (s *CatalogService) SaveSearch(ctx context.Context, in domain.SaveSearchInput) (*domain.SavedSearch, error) {
+	query := strings.TrimSpace(in.Query)
+	name := strings.TrimSpace(in.Name)
+	if in.UserID == "" || (query == "" && len(in.Filters) == 0) {
+		return nil, domain.ErrInvalidInput
+	}
+	if len(query) > maxSearchQueryLength || len(name) > maxSearchNameLength || len(in.Filters) > maxSearchFilters {
+		return nil, domain.ErrInvalidInput
+	}
+	if name == "" {
+		name = query
+	}
+
+	count, err := s.watchlistRepo.CountSavedSearches(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxSavedSearches {
+		return nil, fmt.Errorf("%w: at most %d saved searches", domain.ErrInvalidInput, maxSavedSearches)
+	}
+
+	saved, err := s.watchlistRepo.SaveSearch(ctx, domain.SavedSearch{
+		UserID:  in.UserID,
+		Name:    name,
+		Query:   query,
+		Filters: in.Filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save search: %w", err)
+	}
+	return &saved, nil
+}
+
+func (s *CatalogService) DeleteSavedSearch(ctx context.Context, userID, id string) error {
+	if userID == "" || id == "" {
+		return domain.ErrInvalidInput
+	}
+	return s.watchlistRepo.DeleteSavedSearch(ctx, userID, id)
+}
+
+func (s *CatalogService) GetWatchlist(ctx context.Context, userID string) ([]domain.WatchlistItem, []domain.SavedSearch, error) {
+	if userID == "" {
+		return nil, nil, domain.ErrInvalidInput
+	}
+
+	items, err := s.watchlistRepo.ListFavorites(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list favorites: %w", err)
+	}
+	searches, err := s.watchlistRepo.ListSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	return items, searches, nil
+}
+
+// RunWatchlistAlerts evaluates floor alerts every interval until ctx is cancelled
+func (s *CatalogService) RunWatchlistAlerts(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.EvaluateFloorAlerts(ctx, time.Now()); err != nil {
+				log.Printf("Watchlist alert evaluation failed: %v", err)
+			}
+		}
+	}
+}
+
+// EvaluateFloorAlerts publishes watchlist.floor_below for every item whose collection
+// floor dropped below its threshold and returns how many were sent. An alert fires once
+// and re-arms when the floor recovers; collections without a floor are skipped.
+func (s *CatalogService) EvaluateFloorAlerts(ctx context.Context, now time.Time) (int, error) {
+	fired := 0
+	afterID := ""
+	for {
+		items, err := s.watchlistRepo.ListFloorAlerts(ctx, afterID, defaultSchedulerBatch)
+		if err != nil {
+			return fired, err
+		}
+
+		for _, item := range items {
+			if item.CurrentFloor == nil || item.CurrentFloor.Sign() <= 0 {
+				continue
+			}
+			below := item.CurrentFloor.Cmp(item.FloorAlertBelow) < 0
+
+			switch {
+			case below && item.AlertFiredAt == nil:
+				if err := s.publishFloorAlert(ctx, item, now); err != nil {
+					log.Printf("Failed to publish %s for %s: %v", domain.EventWatchlistFloorBelow, item.ID, err)
+					continue
+				}
+				if err := s.watchlistRepo.SetAlertFired(ctx, item.ID, &now); err != nil {
+					log.Printf("Failed to record floor alert for %s: %v", item.ID, err)
+				}
+				fired++
+			case !below && item.AlertFiredAt != nil:
+				if err := s.watchlistRepo.SetAlertFired(ctx, item.ID, nil); err != nil {
+					log.Printf("Failed to re-arm floor alert for %s: %v", item.ID, err)
+				}
+			}
+		}
+
+		if len(items) < defaultSchedulerBatch {
+			return fired, nil
+		}
+		afterID = items[len(items)-1].ID
+	}
+}
+
+func (s *CatalogService) publishFloorAlert(ctx context.Context, item domain.WatchlistItem, now time.Time) error {
+	domainEvent := &domain.DomainEvent{
+		Schema:      "marketplace.domain.v1",
+		Version:     "1.0",
+		EventID:     fmt.Sprintf("%s_%s_%d", domain.EventWatchlistFloorBelow, item.ID, now.Unix()),
+		EventType:   domain.EventWatchlistFloorBelow,
+		AggregateID: item.ID,
+		ChainID:     item.ChainID,
+		Data: map[string]interface{}{
+			"user_id":           item.UserID,
+			"target_type":       item.TargetType,
+			"chain_id":          item.ChainID,
+			"contract_address":  item.ContractAddress,
+			"token_id":          item.TokenID,
+			"collection_name":   item.CollectionName,
+			"floor_price":       item.CurrentFloor.String(),
+			"floor_alert_below": item.FloorAlertBelow.String(),
+			"floor_delta":       item.FloorDelta().String(),
+		},
+		Timestamp: now,
+	}
+
+	return s.publisher.PublishDomainEvent(ctx, domainEvent)
+}
@@ -0,0 +1,84 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func partition(from time.Time, archived bool) domain.ActivityPartition {
+	return domain.ActivityPartition{
+		Name:     fmt.Sprintf("wallet_activity_p%s", from.Format("200601")),
+		From:     from,
+		To:       from.AddDate(0, 1, 0),
+		Archived: archived,
+	}
+}
+
+func TestMaintainActivityPartitions(t *testing.T) {
+	partitionRepo := new(MockActivityPartitionRepository)
+	svc := newActivityService(new(MockWalletActivityRepository), new(MockEarningsRepository))
+	require.NoError(t, svc.SetActivityPartitions(partitionRepo, domain.PartitionPolicy{AheadMonths: 1, HotMonths: 3, RetentionMonths: 12}))
+	ctx := context.Background()
+	now := time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC)
+
+	partitionRepo.On("ListPartitions", ctx).Return([]domain.ActivityPartition{
+		partition(month(2025, 5), true),  // past retention
+		partition(month(2025, 6), true),  // archived, still retained
+		partition(month(2026, 3), false), // fell out of the hot window
+		partition(month(2026, 4), false),
+		partition(month(2026, 6), false),
+	}, nil)
+	// hot window is April to June; May and July (ahead) are missing
+	partitionRepo.On("CreatePartition", ctx, month(2026, 5)).Return(nil)
+	partitionRepo.On("CreatePartition", ctx, month(2026, 7)).Return(errors.New("lock timeout"))
+	partitionRepo.On("ArchivePartition", ctx, "wallet_activity_p202603").Return(nil)
+	partitionRepo.On("DropPartition", ctx, "wallet_activity_p202505").Return(nil)
+
+	// the failed month is reported without stopping archive and retention
+	err := svc.MaintainActivityPartitions(ctx, now)
+	assert.ErrorContains(t, err, "lock timeout")
+	partitionRepo.AssertExpectations(t)
+	partitionRepo.AssertNumberOfCalls(t, "CreatePartition", 2)
+	partitionRepo.AssertNumberOfCalls(t, "ArchivePartition", 1)
+	partitionRepo.AssertNumberOfCalls(t, "DropPartition", 1)
+}
+
+func TestMaintainActivityPartitions_KeepsArchivesWithoutRetention(t *testing.T) {
+	partitionRepo := new(MockActivityPartitionRepository)
+	svc := newActivityService(new(MockWalletActivityRepository), new(MockEarningsRepository))
+	require.NoError(t, svc.SetActivityPartitions(partitionRepo, domain.PartitionPolicy{HotMonths: 1}))
+	ctx := context.Background()
+
+	partitionRepo.On("ListPartitions", ctx).Return([]domain.ActivityPartition{
+		partition(month(2020, 1), true),
+		partition(month(2026, 6), false),
+	}, nil)
+
+	require.NoError(t, svc.MaintainActivityPartitions(ctx, time.Date(2026, 6, 30, 23, 0, 0, 0, time.UTC)))
+	partitionRepo.AssertNotCalled(t, "DropPartition", ctx, "wallet_activity_p202001")
+	partitionRepo.AssertNotCalled(t, "CreatePartition", ctx, month(2026, 7))
+}
+
+func TestSetActivityPartitions_RejectsInvalidPolicy(t *testing.T) {
+	svc := newActivityService(new(MockWalletActivityRepository), new(MockEarningsRepository))
+
+	for _, policy := range []domain.PartitionPolicy{
+		{HotMonths: 0},
+		{HotMonths: 6, AheadMonths: -1},
+		{HotMonths: 6, RetentionMonths: 3},
+	} {
+		err := svc.SetActivityPartitions(new(MockActivityPartitionRepository), policy)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, "%+v", policy)
+	}
+}
@@ -0,0 +1,142 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const auctionHouse = "0xdc64a140aa3e981100a9beca4e685f962f0cf6c9"
+
+func TestCatalogService_HandleMarketEvent_AuctionCreatedStoresAuction(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	mockAuctionRepo := new(MockAuctionRepository)
+	svc := newAuctionService(mockSchedulerRepo, mockAuctionRepo, new(MockMessagePublisher))
+
+	ctx := context.Background()
+	start := time.Now().Truncate(time.Second)
+	end := start.Add(24 * time.Hour)
+	mockAuctionRepo.On("Create", ctx, mock.MatchedBy(func(a domain.Auction) bool {
+		return a.ChainID == "eip155-1" &&
+			a.AuctionID == "7" &&
+			a.AuctionHouse == auctionHouse &&
+			a.ContractAddress == marketContract &&
+			a.Seller == "0x00000000000000000000000000000000000000aa" &&
+			a.AuctionType == "dutch" &&
+			a.StartPrice.Cmp(big.NewInt(5000)) == 0 &&
+			a.EndPrice.Cmp(big.NewInt(1000)) == 0 &&
+			a.ReservePrice.Sign() == 0 &&
+			a.Status == domain.AuctionActive &&
+			a.StartTime.Equal(start) &&
+			a.EndTime.Equal(end)
+	})).Return(nil)
+	mockSchedulerRepo.On("Get", ctx, "auction:eip155-1:7").Return(domain.ScheduledJob{}, domain.ErrNotFound)
+	mockSchedulerRepo.On("Schedule", ctx, mock.MatchedBy(func(job domain.ScheduledJob) bool {
+		return job.FireAt.Equal(end) && job.ContractAddress == marketContract
+	})).Return(nil)
+
+	evt := marketEvent("auction_created", map[string]interface{}{
+		"auction_id":         "7",
+		"auction_house":      auctionHouse,
+		"collection_address": marketContract,
+		"token_id":           "1",
+		"seller":             "0x00000000000000000000000000000000000000AA",
+		"auction_type":       "dutch",
+		"start_price":        "5000",
+		"reserve_price":      "0",
+		"end_price":          "1000",
+		"start_time":         float64(start.Unix()),
+		"end_time":           float64(end.Unix()),
+	})
+	evt.Contract = auctionHouse
+
+	err := svc.HandleMarketEvent(ctx, evt)
+
+	assert.NoError(t, err)
+	mockAuctionRepo.AssertExpectations(t)
+	mockSchedulerRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleMarketEvent_AuctionBidPublishesLiveBid(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	mockAuctionRepo := new(MockAuctionRepository)
+	mockPublisher := new(MockMessagePublisher)
+	svc := newAuctionService(mockSchedulerRepo, mockAuctionRepo, mockPublisher)
+
+	ctx := context.Background()
+	end := time.Now().Add(time.Hour).Truncate(time.Second)
+	mockAuctionRepo.On("RecordBid", ctx, mock.MatchedBy(func(bid domain.AuctionBid) bool {
+		return bid.EventID == "auction_bid-1" &&
+			bid.AuctionID == "7" &&
+			bid.Bidder == "0x00000000000000000000000000000000000000cc" &&
+			bid.Amount.Cmp(big.NewInt(2500)) == 0 &&
+			bid.EndTime.Equal(end)
+	})).Return(domain.Auction{
+		ChainID:         "eip155-1",
+		AuctionID:       "7",
+		AuctionHouse:    auctionHouse,
+		ContractAddress: marketContract,
+		TokenID:         "1",
+		HighestBid:      big.NewInt(2500),
+		HighestBidder:   "0x00000000000000000000000000000000000000cc",
+		BidCount:        2,
+		EndTime:         end,
+	}, true, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(e *domain.DomainEvent) bool {
+		return e.EventType == domain.EventAuctionBidPlaced &&
+			e.ChainID == "eip155-1" &&
+			e.Data["highest_bid"] == "2500" &&
+			e.Data["bid_count"] == 2
+	})).Return(nil)
+	mockSchedulerRepo.On("Get", ctx, "auction:eip155-1:7").Return(domain.ScheduledJob{}, domain.ErrNotFound)
+	mockSchedulerRepo.On("Schedule", ctx, mock.AnythingOfType("domain.ScheduledJob")).Return(nil)
+
+	err := svc.HandleMarketEvent(ctx, marketEvent("auction_bid", map[string]interface{}{
+		"auction_id": "7",
+		"bidder":     "0x00000000000000000000000000000000000000CC",
+		"amount":     "2500",
+		"end_time":   float64(end.Unix()),
+	}))
+
+	assert.NoError(t, err)
+	mockAuctionRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleMarketEvent_BidOnUnknownAuctionFails(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	mockAuctionRepo := new(MockAuctionRepository)
+	svc := newAuctionService(mockSchedulerRepo, mockAuctionRepo, new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockAuctionRepo.On("RecordBid", ctx, mock.AnythingOfType("domain.AuctionBid")).Return(domain.Auction{}, false, domain.ErrNotFound)
+
+	err := svc.HandleMarketEvent(ctx, marketEvent("auction_bid", map[string]interface{}{
+		"auction_id": "9",
+		"bidder":     "0x00000000000000000000000000000000000000cc",
+		"amount":     "1",
+	}))
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	mockSchedulerRepo.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_GetAuction(t *testing.T) {
+	mockAuctionRepo := new(MockAuctionRepository)
+	svc := newAuctionService(new(MockSchedulerRepository), mockAuctionRepo, new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockAuctionRepo.On("Get", ctx, "eip155-1", "7").Return(domain.Auction{AuctionID: "7", Status: domain.AuctionActive}, nil)
+
+	auction, err := svc.GetAuction(ctx, "eip155:1", "7")
+	assert.NoError(t, err)
+	assert.Equal(t, "7", auction.AuctionID)
+
+	_, err = svc.GetAuction(ctx, "eip155:1", " ")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -0,0 +1,282 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryExports keeps exports and serves wallet activity like the SQL repository does
+type memoryExports struct {
+	activity []domain.WalletActivity
+	exports  map[string]*domain.CollectionExport
+}
+
+func (m *memoryExports) matching(chainID, contract, kind string, from, to time.Time) []domain.WalletActivity {
+	var out []domain.WalletActivity
+	for _, a := range m.activity {
+		if a.ChainID == chainID && a.ContractAddress == contract && a.Kind == kind &&
+			!a.OccurredAt.Before(from) && a.OccurredAt.Before(to) {
+			out = append(out, a)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].OccurredAt.Before(out[j].OccurredAt) })
+	return out
+}
+
+func (m *memoryExports) CountRows(ctx context.Context, chainID, contract, kind string, from, to time.Time) (int, error) {
+	return len(m.matching(chainID, contract, kind, from, to)), nil
+}
+
+func (m *memoryExports) StreamRows(ctx context.Context, chainID, contract, kind string, from, to time.Time, fn func(domain.WalletActivity) error) error {
+	for _, a := range m.matching(chainID, contract, kind, from, to) {
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memoryExports) Create(ctx context.Context, e domain.CollectionExport) (*domain.CollectionExport, error) {
+	e.ID = uuid.New().String()
+	e.CreatedAt = time.Now()
+	m.exports[e.ID] = &e
+	created := e
+	return &created, nil
+}
+
+func (m *memoryExports) Get(ctx context.Context, id string) (*domain.CollectionExport, error) {
+	e, ok := m.exports[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	found := *e
+	return &found, nil
+}
+
+func (m *memoryExports) ClaimPending(ctx context.Context, limit int, staleBefore time.Time) ([]domain.CollectionExport, error) {
+	var claimed []domain.CollectionExport
+	for _, e := range m.exports {
+		if e.Status == domain.ExportPending && len(claimed) < limit {
+			e.Status = domain.ExportRunning
+			claimed = append(claimed, *e)
+		}
+	}
+	return claimed, nil
+}
+
+func (m *memoryExports) Complete(ctx context.Context, id, artifactID string, rowCount int) error {
+	now := time.Now()
+	e := m.exports[id]
+	e.Status, e.ArtifactID, e.RowCount, e.CompletedAt = domain.ExportReady, artifactID, rowCount, &now
+	return nil
+}
+
+func (m *memoryExports) Fail(ctx context.Context, id, reason string) error {
+	now := time.Now()
+	e := m.exports[id]
+	e.Status, e.Error, e.CompletedAt = domain.ExportFailed, reason, &now
+	return nil
+}
+
+func (m *memoryExports) Requeue(ctx context.Context, id string) error {
+	e := m.exports[id]
+	e.Status, e.ArtifactID, e.CompletedAt = domain.ExportPending, "", nil
+	return nil
+}
+
+type recordingExportNotifier struct {
+	events []contracts.CollectionExportReadyEvent
+}
+
+func (n *recordingExportNotifier) PublishExportReady(ctx context.Context, event contracts.CollectionExportReadyEvent) error {
+	n.events = append(n.events, event)
+	return nil
+}
+
+var exportDay = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func newExportService() (*service.CatalogService, *memoryExports, *memoryArtifacts, *recordingExportNotifier) {
+	collectionRepo := new(MockCollectionsRepository)
+	moderationRepo := new(MockModerationRepository)
+	collectionRepo.On("GetByPK", context.Background(), domain.ChainID("eip155-1"), domain.Address(editionContract)).Return(domain.Collection{
+		ChainID:         "eip155-1",
+		ContractAddress: editionContract,
+		CollectionType:  "ERC1155",
+	}, nil)
+	moderationRepo.On("Get", context.Background(), domain.ChainID("eip155-1"), domain.Address(editionContract), "").Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	supplyRepo := new(MockTokenSupplyRepository)
+	supplyRepo.On("ApplyTransfer", context.Background(), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]domain.TokenSupply{}, false, nil)
+
+	svc := newSupplyService(collectionRepo, moderationRepo, supplyRepo, new(MockMessagePublisher))
+	exports := &memoryExports{exports: map[string]*domain.CollectionExport{}}
+	artifacts := &memoryArtifacts{content: map[string][]byte{}}
+	notifier := &recordingExportNotifier{}
+	svc.SetCollectionExports(exports, artifacts, notifier)
+	return svc, exports, artifacts, notifier
+}
+
+func exportActivity(kind string, n int, at time.Time) domain.WalletActivity {
+	a := domain.WalletActivity{
+		ID:              fmt.Sprintf("%s-%d", kind, n),
+		ChainID:         "eip155-1",
+		ContractAddress: editionContract,
+		TokenID:         fmt.Sprint(n),
+		Kind:            kind,
+		FromAddress:     zeroAddr,
+		ToAddress:       holderAddr,
+		Quantity:        big.NewInt(1),
+		TxHash:          fmt.Sprintf("0x%064d", n),
+		OccurredAt:      at,
+	}
+	if kind == domain.ActivitySale {
+		a.FromAddress, a.ToAddress = holderAddr, otherHolder
+		a.Price, a.Currency = big.NewInt(1_500_000_000_000_000_000), "ETH"
+	}
+	return a
+}
+
+func salesExportInput() domain.CreateCollectionExportInput {
+	return domain.CreateCollectionExportInput{
+		Kind:        domain.ExportSales,
+		ChainID:     "eip155-1",
+		Contract:    domain.Address(editionContract),
+		From:        exportDay,
+		To:          exportDay.Add(24 * time.Hour),
+		RequestedBy: "user-1",
+	}
+}
+
+func TestCatalogService_CreateCollectionExport_SmallPeriodIsReady(t *testing.T) {
+	svc, exports, artifacts, notifier := newExportService()
+	exports.activity = []domain.WalletActivity{
+		exportActivity(domain.ActivitySale, 2, exportDay.Add(2*time.Hour)),
+		exportActivity(domain.ActivitySale, 1, exportDay.Add(time.Hour)),
+		exportActivity(domain.ActivityMint, 3, exportDay.Add(time.Hour)),
+		exportActivity(domain.ActivitySale, 4, exportDay.Add(24*time.Hour)), // past the period
+	}
+
+	export, err := svc.CreateCollectionExport(context.Background(), salesExportInput())
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.ExportReady, export.Status)
+	assert.Equal(t, 2, export.RowCount)
+	require.NotNil(t, export.CSV)
+	assert.Equal(t,
+		"occurred_at,tx_hash,token_id,seller,buyer,quantity,price,currency\n"+
+			"2025-03-01T01:00:00Z,"+fmt.Sprintf("0x%064d", 1)+",1,"+holderAddr+","+otherHolder+",1,1500000000000000000,ETH\n"+
+			"2025-03-01T02:00:00Z,"+fmt.Sprintf("0x%064d", 2)+",2,"+holderAddr+","+otherHolder+",1,1500000000000000000,ETH\n",
+		string(artifacts.content[export.CSV.ArtifactID]))
+	assert.Empty(t, notifier.events, "inline exports are returned, not announced")
+}
+
+func TestCatalogService_CreateCollectionExport_LargePeriodRunsInBackground(t *testing.T) {
+	svc, exports, artifacts, notifier := newExportService()
+	ctx := context.Background()
+	for i := 0; i <= domain.ExportInlineRows; i++ {
+		exports.activity = append(exports.activity, exportActivity(domain.ActivityMint, i, exportDay.Add(time.Duration(i)*time.Second)))
+	}
+
+	in := salesExportInput()
+	in.Kind = domain.ExportMints
+	export, err := svc.CreateCollectionExport(ctx, in)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExportPending, export.Status)
+	assert.Nil(t, export.CSV)
+
+	require.NoError(t, svc.ProcessCollectionExports(ctx))
+
+	ready, err := svc.GetCollectionExport(ctx, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExportReady, ready.Status)
+	assert.Equal(t, domain.ExportInlineRows+1, ready.RowCount)
+	require.NotNil(t, ready.CSV)
+	content := string(artifacts.content[ready.CSV.ArtifactID])
+	assert.True(t, strings.HasPrefix(content, "occurred_at,tx_hash,token_id,minter,quantity\n2025-03-01T00:00:00Z,"))
+
+	require.Len(t, notifier.events, 1)
+	assert.Equal(t, "user-1", notifier.events[0].UserID)
+	assert.Equal(t, export.ID, notifier.events[0].ExportID)
+	assert.Equal(t, domain.ExportReady, notifier.events[0].Status)
+	assert.Equal(t, domain.ExportInlineRows+1, notifier.events[0].RowCount)
+}
+
+func TestCatalogService_CreateCollectionExport_EnforcesLimits(t *testing.T) {
+	svc, exports, _, _ := newExportService()
+	ctx := context.Background()
+
+	in := salesExportInput()
+	in.Kind = "listings"
+	_, err := svc.CreateCollectionExport(ctx, in)
+	assert.True(t, errs.Is(err, errs.InvalidArgument), "unknown kind")
+
+	in = salesExportInput()
+	in.To = in.From
+	_, err = svc.CreateCollectionExport(ctx, in)
+	assert.True(t, errs.Is(err, errs.InvalidArgument), "empty period")
+
+	in = salesExportInput()
+	in.To = in.From.Add(domain.MaxExportPeriod + time.Hour)
+	_, err = svc.CreateCollectionExport(ctx, in)
+	assert.True(t, errs.Is(err, errs.InvalidArgument), "period too long")
+
+	for i := 0; i <= domain.MaxExportRows; i++ {
+		exports.activity = append(exports.activity, exportActivity(domain.ActivitySale, i, exportDay))
+	}
+	_, err = svc.CreateCollectionExport(ctx, salesExportInput())
+	assert.True(t, errs.Is(err, errs.InvalidArgument), "too many rows")
+	assert.Empty(t, exports.exports)
+}
+
+func TestCatalogService_CreateCollectionExport_FailsPastSizeLimit(t *testing.T) {
+	svc, exports, artifacts, _ := newExportService()
+	currency := strings.Repeat("x", 8<<10)
+	for i := 0; i < domain.MaxExportBytes/len(currency)+1; i++ {
+		a := exportActivity(domain.ActivitySale, i, exportDay)
+		a.Currency = currency
+		exports.activity = append(exports.activity, a)
+	}
+
+	export, err := svc.CreateCollectionExport(context.Background(), salesExportInput())
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExportFailed, export.Status)
+	assert.Contains(t, export.Error, "narrow the period")
+	assert.Empty(t, artifacts.content)
+}
+
+func TestCatalogService_GetCollectionExport_RequeuesExpired(t *testing.T) {
+	svc, exports, artifacts, notifier := newExportService()
+	ctx := context.Background()
+	exports.activity = []domain.WalletActivity{exportActivity(domain.ActivitySale, 1, exportDay)}
+
+	created, err := svc.CreateCollectionExport(ctx, salesExportInput())
+	require.NoError(t, err)
+	delete(artifacts.content, created.CSV.ArtifactID)
+
+	expired, err := svc.GetCollectionExport(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExportPending, expired.Status)
+	assert.Nil(t, expired.CSV)
+
+	require.NoError(t, svc.ProcessCollectionExports(ctx))
+	again, err := svc.GetCollectionExport(ctx, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, again.CSV)
+	assert.Contains(t, string(artifacts.content[again.CSV.ArtifactID]), holderAddr+","+otherHolder)
+	assert.Len(t, notifier.events, 1)
+}
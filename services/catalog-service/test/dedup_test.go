@@ -0,0 +1,82 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/httpapi"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+)
+
+func TestHandleCollectionCreated_ReplayedLogIsDropped(t *testing.T) {
+	processed := new(MockProcessedEventsRepository)
+	svc := service.NewCatalogService(new(MockCollectionsRepository), processed, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	// A replay that lost the indexer's event id and carries the CAIP-2 chain id
+	replay := &domain.CollectionEvent{
+		EventID:   "amqp-message-7",
+		EventType: "collection_created",
+		ChainID:   "eip155:1",
+		TxHash:    "0xABCDEF",
+		Contract:  "0x1234567890123456789012345678901234567890",
+		Data: map[string]interface{}{
+			"collection_address": "0x1234567890123456789012345678901234567890",
+			"log_index":          float64(3),
+		},
+		Timestamp: time.Now(),
+	}
+
+	var key domain.ProcessedEventKey
+	processed.On("MarkProcessed", ctx, processedKey(replay.EventID)).Run(func(args mock.Arguments) {
+		key = args.Get(1).(domain.ProcessedEventKey)
+	}).Return(domain.DuplicateLog, nil)
+
+	require.NoError(t, svc.HandleCollectionCreated(ctx, replay))
+
+	assert.Equal(t, "eip155-1", key.ChainID, "logs are keyed in the indexer's chain id form")
+	assert.Equal(t, "0xabcdef", key.TxHash)
+	assert.Equal(t, "collection_created", key.EventType)
+	require.True(t, key.HasLog())
+	assert.Equal(t, uint64(3), *key.LogIndex)
+
+	assert.Equal(t, []domain.DedupCount{
+		{EventType: "collection_created", Reason: domain.DuplicateLog, Count: 1},
+	}, svc.DedupCounts())
+}
+
+func TestMetricsEndpoint_ReportsDuplicateDrops(t *testing.T) {
+	catalog := stubCatalog{counts: []domain.DedupCount{
+		{EventType: "collection_created", Reason: domain.NotDuplicate, Count: 40},
+		{EventType: "collection_created", Reason: domain.DuplicateEventID, Count: 9},
+		{EventType: "collection_created", Reason: domain.DuplicateLog, Count: 1},
+	}}
+
+	rec := httptest.NewRecorder()
+	httpapi.NewHandler(catalog).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, httpapi.MetricsRoute, nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, `catalog_events_processed_total{event_type="collection_created"} 40`)
+	assert.Contains(t, body, `catalog_events_duplicates_dropped_total{event_type="collection_created",reason="event_id"} 9`)
+	assert.Contains(t, body, `catalog_events_duplicates_dropped_total{event_type="collection_created",reason="log"} 1`)
+}
+
+type stubCatalog struct {
+	counts   []domain.DedupCount
+	sequence domain.SequenceStats
+}
+
+func (s stubCatalog) DedupCounts() []domain.DedupCount { return s.counts }
+
+func (s stubCatalog) SequenceStats(ctx context.Context) (domain.SequenceStats, error) {
+	return s.sequence, nil
+}
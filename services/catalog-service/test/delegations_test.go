@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	hotWallet  = "0x00000000000000000000000000000000000000d1"
+	vaultAddr  = "0x00000000000000000000000000000000000000f1"
+	otherVault = "0x00000000000000000000000000000000000000f2"
+)
+
+// fakeDelegationRegistry answers from a fixed table and counts reads
+type fakeDelegationRegistry struct {
+	delegations map[string][]domain.Delegation
+	err         error
+	reads       int
+}
+
+func (f *fakeDelegationRegistry) IncomingDelegations(ctx context.Context, chainID, delegate string) ([]domain.Delegation, error) {
+	f.reads++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.delegations[delegate], nil
+}
+
+func newDelegationRegistry() *fakeDelegationRegistry {
+	return &fakeDelegationRegistry{delegations: map[string][]domain.Delegation{
+		hotWallet: {
+			{Vault: vaultAddr, Delegate: hotWallet},
+			{Vault: otherVault, Delegate: hotWallet, Contract: editionContract},
+			// A vault delegating to itself adds nothing
+			{Vault: hotWallet, Delegate: hotWallet},
+		},
+	}}
+}
+
+func TestCatalogService_ListDelegatedVaults_ScopesContractDelegations(t *testing.T) {
+	svc := newSchedulerService(new(MockSchedulerRepository), new(MockMessagePublisher))
+	svc.SetDelegations(newDelegationRegistry(), time.Minute)
+	ctx := context.Background()
+
+	vaults, err := svc.ListDelegatedVaults(ctx, "eip155:1", []string{hotWallet}, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{vaultAddr}, vaults)
+
+	vaults, err = svc.ListDelegatedVaults(ctx, "eip155:1", []string{hotWallet}, editionContract)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{vaultAddr, otherVault}, vaults)
+}
+
+func TestCatalogService_ListDelegatedVaults_CachesReads(t *testing.T) {
+	svc := newSchedulerService(new(MockSchedulerRepository), new(MockMessagePublisher))
+	registry := newDelegationRegistry()
+	svc.SetDelegations(registry, time.Hour)
+	ctx := context.Background()
+
+	_, err := svc.ListDelegatedVaults(ctx, "eip155:1", []string{hotWallet}, "")
+	require.NoError(t, err)
+	_, err = svc.ListDelegatedVaults(ctx, "eip155:1", []string{hotWallet}, editionContract)
+	require.NoError(t, err)
+	assert.Equal(t, 1, registry.reads)
+}
+
+func TestCatalogService_ListDelegatedVaults_FallsBackOnReadErrors(t *testing.T) {
+	svc := newSchedulerService(new(MockSchedulerRepository), new(MockMessagePublisher))
+	registry := newDelegationRegistry()
+	svc.SetDelegations(registry, time.Nanosecond)
+	ctx := context.Background()
+
+	_, err := svc.ListDelegatedVaults(ctx, "eip155:1", []string{hotWallet}, "")
+	require.NoError(t, err)
+
+	// An expired entry is still used while the registry can't be read
+	registry.err = errors.New("rpc down")
+	time.Sleep(time.Millisecond)
+	vaults, err := svc.ListDelegatedVaults(ctx, "eip155:1", []string{hotWallet}, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{vaultAddr}, vaults)
+
+	// A wallet never read is skipped
+	vaults, err = svc.ListDelegatedVaults(ctx, "eip155:1", []string{otherHolder}, "")
+	require.NoError(t, err)
+	assert.Empty(t, vaults)
+}
+
+func TestCatalogService_ListDelegatedVaults_Disabled(t *testing.T) {
+	svc := newSchedulerService(new(MockSchedulerRepository), new(MockMessagePublisher))
+
+	_, err := svc.ListDelegatedVaults(context.Background(), "eip155:1", []string{hotWallet}, "")
+	assert.True(t, errs.Is(err, errs.Unavailable), "got %v", err)
+}
@@ -0,0 +1,273 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+)
+
+// memoryDropRepo serves fixed collection drops and keeps submissions in memory
+type memoryDropRepo struct {
+	collectionDrops []domain.UpcomingDrop
+	submissions     []domain.DropSubmission
+	lastFilter      domain.DropCalendarFilter
+}
+
+func (r *memoryDropRepo) ListCollectionDrops(ctx context.Context, filter domain.DropCalendarFilter) ([]domain.UpcomingDrop, error) {
+	r.lastFilter = filter
+	return r.collectionDrops, nil
+}
+
+func (r *memoryDropRepo) ListApprovedDrops(ctx context.Context, filter domain.DropCalendarFilter) ([]domain.UpcomingDrop, error) {
+	var out []domain.UpcomingDrop
+	for _, s := range r.submissions {
+		if s.Status == domain.DropApproved {
+			out = append(out, domain.UpcomingDrop{ID: s.ID, Source: domain.DropSourceSubmission, ChainID: s.ChainID, Name: s.Name, StartsAt: s.StartsAt})
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryDropRepo) CreateSubmission(ctx context.Context, sub domain.DropSubmission) (domain.DropSubmission, error) {
+	sub.ID = fmt.Sprintf("sub-%d", len(r.submissions)+1)
+	sub.Status = domain.DropPending
+	r.submissions = append(r.submissions, sub)
+	return sub, nil
+}
+
+func (r *memoryDropRepo) ListSubmissions(ctx context.Context, filter domain.DropSubmissionFilter) ([]domain.DropSubmission, error) {
+	var out []domain.DropSubmission
+	for _, s := range r.submissions {
+		if (filter.Status == "" || s.Status == filter.Status) && (filter.SubmittedBy == "" || s.SubmittedBy == filter.SubmittedBy) {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryDropRepo) ReviewSubmission(ctx context.Context, id string, status domain.DropSubmissionStatus, actorID, note string) (domain.DropSubmission, error) {
+	for i := range r.submissions {
+		if r.submissions[i].ID == id {
+			r.submissions[i].Status = status
+			r.submissions[i].ReviewedBy = actorID
+			r.submissions[i].ReviewNote = note
+			return r.submissions[i], nil
+		}
+	}
+	return domain.DropSubmission{}, domain.ErrNotFound
+}
+
+func dropService(schedulerRepo *MockSchedulerRepository) (*service.CatalogService, *memoryDropRepo) {
+	svc := newSchedulerService(schedulerRepo, new(MockMessagePublisher))
+	repo := &memoryDropRepo{}
+	svc.SetDrops(repo)
+	return svc, repo
+}
+
+func dropInput(startsAt time.Time) domain.SubmitDropInput {
+	return domain.SubmitDropInput{
+		ChainID:     "eip155:1",
+		Name:        "  Genesis Pass  ",
+		ExternalURL: "https://drops.example.com/genesis",
+		MintPrice:   "50000000000000000",
+		StartsAt:    startsAt,
+		SubmittedBy: "user-1",
+	}
+}
+
+func TestCatalogService_ListUpcomingDrops_MergesSources(t *testing.T) {
+	svc, repo := dropService(new(MockSchedulerRepository))
+	now := time.Now()
+	repo.collectionDrops = []domain.UpcomingDrop{
+		{ID: "c-2", Source: domain.DropSourceCollection, StartsAt: now.Add(3 * time.Hour)},
+		{ID: "c-1", Source: domain.DropSourceCollection, StartsAt: now.Add(time.Hour)},
+	}
+	repo.submissions = []domain.DropSubmission{
+		{ID: "s-1", Status: domain.DropApproved, StartsAt: now.Add(2 * time.Hour)},
+		{ID: "s-2", Status: domain.DropPending, StartsAt: now.Add(90 * time.Minute)},
+	}
+
+	drops, err := svc.ListUpcomingDrops(context.Background(), domain.DropCalendarFilter{ChainID: "eip155:1"})
+	require.NoError(t, err)
+
+	var ids []string
+	for _, d := range drops {
+		ids = append(ids, d.ID)
+	}
+	assert.Equal(t, []string{"c-1", "s-1", "c-2"}, ids)
+
+	// The window defaults to the next 30 days on the normalized chain
+	assert.Equal(t, "eip155-1", repo.lastFilter.ChainID)
+	assert.WithinDuration(t, now, repo.lastFilter.From, time.Minute)
+	assert.Equal(t, 30*24*time.Hour, repo.lastFilter.To.Sub(repo.lastFilter.From))
+
+	drops, err = svc.ListUpcomingDrops(context.Background(), domain.DropCalendarFilter{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, drops, 2)
+}
+
+func TestCatalogService_ListUpcomingDrops_RejectsBadWindows(t *testing.T) {
+	svc, _ := dropService(new(MockSchedulerRepository))
+	from := time.Now()
+
+	_, err := svc.ListUpcomingDrops(context.Background(), domain.DropCalendarFilter{From: from, To: from.Add(-time.Hour)})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.ListUpcomingDrops(context.Background(), domain.DropCalendarFilter{From: from, To: from.Add(91 * 24 * time.Hour)})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestCatalogService_SubmitDrop(t *testing.T) {
+	svc, repo := dropService(new(MockSchedulerRepository))
+	ctx := context.Background()
+
+	sub, err := svc.SubmitDrop(ctx, dropInput(time.Now().Add(48*time.Hour)))
+	require.NoError(t, err)
+	assert.Equal(t, domain.DropPending, sub.Status)
+	assert.Equal(t, "eip155-1", sub.ChainID)
+	assert.Equal(t, "Genesis Pass", sub.Name)
+
+	for name, mutate := range map[string]func(*domain.SubmitDropInput){
+		"past start":   func(in *domain.SubmitDropInput) { in.StartsAt = time.Now().Add(-time.Minute) },
+		"far future":   func(in *domain.SubmitDropInput) { in.StartsAt = time.Now().Add(400 * 24 * time.Hour) },
+		"no name":      func(in *domain.SubmitDropInput) { in.Name = "  " },
+		"bad url":      func(in *domain.SubmitDropInput) { in.ExternalURL = "javascript:alert(1)" },
+		"bad contract": func(in *domain.SubmitDropInput) { in.ContractAddress = "0x123" },
+		"bad price":    func(in *domain.SubmitDropInput) { in.MintPrice = "0.05" },
+		"ends first": func(in *domain.SubmitDropInput) {
+			endsAt := in.StartsAt.Add(-time.Hour)
+			in.EndsAt = &endsAt
+		},
+	} {
+		in := dropInput(time.Now().Add(48 * time.Hour))
+		mutate(&in)
+		_, err := svc.SubmitDrop(ctx, in)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, name)
+	}
+
+	// A submitter may only have so many drops awaiting review
+	for len(repo.submissions) < 10 {
+		_, err := svc.SubmitDrop(ctx, dropInput(time.Now().Add(48*time.Hour)))
+		require.NoError(t, err)
+	}
+	_, err = svc.SubmitDrop(ctx, dropInput(time.Now().Add(48*time.Hour)))
+	assert.ErrorIs(t, err, domain.ErrRateLimited)
+}
+
+func TestCatalogService_ReviewDropSubmission_SchedulesAlert(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	svc, _ := dropService(mockSchedulerRepo)
+	svc.SetDropLead(10 * time.Minute)
+	ctx := context.Background()
+
+	startsAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	sub, err := svc.SubmitDrop(ctx, dropInput(startsAt))
+	require.NoError(t, err)
+
+	mockSchedulerRepo.On("Schedule", ctx, mock.MatchedBy(func(job domain.ScheduledJob) bool {
+		return job.ID == "drop:eip155-1:"+sub.ID &&
+			job.Kind == domain.JobDropStartingSoon &&
+			job.SubjectID == sub.ID &&
+			job.EndsAt.Equal(startsAt) &&
+			job.FireAt.Equal(startsAt.Add(-10*time.Minute)) &&
+			job.Recipients != nil
+	})).Return(nil).Once()
+
+	reviewed, err := svc.ReviewDropSubmission(ctx, domain.ReviewDropSubmissionInput{ID: sub.ID, Action: domain.DropActionApprove, ActorID: "admin-1"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.DropApproved, reviewed.Status)
+
+	// Taking the drop down again cancels its alert
+	mockSchedulerRepo.On("Cancel", ctx, "drop:eip155-1:"+sub.ID).Return(nil).Once()
+	reviewed, err = svc.ReviewDropSubmission(ctx, domain.ReviewDropSubmissionInput{ID: sub.ID, Action: domain.DropActionReject, Note: "duplicate", ActorID: "admin-1"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.DropRejected, reviewed.Status)
+	assert.Equal(t, "duplicate", reviewed.ReviewNote)
+
+	_, err = svc.ReviewDropSubmission(ctx, domain.ReviewDropSubmissionInput{ID: sub.ID, Action: "publish"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = svc.ReviewDropSubmission(ctx, domain.ReviewDropSubmissionInput{ID: "missing", Action: domain.DropActionApprove})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	mockSchedulerRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleCollectionCreated_SchedulesDropAlert(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockProcessedEventRepo := new(MockProcessedEventsRepository)
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	mockPublisher := new(MockMessagePublisher)
+	svc := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), mockSchedulerRepo, new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+
+	ctx := context.Background()
+	mintStart := time.Now().Add(6 * time.Hour).Truncate(time.Second)
+	event := &domain.CollectionEvent{
+		EventID:   "drop-event-1",
+		EventType: "collection_created",
+		ChainID:   "eip155-1",
+		Contract:  marketContract,
+		Data: map[string]interface{}{
+			"collection_address": marketContract,
+			"creator":            "0x00000000000000000000000000000000000000AA",
+			"name":               "Drop Collection",
+			"collection_type":    "ERC721",
+			"mint_start_time":    fmt.Sprint(mintStart.Unix()),
+		},
+		Timestamp: time.Now(),
+	}
+
+	mockProcessedEventRepo.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.NotDuplicate, nil)
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(marketContract)).Return(domain.Collection{}, sql.ErrNoRows)
+	mockCollectionRepo.On("SlugOwner", ctx, "drop-collection").Return("", nil)
+	mockCollectionRepo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool {
+		return c.MintStartTime.Int64() == mintStart.Unix()
+	})).Return(true, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.AnythingOfType("*domain.DomainEvent")).Return(nil)
+	mockSchedulerRepo.On("Schedule", ctx, mock.MatchedBy(func(job domain.ScheduledJob) bool {
+		return job.ID == "drop:eip155-1:"+marketContract &&
+			job.Kind == domain.JobDropStartingSoon &&
+			job.EndsAt.Equal(mintStart) &&
+			job.FireAt.Equal(mintStart.Add(-15*time.Minute)) &&
+			assert.ObjectsAreEqual([]string{"0x00000000000000000000000000000000000000aa"}, job.Recipients)
+	})).Return(nil)
+
+	require.NoError(t, svc.HandleCollectionCreated(ctx, event))
+	mockSchedulerRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_FireDueAlerts_DropCountsDownToStart(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	mockPublisher := new(MockMessagePublisher)
+	svc := newSchedulerService(mockSchedulerRepo, mockPublisher)
+
+	ctx := context.Background()
+	now := time.Now()
+	startsAt := now.Add(15 * time.Minute)
+	mockSchedulerRepo.On("ClaimDue", ctx, now, mock.Anything).Return([]domain.ScheduledJob{{
+		ID:         "drop:eip155-1:sub-1",
+		Kind:       domain.JobDropStartingSoon,
+		ChainID:    "eip155-1",
+		SubjectID:  "sub-1",
+		Recipients: []string{},
+		EndsAt:     startsAt,
+		FireAt:     now,
+	}}, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(evt *domain.DomainEvent) bool {
+		_, hasEnd := evt.Data["ends_at"]
+		return evt.EventType == domain.JobDropStartingSoon && evt.Data["starts_at"] == startsAt && !hasEnd
+	})).Return(nil)
+
+	fired, err := svc.FireDueAlerts(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fired)
+	mockPublisher.AssertExpectations(t)
+}
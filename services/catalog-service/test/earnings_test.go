@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const soldContract = "0xabcdef0123456789abcdef0123456789abcdef01"
+
+func saleEvent(data map[string]interface{}) *domain.CollectionEvent {
+	return &domain.CollectionEvent{
+		EventID:   "sale-1",
+		EventType: "sale_indexed",
+		ChainID:   "eip155:1",
+		TxHash:    "0xsale",
+		Contract:  soldContract,
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+}
+
+func TestCatalogService_HandleSaleIndexed_ReportedRoyalty(t *testing.T) {
+	mockEarningsRepo := new(MockEarningsRepository)
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), mockEarningsRepo, new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockEarningsRepo.On("Record", ctx, mock.MatchedBy(func(e domain.RoyaltyEarning) bool {
+		return e.EventID == "sale-1" &&
+			e.ChainID == "eip155-1" &&
+			e.Recipient == "0x00000000000000000000000000000000000000aa" &&
+			e.Currency == "WETH" &&
+			e.Amount.String() == "50000000000000000"
+	})).Return(true, nil)
+
+	err := svc.HandleSaleIndexed(ctx, saleEvent(map[string]interface{}{
+		"token_id":          "7",
+		"price":             "1000000000000000000",
+		"currency":          "weth",
+		"royalty_recipient": "0x00000000000000000000000000000000000000AA",
+		"royalty_amount":    "50000000000000000",
+	}))
+
+	assert.NoError(t, err)
+	mockEarningsRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleSaleIndexed_DerivesRoyaltyFromCollection(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockEarningsRepo := new(MockEarningsRepository)
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), mockEarningsRepo, new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(soldContract)).
+		Return(domain.Collection{RoyaltyRecipient: "0xcreator", RoyaltyPercentage: 750}, nil)
+	mockEarningsRepo.On("Record", ctx, mock.MatchedBy(func(e domain.RoyaltyEarning) bool {
+		// 7.5% of 2 ETH
+		return e.Recipient == "0xcreator" && e.Currency == "ETH" && e.Amount.String() == "150000000000000000"
+	})).Return(true, nil)
+
+	err := svc.HandleSaleIndexed(ctx, saleEvent(map[string]interface{}{
+		"token_id": "7",
+		"price":    "2000000000000000000",
+	}))
+
+	assert.NoError(t, err)
+	mockEarningsRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleSaleIndexed_NoRoyaltyConfigured(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockEarningsRepo := new(MockEarningsRepository)
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), mockEarningsRepo, new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(soldContract)).
+		Return(domain.Collection{}, nil)
+
+	err := svc.HandleSaleIndexed(ctx, saleEvent(map[string]interface{}{
+		"token_id": "7",
+		"price":    "2000000000000000000",
+	}))
+
+	assert.NoError(t, err)
+	mockEarningsRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_GetEarnings(t *testing.T) {
+	mockEarningsRepo := new(MockEarningsRepository)
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), mockEarningsRepo, new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	totals := []domain.EarningsTotal{{ChainID: "eip155-1", ContractAddress: soldContract, Currency: "ETH", Amount: big.NewInt(42), SaleCount: 2}}
+	mockEarningsRepo.On("TotalsByRecipients", ctx, []string{"0xaa", "0xbb"}, mock.MatchedBy(func(since time.Time) bool {
+		return time.Since(since) > 6*24*time.Hour && time.Since(since) < 8*24*time.Hour
+	})).Return(totals, nil)
+
+	got, since, err := svc.GetEarnings(ctx, []string{"0xAA", "0xaa", " 0xbb ", ""}, "week")
+
+	assert.NoError(t, err)
+	assert.False(t, since.IsZero())
+	assert.Equal(t, totals, got)
+
+	_, _, err = svc.GetEarnings(ctx, []string{"0xaa"}, "decade")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	got, _, err = svc.GetEarnings(ctx, nil, "all")
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
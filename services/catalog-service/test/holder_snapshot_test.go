@@ -0,0 +1,374 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const otherHolder = "0x00000000000000000000000000000000000000bb"
+
+// memorySnapshots folds holders from recorded transfers like the SQL repository does
+type memorySnapshots struct {
+	transfers []domain.OwnershipTransfer
+	snapshots map[string]*domain.HolderSnapshot
+	balances  map[string][]domain.HolderBalance
+}
+
+func newMemorySnapshots() *memorySnapshots {
+	return &memorySnapshots{snapshots: map[string]*domain.HolderSnapshot{}, balances: map[string][]domain.HolderBalance{}}
+}
+
+func (m *memorySnapshots) RecordTransfers(ctx context.Context, transfers []domain.OwnershipTransfer) error {
+	m.transfers = append(m.transfers, transfers...)
+	return nil
+}
+
+func (m *memorySnapshots) LatestBlock(ctx context.Context, chainID, contract string) (uint64, bool, error) {
+	var latest uint64
+	found := false
+	for _, t := range m.transfers {
+		if t.ChainID == chainID && t.Contract == contract && t.BlockNumber >= latest {
+			latest, found = t.BlockNumber, true
+		}
+	}
+	return latest, found, nil
+}
+
+func (m *memorySnapshots) CreateSnapshot(ctx context.Context, s domain.HolderSnapshot) (*domain.HolderSnapshot, error) {
+	held := map[string]map[string]*big.Int{}
+	move := func(holder, tokenID string, amount *big.Int) {
+		if holder == zeroAddr {
+			return
+		}
+		if held[holder] == nil {
+			held[holder] = map[string]*big.Int{}
+		}
+		if held[holder][tokenID] == nil {
+			held[holder][tokenID] = new(big.Int)
+		}
+		held[holder][tokenID].Add(held[holder][tokenID], amount)
+	}
+	for _, t := range m.transfers {
+		if t.ChainID == s.ChainID && t.Contract == s.ContractAddress && t.BlockNumber <= s.BlockNumber {
+			move(t.To, t.TokenID, t.Quantity)
+			move(t.From, t.TokenID, new(big.Int).Neg(t.Quantity))
+		}
+	}
+
+	s.ID = fmt.Sprintf("snapshot-%d", len(m.snapshots)+1)
+	s.TotalQuantity = new(big.Int)
+	s.CreatedAt = time.Now()
+	var balances []domain.HolderBalance
+	for holder, tokens := range held {
+		b := domain.HolderBalance{Holder: holder, Balance: new(big.Int)}
+		for _, amount := range tokens {
+			if amount.Sign() > 0 {
+				b.Balance.Add(b.Balance, amount)
+				b.TokenCount++
+			}
+		}
+		if b.TokenCount > 0 {
+			balances = append(balances, b)
+			s.TotalQuantity.Add(s.TotalQuantity, b.Balance)
+		}
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].Balance.Cmp(balances[j].Balance) > 0 })
+	s.HolderCount = len(balances)
+
+	m.snapshots[s.ID] = &s
+	m.balances[s.ID] = balances
+	out := s
+	return &out, nil
+}
+
+func (m *memorySnapshots) SetExports(ctx context.Context, id, csvArtifactID, jsonArtifactID string) error {
+	m.snapshots[id].CSVArtifactID, m.snapshots[id].JSONArtifactID = csvArtifactID, jsonArtifactID
+	return nil
+}
+
+func (m *memorySnapshots) Get(ctx context.Context, id string) (*domain.HolderSnapshot, error) {
+	s, ok := m.snapshots[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	out := *s
+	return &out, nil
+}
+
+func (m *memorySnapshots) ListBalances(ctx context.Context, id string) ([]domain.HolderBalance, error) {
+	return m.balances[id], nil
+}
+
+func (m *memorySnapshots) HeldBalance(ctx context.Context, chainID, contract string, owners []string) (*big.Int, error) {
+	balance := new(big.Int)
+	for _, t := range m.transfers {
+		if t.ChainID != chainID || t.Contract != contract {
+			continue
+		}
+		for _, owner := range owners {
+			if t.To == owner {
+				balance.Add(balance, t.Quantity)
+			}
+			if t.From == owner {
+				balance.Sub(balance, t.Quantity)
+			}
+		}
+	}
+	return balance, nil
+}
+
+func (m *memorySnapshots) TokenBalances(ctx context.Context, chainID, contract string, holders, tokenIDs []string) (map[string]map[string]*big.Int, error) {
+	balances := make(map[string]map[string]*big.Int)
+	add := func(holder, tokenID string, quantity *big.Int) {
+		if !slices.Contains(holders, holder) || !slices.Contains(tokenIDs, tokenID) {
+			return
+		}
+		if balances[holder] == nil {
+			balances[holder] = make(map[string]*big.Int)
+		}
+		if balances[holder][tokenID] == nil {
+			balances[holder][tokenID] = new(big.Int)
+		}
+		balances[holder][tokenID].Add(balances[holder][tokenID], quantity)
+	}
+	for _, t := range m.transfers {
+		if t.ChainID != chainID || t.Contract != contract {
+			continue
+		}
+		add(t.To, t.TokenID, t.Quantity)
+		add(t.From, t.TokenID, new(big.Int).Neg(t.Quantity))
+	}
+	return balances, nil
+}
+
+// memoryArtifacts keeps stored exports; deleting one stands in for media-service retention
+type memoryArtifacts struct {
+	content map[string][]byte
+}
+
+func (m *memoryArtifacts) Store(ctx context.Context, name, mime string, content []byte, ownerID string) (*domain.SnapshotExport, error) {
+	id := fmt.Sprintf("artifact-%d:%s", len(m.content)+1, name)
+	m.content[id] = content
+	return m.Get(ctx, id)
+}
+
+func (m *memoryArtifacts) Get(ctx context.Context, artifactID string) (*domain.SnapshotExport, error) {
+	content, ok := m.content[artifactID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &domain.SnapshotExport{
+		ArtifactID:   artifactID,
+		DownloadURL:  "https://gateway.test/artifacts/" + artifactID + "?sig=x",
+		URLExpiresAt: time.Now().Add(time.Hour),
+		Bytes:        int64(len(content)),
+	}, nil
+}
+
+func newSnapshotService() (*service.CatalogService, *memorySnapshots, *memoryArtifacts) {
+	collectionRepo := new(MockCollectionsRepository)
+	moderationRepo := new(MockModerationRepository)
+	collectionRepo.On("GetByPK", context.Background(), domain.ChainID("eip155-1"), domain.Address(editionContract)).Return(domain.Collection{
+		ChainID:         "eip155-1",
+		ContractAddress: editionContract,
+		CollectionType:  "ERC1155",
+	}, nil)
+	moderationRepo.On("Get", context.Background(), domain.ChainID("eip155-1"), domain.Address(editionContract), "").Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	supplyRepo := new(MockTokenSupplyRepository)
+	supplyRepo.On("ApplyTransfer", context.Background(), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]domain.TokenSupply{}, false, nil)
+
+	svc := newSupplyService(collectionRepo, moderationRepo, supplyRepo, new(MockMessagePublisher))
+	snapshots := newMemorySnapshots()
+	artifacts := &memoryArtifacts{content: map[string][]byte{}}
+	svc.SetHolderSnapshots(snapshots, artifacts)
+	return svc, snapshots, artifacts
+}
+
+func indexedTransfer(t *testing.T, svc *service.CatalogService, id string, block int, args map[string]interface{}) {
+	evt := transferEvent("transfer_batch", args)
+	evt.EventID = id
+	evt.Data["block_number"] = fmt.Sprint(block)
+	evt.Data["log_index"] = float64(block)
+	require.NoError(t, svc.HandleDecodedEvent(context.Background(), evt))
+}
+
+func TestCatalogService_HandleDecodedEvent_IndexesOwnership(t *testing.T) {
+	svc, snapshots, _ := newSnapshotService()
+
+	indexedTransfer(t, svc, "mint", 100, map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "ids": []interface{}{"1", "2", "1"}, "values": []interface{}{"5", "3", "2"},
+	})
+
+	require.Len(t, snapshots.transfers, 2, "repeated ids of a batch share one row")
+	assert.Equal(t, "1", snapshots.transfers[0].TokenID)
+	assert.Equal(t, "7", snapshots.transfers[0].Quantity.String())
+	assert.Equal(t, uint64(100), snapshots.transfers[0].BlockNumber)
+	assert.Equal(t, 100, snapshots.transfers[0].LogIndex)
+	assert.Equal(t, holderAddr, snapshots.transfers[0].To)
+}
+
+func TestCatalogService_HandleDecodedEvent_SkipsOwnershipWithoutBlock(t *testing.T) {
+	svc, snapshots, _ := newSnapshotService()
+
+	evt := transferEvent("transfer_single", map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "id": "1", "value": "1",
+	})
+	require.NoError(t, svc.HandleDecodedEvent(context.Background(), evt))
+	assert.Empty(t, snapshots.transfers)
+}
+
+type recordingHoldingsNotifier struct {
+	events []contracts.HoldingsChangedEvent
+}
+
+func (n *recordingHoldingsNotifier) PublishHoldingsChanged(ctx context.Context, event contracts.HoldingsChangedEvent) error {
+	n.events = append(n.events, event)
+	return nil
+}
+
+func TestCatalogService_HandleDecodedEvent_AnnouncesHoldings(t *testing.T) {
+	svc, _, _ := newSnapshotService()
+	notifier := &recordingHoldingsNotifier{}
+	svc.SetHoldingsNotifier(notifier)
+
+	indexedTransfer(t, svc, "mint", 100, map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "ids": []interface{}{"1", "2"}, "values": []interface{}{"5", "3"},
+	})
+	// Part of token 1 and all of token 2 move to a wallet holding neither
+	indexedTransfer(t, svc, "transfer", 110, map[string]interface{}{
+		"from": holderAddr, "to": otherHolder, "ids": []interface{}{"1", "2"}, "values": []interface{}{"2", "3"},
+	})
+	// More of a token the recipient holds already changes no counts
+	indexedTransfer(t, svc, "top-up", 120, map[string]interface{}{
+		"from": holderAddr, "to": otherHolder, "ids": []interface{}{"1"}, "values": []interface{}{"1"},
+	})
+
+	require.Len(t, notifier.events, 2)
+	assert.Equal(t, "holdings_changed_mint", notifier.events[0].EventID)
+	assert.Equal(t, "eip155-1", notifier.events[0].ChainID)
+	assert.Equal(t, map[string]int64{holderAddr: 2}, notifier.events[0].Deltas, "the zero address holds nothing")
+	assert.Equal(t, map[string]int64{holderAddr: -1, otherHolder: 2}, notifier.events[1].Deltas)
+}
+
+func TestCatalogService_CreateHolderSnapshot_AtBlock(t *testing.T) {
+	svc, _, artifacts := newSnapshotService()
+	ctx := context.Background()
+
+	indexedTransfer(t, svc, "mint", 100, map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "ids": []interface{}{"1", "2"}, "values": []interface{}{"5", "3"},
+	})
+	indexedTransfer(t, svc, "transfer", 110, map[string]interface{}{
+		"from": holderAddr, "to": otherHolder, "ids": []interface{}{"2"}, "values": []interface{}{"3"},
+	})
+	indexedTransfer(t, svc, "burn", 120, map[string]interface{}{
+		"from": holderAddr, "to": zeroAddr, "ids": []interface{}{"1"}, "values": []interface{}{"5"},
+	})
+
+	block := uint64(110)
+	snapshot, err := svc.CreateHolderSnapshot(ctx, domain.CreateHolderSnapshotInput{
+		ChainID:     "eip155:1",
+		Contract:    domain.Address(editionContract),
+		BlockNumber: &block,
+		RequestedBy: "user-1",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(110), snapshot.BlockNumber)
+	assert.Equal(t, 2, snapshot.HolderCount)
+	assert.Equal(t, "8", snapshot.TotalQuantity.String())
+	require.NotNil(t, snapshot.CSV)
+	require.NotNil(t, snapshot.JSON)
+
+	assert.Equal(t, "holder,balance,token_count\n"+holderAddr+",5,1\n"+otherHolder+",3,1\n", string(artifacts.content[snapshot.CSV.ArtifactID]))
+
+	var export struct {
+		BlockNumber string `json:"block_number"`
+		Holders     []struct {
+			Holder  string `json:"holder"`
+			Balance string `json:"balance"`
+		} `json:"holders"`
+	}
+	require.NoError(t, json.Unmarshal(artifacts.content[snapshot.JSON.ArtifactID], &export))
+	assert.Equal(t, "110", export.BlockNumber)
+	require.Len(t, export.Holders, 2)
+	assert.Equal(t, otherHolder, export.Holders[1].Holder)
+	assert.Equal(t, "3", export.Holders[1].Balance)
+}
+
+func TestCatalogService_CreateHolderSnapshot_DefaultsToLatestBlock(t *testing.T) {
+	svc, _, _ := newSnapshotService()
+
+	indexedTransfer(t, svc, "mint", 100, map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "ids": []interface{}{"1"}, "values": []interface{}{"5"},
+	})
+	indexedTransfer(t, svc, "burn", 120, map[string]interface{}{
+		"from": holderAddr, "to": zeroAddr, "ids": []interface{}{"1"}, "values": []interface{}{"5"},
+	})
+
+	snapshot, err := svc.CreateHolderSnapshot(context.Background(), domain.CreateHolderSnapshotInput{
+		ChainID:     "eip155-1",
+		Contract:    domain.Address(editionContract),
+		RequestedBy: "user-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(120), snapshot.BlockNumber)
+	assert.Equal(t, 0, snapshot.HolderCount)
+}
+
+func TestCatalogService_CreateHolderSnapshot_RejectsUnindexedBlock(t *testing.T) {
+	svc, _, _ := newSnapshotService()
+	ctx := context.Background()
+	in := domain.CreateHolderSnapshotInput{ChainID: "eip155-1", Contract: domain.Address(editionContract), RequestedBy: "user-1"}
+
+	_, err := svc.CreateHolderSnapshot(ctx, in)
+	assert.True(t, errs.Is(err, errs.FailedPrecondition), "nothing indexed yet")
+
+	indexedTransfer(t, svc, "mint", 100, map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "ids": []interface{}{"1"}, "values": []interface{}{"5"},
+	})
+	block := uint64(101)
+	in.BlockNumber = &block
+	_, err = svc.CreateHolderSnapshot(ctx, in)
+	assert.True(t, errs.Is(err, errs.FailedPrecondition), "block past the index")
+}
+
+func TestCatalogService_GetHolderSnapshot_ReexportsExpired(t *testing.T) {
+	svc, _, artifacts := newSnapshotService()
+	ctx := context.Background()
+
+	indexedTransfer(t, svc, "mint", 100, map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "ids": []interface{}{"1"}, "values": []interface{}{"5"},
+	})
+	created, err := svc.CreateHolderSnapshot(ctx, domain.CreateHolderSnapshotInput{
+		ChainID: "eip155-1", Contract: domain.Address(editionContract), RequestedBy: "user-1",
+	})
+	require.NoError(t, err)
+
+	delete(artifacts.content, created.CSV.ArtifactID)
+
+	snapshot, err := svc.GetHolderSnapshot(ctx, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot.CSV)
+	assert.NotEqual(t, created.CSV.ArtifactID, snapshot.CSV.ArtifactID)
+	assert.Contains(t, string(artifacts.content[snapshot.CSV.ArtifactID]), holderAddr+",5,1")
+
+	again, err := svc.GetHolderSnapshot(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.CSV.ArtifactID, again.CSV.ArtifactID, "exports are reused while stored")
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+)
+
+type stubMetadataFetcher struct {
+	meta *domain.ContractMetadata
+	err  error
+	uris []string
+}
+
+func (s *stubMetadataFetcher) FetchContractMetadata(ctx context.Context, uri string) (*domain.ContractMetadata, error) {
+	s.uris = append(s.uris, uri)
+	return s.meta, s.err
+}
+
+func importedEvent() *domain.CollectionEvent {
+	return &domain.CollectionEvent{
+		EventID:   "collection_imported_eip155-1_0xlegacy",
+		EventType: "collection_imported",
+		ChainID:   "eip155-1",
+		Contract:  "0x00000000000000000000000000000000000000d1",
+		Data: map[string]interface{}{
+			"collection_address": "0x00000000000000000000000000000000000000D1",
+			"owner":              "0xABCDEFabcdefABCDEFabcdefABCDEFabcdefABCD",
+			"collection_type":    "ERC721",
+			"name":               "Legacy Apes",
+			"contract_uri":       "ipfs://bafy/contract.json",
+			"imported_by":        "user-1",
+		},
+		Timestamp: time.Now(),
+	}
+}
+
+func TestHandleCollectionImported_ListsCollectionWithCrawledMetadata(t *testing.T) {
+	ctx := context.Background()
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockProcessedEventRepo := new(MockProcessedEventsRepository)
+	mockPublisher := new(MockMessagePublisher)
+	svc := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+	fetcher := &stubMetadataFetcher{meta: &domain.ContractMetadata{
+		Description: "Apes from 2021", Image: "ipfs://bafy/cover.png", ExternalLink: "https://legacy.example",
+	}}
+	svc.SetMetadataFetcher(fetcher)
+
+	event := importedEvent()
+	mockProcessedEventRepo.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.NotDuplicate, nil)
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address("0x00000000000000000000000000000000000000d1")).Return(domain.Collection{}, sql.ErrNoRows)
+	mockCollectionRepo.On("SlugOwner", ctx, "legacy-apes").Return("", nil)
+	mockCollectionRepo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool {
+		return c.ContractAddress == "0x00000000000000000000000000000000000000d1" &&
+			c.Owner == "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd" && c.Creator == c.Owner &&
+			c.Description == "Apes from 2021" && c.ImageURL == "ipfs://bafy/cover.png" &&
+			c.Confirmations == 0 && c.RequiredConfirmations == 0
+	})).Return(true, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.AnythingOfType("*domain.DomainEvent")).Return(nil)
+
+	err := svc.HandleCollectionImported(ctx, event)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ipfs://bafy/contract.json"}, fetcher.uris)
+	mockCollectionRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestHandleCollectionImported_FailedCrawlStillLists(t *testing.T) {
+	ctx := context.Background()
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockProcessedEventRepo := new(MockProcessedEventsRepository)
+	mockPublisher := new(MockMessagePublisher)
+	svc := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+	svc.SetMetadataFetcher(&stubMetadataFetcher{err: errors.New("gateway timeout")})
+
+	event := importedEvent()
+	mockProcessedEventRepo.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.NotDuplicate, nil)
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address("0x00000000000000000000000000000000000000d1")).Return(domain.Collection{}, sql.ErrNoRows)
+	mockCollectionRepo.On("SlugOwner", ctx, "legacy-apes").Return("", nil)
+	mockCollectionRepo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool {
+		return c.Name == "Legacy Apes" && c.Description == ""
+	})).Return(true, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.AnythingOfType("*domain.DomainEvent")).Return(nil)
+
+	assert.NoError(t, svc.HandleCollectionImported(ctx, event))
+	mockCollectionRepo.AssertExpectations(t)
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+)
+
+func newListingService(repo *MockCollectionsRepository) *service.CatalogService {
+	return service.NewCatalogService(repo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+}
+
+func TestCatalogService_ListCollections_DefaultsToNewestFirst(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCollectionsRepository)
+	repo.On("List", ctx, domain.CollectionFilter{
+		ChainID:      "eip155-1",
+		VerifiedOnly: true,
+		Sort:         domain.CollectionSortCreatedAt,
+		Descending:   true,
+		Limit:        20,
+	}).Return([]domain.Collection{{ID: "collection-1"}}, nil)
+
+	collections, err := newListingService(repo).ListCollections(ctx, domain.CollectionFilter{ChainID: "eip155:1", VerifiedOnly: true})
+	require.NoError(t, err)
+	assert.Len(t, collections, 1)
+}
+
+func TestCatalogService_ListCollections_RejectsInvalidFilters(t *testing.T) {
+	repo := new(MockCollectionsRepository)
+	svc := newListingService(repo)
+
+	tests := map[string]domain.CollectionFilter{
+		"unknown sort":   {Sort: "name; DROP TABLE collections"},
+		"negative floor": {FloorPrice: domain.PriceRange{Min: big.NewInt(-1)}},
+		"inverted range": {FloorPrice: domain.PriceRange{Min: big.NewInt(10), Max: big.NewInt(5)}},
+	}
+	for name, filter := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.ListCollections(context.Background(), filter)
+			assert.True(t, errs.Is(err, errs.InvalidArgument), "got %v", err)
+		})
+	}
+	repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_ListTokens_NormalizesTraitFilters(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCollectionsRepository)
+	price := domain.PriceRange{Max: big.NewInt(1_000_000)}
+
+	var got domain.TokenFilter
+	repo.On("ListTokens", ctx, mock.AnythingOfType("domain.TokenFilter")).
+		Run(func(args mock.Arguments) { got = args.Get(1).(domain.TokenFilter) }).
+		Return([]domain.Token{{TokenID: "7"}}, nil)
+
+	tokens, err := newListingService(repo).ListTokens(ctx, domain.TokenFilter{
+		ChainID:         "eip155:1",
+		ContractAddress: "0x00000000000000000000000000000000000000AB",
+		Price:           price,
+		Traits: []domain.TraitFilter{
+			{Name: " Background ", Values: []string{"Blue", " "}},
+			{Name: "background", Values: []string{"RED"}},
+			{Name: "Eyes", Values: []string{"Laser"}},
+		},
+		Sort:  domain.TokenSortRarity,
+		Limit: 500,
+	})
+	require.NoError(t, err)
+	assert.Len(t, tokens, 1)
+
+	assert.Equal(t, "eip155-1", got.ChainID)
+	assert.Equal(t, "0x00000000000000000000000000000000000000ab", got.ContractAddress)
+	assert.Equal(t, []domain.TraitFilter{
+		{Name: "background", Values: []string{"blue", "red"}},
+		{Name: "eyes", Values: []string{"laser"}},
+	}, got.Traits)
+	assert.Equal(t, domain.TokenSortRarity, got.Sort)
+	assert.False(t, got.Descending)
+	assert.Equal(t, price, got.Price)
+	assert.Equal(t, 100, got.Limit)
+}
+
+func TestCatalogService_ListTokens_RejectsInvalidFilters(t *testing.T) {
+	repo := new(MockCollectionsRepository)
+	svc := newListingService(repo)
+
+	tooManyTraits := make([]domain.TraitFilter, 11)
+	for i := range tooManyTraits {
+		tooManyTraits[i] = domain.TraitFilter{Name: string(rune('a' + i)), Values: []string{"x"}}
+	}
+
+	tests := map[string]domain.TokenFilter{
+		"unknown sort":        {Sort: "floor"},
+		"trait without name":  {Traits: []domain.TraitFilter{{Values: []string{"blue"}}}},
+		"trait without value": {Traits: []domain.TraitFilter{{Name: "background", Values: []string{" "}}}},
+		"too many traits":     {Traits: tooManyTraits},
+		"inverted price":      {Price: domain.PriceRange{Min: big.NewInt(2), Max: big.NewInt(1)}},
+	}
+	for name, filter := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.ListTokens(context.Background(), filter)
+			assert.True(t, errs.Is(err, errs.InvalidArgument), "got %v", err)
+		})
+	}
+	repo.AssertNotCalled(t, "ListTokens", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,134 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+)
+
+const contentContract = "0x00000000000000000000000000000000000000c7"
+
+// memoryLocalizedContentRepo keeps one collection's content by locale
+type memoryLocalizedContentRepo struct {
+	content map[string]domain.LocalizedContent
+}
+
+func (r *memoryLocalizedContentRepo) List(ctx context.Context, chainID domain.ChainID, contract domain.Address) ([]domain.LocalizedContent, error) {
+	out := make([]domain.LocalizedContent, 0, len(r.content))
+	for _, c := range r.content {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Locale < out[j].Locale })
+	return out, nil
+}
+
+func (r *memoryLocalizedContentRepo) Put(ctx context.Context, chainID domain.ChainID, contract domain.Address, content domain.LocalizedContent) error {
+	r.content[content.Locale] = content
+	return nil
+}
+
+func (r *memoryLocalizedContentRepo) Delete(ctx context.Context, chainID domain.ChainID, contract domain.Address, locale string) error {
+	delete(r.content, locale)
+	return nil
+}
+
+func localizedService(t *testing.T) (*service.CatalogService, *memoryLocalizedContentRepo) {
+	t.Helper()
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockModerationRepo := new(MockModerationRepository)
+	mockCollectionRepo.On("GetByPK", context.Background(), domain.ChainID("eip155-1"), domain.Address(contentContract)).
+		Return(domain.Collection{ID: "collection-1", ChainID: "eip155-1", ContractAddress: contentContract, Description: "On-chain description"}, nil)
+	mockModerationRepo.On("Get", context.Background(), domain.ChainID("eip155-1"), domain.Address(contentContract), "").
+		Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), mockModerationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+	repo := &memoryLocalizedContentRepo{content: map[string]domain.LocalizedContent{}}
+	svc.SetLocalizedContent(repo)
+	return svc, repo
+}
+
+func contentInput(locale, description, tagline string) domain.SetCollectionContentInput {
+	return domain.SetCollectionContentInput{
+		ChainID:         "eip155:1",
+		ContractAddress: domain.Address(strings.ToUpper(contentContract[:2]) + contentContract[2:]),
+		Locale:          locale,
+		Description:     description,
+		Tagline:         tagline,
+		ActorID:         "user-1",
+	}
+}
+
+func TestCatalogService_SetCollectionContent(t *testing.T) {
+	svc, repo := localizedService(t)
+	ctx := context.Background()
+
+	collection, err := svc.SetCollectionContent(ctx, contentInput("pt_br", "  Descrição  ", "Arte generativa"))
+	require.NoError(t, err)
+
+	// The locale is canonicalized and the collection comes back with its content
+	require.Len(t, collection.Localized, 1)
+	assert.Equal(t, "pt-BR", collection.Localized[0].Locale)
+	assert.Equal(t, "Descrição", collection.Localized[0].Description)
+	assert.Equal(t, "Arte generativa", collection.Localized[0].Tagline)
+	assert.Equal(t, "user-1", collection.Localized[0].UpdatedByUserID)
+	assert.Equal(t, "On-chain description", collection.Description)
+
+	got, err := svc.GetCollection(ctx, "eip155-1", contentContract, false, false)
+	require.NoError(t, err)
+	assert.Len(t, got.Localized, 1)
+
+	// Clearing both fields removes the locale
+	collection, err = svc.SetCollectionContent(ctx, contentInput("pt-BR", "", " "))
+	require.NoError(t, err)
+	assert.Empty(t, collection.Localized)
+	assert.Empty(t, repo.content)
+}
+
+func TestCatalogService_SetCollectionContent_Validation(t *testing.T) {
+	svc, repo := localizedService(t)
+	ctx := context.Background()
+
+	cases := map[string]domain.SetCollectionContentInput{
+		"locale":      contentInput("not a locale", "desc", ""),
+		"description": contentInput("en", strings.Repeat("a", 5001), ""),
+		"tagline":     contentInput("en", "", strings.Repeat("a", 141)),
+		"actor":       {ChainID: "eip155:1", ContractAddress: contentContract, Locale: "en", Description: "desc"},
+	}
+	for name, in := range cases {
+		_, err := svc.SetCollectionContent(ctx, in)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, name)
+	}
+	assert.Empty(t, repo.content)
+}
+
+func TestCatalogService_SetCollectionContent_LocaleCap(t *testing.T) {
+	svc, repo := localizedService(t)
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		repo.content[fmt.Sprintf("x-l%02d", i)] = domain.LocalizedContent{Locale: fmt.Sprintf("x-l%02d", i), Description: "desc"}
+	}
+
+	_, err := svc.SetCollectionContent(ctx, contentInput("fr", "Description", ""))
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	// Locales already present can still be edited
+	_, err = svc.SetCollectionContent(ctx, contentInput("x-l00", "Updated", ""))
+	assert.NoError(t, err)
+}
+
+func TestCatalogService_SetCollectionContent_Disabled(t *testing.T) {
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	_, err := svc.SetCollectionContent(context.Background(), contentInput("en", "desc", ""))
+
+	assert.ErrorIs(t, err, domain.ErrUnavailable)
+}
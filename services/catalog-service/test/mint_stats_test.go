@@ -0,0 +1,160 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// memoryMintStatsRepo folds mints into minute buckets in memory
+type memoryMintStatsRepo struct {
+	events  map[string]bool
+	buckets map[time.Time]*domain.MintStatsBucket
+	minters map[time.Time]map[string]bool
+	since   time.Time
+}
+
+func newMemoryMintStatsRepo() *memoryMintStatsRepo {
+	return &memoryMintStatsRepo{
+		events:  make(map[string]bool),
+		buckets: make(map[time.Time]*domain.MintStatsBucket),
+		minters: make(map[time.Time]map[string]bool),
+	}
+}
+
+func (r *memoryMintStatsRepo) RecordMint(ctx context.Context, m domain.MintRecord) (domain.MintStatsBucket, bool, error) {
+	if r.events[m.EventID] {
+		return domain.MintStatsBucket{}, false, nil
+	}
+	r.events[m.EventID] = true
+
+	minute := m.MintedAt.Truncate(time.Minute)
+	b, ok := r.buckets[minute]
+	if !ok {
+		b = &domain.MintStatsBucket{Minute: minute, Mints: new(big.Int), Revenue: new(big.Int)}
+		r.buckets[minute] = b
+		r.minters[minute] = make(map[string]bool)
+	}
+	b.Mints.Add(b.Mints, m.Quantity)
+	b.Revenue.Add(b.Revenue, m.Revenue)
+	r.minters[minute][m.Minter] = true
+	b.UniqueMinters = int64(len(r.minters[minute]))
+	return *b, true, nil
+}
+
+func (r *memoryMintStatsRepo) GetMintStats(ctx context.Context, chainID, contract string, since time.Time) (domain.MintStats, error) {
+	r.since = since
+	return domain.MintStats{ChainID: chainID, ContractAddress: contract, Since: since, Mints: new(big.Int), Revenue: new(big.Int)}, nil
+}
+
+type recordingMintNotifier struct {
+	updates []contracts.MintStatsUpdate
+}
+
+func (n *recordingMintNotifier) PublishMintStats(ctx context.Context, update contracts.MintStatsUpdate) error {
+	n.updates = append(n.updates, update)
+	return nil
+}
+
+func mintEvent(eventID, minter string, at time.Time) *domain.CollectionEvent {
+	evt := transferEvent("transfer_single", map[string]interface{}{
+		"operator": minter, "from": zeroAddr, "to": minter, "id": "1", "value": "2",
+	})
+	evt.EventID = eventID
+	evt.Timestamp = at
+	return evt
+}
+
+func TestCatalogService_HandleDecodedEvent_RecordsMintStats(t *testing.T) {
+	collectionRepo := new(MockCollectionsRepository)
+	supplyRepo := new(MockTokenSupplyRepository)
+	publisher := new(MockMessagePublisher)
+	svc := newSupplyService(collectionRepo, new(MockModerationRepository), supplyRepo, publisher)
+	repo := newMemoryMintStatsRepo()
+	notifier := &recordingMintNotifier{}
+	svc.SetMintStats(repo, notifier)
+	ctx := context.Background()
+
+	collectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(editionContract)).Return(domain.Collection{
+		MintPrice:       big.NewInt(5),
+		PublicMintPrice: big.NewInt(100),
+	}, nil)
+	supplyRepo.On("ApplyTransfer", ctx, mock.Anything, "eip155-1", editionContract, mock.Anything).Return(nil, false, nil)
+
+	minute := time.Date(2026, 10, 1, 12, 30, 0, 0, time.UTC)
+	require.NoError(t, svc.HandleDecodedEvent(ctx, mintEvent("m1", holderAddr, minute.Add(5*time.Second))))
+	require.NoError(t, svc.HandleDecodedEvent(ctx, mintEvent("m2", holderAddr, minute.Add(40*time.Second))))
+	require.NoError(t, svc.HandleDecodedEvent(ctx, mintEvent("m3", "0x00000000000000000000000000000000000000bb", minute.Add(50*time.Second))))
+	// Redelivery is not counted again
+	require.NoError(t, svc.HandleDecodedEvent(ctx, mintEvent("m3", "0x00000000000000000000000000000000000000bb", minute.Add(50*time.Second))))
+
+	require.Len(t, notifier.updates, 3)
+	last := notifier.updates[2]
+	assert.Equal(t, "eip155-1", last.ChainID)
+	assert.Equal(t, editionContract, last.ContractAddress)
+	assert.True(t, last.Minute.Equal(minute))
+	assert.Equal(t, "6", last.Mints)
+	assert.Equal(t, int64(2), last.UniqueMinters)
+	// Public price wins over the base mint price
+	assert.Equal(t, "600", last.Revenue)
+}
+
+func TestCatalogService_HandleDecodedEvent_MintStatsSkipTransfersAndUnknownCollections(t *testing.T) {
+	collectionRepo := new(MockCollectionsRepository)
+	supplyRepo := new(MockTokenSupplyRepository)
+	svc := newSupplyService(collectionRepo, new(MockModerationRepository), supplyRepo, new(MockMessagePublisher))
+	repo := newMemoryMintStatsRepo()
+	notifier := &recordingMintNotifier{}
+	svc.SetMintStats(repo, notifier)
+	ctx := context.Background()
+
+	transfer := transferEvent("transfer_single", map[string]interface{}{
+		"from": holderAddr, "to": "0x00000000000000000000000000000000000000bb", "id": "1", "value": "1",
+	})
+	require.NoError(t, svc.HandleDecodedEvent(ctx, transfer))
+	assert.Empty(t, notifier.updates)
+
+	collectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(editionContract)).Return(domain.Collection{}, sql.ErrNoRows)
+	supplyRepo.On("ApplyTransfer", ctx, mock.Anything, "eip155-1", editionContract, mock.Anything).Return(nil, false, nil)
+	require.NoError(t, svc.HandleDecodedEvent(ctx, mintEvent("m1", holderAddr, time.Now())))
+	require.Len(t, notifier.updates, 1)
+	assert.Equal(t, "2", notifier.updates[0].Mints)
+	assert.Equal(t, "0", notifier.updates[0].Revenue)
+}
+
+func TestCatalogService_GetMintStats_Windows(t *testing.T) {
+	svc := newSupplyService(new(MockCollectionsRepository), new(MockModerationRepository), new(MockTokenSupplyRepository), new(MockMessagePublisher))
+	ctx := context.Background()
+
+	_, err := svc.GetMintStats(ctx, "eip155-1", editionContract, "1h")
+	assert.ErrorIs(t, err, domain.ErrUnavailable)
+
+	repo := newMemoryMintStatsRepo()
+	svc.SetMintStats(repo, nil)
+
+	stats, err := svc.GetMintStats(ctx, "eip155:1", "0x5FbDB2315678afecb367f032d93F642f64180aa3", "15M")
+	require.NoError(t, err)
+	assert.Equal(t, domain.MintStatsWindow15m, stats.Window)
+	assert.Equal(t, "eip155-1", stats.ChainID)
+	assert.Equal(t, editionContract, stats.ContractAddress)
+	assert.Zero(t, repo.since.Second())
+	assert.WithinDuration(t, time.Now().Add(-15*time.Minute), repo.since, time.Minute)
+
+	stats, err = svc.GetMintStats(ctx, "eip155-1", editionContract, "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.MintStatsWindow1h, stats.Window)
+
+	_, err = svc.GetMintStats(ctx, "eip155-1", editionContract, "7d")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = svc.GetMintStats(ctx, "eip155-1", "not-an-address", "1h")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const flaggedContract = "0x1234567890123456789012345678901234567890"
+
+func TestCatalogService_FlagItem_AdminHidesCollection(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockModerationRepo := new(MockModerationRepository)
+	mockPublisher := new(MockMessagePublisher)
+
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), mockModerationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+
+	ctx := context.Background()
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract), "").
+		Return(domain.ModerationFlag{}, sql.ErrNoRows)
+	mockModerationRepo.On("Upsert", ctx, mock.MatchedBy(func(f domain.ModerationFlag) bool {
+		return f.Status == domain.ModerationFlagged && f.ChainID == "eip155-1" && f.Reason == "stolen"
+	})).Return(domain.ModerationFlag{ID: "flag-1", ChainID: "eip155-1", ContractAddress: flaggedContract, Status: domain.ModerationFlagged, Reason: "stolen"}, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(e *domain.DomainEvent) bool {
+		return e.EventType == "catalog.item_flagged" && e.Data["hidden"] == true
+	})).Return(nil)
+
+	flag, err := svc.FlagItem(ctx, domain.FlagItemInput{
+		ChainID:         "eip155:1",
+		ContractAddress: flaggedContract,
+		Reason:          "stolen",
+		Source:          domain.ModerationSourceAdmin,
+		ActorID:         "admin-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ModerationFlagged, flag.Status)
+	mockModerationRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestCatalogService_FlagItem_ReportDoesNotDowngradeAdminFlag(t *testing.T) {
+	mockModerationRepo := new(MockModerationRepository)
+	mockPublisher := new(MockMessagePublisher)
+
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), mockModerationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+
+	ctx := context.Background()
+	existing := domain.ModerationFlag{ID: "flag-1", ChainID: "eip155-1", ContractAddress: flaggedContract, Status: domain.ModerationFlagged}
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract), "7").Return(existing, nil)
+
+	flag, err := svc.FlagItem(ctx, domain.FlagItemInput{
+		ChainID:         "eip155-1",
+		ContractAddress: flaggedContract,
+		TokenID:         "7",
+		Source:          domain.ModerationSourceReport,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ModerationFlagged, flag.Status)
+	mockModerationRepo.AssertNotCalled(t, "Upsert")
+	mockPublisher.AssertNotCalled(t, "PublishDomainEvent")
+}
+
+func TestCatalogService_GetCollection_HidesFlagged(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockModerationRepo := new(MockModerationRepository)
+
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), mockModerationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract)).
+		Return(domain.Collection{ID: "collection-1", ChainID: "eip155-1", ContractAddress: flaggedContract}, nil)
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract), "").
+		Return(domain.ModerationFlag{Status: domain.ModerationFlagged}, nil)
+
+	_, err := svc.GetCollection(ctx, "eip155-1", flaggedContract, false, false)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	collection, err := svc.GetCollection(ctx, "eip155-1", flaggedContract, true, false)
+	assert.NoError(t, err)
+	assert.True(t, collection.Flagged())
+}
+
+func TestCatalogService_GetCollection_HidesPendingFinality(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockModerationRepo := new(MockModerationRepository)
+
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), mockModerationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract)).
+		Return(domain.Collection{ID: "collection-1", ChainID: "eip155-1", ContractAddress: flaggedContract, Confirmations: 3, RequiredConfirmations: 12}, nil)
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract), "").
+		Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	_, err := svc.GetCollection(ctx, "eip155-1", flaggedContract, false, false)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	collection, err := svc.GetCollection(ctx, "eip155-1", flaggedContract, false, true)
+	assert.NoError(t, err)
+	assert.True(t, collection.PendingFinality())
+}
+
+func TestCatalogService_UnflagItem_NotFound(t *testing.T) {
+	mockModerationRepo := new(MockModerationRepository)
+
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), mockModerationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract), "").
+		Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	_, err := svc.UnflagItem(ctx, domain.UnflagItemInput{ChainID: "eip155-1", ContractAddress: flaggedContract})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
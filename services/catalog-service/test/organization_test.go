@@ -0,0 +1,63 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+)
+
+const (
+	orgContract = "0x00000000000000000000000000000000000000d1"
+	orgID       = "3f2b8c1e-8a7d-4f7e-9c55-0d6e2a1b9c10"
+)
+
+func TestCatalogService_SetCollectionOrganization(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockModerationRepo := new(MockModerationRepository)
+
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), mockModerationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockCollectionRepo.On("SetOwnerOrg", ctx, domain.ChainID("eip155-1"), domain.Address(orgContract), orgID).Return(nil)
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(orgContract)).
+		Return(domain.Collection{ID: "collection-1", ChainID: "eip155-1", ContractAddress: orgContract, OwnerOrgID: orgID}, nil)
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(orgContract), "").
+		Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	// Chain id and address are normalized before the update
+	collection, err := svc.SetCollectionOrganization(ctx, "eip155:1", "0x00000000000000000000000000000000000000D1", orgID, "user-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, orgID, collection.OwnerOrgID)
+	mockCollectionRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_SetCollectionOrganization_InvalidOrgID(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	_, err := svc.SetCollectionOrganization(context.Background(), "eip155-1", orgContract, "not-a-uuid", "user-1")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockCollectionRepo.AssertNotCalled(t, "SetOwnerOrg", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_SetCollectionOrganization_UnknownCollection(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+
+	svc := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockCollectionRepo.On("SetOwnerOrg", ctx, domain.ChainID("eip155-1"), domain.Address(orgContract), "").Return(sql.ErrNoRows)
+
+	_, err := svc.SetCollectionOrganization(ctx, "eip155-1", orgContract, "", "user-1")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,97 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+)
+
+const splitContract = "0x00000000000000000000000000000000000000d4"
+
+// memoryPayoutSplitRepo keeps payout splits by chain and contract
+type memoryPayoutSplitRepo struct {
+	splits map[string][]domain.PayoutSplit
+}
+
+func (r *memoryPayoutSplitRepo) List(ctx context.Context, chainID domain.ChainID, contract domain.Address) ([]domain.PayoutSplit, error) {
+	return r.splits[string(chainID)+"/"+string(contract)], nil
+}
+
+func (r *memoryPayoutSplitRepo) Replace(ctx context.Context, chainID domain.ChainID, contract domain.Address, splits []domain.PayoutSplit) error {
+	r.splits[string(chainID)+"/"+string(contract)] = splits
+	return nil
+}
+
+func payoutSplitService(t *testing.T) (*service.CatalogService, *memoryPayoutSplitRepo, *MockCollectionsRepository, *MockProcessedEventsRepository) {
+	t.Helper()
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockProcessedEventRepo := new(MockProcessedEventsRepository)
+	mockModerationRepo := new(MockModerationRepository)
+	mockModerationRepo.On("Get", context.Background(), domain.ChainID("eip155-1"), domain.Address(splitContract), "").
+		Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	svc := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, mockModerationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+	repo := &memoryPayoutSplitRepo{splits: map[string][]domain.PayoutSplit{}}
+	svc.SetPayoutSplits(repo)
+	return svc, repo, mockCollectionRepo, mockProcessedEventRepo
+}
+
+// splitsEvent builds the event the indexer publishes, round-tripped through JSON like the consumer sees it
+func splitsEvent(t *testing.T, splits string) *domain.CollectionEvent {
+	t.Helper()
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"collection_address":"`+splitContract+`","kind":"payout_splits_updated","payout_splits":`+splits+`}`), &data))
+	return &domain.CollectionEvent{
+		EventID:   "splits-event-1",
+		EventType: "collection_payout_splits_updated",
+		ChainID:   "eip155-1",
+		Contract:  splitContract,
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+}
+
+func TestCatalogService_HandleCollectionUpdated_PayoutSplits(t *testing.T) {
+	svc, repo, collections, processed := payoutSplitService(t)
+	ctx := context.Background()
+	event := splitsEvent(t, `[{"recipient":"0x00000000000000000000000000000000000000AA","bps":"7000"},{"recipient":"0x00000000000000000000000000000000000000bb","bps":"3000"}]`)
+	processed.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.NotDuplicate, nil)
+
+	require.NoError(t, svc.HandleCollectionUpdated(ctx, event))
+
+	// The split is stored on its own; the collection row is not touched
+	assert.Equal(t, []domain.PayoutSplit{
+		{Recipient: "0x00000000000000000000000000000000000000aa", Bps: 7000},
+		{Recipient: "0x00000000000000000000000000000000000000bb", Bps: 3000},
+	}, repo.splits["eip155-1/"+splitContract])
+	collections.AssertNotCalled(t, "GetByPK")
+	collections.AssertNotCalled(t, "Upsert")
+
+	// A collection page read carries the split
+	collections.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(splitContract)).
+		Return(domain.Collection{ID: "collection-1", ChainID: "eip155-1", ContractAddress: splitContract}, nil)
+	collection, err := svc.GetCollection(ctx, "eip155:1", splitContract, false, false)
+	require.NoError(t, err)
+	require.Len(t, collection.PayoutSplits, 2)
+	assert.Equal(t, uint64(7000), collection.PayoutSplits[0].Bps)
+}
+
+func TestCatalogService_HandleCollectionUpdated_MalformedPayoutSplits(t *testing.T) {
+	svc, repo, _, processed := payoutSplitService(t)
+	ctx := context.Background()
+	event := splitsEvent(t, `[{"recipient":"0x00000000000000000000000000000000000000aa","bps":"seven"}]`)
+	processed.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.NotDuplicate, nil)
+
+	err := svc.HandleCollectionUpdated(ctx, event)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Empty(t, repo.splits)
+}
@@ -0,0 +1,12 @@
+package test
+
+import (
+	"testing"
+
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/protocheck"
+)
+
+func TestProtoContract(t *testing.T) {
+	protocheck.Check(t, catalogpb.File_catalog_proto)
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	relatedContract = "0x6666666666666666666666666666666666666666"
+	hiddenContract  = "0x7777777777777777777777777777777777777777"
+	pendingContract = "0x8888888888888888888888888888888888888888"
+)
+
+// MockRecommendationRepository is a mock implementation of RecommendationRepository
+type MockRecommendationRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecommendationRepository) Refresh(ctx context.Context, weights domain.SimilarityWeights, perCollection int) (int, error) {
+	args := m.Called(ctx, weights, perCollection)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRecommendationRepository) ListRelated(ctx context.Context, collectionID string, limit int) ([]domain.RelatedCollection, error) {
+	args := m.Called(ctx, collectionID, limit)
+	return args.Get(0).([]domain.RelatedCollection), args.Error(1)
+}
+
+func TestListRelatedCollections_SkipsHiddenCollections(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCollectionsRepository)
+	moderation := new(MockModerationRepository)
+	recommendations := new(MockRecommendationRepository)
+	svc := slugService(repo, moderation)
+	svc.SetRecommendations(recommendations, domain.SimilarityWeights{})
+
+	collections := map[string]domain.Collection{
+		slugContract:    {ID: "collection-1", Slug: "space-apes", ChainID: "eip155-1", ContractAddress: slugContract},
+		relatedContract: {ID: "collection-2", Slug: "moon-cats", ChainID: "eip155-1", ContractAddress: relatedContract},
+		hiddenContract:  {ID: "collection-3", ChainID: "eip155-1", ContractAddress: hiddenContract},
+		// Pending finality
+		pendingContract: {ID: "collection-4", ChainID: "eip155-1", ContractAddress: pendingContract, RequiredConfirmations: 12},
+	}
+	repo.On("ResolveSlug", ctx, "space-apes").Return(domain.ChainID("eip155-1"), domain.Address(slugContract), nil)
+	for contract, c := range collections {
+		repo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(contract)).Return(c, nil)
+		flag := domain.ModerationFlag{}
+		var err error = sql.ErrNoRows
+		if contract == hiddenContract {
+			flag, err = domain.ModerationFlag{Status: domain.ModerationFlagged}, nil
+		}
+		moderation.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(contract), "").Return(flag, err)
+	}
+	recommendations.On("ListRelated", ctx, "collection-1", mock.Anything).Return([]domain.RelatedCollection{
+		{ChainID: "eip155-1", ContractAddress: hiddenContract, Score: 0.9},
+		{ChainID: "eip155-1", ContractAddress: pendingContract, Score: 0.8},
+		{ChainID: "eip155-1", ContractAddress: relatedContract, Score: 0.5, SharedHolders: 3},
+	}, nil)
+
+	related, err := svc.ListRelatedCollections(ctx, "space-apes", 5)
+
+	require.NoError(t, err)
+	require.Len(t, related, 1)
+	assert.Equal(t, "moon-cats", related[0].Slug)
+}
+
+func TestListRelatedCollections_Disabled(t *testing.T) {
+	svc := slugService(new(MockCollectionsRepository), new(MockModerationRepository))
+
+	_, err := svc.ListRelatedCollections(context.Background(), "space-apes", 5)
+
+	assert.ErrorIs(t, err, domain.ErrUnavailable)
+}
+
+func TestRefreshRecommendations_UsesDefaultWeights(t *testing.T) {
+	ctx := context.Background()
+	recommendations := new(MockRecommendationRepository)
+	svc := slugService(new(MockCollectionsRepository), new(MockModerationRepository))
+	svc.SetRecommendations(recommendations, domain.SimilarityWeights{})
+
+	recommendations.On("Refresh", ctx, domain.SimilarityWeights{Holders: 0.6, Category: 0.25, Tags: 0.15}, mock.Anything).Return(12, nil)
+
+	require.NoError(t, svc.RefreshRecommendations(ctx))
+	recommendations.AssertExpectations(t)
+}
+
+func TestSetCollectionClassification_NormalizesTags(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCollectionsRepository)
+	moderation := new(MockModerationRepository)
+	svc := slugService(repo, moderation)
+
+	repo.On("SetClassification", ctx, domain.ChainID("eip155-1"), domain.Address(slugContract), "pfp", []string{"pixel-art", "generative"}).Return(nil)
+	repo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(slugContract)).
+		Return(domain.Collection{ChainID: "eip155-1", ContractAddress: slugContract, Category: "pfp", Tags: []string{"pixel-art", "generative"}}, nil)
+	moderation.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(slugContract), "").Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	collection, err := svc.SetCollectionClassification(ctx, domain.SetCollectionClassificationInput{
+		ChainID:         "eip155:1",
+		ContractAddress: slugContract,
+		Category:        " PFP ",
+		Tags:            []string{"Pixel  Art", "generative", "pixel-art", ""},
+		ActorID:         "user-1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pfp", collection.Category)
+	repo.AssertExpectations(t)
+}
+
+func TestSetCollectionClassification_RejectsInvalidInput(t *testing.T) {
+	cases := map[string]domain.SetCollectionClassificationInput{
+		"category": {Category: "memes"},
+		"tag":      {Tags: []string{"no_underscores"}},
+		"too many": {Tags: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}},
+	}
+	for name, in := range cases {
+		t.Run(name, func(t *testing.T) {
+			repo := new(MockCollectionsRepository)
+			svc := slugService(repo, new(MockModerationRepository))
+			in.ChainID, in.ContractAddress, in.ActorID = "eip155-1", slugContract, "user-1"
+
+			_, err := svc.SetCollectionClassification(context.Background(), in)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+			repo.AssertNotCalled(t, "SetClassification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const renterAddr = "0x00000000000000000000000000000000000000cc"
+
+// memoryRentals keeps the latest event per token and record like the SQL repository does
+type memoryRentals struct {
+	rentals map[string]domain.Rental
+}
+
+func rentalKey(chainID, contract, tokenID, recordID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", chainID, contract, tokenID, recordID)
+}
+
+func (m *memoryRentals) Apply(ctx context.Context, r domain.Rental) (*domain.Rental, bool, error) {
+	key := rentalKey(r.ChainID, r.ContractAddress, r.TokenID, r.RecordID)
+	current, ok := m.rentals[key]
+	if ok && (current.BlockNumber > r.BlockNumber || (current.BlockNumber == r.BlockNumber && current.LogIndex >= r.LogIndex)) {
+		return nil, false, nil
+	}
+	m.rentals[key] = r
+	if !ok {
+		return nil, true, nil
+	}
+	return &current, true, nil
+}
+
+func (m *memoryRentals) Get(ctx context.Context, chainID, contract, tokenID, recordID string) (domain.Rental, error) {
+	r, ok := m.rentals[rentalKey(chainID, contract, tokenID, recordID)]
+	if !ok {
+		return domain.Rental{}, domain.ErrNotFound
+	}
+	return r, nil
+}
+
+func (m *memoryRentals) GetRecord(ctx context.Context, chainID, contract, recordID string) (domain.Rental, error) {
+	for _, r := range m.rentals {
+		if r.ChainID == chainID && r.ContractAddress == contract && r.RecordID == recordID && recordID != "" {
+			return r, nil
+		}
+	}
+	return domain.Rental{}, domain.ErrNotFound
+}
+
+func (m *memoryRentals) ListActive(ctx context.Context, tokens []domain.TokenRef, now time.Time) ([]domain.Rental, error) {
+	var out []domain.Rental
+	for _, t := range tokens {
+		for _, r := range m.rentals {
+			if r.ChainID == t.ChainID && r.ContractAddress == t.ContractAddress && r.TokenID == t.TokenID && r.Active(now) {
+				out = append(out, r)
+			}
+		}
+	}
+	return out, nil
+}
+
+type rentalHarness struct {
+	svc       *service.CatalogService
+	rentals   *memoryRentals
+	scheduler *MockSchedulerRepository
+	published []*domain.DomainEvent
+	scheduled []domain.ScheduledJob
+	cancelled []string
+}
+
+func newRentalHarness() *rentalHarness {
+	h := &rentalHarness{rentals: &memoryRentals{rentals: map[string]domain.Rental{}}, scheduler: new(MockSchedulerRepository)}
+	publisher := new(MockMessagePublisher)
+	publisher.On("PublishDomainEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		h.published = append(h.published, args.Get(1).(*domain.DomainEvent))
+	}).Return(nil)
+	h.scheduler.On("Schedule", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		h.scheduled = append(h.scheduled, args.Get(1).(domain.ScheduledJob))
+	}).Return(nil)
+	h.scheduler.On("Cancel", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		h.cancelled = append(h.cancelled, args.String(1))
+	}).Return(nil)
+
+	h.svc = newSchedulerService(h.scheduler, publisher)
+	h.svc.SetRentals(h.rentals)
+	return h
+}
+
+func (h *rentalHarness) event(t *testing.T, eventType string, block int, args map[string]interface{}) {
+	evt := transferEvent(eventType, args)
+	evt.EventID = fmt.Sprintf("%s-%d", eventType, block)
+	evt.Data["block_number"] = fmt.Sprint(block)
+	evt.Data["log_index"] = float64(0)
+	require.NoError(t, h.svc.HandleDecodedEvent(context.Background(), evt))
+}
+
+func (h *rentalHarness) eventTypes() []string {
+	types := make([]string, len(h.published))
+	for i, e := range h.published {
+		types[i] = e.EventType
+	}
+	return types
+}
+
+func TestCatalogService_Rentals_ERC4907Lifecycle(t *testing.T) {
+	h := newRentalHarness()
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	h.event(t, "update_user", 100, map[string]interface{}{"tokenId": "7", "user": renterAddr, "expires": fmt.Sprint(expires.Unix())})
+
+	require.Equal(t, []string{domain.EventRentalStarted}, h.eventTypes())
+	assert.Equal(t, renterAddr, h.published[0].Data["renter"])
+	require.Len(t, h.scheduled, 1)
+	assert.Equal(t, domain.JobRentalExpired, h.scheduled[0].Kind)
+	assert.True(t, h.scheduled[0].FireAt.Equal(expires))
+
+	// A redelivery is ignored; a renewal by the same renter only moves the expiry
+	h.event(t, "update_user", 100, map[string]interface{}{"tokenId": "7", "user": renterAddr, "expires": fmt.Sprint(expires.Unix())})
+	renewed := expires.Add(time.Hour)
+	h.event(t, "update_user", 110, map[string]interface{}{"tokenId": "7", "user": renterAddr, "expires": fmt.Sprint(renewed.Unix())})
+	assert.Len(t, h.published, 1)
+	require.Len(t, h.scheduled, 2)
+	assert.Equal(t, h.scheduled[0].ID, h.scheduled[1].ID)
+
+	// An older event arriving late changes nothing
+	h.event(t, "update_user", 105, map[string]interface{}{"tokenId": "7", "user": zeroAddr, "expires": "0"})
+	assert.Len(t, h.published, 1)
+
+	// Clearing the user ends the rental early
+	h.event(t, "update_user", 120, map[string]interface{}{"tokenId": "7", "user": zeroAddr, "expires": "0"})
+	require.Equal(t, []string{domain.EventRentalStarted, domain.JobRentalExpired}, h.eventTypes())
+	assert.Equal(t, renterAddr, h.published[1].Data["renter"])
+	assert.Equal(t, []string{h.scheduled[0].ID}, h.cancelled)
+}
+
+func TestCatalogService_Rentals_ERC5006Records(t *testing.T) {
+	h := newRentalHarness()
+	expiry := fmt.Sprint(time.Now().Add(time.Hour).Unix())
+
+	h.event(t, "create_user_record", 100, map[string]interface{}{
+		"recordId": "1", "tokenId": "7", "amount": "3", "owner": holderAddr, "user": renterAddr, "expiry": expiry,
+	})
+	h.event(t, "create_user_record", 101, map[string]interface{}{
+		"recordId": "2", "tokenId": "7", "amount": "2", "owner": holderAddr, "user": otherHolder, "expiry": expiry,
+	})
+	require.Equal(t, []string{domain.EventRentalStarted, domain.EventRentalStarted}, h.eventTypes())
+	assert.Equal(t, "1", h.published[0].Data["record_id"])
+
+	active, err := h.rentals.ListActive(context.Background(), []domain.TokenRef{{ChainID: "eip155-1", ContractAddress: editionContract, TokenID: "7"}}, time.Now())
+	require.NoError(t, err)
+	assert.Len(t, active, 2)
+
+	// The delete event names only the record
+	h.event(t, "delete_user_record", 110, map[string]interface{}{"recordId": "1"})
+	require.Len(t, h.published, 3)
+	assert.Equal(t, domain.JobRentalExpired, h.published[2].EventType)
+	assert.Equal(t, "1", h.published[2].Data["record_id"])
+	assert.Equal(t, "3", h.published[2].Data["amount"])
+
+	// Deleting a record created before indexing started is skipped
+	h.event(t, "delete_user_record", 111, map[string]interface{}{"recordId": "9"})
+	assert.Len(t, h.published, 3)
+}
+
+func TestCatalogService_FireDueAlerts_RentalExpiry(t *testing.T) {
+	h := newRentalHarness()
+	ctx := context.Background()
+	expires := time.Now().Add(time.Minute).Truncate(time.Second)
+
+	h.event(t, "update_user", 100, map[string]interface{}{"tokenId": "7", "user": renterAddr, "expires": fmt.Sprint(expires.Unix())})
+	job := h.scheduled[0]
+
+	// A job left from a term since renewed is dropped
+	stale := job
+	stale.EndsAt = expires.Add(-time.Hour)
+	h.scheduler.On("ClaimDue", ctx, expires, mock.Anything).Return([]domain.ScheduledJob{job, stale}, nil)
+
+	fired, err := h.svc.FireDueAlerts(ctx, expires)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fired)
+	require.Equal(t, []string{domain.EventRentalStarted, domain.JobRentalExpired}, h.eventTypes())
+	assert.Equal(t, "7", h.published[1].Data["token_id"])
+	assert.Equal(t, big.NewInt(1).String(), h.published[1].Data["amount"])
+}
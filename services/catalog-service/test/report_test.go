@@ -0,0 +1,133 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const reportedTarget = "eip155-1/" + flaggedContract
+
+func TestCatalogService_ReportContent_SetsReportedBadge(t *testing.T) {
+	mockModerationRepo := new(MockModerationRepository)
+	mockReportsRepo := new(MockReportsRepository)
+	mockPublisher := new(MockMessagePublisher)
+
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), mockModerationRepo, mockReportsRepo, new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+
+	ctx := context.Background()
+	mockReportsRepo.On("CountByReporterSince", ctx, "user-1", mock.Anything).Return(0, nil)
+	mockReportsRepo.On("Create", ctx, mock.AnythingOfType("domain.Report")).
+		Return(domain.Report{ID: "report-1", TargetType: domain.ReportTargetCollection, TargetID: reportedTarget, Status: domain.ReportOpen}, true, nil)
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract), "").
+		Return(domain.ModerationFlag{}, nil)
+	mockModerationRepo.On("Upsert", ctx, mock.MatchedBy(func(f domain.ModerationFlag) bool {
+		return f.Status == domain.ModerationReported && f.Source == domain.ModerationSourceReport
+	})).Return(domain.ModerationFlag{ID: "flag-1", Status: domain.ModerationReported}, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.AnythingOfType("*domain.DomainEvent")).Return(nil)
+
+	report, duplicate, err := svc.ReportContent(ctx, domain.ReportContentInput{
+		TargetType: domain.ReportTargetCollection,
+		TargetID:   reportedTarget,
+		ReporterID: "user-1",
+		Reason:     "stolen",
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, duplicate)
+	assert.Equal(t, "report-1", report.ID)
+	mockModerationRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestCatalogService_ReportContent_DuplicateCollapses(t *testing.T) {
+	mockModerationRepo := new(MockModerationRepository)
+	mockReportsRepo := new(MockReportsRepository)
+
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), mockModerationRepo, mockReportsRepo, new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockReportsRepo.On("CountByReporterSince", ctx, "user-1", mock.Anything).Return(1, nil)
+	mockReportsRepo.On("Create", ctx, mock.AnythingOfType("domain.Report")).
+		Return(domain.Report{ID: "report-1", Status: domain.ReportOpen}, false, nil)
+
+	report, duplicate, err := svc.ReportContent(ctx, domain.ReportContentInput{
+		TargetType: domain.ReportTargetCollection,
+		TargetID:   reportedTarget,
+		ReporterID: "user-1",
+		Reason:     "stolen",
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, duplicate)
+	assert.Equal(t, "report-1", report.ID)
+	mockModerationRepo.AssertNotCalled(t, "Upsert")
+}
+
+func TestCatalogService_ReportContent_RateLimited(t *testing.T) {
+	mockReportsRepo := new(MockReportsRepository)
+
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), mockReportsRepo, new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+	svc.SetReportRateLimit(3, 0)
+
+	ctx := context.Background()
+	mockReportsRepo.On("CountByReporterSince", ctx, "user-1", mock.Anything).Return(3, nil)
+
+	_, _, err := svc.ReportContent(ctx, domain.ReportContentInput{
+		TargetType: domain.ReportTargetToken,
+		TargetID:   reportedTarget + "/7",
+		ReporterID: "user-1",
+		Reason:     "infringing",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrRateLimited)
+	mockReportsRepo.AssertNotCalled(t, "Create")
+}
+
+func TestCatalogService_ReportContent_InvalidTarget(t *testing.T) {
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	_, _, err := svc.ReportContent(context.Background(), domain.ReportContentInput{
+		TargetType: domain.ReportTargetToken,
+		TargetID:   reportedTarget, // missing token id
+		ReporterID: "user-1",
+		Reason:     "stolen",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestCatalogService_ResolveReports_UpholdFlagsItem(t *testing.T) {
+	mockModerationRepo := new(MockModerationRepository)
+	mockReportsRepo := new(MockReportsRepository)
+	mockPublisher := new(MockMessagePublisher)
+
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), mockModerationRepo, mockReportsRepo, new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+
+	ctx := context.Background()
+	mockReportsRepo.On("ResolveOpen", ctx, domain.ReportTargetCollection, reportedTarget, domain.ReportUpheld, "admin-1", "confirmed").
+		Return([]domain.Report{{ID: "report-1", Reason: "stolen"}, {ID: "report-2", Reason: "stolen"}}, nil)
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(flaggedContract), "").
+		Return(domain.ModerationFlag{ID: "flag-1", Status: domain.ModerationReported}, nil)
+	mockModerationRepo.On("Upsert", ctx, mock.MatchedBy(func(f domain.ModerationFlag) bool {
+		return f.ID == "flag-1" && f.Status == domain.ModerationFlagged && f.Reason == "stolen"
+	})).Return(domain.ModerationFlag{ID: "flag-1", Status: domain.ModerationFlagged}, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.AnythingOfType("*domain.DomainEvent")).Return(nil)
+
+	resolved, flag, err := svc.ResolveReports(ctx, domain.ResolveReportsInput{
+		TargetType: domain.ReportTargetCollection,
+		TargetID:   reportedTarget,
+		Action:     domain.ReportActionUphold,
+		Note:       "confirmed",
+		ActorID:    "admin-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resolved)
+	assert.Equal(t, domain.ModerationFlagged, flag.Status)
+	mockModerationRepo.AssertExpectations(t)
+}
@@ -0,0 +1,212 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const resyncContract = "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"
+
+// memoryResync serves a fixed sample and keeps the last repair
+type memoryResync struct {
+	contractURI string
+	holdings    []domain.TokenHoldings
+	repairs     []domain.CollectionRepair
+}
+
+func (m *memoryResync) GetContractURI(ctx context.Context, chainID, contract string) (string, error) {
+	return m.contractURI, nil
+}
+
+func (m *memoryResync) SampleHoldings(ctx context.Context, chainID, contract string, limit int) ([]domain.TokenHoldings, error) {
+	if len(m.holdings) > limit {
+		return m.holdings[:limit], nil
+	}
+	return m.holdings, nil
+}
+
+func (m *memoryResync) ApplyRepair(ctx context.Context, repair domain.CollectionRepair) error {
+	m.repairs = append(m.repairs, repair)
+	return nil
+}
+
+// fakeChain answers view calls from fixed state
+type fakeChain struct {
+	supply   *big.Int
+	uri      string
+	owners   map[string]string
+	balances map[string]*big.Int // holder + "/" + token id
+}
+
+func (c *fakeChain) BlockNumber(ctx context.Context, chainID string) (uint64, error) {
+	return 500, nil
+}
+
+func (c *fakeChain) TotalSupply(ctx context.Context, chainID, contract string, block uint64) (*big.Int, bool, error) {
+	return c.supply, c.supply != nil, nil
+}
+
+func (c *fakeChain) ContractURI(ctx context.Context, chainID, contract string, block uint64) (string, error) {
+	return c.uri, nil
+}
+
+func (c *fakeChain) OwnerOf(ctx context.Context, chainID, contract, tokenID string, block uint64) (string, error) {
+	return c.owners[tokenID], nil
+}
+
+func (c *fakeChain) BalanceOf(ctx context.Context, chainID, contract, owner, tokenID string, block uint64) (*big.Int, error) {
+	if b, ok := c.balances[owner+"/"+tokenID]; ok {
+		return b, nil
+	}
+	return new(big.Int), nil
+}
+
+func newResyncService(collection domain.Collection, repo *memoryResync, chain *fakeChain) *service.CatalogService {
+	collectionRepo := new(MockCollectionsRepository)
+	moderationRepo := new(MockModerationRepository)
+	collectionRepo.On("GetByPK", context.Background(), domain.ChainID("eip155-1"), domain.Address(resyncContract)).Return(collection, nil)
+	moderationRepo.On("Get", context.Background(), domain.ChainID("eip155-1"), domain.Address(resyncContract), "").Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	svc := newSupplyService(collectionRepo, moderationRepo, new(MockTokenSupplyRepository), new(MockMessagePublisher))
+	svc.SetResync(repo, chain)
+	return svc
+}
+
+func holding(tokenID string, holders map[string]int64) domain.TokenHoldings {
+	h := domain.TokenHoldings{TokenID: tokenID, Holders: map[string]*big.Int{}}
+	for holder, amount := range holders {
+		h.Holders[holder] = big.NewInt(amount)
+	}
+	return h
+}
+
+func TestCatalogService_ResyncCollection_ReportsERC721Drift(t *testing.T) {
+	repo := &memoryResync{
+		contractURI: "ipfs://contract",
+		holdings: []domain.TokenHoldings{
+			holding("1", map[string]int64{holderAddr: 1}),
+			holding("2", map[string]int64{holderAddr: 1}),
+			holding("3", nil),
+			holding("4", nil),
+		},
+	}
+	chain := &fakeChain{
+		supply: big.NewInt(3),
+		uri:    "ipfs://contract",
+		owners: map[string]string{"1": holderAddr, "2": otherHolder, "3": holderAddr},
+	}
+	svc := newResyncService(domain.Collection{
+		ChainID: "eip155-1", ContractAddress: resyncContract, CollectionType: "ERC721", TotalSupply: big.NewInt(2),
+	}, repo, chain)
+
+	report, err := svc.ResyncCollection(context.Background(), domain.ResyncCollectionInput{
+		ChainID: "eip155-1", Contract: resyncContract, RequestedBy: "admin-1",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(500), report.BlockNumber)
+	assert.Equal(t, 4, report.TokensSampled)
+	assert.Equal(t, []domain.Drift{
+		{Kind: domain.DriftTotalSupply, Catalog: "2", Chain: "3"},
+		{Kind: domain.DriftOwner, TokenID: "2", Catalog: holderAddr, Chain: otherHolder},
+		{Kind: domain.DriftOwner, TokenID: "3", Catalog: "", Chain: holderAddr},
+	}, report.Drifts, "burned on both sides is not drift")
+	assert.False(t, report.Repaired)
+	assert.Empty(t, repo.repairs, "reports without repair are read-only")
+}
+
+func TestCatalogService_ResyncCollection_RepairsOwnershipIndex(t *testing.T) {
+	repo := &memoryResync{
+		holdings: []domain.TokenHoldings{
+			holding("2", map[string]int64{holderAddr: 1}),
+			holding("3", nil),
+		},
+	}
+	chain := &fakeChain{
+		supply: big.NewInt(2),
+		uri:    "ipfs://contract",
+		owners: map[string]string{"2": otherHolder, "3": holderAddr},
+	}
+	svc := newResyncService(domain.Collection{
+		ChainID: "eip155-1", ContractAddress: resyncContract, CollectionType: "ERC721", TotalSupply: big.NewInt(2),
+	}, repo, chain)
+
+	report, err := svc.ResyncCollection(context.Background(), domain.ResyncCollectionInput{
+		ChainID: "eip155-1", Contract: resyncContract, Repair: true, RequestedBy: "admin-1",
+	})
+	require.NoError(t, err)
+	require.True(t, report.Repaired)
+	require.Len(t, repo.repairs, 1)
+
+	repair := repo.repairs[0]
+	assert.Nil(t, repair.TotalSupply)
+	require.NotNil(t, repair.ContractURI)
+	assert.Equal(t, "ipfs://contract", *repair.ContractURI)
+
+	require.Len(t, repair.Transfers, 3)
+	moves := make([][3]string, len(repair.Transfers))
+	for i, tr := range repair.Transfers {
+		moves[i] = [3]string{tr.TokenID, tr.From, tr.To}
+		assert.Equal(t, "1", tr.Quantity.String())
+		assert.Equal(t, "resync:"+report.ID, tr.TxHash)
+		assert.Equal(t, i, tr.LogIndex, "corrective transfers share the resync tx")
+		assert.Equal(t, uint64(500), tr.BlockNumber)
+	}
+	assert.Equal(t, [][3]string{
+		{"2", holderAddr, zeroAddr},
+		{"2", zeroAddr, otherHolder},
+		{"3", zeroAddr, holderAddr},
+	}, moves)
+}
+
+func TestCatalogService_ResyncCollection_ChecksERC1155Balances(t *testing.T) {
+	repo := &memoryResync{
+		contractURI: "ipfs://contract",
+		holdings:    []domain.TokenHoldings{holding("7", map[string]int64{holderAddr: 5, otherHolder: 2})},
+	}
+	chain := &fakeChain{
+		supply:   big.NewInt(99),
+		uri:      "ipfs://contract",
+		balances: map[string]*big.Int{holderAddr + "/7": big.NewInt(5), otherHolder + "/7": big.NewInt(0)},
+	}
+	svc := newResyncService(domain.Collection{
+		ChainID: "eip155-1", ContractAddress: resyncContract, CollectionType: "ERC1155",
+	}, repo, chain)
+
+	report, err := svc.ResyncCollection(context.Background(), domain.ResyncCollectionInput{
+		ChainID: "eip155-1", Contract: resyncContract, Repair: true, RequestedBy: "admin-1",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.Drift{
+		{Kind: domain.DriftBalance, TokenID: "7", Holder: otherHolder, Catalog: "2", Chain: "0"},
+	}, report.Drifts, "total supply is only compared for ERC-721")
+	require.Len(t, repo.repairs, 1)
+	require.Len(t, repo.repairs[0].Transfers, 1)
+	tr := repo.repairs[0].Transfers[0]
+	assert.Equal(t, otherHolder, tr.From)
+	assert.Equal(t, zeroAddr, tr.To)
+	assert.Equal(t, "2", tr.Quantity.String())
+}
+
+func TestCatalogService_ResyncCollection_Refusals(t *testing.T) {
+	ctx := context.Background()
+
+	disabled := newSupplyService(new(MockCollectionsRepository), new(MockModerationRepository), new(MockTokenSupplyRepository), new(MockMessagePublisher))
+	_, err := disabled.ResyncCollection(ctx, domain.ResyncCollectionInput{ChainID: "eip155-1", Contract: resyncContract, RequestedBy: "admin-1"})
+	assert.True(t, errs.Is(err, errs.Unavailable))
+
+	svc := newResyncService(domain.Collection{ChainID: "eip155-1", ContractAddress: resyncContract}, &memoryResync{}, &fakeChain{})
+	_, err = svc.ResyncCollection(ctx, domain.ResyncCollectionInput{
+		ChainID: "eip155-1", Contract: resyncContract, SampleSize: service.MaxResyncSample + 1, RequestedBy: "admin-1",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
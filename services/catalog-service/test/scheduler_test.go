@@ -0,0 +1,160 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const marketContract = "0x1111111111111111111111111111111111111111"
+
+func marketEvent(eventType string, data map[string]interface{}) *domain.CollectionEvent {
+	return &domain.CollectionEvent{
+		EventID:   eventType + "-1",
+		EventType: eventType,
+		ChainID:   "eip155:1",
+		Contract:  marketContract,
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+}
+
+func newSchedulerService(schedulerRepo *MockSchedulerRepository, publisher *MockMessagePublisher) *service.CatalogService {
+	return newAuctionService(schedulerRepo, new(MockAuctionRepository), publisher)
+}
+
+func newAuctionService(schedulerRepo *MockSchedulerRepository, auctionRepo *MockAuctionRepository, publisher *MockMessagePublisher) *service.CatalogService {
+	return service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), schedulerRepo, auctionRepo, new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), publisher)
+}
+
+func TestCatalogService_HandleMarketEvent_SchedulesOfferExpiry(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	svc := newSchedulerService(mockSchedulerRepo, new(MockMessagePublisher))
+	svc.SetExpiryLead(30 * time.Minute)
+
+	ctx := context.Background()
+	expiresAt := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	mockSchedulerRepo.On("Schedule", ctx, mock.MatchedBy(func(job domain.ScheduledJob) bool {
+		return job.ID == "offer:eip155-1:42" &&
+			job.Kind == domain.JobOfferExpiringSoon &&
+			job.TokenID == "7" &&
+			job.EndsAt.Equal(expiresAt) &&
+			job.FireAt.Equal(expiresAt.Add(-30*time.Minute)) &&
+			assert.ObjectsAreEqual([]string{"0x00000000000000000000000000000000000000aa", "0x00000000000000000000000000000000000000bb"}, job.Recipients)
+	})).Return(nil)
+
+	err := svc.HandleMarketEvent(ctx, marketEvent("offer_created", map[string]interface{}{
+		"offer_id":   "42",
+		"token_id":   "7",
+		"maker":      "0x00000000000000000000000000000000000000AA",
+		"owner":      "0x00000000000000000000000000000000000000BB",
+		"expires_at": expiresAt.Format(time.RFC3339),
+	}))
+
+	assert.NoError(t, err)
+	mockSchedulerRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleMarketEvent_ExpiredListingCancelsAlert(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	svc := newSchedulerService(mockSchedulerRepo, new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockSchedulerRepo.On("Cancel", ctx, "listing:eip155-1:9").Return(nil)
+
+	err := svc.HandleMarketEvent(ctx, marketEvent("listing_created", map[string]interface{}{
+		"listing_id": "9",
+		"expires_at": float64(time.Now().Add(-time.Minute).Unix()),
+	}))
+
+	assert.NoError(t, err)
+	mockSchedulerRepo.AssertExpectations(t)
+	mockSchedulerRepo.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_HandleMarketEvent_AuctionBidExtendsEndAndAddsBidder(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	mockAuctionRepo := new(MockAuctionRepository)
+	svc := newAuctionService(mockSchedulerRepo, mockAuctionRepo, new(MockMessagePublisher))
+
+	ctx := context.Background()
+	oldEnd := time.Now().Add(time.Hour).Truncate(time.Second)
+	newEnd := oldEnd.Add(10 * time.Minute)
+	mockSchedulerRepo.On("Get", ctx, "auction:eip155-1:3").Return(domain.ScheduledJob{
+		ID:         "auction:eip155-1:3",
+		Kind:       domain.JobAuctionEnded,
+		ChainID:    "eip155-1",
+		SubjectID:  "3",
+		Recipients: []string{"0x00000000000000000000000000000000000000aa"},
+		EndsAt:     oldEnd,
+		FireAt:     oldEnd,
+	}, nil)
+	// A redelivered bid keeps the schedule in sync without publishing again
+	mockAuctionRepo.On("RecordBid", ctx, mock.AnythingOfType("domain.AuctionBid")).Return(domain.Auction{}, false, nil)
+	mockSchedulerRepo.On("Schedule", ctx, mock.MatchedBy(func(job domain.ScheduledJob) bool {
+		return job.FireAt.Equal(newEnd) &&
+			assert.ObjectsAreEqual([]string{"0x00000000000000000000000000000000000000aa", "0x00000000000000000000000000000000000000cc"}, job.Recipients)
+	})).Return(nil)
+
+	err := svc.HandleMarketEvent(ctx, marketEvent("auction_bid", map[string]interface{}{
+		"auction_id": "3",
+		"bidder":     "0x00000000000000000000000000000000000000CC",
+		"amount":     "1000",
+		"end_time":   float64(newEnd.Unix()),
+	}))
+
+	assert.NoError(t, err)
+	mockSchedulerRepo.AssertExpectations(t)
+	mockAuctionRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleMarketEvent_SettledAuctionCancelsAlert(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	mockAuctionRepo := new(MockAuctionRepository)
+	svc := newAuctionService(mockSchedulerRepo, mockAuctionRepo, new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockAuctionRepo.On("Close", ctx, "eip155-1", "3", domain.AuctionSettled, "").Return(nil)
+	mockSchedulerRepo.On("Cancel", ctx, "auction:eip155-1:3").Return(nil)
+
+	err := svc.HandleMarketEvent(ctx, marketEvent("auction_settled", map[string]interface{}{"auction_id": "3"}))
+
+	assert.NoError(t, err)
+	mockSchedulerRepo.AssertExpectations(t)
+	mockAuctionRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_FireDueAlerts_PublishesAndRetriesFailures(t *testing.T) {
+	mockSchedulerRepo := new(MockSchedulerRepository)
+	mockPublisher := new(MockMessagePublisher)
+	svc := newSchedulerService(mockSchedulerRepo, mockPublisher)
+
+	ctx := context.Background()
+	now := time.Now()
+	ended := domain.ScheduledJob{ID: "auction:eip155-1:3", Kind: domain.JobAuctionEnded, ChainID: "eip155-1", SubjectID: "3", EndsAt: now, FireAt: now}
+	expiring := domain.ScheduledJob{ID: "offer:eip155-1:42", Kind: domain.JobOfferExpiringSoon, ChainID: "eip155-1", SubjectID: "42", EndsAt: now.Add(time.Hour), FireAt: now}
+	mockSchedulerRepo.On("ClaimDue", ctx, now, mock.Anything).Return([]domain.ScheduledJob{ended, expiring}, nil)
+
+	mockPublisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(e *domain.DomainEvent) bool {
+		return e.EventType == domain.JobAuctionEnded
+	})).Return(nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(e *domain.DomainEvent) bool {
+		return e.EventType == domain.JobOfferExpiringSoon
+	})).Return(errors.New("broker down"))
+	mockSchedulerRepo.On("Schedule", ctx, mock.MatchedBy(func(job domain.ScheduledJob) bool {
+		return job.ID == expiring.ID && job.FireAt.After(now)
+	})).Return(nil)
+
+	fired, err := svc.FireDueAlerts(ctx, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fired)
+	mockSchedulerRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
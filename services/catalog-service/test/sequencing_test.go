@@ -0,0 +1,235 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/httpapi"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// memorySequences keeps applied sequences and parked events in memory
+type memorySequences struct {
+	applied map[string]uint64
+	parked  map[string][]*domain.ParkedEvent
+}
+
+func newMemorySequences() *memorySequences {
+	return &memorySequences{applied: map[string]uint64{}, parked: map[string][]*domain.ParkedEvent{}}
+}
+
+func (m *memorySequences) LastApplied(ctx context.Context, aggregateID string) (uint64, error) {
+	return m.applied[aggregateID], nil
+}
+
+func (m *memorySequences) Advance(ctx context.Context, aggregateID string, sequence uint64) error {
+	m.applied[aggregateID] = max(m.applied[aggregateID], sequence)
+	return nil
+}
+
+func (m *memorySequences) Park(ctx context.Context, event *domain.ParkedEvent) error {
+	for _, p := range m.parked[event.AggregateID] {
+		if p.Sequence == event.Sequence {
+			return nil
+		}
+	}
+	m.parked[event.AggregateID] = append(m.parked[event.AggregateID], event)
+	sort.Slice(m.parked[event.AggregateID], func(i, j int) bool {
+		return m.parked[event.AggregateID][i].Sequence < m.parked[event.AggregateID][j].Sequence
+	})
+	return nil
+}
+
+func (m *memorySequences) NextParked(ctx context.Context, aggregateID string) (*domain.ParkedEvent, error) {
+	if len(m.parked[aggregateID]) == 0 {
+		return nil, nil
+	}
+	return m.parked[aggregateID][0], nil
+}
+
+func (m *memorySequences) Unpark(ctx context.Context, aggregateID string, sequence uint64) error {
+	kept := m.parked[aggregateID][:0]
+	for _, p := range m.parked[aggregateID] {
+		if p.Sequence != sequence {
+			kept = append(kept, p)
+		}
+	}
+	m.parked[aggregateID] = kept
+	return nil
+}
+
+func (m *memorySequences) StaleAggregates(ctx context.Context, cutoff time.Time, limit int) ([]string, error) {
+	var out []string
+	for aggregateID, parked := range m.parked {
+		for _, p := range parked {
+			if p.ParkedAt.Before(cutoff) {
+				out = append(out, aggregateID)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (m *memorySequences) CountParked(ctx context.Context) (int, error) {
+	n := 0
+	for _, parked := range m.parked {
+		n += len(parked)
+	}
+	return n, nil
+}
+
+var tokenAggregate = contracts.TokenAggregate("eip155-1", "0x1234567890123456789012345678901234567890", "7")
+
+func sequencedEvent(eventType string, sequence uint64) *domain.CollectionEvent {
+	return &domain.CollectionEvent{
+		EventID:     fmt.Sprintf("%s-%d", eventType, sequence),
+		EventType:   eventType,
+		ChainID:     "eip155-1",
+		AggregateID: tokenAggregate,
+		Sequence:    sequence,
+	}
+}
+
+func newSequencedService(repo domain.EventSequenceRepository) *service.CatalogService {
+	svc := service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+	svc.SetEventSequencing(repo, 5*time.Minute)
+	return svc
+}
+
+// recorder is a handler that records the sequences it applied
+type recorder struct {
+	applied []uint64
+	fail    map[uint64]error
+}
+
+func (r *recorder) handle(ctx context.Context, evt *domain.CollectionEvent) error {
+	if err := r.fail[evt.Sequence]; err != nil {
+		return err
+	}
+	r.applied = append(r.applied, evt.Sequence)
+	return nil
+}
+
+func TestSequenced_ParksEventsAheadOfAGap(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemorySequences()
+	svc := newSequencedService(repo)
+	transfers, sales := &recorder{}, &recorder{}
+	onTransfer := svc.Sequenced("collection_decoded", transfers.handle)
+	onSale := svc.Sequenced("sale_indexed", sales.handle)
+
+	// The sale and second transfer overtake the mint
+	require.NoError(t, onSale(ctx, sequencedEvent("sale", 2)))
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 3)))
+	assert.Empty(t, transfers.applied)
+	assert.Empty(t, sales.applied)
+
+	// The mint fills the gap and the parked events follow it in order
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 1)))
+	assert.Equal(t, []uint64{1, 3}, transfers.applied)
+	assert.Equal(t, []uint64{2}, sales.applied)
+	assert.Equal(t, uint64(3), repo.applied[tokenAggregate])
+	assert.Empty(t, repo.parked[tokenAggregate])
+
+	stats, err := svc.SequenceStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SequenceStats{Parked: 2}, stats)
+}
+
+func TestSequenced_DropsEventsAtOrBelowTheLastApplied(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemorySequences()
+	repo.applied[tokenAggregate] = 4
+	svc := newSequencedService(repo)
+	transfers := &recorder{}
+	onTransfer := svc.Sequenced("collection_decoded", transfers.handle)
+
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 4)))
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 2)))
+	assert.Empty(t, transfers.applied)
+	assert.Equal(t, []domain.DedupCount{
+		{EventType: "transfer", Reason: domain.DuplicateSequence, Count: 2},
+	}, svc.DedupCounts())
+}
+
+func TestSequenced_AppliesUnsequencedEventsAsTheyArrive(t *testing.T) {
+	ctx := context.Background()
+	svc := newSequencedService(newMemorySequences())
+	transfers := &recorder{}
+	onTransfer := svc.Sequenced("collection_decoded", transfers.handle)
+
+	require.NoError(t, onTransfer(ctx, &domain.CollectionEvent{EventID: "legacy", EventType: "transfer"}))
+	assert.Equal(t, []uint64{0}, transfers.applied)
+}
+
+func TestSequenced_FailedEventIsRedeliveredWithoutAdvancing(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemorySequences()
+	svc := newSequencedService(repo)
+	transfers := &recorder{fail: map[uint64]error{1: errors.New("database unavailable")}}
+	onTransfer := svc.Sequenced("collection_decoded", transfers.handle)
+
+	assert.Error(t, onTransfer(ctx, sequencedEvent("transfer", 1)))
+	assert.Zero(t, repo.applied[tokenAggregate])
+
+	delete(transfers.fail, 1)
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 1)))
+	assert.Equal(t, uint64(1), repo.applied[tokenAggregate])
+}
+
+func TestReleaseParkedEvents_SkipsGapAfterTimeout(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemorySequences()
+	svc := newSequencedService(repo)
+	transfers := &recorder{}
+	onTransfer := svc.Sequenced("collection_decoded", transfers.handle)
+
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 1)))
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 3)))
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 4)))
+
+	// Still within the park timeout, the gap may yet fill
+	released, err := svc.ReleaseParkedEvents(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, released)
+	assert.Equal(t, []uint64{1}, transfers.applied)
+
+	released, err = svc.ReleaseParkedEvents(ctx, time.Now().Add(6*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, released)
+	assert.Equal(t, []uint64{1, 3, 4}, transfers.applied)
+	assert.Equal(t, uint64(4), repo.applied[tokenAggregate])
+
+	// The missing event turning up late is dropped
+	require.NoError(t, onTransfer(ctx, sequencedEvent("transfer", 2)))
+	assert.Equal(t, []uint64{1, 3, 4}, transfers.applied)
+
+	stats, err := svc.SequenceStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SequenceStats{Parked: 2, GapsSkipped: 1}, stats)
+}
+
+func TestMetricsEndpoint_ReportsParkedEvents(t *testing.T) {
+	catalog := stubCatalog{sequence: domain.SequenceStats{Parked: 12, GapsSkipped: 1, Waiting: 3}}
+
+	rec := httptest.NewRecorder()
+	httpapi.NewHandler(catalog).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, httpapi.MetricsRoute, nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, "catalog_events_parked_total 12")
+	assert.Contains(t, body, "catalog_event_gaps_skipped_total 1")
+	assert.Contains(t, body, "# TYPE catalog_events_parked gauge\ncatalog_events_parked 3")
+}
@@ -2,6 +2,8 @@ package test
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -26,15 +28,275 @@ func (m *MockCollectionsRepository) GetByPK(ctx context.Context, chainID domain.
 	return args.Get(0).(domain.Collection), args.Error(1)
 }
 
-type MockProcessedEventsRepository struct {
+func (m *MockCollectionsRepository) List(ctx context.Context, filter domain.CollectionFilter) ([]domain.Collection, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]domain.Collection), args.Error(1)
+}
+
+func (m *MockCollectionsRepository) ListTokens(ctx context.Context, filter domain.TokenFilter) ([]domain.Token, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]domain.Token), args.Error(1)
+}
+
+func (m *MockCollectionsRepository) SetOwnerOrg(ctx context.Context, chainID domain.ChainID, contract domain.Address, orgID string) error {
+	args := m.Called(ctx, chainID, contract, orgID)
+	return args.Error(0)
+}
+
+func (m *MockCollectionsRepository) SetClassification(ctx context.Context, chainID domain.ChainID, contract domain.Address, category string, tags []string) error {
+	args := m.Called(ctx, chainID, contract, category, tags)
+	return args.Error(0)
+}
+
+func (m *MockCollectionsRepository) SetConfirmations(ctx context.Context, chainID domain.ChainID, contract domain.Address, confirmations, required int) error {
+	args := m.Called(ctx, chainID, contract, confirmations, required)
+	return args.Error(0)
+}
+
+func (m *MockCollectionsRepository) LinkIntent(ctx context.Context, link domain.IntentLink) error {
+	args := m.Called(ctx, link)
+	return args.Error(0)
+}
+
+func (m *MockCollectionsRepository) SlugOwner(ctx context.Context, slug string) (string, error) {
+	args := m.Called(ctx, slug)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockCollectionsRepository) AddSlugRedirect(ctx context.Context, slug, collectionID string) error {
+	args := m.Called(ctx, slug, collectionID)
+	return args.Error(0)
+}
+
+func (m *MockCollectionsRepository) ResolveSlug(ctx context.Context, slug string) (domain.ChainID, domain.Address, error) {
+	args := m.Called(ctx, slug)
+	return args.Get(0).(domain.ChainID), args.Get(1).(domain.Address), args.Error(2)
+}
+
+func (m *MockCollectionsRepository) Suggest(ctx context.Context, query string, limit int) ([]domain.Suggestion, error) {
+	args := m.Called(ctx, query, limit)
+	suggestions, _ := args.Get(0).([]domain.Suggestion)
+	return suggestions, args.Error(1)
+}
+
+type MockModerationRepository struct {
+	mock.Mock
+}
+
+func (m *MockModerationRepository) Upsert(ctx context.Context, f domain.ModerationFlag) (domain.ModerationFlag, error) {
+	args := m.Called(ctx, f)
+	return args.Get(0).(domain.ModerationFlag), args.Error(1)
+}
+
+func (m *MockModerationRepository) Get(ctx context.Context, chainID domain.ChainID, contract domain.Address, tokenID string) (domain.ModerationFlag, error) {
+	args := m.Called(ctx, chainID, contract, tokenID)
+	return args.Get(0).(domain.ModerationFlag), args.Error(1)
+}
+
+type MockReportsRepository struct {
 	mock.Mock
 }
 
-func (m *MockProcessedEventsRepository) MarkProcessed(ctx context.Context, eventID string) (bool, error) {
-	args := m.Called(ctx, eventID)
+func (m *MockReportsRepository) Create(ctx context.Context, r domain.Report) (domain.Report, bool, error) {
+	args := m.Called(ctx, r)
+	return args.Get(0).(domain.Report), args.Bool(1), args.Error(2)
+}
+
+func (m *MockReportsRepository) CountByReporterSince(ctx context.Context, reporterID string, since time.Time) (int, error) {
+	args := m.Called(ctx, reporterID, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReportsRepository) ListOpenQueue(ctx context.Context, limit, offset int) ([]domain.ReportQueueItem, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]domain.ReportQueueItem), args.Error(1)
+}
+
+func (m *MockReportsRepository) ResolveOpen(ctx context.Context, targetType, targetID string, status domain.ReportStatus, actorID, note string) ([]domain.Report, error) {
+	args := m.Called(ctx, targetType, targetID, status, actorID, note)
+	return args.Get(0).([]domain.Report), args.Error(1)
+}
+
+type MockEarningsRepository struct {
+	mock.Mock
+}
+
+func (m *MockEarningsRepository) Record(ctx context.Context, e domain.RoyaltyEarning) (bool, error) {
+	args := m.Called(ctx, e)
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockEarningsRepository) TotalsByRecipients(ctx context.Context, recipients []string, since time.Time) ([]domain.EarningsTotal, error) {
+	args := m.Called(ctx, recipients, since)
+	return args.Get(0).([]domain.EarningsTotal), args.Error(1)
+}
+
+type MockSchedulerRepository struct {
+	mock.Mock
+}
+
+func (m *MockSchedulerRepository) Schedule(ctx context.Context, job domain.ScheduledJob) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *MockSchedulerRepository) Get(ctx context.Context, id string) (domain.ScheduledJob, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.ScheduledJob), args.Error(1)
+}
+
+func (m *MockSchedulerRepository) Cancel(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSchedulerRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledJob, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]domain.ScheduledJob), args.Error(1)
+}
+
+type MockAuctionRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuctionRepository) Create(ctx context.Context, a domain.Auction) error {
+	args := m.Called(ctx, a)
+	return args.Error(0)
+}
+
+func (m *MockAuctionRepository) RecordBid(ctx context.Context, bid domain.AuctionBid) (domain.Auction, bool, error) {
+	args := m.Called(ctx, bid)
+	return args.Get(0).(domain.Auction), args.Bool(1), args.Error(2)
+}
+
+func (m *MockAuctionRepository) Close(ctx context.Context, chainID, auctionID, status, winner string) error {
+	args := m.Called(ctx, chainID, auctionID, status, winner)
+	return args.Error(0)
+}
+
+func (m *MockAuctionRepository) Get(ctx context.Context, chainID, auctionID string) (domain.Auction, error) {
+	args := m.Called(ctx, chainID, auctionID)
+	return args.Get(0).(domain.Auction), args.Error(1)
+}
+
+type MockWatchlistRepository struct {
+	mock.Mock
+}
+
+func (m *MockWatchlistRepository) AddFavorite(ctx context.Context, item domain.WatchlistItem) (domain.WatchlistItem, error) {
+	args := m.Called(ctx, item)
+	return args.Get(0).(domain.WatchlistItem), args.Error(1)
+}
+
+func (m *MockWatchlistRepository) RemoveFavorite(ctx context.Context, userID, id string) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
+
+func (m *MockWatchlistRepository) ListFavorites(ctx context.Context, userID string) ([]domain.WatchlistItem, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]domain.WatchlistItem), args.Error(1)
+}
+
+func (m *MockWatchlistRepository) ListFloorAlerts(ctx context.Context, afterID string, limit int) ([]domain.WatchlistItem, error) {
+	args := m.Called(ctx, afterID, limit)
+	return args.Get(0).([]domain.WatchlistItem), args.Error(1)
+}
+
+func (m *MockWatchlistRepository) SetAlertFired(ctx context.Context, id string, firedAt *time.Time) error {
+	args := m.Called(ctx, id, firedAt)
+	return args.Error(0)
+}
+
+func (m *MockWatchlistRepository) SaveSearch(ctx context.Context, s domain.SavedSearch) (domain.SavedSearch, error) {
+	args := m.Called(ctx, s)
+	return args.Get(0).(domain.SavedSearch), args.Error(1)
+}
+
+func (m *MockWatchlistRepository) CountSavedSearches(ctx context.Context, userID string) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWatchlistRepository) DeleteSavedSearch(ctx context.Context, userID, id string) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
+
+func (m *MockWatchlistRepository) ListSavedSearches(ctx context.Context, userID string) ([]domain.SavedSearch, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]domain.SavedSearch), args.Error(1)
+}
+
+type MockTokenSupplyRepository struct {
+	mock.Mock
+}
+
+func (m *MockTokenSupplyRepository) ApplyTransfer(ctx context.Context, eventID, chainID, contract string, deltas []domain.SupplyDelta) ([]domain.TokenSupply, bool, error) {
+	args := m.Called(ctx, eventID, chainID, contract, deltas)
+	supplies, _ := args.Get(0).([]domain.TokenSupply)
+	return supplies, args.Bool(1), args.Error(2)
+}
+
+func (m *MockTokenSupplyRepository) Get(ctx context.Context, chainID, contract, tokenID string) (domain.TokenSupply, error) {
+	args := m.Called(ctx, chainID, contract, tokenID)
+	return args.Get(0).(domain.TokenSupply), args.Error(1)
+}
+
+type MockWalletActivityRepository struct {
+	mock.Mock
+}
+
+func (m *MockWalletActivityRepository) Record(ctx context.Context, activities []domain.WalletActivity) error {
+	args := m.Called(ctx, activities)
+	return args.Error(0)
+}
+
+func (m *MockWalletActivityRepository) ListByAddress(ctx context.Context, address string, before *domain.ActivityCursor, limit int) ([]domain.WalletActivity, error) {
+	args := m.Called(ctx, address, before, limit)
+	activities, _ := args.Get(0).([]domain.WalletActivity)
+	return activities, args.Error(1)
+}
+
+type MockActivityPartitionRepository struct {
+	mock.Mock
+}
+
+func (m *MockActivityPartitionRepository) ListPartitions(ctx context.Context) ([]domain.ActivityPartition, error) {
+	args := m.Called(ctx)
+	partitions, _ := args.Get(0).([]domain.ActivityPartition)
+	return partitions, args.Error(1)
+}
+
+func (m *MockActivityPartitionRepository) CreatePartition(ctx context.Context, from time.Time) error {
+	args := m.Called(ctx, from)
+	return args.Error(0)
+}
+
+func (m *MockActivityPartitionRepository) ArchivePartition(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockActivityPartitionRepository) DropPartition(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+type MockProcessedEventsRepository struct {
+	mock.Mock
+}
+
+func (m *MockProcessedEventsRepository) MarkProcessed(ctx context.Context, key domain.ProcessedEventKey) (domain.DuplicateReason, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(domain.DuplicateReason), args.Error(1)
+}
+
+// processedKey matches the dedup key of the event with eventID
+func processedKey(eventID string) interface{} {
+	return mock.MatchedBy(func(key domain.ProcessedEventKey) bool { return key.EventID == eventID })
+}
+
 type MockMessagePublisher struct {
 	mock.Mock
 }
@@ -55,7 +317,7 @@ func TestCatalogService_HandleCollectionCreated(t *testing.T) {
 	mockProcessedEventRepo := new(MockProcessedEventsRepository)
 	mockPublisher := new(MockMessagePublisher)
 
-	service := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, mockPublisher)
+	service := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
 
 	ctx := context.Background()
 	event := &domain.CollectionEvent{
@@ -77,8 +339,12 @@ func TestCatalogService_HandleCollectionCreated(t *testing.T) {
 	}
 
 	// Mock expectations
-	mockProcessedEventRepo.On("MarkProcessed", ctx, event.EventID).Return(true, nil)
-	mockCollectionRepo.On("Upsert", ctx, mock.AnythingOfType("domain.Collection")).Return(true, nil)
+	mockProcessedEventRepo.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.NotDuplicate, nil)
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(event.Contract)).Return(domain.Collection{}, sql.ErrNoRows)
+	mockCollectionRepo.On("SlugOwner", ctx, "test-collection").Return("", nil)
+	mockCollectionRepo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool {
+		return c.Slug == "test-collection"
+	})).Return(true, nil)
 	mockPublisher.On("PublishDomainEvent", ctx, mock.AnythingOfType("*domain.DomainEvent")).Return(nil)
 
 	// Act
@@ -97,7 +363,7 @@ func TestCatalogService_HandleCollectionCreated_AlreadyProcessed(t *testing.T) {
 	mockProcessedEventRepo := new(MockProcessedEventsRepository)
 	mockPublisher := new(MockMessagePublisher)
 
-	service := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, mockPublisher)
+	service := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
 
 	ctx := context.Background()
 	event := &domain.CollectionEvent{
@@ -115,7 +381,7 @@ func TestCatalogService_HandleCollectionCreated_AlreadyProcessed(t *testing.T) {
 	}
 
 	// Mock expectations - event already processed
-	mockProcessedEventRepo.On("MarkProcessed", ctx, event.EventID).Return(false, nil)
+	mockProcessedEventRepo.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.DuplicateEventID, nil)
 
 	// Act
 	err := service.HandleCollectionCreated(ctx, event)
@@ -126,3 +392,159 @@ func TestCatalogService_HandleCollectionCreated_AlreadyProcessed(t *testing.T) {
 	mockCollectionRepo.AssertNotCalled(t, "Upsert")
 	mockPublisher.AssertNotCalled(t, "PublishDomainEvent")
 }
+
+func TestCatalogService_HandleCollectionUpdated_OwnershipTransferred(t *testing.T) {
+	// Arrange
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockProcessedEventRepo := new(MockProcessedEventsRepository)
+	mockPublisher := new(MockMessagePublisher)
+
+	service := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+
+	ctx := context.Background()
+	contract := "0x1234567890123456789012345678901234567890"
+	event := &domain.CollectionEvent{
+		EventID:   "test-event-456",
+		EventType: "collection_ownership_transferred",
+		ChainID:   "eip155-1",
+		Contract:  contract,
+		Data: map[string]interface{}{
+			"collection_address": contract,
+			"previous_owner":     "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd",
+			"new_owner":          "0x1111111111111111111111111111111111111111",
+		},
+		Timestamp: time.Now(),
+	}
+
+	existing := domain.Collection{
+		ID:              "collection-1",
+		ChainID:         "eip155-1",
+		ContractAddress: contract,
+		Owner:           "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd",
+	}
+
+	// Mock expectations
+	mockProcessedEventRepo.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.NotDuplicate, nil)
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(contract)).Return(existing, nil)
+	mockCollectionRepo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool {
+		return c.ID == "collection-1" && c.Owner == "0x1111111111111111111111111111111111111111"
+	})).Return(false, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.AnythingOfType("*domain.DomainEvent")).Return(nil)
+
+	// Act
+	err := service.HandleCollectionUpdated(ctx, event)
+
+	// Assert
+	assert.NoError(t, err)
+	mockProcessedEventRepo.AssertExpectations(t)
+	mockCollectionRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleCollectionCreated_StoresConfirmations(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockProcessedEventRepo := new(MockProcessedEventsRepository)
+	mockPublisher := new(MockMessagePublisher)
+
+	service := service.NewCatalogService(mockCollectionRepo, mockProcessedEventRepo, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), mockPublisher)
+
+	ctx := context.Background()
+	var data map[string]interface{}
+	// Decoded from JSON like the consumer does, so counts arrive as float64
+	err := json.Unmarshal([]byte(`{"collection_address":"0x1234567890123456789012345678901234567890","creator":"0xabcdefabcdefabcdefabcdefabcdefabcdefabcd","name":"Fresh","collection_type":"ERC721","confirmations":2,"required_confirmations":12}`), &data)
+	assert.NoError(t, err)
+	event := &domain.CollectionEvent{
+		EventID:   "test-event-789",
+		EventType: "collection_created",
+		ChainID:   "eip155-1",
+		Contract:  "0x1234567890123456789012345678901234567890",
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+
+	mockProcessedEventRepo.On("MarkProcessed", ctx, processedKey(event.EventID)).Return(domain.NotDuplicate, nil)
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(event.Contract)).Return(domain.Collection{}, sql.ErrNoRows)
+	mockCollectionRepo.On("SlugOwner", ctx, "fresh").Return("", nil)
+	mockCollectionRepo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool {
+		return c.Confirmations == 2 && c.RequiredConfirmations == 12 && c.PendingFinality()
+	})).Return(true, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.AnythingOfType("*domain.DomainEvent")).Return(nil)
+
+	err = service.HandleCollectionCreated(ctx, event)
+
+	assert.NoError(t, err)
+	mockCollectionRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleCollectionConfirmations(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	service := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	contract := "0x1234567890123456789012345678901234567890"
+	mockCollectionRepo.On("SetConfirmations", ctx, domain.ChainID("eip155-1"), domain.Address(contract), 12, 12).Return(nil).Once()
+	mockCollectionRepo.On("SetConfirmations", ctx, domain.ChainID("eip155-1"), domain.Address("0x00000000000000000000000000000000000000aa"), 5, 12).Return(sql.ErrNoRows).Once()
+
+	err := service.HandleCollectionConfirmations(ctx, &domain.CollectionEvent{
+		EventType: "collection_confirmations",
+		ChainID:   "eip155-1",
+		Contract:  contract,
+		Data:      map[string]interface{}{"collection_address": contract, "confirmations": float64(12), "required_confirmations": float64(12)},
+	})
+	assert.NoError(t, err)
+
+	// An update for a collection not stored yet is dropped rather than retried
+	err = service.HandleCollectionConfirmations(ctx, &domain.CollectionEvent{
+		EventType: "collection_confirmations",
+		ChainID:   "eip155-1",
+		Contract:  "0x00000000000000000000000000000000000000aa",
+		Data:      map[string]interface{}{"confirmations": float64(5), "required_confirmations": float64(12)},
+	})
+	assert.NoError(t, err)
+	mockCollectionRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleIntentTxTracked_LinksIntent(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	service := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	ctx := context.Background()
+	event := &domain.CollectionEvent{
+		EventID:   "intent_tx_tracked_intent-1_0xabc",
+		EventType: "intent_tx_tracked",
+		ChainID:   "eip155:1",
+		TxHash:    "0xABC",
+		Data: map[string]interface{}{
+			"intent_id":  "intent-1",
+			"kind":       "collection",
+			"created_by": "7f2c1c4e-3d1a-4b7e-9a51-2b3f4c5d6e7f",
+		},
+		Timestamp: time.Now(),
+	}
+
+	mockCollectionRepo.On("LinkIntent", ctx, domain.IntentLink{
+		ChainID:         "eip155-1",
+		TxHash:          "0xABC",
+		IntentID:        "intent-1",
+		CreatedByUserID: "7f2c1c4e-3d1a-4b7e-9a51-2b3f4c5d6e7f",
+	}).Return(nil)
+
+	err := service.HandleIntentTxTracked(ctx, event)
+
+	assert.NoError(t, err)
+	mockCollectionRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleIntentTxTracked_RequiresIntentAndTx(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	service := service.NewCatalogService(mockCollectionRepo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+
+	err := service.HandleIntentTxTracked(context.Background(), &domain.CollectionEvent{
+		EventType: "intent_tx_tracked",
+		ChainID:   "eip155-1",
+		Data:      map[string]interface{}{"intent_id": "intent-1"},
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockCollectionRepo.AssertNotCalled(t, "LinkIntent", mock.Anything, mock.Anything)
+}
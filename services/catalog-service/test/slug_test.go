@@ -0,0 +1,131 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const slugContract = "0x5555555555555555555555555555555555555555"
+
+func TestSlugify(t *testing.T) {
+	cases := map[string]string{
+		"Bored Ape Yacht Club":    "bored-ape-yacht-club",
+		"  Crypto--Punks!! ":      "crypto-punks",
+		"Café Crème":              "cafe-creme",
+		"Đà Lạt Đêm":              "da-lat-dem",
+		"Straße der Ölmühle":      "strasse-der-olmuhle",
+		"Кибер Коты":              "kiber-koty",
+		"Ωmega Φ":                 "omega-f",
+		"ＦＵＬＬ　ＷＩＤＴＨ":              "full-width",
+		"猫":                       "",
+		"Genesis #1 (2024)":       "genesis-1-2024",
+		strings.Repeat("ab ", 40): strings.TrimSuffix(strings.Repeat("ab-", 16), "-"),
+	}
+	for name, want := range cases {
+		assert.Equal(t, want, service.Slugify(name), name)
+	}
+}
+
+func TestSlugCandidates(t *testing.T) {
+	candidates := service.SlugCandidates("Apes", "eip155:137")
+	assert.Equal(t, []string{"apes", "apes-polygon", "apes-polygon-2"}, candidates[:3])
+
+	// Reserved words and names with nothing to keep never take the bare slug
+	assert.Equal(t, "explore-ethereum", service.SlugCandidates("Explore", "eip155-1")[0])
+	assert.Equal(t, "collection-ethereum", service.SlugCandidates("猫", "eip155-1")[0])
+	assert.Equal(t, "apes-5000", service.SlugCandidates("Apes", "eip155-5000")[1])
+}
+
+func slugService(repo *MockCollectionsRepository, moderation *MockModerationRepository) *service.CatalogService {
+	return service.NewCatalogService(repo, new(MockProcessedEventsRepository), moderation, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+}
+
+func createdEvent(name string) *domain.CollectionEvent {
+	return &domain.CollectionEvent{
+		EventID:   "slug-event-" + name,
+		EventType: "collection_created",
+		ChainID:   "eip155-1",
+		Contract:  slugContract,
+		Data:      map[string]interface{}{"name": name, "collection_type": "ERC721"},
+		Timestamp: time.Now(),
+	}
+}
+
+func TestHandleCollectionCreated_SuffixesCollidingSlug(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCollectionsRepository)
+	processed := new(MockProcessedEventsRepository)
+	publisher := new(MockMessagePublisher)
+	svc := service.NewCatalogService(repo, processed, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), publisher)
+
+	evt := createdEvent("Apes")
+	processed.On("MarkProcessed", ctx, processedKey(evt.EventID)).Return(domain.NotDuplicate, nil)
+	repo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(slugContract)).Return(domain.Collection{}, sql.ErrNoRows)
+	repo.On("SlugOwner", ctx, "apes").Return("other-1", nil)
+	repo.On("SlugOwner", ctx, "apes-ethereum").Return("other-2", nil)
+	repo.On("SlugOwner", ctx, "apes-ethereum-2").Return("", nil)
+	repo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool { return c.Slug == "apes-ethereum-2" })).Return(true, nil)
+	publisher.On("PublishDomainEvent", ctx, mock.Anything).Return(nil)
+
+	require.NoError(t, svc.HandleCollectionCreated(ctx, evt))
+	repo.AssertExpectations(t)
+}
+
+func TestHandleCollectionCreated_RenameLeavesRedirect(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCollectionsRepository)
+	processed := new(MockProcessedEventsRepository)
+	publisher := new(MockMessagePublisher)
+	svc := service.NewCatalogService(repo, processed, new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), publisher)
+
+	existing := domain.Collection{ID: "collection-1", Name: "Apes", Slug: "apes", ChainID: "eip155-1", ContractAddress: slugContract}
+	repo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(slugContract)).Return(existing, nil)
+	publisher.On("PublishDomainEvent", ctx, mock.Anything).Return(nil)
+
+	// Same name: the slug is kept without lookups
+	same := createdEvent("Apes")
+	processed.On("MarkProcessed", ctx, processedKey(same.EventID)).Return(domain.NotDuplicate, nil)
+	repo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool { return c.Slug == "apes" })).Return(false, nil).Once()
+	require.NoError(t, svc.HandleCollectionCreated(ctx, same))
+	repo.AssertNotCalled(t, "SlugOwner", mock.Anything, mock.Anything)
+
+	// New name: the old slug redirects; a former slug of the same collection is reusable
+	renamed := createdEvent("Space Apes")
+	processed.On("MarkProcessed", ctx, processedKey(renamed.EventID)).Return(domain.NotDuplicate, nil)
+	repo.On("SlugOwner", ctx, "space-apes").Return("collection-1", nil)
+	repo.On("AddSlugRedirect", ctx, "apes", "collection-1").Return(nil)
+	repo.On("Upsert", ctx, mock.MatchedBy(func(c domain.Collection) bool { return c.Slug == "space-apes" })).Return(false, nil).Once()
+	require.NoError(t, svc.HandleCollectionCreated(ctx, renamed))
+	repo.AssertExpectations(t)
+}
+
+func TestGetCollectionBySlug_FollowsRedirect(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCollectionsRepository)
+	moderation := new(MockModerationRepository)
+	svc := slugService(repo, moderation)
+
+	repo.On("ResolveSlug", ctx, "apes").Return(domain.ChainID("eip155-1"), domain.Address(slugContract), nil)
+	repo.On("ResolveSlug", ctx, "missing").Return(domain.ChainID(""), domain.Address(""), sql.ErrNoRows)
+	repo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(slugContract)).
+		Return(domain.Collection{ID: "collection-1", Slug: "space-apes", ChainID: "eip155-1", ContractAddress: slugContract}, nil)
+	moderation.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(slugContract), "").Return(domain.ModerationFlag{}, sql.ErrNoRows)
+
+	collection, err := svc.GetCollectionBySlug(ctx, " Apes ", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "space-apes", collection.Slug)
+
+	_, err = svc.GetCollectionBySlug(ctx, "missing", false, false)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = svc.GetCollectionBySlug(ctx, "", false, false)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
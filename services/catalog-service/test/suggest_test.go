@@ -0,0 +1,73 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+)
+
+func newSuggestService(collectionRepo *MockCollectionsRepository) *service.CatalogService {
+	return service.NewCatalogService(collectionRepo, new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+}
+
+func TestCatalogService_Suggest_NormalizesQuery(t *testing.T) {
+	repo := new(MockCollectionsRepository)
+	svc := newSuggestService(repo)
+	ctx := context.Background()
+
+	repo.On("Suggest", ctx, "bored ape", 8).Return([]domain.Suggestion{
+		{Kind: domain.SuggestionCollection, ChainID: "eip155-1", ContractAddress: watchContract, Slug: "bored-ape", Label: "Bored Ape", Score: 2},
+		{Kind: domain.SuggestionToken, ChainID: "eip155-1", ContractAddress: watchContract, TokenID: "7", Label: "Bored Ape #7", CollectionName: "Bored Ape", Score: 1.9},
+	}, nil)
+
+	suggestions, err := svc.Suggest(ctx, "  Bored \t APE ", 0)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, "bored-ape", suggestions[0].Slug)
+	assert.Equal(t, "7", suggestions[1].TokenID)
+	repo.AssertExpectations(t)
+}
+
+func TestCatalogService_Suggest_SkipsShortQueries(t *testing.T) {
+	repo := new(MockCollectionsRepository)
+	svc := newSuggestService(repo)
+
+	for _, query := range []string{"", "   ", "a", " é "} {
+		suggestions, err := svc.Suggest(context.Background(), query, 5)
+		require.NoError(t, err)
+		assert.Empty(t, suggestions)
+	}
+	repo.AssertNotCalled(t, "Suggest", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_Suggest_BoundsLimitAndQuery(t *testing.T) {
+	repo := new(MockCollectionsRepository)
+	svc := newSuggestService(repo)
+	ctx := context.Background()
+
+	many := make([]domain.Suggestion, 40)
+	repo.On("Suggest", ctx, strings.Repeat("x", 64), 20).Return(many, nil)
+
+	suggestions, err := svc.Suggest(ctx, strings.Repeat("X", 200), 500)
+	require.NoError(t, err)
+	assert.Len(t, suggestions, 20)
+	repo.AssertExpectations(t)
+}
+
+func TestCatalogService_Suggest_WrapsRepositoryErrors(t *testing.T) {
+	repo := new(MockCollectionsRepository)
+	svc := newSuggestService(repo)
+
+	repo.On("Suggest", mock.Anything, "punk", 3).Return(nil, errors.New("connection refused"))
+
+	_, err := svc.Suggest(context.Background(), "punk", 3)
+	assert.ErrorContains(t, err, "connection refused")
+}
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+type stubQueueInspector map[string]contracts.QueueDepth
+
+func (s stubQueueInspector) InspectQueue(name string) (contracts.QueueDepth, error) {
+	depth, ok := s[name]
+	if !ok {
+		return contracts.QueueDepth{}, errors.New("NOT_FOUND - no queue")
+	}
+	return depth, nil
+}
+
+type stubConsumerLags []contracts.ConsumerLag
+
+func (s stubConsumerLags) ReadConsumerLags(ctx context.Context) ([]contracts.ConsumerLag, error) {
+	return s, nil
+}
+
+func newStatusTestService() *service.CatalogService {
+	return service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), new(MockWalletActivityRepository), new(MockMessagePublisher))
+}
+
+func TestGetSystemStatus_FlagsConsumersFallingBehind(t *testing.T) {
+	now := time.Now()
+	svc := newStatusTestService()
+	svc.SetSystemStatus(
+		stubQueueInspector{
+			"catalog-service-queue":           {Name: "catalog-service-queue", Messages: 5000, Consumers: 1},
+			"subscription.collections.domain": {Name: "subscription.collections.domain", Messages: 30, Consumers: 1},
+		},
+		stubConsumerLags{
+			// 5000 waiting at 20/s takes over four minutes to drain
+			{Consumer: "catalog-service-consumer", Queue: "catalog-service-queue", MessagesPerSecond: 20, ReportedAt: now},
+			{Consumer: "subscription-worker", Queue: "subscription.collections.domain", MessagesPerSecond: 50, OldestUnackedSeconds: 2, ReportedAt: now},
+		},
+		[]string{"catalog-service-queue", "subscription.collections.domain", "indexer.dlq"},
+	)
+
+	status, err := svc.GetSystemStatus(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, status.Queues, 3)
+	assert.Equal(t, 5000, status.Queues[0].Messages)
+	assert.Equal(t, "indexer.dlq", status.Queues[2].Name)
+	assert.NotEmpty(t, status.Queues[2].Error)
+
+	require.Len(t, status.Consumers, 2)
+	assert.Equal(t, "catalog-service-consumer", status.Consumers[0].Consumer)
+	assert.True(t, status.Consumers[0].FallingBehind)
+	assert.False(t, status.Consumers[1].FallingBehind)
+	assert.False(t, status.Consumers[1].Stale)
+}
+
+func TestGetSystemStatus_StaleAndStuckConsumers(t *testing.T) {
+	now := time.Now()
+	svc := newStatusTestService()
+	svc.SetSystemStatus(
+		stubQueueInspector{
+			"catalog-service-queue":           {Name: "catalog-service-queue", Messages: 3},
+			"subscription.collections.domain": {Name: "subscription.collections.domain"},
+		},
+		stubConsumerLags{
+			// stopped reporting with messages waiting
+			{Consumer: "catalog-service-consumer", Queue: "catalog-service-queue", MessagesPerSecond: 100, ReportedAt: now.Add(-10 * time.Minute)},
+			// holding a message published two minutes ago
+			{Consumer: "subscription-worker", Queue: "subscription.collections.domain", InFlight: 1, OldestUnackedSeconds: 120, ReportedAt: now},
+		},
+		[]string{"catalog-service-queue", "subscription.collections.domain"},
+	)
+
+	status, err := svc.GetSystemStatus(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, status.Consumers, 2)
+	assert.True(t, status.Consumers[0].Stale)
+	assert.True(t, status.Consumers[0].FallingBehind)
+	assert.False(t, status.Consumers[1].Stale)
+	assert.True(t, status.Consumers[1].FallingBehind)
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	"github.com/quangdang46/NFT-Marketplace/shared/tokengate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var gateSecret = []byte("gate-secret")
+
+func TestCatalogService_VerifyTokenGate_SumsLinkedWallets(t *testing.T) {
+	svc, _, _ := newSnapshotService()
+	svc.SetTokenGates(gateSecret, time.Minute)
+	ctx := context.Background()
+
+	indexedTransfer(t, svc, "mint", 100, map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "ids": []interface{}{"1", "2"}, "values": []interface{}{"2", "1"},
+	})
+	indexedTransfer(t, svc, "transfer", 110, map[string]interface{}{
+		"from": holderAddr, "to": otherHolder, "ids": []interface{}{"1"}, "values": []interface{}{"1"},
+	})
+
+	in := domain.VerifyTokenGateInput{
+		UserID:     "user-1",
+		Owners:     []string{"0x" + strings.ToUpper(holderAddr[2:]), otherHolder},
+		ChainID:    "eip155:1",
+		Contract:   domain.Address(editionContract),
+		MinBalance: big.NewInt(3),
+	}
+
+	result, err := svc.VerifyTokenGate(ctx, in)
+	require.NoError(t, err)
+	assert.True(t, result.Held)
+	assert.Equal(t, "3", result.Balance.String())
+
+	claims, err := tokengate.Verify(gateSecret, result.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+	assert.True(t, claims.Covers("eip155-1", strings.ToUpper(editionContract), big.NewInt(2)))
+	assert.False(t, claims.Covers("eip155-1", editionContract, big.NewInt(4)))
+	assert.WithinDuration(t, time.Now().Add(time.Minute), result.ExpiresAt, 5*time.Second)
+
+	// One wallet alone falls short
+	in.Owners = []string{otherHolder}
+	result, err = svc.VerifyTokenGate(ctx, in)
+	require.NoError(t, err)
+	assert.False(t, result.Held)
+	assert.Equal(t, "1", result.Balance.String())
+	assert.Empty(t, result.Token)
+}
+
+func TestCatalogService_VerifyTokenGate_Disabled(t *testing.T) {
+	svc, _, _ := newSnapshotService()
+
+	_, err := svc.VerifyTokenGate(context.Background(), domain.VerifyTokenGateInput{
+		UserID: "user-1", ChainID: "eip155-1", Contract: domain.Address(editionContract),
+	})
+	assert.True(t, errs.Is(err, errs.Unavailable), "got %v", err)
+}
+
+func TestTokenGate_RejectsForeignTokens(t *testing.T) {
+	now := time.Now()
+	token, err := tokengate.Sign(gateSecret, tokengate.Claims{
+		UserID: "user-1", ChainID: "eip155:1", Contract: editionContract, MinBalance: big.NewInt(1), ExpiresAt: now.Add(time.Minute),
+	}, now)
+	require.NoError(t, err)
+
+	_, err = tokengate.Verify([]byte("other-secret"), token)
+	assert.ErrorIs(t, err, tokengate.ErrInvalidToken)
+
+	expired, err := tokengate.Sign(gateSecret, tokengate.Claims{
+		UserID: "user-1", ChainID: "eip155:1", Contract: editionContract, MinBalance: big.NewInt(1), ExpiresAt: now.Add(-time.Minute),
+	}, now.Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = tokengate.Verify(gateSecret, expired)
+	assert.ErrorIs(t, err, tokengate.ErrInvalidToken)
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+func TestCollectionRepository_ListTokens_ReadsSupplyLedger(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := repository.NewCollectionRepository(postgres.NewPostgresWithDB(db), nil)
+
+	columns := []string{
+		"chain_id", "contract_address", "token_number", "standard",
+		"minted", "burned", "max_supply", "name", "image_url", "owner_address",
+		"rarity", "price", "moderation",
+	}
+	mock.ExpectQuery(`LEFT JOIN token_supply ts`).WillReturnRows(sqlmock.NewRows(columns).
+		// an edition of 50 minted, 8 burned, in a collection capped at 100 per token
+		AddRow("eip155-1", editionContract, "1", "ERC1155", "50", "8", "100", "Edition", "", "", nil, nil, "").
+		// an ERC-1155 token the ledger hasn't seen yet, in an uncapped collection
+		AddRow("eip155-1", editionContract, "2", "ERC1155", nil, nil, "0", "Fresh", "", "", nil, nil, "").
+		AddRow("eip155-1", "0x00000000000000000000000000000000000000c7", "9", "ERC721", nil, nil, "10000", "Unique", "", holderAddr, nil, "1000", ""))
+
+	tokens, err := repo.ListTokens(context.Background(), domain.TokenFilter{})
+	require.NoError(t, err)
+	require.Len(t, tokens, 3)
+
+	edition := tokens[0]
+	assert.Equal(t, big.NewInt(42), edition.Supply)
+	assert.Equal(t, big.NewInt(50), edition.Minted)
+	assert.Equal(t, big.NewInt(8), edition.Burned)
+	assert.Equal(t, big.NewInt(100), edition.MaxSupply)
+
+	fresh := tokens[1]
+	assert.Equal(t, 0, fresh.Supply.Sign())
+	assert.Equal(t, 0, fresh.Minted.Sign())
+	assert.Nil(t, fresh.MaxSupply, "an uncapped collection has no max supply")
+
+	unique := tokens[2]
+	assert.Equal(t, big.NewInt(1), unique.Supply)
+	assert.Equal(t, big.NewInt(1), unique.MaxSupply)
+	assert.Equal(t, big.NewInt(0), unique.Burned)
+	assert.Equal(t, big.NewInt(1000), unique.Price)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,154 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	editionContract = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+	zeroAddr        = "0x0000000000000000000000000000000000000000"
+	holderAddr      = "0x00000000000000000000000000000000000000aa"
+)
+
+// newSupplyService accepts any wallet activity; wallet_activity_test covers what is recorded
+func newSupplyService(collectionRepo *MockCollectionsRepository, moderationRepo *MockModerationRepository, supplyRepo *MockTokenSupplyRepository, publisher *MockMessagePublisher) *service.CatalogService {
+	activityRepo := new(MockWalletActivityRepository)
+	activityRepo.On("Record", mock.Anything, mock.Anything).Return(nil)
+	return service.NewCatalogService(collectionRepo, new(MockProcessedEventsRepository), moderationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), supplyRepo, activityRepo, publisher)
+}
+
+func transferEvent(eventType string, args map[string]interface{}) *domain.CollectionEvent {
+	return &domain.CollectionEvent{
+		EventID:   "evt-" + eventType,
+		EventType: eventType,
+		ChainID:   "eip155-1",
+		TxHash:    "0xtx",
+		Contract:  editionContract,
+		Data:      map[string]interface{}{"args": args},
+	}
+}
+
+func TestCatalogService_HandleDecodedEvent_BatchMint(t *testing.T) {
+	supplyRepo := new(MockTokenSupplyRepository)
+	publisher := new(MockMessagePublisher)
+	svc := newSupplyService(new(MockCollectionsRepository), new(MockModerationRepository), supplyRepo, publisher)
+	ctx := context.Background()
+
+	evt := transferEvent("transfer_batch", map[string]interface{}{
+		"operator": holderAddr,
+		"from":     zeroAddr,
+		"to":       holderAddr,
+		"ids":      []interface{}{"1", "2", "1"},
+		"values":   []interface{}{"5", "3", "2"},
+	})
+
+	supplyRepo.On("ApplyTransfer", ctx, "evt-transfer_batch", "eip155-1", editionContract, mock.MatchedBy(func(deltas []domain.SupplyDelta) bool {
+		return len(deltas) == 2 &&
+			deltas[0].TokenID == "1" && deltas[0].Minted.Cmp(big.NewInt(7)) == 0 && deltas[0].Burned.Sign() == 0 &&
+			deltas[1].TokenID == "2" && deltas[1].Minted.Cmp(big.NewInt(3)) == 0
+	})).Return([]domain.TokenSupply{
+		{ChainID: "eip155-1", ContractAddress: editionContract, TokenID: "1", Minted: big.NewInt(17), Burned: big.NewInt(4)},
+		{ChainID: "eip155-1", ContractAddress: editionContract, TokenID: "2", Minted: big.NewInt(3), Burned: big.NewInt(0)},
+	}, true, nil)
+	publisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(e *domain.DomainEvent) bool {
+		return e.EventType == domain.EventTokenSupplyChanged && e.Data["token_id"] == "1" && e.Data["supply"] == "13"
+	})).Return(nil).Once()
+	publisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(e *domain.DomainEvent) bool {
+		return e.EventType == domain.EventTokenSupplyChanged && e.Data["token_id"] == "2" && e.Data["supply"] == "3"
+	})).Return(nil).Once()
+
+	require.NoError(t, svc.HandleDecodedEvent(ctx, evt))
+	supplyRepo.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleDecodedEvent_Burn(t *testing.T) {
+	supplyRepo := new(MockTokenSupplyRepository)
+	publisher := new(MockMessagePublisher)
+	svc := newSupplyService(new(MockCollectionsRepository), new(MockModerationRepository), supplyRepo, publisher)
+	ctx := context.Background()
+
+	evt := transferEvent("transfer_single", map[string]interface{}{
+		"operator": holderAddr,
+		"from":     holderAddr,
+		"to":       zeroAddr,
+		"id":       "1",
+		"value":    "2",
+	})
+
+	supplyRepo.On("ApplyTransfer", ctx, "evt-transfer_single", "eip155-1", editionContract, mock.MatchedBy(func(deltas []domain.SupplyDelta) bool {
+		return len(deltas) == 1 && deltas[0].Minted.Sign() == 0 && deltas[0].Burned.Cmp(big.NewInt(2)) == 0
+	})).Return([]domain.TokenSupply{
+		{ChainID: "eip155-1", ContractAddress: editionContract, TokenID: "1", Minted: big.NewInt(10), Burned: big.NewInt(2)},
+	}, true, nil)
+	publisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(e *domain.DomainEvent) bool {
+		return e.Data["supply"] == "8" && e.Data["burned_delta"] == "2"
+	})).Return(nil)
+
+	require.NoError(t, svc.HandleDecodedEvent(ctx, evt))
+	supplyRepo.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleDecodedEvent_IgnoresTransfersAndReplays(t *testing.T) {
+	supplyRepo := new(MockTokenSupplyRepository)
+	publisher := new(MockMessagePublisher)
+	svc := newSupplyService(new(MockCollectionsRepository), new(MockModerationRepository), supplyRepo, publisher)
+	ctx := context.Background()
+
+	// holder to holder moves no supply
+	transfer := transferEvent("transfer_single", map[string]interface{}{
+		"from": holderAddr, "to": "0x00000000000000000000000000000000000000bb", "id": "1", "value": "1",
+	})
+	require.NoError(t, svc.HandleDecodedEvent(ctx, transfer))
+
+	// other decoded events are not supply changes
+	require.NoError(t, svc.HandleDecodedEvent(ctx, transferEvent("approval_for_all", map[string]interface{}{})))
+
+	mint := transferEvent("transfer_single", map[string]interface{}{
+		"from": zeroAddr, "to": holderAddr, "id": "1", "value": "1",
+	})
+	supplyRepo.On("ApplyTransfer", ctx, mint.EventID, "eip155-1", editionContract, mock.Anything).Return(nil, false, nil)
+	require.NoError(t, svc.HandleDecodedEvent(ctx, mint))
+
+	supplyRepo.AssertNumberOfCalls(t, "ApplyTransfer", 1)
+	publisher.AssertNotCalled(t, "PublishDomainEvent", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_GetToken(t *testing.T) {
+	collectionRepo := new(MockCollectionsRepository)
+	moderationRepo := new(MockModerationRepository)
+	supplyRepo := new(MockTokenSupplyRepository)
+	svc := newSupplyService(collectionRepo, moderationRepo, supplyRepo, new(MockMessagePublisher))
+	ctx := context.Background()
+
+	collectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(editionContract)).Return(domain.Collection{
+		ChainID:         "eip155-1",
+		ContractAddress: editionContract,
+		CollectionType:  "ERC1155",
+		MaxSupply:       big.NewInt(100),
+	}, nil)
+	moderationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(editionContract), "").Return(domain.ModerationFlag{}, sql.ErrNoRows)
+	moderationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(editionContract), "1").Return(domain.ModerationFlag{}, sql.ErrNoRows)
+	supplyRepo.On("Get", ctx, "eip155-1", editionContract, "1").Return(domain.TokenSupply{
+		Minted: big.NewInt(10), Burned: big.NewInt(3),
+	}, nil)
+
+	token, err := svc.GetToken(ctx, "eip155:1", domain.Address(editionContract), "1", false)
+	require.NoError(t, err)
+	assert.Equal(t, "7", token.Supply.String())
+	assert.Equal(t, "100", token.MaxSupply.String())
+	assert.Equal(t, "ERC1155", token.Standard)
+
+	_, err = svc.GetToken(ctx, "eip155:1", domain.Address(editionContract), "abc", false)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newActivityService(activityRepo *MockWalletActivityRepository, earningsRepo *MockEarningsRepository) *service.CatalogService {
+	return service.NewCatalogService(new(MockCollectionsRepository), new(MockProcessedEventsRepository), new(MockModerationRepository), new(MockReportsRepository), earningsRepo, new(MockSchedulerRepository), new(MockAuctionRepository), new(MockWatchlistRepository), new(MockTokenSupplyRepository), activityRepo, new(MockMessagePublisher))
+}
+
+func TestCatalogService_HandleDecodedEvent_RecordsERC721Transfer(t *testing.T) {
+	activityRepo := new(MockWalletActivityRepository)
+	svc := newActivityService(activityRepo, new(MockEarningsRepository))
+	ctx := context.Background()
+
+	evt := transferEvent("transfer", map[string]interface{}{
+		"from":    zeroAddr,
+		"to":      holderAddr,
+		"tokenId": "42",
+	})
+	evt.Timestamp = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	activityRepo.On("Record", ctx, []domain.WalletActivity{{
+		ID:              "evt-transfer",
+		ChainID:         "eip155-1",
+		ContractAddress: editionContract,
+		TokenID:         "42",
+		Kind:            domain.ActivityMint,
+		FromAddress:     zeroAddr,
+		ToAddress:       holderAddr,
+		Quantity:        big.NewInt(1),
+		TxHash:          "0xtx",
+		OccurredAt:      evt.Timestamp,
+	}}).Return(nil)
+
+	// ERC-721 transfers are activity only; supply is tracked for ERC-1155
+	require.NoError(t, svc.HandleDecodedEvent(ctx, evt))
+	activityRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleDecodedEvent_RecordsBatchLegs(t *testing.T) {
+	activityRepo := new(MockWalletActivityRepository)
+	svc := newActivityService(activityRepo, new(MockEarningsRepository))
+	ctx := context.Background()
+
+	evt := transferEvent("transfer_batch", map[string]interface{}{
+		"from":   holderAddr,
+		"to":     "0x00000000000000000000000000000000000000bb",
+		"ids":    []interface{}{"1", "2", "1"},
+		"values": []interface{}{"5", "0", "2"},
+	})
+
+	activityRepo.On("Record", ctx, mock.MatchedBy(func(activities []domain.WalletActivity) bool {
+		// the zero-amount leg is dropped; the repeated id keeps its own row
+		return len(activities) == 2 &&
+			activities[0].ID == "evt-transfer_batch:0" && activities[0].Quantity.Cmp(big.NewInt(5)) == 0 &&
+			activities[1].ID == "evt-transfer_batch:1" && activities[1].TokenID == "1" &&
+			activities[1].Kind == domain.ActivityTransfer
+	})).Return(nil)
+
+	require.NoError(t, svc.HandleDecodedEvent(ctx, evt))
+	activityRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_HandleSaleIndexed_RecordsSaleActivity(t *testing.T) {
+	activityRepo := new(MockWalletActivityRepository)
+	earningsRepo := new(MockEarningsRepository)
+	svc := newActivityService(activityRepo, earningsRepo)
+	ctx := context.Background()
+
+	evt := &domain.CollectionEvent{
+		EventID:   "sale-1",
+		EventType: "sale_indexed",
+		ChainID:   "eip155:1",
+		TxHash:    "0xsale",
+		Contract:  editionContract,
+		Data: map[string]interface{}{
+			"token_id":          "7",
+			"price":             "1000",
+			"currency":          "weth",
+			"seller":            holderAddr,
+			"buyer":             "0x00000000000000000000000000000000000000BB",
+			"royalty_recipient": holderAddr,
+			"royalty_amount":    "50",
+			"occurred_at":       "2026-01-02T03:04:05Z",
+		},
+	}
+
+	activityRepo.On("Record", ctx, mock.MatchedBy(func(activities []domain.WalletActivity) bool {
+		a := activities[0]
+		return len(activities) == 1 && a.Kind == domain.ActivitySale && a.ChainID == "eip155-1" &&
+			a.FromAddress == holderAddr && a.ToAddress == "0x00000000000000000000000000000000000000bb" &&
+			a.Price.Cmp(big.NewInt(1000)) == 0 && a.Currency == "WETH" && a.Quantity.Cmp(big.NewInt(1)) == 0
+	})).Return(nil)
+	earningsRepo.On("Record", ctx, mock.Anything).Return(true, nil)
+
+	require.NoError(t, svc.HandleSaleIndexed(ctx, evt))
+	activityRepo.AssertExpectations(t)
+	earningsRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_ListWalletActivity(t *testing.T) {
+	activityRepo := new(MockWalletActivityRepository)
+	svc := newActivityService(activityRepo, new(MockEarningsRepository))
+	ctx := context.Background()
+
+	before := &domain.ActivityCursor{OccurredAt: time.Now(), ID: "evt-1"}
+	activityRepo.On("ListByAddress", ctx, holderAddr, before, 100).Return([]domain.WalletActivity{{ID: "evt-0"}}, nil)
+
+	activities, err := svc.ListWalletActivity(ctx, "0x00000000000000000000000000000000000000AA", before, 500)
+	require.NoError(t, err)
+	assert.Len(t, activities, 1)
+
+	_, err = svc.ListWalletActivity(ctx, "not-an-address", nil, 20)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.ListWalletActivity(ctx, holderAddr, &domain.ActivityCursor{OccurredAt: time.Now()}, 20)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
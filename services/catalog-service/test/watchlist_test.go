@@ -0,0 +1,155 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/catalog-service/internal/service"
+)
+
+const watchContract = "0x00000000000000000000000000000000000000e1"
+
+func newWatchlistService(collectionRepo *MockCollectionsRepository, moderationRepo *MockModerationRepository, watchlistRepo *MockWatchlistRepository, publisher *MockMessagePublisher) *service.CatalogService {
+	return service.NewCatalogService(collectionRepo, new(MockProcessedEventsRepository), moderationRepo, new(MockReportsRepository), new(MockEarningsRepository), new(MockSchedulerRepository), new(MockAuctionRepository), watchlistRepo, new(MockTokenSupplyRepository), new(MockWalletActivityRepository), publisher)
+}
+
+func TestCatalogService_Favorite_RecordsFloorBaseline(t *testing.T) {
+	mockCollectionRepo := new(MockCollectionsRepository)
+	mockModerationRepo := new(MockModerationRepository)
+	mockWatchlistRepo := new(MockWatchlistRepository)
+	svc := newWatchlistService(mockCollectionRepo, mockModerationRepo, mockWatchlistRepo, new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockCollectionRepo.On("GetByPK", ctx, domain.ChainID("eip155-1"), domain.Address(watchContract)).
+		Return(domain.Collection{ChainID: "eip155-1", ContractAddress: watchContract, Name: "Watched", FloorPrice: big.NewInt(500)}, nil)
+	mockModerationRepo.On("Get", ctx, domain.ChainID("eip155-1"), domain.Address(watchContract), "").
+		Return(domain.ModerationFlag{}, sql.ErrNoRows)
+	mockWatchlistRepo.On("AddFavorite", ctx, mock.MatchedBy(func(item domain.WatchlistItem) bool {
+		return item.UserID == "user-1" &&
+			item.TargetType == domain.WatchTargetCollection &&
+			item.ChainID == "eip155-1" &&
+			item.ContractAddress == watchContract &&
+			item.FloorAtAdd.Cmp(big.NewInt(500)) == 0 &&
+			item.FloorAlertBelow.Cmp(big.NewInt(400)) == 0
+	})).Return(domain.WatchlistItem{
+		ID: "item-1", UserID: "user-1", TargetType: domain.WatchTargetCollection, ChainID: "eip155-1",
+		ContractAddress: watchContract, FloorAtAdd: big.NewInt(500), FloorAlertBelow: big.NewInt(400),
+	}, nil)
+
+	item, err := svc.Favorite(ctx, domain.FavoriteInput{
+		UserID:          "user-1",
+		TargetType:      domain.WatchTargetCollection,
+		ChainID:         "eip155:1",
+		ContractAddress: "0x00000000000000000000000000000000000000E1",
+		FloorAlertBelow: big.NewInt(400),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Watched", item.CollectionName)
+	assert.Equal(t, "0", item.FloorDelta().String())
+	mockWatchlistRepo.AssertExpectations(t)
+}
+
+func TestCatalogService_Favorite_RejectsInvalidInput(t *testing.T) {
+	mockWatchlistRepo := new(MockWatchlistRepository)
+	svc := newWatchlistService(new(MockCollectionsRepository), new(MockModerationRepository), mockWatchlistRepo, new(MockMessagePublisher))
+
+	cases := map[string]domain.FavoriteInput{
+		"token without token id":   {UserID: "user-1", TargetType: domain.WatchTargetToken, ChainID: "eip155-1", ContractAddress: watchContract},
+		"collection with token id": {UserID: "user-1", TargetType: domain.WatchTargetCollection, ChainID: "eip155-1", ContractAddress: watchContract, TokenID: "1"},
+		"non-positive alert":       {UserID: "user-1", TargetType: domain.WatchTargetCollection, ChainID: "eip155-1", ContractAddress: watchContract, FloorAlertBelow: big.NewInt(0)},
+		"unknown target type":      {UserID: "user-1", TargetType: "wallet", ChainID: "eip155-1", ContractAddress: watchContract},
+		"missing user":             {TargetType: domain.WatchTargetCollection, ChainID: "eip155-1", ContractAddress: watchContract},
+	}
+	for name, in := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.Favorite(context.Background(), in)
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+	mockWatchlistRepo.AssertNotCalled(t, "AddFavorite", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_SaveSearch_DefaultsNameAndEnforcesLimit(t *testing.T) {
+	mockWatchlistRepo := new(MockWatchlistRepository)
+	svc := newWatchlistService(new(MockCollectionsRepository), new(MockModerationRepository), mockWatchlistRepo, new(MockMessagePublisher))
+
+	ctx := context.Background()
+	mockWatchlistRepo.On("CountSavedSearches", ctx, "user-1").Return(0, nil).Once()
+	mockWatchlistRepo.On("SaveSearch", ctx, mock.MatchedBy(func(s domain.SavedSearch) bool {
+		return s.Name == "punks" && s.Query == "punks" && s.Filters["chain_id"] == "eip155-1"
+	})).Return(domain.SavedSearch{ID: "search-1", Name: "punks", Query: "punks"}, nil)
+
+	saved, err := svc.SaveSearch(ctx, domain.SaveSearchInput{UserID: "user-1", Query: "  punks ", Filters: map[string]string{"chain_id": "eip155-1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "search-1", saved.ID)
+
+	mockWatchlistRepo.On("CountSavedSearches", ctx, "user-1").Return(50, nil).Once()
+	_, err = svc.SaveSearch(ctx, domain.SaveSearchInput{UserID: "user-1", Query: "apes"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockWatchlistRepo.AssertNumberOfCalls(t, "SaveSearch", 1)
+}
+
+func TestCatalogService_EvaluateFloorAlerts(t *testing.T) {
+	mockWatchlistRepo := new(MockWatchlistRepository)
+	mockPublisher := new(MockMessagePublisher)
+	svc := newWatchlistService(new(MockCollectionsRepository), new(MockModerationRepository), mockWatchlistRepo, mockPublisher)
+
+	ctx := context.Background()
+	now := time.Now()
+	firedEarlier := now.Add(-time.Hour)
+	items := []domain.WatchlistItem{
+		// Dropped below: fires
+		{ID: "a", UserID: "user-1", ChainID: "eip155-1", ContractAddress: watchContract, FloorAtAdd: big.NewInt(500), CurrentFloor: big.NewInt(300), FloorAlertBelow: big.NewInt(400)},
+		// Still below and already fired: quiet
+		{ID: "b", UserID: "user-2", ChainID: "eip155-1", ContractAddress: watchContract, FloorAtAdd: big.NewInt(500), CurrentFloor: big.NewInt(300), FloorAlertBelow: big.NewInt(400), AlertFiredAt: &firedEarlier},
+		// Recovered: re-armed
+		{ID: "c", UserID: "user-3", ChainID: "eip155-1", ContractAddress: watchContract, FloorAtAdd: big.NewInt(500), CurrentFloor: big.NewInt(450), FloorAlertBelow: big.NewInt(400), AlertFiredAt: &firedEarlier},
+		// No floor: skipped
+		{ID: "d", UserID: "user-4", ChainID: "eip155-1", ContractAddress: watchContract, FloorAtAdd: big.NewInt(500), CurrentFloor: big.NewInt(0), FloorAlertBelow: big.NewInt(400)},
+	}
+	mockWatchlistRepo.On("ListFloorAlerts", ctx, "", 100).Return(items, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.MatchedBy(func(evt *domain.DomainEvent) bool {
+		return evt.EventType == domain.EventWatchlistFloorBelow &&
+			evt.AggregateID == "a" &&
+			evt.Data["user_id"] == "user-1" &&
+			evt.Data["floor_delta"] == "-200"
+	})).Return(nil).Once()
+	mockWatchlistRepo.On("SetAlertFired", ctx, "a", &now).Return(nil)
+	mockWatchlistRepo.On("SetAlertFired", ctx, "c", (*time.Time)(nil)).Return(nil)
+
+	fired, err := svc.EvaluateFloorAlerts(ctx, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fired)
+	mockPublisher.AssertExpectations(t)
+	mockWatchlistRepo.AssertExpectations(t)
+	mockWatchlistRepo.AssertNumberOfCalls(t, "SetAlertFired", 2)
+}
+
+func TestCatalogService_EvaluateFloorAlerts_RetriesFailedPublish(t *testing.T) {
+	mockWatchlistRepo := new(MockWatchlistRepository)
+	mockPublisher := new(MockMessagePublisher)
+	svc := newWatchlistService(new(MockCollectionsRepository), new(MockModerationRepository), mockWatchlistRepo, mockPublisher)
+
+	ctx := context.Background()
+	mockWatchlistRepo.On("ListFloorAlerts", ctx, "", 100).Return([]domain.WatchlistItem{
+		{ID: "a", UserID: "user-1", ChainID: "eip155-1", ContractAddress: watchContract, FloorAtAdd: big.NewInt(500), CurrentFloor: big.NewInt(300), FloorAlertBelow: big.NewInt(400)},
+	}, nil)
+	mockPublisher.On("PublishDomainEvent", ctx, mock.Anything).Return(errors.New("broker down"))
+
+	fired, err := svc.EvaluateFloorAlerts(ctx, time.Now())
+
+	// The alert stays armed so the next tick retries it
+	assert.NoError(t, err)
+	assert.Equal(t, 0, fired)
+	mockWatchlistRepo.AssertNotCalled(t, "SetAlertFired", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -3,16 +3,24 @@ package main
 import (
 	"context"
 	"log"
-	"net"
+	"net/http"
+	"time"
 
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/infrastructure/events"
 	grpc_handler "github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/infrastructure/httpapi"
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/infrastructure/repository"
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/seed"
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	chainpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
-	"google.golang.org/grpc"
 
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/config"
+	runtimeconfig "github.com/quangdang46/NFT-Marketplace/shared/config"
+	"github.com/quangdang46/NFT-Marketplace/shared/grpcserver"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 	shpg "github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	shredis "github.com/quangdang46/NFT-Marketplace/shared/redis"
 )
@@ -21,6 +29,12 @@ func main() {
 	cfg := config.Load()
 	cfg.Validate()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("chain-registry-service"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	ctx := context.Background()
 
 	pg, err := shpg.NewPostgres(cfg.Postgres)
@@ -46,19 +60,86 @@ func main() {
 		log.Fatalf("failed to ping redis: %v", err)
 	}
 
+	// ABI change announcements are best effort: registry reads must not depend on RabbitMQ
+	var publisher domain.EventPublisher
+	amqpClient, err := messaging.NewRabbitMQ(cfg.RabbitMQ)
+	if err != nil {
+		log.Printf("rabbitmq unavailable, abi_changed events and chain heads disabled: %v", err)
+	} else {
+		defer amqpClient.Close()
+		if p, err := events.NewEventPublisher(amqpClient); err != nil {
+			log.Printf("abi_changed events disabled: %v", err)
+		} else {
+			publisher = p
+		}
+	}
+
 	repo := repository.NewRepository(pg, redis)
-	svc := service.New(repo)
+	svc := service.New(repo, publisher)
+	svc.(*service.Service).SetChainHeads(repository.NewChainHeadStore(redis), time.Duration(cfg.ChainHead.StaleAfterSeconds)*time.Second)
+
+	// The indexer reports heads over RabbitMQ; without it GetChainHead serves the last stored ones
+	if amqpClient != nil {
+		err := amqpClient.ConsumeChainHeadAdvanced("registry.chain.head_advanced", "chain-registry-service", func(ctx context.Context, event *contracts.ChainHeadAdvancedEvent) error {
+			return svc.HandleChainHeadAdvanced(ctx, event.ChainHead)
+		})
+		if err != nil {
+			log.Printf("chain.head_advanced consumer disabled: %v", err)
+		}
+	}
+
+	// Runtime config adjusts the log level and ABI rate limits without a restart
+	var reloader *runtimeconfig.Reloader
+	if source := runtimeconfig.LoadSource(); source != nil {
+		reloader = runtimeconfig.NewReloader(source)
+		runtimeconfig.WatchLogLevel(reloader)
+	}
+
+	if cfg.HTTP.Port != "" {
+		limiter := httpapi.NewRateLimiter(cfg.HTTP.RateLimitPerMinute, cfg.HTTP.RateLimitBurst, cfg.HTTP.TrustForwardedFor)
+		if reloader != nil {
+			base := config.RateLimits{PerMinute: cfg.HTTP.RateLimitPerMinute, Burst: cfg.HTTP.RateLimitBurst}
+			runtimeconfig.OnChange(reloader, config.RateLimitSection, base, func(l config.RateLimits) {
+				limiter.SetLimits(l.PerMinute, l.Burst)
+			})
+		}
+		mux := http.NewServeMux()
+		mux.Handle(httpapi.AbiRoute, httpapi.NewAbiHandler(svc, limiter))
+		httpServer := &http.Server{
+			Addr:              cfg.HTTP.Port,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+		}
+		go func() {
+			log.Printf("ABI HTTP endpoint listening on %s", cfg.HTTP.Port)
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Printf("ABI HTTP endpoint stopped: %v", err)
+			}
+		}()
+	}
+
+	if reloader != nil {
+		if err := reloader.Start(ctx); err != nil {
+			log.Printf("runtime config disabled: %v", err)
+		}
+	}
 
-	server := grpc.NewServer()
+	server := grpcserver.New(grpcserver.LoadConfig("chain-registry-service"))
 	handler := grpc_handler.NewGRPCHandler(svc)
 	chainpb.RegisterChainRegistryServiceServer(server, handler)
 
-	lis, err := net.Listen("tcp", cfg.GRPC.Port)
-	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
-	}
 	log.Printf("Chain Registry service listening on %s", cfg.GRPC.Port)
-	if err := server.Serve(lis); err != nil {
+	if err := grpcserver.ListenAndServe(server, cfg.GRPC.Port); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
+
+	// Finish the chain heads already received before the connection closes
+	if amqpClient != nil {
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer drainCancel()
+		if err := amqpClient.Drain(drainCtx); err != nil {
+			log.Printf("Error draining consumers: %v", err)
+		}
+	}
 }
@@ -63,6 +63,44 @@ CREATE TABLE IF NOT EXISTS chain_gas_policy (
   updated_at                       TIMESTAMPTZ NOT NULL DEFAULT now()
 );
 
+-- Giới hạn tham số tạo collection theo chain (optional, NULL => mặc định)
+CREATE TABLE IF NOT EXISTS chain_collection_limits (
+  chain_id                         INTEGER PRIMARY KEY REFERENCES chains(id) ON DELETE CASCADE,
+  max_royalty_bps                  BIGINT CHECK (max_royalty_bps BETWEEN 0 AND 10000),
+  min_stage_duration_sec           BIGINT CHECK (min_stage_duration_sec >= 0),
+  max_supply_cap                   BIGINT CHECK (max_supply_cap > 0),
+  updated_at                       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Tính năng marketplace theo chain (không có dòng => mặc định: chỉ ERC-721 factory)
+CREATE TABLE IF NOT EXISTS chain_capabilities (
+  chain_id                         INTEGER PRIMARY KEY REFERENCES chains(id) ON DELETE CASCADE,
+  erc721_factory                   BOOLEAN NOT NULL DEFAULT TRUE,
+  erc1155_factory                  BOOLEAN NOT NULL DEFAULT FALSE,
+  allowlist_mint                   BOOLEAN NOT NULL DEFAULT FALSE,
+  lazy_mint                        BOOLEAN NOT NULL DEFAULT FALSE,
+  version                          BIGINT NOT NULL DEFAULT 1,
+  updated_at                       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Every change to a chain's capabilities gets a new version
+CREATE OR REPLACE FUNCTION bump_chain_capabilities_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF ROW(NEW.erc721_factory, NEW.erc1155_factory, NEW.allowlist_mint, NEW.lazy_mint)
+     IS DISTINCT FROM ROW(OLD.erc721_factory, OLD.erc1155_factory, OLD.allowlist_mint, OLD.lazy_mint) THEN
+    NEW.version = OLD.version + 1;
+    NEW.updated_at = now();
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS bump_chain_capabilities_version ON chain_capabilities;
+CREATE TRIGGER bump_chain_capabilities_version
+BEFORE UPDATE ON chain_capabilities
+FOR EACH ROW EXECUTE FUNCTION bump_chain_capabilities_version();
+
 -- =========================================================
 -- ABI blob metadata (payload lưu S3/IPFS theo sha256)
 -- =========================================================
@@ -99,9 +137,19 @@ CREATE TABLE IF NOT EXISTS chain_contracts (
   UNIQUE (chain_id, address)
 );
 CREATE INDEX IF NOT EXISTS ix_chain_contracts_chain ON chain_contracts(chain_id);
+-- Collections deployed outside the factory and imported by their owner; the indexer follows them
+ALTER TABLE chain_contracts ADD COLUMN IF NOT EXISTS imported_at TIMESTAMPTZ;
 CREATE INDEX IF NOT EXISTS ix_chain_contracts_abi ON chain_contracts(abi_sha256);
 CREATE INDEX IF NOT EXISTS ix_chain_contracts_impl ON chain_contracts(impl_address);
 CREATE INDEX IF NOT EXISTS ix_chain_contracts_standard ON chain_contracts(standard);
+-- Roles tag what a contract is for (factory, marketplace, auction-house, ...); consumers look
+-- contracts up by role instead of by name
+ALTER TABLE chain_contracts ADD COLUMN IF NOT EXISTS roles TEXT[] NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS ix_chain_contracts_roles ON chain_contracts USING GIN (roles);
+-- Backfill the contracts consumers used to find by name
+UPDATE chain_contracts SET roles = ARRAY['erc721-factory', 'factory'] WHERE name = 'ERC721CollectionFactory' AND roles = '{}';
+UPDATE chain_contracts SET roles = ARRAY['erc1155-factory', 'factory'] WHERE name = 'ERC1155CollectionFactory' AND roles = '{}';
+UPDATE chain_contracts SET roles = ARRAY['auction-house'] WHERE name = 'AuctionHouse' AND roles = '{}';
 
 -- Lịch sử nâng cấp proxy (để reprocess/đối chiếu)
 CREATE TABLE IF NOT EXISTS contract_impl_history (
@@ -115,6 +163,27 @@ CREATE TABLE IF NOT EXISTS contract_impl_history (
 );
 CREATE INDEX IF NOT EXISTS ix_contract_impl_hist_cc ON contract_impl_history(chain_contract_id, block_number);
 
+-- Lịch sử thay ABI (diff cấu trúc events/functions, gắn với registry version)
+CREATE TABLE IF NOT EXISTS contract_abi_changes (
+  id                  BIGSERIAL PRIMARY KEY,
+  chain_contract_id   BIGINT NOT NULL REFERENCES chain_contracts(id) ON DELETE CASCADE,
+  old_abi_sha256      CHAR(64) REFERENCES abi_blobs(sha256),
+  new_abi_sha256      CHAR(64) NOT NULL REFERENCES abi_blobs(sha256),
+  diff_json           JSONB NOT NULL,               -- added/removed events & functions
+  reason              TEXT NOT NULL,
+  registry_version    TEXT NOT NULL,
+  at                  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS ix_contract_abi_changes_cc ON contract_abi_changes(chain_contract_id, at DESC);
+
+-- Mint function of collections that depart from the standard signature (ERC-721A, claims)
+CREATE TABLE IF NOT EXISTS contract_mint_functions (
+  chain_contract_id   BIGINT PRIMARY KEY REFERENCES chain_contracts(id) ON DELETE CASCADE,
+  descriptor_json     JSONB NOT NULL,               -- name, args layout, payable, price, max per tx
+  reason              TEXT NOT NULL,
+  updated_at          TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
 -- Diamond facets (tuỳ chọn, nếu dùng EIP-2535)
 CREATE TABLE IF NOT EXISTS diamond_facets (
   id                  BIGSERIAL PRIMARY KEY,
@@ -168,3 +237,16 @@ INSERT INTO chain_gas_policy (chain_id, max_fee_gwei, priority_fee_gwei, multipl
 SELECT id, 50.0, 2.0, 1.10, 20.0 FROM chains WHERE caip2 = 'eip155:11155111'
 ON CONFLICT (chain_id) DO NOTHING;
 
+-- Collection limits: short stages on the local chain for testing
+INSERT INTO chain_collection_limits (chain_id, max_royalty_bps, min_stage_duration_sec, max_supply_cap)
+SELECT id, 1000, 60, 1000000 FROM chains WHERE caip2 = 'eip155:31337'
+ON CONFLICT (chain_id) DO NOTHING;
+
+INSERT INTO chain_collection_limits (chain_id, max_royalty_bps, min_stage_duration_sec, max_supply_cap)
+SELECT id, 1000, 3600, 1000000 FROM chains WHERE caip2 = 'eip155:11155111'
+ON CONFLICT (chain_id) DO NOTHING;
+
+-- Chain capabilities: the full contract suite is deployed on Anvil and Sepolia
+INSERT INTO chain_capabilities (chain_id, erc721_factory, erc1155_factory, allowlist_mint, lazy_mint)
+SELECT id, TRUE, TRUE, TRUE, FALSE FROM chains WHERE caip2 IN ('eip155:31337', 'eip155:11155111')
+ON CONFLICT (chain_id) DO NOTHING;
@@ -5,16 +5,46 @@ import (
 	"strconv"
 
 	"github.com/quangdang46/NFT-Marketplace/shared/env"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	shpg "github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	shredis "github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 type GRPCConfig struct{ Port string }
 
+// ChainHeadConfig controls when a chain head the indexer stopped advancing is reported stale
+type ChainHeadConfig struct {
+	StaleAfterSeconds int
+}
+
+// HTTPConfig drives the public ABI endpoint
+type HTTPConfig struct {
+	// Port is where /abi/{sha256} is served; empty disables it
+	Port string
+	// RateLimitPerMinute and RateLimitBurst bound requests per client IP
+	RateLimitPerMinute int
+	RateLimitBurst     int
+	// TrustForwardedFor limits by X-Forwarded-For, for deployments behind a proxy
+	TrustForwardedFor bool
+}
+
+// RateLimitSection is the runtime config section that adjusts the ABI endpoint's limits
+const RateLimitSection = "rate_limit"
+
+// RateLimits is the rate_limit section
+type RateLimits struct {
+	PerMinute int `json:"per_minute"`
+	Burst     int `json:"burst"`
+}
+
 type Config struct {
-	GRPC     GRPCConfig
-	Postgres shpg.PostgresConfig
-	Redis    shredis.RedisConfig
+	GRPC      GRPCConfig
+	HTTP      HTTPConfig
+	Postgres  shpg.PostgresConfig
+	Redis     shredis.RedisConfig
+	RabbitMQ  messaging.RabbitMQConfig
+	ChainHead ChainHeadConfig
 }
 
 func Load() *Config {
@@ -24,16 +54,34 @@ func Load() *Config {
 
 	return &Config{
 		GRPC: GRPCConfig{Port: env.GetString("CHAIN_REGISTRY_GRPC_PORT", ":50056")},
+		HTTP: HTTPConfig{
+			Port:               env.GetString("CHAIN_REGISTRY_HTTP_PORT", ":8086"),
+			RateLimitPerMinute: env.GetInt("ABI_HTTP_RATE_LIMIT_PER_MINUTE", 120),
+			RateLimitBurst:     env.GetInt("ABI_HTTP_RATE_LIMIT_BURST", 30),
+			TrustForwardedFor:  env.GetBool("ABI_HTTP_TRUST_FORWARDED_FOR", false),
+		},
 		Postgres: shpg.PostgresConfig{
 			PostgresHost:     env.GetString("POSTGRES_HOST", "localhost"),
 			PostgresPort:     postgresPort,
 			PostgresUser:     env.GetString("POSTGRES_USER", "postgres"),
 			PostgresPassword: env.GetString("POSTGRES_PASSWORD", "postgres"),
 			PostgresDatabase: env.GetString("POSTGRES_DATABASE", "nft_marketplace"),
+			Tenant:           tenant.FromEnv(),
 		},
 		Redis: shredis.RedisConfig{
 			RedisHost: env.GetString("REDIS_HOST", "localhost"),
 			RedisPort: redisPort,
+			Tenant:    tenant.FromEnv(),
+		},
+		RabbitMQ: messaging.RabbitMQConfig{
+			RabbitMQHost:     env.GetString("RABBITMQ_HOST", "localhost"),
+			RabbitMQPort:     env.GetInt("RABBITMQ_PORT", 5672),
+			RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
+			RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
+			Tenant:           tenant.FromEnv(),
+		},
+		ChainHead: ChainHeadConfig{
+			StaleAfterSeconds: env.GetInt("CHAIN_HEAD_STALE_AFTER_SECONDS", 120),
 		},
 	}
 }
@@ -2,7 +2,10 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 )
 
 // ---------- Strong types ----------
@@ -39,8 +42,16 @@ type Contract struct {
 	Standard    ContractStandard `json:"standard,omitempty"`
 	ImplAddress *Address         `json:"implAddress,omitempty"` // nếu là proxy
 	AbiSHA256   *Sha256          `json:"abiSha256,omitempty"`   // content-addressed
+	Imported    bool             `json:"imported,omitempty"`    // collection imported by its owner
+	// MintFunction is set for collections minted through a non-standard function
+	MintFunction *MintFunction `json:"mintFunction,omitempty"`
+	// Roles tag what the contract is for, e.g. contracts.RoleFactory; sorted
+	Roles []string `json:"roles,omitempty"`
 }
 
+// MintFunction describes a collection's mint calldata: name, argument layout and payment
+type MintFunction = contracts.MintFunction
+
 type GasPolicy struct {
 	// Ghi chú: float64 OK cho Gwei (không phải tiền on-chain), nếu cần tuyệt đối: chuyển sang decimal lib.
 	MaxFeeGwei              float64   `json:"maxFeeGwei"`
@@ -63,6 +74,38 @@ type ChainParams struct {
 	RequiredConfirmations uint32 `json:"requiredConfirmations"`
 	ReorgDepth            uint32 `json:"reorgDepth"` // ví dụ 12
 	BlockTimeMs           uint32 `json:"blockTimeMs,omitempty"`
+	CollectionLimits
+}
+
+// CollectionLimits bounds collection creation parameters so a deployment cannot be bricked
+type CollectionLimits struct {
+	MaxRoyaltyBps       uint64 `json:"maxRoyaltyBps"`
+	MinStageDurationSec uint64 `json:"minStageDurationSec"`
+	MaxSupplyCap        uint64 `json:"maxSupplyCap"`
+}
+
+// DefaultCollectionLimits applies to chains without a chain_collection_limits row
+var DefaultCollectionLimits = CollectionLimits{
+	MaxRoyaltyBps:       10000,
+	MinStageDurationSec: 3600,
+	MaxSupplyCap:        1000000,
+}
+
+// ChainCapabilities lists the marketplace features deployed on a chain so clients can hide
+// the options it does not support
+type ChainCapabilities struct {
+	ERC721Factory  bool       `json:"erc721Factory"`
+	ERC1155Factory bool       `json:"erc1155Factory"`
+	AllowlistMint  bool       `json:"allowlistMint"`
+	LazyMint       bool       `json:"lazyMint"`
+	Version        uint64     `json:"version"`             // bumped on every change to the chain's row
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"` // nil for chains on the defaults
+}
+
+// DefaultChainCapabilities applies to chains without a chain_capabilities row
+var DefaultChainCapabilities = ChainCapabilities{
+	ERC721Factory: true,
+	Version:       1,
 }
 
 type ChainContracts struct {
@@ -86,28 +129,109 @@ type ChainRpcEndpoints struct {
 	RegistryVersion string        `json:"registryVersion"`
 }
 
+type ChainCapabilitiesInfo struct {
+	ChainID         ChainID           `json:"chainId"`
+	Capabilities    ChainCapabilities `json:"capabilities"`
+	RegistryVersion string            `json:"registryVersion"`
+}
+
+// ErrAbiNotFound is returned for a hash no registered ABI has
+var ErrAbiNotFound = errors.New("ABI blob not found")
+
+// GetContractByRole errors
+var (
+	ErrRoleNotAssigned = errors.New("no contract holds the role")
+	ErrRoleAmbiguous   = errors.New("several contracts hold the role")
+)
+
+// ChainHead is a chain's latest and finalized blocks as the indexer last reported them
+type ChainHead = contracts.ChainHead
+
+// ErrChainHeadNotFound is returned for a chain the indexer has not reported a head for
+var ErrChainHeadNotFound = errors.New("chain head not found")
+
+// ChainHeadStatus is a chain's stored head and whether it has stopped advancing
+type ChainHeadStatus struct {
+	ChainHead
+	Stale bool `json:"stale"`
+}
+
 type ContractMeta struct {
 	ChainID         ChainID  `json:"chainId"`
 	Contract        Contract `json:"contract"`
 	RegistryVersion string   `json:"registryVersion"`
 }
 
+// AbiDiff lists the event/function signatures added or removed by an ABI replacement
+type AbiDiff = contracts.AbiDiff
+
+// AbiChange records one ABI replacement for a contract, stored with the version bump it caused
+type AbiChange struct {
+	ChainID         ChainID   `json:"chainId"`
+	Address         Address   `json:"address"`
+	Name            string    `json:"name"`
+	OldAbiSHA256    Sha256    `json:"oldAbiSha256,omitempty"` // empty when no ABI was registered
+	NewAbiSHA256    Sha256    `json:"newAbiSha256"`
+	Diff            AbiDiff   `json:"diff"`
+	Reason          string    `json:"reason"`
+	RegistryVersion string    `json:"registryVersion"`
+	ChangedAt       time.Time `json:"changedAt"`
+}
+
+// RegistryChange announces that a chain's registry version moved
+type RegistryChange struct {
+	ChainID         ChainID   `json:"chainId"`
+	RegistryVersion string    `json:"registryVersion"`
+	Reason          string    `json:"reason"`
+	ChangedAt       time.Time `json:"changedAt"`
+}
+
 // ---------- Ports ----------
 type ChainRegistryRepository interface {
 	GetContracts(ctx context.Context, chainID ChainID) (*ChainContracts, error)
 	GetGasPolicy(ctx context.Context, chainID ChainID) (*ChainGasPolicy, error)
 	GetRpcEndpoints(ctx context.Context, chainID ChainID) (*ChainRpcEndpoints, error)
+	GetChainCapabilities(ctx context.Context, chainID ChainID) (*ChainCapabilitiesInfo, error)
 	BumpVersion(ctx context.Context, chainID ChainID, reason string) (newVersion string, err error)
 
 	GetContractMeta(ctx context.Context, chainID ChainID, address Address) (*ContractMeta, error)
 	GetAbiBlob(ctx context.Context, sha Sha256) (abiJSON []byte, etag string, err error)
 	ResolveProxy(ctx context.Context, chainID ChainID, address Address) (implAddress Address, abiSha256 Sha256, err error)
+
+	// ReplaceAbi stores the new ABI blob, points the contract at it, records the change and
+	// bumps the chain's registry version; change.RegistryVersion is set to the new version
+	ReplaceAbi(ctx context.Context, change *AbiChange, abiJSON []byte) error
+
+	// RegisterCollection inserts an imported collection and bumps the chain's registry
+	// version. An address already registered is left as is and reported with created false.
+	RegisterCollection(ctx context.Context, chainID ChainID, contract Contract) (registered *Contract, created bool, newVersion string, err error)
+
+	// SetMintFunction stores or, for a nil fn, clears a contract's mint function and bumps
+	// the chain's registry version
+	SetMintFunction(ctx context.Context, chainID ChainID, address Address, fn *MintFunction, reason string) (newVersion string, err error)
+
+	// SetContractRoles replaces a contract's roles and bumps the chain's registry version
+	SetContractRoles(ctx context.Context, chainID ChainID, address Address, roles []string) (newVersion string, err error)
+}
+
+// ChainHeadStore keeps the last head reported for every chain. ReadChainHead fails with
+// ErrChainHeadNotFound for a chain without one.
+type ChainHeadStore interface {
+	WriteChainHead(ctx context.Context, head ChainHead) error
+	ReadChainHead(ctx context.Context, chainID ChainID) (ChainHead, error)
+}
+
+// EventPublisher publishes registry events for the indexer and orchestrator
+type EventPublisher interface {
+	PublishAbiChanged(ctx context.Context, change *AbiChange) error
+	PublishRegistryChanged(ctx context.Context, change *RegistryChange) error
 }
 
 type ChainRegistryService interface {
 	GetContracts(ctx context.Context, chainID ChainID) (*ChainContracts, error)
 	GetGasPolicy(ctx context.Context, chainID ChainID) (*ChainGasPolicy, error)
 	GetRpcEndpoints(ctx context.Context, chainID ChainID) (*ChainRpcEndpoints, error)
+	GetChainCapabilities(ctx context.Context, chainID ChainID) (*ChainCapabilitiesInfo, error)
 	BumpVersion(ctx context.Context, chainID ChainID, reason string) (ok bool, newVersion string, err error)
 
 	GetContractMeta(ctx context.Context, chainID ChainID, address Address) (*ContractMeta, error)
@@ -116,4 +240,30 @@ type ChainRegistryService interface {
 
 	// Friendly API: fetch ABI directly by chain + address
 	GetAbiByAddress(ctx context.Context, chainID ChainID, address Address) (abiJSON []byte, etag string, err error)
+
+	// UpdateContractAbi replaces a contract's ABI and publishes the structural diff;
+	// changed is false when the new ABI hashes to the registered one
+	UpdateContractAbi(ctx context.Context, chainID ChainID, address Address, abiJSON []byte, reason string) (change *AbiChange, changed bool, err error)
+
+	// RegisterCollection adds an already-deployed ERC-721/1155 collection to the contracts
+	// the indexer follows and announces the new registry version
+	RegisterCollection(ctx context.Context, chainID ChainID, contract Contract, reason string) (registered *Contract, created bool, version string, err error)
+
+	// SetMintFunction records how a collection is minted, or restores the standard mint
+	// signature for a nil fn, and announces the new registry version
+	SetMintFunction(ctx context.Context, chainID ChainID, address Address, fn *MintFunction, reason string) (version string, err error)
+
+	// GetContractByRole returns the one contract on the chain holding role. It fails with
+	// ErrRoleNotAssigned when none does and ErrRoleAmbiguous when several do.
+	GetContractByRole(ctx context.Context, chainID ChainID, role string) (*ContractMeta, error)
+
+	// SetContractRoles replaces a contract's roles and announces the new registry version
+	SetContractRoles(ctx context.Context, chainID ChainID, address Address, roles []string, reason string) (version string, err error)
+
+	// GetChainHead returns the chain's last reported head. It fails with
+	// ErrChainHeadNotFound before the indexer has reported one.
+	GetChainHead(ctx context.Context, chainID ChainID) (*ChainHeadStatus, error)
+
+	// HandleChainHeadAdvanced stores a head the indexer reported unless a later one is stored
+	HandleChainHeadAdvanced(ctx context.Context, head ChainHead) error
 }
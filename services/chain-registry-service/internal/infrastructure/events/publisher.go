@@ -0,0 +1,109 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+)
+
+const (
+	abiChangedPrefix      = "registry.abi_changed"
+	registryChangedPrefix = "registry.changed"
+)
+
+type EventPublisher struct {
+	amqp *messaging.RabbitMQ
+}
+
+// NewEventPublisher creates a new RabbitMQ registry event publisher
+func NewEventPublisher(amqp *messaging.RabbitMQ) (*EventPublisher, error) {
+	err := amqp.DeclareExchange(messaging.ExchangeConfig{
+		Name:    contracts.RegistryExchange,
+		Type:    "topic",
+		Durable: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to declare %s exchange: %w", contracts.RegistryExchange, err)
+	}
+	return &EventPublisher{amqp: amqp}, nil
+}
+
+// PublishAbiChanged publishes the diff on registry.abi_changed.<chain>, e.g. registry.abi_changed.eip155-1
+func (p *EventPublisher) PublishAbiChanged(ctx context.Context, change *domain.AbiChange) error {
+	event := contracts.AbiChangedEvent{
+		EventID:         fmt.Sprintf("abi_changed_%s_%s_%s", change.ChainID, change.Address, change.NewAbiSHA256),
+		ChainID:         change.ChainID,
+		Address:         change.Address,
+		Name:            change.Name,
+		OldAbiSHA256:    change.OldAbiSHA256,
+		NewAbiSHA256:    change.NewAbiSHA256,
+		RegistryVersion: change.RegistryVersion,
+		Reason:          change.Reason,
+		Diff:            change.Diff,
+		ChangedAt:       change.ChangedAt,
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal abi_changed event: %w", err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   contracts.RegistryExchange,
+		RoutingKey: fmt.Sprintf("%s.%s", abiChangedPrefix, strings.ReplaceAll(change.ChainID, ":", "-")),
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   "registry.abi_changed",
+			"chain_id":     change.ChainID,
+			"published_at": change.ChangedAt.Unix(),
+			"content_type": "application/json",
+		},
+		Timestamp: change.ChangedAt,
+		MessageID: event.EventID,
+	}
+
+	if err := p.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("failed to publish abi_changed event: %w", err)
+	}
+	return nil
+}
+
+// PublishRegistryChanged publishes the new version on registry.changed.<chain>, e.g. registry.changed.eip155-1
+func (p *EventPublisher) PublishRegistryChanged(ctx context.Context, change *domain.RegistryChange) error {
+	event := contracts.RegistryChangedEvent{
+		EventID:         fmt.Sprintf("registry_changed_%s_%s", change.ChainID, change.RegistryVersion),
+		ChainID:         change.ChainID,
+		RegistryVersion: change.RegistryVersion,
+		Reason:          change.Reason,
+		ChangedAt:       change.ChangedAt,
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal registry.changed event: %w", err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   contracts.RegistryExchange,
+		RoutingKey: fmt.Sprintf("%s.%s", registryChangedPrefix, strings.ReplaceAll(change.ChainID, ":", "-")),
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   "registry.changed",
+			"chain_id":     change.ChainID,
+			"published_at": change.ChangedAt.Unix(),
+			"content_type": "application/json",
+		},
+		Timestamp: change.ChangedAt,
+		MessageID: event.EventID,
+	}
+
+	if err := p.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("failed to publish registry.changed event: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package grpc_handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/utils"
@@ -109,6 +110,9 @@ func (h *GRPCHandler) GetAbiBlob(ctx context.Context, req *chainpb.GetAbiBlobReq
 	}
 
 	abiJSON, etag, err := h.svc.GetAbiBlob(ctx, domain.Sha256(req.AbiSha256))
+	if errors.Is(err, domain.ErrAbiNotFound) {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get ABI blob: %v", err)
 	}
@@ -157,6 +161,23 @@ func (h *GRPCHandler) ResolveProxy(ctx context.Context, req *chainpb.ResolveProx
 	}, nil
 }
 
+func (h *GRPCHandler) GetChainCapabilities(ctx context.Context, req *chainpb.GetChainCapabilitiesRequest) (*chainpb.GetChainCapabilitiesResponse, error) {
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chain_id is required")
+	}
+
+	capabilities, err := h.svc.GetChainCapabilities(ctx, domain.ChainID(req.ChainId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get chain capabilities: %v", err)
+	}
+
+	return &chainpb.GetChainCapabilitiesResponse{
+		ChainId:         string(capabilities.ChainID),
+		Capabilities:    utils.DomainToProtoChainCapabilities(capabilities.Capabilities),
+		RegistryVersion: capabilities.RegistryVersion,
+	}, nil
+}
+
 func (h *GRPCHandler) BumpVersion(ctx context.Context, req *chainpb.BumpVersionRequest) (*chainpb.BumpVersionResponse, error) {
 	if req.ChainId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "chain_id is required")
@@ -175,3 +196,158 @@ func (h *GRPCHandler) BumpVersion(ctx context.Context, req *chainpb.BumpVersionR
 		NewVersion: newVersion,
 	}, nil
 }
+
+func (h *GRPCHandler) UpdateContractAbi(ctx context.Context, req *chainpb.UpdateContractAbiRequest) (*chainpb.UpdateContractAbiResponse, error) {
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chain_id is required")
+	}
+	if req.Address == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "address is required")
+	}
+	if req.AbiJson == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "abi_json is required")
+	}
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	change, changed, err := h.svc.UpdateContractAbi(ctx, domain.ChainID(req.ChainId), domain.Address(req.Address), []byte(req.AbiJson), req.Reason)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update contract ABI: %v", err)
+	}
+
+	return &chainpb.UpdateContractAbiResponse{
+		Changed:      changed,
+		OldAbiSha256: string(change.OldAbiSHA256),
+		NewAbiSha256: string(change.NewAbiSHA256),
+		NewVersion:   change.RegistryVersion,
+		Diff:         utils.DomainToProtoAbiDiff(change.Diff),
+	}, nil
+}
+
+func (h *GRPCHandler) RegisterCollection(ctx context.Context, req *chainpb.RegisterCollectionRequest) (*chainpb.RegisterCollectionResponse, error) {
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chain_id is required")
+	}
+	if req.Address == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "address is required")
+	}
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+	if req.StartBlock < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "start_block must not be negative")
+	}
+
+	standard, ok := collectionStandards[req.Standard]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "standard must be STD_ERC721 or STD_ERC1155")
+	}
+
+	contract, created, version, err := h.svc.RegisterCollection(ctx, domain.ChainID(req.ChainId), domain.Contract{
+		Address:    domain.Address(req.Address),
+		Standard:   standard,
+		StartBlock: uint64(req.StartBlock),
+	}, req.Reason)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to register collection: %v", err)
+	}
+
+	return &chainpb.RegisterCollectionResponse{
+		Contract:        utils.DomainToProtoContract(*contract),
+		Created:         created,
+		RegistryVersion: version,
+	}, nil
+}
+
+func (h *GRPCHandler) SetMintFunction(ctx context.Context, req *chainpb.SetMintFunctionRequest) (*chainpb.SetMintFunctionResponse, error) {
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chain_id is required")
+	}
+	if req.Address == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "address is required")
+	}
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	fn := utils.ProtoToDomainMintFunction(req.MintFunction)
+	if fn != nil {
+		if err := fn.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid mint_function: %v", err)
+		}
+	}
+
+	version, err := h.svc.SetMintFunction(ctx, domain.ChainID(req.ChainId), domain.Address(req.Address), fn, req.Reason)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set mint function: %v", err)
+	}
+
+	return &chainpb.SetMintFunctionResponse{RegistryVersion: version}, nil
+}
+
+func (h *GRPCHandler) GetContractByRole(ctx context.Context, req *chainpb.GetContractByRoleRequest) (*chainpb.GetContractMetaResponse, error) {
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chain_id is required")
+	}
+	if req.Role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "role is required")
+	}
+
+	contractMeta, err := h.svc.GetContractByRole(ctx, domain.ChainID(req.ChainId), req.Role)
+	switch {
+	case errors.Is(err, domain.ErrRoleNotAssigned):
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrRoleAmbiguous):
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to get contract by role: %v", err)
+	}
+
+	return &chainpb.GetContractMetaResponse{
+		ChainId:         string(contractMeta.ChainID),
+		Contract:        utils.DomainToProtoContract(contractMeta.Contract),
+		RegistryVersion: contractMeta.RegistryVersion,
+	}, nil
+}
+
+func (h *GRPCHandler) SetContractRoles(ctx context.Context, req *chainpb.SetContractRolesRequest) (*chainpb.SetContractRolesResponse, error) {
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chain_id is required")
+	}
+	if req.Address == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "address is required")
+	}
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is required")
+	}
+
+	version, err := h.svc.SetContractRoles(ctx, domain.ChainID(req.ChainId), domain.Address(req.Address), req.Roles, req.Reason)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set contract roles: %v", err)
+	}
+
+	return &chainpb.SetContractRolesResponse{RegistryVersion: version}, nil
+}
+
+// collectionStandards are the standards RegisterCollection accepts
+var collectionStandards = map[chainpb.ContractStandard]domain.ContractStandard{
+	chainpb.ContractStandard_STD_ERC721:  domain.StdERC721,
+	chainpb.ContractStandard_STD_ERC1155: domain.StdERC1155,
+}
+
+func (h *GRPCHandler) GetChainHead(ctx context.Context, req *chainpb.GetChainHeadRequest) (*chainpb.GetChainHeadResponse, error) {
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "chain_id is required")
+	}
+
+	head, err := h.svc.GetChainHead(ctx, domain.ChainID(req.ChainId))
+	switch {
+	case errors.Is(err, domain.ErrChainHeadNotFound):
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to get chain head: %v", err)
+	}
+
+	return &chainpb.GetChainHeadResponse{Head: utils.DomainToProtoChainHead(head)}, nil
+}
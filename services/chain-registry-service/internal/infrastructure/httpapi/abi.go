@@ -0,0 +1,167 @@
+// Package httpapi serves registry ABIs over plain HTTP at /abi/{sha256}, so frontends and
+// third-party tools can fetch them without a gRPC client. ABIs are content-addressed, so a
+// response never changes and is cached for a year by browsers and CDNs.
+package httpapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+)
+
+// AbiRoute is the path prefix the ABI handler is mounted on
+const AbiRoute = "/abi/"
+
+const (
+	// immutableCache lets clients keep an ABI for a year; its hash always names the same bytes
+	immutableCache = "public, max-age=31536000, immutable"
+
+	// missCache keeps unknown hashes from hammering the registry without pinning the miss,
+	// since the ABI may be registered later
+	missCache = "public, max-age=60"
+
+	// minGzipBytes skips compressing bodies too small to benefit
+	minGzipBytes = 1024
+)
+
+// AbiReader reads ABI blobs by hash; domain.ChainRegistryService implements it
+type AbiReader interface {
+	GetAbiBlob(ctx context.Context, sha domain.Sha256) (abiJSON []byte, etag string, err error)
+}
+
+// AbiHandler serves ABI JSON by its sha256
+type AbiHandler struct {
+	abis    AbiReader
+	limiter *RateLimiter
+}
+
+// NewAbiHandler creates an ABI handler; a nil limiter leaves it unlimited
+func NewAbiHandler(abis AbiReader, limiter *RateLimiter) *AbiHandler {
+	if limiter == nil {
+		limiter = NewRateLimiter(0, 0, false)
+	}
+	return &AbiHandler{abis: abis, limiter: limiter}
+}
+
+// ParseAbiHash reads the hash from the path: 64 hex digits, case-insensitive
+func ParseAbiHash(path string) (domain.Sha256, bool) {
+	sha := strings.ToLower(strings.TrimPrefix(path, AbiRoute))
+	if len(sha) != 64 {
+		return "", false
+	}
+	for _, c := range sha {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return "", false
+		}
+	}
+	return domain.Sha256(sha), true
+}
+
+// ETag is the strong validator of an ABI: its hash
+func ETag(sha domain.Sha256) string {
+	return `"` + string(sha) + `"`
+}
+
+func (h *AbiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if ok, retryAfter := h.limiter.Allow(h.limiter.ClientIP(r)); !ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+		return
+	}
+
+	sha, ok := ParseAbiHash(r.URL.Path)
+	if !ok {
+		http.Error(w, "abi hash must be 64 hex digits", http.StatusBadRequest)
+		return
+	}
+
+	// The hash is the content, so a client holding it needs nothing from the registry
+	etag := ETag(sha)
+	w.Header().Set("Vary", "Accept-Encoding")
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", immutableCache)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	body, _, err := h.abis.GetAbiBlob(ctx, sha)
+	if err != nil {
+		if errors.Is(err, domain.ErrAbiNotFound) {
+			w.Header().Set("Cache-Control", missCache)
+			http.Error(w, "abi not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("abi http: failed to get ABI %s: %v", sha, err)
+		w.Header().Set("Cache-Control", "no-store")
+		http.Error(w, "failed to load abi", http.StatusBadGateway)
+		return
+	}
+
+	// A gzipped body differs byte for byte, so its validator is weak
+	if len(body) >= minGzipBytes && acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		zw.Write(body)
+		zw.Close()
+		body = buf.Bytes()
+		etag = "W/" + etag
+		w.Header().Set("Content-Encoding", "gzip")
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", immutableCache)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		w.Write(body)
+	}
+}
+
+// matchesETag applies the weak comparison If-None-Match calls for
+func matchesETag(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == want {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip reports whether Accept-Encoding allows gzip with a non-zero q
+func acceptsGzip(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") && strings.TrimSpace(coding) != "*" {
+			continue
+		}
+		q := strings.ReplaceAll(strings.TrimSpace(params), " ", "")
+		return q != "q=0" && q != "q=0.0" && q != "q=0.00" && q != "q=0.000"
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package httpapi
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket per client IP: each client may burst up to Burst requests
+// and then gets PerMinute spread evenly over the minute
+type RateLimiter struct {
+	// trustForwarded reads the client from X-Forwarded-For, for deployments behind a proxy
+	trustForwarded bool
+	now            func() time.Time
+
+	mu        sync.Mutex
+	perMinute float64
+	burst     float64
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	seen   time.Time
+}
+
+// NewRateLimiter creates a limiter; perMinute <= 0 disables limiting
+func NewRateLimiter(perMinute, burst int, trustForwarded bool) *RateLimiter {
+	l := &RateLimiter{
+		trustForwarded: trustForwarded,
+		now:            time.Now,
+		buckets:        make(map[string]*bucket),
+	}
+	l.SetLimits(perMinute, burst)
+	return l
+}
+
+// SetLimits changes the limits while serving; buckets above the new burst are trimmed to it
+func (l *RateLimiter) SetLimits(perMinute, burst int) {
+	if burst < 1 {
+		burst = 1
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.perMinute = float64(perMinute)
+	l.burst = float64(burst)
+	for _, b := range l.buckets {
+		b.tokens = min(l.burst, b.tokens)
+	}
+}
+
+// WithClock replaces the limiter's clock
+func (l *RateLimiter) WithClock(now func() time.Time) *RateLimiter {
+	l.now = now
+	return l
+}
+
+// Allow takes a token from key's bucket and reports whether one was left, with how long
+// until the next one when not
+func (l *RateLimiter) Allow(key string) (bool, time.Duration) {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.perMinute <= 0 {
+		return true, 0
+	}
+	rate := l.perMinute / 60 // tokens per second
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, seen: now}
+		l.buckets[key] = b
+	}
+	b.tokens = min(l.burst, b.tokens+now.Sub(b.seen).Seconds()*rate)
+	b.seen = now
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// sweep drops buckets idle long enough to have refilled, once a minute
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+	full := time.Duration(l.burst / (l.perMinute / 60) * float64(time.Second))
+	for key, b := range l.buckets {
+		if now.Sub(b.seen) > full {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// ClientIP is the key a request is limited under
+func (l *RateLimiter) ClientIP(r *http.Request) string {
+	if l.trustForwarded {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			first, _, _ := strings.Cut(forwarded, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+// ChainHeadStore keeps reported chain heads in the shared Redis chain:head hash
+type ChainHeadStore struct {
+	redis *redis.Redis
+}
+
+func NewChainHeadStore(redis *redis.Redis) domain.ChainHeadStore {
+	return &ChainHeadStore{redis: redis}
+}
+
+func (s *ChainHeadStore) WriteChainHead(ctx context.Context, head domain.ChainHead) error {
+	return s.redis.WriteChainHead(ctx, head)
+}
+
+func (s *ChainHeadStore) ReadChainHead(ctx context.Context, chainID domain.ChainID) (domain.ChainHead, error) {
+	head, err := s.redis.ReadChainHead(ctx, chainID)
+	if errors.Is(err, redis.ErrChainHeadNotFound) {
+		return head, domain.ErrChainHeadNotFound
+	}
+	return head, err
+}
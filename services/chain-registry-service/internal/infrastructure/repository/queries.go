@@ -11,16 +11,18 @@ const (
 
 	// Contract queries
 	QueryGetContracts = `
-		SELECT name, address, start_block, verified_at, standard, impl_address, abi_sha256 
+		SELECT name, address, start_block, verified_at, standard, impl_address, abi_sha256, imported_at IS NOT NULL, roles
 		FROM chain_contracts 
 		WHERE chain_id = (SELECT id FROM chains WHERE caip2 = $1)
 		ORDER BY name, address
 	`
 
 	QueryGetContractMeta = `
-		SELECT name, address, start_block, verified_at, standard, impl_address, abi_sha256
-		FROM chain_contracts 
-		WHERE chain_id = (SELECT id FROM chains WHERE caip2 = $1) AND address = $2
+		SELECT c.name, c.address, c.start_block, c.verified_at, c.standard, c.impl_address, c.abi_sha256, c.imported_at IS NOT NULL,
+		       c.roles, m.descriptor_json::text
+		FROM chain_contracts c
+		LEFT JOIN contract_mint_functions m ON m.chain_contract_id = c.id
+		WHERE c.chain_id = (SELECT id FROM chains WHERE caip2 = $1) AND c.address = $2
 	`
 
 	QueryGetProxyContract = `
@@ -36,6 +38,21 @@ const (
 		WHERE chain_id = (SELECT id FROM chains WHERE caip2 = $1)
 	`
 
+	// Collection limit queries
+	QueryGetCollectionLimits = `
+		SELECT max_royalty_bps, min_stage_duration_sec, max_supply_cap
+		FROM chain_collection_limits
+		WHERE chain_id = (SELECT id FROM chains WHERE caip2 = $1)
+	`
+
+	// Chain capability queries; capability columns are NULL for chains without a row
+	QueryGetChainCapabilities = `
+		SELECT cap.erc721_factory, cap.erc1155_factory, cap.allowlist_mint, cap.lazy_mint, cap.version, cap.updated_at
+		FROM chains c
+		LEFT JOIN chain_capabilities cap ON cap.chain_id = c.id
+		WHERE c.caip2 = $1 AND c.enabled = true
+	`
+
 	// RPC endpoint queries
 	QueryGetRpcEndpoints = `
 		SELECT url, priority, weight, auth_type, rate_limit, active
@@ -56,4 +73,53 @@ const (
 		FROM abi_blobs 
 		WHERE sha256 = $1
 	`
+
+	// ABI replacement queries
+	QueryInsertAbiBlob = `
+		INSERT INTO abi_blobs (sha256, size_bytes, source, contract_name, abi_json, s3_key)
+		VALUES ($1, $2, 'internal', $3, $4::jsonb, $5)
+		ON CONFLICT (sha256) DO NOTHING
+	`
+
+	QueryUpdateContractAbi = `
+		UPDATE chain_contracts SET abi_sha256 = $3
+		WHERE chain_id = (SELECT id FROM chains WHERE caip2 = $1) AND address = $2
+		RETURNING id
+	`
+
+	QueryInsertAbiChange = `
+		INSERT INTO contract_abi_changes (chain_contract_id, old_abi_sha256, new_abi_sha256, diff_json, reason, registry_version, at)
+		VALUES ($1, NULLIF($2, ''), $3, $4::jsonb, $5, $6, $7)
+	`
+
+	// Imported collection queries
+	QueryInsertImportedCollection = `
+		INSERT INTO chain_contracts (chain_id, name, address, start_block, standard, verified_at, imported_at)
+		SELECT id, $2, $3, $4, $5, now(), now() FROM chains WHERE caip2 = $1
+		ON CONFLICT (chain_id, address) DO NOTHING
+		RETURNING id
+	`
+
+	// Mint function queries
+	QueryUpsertMintFunction = `
+		INSERT INTO contract_mint_functions (chain_contract_id, descriptor_json, reason, updated_at)
+		SELECT id, $3::jsonb, $4, now() FROM chain_contracts
+		WHERE chain_id = (SELECT id FROM chains WHERE caip2 = $1) AND address = $2
+		ON CONFLICT (chain_contract_id) DO UPDATE
+		SET descriptor_json = EXCLUDED.descriptor_json, reason = EXCLUDED.reason, updated_at = now()
+	`
+
+	QueryDeleteMintFunction = `
+		DELETE FROM contract_mint_functions
+		WHERE chain_contract_id = (
+			SELECT id FROM chain_contracts
+			WHERE chain_id = (SELECT id FROM chains WHERE caip2 = $1) AND address = $2
+		)
+	`
+
+	// Contract role queries
+	QuerySetContractRoles = `
+		UPDATE chain_contracts SET roles = $3
+		WHERE chain_id = (SELECT id FROM chains WHERE caip2 = $1) AND address = $2
+	`
 )
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/utils"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
@@ -23,16 +24,7 @@ func NewRepository(db *postgres.Postgres, redis *redis.Redis) domain.ChainRegist
 }
 
 func (r *Repository) GetContracts(ctx context.Context, chainID domain.ChainID) (*domain.ChainContracts, error) {
-	getOrInitVersion := func() string {
-		versionKey := fmt.Sprintf("cache:chains:%s:version", chainID)
-		if v, err := r.redis.Get(ctx, versionKey); err == nil && v != "" {
-			return v
-		}
-		v := "1.0.0"
-		_ = r.redis.SetWithExpiration(ctx, versionKey, v, 60*time.Second)
-		return v
-	}
-	version := getOrInitVersion()
+	version := r.currentVersion(ctx, chainID)
 	cacheKey := fmt.Sprintf("cache:chains:%s:%s", chainID, version)
 	if cached, err := r.redis.Get(ctx, cacheKey); err == nil && cached != "" {
 		var result domain.ChainContracts
@@ -82,6 +74,8 @@ func (r *Repository) GetContracts(ctx context.Context, chainID domain.ChainID) (
 			&standard,
 			&implAddress,
 			&abiSha256,
+			&contract.Imported,
+			pq.Array(&contract.Roles),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan contract: %w", err)
@@ -108,11 +102,17 @@ func (r *Repository) GetContracts(ctx context.Context, chainID domain.ChainID) (
 		return nil, fmt.Errorf("error iterating contracts: %w", err)
 	}
 
+	limits, err := r.getCollectionLimits(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get chain params from configuration or database
 	params := domain.ChainParams{
 		RequiredConfirmations: 12,
 		ReorgDepth:            12,
 		BlockTimeMs:           12000, // 12 seconds for most chains
+		CollectionLimits:      *limits,
 	}
 
 	result := &domain.ChainContracts{
@@ -132,6 +132,35 @@ func (r *Repository) GetContracts(ctx context.Context, chainID domain.ChainID) (
 	return result, nil
 }
 
+// getCollectionLimits reads the chain's collection limits, falling back to the defaults
+func (r *Repository) getCollectionLimits(ctx context.Context, chainID domain.ChainID) (*domain.CollectionLimits, error) {
+	limits := domain.DefaultCollectionLimits
+	var maxRoyaltyBps, minStageDurationSec, maxSupplyCap sql.NullInt64
+	err := r.db.GetClient().QueryRowContext(ctx, QueryGetCollectionLimits, chainID).Scan(
+		&maxRoyaltyBps,
+		&minStageDurationSec,
+		&maxSupplyCap,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &limits, nil
+		}
+		return nil, fmt.Errorf("failed to get collection limits: %w", err)
+	}
+
+	// NULL columns keep the default
+	if maxRoyaltyBps.Valid {
+		limits.MaxRoyaltyBps = uint64(maxRoyaltyBps.Int64)
+	}
+	if minStageDurationSec.Valid {
+		limits.MinStageDurationSec = uint64(minStageDurationSec.Int64)
+	}
+	if maxSupplyCap.Valid {
+		limits.MaxSupplyCap = uint64(maxSupplyCap.Int64)
+	}
+	return &limits, nil
+}
+
 func (r *Repository) GetGasPolicy(ctx context.Context, chainID domain.ChainID) (*domain.ChainGasPolicy, error) {
 	// Try cache first
 	cacheKey := fmt.Sprintf("chain_gas_policy:%s", chainID)
@@ -247,9 +276,89 @@ func (r *Repository) GetRpcEndpoints(ctx context.Context, chainID domain.ChainID
 	return result, nil
 }
 
+// GetChainCapabilities reads the chain's feature matrix, falling back to the defaults for
+// enabled chains without a chain_capabilities row
+func (r *Repository) GetChainCapabilities(ctx context.Context, chainID domain.ChainID) (*domain.ChainCapabilitiesInfo, error) {
+	// Try cache first
+	cacheKey := fmt.Sprintf("chain_capabilities:%s", chainID)
+	if cached, err := r.redis.Get(ctx, cacheKey); err == nil && cached != "" {
+		var result domain.ChainCapabilitiesInfo
+		if err := json.Unmarshal([]byte(cached), &result); err == nil {
+			return &result, nil
+		}
+		// If unmarshaling fails, continue to database
+	}
+
+	var erc721Factory, erc1155Factory, allowlistMint, lazyMint sql.NullBool
+	var version sql.NullInt64
+	var updatedAt sql.NullTime
+	err := r.db.GetClient().QueryRowContext(ctx, QueryGetChainCapabilities, chainID).Scan(
+		&erc721Factory,
+		&erc1155Factory,
+		&allowlistMint,
+		&lazyMint,
+		&version,
+		&updatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("chain not found: %s", chainID)
+		}
+		return nil, fmt.Errorf("failed to get chain capabilities: %w", err)
+	}
+
+	capabilities := domain.DefaultChainCapabilities
+	if version.Valid {
+		capabilities = domain.ChainCapabilities{
+			ERC721Factory:  erc721Factory.Bool,
+			ERC1155Factory: erc1155Factory.Bool,
+			AllowlistMint:  allowlistMint.Bool,
+			LazyMint:       lazyMint.Bool,
+			Version:        uint64(version.Int64),
+		}
+		if updatedAt.Valid {
+			capabilities.UpdatedAt = &updatedAt.Time
+		}
+	}
+
+	result := &domain.ChainCapabilitiesInfo{
+		ChainID:         chainID,
+		Capabilities:    capabilities,
+		RegistryVersion: r.currentVersion(ctx, chainID),
+	}
+
+	// Cache the result
+	if cachedData, err := json.Marshal(result); err == nil {
+		_ = r.redis.SetWithExpiration(ctx, cacheKey, string(cachedData), 15*time.Minute)
+	}
+
+	return result, nil
+}
+
 func (r *Repository) BumpVersion(ctx context.Context, chainID domain.ChainID, reason string) (newVersion string, err error) {
-	// Generate a timestamp-based version
-	newVersion = fmt.Sprintf("1.0.%d", time.Now().Unix())
+	newVersion = nextRegistryVersion()
+	r.publishVersion(ctx, chainID, newVersion)
+	return newVersion, nil
+}
+
+// nextRegistryVersion generates a timestamp-based version
+func nextRegistryVersion() string {
+	return fmt.Sprintf("1.0.%d", time.Now().Unix())
+}
+
+// currentVersion returns the chain's registry version, initialising it when unset
+func (r *Repository) currentVersion(ctx context.Context, chainID domain.ChainID) string {
+	versionKey := fmt.Sprintf("cache:chains:%s:version", chainID)
+	if v, err := r.redis.Get(ctx, versionKey); err == nil && v != "" {
+		return v
+	}
+	v := "1.0.0"
+	_ = r.redis.SetWithExpiration(ctx, versionKey, v, 60*time.Second)
+	return v
+}
+
+// publishVersion makes newVersion the chain's current registry version
+func (r *Repository) publishVersion(ctx context.Context, chainID domain.ChainID, newVersion string) {
 	// Persist new version key (short TTL per doc)
 	versionKey := fmt.Sprintf("cache:chains:%s:version", chainID)
 	_ = r.redis.SetWithExpiration(ctx, versionKey, newVersion, 60*time.Second)
@@ -258,9 +367,9 @@ func (r *Repository) BumpVersion(ctx context.Context, chainID domain.ChainID, re
 		fmt.Sprintf("chain_contracts:%s", chainID),
 		fmt.Sprintf("chain_gas_policy:%s", chainID),
 		fmt.Sprintf("chain_rpc_endpoints:%s", chainID),
+		fmt.Sprintf("chain_capabilities:%s", chainID),
 	}
 	r.redis.Delete(ctx, legacyKeys...)
-	return newVersion, nil
 }
 
 func (r *Repository) GetContractMeta(ctx context.Context, chainID domain.ChainID, address domain.Address) (*domain.ContractMeta, error) {
@@ -281,6 +390,7 @@ func (r *Repository) GetContractMeta(ctx context.Context, chainID domain.ChainID
 	var standard sql.NullString
 	var implAddress sql.NullString
 	var abiSha256 sql.NullString
+	var mintFunction sql.NullString
 
 	err := r.db.GetClient().QueryRowContext(ctx, QueryGetContractMeta, chainID, address).Scan(
 		&contract.Name,
@@ -290,6 +400,9 @@ func (r *Repository) GetContractMeta(ctx context.Context, chainID domain.ChainID
 		&standard,
 		&implAddress,
 		&abiSha256,
+		&contract.Imported,
+		pq.Array(&contract.Roles),
+		&mintFunction,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -311,6 +424,13 @@ func (r *Repository) GetContractMeta(ctx context.Context, chainID domain.ChainID
 	if abiSha256.Valid {
 		contract.AbiSHA256 = &abiSha256.String
 	}
+	if mintFunction.Valid {
+		var fn domain.MintFunction
+		if err := json.Unmarshal([]byte(mintFunction.String), &fn); err != nil {
+			return nil, fmt.Errorf("failed to decode mint function: %w", err)
+		}
+		contract.MintFunction = &fn
+	}
 
 	result := &domain.ContractMeta{
 		ChainID:         chainID,
@@ -340,7 +460,7 @@ func (r *Repository) GetAbiBlob(ctx context.Context, sha domain.Sha256) (abiJSON
 	err = r.db.GetClient().QueryRowContext(ctx, QueryGetAbiBlobMetadata, sha).Scan(&s3Key, &sizeBytes)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, "", fmt.Errorf("ABI blob not found: %s", sha)
+			return nil, "", fmt.Errorf("%w: %s", domain.ErrAbiNotFound, sha)
 		}
 		return nil, "", fmt.Errorf("failed to get ABI blob metadata: %w", err)
 	}
@@ -417,3 +537,123 @@ func (r *Repository) ResolveProxy(ctx context.Context, chainID domain.ChainID, a
 
 	return implAddress, abiSha256, nil
 }
+
+func (r *Repository) ReplaceAbi(ctx context.Context, change *domain.AbiChange, abiJSON []byte) error {
+	diffJSON, err := json.Marshal(change.Diff)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ABI diff: %w", err)
+	}
+	newVersion := nextRegistryVersion()
+
+	tx, err := r.db.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, QueryInsertAbiBlob,
+		change.NewAbiSHA256, len(abiJSON), change.Name, string(abiJSON), fmt.Sprintf("local/%s", change.NewAbiSHA256),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert ABI blob: %w", err)
+	}
+
+	var contractID int64
+	err = tx.QueryRowContext(ctx, QueryUpdateContractAbi, change.ChainID, change.Address, change.NewAbiSHA256).Scan(&contractID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("contract not found: %s on chain %s", change.Address, change.ChainID)
+		}
+		return fmt.Errorf("failed to update contract ABI: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, QueryInsertAbiChange,
+		contractID, change.OldAbiSHA256, change.NewAbiSHA256, string(diffJSON), change.Reason, newVersion, change.ChangedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record ABI change: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit ABI change: %w", err)
+	}
+
+	// Drop the cached meta so readers see the new hash, then bump the version so
+	// consumers keyed on it (orchestrator ABI cache) refetch
+	r.redis.Delete(ctx,
+		fmt.Sprintf("contract_meta:%s:%s", change.ChainID, change.Address),
+		fmt.Sprintf("proxy_resolution:%s:%s", change.ChainID, change.Address),
+	)
+	r.publishVersion(ctx, change.ChainID, newVersion)
+	change.RegistryVersion = newVersion
+	return nil
+}
+
+func (r *Repository) RegisterCollection(ctx context.Context, chainID domain.ChainID, contract domain.Contract) (*domain.Contract, bool, string, error) {
+	var id int64
+	err := r.db.GetClient().QueryRowContext(ctx, QueryInsertImportedCollection,
+		chainID, contract.Name, contract.Address, int32(contract.StartBlock), string(contract.Standard),
+	).Scan(&id)
+	if err == sql.ErrNoRows {
+		// Already registered, or the chain is unknown; GetContractMeta tells which
+		meta, err := r.GetContractMeta(ctx, chainID, contract.Address)
+		if err != nil {
+			return nil, false, "", err
+		}
+		return &meta.Contract, false, "", nil
+	}
+	if err != nil {
+		return nil, false, "", fmt.Errorf("failed to register collection: %w", err)
+	}
+
+	// GetContracts is cached per version, so the bump makes it list the collection
+	newVersion := nextRegistryVersion()
+	r.publishVersion(ctx, chainID, newVersion)
+
+	now := time.Now().UTC()
+	contract.VerifiedAt = &now
+	contract.Imported = true
+	return &contract, true, newVersion, nil
+}
+
+func (r *Repository) SetMintFunction(ctx context.Context, chainID domain.ChainID, address domain.Address, fn *domain.MintFunction, reason string) (string, error) {
+	var result sql.Result
+	var err error
+	if fn == nil {
+		result, err = r.db.GetClient().ExecContext(ctx, QueryDeleteMintFunction, chainID, address)
+	} else {
+		descriptor, marshalErr := json.Marshal(fn)
+		if marshalErr != nil {
+			return "", fmt.Errorf("failed to marshal mint function: %w", marshalErr)
+		}
+		result, err = r.db.GetClient().ExecContext(ctx, QueryUpsertMintFunction, chainID, address, string(descriptor), reason)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to set mint function: %w", err)
+	}
+	if fn != nil {
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return "", fmt.Errorf("contract not found: %s on chain %s", address, chainID)
+		}
+	}
+
+	r.redis.Delete(ctx, fmt.Sprintf("contract_meta:%s:%s", chainID, address))
+	newVersion := nextRegistryVersion()
+	r.publishVersion(ctx, chainID, newVersion)
+	return newVersion, nil
+}
+
+func (r *Repository) SetContractRoles(ctx context.Context, chainID domain.ChainID, address domain.Address, roles []string) (string, error) {
+	result, err := r.db.GetClient().ExecContext(ctx, QuerySetContractRoles, chainID, address, pq.Array(roles))
+	if err != nil {
+		return "", fmt.Errorf("failed to set contract roles: %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return "", fmt.Errorf("contract not found: %s on chain %s", address, chainID)
+	}
+
+	r.redis.Delete(ctx, fmt.Sprintf("contract_meta:%s:%s", chainID, address))
+	newVersion := nextRegistryVersion()
+	r.publishVersion(ctx, chainID, newVersion)
+	return newVersion, nil
+}
@@ -15,6 +15,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	shpg "github.com/quangdang46/NFT-Marketplace/shared/postgres"
 )
 
@@ -24,6 +26,7 @@ type ContractSeed struct {
 	Address     string
 	Standard    string
 	AbiFileName string
+	Roles       []string // sorted
 }
 
 func RunStartupSeed(pg *shpg.Postgres) error {
@@ -79,7 +82,7 @@ func seedOne(pg *shpg.Postgres, abiDir string, seed ContractSeed) error {
 		return fmt.Errorf("failed to upsert ABI blob: %w", err)
 	}
 
-	if err := upsertContract(pg, seed.ChainCAIP2, seed.Name, strings.ToLower(seed.Address), seed.Standard, sha); err != nil {
+	if err := upsertContract(pg, seed.ChainCAIP2, seed.Name, strings.ToLower(seed.Address), seed.Standard, sha, seed.Roles); err != nil {
 		return fmt.Errorf("failed to upsert contract: %w", err)
 	}
 
@@ -133,7 +136,7 @@ func upsertAbiBlob(pg *shpg.Postgres, sha string, size int, name, standard strin
 	return nil
 }
 
-func upsertContract(pg *shpg.Postgres, caip2, name, address, standard, sha string) error {
+func upsertContract(pg *shpg.Postgres, caip2, name, address, standard, sha string, roles []string) error {
 	// First, let's check if the chain exists and get both id and chain_numeric
 	var chainID int
 	var chainNumeric int
@@ -143,11 +146,11 @@ func upsertContract(pg *shpg.Postgres, caip2, name, address, standard, sha strin
 	}
 
 	_, err = pg.GetClient().Exec(
-		`INSERT INTO chain_contracts (chain_id, name, address, standard, abi_sha256)
-		 VALUES ($1, $2, $3, $4, $5)
+		`INSERT INTO chain_contracts (chain_id, name, address, standard, abi_sha256, roles)
+		 VALUES ($1, $2, $3, $4, $5, $6)
 		 ON CONFLICT (chain_id, address)
-		 DO UPDATE SET name = EXCLUDED.name, standard = EXCLUDED.standard, abi_sha256 = EXCLUDED.abi_sha256`,
-		chainID, name, address, strings.ToUpper(standard), sha,
+		 DO UPDATE SET name = EXCLUDED.name, standard = EXCLUDED.standard, abi_sha256 = EXCLUDED.abi_sha256, roles = EXCLUDED.roles`,
+		chainID, name, address, strings.ToUpper(standard), sha, pq.Array(roles),
 	)
 	if err != nil {
 		return err
@@ -163,6 +166,7 @@ func anvilSeeds() []ContractSeed {
 			Address:     "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512",
 			Standard:    "CUSTOM",
 			AbiFileName: "ERC721CollectionFactory.json",
+			Roles:       []string{contracts.RoleERC721Factory, contracts.RoleFactory},
 		},
 		{
 			ChainCAIP2:  "eip155:31337",
@@ -170,6 +174,15 @@ func anvilSeeds() []ContractSeed {
 			Address:     "0x9fE46736679d2D9a65F0992F2272dE9f3c7fa6e0",
 			Standard:    "CUSTOM",
 			AbiFileName: "ERC1155CollectionFactory.json",
+			Roles:       []string{contracts.RoleERC1155Factory, contracts.RoleFactory},
+		},
+		{
+			ChainCAIP2:  "eip155:31337",
+			Name:        "AuctionHouse",
+			Address:     "0xDc64a140Aa3E981100a9becA4E685f962f0cF6C9",
+			Standard:    "CUSTOM",
+			AbiFileName: "AuctionHouse.json",
+			Roles:       []string{contracts.RoleAuctionHouse},
 		},
 	}
 }
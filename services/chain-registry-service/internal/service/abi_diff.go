@@ -0,0 +1,109 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+)
+
+type abiParam struct {
+	Type       string     `json:"type"`
+	Components []abiParam `json:"components"`
+}
+
+type abiEntry struct {
+	Type   string     `json:"type"`
+	Name   string     `json:"name"`
+	Inputs []abiParam `json:"inputs"`
+}
+
+// DiffAbi compares two ABIs (raw arrays or artifacts with an "abi" field) by their
+// canonical event and function signatures. An empty old ABI counts as no signatures.
+func DiffAbi(oldJSON, newJSON []byte) (domain.AbiDiff, error) {
+	oldEvents, oldFunctions, err := abiSignatures(oldJSON)
+	if err != nil {
+		return domain.AbiDiff{}, fmt.Errorf("parse current ABI: %w", err)
+	}
+	newEvents, newFunctions, err := abiSignatures(newJSON)
+	if err != nil {
+		return domain.AbiDiff{}, fmt.Errorf("parse new ABI: %w", err)
+	}
+
+	return domain.AbiDiff{
+		AddedEvents:      missingFrom(newEvents, oldEvents),
+		RemovedEvents:    missingFrom(oldEvents, newEvents),
+		AddedFunctions:   missingFrom(newFunctions, oldFunctions),
+		RemovedFunctions: missingFrom(oldFunctions, newFunctions),
+	}, nil
+}
+
+func abiSignatures(abiJSON []byte) (events, functions map[string]struct{}, err error) {
+	events = make(map[string]struct{})
+	functions = make(map[string]struct{})
+
+	trimmed := bytes.TrimSpace(abiJSON)
+	if len(trimmed) == 0 {
+		return events, functions, nil
+	}
+	if trimmed[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(trimmed, &artifact); err != nil {
+			return nil, nil, err
+		}
+		trimmed = artifact.ABI
+		if len(trimmed) == 0 {
+			return events, functions, nil
+		}
+	}
+
+	var entries []abiEntry
+	if err := json.Unmarshal(trimmed, &entries); err != nil {
+		return nil, nil, err
+	}
+
+	for _, entry := range entries {
+		switch entry.Type {
+		case "event":
+			events[signature(entry)] = struct{}{}
+		case "function", "": // type defaults to function
+			functions[signature(entry)] = struct{}{}
+		}
+	}
+	return events, functions, nil
+}
+
+// signature renders Name(type1,type2,...) with tuples expanded, as used for selectors and topics
+func signature(entry abiEntry) string {
+	return entry.Name + "(" + canonicalTypes(entry.Inputs) + ")"
+}
+
+func canonicalTypes(params []abiParam) string {
+	types := make([]string, len(params))
+	for i, p := range params {
+		if strings.HasPrefix(p.Type, "tuple") {
+			// tuple[] / tuple[2] keep their array suffix
+			types[i] = "(" + canonicalTypes(p.Components) + ")" + strings.TrimPrefix(p.Type, "tuple")
+			continue
+		}
+		types[i] = p.Type
+	}
+	return strings.Join(types, ",")
+}
+
+// missingFrom returns the sorted signatures in a that are not in b
+func missingFrom(a, b map[string]struct{}) []string {
+	out := []string{}
+	for sig := range a {
+		if _, ok := b[sig]; !ok {
+			out = append(out, sig)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+)
+
+// staleBlocks is how many block times a chain may go without a new head before it is
+// reported stale, for chains slow enough that the configured floor would flag them
+const staleBlocks = 10
+
+// SetChainHeads enables GetChainHead and the chain.head_advanced consumer. A head that has
+// not advanced for staleAfter, or ten block times on slower chains, is reported stale.
+func (s *Service) SetChainHeads(store domain.ChainHeadStore, staleAfter time.Duration) {
+	s.heads = store
+	s.headStaleAfter = staleAfter
+}
+
+func (s *Service) GetChainHead(ctx context.Context, chainID domain.ChainID) (*domain.ChainHeadStatus, error) {
+	if err := ValidateChainID(chainID); err != nil {
+		return nil, err
+	}
+	if s.heads == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrChainHeadNotFound, chainID)
+	}
+
+	head, err := s.heads.ReadChainHead(ctx, chainID)
+	if errors.Is(err, domain.ErrChainHeadNotFound) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrChainHeadNotFound, chainID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain head: %w", err)
+	}
+
+	return &domain.ChainHeadStatus{
+		ChainHead: head,
+		Stale:     head.Stale(time.Now(), s.staleAfter(ctx, chainID)),
+	}, nil
+}
+
+func (s *Service) HandleChainHeadAdvanced(ctx context.Context, head domain.ChainHead) error {
+	if s.heads == nil {
+		return nil
+	}
+	if err := ValidateChainID(head.ChainID); err != nil {
+		return err
+	}
+
+	// Redelivered or reordered events must not move a head back
+	stored, err := s.heads.ReadChainHead(ctx, head.ChainID)
+	switch {
+	case errors.Is(err, domain.ErrChainHeadNotFound):
+	case err != nil:
+		return fmt.Errorf("failed to read chain head: %w", err)
+	case head.LatestBlock <= stored.LatestBlock:
+		return nil
+	}
+
+	if err := s.heads.WriteChainHead(ctx, head); err != nil {
+		return fmt.Errorf("failed to write chain head: %w", err)
+	}
+	return nil
+}
+
+// staleAfter is the configured floor, raised for chains whose block time makes it too tight
+func (s *Service) staleAfter(ctx context.Context, chainID domain.ChainID) time.Duration {
+	after := s.headStaleAfter
+	chainContracts, err := s.repo.GetContracts(ctx, chainID)
+	if err != nil {
+		return after
+	}
+	if byBlocks := staleBlocks * time.Duration(chainContracts.Params.BlockTimeMs) * time.Millisecond; byBlocks > after {
+		after = byBlocks
+	}
+	return after
+}
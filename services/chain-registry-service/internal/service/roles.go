@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// GetContractByRole picks the contract holding role out of the chain's contract list, which
+// the repository caches per registry version
+func (s *Service) GetContractByRole(ctx context.Context, chainID domain.ChainID, role string) (*domain.ContractMeta, error) {
+	if err := ValidateGetContractByRoleRequest(chainID, role); err != nil {
+		return nil, err
+	}
+
+	chainContracts, err := s.repo.GetContracts(ctx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contracts from repository: %w", err)
+	}
+
+	var found *domain.Contract
+	for i := range chainContracts.Contracts {
+		contract := &chainContracts.Contracts[i]
+		if !contracts.HasRole(contract.Roles, role) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("%w: %s held by %s and %s on %s", domain.ErrRoleAmbiguous, role, found.Address, contract.Address, chainID)
+		}
+		found = contract
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: %s on %s", domain.ErrRoleNotAssigned, role, chainID)
+	}
+
+	s.audit(ctx, "GetContractByRole", map[string]any{
+		"chain_id":  chainID,
+		"role":      role,
+		"address":   found.Address,
+		"timestamp": time.Now().UTC().Format(time.RFC3339Nano),
+	})
+
+	return &domain.ContractMeta{
+		ChainID:         chainID,
+		Contract:        *found,
+		RegistryVersion: chainContracts.RegistryVersion,
+	}, nil
+}
+
+// SetContractRoles stores the roles sorted and without repeats, so equal sets compare equal
+func (s *Service) SetContractRoles(ctx context.Context, chainID domain.ChainID, address domain.Address, roles []string, reason string) (string, error) {
+	if err := ValidateSetContractRolesRequest(chainID, address, roles, reason); err != nil {
+		return "", err
+	}
+	address = strings.ToLower(address)
+	roles = normalizeRoles(roles)
+
+	version, err := s.repo.SetContractRoles(ctx, chainID, address, roles)
+	if err != nil {
+		return "", fmt.Errorf("failed to set contract roles in repository: %w", err)
+	}
+
+	// The orchestrator and indexer re-resolve factories and the auction house on the new version
+	s.announceVersion(ctx, chainID, version, reason)
+
+	s.audit(ctx, "SetContractRoles", map[string]any{
+		"chain_id":         chainID,
+		"address":          address,
+		"roles":            strings.Join(roles, ","),
+		"registry_version": version,
+		"timestamp":        time.Now().UTC().Format(time.RFC3339Nano),
+	})
+
+	return version, nil
+}
+
+func normalizeRoles(roles []string) []string {
+	out := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if !contracts.HasRole(out, role) {
+			out = append(out, role)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
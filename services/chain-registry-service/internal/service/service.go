@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
@@ -11,11 +14,15 @@ import (
 )
 
 type Service struct {
-	repo domain.ChainRegistryRepository
+	repo      domain.ChainRegistryRepository
+	publisher domain.EventPublisher // optional; ABI and version changes are not announced without it
+
+	heads          domain.ChainHeadStore // optional; GetChainHead reports no heads without it
+	headStaleAfter time.Duration
 }
 
-func New(repo domain.ChainRegistryRepository) domain.ChainRegistryService {
-	return &Service{repo: repo}
+func New(repo domain.ChainRegistryRepository, publisher domain.EventPublisher) domain.ChainRegistryService {
+	return &Service{repo: repo, publisher: publisher}
 }
 
 func (s *Service) GetContracts(ctx context.Context, chainID domain.ChainID) (*domain.ChainContracts, error) {
@@ -72,6 +79,24 @@ func (s *Service) GetRpcEndpoints(ctx context.Context, chainID domain.ChainID) (
 	return chainRpcEndpoints, nil
 }
 
+func (s *Service) GetChainCapabilities(ctx context.Context, chainID domain.ChainID) (*domain.ChainCapabilitiesInfo, error) {
+	if err := ValidateGetChainCapabilitiesRequest(chainID); err != nil {
+		return nil, err
+	}
+
+	capabilities, err := s.repo.GetChainCapabilities(ctx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain capabilities from repository: %w", err)
+	}
+
+	s.audit(ctx, "GetChainCapabilities", map[string]any{
+		"chain_id":  chainID,
+		"timestamp": time.Now().UTC().Format(time.RFC3339Nano),
+	})
+
+	return capabilities, nil
+}
+
 func (s *Service) BumpVersion(ctx context.Context, chainID domain.ChainID, reason string) (ok bool, newVersion string, err error) {
 	if err := ValidateBumpVersionRequest(chainID, reason); err != nil {
 		return false, "", err
@@ -81,6 +106,7 @@ func (s *Service) BumpVersion(ctx context.Context, chainID domain.ChainID, reaso
 	if err != nil {
 		return false, "", fmt.Errorf("failed to bump version in repository: %w", err)
 	}
+	s.announceVersion(ctx, chainID, newVersion, reason)
 
 	s.audit(ctx, "BumpVersion", map[string]any{
 		"chain_id":  chainID,
@@ -170,6 +196,160 @@ func (s *Service) GetAbiByAddress(ctx context.Context, chainID domain.ChainID, a
 	return abiJSON, etag, err
 }
 
+func (s *Service) UpdateContractAbi(ctx context.Context, chainID domain.ChainID, address domain.Address, abiJSON []byte, reason string) (*domain.AbiChange, bool, error) {
+	if err := ValidateUpdateContractAbiRequest(chainID, address, abiJSON, reason); err != nil {
+		return nil, false, err
+	}
+	address = strings.ToLower(address)
+
+	meta, err := s.repo.GetContractMeta(ctx, chainID, address)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get contract meta: %w", err)
+	}
+
+	sum := sha256.Sum256(abiJSON)
+	change := &domain.AbiChange{
+		ChainID:         chainID,
+		Address:         address,
+		Name:            meta.Contract.Name,
+		NewAbiSHA256:    hex.EncodeToString(sum[:]),
+		Reason:          reason,
+		RegistryVersion: meta.RegistryVersion,
+		ChangedAt:       time.Now().UTC(),
+	}
+
+	var oldJSON []byte
+	if meta.Contract.AbiSHA256 != nil && *meta.Contract.AbiSHA256 != "" {
+		change.OldAbiSHA256 = *meta.Contract.AbiSHA256
+		if change.OldAbiSHA256 == change.NewAbiSHA256 {
+			return change, false, nil
+		}
+		oldJSON, _, err = s.repo.GetAbiBlob(ctx, change.OldAbiSHA256)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get current ABI: %w", err)
+		}
+	}
+
+	change.Diff, err = DiffAbi(oldJSON, abiJSON)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := s.repo.ReplaceAbi(ctx, change, abiJSON); err != nil {
+		return nil, false, fmt.Errorf("failed to replace ABI in repository: %w", err)
+	}
+
+	// The ABI is already replaced, so a failed announcement is logged rather than returned
+	if s.publisher != nil {
+		if err := s.publisher.PublishAbiChanged(ctx, change); err != nil {
+			log.Printf("failed to publish abi_changed for %s on %s: %v", address, chainID, err)
+		}
+	}
+	s.announceVersion(ctx, chainID, change.RegistryVersion, reason)
+
+	s.audit(ctx, "UpdateContractAbi", map[string]any{
+		"chain_id":          chainID,
+		"address":           address,
+		"old_abi_sha256":    change.OldAbiSHA256,
+		"new_abi_sha256":    change.NewAbiSHA256,
+		"removed_events":    len(change.Diff.RemovedEvents),
+		"removed_functions": len(change.Diff.RemovedFunctions),
+		"registry_version":  change.RegistryVersion,
+		"timestamp":         time.Now().UTC().Format(time.RFC3339Nano),
+	})
+
+	return change, true, nil
+}
+
+// ImportedCollectionName is the registry name of an imported collection; factory and
+// marketplace names stay reserved for the contracts seeded by the deployment
+func ImportedCollectionName(address domain.Address) string {
+	return "collection-" + strings.ToLower(address)
+}
+
+func (s *Service) RegisterCollection(ctx context.Context, chainID domain.ChainID, contract domain.Contract, reason string) (*domain.Contract, bool, string, error) {
+	if err := ValidateRegisterCollectionRequest(chainID, contract, reason); err != nil {
+		return nil, false, "", err
+	}
+	contract.Address = strings.ToLower(contract.Address)
+	contract.Name = ImportedCollectionName(contract.Address)
+
+	registered, created, version, err := s.repo.RegisterCollection(ctx, chainID, contract)
+	if err != nil {
+		return nil, false, "", fmt.Errorf("failed to register collection in repository: %w", err)
+	}
+	if !created {
+		return registered, false, "", nil
+	}
+
+	// The indexer starts following the collection when it sees the new version
+	s.announceVersion(ctx, chainID, version, reason)
+
+	s.audit(ctx, "RegisterCollection", map[string]any{
+		"chain_id":         chainID,
+		"address":          contract.Address,
+		"standard":         contract.Standard,
+		"start_block":      contract.StartBlock,
+		"registry_version": version,
+		"timestamp":        time.Now().UTC().Format(time.RFC3339Nano),
+	})
+
+	return registered, true, version, nil
+}
+
+func (s *Service) SetMintFunction(ctx context.Context, chainID domain.ChainID, address domain.Address, fn *domain.MintFunction, reason string) (string, error) {
+	if err := ValidateSetMintFunctionRequest(chainID, address, fn, reason); err != nil {
+		return "", err
+	}
+	address = strings.ToLower(address)
+
+	meta, err := s.repo.GetContractMeta(ctx, chainID, address)
+	if err != nil {
+		return "", fmt.Errorf("failed to get contract meta: %w", err)
+	}
+	if fn != nil && meta.Contract.Standard != domain.StdERC721 && meta.Contract.Standard != domain.StdERC1155 {
+		return "", fmt.Errorf("mint functions apply to ERC721 and ERC1155 collections, got %q", meta.Contract.Standard)
+	}
+
+	version, err := s.repo.SetMintFunction(ctx, chainID, address, fn, reason)
+	if err != nil {
+		return "", fmt.Errorf("failed to set mint function in repository: %w", err)
+	}
+
+	// The orchestrator drops its cached contract metadata when it sees the new version
+	s.announceVersion(ctx, chainID, version, reason)
+
+	fields := map[string]any{
+		"chain_id":         chainID,
+		"address":          address,
+		"registry_version": version,
+		"timestamp":        time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if fn != nil {
+		fields["mint_function"] = fn.Name
+	}
+	s.audit(ctx, "SetMintFunction", fields)
+
+	return version, nil
+}
+
+// announceVersion publishes registry.changed so the indexer and orchestrator refetch the
+// chain's endpoints and params. The version is already stored, so failures are only logged.
+func (s *Service) announceVersion(ctx context.Context, chainID domain.ChainID, version, reason string) {
+	if s.publisher == nil {
+		return
+	}
+	change := &domain.RegistryChange{
+		ChainID:         chainID,
+		RegistryVersion: version,
+		Reason:          reason,
+		ChangedAt:       time.Now().UTC(),
+	}
+	if err := s.publisher.PublishRegistryChanged(ctx, change); err != nil {
+		log.Printf("failed to publish registry.changed for %s: %v", chainID, err)
+	}
+}
+
 // audit emits structured audit logs with optional session context from gRPC metadata
 func (s *Service) audit(ctx context.Context, method string, fields map[string]any) {
 	var sessionID string
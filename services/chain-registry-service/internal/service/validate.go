@@ -1,7 +1,10 @@
 package service
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
@@ -82,6 +85,11 @@ func ValidateGetRpcEndpointsRequest(chainID domain.ChainID) error {
 	return ValidateChainID(chainID)
 }
 
+// ValidateGetChainCapabilitiesRequest validates the GetChainCapabilities request
+func ValidateGetChainCapabilitiesRequest(chainID domain.ChainID) error {
+	return ValidateChainID(chainID)
+}
+
 // ValidateGetContractMetaRequest validates the GetContractMeta request
 func ValidateGetContractMetaRequest(chainID domain.ChainID, address domain.Address) error {
 	if err := ValidateChainID(chainID); err != nil {
@@ -121,3 +129,112 @@ func ValidateBumpVersionRequest(chainID domain.ChainID, reason string) error {
 	}
 	return nil
 }
+
+// ValidateUpdateContractAbiRequest validates the UpdateContractAbi request
+func ValidateUpdateContractAbiRequest(chainID domain.ChainID, address domain.Address, abiJSON []byte, reason string) error {
+	if err := ValidateChainID(chainID); err != nil {
+		return err
+	}
+	if err := ValidateAddress(address); err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(abiJSON)) == 0 {
+		return fmt.Errorf("abi_json is required")
+	}
+	if !json.Valid(abiJSON) {
+		return fmt.Errorf("abi_json is not valid JSON")
+	}
+	if reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	return nil
+}
+
+// ValidateRegisterCollectionRequest validates the RegisterCollection request
+func ValidateRegisterCollectionRequest(chainID domain.ChainID, contract domain.Contract, reason string) error {
+	if err := ValidateChainID(chainID); err != nil {
+		return err
+	}
+	if err := ValidateAddress(contract.Address); err != nil {
+		return err
+	}
+	if contract.Standard != domain.StdERC721 && contract.Standard != domain.StdERC1155 {
+		return fmt.Errorf("standard must be ERC721 or ERC1155, got %q", contract.Standard)
+	}
+	if contract.StartBlock > math.MaxInt32 {
+		return fmt.Errorf("start_block %d is out of range", contract.StartBlock)
+	}
+	if reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	return nil
+}
+
+// ValidateSetMintFunctionRequest validates the SetMintFunction request; a nil fn clears it
+func ValidateSetMintFunctionRequest(chainID domain.ChainID, address domain.Address, fn *domain.MintFunction, reason string) error {
+	if err := ValidateChainID(chainID); err != nil {
+		return err
+	}
+	if err := ValidateAddress(address); err != nil {
+		return err
+	}
+	if fn != nil {
+		if err := fn.Validate(); err != nil {
+			return err
+		}
+	}
+	if reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	return nil
+}
+
+// maxContractRoles caps the roles one contract can hold
+const maxContractRoles = 16
+
+// ValidateContractRole checks a role is a lowercase kebab-case tag, e.g. "auction-house"
+func ValidateContractRole(role string) error {
+	if role == "" {
+		return fmt.Errorf("role is required")
+	}
+	if len(role) > 64 {
+		return fmt.Errorf("role %q is longer than 64 characters", role)
+	}
+	for i, char := range role {
+		if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || (char == '-' && i > 0) {
+			continue
+		}
+		return fmt.Errorf("role %q must be lowercase letters, digits and dashes", role)
+	}
+	return nil
+}
+
+// ValidateGetContractByRoleRequest validates the GetContractByRole request
+func ValidateGetContractByRoleRequest(chainID domain.ChainID, role string) error {
+	if err := ValidateChainID(chainID); err != nil {
+		return err
+	}
+	return ValidateContractRole(role)
+}
+
+// ValidateSetContractRolesRequest validates the SetContractRoles request; no roles clears them
+func ValidateSetContractRolesRequest(chainID domain.ChainID, address domain.Address, roles []string, reason string) error {
+	if err := ValidateChainID(chainID); err != nil {
+		return err
+	}
+	if err := ValidateAddress(address); err != nil {
+		return err
+	}
+	if len(roles) > maxContractRoles {
+		return fmt.Errorf("a contract can hold at most %d roles", maxContractRoles)
+	}
+	for _, role := range roles {
+		if err := ValidateContractRole(role); err != nil {
+			return err
+		}
+	}
+	if reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	return nil
+}
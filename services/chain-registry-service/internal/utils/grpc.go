@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	chainpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
 )
 
@@ -48,6 +49,8 @@ func DomainToProtoContract(contract domain.Contract) *chainpb.Contract {
 		Address:    contract.Address,
 		StartBlock: int32(contract.StartBlock),
 		Standard:   DomainToProtoContractStandard(contract.Standard),
+		Imported:   contract.Imported,
+		Roles:      contract.Roles,
 	}
 
 	if contract.VerifiedAt != nil {
@@ -59,10 +62,46 @@ func DomainToProtoContract(contract domain.Contract) *chainpb.Contract {
 	if contract.AbiSHA256 != nil {
 		protoContract.AbiSha256 = *contract.AbiSHA256
 	}
+	if contract.MintFunction != nil {
+		protoContract.MintFunction = DomainToProtoMintFunction(*contract.MintFunction)
+	}
 
 	return protoContract
 }
 
+// DomainToProtoMintFunction converts a mint function descriptor to protobuf
+func DomainToProtoMintFunction(fn domain.MintFunction) *chainpb.MintFunction {
+	args := make([]string, len(fn.Args))
+	for i, arg := range fn.Args {
+		args[i] = string(arg)
+	}
+	return &chainpb.MintFunction{
+		Name:         fn.Name,
+		Args:         args,
+		Payable:      fn.Payable,
+		UnitPriceWei: fn.UnitPriceWei,
+		MaxPerTx:     fn.MaxPerTx,
+	}
+}
+
+// ProtoToDomainMintFunction converts a protobuf mint function; nil stays nil
+func ProtoToDomainMintFunction(fn *chainpb.MintFunction) *domain.MintFunction {
+	if fn == nil {
+		return nil
+	}
+	args := make([]contracts.MintArg, len(fn.GetArgs()))
+	for i, arg := range fn.GetArgs() {
+		args[i] = contracts.MintArg(arg)
+	}
+	return &domain.MintFunction{
+		Name:         fn.GetName(),
+		Args:         args,
+		Payable:      fn.GetPayable(),
+		UnitPriceWei: fn.GetUnitPriceWei(),
+		MaxPerTx:     fn.GetMaxPerTx(),
+	}
+}
+
 // DomainToProtoGasPolicy converts domain gas policy to protobuf
 func DomainToProtoGasPolicy(policy domain.GasPolicy) *chainpb.GasPolicy {
 	return &chainpb.GasPolicy{
@@ -74,6 +113,23 @@ func DomainToProtoGasPolicy(policy domain.GasPolicy) *chainpb.GasPolicy {
 	}
 }
 
+// DomainToProtoChainCapabilities converts domain chain capabilities to protobuf
+func DomainToProtoChainCapabilities(capabilities domain.ChainCapabilities) *chainpb.ChainCapabilities {
+	protoCapabilities := &chainpb.ChainCapabilities{
+		Erc721Factory:  capabilities.ERC721Factory,
+		Erc1155Factory: capabilities.ERC1155Factory,
+		AllowlistMint:  capabilities.AllowlistMint,
+		LazyMint:       capabilities.LazyMint,
+		Version:        capabilities.Version,
+	}
+
+	if capabilities.UpdatedAt != nil {
+		protoCapabilities.UpdatedAt = capabilities.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return protoCapabilities
+}
+
 // DomainToProtoRpcEndpoint converts domain RPC endpoint to protobuf
 func DomainToProtoRpcEndpoint(endpoint domain.RpcEndpoint) *chainpb.RpcEndpoint {
 	protoEndpoint := &chainpb.RpcEndpoint{
@@ -97,5 +153,30 @@ func DomainToProtoChainParams(params domain.ChainParams) *chainpb.ChainParams {
 		RequiredConfirmations: params.RequiredConfirmations,
 		ReorgDepth:            params.ReorgDepth,
 		BlockTimeMs:           params.BlockTimeMs,
+		MaxRoyaltyBps:         params.MaxRoyaltyBps,
+		MinStageDurationSec:   params.MinStageDurationSec,
+		MaxSupplyCap:          params.MaxSupplyCap,
+	}
+}
+
+func DomainToProtoAbiDiff(diff domain.AbiDiff) *chainpb.AbiDiff {
+	return &chainpb.AbiDiff{
+		AddedEvents:      diff.AddedEvents,
+		RemovedEvents:    diff.RemovedEvents,
+		AddedFunctions:   diff.AddedFunctions,
+		RemovedFunctions: diff.RemovedFunctions,
+	}
+}
+
+func DomainToProtoChainHead(head *domain.ChainHeadStatus) *chainpb.ChainHead {
+	return &chainpb.ChainHead{
+		ChainId:         head.ChainID,
+		LatestBlock:     head.LatestBlock,
+		LatestBlockHash: head.LatestBlockHash,
+		LatestBlockTime: head.LatestBlockTime.UTC().Format(time.RFC3339),
+		FinalizedBlock:  head.FinalizedBlock,
+		FinalizedByTag:  head.FinalizedByTag,
+		AdvancedAt:      head.AdvancedAt.UTC().Format(time.RFC3339),
+		Stale:           head.Stale,
 	}
 }
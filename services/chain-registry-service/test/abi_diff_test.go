@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const auctionAbiV1 = `{"abi":[
+	{"type":"function","name":"bid","stateMutability":"payable","inputs":[{"name":"auctionId","type":"uint256"}]},
+	{"type":"function","name":"settle","inputs":[{"name":"auctionId","type":"uint256"}]},
+	{"type":"event","name":"BidPlaced","inputs":[{"name":"auctionId","type":"uint256","indexed":true},{"name":"bidder","type":"address","indexed":true},{"name":"amount","type":"uint256"},{"name":"endTime","type":"uint64"}]}
+]}`
+
+const auctionAbiV2 = `[
+	{"type":"function","name":"bid","stateMutability":"payable","inputs":[{"name":"auctionId","type":"uint256"},{"name":"referrer","type":"address"}]},
+	{"type":"function","name":"settle","inputs":[{"name":"auctionId","type":"uint256"}]},
+	{"type":"function","name":"configure","inputs":[{"name":"cfg","type":"tuple","components":[{"name":"fee","type":"uint96"},{"name":"to","type":"address"}]}]},
+	{"type":"event","name":"BidPlaced","inputs":[{"name":"auctionId","type":"uint256","indexed":true},{"name":"bidder","type":"address","indexed":true},{"name":"amount","type":"uint256"},{"name":"endTime","type":"uint64"}]},
+	{"type":"event","name":"AuctionExtended","inputs":[{"name":"auctionId","type":"uint256","indexed":true},{"name":"endTime","type":"uint64"}]}
+]`
+
+func TestDiffAbi_ReportsAddedAndRemovedSignatures(t *testing.T) {
+	diff, err := service.DiffAbi([]byte(auctionAbiV1), []byte(auctionAbiV2))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"AuctionExtended(uint256,uint64)"}, diff.AddedEvents)
+	assert.Empty(t, diff.RemovedEvents)
+	assert.Equal(t, []string{"bid(uint256,address)", "configure((uint96,address))"}, diff.AddedFunctions)
+	assert.Equal(t, []string{"bid(uint256)"}, diff.RemovedFunctions)
+}
+
+func TestDiffAbi_EmptyCurrentAbiAddsEverything(t *testing.T) {
+	diff, err := service.DiffAbi(nil, []byte(auctionAbiV1))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"BidPlaced(uint256,address,uint256,uint64)"}, diff.AddedEvents)
+	assert.Equal(t, []string{"bid(uint256)", "settle(uint256)"}, diff.AddedFunctions)
+	assert.Empty(t, diff.RemovedFunctions)
+}
+
+func TestService_UpdateContractAbi(t *testing.T) {
+	ctx := context.Background()
+	address := "0xdc64a140aa3e981100a9beca4e685f962f0cf6c9"
+	oldSha := "aa"
+	meta := &domain.ContractMeta{
+		ChainID:         "eip155:31337",
+		Contract:        domain.Contract{Name: "AuctionHouse", Address: address, AbiSHA256: &oldSha},
+		RegistryVersion: "1.0.0",
+	}
+
+	t.Run("replaces the ABI and publishes the diff", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPublisher := new(MockPublisher)
+		svc := service.New(mockRepo, mockPublisher)
+
+		mockRepo.On("GetContractMeta", ctx, "eip155:31337", address).Return(meta, nil)
+		mockRepo.On("GetAbiBlob", ctx, oldSha).Return([]byte(auctionAbiV1), "etag", nil)
+		mockRepo.On("ReplaceAbi", ctx, mock.AnythingOfType("*domain.AbiChange"), []byte(auctionAbiV2)).
+			Run(func(args mock.Arguments) { args.Get(1).(*domain.AbiChange).RegistryVersion = "1.0.2" }).
+			Return(nil)
+		mockPublisher.On("PublishAbiChanged", ctx, mock.MatchedBy(func(c *domain.AbiChange) bool {
+			return c.Name == "AuctionHouse" &&
+				c.OldAbiSHA256 == oldSha &&
+				c.RegistryVersion == "1.0.2" &&
+				assert.ObjectsAreEqual([]string{"bid(uint256)"}, c.Diff.RemovedFunctions)
+		})).Return(nil)
+		mockPublisher.On("PublishRegistryChanged", ctx, mock.MatchedBy(func(c *domain.RegistryChange) bool {
+			return c.ChainID == "eip155:31337" && c.RegistryVersion == "1.0.2"
+		})).Return(nil)
+
+		change, changed, err := svc.UpdateContractAbi(ctx, "eip155:31337", "0xDc64a140Aa3E981100a9becA4E685f962f0cF6C9", []byte(auctionAbiV2), "upgrade to v2")
+
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Len(t, change.NewAbiSHA256, 64)
+		mockRepo.AssertExpectations(t)
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("unchanged hash is a no-op", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPublisher := new(MockPublisher)
+		svc := service.New(mockRepo, mockPublisher)
+
+		sum := sha256.Sum256([]byte(auctionAbiV1))
+		sameSha := hex.EncodeToString(sum[:])
+		current := *meta
+		current.Contract.AbiSHA256 = &sameSha
+		mockRepo.On("GetContractMeta", ctx, "eip155:31337", address).Return(&current, nil)
+
+		change, changed, err := svc.UpdateContractAbi(ctx, "eip155:31337", address, []byte(auctionAbiV1), "reseed")
+
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, "1.0.0", change.RegistryVersion)
+		mockRepo.AssertNotCalled(t, "ReplaceAbi", mock.Anything, mock.Anything, mock.Anything)
+		mockPublisher.AssertNotCalled(t, "PublishAbiChanged", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects invalid JSON", func(t *testing.T) {
+		svc := service.New(new(MockRepository), nil)
+
+		_, _, err := svc.UpdateContractAbi(ctx, "eip155:31337", address, []byte("{not json"), "oops")
+
+		assert.EqualError(t, err, "abi_json is not valid JSON")
+	})
+}
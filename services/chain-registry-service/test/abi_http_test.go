@@ -0,0 +1,143 @@
+package test
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/infrastructure/httpapi"
+)
+
+// fakeAbiReader serves fixed ABIs and counts reads
+type fakeAbiReader struct {
+	abis  map[domain.Sha256][]byte
+	reads int
+}
+
+func (f *fakeAbiReader) GetAbiBlob(ctx context.Context, sha domain.Sha256) ([]byte, string, error) {
+	f.reads++
+	abi, ok := f.abis[sha]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %s", domain.ErrAbiNotFound, sha)
+	}
+	return abi, "etag", nil
+}
+
+var abiHash = domain.Sha256(strings.Repeat("ab", 32))
+
+func abiRequest(t *testing.T, h http.Handler, path string, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAbiHandler_ServesAndRevalidates(t *testing.T) {
+	reader := &fakeAbiReader{abis: map[domain.Sha256][]byte{abiHash: []byte(`[{"type":"function","name":"mint"}]`)}}
+	h := httpapi.NewAbiHandler(reader, nil)
+
+	rec := abiRequest(t, h, "/abi/"+strings.ToUpper(string(abiHash)), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"type":"function","name":"mint"}]`, rec.Body.String())
+	assert.Equal(t, `"`+string(abiHash)+`"`, rec.Header().Get("ETag"))
+	assert.Contains(t, rec.Header().Get("Cache-Control"), "immutable")
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	// Revalidation is answered from the hash alone
+	rec = abiRequest(t, h, "/abi/"+string(abiHash), map[string]string{"If-None-Match": `"other", W/"` + string(abiHash) + `"`})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	assert.Equal(t, 1, reader.reads)
+}
+
+func TestAbiHandler_Gzip(t *testing.T) {
+	abi := `[` + strings.Repeat(`{"type":"function","name":"mint","inputs":[]},`, 50) + `{"type":"fallback"}]`
+	h := httpapi.NewAbiHandler(&fakeAbiReader{abis: map[domain.Sha256][]byte{abiHash: []byte(abi)}}, nil)
+
+	rec := abiRequest(t, h, "/abi/"+string(abiHash), map[string]string{"Accept-Encoding": "br, gzip"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, `W/"`+string(abiHash)+`"`, rec.Header().Get("ETag"))
+	zr, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Equal(t, abi, string(body))
+
+	rec = abiRequest(t, h, "/abi/"+string(abiHash), map[string]string{"Accept-Encoding": "gzip;q=0"})
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, abi, rec.Body.String())
+}
+
+func TestAbiHandler_RejectsBadAndUnknownHashes(t *testing.T) {
+	h := httpapi.NewAbiHandler(&fakeAbiReader{}, nil)
+
+	assert.Equal(t, http.StatusBadRequest, abiRequest(t, h, "/abi/not-a-hash", nil).Code)
+	assert.Equal(t, http.StatusBadRequest, abiRequest(t, h, "/abi/"+strings.Repeat("g", 64), nil).Code)
+
+	rec := abiRequest(t, h, "/abi/"+string(abiHash), nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+}
+
+func TestAbiHandler_RateLimitsPerClient(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	limiter := httpapi.NewRateLimiter(60, 2, false).WithClock(func() time.Time { return now })
+	h := httpapi.NewAbiHandler(&fakeAbiReader{abis: map[domain.Sha256][]byte{abiHash: []byte(`[]`)}}, limiter)
+
+	from := func(addr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/abi/"+string(abiHash), nil)
+		req.RemoteAddr = addr
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, from("203.0.113.1:4000").Code)
+	assert.Equal(t, http.StatusOK, from("203.0.113.1:4001").Code)
+	rec := from("203.0.113.1:4002")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	// Other clients have their own bucket
+	assert.Equal(t, http.StatusOK, from("203.0.113.2:4000").Code)
+
+	// One token a second refills
+	now = now.Add(time.Second)
+	assert.Equal(t, http.StatusOK, from("203.0.113.1:4003").Code)
+}
+
+func TestRateLimiter_SetLimitsWhileServing(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	limiter := httpapi.NewRateLimiter(60, 5, false).WithClock(func() time.Time { return now })
+
+	ok, _ := limiter.Allow("203.0.113.1")
+	assert.True(t, ok)
+
+	// A smaller burst trims buckets that already hold more
+	limiter.SetLimits(60, 1)
+	ok, _ = limiter.Allow("203.0.113.1")
+	assert.True(t, ok)
+	ok, wait := limiter.Allow("203.0.113.1")
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, wait)
+
+	// Zero per minute turns limiting off
+	limiter.SetLimits(0, 1)
+	ok, _ = limiter.Allow("203.0.113.1")
+	assert.True(t, ok)
+}
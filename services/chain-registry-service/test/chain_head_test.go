@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryChainHeadStore keeps heads in a map
+type memoryChainHeadStore struct {
+	heads map[domain.ChainID]domain.ChainHead
+}
+
+func (s *memoryChainHeadStore) WriteChainHead(ctx context.Context, head domain.ChainHead) error {
+	if s.heads == nil {
+		s.heads = make(map[domain.ChainID]domain.ChainHead)
+	}
+	s.heads[head.ChainID] = head
+	return nil
+}
+
+func (s *memoryChainHeadStore) ReadChainHead(ctx context.Context, chainID domain.ChainID) (domain.ChainHead, error) {
+	head, ok := s.heads[chainID]
+	if !ok {
+		return head, domain.ErrChainHeadNotFound
+	}
+	return head, nil
+}
+
+func TestService_GetChainHead(t *testing.T) {
+	ctx := context.Background()
+	headsService := func(blockTimeMs uint32) (*service.Service, *memoryChainHeadStore) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("GetContracts", ctx, mock.Anything).Return(&domain.ChainContracts{
+			ChainID: "eip155:1",
+			Params:  domain.ChainParams{BlockTimeMs: blockTimeMs},
+		}, nil).Maybe()
+		store := &memoryChainHeadStore{}
+		svc := service.New(mockRepo, nil).(*service.Service)
+		svc.SetChainHeads(store, 2*time.Minute)
+		return svc, store
+	}
+
+	t.Run("not found before the indexer reports a head", func(t *testing.T) {
+		svc, _ := headsService(12000)
+
+		_, err := svc.GetChainHead(ctx, "eip155:1")
+
+		assert.ErrorIs(t, err, domain.ErrChainHeadNotFound)
+	})
+
+	t.Run("not found without a store", func(t *testing.T) {
+		svc := service.New(new(MockRepository), nil)
+
+		_, err := svc.GetChainHead(ctx, "eip155:1")
+
+		assert.ErrorIs(t, err, domain.ErrChainHeadNotFound)
+	})
+
+	t.Run("reports a head stale once it stops advancing", func(t *testing.T) {
+		svc, _ := headsService(12000)
+		require.NoError(t, svc.HandleChainHeadAdvanced(ctx, domain.ChainHead{ChainID: "eip155:1", LatestBlock: 100, AdvancedAt: time.Now().Add(-30 * time.Second)}))
+
+		head, err := svc.GetChainHead(ctx, "eip155:1")
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), head.LatestBlock)
+		assert.False(t, head.Stale)
+
+		require.NoError(t, svc.HandleChainHeadAdvanced(ctx, domain.ChainHead{ChainID: "eip155:1", LatestBlock: 101, AdvancedAt: time.Now().Add(-3 * time.Minute)}))
+		head, err = svc.GetChainHead(ctx, "eip155:1")
+		require.NoError(t, err)
+		assert.True(t, head.Stale)
+	})
+
+	t.Run("slow chains get ten block times", func(t *testing.T) {
+		svc, _ := headsService(30000)
+		require.NoError(t, svc.HandleChainHeadAdvanced(ctx, domain.ChainHead{ChainID: "eip155:1", LatestBlock: 100, AdvancedAt: time.Now().Add(-3 * time.Minute)}))
+
+		head, err := svc.GetChainHead(ctx, "eip155:1")
+
+		require.NoError(t, err)
+		assert.False(t, head.Stale)
+	})
+
+	t.Run("older heads never replace a later one", func(t *testing.T) {
+		svc, store := headsService(12000)
+		require.NoError(t, svc.HandleChainHeadAdvanced(ctx, domain.ChainHead{ChainID: "eip155:1", LatestBlock: 100, LatestBlockHash: "0xnew"}))
+		require.NoError(t, svc.HandleChainHeadAdvanced(ctx, domain.ChainHead{ChainID: "eip155:1", LatestBlock: 99, LatestBlockHash: "0xold"}))
+		require.NoError(t, svc.HandleChainHeadAdvanced(ctx, domain.ChainHead{ChainID: "eip155:1", LatestBlock: 100, LatestBlockHash: "0xredelivered"}))
+
+		assert.Equal(t, "0xnew", store.heads["eip155:1"].LatestBlockHash)
+	})
+}
@@ -0,0 +1,12 @@
+package test
+
+import (
+	"testing"
+
+	chainpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/protocheck"
+)
+
+func TestProtoContract(t *testing.T) {
+	protocheck.Check(t, chainpb.File_chain_registry_proto)
+}
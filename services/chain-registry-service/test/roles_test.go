@@ -0,0 +1,111 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_GetContractByRole(t *testing.T) {
+	ctx := context.Background()
+	chainContracts := func(list ...domain.Contract) *domain.ChainContracts {
+		return &domain.ChainContracts{ChainID: "eip155:1", Contracts: list, RegistryVersion: "1.0.7"}
+	}
+	erc721Factory := domain.Contract{Name: "ERC721CollectionFactory", Address: "0x1111111111111111111111111111111111111111", Roles: []string{contracts.RoleERC721Factory, contracts.RoleFactory}}
+	erc1155Factory := domain.Contract{Name: "ERC1155CollectionFactory", Address: "0x2222222222222222222222222222222222222222", Roles: []string{contracts.RoleERC1155Factory, contracts.RoleFactory}}
+	auctionHouse := domain.Contract{Name: "AuctionHouse", Address: "0x3333333333333333333333333333333333333333", Roles: []string{contracts.RoleAuctionHouse}}
+
+	t.Run("returns the contract holding the role", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+		mockRepo.On("GetContracts", ctx, "eip155:1").Return(chainContracts(erc721Factory, erc1155Factory, auctionHouse), nil)
+
+		meta, err := svc.GetContractByRole(ctx, "eip155:1", contracts.RoleAuctionHouse)
+
+		assert.NoError(t, err)
+		assert.Equal(t, auctionHouse.Address, meta.Contract.Address)
+		assert.Equal(t, "1.0.7", meta.RegistryVersion)
+	})
+
+	t.Run("fails when no contract holds the role", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+		mockRepo.On("GetContracts", ctx, "eip155:1").Return(chainContracts(erc721Factory), nil)
+
+		_, err := svc.GetContractByRole(ctx, "eip155:1", contracts.RoleMarketplace)
+
+		assert.ErrorIs(t, err, domain.ErrRoleNotAssigned)
+	})
+
+	t.Run("fails when several contracts hold the role", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+		mockRepo.On("GetContracts", ctx, "eip155:1").Return(chainContracts(erc721Factory, erc1155Factory), nil)
+
+		_, err := svc.GetContractByRole(ctx, "eip155:1", contracts.RoleFactory)
+
+		assert.ErrorIs(t, err, domain.ErrRoleAmbiguous)
+	})
+
+	t.Run("rejects malformed roles", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+
+		for _, role := range []string{"", "Factory", "-factory", "auction house"} {
+			_, err := svc.GetContractByRole(ctx, "eip155:1", role)
+			assert.Error(t, err, role)
+		}
+		mockRepo.AssertNotCalled(t, "GetContracts", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_SetContractRoles(t *testing.T) {
+	ctx := context.Background()
+	address := "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+
+	t.Run("stores sorted unique roles and announces the new version", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPublisher := new(MockPublisher)
+		svc := service.New(mockRepo, mockPublisher)
+
+		mockRepo.On("SetContractRoles", ctx, "eip155:1", address, []string{"factory", "forwarder"}).Return("1.0.11", nil)
+		mockPublisher.On("PublishRegistryChanged", ctx, mock.MatchedBy(func(c *domain.RegistryChange) bool {
+			return c.RegistryVersion == "1.0.11" && c.Reason == "new forwarder"
+		})).Return(nil)
+
+		version, err := svc.SetContractRoles(ctx, "eip155:1", "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+			[]string{contracts.RoleForwarder, contracts.RoleFactory, contracts.RoleForwarder}, "new forwarder")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.11", version)
+		mockRepo.AssertExpectations(t)
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("clears roles", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+		mockRepo.On("SetContractRoles", ctx, "eip155:1", address, []string{}).Return("1.0.12", nil)
+
+		_, err := svc.SetContractRoles(ctx, "eip155:1", address, nil, "retired")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects malformed roles and missing reasons", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+
+		_, err := svc.SetContractRoles(ctx, "eip155:1", address, []string{"Auction_House"}, "typo")
+		assert.Error(t, err)
+		_, err = svc.SetContractRoles(ctx, "eip155:1", address, []string{contracts.RoleMarketplace}, "")
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "SetContractRoles", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -2,12 +2,14 @@ package test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/chain-registry-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -33,6 +35,14 @@ func (m *MockRepository) GetGasPolicy(ctx context.Context, chainID domain.ChainI
 	return args.Get(0).(*domain.ChainGasPolicy), args.Error(1)
 }
 
+func (m *MockRepository) GetChainCapabilities(ctx context.Context, chainID domain.ChainID) (*domain.ChainCapabilitiesInfo, error) {
+	args := m.Called(ctx, chainID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ChainCapabilitiesInfo), args.Error(1)
+}
+
 func (m *MockRepository) GetRpcEndpoints(ctx context.Context, chainID domain.ChainID) (*domain.ChainRpcEndpoints, error) {
 	args := m.Called(ctx, chainID)
 	if args.Get(0) == nil {
@@ -70,6 +80,45 @@ func (m *MockRepository) ResolveProxy(ctx context.Context, chainID domain.ChainI
 	return args.String(0), args.String(1), args.Error(2)
 }
 
+func (m *MockRepository) ReplaceAbi(ctx context.Context, change *domain.AbiChange, abiJSON []byte) error {
+	args := m.Called(ctx, change, abiJSON)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RegisterCollection(ctx context.Context, chainID domain.ChainID, contract domain.Contract) (*domain.Contract, bool, string, error) {
+	args := m.Called(ctx, chainID, contract)
+	var registered *domain.Contract
+	if args.Get(0) != nil {
+		registered = args.Get(0).(*domain.Contract)
+	}
+	return registered, args.Bool(1), args.String(2), args.Error(3)
+}
+
+func (m *MockRepository) SetMintFunction(ctx context.Context, chainID domain.ChainID, address domain.Address, fn *domain.MintFunction, reason string) (string, error) {
+	args := m.Called(ctx, chainID, address, fn, reason)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockRepository) SetContractRoles(ctx context.Context, chainID domain.ChainID, address domain.Address, roles []string) (string, error) {
+	args := m.Called(ctx, chainID, address, roles)
+	return args.String(0), args.Error(1)
+}
+
+// MockPublisher implements domain.EventPublisher for testing
+type MockPublisher struct {
+	mock.Mock
+}
+
+func (m *MockPublisher) PublishAbiChanged(ctx context.Context, change *domain.AbiChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) PublishRegistryChanged(ctx context.Context, change *domain.RegistryChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
 func TestService_GetContracts(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -119,7 +168,7 @@ func TestService_GetContracts(t *testing.T) {
 			mockRepo := new(MockRepository)
 			tt.setupMock(mockRepo)
 
-			svc := service.New(mockRepo)
+			svc := service.New(mockRepo, nil)
 			result, err := svc.GetContracts(context.Background(), tt.chainID)
 
 			if tt.expectError {
@@ -180,7 +229,7 @@ func TestService_GetGasPolicy(t *testing.T) {
 			mockRepo := new(MockRepository)
 			tt.setupMock(mockRepo)
 
-			svc := service.New(mockRepo)
+			svc := service.New(mockRepo, nil)
 			result, err := svc.GetGasPolicy(context.Background(), tt.chainID)
 
 			if tt.expectError {
@@ -198,6 +247,74 @@ func TestService_GetGasPolicy(t *testing.T) {
 	}
 }
 
+func TestService_GetChainCapabilities(t *testing.T) {
+	tests := []struct {
+		name        string
+		chainID     domain.ChainID
+		setupMock   func(*MockRepository)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:    "successful get chain capabilities",
+			chainID: "eip155:1",
+			setupMock: func(mockRepo *MockRepository) {
+				expected := &domain.ChainCapabilitiesInfo{
+					ChainID:         "eip155:1",
+					Capabilities:    domain.DefaultChainCapabilities,
+					RegistryVersion: "1.0.0",
+				}
+				mockRepo.On("GetChainCapabilities", mock.Anything, "eip155:1").Return(expected, nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "unknown chain",
+			chainID: "eip155:999",
+			setupMock: func(mockRepo *MockRepository) {
+				mockRepo.On("GetChainCapabilities", mock.Anything, "eip155:999").Return(nil, fmt.Errorf("chain not found: eip155:999"))
+			},
+			expectError: true,
+			errorMsg:    "chain not found",
+		},
+		{
+			name:    "invalid chain ID",
+			chainID: "eip155",
+			setupMock: func(mockRepo *MockRepository) {
+				// No mock setup needed as validation should fail first
+			},
+			expectError: true,
+			errorMsg:    "invalid chain ID format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			tt.setupMock(mockRepo)
+
+			svc := service.New(mockRepo, nil)
+			result, err := svc.GetChainCapabilities(context.Background(), tt.chainID)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+				assert.Equal(t, tt.chainID, result.ChainID)
+				assert.True(t, result.Capabilities.ERC721Factory)
+				assert.False(t, result.Capabilities.ERC1155Factory)
+				assert.False(t, result.Capabilities.AllowlistMint)
+				assert.False(t, result.Capabilities.LazyMint)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_GetRpcEndpoints(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -243,7 +360,7 @@ func TestService_GetRpcEndpoints(t *testing.T) {
 			mockRepo := new(MockRepository)
 			tt.setupMock(mockRepo)
 
-			svc := service.New(mockRepo)
+			svc := service.New(mockRepo, nil)
 			result, err := svc.GetRpcEndpoints(context.Background(), tt.chainID)
 
 			if tt.expectError {
@@ -302,7 +419,7 @@ func TestService_BumpVersion(t *testing.T) {
 			mockRepo := new(MockRepository)
 			tt.setupMock(mockRepo)
 
-			svc := service.New(mockRepo)
+			svc := service.New(mockRepo, nil)
 			ok, newVersion, err := svc.BumpVersion(context.Background(), tt.chainID, tt.reason)
 
 			if tt.expectError {
@@ -321,6 +438,26 @@ func TestService_BumpVersion(t *testing.T) {
 	}
 }
 
+func TestService_BumpVersion_PublishesRegistryChanged(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	mockPublisher := new(MockPublisher)
+	svc := service.New(mockRepo, mockPublisher)
+
+	mockRepo.On("BumpVersion", ctx, "eip155:1", "rotate rpc").Return("1.0.42", nil)
+	mockPublisher.On("PublishRegistryChanged", ctx, mock.MatchedBy(func(c *domain.RegistryChange) bool {
+		return c.ChainID == "eip155:1" && c.RegistryVersion == "1.0.42" && c.Reason == "rotate rpc"
+	})).Return(errors.New("broker down"))
+
+	ok, newVersion, err := svc.BumpVersion(ctx, "eip155:1", "rotate rpc")
+
+	// The bump is stored, so a failed announcement does not fail the call
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "1.0.42", newVersion)
+	mockPublisher.AssertExpectations(t)
+}
+
 func TestService_GetContractMeta(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -371,7 +508,7 @@ func TestService_GetContractMeta(t *testing.T) {
 			mockRepo := new(MockRepository)
 			tt.setupMock(mockRepo)
 
-			svc := service.New(mockRepo)
+			svc := service.New(mockRepo, nil)
 			result, err := svc.GetContractMeta(context.Background(), tt.chainID, tt.address)
 
 			if tt.expectError {
@@ -392,7 +529,7 @@ func TestService_GetContractMeta(t *testing.T) {
 
 func TestService_GetAbiBlob(t *testing.T) {
 	mockRepo := &MockRepository{}
-	svc := service.New(mockRepo)
+	svc := service.New(mockRepo, nil)
 
 	ctx := context.Background()
 	sha := domain.Sha256("abc123")
@@ -434,7 +571,7 @@ func TestService_GetAbiBlob(t *testing.T) {
 
 func TestService_ResolveProxy(t *testing.T) {
 	mockRepo := &MockRepository{}
-	svc := service.New(mockRepo)
+	svc := service.New(mockRepo, nil)
 
 	ctx := context.Background()
 	chainID := domain.ChainID("eip155:1")
@@ -483,3 +620,126 @@ func TestService_ResolveProxy(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestService_RegisterCollection(t *testing.T) {
+	ctx := context.Background()
+	address := "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+	want := domain.Contract{
+		Name:       "collection-" + address,
+		Address:    address,
+		Standard:   domain.StdERC721,
+		StartBlock: 1200,
+	}
+
+	t.Run("registers the collection and announces the new version", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPublisher := new(MockPublisher)
+		svc := service.New(mockRepo, mockPublisher)
+
+		registered := want
+		registered.Imported = true
+		mockRepo.On("RegisterCollection", ctx, "eip155:1", want).Return(&registered, true, "1.0.9", nil)
+		mockPublisher.On("PublishRegistryChanged", ctx, mock.MatchedBy(func(c *domain.RegistryChange) bool {
+			return c.ChainID == "eip155:1" && c.RegistryVersion == "1.0.9" && c.Reason == "collection import"
+		})).Return(nil)
+
+		contract, created, version, err := svc.RegisterCollection(ctx, "eip155:1", domain.Contract{
+			Address:    "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+			Standard:   domain.StdERC721,
+			StartBlock: 1200,
+		}, "collection import")
+
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, "1.0.9", version)
+		assert.True(t, contract.Imported)
+		mockRepo.AssertExpectations(t)
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("already registered address is not announced", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPublisher := new(MockPublisher)
+		svc := service.New(mockRepo, mockPublisher)
+
+		mockRepo.On("RegisterCollection", ctx, "eip155:1", want).Return(&want, false, "", nil)
+
+		_, created, _, err := svc.RegisterCollection(ctx, "eip155:1", want, "collection import")
+
+		assert.NoError(t, err)
+		assert.False(t, created)
+		mockPublisher.AssertNotCalled(t, "PublishRegistryChanged", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects other standards", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+
+		_, _, _, err := svc.RegisterCollection(ctx, "eip155:1", domain.Contract{Address: address, Standard: domain.StdProxy}, "collection import")
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "RegisterCollection", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_SetMintFunction(t *testing.T) {
+	ctx := context.Background()
+	address := "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+	erc721A := &domain.MintFunction{
+		Name:         "mint",
+		Args:         []contracts.MintArg{contracts.MintArgQuantity},
+		Payable:      true,
+		UnitPriceWei: "10000000000000000",
+		MaxPerTx:     5,
+	}
+	meta := func(standard domain.ContractStandard) *domain.ContractMeta {
+		return &domain.ContractMeta{ChainID: "eip155:1", Contract: domain.Contract{Address: address, Standard: standard}}
+	}
+
+	t.Run("stores the descriptor and announces the new version", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPublisher := new(MockPublisher)
+		svc := service.New(mockRepo, mockPublisher)
+
+		mockRepo.On("GetContractMeta", ctx, "eip155:1", address).Return(meta(domain.StdERC721), nil)
+		mockRepo.On("SetMintFunction", ctx, "eip155:1", address, erc721A, "erc721a collection").Return("1.0.10", nil)
+		mockPublisher.On("PublishRegistryChanged", ctx, mock.MatchedBy(func(c *domain.RegistryChange) bool {
+			return c.RegistryVersion == "1.0.10"
+		})).Return(nil)
+
+		version, err := svc.SetMintFunction(ctx, "eip155:1", "0x5FbDB2315678afecb367f032d93F642f64180aa3", erc721A, "erc721a collection")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.10", version)
+		mockRepo.AssertExpectations(t)
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid descriptors", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+
+		for _, fn := range []*domain.MintFunction{
+			{Name: "mint(uint256)", Args: []contracts.MintArg{contracts.MintArgQuantity}},
+			{Name: "mint", Args: []contracts.MintArg{"amount"}},
+			{Name: "mint", Args: []contracts.MintArg{contracts.MintArgQuantity, contracts.MintArgQuantity}},
+			{Name: "mint", UnitPriceWei: "100"},
+			{Name: "mint", Payable: true, UnitPriceWei: "0.01"},
+		} {
+			_, err := svc.SetMintFunction(ctx, "eip155:1", address, fn, "bad descriptor")
+			assert.Error(t, err, fn)
+		}
+		mockRepo.AssertNotCalled(t, "SetMintFunction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects contracts that are not collections", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := service.New(mockRepo, nil)
+
+		mockRepo.On("GetContractMeta", ctx, "eip155:1", address).Return(meta(domain.StdCustom), nil)
+
+		_, err := svc.SetMintFunction(ctx, "eip155:1", address, erc721A, "factory")
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "SetMintFunction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
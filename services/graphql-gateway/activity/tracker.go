@@ -0,0 +1,92 @@
+// Package activity marks the viewer's wallets as seen while they use the gateway, so the
+// wallet service can report daily active wallets without the frontend sending anything
+package activity
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+// DefaultInterval is how often one user's wallets are touched at most
+const DefaultInterval = 15 * time.Minute
+
+// touchTimeout bounds a touch, which runs after the operation has been answered
+const touchTimeout = 5 * time.Second
+
+// Tracker touches a user's wallets on their first operation and then at most once per
+// interval. Sessions of admins impersonating the user are not the user's activity.
+type Tracker struct {
+	client   walletpb.WalletServiceClient
+	interval time.Duration
+
+	mu     sync.Mutex
+	seen   map[string]time.Time
+	pruned time.Time
+}
+
+// NewTracker creates a tracker; interval <= 0 uses DefaultInterval
+func NewTracker(client walletpb.WalletServiceClient, interval time.Duration) *Tracker {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Tracker{
+		client:   client,
+		interval: interval,
+		seen:     make(map[string]time.Time),
+		pruned:   time.Now(),
+	}
+}
+
+// AroundOperations is a gqlgen operation middleware touching the authenticated viewer's wallets
+func (t *Tracker) AroundOperations(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if user := middleware.GetCurrentUser(ctx); user != nil && !user.IsImpersonated() {
+		t.Touch(user.UserID)
+	}
+	return next(ctx)
+}
+
+// Touch asks the wallet service to mark the user's wallets as seen, unless it was asked
+// within the interval. It reports whether a touch was sent; the call itself is asynchronous.
+func (t *Tracker) Touch(userID string) bool {
+	if userID == "" || t.client == nil {
+		return false
+	}
+
+	now := time.Now()
+	t.mu.Lock()
+	if last, ok := t.seen[userID]; ok && now.Sub(last) < t.interval {
+		t.mu.Unlock()
+		return false
+	}
+	t.seen[userID] = now
+	t.prune(now)
+	t.mu.Unlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), touchTimeout)
+		defer cancel()
+		if _, err := t.client.TouchWallets(ctx, &walletpb.TouchWalletsRequest{UserId: userID}); err != nil {
+			log.Printf("Failed to touch wallets of user %s: %v", userID, err)
+		}
+	}()
+	return true
+}
+
+// prune forgets users not seen within the interval, once per interval; callers hold mu
+func (t *Tracker) prune(now time.Time) {
+	if now.Sub(t.pruned) < t.interval {
+		return
+	}
+	for userID, last := range t.seen {
+		if now.Sub(last) >= t.interval {
+			delete(t.seen, userID)
+		}
+	}
+	t.pruned = now
+}
@@ -0,0 +1,89 @@
+// Package artifacts serves generated files such as holder snapshot exports at
+// /artifacts/{id}?expires=...&sig=... . The signature is issued and checked by the media
+// service, so the route needs no session and links can be handed to other tools. Gated
+// artifacts also need a gate token from verifyTokenGate, sent in the X-Gate-Token header or
+// the gate query parameter.
+package artifacts
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Route is the path prefix the handler is mounted on
+const Route = "/artifacts/"
+
+// Handler streams signed artifact downloads from the media service
+type Handler struct {
+	media media.MediaServiceClient
+}
+
+// NewHandler creates an artifact download handler
+func NewHandler(mediaClient media.MediaServiceClient) *Handler {
+	return &Handler{media: mediaClient}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, Route), "/")
+	query := r.URL.Query()
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if id == "" || strings.Contains(id, "/") || err != nil || query.Get("sig") == "" {
+		http.Error(w, "invalid download link", http.StatusBadRequest)
+		return
+	}
+
+	// Links may be shared, but the content must not be cached by anyone on the way
+	w.Header().Set("Cache-Control", "private, no-store")
+
+	resp, err := h.media.DownloadArtifact(r.Context(), &media.DownloadArtifactRequest{
+		Id:        id,
+		Expires:   expires,
+		Signature: query.Get("sig"),
+		GateToken: gateToken(r),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied, codes.InvalidArgument:
+			http.Error(w, "download link is invalid or expired", http.StatusForbidden)
+		case codes.NotFound:
+			http.Error(w, "artifact not found", http.StatusNotFound)
+		default:
+			log.Printf("artifacts: failed to download %s: %v", id, err)
+			http.Error(w, "failed to load artifact", http.StatusBadGateway)
+		}
+		return
+	}
+
+	artifact := resp.GetArtifact()
+	w.Header().Set("Content-Type", artifact.GetMime())
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.GetContent())))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", artifact.GetName()))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write(resp.GetContent()); err != nil {
+		log.Printf("artifacts: failed to write %s: %v", id, err)
+	}
+}
+
+// gateToken prefers the header, which keeps the token out of logs and shared links
+func gateToken(r *http.Request) string {
+	if token := r.Header.Get("X-Gate-Token"); token != "" {
+		return token
+	}
+	return r.URL.Query().Get("gate")
+}
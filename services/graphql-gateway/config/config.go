@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/shared/env"
 )
@@ -15,9 +16,39 @@ type Config struct {
 	MediaServiceURL         string
 	ChainRegistryServiceURL string
 	OrchestratorServiceURL  string
+	CatalogServiceURL       string
 	SubscriptionWorkerWSURL string
+
+	// OperationMode is OperationModeAPQ, which runs any query, or OperationModeAllowlist,
+	// which only runs those in PersistedManifestPath and turns off introspection and the
+	// playground
+	OperationMode         string
+	PersistedManifestPath string
+
+	// WalletSeenInterval is how often a signed-in user's wallets are marked as seen at most
+	WalletSeenInterval time.Duration
+
+	// StatusChains are the chains whose indexer lag platformStatus reports; the snapshot
+	// is served for StatusTTL and queues read as degraded from StatusQueueBacklog messages
+	StatusChains       []string
+	StatusTTL          time.Duration
+	StatusQueueBacklog int
+
+	// MetricsAddr serves operation metrics apart from the public listener; empty disables it
+	MetricsAddr string
+	// LogOperations logs every operation; operations taking at least SlowQueryThreshold are
+	// also logged with their slowest resolvers, SlowQuerySampleRate of them
+	LogOperations       bool
+	SlowQueryThreshold  time.Duration
+	SlowQuerySampleRate float64
 }
 
+// Operation modes
+const (
+	OperationModeAPQ       = "apq"
+	OperationModeAllowlist = "allowlist"
+)
+
 // LoadConfig loads configuration from environment variables
 func LoadConfig() *Config {
 	log.Println("Loading GraphQL Gateway configuration...")
@@ -30,7 +61,18 @@ func LoadConfig() *Config {
 		MediaServiceURL:         env.GetString("MEDIA_SERVICE_URL", "media-service:50055"),
 		ChainRegistryServiceURL: env.GetString("CHAIN_REGISTRY_SERVICE_URL", "chain-registry-service:50056"),
 		OrchestratorServiceURL:  env.GetString("ORCHESTRATOR_SERVICE_URL", "orchestrator-service:50054"),
+		CatalogServiceURL:       env.GetString("CATALOG_SERVICE_URL", "catalog-service:50057"),
 		SubscriptionWorkerWSURL: env.GetString("SUBSCRIPTION_WORKER_WS_URL", "ws://subscription-worker:8080/ws"),
+		OperationMode:           env.GetString("GRAPHQL_OPERATION_MODE", OperationModeAPQ),
+		PersistedManifestPath:   env.GetString("GRAPHQL_PERSISTED_MANIFEST", ""),
+		WalletSeenInterval:      time.Duration(env.GetInt("WALLET_SEEN_INTERVAL_MINUTES", 15)) * time.Minute,
+		StatusChains:            env.GetStringList("STATUS_CHAINS", []string{"eip155-1", "eip155-11155111"}),
+		StatusTTL:               time.Duration(env.GetInt("STATUS_TTL_SECONDS", 30)) * time.Second,
+		StatusQueueBacklog:      env.GetInt("STATUS_QUEUE_BACKLOG", 1000),
+		MetricsAddr:             env.GetString("GATEWAY_METRICS_ADDR", ":9091"),
+		LogOperations:           env.GetBool("GRAPHQL_LOG_OPERATIONS", true),
+		SlowQueryThreshold:      time.Duration(env.GetInt("GRAPHQL_SLOW_QUERY_MS", 0)) * time.Millisecond,
+		SlowQuerySampleRate:     env.GetFloat("GRAPHQL_SLOW_QUERY_SAMPLE_RATE", 1),
 	}
 
 	log.Printf("GraphQL Gateway config loaded - HTTP: %s, Orchestrator: %s",
@@ -63,6 +105,22 @@ func (c *Config) Validate() error {
 	if c.OrchestratorServiceURL == "" {
 		log.Fatal("ORCHESTRATOR_SERVICE_URL is required")
 	}
+	if c.CatalogServiceURL == "" {
+		log.Fatal("CATALOG_SERVICE_URL is required")
+	}
+	switch c.OperationMode {
+	case OperationModeAPQ:
+	case OperationModeAllowlist:
+		if c.PersistedManifestPath == "" {
+			log.Fatal("GRAPHQL_PERSISTED_MANIFEST is required when GRAPHQL_OPERATION_MODE is allowlist")
+		}
+	default:
+		log.Fatalf("GRAPHQL_OPERATION_MODE must be %s or %s, got %q", OperationModeAPQ, OperationModeAllowlist, c.OperationMode)
+	}
+
+	if c.SlowQuerySampleRate < 0 || c.SlowQuerySampleRate > 1 {
+		log.Fatalf("GRAPHQL_SLOW_QUERY_SAMPLE_RATE must be between 0 and 1, got %v", c.SlowQuerySampleRate)
+	}
 
 	log.Println("GraphQL Gateway configuration validation passed")
 	return nil
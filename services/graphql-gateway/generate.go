@@ -0,0 +1,54 @@
+//go:build ignore
+
+// Regenerates the gateway's gqlgen code: go run generate.go (from this directory).
+// It runs the stock generator with a modelgen field hook that documents fields
+// typed by the shared scalars in scalars.graphql.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/plugin/modelgen"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func main() {
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to load config:", err)
+		os.Exit(2)
+	}
+
+	p := modelgen.Plugin{
+		MutateHook: modelgen.DefaultBuildMutateHook,
+		FieldHook:  sharedScalarFieldHook(cfg),
+	}
+	if err := api.Generate(cfg, api.ReplacePlugin(&p)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(3)
+	}
+}
+
+// sharedScalarFieldHook copies a shared scalar's description onto undocumented
+// model fields of that type, so the string-backed Go field still says what it holds
+func sharedScalarFieldHook(cfg *config.Config) modelgen.FieldMutateHook {
+	return func(td *ast.Definition, fd *ast.FieldDefinition, f *modelgen.Field) (*modelgen.Field, error) {
+		f, err := modelgen.DefaultFieldMutateHook(td, fd, f)
+		if err != nil || f.Description != "" {
+			return f, err
+		}
+		def := cfg.Schema.Types[fd.Type.Name()]
+		if def == nil || def.Kind != ast.Scalar || def.BuiltIn || def.Description == "" {
+			return f, nil
+		}
+		if def.Position == nil || def.Position.Src == nil || filepath.Base(def.Position.Src.Name) != "scalars.graphql" {
+			return f, nil
+		}
+		f.Description = fmt.Sprintf("%s: %s", def.Name, def.Description)
+		return f, nil
+	}
+}
@@ -0,0 +1,186 @@
+package graphql_resolver
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultActivityLimit = 20
+	maxActivityLimit     = 100
+)
+
+// activityPosition is the last item of one source already returned
+type activityPosition struct {
+	At time.Time `json:"t"`
+	ID string    `json:"id"`
+}
+
+// activityCursor keeps a position per source, since catalog and intent ids don't
+// share an ordering
+type activityCursor struct {
+	Catalog *activityPosition `json:"c,omitempty"`
+	Intents *activityPosition `json:"i,omitempty"`
+}
+
+// activityEntry is a timeline item with the source position it advances
+type activityEntry struct {
+	item     *schemas.WalletActivity
+	at       time.Time
+	id       string
+	isIntent bool
+}
+
+// WalletActivity merges the wallet's indexed transfers and sales with the intents it was
+// asked to sign, newest first. Each source is read one page past its own cursor position.
+func (r *CatalogQueryResolver) WalletActivity(ctx context.Context, address string, cursor *string, limit *int) (*schemas.WalletActivityPage, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	n := defaultActivityLimit
+	if limit != nil && *limit > 0 {
+		n = *limit
+	}
+	if n > maxActivityLimit {
+		n = maxActivityLimit
+	}
+
+	var pos activityCursor
+	if cursor != nil && *cursor != "" {
+		if pos, err = decodeActivityCursor(*cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	links, err := (*r.server.walletClient.Client).ListLinks(ctx, &walletpb.ListLinksRequest{UserId: user.UserID})
+	if err != nil {
+		return nil, err
+	}
+	owned := false
+	for _, link := range links.GetLinks() {
+		// A watch-only wallet's intents belong to whoever signed for it
+		if !link.GetIsWatchOnly() && strings.EqualFold(link.GetAddress(), address) {
+			owned = true
+			break
+		}
+	}
+	if !owned {
+		return nil, fmt.Errorf("address is not linked to your account")
+	}
+
+	activityReq := &catalogpb.ListWalletActivityRequest{Address: address, Limit: int32(n)}
+	if pos.Catalog != nil {
+		activityReq.Before = timestamppb.New(pos.Catalog.At)
+		activityReq.BeforeId = pos.Catalog.ID
+	}
+	activityResp, err := (*r.server.catalogClient.Client).ListWalletActivity(ctx, activityReq)
+	if err != nil {
+		return nil, err
+	}
+
+	intentsReq := &orchestratorpb.ListIntentsRequest{Signer: address, Limit: int32(n)}
+	if pos.Intents != nil {
+		intentsReq.Before = timestamppb.New(pos.Intents.At)
+		intentsReq.BeforeId = pos.Intents.ID
+	}
+	intentsResp, err := (*r.server.orchestratorClient.Client).ListIntents(ctx, intentsReq)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, intents := activityResp.GetActivities(), intentsResp.GetIntents()
+	entries := make([]activityEntry, 0, len(activities)+len(intents))
+	for _, a := range activities {
+		entries = append(entries, activityEntry{item: utils.MapWalletActivity(a), at: a.GetOccurredAt().AsTime(), id: a.GetId()})
+	}
+	for _, it := range intents {
+		entries = append(entries, activityEntry{item: utils.MapIntentActivity(it), at: it.GetCreatedAt().AsTime(), id: it.GetIntentId(), isIntent: true})
+	}
+	page, next := mergeActivity(entries, n, pos)
+
+	out := &schemas.WalletActivityPage{Items: page}
+	// A full page from either source may have more behind it
+	if len(entries) > len(page) || len(activities) == n || len(intents) == n {
+		encoded, err := encodeActivityCursor(next)
+		if err != nil {
+			return nil, err
+		}
+		out.NextCursor = &encoded
+	}
+	return out, nil
+}
+
+// mergeActivity takes the newest n entries and advances each source's position to the
+// last of its entries taken
+func mergeActivity(entries []activityEntry, n int, pos activityCursor) ([]*schemas.WalletActivity, activityCursor) {
+	sortActivityEntries(entries)
+	if len(entries) > n {
+		entries = entries[:n]
+	}
+
+	page := make([]*schemas.WalletActivity, len(entries))
+	for i, e := range entries {
+		page[i] = e.item
+		last := &activityPosition{At: e.at, ID: e.id}
+		if e.isIntent {
+			pos.Intents = last
+		} else {
+			pos.Catalog = last
+		}
+	}
+	return page, pos
+}
+
+// sortActivityEntries orders newest first, breaking ties by id descending like the services
+func sortActivityEntries(entries []activityEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].at.Equal(entries[j].at) {
+			return entries[i].at.After(entries[j].at)
+		}
+		return entries[i].id > entries[j].id
+	})
+}
+
+func encodeActivityCursor(c activityCursor) (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeActivityCursor(s string) (activityCursor, error) {
+	var c activityCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor")
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor")
+	}
+	return c, nil
+}
@@ -0,0 +1,207 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/websocket"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PrepareAirdrop splits an airdrop into batches the wallet sends one by one
+func (r *OrchestratorMutationResolver) PrepareAirdrop(ctx context.Context, input schemas.PrepareAirdropInput) (*schemas.AirdropBundle, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	req := &orchestratorpb.PrepareAirdropRequest{
+		ChainId:    input.ChainID,
+		Contract:   input.Contract,
+		UserId:     user.UserID,
+		Standard:   input.Standard,
+		TokenId:    utils.PtrStr(input.TokenID),
+		SnapshotId: utils.PtrStr(input.SnapshotID),
+	}
+	if input.AmountPerHolder != nil {
+		if *input.AmountPerHolder <= 0 {
+			return nil, fmt.Errorf("amountPerHolder must be positive")
+		}
+		req.AmountPerHolder = uint64(*input.AmountPerHolder)
+	}
+	for _, recipient := range input.Recipients {
+		amount := uint64(1)
+		if recipient.Amount != nil {
+			if *recipient.Amount <= 0 {
+				return nil, fmt.Errorf("recipient amounts must be positive")
+			}
+			amount = uint64(*recipient.Amount)
+		}
+		req.Recipients = append(req.Recipients, &orchestratorpb.AirdropRecipient{Address: recipient.Address, Amount: amount})
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).PrepareAirdrop(ctx, req)
+	if err != nil {
+		return nil, mapAirdropError(err, "failed to prepare airdrop")
+	}
+	return utils.MapAirdropBundle(resp), nil
+}
+
+func (r *OrchestratorQueryResolver) AirdropProgress(ctx context.Context, bundleID string) (*schemas.AirdropProgress, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	progress, err := r.server.fetchAirdropProgress(ctx, bundleID, user.UserID)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, mapAirdropError(err, "failed to get airdrop progress")
+	}
+	return progress, nil
+}
+
+// OnAirdropProgress pushes the bundle's progress whenever one of its batches changes status,
+// and ends once every batch is confirmed or failed
+func (r *OrchestratorSubscriptionResolver) OnAirdropProgress(ctx context.Context, bundleID string) (<-chan *schemas.AirdropProgress, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	initial, err := r.server.fetchAirdropProgress(ctx, bundleID, user.UserID)
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("airdrop not found")
+	}
+	if err != nil {
+		return nil, mapAirdropError(err, "failed to get airdrop progress")
+	}
+
+	// Batch updates only signal a refetch, so every push carries the whole bundle and
+	// the status cache stays the single source of truth
+	changed := make(chan struct{}, 1)
+	var intentIDs []string
+	if r.server.websocketClient != nil && r.server.websocketClient.IsConnected() {
+		for _, batch := range initial.Batches {
+			callback := func(string, *websocket.IntentStatusData) error {
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+				return nil
+			}
+			if err := r.server.websocketClient.Subscribe(batch.IntentID, callback); err != nil {
+				log.Printf("Failed to subscribe to airdrop batch %s: %v", batch.IntentID, err)
+				r.server.unsubscribeIntents(intentIDs)
+				intentIDs = nil
+				break
+			}
+			intentIDs = append(intentIDs, batch.IntentID)
+		}
+	}
+
+	progressChan := make(chan *schemas.AirdropProgress, 1)
+	progressChan <- initial
+	go func() {
+		defer close(progressChan)
+		defer r.server.unsubscribeIntents(intentIDs)
+
+		// Poll when the subscription worker cannot push batch updates
+		var poll <-chan time.Time
+		if intentIDs == nil {
+			log.Printf("WebSocket not available, falling back to polling for airdrop: %s", bundleID)
+			ticker := time.NewTicker(2 * time.Second)
+			defer ticker.Stop()
+			poll = ticker.C
+		}
+
+		current := initial
+		last := airdropFingerprint(current)
+		for !airdropSettled(current) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+			case <-poll:
+			}
+			progress, err := r.server.fetchAirdropProgress(ctx, bundleID, user.UserID)
+			if err != nil {
+				log.Printf("GetAirdropProgress error for %s: %v", bundleID, err)
+				continue
+			}
+			fp := airdropFingerprint(progress)
+			if fp == last {
+				continue
+			}
+			last = fp
+			current = progress
+			select {
+			case progressChan <- progress:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return progressChan, nil
+}
+
+func (r *Resolver) fetchAirdropProgress(ctx context.Context, bundleID, userID string) (*schemas.AirdropProgress, error) {
+	resp, err := (*r.orchestratorClient.Client).GetAirdropProgress(ctx, &orchestratorpb.GetAirdropProgressRequest{
+		BundleId: bundleID,
+		UserId:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapAirdropProgress(resp), nil
+}
+
+func (r *Resolver) unsubscribeIntents(intentIDs []string) {
+	for _, intentID := range intentIDs {
+		if err := r.websocketClient.Unsubscribe(intentID); err != nil {
+			log.Printf("Failed to unsubscribe from WebSocket for intent %s: %v", intentID, err)
+		}
+	}
+}
+
+// airdropSettled reports whether no batch can change status anymore
+func airdropSettled(p *schemas.AirdropProgress) bool {
+	return p.Confirmed+p.Failed >= p.BatchCount
+}
+
+// airdropFingerprint changes whenever any batch's status or tx does
+func airdropFingerprint(p *schemas.AirdropProgress) string {
+	fp := string(p.Status)
+	for _, batch := range p.Batches {
+		fp += fmt.Sprintf("|%s:%s", batch.Status, utils.PtrStr(batch.TxHash))
+	}
+	return fp
+}
+
+// mapAirdropError surfaces the orchestrator's reason for rejecting an airdrop, including
+// snapshots that are missing or not the caller's
+func mapAirdropError(err error, action string) error {
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("%s", status.Convert(err).Message())
+	}
+	return mapImportError(err, action)
+}
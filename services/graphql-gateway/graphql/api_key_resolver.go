@@ -0,0 +1,125 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+// MyAPIKeys lists the caller's API keys, revoked ones included
+func (r *AuthQueryResolver) MyAPIKeys(ctx context.Context) ([]*schemas.APIKey, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+
+	resp, err := (*r.server.authClient.Client).ListApiKeys(ctx, &authpb.ListApiKeysRequest{UserId: user.UserID})
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*schemas.APIKey, 0, len(resp.GetKeys()))
+	for _, k := range resp.GetKeys() {
+		keys = append(keys, utils.MapAPIKey(k))
+	}
+	return keys, nil
+}
+
+// MyAPIUsage reports the plan, today's use and hourly usage of each of the caller's keys
+func (r *AuthQueryResolver) MyAPIUsage(ctx context.Context, days *int) ([]*schemas.APIKeyUsage, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if days != nil && (*days < 1 || *days > 30) {
+		return nil, fmt.Errorf("days must be between 1 and 30")
+	}
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+
+	req := &authpb.GetApiUsageRequest{UserId: user.UserID}
+	if days != nil {
+		req.Days = int32(*days)
+	}
+	resp, err := (*r.server.authClient.Client).GetApiUsage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	usage := make([]*schemas.APIKeyUsage, 0, len(resp.GetKeys()))
+	for _, u := range resp.GetKeys() {
+		usage = append(usage, utils.MapAPIKeyUsage(u))
+	}
+	return usage, nil
+}
+
+// CreateAPIKey issues a key for the caller; a request made with an API key can't, so a
+// leaked key cannot mint more
+func (r *AuthMutationResolver) CreateAPIKey(ctx context.Context, name string) (*schemas.CreatedAPIKey, error) {
+	user, err := requireSessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+
+	resp, err := (*r.server.authClient.Client).CreateApiKey(ctx, &authpb.CreateApiKeyRequest{UserId: user.UserID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return &schemas.CreatedAPIKey{APIKey: utils.MapAPIKey(resp.GetKey()), Secret: resp.GetSecret()}, nil
+}
+
+func (r *AuthMutationResolver) RevokeAPIKey(ctx context.Context, id string) (bool, error) {
+	user, err := requireSessionUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	if r.server.authClient == nil {
+		return false, fmt.Errorf("auth service unavailable")
+	}
+
+	if _, err := (*r.server.authClient.Client).RevokeApiKey(ctx, &authpb.RevokeApiKeyRequest{UserId: user.UserID, KeyId: id}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *AuthMutationResolver) SetAPIKeyPlan(ctx context.Context, id string, plan schemas.APIPlan) (*schemas.APIKey, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+
+	resp, err := (*r.server.authClient.Client).SetApiKeyPlan(ctx, &authpb.SetApiKeyPlanRequest{
+		AdminUserId: admin.UserID,
+		KeyId:       id,
+		Plan:        string(plan),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapAPIKey(resp.GetKey()), nil
+}
+
+// requireSessionUser is RequireAuth for operations API keys may not perform
+func requireSessionUser(ctx context.Context) (*middleware.CurrentUser, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsAPIKey() {
+		return nil, fmt.Errorf("API keys cannot manage API keys")
+	}
+	return user, nil
+}
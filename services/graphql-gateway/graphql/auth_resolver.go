@@ -0,0 +1,334 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+// AuthQueryResolver resolves the Query fields declared in auth.graphql and base.graphql
+type AuthQueryResolver struct {
+	server *Resolver
+}
+
+// AuthMutationResolver resolves the Mutation fields declared in auth.graphql and base.graphql
+type AuthMutationResolver struct {
+	server *Resolver
+}
+
+func (r *AuthQueryResolver) Health(ctx context.Context) (string, error) {
+	return "ok", nil
+}
+
+// PlatformStatus is public; it only reports component levels, never error details
+func (r *AuthQueryResolver) PlatformStatus(ctx context.Context) (*schemas.PlatformStatus, error) {
+	if r.server.platformStatus == nil {
+		return nil, fmt.Errorf("platform status unavailable")
+	}
+	return utils.MapPlatformStatus(r.server.platformStatus.Snapshot()), nil
+}
+
+func (r *AuthQueryResolver) Me(ctx context.Context) (*schemas.User, error) {
+	// First, check if user is already authenticated via Bearer token
+	if user := middleware.GetCurrentUser(ctx); user != nil {
+		me := &schemas.User{
+			ID: user.UserID,
+		}
+		if user.IsImpersonated() {
+			me.Impersonation = &schemas.Impersonation{
+				ImpersonatorID: user.Impersonation.ImpersonatorID,
+				Reason:         user.Impersonation.Reason,
+				ExpiresAt:      user.Impersonation.ExpiresAt.UTC().Format(time.RFC3339),
+			}
+		}
+		return me, nil
+	}
+
+	// If no Bearer token, try silent refresh using cookie
+	if r.server.authClient == nil {
+		return nil, nil // Return null if auth service not available
+	}
+
+	// Get HTTP request from context
+	req := middleware.GetRequest(ctx)
+	if req == nil {
+		return nil, nil // Return null if request not available
+	}
+
+	// Try to get refresh token from cookie
+	refreshToken := middleware.GetRefreshTokenFromCookie(req)
+	if refreshToken == "" {
+		return nil, nil // Return null if no refresh token (not logged in)
+	}
+
+	// Get client info for audit
+	ip, userAgent := middleware.GetClientInfo(req)
+
+	// Try to refresh session silently
+	resp, err := (*r.server.authClient.Client).RefreshSession(ctx, &authpb.RefreshSessionRequest{
+		RefreshToken: refreshToken,
+		UserAgent:    userAgent,
+		IpAddress:    ip,
+	})
+	if err != nil {
+		// If refresh fails, clear the cookie and return null
+		if rw := middleware.GetResponseWriter(ctx); rw != nil {
+			middleware.ClearRefreshTokenCookie(rw)
+		}
+		return nil, nil
+	}
+
+	// Set new refresh token cookie
+	if rw := middleware.GetResponseWriter(ctx); rw != nil {
+		middleware.SetRefreshTokenCookie(rw, resp.GetRefreshToken())
+	}
+
+	// Return user info with new access token available for future requests
+	return &schemas.User{
+		ID: resp.GetUserId(),
+	}, nil
+}
+
+func (r *AuthMutationResolver) SignInSiwe(ctx context.Context, input schemas.SignInSiweInput) (*schemas.NoncePayload, error) {
+	// Validate input early
+	if input.AccountID == "" || input.ChainID == "" || input.Domain == "" {
+		return nil, fmt.Errorf("invalid sign in siwe input")
+	}
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+	nonceResponse, err := (*r.server.authClient.Client).GetNonce(ctx, &authpb.GetNonceRequest{
+		AccountId: input.AccountID,
+		ChainId:   input.ChainID,
+		Domain:    input.Domain,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.NoncePayload{Nonce: nonceResponse.Nonce}, nil
+}
+
+func (r *AuthMutationResolver) VerifySiwe(ctx context.Context, input schemas.VerifySiweInput) (*schemas.AuthPayload, error) {
+	if input.AccountID == "" || input.Message == "" || input.Signature == "" {
+		return nil, fmt.Errorf("invalid verify siwe input")
+	}
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+
+	verifyReq := &authpb.VerifySiweRequest{
+		AccountId: input.AccountID,
+		Message:   input.Message,
+		Signature: input.Signature,
+	}
+	// Client info for the session's location and the login audit
+	if req := middleware.GetRequest(ctx); req != nil {
+		verifyReq.IpAddress, verifyReq.UserAgent = middleware.GetClientInfo(req)
+	}
+
+	resp, err := (*r.server.authClient.Client).VerifySiwe(ctx, verifyReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set refresh token as httpOnly cookie for security
+	if rw := middleware.GetResponseWriter(ctx); rw != nil {
+		middleware.SetRefreshTokenCookie(rw, resp.GetRefreshToken())
+	}
+
+	return &schemas.AuthPayload{
+		AccessToken:  resp.GetAccessToken(),
+		RefreshToken: resp.GetRefreshToken(), // Also return in response body
+		ExpiresAt:    resp.GetExpiresAt(),
+		UserID:       resp.GetUserId(),
+	}, nil
+}
+
+func (r *AuthMutationResolver) RefreshSession(ctx context.Context) (*schemas.AuthPayload, error) {
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+
+	// Get HTTP request from context
+	req := middleware.GetRequest(ctx)
+	if req == nil {
+		return nil, fmt.Errorf("request not available")
+	}
+
+	// Get refresh token from httpOnly cookie
+	refreshToken := middleware.GetRefreshTokenFromCookie(req)
+	if refreshToken == "" {
+		return nil, fmt.Errorf("refresh token not found")
+	}
+
+	// Get client info for audit
+	ip, userAgent := middleware.GetClientInfo(req)
+
+	// Call auth service to refresh session
+	resp, err := (*r.server.authClient.Client).RefreshSession(ctx, &authpb.RefreshSessionRequest{
+		RefreshToken: refreshToken,
+		UserAgent:    userAgent,
+		IpAddress:    ip,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Set new refresh token cookie
+	if rw := middleware.GetResponseWriter(ctx); rw != nil {
+		middleware.SetRefreshTokenCookie(rw, resp.GetRefreshToken())
+	}
+
+	return &schemas.AuthPayload{
+		AccessToken:  resp.GetAccessToken(),
+		RefreshToken: resp.GetRefreshToken(),
+		ExpiresAt:    resp.GetExpiresAt(),
+		UserID:       resp.GetUserId(),
+	}, nil
+}
+
+func (r *AuthMutationResolver) Logout(ctx context.Context) (bool, error) {
+	if r.server.authClient == nil {
+		return false, fmt.Errorf("auth service unavailable")
+	}
+
+	// Check if user is authenticated via Bearer token
+	if user := middleware.GetCurrentUser(ctx); user != nil {
+		// Use session ID from JWT token for more precise logout
+		_, err := (*r.server.authClient.Client).RevokeSession(ctx, &authpb.RevokeSessionRequest{
+			SessionId: user.SessionID,
+		})
+		if err != nil {
+			// Log error but continue with cookie cleanup
+		}
+	}
+
+	// Also try to revoke using refresh token from cookie as fallback
+	req := middleware.GetRequest(ctx)
+	if req != nil {
+		refreshToken := middleware.GetRefreshTokenFromCookie(req)
+		if refreshToken != "" {
+			_, err := (*r.server.authClient.Client).RevokeSessionByRefreshToken(ctx, &authpb.RevokeSessionByRefreshTokenRequest{
+				RefreshToken: refreshToken,
+			})
+			if err != nil {
+				// Log error but still clear cookie - don't fail logout
+			}
+		}
+	}
+
+	// Clear refresh token cookie
+	if rw := middleware.GetResponseWriter(ctx); rw != nil {
+		middleware.ClearRefreshTokenCookie(rw)
+	}
+
+	return true, nil
+}
+
+// StartImpersonation issues a short-lived token acting as userID. Only admins may call it,
+// and not with an impersonation token of their own.
+func (r *AuthMutationResolver) StartImpersonation(ctx context.Context, userID string, reason string) (*schemas.ImpersonationPayload, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if admin.IsImpersonated() {
+		return nil, fmt.Errorf("already impersonating")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, fmt.Errorf("reason is required")
+	}
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+
+	resp, err := (*r.server.authClient.Client).StartImpersonation(ctx, &authpb.StartImpersonationRequest{
+		AdminUserId:  admin.UserID,
+		TargetUserId: userID,
+		Reason:       reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// No refresh cookie: the admin's own session keeps it
+	return &schemas.ImpersonationPayload{
+		AccessToken:    resp.GetAccessToken(),
+		ExpiresAt:      resp.GetExpiresAt(),
+		UserID:         resp.GetUserId(),
+		ImpersonatorID: resp.GetImpersonatorId(),
+	}, nil
+}
+
+// EndImpersonation revokes the impersonation session the request is made with
+func (r *AuthMutationResolver) EndImpersonation(ctx context.Context) (bool, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !user.IsImpersonated() {
+		return false, fmt.Errorf("not impersonating")
+	}
+	if r.server.authClient == nil {
+		return false, fmt.Errorf("auth service unavailable")
+	}
+
+	if _, err := (*r.server.authClient.Client).EndImpersonation(ctx, &authpb.EndImpersonationRequest{
+		SessionId: user.SessionID,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateSubscriptionTicket issues a single-use ticket for the caller's next subscription
+// socket, bound to the page origin the request came from
+func (r *AuthMutationResolver) CreateSubscriptionTicket(ctx context.Context) (*schemas.SubscriptionTicket, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.authClient == nil {
+		return nil, fmt.Errorf("auth service unavailable")
+	}
+
+	ticketReq := &authpb.IssueSubscriptionTicketRequest{
+		UserId:    user.UserID,
+		SessionId: user.SessionID,
+	}
+	if req := middleware.GetRequest(ctx); req != nil {
+		ticketReq.Origin = req.Header.Get("Origin")
+	}
+
+	resp, err := (*r.server.authClient.Client).IssueSubscriptionTicket(ctx, ticketReq)
+	if err != nil {
+		return nil, err
+	}
+	return &schemas.SubscriptionTicket{
+		Ticket:    resp.GetTicket(),
+		ExpiresAt: resp.GetExpiresAt(),
+	}, nil
+}
+
+// UpdateProfile is an example of a protected mutation that requires authentication
+func (r *AuthMutationResolver) UpdateProfile(ctx context.Context, displayName *string) (bool, error) {
+	// This demonstrates how to use authentication in resolvers
+	return middleware.WithAuth(ctx, func(ctx context.Context, user *middleware.CurrentUser) (bool, error) {
+		// User is guaranteed to be authenticated here
+		// You can access user.UserID and user.SessionID
+
+		// TODO: Call user service to update profile
+		// For now, just return success to demonstrate the pattern
+		_ = displayName // Use the parameter
+
+		fmt.Printf("User %s updating profile with display name: %v\n", user.UserID, displayName)
+		return true, nil
+	})
+}
@@ -0,0 +1,47 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+func (r *UserMutationResolver) SetAvatarFromNft(ctx context.Context, chainID string, contract string, tokenID string) (*schemas.NftAvatar, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).SetAvatarFromNft(ctx, &userpb.SetAvatarFromNftRequest{
+		UserId:   user.UserID,
+		ChainId:  chainID,
+		Contract: contract,
+		TokenId:  tokenID,
+	})
+	if err != nil {
+		return nil, mapImportError(err, "failed to set avatar")
+	}
+	return utils.MapNftAvatar(resp.GetAvatar()), nil
+}
+
+func (r *UserMutationResolver) ClearNftAvatar(ctx context.Context) (bool, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return false, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return false, fmt.Errorf("user service unavailable")
+	}
+
+	if _, err := (*r.server.userClient.Client).ClearNftAvatar(ctx, &userpb.ClearNftAvatarRequest{UserId: user.UserID}); err != nil {
+		return false, fmt.Errorf("failed to clear avatar: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,74 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PrepareBulkTransfer groups the tokens by collection into batches the wallet sends one by one
+func (r *OrchestratorMutationResolver) PrepareBulkTransfer(ctx context.Context, input schemas.PrepareBulkTransferInput) (*schemas.BulkTransferBundle, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	req := &orchestratorpb.PrepareBulkTransferRequest{
+		ChainId:  input.ChainID,
+		UserId:   user.UserID,
+		From:     input.From,
+		Operator: utils.PtrStr(input.Operator),
+	}
+	for _, item := range input.Items {
+		amount := uint64(1)
+		if item.Amount != nil {
+			if *item.Amount <= 0 {
+				return nil, fmt.Errorf("item amounts must be positive")
+			}
+			amount = uint64(*item.Amount)
+		}
+		req.Items = append(req.Items, &orchestratorpb.BulkTransferItem{
+			Contract: item.Contract,
+			TokenId:  item.TokenID,
+			To:       item.To,
+			Amount:   amount,
+		})
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).PrepareBulkTransfer(ctx, req)
+	if err != nil {
+		return nil, mapImportError(err, "failed to prepare bulk transfer")
+	}
+	return utils.MapBulkTransferBundle(resp), nil
+}
+
+func (r *OrchestratorQueryResolver) BulkTransferProgress(ctx context.Context, bundleID string) (*schemas.BulkTransferProgress, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).GetBulkTransferProgress(ctx, &orchestratorpb.GetBulkTransferProgressRequest{
+		BundleId: bundleID,
+		UserId:   user.UserID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, mapImportError(err, "failed to get bulk transfer progress")
+	}
+	return utils.MapBulkTransferProgress(resp), nil
+}
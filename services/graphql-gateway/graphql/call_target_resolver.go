@@ -0,0 +1,75 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+)
+
+// CallTargetOverrides lists the contracts admins let mints call
+func (r *OrchestratorQueryResolver) CallTargetOverrides(ctx context.Context, chainID *string) ([]*schemas.CallTargetOverride, error) {
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).ListCallTargetOverrides(ctx, &orchestratorpb.ListCallTargetOverridesRequest{
+		ChainId: utils.PtrStr(chainID),
+	})
+	if err != nil {
+		return nil, mapImportError(err, "failed to list call target overrides")
+	}
+
+	overrides := make([]*schemas.CallTargetOverride, 0, len(resp.GetOverrides()))
+	for _, o := range resp.GetOverrides() {
+		overrides = append(overrides, utils.MapCallTargetOverride(o))
+	}
+	return overrides, nil
+}
+
+func (r *OrchestratorMutationResolver) AllowCallTarget(ctx context.Context, chainID string, address string, reason string) (*schemas.CallTargetOverride, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).AllowCallTarget(ctx, &orchestratorpb.AllowCallTargetRequest{
+		ChainId: chainID,
+		Address: address,
+		Reason:  reason,
+		ActorId: admin.UserID,
+	})
+	if err != nil {
+		return nil, mapImportError(err, "failed to allow call target")
+	}
+	return utils.MapCallTargetOverride(resp.GetOverride()), nil
+}
+
+func (r *OrchestratorMutationResolver) RevokeCallTarget(ctx context.Context, chainID string, address string) (bool, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return false, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return false, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).RevokeCallTarget(ctx, &orchestratorpb.RevokeCallTargetRequest{
+		ChainId: chainID,
+		Address: address,
+		ActorId: admin.UserID,
+	})
+	if err != nil {
+		return false, mapImportError(err, "failed to revoke call target")
+	}
+	return resp.GetRevoked(), nil
+}
@@ -0,0 +1,760 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// CatalogQueryResolver resolves the Query fields declared in catalog.graphql
+type CatalogQueryResolver struct {
+	server *Resolver
+}
+
+// CatalogMutationResolver resolves the Mutation fields declared in catalog.graphql
+type CatalogMutationResolver struct {
+	server *Resolver
+}
+
+// CatalogSubscriptionResolver resolves the Subscription fields declared in catalog.graphql
+type CatalogSubscriptionResolver struct {
+	server *Resolver
+}
+
+func (r *CatalogQueryResolver) Collection(ctx context.Context, chainID string, contract string, includeFlagged *bool, includeUnconfirmed *bool) (*schemas.Collection, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	withFlagged, err := includeFlaggedFor(ctx, includeFlagged)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := (*r.server.catalogClient.Client).GetCollection(ctx, &catalogpb.GetCollectionRequest{
+		ChainId:            chainID,
+		ContractAddress:    contract,
+		IncludeFlagged:     withFlagged,
+		IncludeUnconfirmed: utils.PtrBool(includeUnconfirmed),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return utils.LocalizeCollection(utils.MapCollection(resp.GetCollection()), resp.GetCollection(), r.server.preferredLocales(ctx)), nil
+}
+
+func (r *CatalogQueryResolver) CollectionBySlug(ctx context.Context, slug string, includeFlagged *bool, includeUnconfirmed *bool) (*schemas.Collection, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	withFlagged, err := includeFlaggedFor(ctx, includeFlagged)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := (*r.server.catalogClient.Client).GetCollectionBySlug(ctx, &catalogpb.GetCollectionBySlugRequest{
+		Slug:               slug,
+		IncludeFlagged:     withFlagged,
+		IncludeUnconfirmed: utils.PtrBool(includeUnconfirmed),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return utils.LocalizeCollection(utils.MapCollection(resp.GetCollection()), resp.GetCollection(), r.server.preferredLocales(ctx)), nil
+}
+
+func (r *CatalogQueryResolver) Token(ctx context.Context, chainID string, contract string, tokenID string, includeFlagged *bool) (*schemas.Token, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	withFlagged, err := includeFlaggedFor(ctx, includeFlagged)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := (*r.server.catalogClient.Client).GetToken(ctx, &catalogpb.GetTokenRequest{
+		ChainId:         chainID,
+		ContractAddress: contract,
+		TokenId:         tokenID,
+		IncludeFlagged:  withFlagged,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return utils.MapToken(resp.GetToken()), nil
+}
+
+func (r *CatalogQueryResolver) Tokens(ctx context.Context, filter *schemas.TokenFilterInput, sort *schemas.TokenSortInput, limit *int, offset *int, includeFlagged *bool) ([]*schemas.Token, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	withFlagged, err := includeFlaggedFor(ctx, includeFlagged)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &catalogpb.ListTokensRequest{IncludeFlagged: withFlagged}
+	req.Sort, req.Descending = utils.MapTokenSort(sort)
+	if filter != nil {
+		req.ChainId = utils.PtrStr(filter.ChainID)
+		req.ContractAddress = utils.PtrStr(filter.Contract)
+		req.Owner = utils.PtrStr(filter.Owner)
+		req.Price = utils.MapPriceRange(filter.Price)
+		req.Traits = utils.MapTraitFilters(filter.Traits)
+	}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	if offset != nil {
+		req.Offset = int32(*offset)
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ListTokens(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*schemas.Token, 0, len(resp.GetTokens()))
+	for _, t := range resp.GetTokens() {
+		out = append(out, utils.MapToken(t))
+	}
+	return out, nil
+}
+
+func (r *CatalogQueryResolver) MyNFTs(ctx context.Context, chainID string, contract *string, limit *int, offset *int) ([]*schemas.Token, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	owners, err := r.server.holderWallets(ctx, user.UserID, chainID, utils.PtrStr(contract))
+	if err != nil {
+		return nil, err
+	}
+	if len(owners) == 0 {
+		return []*schemas.Token{}, nil
+	}
+
+	req := &catalogpb.ListTokensRequest{
+		ChainId:         chainID,
+		ContractAddress: utils.PtrStr(contract),
+		Owners:          owners,
+	}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	if offset != nil {
+		req.Offset = int32(*offset)
+	}
+	resp, err := (*r.server.catalogClient.Client).ListTokens(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*schemas.Token, 0, len(resp.GetTokens()))
+	for _, t := range resp.GetTokens() {
+		out = append(out, utils.MapToken(t))
+	}
+	return out, nil
+}
+
+func (r *CatalogQueryResolver) Collections(ctx context.Context, chainID *string, filter *schemas.CollectionFilterInput, sort *schemas.CollectionSortInput, limit *int, offset *int, includeFlagged *bool, includeUnconfirmed *bool) ([]*schemas.Collection, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	withFlagged, err := includeFlaggedFor(ctx, includeFlagged)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &catalogpb.ListCollectionsRequest{
+		ChainId:            utils.PtrStr(chainID),
+		IncludeFlagged:     withFlagged,
+		IncludeUnconfirmed: utils.PtrBool(includeUnconfirmed),
+	}
+	req.Sort, req.Descending = utils.MapCollectionSort(sort)
+	if filter != nil {
+		if filter.ChainID != nil {
+			req.ChainId = *filter.ChainID
+		}
+		req.Creator = utils.PtrStr(filter.Creator)
+		req.VerifiedOnly = utils.PtrBool(filter.VerifiedOnly)
+		req.FloorPrice = utils.MapPriceRange(filter.FloorPrice)
+	}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	if offset != nil {
+		req.Offset = int32(*offset)
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ListCollections(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*schemas.Collection, 0, len(resp.GetCollections()))
+	for _, c := range resp.GetCollections() {
+		out = append(out, utils.MapCollection(c))
+	}
+	return out, nil
+}
+
+func (r *CatalogQueryResolver) RelatedCollections(ctx context.Context, slug string, limit *int) ([]*schemas.Collection, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	req := &catalogpb.ListRelatedCollectionsRequest{Slug: slug}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	resp, err := (*r.server.catalogClient.Client).ListRelatedCollections(ctx, req)
+	if err != nil {
+		// A rail for an unknown collection is just empty
+		if status.Code(err) == codes.NotFound {
+			return []*schemas.Collection{}, nil
+		}
+		return nil, err
+	}
+
+	out := make([]*schemas.Collection, 0, len(resp.GetCollections()))
+	for _, c := range resp.GetCollections() {
+		out = append(out, utils.MapCollection(c))
+	}
+	return out, nil
+}
+
+func (r *CatalogQueryResolver) MyCreatedCollections(ctx context.Context, chainID *string, limit *int, offset *int) ([]*schemas.Collection, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	req := &catalogpb.ListCollectionsRequest{
+		ChainId:            utils.PtrStr(chainID),
+		CreatedByUserId:    user.UserID,
+		IncludeFlagged:     true,
+		IncludeUnconfirmed: true,
+	}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	if offset != nil {
+		req.Offset = int32(*offset)
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ListCollections(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*schemas.Collection, 0, len(resp.GetCollections()))
+	for _, c := range resp.GetCollections() {
+		out = append(out, utils.MapCollection(c))
+	}
+	return out, nil
+}
+
+func (r *CatalogMutationResolver) FlagItem(ctx context.Context, input schemas.FlagItemInput) (*schemas.ModerationFlag, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).FlagItem(ctx, &catalogpb.FlagItemRequest{
+		ChainId:         input.ChainID,
+		ContractAddress: input.Contract,
+		TokenId:         utils.PtrStr(input.TokenID),
+		Reason:          string(input.Reason),
+		Note:            utils.PtrStr(input.Note),
+		Source:          "admin",
+		ActorId:         admin.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapModerationFlag(resp.GetFlag()), nil
+}
+
+func (r *CatalogMutationResolver) UnflagItem(ctx context.Context, input schemas.UnflagItemInput) (*schemas.ModerationFlag, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).UnflagItem(ctx, &catalogpb.UnflagItemRequest{
+		ChainId:         input.ChainID,
+		ContractAddress: input.Contract,
+		TokenId:         utils.PtrStr(input.TokenID),
+		Note:            utils.PtrStr(input.Note),
+		ActorId:         admin.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapModerationFlag(resp.GetFlag()), nil
+}
+
+// ResyncCollection compares a collection with on-chain state and, when asked, repairs the
+// catalog to match
+func (r *CatalogMutationResolver) ResyncCollection(ctx context.Context, input schemas.ResyncCollectionInput) (*schemas.ResyncReport, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	req := &catalogpb.ResyncCollectionRequest{
+		ChainId:         input.ChainID,
+		ContractAddress: input.Contract,
+		Repair:          input.Repair != nil && *input.Repair,
+		RequestedBy:     admin.UserID,
+	}
+	if input.SampleSize != nil {
+		if *input.SampleSize <= 0 {
+			return nil, fmt.Errorf("sampleSize must be positive")
+		}
+		req.SampleSize = int32(*input.SampleSize)
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ResyncCollection(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapResyncReport(resp), nil
+}
+
+// includeFlaggedFor only lets admins see flagged items; public queries always hide them
+func includeFlaggedFor(ctx context.Context, includeFlagged *bool) (bool, error) {
+	if includeFlagged == nil || !*includeFlagged {
+		return false, nil
+	}
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *CatalogQueryResolver) ReportQueue(ctx context.Context, limit *int, offset *int) ([]*schemas.ReportQueueItem, error) {
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	req := &catalogpb.ListReportQueueRequest{}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	if offset != nil {
+		req.Offset = int32(*offset)
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ListReportQueue(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*schemas.ReportQueueItem, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		out = append(out, utils.MapReportQueueItem(item))
+	}
+	return out, nil
+}
+
+func (r *CatalogQueryResolver) SystemStatus(ctx context.Context) (*schemas.SystemStatus, error) {
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).GetSystemStatus(ctx, &catalogpb.GetSystemStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapSystemStatus(resp), nil
+}
+
+func (r *CatalogMutationResolver) ReportContent(ctx context.Context, targetType schemas.ReportTargetType, targetID string, reason schemas.ModerationReason, details *string) (*schemas.ReportContentPayload, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ReportContent(ctx, &catalogpb.ReportContentRequest{
+		TargetType: string(targetType),
+		TargetId:   targetID,
+		Reason:     string(reason),
+		Details:    utils.PtrStr(details),
+		ReporterId: user.UserID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil, fmt.Errorf("too many reports, please try again later")
+		}
+		return nil, err
+	}
+
+	return &schemas.ReportContentPayload{
+		Report:    utils.MapReport(resp.GetReport()),
+		Duplicate: resp.GetDuplicate(),
+	}, nil
+}
+
+func (r *CatalogMutationResolver) ResolveReports(ctx context.Context, targetType schemas.ReportTargetType, targetID string, action schemas.ReportAction, note *string) (*schemas.ResolveReportsPayload, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ResolveReports(ctx, &catalogpb.ResolveReportsRequest{
+		TargetType: string(targetType),
+		TargetId:   targetID,
+		Action:     string(action),
+		Note:       utils.PtrStr(note),
+		ActorId:    admin.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemas.ResolveReportsPayload{
+		Resolved: int(resp.GetResolved()),
+		Flag:     utils.MapModerationFlag(resp.GetFlag()),
+	}, nil
+}
+
+// MyEarnings totals royalties paid to every wallet the current user has linked
+func (r *CatalogQueryResolver) MyEarnings(ctx context.Context, period *schemas.EarningsPeriod) (*schemas.Earnings, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	p := schemas.EarningsPeriodAll
+	if period != nil {
+		p = *period
+	}
+
+	links, err := (*r.server.walletClient.Client).ListLinks(ctx, &walletpb.ListLinksRequest{UserId: user.UserID})
+	if err != nil {
+		return nil, err
+	}
+	recipients := make([]string, 0, len(links.GetLinks()))
+	for _, link := range links.GetLinks() {
+		if !link.GetIsWatchOnly() {
+			recipients = append(recipients, link.GetAddress())
+		}
+	}
+
+	resp, err := (*r.server.catalogClient.Client).GetEarnings(ctx, &catalogpb.GetEarningsRequest{
+		Recipients: recipients,
+		Period:     string(p),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &schemas.Earnings{
+		Period: p,
+		Totals: make([]*schemas.EarningsTotal, 0, len(resp.GetTotals())),
+	}
+	if resp.GetSince() != nil {
+		since := resp.GetSince().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		out.Since = &since
+	}
+	for _, t := range resp.GetTotals() {
+		out.Totals = append(out.Totals, utils.MapEarningsTotal(t))
+	}
+	return out, nil
+}
+
+func (r *CatalogMutationResolver) CreateHolderSnapshot(ctx context.Context, chainID string, contract string, blockNumber *string) (*schemas.HolderSnapshot, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	req := &catalogpb.CreateHolderSnapshotRequest{
+		ChainId:         chainID,
+		ContractAddress: contract,
+		RequestedBy:     user.UserID,
+	}
+	if blockNumber != nil {
+		block, err := strconv.ParseUint(*blockNumber, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blockNumber")
+		}
+		req.BlockNumber = wrapperspb.UInt64(block)
+	}
+
+	collection, err := (*r.server.catalogClient.Client).GetCollection(ctx, &catalogpb.GetCollectionRequest{
+		ChainId:         chainID,
+		ContractAddress: contract,
+		IncludeFlagged:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	isCreator, err := r.server.isCollectionCreator(ctx, user.UserID, chainID, contract, collection.GetCollection().GetCreator())
+	if err != nil {
+		return nil, err
+	}
+	if !isCreator {
+		return nil, fmt.Errorf("only the collection creator can snapshot its holders")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).CreateHolderSnapshot(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return utils.MapHolderSnapshot(resp.GetSnapshot()), nil
+}
+
+func (r *CatalogMutationResolver) VerifyTokenGate(ctx context.Context, chainID string, contract string, minBalance *string, proof *schemas.AddressProofInput) (*schemas.TokenGateResult, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	owners, err := r.server.holderWallets(ctx, user.UserID, chainID, contract)
+	if err != nil {
+		return nil, err
+	}
+	if proof != nil {
+		proven, err := r.server.proveAddress(ctx, user.UserID, schemas.AddressChallengePurposeTokenGate, proof)
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, proven)
+	}
+
+	req := &catalogpb.VerifyTokenGateRequest{
+		UserId:          user.UserID,
+		Owners:          owners,
+		ChainId:         chainID,
+		ContractAddress: contract,
+	}
+	if minBalance != nil {
+		req.MinBalance = *minBalance
+	}
+	resp, err := (*r.server.catalogClient.Client).VerifyTokenGate(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return utils.MapTokenGateResult(resp), nil
+}
+
+func (r *CatalogQueryResolver) HolderSnapshot(ctx context.Context, id string) (*schemas.HolderSnapshot, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).GetHolderSnapshot(ctx, &catalogpb.GetHolderSnapshotRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Snapshots of other users read as missing
+	if resp.GetSnapshot().GetRequestedBy() != user.UserID {
+		return nil, nil
+	}
+	return utils.MapHolderSnapshot(resp.GetSnapshot()), nil
+}
+
+func (r *CatalogMutationResolver) SetCollectionContent(ctx context.Context, chainID string, contract string, locale string, description *string, tagline *string) (*schemas.Collection, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	current, err := (*r.server.catalogClient.Client).GetCollection(ctx, &catalogpb.GetCollectionRequest{
+		ChainId:            chainID,
+		ContractAddress:    contract,
+		IncludeFlagged:     true,
+		IncludeUnconfirmed: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.server.requireCollectionEditor(ctx, user.UserID, current.GetCollection()); err != nil {
+		return nil, err
+	}
+
+	resp, err := (*r.server.catalogClient.Client).SetCollectionContent(ctx, &catalogpb.SetCollectionContentRequest{
+		ChainId:         chainID,
+		ContractAddress: contract,
+		Locale:          locale,
+		Description:     utils.PtrStr(description),
+		Tagline:         utils.PtrStr(tagline),
+		ActorId:         user.UserID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return utils.LocalizeCollection(utils.MapCollection(resp.GetCollection()), resp.GetCollection(), []string{locale}), nil
+}
+
+func (r *CatalogMutationResolver) SetCollectionClassification(ctx context.Context, chainID string, contract string, category *schemas.CollectionCategory, tags []string) (*schemas.Collection, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	current, err := (*r.server.catalogClient.Client).GetCollection(ctx, &catalogpb.GetCollectionRequest{
+		ChainId:            chainID,
+		ContractAddress:    contract,
+		IncludeFlagged:     true,
+		IncludeUnconfirmed: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.server.requireCollectionEditor(ctx, user.UserID, current.GetCollection()); err != nil {
+		return nil, err
+	}
+
+	req := &catalogpb.SetCollectionClassificationRequest{
+		ChainId:         chainID,
+		ContractAddress: contract,
+		Tags:            tags,
+		ActorId:         user.UserID,
+	}
+	if category != nil {
+		req.Category = string(*category)
+	}
+	resp, err := (*r.server.catalogClient.Client).SetCollectionClassification(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return utils.LocalizeCollection(utils.MapCollection(resp.GetCollection()), resp.GetCollection(), r.server.preferredLocales(ctx)), nil
+}
+
+// requireCollectionEditor checks that the user may edit the collection's content: an
+// organization's admins edit its collections, otherwise the creator does
+func (r *Resolver) requireCollectionEditor(ctx context.Context, userID string, collection *catalogpb.Collection) error {
+	if orgID := collection.GetOwnerOrgId(); orgID != "" {
+		_, err := middleware.RequireOrgRole(ctx, orgID, orgRoleOwner, orgRoleAdmin)
+		return err
+	}
+	isCreator, err := r.isCollectionCreator(ctx, userID, collection.GetChainId(), collection.GetContractAddress(), collection.GetCreator())
+	if err != nil {
+		return err
+	}
+	if !isCreator {
+		return fmt.Errorf("only the collection creator can edit its content")
+	}
+	return nil
+}
+
+// preferredLocales lists the locales a viewer reads, their saved locale before the
+// request's Accept-Language
+func (r *Resolver) preferredLocales(ctx context.Context) []string {
+	var preferred []string
+	if user := middleware.GetCurrentUser(ctx); user != nil && r.userClient != nil && r.userClient.Client != nil {
+		// Content still renders when preferences can't be read
+		resp, err := (*r.userClient.Client).GetPreferences(ctx, &userpb.GetPreferencesRequest{UserId: user.UserID})
+		if err == nil && resp.GetPreferences().GetLocale() != "" {
+			preferred = append(preferred, resp.GetPreferences().GetLocale())
+		}
+	}
+	return append(preferred, middleware.AcceptedLocales(ctx)...)
+}
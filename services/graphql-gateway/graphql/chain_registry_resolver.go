@@ -2,76 +2,40 @@ package graphql_resolver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
-	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
-	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
 	chainregpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-type QueryResolver struct {
+// ChainRegistryQueryResolver resolves the Query fields declared in chain-registry.graphql
+type ChainRegistryQueryResolver struct {
 	server *Resolver
 }
 
-func (r *QueryResolver) Health(ctx context.Context) (string, error) {
-	return "ok", nil
+// ChainRegistryMutationResolver resolves the Mutation fields declared in chain-registry.graphql
+type ChainRegistryMutationResolver struct {
+	server *Resolver
 }
 
-func (r *QueryResolver) Me(ctx context.Context) (*schemas.User, error) {
-	// First, check if user is already authenticated via Bearer token
-	if user := middleware.GetCurrentUser(ctx); user != nil {
-		return &schemas.User{
-			ID: user.UserID,
-		}, nil
-	}
-
-	// If no Bearer token, try silent refresh using cookie
-	if r.server.authClient == nil {
-		return nil, nil // Return null if auth service not available
-	}
-
-	// Get HTTP request from context
-	req := middleware.GetRequest(ctx)
-	if req == nil {
-		return nil, nil // Return null if request not available
-	}
-
-	// Try to get refresh token from cookie
-	refreshToken := middleware.GetRefreshTokenFromCookie(req)
-	if refreshToken == "" {
-		return nil, nil // Return null if no refresh token (not logged in)
+func (r *ChainRegistryQueryResolver) ChainHead(ctx context.Context, chainID string) (*schemas.ChainHead, error) {
+	if r.server.chainRegistryClient == nil || r.server.chainRegistryClient.Client == nil {
+		return nil, nil
 	}
-
-	// Get client info for audit
-	ip, userAgent := middleware.GetClientInfo(req)
-
-	// Try to refresh session silently
-	resp, err := (*r.server.authClient.Client).RefreshSession(ctx, &authpb.RefreshSessionRequest{
-		RefreshToken: refreshToken,
-		UserAgent:    userAgent,
-		IpAddress:    ip,
-	})
-	if err != nil {
-		// If refresh fails, clear the cookie and return null
-		if rw := middleware.GetResponseWriter(ctx); rw != nil {
-			middleware.ClearRefreshTokenCookie(rw)
-		}
+	resp, err := (*r.server.chainRegistryClient.Client).GetChainHead(ctx, &chainregpb.GetChainHeadRequest{ChainId: chainID})
+	if status.Code(err) == codes.NotFound {
 		return nil, nil
 	}
-
-	// Set new refresh token cookie
-	if rw := middleware.GetResponseWriter(ctx); rw != nil {
-		middleware.SetRefreshTokenCookie(rw, resp.GetRefreshToken())
+	if err != nil {
+		return nil, err
 	}
-
-	// Return user info with new access token available for future requests
-	return &schemas.User{
-		ID: resp.GetUserId(),
-	}, nil
+	return utils.MapChainHead(resp.GetHead()), nil
 }
 
-func (r *QueryResolver) ChainContracts(ctx context.Context, chainID string) (*schemas.ChainContracts, error) {
+func (r *ChainRegistryQueryResolver) ChainContracts(ctx context.Context, chainID string) (*schemas.ChainContracts, error) {
 	if r.server.chainRegistryClient == nil || r.server.chainRegistryClient.Client == nil {
 		return nil, nil
 	}
@@ -94,7 +58,7 @@ func (r *QueryResolver) ChainContracts(ctx context.Context, chainID string) (*sc
 	}, nil
 }
 
-func (r *QueryResolver) ChainGasPolicy(ctx context.Context, chainID string) (*schemas.ChainGasPolicy, error) {
+func (r *ChainRegistryQueryResolver) ChainGasPolicy(ctx context.Context, chainID string) (*schemas.ChainGasPolicy, error) {
 	if r.server.chainRegistryClient == nil || r.server.chainRegistryClient.Client == nil {
 		return nil, nil
 	}
@@ -110,7 +74,7 @@ func (r *QueryResolver) ChainGasPolicy(ctx context.Context, chainID string) (*sc
 	}, nil
 }
 
-func (r *QueryResolver) ChainRPCEndpoints(ctx context.Context, chainID string) (*schemas.ChainRPCEndpoints, error) {
+func (r *ChainRegistryQueryResolver) ChainRPCEndpoints(ctx context.Context, chainID string) (*schemas.ChainRPCEndpoints, error) {
 	if r.server.chainRegistryClient == nil || r.server.chainRegistryClient.Client == nil {
 		return nil, nil
 	}
@@ -129,7 +93,22 @@ func (r *QueryResolver) ChainRPCEndpoints(ctx context.Context, chainID string) (
 	}, nil
 }
 
-func (r *QueryResolver) ContractMeta(ctx context.Context, chainID string, address string) (*schemas.ContractMeta, error) {
+func (r *ChainRegistryQueryResolver) ChainCapabilities(ctx context.Context, chainID string) (*schemas.ChainCapabilitiesInfo, error) {
+	if r.server.chainRegistryClient == nil || r.server.chainRegistryClient.Client == nil {
+		return nil, nil
+	}
+	resp, err := (*r.server.chainRegistryClient.Client).GetChainCapabilities(ctx, &chainregpb.GetChainCapabilitiesRequest{ChainId: chainID})
+	if err != nil {
+		return nil, err
+	}
+	return &schemas.ChainCapabilitiesInfo{
+		ChainID:         resp.GetChainId(),
+		Capabilities:    utils.MapChainCapabilities(resp.GetCapabilities()),
+		RegistryVersion: resp.GetRegistryVersion(),
+	}, nil
+}
+
+func (r *ChainRegistryQueryResolver) ContractMeta(ctx context.Context, chainID string, address string) (*schemas.ContractMeta, error) {
 	if r.server.chainRegistryClient == nil || r.server.chainRegistryClient.Client == nil {
 		return nil, nil
 	}
@@ -143,3 +122,18 @@ func (r *QueryResolver) ContractMeta(ctx context.Context, chainID string, addres
 		RegistryVersion: resp.GetRegistryVersion(),
 	}, nil
 }
+
+func (r *ChainRegistryMutationResolver) BumpChainVersion(ctx context.Context, input schemas.BumpChainVersionInput) (*schemas.BumpChainVersionPayload, error) {
+	if r.server.chainRegistryClient == nil || r.server.chainRegistryClient.Client == nil {
+		return nil, fmt.Errorf("chain registry service unavailable")
+	}
+	reason := ""
+	if input.Reason != nil {
+		reason = *input.Reason
+	}
+	resp, err := (*r.server.chainRegistryClient.Client).BumpVersion(ctx, &chainregpb.BumpVersionRequest{ChainId: input.ChainID, Reason: reason})
+	if err != nil {
+		return nil, err
+	}
+	return &schemas.BumpChainVersionPayload{Ok: resp.GetOk(), NewVersion: resp.GetNewVersion()}, nil
+}
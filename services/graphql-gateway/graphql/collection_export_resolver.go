@@ -0,0 +1,117 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (r *CatalogMutationResolver) ExportSales(ctx context.Context, chainID string, contract string, from string, to string) (*schemas.CollectionExport, error) {
+	return r.createCollectionExport(ctx, schemas.CollectionExportKindSales, chainID, contract, from, to)
+}
+
+func (r *CatalogMutationResolver) ExportMints(ctx context.Context, chainID string, contract string, from string, to string) (*schemas.CollectionExport, error) {
+	return r.createCollectionExport(ctx, schemas.CollectionExportKindMints, chainID, contract, from, to)
+}
+
+func (r *CatalogMutationResolver) createCollectionExport(ctx context.Context, kind schemas.CollectionExportKind, chainID, contract, from, to string) (*schemas.CollectionExport, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	fromTS, err := dropTimestamp("from", &from)
+	if err != nil {
+		return nil, err
+	}
+	toTS, err := dropTimestamp("to", &to)
+	if err != nil {
+		return nil, err
+	}
+	if fromTS == nil || toTS == nil {
+		return nil, fmt.Errorf("from and to are required")
+	}
+
+	// Admins export any collection; otherwise whoever manages it does
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		collection, err := (*r.server.catalogClient.Client).GetCollection(ctx, &catalogpb.GetCollectionRequest{
+			ChainId:         chainID,
+			ContractAddress: contract,
+			IncludeFlagged:  true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := r.server.requireCollectionExporter(ctx, user.UserID, collection.GetCollection()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := (*r.server.catalogClient.Client).CreateCollectionExport(ctx, &catalogpb.CreateCollectionExportRequest{
+		Kind:            string(kind),
+		ChainId:         chainID,
+		ContractAddress: contract,
+		From:            fromTS,
+		To:              toTS,
+		RequestedBy:     user.UserID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return utils.MapCollectionExport(resp.GetExport()), nil
+}
+
+func (r *CatalogQueryResolver) CollectionExport(ctx context.Context, id string) (*schemas.CollectionExport, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).GetCollectionExport(ctx, &catalogpb.GetCollectionExportRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Exports of other users read as missing
+	if resp.GetExport().GetRequestedBy() != user.UserID {
+		return nil, nil
+	}
+	return utils.MapCollectionExport(resp.GetExport()), nil
+}
+
+// requireCollectionExporter checks that the user may export the collection's sales and
+// mints: an organization's admins for its collections, otherwise the creator
+func (r *Resolver) requireCollectionExporter(ctx context.Context, userID string, collection *catalogpb.Collection) error {
+	if orgID := collection.GetOwnerOrgId(); orgID != "" {
+		_, err := middleware.RequireOrgRole(ctx, orgID, orgRoleOwner, orgRoleAdmin)
+		return err
+	}
+	if r.walletClient == nil || r.walletClient.Client == nil {
+		return fmt.Errorf("wallet service unavailable")
+	}
+	isCreator, err := r.isCollectionCreator(ctx, userID, collection.GetChainId(), collection.GetContractAddress(), collection.GetCreator())
+	if err != nil {
+		return err
+	}
+	if !isCreator {
+		return fmt.Errorf("only the collection creator or an admin can export its activity")
+	}
+	return nil
+}
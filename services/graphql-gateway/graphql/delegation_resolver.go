@@ -0,0 +1,62 @@
+package graphql_resolver
+
+import (
+	"context"
+	"log"
+
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+// signedWallets lists the user's linked wallets. Watch-only wallets are not proven to be
+// the user's, so they are left out.
+func (r *Resolver) signedWallets(ctx context.Context, userID string) ([]string, error) {
+	links, err := (*r.walletClient.Client).ListLinks(ctx, &walletpb.ListLinksRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	wallets := make([]string, 0, len(links.GetLinks()))
+	for _, link := range links.GetLinks() {
+		if !link.GetIsWatchOnly() {
+			wallets = append(wallets, link.GetAddress())
+		}
+	}
+	return wallets, nil
+}
+
+// holderWallets lists the wallets whose tokens count as the user's on a chain: the signed
+// wallets and, when the user honors delegations, the vaults delegating to them for all
+// their tokens or for contract. Delegations only add wallets, so a failed lookup falls
+// back to the signed wallets.
+func (r *Resolver) holderWallets(ctx context.Context, userID, chainID, contract string) ([]string, error) {
+	wallets, err := r.signedWallets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wallets) == 0 || !r.honorsDelegations(ctx, userID) {
+		return wallets, nil
+	}
+
+	resp, err := (*r.catalogClient.Client).ListDelegatedVaults(ctx, &catalogpb.ListDelegatedVaultsRequest{
+		ChainId:         chainID,
+		Delegates:       wallets,
+		ContractAddress: contract,
+	})
+	if err != nil {
+		log.Printf("Failed to list delegated vaults for user %s: %v", userID, err)
+		return wallets, nil
+	}
+	return append(wallets, resp.GetVaults()...), nil
+}
+
+func (r *Resolver) honorsDelegations(ctx context.Context, userID string) bool {
+	if r.userClient == nil || r.userClient.Client == nil || r.catalogClient == nil || r.catalogClient.Client == nil {
+		return false
+	}
+	resp, err := (*r.userClient.Client).GetPreferences(ctx, &userpb.GetPreferencesRequest{UserId: userID})
+	if err != nil {
+		return false
+	}
+	return resp.GetPreferences().GetHonorDelegations()
+}
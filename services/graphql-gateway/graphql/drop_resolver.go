@@ -0,0 +1,170 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UpcomingDrops is the public drop calendar: collections whose mint is about to open and
+// approved off-platform drops, soonest first
+func (r *CatalogQueryResolver) UpcomingDrops(ctx context.Context, chainID *string, from *string, to *string, limit *int) ([]*schemas.UpcomingDrop, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	req := &catalogpb.ListUpcomingDropsRequest{ChainId: utils.PtrStr(chainID)}
+	var err error
+	if req.From, err = dropTimestamp("from", from); err != nil {
+		return nil, err
+	}
+	if req.To, err = dropTimestamp("to", to); err != nil {
+		return nil, err
+	}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ListUpcomingDrops(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	out := make([]*schemas.UpcomingDrop, 0, len(resp.GetDrops()))
+	for _, d := range resp.GetDrops() {
+		out = append(out, utils.MapUpcomingDrop(d, now))
+	}
+	return out, nil
+}
+
+func (r *CatalogQueryResolver) MyDropSubmissions(ctx context.Context, limit *int, offset *int) ([]*schemas.DropSubmission, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.listDropSubmissions(ctx, &catalogpb.ListDropSubmissionsRequest{SubmittedBy: user.UserID}, limit, offset)
+}
+
+// DropSubmissions is the admin review queue
+func (r *CatalogQueryResolver) DropSubmissions(ctx context.Context, status *schemas.DropSubmissionStatus, limit *int, offset *int) ([]*schemas.DropSubmission, error) {
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	req := &catalogpb.ListDropSubmissionsRequest{}
+	if status != nil {
+		req.Status = string(*status)
+	}
+	return r.listDropSubmissions(ctx, req, limit, offset)
+}
+
+func (r *CatalogQueryResolver) listDropSubmissions(ctx context.Context, req *catalogpb.ListDropSubmissionsRequest, limit *int, offset *int) ([]*schemas.DropSubmission, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	if limit != nil {
+		req.Limit = int32(*limit)
+	}
+	if offset != nil {
+		req.Offset = int32(*offset)
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ListDropSubmissions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*schemas.DropSubmission, 0, len(resp.GetSubmissions()))
+	for _, s := range resp.GetSubmissions() {
+		out = append(out, utils.MapDropSubmission(s))
+	}
+	return out, nil
+}
+
+// SubmitDrop queues an off-platform drop for the calendar; it is listed once an admin
+// approves it
+func (r *CatalogMutationResolver) SubmitDrop(ctx context.Context, input schemas.SubmitDropInput) (*schemas.DropSubmission, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	req := &catalogpb.SubmitDropRequest{
+		ChainId:         input.ChainID,
+		ContractAddress: utils.PtrStr(input.Contract),
+		Name:            input.Name,
+		Description:     utils.PtrStr(input.Description),
+		ImageUrl:        utils.PtrStr(input.ImageURL),
+		ExternalUrl:     input.ExternalURL,
+		MintPrice:       utils.PtrStr(input.MintPrice),
+		SubmittedBy:     user.UserID,
+	}
+	if req.StartsAt, err = dropTimestamp("startsAt", &input.StartsAt); err != nil {
+		return nil, err
+	}
+	if req.EndsAt, err = dropTimestamp("endsAt", input.EndsAt); err != nil {
+		return nil, err
+	}
+
+	resp, err := (*r.server.catalogClient.Client).SubmitDrop(ctx, req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		case codes.ResourceExhausted:
+			return nil, fmt.Errorf("too many drop submissions awaiting review, try again later")
+		}
+		return nil, err
+	}
+	return utils.MapDropSubmission(resp.GetSubmission()), nil
+}
+
+func (r *CatalogMutationResolver) ReviewDropSubmission(ctx context.Context, id string, action schemas.DropReviewAction, note *string) (*schemas.DropSubmission, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).ReviewDropSubmission(ctx, &catalogpb.ReviewDropSubmissionRequest{
+		Id:      id,
+		Action:  string(action),
+		Note:    utils.PtrStr(note),
+		ActorId: admin.UserID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("drop submission not found")
+		}
+		return nil, err
+	}
+	return utils.MapDropSubmission(resp.GetSubmission()), nil
+}
+
+// dropTimestamp converts an optional DateTime argument
+func dropTimestamp(field string, value *string) (*timestamppb.Timestamp, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, *value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", field, err)
+	}
+	return timestamppb.New(t), nil
+}
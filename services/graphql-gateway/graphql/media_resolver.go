@@ -4,14 +4,32 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log"
 
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/quangdang46/NFT-Marketplace/shared/proto/media"
 )
 
+// MediaQueryResolver resolves the Query fields declared in media.graphql
+type MediaQueryResolver struct {
+	server *Resolver
+}
+
+// MediaMutationResolver resolves the Mutation fields declared in media.graphql
+type MediaMutationResolver struct {
+	server *Resolver
+}
+
+// MediaSubscriptionResolver resolves the Subscription fields declared in media.graphql
+type MediaSubscriptionResolver struct {
+	server *Resolver
+}
+
 // Query resolvers
-func (r *QueryResolver) MediaAsset(ctx context.Context, id string) (*schemas.MediaAsset, error) {
+func (r *MediaQueryResolver) MediaAsset(ctx context.Context, id string) (*schemas.MediaAsset, error) {
 	resp, err := (*r.server.mediaClient.Client).GetAsset(ctx, &media.GetAssetRequest{
 		Id: id,
 	})
@@ -22,7 +40,7 @@ func (r *QueryResolver) MediaAsset(ctx context.Context, id string) (*schemas.Med
 	return utils.MapAssetToGraphQL(resp.Asset), nil
 }
 
-func (r *QueryResolver) MediaAssetByCid(ctx context.Context, cid string) (*schemas.MediaAsset, error) {
+func (r *MediaQueryResolver) MediaAssetByCid(ctx context.Context, cid string) (*schemas.MediaAsset, error) {
 	resp, err := (*r.server.mediaClient.Client).GetAssetByCid(ctx, &media.GetAssetByCidRequest{
 		Cid: cid,
 	})
@@ -33,25 +51,88 @@ func (r *QueryResolver) MediaAssetByCid(ctx context.Context, cid string) (*schem
 	return utils.MapAssetToGraphQL(resp.Asset), nil
 }
 
+// MyStorageUsage reports the storage the signed-in user holds against their quota
+func (r *MediaQueryResolver) MyStorageUsage(ctx context.Context) (*schemas.StorageUsage, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := (*r.server.mediaClient.Client).GetStorageUsage(ctx, &media.GetStorageUsageRequest{
+		OwnerId: user.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.MapStorageUsage(resp), nil
+}
+
+// uploadChunkSize is the size of the chunks a file is streamed to the media service in
+const uploadChunkSize = 256 << 10
+
 // Mutation resolvers
-func (r *MutationResolver) UploadSingleFile(ctx context.Context, input schemas.UploadSingleFileInput) (*schemas.UploadSingleFilePayload, error) {
-	fileData, err := io.ReadAll(input.File.File)
+
+// UploadSingleFile streams the file to the media service. With an upload ticket, its
+// progress is published to onUploadProgress subscribers while it is received and pinned.
+// The upload is charged to the signed-in user's storage quota.
+func (r *MediaMutationResolver) UploadSingleFile(ctx context.Context, input schemas.UploadSingleFileInput) (*schemas.UploadSingleFilePayload, error) {
+	user, err := middleware.RequireAuth(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %w", err)
+		return nil, err
 	}
 
-	req := &media.SingleUploadRequest{
-		FileData: fileData,
+	meta := &media.UploadStreamMeta{
 		Filename: input.File.Filename,
 		Mime:     input.File.ContentType,
 		Kind:     utils.ConvertMediaKindToProto(input.Kind),
+		OwnerId:  user.UserID,
+	}
+	if input.UploadTicket != nil {
+		meta.UploadTicket = *input.UploadTicket
+	}
+	if input.File.Size > 0 {
+		meta.TotalBytes = uint64(input.File.Size)
 	}
 
-	client := *r.server.mediaClient.Client
-	resp, err := client.UploadSingleFile(ctx, req)
+	stream, err := (*r.server.mediaClient.Client).UploadFileStream(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload file: %w", err)
 	}
+	if err := stream.Send(&media.UploadStreamRequest{Payload: &media.UploadStreamRequest_Meta{Meta: meta}}); err != nil {
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, readErr := io.ReadFull(input.File.File, buf)
+		if n > 0 {
+			chunk := &media.UploadStreamRequest{Payload: &media.UploadStreamRequest_Chunk{Chunk: append([]byte(nil), buf[:n]...)}}
+			if err := stream.Send(chunk); err != nil {
+				// The service ended the stream; its status is returned by Recv below
+				break
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read file: %w", readErr)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	// Progress reports reach the client through the subscription worker; only the result matters here
+	var resp *media.UploadAndPinResponse
+	for resp == nil {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload file: %w", err)
+		}
+		resp = msg.GetResult()
+	}
 
 	asset := utils.MapAssetToGraphQL(resp.Asset)
 
@@ -75,3 +156,66 @@ func (r *MutationResolver) UploadSingleFile(ctx context.Context, input schemas.U
 		Cid:          cid,
 	}, nil
 }
+
+// ReleaseMediaAsset gives up the signed-in user's upload, crediting it back to their quota
+func (r *MediaMutationResolver) ReleaseMediaAsset(ctx context.Context, id string) (bool, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := (*r.server.mediaClient.Client).ReleaseAsset(ctx, &media.ReleaseAssetRequest{
+		AssetId: id,
+		OwnerId: user.UserID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Released, nil
+}
+
+// OnUploadProgress streams the progress of the upload started with ticket, relayed by the
+// subscription worker, and ends once the upload is done or failed
+func (r *MediaSubscriptionResolver) OnUploadProgress(ctx context.Context, ticket string) (<-chan *schemas.UploadProgress, error) {
+	if ticket == "" {
+		return nil, fmt.Errorf("invalid upload ticket")
+	}
+	if r.server.websocketClient == nil || !r.server.websocketClient.IsConnected() {
+		return nil, fmt.Errorf("upload progress unavailable")
+	}
+
+	// Callbacks run one at a time on the client's reader, so closed needs no lock
+	progressChan := make(chan *schemas.UploadProgress, 10)
+	finished := make(chan struct{})
+	closed := false
+	cancel, err := r.server.websocketClient.SubscribeUploadProgress(ticket, func(progress *contracts.UploadProgress) {
+		if closed {
+			return
+		}
+		select {
+		case progressChan <- utils.MapUploadProgress(progress):
+		case <-ctx.Done():
+		default:
+			log.Printf("Channel full, dropping %s progress of upload %s", progress.Stage, ticket)
+		}
+		if progress.Done() {
+			closed = true
+			close(progressChan)
+			close(finished)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-finished:
+		}
+		cancel()
+	}()
+
+	return progressChan, nil
+}
@@ -0,0 +1,135 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Mints during a busy drop arrive many times a second; dashboards refetch at most this often
+	mintStatsThrottle = time.Second
+	// How often the window rolls forward, or is polled when the worker cannot push mints
+	mintStatsRollInterval = time.Minute
+	mintStatsPollInterval = 5 * time.Second
+)
+
+// MintStats aggregates a collection's mints per minute for drop dashboards
+func (r *CatalogQueryResolver) MintStats(ctx context.Context, chainID string, contract string, window *schemas.MintStatsWindow) (*schemas.MintStats, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	return r.server.fetchMintStats(ctx, chainID, contract, window)
+}
+
+// MintStats pushes the collection's mint stats, then the whole window again whenever the
+// subscription worker relays a mint and each minute as the window rolls forward
+func (r *CatalogSubscriptionResolver) MintStats(ctx context.Context, chainID string, contract string, window *schemas.MintStatsWindow) (<-chan *schemas.MintStats, error) {
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	initial, err := r.server.fetchMintStats(ctx, chainID, contract, window)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mint updates only signal a refetch, so a push always carries the whole window
+	changed := make(chan struct{}, 1)
+	var cancel func()
+	if r.server.websocketClient != nil && r.server.websocketClient.IsConnected() {
+		// The catalog answers with the chain id and address as its updates carry them
+		cancel, err = r.server.websocketClient.SubscribeMintStats(initial.ChainID, initial.Contract, func(*contracts.MintStatsUpdate) {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		})
+		if err != nil {
+			log.Printf("Failed to subscribe to mint stats of %s: %v", initial.Contract, err)
+			cancel = nil
+		}
+	}
+
+	statsChan := make(chan *schemas.MintStats, 1)
+	statsChan <- initial
+	go func() {
+		defer close(statsChan)
+		interval := mintStatsRollInterval
+		if cancel != nil {
+			defer cancel()
+		} else {
+			log.Printf("WebSocket not available, falling back to polling for mint stats: %s", initial.Contract)
+			interval = mintStatsPollInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		last := mintStatsFingerprint(initial)
+		lastFetch := time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+			case <-ticker.C:
+			}
+			// Signals arriving meanwhile collapse into the one buffered in changed
+			if wait := mintStatsThrottle - time.Since(lastFetch); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(wait):
+				}
+			}
+			lastFetch = time.Now()
+
+			stats, err := r.server.fetchMintStats(ctx, chainID, contract, window)
+			if err != nil {
+				log.Printf("GetMintStats error for %s: %v", contract, err)
+				continue
+			}
+			fp := mintStatsFingerprint(stats)
+			if fp == last {
+				continue
+			}
+			last = fp
+			select {
+			case statsChan <- stats:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return statsChan, nil
+}
+
+func (r *Resolver) fetchMintStats(ctx context.Context, chainID, contract string, window *schemas.MintStatsWindow) (*schemas.MintStats, error) {
+	req := &catalogpb.GetMintStatsRequest{ChainId: chainID, ContractAddress: contract}
+	if window != nil {
+		req.Window = strings.TrimPrefix(string(*window), "last")
+	}
+	resp, err := (*r.catalogClient.Client).GetMintStats(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return utils.MapMintStats(resp), nil
+}
+
+// mintStatsFingerprint changes whenever the window starts later or any total does
+func mintStatsFingerprint(s *schemas.MintStats) string {
+	return fmt.Sprintf("%s|%s|%d|%s|%d", s.Since, s.Mints, s.UniqueMinters, s.Revenue, len(s.Buckets))
+}
@@ -4,13 +4,31 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
 	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
 )
 
-func (r *MutationResolver) PrepareCreateCollection(ctx context.Context, input schemas.PrepareCreateCollectionInput) (*schemas.PrepareCreateCollectionPayload, error) {
+// OrchestratorQueryResolver resolves the Query fields declared in orchestrator.graphql
+type OrchestratorQueryResolver struct {
+	server *Resolver
+}
+
+// OrchestratorMutationResolver resolves the Mutation fields declared in orchestrator.graphql
+type OrchestratorMutationResolver struct {
+	server *Resolver
+}
+
+// OrchestratorSubscriptionResolver resolves the Subscription fields declared in orchestrator.graphql
+type OrchestratorSubscriptionResolver struct {
+	server *Resolver
+}
+
+func (r *OrchestratorMutationResolver) PrepareCreateCollection(ctx context.Context, input schemas.PrepareCreateCollectionInput) (*schemas.PrepareCreateCollectionPayload, error) {
 	// Validate input early
 	if input.ChainID == "" || input.Name == "" || input.Symbol == "" || input.Type == "" {
 		return nil, fmt.Errorf("invalid prepare create collection input: missing required fields")
@@ -45,6 +63,11 @@ func (r *MutationResolver) PrepareCreateCollection(ctx context.Context, input sc
 	publicMintPrice = utils.ParseUint64(input.PublicMintPrice)
 	allowlistStageDuration = utils.ParseUint64(input.AllowlistStageDuration)
 
+	payoutSplits, err := utils.PayoutSplitsToProto(input.PayoutSplits)
+	if err != nil {
+		return nil, err
+	}
+
 	// Call orchestrator service
 	resp, err := (*r.server.orchestratorClient.Client).PrepareCreateCollection(ctx, &orchestratorpb.PrepareCreateCollectionRequest{
 		ChainId:                input.ChainID,
@@ -62,6 +85,9 @@ func (r *MutationResolver) PrepareCreateCollection(ctx context.Context, input sc
 		AllowlistMintPrice:     allowlistMintPrice,
 		PublicMintPrice:        publicMintPrice,
 		AllowlistStageDuration: allowlistStageDuration,
+		AssetIds:               input.AssetIds,
+		CallbackUrl:            utils.PtrStr(input.CallbackURL),
+		PayoutSplits:           payoutSplits,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare create collection: %w", err)
@@ -76,12 +102,47 @@ func (r *MutationResolver) PrepareCreateCollection(ctx context.Context, input sc
 	}
 
 	return &schemas.PrepareCreateCollectionPayload{
-		IntentID:  resp.IntentId,
-		TxRequest: txRequest,
+		IntentID:       resp.IntentId,
+		TxRequest:      txRequest,
+		CallbackSecret: utils.StrPtrOrNil(resp.CallbackSecret),
 	}, nil
 }
 
-func (r *MutationResolver) PrepareMint(ctx context.Context, input schemas.PrepareMintInput) (*schemas.PrepareMintPayload, error) {
+// PrepareSetPayoutSplits replaces the payout split of a deployed collection
+func (r *OrchestratorMutationResolver) PrepareSetPayoutSplits(ctx context.Context, chainID string, contract string, splits []*schemas.PayoutSplitInput) (*schemas.PrepareCollectionAdminPayload, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+	payoutSplits, err := utils.PayoutSplitsToProto(splits)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).PrepareSetPayoutSplits(ctx, &orchestratorpb.PrepareSetPayoutSplitsRequest{
+		ChainId:  chainID,
+		Contract: contract,
+		UserId:   user.UserID,
+		Splits:   payoutSplits,
+	})
+	if err != nil {
+		return nil, mapImportError(err, "failed to prepare payout splits")
+	}
+
+	return &schemas.PrepareCollectionAdminPayload{
+		IntentID: resp.IntentId,
+		TxRequest: &schemas.TxRequest{
+			To:    resp.Tx.To,
+			Data:  string(resp.Tx.Data),
+			Value: resp.Tx.Value,
+		},
+	}, nil
+}
+
+func (r *OrchestratorMutationResolver) PrepareMint(ctx context.Context, input schemas.PrepareMintInput) (*schemas.PrepareMintPayload, error) {
 	// Validate input early
 	if input.ChainID == "" || input.Contract == "" || input.Standard == "" {
 		return nil, fmt.Errorf("invalid prepare mint input: missing required fields")
@@ -110,6 +171,8 @@ func (r *MutationResolver) PrepareMint(ctx context.Context, input schemas.Prepar
 		Minter:   user.UserID,
 		Standard: input.Standard,
 		Quantity: quantity,
+		TokenId:  utils.PtrStr(input.TokenID),
+		Proof:    input.Proof,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare mint: %w", err)
@@ -129,7 +192,7 @@ func (r *MutationResolver) PrepareMint(ctx context.Context, input schemas.Prepar
 	}, nil
 }
 
-func (r *MutationResolver) TrackTx(ctx context.Context, input schemas.TrackTxInput) (bool, error) {
+func (r *OrchestratorMutationResolver) TrackTx(ctx context.Context, input schemas.TrackTxInput) (bool, error) {
 	// Validate input early
 	if input.IntentID == "" || input.ChainID == "" || input.TxHash == "" {
 		return false, fmt.Errorf("invalid track tx input: missing required fields")
@@ -158,3 +221,83 @@ func (r *MutationResolver) TrackTx(ctx context.Context, input schemas.TrackTxInp
 
 	return resp.Ok, nil
 }
+
+// CollectionDefaults returns the bounds the chain puts on prepareCreateCollection so the
+// create form can enforce them before the orchestrator does
+func (r *OrchestratorQueryResolver) CollectionDefaults(ctx context.Context, chainID string) (*schemas.CollectionDefaults, error) {
+	if chainID == "" {
+		return nil, fmt.Errorf("chainId is required")
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).GetCollectionDefaults(ctx, &orchestratorpb.GetCollectionDefaultsRequest{ChainId: chainID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection defaults: %w", err)
+	}
+
+	return utils.MapCollectionDefaults(resp), nil
+}
+
+// PrepareCollectionImport returns the message the owner of an existing contract signs to
+// import it into the marketplace
+func (r *OrchestratorMutationResolver) PrepareCollectionImport(ctx context.Context, chainID string, address string) (*schemas.CollectionImportChallenge, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).PrepareImportCollection(ctx, &orchestratorpb.PrepareImportCollectionRequest{
+		ChainId:  chainID,
+		Contract: address,
+		UserId:   user.UserID,
+	})
+	if err != nil {
+		return nil, mapImportError(err, "failed to prepare collection import")
+	}
+
+	return &schemas.CollectionImportChallenge{
+		Message:     resp.GetMessage(),
+		IssuedAt:    resp.GetIssuedAt(),
+		ExpiresAt:   resp.GetExpiresAt(),
+		ChallengeID: resp.GetChallengeId(),
+	}, nil
+}
+
+// ImportCollection verifies the signed challenge and registers the contract for indexing.
+// issuedAt is ignored; the orchestrator refuses calls without a challengeId and says why.
+func (r *OrchestratorMutationResolver) ImportCollection(ctx context.Context, chainID string, address string, issuedAt *string, signature string, challengeID *string) (*schemas.ImportedCollection, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).ImportCollection(ctx, &orchestratorpb.ImportCollectionRequest{
+		ChainId:     chainID,
+		Contract:    address,
+		UserId:      user.UserID,
+		Signature:   signature,
+		ChallengeId: utils.PtrStr(challengeID),
+	})
+	if err != nil {
+		return nil, mapImportError(err, "failed to import collection")
+	}
+
+	return utils.MapImportedCollection(resp), nil
+}
+
+// mapImportError passes the orchestrator's reason through for rejections the importer can act on
+func mapImportError(err error, action string) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.PermissionDenied, codes.AlreadyExists, codes.FailedPrecondition:
+		return fmt.Errorf("%s", status.Convert(err).Message())
+	}
+	return fmt.Errorf("%s: %w", action, err)
+}
@@ -0,0 +1,280 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	orgRoleOwner = string(schemas.OrganizationRoleOwner)
+	orgRoleAdmin = string(schemas.OrganizationRoleAdmin)
+)
+
+func (r *UserQueryResolver) MyOrganizations(ctx context.Context) ([]*schemas.OrganizationMembership, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).ListUserOrganizations(ctx, &userpb.ListUserOrganizationsRequest{UserId: user.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*schemas.OrganizationMembership, 0, len(resp.GetMemberships()))
+	for _, m := range resp.GetMemberships() {
+		out = append(out, utils.MapOrganizationMembership(m))
+	}
+	return out, nil
+}
+
+func (r *UserQueryResolver) Organization(ctx context.Context, id string) (*schemas.OrganizationDetails, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).GetOrganization(ctx, &userpb.GetOrganizationRequest{OrgId: id, UserId: user.UserID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	out := &schemas.OrganizationDetails{
+		Organization: utils.MapOrganization(resp.GetOrganization()),
+		Members:      make([]*schemas.OrganizationMember, 0, len(resp.GetMembers())),
+	}
+	for _, m := range resp.GetMembers() {
+		out.Members = append(out.Members, utils.MapOrganizationMember(m))
+	}
+	return out, nil
+}
+
+func (r *UserMutationResolver) CreateOrganization(ctx context.Context, name string) (*schemas.Organization, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).CreateOrganization(ctx, &userpb.CreateOrganizationRequest{
+		UserId: user.UserID,
+		Name:   name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapOrganization(resp.GetOrganization()), nil
+}
+
+func (r *UserMutationResolver) InviteOrganizationMember(ctx context.Context, orgID string, email string, role *schemas.OrganizationRole) (*schemas.OrganizationInvitation, error) {
+	user, err := middleware.RequireOrgRole(ctx, orgID, orgRoleOwner, orgRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	invitedRole := schemas.OrganizationRoleMember
+	if role != nil {
+		invitedRole = *role
+	}
+
+	resp, err := (*r.server.userClient.Client).InviteOrganizationMember(ctx, &userpb.InviteOrganizationMemberRequest{
+		OrgId:     orgID,
+		InviterId: user.UserID,
+		Email:     email,
+		Role:      string(invitedRole),
+	})
+	if err != nil {
+		return nil, mapOrganizationError(err)
+	}
+	return &schemas.OrganizationInvitation{
+		ID:        resp.GetInvitationId(),
+		ExpiresAt: resp.GetExpiresAt(),
+	}, nil
+}
+
+func (r *UserMutationResolver) AcceptOrganizationInvitation(ctx context.Context, token string) (*schemas.OrganizationMembership, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).AcceptOrganizationInvitation(ctx, &userpb.AcceptOrganizationInvitationRequest{
+		UserId: user.UserID,
+		Token:  token,
+	})
+	if err != nil {
+		return nil, mapOrganizationError(err)
+	}
+	return utils.MapOrganizationMembership(resp.GetMembership()), nil
+}
+
+func (r *UserMutationResolver) RemoveOrganizationMember(ctx context.Context, orgID string, userID string) (bool, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return false, err
+	}
+	// Anyone may leave; removing someone else takes an owner or admin
+	if userID != user.UserID {
+		if _, err := middleware.RequireOrgRole(ctx, orgID, orgRoleOwner, orgRoleAdmin); err != nil {
+			return false, err
+		}
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return false, fmt.Errorf("user service unavailable")
+	}
+
+	_, err = (*r.server.userClient.Client).RemoveOrganizationMember(ctx, &userpb.RemoveOrganizationMemberRequest{
+		OrgId:   orgID,
+		ActorId: user.UserID,
+		UserId:  userID,
+	})
+	if err != nil {
+		return false, mapOrganizationError(err)
+	}
+	return true, nil
+}
+
+func (r *UserMutationResolver) SetOrganizationMemberRole(ctx context.Context, orgID string, userID string, role schemas.OrganizationRole) (*schemas.OrganizationMember, error) {
+	user, err := middleware.RequireOrgRole(ctx, orgID, orgRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).SetOrganizationMemberRole(ctx, &userpb.SetOrganizationMemberRoleRequest{
+		OrgId:   orgID,
+		ActorId: user.UserID,
+		UserId:  userID,
+		Role:    string(role),
+	})
+	if err != nil {
+		return nil, mapOrganizationError(err)
+	}
+	return utils.MapOrganizationMember(resp.GetMember()), nil
+}
+
+// AssignCollectionToOrganization moves collection management between the creator and
+// organizations. Leaving an organization takes an owner or admin of it; joining one takes
+// an owner or admin of the target and, for creator-managed collections, the creator.
+func (r *UserMutationResolver) AssignCollectionToOrganization(ctx context.Context, chainID string, contract string, orgID *string) (*schemas.Collection, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	target := utils.PtrStr(orgID)
+
+	current, err := (*r.server.catalogClient.Client).GetCollection(ctx, &catalogpb.GetCollectionRequest{
+		ChainId:            chainID,
+		ContractAddress:    contract,
+		IncludeFlagged:     true,
+		IncludeUnconfirmed: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	currentOrg := current.GetCollection().GetOwnerOrgId()
+	if currentOrg == target {
+		return utils.MapCollection(current.GetCollection()), nil
+	}
+
+	if currentOrg != "" {
+		if _, err := middleware.RequireOrgRole(ctx, currentOrg, orgRoleOwner, orgRoleAdmin); err != nil {
+			return nil, err
+		}
+	}
+	if target != "" {
+		if _, err := middleware.RequireOrgRole(ctx, target, orgRoleOwner, orgRoleAdmin); err != nil {
+			return nil, err
+		}
+		if currentOrg == "" {
+			if err := r.requireCollectionCreator(ctx, user.UserID, chainID, contract, current.GetCollection().GetCreator()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resp, err := (*r.server.catalogClient.Client).SetCollectionOrganization(ctx, &catalogpb.SetCollectionOrganizationRequest{
+		ChainId:         chainID,
+		ContractAddress: contract,
+		OrgId:           target,
+		ActorId:         user.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapCollection(resp.GetCollection()), nil
+}
+
+// requireCollectionCreator checks that one of the user's linked wallets created the collection
+func (r *UserMutationResolver) requireCollectionCreator(ctx context.Context, userID, chainID, contract, creator string) error {
+	isCreator, err := r.server.isCollectionCreator(ctx, userID, chainID, contract, creator)
+	if err != nil {
+		return err
+	}
+	if !isCreator {
+		return fmt.Errorf("only the collection creator can assign it to an organization")
+	}
+	return nil
+}
+
+// isCollectionCreator reports whether one of the user's linked wallets, or a vault
+// delegating to them when the user honors delegations, is creator
+func (r *Resolver) isCollectionCreator(ctx context.Context, userID, chainID, contract, creator string) (bool, error) {
+	wallets, err := r.holderWallets(ctx, userID, chainID, contract)
+	if err != nil {
+		return false, err
+	}
+	for _, wallet := range wallets {
+		if strings.EqualFold(wallet, creator) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func mapOrganizationError(err error) error {
+	switch status.Code(err) {
+	case codes.PermissionDenied:
+		return fmt.Errorf("organization role does not allow this action")
+	case codes.AlreadyExists:
+		return fmt.Errorf("already a member of this organization")
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%s", status.Convert(err).Message())
+	}
+	return err
+}
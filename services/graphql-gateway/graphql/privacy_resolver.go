@@ -0,0 +1,287 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxHolderCollections bounds the owner's collections checked for a holders-only profile
+const maxHolderCollections = 20
+
+func (r *UserQueryResolver) UserProfile(ctx context.Context, userID string) (*schemas.UserProfile, error) {
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	visible, err := r.server.profileVisible(ctx, userID)
+	if err != nil || !visible {
+		return nil, err
+	}
+
+	resp, err := (*r.server.userClient.Client).GetUsersByIDs(ctx, &userpb.GetUsersByIDsRequest{UserIds: []string{userID}})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetUsers()) == 0 || !resp.GetUsers()[0].GetFound() {
+		return nil, nil
+	}
+	return utils.MapUserProfile(resp.GetUsers()[0]), nil
+}
+
+// UserActivity pages the indexed activity of one of the user's wallets. Unlike
+// walletActivity it leaves out intents, which only the signer sees.
+func (r *UserQueryResolver) UserActivity(ctx context.Context, userID string, address string, cursor *string, limit *int) (*schemas.WalletActivityPage, error) {
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	visible, err := r.server.profileVisible(ctx, userID)
+	if err != nil || !visible {
+		return nil, err
+	}
+
+	n := defaultActivityLimit
+	if limit != nil && *limit > 0 {
+		n = *limit
+	}
+	if n > maxActivityLimit {
+		n = maxActivityLimit
+	}
+	var pos activityCursor
+	if cursor != nil && *cursor != "" {
+		if pos, err = decodeActivityCursor(*cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	links, err := (*r.server.walletClient.Client).ListLinks(ctx, &walletpb.ListLinksRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	linked := false
+	for _, link := range links.GetLinks() {
+		if !link.GetIsWatchOnly() && strings.EqualFold(link.GetAddress(), address) {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		return nil, nil
+	}
+
+	req := &catalogpb.ListWalletActivityRequest{Address: address, Limit: int32(n)}
+	if pos.Catalog != nil {
+		req.Before = timestamppb.New(pos.Catalog.At)
+		req.BeforeId = pos.Catalog.ID
+	}
+	resp, err := (*r.server.catalogClient.Client).ListWalletActivity(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := resp.GetActivities()
+	entries := make([]activityEntry, len(activities))
+	for i, a := range activities {
+		entries[i] = activityEntry{item: utils.MapWalletActivity(a), at: a.GetOccurredAt().AsTime(), id: a.GetId()}
+	}
+	page, next := mergeActivity(entries, n, activityCursor{})
+
+	out := &schemas.WalletActivityPage{Items: page}
+	if len(activities) == n {
+		encoded, err := encodeActivityCursor(next)
+		if err != nil {
+			return nil, err
+		}
+		out.NextCursor = &encoded
+	}
+	return out, nil
+}
+
+func (r *UserQueryResolver) MyRelationships(ctx context.Context, kind schemas.RelationshipKind) ([]*schemas.UserRelationship, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).ListRelationships(ctx, &userpb.ListRelationshipsRequest{
+		UserId: user.UserID,
+		Kind:   string(kind),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*schemas.UserRelationship, len(resp.GetRelationships()))
+	for i, rel := range resp.GetRelationships() {
+		out[i] = utils.MapUserRelationship(rel)
+	}
+	return out, nil
+}
+
+func (r *UserMutationResolver) BlockUser(ctx context.Context, userID string) (bool, error) {
+	return r.server.setRelationship(ctx, userID, schemas.RelationshipKindBlock, true)
+}
+
+func (r *UserMutationResolver) UnblockUser(ctx context.Context, userID string) (bool, error) {
+	return r.server.setRelationship(ctx, userID, schemas.RelationshipKindBlock, false)
+}
+
+func (r *UserMutationResolver) MuteUser(ctx context.Context, userID string) (bool, error) {
+	return r.server.setRelationship(ctx, userID, schemas.RelationshipKindMute, true)
+}
+
+func (r *UserMutationResolver) UnmuteUser(ctx context.Context, userID string) (bool, error) {
+	return r.server.setRelationship(ctx, userID, schemas.RelationshipKindMute, false)
+}
+
+func (r *UserMutationResolver) FollowUser(ctx context.Context, userID string) (bool, error) {
+	return r.server.setRelationship(ctx, userID, schemas.RelationshipKindFollow, true)
+}
+
+func (r *UserMutationResolver) UnfollowUser(ctx context.Context, userID string) (bool, error) {
+	return r.server.setRelationship(ctx, userID, schemas.RelationshipKindFollow, false)
+}
+
+func (r *UserMutationResolver) SetProfileVisibility(ctx context.Context, visibility schemas.ProfileVisibility) (schemas.ProfileVisibility, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return "", fmt.Errorf("user service unavailable")
+	}
+
+	_, err = (*r.server.userClient.Client).SetProfileVisibility(ctx, &userpb.SetProfileVisibilityRequest{
+		UserId:     user.UserID,
+		Visibility: string(visibility),
+	})
+	if err != nil {
+		return "", err
+	}
+	return visibility, nil
+}
+
+func (r *Resolver) setRelationship(ctx context.Context, targetID string, kind schemas.RelationshipKind, active bool) (bool, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return false, err
+	}
+	if r.userClient == nil || r.userClient.Client == nil {
+		return false, fmt.Errorf("user service unavailable")
+	}
+
+	_, err = (*r.userClient.Client).SetRelationship(ctx, &userpb.SetRelationshipRequest{
+		UserId:   user.UserID,
+		TargetId: targetID,
+		Kind:     string(kind),
+		Active:   active,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, fmt.Errorf("user not found")
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// profileVisible reports whether the current viewer, who may be anonymous, may see the
+// owner's profile and activity. Users always see their own; a block either way hides it.
+func (r *Resolver) profileVisible(ctx context.Context, ownerID string) (bool, error) {
+	var viewerID string
+	if viewer := middleware.GetCurrentUser(ctx); viewer != nil {
+		viewerID = viewer.UserID
+	}
+	if viewerID != "" && viewerID == ownerID {
+		return true, nil
+	}
+
+	access, err := (*r.userClient.Client).GetProfileAccess(ctx, &userpb.GetProfileAccessRequest{
+		ViewerId: viewerID,
+		OwnerId:  ownerID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if access.GetBlocked() {
+		return false, nil
+	}
+
+	switch schemas.ProfileVisibility(access.GetVisibility()) {
+	case schemas.ProfileVisibilityPublic:
+		return true, nil
+	case schemas.ProfileVisibilityHolders:
+		if viewerID == "" {
+			return false, nil
+		}
+		return r.holdsCreatorToken(ctx, viewerID, ownerID)
+	default:
+		return false, nil
+	}
+}
+
+// holdsCreatorToken reports whether any of the viewer's wallets holds a token from one of
+// the owner's most recent collections
+func (r *Resolver) holdsCreatorToken(ctx context.Context, viewerID, ownerID string) (bool, error) {
+	if r.catalogClient == nil || r.catalogClient.Client == nil || r.walletClient == nil || r.walletClient.Client == nil {
+		return false, nil
+	}
+
+	collections, err := (*r.catalogClient.Client).ListCollections(ctx, &catalogpb.ListCollectionsRequest{
+		CreatedByUserId: ownerID,
+		Limit:           maxHolderCollections,
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(collections.GetCollections()) == 0 {
+		return false, nil
+	}
+	links, err := (*r.walletClient.Client).ListLinks(ctx, &walletpb.ListLinksRequest{UserId: viewerID})
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range collections.GetCollections() {
+		for _, link := range links.GetLinks() {
+			if link.GetIsWatchOnly() {
+				continue
+			}
+			tokens, err := (*r.catalogClient.Client).ListTokens(ctx, &catalogpb.ListTokensRequest{
+				ChainId:         c.GetChainId(),
+				ContractAddress: c.GetContractAddress(),
+				Owner:           link.GetAddress(),
+				Limit:           1,
+			})
+			if err != nil {
+				return false, err
+			}
+			if len(tokens.GetTokens()) > 0 {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
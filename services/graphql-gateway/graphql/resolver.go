@@ -1,8 +1,10 @@
 package graphql_resolver
 
 import (
+	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
 	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/platformstatus"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/websocket"
 )
 
@@ -12,7 +14,13 @@ type Resolver struct {
 	mediaClient         *grpcclients.MediaClient
 	chainRegistryClient *grpcclients.ChainRegistryClient
 	orchestratorClient  *grpcclients.OrchestratorClient
+	catalogClient       *grpcclients.CatalogClient
+	userClient          *grpcclients.UserClient
 	websocketClient     *websocket.Client
+	platformStatus      *platformstatus.Aggregator
+
+	// suggest results by normalized query and limit
+	suggestCache *lru.LRU[suggestEntry]
 }
 
 func NewResolver(authClient *grpcclients.AuthClient, walletClient *grpcclients.WalletClient, mediaClient *grpcclients.MediaClient) *Resolver {
@@ -20,6 +28,7 @@ func NewResolver(authClient *grpcclients.AuthClient, walletClient *grpcclients.W
 		authClient:   authClient,
 		walletClient: walletClient,
 		mediaClient:  mediaClient,
+		suggestCache: newSuggestCache(),
 	}
 }
 
@@ -33,14 +42,83 @@ func (r *Resolver) WithChainRegistryClient(c *grpcclients.ChainRegistryClient) *
 	return r
 }
 
+func (r *Resolver) WithCatalogClient(c *grpcclients.CatalogClient) *Resolver {
+	r.catalogClient = c
+	return r
+}
+
+func (r *Resolver) WithUserClient(c *grpcclients.UserClient) *Resolver {
+	r.userClient = c
+	return r
+}
+
 func (r *Resolver) WithWebSocketClient(c *websocket.Client) *Resolver {
 	r.websocketClient = c
 	return r
 }
 
+func (r *Resolver) WithPlatformStatus(a *platformstatus.Aggregator) *Resolver {
+	r.platformStatus = a
+	return r
+}
+
+// QueryResolver composes the per-domain Query resolvers. Each domain owns its
+// slice of the schema; a field declared in two slices fails to compile here.
+type QueryResolver struct {
+	*AuthQueryResolver
+	*CatalogQueryResolver
+	*ChainRegistryQueryResolver
+	*MediaQueryResolver
+	*OrchestratorQueryResolver
+	*UserQueryResolver
+}
+
+// MutationResolver composes the per-domain Mutation resolvers
+type MutationResolver struct {
+	*AuthMutationResolver
+	*CatalogMutationResolver
+	*ChainRegistryMutationResolver
+	*MediaMutationResolver
+	*OrchestratorMutationResolver
+	*UserMutationResolver
+}
+
+// SubscriptionResolver composes the per-domain Subscription resolvers
+type SubscriptionResolver struct {
+	*CatalogSubscriptionResolver
+	*MediaSubscriptionResolver
+	*OrchestratorSubscriptionResolver
+	*UserSubscriptionResolver
+}
+
 // gqlgen root bindings
-func (r *Resolver) Mutation() schemas.MutationResolver { return &MutationResolver{server: r} }
-func (r *Resolver) Query() schemas.QueryResolver       { return &QueryResolver{server: r} }
+func (r *Resolver) Mutation() schemas.MutationResolver {
+	return &MutationResolver{
+		AuthMutationResolver:          &AuthMutationResolver{server: r},
+		CatalogMutationResolver:       &CatalogMutationResolver{server: r},
+		ChainRegistryMutationResolver: &ChainRegistryMutationResolver{server: r},
+		MediaMutationResolver:         &MediaMutationResolver{server: r},
+		OrchestratorMutationResolver:  &OrchestratorMutationResolver{server: r},
+		UserMutationResolver:          &UserMutationResolver{server: r},
+	}
+}
+
+func (r *Resolver) Query() schemas.QueryResolver {
+	return &QueryResolver{
+		AuthQueryResolver:          &AuthQueryResolver{server: r},
+		CatalogQueryResolver:       &CatalogQueryResolver{server: r},
+		ChainRegistryQueryResolver: &ChainRegistryQueryResolver{server: r},
+		MediaQueryResolver:         &MediaQueryResolver{server: r},
+		OrchestratorQueryResolver:  &OrchestratorQueryResolver{server: r},
+		UserQueryResolver:          &UserQueryResolver{server: r},
+	}
+}
+
 func (r *Resolver) Subscription() schemas.SubscriptionResolver {
-	return &SubscriptionResolver{server: r}
+	return &SubscriptionResolver{
+		CatalogSubscriptionResolver:      &CatalogSubscriptionResolver{server: r},
+		MediaSubscriptionResolver:        &MediaSubscriptionResolver{server: r},
+		OrchestratorSubscriptionResolver: &OrchestratorSubscriptionResolver{server: r},
+		UserSubscriptionResolver:         &UserSubscriptionResolver{server: r},
+	}
 }
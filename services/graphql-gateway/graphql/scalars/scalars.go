@@ -0,0 +1,125 @@
+// Package scalars implements the custom GraphQL scalars. Values stay strings in Go; the
+// scalars validate input and normalize it so resolvers only see well-formed values.
+package scalars
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// maxUint256 bounds BigInt, which carries on-chain uint256 values
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// caip2Pattern is a CAIP-2 chain id: namespace:reference
+var caip2Pattern = regexp.MustCompile(`^[-a-z0-9]{3,8}:[-_a-zA-Z0-9]{1,32}$`)
+
+// MarshalBigInt writes a BigInt as a decimal string so clients don't lose precision
+func MarshalBigInt(v string) graphql.Marshaler {
+	return graphql.MarshalString(v)
+}
+
+// UnmarshalBigInt accepts a decimal string or an integer and returns its canonical decimal form
+func UnmarshalBigInt(v interface{}) (string, error) {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = strings.TrimSpace(v)
+	case json.Number:
+		s = v.String()
+	case int:
+		s = strconv.Itoa(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	default:
+		return "", fmt.Errorf("BigInt must be a decimal string, got %T", v)
+	}
+
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return "", fmt.Errorf("BigInt %q is not a decimal integer", s)
+	}
+	if n.Sign() < 0 || n.Cmp(maxUint256) > 0 {
+		return "", fmt.Errorf("BigInt %q is out of the uint256 range", s)
+	}
+	return n.String(), nil
+}
+
+// MarshalAddress writes an address as stored by the services
+func MarshalAddress(v string) graphql.Marshaler {
+	return graphql.MarshalString(v)
+}
+
+// UnmarshalAddress accepts a 0x-prefixed 20-byte hex address. Mixed-case addresses must
+// carry a valid EIP-55 checksum; all-lower and all-upper ones are taken as unchecksummed.
+func UnmarshalAddress(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("Address must be a string, got %T", v)
+	}
+	if !strings.HasPrefix(s, "0x") || !common.IsHexAddress(s) {
+		return "", fmt.Errorf("Address %q is not a 0x-prefixed 20-byte hex address", s)
+	}
+
+	digits := s[2:]
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) {
+		if common.HexToAddress(s).Hex() != s {
+			return "", fmt.Errorf("Address %q has an invalid EIP-55 checksum", s)
+		}
+	}
+	return s, nil
+}
+
+// MarshalChainId writes a CAIP-2 chain id. Services that store the indexer form (eip155-1)
+// are mapped back to eip155:1.
+func MarshalChainId(v string) graphql.Marshaler {
+	return graphql.MarshalString(normalizeChainId(v))
+}
+
+// UnmarshalChainId accepts a CAIP-2 chain id such as eip155:1. The indexer form eip155-1 is
+// accepted too and normalized to CAIP-2.
+func UnmarshalChainId(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("ChainId must be a string, got %T", v)
+	}
+
+	s = normalizeChainId(strings.TrimSpace(s))
+	if !caip2Pattern.MatchString(s) {
+		return "", fmt.Errorf("ChainId %q is not a CAIP-2 chain id (expected e.g. eip155:1)", s)
+	}
+	return s, nil
+}
+
+func normalizeChainId(s string) string {
+	if strings.Contains(s, ":") {
+		return s
+	}
+	return strings.Replace(s, "-", ":", 1)
+}
+
+// MarshalDateTime writes a timestamp as formatted by the resolvers (RFC 3339)
+func MarshalDateTime(v string) graphql.Marshaler {
+	return graphql.MarshalString(v)
+}
+
+// UnmarshalDateTime accepts an RFC 3339 timestamp and returns it in UTC
+func UnmarshalDateTime(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("DateTime must be an RFC 3339 string, got %T", v)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(s))
+	if err != nil {
+		return "", fmt.Errorf("DateTime %q is not an RFC 3339 timestamp", s)
+	}
+	return t.UTC().Format(time.RFC3339Nano), nil
+}
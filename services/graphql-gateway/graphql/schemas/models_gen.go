@@ -11,14 +11,186 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
+type AccountEvent struct {
+	Type AccountEventType `json:"type"`
+	// DateTime: RFC 3339
+	OccurredAt string  `json:"occurredAt"`
+	WalletID   *string `json:"walletId,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Address *string `json:"address,omitempty"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID          *string `json:"chainId,omitempty"`
+	IsPrimary        *bool   `json:"isPrimary,omitempty"`
+	PreviousWalletID *string `json:"previousWalletId,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	PreviousAddress *string `json:"previousAddress,omitempty"`
+	SessionID       *string `json:"sessionId,omitempty"`
+	ExportID        *string `json:"exportId,omitempty"`
+}
+
+type AddressChallenge struct {
+	ID      string          `json:"id"`
+	Message string          `json:"message"`
+	Scheme  SignatureScheme `json:"scheme"`
+	// DateTime: RFC 3339
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type AddressProofInput struct {
+	ChallengeID string `json:"challengeId"`
+	// Hex: 0x-prefixed hex bytes
+	Signature string `json:"signature"`
+}
+
+type AirdropBatch struct {
+	IntentID   string              `json:"intentId"`
+	Seq        int                 `json:"seq"`
+	Recipients []*AirdropRecipient `json:"recipients"`
+	// BigInt: uint256 as a decimal string
+	GasLimit  string     `json:"gasLimit"`
+	TxRequest *TxRequest `json:"txRequest"`
+}
+
+type AirdropBundle struct {
+	BundleID string `json:"bundleId"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract       string          `json:"contract"`
+	RecipientCount int             `json:"recipientCount"`
+	Batches        []*AirdropBatch `json:"batches"`
+}
+
+type AirdropProgress struct {
+	BundleID string `json:"bundleId"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract       string                 `json:"contract"`
+	Status         IntentStatus           `json:"status"`
+	RecipientCount int                    `json:"recipientCount"`
+	BatchCount     int                    `json:"batchCount"`
+	Confirmed      int                    `json:"confirmed"`
+	Failed         int                    `json:"failed"`
+	Batches        []*IntentStatusPayload `json:"batches"`
+}
+
+type AirdropRecipient struct {
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Address string `json:"address"`
+	Amount  int    `json:"amount"`
+}
+
+type AirdropRecipientInput struct {
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Address string `json:"address"`
+	Amount  *int   `json:"amount,omitempty"`
+}
+
+type APIKey struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Prefix string  `json:"prefix"`
+	Plan   APIPlan `json:"plan"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+	// DateTime: RFC 3339
+	LastUsedAt *string `json:"lastUsedAt,omitempty"`
+	// DateTime: RFC 3339
+	RevokedAt *string `json:"revokedAt,omitempty"`
+}
+
+type APIKeyUsage struct {
+	APIKey    *APIKey         `json:"apiKey"`
+	Limits    *APIPlanLimits  `json:"limits"`
+	UsedToday int             `json:"usedToday"`
+	Hours     []*APIUsageHour `json:"hours"`
+}
+
+type APIPlanLimits struct {
+	Plan           APIPlan          `json:"plan"`
+	DailyQuota     *int             `json:"dailyQuota,omitempty"`
+	BurstPerSecond *int             `json:"burstPerSecond,omitempty"`
+	Enforcement    QuotaEnforcement `json:"enforcement"`
+}
+
+type APIUsageHour struct {
+	// DateTime: RFC 3339
+	Hour     string `json:"hour"`
+	Requests int    `json:"requests"`
+	Rejected int    `json:"rejected"`
+}
+
 type AuthPayload struct {
 	AccessToken  string `json:"accessToken"`
 	RefreshToken string `json:"refreshToken"`
-	ExpiresAt    string `json:"expiresAt"`
-	UserID       string `json:"userId"`
+	// DateTime: RFC 3339
+	ExpiresAt string `json:"expiresAt"`
+	UserID    string `json:"userId"`
+}
+
+type BulkTransferApproval struct {
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract  string     `json:"contract"`
+	Check     *TxRequest `json:"check"`
+	TxRequest *TxRequest `json:"txRequest"`
+}
+
+type BulkTransferBatch struct {
+	IntentID string `json:"intentId"`
+	Seq      int    `json:"seq"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	Standard string `json:"standard"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	To        string               `json:"to"`
+	Tokens    []*BulkTransferToken `json:"tokens"`
+	TxRequest *TxRequest           `json:"txRequest"`
+}
+
+type BulkTransferBundle struct {
+	BundleID string `json:"bundleId"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	From string `json:"from"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Signer     string                  `json:"signer"`
+	TokenCount int                     `json:"tokenCount"`
+	Approvals  []*BulkTransferApproval `json:"approvals"`
+	Batches    []*BulkTransferBatch    `json:"batches"`
+}
+
+type BulkTransferItemInput struct {
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// BigInt: uint256 as a decimal string
+	TokenID string `json:"tokenId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	To     string `json:"to"`
+	Amount *int   `json:"amount,omitempty"`
+}
+
+type BulkTransferProgress struct {
+	BundleID string `json:"bundleId"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID        string                 `json:"chainId"`
+	Status         IntentStatus           `json:"status"`
+	RecipientCount int                    `json:"recipientCount"`
+	BatchCount     int                    `json:"batchCount"`
+	Confirmed      int                    `json:"confirmed"`
+	Failed         int                    `json:"failed"`
+	Batches        []*IntentStatusPayload `json:"batches"`
+}
+
+type BulkTransferToken struct {
+	// BigInt: uint256 as a decimal string
+	TokenID string `json:"tokenId"`
+	Amount  int    `json:"amount"`
 }
 
 type BumpChainVersionInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
 	ChainID string  `json:"chainId"`
 	Reason  *string `json:"reason,omitempty"`
 }
@@ -28,7 +200,36 @@ type BumpChainVersionPayload struct {
 	NewVersion string `json:"newVersion"`
 }
 
+type CallTargetOverride struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Address   string `json:"address"`
+	Reason    string `json:"reason"`
+	GrantedBy string `json:"grantedBy"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+}
+
+type ChainCapabilities struct {
+	Erc721Factory  bool `json:"erc721Factory"`
+	Erc1155Factory bool `json:"erc1155Factory"`
+	AllowlistMint  bool `json:"allowlistMint"`
+	LazyMint       bool `json:"lazyMint"`
+	Version        int  `json:"version"`
+	// DateTime: RFC 3339
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+type ChainCapabilitiesInfo struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID         string             `json:"chainId"`
+	Capabilities    *ChainCapabilities `json:"capabilities"`
+	RegistryVersion string             `json:"registryVersion"`
+}
+
 type ChainContracts struct {
+	// ChainId: CAIP-2, e.g. eip155:1
 	ChainID         string       `json:"chainId"`
 	ChainNumeric    int          `json:"chainNumeric"`
 	NativeSymbol    *string      `json:"nativeSymbol,omitempty"`
@@ -38,11 +239,39 @@ type ChainContracts struct {
 }
 
 type ChainGasPolicy struct {
+	// ChainId: CAIP-2, e.g. eip155:1
 	ChainID         string     `json:"chainId"`
 	Policy          *GasPolicy `json:"policy"`
 	RegistryVersion string     `json:"registryVersion"`
 }
 
+type ChainHead struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// BigInt: uint256 as a decimal string
+	LatestBlock     string `json:"latestBlock"`
+	LatestBlockHash string `json:"latestBlockHash"`
+	// DateTime: RFC 3339
+	LatestBlockTime string `json:"latestBlockTime"`
+	// BigInt: uint256 as a decimal string
+	FinalizedBlock string `json:"finalizedBlock"`
+	FinalizedByTag bool   `json:"finalizedByTag"`
+	// DateTime: RFC 3339
+	AdvancedAt string `json:"advancedAt"`
+	Stale      bool   `json:"stale"`
+}
+
+type ChainIndexingStatus struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string         `json:"chainId"`
+	Status  PlatformHealth `json:"status"`
+	// BigInt: uint256 as a decimal string
+	LatestBlock string `json:"latestBlock"`
+	// DateTime: RFC 3339
+	AdvancedAt *string `json:"advancedAt,omitempty"`
+	LagSeconds int     `json:"lagSeconds"`
+}
+
 type ChainParams struct {
 	RequiredConfirmations int  `json:"requiredConfirmations"`
 	ReorgDepth            int  `json:"reorgDepth"`
@@ -50,67 +279,361 @@ type ChainParams struct {
 }
 
 type ChainRPCEndpoints struct {
+	// ChainId: CAIP-2, e.g. eip155:1
 	ChainID         string         `json:"chainId"`
 	Endpoints       []*RPCEndpoint `json:"endpoints"`
 	RegistryVersion string         `json:"registryVersion"`
 }
 
+type Collection struct {
+	ID          string  `json:"id"`
+	Slug        string  `json:"slug"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	ContractAddress string `json:"contractAddress"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Creator string `json:"creator"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Owner *string `json:"owner,omitempty"`
+	Type  string  `json:"type"`
+	// BigInt: uint256 as a decimal string
+	MaxSupply *string `json:"maxSupply,omitempty"`
+	// BigInt: uint256 as a decimal string
+	TotalSupply *string `json:"totalSupply,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	RoyaltyRecipient      *string             `json:"royaltyRecipient,omitempty"`
+	RoyaltyBps            int                 `json:"royaltyBps"`
+	TokenURI              *string             `json:"tokenURI,omitempty"`
+	ImageURL              *string             `json:"imageUrl,omitempty"`
+	IsVerified            bool                `json:"isVerified"`
+	Flagged               bool                `json:"flagged"`
+	Reported              bool                `json:"reported"`
+	OwnerOrgID            *string             `json:"ownerOrgId,omitempty"`
+	Confirmations         int                 `json:"confirmations"`
+	RequiredConfirmations int                 `json:"requiredConfirmations"`
+	PendingFinality       bool                `json:"pendingFinality"`
+	Tagline               *string             `json:"tagline,omitempty"`
+	ContentLocale         *string             `json:"contentLocale,omitempty"`
+	LocalizedContent      []*LocalizedContent `json:"localizedContent"`
+	PayoutSplits          []*PayoutSplit      `json:"payoutSplits"`
+	Category              *CollectionCategory `json:"category,omitempty"`
+	Tags                  []string            `json:"tags"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+	// DateTime: RFC 3339
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type CollectionConstraints struct {
+	// BigInt: uint256 as a decimal string
+	MaxRoyaltyBps string `json:"maxRoyaltyBps"`
+	// BigInt: uint256 as a decimal string
+	MinStageDurationSec string `json:"minStageDurationSec"`
+	// BigInt: uint256 as a decimal string
+	MaxSupplyCap string `json:"maxSupplyCap"`
+}
+
+type CollectionDefaults struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID     string                 `json:"chainId"`
+	Constraints *CollectionConstraints `json:"constraints"`
+	// BigInt: uint256 as a decimal string
+	RoyaltyFee string `json:"royaltyFee"`
+	// BigInt: uint256 as a decimal string
+	MaxSupply string `json:"maxSupply"`
+	// BigInt: uint256 as a decimal string
+	MintLimitPerWallet string `json:"mintLimitPerWallet"`
+	// BigInt: uint256 as a decimal string
+	AllowlistStageDuration string   `json:"allowlistStageDuration"`
+	SupportedTypes         []string `json:"supportedTypes"`
+}
+
+type CollectionExport struct {
+	ID   string               `json:"id"`
+	Kind CollectionExportKind `json:"kind"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// DateTime: RFC 3339
+	From string `json:"from"`
+	// DateTime: RFC 3339
+	To       string                 `json:"to"`
+	Status   CollectionExportStatus `json:"status"`
+	RowCount int                    `json:"rowCount"`
+	Error    *string                `json:"error,omitempty"`
+	CSV      *SnapshotExport        `json:"csv,omitempty"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+	// DateTime: RFC 3339
+	CompletedAt *string `json:"completedAt,omitempty"`
+}
+
+type CollectionFilterInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID *string `json:"chainId,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Creator      *string          `json:"creator,omitempty"`
+	VerifiedOnly *bool            `json:"verifiedOnly,omitempty"`
+	FloorPrice   *PriceRangeInput `json:"floorPrice,omitempty"`
+}
+
+type CollectionImportChallenge struct {
+	Message string `json:"message"`
+	// DateTime: RFC 3339
+	IssuedAt string `json:"issuedAt"`
+	// DateTime: RFC 3339
+	ExpiresAt   string `json:"expiresAt"`
+	ChallengeID string `json:"challengeId"`
+}
+
+type CollectionSortInput struct {
+	Field     CollectionSortField `json:"field"`
+	Direction *SortDirection      `json:"direction,omitempty"`
+}
+
+type ConsumerStatus struct {
+	Consumer             string  `json:"consumer"`
+	Queue                string  `json:"queue"`
+	MessagesPerSecond    float64 `json:"messagesPerSecond"`
+	InFlight             int     `json:"inFlight"`
+	OldestUnackedSeconds float64 `json:"oldestUnackedSeconds"`
+	Processed            int     `json:"processed"`
+	Failed               int     `json:"failed"`
+	// DateTime: RFC 3339
+	ReportedAt    string `json:"reportedAt"`
+	Stale         bool   `json:"stale"`
+	FallingBehind bool   `json:"fallingBehind"`
+}
+
 type Contract struct {
-	Name        string            `json:"name"`
-	Address     string            `json:"address"`
-	StartBlock  *int              `json:"startBlock,omitempty"`
-	VerifiedAt  *string           `json:"verifiedAt,omitempty"`
-	Standard    *ContractStandard `json:"standard,omitempty"`
-	ImplAddress *string           `json:"implAddress,omitempty"`
-	AbiSha256   *string           `json:"abiSha256,omitempty"`
-	AbiURL      *string           `json:"abiUrl,omitempty"`
+	Name string `json:"name"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Address    string `json:"address"`
+	StartBlock *int   `json:"startBlock,omitempty"`
+	// DateTime: RFC 3339
+	VerifiedAt *string           `json:"verifiedAt,omitempty"`
+	Standard   *ContractStandard `json:"standard,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	ImplAddress *string `json:"implAddress,omitempty"`
+	AbiSha256   *string `json:"abiSha256,omitempty"`
+	AbiURL      *string `json:"abiUrl,omitempty"`
 }
 
 type ContractMeta struct {
+	// ChainId: CAIP-2, e.g. eip155:1
 	ChainID         string    `json:"chainId"`
 	Contract        *Contract `json:"contract"`
 	RegistryVersion string    `json:"registryVersion"`
 }
 
+type CreatedAPIKey struct {
+	APIKey *APIKey `json:"apiKey"`
+	Secret string  `json:"secret"`
+}
+
+type DropSubmission struct {
+	ID string `json:"id"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract    *string `json:"contract,omitempty"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	ImageURL    *string `json:"imageUrl,omitempty"`
+	ExternalURL string  `json:"externalUrl"`
+	// Wei: Amount in wei as a decimal string
+	MintPrice *string `json:"mintPrice,omitempty"`
+	// DateTime: RFC 3339
+	StartsAt string `json:"startsAt"`
+	// DateTime: RFC 3339
+	EndsAt     *string              `json:"endsAt,omitempty"`
+	Status     DropSubmissionStatus `json:"status"`
+	ReviewNote *string              `json:"reviewNote,omitempty"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+}
+
+type Earnings struct {
+	Period EarningsPeriod `json:"period"`
+	// DateTime: RFC 3339
+	Since  *string          `json:"since,omitempty"`
+	Totals []*EarningsTotal `json:"totals"`
+}
+
+type EarningsTotal struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract       string  `json:"contract"`
+	CollectionName *string `json:"collectionName,omitempty"`
+	Currency       string  `json:"currency"`
+	// BigInt: uint256 as a decimal string
+	Amount string `json:"amount"`
+	Sales  int    `json:"sales"`
+}
+
+type EmailStatus struct {
+	Email        string `json:"email"`
+	Verified     bool   `json:"verified"`
+	DigestOptOut bool   `json:"digestOptOut"`
+	// DateTime: RFC 3339
+	VerifiedAt *string     `json:"verifiedAt,omitempty"`
+	OptOuts    []EmailKind `json:"optOuts"`
+}
+
+type EmailUnsubscribeResult struct {
+	Kind  EmailKind    `json:"kind"`
+	Email *EmailStatus `json:"email"`
+}
+
+type FlagItemInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// BigInt: uint256 as a decimal string
+	TokenID *string          `json:"tokenId,omitempty"`
+	Reason  ModerationReason `json:"reason"`
+	Note    *string          `json:"note,omitempty"`
+}
+
 type GasPolicy struct {
 	MaxFeeGwei              float64  `json:"maxFeeGwei"`
 	PriorityFeeGwei         float64  `json:"priorityFeeGwei"`
 	Multiplier              float64  `json:"multiplier"`
 	LastObservedBaseFeeGwei *float64 `json:"lastObservedBaseFeeGwei,omitempty"`
-	UpdatedAt               *string  `json:"updatedAt,omitempty"`
+	// DateTime: RFC 3339
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+type HolderSnapshot struct {
+	ID string `json:"id"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// BigInt: uint256 as a decimal string
+	BlockNumber string `json:"blockNumber"`
+	HolderCount int    `json:"holderCount"`
+	// BigInt: uint256 as a decimal string
+	TotalQuantity string          `json:"totalQuantity"`
+	CSV           *SnapshotExport `json:"csv,omitempty"`
+	JSON          *SnapshotExport `json:"json,omitempty"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+}
+
+type Impersonation struct {
+	ImpersonatorID string `json:"impersonatorId"`
+	Reason         string `json:"reason"`
+	// DateTime: RFC 3339
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type ImpersonationPayload struct {
+	AccessToken string `json:"accessToken"`
+	// DateTime: RFC 3339
+	ExpiresAt      string `json:"expiresAt"`
+	UserID         string `json:"userId"`
+	ImpersonatorID string `json:"impersonatorId"`
+}
+
+type ImportedCollection struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Address  string `json:"address"`
+	Standard string `json:"standard"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Owner  string `json:"owner"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+	// BigInt: uint256 as a decimal string
+	StartBlock string `json:"startBlock"`
 }
 
 type IntentStatusPayload struct {
-	IntentID        string       `json:"intentId"`
-	Kind            string       `json:"kind"`
-	Status          IntentStatus `json:"status"`
-	ChainID         *string      `json:"chainId,omitempty"`
-	TxHash          *string      `json:"txHash,omitempty"`
-	ContractAddress *string      `json:"contractAddress,omitempty"`
+	IntentID string       `json:"intentId"`
+	Kind     string       `json:"kind"`
+	Status   IntentStatus `json:"status"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID *string `json:"chainId,omitempty"`
+	// Hex: 0x-prefixed hex bytes
+	TxHash *string `json:"txHash,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	ContractAddress *string         `json:"contractAddress,omitempty"`
+	Error           *string         `json:"error,omitempty"`
+	Recovery        *IntentRecovery `json:"recovery,omitempty"`
+	// DateTime: RFC 3339
+	UpdatedAt   *string `json:"updatedAt,omitempty"`
+	StalenessMs *int    `json:"stalenessMs,omitempty"`
+}
+
+type LinkedWallet struct {
+	ID string `json:"id"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Address string `json:"address"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID     string   `json:"chainId"`
+	IsPrimary   bool     `json:"isPrimary"`
+	IsWatchOnly bool     `json:"isWatchOnly"`
+	Label       *string  `json:"label,omitempty"`
+	Tags        []string `json:"tags"`
+	// DateTime: RFC 3339
+	VerifiedAt *string `json:"verifiedAt,omitempty"`
+	// DateTime: RFC 3339
+	LastSeenAt *string `json:"lastSeenAt,omitempty"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+}
+
+type LocalizedContent struct {
+	Locale      string  `json:"locale"`
+	Description *string `json:"description,omitempty"`
+	Tagline     *string `json:"tagline,omitempty"`
+	// DateTime: RFC 3339
+	UpdatedAt string `json:"updatedAt"`
 }
 
 type MediaAsset struct {
-	ID        string          `json:"id"`
-	Kind      MediaKind       `json:"kind"`
-	Mime      string          `json:"mime"`
-	Bytes     *string         `json:"bytes,omitempty"`
-	Width     *int            `json:"width,omitempty"`
-	Height    *int            `json:"height,omitempty"`
-	Sha256    string          `json:"sha256"`
-	PinStatus PinStatus       `json:"pinStatus"`
-	IpfsCid   *string         `json:"ipfsCid,omitempty"`
+	ID   string    `json:"id"`
+	Kind MediaKind `json:"kind"`
+	Mime string    `json:"mime"`
+	// BigInt: uint256 as a decimal string
+	Bytes     *string   `json:"bytes,omitempty"`
+	Width     *int      `json:"width,omitempty"`
+	Height    *int      `json:"height,omitempty"`
+	Sha256    string    `json:"sha256"`
+	PinStatus PinStatus `json:"pinStatus"`
+	// CID: IPFS content identifier
+	IpfsCid *string `json:"ipfsCid,omitempty"`
+	// DateTime: RFC 3339
 	CreatedAt string          `json:"createdAt"`
 	RefCount  int             `json:"refCount"`
 	Variants  []*MediaVariant `json:"variants"`
 	URL       *MediaUrls      `json:"url,omitempty"`
 }
 
+type MediaKindUsage struct {
+	Kind MediaKind `json:"kind"`
+	// BigInt: uint256 as a decimal string
+	Bytes     string         `json:"bytes"`
+	Assets    int            `json:"assets"`
+	HardLimit *StorageLimits `json:"hardLimit,omitempty"`
+}
+
 type MediaPinStatusEvent struct {
-	AssetID   string    `json:"assetId"`
-	Status    PinStatus `json:"status"`
-	Cid       *string   `json:"cid,omitempty"`
-	Gateway   *string   `json:"gateway,omitempty"`
-	UpdatedAt string    `json:"updatedAt"`
+	AssetID string    `json:"assetId"`
+	Status  PinStatus `json:"status"`
+	// CID: IPFS content identifier
+	Cid     *string `json:"cid,omitempty"`
+	Gateway *string `json:"gateway,omitempty"`
+	// DateTime: RFC 3339
+	UpdatedAt string `json:"updatedAt"`
 }
 
 type MediaUrls struct {
@@ -126,40 +649,213 @@ type MediaVariant struct {
 	Format VariantFormat `json:"format"`
 }
 
+type MintStats struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string          `json:"contract"`
+	Window   MintStatsWindow `json:"window"`
+	// DateTime: RFC 3339
+	Since string `json:"since"`
+	// BigInt: uint256 as a decimal string
+	Mints         string `json:"mints"`
+	UniqueMinters int    `json:"uniqueMinters"`
+	// Wei: Amount in wei as a decimal string
+	Revenue string             `json:"revenue"`
+	Buckets []*MintStatsBucket `json:"buckets"`
+}
+
+type MintStatsBucket struct {
+	// DateTime: RFC 3339
+	Minute string `json:"minute"`
+	// BigInt: uint256 as a decimal string
+	Mints         string `json:"mints"`
+	UniqueMinters int    `json:"uniqueMinters"`
+	// Wei: Amount in wei as a decimal string
+	Revenue string `json:"revenue"`
+}
+
+type ModerationFlag struct {
+	ID string `json:"id"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// BigInt: uint256 as a decimal string
+	TokenID *string `json:"tokenId,omitempty"`
+	Status  string  `json:"status"`
+	Reason  string  `json:"reason"`
+	Note    *string `json:"note,omitempty"`
+	Source  string  `json:"source"`
+	// DateTime: RFC 3339
+	UpdatedAt string `json:"updatedAt"`
+}
+
 type Mutation struct {
 }
 
+type NftAvatar struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// BigInt: uint256 as a decimal string
+	TokenID  string `json:"tokenId"`
+	Verified bool   `json:"verified"`
+	// DateTime: RFC 3339
+	VerifiedAt *string `json:"verifiedAt,omitempty"`
+	// DateTime: RFC 3339
+	CheckedAt string `json:"checkedAt"`
+}
+
 type NoncePayload struct {
 	Nonce string `json:"nonce"`
 }
 
-type PrepareCreateCollectionInput struct {
-	ChainID                string  `json:"chainId"`
-	Name                   string  `json:"name"`
-	Symbol                 string  `json:"symbol"`
-	TokenURI               *string `json:"tokenURI,omitempty"`
-	Type                   string  `json:"type"`
-	Description            *string `json:"description,omitempty"`
-	MintPrice              *string `json:"mintPrice,omitempty"`
-	RoyaltyFee             *string `json:"royaltyFee,omitempty"`
-	MaxSupply              *string `json:"maxSupply,omitempty"`
-	MintLimitPerWallet     *string `json:"mintLimitPerWallet,omitempty"`
-	MintStartTime          *string `json:"mintStartTime,omitempty"`
-	AllowlistMintPrice     *string `json:"allowlistMintPrice,omitempty"`
-	PublicMintPrice        *string `json:"publicMintPrice,omitempty"`
-	AllowlistStageDuration *string `json:"allowlistStageDuration,omitempty"`
+type NumberFormat struct {
+	DecimalSeparator string `json:"decimalSeparator"`
+	GroupSeparator   string `json:"groupSeparator"`
+	CurrencySymbol   string `json:"currencySymbol"`
+	FractionDigits   int    `json:"fractionDigits"`
 }
 
-type PrepareCreateCollectionPayload struct {
+type Organization struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedBy string `json:"createdBy"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+}
+
+type OrganizationDetails struct {
+	Organization *Organization         `json:"organization"`
+	Members      []*OrganizationMember `json:"members"`
+}
+
+type OrganizationInvitation struct {
+	ID string `json:"id"`
+	// DateTime: RFC 3339
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type OrganizationMember struct {
+	UserID string           `json:"userId"`
+	Role   OrganizationRole `json:"role"`
+	// DateTime: RFC 3339
+	JoinedAt string `json:"joinedAt"`
+}
+
+type OrganizationMembership struct {
+	Organization *Organization    `json:"organization"`
+	Role         OrganizationRole `json:"role"`
+}
+
+type PayoutSplit struct {
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Recipient string `json:"recipient"`
+	Bps       int    `json:"bps"`
+}
+
+type PayoutSplitInput struct {
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Recipient string `json:"recipient"`
+	Bps       int    `json:"bps"`
+}
+
+type PinningHealth struct {
+	Status PlatformHealth `json:"status"`
+	// DateTime: RFC 3339
+	LastPinnedAt *string `json:"lastPinnedAt,omitempty"`
+}
+
+type PlatformStatus struct {
+	Status   PlatformHealth         `json:"status"`
+	Services []*ServiceHealth       `json:"services"`
+	Chains   []*ChainIndexingStatus `json:"chains"`
+	Queues   []*QueueBacklog        `json:"queues"`
+	Pinning  *PinningHealth         `json:"pinning"`
+	// DateTime: RFC 3339
+	CheckedAt string `json:"checkedAt"`
+}
+
+type PrepareAirdropInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	Standard string `json:"standard"`
+	// BigInt: uint256 as a decimal string
+	TokenID         *string                  `json:"tokenId,omitempty"`
+	SnapshotID      *string                  `json:"snapshotId,omitempty"`
+	Recipients      []*AirdropRecipientInput `json:"recipients,omitempty"`
+	AmountPerHolder *int                     `json:"amountPerHolder,omitempty"`
+}
+
+type PrepareBulkTransferInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	From string `json:"from"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Operator *string                  `json:"operator,omitempty"`
+	Items    []*BulkTransferItemInput `json:"items"`
+}
+
+type PrepareCollectionAdminPayload struct {
 	IntentID  string     `json:"intentId"`
 	TxRequest *TxRequest `json:"txRequest"`
 }
 
+type PrepareCreateCollectionInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	Name    string `json:"name"`
+	Symbol  string `json:"symbol"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Creator     string  `json:"creator"`
+	TokenURI    *string `json:"tokenURI,omitempty"`
+	Type        string  `json:"type"`
+	Description *string `json:"description,omitempty"`
+	// BigInt: uint256 as a decimal string
+	MintPrice *string `json:"mintPrice,omitempty"`
+	// BigInt: uint256 as a decimal string
+	RoyaltyFee *string `json:"royaltyFee,omitempty"`
+	// BigInt: uint256 as a decimal string
+	MaxSupply *string `json:"maxSupply,omitempty"`
+	// BigInt: uint256 as a decimal string
+	MintLimitPerWallet *string `json:"mintLimitPerWallet,omitempty"`
+	// BigInt: uint256 as a decimal string
+	MintStartTime *string `json:"mintStartTime,omitempty"`
+	// BigInt: uint256 as a decimal string
+	MintEndTime *string `json:"mintEndTime,omitempty"`
+	// BigInt: uint256 as a decimal string
+	AllowlistMintPrice *string `json:"allowlistMintPrice,omitempty"`
+	// BigInt: uint256 as a decimal string
+	PublicMintPrice *string `json:"publicMintPrice,omitempty"`
+	// BigInt: uint256 as a decimal string
+	AllowlistStageDuration *string             `json:"allowlistStageDuration,omitempty"`
+	AssetIds               []string            `json:"assetIds,omitempty"`
+	CallbackURL            *string             `json:"callbackUrl,omitempty"`
+	PayoutSplits           []*PayoutSplitInput `json:"payoutSplits,omitempty"`
+}
+
+type PrepareCreateCollectionPayload struct {
+	IntentID       string     `json:"intentId"`
+	TxRequest      *TxRequest `json:"txRequest"`
+	CallbackSecret *string    `json:"callbackSecret,omitempty"`
+}
+
 type PrepareMintInput struct {
-	ChainID  string `json:"chainId"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
 	Contract string `json:"contract"`
 	Standard string `json:"standard"`
 	Quantity *int   `json:"quantity,omitempty"`
+	// BigInt: uint256 as a decimal string
+	TokenID *string `json:"tokenId,omitempty"`
+	// Hex: 0x-prefixed hex bytes
+	Proof []string `json:"proof,omitempty"`
 }
 
 type PrepareMintPayload struct {
@@ -167,9 +863,104 @@ type PrepareMintPayload struct {
 	TxRequest *TxRequest `json:"txRequest"`
 }
 
+type PriceRangeInput struct {
+	// Wei: Amount in wei as a decimal string
+	Min *string `json:"min,omitempty"`
+	// Wei: Amount in wei as a decimal string
+	Max *string `json:"max,omitempty"`
+}
+
+type ProfileStats struct {
+	CollectionsCreated int `json:"collectionsCreated"`
+	ItemsOwned         int `json:"itemsOwned"`
+	Followers          int `json:"followers"`
+	// BigInt: uint256 as a decimal string
+	VolumeWei string `json:"volumeWei"`
+}
+
 type Query struct {
 }
 
+type QueueBacklog struct {
+	Name     string         `json:"name"`
+	Status   PlatformHealth `json:"status"`
+	Messages int            `json:"messages"`
+}
+
+type QueueStatus struct {
+	Name      string  `json:"name"`
+	Messages  int     `json:"messages"`
+	Consumers int     `json:"consumers"`
+	Error     *string `json:"error,omitempty"`
+}
+
+type Report struct {
+	ID         string           `json:"id"`
+	TargetType ReportTargetType `json:"targetType"`
+	TargetID   string           `json:"targetId"`
+	Reason     string           `json:"reason"`
+	Details    *string          `json:"details,omitempty"`
+	Status     string           `json:"status"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+}
+
+type ReportContentPayload struct {
+	Report    *Report `json:"report"`
+	Duplicate bool    `json:"duplicate"`
+}
+
+type ReportQueueItem struct {
+	TargetType  ReportTargetType `json:"targetType"`
+	TargetID    string           `json:"targetId"`
+	ReportCount int              `json:"reportCount"`
+	Reasons     []string         `json:"reasons"`
+	// DateTime: RFC 3339
+	FirstReportedAt string `json:"firstReportedAt"`
+	// DateTime: RFC 3339
+	LastReportedAt string `json:"lastReportedAt"`
+}
+
+type ResolveReportsPayload struct {
+	Resolved int             `json:"resolved"`
+	Flag     *ModerationFlag `json:"flag,omitempty"`
+}
+
+type ResyncCollectionInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract   string `json:"contract"`
+	SampleSize *int   `json:"sampleSize,omitempty"`
+	Repair     *bool  `json:"repair,omitempty"`
+}
+
+type ResyncDrift struct {
+	Kind string `json:"kind"`
+	// BigInt: uint256 as a decimal string
+	TokenID *string `json:"tokenId,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Holder  *string `json:"holder,omitempty"`
+	Catalog string  `json:"catalog"`
+	Chain   string  `json:"chain"`
+}
+
+type ResyncReport struct {
+	ID string `json:"id"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	Standard string `json:"standard"`
+	// BigInt: uint256 as a decimal string
+	BlockNumber   string         `json:"blockNumber"`
+	TokensSampled int            `json:"tokensSampled"`
+	Drifts        []*ResyncDrift `json:"drifts"`
+	Repaired      bool           `json:"repaired"`
+	// DateTime: RFC 3339
+	CheckedAt string `json:"checkedAt"`
+}
+
 type RPCEndpoint struct {
 	URL       string  `json:"url"`
 	Priority  int     `json:"priority"`
@@ -179,99 +970,433 @@ type RPCEndpoint struct {
 	Active    bool    `json:"active"`
 }
 
+type SavedSearch struct {
+	ID      string          `json:"id"`
+	Name    string          `json:"name"`
+	Query   string          `json:"query"`
+	Filters []*SearchFilter `json:"filters"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+}
+
+type SearchFilter struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type SearchFilterInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type ServiceHealth struct {
+	Name   string         `json:"name"`
+	Status PlatformHealth `json:"status"`
+}
+
 type SignInSiweInput struct {
 	AccountID string `json:"accountId"`
-	ChainID   string `json:"chainId"`
-	Domain    string `json:"domain"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	Domain  string `json:"domain"`
+}
+
+type SnapshotExport struct {
+	URL string `json:"url"`
+	// DateTime: RFC 3339
+	ExpiresAt string `json:"expiresAt"`
+	Bytes     int    `json:"bytes"`
+}
+
+type SponsorshipBudget struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID     string `json:"chainId"`
+	BalanceGwei string `json:"balanceGwei"`
+	// BigInt: uint256 as a decimal string
+	ToppedUpGwei string `json:"toppedUpGwei"`
+	// BigInt: uint256 as a decimal string
+	SpentGwei    string  `json:"spentGwei"`
+	Frozen       bool    `json:"frozen"`
+	FrozenReason *string `json:"frozenReason,omitempty"`
+	UpdatedBy    *string `json:"updatedBy,omitempty"`
+	// DateTime: RFC 3339
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type StorageLimits struct {
+	// BigInt: uint256 as a decimal string
+	Bytes  *string `json:"bytes,omitempty"`
+	Assets *int    `json:"assets,omitempty"`
+}
+
+type StorageUsage struct {
+	// BigInt: uint256 as a decimal string
+	Bytes         string            `json:"bytes"`
+	Assets        int               `json:"assets"`
+	ByKind        []*MediaKindUsage `json:"byKind"`
+	SoftLimit     *StorageLimits    `json:"softLimit"`
+	HardLimit     *StorageLimits    `json:"hardLimit"`
+	OverSoftLimit bool              `json:"overSoftLimit"`
+}
+
+type SubmitDropInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract    *string `json:"contract,omitempty"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	ImageURL    *string `json:"imageUrl,omitempty"`
+	ExternalURL string  `json:"externalUrl"`
+	// Wei: Amount in wei as a decimal string
+	MintPrice *string `json:"mintPrice,omitempty"`
+	// DateTime: RFC 3339
+	StartsAt string `json:"startsAt"`
+	// DateTime: RFC 3339
+	EndsAt *string `json:"endsAt,omitempty"`
 }
 
 type Subscription struct {
 }
 
+type SubscriptionTicket struct {
+	Ticket string `json:"ticket"`
+	// DateTime: RFC 3339
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type Suggestion struct {
+	Kind         SuggestionKind `json:"kind"`
+	ID           string         `json:"id"`
+	Label        string         `json:"label"`
+	Sublabel     *string        `json:"sublabel,omitempty"`
+	ThumbnailURL *string        `json:"thumbnailUrl,omitempty"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID *string `json:"chainId,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract *string `json:"contract,omitempty"`
+	// BigInt: uint256 as a decimal string
+	TokenID  *string `json:"tokenId,omitempty"`
+	Slug     *string `json:"slug,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+type SystemStatus struct {
+	Queues    []*QueueStatus    `json:"queues"`
+	Consumers []*ConsumerStatus `json:"consumers"`
+	// DateTime: RFC 3339
+	CheckedAt string `json:"checkedAt"`
+}
+
+type Token struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// BigInt: uint256 as a decimal string
+	TokenID  string `json:"tokenId"`
+	Standard string `json:"standard"`
+	// BigInt: uint256 as a decimal string
+	Supply string `json:"supply"`
+	// BigInt: uint256 as a decimal string
+	MaxSupply *string `json:"maxSupply,omitempty"`
+	// BigInt: uint256 as a decimal string
+	Minted string `json:"minted"`
+	// BigInt: uint256 as a decimal string
+	Burned   string  `json:"burned"`
+	Name     *string `json:"name,omitempty"`
+	ImageURL *string `json:"imageUrl,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Owner       *string  `json:"owner,omitempty"`
+	RarityScore *float64 `json:"rarityScore,omitempty"`
+	// Wei: Amount in wei as a decimal string
+	Price   *string        `json:"price,omitempty"`
+	Rentals []*TokenRental `json:"rentals"`
+}
+
+type TokenFilterInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID *string `json:"chainId,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract *string `json:"contract,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Owner  *string             `json:"owner,omitempty"`
+	Price  *PriceRangeInput    `json:"price,omitempty"`
+	Traits []*TraitFilterInput `json:"traits,omitempty"`
+}
+
+type TokenGateResult struct {
+	Held bool `json:"held"`
+	// BigInt: uint256 as a decimal string
+	Balance string  `json:"balance"`
+	Token   *string `json:"token,omitempty"`
+	// DateTime: RFC 3339
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+}
+
+type TokenRental struct {
+	Standard string `json:"standard"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Renter string `json:"renter"`
+	// BigInt: uint256 as a decimal string
+	Amount string `json:"amount"`
+	// DateTime: RFC 3339
+	ExpiresAt string `json:"expiresAt"`
+	// BigInt: uint256 as a decimal string
+	RecordID *string `json:"recordId,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Owner *string `json:"owner,omitempty"`
+}
+
+type TokenSortInput struct {
+	Field     TokenSortField `json:"field"`
+	Direction *SortDirection `json:"direction,omitempty"`
+}
+
 type TrackTxInput struct {
-	IntentID string  `json:"intentId"`
-	ChainID  string  `json:"chainId"`
-	TxHash   string  `json:"txHash"`
+	IntentID string `json:"intentId"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Hex: 0x-prefixed hex bytes
+	TxHash string `json:"txHash"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
 	Contract *string `json:"contract,omitempty"`
 }
 
+type TraitFilterInput struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
 type TxRequest struct {
-	To             string  `json:"to"`
-	Data           string  `json:"data"`
-	Value          string  `json:"value"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	To string `json:"to"`
+	// Hex: 0x-prefixed hex bytes
+	Data string `json:"data"`
+	// BigInt: uint256 as a decimal string
+	Value string `json:"value"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
 	PreviewAddress *string `json:"previewAddress,omitempty"`
 }
 
+type UnflagItemInput struct {
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// BigInt: uint256 as a decimal string
+	TokenID *string `json:"tokenId,omitempty"`
+	Note    *string `json:"note,omitempty"`
+}
+
+type UpcomingDrop struct {
+	ID     string     `json:"id"`
+	Source DropSource `json:"source"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract    *string `json:"contract,omitempty"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	ImageURL    *string `json:"imageUrl,omitempty"`
+	ExternalURL *string `json:"externalUrl,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	// Wei: Amount in wei as a decimal string
+	MintPrice  *string `json:"mintPrice,omitempty"`
+	IsVerified bool    `json:"isVerified"`
+	// DateTime: RFC 3339
+	StartsAt string `json:"startsAt"`
+	// DateTime: RFC 3339
+	EndsAt          *string    `json:"endsAt,omitempty"`
+	StartsInSeconds int        `json:"startsInSeconds"`
+	Status          DropStatus `json:"status"`
+}
+
+type UpdateWalletInput struct {
+	WalletID string   `json:"walletId"`
+	Label    *string  `json:"label,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+type UploadProgress struct {
+	Ticket        string      `json:"ticket"`
+	Stage         UploadStage `json:"stage"`
+	BytesReceived int         `json:"bytesReceived"`
+	TotalBytes    int         `json:"totalBytes"`
+	AssetID       *string     `json:"assetId,omitempty"`
+	Error         *string     `json:"error,omitempty"`
+	// DateTime: RFC 3339
+	EmittedAt string `json:"emittedAt"`
+}
+
 type UploadSingleFileInput struct {
-	File graphql.Upload `json:"file"`
-	Kind MediaKind      `json:"kind"`
+	File         graphql.Upload `json:"file"`
+	Kind         MediaKind      `json:"kind"`
+	UploadTicket *string        `json:"uploadTicket,omitempty"`
 }
 
 type UploadSingleFilePayload struct {
 	Asset        *MediaAsset `json:"asset"`
 	Deduplicated bool        `json:"deduplicated"`
 	URL          *MediaUrls  `json:"url,omitempty"`
-	Cid          *string     `json:"cid,omitempty"`
+	// CID: IPFS content identifier
+	Cid *string `json:"cid,omitempty"`
 }
 
 type User struct {
-	ID string `json:"id"`
+	ID            string         `json:"id"`
+	Impersonation *Impersonation `json:"impersonation,omitempty"`
+}
+
+type UserProfile struct {
+	UserID            string        `json:"userId"`
+	Username          *string       `json:"username,omitempty"`
+	DisplayName       *string       `json:"displayName,omitempty"`
+	AvatarURL         *string       `json:"avatarUrl,omitempty"`
+	BannerURL         *string       `json:"bannerUrl,omitempty"`
+	Bio               *string       `json:"bio,omitempty"`
+	NftAvatar         *NftAvatar    `json:"nftAvatar,omitempty"`
+	VerifiedNftAvatar bool          `json:"verifiedNftAvatar"`
+	Stats             *ProfileStats `json:"stats"`
+}
+
+type UserRelationship struct {
+	UserID string           `json:"userId"`
+	Kind   RelationshipKind `json:"kind"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
 }
 
 type VerifySiweInput struct {
 	AccountID string `json:"accountId"`
 	Message   string `json:"message"`
+	// Hex: 0x-prefixed hex bytes
 	Signature string `json:"signature"`
 }
 
-type ContractStandard string
+type ViewerPreferences struct {
+	Locale           string        `json:"locale"`
+	Timezone         string        `json:"timezone"`
+	Currency         string        `json:"currency"`
+	Format           *NumberFormat `json:"format"`
+	HonorDelegations bool          `json:"honorDelegations"`
+	// DateTime: RFC 3339
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type WalletActivity struct {
+	ID   string             `json:"id"`
+	Kind WalletActivityKind `json:"kind"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract *string `json:"contract,omitempty"`
+	// BigInt: uint256 as a decimal string
+	TokenID *string `json:"tokenId,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	From *string `json:"from,omitempty"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	To *string `json:"to,omitempty"`
+	// BigInt: uint256 as a decimal string
+	Quantity *string `json:"quantity,omitempty"`
+	// Wei: Amount in wei as a decimal string
+	Price    *string `json:"price,omitempty"`
+	Currency *string `json:"currency,omitempty"`
+	// Hex: 0x-prefixed hex bytes
+	TxHash       *string `json:"txHash,omitempty"`
+	IntentID     *string `json:"intentId,omitempty"`
+	IntentKind   *string `json:"intentKind,omitempty"`
+	IntentStatus *string `json:"intentStatus,omitempty"`
+	// DateTime: RFC 3339
+	OccurredAt string `json:"occurredAt"`
+}
+
+type WalletActivityPage struct {
+	Items      []*WalletActivity `json:"items"`
+	NextCursor *string           `json:"nextCursor,omitempty"`
+}
+
+type Watchlist struct {
+	Items         []*WatchlistItem `json:"items"`
+	SavedSearches []*SavedSearch   `json:"savedSearches"`
+}
+
+type WatchlistItem struct {
+	ID         string          `json:"id"`
+	TargetType WatchTargetType `json:"targetType"`
+	// ChainId: CAIP-2, e.g. eip155:1
+	ChainID string `json:"chainId"`
+	// Address: 0x-prefixed 20-byte hex; mixed case must be EIP-55 checksummed
+	Contract string `json:"contract"`
+	// BigInt: uint256 as a decimal string
+	TokenID        *string `json:"tokenId,omitempty"`
+	CollectionName *string `json:"collectionName,omitempty"`
+	// Wei: Amount in wei as a decimal string
+	FloorAtAdd string `json:"floorAtAdd"`
+	// Wei: Amount in wei as a decimal string
+	CurrentFloor string `json:"currentFloor"`
+	// Wei: Amount in wei as a decimal string
+	FloorDelta string `json:"floorDelta"`
+	// Wei: Amount in wei as a decimal string
+	FloorAlertBelow *string `json:"floorAlertBelow,omitempty"`
+	// DateTime: RFC 3339
+	AlertFiredAt *string `json:"alertFiredAt,omitempty"`
+	// DateTime: RFC 3339
+	CreatedAt string `json:"createdAt"`
+}
+
+type AccountEventType string
 
 const (
-	ContractStandardErc721  ContractStandard = "ERC721"
-	ContractStandardErc1155 ContractStandard = "ERC1155"
-	ContractStandardProxy   ContractStandard = "PROXY"
-	ContractStandardDiamond ContractStandard = "DIAMOND"
-	ContractStandardCustom  ContractStandard = "CUSTOM"
+	AccountEventTypeWalletLinked   AccountEventType = "wallet_linked"
+	AccountEventTypeWalletUnlinked AccountEventType = "wallet_unlinked"
+	AccountEventTypePrimaryChanged AccountEventType = "primary_changed"
+	AccountEventTypeProfileUpdated AccountEventType = "profile_updated"
+	AccountEventTypeSessionRevoked AccountEventType = "session_revoked"
+	AccountEventTypeSessionEvicted AccountEventType = "session_evicted"
+	AccountEventTypeExportReady    AccountEventType = "export_ready"
 )
 
-var AllContractStandard = []ContractStandard{
-	ContractStandardErc721,
-	ContractStandardErc1155,
-	ContractStandardProxy,
-	ContractStandardDiamond,
-	ContractStandardCustom,
+var AllAccountEventType = []AccountEventType{
+	AccountEventTypeWalletLinked,
+	AccountEventTypeWalletUnlinked,
+	AccountEventTypePrimaryChanged,
+	AccountEventTypeProfileUpdated,
+	AccountEventTypeSessionRevoked,
+	AccountEventTypeSessionEvicted,
+	AccountEventTypeExportReady,
 }
 
-func (e ContractStandard) IsValid() bool {
+func (e AccountEventType) IsValid() bool {
 	switch e {
-	case ContractStandardErc721, ContractStandardErc1155, ContractStandardProxy, ContractStandardDiamond, ContractStandardCustom:
+	case AccountEventTypeWalletLinked, AccountEventTypeWalletUnlinked, AccountEventTypePrimaryChanged, AccountEventTypeProfileUpdated, AccountEventTypeSessionRevoked, AccountEventTypeSessionEvicted, AccountEventTypeExportReady:
 		return true
 	}
 	return false
 }
 
-func (e ContractStandard) String() string {
+func (e AccountEventType) String() string {
 	return string(e)
 }
 
-func (e *ContractStandard) UnmarshalGQL(v any) error {
+func (e *AccountEventType) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ContractStandard(str)
+	*e = AccountEventType(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ContractStandard", str)
+		return fmt.Errorf("%s is not a valid AccountEventType", str)
 	}
 	return nil
 }
 
-func (e ContractStandard) MarshalGQL(w io.Writer) {
+func (e AccountEventType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *ContractStandard) UnmarshalJSON(b []byte) error {
+func (e *AccountEventType) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -279,58 +1404,52 @@ func (e *ContractStandard) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e ContractStandard) MarshalJSON() ([]byte, error) {
+func (e AccountEventType) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type IntentStatus string
+type AddressChallengePurpose string
 
 const (
-	IntentStatusPending IntentStatus = "pending"
-	IntentStatusReady   IntentStatus = "ready"
-	IntentStatusFailed  IntentStatus = "failed"
-	IntentStatusExpired IntentStatus = "expired"
+	AddressChallengePurposeTokenGate AddressChallengePurpose = "token_gate"
 )
 
-var AllIntentStatus = []IntentStatus{
-	IntentStatusPending,
-	IntentStatusReady,
-	IntentStatusFailed,
-	IntentStatusExpired,
+var AllAddressChallengePurpose = []AddressChallengePurpose{
+	AddressChallengePurposeTokenGate,
 }
 
-func (e IntentStatus) IsValid() bool {
+func (e AddressChallengePurpose) IsValid() bool {
 	switch e {
-	case IntentStatusPending, IntentStatusReady, IntentStatusFailed, IntentStatusExpired:
+	case AddressChallengePurposeTokenGate:
 		return true
 	}
 	return false
 }
 
-func (e IntentStatus) String() string {
+func (e AddressChallengePurpose) String() string {
 	return string(e)
 }
 
-func (e *IntentStatus) UnmarshalGQL(v any) error {
+func (e *AddressChallengePurpose) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = IntentStatus(str)
+	*e = AddressChallengePurpose(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid IntentStatus", str)
+		return fmt.Errorf("%s is not a valid AddressChallengePurpose", str)
 	}
 	return nil
 }
 
-func (e IntentStatus) MarshalGQL(w io.Writer) {
+func (e AddressChallengePurpose) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *IntentStatus) UnmarshalJSON(b []byte) error {
+func (e *AddressChallengePurpose) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -338,58 +1457,54 @@ func (e *IntentStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e IntentStatus) MarshalJSON() ([]byte, error) {
+func (e AddressChallengePurpose) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type MediaKind string
+type APIPlan string
 
 const (
-	MediaKindImage MediaKind = "IMAGE"
-	MediaKindVideo MediaKind = "VIDEO"
-	MediaKindAudio MediaKind = "AUDIO"
-	MediaKindOther MediaKind = "OTHER"
+	APIPlanFree APIPlan = "free"
+	APIPlanPro  APIPlan = "pro"
 )
 
-var AllMediaKind = []MediaKind{
-	MediaKindImage,
-	MediaKindVideo,
-	MediaKindAudio,
-	MediaKindOther,
+var AllAPIPlan = []APIPlan{
+	APIPlanFree,
+	APIPlanPro,
 }
 
-func (e MediaKind) IsValid() bool {
+func (e APIPlan) IsValid() bool {
 	switch e {
-	case MediaKindImage, MediaKindVideo, MediaKindAudio, MediaKindOther:
+	case APIPlanFree, APIPlanPro:
 		return true
 	}
 	return false
 }
 
-func (e MediaKind) String() string {
+func (e APIPlan) String() string {
 	return string(e)
 }
 
-func (e *MediaKind) UnmarshalGQL(v any) error {
+func (e *APIPlan) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = MediaKind(str)
+	*e = APIPlan(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid MediaKind", str)
+		return fmt.Errorf("%s is not a valid ApiPlan", str)
 	}
 	return nil
 }
 
-func (e MediaKind) MarshalGQL(w io.Writer) {
+func (e APIPlan) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *MediaKind) UnmarshalJSON(b []byte) error {
+func (e *APIPlan) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -397,58 +1512,70 @@ func (e *MediaKind) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e MediaKind) MarshalJSON() ([]byte, error) {
+func (e APIPlan) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type PinStatus string
+type CollectionCategory string
 
 const (
-	PinStatusPending PinStatus = "PENDING"
-	PinStatusPinning PinStatus = "PINNING"
-	PinStatusPinned  PinStatus = "PINNED"
-	PinStatusFailed  PinStatus = "FAILED"
+	CollectionCategoryArt           CollectionCategory = "art"
+	CollectionCategoryCollectibles  CollectionCategory = "collectibles"
+	CollectionCategoryGaming        CollectionCategory = "gaming"
+	CollectionCategoryMemberships   CollectionCategory = "memberships"
+	CollectionCategoryMusic         CollectionCategory = "music"
+	CollectionCategoryPfp           CollectionCategory = "pfp"
+	CollectionCategoryPhotography   CollectionCategory = "photography"
+	CollectionCategorySports        CollectionCategory = "sports"
+	CollectionCategoryUtility       CollectionCategory = "utility"
+	CollectionCategoryVirtualWorlds CollectionCategory = "virtual_worlds"
 )
 
-var AllPinStatus = []PinStatus{
-	PinStatusPending,
-	PinStatusPinning,
-	PinStatusPinned,
-	PinStatusFailed,
+var AllCollectionCategory = []CollectionCategory{
+	CollectionCategoryArt,
+	CollectionCategoryCollectibles,
+	CollectionCategoryGaming,
+	CollectionCategoryMemberships,
+	CollectionCategoryMusic,
+	CollectionCategoryPfp,
+	CollectionCategoryPhotography,
+	CollectionCategorySports,
+	CollectionCategoryUtility,
+	CollectionCategoryVirtualWorlds,
 }
 
-func (e PinStatus) IsValid() bool {
+func (e CollectionCategory) IsValid() bool {
 	switch e {
-	case PinStatusPending, PinStatusPinning, PinStatusPinned, PinStatusFailed:
+	case CollectionCategoryArt, CollectionCategoryCollectibles, CollectionCategoryGaming, CollectionCategoryMemberships, CollectionCategoryMusic, CollectionCategoryPfp, CollectionCategoryPhotography, CollectionCategorySports, CollectionCategoryUtility, CollectionCategoryVirtualWorlds:
 		return true
 	}
 	return false
 }
 
-func (e PinStatus) String() string {
+func (e CollectionCategory) String() string {
 	return string(e)
 }
 
-func (e *PinStatus) UnmarshalGQL(v any) error {
+func (e *CollectionCategory) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = PinStatus(str)
+	*e = CollectionCategory(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid PinStatus", str)
+		return fmt.Errorf("%s is not a valid CollectionCategory", str)
 	}
 	return nil
 }
 
-func (e PinStatus) MarshalGQL(w io.Writer) {
+func (e CollectionCategory) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *PinStatus) UnmarshalJSON(b []byte) error {
+func (e *CollectionCategory) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -456,37 +1583,1350 @@ func (e *PinStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e PinStatus) MarshalJSON() ([]byte, error) {
+func (e CollectionCategory) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type RPCAuthType string
+type CollectionExportKind string
 
 const (
-	RPCAuthTypeNone   RPCAuthType = "NONE"
-	RPCAuthTypeKey    RPCAuthType = "KEY"
-	RPCAuthTypeBasic  RPCAuthType = "BASIC"
-	RPCAuthTypeBearer RPCAuthType = "BEARER"
+	CollectionExportKindSales CollectionExportKind = "sales"
+	CollectionExportKindMints CollectionExportKind = "mints"
 )
 
-var AllRPCAuthType = []RPCAuthType{
-	RPCAuthTypeNone,
-	RPCAuthTypeKey,
-	RPCAuthTypeBasic,
-	RPCAuthTypeBearer,
+var AllCollectionExportKind = []CollectionExportKind{
+	CollectionExportKindSales,
+	CollectionExportKindMints,
 }
 
-func (e RPCAuthType) IsValid() bool {
+func (e CollectionExportKind) IsValid() bool {
 	switch e {
-	case RPCAuthTypeNone, RPCAuthTypeKey, RPCAuthTypeBasic, RPCAuthTypeBearer:
+	case CollectionExportKindSales, CollectionExportKindMints:
 		return true
 	}
 	return false
 }
 
-func (e RPCAuthType) String() string {
+func (e CollectionExportKind) String() string {
+	return string(e)
+}
+
+func (e *CollectionExportKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CollectionExportKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CollectionExportKind", str)
+	}
+	return nil
+}
+
+func (e CollectionExportKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CollectionExportKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CollectionExportKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CollectionExportStatus string
+
+const (
+	CollectionExportStatusPending CollectionExportStatus = "pending"
+	CollectionExportStatusRunning CollectionExportStatus = "running"
+	CollectionExportStatusReady   CollectionExportStatus = "ready"
+	CollectionExportStatusFailed  CollectionExportStatus = "failed"
+)
+
+var AllCollectionExportStatus = []CollectionExportStatus{
+	CollectionExportStatusPending,
+	CollectionExportStatusRunning,
+	CollectionExportStatusReady,
+	CollectionExportStatusFailed,
+}
+
+func (e CollectionExportStatus) IsValid() bool {
+	switch e {
+	case CollectionExportStatusPending, CollectionExportStatusRunning, CollectionExportStatusReady, CollectionExportStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e CollectionExportStatus) String() string {
+	return string(e)
+}
+
+func (e *CollectionExportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CollectionExportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CollectionExportStatus", str)
+	}
+	return nil
+}
+
+func (e CollectionExportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CollectionExportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CollectionExportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CollectionSortField string
+
+const (
+	CollectionSortFieldCreatedAt CollectionSortField = "createdAt"
+	CollectionSortFieldFloor     CollectionSortField = "floor"
+	CollectionSortFieldVolume    CollectionSortField = "volume"
+)
+
+var AllCollectionSortField = []CollectionSortField{
+	CollectionSortFieldCreatedAt,
+	CollectionSortFieldFloor,
+	CollectionSortFieldVolume,
+}
+
+func (e CollectionSortField) IsValid() bool {
+	switch e {
+	case CollectionSortFieldCreatedAt, CollectionSortFieldFloor, CollectionSortFieldVolume:
+		return true
+	}
+	return false
+}
+
+func (e CollectionSortField) String() string {
+	return string(e)
+}
+
+func (e *CollectionSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CollectionSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CollectionSortField", str)
+	}
+	return nil
+}
+
+func (e CollectionSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CollectionSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CollectionSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ContractStandard string
+
+const (
+	ContractStandardErc721  ContractStandard = "ERC721"
+	ContractStandardErc1155 ContractStandard = "ERC1155"
+	ContractStandardProxy   ContractStandard = "PROXY"
+	ContractStandardDiamond ContractStandard = "DIAMOND"
+	ContractStandardCustom  ContractStandard = "CUSTOM"
+)
+
+var AllContractStandard = []ContractStandard{
+	ContractStandardErc721,
+	ContractStandardErc1155,
+	ContractStandardProxy,
+	ContractStandardDiamond,
+	ContractStandardCustom,
+}
+
+func (e ContractStandard) IsValid() bool {
+	switch e {
+	case ContractStandardErc721, ContractStandardErc1155, ContractStandardProxy, ContractStandardDiamond, ContractStandardCustom:
+		return true
+	}
+	return false
+}
+
+func (e ContractStandard) String() string {
+	return string(e)
+}
+
+func (e *ContractStandard) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ContractStandard(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ContractStandard", str)
+	}
+	return nil
+}
+
+func (e ContractStandard) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ContractStandard) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ContractStandard) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DropReviewAction string
+
+const (
+	DropReviewActionApprove DropReviewAction = "approve"
+	DropReviewActionReject  DropReviewAction = "reject"
+)
+
+var AllDropReviewAction = []DropReviewAction{
+	DropReviewActionApprove,
+	DropReviewActionReject,
+}
+
+func (e DropReviewAction) IsValid() bool {
+	switch e {
+	case DropReviewActionApprove, DropReviewActionReject:
+		return true
+	}
+	return false
+}
+
+func (e DropReviewAction) String() string {
+	return string(e)
+}
+
+func (e *DropReviewAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DropReviewAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DropReviewAction", str)
+	}
+	return nil
+}
+
+func (e DropReviewAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DropReviewAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DropReviewAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DropSource string
+
+const (
+	DropSourceCollection DropSource = "collection"
+	DropSourceSubmission DropSource = "submission"
+)
+
+var AllDropSource = []DropSource{
+	DropSourceCollection,
+	DropSourceSubmission,
+}
+
+func (e DropSource) IsValid() bool {
+	switch e {
+	case DropSourceCollection, DropSourceSubmission:
+		return true
+	}
+	return false
+}
+
+func (e DropSource) String() string {
+	return string(e)
+}
+
+func (e *DropSource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DropSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DropSource", str)
+	}
+	return nil
+}
+
+func (e DropSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DropSource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DropSource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DropStatus string
+
+const (
+	DropStatusUpcoming DropStatus = "upcoming"
+	DropStatusLive     DropStatus = "live"
+)
+
+var AllDropStatus = []DropStatus{
+	DropStatusUpcoming,
+	DropStatusLive,
+}
+
+func (e DropStatus) IsValid() bool {
+	switch e {
+	case DropStatusUpcoming, DropStatusLive:
+		return true
+	}
+	return false
+}
+
+func (e DropStatus) String() string {
+	return string(e)
+}
+
+func (e *DropStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DropStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DropStatus", str)
+	}
+	return nil
+}
+
+func (e DropStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DropStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DropStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DropSubmissionStatus string
+
+const (
+	DropSubmissionStatusPending  DropSubmissionStatus = "pending"
+	DropSubmissionStatusApproved DropSubmissionStatus = "approved"
+	DropSubmissionStatusRejected DropSubmissionStatus = "rejected"
+)
+
+var AllDropSubmissionStatus = []DropSubmissionStatus{
+	DropSubmissionStatusPending,
+	DropSubmissionStatusApproved,
+	DropSubmissionStatusRejected,
+}
+
+func (e DropSubmissionStatus) IsValid() bool {
+	switch e {
+	case DropSubmissionStatusPending, DropSubmissionStatusApproved, DropSubmissionStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e DropSubmissionStatus) String() string {
+	return string(e)
+}
+
+func (e *DropSubmissionStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DropSubmissionStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DropSubmissionStatus", str)
+	}
+	return nil
+}
+
+func (e DropSubmissionStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DropSubmissionStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DropSubmissionStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type EarningsPeriod string
+
+const (
+	EarningsPeriodDay   EarningsPeriod = "day"
+	EarningsPeriodWeek  EarningsPeriod = "week"
+	EarningsPeriodMonth EarningsPeriod = "month"
+	EarningsPeriodYear  EarningsPeriod = "year"
+	EarningsPeriodAll   EarningsPeriod = "all"
+)
+
+var AllEarningsPeriod = []EarningsPeriod{
+	EarningsPeriodDay,
+	EarningsPeriodWeek,
+	EarningsPeriodMonth,
+	EarningsPeriodYear,
+	EarningsPeriodAll,
+}
+
+func (e EarningsPeriod) IsValid() bool {
+	switch e {
+	case EarningsPeriodDay, EarningsPeriodWeek, EarningsPeriodMonth, EarningsPeriodYear, EarningsPeriodAll:
+		return true
+	}
+	return false
+}
+
+func (e EarningsPeriod) String() string {
+	return string(e)
+}
+
+func (e *EarningsPeriod) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EarningsPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EarningsPeriod", str)
+	}
+	return nil
+}
+
+func (e EarningsPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EarningsPeriod) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EarningsPeriod) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type EmailKind string
+
+const (
+	EmailKindWeeklyDigest     EmailKind = "weekly_digest"
+	EmailKindSaleConfirmation EmailKind = "sale_confirmation"
+	EmailKindDropReminder     EmailKind = "drop_reminder"
+)
+
+var AllEmailKind = []EmailKind{
+	EmailKindWeeklyDigest,
+	EmailKindSaleConfirmation,
+	EmailKindDropReminder,
+}
+
+func (e EmailKind) IsValid() bool {
+	switch e {
+	case EmailKindWeeklyDigest, EmailKindSaleConfirmation, EmailKindDropReminder:
+		return true
+	}
+	return false
+}
+
+func (e EmailKind) String() string {
+	return string(e)
+}
+
+func (e *EmailKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EmailKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EmailKind", str)
+	}
+	return nil
+}
+
+func (e EmailKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EmailKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EmailKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type IntentRecovery string
+
+const (
+	IntentRecoverySpeedUp  IntentRecovery = "speed_up"
+	IntentRecoveryResubmit IntentRecovery = "resubmit"
+)
+
+var AllIntentRecovery = []IntentRecovery{
+	IntentRecoverySpeedUp,
+	IntentRecoveryResubmit,
+}
+
+func (e IntentRecovery) IsValid() bool {
+	switch e {
+	case IntentRecoverySpeedUp, IntentRecoveryResubmit:
+		return true
+	}
+	return false
+}
+
+func (e IntentRecovery) String() string {
+	return string(e)
+}
+
+func (e *IntentRecovery) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IntentRecovery(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IntentRecovery", str)
+	}
+	return nil
+}
+
+func (e IntentRecovery) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *IntentRecovery) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e IntentRecovery) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type IntentStatus string
+
+const (
+	IntentStatusPending IntentStatus = "pending"
+	IntentStatusReady   IntentStatus = "ready"
+	IntentStatusFailed  IntentStatus = "failed"
+	IntentStatusExpired IntentStatus = "expired"
+	IntentStatusStalled IntentStatus = "stalled"
+)
+
+var AllIntentStatus = []IntentStatus{
+	IntentStatusPending,
+	IntentStatusReady,
+	IntentStatusFailed,
+	IntentStatusExpired,
+	IntentStatusStalled,
+}
+
+func (e IntentStatus) IsValid() bool {
+	switch e {
+	case IntentStatusPending, IntentStatusReady, IntentStatusFailed, IntentStatusExpired, IntentStatusStalled:
+		return true
+	}
+	return false
+}
+
+func (e IntentStatus) String() string {
+	return string(e)
+}
+
+func (e *IntentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IntentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IntentStatus", str)
+	}
+	return nil
+}
+
+func (e IntentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *IntentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e IntentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MediaKind string
+
+const (
+	MediaKindImage MediaKind = "IMAGE"
+	MediaKindVideo MediaKind = "VIDEO"
+	MediaKindAudio MediaKind = "AUDIO"
+	MediaKindOther MediaKind = "OTHER"
+)
+
+var AllMediaKind = []MediaKind{
+	MediaKindImage,
+	MediaKindVideo,
+	MediaKindAudio,
+	MediaKindOther,
+}
+
+func (e MediaKind) IsValid() bool {
+	switch e {
+	case MediaKindImage, MediaKindVideo, MediaKindAudio, MediaKindOther:
+		return true
+	}
+	return false
+}
+
+func (e MediaKind) String() string {
+	return string(e)
+}
+
+func (e *MediaKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MediaKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MediaKind", str)
+	}
+	return nil
+}
+
+func (e MediaKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MediaKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MediaKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MintStatsWindow string
+
+const (
+	MintStatsWindowLast15m MintStatsWindow = "last15m"
+	MintStatsWindowLast1h  MintStatsWindow = "last1h"
+	MintStatsWindowLast24h MintStatsWindow = "last24h"
+)
+
+var AllMintStatsWindow = []MintStatsWindow{
+	MintStatsWindowLast15m,
+	MintStatsWindowLast1h,
+	MintStatsWindowLast24h,
+}
+
+func (e MintStatsWindow) IsValid() bool {
+	switch e {
+	case MintStatsWindowLast15m, MintStatsWindowLast1h, MintStatsWindowLast24h:
+		return true
+	}
+	return false
+}
+
+func (e MintStatsWindow) String() string {
+	return string(e)
+}
+
+func (e *MintStatsWindow) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MintStatsWindow(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MintStatsWindow", str)
+	}
+	return nil
+}
+
+func (e MintStatsWindow) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MintStatsWindow) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MintStatsWindow) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ModerationReason string
+
+const (
+	ModerationReasonStolen     ModerationReason = "stolen"
+	ModerationReasonInfringing ModerationReason = "infringing"
+	ModerationReasonOther      ModerationReason = "other"
+)
+
+var AllModerationReason = []ModerationReason{
+	ModerationReasonStolen,
+	ModerationReasonInfringing,
+	ModerationReasonOther,
+}
+
+func (e ModerationReason) IsValid() bool {
+	switch e {
+	case ModerationReasonStolen, ModerationReasonInfringing, ModerationReasonOther:
+		return true
+	}
+	return false
+}
+
+func (e ModerationReason) String() string {
+	return string(e)
+}
+
+func (e *ModerationReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModerationReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModerationReason", str)
+	}
+	return nil
+}
+
+func (e ModerationReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ModerationReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ModerationReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type OrganizationRole string
+
+const (
+	OrganizationRoleOwner  OrganizationRole = "owner"
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+var AllOrganizationRole = []OrganizationRole{
+	OrganizationRoleOwner,
+	OrganizationRoleAdmin,
+	OrganizationRoleMember,
+}
+
+func (e OrganizationRole) IsValid() bool {
+	switch e {
+	case OrganizationRoleOwner, OrganizationRoleAdmin, OrganizationRoleMember:
+		return true
+	}
+	return false
+}
+
+func (e OrganizationRole) String() string {
+	return string(e)
+}
+
+func (e *OrganizationRole) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrganizationRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrganizationRole", str)
+	}
+	return nil
+}
+
+func (e OrganizationRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrganizationRole) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrganizationRole) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PinStatus string
+
+const (
+	PinStatusPending PinStatus = "PENDING"
+	PinStatusPinning PinStatus = "PINNING"
+	PinStatusPinned  PinStatus = "PINNED"
+	PinStatusFailed  PinStatus = "FAILED"
+)
+
+var AllPinStatus = []PinStatus{
+	PinStatusPending,
+	PinStatusPinning,
+	PinStatusPinned,
+	PinStatusFailed,
+}
+
+func (e PinStatus) IsValid() bool {
+	switch e {
+	case PinStatusPending, PinStatusPinning, PinStatusPinned, PinStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e PinStatus) String() string {
+	return string(e)
+}
+
+func (e *PinStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PinStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PinStatus", str)
+	}
+	return nil
+}
+
+func (e PinStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PinStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PinStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PlatformHealth string
+
+const (
+	PlatformHealthOperational PlatformHealth = "operational"
+	PlatformHealthDegraded    PlatformHealth = "degraded"
+	PlatformHealthOutage      PlatformHealth = "outage"
+)
+
+var AllPlatformHealth = []PlatformHealth{
+	PlatformHealthOperational,
+	PlatformHealthDegraded,
+	PlatformHealthOutage,
+}
+
+func (e PlatformHealth) IsValid() bool {
+	switch e {
+	case PlatformHealthOperational, PlatformHealthDegraded, PlatformHealthOutage:
+		return true
+	}
+	return false
+}
+
+func (e PlatformHealth) String() string {
+	return string(e)
+}
+
+func (e *PlatformHealth) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlatformHealth(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlatformHealth", str)
+	}
+	return nil
+}
+
+func (e PlatformHealth) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PlatformHealth) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PlatformHealth) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ProfileVisibility string
+
+const (
+	ProfileVisibilityPublic  ProfileVisibility = "public"
+	ProfileVisibilityHolders ProfileVisibility = "holders"
+	ProfileVisibilityPrivate ProfileVisibility = "private"
+)
+
+var AllProfileVisibility = []ProfileVisibility{
+	ProfileVisibilityPublic,
+	ProfileVisibilityHolders,
+	ProfileVisibilityPrivate,
+}
+
+func (e ProfileVisibility) IsValid() bool {
+	switch e {
+	case ProfileVisibilityPublic, ProfileVisibilityHolders, ProfileVisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+func (e ProfileVisibility) String() string {
+	return string(e)
+}
+
+func (e *ProfileVisibility) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProfileVisibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProfileVisibility", str)
+	}
+	return nil
+}
+
+func (e ProfileVisibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ProfileVisibility) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ProfileVisibility) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type QuotaEnforcement string
+
+const (
+	QuotaEnforcementHard QuotaEnforcement = "hard"
+	QuotaEnforcementSoft QuotaEnforcement = "soft"
+)
+
+var AllQuotaEnforcement = []QuotaEnforcement{
+	QuotaEnforcementHard,
+	QuotaEnforcementSoft,
+}
+
+func (e QuotaEnforcement) IsValid() bool {
+	switch e {
+	case QuotaEnforcementHard, QuotaEnforcementSoft:
+		return true
+	}
+	return false
+}
+
+func (e QuotaEnforcement) String() string {
+	return string(e)
+}
+
+func (e *QuotaEnforcement) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = QuotaEnforcement(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid QuotaEnforcement", str)
+	}
+	return nil
+}
+
+func (e QuotaEnforcement) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *QuotaEnforcement) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e QuotaEnforcement) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RelationshipKind string
+
+const (
+	RelationshipKindBlock  RelationshipKind = "block"
+	RelationshipKindMute   RelationshipKind = "mute"
+	RelationshipKindFollow RelationshipKind = "follow"
+)
+
+var AllRelationshipKind = []RelationshipKind{
+	RelationshipKindBlock,
+	RelationshipKindMute,
+	RelationshipKindFollow,
+}
+
+func (e RelationshipKind) IsValid() bool {
+	switch e {
+	case RelationshipKindBlock, RelationshipKindMute, RelationshipKindFollow:
+		return true
+	}
+	return false
+}
+
+func (e RelationshipKind) String() string {
+	return string(e)
+}
+
+func (e *RelationshipKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RelationshipKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RelationshipKind", str)
+	}
+	return nil
+}
+
+func (e RelationshipKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RelationshipKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RelationshipKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportAction string
+
+const (
+	ReportActionUphold  ReportAction = "uphold"
+	ReportActionDismiss ReportAction = "dismiss"
+)
+
+var AllReportAction = []ReportAction{
+	ReportActionUphold,
+	ReportActionDismiss,
+}
+
+func (e ReportAction) IsValid() bool {
+	switch e {
+	case ReportActionUphold, ReportActionDismiss:
+		return true
+	}
+	return false
+}
+
+func (e ReportAction) String() string {
+	return string(e)
+}
+
+func (e *ReportAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportAction", str)
+	}
+	return nil
+}
+
+func (e ReportAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportTargetType string
+
+const (
+	ReportTargetTypeCollection ReportTargetType = "collection"
+	ReportTargetTypeToken      ReportTargetType = "token"
+)
+
+var AllReportTargetType = []ReportTargetType{
+	ReportTargetTypeCollection,
+	ReportTargetTypeToken,
+}
+
+func (e ReportTargetType) IsValid() bool {
+	switch e {
+	case ReportTargetTypeCollection, ReportTargetTypeToken:
+		return true
+	}
+	return false
+}
+
+func (e ReportTargetType) String() string {
+	return string(e)
+}
+
+func (e *ReportTargetType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportTargetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportTargetType", str)
+	}
+	return nil
+}
+
+func (e ReportTargetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportTargetType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportTargetType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RPCAuthType string
+
+const (
+	RPCAuthTypeNone   RPCAuthType = "NONE"
+	RPCAuthTypeKey    RPCAuthType = "KEY"
+	RPCAuthTypeBasic  RPCAuthType = "BASIC"
+	RPCAuthTypeBearer RPCAuthType = "BEARER"
+)
+
+var AllRPCAuthType = []RPCAuthType{
+	RPCAuthTypeNone,
+	RPCAuthTypeKey,
+	RPCAuthTypeBasic,
+	RPCAuthTypeBearer,
+}
+
+func (e RPCAuthType) IsValid() bool {
+	switch e {
+	case RPCAuthTypeNone, RPCAuthTypeKey, RPCAuthTypeBasic, RPCAuthTypeBearer:
+		return true
+	}
+	return false
+}
+
+func (e RPCAuthType) String() string {
 	return string(e)
 }
 
@@ -496,18 +2936,73 @@ func (e *RPCAuthType) UnmarshalGQL(v any) error {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = RPCAuthType(str)
+	*e = RPCAuthType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RpcAuthType", str)
+	}
+	return nil
+}
+
+func (e RPCAuthType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RPCAuthType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RPCAuthType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SignatureScheme string
+
+const (
+	SignatureSchemeEip191 SignatureScheme = "eip191"
+	SignatureSchemeEip712 SignatureScheme = "eip712"
+)
+
+var AllSignatureScheme = []SignatureScheme{
+	SignatureSchemeEip191,
+	SignatureSchemeEip712,
+}
+
+func (e SignatureScheme) IsValid() bool {
+	switch e {
+	case SignatureSchemeEip191, SignatureSchemeEip712:
+		return true
+	}
+	return false
+}
+
+func (e SignatureScheme) String() string {
+	return string(e)
+}
+
+func (e *SignatureScheme) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SignatureScheme(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid RpcAuthType", str)
+		return fmt.Errorf("%s is not a valid SignatureScheme", str)
 	}
 	return nil
 }
 
-func (e RPCAuthType) MarshalGQL(w io.Writer) {
+func (e SignatureScheme) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *RPCAuthType) UnmarshalJSON(b []byte) error {
+func (e *SignatureScheme) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -515,7 +3010,237 @@ func (e *RPCAuthType) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e RPCAuthType) MarshalJSON() ([]byte, error) {
+func (e SignatureScheme) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "asc"
+	SortDirectionDesc SortDirection = "desc"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SuggestionKind string
+
+const (
+	SuggestionKindCollection SuggestionKind = "collection"
+	SuggestionKindUser       SuggestionKind = "user"
+	SuggestionKindToken      SuggestionKind = "token"
+)
+
+var AllSuggestionKind = []SuggestionKind{
+	SuggestionKindCollection,
+	SuggestionKindUser,
+	SuggestionKindToken,
+}
+
+func (e SuggestionKind) IsValid() bool {
+	switch e {
+	case SuggestionKindCollection, SuggestionKindUser, SuggestionKindToken:
+		return true
+	}
+	return false
+}
+
+func (e SuggestionKind) String() string {
+	return string(e)
+}
+
+func (e *SuggestionKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SuggestionKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SuggestionKind", str)
+	}
+	return nil
+}
+
+func (e SuggestionKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SuggestionKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SuggestionKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TokenSortField string
+
+const (
+	TokenSortFieldCreatedAt TokenSortField = "createdAt"
+	TokenSortFieldPrice     TokenSortField = "price"
+	TokenSortFieldRarity    TokenSortField = "rarity"
+)
+
+var AllTokenSortField = []TokenSortField{
+	TokenSortFieldCreatedAt,
+	TokenSortFieldPrice,
+	TokenSortFieldRarity,
+}
+
+func (e TokenSortField) IsValid() bool {
+	switch e {
+	case TokenSortFieldCreatedAt, TokenSortFieldPrice, TokenSortFieldRarity:
+		return true
+	}
+	return false
+}
+
+func (e TokenSortField) String() string {
+	return string(e)
+}
+
+func (e *TokenSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TokenSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TokenSortField", str)
+	}
+	return nil
+}
+
+func (e TokenSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TokenSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TokenSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type UploadStage string
+
+const (
+	UploadStageUploading  UploadStage = "UPLOADING"
+	UploadStagePinning    UploadStage = "PINNING"
+	UploadStageProcessing UploadStage = "PROCESSING"
+	UploadStageDone       UploadStage = "DONE"
+	UploadStageFailed     UploadStage = "FAILED"
+)
+
+var AllUploadStage = []UploadStage{
+	UploadStageUploading,
+	UploadStagePinning,
+	UploadStageProcessing,
+	UploadStageDone,
+	UploadStageFailed,
+}
+
+func (e UploadStage) IsValid() bool {
+	switch e {
+	case UploadStageUploading, UploadStagePinning, UploadStageProcessing, UploadStageDone, UploadStageFailed:
+		return true
+	}
+	return false
+}
+
+func (e UploadStage) String() string {
+	return string(e)
+}
+
+func (e *UploadStage) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UploadStage(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UploadStage", str)
+	}
+	return nil
+}
+
+func (e UploadStage) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UploadStage) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UploadStage) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
@@ -581,3 +3306,119 @@ func (e VariantFormat) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type WalletActivityKind string
+
+const (
+	WalletActivityKindMint     WalletActivityKind = "mint"
+	WalletActivityKindBurn     WalletActivityKind = "burn"
+	WalletActivityKindTransfer WalletActivityKind = "transfer"
+	WalletActivityKindSale     WalletActivityKind = "sale"
+	WalletActivityKindIntent   WalletActivityKind = "intent"
+)
+
+var AllWalletActivityKind = []WalletActivityKind{
+	WalletActivityKindMint,
+	WalletActivityKindBurn,
+	WalletActivityKindTransfer,
+	WalletActivityKindSale,
+	WalletActivityKindIntent,
+}
+
+func (e WalletActivityKind) IsValid() bool {
+	switch e {
+	case WalletActivityKindMint, WalletActivityKindBurn, WalletActivityKindTransfer, WalletActivityKindSale, WalletActivityKindIntent:
+		return true
+	}
+	return false
+}
+
+func (e WalletActivityKind) String() string {
+	return string(e)
+}
+
+func (e *WalletActivityKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WalletActivityKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WalletActivityKind", str)
+	}
+	return nil
+}
+
+func (e WalletActivityKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WalletActivityKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WalletActivityKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WatchTargetType string
+
+const (
+	WatchTargetTypeCollection WatchTargetType = "collection"
+	WatchTargetTypeToken      WatchTargetType = "token"
+)
+
+var AllWatchTargetType = []WatchTargetType{
+	WatchTargetTypeCollection,
+	WatchTargetTypeToken,
+}
+
+func (e WatchTargetType) IsValid() bool {
+	switch e {
+	case WatchTargetTypeCollection, WatchTargetTypeToken:
+		return true
+	}
+	return false
+}
+
+func (e WatchTargetType) String() string {
+	return string(e)
+}
+
+func (e *WatchTargetType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WatchTargetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WatchTargetType", str)
+	}
+	return nil
+}
+
+func (e WatchTargetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WatchTargetType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WatchTargetType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,6645 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package schemas
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/introspection"
+	gqlparser "github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
+func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
+	return &executableSchema{
+		schema:     cfg.Schema,
+		resolvers:  cfg.Resolvers,
+		directives: cfg.Directives,
+		complexity: cfg.Complexity,
+	}
+}
+
+type Config struct {
+	Schema     *ast.Schema
+	Resolvers  ResolverRoot
+	Directives DirectiveRoot
+	Complexity ComplexityRoot
+}
+
+type ResolverRoot interface {
+	Mutation() MutationResolver
+	Query() QueryResolver
+	Subscription() SubscriptionResolver
+}
+
+type DirectiveRoot struct {
+}
+
+type ComplexityRoot struct {
+	AccountEvent struct {
+		Address          func(childComplexity int) int
+		ChainID          func(childComplexity int) int
+		ExportID         func(childComplexity int) int
+		IsPrimary        func(childComplexity int) int
+		OccurredAt       func(childComplexity int) int
+		PreviousAddress  func(childComplexity int) int
+		PreviousWalletID func(childComplexity int) int
+		SessionID        func(childComplexity int) int
+		Type             func(childComplexity int) int
+		WalletID         func(childComplexity int) int
+	}
+
+	AddressChallenge struct {
+		ExpiresAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Message   func(childComplexity int) int
+		Scheme    func(childComplexity int) int
+	}
+
+	AirdropBatch struct {
+		GasLimit   func(childComplexity int) int
+		IntentID   func(childComplexity int) int
+		Recipients func(childComplexity int) int
+		Seq        func(childComplexity int) int
+		TxRequest  func(childComplexity int) int
+	}
+
+	AirdropBundle struct {
+		Batches        func(childComplexity int) int
+		BundleID       func(childComplexity int) int
+		ChainID        func(childComplexity int) int
+		Contract       func(childComplexity int) int
+		RecipientCount func(childComplexity int) int
+	}
+
+	AirdropProgress struct {
+		BatchCount     func(childComplexity int) int
+		Batches        func(childComplexity int) int
+		BundleID       func(childComplexity int) int
+		ChainID        func(childComplexity int) int
+		Confirmed      func(childComplexity int) int
+		Contract       func(childComplexity int) int
+		Failed         func(childComplexity int) int
+		RecipientCount func(childComplexity int) int
+		Status         func(childComplexity int) int
+	}
+
+	AirdropRecipient struct {
+		Address func(childComplexity int) int
+		Amount  func(childComplexity int) int
+	}
+
+	ApiKey struct {
+		CreatedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		LastUsedAt func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Plan       func(childComplexity int) int
+		Prefix     func(childComplexity int) int
+		RevokedAt  func(childComplexity int) int
+	}
+
+	ApiKeyUsage struct {
+		APIKey    func(childComplexity int) int
+		Hours     func(childComplexity int) int
+		Limits    func(childComplexity int) int
+		UsedToday func(childComplexity int) int
+	}
+
+	ApiPlanLimits struct {
+		BurstPerSecond func(childComplexity int) int
+		DailyQuota     func(childComplexity int) int
+		Enforcement    func(childComplexity int) int
+		Plan           func(childComplexity int) int
+	}
+
+	ApiUsageHour struct {
+		Hour     func(childComplexity int) int
+		Rejected func(childComplexity int) int
+		Requests func(childComplexity int) int
+	}
+
+	AuthPayload struct {
+		AccessToken  func(childComplexity int) int
+		ExpiresAt    func(childComplexity int) int
+		RefreshToken func(childComplexity int) int
+		UserID       func(childComplexity int) int
+	}
+
+	BulkTransferApproval struct {
+		Check     func(childComplexity int) int
+		Contract  func(childComplexity int) int
+		TxRequest func(childComplexity int) int
+	}
+
+	BulkTransferBatch struct {
+		Contract  func(childComplexity int) int
+		IntentID  func(childComplexity int) int
+		Seq       func(childComplexity int) int
+		Standard  func(childComplexity int) int
+		To        func(childComplexity int) int
+		Tokens    func(childComplexity int) int
+		TxRequest func(childComplexity int) int
+	}
+
+	BulkTransferBundle struct {
+		Approvals  func(childComplexity int) int
+		Batches    func(childComplexity int) int
+		BundleID   func(childComplexity int) int
+		ChainID    func(childComplexity int) int
+		From       func(childComplexity int) int
+		Signer     func(childComplexity int) int
+		TokenCount func(childComplexity int) int
+	}
+
+	BulkTransferProgress struct {
+		BatchCount     func(childComplexity int) int
+		Batches        func(childComplexity int) int
+		BundleID       func(childComplexity int) int
+		ChainID        func(childComplexity int) int
+		Confirmed      func(childComplexity int) int
+		Failed         func(childComplexity int) int
+		RecipientCount func(childComplexity int) int
+		Status         func(childComplexity int) int
+	}
+
+	BulkTransferToken struct {
+		Amount  func(childComplexity int) int
+		TokenID func(childComplexity int) int
+	}
+
+	BumpChainVersionPayload struct {
+		NewVersion func(childComplexity int) int
+		Ok         func(childComplexity int) int
+	}
+
+	CallTargetOverride struct {
+		Address   func(childComplexity int) int
+		ChainID   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		GrantedBy func(childComplexity int) int
+		Reason    func(childComplexity int) int
+	}
+
+	ChainCapabilities struct {
+		AllowlistMint  func(childComplexity int) int
+		Erc1155Factory func(childComplexity int) int
+		Erc721Factory  func(childComplexity int) int
+		LazyMint       func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+		Version        func(childComplexity int) int
+	}
+
+	ChainCapabilitiesInfo struct {
+		Capabilities    func(childComplexity int) int
+		ChainID         func(childComplexity int) int
+		RegistryVersion func(childComplexity int) int
+	}
+
+	ChainContracts struct {
+		ChainID         func(childComplexity int) int
+		ChainNumeric    func(childComplexity int) int
+		Contracts       func(childComplexity int) int
+		NativeSymbol    func(childComplexity int) int
+		Params          func(childComplexity int) int
+		RegistryVersion func(childComplexity int) int
+	}
+
+	ChainGasPolicy struct {
+		ChainID         func(childComplexity int) int
+		Policy          func(childComplexity int) int
+		RegistryVersion func(childComplexity int) int
+	}
+
+	ChainHead struct {
+		AdvancedAt      func(childComplexity int) int
+		ChainID         func(childComplexity int) int
+		FinalizedBlock  func(childComplexity int) int
+		FinalizedByTag  func(childComplexity int) int
+		LatestBlock     func(childComplexity int) int
+		LatestBlockHash func(childComplexity int) int
+		LatestBlockTime func(childComplexity int) int
+		Stale           func(childComplexity int) int
+	}
+
+	ChainIndexingStatus struct {
+		AdvancedAt  func(childComplexity int) int
+		ChainID     func(childComplexity int) int
+		LagSeconds  func(childComplexity int) int
+		LatestBlock func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
+	ChainParams struct {
+		BlockTimeMs           func(childComplexity int) int
+		ReorgDepth            func(childComplexity int) int
+		RequiredConfirmations func(childComplexity int) int
+	}
+
+	ChainRpcEndpoints struct {
+		ChainID         func(childComplexity int) int
+		Endpoints       func(childComplexity int) int
+		RegistryVersion func(childComplexity int) int
+	}
+
+	Collection struct {
+		Category              func(childComplexity int) int
+		ChainID               func(childComplexity int) int
+		Confirmations         func(childComplexity int) int
+		ContentLocale         func(childComplexity int) int
+		ContractAddress       func(childComplexity int) int
+		CreatedAt             func(childComplexity int) int
+		Creator               func(childComplexity int) int
+		Description           func(childComplexity int) int
+		Flagged               func(childComplexity int) int
+		ID                    func(childComplexity int) int
+		ImageURL              func(childComplexity int) int
+		IsVerified            func(childComplexity int) int
+		LocalizedContent      func(childComplexity int) int
+		MaxSupply             func(childComplexity int) int
+		Name                  func(childComplexity int) int
+		Owner                 func(childComplexity int) int
+		OwnerOrgID            func(childComplexity int) int
+		PayoutSplits          func(childComplexity int) int
+		PendingFinality       func(childComplexity int) int
+		Reported              func(childComplexity int) int
+		RequiredConfirmations func(childComplexity int) int
+		RoyaltyBps            func(childComplexity int) int
+		RoyaltyRecipient      func(childComplexity int) int
+		Slug                  func(childComplexity int) int
+		Tagline               func(childComplexity int) int
+		Tags                  func(childComplexity int) int
+		TokenURI              func(childComplexity int) int
+		TotalSupply           func(childComplexity int) int
+		Type                  func(childComplexity int) int
+		UpdatedAt             func(childComplexity int) int
+	}
+
+	CollectionConstraints struct {
+		MaxRoyaltyBps       func(childComplexity int) int
+		MaxSupplyCap        func(childComplexity int) int
+		MinStageDurationSec func(childComplexity int) int
+	}
+
+	CollectionDefaults struct {
+		AllowlistStageDuration func(childComplexity int) int
+		ChainID                func(childComplexity int) int
+		Constraints            func(childComplexity int) int
+		MaxSupply              func(childComplexity int) int
+		MintLimitPerWallet     func(childComplexity int) int
+		RoyaltyFee             func(childComplexity int) int
+		SupportedTypes         func(childComplexity int) int
+	}
+
+	CollectionExport struct {
+		CSV         func(childComplexity int) int
+		ChainID     func(childComplexity int) int
+		CompletedAt func(childComplexity int) int
+		Contract    func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Error       func(childComplexity int) int
+		From        func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Kind        func(childComplexity int) int
+		RowCount    func(childComplexity int) int
+		Status      func(childComplexity int) int
+		To          func(childComplexity int) int
+	}
+
+	CollectionImportChallenge struct {
+		ChallengeID func(childComplexity int) int
+		ExpiresAt   func(childComplexity int) int
+		IssuedAt    func(childComplexity int) int
+		Message     func(childComplexity int) int
+	}
+
+	ConsumerStatus struct {
+		Consumer             func(childComplexity int) int
+		Failed               func(childComplexity int) int
+		FallingBehind        func(childComplexity int) int
+		InFlight             func(childComplexity int) int
+		MessagesPerSecond    func(childComplexity int) int
+		OldestUnackedSeconds func(childComplexity int) int
+		Processed            func(childComplexity int) int
+		Queue                func(childComplexity int) int
+		ReportedAt           func(childComplexity int) int
+		Stale                func(childComplexity int) int
+	}
+
+	Contract struct {
+		AbiSha256   func(childComplexity int) int
+		AbiURL      func(childComplexity int) int
+		Address     func(childComplexity int) int
+		ImplAddress func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Standard    func(childComplexity int) int
+		StartBlock  func(childComplexity int) int
+		VerifiedAt  func(childComplexity int) int
+	}
+
+	ContractMeta struct {
+		ChainID         func(childComplexity int) int
+		Contract        func(childComplexity int) int
+		RegistryVersion func(childComplexity int) int
+	}
+
+	CreatedApiKey struct {
+		APIKey func(childComplexity int) int
+		Secret func(childComplexity int) int
+	}
+
+	DropSubmission struct {
+		ChainID     func(childComplexity int) int
+		Contract    func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Description func(childComplexity int) int
+		EndsAt      func(childComplexity int) int
+		ExternalURL func(childComplexity int) int
+		ID          func(childComplexity int) int
+		ImageURL    func(childComplexity int) int
+		MintPrice   func(childComplexity int) int
+		Name        func(childComplexity int) int
+		ReviewNote  func(childComplexity int) int
+		StartsAt    func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
+	Earnings struct {
+		Period func(childComplexity int) int
+		Since  func(childComplexity int) int
+		Totals func(childComplexity int) int
+	}
+
+	EarningsTotal struct {
+		Amount         func(childComplexity int) int
+		ChainID        func(childComplexity int) int
+		CollectionName func(childComplexity int) int
+		Contract       func(childComplexity int) int
+		Currency       func(childComplexity int) int
+		Sales          func(childComplexity int) int
+	}
+
+	EmailStatus struct {
+		DigestOptOut func(childComplexity int) int
+		Email        func(childComplexity int) int
+		OptOuts      func(childComplexity int) int
+		Verified     func(childComplexity int) int
+		VerifiedAt   func(childComplexity int) int
+	}
+
+	EmailUnsubscribeResult struct {
+		Email func(childComplexity int) int
+		Kind  func(childComplexity int) int
+	}
+
+	GasPolicy struct {
+		LastObservedBaseFeeGwei func(childComplexity int) int
+		MaxFeeGwei              func(childComplexity int) int
+		Multiplier              func(childComplexity int) int
+		PriorityFeeGwei         func(childComplexity int) int
+		UpdatedAt               func(childComplexity int) int
+	}
+
+	HolderSnapshot struct {
+		BlockNumber   func(childComplexity int) int
+		CSV           func(childComplexity int) int
+		ChainID       func(childComplexity int) int
+		Contract      func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		HolderCount   func(childComplexity int) int
+		ID            func(childComplexity int) int
+		JSON          func(childComplexity int) int
+		TotalQuantity func(childComplexity int) int
+	}
+
+	Impersonation struct {
+		ExpiresAt      func(childComplexity int) int
+		ImpersonatorID func(childComplexity int) int
+		Reason         func(childComplexity int) int
+	}
+
+	ImpersonationPayload struct {
+		AccessToken    func(childComplexity int) int
+		ExpiresAt      func(childComplexity int) int
+		ImpersonatorID func(childComplexity int) int
+		UserID         func(childComplexity int) int
+	}
+
+	ImportedCollection struct {
+		Address    func(childComplexity int) int
+		ChainID    func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Owner      func(childComplexity int) int
+		Standard   func(childComplexity int) int
+		StartBlock func(childComplexity int) int
+		Symbol     func(childComplexity int) int
+	}
+
+	IntentStatusPayload struct {
+		ChainID         func(childComplexity int) int
+		ContractAddress func(childComplexity int) int
+		Error           func(childComplexity int) int
+		IntentID        func(childComplexity int) int
+		Kind            func(childComplexity int) int
+		Recovery        func(childComplexity int) int
+		StalenessMs     func(childComplexity int) int
+		Status          func(childComplexity int) int
+		TxHash          func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+	}
+
+	LinkedWallet struct {
+		Address     func(childComplexity int) int
+		ChainID     func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		IsPrimary   func(childComplexity int) int
+		IsWatchOnly func(childComplexity int) int
+		Label       func(childComplexity int) int
+		LastSeenAt  func(childComplexity int) int
+		Tags        func(childComplexity int) int
+		VerifiedAt  func(childComplexity int) int
+	}
+
+	LocalizedContent struct {
+		Description func(childComplexity int) int
+		Locale      func(childComplexity int) int
+		Tagline     func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+	}
+
+	MediaAsset struct {
+		Bytes     func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Height    func(childComplexity int) int
+		ID        func(childComplexity int) int
+		IpfsCid   func(childComplexity int) int
+		Kind      func(childComplexity int) int
+		Mime      func(childComplexity int) int
+		PinStatus func(childComplexity int) int
+		RefCount  func(childComplexity int) int
+		Sha256    func(childComplexity int) int
+		URL       func(childComplexity int) int
+		Variants  func(childComplexity int) int
+		Width     func(childComplexity int) int
+	}
+
+	MediaKindUsage struct {
+		Assets    func(childComplexity int) int
+		Bytes     func(childComplexity int) int
+		HardLimit func(childComplexity int) int
+		Kind      func(childComplexity int) int
+	}
+
+	MediaPinStatusEvent struct {
+		AssetID   func(childComplexity int) int
+		Cid       func(childComplexity int) int
+		Gateway   func(childComplexity int) int
+		Status    func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
+	MediaUrls struct {
+		Cdn     func(childComplexity int) int
+		Gateway func(childComplexity int) int
+	}
+
+	MediaVariant struct {
+		CdnURL func(childComplexity int) int
+		Format func(childComplexity int) int
+		Height func(childComplexity int) int
+		ID     func(childComplexity int) int
+		Width  func(childComplexity int) int
+	}
+
+	MintStats struct {
+		Buckets       func(childComplexity int) int
+		ChainID       func(childComplexity int) int
+		Contract      func(childComplexity int) int
+		Mints         func(childComplexity int) int
+		Revenue       func(childComplexity int) int
+		Since         func(childComplexity int) int
+		UniqueMinters func(childComplexity int) int
+		Window        func(childComplexity int) int
+	}
+
+	MintStatsBucket struct {
+		Mints         func(childComplexity int) int
+		Minute        func(childComplexity int) int
+		Revenue       func(childComplexity int) int
+		UniqueMinters func(childComplexity int) int
+	}
+
+	ModerationFlag struct {
+		ChainID   func(childComplexity int) int
+		Contract  func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Note      func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		Source    func(childComplexity int) int
+		Status    func(childComplexity int) int
+		TokenID   func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
+	Mutation struct {
+		AcceptOrganizationInvitation   func(childComplexity int, token string) int
+		AddWatchOnlyWallet             func(childComplexity int, address string, chainID string, label *string, tags []string) int
+		AllowCallTarget                func(childComplexity int, chainID string, address string, reason string) int
+		AssignCollectionToOrganization func(childComplexity int, chainID string, contract string, orgID *string) int
+		BlockUser                      func(childComplexity int, userID string) int
+		BumpChainVersion               func(childComplexity int, input BumpChainVersionInput) int
+		ClearNftAvatar                 func(childComplexity int) int
+		ConfirmEmail                   func(childComplexity int, code string) int
+		CreateAPIKey                   func(childComplexity int, name string) int
+		CreateAddressChallenge         func(childComplexity int, purpose AddressChallengePurpose, address string, chainID string, scheme *SignatureScheme) int
+		CreateHolderSnapshot           func(childComplexity int, chainID string, contract string, blockNumber *string) int
+		CreateOrganization             func(childComplexity int, name string) int
+		CreateSubscriptionTicket       func(childComplexity int) int
+		DeleteSavedSearch              func(childComplexity int, id string) int
+		EndImpersonation               func(childComplexity int) int
+		ExportMints                    func(childComplexity int, chainID string, contract string, from string, to string) int
+		ExportSales                    func(childComplexity int, chainID string, contract string, from string, to string) int
+		FavoriteCollection             func(childComplexity int, chainID string, contract string, floorAlertBelow *string) int
+		FavoriteToken                  func(childComplexity int, chainID string, contract string, tokenID string, floorAlertBelow *string) int
+		FlagItem                       func(childComplexity int, input FlagItemInput) int
+		FollowUser                     func(childComplexity int, userID string) int
+		FreezeSponsorshipBudget        func(childComplexity int, chainID string, reason string) int
+		ImportCollection               func(childComplexity int, chainID string, address string, issuedAt *string, signature string, challengeID *string) int
+		InviteOrganizationMember       func(childComplexity int, orgID string, email string, role *OrganizationRole) int
+		Logout                         func(childComplexity int) int
+		MuteUser                       func(childComplexity int, userID string) int
+		PrepareAirdrop                 func(childComplexity int, input PrepareAirdropInput) int
+		PrepareBulkTransfer            func(childComplexity int, input PrepareBulkTransferInput) int
+		PrepareCollectionImport        func(childComplexity int, chainID string, address string) int
+		PrepareCreateCollection        func(childComplexity int, input PrepareCreateCollectionInput) int
+		PrepareMint                    func(childComplexity int, input PrepareMintInput) int
+		PrepareSetPayoutSplits         func(childComplexity int, chainID string, contract string, splits []*PayoutSplitInput) int
+		RefreshSession                 func(childComplexity int) int
+		ReleaseMediaAsset              func(childComplexity int, id string) int
+		RemoveOrganizationMember       func(childComplexity int, orgID string, userID string) int
+		ReportContent                  func(childComplexity int, targetType ReportTargetType, targetID string, reason ModerationReason, details *string) int
+		ResolveReports                 func(childComplexity int, targetType ReportTargetType, targetID string, action ReportAction, note *string) int
+		ResyncCollection               func(childComplexity int, input ResyncCollectionInput) int
+		ReviewDropSubmission           func(childComplexity int, id string, action DropReviewAction, note *string) int
+		RevokeAPIKey                   func(childComplexity int, id string) int
+		RevokeCallTarget               func(childComplexity int, chainID string, address string) int
+		SaveSearch                     func(childComplexity int, query string, filters []*SearchFilterInput, name *string) int
+		SetAPIKeyPlan                  func(childComplexity int, id string, plan APIPlan) int
+		SetAvatarFromNft               func(childComplexity int, chainID string, contract string, tokenID string) int
+		SetCollectionClassification    func(childComplexity int, chainID string, contract string, category *CollectionCategory, tags []string) int
+		SetCollectionContent           func(childComplexity int, chainID string, contract string, locale string, description *string, tagline *string) int
+		SetEmailDigestOptOut           func(childComplexity int, optOut bool) int
+		SetEmailOptOut                 func(childComplexity int, kind EmailKind, optOut bool) int
+		SetOrganizationMemberRole      func(childComplexity int, orgID string, userID string, role OrganizationRole) int
+		SetProfileVisibility           func(childComplexity int, visibility ProfileVisibility) int
+		SignInSiwe                     func(childComplexity int, input SignInSiweInput) int
+		StartEmailVerification         func(childComplexity int, email string) int
+		StartImpersonation             func(childComplexity int, userID string, reason string) int
+		SubmitDrop                     func(childComplexity int, input SubmitDropInput) int
+		TopUpSponsorshipBudget         func(childComplexity int, chainID string, amountGwei string, note *string) int
+		TrackTx                        func(childComplexity int, input TrackTxInput) int
+		UnblockUser                    func(childComplexity int, userID string) int
+		Unfavorite                     func(childComplexity int, id string) int
+		UnflagItem                     func(childComplexity int, input UnflagItemInput) int
+		UnfollowUser                   func(childComplexity int, userID string) int
+		UnfreezeSponsorshipBudget      func(childComplexity int, chainID string) int
+		UnmuteUser                     func(childComplexity int, userID string) int
+		UnsubscribeEmail               func(childComplexity int, token string) int
+		UpdateProfile                  func(childComplexity int, displayName *string) int
+		UpdateViewerPreferences        func(childComplexity int, locale *string, timezone *string, currency *string, honorDelegations *bool) int
+		UpdateWallet                   func(childComplexity int, input UpdateWalletInput) int
+		UploadSingleFile               func(childComplexity int, input UploadSingleFileInput) int
+		VerifySiwe                     func(childComplexity int, input VerifySiweInput) int
+		VerifyTokenGate                func(childComplexity int, chainID string, contract string, minBalance *string, proof *AddressProofInput) int
+	}
+
+	NftAvatar struct {
+		ChainID    func(childComplexity int) int
+		CheckedAt  func(childComplexity int) int
+		Contract   func(childComplexity int) int
+		TokenID    func(childComplexity int) int
+		Verified   func(childComplexity int) int
+		VerifiedAt func(childComplexity int) int
+	}
+
+	NoncePayload struct {
+		Nonce func(childComplexity int) int
+	}
+
+	NumberFormat struct {
+		CurrencySymbol   func(childComplexity int) int
+		DecimalSeparator func(childComplexity int) int
+		FractionDigits   func(childComplexity int) int
+		GroupSeparator   func(childComplexity int) int
+	}
+
+	Organization struct {
+		CreatedAt func(childComplexity int) int
+		CreatedBy func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Name      func(childComplexity int) int
+	}
+
+	OrganizationDetails struct {
+		Members      func(childComplexity int) int
+		Organization func(childComplexity int) int
+	}
+
+	OrganizationInvitation struct {
+		ExpiresAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+	}
+
+	OrganizationMember struct {
+		JoinedAt func(childComplexity int) int
+		Role     func(childComplexity int) int
+		UserID   func(childComplexity int) int
+	}
+
+	OrganizationMembership struct {
+		Organization func(childComplexity int) int
+		Role         func(childComplexity int) int
+	}
+
+	PayoutSplit struct {
+		Bps       func(childComplexity int) int
+		Recipient func(childComplexity int) int
+	}
+
+	PinningHealth struct {
+		LastPinnedAt func(childComplexity int) int
+		Status       func(childComplexity int) int
+	}
+
+	PlatformStatus struct {
+		Chains    func(childComplexity int) int
+		CheckedAt func(childComplexity int) int
+		Pinning   func(childComplexity int) int
+		Queues    func(childComplexity int) int
+		Services  func(childComplexity int) int
+		Status    func(childComplexity int) int
+	}
+
+	PrepareCollectionAdminPayload struct {
+		IntentID  func(childComplexity int) int
+		TxRequest func(childComplexity int) int
+	}
+
+	PrepareCreateCollectionPayload struct {
+		CallbackSecret func(childComplexity int) int
+		IntentID       func(childComplexity int) int
+		TxRequest      func(childComplexity int) int
+	}
+
+	PrepareMintPayload struct {
+		IntentID  func(childComplexity int) int
+		TxRequest func(childComplexity int) int
+	}
+
+	ProfileStats struct {
+		CollectionsCreated func(childComplexity int) int
+		Followers          func(childComplexity int) int
+		ItemsOwned         func(childComplexity int) int
+		VolumeWei          func(childComplexity int) int
+	}
+
+	Query struct {
+		AirdropProgress      func(childComplexity int, bundleID string) int
+		BulkTransferProgress func(childComplexity int, bundleID string) int
+		CallTargetOverrides  func(childComplexity int, chainID *string) int
+		ChainCapabilities    func(childComplexity int, chainID string) int
+		ChainContracts       func(childComplexity int, chainID string) int
+		ChainGasPolicy       func(childComplexity int, chainID string) int
+		ChainHead            func(childComplexity int, chainID string) int
+		ChainRPCEndpoints    func(childComplexity int, chainID string) int
+		Collection           func(childComplexity int, chainID string, contract string, includeFlagged *bool, includeUnconfirmed *bool) int
+		CollectionBySlug     func(childComplexity int, slug string, includeFlagged *bool, includeUnconfirmed *bool) int
+		CollectionDefaults   func(childComplexity int, chainID string) int
+		CollectionExport     func(childComplexity int, id string) int
+		Collections          func(childComplexity int, chainID *string, filter *CollectionFilterInput, sort *CollectionSortInput, limit *int, offset *int, includeFlagged *bool, includeUnconfirmed *bool) int
+		ContractMeta         func(childComplexity int, chainID string, address string) int
+		DropSubmissions      func(childComplexity int, status *DropSubmissionStatus, limit *int, offset *int) int
+		Health               func(childComplexity int) int
+		HolderSnapshot       func(childComplexity int, id string) int
+		Me                   func(childComplexity int) int
+		MediaAsset           func(childComplexity int, id string) int
+		MediaAssetByCid      func(childComplexity int, cid string) int
+		MintStats            func(childComplexity int, chainID string, contract string, window *MintStatsWindow) int
+		MyAPIKeys            func(childComplexity int) int
+		MyAPIUsage           func(childComplexity int, days *int) int
+		MyCreatedCollections func(childComplexity int, chainID *string, limit *int, offset *int) int
+		MyDropSubmissions    func(childComplexity int, limit *int, offset *int) int
+		MyEarnings           func(childComplexity int, period *EarningsPeriod) int
+		MyEmail              func(childComplexity int) int
+		MyNFTs               func(childComplexity int, chainID string, contract *string, limit *int, offset *int) int
+		MyOrganizations      func(childComplexity int) int
+		MyRelationships      func(childComplexity int, kind RelationshipKind) int
+		MyStorageUsage       func(childComplexity int) int
+		MyWallets            func(childComplexity int, watchOnly *bool) int
+		MyWatchlist          func(childComplexity int) int
+		Organization         func(childComplexity int, id string) int
+		PlatformStatus       func(childComplexity int) int
+		RelatedCollections   func(childComplexity int, slug string, limit *int) int
+		ReportQueue          func(childComplexity int, limit *int, offset *int) int
+		SponsorshipBudgets   func(childComplexity int) int
+		Suggest              func(childComplexity int, query string, limit *int) int
+		SystemStatus         func(childComplexity int) int
+		Token                func(childComplexity int, chainID string, contract string, tokenID string, includeFlagged *bool) int
+		Tokens               func(childComplexity int, filter *TokenFilterInput, sort *TokenSortInput, limit *int, offset *int, includeFlagged *bool) int
+		UpcomingDrops        func(childComplexity int, chainID *string, from *string, to *string, limit *int) int
+		UserActivity         func(childComplexity int, userID string, address string, cursor *string, limit *int) int
+		UserProfile          func(childComplexity int, userID string) int
+		ViewerPreferences    func(childComplexity int) int
+		WalletActivity       func(childComplexity int, address string, cursor *string, limit *int) int
+	}
+
+	QueueBacklog struct {
+		Messages func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Status   func(childComplexity int) int
+	}
+
+	QueueStatus struct {
+		Consumers func(childComplexity int) int
+		Error     func(childComplexity int) int
+		Messages  func(childComplexity int) int
+		Name      func(childComplexity int) int
+	}
+
+	Report struct {
+		CreatedAt  func(childComplexity int) int
+		Details    func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Reason     func(childComplexity int) int
+		Status     func(childComplexity int) int
+		TargetID   func(childComplexity int) int
+		TargetType func(childComplexity int) int
+	}
+
+	ReportContentPayload struct {
+		Duplicate func(childComplexity int) int
+		Report    func(childComplexity int) int
+	}
+
+	ReportQueueItem struct {
+		FirstReportedAt func(childComplexity int) int
+		LastReportedAt  func(childComplexity int) int
+		Reasons         func(childComplexity int) int
+		ReportCount     func(childComplexity int) int
+		TargetID        func(childComplexity int) int
+		TargetType      func(childComplexity int) int
+	}
+
+	ResolveReportsPayload struct {
+		Flag     func(childComplexity int) int
+		Resolved func(childComplexity int) int
+	}
+
+	ResyncDrift struct {
+		Catalog func(childComplexity int) int
+		Chain   func(childComplexity int) int
+		Holder  func(childComplexity int) int
+		Kind    func(childComplexity int) int
+		TokenID func(childComplexity int) int
+	}
+
+	ResyncReport struct {
+		BlockNumber   func(childComplexity int) int
+		ChainID       func(childComplexity int) int
+		CheckedAt     func(childComplexity int) int
+		Contract      func(childComplexity int) int
+		Drifts        func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Repaired      func(childComplexity int) int
+		Standard      func(childComplexity int) int
+		TokensSampled func(childComplexity int) int
+	}
+
+	RpcEndpoint struct {
+		Active    func(childComplexity int) int
+		AuthType  func(childComplexity int) int
+		Priority  func(childComplexity int) int
+		RateLimit func(childComplexity int) int
+		URL       func(childComplexity int) int
+		Weight    func(childComplexity int) int
+	}
+
+	SavedSearch struct {
+		CreatedAt func(childComplexity int) int
+		Filters   func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Query     func(childComplexity int) int
+	}
+
+	SearchFilter struct {
+		Key   func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	ServiceHealth struct {
+		Name   func(childComplexity int) int
+		Status func(childComplexity int) int
+	}
+
+	SnapshotExport struct {
+		Bytes     func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		URL       func(childComplexity int) int
+	}
+
+	SponsorshipBudget struct {
+		BalanceGwei  func(childComplexity int) int
+		ChainID      func(childComplexity int) int
+		Frozen       func(childComplexity int) int
+		FrozenReason func(childComplexity int) int
+		SpentGwei    func(childComplexity int) int
+		ToppedUpGwei func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		UpdatedBy    func(childComplexity int) int
+	}
+
+	StorageLimits struct {
+		Assets func(childComplexity int) int
+		Bytes  func(childComplexity int) int
+	}
+
+	StorageUsage struct {
+		Assets        func(childComplexity int) int
+		ByKind        func(childComplexity int) int
+		Bytes         func(childComplexity int) int
+		HardLimit     func(childComplexity int) int
+		OverSoftLimit func(childComplexity int) int
+		SoftLimit     func(childComplexity int) int
+	}
+
+	Subscription struct {
+		MintStats         func(childComplexity int, chainID string, contract string, window *MintStatsWindow) int
+		MyAccountEvents   func(childComplexity int) int
+		OnAirdropProgress func(childComplexity int, bundleID string) int
+		OnIntentStatus    func(childComplexity int, intentID string) int
+		OnUploadProgress  func(childComplexity int, ticket string) int
+	}
+
+	SubscriptionTicket struct {
+		ExpiresAt func(childComplexity int) int
+		Ticket    func(childComplexity int) int
+	}
+
+	Suggestion struct {
+		ChainID      func(childComplexity int) int
+		Contract     func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Label        func(childComplexity int) int
+		Slug         func(childComplexity int) int
+		Sublabel     func(childComplexity int) int
+		ThumbnailURL func(childComplexity int) int
+		TokenID      func(childComplexity int) int
+		Username     func(childComplexity int) int
+	}
+
+	SystemStatus struct {
+		CheckedAt func(childComplexity int) int
+		Consumers func(childComplexity int) int
+		Queues    func(childComplexity int) int
+	}
+
+	Token struct {
+		Burned      func(childComplexity int) int
+		ChainID     func(childComplexity int) int
+		Contract    func(childComplexity int) int
+		ImageURL    func(childComplexity int) int
+		MaxSupply   func(childComplexity int) int
+		Minted      func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Owner       func(childComplexity int) int
+		Price       func(childComplexity int) int
+		RarityScore func(childComplexity int) int
+		Rentals     func(childComplexity int) int
+		Standard    func(childComplexity int) int
+		Supply      func(childComplexity int) int
+		TokenID     func(childComplexity int) int
+	}
+
+	TokenGateResult struct {
+		Balance   func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		Held      func(childComplexity int) int
+		Token     func(childComplexity int) int
+	}
+
+	TokenRental struct {
+		Amount    func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		Owner     func(childComplexity int) int
+		RecordID  func(childComplexity int) int
+		Renter    func(childComplexity int) int
+		Standard  func(childComplexity int) int
+	}
+
+	TxRequest struct {
+		Data           func(childComplexity int) int
+		PreviewAddress func(childComplexity int) int
+		To             func(childComplexity int) int
+		Value          func(childComplexity int) int
+	}
+
+	UpcomingDrop struct {
+		ChainID         func(childComplexity int) int
+		Contract        func(childComplexity int) int
+		Description     func(childComplexity int) int
+		EndsAt          func(childComplexity int) int
+		ExternalURL     func(childComplexity int) int
+		ID              func(childComplexity int) int
+		ImageURL        func(childComplexity int) int
+		IsVerified      func(childComplexity int) int
+		MintPrice       func(childComplexity int) int
+		Name            func(childComplexity int) int
+		Slug            func(childComplexity int) int
+		Source          func(childComplexity int) int
+		StartsAt        func(childComplexity int) int
+		StartsInSeconds func(childComplexity int) int
+		Status          func(childComplexity int) int
+	}
+
+	UploadProgress struct {
+		AssetID       func(childComplexity int) int
+		BytesReceived func(childComplexity int) int
+		EmittedAt     func(childComplexity int) int
+		Error         func(childComplexity int) int
+		Stage         func(childComplexity int) int
+		Ticket        func(childComplexity int) int
+		TotalBytes    func(childComplexity int) int
+	}
+
+	UploadSingleFilePayload struct {
+		Asset        func(childComplexity int) int
+		Cid          func(childComplexity int) int
+		Deduplicated func(childComplexity int) int
+		URL          func(childComplexity int) int
+	}
+
+	User struct {
+		ID            func(childComplexity int) int
+		Impersonation func(childComplexity int) int
+	}
+
+	UserProfile struct {
+		AvatarURL         func(childComplexity int) int
+		BannerURL         func(childComplexity int) int
+		Bio               func(childComplexity int) int
+		DisplayName       func(childComplexity int) int
+		NftAvatar         func(childComplexity int) int
+		Stats             func(childComplexity int) int
+		UserID            func(childComplexity int) int
+		Username          func(childComplexity int) int
+		VerifiedNftAvatar func(childComplexity int) int
+	}
+
+	UserRelationship struct {
+		CreatedAt func(childComplexity int) int
+		Kind      func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
+	ViewerPreferences struct {
+		Currency         func(childComplexity int) int
+		Format           func(childComplexity int) int
+		HonorDelegations func(childComplexity int) int
+		Locale           func(childComplexity int) int
+		Timezone         func(childComplexity int) int
+		UpdatedAt        func(childComplexity int) int
+	}
+
+	WalletActivity struct {
+		ChainID      func(childComplexity int) int
+		Contract     func(childComplexity int) int
+		Currency     func(childComplexity int) int
+		From         func(childComplexity int) int
+		ID           func(childComplexity int) int
+		IntentID     func(childComplexity int) int
+		IntentKind   func(childComplexity int) int
+		IntentStatus func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		OccurredAt   func(childComplexity int) int
+		Price        func(childComplexity int) int
+		Quantity     func(childComplexity int) int
+		To           func(childComplexity int) int
+		TokenID      func(childComplexity int) int
+		TxHash       func(childComplexity int) int
+	}
+
+	WalletActivityPage struct {
+		Items      func(childComplexity int) int
+		NextCursor func(childComplexity int) int
+	}
+
+	Watchlist struct {
+		Items         func(childComplexity int) int
+		SavedSearches func(childComplexity int) int
+	}
+
+	WatchlistItem struct {
+		AlertFiredAt    func(childComplexity int) int
+		ChainID         func(childComplexity int) int
+		CollectionName  func(childComplexity int) int
+		Contract        func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		CurrentFloor    func(childComplexity int) int
+		FloorAlertBelow func(childComplexity int) int
+		FloorAtAdd      func(childComplexity int) int
+		FloorDelta      func(childComplexity int) int
+		ID              func(childComplexity int) int
+		TargetType      func(childComplexity int) int
+		TokenID         func(childComplexity int) int
+	}
+}
+
+type executableSchema struct {
+	schema     *ast.Schema
+	resolvers  ResolverRoot
+	directives DirectiveRoot
+	complexity ComplexityRoot
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	if e.schema != nil {
+		return e.schema
+	}
+	return parsedSchema
+}
+
+func (e *executableSchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, rawArgs map[string]any) (int, bool) {
+	ec := executionContext{nil, e, 0, 0, nil}
+	_ = ec
+	switch typeName + "." + field {
+
+	case "AccountEvent.address":
+		if e.complexity.AccountEvent.Address == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.Address(childComplexity), true
+
+	case "AccountEvent.chainId":
+		if e.complexity.AccountEvent.ChainID == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.ChainID(childComplexity), true
+
+	case "AccountEvent.exportId":
+		if e.complexity.AccountEvent.ExportID == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.ExportID(childComplexity), true
+
+	case "AccountEvent.isPrimary":
+		if e.complexity.AccountEvent.IsPrimary == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.IsPrimary(childComplexity), true
+
+	case "AccountEvent.occurredAt":
+		if e.complexity.AccountEvent.OccurredAt == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.OccurredAt(childComplexity), true
+
+	case "AccountEvent.previousAddress":
+		if e.complexity.AccountEvent.PreviousAddress == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.PreviousAddress(childComplexity), true
+
+	case "AccountEvent.previousWalletId":
+		if e.complexity.AccountEvent.PreviousWalletID == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.PreviousWalletID(childComplexity), true
+
+	case "AccountEvent.sessionId":
+		if e.complexity.AccountEvent.SessionID == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.SessionID(childComplexity), true
+
+	case "AccountEvent.type":
+		if e.complexity.AccountEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.Type(childComplexity), true
+
+	case "AccountEvent.walletId":
+		if e.complexity.AccountEvent.WalletID == nil {
+			break
+		}
+
+		return e.complexity.AccountEvent.WalletID(childComplexity), true
+
+	case "AddressChallenge.expiresAt":
+		if e.complexity.AddressChallenge.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.AddressChallenge.ExpiresAt(childComplexity), true
+
+	case "AddressChallenge.id":
+		if e.complexity.AddressChallenge.ID == nil {
+			break
+		}
+
+		return e.complexity.AddressChallenge.ID(childComplexity), true
+
+	case "AddressChallenge.message":
+		if e.complexity.AddressChallenge.Message == nil {
+			break
+		}
+
+		return e.complexity.AddressChallenge.Message(childComplexity), true
+
+	case "AddressChallenge.scheme":
+		if e.complexity.AddressChallenge.Scheme == nil {
+			break
+		}
+
+		return e.complexity.AddressChallenge.Scheme(childComplexity), true
+
+	case "AirdropBatch.gasLimit":
+		if e.complexity.AirdropBatch.GasLimit == nil {
+			break
+		}
+
+		return e.complexity.AirdropBatch.GasLimit(childComplexity), true
+
+	case "AirdropBatch.intentId":
+		if e.complexity.AirdropBatch.IntentID == nil {
+			break
+		}
+
+		return e.complexity.AirdropBatch.IntentID(childComplexity), true
+
+	case "AirdropBatch.recipients":
+		if e.complexity.AirdropBatch.Recipients == nil {
+			break
+		}
+
+		return e.complexity.AirdropBatch.Recipients(childComplexity), true
+
+	case "AirdropBatch.seq":
+		if e.complexity.AirdropBatch.Seq == nil {
+			break
+		}
+
+		return e.complexity.AirdropBatch.Seq(childComplexity), true
+
+	case "AirdropBatch.txRequest":
+		if e.complexity.AirdropBatch.TxRequest == nil {
+			break
+		}
+
+		return e.complexity.AirdropBatch.TxRequest(childComplexity), true
+
+	case "AirdropBundle.batches":
+		if e.complexity.AirdropBundle.Batches == nil {
+			break
+		}
+
+		return e.complexity.AirdropBundle.Batches(childComplexity), true
+
+	case "AirdropBundle.bundleId":
+		if e.complexity.AirdropBundle.BundleID == nil {
+			break
+		}
+
+		return e.complexity.AirdropBundle.BundleID(childComplexity), true
+
+	case "AirdropBundle.chainId":
+		if e.complexity.AirdropBundle.ChainID == nil {
+			break
+		}
+
+		return e.complexity.AirdropBundle.ChainID(childComplexity), true
+
+	case "AirdropBundle.contract":
+		if e.complexity.AirdropBundle.Contract == nil {
+			break
+		}
+
+		return e.complexity.AirdropBundle.Contract(childComplexity), true
+
+	case "AirdropBundle.recipientCount":
+		if e.complexity.AirdropBundle.RecipientCount == nil {
+			break
+		}
+
+		return e.complexity.AirdropBundle.RecipientCount(childComplexity), true
+
+	case "AirdropProgress.batchCount":
+		if e.complexity.AirdropProgress.BatchCount == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.BatchCount(childComplexity), true
+
+	case "AirdropProgress.batches":
+		if e.complexity.AirdropProgress.Batches == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.Batches(childComplexity), true
+
+	case "AirdropProgress.bundleId":
+		if e.complexity.AirdropProgress.BundleID == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.BundleID(childComplexity), true
+
+	case "AirdropProgress.chainId":
+		if e.complexity.AirdropProgress.ChainID == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.ChainID(childComplexity), true
+
+	case "AirdropProgress.confirmed":
+		if e.complexity.AirdropProgress.Confirmed == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.Confirmed(childComplexity), true
+
+	case "AirdropProgress.contract":
+		if e.complexity.AirdropProgress.Contract == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.Contract(childComplexity), true
+
+	case "AirdropProgress.failed":
+		if e.complexity.AirdropProgress.Failed == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.Failed(childComplexity), true
+
+	case "AirdropProgress.recipientCount":
+		if e.complexity.AirdropProgress.RecipientCount == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.RecipientCount(childComplexity), true
+
+	case "AirdropProgress.status":
+		if e.complexity.AirdropProgress.Status == nil {
+			break
+		}
+
+		return e.complexity.AirdropProgress.Status(childComplexity), true
+
+	case "AirdropRecipient.address":
+		if e.complexity.AirdropRecipient.Address == nil {
+			break
+		}
+
+		return e.complexity.AirdropRecipient.Address(childComplexity), true
+
+	case "AirdropRecipient.amount":
+		if e.complexity.AirdropRecipient.Amount == nil {
+			break
+		}
+
+		return e.complexity.AirdropRecipient.Amount(childComplexity), true
+
+	case "ApiKey.createdAt":
+		if e.complexity.ApiKey.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.CreatedAt(childComplexity), true
+
+	case "ApiKey.id":
+		if e.complexity.ApiKey.ID == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.ID(childComplexity), true
+
+	case "ApiKey.lastUsedAt":
+		if e.complexity.ApiKey.LastUsedAt == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.LastUsedAt(childComplexity), true
+
+	case "ApiKey.name":
+		if e.complexity.ApiKey.Name == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.Name(childComplexity), true
+
+	case "ApiKey.plan":
+		if e.complexity.ApiKey.Plan == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.Plan(childComplexity), true
+
+	case "ApiKey.prefix":
+		if e.complexity.ApiKey.Prefix == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.Prefix(childComplexity), true
+
+	case "ApiKey.revokedAt":
+		if e.complexity.ApiKey.RevokedAt == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.RevokedAt(childComplexity), true
+
+	case "ApiKeyUsage.apiKey":
+		if e.complexity.ApiKeyUsage.APIKey == nil {
+			break
+		}
+
+		return e.complexity.ApiKeyUsage.APIKey(childComplexity), true
+
+	case "ApiKeyUsage.hours":
+		if e.complexity.ApiKeyUsage.Hours == nil {
+			break
+		}
+
+		return e.complexity.ApiKeyUsage.Hours(childComplexity), true
+
+	case "ApiKeyUsage.limits":
+		if e.complexity.ApiKeyUsage.Limits == nil {
+			break
+		}
+
+		return e.complexity.ApiKeyUsage.Limits(childComplexity), true
+
+	case "ApiKeyUsage.usedToday":
+		if e.complexity.ApiKeyUsage.UsedToday == nil {
+			break
+		}
+
+		return e.complexity.ApiKeyUsage.UsedToday(childComplexity), true
+
+	case "ApiPlanLimits.burstPerSecond":
+		if e.complexity.ApiPlanLimits.BurstPerSecond == nil {
+			break
+		}
+
+		return e.complexity.ApiPlanLimits.BurstPerSecond(childComplexity), true
+
+	case "ApiPlanLimits.dailyQuota":
+		if e.complexity.ApiPlanLimits.DailyQuota == nil {
+			break
+		}
+
+		return e.complexity.ApiPlanLimits.DailyQuota(childComplexity), true
+
+	case "ApiPlanLimits.enforcement":
+		if e.complexity.ApiPlanLimits.Enforcement == nil {
+			break
+		}
+
+		return e.complexity.ApiPlanLimits.Enforcement(childComplexity), true
+
+	case "ApiPlanLimits.plan":
+		if e.complexity.ApiPlanLimits.Plan == nil {
+			break
+		}
+
+		return e.complexity.ApiPlanLimits.Plan(childComplexity), true
+
+	case "ApiUsageHour.hour":
+		if e.complexity.ApiUsageHour.Hour == nil {
+			break
+		}
+
+		return e.complexity.ApiUsageHour.Hour(childComplexity), true
+
+	case "ApiUsageHour.rejected":
+		if e.complexity.ApiUsageHour.Rejected == nil {
+			break
+		}
+
+		return e.complexity.ApiUsageHour.Rejected(childComplexity), true
+
+	case "ApiUsageHour.requests":
+		if e.complexity.ApiUsageHour.Requests == nil {
+			break
+		}
+
+		return e.complexity.ApiUsageHour.Requests(childComplexity), true
+
+	case "AuthPayload.accessToken":
+		if e.complexity.AuthPayload.AccessToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.AccessToken(childComplexity), true
+
+	case "AuthPayload.expiresAt":
+		if e.complexity.AuthPayload.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.ExpiresAt(childComplexity), true
+
+	case "AuthPayload.refreshToken":
+		if e.complexity.AuthPayload.RefreshToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.RefreshToken(childComplexity), true
+
+	case "AuthPayload.userId":
+		if e.complexity.AuthPayload.UserID == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.UserID(childComplexity), true
+
+	case "BulkTransferApproval.check":
+		if e.complexity.BulkTransferApproval.Check == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferApproval.Check(childComplexity), true
+
+	case "BulkTransferApproval.contract":
+		if e.complexity.BulkTransferApproval.Contract == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferApproval.Contract(childComplexity), true
+
+	case "BulkTransferApproval.txRequest":
+		if e.complexity.BulkTransferApproval.TxRequest == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferApproval.TxRequest(childComplexity), true
+
+	case "BulkTransferBatch.contract":
+		if e.complexity.BulkTransferBatch.Contract == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBatch.Contract(childComplexity), true
+
+	case "BulkTransferBatch.intentId":
+		if e.complexity.BulkTransferBatch.IntentID == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBatch.IntentID(childComplexity), true
+
+	case "BulkTransferBatch.seq":
+		if e.complexity.BulkTransferBatch.Seq == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBatch.Seq(childComplexity), true
+
+	case "BulkTransferBatch.standard":
+		if e.complexity.BulkTransferBatch.Standard == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBatch.Standard(childComplexity), true
+
+	case "BulkTransferBatch.to":
+		if e.complexity.BulkTransferBatch.To == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBatch.To(childComplexity), true
+
+	case "BulkTransferBatch.tokens":
+		if e.complexity.BulkTransferBatch.Tokens == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBatch.Tokens(childComplexity), true
+
+	case "BulkTransferBatch.txRequest":
+		if e.complexity.BulkTransferBatch.TxRequest == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBatch.TxRequest(childComplexity), true
+
+	case "BulkTransferBundle.approvals":
+		if e.complexity.BulkTransferBundle.Approvals == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBundle.Approvals(childComplexity), true
+
+	case "BulkTransferBundle.batches":
+		if e.complexity.BulkTransferBundle.Batches == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBundle.Batches(childComplexity), true
+
+	case "BulkTransferBundle.bundleId":
+		if e.complexity.BulkTransferBundle.BundleID == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBundle.BundleID(childComplexity), true
+
+	case "BulkTransferBundle.chainId":
+		if e.complexity.BulkTransferBundle.ChainID == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBundle.ChainID(childComplexity), true
+
+	case "BulkTransferBundle.from":
+		if e.complexity.BulkTransferBundle.From == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBundle.From(childComplexity), true
+
+	case "BulkTransferBundle.signer":
+		if e.complexity.BulkTransferBundle.Signer == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBundle.Signer(childComplexity), true
+
+	case "BulkTransferBundle.tokenCount":
+		if e.complexity.BulkTransferBundle.TokenCount == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferBundle.TokenCount(childComplexity), true
+
+	case "BulkTransferProgress.batchCount":
+		if e.complexity.BulkTransferProgress.BatchCount == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferProgress.BatchCount(childComplexity), true
+
+	case "BulkTransferProgress.batches":
+		if e.complexity.BulkTransferProgress.Batches == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferProgress.Batches(childComplexity), true
+
+	case "BulkTransferProgress.bundleId":
+		if e.complexity.BulkTransferProgress.BundleID == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferProgress.BundleID(childComplexity), true
+
+	case "BulkTransferProgress.chainId":
+		if e.complexity.BulkTransferProgress.ChainID == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferProgress.ChainID(childComplexity), true
+
+	case "BulkTransferProgress.confirmed":
+		if e.complexity.BulkTransferProgress.Confirmed == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferProgress.Confirmed(childComplexity), true
+
+	case "BulkTransferProgress.failed":
+		if e.complexity.BulkTransferProgress.Failed == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferProgress.Failed(childComplexity), true
+
+	case "BulkTransferProgress.recipientCount":
+		if e.complexity.BulkTransferProgress.RecipientCount == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferProgress.RecipientCount(childComplexity), true
+
+	case "BulkTransferProgress.status":
+		if e.complexity.BulkTransferProgress.Status == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferProgress.Status(childComplexity), true
+
+	case "BulkTransferToken.amount":
+		if e.complexity.BulkTransferToken.Amount == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferToken.Amount(childComplexity), true
+
+	case "BulkTransferToken.tokenId":
+		if e.complexity.BulkTransferToken.TokenID == nil {
+			break
+		}
+
+		return e.complexity.BulkTransferToken.TokenID(childComplexity), true
+
+	case "BumpChainVersionPayload.newVersion":
+		if e.complexity.BumpChainVersionPayload.NewVersion == nil {
+			break
+		}
+
+		return e.complexity.BumpChainVersionPayload.NewVersion(childComplexity), true
+
+	case "BumpChainVersionPayload.ok":
+		if e.complexity.BumpChainVersionPayload.Ok == nil {
+			break
+		}
+
+		return e.complexity.BumpChainVersionPayload.Ok(childComplexity), true
+
+	case "CallTargetOverride.address":
+		if e.complexity.CallTargetOverride.Address == nil {
+			break
+		}
+
+		return e.complexity.CallTargetOverride.Address(childComplexity), true
+
+	case "CallTargetOverride.chainId":
+		if e.complexity.CallTargetOverride.ChainID == nil {
+			break
+		}
+
+		return e.complexity.CallTargetOverride.ChainID(childComplexity), true
+
+	case "CallTargetOverride.createdAt":
+		if e.complexity.CallTargetOverride.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CallTargetOverride.CreatedAt(childComplexity), true
+
+	case "CallTargetOverride.grantedBy":
+		if e.complexity.CallTargetOverride.GrantedBy == nil {
+			break
+		}
+
+		return e.complexity.CallTargetOverride.GrantedBy(childComplexity), true
+
+	case "CallTargetOverride.reason":
+		if e.complexity.CallTargetOverride.Reason == nil {
+			break
+		}
+
+		return e.complexity.CallTargetOverride.Reason(childComplexity), true
+
+	case "ChainCapabilities.allowlistMint":
+		if e.complexity.ChainCapabilities.AllowlistMint == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilities.AllowlistMint(childComplexity), true
+
+	case "ChainCapabilities.erc1155Factory":
+		if e.complexity.ChainCapabilities.Erc1155Factory == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilities.Erc1155Factory(childComplexity), true
+
+	case "ChainCapabilities.erc721Factory":
+		if e.complexity.ChainCapabilities.Erc721Factory == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilities.Erc721Factory(childComplexity), true
+
+	case "ChainCapabilities.lazyMint":
+		if e.complexity.ChainCapabilities.LazyMint == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilities.LazyMint(childComplexity), true
+
+	case "ChainCapabilities.updatedAt":
+		if e.complexity.ChainCapabilities.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilities.UpdatedAt(childComplexity), true
+
+	case "ChainCapabilities.version":
+		if e.complexity.ChainCapabilities.Version == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilities.Version(childComplexity), true
+
+	case "ChainCapabilitiesInfo.capabilities":
+		if e.complexity.ChainCapabilitiesInfo.Capabilities == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilitiesInfo.Capabilities(childComplexity), true
+
+	case "ChainCapabilitiesInfo.chainId":
+		if e.complexity.ChainCapabilitiesInfo.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilitiesInfo.ChainID(childComplexity), true
+
+	case "ChainCapabilitiesInfo.registryVersion":
+		if e.complexity.ChainCapabilitiesInfo.RegistryVersion == nil {
+			break
+		}
+
+		return e.complexity.ChainCapabilitiesInfo.RegistryVersion(childComplexity), true
+
+	case "ChainContracts.chainId":
+		if e.complexity.ChainContracts.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ChainContracts.ChainID(childComplexity), true
+
+	case "ChainContracts.chainNumeric":
+		if e.complexity.ChainContracts.ChainNumeric == nil {
+			break
+		}
+
+		return e.complexity.ChainContracts.ChainNumeric(childComplexity), true
+
+	case "ChainContracts.contracts":
+		if e.complexity.ChainContracts.Contracts == nil {
+			break
+		}
+
+		return e.complexity.ChainContracts.Contracts(childComplexity), true
+
+	case "ChainContracts.nativeSymbol":
+		if e.complexity.ChainContracts.NativeSymbol == nil {
+			break
+		}
+
+		return e.complexity.ChainContracts.NativeSymbol(childComplexity), true
+
+	case "ChainContracts.params":
+		if e.complexity.ChainContracts.Params == nil {
+			break
+		}
+
+		return e.complexity.ChainContracts.Params(childComplexity), true
+
+	case "ChainContracts.registryVersion":
+		if e.complexity.ChainContracts.RegistryVersion == nil {
+			break
+		}
+
+		return e.complexity.ChainContracts.RegistryVersion(childComplexity), true
+
+	case "ChainGasPolicy.chainId":
+		if e.complexity.ChainGasPolicy.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ChainGasPolicy.ChainID(childComplexity), true
+
+	case "ChainGasPolicy.policy":
+		if e.complexity.ChainGasPolicy.Policy == nil {
+			break
+		}
+
+		return e.complexity.ChainGasPolicy.Policy(childComplexity), true
+
+	case "ChainGasPolicy.registryVersion":
+		if e.complexity.ChainGasPolicy.RegistryVersion == nil {
+			break
+		}
+
+		return e.complexity.ChainGasPolicy.RegistryVersion(childComplexity), true
+
+	case "ChainHead.advancedAt":
+		if e.complexity.ChainHead.AdvancedAt == nil {
+			break
+		}
+
+		return e.complexity.ChainHead.AdvancedAt(childComplexity), true
+
+	case "ChainHead.chainId":
+		if e.complexity.ChainHead.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ChainHead.ChainID(childComplexity), true
+
+	case "ChainHead.finalizedBlock":
+		if e.complexity.ChainHead.FinalizedBlock == nil {
+			break
+		}
+
+		return e.complexity.ChainHead.FinalizedBlock(childComplexity), true
+
+	case "ChainHead.finalizedByTag":
+		if e.complexity.ChainHead.FinalizedByTag == nil {
+			break
+		}
+
+		return e.complexity.ChainHead.FinalizedByTag(childComplexity), true
+
+	case "ChainHead.latestBlock":
+		if e.complexity.ChainHead.LatestBlock == nil {
+			break
+		}
+
+		return e.complexity.ChainHead.LatestBlock(childComplexity), true
+
+	case "ChainHead.latestBlockHash":
+		if e.complexity.ChainHead.LatestBlockHash == nil {
+			break
+		}
+
+		return e.complexity.ChainHead.LatestBlockHash(childComplexity), true
+
+	case "ChainHead.latestBlockTime":
+		if e.complexity.ChainHead.LatestBlockTime == nil {
+			break
+		}
+
+		return e.complexity.ChainHead.LatestBlockTime(childComplexity), true
+
+	case "ChainHead.stale":
+		if e.complexity.ChainHead.Stale == nil {
+			break
+		}
+
+		return e.complexity.ChainHead.Stale(childComplexity), true
+
+	case "ChainIndexingStatus.advancedAt":
+		if e.complexity.ChainIndexingStatus.AdvancedAt == nil {
+			break
+		}
+
+		return e.complexity.ChainIndexingStatus.AdvancedAt(childComplexity), true
+
+	case "ChainIndexingStatus.chainId":
+		if e.complexity.ChainIndexingStatus.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ChainIndexingStatus.ChainID(childComplexity), true
+
+	case "ChainIndexingStatus.lagSeconds":
+		if e.complexity.ChainIndexingStatus.LagSeconds == nil {
+			break
+		}
+
+		return e.complexity.ChainIndexingStatus.LagSeconds(childComplexity), true
+
+	case "ChainIndexingStatus.latestBlock":
+		if e.complexity.ChainIndexingStatus.LatestBlock == nil {
+			break
+		}
+
+		return e.complexity.ChainIndexingStatus.LatestBlock(childComplexity), true
+
+	case "ChainIndexingStatus.status":
+		if e.complexity.ChainIndexingStatus.Status == nil {
+			break
+		}
+
+		return e.complexity.ChainIndexingStatus.Status(childComplexity), true
+
+	case "ChainParams.blockTimeMs":
+		if e.complexity.ChainParams.BlockTimeMs == nil {
+			break
+		}
+
+		return e.complexity.ChainParams.BlockTimeMs(childComplexity), true
+
+	case "ChainParams.reorgDepth":
+		if e.complexity.ChainParams.ReorgDepth == nil {
+			break
+		}
+
+		return e.complexity.ChainParams.ReorgDepth(childComplexity), true
+
+	case "ChainParams.requiredConfirmations":
+		if e.complexity.ChainParams.RequiredConfirmations == nil {
+			break
+		}
+
+		return e.complexity.ChainParams.RequiredConfirmations(childComplexity), true
+
+	case "ChainRpcEndpoints.chainId":
+		if e.complexity.ChainRpcEndpoints.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ChainRpcEndpoints.ChainID(childComplexity), true
+
+	case "ChainRpcEndpoints.endpoints":
+		if e.complexity.ChainRpcEndpoints.Endpoints == nil {
+			break
+		}
+
+		return e.complexity.ChainRpcEndpoints.Endpoints(childComplexity), true
+
+	case "ChainRpcEndpoints.registryVersion":
+		if e.complexity.ChainRpcEndpoints.RegistryVersion == nil {
+			break
+		}
+
+		return e.complexity.ChainRpcEndpoints.RegistryVersion(childComplexity), true
+
+	case "Collection.category":
+		if e.complexity.Collection.Category == nil {
+			break
+		}
+
+		return e.complexity.Collection.Category(childComplexity), true
+
+	case "Collection.chainId":
+		if e.complexity.Collection.ChainID == nil {
+			break
+		}
+
+		return e.complexity.Collection.ChainID(childComplexity), true
+
+	case "Collection.confirmations":
+		if e.complexity.Collection.Confirmations == nil {
+			break
+		}
+
+		return e.complexity.Collection.Confirmations(childComplexity), true
+
+	case "Collection.contentLocale":
+		if e.complexity.Collection.ContentLocale == nil {
+			break
+		}
+
+		return e.complexity.Collection.ContentLocale(childComplexity), true
+
+	case "Collection.contractAddress":
+		if e.complexity.Collection.ContractAddress == nil {
+			break
+		}
+
+		return e.complexity.Collection.ContractAddress(childComplexity), true
+
+	case "Collection.createdAt":
+		if e.complexity.Collection.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Collection.CreatedAt(childComplexity), true
+
+	case "Collection.creator":
+		if e.complexity.Collection.Creator == nil {
+			break
+		}
+
+		return e.complexity.Collection.Creator(childComplexity), true
+
+	case "Collection.description":
+		if e.complexity.Collection.Description == nil {
+			break
+		}
+
+		return e.complexity.Collection.Description(childComplexity), true
+
+	case "Collection.flagged":
+		if e.complexity.Collection.Flagged == nil {
+			break
+		}
+
+		return e.complexity.Collection.Flagged(childComplexity), true
+
+	case "Collection.id":
+		if e.complexity.Collection.ID == nil {
+			break
+		}
+
+		return e.complexity.Collection.ID(childComplexity), true
+
+	case "Collection.imageUrl":
+		if e.complexity.Collection.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.Collection.ImageURL(childComplexity), true
+
+	case "Collection.isVerified":
+		if e.complexity.Collection.IsVerified == nil {
+			break
+		}
+
+		return e.complexity.Collection.IsVerified(childComplexity), true
+
+	case "Collection.localizedContent":
+		if e.complexity.Collection.LocalizedContent == nil {
+			break
+		}
+
+		return e.complexity.Collection.LocalizedContent(childComplexity), true
+
+	case "Collection.maxSupply":
+		if e.complexity.Collection.MaxSupply == nil {
+			break
+		}
+
+		return e.complexity.Collection.MaxSupply(childComplexity), true
+
+	case "Collection.name":
+		if e.complexity.Collection.Name == nil {
+			break
+		}
+
+		return e.complexity.Collection.Name(childComplexity), true
+
+	case "Collection.owner":
+		if e.complexity.Collection.Owner == nil {
+			break
+		}
+
+		return e.complexity.Collection.Owner(childComplexity), true
+
+	case "Collection.ownerOrgId":
+		if e.complexity.Collection.OwnerOrgID == nil {
+			break
+		}
+
+		return e.complexity.Collection.OwnerOrgID(childComplexity), true
+
+	case "Collection.payoutSplits":
+		if e.complexity.Collection.PayoutSplits == nil {
+			break
+		}
+
+		return e.complexity.Collection.PayoutSplits(childComplexity), true
+
+	case "Collection.pendingFinality":
+		if e.complexity.Collection.PendingFinality == nil {
+			break
+		}
+
+		return e.complexity.Collection.PendingFinality(childComplexity), true
+
+	case "Collection.reported":
+		if e.complexity.Collection.Reported == nil {
+			break
+		}
+
+		return e.complexity.Collection.Reported(childComplexity), true
+
+	case "Collection.requiredConfirmations":
+		if e.complexity.Collection.RequiredConfirmations == nil {
+			break
+		}
+
+		return e.complexity.Collection.RequiredConfirmations(childComplexity), true
+
+	case "Collection.royaltyBps":
+		if e.complexity.Collection.RoyaltyBps == nil {
+			break
+		}
+
+		return e.complexity.Collection.RoyaltyBps(childComplexity), true
+
+	case "Collection.royaltyRecipient":
+		if e.complexity.Collection.RoyaltyRecipient == nil {
+			break
+		}
+
+		return e.complexity.Collection.RoyaltyRecipient(childComplexity), true
+
+	case "Collection.slug":
+		if e.complexity.Collection.Slug == nil {
+			break
+		}
+
+		return e.complexity.Collection.Slug(childComplexity), true
+
+	case "Collection.tagline":
+		if e.complexity.Collection.Tagline == nil {
+			break
+		}
+
+		return e.complexity.Collection.Tagline(childComplexity), true
+
+	case "Collection.tags":
+		if e.complexity.Collection.Tags == nil {
+			break
+		}
+
+		return e.complexity.Collection.Tags(childComplexity), true
+
+	case "Collection.tokenURI":
+		if e.complexity.Collection.TokenURI == nil {
+			break
+		}
+
+		return e.complexity.Collection.TokenURI(childComplexity), true
+
+	case "Collection.totalSupply":
+		if e.complexity.Collection.TotalSupply == nil {
+			break
+		}
+
+		return e.complexity.Collection.TotalSupply(childComplexity), true
+
+	case "Collection.type":
+		if e.complexity.Collection.Type == nil {
+			break
+		}
+
+		return e.complexity.Collection.Type(childComplexity), true
+
+	case "Collection.updatedAt":
+		if e.complexity.Collection.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Collection.UpdatedAt(childComplexity), true
+
+	case "CollectionConstraints.maxRoyaltyBps":
+		if e.complexity.CollectionConstraints.MaxRoyaltyBps == nil {
+			break
+		}
+
+		return e.complexity.CollectionConstraints.MaxRoyaltyBps(childComplexity), true
+
+	case "CollectionConstraints.maxSupplyCap":
+		if e.complexity.CollectionConstraints.MaxSupplyCap == nil {
+			break
+		}
+
+		return e.complexity.CollectionConstraints.MaxSupplyCap(childComplexity), true
+
+	case "CollectionConstraints.minStageDurationSec":
+		if e.complexity.CollectionConstraints.MinStageDurationSec == nil {
+			break
+		}
+
+		return e.complexity.CollectionConstraints.MinStageDurationSec(childComplexity), true
+
+	case "CollectionDefaults.allowlistStageDuration":
+		if e.complexity.CollectionDefaults.AllowlistStageDuration == nil {
+			break
+		}
+
+		return e.complexity.CollectionDefaults.AllowlistStageDuration(childComplexity), true
+
+	case "CollectionDefaults.chainId":
+		if e.complexity.CollectionDefaults.ChainID == nil {
+			break
+		}
+
+		return e.complexity.CollectionDefaults.ChainID(childComplexity), true
+
+	case "CollectionDefaults.constraints":
+		if e.complexity.CollectionDefaults.Constraints == nil {
+			break
+		}
+
+		return e.complexity.CollectionDefaults.Constraints(childComplexity), true
+
+	case "CollectionDefaults.maxSupply":
+		if e.complexity.CollectionDefaults.MaxSupply == nil {
+			break
+		}
+
+		return e.complexity.CollectionDefaults.MaxSupply(childComplexity), true
+
+	case "CollectionDefaults.mintLimitPerWallet":
+		if e.complexity.CollectionDefaults.MintLimitPerWallet == nil {
+			break
+		}
+
+		return e.complexity.CollectionDefaults.MintLimitPerWallet(childComplexity), true
+
+	case "CollectionDefaults.royaltyFee":
+		if e.complexity.CollectionDefaults.RoyaltyFee == nil {
+			break
+		}
+
+		return e.complexity.CollectionDefaults.RoyaltyFee(childComplexity), true
+
+	case "CollectionDefaults.supportedTypes":
+		if e.complexity.CollectionDefaults.SupportedTypes == nil {
+			break
+		}
+
+		return e.complexity.CollectionDefaults.SupportedTypes(childComplexity), true
+
+	case "CollectionExport.csv":
+		if e.complexity.CollectionExport.CSV == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.CSV(childComplexity), true
+
+	case "CollectionExport.chainId":
+		if e.complexity.CollectionExport.ChainID == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.ChainID(childComplexity), true
+
+	case "CollectionExport.completedAt":
+		if e.complexity.CollectionExport.CompletedAt == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.CompletedAt(childComplexity), true
+
+	case "CollectionExport.contract":
+		if e.complexity.CollectionExport.Contract == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.Contract(childComplexity), true
+
+	case "CollectionExport.createdAt":
+		if e.complexity.CollectionExport.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.CreatedAt(childComplexity), true
+
+	case "CollectionExport.error":
+		if e.complexity.CollectionExport.Error == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.Error(childComplexity), true
+
+	case "CollectionExport.from":
+		if e.complexity.CollectionExport.From == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.From(childComplexity), true
+
+	case "CollectionExport.id":
+		if e.complexity.CollectionExport.ID == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.ID(childComplexity), true
+
+	case "CollectionExport.kind":
+		if e.complexity.CollectionExport.Kind == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.Kind(childComplexity), true
+
+	case "CollectionExport.rowCount":
+		if e.complexity.CollectionExport.RowCount == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.RowCount(childComplexity), true
+
+	case "CollectionExport.status":
+		if e.complexity.CollectionExport.Status == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.Status(childComplexity), true
+
+	case "CollectionExport.to":
+		if e.complexity.CollectionExport.To == nil {
+			break
+		}
+
+		return e.complexity.CollectionExport.To(childComplexity), true
+
+	case "CollectionImportChallenge.challengeId":
+		if e.complexity.CollectionImportChallenge.ChallengeID == nil {
+			break
+		}
+
+		return e.complexity.CollectionImportChallenge.ChallengeID(childComplexity), true
+
+	case "CollectionImportChallenge.expiresAt":
+		if e.complexity.CollectionImportChallenge.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.CollectionImportChallenge.ExpiresAt(childComplexity), true
+
+	case "CollectionImportChallenge.issuedAt":
+		if e.complexity.CollectionImportChallenge.IssuedAt == nil {
+			break
+		}
+
+		return e.complexity.CollectionImportChallenge.IssuedAt(childComplexity), true
+
+	case "CollectionImportChallenge.message":
+		if e.complexity.CollectionImportChallenge.Message == nil {
+			break
+		}
+
+		return e.complexity.CollectionImportChallenge.Message(childComplexity), true
+
+	case "ConsumerStatus.consumer":
+		if e.complexity.ConsumerStatus.Consumer == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.Consumer(childComplexity), true
+
+	case "ConsumerStatus.failed":
+		if e.complexity.ConsumerStatus.Failed == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.Failed(childComplexity), true
+
+	case "ConsumerStatus.fallingBehind":
+		if e.complexity.ConsumerStatus.FallingBehind == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.FallingBehind(childComplexity), true
+
+	case "ConsumerStatus.inFlight":
+		if e.complexity.ConsumerStatus.InFlight == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.InFlight(childComplexity), true
+
+	case "ConsumerStatus.messagesPerSecond":
+		if e.complexity.ConsumerStatus.MessagesPerSecond == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.MessagesPerSecond(childComplexity), true
+
+	case "ConsumerStatus.oldestUnackedSeconds":
+		if e.complexity.ConsumerStatus.OldestUnackedSeconds == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.OldestUnackedSeconds(childComplexity), true
+
+	case "ConsumerStatus.processed":
+		if e.complexity.ConsumerStatus.Processed == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.Processed(childComplexity), true
+
+	case "ConsumerStatus.queue":
+		if e.complexity.ConsumerStatus.Queue == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.Queue(childComplexity), true
+
+	case "ConsumerStatus.reportedAt":
+		if e.complexity.ConsumerStatus.ReportedAt == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.ReportedAt(childComplexity), true
+
+	case "ConsumerStatus.stale":
+		if e.complexity.ConsumerStatus.Stale == nil {
+			break
+		}
+
+		return e.complexity.ConsumerStatus.Stale(childComplexity), true
+
+	case "Contract.abiSha256":
+		if e.complexity.Contract.AbiSha256 == nil {
+			break
+		}
+
+		return e.complexity.Contract.AbiSha256(childComplexity), true
+
+	case "Contract.abiUrl":
+		if e.complexity.Contract.AbiURL == nil {
+			break
+		}
+
+		return e.complexity.Contract.AbiURL(childComplexity), true
+
+	case "Contract.address":
+		if e.complexity.Contract.Address == nil {
+			break
+		}
+
+		return e.complexity.Contract.Address(childComplexity), true
+
+	case "Contract.implAddress":
+		if e.complexity.Contract.ImplAddress == nil {
+			break
+		}
+
+		return e.complexity.Contract.ImplAddress(childComplexity), true
+
+	case "Contract.name":
+		if e.complexity.Contract.Name == nil {
+			break
+		}
+
+		return e.complexity.Contract.Name(childComplexity), true
+
+	case "Contract.standard":
+		if e.complexity.Contract.Standard == nil {
+			break
+		}
+
+		return e.complexity.Contract.Standard(childComplexity), true
+
+	case "Contract.startBlock":
+		if e.complexity.Contract.StartBlock == nil {
+			break
+		}
+
+		return e.complexity.Contract.StartBlock(childComplexity), true
+
+	case "Contract.verifiedAt":
+		if e.complexity.Contract.VerifiedAt == nil {
+			break
+		}
+
+		return e.complexity.Contract.VerifiedAt(childComplexity), true
+
+	case "ContractMeta.chainId":
+		if e.complexity.ContractMeta.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ContractMeta.ChainID(childComplexity), true
+
+	case "ContractMeta.contract":
+		if e.complexity.ContractMeta.Contract == nil {
+			break
+		}
+
+		return e.complexity.ContractMeta.Contract(childComplexity), true
+
+	case "ContractMeta.registryVersion":
+		if e.complexity.ContractMeta.RegistryVersion == nil {
+			break
+		}
+
+		return e.complexity.ContractMeta.RegistryVersion(childComplexity), true
+
+	case "CreatedApiKey.apiKey":
+		if e.complexity.CreatedApiKey.APIKey == nil {
+			break
+		}
+
+		return e.complexity.CreatedApiKey.APIKey(childComplexity), true
+
+	case "CreatedApiKey.secret":
+		if e.complexity.CreatedApiKey.Secret == nil {
+			break
+		}
+
+		return e.complexity.CreatedApiKey.Secret(childComplexity), true
+
+	case "DropSubmission.chainId":
+		if e.complexity.DropSubmission.ChainID == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.ChainID(childComplexity), true
+
+	case "DropSubmission.contract":
+		if e.complexity.DropSubmission.Contract == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.Contract(childComplexity), true
+
+	case "DropSubmission.createdAt":
+		if e.complexity.DropSubmission.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.CreatedAt(childComplexity), true
+
+	case "DropSubmission.description":
+		if e.complexity.DropSubmission.Description == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.Description(childComplexity), true
+
+	case "DropSubmission.endsAt":
+		if e.complexity.DropSubmission.EndsAt == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.EndsAt(childComplexity), true
+
+	case "DropSubmission.externalUrl":
+		if e.complexity.DropSubmission.ExternalURL == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.ExternalURL(childComplexity), true
+
+	case "DropSubmission.id":
+		if e.complexity.DropSubmission.ID == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.ID(childComplexity), true
+
+	case "DropSubmission.imageUrl":
+		if e.complexity.DropSubmission.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.ImageURL(childComplexity), true
+
+	case "DropSubmission.mintPrice":
+		if e.complexity.DropSubmission.MintPrice == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.MintPrice(childComplexity), true
+
+	case "DropSubmission.name":
+		if e.complexity.DropSubmission.Name == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.Name(childComplexity), true
+
+	case "DropSubmission.reviewNote":
+		if e.complexity.DropSubmission.ReviewNote == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.ReviewNote(childComplexity), true
+
+	case "DropSubmission.startsAt":
+		if e.complexity.DropSubmission.StartsAt == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.StartsAt(childComplexity), true
+
+	case "DropSubmission.status":
+		if e.complexity.DropSubmission.Status == nil {
+			break
+		}
+
+		return e.complexity.DropSubmission.Status(childComplexity), true
+
+	case "Earnings.period":
+		if e.complexity.Earnings.Period == nil {
+			break
+		}
+
+		return e.complexity.Earnings.Period(childComplexity), true
+
+	case "Earnings.since":
+		if e.complexity.Earnings.Since == nil {
+			break
+		}
+
+		return e.complexity.Earnings.Since(childComplexity), true
+
+	case "Earnings.totals":
+		if e.complexity.Earnings.Totals == nil {
+			break
+		}
+
+		return e.complexity.Earnings.Totals(childComplexity), true
+
+	case "EarningsTotal.amount":
+		if e.complexity.EarningsTotal.Amount == nil {
+			break
+		}
+
+		return e.complexity.EarningsTotal.Amount(childComplexity), true
+
+	case "EarningsTotal.chainId":
+		if e.complexity.EarningsTotal.ChainID == nil {
+			break
+		}
+
+		return e.complexity.EarningsTotal.ChainID(childComplexity), true
+
+	case "EarningsTotal.collectionName":
+		if e.complexity.EarningsTotal.CollectionName == nil {
+			break
+		}
+
+		return e.complexity.EarningsTotal.CollectionName(childComplexity), true
+
+	case "EarningsTotal.contract":
+		if e.complexity.EarningsTotal.Contract == nil {
+			break
+		}
+
+		return e.complexity.EarningsTotal.Contract(childComplexity), true
+
+	case "EarningsTotal.currency":
+		if e.complexity.EarningsTotal.Currency == nil {
+			break
+		}
+
+		return e.complexity.EarningsTotal.Currency(childComplexity), true
+
+	case "EarningsTotal.sales":
+		if e.complexity.EarningsTotal.Sales == nil {
+			break
+		}
+
+		return e.complexity.EarningsTotal.Sales(childComplexity), true
+
+	case "EmailStatus.digestOptOut":
+		if e.complexity.EmailStatus.DigestOptOut == nil {
+			break
+		}
+
+		return e.complexity.EmailStatus.DigestOptOut(childComplexity), true
+
+	case "EmailStatus.email":
+		if e.complexity.EmailStatus.Email == nil {
+			break
+		}
+
+		return e.complexity.EmailStatus.Email(childComplexity), true
+
+	case "EmailStatus.optOuts":
+		if e.complexity.EmailStatus.OptOuts == nil {
+			break
+		}
+
+		return e.complexity.EmailStatus.OptOuts(childComplexity), true
+
+	case "EmailStatus.verified":
+		if e.complexity.EmailStatus.Verified == nil {
+			break
+		}
+
+		return e.complexity.EmailStatus.Verified(childComplexity), true
+
+	case "EmailStatus.verifiedAt":
+		if e.complexity.EmailStatus.VerifiedAt == nil {
+			break
+		}
+
+		return e.complexity.EmailStatus.VerifiedAt(childComplexity), true
+
+	case "EmailUnsubscribeResult.email":
+		if e.complexity.EmailUnsubscribeResult.Email == nil {
+			break
+		}
+
+		return e.complexity.EmailUnsubscribeResult.Email(childComplexity), true
+
+	case "EmailUnsubscribeResult.kind":
+		if e.complexity.EmailUnsubscribeResult.Kind == nil {
+			break
+		}
+
+		return e.complexity.EmailUnsubscribeResult.Kind(childComplexity), true
+
+	case "GasPolicy.lastObservedBaseFeeGwei":
+		if e.complexity.GasPolicy.LastObservedBaseFeeGwei == nil {
+			break
+		}
+
+		return e.complexity.GasPolicy.LastObservedBaseFeeGwei(childComplexity), true
+
+	case "GasPolicy.maxFeeGwei":
+		if e.complexity.GasPolicy.MaxFeeGwei == nil {
+			break
+		}
+
+		return e.complexity.GasPolicy.MaxFeeGwei(childComplexity), true
+
+	case "GasPolicy.multiplier":
+		if e.complexity.GasPolicy.Multiplier == nil {
+			break
+		}
+
+		return e.complexity.GasPolicy.Multiplier(childComplexity), true
+
+	case "GasPolicy.priorityFeeGwei":
+		if e.complexity.GasPolicy.PriorityFeeGwei == nil {
+			break
+		}
+
+		return e.complexity.GasPolicy.PriorityFeeGwei(childComplexity), true
+
+	case "GasPolicy.updatedAt":
+		if e.complexity.GasPolicy.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.GasPolicy.UpdatedAt(childComplexity), true
+
+	case "HolderSnapshot.blockNumber":
+		if e.complexity.HolderSnapshot.BlockNumber == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.BlockNumber(childComplexity), true
+
+	case "HolderSnapshot.csv":
+		if e.complexity.HolderSnapshot.CSV == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.CSV(childComplexity), true
+
+	case "HolderSnapshot.chainId":
+		if e.complexity.HolderSnapshot.ChainID == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.ChainID(childComplexity), true
+
+	case "HolderSnapshot.contract":
+		if e.complexity.HolderSnapshot.Contract == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.Contract(childComplexity), true
+
+	case "HolderSnapshot.createdAt":
+		if e.complexity.HolderSnapshot.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.CreatedAt(childComplexity), true
+
+	case "HolderSnapshot.holderCount":
+		if e.complexity.HolderSnapshot.HolderCount == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.HolderCount(childComplexity), true
+
+	case "HolderSnapshot.id":
+		if e.complexity.HolderSnapshot.ID == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.ID(childComplexity), true
+
+	case "HolderSnapshot.json":
+		if e.complexity.HolderSnapshot.JSON == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.JSON(childComplexity), true
+
+	case "HolderSnapshot.totalQuantity":
+		if e.complexity.HolderSnapshot.TotalQuantity == nil {
+			break
+		}
+
+		return e.complexity.HolderSnapshot.TotalQuantity(childComplexity), true
+
+	case "Impersonation.expiresAt":
+		if e.complexity.Impersonation.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.Impersonation.ExpiresAt(childComplexity), true
+
+	case "Impersonation.impersonatorId":
+		if e.complexity.Impersonation.ImpersonatorID == nil {
+			break
+		}
+
+		return e.complexity.Impersonation.ImpersonatorID(childComplexity), true
+
+	case "Impersonation.reason":
+		if e.complexity.Impersonation.Reason == nil {
+			break
+		}
+
+		return e.complexity.Impersonation.Reason(childComplexity), true
+
+	case "ImpersonationPayload.accessToken":
+		if e.complexity.ImpersonationPayload.AccessToken == nil {
+			break
+		}
+
+		return e.complexity.ImpersonationPayload.AccessToken(childComplexity), true
+
+	case "ImpersonationPayload.expiresAt":
+		if e.complexity.ImpersonationPayload.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.ImpersonationPayload.ExpiresAt(childComplexity), true
+
+	case "ImpersonationPayload.impersonatorId":
+		if e.complexity.ImpersonationPayload.ImpersonatorID == nil {
+			break
+		}
+
+		return e.complexity.ImpersonationPayload.ImpersonatorID(childComplexity), true
+
+	case "ImpersonationPayload.userId":
+		if e.complexity.ImpersonationPayload.UserID == nil {
+			break
+		}
+
+		return e.complexity.ImpersonationPayload.UserID(childComplexity), true
+
+	case "ImportedCollection.address":
+		if e.complexity.ImportedCollection.Address == nil {
+			break
+		}
+
+		return e.complexity.ImportedCollection.Address(childComplexity), true
+
+	case "ImportedCollection.chainId":
+		if e.complexity.ImportedCollection.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ImportedCollection.ChainID(childComplexity), true
+
+	case "ImportedCollection.name":
+		if e.complexity.ImportedCollection.Name == nil {
+			break
+		}
+
+		return e.complexity.ImportedCollection.Name(childComplexity), true
+
+	case "ImportedCollection.owner":
+		if e.complexity.ImportedCollection.Owner == nil {
+			break
+		}
+
+		return e.complexity.ImportedCollection.Owner(childComplexity), true
+
+	case "ImportedCollection.standard":
+		if e.complexity.ImportedCollection.Standard == nil {
+			break
+		}
+
+		return e.complexity.ImportedCollection.Standard(childComplexity), true
+
+	case "ImportedCollection.startBlock":
+		if e.complexity.ImportedCollection.StartBlock == nil {
+			break
+		}
+
+		return e.complexity.ImportedCollection.StartBlock(childComplexity), true
+
+	case "ImportedCollection.symbol":
+		if e.complexity.ImportedCollection.Symbol == nil {
+			break
+		}
+
+		return e.complexity.ImportedCollection.Symbol(childComplexity), true
+
+	case "IntentStatusPayload.chainId":
+		if e.complexity.IntentStatusPayload.ChainID == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.ChainID(childComplexity), true
+
+	case "IntentStatusPayload.contractAddress":
+		if e.complexity.IntentStatusPayload.ContractAddress == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.ContractAddress(childComplexity), true
+
+	case "IntentStatusPayload.error":
+		if e.complexity.IntentStatusPayload.Error == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.Error(childComplexity), true
+
+	case "IntentStatusPayload.intentId":
+		if e.complexity.IntentStatusPayload.IntentID == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.IntentID(childComplexity), true
+
+	case "IntentStatusPayload.kind":
+		if e.complexity.IntentStatusPayload.Kind == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.Kind(childComplexity), true
+
+	case "IntentStatusPayload.recovery":
+		if e.complexity.IntentStatusPayload.Recovery == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.Recovery(childComplexity), true
+
+	case "IntentStatusPayload.stalenessMs":
+		if e.complexity.IntentStatusPayload.StalenessMs == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.StalenessMs(childComplexity), true
+
+	case "IntentStatusPayload.status":
+		if e.complexity.IntentStatusPayload.Status == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.Status(childComplexity), true
+
+	case "IntentStatusPayload.txHash":
+		if e.complexity.IntentStatusPayload.TxHash == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.TxHash(childComplexity), true
+
+	case "IntentStatusPayload.updatedAt":
+		if e.complexity.IntentStatusPayload.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.IntentStatusPayload.UpdatedAt(childComplexity), true
+
+	case "LinkedWallet.address":
+		if e.complexity.LinkedWallet.Address == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.Address(childComplexity), true
+
+	case "LinkedWallet.chainId":
+		if e.complexity.LinkedWallet.ChainID == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.ChainID(childComplexity), true
+
+	case "LinkedWallet.createdAt":
+		if e.complexity.LinkedWallet.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.CreatedAt(childComplexity), true
+
+	case "LinkedWallet.id":
+		if e.complexity.LinkedWallet.ID == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.ID(childComplexity), true
+
+	case "LinkedWallet.isPrimary":
+		if e.complexity.LinkedWallet.IsPrimary == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.IsPrimary(childComplexity), true
+
+	case "LinkedWallet.isWatchOnly":
+		if e.complexity.LinkedWallet.IsWatchOnly == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.IsWatchOnly(childComplexity), true
+
+	case "LinkedWallet.label":
+		if e.complexity.LinkedWallet.Label == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.Label(childComplexity), true
+
+	case "LinkedWallet.lastSeenAt":
+		if e.complexity.LinkedWallet.LastSeenAt == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.LastSeenAt(childComplexity), true
+
+	case "LinkedWallet.tags":
+		if e.complexity.LinkedWallet.Tags == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.Tags(childComplexity), true
+
+	case "LinkedWallet.verifiedAt":
+		if e.complexity.LinkedWallet.VerifiedAt == nil {
+			break
+		}
+
+		return e.complexity.LinkedWallet.VerifiedAt(childComplexity), true
+
+	case "LocalizedContent.description":
+		if e.complexity.LocalizedContent.Description == nil {
+			break
+		}
+
+		return e.complexity.LocalizedContent.Description(childComplexity), true
+
+	case "LocalizedContent.locale":
+		if e.complexity.LocalizedContent.Locale == nil {
+			break
+		}
+
+		return e.complexity.LocalizedContent.Locale(childComplexity), true
+
+	case "LocalizedContent.tagline":
+		if e.complexity.LocalizedContent.Tagline == nil {
+			break
+		}
+
+		return e.complexity.LocalizedContent.Tagline(childComplexity), true
+
+	case "LocalizedContent.updatedAt":
+		if e.complexity.LocalizedContent.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.LocalizedContent.UpdatedAt(childComplexity), true
+
+	case "MediaAsset.bytes":
+		if e.complexity.MediaAsset.Bytes == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.Bytes(childComplexity), true
+
+	case "MediaAsset.createdAt":
+		if e.complexity.MediaAsset.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.CreatedAt(childComplexity), true
+
+	case "MediaAsset.height":
+		if e.complexity.MediaAsset.Height == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.Height(childComplexity), true
+
+	case "MediaAsset.id":
+		if e.complexity.MediaAsset.ID == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.ID(childComplexity), true
+
+	case "MediaAsset.ipfsCid":
+		if e.complexity.MediaAsset.IpfsCid == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.IpfsCid(childComplexity), true
+
+	case "MediaAsset.kind":
+		if e.complexity.MediaAsset.Kind == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.Kind(childComplexity), true
+
+	case "MediaAsset.mime":
+		if e.complexity.MediaAsset.Mime == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.Mime(childComplexity), true
+
+	case "MediaAsset.pinStatus":
+		if e.complexity.MediaAsset.PinStatus == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.PinStatus(childComplexity), true
+
+	case "MediaAsset.refCount":
+		if e.complexity.MediaAsset.RefCount == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.RefCount(childComplexity), true
+
+	case "MediaAsset.sha256":
+		if e.complexity.MediaAsset.Sha256 == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.Sha256(childComplexity), true
+
+	case "MediaAsset.url":
+		if e.complexity.MediaAsset.URL == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.URL(childComplexity), true
+
+	case "MediaAsset.variants":
+		if e.complexity.MediaAsset.Variants == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.Variants(childComplexity), true
+
+	case "MediaAsset.width":
+		if e.complexity.MediaAsset.Width == nil {
+			break
+		}
+
+		return e.complexity.MediaAsset.Width(childComplexity), true
+
+	case "MediaKindUsage.assets":
+		if e.complexity.MediaKindUsage.Assets == nil {
+			break
+		}
+
+		return e.complexity.MediaKindUsage.Assets(childComplexity), true
+
+	case "MediaKindUsage.bytes":
+		if e.complexity.MediaKindUsage.Bytes == nil {
+			break
+		}
+
+		return e.complexity.MediaKindUsage.Bytes(childComplexity), true
+
+	case "MediaKindUsage.hardLimit":
+		if e.complexity.MediaKindUsage.HardLimit == nil {
+			break
+		}
+
+		return e.complexity.MediaKindUsage.HardLimit(childComplexity), true
+
+	case "MediaKindUsage.kind":
+		if e.complexity.MediaKindUsage.Kind == nil {
+			break
+		}
+
+		return e.complexity.MediaKindUsage.Kind(childComplexity), true
+
+	case "MediaPinStatusEvent.assetId":
+		if e.complexity.MediaPinStatusEvent.AssetID == nil {
+			break
+		}
+
+		return e.complexity.MediaPinStatusEvent.AssetID(childComplexity), true
+
+	case "MediaPinStatusEvent.cid":
+		if e.complexity.MediaPinStatusEvent.Cid == nil {
+			break
+		}
+
+		return e.complexity.MediaPinStatusEvent.Cid(childComplexity), true
+
+	case "MediaPinStatusEvent.gateway":
+		if e.complexity.MediaPinStatusEvent.Gateway == nil {
+			break
+		}
+
+		return e.complexity.MediaPinStatusEvent.Gateway(childComplexity), true
+
+	case "MediaPinStatusEvent.status":
+		if e.complexity.MediaPinStatusEvent.Status == nil {
+			break
+		}
+
+		return e.complexity.MediaPinStatusEvent.Status(childComplexity), true
+
+	case "MediaPinStatusEvent.updatedAt":
+		if e.complexity.MediaPinStatusEvent.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.MediaPinStatusEvent.UpdatedAt(childComplexity), true
+
+	case "MediaUrls.cdn":
+		if e.complexity.MediaUrls.Cdn == nil {
+			break
+		}
+
+		return e.complexity.MediaUrls.Cdn(childComplexity), true
+
+	case "MediaUrls.gateway":
+		if e.complexity.MediaUrls.Gateway == nil {
+			break
+		}
+
+		return e.complexity.MediaUrls.Gateway(childComplexity), true
+
+	case "MediaVariant.cdnUrl":
+		if e.complexity.MediaVariant.CdnURL == nil {
+			break
+		}
+
+		return e.complexity.MediaVariant.CdnURL(childComplexity), true
+
+	case "MediaVariant.format":
+		if e.complexity.MediaVariant.Format == nil {
+			break
+		}
+
+		return e.complexity.MediaVariant.Format(childComplexity), true
+
+	case "MediaVariant.height":
+		if e.complexity.MediaVariant.Height == nil {
+			break
+		}
+
+		return e.complexity.MediaVariant.Height(childComplexity), true
+
+	case "MediaVariant.id":
+		if e.complexity.MediaVariant.ID == nil {
+			break
+		}
+
+		return e.complexity.MediaVariant.ID(childComplexity), true
+
+	case "MediaVariant.width":
+		if e.complexity.MediaVariant.Width == nil {
+			break
+		}
+
+		return e.complexity.MediaVariant.Width(childComplexity), true
+
+	case "MintStats.buckets":
+		if e.complexity.MintStats.Buckets == nil {
+			break
+		}
+
+		return e.complexity.MintStats.Buckets(childComplexity), true
+
+	case "MintStats.chainId":
+		if e.complexity.MintStats.ChainID == nil {
+			break
+		}
+
+		return e.complexity.MintStats.ChainID(childComplexity), true
+
+	case "MintStats.contract":
+		if e.complexity.MintStats.Contract == nil {
+			break
+		}
+
+		return e.complexity.MintStats.Contract(childComplexity), true
+
+	case "MintStats.mints":
+		if e.complexity.MintStats.Mints == nil {
+			break
+		}
+
+		return e.complexity.MintStats.Mints(childComplexity), true
+
+	case "MintStats.revenue":
+		if e.complexity.MintStats.Revenue == nil {
+			break
+		}
+
+		return e.complexity.MintStats.Revenue(childComplexity), true
+
+	case "MintStats.since":
+		if e.complexity.MintStats.Since == nil {
+			break
+		}
+
+		return e.complexity.MintStats.Since(childComplexity), true
+
+	case "MintStats.uniqueMinters":
+		if e.complexity.MintStats.UniqueMinters == nil {
+			break
+		}
+
+		return e.complexity.MintStats.UniqueMinters(childComplexity), true
+
+	case "MintStats.window":
+		if e.complexity.MintStats.Window == nil {
+			break
+		}
+
+		return e.complexity.MintStats.Window(childComplexity), true
+
+	case "MintStatsBucket.mints":
+		if e.complexity.MintStatsBucket.Mints == nil {
+			break
+		}
+
+		return e.complexity.MintStatsBucket.Mints(childComplexity), true
+
+	case "MintStatsBucket.minute":
+		if e.complexity.MintStatsBucket.Minute == nil {
+			break
+		}
+
+		return e.complexity.MintStatsBucket.Minute(childComplexity), true
+
+	case "MintStatsBucket.revenue":
+		if e.complexity.MintStatsBucket.Revenue == nil {
+			break
+		}
+
+		return e.complexity.MintStatsBucket.Revenue(childComplexity), true
+
+	case "MintStatsBucket.uniqueMinters":
+		if e.complexity.MintStatsBucket.UniqueMinters == nil {
+			break
+		}
+
+		return e.complexity.MintStatsBucket.UniqueMinters(childComplexity), true
+
+	case "ModerationFlag.chainId":
+		if e.complexity.ModerationFlag.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.ChainID(childComplexity), true
+
+	case "ModerationFlag.contract":
+		if e.complexity.ModerationFlag.Contract == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.Contract(childComplexity), true
+
+	case "ModerationFlag.id":
+		if e.complexity.ModerationFlag.ID == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.ID(childComplexity), true
+
+	case "ModerationFlag.note":
+		if e.complexity.ModerationFlag.Note == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.Note(childComplexity), true
+
+	case "ModerationFlag.reason":
+		if e.complexity.ModerationFlag.Reason == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.Reason(childComplexity), true
+
+	case "ModerationFlag.source":
+		if e.complexity.ModerationFlag.Source == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.Source(childComplexity), true
+
+	case "ModerationFlag.status":
+		if e.complexity.ModerationFlag.Status == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.Status(childComplexity), true
+
+	case "ModerationFlag.tokenId":
+		if e.complexity.ModerationFlag.TokenID == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.TokenID(childComplexity), true
+
+	case "ModerationFlag.updatedAt":
+		if e.complexity.ModerationFlag.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ModerationFlag.UpdatedAt(childComplexity), true
+
+	case "Mutation.acceptOrganizationInvitation":
+		if e.complexity.Mutation.AcceptOrganizationInvitation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_acceptOrganizationInvitation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AcceptOrganizationInvitation(childComplexity, args["token"].(string)), true
+
+	case "Mutation.addWatchOnlyWallet":
+		if e.complexity.Mutation.AddWatchOnlyWallet == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addWatchOnlyWallet_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddWatchOnlyWallet(childComplexity, args["address"].(string), args["chainId"].(string), args["label"].(*string), args["tags"].([]string)), true
+
+	case "Mutation.allowCallTarget":
+		if e.complexity.Mutation.AllowCallTarget == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_allowCallTarget_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AllowCallTarget(childComplexity, args["chainId"].(string), args["address"].(string), args["reason"].(string)), true
+
+	case "Mutation.assignCollectionToOrganization":
+		if e.complexity.Mutation.AssignCollectionToOrganization == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_assignCollectionToOrganization_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AssignCollectionToOrganization(childComplexity, args["chainId"].(string), args["contract"].(string), args["orgId"].(*string)), true
+
+	case "Mutation.blockUser":
+		if e.complexity.Mutation.BlockUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_blockUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.BlockUser(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.bumpChainVersion":
+		if e.complexity.Mutation.BumpChainVersion == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_bumpChainVersion_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.BumpChainVersion(childComplexity, args["input"].(BumpChainVersionInput)), true
+
+	case "Mutation.clearNftAvatar":
+		if e.complexity.Mutation.ClearNftAvatar == nil {
+			break
+		}
+
+		return e.complexity.Mutation.ClearNftAvatar(childComplexity), true
+
+	case "Mutation.confirmEmail":
+		if e.complexity.Mutation.ConfirmEmail == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_confirmEmail_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConfirmEmail(childComplexity, args["code"].(string)), true
+
+	case "Mutation.createApiKey":
+		if e.complexity.Mutation.CreateAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createApiKey_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAPIKey(childComplexity, args["name"].(string)), true
+
+	case "Mutation.createAddressChallenge":
+		if e.complexity.Mutation.CreateAddressChallenge == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAddressChallenge_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAddressChallenge(childComplexity, args["purpose"].(AddressChallengePurpose), args["address"].(string), args["chainId"].(string), args["scheme"].(*SignatureScheme)), true
+
+	case "Mutation.createHolderSnapshot":
+		if e.complexity.Mutation.CreateHolderSnapshot == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createHolderSnapshot_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateHolderSnapshot(childComplexity, args["chainId"].(string), args["contract"].(string), args["blockNumber"].(*string)), true
+
+	case "Mutation.createOrganization":
+		if e.complexity.Mutation.CreateOrganization == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createOrganization_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateOrganization(childComplexity, args["name"].(string)), true
+
+	case "Mutation.createSubscriptionTicket":
+		if e.complexity.Mutation.CreateSubscriptionTicket == nil {
+			break
+		}
+
+		return e.complexity.Mutation.CreateSubscriptionTicket(childComplexity), true
+
+	case "Mutation.deleteSavedSearch":
+		if e.complexity.Mutation.DeleteSavedSearch == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteSavedSearch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteSavedSearch(childComplexity, args["id"].(string)), true
+
+	case "Mutation.endImpersonation":
+		if e.complexity.Mutation.EndImpersonation == nil {
+			break
+		}
+
+		return e.complexity.Mutation.EndImpersonation(childComplexity), true
+
+	case "Mutation.exportMints":
+		if e.complexity.Mutation.ExportMints == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exportMints_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExportMints(childComplexity, args["chainId"].(string), args["contract"].(string), args["from"].(string), args["to"].(string)), true
+
+	case "Mutation.exportSales":
+		if e.complexity.Mutation.ExportSales == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exportSales_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExportSales(childComplexity, args["chainId"].(string), args["contract"].(string), args["from"].(string), args["to"].(string)), true
+
+	case "Mutation.favoriteCollection":
+		if e.complexity.Mutation.FavoriteCollection == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_favoriteCollection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.FavoriteCollection(childComplexity, args["chainId"].(string), args["contract"].(string), args["floorAlertBelow"].(*string)), true
+
+	case "Mutation.favoriteToken":
+		if e.complexity.Mutation.FavoriteToken == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_favoriteToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.FavoriteToken(childComplexity, args["chainId"].(string), args["contract"].(string), args["tokenId"].(string), args["floorAlertBelow"].(*string)), true
+
+	case "Mutation.flagItem":
+		if e.complexity.Mutation.FlagItem == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_flagItem_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.FlagItem(childComplexity, args["input"].(FlagItemInput)), true
+
+	case "Mutation.followUser":
+		if e.complexity.Mutation.FollowUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_followUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.FollowUser(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.freezeSponsorshipBudget":
+		if e.complexity.Mutation.FreezeSponsorshipBudget == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_freezeSponsorshipBudget_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.FreezeSponsorshipBudget(childComplexity, args["chainId"].(string), args["reason"].(string)), true
+
+	case "Mutation.importCollection":
+		if e.complexity.Mutation.ImportCollection == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_importCollection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ImportCollection(childComplexity, args["chainId"].(string), args["address"].(string), args["issuedAt"].(*string), args["signature"].(string), args["challengeId"].(*string)), true
+
+	case "Mutation.inviteOrganizationMember":
+		if e.complexity.Mutation.InviteOrganizationMember == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_inviteOrganizationMember_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.InviteOrganizationMember(childComplexity, args["orgId"].(string), args["email"].(string), args["role"].(*OrganizationRole)), true
+
+	case "Mutation.logout":
+		if e.complexity.Mutation.Logout == nil {
+			break
+		}
+
+		return e.complexity.Mutation.Logout(childComplexity), true
+
+	case "Mutation.muteUser":
+		if e.complexity.Mutation.MuteUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_muteUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MuteUser(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.prepareAirdrop":
+		if e.complexity.Mutation.PrepareAirdrop == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_prepareAirdrop_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PrepareAirdrop(childComplexity, args["input"].(PrepareAirdropInput)), true
+
+	case "Mutation.prepareBulkTransfer":
+		if e.complexity.Mutation.PrepareBulkTransfer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_prepareBulkTransfer_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PrepareBulkTransfer(childComplexity, args["input"].(PrepareBulkTransferInput)), true
+
+	case "Mutation.prepareCollectionImport":
+		if e.complexity.Mutation.PrepareCollectionImport == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_prepareCollectionImport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PrepareCollectionImport(childComplexity, args["chainId"].(string), args["address"].(string)), true
+
+	case "Mutation.prepareCreateCollection":
+		if e.complexity.Mutation.PrepareCreateCollection == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_prepareCreateCollection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PrepareCreateCollection(childComplexity, args["input"].(PrepareCreateCollectionInput)), true
+
+	case "Mutation.prepareMint":
+		if e.complexity.Mutation.PrepareMint == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_prepareMint_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PrepareMint(childComplexity, args["input"].(PrepareMintInput)), true
+
+	case "Mutation.prepareSetPayoutSplits":
+		if e.complexity.Mutation.PrepareSetPayoutSplits == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_prepareSetPayoutSplits_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PrepareSetPayoutSplits(childComplexity, args["chainId"].(string), args["contract"].(string), args["splits"].([]*PayoutSplitInput)), true
+
+	case "Mutation.refreshSession":
+		if e.complexity.Mutation.RefreshSession == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RefreshSession(childComplexity), true
+
+	case "Mutation.releaseMediaAsset":
+		if e.complexity.Mutation.ReleaseMediaAsset == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_releaseMediaAsset_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReleaseMediaAsset(childComplexity, args["id"].(string)), true
+
+	case "Mutation.removeOrganizationMember":
+		if e.complexity.Mutation.RemoveOrganizationMember == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeOrganizationMember_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveOrganizationMember(childComplexity, args["orgId"].(string), args["userId"].(string)), true
+
+	case "Mutation.reportContent":
+		if e.complexity.Mutation.ReportContent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_reportContent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReportContent(childComplexity, args["targetType"].(ReportTargetType), args["targetId"].(string), args["reason"].(ModerationReason), args["details"].(*string)), true
+
+	case "Mutation.resolveReports":
+		if e.complexity.Mutation.ResolveReports == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resolveReports_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResolveReports(childComplexity, args["targetType"].(ReportTargetType), args["targetId"].(string), args["action"].(ReportAction), args["note"].(*string)), true
+
+	case "Mutation.resyncCollection":
+		if e.complexity.Mutation.ResyncCollection == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resyncCollection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResyncCollection(childComplexity, args["input"].(ResyncCollectionInput)), true
+
+	case "Mutation.reviewDropSubmission":
+		if e.complexity.Mutation.ReviewDropSubmission == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_reviewDropSubmission_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReviewDropSubmission(childComplexity, args["id"].(string), args["action"].(DropReviewAction), args["note"].(*string)), true
+
+	case "Mutation.revokeApiKey":
+		if e.complexity.Mutation.RevokeAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeApiKey_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAPIKey(childComplexity, args["id"].(string)), true
+
+	case "Mutation.revokeCallTarget":
+		if e.complexity.Mutation.RevokeCallTarget == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeCallTarget_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeCallTarget(childComplexity, args["chainId"].(string), args["address"].(string)), true
+
+	case "Mutation.saveSearch":
+		if e.complexity.Mutation.SaveSearch == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_saveSearch_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SaveSearch(childComplexity, args["query"].(string), args["filters"].([]*SearchFilterInput), args["name"].(*string)), true
+
+	case "Mutation.setApiKeyPlan":
+		if e.complexity.Mutation.SetAPIKeyPlan == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setApiKeyPlan_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetAPIKeyPlan(childComplexity, args["id"].(string), args["plan"].(APIPlan)), true
+
+	case "Mutation.setAvatarFromNFT":
+		if e.complexity.Mutation.SetAvatarFromNft == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setAvatarFromNFT_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetAvatarFromNft(childComplexity, args["chainId"].(string), args["contract"].(string), args["tokenId"].(string)), true
+
+	case "Mutation.setCollectionClassification":
+		if e.complexity.Mutation.SetCollectionClassification == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setCollectionClassification_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetCollectionClassification(childComplexity, args["chainId"].(string), args["contract"].(string), args["category"].(*CollectionCategory), args["tags"].([]string)), true
+
+	case "Mutation.setCollectionContent":
+		if e.complexity.Mutation.SetCollectionContent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setCollectionContent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetCollectionContent(childComplexity, args["chainId"].(string), args["contract"].(string), args["locale"].(string), args["description"].(*string), args["tagline"].(*string)), true
+
+	case "Mutation.setEmailDigestOptOut":
+		if e.complexity.Mutation.SetEmailDigestOptOut == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setEmailDigestOptOut_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetEmailDigestOptOut(childComplexity, args["optOut"].(bool)), true
+
+	case "Mutation.setEmailOptOut":
+		if e.complexity.Mutation.SetEmailOptOut == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setEmailOptOut_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetEmailOptOut(childComplexity, args["kind"].(EmailKind), args["optOut"].(bool)), true
+
+	case "Mutation.setOrganizationMemberRole":
+		if e.complexity.Mutation.SetOrganizationMemberRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setOrganizationMemberRole_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetOrganizationMemberRole(childComplexity, args["orgId"].(string), args["userId"].(string), args["role"].(OrganizationRole)), true
+
+	case "Mutation.setProfileVisibility":
+		if e.complexity.Mutation.SetProfileVisibility == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setProfileVisibility_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetProfileVisibility(childComplexity, args["visibility"].(ProfileVisibility)), true
+
+	case "Mutation.signInSiwe":
+		if e.complexity.Mutation.SignInSiwe == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_signInSiwe_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SignInSiwe(childComplexity, args["input"].(SignInSiweInput)), true
+
+	case "Mutation.startEmailVerification":
+		if e.complexity.Mutation.StartEmailVerification == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_startEmailVerification_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.StartEmailVerification(childComplexity, args["email"].(string)), true
+
+	case "Mutation.startImpersonation":
+		if e.complexity.Mutation.StartImpersonation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_startImpersonation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.StartImpersonation(childComplexity, args["userId"].(string), args["reason"].(string)), true
+
+	case "Mutation.submitDrop":
+		if e.complexity.Mutation.SubmitDrop == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_submitDrop_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SubmitDrop(childComplexity, args["input"].(SubmitDropInput)), true
+
+	case "Mutation.topUpSponsorshipBudget":
+		if e.complexity.Mutation.TopUpSponsorshipBudget == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_topUpSponsorshipBudget_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TopUpSponsorshipBudget(childComplexity, args["chainId"].(string), args["amountGwei"].(string), args["note"].(*string)), true
+
+	case "Mutation.trackTx":
+		if e.complexity.Mutation.TrackTx == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_trackTx_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TrackTx(childComplexity, args["input"].(TrackTxInput)), true
+
+	case "Mutation.unblockUser":
+		if e.complexity.Mutation.UnblockUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unblockUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnblockUser(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.unfavorite":
+		if e.complexity.Mutation.Unfavorite == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unfavorite_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Unfavorite(childComplexity, args["id"].(string)), true
+
+	case "Mutation.unflagItem":
+		if e.complexity.Mutation.UnflagItem == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unflagItem_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnflagItem(childComplexity, args["input"].(UnflagItemInput)), true
+
+	case "Mutation.unfollowUser":
+		if e.complexity.Mutation.UnfollowUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unfollowUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnfollowUser(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.unfreezeSponsorshipBudget":
+		if e.complexity.Mutation.UnfreezeSponsorshipBudget == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unfreezeSponsorshipBudget_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnfreezeSponsorshipBudget(childComplexity, args["chainId"].(string)), true
+
+	case "Mutation.unmuteUser":
+		if e.complexity.Mutation.UnmuteUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unmuteUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnmuteUser(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.unsubscribeEmail":
+		if e.complexity.Mutation.UnsubscribeEmail == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unsubscribeEmail_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnsubscribeEmail(childComplexity, args["token"].(string)), true
+
+	case "Mutation.updateProfile":
+		if e.complexity.Mutation.UpdateProfile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateProfile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateProfile(childComplexity, args["displayName"].(*string)), true
+
+	case "Mutation.updateViewerPreferences":
+		if e.complexity.Mutation.UpdateViewerPreferences == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateViewerPreferences_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateViewerPreferences(childComplexity, args["locale"].(*string), args["timezone"].(*string), args["currency"].(*string), args["honorDelegations"].(*bool)), true
+
+	case "Mutation.updateWallet":
+		if e.complexity.Mutation.UpdateWallet == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateWallet_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateWallet(childComplexity, args["input"].(UpdateWalletInput)), true
+
+	case "Mutation.uploadSingleFile":
+		if e.complexity.Mutation.UploadSingleFile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadSingleFile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadSingleFile(childComplexity, args["input"].(UploadSingleFileInput)), true
+
+	case "Mutation.verifySiwe":
+		if e.complexity.Mutation.VerifySiwe == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_verifySiwe_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VerifySiwe(childComplexity, args["input"].(VerifySiweInput)), true
+
+	case "Mutation.verifyTokenGate":
+		if e.complexity.Mutation.VerifyTokenGate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_verifyTokenGate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VerifyTokenGate(childComplexity, args["chainId"].(string), args["contract"].(string), args["minBalance"].(*string), args["proof"].(*AddressProofInput)), true
+
+	case "NftAvatar.chainId":
+		if e.complexity.NftAvatar.ChainID == nil {
+			break
+		}
+
+		return e.complexity.NftAvatar.ChainID(childComplexity), true
+
+	case "NftAvatar.checkedAt":
+		if e.complexity.NftAvatar.CheckedAt == nil {
+			break
+		}
+
+		return e.complexity.NftAvatar.CheckedAt(childComplexity), true
+
+	case "NftAvatar.contract":
+		if e.complexity.NftAvatar.Contract == nil {
+			break
+		}
+
+		return e.complexity.NftAvatar.Contract(childComplexity), true
+
+	case "NftAvatar.tokenId":
+		if e.complexity.NftAvatar.TokenID == nil {
+			break
+		}
+
+		return e.complexity.NftAvatar.TokenID(childComplexity), true
+
+	case "NftAvatar.verified":
+		if e.complexity.NftAvatar.Verified == nil {
+			break
+		}
+
+		return e.complexity.NftAvatar.Verified(childComplexity), true
+
+	case "NftAvatar.verifiedAt":
+		if e.complexity.NftAvatar.VerifiedAt == nil {
+			break
+		}
+
+		return e.complexity.NftAvatar.VerifiedAt(childComplexity), true
+
+	case "NoncePayload.nonce":
+		if e.complexity.NoncePayload.Nonce == nil {
+			break
+		}
+
+		return e.complexity.NoncePayload.Nonce(childComplexity), true
+
+	case "NumberFormat.currencySymbol":
+		if e.complexity.NumberFormat.CurrencySymbol == nil {
+			break
+		}
+
+		return e.complexity.NumberFormat.CurrencySymbol(childComplexity), true
+
+	case "NumberFormat.decimalSeparator":
+		if e.complexity.NumberFormat.DecimalSeparator == nil {
+			break
+		}
+
+		return e.complexity.NumberFormat.DecimalSeparator(childComplexity), true
+
+	case "NumberFormat.fractionDigits":
+		if e.complexity.NumberFormat.FractionDigits == nil {
+			break
+		}
+
+		return e.complexity.NumberFormat.FractionDigits(childComplexity), true
+
+	case "NumberFormat.groupSeparator":
+		if e.complexity.NumberFormat.GroupSeparator == nil {
+			break
+		}
+
+		return e.complexity.NumberFormat.GroupSeparator(childComplexity), true
+
+	case "Organization.createdAt":
+		if e.complexity.Organization.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Organization.CreatedAt(childComplexity), true
+
+	case "Organization.createdBy":
+		if e.complexity.Organization.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.Organization.CreatedBy(childComplexity), true
+
+	case "Organization.id":
+		if e.complexity.Organization.ID == nil {
+			break
+		}
+
+		return e.complexity.Organization.ID(childComplexity), true
+
+	case "Organization.name":
+		if e.complexity.Organization.Name == nil {
+			break
+		}
+
+		return e.complexity.Organization.Name(childComplexity), true
+
+	case "OrganizationDetails.members":
+		if e.complexity.OrganizationDetails.Members == nil {
+			break
+		}
+
+		return e.complexity.OrganizationDetails.Members(childComplexity), true
+
+	case "OrganizationDetails.organization":
+		if e.complexity.OrganizationDetails.Organization == nil {
+			break
+		}
+
+		return e.complexity.OrganizationDetails.Organization(childComplexity), true
+
+	case "OrganizationInvitation.expiresAt":
+		if e.complexity.OrganizationInvitation.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.OrganizationInvitation.ExpiresAt(childComplexity), true
+
+	case "OrganizationInvitation.id":
+		if e.complexity.OrganizationInvitation.ID == nil {
+			break
+		}
+
+		return e.complexity.OrganizationInvitation.ID(childComplexity), true
+
+	case "OrganizationMember.joinedAt":
+		if e.complexity.OrganizationMember.JoinedAt == nil {
+			break
+		}
+
+		return e.complexity.OrganizationMember.JoinedAt(childComplexity), true
+
+	case "OrganizationMember.role":
+		if e.complexity.OrganizationMember.Role == nil {
+			break
+		}
+
+		return e.complexity.OrganizationMember.Role(childComplexity), true
+
+	case "OrganizationMember.userId":
+		if e.complexity.OrganizationMember.UserID == nil {
+			break
+		}
+
+		return e.complexity.OrganizationMember.UserID(childComplexity), true
+
+	case "OrganizationMembership.organization":
+		if e.complexity.OrganizationMembership.Organization == nil {
+			break
+		}
+
+		return e.complexity.OrganizationMembership.Organization(childComplexity), true
+
+	case "OrganizationMembership.role":
+		if e.complexity.OrganizationMembership.Role == nil {
+			break
+		}
+
+		return e.complexity.OrganizationMembership.Role(childComplexity), true
+
+	case "PayoutSplit.bps":
+		if e.complexity.PayoutSplit.Bps == nil {
+			break
+		}
+
+		return e.complexity.PayoutSplit.Bps(childComplexity), true
+
+	case "PayoutSplit.recipient":
+		if e.complexity.PayoutSplit.Recipient == nil {
+			break
+		}
+
+		return e.complexity.PayoutSplit.Recipient(childComplexity), true
+
+	case "PinningHealth.lastPinnedAt":
+		if e.complexity.PinningHealth.LastPinnedAt == nil {
+			break
+		}
+
+		return e.complexity.PinningHealth.LastPinnedAt(childComplexity), true
+
+	case "PinningHealth.status":
+		if e.complexity.PinningHealth.Status == nil {
+			break
+		}
+
+		return e.complexity.PinningHealth.Status(childComplexity), true
+
+	case "PlatformStatus.chains":
+		if e.complexity.PlatformStatus.Chains == nil {
+			break
+		}
+
+		return e.complexity.PlatformStatus.Chains(childComplexity), true
+
+	case "PlatformStatus.checkedAt":
+		if e.complexity.PlatformStatus.CheckedAt == nil {
+			break
+		}
+
+		return e.complexity.PlatformStatus.CheckedAt(childComplexity), true
+
+	case "PlatformStatus.pinning":
+		if e.complexity.PlatformStatus.Pinning == nil {
+			break
+		}
+
+		return e.complexity.PlatformStatus.Pinning(childComplexity), true
+
+	case "PlatformStatus.queues":
+		if e.complexity.PlatformStatus.Queues == nil {
+			break
+		}
+
+		return e.complexity.PlatformStatus.Queues(childComplexity), true
+
+	case "PlatformStatus.services":
+		if e.complexity.PlatformStatus.Services == nil {
+			break
+		}
+
+		return e.complexity.PlatformStatus.Services(childComplexity), true
+
+	case "PlatformStatus.status":
+		if e.complexity.PlatformStatus.Status == nil {
+			break
+		}
+
+		return e.complexity.PlatformStatus.Status(childComplexity), true
+
+	case "PrepareCollectionAdminPayload.intentId":
+		if e.complexity.PrepareCollectionAdminPayload.IntentID == nil {
+			break
+		}
+
+		return e.complexity.PrepareCollectionAdminPayload.IntentID(childComplexity), true
+
+	case "PrepareCollectionAdminPayload.txRequest":
+		if e.complexity.PrepareCollectionAdminPayload.TxRequest == nil {
+			break
+		}
+
+		return e.complexity.PrepareCollectionAdminPayload.TxRequest(childComplexity), true
+
+	case "PrepareCreateCollectionPayload.callbackSecret":
+		if e.complexity.PrepareCreateCollectionPayload.CallbackSecret == nil {
+			break
+		}
+
+		return e.complexity.PrepareCreateCollectionPayload.CallbackSecret(childComplexity), true
+
+	case "PrepareCreateCollectionPayload.intentId":
+		if e.complexity.PrepareCreateCollectionPayload.IntentID == nil {
+			break
+		}
+
+		return e.complexity.PrepareCreateCollectionPayload.IntentID(childComplexity), true
+
+	case "PrepareCreateCollectionPayload.txRequest":
+		if e.complexity.PrepareCreateCollectionPayload.TxRequest == nil {
+			break
+		}
+
+		return e.complexity.PrepareCreateCollectionPayload.TxRequest(childComplexity), true
+
+	case "PrepareMintPayload.intentId":
+		if e.complexity.PrepareMintPayload.IntentID == nil {
+			break
+		}
+
+		return e.complexity.PrepareMintPayload.IntentID(childComplexity), true
+
+	case "PrepareMintPayload.txRequest":
+		if e.complexity.PrepareMintPayload.TxRequest == nil {
+			break
+		}
+
+		return e.complexity.PrepareMintPayload.TxRequest(childComplexity), true
+
+	case "ProfileStats.collectionsCreated":
+		if e.complexity.ProfileStats.CollectionsCreated == nil {
+			break
+		}
+
+		return e.complexity.ProfileStats.CollectionsCreated(childComplexity), true
+
+	case "ProfileStats.followers":
+		if e.complexity.ProfileStats.Followers == nil {
+			break
+		}
+
+		return e.complexity.ProfileStats.Followers(childComplexity), true
+
+	case "ProfileStats.itemsOwned":
+		if e.complexity.ProfileStats.ItemsOwned == nil {
+			break
+		}
+
+		return e.complexity.ProfileStats.ItemsOwned(childComplexity), true
+
+	case "ProfileStats.volumeWei":
+		if e.complexity.ProfileStats.VolumeWei == nil {
+			break
+		}
+
+		return e.complexity.ProfileStats.VolumeWei(childComplexity), true
+
+	case "Query.airdropProgress":
+		if e.complexity.Query.AirdropProgress == nil {
+			break
+		}
+
+		args, err := ec.field_Query_airdropProgress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AirdropProgress(childComplexity, args["bundleId"].(string)), true
+
+	case "Query.bulkTransferProgress":
+		if e.complexity.Query.BulkTransferProgress == nil {
+			break
+		}
+
+		args, err := ec.field_Query_bulkTransferProgress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BulkTransferProgress(childComplexity, args["bundleId"].(string)), true
+
+	case "Query.callTargetOverrides":
+		if e.complexity.Query.CallTargetOverrides == nil {
+			break
+		}
+
+		args, err := ec.field_Query_callTargetOverrides_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CallTargetOverrides(childComplexity, args["chainId"].(*string)), true
+
+	case "Query.chainCapabilities":
+		if e.complexity.Query.ChainCapabilities == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chainCapabilities_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ChainCapabilities(childComplexity, args["chainId"].(string)), true
+
+	case "Query.chainContracts":
+		if e.complexity.Query.ChainContracts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chainContracts_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ChainContracts(childComplexity, args["chainId"].(string)), true
+
+	case "Query.chainGasPolicy":
+		if e.complexity.Query.ChainGasPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chainGasPolicy_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ChainGasPolicy(childComplexity, args["chainId"].(string)), true
+
+	case "Query.chainHead":
+		if e.complexity.Query.ChainHead == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chainHead_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ChainHead(childComplexity, args["chainId"].(string)), true
+
+	case "Query.chainRpcEndpoints":
+		if e.complexity.Query.ChainRPCEndpoints == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chainRpcEndpoints_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ChainRPCEndpoints(childComplexity, args["chainId"].(string)), true
+
+	case "Query.collection":
+		if e.complexity.Query.Collection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_collection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Collection(childComplexity, args["chainId"].(string), args["contract"].(string), args["includeFlagged"].(*bool), args["includeUnconfirmed"].(*bool)), true
+
+	case "Query.collectionBySlug":
+		if e.complexity.Query.CollectionBySlug == nil {
+			break
+		}
+
+		args, err := ec.field_Query_collectionBySlug_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CollectionBySlug(childComplexity, args["slug"].(string), args["includeFlagged"].(*bool), args["includeUnconfirmed"].(*bool)), true
+
+	case "Query.collectionDefaults":
+		if e.complexity.Query.CollectionDefaults == nil {
+			break
+		}
+
+		args, err := ec.field_Query_collectionDefaults_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CollectionDefaults(childComplexity, args["chainId"].(string)), true
+
+	case "Query.collectionExport":
+		if e.complexity.Query.CollectionExport == nil {
+			break
+		}
+
+		args, err := ec.field_Query_collectionExport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CollectionExport(childComplexity, args["id"].(string)), true
+
+	case "Query.collections":
+		if e.complexity.Query.Collections == nil {
+			break
+		}
+
+		args, err := ec.field_Query_collections_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Collections(childComplexity, args["chainId"].(*string), args["filter"].(*CollectionFilterInput), args["sort"].(*CollectionSortInput), args["limit"].(*int), args["offset"].(*int), args["includeFlagged"].(*bool), args["includeUnconfirmed"].(*bool)), true
+
+	case "Query.contractMeta":
+		if e.complexity.Query.ContractMeta == nil {
+			break
+		}
+
+		args, err := ec.field_Query_contractMeta_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ContractMeta(childComplexity, args["chainId"].(string), args["address"].(string)), true
+
+	case "Query.dropSubmissions":
+		if e.complexity.Query.DropSubmissions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dropSubmissions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DropSubmissions(childComplexity, args["status"].(*DropSubmissionStatus), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.health":
+		if e.complexity.Query.Health == nil {
+			break
+		}
+
+		return e.complexity.Query.Health(childComplexity), true
+
+	case "Query.holderSnapshot":
+		if e.complexity.Query.HolderSnapshot == nil {
+			break
+		}
+
+		args, err := ec.field_Query_holderSnapshot_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HolderSnapshot(childComplexity, args["id"].(string)), true
+
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
+			break
+		}
+
+		return e.complexity.Query.Me(childComplexity), true
+
+	case "Query.mediaAsset":
+		if e.complexity.Query.MediaAsset == nil {
+			break
+		}
+
+		args, err := ec.field_Query_mediaAsset_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MediaAsset(childComplexity, args["id"].(string)), true
+
+	case "Query.mediaAssetByCid":
+		if e.complexity.Query.MediaAssetByCid == nil {
+			break
+		}
+
+		args, err := ec.field_Query_mediaAssetByCid_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MediaAssetByCid(childComplexity, args["cid"].(string)), true
+
+	case "Query.mintStats":
+		if e.complexity.Query.MintStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_mintStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MintStats(childComplexity, args["chainId"].(string), args["contract"].(string), args["window"].(*MintStatsWindow)), true
+
+	case "Query.myApiKeys":
+		if e.complexity.Query.MyAPIKeys == nil {
+			break
+		}
+
+		return e.complexity.Query.MyAPIKeys(childComplexity), true
+
+	case "Query.myApiUsage":
+		if e.complexity.Query.MyAPIUsage == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myApiUsage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyAPIUsage(childComplexity, args["days"].(*int)), true
+
+	case "Query.myCreatedCollections":
+		if e.complexity.Query.MyCreatedCollections == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myCreatedCollections_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyCreatedCollections(childComplexity, args["chainId"].(*string), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.myDropSubmissions":
+		if e.complexity.Query.MyDropSubmissions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myDropSubmissions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyDropSubmissions(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.myEarnings":
+		if e.complexity.Query.MyEarnings == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myEarnings_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyEarnings(childComplexity, args["period"].(*EarningsPeriod)), true
+
+	case "Query.myEmail":
+		if e.complexity.Query.MyEmail == nil {
+			break
+		}
+
+		return e.complexity.Query.MyEmail(childComplexity), true
+
+	case "Query.myNFTs":
+		if e.complexity.Query.MyNFTs == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myNFTs_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyNFTs(childComplexity, args["chainId"].(string), args["contract"].(*string), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.myOrganizations":
+		if e.complexity.Query.MyOrganizations == nil {
+			break
+		}
+
+		return e.complexity.Query.MyOrganizations(childComplexity), true
+
+	case "Query.myRelationships":
+		if e.complexity.Query.MyRelationships == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myRelationships_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyRelationships(childComplexity, args["kind"].(RelationshipKind)), true
+
+	case "Query.myStorageUsage":
+		if e.complexity.Query.MyStorageUsage == nil {
+			break
+		}
+
+		return e.complexity.Query.MyStorageUsage(childComplexity), true
+
+	case "Query.myWallets":
+		if e.complexity.Query.MyWallets == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myWallets_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyWallets(childComplexity, args["watchOnly"].(*bool)), true
+
+	case "Query.myWatchlist":
+		if e.complexity.Query.MyWatchlist == nil {
+			break
+		}
+
+		return e.complexity.Query.MyWatchlist(childComplexity), true
+
+	case "Query.organization":
+		if e.complexity.Query.Organization == nil {
+			break
+		}
+
+		args, err := ec.field_Query_organization_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Organization(childComplexity, args["id"].(string)), true
+
+	case "Query.platformStatus":
+		if e.complexity.Query.PlatformStatus == nil {
+			break
+		}
+
+		return e.complexity.Query.PlatformStatus(childComplexity), true
+
+	case "Query.relatedCollections":
+		if e.complexity.Query.RelatedCollections == nil {
+			break
+		}
+
+		args, err := ec.field_Query_relatedCollections_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RelatedCollections(childComplexity, args["slug"].(string), args["limit"].(*int)), true
+
+	case "Query.reportQueue":
+		if e.complexity.Query.ReportQueue == nil {
+			break
+		}
+
+		args, err := ec.field_Query_reportQueue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ReportQueue(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.sponsorshipBudgets":
+		if e.complexity.Query.SponsorshipBudgets == nil {
+			break
+		}
+
+		return e.complexity.Query.SponsorshipBudgets(childComplexity), true
+
+	case "Query.suggest":
+		if e.complexity.Query.Suggest == nil {
+			break
+		}
+
+		args, err := ec.field_Query_suggest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Suggest(childComplexity, args["query"].(string), args["limit"].(*int)), true
+
+	case "Query.systemStatus":
+		if e.complexity.Query.SystemStatus == nil {
+			break
+		}
+
+		return e.complexity.Query.SystemStatus(childComplexity), true
+
+	case "Query.token":
+		if e.complexity.Query.Token == nil {
+			break
+		}
+
+		args, err := ec.field_Query_token_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Token(childComplexity, args["chainId"].(string), args["contract"].(string), args["tokenId"].(string), args["includeFlagged"].(*bool)), true
+
+	case "Query.tokens":
+		if e.complexity.Query.Tokens == nil {
+			break
+		}
+
+		args, err := ec.field_Query_tokens_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Tokens(childComplexity, args["filter"].(*TokenFilterInput), args["sort"].(*TokenSortInput), args["limit"].(*int), args["offset"].(*int), args["includeFlagged"].(*bool)), true
+
+	case "Query.upcomingDrops":
+		if e.complexity.Query.UpcomingDrops == nil {
+			break
+		}
+
+		args, err := ec.field_Query_upcomingDrops_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.UpcomingDrops(childComplexity, args["chainId"].(*string), args["from"].(*string), args["to"].(*string), args["limit"].(*int)), true
+
+	case "Query.userActivity":
+		if e.complexity.Query.UserActivity == nil {
+			break
+		}
+
+		args, err := ec.field_Query_userActivity_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.UserActivity(childComplexity, args["userId"].(string), args["address"].(string), args["cursor"].(*string), args["limit"].(*int)), true
+
+	case "Query.userProfile":
+		if e.complexity.Query.UserProfile == nil {
+			break
+		}
+
+		args, err := ec.field_Query_userProfile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.UserProfile(childComplexity, args["userId"].(string)), true
+
+	case "Query.viewerPreferences":
+		if e.complexity.Query.ViewerPreferences == nil {
+			break
+		}
+
+		return e.complexity.Query.ViewerPreferences(childComplexity), true
+
+	case "Query.walletActivity":
+		if e.complexity.Query.WalletActivity == nil {
+			break
+		}
+
+		args, err := ec.field_Query_walletActivity_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.WalletActivity(childComplexity, args["address"].(string), args["cursor"].(*string), args["limit"].(*int)), true
+
+	case "QueueBacklog.messages":
+		if e.complexity.QueueBacklog.Messages == nil {
+			break
+		}
+
+		return e.complexity.QueueBacklog.Messages(childComplexity), true
+
+	case "QueueBacklog.name":
+		if e.complexity.QueueBacklog.Name == nil {
+			break
+		}
+
+		return e.complexity.QueueBacklog.Name(childComplexity), true
+
+	case "QueueBacklog.status":
+		if e.complexity.QueueBacklog.Status == nil {
+			break
+		}
+
+		return e.complexity.QueueBacklog.Status(childComplexity), true
+
+	case "QueueStatus.consumers":
+		if e.complexity.QueueStatus.Consumers == nil {
+			break
+		}
+
+		return e.complexity.QueueStatus.Consumers(childComplexity), true
+
+	case "QueueStatus.error":
+		if e.complexity.QueueStatus.Error == nil {
+			break
+		}
+
+		return e.complexity.QueueStatus.Error(childComplexity), true
+
+	case "QueueStatus.messages":
+		if e.complexity.QueueStatus.Messages == nil {
+			break
+		}
+
+		return e.complexity.QueueStatus.Messages(childComplexity), true
+
+	case "QueueStatus.name":
+		if e.complexity.QueueStatus.Name == nil {
+			break
+		}
+
+		return e.complexity.QueueStatus.Name(childComplexity), true
+
+	case "Report.createdAt":
+		if e.complexity.Report.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Report.CreatedAt(childComplexity), true
+
+	case "Report.details":
+		if e.complexity.Report.Details == nil {
+			break
+		}
+
+		return e.complexity.Report.Details(childComplexity), true
+
+	case "Report.id":
+		if e.complexity.Report.ID == nil {
+			break
+		}
+
+		return e.complexity.Report.ID(childComplexity), true
+
+	case "Report.reason":
+		if e.complexity.Report.Reason == nil {
+			break
+		}
+
+		return e.complexity.Report.Reason(childComplexity), true
+
+	case "Report.status":
+		if e.complexity.Report.Status == nil {
+			break
+		}
+
+		return e.complexity.Report.Status(childComplexity), true
+
+	case "Report.targetId":
+		if e.complexity.Report.TargetID == nil {
+			break
+		}
+
+		return e.complexity.Report.TargetID(childComplexity), true
+
+	case "Report.targetType":
+		if e.complexity.Report.TargetType == nil {
+			break
+		}
+
+		return e.complexity.Report.TargetType(childComplexity), true
+
+	case "ReportContentPayload.duplicate":
+		if e.complexity.ReportContentPayload.Duplicate == nil {
+			break
+		}
+
+		return e.complexity.ReportContentPayload.Duplicate(childComplexity), true
+
+	case "ReportContentPayload.report":
+		if e.complexity.ReportContentPayload.Report == nil {
+			break
+		}
+
+		return e.complexity.ReportContentPayload.Report(childComplexity), true
+
+	case "ReportQueueItem.firstReportedAt":
+		if e.complexity.ReportQueueItem.FirstReportedAt == nil {
+			break
+		}
+
+		return e.complexity.ReportQueueItem.FirstReportedAt(childComplexity), true
+
+	case "ReportQueueItem.lastReportedAt":
+		if e.complexity.ReportQueueItem.LastReportedAt == nil {
+			break
+		}
+
+		return e.complexity.ReportQueueItem.LastReportedAt(childComplexity), true
+
+	case "ReportQueueItem.reasons":
+		if e.complexity.ReportQueueItem.Reasons == nil {
+			break
+		}
+
+		return e.complexity.ReportQueueItem.Reasons(childComplexity), true
+
+	case "ReportQueueItem.reportCount":
+		if e.complexity.ReportQueueItem.ReportCount == nil {
+			break
+		}
+
+		return e.complexity.ReportQueueItem.ReportCount(childComplexity), true
+
+	case "ReportQueueItem.targetId":
+		if e.complexity.ReportQueueItem.TargetID == nil {
+			break
+		}
+
+		return e.complexity.ReportQueueItem.TargetID(childComplexity), true
+
+	case "ReportQueueItem.targetType":
+		if e.complexity.ReportQueueItem.TargetType == nil {
+			break
+		}
+
+		return e.complexity.ReportQueueItem.TargetType(childComplexity), true
+
+	case "ResolveReportsPayload.flag":
+		if e.complexity.ResolveReportsPayload.Flag == nil {
+			break
+		}
+
+		return e.complexity.ResolveReportsPayload.Flag(childComplexity), true
+
+	case "ResolveReportsPayload.resolved":
+		if e.complexity.ResolveReportsPayload.Resolved == nil {
+			break
+		}
+
+		return e.complexity.ResolveReportsPayload.Resolved(childComplexity), true
+
+	case "ResyncDrift.catalog":
+		if e.complexity.ResyncDrift.Catalog == nil {
+			break
+		}
+
+		return e.complexity.ResyncDrift.Catalog(childComplexity), true
+
+	case "ResyncDrift.chain":
+		if e.complexity.ResyncDrift.Chain == nil {
+			break
+		}
+
+		return e.complexity.ResyncDrift.Chain(childComplexity), true
+
+	case "ResyncDrift.holder":
+		if e.complexity.ResyncDrift.Holder == nil {
+			break
+		}
+
+		return e.complexity.ResyncDrift.Holder(childComplexity), true
+
+	case "ResyncDrift.kind":
+		if e.complexity.ResyncDrift.Kind == nil {
+			break
+		}
+
+		return e.complexity.ResyncDrift.Kind(childComplexity), true
+
+	case "ResyncDrift.tokenId":
+		if e.complexity.ResyncDrift.TokenID == nil {
+			break
+		}
+
+		return e.complexity.ResyncDrift.TokenID(childComplexity), true
+
+	case "ResyncReport.blockNumber":
+		if e.complexity.ResyncReport.BlockNumber == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.BlockNumber(childComplexity), true
+
+	case "ResyncReport.chainId":
+		if e.complexity.ResyncReport.ChainID == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.ChainID(childComplexity), true
+
+	case "ResyncReport.checkedAt":
+		if e.complexity.ResyncReport.CheckedAt == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.CheckedAt(childComplexity), true
+
+	case "ResyncReport.contract":
+		if e.complexity.ResyncReport.Contract == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.Contract(childComplexity), true
+
+	case "ResyncReport.drifts":
+		if e.complexity.ResyncReport.Drifts == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.Drifts(childComplexity), true
+
+	case "ResyncReport.id":
+		if e.complexity.ResyncReport.ID == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.ID(childComplexity), true
+
+	case "ResyncReport.repaired":
+		if e.complexity.ResyncReport.Repaired == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.Repaired(childComplexity), true
+
+	case "ResyncReport.standard":
+		if e.complexity.ResyncReport.Standard == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.Standard(childComplexity), true
+
+	case "ResyncReport.tokensSampled":
+		if e.complexity.ResyncReport.TokensSampled == nil {
+			break
+		}
+
+		return e.complexity.ResyncReport.TokensSampled(childComplexity), true
+
+	case "RpcEndpoint.active":
+		if e.complexity.RpcEndpoint.Active == nil {
+			break
+		}
+
+		return e.complexity.RpcEndpoint.Active(childComplexity), true
+
+	case "RpcEndpoint.authType":
+		if e.complexity.RpcEndpoint.AuthType == nil {
+			break
+		}
+
+		return e.complexity.RpcEndpoint.AuthType(childComplexity), true
+
+	case "RpcEndpoint.priority":
+		if e.complexity.RpcEndpoint.Priority == nil {
+			break
+		}
+
+		return e.complexity.RpcEndpoint.Priority(childComplexity), true
+
+	case "RpcEndpoint.rateLimit":
+		if e.complexity.RpcEndpoint.RateLimit == nil {
+			break
+		}
+
+		return e.complexity.RpcEndpoint.RateLimit(childComplexity), true
+
+	case "RpcEndpoint.url":
+		if e.complexity.RpcEndpoint.URL == nil {
+			break
+		}
+
+		return e.complexity.RpcEndpoint.URL(childComplexity), true
+
+	case "RpcEndpoint.weight":
+		if e.complexity.RpcEndpoint.Weight == nil {
+			break
+		}
+
+		return e.complexity.RpcEndpoint.Weight(childComplexity), true
+
+	case "SavedSearch.createdAt":
+		if e.complexity.SavedSearch.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.SavedSearch.CreatedAt(childComplexity), true
+
+	case "SavedSearch.filters":
+		if e.complexity.SavedSearch.Filters == nil {
+			break
+		}
+
+		return e.complexity.SavedSearch.Filters(childComplexity), true
+
+	case "SavedSearch.id":
+		if e.complexity.SavedSearch.ID == nil {
+			break
+		}
+
+		return e.complexity.SavedSearch.ID(childComplexity), true
+
+	case "SavedSearch.name":
+		if e.complexity.SavedSearch.Name == nil {
+			break
+		}
+
+		return e.complexity.SavedSearch.Name(childComplexity), true
+
+	case "SavedSearch.query":
+		if e.complexity.SavedSearch.Query == nil {
+			break
+		}
+
+		return e.complexity.SavedSearch.Query(childComplexity), true
+
+	case "SearchFilter.key":
+		if e.complexity.SearchFilter.Key == nil {
+			break
+		}
+
+		return e.complexity.SearchFilter.Key(childComplexity), true
+
+	case "SearchFilter.value":
+		if e.complexity.SearchFilter.Value == nil {
+			break
+		}
+
+		return e.complexity.SearchFilter.Value(childComplexity), true
+
+	case "ServiceHealth.name":
+		if e.complexity.ServiceHealth.Name == nil {
+			break
+		}
+
+		return e.complexity.ServiceHealth.Name(childComplexity), true
+
+	case "ServiceHealth.status":
+		if e.complexity.ServiceHealth.Status == nil {
+			break
+		}
+
+		return e.complexity.ServiceHealth.Status(childComplexity), true
+
+	case "SnapshotExport.bytes":
+		if e.complexity.SnapshotExport.Bytes == nil {
+			break
+		}
+
+		return e.complexity.SnapshotExport.Bytes(childComplexity), true
+
+	case "SnapshotExport.expiresAt":
+		if e.complexity.SnapshotExport.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.SnapshotExport.ExpiresAt(childComplexity), true
+
+	case "SnapshotExport.url":
+		if e.complexity.SnapshotExport.URL == nil {
+			break
+		}
+
+		return e.complexity.SnapshotExport.URL(childComplexity), true
+
+	case "SponsorshipBudget.balanceGwei":
+		if e.complexity.SponsorshipBudget.BalanceGwei == nil {
+			break
+		}
+
+		return e.complexity.SponsorshipBudget.BalanceGwei(childComplexity), true
+
+	case "SponsorshipBudget.chainId":
+		if e.complexity.SponsorshipBudget.ChainID == nil {
+			break
+		}
+
+		return e.complexity.SponsorshipBudget.ChainID(childComplexity), true
+
+	case "SponsorshipBudget.frozen":
+		if e.complexity.SponsorshipBudget.Frozen == nil {
+			break
+		}
+
+		return e.complexity.SponsorshipBudget.Frozen(childComplexity), true
+
+	case "SponsorshipBudget.frozenReason":
+		if e.complexity.SponsorshipBudget.FrozenReason == nil {
+			break
+		}
+
+		return e.complexity.SponsorshipBudget.FrozenReason(childComplexity), true
+
+	case "SponsorshipBudget.spentGwei":
+		if e.complexity.SponsorshipBudget.SpentGwei == nil {
+			break
+		}
+
+		return e.complexity.SponsorshipBudget.SpentGwei(childComplexity), true
+
+	case "SponsorshipBudget.toppedUpGwei":
+		if e.complexity.SponsorshipBudget.ToppedUpGwei == nil {
+			break
+		}
+
+		return e.complexity.SponsorshipBudget.ToppedUpGwei(childComplexity), true
+
+	case "SponsorshipBudget.updatedAt":
+		if e.complexity.SponsorshipBudget.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.SponsorshipBudget.UpdatedAt(childComplexity), true
+
+	case "SponsorshipBudget.updatedBy":
+		if e.complexity.SponsorshipBudget.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.SponsorshipBudget.UpdatedBy(childComplexity), true
+
+	case "StorageLimits.assets":
+		if e.complexity.StorageLimits.Assets == nil {
+			break
+		}
+
+		return e.complexity.StorageLimits.Assets(childComplexity), true
+
+	case "StorageLimits.bytes":
+		if e.complexity.StorageLimits.Bytes == nil {
+			break
+		}
+
+		return e.complexity.StorageLimits.Bytes(childComplexity), true
+
+	case "StorageUsage.assets":
+		if e.complexity.StorageUsage.Assets == nil {
+			break
+		}
+
+		return e.complexity.StorageUsage.Assets(childComplexity), true
+
+	case "StorageUsage.byKind":
+		if e.complexity.StorageUsage.ByKind == nil {
+			break
+		}
+
+		return e.complexity.StorageUsage.ByKind(childComplexity), true
+
+	case "StorageUsage.bytes":
+		if e.complexity.StorageUsage.Bytes == nil {
+			break
+		}
+
+		return e.complexity.StorageUsage.Bytes(childComplexity), true
+
+	case "StorageUsage.hardLimit":
+		if e.complexity.StorageUsage.HardLimit == nil {
+			break
+		}
+
+		return e.complexity.StorageUsage.HardLimit(childComplexity), true
+
+	case "StorageUsage.overSoftLimit":
+		if e.complexity.StorageUsage.OverSoftLimit == nil {
+			break
+		}
+
+		return e.complexity.StorageUsage.OverSoftLimit(childComplexity), true
+
+	case "StorageUsage.softLimit":
+		if e.complexity.StorageUsage.SoftLimit == nil {
+			break
+		}
+
+		return e.complexity.StorageUsage.SoftLimit(childComplexity), true
+
+	case "Subscription.mintStats":
+		if e.complexity.Subscription.MintStats == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_mintStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.MintStats(childComplexity, args["chainId"].(string), args["contract"].(string), args["window"].(*MintStatsWindow)), true
+
+	case "Subscription.myAccountEvents":
+		if e.complexity.Subscription.MyAccountEvents == nil {
+			break
+		}
+
+		return e.complexity.Subscription.MyAccountEvents(childComplexity), true
+
+	case "Subscription.onAirdropProgress":
+		if e.complexity.Subscription.OnAirdropProgress == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_onAirdropProgress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.OnAirdropProgress(childComplexity, args["bundleId"].(string)), true
+
+	case "Subscription.onIntentStatus":
+		if e.complexity.Subscription.OnIntentStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_onIntentStatus_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.OnIntentStatus(childComplexity, args["intentId"].(string)), true
+
+	case "Subscription.onUploadProgress":
+		if e.complexity.Subscription.OnUploadProgress == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_onUploadProgress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.OnUploadProgress(childComplexity, args["ticket"].(string)), true
+
+	case "SubscriptionTicket.expiresAt":
+		if e.complexity.SubscriptionTicket.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.SubscriptionTicket.ExpiresAt(childComplexity), true
+
+	case "SubscriptionTicket.ticket":
+		if e.complexity.SubscriptionTicket.Ticket == nil {
+			break
+		}
+
+		return e.complexity.SubscriptionTicket.Ticket(childComplexity), true
+
+	case "Suggestion.chainId":
+		if e.complexity.Suggestion.ChainID == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.ChainID(childComplexity), true
+
+	case "Suggestion.contract":
+		if e.complexity.Suggestion.Contract == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.Contract(childComplexity), true
+
+	case "Suggestion.id":
+		if e.complexity.Suggestion.ID == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.ID(childComplexity), true
+
+	case "Suggestion.kind":
+		if e.complexity.Suggestion.Kind == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.Kind(childComplexity), true
+
+	case "Suggestion.label":
+		if e.complexity.Suggestion.Label == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.Label(childComplexity), true
+
+	case "Suggestion.slug":
+		if e.complexity.Suggestion.Slug == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.Slug(childComplexity), true
+
+	case "Suggestion.sublabel":
+		if e.complexity.Suggestion.Sublabel == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.Sublabel(childComplexity), true
+
+	case "Suggestion.thumbnailUrl":
+		if e.complexity.Suggestion.ThumbnailURL == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.ThumbnailURL(childComplexity), true
+
+	case "Suggestion.tokenId":
+		if e.complexity.Suggestion.TokenID == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.TokenID(childComplexity), true
+
+	case "Suggestion.username":
+		if e.complexity.Suggestion.Username == nil {
+			break
+		}
+
+		return e.complexity.Suggestion.Username(childComplexity), true
+
+	case "SystemStatus.checkedAt":
+		if e.complexity.SystemStatus.CheckedAt == nil {
+			break
+		}
+
+		return e.complexity.SystemStatus.CheckedAt(childComplexity), true
+
+	case "SystemStatus.consumers":
+		if e.complexity.SystemStatus.Consumers == nil {
+			break
+		}
+
+		return e.complexity.SystemStatus.Consumers(childComplexity), true
+
+	case "SystemStatus.queues":
+		if e.complexity.SystemStatus.Queues == nil {
+			break
+		}
+
+		return e.complexity.SystemStatus.Queues(childComplexity), true
+
+	case "Token.burned":
+		if e.complexity.Token.Burned == nil {
+			break
+		}
+
+		return e.complexity.Token.Burned(childComplexity), true
+
+	case "Token.chainId":
+		if e.complexity.Token.ChainID == nil {
+			break
+		}
+
+		return e.complexity.Token.ChainID(childComplexity), true
+
+	case "Token.contract":
+		if e.complexity.Token.Contract == nil {
+			break
+		}
+
+		return e.complexity.Token.Contract(childComplexity), true
+
+	case "Token.imageUrl":
+		if e.complexity.Token.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.Token.ImageURL(childComplexity), true
+
+	case "Token.maxSupply":
+		if e.complexity.Token.MaxSupply == nil {
+			break
+		}
+
+		return e.complexity.Token.MaxSupply(childComplexity), true
+
+	case "Token.minted":
+		if e.complexity.Token.Minted == nil {
+			break
+		}
+
+		return e.complexity.Token.Minted(childComplexity), true
+
+	case "Token.name":
+		if e.complexity.Token.Name == nil {
+			break
+		}
+
+		return e.complexity.Token.Name(childComplexity), true
+
+	case "Token.owner":
+		if e.complexity.Token.Owner == nil {
+			break
+		}
+
+		return e.complexity.Token.Owner(childComplexity), true
+
+	case "Token.price":
+		if e.complexity.Token.Price == nil {
+			break
+		}
+
+		return e.complexity.Token.Price(childComplexity), true
+
+	case "Token.rarityScore":
+		if e.complexity.Token.RarityScore == nil {
+			break
+		}
+
+		return e.complexity.Token.RarityScore(childComplexity), true
+
+	case "Token.rentals":
+		if e.complexity.Token.Rentals == nil {
+			break
+		}
+
+		return e.complexity.Token.Rentals(childComplexity), true
+
+	case "Token.standard":
+		if e.complexity.Token.Standard == nil {
+			break
+		}
+
+		return e.complexity.Token.Standard(childComplexity), true
+
+	case "Token.supply":
+		if e.complexity.Token.Supply == nil {
+			break
+		}
+
+		return e.complexity.Token.Supply(childComplexity), true
+
+	case "Token.tokenId":
+		if e.complexity.Token.TokenID == nil {
+			break
+		}
+
+		return e.complexity.Token.TokenID(childComplexity), true
+
+	case "TokenGateResult.balance":
+		if e.complexity.TokenGateResult.Balance == nil {
+			break
+		}
+
+		return e.complexity.TokenGateResult.Balance(childComplexity), true
+
+	case "TokenGateResult.expiresAt":
+		if e.complexity.TokenGateResult.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.TokenGateResult.ExpiresAt(childComplexity), true
+
+	case "TokenGateResult.held":
+		if e.complexity.TokenGateResult.Held == nil {
+			break
+		}
+
+		return e.complexity.TokenGateResult.Held(childComplexity), true
+
+	case "TokenGateResult.token":
+		if e.complexity.TokenGateResult.Token == nil {
+			break
+		}
+
+		return e.complexity.TokenGateResult.Token(childComplexity), true
+
+	case "TokenRental.amount":
+		if e.complexity.TokenRental.Amount == nil {
+			break
+		}
+
+		return e.complexity.TokenRental.Amount(childComplexity), true
+
+	case "TokenRental.expiresAt":
+		if e.complexity.TokenRental.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.TokenRental.ExpiresAt(childComplexity), true
+
+	case "TokenRental.owner":
+		if e.complexity.TokenRental.Owner == nil {
+			break
+		}
+
+		return e.complexity.TokenRental.Owner(childComplexity), true
+
+	case "TokenRental.recordId":
+		if e.complexity.TokenRental.RecordID == nil {
+			break
+		}
+
+		return e.complexity.TokenRental.RecordID(childComplexity), true
+
+	case "TokenRental.renter":
+		if e.complexity.TokenRental.Renter == nil {
+			break
+		}
+
+		return e.complexity.TokenRental.Renter(childComplexity), true
+
+	case "TokenRental.standard":
+		if e.complexity.TokenRental.Standard == nil {
+			break
+		}
+
+		return e.complexity.TokenRental.Standard(childComplexity), true
+
+	case "TxRequest.data":
+		if e.complexity.TxRequest.Data == nil {
+			break
+		}
+
+		return e.complexity.TxRequest.Data(childComplexity), true
+
+	case "TxRequest.previewAddress":
+		if e.complexity.TxRequest.PreviewAddress == nil {
+			break
+		}
+
+		return e.complexity.TxRequest.PreviewAddress(childComplexity), true
+
+	case "TxRequest.to":
+		if e.complexity.TxRequest.To == nil {
+			break
+		}
+
+		return e.complexity.TxRequest.To(childComplexity), true
+
+	case "TxRequest.value":
+		if e.complexity.TxRequest.Value == nil {
+			break
+		}
+
+		return e.complexity.TxRequest.Value(childComplexity), true
+
+	case "UpcomingDrop.chainId":
+		if e.complexity.UpcomingDrop.ChainID == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.ChainID(childComplexity), true
+
+	case "UpcomingDrop.contract":
+		if e.complexity.UpcomingDrop.Contract == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.Contract(childComplexity), true
+
+	case "UpcomingDrop.description":
+		if e.complexity.UpcomingDrop.Description == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.Description(childComplexity), true
+
+	case "UpcomingDrop.endsAt":
+		if e.complexity.UpcomingDrop.EndsAt == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.EndsAt(childComplexity), true
+
+	case "UpcomingDrop.externalUrl":
+		if e.complexity.UpcomingDrop.ExternalURL == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.ExternalURL(childComplexity), true
+
+	case "UpcomingDrop.id":
+		if e.complexity.UpcomingDrop.ID == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.ID(childComplexity), true
+
+	case "UpcomingDrop.imageUrl":
+		if e.complexity.UpcomingDrop.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.ImageURL(childComplexity), true
+
+	case "UpcomingDrop.isVerified":
+		if e.complexity.UpcomingDrop.IsVerified == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.IsVerified(childComplexity), true
+
+	case "UpcomingDrop.mintPrice":
+		if e.complexity.UpcomingDrop.MintPrice == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.MintPrice(childComplexity), true
+
+	case "UpcomingDrop.name":
+		if e.complexity.UpcomingDrop.Name == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.Name(childComplexity), true
+
+	case "UpcomingDrop.slug":
+		if e.complexity.UpcomingDrop.Slug == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.Slug(childComplexity), true
+
+	case "UpcomingDrop.source":
+		if e.complexity.UpcomingDrop.Source == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.Source(childComplexity), true
+
+	case "UpcomingDrop.startsAt":
+		if e.complexity.UpcomingDrop.StartsAt == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.StartsAt(childComplexity), true
+
+	case "UpcomingDrop.startsInSeconds":
+		if e.complexity.UpcomingDrop.StartsInSeconds == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.StartsInSeconds(childComplexity), true
+
+	case "UpcomingDrop.status":
+		if e.complexity.UpcomingDrop.Status == nil {
+			break
+		}
+
+		return e.complexity.UpcomingDrop.Status(childComplexity), true
+
+	case "UploadProgress.assetId":
+		if e.complexity.UploadProgress.AssetID == nil {
+			break
+		}
+
+		return e.complexity.UploadProgress.AssetID(childComplexity), true
+
+	case "UploadProgress.bytesReceived":
+		if e.complexity.UploadProgress.BytesReceived == nil {
+			break
+		}
+
+		return e.complexity.UploadProgress.BytesReceived(childComplexity), true
+
+	case "UploadProgress.emittedAt":
+		if e.complexity.UploadProgress.EmittedAt == nil {
+			break
+		}
+
+		return e.complexity.UploadProgress.EmittedAt(childComplexity), true
+
+	case "UploadProgress.error":
+		if e.complexity.UploadProgress.Error == nil {
+			break
+		}
+
+		return e.complexity.UploadProgress.Error(childComplexity), true
+
+	case "UploadProgress.stage":
+		if e.complexity.UploadProgress.Stage == nil {
+			break
+		}
+
+		return e.complexity.UploadProgress.Stage(childComplexity), true
+
+	case "UploadProgress.ticket":
+		if e.complexity.UploadProgress.Ticket == nil {
+			break
+		}
+
+		return e.complexity.UploadProgress.Ticket(childComplexity), true
+
+	case "UploadProgress.totalBytes":
+		if e.complexity.UploadProgress.TotalBytes == nil {
+			break
+		}
+
+		return e.complexity.UploadProgress.TotalBytes(childComplexity), true
+
+	case "UploadSingleFilePayload.asset":
+		if e.complexity.UploadSingleFilePayload.Asset == nil {
+			break
+		}
+
+		return e.complexity.UploadSingleFilePayload.Asset(childComplexity), true
+
+	case "UploadSingleFilePayload.cid":
+		if e.complexity.UploadSingleFilePayload.Cid == nil {
+			break
+		}
+
+		return e.complexity.UploadSingleFilePayload.Cid(childComplexity), true
+
+	case "UploadSingleFilePayload.deduplicated":
+		if e.complexity.UploadSingleFilePayload.Deduplicated == nil {
+			break
+		}
+
+		return e.complexity.UploadSingleFilePayload.Deduplicated(childComplexity), true
+
+	case "UploadSingleFilePayload.url":
+		if e.complexity.UploadSingleFilePayload.URL == nil {
+			break
+		}
+
+		return e.complexity.UploadSingleFilePayload.URL(childComplexity), true
+
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+
+	case "User.impersonation":
+		if e.complexity.User.Impersonation == nil {
+			break
+		}
+
+		return e.complexity.User.Impersonation(childComplexity), true
+
+	case "UserProfile.avatarUrl":
+		if e.complexity.UserProfile.AvatarURL == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.AvatarURL(childComplexity), true
+
+	case "UserProfile.bannerUrl":
+		if e.complexity.UserProfile.BannerURL == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.BannerURL(childComplexity), true
+
+	case "UserProfile.bio":
+		if e.complexity.UserProfile.Bio == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.Bio(childComplexity), true
+
+	case "UserProfile.displayName":
+		if e.complexity.UserProfile.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.DisplayName(childComplexity), true
+
+	case "UserProfile.nftAvatar":
+		if e.complexity.UserProfile.NftAvatar == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.NftAvatar(childComplexity), true
+
+	case "UserProfile.stats":
+		if e.complexity.UserProfile.Stats == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.Stats(childComplexity), true
+
+	case "UserProfile.userId":
+		if e.complexity.UserProfile.UserID == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.UserID(childComplexity), true
+
+	case "UserProfile.username":
+		if e.complexity.UserProfile.Username == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.Username(childComplexity), true
+
+	case "UserProfile.verifiedNftAvatar":
+		if e.complexity.UserProfile.VerifiedNftAvatar == nil {
+			break
+		}
+
+		return e.complexity.UserProfile.VerifiedNftAvatar(childComplexity), true
+
+	case "UserRelationship.createdAt":
+		if e.complexity.UserRelationship.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.UserRelationship.CreatedAt(childComplexity), true
+
+	case "UserRelationship.kind":
+		if e.complexity.UserRelationship.Kind == nil {
+			break
+		}
+
+		return e.complexity.UserRelationship.Kind(childComplexity), true
+
+	case "UserRelationship.userId":
+		if e.complexity.UserRelationship.UserID == nil {
+			break
+		}
+
+		return e.complexity.UserRelationship.UserID(childComplexity), true
+
+	case "ViewerPreferences.currency":
+		if e.complexity.ViewerPreferences.Currency == nil {
+			break
+		}
+
+		return e.complexity.ViewerPreferences.Currency(childComplexity), true
+
+	case "ViewerPreferences.format":
+		if e.complexity.ViewerPreferences.Format == nil {
+			break
+		}
+
+		return e.complexity.ViewerPreferences.Format(childComplexity), true
+
+	case "ViewerPreferences.honorDelegations":
+		if e.complexity.ViewerPreferences.HonorDelegations == nil {
+			break
+		}
+
+		return e.complexity.ViewerPreferences.HonorDelegations(childComplexity), true
+
+	case "ViewerPreferences.locale":
+		if e.complexity.ViewerPreferences.Locale == nil {
+			break
+		}
+
+		return e.complexity.ViewerPreferences.Locale(childComplexity), true
+
+	case "ViewerPreferences.timezone":
+		if e.complexity.ViewerPreferences.Timezone == nil {
+			break
+		}
+
+		return e.complexity.ViewerPreferences.Timezone(childComplexity), true
+
+	case "ViewerPreferences.updatedAt":
+		if e.complexity.ViewerPreferences.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ViewerPreferences.UpdatedAt(childComplexity), true
+
+	case "WalletActivity.chainId":
+		if e.complexity.WalletActivity.ChainID == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.ChainID(childComplexity), true
+
+	case "WalletActivity.contract":
+		if e.complexity.WalletActivity.Contract == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.Contract(childComplexity), true
+
+	case "WalletActivity.currency":
+		if e.complexity.WalletActivity.Currency == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.Currency(childComplexity), true
+
+	case "WalletActivity.from":
+		if e.complexity.WalletActivity.From == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.From(childComplexity), true
+
+	case "WalletActivity.id":
+		if e.complexity.WalletActivity.ID == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.ID(childComplexity), true
+
+	case "WalletActivity.intentId":
+		if e.complexity.WalletActivity.IntentID == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.IntentID(childComplexity), true
+
+	case "WalletActivity.intentKind":
+		if e.complexity.WalletActivity.IntentKind == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.IntentKind(childComplexity), true
+
+	case "WalletActivity.intentStatus":
+		if e.complexity.WalletActivity.IntentStatus == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.IntentStatus(childComplexity), true
+
+	case "WalletActivity.kind":
+		if e.complexity.WalletActivity.Kind == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.Kind(childComplexity), true
+
+	case "WalletActivity.occurredAt":
+		if e.complexity.WalletActivity.OccurredAt == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.OccurredAt(childComplexity), true
+
+	case "WalletActivity.price":
+		if e.complexity.WalletActivity.Price == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.Price(childComplexity), true
+
+	case "WalletActivity.quantity":
+		if e.complexity.WalletActivity.Quantity == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.Quantity(childComplexity), true
+
+	case "WalletActivity.to":
+		if e.complexity.WalletActivity.To == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.To(childComplexity), true
+
+	case "WalletActivity.tokenId":
+		if e.complexity.WalletActivity.TokenID == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.TokenID(childComplexity), true
+
+	case "WalletActivity.txHash":
+		if e.complexity.WalletActivity.TxHash == nil {
+			break
+		}
+
+		return e.complexity.WalletActivity.TxHash(childComplexity), true
+
+	case "WalletActivityPage.items":
+		if e.complexity.WalletActivityPage.Items == nil {
+			break
+		}
+
+		return e.complexity.WalletActivityPage.Items(childComplexity), true
+
+	case "WalletActivityPage.nextCursor":
+		if e.complexity.WalletActivityPage.NextCursor == nil {
+			break
+		}
+
+		return e.complexity.WalletActivityPage.NextCursor(childComplexity), true
+
+	case "Watchlist.items":
+		if e.complexity.Watchlist.Items == nil {
+			break
+		}
+
+		return e.complexity.Watchlist.Items(childComplexity), true
+
+	case "Watchlist.savedSearches":
+		if e.complexity.Watchlist.SavedSearches == nil {
+			break
+		}
+
+		return e.complexity.Watchlist.SavedSearches(childComplexity), true
+
+	case "WatchlistItem.alertFiredAt":
+		if e.complexity.WatchlistItem.AlertFiredAt == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.AlertFiredAt(childComplexity), true
+
+	case "WatchlistItem.chainId":
+		if e.complexity.WatchlistItem.ChainID == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.ChainID(childComplexity), true
+
+	case "WatchlistItem.collectionName":
+		if e.complexity.WatchlistItem.CollectionName == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.CollectionName(childComplexity), true
+
+	case "WatchlistItem.contract":
+		if e.complexity.WatchlistItem.Contract == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.Contract(childComplexity), true
+
+	case "WatchlistItem.createdAt":
+		if e.complexity.WatchlistItem.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.CreatedAt(childComplexity), true
+
+	case "WatchlistItem.currentFloor":
+		if e.complexity.WatchlistItem.CurrentFloor == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.CurrentFloor(childComplexity), true
+
+	case "WatchlistItem.floorAlertBelow":
+		if e.complexity.WatchlistItem.FloorAlertBelow == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.FloorAlertBelow(childComplexity), true
+
+	case "WatchlistItem.floorAtAdd":
+		if e.complexity.WatchlistItem.FloorAtAdd == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.FloorAtAdd(childComplexity), true
+
+	case "WatchlistItem.floorDelta":
+		if e.complexity.WatchlistItem.FloorDelta == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.FloorDelta(childComplexity), true
+
+	case "WatchlistItem.id":
+		if e.complexity.WatchlistItem.ID == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.ID(childComplexity), true
+
+	case "WatchlistItem.targetType":
+		if e.complexity.WatchlistItem.TargetType == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.TargetType(childComplexity), true
+
+	case "WatchlistItem.tokenId":
+		if e.complexity.WatchlistItem.TokenID == nil {
+			break
+		}
+
+		return e.complexity.WatchlistItem.TokenID(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAddressProofInput,
+		ec.unmarshalInputAirdropRecipientInput,
+		ec.unmarshalInputBulkTransferItemInput,
+		ec.unmarshalInputBumpChainVersionInput,
+		ec.unmarshalInputCollectionFilterInput,
+		ec.unmarshalInputCollectionSortInput,
+		ec.unmarshalInputFlagItemInput,
+		ec.unmarshalInputPayoutSplitInput,
+		ec.unmarshalInputPrepareAirdropInput,
+		ec.unmarshalInputPrepareBulkTransferInput,
+		ec.unmarshalInputPrepareCreateCollectionInput,
+		ec.unmarshalInputPrepareMintInput,
+		ec.unmarshalInputPriceRangeInput,
+		ec.unmarshalInputResyncCollectionInput,
+		ec.unmarshalInputSearchFilterInput,
+		ec.unmarshalInputSignInSiweInput,
+		ec.unmarshalInputSubmitDropInput,
+		ec.unmarshalInputTokenFilterInput,
+		ec.unmarshalInputTokenSortInput,
+		ec.unmarshalInputTrackTxInput,
+		ec.unmarshalInputTraitFilterInput,
+		ec.unmarshalInputUnflagItemInput,
+		ec.unmarshalInputUpdateWalletInput,
+		ec.unmarshalInputUploadSingleFileInput,
+		ec.unmarshalInputVerifySiweInput,
+	)
+	first := true
+
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+//go:embed "auth.graphql" "base.graphql" "catalog.graphql" "chain-registry.graphql" "media.graphql" "orchestrator.graphql" "scalars.graphql" "user.graphql"
+var sourcesFS embed.FS
+
+func sourceData(filename string) string {
+	data, err := sourcesFS.ReadFile(filename)
+	if err != nil {
+		panic(fmt.Sprintf("codegen problem: %s not available", filename))
+	}
+	return string(data)
+}
+
+var sources = []*ast.Source{
+	{Name: "auth.graphql", Input: sourceData("auth.graphql"), BuiltIn: false},
+	{Name: "base.graphql", Input: sourceData("base.graphql"), BuiltIn: false},
+	{Name: "catalog.graphql", Input: sourceData("catalog.graphql"), BuiltIn: false},
+	{Name: "chain-registry.graphql", Input: sourceData("chain-registry.graphql"), BuiltIn: false},
+	{Name: "media.graphql", Input: sourceData("media.graphql"), BuiltIn: false},
+	{Name: "orchestrator.graphql", Input: sourceData("orchestrator.graphql"), BuiltIn: false},
+	{Name: "scalars.graphql", Input: sourceData("scalars.graphql"), BuiltIn: false},
+	{Name: "user.graphql", Input: sourceData("user.graphql"), BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -0,0 +1,87 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+)
+
+// SponsorshipBudgets lists the mint sponsorship budget of every chain
+func (r *OrchestratorQueryResolver) SponsorshipBudgets(ctx context.Context) ([]*schemas.SponsorshipBudget, error) {
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).ListSponsorshipBudgets(ctx, &orchestratorpb.ListSponsorshipBudgetsRequest{})
+	if err != nil {
+		return nil, mapImportError(err, "failed to list sponsorship budgets")
+	}
+
+	budgets := make([]*schemas.SponsorshipBudget, 0, len(resp.GetBudgets()))
+	for _, b := range resp.GetBudgets() {
+		budgets = append(budgets, utils.MapSponsorshipBudget(b))
+	}
+	return budgets, nil
+}
+
+func (r *OrchestratorMutationResolver) TopUpSponsorshipBudget(ctx context.Context, chainID string, amountGwei string, note *string) (*schemas.SponsorshipBudget, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := strconv.ParseInt(amountGwei, 10, 64)
+	if err != nil || amount <= 0 {
+		return nil, fmt.Errorf("amountGwei must be a positive 64-bit integer")
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).TopUpSponsorshipBudget(ctx, &orchestratorpb.TopUpSponsorshipBudgetRequest{
+		ChainId:    chainID,
+		AmountGwei: amount,
+		Note:       utils.PtrStr(note),
+		ActorId:    admin.UserID,
+	})
+	if err != nil {
+		return nil, mapImportError(err, "failed to top up sponsorship budget")
+	}
+	return utils.MapSponsorshipBudget(resp.GetBudget()), nil
+}
+
+func (r *OrchestratorMutationResolver) FreezeSponsorshipBudget(ctx context.Context, chainID string, reason string) (*schemas.SponsorshipBudget, error) {
+	return r.setSponsorshipBudgetFrozen(ctx, chainID, true, reason)
+}
+
+func (r *OrchestratorMutationResolver) UnfreezeSponsorshipBudget(ctx context.Context, chainID string) (*schemas.SponsorshipBudget, error) {
+	return r.setSponsorshipBudgetFrozen(ctx, chainID, false, "")
+}
+
+func (r *OrchestratorMutationResolver) setSponsorshipBudgetFrozen(ctx context.Context, chainID string, frozen bool, reason string) (*schemas.SponsorshipBudget, error) {
+	admin, err := middleware.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.orchestratorClient == nil || r.server.orchestratorClient.Client == nil {
+		return nil, fmt.Errorf("orchestrator service unavailable")
+	}
+
+	resp, err := (*r.server.orchestratorClient.Client).SetSponsorshipBudgetFrozen(ctx, &orchestratorpb.SetSponsorshipBudgetFrozenRequest{
+		ChainId: chainID,
+		Frozen:  frozen,
+		Reason:  reason,
+		ActorId: admin.UserID,
+	})
+	if err != nil {
+		return nil, mapImportError(err, "failed to update sponsorship budget")
+	}
+	return utils.MapSponsorshipBudget(resp.GetBudget()), nil
+}
@@ -11,11 +11,7 @@ import (
 	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
 )
 
-type SubscriptionResolver struct {
-	server *Resolver
-}
-
-func (r *SubscriptionResolver) OnIntentStatus(ctx context.Context, intentID string) (<-chan *schemas.IntentStatusPayload, error) {
+func (r *OrchestratorSubscriptionResolver) OnIntentStatus(ctx context.Context, intentID string) (<-chan *schemas.IntentStatusPayload, error) {
 	// Validate input
 	if intentID == "" {
 		return nil, fmt.Errorf("invalid intent ID")
@@ -38,7 +34,7 @@ func (r *SubscriptionResolver) OnIntentStatus(ctx context.Context, intentID stri
 }
 
 // onIntentStatusPolling implements the original polling-based subscription
-func (r *SubscriptionResolver) onIntentStatusPolling(ctx context.Context, intentID string) (<-chan *schemas.IntentStatusPayload, error) {
+func (r *OrchestratorSubscriptionResolver) onIntentStatusPolling(ctx context.Context, intentID string) (<-chan *schemas.IntentStatusPayload, error) {
 
 	// Create channel for streaming updates
 	statusChan := make(chan *schemas.IntentStatusPayload)
@@ -70,6 +66,7 @@ func (r *SubscriptionResolver) onIntentStatusPolling(ctx context.Context, intent
 			if resp.ContractAddress != "" {
 				payload.ContractAddress = &resp.ContractAddress
 			}
+			utils.SetIntentStatusDetails(payload, resp.Error, resp.Recovery)
 			return payload, nil
 		}
 
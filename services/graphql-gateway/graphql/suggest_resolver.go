@@ -0,0 +1,145 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+const (
+	// Shorter queries match too much to rank usefully; the services apply the same bounds
+	minSuggestQueryLength = 2
+	maxSuggestQueryLength = 64
+	defaultSuggestLimit   = 8
+	maxSuggestLimit       = 20
+
+	// Autocomplete fires on every keystroke and most prefixes repeat across users, so
+	// results are kept briefly per query and limit
+	suggestCacheSize = 10000
+	suggestCacheTTL  = 30 * time.Second
+
+	// suggestTimeout drops a slow source rather than holding up the other one
+	suggestTimeout = 150 * time.Millisecond
+)
+
+type suggestEntry struct {
+	suggestions []*schemas.Suggestion
+	expiresAt   time.Time
+}
+
+func newSuggestCache() *lru.LRU[suggestEntry] {
+	return lru.New[suggestEntry](suggestCacheSize)
+}
+
+// scoredSuggestion carries the score the catalog and user services rank by, which are
+// comparable across the two
+type scoredSuggestion struct {
+	suggestion *schemas.Suggestion
+	score      float64
+}
+
+// Suggest autocompletes collection names, usernames and token names, best match first.
+// It is public, so results are the same for every caller and cached by query.
+func (r *CatalogQueryResolver) Suggest(ctx context.Context, query string, limit *int) ([]*schemas.Suggestion, error) {
+	n := defaultSuggestLimit
+	if limit != nil {
+		n = *limit
+	}
+	if n <= 0 {
+		return nil, fmt.Errorf("limit must be positive")
+	}
+	if n > maxSuggestLimit {
+		n = maxSuggestLimit
+	}
+
+	query = normalizeSuggestQuery(query)
+	if utf8.RuneCountInString(query) < minSuggestQueryLength {
+		return []*schemas.Suggestion{}, nil
+	}
+	catalogReady := r.server.catalogClient != nil && r.server.catalogClient.Client != nil
+	userReady := r.server.userClient != nil && r.server.userClient.Client != nil
+	if !catalogReady && !userReady {
+		return nil, fmt.Errorf("search unavailable")
+	}
+
+	key := fmt.Sprintf("%d|%s", n, query)
+	if entry, ok := r.server.suggestCache.Get(ctx, key); ok && time.Now().Before(entry.expiresAt) {
+		return entry.suggestions, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, suggestTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var catalogResp *catalogpb.SuggestResponse
+	var userResp *userpb.SuggestUsersResponse
+	var catalogErr, userErr error
+	if catalogReady {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			catalogResp, catalogErr = (*r.server.catalogClient.Client).Suggest(ctx, &catalogpb.SuggestRequest{Query: query, Limit: int32(n)})
+		}()
+	}
+	if userReady {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			userResp, userErr = (*r.server.userClient.Client).SuggestUsers(ctx, &userpb.SuggestUsersRequest{Query: query, Limit: int32(n)})
+		}()
+	}
+	wg.Wait()
+
+	if catalogErr != nil && userErr != nil {
+		return nil, fmt.Errorf("failed to suggest: %w", catalogErr)
+	}
+	if catalogErr != nil {
+		log.Printf("Catalog suggest failed for %q: %v", query, catalogErr)
+	}
+	if userErr != nil {
+		log.Printf("User suggest failed for %q: %v", query, userErr)
+	}
+
+	scored := make([]scoredSuggestion, 0, len(catalogResp.GetSuggestions())+len(userResp.GetUsers()))
+	for _, s := range catalogResp.GetSuggestions() {
+		scored = append(scored, scoredSuggestion{suggestion: utils.MapCatalogSuggestion(s), score: s.GetScore()})
+	}
+	for _, u := range userResp.GetUsers() {
+		scored = append(scored, scoredSuggestion{suggestion: utils.MapUserSuggestion(u), score: u.GetScore()})
+	}
+	sort.SliceStable(scored, func(i, j int) bool { return scored[i].score > scored[j].score })
+	if len(scored) > n {
+		scored = scored[:n]
+	}
+
+	suggestions := make([]*schemas.Suggestion, len(scored))
+	for i, s := range scored {
+		suggestions[i] = s.suggestion
+	}
+	// A source that failed would leave a gap in the cached results until they expire
+	if catalogErr == nil && userErr == nil {
+		r.server.suggestCache.Add(ctx, key, suggestEntry{suggestions: suggestions, expiresAt: time.Now().Add(suggestCacheTTL)})
+	}
+	return suggestions, nil
+}
+
+// normalizeSuggestQuery lower-cases query, collapses its whitespace and caps its length,
+// so equivalent queries share a cache entry
+func normalizeSuggestQuery(query string) string {
+	query = strings.Join(strings.Fields(strings.ToLower(query)), " ")
+	if utf8.RuneCountInString(query) > maxSuggestQueryLength {
+		query = string([]rune(query)[:maxSuggestQueryLength])
+	}
+	return query
+}
@@ -0,0 +1,229 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserQueryResolver resolves the Query fields declared in user.graphql
+type UserQueryResolver struct {
+	server *Resolver
+}
+
+// UserMutationResolver resolves the Mutation fields declared in user.graphql
+type UserMutationResolver struct {
+	server *Resolver
+}
+
+// UserSubscriptionResolver resolves the Subscription fields declared in user.graphql
+type UserSubscriptionResolver struct {
+	server *Resolver
+}
+
+func (r *UserQueryResolver) MyEmail(ctx context.Context) (*schemas.EmailStatus, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).GetEmailStatus(ctx, &userpb.GetEmailStatusRequest{UserId: user.UserID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return utils.MapEmailStatus(resp.GetEmail()), nil
+}
+
+func (r *UserMutationResolver) StartEmailVerification(ctx context.Context, email string) (string, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return "", fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).StartEmailVerification(ctx, &userpb.StartEmailVerificationRequest{
+		UserId: user.UserID,
+		Email:  email,
+	})
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return "", fmt.Errorf("email is already linked to another account")
+		}
+		return "", err
+	}
+	return resp.GetExpiresAt(), nil
+}
+
+func (r *UserMutationResolver) ConfirmEmail(ctx context.Context, code string) (*schemas.EmailStatus, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).ConfirmEmail(ctx, &userpb.ConfirmEmailRequest{
+		UserId: user.UserID,
+		Code:   code,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.AlreadyExists:
+			return nil, fmt.Errorf("email is already linked to another account")
+		case codes.ResourceExhausted:
+			return nil, fmt.Errorf("too many attempts, please request a new code")
+		}
+		return nil, err
+	}
+	return utils.MapEmailStatus(resp.GetEmail()), nil
+}
+
+func (r *UserMutationResolver) SetEmailDigestOptOut(ctx context.Context, optOut bool) (*schemas.EmailStatus, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).SetEmailDigestOptOut(ctx, &userpb.SetEmailDigestOptOutRequest{
+		UserId: user.UserID,
+		OptOut: optOut,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapEmailStatus(resp.GetEmail()), nil
+}
+
+func (r *UserMutationResolver) SetEmailOptOut(ctx context.Context, kind schemas.EmailKind, optOut bool) (*schemas.EmailStatus, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).SetEmailOptOut(ctx, &userpb.SetEmailOptOutRequest{
+		UserId: user.UserID,
+		Kind:   string(kind),
+		OptOut: optOut,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapEmailStatus(resp.GetEmail()), nil
+}
+
+// UnsubscribeEmail needs no session: the token signs the user and the kind of email
+func (r *UserMutationResolver) UnsubscribeEmail(ctx context.Context, token string) (*schemas.EmailUnsubscribeResult, error) {
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).UnsubscribeEmail(ctx, &userpb.UnsubscribeEmailRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return &schemas.EmailUnsubscribeResult{
+		Kind:  schemas.EmailKind(resp.GetKind()),
+		Email: utils.MapEmailStatus(resp.GetEmail()),
+	}, nil
+}
+
+func (r *UserQueryResolver) ViewerPreferences(ctx context.Context) (*schemas.ViewerPreferences, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).GetPreferences(ctx, &userpb.GetPreferencesRequest{UserId: user.UserID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return utils.MapViewerPreferences(resp.GetPreferences()), nil
+}
+
+func (r *UserMutationResolver) UpdateViewerPreferences(ctx context.Context, locale *string, timezone *string, currency *string, honorDelegations *bool) (*schemas.ViewerPreferences, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.userClient == nil || r.server.userClient.Client == nil {
+		return nil, fmt.Errorf("user service unavailable")
+	}
+
+	resp, err := (*r.server.userClient.Client).UpdatePreferences(ctx, &userpb.UpdatePreferencesRequest{
+		UserId:           user.UserID,
+		Locale:           utils.PtrStr(locale),
+		Timezone:         utils.PtrStr(timezone),
+		Currency:         utils.PtrStr(currency),
+		HonorDelegations: honorDelegations,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%s", status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return utils.MapViewerPreferences(resp.GetPreferences()), nil
+}
+
+// MyAccountEvents streams the signed-in user's wallet, profile and session events, relayed
+// by the subscription worker so every open tab and device stays in sync
+func (r *UserSubscriptionResolver) MyAccountEvents(ctx context.Context) (<-chan *schemas.AccountEvent, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.websocketClient == nil || !r.server.websocketClient.IsConnected() {
+		return nil, fmt.Errorf("account events unavailable")
+	}
+
+	// The channel is never closed: a callback may still be running after cancel, and
+	// gqlgen ends the subscription on ctx.Done
+	events := make(chan *schemas.AccountEvent, 10)
+	cancel, err := r.server.websocketClient.SubscribeAccountEvents(user.UserID, func(event *contracts.AccountEvent) {
+		select {
+		case events <- utils.MapAccountEvent(event):
+		case <-ctx.Done():
+		default:
+			log.Printf("Channel full, dropping %s event for user %s", event.Type, user.UserID)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	return events, nil
+}
@@ -0,0 +1,139 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (r *UserQueryResolver) MyWallets(ctx context.Context, watchOnly *bool) ([]*schemas.LinkedWallet, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	resp, err := (*r.server.walletClient.Client).ListLinks(ctx, &walletpb.ListLinksRequest{UserId: user.UserID})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*schemas.LinkedWallet, 0, len(resp.GetLinks()))
+	for _, link := range resp.GetLinks() {
+		if watchOnly != nil && link.GetIsWatchOnly() != *watchOnly {
+			continue
+		}
+		out = append(out, utils.MapLinkedWallet(link))
+	}
+	return out, nil
+}
+
+func (r *UserMutationResolver) AddWatchOnlyWallet(ctx context.Context, address string, chainID string, label *string, tags []string) (*schemas.LinkedWallet, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	resp, err := (*r.server.walletClient.Client).AddWatchOnlyWallet(ctx, &walletpb.AddWatchOnlyWalletRequest{
+		UserId:  user.UserID,
+		Address: address,
+		ChainId: chainID,
+		Label:   utils.PtrStr(label),
+		Tags:    tags,
+	})
+	if err != nil {
+		return nil, mapWalletError(err)
+	}
+	return utils.MapLinkedWallet(resp.GetLink()), nil
+}
+
+func (r *UserMutationResolver) UpdateWallet(ctx context.Context, input schemas.UpdateWalletInput) (*schemas.LinkedWallet, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	resp, err := (*r.server.walletClient.Client).UpdateWalletDetails(ctx, &walletpb.UpdateWalletDetailsRequest{
+		UserId:      user.UserID,
+		WalletId:    input.WalletID,
+		Label:       input.Label,
+		Tags:        input.Tags,
+		ReplaceTags: input.Tags != nil,
+	})
+	if err != nil {
+		return nil, mapWalletError(err)
+	}
+	return utils.MapLinkedWallet(resp.GetLink()), nil
+}
+
+// CreateAddressChallenge issues a challenge for the caller to sign with address; only the
+// feature named by purpose accepts it, and only from the caller
+func (r *UserMutationResolver) CreateAddressChallenge(ctx context.Context, purpose schemas.AddressChallengePurpose, address string, chainID string, scheme *schemas.SignatureScheme) (*schemas.AddressChallenge, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.walletClient == nil || r.server.walletClient.Client == nil {
+		return nil, fmt.Errorf("wallet service unavailable")
+	}
+
+	req := &walletpb.CreateSignatureChallengeRequest{
+		Purpose: string(purpose),
+		Address: address,
+		ChainId: chainID,
+		UserId:  user.UserID,
+	}
+	if scheme != nil && *scheme == schemas.SignatureSchemeEip712 {
+		req.Scheme = walletpb.SignatureScheme_SIGNATURE_SCHEME_EIP712
+	}
+	resp, err := (*r.server.walletClient.Client).CreateSignatureChallenge(ctx, req)
+	if err != nil {
+		return nil, mapWalletError(err)
+	}
+	return utils.MapAddressChallenge(resp), nil
+}
+
+// proveAddress verifies a signed address challenge created for purpose by the user and
+// returns the address it proves
+func (r *Resolver) proveAddress(ctx context.Context, userID string, purpose schemas.AddressChallengePurpose, proof *schemas.AddressProofInput) (string, error) {
+	resp, err := (*r.walletClient.Client).VerifySignatureChallenge(ctx, &walletpb.VerifySignatureChallengeRequest{
+		ChallengeId: proof.ChallengeID,
+		Signature:   proof.Signature,
+		Purpose:     string(purpose),
+		UserId:      userID,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return resp.GetAddress(), nil
+	case codes.NotFound:
+		return "", fmt.Errorf("address challenge expired or already used")
+	case codes.InvalidArgument, codes.PermissionDenied:
+		return "", fmt.Errorf("address proof rejected: %s", status.Convert(err).Message())
+	}
+	return "", err
+}
+
+func mapWalletError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return fmt.Errorf("wallet not found")
+	case codes.AlreadyExists:
+		return fmt.Errorf("wallet is already on your account")
+	case codes.InvalidArgument:
+		return fmt.Errorf("%s", status.Convert(err).Message())
+	}
+	return err
+}
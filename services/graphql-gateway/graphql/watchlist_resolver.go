@@ -0,0 +1,145 @@
+package graphql_resolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MyWatchlist returns the user's favorites with floor deltas computed against the
+// current collection floor, and their saved searches
+func (r *CatalogQueryResolver) MyWatchlist(ctx context.Context) (*schemas.Watchlist, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	resp, err := (*r.server.catalogClient.Client).GetWatchlist(ctx, &catalogpb.GetWatchlistRequest{UserId: user.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &schemas.Watchlist{
+		Items:         make([]*schemas.WatchlistItem, 0, len(resp.GetItems())),
+		SavedSearches: make([]*schemas.SavedSearch, 0, len(resp.GetSavedSearches())),
+	}
+	for _, item := range resp.GetItems() {
+		out.Items = append(out.Items, utils.MapWatchlistItem(item))
+	}
+	for _, search := range resp.GetSavedSearches() {
+		out.SavedSearches = append(out.SavedSearches, utils.MapSavedSearch(search))
+	}
+	return out, nil
+}
+
+func (r *CatalogMutationResolver) FavoriteCollection(ctx context.Context, chainID string, contract string, floorAlertBelow *string) (*schemas.WatchlistItem, error) {
+	return r.favorite(ctx, &catalogpb.FavoriteRequest{
+		TargetType:      string(schemas.WatchTargetTypeCollection),
+		ChainId:         chainID,
+		ContractAddress: contract,
+		FloorAlertBelow: utils.PtrStr(floorAlertBelow),
+	})
+}
+
+func (r *CatalogMutationResolver) FavoriteToken(ctx context.Context, chainID string, contract string, tokenID string, floorAlertBelow *string) (*schemas.WatchlistItem, error) {
+	return r.favorite(ctx, &catalogpb.FavoriteRequest{
+		TargetType:      string(schemas.WatchTargetTypeToken),
+		ChainId:         chainID,
+		ContractAddress: contract,
+		TokenId:         tokenID,
+		FloorAlertBelow: utils.PtrStr(floorAlertBelow),
+	})
+}
+
+func (r *CatalogMutationResolver) favorite(ctx context.Context, req *catalogpb.FavoriteRequest) (*schemas.WatchlistItem, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	req.UserId = user.UserID
+	resp, err := (*r.server.catalogClient.Client).Favorite(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("collection not found")
+		}
+		return nil, err
+	}
+	return utils.MapWatchlistItem(resp.GetItem()), nil
+}
+
+func (r *CatalogMutationResolver) Unfavorite(ctx context.Context, id string) (bool, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return false, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return false, fmt.Errorf("catalog service unavailable")
+	}
+
+	_, err = (*r.server.catalogClient.Client).RemoveFavorite(ctx, &catalogpb.RemoveFavoriteRequest{UserId: user.UserID, Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *CatalogMutationResolver) SaveSearch(ctx context.Context, query string, filters []*schemas.SearchFilterInput, name *string) (*schemas.SavedSearch, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return nil, fmt.Errorf("catalog service unavailable")
+	}
+
+	filterMap := make(map[string]string, len(filters))
+	for _, f := range filters {
+		filterMap[f.Key] = f.Value
+	}
+
+	resp, err := (*r.server.catalogClient.Client).SaveSearch(ctx, &catalogpb.SaveSearchRequest{
+		UserId:  user.UserID,
+		Name:    utils.PtrStr(name),
+		Query:   query,
+		Filters: filterMap,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.MapSavedSearch(resp.GetSearch()), nil
+}
+
+func (r *CatalogMutationResolver) DeleteSavedSearch(ctx context.Context, id string) (bool, error) {
+	user, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return false, err
+	}
+	if r.server.catalogClient == nil || r.server.catalogClient.Client == nil {
+		return false, fmt.Errorf("catalog service unavailable")
+	}
+
+	_, err = (*r.server.catalogClient.Client).DeleteSavedSearch(ctx, &catalogpb.DeleteSavedSearchRequest{UserId: user.UserID, Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -125,11 +125,22 @@ func (r *WebSocketSubscriptionResolver) OnIntentStatus(ctx context.Context, inte
 				payload.ContractAddress = &data.ContractAddress
 			}
 
-			// Extract kind from data if available
+			// Extract kind and recovery from data if available
+			recovery := ""
 			if dataMap, ok := data.Data.(map[string]interface{}); ok {
 				if kind, exists := dataMap["kind"].(string); exists {
 					payload.Kind = kind
 				}
+				recovery, _ = dataMap["recovery"].(string)
+			}
+			utils.SetIntentStatusDetails(payload, data.Error, recovery)
+			if !data.UpdatedAt.IsZero() {
+				updatedAt := data.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+				payload.UpdatedAt = &updatedAt
+			}
+			if data.StalenessMs != nil {
+				staleness := int(*data.StalenessMs)
+				payload.StalenessMs = &staleness
 			}
 
 			// Check for changes to avoid duplicate notifications
@@ -204,6 +215,7 @@ func (r *WebSocketSubscriptionResolver) fetchCurrentStatus(ctx context.Context,
 	if resp.ContractAddress != "" {
 		payload.ContractAddress = &resp.ContractAddress
 	}
+	utils.SetIntentStatusDetails(payload, resp.Error, resp.Recovery)
 
 	return payload, nil
 }
@@ -223,6 +235,12 @@ func (r *WebSocketSubscriptionResolver) payloadsEqual(a, b *schemas.IntentStatus
 	if utils.PtrStr(a.ContractAddress) != utils.PtrStr(b.ContractAddress) {
 		return false
 	}
+	if utils.PtrStr(a.Error) != utils.PtrStr(b.Error) {
+		return false
+	}
+	if (a.Recovery == nil) != (b.Recovery == nil) || (a.Recovery != nil && *a.Recovery != *b.Recovery) {
+		return false
+	}
 
 	return true
 }
@@ -0,0 +1,24 @@
+package grpcclients
+
+import (
+	"log"
+
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type CatalogClient struct {
+	Client *catalogpb.CatalogServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewCatalogClient(url string) *CatalogClient {
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	conn, err := grpc.Dial(url, dialOptions...)
+	if err != nil {
+		log.Fatalf("failed to dial catalog service: %v", err)
+	}
+	client := catalogpb.NewCatalogServiceClient(conn)
+	return &CatalogClient{Client: &client, conn: conn}
+}
@@ -0,0 +1,33 @@
+package grpcclients
+
+import healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+// Health clients check each service's readiness over the connection its calls use
+
+func (c *AuthClient) Health() healthpb.HealthClient {
+	return healthpb.NewHealthClient(c.conn)
+}
+
+func (c *CatalogClient) Health() healthpb.HealthClient {
+	return healthpb.NewHealthClient(c.conn)
+}
+
+func (c *ChainRegistryClient) Health() healthpb.HealthClient {
+	return healthpb.NewHealthClient(c.conn)
+}
+
+func (c *MediaClient) Health() healthpb.HealthClient {
+	return healthpb.NewHealthClient(c.conn)
+}
+
+func (c *OrchestratorClient) Health() healthpb.HealthClient {
+	return healthpb.NewHealthClient(c.conn)
+}
+
+func (c *UserClient) Health() healthpb.HealthClient {
+	return healthpb.NewHealthClient(c.conn)
+}
+
+func (c *WalletClient) Health() healthpb.HealthClient {
+	return healthpb.NewHealthClient(c.conn)
+}
@@ -1,11 +1,14 @@
 package grpcclients
 
 import (
+	"context"
 	"log"
 
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
 	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type OrchestratorClient struct {
@@ -16,6 +19,7 @@ type OrchestratorClient struct {
 func NewOrchestratorClient(url string) *OrchestratorClient {
 	dialOptions := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(forwardSessionID),
 	}
 	conn, err := grpc.Dial(url, dialOptions...)
 	if err != nil {
@@ -29,3 +33,12 @@ func NewOrchestratorClient(url string) *OrchestratorClient {
 		conn:   conn,
 	}
 }
+
+// forwardSessionID passes the caller's auth session on, so the orchestrator can link the
+// intents it prepares to the session that asked for them
+func forwardSessionID(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if sessionID := middleware.GetSessionID(ctx); sessionID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-auth-session-id", sessionID)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
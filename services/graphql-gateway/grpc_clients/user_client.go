@@ -0,0 +1,24 @@
+package grpcclients
+
+import (
+	"log"
+
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type UserClient struct {
+	Client *userpb.UserServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewUserClient(url string) *UserClient {
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	conn, err := grpc.Dial(url, dialOptions...)
+	if err != nil {
+		log.Fatalf("failed to dial user service: %v", err)
+	}
+	client := userpb.NewUserServiceClient(conn)
+	return &UserClient{Client: &client, conn: conn}
+}
@@ -0,0 +1,355 @@
+// Package imageproxy serves media assets resized on the fly at /img/{assetId}?w=...&fm=...,
+// so avatars and collection cards don't need every variant size computed at upload.
+// Responses are immutable per asset, width and format and carry long-lived cache headers
+// for the CDN in front of the gateway, except JPEG/PNG stand-ins for a WebP rendition that
+// doesn't exist yet.
+package imageproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // decode GIF uploads
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Route is the path prefix the handler is mounted on
+const Route = "/img/"
+
+const (
+	// immutableCache lets browsers and the CDN keep a rendition for a year; an asset id
+	// always names the same bytes, so a rendition never changes
+	immutableCache = "public, max-age=31536000, immutable"
+
+	// fallbackCache is for a JPEG or PNG served where the client negotiated WebP but no
+	// WebP rendition exists at the width yet; it expires soon so the CDN picks up the
+	// rendition once the media service computes it
+	fallbackCache = "public, max-age=300"
+
+	// missCache keeps unknown assets from hammering the media service without pinning
+	// the miss for long, since the asset may just not be pinned yet
+	missCache = "public, max-age=60"
+
+	// maxSourceBytes bounds the original fetched from the IPFS gateway
+	maxSourceBytes = 20 << 20
+
+	// maxSourcePixels rejects decompression bombs before decoding them
+	maxSourcePixels = 40_000_000
+
+	// maxConcurrentResizes bounds the CPU spent on resizing at once
+	maxConcurrentResizes = 8
+
+	jpegQuality = 82
+)
+
+// Widths are the renditions served; a requested width is rounded up to the next one so the
+// CDN caches a handful of sizes per asset instead of one per pixel
+var Widths = []int{64, 128, 256, 384, 512, 768, 1024, 1536, 2048}
+
+// Output formats accepted in fm
+const (
+	FormatWebP = "webp"
+	FormatJPEG = "jpg"
+	FormatPNG  = "png"
+)
+
+var contentTypes = map[string]string{
+	FormatWebP: "image/webp",
+	FormatJPEG: "image/jpeg",
+	FormatPNG:  "image/png",
+}
+
+// Handler serves resized renditions of media assets
+type Handler struct {
+	media      media.MediaServiceClient
+	httpClient *http.Client
+	resizes    chan struct{}
+}
+
+// NewHandler creates an image proxy reading assets from the media service
+func NewHandler(mediaClient media.MediaServiceClient) *Handler {
+	return &Handler{
+		media:      mediaClient,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+		resizes:    make(chan struct{}, maxConcurrentResizes),
+	}
+}
+
+// WithHTTPClient replaces the client used to fetch originals from the IPFS gateway
+func (h *Handler) WithHTTPClient(client *http.Client) *Handler {
+	h.httpClient = client
+	return h
+}
+
+// Request is a parsed /img request
+type Request struct {
+	AssetID string
+	Width   int    // 0 keeps the original width
+	Format  string // "" picks from the Accept header
+}
+
+// ParseRequest reads the asset id from the path and w and fm from the query
+func ParseRequest(r *http.Request) (Request, error) {
+	req := Request{AssetID: strings.Trim(strings.TrimPrefix(r.URL.Path, Route), "/")}
+	if req.AssetID == "" || strings.Contains(req.AssetID, "/") {
+		return req, errors.New("asset id is required")
+	}
+
+	query := r.URL.Query()
+	if w := query.Get("w"); w != "" {
+		width, err := strconv.Atoi(w)
+		if err != nil || width <= 0 {
+			return req, fmt.Errorf("invalid width %q", w)
+		}
+		req.Width = SnapWidth(width)
+	}
+
+	switch fm := strings.ToLower(query.Get("fm")); fm {
+	case "", "auto":
+		req.Format = ""
+	case "jpeg", FormatJPEG:
+		req.Format = FormatJPEG
+	case FormatWebP, FormatPNG:
+		req.Format = fm
+	default:
+		return req, fmt.Errorf("unsupported format %q", fm)
+	}
+	return req, nil
+}
+
+// SnapWidth rounds width up to the next served rendition, capped at the largest
+func SnapWidth(width int) int {
+	for _, w := range Widths {
+		if width <= w {
+			return w
+		}
+	}
+	return Widths[len(Widths)-1]
+}
+
+// ETag identifies a rendition: the original's hash plus the width and format it was rendered at
+func ETag(sha string, width int, format string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%s", sha, width, format)))
+	return `"` + hex.EncodeToString(sum[:12]) + `"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req, err := ParseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.media.GetAsset(r.Context(), &media.GetAssetRequest{Id: req.AssetID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound || status.Code(err) == codes.InvalidArgument {
+			w.Header().Set("Cache-Control", missCache)
+			http.Error(w, "asset not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("image proxy: failed to get asset %s: %v", req.AssetID, err)
+		http.Error(w, "failed to load asset", http.StatusBadGateway)
+		return
+	}
+	asset := resp.GetAsset()
+	if asset == nil || !strings.HasPrefix(asset.GetMime(), "image/") {
+		w.Header().Set("Cache-Control", missCache)
+		http.Error(w, "asset is not an image", http.StatusNotFound)
+		return
+	}
+
+	// Without an explicit fm the format follows Accept, so caches must key on it
+	format := req.Format
+	if format == "" {
+		w.Header().Set("Vary", "Accept")
+		format = negotiateFormat(r.Header.Get("Accept"), asset.GetMime())
+	}
+
+	// The proxy can't encode WebP, so it is only served from a rendition computed at upload
+	// at exactly the requested width. An explicit fm=webp without one is refused; negotiated
+	// WebP falls back to a format the proxy encodes, cached briefly since Vary: Accept
+	// already keys it apart from a WebP response.
+	cacheControl := immutableCache
+	variant := webpVariant(asset, req.Width)
+	if format == FormatWebP && variant == nil {
+		if req.Format == FormatWebP {
+			w.Header().Set("Cache-Control", missCache)
+			http.Error(w, "no webp rendition at this width", http.StatusNotAcceptable)
+			return
+		}
+		format = fallbackFormat(asset.GetMime())
+		cacheControl = fallbackCache
+	}
+
+	etag := ETag(asset.GetSha256(), req.Width, format)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	var body []byte
+	if format == FormatWebP {
+		body, err = h.fetch(r.Context(), variant.GetCdnUrl())
+	} else {
+		body, err = h.render(r.Context(), asset, req.Width, format)
+	}
+	if err != nil {
+		w.Header().Del("ETag")
+		w.Header().Set("Cache-Control", "no-store")
+		if errors.Is(err, errUnsupportedSource) {
+			http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+			return
+		}
+		log.Printf("image proxy: failed to render asset %s: %v", req.AssetID, err)
+		http.Error(w, "failed to render image", http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypes[format])
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		w.Write(body)
+	}
+}
+
+var errUnsupportedSource = errors.New("unsupported source image")
+
+// render fetches the original and encodes it at width in format
+func (h *Handler) render(ctx context.Context, asset *media.Asset, width int, format string) ([]byte, error) {
+	if asset.GetGatewayUrl() == nil || asset.GetGatewayUrl().GetValue() == "" {
+		return nil, fmt.Errorf("asset %s has no gateway url", asset.GetId())
+	}
+	original, err := h.fetch(ctx, asset.GetGatewayUrl().GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(original))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUnsupportedSource, err)
+	}
+	if config.Width*config.Height > maxSourcePixels {
+		return nil, fmt.Errorf("%w: %dx%d is too large", errUnsupportedSource, config.Width, config.Height)
+	}
+
+	select {
+	case h.resizes <- struct{}{}:
+		defer func() { <-h.resizes }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(original))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUnsupportedSource, err)
+	}
+	img = Resize(img, width)
+
+	var out bytes.Buffer
+	switch format {
+	case FormatPNG:
+		err = png.Encode(&out, img)
+	default:
+		err = jpeg.Encode(&out, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", format, err)
+	}
+	return out.Bytes(), nil
+}
+
+func (h *Handler) fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source url: %w", err)
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch source: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("source returned %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSourceBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source: %w", err)
+	}
+	if len(body) > maxSourceBytes {
+		return nil, fmt.Errorf("%w: larger than %d bytes", errUnsupportedSource, maxSourceBytes)
+	}
+	return body, nil
+}
+
+// webpVariant picks the WebP rendition exactly width wide, or the widest one when width is
+// 0; a rendition of another width would be cached as if it were this one
+func webpVariant(asset *media.Asset, width int) *media.MediaVariant {
+	var widest *media.MediaVariant
+	for _, v := range asset.GetVariants() {
+		if v.GetFormat() != media.VariantFormat_WEBP || v.GetCdnUrl() == "" {
+			continue
+		}
+		if width > 0 && int(v.GetWidth()) == width {
+			return v
+		}
+		if widest == nil || v.GetWidth() > widest.GetWidth() {
+			widest = v
+		}
+	}
+	if width > 0 {
+		return nil
+	}
+	return widest
+}
+
+// negotiateFormat prefers WebP when the client accepts it and otherwise keeps PNG sources
+// as PNG, for their transparency, and everything else as JPEG
+func negotiateFormat(accept, mime string) string {
+	if strings.Contains(accept, "image/webp") {
+		return FormatWebP
+	}
+	return fallbackFormat(mime)
+}
+
+func fallbackFormat(mime string) string {
+	if mime == "image/png" || mime == "image/gif" {
+		return FormatPNG
+	}
+	return FormatJPEG
+}
+
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package imageproxy
+
+import (
+	"image"
+	"image/color"
+)
+
+// Resize scales src down to width, keeping its aspect ratio. Each output pixel averages the
+// source pixels it covers (box filter), which is what downscaling avatars and cards needs;
+// images are never scaled up.
+func Resize(src image.Image, width int) image.Image {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if width <= 0 || width >= srcW || srcH == 0 {
+		return src
+	}
+	height := (srcH*width + srcW/2) / srcW
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*srcH/height
+		y1 := bounds.Min.Y + (y+1)*srcH/height
+		if y1 == y0 {
+			y1++
+		}
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*srcW/width
+			x1 := bounds.Min.X + (x+1)*srcW/width
+			if x1 == x0 {
+				x1++
+			}
+
+			// Sum premultiplied channels so transparent pixels don't bleed their color
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			avg := color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)}
+			dst.Set(x, y, avg)
+		}
+	}
+	return dst
+}
@@ -1,17 +1,33 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/activity"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/artifacts"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/config"
 	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
 	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/imageproxy"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/opmetrics"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/persisted"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/platformstatus"
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/websocket"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+	"github.com/vektah/gqlparser/v2/ast"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
@@ -19,6 +35,12 @@ func main() {
 	cfg := config.LoadConfig()
 	cfg.Validate()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("graphql-gateway"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	log.Printf("Starting GraphQL Gateway with auth: %s, user: %s, wallet: %s, media: %s, chain-registry: %s, orchestrator: %s",
 		cfg.AuthServiceURL, cfg.UserServiceURL, cfg.WalletServiceURL, cfg.MediaServiceURL, cfg.ChainRegistryServiceURL, cfg.OrchestratorServiceURL)
 
@@ -28,12 +50,18 @@ func main() {
 		mediaClient         *grpcclients.MediaClient
 		chainRegistryClient *grpcclients.ChainRegistryClient
 		orchestratorClient  *grpcclients.OrchestratorClient
+		catalogClient       *grpcclients.CatalogClient
+		userClient          *grpcclients.UserClient
 	)
 
 	if cfg.AuthServiceURL != "" {
 		authClient = grpcclients.NewAuthClient(cfg.AuthServiceURL)
 	}
 
+	if cfg.UserServiceURL != "" {
+		userClient = grpcclients.NewUserClient(cfg.UserServiceURL)
+	}
+
 	if cfg.WalletServiceURL != "" {
 		walletClient = grpcclients.NewWalletClient(cfg.WalletServiceURL)
 	}
@@ -50,6 +78,10 @@ func main() {
 		orchestratorClient = grpcclients.NewOrchestratorClient(cfg.OrchestratorServiceURL)
 	}
 
+	if cfg.CatalogServiceURL != "" {
+		catalogClient = grpcclients.NewCatalogClient(cfg.CatalogServiceURL)
+	}
+
 	// Initialize WebSocket client for subscription worker
 	var wsClient *websocket.Client
 	if cfg.SubscriptionWorkerWSURL != "" {
@@ -62,31 +94,136 @@ func main() {
 		}
 	}
 
-	resolver := graphql_resolver.NewResolver(authClient, walletClient, mediaClient).WithChainRegistryClient(chainRegistryClient).WithOrchestratorClient(orchestratorClient)
+	cookiePolicy, err := middleware.LoadCookiePolicy()
+	if err != nil {
+		log.Fatalf("Invalid refresh cookie configuration: %v", err)
+	}
+	middleware.UseCookiePolicy(cookiePolicy)
+
+	resolver := graphql_resolver.NewResolver(authClient, walletClient, mediaClient).WithChainRegistryClient(chainRegistryClient).WithOrchestratorClient(orchestratorClient).WithCatalogClient(catalogClient).WithUserClient(userClient)
+
+	// Public platform status, checked over the clients' connections
+	statusSources := platformstatus.Sources{Services: map[string]healthpb.HealthClient{}}
+	if authClient != nil {
+		statusSources.Services["auth"] = authClient.Health()
+	}
+	if userClient != nil {
+		statusSources.Services["user"] = userClient.Health()
+	}
+	if walletClient != nil {
+		statusSources.Services["wallet"] = walletClient.Health()
+	}
+	if mediaClient != nil {
+		statusSources.Services["media"] = mediaClient.Health()
+		statusSources.Media = *mediaClient.Client
+	}
+	if chainRegistryClient != nil {
+		statusSources.Services["chain-registry"] = chainRegistryClient.Health()
+		statusSources.ChainRegistry = *chainRegistryClient.Client
+	}
+	if orchestratorClient != nil {
+		statusSources.Services["orchestrator"] = orchestratorClient.Health()
+	}
+	if catalogClient != nil {
+		statusSources.Services["catalog"] = catalogClient.Health()
+		statusSources.Catalog = *catalogClient.Client
+	}
+	resolver = resolver.WithPlatformStatus(platformstatus.NewAggregator(statusSources, platformstatus.Options{
+		Chains:       cfg.StatusChains,
+		TTL:          cfg.StatusTTL,
+		QueueBacklog: cfg.StatusQueueBacklog,
+	}))
 
 	// Connect WebSocket client if available
 	if wsClient != nil {
 		resolver = resolver.WithWebSocketClient(wsClient)
 	}
-	// TODO: pass collectionClient into resolver once gql schema/resolvers are added
 	es := schemas.NewExecutableSchema(schemas.Config{Resolvers: resolver})
 
-	// Create GraphQL handler with middleware chain
-	graphqlHandler := handler.NewDefaultServer(es)
-
-	// Apply middleware chain: Auth -> Cookie -> GraphQL
-	middlewareChain := middleware.CreateAuthMiddleware()(
-		middleware.CookieMiddleware(graphqlHandler),
-	)
+	// Create GraphQL handler with middleware chain. Same setup as handler.NewDefaultServer,
+	// plus connection_init auth for subscriptions from browsers.
+	graphqlHandler := handler.New(es)
+	graphqlHandler.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              middleware.CreateWebsocketInitFunc(),
+	})
+	graphqlHandler.AddTransport(transport.Options{})
+	graphqlHandler.AddTransport(transport.GET{})
+	graphqlHandler.AddTransport(transport.POST{})
+	graphqlHandler.AddTransport(transport.MultipartForm{})
+	graphqlHandler.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	// Production runs only the operations the frontend build shipped; ad-hoc queries,
+	// introspection and the playground stay for development
+	var allowlist *persisted.Allowlist
+	if cfg.OperationMode == config.OperationModeAllowlist {
+		allowlist, err = persisted.NewAllowlist(cfg.PersistedManifestPath)
+		if err != nil {
+			log.Fatalf("Failed to load persisted operations: %v", err)
+		}
+		graphqlHandler.Use(allowlist)
+	} else {
+		graphqlHandler.Use(extension.Introspection{})
+		graphqlHandler.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](100)})
+	}
+	graphqlHandler.AroundRootFields(middleware.ImpersonationGuard)
+	// Operation and resolver costs, to find the queries loading the services behind
+	opRecorder := opmetrics.NewRecorder(opmetrics.Options{
+		LogOperations:  cfg.LogOperations,
+		SlowThreshold:  cfg.SlowQueryThreshold,
+		SlowSampleRate: cfg.SlowQuerySampleRate,
+	})
+	graphqlHandler.Use(opRecorder)
+	// Operations of signed-in users keep their wallets' last seen and daily activity current
+	if walletClient != nil {
+		graphqlHandler.AroundOperations(activity.NewTracker(*walletClient.Client, cfg.WalletSeenInterval).AroundOperations)
+	}
+	graphqlHandler.SetErrorPresenter(middleware.PresentError)
+	// gqlgen recovers resolver panics itself, so report them before the default handling
+	graphqlHandler.SetRecoverFunc(func(ctx context.Context, err interface{}) error {
+		monitoring.CaptureError(ctx, fmt.Errorf("resolver panic: %v", err))
+		return graphql.DefaultRecover(ctx, err)
+	})
+
+	// Apply middleware chain: Monitoring -> Auth -> API key -> Cookie -> GraphQL
+	var apiHandler http.Handler = middleware.CookieMiddleware(graphqlHandler)
+	if authClient != nil {
+		apiHandler = middleware.CreateAPIKeyMiddleware(*authClient.Client)(apiHandler)
+	}
+	middlewareChain := monitoring.HTTPMiddleware(middleware.CreateAuthMiddleware()(apiHandler))
 
 	http.Handle("/graphql", middlewareChain)
-	http.Handle("/playground", playground.Handler("GraphQL playground", "/graphql"))
+	// Resized avatars and collection cards; public and cacheable, so outside the auth chain
+	http.Handle(imageproxy.Route, monitoring.HTTPMiddleware(imageproxy.NewHandler(*mediaClient.Client)))
+	// Export downloads are authorized by their signed link rather than a session
+	http.Handle(artifacts.Route, monitoring.HTTPMiddleware(artifacts.NewHandler(*mediaClient.Client)))
+	if allowlist != nil {
+		http.Handle(persisted.ReloadRoute, monitoring.HTTPMiddleware(middleware.CreateAuthMiddleware()(persisted.NewReloadHandler(allowlist))))
+	} else {
+		http.Handle("/playground", playground.Handler("GraphQL playground", "/graphql"))
+	}
 	http.Handle("/health", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	}))
 
-	log.Printf("GraphQL server running at %s/playground", cfg.HTTPAddr)
+	// Metrics stay off the public listener
+	if cfg.MetricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle(opmetrics.Route, opRecorder)
+		metricsServer := &http.Server{
+			Addr:              cfg.MetricsAddr,
+			Handler:           metricsMux,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+		go func() {
+			log.Printf("Metrics endpoint listening on %s", cfg.MetricsAddr)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Printf("Metrics endpoint stopped: %v", err)
+			}
+		}()
+	}
+
+	log.Printf("GraphQL server running at %s (operation mode %s)", cfg.HTTPAddr, cfg.OperationMode)
 
 	log.Fatal(http.ListenAndServe(cfg.HTTPAddr, nil))
 }
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+// APIKeyHeader carries a developer API key in place of a Bearer token
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuthorizer counts a request made with an API key against its plan
+type APIKeyAuthorizer func(ctx context.Context, key string) (*authpb.AuthorizeApiKeyResponse, error)
+
+// APIKeyMiddleware authenticates requests carrying an API key as the key's user. A valid
+// Bearer token wins and the key is not counted. Every counted request gets its plan and
+// quota in X-RateLimit-* headers; refused ones get 429 with Retry-After, and requests let
+// through past a soft quota are marked with X-Quota-Overage. Invalid keys are refused
+// rather than served anonymously, so a broken integration is noticed.
+func APIKeyMiddleware(authorize APIKeyAuthorizer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(APIKeyHeader)
+			if key == "" || GetCurrentUser(r.Context()) != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			resp, err := authorize(r.Context(), key)
+			if status.Code(err) == codes.Unauthenticated {
+				writeGraphQLError(w, http.StatusUnauthorized, "UNAUTHENTICATED", "invalid API key")
+				return
+			}
+			if err != nil {
+				writeGraphQLError(w, http.StatusServiceUnavailable, "UNAVAILABLE", "API key could not be checked")
+				return
+			}
+
+			setQuotaHeaders(w.Header(), resp)
+			if !resp.GetAllowed() {
+				retry := int(math.Ceil(float64(resp.GetRetryAfterMs()) / 1000))
+				w.Header().Set("Retry-After", strconv.Itoa(max(retry, 1)))
+				if resp.GetRejectReason() == "quota" {
+					writeGraphQLError(w, http.StatusTooManyRequests, "QUOTA_EXCEEDED", "daily API quota exceeded")
+				} else {
+					writeGraphQLError(w, http.StatusTooManyRequests, "RATE_LIMITED", "API rate limit exceeded")
+				}
+				return
+			}
+			if resp.GetOverQuota() {
+				w.Header().Set("X-Quota-Overage", "true")
+			}
+
+			user := &CurrentUser{UserID: resp.GetUserId(), APIKeyID: resp.GetKeyId()}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), CurrentUserKey, user)))
+		})
+	}
+}
+
+// CreateAPIKeyMiddleware creates the API key middleware backed by the auth service
+func CreateAPIKeyMiddleware(client authpb.AuthServiceClient) func(http.Handler) http.Handler {
+	return APIKeyMiddleware(func(ctx context.Context, key string) (*authpb.AuthorizeApiKeyResponse, error) {
+		return client.AuthorizeApiKey(ctx, &authpb.AuthorizeApiKeyRequest{Key: key})
+	})
+}
+
+func setQuotaHeaders(h http.Header, resp *authpb.AuthorizeApiKeyResponse) {
+	limits := resp.GetLimits()
+	h.Set("X-Api-Plan", limits.GetPlan())
+	if quota := limits.GetDailyQuota(); quota > 0 {
+		h.Set("X-RateLimit-Limit", strconv.FormatInt(quota, 10))
+		h.Set("X-RateLimit-Remaining", strconv.FormatInt(max(quota-resp.GetUsedToday(), 0), 10))
+	}
+	if resetsAt, err := time.Parse(time.RFC3339, resp.GetResetsAt()); err == nil {
+		h.Set("X-RateLimit-Reset", strconv.FormatInt(resetsAt.Unix(), 10))
+	}
+}
+
+// writeGraphQLError answers with a GraphQL error body, so clients parse it like any other
+func writeGraphQLError(w http.ResponseWriter, code int, errCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{
+			"message":    message,
+			"extensions": map[string]string{"code": errCode},
+		}},
+	})
+}
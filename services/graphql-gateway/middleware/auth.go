@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/quangdang46/NFT-Marketplace/shared/env"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
 )
 
 // AuthContextKey type for auth context keys
@@ -24,10 +27,67 @@ const (
 type CurrentUser struct {
 	UserID    string `json:"user_id"`
 	SessionID string `json:"session_id"`
+	// Orgs maps organization id to the user's role when the token was issued
+	Orgs map[string]string `json:"orgs,omitempty"`
+	// Impersonation is set when an admin acts as UserID
+	Impersonation *Impersonation `json:"impersonation,omitempty"`
+	// APIKeyID is set when the request was made with an API key instead of a session
+	APIKeyID string `json:"api_key_id,omitempty"`
+}
+
+// Impersonation describes an admin acting as another user, read from the token claims
+type Impersonation struct {
+	ImpersonatorID string    `json:"impersonator_id"`
+	Reason         string    `json:"reason"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// IsImpersonated reports whether an admin is acting as the user
+func (u *CurrentUser) IsImpersonated() bool {
+	return u.Impersonation != nil
+}
+
+// IsAPIKey reports whether the request was made with an API key
+func (u *CurrentUser) IsAPIKey() bool {
+	return u.APIKeyID != ""
+}
+
+// OrgRole returns the user's role in the organization, empty when not a member
+func (u *CurrentUser) OrgRole(orgID string) string {
+	return u.Orgs[orgID]
+}
+
+// TokenPolicy is what an access token must satisfy besides a valid signature. Issuers
+// holds every accepted issuer, so a migration window can admit the old and new one;
+// Audience keeps tokens minted for another environment out even when secrets are shared.
+type TokenPolicy struct {
+	Secret   []byte
+	Issuers  []string
+	Audience string
+}
+
+// LoadTokenPolicy reads the token policy from JWT_SECRET, JWT_ISSUER, JWT_ACCEPTED_ISSUERS
+// and JWT_AUDIENCE, using the same defaults as the auth service
+func LoadTokenPolicy() TokenPolicy {
+	issuer := env.GetString("JWT_ISSUER", "nft-marketplace-auth")
+	return TokenPolicy{
+		Secret:   []byte(env.GetString("JWT_SECRET", "default-jwt-secret-for-development")),
+		Issuers:  append([]string{issuer}, env.GetStringList("JWT_ACCEPTED_ISSUERS", nil)...),
+		Audience: env.GetString("JWT_AUDIENCE", "nft-marketplace-api"),
+	}
+}
+
+func (p TokenPolicy) acceptsIssuer(issuer string) bool {
+	for _, accepted := range p.Issuers {
+		if accepted == issuer {
+			return true
+		}
+	}
+	return false
 }
 
 // AuthMiddleware validates JWT Bearer tokens and adds user info to context
-func AuthMiddleware(jwtSecret []byte) func(http.Handler) http.Handler {
+func AuthMiddleware(policy TokenPolicy) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Extract Bearer token from Authorization header
@@ -38,7 +98,7 @@ func AuthMiddleware(jwtSecret []byte) func(http.Handler) http.Handler {
 					tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
 					// Validate JWT token
-					if user, err := validateJWTToken(tokenString, jwtSecret); err == nil {
+					if user, err := validateJWTToken(tokenString, policy); err == nil {
 						// Add user info to context
 						ctx := context.WithValue(r.Context(), CurrentUserKey, user)
 						ctx = context.WithValue(ctx, SessionIDKey, user.SessionID)
@@ -54,6 +114,27 @@ func AuthMiddleware(jwtSecret []byte) func(http.Handler) http.Handler {
 	}
 }
 
+// WebsocketInitFunc authenticates GraphQL subscriptions from the connection_init payload,
+// since browsers cannot set headers on the WebSocket upgrade. A user already set from the
+// upgrade request is kept; invalid tokens continue unauthenticated, as in AuthMiddleware.
+func WebsocketInitFunc(policy TokenPolicy) transport.WebsocketInitFunc {
+	return func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		if GetCurrentUser(ctx) != nil {
+			return ctx, nil, nil
+		}
+
+		tokenString := strings.TrimPrefix(initPayload.Authorization(), "Bearer ")
+		if tokenString == "" {
+			return ctx, nil, nil
+		}
+		if user, err := validateJWTToken(tokenString, policy); err == nil {
+			ctx = context.WithValue(ctx, CurrentUserKey, user)
+			ctx = context.WithValue(ctx, SessionIDKey, user.SessionID)
+		}
+		return ctx, nil, nil
+	}
+}
+
 // GetCurrentUser retrieves current user from context
 func GetCurrentUser(ctx context.Context) *CurrentUser {
 	if user, ok := ctx.Value(CurrentUserKey).(*CurrentUser); ok {
@@ -70,25 +151,70 @@ func GetSessionID(ctx context.Context) string {
 	return ""
 }
 
+// Errors returned by the Require helpers
+var (
+	ErrAuthRequired  = errs.New(errs.Unauthenticated, "authentication_required").WithMessage("authentication required")
+	ErrAdminRequired = errs.New(errs.PermissionDenied, "admin_required").WithMessage("admin privileges required")
+)
+
 // RequireAuth returns error if user is not authenticated
 func RequireAuth(ctx context.Context) (*CurrentUser, error) {
 	user := GetCurrentUser(ctx)
 	if user == nil {
-		return nil, fmt.Errorf("authentication required")
+		return nil, ErrAuthRequired
 	}
 	return user, nil
 }
 
+// RequireAdmin returns error unless the authenticated user is listed in ADMIN_USER_IDS.
+// API keys never act as admins.
+func RequireAdmin(ctx context.Context) (*CurrentUser, error) {
+	user, err := RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsAPIKey() {
+		return nil, ErrAdminRequired
+	}
+
+	for _, id := range strings.Split(env.GetString("ADMIN_USER_IDS", ""), ",") {
+		if strings.TrimSpace(id) == user.UserID {
+			return user, nil
+		}
+	}
+	return nil, ErrAdminRequired
+}
+
+// RequireOrgRole returns error unless the token carries one of roles for the organization.
+// Org claims refresh with the access token, so new memberships apply after refreshSession.
+func RequireOrgRole(ctx context.Context, orgID string, roles ...string) (*CurrentUser, error) {
+	user, err := RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role := user.OrgRole(orgID)
+	if role == "" {
+		return nil, fmt.Errorf("organization membership required")
+	}
+	for _, allowed := range roles {
+		if role == allowed {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("organization role %s is not allowed", role)
+}
+
 // validateJWTToken validates JWT token and returns user info
-func validateJWTToken(tokenString string, jwtSecret []byte) (*CurrentUser, error) {
-	// Parse JWT token
+func validateJWTToken(tokenString string, policy TokenPolicy) (*CurrentUser, error) {
+	// Parse JWT token; the audience must name this environment
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		// Validate signing method
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return jwtSecret, nil
-	})
+		return policy.Secret, nil
+	}, jwt.WithAudience(policy.Audience))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
@@ -109,23 +235,57 @@ func validateJWTToken(tokenString string, jwtSecret []byte) (*CurrentUser, error
 		}
 
 		// Validate issuer
-		if iss, ok := claims["iss"].(string); !ok || iss != "nft-marketplace-auth" {
+		if iss, ok := claims["iss"].(string); !ok || !policy.acceptsIssuer(iss) {
 			return nil, fmt.Errorf("invalid token issuer")
 		}
 
 		return &CurrentUser{
-			UserID:    userID,
-			SessionID: sessionID,
+			UserID:        userID,
+			SessionID:     sessionID,
+			Orgs:          orgClaims(claims["orgs"]),
+			Impersonation: impersonationClaims(claims),
 		}, nil
 	}
 
 	return nil, fmt.Errorf("invalid token claims")
 }
 
+// orgClaims reads the optional "orgs" claim (org id -> role); malformed entries are dropped
+func orgClaims(raw interface{}) map[string]string {
+	entries, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	orgs := make(map[string]string, len(entries))
+	for orgID, role := range entries {
+		if r, ok := role.(string); ok {
+			orgs[orgID] = r
+		}
+	}
+	return orgs
+}
+
+// impersonationClaims reads the "impersonator" claim auth-service stamps on impersonation
+// tokens, with the reason and expiry shown to the admin while it lasts
+func impersonationClaims(claims jwt.MapClaims) *Impersonation {
+	impersonator, ok := claims["impersonator"].(string)
+	if !ok || impersonator == "" {
+		return nil
+	}
+	impersonation := &Impersonation{ImpersonatorID: impersonator}
+	impersonation.Reason, _ = claims["impersonation_reason"].(string)
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		impersonation.ExpiresAt = exp.Time
+	}
+	return impersonation
+}
+
 // CreateAuthMiddleware creates auth middleware with configuration
 func CreateAuthMiddleware() func(http.Handler) http.Handler {
-	// Load JWT secret from environment
-	jwtSecret := env.GetString("JWT_SECRET", "default-jwt-secret-for-development")
+	return AuthMiddleware(LoadTokenPolicy())
+}
 
-	return AuthMiddleware([]byte(jwtSecret))
+// CreateWebsocketInitFunc creates the subscription auth hook with configuration
+func CreateWebsocketInitFunc() transport.WebsocketInitFunc {
+	return WebsocketInitFunc(LoadTokenPolicy())
 }
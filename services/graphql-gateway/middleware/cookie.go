@@ -2,8 +2,12 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/env"
 )
 
 // ContextKey type for context keys
@@ -45,37 +49,138 @@ func GetRequest(ctx context.Context) *http.Request {
 	return nil
 }
 
-// SetRefreshTokenCookie sets httpOnly cookie for refresh token
-func SetRefreshTokenCookie(w http.ResponseWriter, refreshToken string) {
-	cookie := &http.Cookie{
+// hostCookiePrefix marks a cookie the browser only accepts when it is Secure, has path "/"
+// and no Domain, pinning it to the exact host that set it
+const hostCookiePrefix = "__Host-"
+
+// CookiePolicy holds the attributes of the refresh token cookie
+type CookiePolicy struct {
+	Name     string
+	Domain   string
+	Secure   bool
+	SameSite http.SameSite
+	MaxAge   int
+	// HostPrefix prepends __Host- to Name for production HTTPS deployments
+	HostPrefix bool
+}
+
+// DefaultCookiePolicy matches local development: a host-only, non-Secure strict cookie
+// kept for 30 days
+func DefaultCookiePolicy() CookiePolicy {
+	return CookiePolicy{
 		Name:     "refresh_token",
-		Value:    refreshToken,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
 		SameSite: http.SameSiteStrictMode,
-		MaxAge:   30 * 24 * 60 * 60, // 30 days
+		MaxAge:   30 * 24 * 60 * 60,
 	}
-	http.SetCookie(w, cookie)
 }
 
-// ClearRefreshTokenCookie clears the refresh token cookie
-func ClearRefreshTokenCookie(w http.ResponseWriter) {
-	cookie := &http.Cookie{
-		Name:     "refresh_token",
-		Value:    "",
+// LoadCookiePolicy reads the refresh cookie attributes from the environment
+func LoadCookiePolicy() (CookiePolicy, error) {
+	policy := DefaultCookiePolicy()
+	policy.Name = env.GetString("REFRESH_COOKIE_NAME", policy.Name)
+	policy.Domain = env.GetString("REFRESH_COOKIE_DOMAIN", "")
+	policy.Secure = env.GetBool("REFRESH_COOKIE_SECURE", false)
+	policy.MaxAge = env.GetInt("REFRESH_COOKIE_MAX_AGE", policy.MaxAge)
+	policy.HostPrefix = env.GetBool("REFRESH_COOKIE_HOST_PREFIX", false)
+
+	sameSite, err := parseSameSite(env.GetString("REFRESH_COOKIE_SAMESITE", "strict"))
+	if err != nil {
+		return policy, err
+	}
+	policy.SameSite = sameSite
+
+	return policy, policy.Validate()
+}
+
+// Validate rejects attribute combinations browsers would drop the cookie for
+func (p CookiePolicy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("refresh cookie name is required")
+	}
+	if p.MaxAge <= 0 {
+		return fmt.Errorf("refresh cookie max-age must be positive")
+	}
+	if p.SameSite == http.SameSiteNoneMode && !p.Secure {
+		return fmt.Errorf("refresh cookie with SameSite=None must be Secure")
+	}
+	if p.HostPrefix {
+		if !p.Secure {
+			return fmt.Errorf("__Host- refresh cookie must be Secure")
+		}
+		if p.Domain != "" {
+			return fmt.Errorf("__Host- refresh cookie cannot set a domain")
+		}
+	}
+	return nil
+}
+
+// CookieName is the name the refresh cookie is written and read under
+func (p CookiePolicy) CookieName() string {
+	if p.HostPrefix && !strings.HasPrefix(p.Name, hostCookiePrefix) {
+		return hostCookiePrefix + p.Name
+	}
+	return p.Name
+}
+
+func (p CookiePolicy) cookie(value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     p.CookieName(),
+		Value:    value,
 		Path:     "/",
+		Domain:   p.Domain,
 		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
-		SameSite: http.SameSiteStrictMode,
-		MaxAge:   -1, // Delete cookie
+		Secure:   p.Secure,
+		SameSite: p.SameSite,
+		MaxAge:   maxAge,
 	}
-	http.SetCookie(w, cookie)
+}
+
+func parseSameSite(value string) (http.SameSite, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	default:
+		return http.SameSiteDefaultMode, fmt.Errorf("invalid REFRESH_COOKIE_SAMESITE %q", value)
+	}
+}
+
+var (
+	cookiePolicyMu sync.RWMutex
+	cookiePolicy   = DefaultCookiePolicy()
+)
+
+// UseCookiePolicy sets the policy applied by the refresh cookie helpers
+func UseCookiePolicy(policy CookiePolicy) {
+	cookiePolicyMu.Lock()
+	defer cookiePolicyMu.Unlock()
+	cookiePolicy = policy
+}
+
+func currentCookiePolicy() CookiePolicy {
+	cookiePolicyMu.RLock()
+	defer cookiePolicyMu.RUnlock()
+	return cookiePolicy
+}
+
+// SetRefreshTokenCookie sets httpOnly cookie for refresh token
+func SetRefreshTokenCookie(w http.ResponseWriter, refreshToken string) {
+	policy := currentCookiePolicy()
+	http.SetCookie(w, policy.cookie(refreshToken, policy.MaxAge))
+}
+
+// ClearRefreshTokenCookie clears the refresh token cookie. The attributes must match the
+// ones it was set with or the browser keeps the original.
+func ClearRefreshTokenCookie(w http.ResponseWriter) {
+	http.SetCookie(w, currentCookiePolicy().cookie("", -1))
 }
 
 // GetRefreshTokenFromCookie retrieves refresh token from httpOnly cookie
 func GetRefreshTokenFromCookie(r *http.Request) string {
-	cookie, err := r.Cookie("refresh_token")
+	cookie, err := r.Cookie(currentCookiePolicy().CookieName())
 	if err != nil {
 		return ""
 	}
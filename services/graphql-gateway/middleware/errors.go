@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"log"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/status"
+)
+
+// PresentError is the gqlgen error presenter. Errors classified by a service, or returned
+// by one as a gRPC status, get their code and whether a retry can help in the extensions,
+// so clients branch on the code instead of the message. Internal failures are logged and
+// shown without their details. Errors that already carry a code keep it.
+func PresentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if _, coded := gqlErr.Extensions["code"]; coded {
+		return gqlErr
+	}
+
+	appErr, ok := errs.As(err)
+	if !ok {
+		return gqlErr
+	}
+
+	if appErr.Code == errs.Internal {
+		log.Printf("Internal error at %s: %v", gqlErr.Path, err)
+		gqlErr.Message = "internal error"
+	} else if st, isStatus := err.(interface{ GRPCStatus() *status.Status }); isStatus {
+		// drop the "rpc error: code = ... desc =" prefix of a status returned as is
+		gqlErr.Message = st.GRPCStatus().Message()
+	}
+
+	extensions := make(map[string]interface{}, len(gqlErr.Extensions)+2)
+	for k, v := range gqlErr.Extensions {
+		extensions[k] = v
+	}
+	extensions["code"] = string(appErr.Code)
+	extensions["retryable"] = errs.Retryable(err)
+	gqlErr.Extensions = extensions
+	return gqlErr
+}
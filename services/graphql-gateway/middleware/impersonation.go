@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// impersonationMutations are the only mutations an impersonation token may run. Everything
+// else can change the user's data or move their assets, so support staff only look.
+var impersonationMutations = map[string]bool{
+	"endImpersonation":         true,
+	"createSubscriptionTicket": true,
+}
+
+// ImpersonationGuard is a root field middleware for requests made with an impersonation
+// token. Every root field is written to the audit log, and mutations outside
+// impersonationMutations are refused without reaching their resolver.
+func ImpersonationGuard(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	user := GetCurrentUser(ctx)
+	root := graphql.GetRootFieldContext(ctx)
+	if user == nil || !user.IsImpersonated() || root == nil {
+		return next(ctx)
+	}
+
+	if root.Object == "Mutation" && !impersonationMutations[root.Field.Name] {
+		log.Printf("audit|event=impersonation_blocked|session_id=%s|user_id=%s|impersonator_id=%s|operation=%s.%s|timestamp=%s",
+			user.SessionID, user.UserID, user.Impersonation.ImpersonatorID, root.Object, root.Field.Name, time.Now().UTC().Format(time.RFC3339Nano))
+		graphql.AddError(ctx, &gqlerror.Error{
+			Message:    "mutation " + root.Field.Name + " is not allowed while impersonating",
+			Path:       ast.Path{ast.PathName(root.Field.Alias)},
+			Extensions: map[string]interface{}{"code": "IMPERSONATION_FORBIDDEN"},
+		})
+		return graphql.Null
+	}
+
+	log.Printf("audit|event=impersonated_request|session_id=%s|user_id=%s|impersonator_id=%s|operation=%s.%s|timestamp=%s",
+		user.SessionID, user.UserID, user.Impersonation.ImpersonatorID, root.Object, root.Field.Name, time.Now().UTC().Format(time.RFC3339Nano))
+	return next(ctx)
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/locale"
+)
+
+// AcceptedLocales returns the locales the request's Accept-Language header asks for,
+// most preferred first. It is empty outside an HTTP request or without the header.
+func AcceptedLocales(ctx context.Context) []string {
+	req := GetRequest(ctx)
+	if req == nil {
+		return nil
+	}
+	return locale.ParseAcceptLanguage(req.Header.Get("Accept-Language"))
+}
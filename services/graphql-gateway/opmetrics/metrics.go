@@ -0,0 +1,117 @@
+package opmetrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+)
+
+// Route is where the metrics are served
+const Route = "/metrics"
+
+// Bucket upper bounds, in seconds and in bytes
+var (
+	durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	sizeBuckets     = []float64{256, 1024, 4096, 16384, 65536, 262144, 1048576}
+)
+
+// histogram counts observations per bucket; counts are not cumulative until written
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+func observeHistogram[K comparable](histograms map[K]*histogram, key K, buckets []float64, value float64) {
+	h, ok := histograms[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(buckets))}
+		histograms[key] = h
+	}
+	for i, bound := range buckets {
+		if value <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	r.WriteMetrics(w)
+}
+
+// WriteMetrics writes the metrics in the Prometheus text format
+func (r *Recorder) WriteMetrics(w io.Writer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	monitoring.WriteMetricHeader(w, "gateway_operations_total", "counter", "GraphQL operations answered, by operation, type and whether the response had errors.")
+	totals := sortedKeys(r.totals, func(a, b operationKey) bool { return a.less(b) })
+	for _, k := range totals {
+		fmt.Fprintf(w, "gateway_operations_total{operation=%q,type=%q,status=%q} %d\n", k.name, k.kind, k.status, r.totals[k])
+	}
+
+	monitoring.WriteMetricHeader(w, "gateway_operation_errors_total", "counter", "Errors in GraphQL responses, by operation and error code.")
+	errKeys := sortedKeys(r.errors, func(a, b errorKey) bool {
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		return a.code < b.code
+	})
+	for _, k := range errKeys {
+		fmt.Fprintf(w, "gateway_operation_errors_total{operation=%q,code=%q} %d\n", k.name, k.code, r.errors[k])
+	}
+
+	monitoring.WriteMetricHeader(w, "gateway_operation_duration_seconds", "histogram", "Time to answer a GraphQL operation, from parsing to the response.")
+	for _, k := range sortedKeys(r.durations, func(a, b operationKey) bool { return a.less(b) }) {
+		writeHistogram(w, "gateway_operation_duration_seconds", fmt.Sprintf("operation=%q,type=%q", k.name, k.kind), durationBuckets, r.durations[k])
+	}
+
+	monitoring.WriteMetricHeader(w, "gateway_response_size_bytes", "histogram", "Size of the data of GraphQL responses.")
+	for _, k := range sortedKeys(r.sizes, func(a, b operationKey) bool { return a.less(b) }) {
+		writeHistogram(w, "gateway_response_size_bytes", fmt.Sprintf("operation=%q,type=%q", k.name, k.kind), sizeBuckets, r.sizes[k])
+	}
+
+	monitoring.WriteMetricHeader(w, "gateway_resolver_duration_seconds", "histogram", "Time spent in each resolver, such as Query.collections.")
+	for _, field := range sortedKeys(r.resolvers, func(a, b string) bool { return a < b }) {
+		writeHistogram(w, "gateway_resolver_duration_seconds", fmt.Sprintf("field=%q", field), durationBuckets, r.resolvers[field])
+	}
+}
+
+func (k operationKey) less(o operationKey) bool {
+	if k.name != o.name {
+		return k.name < o.name
+	}
+	if k.kind != o.kind {
+		return k.kind < o.kind
+	}
+	return k.status < o.status
+}
+
+func sortedKeys[K comparable, V any](m map[K]V, less func(a, b K) bool) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	return keys
+}
+
+func writeHistogram(w io.Writer, name, labels string, buckets []float64, h *histogram) {
+	var cumulative uint64
+	for i, bound := range buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(w, "%s_bucket{%s,le=%q} %d\n", name, labels, strconv.FormatFloat(bound, 'f', -1, 64), cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, h.count)
+	fmt.Fprintf(w, "%s_sum{%s} %s\n", name, labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+	fmt.Fprintf(w, "%s_count{%s} %d\n", name, labels, h.count)
+}
@@ -0,0 +1,273 @@
+// Package opmetrics records what each GraphQL operation costs: how long it and each of its
+// resolvers took, which error codes it returned and how large its response was. Totals are
+// served at /metrics in the Prometheus text format; operations are logged one line each,
+// and slow ones, optionally sampled, with their slowest resolvers.
+package opmetrics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+)
+
+const (
+	// maxOperationNames bounds the operation label; clients name their own operations, so
+	// names past the limit are counted as otherOperation
+	maxOperationNames = 200
+	otherOperation    = "other"
+	anonymous         = "anonymous"
+	// uncoded labels errors no service classified
+	uncoded = "UNCLASSIFIED"
+	// slowResolvers is how many of a slow operation's resolvers are logged
+	slowResolvers = 5
+)
+
+// Options tune the operation log and the slow query log
+type Options struct {
+	// LogOperations logs one line per operation
+	LogOperations bool
+	// SlowThreshold logs operations taking at least this long; 0 disables the slow query log
+	SlowThreshold time.Duration
+	// SlowSampleRate is the share of slow operations logged, from 0 to 1
+	SlowSampleRate float64
+}
+
+// Recorder is a gqlgen extension collecting operation metrics. Subscriptions are not
+// recorded: their responses are pushed over the life of a connection.
+type Recorder struct {
+	opts   Options
+	sample func() float64
+
+	mu         sync.Mutex
+	operations map[string]bool
+	totals     map[operationKey]uint64
+	errors     map[errorKey]uint64
+	durations  map[operationKey]*histogram
+	sizes      map[operationKey]*histogram
+	resolvers  map[string]*histogram
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = (*Recorder)(nil)
+
+type operationKey struct {
+	name, kind, status string
+}
+
+type errorKey struct {
+	name, code string
+}
+
+// NewRecorder creates a recorder
+func NewRecorder(opts Options) *Recorder {
+	return &Recorder{
+		opts:       opts,
+		sample:     rand.Float64,
+		operations: make(map[string]bool),
+		totals:     make(map[operationKey]uint64),
+		errors:     make(map[errorKey]uint64),
+		durations:  make(map[operationKey]*histogram),
+		sizes:      make(map[operationKey]*histogram),
+		resolvers:  make(map[string]*histogram),
+	}
+}
+
+// WithSampler replaces the slow query log's sampling source, for tests
+func (r *Recorder) WithSampler(sample func() float64) *Recorder {
+	r.sample = sample
+	return r
+}
+
+func (r *Recorder) ExtensionName() string {
+	return "OperationMetrics"
+}
+
+func (r *Recorder) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// operationStats collects one operation's resolver timings
+type operationStats struct {
+	mu        sync.Mutex
+	resolvers map[string]time.Duration
+}
+
+type statsKey struct{}
+
+func (r *Recorder) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation == ast.Subscription {
+		return next(ctx)
+	}
+
+	start := oc.Stats.OperationStart
+	if start.IsZero() {
+		start = time.Now()
+	}
+	stats := &operationStats{resolvers: make(map[string]time.Duration)}
+	resp := next(context.WithValue(ctx, statsKey{}, stats))
+	duration := time.Since(start)
+
+	var userID string
+	if user := middleware.GetCurrentUser(ctx); user != nil {
+		userID = user.UserID
+	}
+	size := 0
+	var codes []string
+	if resp != nil {
+		size = len(resp.Data)
+		for _, err := range resp.Errors {
+			codes = append(codes, errorCode(err.Extensions))
+		}
+	}
+	r.observe(operation{
+		Name:      oc.Operation.Name,
+		Kind:      string(oc.Operation.Operation),
+		UserID:    userID,
+		Duration:  duration,
+		Size:      size,
+		Codes:     codes,
+		Resolvers: stats.snapshot(),
+	})
+	return resp
+}
+
+func (r *Recorder) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	stats, _ := ctx.Value(statsKey{}).(*operationStats)
+	// Fields read off a parent's value cost nothing worth recording
+	if stats == nil || fc == nil || !fc.IsResolver || strings.HasPrefix(fc.Object, "__") {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	elapsed := time.Since(start)
+
+	field := fc.Object + "." + fc.Field.Name
+	stats.mu.Lock()
+	stats.resolvers[field] += elapsed
+	stats.mu.Unlock()
+	r.mu.Lock()
+	observeHistogram(r.resolvers, field, durationBuckets, elapsed.Seconds())
+	r.mu.Unlock()
+	return res, err
+}
+
+func (s *operationStats) snapshot() map[string]time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]time.Duration, len(s.resolvers))
+	for field, d := range s.resolvers {
+		out[field] = d
+	}
+	return out
+}
+
+// operation is one finished operation as the recorder saw it
+type operation struct {
+	Name     string
+	Kind     string // query or mutation
+	UserID   string // empty for anonymous viewers
+	Duration time.Duration
+	// Size is the length of the response data in bytes
+	Size int
+	// Codes are the error codes of the response's errors, in order
+	Codes []string
+	// Resolvers is the time spent in each resolver, summed over the operation
+	Resolvers map[string]time.Duration
+}
+
+// observe folds an operation into the totals and logs it
+func (r *Recorder) observe(op operation) {
+	status := "ok"
+	if len(op.Codes) > 0 {
+		status = "error"
+	}
+
+	r.mu.Lock()
+	name := r.operationLabel(op.Name)
+	key := operationKey{name: name, kind: op.Kind, status: status}
+	r.totals[key]++
+	for _, code := range op.Codes {
+		r.errors[errorKey{name: name, code: code}]++
+	}
+	observeHistogram(r.durations, operationKey{name: name, kind: op.Kind}, durationBuckets, op.Duration.Seconds())
+	observeHistogram(r.sizes, operationKey{name: name, kind: op.Kind}, sizeBuckets, float64(op.Size))
+	r.mu.Unlock()
+
+	if r.opts.LogOperations {
+		log.Printf("graphql_operation|operation=%s|type=%s|user_id=%s|duration_ms=%d|response_bytes=%d|errors=%s",
+			nameOrAnonymous(op.Name), op.Kind, op.UserID, op.Duration.Milliseconds(), op.Size, strings.Join(op.Codes, ","))
+	}
+	if r.opts.SlowThreshold > 0 && op.Duration >= r.opts.SlowThreshold && r.sample() < r.opts.SlowSampleRate {
+		log.Printf("graphql_slow_query|operation=%s|type=%s|user_id=%s|duration_ms=%d|response_bytes=%d|resolvers=%s",
+			nameOrAnonymous(op.Name), op.Kind, op.UserID, op.Duration.Milliseconds(), op.Size, slowest(op.Resolvers, slowResolvers))
+	}
+}
+
+// operationLabel names the operation in metrics; callers hold mu
+func (r *Recorder) operationLabel(name string) string {
+	name = nameOrAnonymous(name)
+	if r.operations[name] {
+		return name
+	}
+	if len(r.operations) >= maxOperationNames {
+		return otherOperation
+	}
+	r.operations[name] = true
+	return name
+}
+
+func nameOrAnonymous(name string) string {
+	if name == "" {
+		return anonymous
+	}
+	return name
+}
+
+// errorCode is the code the error presenter put in the extensions
+func errorCode(extensions map[string]interface{}) string {
+	if code, ok := extensions["code"].(string); ok && code != "" {
+		return code
+	}
+	return uncoded
+}
+
+// slowest lists the n resolvers that took longest as field:ms pairs
+func slowest(resolvers map[string]time.Duration, n int) string {
+	fields := make([]string, 0, len(resolvers))
+	for field := range resolvers {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		if resolvers[fields[i]] != resolvers[fields[j]] {
+			return resolvers[fields[i]] > resolvers[fields[j]]
+		}
+		return fields[i] < fields[j]
+	})
+	if len(fields) > n {
+		fields = fields[:n]
+	}
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = fmt.Sprintf("%s:%d", field, resolvers[field].Milliseconds())
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,120 @@
+// Package persisted restricts the gateway to the GraphQL operations the frontend build
+// shipped. The build writes a manifest mapping each operation's SHA-256 hash to its text,
+// the same format APQ hashes with, and clients send the hash alone.
+package persisted
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes clients see for operations outside the manifest
+const (
+	ErrCodeNotFound   = "PERSISTED_QUERY_NOT_FOUND"
+	ErrCodeNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+)
+
+// Allowlist is a gqlgen extension that only runs operations listed in the manifest. A
+// request may carry the hash in extensions.persistedQuery, the full text of a listed
+// operation, or both.
+type Allowlist struct {
+	path string
+
+	mu         sync.RWMutex
+	operations map[string]string
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = (*Allowlist)(nil)
+
+// NewAllowlist loads the manifest at path
+func NewAllowlist(path string) (*Allowlist, error) {
+	a := &Allowlist{path: path}
+	if _, err := a.Reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Reload re-reads the manifest and returns how many operations it lists. A manifest that
+// fails to load leaves the current one in place.
+func (a *Allowlist) Reload() (int, error) {
+	raw, err := os.ReadFile(a.path)
+	if err != nil {
+		return 0, fmt.Errorf("read persisted operation manifest: %w", err)
+	}
+	var operations map[string]string
+	if err := json.Unmarshal(raw, &operations); err != nil {
+		return 0, fmt.Errorf("decode persisted operation manifest: %w", err)
+	}
+	for hash, query := range operations {
+		if queryHash(query) != hash {
+			return 0, fmt.Errorf("persisted operation %s does not match its hash", hash)
+		}
+	}
+
+	a.mu.Lock()
+	a.operations = operations
+	a.mu.Unlock()
+	return len(operations), nil
+}
+
+func (a *Allowlist) ExtensionName() string {
+	return "PersistedOperationAllowlist"
+}
+
+func (a *Allowlist) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (a *Allowlist) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	hash := requestedHash(rawParams.Extensions)
+	if rawParams.Query != "" {
+		sent := queryHash(rawParams.Query)
+		if hash != "" && hash != sent {
+			return gqlerror.Errorf("provided persisted query hash does not match query")
+		}
+		hash = sent
+	}
+
+	a.mu.RLock()
+	query, ok := a.operations[hash]
+	a.mu.RUnlock()
+
+	switch {
+	case ok:
+		rawParams.Query = query
+		return nil
+	case rawParams.Query == "" && hash != "":
+		err := gqlerror.Errorf("PersistedQueryNotFound")
+		errcode.Set(err, ErrCodeNotFound)
+		return err
+	default:
+		err := gqlerror.Errorf("only persisted operations are allowed")
+		errcode.Set(err, ErrCodeNotAllowed)
+		return err
+	}
+}
+
+// requestedHash reads extensions.persistedQuery.sha256Hash, empty when absent
+func requestedHash(extensions map[string]interface{}) string {
+	persistedQuery, _ := extensions["persistedQuery"].(map[string]interface{})
+	hash, _ := persistedQuery["sha256Hash"].(string)
+	return hash
+}
+
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
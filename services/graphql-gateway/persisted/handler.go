@@ -0,0 +1,45 @@
+package persisted
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+)
+
+// ReloadRoute is where admins ask the gateway to re-read the manifest after a deploy
+const ReloadRoute = "/admin/persisted-operations/reload"
+
+// ReloadHandler reloads an allowlist's manifest. It must sit behind the auth middleware.
+type ReloadHandler struct {
+	allowlist *Allowlist
+}
+
+// NewReloadHandler creates a manifest reload handler
+func NewReloadHandler(allowlist *Allowlist) *ReloadHandler {
+	return &ReloadHandler{allowlist: allowlist}
+}
+
+func (h *ReloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if _, err := middleware.RequireAdmin(r.Context()); err != nil {
+		http.Error(w, "admin privileges required", http.StatusForbidden)
+		return
+	}
+
+	count, err := h.allowlist.Reload()
+	if err != nil {
+		log.Printf("Persisted operation manifest reload failed: %v", err)
+		http.Error(w, "manifest reload failed; the previous manifest stays in use", http.StatusUnprocessableEntity)
+		return
+	}
+	log.Printf("Persisted operation manifest reloaded with %d operations", count)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int{"operations": count})
+}
@@ -0,0 +1,292 @@
+// Package platformstatus aggregates the health of the platform's components into the
+// snapshot behind the public platformStatus query. Snapshots are cached so a status page
+// and every client's degraded-mode banner polling it do not fan out to each service.
+package platformstatus
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	chainregpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Component levels, from best to worst
+const (
+	Operational = "operational"
+	Degraded    = "degraded"
+	Outage      = "outage"
+)
+
+const (
+	// DefaultTTL is how long a snapshot is served before the components are asked again
+	DefaultTTL = 30 * time.Second
+	// DefaultQueueBacklog is the queue depth from which a queue reads as degraded
+	DefaultQueueBacklog = 1000
+	// checkTimeout bounds one refresh; a component that does not answer in time is down
+	checkTimeout = 3 * time.Second
+)
+
+// Sources are the clients a snapshot is gathered from; any of them may be nil
+type Sources struct {
+	// Services checked for readiness, by name
+	Services      map[string]healthpb.HealthClient
+	ChainRegistry chainregpb.ChainRegistryServiceClient
+	Catalog       catalogpb.CatalogServiceClient
+	Media         mediapb.MediaServiceClient
+}
+
+// Options tune what a snapshot reports
+type Options struct {
+	// Chains whose indexer lag is reported
+	Chains []string
+	// TTL <= 0 uses DefaultTTL
+	TTL time.Duration
+	// QueueBacklog <= 0 uses DefaultQueueBacklog
+	QueueBacklog int
+}
+
+type ServiceStatus struct {
+	Name   string
+	Status string
+}
+
+type ChainStatus struct {
+	ChainID     string
+	Status      string
+	LatestBlock uint64
+	// AdvancedAt is when the indexer last saw the head advance, nil until it reports the chain
+	AdvancedAt *time.Time
+	// LagSeconds is the time since AdvancedAt
+	LagSeconds int
+}
+
+type QueueStatus struct {
+	Name     string
+	Status   string
+	Messages int
+}
+
+type PinningStatus struct {
+	Status string
+	// LastPinnedAt is nil until content was pinned
+	LastPinnedAt *time.Time
+}
+
+// Snapshot is the platform's health at CheckedAt
+type Snapshot struct {
+	Status    string
+	Services  []ServiceStatus
+	Chains    []ChainStatus
+	Queues    []QueueStatus
+	Pinning   PinningStatus
+	CheckedAt time.Time
+}
+
+// Aggregator builds snapshots and serves each for its TTL
+type Aggregator struct {
+	sources Sources
+	opts    Options
+	now     func() time.Time
+
+	mu       sync.Mutex
+	snapshot *Snapshot
+}
+
+// NewAggregator creates an aggregator over sources
+func NewAggregator(sources Sources, opts Options) *Aggregator {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultTTL
+	}
+	if opts.QueueBacklog <= 0 {
+		opts.QueueBacklog = DefaultQueueBacklog
+	}
+	return &Aggregator{sources: sources, opts: opts, now: time.Now}
+}
+
+// WithClock replaces the aggregator's clock, for tests
+func (a *Aggregator) WithClock(now func() time.Time) *Aggregator {
+	a.now = now
+	return a
+}
+
+// Snapshot returns the cached snapshot, refreshing it once it is older than the TTL.
+// Concurrent callers wait for a single refresh.
+func (a *Aggregator) Snapshot() *Snapshot {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.snapshot != nil && a.now().Sub(a.snapshot.CheckedAt) < a.opts.TTL {
+		return a.snapshot
+	}
+	// Not bound to the caller's request: a cancelled request must not cache an outage
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+	a.snapshot = a.collect(ctx)
+	return a.snapshot
+}
+
+func (a *Aggregator) collect(ctx context.Context) *Snapshot {
+	checkedAt := a.now()
+	snapshot := &Snapshot{CheckedAt: checkedAt.UTC()}
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go func() { defer wg.Done(); snapshot.Services = a.checkServices(ctx) }()
+	go func() { defer wg.Done(); snapshot.Chains = a.checkChains(ctx, checkedAt) }()
+	go func() { defer wg.Done(); snapshot.Queues = a.checkQueues(ctx) }()
+	go func() { defer wg.Done(); snapshot.Pinning = a.checkPinning(ctx) }()
+	wg.Wait()
+
+	// A service that is down is an outage; everything else at worst degrades the platform
+	snapshot.Status = Operational
+	for _, s := range snapshot.Services {
+		snapshot.Status = worst(snapshot.Status, s.Status)
+	}
+	for _, c := range snapshot.Chains {
+		snapshot.Status = worst(snapshot.Status, capDegraded(c.Status))
+	}
+	for _, q := range snapshot.Queues {
+		snapshot.Status = worst(snapshot.Status, capDegraded(q.Status))
+	}
+	snapshot.Status = worst(snapshot.Status, capDegraded(snapshot.Pinning.Status))
+	return snapshot
+}
+
+func (a *Aggregator) checkServices(ctx context.Context) []ServiceStatus {
+	names := make([]string, 0, len(a.sources.Services))
+	for name := range a.sources.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	statuses := make([]ServiceStatus, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i] = ServiceStatus{Name: name, Status: checkService(ctx, name, a.sources.Services[name])}
+		}()
+	}
+	wg.Wait()
+	return statuses
+}
+
+func checkService(ctx context.Context, name string, client healthpb.HealthClient) string {
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	// A service not exposing the health check yet still answered
+	if status.Code(err) == codes.Unimplemented {
+		return Operational
+	}
+	if err != nil {
+		log.Printf("Platform status: %s health check failed: %v", name, err)
+		return Outage
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return Outage
+	}
+	return Operational
+}
+
+// checkChains reports how long ago each chain's indexer saw the head advance. A chain
+// the indexer has not reported, or whose head went stale, is degraded.
+func (a *Aggregator) checkChains(ctx context.Context, now time.Time) []ChainStatus {
+	statuses := make([]ChainStatus, 0, len(a.opts.Chains))
+	for _, chainID := range a.opts.Chains {
+		chain := ChainStatus{ChainID: chainID, Status: Degraded}
+		if a.sources.ChainRegistry == nil {
+			statuses = append(statuses, chain)
+			continue
+		}
+		resp, err := a.sources.ChainRegistry.GetChainHead(ctx, &chainregpb.GetChainHeadRequest{ChainId: chainID})
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				log.Printf("Platform status: chain head of %s unavailable: %v", chainID, err)
+			}
+			statuses = append(statuses, chain)
+			continue
+		}
+		head := resp.GetHead()
+		chain.LatestBlock = head.GetLatestBlock()
+		if advancedAt, err := time.Parse(time.RFC3339, head.GetAdvancedAt()); err == nil {
+			chain.AdvancedAt = &advancedAt
+			chain.LagSeconds = max(0, int(now.Sub(advancedAt).Seconds()))
+		}
+		if !head.GetStale() && chain.AdvancedAt != nil {
+			chain.Status = Operational
+		}
+		statuses = append(statuses, chain)
+	}
+	return statuses
+}
+
+// checkQueues reports the backlog of the queues catalog-service watches. A queue reads as
+// degraded past the backlog threshold, when its consumers fall behind or stop reporting,
+// or when the broker could not be asked about it.
+func (a *Aggregator) checkQueues(ctx context.Context) []QueueStatus {
+	if a.sources.Catalog == nil {
+		return nil
+	}
+	resp, err := a.sources.Catalog.GetSystemStatus(ctx, &catalogpb.GetSystemStatusRequest{})
+	if err != nil {
+		log.Printf("Platform status: queue status unavailable: %v", err)
+		return nil
+	}
+
+	lagging := make(map[string]bool)
+	for _, c := range resp.GetConsumers() {
+		if c.GetFallingBehind() || c.GetStale() {
+			lagging[c.GetQueue()] = true
+		}
+	}
+	statuses := make([]QueueStatus, 0, len(resp.GetQueues()))
+	for _, q := range resp.GetQueues() {
+		queue := QueueStatus{Name: q.GetName(), Status: Operational, Messages: int(q.GetMessages())}
+		if q.GetError() != "" || queue.Messages >= a.opts.QueueBacklog || lagging[queue.Name] {
+			queue.Status = Degraded
+		}
+		statuses = append(statuses, queue)
+	}
+	return statuses
+}
+
+func (a *Aggregator) checkPinning(ctx context.Context) PinningStatus {
+	if a.sources.Media == nil {
+		return PinningStatus{Status: Degraded}
+	}
+	resp, err := a.sources.Media.GetPinHealth(ctx, &mediapb.GetPinHealthRequest{})
+	if err != nil {
+		log.Printf("Platform status: pin health unavailable: %v", err)
+		return PinningStatus{Status: Degraded}
+	}
+	pinning := PinningStatus{Status: Operational}
+	if resp.GetLastPinnedAt() != nil {
+		lastPinnedAt := resp.GetLastPinnedAt().AsTime()
+		pinning.LastPinnedAt = &lastPinnedAt
+	}
+	return pinning
+}
+
+var levels = map[string]int{Operational: 0, Degraded: 1, Outage: 2}
+
+func worst(a, b string) string {
+	if levels[b] > levels[a] {
+		return b
+	}
+	return a
+}
+
+func capDegraded(level string) string {
+	if level == Outage {
+		return Degraded
+	}
+	return level
+}
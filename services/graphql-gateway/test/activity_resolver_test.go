@@ -0,0 +1,138 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const activityWallet = "0x00000000000000000000000000000000000000aa"
+
+// stubActivityCatalog serves ListWalletActivity from a fixed timeline; other catalog
+// calls are not used by walletActivity
+type stubActivityCatalog struct {
+	catalogpb.CatalogServiceClient
+	timeline []*catalogpb.WalletActivity
+	requests []*catalogpb.ListWalletActivityRequest
+}
+
+func (s *stubActivityCatalog) ListWalletActivity(ctx context.Context, req *catalogpb.ListWalletActivityRequest, opts ...grpc.CallOption) (*catalogpb.ListWalletActivityResponse, error) {
+	s.requests = append(s.requests, req)
+	var out []*catalogpb.WalletActivity
+	for _, a := range s.timeline {
+		if req.Before != nil && !a.OccurredAt.AsTime().Before(req.Before.AsTime()) {
+			continue
+		}
+		if len(out) < int(req.Limit) {
+			out = append(out, a)
+		}
+	}
+	return &catalogpb.ListWalletActivityResponse{Activities: out}, nil
+}
+
+func activityResolver(catalog *stubActivityCatalog, orchestrator *MockOrchestratorServiceClient, wallet *MockWalletServiceClient) schemas.QueryResolver {
+	var cc catalogpb.CatalogServiceClient = catalog
+	var oc orchestratorpb.OrchestratorServiceClient = orchestrator
+	var wc walletpb.WalletServiceClient = wallet
+	return graphql_resolver.NewResolver(nil, &grpcclients.WalletClient{Client: &wc}, nil).
+		WithCatalogClient(&grpcclients.CatalogClient{Client: &cc}).
+		WithOrchestratorClient(&grpcclients.OrchestratorClient{Client: &oc}).
+		Query()
+}
+
+func activityContext() context.Context {
+	return context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1"})
+}
+
+func TestWalletActivity_MergesSourcesAcrossPages(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) *timestamppb.Timestamp {
+		return timestamppb.New(base.Add(time.Duration(minutes) * time.Minute))
+	}
+
+	catalog := &stubActivityCatalog{timeline: []*catalogpb.WalletActivity{
+		{Id: "sale-1", Kind: "sale", ChainId: "eip155-1", Price: "1000", OccurredAt: at(5)},
+		{Id: "mint-1", Kind: "mint", ChainId: "eip155-1", Quantity: "1", OccurredAt: at(2)},
+	}}
+	wallet := new(MockWalletServiceClient)
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "user-1"}).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: "0x00000000000000000000000000000000000000AA"}},
+	}, nil)
+	orchestrator := new(MockOrchestratorServiceClient)
+	orchestrator.On("ListIntents", mock.Anything, mock.MatchedBy(func(req *orchestratorpb.ListIntentsRequest) bool {
+		return req.Before == nil
+	})).Return(&orchestratorpb.ListIntentsResponse{Intents: []*orchestratorpb.Intent{
+		{IntentId: "intent-b", Kind: "bid", Status: "ready", ChainId: "eip155:1", CreatedAt: at(4)},
+		{IntentId: "intent-a", Kind: "mint", Status: "pending", ChainId: "eip155:1", CreatedAt: at(1)},
+	}}, nil).Once()
+	orchestrator.On("ListIntents", mock.Anything, mock.MatchedBy(func(req *orchestratorpb.ListIntentsRequest) bool {
+		return req.Before != nil && req.BeforeId == "intent-b"
+	})).Return(&orchestratorpb.ListIntentsResponse{Intents: []*orchestratorpb.Intent{
+		{IntentId: "intent-a", Kind: "mint", Status: "pending", ChainId: "eip155:1", CreatedAt: at(1)},
+	}}, nil).Once()
+
+	resolver := activityResolver(catalog, orchestrator, wallet)
+	ctx := activityContext()
+	limit := 2
+
+	first, err := resolver.WalletActivity(ctx, activityWallet, nil, &limit)
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+	assert.Equal(t, "sale-1", first.Items[0].ID)
+	assert.Equal(t, "eip155:1", first.Items[0].ChainID)
+	assert.Equal(t, schemas.WalletActivityKindIntent, first.Items[1].Kind)
+	assert.Equal(t, "confirmed", *first.Items[1].IntentStatus)
+	require.NotNil(t, first.NextCursor)
+
+	second, err := resolver.WalletActivity(ctx, activityWallet, first.NextCursor, &limit)
+	require.NoError(t, err)
+	require.Len(t, second.Items, 2)
+	assert.Equal(t, "mint-1", second.Items[0].ID)
+	assert.Equal(t, "intent:intent-a", second.Items[1].ID)
+	assert.Equal(t, "prepared", *second.Items[1].IntentStatus)
+	assert.Nil(t, second.NextCursor)
+
+	// each source resumes from its own last item
+	assert.Equal(t, "sale-1", catalog.requests[1].BeforeId)
+	orchestrator.AssertExpectations(t)
+}
+
+func TestWalletActivity_RejectsUnlinkedAddress(t *testing.T) {
+	wallet := new(MockWalletServiceClient)
+	wallet.On("ListLinks", mock.Anything, mock.Anything).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: "0x00000000000000000000000000000000000000bb"}},
+	}, nil)
+	catalog := &stubActivityCatalog{}
+	resolver := activityResolver(catalog, new(MockOrchestratorServiceClient), wallet)
+
+	_, err := resolver.WalletActivity(activityContext(), activityWallet, nil, nil)
+	assert.Error(t, err)
+	assert.Empty(t, catalog.requests)
+
+	// A watch-only wallet is tracked, not owned, so its intents stay hidden
+	watching := new(MockWalletServiceClient)
+	watching.On("ListLinks", mock.Anything, mock.Anything).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: activityWallet, IsWatchOnly: true}},
+	}, nil)
+	_, err = activityResolver(catalog, new(MockOrchestratorServiceClient), watching).WalletActivity(activityContext(), activityWallet, nil, nil)
+	assert.Error(t, err)
+	assert.Empty(t, catalog.requests)
+
+	bad := "not-a-cursor!"
+	_, err = resolver.WalletActivity(activityContext(), activityWallet, &bad, nil)
+	assert.Error(t, err)
+}
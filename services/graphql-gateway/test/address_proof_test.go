@@ -0,0 +1,102 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const coldWallet = "0x00000000000000000000000000000000000000c0"
+
+// stubGateCatalog records the owners a token gate is checked for
+type stubGateCatalog struct {
+	catalogpb.CatalogServiceClient
+	gateRequest *catalogpb.VerifyTokenGateRequest
+}
+
+func (s *stubGateCatalog) VerifyTokenGate(ctx context.Context, req *catalogpb.VerifyTokenGateRequest, opts ...grpc.CallOption) (*catalogpb.VerifyTokenGateResponse, error) {
+	s.gateRequest = req
+	return &catalogpb.VerifyTokenGateResponse{Held: true, Balance: "1", GateToken: "gate"}, nil
+}
+
+func addressProofResolver(wallet *MockWalletServiceClient, catalog *stubGateCatalog) *graphql_resolver.Resolver {
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "viewer-1"}).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: delegateHot}},
+	}, nil)
+
+	var cc catalogpb.CatalogServiceClient = catalog
+	var wc walletpb.WalletServiceClient = wallet
+	return graphql_resolver.NewResolver(nil, &grpcclients.WalletClient{Client: &wc}, nil).
+		WithCatalogClient(&grpcclients.CatalogClient{Client: &cc})
+}
+
+func TestCreateAddressChallenge_ScopedToCaller(t *testing.T) {
+	wallet := new(MockWalletServiceClient)
+	resolver := addressProofResolver(wallet, &stubGateCatalog{}).Mutation()
+	wallet.On("CreateSignatureChallenge", mock.Anything, &walletpb.CreateSignatureChallengeRequest{
+		Purpose: "token_gate",
+		Address: coldWallet,
+		ChainId: "eip155:1",
+		UserId:  "viewer-1",
+		Scheme:  walletpb.SignatureScheme_SIGNATURE_SCHEME_EIP712,
+	}).Return(&walletpb.CreateSignatureChallengeResponse{
+		ChallengeId: "challenge-1",
+		Message:     "{}",
+		Scheme:      walletpb.SignatureScheme_SIGNATURE_SCHEME_EIP712,
+		ExpiresAt:   timestamppb.Now(),
+	}, nil)
+
+	scheme := schemas.SignatureSchemeEip712
+	challenge, err := resolver.CreateAddressChallenge(viewerContext("viewer-1"), schemas.AddressChallengePurposeTokenGate, coldWallet, "eip155:1", &scheme)
+
+	require.NoError(t, err)
+	assert.Equal(t, "challenge-1", challenge.ID)
+	assert.Equal(t, schemas.SignatureSchemeEip712, challenge.Scheme)
+	wallet.AssertNumberOfCalls(t, "CreateSignatureChallenge", 1)
+}
+
+func TestVerifyTokenGate_CountsProvenAddress(t *testing.T) {
+	wallet := new(MockWalletServiceClient)
+	catalog := &stubGateCatalog{}
+	resolver := addressProofResolver(wallet, catalog).Mutation()
+	wallet.On("VerifySignatureChallenge", mock.Anything, &walletpb.VerifySignatureChallengeRequest{
+		ChallengeId: "challenge-1",
+		Signature:   "0xsig",
+		Purpose:     "token_gate",
+		UserId:      "viewer-1",
+	}).Return(&walletpb.VerifySignatureChallengeResponse{Address: coldWallet, Method: "eip1271"}, nil)
+
+	result, err := resolver.VerifyTokenGate(viewerContext("viewer-1"), "eip155:1", delegateVault, nil,
+		&schemas.AddressProofInput{ChallengeID: "challenge-1", Signature: "0xsig"})
+
+	require.NoError(t, err)
+	assert.True(t, result.Held)
+	assert.Equal(t, []string{delegateHot, coldWallet}, catalog.gateRequest.Owners)
+}
+
+func TestVerifyTokenGate_RejectsUsedProof(t *testing.T) {
+	wallet := new(MockWalletServiceClient)
+	catalog := &stubGateCatalog{}
+	resolver := addressProofResolver(wallet, catalog).Mutation()
+	wallet.On("VerifySignatureChallenge", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.NotFound, "signature_challenge_not_found"))
+
+	_, err := resolver.VerifyTokenGate(viewerContext("viewer-1"), "eip155:1", delegateVault, nil,
+		&schemas.AddressProofInput{ChallengeID: "challenge-1", Signature: "0xsig"})
+
+	assert.EqualError(t, err, "address challenge expired or already used")
+	assert.Nil(t, catalog.gateRequest)
+}
@@ -0,0 +1,196 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+var freePlan = &authpb.ApiPlanLimits{Plan: "free", DailyQuota: 1000, BurstPerSecond: 5, Enforcement: "hard"}
+
+// serveWithAPIKey runs a request carrying key through the API key middleware and returns
+// the response and the user the next handler saw
+func serveWithAPIKey(t *testing.T, key string, authorize middleware.APIKeyAuthorizer, ctx context.Context) (*httptest.ResponseRecorder, *middleware.CurrentUser) {
+	t.Helper()
+	var seen *middleware.CurrentUser
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = middleware.GetCurrentUser(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+	req := httptest.NewRequest("POST", "/graphql", nil).WithContext(ctx)
+	if key != "" {
+		req.Header.Set(middleware.APIKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	middleware.APIKeyMiddleware(authorize)(next).ServeHTTP(rec, req)
+	return rec, seen
+}
+
+func TestAPIKeyMiddleware_ActsAsKeyOwner(t *testing.T) {
+	authorize := func(ctx context.Context, key string) (*authpb.AuthorizeApiKeyResponse, error) {
+		assert.Equal(t, "zk_good", key)
+		return &authpb.AuthorizeApiKeyResponse{
+			Allowed: true, UserId: "user-1", KeyId: "key-1", Limits: freePlan, UsedToday: 10, ResetsAt: "2026-10-16T00:00:00Z",
+		}, nil
+	}
+
+	rec, user := serveWithAPIKey(t, "zk_good", authorize, context.Background())
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, user)
+	assert.Equal(t, "user-1", user.UserID)
+	assert.Equal(t, "key-1", user.APIKeyID)
+	assert.Empty(t, user.SessionID)
+	assert.Equal(t, "free", rec.Header().Get("X-Api-Plan"))
+	assert.Equal(t, "1000", rec.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "990", rec.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "1792108800", rec.Header().Get("X-RateLimit-Reset"))
+	assert.Empty(t, rec.Header().Get("X-Quota-Overage"))
+}
+
+func TestAPIKeyMiddleware_SessionWinsAndNoKeyPasses(t *testing.T) {
+	authorize := func(ctx context.Context, key string) (*authpb.AuthorizeApiKeyResponse, error) {
+		t.Fatal("the key must not be counted")
+		return nil, nil
+	}
+
+	rec, user := serveWithAPIKey(t, "", authorize, context.Background())
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Nil(t, user)
+
+	session := &middleware.CurrentUser{UserID: "user-2", SessionID: "session-2"}
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, session)
+	rec, user = serveWithAPIKey(t, "zk_good", authorize, ctx)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Same(t, session, user)
+}
+
+func TestAPIKeyMiddleware_Refusals(t *testing.T) {
+	tests := []struct {
+		name       string
+		resp       *authpb.AuthorizeApiKeyResponse
+		err        error
+		wantCode   int
+		wantRetry  string
+		wantErrTag string
+	}{
+		{
+			name:       "invalid key",
+			err:        status.Error(codes.Unauthenticated, "API key invalid"),
+			wantCode:   http.StatusUnauthorized,
+			wantErrTag: "UNAUTHENTICATED",
+		},
+		{
+			name:       "auth service down",
+			err:        status.Error(codes.Unavailable, "connection refused"),
+			wantCode:   http.StatusServiceUnavailable,
+			wantErrTag: "UNAVAILABLE",
+		},
+		{
+			name:       "burst",
+			resp:       &authpb.AuthorizeApiKeyResponse{Limits: freePlan, RejectReason: "burst", RetryAfterMs: 300},
+			wantCode:   http.StatusTooManyRequests,
+			wantRetry:  "1",
+			wantErrTag: "RATE_LIMITED",
+		},
+		{
+			name:       "quota",
+			resp:       &authpb.AuthorizeApiKeyResponse{Limits: freePlan, UsedToday: 1000, OverQuota: true, RejectReason: "quota", RetryAfterMs: 3600500},
+			wantCode:   http.StatusTooManyRequests,
+			wantRetry:  "3601",
+			wantErrTag: "QUOTA_EXCEEDED",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authorize := func(ctx context.Context, key string) (*authpb.AuthorizeApiKeyResponse, error) {
+				return tc.resp, tc.err
+			}
+			rec, user := serveWithAPIKey(t, "zk_key", authorize, context.Background())
+			assert.Equal(t, tc.wantCode, rec.Code)
+			assert.Nil(t, user)
+			assert.Equal(t, tc.wantRetry, rec.Header().Get("Retry-After"))
+			assert.Contains(t, rec.Body.String(), tc.wantErrTag)
+		})
+	}
+}
+
+func TestAPIKeyMiddleware_SoftQuotaOverage(t *testing.T) {
+	authorize := func(ctx context.Context, key string) (*authpb.AuthorizeApiKeyResponse, error) {
+		return &authpb.AuthorizeApiKeyResponse{
+			Allowed: true, UserId: "user-1", KeyId: "key-1", OverQuota: true, UsedToday: 120,
+			Limits: &authpb.ApiPlanLimits{Plan: "pro", DailyQuota: 100, Enforcement: "soft"},
+		}, nil
+	}
+
+	rec, user := serveWithAPIKey(t, "zk_pro", authorize, context.Background())
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, user)
+	assert.Equal(t, "true", rec.Header().Get("X-Quota-Overage"))
+	assert.Equal(t, "0", rec.Header().Get("X-RateLimit-Remaining"))
+}
+
+func TestAPIKeyCallers_CannotAdministerOrManageKeys(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	mockAuthClient := new(MockAuthServiceClient)
+	var ac authpb.AuthServiceClient = mockAuthClient
+	resolver := graphql_resolver.NewResolver(&grpcclients.AuthClient{Client: &ac}, nil, nil).Mutation()
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "admin-1", APIKeyID: "key-1"})
+
+	_, err := middleware.RequireAdmin(ctx)
+	assert.ErrorIs(t, err, middleware.ErrAdminRequired)
+	_, err = resolver.SetAPIKeyPlan(ctx, "key-2", schemas.APIPlanPro)
+	assert.Error(t, err)
+	_, err = resolver.CreateAPIKey(ctx, "another")
+	assert.ErrorContains(t, err, "API keys cannot manage API keys")
+	_, err = resolver.RevokeAPIKey(ctx, "key-1")
+	assert.Error(t, err)
+	mockAuthClient.AssertNotCalled(t, "CreateApiKey", mock.Anything, mock.Anything)
+}
+
+func TestMyAPIUsage(t *testing.T) {
+	mockAuthClient := new(MockAuthServiceClient)
+	var ac authpb.AuthServiceClient = mockAuthClient
+	resolver := graphql_resolver.NewResolver(&grpcclients.AuthClient{Client: &ac}, nil, nil).Query()
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1", APIKeyID: "key-1"})
+
+	mockAuthClient.On("GetApiUsage", mock.Anything, &authpb.GetApiUsageRequest{UserId: "user-1", Days: 2}).Return(&authpb.GetApiUsageResponse{
+		Keys: []*authpb.ApiKeyUsage{{
+			Key:       &authpb.ApiKey{Id: "key-1", Name: "bot", Prefix: "zk_1a2b3c4d", Plan: "pro", CreatedAt: "2026-10-01T08:00:00Z"},
+			Limits:    &authpb.ApiPlanLimits{Plan: "pro", DailyQuota: 100000, Enforcement: "soft"},
+			UsedToday: 42,
+			Hours:     []*authpb.ApiUsageHour{{Hour: "2026-10-15T09:00:00Z", Requests: 40, Rejected: 2}},
+		}},
+	}, nil)
+
+	days := 2
+	usage, err := resolver.MyAPIUsage(ctx, &days)
+	require.NoError(t, err)
+	require.Len(t, usage, 1)
+	u := usage[0]
+	assert.Equal(t, "zk_1a2b3c4d", u.APIKey.Prefix)
+	assert.Nil(t, u.APIKey.LastUsedAt)
+	assert.Equal(t, schemas.APIPlanPro, u.Limits.Plan)
+	assert.Equal(t, 100000, *u.Limits.DailyQuota)
+	assert.Nil(t, u.Limits.BurstPerSecond)
+	assert.Equal(t, schemas.QuotaEnforcementSoft, u.Limits.Enforcement)
+	assert.Equal(t, 42, u.UsedToday)
+	assert.Equal(t, []*schemas.APIUsageHour{{Hour: "2026-10-15T09:00:00Z", Requests: 40, Rejected: 2}}, u.Hours)
+
+	days = 31
+	_, err = resolver.MyAPIUsage(ctx, &days)
+	assert.Error(t, err)
+	mockAuthClient.AssertExpectations(t)
+}
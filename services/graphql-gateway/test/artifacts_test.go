@@ -0,0 +1,76 @@
+package test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/artifacts"
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+)
+
+func TestArtifacts_ServesSignedDownload(t *testing.T) {
+	media := new(MockMediaServiceClient)
+	media.On("DownloadArtifact", mock.Anything, &mediapb.DownloadArtifactRequest{Id: "artifact-1", Expires: 1700000000, Signature: "abcd"}).
+		Return(&mediapb.DownloadArtifactResponse{
+			Artifact: &mediapb.Artifact{Id: "artifact-1", Name: "holders.csv", Mime: "text/csv"},
+			Content:  []byte("holder,balance,token_count\n"),
+		}, nil)
+
+	rec := httptest.NewRecorder()
+	artifacts.NewHandler(media).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/artifacts/artifact-1?expires=1700000000&sig=abcd", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="holders.csv"`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "holder,balance,token_count\n", rec.Body.String())
+}
+
+func TestArtifacts_RejectsBadLinks(t *testing.T) {
+	media := new(MockMediaServiceClient)
+	media.On("DownloadArtifact", mock.Anything, mock.MatchedBy(func(req *mediapb.DownloadArtifactRequest) bool { return req.Id == "forged" })).
+		Return(nil, status.Error(codes.PermissionDenied, "invalid or expired download signature"))
+	media.On("DownloadArtifact", mock.Anything, mock.MatchedBy(func(req *mediapb.DownloadArtifactRequest) bool { return req.Id == "gone" })).
+		Return(nil, status.Error(codes.NotFound, "artifact not found"))
+	handler := artifacts.NewHandler(media)
+
+	cases := map[string]int{
+		"/artifacts/forged?expires=1&sig=ab": http.StatusForbidden,
+		"/artifacts/gone?expires=1&sig=ab":   http.StatusNotFound,
+		"/artifacts/a?sig=ab":                http.StatusBadRequest,
+		"/artifacts/a?expires=1":             http.StatusBadRequest,
+		"/artifacts/?expires=1&sig=ab":       http.StatusBadRequest,
+	}
+	for target, code := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, code, rec.Code, target)
+	}
+}
+
+func TestArtifacts_ForwardsGateToken(t *testing.T) {
+	media := new(MockMediaServiceClient)
+	media.On("DownloadArtifact", mock.Anything, mock.MatchedBy(func(req *mediapb.DownloadArtifactRequest) bool { return req.GetGateToken() == "from-header" })).
+		Return(&mediapb.DownloadArtifactResponse{Artifact: &mediapb.Artifact{Name: "hires.png", Mime: "image/png"}, Content: []byte("png")}, nil)
+	media.On("DownloadArtifact", mock.Anything, mock.MatchedBy(func(req *mediapb.DownloadArtifactRequest) bool { return req.GetGateToken() == "from-query" })).
+		Return(&mediapb.DownloadArtifactResponse{Artifact: &mediapb.Artifact{Name: "hires.png", Mime: "image/png"}, Content: []byte("png")}, nil)
+	handler := artifacts.NewHandler(media)
+
+	req := httptest.NewRequest(http.MethodGet, "/artifacts/a?expires=1&sig=ab&gate=from-query", nil)
+	req.Header.Set("X-Gate-Token", "from-header")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/artifacts/a?expires=1&sig=ab&gate=from-query", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	media.AssertNumberOfCalls(t, "DownloadArtifact", 2)
+}
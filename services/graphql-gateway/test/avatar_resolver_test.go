@@ -0,0 +1,96 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+// stubAvatarUsers sets NFT avatars for tokens listed in held and serves one profile
+type stubAvatarUsers struct {
+	userpb.UserServiceClient
+	held    map[string]bool
+	avatar  *userpb.NftAvatar
+	cleared []string
+}
+
+func (s *stubAvatarUsers) SetAvatarFromNft(ctx context.Context, req *userpb.SetAvatarFromNftRequest, opts ...grpc.CallOption) (*userpb.SetAvatarFromNftResponse, error) {
+	if !s.held[req.TokenId] {
+		return nil, status.Error(codes.FailedPrecondition, "none of your signed wallets holds this token")
+	}
+	s.avatar = &userpb.NftAvatar{
+		ChainId: req.ChainId, Contract: req.Contract, TokenId: req.TokenId,
+		Verified: true, VerifiedAt: "2026-10-15T12:00:00Z", CheckedAt: "2026-10-15T12:00:00Z",
+	}
+	return &userpb.SetAvatarFromNftResponse{Avatar: s.avatar}, nil
+}
+
+func (s *stubAvatarUsers) ClearNftAvatar(ctx context.Context, req *userpb.ClearNftAvatarRequest, opts ...grpc.CallOption) (*userpb.ClearNftAvatarResponse, error) {
+	s.cleared = append(s.cleared, req.UserId)
+	return &userpb.ClearNftAvatarResponse{}, nil
+}
+
+func (s *stubAvatarUsers) GetProfileAccess(ctx context.Context, req *userpb.GetProfileAccessRequest, opts ...grpc.CallOption) (*userpb.GetProfileAccessResponse, error) {
+	return &userpb.GetProfileAccessResponse{Visibility: "public"}, nil
+}
+
+func (s *stubAvatarUsers) GetUsersByIDs(ctx context.Context, req *userpb.GetUsersByIDsRequest, opts ...grpc.CallOption) (*userpb.GetUsersByIDsResponse, error) {
+	return &userpb.GetUsersByIDsResponse{Users: []*userpb.UserCard{{
+		Found:   true,
+		User:    &userpb.User{Id: req.UserIds[0]},
+		Profile: &userpb.Profile{Username: "holder", NftAvatar: s.avatar},
+	}}}, nil
+}
+
+func avatarResolver(users *stubAvatarUsers) *graphql_resolver.Resolver {
+	var uc userpb.UserServiceClient = users
+	return graphql_resolver.NewResolver(nil, nil, nil).WithUserClient(&grpcclients.UserClient{Client: &uc})
+}
+
+func TestSetAvatarFromNft_RequiresHeldToken(t *testing.T) {
+	users := &stubAvatarUsers{held: map[string]bool{"42": true}}
+	resolver := avatarResolver(users)
+
+	_, err := resolver.Mutation().SetAvatarFromNft(context.Background(), "eip155:1", creatorContract, "42")
+	require.Error(t, err, "setting an avatar requires authentication")
+
+	_, err = resolver.Mutation().SetAvatarFromNft(viewerContext("user-1"), "eip155:1", creatorContract, "7")
+	require.Error(t, err)
+	assert.Equal(t, "none of your signed wallets holds this token", err.Error())
+
+	avatar, err := resolver.Mutation().SetAvatarFromNft(viewerContext("user-1"), "eip155:1", creatorContract, "42")
+	require.NoError(t, err)
+	assert.True(t, avatar.Verified)
+	require.NotNil(t, avatar.VerifiedAt)
+
+	profile, err := resolver.Query().UserProfile(context.Background(), "user-1")
+	require.NoError(t, err)
+	require.NotNil(t, profile.NftAvatar)
+	assert.Equal(t, "42", profile.NftAvatar.TokenID)
+	assert.True(t, profile.VerifiedNftAvatar)
+}
+
+func TestUserProfile_UnverifiedNftAvatar(t *testing.T) {
+	users := &stubAvatarUsers{avatar: &userpb.NftAvatar{ChainId: "eip155:1", Contract: creatorContract, TokenId: "42", CheckedAt: "2026-10-15T12:00:00Z"}}
+	resolver := avatarResolver(users)
+
+	profile, err := resolver.Query().UserProfile(context.Background(), "user-1")
+	require.NoError(t, err)
+	require.NotNil(t, profile.NftAvatar, "a sold token stays the avatar")
+	assert.False(t, profile.VerifiedNftAvatar)
+	assert.Nil(t, profile.NftAvatar.VerifiedAt)
+
+	ok, err := resolver.Mutation().ClearNftAvatar(viewerContext("user-1"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"user-1"}, users.cleared)
+}
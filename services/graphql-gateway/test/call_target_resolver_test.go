@@ -0,0 +1,43 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+)
+
+func TestAllowCallTarget_AdminOnly(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	orchestrator := &MockOrchestratorServiceClient{}
+	var oc orchestratorpb.OrchestratorServiceClient = orchestrator
+	resolver := graphql_resolver.NewResolver(nil, nil, nil).
+		WithOrchestratorClient(&grpcclients.OrchestratorClient{Client: &oc}).
+		Mutation()
+	as := func(userID string) context.Context {
+		return context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: userID})
+	}
+	target := "0x00000000000000000000000000000000000000ee"
+
+	_, err := resolver.AllowCallTarget(as("user-1"), "eip155:1", target, "partner drop")
+	assert.ErrorIs(t, err, middleware.ErrAdminRequired)
+	orchestrator.AssertNotCalled(t, "AllowCallTarget", mock.Anything, mock.Anything)
+
+	orchestrator.On("AllowCallTarget", mock.Anything, mock.MatchedBy(func(req *orchestratorpb.AllowCallTargetRequest) bool {
+		return req.ActorId == "admin-1" && req.Address == target && req.Reason == "partner drop"
+	})).Return(&orchestratorpb.AllowCallTargetResponse{Override: &orchestratorpb.CallTargetOverride{
+		ChainId: "eip155:1", Address: target, Reason: "partner drop", GrantedBy: "admin-1",
+	}}, nil)
+
+	override, err := resolver.AllowCallTarget(as("admin-1"), "eip155:1", target, "partner drop")
+	require.NoError(t, err)
+	assert.Equal(t, target, override.Address)
+	assert.Equal(t, "admin-1", override.GrantedBy)
+}
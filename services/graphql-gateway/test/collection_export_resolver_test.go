@@ -0,0 +1,156 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+)
+
+// stubExportCatalog serves an organization's collection and records export requests
+type stubExportCatalog struct {
+	catalogpb.CatalogServiceClient
+	requests []*catalogpb.CreateCollectionExportRequest
+}
+
+func (s *stubExportCatalog) GetCollection(ctx context.Context, req *catalogpb.GetCollectionRequest, opts ...grpc.CallOption) (*catalogpb.GetCollectionResponse, error) {
+	return &catalogpb.GetCollectionResponse{Collection: &catalogpb.Collection{
+		ChainId:         req.ChainId,
+		ContractAddress: req.ContractAddress,
+		OwnerOrgId:      "org-1",
+	}}, nil
+}
+
+func (s *stubExportCatalog) CreateCollectionExport(ctx context.Context, req *catalogpb.CreateCollectionExportRequest, opts ...grpc.CallOption) (*catalogpb.CreateCollectionExportResponse, error) {
+	if req.To.AsTime().Sub(req.From.AsTime()) > 366*24*time.Hour {
+		return nil, status.Error(codes.InvalidArgument, "export period may span at most 366 days")
+	}
+	s.requests = append(s.requests, req)
+	return &catalogpb.CreateCollectionExportResponse{Export: &catalogpb.CollectionExport{
+		Id:              "export-1",
+		Kind:            req.Kind,
+		ChainId:         req.ChainId,
+		ContractAddress: req.ContractAddress,
+		From:            req.From,
+		To:              req.To,
+		RequestedBy:     req.RequestedBy,
+		Status:          "ready",
+		RowCount:        2,
+		Csv: &catalogpb.SnapshotExport{
+			ArtifactId:   "artifact-1",
+			DownloadUrl:  "https://gateway.test/artifacts/artifact-1?sig=x",
+			UrlExpiresAt: timestamppb.New(time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)),
+			Bytes:        180,
+		},
+		CreatedAt:   timestamppb.New(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)),
+		CompletedAt: timestamppb.New(time.Date(2026, 10, 1, 12, 0, 1, 0, time.UTC)),
+	}}, nil
+}
+
+func (s *stubExportCatalog) GetCollectionExport(ctx context.Context, req *catalogpb.GetCollectionExportRequest, opts ...grpc.CallOption) (*catalogpb.GetCollectionExportResponse, error) {
+	if req.Id != "export-1" {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &catalogpb.GetCollectionExportResponse{Export: &catalogpb.CollectionExport{
+		Id:          "export-1",
+		Kind:        "mints",
+		RequestedBy: "user-1",
+		Status:      "pending",
+		RowCount:    5000,
+		From:        timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		To:          timestamppb.New(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   timestamppb.New(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)),
+	}}, nil
+}
+
+func exportResolver(catalog *stubExportCatalog) *graphql_resolver.Resolver {
+	var cc catalogpb.CatalogServiceClient = catalog
+	return graphql_resolver.NewResolver(nil, nil, nil).WithCatalogClient(&grpcclients.CatalogClient{Client: &cc})
+}
+
+func TestExportSales_OrganizationAdminGetsCSV(t *testing.T) {
+	catalog := &stubExportCatalog{}
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{
+		UserID: "user-1",
+		Orgs:   map[string]string{"org-1": "admin"},
+	})
+
+	export, err := exportResolver(catalog).Mutation().ExportSales(ctx, "eip155-1", "0xabc", "2026-09-01T00:00:00Z", "2026-10-01T00:00:00Z")
+	require.NoError(t, err)
+
+	require.Len(t, catalog.requests, 1)
+	assert.Equal(t, "sales", catalog.requests[0].Kind)
+	assert.Equal(t, "user-1", catalog.requests[0].RequestedBy)
+	assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), catalog.requests[0].From.AsTime())
+
+	assert.Equal(t, schemas.CollectionExportKindSales, export.Kind)
+	assert.Equal(t, schemas.CollectionExportStatusReady, export.Status)
+	assert.Equal(t, 2, export.RowCount)
+	require.NotNil(t, export.CSV)
+	assert.Equal(t, "https://gateway.test/artifacts/artifact-1?sig=x", export.CSV.URL)
+	require.NotNil(t, export.CompletedAt)
+	assert.Nil(t, export.Error)
+}
+
+func TestExportMints_Rejections(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	catalog := &stubExportCatalog{}
+	resolver := exportResolver(catalog).Mutation()
+
+	_, err := resolver.ExportMints(context.Background(), "eip155-1", "0xabc", "2026-09-01T00:00:00Z", "2026-10-01T00:00:00Z")
+	assert.Error(t, err, "signed out")
+
+	memberCtx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{
+		UserID: "user-2",
+		Orgs:   map[string]string{"org-1": "member"},
+	})
+	_, err = resolver.ExportMints(memberCtx, "eip155-1", "0xabc", "2026-09-01T00:00:00Z", "2026-10-01T00:00:00Z")
+	assert.Error(t, err, "organization members below admin")
+
+	adminCtx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "admin-1"})
+	_, err = resolver.ExportMints(adminCtx, "eip155-1", "0xabc", "yesterday", "2026-10-01T00:00:00Z")
+	assert.Error(t, err, "invalid from")
+	assert.Empty(t, catalog.requests)
+
+	_, err = resolver.ExportMints(adminCtx, "eip155-1", "0xabc", "2024-01-01T00:00:00Z", "2026-10-01T00:00:00Z")
+	require.Error(t, err)
+	assert.Equal(t, "export period may span at most 366 days", err.Error())
+
+	export, err := resolver.ExportMints(adminCtx, "eip155-1", "0xabc", "2026-09-01T00:00:00Z", "2026-10-01T00:00:00Z")
+	require.NoError(t, err, "admins export any collection")
+	assert.Equal(t, schemas.CollectionExportKindMints, export.Kind)
+}
+
+func TestCollectionExport_OnlyRequesterSeesIt(t *testing.T) {
+	resolver := exportResolver(&stubExportCatalog{}).Query()
+
+	ownerCtx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1"})
+	export, err := resolver.CollectionExport(ownerCtx, "export-1")
+	require.NoError(t, err)
+	require.NotNil(t, export)
+	assert.Equal(t, schemas.CollectionExportStatusPending, export.Status)
+	assert.Nil(t, export.CSV)
+	assert.Nil(t, export.CompletedAt)
+	assert.Equal(t, "2026-01-01T00:00:00Z", export.From)
+
+	otherCtx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-2"})
+	export, err = resolver.CollectionExport(otherCtx, "export-1")
+	require.NoError(t, err)
+	assert.Nil(t, export)
+
+	export, err = resolver.CollectionExport(ownerCtx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, export)
+}
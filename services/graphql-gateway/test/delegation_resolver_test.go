@@ -0,0 +1,109 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const (
+	delegateHot   = "0x00000000000000000000000000000000000000d1"
+	delegateVault = "0x00000000000000000000000000000000000000f1"
+)
+
+// stubDelegationUsers answers the viewer's honorDelegations preference
+type stubDelegationUsers struct {
+	userpb.UserServiceClient
+	honor bool
+}
+
+func (s *stubDelegationUsers) GetPreferences(ctx context.Context, req *userpb.GetPreferencesRequest, opts ...grpc.CallOption) (*userpb.GetPreferencesResponse, error) {
+	return &userpb.GetPreferencesResponse{Preferences: &userpb.Preferences{UserId: req.UserId, HonorDelegations: s.honor}}, nil
+}
+
+// stubDelegationCatalog reports delegateVault delegating to delegateHot and records the
+// owners tokens are listed for
+type stubDelegationCatalog struct {
+	catalogpb.CatalogServiceClient
+	vaultErr    error
+	vaultCalls  int
+	listRequest *catalogpb.ListTokensRequest
+}
+
+func (s *stubDelegationCatalog) ListDelegatedVaults(ctx context.Context, req *catalogpb.ListDelegatedVaultsRequest, opts ...grpc.CallOption) (*catalogpb.ListDelegatedVaultsResponse, error) {
+	s.vaultCalls++
+	if s.vaultErr != nil {
+		return nil, s.vaultErr
+	}
+	return &catalogpb.ListDelegatedVaultsResponse{Vaults: []string{delegateVault}}, nil
+}
+
+func (s *stubDelegationCatalog) ListTokens(ctx context.Context, req *catalogpb.ListTokensRequest, opts ...grpc.CallOption) (*catalogpb.ListTokensResponse, error) {
+	s.listRequest = req
+	return &catalogpb.ListTokensResponse{Tokens: []*catalogpb.Token{{TokenId: "1"}}}, nil
+}
+
+func delegationResolver(users *stubDelegationUsers, catalog *stubDelegationCatalog) *graphql_resolver.Resolver {
+	wallet := new(MockWalletServiceClient)
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "viewer-1"}).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: delegateHot}, {Address: "0x00000000000000000000000000000000000000ee", IsWatchOnly: true}},
+	}, nil)
+
+	var uc userpb.UserServiceClient = users
+	var cc catalogpb.CatalogServiceClient = catalog
+	var wc walletpb.WalletServiceClient = wallet
+	return graphql_resolver.NewResolver(nil, &grpcclients.WalletClient{Client: &wc}, nil).
+		WithUserClient(&grpcclients.UserClient{Client: &uc}).
+		WithCatalogClient(&grpcclients.CatalogClient{Client: &cc})
+}
+
+func TestMyNFTs_IncludesDelegatedVaultsWhenHonored(t *testing.T) {
+	catalog := &stubDelegationCatalog{}
+	resolver := delegationResolver(&stubDelegationUsers{honor: true}, catalog).Query()
+
+	tokens, err := resolver.MyNFTs(viewerContext("viewer-1"), "eip155:1", nil, nil, nil)
+
+	require.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, []string{delegateHot, delegateVault}, catalog.listRequest.Owners)
+}
+
+func TestMyNFTs_IgnoresDelegationsByDefault(t *testing.T) {
+	catalog := &stubDelegationCatalog{}
+	resolver := delegationResolver(&stubDelegationUsers{}, catalog).Query()
+
+	_, err := resolver.MyNFTs(viewerContext("viewer-1"), "eip155:1", nil, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{delegateHot}, catalog.listRequest.Owners)
+	assert.Zero(t, catalog.vaultCalls)
+}
+
+func TestMyNFTs_FallsBackWhenRegistryUnavailable(t *testing.T) {
+	catalog := &stubDelegationCatalog{vaultErr: errors.New("unavailable")}
+	resolver := delegationResolver(&stubDelegationUsers{honor: true}, catalog).Query()
+
+	_, err := resolver.MyNFTs(viewerContext("viewer-1"), "eip155:1", nil, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{delegateHot}, catalog.listRequest.Owners)
+}
+
+func TestMyNFTs_RequiresAuth(t *testing.T) {
+	resolver := delegationResolver(&stubDelegationUsers{}, &stubDelegationCatalog{}).Query()
+
+	_, err := resolver.MyNFTs(context.Background(), "eip155:1", nil, nil, nil)
+
+	assert.Error(t, err)
+}
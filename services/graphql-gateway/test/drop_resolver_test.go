@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+)
+
+// stubDropCatalog serves a fixed calendar and records submissions
+type stubDropCatalog struct {
+	catalogpb.CatalogServiceClient
+	drops     []*catalogpb.UpcomingDrop
+	listReq   *catalogpb.ListUpcomingDropsRequest
+	submitReq *catalogpb.SubmitDropRequest
+}
+
+func (s *stubDropCatalog) ListUpcomingDrops(ctx context.Context, req *catalogpb.ListUpcomingDropsRequest, opts ...grpc.CallOption) (*catalogpb.ListUpcomingDropsResponse, error) {
+	s.listReq = req
+	return &catalogpb.ListUpcomingDropsResponse{Drops: s.drops}, nil
+}
+
+func (s *stubDropCatalog) SubmitDrop(ctx context.Context, req *catalogpb.SubmitDropRequest, opts ...grpc.CallOption) (*catalogpb.SubmitDropResponse, error) {
+	s.submitReq = req
+	return &catalogpb.SubmitDropResponse{Submission: &catalogpb.DropSubmission{
+		Id:          "sub-1",
+		ChainId:     req.GetChainId(),
+		Name:        req.GetName(),
+		ExternalUrl: req.GetExternalUrl(),
+		StartsAt:    req.GetStartsAt(),
+		Status:      "pending",
+		CreatedAt:   timestamppb.Now(),
+	}}, nil
+}
+
+func TestUpcomingDrops_CountdownAndStatus(t *testing.T) {
+	now := time.Now()
+	catalog := &stubDropCatalog{drops: []*catalogpb.UpcomingDrop{
+		{Id: "c1", Source: "collection", ChainId: "eip155-1", Name: "Live", StartsAt: timestamppb.New(now.Add(-time.Minute))},
+		{Id: "s1", Source: "submission", ChainId: "eip155-1", Name: "Soon", StartsAt: timestamppb.New(now.Add(time.Hour))},
+	}}
+	var cc catalogpb.CatalogServiceClient = catalog
+	query := graphql_resolver.NewResolver(nil, nil, nil).WithCatalogClient(&grpcclients.CatalogClient{Client: &cc}).Query()
+
+	from := now.UTC().Format(time.RFC3339Nano)
+	drops, err := query.UpcomingDrops(context.Background(), nil, &from, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, drops, 2)
+	assert.Equal(t, schemas.DropStatusLive, drops[0].Status)
+	assert.Zero(t, drops[0].StartsInSeconds)
+	assert.Equal(t, schemas.DropStatusUpcoming, drops[1].Status)
+	assert.InDelta(t, 3600, drops[1].StartsInSeconds, 5)
+	assert.Nil(t, drops[1].Contract)
+	require.NotNil(t, catalog.listReq.GetFrom())
+	assert.Nil(t, catalog.listReq.GetTo())
+
+	bad := "next week"
+	_, err = query.UpcomingDrops(context.Background(), nil, nil, &bad, nil)
+	assert.Error(t, err)
+}
+
+func TestSubmitDrop_RequiresAuthAndStampsSubmitter(t *testing.T) {
+	catalog := &stubDropCatalog{}
+	var cc catalogpb.CatalogServiceClient = catalog
+	mutation := graphql_resolver.NewResolver(nil, nil, nil).WithCatalogClient(&grpcclients.CatalogClient{Client: &cc}).Mutation()
+
+	input := schemas.SubmitDropInput{
+		ChainID:     "eip155-1",
+		Name:        "Off-platform drop",
+		ExternalURL: "https://example.com/drop",
+		StartsAt:    time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339Nano),
+	}
+	_, err := mutation.SubmitDrop(context.Background(), input)
+	assert.Error(t, err)
+	assert.Nil(t, catalog.submitReq)
+
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1"})
+	sub, err := mutation.SubmitDrop(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", catalog.submitReq.GetSubmittedBy())
+	assert.Nil(t, catalog.submitReq.GetEndsAt())
+	assert.Equal(t, schemas.DropSubmissionStatusPending, sub.Status)
+	assert.Equal(t, input.StartsAt[:19], sub.StartsAt[:19])
+}
@@ -0,0 +1,328 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/imageproxy"
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+)
+
+// MockMediaServiceClient is a mock implementation of MediaServiceClient
+type MockMediaServiceClient struct {
+	mock.Mock
+}
+
+func (m *MockMediaServiceClient) UploadSingleFile(ctx context.Context, req *mediapb.SingleUploadRequest, opts ...grpc.CallOption) (*mediapb.UploadAndPinResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*mediapb.UploadAndPinResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) UploadFileStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[mediapb.UploadStreamRequest, mediapb.UploadStreamResponse], error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(grpc.BidiStreamingClient[mediapb.UploadStreamRequest, mediapb.UploadStreamResponse]), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) GetAsset(ctx context.Context, req *mediapb.GetAssetRequest, opts ...grpc.CallOption) (*mediapb.GetAssetResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.GetAssetResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) GetAssetByCid(ctx context.Context, req *mediapb.GetAssetByCidRequest, opts ...grpc.CallOption) (*mediapb.GetAssetResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*mediapb.GetAssetResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) AddRef(ctx context.Context, req *mediapb.AddRefRequest, opts ...grpc.CallOption) (*mediapb.AddRefResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.AddRefResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) ReleaseRef(ctx context.Context, req *mediapb.ReleaseRefRequest, opts ...grpc.CallOption) (*mediapb.ReleaseRefResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.ReleaseRefResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) ReleaseAsset(ctx context.Context, req *mediapb.ReleaseAssetRequest, opts ...grpc.CallOption) (*mediapb.ReleaseAssetResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.ReleaseAssetResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) GetStorageUsage(ctx context.Context, req *mediapb.GetStorageUsageRequest, opts ...grpc.CallOption) (*mediapb.GetStorageUsageResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.GetStorageUsageResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) StoreArtifact(ctx context.Context, req *mediapb.StoreArtifactRequest, opts ...grpc.CallOption) (*mediapb.StoreArtifactResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.StoreArtifactResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) GetArtifact(ctx context.Context, req *mediapb.GetArtifactRequest, opts ...grpc.CallOption) (*mediapb.GetArtifactResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.GetArtifactResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) DownloadArtifact(ctx context.Context, req *mediapb.DownloadArtifactRequest, opts ...grpc.CallOption) (*mediapb.DownloadArtifactResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.DownloadArtifactResponse), args.Error(1)
+}
+
+func (m *MockMediaServiceClient) GetPinHealth(ctx context.Context, req *mediapb.GetPinHealthRequest, opts ...grpc.CallOption) (*mediapb.GetPinHealthResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*mediapb.GetPinHealthResponse), args.Error(1)
+}
+
+func pngFixture(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// newImageProxy serves original from a fake IPFS gateway and describes it as asset-1
+func newImageProxy(t *testing.T, original []byte, variants ...*mediapb.MediaVariant) (*imageproxy.Handler, *MockMediaServiceClient, *int) {
+	fetches := 0
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		w.Write(original)
+	}))
+	t.Cleanup(origin.Close)
+
+	for _, v := range variants {
+		v.CdnUrl = origin.URL + v.CdnUrl
+	}
+	mediaClient := new(MockMediaServiceClient)
+	mediaClient.On("GetAsset", mock.Anything, &mediapb.GetAssetRequest{Id: "asset-1"}).Return(&mediapb.GetAssetResponse{
+		Asset: &mediapb.Asset{
+			Id:         "asset-1",
+			Mime:       "image/png",
+			Sha256:     "abc123",
+			GatewayUrl: wrapperspb.String(origin.URL + "/ipfs/cid"),
+			Variants:   variants,
+		},
+	}, nil)
+	mediaClient.On("GetAsset", mock.Anything, mock.Anything).Return(nil, status.Error(codes.NotFound, "asset not found"))
+
+	return imageproxy.NewHandler(mediaClient).WithHTTPClient(origin.Client()), mediaClient, &fetches
+}
+
+func TestParseRequest(t *testing.T) {
+	req, err := imageproxy.ParseRequest(httptest.NewRequest(http.MethodGet, "/img/asset-1?w=300&fm=JPEG", nil))
+	require.NoError(t, err)
+	assert.Equal(t, imageproxy.Request{AssetID: "asset-1", Width: 384, Format: imageproxy.FormatJPEG}, req)
+
+	req, err = imageproxy.ParseRequest(httptest.NewRequest(http.MethodGet, "/img/asset-1", nil))
+	require.NoError(t, err)
+	assert.Equal(t, imageproxy.Request{AssetID: "asset-1"}, req)
+
+	for _, target := range []string{"/img/", "/img/a/b", "/img/asset-1?w=0", "/img/asset-1?w=abc", "/img/asset-1?fm=tiff"} {
+		_, err := imageproxy.ParseRequest(httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Error(t, err, target)
+	}
+}
+
+func TestSnapWidth(t *testing.T) {
+	assert.Equal(t, 64, imageproxy.SnapWidth(1))
+	assert.Equal(t, 128, imageproxy.SnapWidth(128))
+	assert.Equal(t, 256, imageproxy.SnapWidth(129))
+	assert.Equal(t, 2048, imageproxy.SnapWidth(10000))
+}
+
+func TestResize_KeepsAspectRatioAndNeverUpscales(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+
+	assert.Equal(t, image.Rect(0, 0, 100, 50), imageproxy.Resize(src, 100).Bounds())
+	assert.Equal(t, src, imageproxy.Resize(src, 800))
+	assert.Equal(t, src, imageproxy.Resize(src, 0))
+}
+
+func TestImageProxy_ResizesAndSetsCacheHeaders(t *testing.T) {
+	handler, _, _ := newImageProxy(t, pngFixture(t, 400, 200))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/img/asset-1?w=100&fm=jpg", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/jpeg", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, imageproxy.ETag("abc123", 128, imageproxy.FormatJPEG), rec.Header().Get("ETag"))
+	assert.Empty(t, rec.Header().Get("Vary"))
+
+	img, err := jpeg.Decode(rec.Body)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 128, 64), img.Bounds())
+}
+
+func TestImageProxy_NotModifiedSkipsFetch(t *testing.T) {
+	handler, _, fetches := newImageProxy(t, pngFixture(t, 400, 200))
+
+	req := httptest.NewRequest(http.MethodGet, "/img/asset-1?w=256&fm=png", nil)
+	req.Header.Set("If-None-Match", imageproxy.ETag("abc123", 256, imageproxy.FormatPNG))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.Bytes())
+	assert.Equal(t, 0, *fetches)
+}
+
+func TestImageProxy_NegotiatesWebPVariant(t *testing.T) {
+	webp := []byte("RIFF....WEBPVP8 ")
+	handler, _, _ := newImageProxy(t, webp,
+		&mediapb.MediaVariant{CdnUrl: "/small.webp", Width: 128, Format: mediapb.VariantFormat_WEBP},
+		&mediapb.MediaVariant{CdnUrl: "/large.webp", Width: 1024, Format: mediapb.VariantFormat_WEBP},
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/img/asset-1?w=100", nil)
+	req.Header.Set("Accept", "image/avif,image/webp,*/*")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/webp", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "Accept", rec.Header().Get("Vary"))
+	assert.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, imageproxy.ETag("abc123", 128, imageproxy.FormatWebP), rec.Header().Get("ETag"))
+	assert.Equal(t, webp, rec.Body.Bytes())
+
+	// the full-size request gets the widest rendition
+	req = httptest.NewRequest(http.MethodGet, "/img/asset-1?fm=webp", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, imageproxy.ETag("abc123", 0, imageproxy.FormatWebP), rec.Header().Get("ETag"))
+}
+
+func TestImageProxy_NegotiatedWebPWithoutVariantFallsBackBriefly(t *testing.T) {
+	handler, _, _ := newImageProxy(t, pngFixture(t, 600, 300),
+		&mediapb.MediaVariant{CdnUrl: "/large.webp", Width: 1024, Format: mediapb.VariantFormat_WEBP},
+	)
+
+	// 1024 is wider than asked for, so it would be cached as the 512 rendition
+	req := httptest.NewRequest(http.MethodGet, "/img/asset-1?w=512", nil)
+	req.Header.Set("Accept", "image/webp,*/*")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "Accept", rec.Header().Get("Vary"))
+	assert.Equal(t, "public, max-age=300", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, imageproxy.ETag("abc123", 512, imageproxy.FormatPNG), rec.Header().Get("ETag"))
+	img, err := png.Decode(rec.Body)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 512, 256), img.Bounds())
+}
+
+func TestImageProxy_ExplicitWebPWithoutVariantIsNotAcceptable(t *testing.T) {
+	handler, _, fetches := newImageProxy(t, pngFixture(t, 64, 64),
+		&mediapb.MediaVariant{CdnUrl: "/large.webp", Width: 1024, Format: mediapb.VariantFormat_WEBP},
+	)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/img/asset-1?w=256&fm=webp", nil))
+
+	assert.Equal(t, http.StatusNotAcceptable, rec.Code)
+	assert.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+	assert.Empty(t, rec.Header().Get("ETag"))
+	assert.Equal(t, 0, *fetches)
+}
+
+func TestImageProxy_NotModifiedByFormat(t *testing.T) {
+	handler, _, fetches := newImageProxy(t, pngFixture(t, 400, 200),
+		&mediapb.MediaVariant{CdnUrl: "/small.webp", Width: 128, Format: mediapb.VariantFormat_WEBP},
+	)
+	conditional := func(target, accept, etag string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.Header.Set("Accept", accept)
+		req.Header.Set("If-None-Match", etag)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := conditional("/img/asset-1?w=128", "image/webp", imageproxy.ETag("abc123", 128, imageproxy.FormatWebP))
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+
+	// a fallback revalidates against its own format and keeps its short lifetime
+	rec = conditional("/img/asset-1?w=256", "image/webp", imageproxy.ETag("abc123", 256, imageproxy.FormatPNG))
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Equal(t, "public, max-age=300", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, 0, *fetches)
+
+	// a WebP tag never validates the stand-in
+	rec = conditional("/img/asset-1?w=256", "image/webp", imageproxy.ETag("abc123", 256, imageproxy.FormatWebP))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+}
+
+func TestImageProxy_Errors(t *testing.T) {
+	handler, _, _ := newImageProxy(t, []byte("not an image"))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/img/missing", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/img/asset-1?fm=jpg", nil))
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	assert.Empty(t, rec.Header().Get("ETag"))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/img/asset-1", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,154 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+func impersonatedUser() *middleware.CurrentUser {
+	return &middleware.CurrentUser{
+		UserID:    "user-1",
+		SessionID: "session-1",
+		Impersonation: &middleware.Impersonation{
+			ImpersonatorID: "admin-1",
+			Reason:         "ticket #42",
+			ExpiresAt:      time.Date(2026, 3, 1, 12, 15, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestAuthMiddleware_ImpersonationClaims(t *testing.T) {
+	jwtSecret := []byte("test-secret")
+	expiresAt := time.Now().Add(15 * time.Minute).Truncate(time.Second)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":                  "user-1",
+		"session_id":           "session-1",
+		"iss":                  "nft-marketplace-auth",
+		"aud":                  "nft-marketplace-api",
+		"exp":                  expiresAt.Unix(),
+		"impersonator":         "admin-1",
+		"impersonation_reason": "ticket #42",
+	})
+	tokenString, err := token.SignedString(jwtSecret)
+	require.NoError(t, err)
+
+	var user *middleware.CurrentUser
+	handler := middleware.AuthMiddleware(testTokenPolicy(jwtSecret))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user = middleware.GetCurrentUser(r.Context())
+	}))
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.NotNil(t, user)
+	require.True(t, user.IsImpersonated())
+	assert.Equal(t, "admin-1", user.Impersonation.ImpersonatorID)
+	assert.Equal(t, "ticket #42", user.Impersonation.Reason)
+	assert.True(t, expiresAt.Equal(user.Impersonation.ExpiresAt))
+}
+
+func rootFieldContext(user *middleware.CurrentUser, object, field string) context.Context {
+	ctx := graphql.WithResponseContext(context.Background(), graphql.DefaultErrorPresenter, graphql.DefaultRecover)
+	if user != nil {
+		ctx = context.WithValue(ctx, middleware.CurrentUserKey, user)
+	}
+	return graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+		Object: object,
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: field, Alias: field}},
+	})
+}
+
+func TestImpersonationGuard(t *testing.T) {
+	cases := []struct {
+		name    string
+		user    *middleware.CurrentUser
+		object  string
+		field   string
+		blocked bool
+	}{
+		{"query while impersonating", impersonatedUser(), "Query", "me", false},
+		{"destructive mutation while impersonating", impersonatedUser(), "Mutation", "prepareMint", true},
+		{"ending impersonation", impersonatedUser(), "Mutation", "endImpersonation", false},
+		{"subscription ticket while impersonating", impersonatedUser(), "Mutation", "createSubscriptionTicket", false},
+		{"own session", &middleware.CurrentUser{UserID: "user-1"}, "Mutation", "prepareMint", false},
+		{"anonymous", nil, "Mutation", "signInSiwe", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := rootFieldContext(tc.user, tc.object, tc.field)
+			resolved := false
+
+			out := middleware.ImpersonationGuard(ctx, func(ctx context.Context) graphql.Marshaler {
+				resolved = true
+				return graphql.MarshalString("ok")
+			})
+
+			errs := graphql.GetErrors(ctx)
+			if tc.blocked {
+				assert.False(t, resolved)
+				assert.Equal(t, graphql.Null, out)
+				require.Len(t, errs, 1)
+				assert.Equal(t, "IMPERSONATION_FORBIDDEN", errs[0].Extensions["code"])
+			} else {
+				assert.True(t, resolved)
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestMe_ImpersonationBanner(t *testing.T) {
+	resolver := graphql_resolver.NewResolver(nil, nil, nil).Query()
+
+	me, err := resolver.Me(context.WithValue(context.Background(), middleware.CurrentUserKey, impersonatedUser()))
+	require.NoError(t, err)
+	require.NotNil(t, me.Impersonation)
+	assert.Equal(t, "admin-1", me.Impersonation.ImpersonatorID)
+	assert.Equal(t, "ticket #42", me.Impersonation.Reason)
+	assert.Equal(t, "2026-03-01T12:15:00Z", me.Impersonation.ExpiresAt)
+
+	me, err = resolver.Me(context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1"}))
+	require.NoError(t, err)
+	assert.Nil(t, me.Impersonation)
+}
+
+func TestStartImpersonation_RequiresAdmin(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	mockAuthClient := new(MockAuthServiceClient)
+	var ac authpb.AuthServiceClient = mockAuthClient
+	resolver := graphql_resolver.NewResolver(&grpcclients.AuthClient{Client: &ac}, nil, nil).Mutation()
+
+	userCtx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-2"})
+	_, err := resolver.StartImpersonation(userCtx, "user-1", "ticket #42")
+	assert.Error(t, err)
+
+	adminCtx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "admin-1"})
+	_, err = resolver.StartImpersonation(adminCtx, "user-1", " ")
+	assert.Error(t, err)
+
+	mockAuthClient.On("StartImpersonation", mock.Anything, &authpb.StartImpersonationRequest{
+		AdminUserId: "admin-1", TargetUserId: "user-1", Reason: "ticket #42",
+	}).Return(&authpb.StartImpersonationResponse{
+		AccessToken: "token", ExpiresAt: "2026-03-01T12:15:00Z", UserId: "user-1", ImpersonatorId: "admin-1",
+	}, nil)
+	payload, err := resolver.StartImpersonation(adminCtx, "user-1", "ticket #42")
+	require.NoError(t, err)
+	assert.Equal(t, "admin-1", payload.ImpersonatorID)
+	assert.Equal(t, "token", payload.AccessToken)
+	mockAuthClient.AssertExpectations(t)
+}
@@ -324,6 +324,7 @@ func (suite *IntegrationTestSuite) createValidJWT(userID, sessionID string) stri
 		"sub":        userID,
 		"session_id": sessionID,
 		"iss":        "nft-marketplace-auth",
+		"aud":        "nft-marketplace-api",
 		"exp":        time.Now().Add(time.Hour).Unix(),
 		"iat":        time.Now().Unix(),
 	}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+)
+
+// stubLocalizedCatalog answers with a collection carrying localized content
+type stubLocalizedCatalog struct {
+	catalogpb.CatalogServiceClient
+	collection *catalogpb.Collection
+}
+
+func (s *stubLocalizedCatalog) GetCollection(ctx context.Context, req *catalogpb.GetCollectionRequest, opts ...grpc.CallOption) (*catalogpb.GetCollectionResponse, error) {
+	return &catalogpb.GetCollectionResponse{Collection: s.collection}, nil
+}
+
+func acceptLanguageContext(header string) context.Context {
+	req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	req.Header.Set("Accept-Language", header)
+	return context.WithValue(context.Background(), middleware.RequestKey, req)
+}
+
+func TestCollection_NegotiatesLocalizedContent(t *testing.T) {
+	catalog := &stubLocalizedCatalog{collection: &catalogpb.Collection{
+		ChainId:         "eip155-1",
+		ContractAddress: "0xabc",
+		Description:     "On-chain description",
+		Localized: []*catalogpb.LocalizedContent{
+			{Locale: "en", Description: "English description", Tagline: "Hello"},
+			{Locale: "pt-BR", Description: "Descrição", Tagline: "Olá"},
+		},
+	}}
+	var cc catalogpb.CatalogServiceClient = catalog
+	query := graphql_resolver.NewResolver(nil, nil, nil).WithCatalogClient(&grpcclients.CatalogClient{Client: &cc}).Query()
+
+	cases := []struct {
+		acceptLanguage string
+		locale         string
+		description    string
+	}{
+		{"pt-PT,pt;q=0.9", "pt-BR", "Descrição"},
+		{"ja", "en", "English description"},
+		{"", "en", "English description"},
+	}
+	for _, tc := range cases {
+		collection, err := query.Collection(acceptLanguageContext(tc.acceptLanguage), "eip155-1", "0xabc", nil, nil)
+		require.NoError(t, err)
+		require.NotNil(t, collection.ContentLocale, tc.acceptLanguage)
+		assert.Equal(t, tc.locale, *collection.ContentLocale, tc.acceptLanguage)
+		assert.Equal(t, tc.description, *collection.Description, tc.acceptLanguage)
+		assert.Len(t, collection.LocalizedContent, 2)
+	}
+
+	// Without English content, a reader no locale suits sees the on-chain description
+	catalog.collection.Localized = catalog.collection.Localized[1:]
+	collection, err := query.Collection(acceptLanguageContext("ja"), "eip155-1", "0xabc", nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, collection.ContentLocale)
+	assert.Equal(t, "On-chain description", *collection.Description)
+}
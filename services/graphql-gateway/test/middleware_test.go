@@ -2,16 +2,22 @@ package test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
 )
 
 // MiddlewareTestSuite defines the test suite for middleware
@@ -20,6 +26,11 @@ type MiddlewareTestSuite struct {
 	jwtSecret []byte
 }
 
+// testTokenPolicy accepts tokens minted by the auth service with its default identity
+func testTokenPolicy(secret []byte) middleware.TokenPolicy {
+	return middleware.TokenPolicy{Secret: secret, Issuers: []string{"nft-marketplace-auth"}, Audience: "nft-marketplace-api"}
+}
+
 func (suite *MiddlewareTestSuite) SetupTest() {
 	suite.jwtSecret = []byte("test-jwt-secret-for-testing")
 }
@@ -30,6 +41,7 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_ValidToken() {
 		"sub":        "user-123",
 		"session_id": "session-456",
 		"iss":        "nft-marketplace-auth",
+		"aud":        "nft-marketplace-api",
 		"exp":        time.Now().Add(time.Hour).Unix(),
 		"iat":        time.Now().Unix(),
 	})
@@ -47,7 +59,7 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_ValidToken() {
 	})
 
 	// Create middleware
-	authMiddleware := middleware.AuthMiddleware(suite.jwtSecret)
+	authMiddleware := middleware.AuthMiddleware(testTokenPolicy(suite.jwtSecret))
 	handler := authMiddleware(testHandler)
 
 	// Create test request
@@ -72,7 +84,7 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_InvalidToken() {
 	})
 
 	// Create middleware
-	authMiddleware := middleware.AuthMiddleware(suite.jwtSecret)
+	authMiddleware := middleware.AuthMiddleware(testTokenPolicy(suite.jwtSecret))
 	handler := authMiddleware(testHandler)
 
 	// Create test request
@@ -95,7 +107,7 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_NoToken() {
 	})
 
 	// Create middleware
-	authMiddleware := middleware.AuthMiddleware(suite.jwtSecret)
+	authMiddleware := middleware.AuthMiddleware(testTokenPolicy(suite.jwtSecret))
 	handler := authMiddleware(testHandler)
 
 	// Create test request without Authorization header
@@ -114,6 +126,7 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_ExpiredToken() {
 		"sub":        "user-123",
 		"session_id": "session-456",
 		"iss":        "nft-marketplace-auth",
+		"aud":        "nft-marketplace-api",
 		"exp":        time.Now().Add(-time.Hour).Unix(), // Expired 1 hour ago
 		"iat":        time.Now().Add(-2 * time.Hour).Unix(),
 	})
@@ -129,7 +142,7 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_ExpiredToken() {
 	})
 
 	// Create middleware
-	authMiddleware := middleware.AuthMiddleware(suite.jwtSecret)
+	authMiddleware := middleware.AuthMiddleware(testTokenPolicy(suite.jwtSecret))
 	handler := authMiddleware(testHandler)
 
 	// Create test request
@@ -149,6 +162,7 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_InvalidIssuer() {
 		"sub":        "user-123",
 		"session_id": "session-456",
 		"iss":        "invalid-issuer",
+		"aud":        "nft-marketplace-api",
 		"exp":        time.Now().Add(time.Hour).Unix(),
 		"iat":        time.Now().Unix(),
 	})
@@ -164,7 +178,7 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_InvalidIssuer() {
 	})
 
 	// Create middleware
-	authMiddleware := middleware.AuthMiddleware(suite.jwtSecret)
+	authMiddleware := middleware.AuthMiddleware(testTokenPolicy(suite.jwtSecret))
 	handler := authMiddleware(testHandler)
 
 	// Create test request
@@ -178,6 +192,54 @@ func (suite *MiddlewareTestSuite) TestAuthMiddleware_InvalidIssuer() {
 	suite.Equal(http.StatusOK, w.Code)
 }
 
+func (suite *MiddlewareTestSuite) TestAuthMiddleware_TokenPolicy() {
+	policy := middleware.TokenPolicy{
+		Secret:   suite.jwtSecret,
+		Issuers:  []string{"marketplace-prod", "nft-marketplace-auth"},
+		Audience: "marketplace-prod-api",
+	}
+
+	cases := []struct {
+		name     string
+		iss      string
+		aud      interface{}
+		accepted bool
+	}{
+		{"current issuer", "marketplace-prod", "marketplace-prod-api", true},
+		{"issuer being migrated away from", "nft-marketplace-auth", "marketplace-prod-api", true},
+		{"audience list", "marketplace-prod", []string{"marketplace-staging-api", "marketplace-prod-api"}, true},
+		{"other environment audience", "marketplace-prod", "marketplace-staging-api", false},
+		{"missing audience", "marketplace-prod", nil, false},
+		{"other environment issuer", "marketplace-staging", "marketplace-prod-api", false},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			claims := jwt.MapClaims{
+				"sub":        "user-123",
+				"session_id": "session-456",
+				"iss":        tc.iss,
+				"exp":        time.Now().Add(time.Hour).Unix(),
+			}
+			if tc.aud != nil {
+				claims["aud"] = tc.aud
+			}
+			tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(suite.jwtSecret)
+			suite.Require().NoError(err)
+
+			var user *middleware.CurrentUser
+			handler := middleware.AuthMiddleware(policy)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				user = middleware.GetCurrentUser(r.Context())
+			}))
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Authorization", "Bearer "+tokenString)
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			suite.Equal(tc.accepted, user != nil)
+		})
+	}
+}
+
 func (suite *MiddlewareTestSuite) TestCookieMiddleware() {
 	// Create test handler that checks for request and response writer in context
 	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -390,7 +452,128 @@ func TestProtectedOperations(t *testing.T) {
 	})
 }
 
+func TestRequireAdmin(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1, admin-2")
+
+	t.Run("Allowlisted", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "admin-2"})
+
+		user, err := middleware.RequireAdmin(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "admin-2", user.UserID)
+	})
+
+	t.Run("NotAllowlisted", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-123"})
+
+		_, err := middleware.RequireAdmin(ctx)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "admin privileges required")
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		_, err := middleware.RequireAdmin(context.Background())
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "authentication required")
+	})
+}
+
+func TestRequireOrgRole(t *testing.T) {
+	user := &middleware.CurrentUser{UserID: "user-1", Orgs: map[string]string{"org-1": "admin", "org-2": "member"}}
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, user)
+
+	t.Run("AllowedRole", func(t *testing.T) {
+		got, err := middleware.RequireOrgRole(ctx, "org-1", "owner", "admin")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user-1", got.UserID)
+	})
+
+	t.Run("RoleNotAllowed", func(t *testing.T) {
+		_, err := middleware.RequireOrgRole(ctx, "org-2", "owner", "admin")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "member is not allowed")
+	})
+
+	t.Run("NotMember", func(t *testing.T) {
+		_, err := middleware.RequireOrgRole(ctx, "org-3", "owner")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "organization membership required")
+	})
+}
+
+func TestAuthMiddleware_OrgClaims(t *testing.T) {
+	jwtSecret := []byte("test-secret")
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":        "user-1",
+		"session_id": "session-1",
+		"iss":        "nft-marketplace-auth",
+		"aud":        "nft-marketplace-api",
+		"exp":        time.Now().Add(time.Hour).Unix(),
+		"orgs":       map[string]string{"org-1": "owner"},
+	})
+	tokenString, err := token.SignedString(jwtSecret)
+	assert.NoError(t, err)
+
+	var user *middleware.CurrentUser
+	handler := middleware.AuthMiddleware(testTokenPolicy(jwtSecret))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user = middleware.GetCurrentUser(r.Context())
+	}))
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if assert.NotNil(t, user) {
+		assert.Equal(t, "owner", user.OrgRole("org-1"))
+		assert.Empty(t, user.OrgRole("org-2"))
+	}
+}
+
 // Test JWT token validation
+func TestWebsocketInitFunc(t *testing.T) {
+	jwtSecret := []byte("test-secret")
+	initFunc := middleware.WebsocketInitFunc(testTokenPolicy(jwtSecret))
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":        "user-123",
+		"session_id": "session-456",
+		"iss":        "nft-marketplace-auth",
+		"aud":        "nft-marketplace-api",
+		"exp":        time.Now().Add(time.Hour).Unix(),
+	})
+	tokenString, err := token.SignedString(jwtSecret)
+	assert.NoError(t, err)
+
+	t.Run("AuthorizationInPayload", func(t *testing.T) {
+		ctx, _, err := initFunc(context.Background(), transport.InitPayload{"Authorization": "Bearer " + tokenString})
+		assert.NoError(t, err)
+		user := middleware.GetCurrentUser(ctx)
+		if assert.NotNil(t, user) {
+			assert.Equal(t, "user-123", user.UserID)
+			assert.Equal(t, "session-456", middleware.GetSessionID(ctx))
+		}
+	})
+
+	t.Run("InvalidTokenStaysAnonymous", func(t *testing.T) {
+		ctx, _, err := initFunc(context.Background(), transport.InitPayload{"Authorization": "Bearer not-a-token"})
+		assert.NoError(t, err)
+		assert.Nil(t, middleware.GetCurrentUser(ctx))
+	})
+
+	t.Run("KeepsUpgradeRequestUser", func(t *testing.T) {
+		existing := &middleware.CurrentUser{UserID: "header-user", SessionID: "header-session"}
+		ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, existing)
+		ctx, _, err := initFunc(ctx, transport.InitPayload{"Authorization": "Bearer " + tokenString})
+		assert.NoError(t, err)
+		assert.Equal(t, "header-user", middleware.GetCurrentUser(ctx).UserID)
+	})
+}
+
 func TestValidateJWTToken(t *testing.T) {
 	jwtSecret := []byte("test-secret")
 
@@ -399,6 +582,7 @@ func TestValidateJWTToken(t *testing.T) {
 			"sub":        "user-123",
 			"session_id": "session-456",
 			"iss":        "nft-marketplace-auth",
+			"aud":        "nft-marketplace-api",
 			"exp":        time.Now().Add(time.Hour).Unix(),
 			"iat":        time.Now().Unix(),
 		})
@@ -415,6 +599,7 @@ func TestValidateJWTToken(t *testing.T) {
 			"sub":        "user-123",
 			"session_id": "session-456",
 			"iss":        "nft-marketplace-auth",
+			"aud":        "nft-marketplace-api",
 			"exp":        time.Now().Add(-time.Hour).Unix(), // Expired
 			"iat":        time.Now().Add(-2 * time.Hour).Unix(),
 		})
@@ -431,6 +616,7 @@ func TestValidateJWTToken(t *testing.T) {
 			"sub":        "user-123",
 			"session_id": "session-456",
 			"iss":        "nft-marketplace-auth",
+			"aud":        "nft-marketplace-api",
 			"exp":        time.Now().Add(time.Hour).Unix(),
 			"iat":        time.Now().Unix(),
 		})
@@ -489,6 +675,71 @@ func TestCookieOperations(t *testing.T) {
 	})
 }
 
+func TestCookiePolicy(t *testing.T) {
+	t.Run("HostPrefixProduction", func(t *testing.T) {
+		t.Setenv("REFRESH_COOKIE_SECURE", "true")
+		t.Setenv("REFRESH_COOKIE_SAMESITE", "lax")
+		t.Setenv("REFRESH_COOKIE_MAX_AGE", "3600")
+		t.Setenv("REFRESH_COOKIE_HOST_PREFIX", "true")
+
+		policy, err := middleware.LoadCookiePolicy()
+		assert.NoError(t, err)
+		middleware.UseCookiePolicy(policy)
+		defer middleware.UseCookiePolicy(middleware.DefaultCookiePolicy())
+
+		w := httptest.NewRecorder()
+		middleware.SetRefreshTokenCookie(w, "token-1")
+		cookie := w.Result().Cookies()[0]
+		assert.Equal(t, "__Host-refresh_token", cookie.Name)
+		assert.True(t, cookie.Secure)
+		assert.Empty(t, cookie.Domain)
+		assert.Equal(t, http.SameSiteLaxMode, cookie.SameSite)
+		assert.Equal(t, 3600, cookie.MaxAge)
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "unprefixed"})
+		assert.Empty(t, middleware.GetRefreshTokenFromCookie(req))
+		req.AddCookie(cookie)
+		assert.Equal(t, "token-1", middleware.GetRefreshTokenFromCookie(req))
+	})
+
+	t.Run("SharedDomain", func(t *testing.T) {
+		policy := middleware.DefaultCookiePolicy()
+		policy.Name = "zuno_rt"
+		policy.Domain = "zuno.example"
+		policy.Secure = true
+		assert.NoError(t, policy.Validate())
+		middleware.UseCookiePolicy(policy)
+		defer middleware.UseCookiePolicy(middleware.DefaultCookiePolicy())
+
+		w := httptest.NewRecorder()
+		middleware.ClearRefreshTokenCookie(w)
+		cookie := w.Result().Cookies()[0]
+		assert.Equal(t, "zuno_rt", cookie.Name)
+		assert.Equal(t, "zuno.example", cookie.Domain)
+		assert.Equal(t, -1, cookie.MaxAge)
+	})
+
+	t.Run("RejectsInvalidCombinations", func(t *testing.T) {
+		insecureHost := middleware.DefaultCookiePolicy()
+		insecureHost.HostPrefix = true
+		assert.Error(t, insecureHost.Validate())
+
+		hostWithDomain := insecureHost
+		hostWithDomain.Secure = true
+		hostWithDomain.Domain = "zuno.example"
+		assert.Error(t, hostWithDomain.Validate())
+
+		insecureNone := middleware.DefaultCookiePolicy()
+		insecureNone.SameSite = http.SameSiteNoneMode
+		assert.Error(t, insecureNone.Validate())
+
+		t.Setenv("REFRESH_COOKIE_SAMESITE", "sometimes")
+		_, err := middleware.LoadCookiePolicy()
+		assert.Error(t, err)
+	})
+}
+
 // Test client info extraction
 func TestGetClientInfo(t *testing.T) {
 	testCases := []struct {
@@ -573,6 +824,7 @@ func TestMiddlewareChain(t *testing.T) {
 		"sub":        "user-123",
 		"session_id": "session-456",
 		"iss":        "nft-marketplace-auth",
+		"aud":        "nft-marketplace-api",
 		"exp":        time.Now().Add(time.Hour).Unix(),
 		"iat":        time.Now().Unix(),
 	})
@@ -597,7 +849,7 @@ func TestMiddlewareChain(t *testing.T) {
 	})
 
 	// Create middleware chain: Auth -> Cookie -> Handler
-	authMiddleware := middleware.AuthMiddleware(jwtSecret)
+	authMiddleware := middleware.AuthMiddleware(testTokenPolicy(jwtSecret))
 	middlewareChain := authMiddleware(middleware.CookieMiddleware(testHandler))
 
 	// Create test request
@@ -619,6 +871,7 @@ func BenchmarkAuthMiddleware(b *testing.B) {
 		"sub":        "user-123",
 		"session_id": "session-456",
 		"iss":        "nft-marketplace-auth",
+		"aud":        "nft-marketplace-api",
 		"exp":        time.Now().Add(time.Hour).Unix(),
 		"iat":        time.Now().Unix(),
 	})
@@ -629,7 +882,7 @@ func BenchmarkAuthMiddleware(b *testing.B) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	authMiddleware := middleware.AuthMiddleware(jwtSecret)
+	authMiddleware := middleware.AuthMiddleware(testTokenPolicy(jwtSecret))
 	handler := authMiddleware(testHandler)
 
 	req := httptest.NewRequest("GET", "/test", nil)
@@ -688,3 +941,47 @@ func TestSecurityFeatures(t *testing.T) {
 		t.Skip("Security validation tests need implementation")
 	})
 }
+
+func TestPresentError(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("gRPC status keeps its message and gains a code", func(t *testing.T) {
+		gqlErr := middleware.PresentError(ctx, status.Error(codes.Unavailable, "catalog is restarting"))
+		assert.Equal(t, "catalog is restarting", gqlErr.Message)
+		assert.Equal(t, "UNAVAILABLE", gqlErr.Extensions["code"])
+		assert.Equal(t, true, gqlErr.Extensions["retryable"])
+	})
+
+	t.Run("reason survives the gRPC hop", func(t *testing.T) {
+		notFound := errs.New(errs.NotFound, "intent_not_found").WithMessage("intent not found")
+		err := errs.FromGRPC(errs.ToGRPC(notFound))
+		assert.ErrorIs(t, err, notFound)
+
+		gqlErr := middleware.PresentError(ctx, err)
+		assert.Equal(t, "intent not found", gqlErr.Message)
+		assert.Equal(t, "NOT_FOUND", gqlErr.Extensions["code"])
+		assert.Equal(t, false, gqlErr.Extensions["retryable"])
+	})
+
+	t.Run("auth helpers are classified", func(t *testing.T) {
+		_, err := middleware.RequireAuth(ctx)
+		gqlErr := middleware.PresentError(ctx, err)
+		assert.Equal(t, "authentication required", gqlErr.Message)
+		assert.Equal(t, "UNAUTHENTICATED", gqlErr.Extensions["code"])
+	})
+
+	t.Run("internal details are hidden", func(t *testing.T) {
+		gqlErr := middleware.PresentError(ctx, status.Error(codes.Internal, "internal error: pq: connection refused"))
+		assert.Equal(t, "internal error", gqlErr.Message)
+		assert.Equal(t, "INTERNAL", gqlErr.Extensions["code"])
+	})
+
+	t.Run("existing codes and plain errors are left alone", func(t *testing.T) {
+		coded := &gqlerror.Error{Message: "not allowed", Extensions: map[string]interface{}{"code": "IMPERSONATION_FORBIDDEN"}}
+		assert.Equal(t, "IMPERSONATION_FORBIDDEN", middleware.PresentError(ctx, coded).Extensions["code"])
+
+		plain := middleware.PresentError(ctx, errors.New("username is required"))
+		assert.Equal(t, "username is required", plain.Message)
+		assert.Nil(t, plain.Extensions)
+	})
+}
@@ -0,0 +1,104 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+)
+
+// stubMintStatsCatalog answers with one bucket and records the requested window
+type stubMintStatsCatalog struct {
+	catalogpb.CatalogServiceClient
+	windows []string
+}
+
+func (s *stubMintStatsCatalog) GetMintStats(ctx context.Context, req *catalogpb.GetMintStatsRequest, opts ...grpc.CallOption) (*catalogpb.GetMintStatsResponse, error) {
+	s.windows = append(s.windows, req.GetWindow())
+	if req.GetWindow() == "7d" {
+		return nil, status.Error(codes.InvalidArgument, "window must be one of 15m, 1h, 24h")
+	}
+	minute := time.Date(2026, 10, 1, 12, 30, 0, 0, time.UTC)
+	window := req.GetWindow()
+	if window == "" {
+		window = "1h"
+	}
+	return &catalogpb.GetMintStatsResponse{
+		ChainId:         "eip155-1",
+		ContractAddress: "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		Window:          window,
+		Since:           timestamppb.New(minute.Add(-time.Hour)),
+		Mints:           "6",
+		UniqueMinters:   2,
+		Revenue:         "600",
+		Buckets: []*catalogpb.MintStatsBucket{
+			{Minute: timestamppb.New(minute), Mints: "6", UniqueMinters: 2, Revenue: "600"},
+		},
+	}, nil
+}
+
+func newMintStatsResolver(catalog *stubMintStatsCatalog) *graphql_resolver.Resolver {
+	var cc catalogpb.CatalogServiceClient = catalog
+	return graphql_resolver.NewResolver(nil, nil, nil).WithCatalogClient(&grpcclients.CatalogClient{Client: &cc})
+}
+
+func TestMintStats_MapsWindowAndBuckets(t *testing.T) {
+	catalog := &stubMintStatsCatalog{}
+	query := newMintStatsResolver(catalog).Query()
+
+	window := schemas.MintStatsWindowLast15m
+	stats, err := query.MintStats(context.Background(), "eip155:1", "0x5FbDB2315678afecb367f032d93F642f64180aa3", &window)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"15m"}, catalog.windows)
+	assert.Equal(t, schemas.MintStatsWindowLast15m, stats.Window)
+	assert.Equal(t, "6", stats.Mints)
+	assert.Equal(t, 2, stats.UniqueMinters)
+	require.Len(t, stats.Buckets, 1)
+	assert.Equal(t, "2026-10-01T12:30:00Z", stats.Buckets[0].Minute)
+	assert.Equal(t, "600", stats.Buckets[0].Revenue)
+
+	stats, err = query.MintStats(context.Background(), "eip155:1", "0x5FbDB2315678afecb367f032d93F642f64180aa3", nil)
+	require.NoError(t, err)
+	assert.Equal(t, schemas.MintStatsWindowLast1h, stats.Window)
+
+	bad := schemas.MintStatsWindow("7d")
+	_, err = query.MintStats(context.Background(), "eip155:1", "0x5FbDB2315678afecb367f032d93F642f64180aa3", &bad)
+	assert.EqualError(t, err, "window must be one of 15m, 1h, 24h")
+}
+
+func TestMintStatsSubscription_PushesInitialWindow(t *testing.T) {
+	catalog := &stubMintStatsCatalog{}
+	subscription := newMintStatsResolver(catalog).Subscription()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	window := schemas.MintStatsWindowLast24h
+	ch, err := subscription.MintStats(ctx, "eip155:1", "0x5FbDB2315678afecb367f032d93F642f64180aa3", &window)
+	require.NoError(t, err)
+
+	select {
+	case stats := <-ch:
+		assert.Equal(t, schemas.MintStatsWindowLast24h, stats.Window)
+		assert.Equal(t, "6", stats.Mints)
+	case <-time.After(time.Second):
+		t.Fatal("no initial mint stats pushed")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok, "unchanged stats were pushed again")
+	case <-time.After(time.Second):
+		t.Fatal("subscription not closed after cancel")
+	}
+}
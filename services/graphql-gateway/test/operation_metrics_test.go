@@ -0,0 +1,104 @@
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/opmetrics"
+)
+
+func recordedServer(recorder *opmetrics.Recorder) http.Handler {
+	es := schemas.NewExecutableSchema(schemas.Config{Resolvers: graphql_resolver.NewResolver(nil, nil, nil)})
+	srv := handler.New(es)
+	srv.AddTransport(transport.POST{})
+	srv.Use(recorder)
+	srv.SetErrorPresenter(middleware.PresentError)
+	return srv
+}
+
+func metricsText(recorder *opmetrics.Recorder) string {
+	var buf bytes.Buffer
+	recorder.WriteMetrics(&buf)
+	return buf.String()
+}
+
+// captureLog collects what the standard logger writes while the test runs
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+	return &buf
+}
+
+func TestOperationMetrics_CountsOperationsErrorsAndResolvers(t *testing.T) {
+	logs := captureLog(t)
+	recorder := opmetrics.NewRecorder(opmetrics.Options{LogOperations: true})
+	srv := recordedServer(recorder)
+
+	postOperation(t, srv, map[string]any{"query": healthQuery})
+	postOperation(t, srv, map[string]any{"query": healthQuery})
+	postOperation(t, srv, map[string]any{"query": "{ health }"})
+	_, codes := postOperation(t, srv, map[string]any{"query": "query Watchlist { myWatchlist { __typename } }"})
+	require.Equal(t, []string{"UNAUTHENTICATED"}, codes)
+
+	metrics := metricsText(recorder)
+	assert.Contains(t, metrics, `gateway_operations_total{operation="Health",type="query",status="ok"} 2`)
+	assert.Contains(t, metrics, `gateway_operations_total{operation="anonymous",type="query",status="ok"} 1`)
+	assert.Contains(t, metrics, `gateway_operations_total{operation="Watchlist",type="query",status="error"} 1`)
+	assert.Contains(t, metrics, `gateway_operation_errors_total{operation="Watchlist",code="UNAUTHENTICATED"} 1`)
+	assert.Contains(t, metrics, `gateway_operation_duration_seconds_count{operation="Health",type="query"} 2`)
+	assert.Contains(t, metrics, `gateway_response_size_bytes_bucket{operation="Health",type="query",le="256"} 2`)
+	assert.Contains(t, metrics, `gateway_resolver_duration_seconds_count{field="Query.health"} 3`)
+	assert.Contains(t, metrics, `gateway_resolver_duration_seconds_count{field="Query.myWatchlist"} 1`)
+	assert.NotContains(t, metrics, "__typename", "fields read off a parent are not resolvers")
+
+	assert.Contains(t, logs.String(), "graphql_operation|operation=Watchlist|type=query|user_id=|")
+	assert.Contains(t, logs.String(), "|errors=UNAUTHENTICATED")
+	assert.NotContains(t, logs.String(), "graphql_slow_query", "the slow query log is off by default")
+}
+
+func TestOperationMetrics_SlowQueryLogIsSampled(t *testing.T) {
+	logs := captureLog(t)
+	draw := 0.9
+	recorder := opmetrics.NewRecorder(opmetrics.Options{SlowThreshold: time.Nanosecond, SlowSampleRate: 0.5}).
+		WithSampler(func() float64 { return draw })
+	srv := recordedServer(recorder)
+
+	postOperation(t, srv, map[string]any{"query": healthQuery})
+	assert.Empty(t, logs.String(), "outside the sample")
+
+	draw = 0.1
+	postOperation(t, srv, map[string]any{"query": healthQuery})
+	assert.Contains(t, logs.String(), "graphql_slow_query|operation=Health|type=query|")
+	assert.Contains(t, logs.String(), "|resolvers=Query.health:")
+	assert.Equal(t, 1, strings.Count(logs.String(), "graphql_slow_query"))
+}
+
+func TestOperationMetrics_BoundsOperationNames(t *testing.T) {
+	recorder := opmetrics.NewRecorder(opmetrics.Options{})
+	srv := recordedServer(recorder)
+
+	for i := 0; i <= 200; i++ {
+		postOperation(t, srv, map[string]any{"query": fmt.Sprintf("query Op%d { health }", i)})
+	}
+
+	metrics := metricsText(recorder)
+	assert.Contains(t, metrics, `gateway_operations_total{operation="Op199",type="query",status="ok"} 1`)
+	assert.NotContains(t, metrics, `operation="Op200"`)
+	assert.Contains(t, metrics, `gateway_operations_total{operation="other",type="query",status="ok"} 1`)
+}
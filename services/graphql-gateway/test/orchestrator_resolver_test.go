@@ -3,9 +3,11 @@ package test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
@@ -14,6 +16,8 @@ import (
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
 	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // MockOrchestratorServiceClient is a mock implementation of OrchestratorServiceClient
@@ -41,6 +45,174 @@ func (m *MockOrchestratorServiceClient) GetIntentStatus(ctx context.Context, req
 	return args.Get(0).(*orchestratorpb.GetIntentStatusResponse), args.Error(1)
 }
 
+func (m *MockOrchestratorServiceClient) ListIntents(ctx context.Context, req *orchestratorpb.ListIntentsRequest, opts ...grpc.CallOption) (*orchestratorpb.ListIntentsResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*orchestratorpb.ListIntentsResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareUpdateRoyalty(ctx context.Context, req *orchestratorpb.PrepareUpdateRoyaltyRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareCollectionAdminResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*orchestratorpb.PrepareCollectionAdminResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareTransferCollectionOwnership(ctx context.Context, req *orchestratorpb.PrepareTransferCollectionOwnershipRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareCollectionAdminResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*orchestratorpb.PrepareCollectionAdminResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareSetBaseURI(ctx context.Context, req *orchestratorpb.PrepareSetBaseURIRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareCollectionAdminResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*orchestratorpb.PrepareCollectionAdminResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareSetPayoutSplits(ctx context.Context, req *orchestratorpb.PrepareSetPayoutSplitsRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareCollectionAdminResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*orchestratorpb.PrepareCollectionAdminResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareCreateAuction(ctx context.Context, req *orchestratorpb.PrepareCreateAuctionRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareAuctionResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*orchestratorpb.PrepareAuctionResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareBid(ctx context.Context, req *orchestratorpb.PrepareBidRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareAuctionResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*orchestratorpb.PrepareAuctionResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareSettleAuction(ctx context.Context, req *orchestratorpb.PrepareSettleAuctionRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareAuctionResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*orchestratorpb.PrepareAuctionResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) GetCollectionDefaults(ctx context.Context, req *orchestratorpb.GetCollectionDefaultsRequest, opts ...grpc.CallOption) (*orchestratorpb.GetCollectionDefaultsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.GetCollectionDefaultsResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareImportCollection(ctx context.Context, req *orchestratorpb.PrepareImportCollectionRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareImportCollectionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.PrepareImportCollectionResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) ImportCollection(ctx context.Context, req *orchestratorpb.ImportCollectionRequest, opts ...grpc.CallOption) (*orchestratorpb.ImportCollectionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.ImportCollectionResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareAirdrop(ctx context.Context, req *orchestratorpb.PrepareAirdropRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareAirdropResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.PrepareAirdropResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) GetAirdropProgress(ctx context.Context, req *orchestratorpb.GetAirdropProgressRequest, opts ...grpc.CallOption) (*orchestratorpb.GetAirdropProgressResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.GetAirdropProgressResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) PrepareBulkTransfer(ctx context.Context, req *orchestratorpb.PrepareBulkTransferRequest, opts ...grpc.CallOption) (*orchestratorpb.PrepareBulkTransferResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.PrepareBulkTransferResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) GetBulkTransferProgress(ctx context.Context, req *orchestratorpb.GetBulkTransferProgressRequest, opts ...grpc.CallOption) (*orchestratorpb.GetBulkTransferProgressResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.GetBulkTransferProgressResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) AllowCallTarget(ctx context.Context, req *orchestratorpb.AllowCallTargetRequest, opts ...grpc.CallOption) (*orchestratorpb.AllowCallTargetResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.AllowCallTargetResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) RevokeCallTarget(ctx context.Context, req *orchestratorpb.RevokeCallTargetRequest, opts ...grpc.CallOption) (*orchestratorpb.RevokeCallTargetResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.RevokeCallTargetResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) ListCallTargetOverrides(ctx context.Context, req *orchestratorpb.ListCallTargetOverridesRequest, opts ...grpc.CallOption) (*orchestratorpb.ListCallTargetOverridesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.ListCallTargetOverridesResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) ReserveSponsorship(ctx context.Context, req *orchestratorpb.ReserveSponsorshipRequest, opts ...grpc.CallOption) (*orchestratorpb.ReserveSponsorshipResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.ReserveSponsorshipResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) SettleSponsorship(ctx context.Context, req *orchestratorpb.SettleSponsorshipRequest, opts ...grpc.CallOption) (*orchestratorpb.SettleSponsorshipResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.SettleSponsorshipResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) ReleaseSponsorship(ctx context.Context, req *orchestratorpb.ReleaseSponsorshipRequest, opts ...grpc.CallOption) (*orchestratorpb.ReleaseSponsorshipResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.ReleaseSponsorshipResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) TopUpSponsorshipBudget(ctx context.Context, req *orchestratorpb.TopUpSponsorshipBudgetRequest, opts ...grpc.CallOption) (*orchestratorpb.TopUpSponsorshipBudgetResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.TopUpSponsorshipBudgetResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) SetSponsorshipBudgetFrozen(ctx context.Context, req *orchestratorpb.SetSponsorshipBudgetFrozenRequest, opts ...grpc.CallOption) (*orchestratorpb.SetSponsorshipBudgetFrozenResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.SetSponsorshipBudgetFrozenResponse), args.Error(1)
+}
+
+func (m *MockOrchestratorServiceClient) ListSponsorshipBudgets(ctx context.Context, req *orchestratorpb.ListSponsorshipBudgetsRequest, opts ...grpc.CallOption) (*orchestratorpb.ListSponsorshipBudgetsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*orchestratorpb.ListSponsorshipBudgetsResponse), args.Error(1)
+}
+
 // OrchestratorResolverTestSuite defines the test suite for orchestrator resolvers
 type OrchestratorResolverTestSuite struct {
 	suite.Suite
@@ -372,7 +544,320 @@ func (suite *OrchestratorResolverTestSuite) TestOrchestratorServiceUnavailable()
 	assert.Contains(suite.T(), err.Error(), "orchestrator service unavailable")
 }
 
+func (suite *OrchestratorResolverTestSuite) TestCollectionDefaults() {
+	ctx := context.Background()
+	suite.mockOrchestratorClient.On("GetCollectionDefaults", ctx, &orchestratorpb.GetCollectionDefaultsRequest{ChainId: "eip155:11155111"}).
+		Return(&orchestratorpb.GetCollectionDefaultsResponse{
+			ChainId:                "eip155:11155111",
+			Constraints:            &orchestratorpb.CollectionConstraints{MaxRoyaltyBps: 1000, MinStageDurationSec: 3600, MaxSupplyCap: 1000000},
+			RoyaltyFee:             500,
+			MaxSupply:              10000,
+			MintLimitPerWallet:     10,
+			AllowlistStageDuration: 86400,
+			SupportedTypes:         []string{"ERC721"},
+		}, nil)
+
+	result, err := suite.resolver.Query().CollectionDefaults(ctx, "eip155:11155111")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "1000", result.Constraints.MaxRoyaltyBps)
+	assert.Equal(suite.T(), "3600", result.Constraints.MinStageDurationSec)
+	assert.Equal(suite.T(), "500", result.RoyaltyFee)
+	assert.Equal(suite.T(), "86400", result.AllowlistStageDuration)
+	assert.Equal(suite.T(), []string{"ERC721"}, result.SupportedTypes)
+	suite.mockOrchestratorClient.AssertExpectations(suite.T())
+}
+
+func (suite *OrchestratorResolverTestSuite) TestImportCollection_Success() {
+	ctx := suite.createAuthenticatedContext()
+	suite.mockOrchestratorClient.On("ImportCollection", ctx, &orchestratorpb.ImportCollectionRequest{
+		ChainId:     "eip155:1",
+		Contract:    "0x00000000000000000000000000000000000000d1",
+		UserId:      "test-user-id",
+		Signature:   "0xsig",
+		ChallengeId: "challenge-1",
+	}).Return(&orchestratorpb.ImportCollectionResponse{
+		ChainId:    "eip155:1",
+		Contract:   "0x00000000000000000000000000000000000000d1",
+		Standard:   "ERC721",
+		Owner:      "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd",
+		Name:       "Legacy",
+		StartBlock: 1234,
+	}, nil)
+
+	challengeID := "challenge-1"
+	result, err := suite.mutationResolver.ImportCollection(ctx, "eip155:1", "0x00000000000000000000000000000000000000d1", nil, "0xsig", &challengeID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "ERC721", result.Standard)
+	assert.Equal(suite.T(), "1234", result.StartBlock)
+	suite.mockOrchestratorClient.AssertExpectations(suite.T())
+}
+
+func (suite *OrchestratorResolverTestSuite) TestImportCollection_NotOwner() {
+	ctx := suite.createAuthenticatedContext()
+	suite.mockOrchestratorClient.On("ImportCollection", ctx, mock.Anything).
+		Return(nil, status.Error(codes.PermissionDenied, "signer is not the contract owner"))
+
+	challengeID := "challenge-1"
+	_, err := suite.mutationResolver.ImportCollection(ctx, "eip155:1", "0x00000000000000000000000000000000000000d1", nil, "0xsig", &challengeID)
+
+	assert.EqualError(suite.T(), err, "signer is not the contract owner")
+}
+
+func (suite *OrchestratorResolverTestSuite) TestImportCollection_WalletChallenge() {
+	ctx := suite.createAuthenticatedContext()
+	suite.mockOrchestratorClient.On("PrepareImportCollection", ctx, mock.Anything).Return(&orchestratorpb.PrepareImportCollectionResponse{
+		Message:     "Import collection to Zuno Marketplace",
+		IssuedAt:    "2026-01-01T00:00:00Z",
+		ExpiresAt:   "2026-01-01T00:10:00Z",
+		ChallengeId: "challenge-1",
+	}, nil)
+	suite.mockOrchestratorClient.On("ImportCollection", ctx, &orchestratorpb.ImportCollectionRequest{
+		ChainId:     "eip155:1",
+		Contract:    "0x00000000000000000000000000000000000000d1",
+		UserId:      "test-user-id",
+		Signature:   "0xsig",
+		ChallengeId: "challenge-1",
+	}).Return(&orchestratorpb.ImportCollectionResponse{ChainId: "eip155:1", Standard: "ERC1155"}, nil)
+
+	challenge, err := suite.mutationResolver.PrepareCollectionImport(ctx, "eip155:1", "0x00000000000000000000000000000000000000d1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "challenge-1", challenge.ChallengeID)
+
+	result, err := suite.mutationResolver.ImportCollection(ctx, "eip155:1", "0x00000000000000000000000000000000000000d1", nil, "0xsig", &challenge.ChallengeID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "ERC1155", result.Standard)
+	suite.mockOrchestratorClient.AssertExpectations(suite.T())
+}
+
+func (suite *OrchestratorResolverTestSuite) TestPrepareCollectionImport_RequiresAuth() {
+	_, err := suite.mutationResolver.PrepareCollectionImport(context.Background(), "eip155:1", "0x00000000000000000000000000000000000000d1")
+
+	assert.Error(suite.T(), err)
+	suite.mockOrchestratorClient.AssertNotCalled(suite.T(), "PrepareImportCollection", mock.Anything, mock.Anything)
+}
+
+func (suite *OrchestratorResolverTestSuite) TestPrepareAirdrop_Success() {
+	ctx := suite.createAuthenticatedContext()
+	amount := 3
+	suite.mockOrchestratorClient.On("PrepareAirdrop", ctx, &orchestratorpb.PrepareAirdropRequest{
+		ChainId:  "eip155:1",
+		Contract: "0x00000000000000000000000000000000000000d1",
+		UserId:   "test-user-id",
+		Standard: "ERC721",
+		Recipients: []*orchestratorpb.AirdropRecipient{
+			{Address: "0x00000000000000000000000000000000000000a1", Amount: 3},
+			{Address: "0x00000000000000000000000000000000000000a2", Amount: 1},
+		},
+	}).Return(&orchestratorpb.PrepareAirdropResponse{
+		BundleId:       "bundle-1",
+		ChainId:        "eip155:1",
+		Contract:       "0x00000000000000000000000000000000000000d1",
+		RecipientCount: 2,
+		Batches: []*orchestratorpb.AirdropBatch{{
+			IntentId:   "intent-1",
+			Recipients: []*orchestratorpb.AirdropRecipient{{Address: "0x00000000000000000000000000000000000000a1", Amount: 3}},
+			GasLimit:   230000,
+			Tx:         &orchestratorpb.TxRequest{To: "0x00000000000000000000000000000000000000d1", Data: []byte("0x05"), Value: "0"},
+		}, {
+			IntentId:   "intent-2",
+			Seq:        1,
+			Recipients: []*orchestratorpb.AirdropRecipient{{Address: "0x00000000000000000000000000000000000000a2", Amount: 1}},
+			GasLimit:   120000,
+			Tx:         &orchestratorpb.TxRequest{To: "0x00000000000000000000000000000000000000d1", Data: []byte("0x06"), Value: "0"},
+		}},
+	}, nil)
+
+	result, err := suite.mutationResolver.PrepareAirdrop(ctx, schemas.PrepareAirdropInput{
+		ChainID:  "eip155:1",
+		Contract: "0x00000000000000000000000000000000000000d1",
+		Standard: "ERC721",
+		Recipients: []*schemas.AirdropRecipientInput{
+			{Address: "0x00000000000000000000000000000000000000a1", Amount: &amount},
+			{Address: "0x00000000000000000000000000000000000000a2"},
+		},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "bundle-1", result.BundleID)
+	assert.Len(suite.T(), result.Batches, 2)
+	assert.Equal(suite.T(), 1, result.Batches[1].Seq)
+	assert.Equal(suite.T(), "230000", result.Batches[0].GasLimit)
+	assert.Equal(suite.T(), 3, result.Batches[0].Recipients[0].Amount)
+	assert.Equal(suite.T(), "0x06", result.Batches[1].TxRequest.Data)
+	suite.mockOrchestratorClient.AssertExpectations(suite.T())
+}
+
+func (suite *OrchestratorResolverTestSuite) TestPrepareAirdrop_RejectsNonPositiveAmount() {
+	ctx := suite.createAuthenticatedContext()
+	amount := 0
+
+	_, err := suite.mutationResolver.PrepareAirdrop(ctx, schemas.PrepareAirdropInput{
+		ChainID:    "eip155:1",
+		Contract:   "0x00000000000000000000000000000000000000d1",
+		Standard:   "ERC721",
+		Recipients: []*schemas.AirdropRecipientInput{{Address: "0x00000000000000000000000000000000000000a1", Amount: &amount}},
+	})
+
+	assert.EqualError(suite.T(), err, "recipient amounts must be positive")
+	suite.mockOrchestratorClient.AssertNotCalled(suite.T(), "PrepareAirdrop", mock.Anything, mock.Anything)
+}
+
+func (suite *OrchestratorResolverTestSuite) TestPrepareBulkTransfer_Success() {
+	ctx := suite.createAuthenticatedContext()
+	operator := "0x00000000000000000000000000000000000000e1"
+	amount := 4
+	suite.mockOrchestratorClient.On("PrepareBulkTransfer", ctx, &orchestratorpb.PrepareBulkTransferRequest{
+		ChainId:  "eip155:1",
+		UserId:   "test-user-id",
+		From:     "0x00000000000000000000000000000000000000f1",
+		Operator: operator,
+		Items: []*orchestratorpb.BulkTransferItem{
+			{Contract: "0x00000000000000000000000000000000000000d1", TokenId: "7", To: "0x00000000000000000000000000000000000000a1", Amount: 4},
+			{Contract: "0x00000000000000000000000000000000000000d2", TokenId: "1", To: "0x00000000000000000000000000000000000000a1", Amount: 1},
+		},
+	}).Return(&orchestratorpb.PrepareBulkTransferResponse{
+		BundleId:   "bundle-1",
+		ChainId:    "eip155:1",
+		From:       "0x00000000000000000000000000000000000000f1",
+		Signer:     operator,
+		TokenCount: 2,
+		Approvals: []*orchestratorpb.BulkTransferApproval{{
+			Contract: "0x00000000000000000000000000000000000000d1",
+			Check:    &orchestratorpb.TxRequest{To: "0x00000000000000000000000000000000000000d1", Data: []byte("0x07"), Value: "0"},
+			Tx:       &orchestratorpb.TxRequest{To: "0x00000000000000000000000000000000000000d1", Data: []byte("0x08"), Value: "0"},
+		}},
+		Batches: []*orchestratorpb.BulkTransferBatch{{
+			IntentId: "intent-1",
+			Contract: "0x00000000000000000000000000000000000000d1",
+			Standard: "ERC1155",
+			To:       "0x00000000000000000000000000000000000000a1",
+			Tokens:   []*orchestratorpb.BulkTransferToken{{TokenId: "7", Amount: 4}},
+			Tx:       &orchestratorpb.TxRequest{To: "0x00000000000000000000000000000000000000d1", Data: []byte("0x06"), Value: "0"},
+		}},
+	}, nil)
+
+	result, err := suite.mutationResolver.PrepareBulkTransfer(ctx, schemas.PrepareBulkTransferInput{
+		ChainID:  "eip155:1",
+		From:     "0x00000000000000000000000000000000000000f1",
+		Operator: &operator,
+		Items: []*schemas.BulkTransferItemInput{
+			{Contract: "0x00000000000000000000000000000000000000d1", TokenID: "7", To: "0x00000000000000000000000000000000000000a1", Amount: &amount},
+			{Contract: "0x00000000000000000000000000000000000000d2", TokenID: "1", To: "0x00000000000000000000000000000000000000a1"},
+		},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), operator, result.Signer)
+	assert.Len(suite.T(), result.Approvals, 1)
+	assert.Equal(suite.T(), "0x07", result.Approvals[0].Check.Data)
+	assert.Equal(suite.T(), "0x08", result.Approvals[0].TxRequest.Data)
+	assert.Equal(suite.T(), 4, result.Batches[0].Tokens[0].Amount)
+	suite.mockOrchestratorClient.AssertExpectations(suite.T())
+}
+
+func (suite *OrchestratorResolverTestSuite) TestPrepareBulkTransfer_RejectsNonPositiveAmount() {
+	ctx := suite.createAuthenticatedContext()
+	amount := -1
+
+	_, err := suite.mutationResolver.PrepareBulkTransfer(ctx, schemas.PrepareBulkTransferInput{
+		ChainID: "eip155:1",
+		From:    "0x00000000000000000000000000000000000000f1",
+		Items:   []*schemas.BulkTransferItemInput{{Contract: "0x00000000000000000000000000000000000000d1", TokenID: "7", To: "0x00000000000000000000000000000000000000a1", Amount: &amount}},
+	})
+
+	assert.EqualError(suite.T(), err, "item amounts must be positive")
+	suite.mockOrchestratorClient.AssertNotCalled(suite.T(), "PrepareBulkTransfer", mock.Anything, mock.Anything)
+}
+
+func (suite *OrchestratorResolverTestSuite) TestBulkTransferProgress_NotFoundReadsAsNull() {
+	ctx := suite.createAuthenticatedContext()
+	suite.mockOrchestratorClient.On("GetBulkTransferProgress", ctx, &orchestratorpb.GetBulkTransferProgressRequest{BundleId: "bundle-1", UserId: "test-user-id"}).
+		Return(nil, status.Error(codes.NotFound, "not found"))
+
+	result, err := suite.resolver.Query().BulkTransferProgress(ctx, "bundle-1")
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *OrchestratorResolverTestSuite) TestAirdropProgress_NotFoundReadsAsNull() {
+	ctx := suite.createAuthenticatedContext()
+	suite.mockOrchestratorClient.On("GetAirdropProgress", ctx, &orchestratorpb.GetAirdropProgressRequest{BundleId: "bundle-1", UserId: "test-user-id"}).
+		Return(nil, status.Error(codes.NotFound, "not found"))
+
+	result, err := suite.resolver.Query().AirdropProgress(ctx, "bundle-1")
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *OrchestratorResolverTestSuite) TestOnAirdropProgress_PollsUntilSettled() {
+	ctx, cancel := context.WithTimeout(suite.createAuthenticatedContext(), 10*time.Second)
+	defer cancel()
+	batch := func(intentID, status string) *orchestratorpb.GetIntentStatusResponse {
+		return &orchestratorpb.GetIntentStatusResponse{IntentId: intentID, Kind: "airdrop", Status: status}
+	}
+	req := &orchestratorpb.GetAirdropProgressRequest{BundleId: "bundle-1", UserId: "test-user-id"}
+	suite.mockOrchestratorClient.On("GetAirdropProgress", mock.Anything, req).Return(&orchestratorpb.GetAirdropProgressResponse{
+		BundleId: "bundle-1", Status: "pending", BatchCount: 2, Confirmed: 1,
+		Batches: []*orchestratorpb.GetIntentStatusResponse{batch("intent-1", "ready"), batch("intent-2", "pending")},
+	}, nil).Once()
+	suite.mockOrchestratorClient.On("GetAirdropProgress", mock.Anything, req).Return(&orchestratorpb.GetAirdropProgressResponse{
+		BundleId: "bundle-1", Status: "ready", BatchCount: 2, Confirmed: 2,
+		Batches: []*orchestratorpb.GetIntentStatusResponse{batch("intent-1", "ready"), batch("intent-2", "ready")},
+	}, nil)
+
+	updates, err := suite.subscriptionResolver.OnAirdropProgress(ctx, "bundle-1")
+	assert.NoError(suite.T(), err)
+
+	var statuses []schemas.IntentStatus
+	for progress := range updates {
+		statuses = append(statuses, progress.Status)
+	}
+	assert.Equal(suite.T(), []schemas.IntentStatus{schemas.IntentStatusPending, schemas.IntentStatusReady}, statuses)
+}
+
 // Run the test suite
+func (suite *OrchestratorResolverTestSuite) TestPrepareSetPayoutSplits_Success() {
+	ctx := suite.createAuthenticatedContext()
+	suite.mockOrchestratorClient.On("PrepareSetPayoutSplits", ctx, mock.MatchedBy(func(req *orchestratorpb.PrepareSetPayoutSplitsRequest) bool {
+		return req.UserId == "test-user-id" && len(req.Splits) == 2 && req.Splits[1].Bps == 3000
+	})).Return(&orchestratorpb.PrepareCollectionAdminResponse{
+		IntentId: "splits-intent",
+		Tx:       &orchestratorpb.TxRequest{To: "0x1234567890123456789012345678901234567890", Data: []byte("0xabcdef"), Value: "0"},
+	}, nil)
+
+	result, err := suite.mutationResolver.PrepareSetPayoutSplits(ctx, "eip155-1", "0x1234567890123456789012345678901234567890", []*schemas.PayoutSplitInput{
+		{Recipient: "0x2222222222222222222222222222222222222222", Bps: 7000},
+		{Recipient: "0x3333333333333333333333333333333333333333", Bps: 3000},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "splits-intent", result.IntentID)
+	assert.Equal(suite.T(), "0xabcdef", result.TxRequest.Data)
+	suite.mockOrchestratorClient.AssertExpectations(suite.T())
+}
+
+func (suite *OrchestratorResolverTestSuite) TestPrepareSetPayoutSplits_SurfacesRejection() {
+	ctx := suite.createAuthenticatedContext()
+	suite.mockOrchestratorClient.On("PrepareSetPayoutSplits", ctx, mock.Anything).
+		Return((*orchestratorpb.PrepareCollectionAdminResponse)(nil), status.Error(codes.InvalidArgument, "payoutSplits: shares add up to 9000 bps, want 10000"))
+
+	_, err := suite.mutationResolver.PrepareSetPayoutSplits(ctx, "eip155-1", "0x1234567890123456789012345678901234567890", []*schemas.PayoutSplitInput{
+		{Recipient: "0x2222222222222222222222222222222222222222", Bps: 7000},
+		{Recipient: "0x3333333333333333333333333333333333333333", Bps: 2000},
+	})
+	assert.EqualError(suite.T(), err, "payoutSplits: shares add up to 9000 bps, want 10000")
+
+	_, err = suite.mutationResolver.PrepareSetPayoutSplits(ctx, "eip155-1", "0x1234567890123456789012345678901234567890", []*schemas.PayoutSplitInput{
+		{Recipient: "0x2222222222222222222222222222222222222222", Bps: -1},
+	})
+	assert.EqualError(suite.T(), err, "payout split shares must be positive")
+}
+
 func TestOrchestratorResolverTestSuite(t *testing.T) {
 	suite.Run(t, new(OrchestratorResolverTestSuite))
 }
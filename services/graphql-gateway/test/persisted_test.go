@@ -0,0 +1,154 @@
+package test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/persisted"
+)
+
+const healthQuery = "query Health { health }"
+
+func operationHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeManifest(t *testing.T, path string, queries ...string) {
+	t.Helper()
+	manifest := make(map[string]string, len(queries))
+	for _, q := range queries {
+		manifest[operationHash(q)] = q
+	}
+	raw, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, raw, 0o600))
+}
+
+func allowlistServer(t *testing.T, allowlist *persisted.Allowlist) *handler.Server {
+	es := schemas.NewExecutableSchema(schemas.Config{Resolvers: graphql_resolver.NewResolver(nil, nil, nil)})
+	srv := handler.New(es)
+	srv.AddTransport(transport.POST{})
+	srv.Use(allowlist)
+	return srv
+}
+
+// postOperation sends body to the server and returns the decoded response
+func postOperation(t *testing.T, srv http.Handler, body map[string]any) (data map[string]any, codes []string) {
+	t.Helper()
+	raw, err := json.Marshal(body)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(raw)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	var resp struct {
+		Data   map[string]any `json:"data"`
+		Errors []struct {
+			Extensions map[string]any `json:"extensions"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	for _, e := range resp.Errors {
+		code, _ := e.Extensions["code"].(string)
+		codes = append(codes, code)
+	}
+	return resp.Data, codes
+}
+
+func persistedQuery(hash string) map[string]any {
+	return map[string]any{"persistedQuery": map[string]any{"version": 1, "sha256Hash": hash}}
+}
+
+func TestAllowlist_RunsOnlyManifestOperations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	writeManifest(t, path, healthQuery)
+	allowlist, err := persisted.NewAllowlist(path)
+	require.NoError(t, err)
+	srv := allowlistServer(t, allowlist)
+
+	data, codes := postOperation(t, srv, map[string]any{"extensions": persistedQuery(operationHash(healthQuery))})
+	assert.Empty(t, codes)
+	assert.Equal(t, "ok", data["health"])
+
+	// The full text of a listed operation is accepted too
+	data, codes = postOperation(t, srv, map[string]any{"query": healthQuery})
+	assert.Empty(t, codes)
+	assert.Equal(t, "ok", data["health"])
+
+	_, codes = postOperation(t, srv, map[string]any{"query": "{ health }"})
+	assert.Equal(t, []string{persisted.ErrCodeNotAllowed}, codes)
+
+	_, codes = postOperation(t, srv, map[string]any{"query": "{ __schema { types { name } } }"})
+	assert.Equal(t, []string{persisted.ErrCodeNotAllowed}, codes)
+
+	_, codes = postOperation(t, srv, map[string]any{"extensions": persistedQuery(operationHash("{ health }"))})
+	assert.Equal(t, []string{persisted.ErrCodeNotFound}, codes)
+
+	// A listed hash can't smuggle in different text
+	_, codes = postOperation(t, srv, map[string]any{"query": "{ health }", "extensions": persistedQuery(operationHash(healthQuery))})
+	assert.Len(t, codes, 1)
+}
+
+func TestAllowlist_RejectsTamperedManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"`+operationHash(healthQuery)+`": "{ health }"}`), 0o600))
+
+	_, err := persisted.NewAllowlist(path)
+
+	assert.Error(t, err)
+}
+
+func TestReloadHandler(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	writeManifest(t, path, healthQuery)
+	allowlist, err := persisted.NewAllowlist(path)
+	require.NoError(t, err)
+	reload := persisted.NewReloadHandler(allowlist)
+	srv := allowlistServer(t, allowlist)
+
+	post := func(userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, persisted.ReloadRoute, nil)
+		if userID != "" {
+			req = req.WithContext(context.WithValue(req.Context(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: userID}))
+		}
+		rec := httptest.NewRecorder()
+		reload.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusForbidden, post("").Code)
+	assert.Equal(t, http.StatusForbidden, post("user-1").Code)
+
+	// A new deploy adds an operation
+	const meQuery = "query Me { me { id } }"
+	writeManifest(t, path, healthQuery, meQuery)
+	rec := post("admin-1")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"operations": 2}`, rec.Body.String())
+
+	// A broken manifest keeps the loaded one
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0o600))
+	assert.Equal(t, http.StatusUnprocessableEntity, post("admin-1").Code)
+	data, codes := postOperation(t, srv, map[string]any{"extensions": persistedQuery(operationHash(healthQuery))})
+	assert.Empty(t, codes)
+	assert.Equal(t, "ok", data["health"])
+}
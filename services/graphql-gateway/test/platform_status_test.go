@@ -0,0 +1,162 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/platformstatus"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	chainregpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+)
+
+var statusNow = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// stubHealth answers health checks with a fixed status or error
+type stubHealth struct {
+	status healthpb.HealthCheckResponse_ServingStatus
+	err    error
+	calls  int
+}
+
+func (s *stubHealth) Check(ctx context.Context, req *healthpb.HealthCheckRequest, opts ...grpc.CallOption) (*healthpb.HealthCheckResponse, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &healthpb.HealthCheckResponse{Status: s.status}, nil
+}
+
+func (s *stubHealth) Watch(ctx context.Context, req *healthpb.HealthCheckRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[healthpb.HealthCheckResponse], error) {
+	return nil, status.Error(codes.Unimplemented, "not used")
+}
+
+func (s *stubHealth) List(ctx context.Context, req *healthpb.HealthListRequest, opts ...grpc.CallOption) (*healthpb.HealthListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not used")
+}
+
+// stubHeads serves chain heads by chain; unknown chains are not reported yet
+type stubHeads struct {
+	chainregpb.ChainRegistryServiceClient
+	heads map[string]*chainregpb.ChainHead
+}
+
+func (s *stubHeads) GetChainHead(ctx context.Context, req *chainregpb.GetChainHeadRequest, opts ...grpc.CallOption) (*chainregpb.GetChainHeadResponse, error) {
+	head, ok := s.heads[req.ChainId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "chain head not reported")
+	}
+	return &chainregpb.GetChainHeadResponse{Head: head}, nil
+}
+
+type stubQueues struct {
+	catalogpb.CatalogServiceClient
+	resp *catalogpb.GetSystemStatusResponse
+}
+
+func (s *stubQueues) GetSystemStatus(ctx context.Context, req *catalogpb.GetSystemStatusRequest, opts ...grpc.CallOption) (*catalogpb.GetSystemStatusResponse, error) {
+	return s.resp, nil
+}
+
+type stubPins struct {
+	mediapb.MediaServiceClient
+	lastPinnedAt *timestamppb.Timestamp
+}
+
+func (s *stubPins) GetPinHealth(ctx context.Context, req *mediapb.GetPinHealthRequest, opts ...grpc.CallOption) (*mediapb.GetPinHealthResponse, error) {
+	return &mediapb.GetPinHealthResponse{LastPinnedAt: s.lastPinnedAt}, nil
+}
+
+func healthySources() (platformstatus.Sources, *stubHealth) {
+	catalogHealth := &stubHealth{status: healthpb.HealthCheckResponse_SERVING}
+	return platformstatus.Sources{
+		Services: map[string]healthpb.HealthClient{
+			"catalog": catalogHealth,
+			"media":   &stubHealth{status: healthpb.HealthCheckResponse_SERVING},
+		},
+		ChainRegistry: &stubHeads{heads: map[string]*chainregpb.ChainHead{
+			"eip155-1": {ChainId: "eip155-1", LatestBlock: 21000000, AdvancedAt: statusNow.Add(-12 * time.Second).Format(time.RFC3339)},
+		}},
+		Catalog: &stubQueues{resp: &catalogpb.GetSystemStatusResponse{
+			Queues: []*catalogpb.QueueStatus{{Name: "catalog-service-queue", Messages: 40, Consumers: 2}},
+		}},
+		Media: &stubPins{lastPinnedAt: timestamppb.New(statusNow.Add(-time.Minute))},
+	}, catalogHealth
+}
+
+func TestPlatformStatus_AllHealthyIsOperational(t *testing.T) {
+	sources, _ := healthySources()
+	aggregator := platformstatus.NewAggregator(sources, platformstatus.Options{Chains: []string{"eip155-1"}}).
+		WithClock(func() time.Time { return statusNow })
+	resolver := graphql_resolver.NewResolver(nil, nil, nil).WithPlatformStatus(aggregator).Query()
+
+	platform, err := resolver.PlatformStatus(context.Background())
+
+	require.NoError(t, err, "public, no session needed")
+	assert.Equal(t, schemas.PlatformHealthOperational, platform.Status)
+	require.Len(t, platform.Services, 2)
+	assert.Equal(t, "catalog", platform.Services[0].Name)
+	require.Len(t, platform.Chains, 1)
+	assert.Equal(t, "21000000", platform.Chains[0].LatestBlock)
+	assert.Equal(t, 12, platform.Chains[0].LagSeconds)
+	require.Len(t, platform.Queues, 1)
+	assert.Equal(t, 40, platform.Queues[0].Messages)
+	require.NotNil(t, platform.Pinning.LastPinnedAt)
+	assert.Equal(t, "2026-10-01T11:59:00Z", *platform.Pinning.LastPinnedAt)
+	assert.Equal(t, "2026-10-01T12:00:00Z", platform.CheckedAt)
+}
+
+func TestPlatformStatus_DegradedAndOutage(t *testing.T) {
+	sources, catalogHealth := healthySources()
+	sources.ChainRegistry.(*stubHeads).heads["eip155-137"] = &chainregpb.ChainHead{
+		ChainId: "eip155-137", LatestBlock: 5, AdvancedAt: statusNow.Add(-10 * time.Minute).Format(time.RFC3339), Stale: true,
+	}
+	sources.Catalog.(*stubQueues).resp.Queues[0].Messages = 5000
+	opts := platformstatus.Options{Chains: []string{"eip155-1", "eip155-137", "eip155-11155111"}}
+
+	snapshot := platformstatus.NewAggregator(sources, opts).WithClock(func() time.Time { return statusNow }).Snapshot()
+
+	assert.Equal(t, platformstatus.Degraded, snapshot.Status, "lag and backlog degrade the platform")
+	require.Len(t, snapshot.Chains, 3)
+	assert.Equal(t, platformstatus.Operational, snapshot.Chains[0].Status)
+	assert.Equal(t, platformstatus.Degraded, snapshot.Chains[1].Status, "stale head")
+	assert.Equal(t, 600, snapshot.Chains[1].LagSeconds)
+	assert.Equal(t, platformstatus.Degraded, snapshot.Chains[2].Status, "not reported yet")
+	assert.Nil(t, snapshot.Chains[2].AdvancedAt)
+	assert.Equal(t, platformstatus.Degraded, snapshot.Queues[0].Status)
+
+	catalogHealth.err = status.Error(codes.Unavailable, "connection refused")
+	snapshot = platformstatus.NewAggregator(sources, opts).WithClock(func() time.Time { return statusNow }).Snapshot()
+	assert.Equal(t, platformstatus.Outage, snapshot.Status, "a service that is down")
+	assert.Equal(t, platformstatus.Outage, snapshot.Services[0].Status)
+}
+
+func TestPlatformStatus_SnapshotIsCached(t *testing.T) {
+	sources, catalogHealth := healthySources()
+	now := statusNow
+	aggregator := platformstatus.NewAggregator(sources, platformstatus.Options{TTL: 30 * time.Second}).
+		WithClock(func() time.Time { return now })
+
+	first := aggregator.Snapshot()
+	now = now.Add(29 * time.Second)
+	catalogHealth.status = healthpb.HealthCheckResponse_NOT_SERVING
+	assert.Same(t, first, aggregator.Snapshot())
+	assert.Equal(t, 1, catalogHealth.calls)
+
+	now = now.Add(time.Second)
+	refreshed := aggregator.Snapshot()
+	assert.Equal(t, 2, catalogHealth.calls)
+	assert.Equal(t, platformstatus.Outage, refreshed.Status)
+	assert.Equal(t, now, refreshed.CheckedAt)
+}
@@ -0,0 +1,218 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const creatorContract = "0x00000000000000000000000000000000000000c0"
+
+// stubPrivacyUsers answers profile access from fixed settings for "owner-1"
+type stubPrivacyUsers struct {
+	userpb.UserServiceClient
+	visibility    string
+	blocked       bool
+	accessCalls   int
+	relationships []*userpb.SetRelationshipRequest
+}
+
+func (s *stubPrivacyUsers) GetProfileAccess(ctx context.Context, req *userpb.GetProfileAccessRequest, opts ...grpc.CallOption) (*userpb.GetProfileAccessResponse, error) {
+	s.accessCalls++
+	if req.OwnerId != "owner-1" {
+		return nil, status.Error(codes.NotFound, "profile_not_found")
+	}
+	return &userpb.GetProfileAccessResponse{Visibility: s.visibility, Blocked: s.blocked}, nil
+}
+
+func (s *stubPrivacyUsers) GetUsersByIDs(ctx context.Context, req *userpb.GetUsersByIDsRequest, opts ...grpc.CallOption) (*userpb.GetUsersByIDsResponse, error) {
+	return &userpb.GetUsersByIDsResponse{Users: []*userpb.UserCard{{
+		Found:   true,
+		User:    &userpb.User{Id: req.UserIds[0]},
+		Profile: &userpb.Profile{Username: "creator", Bio: "gm", Locale: "en-US"},
+	}}}, nil
+}
+
+func (s *stubPrivacyUsers) SetRelationship(ctx context.Context, req *userpb.SetRelationshipRequest, opts ...grpc.CallOption) (*userpb.SetRelationshipResponse, error) {
+	s.relationships = append(s.relationships, req)
+	return &userpb.SetRelationshipResponse{}, nil
+}
+
+// stubCreatorCatalog lists one collection for "owner-1" and the tokens held in it
+type stubCreatorCatalog struct {
+	catalogpb.CatalogServiceClient
+	holders    map[string]bool
+	activities []*catalogpb.WalletActivity
+}
+
+func (s *stubCreatorCatalog) ListCollections(ctx context.Context, req *catalogpb.ListCollectionsRequest, opts ...grpc.CallOption) (*catalogpb.ListCollectionsResponse, error) {
+	if req.CreatedByUserId != "owner-1" {
+		return &catalogpb.ListCollectionsResponse{}, nil
+	}
+	return &catalogpb.ListCollectionsResponse{Collections: []*catalogpb.Collection{{ChainId: "eip155-1", ContractAddress: creatorContract}}}, nil
+}
+
+func (s *stubCreatorCatalog) ListTokens(ctx context.Context, req *catalogpb.ListTokensRequest, opts ...grpc.CallOption) (*catalogpb.ListTokensResponse, error) {
+	if req.ContractAddress == creatorContract && s.holders[req.Owner] {
+		return &catalogpb.ListTokensResponse{Tokens: []*catalogpb.Token{{TokenId: "1"}}}, nil
+	}
+	return &catalogpb.ListTokensResponse{}, nil
+}
+
+func (s *stubCreatorCatalog) ListWalletActivity(ctx context.Context, req *catalogpb.ListWalletActivityRequest, opts ...grpc.CallOption) (*catalogpb.ListWalletActivityResponse, error) {
+	return &catalogpb.ListWalletActivityResponse{Activities: s.activities}, nil
+}
+
+func privacyResolver(users *stubPrivacyUsers, catalog *stubCreatorCatalog, wallet *MockWalletServiceClient) *graphql_resolver.Resolver {
+	var uc userpb.UserServiceClient = users
+	var cc catalogpb.CatalogServiceClient = catalog
+	var wc walletpb.WalletServiceClient = wallet
+	return graphql_resolver.NewResolver(nil, &grpcclients.WalletClient{Client: &wc}, nil).
+		WithUserClient(&grpcclients.UserClient{Client: &uc}).
+		WithCatalogClient(&grpcclients.CatalogClient{Client: &cc})
+}
+
+func viewerContext(userID string) context.Context {
+	return context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: userID})
+}
+
+func TestUserProfile_PublicProfileShowsPublicFields(t *testing.T) {
+	users := &stubPrivacyUsers{visibility: "public"}
+	resolver := privacyResolver(users, &stubCreatorCatalog{}, new(MockWalletServiceClient)).Query()
+
+	profile, err := resolver.UserProfile(context.Background(), "owner-1")
+
+	require.NoError(t, err)
+	require.NotNil(t, profile, "anonymous viewers see public profiles")
+	assert.Equal(t, "creator", *profile.Username)
+	assert.Equal(t, "gm", *profile.Bio)
+	assert.Nil(t, profile.DisplayName)
+}
+
+func TestUserProfile_HiddenByBlockOrPrivacy(t *testing.T) {
+	blocked := &stubPrivacyUsers{visibility: "public", blocked: true}
+	profile, err := privacyResolver(blocked, &stubCreatorCatalog{}, new(MockWalletServiceClient)).Query().
+		UserProfile(viewerContext("viewer-1"), "owner-1")
+	require.NoError(t, err)
+	assert.Nil(t, profile, "a block either way hides the profile")
+
+	private := &stubPrivacyUsers{visibility: "private"}
+	resolver := privacyResolver(private, &stubCreatorCatalog{}, new(MockWalletServiceClient)).Query()
+	profile, err = resolver.UserProfile(viewerContext("viewer-1"), "owner-1")
+	require.NoError(t, err)
+	assert.Nil(t, profile)
+
+	profile, err = resolver.UserProfile(viewerContext("owner-1"), "owner-1")
+	require.NoError(t, err)
+	assert.NotNil(t, profile, "owners see their own private profile")
+	assert.Equal(t, 1, private.accessCalls)
+
+	profile, err = resolver.UserProfile(viewerContext("viewer-1"), "missing")
+	require.NoError(t, err)
+	assert.Nil(t, profile)
+}
+
+func TestUserProfile_HoldersOnlyChecksViewerHoldings(t *testing.T) {
+	users := &stubPrivacyUsers{visibility: "holders"}
+	catalog := &stubCreatorCatalog{holders: map[string]bool{"0x00000000000000000000000000000000000000aa": true}}
+	wallet := new(MockWalletServiceClient)
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "holder-1"}).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: "0x00000000000000000000000000000000000000ff"}, {Address: "0x00000000000000000000000000000000000000aa"}},
+	}, nil)
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "viewer-1"}).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: "0x00000000000000000000000000000000000000bb"}},
+	}, nil)
+	resolver := privacyResolver(users, catalog, wallet).Query()
+
+	profile, err := resolver.UserProfile(viewerContext("holder-1"), "owner-1")
+	require.NoError(t, err)
+	assert.NotNil(t, profile)
+
+	profile, err = resolver.UserProfile(viewerContext("viewer-1"), "owner-1")
+	require.NoError(t, err)
+	assert.Nil(t, profile)
+
+	profile, err = resolver.UserProfile(context.Background(), "owner-1")
+	require.NoError(t, err)
+	assert.Nil(t, profile, "anonymous viewers hold nothing")
+}
+
+func TestUserActivity_FollowsProfileVisibility(t *testing.T) {
+	catalog := &stubCreatorCatalog{activities: []*catalogpb.WalletActivity{
+		{Id: "sale-1", Kind: "sale", ChainId: "eip155-1", Price: "1000", OccurredAt: timestamppb.New(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))},
+	}}
+	wallet := new(MockWalletServiceClient)
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "owner-1"}).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: "0x00000000000000000000000000000000000000aa"}},
+	}, nil)
+
+	public := privacyResolver(&stubPrivacyUsers{visibility: "public"}, catalog, wallet).Query()
+	page, err := public.UserActivity(viewerContext("viewer-1"), "owner-1", "0x00000000000000000000000000000000000000AA", nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, page)
+	require.Len(t, page.Items, 1)
+	assert.Nil(t, page.NextCursor)
+
+	page, err = public.UserActivity(viewerContext("viewer-1"), "owner-1", "0x00000000000000000000000000000000000000bb", nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, page, "wallets the user has not linked are not theirs to show")
+
+	private := privacyResolver(&stubPrivacyUsers{visibility: "private"}, catalog, wallet).Query()
+	page, err = private.UserActivity(viewerContext("viewer-1"), "owner-1", "0x00000000000000000000000000000000000000aa", nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, page)
+}
+
+func TestBlockAndMuteUser(t *testing.T) {
+	users := &stubPrivacyUsers{}
+	resolver := privacyResolver(users, &stubCreatorCatalog{}, new(MockWalletServiceClient)).Mutation()
+
+	_, err := resolver.BlockUser(context.Background(), "user-2")
+	assert.Error(t, err, "blocking requires a signed-in user")
+
+	ok, err := resolver.BlockUser(viewerContext("user-1"), "user-2")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = resolver.UnmuteUser(viewerContext("user-1"), "user-3")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.Len(t, users.relationships, 2)
+	assert.Equal(t, &userpb.SetRelationshipRequest{UserId: "user-1", TargetId: "user-2", Kind: string(schemas.RelationshipKindBlock), Active: true}, users.relationships[0])
+	assert.Equal(t, &userpb.SetRelationshipRequest{UserId: "user-1", TargetId: "user-3", Kind: string(schemas.RelationshipKindMute), Active: false}, users.relationships[1])
+}
+
+func TestFollowAndUnfollowUser(t *testing.T) {
+	users := &stubPrivacyUsers{}
+	resolver := privacyResolver(users, &stubCreatorCatalog{}, new(MockWalletServiceClient)).Mutation()
+
+	_, err := resolver.FollowUser(context.Background(), "user-2")
+	assert.Error(t, err, "following requires a signed-in user")
+
+	ok, err := resolver.FollowUser(viewerContext("user-1"), "user-2")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = resolver.UnfollowUser(viewerContext("user-1"), "user-2")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.Len(t, users.relationships, 2)
+	assert.Equal(t, &userpb.SetRelationshipRequest{UserId: "user-1", TargetId: "user-2", Kind: string(schemas.RelationshipKindFollow), Active: true}, users.relationships[0])
+	assert.Equal(t, &userpb.SetRelationshipRequest{UserId: "user-1", TargetId: "user-2", Kind: string(schemas.RelationshipKindFollow), Active: false}, users.relationships[1])
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const classifiedCreator = "0x00000000000000000000000000000000000000c1"
+
+// stubRelatedCatalog serves related collections for "space-apes" and records
+// classification updates
+type stubRelatedCatalog struct {
+	catalogpb.CatalogServiceClient
+	classified *catalogpb.SetCollectionClassificationRequest
+}
+
+func (s *stubRelatedCatalog) ListRelatedCollections(ctx context.Context, req *catalogpb.ListRelatedCollectionsRequest, opts ...grpc.CallOption) (*catalogpb.ListRelatedCollectionsResponse, error) {
+	if req.Slug != "space-apes" {
+		return nil, status.Error(codes.NotFound, "collection not found")
+	}
+	return &catalogpb.ListRelatedCollectionsResponse{Collections: []*catalogpb.Collection{
+		{Slug: "moon-cats", Category: "pfp", Tags: []string{"pixel-art"}},
+		{Slug: "untagged"},
+	}}, nil
+}
+
+func (s *stubRelatedCatalog) GetCollection(ctx context.Context, req *catalogpb.GetCollectionRequest, opts ...grpc.CallOption) (*catalogpb.GetCollectionResponse, error) {
+	return &catalogpb.GetCollectionResponse{Collection: &catalogpb.Collection{
+		ChainId: "eip155-1", ContractAddress: req.ContractAddress, Creator: classifiedCreator,
+	}}, nil
+}
+
+func (s *stubRelatedCatalog) SetCollectionClassification(ctx context.Context, req *catalogpb.SetCollectionClassificationRequest, opts ...grpc.CallOption) (*catalogpb.SetCollectionClassificationResponse, error) {
+	s.classified = req
+	return &catalogpb.SetCollectionClassificationResponse{Collection: &catalogpb.Collection{
+		ChainId: "eip155-1", ContractAddress: req.ContractAddress, Category: req.Category, Tags: req.Tags,
+	}}, nil
+}
+
+func relatedResolver(catalog *stubRelatedCatalog, wallet *MockWalletServiceClient) *graphql_resolver.Resolver {
+	var cc catalogpb.CatalogServiceClient = catalog
+	var wc walletpb.WalletServiceClient = wallet
+	return graphql_resolver.NewResolver(nil, &grpcclients.WalletClient{Client: &wc}, nil).
+		WithCatalogClient(&grpcclients.CatalogClient{Client: &cc})
+}
+
+func TestRelatedCollections_MapsClassification(t *testing.T) {
+	query := relatedResolver(&stubRelatedCatalog{}, new(MockWalletServiceClient)).Query()
+
+	related, err := query.RelatedCollections(context.Background(), "space-apes", nil)
+
+	require.NoError(t, err)
+	require.Len(t, related, 2)
+	require.NotNil(t, related[0].Category)
+	assert.Equal(t, schemas.CollectionCategoryPfp, *related[0].Category)
+	assert.Equal(t, []string{"pixel-art"}, related[0].Tags)
+	assert.Nil(t, related[1].Category)
+	assert.Empty(t, related[1].Tags)
+
+	related, err = query.RelatedCollections(context.Background(), "unknown", nil)
+	require.NoError(t, err)
+	assert.Empty(t, related)
+}
+
+func TestSetCollectionClassification_RequiresCreator(t *testing.T) {
+	catalog := &stubRelatedCatalog{}
+	wallet := new(MockWalletServiceClient)
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "creator-1"}).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{{Address: classifiedCreator}},
+	}, nil)
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "viewer-1"}).Return(&walletpb.ListLinksResponse{}, nil)
+	mutation := relatedResolver(catalog, wallet).Mutation()
+	category := schemas.CollectionCategoryArt
+
+	_, err := mutation.SetCollectionClassification(viewerContext("viewer-1"), "eip155-1", "0xabc", &category, []string{"generative"})
+	assert.Error(t, err)
+	assert.Nil(t, catalog.classified)
+
+	collection, err := mutation.SetCollectionClassification(viewerContext("creator-1"), "eip155-1", "0xabc", &category, []string{"generative"})
+	require.NoError(t, err)
+	assert.Equal(t, "art", catalog.classified.Category)
+	assert.Equal(t, schemas.CollectionCategoryArt, *collection.Category)
+}
@@ -49,6 +49,83 @@ func (m *MockAuthServiceClient) RevokeSessionByRefreshToken(ctx context.Context,
 	return args.Get(0).(*authpb.RevokeSessionByRefreshTokenResponse), args.Error(1)
 }
 
+func (m *MockAuthServiceClient) StartImpersonation(ctx context.Context, req *authpb.StartImpersonationRequest, opts ...grpc.CallOption) (*authpb.StartImpersonationResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.StartImpersonationResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) EndImpersonation(ctx context.Context, req *authpb.EndImpersonationRequest, opts ...grpc.CallOption) (*authpb.EndImpersonationResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*authpb.EndImpersonationResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) IssueSubscriptionTicket(ctx context.Context, req *authpb.IssueSubscriptionTicketRequest, opts ...grpc.CallOption) (*authpb.IssueSubscriptionTicketResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.IssueSubscriptionTicketResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) ValidateSession(ctx context.Context, req *authpb.ValidateSessionRequest, opts ...grpc.CallOption) (*authpb.ValidateSessionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.ValidateSessionResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) CreateApiKey(ctx context.Context, req *authpb.CreateApiKeyRequest, opts ...grpc.CallOption) (*authpb.CreateApiKeyResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.CreateApiKeyResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) ListApiKeys(ctx context.Context, req *authpb.ListApiKeysRequest, opts ...grpc.CallOption) (*authpb.ListApiKeysResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.ListApiKeysResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) RevokeApiKey(ctx context.Context, req *authpb.RevokeApiKeyRequest, opts ...grpc.CallOption) (*authpb.RevokeApiKeyResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.RevokeApiKeyResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) SetApiKeyPlan(ctx context.Context, req *authpb.SetApiKeyPlanRequest, opts ...grpc.CallOption) (*authpb.SetApiKeyPlanResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.SetApiKeyPlanResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) AuthorizeApiKey(ctx context.Context, req *authpb.AuthorizeApiKeyRequest, opts ...grpc.CallOption) (*authpb.AuthorizeApiKeyResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.AuthorizeApiKeyResponse), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) GetApiUsage(ctx context.Context, req *authpb.GetApiUsageRequest, opts ...grpc.CallOption) (*authpb.GetApiUsageResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authpb.GetApiUsageResponse), args.Error(1)
+}
+
 // MockWalletServiceClient is a mock implementation of WalletServiceClient
 type MockWalletServiceClient struct {
 	mock.Mock
@@ -59,6 +136,61 @@ func (m *MockWalletServiceClient) UpsertLink(ctx context.Context, req *walletpb.
 	return args.Get(0).(*walletpb.UpsertLinkResponse), args.Error(1)
 }
 
+func (m *MockWalletServiceClient) ListLinks(ctx context.Context, req *walletpb.ListLinksRequest, opts ...grpc.CallOption) (*walletpb.ListLinksResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*walletpb.ListLinksResponse), args.Error(1)
+}
+
+func (m *MockWalletServiceClient) RemoveWallet(ctx context.Context, req *walletpb.RemoveWalletRequest, opts ...grpc.CallOption) (*walletpb.RemoveWalletResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*walletpb.RemoveWalletResponse), args.Error(1)
+}
+
+func (m *MockWalletServiceClient) SetPrimaryWallet(ctx context.Context, req *walletpb.SetPrimaryWalletRequest, opts ...grpc.CallOption) (*walletpb.SetPrimaryWalletResponse, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*walletpb.SetPrimaryWalletResponse), args.Error(1)
+}
+
+func (m *MockWalletServiceClient) AddWatchOnlyWallet(ctx context.Context, req *walletpb.AddWatchOnlyWalletRequest, opts ...grpc.CallOption) (*walletpb.AddWatchOnlyWalletResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletpb.AddWatchOnlyWalletResponse), args.Error(1)
+}
+
+func (m *MockWalletServiceClient) UpdateWalletDetails(ctx context.Context, req *walletpb.UpdateWalletDetailsRequest, opts ...grpc.CallOption) (*walletpb.UpdateWalletDetailsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletpb.UpdateWalletDetailsResponse), args.Error(1)
+}
+
+func (m *MockWalletServiceClient) TouchWallets(ctx context.Context, req *walletpb.TouchWalletsRequest, opts ...grpc.CallOption) (*walletpb.TouchWalletsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletpb.TouchWalletsResponse), args.Error(1)
+}
+
+func (m *MockWalletServiceClient) CreateSignatureChallenge(ctx context.Context, req *walletpb.CreateSignatureChallengeRequest, opts ...grpc.CallOption) (*walletpb.CreateSignatureChallengeResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletpb.CreateSignatureChallengeResponse), args.Error(1)
+}
+
+func (m *MockWalletServiceClient) VerifySignatureChallenge(ctx context.Context, req *walletpb.VerifySignatureChallengeRequest, opts ...grpc.CallOption) (*walletpb.VerifySignatureChallengeResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*walletpb.VerifySignatureChallengeResponse), args.Error(1)
+}
+
 // MockCollectionServiceClient is a mock implementation of CollectionServiceClient
 
 // ResolverTestSuite defines the test suite for GraphQL resolvers
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+)
+
+// stubResyncCatalog records resync requests and answers with one owner drift
+type stubResyncCatalog struct {
+	catalogpb.CatalogServiceClient
+	requests []*catalogpb.ResyncCollectionRequest
+}
+
+func (s *stubResyncCatalog) ResyncCollection(ctx context.Context, req *catalogpb.ResyncCollectionRequest, opts ...grpc.CallOption) (*catalogpb.ResyncCollectionResponse, error) {
+	s.requests = append(s.requests, req)
+	return &catalogpb.ResyncCollectionResponse{
+		Id:              "resync-1",
+		ChainId:         req.ChainId,
+		ContractAddress: req.ContractAddress,
+		Standard:        "ERC721",
+		BlockNumber:     500,
+		TokensSampled:   2,
+		Drifts:          []*catalogpb.ResyncDrift{{Kind: "owner", TokenId: "2", Catalog: "0xaa", Chain: "0xbb"}},
+		Repaired:        req.Repair,
+		CheckedAt:       timestamppb.New(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)),
+	}, nil
+}
+
+func resyncResolver(catalog *stubResyncCatalog) schemas.MutationResolver {
+	var cc catalogpb.CatalogServiceClient = catalog
+	return graphql_resolver.NewResolver(nil, nil, nil).WithCatalogClient(&grpcclients.CatalogClient{Client: &cc}).Mutation()
+}
+
+func TestResyncCollection_AdminGetsDriftReport(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	catalog := &stubResyncCatalog{}
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "admin-1"})
+	repair, sample := true, 50
+
+	report, err := resyncResolver(catalog).ResyncCollection(ctx, schemas.ResyncCollectionInput{
+		ChainID: "eip155-1", Contract: "0xabc", SampleSize: &sample, Repair: &repair,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, catalog.requests, 1)
+	assert.Equal(t, "admin-1", catalog.requests[0].RequestedBy)
+	assert.Equal(t, int32(50), catalog.requests[0].SampleSize)
+	assert.True(t, catalog.requests[0].Repair)
+
+	assert.Equal(t, "500", report.BlockNumber)
+	assert.True(t, report.Repaired)
+	require.Len(t, report.Drifts, 1)
+	assert.Equal(t, "owner", report.Drifts[0].Kind)
+	require.NotNil(t, report.Drifts[0].TokenID)
+	assert.Equal(t, "2", *report.Drifts[0].TokenID)
+	assert.Nil(t, report.Drifts[0].Holder)
+	assert.Equal(t, "2026-10-01T12:00:00Z", report.CheckedAt)
+}
+
+func TestResyncCollection_Rejections(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	catalog := &stubResyncCatalog{}
+	resolver := resyncResolver(catalog)
+
+	userCtx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1"})
+	_, err := resolver.ResyncCollection(userCtx, schemas.ResyncCollectionInput{ChainID: "eip155-1", Contract: "0xabc"})
+	assert.Error(t, err)
+
+	adminCtx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "admin-1"})
+	zero := 0
+	_, err = resolver.ResyncCollection(adminCtx, schemas.ResyncCollectionInput{ChainID: "eip155-1", Contract: "0xabc", SampleSize: &zero})
+	assert.Error(t, err)
+	assert.Empty(t, catalog.requests)
+}
@@ -0,0 +1,88 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/scalars"
+)
+
+func TestUnmarshalBigInt(t *testing.T) {
+	for input, want := range map[interface{}]string{
+		"007":                "7",
+		json.Number("12345"): "12345",
+		int64(42):            "42",
+		"115792089237316195423570985008687907853269984665640564039457584007913129639935": "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+	} {
+		got, err := scalars.UnmarshalBigInt(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, got)
+	}
+
+	for _, input := range []interface{}{"-1", "1.5", "0x10", "", 1.5,
+		"115792089237316195423570985008687907853269984665640564039457584007913129639936"} {
+		_, err := scalars.UnmarshalBigInt(input)
+		assert.Error(t, err, input)
+	}
+
+	var buf bytes.Buffer
+	scalars.MarshalBigInt("1000000000000000000").MarshalGQL(&buf)
+	assert.Equal(t, `"1000000000000000000"`, buf.String())
+}
+
+func TestUnmarshalAddress(t *testing.T) {
+	for _, input := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", // EIP-55 checksummed
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+	} {
+		got, err := scalars.UnmarshalAddress(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, input, got)
+	}
+
+	for _, input := range []interface{}{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", // checksum broken
+		"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea",
+		42,
+	} {
+		_, err := scalars.UnmarshalAddress(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestChainId(t *testing.T) {
+	got, err := scalars.UnmarshalChainId("eip155:11155111")
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:11155111", got)
+
+	// the indexer form is accepted and normalized
+	got, err = scalars.UnmarshalChainId("eip155-1")
+	require.NoError(t, err)
+	assert.Equal(t, "eip155:1", got)
+
+	for _, input := range []interface{}{"1", "eip155:", "EIP155:1", 1} {
+		_, err := scalars.UnmarshalChainId(input)
+		assert.Error(t, err, input)
+	}
+
+	var buf bytes.Buffer
+	scalars.MarshalChainId("eip155-1").MarshalGQL(&buf)
+	assert.Equal(t, `"eip155:1"`, buf.String())
+}
+
+func TestUnmarshalDateTime(t *testing.T) {
+	got, err := scalars.UnmarshalDateTime("2026-03-01T14:00:00+02:00")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-01T12:00:00Z", got)
+
+	for _, input := range []interface{}{"2026-03-01", "yesterday", int64(1772366400)} {
+		_, err := scalars.UnmarshalDateTime(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+)
+
+func sponsorshipResolver(orchestrator *MockOrchestratorServiceClient) *graphql_resolver.Resolver {
+	var oc orchestratorpb.OrchestratorServiceClient = orchestrator
+	return graphql_resolver.NewResolver(nil, nil, nil).
+		WithOrchestratorClient(&grpcclients.OrchestratorClient{Client: &oc})
+}
+
+func asUser(userID string) context.Context {
+	return context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: userID})
+}
+
+func TestTopUpSponsorshipBudget_AdminOnly(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	orchestrator := &MockOrchestratorServiceClient{}
+	resolver := sponsorshipResolver(orchestrator).Mutation()
+
+	_, err := resolver.TopUpSponsorshipBudget(asUser("user-1"), "eip155:8453", "1000", nil)
+	assert.ErrorIs(t, err, middleware.ErrAdminRequired)
+
+	_, err = resolver.TopUpSponsorshipBudget(asUser("admin-1"), "eip155:8453", "9223372036854775808", nil)
+	assert.EqualError(t, err, "amountGwei must be a positive 64-bit integer")
+	orchestrator.AssertNotCalled(t, "TopUpSponsorshipBudget", mock.Anything, mock.Anything)
+
+	note := "march drop"
+	orchestrator.On("TopUpSponsorshipBudget", mock.Anything, &orchestratorpb.TopUpSponsorshipBudgetRequest{
+		ChainId: "eip155:8453", AmountGwei: 1000, Note: note, ActorId: "admin-1",
+	}).Return(&orchestratorpb.TopUpSponsorshipBudgetResponse{Budget: &orchestratorpb.SponsorshipBudget{
+		ChainId: "eip155:8453", BalanceGwei: -20, ToppedUpGwei: 1000, SpentGwei: 1020, UpdatedBy: "admin-1",
+	}}, nil)
+
+	budget, err := resolver.TopUpSponsorshipBudget(asUser("admin-1"), "eip155:8453", "1000", &note)
+	require.NoError(t, err)
+	assert.Equal(t, "-20", budget.BalanceGwei)
+	assert.Equal(t, "1020", budget.SpentGwei)
+	assert.Nil(t, budget.FrozenReason)
+}
+
+func TestFreezeSponsorshipBudget(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	orchestrator := &MockOrchestratorServiceClient{}
+	resolver := sponsorshipResolver(orchestrator).Mutation()
+	orchestrator.On("SetSponsorshipBudgetFrozen", mock.Anything, &orchestratorpb.SetSponsorshipBudgetFrozenRequest{
+		ChainId: "eip155:8453", Frozen: true, Reason: "gas spike", ActorId: "admin-1",
+	}).Return(&orchestratorpb.SetSponsorshipBudgetFrozenResponse{Budget: &orchestratorpb.SponsorshipBudget{
+		ChainId: "eip155:8453", Frozen: true, FrozenReason: "gas spike",
+	}}, nil)
+	orchestrator.On("SetSponsorshipBudgetFrozen", mock.Anything, &orchestratorpb.SetSponsorshipBudgetFrozenRequest{
+		ChainId: "eip155:8453", ActorId: "admin-1",
+	}).Return(&orchestratorpb.SetSponsorshipBudgetFrozenResponse{Budget: &orchestratorpb.SponsorshipBudget{
+		ChainId: "eip155:8453",
+	}}, nil)
+
+	budget, err := resolver.FreezeSponsorshipBudget(asUser("admin-1"), "eip155:8453", "gas spike")
+	require.NoError(t, err)
+	assert.True(t, budget.Frozen)
+	assert.Equal(t, "gas spike", *budget.FrozenReason)
+
+	budget, err = resolver.UnfreezeSponsorshipBudget(asUser("admin-1"), "eip155:8453")
+	require.NoError(t, err)
+	assert.False(t, budget.Frozen)
+	orchestrator.AssertNumberOfCalls(t, "SetSponsorshipBudgetFrozen", 2)
+}
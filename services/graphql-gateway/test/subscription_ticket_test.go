@@ -0,0 +1,40 @@
+package test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+func TestCreateSubscriptionTicket_PassesSessionAndOrigin(t *testing.T) {
+	mockAuthClient := new(MockAuthServiceClient)
+	var ac authpb.AuthServiceClient = mockAuthClient
+	resolver := graphql_resolver.NewResolver(&grpcclients.AuthClient{Client: &ac}, nil, nil).Mutation()
+
+	_, err := resolver.CreateSubscriptionTicket(context.Background())
+	assert.Error(t, err)
+
+	req := httptest.NewRequest("POST", "/graphql", nil)
+	req.Header.Set("Origin", "https://app.zuno.io")
+	ctx := context.WithValue(context.Background(), middleware.RequestKey, req)
+	ctx = context.WithValue(ctx, middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1", SessionID: "session-1"})
+
+	mockAuthClient.On("IssueSubscriptionTicket", mock.Anything, &authpb.IssueSubscriptionTicketRequest{
+		UserId: "user-1", SessionId: "session-1", Origin: "https://app.zuno.io",
+	}).Return(&authpb.IssueSubscriptionTicketResponse{Ticket: "ticket-1", ExpiresAt: "2026-03-01T12:00:30Z"}, nil)
+
+	ticket, err := resolver.CreateSubscriptionTicket(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ticket-1", ticket.Ticket)
+	assert.Equal(t, "2026-03-01T12:00:30Z", ticket.ExpiresAt)
+	mockAuthClient.AssertExpectations(t)
+}
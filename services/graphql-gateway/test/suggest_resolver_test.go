@@ -0,0 +1,140 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+// stubSuggestCatalog answers Suggest with fixed suggestions; other catalog calls are not
+// used by suggest
+type stubSuggestCatalog struct {
+	catalogpb.CatalogServiceClient
+	suggestions []*catalogpb.Suggestion
+	err         error
+	requests    []*catalogpb.SuggestRequest
+}
+
+func (s *stubSuggestCatalog) Suggest(ctx context.Context, req *catalogpb.SuggestRequest, opts ...grpc.CallOption) (*catalogpb.SuggestResponse, error) {
+	s.requests = append(s.requests, req)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &catalogpb.SuggestResponse{Suggestions: s.suggestions}, nil
+}
+
+type stubSuggestUsers struct {
+	userpb.UserServiceClient
+	users    []*userpb.UserSuggestion
+	err      error
+	requests []*userpb.SuggestUsersRequest
+}
+
+func (s *stubSuggestUsers) SuggestUsers(ctx context.Context, req *userpb.SuggestUsersRequest, opts ...grpc.CallOption) (*userpb.SuggestUsersResponse, error) {
+	s.requests = append(s.requests, req)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &userpb.SuggestUsersResponse{Users: s.users}, nil
+}
+
+func suggestResolver(catalog *stubSuggestCatalog, users *stubSuggestUsers) schemas.QueryResolver {
+	var cc catalogpb.CatalogServiceClient = catalog
+	var uc userpb.UserServiceClient = users
+	return graphql_resolver.NewResolver(nil, nil, nil).
+		WithCatalogClient(&grpcclients.CatalogClient{Client: &cc}).
+		WithUserClient(&grpcclients.UserClient{Client: &uc}).
+		Query()
+}
+
+func TestSuggest_MergesSourcesByScore(t *testing.T) {
+	catalog := &stubSuggestCatalog{suggestions: []*catalogpb.Suggestion{
+		{Kind: "collection", ChainId: "eip155-1", ContractAddress: "0xabc", Slug: "bored-apes", Label: "Bored Apes", ImageUrl: "https://img/ape.png", Score: 1.9},
+		{Kind: "token", ChainId: "eip155-1", ContractAddress: "0xabc", TokenId: "7", Label: "Bored Ape #7", CollectionName: "Bored Apes", Score: 0.6},
+	}}
+	users := &stubSuggestUsers{users: []*userpb.UserSuggestion{
+		{UserId: "u-1", Username: "boredwhale", DisplayName: "Whale", AvatarUrl: "https://img/whale.png", Score: 1.2},
+		{UserId: "u-2", DisplayName: "Bored Dev", Score: 0.4},
+	}}
+	limit := 3
+
+	got, err := suggestResolver(catalog, users).Suggest(context.Background(), "  Bored ", &limit)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+
+	assert.Equal(t, schemas.SuggestionKindCollection, got[0].Kind)
+	assert.Equal(t, "collection:eip155:1:0xabc", got[0].ID)
+	assert.Equal(t, "bored-apes", *got[0].Slug)
+	assert.Equal(t, "https://img/ape.png", *got[0].ThumbnailURL)
+
+	assert.Equal(t, schemas.SuggestionKindUser, got[1].Kind)
+	assert.Equal(t, "boredwhale", got[1].Label)
+	assert.Equal(t, "Whale", *got[1].Sublabel)
+
+	assert.Equal(t, "token:eip155:1:0xabc:7", got[2].ID)
+	assert.Equal(t, "Bored Apes", *got[2].Sublabel)
+
+	assert.Equal(t, "bored", catalog.requests[0].Query)
+	assert.Equal(t, int32(3), users.requests[0].Limit)
+}
+
+func TestSuggest_CachesByNormalizedQuery(t *testing.T) {
+	catalog := &stubSuggestCatalog{suggestions: []*catalogpb.Suggestion{
+		{Kind: "collection", ChainId: "eip155-1", ContractAddress: "0xabc", Label: "Punks", Score: 2},
+	}}
+	users := &stubSuggestUsers{}
+	resolver := suggestResolver(catalog, users)
+
+	first, err := resolver.Suggest(context.Background(), "Punk", nil)
+	require.NoError(t, err)
+	second, err := resolver.Suggest(context.Background(), " punk  ", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Len(t, catalog.requests, 1)
+	assert.Len(t, users.requests, 1)
+}
+
+func TestSuggest_ServesOneSourceWhenTheOtherFails(t *testing.T) {
+	catalog := &stubSuggestCatalog{err: errors.New("catalog down")}
+	users := &stubSuggestUsers{users: []*userpb.UserSuggestion{{UserId: "u-1", Username: "alice", Score: 1.5}}}
+	resolver := suggestResolver(catalog, users)
+
+	got, err := resolver.Suggest(context.Background(), "ali", nil)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "user:u-1", got[0].ID)
+
+	// partial results are not cached
+	_, err = resolver.Suggest(context.Background(), "ali", nil)
+	require.NoError(t, err)
+	assert.Len(t, catalog.requests, 2)
+}
+
+func TestSuggest_FailsWhenEverySourceFails(t *testing.T) {
+	resolver := suggestResolver(&stubSuggestCatalog{err: errors.New("catalog down")}, &stubSuggestUsers{err: errors.New("users down")})
+
+	_, err := resolver.Suggest(context.Background(), "ali", nil)
+	assert.Error(t, err)
+}
+
+func TestSuggest_SkipsShortQueries(t *testing.T) {
+	catalog := &stubSuggestCatalog{}
+	users := &stubSuggestUsers{}
+
+	got, err := suggestResolver(catalog, users).Suggest(context.Background(), " a ", nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	assert.Empty(t, catalog.requests)
+	assert.Empty(t, users.requests)
+}
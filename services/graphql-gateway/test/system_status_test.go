@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+)
+
+// stubStatusCatalog serves GetSystemStatus; other catalog calls are not used by systemStatus
+type stubStatusCatalog struct {
+	catalogpb.CatalogServiceClient
+	calls int
+}
+
+func (s *stubStatusCatalog) GetSystemStatus(ctx context.Context, req *catalogpb.GetSystemStatusRequest, opts ...grpc.CallOption) (*catalogpb.GetSystemStatusResponse, error) {
+	s.calls++
+	checkedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	return &catalogpb.GetSystemStatusResponse{
+		Queues: []*catalogpb.QueueStatus{
+			{Name: "catalog-service-queue", Messages: 5000, Consumers: 1},
+			{Name: "indexer.dlq", Error: "NOT_FOUND - no queue"},
+		},
+		Consumers: []*catalogpb.ConsumerStatus{{
+			Consumer: "catalog-service-consumer", Queue: "catalog-service-queue", MessagesPerSecond: 20,
+			Processed: 120000, ReportedAt: timestamppb.New(checkedAt), FallingBehind: true,
+		}},
+		CheckedAt: timestamppb.New(checkedAt),
+	}, nil
+}
+
+func TestSystemStatus_AdminSeesQueuesAndLag(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	catalog := &stubStatusCatalog{}
+	var cc catalogpb.CatalogServiceClient = catalog
+	resolver := graphql_resolver.NewResolver(nil, nil, nil).WithCatalogClient(&grpcclients.CatalogClient{Client: &cc}).Query()
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "admin-1"})
+
+	status, err := resolver.SystemStatus(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, status.Queues, 2)
+	assert.Equal(t, 5000, status.Queues[0].Messages)
+	assert.Nil(t, status.Queues[0].Error)
+	require.NotNil(t, status.Queues[1].Error)
+	require.Len(t, status.Consumers, 1)
+	assert.True(t, status.Consumers[0].FallingBehind)
+	assert.Equal(t, 120000, status.Consumers[0].Processed)
+	assert.Equal(t, "2026-10-01T12:00:00Z", status.CheckedAt)
+}
+
+func TestSystemStatus_RequiresAdmin(t *testing.T) {
+	t.Setenv("ADMIN_USER_IDS", "admin-1")
+	catalog := &stubStatusCatalog{}
+	var cc catalogpb.CatalogServiceClient = catalog
+	resolver := graphql_resolver.NewResolver(nil, nil, nil).WithCatalogClient(&grpcclients.CatalogClient{Client: &cc}).Query()
+	ctx := context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1"})
+
+	_, err := resolver.SystemStatus(ctx)
+
+	assert.Error(t, err)
+	assert.Zero(t, catalog.calls)
+}
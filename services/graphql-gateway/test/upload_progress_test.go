@@ -0,0 +1,44 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/utils"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+func TestMapUploadProgress(t *testing.T) {
+	emittedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	uploading := utils.MapUploadProgress(&contracts.UploadProgress{
+		Ticket:        "ticket-123",
+		Stage:         contracts.UploadStageUploading,
+		BytesReceived: 1 << 20,
+		TotalBytes:    5 << 20,
+		EmittedAt:     emittedAt,
+	})
+	require.NotNil(t, uploading)
+	assert.Equal(t, schemas.UploadStageUploading, uploading.Stage)
+	assert.Equal(t, 1<<20, uploading.BytesReceived)
+	assert.Equal(t, 5<<20, uploading.TotalBytes)
+	assert.Equal(t, "2024-01-01T00:00:00Z", uploading.EmittedAt)
+	assert.Nil(t, uploading.AssetID)
+	assert.Nil(t, uploading.Error)
+
+	done := utils.MapUploadProgress(&contracts.UploadProgress{Ticket: "ticket-123", Stage: contracts.UploadStageDone, AssetID: "asset-1"})
+	assert.Equal(t, schemas.UploadStageDone, done.Stage)
+	require.NotNil(t, done.AssetID)
+	assert.Equal(t, "asset-1", *done.AssetID)
+
+	failed := utils.MapUploadProgress(&contracts.UploadProgress{Ticket: "ticket-123", Stage: contracts.UploadStageFailed, Error: "pin failed"})
+	assert.Equal(t, schemas.UploadStageFailed, failed.Stage)
+	require.NotNil(t, failed.Error)
+	assert.True(t, failed.Stage.IsValid())
+
+	assert.Nil(t, utils.MapUploadProgress(nil))
+}
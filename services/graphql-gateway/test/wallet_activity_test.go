@@ -0,0 +1,55 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/activity"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+func TestActivityTracker_TouchesOncePerInterval(t *testing.T) {
+	touched := make(chan string, 4)
+	wallet := new(MockWalletServiceClient)
+	wallet.On("TouchWallets", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		touched <- args.Get(1).(*walletpb.TouchWalletsRequest).UserId
+	}).Return(&walletpb.TouchWalletsResponse{Touched: 1}, nil)
+	tracker := activity.NewTracker(wallet, time.Hour)
+
+	assert.True(t, tracker.Touch("user-1"))
+	assert.False(t, tracker.Touch("user-1"))
+	assert.True(t, tracker.Touch("user-2"))
+	assert.False(t, tracker.Touch(""))
+
+	var users []string
+	for len(users) < 2 {
+		select {
+		case userID := <-touched:
+			users = append(users, userID)
+		case <-time.After(time.Second):
+			t.Fatal("wallets were not touched")
+		}
+	}
+	assert.ElementsMatch(t, []string{"user-1", "user-2"}, users)
+}
+
+func TestActivityTracker_SkipsAnonymousAndImpersonatedOperations(t *testing.T) {
+	wallet := new(MockWalletServiceClient)
+	wallet.On("TouchWallets", mock.Anything, mock.Anything).Return(&walletpb.TouchWalletsResponse{}, nil).Maybe()
+	tracker := activity.NewTracker(wallet, time.Hour)
+	next := func(ctx context.Context) graphql.ResponseHandler {
+		return func(ctx context.Context) *graphql.Response { return nil }
+	}
+
+	tracker.AroundOperations(context.Background(), next)
+	tracker.AroundOperations(context.WithValue(context.Background(), middleware.CurrentUserKey, impersonatedUser()), next)
+
+	// Neither operation was the user's own, so their first own operation still touches
+	assert.True(t, tracker.Touch("user-1"))
+}
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	graphql_resolver "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	grpcclients "github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/grpc_clients"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/middleware"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+func walletResolver(wallet *MockWalletServiceClient) *graphql_resolver.Resolver {
+	var wc walletpb.WalletServiceClient = wallet
+	return graphql_resolver.NewResolver(nil, &grpcclients.WalletClient{Client: &wc}, nil)
+}
+
+func walletContext() context.Context {
+	return context.WithValue(context.Background(), middleware.CurrentUserKey, &middleware.CurrentUser{UserID: "user-1"})
+}
+
+func TestMyWallets_SeparatesWatchOnly(t *testing.T) {
+	verified := timestamppb.New(time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC))
+	wallet := new(MockWalletServiceClient)
+	wallet.On("ListLinks", mock.Anything, &walletpb.ListLinksRequest{UserId: "user-1"}).Return(&walletpb.ListLinksResponse{
+		Links: []*walletpb.WalletLink{
+			{Id: "w-1", Address: "0x00000000000000000000000000000000000000aa", ChainId: "eip155:1", IsPrimary: true, VerifiedAt: verified, LastSeenAt: verified, CreatedAt: verified},
+			{Id: "w-2", Address: "0x00000000000000000000000000000000000000bb", ChainId: "eip155:1", IsWatchOnly: true, Label: "Vault", Tags: []string{"cold"}, CreatedAt: verified},
+		},
+	}, nil)
+	resolver := walletResolver(wallet).Query()
+
+	all, err := resolver.MyWallets(walletContext(), nil)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.NotNil(t, all[0].VerifiedAt)
+	require.NotNil(t, all[0].LastSeenAt)
+	assert.Equal(t, "2026-05-01T00:00:00Z", *all[0].LastSeenAt)
+	assert.Nil(t, all[1].LastSeenAt)
+	assert.Nil(t, all[0].Label)
+	assert.Equal(t, []string{}, all[0].Tags)
+	assert.Nil(t, all[1].VerifiedAt)
+	assert.Equal(t, "Vault", *all[1].Label)
+
+	watchOnly := true
+	watched, err := resolver.MyWallets(walletContext(), &watchOnly)
+	require.NoError(t, err)
+	require.Len(t, watched, 1)
+	assert.Equal(t, "w-2", watched[0].ID)
+	assert.True(t, watched[0].IsWatchOnly)
+
+	_, err = resolver.MyWallets(context.Background(), nil)
+	assert.Error(t, err)
+}
+
+func TestAddWatchOnlyWallet(t *testing.T) {
+	wallet := new(MockWalletServiceClient)
+	wallet.On("AddWatchOnlyWallet", mock.Anything, &walletpb.AddWatchOnlyWalletRequest{
+		UserId:  "user-1",
+		Address: "0x00000000000000000000000000000000000000bb",
+		ChainId: "eip155:1",
+		Tags:    []string{"cold"},
+	}).Return(&walletpb.AddWatchOnlyWalletResponse{Link: &walletpb.WalletLink{Id: "w-2", IsWatchOnly: true, Tags: []string{"cold"}}}, nil).Once()
+	wallet.On("AddWatchOnlyWallet", mock.Anything, mock.Anything).Return(nil, status.Error(codes.AlreadyExists, "wallet_already_exists"))
+	resolver := walletResolver(wallet).Mutation()
+
+	added, err := resolver.AddWatchOnlyWallet(walletContext(), "0x00000000000000000000000000000000000000bb", "eip155:1", nil, []string{"cold"})
+	require.NoError(t, err)
+	assert.True(t, added.IsWatchOnly)
+
+	_, err = resolver.AddWatchOnlyWallet(walletContext(), "0x00000000000000000000000000000000000000bb", "eip155:1", nil, nil)
+	assert.EqualError(t, err, "wallet is already on your account")
+}
+
+func TestUpdateWallet_ReplacesTagsOnlyWhenGiven(t *testing.T) {
+	label := "Trading"
+	wallet := new(MockWalletServiceClient)
+	wallet.On("UpdateWalletDetails", mock.Anything, mock.MatchedBy(func(req *walletpb.UpdateWalletDetailsRequest) bool {
+		return req.GetLabel() == label && !req.GetReplaceTags()
+	})).Return(&walletpb.UpdateWalletDetailsResponse{Link: &walletpb.WalletLink{Id: "w-1", Label: label}}, nil).Once()
+	wallet.On("UpdateWalletDetails", mock.Anything, mock.MatchedBy(func(req *walletpb.UpdateWalletDetailsRequest) bool {
+		return req.Label == nil && req.GetReplaceTags() && len(req.GetTags()) == 0
+	})).Return(&walletpb.UpdateWalletDetailsResponse{Link: &walletpb.WalletLink{Id: "w-1"}}, nil).Once()
+	resolver := walletResolver(wallet).Mutation()
+
+	updated, err := resolver.UpdateWallet(walletContext(), schemas.UpdateWalletInput{WalletID: "w-1", Label: &label})
+	require.NoError(t, err)
+	assert.Equal(t, label, *updated.Label)
+
+	_, err = resolver.UpdateWallet(walletContext(), schemas.UpdateWalletInput{WalletID: "w-1", Tags: []string{}})
+	require.NoError(t, err)
+	wallet.AssertExpectations(t)
+}
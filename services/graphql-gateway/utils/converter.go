@@ -140,6 +140,10 @@ func PtrStr(s *string) string {
 	return *s
 }
 
+func PtrBool(b *bool) bool {
+	return b != nil && *b
+}
+
 func ParseUint64(s *string) uint64 {
 	if s == nil || *s == "" {
 		return 0
@@ -2,10 +2,23 @@ package utils
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/graphql/schemas"
+	"github.com/quangdang46/NFT-Marketplace/services/graphql-gateway/platformstatus"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/locale"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+	catalogpb "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
 	chainregpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
 	mediaProto "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
 )
 
 // Media mapping functions
@@ -72,6 +85,44 @@ func MapAssetToGraphQL(asset *mediaProto.Asset) *schemas.MediaAsset {
 	}
 }
 
+// MapStorageUsage maps the media service's usage report; zero limits are unlimited
+func MapStorageUsage(usage *mediaProto.GetStorageUsageResponse) *schemas.StorageUsage {
+	byKind := make([]*schemas.MediaKindUsage, len(usage.Kinds))
+	for i, k := range usage.Kinds {
+		byKind[i] = &schemas.MediaKindUsage{
+			Kind:   ConvertMediaKindFromProto(k.Kind),
+			Bytes:  fmt.Sprintf("%d", k.Bytes),
+			Assets: int(k.Assets),
+		}
+		if k.HardLimit != nil {
+			byKind[i].HardLimit = mapStorageLimits(k.HardLimit)
+		}
+	}
+
+	soft := usage.GetSoftLimit()
+	return &schemas.StorageUsage{
+		Bytes:         fmt.Sprintf("%d", usage.Bytes),
+		Assets:        int(usage.Assets),
+		ByKind:        byKind,
+		SoftLimit:     mapStorageLimits(soft),
+		HardLimit:     mapStorageLimits(usage.GetHardLimit()),
+		OverSoftLimit: (soft.GetBytes() > 0 && usage.Bytes > soft.GetBytes()) || (soft.GetAssets() > 0 && usage.Assets > soft.GetAssets()),
+	}
+}
+
+func mapStorageLimits(limits *mediaProto.StorageLimits) *schemas.StorageLimits {
+	out := &schemas.StorageLimits{}
+	if limits.GetBytes() > 0 {
+		b := fmt.Sprintf("%d", limits.GetBytes())
+		out.Bytes = &b
+	}
+	if limits.GetAssets() > 0 {
+		a := int(limits.GetAssets())
+		out.Assets = &a
+	}
+	return out
+}
+
 // Chain Registry mapping functions
 func MapContract(c *chainregpb.Contract) *schemas.Contract {
 	if c == nil {
@@ -115,6 +166,20 @@ func MapGasPolicy(p *chainregpb.GasPolicy) *schemas.GasPolicy {
 	}
 }
 
+func MapChainCapabilities(c *chainregpb.ChainCapabilities) *schemas.ChainCapabilities {
+	if c == nil {
+		return nil
+	}
+	return &schemas.ChainCapabilities{
+		Erc721Factory:  c.GetErc721Factory(),
+		Erc1155Factory: c.GetErc1155Factory(),
+		AllowlistMint:  c.GetAllowlistMint(),
+		LazyMint:       c.GetLazyMint(),
+		Version:        int(c.GetVersion()),
+		UpdatedAt:      StrPtrOrNil(c.GetUpdatedAt()),
+	}
+}
+
 func MapChainParams(p *chainregpb.ChainParams) *schemas.ChainParams {
 	if p == nil {
 		return nil
@@ -125,3 +190,1173 @@ func MapChainParams(p *chainregpb.ChainParams) *schemas.ChainParams {
 		BlockTimeMs:           IntPtrIfNonZero(p.GetBlockTimeMs()),
 	}
 }
+
+func MapChainHead(h *chainregpb.ChainHead) *schemas.ChainHead {
+	if h == nil {
+		return nil
+	}
+	return &schemas.ChainHead{
+		ChainID:         h.GetChainId(),
+		LatestBlock:     strconv.FormatUint(h.GetLatestBlock(), 10),
+		LatestBlockHash: h.GetLatestBlockHash(),
+		LatestBlockTime: h.GetLatestBlockTime(),
+		FinalizedBlock:  strconv.FormatUint(h.GetFinalizedBlock(), 10),
+		FinalizedByTag:  h.GetFinalizedByTag(),
+		AdvancedAt:      h.GetAdvancedAt(),
+		Stale:           h.GetStale(),
+	}
+}
+
+// MapPlatformStatus maps an aggregated platform status snapshot
+func MapPlatformStatus(snapshot *platformstatus.Snapshot) *schemas.PlatformStatus {
+	formatTime := func(t *time.Time) *string {
+		if t == nil {
+			return nil
+		}
+		formatted := t.UTC().Format("2006-01-02T15:04:05Z07:00")
+		return &formatted
+	}
+	out := &schemas.PlatformStatus{
+		Status:   schemas.PlatformHealth(snapshot.Status),
+		Services: make([]*schemas.ServiceHealth, 0, len(snapshot.Services)),
+		Chains:   make([]*schemas.ChainIndexingStatus, 0, len(snapshot.Chains)),
+		Queues:   make([]*schemas.QueueBacklog, 0, len(snapshot.Queues)),
+		Pinning: &schemas.PinningHealth{
+			Status:       schemas.PlatformHealth(snapshot.Pinning.Status),
+			LastPinnedAt: formatTime(snapshot.Pinning.LastPinnedAt),
+		},
+		CheckedAt: snapshot.CheckedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	for _, s := range snapshot.Services {
+		out.Services = append(out.Services, &schemas.ServiceHealth{Name: s.Name, Status: schemas.PlatformHealth(s.Status)})
+	}
+	for _, c := range snapshot.Chains {
+		out.Chains = append(out.Chains, &schemas.ChainIndexingStatus{
+			ChainID:     c.ChainID,
+			Status:      schemas.PlatformHealth(c.Status),
+			LatestBlock: strconv.FormatUint(c.LatestBlock, 10),
+			AdvancedAt:  formatTime(c.AdvancedAt),
+			LagSeconds:  c.LagSeconds,
+		})
+	}
+	for _, q := range snapshot.Queues {
+		out.Queues = append(out.Queues, &schemas.QueueBacklog{Name: q.Name, Status: schemas.PlatformHealth(q.Status), Messages: q.Messages})
+	}
+	return out
+}
+
+// Catalog mapping functions
+func MapToken(t *catalogpb.Token) *schemas.Token {
+	if t == nil {
+		return nil
+	}
+	token := &schemas.Token{
+		ChainID:   t.GetChainId(),
+		Contract:  t.GetContractAddress(),
+		TokenID:   t.GetTokenId(),
+		Standard:  t.GetStandard(),
+		Supply:    t.GetSupply(),
+		MaxSupply: StrPtrOrNil(t.GetMaxSupply()),
+		Minted:    t.GetMinted(),
+		Burned:    t.GetBurned(),
+		Name:      StrPtrOrNil(t.GetName()),
+		ImageURL:  StrPtrOrNil(t.GetImageUrl()),
+		Owner:     StrPtrOrNil(t.GetOwner()),
+		Price:     StrPtrOrNil(t.GetPrice()),
+	}
+	if t.GetRarityScore() != nil {
+		score := t.GetRarityScore().GetValue()
+		token.RarityScore = &score
+	}
+	token.Rentals = make([]*schemas.TokenRental, 0, len(t.GetRentals()))
+	for _, r := range t.GetRentals() {
+		token.Rentals = append(token.Rentals, &schemas.TokenRental{
+			Standard:  r.GetStandard(),
+			Renter:    r.GetRenter(),
+			Amount:    r.GetAmount(),
+			ExpiresAt: r.GetExpiresAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+			RecordID:  StrPtrOrNil(r.GetRecordId()),
+			Owner:     StrPtrOrNil(r.GetOwner()),
+		})
+	}
+	return token
+}
+
+// Catalog sort keys of the GraphQL sort fields
+var (
+	collectionSortKeys = map[schemas.CollectionSortField]string{
+		schemas.CollectionSortFieldCreatedAt: "created_at",
+		schemas.CollectionSortFieldFloor:     "floor",
+		schemas.CollectionSortFieldVolume:    "volume",
+	}
+	tokenSortKeys = map[schemas.TokenSortField]string{
+		schemas.TokenSortFieldCreatedAt: "created_at",
+		schemas.TokenSortFieldPrice:     "price",
+		schemas.TokenSortFieldRarity:    "rarity",
+	}
+)
+
+// MapCollectionSort returns the catalog sort key and direction; nil keeps the catalog default
+func MapCollectionSort(sort *schemas.CollectionSortInput) (key string, descending bool) {
+	if sort == nil {
+		return "", false
+	}
+	return collectionSortKeys[sort.Field], sortDescending(sort.Direction)
+}
+
+// MapTokenSort returns the catalog sort key and direction; nil keeps the catalog default
+func MapTokenSort(sort *schemas.TokenSortInput) (key string, descending bool) {
+	if sort == nil {
+		return "", false
+	}
+	return tokenSortKeys[sort.Field], sortDescending(sort.Direction)
+}
+
+func sortDescending(direction *schemas.SortDirection) bool {
+	return direction == nil || *direction == schemas.SortDirectionDesc
+}
+
+func MapPriceRange(r *schemas.PriceRangeInput) *catalogpb.PriceRange {
+	if r == nil {
+		return nil
+	}
+	return &catalogpb.PriceRange{Min: PtrStr(r.Min), Max: PtrStr(r.Max)}
+}
+
+func MapTraitFilters(traits []*schemas.TraitFilterInput) []*catalogpb.TraitFilter {
+	out := make([]*catalogpb.TraitFilter, 0, len(traits))
+	for _, t := range traits {
+		out = append(out, &catalogpb.TraitFilter{Name: t.Name, Values: t.Values})
+	}
+	return out
+}
+
+func MapCollection(c *catalogpb.Collection) *schemas.Collection {
+	if c == nil {
+		return nil
+	}
+	return &schemas.Collection{
+		ID:                    c.GetId(),
+		Slug:                  c.GetSlug(),
+		Name:                  c.GetName(),
+		Description:           StrPtrOrNil(c.GetDescription()),
+		ChainID:               c.GetChainId(),
+		ContractAddress:       c.GetContractAddress(),
+		Creator:               c.GetCreator(),
+		Owner:                 StrPtrOrNil(c.GetOwner()),
+		Type:                  c.GetCollectionType(),
+		MaxSupply:             StrPtrOrNil(c.GetMaxSupply()),
+		TotalSupply:           StrPtrOrNil(c.GetTotalSupply()),
+		RoyaltyRecipient:      StrPtrOrNil(c.GetRoyaltyRecipient()),
+		RoyaltyBps:            int(c.GetRoyaltyPercentage()),
+		TokenURI:              StrPtrOrNil(c.GetTokenUri()),
+		ImageURL:              StrPtrOrNil(c.GetImageUrl()),
+		IsVerified:            c.GetIsVerified(),
+		Flagged:               c.GetFlagged(),
+		Reported:              c.GetReported(),
+		OwnerOrgID:            StrPtrOrNil(c.GetOwnerOrgId()),
+		Confirmations:         int(c.GetConfirmations()),
+		RequiredConfirmations: int(c.GetRequiredConfirmations()),
+		PendingFinality:       c.GetConfirmations() < c.GetRequiredConfirmations(),
+		LocalizedContent:      MapLocalizedContents(c.GetLocalized()),
+		PayoutSplits:          MapPayoutSplits(c.GetPayoutSplits()),
+		Category:              mapCollectionCategory(c.GetCategory()),
+		Tags:                  append([]string{}, c.GetTags()...),
+		CreatedAt:             c.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:             c.GetUpdatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func mapCollectionCategory(category string) *schemas.CollectionCategory {
+	c := schemas.CollectionCategory(category)
+	if !c.IsValid() {
+		return nil
+	}
+	return &c
+}
+
+func MapLocalizedContents(contents []*catalogpb.LocalizedContent) []*schemas.LocalizedContent {
+	out := make([]*schemas.LocalizedContent, 0, len(contents))
+	for _, content := range contents {
+		out = append(out, &schemas.LocalizedContent{
+			Locale:      content.GetLocale(),
+			Description: StrPtrOrNil(content.GetDescription()),
+			Tagline:     StrPtrOrNil(content.GetTagline()),
+			UpdatedAt:   content.GetUpdatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+	return out
+}
+
+func MapPayoutSplits(splits []*catalogpb.PayoutSplit) []*schemas.PayoutSplit {
+	out := make([]*schemas.PayoutSplit, 0, len(splits))
+	for _, split := range splits {
+		out = append(out, &schemas.PayoutSplit{Recipient: split.GetRecipient(), Bps: int(split.GetBps())})
+	}
+	return out
+}
+
+// PayoutSplitsToProto converts split inputs for the orchestrator, which checks they add up
+func PayoutSplitsToProto(splits []*schemas.PayoutSplitInput) ([]*orchestratorpb.PayoutSplit, error) {
+	out := make([]*orchestratorpb.PayoutSplit, 0, len(splits))
+	for _, split := range splits {
+		if split.Bps <= 0 {
+			return nil, fmt.Errorf("payout split shares must be positive")
+		}
+		out = append(out, &orchestratorpb.PayoutSplit{Recipient: split.Recipient, Bps: uint64(split.Bps)})
+	}
+	return out, nil
+}
+
+// LocalizeCollection shows the creator's content in the locale closest to the preferred
+// ones, falling back to English. Without a close locale the on-chain description stays,
+// unless the collection has none, in which case its first localized content is shown.
+func LocalizeCollection(collection *schemas.Collection, c *catalogpb.Collection, preferred []string) *schemas.Collection {
+	if collection == nil || len(c.GetLocalized()) == 0 {
+		return collection
+	}
+	available := make([]string, len(c.GetLocalized()))
+	for i, content := range c.GetLocalized() {
+		available[i] = content.GetLocale()
+	}
+
+	chosen, ok := locale.Match(available, preferred...)
+	if !ok {
+		chosen, ok = locale.Match(available, locale.DefaultLocale)
+	}
+	if !ok {
+		if c.GetDescription() != "" {
+			return collection
+		}
+		chosen = available[0]
+	}
+
+	for _, content := range c.GetLocalized() {
+		if content.GetLocale() != chosen {
+			continue
+		}
+		if content.GetDescription() != "" {
+			collection.Description = StrPtrOrNil(content.GetDescription())
+		}
+		collection.Tagline = StrPtrOrNil(content.GetTagline())
+		collection.ContentLocale = StrPtrOrNil(chosen)
+	}
+	return collection
+}
+
+func MapModerationFlag(f *catalogpb.ModerationFlag) *schemas.ModerationFlag {
+	if f == nil {
+		return nil
+	}
+	return &schemas.ModerationFlag{
+		ID:        f.GetId(),
+		ChainID:   f.GetChainId(),
+		Contract:  f.GetContractAddress(),
+		TokenID:   StrPtrOrNil(f.GetTokenId()),
+		Status:    f.GetStatus(),
+		Reason:    f.GetReason(),
+		Note:      StrPtrOrNil(f.GetNote()),
+		Source:    f.GetSource(),
+		UpdatedAt: f.GetUpdatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func MapResyncReport(r *catalogpb.ResyncCollectionResponse) *schemas.ResyncReport {
+	if r == nil {
+		return nil
+	}
+	drifts := make([]*schemas.ResyncDrift, len(r.GetDrifts()))
+	for i, d := range r.GetDrifts() {
+		drifts[i] = &schemas.ResyncDrift{
+			Kind:    d.GetKind(),
+			TokenID: StrPtrOrNil(d.GetTokenId()),
+			Holder:  StrPtrOrNil(d.GetHolder()),
+			Catalog: d.GetCatalog(),
+			Chain:   d.GetChain(),
+		}
+	}
+	return &schemas.ResyncReport{
+		ID:            r.GetId(),
+		ChainID:       r.GetChainId(),
+		Contract:      r.GetContractAddress(),
+		Standard:      r.GetStandard(),
+		BlockNumber:   strconv.FormatUint(r.GetBlockNumber(), 10),
+		TokensSampled: int(r.GetTokensSampled()),
+		Drifts:        drifts,
+		Repaired:      r.GetRepaired(),
+		CheckedAt:     r.GetCheckedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func MapReport(r *catalogpb.Report) *schemas.Report {
+	if r == nil {
+		return nil
+	}
+	return &schemas.Report{
+		ID:         r.GetId(),
+		TargetType: schemas.ReportTargetType(r.GetTargetType()),
+		TargetID:   r.GetTargetId(),
+		Reason:     r.GetReason(),
+		Details:    StrPtrOrNil(r.GetDetails()),
+		Status:     r.GetStatus(),
+		CreatedAt:  r.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func MapReportQueueItem(item *catalogpb.ReportQueueItem) *schemas.ReportQueueItem {
+	if item == nil {
+		return nil
+	}
+	return &schemas.ReportQueueItem{
+		TargetType:      schemas.ReportTargetType(item.GetTargetType()),
+		TargetID:        item.GetTargetId(),
+		ReportCount:     int(item.GetReportCount()),
+		Reasons:         item.GetReasons(),
+		FirstReportedAt: item.GetFirstReportedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		LastReportedAt:  item.GetLastReportedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func MapSystemStatus(resp *catalogpb.GetSystemStatusResponse) *schemas.SystemStatus {
+	out := &schemas.SystemStatus{
+		Queues:    make([]*schemas.QueueStatus, 0, len(resp.GetQueues())),
+		Consumers: make([]*schemas.ConsumerStatus, 0, len(resp.GetConsumers())),
+		CheckedAt: resp.GetCheckedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	for _, q := range resp.GetQueues() {
+		out.Queues = append(out.Queues, &schemas.QueueStatus{
+			Name:      q.GetName(),
+			Messages:  int(q.GetMessages()),
+			Consumers: int(q.GetConsumers()),
+			Error:     StrPtrOrNil(q.GetError()),
+		})
+	}
+	for _, c := range resp.GetConsumers() {
+		out.Consumers = append(out.Consumers, &schemas.ConsumerStatus{
+			Consumer:             c.GetConsumer(),
+			Queue:                c.GetQueue(),
+			MessagesPerSecond:    c.GetMessagesPerSecond(),
+			InFlight:             int(c.GetInFlight()),
+			OldestUnackedSeconds: c.GetOldestUnackedSeconds(),
+			Processed:            int(c.GetProcessed()),
+			Failed:               int(c.GetFailed()),
+			ReportedAt:           c.GetReportedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+			Stale:                c.GetStale(),
+			FallingBehind:        c.GetFallingBehind(),
+		})
+	}
+	return out
+}
+
+func MapHolderSnapshot(s *catalogpb.HolderSnapshot) *schemas.HolderSnapshot {
+	if s == nil {
+		return nil
+	}
+	return &schemas.HolderSnapshot{
+		ID:            s.GetId(),
+		ChainID:       s.GetChainId(),
+		Contract:      s.GetContractAddress(),
+		BlockNumber:   strconv.FormatUint(s.GetBlockNumber(), 10),
+		HolderCount:   int(s.GetHolderCount()),
+		TotalQuantity: s.GetTotalQuantity(),
+		CSV:           mapSnapshotExport(s.GetCsv()),
+		JSON:          mapSnapshotExport(s.GetJson()),
+		CreatedAt:     s.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func MapTokenGateResult(r *catalogpb.VerifyTokenGateResponse) *schemas.TokenGateResult {
+	out := &schemas.TokenGateResult{
+		Held:    r.GetHeld(),
+		Balance: r.GetBalance(),
+	}
+	if r.GetGateToken() != "" {
+		token := r.GetGateToken()
+		expiresAt := r.GetExpiresAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		out.Token = &token
+		out.ExpiresAt = &expiresAt
+	}
+	return out
+}
+
+func MapCollectionExport(e *catalogpb.CollectionExport) *schemas.CollectionExport {
+	if e == nil {
+		return nil
+	}
+	out := &schemas.CollectionExport{
+		ID:        e.GetId(),
+		Kind:      schemas.CollectionExportKind(e.GetKind()),
+		ChainID:   e.GetChainId(),
+		Contract:  e.GetContractAddress(),
+		From:      e.GetFrom().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		To:        e.GetTo().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		Status:    schemas.CollectionExportStatus(e.GetStatus()),
+		RowCount:  int(e.GetRowCount()),
+		CSV:       mapSnapshotExport(e.GetCsv()),
+		CreatedAt: e.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if e.GetError() != "" {
+		reason := e.GetError()
+		out.Error = &reason
+	}
+	if e.GetCompletedAt() != nil {
+		completedAt := e.GetCompletedAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		out.CompletedAt = &completedAt
+	}
+	return out
+}
+
+func mapSnapshotExport(e *catalogpb.SnapshotExport) *schemas.SnapshotExport {
+	if e == nil || e.GetDownloadUrl() == "" {
+		return nil
+	}
+	return &schemas.SnapshotExport{
+		URL:       e.GetDownloadUrl(),
+		ExpiresAt: e.GetUrlExpiresAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		Bytes:     int(e.GetBytes()),
+	}
+}
+
+func MapEmailStatus(e *userpb.EmailStatus) *schemas.EmailStatus {
+	if e == nil {
+		return nil
+	}
+	optOuts := make([]schemas.EmailKind, 0, len(e.GetOptOuts()))
+	for _, kind := range e.GetOptOuts() {
+		optOuts = append(optOuts, schemas.EmailKind(kind))
+	}
+	return &schemas.EmailStatus{
+		Email:        e.GetEmail(),
+		Verified:     e.GetVerified(),
+		DigestOptOut: e.GetDigestOptOut(),
+		VerifiedAt:   StrPtrOrNil(e.GetVerifiedAt()),
+		OptOuts:      optOuts,
+	}
+}
+
+// MapViewerPreferences maps stored preferences along with the number format they imply
+func MapViewerPreferences(p *userpb.Preferences) *schemas.ViewerPreferences {
+	if p == nil {
+		return nil
+	}
+	formatter := locale.NewFormatter(p.GetLocale(), p.GetTimezone(), p.GetCurrency())
+	format := formatter.Format()
+	return &schemas.ViewerPreferences{
+		Locale:   formatter.Locale(),
+		Timezone: formatter.Timezone(),
+		Currency: formatter.Currency(),
+		Format: &schemas.NumberFormat{
+			DecimalSeparator: format.DecimalSeparator,
+			GroupSeparator:   format.GroupSeparator,
+			CurrencySymbol:   format.CurrencySymbol,
+			FractionDigits:   format.FractionDigits,
+		},
+		HonorDelegations: p.GetHonorDelegations(),
+		UpdatedAt:        p.GetUpdatedAt(),
+	}
+}
+
+// MapAccountEvent maps a relayed wallet, auth or profile event; fields absent from the
+// published payload stay nil
+func MapAccountEvent(e *contracts.AccountEvent) *schemas.AccountEvent {
+	if e == nil {
+		return nil
+	}
+	str := func(key string) *string {
+		if v, ok := e.Data[key].(string); ok && v != "" {
+			return &v
+		}
+		return nil
+	}
+
+	out := &schemas.AccountEvent{
+		Type:       schemas.AccountEventType(e.Type),
+		OccurredAt: e.OccurredAt.Format("2006-01-02T15:04:05Z07:00"),
+		WalletID:   str("wallet_id"),
+		Address:    str("address"),
+		ChainID:    str("chain_id"),
+		SessionID:  str("session_id"),
+		ExportID:   str("export_id"),
+
+		PreviousWalletID: str("previous_wallet_id"),
+		PreviousAddress:  str("previous_address"),
+	}
+	if v, ok := e.Data["is_primary"].(bool); ok {
+		out.IsPrimary = &v
+	}
+	return out
+}
+
+// MapUploadProgress maps a media upload progress report; stages are the enum values in lower case
+func MapUploadProgress(p *contracts.UploadProgress) *schemas.UploadProgress {
+	if p == nil {
+		return nil
+	}
+	out := &schemas.UploadProgress{
+		Ticket:        p.Ticket,
+		Stage:         schemas.UploadStage(strings.ToUpper(p.Stage)),
+		BytesReceived: int(p.BytesReceived),
+		TotalBytes:    int(p.TotalBytes),
+		EmittedAt:     p.EmittedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if p.AssetID != "" {
+		out.AssetID = &p.AssetID
+	}
+	if p.Error != "" {
+		out.Error = &p.Error
+	}
+	return out
+}
+
+func MapOrganization(o *userpb.Organization) *schemas.Organization {
+	if o == nil {
+		return nil
+	}
+	return &schemas.Organization{
+		ID:        o.GetId(),
+		Name:      o.GetName(),
+		CreatedBy: o.GetCreatedBy(),
+		CreatedAt: o.GetCreatedAt(),
+	}
+}
+
+func MapOrganizationMember(m *userpb.OrganizationMember) *schemas.OrganizationMember {
+	if m == nil {
+		return nil
+	}
+	return &schemas.OrganizationMember{
+		UserID:   m.GetUserId(),
+		Role:     schemas.OrganizationRole(m.GetRole()),
+		JoinedAt: m.GetJoinedAt(),
+	}
+}
+
+func MapOrganizationMembership(m *userpb.OrganizationMembership) *schemas.OrganizationMembership {
+	if m == nil {
+		return nil
+	}
+	return &schemas.OrganizationMembership{
+		Organization: MapOrganization(m.GetOrganization()),
+		Role:         schemas.OrganizationRole(m.GetRole()),
+	}
+}
+
+// MapUserProfile keeps the public profile fields; locale, timezone and currency stay private
+func MapUserProfile(c *userpb.UserCard) *schemas.UserProfile {
+	if c == nil {
+		return nil
+	}
+	p := c.GetProfile()
+	return &schemas.UserProfile{
+		UserID:            c.GetUser().GetId(),
+		Username:          StrPtrOrNil(p.GetUsername()),
+		DisplayName:       StrPtrOrNil(p.GetDisplayName()),
+		AvatarURL:         StrPtrOrNil(p.GetAvatarUrl()),
+		BannerURL:         StrPtrOrNil(p.GetBannerUrl()),
+		Bio:               StrPtrOrNil(p.GetBio()),
+		NftAvatar:         MapNftAvatar(p.GetNftAvatar()),
+		VerifiedNftAvatar: p.GetNftAvatar().GetVerified(),
+		Stats:             MapProfileStats(p.GetStats()),
+	}
+}
+
+func MapProfileStats(st *userpb.ProfileStats) *schemas.ProfileStats {
+	volume := st.GetVolumeWei()
+	if volume == "" {
+		volume = "0"
+	}
+	return &schemas.ProfileStats{
+		CollectionsCreated: int(st.GetCollectionsCreated()),
+		ItemsOwned:         int(st.GetItemsOwned()),
+		Followers:          int(st.GetFollowers()),
+		VolumeWei:          volume,
+	}
+}
+
+func MapNftAvatar(a *userpb.NftAvatar) *schemas.NftAvatar {
+	if a == nil {
+		return nil
+	}
+	return &schemas.NftAvatar{
+		ChainID:    a.GetChainId(),
+		Contract:   a.GetContract(),
+		TokenID:    a.GetTokenId(),
+		Verified:   a.GetVerified(),
+		VerifiedAt: StrPtrOrNil(a.GetVerifiedAt()),
+		CheckedAt:  a.GetCheckedAt(),
+	}
+}
+
+func MapUserRelationship(r *userpb.Relationship) *schemas.UserRelationship {
+	if r == nil {
+		return nil
+	}
+	return &schemas.UserRelationship{
+		UserID:    r.GetTargetId(),
+		Kind:      schemas.RelationshipKind(r.GetKind()),
+		CreatedAt: r.GetCreatedAt(),
+	}
+}
+
+func MapLinkedWallet(w *walletpb.WalletLink) *schemas.LinkedWallet {
+	if w == nil {
+		return nil
+	}
+	out := &schemas.LinkedWallet{
+		ID:          w.GetId(),
+		Address:     w.GetAddress(),
+		ChainID:     w.GetChainId(),
+		IsPrimary:   w.GetIsPrimary(),
+		IsWatchOnly: w.GetIsWatchOnly(),
+		Label:       StrPtrOrNil(w.GetLabel()),
+		Tags:        append([]string{}, w.GetTags()...),
+		CreatedAt:   w.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if w.GetVerifiedAt() != nil {
+		verifiedAt := w.GetVerifiedAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		out.VerifiedAt = &verifiedAt
+	}
+	if w.GetLastSeenAt() != nil {
+		lastSeenAt := w.GetLastSeenAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		out.LastSeenAt = &lastSeenAt
+	}
+	return out
+}
+
+func MapAddressChallenge(c *walletpb.CreateSignatureChallengeResponse) *schemas.AddressChallenge {
+	if c == nil {
+		return nil
+	}
+	out := &schemas.AddressChallenge{
+		ID:        c.GetChallengeId(),
+		Message:   c.GetMessage(),
+		Scheme:    schemas.SignatureSchemeEip191,
+		ExpiresAt: c.GetExpiresAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if c.GetScheme() == walletpb.SignatureScheme_SIGNATURE_SCHEME_EIP712 {
+		out.Scheme = schemas.SignatureSchemeEip712
+	}
+	return out
+}
+
+func MapEarningsTotal(t *catalogpb.EarningsTotal) *schemas.EarningsTotal {
+	if t == nil {
+		return nil
+	}
+	return &schemas.EarningsTotal{
+		ChainID:        t.GetChainId(),
+		Contract:       t.GetContractAddress(),
+		CollectionName: StrPtrOrNil(t.GetCollectionName()),
+		Currency:       t.GetCurrency(),
+		Amount:         t.GetAmount(),
+		Sales:          int(t.GetSaleCount()),
+	}
+}
+
+// MapWalletActivity maps a catalog transfer or sale; chain ids are shown in CAIP-2 form
+// like intents
+func MapWalletActivity(a *catalogpb.WalletActivity) *schemas.WalletActivity {
+	if a == nil {
+		return nil
+	}
+	return &schemas.WalletActivity{
+		ID:         a.GetId(),
+		Kind:       schemas.WalletActivityKind(a.GetKind()),
+		ChainID:    strings.Replace(a.GetChainId(), "-", ":", 1),
+		Contract:   StrPtrOrNil(a.GetContractAddress()),
+		TokenID:    StrPtrOrNil(a.GetTokenId()),
+		From:       StrPtrOrNil(a.GetFromAddress()),
+		To:         StrPtrOrNil(a.GetToAddress()),
+		Quantity:   StrPtrOrNil(a.GetQuantity()),
+		Price:      StrPtrOrNil(a.GetPrice()),
+		Currency:   StrPtrOrNil(a.GetCurrency()),
+		TxHash:     StrPtrOrNil(a.GetTxHash()),
+		OccurredAt: a.GetOccurredAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// MapCatalogSuggestion maps a collection or token suggestion, with its id built from the
+// CAIP-2 chain id
+func MapCatalogSuggestion(s *catalogpb.Suggestion) *schemas.Suggestion {
+	if s == nil {
+		return nil
+	}
+	chainID := strings.Replace(s.GetChainId(), "-", ":", 1)
+	out := &schemas.Suggestion{
+		Kind:         schemas.SuggestionKind(s.GetKind()),
+		ID:           s.GetKind() + ":" + chainID + ":" + s.GetContractAddress(),
+		Label:        s.GetLabel(),
+		Sublabel:     StrPtrOrNil(s.GetCollectionName()),
+		ThumbnailURL: StrPtrOrNil(s.GetImageUrl()),
+		ChainID:      &chainID,
+		Contract:     StrPtrOrNil(s.GetContractAddress()),
+		TokenID:      StrPtrOrNil(s.GetTokenId()),
+		Slug:         StrPtrOrNil(s.GetSlug()),
+	}
+	if s.GetTokenId() != "" {
+		out.ID += ":" + s.GetTokenId()
+	}
+	return out
+}
+
+// MapUserSuggestion labels a user by username, falling back to the display name
+func MapUserSuggestion(u *userpb.UserSuggestion) *schemas.Suggestion {
+	if u == nil {
+		return nil
+	}
+	out := &schemas.Suggestion{
+		Kind:         schemas.SuggestionKindUser,
+		ID:           "user:" + u.GetUserId(),
+		Label:        u.GetUsername(),
+		Sublabel:     StrPtrOrNil(u.GetDisplayName()),
+		ThumbnailURL: StrPtrOrNil(u.GetAvatarUrl()),
+		Username:     StrPtrOrNil(u.GetUsername()),
+	}
+	if out.Label == "" {
+		out.Label, out.Sublabel = u.GetDisplayName(), nil
+	}
+	return out
+}
+
+// SetIntentStatusDetails fills in why a failed intent failed and what to offer the signer
+// of a stalled one; both are left out for any other status
+func SetIntentStatusDetails(p *schemas.IntentStatusPayload, errMsg, recovery string) {
+	switch p.Status {
+	case schemas.IntentStatusFailed:
+		p.Error = StrPtrOrNil(errMsg)
+	case schemas.IntentStatusStalled:
+		if r := schemas.IntentRecovery(recovery); r.IsValid() {
+			p.Recovery = &r
+		}
+	}
+}
+
+// intentActivityStatus names intent statuses the way the activity tab shows them
+var intentActivityStatus = map[string]string{
+	"pending": "prepared",
+	"ready":   "confirmed",
+	"failed":  "failed",
+	"expired": "expired",
+}
+
+// MapIntentActivity maps an orchestrator intent onto the wallet activity timeline
+func MapIntentActivity(it *orchestratorpb.Intent) *schemas.WalletActivity {
+	if it == nil {
+		return nil
+	}
+	status, ok := intentActivityStatus[it.GetStatus()]
+	if !ok {
+		status = it.GetStatus()
+	}
+	id := it.GetIntentId()
+	kind := it.GetKind()
+	return &schemas.WalletActivity{
+		ID:           "intent:" + id,
+		Kind:         schemas.WalletActivityKindIntent,
+		ChainID:      it.GetChainId(),
+		Contract:     StrPtrOrNil(it.GetContractAddress()),
+		From:         StrPtrOrNil(it.GetSigner()),
+		TxHash:       StrPtrOrNil(it.GetTxHash()),
+		IntentID:     &id,
+		IntentKind:   &kind,
+		IntentStatus: &status,
+		OccurredAt:   it.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// MapImportedCollection maps a collection imported from an existing contract
+func MapImportedCollection(c *orchestratorpb.ImportCollectionResponse) *schemas.ImportedCollection {
+	if c == nil {
+		return nil
+	}
+	return &schemas.ImportedCollection{
+		ChainID:    c.GetChainId(),
+		Address:    c.GetContract(),
+		Standard:   c.GetStandard(),
+		Owner:      c.GetOwner(),
+		Name:       c.GetName(),
+		Symbol:     c.GetSymbol(),
+		StartBlock: strconv.FormatUint(c.GetStartBlock(), 10),
+	}
+}
+
+// MapCollectionDefaults maps a chain's collection form bounds and starting values
+func MapCollectionDefaults(d *orchestratorpb.GetCollectionDefaultsResponse) *schemas.CollectionDefaults {
+	if d == nil {
+		return nil
+	}
+	c := d.GetConstraints()
+	supportedTypes := d.GetSupportedTypes()
+	if supportedTypes == nil {
+		supportedTypes = []string{}
+	}
+	return &schemas.CollectionDefaults{
+		ChainID: d.GetChainId(),
+		Constraints: &schemas.CollectionConstraints{
+			MaxRoyaltyBps:       strconv.FormatUint(c.GetMaxRoyaltyBps(), 10),
+			MinStageDurationSec: strconv.FormatUint(c.GetMinStageDurationSec(), 10),
+			MaxSupplyCap:        strconv.FormatUint(c.GetMaxSupplyCap(), 10),
+		},
+		RoyaltyFee:             strconv.FormatUint(d.GetRoyaltyFee(), 10),
+		MaxSupply:              strconv.FormatUint(d.GetMaxSupply(), 10),
+		MintLimitPerWallet:     strconv.FormatUint(d.GetMintLimitPerWallet(), 10),
+		AllowlistStageDuration: strconv.FormatUint(d.GetAllowlistStageDuration(), 10),
+		SupportedTypes:         supportedTypes,
+	}
+}
+
+func MapWatchlistItem(w *catalogpb.WatchlistItem) *schemas.WatchlistItem {
+	if w == nil {
+		return nil
+	}
+	out := &schemas.WatchlistItem{
+		ID:              w.GetId(),
+		TargetType:      schemas.WatchTargetType(w.GetTargetType()),
+		ChainID:         w.GetChainId(),
+		Contract:        w.GetContractAddress(),
+		TokenID:         StrPtrOrNil(w.GetTokenId()),
+		CollectionName:  StrPtrOrNil(w.GetCollectionName()),
+		FloorAtAdd:      w.GetFloorAtAdd(),
+		CurrentFloor:    w.GetCurrentFloor(),
+		FloorDelta:      w.GetFloorDelta(),
+		FloorAlertBelow: StrPtrOrNil(w.GetFloorAlertBelow()),
+		CreatedAt:       w.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if w.GetAlertFiredAt() != nil {
+		firedAt := w.GetAlertFiredAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		out.AlertFiredAt = &firedAt
+	}
+	return out
+}
+
+func MapSavedSearch(s *catalogpb.SavedSearch) *schemas.SavedSearch {
+	if s == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(s.GetFilters()))
+	for key := range s.GetFilters() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	filters := make([]*schemas.SearchFilter, 0, len(keys))
+	for _, key := range keys {
+		filters = append(filters, &schemas.SearchFilter{Key: key, Value: s.GetFilters()[key]})
+	}
+	return &schemas.SavedSearch{
+		ID:        s.GetId(),
+		Name:      s.GetName(),
+		Query:     s.GetQuery(),
+		Filters:   filters,
+		CreatedAt: s.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// MapIntentStatus maps an intent's current status
+func MapIntentStatus(s *orchestratorpb.GetIntentStatusResponse) *schemas.IntentStatusPayload {
+	if s == nil {
+		return nil
+	}
+	p := &schemas.IntentStatusPayload{
+		IntentID:        s.GetIntentId(),
+		Kind:            s.GetKind(),
+		Status:          schemas.IntentStatus(s.GetStatus()),
+		ChainID:         StrPtrOrNil(s.GetChainId()),
+		TxHash:          StrPtrOrNil(s.GetTxHash()),
+		ContractAddress: StrPtrOrNil(s.GetContractAddress()),
+	}
+	SetIntentStatusDetails(p, s.GetError(), s.GetRecovery())
+	if s.GetUpdatedAt() != nil {
+		updatedAt := s.GetUpdatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		p.UpdatedAt = &updatedAt
+		// The orchestrator reads the primary it writes to
+		staleness := 0
+		p.StalenessMs = &staleness
+	}
+	return p
+}
+
+func mapAirdropRecipients(recipients []*orchestratorpb.AirdropRecipient) []*schemas.AirdropRecipient {
+	out := make([]*schemas.AirdropRecipient, 0, len(recipients))
+	for _, r := range recipients {
+		out = append(out, &schemas.AirdropRecipient{Address: r.GetAddress(), Amount: int(r.GetAmount())})
+	}
+	return out
+}
+
+// MapAirdropBundle maps the batches of a prepared airdrop, in the order to send them
+func MapAirdropBundle(b *orchestratorpb.PrepareAirdropResponse) *schemas.AirdropBundle {
+	if b == nil {
+		return nil
+	}
+	batches := make([]*schemas.AirdropBatch, 0, len(b.GetBatches()))
+	for _, batch := range b.GetBatches() {
+		tx := batch.GetTx()
+		batches = append(batches, &schemas.AirdropBatch{
+			IntentID:   batch.GetIntentId(),
+			Seq:        int(batch.GetSeq()),
+			Recipients: mapAirdropRecipients(batch.GetRecipients()),
+			GasLimit:   strconv.FormatUint(batch.GetGasLimit(), 10),
+			TxRequest: &schemas.TxRequest{
+				To:             tx.GetTo(),
+				Data:           string(tx.GetData()),
+				Value:          tx.GetValue(),
+				PreviewAddress: StrPtrOrNil(tx.GetPreviewAddress()),
+			},
+		})
+	}
+	return &schemas.AirdropBundle{
+		BundleID:       b.GetBundleId(),
+		ChainID:        b.GetChainId(),
+		Contract:       b.GetContract(),
+		RecipientCount: int(b.GetRecipientCount()),
+		Batches:        batches,
+	}
+}
+
+// MapAirdropProgress maps the batch statuses of an airdrop bundle
+func MapAirdropProgress(p *orchestratorpb.GetAirdropProgressResponse) *schemas.AirdropProgress {
+	if p == nil {
+		return nil
+	}
+	batches := make([]*schemas.IntentStatusPayload, 0, len(p.GetBatches()))
+	for _, batch := range p.GetBatches() {
+		batches = append(batches, MapIntentStatus(batch))
+	}
+	return &schemas.AirdropProgress{
+		BundleID:       p.GetBundleId(),
+		ChainID:        p.GetChainId(),
+		Contract:       p.GetContract(),
+		Status:         schemas.IntentStatus(p.GetStatus()),
+		RecipientCount: int(p.GetRecipientCount()),
+		BatchCount:     int(p.GetBatchCount()),
+		Confirmed:      int(p.GetConfirmed()),
+		Failed:         int(p.GetFailed()),
+		Batches:        batches,
+	}
+}
+
+// MapBulkTransferBundle maps the approvals and batches of a prepared bulk transfer
+func MapBulkTransferBundle(b *orchestratorpb.PrepareBulkTransferResponse) *schemas.BulkTransferBundle {
+	if b == nil {
+		return nil
+	}
+	approvals := make([]*schemas.BulkTransferApproval, 0, len(b.GetApprovals()))
+	for _, approval := range b.GetApprovals() {
+		approvals = append(approvals, &schemas.BulkTransferApproval{
+			Contract:  approval.GetContract(),
+			Check:     mapTxRequest(approval.GetCheck()),
+			TxRequest: mapTxRequest(approval.GetTx()),
+		})
+	}
+	batches := make([]*schemas.BulkTransferBatch, 0, len(b.GetBatches()))
+	for _, batch := range b.GetBatches() {
+		tokens := make([]*schemas.BulkTransferToken, 0, len(batch.GetTokens()))
+		for _, t := range batch.GetTokens() {
+			tokens = append(tokens, &schemas.BulkTransferToken{TokenID: t.GetTokenId(), Amount: int(t.GetAmount())})
+		}
+		batches = append(batches, &schemas.BulkTransferBatch{
+			IntentID:  batch.GetIntentId(),
+			Seq:       int(batch.GetSeq()),
+			Contract:  batch.GetContract(),
+			Standard:  batch.GetStandard(),
+			To:        batch.GetTo(),
+			Tokens:    tokens,
+			TxRequest: mapTxRequest(batch.GetTx()),
+		})
+	}
+	return &schemas.BulkTransferBundle{
+		BundleID:   b.GetBundleId(),
+		ChainID:    b.GetChainId(),
+		From:       b.GetFrom(),
+		Signer:     b.GetSigner(),
+		TokenCount: int(b.GetTokenCount()),
+		Approvals:  approvals,
+		Batches:    batches,
+	}
+}
+
+// MapBulkTransferProgress maps the batch statuses of a bulk transfer bundle
+func MapBulkTransferProgress(p *orchestratorpb.GetBulkTransferProgressResponse) *schemas.BulkTransferProgress {
+	if p == nil {
+		return nil
+	}
+	batches := make([]*schemas.IntentStatusPayload, 0, len(p.GetBatches()))
+	for _, batch := range p.GetBatches() {
+		batches = append(batches, MapIntentStatus(batch))
+	}
+	return &schemas.BulkTransferProgress{
+		BundleID:       p.GetBundleId(),
+		ChainID:        p.GetChainId(),
+		Status:         schemas.IntentStatus(p.GetStatus()),
+		RecipientCount: int(p.GetRecipientCount()),
+		BatchCount:     int(p.GetBatchCount()),
+		Confirmed:      int(p.GetConfirmed()),
+		Failed:         int(p.GetFailed()),
+		Batches:        batches,
+	}
+}
+
+func mapTxRequest(tx *orchestratorpb.TxRequest) *schemas.TxRequest {
+	return &schemas.TxRequest{
+		To:             tx.GetTo(),
+		Data:           string(tx.GetData()),
+		Value:          tx.GetValue(),
+		PreviewAddress: StrPtrOrNil(tx.GetPreviewAddress()),
+	}
+}
+
+func MapCallTargetOverride(o *orchestratorpb.CallTargetOverride) *schemas.CallTargetOverride {
+	return &schemas.CallTargetOverride{
+		ChainID:   o.GetChainId(),
+		Address:   o.GetAddress(),
+		Reason:    o.GetReason(),
+		GrantedBy: o.GetGrantedBy(),
+		CreatedAt: o.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func MapSponsorshipBudget(b *orchestratorpb.SponsorshipBudget) *schemas.SponsorshipBudget {
+	return &schemas.SponsorshipBudget{
+		ChainID:      b.GetChainId(),
+		BalanceGwei:  strconv.FormatInt(b.GetBalanceGwei(), 10),
+		ToppedUpGwei: strconv.FormatInt(b.GetToppedUpGwei(), 10),
+		SpentGwei:    strconv.FormatInt(b.GetSpentGwei(), 10),
+		Frozen:       b.GetFrozen(),
+		FrozenReason: StrPtrOrNil(b.GetFrozenReason()),
+		UpdatedBy:    StrPtrOrNil(b.GetUpdatedBy()),
+		UpdatedAt:    b.GetUpdatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// MapUpcomingDrop maps a calendar drop with its countdown as of now
+func MapUpcomingDrop(d *catalogpb.UpcomingDrop, now time.Time) *schemas.UpcomingDrop {
+	if d == nil {
+		return nil
+	}
+	startsAt := d.GetStartsAt().AsTime()
+	out := &schemas.UpcomingDrop{
+		ID:          d.GetId(),
+		Source:      schemas.DropSource(d.GetSource()),
+		ChainID:     d.GetChainId(),
+		Contract:    StrPtrOrNil(d.GetContractAddress()),
+		Name:        d.GetName(),
+		Description: StrPtrOrNil(d.GetDescription()),
+		ImageURL:    StrPtrOrNil(d.GetImageUrl()),
+		ExternalURL: StrPtrOrNil(d.GetExternalUrl()),
+		Slug:        StrPtrOrNil(d.GetSlug()),
+		MintPrice:   StrPtrOrNil(d.GetMintPrice()),
+		IsVerified:  d.GetIsVerified(),
+		StartsAt:    startsAt.Format("2006-01-02T15:04:05Z07:00"),
+		Status:      schemas.DropStatusUpcoming,
+	}
+	if d.GetEndsAt() != nil {
+		endsAt := d.GetEndsAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		out.EndsAt = &endsAt
+	}
+	if startsAt.After(now) {
+		out.StartsInSeconds = int(math.Ceil(startsAt.Sub(now).Seconds()))
+	} else {
+		out.Status = schemas.DropStatusLive
+	}
+	return out
+}
+
+// MapDropSubmission maps a drop submission for its submitter or reviewing admin
+func MapDropSubmission(s *catalogpb.DropSubmission) *schemas.DropSubmission {
+	if s == nil {
+		return nil
+	}
+	out := &schemas.DropSubmission{
+		ID:          s.GetId(),
+		ChainID:     s.GetChainId(),
+		Contract:    StrPtrOrNil(s.GetContractAddress()),
+		Name:        s.GetName(),
+		Description: StrPtrOrNil(s.GetDescription()),
+		ImageURL:    StrPtrOrNil(s.GetImageUrl()),
+		ExternalURL: s.GetExternalUrl(),
+		MintPrice:   StrPtrOrNil(s.GetMintPrice()),
+		StartsAt:    s.GetStartsAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		Status:      schemas.DropSubmissionStatus(s.GetStatus()),
+		ReviewNote:  StrPtrOrNil(s.GetReviewNote()),
+		CreatedAt:   s.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if s.GetEndsAt() != nil {
+		endsAt := s.GetEndsAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+		out.EndsAt = &endsAt
+	}
+	return out
+}
+
+// MapMintStats converts catalog mint stats; catalog windows ("15m", "1h", "24h") map to the
+// schema's last15m, last1h and last24h
+func MapMintStats(s *catalogpb.GetMintStatsResponse) *schemas.MintStats {
+	if s == nil {
+		return nil
+	}
+	out := &schemas.MintStats{
+		ChainID:       s.GetChainId(),
+		Contract:      s.GetContractAddress(),
+		Window:        schemas.MintStatsWindow("last" + s.GetWindow()),
+		Since:         s.GetSince().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+		Mints:         s.GetMints(),
+		UniqueMinters: int(s.GetUniqueMinters()),
+		Revenue:       s.GetRevenue(),
+		Buckets:       make([]*schemas.MintStatsBucket, 0, len(s.GetBuckets())),
+	}
+	for _, b := range s.GetBuckets() {
+		out.Buckets = append(out.Buckets, &schemas.MintStatsBucket{
+			Minute:        b.GetMinute().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+			Mints:         b.GetMints(),
+			UniqueMinters: int(b.GetUniqueMinters()),
+			Revenue:       b.GetRevenue(),
+		})
+	}
+	return out
+}
+
+func MapAPIKey(k *authpb.ApiKey) *schemas.APIKey {
+	return &schemas.APIKey{
+		ID:         k.GetId(),
+		Name:       k.GetName(),
+		Prefix:     k.GetPrefix(),
+		Plan:       schemas.APIPlan(k.GetPlan()),
+		CreatedAt:  k.GetCreatedAt(),
+		LastUsedAt: StrPtrOrNil(k.GetLastUsedAt()),
+		RevokedAt:  StrPtrOrNil(k.GetRevokedAt()),
+	}
+}
+
+// MapAPIPlanLimits maps plan limits; limits that are off become null
+func MapAPIPlanLimits(l *authpb.ApiPlanLimits) *schemas.APIPlanLimits {
+	limit := func(n int64) *int {
+		if n <= 0 {
+			return nil
+		}
+		v := int(n)
+		return &v
+	}
+	return &schemas.APIPlanLimits{
+		Plan:           schemas.APIPlan(l.GetPlan()),
+		DailyQuota:     limit(l.GetDailyQuota()),
+		BurstPerSecond: limit(l.GetBurstPerSecond()),
+		Enforcement:    schemas.QuotaEnforcement(l.GetEnforcement()),
+	}
+}
+
+func MapAPIKeyUsage(u *authpb.ApiKeyUsage) *schemas.APIKeyUsage {
+	hours := make([]*schemas.APIUsageHour, 0, len(u.GetHours()))
+	for _, h := range u.GetHours() {
+		hours = append(hours, &schemas.APIUsageHour{
+			Hour:     h.GetHour(),
+			Requests: int(h.GetRequests()),
+			Rejected: int(h.GetRejected()),
+		})
+	}
+	return &schemas.APIKeyUsage{
+		APIKey:    MapAPIKey(u.GetKey()),
+		Limits:    MapAPIPlanLimits(u.GetLimits()),
+		UsedToday: int(u.GetUsedToday()),
+		Hours:     hours,
+	}
+}
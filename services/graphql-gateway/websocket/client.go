@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 )
 
 // WebSocketMessage represents a message from the subscription worker
@@ -25,20 +26,40 @@ type WebSocketMessage struct {
 type IntentStatusData struct {
 	IntentID        string      `json:"intent_id"`
 	Status          string      `json:"status"`
+	Error           string      `json:"error,omitempty"`
 	ChainID         string      `json:"chain_id,omitempty"`
 	TxHash          string      `json:"tx_hash,omitempty"`
 	ContractAddress string      `json:"contract_address,omitempty"`
 	Data            interface{} `json:"data,omitempty"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+	// StalenessMs bounds how far the status may lag its latest write when the worker read
+	// it from a regional replica; nil when unknown
+	StalenessMs *int64 `json:"staleness_ms,omitempty"`
 }
 
 // SubscriptionCallback is called when a message is received for a subscribed intent
 type SubscriptionCallback func(intentID string, data *IntentStatusData) error
 
+// AccountEventCallback is called for each account event of a followed user
+type AccountEventCallback func(event *contracts.AccountEvent)
+
+// UploadProgressCallback is called for each progress report of a followed upload
+type UploadProgressCallback func(progress *contracts.UploadProgress)
+
+// MintStatsCallback is called for each mint stats update of a followed collection
+type MintStatsCallback func(update *contracts.MintStatsUpdate)
+
 // Client manages WebSocket connections to the subscription worker service
 type Client struct {
 	url               string
 	conn              *websocket.Conn
 	subscriptions     map[string][]SubscriptionCallback
+	accountSubs       map[string]map[uint64]AccountEventCallback // keyed by user topic, then subscriber
+	nextAccountSubID  uint64
+	uploadSubs        map[string]map[uint64]UploadProgressCallback // keyed by upload topic, then subscriber
+	nextUploadSubID   uint64
+	mintStatsSubs     map[string]map[uint64]MintStatsCallback // keyed by mint stats topic, then subscriber
+	nextMintStatsID   uint64
 	mu                sync.RWMutex
 	reconnectInterval time.Duration
 	maxReconnectDelay time.Duration
@@ -55,6 +76,9 @@ func NewClient(subscriptionWorkerURL string) *Client {
 	return &Client{
 		url:               subscriptionWorkerURL,
 		subscriptions:     make(map[string][]SubscriptionCallback),
+		accountSubs:       make(map[string]map[uint64]AccountEventCallback),
+		uploadSubs:        make(map[string]map[uint64]UploadProgressCallback),
+		mintStatsSubs:     make(map[string]map[uint64]MintStatsCallback),
 		reconnectInterval: 5 * time.Second,
 		maxReconnectDelay: 60 * time.Second,
 		reconnectDelay:    1 * time.Second,
@@ -149,6 +173,132 @@ func (c *Client) Unsubscribe(intentID string) error {
 	return nil
 }
 
+// SubscribeAccountEvents follows a user's account events until the returned cancel func is
+// called. Each GraphQL subscription gets its own callback; the worker topic is subscribed
+// once per user and released with the last callback.
+func (c *Client) SubscribeAccountEvents(userID string, callback AccountEventCallback) (func(), error) {
+	topic := userTopic(userID)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.nextAccountSubID++
+	id := c.nextAccountSubID
+	if c.accountSubs[topic] == nil {
+		c.accountSubs[topic] = make(map[uint64]AccountEventCallback)
+		if err := c.sendTopicMessageLocked("subscribe", topic); err != nil {
+			delete(c.accountSubs, topic)
+			return nil, err
+		}
+	}
+	c.accountSubs[topic][id] = callback
+
+	cancel := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.accountSubs[topic], id)
+		if len(c.accountSubs[topic]) == 0 {
+			delete(c.accountSubs, topic)
+			if err := c.sendTopicMessageLocked("unsubscribe", topic); err != nil {
+				log.Printf("Failed to release account events for user %s: %v", userID, err)
+			}
+		}
+	}
+	return cancel, nil
+}
+
+// SubscribeUploadProgress follows the progress of the upload started with ticket until
+// the returned cancel func is called
+func (c *Client) SubscribeUploadProgress(ticket string, callback UploadProgressCallback) (func(), error) {
+	topic := contracts.UploadTopic(ticket)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.nextUploadSubID++
+	id := c.nextUploadSubID
+	if c.uploadSubs[topic] == nil {
+		c.uploadSubs[topic] = make(map[uint64]UploadProgressCallback)
+		if err := c.sendTopicMessageLocked("subscribe", topic); err != nil {
+			delete(c.uploadSubs, topic)
+			return nil, err
+		}
+	}
+	c.uploadSubs[topic][id] = callback
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			delete(c.uploadSubs[topic], id)
+			if len(c.uploadSubs[topic]) == 0 {
+				delete(c.uploadSubs, topic)
+				if err := c.sendTopicMessageLocked("unsubscribe", topic); err != nil {
+					log.Printf("Failed to release progress of upload %s: %v", ticket, err)
+				}
+			}
+		})
+	}
+	return cancel, nil
+}
+
+// SubscribeMintStats follows the mint stats updates of a collection until the returned
+// cancel func is called
+func (c *Client) SubscribeMintStats(chainID, contract string, callback MintStatsCallback) (func(), error) {
+	topic := contracts.MintStatsTopic(chainID, contract)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.nextMintStatsID++
+	id := c.nextMintStatsID
+	if c.mintStatsSubs[topic] == nil {
+		c.mintStatsSubs[topic] = make(map[uint64]MintStatsCallback)
+		if err := c.sendTopicMessageLocked("subscribe", topic); err != nil {
+			delete(c.mintStatsSubs, topic)
+			return nil, err
+		}
+	}
+	c.mintStatsSubs[topic][id] = callback
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			delete(c.mintStatsSubs[topic], id)
+			if len(c.mintStatsSubs[topic]) == 0 {
+				delete(c.mintStatsSubs, topic)
+				if err := c.sendTopicMessageLocked("unsubscribe", topic); err != nil {
+					log.Printf("Failed to release mint stats of %s: %v", contract, err)
+				}
+			}
+		})
+	}
+	return cancel, nil
+}
+
+// sendTopicMessageLocked sends a subscribe or unsubscribe for topic when connected.
+// Callers hold c.mu; reconnect resubscribes every topic still in use.
+func (c *Client) sendTopicMessageLocked(msgType, topic string) error {
+	if !c.isConnected || c.conn == nil {
+		return nil
+	}
+	return c.conn.WriteJSON(map[string]string{
+		"type":      msgType,
+		"intent_id": topic,
+	})
+}
+
+// userTopic matches the subscription worker's per-user channel key
+func userTopic(userID string) string {
+	return "user:" + userID
+}
+
 // handleMessages processes incoming WebSocket messages
 func (c *Client) handleMessages() {
 	defer func() {
@@ -190,6 +340,12 @@ func (c *Client) processMessage(msg *WebSocketMessage) {
 	switch msg.Type {
 	case "status_update":
 		c.handleStatusUpdate(msg)
+	case "account_event":
+		c.handleAccountEvent(msg)
+	case "upload_progress":
+		c.handleUploadProgress(msg)
+	case "mint_stats":
+		c.handleMintStats(msg)
 	case "subscribed":
 		log.Printf("Subscription confirmed for intent: %s", msg.IntentID)
 	case "unsubscribed":
@@ -234,6 +390,81 @@ func (c *Client) handleStatusUpdate(msg *WebSocketMessage) {
 	}
 }
 
+// handleAccountEvent hands an account event to every callback following its user
+func (c *Client) handleAccountEvent(msg *WebSocketMessage) {
+	dataBytes, err := json.Marshal(msg.Data)
+	if err != nil {
+		log.Printf("Failed to marshal account event for %s: %v", msg.IntentID, err)
+		return
+	}
+	var event contracts.AccountEvent
+	if err := json.Unmarshal(dataBytes, &event); err != nil {
+		log.Printf("Failed to parse account event for %s: %v", msg.IntentID, err)
+		return
+	}
+
+	c.mu.RLock()
+	callbacks := make([]AccountEventCallback, 0, len(c.accountSubs[msg.IntentID]))
+	for _, callback := range c.accountSubs[msg.IntentID] {
+		callbacks = append(callbacks, callback)
+	}
+	c.mu.RUnlock()
+
+	for _, callback := range callbacks {
+		callback(&event)
+	}
+}
+
+// handleUploadProgress hands an upload progress report to every callback following its ticket
+func (c *Client) handleUploadProgress(msg *WebSocketMessage) {
+	dataBytes, err := json.Marshal(msg.Data)
+	if err != nil {
+		log.Printf("Failed to marshal upload progress for %s: %v", msg.IntentID, err)
+		return
+	}
+	var progress contracts.UploadProgress
+	if err := json.Unmarshal(dataBytes, &progress); err != nil {
+		log.Printf("Failed to parse upload progress for %s: %v", msg.IntentID, err)
+		return
+	}
+
+	c.mu.RLock()
+	callbacks := make([]UploadProgressCallback, 0, len(c.uploadSubs[msg.IntentID]))
+	for _, callback := range c.uploadSubs[msg.IntentID] {
+		callbacks = append(callbacks, callback)
+	}
+	c.mu.RUnlock()
+
+	for _, callback := range callbacks {
+		callback(&progress)
+	}
+}
+
+// handleMintStats hands a mint stats update to every callback following its collection
+func (c *Client) handleMintStats(msg *WebSocketMessage) {
+	dataBytes, err := json.Marshal(msg.Data)
+	if err != nil {
+		log.Printf("Failed to marshal mint stats for %s: %v", msg.IntentID, err)
+		return
+	}
+	var update contracts.MintStatsUpdate
+	if err := json.Unmarshal(dataBytes, &update); err != nil {
+		log.Printf("Failed to parse mint stats for %s: %v", msg.IntentID, err)
+		return
+	}
+
+	c.mu.RLock()
+	callbacks := make([]MintStatsCallback, 0, len(c.mintStatsSubs[msg.IntentID]))
+	for _, callback := range c.mintStatsSubs[msg.IntentID] {
+		callbacks = append(callbacks, callback)
+	}
+	c.mu.RUnlock()
+
+	for _, callback := range callbacks {
+		callback(&update)
+	}
+}
+
 // maintainConnection handles reconnection logic
 func (c *Client) maintainConnection() {
 	ticker := time.NewTicker(30 * time.Second)
@@ -318,6 +549,15 @@ func (c *Client) reconnect() {
 	for intentID := range c.subscriptions {
 		subscriptions[intentID] = true
 	}
+	for topic := range c.accountSubs {
+		subscriptions[topic] = true
+	}
+	for topic := range c.uploadSubs {
+		subscriptions[topic] = true
+	}
+	for topic := range c.mintStatsSubs {
+		subscriptions[topic] = true
+	}
 	c.mu.RUnlock()
 
 	for intentID := range subscriptions {
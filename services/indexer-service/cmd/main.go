@@ -3,24 +3,37 @@ package main
 import (
 	"context"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/config"
-	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/events"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/httpapi"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/registry"
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/repository"
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/mongo"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
 	cfg := config.NewConfig()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("indexer-service"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -61,26 +74,67 @@ func main() {
 
 	// Initialize event publisher
 	publisher := events.NewEventPublisher(amqpClient)
+	// Collection and token events carry per-aggregate sequences so consumers apply them in order
+	publisher.SetSequencer(repository.NewSequenceRepository(postgresClient))
 
-	// Initialize blockchain clients
-	blockchainClients := make(map[string]*blockchain.Client)
-	for chainID, rpcURL := range cfg.ChainRPCs {
-		client, err := blockchain.NewClient(chainID, rpcURL, cfg.ConfirmationBlocks)
-		if err != nil {
-			log.Fatalf("Failed to create blockchain client for chain %s: %v", chainID, err)
-		}
-		blockchainClients[chainID] = client
+	// Chain heads go out on their own exchange, which no consumer may have declared yet
+	if err := amqpClient.DeclareExchange(messaging.ExchangeConfig{Name: contracts.ChainsExchange, Type: "topic", Durable: true}); err != nil {
+		log.Fatalf("Failed to declare %s exchange: %v", contracts.ChainsExchange, err)
 	}
 
+	// RPC endpoints and confirmation params are read from chain-registry-service
+	registryConn, err := grpc.Dial(cfg.ChainRegistryURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to chain-registry-service: %v", err)
+	}
+	defer registryConn.Close()
+	chainSource := registry.NewChainConfigSource(protoChainRegistry.NewChainRegistryServiceClient(registryConn))
+
 	// Initialize indexer service
 	indexerService := service.NewIndexerService(
 		eventRepo,
 		checkpointRepo,
 		publisher,
-		blockchainClients,
+		chainSource,
 		cfg.FactoryContracts,
+		cfg.AuctionContracts,
 		cfg.PollingInterval,
 	)
+	// Events of registry ABIs are decoded alongside the compiled-in decoders
+	indexerService.SetAbiSource(chainSource)
+	// Logs that fail to decode are kept with their raw topics and data for reprocessing
+	indexerService.SetDeadLetters(repository.NewDeadLetterRepository(mongoClient))
+	if err := indexerService.LoadChains(ctx); err != nil {
+		log.Fatalf("Failed to load chains from chain-registry-service: %v", err)
+	}
+
+	// Follow endpoint and param edits in the registry without a restart
+	if err := amqpClient.ConsumeRegistryChanged("indexer.registry.changed", "indexer-service", indexerService.HandleRegistryChanged); err != nil {
+		log.Printf("Failed to start registry.changed consumer: %v", err)
+	}
+
+	// Warn when the registry replaces an ABI with signatures the indexer no longer decodes,
+	// and pick up the events it adds
+	if err := amqpClient.ConsumeAbiChanged("indexer.registry.abi_changed", "indexer-service", indexerService.HandleAbiChanged); err != nil {
+		log.Printf("Failed to start abi_changed consumer: %v", err)
+	}
+
+	if cfg.HTTPPort != "" {
+		httpServer := &http.Server{
+			Addr:              cfg.HTTPPort,
+			Handler:           httpapi.NewHandler(indexerService, cfg.AdminToken),
+			ReadHeaderTimeout: 5 * time.Second,
+			// A reprocess run retries up to service.MaxReprocessBatch logs
+			WriteTimeout: 5 * time.Minute,
+		}
+		go func() {
+			log.Printf("Metrics and admin endpoints listening on %s", cfg.HTTPPort)
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Printf("Metrics and admin endpoints stopped: %v", err)
+			}
+		}()
+		defer httpServer.Close()
+	}
 
 	// Start indexing in a separate goroutine
 	go func() {
@@ -98,6 +152,11 @@ func main() {
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
 
+	// Finish the registry change notifications already received before the connection closes
+	if err := amqpClient.Drain(shutdownCtx); err != nil {
+		log.Printf("Error draining consumers: %v", err)
+	}
+
 	// Stop the indexer service gracefully
 	if err := indexerService.Stop(shutdownCtx); err != nil {
 		log.Printf("Error during shutdown: %v", err)
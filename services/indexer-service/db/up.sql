@@ -1,13 +1,23 @@
 
 CREATE TABLE IF NOT EXISTS indexer_checkpoints (
-    chain_id        text PRIMARY KEY,               -- ví dụ "eip155:1"
-    last_block      bigint NOT NULL,                -- block height đã xử lý tới
-    last_block_hash text,                           -- hash của block đó
-    updated_at      timestamptz NOT NULL DEFAULT now()
+    chain_id         text NOT NULL,                 -- ví dụ "eip155:1"
+    contract_address text NOT NULL DEFAULT '',      -- '' = checkpoint cấp chain
+    last_block       bigint NOT NULL,               -- block height đã xử lý tới
+    last_block_hash  text,                          -- hash của block đó
+    updated_at       timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (chain_id, contract_address)
 );
 
-COMMENT ON TABLE indexer_checkpoints IS 'Lưu checkpoint của indexer cho mỗi chain (1 row/chain)';
+-- Migration from one row per chain: existing rows keep contract_address = '' and become the
+-- chain-level checkpoint that contracts without their own row start from
+ALTER TABLE indexer_checkpoints ADD COLUMN IF NOT EXISTS contract_address text NOT NULL DEFAULT '';
+ALTER TABLE indexer_checkpoints
+  DROP CONSTRAINT IF EXISTS indexer_checkpoints_pkey,
+  ADD CONSTRAINT indexer_checkpoints_pkey PRIMARY KEY (chain_id, contract_address);
+
+COMMENT ON TABLE indexer_checkpoints IS 'Lưu checkpoint của indexer cho mỗi (chain, contract)';
 COMMENT ON COLUMN indexer_checkpoints.chain_id IS 'CAIP-2 Chain ID (vd: eip155:1)';
+COMMENT ON COLUMN indexer_checkpoints.contract_address IS 'Contract (lowercase); rỗng = checkpoint cấp chain';
 COMMENT ON COLUMN indexer_checkpoints.last_block IS 'Block height cuối cùng đã xử lý';
 COMMENT ON COLUMN indexer_checkpoints.last_block_hash IS 'Hash của block cuối cùng';
 COMMENT ON COLUMN indexer_checkpoints.updated_at IS 'Lần cuối checkpoint được cập nhật';
@@ -17,7 +27,7 @@ COMMENT ON COLUMN indexer_checkpoints.updated_at IS 'Lần cuối checkpoint đ
 -- =========================
 
 -- Truy vấn checkpoint theo chain (đã có qua PRIMARY KEY)
--- PK mặc định sẽ tạo index B-Tree trên chain_id
+-- PK mặc định sẽ tạo index B-Tree trên (chain_id, contract_address)
 
 -- Sắp xếp / monitoring theo block number (ai cao nhất)
 CREATE INDEX IF NOT EXISTS idx_idxcp_last_block
@@ -31,3 +41,23 @@ CREATE INDEX IF NOT EXISTS idx_idxcp_updated_at
 -- (chain_id + last_block + updated_at) → chỉ cần index scan, không phải quay lại bảng
 CREATE INDEX IF NOT EXISTS idx_idxcp_health
     ON indexer_checkpoints(chain_id, updated_at DESC, last_block DESC);
+
+-- =========================
+-- Event sequences
+-- =========================
+
+-- Last sequence stamped per aggregate (collection:<chain>:<contract>, token:<chain>:<contract>:<id>)
+CREATE TABLE IF NOT EXISTS event_sequences (
+    aggregate_id  text PRIMARY KEY,
+    last_sequence bigint NOT NULL,
+    updated_at    timestamptz NOT NULL DEFAULT now()
+);
+
+-- The sequence each published event was stamped with, reused when it is published again
+CREATE TABLE IF NOT EXISTS event_sequence_stamps (
+    event_id     text PRIMARY KEY,
+    aggregate_id text NOT NULL,
+    sequence     bigint NOT NULL,
+    stamped_at   timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (aggregate_id, sequence)
+);
@@ -7,16 +7,19 @@ import (
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/mongo"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 type Config struct {
-	MongoConfig        mongo.MongoConfig
-	PostgresConfig     postgres.PostgresConfig
-	RabbitMQ           messaging.RabbitMQConfig
-	ChainRPCs          map[string]string // chainId -> RPC URL
-	FactoryContracts   map[string]string // chainId -> factory contract address
-	ConfirmationBlocks map[string]int    // chainId -> number of confirmation blocks
-	PollingInterval    time.Duration
+	MongoConfig      mongo.MongoConfig
+	PostgresConfig   postgres.PostgresConfig
+	RabbitMQ         messaging.RabbitMQConfig
+	ChainRegistryURL string            // RPC endpoints and confirmation params come from the registry
+	FactoryContracts map[string]string // chainId -> factory contract address
+	AuctionContracts map[string]string // chainId -> AuctionHouse contract address
+	PollingInterval  time.Duration
+	HTTPPort         string // metrics and admin endpoints; empty disables them
+	AdminToken       string // bearer token of the admin endpoints; empty disables them
 }
 
 func NewConfig() *Config {
@@ -24,6 +27,7 @@ func NewConfig() *Config {
 		MongoConfig: mongo.MongoConfig{
 			MongoURI:      env.GetString("MONGO_URI", "mongodb://localhost:27017"),
 			MongoDatabase: env.GetString("MONGO_DATABASE", "indexer"),
+			Tenant:        tenant.FromEnv(),
 		},
 		PostgresConfig: postgres.PostgresConfig{
 			PostgresHost:     env.GetString("POSTGRES_HOST", "localhost"),
@@ -32,6 +36,7 @@ func NewConfig() *Config {
 			PostgresPassword: env.GetString("POSTGRES_PASSWORD", "password"),
 			PostgresDatabase: env.GetString("POSTGRES_DATABASE", "indexer_db"),
 			PostgresSSLMode:  env.GetString("POSTGRES_SSL_MODE", "disable"),
+			Tenant:           tenant.FromEnv(),
 		},
 		RabbitMQ: messaging.RabbitMQConfig{
 			RabbitMQHost:     env.GetString("RABBITMQ_HOST", "localhost"),
@@ -39,25 +44,23 @@ func NewConfig() *Config {
 			RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
 			RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
 			RabbitMQExchange: env.GetString("RABBITMQ_EXCHANGE", "nft-marketplace"),
+			Tenant:           tenant.FromEnv(),
 		},
-		ChainRPCs: map[string]string{
-			"eip155-1":        env.GetString("ETH_MAINNET_RPC", ""), // Ethereum Mainnet
-			"eip155-11155111": env.GetString("ETH_SEPOLIA_RPC", ""), // Ethereum Sepolia
-			"eip155-137":      env.GetString("POLYGON_RPC", ""),     // Polygon
-			"eip155-80001":    env.GetString("MUMBAI_RPC", ""),      // Polygon Mumbai
-		},
+		ChainRegistryURL: env.GetString("CHAIN_REGISTRY_URL", "localhost:50056"),
 		FactoryContracts: map[string]string{
 			"eip155-1":        env.GetString("ETH_MAINNET_FACTORY", ""),
 			"eip155-11155111": env.GetString("ETH_SEPOLIA_FACTORY", ""),
 			"eip155-137":      env.GetString("POLYGON_FACTORY", ""),
 			"eip155-80001":    env.GetString("MUMBAI_FACTORY", ""),
 		},
-		ConfirmationBlocks: map[string]int{
-			"eip155-1":        env.GetInt("ETH_MAINNET_CONFIRMATIONS", 12),
-			"eip155-11155111": env.GetInt("ETH_SEPOLIA_CONFIRMATIONS", 3),
-			"eip155-137":      env.GetInt("POLYGON_CONFIRMATIONS", 20),
-			"eip155-80001":    env.GetInt("MUMBAI_CONFIRMATIONS", 5),
+		AuctionContracts: map[string]string{
+			"eip155-1":        env.GetString("ETH_MAINNET_AUCTION_HOUSE", ""),
+			"eip155-11155111": env.GetString("ETH_SEPOLIA_AUCTION_HOUSE", ""),
+			"eip155-137":      env.GetString("POLYGON_AUCTION_HOUSE", ""),
+			"eip155-80001":    env.GetString("MUMBAI_AUCTION_HOUSE", ""),
 		},
 		PollingInterval: time.Duration(env.GetInt("POLLING_INTERVAL_SECONDS", 5)) * time.Second,
+		HTTPPort:        env.GetString("INDEXER_HTTP_PORT", ":8087"),
+		AdminToken:      env.GetString("INDEXER_ADMIN_TOKEN", ""),
 	}
 }
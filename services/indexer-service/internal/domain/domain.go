@@ -4,6 +4,8 @@ import (
 	"context"
 	"math/big"
 	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 )
 
 // RawEvent represents a raw blockchain event stored in MongoDB
@@ -20,16 +22,76 @@ type RawEvent struct {
 	RawData         map[string]interface{} `bson:"raw_data" json:"raw_data"`
 	ParsedJSON      string                 `bson:"parsed_json" json:"parsed_json"`
 	Confirmations   int                    `bson:"confirmations" json:"confirmations"`
-	ObservedAt      time.Time              `bson:"observed_at" json:"observed_at"`
-	CreatedAt       time.Time              `bson:"created_at" json:"created_at"`
+	// State is RawEventDecodeFailed for a log whose data could not be decoded, with the
+	// reason in DecodeError; events stored before states existed have none and were decoded
+	State       string `bson:"state" json:"state"`
+	DecodeError string `bson:"decode_error,omitempty" json:"decode_error,omitempty"`
+	// RequiredConfirmations is the registry depth at publish time; not stored
+	RequiredConfirmations int       `bson:"-" json:"required_confirmations,omitempty"`
+	ObservedAt            time.Time `bson:"observed_at" json:"observed_at"`
+	CreatedAt             time.Time `bson:"created_at" json:"created_at"`
+}
+
+// RawEvent states
+const (
+	RawEventDecoded      = "decoded"
+	RawEventDecodeFailed = "decode_failed"
+)
+
+// DeadLetter keeps a log the indexer fetched but could not decode, with its raw topics and
+// data, until it is reprocessed once a decoder fix ships. Its ID is the raw event's.
+type DeadLetter struct {
+	ID              string     `bson:"_id" json:"id"`
+	ChainID         string     `bson:"chain_id" json:"chain_id"`
+	TxHash          string     `bson:"tx_hash" json:"tx_hash"`
+	LogIndex        int        `bson:"log_index" json:"log_index"`
+	BlockNumber     *big.Int   `bson:"block_number" json:"block_number"`
+	BlockHash       string     `bson:"block_hash" json:"block_hash"`
+	ContractAddress string     `bson:"contract_address" json:"contract_address"`
+	EventSignature  string     `bson:"event_signature" json:"event_signature"` // topic 0
+	EventName       string     `bson:"event_name" json:"event_name"`           // empty when no decoder matched
+	Stream          string     `bson:"stream" json:"stream"`                   // the kind of contract it was fetched from
+	Topics          []string   `bson:"topics" json:"topics"`
+	Data            string     `bson:"data" json:"data"`
+	Error           string     `bson:"error" json:"error"`
+	Attempts        int        `bson:"attempts" json:"attempts"`
+	FirstFailedAt   time.Time  `bson:"first_failed_at" json:"first_failed_at"`
+	LastFailedAt    time.Time  `bson:"last_failed_at" json:"last_failed_at"`
+	ReprocessedAt   *time.Time `bson:"reprocessed_at,omitempty" json:"reprocessed_at,omitempty"`
+}
+
+// DeadLetterFilter selects pending dead letters; empty fields match every value
+type DeadLetterFilter struct {
+	ChainID         string `json:"chain_id"`
+	ContractAddress string `json:"contract"`
+	EventSignature  string `json:"event_signature"`
+	Limit           int    `json:"limit"`
 }
 
-// Checkpoint represents the indexing progress for a specific chain
+// DecodeFailureCount counts the decode failures, or pending dead letters, of one event
+// signature on one contract
+type DecodeFailureCount struct {
+	ChainID         string `json:"chain_id"`
+	ContractAddress string `json:"contract"`
+	EventSignature  string `json:"event_signature"`
+	Count           int64  `json:"count"`
+}
+
+// ReprocessResult reports a dead-letter reprocessing run
+type ReprocessResult struct {
+	Reprocessed int `json:"reprocessed"`
+	Failed      int `json:"failed"`
+}
+
+// Checkpoint represents the indexing progress of one contract on a chain. The row with an
+// empty ContractAddress is the chain-level checkpoint kept from before per-contract progress;
+// contracts without their own row start from it.
 type Checkpoint struct {
-	ChainID       string    `db:"chain_id" json:"chain_id"`
-	LastBlock     *big.Int  `db:"last_block" json:"last_block"`
-	LastBlockHash string    `db:"last_block_hash" json:"last_block_hash"`
-	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+	ChainID         string    `db:"chain_id" json:"chain_id"`
+	ContractAddress string    `db:"contract_address" json:"contract_address"`
+	LastBlock       *big.Int  `db:"last_block" json:"last_block"`
+	LastBlockHash   string    `db:"last_block_hash" json:"last_block_hash"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
 }
 
 // CollectionCreatedEvent represents the parsed CollectionCreated event
@@ -44,6 +106,68 @@ type CollectionCreatedEvent struct {
 	RoyaltyPercentage uint16   `json:"royalty_percentage"`
 }
 
+// Collection admin event kinds emitted by deployed collections
+const (
+	CollectionAdminOwnershipTransferred = "ownership_transferred"
+	CollectionAdminRoyaltyUpdated       = "royalty_updated"
+	CollectionAdminBaseURIUpdated       = "base_uri_updated"
+	CollectionAdminPayoutSplitsUpdated  = "payout_splits_updated"
+)
+
+// CollectionAdminEvent represents a parsed owner-only change on a deployed collection
+type CollectionAdminEvent struct {
+	CollectionAddress string        `json:"collection_address"`
+	Kind              string        `json:"kind"`
+	PreviousOwner     string        `json:"previous_owner,omitempty"`
+	NewOwner          string        `json:"new_owner,omitempty"`
+	RoyaltyRecipient  string        `json:"royalty_recipient,omitempty"`
+	RoyaltyFeeBps     uint64        `json:"royalty_fee_bps,omitempty"`
+	BaseURI           string        `json:"base_uri,omitempty"`
+	PayoutSplits      []PayoutSplit `json:"payout_splits,omitempty"`
+}
+
+// PayoutSplit is one recipient's share, in basis points, of a collection's payouts
+type PayoutSplit struct {
+	Recipient string `json:"recipient"`
+	Bps       uint64 `json:"bps"`
+}
+
+// Auction event kinds emitted by the AuctionHouse contract
+const (
+	AuctionCreated   = "created"
+	AuctionBid       = "bid"
+	AuctionSettled   = "settled"
+	AuctionCancelled = "cancelled"
+)
+
+// AuctionEvent represents a parsed AuctionHouse log; amounts are wei
+type AuctionEvent struct {
+	AuctionHouse      string   `json:"auction_house"`
+	Kind              string   `json:"kind"`
+	AuctionID         *big.Int `json:"auction_id"`
+	CollectionAddress string   `json:"collection_address,omitempty"`
+	TokenID           *big.Int `json:"token_id,omitempty"`
+	Seller            string   `json:"seller,omitempty"`
+	AuctionType       string   `json:"auction_type,omitempty"` // "english" | "dutch"
+	StartPrice        *big.Int `json:"start_price,omitempty"`
+	ReservePrice      *big.Int `json:"reserve_price,omitempty"`
+	EndPrice          *big.Int `json:"end_price,omitempty"`
+	StartTime         uint64   `json:"start_time,omitempty"`
+	EndTime           uint64   `json:"end_time,omitempty"`
+	Bidder            string   `json:"bidder,omitempty"`
+	Amount            *big.Int `json:"amount,omitempty"`
+	Winner            string   `json:"winner,omitempty"`
+}
+
+// DecodedEvent is a log decoded through a registered ABI rather than a dedicated parser.
+// Args are keyed by input name; numbers and byte values are rendered as strings.
+type DecodedEvent struct {
+	Contract  string                 `json:"contract"`
+	Name      string                 `json:"name"`
+	Signature string                 `json:"signature"`
+	Args      map[string]interface{} `json:"args"`
+}
+
 // PublishableEvent represents an event ready to be published to RabbitMQ
 type PublishableEvent struct {
 	Schema    string                 `json:"schema"`
@@ -55,12 +179,17 @@ type PublishableEvent struct {
 	Contract  string                 `json:"contract"`
 	Data      map[string]interface{} `json:"data"`
 	Timestamp time.Time              `json:"timestamp"`
+	// AggregateID is the collection or token the event is about; consumers apply an
+	// aggregate's events in Sequence order. Empty leaves the event unsequenced.
+	AggregateID string `json:"aggregate_id,omitempty"`
+	Sequence    uint64 `json:"sequence,omitempty"`
 }
 
 // Repository interfaces
 
 type EventRepository interface {
-	// StoreRawEvent stores a raw blockchain event with deduplication
+	// StoreRawEvent stores a raw blockchain event with deduplication; a decoded event
+	// replaces a stored decode failure of the same log
 	StoreRawEvent(ctx context.Context, event *RawEvent) error
 
 	// GetRawEvent retrieves a raw event by unique key
@@ -68,17 +197,46 @@ type EventRepository interface {
 
 	// GetEventsByBlock retrieves all events for a specific block
 	GetEventsByBlock(ctx context.Context, chainID string, blockNumber *big.Int) ([]*RawEvent, error)
+
+	// ListCollectionAddresses returns the addresses of collections created on a chain
+	ListCollectionAddresses(ctx context.Context, chainID string) ([]string, error)
+
+	// ListPendingEvents returns stored events of eventName still below the required depth
+	ListPendingEvents(ctx context.Context, chainID, eventName string, requiredConfirmations int) ([]*RawEvent, error)
+
+	// UpdateConfirmations records the current depth of a stored event; -1 marks it reorged out
+	UpdateConfirmations(ctx context.Context, chainID, txHash string, logIndex, confirmations int) error
+}
+
+type DeadLetterRepository interface {
+	// RecordDeadLetter stores a decode failure, or counts another attempt of a stored one
+	RecordDeadLetter(ctx context.Context, letter *DeadLetter) error
+
+	// ListDeadLetters returns pending dead letters, oldest first
+	ListDeadLetters(ctx context.Context, filter DeadLetterFilter) ([]*DeadLetter, error)
+
+	// MarkReprocessed takes a dead letter out of the pending set
+	MarkReprocessed(ctx context.Context, id string, at time.Time) error
+
+	// CountPending counts pending dead letters per chain, contract and event signature
+	CountPending(ctx context.Context) ([]DecodeFailureCount, error)
+}
+
+type EventSequencer interface {
+	// NextSequence returns the sequence eventID was stamped with in aggregateID, stamping the
+	// aggregate's next one the first time the event is published
+	NextSequence(ctx context.Context, aggregateID, eventID string) (uint64, error)
 }
 
 type CheckpointRepository interface {
-	// GetCheckpoint retrieves the latest checkpoint for a chain
-	GetCheckpoint(ctx context.Context, chainID string) (*Checkpoint, error)
+	// ListCheckpoints returns every checkpoint of a chain, including the chain-level row
+	ListCheckpoints(ctx context.Context, chainID string) ([]*Checkpoint, error)
 
-	// UpdateCheckpoint updates the checkpoint for a chain
-	UpdateCheckpoint(ctx context.Context, checkpoint *Checkpoint) error
+	// UpdateCheckpoints writes the checkpoints of the contracts a block range covered, atomically
+	UpdateCheckpoints(ctx context.Context, checkpoints []*Checkpoint) error
 
-	// CreateCheckpoint creates a new checkpoint for a chain
-	CreateCheckpoint(ctx context.Context, checkpoint *Checkpoint) error
+	// SeedCheckpoint starts a contract at a block unless it already has a checkpoint
+	SeedCheckpoint(ctx context.Context, checkpoint *Checkpoint) error
 
 	// HealthCheck performs a health check on the repository
 	HealthCheck(ctx context.Context) error
@@ -92,6 +250,42 @@ type EventPublisher interface {
 
 	// PublishCollectionCreatedEvent publishes a CollectionCreated event
 	PublishCollectionCreatedEvent(ctx context.Context, chainID string, rawEvent *RawEvent, collectionEvent *CollectionCreatedEvent) error
+
+	// PublishCollectionConfirmationsEvent publishes the new depth of a collection deployment,
+	// or that its block was reorged out
+	PublishCollectionConfirmationsEvent(ctx context.Context, chainID string, rawEvent *RawEvent, collectionAddress string, reorged bool) error
+
+	// PublishCollectionUpdatedEvent publishes an ownership, royalty or base URI change
+	PublishCollectionUpdatedEvent(ctx context.Context, chainID string, rawEvent *RawEvent, adminEvent *CollectionAdminEvent) error
+
+	// PublishAuctionEvent publishes an auction creation, bid, settlement or cancellation
+	PublishAuctionEvent(ctx context.Context, chainID string, rawEvent *RawEvent, auctionEvent *AuctionEvent) error
+
+	// PublishDecodedEvent publishes an event decoded from a registered ABI
+	PublishDecodedEvent(ctx context.Context, chainID string, rawEvent *RawEvent, decoded *DecodedEvent) error
+
+	// PublishChainHeadAdvanced publishes a chain's new latest and finalized blocks
+	PublishChainHeadAdvanced(ctx context.Context, chainID string, head contracts.ChainHead) error
+}
+
+// ChainConfigSource loads a chain's RPC endpoints and confirmation params from the chain registry
+type ChainConfigSource interface {
+	// GetChainConfig returns the registry configuration for a chain, e.g. eip155-1
+	GetChainConfig(ctx context.Context, chainID string) (*ChainConfig, error)
+}
+
+// ContractAbi is the ABI the chain registry holds for one contract
+type ContractAbi struct {
+	Name     string
+	Address  string
+	Standard string // "erc721", "erc1155", "proxy", "diamond" or "custom"
+	AbiJSON  string
+}
+
+// ContractAbiSource lists the ABIs of a chain's registered contracts
+type ContractAbiSource interface {
+	// ListContractAbis returns every registered contract of a chain that has an ABI
+	ListContractAbis(ctx context.Context, chainID string) ([]ContractAbi, error)
 }
 
 type BlockchainClient interface {
@@ -110,12 +304,40 @@ type BlockchainClient interface {
 	// ParseCollectionCreatedLog parses a CollectionCreated log
 	ParseCollectionCreatedLog(log *Log) (*CollectionCreatedEvent, error)
 
+	// ParseCollectionAdminLog parses an ownership, royalty or base URI log from a collection
+	ParseCollectionAdminLog(log *Log) (*CollectionAdminEvent, error)
+
+	// ParseAuctionLog parses an AuctionHouse log
+	ParseAuctionLog(log *Log) (*AuctionEvent, error)
+
 	// IsHealthy checks if the blockchain client is healthy
 	IsHealthy(ctx context.Context) error
 }
 
 // Blockchain types
 
+// ChainConfig is the chain-registry configuration the indexer follows a chain with
+type ChainConfig struct {
+	ChainID               string   `json:"chain_id"`
+	RPCURLs               []string `json:"rpc_urls"` // active endpoints, preferred first
+	RequiredConfirmations int      `json:"required_confirmations"`
+	ReorgDepth            int      `json:"reorg_depth"`
+	RegistryVersion       string   `json:"registry_version"`
+	// ImportedCollections were deployed outside the marketplace factories and registered
+	// for indexing, so no CollectionCreated event announces them
+	ImportedCollections []ImportedCollection `json:"imported_collections,omitempty"`
+	// Factories and AuctionHouse hold the factory and auction-house roles in the registry;
+	// empty while no contract is tagged
+	Factories    []string `json:"factories,omitempty"`
+	AuctionHouse string   `json:"auction_house,omitempty"`
+}
+
+// ImportedCollection is backfilled from StartBlock, the block it was deployed in
+type ImportedCollection struct {
+	Address    string `json:"address"`
+	StartBlock int64  `json:"start_block"`
+}
+
 type BlockInfo struct {
 	Number    *big.Int  `json:"number"`
 	Hash      string    `json:"hash"`
@@ -4,50 +4,87 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
 )
 
+// Signatures of the owner-only events emitted by deployed collections
+const (
+	OwnershipTransferredSig  = "OwnershipTransferred(address,address)"
+	DefaultRoyaltyUpdatedSig = "DefaultRoyaltyUpdated(address,uint96)"
+	BaseURIUpdatedSig        = "BaseURIUpdated(string)"
+	PayoutSplitsUpdatedSig   = "PayoutSplitsUpdated(address[],uint256[])"
+)
+
+// Topics of the owner-only events emitted by deployed collections
+var (
+	OwnershipTransferredTopic  = crypto.Keccak256Hash([]byte(OwnershipTransferredSig)).Hex()
+	DefaultRoyaltyUpdatedTopic = crypto.Keccak256Hash([]byte(DefaultRoyaltyUpdatedSig)).Hex()
+	BaseURIUpdatedTopic        = crypto.Keccak256Hash([]byte(BaseURIUpdatedSig)).Hex()
+	PayoutSplitsUpdatedTopic   = crypto.Keccak256Hash([]byte(PayoutSplitsUpdatedSig)).Hex()
+)
+
+// Signatures of the events emitted by the AuctionHouse contract
+const (
+	AuctionCreatedSig   = "AuctionCreated(uint256,address,uint256,address,uint8,uint256,uint256,uint256,uint64,uint64)"
+	BidPlacedSig        = "BidPlaced(uint256,address,uint256,uint64)"
+	AuctionSettledSig   = "AuctionSettled(uint256,address,uint256)"
+	AuctionCancelledSig = "AuctionCancelled(uint256)"
+)
+
+// Topics of the events emitted by the AuctionHouse contract
+var (
+	AuctionCreatedTopic   = crypto.Keccak256Hash([]byte(AuctionCreatedSig)).Hex()
+	BidPlacedTopic        = crypto.Keccak256Hash([]byte(BidPlacedSig)).Hex()
+	AuctionSettledTopic   = crypto.Keccak256Hash([]byte(AuctionSettledSig)).Hex()
+	AuctionCancelledTopic = crypto.Keccak256Hash([]byte(AuctionCancelledSig)).Hex()
+)
+
 // Client implements the BlockchainClient interface for Ethereum-compatible chains
 type Client struct {
-	chainID            string
-	ethClient          *ethclient.Client
-	rpcClient          *rpc.Client
-	confirmationBlocks int
-	rpcURL             string
+	chainID   string
+	ethClient *ethclient.Client
+	rpcClient *rpc.Client
+	rpcURL    string
 }
 
-// NewClient creates a new blockchain client
-func NewClient(chainID, rpcURL string, confirmationBlocks map[string]int) (*Client, error) {
-	if rpcURL == "" {
-		return nil, fmt.Errorf("RPC URL cannot be empty for chain %s", chainID)
+// NewClient creates a new blockchain client on the first of rpcURLs that answers
+func NewClient(chainID string, rpcURLs []string) (*Client, error) {
+	if len(rpcURLs) == 0 {
+		return nil, fmt.Errorf("no RPC URL configured for chain %s", chainID)
 	}
 
+	var lastErr error
+	for _, rpcURL := range rpcURLs {
+		client, err := dial(chainID, rpcURL)
+		if err == nil {
+			return client, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+func dial(chainID, rpcURL string) (*Client, error) {
 	rpcClient, err := rpc.Dial(rpcURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial RPC for chain %s: %w", chainID, err)
 	}
 
-	ethClient := ethclient.NewClient(rpcClient)
-
-	// Get confirmation blocks for this chain
-	chainConfirmations := confirmationBlocks[chainID]
-	if chainConfirmations == 0 {
-		chainConfirmations = 12 // Default for mainnet
-	}
-
 	client := &Client{
-		chainID:            chainID,
-		ethClient:          ethClient,
-		rpcClient:          rpcClient,
-		confirmationBlocks: chainConfirmations,
-		rpcURL:             rpcURL,
+		chainID:   chainID,
+		ethClient: ethclient.NewClient(rpcClient),
+		rpcClient: rpcClient,
+		rpcURL:    rpcURL,
 	}
 
 	// Test connection
@@ -55,6 +92,7 @@ func NewClient(chainID, rpcURL string, confirmationBlocks map[string]int) (*Clie
 	defer cancel()
 
 	if _, err := client.GetLatestBlock(ctx); err != nil {
+		client.Close()
 		return nil, fmt.Errorf("failed to test connection for chain %s: %w", chainID, err)
 	}
 
@@ -70,6 +108,29 @@ func (c *Client) GetLatestBlock(ctx context.Context) (*big.Int, error) {
 	return header.Number, nil
 }
 
+// GetLatestHeader returns the number, hash and time of the latest block
+func (c *Client) GetLatestHeader(ctx context.Context) (*domain.BlockInfo, error) {
+	header, err := c.ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block header: %w", err)
+	}
+	return &domain.BlockInfo{
+		Number:    header.Number,
+		Hash:      header.Hash().Hex(),
+		Timestamp: time.Unix(int64(header.Time), 0),
+	}, nil
+}
+
+// GetFinalizedBlock returns the block the "finalized" tag points at. Chains and nodes
+// without the tag answer with an error.
+func (c *Client) GetFinalizedBlock(ctx context.Context) (*big.Int, error) {
+	header, err := c.ethClient.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finalized block header: %w", err)
+	}
+	return header.Number, nil
+}
+
 // GetBlockByNumber returns block information
 func (c *Client) GetBlockByNumber(ctx context.Context, blockNumber *big.Int) (*domain.BlockInfo, error) {
 	block, err := c.ethClient.BlockByNumber(ctx, blockNumber)
@@ -168,6 +229,11 @@ func (c *Client) GetChainID() string {
 	return c.chainID
 }
 
+// RPCURL returns the endpoint the client is connected to
+func (c *Client) RPCURL() string {
+	return c.rpcURL
+}
+
 // IsHealthy checks if the client connection is healthy
 func (c *Client) IsHealthy(ctx context.Context) error {
 	_, err := c.GetLatestBlock(ctx)
@@ -195,8 +261,8 @@ func (c *Client) ParseCollectionCreatedLog(log *domain.Log) (*domain.CollectionC
 	}
 
 	// Extract indexed parameters from topics
-	collectionAddress := c.addressFromTopic(log.Topics[1])
-	creator := c.addressFromTopic(log.Topics[2])
+	collectionAddress := addressFromTopic(log.Topics[1])
+	creator := addressFromTopic(log.Topics[2])
 
 	// Parse non-indexed parameters from data
 	// This is a simplified version - in practice, you'd use ABI decoding
@@ -215,8 +281,176 @@ func (c *Client) ParseCollectionCreatedLog(log *domain.Log) (*domain.CollectionC
 	return event, nil
 }
 
+// ParseCollectionAdminLog parses an OwnershipTransferred, DefaultRoyaltyUpdated, BaseURIUpdated
+// or PayoutSplitsUpdated log
+func (c *Client) ParseCollectionAdminLog(log *domain.Log) (*domain.CollectionAdminEvent, error) {
+	return parseCollectionAdminLog(log)
+}
+
+func parseCollectionAdminLog(log *domain.Log) (*domain.CollectionAdminEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("invalid collection admin log: no topics")
+	}
+
+	event := &domain.CollectionAdminEvent{
+		CollectionAddress: strings.ToLower(log.Address),
+	}
+	data := common.FromHex(log.Data)
+
+	switch strings.ToLower(log.Topics[0]) {
+	case strings.ToLower(OwnershipTransferredTopic):
+		// event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+		if len(log.Topics) < 3 {
+			return nil, fmt.Errorf("invalid OwnershipTransferred log: insufficient topics")
+		}
+		event.Kind = domain.CollectionAdminOwnershipTransferred
+		event.PreviousOwner = addressFromTopic(log.Topics[1])
+		event.NewOwner = addressFromTopic(log.Topics[2])
+
+	case strings.ToLower(DefaultRoyaltyUpdatedTopic):
+		// event DefaultRoyaltyUpdated(address indexed receiver, uint96 feeNumerator)
+		if len(log.Topics) < 2 {
+			return nil, fmt.Errorf("invalid DefaultRoyaltyUpdated log: insufficient topics")
+		}
+		values, err := unpackArgs(data, "uint96")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode DefaultRoyaltyUpdated data: %w", err)
+		}
+		event.Kind = domain.CollectionAdminRoyaltyUpdated
+		event.RoyaltyRecipient = addressFromTopic(log.Topics[1])
+		event.RoyaltyFeeBps = values[0].(*big.Int).Uint64()
+
+	case strings.ToLower(BaseURIUpdatedTopic):
+		// event BaseURIUpdated(string baseURI)
+		values, err := unpackArgs(data, "string")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode BaseURIUpdated data: %w", err)
+		}
+		event.Kind = domain.CollectionAdminBaseURIUpdated
+		event.BaseURI = values[0].(string)
+
+	case strings.ToLower(PayoutSplitsUpdatedTopic):
+		// event PayoutSplitsUpdated(address[] recipients, uint256[] shares)
+		values, err := unpackArgs(data, "address[]", "uint256[]")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode PayoutSplitsUpdated data: %w", err)
+		}
+		recipients := values[0].([]common.Address)
+		shares := values[1].([]*big.Int)
+		if len(recipients) != len(shares) {
+			return nil, fmt.Errorf("invalid PayoutSplitsUpdated log: %d recipients for %d shares", len(recipients), len(shares))
+		}
+		event.Kind = domain.CollectionAdminPayoutSplitsUpdated
+		event.PayoutSplits = make([]domain.PayoutSplit, len(recipients))
+		for i, recipient := range recipients {
+			event.PayoutSplits[i] = domain.PayoutSplit{
+				Recipient: strings.ToLower(recipient.Hex()),
+				Bps:       shares[i].Uint64(),
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown collection admin topic %s", log.Topics[0])
+	}
+
+	return event, nil
+}
+
+// ParseAuctionLog parses an AuctionCreated, BidPlaced, AuctionSettled or AuctionCancelled log
+func (c *Client) ParseAuctionLog(log *domain.Log) (*domain.AuctionEvent, error) {
+	return parseAuctionLog(log)
+}
+
+func parseAuctionLog(log *domain.Log) (*domain.AuctionEvent, error) {
+	// every auction event indexes the auction id first
+	if len(log.Topics) < 2 {
+		return nil, fmt.Errorf("invalid auction log: insufficient topics")
+	}
+
+	event := &domain.AuctionEvent{
+		AuctionHouse: strings.ToLower(log.Address),
+		AuctionID:    new(big.Int).SetBytes(common.HexToHash(log.Topics[1]).Bytes()),
+	}
+	data := common.FromHex(log.Data)
+
+	switch strings.ToLower(log.Topics[0]) {
+	case strings.ToLower(AuctionCreatedTopic):
+		// event AuctionCreated(uint256 indexed auctionId, address indexed nft, uint256 indexed tokenId, address seller,
+		//   uint8 auctionType, uint256 startPrice, uint256 reservePrice, uint256 endPrice, uint64 startTime, uint64 endTime)
+		if len(log.Topics) < 4 {
+			return nil, fmt.Errorf("invalid AuctionCreated log: insufficient topics")
+		}
+		values, err := unpackArgs(data, "address", "uint8", "uint256", "uint256", "uint256", "uint64", "uint64")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode AuctionCreated data: %w", err)
+		}
+		event.Kind = domain.AuctionCreated
+		event.CollectionAddress = strings.ToLower(addressFromTopic(log.Topics[2]))
+		event.TokenID = new(big.Int).SetBytes(common.HexToHash(log.Topics[3]).Bytes())
+		event.Seller = strings.ToLower(values[0].(common.Address).Hex())
+		event.AuctionType = "english"
+		if values[1].(uint8) == 1 {
+			event.AuctionType = "dutch"
+		}
+		event.StartPrice = values[2].(*big.Int)
+		event.ReservePrice = values[3].(*big.Int)
+		event.EndPrice = values[4].(*big.Int)
+		event.StartTime = values[5].(uint64)
+		event.EndTime = values[6].(uint64)
+
+	case strings.ToLower(BidPlacedTopic):
+		// event BidPlaced(uint256 indexed auctionId, address indexed bidder, uint256 amount, uint64 endTime)
+		if len(log.Topics) < 3 {
+			return nil, fmt.Errorf("invalid BidPlaced log: insufficient topics")
+		}
+		values, err := unpackArgs(data, "uint256", "uint64")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode BidPlaced data: %w", err)
+		}
+		event.Kind = domain.AuctionBid
+		event.Bidder = addressFromTopic(log.Topics[2])
+		event.Amount = values[0].(*big.Int)
+		event.EndTime = values[1].(uint64)
+
+	case strings.ToLower(AuctionSettledTopic):
+		// event AuctionSettled(uint256 indexed auctionId, address indexed winner, uint256 amount)
+		if len(log.Topics) < 3 {
+			return nil, fmt.Errorf("invalid AuctionSettled log: insufficient topics")
+		}
+		values, err := unpackArgs(data, "uint256")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode AuctionSettled data: %w", err)
+		}
+		event.Kind = domain.AuctionSettled
+		event.Winner = addressFromTopic(log.Topics[2])
+		event.Amount = values[0].(*big.Int)
+
+	case strings.ToLower(AuctionCancelledTopic):
+		// event AuctionCancelled(uint256 indexed auctionId)
+		event.Kind = domain.AuctionCancelled
+
+	default:
+		return nil, fmt.Errorf("unknown auction topic %s", log.Topics[0])
+	}
+
+	return event, nil
+}
+
+// unpackArgs decodes non-indexed log data for the given solidity types
+func unpackArgs(data []byte, types ...string) ([]interface{}, error) {
+	args := make(abi.Arguments, len(types))
+	for i, t := range types {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = abi.Argument{Type: typ}
+	}
+	return args.Unpack(data)
+}
+
 // addressFromTopic extracts an address from a log topic
-func (c *Client) addressFromTopic(topic string) string {
+func addressFromTopic(topic string) string {
 	if len(topic) != 66 { // 0x + 64 hex chars
 		return ""
 	}
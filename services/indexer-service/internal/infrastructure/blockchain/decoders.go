@@ -0,0 +1,308 @@
+package blockchain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+)
+
+// Contracts a decoder's logs are fetched from
+const (
+	DecoderSourceCollection = "collection" // collections deployed by the factory
+	DecoderSourceAuction    = "auction"    // the chain's AuctionHouse
+)
+
+// standardCollectionABI holds the ERC-721/1155 events decoded generically on collections.
+// Transfers feed the catalog's wallet activity, and ERC-1155 ones its per-token supply.
+// ERC-4907 UpdateUser and ERC-5006 user records feed its rental state.
+const standardCollectionABI = `[
+	{"type":"event","name":"Transfer","inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"tokenId","type":"uint256","indexed":true}]},
+	{"type":"event","name":"Approval","inputs":[
+		{"name":"owner","type":"address","indexed":true},
+		{"name":"approved","type":"address","indexed":true},
+		{"name":"tokenId","type":"uint256","indexed":true}]},
+	{"type":"event","name":"ApprovalForAll","inputs":[
+		{"name":"owner","type":"address","indexed":true},
+		{"name":"operator","type":"address","indexed":true},
+		{"name":"approved","type":"bool","indexed":false}]},
+	{"type":"event","name":"URI","inputs":[
+		{"name":"value","type":"string","indexed":false},
+		{"name":"id","type":"uint256","indexed":true}]},
+	{"type":"event","name":"TransferSingle","inputs":[
+		{"name":"operator","type":"address","indexed":true},
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"id","type":"uint256","indexed":false},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"TransferBatch","inputs":[
+		{"name":"operator","type":"address","indexed":true},
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"ids","type":"uint256[]","indexed":false},
+		{"name":"values","type":"uint256[]","indexed":false}]},
+	{"type":"event","name":"UpdateUser","inputs":[
+		{"name":"tokenId","type":"uint256","indexed":true},
+		{"name":"user","type":"address","indexed":true},
+		{"name":"expires","type":"uint64","indexed":false}]},
+	{"type":"event","name":"CreateUserRecord","inputs":[
+		{"name":"recordId","type":"uint256","indexed":false},
+		{"name":"tokenId","type":"uint256","indexed":false},
+		{"name":"amount","type":"uint64","indexed":false},
+		{"name":"owner","type":"address","indexed":false},
+		{"name":"user","type":"address","indexed":false},
+		{"name":"expiry","type":"uint64","indexed":false}]},
+	{"type":"event","name":"DeleteUserRecord","inputs":[
+		{"name":"recordId","type":"uint256","indexed":false}]}
+]`
+
+// EventDecoder decodes the logs of one event signature. Decode returns the event the
+// indexer publishes: *domain.CollectionAdminEvent, *domain.AuctionEvent, or
+// *domain.DecodedEvent for events decoded straight from an ABI.
+type EventDecoder struct {
+	Name      string // e.g. "BidPlaced"
+	Signature string // canonical, e.g. "BidPlaced(uint256,address,uint256,uint64)"
+	Topic     string // keccak256 of Signature; filled in by Register
+	Source    string // DecoderSourceCollection or DecoderSourceAuction
+	Decode    func(log *domain.Log) (interface{}, error)
+}
+
+// DecoderRegistry maps event topics to their decoders. Supporting a new event only
+// takes registering a decoder for it, either in code or through a registry ABI.
+type DecoderRegistry struct {
+	mu      sync.RWMutex
+	byTopic map[string]*EventDecoder
+}
+
+// NewDecoderRegistry creates an empty registry
+func NewDecoderRegistry() *DecoderRegistry {
+	return &DecoderRegistry{byTopic: make(map[string]*EventDecoder)}
+}
+
+// DefaultDecoders returns a registry with every decoder compiled into the indexer
+func DefaultDecoders() *DecoderRegistry {
+	r := NewDecoderRegistry()
+	builtin := []EventDecoder{
+		{Name: "OwnershipTransferred", Signature: OwnershipTransferredSig, Source: DecoderSourceCollection, Decode: decodeCollectionAdmin},
+		{Name: "DefaultRoyaltyUpdated", Signature: DefaultRoyaltyUpdatedSig, Source: DecoderSourceCollection, Decode: decodeCollectionAdmin},
+		{Name: "BaseURIUpdated", Signature: BaseURIUpdatedSig, Source: DecoderSourceCollection, Decode: decodeCollectionAdmin},
+		{Name: "PayoutSplitsUpdated", Signature: PayoutSplitsUpdatedSig, Source: DecoderSourceCollection, Decode: decodeCollectionAdmin},
+		{Name: "AuctionCreated", Signature: AuctionCreatedSig, Source: DecoderSourceAuction, Decode: decodeAuction},
+		{Name: "BidPlaced", Signature: BidPlacedSig, Source: DecoderSourceAuction, Decode: decodeAuction},
+		{Name: "AuctionSettled", Signature: AuctionSettledSig, Source: DecoderSourceAuction, Decode: decodeAuction},
+		{Name: "AuctionCancelled", Signature: AuctionCancelledSig, Source: DecoderSourceAuction, Decode: decodeAuction},
+	}
+	for _, d := range builtin {
+		if err := r.Register(d); err != nil {
+			panic(err)
+		}
+	}
+	if _, err := r.RegisterABI(DecoderSourceCollection, standardCollectionABI); err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Register adds a decoder; a signature can only be registered once
+func (r *DecoderRegistry) Register(d EventDecoder) error {
+	if d.Signature == "" || d.Decode == nil {
+		return fmt.Errorf("decoder %q needs a signature and a decode func", d.Name)
+	}
+	if d.Source != DecoderSourceCollection && d.Source != DecoderSourceAuction {
+		return fmt.Errorf("decoder %s: unknown source %q", d.Signature, d.Source)
+	}
+	d.Topic = strings.ToLower(crypto.Keccak256Hash([]byte(d.Signature)).Hex())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.byTopic[d.Topic]; ok {
+		return fmt.Errorf("decoder for %s already registered as %s", d.Signature, existing.Name)
+	}
+	r.byTopic[d.Topic] = &d
+	return nil
+}
+
+// RegisterABI registers a generic decoder for every event of an ABI that has none yet
+// and returns how many were added. abiJSON is a raw ABI array or an artifact with an
+// "abi" field.
+func (r *DecoderRegistry) RegisterABI(source, abiJSON string) (int, error) {
+	parsed, err := parseABI(abiJSON)
+	if err != nil {
+		return 0, err
+	}
+
+	added := 0
+	for _, event := range parsed.Events {
+		if event.Anonymous {
+			continue
+		}
+		if _, ok := r.Lookup(event.ID.Hex()); ok {
+			continue
+		}
+		if err := r.Register(EventDecoder{
+			Name:      event.RawName,
+			Signature: event.Sig,
+			Source:    source,
+			Decode:    abiEventDecoder(event),
+		}); err != nil {
+			return added, err
+		}
+		added++
+	}
+	return added, nil
+}
+
+// Lookup returns the decoder for a log's first topic
+func (r *DecoderRegistry) Lookup(topic string) (*EventDecoder, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, ok := r.byTopic[strings.ToLower(topic)]
+	return d, ok
+}
+
+// Topics returns the topics of every decoder fetched from source, sorted
+func (r *DecoderRegistry) Topics(source string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var topics []string
+	for topic, d := range r.byTopic {
+		if d.Source == source {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// Signatures returns the signature of every registered decoder, sorted
+func (r *DecoderRegistry) Signatures() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	signatures := make([]string, 0, len(r.byTopic))
+	for _, d := range r.byTopic {
+		signatures = append(signatures, d.Signature)
+	}
+	sort.Strings(signatures)
+	return signatures
+}
+
+func decodeCollectionAdmin(log *domain.Log) (interface{}, error) {
+	return parseCollectionAdminLog(log)
+}
+
+func decodeAuction(log *domain.Log) (interface{}, error) {
+	return parseAuctionLog(log)
+}
+
+// abiEventDecoder decodes indexed arguments from the topics and the rest from the data
+func abiEventDecoder(event abi.Event) func(log *domain.Log) (interface{}, error) {
+	return func(log *domain.Log) (interface{}, error) {
+		var indexed abi.Arguments
+		for _, input := range event.Inputs {
+			if input.Indexed {
+				indexed = append(indexed, input)
+			}
+		}
+		if len(log.Topics) != len(indexed)+1 {
+			return nil, fmt.Errorf("invalid %s log: expected %d topics, got %d", event.RawName, len(indexed)+1, len(log.Topics))
+		}
+
+		values := make(map[string]interface{})
+		topics := make([]common.Hash, len(indexed))
+		for i, topic := range log.Topics[1:] {
+			topics[i] = common.HexToHash(topic)
+		}
+		if err := abi.ParseTopicsIntoMap(values, indexed, topics); err != nil {
+			return nil, fmt.Errorf("failed to decode %s topics: %w", event.RawName, err)
+		}
+		if err := event.Inputs.NonIndexed().UnpackIntoMap(values, common.FromHex(log.Data)); err != nil {
+			return nil, fmt.Errorf("failed to decode %s data: %w", event.RawName, err)
+		}
+
+		args := make(map[string]interface{}, len(values))
+		for name, value := range values {
+			args[name] = jsonValue(value)
+		}
+		return &domain.DecodedEvent{
+			Contract:  strings.ToLower(log.Address),
+			Name:      event.RawName,
+			Signature: event.Sig,
+			Args:      args,
+		}, nil
+	}
+}
+
+// jsonValue renders decoded ABI values as JSON-safe strings, bools and lists
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return strings.ToLower(v.Hex())
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return fmt.Sprintf("0x%x", v)
+	case string, bool:
+		return v
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%d", rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%d", rv.Uint())
+	case reflect.Array:
+		// fixed-size byte arrays such as bytes32
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return fmt.Sprintf("0x%x", b)
+		}
+		fallthrough
+	case reflect.Slice:
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = jsonValue(rv.Index(i).Interface())
+		}
+		return list
+	}
+	return fmt.Sprint(value)
+}
+
+// parseABI accepts either a raw ABI array or an artifact object with an "abi" field
+func parseABI(abiJSON string) (*abi.ABI, error) {
+	trimmed := bytes.TrimSpace([]byte(abiJSON))
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(trimmed, &artifact); err != nil {
+			return nil, fmt.Errorf("parse ABI json: %w", err)
+		}
+		if len(artifact.ABI) == 0 {
+			return nil, fmt.Errorf("abi field not found")
+		}
+		trimmed = artifact.ABI
+	}
+
+	parsed, err := abi.JSON(bytes.NewReader(trimmed))
+	if err != nil {
+		return nil, fmt.Errorf("parse abi: %w", err)
+	}
+	return &parsed, nil
+}
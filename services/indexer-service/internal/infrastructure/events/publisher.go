@@ -4,22 +4,31 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 )
 
 const (
 	// Collection event routing keys
-	collectionEventPrefix = "collections.events.created"
+	collectionEventPrefix         = "collections.events.created"
+	collectionUpdatedEventPrefix  = "collections.events.updated"
+	collectionConfirmationsPrefix = "collections.events.confirmations"
+	auctionEventPrefix            = "auctions.events"
+	decodedEventPrefix            = "collections.events.decoded"
+	chainHeadAdvancedPrefix       = "chain.head_advanced"
 
 	// Event schema versions
 	eventSchemaV1 = "marketplace.events.v1"
 )
 
 type EventPublisher struct {
-	amqp *messaging.RabbitMQ
+	amqp      *messaging.RabbitMQ
+	sequencer domain.EventSequencer // nil publishes events unsequenced
 }
 
 // NewEventPublisher creates a new RabbitMQ event publisher
@@ -29,8 +38,18 @@ func NewEventPublisher(amqp *messaging.RabbitMQ) *EventPublisher {
 	}
 }
 
+// SetSequencer stamps collection and token events with their aggregate's next sequence
+func (p *EventPublisher) SetSequencer(sequencer domain.EventSequencer) {
+	p.sequencer = sequencer
+}
+
 // PublishCollectionEvent publishes a collection-related event
 func (p *EventPublisher) PublishCollectionEvent(ctx context.Context, chainID string, event *domain.PublishableEvent) error {
+	return p.publishCollectionEvent(ctx, collectionEventPrefix, chainID, event)
+}
+
+// publishCollectionEvent publishes a collection event under the given routing key prefix
+func (p *EventPublisher) publishCollectionEvent(ctx context.Context, prefix, chainID string, event *domain.PublishableEvent) error {
 	if event == nil {
 		return fmt.Errorf("event cannot be nil")
 	}
@@ -45,9 +64,16 @@ func (p *EventPublisher) PublishCollectionEvent(ctx context.Context, chainID str
 	if event.Timestamp.IsZero() {
 		event.Timestamp = time.Now()
 	}
+	if p.sequencer != nil && event.AggregateID != "" && event.Sequence == 0 {
+		sequence, err := p.sequencer.NextSequence(ctx, event.AggregateID, event.EventID)
+		if err != nil {
+			return fmt.Errorf("failed to sequence event %s: %w", event.EventID, err)
+		}
+		event.Sequence = sequence
+	}
 
 	// Construct routing key: collections.events.created.eip155-1 (per CREATE.md line 68)
-	routingKey := fmt.Sprintf("%s.%s", collectionEventPrefix, chainID)
+	routingKey := fmt.Sprintf("%s.%s", prefix, chainID)
 
 	// Marshal event to JSON
 	eventData, err := json.Marshal(event)
@@ -64,6 +90,10 @@ func (p *EventPublisher) PublishCollectionEvent(ctx context.Context, chainID str
 		"published_at": event.Timestamp.Unix(),
 		"content_type": "application/json",
 	}
+	if event.Sequence > 0 {
+		headers[contracts.AggregateIDHeader] = event.AggregateID
+		headers[contracts.SequenceHeader] = int64(event.Sequence)
+	}
 
 	// Publish message
 	message := &messaging.Message{
@@ -87,14 +117,77 @@ func (p *EventPublisher) PublishCollectionEvent(ctx context.Context, chainID str
 func (p *EventPublisher) PublishCollectionCreatedEvent(ctx context.Context, chainID string, rawEvent *domain.RawEvent, collectionEvent *domain.CollectionCreatedEvent) error {
 	// Create publishable event
 	eventData := map[string]interface{}{
-		"collection_address": collectionEvent.CollectionAddress,
-		"creator":            collectionEvent.Creator,
-		"name":               collectionEvent.Name,
-		"symbol":             collectionEvent.Symbol,
-		"collection_type":    collectionEvent.CollectionType,
-		"max_supply":         collectionEvent.MaxSupply.String(),
-		"royalty_recipient":  collectionEvent.RoyaltyRecipient,
-		"royalty_percentage": collectionEvent.RoyaltyPercentage,
+		"collection_address":     collectionEvent.CollectionAddress,
+		"creator":                collectionEvent.Creator,
+		"name":                   collectionEvent.Name,
+		"symbol":                 collectionEvent.Symbol,
+		"collection_type":        collectionEvent.CollectionType,
+		"max_supply":             collectionEvent.MaxSupply.String(),
+		"royalty_recipient":      collectionEvent.RoyaltyRecipient,
+		"royalty_percentage":     collectionEvent.RoyaltyPercentage,
+		"block_number":           rawEvent.BlockNumber.String(),
+		"block_hash":             rawEvent.BlockHash,
+		"tx_hash":                rawEvent.TxHash,
+		"log_index":              rawEvent.LogIndex,
+		"confirmations":          rawEvent.Confirmations,
+		"required_confirmations": rawEvent.RequiredConfirmations,
+	}
+
+	publishableEvent := &domain.PublishableEvent{
+		Schema:    eventSchemaV1,
+		Version:   "1.0",
+		EventID:   generateEventID(chainID, rawEvent.TxHash, rawEvent.LogIndex),
+		EventType: "collection_created",
+		ChainID:   chainID,
+		TxHash:    rawEvent.TxHash,
+		Contract:  rawEvent.ContractAddress,
+		Data:      eventData,
+		Timestamp: time.Now(),
+
+		AggregateID: contracts.CollectionAggregate(chainID, collectionEvent.CollectionAddress),
+	}
+
+	return p.PublishCollectionEvent(ctx, chainID, publishableEvent)
+}
+
+// PublishCollectionConfirmationsEvent publishes the current depth of a CollectionCreated log
+// on collections.events.confirmations.<chain> while it settles
+func (p *EventPublisher) PublishCollectionConfirmationsEvent(ctx context.Context, chainID string, rawEvent *domain.RawEvent, collectionAddress string, reorged bool) error {
+	confirmations := rawEvent.Confirmations
+	if reorged {
+		confirmations = 0
+	}
+
+	publishableEvent := &domain.PublishableEvent{
+		Schema:    eventSchemaV1,
+		Version:   "1.0",
+		EventID:   fmt.Sprintf("%s_c%d", generateEventID(chainID, rawEvent.TxHash, rawEvent.LogIndex), rawEvent.Confirmations),
+		EventType: "collection_confirmations",
+		ChainID:   chainID,
+		TxHash:    rawEvent.TxHash,
+		Contract:  collectionAddress,
+		Data: map[string]interface{}{
+			"collection_address":     collectionAddress,
+			"block_number":           rawEvent.BlockNumber.String(),
+			"block_hash":             rawEvent.BlockHash,
+			"confirmations":          confirmations,
+			"required_confirmations": rawEvent.RequiredConfirmations,
+			"reorged":                reorged,
+		},
+		Timestamp: time.Now(),
+
+		AggregateID: contracts.CollectionAggregate(chainID, collectionAddress),
+	}
+
+	return p.publishCollectionEvent(ctx, collectionConfirmationsPrefix, chainID, publishableEvent)
+}
+
+// PublishCollectionUpdatedEvent publishes an ownership, royalty, base URI or payout split change
+// on collections.events.updated.<chain>; the change kind travels in the event data
+func (p *EventPublisher) PublishCollectionUpdatedEvent(ctx context.Context, chainID string, rawEvent *domain.RawEvent, adminEvent *domain.CollectionAdminEvent) error {
+	eventData := map[string]interface{}{
+		"collection_address": adminEvent.CollectionAddress,
+		"kind":               adminEvent.Kind,
 		"block_number":       rawEvent.BlockNumber.String(),
 		"block_hash":         rawEvent.BlockHash,
 		"tx_hash":            rawEvent.TxHash,
@@ -102,19 +195,144 @@ func (p *EventPublisher) PublishCollectionCreatedEvent(ctx context.Context, chai
 		"confirmations":      rawEvent.Confirmations,
 	}
 
+	switch adminEvent.Kind {
+	case domain.CollectionAdminOwnershipTransferred:
+		eventData["previous_owner"] = adminEvent.PreviousOwner
+		eventData["new_owner"] = adminEvent.NewOwner
+	case domain.CollectionAdminRoyaltyUpdated:
+		eventData["royalty_recipient"] = adminEvent.RoyaltyRecipient
+		eventData["royalty_percentage"] = fmt.Sprintf("%d", adminEvent.RoyaltyFeeBps)
+	case domain.CollectionAdminBaseURIUpdated:
+		eventData["token_uri"] = adminEvent.BaseURI
+	case domain.CollectionAdminPayoutSplitsUpdated:
+		splits := make([]map[string]interface{}, len(adminEvent.PayoutSplits))
+		for i, split := range adminEvent.PayoutSplits {
+			splits[i] = map[string]interface{}{"recipient": split.Recipient, "bps": fmt.Sprintf("%d", split.Bps)}
+		}
+		eventData["payout_splits"] = splits
+	}
+
 	publishableEvent := &domain.PublishableEvent{
 		Schema:    eventSchemaV1,
 		Version:   "1.0",
 		EventID:   generateEventID(chainID, rawEvent.TxHash, rawEvent.LogIndex),
-		EventType: "collection_created",
+		EventType: "collection_" + adminEvent.Kind,
 		ChainID:   chainID,
 		TxHash:    rawEvent.TxHash,
-		Contract:  rawEvent.ContractAddress,
+		Contract:  adminEvent.CollectionAddress,
 		Data:      eventData,
 		Timestamp: time.Now(),
+
+		AggregateID: contracts.CollectionAggregate(chainID, adminEvent.CollectionAddress),
 	}
 
-	return p.PublishCollectionEvent(ctx, chainID, publishableEvent)
+	return p.publishCollectionEvent(ctx, collectionUpdatedEventPrefix, chainID, publishableEvent)
+}
+
+// PublishAuctionEvent publishes an AuctionHouse event on auctions.events.<kind>.<chain>
+func (p *EventPublisher) PublishAuctionEvent(ctx context.Context, chainID string, rawEvent *domain.RawEvent, auctionEvent *domain.AuctionEvent) error {
+	eventData := map[string]interface{}{
+		"auction_id":    auctionEvent.AuctionID.String(),
+		"auction_house": auctionEvent.AuctionHouse,
+		"block_number":  rawEvent.BlockNumber.String(),
+		"block_hash":    rawEvent.BlockHash,
+		"tx_hash":       rawEvent.TxHash,
+		"log_index":     rawEvent.LogIndex,
+		"confirmations": rawEvent.Confirmations,
+	}
+
+	switch auctionEvent.Kind {
+	case domain.AuctionCreated:
+		eventData["collection_address"] = auctionEvent.CollectionAddress
+		eventData["token_id"] = auctionEvent.TokenID.String()
+		eventData["seller"] = auctionEvent.Seller
+		eventData["auction_type"] = auctionEvent.AuctionType
+		eventData["start_price"] = auctionEvent.StartPrice.String()
+		eventData["reserve_price"] = auctionEvent.ReservePrice.String()
+		eventData["end_price"] = auctionEvent.EndPrice.String()
+		eventData["start_time"] = auctionEvent.StartTime
+		eventData["end_time"] = auctionEvent.EndTime
+	case domain.AuctionBid:
+		eventData["bidder"] = auctionEvent.Bidder
+		eventData["amount"] = auctionEvent.Amount.String()
+		eventData["end_time"] = auctionEvent.EndTime
+	case domain.AuctionSettled:
+		eventData["winner"] = auctionEvent.Winner
+		eventData["amount"] = auctionEvent.Amount.String()
+	}
+
+	publishableEvent := &domain.PublishableEvent{
+		Schema:    eventSchemaV1,
+		Version:   "1.0",
+		EventID:   generateEventID(chainID, rawEvent.TxHash, rawEvent.LogIndex),
+		EventType: "auction_" + auctionEvent.Kind,
+		ChainID:   chainID,
+		TxHash:    rawEvent.TxHash,
+		Contract:  auctionEvent.AuctionHouse,
+		Data:      eventData,
+		Timestamp: time.Now(),
+	}
+
+	return p.publishCollectionEvent(ctx, auctionEventPrefix+"."+auctionEvent.Kind, chainID, publishableEvent)
+}
+
+// PublishDecodedEvent publishes an ABI-decoded event on collections.events.decoded.<chain>;
+// event_type is the snake_case event name, e.g. approval_for_all
+func (p *EventPublisher) PublishDecodedEvent(ctx context.Context, chainID string, rawEvent *domain.RawEvent, decoded *domain.DecodedEvent) error {
+	eventData := map[string]interface{}{
+		"event_name":    decoded.Name,
+		"signature":     decoded.Signature,
+		"args":          decoded.Args,
+		"block_number":  rawEvent.BlockNumber.String(),
+		"block_hash":    rawEvent.BlockHash,
+		"tx_hash":       rawEvent.TxHash,
+		"log_index":     rawEvent.LogIndex,
+		"confirmations": rawEvent.Confirmations,
+	}
+
+	publishableEvent := &domain.PublishableEvent{
+		Schema:    eventSchemaV1,
+		Version:   "1.0",
+		EventID:   generateEventID(chainID, rawEvent.TxHash, rawEvent.LogIndex),
+		EventType: snakeCase(decoded.Name),
+		ChainID:   chainID,
+		TxHash:    rawEvent.TxHash,
+		Contract:  decoded.Contract,
+		Data:      eventData,
+		Timestamp: time.Now(),
+
+		AggregateID: decodedAggregate(chainID, decoded),
+	}
+
+	return p.publishCollectionEvent(ctx, decodedEventPrefix, chainID, publishableEvent)
+}
+
+// decodedAggregate puts events about one token, such as its mints, transfers and approvals,
+// in the token's aggregate and the rest, batch transfers included, in the collection's
+func decodedAggregate(chainID string, decoded *domain.DecodedEvent) string {
+	for _, name := range []string{"tokenId", "id"} {
+		if tokenID, ok := decoded.Args[name]; ok && tokenID != nil {
+			return contracts.TokenAggregate(chainID, decoded.Contract, fmt.Sprint(tokenID))
+		}
+	}
+	return contracts.CollectionAggregate(chainID, decoded.Contract)
+}
+
+// snakeCase turns an event name such as BaseURIUpdated into base_uri_updated
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// a word starts after a lowercase letter, or at the last capital of an acronym
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
 }
 
 // PublishMintEvent publishes a mint-related event (for future use)
@@ -226,6 +444,38 @@ func (p *EventPublisher) PublishHealthCheck(ctx context.Context, chainID string)
 }
 
 // generateEventID creates a unique event ID
+// PublishChainHeadAdvanced publishes a chain's new head on chain.head_advanced.<chain> of
+// the chains exchange
+func (p *EventPublisher) PublishChainHeadAdvanced(ctx context.Context, chainID string, head contracts.ChainHead) error {
+	event := contracts.ChainHeadAdvancedEvent{
+		EventID:   fmt.Sprintf("head_advanced_%s_%d", chainID, head.LatestBlock),
+		ChainHead: head,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal chain.head_advanced event: %w", err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   contracts.ChainsExchange,
+		RoutingKey: fmt.Sprintf("%s.%s", chainHeadAdvancedPrefix, chainID),
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   "chain.head_advanced",
+			"chain_id":     head.ChainID,
+			"published_at": head.AdvancedAt.Unix(),
+			"content_type": "application/json",
+		},
+		Timestamp: head.AdvancedAt,
+		MessageID: event.EventID,
+	}
+
+	if err := p.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("failed to publish chain.head_advanced event: %w", err)
+	}
+	return nil
+}
+
 func generateEventID(chainID, txHash string, logIndex int) string {
 	return fmt.Sprintf("%s_%s_%d", chainID, txHash, logIndex)
 }
@@ -0,0 +1,115 @@
+// Package httpapi serves the indexer's operational endpoints: decode failure metrics at
+// /metrics in the Prometheus text format, and admin actions under /admin/ that need the
+// admin token.
+package httpapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+)
+
+const (
+	MetricsRoute   = "/metrics"
+	ReprocessRoute = "/admin/dead-letters/reprocess"
+)
+
+// Indexer is what the endpoints read and act on; service.IndexerService implements it
+type Indexer interface {
+	DecodeFailureCounts() []domain.DecodeFailureCount
+	PendingDeadLetters(ctx context.Context) ([]domain.DecodeFailureCount, error)
+	ReprocessDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) (domain.ReprocessResult, error)
+}
+
+// NewHandler routes the operational endpoints. Admin endpoints are disabled while
+// adminToken is empty.
+func NewHandler(indexer Indexer, adminToken string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(MetricsRoute, func(w http.ResponseWriter, r *http.Request) {
+		serveMetrics(w, r, indexer)
+	})
+	mux.Handle(ReprocessRoute, requireAdmin(adminToken, func(w http.ResponseWriter, r *http.Request) {
+		serveReprocess(w, r, indexer)
+	}))
+	return mux
+}
+
+func serveMetrics(w http.ResponseWriter, r *http.Request, indexer Indexer) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	writeCounts(w, "indexer_decode_failures_total", "counter",
+		"Logs the indexer failed to decode since it started.", indexer.DecodeFailureCounts())
+
+	// The dead-letter store may be down while the counters are still worth scraping
+	pending, err := indexer.PendingDeadLetters(ctx)
+	if err != nil {
+		log.Printf("metrics: failed to count dead letters: %v", err)
+		return
+	}
+	writeCounts(w, "indexer_dead_letters_pending", "gauge",
+		"Dead-lettered logs awaiting reprocessing.", pending)
+}
+
+func writeCounts(w io.Writer, name, kind, help string, counts []domain.DecodeFailureCount) {
+	monitoring.WriteMetricHeader(w, name, kind, help)
+	for _, c := range counts {
+		fmt.Fprintf(w, "%s{chain_id=%q,contract=%q,event_signature=%q} %d\n",
+			name, c.ChainID, c.ContractAddress, c.EventSignature, c.Count)
+	}
+}
+
+// serveReprocess retries pending dead letters; the JSON body narrows them by chain,
+// contract and event signature and may be empty
+func serveReprocess(w http.ResponseWriter, r *http.Request, indexer Indexer) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var filter domain.DeadLetterFilter
+	if err := json.NewDecoder(io.LimitReader(r.Body, 1<<16)).Decode(&filter); err != nil && err != io.EOF {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := indexer.ReprocessDeadLetters(r.Context(), filter)
+	if err != nil {
+		log.Printf("admin: dead letter reprocess failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("audit|event=dead_letters_reprocessed|chain_id=%s|contract=%s|event_signature=%s|reprocessed=%d|failed=%d",
+		filter.ChainID, filter.ContractAddress, filter.EventSignature, result.Reprocessed, result.Failed)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// requireAdmin accepts requests bearing the admin token
+func requireAdmin(token string, next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token == "" {
+			http.Error(w, "admin endpoints are disabled", http.StatusNotFound)
+			return
+		}
+		presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	})
+}
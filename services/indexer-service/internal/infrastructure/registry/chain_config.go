@@ -0,0 +1,110 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	sharedcontracts "github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	chainpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+type ChainConfigSource struct {
+	client chainpb.ChainRegistryServiceClient
+}
+
+// NewChainConfigSource creates a chain config source backed by chain-registry-service.
+// It also serves the contract ABIs as a domain.ContractAbiSource.
+func NewChainConfigSource(client chainpb.ChainRegistryServiceClient) *ChainConfigSource {
+	return &ChainConfigSource{client: client}
+}
+
+// GetChainConfig fetches the chain's active RPC endpoints and params. The registry keys
+// chains by CAIP-2 (eip155:1) while the indexer uses eip155-1.
+func (s *ChainConfigSource) GetChainConfig(ctx context.Context, chainID string) (*domain.ChainConfig, error) {
+	registryChainID := strings.Replace(chainID, "-", ":", 1)
+
+	endpoints, err := s.client.GetRpcEndpoints(ctx, &chainpb.GetRpcEndpointsRequest{ChainId: registryChainID})
+	if err != nil {
+		return nil, fmt.Errorf("get rpc endpoints for chain %s: %w", chainID, err)
+	}
+	contracts, err := s.client.GetContracts(ctx, &chainpb.GetContractsRequest{ChainId: registryChainID})
+	if err != nil {
+		return nil, fmt.Errorf("get chain params for chain %s: %w", chainID, err)
+	}
+
+	cfg := &domain.ChainConfig{
+		ChainID:               chainID,
+		RequiredConfirmations: int(contracts.GetParams().GetRequiredConfirmations()),
+		ReorgDepth:            int(contracts.GetParams().GetReorgDepth()),
+		RegistryVersion:       contracts.GetRegistryVersion(),
+	}
+	// Endpoints arrive ordered by priority, then weight
+	for _, endpoint := range endpoints.GetEndpoints() {
+		if endpoint.GetActive() && endpoint.GetUrl() != "" {
+			cfg.RPCURLs = append(cfg.RPCURLs, endpoint.GetUrl())
+		}
+	}
+	if len(cfg.RPCURLs) == 0 {
+		return nil, fmt.Errorf("no active rpc endpoints registered for chain %s", chainID)
+	}
+	for _, contract := range contracts.GetContracts() {
+		address := strings.ToLower(contract.GetAddress())
+		if contract.GetImported() {
+			cfg.ImportedCollections = append(cfg.ImportedCollections, domain.ImportedCollection{
+				Address:    address,
+				StartBlock: int64(contract.GetStartBlock()),
+			})
+		}
+		if sharedcontracts.HasRole(contract.GetRoles(), sharedcontracts.RoleFactory) {
+			cfg.Factories = append(cfg.Factories, address)
+		}
+		if sharedcontracts.HasRole(contract.GetRoles(), sharedcontracts.RoleAuctionHouse) {
+			if cfg.AuctionHouse != "" {
+				return nil, fmt.Errorf("several contracts hold the %s role on chain %s: %s and %s",
+					sharedcontracts.RoleAuctionHouse, chainID, cfg.AuctionHouse, address)
+			}
+			cfg.AuctionHouse = address
+		}
+	}
+
+	return cfg, nil
+}
+
+// contractStandards maps registry contract standards to domain.ContractAbi.Standard
+var contractStandards = map[chainpb.ContractStandard]string{
+	chainpb.ContractStandard_STD_CUSTOM:  "custom",
+	chainpb.ContractStandard_STD_ERC721:  "erc721",
+	chainpb.ContractStandard_STD_ERC1155: "erc1155",
+	chainpb.ContractStandard_STD_PROXY:   "proxy",
+	chainpb.ContractStandard_STD_DIAMOND: "diamond",
+}
+
+// ListContractAbis fetches the ABI blob of every registered contract of the chain that has one
+func (s *ChainConfigSource) ListContractAbis(ctx context.Context, chainID string) ([]domain.ContractAbi, error) {
+	registryChainID := strings.Replace(chainID, "-", ":", 1)
+
+	resp, err := s.client.GetContracts(ctx, &chainpb.GetContractsRequest{ChainId: registryChainID})
+	if err != nil {
+		return nil, fmt.Errorf("get contracts for chain %s: %w", chainID, err)
+	}
+
+	var abis []domain.ContractAbi
+	for _, contract := range resp.GetContracts() {
+		if contract.GetAbiSha256() == "" {
+			continue
+		}
+		blob, err := s.client.GetAbiBlob(ctx, &chainpb.GetAbiBlobRequest{AbiSha256: contract.GetAbiSha256()})
+		if err != nil {
+			return nil, fmt.Errorf("get abi of %s on chain %s: %w", contract.GetName(), chainID, err)
+		}
+		abis = append(abis, domain.ContractAbi{
+			Name:     contract.GetName(),
+			Address:  strings.ToLower(contract.GetAddress()),
+			Standard: contractStandards[contract.GetStandard()],
+			AbiJSON:  blob.GetAbiJson(),
+		})
+	}
+	return abis, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
@@ -29,18 +30,27 @@ func NewCheckpointRepository(client *postgres.Postgres) *CheckpointRepository {
 	return repo
 }
 
-// initSchema creates the indexer_checkpoints table if it doesn't exist
+// initSchema creates the indexer_checkpoints table if it doesn't exist and migrates the
+// one-row-per-chain layout to one row per (chain, contract). Existing rows keep an empty
+// contract_address and become the chain-level checkpoint contracts start from.
 func (r *CheckpointRepository) initSchema() error {
 	ctx := context.Background()
 
 	createTableQuery := `
 		CREATE TABLE IF NOT EXISTS indexer_checkpoints (
-			chain_id VARCHAR(50) PRIMARY KEY,
+			chain_id VARCHAR(50) NOT NULL,
+			contract_address VARCHAR(42) NOT NULL DEFAULT '',
 			last_block NUMERIC(78, 0) NOT NULL DEFAULT 0,
 			last_block_hash VARCHAR(66) NOT NULL DEFAULT '',
-			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (chain_id, contract_address)
 		);
-		
+
+		ALTER TABLE indexer_checkpoints ADD COLUMN IF NOT EXISTS contract_address VARCHAR(42) NOT NULL DEFAULT '';
+		ALTER TABLE indexer_checkpoints
+			DROP CONSTRAINT IF EXISTS indexer_checkpoints_pkey,
+			ADD CONSTRAINT indexer_checkpoints_pkey PRIMARY KEY (chain_id, contract_address);
+
 		-- Create index for faster lookups
 		CREATE INDEX IF NOT EXISTS idx_checkpoints_updated_at ON indexer_checkpoints(updated_at);
 	`
@@ -53,131 +63,127 @@ func (r *CheckpointRepository) initSchema() error {
 	return nil
 }
 
-// GetCheckpoint retrieves the latest checkpoint for a chain
-func (r *CheckpointRepository) GetCheckpoint(ctx context.Context, chainID string) (*domain.Checkpoint, error) {
+// GetCheckpoint retrieves the checkpoint of a contract; pass an empty contract for the chain-level row
+func (r *CheckpointRepository) GetCheckpoint(ctx context.Context, chainID, contract string) (*domain.Checkpoint, error) {
 	query := `
-		SELECT chain_id, last_block, last_block_hash, updated_at
+		SELECT chain_id, contract_address, last_block, last_block_hash, updated_at
 		FROM indexer_checkpoints
-		WHERE chain_id = $1
+		WHERE chain_id = $1 AND contract_address = $2
 	`
 
-	var checkpoint domain.Checkpoint
-	var lastBlockStr string
-
-	err := r.db.GetClient().QueryRowContext(ctx, query, chainID).Scan(
-		&checkpoint.ChainID,
-		&lastBlockStr,
-		&checkpoint.LastBlockHash,
-		&checkpoint.UpdatedAt,
-	)
-
+	checkpoint, err := scanCheckpoint(r.db.GetClient().QueryRowContext(ctx, query, chainID, strings.ToLower(contract)))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			// Return default checkpoint starting from block 0
 			return &domain.Checkpoint{
-				ChainID:       chainID,
-				LastBlock:     big.NewInt(0),
-				LastBlockHash: "",
-				UpdatedAt:     time.Now(),
+				ChainID:         chainID,
+				ContractAddress: strings.ToLower(contract),
+				LastBlock:       big.NewInt(0),
+				LastBlockHash:   "",
+				UpdatedAt:       time.Now(),
 			}, nil
 		}
-		return nil, fmt.Errorf("failed to get checkpoint for chain %s: %w", chainID, err)
-	}
-
-	// Convert string to big.Int
-	lastBlock := new(big.Int)
-	if lastBlockStr != "" {
-		if _, ok := lastBlock.SetString(lastBlockStr, 10); !ok {
-			return nil, fmt.Errorf("invalid block number format: %s", lastBlockStr)
-		}
+		return nil, fmt.Errorf("failed to get checkpoint for %s on chain %s: %w", contract, chainID, err)
 	}
-	checkpoint.LastBlock = lastBlock
 
-	return &checkpoint, nil
+	return checkpoint, nil
 }
 
-// UpdateCheckpoint updates the checkpoint for a chain
-func (r *CheckpointRepository) UpdateCheckpoint(ctx context.Context, checkpoint *domain.Checkpoint) error {
-	if checkpoint == nil {
-		return fmt.Errorf("checkpoint cannot be nil")
-	}
-
+// ListCheckpoints retrieves every checkpoint of a chain, including the chain-level row
+func (r *CheckpointRepository) ListCheckpoints(ctx context.Context, chainID string) ([]*domain.Checkpoint, error) {
 	query := `
-		UPDATE indexer_checkpoints
-		SET last_block = $2,
-			last_block_hash = $3,
-			updated_at = $4
+		SELECT chain_id, contract_address, last_block, last_block_hash, updated_at
+		FROM indexer_checkpoints
 		WHERE chain_id = $1
+		ORDER BY contract_address
 	`
 
-	lastBlockStr := checkpoint.LastBlock.String()
-	updatedAt := time.Now()
+	rows, err := r.db.GetClient().QueryContext(ctx, query, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checkpoints for chain %s: %w", chainID, err)
+	}
+	defer rows.Close()
 
-	result, err := r.db.GetClient().ExecContext(ctx, query,
-		checkpoint.ChainID,
-		lastBlockStr,
-		checkpoint.LastBlockHash,
-		updatedAt,
-	)
+	return scanCheckpoints(rows)
+}
 
-	if err != nil {
-		return fmt.Errorf("failed to update checkpoint for chain %s: %w", checkpoint.ChainID, err)
+// UpdateCheckpoints upserts the checkpoints of one block range in a single transaction
+func (r *CheckpointRepository) UpdateCheckpoints(ctx context.Context, checkpoints []*domain.Checkpoint) error {
+	if len(checkpoints) == 0 {
+		return nil
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	query := `
+		INSERT INTO indexer_checkpoints (chain_id, contract_address, last_block, last_block_hash, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (chain_id, contract_address) DO UPDATE SET
+			last_block = EXCLUDED.last_block,
+			last_block_hash = EXCLUDED.last_block_hash,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	tx, err := r.db.GetClient().BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	if rowsAffected == 0 {
-		// Checkpoint doesn't exist, create it
-		return r.CreateCheckpoint(ctx, checkpoint)
+	defer tx.Rollback()
+
+	updatedAt := time.Now()
+	for _, checkpoint := range checkpoints {
+		if checkpoint == nil {
+			return fmt.Errorf("checkpoint cannot be nil")
+		}
+		_, err := tx.ExecContext(ctx, query,
+			checkpoint.ChainID,
+			strings.ToLower(checkpoint.ContractAddress),
+			checkpoint.LastBlock.String(),
+			checkpoint.LastBlockHash,
+			updatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update checkpoint for %s on chain %s: %w", checkpoint.ContractAddress, checkpoint.ChainID, err)
+		}
 	}
 
-	// Update the checkpoint timestamp
-	checkpoint.UpdatedAt = updatedAt
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit checkpoint update: %w", err)
+	}
 
+	for _, checkpoint := range checkpoints {
+		checkpoint.UpdatedAt = updatedAt
+	}
 	return nil
 }
 
-// CreateCheckpoint creates a new checkpoint for a chain
-func (r *CheckpointRepository) CreateCheckpoint(ctx context.Context, checkpoint *domain.Checkpoint) error {
+// SeedCheckpoint inserts a contract's starting checkpoint, leaving an existing one untouched
+func (r *CheckpointRepository) SeedCheckpoint(ctx context.Context, checkpoint *domain.Checkpoint) error {
 	if checkpoint == nil {
 		return fmt.Errorf("checkpoint cannot be nil")
 	}
 
 	query := `
-		INSERT INTO indexer_checkpoints (chain_id, last_block, last_block_hash, updated_at)
-		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (chain_id) DO UPDATE SET
-			last_block = EXCLUDED.last_block,
-			last_block_hash = EXCLUDED.last_block_hash,
-			updated_at = EXCLUDED.updated_at
+		INSERT INTO indexer_checkpoints (chain_id, contract_address, last_block, last_block_hash, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (chain_id, contract_address) DO NOTHING
 	`
 
-	lastBlockStr := checkpoint.LastBlock.String()
-	updatedAt := time.Now()
-
 	_, err := r.db.GetClient().ExecContext(ctx, query,
 		checkpoint.ChainID,
-		lastBlockStr,
+		strings.ToLower(checkpoint.ContractAddress),
+		checkpoint.LastBlock.String(),
 		checkpoint.LastBlockHash,
-		updatedAt,
 	)
-
 	if err != nil {
-		return fmt.Errorf("failed to create checkpoint for chain %s: %w", checkpoint.ChainID, err)
+		return fmt.Errorf("failed to seed checkpoint for %s on chain %s: %w", checkpoint.ContractAddress, checkpoint.ChainID, err)
 	}
 
-	// Update the checkpoint timestamp
-	checkpoint.UpdatedAt = updatedAt
-
 	return nil
 }
 
 // GetAllCheckpoints retrieves all checkpoints
 func (r *CheckpointRepository) GetAllCheckpoints(ctx context.Context) ([]*domain.Checkpoint, error) {
 	query := `
-		SELECT chain_id, last_block, last_block_hash, updated_at
+		SELECT chain_id, contract_address, last_block, last_block_hash, updated_at
 		FROM indexer_checkpoints
 		ORDER BY updated_at DESC
 	`
@@ -188,100 +194,29 @@ func (r *CheckpointRepository) GetAllCheckpoints(ctx context.Context) ([]*domain
 	}
 	defer rows.Close()
 
-	var checkpoints []*domain.Checkpoint
-
-	for rows.Next() {
-		var checkpoint domain.Checkpoint
-		var lastBlockStr string
-
-		err := rows.Scan(
-			&checkpoint.ChainID,
-			&lastBlockStr,
-			&checkpoint.LastBlockHash,
-			&checkpoint.UpdatedAt,
-		)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan checkpoint: %w", err)
-		}
-
-		// Convert string to big.Int
-		lastBlock := new(big.Int)
-		if lastBlockStr != "" {
-			if _, ok := lastBlock.SetString(lastBlockStr, 10); !ok {
-				return nil, fmt.Errorf("invalid block number format: %s", lastBlockStr)
-			}
-		}
-		checkpoint.LastBlock = lastBlock
-
-		checkpoints = append(checkpoints, &checkpoint)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating checkpoints: %w", err)
-	}
-
-	return checkpoints, nil
-}
-
-// IncrementCheckpoint increments the checkpoint by one block
-func (r *CheckpointRepository) IncrementCheckpoint(ctx context.Context, chainID, blockHash string) error {
-	// Begin transaction for atomic update
-	tx, err := r.db.GetClient().BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer tx.Rollback()
-
-	// Get current checkpoint
-	checkpoint, err := r.GetCheckpoint(ctx, chainID)
-	if err != nil {
-		return fmt.Errorf("failed to get current checkpoint: %w", err)
-	}
-
-	// Increment block number
-	newBlock := new(big.Int).Add(checkpoint.LastBlock, big.NewInt(1))
-
-	// Update checkpoint
-	updatedCheckpoint := &domain.Checkpoint{
-		ChainID:       chainID,
-		LastBlock:     newBlock,
-		LastBlockHash: blockHash,
-		UpdatedAt:     time.Now(),
-	}
-
-	err = r.UpdateCheckpoint(ctx, updatedCheckpoint)
-	if err != nil {
-		return fmt.Errorf("failed to update checkpoint: %w", err)
-	}
-
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit checkpoint update: %w", err)
-	}
-
-	return nil
+	return scanCheckpoints(rows)
 }
 
-// SetCheckpointToBlock sets the checkpoint to a specific block
-func (r *CheckpointRepository) SetCheckpointToBlock(ctx context.Context, chainID string, blockNumber *big.Int, blockHash string) error {
+// SetCheckpointToBlock sets the checkpoint of a contract to a specific block
+func (r *CheckpointRepository) SetCheckpointToBlock(ctx context.Context, chainID, contract string, blockNumber *big.Int, blockHash string) error {
 	checkpoint := &domain.Checkpoint{
-		ChainID:       chainID,
-		LastBlock:     blockNumber,
-		LastBlockHash: blockHash,
-		UpdatedAt:     time.Now(),
+		ChainID:         chainID,
+		ContractAddress: contract,
+		LastBlock:       blockNumber,
+		LastBlockHash:   blockHash,
+		UpdatedAt:       time.Now(),
 	}
 
-	return r.UpdateCheckpoint(ctx, checkpoint)
+	return r.UpdateCheckpoints(ctx, []*domain.Checkpoint{checkpoint})
 }
 
-// DeleteCheckpoint removes a checkpoint for a specific chain
-func (r *CheckpointRepository) DeleteCheckpoint(ctx context.Context, chainID string) error {
-	query := `DELETE FROM indexer_checkpoints WHERE chain_id = $1`
+// DeleteCheckpoint removes the checkpoint of a contract so it restarts from the chain-level row
+func (r *CheckpointRepository) DeleteCheckpoint(ctx context.Context, chainID, contract string) error {
+	query := `DELETE FROM indexer_checkpoints WHERE chain_id = $1 AND contract_address = $2`
 
-	result, err := r.db.GetClient().ExecContext(ctx, query, chainID)
+	result, err := r.db.GetClient().ExecContext(ctx, query, chainID, strings.ToLower(contract))
 	if err != nil {
-		return fmt.Errorf("failed to delete checkpoint for chain %s: %w", chainID, err)
+		return fmt.Errorf("failed to delete checkpoint for %s on chain %s: %w", contract, chainID, err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -289,7 +224,7 @@ func (r *CheckpointRepository) DeleteCheckpoint(ctx context.Context, chainID str
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("checkpoint not found for chain %s", chainID)
+		return fmt.Errorf("checkpoint not found for %s on chain %s", contract, chainID)
 	}
 
 	return nil
@@ -306,11 +241,58 @@ func (r *CheckpointRepository) HealthCheck(ctx context.Context) error {
 	return nil
 }
 
-// GetLastProcessedBlock is a helper function to get just the block number
-func (r *CheckpointRepository) GetLastProcessedBlock(ctx context.Context, chainID string) (*big.Int, error) {
-	checkpoint, err := r.GetCheckpoint(ctx, chainID)
+// GetLastProcessedBlock is a helper function to get just the block number of a contract
+func (r *CheckpointRepository) GetLastProcessedBlock(ctx context.Context, chainID, contract string) (*big.Int, error) {
+	checkpoint, err := r.GetCheckpoint(ctx, chainID, contract)
 	if err != nil {
 		return nil, err
 	}
 	return checkpoint.LastBlock, nil
 }
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanCheckpoint(row rowScanner) (*domain.Checkpoint, error) {
+	var checkpoint domain.Checkpoint
+	var lastBlockStr string
+
+	if err := row.Scan(
+		&checkpoint.ChainID,
+		&checkpoint.ContractAddress,
+		&lastBlockStr,
+		&checkpoint.LastBlockHash,
+		&checkpoint.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	// Convert string to big.Int
+	lastBlock := new(big.Int)
+	if lastBlockStr != "" {
+		if _, ok := lastBlock.SetString(lastBlockStr, 10); !ok {
+			return nil, fmt.Errorf("invalid block number format: %s", lastBlockStr)
+		}
+	}
+	checkpoint.LastBlock = lastBlock
+
+	return &checkpoint, nil
+}
+
+func scanCheckpoints(rows *sql.Rows) ([]*domain.Checkpoint, error) {
+	var checkpoints []*domain.Checkpoint
+	for rows.Next() {
+		checkpoint, err := scanCheckpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan checkpoint: %w", err)
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating checkpoints: %w", err)
+	}
+
+	return checkpoints, nil
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	mongoClient "github.com/quangdang46/NFT-Marketplace/shared/mongo"
+)
+
+const deadLetterCollection = "events.dead_letter"
+
+// deadLetterDoc is the stored form of a dead letter; block numbers are kept as strings
+// like in events.raw
+type deadLetterDoc struct {
+	ID              string     `bson:"_id"`
+	ChainID         string     `bson:"chain_id"`
+	TxHash          string     `bson:"tx_hash"`
+	LogIndex        int        `bson:"log_index"`
+	BlockNumber     string     `bson:"block_number"`
+	BlockHash       string     `bson:"block_hash"`
+	ContractAddress string     `bson:"contract_address"`
+	EventSignature  string     `bson:"event_signature"`
+	EventName       string     `bson:"event_name"`
+	Stream          string     `bson:"stream"`
+	Topics          []string   `bson:"topics"`
+	Data            string     `bson:"data"`
+	Error           string     `bson:"error"`
+	Attempts        int        `bson:"attempts"`
+	FirstFailedAt   time.Time  `bson:"first_failed_at"`
+	LastFailedAt    time.Time  `bson:"last_failed_at"`
+	ReprocessedAt   *time.Time `bson:"reprocessed_at,omitempty"`
+}
+
+type DeadLetterRepository struct {
+	collection *mongo.Collection
+}
+
+// NewDeadLetterRepository creates a MongoDB repository for logs the indexer failed to decode
+func NewDeadLetterRepository(client *mongoClient.MongoDB) *DeadLetterRepository {
+	repo := &DeadLetterRepository{collection: client.GetDatabase().Collection(deadLetterCollection)}
+	repo.createIndexes()
+	return repo
+}
+
+func (r *DeadLetterRepository) createIndexes() {
+	ctx := context.Background()
+	idx := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "reprocessed_at", Value: 1}, {Key: "chain_id", Value: 1}, {Key: "contract_address", Value: 1}, {Key: "event_signature", Value: 1}}},
+		{Keys: bson.D{{Key: "first_failed_at", Value: 1}}},
+	}
+	if _, err := r.collection.Indexes().CreateMany(ctx, idx); err != nil {
+		fmt.Printf("Warning: failed to create dead letter indexes: %v\n", err)
+	}
+}
+
+// RecordDeadLetter stores a decode failure keyed by its raw event ID. A log failing again,
+// including one reprocessed earlier, is pending again with its attempts counted.
+func (r *DeadLetterRepository) RecordDeadLetter(ctx context.Context, letter *domain.DeadLetter) error {
+	if letter == nil {
+		return fmt.Errorf("dead letter cannot be nil")
+	}
+	if letter.ID == "" {
+		letter.ID = generateEventID(letter.ChainID, letter.TxHash, letter.LogIndex)
+	}
+	if letter.LastFailedAt.IsZero() {
+		letter.LastFailedAt = time.Now()
+	}
+
+	blockNumber := ""
+	if letter.BlockNumber != nil {
+		blockNumber = letter.BlockNumber.String()
+	}
+
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"chain_id":         letter.ChainID,
+			"tx_hash":          letter.TxHash,
+			"log_index":        letter.LogIndex,
+			"block_number":     blockNumber,
+			"block_hash":       letter.BlockHash,
+			"contract_address": letter.ContractAddress,
+			"event_signature":  letter.EventSignature,
+			"stream":           letter.Stream,
+			"topics":           letter.Topics,
+			"data":             letter.Data,
+			"first_failed_at":  letter.LastFailedAt,
+		},
+		"$set": bson.M{
+			"event_name":     letter.EventName,
+			"error":          letter.Error,
+			"last_failed_at": letter.LastFailedAt,
+		},
+		"$unset": bson.M{"reprocessed_at": ""},
+		"$inc":   bson.M{"attempts": 1},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": letter.ID}, update, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to record dead letter: %w", err)
+	}
+	return nil
+}
+
+// ListDeadLetters returns pending dead letters matching filter, oldest failure first
+func (r *DeadLetterRepository) ListDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) ([]*domain.DeadLetter, error) {
+	query := pendingFilter(filter)
+	findOpts := options.Find().SetSort(bson.D{{Key: "first_failed_at", Value: 1}, {Key: "_id", Value: 1}})
+	if filter.Limit > 0 {
+		findOpts = findOpts.SetLimit(int64(filter.Limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, query, findOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find dead letters: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var letters []*domain.DeadLetter
+	for cursor.Next(ctx) {
+		var doc deadLetterDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode dead letter: %w", err)
+		}
+		letters = append(letters, doc.toDomain())
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return letters, nil
+}
+
+// MarkReprocessed takes a dead letter out of the pending set; it stays for the record
+func (r *DeadLetterRepository) MarkReprocessed(ctx context.Context, id string, at time.Time) error {
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"reprocessed_at": at}}); err != nil {
+		return fmt.Errorf("failed to mark dead letter reprocessed: %w", err)
+	}
+	return nil
+}
+
+// CountPending counts pending dead letters per chain, contract and event signature
+func (r *DeadLetterRepository) CountPending(ctx context.Context) ([]domain.DecodeFailureCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: pendingFilter(domain.DeadLetterFilter{})}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"chain_id":         "$chain_id",
+				"contract_address": "$contract_address",
+				"event_signature":  "$event_signature",
+			},
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count dead letters: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var counts []domain.DecodeFailureCount
+	for cursor.Next(ctx) {
+		var row struct {
+			ID struct {
+				ChainID         string `bson:"chain_id"`
+				ContractAddress string `bson:"contract_address"`
+				EventSignature  string `bson:"event_signature"`
+			} `bson:"_id"`
+			Count int64 `bson:"count"`
+		}
+		if err := cursor.Decode(&row); err != nil {
+			return nil, fmt.Errorf("failed to decode dead letter count: %w", err)
+		}
+		counts = append(counts, domain.DecodeFailureCount{
+			ChainID:         row.ID.ChainID,
+			ContractAddress: row.ID.ContractAddress,
+			EventSignature:  row.ID.EventSignature,
+			Count:           row.Count,
+		})
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// pendingFilter matches dead letters not yet reprocessed
+func pendingFilter(filter domain.DeadLetterFilter) bson.M {
+	query := bson.M{"reprocessed_at": nil}
+	if filter.ChainID != "" {
+		query["chain_id"] = filter.ChainID
+	}
+	if filter.ContractAddress != "" {
+		query["contract_address"] = filter.ContractAddress
+	}
+	if filter.EventSignature != "" {
+		query["event_signature"] = filter.EventSignature
+	}
+	return query
+}
+
+func (d *deadLetterDoc) toDomain() *domain.DeadLetter {
+	blockNumber, ok := new(big.Int).SetString(d.BlockNumber, 10)
+	if !ok {
+		blockNumber = nil
+	}
+	return &domain.DeadLetter{
+		ID:              d.ID,
+		ChainID:         d.ChainID,
+		TxHash:          d.TxHash,
+		LogIndex:        d.LogIndex,
+		BlockNumber:     blockNumber,
+		BlockHash:       d.BlockHash,
+		ContractAddress: d.ContractAddress,
+		EventSignature:  d.EventSignature,
+		EventName:       d.EventName,
+		Stream:          d.Stream,
+		Topics:          d.Topics,
+		Data:            d.Data,
+		Error:           d.Error,
+		Attempts:        d.Attempts,
+		FirstFailedAt:   d.FirstFailedAt,
+		LastFailedAt:    d.LastFailedAt,
+		ReprocessedAt:   d.ReprocessedAt,
+	}
+}
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -149,6 +151,10 @@ func (r *EventRepository) StoreRawEvent(ctx context.Context, event *domain.RawEv
 		event.ObservedAt = time.Now()
 	}
 
+	if event.State == "" {
+		event.State = domain.RawEventDecoded
+	}
+
 	// Convert big.Int to string for MongoDB storage
 	eventDoc := r.eventToDocument(event)
 
@@ -169,10 +175,15 @@ func (r *EventRepository) StoreRawEvent(ctx context.Context, event *domain.RawEv
 		return fmt.Errorf("failed to store event: %w", err)
 	}
 
-	// Check if this was a duplicate (no upsert occurred)
-	if result.UpsertedCount == 0 && result.ModifiedCount == 0 {
-		// Event already exists, this is not an error for idempotency
-		return nil
+	// A log stored as a decode failure is replaced once it decodes; any other duplicate is
+	// left as is for idempotency
+	if result.UpsertedCount == 0 && event.State == domain.RawEventDecoded {
+		delete(eventDoc, "_id")
+		delete(eventDoc, "created_at")
+		filter["state"] = domain.RawEventDecodeFailed
+		if _, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": eventDoc}); err != nil {
+			return fmt.Errorf("failed to replace decode failure: %w", err)
+		}
 	}
 
 	return nil
@@ -270,6 +281,101 @@ func (r *EventRepository) GetEventsByContract(ctx context.Context, chainID, cont
 	return events, nil
 }
 
+// ListCollectionAddresses returns the addresses of collections created on a chain,
+// taken from the parsed CollectionCreated events stored by the indexer
+func (r *EventRepository) ListCollectionAddresses(ctx context.Context, chainID string) ([]string, error) {
+	filter := bson.M{
+		"chain_id":   chainID,
+		"event_name": "CollectionCreated",
+	}
+
+	findOpts := options.Find().SetProjection(bson.M{"parsed_json": 1})
+	cursor, err := r.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find collection created events: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	seen := make(map[string]struct{})
+	var addresses []string
+	for cursor.Next(ctx) {
+		var doc struct {
+			ParsedJSON string `bson:"parsed_json"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode event: %w", err)
+		}
+
+		var parsed domain.CollectionCreatedEvent
+		if err := json.Unmarshal([]byte(doc.ParsedJSON), &parsed); err != nil || parsed.CollectionAddress == "" {
+			continue
+		}
+		addr := strings.ToLower(parsed.CollectionAddress)
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		addresses = append(addresses, addr)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return addresses, nil
+}
+
+// ListPendingEvents returns stored events that have not reached requiredConfirmations.
+// Events marked reorged (-1) and decode failures are left out.
+func (r *EventRepository) ListPendingEvents(ctx context.Context, chainID, eventName string, requiredConfirmations int) ([]*domain.RawEvent, error) {
+	filter := bson.M{
+		"chain_id":      chainID,
+		"event_name":    eventName,
+		"confirmations": bson.M{"$gte": 0, "$lt": requiredConfirmations},
+		"state":         bson.M{"$ne": domain.RawEventDecodeFailed},
+	}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending events: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []*domain.RawEvent
+	for cursor.Next(ctx) {
+		var eventDoc bson.M
+		if err := cursor.Decode(&eventDoc); err != nil {
+			return nil, fmt.Errorf("failed to decode event: %w", err)
+		}
+
+		event, err := r.documentToEvent(eventDoc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert document to event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return events, nil
+}
+
+// UpdateConfirmations records the current depth of a stored event
+func (r *EventRepository) UpdateConfirmations(ctx context.Context, chainID, txHash string, logIndex, confirmations int) error {
+	filter := bson.M{
+		"chain_id":  chainID,
+		"tx_hash":   txHash,
+		"log_index": logIndex,
+	}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"confirmations": confirmations}}); err != nil {
+		return fmt.Errorf("failed to update confirmations: %w", err)
+	}
+	return nil
+}
+
 // EventToDocument converts a domain event to a MongoDB document
 // Exported for testing purposes
 func (r *EventRepository) EventToDocument(event *domain.RawEvent) bson.M {
@@ -291,9 +397,13 @@ func (r *EventRepository) eventToDocument(event *domain.RawEvent) bson.M {
 		"raw_data":         event.RawData,
 		"parsed_json":      event.ParsedJSON,
 		"confirmations":    event.Confirmations,
+		"state":            event.State,
 		"observed_at":      event.ObservedAt,
 		"created_at":       event.CreatedAt,
 	}
+	if event.DecodeError != "" {
+		doc["decode_error"] = event.DecodeError
+	}
 
 	return doc
 }
@@ -367,6 +477,14 @@ func (r *EventRepository) documentToEvent(doc bson.M) (*domain.RawEvent, error)
 		event.Confirmations = int(confirmations)
 	}
 
+	if state, ok := doc["state"].(string); ok {
+		event.State = state
+	}
+
+	if decodeError, ok := doc["decode_error"].(string); ok {
+		event.DecodeError = decodeError
+	}
+
 	if observedAt, ok := doc["observed_at"].(time.Time); ok {
 		event.ObservedAt = observedAt
 	}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// SequenceRepository stamps published events with per-aggregate sequences. Each event's stamp
+// is kept, so an event published again after a failed publish or a backfill keeps its place.
+type SequenceRepository struct {
+	db *postgres.Postgres
+}
+
+// NewSequenceRepository creates a PostgreSQL event sequencer
+func NewSequenceRepository(client *postgres.Postgres) *SequenceRepository {
+	repo := &SequenceRepository{db: client}
+
+	if err := repo.initSchema(); err != nil {
+		fmt.Printf("Warning: failed to initialize event sequence schema: %v\n", err)
+	}
+
+	return repo
+}
+
+var _ domain.EventSequencer = (*SequenceRepository)(nil)
+
+func (r *SequenceRepository) initSchema() error {
+	query := `
+		CREATE TABLE IF NOT EXISTS event_sequences (
+			aggregate_id TEXT PRIMARY KEY,
+			last_sequence BIGINT NOT NULL,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+		);
+
+		CREATE TABLE IF NOT EXISTS event_sequence_stamps (
+			event_id TEXT PRIMARY KEY,
+			aggregate_id TEXT NOT NULL,
+			sequence BIGINT NOT NULL,
+			stamped_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			UNIQUE (aggregate_id, sequence)
+		);
+	`
+	if _, err := r.db.GetClient().ExecContext(context.Background(), query); err != nil {
+		return fmt.Errorf("failed to create event sequence tables: %w", err)
+	}
+	return nil
+}
+
+// NextSequence returns eventID's stamp in aggregateID, stamping the aggregate's next
+// sequence the first time. The counter row is locked until the stamp is stored, so two
+// publishers of one aggregate never share a sequence.
+func (r *SequenceRepository) NextSequence(ctx context.Context, aggregateID, eventID string) (uint64, error) {
+	tx, err := r.db.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var sequence uint64
+	err = tx.QueryRowContext(ctx,
+		`SELECT sequence FROM event_sequence_stamps WHERE event_id = $1`, eventID,
+	).Scan(&sequence)
+	if err == nil {
+		return sequence, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("failed to look up sequence of %s: %w", eventID, err)
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO event_sequences (aggregate_id, last_sequence, updated_at)
+		VALUES ($1, 1, NOW())
+		ON CONFLICT (aggregate_id) DO UPDATE SET
+			last_sequence = event_sequences.last_sequence + 1,
+			updated_at = NOW()
+		RETURNING last_sequence
+	`, aggregateID).Scan(&sequence)
+	if err != nil {
+		return 0, fmt.Errorf("failed to advance sequence of %s: %w", aggregateID, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO event_sequence_stamps (event_id, aggregate_id, sequence) VALUES ($1, $2, $3)`,
+		eventID, aggregateID, sequence)
+	if err != nil {
+		return 0, fmt.Errorf("failed to stamp %s: %w", eventID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit sequence of %s: %w", eventID, err)
+	}
+	return sequence, nil
+}
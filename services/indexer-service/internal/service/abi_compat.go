@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// HandleAbiChanged warns when a registry ABI replacement drops an event signature
+// the indexer decodes in code, then registers decoders for the events the new ABI adds.
+// A failed ABI reload is returned so the event is redelivered.
+func (s *IndexerService) HandleAbiChanged(ctx context.Context, event *contracts.AbiChangedEvent) error {
+	for _, line := range AbiCompatWarnings(event) {
+		log.Print(line)
+	}
+
+	chainID := strings.ReplaceAll(event.ChainID, ":", "-")
+	if s.factoryContracts[chainID] == "" || len(event.Diff.AddedEvents) == 0 {
+		return nil
+	}
+	return s.loadChainAbis(ctx, chainID)
+}
+
+// compiledSignatures are the events decoded without any registry ABI
+var compiledSignatures = blockchain.DefaultDecoders().Signatures()
+
+// AbiCompatWarnings returns one log line per compiled-in decoded event the change breaks
+func AbiCompatWarnings(event *contracts.AbiChangedEvent) []string {
+	var lines []string
+	for _, b := range event.Diff.Breaks(compiledSignatures, nil) {
+		line := "abi_compat|service=indexer|chain_id=" + event.ChainID +
+			"|address=" + event.Address +
+			"|contract=" + event.Name +
+			"|registry_version=" + event.RegistryVersion +
+			"|removed=" + b.Removed
+		if b.Replacement != "" {
+			line += "|replacement=" + b.Replacement
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// defaultRequiredConfirmations applies while a chain has no confirmation count in the registry
+const defaultRequiredConfirmations = 12
+
+// LoadChains fetches the registry configuration of every chain with a factory contract
+// and connects to its RPC endpoints. Any failure aborts startup, except for registry
+// ABIs: without them the compiled-in decoders still run.
+func (s *IndexerService) LoadChains(ctx context.Context) error {
+	for chainID, factoryAddress := range s.factoryContracts {
+		if factoryAddress == "" {
+			continue
+		}
+		if err := s.reloadChain(ctx, chainID); err != nil {
+			return err
+		}
+		if err := s.loadChainAbis(ctx, chainID); err != nil {
+			log.Printf("Registry ABIs not loaded: %v", err)
+		}
+		if err := s.followImportedCollections(ctx, chainID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleRegistryChanged refetches a chain's endpoints and params after the registry
+// version moved. Failures are returned so the event is redelivered; the previous
+// client keeps indexing in the meantime.
+func (s *IndexerService) HandleRegistryChanged(ctx context.Context, event *contracts.RegistryChangedEvent) error {
+	chainID := strings.ReplaceAll(event.ChainID, ":", "-")
+	if s.factoryContracts[chainID] == "" {
+		return nil
+	}
+
+	if err := s.reloadChain(ctx, chainID); err != nil {
+		return fmt.Errorf("reload chain %s at registry version %s: %w", chainID, event.RegistryVersion, err)
+	}
+	// Contracts registered since the last load bring their events along
+	if err := s.loadChainAbis(ctx, chainID); err != nil {
+		log.Printf("Registry ABIs not reloaded: %v", err)
+	}
+	// An import bumps the registry version, which is how the indexer learns about it
+	if err := s.followImportedCollections(ctx, chainID); err != nil {
+		return fmt.Errorf("follow imported collections on %s: %w", chainID, err)
+	}
+	return nil
+}
+
+// followImportedCollections seeds a checkpoint at the deployment block of every imported
+// collection the indexer doesn't follow yet, which backfills its history from there
+func (s *IndexerService) followImportedCollections(ctx context.Context, chainID string) error {
+	cfg, ok := s.chainConfig(chainID)
+	if !ok || len(cfg.ImportedCollections) == 0 {
+		return nil
+	}
+
+	known, err := s.knownCollections(ctx, chainID)
+	if err != nil {
+		return err
+	}
+	followed := make(map[string]struct{}, len(known))
+	for _, addr := range known {
+		followed[addr] = struct{}{}
+	}
+
+	for _, imported := range cfg.ImportedCollections {
+		if _, ok := followed[imported.Address]; ok {
+			continue
+		}
+		if err := s.followCollection(ctx, chainID, imported.Address, big.NewInt(imported.StartBlock)); err != nil {
+			return err
+		}
+		log.Printf("Following imported collection %s on chain %s from block %d", imported.Address, chainID, imported.StartBlock)
+	}
+	return nil
+}
+
+// reloadChain stores the chain's registry configuration, reconnecting only when the
+// endpoint list changed. The replaced client is retired rather than closed: the poll in
+// flight may still be using it, so the chain's loop closes it once that poll is done.
+func (s *IndexerService) reloadChain(ctx context.Context, chainID string) error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	cfg, err := s.chainSource.GetChainConfig(ctx, chainID)
+	if err != nil {
+		return err
+	}
+
+	s.chainsMu.RLock()
+	current, hasClient := s.blockchainClients[chainID]
+	previous := s.chainConfigs[chainID]
+	s.chainsMu.RUnlock()
+
+	if hasClient && previous != nil && sameURLs(previous.RPCURLs, cfg.RPCURLs) {
+		s.chainsMu.Lock()
+		s.chainConfigs[chainID] = cfg
+		s.chainsMu.Unlock()
+		return nil
+	}
+
+	client, err := blockchain.NewClient(chainID, cfg.RPCURLs)
+	if err != nil {
+		return err
+	}
+
+	s.chainsMu.Lock()
+	s.blockchainClients[chainID] = client
+	s.chainConfigs[chainID] = cfg
+	if hasClient {
+		s.retiredClients[chainID] = append(s.retiredClients[chainID], current)
+	}
+	s.chainsMu.Unlock()
+
+	log.Printf("Chain %s configured from registry version %s: rpc=%s confirmations=%d reorg_depth=%d",
+		chainID, cfg.RegistryVersion, client.RPCURL(), cfg.RequiredConfirmations, cfg.ReorgDepth)
+	return nil
+}
+
+// chainClient returns the client currently serving a chain
+func (s *IndexerService) chainClient(chainID string) (*blockchain.Client, bool) {
+	s.chainsMu.RLock()
+	defer s.chainsMu.RUnlock()
+	client, ok := s.blockchainClients[chainID]
+	return client, ok
+}
+
+// closeRetiredClients closes the clients replaced since the chain's last poll. It runs
+// between polls, once the pipeline has picked up the current client.
+func (s *IndexerService) closeRetiredClients(chainID string) {
+	s.chainsMu.Lock()
+	retired := s.retiredClients[chainID]
+	delete(s.retiredClients, chainID)
+	s.chainsMu.Unlock()
+
+	for _, client := range retired {
+		client.Close()
+	}
+}
+
+// chainConfig returns the registry configuration currently applied to a chain
+func (s *IndexerService) chainConfig(chainID string) (*domain.ChainConfig, bool) {
+	s.chainsMu.RLock()
+	defer s.chainsMu.RUnlock()
+	cfg, ok := s.chainConfigs[chainID]
+	return cfg, ok
+}
+
+// factoryAddresses returns the chain's factories tagged in the registry, falling back to
+// the configured factory while none is
+func (s *IndexerService) factoryAddresses(chainID, configured string) []string {
+	if cfg, ok := s.chainConfig(chainID); ok && len(cfg.Factories) > 0 {
+		return cfg.Factories
+	}
+	return []string{configured}
+}
+
+// auctionHouse returns the chain's auction house tagged in the registry, falling back to
+// the configured one
+func (s *IndexerService) auctionHouse(chainID string) string {
+	if cfg, ok := s.chainConfig(chainID); ok && cfg.AuctionHouse != "" {
+		return cfg.AuctionHouse
+	}
+	return s.auctionContracts[chainID]
+}
+
+// chainClients returns a snapshot of every connected chain
+func (s *IndexerService) chainClients() map[string]*blockchain.Client {
+	s.chainsMu.RLock()
+	defer s.chainsMu.RUnlock()
+	clients := make(map[string]*blockchain.Client, len(s.blockchainClients))
+	for chainID, client := range s.blockchainClients {
+		clients[chainID] = client
+	}
+	return clients
+}
+
+func sameURLs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// NextChainHead builds a chain's head from a poll's latest block. finalized is what the
+// chain's "finalized" tag points at, nil on chains without one, which fall back to the
+// latest block less reorgDepth. It reports whether the latest block moved past prev.
+func NextChainHead(prev *contracts.ChainHead, chainID string, latest *domain.BlockInfo, finalized *big.Int, reorgDepth int, now time.Time) (contracts.ChainHead, bool) {
+	head := contracts.ChainHead{
+		ChainID:         strings.Replace(chainID, "-", ":", 1),
+		LatestBlock:     latest.Number.Uint64(),
+		LatestBlockHash: latest.Hash,
+		LatestBlockTime: latest.Timestamp,
+		AdvancedAt:      now,
+	}
+	if finalized != nil {
+		head.FinalizedBlock = finalized.Uint64()
+		head.FinalizedByTag = true
+	} else if depth := uint64(reorgDepth); head.LatestBlock > depth {
+		head.FinalizedBlock = head.LatestBlock - depth
+	}
+
+	if prev != nil && head.LatestBlock <= prev.LatestBlock {
+		return *prev, false
+	}
+	return head, true
+}
+
+// reportChainHead publishes chain.head_advanced when a poll finds the chain's latest
+// block moved. Failures are logged; they never hold up indexing.
+func (s *IndexerService) reportChainHead(ctx context.Context, chainID string, latest *domain.BlockInfo, client *blockchain.Client) {
+	// Chains without the tag answer with an error and fall back to the reorg depth
+	finalized, _ := client.GetFinalizedBlock(ctx)
+	reorgDepth := s.getRequiredConfirmations(chainID)
+	if cfg, ok := s.chainConfig(chainID); ok && cfg.ReorgDepth > 0 {
+		reorgDepth = cfg.ReorgDepth
+	}
+
+	s.headsMu.Lock()
+	prev, seen := s.heads[chainID]
+	var prevHead *contracts.ChainHead
+	if seen {
+		prevHead = &prev
+	}
+	head, advanced := NextChainHead(prevHead, chainID, latest, finalized, reorgDepth, time.Now())
+	s.heads[chainID] = head
+	s.headsMu.Unlock()
+
+	if !advanced {
+		return
+	}
+	if err := s.publisher.PublishChainHeadAdvanced(ctx, chainID, head); err != nil {
+		fmt.Printf("Failed to publish head of chain %s: %v\n", chainID, err)
+	}
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+)
+
+// reorgedConfirmations marks a stored event whose block is no longer canonical
+const reorgedConfirmations = -1
+
+// refreshPendingCollections publishes the new depth of every CollectionCreated log still
+// below the chain's required confirmations. Once a log reaches that depth its block hash
+// is checked against the chain; a mismatch is published as reorged and never followed again.
+func (s *IndexerService) refreshPendingCollections(ctx context.Context, chainID string, latestBlock *big.Int, client *blockchain.Client) error {
+	required := s.getRequiredConfirmations(chainID)
+	pending, err := s.eventRepo.ListPendingEvents(ctx, chainID, "CollectionCreated", required)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range pending {
+		if event.BlockNumber == nil {
+			continue
+		}
+		confirmations := int(new(big.Int).Sub(latestBlock, event.BlockNumber).Int64()) + 1
+		if confirmations <= event.Confirmations {
+			continue
+		}
+
+		var parsed domain.CollectionCreatedEvent
+		if err := json.Unmarshal([]byte(event.ParsedJSON), &parsed); err != nil || parsed.CollectionAddress == "" {
+			continue
+		}
+
+		reorged := false
+		if confirmations >= required {
+			block, err := client.GetBlockByNumber(ctx, event.BlockNumber)
+			if err != nil {
+				return fmt.Errorf("failed to get block %s: %w", event.BlockNumber, err)
+			}
+			reorged = !strings.EqualFold(block.Hash, event.BlockHash)
+		}
+
+		event.Confirmations = confirmations
+		event.RequiredConfirmations = required
+		if err := s.publisher.PublishCollectionConfirmationsEvent(ctx, chainID, event, parsed.CollectionAddress, reorged); err != nil {
+			return fmt.Errorf("failed to publish confirmations for %s: %w", parsed.CollectionAddress, err)
+		}
+
+		stored := confirmations
+		if reorged {
+			stored = reorgedConfirmations
+		}
+		if err := s.eventRepo.UpdateConfirmations(ctx, chainID, event.TxHash, event.LogIndex, stored); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+)
+
+// Stream kinds; every followed contract belongs to one
+const (
+	StreamFactory    = "factory"
+	StreamCollection = "collection"
+	StreamAuction    = "auction"
+)
+
+const (
+	// MaxRangesPerStream bounds the MaxBlockBatchSize ranges one stream processes per poll,
+	// so a contract backfilling from far behind cannot hold back the others
+	MaxRangesPerStream = 10
+
+	// LaggingBlocks is the lag past which a contract is reported as lagging
+	LaggingBlocks = 100
+)
+
+// ContractStream is a group of contracts of one kind at the same checkpoint, fetched together
+type ContractStream struct {
+	Kind      string
+	Contracts []string
+	LastBlock *big.Int
+}
+
+// PlanStreams groups contracts of one kind by checkpoint, so contracts at the same block
+// share a getLogs call while a contract backfilling from behind gets its own stream.
+// Contracts without a checkpoint start from start. Streams closest to the head come first.
+func PlanStreams(kind string, contracts []string, checkpoints map[string]*big.Int, start *big.Int) []*ContractStream {
+	byBlock := make(map[string]*ContractStream)
+	var streams []*ContractStream
+	for _, contract := range contracts {
+		contract = strings.ToLower(contract)
+		last, ok := checkpoints[contract]
+		if !ok {
+			last = start
+		}
+		stream, ok := byBlock[last.String()]
+		if !ok {
+			stream = &ContractStream{Kind: kind, LastBlock: last}
+			byBlock[last.String()] = stream
+			streams = append(streams, stream)
+		}
+		stream.Contracts = append(stream.Contracts, contract)
+	}
+
+	sortStreams(streams)
+	return streams
+}
+
+func sortStreams(streams []*ContractStream) {
+	sort.SliceStable(streams, func(i, j int) bool {
+		return streams[i].LastBlock.Cmp(streams[j].LastBlock) > 0
+	})
+}
+
+// ContractLag is the progress of one contract against the chain head
+type ContractLag struct {
+	Contract  string
+	LastBlock *big.Int
+	Lag       *big.Int
+	UpdatedAt time.Time
+}
+
+// LagReport aggregates per-contract progress on a chain
+type LagReport struct {
+	Contracts []ContractLag
+	// SlowestBlock and MaxLag come from the contract furthest behind
+	SlowestBlock *big.Int
+	MaxLag       *big.Int
+	Lagging      int // contracts more than LaggingBlocks behind
+}
+
+// AggregateLag reports every contract checkpoint against latestBlock. The chain-level row
+// only counts when no contract has its own checkpoint yet.
+func AggregateLag(latestBlock *big.Int, checkpoints []*domain.Checkpoint) LagReport {
+	report := LagReport{SlowestBlock: big.NewInt(0), MaxLag: big.NewInt(0)}
+
+	var counted []*domain.Checkpoint
+	for _, cp := range checkpoints {
+		if cp.ContractAddress != "" {
+			counted = append(counted, cp)
+		}
+	}
+	if len(counted) == 0 {
+		counted = checkpoints
+	}
+
+	for i, cp := range counted {
+		lag := new(big.Int).Sub(latestBlock, cp.LastBlock)
+		if lag.Sign() < 0 {
+			lag.SetInt64(0)
+		}
+		report.Contracts = append(report.Contracts, ContractLag{
+			Contract:  cp.ContractAddress,
+			LastBlock: cp.LastBlock,
+			Lag:       lag,
+			UpdatedAt: cp.UpdatedAt,
+		})
+		if i == 0 || lag.Cmp(report.MaxLag) > 0 {
+			report.MaxLag = lag
+			report.SlowestBlock = cp.LastBlock
+		}
+		if lag.Cmp(big.NewInt(LaggingBlocks)) > 0 {
+			report.Lagging++
+		}
+	}
+	return report
+}
+
+// loadCheckpoints returns the last block per contract and the chain-level block contracts
+// without a checkpoint start from
+func (s *IndexerService) loadCheckpoints(ctx context.Context, chainID string) (map[string]*big.Int, *big.Int, error) {
+	checkpoints, err := s.checkpointRepo.ListCheckpoints(ctx, chainID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get checkpoints: %w", err)
+	}
+
+	start := big.NewInt(0)
+	byContract := make(map[string]*big.Int, len(checkpoints))
+	for _, cp := range checkpoints {
+		if cp.ContractAddress == "" {
+			start = cp.LastBlock
+			continue
+		}
+		byContract[strings.ToLower(cp.ContractAddress)] = cp.LastBlock
+	}
+	return byContract, start, nil
+}
+
+// runStreams advances each stream up to MaxRangesPerStream ranges towards latestBlock. A
+// failing stream keeps its checkpoint and the remaining streams still run.
+func (s *IndexerService) runStreams(ctx context.Context, chainID string, latestBlock *big.Int, client *blockchain.Client, streams []*ContractStream) error {
+	var errs []error
+	for _, stream := range streams {
+		select {
+		case <-s.stopChan:
+			return errors.Join(errs...)
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		from := new(big.Int).Add(stream.LastBlock, big.NewInt(1))
+		if from.Cmp(latestBlock) > 0 {
+			continue
+		}
+		to := new(big.Int).Add(from, big.NewInt(MaxRangesPerStream*MaxBlockBatchSize-1))
+		if to.Cmp(latestBlock) > 0 {
+			to = latestBlock
+		}
+
+		err := s.runPipeline(ctx, chainID, stream.Contracts, from, to,
+			func(ctx context.Context, from, to *big.Int) (*fetchedRange, error) {
+				return s.fetchStreamRange(ctx, chainID, stream, from, to, client)
+			},
+			func(ctx context.Context, fetched *fetchedRange, emit func(*publishJob) error) error {
+				return s.parseRange(ctx, chainID, fetched, client, emit)
+			},
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s stream at block %s: %w", stream.Kind, from.String(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// fetchStreamRange collects the logs a stream follows in [from, to] plus the hash of the last block
+func (s *IndexerService) fetchStreamRange(ctx context.Context, chainID string, stream *ContractStream, fromBlock, toBlock *big.Int, client *blockchain.Client) (*fetchedRange, error) {
+	fmt.Printf("Processing blocks %s to %s for %d %s contract(s) on chain %s\n",
+		fromBlock.String(), toBlock.String(), len(stream.Contracts), stream.Kind, chainID)
+
+	fetched := &fetchedRange{from: fromBlock, to: toBlock}
+	var err error
+	switch stream.Kind {
+	case StreamFactory:
+		fetched.factoryLogs, err = client.GetLogs(ctx, &domain.LogFilter{
+			FromBlock: fromBlock,
+			ToBlock:   toBlock,
+			Addresses: stream.Contracts,
+			Topics:    []string{CollectionCreatedSignature},
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to get logs for blocks %s-%s: %w", fromBlock.String(), toBlock.String(), err)
+		}
+	case StreamCollection:
+		// Follow owner-only changes and other decoded events on collections deployed by the factory
+		fetched.collectionLogs, err = s.fetchCollectionLogs(ctx, stream.Contracts, fromBlock, toBlock, client)
+	case StreamAuction:
+		// Auctions, bids and settlements on the chain's AuctionHouse
+		fetched.auctionLogs, err = s.fetchAuctionLogs(ctx, stream.Contracts, fromBlock, toBlock, client)
+	default:
+		err = fmt.Errorf("unknown stream kind %s", stream.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blockInfo, err := client.GetBlockByNumber(ctx, toBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block info for %s: %w", toBlock.String(), err)
+	}
+	fetched.toBlockHash = blockInfo.Hash
+
+	return fetched, nil
+}
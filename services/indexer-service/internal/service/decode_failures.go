@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+)
+
+// MaxReprocessBatch bounds the dead letters one reprocess call retries
+const MaxReprocessBatch = 500
+
+// decodeFailure is returned by the prepare functions for a log that was fetched but whose
+// topics or data no decoder accepts
+type decodeFailure struct {
+	eventName     string // empty when no decoder matched the topic
+	confirmations int
+	err           error
+}
+
+func (f *decodeFailure) Error() string {
+	if f.eventName == "" {
+		return fmt.Sprintf("failed to decode log: %v", f.err)
+	}
+	return fmt.Sprintf("failed to decode %s log: %v", f.eventName, f.err)
+}
+
+func (f *decodeFailure) Unwrap() error {
+	return f.err
+}
+
+// DecodeFailureCounter counts decode failures per chain, contract and event signature since
+// the indexer started
+type DecodeFailureCounter struct {
+	mu     sync.Mutex
+	counts map[[3]string]int64
+}
+
+// NewDecodeFailureCounter creates an empty counter
+func NewDecodeFailureCounter() *DecodeFailureCounter {
+	return &DecodeFailureCounter{counts: make(map[[3]string]int64)}
+}
+
+// Inc counts one failure of an event signature on a contract
+func (c *DecodeFailureCounter) Inc(chainID, contract, signature string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[[3]string{chainID, strings.ToLower(contract), strings.ToLower(signature)}]++
+}
+
+// Snapshot returns the counts ordered by chain, contract and signature
+func (c *DecodeFailureCounter) Snapshot() []domain.DecodeFailureCount {
+	c.mu.Lock()
+	out := make([]domain.DecodeFailureCount, 0, len(c.counts))
+	for key, count := range c.counts {
+		out = append(out, domain.DecodeFailureCount{ChainID: key[0], ContractAddress: key[1], EventSignature: key[2], Count: count})
+	}
+	c.mu.Unlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ChainID != out[j].ChainID {
+			return out[i].ChainID < out[j].ChainID
+		}
+		if out[i].ContractAddress != out[j].ContractAddress {
+			return out[i].ContractAddress < out[j].ContractAddress
+		}
+		return out[i].EventSignature < out[j].EventSignature
+	})
+	return out
+}
+
+// SetDeadLetters keeps logs that fail to decode in a dead-letter store so they can be
+// reprocessed; without it they are only stored as failed raw events
+func (s *IndexerService) SetDeadLetters(repo domain.DeadLetterRepository) {
+	s.deadLetters = repo
+}
+
+// DecodeFailureCounts returns the decode failures counted since the indexer started
+func (s *IndexerService) DecodeFailureCounts() []domain.DecodeFailureCount {
+	return s.decodeFailures.Snapshot()
+}
+
+// PendingDeadLetters counts the dead letters awaiting reprocessing
+func (s *IndexerService) PendingDeadLetters(ctx context.Context) ([]domain.DecodeFailureCount, error) {
+	if s.deadLetters == nil {
+		return nil, nil
+	}
+	return s.deadLetters.CountPending(ctx)
+}
+
+// deadLetter records a log that failed to decode: it is counted, stored as a failed raw
+// event so the log is not lost, and kept with its raw topics and data for reprocessing
+func (s *IndexerService) deadLetter(ctx context.Context, chainID, stream string, log *domain.Log, failure *decodeFailure) error {
+	signature := ""
+	if len(log.Topics) > 0 {
+		signature = strings.ToLower(log.Topics[0])
+	}
+	s.decodeFailures.Inc(chainID, log.Address, signature)
+	fmt.Printf("Dead-lettering log %s:%d of %s on chain %s: %v\n", log.TxHash, log.LogIndex, log.Address, chainID, failure)
+
+	now := time.Now()
+	rawEvent := &domain.RawEvent{
+		ChainID:         chainID,
+		TxHash:          log.TxHash,
+		LogIndex:        log.LogIndex,
+		BlockNumber:     log.BlockNumber,
+		BlockHash:       log.BlockHash,
+		ContractAddress: log.Address,
+		EventName:       failure.eventName,
+		EventSignature:  signature,
+		RawData: map[string]interface{}{
+			"topics": log.Topics,
+			"data":   log.Data,
+		},
+		Confirmations: failure.confirmations,
+		State:         domain.RawEventDecodeFailed,
+		DecodeError:   failure.err.Error(),
+		ObservedAt:    now,
+	}
+	if err := s.eventRepo.StoreRawEvent(ctx, rawEvent); err != nil {
+		return fmt.Errorf("failed to store decode failure: %w", err)
+	}
+
+	if s.deadLetters == nil {
+		return nil
+	}
+	return s.deadLetters.RecordDeadLetter(ctx, &domain.DeadLetter{
+		ID:              rawEvent.ID,
+		ChainID:         chainID,
+		TxHash:          log.TxHash,
+		LogIndex:        log.LogIndex,
+		BlockNumber:     log.BlockNumber,
+		BlockHash:       log.BlockHash,
+		ContractAddress: strings.ToLower(log.Address),
+		EventSignature:  signature,
+		EventName:       failure.eventName,
+		Stream:          stream,
+		Topics:          log.Topics,
+		Data:            log.Data,
+		Error:           failure.err.Error(),
+		LastFailedAt:    now,
+	})
+}
+
+// ReprocessDeadLetters runs pending dead letters through the current decoders, for use once
+// a decoder fix ships. Logs that decode are stored over their failed raw event and published
+// like freshly indexed ones; logs that still fail stay pending with another attempt counted.
+func (s *IndexerService) ReprocessDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) (domain.ReprocessResult, error) {
+	var result domain.ReprocessResult
+	if s.deadLetters == nil {
+		return result, fmt.Errorf("dead letters are not enabled")
+	}
+	if filter.Limit <= 0 || filter.Limit > MaxReprocessBatch {
+		filter.Limit = MaxReprocessBatch
+	}
+	filter.ContractAddress = strings.ToLower(filter.ContractAddress)
+	filter.EventSignature = strings.ToLower(filter.EventSignature)
+
+	letters, err := s.deadLetters.ListDeadLetters(ctx, filter)
+	if err != nil {
+		return result, err
+	}
+
+	for _, letter := range letters {
+		if err := s.reprocessDeadLetter(ctx, letter); err != nil {
+			fmt.Printf("Failed to reprocess log %s:%d on chain %s: %v\n", letter.TxHash, letter.LogIndex, letter.ChainID, err)
+			result.Failed++
+			continue
+		}
+		result.Reprocessed++
+	}
+
+	fmt.Printf("Reprocessed %d dead letters, %d still failing\n", result.Reprocessed, result.Failed)
+	return result, nil
+}
+
+func (s *IndexerService) reprocessDeadLetter(ctx context.Context, letter *domain.DeadLetter) error {
+	client, ok := s.chainClient(letter.ChainID)
+	if !ok {
+		return fmt.Errorf("blockchain client not found for chain %s", letter.ChainID)
+	}
+
+	log := &domain.Log{
+		Address:     letter.ContractAddress,
+		Topics:      letter.Topics,
+		Data:        letter.Data,
+		BlockNumber: letter.BlockNumber,
+		TxHash:      letter.TxHash,
+		LogIndex:    letter.LogIndex,
+		BlockHash:   letter.BlockHash,
+	}
+
+	prepare := s.prepareDecodedLog
+	if letter.Stream == StreamFactory {
+		prepare = s.prepareCollectionCreatedLog
+	}
+
+	job, err := prepare(ctx, letter.ChainID, log, client)
+	var failure *decodeFailure
+	if errors.As(err, &failure) {
+		if recordErr := s.deadLetter(ctx, letter.ChainID, letter.Stream, log, failure); recordErr != nil {
+			return errors.Join(err, recordErr)
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if job != nil {
+		if err := s.publishWithRetry(ctx, job); err != nil {
+			return err
+		}
+	}
+	return s.deadLetters.MarkReprocessed(ctx, letter.ID, time.Now())
+}
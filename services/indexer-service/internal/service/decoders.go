@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+)
+
+// SetAbiSource makes the indexer decode the events of the ABIs held by the chain registry
+// on top of its compiled-in decoders
+func (s *IndexerService) SetAbiSource(source domain.ContractAbiSource) {
+	s.abiSource = source
+}
+
+// Decoders returns the decoder registry, for registering decoders in code before Start
+func (s *IndexerService) Decoders() *blockchain.DecoderRegistry {
+	return s.decoders
+}
+
+// loadChainAbis registers a decoder for every new event of a chain's registry ABIs. The
+// AuctionHouse ABI feeds auction decoders and ERC-721/1155 templates feed collection
+// decoders; the indexer does not fetch logs of other contracts, so their ABIs are skipped.
+// Decoders are keyed by topic alone and apply to every chain once registered.
+func (s *IndexerService) loadChainAbis(ctx context.Context, chainID string) error {
+	if s.abiSource == nil {
+		return nil
+	}
+
+	abis, err := s.abiSource.ListContractAbis(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("list contract abis for chain %s: %w", chainID, err)
+	}
+
+	auctionHouse := s.auctionHouse(chainID)
+	for _, contract := range abis {
+		var source string
+		switch {
+		case auctionHouse != "" && strings.EqualFold(contract.Address, auctionHouse):
+			source = blockchain.DecoderSourceAuction
+		case contract.Standard == "erc721" || contract.Standard == "erc1155":
+			source = blockchain.DecoderSourceCollection
+		default:
+			continue
+		}
+
+		added, err := s.decoders.RegisterABI(source, contract.AbiJSON)
+		if err != nil {
+			log.Printf("Skipping ABI of %s (%s) on chain %s: %v", contract.Name, contract.Address, chainID, err)
+			continue
+		}
+		if added > 0 {
+			log.Printf("Registered %d event decoders from the %s ABI on chain %s", added, contract.Name, chainID)
+		}
+	}
+	return nil
+}
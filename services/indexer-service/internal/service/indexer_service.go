@@ -3,13 +3,17 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 )
 
 const (
@@ -23,12 +27,40 @@ const (
 )
 
 type IndexerService struct {
-	eventRepo         domain.EventRepository
-	checkpointRepo    domain.CheckpointRepository
-	publisher         domain.EventPublisher
+	eventRepo        domain.EventRepository
+	checkpointRepo   domain.CheckpointRepository
+	publisher        domain.EventPublisher
+	chainSource      domain.ChainConfigSource
+	factoryContracts map[string]string // chainID -> factory contract address, until the registry tags factories
+	auctionContracts map[string]string // chainID -> AuctionHouse contract address, until the registry tags one
+	pollingInterval  time.Duration
+	// retryDelay is the first wait between publish attempts, RetryDelay by default
+	retryDelay time.Duration
+
+	// decoders for every event followed on collections and the AuctionHouse, extended
+	// with the events of registry ABIs
+	decoders  *blockchain.DecoderRegistry
+	abiSource domain.ContractAbiSource
+
+	// logs that failed to decode are counted and, when set, dead-lettered for reprocessing
+	decodeFailures *DecodeFailureCounter
+	deadLetters    domain.DeadLetterRepository
+
+	// clients and registry configuration per chain, swapped on registry.changed; replaced
+	// clients wait in retiredClients until the poll using them is done
 	blockchainClients map[string]*blockchain.Client
-	factoryContracts  map[string]string // chainID -> factory contract address
-	pollingInterval   time.Duration
+	retiredClients    map[string][]*blockchain.Client
+	chainConfigs      map[string]*domain.ChainConfig
+	chainsMu          sync.RWMutex
+	reloadMu          sync.Mutex
+
+	// collections per chain whose admin events are followed, loaded lazily from stored events
+	collections   map[string]map[string]struct{}
+	collectionsMu sync.RWMutex
+
+	// last head reported per chain, so chain.head_advanced only goes out when it moves
+	heads   map[string]contracts.ChainHead
+	headsMu sync.Mutex
 
 	// Control channels
 	stopChan  chan struct{}
@@ -43,19 +75,29 @@ func NewIndexerService(
 	eventRepo domain.EventRepository,
 	checkpointRepo domain.CheckpointRepository,
 	publisher domain.EventPublisher,
-	blockchainClients map[string]*blockchain.Client,
+	chainSource domain.ChainConfigSource,
 	factoryContracts map[string]string,
+	auctionContracts map[string]string,
 	pollingInterval time.Duration,
 ) *IndexerService {
 	return &IndexerService{
 		eventRepo:         eventRepo,
 		checkpointRepo:    checkpointRepo,
 		publisher:         publisher,
-		blockchainClients: blockchainClients,
+		chainSource:       chainSource,
 		factoryContracts:  factoryContracts,
+		auctionContracts:  auctionContracts,
 		pollingInterval:   pollingInterval,
+		retryDelay:        RetryDelay,
+		decoders:          blockchain.DefaultDecoders(),
+		decodeFailures:    NewDecodeFailureCounter(),
+		blockchainClients: make(map[string]*blockchain.Client),
+		retiredClients:    make(map[string][]*blockchain.Client),
+		chainConfigs:      make(map[string]*domain.ChainConfig),
+		collections:       make(map[string]map[string]struct{}),
+		heads:             make(map[string]contracts.ChainHead),
 		stopChan:          make(chan struct{}),
-		errorChan:         make(chan error, len(blockchainClients)),
+		errorChan:         make(chan error, len(factoryContracts)),
 	}
 }
 
@@ -69,10 +111,11 @@ func (s *IndexerService) Start(ctx context.Context) error {
 	s.isRunning = true
 	s.mu.Unlock()
 
-	fmt.Printf("Starting indexer service for %d chains\n", len(s.blockchainClients))
+	clients := s.chainClients()
+	fmt.Printf("Starting indexer service for %d chains\n", len(clients))
 
 	// Start indexing for each configured chain
-	for chainID := range s.blockchainClients {
+	for chainID := range clients {
 		factoryAddress, exists := s.factoryContracts[chainID]
 		if !exists || factoryAddress == "" {
 			fmt.Printf("Warning: No factory contract configured for chain %s, skipping\n", chainID)
@@ -144,7 +187,7 @@ func (s *IndexerService) Stop(ctx context.Context) error {
 
 // IndexChain indexes events for a specific chain
 func (s *IndexerService) IndexChain(ctx context.Context, chainID string) error {
-	client, exists := s.blockchainClients[chainID]
+	client, exists := s.chainClient(chainID)
 	if !exists {
 		return fmt.Errorf("blockchain client not found for chain %s", chainID)
 	}
@@ -159,7 +202,6 @@ func (s *IndexerService) IndexChain(ctx context.Context, chainID string) error {
 
 // indexChainLoop runs the continuous indexing loop for a specific chain
 func (s *IndexerService) indexChainLoop(ctx context.Context, chainID, factoryAddress string) {
-	client := s.blockchainClients[chainID]
 	ticker := time.NewTicker(s.pollingInterval)
 	defer ticker.Stop()
 
@@ -174,109 +216,105 @@ func (s *IndexerService) indexChainLoop(ctx context.Context, chainID, factoryAdd
 			fmt.Printf("Stop signal received for chain %s\n", chainID)
 			return
 		case <-ticker.C:
+			// Looked up every tick so a client swapped on registry.changed takes over
+			client, _ := s.chainClient(chainID)
 			if err := s.processChainEvents(ctx, chainID, factoryAddress, client); err != nil {
+				monitoring.CaptureError(monitoring.WithTags(ctx, monitoring.TagChainID, chainID), err)
 				s.errorChan <- fmt.Errorf("chain %s indexing error: %w", chainID, err)
 				// Continue processing despite errors
 			}
+			// The next poll looks up the current client, so replaced ones are free to go
+			s.closeRetiredClients(chainID)
 		}
 	}
 }
 
-// processChainEvents processes new events for a specific chain
+// processChainEvents runs the fetch → parse → publish pipeline for every followed contract
+// from its own checkpoint up to the chain head. A contract's checkpoint only moves once every
+// event of a block range is published, and contracts behind the others progress on their own.
 func (s *IndexerService) processChainEvents(ctx context.Context, chainID, factoryAddress string, client *blockchain.Client) error {
-	// Get latest checkpoint
-	checkpoint, err := s.checkpointRepo.GetCheckpoint(ctx, chainID)
-	if err != nil {
-		return fmt.Errorf("failed to get checkpoint: %w", err)
-	}
-
 	// Get latest block from blockchain
-	latestBlock, err := client.GetLatestBlock(ctx)
+	latest, err := client.GetLatestHeader(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get latest block: %w", err)
 	}
+	latestBlock := latest.Number
+	s.reportChainHead(ctx, chainID, latest, client)
 
-	// Calculate next block to process
-	nextBlock := new(big.Int).Add(checkpoint.LastBlock, big.NewInt(1))
+	if err := s.refreshPendingCollections(ctx, chainID, latestBlock, client); err != nil {
+		fmt.Printf("Failed to refresh pending collections on chain %s: %v\n", chainID, err)
+	}
 
-	// If we're already at the latest block, nothing to do
-	if nextBlock.Cmp(latestBlock) > 0 {
-		return nil
+	checkpoints, start, err := s.loadCheckpoints(ctx, chainID)
+	if err != nil {
+		return err
 	}
 
-	// Process blocks in batches to avoid overwhelming the system
-	batchSize := int64(MaxBlockBatchSize)
-	fromBlock := nextBlock
+	// The factory runs first so the collections it creates are followed in the same poll
+	factoryErr := s.runStreams(ctx, chainID, latestBlock, client,
+		PlanStreams(StreamFactory, s.factoryAddresses(chainID, factoryAddress), checkpoints, start))
 
-	for fromBlock.Cmp(latestBlock) <= 0 {
-		// Calculate batch end block
-		toBlock := new(big.Int).Add(fromBlock, big.NewInt(batchSize-1))
-		if toBlock.Cmp(latestBlock) > 0 {
-			toBlock = new(big.Int).Set(latestBlock)
-		}
+	collections, err := s.knownCollections(ctx, chainID)
+	if err != nil {
+		return errors.Join(factoryErr, err)
+	}
+	// Reloaded for the checkpoints seeded by new collections
+	if checkpoints, start, err = s.loadCheckpoints(ctx, chainID); err != nil {
+		return errors.Join(factoryErr, err)
+	}
 
-		fmt.Printf("Processing blocks %s to %s for chain %s\n", fromBlock.String(), toBlock.String(), chainID)
+	streams := PlanStreams(StreamCollection, collections, checkpoints, start)
+	if auctionHouse := s.auctionHouse(chainID); auctionHouse != "" {
+		streams = append(streams, PlanStreams(StreamAuction, []string{auctionHouse}, checkpoints, start)...)
+	}
+	sortStreams(streams)
 
-		// Get logs for this batch
-		filter := &domain.LogFilter{
-			FromBlock: fromBlock,
-			ToBlock:   toBlock,
-			Addresses: []string{factoryAddress},
-			Topics:    []string{CollectionCreatedSignature},
-		}
+	return errors.Join(factoryErr, s.runStreams(ctx, chainID, latestBlock, client, streams))
+}
 
-		logs, err := client.GetLogs(ctx, filter)
-		if err != nil {
-			return fmt.Errorf("failed to get logs for blocks %s-%s: %w", fromBlock.String(), toBlock.String(), err)
-		}
+// parseRange stores every log of a fetched range and emits the confirmed ones for publishing.
+// A log that cannot be decoded is dead-lettered for reprocessing; one that cannot be stored
+// is reported and skipped, as before the pipeline.
+func (s *IndexerService) parseRange(ctx context.Context, chainID string, fetched *fetchedRange, client *blockchain.Client, emit func(*publishJob) error) error {
+	steps := []struct {
+		logs    []*domain.Log
+		label   string
+		stream  string
+		prepare func(context.Context, string, *domain.Log, *blockchain.Client) (*publishJob, error)
+	}{
+		{fetched.factoryLogs, "log", StreamFactory, s.prepareCollectionCreatedLog},
+		{fetched.collectionLogs, "collection log", StreamCollection, s.prepareDecodedLog},
+		{fetched.auctionLogs, "auction log", StreamAuction, s.prepareDecodedLog},
+	}
 
-		// Process each log
-		for _, log := range logs {
-			if err := s.processCollectionCreatedLog(ctx, chainID, log, client); err != nil {
-				fmt.Printf("Failed to process log %s:%d: %v\n", log.TxHash, log.LogIndex, err)
-				// Continue processing other logs
+	for _, step := range steps {
+		for _, log := range step.logs {
+			job, err := step.prepare(ctx, chainID, log, client)
+			var failure *decodeFailure
+			if errors.As(err, &failure) {
+				err = s.deadLetter(ctx, chainID, step.stream, log, failure)
+			}
+			if err != nil {
+				fmt.Printf("Failed to process %s %s:%d: %v\n", step.label, log.TxHash, log.LogIndex, err)
+				continue
+			}
+			if job == nil {
+				continue
+			}
+			if err := emit(job); err != nil {
+				return err
 			}
-		}
-
-		// Update checkpoint to the last processed block
-		blockInfo, err := client.GetBlockByNumber(ctx, toBlock)
-		if err != nil {
-			return fmt.Errorf("failed to get block info for %s: %w", toBlock.String(), err)
-		}
-
-		newCheckpoint := &domain.Checkpoint{
-			ChainID:       chainID,
-			LastBlock:     toBlock,
-			LastBlockHash: blockInfo.Hash,
-			UpdatedAt:     time.Now(),
-		}
-
-		if err := s.checkpointRepo.UpdateCheckpoint(ctx, newCheckpoint); err != nil {
-			return fmt.Errorf("failed to update checkpoint: %w", err)
-		}
-
-		// Move to next batch
-		fromBlock = new(big.Int).Add(toBlock, big.NewInt(1))
-
-		// Small delay between batches to avoid overwhelming the node
-		select {
-		case <-time.After(100 * time.Millisecond):
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-s.stopChan:
-			return nil
 		}
 	}
-
 	return nil
 }
 
-// processCollectionCreatedLog processes a single CollectionCreated log
-func (s *IndexerService) processCollectionCreatedLog(ctx context.Context, chainID string, log *domain.Log, client *blockchain.Client) error {
+// prepareCollectionCreatedLog stores a single CollectionCreated log and returns its publish job
+func (s *IndexerService) prepareCollectionCreatedLog(ctx context.Context, chainID string, log *domain.Log, client *blockchain.Client) (*publishJob, error) {
 	// Check confirmations
 	confirmations, err := client.GetConfirmations(ctx, log.BlockNumber)
 	if err != nil {
-		return fmt.Errorf("failed to get confirmations: %w", err)
+		return nil, fmt.Errorf("failed to get confirmations: %w", err)
 	}
 
 	// Create raw event
@@ -300,53 +338,262 @@ func (s *IndexerService) processCollectionCreatedLog(ctx context.Context, chainI
 	// Parse the collection created event
 	collectionEvent, err := client.ParseCollectionCreatedLog(log)
 	if err != nil {
-		return fmt.Errorf("failed to parse collection created log: %w", err)
+		return nil, &decodeFailure{eventName: "CollectionCreated", confirmations: confirmations, err: err}
 	}
 
 	// Serialize parsed data to JSON
 	parsedJSON, err := json.Marshal(collectionEvent)
 	if err != nil {
-		return fmt.Errorf("failed to marshal parsed event: %w", err)
+		return nil, fmt.Errorf("failed to marshal parsed event: %w", err)
 	}
 	rawEvent.ParsedJSON = string(parsedJSON)
 
 	// Store raw event in MongoDB (with deduplication)
 	if err := s.eventRepo.StoreRawEvent(ctx, rawEvent); err != nil {
-		return fmt.Errorf("failed to store raw event: %w", err)
+		return nil, fmt.Errorf("failed to store raw event: %w", err)
 	}
 
-	// Only publish if event has sufficient confirmations
-	requiredConfirmations := s.getRequiredConfirmations(chainID)
-	if confirmations >= requiredConfirmations {
-		if err := s.publisher.PublishCollectionCreatedEvent(ctx, chainID, rawEvent, collectionEvent); err != nil {
-			return fmt.Errorf("failed to publish collection created event: %w", err)
+	// Published right away so the catalog can show it as pending finality; the depth is
+	// followed by refreshPendingCollections until it reaches the registry confirmations
+	rawEvent.RequiredConfirmations = s.getRequiredConfirmations(chainID)
+
+	return &publishJob{
+		description: fmt.Sprintf("CollectionCreated event for %s on chain %s", collectionEvent.CollectionAddress, chainID),
+		publish: func(ctx context.Context) error {
+			if err := s.publisher.PublishCollectionCreatedEvent(ctx, chainID, rawEvent, collectionEvent); err != nil {
+				return err
+			}
+			return s.followCollection(ctx, chainID, collectionEvent.CollectionAddress, log.BlockNumber)
+		},
+	}, nil
+}
+
+// followCollection starts a new collection's checkpoint just before its creation block, so
+// it is indexed on its own from there instead of from the chain-level checkpoint
+func (s *IndexerService) followCollection(ctx context.Context, chainID, address string, createdAt *big.Int) error {
+	if address == "" || createdAt == nil {
+		return nil
+	}
+	lastBlock := new(big.Int).Sub(createdAt, big.NewInt(1))
+	if lastBlock.Sign() < 0 {
+		lastBlock.SetInt64(0)
+	}
+	if err := s.checkpointRepo.SeedCheckpoint(ctx, &domain.Checkpoint{
+		ChainID:         chainID,
+		ContractAddress: strings.ToLower(address),
+		LastBlock:       lastBlock,
+	}); err != nil {
+		return err
+	}
+	s.trackCollection(chainID, address)
+	return nil
+}
+
+// knownCollections returns the collection addresses followed on a chain, loading them
+// from stored CollectionCreated events the first time the chain is seen
+func (s *IndexerService) knownCollections(ctx context.Context, chainID string) ([]string, error) {
+	s.collectionsMu.RLock()
+	set, loaded := s.collections[chainID]
+	s.collectionsMu.RUnlock()
+
+	if !loaded {
+		addresses, err := s.eventRepo.ListCollectionAddresses(ctx, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list collections: %w", err)
 		}
-		fmt.Printf("Published CollectionCreated event for %s on chain %s\n", collectionEvent.CollectionAddress, chainID)
-	} else {
-		fmt.Printf("Event %s:%d has %d confirmations, need %d\n", log.TxHash, log.LogIndex, confirmations, requiredConfirmations)
+		s.collectionsMu.Lock()
+		set = s.collections[chainID]
+		if set == nil {
+			set = make(map[string]struct{}, len(addresses))
+			s.collections[chainID] = set
+		}
+		for _, addr := range addresses {
+			set[strings.ToLower(addr)] = struct{}{}
+		}
+		s.collectionsMu.Unlock()
 	}
 
-	return nil
+	s.collectionsMu.RLock()
+	defer s.collectionsMu.RUnlock()
+	addresses := make([]string, 0, len(set))
+	for addr := range set {
+		addresses = append(addresses, addr)
+	}
+	return addresses, nil
 }
 
-// getRequiredConfirmations returns the required number of confirmations for a chain
-func (s *IndexerService) getRequiredConfirmations(chainID string) int {
-	// This should be configurable per chain
-	// For now, using default values
-	switch chainID {
-	case "eip155-1": // Ethereum Mainnet
-		return 12
-	case "eip155-137": // Polygon
-		return 20
-	case "eip155-11155111": // Ethereum Sepolia
-		return 3
-	case "eip155-80001": // Polygon Mumbai
-		return 5
+// trackCollection starts following admin events for a newly created collection
+func (s *IndexerService) trackCollection(chainID, address string) {
+	if address == "" {
+		return
+	}
+	s.collectionsMu.Lock()
+	defer s.collectionsMu.Unlock()
+	if s.collections[chainID] == nil {
+		// leave unloaded chains alone so the first lookup still reads the stored events
+		return
+	}
+	s.collections[chainID][strings.ToLower(address)] = struct{}{}
+}
+
+// fetchCollectionLogs fetches the logs of every collection-sourced decoder for the given collections
+func (s *IndexerService) fetchCollectionLogs(ctx context.Context, addresses []string, fromBlock, toBlock *big.Int, client *blockchain.Client) ([]*domain.Log, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	var collectionLogs []*domain.Log
+	for _, topic := range s.decoders.Topics(blockchain.DecoderSourceCollection) {
+		filter := &domain.LogFilter{
+			FromBlock: fromBlock,
+			ToBlock:   toBlock,
+			Addresses: addresses,
+			Topics:    []string{topic},
+		}
+
+		logs, err := client.GetLogs(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get collection logs for blocks %s-%s: %w", fromBlock.String(), toBlock.String(), err)
+		}
+		collectionLogs = append(collectionLogs, logs...)
+	}
+
+	return collectionLogs, nil
+}
+
+// fetchAuctionLogs fetches the logs of auction-sourced decoders on the given AuctionHouse contracts
+func (s *IndexerService) fetchAuctionLogs(ctx context.Context, auctionHouses []string, fromBlock, toBlock *big.Int, client *blockchain.Client) ([]*domain.Log, error) {
+	if len(auctionHouses) == 0 {
+		return nil, nil
+	}
+
+	filter := &domain.LogFilter{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Addresses: auctionHouses,
+	}
+
+	logs, err := client.GetLogs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auction logs for blocks %s-%s: %w", fromBlock.String(), toBlock.String(), err)
+	}
+
+	auctionLogs := logs[:0]
+	for _, log := range logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+		if decoder, ok := s.decoders.Lookup(log.Topics[0]); ok && decoder.Source == blockchain.DecoderSourceAuction {
+			auctionLogs = append(auctionLogs, log)
+		}
+	}
+
+	return auctionLogs, nil
+}
+
+// prepareDecodedLog decodes a collection or AuctionHouse log with its registered decoder,
+// stores it and returns its publish job
+func (s *IndexerService) prepareDecodedLog(ctx context.Context, chainID string, log *domain.Log, client *blockchain.Client) (*publishJob, error) {
+	if log.Removed || len(log.Topics) == 0 {
+		return nil, nil
+	}
+
+	confirmations, err := client.GetConfirmations(ctx, log.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get confirmations: %w", err)
+	}
+
+	decoder, ok := s.decoders.Lookup(log.Topics[0])
+	if !ok {
+		return nil, &decodeFailure{confirmations: confirmations, err: fmt.Errorf("no decoder registered for topic %s", log.Topics[0])}
+	}
+
+	decoded, err := decoder.Decode(log)
+	if err != nil {
+		return nil, &decodeFailure{eventName: decoder.Name, confirmations: confirmations, err: err}
+	}
+
+	parsedJSON, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal parsed event: %w", err)
+	}
+
+	rawEvent := &domain.RawEvent{
+		ChainID:         chainID,
+		TxHash:          log.TxHash,
+		LogIndex:        log.LogIndex,
+		BlockNumber:     log.BlockNumber,
+		BlockHash:       log.BlockHash,
+		ContractAddress: log.Address,
+		EventName:       decoder.Name,
+		EventSignature:  log.Topics[0],
+		RawData: map[string]interface{}{
+			"topics": log.Topics,
+			"data":   log.Data,
+		},
+		ParsedJSON:    string(parsedJSON),
+		Confirmations: confirmations,
+		ObservedAt:    time.Now(),
+	}
+
+	if err := s.eventRepo.StoreRawEvent(ctx, rawEvent); err != nil {
+		return nil, fmt.Errorf("failed to store raw event: %w", err)
+	}
+
+	if !s.hasRequiredConfirmations(chainID, log, confirmations) {
+		return nil, nil
+	}
+
+	return s.decodedPublishJob(chainID, rawEvent, decoded)
+}
+
+// decodedPublishJob routes a decoded event to the publisher method for its type
+func (s *IndexerService) decodedPublishJob(chainID string, rawEvent *domain.RawEvent, decoded interface{}) (*publishJob, error) {
+	switch event := decoded.(type) {
+	case *domain.CollectionAdminEvent:
+		return &publishJob{
+			description: fmt.Sprintf("%s event for %s on chain %s", rawEvent.EventName, event.CollectionAddress, chainID),
+			publish: func(ctx context.Context) error {
+				return s.publisher.PublishCollectionUpdatedEvent(ctx, chainID, rawEvent, event)
+			},
+		}, nil
+	case *domain.AuctionEvent:
+		return &publishJob{
+			description: fmt.Sprintf("%s event for auction %s on chain %s", rawEvent.EventName, event.AuctionID.String(), chainID),
+			publish: func(ctx context.Context) error {
+				return s.publisher.PublishAuctionEvent(ctx, chainID, rawEvent, event)
+			},
+		}, nil
+	case *domain.DecodedEvent:
+		return &publishJob{
+			description: fmt.Sprintf("%s event for %s on chain %s", rawEvent.EventName, event.Contract, chainID),
+			publish: func(ctx context.Context) error {
+				return s.publisher.PublishDecodedEvent(ctx, chainID, rawEvent, event)
+			},
+		}, nil
 	default:
-		return 6 // Default
+		return nil, fmt.Errorf("decoder for %s returned unsupported %T", rawEvent.EventName, decoded)
 	}
 }
 
+// hasRequiredConfirmations reports whether a stored log is deep enough to publish
+func (s *IndexerService) hasRequiredConfirmations(chainID string, log *domain.Log, confirmations int) bool {
+	requiredConfirmations := s.getRequiredConfirmations(chainID)
+	if confirmations < requiredConfirmations {
+		fmt.Printf("Event %s:%d has %d confirmations, need %d\n", log.TxHash, log.LogIndex, confirmations, requiredConfirmations)
+		return false
+	}
+	return true
+}
+
+// getRequiredConfirmations returns the chain-registry confirmation count for a chain
+func (s *IndexerService) getRequiredConfirmations(chainID string) int {
+	if cfg, ok := s.chainConfig(chainID); ok && cfg.RequiredConfirmations > 0 {
+		return cfg.RequiredConfirmations
+	}
+	return defaultRequiredConfirmations
+}
+
 // HealthCheck performs a health check on the indexer service
 func (s *IndexerService) HealthCheck(ctx context.Context) error {
 	// Check if service is running
@@ -359,7 +606,7 @@ func (s *IndexerService) HealthCheck(ctx context.Context) error {
 	}
 
 	// Check blockchain client connections
-	for chainID, client := range s.blockchainClients {
+	for chainID, client := range s.chainClients() {
 		if err := client.IsHealthy(ctx); err != nil {
 			return fmt.Errorf("blockchain client %s is unhealthy: %w", chainID, err)
 		}
@@ -377,11 +624,16 @@ func (s *IndexerService) HealthCheck(ctx context.Context) error {
 func (s *IndexerService) GetIndexingStatus(ctx context.Context) (map[string]interface{}, error) {
 	status := make(map[string]interface{})
 
-	for chainID, client := range s.blockchainClients {
+	for chainID, client := range s.chainClients() {
 		chainStatus := make(map[string]interface{})
+		if cfg, ok := s.chainConfig(chainID); ok {
+			chainStatus["rpc_url"] = client.RPCURL()
+			chainStatus["required_confirmations"] = cfg.RequiredConfirmations
+			chainStatus["reorg_depth"] = cfg.ReorgDepth
+			chainStatus["registry_version"] = cfg.RegistryVersion
+		}
 
-		// Get checkpoint
-		checkpoint, err := s.checkpointRepo.GetCheckpoint(ctx, chainID)
+		checkpoints, err := s.checkpointRepo.ListCheckpoints(ctx, chainID)
 		if err != nil {
 			chainStatus["error"] = err.Error()
 			status[chainID] = chainStatus
@@ -396,14 +648,23 @@ func (s *IndexerService) GetIndexingStatus(ctx context.Context) (map[string]inte
 			continue
 		}
 
-		// Calculate lag
-		lag := new(big.Int).Sub(latestBlock, checkpoint.LastBlock)
+		// The chain is as far along as its slowest contract
+		report := AggregateLag(latestBlock, checkpoints)
+		contracts := make(map[string]interface{}, len(report.Contracts))
+		for _, c := range report.Contracts {
+			contracts[c.Contract] = map[string]interface{}{
+				"last_processed_block": c.LastBlock.String(),
+				"lag_blocks":           c.Lag.String(),
+				"last_updated":         c.UpdatedAt,
+			}
+		}
 
-		chainStatus["last_processed_block"] = checkpoint.LastBlock.String()
+		chainStatus["last_processed_block"] = report.SlowestBlock.String()
 		chainStatus["latest_block"] = latestBlock.String()
-		chainStatus["lag_blocks"] = lag.String()
-		chainStatus["last_updated"] = checkpoint.UpdatedAt
-		chainStatus["healthy"] = lag.Int64() < 100 // Consider healthy if less than 100 blocks behind
+		chainStatus["lag_blocks"] = report.MaxLag.String()
+		chainStatus["contracts"] = contracts
+		chainStatus["contracts_lagging"] = report.Lagging
+		chainStatus["healthy"] = report.Lagging == 0
 
 		status[chainID] = chainStatus
 	}
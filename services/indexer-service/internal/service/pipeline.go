@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+)
+
+// Pipeline queue bounds. When the broker slows down the publish queue fills, parsing
+// blocks on it, the fetch queue fills in turn and block advancement pauses.
+const (
+	FetchQueueSize   = 2   // block ranges fetched ahead of parsing
+	PublishQueueSize = 500 // stored events waiting to be published
+)
+
+// fetchedRange holds the logs of one block range in fetch order
+type fetchedRange struct {
+	from, to       *big.Int
+	toBlockHash    string
+	factoryLogs    []*domain.Log
+	collectionLogs []*domain.Log
+	auctionLogs    []*domain.Log
+}
+
+// publishJob publishes one stored event
+type publishJob struct {
+	description string
+	publish     func(ctx context.Context) error
+}
+
+// publishItem is either a job or, once every job of a range was queued, the checkpoints of
+// the contracts the range covered
+type publishItem struct {
+	job         *publishJob
+	checkpoints []*domain.Checkpoint
+}
+
+type (
+	fetchFunc func(ctx context.Context, from, to *big.Int) (*fetchedRange, error)
+	parseFunc func(ctx context.Context, fetched *fetchedRange, emit func(*publishJob) error) error
+)
+
+// runPipeline processes [fromBlock, toBlock] for contracts in MaxBlockBatchSize ranges across
+// three stages joined by bounded queues. Publishing is ordered and the contracts' checkpoints
+// for a range are written only after all of its events are published, so a broker outage
+// stalls the indexer instead of losing events; already published events of a failed range
+// are redelivered on restart.
+func (s *IndexerService) runPipeline(ctx context.Context, chainID string, contracts []string, fromBlock, toBlock *big.Int, fetch fetchFunc, parse parseFunc) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	fetched := make(chan *fetchedRange, FetchQueueSize)
+	parsed := make(chan publishItem, PublishQueueSize)
+
+	var wg sync.WaitGroup
+	var stageErr error
+	var errOnce sync.Once
+	fail := func(err error) {
+		errOnce.Do(func() { stageErr = err })
+		cancel()
+	}
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer close(fetched)
+		if err := s.fetchStage(ctx, chainID, fromBlock, toBlock, fetch, fetched); err != nil {
+			fail(err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		defer close(parsed)
+		if err := s.parseStage(ctx, chainID, contracts, parse, fetched, parsed); err != nil {
+			fail(err)
+		}
+	}()
+
+	if err := s.publishStage(ctx, chainID, parsed); err != nil {
+		fail(err)
+	}
+	wg.Wait()
+
+	if stageErr != nil && !errors.Is(stageErr, context.Canceled) {
+		return stageErr
+	}
+	return ctx.Err()
+}
+
+// fetchStage walks the block ranges, stopping early on a stop signal so the
+// ranges already queued still drain through publishing
+func (s *IndexerService) fetchStage(ctx context.Context, chainID string, fromBlock, toBlock *big.Int, fetch fetchFunc, out chan<- *fetchedRange) error {
+	batchSize := int64(MaxBlockBatchSize)
+
+	for from := fromBlock; from.Cmp(toBlock) <= 0; {
+		to := new(big.Int).Add(from, big.NewInt(batchSize-1))
+		if to.Cmp(toBlock) > 0 {
+			to = new(big.Int).Set(toBlock)
+		}
+
+		fetched, err := fetch(ctx, from, to)
+		if err != nil {
+			return err
+		}
+		if err := enqueue(ctx, out, fetched, func() {
+			fmt.Printf("Backpressure on chain %s: parse queue full, pausing at block %s\n", chainID, to.String())
+		}); err != nil {
+			return err
+		}
+
+		from = new(big.Int).Add(to, big.NewInt(1))
+
+		// Small delay between batches to avoid overwhelming the node
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopChan:
+			return nil
+		}
+	}
+	return nil
+}
+
+// parseStage turns fetched ranges into publish jobs followed by the range's checkpoints
+func (s *IndexerService) parseStage(ctx context.Context, chainID string, contracts []string, parse parseFunc, in <-chan *fetchedRange, out chan<- publishItem) error {
+	onFull := func() {
+		fmt.Printf("Backpressure on chain %s: publish queue full (%d events), pausing parsing\n", chainID, PublishQueueSize)
+	}
+
+	for fetched := range in {
+		emit := func(job *publishJob) error {
+			return enqueue(ctx, out, publishItem{job: job}, onFull)
+		}
+		if err := parse(ctx, fetched, emit); err != nil {
+			return err
+		}
+
+		checkpoints := make([]*domain.Checkpoint, len(contracts))
+		for i, contract := range contracts {
+			checkpoints[i] = &domain.Checkpoint{
+				ChainID:         chainID,
+				ContractAddress: contract,
+				LastBlock:       fetched.to,
+				LastBlockHash:   fetched.toBlockHash,
+				UpdatedAt:       time.Now(),
+			}
+		}
+		if err := enqueue(ctx, out, publishItem{checkpoints: checkpoints}, onFull); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishStage publishes jobs in order with retries and advances the checkpoints at each range boundary
+func (s *IndexerService) publishStage(ctx context.Context, chainID string, in <-chan publishItem) error {
+	for item := range in {
+		if item.checkpoints != nil {
+			if err := s.checkpointRepo.UpdateCheckpoints(ctx, item.checkpoints); err != nil {
+				return fmt.Errorf("failed to update checkpoint: %w", err)
+			}
+			continue
+		}
+
+		if err := s.publishWithRetry(ctx, item.job); err != nil {
+			return fmt.Errorf("chain %s: %w; checkpoint held at the last fully published range", chainID, err)
+		}
+		fmt.Printf("Published %s\n", item.job.description)
+	}
+	return nil
+}
+
+// publishWithRetry retries a publish MaxRetries times with a linear backoff
+func (s *IndexerService) publishWithRetry(ctx context.Context, job *publishJob) error {
+	var err error
+	for attempt := 1; attempt <= MaxRetries; attempt++ {
+		if err = job.publish(ctx); err == nil {
+			return nil
+		}
+		if attempt == MaxRetries {
+			break
+		}
+		select {
+		case <-time.After(time.Duration(attempt) * s.retryDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return fmt.Errorf("failed to publish %s after %d attempts: %w", job.description, MaxRetries, err)
+}
+
+// SetPublishRetryDelay changes the first wait between publish attempts from RetryDelay
+func (s *IndexerService) SetPublishRetryDelay(delay time.Duration) {
+	s.retryDelay = delay
+}
+
+// RangePublish is one event to publish for a block range
+type RangePublish struct {
+	Description string
+	Publish     func(ctx context.Context) error
+}
+
+// RunPublishPipeline runs the pipeline a stream runs over [fromBlock, toBlock] for
+// contracts, with fetch returning the publishes of each range in place of fetching and
+// decoding its logs. Ordering, backpressure and checkpointing are the same.
+func (s *IndexerService) RunPublishPipeline(ctx context.Context, chainID string, contracts []string, fromBlock, toBlock *big.Int, fetch func(ctx context.Context, from, to *big.Int) ([]RangePublish, error)) error {
+	// Only the fetch stage writes, and each range is parsed after it was fetched
+	var mu sync.Mutex
+	fetchedJobs := make(map[string][]RangePublish)
+
+	return s.runPipeline(ctx, chainID, contracts, fromBlock, toBlock,
+		func(ctx context.Context, from, to *big.Int) (*fetchedRange, error) {
+			publishes, err := fetch(ctx, from, to)
+			if err != nil {
+				return nil, err
+			}
+			mu.Lock()
+			fetchedJobs[from.String()] = publishes
+			mu.Unlock()
+			return &fetchedRange{from: from, to: to}, nil
+		},
+		func(ctx context.Context, fetched *fetchedRange, emit func(*publishJob) error) error {
+			mu.Lock()
+			publishes := fetchedJobs[fetched.from.String()]
+			delete(fetchedJobs, fetched.from.String())
+			mu.Unlock()
+			for _, p := range publishes {
+				if err := emit(&publishJob{description: p.Description, publish: p.Publish}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// enqueue sends item, calling onFull once if the queue is full before blocking on it
+func enqueue[T any](ctx context.Context, ch chan<- T, item T, onFull func()) error {
+	select {
+	case ch <- item:
+		return nil
+	default:
+	}
+
+	onFull()
+	select {
+	case ch <- item:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+func TestAbiCompatWarnings_FlagsDecodedEventChanges(t *testing.T) {
+	event := &contracts.AbiChangedEvent{
+		ChainID: "eip155:1",
+		Address: "0xdc64a140aa3e981100a9beca4e685f962f0cf6c9",
+		Name:    "AuctionHouse",
+		Diff: contracts.AbiDiff{
+			AddedEvents:   []string{"BidPlaced(uint256,address,uint256,uint64,address)"},
+			RemovedEvents: []string{"BidPlaced(uint256,address,uint256,uint64)", "Paused(address)"},
+		},
+	}
+
+	lines := service.AbiCompatWarnings(event)
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 warning, got %d: %v", len(lines), lines)
+	}
+	if !strings.Contains(lines[0], "removed=BidPlaced(uint256,address,uint256,uint64)") ||
+		!strings.Contains(lines[0], "replacement=BidPlaced(uint256,address,uint256,uint64,address)") {
+		t.Fatalf("unexpected warning: %s", lines[0])
+	}
+}
+
+func TestAbiCompatWarnings_IgnoresAdditiveChanges(t *testing.T) {
+	event := &contracts.AbiChangedEvent{
+		ChainID: "eip155:1",
+		Diff:    contracts.AbiDiff{AddedEvents: []string{"AuctionExtended(uint256,uint64)"}},
+	}
+
+	if lines := service.AbiCompatWarnings(event); len(lines) != 0 {
+		t.Fatalf("expected no warnings, got %v", lines)
+	}
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+)
+
+func packLogData(t *testing.T, types []string, values ...interface{}) string {
+	args := make(abi.Arguments, len(types))
+	for i, typ := range types {
+		parsed, err := abi.NewType(typ, "", nil)
+		if err != nil {
+			t.Fatalf("abi type %s: %v", typ, err)
+		}
+		args[i] = abi.Argument{Type: parsed}
+	}
+	data, err := args.Pack(values...)
+	if err != nil {
+		t.Fatalf("pack log data: %v", err)
+	}
+	return fmt.Sprintf("0x%x", data)
+}
+
+func uintTopic(n int64) string {
+	return common.BigToHash(big.NewInt(n)).Hex()
+}
+
+func addressTopic(addr string) string {
+	return common.BytesToHash(common.HexToAddress(addr).Bytes()).Hex()
+}
+
+func TestParseAuctionLog_AuctionCreated(t *testing.T) {
+	seller := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	log := &domain.Log{
+		Address: "0xDc64a140Aa3E981100a9becA4E685f962f0cF6C9",
+		Topics: []string{
+			blockchain.AuctionCreatedTopic,
+			uintTopic(3),
+			addressTopic("0x00000000000000000000000000000000000000c0"),
+			uintTopic(7),
+		},
+		Data: packLogData(t, []string{"address", "uint8", "uint256", "uint256", "uint256", "uint64", "uint64"},
+			seller, uint8(1), big.NewInt(1000), big.NewInt(0), big.NewInt(100), uint64(1700000000), uint64(1700003600)),
+	}
+
+	event, err := (&blockchain.Client{}).ParseAuctionLog(log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Kind != domain.AuctionCreated || event.AuctionID.String() != "3" || event.TokenID.String() != "7" {
+		t.Fatalf("unexpected auction identity: %+v", event)
+	}
+	if event.CollectionAddress != "0x00000000000000000000000000000000000000c0" || event.Seller != "0x00000000000000000000000000000000000000aa" {
+		t.Fatalf("unexpected addresses: collection=%s seller=%s", event.CollectionAddress, event.Seller)
+	}
+	if event.AuctionType != "dutch" || event.EndPrice.String() != "100" || event.EndTime != 1700003600 {
+		t.Fatalf("unexpected terms: type=%s end_price=%s end_time=%d", event.AuctionType, event.EndPrice, event.EndTime)
+	}
+}
+
+func TestParseAuctionLog_BidPlaced(t *testing.T) {
+	log := &domain.Log{
+		Address: "0xDc64a140Aa3E981100a9becA4E685f962f0cF6C9",
+		Topics: []string{
+			blockchain.BidPlacedTopic,
+			uintTopic(3),
+			addressTopic("0x00000000000000000000000000000000000000bb"),
+		},
+		Data: packLogData(t, []string{"uint256", "uint64"}, big.NewInt(5000), uint64(1700004000)),
+	}
+
+	event, err := (&blockchain.Client{}).ParseAuctionLog(log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Kind != domain.AuctionBid || event.Bidder != "0x00000000000000000000000000000000000000bb" {
+		t.Fatalf("unexpected bid: %+v", event)
+	}
+	if event.Amount.String() != "5000" || event.EndTime != 1700004000 {
+		t.Fatalf("unexpected bid amount/end: %s %d", event.Amount, event.EndTime)
+	}
+}
+
+func TestParseAuctionLog_UnknownTopic(t *testing.T) {
+	_, err := (&blockchain.Client{}).ParseAuctionLog(&domain.Log{
+		Topics: []string{blockchain.OwnershipTransferredTopic, uintTopic(1)},
+	})
+	if err == nil {
+		t.Fatal("expected error for non-auction topic")
+	}
+}
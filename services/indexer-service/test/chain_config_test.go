@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/registry"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	chainpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// fakeRegistryClient answers the registry calls the chain config source makes
+type fakeRegistryClient struct {
+	chainpb.ChainRegistryServiceClient
+	endpoints   []*chainpb.RpcEndpoint
+	params      *chainpb.ChainParams
+	contracts   []*chainpb.Contract
+	abis        map[string]string // abi_sha256 -> abi json
+	err         error
+	requestedID string
+}
+
+func (f *fakeRegistryClient) GetRpcEndpoints(ctx context.Context, in *chainpb.GetRpcEndpointsRequest, opts ...grpc.CallOption) (*chainpb.GetRpcEndpointsResponse, error) {
+	f.requestedID = in.GetChainId()
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &chainpb.GetRpcEndpointsResponse{ChainId: in.GetChainId(), Endpoints: f.endpoints, RegistryVersion: "1.0.7"}, nil
+}
+
+func (f *fakeRegistryClient) GetContracts(ctx context.Context, in *chainpb.GetContractsRequest, opts ...grpc.CallOption) (*chainpb.GetContractsResponse, error) {
+	return &chainpb.GetContractsResponse{ChainId: in.GetChainId(), Contracts: f.contracts, Params: f.params, RegistryVersion: "1.0.7"}, nil
+}
+
+func (f *fakeRegistryClient) GetAbiBlob(ctx context.Context, in *chainpb.GetAbiBlobRequest, opts ...grpc.CallOption) (*chainpb.GetAbiBlobResponse, error) {
+	return &chainpb.GetAbiBlobResponse{AbiJson: f.abis[in.GetAbiSha256()], Etag: in.GetAbiSha256()}, nil
+}
+
+func TestChainConfigSource_MapsRegistryEndpointsAndParams(t *testing.T) {
+	client := &fakeRegistryClient{
+		endpoints: []*chainpb.RpcEndpoint{
+			{Url: "https://primary.example", Priority: 1, Active: true},
+			{Url: "https://retired.example", Priority: 2, Active: false},
+			{Url: "https://fallback.example", Priority: 3, Active: true},
+		},
+		params: &chainpb.ChainParams{RequiredConfirmations: 20, ReorgDepth: 64},
+	}
+
+	cfg, err := registry.NewChainConfigSource(client).GetChainConfig(context.Background(), "eip155-137")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if client.requestedID != "eip155:137" {
+		t.Fatalf("expected CAIP-2 chain id, got %s", client.requestedID)
+	}
+	if want := []string{"https://primary.example", "https://fallback.example"}; !reflect.DeepEqual(cfg.RPCURLs, want) {
+		t.Fatalf("expected active endpoints %v, got %v", want, cfg.RPCURLs)
+	}
+	if cfg.ChainID != "eip155-137" || cfg.RequiredConfirmations != 20 || cfg.ReorgDepth != 64 || cfg.RegistryVersion != "1.0.7" {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestChainConfigSource_ListsImportedCollections(t *testing.T) {
+	client := &fakeRegistryClient{
+		endpoints: []*chainpb.RpcEndpoint{{Url: "https://primary.example", Active: true}},
+		contracts: []*chainpb.Contract{
+			{Name: "ERC721CollectionFactory", Address: "0xFactory"},
+			{Name: "collection-0xlegacy", Address: "0xLegacy", StartBlock: 1200, Imported: true},
+		},
+	}
+
+	cfg, err := registry.NewChainConfigSource(client).GetChainConfig(context.Background(), "eip155-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []domain.ImportedCollection{{Address: "0xlegacy", StartBlock: 1200}}
+	if !reflect.DeepEqual(cfg.ImportedCollections, want) {
+		t.Fatalf("expected imported collections %v, got %v", want, cfg.ImportedCollections)
+	}
+}
+
+func TestChainConfigSource_MapsContractRoles(t *testing.T) {
+	client := &fakeRegistryClient{
+		endpoints: []*chainpb.RpcEndpoint{{Url: "https://primary.example", Active: true}},
+		contracts: []*chainpb.Contract{
+			{Name: "AuctionHouse", Address: "0xAuction", Roles: []string{contracts.RoleAuctionHouse}},
+			{Name: "ERC1155CollectionFactory", Address: "0xFactory1155", Roles: []string{contracts.RoleERC1155Factory, contracts.RoleFactory}},
+			{Name: "ERC721CollectionFactory", Address: "0xFactory721", Roles: []string{contracts.RoleERC721Factory, contracts.RoleFactory}},
+			{Name: "Forwarder", Address: "0xForwarder", Roles: []string{contracts.RoleForwarder}},
+		},
+	}
+
+	cfg, err := registry.NewChainConfigSource(client).GetChainConfig(context.Background(), "eip155-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := []string{"0xfactory1155", "0xfactory721"}; !reflect.DeepEqual(cfg.Factories, want) {
+		t.Fatalf("expected factories %v, got %v", want, cfg.Factories)
+	}
+	if cfg.AuctionHouse != "0xauction" {
+		t.Fatalf("expected auction house 0xauction, got %q", cfg.AuctionHouse)
+	}
+}
+
+func TestChainConfigSource_RejectsAmbiguousAuctionHouse(t *testing.T) {
+	client := &fakeRegistryClient{
+		endpoints: []*chainpb.RpcEndpoint{{Url: "https://primary.example", Active: true}},
+		contracts: []*chainpb.Contract{
+			{Name: "AuctionHouse", Address: "0xAuction", Roles: []string{contracts.RoleAuctionHouse}},
+			{Name: "AuctionHouseV2", Address: "0xAuction2", Roles: []string{contracts.RoleAuctionHouse}},
+		},
+	}
+
+	if _, err := registry.NewChainConfigSource(client).GetChainConfig(context.Background(), "eip155-1"); err == nil {
+		t.Fatal("expected an error when two contracts hold the auction-house role")
+	}
+}
+
+func TestChainConfigSource_RejectsChainWithoutActiveEndpoints(t *testing.T) {
+	client := &fakeRegistryClient{
+		endpoints: []*chainpb.RpcEndpoint{{Url: "https://retired.example", Active: false}},
+		params:    &chainpb.ChainParams{RequiredConfirmations: 12},
+	}
+
+	if _, err := registry.NewChainConfigSource(client).GetChainConfig(context.Background(), "eip155-1"); err == nil {
+		t.Fatal("expected an error for a chain without active endpoints")
+	}
+}
+
+func TestIndexerService_RegistryErrorsAbortLoadAndRetryChanges(t *testing.T) {
+	client := &fakeRegistryClient{err: errors.New("registry unavailable")}
+	svc := service.NewIndexerService(nil, nil, nil, registry.NewChainConfigSource(client),
+		map[string]string{"eip155-1": "0xfactory"}, nil, 0)
+
+	if err := svc.LoadChains(context.Background()); err == nil {
+		t.Fatal("expected LoadChains to fail while the registry is unavailable")
+	}
+
+	// Changes for an indexed chain are redelivered until the registry answers
+	if err := svc.HandleRegistryChanged(context.Background(), &contracts.RegistryChangedEvent{ChainID: "eip155:1", RegistryVersion: "1.0.8"}); err == nil {
+		t.Fatal("expected HandleRegistryChanged to fail while the registry is unavailable")
+	}
+
+	// Chains without a factory are not indexed, so their changes are acknowledged untouched
+	client.requestedID = ""
+	if err := svc.HandleRegistryChanged(context.Background(), &contracts.RegistryChangedEvent{ChainID: "eip155:137"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.requestedID != "" {
+		t.Fatalf("expected no registry call for an unindexed chain, got %s", client.requestedID)
+	}
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/service"
+)
+
+func TestNextChainHead_UsesFinalizedTag(t *testing.T) {
+	now := time.Now()
+	latest := &domain.BlockInfo{Number: big.NewInt(1000), Hash: "0xabc", Timestamp: now.Add(-2 * time.Second)}
+
+	head, advanced := service.NextChainHead(nil, "eip155-1", latest, big.NewInt(936), 12, now)
+	if !advanced {
+		t.Fatal("expected the first head to advance")
+	}
+	if head.ChainID != "eip155:1" || head.LatestBlock != 1000 || head.LatestBlockHash != "0xabc" {
+		t.Fatalf("unexpected head %+v", head)
+	}
+	if head.FinalizedBlock != 936 || !head.FinalizedByTag {
+		t.Fatalf("expected the tagged finalized block, got %d (tag=%v)", head.FinalizedBlock, head.FinalizedByTag)
+	}
+}
+
+func TestNextChainHead_FallsBackToReorgDepth(t *testing.T) {
+	latest := &domain.BlockInfo{Number: big.NewInt(1000)}
+
+	head, _ := service.NextChainHead(nil, "eip155-137", latest, nil, 64, time.Now())
+	if head.FinalizedBlock != 936 || head.FinalizedByTag {
+		t.Fatalf("expected latest less the reorg depth, got %d (tag=%v)", head.FinalizedBlock, head.FinalizedByTag)
+	}
+
+	// A chain younger than its reorg depth has nothing final yet
+	head, _ = service.NextChainHead(nil, "eip155-137", &domain.BlockInfo{Number: big.NewInt(10)}, nil, 64, time.Now())
+	if head.FinalizedBlock != 0 {
+		t.Fatalf("expected no finalized block, got %d", head.FinalizedBlock)
+	}
+}
+
+func TestNextChainHead_OnlyAdvancesForward(t *testing.T) {
+	first := time.Now()
+	prev, _ := service.NextChainHead(nil, "eip155-1", &domain.BlockInfo{Number: big.NewInt(1000)}, nil, 12, first)
+
+	// A poll served by a lagging endpoint keeps the earlier head and its time
+	head, advanced := service.NextChainHead(&prev, "eip155-1", &domain.BlockInfo{Number: big.NewInt(999)}, nil, 12, first.Add(time.Minute))
+	if advanced || head.LatestBlock != 1000 || !head.AdvancedAt.Equal(first) {
+		t.Fatalf("expected the previous head, got %+v (advanced=%v)", head, advanced)
+	}
+
+	head, advanced = service.NextChainHead(&prev, "eip155-1", &domain.BlockInfo{Number: big.NewInt(1001)}, nil, 12, first.Add(time.Minute))
+	if !advanced || head.LatestBlock != 1001 || !head.AdvancedAt.Equal(first.Add(time.Minute)) {
+		t.Fatalf("expected the new head, got %+v (advanced=%v)", head, advanced)
+	}
+}
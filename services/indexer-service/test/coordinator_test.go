@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/service"
+)
+
+func TestPlanStreams_GroupsContractsByCheckpoint(t *testing.T) {
+	checkpoints := map[string]*big.Int{
+		"0xaaa": big.NewInt(900),
+		"0xbbb": big.NewInt(900),
+		"0xccc": big.NewInt(10), // backfilling from far behind
+	}
+
+	streams := service.PlanStreams(service.StreamCollection, []string{"0xCCC", "0xaaa", "0xddd", "0xbbb"}, checkpoints, big.NewInt(500))
+	if len(streams) != 3 {
+		t.Fatalf("expected 3 streams, got %d", len(streams))
+	}
+
+	// closest to the head first; contracts without a checkpoint start from the chain-level block
+	want := []struct {
+		last      int64
+		contracts []string
+	}{
+		{900, []string{"0xaaa", "0xbbb"}},
+		{500, []string{"0xddd"}},
+		{10, []string{"0xccc"}},
+	}
+	for i, w := range want {
+		if streams[i].Kind != service.StreamCollection {
+			t.Fatalf("stream %d: unexpected kind %s", i, streams[i].Kind)
+		}
+		if streams[i].LastBlock.Int64() != w.last || !reflect.DeepEqual(streams[i].Contracts, w.contracts) {
+			t.Fatalf("stream %d: got %s %v, want %d %v", i, streams[i].LastBlock, streams[i].Contracts, w.last, w.contracts)
+		}
+	}
+}
+
+func TestAggregateLag_ReportsSlowestContract(t *testing.T) {
+	checkpoints := []*domain.Checkpoint{
+		{ChainID: "eip155-1", ContractAddress: "", LastBlock: big.NewInt(1)},
+		{ChainID: "eip155-1", ContractAddress: "0xfactory", LastBlock: big.NewInt(1000)},
+		{ChainID: "eip155-1", ContractAddress: "0xslow", LastBlock: big.NewInt(700)},
+		{ChainID: "eip155-1", ContractAddress: "0xahead", LastBlock: big.NewInt(1005)},
+	}
+
+	report := service.AggregateLag(big.NewInt(1000), checkpoints)
+	if len(report.Contracts) != 3 {
+		t.Fatalf("chain-level row should not be reported next to contracts, got %d", len(report.Contracts))
+	}
+	if report.MaxLag.Int64() != 300 || report.SlowestBlock.Int64() != 700 {
+		t.Fatalf("unexpected slowest contract: lag %s at %s", report.MaxLag, report.SlowestBlock)
+	}
+	if report.Lagging != 1 {
+		t.Fatalf("expected one lagging contract, got %d", report.Lagging)
+	}
+	if report.Contracts[2].Lag.Sign() != 0 {
+		t.Fatalf("a checkpoint past the observed head should report no lag, got %s", report.Contracts[2].Lag)
+	}
+}
+
+func TestAggregateLag_FallsBackToChainLevelCheckpoint(t *testing.T) {
+	report := service.AggregateLag(big.NewInt(50), []*domain.Checkpoint{
+		{ChainID: "eip155-1", LastBlock: big.NewInt(20)},
+	})
+	if len(report.Contracts) != 1 || report.MaxLag.Int64() != 30 || report.Lagging != 0 {
+		t.Fatalf("unexpected report for a chain not yet split: %+v", report)
+	}
+}
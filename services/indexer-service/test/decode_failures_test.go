@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/httpapi"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/service"
+)
+
+// fakeIndexer serves fixed counts and records reprocess filters
+type fakeIndexer struct {
+	failures    *service.DecodeFailureCounter
+	pending     []domain.DecodeFailureCount
+	reprocessed []domain.DeadLetterFilter
+}
+
+func (f *fakeIndexer) DecodeFailureCounts() []domain.DecodeFailureCount {
+	return f.failures.Snapshot()
+}
+
+func (f *fakeIndexer) PendingDeadLetters(ctx context.Context) ([]domain.DecodeFailureCount, error) {
+	return f.pending, nil
+}
+
+func (f *fakeIndexer) ReprocessDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) (domain.ReprocessResult, error) {
+	f.reprocessed = append(f.reprocessed, filter)
+	return domain.ReprocessResult{Reprocessed: 2, Failed: 1}, nil
+}
+
+func TestDecodeFailureCounter_GroupsByContractAndSignature(t *testing.T) {
+	counter := service.NewDecodeFailureCounter()
+	counter.Inc("eip155-1", "0xBBB", "0xFEED")
+	counter.Inc("eip155-1", "0xbbb", "0xfeed")
+	counter.Inc("eip155-1", "0xaaa", "0xfeed")
+
+	counts := counter.Snapshot()
+	if len(counts) != 2 {
+		t.Fatalf("expected 2 series, got %+v", counts)
+	}
+	if counts[0].ContractAddress != "0xaaa" || counts[0].Count != 1 {
+		t.Fatalf("unexpected first series: %+v", counts[0])
+	}
+	if counts[1].ContractAddress != "0xbbb" || counts[1].EventSignature != "0xfeed" || counts[1].Count != 2 {
+		t.Fatalf("unexpected second series: %+v", counts[1])
+	}
+}
+
+func TestMetricsEndpoint_ExposesFailuresAndPending(t *testing.T) {
+	indexer := &fakeIndexer{
+		failures: service.NewDecodeFailureCounter(),
+		pending:  []domain.DecodeFailureCount{{ChainID: "eip155-1", ContractAddress: "0xaaa", EventSignature: "0xfeed", Count: 4}},
+	}
+	indexer.failures.Inc("eip155-1", "0xaaa", "0xfeed")
+	h := httpapi.NewHandler(indexer, "secret")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, httpapi.MetricsRoute, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d", rec.Code)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{
+		"# TYPE indexer_decode_failures_total counter",
+		`indexer_decode_failures_total{chain_id="eip155-1",contract="0xaaa",event_signature="0xfeed"} 1`,
+		"# TYPE indexer_dead_letters_pending gauge",
+		`indexer_dead_letters_pending{chain_id="eip155-1",contract="0xaaa",event_signature="0xfeed"} 4`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("metrics missing %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestReprocessEndpoint_RequiresAdminToken(t *testing.T) {
+	indexer := &fakeIndexer{failures: service.NewDecodeFailureCounter()}
+
+	post := func(h http.Handler, auth, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, httpapi.ReprocessRoute, strings.NewReader(body))
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := post(httpapi.NewHandler(indexer, ""), "Bearer ", ""); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected admin endpoints disabled without a token, got %d", rec.Code)
+	}
+
+	h := httpapi.NewHandler(indexer, "secret")
+	if rec := post(h, "Bearer wrong", ""); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for a wrong token, got %d", rec.Code)
+	}
+	if len(indexer.reprocessed) != 0 {
+		t.Fatalf("reprocess ran without authorization")
+	}
+
+	rec := post(h, "Bearer secret", `{"chain_id":"eip155-1","event_signature":"0xfeed","limit":10}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), `"reprocessed":2`) {
+		t.Fatalf("unexpected body %s", rec.Body.String())
+	}
+	want := domain.DeadLetterFilter{ChainID: "eip155-1", EventSignature: "0xfeed", Limit: 10}
+	if len(indexer.reprocessed) != 1 || indexer.reprocessed[0] != want {
+		t.Fatalf("unexpected filter %+v", indexer.reprocessed)
+	}
+
+	// An empty body reprocesses everything pending
+	if rec := post(h, "Bearer secret", ""); rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d for an empty body", rec.Code)
+	}
+}
+
+func TestEventDocumentConversion_DecodeFailure(t *testing.T) {
+	repo := &repository.EventRepository{}
+	doc := repo.EventToDocument(&domain.RawEvent{
+		ID:          "e1",
+		TxHash:      "0xdeadbeef",
+		State:       domain.RawEventDecodeFailed,
+		DecodeError: "abi: cannot marshal in to go type",
+	})
+	e, err := repo.DocumentToEvent(doc)
+	if err != nil {
+		t.Fatalf("documentToEvent error: %v", err)
+	}
+	if e.State != domain.RawEventDecodeFailed || e.DecodeError != "abi: cannot marshal in to go type" {
+		t.Fatalf("decode failure lost in conversion: %+v", e)
+	}
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/blockchain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/infrastructure/registry"
+	chainpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+const dropABI = `{"abi":[{"type":"event","name":"DropMinted","inputs":[
+	{"name":"to","type":"address","indexed":true},
+	{"name":"tokenId","type":"uint256","indexed":true},
+	{"name":"price","type":"uint256","indexed":false},
+	{"name":"uri","type":"string","indexed":false}]}]}`
+
+func TestDefaultDecoders_RouteCompiledEvents(t *testing.T) {
+	decoders := blockchain.DefaultDecoders()
+
+	bid, ok := decoders.Lookup(blockchain.BidPlacedTopic)
+	if !ok || bid.Name != "BidPlaced" || bid.Source != blockchain.DecoderSourceAuction {
+		t.Fatalf("unexpected BidPlaced decoder: %+v", bid)
+	}
+
+	event, err := bid.Decode(&domain.Log{
+		Topics: []string{blockchain.BidPlacedTopic, uintTopic(3), addressTopic("0x00000000000000000000000000000000000000bb")},
+		Data:   packLogData(t, []string{"uint256", "uint64"}, big.NewInt(5000), uint64(1700004000)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if auction, ok := event.(*domain.AuctionEvent); !ok || auction.Amount.String() != "5000" {
+		t.Fatalf("unexpected decoded bid: %#v", event)
+	}
+
+	// admin events and the standard ERC-721/1155 events are fetched on collections
+	collectionTopics := map[string]bool{}
+	for _, topic := range decoders.Topics(blockchain.DecoderSourceCollection) {
+		collectionTopics[topic] = true
+	}
+	approvalForAll := crypto.Keccak256Hash([]byte("ApprovalForAll(address,address,bool)")).Hex()
+	for _, topic := range []string{blockchain.OwnershipTransferredTopic, blockchain.BaseURIUpdatedTopic, approvalForAll} {
+		if !collectionTopics[topic] {
+			t.Fatalf("expected collection topic %s in %v", topic, collectionTopics)
+		}
+	}
+	if collectionTopics[blockchain.BidPlacedTopic] {
+		t.Fatal("auction topics must not be fetched on collections")
+	}
+}
+
+func TestDecoderRegistry_RejectsDuplicateSignature(t *testing.T) {
+	decoders := blockchain.DefaultDecoders()
+	err := decoders.Register(blockchain.EventDecoder{
+		Name:      "BidPlaced",
+		Signature: blockchain.BidPlacedSig,
+		Source:    blockchain.DecoderSourceAuction,
+		Decode:    func(*domain.Log) (interface{}, error) { return nil, nil },
+	})
+	if err == nil {
+		t.Fatal("expected an error when registering a signature twice")
+	}
+}
+
+func TestDecoderRegistry_DecodesEventsFromABI(t *testing.T) {
+	decoders := blockchain.NewDecoderRegistry()
+	added, err := decoders.RegisterABI(blockchain.DecoderSourceCollection, dropABI)
+	if err != nil || added != 1 {
+		t.Fatalf("expected one decoder, got %d (%v)", added, err)
+	}
+	// already registered events are left alone
+	if added, _ := decoders.RegisterABI(blockchain.DecoderSourceCollection, dropABI); added != 0 {
+		t.Fatalf("expected no new decoders, got %d", added)
+	}
+
+	topic := crypto.Keccak256Hash([]byte("DropMinted(address,uint256,uint256,string)")).Hex()
+	decoder, ok := decoders.Lookup(topic)
+	if !ok {
+		t.Fatal("expected a DropMinted decoder")
+	}
+
+	event, err := decoder.Decode(&domain.Log{
+		Address: "0xC0000000000000000000000000000000000000C0",
+		Topics:  []string{topic, addressTopic("0x00000000000000000000000000000000000000aa"), uintTopic(42)},
+		Data:    packLogData(t, []string{"uint256", "string"}, big.NewInt(1000), "ipfs://drop/42"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decoded, ok := event.(*domain.DecodedEvent)
+	if !ok {
+		t.Fatalf("expected a DecodedEvent, got %T", event)
+	}
+	if decoded.Name != "DropMinted" || decoded.Contract != "0xc0000000000000000000000000000000000000c0" {
+		t.Fatalf("unexpected event identity: %+v", decoded)
+	}
+	want := map[string]interface{}{
+		"to":      "0x00000000000000000000000000000000000000aa",
+		"tokenId": "42",
+		"price":   "1000",
+		"uri":     "ipfs://drop/42",
+	}
+	for name, value := range want {
+		if decoded.Args[name] != value {
+			t.Fatalf("arg %s: expected %v, got %v", name, value, decoded.Args[name])
+		}
+	}
+
+	// a log with the same topic but a different indexing is rejected rather than misread
+	if _, err := decoder.Decode(&domain.Log{Topics: []string{topic, uintTopic(42)}}); err == nil {
+		t.Fatal("expected an error for a topic count mismatch")
+	}
+}
+
+func TestChainConfigSource_ListsContractAbis(t *testing.T) {
+	client := &fakeRegistryClient{
+		contracts: []*chainpb.Contract{
+			{Name: "DropCollection", Address: "0xC0000000000000000000000000000000000000C0", Standard: chainpb.ContractStandard_STD_ERC721, AbiSha256: "drop"},
+			{Name: "Unverified", Address: "0x00000000000000000000000000000000000000d0"},
+		},
+		abis: map[string]string{"drop": dropABI},
+	}
+
+	abis, err := registry.NewChainConfigSource(client).ListContractAbis(context.Background(), "eip155-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(abis) != 1 {
+		t.Fatalf("expected only contracts with an ABI, got %+v", abis)
+	}
+	if abis[0].Standard != "erc721" || abis[0].Address != "0xc0000000000000000000000000000000000000c0" || abis[0].AbiJSON != dropABI {
+		t.Fatalf("unexpected contract abi: %+v", abis[0])
+	}
+}
+
+func TestDefaultDecoders_DecodeERC1155TransferBatch(t *testing.T) {
+	decoders := blockchain.DefaultDecoders()
+	topic := crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])")).Hex()
+
+	decoder, ok := decoders.Lookup(topic)
+	if !ok || decoder.Source != blockchain.DecoderSourceCollection {
+		t.Fatalf("expected a collection decoder for TransferBatch, got %+v", decoder)
+	}
+
+	event, err := decoder.Decode(&domain.Log{
+		Address: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		Topics: []string{
+			topic,
+			addressTopic("0x00000000000000000000000000000000000000aa"),
+			addressTopic("0x0000000000000000000000000000000000000000"),
+			addressTopic("0x00000000000000000000000000000000000000aa"),
+		},
+		Data: packLogData(t, []string{"uint256[]", "uint256[]"},
+			[]*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(5), big.NewInt(3)}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the catalog folds mints and burns from these args into token supply
+	decoded := event.(*domain.DecodedEvent)
+	ids, _ := decoded.Args["ids"].([]interface{})
+	values, _ := decoded.Args["values"].([]interface{})
+	if decoded.Args["from"] != "0x0000000000000000000000000000000000000000" ||
+		len(ids) != 2 || ids[1] != "2" || len(values) != 2 || values[0] != "5" {
+		t.Fatalf("unexpected TransferBatch args: %#v", decoded.Args)
+	}
+}
+
+func TestDefaultDecoders_DecodeERC721Transfer(t *testing.T) {
+	decoders := blockchain.DefaultDecoders()
+	topic := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).Hex()
+
+	decoder, ok := decoders.Lookup(topic)
+	if !ok || decoder.Source != blockchain.DecoderSourceCollection {
+		t.Fatalf("expected a collection decoder for Transfer, got %+v", decoder)
+	}
+
+	log := &domain.Log{
+		Address: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		Topics: []string{
+			topic,
+			addressTopic("0x00000000000000000000000000000000000000aa"),
+			addressTopic("0x00000000000000000000000000000000000000bb"),
+			common.BigToHash(big.NewInt(7)).Hex(),
+		},
+	}
+	event, err := decoder.Decode(log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded := event.(*domain.DecodedEvent)
+	if decoded.Args["to"] != "0x00000000000000000000000000000000000000bb" || decoded.Args["tokenId"] != "7" {
+		t.Fatalf("unexpected Transfer args: %#v", decoded.Args)
+	}
+
+	// an ERC-20 Transfer shares the topic but indexes only two arguments
+	log.Topics = log.Topics[:3]
+	if _, err := decoder.Decode(log); err == nil {
+		t.Fatal("expected an error for an ERC-20 Transfer log")
+	}
+}
+
+func TestDefaultDecoders_DecodeRentalEvents(t *testing.T) {
+	decoders := blockchain.DefaultDecoders()
+
+	updateUser := crypto.Keccak256Hash([]byte("UpdateUser(uint256,address,uint64)")).Hex()
+	decoder, ok := decoders.Lookup(updateUser)
+	if !ok || decoder.Source != blockchain.DecoderSourceCollection {
+		t.Fatalf("expected a collection decoder for UpdateUser, got %+v", decoder)
+	}
+	event, err := decoder.Decode(&domain.Log{
+		Address: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		Topics:  []string{updateUser, uintTopic(7), addressTopic("0x00000000000000000000000000000000000000bb")},
+		Data:    packLogData(t, []string{"uint64"}, uint64(1700004000)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded := event.(*domain.DecodedEvent)
+	if decoded.Args["tokenId"] != "7" || decoded.Args["user"] != "0x00000000000000000000000000000000000000bb" || decoded.Args["expires"] != "1700004000" {
+		t.Fatalf("unexpected UpdateUser args: %#v", decoded.Args)
+	}
+
+	createRecord := crypto.Keccak256Hash([]byte("CreateUserRecord(uint256,uint256,uint64,address,address,uint64)")).Hex()
+	decoder, ok = decoders.Lookup(createRecord)
+	if !ok {
+		t.Fatal("expected a decoder for CreateUserRecord")
+	}
+	event, err = decoder.Decode(&domain.Log{
+		Address: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		Topics:  []string{createRecord},
+		Data: packLogData(t, []string{"uint256", "uint256", "uint64", "address", "address", "uint64"},
+			big.NewInt(1), big.NewInt(7), uint64(3),
+			common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+			common.HexToAddress("0x00000000000000000000000000000000000000bb"),
+			uint64(1700004000)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded = event.(*domain.DecodedEvent)
+	if decoded.Args["recordId"] != "1" || decoded.Args["amount"] != "3" || decoded.Args["owner"] != "0x00000000000000000000000000000000000000aa" {
+		t.Fatalf("unexpected CreateUserRecord args: %#v", decoded.Args)
+	}
+
+	if _, ok := decoders.Lookup(crypto.Keccak256Hash([]byte("DeleteUserRecord(uint256)")).Hex()); !ok {
+		t.Fatal("expected a decoder for DeleteUserRecord")
+	}
+}
+
+func TestDefaultDecoders_DecodePayoutSplitsUpdated(t *testing.T) {
+	decoders := blockchain.DefaultDecoders()
+
+	decoder, ok := decoders.Lookup(blockchain.PayoutSplitsUpdatedTopic)
+	if !ok || decoder.Source != blockchain.DecoderSourceCollection {
+		t.Fatalf("expected a collection decoder for PayoutSplitsUpdated, got %+v", decoder)
+	}
+
+	event, err := decoder.Decode(&domain.Log{
+		Address: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		Topics:  []string{blockchain.PayoutSplitsUpdatedTopic},
+		Data: packLogData(t, []string{"address[]", "uint256[]"},
+			[]common.Address{common.HexToAddress("0x00000000000000000000000000000000000000AA"), common.HexToAddress("0x00000000000000000000000000000000000000bb")},
+			[]*big.Int{big.NewInt(7000), big.NewInt(3000)}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	admin, ok := event.(*domain.CollectionAdminEvent)
+	if !ok || admin.Kind != domain.CollectionAdminPayoutSplitsUpdated ||
+		admin.CollectionAddress != "0x5fbdb2315678afecb367f032d93f642f64180aa3" {
+		t.Fatalf("unexpected decoded event: %#v", event)
+	}
+	want := []domain.PayoutSplit{
+		{Recipient: "0x00000000000000000000000000000000000000aa", Bps: 7000},
+		{Recipient: "0x00000000000000000000000000000000000000bb", Bps: 3000},
+	}
+	if len(admin.PayoutSplits) != len(want) || admin.PayoutSplits[0] != want[0] || admin.PayoutSplits[1] != want[1] {
+		t.Fatalf("unexpected payout splits: %#v", admin.PayoutSplits)
+	}
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/indexer-service/internal/service"
+)
+
+// recordingCheckpoints keeps every checkpoint write
+type recordingCheckpoints struct {
+	mu      sync.Mutex
+	updates [][]*domain.Checkpoint
+}
+
+func (r *recordingCheckpoints) ListCheckpoints(ctx context.Context, chainID string) ([]*domain.Checkpoint, error) {
+	return nil, nil
+}
+
+func (r *recordingCheckpoints) UpdateCheckpoints(ctx context.Context, checkpoints []*domain.Checkpoint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.updates = append(r.updates, checkpoints)
+	return nil
+}
+
+func (r *recordingCheckpoints) SeedCheckpoint(ctx context.Context, checkpoint *domain.Checkpoint) error {
+	return nil
+}
+
+func (r *recordingCheckpoints) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+// lastBlocks returns the block each checkpoint write advanced to
+func (r *recordingCheckpoints) lastBlocks() []int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	blocks := make([]int64, len(r.updates))
+	for i, update := range r.updates {
+		blocks[i] = update[0].LastBlock.Int64()
+	}
+	return blocks
+}
+
+func newPipelineIndexer() (*service.IndexerService, *recordingCheckpoints) {
+	checkpoints := &recordingCheckpoints{}
+	return service.NewIndexerService(nil, checkpoints, nil, nil, nil, nil, time.Second), checkpoints
+}
+
+func TestPipeline_PublishesInOrderAndCheckpointsEachRange(t *testing.T) {
+	indexer, checkpoints := newPipelineIndexer()
+
+	var mu sync.Mutex
+	var published, want []string
+	fetch := func(ctx context.Context, from, to *big.Int) ([]service.RangePublish, error) {
+		var publishes []service.RangePublish
+		for i := 0; i < 3; i++ {
+			description := fmt.Sprintf("%s-%s#%d", from, to, i)
+			publishes = append(publishes, service.RangePublish{
+				Description: description,
+				Publish: func(ctx context.Context) error {
+					mu.Lock()
+					defer mu.Unlock()
+					published = append(published, description)
+					return nil
+				},
+			})
+		}
+		return publishes, nil
+	}
+	for _, r := range []string{"1-100", "101-200", "201-250"} {
+		for i := 0; i < 3; i++ {
+			want = append(want, fmt.Sprintf("%s#%d", r, i))
+		}
+	}
+
+	err := indexer.RunPublishPipeline(context.Background(), "eip155-1", []string{"0xaaa", "0xbbb"}, big.NewInt(1), big.NewInt(250), fetch)
+	if err != nil {
+		t.Fatalf("pipeline failed: %v", err)
+	}
+
+	if fmt.Sprint(published) != fmt.Sprint(want) {
+		t.Fatalf("events published out of order:\n got %v\nwant %v", published, want)
+	}
+	if got := checkpoints.lastBlocks(); fmt.Sprint(got) != "[100 200 250]" {
+		t.Fatalf("expected one checkpoint write per range, got %v", got)
+	}
+	for _, update := range checkpoints.updates {
+		if len(update) != 2 || update[0].ContractAddress != "0xaaa" || update[1].ContractAddress != "0xbbb" {
+			t.Fatalf("expected a checkpoint per contract, got %+v", update)
+		}
+	}
+}
+
+func TestPipeline_FullPublishQueuePausesFetching(t *testing.T) {
+	indexer, checkpoints := newPipelineIndexer()
+
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	defer releaseOnce.Do(func() { close(release) })
+	blocked := make(chan struct{})
+	var blockOnce sync.Once
+
+	var fetches, publishes atomic.Int64
+	fetch := func(ctx context.Context, from, to *big.Int) ([]service.RangePublish, error) {
+		fetches.Add(1)
+		// A range alone fills the publish queue
+		jobs := make([]service.RangePublish, service.PublishQueueSize)
+		for i := range jobs {
+			jobs[i] = service.RangePublish{
+				Description: fmt.Sprintf("%s#%d", from, i),
+				Publish: func(ctx context.Context) error {
+					blockOnce.Do(func() { close(blocked) })
+					select {
+					case <-release:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+					publishes.Add(1)
+					return nil
+				},
+			}
+		}
+		return jobs, nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- indexer.RunPublishPipeline(context.Background(), "eip155-1", []string{"0xaaa"}, big.NewInt(1), big.NewInt(2000), fetch)
+	}()
+
+	<-blocked
+	// Unblocked, the 20 ranges would be fetched in about 2s; stalled publishing caps it at the
+	// range being parsed, the queued ones and the one waiting to be queued
+	time.Sleep(time.Second)
+	stalled := fetches.Load()
+	if max := int64(service.FetchQueueSize + 3); stalled > max {
+		t.Fatalf("fetching did not pause while publishing stalled: %d ranges fetched, expected at most %d", stalled, max)
+	}
+	if len(checkpoints.lastBlocks()) != 0 {
+		t.Fatalf("checkpoint advanced before the range was published: %v", checkpoints.lastBlocks())
+	}
+
+	releaseOnce.Do(func() { close(release) })
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("pipeline failed: %v", err)
+		}
+	case <-time.After(30 * time.Second):
+		t.Fatal("pipeline did not finish once publishing resumed")
+	}
+	if fetches.Load() != 20 || publishes.Load() != 20*service.PublishQueueSize {
+		t.Fatalf("expected every range fetched and published, got %d fetches and %d publishes", fetches.Load(), publishes.Load())
+	}
+	if blocks := checkpoints.lastBlocks(); len(blocks) != 20 || blocks[19] != 2000 {
+		t.Fatalf("expected checkpoints through block 2000, got %v", blocks)
+	}
+}
+
+func TestPipeline_FailedPublishHoldsCheckpoint(t *testing.T) {
+	indexer, checkpoints := newPipelineIndexer()
+	indexer.SetPublishRetryDelay(time.Millisecond)
+
+	brokerDown := errors.New("broker unavailable")
+	var attempts atomic.Int64
+	var published []string
+	fetch := func(ctx context.Context, from, to *big.Int) ([]service.RangePublish, error) {
+		var jobs []service.RangePublish
+		for i := 0; i < 2; i++ {
+			description := fmt.Sprintf("%s#%d", from, i)
+			fails := from.Int64() == 101 && i == 0
+			jobs = append(jobs, service.RangePublish{
+				Description: description,
+				Publish: func(ctx context.Context) error {
+					if fails {
+						attempts.Add(1)
+						return brokerDown
+					}
+					published = append(published, description)
+					return nil
+				},
+			})
+		}
+		return jobs, nil
+	}
+
+	err := indexer.RunPublishPipeline(context.Background(), "eip155-1", []string{"0xaaa"}, big.NewInt(1), big.NewInt(300), fetch)
+	if !errors.Is(err, brokerDown) {
+		t.Fatalf("expected the publish failure, got %v", err)
+	}
+	if attempts.Load() != service.MaxRetries {
+		t.Fatalf("expected %d attempts, got %d", service.MaxRetries, attempts.Load())
+	}
+	if fmt.Sprint(published) != "[1#0 1#1]" {
+		t.Fatalf("nothing after the failed event may be published, got %v", published)
+	}
+	if got := checkpoints.lastBlocks(); fmt.Sprint(got) != "[100]" {
+		t.Fatalf("checkpoint must stay at the last fully published range, got %v", got)
+	}
+}
+
+func TestPipeline_CancellationStopsEveryStage(t *testing.T) {
+	indexer, checkpoints := newPipelineIndexer()
+	baseline := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	publishing := make(chan struct{})
+	var publishingOnce sync.Once
+	fetch := func(ctx context.Context, from, to *big.Int) ([]service.RangePublish, error) {
+		jobs := make([]service.RangePublish, service.PublishQueueSize)
+		for i := range jobs {
+			jobs[i] = service.RangePublish{
+				Description: fmt.Sprintf("%s#%d", from, i),
+				Publish: func(ctx context.Context) error {
+					publishingOnce.Do(func() { close(publishing) })
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			}
+		}
+		return jobs, nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- indexer.RunPublishPipeline(ctx, "eip155-1", []string{"0xaaa"}, big.NewInt(1), big.NewInt(100000), fetch)
+	}()
+
+	<-publishing
+	// Let the fetch and parse stages block on their full queues
+	time.Sleep(300 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("pipeline deadlocked after cancellation")
+	}
+	if len(checkpoints.lastBlocks()) != 0 {
+		t.Fatalf("checkpoint advanced for a cancelled range: %v", checkpoints.lastBlocks())
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > baseline {
+		if time.Now().After(deadline) {
+			t.Fatalf("pipeline goroutines leaked: %d running, %d before", runtime.NumGoroutine(), baseline)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -4,19 +4,18 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net"
-	"os"
-	"os/signal"
-	"syscall"
-
-	"google.golang.org/grpc"
 
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/infrastructure/events"
 	grpc_handler "github.com/quangdang46/NFT-Marketplace/services/media-service/internal/infrastructure/grpc"
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/infrastructure/pinning"
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/infrastructure/repository"
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/grpcserver"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/mongo"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 	mediaProto "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
 )
@@ -25,6 +24,13 @@ func main() {
 	// Load configuration
 
 	cfg := config.LoadConfig()
+
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("media-service"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	fmt.Println("===>JWTKey", cfg.PinataConfig.JWTKey)
 	if err := cfg.Validate(); err != nil {
 		log.Fatalf("Invalid configuration: %v", err)
@@ -46,7 +52,7 @@ func main() {
 		log.Fatalf("Failed to ping MongoDB: %v", err)
 	}
 
-	// Initialize Redis (optional, for caching)
+	// Initialize Redis for asset lookup caching and upload progress
 	redisClient, err := redis.NewRedis(cfg.Redis)
 	if err != nil {
 		log.Fatalf("Failed to connect to Redis: %v", err)
@@ -58,41 +64,75 @@ func main() {
 	}
 
 	// Initialize repository
-	mediaRepo := repository.NewMediaRepository(mongoClient)
+	mediaRepo := repository.NewMediaRepository(mongoClient, redisClient)
 
-	// Initialize Pinata client
-	pinataClient := pinning.NewPinataClient(cfg.PinataConfig)
+	// Initialize pinning providers with failover between them
+	providers, err := pinning.NewProviders(cfg)
+	if err != nil {
+		log.Fatalf("Failed to configure pinning providers: %v", err)
+	}
+	pinner := pinning.NewProviderChain(providers, cfg.Pinning.FailureThreshold, cfg.Pinning.UnhealthyCooldown)
+	pinner.StartHealthChecks(ctx, cfg.Pinning.HealthCheckInterval)
 
 	// Initialize service
 	mediaService := service.NewMediaService(
 		mediaRepo,
-		pinataClient,
+		pinner,
 	)
+	// Progress of ticketed uploads reaches clients through the subscription worker
+	mediaService.SetProgressPublisher(redisClient)
+
+	// Quota announcements are best effort: uploads must not depend on RabbitMQ
+	var quotaEvents domain.QuotaEventPublisher
+	if amqpClient, err := messaging.NewRabbitMQ(cfg.RabbitMQ); err != nil {
+		log.Printf("rabbitmq unavailable, quota_exceeded events disabled: %v", err)
+	} else {
+		defer amqpClient.Close()
+		if p, err := events.NewEventPublisher(amqpClient); err != nil {
+			log.Printf("quota_exceeded events disabled: %v", err)
+		} else {
+			quotaEvents = p
+		}
+	}
+	mediaService.SetStorageQuota(repository.NewUsageRepository(mongoClient), storageQuota(cfg.Quota), quotaEvents)
+
+	// Generated exports are kept in Mongo until their retention ends
+	artifactRepo := repository.NewArtifactRepository(mongoClient)
+	if err := artifactRepo.EnsureIndexes(ctx); err != nil {
+		log.Printf("Failed to ensure artifact indexes: %v", err)
+	}
+	mediaService.SetArtifacts(artifactRepo, []byte(cfg.Artifacts.SigningSecret), cfg.Artifacts.BaseURL, cfg.Artifacts.URLTTL)
+	mediaService.SetTokenGateSecret([]byte(cfg.Artifacts.TokenGateSecret))
 
 	// Initialize gRPC server
-	server := grpc.NewServer()
+	server := grpcserver.New(grpcserver.LoadConfig("media-service"))
 	grpcHandler := grpc_handler.NewgRPCHandler(mediaService)
 	mediaProto.RegisterMediaServiceServer(server, grpcHandler)
 
-	// Start listening
-	lis, err := net.Listen("tcp", cfg.GRPCPort)
-	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
-	}
-
-	// Handle graceful shutdown
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-
-	go func() {
-		<-sigChan
-		log.Println("Shutting down Media Service...")
-		cancel()
-		server.GracefulStop()
-	}()
-
+	// Serve until SIGINT/SIGTERM, then shut down gracefully
 	log.Printf("Media service listening on %s", cfg.GRPCPort)
-	if err := server.Serve(lis); err != nil {
+	if err := grpcserver.ListenAndServe(server, cfg.GRPCPort); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+	log.Println("Shutting down Media Service...")
+}
+
+// storageQuota converts the configured limits for the service
+func storageQuota(q config.QuotaConfig) domain.StorageQuota {
+	kinds := make(map[string]domain.QuotaLimits)
+	for kind, bytes := range q.KindBytes {
+		limits := kinds[kind]
+		limits.Bytes = bytes
+		kinds[kind] = limits
+	}
+	for kind, assets := range q.KindAssets {
+		limits := kinds[kind]
+		limits.Assets = assets
+		kinds[kind] = limits
+	}
+	return domain.StorageQuota{
+		Soft:  domain.QuotaLimits{Bytes: q.SoftBytes, Assets: q.SoftAssets},
+		Hard:  domain.QuotaLimits{Bytes: q.HardBytes, Assets: q.HardAssets},
+		Kinds: kinds,
+	}
 }
@@ -2,10 +2,15 @@ package config
 
 import (
 	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/shared/env"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/mongo"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 // Config contains configuration for Media Service
@@ -13,7 +18,13 @@ type Config struct {
 	GRPCPort     string
 	MongoDB      mongo.MongoConfig
 	Redis        redis.RedisConfig
+	RabbitMQ     messaging.RabbitMQConfig
 	PinataConfig PinataConfig
+	NFTStorage   NFTStorageConfig
+	Kubo         KuboConfig
+	Pinning      PinningConfig
+	Quota        QuotaConfig
+	Artifacts    ArtifactConfig
 }
 
 type PinataConfig struct {
@@ -24,6 +35,50 @@ type PinataConfig struct {
 	JWTKey     string
 }
 
+// NFTStorageConfig configures an NFT.Storage or web3.storage compatible upload API
+type NFTStorageConfig struct {
+	BaseURL    string
+	Token      string
+	GatewayURL string
+}
+
+// KuboConfig configures a self-hosted IPFS node through its Kubo RPC API
+type KuboConfig struct {
+	RPCURL     string
+	AuthHeader string
+	GatewayURL string
+}
+
+// PinningConfig orders the pinning providers and tunes failover between them
+type PinningConfig struct {
+	Providers           []string // tried in order, e.g. pinata,nftstorage,kubo
+	FailureThreshold    int      // consecutive failures before a provider is marked unhealthy
+	UnhealthyCooldown   time.Duration
+	HealthCheckInterval time.Duration
+}
+
+// QuotaConfig limits the storage each user keeps pinned; 0 leaves a limit off.
+// Soft limits only announce quota_exceeded, hard limits reject uploads.
+type QuotaConfig struct {
+	SoftBytes  int64
+	HardBytes  int64
+	SoftAssets int64
+	HardAssets int64
+	// Hard limits per media kind, e.g. VIDEO=1073741824
+	KindBytes  map[string]int64
+	KindAssets map[string]int64
+}
+
+// ArtifactConfig signs download URLs of generated files such as holder exports
+type ArtifactConfig struct {
+	SigningSecret string
+	BaseURL       string // public route serving downloads, e.g. the gateway's /artifacts
+	URLTTL        time.Duration
+
+	// TokenGateSecret checks the catalog's gate tokens; empty denies gated downloads
+	TokenGateSecret string
+}
+
 // LoadConfig loads configuration from environment variables
 func LoadConfig() *Config {
 	log.Println("Loading Media Service configuration...")
@@ -32,11 +87,17 @@ func LoadConfig() *Config {
 		GRPCPort:     env.GetString("MEDIA_GRPC_PORT", ":50054"),
 		MongoDB:      loadMongoConfig(),
 		Redis:        loadRedisConfig(),
+		RabbitMQ:     loadRabbitMQConfig(),
 		PinataConfig: loadPinataConfig(),
+		NFTStorage:   loadNFTStorageConfig(),
+		Kubo:         loadKuboConfig(),
+		Pinning:      loadPinningConfig(),
+		Quota:        loadQuotaConfig(),
+		Artifacts:    loadArtifactConfig(),
 	}
 
-	log.Printf("Media Service config loaded - gRPC: %s",
-		config.GRPCPort)
+	log.Printf("Media Service config loaded - gRPC: %s, pinning providers: %v",
+		config.GRPCPort, config.Pinning.Providers)
 
 	return config
 }
@@ -46,6 +107,7 @@ func loadMongoConfig() mongo.MongoConfig {
 	return mongo.MongoConfig{
 		MongoURI:      env.GetString("MONGO_URI", "mongodb://localhost:27017"),
 		MongoDatabase: env.GetString("MONGO_DATABASE", "nft_marketplace"),
+		Tenant:        tenant.FromEnv(),
 	}
 }
 
@@ -54,6 +116,18 @@ func loadRedisConfig() redis.RedisConfig {
 	return redis.RedisConfig{
 		RedisHost: env.GetString("REDIS_HOST", "localhost"),
 		RedisPort: env.GetInt("REDIS_PORT", 6379),
+		Tenant:    tenant.FromEnv(),
+	}
+}
+
+// loadRabbitMQConfig loads RabbitMQ configuration
+func loadRabbitMQConfig() messaging.RabbitMQConfig {
+	return messaging.RabbitMQConfig{
+		RabbitMQHost:     env.GetString("RABBITMQ_HOST", "localhost"),
+		RabbitMQPort:     env.GetInt("RABBITMQ_PORT", 5672),
+		RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
+		RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
 
@@ -68,6 +142,81 @@ func loadPinataConfig() PinataConfig {
 	}
 }
 
+// loadNFTStorageConfig loads NFT.Storage configuration
+func loadNFTStorageConfig() NFTStorageConfig {
+	return NFTStorageConfig{
+		BaseURL:    env.GetString("NFT_STORAGE_BASE_URL", "https://api.nft.storage"),
+		Token:      env.GetString("NFT_STORAGE_TOKEN", ""),
+		GatewayURL: env.GetString("NFT_STORAGE_GATEWAY_URL", "https://nftstorage.link"),
+	}
+}
+
+// loadKuboConfig loads the self-hosted IPFS node configuration
+func loadKuboConfig() KuboConfig {
+	return KuboConfig{
+		RPCURL:     env.GetString("KUBO_RPC_URL", "http://localhost:5001"),
+		AuthHeader: env.GetString("KUBO_AUTH_HEADER", ""),
+		GatewayURL: env.GetString("KUBO_GATEWAY_URL", ""),
+	}
+}
+
+// loadPinningConfig loads provider order and failover settings
+func loadPinningConfig() PinningConfig {
+	return PinningConfig{
+		Providers:           env.GetStringList("PINNING_PROVIDERS", []string{"pinata"}),
+		FailureThreshold:    env.GetInt("PINNING_FAILURE_THRESHOLD", 3),
+		UnhealthyCooldown:   time.Duration(env.GetInt("PINNING_UNHEALTHY_COOLDOWN_SECONDS", 60)) * time.Second,
+		HealthCheckInterval: time.Duration(env.GetInt("PINNING_HEALTH_CHECK_INTERVAL_SECONDS", 30)) * time.Second,
+	}
+}
+
+// loadQuotaConfig loads per-user storage limits
+func loadQuotaConfig() QuotaConfig {
+	return QuotaConfig{
+		SoftBytes:  int64(env.GetInt("MEDIA_QUOTA_SOFT_BYTES", 0)),
+		HardBytes:  int64(env.GetInt("MEDIA_QUOTA_HARD_BYTES", 0)),
+		SoftAssets: int64(env.GetInt("MEDIA_QUOTA_SOFT_ASSETS", 0)),
+		HardAssets: int64(env.GetInt("MEDIA_QUOTA_HARD_ASSETS", 0)),
+		KindBytes:  kindLimits("MEDIA_QUOTA_KIND_BYTES"),
+		KindAssets: kindLimits("MEDIA_QUOTA_KIND_ASSETS"),
+	}
+}
+
+// kindLimits parses a KIND=limit list such as VIDEO=1073741824,AUDIO=104857600
+func kindLimits(key string) map[string]int64 {
+	limits := make(map[string]int64)
+	for _, entry := range env.GetStringList(key, nil) {
+		kind, value, ok := strings.Cut(entry, "=")
+		limit, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if !ok || err != nil || limit < 0 {
+			log.Fatalf("Invalid %s entry %q, expected KIND=limit", key, entry)
+		}
+		limits[strings.ToUpper(strings.TrimSpace(kind))] = limit
+	}
+	return limits
+}
+
+// loadArtifactConfig loads artifact download settings
+func loadArtifactConfig() ArtifactConfig {
+	return ArtifactConfig{
+		SigningSecret: env.GetString("ARTIFACT_SIGNING_SECRET", "dev-artifact-signing-secret"),
+		BaseURL:       env.GetString("ARTIFACT_BASE_URL", "http://localhost:8081/artifacts"),
+		URLTTL:        time.Duration(env.GetInt("ARTIFACT_URL_TTL_MINUTES", 60)) * time.Minute,
+
+		TokenGateSecret: env.GetString("TOKEN_GATE_SECRET", ""),
+	}
+}
+
+// usesProvider reports whether a pinning provider is enabled
+func (c *Config) usesProvider(name string) bool {
+	for _, p := range c.Pinning.Providers {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Validate validates the configuration
 func (c *Config) Validate() error {
 	if c.GRPCPort == "" {
@@ -76,9 +225,21 @@ func (c *Config) Validate() error {
 	if c.MongoDB.MongoURI == "" {
 		log.Fatal("MONGO_URI is required")
 	}
-	if c.PinataConfig.APIKey == "" {
+	if c.Artifacts.SigningSecret == "" {
+		log.Fatal("ARTIFACT_SIGNING_SECRET is required")
+	}
+	if len(c.Pinning.Providers) == 0 {
+		log.Fatal("PINNING_PROVIDERS is required")
+	}
+	if c.usesProvider("pinata") && c.PinataConfig.APIKey == "" {
 		log.Fatal("PINATA_API_KEY is required")
 	}
+	if c.usesProvider("nftstorage") && c.NFTStorage.Token == "" {
+		log.Fatal("NFT_STORAGE_TOKEN is required")
+	}
+	if c.usesProvider("kubo") && c.Kubo.RPCURL == "" {
+		log.Fatal("KUBO_RPC_URL is required")
+	}
 
 	log.Println("Media Service configuration validation passed")
 	return nil
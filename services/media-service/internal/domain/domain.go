@@ -5,6 +5,8 @@ import (
 	"context"
 	"io"
 	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 )
 
 //
@@ -27,6 +29,9 @@ type UploadMeta struct {
 	Width    *uint32
 	Height   *uint32
 	OwnerID  string // optional (for audit/link)
+
+	Ticket     string // optional; progress is also published under it
+	TotalBytes int64  // optional, announced size of the upload
 }
 
 type AssetDoc struct {
@@ -41,9 +46,13 @@ type AssetDoc struct {
 	IPFSCID     *string           `bson:"ipfs_cid,omitempty"`
 	GatewayURL  *string           `bson:"gateway_url,omitempty"`
 	PinStatus   string            `bson:"pin_status"` // expects values of PinStatus
+	PinProvider *string           `bson:"pin_provider,omitempty"`
 	PinAttempts int               `bson:"pin_attempts"`
 	PinError    *string           `bson:"pin_error,omitempty"`
+	PinnedAt    *time.Time        `bson:"pinned_at,omitempty"` // last time a provider confirmed the pin
 	RefCount    uint32            `bson:"ref_count"`
+	Refs        []string          `bson:"refs,omitempty"`     // holders counted in RefCount besides the upload
+	OwnerID     string            `bson:"owner_id,omitempty"` // uploader charged for the asset, cleared once released
 	Variants    []AssetVariantDoc `bson:"variants"`
 	CreatedAt   time.Time         `bson:"created_at"`
 }
@@ -74,18 +83,37 @@ type MediaRepository interface {
 	// Update detected props after upload
 	UpdateAfterUpload(ctx context.Context, id, mime string, bytes int64, w, h *uint32) error
 
-	// Set final pin result (Pinata SYNC path)
-	SetPinned(ctx context.Context, id, cid string, gatewayURL *string) error
+	// Set final pin result (SYNC path) and the provider holding the pin
+	SetPinned(ctx context.Context, id, cid, provider string, gatewayURL *string) error
+
+	// Most recent pin confirmation across all assets; nil before the first
+	LastPinnedAt(ctx context.Context) (*time.Time, error)
+
+	// Forget the pin after the content was unpinned from its provider
+	ClearPin(ctx context.Context, id string) error
+
+	// Add or replace a rendition (thumbnail, webp, ...) of an asset
+	UpsertVariant(ctx context.Context, id string, variant AssetVariantDoc) error
+
+	// Count holder against a pinned asset; ErrNotPinned otherwise. Adding a held ref is a no-op.
+	AddRef(ctx context.Context, id, ref string) (*AssetDoc, error)
+
+	// Drop holder's ref; released is false when it held none
+	ReleaseRef(ctx context.Context, id, ref string) (asset *AssetDoc, released bool, err error)
+
+	// Drop the uploader's hold; released is false when ownerID does not hold it
+	ReleaseOwner(ctx context.Context, id, ownerID string) (asset *AssetDoc, released bool, err error)
 
 	// Paging (admin/debug)
 	List(ctx context.Context, filter map[string]any, pageSize int, pageToken string) (items []AssetDoc, next string, err error)
 }
 
-// =============== Infra: Pinner (Pinata) ===============
+// =============== Infra: Pinner (Pinata, NFT.Storage, Kubo) ===============
 type PinResult struct {
 	CID         string `json:"IpfsHash"`
 	Size        int64  `json:"PinSize"`
 	IsDuplicate bool   `json:"IsDuplicate"`
+	Provider    string `json:"-"` // name of the provider holding the pin
 }
 
 type Pinner interface {
@@ -95,6 +123,140 @@ type Pinner interface {
 	GatewayURL(cid string) string
 }
 
+// PinProvider is a single pinning backend
+type PinProvider interface {
+	Pinner
+	Name() string
+	// PinCID pins content that is already on IPFS, e.g. to re-pin it elsewhere
+	PinCID(ctx context.Context, cid, name string) (PinResult, error)
+	HealthCheck(ctx context.Context) error
+}
+
+// PinRouter is a Pinner spread over several providers that can target one of them
+type PinRouter interface {
+	Pinner
+	UnpinFrom(ctx context.Context, provider, cid string) error
+	// PinCIDElsewhere pins cid on a healthy provider other than exclude
+	PinCIDElsewhere(ctx context.Context, cid, name, exclude string) (PinResult, error)
+	ProviderGatewayURL(provider, cid string) string
+}
+
+// =============== Upload progress ===============
+
+// ProgressFunc receives the progress reports of one upload
+type ProgressFunc func(progress contracts.UploadProgress)
+
+// ProgressPublisher fans upload progress out to the clients following its ticket
+type ProgressPublisher interface {
+	PublishUploadProgress(ctx context.Context, progress contracts.UploadProgress) error
+}
+
+// =============== Storage quotas ===============
+
+// QuotaLimits bound what one user keeps pinned; 0 leaves a resource unlimited
+type QuotaLimits struct {
+	Bytes  int64
+	Assets int64
+}
+
+// StorageQuota holds the soft limits that only notify, the hard limits that reject uploads,
+// and hard limits per file type keyed by media kind
+type StorageQuota struct {
+	Soft  QuotaLimits
+	Hard  QuotaLimits
+	Kinds map[string]QuotaLimits
+}
+
+// StorageUsage is what a user holds: the assets they uploaded and have not released
+type StorageUsage struct {
+	OwnerID   string               `bson:"_id"`
+	Bytes     int64                `bson:"bytes"`
+	Assets    int64                `bson:"assets"`
+	Kinds     map[string]KindUsage `bson:"kinds,omitempty"`
+	UpdatedAt time.Time            `bson:"updated_at"`
+}
+
+// KindUsage is the share of one media kind in a user's usage
+type KindUsage struct {
+	Bytes  int64 `bson:"bytes"`
+	Assets int64 `bson:"assets"`
+}
+
+type UsageRepository interface {
+	// GetUsage returns zero usage for a user without uploads
+	GetUsage(ctx context.Context, ownerID string) (*StorageUsage, error)
+
+	// AddUsage moves the user's totals and those of kind by the deltas and returns the result
+	AddUsage(ctx context.Context, ownerID, kind string, bytes, assets int64) (*StorageUsage, error)
+}
+
+// QuotaExceeded describes a limit an upload crossed (soft) or was rejected by (hard)
+type QuotaExceeded struct {
+	OwnerID    string
+	Level      string // contracts.QuotaLevel*
+	Resource   string // contracts.QuotaResource*
+	Kind       string // set for a per-file-type limit
+	Used       int64
+	Requested  int64
+	Limit      int64
+	ExceededAt time.Time
+}
+
+// QuotaEventPublisher announces exceeded quotas, e.g. for billing to offer an upgrade
+type QuotaEventPublisher interface {
+	PublishQuotaExceeded(ctx context.Context, exceeded *QuotaExceeded) error
+}
+
+// =============== Artifacts ===============
+
+// ArtifactDoc is a generated file such as an export. Unlike assets it is kept in Mongo
+// rather than pinned, so it stays private to holders of its signed URL, and it expires.
+type ArtifactDoc struct {
+	ID        string    `bson:"_id"`
+	OwnerID   string    `bson:"owner_id,omitempty"`
+	Name      string    `bson:"name"`
+	Mime      string    `bson:"mime"`
+	Bytes     int64     `bson:"bytes"`
+	SHA256    string    `bson:"sha256"`
+	Content   []byte    `bson:"content,omitempty"`
+	CreatedAt time.Time `bson:"created_at"`
+	ExpiresAt time.Time `bson:"expires_at"`
+
+	// Gate, when set, also requires a gate token covering it to download
+	Gate *ArtifactGate `bson:"gate,omitempty"`
+}
+
+// ArtifactGate restricts an artifact to holders of MinBalance (decimal) of a collection
+type ArtifactGate struct {
+	ChainID    string `bson:"chain_id"`
+	Contract   string `bson:"contract"`
+	MinBalance string `bson:"min_balance"`
+}
+
+type ArtifactRepository interface {
+	CreateArtifact(ctx context.Context, a *ArtifactDoc) error
+
+	// GetArtifact returns ErrArtifactNotFound once the artifact expired; the content is
+	// only loaded when asked for
+	GetArtifact(ctx context.Context, id string, withContent bool) (*ArtifactDoc, error)
+}
+
+type StoreArtifactInput struct {
+	Name    string
+	Mime    string
+	OwnerID string        // optional (audit)
+	TTL     time.Duration // 0 keeps the default retention
+	Content []byte
+	Gate    *ArtifactGate // optional
+}
+
+// SignedArtifact is an artifact with a download URL valid until URLExpiresAt
+type SignedArtifact struct {
+	*ArtifactDoc
+	DownloadURL  string
+	URLExpiresAt time.Time
+}
+
 //
 // =============== Service ===============
 //
@@ -104,7 +266,32 @@ type MediaService interface {
 	// Upload bytes + pin immediately; return asset with CID.
 	UploadAndPin(ctx context.Context, meta UploadMeta, r io.Reader, sizeHint int64) (asset *AssetDoc, dedup bool, err error)
 
+	// UploadAndPin reporting each stage to onProgress and, for a ticketed upload, to its followers
+	UploadAndPinWithProgress(ctx context.Context, meta UploadMeta, r io.Reader, sizeHint int64, onProgress ProgressFunc) (asset *AssetDoc, dedup bool, err error)
+
 	// Queries
 	GetAsset(ctx context.Context, id string) (*AssetDoc, error)
 	GetAssetByCID(ctx context.Context, cid string) (*AssetDoc, error)
+
+	// References from other services (e.g. collection intents) keep attached assets accounted for
+	AddRef(ctx context.Context, id, ref string) (*AssetDoc, error)
+	ReleaseRef(ctx context.Context, id, ref string) (asset *AssetDoc, released bool, err error)
+
+	// The uploader gives up an asset, which no longer counts against their storage quota
+	ReleaseAsset(ctx context.Context, id, ownerID string) (asset *AssetDoc, released bool, err error)
+
+	// Storage a user holds, with the quota it is measured against
+	GetStorageUsage(ctx context.Context, ownerID string) (*StorageUsage, StorageQuota, error)
+
+	// Generated files downloaded through signed URLs
+	StoreArtifact(ctx context.Context, in StoreArtifactInput) (*SignedArtifact, error)
+	GetArtifact(ctx context.Context, id string) (*SignedArtifact, error)
+	DownloadArtifact(ctx context.Context, id string, expires int64, signature, gateToken string) (*ArtifactDoc, error)
+
+	// When content was last pinned, nil before the first pin
+	LastPinnedAt(ctx context.Context) (*time.Time, error)
+
+	// Pin maintenance when a provider degrades
+	UnpinAsset(ctx context.Context, id string) error
+	RepinAsset(ctx context.Context, id string) (*AssetDoc, error)
 }
@@ -1,18 +1,25 @@
 package domain
 
+import "github.com/quangdang46/NFT-Marketplace/shared/errs"
+
 var (
-	ErrNotFound           = errSentinel("not found")
-	ErrAlreadyExists      = errSentinel("already exists")
-	ErrInvalid            = errSentinel("invalid argument")
-	ErrAssetNotFound      = errSentinel("asset not found")
-	ErrAssetAlreadyExists = errSentinel("asset already exists")
-	ErrInvalidMimeType    = errSentinel("invalid mime type")
-	ErrInvalidFormat      = errSentinel("invalid format")
-	ErrPinFailed          = errSentinel("pin failed")
-	ErrStorageFailed      = errSentinel("storage failed")
-	ErrInvalidInput       = errSentinel("invalid input")
+	ErrNotFound           = errs.New(errs.NotFound, "not found")
+	ErrAlreadyExists      = errs.New(errs.AlreadyExists, "already exists")
+	ErrInvalid            = errs.New(errs.InvalidArgument, "invalid argument")
+	ErrAssetNotFound      = errs.New(errs.NotFound, "asset not found")
+	ErrAssetAlreadyExists = errs.New(errs.AlreadyExists, "asset already exists")
+	ErrInvalidMimeType    = errs.New(errs.InvalidArgument, "invalid mime type")
+	ErrInvalidFormat      = errs.New(errs.InvalidArgument, "invalid format")
+	ErrPinFailed          = errs.New(errs.Unavailable, "pin failed")
+	ErrNotPinned          = errs.New(errs.FailedPrecondition, "asset not pinned")
+	ErrNoPinProvider      = errs.New(errs.Unavailable, "no pin provider available")
+	ErrStorageFailed      = errs.New(errs.Unavailable, "storage failed")
+	ErrInvalidInput       = errs.New(errs.InvalidArgument, "invalid input")
+	ErrQuotaExceeded      = errs.New(errs.ResourceExhausted, "storage quota exceeded")
+	ErrUsageUnavailable   = errs.New(errs.Unavailable, "storage usage unavailable")
+	ErrArtifactNotFound   = errs.New(errs.NotFound, "artifact not found")
+	ErrArtifactTooLarge   = errs.New(errs.InvalidArgument, "artifact too large")
+	ErrInvalidSignature   = errs.New(errs.PermissionDenied, "invalid or expired download signature")
+	ErrArtifactsDisabled  = errs.New(errs.Unavailable, "artifact storage unavailable")
+	ErrGateTokenRequired  = errs.New(errs.PermissionDenied, "a gate token for the collection is required")
 )
-
-type errSentinel string
-
-func (e errSentinel) Error() string { return string(e) }
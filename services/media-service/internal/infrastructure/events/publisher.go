@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+)
+
+type EventPublisher struct {
+	amqp *messaging.RabbitMQ
+}
+
+// NewEventPublisher creates a new RabbitMQ media event publisher
+func NewEventPublisher(amqp *messaging.RabbitMQ) (*EventPublisher, error) {
+	err := amqp.DeclareExchange(messaging.ExchangeConfig{
+		Name:    contracts.MediaExchange,
+		Type:    "topic",
+		Durable: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to declare %s exchange: %w", contracts.MediaExchange, err)
+	}
+	return &EventPublisher{amqp: amqp}, nil
+}
+
+// PublishQuotaExceeded publishes on media.quota_exceeded
+func (p *EventPublisher) PublishQuotaExceeded(ctx context.Context, exceeded *domain.QuotaExceeded) error {
+	event := contracts.StorageQuotaExceededEvent{
+		EventID:    fmt.Sprintf("quota_exceeded_%s_%s_%s_%d", exceeded.OwnerID, exceeded.Level, exceeded.Resource, exceeded.ExceededAt.UnixNano()),
+		UserID:     exceeded.OwnerID,
+		Level:      exceeded.Level,
+		Resource:   exceeded.Resource,
+		Kind:       exceeded.Kind,
+		Used:       exceeded.Used,
+		Requested:  exceeded.Requested,
+		Limit:      exceeded.Limit,
+		ExceededAt: exceeded.ExceededAt,
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal quota_exceeded event: %w", err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   contracts.MediaExchange,
+		RoutingKey: contracts.QuotaExceededKey,
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   contracts.QuotaExceededKey,
+			"user_id":      exceeded.OwnerID,
+			"published_at": exceeded.ExceededAt.Unix(),
+			"content_type": "application/json",
+		},
+		Timestamp: exceeded.ExceededAt,
+		MessageID: event.EventID,
+	}
+
+	if err := p.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("failed to publish quota_exceeded event: %w", err)
+	}
+	return nil
+}
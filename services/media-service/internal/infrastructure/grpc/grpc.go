@@ -3,15 +3,35 @@ package grpc_handler
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/utils"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
 	mediaProto "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
 )
 
+// MaxStreamUploadBytes bounds a streamed upload; unary uploads are bounded by the gRPC message size
+const MaxStreamUploadBytes = 100 << 20
+
+// uploadTicketPattern keeps tickets usable as subscription keys
+var uploadTicketPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{8,64}$`)
+
+var (
+	errUploadTooLarge = errors.New("upload exceeds the maximum size")
+	errEmptyUpload    = errors.New("file data cannot be empty")
+	errMetaResent     = errors.New("upload meta may only be sent first")
+)
+
 type gRPCHandler struct {
 	mediaProto.UnimplementedMediaServiceServer
 	mediaService domain.MediaService
@@ -56,7 +76,7 @@ func (g *gRPCHandler) UploadSingleFile(ctx context.Context, req *mediaProto.Sing
 	// Call service with file data
 	asset, dedup, err := g.mediaService.UploadAndPin(ctx, domainMeta, bytes.NewReader(req.FileData), int64(len(req.FileData)))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upload and pin: %v", err)
+		return nil, errs.ToGRPC(fmt.Errorf("failed to upload and pin: %w", err))
 	}
 
 	// Convert response
@@ -68,13 +88,120 @@ func (g *gRPCHandler) UploadSingleFile(ctx context.Context, req *mediaProto.Sing
 	return response, nil
 }
 
+// UploadFileStream receives a file as meta followed by chunks and answers with progress
+// reports while it is received, pinned and recorded, then the result as the last message
+func (g *gRPCHandler) UploadFileStream(stream mediaProto.MediaService_UploadFileStreamServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to receive upload meta: %v", err)
+	}
+	meta := first.GetMeta()
+	if meta == nil {
+		return status.Errorf(codes.InvalidArgument, "first message must carry the upload meta")
+	}
+	if meta.Filename == "" {
+		return status.Errorf(codes.InvalidArgument, "filename is required")
+	}
+	if meta.Mime == "" {
+		return status.Errorf(codes.InvalidArgument, "mime type is required")
+	}
+	if meta.UploadTicket != "" && !uploadTicketPattern.MatchString(meta.UploadTicket) {
+		return status.Errorf(codes.InvalidArgument, "upload ticket must be 8-64 letters, digits, - or _")
+	}
+	if meta.TotalBytes > MaxStreamUploadBytes {
+		return status.Errorf(codes.ResourceExhausted, "upload exceeds %d bytes", MaxStreamUploadBytes)
+	}
+
+	domainMeta := domain.UploadMeta{
+		Filename:   meta.Filename,
+		Mime:       meta.Mime,
+		Kind:       utils.ProtoToDomainMediaKind(meta.Kind),
+		OwnerID:    meta.OwnerId,
+		Ticket:     meta.UploadTicket,
+		TotalBytes: int64(meta.TotalBytes),
+	}
+	if meta.Width != nil {
+		w := meta.Width.Value
+		domainMeta.Width = &w
+	}
+	if meta.Height != nil {
+		h := meta.Height.Value
+		domainMeta.Height = &h
+	}
+
+	// Chunks are piped to the service as they arrive so receiving is reported as it happens
+	reader, writer := io.Pipe()
+	defer reader.Close()
+	go func() {
+		var received int64
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				if received == 0 {
+					writer.CloseWithError(errEmptyUpload)
+					return
+				}
+				writer.Close()
+				return
+			}
+			if err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+			if req.GetMeta() != nil {
+				writer.CloseWithError(errMetaResent)
+				return
+			}
+			received += int64(len(req.GetChunk()))
+			if received > MaxStreamUploadBytes {
+				writer.CloseWithError(errUploadTooLarge)
+				return
+			}
+			if _, err := writer.Write(req.GetChunk()); err != nil {
+				return
+			}
+		}
+	}()
+
+	// The result or the error status ends the stream, so final reports are not sent
+	var sendErr error
+	onProgress := func(progress contracts.UploadProgress) {
+		if progress.Done() || sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&mediaProto.UploadStreamResponse{
+			Event: &mediaProto.UploadStreamResponse_Progress{Progress: utils.DomainToProtoUploadProgress(progress)},
+		})
+	}
+
+	asset, dedup, err := g.mediaService.UploadAndPinWithProgress(stream.Context(), domainMeta, reader, int64(meta.TotalBytes), onProgress)
+	if err != nil {
+		switch {
+		case errors.Is(err, errUploadTooLarge):
+			return status.Errorf(codes.ResourceExhausted, "upload exceeds %d bytes", MaxStreamUploadBytes)
+		case errors.Is(err, errEmptyUpload):
+			return status.Error(codes.InvalidArgument, errEmptyUpload.Error())
+		case errors.Is(err, errMetaResent):
+			return status.Error(codes.InvalidArgument, errMetaResent.Error())
+		}
+		return errs.ToGRPC(fmt.Errorf("failed to upload and pin: %w", err))
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+
+	return stream.Send(&mediaProto.UploadStreamResponse{
+		Event: &mediaProto.UploadStreamResponse_Result{Result: &mediaProto.UploadAndPinResponse{
+			Asset:        utils.DomainToProtoAsset(asset),
+			Deduplicated: dedup,
+		}},
+	})
+}
+
 func (g *gRPCHandler) GetAsset(ctx context.Context, req *mediaProto.GetAssetRequest) (*mediaProto.GetAssetResponse, error) {
 	asset, err := g.mediaService.GetAsset(ctx, req.Id)
 	if err != nil {
-		if err == domain.ErrAssetNotFound {
-			return nil, status.Errorf(codes.NotFound, "asset not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get asset: %v", err)
+		return nil, errs.ToGRPC(err)
 	}
 
 	return &mediaProto.GetAssetResponse{
@@ -85,13 +212,104 @@ func (g *gRPCHandler) GetAsset(ctx context.Context, req *mediaProto.GetAssetRequ
 func (g *gRPCHandler) GetAssetByCid(ctx context.Context, req *mediaProto.GetAssetByCidRequest) (*mediaProto.GetAssetResponse, error) {
 	asset, err := g.mediaService.GetAssetByCID(ctx, req.Cid)
 	if err != nil {
-		if err == domain.ErrAssetNotFound {
-			return nil, status.Errorf(codes.NotFound, "asset not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get asset by CID: %v", err)
+		return nil, errs.ToGRPC(err)
 	}
 
 	return &mediaProto.GetAssetResponse{
 		Asset: utils.DomainToProtoAsset(asset),
 	}, nil
 }
+
+func (g *gRPCHandler) AddRef(ctx context.Context, req *mediaProto.AddRefRequest) (*mediaProto.AddRefResponse, error) {
+	asset, err := g.mediaService.AddRef(ctx, req.AssetId, req.Ref)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &mediaProto.AddRefResponse{
+		Asset: utils.DomainToProtoAsset(asset),
+	}, nil
+}
+
+func (g *gRPCHandler) ReleaseRef(ctx context.Context, req *mediaProto.ReleaseRefRequest) (*mediaProto.ReleaseRefResponse, error) {
+	asset, released, err := g.mediaService.ReleaseRef(ctx, req.AssetId, req.Ref)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &mediaProto.ReleaseRefResponse{
+		Asset:    utils.DomainToProtoAsset(asset),
+		Released: released,
+	}, nil
+}
+
+func (g *gRPCHandler) ReleaseAsset(ctx context.Context, req *mediaProto.ReleaseAssetRequest) (*mediaProto.ReleaseAssetResponse, error) {
+	asset, released, err := g.mediaService.ReleaseAsset(ctx, req.AssetId, req.OwnerId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &mediaProto.ReleaseAssetResponse{
+		Asset:    utils.DomainToProtoAsset(asset),
+		Released: released,
+	}, nil
+}
+
+func (g *gRPCHandler) GetStorageUsage(ctx context.Context, req *mediaProto.GetStorageUsageRequest) (*mediaProto.GetStorageUsageResponse, error) {
+	usage, quota, err := g.mediaService.GetStorageUsage(ctx, req.OwnerId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return utils.DomainToProtoStorageUsage(usage, quota), nil
+}
+
+func (g *gRPCHandler) StoreArtifact(ctx context.Context, req *mediaProto.StoreArtifactRequest) (*mediaProto.StoreArtifactResponse, error) {
+	artifact, err := g.mediaService.StoreArtifact(ctx, domain.StoreArtifactInput{
+		Name:    req.Name,
+		Mime:    req.Mime,
+		OwnerID: req.OwnerId,
+		TTL:     time.Duration(req.TtlSeconds) * time.Second,
+		Content: req.Content,
+		Gate:    utils.ProtoToDomainArtifactGate(req.Gate),
+	})
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &mediaProto.StoreArtifactResponse{Artifact: utils.DomainToProtoArtifact(artifact)}, nil
+}
+
+func (g *gRPCHandler) GetArtifact(ctx context.Context, req *mediaProto.GetArtifactRequest) (*mediaProto.GetArtifactResponse, error) {
+	artifact, err := g.mediaService.GetArtifact(ctx, req.Id)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &mediaProto.GetArtifactResponse{Artifact: utils.DomainToProtoArtifact(artifact)}, nil
+}
+
+func (g *gRPCHandler) DownloadArtifact(ctx context.Context, req *mediaProto.DownloadArtifactRequest) (*mediaProto.DownloadArtifactResponse, error) {
+	artifact, err := g.mediaService.DownloadArtifact(ctx, req.Id, req.Expires, req.Signature, req.GateToken)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &mediaProto.DownloadArtifactResponse{
+		Artifact: utils.DomainToProtoArtifact(&domain.SignedArtifact{ArtifactDoc: artifact}),
+		Content:  artifact.Content,
+	}, nil
+}
+
+func (g *gRPCHandler) GetPinHealth(ctx context.Context, req *mediaProto.GetPinHealthRequest) (*mediaProto.GetPinHealthResponse, error) {
+	lastPinnedAt, err := g.mediaService.LastPinnedAt(ctx)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	resp := &mediaProto.GetPinHealthResponse{}
+	if lastPinnedAt != nil {
+		resp.LastPinnedAt = timestamppb.New(*lastPinnedAt)
+	}
+	return resp, nil
+}
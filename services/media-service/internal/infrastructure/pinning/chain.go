@@ -0,0 +1,286 @@
+package pinning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+)
+
+// Provider names used in PINNING_PROVIDERS and stored on assets
+const (
+	ProviderPinata     = "pinata"
+	ProviderNFTStorage = "nftstorage"
+	ProviderKubo       = "kubo"
+)
+
+// NewProviders builds the providers listed in the pinning config, in order
+func NewProviders(cfg *config.Config) ([]domain.PinProvider, error) {
+	providers := make([]domain.PinProvider, 0, len(cfg.Pinning.Providers))
+	for _, name := range cfg.Pinning.Providers {
+		switch name {
+		case ProviderPinata:
+			providers = append(providers, NewPinataClient(cfg.PinataConfig))
+		case ProviderNFTStorage:
+			providers = append(providers, NewNFTStorageClient(cfg.NFTStorage))
+		case ProviderKubo:
+			providers = append(providers, NewKuboClient(cfg.Kubo))
+		default:
+			return nil, fmt.Errorf("unknown pinning provider %q", name)
+		}
+	}
+	return providers, nil
+}
+
+// ProviderChain pins on the first healthy provider and fails over to the next one.
+// A provider is marked unhealthy after failureThreshold consecutive failures, or a
+// failed health check, and skipped until the cooldown passes. Unhealthy providers are
+// still tried as a last resort when every provider is down.
+type ProviderChain struct {
+	providers        []domain.PinProvider
+	failureThreshold int
+	cooldown         time.Duration
+
+	mu     sync.Mutex
+	health map[string]*providerHealth
+}
+
+type providerHealth struct {
+	failures       int
+	unhealthyUntil time.Time
+	lastErr        error
+}
+
+func NewProviderChain(providers []domain.PinProvider, failureThreshold int, cooldown time.Duration) *ProviderChain {
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+	health := make(map[string]*providerHealth, len(providers))
+	for _, p := range providers {
+		health[p.Name()] = &providerHealth{}
+	}
+	return &ProviderChain{
+		providers:        providers,
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		health:           health,
+	}
+}
+
+func (c *ProviderChain) PinFile(ctx context.Context, r io.Reader, name string) (domain.PinResult, error) {
+	if r == nil {
+		return domain.PinResult{}, errors.New("reader is nil")
+	}
+	// Buffered so every provider in the chain gets the full content
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return domain.PinResult{}, err
+	}
+	return c.pin(ctx, "", func(p domain.PinProvider) (domain.PinResult, error) {
+		return p.PinFile(ctx, bytes.NewReader(content), name)
+	})
+}
+
+func (c *ProviderChain) PinJSON(ctx context.Context, v any, name string) (domain.PinResult, error) {
+	// Encoded once so a value that can't be marshalled doesn't count against providers
+	if _, err := json.Marshal(v); err != nil {
+		return domain.PinResult{}, err
+	}
+	return c.pin(ctx, "", func(p domain.PinProvider) (domain.PinResult, error) {
+		return p.PinJSON(ctx, v, name)
+	})
+}
+
+// PinCIDElsewhere pins existing content on the first usable provider other than exclude
+func (c *ProviderChain) PinCIDElsewhere(ctx context.Context, cid, name, exclude string) (domain.PinResult, error) {
+	return c.pin(ctx, exclude, func(p domain.PinProvider) (domain.PinResult, error) {
+		return p.PinCID(ctx, cid, name)
+	})
+}
+
+// Unpin removes cid from every provider; it fails only if no provider succeeded
+func (c *ProviderChain) Unpin(ctx context.Context, cid string) error {
+	var errs []error
+	unpinned := false
+	for _, p := range c.providers {
+		if err := p.Unpin(ctx, cid); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+			continue
+		}
+		unpinned = true
+	}
+	if unpinned {
+		return nil
+	}
+	return errors.Join(errs...)
+}
+
+// UnpinFrom removes cid from one provider
+func (c *ProviderChain) UnpinFrom(ctx context.Context, provider, cid string) error {
+	p, ok := c.provider(provider)
+	if !ok {
+		return fmt.Errorf("%w: %s", domain.ErrNoPinProvider, provider)
+	}
+	return p.Unpin(ctx, cid)
+}
+
+// GatewayURL uses the first healthy provider with a gateway configured
+func (c *ProviderChain) GatewayURL(cid string) string {
+	for _, p := range c.ordered("") {
+		if url := p.GatewayURL(cid); url != "" {
+			return url
+		}
+	}
+	return ""
+}
+
+// ProviderGatewayURL prefers the gateway of the provider holding the pin
+func (c *ProviderChain) ProviderGatewayURL(provider, cid string) string {
+	if p, ok := c.provider(provider); ok {
+		if url := p.GatewayURL(cid); url != "" {
+			return url
+		}
+	}
+	return c.GatewayURL(cid)
+}
+
+// Healthy reports whether a provider is currently used before the unhealthy ones
+func (c *ProviderChain) Healthy(provider string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h, ok := c.health[provider]
+	return ok && !time.Now().Before(h.unhealthyUntil)
+}
+
+// StartHealthChecks probes every provider on interval until ctx is done
+func (c *ProviderChain) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.CheckHealth(ctx)
+			}
+		}
+	}()
+}
+
+// CheckHealth probes every provider once
+func (c *ProviderChain) CheckHealth(ctx context.Context) {
+	for _, p := range c.providers {
+		checkCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		err := p.HealthCheck(checkCtx)
+		cancel()
+		if err != nil {
+			c.markUnhealthy(p.Name(), err)
+			continue
+		}
+		c.recordSuccess(p.Name())
+	}
+}
+
+func (c *ProviderChain) pin(ctx context.Context, exclude string, fn func(domain.PinProvider) (domain.PinResult, error)) (domain.PinResult, error) {
+	var errs []error
+	for _, p := range c.ordered(exclude) {
+		res, err := fn(p)
+		if err == nil {
+			c.recordSuccess(p.Name())
+			res.Provider = p.Name()
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			return domain.PinResult{}, ctx.Err()
+		}
+		c.recordFailure(p.Name(), err)
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+	}
+	if len(errs) == 0 {
+		return domain.PinResult{}, domain.ErrNoPinProvider
+	}
+	return domain.PinResult{}, fmt.Errorf("%w: %w", domain.ErrPinFailed, errors.Join(errs...))
+}
+
+// ordered returns healthy providers in configured order, then the unhealthy ones
+func (c *ProviderChain) ordered(exclude string) []domain.PinProvider {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	var healthy, unhealthy []domain.PinProvider
+	for _, p := range c.providers {
+		if p.Name() == exclude {
+			continue
+		}
+		if now.Before(c.health[p.Name()].unhealthyUntil) {
+			unhealthy = append(unhealthy, p)
+			continue
+		}
+		healthy = append(healthy, p)
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (c *ProviderChain) provider(name string) (domain.PinProvider, bool) {
+	for _, p := range c.providers {
+		if p.Name() == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+func (c *ProviderChain) recordSuccess(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if h, ok := c.health[name]; ok {
+		if !h.unhealthyUntil.IsZero() {
+			log.Printf("Pinning provider %s is healthy again", name)
+		}
+		*h = providerHealth{}
+	}
+}
+
+func (c *ProviderChain) recordFailure(name string, err error) {
+	c.mu.Lock()
+	h, ok := c.health[name]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	h.failures++
+	h.lastErr = err
+	tripped := h.failures >= c.failureThreshold
+	c.mu.Unlock()
+
+	if tripped {
+		c.markUnhealthy(name, err)
+	}
+}
+
+func (c *ProviderChain) markUnhealthy(name string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h, ok := c.health[name]
+	if !ok {
+		return
+	}
+	if time.Now().After(h.unhealthyUntil) {
+		log.Printf("Pinning provider %s marked unhealthy for %s: %v", name, c.cooldown, err)
+	}
+	h.lastErr = err
+	h.unhealthyUntil = time.Now().Add(c.cooldown)
+}
@@ -0,0 +1,172 @@
+package pinning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+)
+
+// KuboClient pins on a self-hosted IPFS node through the Kubo RPC API (/api/v0).
+// Every RPC call is a POST.
+type KuboClient struct {
+	httpClient  *http.Client
+	rpcURL      string
+	gatewayBase string
+	authHeader  string
+}
+
+func NewKuboClient(cfg config.KuboConfig) *KuboClient {
+	return &KuboClient{
+		httpClient:  &http.Client{Timeout: 60 * time.Second},
+		rpcURL:      strings.TrimRight(cfg.RPCURL, "/") + "/api/v0",
+		gatewayBase: strings.TrimRight(cfg.GatewayURL, "/"),
+		authHeader:  cfg.AuthHeader,
+	}
+}
+
+func (c *KuboClient) Name() string { return ProviderKubo }
+
+func (c *KuboClient) PinFile(ctx context.Context, r io.Reader, name string) (domain.PinResult, error) {
+	if r == nil {
+		return domain.PinResult{}, errors.New("reader is nil")
+	}
+	if name == "" {
+		name = "file"
+	}
+	return c.add(ctx, r, name)
+}
+
+func (c *KuboClient) PinJSON(ctx context.Context, v any, name string) (domain.PinResult, error) {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return domain.PinResult{}, err
+	}
+	if name == "" {
+		name = "metadata.json"
+	}
+	return c.add(ctx, bytes.NewReader(bytes.TrimSpace(buf.Bytes())), name)
+}
+
+func (c *KuboClient) add(ctx context.Context, r io.Reader, name string) (domain.PinResult, error) {
+	var res domain.PinResult
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		defer pw.Close()
+		defer mw.Close()
+
+		fw, err := mw.CreateFormFile("file", filepath.Base(name))
+		if err != nil {
+			_ = pw.CloseWithError(err)
+			return
+		}
+		if _, err := io.Copy(fw, r); err != nil {
+			_ = pw.CloseWithError(err)
+		}
+	}()
+
+	httpRes, err := c.call(ctx, "add", url.Values{"pin": {"true"}, "cid-version": {"1"}}, pr, mw.FormDataContentType())
+	if err != nil {
+		return res, err
+	}
+	defer httpRes.Body.Close()
+
+	var out struct {
+		Hash string `json:"Hash"`
+		Size string `json:"Size"`
+	}
+	if err := json.NewDecoder(httpRes.Body).Decode(&out); err != nil {
+		return res, err
+	}
+	res.CID = out.Hash
+	res.Size, _ = strconv.ParseInt(out.Size, 10, 64)
+	return res, nil
+}
+
+// PinCID pins content the node fetches from the IPFS network.
+func (c *KuboClient) PinCID(ctx context.Context, cid, name string) (domain.PinResult, error) {
+	if strings.TrimSpace(cid) == "" {
+		return domain.PinResult{}, errors.New("cid is required")
+	}
+	httpRes, err := c.call(ctx, "pin/add", url.Values{"arg": {cid}}, nil, "")
+	if err != nil {
+		return domain.PinResult{}, err
+	}
+	httpRes.Body.Close()
+	return domain.PinResult{CID: cid}, nil
+}
+
+// Unpin removes the recursive pin; the node garbage collects the blocks later.
+func (c *KuboClient) Unpin(ctx context.Context, cid string) error {
+	if strings.TrimSpace(cid) == "" {
+		return errors.New("cid is required")
+	}
+	httpRes, err := c.call(ctx, "pin/rm", url.Values{"arg": {cid}}, nil, "")
+	if err != nil {
+		return err
+	}
+	httpRes.Body.Close()
+	return nil
+}
+
+// HealthCheck asks the node for its version.
+func (c *KuboClient) HealthCheck(ctx context.Context) error {
+	httpRes, err := c.call(ctx, "version", nil, nil, "")
+	if err != nil {
+		return err
+	}
+	httpRes.Body.Close()
+	return nil
+}
+
+func (c *KuboClient) GatewayURL(cid string) string {
+	if c.gatewayBase == "" || cid == "" {
+		return ""
+	}
+	return c.gatewayBase + "/ipfs/" + cid
+}
+
+// call POSTs an RPC command and returns the response when it succeeded
+func (c *KuboClient) call(ctx context.Context, command string, params url.Values, body io.Reader, contentType string) (*http.Response, error) {
+	endpoint := c.rpcURL + "/" + command
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.authHeader != "" {
+		req.Header.Set("Authorization", c.authHeader)
+	}
+
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if httpRes.StatusCode < 200 || httpRes.StatusCode >= 300 {
+		defer httpRes.Body.Close()
+		b, _ := io.ReadAll(httpRes.Body)
+		return nil, fmt.Errorf("kubo: %s failed: %s: %s", command, httpRes.Status, strings.TrimSpace(string(b)))
+	}
+	return httpRes, nil
+}
@@ -0,0 +1,179 @@
+package pinning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+)
+
+// NFTStorageClient pins through the NFT.Storage HTTP API. The upload endpoint is the
+// one web3.storage's legacy API shares, so either service works with the same client.
+type NFTStorageClient struct {
+	httpClient  *http.Client
+	baseURL     string
+	gatewayBase string
+	authHeader  string
+}
+
+func NewNFTStorageClient(cfg config.NFTStorageConfig) *NFTStorageClient {
+	return &NFTStorageClient{
+		httpClient:  &http.Client{Timeout: 60 * time.Second},
+		baseURL:     strings.TrimRight(cfg.BaseURL, "/"),
+		gatewayBase: strings.TrimRight(cfg.GatewayURL, "/"),
+		authHeader:  "Bearer " + strings.TrimSpace(cfg.Token),
+	}
+}
+
+// uploadResponse covers both NFT.Storage ({"ok","value":{"cid"}}) and web3.storage ({"cid"})
+type uploadResponse struct {
+	CID   string `json:"cid"`
+	Value struct {
+		CID  string `json:"cid"`
+		Size int64  `json:"size"`
+	} `json:"value"`
+}
+
+func (c *NFTStorageClient) Name() string { return ProviderNFTStorage }
+
+func (c *NFTStorageClient) PinFile(ctx context.Context, r io.Reader, name string) (domain.PinResult, error) {
+	if r == nil {
+		return domain.PinResult{}, errors.New("reader is nil")
+	}
+	return c.upload(ctx, r, "application/octet-stream", name)
+}
+
+func (c *NFTStorageClient) PinJSON(ctx context.Context, v any, name string) (domain.PinResult, error) {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return domain.PinResult{}, err
+	}
+	return c.upload(ctx, bytes.NewReader(bytes.TrimSpace(buf.Bytes())), "application/json", name)
+}
+
+func (c *NFTStorageClient) upload(ctx context.Context, r io.Reader, contentType, name string) (domain.PinResult, error) {
+	var res domain.PinResult
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/upload", r)
+	if err != nil {
+		return res, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if name != "" {
+		req.Header.Set("X-Name", url.QueryEscape(name))
+	}
+	req.Header.Set("Authorization", c.authHeader)
+
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return res, err
+	}
+	defer httpRes.Body.Close()
+	if httpRes.StatusCode < 200 || httpRes.StatusCode >= 300 {
+		b, _ := io.ReadAll(httpRes.Body)
+		return res, fmt.Errorf("nftstorage: upload failed: %s: %s", httpRes.Status, strings.TrimSpace(string(b)))
+	}
+
+	var out uploadResponse
+	if err := json.NewDecoder(httpRes.Body).Decode(&out); err != nil {
+		return res, err
+	}
+	res.CID = out.Value.CID
+	if res.CID == "" {
+		res.CID = out.CID
+	}
+	if res.CID == "" {
+		return res, errors.New("nftstorage: upload response has no cid")
+	}
+	res.Size = out.Value.Size
+	return res, nil
+}
+
+// PinCID pins existing IPFS content through the Pinning Service API.
+func (c *NFTStorageClient) PinCID(ctx context.Context, cid, name string) (domain.PinResult, error) {
+	var res domain.PinResult
+	if strings.TrimSpace(cid) == "" {
+		return res, errors.New("cid is required")
+	}
+	body, err := json.Marshal(map[string]string{"cid": cid, "name": name})
+	if err != nil {
+		return res, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/pins", bytes.NewReader(body))
+	if err != nil {
+		return res, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", c.authHeader)
+
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return res, err
+	}
+	defer httpRes.Body.Close()
+	if httpRes.StatusCode < 200 || httpRes.StatusCode >= 300 {
+		b, _ := io.ReadAll(httpRes.Body)
+		return res, fmt.Errorf("nftstorage: pin failed: %s: %s", httpRes.Status, strings.TrimSpace(string(b)))
+	}
+
+	res.CID = cid
+	return res, nil
+}
+
+// Unpin deletes a CID from the account.
+func (c *NFTStorageClient) Unpin(ctx context.Context, cid string) error {
+	if strings.TrimSpace(cid) == "" {
+		return errors.New("cid is required")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.baseURL+"/"+url.PathEscape(cid), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", c.authHeader)
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpRes.Body.Close()
+	if httpRes.StatusCode < 200 || httpRes.StatusCode >= 300 {
+		b, _ := io.ReadAll(httpRes.Body)
+		return fmt.Errorf("nftstorage: unpin failed: %s: %s", httpRes.Status, strings.TrimSpace(string(b)))
+	}
+	return nil
+}
+
+// HealthCheck lists a single upload to verify the API and token.
+func (c *NFTStorageClient) HealthCheck(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/?limit=1", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", c.authHeader)
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpRes.Body.Close()
+	if httpRes.StatusCode < 200 || httpRes.StatusCode >= 300 {
+		return fmt.Errorf("nftstorage: health check failed: %s", httpRes.Status)
+	}
+	return nil
+}
+
+func (c *NFTStorageClient) GatewayURL(cid string) string {
+	if c.gatewayBase == "" || cid == "" {
+		return ""
+	}
+	return c.gatewayBase + "/ipfs/" + cid
+}
@@ -188,6 +188,64 @@ func (c *PinataClient) Unpin(ctx context.Context, cid string) error {
 	return nil
 }
 
+// Name identifies Pinata in the provider chain and on pinned assets.
+func (c *PinataClient) Name() string { return ProviderPinata }
+
+// PinCID asks Pinata to fetch and pin content that is already on IPFS.
+func (c *PinataClient) PinCID(ctx context.Context, cid, name string) (domain.PinResult, error) {
+	var res domain.PinResult
+	if strings.TrimSpace(cid) == "" {
+		return res, errors.New("cid is required")
+	}
+	payload := map[string]any{"hashToPin": cid}
+	if name != "" {
+		payload["pinataMetadata"] = map[string]string{"name": name}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return res, err
+	}
+
+	endpoint := c.baseURL + "/pinning/pinByHash"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return res, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.applyAuth(req)
+
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return res, err
+	}
+	defer httpRes.Body.Close()
+	if httpRes.StatusCode < 200 || httpRes.StatusCode >= 300 {
+		b, _ := io.ReadAll(httpRes.Body)
+		return res, fmt.Errorf("pinata: pinByHash failed: %s: %s", httpRes.Status, strings.TrimSpace(string(b)))
+	}
+
+	res.CID = cid
+	return res, nil
+}
+
+// HealthCheck verifies the Pinata API is reachable and the credentials are accepted.
+func (c *PinataClient) HealthCheck(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/data/testAuthentication", nil)
+	if err != nil {
+		return err
+	}
+	c.applyAuth(req)
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpRes.Body.Close()
+	if httpRes.StatusCode < 200 || httpRes.StatusCode >= 300 {
+		return fmt.Errorf("pinata: health check failed: %s", httpRes.Status)
+	}
+	return nil
+}
+
 // GatewayURL composes a public gateway URL for a CID if PINATA_GATEWAY_BASE is set.
 func (c *PinataClient) GatewayURL(cid string) string {
 	if c.gatewayBase == "" || cid == "" {
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	sharedMongo "github.com/quangdang46/NFT-Marketplace/shared/mongo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const artifactsCollection = "media.artifacts"
+
+type ArtifactRepository struct {
+	client *sharedMongo.MongoDB
+}
+
+// NewArtifactRepository creates the repository of generated files
+func NewArtifactRepository(db *sharedMongo.MongoDB) *ArtifactRepository {
+	return &ArtifactRepository{client: db}
+}
+
+func (r *ArtifactRepository) coll() *mongo.Collection {
+	return r.client.GetDatabase().Collection(artifactsCollection)
+}
+
+// EnsureIndexes lets Mongo delete artifacts once they expire
+func (r *ArtifactRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.coll().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return err
+}
+
+func (r *ArtifactRepository) CreateArtifact(ctx context.Context, a *domain.ArtifactDoc) error {
+	_, err := r.coll().InsertOne(ctx, a)
+	return err
+}
+
+// GetArtifact hides expired artifacts the TTL monitor has not removed yet
+func (r *ArtifactRepository) GetArtifact(ctx context.Context, id string, withContent bool) (*domain.ArtifactDoc, error) {
+	opts := options.FindOne()
+	if !withContent {
+		opts.SetProjection(bson.M{"content": 0})
+	}
+
+	var out domain.ArtifactDoc
+	err := r.coll().FindOne(ctx, bson.M{"_id": id, "expires_at": bson.M{"$gt": time.Now()}}, opts).Decode(&out)
+	if err == mongo.ErrNoDocuments {
+		return nil, domain.ErrArtifactNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
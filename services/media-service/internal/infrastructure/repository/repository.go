@@ -2,9 +2,13 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
 	sharedMongo "github.com/quangdang46/NFT-Marketplace/shared/mongo"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -12,18 +16,29 @@ import (
 
 const (
 	assetsCollection = "media.assets"
+
+	// Hot NFT images are looked up constantly by the gateway. Assets are cached by ID;
+	// CID and SHA-256 keys only point at the ID so an update invalidates a single entry.
+	assetCacheTTL = 10 * time.Minute
 )
 
 type Repository struct {
 	client *sharedMongo.MongoDB
+	redis  *redis.Redis
 }
 
-func NewMediaRepository(db *sharedMongo.MongoDB) domain.MediaRepository {
+// NewMediaRepository creates the asset repository; rds may be nil to disable caching
+func NewMediaRepository(db *sharedMongo.MongoDB, rds *redis.Redis) domain.MediaRepository {
 	return &Repository{
 		client: db,
+		redis:  rds,
 	}
 }
 
+func assetCacheKey(id string) string        { return fmt.Sprintf("media:asset:%s", id) }
+func assetCIDCacheKey(cid string) string    { return fmt.Sprintf("media:asset:cid:%s", cid) }
+func assetSHA256CacheKey(sha string) string { return fmt.Sprintf("media:asset:sha256:%s", sha) }
+
 func (r *Repository) coll() *mongo.Collection {
 	return r.client.GetDatabase().Collection(assetsCollection)
 }
@@ -42,28 +57,54 @@ func (r *Repository) Create(ctx context.Context, a *domain.AssetDoc) error {
 
 // Fetch
 func (r *Repository) GetByID(ctx context.Context, id string) (*domain.AssetDoc, error) {
+	if cached, ok := r.cachedAsset(ctx, id); ok {
+		return cached, nil
+	}
+
 	var out domain.AssetDoc
 	err := r.coll().FindOne(ctx, bson.M{"_id": id}).Decode(&out)
 	if err == mongo.ErrNoDocuments {
 		return nil, domain.ErrAssetNotFound
 	}
-	return &out, err
+	if err != nil {
+		return nil, err
+	}
+	r.cacheAsset(ctx, &out)
+	return &out, nil
 }
 
 func (r *Repository) GetByCID(ctx context.Context, cid string) (*domain.AssetDoc, error) {
+	if id, ok := r.cachedID(ctx, assetCIDCacheKey(cid)); ok {
+		if cached, ok := r.cachedAsset(ctx, id); ok {
+			return cached, nil
+		}
+	}
+
 	var out domain.AssetDoc
 	err := r.coll().FindOne(ctx, bson.M{"ipfs_cid": cid}).Decode(&out)
 	if err == mongo.ErrNoDocuments {
 		return nil, domain.ErrAssetNotFound
 	}
-	return &out, err
+	if err != nil {
+		return nil, err
+	}
+	r.cacheAsset(ctx, &out)
+	return &out, nil
 }
 
 // Idempotent create by SHA256 (dedup)
 func (r *Repository) FindOrCreateBySHA256(ctx context.Context, a *domain.AssetDoc) (asset *domain.AssetDoc, dedup bool, err error) {
+	// Re-uploads of the same file skip Mongo entirely once the hash is cached
+	if id, ok := r.cachedID(ctx, assetSHA256CacheKey(a.SHA256)); ok {
+		if existing, err := r.GetByID(ctx, id); err == nil && existing.SHA256 == a.SHA256 {
+			return existing, true, nil
+		}
+	}
+
 	var existing domain.AssetDoc
 	err = r.coll().FindOne(ctx, bson.M{"sha256": a.SHA256}).Decode(&existing)
 	if err == nil {
+		r.cacheAsset(ctx, &existing)
 		return &existing, true, nil
 	}
 	if err != mongo.ErrNoDocuments {
@@ -73,11 +114,14 @@ func (r *Repository) FindOrCreateBySHA256(ctx context.Context, a *domain.AssetDo
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
 			if e := r.coll().FindOne(ctx, bson.M{"sha256": a.SHA256}).Decode(&existing); e == nil {
+				r.cacheAsset(ctx, &existing)
 				return &existing, true, nil
 			}
 		}
 		return nil, false, err
 	}
+	// Only the hash is cached; the asset itself still changes while it is pinned
+	r.cacheIDs(ctx, a)
 	return a, false, nil
 }
 
@@ -98,6 +142,7 @@ func (r *Repository) UpdateAfterUpload(ctx context.Context, id, mime string, byt
 	if err != nil {
 		return err
 	}
+	r.invalidateAsset(ctx, id)
 	if res.MatchedCount == 0 {
 		return domain.ErrAssetNotFound
 	}
@@ -105,8 +150,11 @@ func (r *Repository) UpdateAfterUpload(ctx context.Context, id, mime string, byt
 }
 
 // Set final pin result (Pinata SYNC path)
-func (r *Repository) SetPinned(ctx context.Context, id, cid string, gatewayURL *string) error {
-	set := bson.M{"pin_status": "PINNED", "ipfs_cid": cid}
+func (r *Repository) SetPinned(ctx context.Context, id, cid, provider string, gatewayURL *string) error {
+	set := bson.M{"pin_status": "PINNED", "ipfs_cid": cid, "pinned_at": time.Now().UTC()}
+	if provider != "" {
+		set["pin_provider"] = provider
+	}
 	if gatewayURL != nil {
 		set["gateway_url"] = *gatewayURL
 	}
@@ -114,12 +162,153 @@ func (r *Repository) SetPinned(ctx context.Context, id, cid string, gatewayURL *
 	if err != nil {
 		return err
 	}
+	r.invalidateAsset(ctx, id)
 	if res.MatchedCount == 0 {
 		return domain.ErrAssetNotFound
 	}
 	return nil
 }
 
+// LastPinnedAt returns the latest pinned_at of any asset
+func (r *Repository) LastPinnedAt(ctx context.Context) (*time.Time, error) {
+	var out struct {
+		PinnedAt time.Time `bson:"pinned_at"`
+	}
+	err := r.coll().FindOne(ctx,
+		bson.M{"pinned_at": bson.M{"$exists": true}},
+		options.FindOne().SetSort(bson.D{{Key: "pinned_at", Value: -1}}).SetProjection(bson.M{"pinned_at": 1}),
+	).Decode(&out)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &out.PinnedAt, nil
+}
+
+// ClearPin marks an unpinned asset pending again; the CID is kept so it can be re-pinned
+func (r *Repository) ClearPin(ctx context.Context, id string) error {
+	res, err := r.coll().UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set":   bson.M{"pin_status": string(domain.PinPending)},
+		"$unset": bson.M{"pin_provider": "", "gateway_url": ""},
+	})
+	if err != nil {
+		return err
+	}
+	r.invalidateAsset(ctx, id)
+	if res.MatchedCount == 0 {
+		return domain.ErrAssetNotFound
+	}
+	return nil
+}
+
+// UpsertVariant adds a rendition of an asset or replaces the one with the same ID
+func (r *Repository) UpsertVariant(ctx context.Context, id string, variant domain.AssetVariantDoc) error {
+	res, err := r.coll().UpdateOne(ctx,
+		bson.M{"_id": id, "variants.id": variant.ID},
+		bson.M{"$set": bson.M{"variants.$": variant}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		// variants is stored as null until the first rendition, which $push rejects
+		res, err = r.coll().UpdateOne(ctx, bson.M{"_id": id}, mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{"variants": bson.M{"$concatArrays": bson.A{
+				bson.M{"$ifNull": bson.A{"$variants", bson.A{}}},
+				bson.A{variant},
+			}}}}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	r.invalidateAsset(ctx, id)
+	if res.MatchedCount == 0 {
+		return domain.ErrAssetNotFound
+	}
+	return nil
+}
+
+// AddRef records ref on a pinned asset. The pin status and the ref are checked in the same
+// update so an asset is never referenced before pinning completes.
+func (r *Repository) AddRef(ctx context.Context, id, ref string) (*domain.AssetDoc, error) {
+	var out domain.AssetDoc
+	err := r.coll().FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "pin_status": string(domain.PinPinned), "refs": bson.M{"$ne": ref}},
+		bson.M{"$addToSet": bson.M{"refs": ref}, "$inc": bson.M{"ref_count": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&out)
+	if err == nil {
+		r.invalidateAsset(ctx, id)
+		return &out, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+
+	// Missing, not pinned, or already referenced by ref
+	if err := r.coll().FindOne(ctx, bson.M{"_id": id}).Decode(&out); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrAssetNotFound
+		}
+		return nil, err
+	}
+	for _, held := range out.Refs {
+		if held == ref {
+			return &out, nil
+		}
+	}
+	return nil, domain.ErrNotPinned
+}
+
+// ReleaseRef drops ref from the asset
+func (r *Repository) ReleaseRef(ctx context.Context, id, ref string) (*domain.AssetDoc, bool, error) {
+	var out domain.AssetDoc
+	err := r.coll().FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "refs": ref},
+		bson.M{"$pull": bson.M{"refs": ref}, "$inc": bson.M{"ref_count": -1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&out)
+	if err == nil {
+		r.invalidateAsset(ctx, id)
+		return &out, true, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, false, err
+	}
+
+	asset, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	return asset, false, nil
+}
+
+// ReleaseOwner clears the uploader and drops the upload's count in one update, so a
+// release is credited once however often it is retried
+func (r *Repository) ReleaseOwner(ctx context.Context, id, ownerID string) (*domain.AssetDoc, bool, error) {
+	var out domain.AssetDoc
+	err := r.coll().FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "owner_id": ownerID},
+		bson.M{"$unset": bson.M{"owner_id": ""}, "$inc": bson.M{"ref_count": -1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&out)
+	if err == nil {
+		r.invalidateAsset(ctx, id)
+		return &out, true, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, false, err
+	}
+
+	asset, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	return asset, false, nil
+}
+
 // Paging (admin/debug)
 func (r *Repository) List(ctx context.Context, filter map[string]any, pageSize int, pageToken string) (items []domain.AssetDoc, next string, err error) {
 	findFilter := bson.M(filter)
@@ -138,3 +327,67 @@ func (r *Repository) List(ctx context.Context, filter map[string]any, pageSize i
 	}
 	return items, "", cur.Err()
 }
+
+// cachedAsset returns the cached asset; any cache failure is a miss
+func (r *Repository) cachedAsset(ctx context.Context, id string) (*domain.AssetDoc, bool) {
+	if r.redis == nil {
+		return nil, false
+	}
+	cached, err := r.redis.Get(ctx, assetCacheKey(id))
+	if err != nil || cached == "" {
+		return nil, false
+	}
+	var asset domain.AssetDoc
+	if err := json.Unmarshal([]byte(cached), &asset); err != nil {
+		return nil, false
+	}
+	return &asset, true
+}
+
+// cachedID resolves a CID or SHA-256 key to an asset ID
+func (r *Repository) cachedID(ctx context.Context, key string) (string, bool) {
+	if r.redis == nil {
+		return "", false
+	}
+	id, err := r.redis.Get(ctx, key)
+	if err != nil || id == "" {
+		return "", false
+	}
+	return id, true
+}
+
+// cacheAsset stores the asset and its lookup keys. Assets still being pinned are not
+// cached, their status is about to change.
+func (r *Repository) cacheAsset(ctx context.Context, asset *domain.AssetDoc) {
+	if r.redis == nil {
+		return
+	}
+	r.cacheIDs(ctx, asset)
+	if asset.PinStatus == string(domain.PinPending) || asset.PinStatus == string(domain.PinPinning) {
+		return
+	}
+	if data, err := json.Marshal(asset); err == nil {
+		_ = r.redis.SetWithExpiration(ctx, assetCacheKey(asset.ID), string(data), assetCacheTTL)
+	}
+}
+
+// cacheIDs points the asset's CID and SHA-256 keys at its ID
+func (r *Repository) cacheIDs(ctx context.Context, asset *domain.AssetDoc) {
+	if r.redis == nil {
+		return
+	}
+	if asset.SHA256 != "" {
+		_ = r.redis.SetWithExpiration(ctx, assetSHA256CacheKey(asset.SHA256), asset.ID, assetCacheTTL)
+	}
+	if asset.IPFSCID != nil && *asset.IPFSCID != "" {
+		_ = r.redis.SetWithExpiration(ctx, assetCIDCacheKey(*asset.IPFSCID), asset.ID, assetCacheTTL)
+	}
+}
+
+// invalidateAsset drops the cached asset after a pin-status or variant change
+func (r *Repository) invalidateAsset(ctx context.Context, id string) {
+	if r.redis == nil {
+		return
+	}
+	_ = r.redis.Delete(ctx, assetCacheKey(id))
+}
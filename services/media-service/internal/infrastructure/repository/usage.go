@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	sharedMongo "github.com/quangdang46/NFT-Marketplace/shared/mongo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// usageCollection holds one document per uploader with their totals and a breakdown by kind
+const usageCollection = "media.usage"
+
+type UsageRepository struct {
+	client *sharedMongo.MongoDB
+}
+
+// NewUsageRepository creates the per-user storage usage repository
+func NewUsageRepository(db *sharedMongo.MongoDB) domain.UsageRepository {
+	return &UsageRepository{client: db}
+}
+
+func (r *UsageRepository) coll() *mongo.Collection {
+	return r.client.GetDatabase().Collection(usageCollection)
+}
+
+func (r *UsageRepository) GetUsage(ctx context.Context, ownerID string) (*domain.StorageUsage, error) {
+	var out domain.StorageUsage
+	err := r.coll().FindOne(ctx, bson.M{"_id": ownerID}).Decode(&out)
+	if err == mongo.ErrNoDocuments {
+		return &domain.StorageUsage{OwnerID: ownerID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddUsage applies the deltas with $inc, so concurrent uploads and releases never lose a count
+func (r *UsageRepository) AddUsage(ctx context.Context, ownerID, kind string, bytes, assets int64) (*domain.StorageUsage, error) {
+	if kind == "" {
+		kind = "OTHER" // a field path segment cannot be empty
+	}
+	var out domain.StorageUsage
+	err := r.coll().FindOneAndUpdate(ctx,
+		bson.M{"_id": ownerID},
+		bson.M{
+			"$inc": bson.M{
+				"bytes":                     bytes,
+				"assets":                    assets,
+				"kinds." + kind + ".bytes":  bytes,
+				"kinds." + kind + ".assets": assets,
+			},
+			"$set": bson.M{"updated_at": time.Now()},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/tokengate"
+)
+
+const (
+	// Artifacts travel in one gRPC message each way, which is capped at 4 MB
+	MaxArtifactBytes = 3 << 20
+
+	defaultArtifactTTL = 7 * 24 * time.Hour
+	maxArtifactTTL     = 30 * 24 * time.Hour
+)
+
+// SetArtifacts stores generated files in repo. Download URLs point below baseURL, e.g. the
+// gateway's /artifacts route, are signed with signingKey and stay valid for urlTTL.
+func (s *Service) SetArtifacts(repo domain.ArtifactRepository, signingKey []byte, baseURL string, urlTTL time.Duration) {
+	s.artifacts = repo
+	s.artifactKey = signingKey
+	s.artifactBaseURL = strings.TrimRight(baseURL, "/")
+	s.artifactURLTTL = urlTTL
+}
+
+// SetTokenGateSecret checks the gate tokens the catalog signs for gated downloads
+func (s *Service) SetTokenGateSecret(secret []byte) {
+	s.tokenGateSecret = secret
+}
+
+// StoreArtifact keeps content for TTL, the default retention when unset, and returns it
+// with a signed download URL
+func (s *Service) StoreArtifact(ctx context.Context, in domain.StoreArtifactInput) (*domain.SignedArtifact, error) {
+	if s.artifacts == nil {
+		return nil, domain.ErrArtifactsDisabled
+	}
+	if in.Name == "" || in.Mime == "" || len(in.Content) == 0 || strings.ContainsAny(in.Name, "/\\\"") {
+		return nil, domain.ErrInvalidInput
+	}
+	if len(in.Content) > MaxArtifactBytes {
+		return nil, domain.ErrArtifactTooLarge.WithMessage(fmt.Sprintf("artifacts are limited to %d bytes", MaxArtifactBytes))
+	}
+	ttl := in.TTL
+	if ttl <= 0 {
+		ttl = defaultArtifactTTL
+	}
+	if ttl > maxArtifactTTL {
+		ttl = maxArtifactTTL
+	}
+	gate, err := normalizeGate(in.Gate)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(in.Content)
+	now := time.Now().UTC()
+	artifact := &domain.ArtifactDoc{
+		ID:        uuid.New().String(),
+		OwnerID:   in.OwnerID,
+		Name:      in.Name,
+		Mime:      in.Mime,
+		Bytes:     int64(len(in.Content)),
+		SHA256:    hex.EncodeToString(hash[:]),
+		Content:   in.Content,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+		Gate:      gate,
+	}
+	if err := s.artifacts.CreateArtifact(ctx, artifact); err != nil {
+		return nil, fmt.Errorf("failed to store artifact: %w", err)
+	}
+	artifact.Content = nil
+	return s.signArtifact(artifact, now), nil
+}
+
+// GetArtifact returns the artifact with a freshly signed download URL
+func (s *Service) GetArtifact(ctx context.Context, id string) (*domain.SignedArtifact, error) {
+	if s.artifacts == nil {
+		return nil, domain.ErrArtifactsDisabled
+	}
+	if id == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	artifact, err := s.artifacts.GetArtifact(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.signArtifact(artifact, time.Now().UTC()), nil
+}
+
+// DownloadArtifact returns the artifact's content for a download URL signed by this service.
+// A gated artifact also needs a gate token covering its collection.
+func (s *Service) DownloadArtifact(ctx context.Context, id string, expires int64, signature, gateToken string) (*domain.ArtifactDoc, error) {
+	if s.artifacts == nil {
+		return nil, domain.ErrArtifactsDisabled
+	}
+	if id == "" || time.Now().Unix() > expires {
+		return nil, domain.ErrInvalidSignature
+	}
+	given, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(given, s.artifactSignature(id, expires)) {
+		return nil, domain.ErrInvalidSignature
+	}
+	artifact, err := s.artifacts.GetArtifact(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	if artifact.Gate != nil && !s.opensGate(artifact.Gate, gateToken) {
+		return nil, domain.ErrGateTokenRequired
+	}
+	return artifact, nil
+}
+
+// opensGate reports whether gateToken is a valid gate token covering gate
+func (s *Service) opensGate(gate *domain.ArtifactGate, gateToken string) bool {
+	claims, err := tokengate.Verify(s.tokenGateSecret, gateToken)
+	if err != nil {
+		return false
+	}
+	minBalance, ok := new(big.Int).SetString(gate.MinBalance, 10)
+	return ok && claims.Covers(gate.ChainID, gate.Contract, minBalance)
+}
+
+// normalizeGate checks a requested gate and defaults its minimum balance to 1
+func normalizeGate(gate *domain.ArtifactGate) (*domain.ArtifactGate, error) {
+	if gate == nil {
+		return nil, nil
+	}
+	if gate.ChainID == "" || gate.Contract == "" {
+		return nil, domain.ErrInvalidInput.WithMessage("gate needs a chain and contract")
+	}
+	minBalance := big.NewInt(1)
+	if gate.MinBalance != "" {
+		var ok bool
+		if minBalance, ok = new(big.Int).SetString(gate.MinBalance, 10); !ok || minBalance.Sign() <= 0 {
+			return nil, domain.ErrInvalidInput.WithMessage("gate minimum balance must be a positive integer")
+		}
+	}
+	return &domain.ArtifactGate{
+		ChainID:    gate.ChainID,
+		Contract:   strings.ToLower(gate.Contract),
+		MinBalance: minBalance.String(),
+	}, nil
+}
+
+// signArtifact signs a download URL that expires after the URL TTL, or with the artifact
+func (s *Service) signArtifact(artifact *domain.ArtifactDoc, now time.Time) *domain.SignedArtifact {
+	expiresAt := now.Add(s.artifactURLTTL)
+	if artifact.ExpiresAt.Before(expiresAt) {
+		expiresAt = artifact.ExpiresAt
+	}
+	expires := expiresAt.Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("sig", hex.EncodeToString(s.artifactSignature(artifact.ID, expires)))
+	return &domain.SignedArtifact{
+		ArtifactDoc:  artifact,
+		DownloadURL:  fmt.Sprintf("%s/%s?%s", s.artifactBaseURL, url.PathEscape(artifact.ID), query.Encode()),
+		URLExpiresAt: time.Unix(expires, 0).UTC(),
+	}
+}
+
+func (s *Service) artifactSignature(id string, expires int64) []byte {
+	mac := hmac.New(sha256.New, s.artifactKey)
+	fmt.Fprintf(mac, "%s|%d", id, expires)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+const (
+	// ProgressInterval is the longest a receiving upload goes without a progress report
+	ProgressInterval = 500 * time.Millisecond
+
+	// ProgressBytes is how much an upload receives between two progress reports at most
+	ProgressBytes = 1 << 20
+)
+
+// progressTracker reports the stages of one upload. Receiving is reported every
+// ProgressBytes or ProgressInterval, whichever comes first; stage changes always are.
+type progressTracker struct {
+	ctx        context.Context
+	ticket     string
+	total      int64
+	onProgress domain.ProgressFunc
+	publisher  domain.ProgressPublisher
+
+	bytes        int64
+	reportedAt   time.Time
+	reportedSize int64
+	finished     bool
+}
+
+func (s *Service) newProgressTracker(ctx context.Context, meta domain.UploadMeta, sizeHint int64, onProgress domain.ProgressFunc) *progressTracker {
+	t := &progressTracker{
+		ctx:        ctx,
+		ticket:     meta.Ticket,
+		total:      meta.TotalBytes,
+		onProgress: onProgress,
+		reportedAt: time.Now(),
+	}
+	if t.total <= 0 {
+		t.total = sizeHint
+	}
+	// Without a ticket nobody can follow the upload besides the caller
+	if meta.Ticket != "" {
+		t.publisher = s.progress
+	}
+	return t
+}
+
+func (t *progressTracker) enabled() bool {
+	return t.onProgress != nil || t.publisher != nil
+}
+
+// reader counts the bytes read from r as received
+func (t *progressTracker) reader(r io.Reader) io.Reader {
+	if !t.enabled() {
+		return r
+	}
+	return &countingReader{r: r, tracker: t}
+}
+
+func (t *progressTracker) add(n int) {
+	t.bytes += int64(n)
+	if t.bytes-t.reportedSize >= ProgressBytes || time.Since(t.reportedAt) >= ProgressInterval {
+		t.emit(contracts.UploadProgress{Stage: contracts.UploadStageUploading})
+	}
+}
+
+// received reports the upload fully received unless the last report already said so
+func (t *progressTracker) received() {
+	if t.reportedSize != t.bytes || t.bytes == 0 {
+		t.emit(contracts.UploadProgress{Stage: contracts.UploadStageUploading})
+	}
+}
+
+func (t *progressTracker) stage(stage string) {
+	t.emit(contracts.UploadProgress{Stage: stage})
+}
+
+func (t *progressTracker) done(assetID string) {
+	t.emit(contracts.UploadProgress{Stage: contracts.UploadStageDone, AssetID: assetID})
+}
+
+func (t *progressTracker) fail(err error) {
+	t.emit(contracts.UploadProgress{Stage: contracts.UploadStageFailed, Error: err.Error()})
+}
+
+func (t *progressTracker) emit(progress contracts.UploadProgress) {
+	if !t.enabled() || t.finished {
+		return
+	}
+	t.finished = progress.Done()
+	t.reportedAt, t.reportedSize = time.Now(), t.bytes
+
+	progress.Ticket = t.ticket
+	progress.BytesReceived = t.bytes
+	progress.TotalBytes = t.total
+	progress.EmittedAt = t.reportedAt.UTC()
+
+	if t.onProgress != nil {
+		t.onProgress(progress)
+	}
+	if t.publisher != nil {
+		if err := t.publisher.PublishUploadProgress(t.ctx, progress); err != nil {
+			log.Printf("Failed to publish progress of upload %s: %v", t.ticket, err)
+		}
+	}
+}
+
+type countingReader struct {
+	r       io.Reader
+	tracker *progressTracker
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if n > 0 {
+		c.tracker.add(n)
+	}
+	return n, err
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// SetStorageQuota meters owned uploads in usage and enforces quota against it. Exceeded
+// limits are announced through publisher, which may be nil.
+func (s *Service) SetStorageQuota(usage domain.UsageRepository, quota domain.StorageQuota, publisher domain.QuotaEventPublisher) {
+	s.usage = usage
+	s.quota = quota
+	s.quotaEvents = publisher
+}
+
+// checkQuota rejects an upload of size bytes that would take the owner over a hard limit.
+// Concurrent uploads are checked against the same usage, so they may overshoot by one file.
+func (s *Service) checkQuota(ctx context.Context, ownerID, kind string, size int64) error {
+	if s.usage == nil || ownerID == "" {
+		return nil
+	}
+	usage, err := s.usage.GetUsage(ctx, ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to load storage usage: %w", err)
+	}
+
+	exceeded := hardLimitExceeded(s.quota, usage, kind, size)
+	if exceeded == nil {
+		return nil
+	}
+	exceeded.OwnerID = ownerID
+	exceeded.ExceededAt = time.Now()
+	s.publishQuotaExceeded(ctx, exceeded)
+	return quotaError(exceeded)
+}
+
+// chargeUpload counts a new asset against its owner and announces crossed soft limits.
+// The asset is pinned by now, so metering failures are only logged.
+func (s *Service) chargeUpload(ctx context.Context, asset *domain.AssetDoc) {
+	if s.usage == nil || asset.OwnerID == "" {
+		return
+	}
+	usage, err := s.usage.AddUsage(ctx, asset.OwnerID, asset.Kind, asset.Bytes, 1)
+	if err != nil {
+		log.Printf("Failed to meter asset %s for %s: %v", asset.ID, asset.OwnerID, err)
+		return
+	}
+
+	now := time.Now()
+	for _, crossed := range softLimitsCrossed(s.quota.Soft, usage, asset.Bytes) {
+		crossed.OwnerID = asset.OwnerID
+		crossed.ExceededAt = now
+		s.publishQuotaExceeded(ctx, crossed)
+	}
+}
+
+// ReleaseAsset gives up the owner's upload and credits it back to their usage. The content
+// stays pinned for the holders still referencing it.
+func (s *Service) ReleaseAsset(ctx context.Context, id, ownerID string) (*domain.AssetDoc, bool, error) {
+	if id == "" || ownerID == "" {
+		return nil, false, domain.ErrInvalidInput
+	}
+	asset, released, err := s.repository.ReleaseOwner(ctx, id, ownerID)
+	if err != nil || !released {
+		return asset, released, err
+	}
+
+	if s.usage != nil {
+		if _, err := s.usage.AddUsage(ctx, ownerID, asset.Kind, -asset.Bytes, -1); err != nil {
+			log.Printf("Failed to credit released asset %s to %s: %v", asset.ID, ownerID, err)
+		}
+	}
+	return asset, true, nil
+}
+
+// GetStorageUsage returns what the owner holds and the quota it is measured against
+func (s *Service) GetStorageUsage(ctx context.Context, ownerID string) (*domain.StorageUsage, domain.StorageQuota, error) {
+	if ownerID == "" {
+		return nil, domain.StorageQuota{}, domain.ErrInvalidInput
+	}
+	if s.usage == nil {
+		return nil, domain.StorageQuota{}, domain.ErrUsageUnavailable
+	}
+	usage, err := s.usage.GetUsage(ctx, ownerID)
+	if err != nil {
+		return nil, domain.StorageQuota{}, err
+	}
+	return usage, s.quota, nil
+}
+
+func (s *Service) publishQuotaExceeded(ctx context.Context, exceeded *domain.QuotaExceeded) {
+	log.Printf("Storage quota %s limit exceeded: owner=%s resource=%s kind=%s used=%d requested=%d limit=%d",
+		exceeded.Level, exceeded.OwnerID, exceeded.Resource, exceeded.Kind, exceeded.Used, exceeded.Requested, exceeded.Limit)
+	if s.quotaEvents == nil {
+		return
+	}
+	if err := s.quotaEvents.PublishQuotaExceeded(ctx, exceeded); err != nil {
+		log.Printf("Failed to publish quota_exceeded for %s: %v", exceeded.OwnerID, err)
+	}
+}
+
+// hardLimitExceeded returns the first hard limit an upload of size bytes would break:
+// the owner's totals first, then those of the file type
+func hardLimitExceeded(quota domain.StorageQuota, usage *domain.StorageUsage, kind string, size int64) *domain.QuotaExceeded {
+	kindUsage := usage.Kinds[kind]
+	kindLimits := quota.Kinds[kind]
+	checks := []domain.QuotaExceeded{
+		{Resource: contracts.QuotaResourceBytes, Used: usage.Bytes, Requested: size, Limit: quota.Hard.Bytes},
+		{Resource: contracts.QuotaResourceAssets, Used: usage.Assets, Requested: 1, Limit: quota.Hard.Assets},
+		{Resource: contracts.QuotaResourceBytes, Kind: kind, Used: kindUsage.Bytes, Requested: size, Limit: kindLimits.Bytes},
+		{Resource: contracts.QuotaResourceAssets, Kind: kind, Used: kindUsage.Assets, Requested: 1, Limit: kindLimits.Assets},
+	}
+	for _, check := range checks {
+		if check.Limit > 0 && check.Used+check.Requested > check.Limit {
+			check.Level = contracts.QuotaLevelHard
+			return &check
+		}
+	}
+	return nil
+}
+
+// softLimitsCrossed returns the soft limits that the upload of size bytes, already counted
+// in usage, took the owner over. Limits exceeded before the upload were announced then.
+func softLimitsCrossed(soft domain.QuotaLimits, usage *domain.StorageUsage, size int64) []*domain.QuotaExceeded {
+	var crossed []*domain.QuotaExceeded
+	if soft.Bytes > 0 && usage.Bytes > soft.Bytes && usage.Bytes-size <= soft.Bytes {
+		crossed = append(crossed, &domain.QuotaExceeded{
+			Level: contracts.QuotaLevelSoft, Resource: contracts.QuotaResourceBytes,
+			Used: usage.Bytes - size, Requested: size, Limit: soft.Bytes,
+		})
+	}
+	if soft.Assets > 0 && usage.Assets > soft.Assets && usage.Assets-1 <= soft.Assets {
+		crossed = append(crossed, &domain.QuotaExceeded{
+			Level: contracts.QuotaLevelSoft, Resource: contracts.QuotaResourceAssets,
+			Used: usage.Assets - 1, Requested: 1, Limit: soft.Assets,
+		})
+	}
+	return crossed
+}
+
+// quotaError explains which limit rejected the upload
+func quotaError(exceeded *domain.QuotaExceeded) error {
+	scope := "storage quota"
+	if exceeded.Kind != "" {
+		scope = exceeded.Kind + " storage quota"
+	}
+	if exceeded.Resource == contracts.QuotaResourceAssets {
+		return domain.ErrQuotaExceeded.WithMessage(fmt.Sprintf("%s exceeded: %d of %d assets used", scope, exceeded.Used, exceeded.Limit))
+	}
+	return domain.ErrQuotaExceeded.WithMessage(fmt.Sprintf("%s exceeded: %d of %d bytes used, upload needs %d more", scope, exceeded.Used, exceeded.Limit, exceeded.Requested))
+}
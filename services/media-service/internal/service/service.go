@@ -7,28 +7,59 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 )
 
 type Service struct {
 	repository domain.MediaRepository
 	pinner     domain.Pinner
+	progress   domain.ProgressPublisher
+
+	usage       domain.UsageRepository // nil leaves uploads unmetered
+	quota       domain.StorageQuota
+	quotaEvents domain.QuotaEventPublisher
+
+	artifacts       domain.ArtifactRepository // nil disables artifacts
+	artifactKey     []byte
+	artifactBaseURL string
+	artifactURLTTL  time.Duration
+	tokenGateSecret []byte // empty denies gated downloads
 }
 
 func NewMediaService(
 	repository domain.MediaRepository,
 	pinner domain.Pinner,
-) domain.MediaService {
+) *Service {
 	return &Service{
 		repository: repository,
 		pinner:     pinner,
 	}
 }
 
+// SetProgressPublisher publishes the progress of ticketed uploads to the clients following them
+func (s *Service) SetProgressPublisher(publisher domain.ProgressPublisher) {
+	s.progress = publisher
+}
+
 func (s *Service) UploadAndPin(ctx context.Context, meta domain.UploadMeta, r io.Reader, sizeHint int64) (asset *domain.AssetDoc, dedup bool, err error) {
+	return s.UploadAndPinWithProgress(ctx, meta, r, sizeHint, nil)
+}
+
+func (s *Service) UploadAndPinWithProgress(ctx context.Context, meta domain.UploadMeta, r io.Reader, sizeHint int64, onProgress domain.ProgressFunc) (asset *domain.AssetDoc, dedup bool, err error) {
+	tracker := s.newProgressTracker(ctx, meta, sizeHint, onProgress)
+	defer func() {
+		if err != nil {
+			tracker.fail(err)
+		} else {
+			tracker.done(asset.ID)
+		}
+	}()
+
 	// Validate inputs
 	if r == nil {
 		return nil, false, fmt.Errorf("reader cannot be nil")
@@ -40,11 +71,26 @@ func (s *Service) UploadAndPin(ctx context.Context, meta domain.UploadMeta, r io
 		return nil, false, fmt.Errorf("mime type is required")
 	}
 
+	// An announced size lets an upload over quota fail before it is received
+	announced := sizeHint
+	if meta.TotalBytes > 0 {
+		announced = meta.TotalBytes
+	}
+	if err := s.checkQuota(ctx, meta.OwnerID, meta.Kind, announced); err != nil {
+		return nil, false, err
+	}
+
 	// Read the entire content to calculate SHA256 and prepare for pinning
-	content, err := io.ReadAll(r)
+	content, err := io.ReadAll(tracker.reader(r))
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to read content: %w", err)
 	}
+	tracker.received()
+	if int64(len(content)) > announced {
+		if err := s.checkQuota(ctx, meta.OwnerID, meta.Kind, int64(len(content))); err != nil {
+			return nil, false, err
+		}
+	}
 
 	// Calculate SHA256 hash for deduplication
 	hash := sha256.Sum256(content)
@@ -64,6 +110,7 @@ func (s *Service) UploadAndPin(ctx context.Context, meta domain.UploadMeta, r io
 		PinStatus:   string(domain.PinPending),
 		PinAttempts: 0,
 		RefCount:    1,
+		OwnerID:     meta.OwnerID,
 		CreatedAt:   time.Now(),
 	}
 
@@ -77,6 +124,7 @@ func (s *Service) UploadAndPin(ctx context.Context, meta domain.UploadMeta, r io
 		return existingAsset, true, nil
 	}
 
+	tracker.stage(contracts.UploadStagePinning)
 	contentReader := io.NopCloser(bytes.NewReader(content))
 	pinResult, err := s.pinner.PinFile(ctx, contentReader, meta.Filename)
 	if err != nil {
@@ -95,23 +143,34 @@ func (s *Service) UploadAndPin(ctx context.Context, meta domain.UploadMeta, r io
 	}
 
 	// Update asset with pin success
+	tracker.stage(contracts.UploadStageProcessing)
 	asset.IPFSCID = &pinResult.CID
 	asset.PinStatus = string(domain.PinPinned)
 	asset.PinAttempts = 1
 
+	if pinResult.Provider != "" {
+		asset.PinProvider = &pinResult.Provider
+	}
+
 	// Set gateway URL if available
-	if gatewayURL := s.pinner.GatewayURL(pinResult.CID); gatewayURL != "" {
+	if gatewayURL := s.gatewayURL(pinResult); gatewayURL != "" {
 		asset.GatewayURL = &gatewayURL
 	}
 
 	// Update the asset in repository with final pin result
-	if err := s.repository.SetPinned(ctx, asset.ID, pinResult.CID, asset.GatewayURL); err != nil {
+	if err := s.repository.SetPinned(ctx, asset.ID, pinResult.CID, pinResult.Provider, asset.GatewayURL); err != nil {
 		return nil, false, fmt.Errorf("failed to update asset with pin result: %w", err)
 	}
+	s.chargeUpload(ctx, asset)
 
 	return asset, false, nil
 }
 
+// LastPinnedAt reports when a provider last confirmed a pin
+func (s *Service) LastPinnedAt(ctx context.Context) (*time.Time, error) {
+	return s.repository.LastPinnedAt(ctx)
+}
+
 func (s *Service) GetAsset(ctx context.Context, id string) (*domain.AssetDoc, error) {
 	return s.repository.GetByID(ctx, id)
 }
@@ -119,3 +178,91 @@ func (s *Service) GetAsset(ctx context.Context, id string) (*domain.AssetDoc, er
 func (s *Service) GetAssetByCID(ctx context.Context, cid string) (*domain.AssetDoc, error) {
 	return s.repository.GetByCID(ctx, cid)
 }
+
+// AddRef counts ref against the asset, which must be pinned already
+func (s *Service) AddRef(ctx context.Context, id, ref string) (*domain.AssetDoc, error) {
+	if id == "" || ref == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	return s.repository.AddRef(ctx, id, ref)
+}
+
+// ReleaseRef drops ref from the asset; releasing a ref that is not held is not an error
+func (s *Service) ReleaseRef(ctx context.Context, id, ref string) (*domain.AssetDoc, bool, error) {
+	if id == "" || ref == "" {
+		return nil, false, domain.ErrInvalidInput
+	}
+	return s.repository.ReleaseRef(ctx, id, ref)
+}
+
+// UnpinAsset removes the asset's pin from the provider holding it
+func (s *Service) UnpinAsset(ctx context.Context, id string) error {
+	asset, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if asset.IPFSCID == nil || asset.PinStatus != string(domain.PinPinned) {
+		return domain.ErrNotPinned
+	}
+
+	router, ok := s.pinner.(domain.PinRouter)
+	if ok && asset.PinProvider != nil {
+		err = router.UnpinFrom(ctx, *asset.PinProvider, *asset.IPFSCID)
+	} else {
+		err = s.pinner.Unpin(ctx, *asset.IPFSCID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unpin asset: %w", err)
+	}
+
+	return s.repository.ClearPin(ctx, id)
+}
+
+// RepinAsset pins the asset's CID on another provider, e.g. when its provider degrades.
+// The old pin is removed best-effort once the new one is in place.
+func (s *Service) RepinAsset(ctx context.Context, id string) (*domain.AssetDoc, error) {
+	asset, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if asset.IPFSCID == nil {
+		return nil, domain.ErrNotPinned
+	}
+	router, ok := s.pinner.(domain.PinRouter)
+	if !ok {
+		return nil, domain.ErrNoPinProvider
+	}
+
+	var previous string
+	if asset.PinProvider != nil {
+		previous = *asset.PinProvider
+	}
+	pinResult, err := router.PinCIDElsewhere(ctx, *asset.IPFSCID, asset.ID, previous)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-pin asset: %w", err)
+	}
+
+	asset.PinStatus = string(domain.PinPinned)
+	asset.PinProvider = &pinResult.Provider
+	if gatewayURL := s.gatewayURL(pinResult); gatewayURL != "" {
+		asset.GatewayURL = &gatewayURL
+	}
+	if err := s.repository.SetPinned(ctx, asset.ID, *asset.IPFSCID, pinResult.Provider, asset.GatewayURL); err != nil {
+		return nil, fmt.Errorf("failed to update asset with pin result: %w", err)
+	}
+
+	if previous != "" {
+		if err := router.UnpinFrom(ctx, previous, *asset.IPFSCID); err != nil {
+			log.Printf("Failed to unpin asset %s from %s after re-pin: %v", asset.ID, previous, err)
+		}
+	}
+	return asset, nil
+}
+
+// gatewayURL prefers the gateway of the provider that took the pin
+func (s *Service) gatewayURL(res domain.PinResult) string {
+	if router, ok := s.pinner.(domain.PinRouter); ok && res.Provider != "" {
+		return router.ProviderGatewayURL(res.Provider, res.CID)
+	}
+	return s.pinner.GatewayURL(res.CID)
+}
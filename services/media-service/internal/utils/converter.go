@@ -1,10 +1,13 @@
 package utils
 
 import (
+	"sort"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	mediaProto "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
 )
 
@@ -94,3 +97,114 @@ func DomainToProtoAsset(asset *domain.AssetDoc) *mediaProto.Asset {
 
 	return protoAsset
 }
+
+// DomainToProtoArtifact leaves the download URL unset when the artifact was not signed
+func DomainToProtoArtifact(artifact *domain.SignedArtifact) *mediaProto.Artifact {
+	protoArtifact := &mediaProto.Artifact{
+		Id:          artifact.ID,
+		Name:        artifact.Name,
+		Mime:        artifact.Mime,
+		Bytes:       nonNegative(artifact.Bytes),
+		Sha256:      artifact.SHA256,
+		CreatedAt:   timestamppb.New(artifact.CreatedAt),
+		ExpiresAt:   timestamppb.New(artifact.ExpiresAt),
+		DownloadUrl: artifact.DownloadURL,
+	}
+	if artifact.DownloadURL != "" {
+		protoArtifact.UrlExpiresAt = timestamppb.New(artifact.URLExpiresAt)
+	}
+	if artifact.Gate != nil {
+		protoArtifact.Gate = &mediaProto.TokenGate{
+			ChainId:    artifact.Gate.ChainID,
+			Contract:   artifact.Gate.Contract,
+			MinBalance: artifact.Gate.MinBalance,
+		}
+	}
+	return protoArtifact
+}
+
+func ProtoToDomainArtifactGate(gate *mediaProto.TokenGate) *domain.ArtifactGate {
+	if gate == nil {
+		return nil
+	}
+	return &domain.ArtifactGate{
+		ChainID:    gate.ChainId,
+		Contract:   gate.Contract,
+		MinBalance: gate.MinBalance,
+	}
+}
+
+func DomainToProtoUploadStage(stage string) mediaProto.UploadStage {
+	switch stage {
+	case contracts.UploadStageUploading:
+		return mediaProto.UploadStage_UPLOAD_STAGE_UPLOADING
+	case contracts.UploadStagePinning:
+		return mediaProto.UploadStage_UPLOAD_STAGE_PINNING
+	case contracts.UploadStageProcessing:
+		return mediaProto.UploadStage_UPLOAD_STAGE_PROCESSING
+	case contracts.UploadStageDone:
+		return mediaProto.UploadStage_UPLOAD_STAGE_DONE
+	case contracts.UploadStageFailed:
+		return mediaProto.UploadStage_UPLOAD_STAGE_FAILED
+	default:
+		return mediaProto.UploadStage_UPLOAD_STAGE_UNSPECIFIED
+	}
+}
+
+func DomainToProtoUploadProgress(progress contracts.UploadProgress) *mediaProto.UploadProgress {
+	return &mediaProto.UploadProgress{
+		UploadTicket:  progress.Ticket,
+		Stage:         DomainToProtoUploadStage(progress.Stage),
+		BytesReceived: uint64(progress.BytesReceived),
+		TotalBytes:    uint64(progress.TotalBytes),
+	}
+}
+
+// DomainToProtoStorageUsage lists every kind that holds storage or has a limit of its own
+func DomainToProtoStorageUsage(usage *domain.StorageUsage, quota domain.StorageQuota) *mediaProto.GetStorageUsageResponse {
+	kinds := make(map[string]bool)
+	for kind := range usage.Kinds {
+		kinds[kind] = true
+	}
+	for kind := range quota.Kinds {
+		kinds[kind] = true
+	}
+	names := make([]string, 0, len(kinds))
+	for kind := range kinds {
+		names = append(names, kind)
+	}
+	sort.Strings(names)
+
+	resp := &mediaProto.GetStorageUsageResponse{
+		Bytes:     nonNegative(usage.Bytes),
+		Assets:    nonNegative(usage.Assets),
+		SoftLimit: domainToProtoLimits(quota.Soft),
+		HardLimit: domainToProtoLimits(quota.Hard),
+	}
+	for _, kind := range names {
+		kindUsage := &mediaProto.KindStorageUsage{
+			Kind:   DomainToProtoMediaKind(kind),
+			Bytes:  nonNegative(usage.Kinds[kind].Bytes),
+			Assets: nonNegative(usage.Kinds[kind].Assets),
+		}
+		if limits, ok := quota.Kinds[kind]; ok {
+			kindUsage.HardLimit = domainToProtoLimits(limits)
+		}
+		resp.Kinds = append(resp.Kinds, kindUsage)
+	}
+	return resp
+}
+
+func domainToProtoLimits(limits domain.QuotaLimits) *mediaProto.StorageLimits {
+	return &mediaProto.StorageLimits{
+		Bytes:  nonNegative(limits.Bytes),
+		Assets: nonNegative(limits.Assets),
+	}
+}
+
+func nonNegative(n int64) uint64 {
+	if n < 0 {
+		return 0
+	}
+	return uint64(n)
+}
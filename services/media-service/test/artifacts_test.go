@@ -0,0 +1,188 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	"github.com/quangdang46/NFT-Marketplace/shared/tokengate"
+)
+
+const artifactBaseURL = "https://gateway.test/artifacts"
+
+type memoryArtifacts struct {
+	artifacts map[string]domain.ArtifactDoc
+}
+
+func (m *memoryArtifacts) CreateArtifact(ctx context.Context, a *domain.ArtifactDoc) error {
+	m.artifacts[a.ID] = *a
+	return nil
+}
+
+func (m *memoryArtifacts) GetArtifact(ctx context.Context, id string, withContent bool) (*domain.ArtifactDoc, error) {
+	a, ok := m.artifacts[id]
+	if !ok || time.Now().After(a.ExpiresAt) {
+		return nil, domain.ErrArtifactNotFound
+	}
+	if !withContent {
+		a.Content = nil
+	}
+	return &a, nil
+}
+
+func newArtifactService(urlTTL time.Duration) (*service.Service, *memoryArtifacts) {
+	svc := service.NewMediaService(newMockMediaRepository(), newMockPinner(false))
+	repo := &memoryArtifacts{artifacts: make(map[string]domain.ArtifactDoc)}
+	svc.SetArtifacts(repo, []byte("test-secret"), artifactBaseURL+"/", urlTTL)
+	return svc, repo
+}
+
+// signedQuery splits a download URL into the id, expiry and signature the gateway forwards
+func signedQuery(t *testing.T, downloadURL string) (string, int64, string) {
+	u, err := url.Parse(downloadURL)
+	if err != nil {
+		t.Fatalf("Expected a valid URL, got %v", err)
+	}
+	expires, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	if err != nil {
+		t.Fatalf("Expected an expires parameter, got %v", err)
+	}
+	return strings.TrimPrefix(u.Path, "/artifacts/"), expires, u.Query().Get("sig")
+}
+
+func TestStoreArtifact_SignedDownload(t *testing.T) {
+	svc, _ := newArtifactService(time.Hour)
+	ctx := context.Background()
+
+	stored, err := svc.StoreArtifact(ctx, domain.StoreArtifactInput{Name: "holders.csv", Mime: "text/csv", Content: []byte("holder,balance\n")})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(stored.DownloadURL, artifactBaseURL+"/"+stored.ID+"?") {
+		t.Errorf("Expected a URL below the base, got %s", stored.DownloadURL)
+	}
+	if stored.Bytes != 15 || stored.SHA256 == "" {
+		t.Errorf("Expected size and hash, got %d bytes hash %q", stored.Bytes, stored.SHA256)
+	}
+
+	id, expires, sig := signedQuery(t, stored.DownloadURL)
+	artifact, err := svc.DownloadArtifact(ctx, id, expires, sig, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(artifact.Content) != "holder,balance\n" {
+		t.Errorf("Expected the stored content, got %q", artifact.Content)
+	}
+}
+
+func TestDownloadArtifact_RejectsTamperedLinks(t *testing.T) {
+	svc, _ := newArtifactService(time.Hour)
+	ctx := context.Background()
+
+	first, _ := svc.StoreArtifact(ctx, domain.StoreArtifactInput{Name: "a.csv", Mime: "text/csv", Content: []byte("a")})
+	second, _ := svc.StoreArtifact(ctx, domain.StoreArtifactInput{Name: "b.csv", Mime: "text/csv", Content: []byte("b")})
+	id, expires, sig := signedQuery(t, first.DownloadURL)
+
+	cases := map[string]struct {
+		id      string
+		expires int64
+		sig     string
+	}{
+		"other artifact":  {second.ID, expires, sig},
+		"extended expiry": {id, expires + 3600, sig},
+		"garbage":         {id, expires, "not-hex"},
+		"expired":         {id, time.Now().Add(-time.Minute).Unix(), sig},
+	}
+	for name, c := range cases {
+		if _, err := svc.DownloadArtifact(ctx, c.id, c.expires, c.sig, ""); !errs.Is(err, errs.PermissionDenied) {
+			t.Errorf("%s: expected PERMISSION_DENIED, got %v", name, err)
+		}
+	}
+}
+
+func TestStoreArtifact_URLExpiresWithArtifact(t *testing.T) {
+	svc, _ := newArtifactService(24 * time.Hour)
+
+	stored, err := svc.StoreArtifact(context.Background(), domain.StoreArtifactInput{
+		Name: "a.json", Mime: "application/json", Content: []byte("{}"), TTL: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if stored.URLExpiresAt.After(stored.ExpiresAt) {
+		t.Errorf("Expected the link to expire with the artifact at %v, got %v", stored.ExpiresAt, stored.URLExpiresAt)
+	}
+}
+
+func TestStoreArtifact_Validation(t *testing.T) {
+	svc, _ := newArtifactService(time.Hour)
+	ctx := context.Background()
+
+	if _, err := svc.StoreArtifact(ctx, domain.StoreArtifactInput{Name: "../a.csv", Mime: "text/csv", Content: []byte("a")}); !errs.Is(err, errs.InvalidArgument) {
+		t.Errorf("Expected INVALID_ARGUMENT for a path name, got %v", err)
+	}
+	tooLarge := make([]byte, service.MaxArtifactBytes+1)
+	if _, err := svc.StoreArtifact(ctx, domain.StoreArtifactInput{Name: "a.csv", Mime: "text/csv", Content: tooLarge}); !errs.Is(err, errs.InvalidArgument) {
+		t.Errorf("Expected INVALID_ARGUMENT for an oversized artifact, got %v", err)
+	}
+	if _, err := svc.GetArtifact(ctx, "missing"); !errs.Is(err, errs.NotFound) {
+		t.Errorf("Expected NOT_FOUND, got %v", err)
+	}
+}
+
+func TestDownloadArtifact_GatedNeedsCoveringToken(t *testing.T) {
+	svc, _ := newArtifactService(time.Hour)
+	secret := []byte("gate-secret")
+	svc.SetTokenGateSecret(secret)
+	ctx := context.Background()
+
+	stored, err := svc.StoreArtifact(ctx, domain.StoreArtifactInput{
+		Name: "hires.png", Mime: "image/png", Content: []byte("png"),
+		Gate: &domain.ArtifactGate{ChainID: "eip155:1", Contract: "0xABC", MinBalance: "2"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if stored.Gate == nil || stored.Gate.Contract != "0xabc" {
+		t.Fatalf("Expected the gate to be kept, got %+v", stored.Gate)
+	}
+	id, expires, sig := signedQuery(t, stored.DownloadURL)
+
+	gateToken := func(key []byte, contract string, minBalance int64) string {
+		now := time.Now()
+		token, err := tokengate.Sign(key, tokengate.Claims{
+			UserID: "user-1", ChainID: "eip155:1", Contract: contract, MinBalance: big.NewInt(minBalance), ExpiresAt: now.Add(time.Minute),
+		}, now)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return token
+	}
+
+	denied := map[string]string{
+		"no token":       "",
+		"other contract": gateToken(secret, "0xdef", 2),
+		"lower minimum":  gateToken(secret, "0xabc", 1),
+		"other secret":   gateToken([]byte("other"), "0xabc", 2),
+	}
+	for name, token := range denied {
+		if _, err := svc.DownloadArtifact(ctx, id, expires, sig, token); !errs.Is(err, errs.PermissionDenied) {
+			t.Errorf("%s: expected PERMISSION_DENIED, got %v", name, err)
+		}
+	}
+
+	artifact, err := svc.DownloadArtifact(ctx, id, expires, sig, gateToken(secret, "0xABC", 3))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(artifact.Content) != "png" {
+		t.Errorf("Expected the stored content, got %q", artifact.Content)
+	}
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
+)
+
+// TestMediaRepositoryCache runs the repository against real MongoDB and Redis and checks
+// that cached lookups follow pin-status and variant updates
+func TestMediaRepositoryCache(t *testing.T) {
+	h := testharness.New(t)
+	db, _ := h.Mongo(t)
+	rds, _ := h.Redis(t)
+	repo := repository.NewMediaRepository(db, rds)
+	ctx := context.Background()
+
+	asset := &domain.AssetDoc{
+		ID:        "cache-asset",
+		Kind:      "IMAGE",
+		Mime:      "image/png",
+		Bytes:     2048,
+		SHA256:    "cache-sha256",
+		PinStatus: string(domain.PinPending),
+		CreatedAt: time.Now().UTC(),
+	}
+	created, dedup, err := repo.FindOrCreateBySHA256(ctx, asset)
+	require.NoError(t, err)
+	assert.False(t, dedup)
+
+	t.Run("DedupBySHA256", func(t *testing.T) {
+		again, dedup, err := repo.FindOrCreateBySHA256(ctx, &domain.AssetDoc{ID: "other-id", SHA256: asset.SHA256})
+		require.NoError(t, err)
+		assert.True(t, dedup)
+		assert.Equal(t, created.ID, again.ID)
+	})
+
+	t.Run("PinInvalidates", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, asset.ID)
+		require.NoError(t, err)
+		assert.Equal(t, string(domain.PinPending), got.PinStatus)
+
+		require.NoError(t, repo.SetPinned(ctx, asset.ID, "QmCacheCID", "kubo", nil))
+
+		got, err = repo.GetByID(ctx, asset.ID)
+		require.NoError(t, err)
+		assert.Equal(t, string(domain.PinPinned), got.PinStatus)
+
+		byCID, err := repo.GetByCID(ctx, "QmCacheCID")
+		require.NoError(t, err)
+		assert.Equal(t, asset.ID, byCID.ID)
+	})
+
+	t.Run("VariantInvalidates", func(t *testing.T) {
+		// warm the cache with the pinned asset
+		_, err := repo.GetByCID(ctx, "QmCacheCID")
+		require.NoError(t, err)
+
+		require.NoError(t, repo.UpsertVariant(ctx, asset.ID, domain.AssetVariantDoc{ID: "thumb", Width: 128}))
+		require.NoError(t, repo.UpsertVariant(ctx, asset.ID, domain.AssetVariantDoc{ID: "thumb", Width: 256}))
+
+		got, err := repo.GetByCID(ctx, "QmCacheCID")
+		require.NoError(t, err)
+		require.Len(t, got.Variants, 1)
+		assert.Equal(t, uint32(256), got.Variants[0].Width)
+	})
+
+	t.Run("UnknownAsset", func(t *testing.T) {
+		err := repo.UpsertVariant(ctx, "missing", domain.AssetVariantDoc{ID: "thumb"})
+		assert.ErrorIs(t, err, domain.ErrAssetNotFound)
+	})
+}
@@ -0,0 +1,196 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/infrastructure/pinning"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/service"
+)
+
+// fakeProvider is a PinProvider that fails while down is set
+type fakeProvider struct {
+	name     string
+	down     bool
+	pins     map[string]bool
+	attempts int
+}
+
+func newFakeProvider(name string) *fakeProvider {
+	return &fakeProvider{name: name, pins: make(map[string]bool)}
+}
+
+func (f *fakeProvider) Name() string { return f.name }
+
+func (f *fakeProvider) PinFile(ctx context.Context, r io.Reader, name string) (domain.PinResult, error) {
+	f.attempts++
+	if f.down {
+		return domain.PinResult{}, errors.New(f.name + " down")
+	}
+	content, _ := io.ReadAll(r)
+	cid := "bafy" + string(content)
+	f.pins[cid] = true
+	return domain.PinResult{CID: cid, Size: int64(len(content))}, nil
+}
+
+func (f *fakeProvider) PinJSON(ctx context.Context, v any, name string) (domain.PinResult, error) {
+	return f.PinFile(ctx, strings.NewReader("json"), name)
+}
+
+func (f *fakeProvider) PinCID(ctx context.Context, cid, name string) (domain.PinResult, error) {
+	f.attempts++
+	if f.down {
+		return domain.PinResult{}, errors.New(f.name + " down")
+	}
+	f.pins[cid] = true
+	return domain.PinResult{CID: cid}, nil
+}
+
+func (f *fakeProvider) Unpin(ctx context.Context, cid string) error {
+	if f.down {
+		return errors.New(f.name + " down")
+	}
+	delete(f.pins, cid)
+	return nil
+}
+
+func (f *fakeProvider) HealthCheck(ctx context.Context) error {
+	if f.down {
+		return errors.New(f.name + " down")
+	}
+	return nil
+}
+
+func (f *fakeProvider) GatewayURL(cid string) string {
+	return "https://" + f.name + ".example/ipfs/" + cid
+}
+
+func TestProviderChain_Failover(t *testing.T) {
+	primary, secondary := newFakeProvider("pinata"), newFakeProvider("kubo")
+	chain := pinning.NewProviderChain([]domain.PinProvider{primary, secondary}, 2, time.Hour)
+	ctx := context.Background()
+
+	primary.down = true
+	res, err := chain.PinFile(ctx, strings.NewReader("one"), "one.png")
+	require.NoError(t, err)
+	assert.Equal(t, "kubo", res.Provider)
+	assert.True(t, secondary.pins["bafyone"])
+	assert.True(t, chain.Healthy("pinata"), "a single failure stays under the threshold")
+
+	_, err = chain.PinFile(ctx, strings.NewReader("two"), "two.png")
+	require.NoError(t, err)
+	assert.False(t, chain.Healthy("pinata"))
+
+	// unhealthy providers are skipped while healthy ones remain
+	_, err = chain.PinFile(ctx, strings.NewReader("three"), "three.png")
+	require.NoError(t, err)
+	assert.Equal(t, 2, primary.attempts)
+}
+
+func TestProviderChain_AllDown(t *testing.T) {
+	primary, secondary := newFakeProvider("pinata"), newFakeProvider("kubo")
+	primary.down, secondary.down = true, true
+	chain := pinning.NewProviderChain([]domain.PinProvider{primary, secondary}, 1, time.Hour)
+
+	_, err := chain.PinFile(context.Background(), strings.NewReader("one"), "one.png")
+	assert.ErrorIs(t, err, domain.ErrPinFailed)
+
+	// a provider that recovers is used even while marked unhealthy
+	secondary.down = false
+	res, err := chain.PinFile(context.Background(), strings.NewReader("one"), "one.png")
+	require.NoError(t, err)
+	assert.Equal(t, "kubo", res.Provider)
+	assert.True(t, chain.Healthy("kubo"))
+}
+
+func TestProviderChain_HealthCheck(t *testing.T) {
+	primary, secondary := newFakeProvider("pinata"), newFakeProvider("kubo")
+	chain := pinning.NewProviderChain([]domain.PinProvider{primary, secondary}, 3, time.Hour)
+	ctx := context.Background()
+
+	primary.down = true
+	chain.CheckHealth(ctx)
+	assert.False(t, chain.Healthy("pinata"))
+	assert.Equal(t, "https://kubo.example/ipfs/cid", chain.GatewayURL("cid"))
+
+	primary.down = false
+	chain.CheckHealth(ctx)
+	assert.True(t, chain.Healthy("pinata"))
+	assert.Equal(t, "https://pinata.example/ipfs/cid", chain.GatewayURL("cid"))
+}
+
+func TestRepinAndUnpinAsset(t *testing.T) {
+	primary, secondary := newFakeProvider("pinata"), newFakeProvider("kubo")
+	chain := pinning.NewProviderChain([]domain.PinProvider{primary, secondary}, 3, time.Hour)
+	repo := newMockMediaRepository()
+	svc := service.NewMediaService(repo, chain)
+	ctx := context.Background()
+
+	meta := domain.UploadMeta{Filename: "art.png", Mime: "image/png", Kind: "IMAGE"}
+	asset, _, err := svc.UploadAndPin(ctx, meta, bytes.NewReader([]byte("art")), 3)
+	require.NoError(t, err)
+	require.NotNil(t, asset.PinProvider)
+	assert.Equal(t, "pinata", *asset.PinProvider)
+	assert.Equal(t, "https://pinata.example/ipfs/bafyart", *asset.GatewayURL)
+
+	repinned, err := svc.RepinAsset(ctx, asset.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "kubo", *repinned.PinProvider)
+	assert.Equal(t, "https://kubo.example/ipfs/bafyart", *repinned.GatewayURL)
+	assert.True(t, secondary.pins["bafyart"])
+	assert.False(t, primary.pins["bafyart"], "old provider is unpinned after re-pin")
+
+	require.NoError(t, svc.UnpinAsset(ctx, asset.ID))
+	assert.False(t, secondary.pins["bafyart"])
+	stored, err := svc.GetAsset(ctx, asset.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(domain.PinPending), stored.PinStatus)
+	assert.Nil(t, stored.PinProvider)
+
+	assert.ErrorIs(t, svc.UnpinAsset(ctx, asset.ID), domain.ErrNotPinned)
+}
+
+func TestKuboClient(t *testing.T) {
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		switch r.URL.Path {
+		case "/api/v0/add":
+			_, _ = w.Write([]byte(`{"Name":"art.png","Hash":"bafykubo","Size":"11"}`))
+		case "/api/v0/pin/rm", "/api/v0/pin/add", "/api/v0/version":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := pinning.NewKuboClient(config.KuboConfig{RPCURL: server.URL, GatewayURL: "http://ipfs.local"})
+	ctx := context.Background()
+
+	res, err := client.PinFile(ctx, strings.NewReader("art"), "art.png")
+	require.NoError(t, err)
+	assert.Equal(t, "bafykubo", res.CID)
+	assert.Equal(t, int64(11), res.Size)
+	require.NoError(t, client.Unpin(ctx, "bafykubo"))
+	require.NoError(t, client.HealthCheck(ctx))
+	assert.Equal(t, "http://ipfs.local/ipfs/bafykubo", client.GatewayURL("bafykubo"))
+
+	assert.Equal(t, []string{
+		"POST /api/v0/add?cid-version=1&pin=true",
+		"POST /api/v0/pin/rm?arg=bafykubo",
+		"POST /api/v0/version?",
+	}, commands)
+}
@@ -0,0 +1,150 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+type recordingPublisher struct {
+	published []contracts.UploadProgress
+}
+
+func (p *recordingPublisher) PublishUploadProgress(ctx context.Context, progress contracts.UploadProgress) error {
+	p.published = append(p.published, progress)
+	return nil
+}
+
+func stages(reports []contracts.UploadProgress) []string {
+	var out []string
+	for _, r := range reports {
+		out = append(out, r.Stage)
+	}
+	return out
+}
+
+func assertStages(t *testing.T, got []contracts.UploadProgress, want ...string) {
+	t.Helper()
+	gotStages := stages(got)
+	if len(gotStages) != len(want) {
+		t.Fatalf("Expected stages %v, got %v", want, gotStages)
+	}
+	for i := range want {
+		if gotStages[i] != want[i] {
+			t.Fatalf("Expected stages %v, got %v", want, gotStages)
+		}
+	}
+}
+
+func TestUploadAndPinWithProgress_ReportsEachStage(t *testing.T) {
+	publisher := &recordingPublisher{}
+	svc := service.NewMediaService(newMockMediaRepository(), newMockPinner(false))
+	svc.SetProgressPublisher(publisher)
+
+	// Twice the reporting step, so receiving is reported while in flight too
+	content := bytes.Repeat([]byte("x"), 2*service.ProgressBytes+10)
+	meta := domain.UploadMeta{Filename: "big.png", Mime: "image/png", Kind: "IMAGE", Ticket: "ticket-123"}
+
+	var reports []contracts.UploadProgress
+	asset, _, err := svc.UploadAndPinWithProgress(context.Background(), meta, bytes.NewReader(content), int64(len(content)), func(p contracts.UploadProgress) {
+		reports = append(reports, p)
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	received := 0
+	for received < len(reports) && reports[received].Stage == contracts.UploadStageUploading {
+		received++
+	}
+	if received < 2 {
+		t.Fatalf("Expected receiving to be reported in flight and once complete, got stages %v", stages(reports))
+	}
+	if got := reports[received-1].BytesReceived; got != int64(len(content)) {
+		t.Errorf("Expected the last uploading report at %d bytes, got %d", len(content), got)
+	}
+	assertStages(t, reports[received:], contracts.UploadStagePinning, contracts.UploadStageProcessing, contracts.UploadStageDone)
+
+	last := reports[len(reports)-1]
+	if last.AssetID != asset.ID {
+		t.Errorf("Expected done report for asset %s, got %s", asset.ID, last.AssetID)
+	}
+	if last.BytesReceived != int64(len(content)) || last.TotalBytes != int64(len(content)) {
+		t.Errorf("Expected %d of %d bytes, got %d of %d", len(content), len(content), last.BytesReceived, last.TotalBytes)
+	}
+	for _, r := range reports {
+		if r.Ticket != "ticket-123" {
+			t.Errorf("Expected ticket ticket-123, got %q", r.Ticket)
+		}
+	}
+
+	if len(publisher.published) != len(reports) {
+		t.Errorf("Expected %d published reports, got %d", len(reports), len(publisher.published))
+	}
+}
+
+func TestUploadAndPinWithProgress_UnticketedUploadIsNotPublished(t *testing.T) {
+	publisher := &recordingPublisher{}
+	svc := service.NewMediaService(newMockMediaRepository(), newMockPinner(false))
+	svc.SetProgressPublisher(publisher)
+
+	meta := domain.UploadMeta{Filename: "a.png", Mime: "image/png", Kind: "IMAGE"}
+	var reports []contracts.UploadProgress
+	_, _, err := svc.UploadAndPinWithProgress(context.Background(), meta, bytes.NewReader([]byte("art")), 3, func(p contracts.UploadProgress) {
+		reports = append(reports, p)
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	assertStages(t, reports,
+		contracts.UploadStageUploading, contracts.UploadStagePinning, contracts.UploadStageProcessing, contracts.UploadStageDone)
+	if len(publisher.published) != 0 {
+		t.Errorf("Expected nothing published without a ticket, got %d reports", len(publisher.published))
+	}
+}
+
+func TestUploadAndPinWithProgress_ReportsFailure(t *testing.T) {
+	publisher := &recordingPublisher{}
+	svc := service.NewMediaService(newMockMediaRepository(), newMockPinner(true))
+	svc.SetProgressPublisher(publisher)
+
+	meta := domain.UploadMeta{Filename: "a.png", Mime: "image/png", Kind: "IMAGE", Ticket: "ticket-456"}
+	_, _, err := svc.UploadAndPinWithProgress(context.Background(), meta, bytes.NewReader([]byte("art")), 3, nil)
+	if err == nil {
+		t.Fatal("Expected pin failure")
+	}
+
+	assertStages(t, publisher.published,
+		contracts.UploadStageUploading, contracts.UploadStagePinning, contracts.UploadStageFailed)
+	if last := publisher.published[len(publisher.published)-1]; last.Error == "" || !last.Done() {
+		t.Errorf("Expected a final failed report with the error, got %+v", last)
+	}
+}
+
+func TestUploadAndPinWithProgress_DedupIsDone(t *testing.T) {
+	svc := service.NewMediaService(newMockMediaRepository(), newMockPinner(false))
+	meta := domain.UploadMeta{Filename: "a.png", Mime: "image/png", Kind: "IMAGE"}
+
+	first, _, err := svc.UploadAndPin(context.Background(), meta, bytes.NewReader([]byte("art")), 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var reports []contracts.UploadProgress
+	_, dedup, err := svc.UploadAndPinWithProgress(context.Background(), meta, bytes.NewReader([]byte("art")), 3, func(p contracts.UploadProgress) {
+		reports = append(reports, p)
+	})
+	if err != nil || !dedup {
+		t.Fatalf("Expected a deduplicated upload, got dedup=%v err=%v", dedup, err)
+	}
+
+	assertStages(t, reports, contracts.UploadStageUploading, contracts.UploadStageDone)
+	if reports[1].AssetID != first.ID {
+		t.Errorf("Expected done report for existing asset %s, got %s", first.ID, reports[1].AssetID)
+	}
+}
@@ -0,0 +1,12 @@
+package test
+
+import (
+	"testing"
+
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/protocheck"
+)
+
+func TestProtoContract(t *testing.T) {
+	protocheck.Check(t, mediapb.File_media_proto)
+}
@@ -0,0 +1,190 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/media-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+)
+
+const quotaOwner = "user-1"
+
+type memoryUsage struct {
+	usage map[string]*domain.StorageUsage
+}
+
+func newMemoryUsage() *memoryUsage {
+	return &memoryUsage{usage: make(map[string]*domain.StorageUsage)}
+}
+
+func (m *memoryUsage) GetUsage(ctx context.Context, ownerID string) (*domain.StorageUsage, error) {
+	if u, ok := m.usage[ownerID]; ok {
+		copied := *u
+		return &copied, nil
+	}
+	return &domain.StorageUsage{OwnerID: ownerID}, nil
+}
+
+func (m *memoryUsage) AddUsage(ctx context.Context, ownerID, kind string, bytes, assets int64) (*domain.StorageUsage, error) {
+	u, ok := m.usage[ownerID]
+	if !ok {
+		u = &domain.StorageUsage{OwnerID: ownerID, Kinds: make(map[string]domain.KindUsage)}
+		m.usage[ownerID] = u
+	}
+	u.Bytes += bytes
+	u.Assets += assets
+	k := u.Kinds[kind]
+	k.Bytes += bytes
+	k.Assets += assets
+	u.Kinds[kind] = k
+	return m.GetUsage(ctx, ownerID)
+}
+
+type recordingQuotaEvents struct {
+	published []domain.QuotaExceeded
+}
+
+func (p *recordingQuotaEvents) PublishQuotaExceeded(ctx context.Context, exceeded *domain.QuotaExceeded) error {
+	p.published = append(p.published, *exceeded)
+	return nil
+}
+
+func newQuotaService(quota domain.StorageQuota) (*service.Service, *memoryUsage, *recordingQuotaEvents) {
+	svc := service.NewMediaService(newMockMediaRepository(), newMockPinner(false))
+	usage := newMemoryUsage()
+	events := &recordingQuotaEvents{}
+	svc.SetStorageQuota(usage, quota, events)
+	return svc, usage, events
+}
+
+func upload(svc *service.Service, owner, kind, content string) (*domain.AssetDoc, bool, error) {
+	meta := domain.UploadMeta{Filename: "file.bin", Mime: "application/octet-stream", Kind: kind, OwnerID: owner}
+	return svc.UploadAndPin(context.Background(), meta, bytes.NewReader([]byte(content)), int64(len(content)))
+}
+
+func TestUploadAndPin_ChargesOwner(t *testing.T) {
+	svc, _, _ := newQuotaService(domain.StorageQuota{})
+
+	if _, _, err := upload(svc, quotaOwner, "IMAGE", "first image"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// Dedup of content already held is free
+	if _, dedup, err := upload(svc, quotaOwner, "IMAGE", "first image"); err != nil || !dedup {
+		t.Fatalf("Expected dedup, got dedup=%v err=%v", dedup, err)
+	}
+	// Uploads without an owner are not metered
+	if _, _, err := upload(svc, "", "IMAGE", "anonymous"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	usage, _, err := svc.GetStorageUsage(context.Background(), quotaOwner)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if usage.Bytes != int64(len("first image")) || usage.Assets != 1 {
+		t.Errorf("Expected 11 bytes in 1 asset, got %d bytes in %d", usage.Bytes, usage.Assets)
+	}
+	if usage.Kinds["IMAGE"].Assets != 1 {
+		t.Errorf("Expected 1 IMAGE asset, got %d", usage.Kinds["IMAGE"].Assets)
+	}
+}
+
+func TestUploadAndPin_HardLimitRejects(t *testing.T) {
+	svc, usage, events := newQuotaService(domain.StorageQuota{Hard: domain.QuotaLimits{Bytes: 16}})
+
+	if _, _, err := upload(svc, quotaOwner, "IMAGE", "0123456789"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	_, _, err := upload(svc, quotaOwner, "IMAGE", "abcdefghij")
+	if !errs.Is(err, errs.ResourceExhausted) {
+		t.Fatalf("Expected RESOURCE_EXHAUSTED, got %v", err)
+	}
+
+	if got := usage.usage[quotaOwner].Bytes; got != 10 {
+		t.Errorf("Expected the rejected upload to stay uncharged, got %d bytes", got)
+	}
+	if len(events.published) != 1 {
+		t.Fatalf("Expected 1 quota event, got %d", len(events.published))
+	}
+	event := events.published[0]
+	if event.Level != contracts.QuotaLevelHard || event.Resource != contracts.QuotaResourceBytes || event.OwnerID != quotaOwner {
+		t.Errorf("Unexpected quota event %+v", event)
+	}
+	if event.Used != 10 || event.Requested != 10 || event.Limit != 16 {
+		t.Errorf("Expected used=10 requested=10 limit=16, got %+v", event)
+	}
+}
+
+func TestUploadAndPin_FileTypeLimit(t *testing.T) {
+	svc, _, events := newQuotaService(domain.StorageQuota{Kinds: map[string]domain.QuotaLimits{"VIDEO": {Assets: 1}}})
+
+	if _, _, err := upload(svc, quotaOwner, "VIDEO", "first video"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, _, err := upload(svc, quotaOwner, "VIDEO", "second video"); !errs.Is(err, errs.ResourceExhausted) {
+		t.Fatalf("Expected RESOURCE_EXHAUSTED, got %v", err)
+	}
+	if _, _, err := upload(svc, quotaOwner, "IMAGE", "an image"); err != nil {
+		t.Fatalf("Expected other file types to pass, got %v", err)
+	}
+
+	if len(events.published) != 1 || events.published[0].Kind != "VIDEO" {
+		t.Errorf("Expected one VIDEO quota event, got %+v", events.published)
+	}
+}
+
+func TestUploadAndPin_SoftLimitNotifiesOnce(t *testing.T) {
+	svc, _, events := newQuotaService(domain.StorageQuota{Soft: domain.QuotaLimits{Assets: 1}})
+
+	for _, content := range []string{"one", "two", "three"} {
+		if _, _, err := upload(svc, quotaOwner, "IMAGE", content); err != nil {
+			t.Fatalf("Expected soft limit to allow upload, got %v", err)
+		}
+	}
+
+	if len(events.published) != 1 {
+		t.Fatalf("Expected 1 quota event, got %d", len(events.published))
+	}
+	event := events.published[0]
+	if event.Level != contracts.QuotaLevelSoft || event.Resource != contracts.QuotaResourceAssets || event.Used != 1 || event.Limit != 1 {
+		t.Errorf("Unexpected quota event %+v", event)
+	}
+}
+
+func TestReleaseAsset_CreditsOwner(t *testing.T) {
+	svc, usage, _ := newQuotaService(domain.StorageQuota{Hard: domain.QuotaLimits{Assets: 1}})
+	ctx := context.Background()
+
+	asset, _, err := upload(svc, quotaOwner, "IMAGE", "release me")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, released, err := svc.ReleaseAsset(ctx, asset.ID, "someone-else"); err != nil || released {
+		t.Fatalf("Expected another user's release to be a no-op, got released=%v err=%v", released, err)
+	}
+	if _, released, err := svc.ReleaseAsset(ctx, asset.ID, quotaOwner); err != nil || !released {
+		t.Fatalf("Expected release, got released=%v err=%v", released, err)
+	}
+	if _, released, _ := svc.ReleaseAsset(ctx, asset.ID, quotaOwner); released {
+		t.Fatal("Expected a repeated release to be a no-op")
+	}
+
+	if u := usage.usage[quotaOwner]; u.Bytes != 0 || u.Assets != 0 {
+		t.Errorf("Expected usage to be credited back, got %d bytes in %d assets", u.Bytes, u.Assets)
+	}
+	if _, _, err := upload(svc, quotaOwner, "IMAGE", "room again"); err != nil {
+		t.Fatalf("Expected released quota to be usable, got %v", err)
+	}
+}
+
+func TestGetStorageUsage_Unmetered(t *testing.T) {
+	svc := service.NewMediaService(newMockMediaRepository(), newMockPinner(false))
+	if _, _, err := svc.GetStorageUsage(context.Background(), quotaOwner); !errs.Is(err, errs.Unavailable) {
+		t.Fatalf("Expected UNAVAILABLE, got %v", err)
+	}
+}
@@ -211,7 +211,7 @@ func TestMockRepository_SetPinned(t *testing.T) {
 	gatewayURL := "https://gateway.pinata.cloud/ipfs/QmTestCID123456789"
 
 	// Test successful pin
-	err := repo.SetPinned(ctx, "test-id", cid, &gatewayURL)
+	err := repo.SetPinned(ctx, "test-id", cid, "pinata", &gatewayURL)
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
@@ -230,7 +230,7 @@ func TestMockRepository_SetPinned(t *testing.T) {
 	}
 
 	// Test not found
-	err = repo.SetPinned(ctx, "non-existent", cid, &gatewayURL)
+	err = repo.SetPinned(ctx, "non-existent", cid, "pinata", &gatewayURL)
 	if err != domain.ErrAssetNotFound {
 		t.Errorf("Expected ErrAssetNotFound, got %v", err)
 	}
@@ -70,16 +70,102 @@ func (m *mockMediaRepository) UpdateAfterUpload(ctx context.Context, id, mime st
 	return domain.ErrAssetNotFound
 }
 
-func (m *mockMediaRepository) SetPinned(ctx context.Context, id, cid string, gatewayURL *string) error {
+func (m *mockMediaRepository) SetPinned(ctx context.Context, id, cid, provider string, gatewayURL *string) error {
 	if asset, exists := m.assets[id]; exists {
+		now := time.Now()
 		asset.IPFSCID = &cid
 		asset.PinStatus = string(domain.PinPinned)
+		asset.PinnedAt = &now
 		asset.GatewayURL = gatewayURL
+		if provider != "" {
+			asset.PinProvider = &provider
+		}
+		return nil
+	}
+	return domain.ErrAssetNotFound
+}
+
+func (m *mockMediaRepository) LastPinnedAt(ctx context.Context) (*time.Time, error) {
+	var last *time.Time
+	for _, asset := range m.assets {
+		if asset.PinnedAt != nil && (last == nil || asset.PinnedAt.After(*last)) {
+			last = asset.PinnedAt
+		}
+	}
+	return last, nil
+}
+
+func (m *mockMediaRepository) ClearPin(ctx context.Context, id string) error {
+	if asset, exists := m.assets[id]; exists {
+		asset.PinStatus = string(domain.PinPending)
+		asset.PinProvider = nil
+		asset.GatewayURL = nil
 		return nil
 	}
 	return domain.ErrAssetNotFound
 }
 
+func (m *mockMediaRepository) UpsertVariant(ctx context.Context, id string, variant domain.AssetVariantDoc) error {
+	asset, exists := m.assets[id]
+	if !exists {
+		return domain.ErrAssetNotFound
+	}
+	for i := range asset.Variants {
+		if asset.Variants[i].ID == variant.ID {
+			asset.Variants[i] = variant
+			return nil
+		}
+	}
+	asset.Variants = append(asset.Variants, variant)
+	return nil
+}
+
+func (m *mockMediaRepository) AddRef(ctx context.Context, id, ref string) (*domain.AssetDoc, error) {
+	asset, exists := m.assets[id]
+	if !exists {
+		return nil, domain.ErrAssetNotFound
+	}
+	for _, held := range asset.Refs {
+		if held == ref {
+			return asset, nil
+		}
+	}
+	if asset.PinStatus != string(domain.PinPinned) {
+		return nil, domain.ErrNotPinned
+	}
+	asset.Refs = append(asset.Refs, ref)
+	asset.RefCount++
+	return asset, nil
+}
+
+func (m *mockMediaRepository) ReleaseRef(ctx context.Context, id, ref string) (*domain.AssetDoc, bool, error) {
+	asset, exists := m.assets[id]
+	if !exists {
+		return nil, false, domain.ErrAssetNotFound
+	}
+	for i, held := range asset.Refs {
+		if held == ref {
+			asset.Refs = append(asset.Refs[:i], asset.Refs[i+1:]...)
+			asset.RefCount--
+			return asset, true, nil
+		}
+	}
+	return asset, false, nil
+}
+
+func (m *mockMediaRepository) ReleaseOwner(ctx context.Context, id, ownerID string) (*domain.AssetDoc, bool, error) {
+	asset, exists := m.assets[id]
+	if !exists {
+		return nil, false, domain.ErrAssetNotFound
+	}
+	if asset.OwnerID != ownerID {
+		return asset, false, nil
+	}
+	asset.OwnerID = ""
+	asset.RefCount--
+	return asset, true, nil
+}
+
 func (m *mockMediaRepository) List(ctx context.Context, filter map[string]any, pageSize int, pageToken string) ([]domain.AssetDoc, string, error) {
 	var assets []domain.AssetDoc
 	for _, asset := range m.assets {
@@ -264,6 +350,35 @@ func TestUploadAndPin_PinFailure(t *testing.T) {
 	}
 }
 
+func TestLastPinnedAt(t *testing.T) {
+	repo := newMockMediaRepository()
+	svc := service.NewMediaService(repo, newMockPinner(false))
+	ctx := context.Background()
+
+	last, err := svc.LastPinnedAt(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if last != nil {
+		t.Fatalf("Expected no pin before the first upload, got %v", last)
+	}
+
+	before := time.Now()
+	meta := domain.UploadMeta{Filename: "test.jpg", Mime: "image/jpeg", Kind: "IMAGE"}
+	content := []byte("test image content")
+	if _, _, err := svc.UploadAndPin(ctx, meta, bytes.NewReader(content), int64(len(content))); err != nil {
+		t.Fatalf("Upload failed: %v", err)
+	}
+
+	last, err = svc.LastPinnedAt(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if last == nil || last.Before(before) {
+		t.Errorf("Expected the upload's pin time, got %v", last)
+	}
+}
+
 func TestGetAsset(t *testing.T) {
 	repo := newMockMediaRepository()
 	pinner := newMockPinner(false)
@@ -334,6 +449,52 @@ func TestGetAssetByCID(t *testing.T) {
 	}
 }
 
+func TestAddRefAndReleaseRef(t *testing.T) {
+	repo := newMockMediaRepository()
+	svc := service.NewMediaService(repo, newMockPinner(false))
+	ctx := context.Background()
+
+	repo.assets["pinned"] = &domain.AssetDoc{ID: "pinned", PinStatus: string(domain.PinPinned), RefCount: 1}
+	repo.assets["pinning"] = &domain.AssetDoc{ID: "pinning", PinStatus: string(domain.PinPinning), RefCount: 1}
+
+	// Retried calls count once
+	for i := 0; i < 2; i++ {
+		asset, err := svc.AddRef(ctx, "pinned", "intent:1")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if asset.RefCount != 2 {
+			t.Errorf("Expected ref count 2, got %d", asset.RefCount)
+		}
+	}
+
+	if _, err := svc.AddRef(ctx, "pinning", "intent:1"); err != domain.ErrNotPinned {
+		t.Errorf("Expected ErrNotPinned, got %v", err)
+	}
+	if _, err := svc.AddRef(ctx, "non-existent", "intent:1"); err != domain.ErrAssetNotFound {
+		t.Errorf("Expected ErrAssetNotFound, got %v", err)
+	}
+	if _, err := svc.AddRef(ctx, "pinned", ""); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+
+	asset, released, err := svc.ReleaseRef(ctx, "pinned", "intent:1")
+	if err != nil || !released {
+		t.Fatalf("Expected the ref to be released, got released=%v err=%v", released, err)
+	}
+	if asset.RefCount != 1 {
+		t.Errorf("Expected ref count 1, got %d", asset.RefCount)
+	}
+
+	_, released, err = svc.ReleaseRef(ctx, "pinned", "intent:1")
+	if err != nil || released {
+		t.Errorf("Expected releasing twice to be a no-op, got released=%v err=%v", released, err)
+	}
+	if asset.RefCount != 1 {
+		t.Errorf("Expected ref count 1, got %d", asset.RefCount)
+	}
+}
+
 // Helper function
 func uint32Ptr(v uint32) *uint32 {
 	return &v
@@ -3,18 +3,30 @@ package main
 import (
 	"context"
 	"log"
-	"net"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/encode"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/infrastructure/chain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/infrastructure/clients"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/infrastructure/events"
 	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/infrastructure/grpc"
 	rep "github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/infrastructure/repository"
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/status"
+	runtimeconfig "github.com/quangdang46/NFT-Marketplace/shared/config"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/grpcserver"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
 	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
 	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -27,6 +39,12 @@ func main() {
 	cfg := config.LoadConfig()
 	_ = cfg.Validate()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("orchestrator-service"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	pg, err := postgres.NewPostgres(cfg.Postgres)
 	if err != nil {
 		log.Fatalf("postgres: %v", err)
@@ -42,6 +60,9 @@ func main() {
 	if err := r.HealthCheck(ctx); err != nil {
 		log.Fatalf("redis ping: %v", err)
 	}
+	if cfg.StatusTransport.Transport == contracts.IntentStatusTransportStream {
+		r.SetIntentStatusStream(int64(cfg.StatusTransport.StreamMaxLen))
+	}
 
 	repo := rep.NewOrchestratorRepo(pg, r)
 	log.Printf("chain-registry-service URL: %s", cfg.ChainRegistryGRPCURL)
@@ -51,9 +72,54 @@ func main() {
 	}
 	chainRegistryClient := protoChainRegistry.NewChainRegistryServiceClient(conn)
 
+	log.Printf("wallet-service URL: %s", cfg.WalletGRPCURL)
+	walletConn, err := grpc.Dial(cfg.WalletGRPCURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("wallet-service connection: %v", err)
+	}
+	walletClient := walletpb.NewWalletServiceClient(walletConn)
+
+	log.Printf("user-service URL: %s", cfg.UserGRPCURL)
+	userConn, err := grpc.Dial(cfg.UserGRPCURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("user-service connection: %v", err)
+	}
+	userClient := userpb.NewUserServiceClient(userConn)
+
+	log.Printf("media-service URL: %s", cfg.MediaGRPCURL)
+	mediaConn, err := grpc.Dial(cfg.MediaGRPCURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("media-service connection: %v", err)
+	}
+	mediaClient := mediapb.NewMediaServiceClient(mediaConn)
+
+	log.Printf("auth-service URL: %s", cfg.AuthGRPCURL)
+	authConn, err := grpc.Dial(cfg.AuthGRPCURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("auth-service connection: %v", err)
+	}
+	authClient := authpb.NewAuthServiceClient(authConn)
+
 	encoder := encode.NewEncoder(chainRegistryClient)
+
+	// Registry and intent events are advisory (cached ABIs are revalidated against the registry
+	// every few minutes anyway), so the orchestrator runs without RabbitMQ
+	amqpClient, err := messaging.NewRabbitMQ(cfg.RabbitMQ)
+	if err != nil {
+		log.Printf("rabbitmq unavailable, registry and intent events disabled: %v", err)
+	} else {
+		defer amqpClient.Close()
+		if err := amqpClient.ConsumeAbiChanged("orchestrator.registry.abi_changed", "orchestrator-service", encode.HandleAbiChanged); err != nil {
+			log.Printf("abi_changed consumer: %v", err)
+		}
+		if err := amqpClient.ConsumeRegistryChanged("orchestrator.registry.changed", "orchestrator-service", encoder.(*encode.Encoder).HandleRegistryChanged); err != nil {
+			log.Printf("registry.changed consumer: %v", err)
+		}
+	}
+
 	statusCache := status.NewStatusCache()
 	statusCache.(*status.StatusCache).SetRedis(r)
+	defer statusCache.(*status.StatusCache).Flush(context.Background())
 
 	svc := service.NewOrchestratorWithTimeout(
 		repo,
@@ -64,15 +130,128 @@ func main() {
 		time.Duration(cfg.Features.SessionValidationTimeoutMs)*time.Millisecond,
 	)
 
-	lis, err := net.Listen("tcp", cfg.GRPCPort)
-	if err != nil {
-		log.Fatalf("listen: %v", err)
+	catalogReader := rep.NewCatalogReader(pg)
+	svc.(*service.Service).SetCollectionAccess(
+		catalogReader,
+		clients.NewWalletLinks(walletClient),
+	)
+	svc.(*service.Service).SetOrgAccess(
+		catalogReader,
+		clients.NewOrgMembers(userClient),
+	)
+	if amqpClient != nil {
+		svc.(*service.Service).SetIntentEvents(events.NewEventPublisher(amqpClient, rep.NewEventSequenceRepo(pg)))
 	}
-	s := grpc.NewServer()
+	svc.(*service.Service).SetCollectionImport(chain.NewCollectionInspector(chainRegistryClient))
+	svc.(*service.Service).SetImportChallenges(clients.NewAddressProofs(walletClient))
+	svc.(*service.Service).SetMediaRefs(clients.NewMediaRefs(mediaClient))
+	svc.(*service.Service).SetSessionValidator(clients.NewAuthSessions(
+		authClient,
+		time.Duration(cfg.SessionValidationCacheTTLMs)*time.Millisecond,
+	))
+
+	gasLimits := make(map[domain.ChainID]uint64, len(cfg.Airdrops.ChainGasLimits))
+	for chainID, limit := range cfg.Airdrops.ChainGasLimits {
+		gasLimits[chainID] = uint64(limit)
+	}
+	bundles := rep.NewBundleRepo(pg)
+	svc.(*service.Service).SetAirdrops(bundles, catalogReader, domain.AirdropPolicy{
+		DefaultGasLimit: uint64(max(cfg.Airdrops.BatchGasLimit, 0)),
+		GasLimits:       gasLimits,
+		MaxRecipients:   cfg.Airdrops.MaxBatchRecipients,
+	})
+	svc.(*service.Service).SetBulkTransfers(bundles, catalogReader)
+
+	if cfg.WebhookSigningSecret != "" {
+		svc.(*service.Service).SetWebhookSecret([]byte(cfg.WebhookSigningSecret))
+	}
+
+	svc.(*service.Service).SetCallTargets(rep.NewCallTargetRepo(pg))
+
+	if cfg.Sponsorship.Enabled {
+		svc.(*service.Service).SetSponsorship(rep.NewSponsorshipCounters(r), rep.NewSponsorshipRepo(pg), sponsorshipPolicy(cfg.Sponsorship))
+	}
+
+	svc.(*service.Service).SetIntentLimits(domain.IntentLimits{
+		MaxOpen:         cfg.IntentLimits.MaxOpen,
+		MaxOpenPerKind:  cfg.IntentLimits.MaxOpenPerKind,
+		MaxOpenPerChain: cfg.IntentLimits.MaxOpenPerChain,
+	})
+
+	// Runtime config adjusts the log level, intent limits and feature flags without a restart
+	if source := runtimeconfig.LoadSource(); source != nil {
+		reloader := runtimeconfig.NewReloader(source)
+		runtimeconfig.WatchLogLevel(reloader)
+		runtimeconfig.OnChange(reloader, config.IntentLimitsSection, cfg.IntentLimits, func(l config.IntentLimitConfig) {
+			svc.(*service.Service).SetIntentLimits(domain.IntentLimits{
+				MaxOpen:         l.MaxOpen,
+				MaxOpenPerKind:  l.MaxOpenPerKind,
+				MaxOpenPerChain: l.MaxOpenPerChain,
+			})
+		})
+		runtimeconfig.OnChange(reloader, config.FeaturesSection, cfg.Features, func(f config.Features) {
+			svc.(*service.Service).SetSessionLinkedIntents(f.SessionLinkedIntents, time.Duration(f.SessionValidationTimeoutMs)*time.Millisecond)
+		})
+		if err := reloader.Start(ctx); err != nil {
+			log.Printf("runtime config disabled: %v", err)
+		}
+	}
+
+	if cfg.StalledIntents.Enabled {
+		timeouts := make(map[domain.ChainID]time.Duration, len(cfg.StalledIntents.ChainTimeoutSeconds))
+		for chainID, seconds := range cfg.StalledIntents.ChainTimeoutSeconds {
+			timeouts[chainID] = time.Duration(seconds) * time.Second
+		}
+		svc.(*service.Service).SetStallDetection(chain.NewTxLookup(chainRegistryClient), domain.StallPolicy{
+			DefaultTimeout: time.Duration(cfg.StalledIntents.TimeoutSeconds) * time.Second,
+			Timeouts:       timeouts,
+			FailAfter:      time.Duration(cfg.StalledIntents.FailAfterSeconds) * time.Second,
+		})
+		svc.(*service.Service).SetChainLiveness(chain.NewChainLiveness(chainRegistryClient))
+		go svc.(*service.Service).RunStalledIntentDetector(ctx, time.Duration(cfg.StalledIntents.IntervalSeconds)*time.Second)
+	}
+
+	if cfg.IntentExpiry.Enabled {
+		go svc.(*service.Service).RunIntentExpiry(ctx, time.Duration(cfg.IntentExpiry.IntervalSeconds)*time.Second)
+	}
+
+	s := grpcserver.New(grpcserver.LoadConfig("orchestrator-service"))
 	handler := grpcHandler.NewGRPCHandler(svc)
 	orchestratorpb.RegisterOrchestratorServiceServer(s, handler)
 	log.Printf("orchestrator-service gRPC on %s", cfg.GRPCPort)
-	if err := s.Serve(lis); err != nil {
+	if err := grpcserver.ListenAndServe(s, cfg.GRPCPort); err != nil {
 		log.Fatalf("serve: %v", err)
 	}
+
+	// Finish the registry change notifications already received before the connection closes
+	if amqpClient != nil {
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer drainCancel()
+		if err := amqpClient.Drain(drainCtx); err != nil {
+			log.Printf("Error draining consumers: %v", err)
+		}
+	}
+}
+
+// sponsorshipPolicy applies each chain's cap overrides over the default caps
+func sponsorshipPolicy(c config.SponsorshipConfig) domain.SponsorshipPolicy {
+	policy := domain.SponsorshipPolicy{
+		Caps: domain.SponsorshipCaps{
+			CollectionDailyGwei: int64(c.CollectionDailyGwei),
+			UserDailyGwei:       int64(c.UserDailyGwei),
+		},
+		ChainCaps: make(map[domain.ChainID]domain.SponsorshipCaps),
+		GrantTTL:  time.Duration(c.GrantTTLSeconds) * time.Second,
+	}
+	for chainID, limit := range c.ChainCollectionDailyGwei {
+		caps := policy.CapsFor(chainID)
+		caps.CollectionDailyGwei = int64(limit)
+		policy.ChainCaps[chainID] = caps
+	}
+	for chainID, limit := range c.ChainUserDailyGwei {
+		caps := policy.CapsFor(chainID)
+		caps.UserDailyGwei = int64(limit)
+		policy.ChainCaps[chainID] = caps
+	}
+	return policy
 }
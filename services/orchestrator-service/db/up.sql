@@ -3,6 +3,7 @@ CREATE TABLE IF NOT EXISTS tx_intents (
   kind             TEXT NOT NULL,                 -- 'collection' | 'mint' | ...
   chain_id         caip2_chain NOT NULL,
   preview_address  evm_address,
+  signer           evm_address,                   -- wallet expected to sign the tx, when known
   tx_hash          evm_tx_hash,
   status           TEXT NOT NULL DEFAULT 'pending', -- pending|ready|failed|expired
   created_by       UUID,                          -- user_id (optional FK tới users.users nếu có)
@@ -10,12 +11,21 @@ CREATE TABLE IF NOT EXISTS tx_intents (
   error            TEXT,
   auth_session_id  VARCHAR(255),                  -- optional session correlation
   deadline_at      TIMESTAMPTZ,
+  status_seq       BIGINT NOT NULL DEFAULT 0,     -- bumped by every status/tx change; orders cached statuses
   created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
   updated_at       TIMESTAMPTZ NOT NULL DEFAULT now()
 );
 CREATE INDEX IF NOT EXISTS ix_tx_intents_chain ON tx_intents(chain_id);
 CREATE INDEX IF NOT EXISTS ix_tx_intents_kind ON tx_intents(kind);
 CREATE INDEX IF NOT EXISTS ix_tx_intents_txhash ON tx_intents(tx_hash);
+CREATE INDEX IF NOT EXISTS ix_tx_intents_signer_created ON tx_intents(signer, created_at DESC, intent_id DESC);
+-- Sent txs the stalled intent detector watches
+CREATE INDEX IF NOT EXISTS ix_tx_intents_awaiting ON tx_intents(updated_at, intent_id)
+WHERE status = 'pending' AND tx_hash IS NOT NULL;
+-- Open intents counted against their owner's intent limits
+CREATE INDEX IF NOT EXISTS ix_tx_intents_open_owner
+ON tx_intents(COALESCE(created_by::text, LOWER(signer)), updated_at)
+WHERE status = 'pending';
 -- Session correlation index (partial)
 CREATE INDEX IF NOT EXISTS idx_tx_intents_session_correlation
 ON tx_intents(auth_session_id, status, created_at)
@@ -31,3 +41,93 @@ CREATE TABLE IF NOT EXISTS session_intent_audit (
   audit_data JSONB NOT NULL,
   created_at TIMESTAMPTZ NOT NULL DEFAULT now()
 );
+
+-- Media assets a collection intent holds a ref on; released once the intent fails or expires
+CREATE TABLE IF NOT EXISTS intent_media_refs (
+  intent_id   UUID NOT NULL REFERENCES tx_intents(intent_id),
+  asset_id    TEXT NOT NULL,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  released_at TIMESTAMPTZ,
+  PRIMARY KEY (intent_id, asset_id)
+);
+CREATE INDEX IF NOT EXISTS ix_intent_media_refs_held ON intent_media_refs(intent_id)
+WHERE released_at IS NULL;
+-- Unsent intents the expiry sweep watches
+CREATE INDEX IF NOT EXISTS ix_tx_intents_unsent ON tx_intents(created_at)
+WHERE status = 'pending' AND tx_hash IS NULL;
+
+-- Intents carried out together in order, such as the batches of an airdrop or the
+-- transfers of a bulk transfer
+CREATE TABLE IF NOT EXISTS intent_bundles (
+  bundle_id        UUID PRIMARY KEY,
+  kind             TEXT NOT NULL,
+  chain_id         caip2_chain NOT NULL,
+  contract         evm_address NOT NULL,
+  created_by       UUID NOT NULL,
+  recipient_count  INTEGER NOT NULL,
+  batch_count      INTEGER NOT NULL,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- Bulk transfers may span collections; their bundles have no single contract
+ALTER TABLE intent_bundles ALTER COLUMN contract DROP NOT NULL;
+CREATE TABLE IF NOT EXISTS intent_bundle_items (
+  bundle_id  UUID NOT NULL REFERENCES intent_bundles(bundle_id),
+  seq        INTEGER NOT NULL,
+  intent_id  UUID NOT NULL UNIQUE REFERENCES tx_intents(intent_id),
+  PRIMARY KEY (bundle_id, seq)
+);
+
+-- Contracts admins allowed intents to call though neither the chain registry nor the
+-- catalog knows them
+CREATE TABLE IF NOT EXISTS call_target_overrides (
+  chain_id    caip2_chain NOT NULL,
+  address     evm_address NOT NULL,
+  reason      TEXT NOT NULL,
+  granted_by  TEXT NOT NULL,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (chain_id, address)
+);
+
+-- Last sequence stamped per intent event aggregate (intent:<intent_id>)
+CREATE TABLE IF NOT EXISTS event_sequences (
+  aggregate_id   TEXT PRIMARY KEY,
+  last_sequence  BIGINT NOT NULL,
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- The sequence each published event was stamped with, reused when it is published again
+CREATE TABLE IF NOT EXISTS event_sequence_stamps (
+  event_id      TEXT PRIMARY KEY,
+  aggregate_id  TEXT NOT NULL,
+  sequence      BIGINT NOT NULL,
+  stamped_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (aggregate_id, sequence)
+);
+
+-- Gas each chain has left to sponsor mints with, in gwei; admins top it up or freeze it
+CREATE TABLE IF NOT EXISTS sponsorship_budgets (
+  chain_id        caip2_chain PRIMARY KEY,
+  balance_gwei    BIGINT NOT NULL DEFAULT 0,
+  topped_up_gwei  BIGINT NOT NULL DEFAULT 0,
+  spent_gwei      BIGINT NOT NULL DEFAULT 0,
+  frozen          BOOLEAN NOT NULL DEFAULT false,
+  frozen_reason   TEXT NOT NULL DEFAULT '',
+  updated_by      TEXT NOT NULL DEFAULT '',
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- Every top-up of a sponsorship budget and every grant settled against one. A grant is
+-- settled at most once.
+CREATE TABLE IF NOT EXISTS sponsorship_ledger (
+  id           BIGSERIAL PRIMARY KEY,
+  chain_id     caip2_chain NOT NULL,
+  entry        TEXT NOT NULL CHECK (entry IN ('top_up', 'settlement')),
+  amount_gwei  BIGINT NOT NULL,
+  grant_id     TEXT UNIQUE,
+  intent_id    TEXT,
+  collection   TEXT,
+  user_id      TEXT,
+  tx_hash      TEXT,
+  actor_id     TEXT,
+  note         TEXT,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_sponsorship_ledger_chain ON sponsorship_ledger (chain_id, created_at DESC);
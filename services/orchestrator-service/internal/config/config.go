@@ -2,10 +2,15 @@ package config
 
 import (
 	"log"
+	"strconv"
+	"strings"
 
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/quangdang46/NFT-Marketplace/shared/env"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 // Config contains configuration for Orchestrator Service
@@ -13,8 +18,24 @@ type Config struct {
 	GRPCPort             string
 	Postgres             postgres.PostgresConfig
 	Redis                redis.RedisConfig
+	RabbitMQ             messaging.RabbitMQConfig
 	ChainRegistryGRPCURL string
+	WalletGRPCURL        string
+	UserGRPCURL          string
+	MediaGRPCURL         string
+	AuthGRPCURL          string
 	Features             Features
+	StalledIntents       StalledIntentConfig
+	IntentExpiry         IntentExpiryConfig
+	Airdrops             AirdropConfig
+	IntentLimits         IntentLimitConfig
+	StatusTransport      StatusTransportConfig
+	Sponsorship          SponsorshipConfig
+	// WebhookSigningSecret signs collection intent callbacks; callbacks are refused when
+	// empty. The subscription-worker must share it.
+	WebhookSigningSecret string
+	// SessionValidationCacheTTLMs is how long auth-service's answer about a session is reused
+	SessionValidationCacheTTLMs int
 }
 
 // LoadConfig loads configuration from environment variables
@@ -25,17 +46,37 @@ func LoadConfig() *Config {
 		GRPCPort:             env.GetString("ORCHESTRATOR_GRPC_PORT", ":50054"),
 		Postgres:             loadPostgresConfig(),
 		Redis:                loadRedisConfig(),
+		RabbitMQ:             loadRabbitMQConfig(),
 		ChainRegistryGRPCURL: env.GetString("CHAIN_REGISTRY_URL", "localhost:50056"),
+		WalletGRPCURL:        env.GetString("WALLET_SERVICE_URL", "localhost:50053"),
+		UserGRPCURL:          env.GetString("USER_SERVICE_URL", "localhost:50052"),
+		MediaGRPCURL:         env.GetString("MEDIA_SERVICE_URL", "localhost:50055"),
+		AuthGRPCURL:          env.GetString("AUTH_SERVICE_URL", "localhost:50051"),
 		Features:             loadFeatures(),
+		StalledIntents:       loadStalledIntentConfig(),
+		IntentExpiry:         loadIntentExpiryConfig(),
+		Airdrops:             loadAirdropConfig(),
+		IntentLimits:         loadIntentLimitConfig(),
+		StatusTransport:      loadStatusTransportConfig(),
+		Sponsorship:          loadSponsorshipConfig(),
+		WebhookSigningSecret: env.GetString("WEBHOOK_SIGNING_SECRET", ""),
+		// Sessions checked for session-linked intents
+		SessionValidationCacheTTLMs: env.GetInt("SESSION_VALIDATION_CACHE_TTL_MS", 30000),
 	}
 
-	log.Printf("Orchestrator config loaded - grpc=%s chain-registry=%s", c.GRPCPort, c.ChainRegistryGRPCURL)
+	log.Printf("Orchestrator config loaded - grpc=%s chain-registry=%s wallet=%s user=%s media=%s", c.GRPCPort, c.ChainRegistryGRPCURL, c.WalletGRPCURL, c.UserGRPCURL, c.MediaGRPCURL)
 	return c
 }
 
+// Runtime config sections the orchestrator applies without a restart
+const (
+	FeaturesSection     = "features"
+	IntentLimitsSection = "intent_limits"
+)
+
 type Features struct {
-	SessionLinkedIntents       bool
-	SessionValidationTimeoutMs int
+	SessionLinkedIntents       bool `json:"session_linked_intents"`
+	SessionValidationTimeoutMs int  `json:"session_validation_timeout_ms"`
 }
 
 func loadFeatures() Features {
@@ -45,6 +86,128 @@ func loadFeatures() Features {
 	}
 }
 
+// StalledIntentConfig drives the detector for sent txs that never confirm
+type StalledIntentConfig struct {
+	Enabled         bool
+	IntervalSeconds int
+	TimeoutSeconds  int
+	// ChainTimeoutSeconds overrides TimeoutSeconds per CAIP-2 chain id
+	ChainTimeoutSeconds map[string]int
+	FailAfterSeconds    int
+}
+
+func loadStalledIntentConfig() StalledIntentConfig {
+	return StalledIntentConfig{
+		Enabled:             env.GetBool("STALLED_INTENTS_ENABLED", true),
+		IntervalSeconds:     env.GetInt("STALLED_INTENTS_INTERVAL_SECONDS", 60),
+		TimeoutSeconds:      env.GetInt("STALLED_INTENTS_TIMEOUT_SECONDS", 600),
+		ChainTimeoutSeconds: parseChainValues("stalled intent timeout", env.GetStringList("STALLED_INTENTS_CHAIN_TIMEOUTS", nil)),
+		FailAfterSeconds:    env.GetInt("STALLED_INTENTS_FAIL_AFTER_SECONDS", 3600),
+	}
+}
+
+// IntentExpiryConfig drives the sweep that expires unsent intents and releases their media
+type IntentExpiryConfig struct {
+	Enabled         bool
+	IntervalSeconds int
+}
+
+func loadIntentExpiryConfig() IntentExpiryConfig {
+	return IntentExpiryConfig{
+		Enabled:         env.GetBool("INTENT_EXPIRY_ENABLED", true),
+		IntervalSeconds: env.GetInt("INTENT_EXPIRY_INTERVAL_SECONDS", 300),
+	}
+}
+
+// AirdropConfig caps the gas of one airdrop batch transaction
+type AirdropConfig struct {
+	BatchGasLimit int
+	// ChainGasLimits overrides BatchGasLimit per CAIP-2 chain id
+	ChainGasLimits     map[string]int
+	MaxBatchRecipients int
+}
+
+func loadAirdropConfig() AirdropConfig {
+	return AirdropConfig{
+		BatchGasLimit:      env.GetInt("AIRDROP_BATCH_GAS_LIMIT", 15000000),
+		ChainGasLimits:     parseChainValues("airdrop gas limit", env.GetStringList("AIRDROP_CHAIN_GAS_LIMITS", nil)),
+		MaxBatchRecipients: env.GetInt("AIRDROP_MAX_BATCH_RECIPIENTS", 500),
+	}
+}
+
+// IntentLimitConfig caps the intents one user may have open; zero disables a limit
+type IntentLimitConfig struct {
+	MaxOpen         int `json:"max_open"`
+	MaxOpenPerKind  int `json:"max_open_per_kind"`
+	MaxOpenPerChain int `json:"max_open_per_chain"`
+}
+
+func loadIntentLimitConfig() IntentLimitConfig {
+	return IntentLimitConfig{
+		MaxOpen:         env.GetInt("INTENT_LIMIT_MAX_OPEN", 50),
+		MaxOpenPerKind:  env.GetInt("INTENT_LIMIT_MAX_OPEN_PER_KIND", 20),
+		MaxOpenPerChain: env.GetInt("INTENT_LIMIT_MAX_OPEN_PER_CHAIN", 30),
+	}
+}
+
+// StatusTransportConfig selects how intent status writes reach the subscription-worker;
+// it must match the worker's
+type StatusTransportConfig struct {
+	// Transport is contracts.IntentStatusTransportPubSub or IntentStatusTransportStream
+	Transport    string
+	StreamMaxLen int
+}
+
+func loadStatusTransportConfig() StatusTransportConfig {
+	return StatusTransportConfig{
+		Transport:    env.GetString("INTENT_STATUS_TRANSPORT", contracts.IntentStatusTransportPubSub),
+		StreamMaxLen: env.GetInt("INTENT_STATUS_STREAM_MAXLEN", 100000),
+	}
+}
+
+// SponsorshipConfig caps the mint gas sponsored per collection and per user each UTC day,
+// in gwei; zero disables a cap. Budgets start empty, so nothing is sponsored before an admin
+// tops one up.
+type SponsorshipConfig struct {
+	Enabled             bool
+	CollectionDailyGwei int
+	UserDailyGwei       int
+	// ChainCollectionDailyGwei and ChainUserDailyGwei override the caps per CAIP-2 chain id
+	ChainCollectionDailyGwei map[string]int
+	ChainUserDailyGwei       map[string]int
+	GrantTTLSeconds          int
+}
+
+func loadSponsorshipConfig() SponsorshipConfig {
+	return SponsorshipConfig{
+		Enabled:                  env.GetBool("SPONSORSHIP_ENABLED", true),
+		CollectionDailyGwei:      env.GetInt("SPONSORSHIP_COLLECTION_DAILY_GWEI", 50000000),
+		UserDailyGwei:            env.GetInt("SPONSORSHIP_USER_DAILY_GWEI", 10000000),
+		ChainCollectionDailyGwei: parseChainValues("collection sponsorship cap", env.GetStringList("SPONSORSHIP_CHAIN_COLLECTION_DAILY_GWEI", nil)),
+		ChainUserDailyGwei:       parseChainValues("user sponsorship cap", env.GetStringList("SPONSORSHIP_CHAIN_USER_DAILY_GWEI", nil)),
+		GrantTTLSeconds:          env.GetInt("SPONSORSHIP_GRANT_TTL_SECONDS", 900),
+	}
+}
+
+// parseChainValues reads "eip155:1=900" entries of a per-chain setting, skipping malformed ones
+func parseChainValues(setting string, entries []string) map[string]int {
+	values := make(map[string]int)
+	for _, entry := range entries {
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 {
+			log.Printf("Ignoring %s %q: want chain=value", setting, entry)
+			continue
+		}
+		value, err := strconv.Atoi(entry[i+1:])
+		if err != nil || value <= 0 {
+			log.Printf("Ignoring %s %q: want chain=value", setting, entry)
+			continue
+		}
+		values[strings.TrimSpace(entry[:i])] = value
+	}
+	return values
+}
+
 // Validate validates the configuration
 func (c *Config) Validate() error {
 	if c.GRPCPort == "" {
@@ -59,6 +222,17 @@ func (c *Config) Validate() error {
 	if c.ChainRegistryGRPCURL == "" {
 		log.Fatal("CHAIN_REGISTRY_URL is required")
 	}
+	if c.WalletGRPCURL == "" {
+		log.Fatal("WALLET_SERVICE_URL is required")
+	}
+	if c.UserGRPCURL == "" {
+		log.Fatal("USER_SERVICE_URL is required")
+	}
+	switch c.StatusTransport.Transport {
+	case contracts.IntentStatusTransportPubSub, contracts.IntentStatusTransportStream:
+	default:
+		log.Fatalf("INTENT_STATUS_TRANSPORT must be %q or %q", contracts.IntentStatusTransportPubSub, contracts.IntentStatusTransportStream)
+	}
 	log.Println("Orchestrator Service configuration validation passed")
 	return nil
 }
@@ -70,6 +244,7 @@ func loadPostgresConfig() postgres.PostgresConfig {
 		PostgresUser:     env.GetString("POSTGRES_USER", "postgres"),
 		PostgresPassword: env.GetString("POSTGRES_PASSWORD", "postgres"),
 		PostgresDatabase: env.GetString("POSTGRES_DATABASE", "nft_marketplace"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
 
@@ -77,5 +252,16 @@ func loadRedisConfig() redis.RedisConfig {
 	return redis.RedisConfig{
 		RedisHost: env.GetString("REDIS_HOST", "localhost"),
 		RedisPort: env.GetInt("REDIS_PORT", 6379),
+		Tenant:    tenant.FromEnv(),
+	}
+}
+
+func loadRabbitMQConfig() messaging.RabbitMQConfig {
+	return messaging.RabbitMQConfig{
+		RabbitMQHost:     env.GetString("RABBITMQ_HOST", "localhost"),
+		RabbitMQPort:     env.GetInt("RABBITMQ_PORT", 5672),
+		RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
+		RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
@@ -17,6 +17,29 @@ type IntentKind string
 const (
 	IntentKindCollection IntentKind = "collection"
 	IntentKindMint       IntentKind = "mint"
+
+	// Post-deploy collection management
+	IntentKindUpdateRoyalty     IntentKind = "update_royalty"
+	IntentKindTransferOwnership IntentKind = "transfer_ownership"
+	IntentKindSetBaseURI        IntentKind = "set_base_uri"
+	IntentKindSetPayoutSplits   IntentKind = "set_payout_splits"
+
+	// Auctions on the AuctionHouse contract
+	IntentKindCreateAuction IntentKind = "create_auction"
+	IntentKindBid           IntentKind = "bid"
+	IntentKindSettleAuction IntentKind = "settle_auction"
+
+	// One batch of an airdrop bundle
+	IntentKindAirdrop IntentKind = "airdrop"
+	// One transaction of a bulk transfer bundle
+	IntentKindBulkTransfer IntentKind = "bulk_transfer"
+)
+
+type AuctionType string
+
+const (
+	AuctionEnglish AuctionType = "english"
+	AuctionDutch   AuctionType = "dutch"
 )
 
 type IntentStatus string
@@ -26,6 +49,18 @@ const (
 	IntentReady   IntentStatus = "ready"
 	IntentFailed  IntentStatus = "failed"
 	IntentExpired IntentStatus = "expired"
+	// IntentStalled is a sent tx left unconfirmed past its chain's stall timeout
+	IntentStalled IntentStatus = "stalled"
+)
+
+// IntentRecovery is what the FE should offer the signer of a stalled intent
+type IntentRecovery string
+
+const (
+	// RecoverySpeedUp resends the same nonce with more gas; the tx is still in the mempool
+	RecoverySpeedUp IntentRecovery = "speed_up"
+	// RecoveryResubmit signs the tx again; no node knows the original anymore
+	RecoveryResubmit IntentRecovery = "resubmit"
 )
 
 type Standard string
@@ -44,6 +79,7 @@ type Intent struct {
 	ChainID         ChainID      `json:"chainId"`
 	PreviewAddress  *Address     `json:"previewAddress,omitempty"`
 	ContractAddress *Address     `json:"contractAddress,omitempty"` // helpful for mint/intents
+	Signer          *Address     `json:"signer,omitempty"`          // wallet expected to sign, when known
 	TxHash          *string      `json:"txHash,omitempty"`
 	Status          IntentStatus `json:"status"`
 	CreatedBy       *string      `json:"createdBy,omitempty"`      // user id (uuid)
@@ -53,6 +89,8 @@ type Intent struct {
 	DeadlineAt      *time.Time   `json:"deadlineAt,omitempty"`
 	CreatedAt       time.Time    `json:"createdAt"`
 	UpdatedAt       time.Time    `json:"updatedAt"`
+	// StatusSeq is bumped by every stored status or tx change
+	StatusSeq int64 `json:"-"`
 }
 
 type TxRequest struct {
@@ -70,6 +108,18 @@ type IntentStatusPayload struct {
 	ChainID         *ChainID     `json:"chainId,omitempty"`
 	TxHash          *string      `json:"txHash,omitempty"`
 	ContractAddress *Address     `json:"contractAddress,omitempty"`
+	// Error explains a failed intent; Recovery is set while it is stalled
+	Error    *string         `json:"error,omitempty"`
+	Recovery *IntentRecovery `json:"recovery,omitempty"`
+	// Version is the cached status version it was read at, for ReplaceIntentStatus
+	Version int64 `json:"-"`
+	// Seq is the Intent.StatusSeq the status was cached at; zero when written without one
+	Seq int64 `json:"-"`
+	// CallbackURL is handed to the subscription-worker, which calls it on confirmation
+	CallbackURL *string `json:"-"`
+	// UpdatedAt is when the cached status was last written; zero for statuses not read
+	// from the cache
+	UpdatedAt time.Time `json:"-"`
 }
 
 type PrepareCreateCollectionInput struct {
@@ -88,15 +138,50 @@ type PrepareCreateCollectionInput struct {
 	AllowlistMintPrice     *uint64  `json:"allowlistMintPrice,omitempty"`
 	PublicMintPrice        *uint64  `json:"publicMintPrice,omitempty"`
 	AllowlistStageDuration *uint64  `json:"allowlistStageDuration,omitempty"`
+	// AssetIDs are pinned media-service assets the collection uses (logo, banner, ...)
+	AssetIDs []string `json:"assetIds,omitempty"`
+	// CallbackURL is the creator's https webhook, called once the collection confirms
+	CallbackURL *string `json:"callbackUrl,omitempty"`
+	// PayoutSplits share the collection's royalties among several recipients; only
+	// factories deploying a splitter accept them
+	PayoutSplits []PayoutSplit `json:"payoutSplits,omitempty"`
 
 	CreatedBy  *string    `json:"createdBy,omitempty"`
 	DeadlineAt *time.Time `json:"deadlineAt,omitempty"`
 	ReqMeta    any        `json:"reqMeta,omitempty"` // kept in req_payload_json
 }
 
+// CollectionConstraints bounds PrepareCreateCollection parameters on a chain, from the
+// chain registry's collection limits
+type CollectionConstraints struct {
+	MaxRoyaltyBps       uint64 `json:"maxRoyaltyBps"`
+	MinStageDurationSec uint64 `json:"minStageDurationSec"`
+	MaxSupplyCap        uint64 `json:"maxSupplyCap"`
+}
+
+// DefaultCollectionConstraints covers limits the chain registry leaves unset
+var DefaultCollectionConstraints = CollectionConstraints{
+	MaxRoyaltyBps:       10000,
+	MinStageDurationSec: 3600,
+	MaxSupplyCap:        1000000,
+}
+
+// CollectionDefaults is what the create collection form starts from on a chain
+type CollectionDefaults struct {
+	ChainID                ChainID               `json:"chainId"`
+	Constraints            CollectionConstraints `json:"constraints"`
+	RoyaltyFee             uint64                `json:"royaltyFee"`
+	MaxSupply              uint64                `json:"maxSupply"`
+	MintLimitPerWallet     uint64                `json:"mintLimitPerWallet"`
+	AllowlistStageDuration uint64                `json:"allowlistStageDuration"`
+	SupportedTypes         []Standard            `json:"supportedTypes"`
+}
+
 type PrepareCreateCollectionResult struct {
 	IntentID string    `json:"intentId"`
 	Tx       TxRequest `json:"txRequest"`
+	// CallbackSecret signs the webhooks sent to CallbackURL; empty without one
+	CallbackSecret string `json:"-"`
 }
 
 // Mint
@@ -106,8 +191,9 @@ type PrepareMintInput struct {
 	Contract  Address  `json:"contract"`
 	Standard  Standard `json:"standard"` // ERC721 | ERC1155
 	Minter    Address  `json:"minter"`
-	Quantity  uint64   `json:"quantity"` // ERC721: 1
-	TokenID   *uint64  `json:"tokenId,omitempty"`
+	Quantity  uint64   `json:"quantity"`          // tokens to mint, or the ERC-1155 amount
+	TokenID   string   `json:"tokenId,omitempty"` // uint256 decimal, for mint functions taking a token id
+	Proof     []string `json:"proof,omitempty"`   // bytes32 hex allowlist proof
 	CreatedBy *string  `json:"createdBy,omitempty"`
 	ReqMeta   any      `json:"reqMeta,omitempty"`
 }
@@ -117,6 +203,390 @@ type PrepareMintResult struct {
 	Tx       TxRequest `json:"txRequest"`
 }
 
+// Collection management (creator-only)
+
+type PrepareUpdateRoyaltyInput struct {
+	ChainID  ChainID `json:"chainId"`
+	Contract Address `json:"contract"`
+	UserID   string  `json:"userId"`
+	Receiver Address `json:"receiver"`
+	FeeBps   uint64  `json:"feeBps"` // basis points, 10000 = 100%
+}
+
+// PayoutSplit is one recipient's share of a collection's payouts
+type PayoutSplit struct {
+	Recipient Address `json:"recipient"`
+	Bps       uint64  `json:"bps"` // basis points; a collection's splits add up to 10000
+}
+
+type PrepareSetPayoutSplitsInput struct {
+	ChainID  ChainID       `json:"chainId"`
+	Contract Address       `json:"contract"`
+	UserID   string        `json:"userId"`
+	Splits   []PayoutSplit `json:"splits"`
+}
+
+type PrepareTransferCollectionOwnershipInput struct {
+	ChainID  ChainID `json:"chainId"`
+	Contract Address `json:"contract"`
+	UserID   string  `json:"userId"`
+	NewOwner Address `json:"newOwner"`
+}
+
+type PrepareSetBaseURIInput struct {
+	ChainID  ChainID `json:"chainId"`
+	Contract Address `json:"contract"`
+	UserID   string  `json:"userId"`
+	BaseURI  string  `json:"baseUri"`
+}
+
+type PrepareCollectionAdminResult struct {
+	IntentID string    `json:"intentId"`
+	Tx       TxRequest `json:"txRequest"`
+}
+
+// Auctions
+
+type PrepareCreateAuctionInput struct {
+	ChainID      ChainID     `json:"chainId"`
+	Collection   Address     `json:"collection"`
+	TokenID      string      `json:"tokenId"`
+	Seller       Address     `json:"seller"`
+	AuctionType  AuctionType `json:"auctionType"`
+	StartPrice   string      `json:"startPrice"`             // wei
+	ReservePrice string      `json:"reservePrice,omitempty"` // english only
+	EndPrice     string      `json:"endPrice,omitempty"`     // dutch only
+	StartTime    uint64      `json:"startTime,omitempty"`    // unix seconds, 0 = now
+	Duration     uint64      `json:"duration"`               // seconds
+}
+
+type PrepareBidInput struct {
+	ChainID   ChainID `json:"chainId"`
+	AuctionID string  `json:"auctionId"`
+	Bidder    Address `json:"bidder"`
+	Amount    string  `json:"amount"` // wei, sent as msg.value
+}
+
+type PrepareSettleAuctionInput struct {
+	ChainID   ChainID `json:"chainId"`
+	AuctionID string  `json:"auctionId"`
+	Caller    Address `json:"caller"`
+}
+
+type PrepareAuctionResult struct {
+	IntentID string    `json:"intentId"`
+	Tx       TxRequest `json:"txRequest"`
+}
+
+// Airdrops
+
+// AirdropRecipient gets Amount tokens: newly minted ERC-721 tokens, or copies of the
+// airdropped ERC-1155 token
+type AirdropRecipient struct {
+	Address Address `json:"address"`
+	Amount  uint64  `json:"amount"`
+}
+
+// PrepareAirdropInput airdrops to the holders of a catalog holder snapshot the user took,
+// or to an explicit recipient list; exactly one of SnapshotID and Recipients is set
+type PrepareAirdropInput struct {
+	ChainID         ChainID            `json:"chainId"`
+	Contract        Address            `json:"contract"`
+	Standard        Standard           `json:"standard"`          // ERC721 | ERC1155
+	TokenID         string             `json:"tokenId,omitempty"` // ERC-1155 token, uint256 decimal
+	UserID          string             `json:"userId"`
+	SnapshotID      string             `json:"snapshotId,omitempty"`
+	Recipients      []AirdropRecipient `json:"recipients,omitempty"`
+	AmountPerHolder uint64             `json:"amountPerHolder,omitempty"` // per snapshot holder, default 1
+}
+
+// AirdropBatch is one transaction of an airdrop, tracked as its own intent
+type AirdropBatch struct {
+	IntentID   string             `json:"intentId"`
+	Seq        int                `json:"seq"`
+	Recipients []AirdropRecipient `json:"recipients"`
+	GasLimit   uint64             `json:"gasLimit"` // conservative estimate, within the chain's batch cap
+	Tx         TxRequest          `json:"txRequest"`
+}
+
+// AirdropBundle lists an airdrop's batches in the order they should be sent
+type AirdropBundle struct {
+	BundleID       string         `json:"bundleId"`
+	ChainID        ChainID        `json:"chainId"`
+	Contract       Address        `json:"contract"`
+	RecipientCount int            `json:"recipientCount"`
+	Batches        []AirdropBatch `json:"batches"`
+}
+
+// BundleProgress summarizes the batches of a bundle. Status is failed once any batch
+// failed or expired, ready once all are, stalled while a sent batch is, and pending otherwise.
+type BundleProgress struct {
+	Bundle    *IntentBundle          `json:"bundle"`
+	Status    IntentStatus           `json:"status"`
+	Confirmed int                    `json:"confirmed"`
+	Failed    int                    `json:"failed"`
+	Batches   []*IntentStatusPayload `json:"batches"` // in batch order
+}
+
+// IntentBundle groups intents that carry out one operation together, in order. Contract is
+// empty for bundles spanning several collections.
+type IntentBundle struct {
+	ID             string     `json:"id"`
+	Kind           IntentKind `json:"kind"`
+	ChainID        ChainID    `json:"chainId"`
+	Contract       Address    `json:"contract"`
+	CreatedBy      string     `json:"createdBy"`
+	RecipientCount int        `json:"recipientCount"`
+	BatchCount     int        `json:"batchCount"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// IntentBundleRepo stores intent bundles
+type IntentBundleRepo interface {
+	// CreateBundle stores the bundle and its intents, in batch order, in one transaction
+	CreateBundle(ctx context.Context, bundle *IntentBundle, intents []*Intent) error
+	GetBundle(ctx context.Context, bundleID string) (*IntentBundle, error)
+	// ListBundleIntents returns the bundle's intents in batch order
+	ListBundleIntents(ctx context.Context, bundleID string) ([]*Intent, error)
+}
+
+// HolderSnapshotReader reads holder snapshots taken by catalog-service
+type HolderSnapshotReader interface {
+	// ListSnapshotHolders returns the holders of a snapshot requested by userID, largest
+	// first, and ErrSnapshotNotFound for anyone else's
+	ListSnapshotHolders(ctx context.Context, snapshotID, userID string) ([]Address, error)
+}
+
+// Bulk transfers
+
+// BulkTransferItem sends Amount copies of a token to To; ERC-721 tokens have one
+type BulkTransferItem struct {
+	Contract Address `json:"contract"`
+	TokenID  string  `json:"tokenId"` // uint256 decimal
+	To       Address `json:"to"`
+	Amount   uint64  `json:"amount,omitempty"` // default 1
+}
+
+// PrepareBulkTransferInput sends tokens From holds. Operator, when set, sends the transfers
+// on From's behalf once From approved it; otherwise From sends them. Both must be wallets
+// linked to the user.
+type PrepareBulkTransferInput struct {
+	ChainID  ChainID            `json:"chainId"`
+	UserID   string             `json:"userId"`
+	From     Address            `json:"from"`
+	Operator Address            `json:"operator,omitempty"`
+	Items    []BulkTransferItem `json:"items"`
+}
+
+// BulkTransferToken is one token of a transfer batch
+type BulkTransferToken struct {
+	TokenID string `json:"tokenId"`
+	Amount  uint64 `json:"amount"`
+}
+
+// BulkTransferApproval lets the operator move From's tokens of one collection. Check is a
+// read-only call returning whether it already may; when it returns false, From sends Tx.
+type BulkTransferApproval struct {
+	Contract Address   `json:"contract"`
+	Check    TxRequest `json:"check"`
+	Tx       TxRequest `json:"txRequest"`
+}
+
+// BulkTransferBatch is one transaction of a bulk transfer, tracked as its own intent: one
+// ERC-721 token, or the ERC-1155 tokens of one collection going to one wallet
+type BulkTransferBatch struct {
+	IntentID string              `json:"intentId"`
+	Seq      int                 `json:"seq"`
+	Contract Address             `json:"contract"`
+	Standard Standard            `json:"standard"`
+	To       Address             `json:"to"`
+	Tokens   []BulkTransferToken `json:"tokens"`
+	Tx       TxRequest           `json:"txRequest"`
+}
+
+// BulkTransferBundle lists the approvals to check first and the batches Signer sends, in
+// the order they should be sent
+type BulkTransferBundle struct {
+	BundleID   string                 `json:"bundleId"`
+	ChainID    ChainID                `json:"chainId"`
+	From       Address                `json:"from"`
+	Signer     Address                `json:"signer"`
+	TokenCount int                    `json:"tokenCount"`
+	Approvals  []BulkTransferApproval `json:"approvals"`
+	Batches    []BulkTransferBatch    `json:"batches"`
+}
+
+// TokenHoldingReader reads the ownership index catalog-service folds indexed transfers into
+type TokenHoldingReader interface {
+	// GetCollectionStandard returns ErrCollectionNotFound for a contract the catalog does
+	// not list
+	GetCollectionStandard(ctx context.Context, chainID ChainID, contract Address) (Standard, error)
+	// TokenBalances returns how many of each token owner holds, by token id; tokens it holds
+	// none of are missing
+	TokenBalances(ctx context.Context, chainID ChainID, contract, owner Address, tokenIDs []string) (map[string]*big.Int, error)
+}
+
+// AirdropPolicy caps the gas of one airdrop batch. GasLimits holds per-chain overrides of
+// DefaultGasLimit; MaxRecipients bounds a batch's calldata however cheap its recipients are.
+type AirdropPolicy struct {
+	DefaultGasLimit uint64
+	GasLimits       map[ChainID]uint64
+	MaxRecipients   int
+}
+
+// GasLimit is the most gas one airdrop batch may use on chainID
+func (p AirdropPolicy) GasLimit(chainID ChainID) uint64 {
+	if l, ok := p.GasLimits[chainID]; ok && l > 0 {
+		return l
+	}
+	return p.DefaultGasLimit
+}
+
+// IntentLimits caps the intents one user may have open: in all, of one kind and on one
+// chain. An intent frees its slot once it confirms, fails or expires, and the batches of a
+// bundle share one slot. Zero disables a limit.
+type IntentLimits struct {
+	MaxOpen         int
+	MaxOpenPerKind  int
+	MaxOpenPerChain int
+}
+
+// Enabled reports whether any limit is set
+func (l IntentLimits) Enabled() bool {
+	return l.MaxOpen > 0 || l.MaxOpenPerKind > 0 || l.MaxOpenPerChain > 0
+}
+
+// CallTargetOverride lets intents call a contract that is neither in the chain registry nor
+// a catalog collection. Admins grant overrides, so the reason and granting admin are kept.
+type CallTargetOverride struct {
+	ChainID   ChainID
+	Address   Address
+	Reason    string
+	GrantedBy string
+	CreatedAt time.Time
+}
+
+// CallTargetOverrideRepo stores the call targets admins allowed
+type CallTargetOverrideRepo interface {
+	HasOverride(ctx context.Context, chainID ChainID, address Address) (bool, error)
+	// PutOverride grants an override, replacing the reason of an existing one
+	PutOverride(ctx context.Context, o *CallTargetOverride) error
+	// DeleteOverride reports whether an override was removed
+	DeleteOverride(ctx context.Context, chainID ChainID, address Address) (bool, error)
+	// ListOverrides lists the overrides of a chain, or of all chains when chainID is empty
+	ListOverrides(ctx context.Context, chainID ChainID) ([]*CallTargetOverride, error)
+}
+
+// SponsorshipCaps bound the mint gas one collection and one user may have sponsored on a
+// chain per UTC day, in gwei. Zero disables a cap.
+type SponsorshipCaps struct {
+	CollectionDailyGwei int64
+	UserDailyGwei       int64
+}
+
+// SponsorshipPolicy holds the default caps, per-chain overrides of them and how long a
+// grant holds budget before it must be settled
+type SponsorshipPolicy struct {
+	Caps      SponsorshipCaps
+	ChainCaps map[ChainID]SponsorshipCaps
+	GrantTTL  time.Duration
+}
+
+// CapsFor returns the caps of chainID
+func (p SponsorshipPolicy) CapsFor(chainID ChainID) SponsorshipCaps {
+	if c, ok := p.ChainCaps[chainID]; ok {
+		return c
+	}
+	return p.Caps
+}
+
+// SponsorshipBudget is the gas a chain has left to sponsor mints with, in gwei. Admins top
+// it up and settled grants draw it down; a frozen budget sponsors nothing.
+type SponsorshipBudget struct {
+	ChainID      ChainID
+	BalanceGwei  int64
+	ToppedUpGwei int64 // every top-up so far
+	SpentGwei    int64 // every settled grant so far
+	Frozen       bool
+	FrozenReason string
+	UpdatedBy    string
+	UpdatedAt    time.Time
+}
+
+// SponsorshipGrant holds gas of a chain budget for one relayed mint until it is settled,
+// released or expires
+type SponsorshipGrant struct {
+	ID         string    `json:"id"`
+	IntentID   string    `json:"intentId"`
+	ChainID    ChainID   `json:"chainId"`
+	Collection Address   `json:"collection"`
+	UserID     string    `json:"userId"`
+	GasGwei    int64     `json:"gasGwei"`
+	Day        string    `json:"day"` // UTC day whose caps it counts against, 2006-01-02
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// SponsorshipLimit names the limit that refused a grant
+type SponsorshipLimit string
+
+const (
+	SponsorshipWithinLimits  SponsorshipLimit = ""
+	SponsorshipCollectionCap SponsorshipLimit = "collection_daily_cap"
+	SponsorshipUserCap       SponsorshipLimit = "user_daily_cap"
+	SponsorshipChainBudget   SponsorshipLimit = "chain_budget"
+)
+
+// SponsorshipCounters track the gas sponsored each day and the grants holding chain budget
+type SponsorshipCounters interface {
+	// HoldGrant counts the grant against its day's caps and, with the other live grants of
+	// its chain, against balanceGwei. It holds nothing and names the limit when one would
+	// be passed. While the grant's intent holds a live grant already, it returns that one
+	// and holds nothing more.
+	HoldGrant(ctx context.Context, grant *SponsorshipGrant, caps SponsorshipCaps, balanceGwei int64) (*SponsorshipGrant, SponsorshipLimit, error)
+	// GetGrant returns ErrGrantNotFound once a grant expired or was released
+	GetGrant(ctx context.Context, id string) (*SponsorshipGrant, error)
+	// ReleaseGrant frees the budget a grant held, leaving spentGwei of it counted against
+	// the day's caps. Releasing a grant again does nothing.
+	ReleaseGrant(ctx context.Context, grant *SponsorshipGrant, spentGwei int64) error
+}
+
+// SponsorshipLedger keeps the chain budgets and every top-up and settlement of them
+type SponsorshipLedger interface {
+	// GetBudget returns an empty budget for a chain never topped up
+	GetBudget(ctx context.Context, chainID ChainID) (*SponsorshipBudget, error)
+	ListBudgets(ctx context.Context) ([]*SponsorshipBudget, error)
+	TopUpBudget(ctx context.Context, chainID ChainID, amountGwei int64, note, actorID string, at time.Time) (*SponsorshipBudget, error)
+	SetBudgetFrozen(ctx context.Context, chainID ChainID, frozen bool, reason, actorID string, at time.Time) (*SponsorshipBudget, error)
+	// SettleGrant charges the chain budget for the gas a grant spent. A grant settled
+	// before is not charged again and reports false.
+	SettleGrant(ctx context.Context, grant *SponsorshipGrant, spentGwei int64, txHash string, at time.Time) (bool, error)
+}
+
+// OpenIntent is a stored pending intent; BundleID is set for the batches of a bundle
+type OpenIntent struct {
+	Intent   *Intent
+	BundleID string
+}
+
+// ListIntentsInput pages a signer's intents newest first. Before and BeforeID are the
+// created time and id of the last intent already seen.
+type ListIntentsInput struct {
+	Signer   Address    `json:"signer"`
+	Before   *time.Time `json:"before,omitempty"`
+	BeforeID string     `json:"beforeId,omitempty"`
+	Limit    int        `json:"limit"`
+}
+
+// ListAwaitingInput pages pending intents that sent a tx, oldest update first, over those
+// last updated before Until. After and AfterID are the update time and id of the last
+// intent already seen.
+type ListAwaitingInput struct {
+	After   time.Time `json:"after"`
+	AfterID string    `json:"afterId"`
+	Until   time.Time `json:"until"`
+	Limit   int       `json:"limit"`
+}
+
 type TrackTxInput struct {
 	IntentID       string   `json:"intentId"`
 	ChainID        ChainID  `json:"chainId"`
@@ -129,33 +599,306 @@ type OrchestratorRepo interface {
 	Create(ctx context.Context, it *Intent) error
 	UpdateTxHash(ctx context.Context, intentID string, txHash string, contractAddr *Address) error
 	UpdateStatus(ctx context.Context, intentID string, status IntentStatus, errMsg *string) error
+	// TrackTx records the tx an intent sent and sets it back to pending in one write,
+	// returning the intent's new StatusSeq
+	TrackTx(ctx context.Context, intentID string, txHash string, contractAddr *Address) (int64, error)
 	GetByID(ctx context.Context, intentID string) (*Intent, error)
 
 	FindByChainTx(ctx context.Context, chainID ChainID, txHash string) (*Intent, error)
+	ListBySigner(ctx context.Context, in ListIntentsInput) ([]*Intent, error)
+	ListAwaitingConfirmation(ctx context.Context, in ListAwaitingInput) ([]*Intent, error)
+	// ListOpenIntents lists the owner's pending intents updated after since, newest first.
+	// The owner is the creating user's id or, for intents without one, the lowercase signer.
+	ListOpenIntents(ctx context.Context, owner string, since time.Time, limit int) ([]OpenIntent, error)
 	InsertSessionIntentAudit(ctx context.Context, sessionID string, intentID string, userID *string, auditData any) error
+
+	// ExpireUnsent marks pending intents that never sent a tx expired once their deadline,
+	// or DefaultIntentTTL when they have none, passed before now, and returns them
+	ExpireUnsent(ctx context.Context, now time.Time, limit int) ([]*Intent, error)
+
+	// AttachMedia records the assets an intent holds a media-service ref on
+	AttachMedia(ctx context.Context, intentID string, assetIDs []string) error
+	// ListReleasableMedia lists refs still held by failed or expired intents
+	ListReleasableMedia(ctx context.Context, limit int) ([]MediaRef, error)
+	MarkMediaReleased(ctx context.Context, ref MediaRef) error
+}
+
+// MediaRef is a media-service asset an intent holds a reference on
+type MediaRef struct {
+	IntentID string `json:"intentId"`
+	AssetID  string `json:"assetId"`
+}
+
+// Holder is the name the intent holds its media-service refs under
+func (r MediaRef) Holder() string { return "intent:" + r.IntentID }
+
+// MediaRefs holds media-service assets for the intents using them
+type MediaRefs interface {
+	// AddRef returns ErrAssetNotPinned until the asset is pinned and ErrAssetNotFound for
+	// unknown assets; adding a held ref is a no-op
+	AddRef(ctx context.Context, ref MediaRef) error
+	// ReleaseRef is a no-op for refs that are not held
+	ReleaseRef(ctx context.Context, ref MediaRef) error
+}
+
+// CollectionCreatorReader resolves the on-chain creator recorded by the catalog
+type CollectionCreatorReader interface {
+	GetCollectionCreator(ctx context.Context, chainID ChainID, contract Address) (Address, error)
+}
+
+// LinkedWalletReader lists the wallet addresses linked to a user
+type LinkedWalletReader interface {
+	ListLinkedAddresses(ctx context.Context, userID string) ([]Address, error)
+}
+
+// AddressChallenge is a wallet-service signature challenge for an address to sign
+type AddressChallenge struct {
+	ID        string
+	Message   string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// AddressProof is the address a signed challenge proved control of
+type AddressProof struct {
+	Address  Address
+	ChainID  ChainID
+	Resource string
+	Method   string // eoa or eip1271
+}
+
+// AddressProver challenges an address through wallet-service, which verifies EOA signatures
+// and, with EIP-1271, contract wallet ones. A challenge is purpose-scoped and single-use.
+type AddressProver interface {
+	CreateAddressChallenge(ctx context.Context, purpose string, chainID ChainID, address Address, userID, resource, statement string) (*AddressChallenge, error)
+	// VerifyAddressChallenge returns ErrChallengeExpired for an unknown, expired or used
+	// challenge and a ValidationError for a signature that proves nothing
+	VerifyAddressChallenge(ctx context.Context, challengeID, signature, purpose, userID string) (*AddressProof, error)
+}
+
+// SessionValidator checks auth sessions against auth-service
+type SessionValidator interface {
+	// ValidateSession reports whether sessionID is an active session of userID; an error
+	// means auth-service could not tell
+	ValidateSession(ctx context.Context, userID, sessionID string) (bool, error)
+}
+
+// CollectionOrgReader resolves the organization managing a collection, empty when none
+type CollectionOrgReader interface {
+	GetCollectionOwnerOrg(ctx context.Context, chainID ChainID, contract Address) (string, error)
+}
+
+// Organization roles allowed to manage org-owned collections
+const (
+	OrgRoleOwner = "owner"
+	OrgRoleAdmin = "admin"
+)
+
+// OrgMembershipReader returns a user's role in an organization, empty when not a member
+type OrgMembershipReader interface {
+	GetOrgRole(ctx context.Context, orgID, userID string) (string, error)
+}
+
+// TxTracked links a collection intent to its deployment tx so the catalog can record
+// which intent and user created the collection
+type TxTracked struct {
+	IntentID  string     `json:"intent_id"`
+	Kind      IntentKind `json:"kind"`
+	ChainID   ChainID    `json:"chain_id"`
+	TxHash    string     `json:"tx_hash"`
+	Contract  *Address   `json:"contract,omitempty"`
+	CreatedBy string     `json:"created_by"`
+	TrackedAt time.Time  `json:"tracked_at"`
+}
+
+// IntentEventPublisher announces intent lifecycle changes to other services
+type IntentEventPublisher interface {
+	PublishTxTracked(ctx context.Context, event TxTracked) error
+	PublishCollectionImported(ctx context.Context, event ImportedCollection) error
+}
+
+// EventSequencer stamps intent events with per-intent sequences
+type EventSequencer interface {
+	// NextSequence returns the sequence eventID was stamped with in aggregateID, stamping the
+	// aggregate's next one the first time the event is published
+	NextSequence(ctx context.Context, aggregateID, eventID string) (uint64, error)
 }
 
 type StatusCache interface {
 	SetIntentStatus(ctx context.Context, payload IntentStatusPayload, ttl time.Duration) error
+	// GetIntentStatus returns ErrNotFound when the intent has no cached status
+	GetIntentStatus(ctx context.Context, intentID string) (*IntentStatusPayload, error)
+	// ReplaceIntentStatus writes payload right away if the cached status is still at
+	// payload.Version, and returns ErrStatusChanged when another writer got there first
+	ReplaceIntentStatus(ctx context.Context, payload IntentStatusPayload, ttl time.Duration) error
+	// WriteIntentStatus writes payload before returning, along with any update still
+	// buffered for the intent. It returns ErrStatusChanged when payload.Seq is not newer
+	// than the cached one.
+	WriteIntentStatus(ctx context.Context, payload IntentStatusPayload, ttl time.Duration) error
+}
+
+// Collection import
+
+type PrepareImportCollectionInput struct {
+	ChainID  ChainID `json:"chainId"`
+	Contract Address `json:"contract"`
+	UserID   string  `json:"userId"`
+}
+
+// ImportChallenge is the wallet-service challenge the contract owner signs to prove control
+// of the collection
+type ImportChallenge struct {
+	ChallengeID string    `json:"challengeId"`
+	Message     string    `json:"message"`
+	IssuedAt    time.Time `json:"issuedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// ImportCollectionInput carries the signed challenge back
+type ImportCollectionInput struct {
+	ChainID     ChainID `json:"chainId"`
+	Contract    Address `json:"contract"`
+	UserID      string  `json:"userId"`
+	ChallengeID string  `json:"challengeId"`
+	Signature   string  `json:"signature"` // personal_sign, or EIP-1271 for contract owners
+}
+
+type ImportedCollection struct {
+	ChainID     ChainID   `json:"chain_id"`
+	Contract    Address   `json:"contract"`
+	Standard    Standard  `json:"standard"`
+	Owner       Address   `json:"owner"`
+	Name        string    `json:"name"`
+	Symbol      string    `json:"symbol"`
+	ContractURI string    `json:"contract_uri"`
+	StartBlock  uint64    `json:"start_block"`
+	ImportedBy  string    `json:"imported_by"`
+	ImportedAt  time.Time `json:"imported_at"`
+}
+
+// ContractInfo is what an existing collection contract reports about itself. Standard is
+// empty when the contract supports neither ERC-721 nor ERC-1155.
+type ContractInfo struct {
+	Standard    Standard
+	Owner       Address
+	Name        string
+	Symbol      string
+	ContractURI string
+}
+
+// CollectionInspector reads deployed collection contracts
+type CollectionInspector interface {
+	// InspectCollection returns ErrNotFound when there is no code at contract
+	InspectCollection(ctx context.Context, chainID ChainID, contract Address) (*ContractInfo, error)
+	// DeploymentBlock finds the block contract was deployed in
+	DeploymentBlock(ctx context.Context, chainID ChainID, contract Address) (uint64, error)
+}
+
+// TxState is what a chain knows about a sent transaction
+type TxState string
+
+const (
+	TxMined    TxState = "mined"
+	TxReverted TxState = "reverted"
+	TxQueued   TxState = "queued"  // in the mempool, not mined yet
+	TxUnknown  TxState = "unknown" // neither mined nor in the mempool: dropped or replaced
+)
+
+// TxLookup reads a transaction's receipt, and its mempool entry when it has none
+type TxLookup interface {
+	LookupTx(ctx context.Context, chainID ChainID, txHash string) (TxState, error)
+}
+
+// ChainLiveness reports whether a chain stopped producing blocks, as the chain registry
+// last heard from the indexer
+type ChainLiveness interface {
+	ChainHalted(ctx context.Context, chainID ChainID) (bool, error)
+}
+
+// StallPolicy decides when a sent tx counts as stalled. Timeouts holds per-chain overrides
+// of DefaultTimeout; a tx no node knows is failed once FailAfter has passed since it was
+// tracked, while one still in the mempool stays stalled until the intent expires.
+type StallPolicy struct {
+	DefaultTimeout time.Duration
+	Timeouts       map[ChainID]time.Duration
+	FailAfter      time.Duration
+}
+
+// Timeout is how long a tx on chainID may stay unconfirmed before it is stalled
+func (p StallPolicy) Timeout(chainID ChainID) time.Duration {
+	if t, ok := p.Timeouts[chainID]; ok && t > 0 {
+		return t
+	}
+	return p.DefaultTimeout
 }
 
 type Encoder interface {
 	EncodeCreateCollection(ctx context.Context, chainID ChainID, factory Address, p PrepareCreateCollectionInput) (to Address, data []byte, value string, preview *Address, err error)
 
 	EncodeMint(ctx context.Context, chainID ChainID, contract Address, standard Standard, p PrepareMintInput) (to Address, data []byte, value string, err error)
+
+	EncodeCollectionAdmin(ctx context.Context, chainID ChainID, contract Address, method string, args ...interface{}) (to Address, data []byte, value string, err error)
+
+	// EncodeAuction packs an AuctionHouse call; value is the wei attached to payable calls
+	EncodeAuction(ctx context.Context, chainID ChainID, auctionHouse Address, method string, value string, args ...interface{}) (to Address, data []byte, err error)
+
+	// EncodeAirdrop packs the collection's owner-only airdrop; tokenID is nil for ERC-721
+	EncodeAirdrop(ctx context.Context, chainID ChainID, contract Address, standard Standard, tokenID *big.Int, recipients []AirdropRecipient) (to Address, data []byte, err error)
+
+	// EncodeTokenTransfer packs a standard transfer from one wallet to another: the ERC-721
+	// safeTransferFrom of one token, or an ERC-1155 safeBatchTransferFrom
+	EncodeTokenTransfer(ctx context.Context, chainID ChainID, contract Address, standard Standard, from, to Address, tokens []BulkTransferToken) (target Address, data []byte, err error)
+
+	// EncodeOperatorApproval packs the isApprovedForAll call checking whether operator may
+	// move owner's tokens of contract, and the setApprovalForAll granting it
+	EncodeOperatorApproval(ctx context.Context, chainID ChainID, contract Address, owner, operator Address) (check, approve []byte, err error)
 }
 
 type OrchestratorService interface {
 	PrepareCreateCollection(ctx context.Context, in PrepareCreateCollectionInput) (*PrepareCreateCollectionResult, error)
+	GetCollectionDefaults(ctx context.Context, chainID ChainID) (*CollectionDefaults, error)
 	PrepareMint(ctx context.Context, in PrepareMintInput) (*PrepareMintResult, error)
 
 	TrackTx(ctx context.Context, in TrackTxInput) (ok bool, err error)
 
 	GetIntentStatus(ctx context.Context, intentID string) (*IntentStatusPayload, error)
+	ListIntents(ctx context.Context, in ListIntentsInput) ([]*Intent, error)
+
+	PrepareUpdateRoyalty(ctx context.Context, in PrepareUpdateRoyaltyInput) (*PrepareCollectionAdminResult, error)
+	PrepareTransferCollectionOwnership(ctx context.Context, in PrepareTransferCollectionOwnershipInput) (*PrepareCollectionAdminResult, error)
+	PrepareSetBaseURI(ctx context.Context, in PrepareSetBaseURIInput) (*PrepareCollectionAdminResult, error)
+	PrepareSetPayoutSplits(ctx context.Context, in PrepareSetPayoutSplitsInput) (*PrepareCollectionAdminResult, error)
+
+	PrepareCreateAuction(ctx context.Context, in PrepareCreateAuctionInput) (*PrepareAuctionResult, error)
+	PrepareBid(ctx context.Context, in PrepareBidInput) (*PrepareAuctionResult, error)
+	PrepareSettleAuction(ctx context.Context, in PrepareSettleAuctionInput) (*PrepareAuctionResult, error)
+
+	PrepareImportCollection(ctx context.Context, in PrepareImportCollectionInput) (*ImportChallenge, error)
+	ImportCollection(ctx context.Context, in ImportCollectionInput) (*ImportedCollection, error)
+
+	PrepareAirdrop(ctx context.Context, in PrepareAirdropInput) (*AirdropBundle, error)
+	GetAirdropProgress(ctx context.Context, bundleID, userID string) (*BundleProgress, error)
+
+	PrepareBulkTransfer(ctx context.Context, in PrepareBulkTransferInput) (*BulkTransferBundle, error)
+	GetBulkTransferProgress(ctx context.Context, bundleID, userID string) (*BundleProgress, error)
+
+	AllowCallTarget(ctx context.Context, chainID ChainID, address Address, reason, actorID string) (*CallTargetOverride, error)
+	RevokeCallTarget(ctx context.Context, chainID ChainID, address Address, actorID string) (bool, error)
+	ListCallTargetOverrides(ctx context.Context, chainID ChainID) ([]*CallTargetOverride, error)
+
+	ReserveSponsorship(ctx context.Context, intentID string, gasGwei int64) (*SponsorshipGrant, error)
+	SettleSponsorship(ctx context.Context, grantID string, spentGwei int64, txHash string) (bool, error)
+	ReleaseSponsorship(ctx context.Context, grantID string) error
+	TopUpSponsorshipBudget(ctx context.Context, chainID ChainID, amountGwei int64, note, actorID string) (*SponsorshipBudget, error)
+	SetSponsorshipBudgetFrozen(ctx context.Context, chainID ChainID, frozen bool, reason, actorID string) (*SponsorshipBudget, error)
+	ListSponsorshipBudgets(ctx context.Context) ([]*SponsorshipBudget, error)
 }
 
 const DefaultIntentTTL = 6 * time.Hour
 
+// IntentExpiredError is the error of an intent whose deadline passed before its tx was sent
+const IntentExpiredError = "intent expired before its transaction was sent"
+
 type CollectionParams struct {
 	Name                   string
 	Symbol                 string
@@ -1,14 +1,47 @@
 package domain
 
+import "github.com/quangdang46/NFT-Marketplace/shared/errs"
+
 var (
-	ErrNotFound        = Error("not_found")
-	ErrInvalidInput    = Error("invalid_input")
-	ErrDuplicateTx     = Error("duplicate_tx")
-	ErrUnsupportedStd  = Error("unsupported_standard")
-	ErrUnauthenticated = Error("unauthenticated")
-	ErrSessionTimeout  = Error("session_timeout")
+	ErrNotFound            = errs.New(errs.NotFound, "not_found").WithMessage("intent not found")
+	ErrInvalidInput        = errs.New(errs.InvalidArgument, "invalid_input").WithMessage("invalid input")
+	ErrDuplicateTx         = errs.New(errs.AlreadyExists, "duplicate_tx").WithMessage("duplicate transaction")
+	ErrUnsupportedStd      = errs.New(errs.InvalidArgument, "unsupported_standard").WithMessage("unsupported standard")
+	ErrUnauthenticated     = errs.New(errs.Unauthenticated, "unauthenticated")
+	ErrSessionTimeout      = errs.New(errs.DeadlineExceeded, "session_timeout").WithMessage("session validation timeout")
+	ErrForbidden           = errs.New(errs.PermissionDenied, "forbidden").WithMessage("caller is not the collection creator")
+	ErrCollectionNotFound  = errs.New(errs.NotFound, "collection_not_found").WithMessage("collection not found")
+	ErrAuctionHouseNotSet  = errs.New(errs.FailedPrecondition, "auction_house_not_registered").WithMessage("no auction contract registered for chain")
+	ErrStatusChanged       = errs.New(errs.Aborted, "status_changed").WithMessage("intent status changed concurrently")
+	ErrCollectionExists    = errs.New(errs.AlreadyExists, "collection_exists").WithMessage("collection already listed")
+	ErrChallengeExpired    = errs.New(errs.FailedPrecondition, "challenge_expired").WithMessage("import challenge expired")
+	ErrNotContractOwner    = errs.New(errs.PermissionDenied, "not_contract_owner").WithMessage("signer is not the contract owner")
+	ErrImportDisabled      = errs.New(errs.FailedPrecondition, "collection_import_not_configured").WithMessage("collection import is not enabled")
+	ErrChallengeRequired   = errs.New(errs.FailedPrecondition, "import_challenge_required").WithMessage("sign the challenge from prepareCollectionImport and pass its challengeId; signatures over issuedAt are no longer accepted")
+	ErrAssetNotFound       = errs.New(errs.NotFound, "asset_not_found").WithMessage("media asset not found")
+	ErrAssetNotPinned      = errs.New(errs.FailedPrecondition, "asset_not_pinned").WithMessage("media asset is not pinned yet")
+	ErrMediaNotConfigured  = errs.New(errs.FailedPrecondition, "media_not_configured").WithMessage("media attachments are not enabled")
+	ErrSnapshotNotFound    = errs.New(errs.NotFound, "snapshot_not_found").WithMessage("holder snapshot not found")
+	ErrAirdropsDisabled    = errs.New(errs.FailedPrecondition, "airdrops_not_configured").WithMessage("airdrops are not enabled")
+	ErrTransfersDisabled   = errs.New(errs.FailedPrecondition, "bulk_transfers_not_configured").WithMessage("bulk transfers are not enabled")
+	ErrIntentLimit         = errs.New(errs.ResourceExhausted, "intent_limit_reached").WithMessage("too many open intents")
+	ErrWebhooksDisabled    = errs.New(errs.FailedPrecondition, "webhooks_not_configured").WithMessage("intent webhooks are not enabled")
+	ErrTargetNotAllowed    = errs.New(errs.PermissionDenied, "target_not_allowed").WithMessage("contract is not a registered call target")
+	ErrOverridesDisabled   = errs.New(errs.FailedPrecondition, "call_targets_not_configured").WithMessage("call target overrides are not enabled")
+	ErrSponsorshipDisabled = errs.New(errs.FailedPrecondition, "sponsorship_not_configured").WithMessage("gas sponsorship is not enabled")
+	ErrNotSponsorable      = errs.New(errs.FailedPrecondition, "not_sponsorable").WithMessage("only pending mint intents can be sponsored")
+	ErrSponsorshipFrozen   = errs.New(errs.FailedPrecondition, "sponsorship_frozen").WithMessage("gas sponsorship is frozen on this chain")
+	ErrSponsorshipBudget   = errs.New(errs.ResourceExhausted, "sponsorship_budget_exhausted").WithMessage("sponsorship budget exhausted")
+	ErrSponsorshipCap      = errs.New(errs.ResourceExhausted, "sponsorship_cap_reached").WithMessage("daily sponsorship cap reached")
+	ErrGrantNotFound       = errs.New(errs.NotFound, "sponsorship_grant_not_found").WithMessage("sponsorship grant expired or already released")
 )
 
-type Error string
+// ValidationError rejects one input field; it matches ErrInvalidInput with errors.Is
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string { return e.Field + ": " + e.Reason }
 
-func (e Error) Error() string { return string(e) }
+func (e *ValidationError) Unwrap() error { return ErrInvalidInput }
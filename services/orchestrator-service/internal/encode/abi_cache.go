@@ -0,0 +1,227 @@
+package encode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	chainpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+// abiRevalidateAfter is how long a contract's ABI is trusted without asking the registry.
+// registry.changed normally invalidates it first; this covers runs without RabbitMQ.
+const abiRevalidateAfter = 5 * time.Minute
+
+// contractABIRef remembers which ABI a contract resolved to under a given registry version
+type contractABIRef struct {
+	abiKey          string
+	registryVersion string
+	checkedAt       time.Time
+}
+
+// abiCache keeps parsed ABIs keyed by AbiSHA256 (or ETag when the sha is unknown).
+// Contract lookups are tied to the chain's registry version so a BumpVersion in the
+// chain registry transparently drops stale entries for that chain. Parsed ABIs live only
+// as long as a contract entry refers to them.
+type abiCache struct {
+	mu            sync.RWMutex
+	parsed        map[string]*abi.ABI
+	contracts     map[string]contractABIRef
+	chainVersions map[domain.ChainID]string
+}
+
+func newABICache() *abiCache {
+	return &abiCache{
+		parsed:        make(map[string]*abi.ABI),
+		contracts:     make(map[string]contractABIRef),
+		chainVersions: make(map[domain.ChainID]string),
+	}
+}
+
+func contractKey(chainID domain.ChainID, address domain.Address) string {
+	return strings.ToLower(string(chainID)) + "|" + strings.ToLower(string(address))
+}
+
+// cached returns a contract's ABI without asking the registry, while the entry was
+// resolved under the chain's current registry version and checked recently
+func (c *abiCache) cached(chainID domain.ChainID, address domain.Address) (*abi.ABI, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ref, ok := c.contracts[contractKey(chainID, address)]
+	if !ok || ref.registryVersion != c.chainVersions[chainID] || time.Since(ref.checkedAt) >= abiRevalidateAfter {
+		return nil, false
+	}
+	parsed, ok := c.parsed[ref.abiKey]
+	return parsed, ok
+}
+
+// lookup returns the cached ABI for a contract if it was resolved under registryVersion,
+// along with the key it is cached under
+func (c *abiCache) lookup(chainID domain.ChainID, address domain.Address, abiSHA256, registryVersion string) (*abi.ABI, string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if abiSHA256 != "" {
+		if parsed, ok := c.parsed[abiSHA256]; ok {
+			return parsed, abiSHA256, true
+		}
+	}
+
+	ref, ok := c.contracts[contractKey(chainID, address)]
+	if !ok || ref.registryVersion != registryVersion {
+		return nil, "", false
+	}
+	parsed, ok := c.parsed[ref.abiKey]
+	return parsed, ref.abiKey, ok
+}
+
+// observeVersion records the chain's registry version, invalidating the chain's
+// contract entries when it changed. It reports whether an invalidation happened.
+func (c *abiCache) observeVersion(chainID domain.ChainID, registryVersion string) bool {
+	if registryVersion == "" {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prev, seen := c.chainVersions[chainID]
+	c.chainVersions[chainID] = registryVersion
+	if !seen || prev == registryVersion {
+		return false
+	}
+
+	prefix := strings.ToLower(string(chainID)) + "|"
+	for key := range c.contracts {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.contracts, key)
+		}
+	}
+	c.pruneParsed()
+	return true
+}
+
+func (c *abiCache) store(chainID domain.ChainID, address domain.Address, abiKey, registryVersion string, parsed *abi.ABI) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if abiKey == "" {
+		return
+	}
+	key := contractKey(chainID, address)
+	prev, replaced := c.contracts[key]
+	c.parsed[abiKey] = parsed
+	c.contracts[key] = contractABIRef{abiKey: abiKey, registryVersion: registryVersion, checkedAt: time.Now()}
+	if replaced && prev.abiKey != abiKey {
+		c.pruneParsed()
+	}
+}
+
+// pruneParsed drops the parsed ABIs no contract entry refers to anymore. Callers hold mu.
+func (c *abiCache) pruneParsed() {
+	used := make(map[string]struct{}, len(c.contracts))
+	for _, ref := range c.contracts {
+		used[ref.abiKey] = struct{}{}
+	}
+	for key := range c.parsed {
+		if _, ok := used[key]; !ok {
+			delete(c.parsed, key)
+		}
+	}
+}
+
+// loadABI resolves and parses the ABI registered for a contract. A cached entry is used
+// without asking the registry until the chain's registry version moves; past that, the
+// cache is used when the registry still reports the same ABI hash and version.
+func (e *Encoder) loadABI(ctx context.Context, chainID domain.ChainID, address domain.Address) (*abi.ABI, error) {
+	if parsed, ok := e.abis.cached(chainID, address); ok {
+		return parsed, nil
+	}
+
+	var abiSHA256, registryVersion string
+	meta, err := e.chainRegistry.GetContractMeta(ctx, &chainpb.GetContractMetaRequest{
+		ChainId: string(chainID),
+		Address: string(address),
+	})
+	if err == nil && meta != nil {
+		registryVersion = meta.RegistryVersion
+		if meta.Contract != nil {
+			abiSHA256 = meta.Contract.AbiSha256
+		}
+	}
+
+	// Without registry metadata there is nothing to validate a cached entry against
+	cacheable := abiSHA256 != "" || registryVersion != ""
+	if cacheable {
+		e.abis.observeVersion(chainID, registryVersion)
+		if parsed, abiKey, ok := e.abis.lookup(chainID, address, abiSHA256, registryVersion); ok {
+			e.abis.store(chainID, address, abiKey, registryVersion, parsed)
+			return parsed, nil
+		}
+	}
+
+	abiResp, err := e.chainRegistry.GetAbiByAddress(ctx, &chainpb.GetAbiByAddressRequest{
+		ChainId: string(chainID),
+		Address: string(address),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get ABI by address: %w", err)
+	}
+	if abiResp == nil {
+		return nil, fmt.Errorf("empty ABI response for %s on %s", address, chainID)
+	}
+
+	parsed, err := parseABI(abiResp.AbiJson)
+	if err != nil {
+		return nil, err
+	}
+
+	abiKey := abiSHA256
+	if abiKey == "" {
+		abiKey = abiResp.Etag
+	}
+	if cacheable {
+		e.abis.store(chainID, address, abiKey, registryVersion, parsed)
+	}
+
+	return parsed, nil
+}
+
+// parseABI accepts either a raw ABI array or an artifact object with an "abi" field
+func parseABI(abiJSON string) (*abi.ABI, error) {
+	trimmed := bytes.TrimSpace([]byte(abiJSON))
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(trimmed, &artifact); err != nil {
+			return nil, fmt.Errorf("parse ABI json: %w", err)
+		}
+		if len(artifact.ABI) == 0 {
+			return nil, fmt.Errorf("abi field not found")
+		}
+		trimmed = artifact.ABI
+	}
+
+	parsed, err := abi.JSON(bytes.NewReader(trimmed))
+	if err != nil {
+		return nil, fmt.Errorf("parse abi: %w", err)
+	}
+	return &parsed, nil
+}
+
+// HandleRegistryChanged drops the chain's cached contract ABIs as soon as the registry
+// version moves instead of waiting for the next lookup to notice.
+func (e *Encoder) HandleRegistryChanged(ctx context.Context, event *contracts.RegistryChangedEvent) error {
+	e.abis.observeVersion(domain.ChainID(event.ChainID), event.RegistryVersion)
+	return nil
+}
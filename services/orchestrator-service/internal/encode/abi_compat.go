@@ -0,0 +1,43 @@
+package encode
+
+import (
+	"context"
+	"log"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// EncodedMethods lists every contract method the orchestrator builds calldata for.
+// Matching is by name, so any changed overload of these is reported.
+var EncodedMethods = []string{
+	"createERC721Collection", "createERC1155Collection",
+	"createERC721CollectionWithSplits", "createERC1155CollectionWithSplits",
+	"setDefaultRoyalty", "transferOwnership", "setBaseURI", "setPayoutSplits",
+	"createEnglishAuction", "createDutchAuction", "bid", "settle",
+}
+
+// HandleAbiChanged warns when a registry ABI replacement changes or drops a method the
+// orchestrator encodes. The registry version bump already evicts the cached ABI.
+func HandleAbiChanged(ctx context.Context, event *contracts.AbiChangedEvent) error {
+	for _, line := range AbiCompatWarnings(event) {
+		log.Print(line)
+	}
+	return nil
+}
+
+// AbiCompatWarnings returns one log line per encoded method the change breaks
+func AbiCompatWarnings(event *contracts.AbiChangedEvent) []string {
+	var lines []string
+	for _, b := range event.Diff.Breaks(nil, EncodedMethods) {
+		line := "abi_compat|service=orchestrator|chain_id=" + event.ChainID +
+			"|address=" + event.Address +
+			"|contract=" + event.Name +
+			"|registry_version=" + event.RegistryVersion +
+			"|removed=" + b.Removed
+		if b.Replacement != "" {
+			line += "|replacement=" + b.Replacement
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
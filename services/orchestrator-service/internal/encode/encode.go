@@ -1,10 +1,10 @@
 package encode
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
+	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -16,38 +16,20 @@ import (
 
 type Encoder struct {
 	chainRegistry chainpb.ChainRegistryServiceClient
+	abis          *abiCache
 }
 
 func NewEncoder(chainRegistry chainpb.ChainRegistryServiceClient) domain.Encoder {
 	return &Encoder{
 		chainRegistry: chainRegistry,
+		abis:          newABICache(),
 	}
 }
 
 func (e *Encoder) EncodeCreateCollection(ctx context.Context, chainID domain.ChainID, factory domain.Address, p domain.PrepareCreateCollectionInput) (to domain.Address, data []byte, value string, preview *domain.Address, err error) {
-	abiResp, err := e.chainRegistry.GetAbiByAddress(ctx, &chainpb.GetAbiByAddressRequest{
-		ChainId: string(chainID),
-		Address: string(factory),
-	})
+	parsedABI, err := e.loadABI(ctx, chainID, factory)
 	if err != nil {
-		return "", nil, "", nil, fmt.Errorf("get ABI by address: %w", err)
-	}
-
-	var raw map[string]any
-	if err := json.Unmarshal([]byte(abiResp.AbiJson), &raw); err != nil {
-		return "", nil, "", nil, fmt.Errorf("parse ABI json: %w", err)
-	}
-	abiArr, ok := raw["abi"]
-	if !ok {
-		return "", nil, "", nil, fmt.Errorf("abi field not found")
-	}
-	abiArrayBytes, err := json.Marshal(abiArr)
-	if err != nil {
-		return "", nil, "", nil, fmt.Errorf("marshal abi array: %w", err)
-	}
-	parsedABI, err := abi.JSON(bytes.NewReader(abiArrayBytes))
-	if err != nil {
-		return "", nil, "", nil, fmt.Errorf("parse abi: %w", err)
+		return "", nil, "", nil, err
 	}
 
 	var methodName string
@@ -60,10 +42,6 @@ func (e *Encoder) EncodeCreateCollection(ctx context.Context, chainID domain.Cha
 		return "", nil, "", nil, fmt.Errorf("unsupported collection type: %s", p.Type)
 	}
 
-	if _, exists := parsedABI.Methods[methodName]; !exists {
-		return "", nil, "", nil, fmt.Errorf("method %s not found in factory ABI", methodName)
-	}
-
 	if p.Name == "" {
 		return "", nil, "", nil, fmt.Errorf("collection name cannot be empty")
 	}
@@ -92,14 +70,231 @@ func (e *Encoder) EncodeCreateCollection(ctx context.Context, chainID domain.Cha
 		TokenURI:               p.TokenURI,
 	}
 
-	packed, err := parsedABI.Pack(methodName, tuple)
+	args := []interface{}{tuple}
+	if len(p.PayoutSplits) > 0 {
+		// Splits go to the factory's splitter-deploying variant; older factories have none
+		methodName += "WithSplits"
+		if _, ok := parsedABI.Methods[methodName]; !ok {
+			return "", nil, "", nil, &domain.ValidationError{Field: "payoutSplits", Reason: "the chain's collection factory does not support payout splits"}
+		}
+		recipients := make([]common.Address, len(p.PayoutSplits))
+		shares := make([]*big.Int, len(p.PayoutSplits))
+		for i, split := range p.PayoutSplits {
+			recipients[i] = common.HexToAddress(split.Recipient)
+			shares[i] = new(big.Int).SetUint64(split.Bps)
+		}
+		args = append(args, recipients, shares)
+	}
+
+	packed, err := packMethod(parsedABI, methodName, args...)
 	if err != nil {
-		return "", nil, "", nil, fmt.Errorf("pack calldata: %w", err)
+		return "", nil, "", nil, err
 	}
 
 	return factory, packed, "0", nil, nil
 }
 
-func (e *Encoder) EncodeMint(ctx context.Context, chainID domain.ChainID, contract domain.Address, standard domain.Standard, p domain.PrepareMintInput) (to domain.Address, data []byte, value string, err error) {
-	return "", nil, "", nil
+// collectionAdminABI covers the owner-only setters every marketplace collection exposes.
+// It is used when the chain registry has no ABI for the deployed collection itself.
+const collectionAdminABI = `[
+	{"type":"function","name":"setDefaultRoyalty","stateMutability":"nonpayable","inputs":[{"name":"receiver","type":"address"},{"name":"feeNumerator","type":"uint96"}],"outputs":[]},
+	{"type":"function","name":"transferOwnership","stateMutability":"nonpayable","inputs":[{"name":"newOwner","type":"address"}],"outputs":[]},
+	{"type":"function","name":"setBaseURI","stateMutability":"nonpayable","inputs":[{"name":"baseURI","type":"string"}],"outputs":[]},
+	{"type":"function","name":"setPayoutSplits","stateMutability":"nonpayable","inputs":[{"name":"recipients","type":"address[]"},{"name":"shares","type":"uint256[]"}],"outputs":[]}
+]`
+
+var defaultAdminABI, _ = abi.JSON(strings.NewReader(collectionAdminABI))
+
+func (e *Encoder) EncodeCollectionAdmin(ctx context.Context, chainID domain.ChainID, contract domain.Address, method string, args ...interface{}) (to domain.Address, data []byte, value string, err error) {
+	if contract == "" {
+		return "", nil, "", fmt.Errorf("collection address cannot be empty")
+	}
+
+	parsedABI, err := e.loadABI(ctx, chainID, contract)
+	if err != nil || parsedABI.Methods[method].Name == "" {
+		parsedABI = &defaultAdminABI
+	}
+
+	packed, err := packMethod(parsedABI, method, args...)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	return contract, packed, "0", nil
+}
+
+// auctionHouseABI is the AuctionHouse interface, used when the registry has no ABI for the deployment
+const auctionHouseABI = `[
+	{"type":"function","name":"createEnglishAuction","stateMutability":"nonpayable","inputs":[{"name":"nft","type":"address"},{"name":"tokenId","type":"uint256"},{"name":"startPrice","type":"uint256"},{"name":"reservePrice","type":"uint256"},{"name":"startTime","type":"uint64"},{"name":"duration","type":"uint64"}],"outputs":[{"name":"auctionId","type":"uint256"}]},
+	{"type":"function","name":"createDutchAuction","stateMutability":"nonpayable","inputs":[{"name":"nft","type":"address"},{"name":"tokenId","type":"uint256"},{"name":"startPrice","type":"uint256"},{"name":"endPrice","type":"uint256"},{"name":"startTime","type":"uint64"},{"name":"duration","type":"uint64"}],"outputs":[{"name":"auctionId","type":"uint256"}]},
+	{"type":"function","name":"bid","stateMutability":"payable","inputs":[{"name":"auctionId","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"settle","stateMutability":"nonpayable","inputs":[{"name":"auctionId","type":"uint256"}],"outputs":[]}
+]`
+
+var defaultAuctionABI, _ = abi.JSON(strings.NewReader(auctionHouseABI))
+
+func (e *Encoder) EncodeAuction(ctx context.Context, chainID domain.ChainID, auctionHouse domain.Address, method string, value string, args ...interface{}) (to domain.Address, data []byte, err error) {
+	if auctionHouse == "" {
+		return "", nil, fmt.Errorf("auction house address cannot be empty")
+	}
+
+	parsedABI, err := e.loadABI(ctx, chainID, auctionHouse)
+	if err != nil || parsedABI.Methods[method].Name == "" {
+		parsedABI = &defaultAuctionABI
+	}
+
+	if value != "0" && !parsedABI.Methods[method].IsPayable() {
+		return "", nil, fmt.Errorf("method %s is not payable", method)
+	}
+
+	packed, err := packMethod(parsedABI, method, args...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return auctionHouse, packed, nil
+}
+
+// Owner-only airdrops of marketplace collections, used when the registry has no ABI for the
+// collection or its ABI has no airdrop: ERC-721 collections mint quantities[i] new tokens to
+// recipients[i], ERC-1155 ones mint amounts[i] of id.
+const (
+	erc721AirdropABI = `[
+	{"type":"function","name":"airdrop","stateMutability":"nonpayable","inputs":[{"name":"recipients","type":"address[]"},{"name":"quantities","type":"uint256[]"}],"outputs":[]}
+]`
+	erc1155AirdropABI = `[
+	{"type":"function","name":"airdrop","stateMutability":"nonpayable","inputs":[{"name":"id","type":"uint256"},{"name":"recipients","type":"address[]"},{"name":"amounts","type":"uint256[]"}],"outputs":[]}
+]`
+)
+
+var (
+	defaultERC721AirdropABI, _  = abi.JSON(strings.NewReader(erc721AirdropABI))
+	defaultERC1155AirdropABI, _ = abi.JSON(strings.NewReader(erc1155AirdropABI))
+)
+
+func (e *Encoder) EncodeAirdrop(ctx context.Context, chainID domain.ChainID, contract domain.Address, standard domain.Standard, tokenID *big.Int, recipients []domain.AirdropRecipient) (to domain.Address, data []byte, err error) {
+	if contract == "" {
+		return "", nil, fmt.Errorf("collection address cannot be empty")
+	}
+
+	addresses := make([]common.Address, len(recipients))
+	amounts := make([]*big.Int, len(recipients))
+	for i, r := range recipients {
+		addresses[i] = common.HexToAddress(r.Address)
+		amounts[i] = new(big.Int).SetUint64(r.Amount)
+	}
+
+	var fallback *abi.ABI
+	var args []interface{}
+	switch standard {
+	case domain.StdERC721:
+		fallback, args = &defaultERC721AirdropABI, []interface{}{addresses, amounts}
+	case domain.StdERC1155:
+		if tokenID == nil {
+			return "", nil, fmt.Errorf("ERC-1155 airdrop needs a token id")
+		}
+		fallback, args = &defaultERC1155AirdropABI, []interface{}{tokenID, addresses, amounts}
+	default:
+		return "", nil, fmt.Errorf("unsupported standard: %s", standard)
+	}
+
+	parsedABI, err := e.loadABI(ctx, chainID, contract)
+	if err != nil || len(parsedABI.Methods["airdrop"].Inputs) != len(args) {
+		parsedABI = fallback
+	}
+
+	packed, err := packMethod(parsedABI, "airdrop", args...)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return contract, packed, nil
+}
+
+// Standard transfers and operator approvals, shared by every ERC-721 and ERC-1155 contract
+const (
+	erc721TransferABI = `[
+	{"type":"function","name":"safeTransferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]}
+]`
+	erc1155TransferABI = `[
+	{"type":"function","name":"safeBatchTransferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"ids","type":"uint256[]"},{"name":"amounts","type":"uint256[]"},{"name":"data","type":"bytes"}],"outputs":[]}
+]`
+	operatorApprovalABI = `[
+	{"type":"function","name":"setApprovalForAll","stateMutability":"nonpayable","inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"outputs":[]},
+	{"type":"function","name":"isApprovedForAll","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"operator","type":"address"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+)
+
+var (
+	erc721TransferParsed, _   = abi.JSON(strings.NewReader(erc721TransferABI))
+	erc1155TransferParsed, _  = abi.JSON(strings.NewReader(erc1155TransferABI))
+	operatorApprovalParsed, _ = abi.JSON(strings.NewReader(operatorApprovalABI))
+)
+
+// EncodeTokenTransfer sends tokens of one contract from one wallet to another: a single
+// ERC-721 safeTransferFrom, or an ERC-1155 safeBatchTransferFrom of every token
+func (e *Encoder) EncodeTokenTransfer(ctx context.Context, chainID domain.ChainID, contract domain.Address, standard domain.Standard, from, to domain.Address, tokens []domain.BulkTransferToken) (target domain.Address, data []byte, err error) {
+	if contract == "" {
+		return "", nil, fmt.Errorf("collection address cannot be empty")
+	}
+	if len(tokens) == 0 {
+		return "", nil, fmt.Errorf("no tokens to transfer")
+	}
+
+	ids := make([]*big.Int, len(tokens))
+	amounts := make([]*big.Int, len(tokens))
+	for i, t := range tokens {
+		id, ok := new(big.Int).SetString(t.TokenID, 10)
+		if !ok {
+			return "", nil, fmt.Errorf("invalid token id: %s", t.TokenID)
+		}
+		ids[i] = id
+		amounts[i] = new(big.Int).SetUint64(t.Amount)
+	}
+
+	fromAddr, toAddr := common.HexToAddress(from), common.HexToAddress(to)
+	switch standard {
+	case domain.StdERC721:
+		if len(tokens) != 1 {
+			return "", nil, fmt.Errorf("ERC-721 transfers send one token")
+		}
+		data, err = packMethod(&erc721TransferParsed, "safeTransferFrom", fromAddr, toAddr, ids[0])
+	case domain.StdERC1155:
+		data, err = packMethod(&erc1155TransferParsed, "safeBatchTransferFrom", fromAddr, toAddr, ids, amounts, []byte{})
+	default:
+		return "", nil, fmt.Errorf("unsupported standard: %s", standard)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return contract, data, nil
+}
+
+// EncodeOperatorApproval returns the isApprovedForAll call checking whether operator may move
+// owner's tokens of contract, and the setApprovalForAll transaction letting it
+func (e *Encoder) EncodeOperatorApproval(ctx context.Context, chainID domain.ChainID, contract, owner, operator domain.Address) (check, approve []byte, err error) {
+	if contract == "" {
+		return nil, nil, fmt.Errorf("collection address cannot be empty")
+	}
+	check, err = packMethod(&operatorApprovalParsed, "isApprovedForAll", common.HexToAddress(owner), common.HexToAddress(operator))
+	if err != nil {
+		return nil, nil, err
+	}
+	approve, err = packMethod(&operatorApprovalParsed, "setApprovalForAll", common.HexToAddress(operator), true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return check, approve, nil
+}
+
+// packMethod builds calldata for any method exposed by the contract ABI
+func packMethod(parsedABI *abi.ABI, methodName string, args ...interface{}) ([]byte, error) {
+	if _, exists := parsedABI.Methods[methodName]; !exists {
+		return nil, fmt.Errorf("method %s not found in contract ABI", methodName)
+	}
+	packed, err := parsedABI.Pack(methodName, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack calldata: %w", err)
+	}
+	return packed, nil
 }
@@ -0,0 +1,176 @@
+package encode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	chainpb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/utils"
+)
+
+// standardMintFunctions are the mint signatures of the marketplace's own collections, used
+// when the chain registry has no mint function registered for the contract
+var standardMintFunctions = map[domain.Standard]contracts.MintFunction{
+	domain.StdERC721: {
+		Name: "mint",
+		Args: []contracts.MintArg{contracts.MintArgRecipient, contracts.MintArgQuantity},
+	},
+	domain.StdERC1155: {
+		Name: "mint",
+		Args: []contracts.MintArg{contracts.MintArgRecipient, contracts.MintArgTokenID, contracts.MintArgQuantity, contracts.MintArgData},
+	},
+}
+
+var mintArgTypes = map[contracts.MintArg]string{
+	contracts.MintArgRecipient: "address",
+	contracts.MintArgQuantity:  "uint256",
+	contracts.MintArgTokenID:   "uint256",
+	contracts.MintArgProof:     "bytes32[]",
+	contracts.MintArgData:      "bytes",
+}
+
+func (e *Encoder) EncodeMint(ctx context.Context, chainID domain.ChainID, contract domain.Address, standard domain.Standard, p domain.PrepareMintInput) (to domain.Address, data []byte, value string, err error) {
+	if contract == "" {
+		return "", nil, "", fmt.Errorf("collection address cannot be empty")
+	}
+
+	fn, err := e.mintFunction(ctx, chainID, contract, standard)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	args, err := mintArguments(fn, p)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	method, err := mintMethod(fn)
+	if err != nil {
+		return "", nil, "", err
+	}
+	packed, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("failed to pack %s: %w", fn.Name, err)
+	}
+
+	value = "0"
+	if fn.Payable && fn.UnitPriceWei != "" {
+		price, _ := new(big.Int).SetString(fn.UnitPriceWei, 10)
+		value = price.Mul(price, utils.ToBigInt(p.Quantity)).String()
+	}
+
+	return contract, append(method.ID, packed...), value, nil
+}
+
+// mintFunction returns the mint function registered for the contract, falling back to the
+// standard signature for collections the registry does not describe
+func (e *Encoder) mintFunction(ctx context.Context, chainID domain.ChainID, contract domain.Address, standard domain.Standard) (contracts.MintFunction, error) {
+	meta, err := e.chainRegistry.GetContractMeta(ctx, &chainpb.GetContractMetaRequest{
+		ChainId: string(chainID),
+		Address: string(contract),
+	})
+	if err != nil {
+		log.Printf("Mint function lookup for %s on %s failed, using the %s default: %v", contract, chainID, standard, err)
+	}
+	if err == nil && meta != nil && meta.Contract != nil && meta.Contract.MintFunction != nil {
+		desc := meta.Contract.MintFunction
+		args := make([]contracts.MintArg, len(desc.Args))
+		for i, arg := range desc.Args {
+			args[i] = contracts.MintArg(arg)
+		}
+		fn := contracts.MintFunction{
+			Name:         desc.Name,
+			Args:         args,
+			Payable:      desc.Payable,
+			UnitPriceWei: desc.UnitPriceWei,
+			MaxPerTx:     desc.MaxPerTx,
+		}
+		if err := fn.Validate(); err != nil {
+			return contracts.MintFunction{}, fmt.Errorf("registered mint function for %s: %w", contract, err)
+		}
+		return fn, nil
+	}
+
+	fn, ok := standardMintFunctions[standard]
+	if !ok {
+		return contracts.MintFunction{}, fmt.Errorf("unsupported mint standard: %s", standard)
+	}
+	return fn, nil
+}
+
+// mintArguments fills the function's arguments from the request, in declaration order
+func mintArguments(fn contracts.MintFunction, p domain.PrepareMintInput) ([]interface{}, error) {
+	if p.Quantity == 0 {
+		return nil, &domain.ValidationError{Field: "quantity", Reason: "must be at least 1"}
+	}
+	if !fn.Has(contracts.MintArgQuantity) && p.Quantity != 1 {
+		return nil, &domain.ValidationError{Field: "quantity", Reason: fmt.Sprintf("%s mints one token per call", fn.Name)}
+	}
+	if fn.MaxPerTx > 0 && p.Quantity > fn.MaxPerTx {
+		return nil, &domain.ValidationError{Field: "quantity", Reason: fmt.Sprintf("at most %d per transaction", fn.MaxPerTx)}
+	}
+	if !fn.Has(contracts.MintArgTokenID) && p.TokenID != "" {
+		return nil, &domain.ValidationError{Field: "tokenId", Reason: fmt.Sprintf("%s does not take a token id", fn.Name)}
+	}
+	if !fn.Has(contracts.MintArgProof) && len(p.Proof) > 0 {
+		return nil, &domain.ValidationError{Field: "proof", Reason: fmt.Sprintf("%s does not take a proof", fn.Name)}
+	}
+
+	args := make([]interface{}, 0, len(fn.Args))
+	for _, arg := range fn.Args {
+		switch arg {
+		case contracts.MintArgRecipient:
+			if !common.IsHexAddress(string(p.Minter)) {
+				return nil, &domain.ValidationError{Field: "minter", Reason: "must be a hex address"}
+			}
+			args = append(args, common.HexToAddress(string(p.Minter)))
+		case contracts.MintArgQuantity:
+			args = append(args, utils.ToBigInt(p.Quantity))
+		case contracts.MintArgTokenID:
+			tokenID, ok := new(big.Int).SetString(p.TokenID, 10)
+			if !ok || tokenID.Sign() < 0 || tokenID.BitLen() > 256 {
+				return nil, &domain.ValidationError{Field: "tokenId", Reason: "must be a uint256 decimal"}
+			}
+			args = append(args, tokenID)
+		case contracts.MintArgProof:
+			proof := make([][32]byte, len(p.Proof))
+			for i, node := range p.Proof {
+				b, err := hexutil.Decode(node)
+				if err != nil || len(b) != 32 {
+					return nil, &domain.ValidationError{Field: "proof", Reason: fmt.Sprintf("entry %d must be 32 bytes of 0x hex", i)}
+				}
+				copy(proof[i][:], b)
+			}
+			args = append(args, proof)
+		case contracts.MintArgData:
+			args = append(args, []byte{})
+		}
+	}
+	return args, nil
+}
+
+// mintMethod builds the ABI method for the function so calldata matches its declared shape
+func mintMethod(fn contracts.MintFunction) (abi.Method, error) {
+	inputs := make(abi.Arguments, len(fn.Args))
+	for i, arg := range fn.Args {
+		typ, err := abi.NewType(mintArgTypes[arg], "", nil)
+		if err != nil {
+			return abi.Method{}, fmt.Errorf("mint argument %s: %w", arg, err)
+		}
+		inputs[i] = abi.Argument{Name: string(arg), Type: typ}
+	}
+
+	mutability := "nonpayable"
+	if fn.Payable {
+		mutability = "payable"
+	}
+	return abi.NewMethod(fn.Name, fn.Name, abi.Function, mutability, false, fn.Payable, inputs, nil), nil
+}
@@ -0,0 +1,141 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/chainrpc"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// ERC-165 interface ids of the collection standards an import accepts
+var (
+	interfaceERC721  = [4]byte{0x80, 0xac, 0x58, 0xcd}
+	interfaceERC1155 = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+)
+
+// collectionABI holds the read-only calls an imported collection is inspected with
+const collectionABI = `[
+	{"type":"function","name":"supportsInterface","stateMutability":"view","inputs":[{"name":"interfaceId","type":"bytes4"}],"outputs":[{"type":"bool"}]},
+	{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"type":"address"}]},
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"type":"string"}]},
+	{"type":"function","name":"contractURI","stateMutability":"view","inputs":[],"outputs":[{"type":"string"}]}
+]`
+
+// CollectionInspector reads deployed collection contracts over the RPC endpoints the chain
+// registry lists
+type CollectionInspector struct {
+	rpc *chainrpc.Clients
+	abi abi.ABI
+}
+
+func NewCollectionInspector(registry protoChainRegistry.ChainRegistryServiceClient) domain.CollectionInspector {
+	parsed, err := abi.JSON(strings.NewReader(collectionABI))
+	if err != nil {
+		panic(fmt.Sprintf("invalid collection abi: %v", err))
+	}
+	return &CollectionInspector{rpc: chainrpc.NewClients(registry), abi: parsed}
+}
+
+// InspectCollection detects the standard through ERC-165 and reads the Ownable owner. Name,
+// symbol and contractURI are optional; ERC-1155 contracts often have none of them.
+func (i *CollectionInspector) InspectCollection(ctx context.Context, chainID domain.ChainID, contract domain.Address) (*domain.ContractInfo, error) {
+	client, err := i.rpc.Client(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	address := common.HexToAddress(contract)
+
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		i.rpc.Drop(chainID, client)
+		return nil, fmt.Errorf("get code of %s: %w", contract, err)
+	}
+	if len(code) == 0 {
+		return nil, domain.ErrNotFound
+	}
+
+	info := &domain.ContractInfo{}
+	if ok, _ := i.supportsInterface(ctx, client, address, interfaceERC721); ok {
+		info.Standard = domain.StdERC721
+	} else if ok, _ := i.supportsInterface(ctx, client, address, interfaceERC1155); ok {
+		info.Standard = domain.StdERC1155
+	}
+
+	var owner common.Address
+	if err := i.call(ctx, client, address, &owner, "owner"); err != nil {
+		return nil, fmt.Errorf("read owner of %s: %w", contract, err)
+	}
+	info.Owner = strings.ToLower(owner.Hex())
+
+	_ = i.call(ctx, client, address, &info.Name, "name")
+	_ = i.call(ctx, client, address, &info.Symbol, "symbol")
+	_ = i.call(ctx, client, address, &info.ContractURI, "contractURI")
+	return info, nil
+}
+
+// DeploymentBlock binary searches for the first block with code at contract
+func (i *CollectionInspector) DeploymentBlock(ctx context.Context, chainID domain.ChainID, contract domain.Address) (uint64, error) {
+	client, err := i.rpc.Client(ctx, chainID)
+	if err != nil {
+		return 0, err
+	}
+	address := common.HexToAddress(contract)
+
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		i.rpc.Drop(chainID, client)
+		return 0, fmt.Errorf("get block number: %w", err)
+	}
+
+	// Historical state needs an archive node; a pruned node fails here and the caller
+	// falls back to backfilling from genesis
+	low, high := uint64(0), head
+	for low < high {
+		mid := low + (high-low)/2
+		code, err := client.CodeAt(ctx, address, new(big.Int).SetUint64(mid))
+		if err != nil {
+			return 0, fmt.Errorf("get code of %s at block %d: %w", contract, mid, err)
+		}
+		if len(code) > 0 {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low, nil
+}
+
+func (i *CollectionInspector) supportsInterface(ctx context.Context, client *ethclient.Client, address common.Address, id [4]byte) (bool, error) {
+	var ok bool
+	if err := i.call(ctx, client, address, &ok, "supportsInterface", id); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// call runs a view method and unpacks its single return value into out
+func (i *CollectionInspector) call(ctx context.Context, client *ethclient.Client, address common.Address, out interface{}, method string, args ...interface{}) error {
+	data, err := i.abi.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("pack %s: %w", method, err)
+	}
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &address, Data: data}, nil)
+	if err != nil {
+		return fmt.Errorf("call %s: %w", method, err)
+	}
+	values, err := i.abi.Unpack(method, result)
+	if err != nil || len(values) != 1 {
+		return fmt.Errorf("unpack %s: unexpected return data", method)
+	}
+	return i.abi.Methods[method].Outputs.Copy(out, values)
+}
@@ -0,0 +1,33 @@
+package chain
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// ChainLiveness reads chain heads the chain registry keeps from the indexer's reports
+type ChainLiveness struct {
+	registry protoChainRegistry.ChainRegistryServiceClient
+}
+
+func NewChainLiveness(registry protoChainRegistry.ChainRegistryServiceClient) domain.ChainLiveness {
+	return &ChainLiveness{registry: registry}
+}
+
+// ChainHalted is false for a chain the registry has no head for: without one there is
+// nothing to tell a halted chain from a dropped tx
+func (l *ChainLiveness) ChainHalted(ctx context.Context, chainID domain.ChainID) (bool, error) {
+	resp, err := l.registry.GetChainHead(ctx, &protoChainRegistry.GetChainHeadRequest{ChainId: chainID})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return resp.GetHead().GetStale(), nil
+}
@@ -0,0 +1,59 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/chainrpc"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// TxLookup reads transactions over the RPC endpoints the chain registry lists
+type TxLookup struct {
+	rpc *chainrpc.Clients
+}
+
+func NewTxLookup(registry protoChainRegistry.ChainRegistryServiceClient) domain.TxLookup {
+	return &TxLookup{rpc: chainrpc.NewClients(registry)}
+}
+
+// LookupTx checks the receipt first and falls back to the mempool when there is none
+func (l *TxLookup) LookupTx(ctx context.Context, chainID domain.ChainID, txHash string) (domain.TxState, error) {
+	client, err := l.rpc.Client(ctx, chainID)
+	if err != nil {
+		return "", err
+	}
+	hash := common.HexToHash(txHash)
+
+	receipt, err := client.TransactionReceipt(ctx, hash)
+	switch {
+	case err == nil:
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			return domain.TxMined, nil
+		}
+		return domain.TxReverted, nil
+	case !errors.Is(err, ethereum.NotFound):
+		l.rpc.Drop(chainID, client)
+		return "", fmt.Errorf("get receipt of %s: %w", txHash, err)
+	}
+
+	_, isPending, err := client.TransactionByHash(ctx, hash)
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		return domain.TxUnknown, nil
+	case err != nil:
+		l.rpc.Drop(chainID, client)
+		return "", fmt.Errorf("get transaction %s: %w", txHash, err)
+	case isPending:
+		return domain.TxQueued, nil
+	default:
+		// Mined between the two calls, or the node has not indexed the receipt yet
+		return domain.TxMined, nil
+	}
+}
@@ -0,0 +1,80 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+// maxStaleSession is how long a cached answer may stand in for auth-service when it fails
+const maxStaleSession = 10 * time.Minute
+
+type sessionKey struct {
+	userID    string
+	sessionID string
+}
+
+type sessionEntry struct {
+	active    bool
+	checkedAt time.Time
+}
+
+// AuthSessions adapts auth-service to the orchestrator's SessionValidator. Answers are
+// cached for ttl, so a revoked session may still pass for up to ttl; when auth-service
+// fails, an answer up to maxStaleSession old is used instead.
+type AuthSessions struct {
+	client authpb.AuthServiceClient
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[sessionKey]sessionEntry
+	swept time.Time
+}
+
+func NewAuthSessions(client authpb.AuthServiceClient, ttl time.Duration) domain.SessionValidator {
+	return &AuthSessions{client: client, ttl: ttl, cache: make(map[sessionKey]sessionEntry)}
+}
+
+func (a *AuthSessions) ValidateSession(ctx context.Context, userID, sessionID string) (bool, error) {
+	key := sessionKey{userID: userID, sessionID: sessionID}
+	now := time.Now()
+
+	a.mu.Lock()
+	entry, cached := a.cache[key]
+	a.mu.Unlock()
+	if cached && now.Sub(entry.checkedAt) < a.ttl {
+		return entry.active, nil
+	}
+
+	resp, err := a.client.ValidateSession(ctx, &authpb.ValidateSessionRequest{UserId: userID, SessionId: sessionID})
+	if err != nil {
+		if cached && now.Sub(entry.checkedAt) < maxStaleSession {
+			return entry.active, nil
+		}
+		return false, fmt.Errorf("validate session: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.cache[key] = sessionEntry{active: resp.GetActive(), checkedAt: now}
+	a.evictStale(now)
+	return resp.GetActive(), nil
+}
+
+// evictStale drops answers too old to stand in for auth-service, at most once a minute;
+// the caller holds mu
+func (a *AuthSessions) evictStale(now time.Time) {
+	if now.Sub(a.swept) < time.Minute {
+		return
+	}
+	a.swept = now
+	for key, entry := range a.cache {
+		if now.Sub(entry.checkedAt) >= maxStaleSession {
+			delete(a.cache, key)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	mediapb "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+)
+
+// MediaRefs adapts media-service to the orchestrator's MediaRefs
+type MediaRefs struct {
+	client mediapb.MediaServiceClient
+}
+
+func NewMediaRefs(client mediapb.MediaServiceClient) domain.MediaRefs {
+	return &MediaRefs{client: client}
+}
+
+func (m *MediaRefs) AddRef(ctx context.Context, ref domain.MediaRef) error {
+	_, err := m.client.AddRef(ctx, &mediapb.AddRefRequest{AssetId: ref.AssetID, Ref: ref.Holder()})
+	switch {
+	case err == nil:
+		return nil
+	case errs.Is(err, errs.NotFound):
+		return domain.ErrAssetNotFound
+	case errs.Is(err, errs.FailedPrecondition):
+		return domain.ErrAssetNotPinned
+	}
+	return fmt.Errorf("add media ref: %w", err)
+}
+
+func (m *MediaRefs) ReleaseRef(ctx context.Context, ref domain.MediaRef) error {
+	_, err := m.client.ReleaseRef(ctx, &mediapb.ReleaseRefRequest{AssetId: ref.AssetID, Ref: ref.Holder()})
+	if err != nil && !errs.Is(err, errs.NotFound) {
+		return fmt.Errorf("release media ref: %w", err)
+	}
+	return nil
+}
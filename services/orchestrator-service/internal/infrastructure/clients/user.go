@@ -0,0 +1,31 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrgMembers adapts user-service to the orchestrator's OrgMembershipReader
+type OrgMembers struct {
+	client userpb.UserServiceClient
+}
+
+func NewOrgMembers(client userpb.UserServiceClient) domain.OrgMembershipReader {
+	return &OrgMembers{client: client}
+}
+
+func (o *OrgMembers) GetOrgRole(ctx context.Context, orgID, userID string) (string, error) {
+	resp, err := o.client.GetOrganizationMembership(ctx, &userpb.GetOrganizationMembershipRequest{OrgId: orgID, UserId: userID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+		return "", fmt.Errorf("get organization membership: %w", err)
+	}
+	return resp.GetMember().GetRole(), nil
+}
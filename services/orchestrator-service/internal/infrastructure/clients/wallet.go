@@ -0,0 +1,93 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+// WalletLinks adapts wallet-service to the orchestrator's LinkedWalletReader; it lists only
+// the wallets the user signed for
+type WalletLinks struct {
+	client walletpb.WalletServiceClient
+}
+
+func NewWalletLinks(client walletpb.WalletServiceClient) domain.LinkedWalletReader {
+	return &WalletLinks{client: client}
+}
+
+func (w *WalletLinks) ListLinkedAddresses(ctx context.Context, userID string) ([]domain.Address, error) {
+	resp, err := w.client.ListLinks(ctx, &walletpb.ListLinksRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("list wallet links: %w", err)
+	}
+
+	addresses := make([]domain.Address, 0, len(resp.Links))
+	for _, link := range resp.Links {
+		// Watch-only wallets are tracked without a signature, so they prove nothing
+		if link.IsWatchOnly {
+			continue
+		}
+		addresses = append(addresses, domain.Address(strings.ToLower(link.Address)))
+	}
+	return addresses, nil
+}
+
+// AddressProofs adapts wallet-service signature challenges to the orchestrator's AddressProver
+type AddressProofs struct {
+	client walletpb.WalletServiceClient
+}
+
+func NewAddressProofs(client walletpb.WalletServiceClient) domain.AddressProver {
+	return &AddressProofs{client: client}
+}
+
+func (a *AddressProofs) CreateAddressChallenge(ctx context.Context, purpose string, chainID domain.ChainID, address domain.Address, userID, resource, statement string) (*domain.AddressChallenge, error) {
+	resp, err := a.client.CreateSignatureChallenge(ctx, &walletpb.CreateSignatureChallengeRequest{
+		Purpose:   purpose,
+		Address:   address,
+		ChainId:   chainID,
+		UserId:    userID,
+		Resource:  resource,
+		Statement: statement,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create signature challenge: %w", err)
+	}
+	return &domain.AddressChallenge{
+		ID:        resp.ChallengeId,
+		Message:   resp.Message,
+		IssuedAt:  resp.IssuedAt.AsTime(),
+		ExpiresAt: resp.ExpiresAt.AsTime(),
+	}, nil
+}
+
+func (a *AddressProofs) VerifyAddressChallenge(ctx context.Context, challengeID, signature, purpose, userID string) (*domain.AddressProof, error) {
+	resp, err := a.client.VerifySignatureChallenge(ctx, &walletpb.VerifySignatureChallengeRequest{
+		ChallengeId: challengeID,
+		Signature:   signature,
+		Purpose:     purpose,
+		UserId:      userID,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return nil, domain.ErrChallengeExpired
+	case codes.InvalidArgument, codes.PermissionDenied:
+		return nil, &domain.ValidationError{Field: "signature", Reason: status.Convert(err).Message()}
+	default:
+		return nil, fmt.Errorf("verify signature challenge: %w", err)
+	}
+	return &domain.AddressProof{
+		Address:  domain.Address(strings.ToLower(resp.Address)),
+		ChainID:  resp.ChainId,
+		Resource: resp.Resource,
+		Method:   resp.Method,
+	}, nil
+}
@@ -0,0 +1,163 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+)
+
+const (
+	// Intent event routing keys: intents.events.tx_tracked.eip155-1
+	txTrackedPrefix = "intents.events.tx_tracked"
+
+	// Collection import routing keys: collections.events.imported.eip155-1
+	collectionImportedPrefix = "collections.events.imported"
+
+	// Event schema versions
+	eventSchemaV1 = "marketplace.events.v1"
+)
+
+// intentEvent mirrors the collection event envelope the catalog consumer decodes
+type intentEvent struct {
+	Schema    string                 `json:"schema"`
+	Version   string                 `json:"version"`
+	EventID   string                 `json:"event_id"`
+	EventType string                 `json:"event_type"`
+	ChainID   string                 `json:"chain_id"`
+	TxHash    string                 `json:"tx_hash"`
+	Contract  string                 `json:"contract"`
+	Data      map[string]interface{} `json:"data"`
+	Timestamp time.Time              `json:"timestamp"`
+	// AggregateID and Sequence order an intent's events for consumers
+	AggregateID string `json:"aggregate_id,omitempty"`
+	Sequence    uint64 `json:"sequence,omitempty"`
+}
+
+type EventPublisher struct {
+	amqp      *messaging.RabbitMQ
+	sequencer domain.EventSequencer // nil publishes intent events unsequenced
+}
+
+// NewEventPublisher creates a new RabbitMQ intent event publisher
+func NewEventPublisher(amqp *messaging.RabbitMQ, sequencer domain.EventSequencer) domain.IntentEventPublisher {
+	return &EventPublisher{amqp: amqp, sequencer: sequencer}
+}
+
+// PublishTxTracked publishes intent_tx_tracked on the collections exchange
+func (p *EventPublisher) PublishTxTracked(ctx context.Context, event domain.TxTracked) error {
+	chainID := strings.ReplaceAll(event.ChainID, ":", "-")
+	contract := ""
+	if event.Contract != nil {
+		contract = strings.ToLower(*event.Contract)
+	}
+
+	eventID := fmt.Sprintf("intent_tx_tracked_%s_%s", event.IntentID, event.TxHash)
+
+	evt := intentEvent{
+		Schema:    eventSchemaV1,
+		Version:   "1.0",
+		EventID:   eventID,
+		EventType: "intent_tx_tracked",
+		ChainID:   chainID,
+		TxHash:    event.TxHash,
+		Contract:  contract,
+		Data: map[string]interface{}{
+			"intent_id":  event.IntentID,
+			"kind":       string(event.Kind),
+			"created_by": event.CreatedBy,
+		},
+		Timestamp: event.TrackedAt,
+	}
+	headers := map[string]interface{}{
+		"event_type":   "intent_tx_tracked",
+		"chain_id":     chainID,
+		"published_at": event.TrackedAt.Unix(),
+		"content_type": "application/json",
+	}
+	if p.sequencer != nil {
+		evt.AggregateID = contracts.IntentAggregate(event.IntentID)
+		sequence, err := p.sequencer.NextSequence(ctx, evt.AggregateID, eventID)
+		if err != nil {
+			return fmt.Errorf("failed to sequence tx_tracked event: %w", err)
+		}
+		evt.Sequence = sequence
+		headers[contracts.AggregateIDHeader] = evt.AggregateID
+		headers[contracts.SequenceHeader] = int64(sequence)
+	}
+
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tx_tracked event: %w", err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   contracts.CollectionsExchange,
+		RoutingKey: fmt.Sprintf("%s.%s", txTrackedPrefix, chainID),
+		Body:       body,
+		Headers:    headers,
+		Timestamp:  event.TrackedAt,
+		MessageID:  eventID,
+	}
+
+	if err := p.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("failed to publish tx_tracked event: %w", err)
+	}
+	return nil
+}
+
+// PublishCollectionImported publishes collection_imported on the collections exchange so the
+// catalog lists the collection and crawls its metadata
+func (p *EventPublisher) PublishCollectionImported(ctx context.Context, event domain.ImportedCollection) error {
+	chainID := strings.ReplaceAll(event.ChainID, ":", "-")
+	contract := strings.ToLower(event.Contract)
+
+	eventID := fmt.Sprintf("collection_imported_%s_%s", chainID, contract)
+
+	body, err := json.Marshal(intentEvent{
+		Schema:    eventSchemaV1,
+		Version:   "1.0",
+		EventID:   eventID,
+		EventType: "collection_imported",
+		ChainID:   chainID,
+		Contract:  contract,
+		Data: map[string]interface{}{
+			"collection_address": contract,
+			"collection_type":    string(event.Standard),
+			"owner":              event.Owner,
+			"name":               event.Name,
+			"symbol":             event.Symbol,
+			"contract_uri":       event.ContractURI,
+			"start_block":        event.StartBlock,
+			"imported_by":        event.ImportedBy,
+		},
+		Timestamp: event.ImportedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal collection_imported event: %w", err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   contracts.CollectionsExchange,
+		RoutingKey: fmt.Sprintf("%s.%s", collectionImportedPrefix, chainID),
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   "collection_imported",
+			"chain_id":     chainID,
+			"published_at": event.ImportedAt.Unix(),
+			"content_type": "application/json",
+		},
+		Timestamp: event.ImportedAt,
+		MessageID: eventID,
+	}
+
+	if err := p.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("failed to publish collection_imported event: %w", err)
+	}
+	return nil
+}
@@ -2,13 +2,11 @@ package grpc_handler
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/utils"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
 	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GRPCHandler struct {
@@ -62,21 +60,223 @@ func (h *GRPCHandler) GetIntentStatus(ctx context.Context, req *orchestratorpb.G
 	return utils.ConvertIntentStatusResponse(result), nil
 }
 
-func (h *GRPCHandler) handleError(err error) error {
-	switch err {
-	case domain.ErrNotFound:
-		return status.Error(codes.NotFound, "intent not found")
-	case domain.ErrInvalidInput:
-		return status.Error(codes.InvalidArgument, "invalid input")
-	case domain.ErrDuplicateTx:
-		return status.Error(codes.AlreadyExists, "duplicate transaction")
-	case domain.ErrUnsupportedStd:
-		return status.Error(codes.InvalidArgument, "unsupported standard")
-	case domain.ErrUnauthenticated:
-		return status.Error(codes.Unauthenticated, "unauthenticated")
-	case domain.ErrSessionTimeout:
-		return status.Error(codes.DeadlineExceeded, "session validation timeout")
-	default:
-		return status.Error(codes.Internal, fmt.Sprintf("internal error: %v", err))
+func (h *GRPCHandler) ListIntents(ctx context.Context, req *orchestratorpb.ListIntentsRequest) (*orchestratorpb.ListIntentsResponse, error) {
+	intents, err := h.svc.ListIntents(ctx, utils.ConvertListIntentsRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertListIntentsResponse(intents), nil
+}
+
+func (h *GRPCHandler) PrepareUpdateRoyalty(ctx context.Context, req *orchestratorpb.PrepareUpdateRoyaltyRequest) (*orchestratorpb.PrepareCollectionAdminResponse, error) {
+	result, err := h.svc.PrepareUpdateRoyalty(ctx, utils.ConvertUpdateRoyaltyRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertCollectionAdminResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareTransferCollectionOwnership(ctx context.Context, req *orchestratorpb.PrepareTransferCollectionOwnershipRequest) (*orchestratorpb.PrepareCollectionAdminResponse, error) {
+	result, err := h.svc.PrepareTransferCollectionOwnership(ctx, utils.ConvertTransferCollectionOwnershipRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertCollectionAdminResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareSetBaseURI(ctx context.Context, req *orchestratorpb.PrepareSetBaseURIRequest) (*orchestratorpb.PrepareCollectionAdminResponse, error) {
+	result, err := h.svc.PrepareSetBaseURI(ctx, utils.ConvertSetBaseURIRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertCollectionAdminResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareSetPayoutSplits(ctx context.Context, req *orchestratorpb.PrepareSetPayoutSplitsRequest) (*orchestratorpb.PrepareCollectionAdminResponse, error) {
+	result, err := h.svc.PrepareSetPayoutSplits(ctx, utils.ConvertSetPayoutSplitsRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertCollectionAdminResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareCreateAuction(ctx context.Context, req *orchestratorpb.PrepareCreateAuctionRequest) (*orchestratorpb.PrepareAuctionResponse, error) {
+	result, err := h.svc.PrepareCreateAuction(ctx, utils.ConvertCreateAuctionRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertAuctionResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareBid(ctx context.Context, req *orchestratorpb.PrepareBidRequest) (*orchestratorpb.PrepareAuctionResponse, error) {
+	result, err := h.svc.PrepareBid(ctx, utils.ConvertBidRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertAuctionResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareSettleAuction(ctx context.Context, req *orchestratorpb.PrepareSettleAuctionRequest) (*orchestratorpb.PrepareAuctionResponse, error) {
+	result, err := h.svc.PrepareSettleAuction(ctx, utils.ConvertSettleAuctionRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertAuctionResponse(result), nil
+}
+
+func (h *GRPCHandler) GetCollectionDefaults(ctx context.Context, req *orchestratorpb.GetCollectionDefaultsRequest) (*orchestratorpb.GetCollectionDefaultsResponse, error) {
+	result, err := h.svc.GetCollectionDefaults(ctx, req.ChainId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertCollectionDefaultsResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareImportCollection(ctx context.Context, req *orchestratorpb.PrepareImportCollectionRequest) (*orchestratorpb.PrepareImportCollectionResponse, error) {
+	result, err := h.svc.PrepareImportCollection(ctx, utils.ConvertPrepareImportCollectionRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertImportChallengeResponse(result), nil
+}
+
+func (h *GRPCHandler) ImportCollection(ctx context.Context, req *orchestratorpb.ImportCollectionRequest) (*orchestratorpb.ImportCollectionResponse, error) {
+	result, err := h.svc.ImportCollection(ctx, utils.ConvertImportCollectionRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertImportCollectionResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareAirdrop(ctx context.Context, req *orchestratorpb.PrepareAirdropRequest) (*orchestratorpb.PrepareAirdropResponse, error) {
+	result, err := h.svc.PrepareAirdrop(ctx, utils.ConvertPrepareAirdropRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertAirdropBundleResponse(result), nil
+}
+
+func (h *GRPCHandler) GetAirdropProgress(ctx context.Context, req *orchestratorpb.GetAirdropProgressRequest) (*orchestratorpb.GetAirdropProgressResponse, error) {
+	result, err := h.svc.GetAirdropProgress(ctx, req.BundleId, req.UserId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertAirdropProgressResponse(result), nil
+}
+
+func (h *GRPCHandler) PrepareBulkTransfer(ctx context.Context, req *orchestratorpb.PrepareBulkTransferRequest) (*orchestratorpb.PrepareBulkTransferResponse, error) {
+	result, err := h.svc.PrepareBulkTransfer(ctx, utils.ConvertPrepareBulkTransferRequest(req))
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertBulkTransferBundleResponse(result), nil
+}
+
+func (h *GRPCHandler) GetBulkTransferProgress(ctx context.Context, req *orchestratorpb.GetBulkTransferProgressRequest) (*orchestratorpb.GetBulkTransferProgressResponse, error) {
+	result, err := h.svc.GetBulkTransferProgress(ctx, req.BundleId, req.UserId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertBulkTransferProgressResponse(result), nil
+}
+
+func (h *GRPCHandler) AllowCallTarget(ctx context.Context, req *orchestratorpb.AllowCallTargetRequest) (*orchestratorpb.AllowCallTargetResponse, error) {
+	result, err := h.svc.AllowCallTarget(ctx, req.ChainId, req.Address, req.Reason, req.ActorId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &orchestratorpb.AllowCallTargetResponse{Override: utils.ConvertCallTargetOverride(result)}, nil
+}
+
+func (h *GRPCHandler) RevokeCallTarget(ctx context.Context, req *orchestratorpb.RevokeCallTargetRequest) (*orchestratorpb.RevokeCallTargetResponse, error) {
+	revoked, err := h.svc.RevokeCallTarget(ctx, req.ChainId, req.Address, req.ActorId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &orchestratorpb.RevokeCallTargetResponse{Revoked: revoked}, nil
+}
+
+func (h *GRPCHandler) ListCallTargetOverrides(ctx context.Context, req *orchestratorpb.ListCallTargetOverridesRequest) (*orchestratorpb.ListCallTargetOverridesResponse, error) {
+	result, err := h.svc.ListCallTargetOverrides(ctx, req.ChainId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return utils.ConvertListCallTargetOverridesResponse(result), nil
+}
+
+func (h *GRPCHandler) ReserveSponsorship(ctx context.Context, req *orchestratorpb.ReserveSponsorshipRequest) (*orchestratorpb.ReserveSponsorshipResponse, error) {
+	grant, err := h.svc.ReserveSponsorship(ctx, req.IntentId, req.GasGwei)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &orchestratorpb.ReserveSponsorshipResponse{Grant: utils.ConvertSponsorshipGrant(grant)}, nil
+}
+
+func (h *GRPCHandler) SettleSponsorship(ctx context.Context, req *orchestratorpb.SettleSponsorshipRequest) (*orchestratorpb.SettleSponsorshipResponse, error) {
+	charged, err := h.svc.SettleSponsorship(ctx, req.GrantId, req.SpentGwei, req.TxHash)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &orchestratorpb.SettleSponsorshipResponse{Charged: charged}, nil
+}
+
+func (h *GRPCHandler) ReleaseSponsorship(ctx context.Context, req *orchestratorpb.ReleaseSponsorshipRequest) (*orchestratorpb.ReleaseSponsorshipResponse, error) {
+	if err := h.svc.ReleaseSponsorship(ctx, req.GrantId); err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &orchestratorpb.ReleaseSponsorshipResponse{}, nil
+}
+
+func (h *GRPCHandler) TopUpSponsorshipBudget(ctx context.Context, req *orchestratorpb.TopUpSponsorshipBudgetRequest) (*orchestratorpb.TopUpSponsorshipBudgetResponse, error) {
+	budget, err := h.svc.TopUpSponsorshipBudget(ctx, req.ChainId, req.AmountGwei, req.Note, req.ActorId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &orchestratorpb.TopUpSponsorshipBudgetResponse{Budget: utils.ConvertSponsorshipBudget(budget)}, nil
+}
+
+func (h *GRPCHandler) SetSponsorshipBudgetFrozen(ctx context.Context, req *orchestratorpb.SetSponsorshipBudgetFrozenRequest) (*orchestratorpb.SetSponsorshipBudgetFrozenResponse, error) {
+	budget, err := h.svc.SetSponsorshipBudgetFrozen(ctx, req.ChainId, req.Frozen, req.Reason, req.ActorId)
+	if err != nil {
+		return nil, h.handleError(err)
+	}
+
+	return &orchestratorpb.SetSponsorshipBudgetFrozenResponse{Budget: utils.ConvertSponsorshipBudget(budget)}, nil
+}
+
+func (h *GRPCHandler) ListSponsorshipBudgets(ctx context.Context, req *orchestratorpb.ListSponsorshipBudgetsRequest) (*orchestratorpb.ListSponsorshipBudgetsResponse, error) {
+	budgets, err := h.svc.ListSponsorshipBudgets(ctx)
+	if err != nil {
+		return nil, h.handleError(err)
 	}
+
+	return utils.ConvertListSponsorshipBudgetsResponse(budgets), nil
+}
+
+// handleError maps domain errors to gRPC statuses through their codes; field-level
+// validation failures keep telling the caller which bound was broken
+func (h *GRPCHandler) handleError(err error) error {
+	return errs.ToGRPC(err)
 }
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// BundleRepo stores intent bundles next to their intents
+type BundleRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewBundleRepo(pg *postgres.Postgres) domain.IntentBundleRepo {
+	return &BundleRepo{pg: pg}
+}
+
+func (r *BundleRepo) CreateBundle(ctx context.Context, bundle *domain.IntentBundle, intents []*domain.Intent) error {
+	tx, err := r.pg.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin bundle tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, CreateBundleQuery,
+		bundle.ID, bundle.Kind, bundle.ChainID, bundle.Contract, bundle.CreatedBy,
+		bundle.RecipientCount, bundle.BatchCount, bundle.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("insert bundle: %w", err)
+	}
+
+	for seq, it := range intents {
+		reqPayloadJSON, err := json.Marshal(it.ReqPayloadJSON)
+		if err != nil {
+			return fmt.Errorf("marshal req payload: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, CreateIntentQuery,
+			it.ID, it.Kind, it.ChainID, it.PreviewAddress, it.TxHash, it.Status,
+			it.CreatedBy, reqPayloadJSON, it.Error, it.DeadlineAt, it.CreatedAt, it.UpdatedAt, it.AuthSessionID, it.Signer,
+		); err != nil {
+			return fmt.Errorf("insert intent: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, AddBundleItemQuery, bundle.ID, seq, it.ID); err != nil {
+			return fmt.Errorf("insert bundle item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit bundle: %w", err)
+	}
+	return nil
+}
+
+func (r *BundleRepo) GetBundle(ctx context.Context, bundleID string) (*domain.IntentBundle, error) {
+	var b domain.IntentBundle
+	err := r.pg.GetClient().QueryRowContext(ctx, GetBundleQuery, bundleID).Scan(
+		&b.ID, &b.Kind, &b.ChainID, &b.Contract, &b.CreatedBy, &b.RecipientCount, &b.BatchCount, &b.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("get bundle: %w", err)
+	}
+	return &b, nil
+}
+
+// ListBundleIntents returns the bundle's intents in batch order
+func (r *BundleRepo) ListBundleIntents(ctx context.Context, bundleID string) ([]*domain.Intent, error) {
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListBundleIntentsQuery, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("list bundle intents: %w", err)
+	}
+	defer rows.Close()
+
+	var intents []*domain.Intent
+	for rows.Next() {
+		var it domain.Intent
+		var reqPayloadJSON []byte
+		if err := rows.Scan(
+			&it.ID, &it.Kind, &it.ChainID, &it.PreviewAddress, &it.TxHash, &it.Status,
+			&it.CreatedBy, &reqPayloadJSON, &it.Error, &it.DeadlineAt, &it.CreatedAt, &it.UpdatedAt, &it.AuthSessionID, &it.Signer, &it.StatusSeq,
+		); err != nil {
+			return nil, fmt.Errorf("scan intent: %w", err)
+		}
+		if len(reqPayloadJSON) > 0 {
+			if err := json.Unmarshal(reqPayloadJSON, &it.ReqPayloadJSON); err != nil {
+				return nil, fmt.Errorf("unmarshal req payload: %w", err)
+			}
+		}
+		intents = append(intents, &it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list bundle intents: %w", err)
+	}
+	return intents, nil
+}
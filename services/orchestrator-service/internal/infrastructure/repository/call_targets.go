@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// CallTargetRepo stores the call target overrides admins granted
+type CallTargetRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewCallTargetRepo(pg *postgres.Postgres) domain.CallTargetOverrideRepo {
+	return &CallTargetRepo{pg: pg}
+}
+
+func (r *CallTargetRepo) HasOverride(ctx context.Context, chainID domain.ChainID, address domain.Address) (bool, error) {
+	var ok bool
+	if err := r.pg.GetClient().QueryRowContext(ctx, HasCallTargetOverrideQuery, chainID, address).Scan(&ok); err != nil {
+		return false, fmt.Errorf("get call target override: %w", err)
+	}
+	return ok, nil
+}
+
+func (r *CallTargetRepo) PutOverride(ctx context.Context, o *domain.CallTargetOverride) error {
+	if _, err := r.pg.GetClient().ExecContext(ctx, PutCallTargetOverrideQuery,
+		o.ChainID, o.Address, o.Reason, o.GrantedBy, o.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("put call target override: %w", err)
+	}
+	return nil
+}
+
+func (r *CallTargetRepo) DeleteOverride(ctx context.Context, chainID domain.ChainID, address domain.Address) (bool, error) {
+	res, err := r.pg.GetClient().ExecContext(ctx, DeleteCallTargetOverrideQuery, chainID, address)
+	if err != nil {
+		return false, fmt.Errorf("delete call target override: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *CallTargetRepo) ListOverrides(ctx context.Context, chainID domain.ChainID) ([]*domain.CallTargetOverride, error) {
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListCallTargetOverridesQuery, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("list call target overrides: %w", err)
+	}
+	defer rows.Close()
+
+	overrides := []*domain.CallTargetOverride{}
+	for rows.Next() {
+		var o domain.CallTargetOverride
+		if err := rows.Scan(&o.ChainID, &o.Address, &o.Reason, &o.GrantedBy, &o.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan call target override: %w", err)
+		}
+		overrides = append(overrides, &o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate call target overrides: %w", err)
+	}
+	return overrides, nil
+}
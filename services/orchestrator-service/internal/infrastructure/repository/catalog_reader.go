@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// CatalogReader reads collection ownership data written by catalog-service
+type CatalogReader struct {
+	pg *postgres.Postgres
+}
+
+func NewCatalogReader(pg *postgres.Postgres) *CatalogReader {
+	return &CatalogReader{pg: pg}
+}
+
+func (r *CatalogReader) GetCollectionCreator(ctx context.Context, chainID domain.ChainID, contract domain.Address) (domain.Address, error) {
+	var creator string
+	err := r.pg.GetClient().QueryRowContext(ctx, GetCollectionCreatorQuery, chainID, contract).Scan(&creator)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", domain.ErrCollectionNotFound
+		}
+		return "", fmt.Errorf("get collection creator: %w", err)
+	}
+	return domain.Address(strings.ToLower(creator)), nil
+}
+
+func (r *CatalogReader) GetCollectionOwnerOrg(ctx context.Context, chainID domain.ChainID, contract domain.Address) (string, error) {
+	var orgID string
+	err := r.pg.GetClient().QueryRowContext(ctx, GetCollectionOwnerOrgQuery, chainID, contract).Scan(&orgID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", domain.ErrCollectionNotFound
+		}
+		return "", fmt.Errorf("get collection owner org: %w", err)
+	}
+	return orgID, nil
+}
+
+// GetCollectionStandard returns the token standard the catalog indexed the collection as
+func (r *CatalogReader) GetCollectionStandard(ctx context.Context, chainID domain.ChainID, contract domain.Address) (domain.Standard, error) {
+	var standard string
+	err := r.pg.GetClient().QueryRowContext(ctx, GetCollectionStandardQuery, chainID, contract).Scan(&standard)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", domain.ErrCollectionNotFound
+		}
+		return "", fmt.Errorf("get collection standard: %w", err)
+	}
+	return domain.Standard(standard), nil
+}
+
+// TokenBalances returns how many of each token owner holds according to the transfer
+// index; tokens it doesn't hold are left out
+func (r *CatalogReader) TokenBalances(ctx context.Context, chainID domain.ChainID, contract, owner domain.Address, tokenIDs []string) (map[string]*big.Int, error) {
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListTokenBalancesQuery, chainID, contract, strings.ToLower(owner), pq.Array(tokenIDs))
+	if err != nil {
+		return nil, fmt.Errorf("list token balances: %w", err)
+	}
+	defer rows.Close()
+
+	balances := make(map[string]*big.Int)
+	for rows.Next() {
+		var tokenID, quantity string
+		if err := rows.Scan(&tokenID, &quantity); err != nil {
+			return nil, fmt.Errorf("scan token balance: %w", err)
+		}
+		balance, ok := new(big.Int).SetString(quantity, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid balance %q of token %s", quantity, tokenID)
+		}
+		if balance.Sign() > 0 {
+			balances[tokenID] = balance
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list token balances: %w", err)
+	}
+	return balances, nil
+}
+
+// ListSnapshotHolders returns the holders of a snapshot userID requested, largest first
+func (r *CatalogReader) ListSnapshotHolders(ctx context.Context, snapshotID, userID string) ([]domain.Address, error) {
+	var requestedBy string
+	err := r.pg.GetClient().QueryRowContext(ctx, GetHolderSnapshotRequesterQuery, snapshotID).Scan(&requestedBy)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSnapshotNotFound
+		}
+		return nil, fmt.Errorf("get holder snapshot: %w", err)
+	}
+	if requestedBy != userID {
+		return nil, domain.ErrSnapshotNotFound
+	}
+
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListSnapshotHoldersQuery, snapshotID)
+	if err != nil {
+		return nil, fmt.Errorf("list snapshot holders: %w", err)
+	}
+	defer rows.Close()
+
+	var holders []domain.Address
+	for rows.Next() {
+		var holder string
+		if err := rows.Scan(&holder); err != nil {
+			return nil, fmt.Errorf("scan snapshot holder: %w", err)
+		}
+		holders = append(holders, domain.Address(holder))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list snapshot holders: %w", err)
+	}
+	return holders, nil
+}
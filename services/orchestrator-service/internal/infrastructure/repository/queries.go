@@ -4,38 +4,288 @@ const (
 	CreateIntentQuery = `
 		INSERT INTO tx_intents (
 			intent_id, kind, chain_id, preview_address, tx_hash, status, 
-			created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id, signer
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
 
 	UpdateTxHashQuery = `
 		UPDATE tx_intents 
-		SET tx_hash = $1, preview_address = $2, updated_at = $3
+		SET tx_hash = $1, preview_address = $2, updated_at = $3, status_seq = status_seq + 1
 		WHERE intent_id = $4
 	`
 
 	UpdateStatusQuery = `
 		UPDATE tx_intents 
-		SET status = $1, error = $2, updated_at = $3
+		SET status = $1, error = $2, updated_at = $3, status_seq = status_seq + 1
 		WHERE intent_id = $4
 	`
 
+	TrackTxQuery = `
+		UPDATE tx_intents
+		SET tx_hash = $1, preview_address = $2, status = 'pending', error = NULL,
+			updated_at = $3, status_seq = status_seq + 1
+		WHERE intent_id = $4
+		RETURNING status_seq
+	`
+
 	GetByIDQuery = `
 		SELECT intent_id, kind, chain_id, preview_address, tx_hash, status,
-			   created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id
+			   created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id, signer, status_seq
 		FROM tx_intents 
 		WHERE intent_id = $1
 	`
 
 	FindByChainTxQuery = `
 		SELECT intent_id, kind, chain_id, preview_address, tx_hash, status,
-			   created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id
+			   created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id, signer, status_seq
 		FROM tx_intents 
 		WHERE chain_id = $1 AND tx_hash = $2
 	`
 
+	// $2/$3 is the (created_at, intent_id) of the last intent of the previous page
+	ListBySignerQuery = `
+		SELECT intent_id, kind, chain_id, preview_address, tx_hash, status,
+			   created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id, signer, status_seq
+		FROM tx_intents
+		WHERE LOWER(signer) = LOWER($1)
+		  AND ($2::timestamptz IS NULL OR (created_at, intent_id) < ($2, $3::uuid))
+		ORDER BY created_at DESC, intent_id DESC
+		LIMIT $4
+	`
+
+	// Confirmations only reach the status cache, so intents stay pending here once mined
+	ListAwaitingConfirmationQuery = `
+		SELECT intent_id, kind, chain_id, preview_address, tx_hash, status,
+			   created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id, signer, status_seq
+		FROM tx_intents
+		WHERE status = 'pending' AND tx_hash IS NOT NULL
+		  AND (updated_at, intent_id) > ($1, $2::uuid)
+		  AND updated_at < $3
+		ORDER BY updated_at, intent_id
+		LIMIT $4
+	`
+
+	// Only what the status overlay needs; a bundle's batches report the bundle
+	ListOpenIntentsQuery = `
+		SELECT i.intent_id, i.kind, i.chain_id, i.preview_address, i.tx_hash, i.status,
+			   i.error, i.status_seq, COALESCE(b.bundle_id::text, '')
+		FROM tx_intents i
+		LEFT JOIN intent_bundle_items b ON b.intent_id = i.intent_id
+		WHERE i.status = 'pending' AND i.updated_at > $2
+		  AND COALESCE(i.created_by::text, LOWER(i.signer)) = $1
+		ORDER BY i.updated_at DESC
+		LIMIT $3
+	`
+
+	// Intents given a preview address store an empty tx hash until they are tracked
+	ExpireUnsentQuery = `
+		UPDATE tx_intents
+		SET status = 'expired', error = $3, updated_at = $1, status_seq = status_seq + 1
+		WHERE intent_id IN (
+			SELECT intent_id FROM tx_intents
+			WHERE status = 'pending' AND COALESCE(tx_hash, '') = ''
+			  AND COALESCE(deadline_at, created_at + $2::interval) < $1
+			ORDER BY created_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING intent_id, kind, chain_id, preview_address, tx_hash, status,
+			   created_by, req_payload_json, error, deadline_at, created_at, updated_at, auth_session_id, signer, status_seq
+	`
+
+	AttachMediaQuery = `
+		INSERT INTO intent_media_refs (intent_id, asset_id)
+		SELECT $1, UNNEST($2::text[])
+		ON CONFLICT (intent_id, asset_id) DO NOTHING
+	`
+
+	ListReleasableMediaQuery = `
+		SELECT m.intent_id, m.asset_id
+		FROM intent_media_refs m
+		JOIN tx_intents i ON i.intent_id = m.intent_id
+		WHERE m.released_at IS NULL AND i.status IN ('failed', 'expired')
+		ORDER BY m.created_at
+		LIMIT $1
+	`
+
+	MarkMediaReleasedQuery = `
+		UPDATE intent_media_refs
+		SET released_at = now()
+		WHERE intent_id = $1 AND asset_id = $2
+	`
+
+	CreateBundleQuery = `
+		INSERT INTO intent_bundles (
+			bundle_id, kind, chain_id, contract, created_by, recipient_count, batch_count, created_at
+		) VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, $8)
+	`
+
+	AddBundleItemQuery = `
+		INSERT INTO intent_bundle_items (bundle_id, seq, intent_id)
+		VALUES ($1, $2, $3)
+	`
+
+	GetBundleQuery = `
+		SELECT bundle_id, kind, chain_id, COALESCE(contract, ''), created_by, recipient_count, batch_count, created_at
+		FROM intent_bundles
+		WHERE bundle_id = $1
+	`
+
+	ListBundleIntentsQuery = `
+		SELECT i.intent_id, i.kind, i.chain_id, i.preview_address, i.tx_hash, i.status,
+			   i.created_by, i.req_payload_json, i.error, i.deadline_at, i.created_at, i.updated_at, i.auth_session_id, i.signer, i.status_seq
+		FROM intent_bundle_items b
+		JOIN tx_intents i ON i.intent_id = b.intent_id
+		WHERE b.bundle_id = $1
+		ORDER BY b.seq
+	`
+
 	InsertSessionIntentAuditQuery = `
 		INSERT INTO session_intent_audit (session_id, intent_id, user_id, audit_data)
 		VALUES ($1, $2, $3, $4)
 	`
+
+	// collections is owned by catalog-service; both services share the same database.
+	// The indexer stores chain ids as "eip155-1" while intents use CAIP-2 "eip155:1".
+	GetCollectionCreatorQuery = `
+		SELECT creator
+		FROM collections
+		WHERE REPLACE(chain_id, '-', ':') = REPLACE($1, '-', ':') AND LOWER(contract_address) = LOWER($2)
+		LIMIT 1
+	`
+
+	// holder_snapshots and holder_snapshot_entries are written by catalog-service too
+	GetHolderSnapshotRequesterQuery = `
+		SELECT requested_by
+		FROM holder_snapshots
+		WHERE id = $1
+	`
+
+	ListSnapshotHoldersQuery = `
+		SELECT holder
+		FROM holder_snapshot_entries
+		WHERE snapshot_id = $1
+		ORDER BY balance DESC, holder
+	`
+
+	GetCollectionStandardQuery = `
+		SELECT UPPER(collection_type)
+		FROM collections
+		WHERE REPLACE(chain_id, '-', ':') = REPLACE($1, '-', ':') AND LOWER(contract_address) = LOWER($2)
+		LIMIT 1
+	`
+
+	// ownership_transfers is the catalog's transfer index, stored with '-' chain ids; a
+	// wallet holds what it received less what it sent
+	ListTokenBalancesQuery = `
+		SELECT token_id,
+			   (SUM(CASE WHEN to_addr = $3 THEN quantity ELSE 0 END) -
+				SUM(CASE WHEN from_addr = $3 THEN quantity ELSE 0 END))::text
+		FROM ownership_transfers
+		WHERE chain_id = REPLACE($1, ':', '-') AND contract = LOWER($2)
+		  AND token_id = ANY($4) AND (to_addr = $3 OR from_addr = $3)
+		GROUP BY token_id
+	`
+
+	GetCollectionOwnerOrgQuery = `
+		SELECT COALESCE(owner_org_id::text, '')
+		FROM collections
+		WHERE REPLACE(chain_id, '-', ':') = REPLACE($1, '-', ':') AND LOWER(contract_address) = LOWER($2)
+		LIMIT 1
+	`
+
+	HasCallTargetOverrideQuery = `
+		SELECT EXISTS (
+			SELECT 1 FROM call_target_overrides WHERE chain_id = $1 AND address = LOWER($2)
+		)
+	`
+
+	PutCallTargetOverrideQuery = `
+		INSERT INTO call_target_overrides (chain_id, address, reason, granted_by, created_at)
+		VALUES ($1, LOWER($2), $3, $4, $5)
+		ON CONFLICT (chain_id, address) DO UPDATE SET
+			reason = EXCLUDED.reason,
+			granted_by = EXCLUDED.granted_by,
+			created_at = EXCLUDED.created_at
+	`
+
+	DeleteCallTargetOverrideQuery = `
+		DELETE FROM call_target_overrides WHERE chain_id = $1 AND address = LOWER($2)
+	`
+
+	ListCallTargetOverridesQuery = `
+		SELECT chain_id, address, reason, granted_by, created_at
+		FROM call_target_overrides
+		WHERE $1 = '' OR chain_id = $1
+		ORDER BY chain_id, created_at DESC
+	`
+
+	GetEventSequenceStampQuery = `
+		SELECT sequence FROM event_sequence_stamps WHERE event_id = $1
+	`
+
+	AdvanceEventSequenceQuery = `
+		INSERT INTO event_sequences (aggregate_id, last_sequence, updated_at)
+		VALUES ($1, 1, now())
+		ON CONFLICT (aggregate_id) DO UPDATE SET
+			last_sequence = event_sequences.last_sequence + 1,
+			updated_at = now()
+		RETURNING last_sequence
+	`
+
+	InsertEventSequenceStampQuery = `
+		INSERT INTO event_sequence_stamps (event_id, aggregate_id, sequence) VALUES ($1, $2, $3)
+	`
+
+	GetSponsorshipBudgetQuery = `
+		SELECT chain_id, balance_gwei, topped_up_gwei, spent_gwei, frozen, frozen_reason, updated_by, updated_at
+		FROM sponsorship_budgets WHERE chain_id = $1
+	`
+
+	ListSponsorshipBudgetsQuery = `
+		SELECT chain_id, balance_gwei, topped_up_gwei, spent_gwei, frozen, frozen_reason, updated_by, updated_at
+		FROM sponsorship_budgets ORDER BY chain_id
+	`
+
+	TopUpSponsorshipBudgetQuery = `
+		INSERT INTO sponsorship_budgets (chain_id, balance_gwei, topped_up_gwei, updated_by, updated_at)
+		VALUES ($1, $2, $2, $3, $4)
+		ON CONFLICT (chain_id) DO UPDATE SET
+			balance_gwei = sponsorship_budgets.balance_gwei + EXCLUDED.balance_gwei,
+			topped_up_gwei = sponsorship_budgets.topped_up_gwei + EXCLUDED.topped_up_gwei,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at
+		RETURNING chain_id, balance_gwei, topped_up_gwei, spent_gwei, frozen, frozen_reason, updated_by, updated_at
+	`
+
+	InsertSponsorshipTopUpQuery = `
+		INSERT INTO sponsorship_ledger (chain_id, entry, amount_gwei, actor_id, note, created_at)
+		VALUES ($1, 'top_up', $2, $3, $4, $5)
+	`
+
+	SetSponsorshipBudgetFrozenQuery = `
+		INSERT INTO sponsorship_budgets (chain_id, frozen, frozen_reason, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (chain_id) DO UPDATE SET
+			frozen = EXCLUDED.frozen,
+			frozen_reason = EXCLUDED.frozen_reason,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at
+		RETURNING chain_id, balance_gwei, topped_up_gwei, spent_gwei, frozen, frozen_reason, updated_by, updated_at
+	`
+
+	InsertSponsorshipSettlementQuery = `
+		INSERT INTO sponsorship_ledger (chain_id, entry, amount_gwei, grant_id, intent_id, collection, user_id, tx_hash, created_at)
+		VALUES ($1, 'settlement', $2, $3, $4, $5, $6, NULLIF($7, ''), $8)
+		ON CONFLICT (grant_id) DO NOTHING
+	`
+
+	ChargeSponsorshipBudgetQuery = `
+		INSERT INTO sponsorship_budgets (chain_id, balance_gwei, spent_gwei, updated_at)
+		VALUES ($1, -$2::BIGINT, $2, $3)
+		ON CONFLICT (chain_id) DO UPDATE SET
+			balance_gwei = sponsorship_budgets.balance_gwei - EXCLUDED.spent_gwei,
+			spent_gwei = sponsorship_budgets.spent_gwei + EXCLUDED.spent_gwei,
+			updated_at = EXCLUDED.updated_at
+	`
 )
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	sharedredis "github.com/quangdang46/NFT-Marketplace/shared/redis"
@@ -30,7 +32,7 @@ func (r *Repo) Create(ctx context.Context, it *domain.Intent) error {
 
 	_, err = r.pg.GetClient().ExecContext(ctx, CreateIntentQuery,
 		it.ID, it.Kind, it.ChainID, it.PreviewAddress, it.TxHash, it.Status,
-		it.CreatedBy, reqPayloadJSON, it.Error, it.DeadlineAt, it.CreatedAt, it.UpdatedAt, it.AuthSessionID,
+		it.CreatedBy, reqPayloadJSON, it.Error, it.DeadlineAt, it.CreatedAt, it.UpdatedAt, it.AuthSessionID, it.Signer,
 	)
 	if err != nil {
 		return fmt.Errorf("insert intent: %w", err)
@@ -57,13 +59,26 @@ func (r *Repo) UpdateStatus(ctx context.Context, intentID string, status domain.
 	return nil
 }
 
+func (r *Repo) TrackTx(ctx context.Context, intentID string, txHash string, contractAddr *domain.Address) (int64, error) {
+	var seq int64
+	err := r.pg.GetClient().QueryRowContext(ctx, TrackTxQuery, txHash, contractAddr, time.Now(), intentID).Scan(&seq)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, domain.ErrNotFound
+		}
+		return 0, fmt.Errorf("track tx: %w", err)
+	}
+
+	return seq, nil
+}
+
 func (r *Repo) GetByID(ctx context.Context, intentID string) (*domain.Intent, error) {
 	var it domain.Intent
 	var reqPayloadJSON []byte
 
 	err := r.pg.GetClient().QueryRowContext(ctx, GetByIDQuery, intentID).Scan(
 		&it.ID, &it.Kind, &it.ChainID, &it.PreviewAddress, &it.TxHash, &it.Status,
-		&it.CreatedBy, &reqPayloadJSON, &it.Error, &it.DeadlineAt, &it.CreatedAt, &it.UpdatedAt, &it.AuthSessionID,
+		&it.CreatedBy, &reqPayloadJSON, &it.Error, &it.DeadlineAt, &it.CreatedAt, &it.UpdatedAt, &it.AuthSessionID, &it.Signer, &it.StatusSeq,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -90,7 +105,7 @@ func (r *Repo) FindByChainTx(ctx context.Context, chainID domain.ChainID, txHash
 
 	err := r.pg.GetClient().QueryRowContext(ctx, FindByChainTxQuery, chainID, txHash).Scan(
 		&it.ID, &it.Kind, &it.ChainID, &it.PreviewAddress, &it.TxHash, &it.Status,
-		&it.CreatedBy, &reqPayloadJSON, &it.Error, &it.DeadlineAt, &it.CreatedAt, &it.UpdatedAt, &it.AuthSessionID,
+		&it.CreatedBy, &reqPayloadJSON, &it.Error, &it.DeadlineAt, &it.CreatedAt, &it.UpdatedAt, &it.AuthSessionID, &it.Signer, &it.StatusSeq,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -109,6 +124,175 @@ func (r *Repo) FindByChainTx(ctx context.Context, chainID domain.ChainID, txHash
 	return &it, nil
 }
 
+// ListBySigner pages the intents a wallet was asked to sign, newest first
+func (r *Repo) ListBySigner(ctx context.Context, in domain.ListIntentsInput) ([]*domain.Intent, error) {
+	var beforeID *string
+	if in.Before != nil {
+		beforeID = &in.BeforeID
+	}
+
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListBySignerQuery, in.Signer, in.Before, beforeID, in.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list intents by signer: %w", err)
+	}
+	defer rows.Close()
+
+	var intents []*domain.Intent
+	for rows.Next() {
+		var it domain.Intent
+		var reqPayloadJSON []byte
+		if err := rows.Scan(
+			&it.ID, &it.Kind, &it.ChainID, &it.PreviewAddress, &it.TxHash, &it.Status,
+			&it.CreatedBy, &reqPayloadJSON, &it.Error, &it.DeadlineAt, &it.CreatedAt, &it.UpdatedAt, &it.AuthSessionID, &it.Signer, &it.StatusSeq,
+		); err != nil {
+			return nil, fmt.Errorf("scan intent: %w", err)
+		}
+		if len(reqPayloadJSON) > 0 {
+			if err := json.Unmarshal(reqPayloadJSON, &it.ReqPayloadJSON); err != nil {
+				return nil, fmt.Errorf("unmarshal req payload: %w", err)
+			}
+		}
+		intents = append(intents, &it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list intents by signer: %w", err)
+	}
+	return intents, nil
+}
+
+// ListAwaitingConfirmation pages pending intents that sent a tx, oldest update first
+func (r *Repo) ListAwaitingConfirmation(ctx context.Context, in domain.ListAwaitingInput) ([]*domain.Intent, error) {
+	afterID := in.AfterID
+	if afterID == "" {
+		afterID = uuid.Nil.String()
+	}
+
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListAwaitingConfirmationQuery, in.After, afterID, in.Until, in.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list intents awaiting confirmation: %w", err)
+	}
+	defer rows.Close()
+
+	var intents []*domain.Intent
+	for rows.Next() {
+		var it domain.Intent
+		var reqPayloadJSON []byte
+		if err := rows.Scan(
+			&it.ID, &it.Kind, &it.ChainID, &it.PreviewAddress, &it.TxHash, &it.Status,
+			&it.CreatedBy, &reqPayloadJSON, &it.Error, &it.DeadlineAt, &it.CreatedAt, &it.UpdatedAt, &it.AuthSessionID, &it.Signer, &it.StatusSeq,
+		); err != nil {
+			return nil, fmt.Errorf("scan intent: %w", err)
+		}
+		if len(reqPayloadJSON) > 0 {
+			if err := json.Unmarshal(reqPayloadJSON, &it.ReqPayloadJSON); err != nil {
+				return nil, fmt.Errorf("unmarshal req payload: %w", err)
+			}
+		}
+		intents = append(intents, &it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list intents awaiting confirmation: %w", err)
+	}
+	return intents, nil
+}
+
+// ListOpenIntents lists the owner's pending intents updated after since, newest first
+func (r *Repo) ListOpenIntents(ctx context.Context, owner string, since time.Time, limit int) ([]domain.OpenIntent, error) {
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListOpenIntentsQuery, owner, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list open intents: %w", err)
+	}
+	defer rows.Close()
+
+	var open []domain.OpenIntent
+	for rows.Next() {
+		var it domain.Intent
+		var bundleID string
+		if err := rows.Scan(
+			&it.ID, &it.Kind, &it.ChainID, &it.PreviewAddress, &it.TxHash, &it.Status,
+			&it.Error, &it.StatusSeq, &bundleID,
+		); err != nil {
+			return nil, fmt.Errorf("scan intent: %w", err)
+		}
+		open = append(open, domain.OpenIntent{Intent: &it, BundleID: bundleID})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list open intents: %w", err)
+	}
+	return open, nil
+}
+
+// ExpireUnsent marks pending intents that never sent a tx expired once past their deadline
+func (r *Repo) ExpireUnsent(ctx context.Context, now time.Time, limit int) ([]*domain.Intent, error) {
+	ttl := fmt.Sprintf("%d seconds", int64(domain.DefaultIntentTTL/time.Second))
+	rows, err := r.pg.GetClient().QueryContext(ctx, ExpireUnsentQuery, now, ttl, domain.IntentExpiredError, limit)
+	if err != nil {
+		return nil, fmt.Errorf("expire unsent intents: %w", err)
+	}
+	defer rows.Close()
+
+	var intents []*domain.Intent
+	for rows.Next() {
+		var it domain.Intent
+		var reqPayloadJSON []byte
+		if err := rows.Scan(
+			&it.ID, &it.Kind, &it.ChainID, &it.PreviewAddress, &it.TxHash, &it.Status,
+			&it.CreatedBy, &reqPayloadJSON, &it.Error, &it.DeadlineAt, &it.CreatedAt, &it.UpdatedAt, &it.AuthSessionID, &it.Signer, &it.StatusSeq,
+		); err != nil {
+			return nil, fmt.Errorf("scan intent: %w", err)
+		}
+		if len(reqPayloadJSON) > 0 {
+			if err := json.Unmarshal(reqPayloadJSON, &it.ReqPayloadJSON); err != nil {
+				return nil, fmt.Errorf("unmarshal req payload: %w", err)
+			}
+		}
+		intents = append(intents, &it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("expire unsent intents: %w", err)
+	}
+	return intents, nil
+}
+
+// AttachMedia records the assets an intent holds a media-service ref on
+func (r *Repo) AttachMedia(ctx context.Context, intentID string, assetIDs []string) error {
+	_, err := r.pg.GetClient().ExecContext(ctx, AttachMediaQuery, intentID, pq.Array(assetIDs))
+	if err != nil {
+		return fmt.Errorf("attach media: %w", err)
+	}
+	return nil
+}
+
+// ListReleasableMedia lists refs still held by failed or expired intents, oldest first
+func (r *Repo) ListReleasableMedia(ctx context.Context, limit int) ([]domain.MediaRef, error) {
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListReleasableMediaQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list releasable media: %w", err)
+	}
+	defer rows.Close()
+
+	var refs []domain.MediaRef
+	for rows.Next() {
+		var ref domain.MediaRef
+		if err := rows.Scan(&ref.IntentID, &ref.AssetID); err != nil {
+			return nil, fmt.Errorf("scan media ref: %w", err)
+		}
+		refs = append(refs, ref)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list releasable media: %w", err)
+	}
+	return refs, nil
+}
+
+func (r *Repo) MarkMediaReleased(ctx context.Context, ref domain.MediaRef) error {
+	_, err := r.pg.GetClient().ExecContext(ctx, MarkMediaReleasedQuery, ref.IntentID, ref.AssetID)
+	if err != nil {
+		return fmt.Errorf("mark media released: %w", err)
+	}
+	return nil
+}
+
 func (r *Repo) InsertSessionIntentAudit(ctx context.Context, sessionID string, intentID string, userID *string, auditData any) error {
 	payload, err := json.Marshal(auditData)
 	if err != nil {
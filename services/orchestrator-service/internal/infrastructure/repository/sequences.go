@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// EventSequenceRepo stamps intent events with per-intent sequences. Each event's stamp is
+// kept, so an event published again keeps its place.
+type EventSequenceRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewEventSequenceRepo(pg *postgres.Postgres) domain.EventSequencer {
+	return &EventSequenceRepo{pg: pg}
+}
+
+// NextSequence stamps eventID with the aggregate's next sequence unless it has a stamp
+// already. The counter row stays locked until the stamp is stored.
+func (r *EventSequenceRepo) NextSequence(ctx context.Context, aggregateID, eventID string) (uint64, error) {
+	tx, err := r.pg.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin sequence tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var sequence uint64
+	err = tx.QueryRowContext(ctx, GetEventSequenceStampQuery, eventID).Scan(&sequence)
+	if err == nil {
+		return sequence, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("get sequence stamp: %w", err)
+	}
+
+	if err := tx.QueryRowContext(ctx, AdvanceEventSequenceQuery, aggregateID).Scan(&sequence); err != nil {
+		return 0, fmt.Errorf("advance event sequence: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, InsertEventSequenceStampQuery, eventID, aggregateID, sequence); err != nil {
+		return 0, fmt.Errorf("stamp event sequence: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit event sequence: %w", err)
+	}
+	return sequence, nil
+}
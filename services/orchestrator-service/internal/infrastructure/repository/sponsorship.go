@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// SponsorshipRepo keeps the sponsorship budget of each chain and a ledger of its top-ups
+// and settled grants
+type SponsorshipRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewSponsorshipRepo(pg *postgres.Postgres) domain.SponsorshipLedger {
+	return &SponsorshipRepo{pg: pg}
+}
+
+// rowScanner is a *sql.Row or *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSponsorshipBudget(row rowScanner) (*domain.SponsorshipBudget, error) {
+	var b domain.SponsorshipBudget
+	if err := row.Scan(&b.ChainID, &b.BalanceGwei, &b.ToppedUpGwei, &b.SpentGwei, &b.Frozen, &b.FrozenReason, &b.UpdatedBy, &b.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *SponsorshipRepo) GetBudget(ctx context.Context, chainID domain.ChainID) (*domain.SponsorshipBudget, error) {
+	budget, err := scanSponsorshipBudget(r.pg.GetClient().QueryRowContext(ctx, GetSponsorshipBudgetQuery, chainID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domain.SponsorshipBudget{ChainID: chainID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get sponsorship budget: %w", err)
+	}
+	return budget, nil
+}
+
+func (r *SponsorshipRepo) ListBudgets(ctx context.Context) ([]*domain.SponsorshipBudget, error) {
+	rows, err := r.pg.GetClient().QueryContext(ctx, ListSponsorshipBudgetsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("list sponsorship budgets: %w", err)
+	}
+	defer rows.Close()
+
+	budgets := []*domain.SponsorshipBudget{}
+	for rows.Next() {
+		budget, err := scanSponsorshipBudget(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan sponsorship budget: %w", err)
+		}
+		budgets = append(budgets, budget)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate sponsorship budgets: %w", err)
+	}
+	return budgets, nil
+}
+
+// TopUpBudget adds to the budget and records the top-up in one transaction
+func (r *SponsorshipRepo) TopUpBudget(ctx context.Context, chainID domain.ChainID, amountGwei int64, note, actorID string, at time.Time) (*domain.SponsorshipBudget, error) {
+	tx, err := r.pg.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin top-up tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	budget, err := scanSponsorshipBudget(tx.QueryRowContext(ctx, TopUpSponsorshipBudgetQuery, chainID, amountGwei, actorID, at))
+	if err != nil {
+		return nil, fmt.Errorf("top up sponsorship budget: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, InsertSponsorshipTopUpQuery, chainID, amountGwei, actorID, note, at); err != nil {
+		return nil, fmt.Errorf("record sponsorship top-up: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit top-up: %w", err)
+	}
+	return budget, nil
+}
+
+func (r *SponsorshipRepo) SetBudgetFrozen(ctx context.Context, chainID domain.ChainID, frozen bool, reason, actorID string, at time.Time) (*domain.SponsorshipBudget, error) {
+	budget, err := scanSponsorshipBudget(r.pg.GetClient().QueryRowContext(ctx, SetSponsorshipBudgetFrozenQuery, chainID, frozen, reason, actorID, at))
+	if err != nil {
+		return nil, fmt.Errorf("set sponsorship budget frozen: %w", err)
+	}
+	return budget, nil
+}
+
+// SettleGrant records the settlement and charges the budget in one transaction; the unique
+// grant id keeps a retried settlement from charging twice
+func (r *SponsorshipRepo) SettleGrant(ctx context.Context, grant *domain.SponsorshipGrant, spentGwei int64, txHash string, at time.Time) (bool, error) {
+	tx, err := r.pg.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("begin settlement tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, InsertSponsorshipSettlementQuery,
+		grant.ChainID, spentGwei, grant.ID, grant.IntentID, grant.Collection, grant.UserID, txHash, at,
+	)
+	if err != nil {
+		return false, fmt.Errorf("record sponsorship settlement: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, ChargeSponsorshipBudgetQuery, grant.ChainID, spentGwei, at); err != nil {
+		return false, fmt.Errorf("charge sponsorship budget: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit settlement: %w", err)
+	}
+	return true, nil
+}
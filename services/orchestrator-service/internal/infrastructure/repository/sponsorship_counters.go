@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+// sponsorshipDayTTL keeps a day's counters until its caps can't be asked about anymore
+const sponsorshipDayTTL = 48 * time.Hour
+
+// holdGrantScript drops the chain's expired grants, then holds the grant unless its intent
+// holds one already, the collection or user would pass a daily cap or the live grants would
+// pass the balance. It returns the limit that refused the grant, or an empty string and the
+// id of the grant the intent holds.
+//
+// KEYS[1] collection day counter; KEYS[2] user day counter; KEYS[3] chain held grants;
+// KEYS[4] grant key; KEYS[5] intent grant key
+// ARGV[1] gas; ARGV[2] collection cap; ARGV[3] user cap; ARGV[4] balance; ARGV[5] now ms;
+// ARGV[6] grant expiry ms; ARGV[7] held member; ARGV[8] grant; ARGV[9] day counter ttl s;
+// ARGV[10] grant id
+var holdGrantScript = redislib.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[3], '-inf', ARGV[5])
+local existing = redis.call('GET', KEYS[5])
+if existing then
+	return {'', existing}
+end
+local gas = tonumber(ARGV[1])
+local collectionCap = tonumber(ARGV[2])
+if collectionCap > 0 and tonumber(redis.call('GET', KEYS[1]) or '0') + gas > collectionCap then
+	return {'collection_daily_cap', ''}
+end
+local userCap = tonumber(ARGV[3])
+if userCap > 0 and tonumber(redis.call('GET', KEYS[2]) or '0') + gas > userCap then
+	return {'user_daily_cap', ''}
+end
+local held = 0
+for _, member in ipairs(redis.call('ZRANGE', KEYS[3], 0, -1)) do
+	held = held + tonumber(string.match(member, ':(%d+)$'))
+end
+if held + gas > tonumber(ARGV[4]) then
+	return {'chain_budget', ''}
+end
+redis.call('INCRBY', KEYS[1], ARGV[1])
+redis.call('EXPIRE', KEYS[1], ARGV[9])
+redis.call('INCRBY', KEYS[2], ARGV[1])
+redis.call('EXPIRE', KEYS[2], ARGV[9])
+redis.call('ZADD', KEYS[3], ARGV[6], ARGV[7])
+redis.call('SET', KEYS[4], ARGV[8], 'PXAT', ARGV[6])
+redis.call('SET', KEYS[5], ARGV[10], 'PXAT', ARGV[6])
+return {'', ARGV[10]}
+`)
+
+// releaseGrantScript deletes the grant and frees what it held, correcting the day counters
+// by what it spent over or under its gas. A grant already gone is left alone.
+//
+// KEYS[1] grant key; KEYS[2] chain held grants; KEYS[3] collection day counter; KEYS[4]
+// user day counter; KEYS[5] intent grant key
+// ARGV[1] held member; ARGV[2] spent minus held gas; ARGV[3] grant id
+var releaseGrantScript = redislib.NewScript(`
+if redis.call('DEL', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('ZREM', KEYS[2], ARGV[1])
+if redis.call('GET', KEYS[5]) == ARGV[3] then
+	redis.call('DEL', KEYS[5])
+end
+if tonumber(ARGV[2]) ~= 0 then
+	for i = 3, 4 do
+		if redis.call('EXISTS', KEYS[i]) == 1 then
+			redis.call('INCRBY', KEYS[i], ARGV[2])
+		end
+	end
+end
+return 1
+`)
+
+// SponsorshipCounters keeps the daily sponsorship counters and the grants holding chain
+// budget in Redis. A grant that expires unsettled stops holding budget but stays counted
+// against its day's caps.
+type SponsorshipCounters struct {
+	redis *redis.Redis
+}
+
+func NewSponsorshipCounters(rds *redis.Redis) domain.SponsorshipCounters {
+	return &SponsorshipCounters{redis: rds}
+}
+
+func sponsorshipGrantKey(id string) string {
+	return "sponsorship:grant:" + id
+}
+
+// sponsorshipIntentKey holds the id of the live grant of an intent
+func sponsorshipIntentKey(intentID string) string {
+	return "sponsorship:intent:" + intentID
+}
+
+// sponsorshipKeys returns the collection and user day counters and the held grants of the
+// grant's chain
+func sponsorshipKeys(g *domain.SponsorshipGrant) (collection, user, held string) {
+	return fmt.Sprintf("sponsorship:day:%s:collection:%s:%s", g.Day, g.ChainID, g.Collection),
+		fmt.Sprintf("sponsorship:day:%s:user:%s:%s", g.Day, g.ChainID, g.UserID),
+		"sponsorship:held:" + g.ChainID
+}
+
+func heldMember(g *domain.SponsorshipGrant) string {
+	return g.ID + ":" + strconv.FormatInt(g.GasGwei, 10)
+}
+
+func (c *SponsorshipCounters) HoldGrant(ctx context.Context, grant *domain.SponsorshipGrant, caps domain.SponsorshipCaps, balanceGwei int64) (*domain.SponsorshipGrant, domain.SponsorshipLimit, error) {
+	payload, err := json.Marshal(grant)
+	if err != nil {
+		return nil, "", fmt.Errorf("encode sponsorship grant: %w", err)
+	}
+	collection, user, held := sponsorshipKeys(grant)
+	result, err := holdGrantScript.Run(ctx, c.redis.GetClient(),
+		[]string{collection, user, held, sponsorshipGrantKey(grant.ID), sponsorshipIntentKey(grant.IntentID)},
+		grant.GasGwei, caps.CollectionDailyGwei, caps.UserDailyGwei, balanceGwei,
+		time.Now().UnixMilli(), grant.ExpiresAt.UnixMilli(), heldMember(grant), payload,
+		int64(sponsorshipDayTTL/time.Second), grant.ID,
+	).StringSlice()
+	if err != nil {
+		return nil, "", fmt.Errorf("hold sponsorship grant: %w", err)
+	}
+	if len(result) != 2 {
+		return nil, "", fmt.Errorf("hold sponsorship grant: unexpected reply %q", result)
+	}
+	if limit := domain.SponsorshipLimit(result[0]); limit != domain.SponsorshipWithinLimits {
+		return nil, limit, nil
+	}
+	if result[1] == grant.ID {
+		return grant, domain.SponsorshipWithinLimits, nil
+	}
+	existing, err := c.GetGrant(ctx, result[1])
+	if err != nil {
+		return nil, "", err
+	}
+	return existing, domain.SponsorshipWithinLimits, nil
+}
+
+func (c *SponsorshipCounters) GetGrant(ctx context.Context, id string) (*domain.SponsorshipGrant, error) {
+	raw, err := c.redis.GetClient().Get(ctx, sponsorshipGrantKey(id)).Bytes()
+	if err == redislib.Nil {
+		return nil, domain.ErrGrantNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get sponsorship grant: %w", err)
+	}
+	var grant domain.SponsorshipGrant
+	if err := json.Unmarshal(raw, &grant); err != nil {
+		return nil, fmt.Errorf("decode sponsorship grant: %w", err)
+	}
+	return &grant, nil
+}
+
+func (c *SponsorshipCounters) ReleaseGrant(ctx context.Context, grant *domain.SponsorshipGrant, spentGwei int64) error {
+	collection, user, held := sponsorshipKeys(grant)
+	if err := releaseGrantScript.Run(ctx, c.redis.GetClient(),
+		[]string{sponsorshipGrantKey(grant.ID), held, collection, user, sponsorshipIntentKey(grant.IntentID)},
+		heldMember(grant), spentGwei-grant.GasGwei, grant.ID,
+	).Err(); err != nil {
+		return fmt.Errorf("release sponsorship grant: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+// Gas charged by the batch estimates. They sit above what marketplace collections spend so a
+// batch sized up to the chain's cap does not run out of gas.
+const (
+	airdropBaseGas      = 60_000
+	airdropRecipientGas = 35_000 // a new balance slot, the transfer event and calldata
+	airdropTokenGas     = 25_000 // each ERC-721 token minted
+)
+
+// maxAirdropRecipients caps the wallets one airdrop reaches
+const maxAirdropRecipients = 10000
+
+// DefaultAirdropPolicy covers the limits SetAirdrops is given as zero
+var DefaultAirdropPolicy = domain.AirdropPolicy{
+	DefaultGasLimit: 15_000_000,
+	MaxRecipients:   500,
+}
+
+// SetAirdrops enables airdrop bundles; snapshots may be nil, which leaves only explicit
+// recipient lists
+func (s *Service) SetAirdrops(bundles domain.IntentBundleRepo, snapshots domain.HolderSnapshotReader, policy domain.AirdropPolicy) {
+	if policy.DefaultGasLimit == 0 {
+		policy.DefaultGasLimit = DefaultAirdropPolicy.DefaultGasLimit
+	}
+	if policy.MaxRecipients <= 0 {
+		policy.MaxRecipients = DefaultAirdropPolicy.MaxRecipients
+	}
+	s.bundles = bundles
+	s.snapshots = snapshots
+	s.airdropPolicy = policy
+}
+
+// PrepareAirdrop splits an airdrop into batches that each fit the chain's gas cap and
+// prepares one intent per batch. The batches are independent, so a failed one can be sent
+// again without the others; their order only keeps the signer's nonces predictable.
+func (s *Service) PrepareAirdrop(ctx context.Context, in domain.PrepareAirdropInput) (*domain.AirdropBundle, error) {
+	if s.bundles == nil {
+		return nil, domain.ErrAirdropsDisabled
+	}
+	if in.ChainID == "" || !IsValidEthereumAddress(in.Contract) || in.UserID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var tokenID *big.Int
+	switch in.Standard {
+	case domain.StdERC721:
+		if in.TokenID != "" {
+			return nil, &domain.ValidationError{Field: "tokenId", Reason: "ERC-721 airdrops mint new tokens"}
+		}
+	case domain.StdERC1155:
+		id, ok := parseUint256(in.TokenID)
+		if !ok {
+			return nil, &domain.ValidationError{Field: "tokenId", Reason: "must be a uint256"}
+		}
+		tokenID = id
+	default:
+		return nil, domain.ErrUnsupportedStd
+	}
+
+	contract := domain.Address(strings.ToLower(in.Contract))
+	now := time.Now()
+	if err := s.authorizeCollectionAdmin(ctx, in.ChainID, contract, in.UserID); err != nil {
+		log.Printf("audit|event=airdrop_denied|chain_id=%s|contract=%s|user_id=%s|reason=%v|timestamp=%s",
+			in.ChainID, contract, in.UserID, err, now.UTC().Format(time.RFC3339Nano))
+		return nil, err
+	}
+	if err := s.checkIntentLimits(ctx, intentOwner(&in.UserID, ""), domain.IntentKindAirdrop, in.ChainID); err != nil {
+		return nil, err
+	}
+
+	recipients, err := s.airdropRecipients(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	batches, err := chunkAirdrop(in.Standard, recipients, s.airdropPolicy.GasLimit(in.ChainID), s.airdropPolicy.MaxRecipients)
+	if err != nil {
+		return nil, err
+	}
+
+	bundleID := uuid.New().String()
+	intents := make([]*domain.Intent, len(batches))
+	for i := range batches {
+		batch := &batches[i]
+		to, data, err := s.encoder.EncodeAirdrop(ctx, in.ChainID, contract, in.Standard, tokenID, batch.Recipients)
+		if err != nil {
+			return nil, fmt.Errorf("encode airdrop: %w", err)
+		}
+		batch.IntentID = uuid.New().String()
+		batch.Seq = i
+		batch.Tx = domain.TxRequest{To: to, Data: data, Value: "0"}
+
+		intents[i] = &domain.Intent{
+			ID:             batch.IntentID,
+			Kind:           domain.IntentKindAirdrop,
+			ChainID:        in.ChainID,
+			PreviewAddress: &contract,
+			Status:         domain.IntentPending,
+			CreatedBy:      &in.UserID,
+			ReqPayloadJSON: map[string]interface{}{
+				"bundleId":   bundleID,
+				"batch":      i,
+				"batches":    len(batches),
+				"standard":   in.Standard,
+				"tokenId":    in.TokenID,
+				"snapshotId": in.SnapshotID,
+				"recipients": batch.Recipients,
+				"gasLimit":   batch.GasLimit,
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	bundle := &domain.IntentBundle{
+		ID:             bundleID,
+		Kind:           domain.IntentKindAirdrop,
+		ChainID:        in.ChainID,
+		Contract:       contract,
+		CreatedBy:      in.UserID,
+		RecipientCount: len(recipients),
+		BatchCount:     len(batches),
+		CreatedAt:      now,
+	}
+	if err := s.bundles.CreateBundle(ctx, bundle, intents); err != nil {
+		return nil, fmt.Errorf("create airdrop bundle: %w", err)
+	}
+
+	log.Printf("audit|event=airdrop_prepared|bundle_id=%s|chain_id=%s|contract=%s|user_id=%s|recipients=%d|batches=%d|timestamp=%s",
+		bundleID, in.ChainID, contract, in.UserID, len(recipients), len(batches), now.UTC().Format(time.RFC3339Nano))
+
+	for _, intent := range intents {
+		s.statusCache.SetIntentStatus(ctx, domain.IntentStatusPayload{
+			IntentID:        intent.ID,
+			Kind:            domain.IntentKindAirdrop,
+			Status:          domain.IntentPending,
+			ChainID:         &intent.ChainID,
+			ContractAddress: &contract,
+		}, domain.DefaultIntentTTL)
+	}
+
+	return &domain.AirdropBundle{
+		BundleID:       bundleID,
+		ChainID:        in.ChainID,
+		Contract:       contract,
+		RecipientCount: len(recipients),
+		Batches:        batches,
+	}, nil
+}
+
+// GetAirdropProgress reports each batch of an airdrop the user prepared, with statuses read
+// like GetIntentStatus
+func (s *Service) GetAirdropProgress(ctx context.Context, bundleID, userID string) (*domain.BundleProgress, error) {
+	if s.bundles == nil {
+		return nil, domain.ErrAirdropsDisabled
+	}
+	return s.bundleProgress(ctx, domain.IntentKindAirdrop, bundleID, userID)
+}
+
+// bundleProgress reports each batch of a kind bundle the user prepared; anyone else's and
+// other kinds' bundles are ErrNotFound
+func (s *Service) bundleProgress(ctx context.Context, kind domain.IntentKind, bundleID, userID string) (*domain.BundleProgress, error) {
+	if userID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if _, err := uuid.Parse(bundleID); err != nil {
+		return nil, domain.ErrNotFound
+	}
+
+	bundle, err := s.bundles.GetBundle(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.CreatedBy != userID || bundle.Kind != kind {
+		return nil, domain.ErrNotFound
+	}
+	intents, err := s.bundles.ListBundleIntents(ctx, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("list bundle intents: %w", err)
+	}
+
+	progress := &domain.BundleProgress{
+		Bundle:  bundle,
+		Status:  domain.IntentPending,
+		Batches: make([]*domain.IntentStatusPayload, 0, len(intents)),
+	}
+	stalled := false
+	for _, intent := range intents {
+		status := s.currentStatus(ctx, intent)
+		switch status.Status {
+		case domain.IntentReady:
+			progress.Confirmed++
+		case domain.IntentFailed, domain.IntentExpired:
+			progress.Failed++
+		case domain.IntentStalled:
+			stalled = true
+		}
+		progress.Batches = append(progress.Batches, status)
+	}
+
+	switch {
+	case progress.Failed > 0:
+		progress.Status = domain.IntentFailed
+	case len(intents) > 0 && progress.Confirmed == len(intents):
+		progress.Status = domain.IntentReady
+	case stalled:
+		progress.Status = domain.IntentStalled
+	}
+	return progress, nil
+}
+
+// airdropRecipients resolves who the airdrop reaches, merging repeated wallets into their
+// first position
+func (s *Service) airdropRecipients(ctx context.Context, in domain.PrepareAirdropInput) ([]domain.AirdropRecipient, error) {
+	listed := in.Recipients
+	if in.SnapshotID != "" {
+		if len(in.Recipients) > 0 {
+			return nil, &domain.ValidationError{Field: "recipients", Reason: "cannot be combined with a snapshot"}
+		}
+		if s.snapshots == nil {
+			return nil, domain.ErrAirdropsDisabled.WithMessage("snapshot airdrops are not enabled")
+		}
+		if _, err := uuid.Parse(in.SnapshotID); err != nil {
+			return nil, domain.ErrSnapshotNotFound
+		}
+		holders, err := s.snapshots.ListSnapshotHolders(ctx, in.SnapshotID, in.UserID)
+		if err != nil {
+			return nil, err
+		}
+		amount := in.AmountPerHolder
+		if amount == 0 {
+			amount = 1
+		}
+		listed = make([]domain.AirdropRecipient, len(holders))
+		for i, holder := range holders {
+			listed[i] = domain.AirdropRecipient{Address: holder, Amount: amount}
+		}
+	}
+	if len(listed) == 0 {
+		return nil, &domain.ValidationError{Field: "recipients", Reason: "no recipients"}
+	}
+
+	merged := make([]domain.AirdropRecipient, 0, len(listed))
+	index := make(map[domain.Address]int, len(listed))
+	for _, r := range listed {
+		if !IsValidEthereumAddress(r.Address) || common.HexToAddress(r.Address) == (common.Address{}) {
+			return nil, &domain.ValidationError{Field: "recipients", Reason: fmt.Sprintf("%q is not a valid recipient", r.Address)}
+		}
+		if r.Amount == 0 {
+			return nil, &domain.ValidationError{Field: "recipients", Reason: "amounts must be positive"}
+		}
+		addr := domain.Address(strings.ToLower(r.Address))
+		if i, seen := index[addr]; seen {
+			if merged[i].Amount > math.MaxUint64-r.Amount {
+				return nil, &domain.ValidationError{Field: "recipients", Reason: fmt.Sprintf("total amount for %s is too large", addr)}
+			}
+			merged[i].Amount += r.Amount
+			continue
+		}
+		index[addr] = len(merged)
+		merged = append(merged, domain.AirdropRecipient{Address: addr, Amount: r.Amount})
+	}
+	if len(merged) > maxAirdropRecipients {
+		return nil, &domain.ValidationError{Field: "recipients", Reason: fmt.Sprintf("cannot exceed %d wallets", maxAirdropRecipients)}
+	}
+	return merged, nil
+}
+
+// recipientGas estimates what one recipient adds to a batch
+func recipientGas(standard domain.Standard, amount uint64) uint64 {
+	if standard != domain.StdERC721 {
+		return airdropRecipientGas
+	}
+	if amount > (math.MaxUint64-airdropRecipientGas)/airdropTokenGas {
+		return math.MaxUint64
+	}
+	return airdropRecipientGas + amount*airdropTokenGas
+}
+
+// chunkAirdrop fills batches with recipients in order, starting a new one whenever the next
+// recipient would take it past the gas or recipient cap
+func chunkAirdrop(standard domain.Standard, recipients []domain.AirdropRecipient, gasLimit uint64, maxRecipients int) ([]domain.AirdropBatch, error) {
+	var batches []domain.AirdropBatch
+	current := domain.AirdropBatch{GasLimit: airdropBaseGas}
+	for _, r := range recipients {
+		gas := recipientGas(standard, r.Amount)
+		if gas > gasLimit || gasLimit-gas < airdropBaseGas {
+			return nil, &domain.ValidationError{Field: "recipients", Reason: fmt.Sprintf("the amount for %s does not fit one transaction on this chain", r.Address)}
+		}
+		if len(current.Recipients) > 0 && (current.GasLimit+gas > gasLimit || len(current.Recipients) >= maxRecipients) {
+			batches = append(batches, current)
+			current = domain.AirdropBatch{GasLimit: airdropBaseGas}
+		}
+		current.Recipients = append(current.Recipients, r)
+		current.GasLimit += gas
+	}
+	if len(current.Recipients) > 0 {
+		batches = append(batches, current)
+	}
+	return batches, nil
+}
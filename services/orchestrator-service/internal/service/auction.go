@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAuctionDuration caps how long an auction may run
+const maxAuctionDuration = 30 * 24 * 60 * 60
+
+func (s *Service) PrepareCreateAuction(ctx context.Context, in domain.PrepareCreateAuctionInput) (*domain.PrepareAuctionResult, error) {
+	if in.ChainID == "" || !IsValidEthereumAddress(in.Collection) || !IsValidEthereumAddress(in.Seller) {
+		return nil, domain.ErrInvalidInput
+	}
+	if in.Duration == 0 || in.Duration > maxAuctionDuration {
+		return nil, domain.ErrInvalidInput
+	}
+
+	tokenID, ok := parseUint256(in.TokenID)
+	if !ok {
+		return nil, domain.ErrInvalidInput
+	}
+	startPrice, ok := parseUint256(in.StartPrice)
+	if !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	nft := common.HexToAddress(in.Collection)
+
+	switch in.AuctionType {
+	case domain.AuctionEnglish:
+		reservePrice := big.NewInt(0)
+		if in.ReservePrice != "" {
+			if reservePrice, ok = parseUint256(in.ReservePrice); !ok {
+				return nil, domain.ErrInvalidInput
+			}
+		}
+		return s.prepareAuction(ctx, domain.IntentKindCreateAuction, in.ChainID, in.Seller, in, "createEnglishAuction", "0",
+			nft, tokenID, startPrice, reservePrice, in.StartTime, in.Duration)
+
+	case domain.AuctionDutch:
+		// a Dutch auction's price falls from start to end, so the floor must be lower
+		endPrice, ok := parseUint256(in.EndPrice)
+		if !ok || endPrice.Cmp(startPrice) >= 0 {
+			return nil, domain.ErrInvalidInput
+		}
+		return s.prepareAuction(ctx, domain.IntentKindCreateAuction, in.ChainID, in.Seller, in, "createDutchAuction", "0",
+			nft, tokenID, startPrice, endPrice, in.StartTime, in.Duration)
+
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+}
+
+func (s *Service) PrepareBid(ctx context.Context, in domain.PrepareBidInput) (*domain.PrepareAuctionResult, error) {
+	if in.ChainID == "" || !IsValidEthereumAddress(in.Bidder) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	auctionID, ok := parseUint256(in.AuctionID)
+	if !ok {
+		return nil, domain.ErrInvalidInput
+	}
+	amount, ok := parseUint256(in.Amount)
+	if !ok || amount.Sign() == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.prepareAuction(ctx, domain.IntentKindBid, in.ChainID, in.Bidder, in, "bid", amount.String(), auctionID)
+}
+
+func (s *Service) PrepareSettleAuction(ctx context.Context, in domain.PrepareSettleAuctionInput) (*domain.PrepareAuctionResult, error) {
+	if in.ChainID == "" || !IsValidEthereumAddress(in.Caller) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	auctionID, ok := parseUint256(in.AuctionID)
+	if !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.prepareAuction(ctx, domain.IntentKindSettleAuction, in.ChainID, in.Caller, in, "settle", "0", auctionID)
+}
+
+// getAuctionHouseAddress looks up the contract holding the auction-house role on the chain
+func (s *Service) getAuctionHouseAddress(ctx context.Context, chainID domain.ChainID) (domain.Address, error) {
+	resp, err := s.chainRegistry.GetContractByRole(ctx, &protoChainRegistry.GetContractByRoleRequest{
+		ChainId: chainID,
+		Role:    contracts.RoleAuctionHouse,
+	})
+	if status.Code(err) == codes.NotFound {
+		return "", domain.ErrAuctionHouseNotSet
+	}
+	if err != nil {
+		return "", fmt.Errorf("get auction house from chain-registry: %w", err)
+	}
+	return domain.Address(strings.ToLower(resp.Contract.GetAddress())), nil
+}
+
+func (s *Service) prepareAuction(ctx context.Context, kind domain.IntentKind, chainID domain.ChainID, signer domain.Address, payload any, method, value string, args ...interface{}) (*domain.PrepareAuctionResult, error) {
+	auctionHouse, err := s.getAuctionHouseAddress(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkIntentLimits(ctx, intentOwner(nil, signer), kind, chainID); err != nil {
+		return nil, err
+	}
+
+	intentID := uuid.New().String()
+	now := time.Now()
+	signer = domain.Address(strings.ToLower(signer))
+
+	intent := &domain.Intent{
+		ID:              intentID,
+		Kind:            kind,
+		ChainID:         chainID,
+		ContractAddress: &auctionHouse,
+		Signer:          &signer,
+		Status:          domain.IntentPending,
+		ReqPayloadJSON: map[string]interface{}{
+			"input":        payload,
+			"method":       method,
+			"auctionHouse": auctionHouse,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.repo.Create(ctx, intent); err != nil {
+		return nil, fmt.Errorf("create intent: %w", err)
+	}
+
+	to, data, err := s.encoder.EncodeAuction(ctx, chainID, auctionHouse, method, value, args...)
+	if err != nil {
+		errMsg := err.Error()
+		if updateErr := s.repo.UpdateStatus(ctx, intentID, domain.IntentFailed, &errMsg); updateErr != nil {
+			fmt.Printf("Failed to update intent status to failed: %v", updateErr)
+		}
+		return nil, fmt.Errorf("encode %s: %w", method, err)
+	}
+
+	log.Printf("audit|event=auction_prepared|intent_id=%s|kind=%s|chain_id=%s|auction_house=%s|timestamp=%s",
+		intentID, kind, chainID, auctionHouse, now.UTC().Format(time.RFC3339Nano))
+
+	statusPayload := domain.IntentStatusPayload{
+		IntentID:        intentID,
+		Kind:            kind,
+		Status:          domain.IntentPending,
+		ChainID:         &chainID,
+		ContractAddress: &auctionHouse,
+	}
+	s.statusCache.SetIntentStatus(ctx, statusPayload, domain.DefaultIntentTTL)
+
+	return &domain.PrepareAuctionResult{
+		IntentID: intentID,
+		Tx: domain.TxRequest{
+			To:    to,
+			Data:  data,
+			Value: value,
+		},
+	}, nil
+}
+
+// parseUint256 parses a base-10 non-negative integer that fits in a uint256
+func parseUint256(s string) (*big.Int, bool) {
+	n, ok := new(big.Int).SetString(strings.TrimSpace(s), 10)
+	if !ok || n.Sign() < 0 || n.BitLen() > 256 {
+		return nil, false
+	}
+	return n, true
+}
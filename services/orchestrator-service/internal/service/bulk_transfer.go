@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+// maxBulkTransferItems caps the tokens one bulk transfer sends; ERC-721 tokens are one
+// transaction each, so it also bounds what the signer has to send
+const maxBulkTransferItems = 100
+
+// SetBulkTransfers enables bulk transfers, kept as bundles and checked against the catalog's
+// ownership index in holdings
+func (s *Service) SetBulkTransfers(bundles domain.IntentBundleRepo, holdings domain.TokenHoldingReader) {
+	s.bundles = bundles
+	s.holdings = holdings
+}
+
+// transferGroup is the tokens of one collection a bulk transfer sends
+type transferGroup struct {
+	contract domain.Address
+	standard domain.Standard
+	items    []domain.BulkTransferItem
+}
+
+// PrepareBulkTransfer checks that From holds every token, groups them by collection and
+// prepares one intent per transaction: an ERC-1155 safeBatchTransferFrom per recipient, and
+// ERC-721 transfers one by one since the standard has no batch. When an operator sends them,
+// the collections it must be approved for come with the calls that check and grant it.
+func (s *Service) PrepareBulkTransfer(ctx context.Context, in domain.PrepareBulkTransferInput) (*domain.BulkTransferBundle, error) {
+	if s.bundles == nil || s.holdings == nil || s.wallets == nil {
+		return nil, domain.ErrTransfersDisabled
+	}
+	if in.ChainID == "" || in.UserID == "" || !IsValidEthereumAddress(in.From) {
+		return nil, domain.ErrInvalidInput
+	}
+	from := domain.Address(strings.ToLower(in.From))
+	signer := from
+	if in.Operator != "" {
+		if !IsValidEthereumAddress(in.Operator) {
+			return nil, &domain.ValidationError{Field: "operator", Reason: "must be an address"}
+		}
+		signer = domain.Address(strings.ToLower(in.Operator))
+	}
+	if err := s.requireLinkedWallets(ctx, in.UserID, from, signer); err != nil {
+		return nil, err
+	}
+
+	groups, err := groupBulkTransfer(from, in.Items)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if err := s.checkTransferGroup(ctx, in.ChainID, from, group); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkIntentLimits(ctx, intentOwner(&in.UserID, ""), domain.IntentKindBulkTransfer, in.ChainID); err != nil {
+		return nil, err
+	}
+
+	result := &domain.BulkTransferBundle{
+		BundleID:   uuid.New().String(),
+		ChainID:    in.ChainID,
+		From:       from,
+		Signer:     signer,
+		TokenCount: len(in.Items),
+	}
+	for _, group := range groups {
+		if signer != from {
+			check, approve, err := s.encoder.EncodeOperatorApproval(ctx, in.ChainID, group.contract, from, signer)
+			if err != nil {
+				return nil, fmt.Errorf("encode approval: %w", err)
+			}
+			result.Approvals = append(result.Approvals, domain.BulkTransferApproval{
+				Contract: group.contract,
+				Check:    domain.TxRequest{To: group.contract, Data: check, Value: "0"},
+				Tx:       domain.TxRequest{To: group.contract, Data: approve, Value: "0"},
+			})
+		}
+		for _, batch := range transferBatches(group) {
+			to, data, err := s.encoder.EncodeTokenTransfer(ctx, in.ChainID, group.contract, group.standard, from, batch.To, batch.Tokens)
+			if err != nil {
+				return nil, fmt.Errorf("encode transfer: %w", err)
+			}
+			batch.IntentID = uuid.New().String()
+			batch.Seq = len(result.Batches)
+			batch.Tx = domain.TxRequest{To: to, Data: data, Value: "0"}
+			result.Batches = append(result.Batches, batch)
+		}
+	}
+
+	now := time.Now()
+	recipients := make(map[domain.Address]bool)
+	intents := make([]*domain.Intent, len(result.Batches))
+	for i, batch := range result.Batches {
+		recipients[batch.To] = true
+		contract := batch.Contract
+		intents[i] = &domain.Intent{
+			ID:             batch.IntentID,
+			Kind:           domain.IntentKindBulkTransfer,
+			ChainID:        in.ChainID,
+			PreviewAddress: &contract,
+			Signer:         &signer,
+			Status:         domain.IntentPending,
+			CreatedBy:      &in.UserID,
+			ReqPayloadJSON: map[string]interface{}{
+				"bundleId": result.BundleID,
+				"batch":    i,
+				"batches":  len(result.Batches),
+				"standard": batch.Standard,
+				"from":     from,
+				"to":       batch.To,
+				"tokens":   batch.Tokens,
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	bundle := &domain.IntentBundle{
+		ID:             result.BundleID,
+		Kind:           domain.IntentKindBulkTransfer,
+		ChainID:        in.ChainID,
+		CreatedBy:      in.UserID,
+		RecipientCount: len(recipients),
+		BatchCount:     len(result.Batches),
+		CreatedAt:      now,
+	}
+	if len(groups) == 1 {
+		bundle.Contract = groups[0].contract
+	}
+	if err := s.bundles.CreateBundle(ctx, bundle, intents); err != nil {
+		return nil, fmt.Errorf("create bulk transfer bundle: %w", err)
+	}
+
+	log.Printf("audit|event=bulk_transfer_prepared|bundle_id=%s|chain_id=%s|from=%s|signer=%s|user_id=%s|tokens=%d|batches=%d|timestamp=%s",
+		result.BundleID, in.ChainID, from, signer, in.UserID, result.TokenCount, len(result.Batches), now.UTC().Format(time.RFC3339Nano))
+
+	for _, intent := range intents {
+		s.statusCache.SetIntentStatus(ctx, domain.IntentStatusPayload{
+			IntentID:        intent.ID,
+			Kind:            domain.IntentKindBulkTransfer,
+			Status:          domain.IntentPending,
+			ChainID:         &intent.ChainID,
+			ContractAddress: intent.PreviewAddress,
+		}, domain.DefaultIntentTTL)
+	}
+
+	return result, nil
+}
+
+// GetBulkTransferProgress reports each transaction of a bulk transfer the user prepared,
+// with statuses read like GetIntentStatus
+func (s *Service) GetBulkTransferProgress(ctx context.Context, bundleID, userID string) (*domain.BundleProgress, error) {
+	if s.bundles == nil || s.holdings == nil {
+		return nil, domain.ErrTransfersDisabled
+	}
+	return s.bundleProgress(ctx, domain.IntentKindBulkTransfer, bundleID, userID)
+}
+
+// requireLinkedWallets checks that every address is a wallet the user signed for
+func (s *Service) requireLinkedWallets(ctx context.Context, userID string, addresses ...domain.Address) error {
+	linked, err := s.wallets.ListLinkedAddresses(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list linked wallets: %w", err)
+	}
+	for _, addr := range addresses {
+		found := false
+		for _, l := range linked {
+			if strings.EqualFold(l, addr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return domain.ErrForbidden.WithMessage(fmt.Sprintf("%s is not a wallet linked to the user", addr))
+		}
+	}
+	return nil
+}
+
+// groupBulkTransfer validates the items and groups them by collection, in the order each
+// collection first appears. Repeats of a token to the same wallet are merged.
+func groupBulkTransfer(from domain.Address, items []domain.BulkTransferItem) ([]*transferGroup, error) {
+	if len(items) == 0 {
+		return nil, &domain.ValidationError{Field: "items", Reason: "no tokens to transfer"}
+	}
+	if len(items) > maxBulkTransferItems {
+		return nil, &domain.ValidationError{Field: "items", Reason: fmt.Sprintf("cannot exceed %d tokens", maxBulkTransferItems)}
+	}
+
+	var groups []*transferGroup
+	byContract := make(map[domain.Address]*transferGroup)
+	merged := make(map[string]int) // contract|token|to -> index in its group
+	for _, item := range items {
+		if !IsValidEthereumAddress(item.Contract) {
+			return nil, &domain.ValidationError{Field: "items", Reason: fmt.Sprintf("%q is not a contract address", item.Contract)}
+		}
+		tokenID, ok := parseUint256(item.TokenID)
+		if !ok {
+			return nil, &domain.ValidationError{Field: "items", Reason: fmt.Sprintf("token id %q must be a uint256", item.TokenID)}
+		}
+		if !IsValidEthereumAddress(item.To) || common.HexToAddress(item.To) == (common.Address{}) {
+			return nil, &domain.ValidationError{Field: "items", Reason: fmt.Sprintf("%q is not a valid recipient", item.To)}
+		}
+		to := domain.Address(strings.ToLower(item.To))
+		if to == from {
+			return nil, &domain.ValidationError{Field: "items", Reason: "tokens cannot be sent to the wallet holding them"}
+		}
+		amount := item.Amount
+		if amount == 0 {
+			amount = 1
+		}
+
+		contract := domain.Address(strings.ToLower(item.Contract))
+		group, ok := byContract[contract]
+		if !ok {
+			group = &transferGroup{contract: contract}
+			byContract[contract] = group
+			groups = append(groups, group)
+		}
+		key := contract + "|" + tokenID.String() + "|" + to
+		if i, seen := merged[key]; seen {
+			if group.items[i].Amount > math.MaxUint64-amount {
+				return nil, &domain.ValidationError{Field: "items", Reason: fmt.Sprintf("total amount of token %s is too large", tokenID)}
+			}
+			group.items[i].Amount += amount
+			continue
+		}
+		merged[key] = len(group.items)
+		group.items = append(group.items, domain.BulkTransferItem{Contract: contract, TokenID: tokenID.String(), To: to, Amount: amount})
+	}
+	return groups, nil
+}
+
+// checkTransferGroup resolves the group's standard from the catalog and checks that from
+// holds every token it sends, as far as the ownership index has seen
+func (s *Service) checkTransferGroup(ctx context.Context, chainID domain.ChainID, from domain.Address, group *transferGroup) error {
+	standard, err := s.holdings.GetCollectionStandard(ctx, chainID, group.contract)
+	if errors.Is(err, domain.ErrCollectionNotFound) {
+		return &domain.ValidationError{Field: "items", Reason: fmt.Sprintf("%s is not a collection the marketplace indexes", group.contract)}
+	}
+	if err != nil {
+		return fmt.Errorf("get collection standard: %w", err)
+	}
+	if standard != domain.StdERC721 && standard != domain.StdERC1155 {
+		return domain.ErrUnsupportedStd
+	}
+	group.standard = standard
+
+	needed := make(map[string]*big.Int)
+	var tokenIDs []string
+	for _, item := range group.items {
+		if standard == domain.StdERC721 && item.Amount != 1 {
+			return &domain.ValidationError{Field: "items", Reason: fmt.Sprintf("ERC-721 token %s of %s has one copy", item.TokenID, group.contract)}
+		}
+		if needed[item.TokenID] == nil {
+			needed[item.TokenID] = new(big.Int)
+			tokenIDs = append(tokenIDs, item.TokenID)
+		}
+		needed[item.TokenID].Add(needed[item.TokenID], new(big.Int).SetUint64(item.Amount))
+	}
+
+	balances, err := s.holdings.TokenBalances(ctx, chainID, group.contract, from, tokenIDs)
+	if err != nil {
+		return fmt.Errorf("get token balances: %w", err)
+	}
+	for _, id := range tokenIDs {
+		held := balances[id]
+		if held == nil {
+			held = new(big.Int)
+		}
+		if held.Cmp(needed[id]) < 0 {
+			return &domain.ValidationError{Field: "items", Reason: fmt.Sprintf("%s holds %s of token %s of %s, %s are sent", from, held, id, group.contract, needed[id])}
+		}
+	}
+	return nil
+}
+
+// transferBatches splits a group into its transactions: one per ERC-721 token, and one per
+// recipient of ERC-1155 tokens, in the order the recipients first appear
+func transferBatches(group *transferGroup) []domain.BulkTransferBatch {
+	var batches []domain.BulkTransferBatch
+	byRecipient := make(map[domain.Address]int)
+	for _, item := range group.items {
+		token := domain.BulkTransferToken{TokenID: item.TokenID, Amount: item.Amount}
+		if group.standard == domain.StdERC1155 {
+			if i, ok := byRecipient[item.To]; ok {
+				batches[i].Tokens = append(batches[i].Tokens, token)
+				continue
+			}
+			byRecipient[item.To] = len(batches)
+		}
+		batches = append(batches, domain.BulkTransferBatch{
+			Contract: group.contract,
+			Standard: group.standard,
+			To:       item.To,
+			Tokens:   []domain.BulkTransferToken{token},
+		})
+	}
+	return batches
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// SetCallTargets restricts the contracts user-chosen intents may call to the ones the chain
+// registry or catalog knows, plus the overrides admins grant in repo
+func (s *Service) SetCallTargets(repo domain.CallTargetOverrideRepo) {
+	s.callTargets = repo
+}
+
+// checkCallTarget rejects a call to a contract that is not registered for the chain, not a
+// catalog collection and not allowed by an admin override. Factory and auction calls are
+// resolved from the registry and collection admin calls need a catalog collection, so only
+// calls whose target the caller picks freely need it.
+func (s *Service) checkCallTarget(ctx context.Context, kind domain.IntentKind, chainID domain.ChainID, to domain.Address, userID string) error {
+	if s.callTargets == nil {
+		return nil
+	}
+	to = strings.ToLower(to)
+
+	resp, err := s.chainRegistry.GetContracts(ctx, &protoChainRegistry.GetContractsRequest{ChainId: chainID})
+	if err != nil {
+		return fmt.Errorf("get contracts from chain-registry: %w", err)
+	}
+	for _, c := range resp.Contracts {
+		if strings.EqualFold(c.Address, to) {
+			return nil
+		}
+	}
+
+	if s.creators != nil {
+		_, err := s.creators.GetCollectionCreator(ctx, chainID, to)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, domain.ErrCollectionNotFound) {
+			return fmt.Errorf("get collection: %w", err)
+		}
+	}
+
+	allowed, err := s.callTargets.HasOverride(ctx, chainID, to)
+	if err != nil {
+		return fmt.Errorf("get call target override: %w", err)
+	}
+	if allowed {
+		return nil
+	}
+
+	log.Printf("audit|event=call_target_denied|kind=%s|chain_id=%s|to=%s|user_id=%s|timestamp=%s",
+		kind, chainID, to, userID, time.Now().UTC().Format(time.RFC3339Nano))
+	return domain.ErrTargetNotAllowed
+}
+
+// AllowCallTarget lets intents call a contract the registry and catalog don't know.
+// Callers authorize the admin.
+func (s *Service) AllowCallTarget(ctx context.Context, chainID domain.ChainID, address domain.Address, reason, actorID string) (*domain.CallTargetOverride, error) {
+	if s.callTargets == nil {
+		return nil, domain.ErrOverridesDisabled
+	}
+	reason = strings.TrimSpace(reason)
+	if !IsValidCAIP2ChainID(chainID) || !IsValidEthereumAddress(address) || actorID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if reason == "" {
+		return nil, &domain.ValidationError{Field: "reason", Reason: "is required"}
+	}
+
+	now := time.Now()
+	override := &domain.CallTargetOverride{
+		ChainID:   chainID,
+		Address:   strings.ToLower(address),
+		Reason:    reason,
+		GrantedBy: actorID,
+		CreatedAt: now,
+	}
+	if err := s.callTargets.PutOverride(ctx, override); err != nil {
+		return nil, err
+	}
+
+	log.Printf("audit|event=call_target_allowed|chain_id=%s|to=%s|actor_id=%s|reason=%q|timestamp=%s",
+		chainID, override.Address, actorID, reason, now.UTC().Format(time.RFC3339Nano))
+	return override, nil
+}
+
+// RevokeCallTarget removes an override, reporting whether one existed. Callers authorize
+// the admin.
+func (s *Service) RevokeCallTarget(ctx context.Context, chainID domain.ChainID, address domain.Address, actorID string) (bool, error) {
+	if s.callTargets == nil {
+		return false, domain.ErrOverridesDisabled
+	}
+	if !IsValidCAIP2ChainID(chainID) || !IsValidEthereumAddress(address) || actorID == "" {
+		return false, domain.ErrInvalidInput
+	}
+
+	address = strings.ToLower(address)
+	removed, err := s.callTargets.DeleteOverride(ctx, chainID, address)
+	if err != nil {
+		return false, err
+	}
+	if removed {
+		log.Printf("audit|event=call_target_revoked|chain_id=%s|to=%s|actor_id=%s|timestamp=%s",
+			chainID, address, actorID, time.Now().UTC().Format(time.RFC3339Nano))
+	}
+	return removed, nil
+}
+
+// ListCallTargetOverrides lists the overrides of a chain, or of every chain when chainID is
+// empty. Callers authorize the admin.
+func (s *Service) ListCallTargetOverrides(ctx context.Context, chainID domain.ChainID) ([]*domain.CallTargetOverride, error) {
+	if s.callTargets == nil {
+		return nil, domain.ErrOverridesDisabled
+	}
+	if chainID != "" && !IsValidCAIP2ChainID(chainID) {
+		return nil, domain.ErrInvalidInput
+	}
+	return s.callTargets.ListOverrides(ctx, chainID)
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+// SetCollectionAccess wires the readers used to authorize creator-only collection intents
+func (s *Service) SetCollectionAccess(creators domain.CollectionCreatorReader, wallets domain.LinkedWalletReader) {
+	s.creators = creators
+	s.wallets = wallets
+}
+
+// SetOrgAccess wires the readers used to authorize intents on organization-owned collections
+func (s *Service) SetOrgAccess(orgs domain.CollectionOrgReader, members domain.OrgMembershipReader) {
+	s.orgs = orgs
+	s.members = members
+}
+
+func (s *Service) PrepareUpdateRoyalty(ctx context.Context, in domain.PrepareUpdateRoyaltyInput) (*domain.PrepareCollectionAdminResult, error) {
+	if in.ChainID == "" || in.Contract == "" || in.UserID == "" || !IsValidEthereumAddress(in.Receiver) {
+		return nil, domain.ErrInvalidInput
+	}
+	// fee is in basis points; anything above 100% is rejected on-chain anyway
+	if in.FeeBps > 10000 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.prepareCollectionAdmin(ctx, domain.IntentKindUpdateRoyalty, in.ChainID, in.Contract, in.UserID, in,
+		"setDefaultRoyalty", common.HexToAddress(in.Receiver), new(big.Int).SetUint64(in.FeeBps))
+}
+
+func (s *Service) PrepareTransferCollectionOwnership(ctx context.Context, in domain.PrepareTransferCollectionOwnershipInput) (*domain.PrepareCollectionAdminResult, error) {
+	if in.ChainID == "" || in.Contract == "" || in.UserID == "" || !IsValidEthereumAddress(in.NewOwner) {
+		return nil, domain.ErrInvalidInput
+	}
+	// transferring to the zero address would renounce ownership for good
+	if common.HexToAddress(in.NewOwner) == (common.Address{}) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.prepareCollectionAdmin(ctx, domain.IntentKindTransferOwnership, in.ChainID, in.Contract, in.UserID, in,
+		"transferOwnership", common.HexToAddress(in.NewOwner))
+}
+
+func (s *Service) PrepareSetBaseURI(ctx context.Context, in domain.PrepareSetBaseURIInput) (*domain.PrepareCollectionAdminResult, error) {
+	if in.ChainID == "" || in.Contract == "" || in.UserID == "" || strings.TrimSpace(in.BaseURI) == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.prepareCollectionAdmin(ctx, domain.IntentKindSetBaseURI, in.ChainID, in.Contract, in.UserID, in,
+		"setBaseURI", in.BaseURI)
+}
+
+// authorizeCollectionAdmin lets owners and admins of the managing organization prepare
+// intents for org-owned collections; other collections stay creator-only
+func (s *Service) authorizeCollectionAdmin(ctx context.Context, chainID domain.ChainID, contract domain.Address, userID string) error {
+	if s.orgs == nil || s.members == nil {
+		return s.authorizeCreator(ctx, chainID, contract, userID)
+	}
+
+	orgID, err := s.orgs.GetCollectionOwnerOrg(ctx, chainID, contract)
+	if err != nil {
+		return err
+	}
+	if orgID == "" {
+		return s.authorizeCreator(ctx, chainID, contract, userID)
+	}
+
+	role, err := s.members.GetOrgRole(ctx, orgID, userID)
+	if err != nil {
+		return fmt.Errorf("get org role: %w", err)
+	}
+	if role == domain.OrgRoleOwner || role == domain.OrgRoleAdmin {
+		return nil
+	}
+	return domain.ErrForbidden
+}
+
+// authorizeCreator checks that one of the user's linked wallets is the collection creator
+func (s *Service) authorizeCreator(ctx context.Context, chainID domain.ChainID, contract domain.Address, userID string) error {
+	if s.creators == nil || s.wallets == nil {
+		return fmt.Errorf("collection access readers not configured")
+	}
+
+	creator, err := s.creators.GetCollectionCreator(ctx, chainID, contract)
+	if err != nil {
+		return err
+	}
+
+	linked, err := s.wallets.ListLinkedAddresses(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list linked wallets: %w", err)
+	}
+	for _, addr := range linked {
+		if strings.EqualFold(addr, creator) {
+			return nil
+		}
+	}
+	return domain.ErrForbidden
+}
+
+func (s *Service) prepareCollectionAdmin(ctx context.Context, kind domain.IntentKind, chainID domain.ChainID, contract domain.Address, userID string, payload any, method string, args ...interface{}) (*domain.PrepareCollectionAdminResult, error) {
+	contract = domain.Address(strings.ToLower(contract))
+	now := time.Now()
+
+	if err := s.authorizeCollectionAdmin(ctx, chainID, contract, userID); err != nil {
+		log.Printf("audit|event=collection_admin_denied|kind=%s|chain_id=%s|contract=%s|user_id=%s|reason=%v|timestamp=%s",
+			kind, chainID, contract, userID, err, now.UTC().Format(time.RFC3339Nano))
+		return nil, err
+	}
+	if err := s.checkIntentLimits(ctx, intentOwner(&userID, ""), kind, chainID); err != nil {
+		return nil, err
+	}
+
+	intentID := uuid.New().String()
+	intent := &domain.Intent{
+		ID:             intentID,
+		Kind:           kind,
+		ChainID:        chainID,
+		PreviewAddress: &contract,
+		Status:         domain.IntentPending,
+		CreatedBy:      &userID,
+		ReqPayloadJSON: map[string]interface{}{
+			"input":  payload,
+			"method": method,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.repo.Create(ctx, intent); err != nil {
+		return nil, fmt.Errorf("create intent: %w", err)
+	}
+
+	to, data, value, err := s.encoder.EncodeCollectionAdmin(ctx, chainID, contract, method, args...)
+	if err != nil {
+		errMsg := err.Error()
+		if updateErr := s.repo.UpdateStatus(ctx, intentID, domain.IntentFailed, &errMsg); updateErr != nil {
+			fmt.Printf("Failed to update intent status to failed: %v", updateErr)
+		}
+		return nil, fmt.Errorf("encode %s: %w", method, err)
+	}
+
+	log.Printf("audit|event=collection_admin_prepared|intent_id=%s|kind=%s|chain_id=%s|contract=%s|user_id=%s|timestamp=%s",
+		intentID, kind, chainID, contract, userID, now.UTC().Format(time.RFC3339Nano))
+
+	statusPayload := domain.IntentStatusPayload{
+		IntentID:        intentID,
+		Kind:            kind,
+		Status:          domain.IntentPending,
+		ChainID:         &chainID,
+		ContractAddress: &contract,
+	}
+	s.statusCache.SetIntentStatus(ctx, statusPayload, domain.DefaultIntentTTL)
+
+	return &domain.PrepareCollectionAdminResult{
+		IntentID: intentID,
+		Tx: domain.TxRequest{
+			To:    to,
+			Data:  data,
+			Value: value,
+		},
+	}, nil
+}
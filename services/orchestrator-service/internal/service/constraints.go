@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// Starting values suggested to the create collection form, clamped to the chain's constraints
+const (
+	defaultRoyaltyBps             = 500
+	defaultMaxSupply              = 10000
+	defaultMintLimitPerWallet     = 10
+	defaultAllowlistStageDuration = 24 * 60 * 60
+)
+
+// CollectionConstraintsFromParams reads the collection limits the chain registry publishes
+// with the chain params. A limit left at zero keeps its default.
+func CollectionConstraintsFromParams(params *protoChainRegistry.ChainParams) domain.CollectionConstraints {
+	constraints := domain.DefaultCollectionConstraints
+	if params == nil {
+		return constraints
+	}
+	if params.MaxRoyaltyBps > 0 {
+		constraints.MaxRoyaltyBps = params.MaxRoyaltyBps
+	}
+	if params.MinStageDurationSec > 0 {
+		constraints.MinStageDurationSec = params.MinStageDurationSec
+	}
+	if params.MaxSupplyCap > 0 {
+		constraints.MaxSupplyCap = params.MaxSupplyCap
+	}
+	return constraints
+}
+
+// ValidateCollectionConstraints rejects creation parameters a deployed collection could not
+// recover from: royalties or supply over the chain's caps, a mint starting before now, or an
+// allowlist stage shorter than the chain's minimum
+func ValidateCollectionConstraints(in domain.PrepareCreateCollectionInput, constraints domain.CollectionConstraints, now time.Time) error {
+	if in.RoyaltyFee != nil && *in.RoyaltyFee > constraints.MaxRoyaltyBps {
+		return &domain.ValidationError{Field: "royaltyFee", Reason: fmt.Sprintf("cannot exceed %d basis points", constraints.MaxRoyaltyBps)}
+	}
+	if in.MaxSupply != nil && *in.MaxSupply > constraints.MaxSupplyCap {
+		return &domain.ValidationError{Field: "maxSupply", Reason: fmt.Sprintf("cannot exceed %d", constraints.MaxSupplyCap)}
+	}
+	if in.MaxSupply != nil && in.MintLimitPerWallet != nil && *in.MintLimitPerWallet > *in.MaxSupply {
+		return &domain.ValidationError{Field: "mintLimitPerWallet", Reason: "cannot exceed max supply"}
+	}
+	if in.MintStartTime != nil && *in.MintStartTime > 0 && *in.MintStartTime < uint64(now.Unix()) {
+		return &domain.ValidationError{Field: "mintStartTime", Reason: "cannot be in the past"}
+	}
+	// A zero duration skips the allowlist stage
+	if in.AllowlistStageDuration != nil && *in.AllowlistStageDuration > 0 && *in.AllowlistStageDuration < constraints.MinStageDurationSec {
+		return &domain.ValidationError{Field: "allowlistStageDuration", Reason: fmt.Sprintf("must be at least %d seconds", constraints.MinStageDurationSec)}
+	}
+	return nil
+}
+
+// GetCollectionDefaults returns the chain's collection constraints with starting values
+// that satisfy them, and the collection types it has a factory for
+func (s *Service) GetCollectionDefaults(ctx context.Context, chainID domain.ChainID) (*domain.CollectionDefaults, error) {
+	if !IsValidCAIP2ChainID(chainID) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	resp, err := s.chainRegistry.GetContracts(ctx, &protoChainRegistry.GetContractsRequest{ChainId: chainID})
+	if err != nil {
+		return nil, fmt.Errorf("get contracts from chain-registry: %w", err)
+	}
+
+	constraints := CollectionConstraintsFromParams(resp.Params)
+	maxSupply := min(defaultMaxSupply, constraints.MaxSupplyCap)
+	defaults := &domain.CollectionDefaults{
+		ChainID:                chainID,
+		Constraints:            constraints,
+		RoyaltyFee:             min(defaultRoyaltyBps, constraints.MaxRoyaltyBps),
+		MaxSupply:              maxSupply,
+		MintLimitPerWallet:     min(defaultMintLimitPerWallet, maxSupply),
+		AllowlistStageDuration: max(defaultAllowlistStageDuration, constraints.MinStageDurationSec),
+		SupportedTypes:         []domain.Standard{},
+	}
+	for _, collectionType := range []domain.Standard{domain.StdERC721, domain.StdERC1155} {
+		if _, err := getFactoryAddress(resp, chainID, collectionType); err == nil {
+			defaults.SupportedTypes = append(defaults.SupportedTypes, collectionType)
+		}
+	}
+	return defaults, nil
+}
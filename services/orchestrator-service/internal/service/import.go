@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// importReason is recorded in the chain registry audit log for imported collections
+const importReason = "collection import"
+
+// importChallengePurpose scopes wallet-service challenges to collection imports
+const importChallengePurpose = "collection_import"
+
+// registryStandards maps the standards an import accepts to the chain registry's
+var registryStandards = map[domain.Standard]protoChainRegistry.ContractStandard{
+	domain.StdERC721:  protoChainRegistry.ContractStandard_STD_ERC721,
+	domain.StdERC1155: protoChainRegistry.ContractStandard_STD_ERC1155,
+}
+
+// SetCollectionImport enables importing collections deployed outside the marketplace
+func (s *Service) SetCollectionImport(inspector domain.CollectionInspector) {
+	s.inspector = inspector
+}
+
+// SetImportChallenges has wallet-service challenge the contract owner, so contracts owned by
+// a contract wallet such as a Safe can be imported with an EIP-1271 signature
+func (s *Service) SetImportChallenges(proofs domain.AddressProver) {
+	s.importProofs = proofs
+}
+
+// importResource is what an import challenge is about
+func importResource(chainID domain.ChainID, contract domain.Address) string {
+	return fmt.Sprintf("%s/%s", chainID, strings.ToLower(contract))
+}
+
+// PrepareImportCollection has wallet-service challenge the contract's current owner. The
+// challenge is single-use and bound to the user and the contract, so a signature can't be
+// replayed.
+func (s *Service) PrepareImportCollection(ctx context.Context, in domain.PrepareImportCollectionInput) (*domain.ImportChallenge, error) {
+	if in.ChainID == "" || in.UserID == "" || !IsValidEthereumAddress(in.Contract) {
+		return nil, domain.ErrInvalidInput
+	}
+	if s.importProofs == nil || s.inspector == nil {
+		return nil, domain.ErrImportDisabled
+	}
+	return s.prepareOwnerChallenge(ctx, in)
+}
+
+// prepareOwnerChallenge has wallet-service challenge the contract's current owner
+func (s *Service) prepareOwnerChallenge(ctx context.Context, in domain.PrepareImportCollectionInput) (*domain.ImportChallenge, error) {
+	contract := domain.Address(strings.ToLower(in.Contract))
+	info, err := s.inspector.InspectCollection(ctx, in.ChainID, contract)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, &domain.ValidationError{Field: "contract", Reason: "no contract deployed at address"}
+		}
+		return nil, fmt.Errorf("inspect collection: %w", err)
+	}
+	if !IsValidEthereumAddress(info.Owner) {
+		return nil, &domain.ValidationError{Field: "contract", Reason: "contract has no owner"}
+	}
+
+	challenge, err := s.importProofs.CreateAddressChallenge(ctx, importChallengePurpose, in.ChainID, info.Owner,
+		in.UserID, importResource(in.ChainID, contract), "Import collection to Zuno Marketplace")
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ImportChallenge{
+		ChallengeID: challenge.ID,
+		Message:     challenge.Message,
+		IssuedAt:    challenge.IssuedAt,
+		ExpiresAt:   challenge.ExpiresAt,
+	}, nil
+}
+
+// ImportCollection registers a collection deployed outside the marketplace for indexing.
+// The signer of the challenge must be the contract's owner(), and the contract must report
+// ERC-721 or ERC-1155 through ERC-165. The indexer backfills it from its deployment block
+// and the catalog crawls its metadata once the import event arrives.
+func (s *Service) ImportCollection(ctx context.Context, in domain.ImportCollectionInput) (*domain.ImportedCollection, error) {
+	if in.ChainID == "" || in.UserID == "" || in.Signature == "" || !IsValidEthereumAddress(in.Contract) {
+		return nil, domain.ErrInvalidInput
+	}
+	if s.inspector == nil || s.intentEvents == nil || s.importProofs == nil {
+		return nil, domain.ErrImportDisabled
+	}
+	// Clients built for the retired issuedAt signatures still send one without a challenge
+	if in.ChallengeID == "" {
+		return nil, domain.ErrChallengeRequired
+	}
+
+	now := time.Now()
+	contract := domain.Address(strings.ToLower(in.Contract))
+	signer, err := s.importSigner(ctx, in, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.creators != nil {
+		_, err := s.creators.GetCollectionCreator(ctx, in.ChainID, contract)
+		if err == nil {
+			return nil, domain.ErrCollectionExists
+		}
+		if !errors.Is(err, domain.ErrCollectionNotFound) {
+			return nil, fmt.Errorf("get collection: %w", err)
+		}
+	}
+
+	info, err := s.inspector.InspectCollection(ctx, in.ChainID, contract)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, &domain.ValidationError{Field: "contract", Reason: "no contract deployed at address"}
+		}
+		return nil, fmt.Errorf("inspect collection: %w", err)
+	}
+	if _, ok := registryStandards[info.Standard]; !ok {
+		return nil, domain.ErrUnsupportedStd
+	}
+	if !strings.EqualFold(info.Owner, signer) {
+		log.Printf("audit|event=collection_import_denied|chain_id=%s|contract=%s|user_id=%s|signer=%s|owner=%s|timestamp=%s",
+			in.ChainID, contract, in.UserID, signer, info.Owner, now.UTC().Format(time.RFC3339Nano))
+		return nil, domain.ErrNotContractOwner
+	}
+
+	// Without a deployment block the backfill starts from genesis, which is slower but complete
+	startBlock, err := s.inspector.DeploymentBlock(ctx, in.ChainID, contract)
+	if err != nil {
+		log.Printf("failed to find deployment block of %s on %s, backfilling from genesis: %v", contract, in.ChainID, err)
+		startBlock = 0
+	}
+	if startBlock > math.MaxInt32 {
+		return nil, fmt.Errorf("deployment block %d exceeds the registry's start block range", startBlock)
+	}
+
+	if _, err := s.chainRegistry.RegisterCollection(ctx, &protoChainRegistry.RegisterCollectionRequest{
+		ChainId:    in.ChainID,
+		Address:    contract,
+		Standard:   registryStandards[info.Standard],
+		StartBlock: int32(startBlock),
+		Reason:     importReason,
+	}); err != nil {
+		return nil, fmt.Errorf("register collection: %w", err)
+	}
+
+	imported := &domain.ImportedCollection{
+		ChainID:     in.ChainID,
+		Contract:    contract,
+		Standard:    info.Standard,
+		Owner:       strings.ToLower(info.Owner),
+		Name:        info.Name,
+		Symbol:      info.Symbol,
+		ContractURI: info.ContractURI,
+		StartBlock:  startBlock,
+		ImportedBy:  in.UserID,
+		ImportedAt:  now.UTC(),
+	}
+	if err := s.intentEvents.PublishCollectionImported(ctx, *imported); err != nil {
+		return nil, fmt.Errorf("publish collection imported: %w", err)
+	}
+
+	log.Printf("audit|event=collection_imported|chain_id=%s|contract=%s|user_id=%s|owner=%s|standard=%s|start_block=%d|timestamp=%s",
+		in.ChainID, contract, in.UserID, imported.Owner, info.Standard, startBlock, now.UTC().Format(time.RFC3339Nano))
+
+	return imported, nil
+}
+
+// importSigner returns the address that signed the import challenge
+func (s *Service) importSigner(ctx context.Context, in domain.ImportCollectionInput, contract domain.Address) (domain.Address, error) {
+	proof, err := s.importProofs.VerifyAddressChallenge(ctx, in.ChallengeID, in.Signature, importChallengePurpose, in.UserID)
+	if err != nil {
+		return "", err
+	}
+	// The challenge is the user's, but must also be for this contract
+	if proof.Resource != importResource(in.ChainID, contract) {
+		return "", &domain.ValidationError{Field: "challengeId", Reason: "challenge is for another collection"}
+	}
+	return proof.Address, nil
+}
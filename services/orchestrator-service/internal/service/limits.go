@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+// maxOpenIntentScan bounds the pending intents read to count one user's open ones
+const maxOpenIntentScan = 500
+
+// SetIntentLimits caps the intents each user may have open; it may be called while serving
+func (s *Service) SetIntentLimits(limits domain.IntentLimits) {
+	s.settingsMu.Lock()
+	defer s.settingsMu.Unlock()
+	s.intentLimits = limits
+}
+
+func (s *Service) currentIntentLimits() domain.IntentLimits {
+	s.settingsMu.RLock()
+	defer s.settingsMu.RUnlock()
+	return s.intentLimits
+}
+
+// SetSessionLinkedIntents turns session validation of collection intents on or off while
+// serving; timeout <= 0 keeps the current one
+func (s *Service) SetSessionLinkedIntents(enabled bool, timeout time.Duration) {
+	s.settingsMu.Lock()
+	defer s.settingsMu.Unlock()
+	s.sessionLinkedIntents = enabled
+	if timeout > 0 {
+		s.sessionValidationTimeout = timeout
+	}
+}
+
+func (s *Service) sessionSettings() (bool, time.Duration) {
+	s.settingsMu.RLock()
+	defer s.settingsMu.RUnlock()
+	return s.sessionLinkedIntents, s.sessionValidationTimeout
+}
+
+// intentOwner is who an intent counts against: the creating user, or the signer when the
+// caller passed no user
+func intentOwner(createdBy *string, signer domain.Address) string {
+	if createdBy != nil && *createdBy != "" {
+		return strings.ToLower(*createdBy)
+	}
+	return strings.ToLower(signer)
+}
+
+// checkIntentLimits rejects a new kind intent on chainID once the owner has as many open
+// intents as a limit allows. Confirmations only reach the status cache, so each stored
+// pending intent is overlaid with its cached status; intents older than the cache TTL
+// have been expired or resolved and are not read.
+func (s *Service) checkIntentLimits(ctx context.Context, owner string, kind domain.IntentKind, chainID domain.ChainID) error {
+	limits := s.currentIntentLimits()
+	if owner == "" || !limits.Enabled() {
+		return nil
+	}
+
+	open, err := s.repo.ListOpenIntents(ctx, owner, time.Now().Add(-domain.DefaultIntentTTL), maxOpenIntentScan)
+	if err != nil {
+		return fmt.Errorf("list open intents: %w", err)
+	}
+
+	slots := make(map[string]bool, len(open))
+	var total, ofKind, onChain int
+	for _, o := range open {
+		slot := o.Intent.ID
+		if o.BundleID != "" {
+			slot = "bundle:" + o.BundleID
+		}
+		if slots[slot] {
+			continue
+		}
+		switch s.currentStatus(ctx, o.Intent).Status {
+		case domain.IntentPending, domain.IntentStalled:
+		default:
+			continue
+		}
+		slots[slot] = true
+
+		total++
+		if o.Intent.Kind == kind {
+			ofKind++
+		}
+		if o.Intent.ChainID == chainID {
+			onChain++
+		}
+	}
+
+	switch {
+	case limits.MaxOpen > 0 && total >= limits.MaxOpen:
+		return domain.ErrIntentLimit.WithMessage(fmt.Sprintf("%d intents are already open; finish or let one expire first", total))
+	case limits.MaxOpenPerKind > 0 && ofKind >= limits.MaxOpenPerKind:
+		return domain.ErrIntentLimit.WithMessage(fmt.Sprintf("%d %s intents are already open; finish or let one expire first", ofKind, kind))
+	case limits.MaxOpenPerChain > 0 && onChain >= limits.MaxOpenPerChain:
+		return domain.ErrIntentLimit.WithMessage(fmt.Sprintf("%d intents are already open on %s; finish or let one expire first", onChain, chainID))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+// Batch sizes of one intent expiry sweep
+const (
+	expiryScanBatch    = 100
+	mediaReleaseBatch  = 100
+	maxExpiryScanPages = 10
+)
+
+// SetMediaRefs lets collection intents hold media-service assets
+func (s *Service) SetMediaRefs(media domain.MediaRefs) {
+	s.media = media
+}
+
+// attachMedia records the intent's assets, then takes a ref on each; media-service refuses
+// assets that are not pinned yet. On failure the refs already taken are released.
+func (s *Service) attachMedia(ctx context.Context, intentID string, assetIDs []string) error {
+	if len(assetIDs) == 0 {
+		return nil
+	}
+	// Recorded first so the expiry sweep releases refs an interrupted attach left behind
+	if err := s.repo.AttachMedia(ctx, intentID, assetIDs); err != nil {
+		return fmt.Errorf("attach media: %w", err)
+	}
+
+	for i, assetID := range assetIDs {
+		if err := s.media.AddRef(ctx, domain.MediaRef{IntentID: intentID, AssetID: assetID}); err != nil {
+			s.releaseMedia(ctx, intentID, assetIDs[:i])
+			return fmt.Errorf("asset %s: %w", assetID, err)
+		}
+	}
+	return nil
+}
+
+// releaseMedia gives back the intent's refs best-effort; the expiry sweep retries the
+// ones left held
+func (s *Service) releaseMedia(ctx context.Context, intentID string, assetIDs []string) {
+	for _, assetID := range assetIDs {
+		ref := domain.MediaRef{IntentID: intentID, AssetID: assetID}
+		if err := s.media.ReleaseRef(ctx, ref); err != nil {
+			log.Printf("failed to release asset %s of intent %s: %v", assetID, intentID, err)
+			continue
+		}
+		if err := s.repo.MarkMediaReleased(ctx, ref); err != nil {
+			log.Printf("failed to mark asset %s of intent %s released: %v", assetID, intentID, err)
+		}
+	}
+}
+
+// RunIntentExpiry expires unsent intents and releases the media of failed and expired ones
+// every interval until ctx is cancelled
+func (s *Service) RunIntentExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ExpireIntents(ctx, time.Now()); err != nil {
+				log.Printf("intent expiry failed: %v", err)
+			}
+			if _, err := s.ReleaseHeldMedia(ctx); err != nil {
+				log.Printf("media release failed: %v", err)
+			}
+		}
+	}
+}
+
+// ExpireIntents marks pending intents whose deadline passed before a tx was sent expired
+// and returns how many it expired
+func (s *Service) ExpireIntents(ctx context.Context, now time.Time) (int, error) {
+	expired := 0
+	for page := 0; page < maxExpiryScanPages; page++ {
+		intents, err := s.repo.ExpireUnsent(ctx, now, expiryScanBatch)
+		if err != nil {
+			return expired, err
+		}
+
+		reason := domain.IntentExpiredError
+		for _, intent := range intents {
+			if err := s.statusCache.SetIntentStatus(ctx, domain.IntentStatusPayload{
+				IntentID:        intent.ID,
+				Kind:            intent.Kind,
+				Status:          domain.IntentExpired,
+				ChainID:         &intent.ChainID,
+				ContractAddress: intent.PreviewAddress,
+				Error:           &reason,
+				Seq:             intent.StatusSeq,
+			}, domain.DefaultIntentTTL); err != nil {
+				log.Printf("failed to cache expired status of intent %s: %v", intent.ID, err)
+			}
+		}
+		expired += len(intents)
+
+		if len(intents) < expiryScanBatch {
+			break
+		}
+	}
+	return expired, nil
+}
+
+// ReleaseHeldMedia releases the refs failed and expired intents still hold and returns how
+// many it released. Refs media-service could not release are retried on the next sweep.
+func (s *Service) ReleaseHeldMedia(ctx context.Context) (int, error) {
+	if s.media == nil {
+		return 0, nil
+	}
+
+	refs, err := s.repo.ListReleasableMedia(ctx, mediaReleaseBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, ref := range refs {
+		if err := s.media.ReleaseRef(ctx, ref); err != nil {
+			log.Printf("failed to release asset %s of intent %s: %v", ref.AssetID, ref.IntentID, err)
+			continue
+		}
+		if err := s.repo.MarkMediaReleased(ctx, ref); err != nil {
+			return released, err
+		}
+		released++
+	}
+	return released, nil
+}
+
+// uniqueAssetIDs drops repeated asset ids, keeping the first occurrence
+func uniqueAssetIDs(assetIDs []string) []string {
+	if len(assetIDs) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(assetIDs))
+	unique := make([]string, 0, len(assetIDs))
+	for _, assetID := range assetIDs {
+		if !seen[assetID] {
+			seen[assetID] = true
+			unique = append(unique, assetID)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+const (
+	// MaxPayoutSplits bounds the recipients of one split; the splitter pays each on release
+	MaxPayoutSplits = 10
+	// payoutSplitsTotalBps is the share the recipients of a split must add up to
+	payoutSplitsTotalBps = 10000
+)
+
+// ValidatePayoutSplits checks a split has between two and MaxPayoutSplits distinct
+// recipients whose shares add up to 100%
+func ValidatePayoutSplits(splits []domain.PayoutSplit) error {
+	if len(splits) < 2 {
+		return &domain.ValidationError{Field: "payoutSplits", Reason: "a split needs at least 2 recipients"}
+	}
+	if len(splits) > MaxPayoutSplits {
+		return &domain.ValidationError{Field: "payoutSplits", Reason: fmt.Sprintf("too many recipients (max %d)", MaxPayoutSplits)}
+	}
+
+	seen := make(map[common.Address]bool, len(splits))
+	var total uint64
+	for _, split := range splits {
+		if !IsValidEthereumAddress(split.Recipient) {
+			return &domain.ValidationError{Field: "payoutSplits", Reason: fmt.Sprintf("invalid recipient address %q", split.Recipient)}
+		}
+		recipient := common.HexToAddress(split.Recipient)
+		if recipient == (common.Address{}) {
+			return &domain.ValidationError{Field: "payoutSplits", Reason: "recipient cannot be the zero address"}
+		}
+		if seen[recipient] {
+			return &domain.ValidationError{Field: "payoutSplits", Reason: fmt.Sprintf("duplicate recipient %s", split.Recipient)}
+		}
+		seen[recipient] = true
+		if split.Bps == 0 {
+			return &domain.ValidationError{Field: "payoutSplits", Reason: "each recipient needs a share above 0"}
+		}
+		total += split.Bps
+	}
+	if total != payoutSplitsTotalBps {
+		return &domain.ValidationError{Field: "payoutSplits", Reason: fmt.Sprintf("shares add up to %d bps, want %d", total, payoutSplitsTotalBps)}
+	}
+	return nil
+}
+
+// PrepareSetPayoutSplits replaces the payout split of a deployed collection
+func (s *Service) PrepareSetPayoutSplits(ctx context.Context, in domain.PrepareSetPayoutSplitsInput) (*domain.PrepareCollectionAdminResult, error) {
+	if in.ChainID == "" || in.Contract == "" || in.UserID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if err := ValidatePayoutSplits(in.Splits); err != nil {
+		return nil, err
+	}
+
+	recipients, shares := payoutSplitArgs(in.Splits)
+	return s.prepareCollectionAdmin(ctx, domain.IntentKindSetPayoutSplits, in.ChainID, domain.Address(strings.ToLower(in.Contract)), in.UserID, in,
+		"setPayoutSplits", recipients, shares)
+}
+
+// payoutSplitArgs converts splits into the recipients and shares arrays the contracts take
+func payoutSplitArgs(splits []domain.PayoutSplit) ([]common.Address, []*big.Int) {
+	recipients := make([]common.Address, len(splits))
+	shares := make([]*big.Int, len(splits))
+	for i, split := range splits {
+		recipients[i] = common.HexToAddress(split.Recipient)
+		shares[i] = new(big.Int).SetUint64(split.Bps)
+	}
+	return recipients, shares
+}
@@ -2,14 +2,18 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
-	"google.golang.org/grpc/metadata"
+	"github.com/quangdang46/NFT-Marketplace/shared/webhook"
 )
 
 type Service struct {
@@ -17,9 +21,48 @@ type Service struct {
 	encoder       domain.Encoder
 	statusCache   domain.StatusCache
 	chainRegistry protoChainRegistry.ChainRegistryServiceClient
+	// collection management authorization
+	creators domain.CollectionCreatorReader
+	wallets  domain.LinkedWalletReader
+	orgs     domain.CollectionOrgReader
+	members  domain.OrgMembershipReader
+	// optional; collection intents are not linked in the catalog without it
+	intentEvents domain.IntentEventPublisher
+	// optional; sent txs are not checked for stalls without it
+	txLookup    domain.TxLookup
+	stallPolicy domain.StallPolicy
+	// optional; intents on a halted chain are stalled like any other without it
+	liveness domain.ChainLiveness
+	// optional; collections can't be imported without it
+	inspector domain.CollectionInspector
+	// optional; imports are signed with orchestrator-built EOA challenges without it
+	importProofs domain.AddressProver
+	// optional; collection intents can't attach media without it
+	media domain.MediaRefs
+	// optional; airdrops and bulk transfers can't be prepared without bundles, airdrops
+	// sent to snapshots without snapshots, or bulk transfers checked without holdings
+	bundles       domain.IntentBundleRepo
+	snapshots     domain.HolderSnapshotReader
+	airdropPolicy domain.AirdropPolicy
+	holdings      domain.TokenHoldingReader
+	// settingsMu guards the settings runtime config may change while serving: intent
+	// limits and the session feature flag
+	settingsMu sync.RWMutex
+	// optional; users may open any number of intents without it
+	intentLimits domain.IntentLimits
+	// optional; collection intents can't take a callback URL without it
+	webhookSecret []byte
+	// optional; mints may target any contract without it
+	callTargets domain.CallTargetOverrideRepo
+	// optional; mint gas can't be sponsored without it
+	sponsorCounters domain.SponsorshipCounters
+	sponsorLedger   domain.SponsorshipLedger
+	sponsorPolicy   domain.SponsorshipPolicy
 	// feature flags
 	sessionLinkedIntents     bool
 	sessionValidationTimeout time.Duration
+	// optional; session-linked intents only get their session id format checked without it
+	sessions domain.SessionValidator
 }
 
 // NewOrchestrator preserves the original 5-arg constructor used in tests
@@ -62,68 +105,72 @@ func NewOrchestratorWithTimeout(
 	}
 }
 
-func (s *Service) getFactoryAddressAndType(ctx context.Context, chainID domain.ChainID, in domain.PrepareCreateCollectionInput) (domain.Address, domain.Standard, error) {
-	req := &protoChainRegistry.GetContractsRequest{
-		ChainId: chainID,
-	}
+// collectionFactories maps each collection type to the chain registry role of its factory
+var collectionFactories = map[domain.Standard]string{
+	domain.StdERC721:  contracts.RoleERC721Factory,
+	domain.StdERC1155: contracts.RoleERC1155Factory,
+}
 
-	resp, err := s.chainRegistry.GetContracts(ctx, req)
-	if err != nil {
-		return "", "", fmt.Errorf("get contracts from chain-registry: %w", err)
+func getFactoryAddress(resp *protoChainRegistry.GetContractsResponse, chainID domain.ChainID, collectionType domain.Standard) (domain.Address, error) {
+	role, ok := collectionFactories[collectionType]
+	if !ok {
+		return "", fmt.Errorf("unsupported collection type: %s", collectionType)
 	}
 
-	collectionType := in.Type
-
-	var targetFactory *protoChainRegistry.Contract
-	switch collectionType {
-	case domain.StdERC721:
-		for _, contract := range resp.Contracts {
-			if contract.Name == "ERC721CollectionFactory" {
-				targetFactory = contract
-				break
-			}
+	var factory *protoChainRegistry.Contract
+	for _, contract := range resp.Contracts {
+		if !contracts.HasRole(contract.Roles, role) {
+			continue
 		}
-	case domain.StdERC1155:
-		for _, contract := range resp.Contracts {
-			if contract.Name == "ERC1155CollectionFactory" {
-				targetFactory = contract
-				break
-			}
+		if factory != nil {
+			return "", fmt.Errorf("several contracts hold the %s role on chain %s: %s and %s", role, chainID, factory.Address, contract.Address)
 		}
-	default:
-		return "", "", fmt.Errorf("unsupported collection type: %s", collectionType)
+		factory = contract
 	}
-
-	if targetFactory == nil {
-		availableContracts := make([]string, 0, len(resp.Contracts))
-		for _, contract := range resp.Contracts {
-			availableContracts = append(availableContracts, contract.Name)
-		}
-		return "", "", fmt.Errorf("factory for collection type %s not found for chain %s. Available contracts: %v", collectionType, chainID, availableContracts)
+	if factory == nil {
+		return "", fmt.Errorf("factory for collection type %s not found for chain %s: no contract holds the %s role", collectionType, chainID, role)
 	}
-
-	return domain.Address(targetFactory.Address), collectionType, nil
+	return domain.Address(factory.Address), nil
 }
 
 func (s *Service) PrepareCreateCollection(ctx context.Context, in domain.PrepareCreateCollectionInput) (*domain.PrepareCreateCollectionResult, error) {
 	if err := ValidateCreateCollectionInput(in); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	in.AssetIDs = uniqueAssetIDs(in.AssetIDs)
+	if len(in.AssetIDs) > 0 && s.media == nil {
+		return nil, domain.ErrMediaNotConfigured
+	}
+	if in.CallbackURL != nil && len(s.webhookSecret) == 0 {
+		return nil, domain.ErrWebhooksDisabled
+	}
 
-	factoryAddr, collectionType, err := s.getFactoryAddressAndType(ctx, in.ChainID, in)
-	fmt.Println("factoryAddr", factoryAddr)
-	fmt.Println("collectionType", collectionType)
+	contracts, err := s.chainRegistry.GetContracts(ctx, &protoChainRegistry.GetContractsRequest{ChainId: in.ChainID})
+	if err != nil {
+		return nil, fmt.Errorf("get contracts from chain-registry: %w", err)
+	}
+	if err := ValidateCollectionConstraints(in, CollectionConstraintsFromParams(contracts.Params), time.Now()); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	collectionType := in.Type
+	factoryAddr, err := getFactoryAddress(contracts, in.ChainID, collectionType)
 	if err != nil {
 		return nil, fmt.Errorf("get factory address: %w", err)
 	}
+	if err := s.checkIntentLimits(ctx, intentOwner(in.CreatedBy, in.Creator), domain.IntentKindCollection, in.ChainID); err != nil {
+		return nil, err
+	}
 
 	intentID := uuid.New().String()
 	now := time.Now()
+	signer := strings.ToLower(in.Creator)
 
 	intent := &domain.Intent{
 		ID:        intentID,
 		Kind:      domain.IntentKindCollection,
 		ChainID:   in.ChainID,
+		Signer:    &signer,
 		Status:    domain.IntentPending,
 		CreatedBy: in.CreatedBy,
 		ReqPayloadJSON: map[string]interface{}{
@@ -136,36 +183,11 @@ func (s *Service) PrepareCreateCollection(ctx context.Context, in domain.Prepare
 		UpdatedAt:  now,
 	}
 
-	// Feature-flagged session validation and correlation
-	if s.sessionLinkedIntents {
-		vctx, cancel := context.WithTimeout(ctx, s.sessionValidationTimeout)
-		defer cancel()
-		var sessionID string
-		if md, ok := metadata.FromIncomingContext(vctx); ok {
-			vals := md.Get("x-auth-session-id")
-			if len(vals) > 0 {
-				sessionID = vals[0]
-			}
-		}
-		if sessionID == "" {
-			return nil, domain.ErrUnauthenticated
-		}
-		if _, err := uuid.Parse(sessionID); err != nil {
-			return nil, domain.ErrUnauthenticated
-		}
-		intent.AuthSessionID = &sessionID
-		log.Printf("audit|event=intent_validate|intent_id=%s|session_id=%s|timestamp=%s", intentID, sessionID, now.UTC().Format(time.RFC3339Nano))
-	} else {
-		// Best-effort correlation without enforcement
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			vals := md.Get("x-auth-session-id")
-			if len(vals) > 0 && vals[0] != "" {
-				sid := vals[0]
-				intent.AuthSessionID = &sid
-				log.Printf("audit|event=intent_create|intent_id=%s|session_id=%s|timestamp=%s", intentID, sid, now.UTC().Format(time.RFC3339Nano))
-			}
-		}
+	sessionID, err := s.linkSession(ctx, intentID, in.CreatedBy, now)
+	if err != nil {
+		return nil, err
 	}
+	intent.AuthSessionID = sessionID
 
 	if err := s.repo.Create(ctx, intent); err != nil {
 		return nil, fmt.Errorf("create intent: %w", err)
@@ -180,12 +202,21 @@ func (s *Service) PrepareCreateCollection(ctx context.Context, in domain.Prepare
 		})
 	}
 
+	if err := s.attachMedia(ctx, intentID, in.AssetIDs); err != nil {
+		errMsg := err.Error()
+		if updateErr := s.repo.UpdateStatus(ctx, intentID, domain.IntentFailed, &errMsg); updateErr != nil {
+			fmt.Printf("Failed to update intent status to failed: %v", updateErr)
+		}
+		return nil, err
+	}
+
 	to, data, value, preview, err := s.encoder.EncodeCreateCollection(ctx, in.ChainID, factoryAddr, in)
 	if err != nil {
 		errMsg := err.Error()
 		if updateErr := s.repo.UpdateStatus(ctx, intentID, domain.IntentFailed, &errMsg); updateErr != nil {
 			fmt.Printf("Failed to update intent status to failed: %v", updateErr)
 		}
+		s.releaseMedia(ctx, intentID, in.AssetIDs)
 		return nil, fmt.Errorf("encode create collection: %w", err)
 	}
 
@@ -209,13 +240,18 @@ func (s *Service) PrepareCreateCollection(ctx context.Context, in domain.Prepare
 		Status:          domain.IntentPending,
 		ChainID:         &in.ChainID,
 		ContractAddress: preview,
+		CallbackURL:     in.CallbackURL,
 	}
 	s.statusCache.SetIntentStatus(ctx, statusPayload, domain.DefaultIntentTTL)
 
-	return &domain.PrepareCreateCollectionResult{
+	result := &domain.PrepareCreateCollectionResult{
 		IntentID: intentID,
 		Tx:       txRequest,
-	}, nil
+	}
+	if in.CallbackURL != nil {
+		result.CallbackSecret = webhook.IntentSecret(s.webhookSecret, intentID)
+	}
+	return result, nil
 }
 
 func (s *Service) PrepareMint(ctx context.Context, in domain.PrepareMintInput) (*domain.PrepareMintResult, error) {
@@ -228,24 +264,46 @@ func (s *Service) PrepareMint(ctx context.Context, in domain.PrepareMintInput) (
 	default:
 		return nil, domain.ErrUnsupportedStd
 	}
+	owner := intentOwner(in.CreatedBy, in.Minter)
+	if err := s.checkCallTarget(ctx, domain.IntentKindMint, in.ChainID, in.Contract, owner); err != nil {
+		return nil, err
+	}
+	if err := s.checkIntentLimits(ctx, owner, domain.IntentKindMint, in.ChainID); err != nil {
+		return nil, err
+	}
 
 	intentID := uuid.New().String()
 	now := time.Now()
+	signer := strings.ToLower(in.Minter)
 
 	intent := &domain.Intent{
 		ID:             intentID,
 		Kind:           domain.IntentKindMint,
 		ChainID:        in.ChainID,
+		Signer:         &signer,
 		Status:         domain.IntentPending,
 		CreatedBy:      in.CreatedBy,
 		ReqPayloadJSON: in,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
+	sessionID, err := s.linkSession(ctx, intentID, in.CreatedBy, now)
+	if err != nil {
+		return nil, err
+	}
+	intent.AuthSessionID = sessionID
 
 	if err := s.repo.Create(ctx, intent); err != nil {
 		return nil, fmt.Errorf("create intent: %w", err)
 	}
+	if intent.AuthSessionID != nil {
+		_ = s.repo.InsertSessionIntentAudit(ctx, *intent.AuthSessionID, intentID, in.CreatedBy, map[string]any{
+			"operation":   "mint",
+			"chainId":     in.ChainID,
+			"contract":    in.Contract,
+			"requestedAt": now.UTC().Format(time.RFC3339Nano),
+		})
+	}
 
 	to, data, value, err := s.encoder.EncodeMint(ctx, in.ChainID, in.Contract, in.Standard, in)
 	if err != nil {
@@ -285,6 +343,13 @@ func (s *Service) TrackTx(ctx context.Context, in domain.TrackTxInput) (ok bool,
 	}
 
 	if intent.TxHash != nil && *intent.TxHash == in.TxHash {
+		// A retry after the cache write failed brings the cache up to the stored status
+		if cached, err := s.statusCache.GetIntentStatus(ctx, in.IntentID); err == nil && cached.Seq >= intent.StatusSeq {
+			return true, nil
+		}
+		if err := s.writeStatus(ctx, *storedStatus(intent)); err != nil {
+			return false, err
+		}
 		return true, nil
 	}
 
@@ -293,16 +358,12 @@ func (s *Service) TrackTx(ctx context.Context, in domain.TrackTxInput) (ok bool,
 		return false, domain.ErrDuplicateTx
 	}
 
-	err = s.repo.UpdateTxHash(ctx, in.IntentID, in.TxHash, in.Contract)
+	seq, err := s.repo.TrackTx(ctx, in.IntentID, in.TxHash, in.Contract)
 	if err != nil {
-		return false, fmt.Errorf("update tx hash: %w", err)
-	}
-
-	err = s.repo.UpdateStatus(ctx, in.IntentID, domain.IntentPending, nil)
-	if err != nil {
-		return false, fmt.Errorf("update status: %w", err)
+		return false, fmt.Errorf("track tx: %w", err)
 	}
 
+	// Written through before returning, so the caller's next GetIntentStatus sees the tx
 	statusPayload := domain.IntentStatusPayload{
 		IntentID:        in.IntentID,
 		Kind:            intent.Kind,
@@ -310,12 +371,67 @@ func (s *Service) TrackTx(ctx context.Context, in domain.TrackTxInput) (ok bool,
 		ChainID:         &in.ChainID,
 		TxHash:          &in.TxHash,
 		ContractAddress: in.Contract,
+		Seq:             seq,
 	}
-	s.statusCache.SetIntentStatus(ctx, statusPayload, domain.DefaultIntentTTL)
+	if err := s.writeStatus(ctx, statusPayload); err != nil {
+		return false, err
+	}
+	s.announceTxTracked(ctx, intent, in)
 
 	return true, nil
 }
 
+// writeStatus writes a stored status through to the cache. A cache already at the same or
+// a later sequence is left as is, since the subscription-worker may have advanced it.
+func (s *Service) writeStatus(ctx context.Context, payload domain.IntentStatusPayload) error {
+	err := s.statusCache.WriteIntentStatus(ctx, payload, domain.DefaultIntentTTL)
+	if err != nil && !errors.Is(err, domain.ErrStatusChanged) {
+		return fmt.Errorf("write status: %w", err)
+	}
+	return nil
+}
+
+// SetWebhookSecret sets the master secret intent webhooks are signed with; the
+// subscription-worker must hold the same one
+func (s *Service) SetWebhookSecret(secret []byte) {
+	s.webhookSecret = secret
+}
+
+// SetIntentEvents wires the publisher that links collection intents to catalog rows
+func (s *Service) SetIntentEvents(publisher domain.IntentEventPublisher) {
+	s.intentEvents = publisher
+}
+
+// announceTxTracked tells the catalog which intent and user deployed a collection. The tx
+// is already tracked, so a failed publish is only logged.
+func (s *Service) announceTxTracked(ctx context.Context, intent *domain.Intent, in domain.TrackTxInput) {
+	if s.intentEvents == nil || intent.Kind != domain.IntentKindCollection {
+		return
+	}
+
+	event := domain.TxTracked{
+		IntentID:  in.IntentID,
+		Kind:      intent.Kind,
+		ChainID:   in.ChainID,
+		TxHash:    in.TxHash,
+		Contract:  in.Contract,
+		TrackedAt: time.Now().UTC(),
+	}
+	if intent.CreatedBy != nil {
+		event.CreatedBy = *intent.CreatedBy
+	}
+	if event.Contract == nil {
+		event.Contract = intent.PreviewAddress
+	}
+
+	if err := s.intentEvents.PublishTxTracked(ctx, event); err != nil {
+		log.Printf("failed to publish tx_tracked for intent %s: %v", in.IntentID, err)
+	}
+}
+
+// GetIntentStatus prefers the cached status, which the subscription-worker also advances,
+// and falls back to the stored intent when the cache has none, is unavailable or is behind
+// the intent's stored seq. Cache writes are best-effort, so the seq is always checked.
 func (s *Service) GetIntentStatus(ctx context.Context, intentID string) (*domain.IntentStatusPayload, error) {
 	if intentID == "" {
 		return nil, domain.ErrInvalidInput
@@ -326,6 +442,23 @@ func (s *Service) GetIntentStatus(ctx context.Context, intentID string) (*domain
 		return nil, fmt.Errorf("get intent: %w", err)
 	}
 
+	return s.currentStatus(ctx, intent), nil
+}
+
+// currentStatus is GetIntentStatus for an intent already loaded
+func (s *Service) currentStatus(ctx context.Context, intent *domain.Intent) *domain.IntentStatusPayload {
+	cached, err := s.statusCache.GetIntentStatus(ctx, intent.ID)
+	if err == nil && cached.Seq >= intent.StatusSeq {
+		return cached
+	}
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		log.Printf("failed to read cached status for intent %s: %v", intent.ID, err)
+	}
+	return storedStatus(intent)
+}
+
+// storedStatus is the status recorded on the intent itself
+func storedStatus(intent *domain.Intent) *domain.IntentStatusPayload {
 	statusPayload := domain.IntentStatusPayload{
 		IntentID:        intent.ID,
 		Kind:            intent.Kind,
@@ -333,7 +466,54 @@ func (s *Service) GetIntentStatus(ctx context.Context, intentID string) (*domain
 		ChainID:         &intent.ChainID,
 		TxHash:          intent.TxHash,
 		ContractAddress: intent.PreviewAddress,
+		Seq:             intent.StatusSeq,
+	}
+	if intent.Status == domain.IntentFailed {
+		statusPayload.Error = intent.Error
 	}
+	return &statusPayload
+}
+
+const (
+	defaultIntentPageSize = 20
+	maxIntentPageSize     = 100
+)
 
-	return &statusPayload, nil
+// ListIntents pages the intents a wallet was asked to sign, newest first. Statuses are
+// overlaid from the cache like GetIntentStatus, since confirmations only advance it there.
+func (s *Service) ListIntents(ctx context.Context, in domain.ListIntentsInput) ([]*domain.Intent, error) {
+	if !IsValidEthereumAddress(in.Signer) {
+		return nil, domain.ErrInvalidInput
+	}
+	if in.Before != nil {
+		if _, err := uuid.Parse(in.BeforeID); err != nil {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+	if in.Limit <= 0 {
+		in.Limit = defaultIntentPageSize
+	}
+	if in.Limit > maxIntentPageSize {
+		in.Limit = maxIntentPageSize
+	}
+
+	intents, err := s.repo.ListBySigner(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("list intents: %w", err)
+	}
+
+	for _, intent := range intents {
+		cached, err := s.statusCache.GetIntentStatus(ctx, intent.ID)
+		if err != nil {
+			if !errors.Is(err, domain.ErrNotFound) {
+				log.Printf("failed to read cached status for intent %s: %v", intent.ID, err)
+			}
+			continue
+		}
+		intent.Status = cached.Status
+		if cached.TxHash != nil {
+			intent.TxHash = cached.TxHash
+		}
+	}
+	return intents, nil
 }
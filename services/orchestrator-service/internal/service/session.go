@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"google.golang.org/grpc/metadata"
+)
+
+// SetSessionValidator has the auth sessions of session-linked intents checked against
+// auth-service; without it only the session id's format is checked
+func (s *Service) SetSessionValidator(sessions domain.SessionValidator) {
+	s.sessions = sessions
+}
+
+// linkSession returns the auth session to record on a new intent, from the caller's
+// x-auth-session-id metadata. With session-linked intents on, the session must be an active
+// session of createdBy. When auth-service can't answer within the validation timeout the
+// format check alone stands, so a slow auth service weakens validation instead of failing
+// intent creation.
+func (s *Service) linkSession(ctx context.Context, intentID string, createdBy *string, now time.Time) (*string, error) {
+	var sessionID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("x-auth-session-id"); len(vals) > 0 {
+			sessionID = vals[0]
+		}
+	}
+
+	sessionLinked, timeout := s.sessionSettings()
+	if !sessionLinked {
+		// Best-effort correlation without enforcement
+		if sessionID == "" {
+			return nil, nil
+		}
+		log.Printf("audit|event=intent_create|intent_id=%s|session_id=%s|timestamp=%s", intentID, sessionID, now.UTC().Format(time.RFC3339Nano))
+		return &sessionID, nil
+	}
+
+	if sessionID == "" || createdBy == nil || *createdBy == "" {
+		return nil, domain.ErrUnauthenticated
+	}
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return nil, domain.ErrUnauthenticated
+	}
+
+	if s.sessions != nil {
+		vctx, cancel := context.WithTimeout(ctx, timeout)
+		active, err := s.sessions.ValidateSession(vctx, *createdBy, sessionID)
+		cancel()
+		switch {
+		case err != nil:
+			log.Printf("audit|event=intent_validate_degraded|intent_id=%s|session_id=%s|error=%q|timestamp=%s", intentID, sessionID, err.Error(), now.UTC().Format(time.RFC3339Nano))
+		case !active:
+			log.Printf("audit|event=intent_validate_rejected|intent_id=%s|session_id=%s|user_id=%s|timestamp=%s", intentID, sessionID, *createdBy, now.UTC().Format(time.RFC3339Nano))
+			return nil, domain.ErrUnauthenticated
+		}
+	}
+
+	log.Printf("audit|event=intent_validate|intent_id=%s|session_id=%s|timestamp=%s", intentID, sessionID, now.UTC().Format(time.RFC3339Nano))
+	return &sessionID, nil
+}
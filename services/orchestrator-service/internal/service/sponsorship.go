@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+// defaultGrantTTL is how long a grant holds budget when the policy sets no TTL
+const defaultGrantTTL = 15 * time.Minute
+
+// SetSponsorship lets a relayer have the gas of mint intents sponsored from per-chain
+// budgets: counters enforce the daily caps and hold budget for live grants, the ledger
+// keeps the budgets and what they paid for
+func (s *Service) SetSponsorship(counters domain.SponsorshipCounters, ledger domain.SponsorshipLedger, policy domain.SponsorshipPolicy) {
+	if policy.GrantTTL <= 0 {
+		policy.GrantTTL = defaultGrantTTL
+	}
+	s.sponsorCounters = counters
+	s.sponsorLedger = ledger
+	s.sponsorPolicy = policy
+}
+
+func (s *Service) sponsorshipEnabled() bool {
+	return s.sponsorCounters != nil && s.sponsorLedger != nil
+}
+
+// ReserveSponsorship holds gasGwei of the chain budget for a pending mint intent. It is
+// refused while the budget is frozen, when the budget less the gas other live grants hold
+// can't cover it, or when it would take the collection or the user past a daily cap.
+// Reserving again while the intent holds a live grant returns that grant.
+func (s *Service) ReserveSponsorship(ctx context.Context, intentID string, gasGwei int64) (*domain.SponsorshipGrant, error) {
+	if !s.sponsorshipEnabled() {
+		return nil, domain.ErrSponsorshipDisabled
+	}
+	if intentID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if gasGwei <= 0 {
+		return nil, &domain.ValidationError{Field: "gas_gwei", Reason: "must be positive"}
+	}
+
+	intent, err := s.repo.GetByID(ctx, intentID)
+	if err != nil {
+		return nil, fmt.Errorf("get intent: %w", err)
+	}
+	if intent.Kind != domain.IntentKindMint || s.currentStatus(ctx, intent).Status != domain.IntentPending {
+		return nil, domain.ErrNotSponsorable
+	}
+	mint, err := mintInput(intent)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := s.sponsorLedger.GetBudget(ctx, intent.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("get sponsorship budget: %w", err)
+	}
+	if budget.Frozen {
+		return nil, domain.ErrSponsorshipFrozen.WithMessage(fmt.Sprintf("gas sponsorship on %s is frozen: %s", intent.ChainID, budget.FrozenReason))
+	}
+
+	now := time.Now()
+	grant := &domain.SponsorshipGrant{
+		ID:         uuid.New().String(),
+		IntentID:   intent.ID,
+		ChainID:    intent.ChainID,
+		Collection: strings.ToLower(mint.Contract),
+		UserID:     intentOwner(intent.CreatedBy, mint.Minter),
+		GasGwei:    gasGwei,
+		Day:        now.UTC().Format(time.DateOnly),
+		ExpiresAt:  now.Add(s.sponsorPolicy.GrantTTL),
+	}
+	caps := s.sponsorPolicy.CapsFor(intent.ChainID)
+	held, limit, err := s.sponsorCounters.HoldGrant(ctx, grant, caps, budget.BalanceGwei)
+	if err != nil {
+		return nil, fmt.Errorf("hold sponsorship grant: %w", err)
+	}
+	if limit != domain.SponsorshipWithinLimits {
+		log.Printf("audit|event=sponsorship_refused|intent_id=%s|chain_id=%s|collection=%s|user_id=%s|gas_gwei=%d|limit=%s|timestamp=%s",
+			intent.ID, grant.ChainID, grant.Collection, grant.UserID, gasGwei, limit, now.UTC().Format(time.RFC3339Nano))
+	}
+
+	switch limit {
+	case domain.SponsorshipCollectionCap:
+		return nil, domain.ErrSponsorshipCap.WithMessage(fmt.Sprintf("collection %s reached its daily sponsorship cap of %d gwei on %s; it resets at 00:00 UTC", grant.Collection, caps.CollectionDailyGwei, grant.ChainID))
+	case domain.SponsorshipUserCap:
+		return nil, domain.ErrSponsorshipCap.WithMessage(fmt.Sprintf("daily sponsorship cap of %d gwei on %s reached; it resets at 00:00 UTC", caps.UserDailyGwei, grant.ChainID))
+	case domain.SponsorshipChainBudget:
+		return nil, domain.ErrSponsorshipBudget.WithMessage(fmt.Sprintf("the %s sponsorship budget can't cover %d gwei", grant.ChainID, gasGwei))
+	}
+	if held.ID != grant.ID {
+		return held, nil
+	}
+
+	log.Printf("audit|event=sponsorship_reserved|grant_id=%s|intent_id=%s|chain_id=%s|collection=%s|user_id=%s|gas_gwei=%d|timestamp=%s",
+		grant.ID, intent.ID, grant.ChainID, grant.Collection, grant.UserID, gasGwei, now.UTC().Format(time.RFC3339Nano))
+	return grant, nil
+}
+
+// SettleSponsorship charges the chain budget for the gas a relayed mint spent and frees the
+// rest of its grant. It reports false when the grant was charged before, so a relayer may
+// retry it until the grant expires. Spending more than the grant holds is refused.
+func (s *Service) SettleSponsorship(ctx context.Context, grantID string, spentGwei int64, txHash string) (bool, error) {
+	if !s.sponsorshipEnabled() {
+		return false, domain.ErrSponsorshipDisabled
+	}
+	if grantID == "" {
+		return false, domain.ErrInvalidInput
+	}
+	if spentGwei < 0 {
+		return false, &domain.ValidationError{Field: "spent_gwei", Reason: "must not be negative"}
+	}
+
+	grant, err := s.sponsorCounters.GetGrant(ctx, grantID)
+	if err != nil {
+		return false, err
+	}
+	if spentGwei > grant.GasGwei {
+		return false, &domain.ValidationError{Field: "spent_gwei", Reason: fmt.Sprintf("exceeds the %d gwei the grant holds", grant.GasGwei)}
+	}
+	now := time.Now()
+	txHash = strings.ToLower(txHash)
+	charged, err := s.sponsorLedger.SettleGrant(ctx, grant, spentGwei, txHash, now)
+	if err != nil {
+		return false, fmt.Errorf("settle sponsorship grant: %w", err)
+	}
+	if err := s.sponsorCounters.ReleaseGrant(ctx, grant, spentGwei); err != nil {
+		return false, fmt.Errorf("release sponsorship grant: %w", err)
+	}
+
+	if charged {
+		log.Printf("audit|event=sponsorship_settled|grant_id=%s|intent_id=%s|chain_id=%s|gas_gwei=%d|spent_gwei=%d|tx_hash=%s|timestamp=%s",
+			grant.ID, grant.IntentID, grant.ChainID, grant.GasGwei, spentGwei, txHash, now.UTC().Format(time.RFC3339Nano))
+	}
+	return charged, nil
+}
+
+// ReleaseSponsorship frees a grant whose mint was never relayed
+func (s *Service) ReleaseSponsorship(ctx context.Context, grantID string) error {
+	if !s.sponsorshipEnabled() {
+		return domain.ErrSponsorshipDisabled
+	}
+	if grantID == "" {
+		return domain.ErrInvalidInput
+	}
+
+	grant, err := s.sponsorCounters.GetGrant(ctx, grantID)
+	if err != nil {
+		return err
+	}
+	if err := s.sponsorCounters.ReleaseGrant(ctx, grant, 0); err != nil {
+		return fmt.Errorf("release sponsorship grant: %w", err)
+	}
+	return nil
+}
+
+// TopUpSponsorshipBudget adds gas to a chain budget. Callers authorize the admin.
+func (s *Service) TopUpSponsorshipBudget(ctx context.Context, chainID domain.ChainID, amountGwei int64, note, actorID string) (*domain.SponsorshipBudget, error) {
+	if !s.sponsorshipEnabled() {
+		return nil, domain.ErrSponsorshipDisabled
+	}
+	if !IsValidCAIP2ChainID(chainID) || actorID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if amountGwei <= 0 {
+		return nil, &domain.ValidationError{Field: "amount_gwei", Reason: "must be positive"}
+	}
+	note = strings.TrimSpace(note)
+
+	now := time.Now()
+	budget, err := s.sponsorLedger.TopUpBudget(ctx, chainID, amountGwei, note, actorID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("audit|event=sponsorship_topped_up|chain_id=%s|amount_gwei=%d|balance_gwei=%d|actor_id=%s|note=%q|timestamp=%s",
+		chainID, amountGwei, budget.BalanceGwei, actorID, note, now.UTC().Format(time.RFC3339Nano))
+	return budget, nil
+}
+
+// SetSponsorshipBudgetFrozen stops or resumes sponsoring on a chain; grants held already
+// may still settle. Callers authorize the admin.
+func (s *Service) SetSponsorshipBudgetFrozen(ctx context.Context, chainID domain.ChainID, frozen bool, reason, actorID string) (*domain.SponsorshipBudget, error) {
+	if !s.sponsorshipEnabled() {
+		return nil, domain.ErrSponsorshipDisabled
+	}
+	if !IsValidCAIP2ChainID(chainID) || actorID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	reason = strings.TrimSpace(reason)
+	if frozen && reason == "" {
+		return nil, &domain.ValidationError{Field: "reason", Reason: "is required"}
+	}
+	if !frozen {
+		reason = ""
+	}
+
+	now := time.Now()
+	budget, err := s.sponsorLedger.SetBudgetFrozen(ctx, chainID, frozen, reason, actorID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("audit|event=sponsorship_frozen|chain_id=%s|frozen=%t|actor_id=%s|reason=%q|timestamp=%s",
+		chainID, frozen, actorID, reason, now.UTC().Format(time.RFC3339Nano))
+	return budget, nil
+}
+
+// ListSponsorshipBudgets lists the budget of every chain ever topped up or frozen. Callers
+// authorize the admin.
+func (s *Service) ListSponsorshipBudgets(ctx context.Context) ([]*domain.SponsorshipBudget, error) {
+	if !s.sponsorshipEnabled() {
+		return nil, domain.ErrSponsorshipDisabled
+	}
+	return s.sponsorLedger.ListBudgets(ctx)
+}
+
+// mintInput reads the request a mint intent was prepared from back out of its payload
+func mintInput(intent *domain.Intent) (*domain.PrepareMintInput, error) {
+	raw, err := json.Marshal(intent.ReqPayloadJSON)
+	if err != nil {
+		return nil, fmt.Errorf("encode mint payload: %w", err)
+	}
+	var in domain.PrepareMintInput
+	if err := json.Unmarshal(raw, &in); err != nil {
+		return nil, fmt.Errorf("decode mint payload: %w", err)
+	}
+	if in.Contract == "" {
+		return nil, errors.New("mint payload has no contract")
+	}
+	return &in, nil
+}
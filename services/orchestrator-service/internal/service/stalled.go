@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+)
+
+// stalledScanBatch is how many intents one page of a stalled intent scan reads
+const stalledScanBatch = 100
+
+// Error messages of intents the detector fails
+const (
+	errTxReverted = "transaction reverted"
+	errTxDropped  = "transaction dropped: not mined and no longer in the mempool"
+)
+
+// SetStallDetection enables the stalled intent detector
+func (s *Service) SetStallDetection(lookup domain.TxLookup, policy domain.StallPolicy) {
+	s.txLookup = lookup
+	s.stallPolicy = policy
+}
+
+// SetChainLiveness holds stall checks on chains whose head stopped advancing, where a tx
+// waiting for the next block is neither stuck nor dropped
+func (s *Service) SetChainLiveness(liveness domain.ChainLiveness) {
+	s.liveness = liveness
+}
+
+// RunStalledIntentDetector checks sent txs every interval until ctx is cancelled
+func (s *Service) RunStalledIntentDetector(ctx context.Context, interval time.Duration) {
+	if s.txLookup == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.DetectStalledIntents(ctx, time.Now()); err != nil {
+				log.Printf("stalled intent detection failed: %v", err)
+			}
+		}
+	}
+}
+
+// DetectStalledIntents re-checks every intent whose tx is unconfirmed past its chain's
+// timeout and returns how many changed status. A reverted tx fails the intent. One still in
+// the mempool is stalled with a speed up offer, and one no node knows is stalled with a
+// resubmit offer until FailAfter, when it fails. A stalled intent whose tx got mined goes
+// back to pending so the confirmation resolves it. Intents on a halted chain are left alone.
+func (s *Service) DetectStalledIntents(ctx context.Context, now time.Time) (int, error) {
+	if s.txLookup == nil {
+		return 0, nil
+	}
+
+	shortest := s.stallPolicy.DefaultTimeout
+	for _, t := range s.stallPolicy.Timeouts {
+		if t > 0 && t < shortest {
+			shortest = t
+		}
+	}
+
+	// Older intents have no cached status left to show a stall on
+	in := domain.ListAwaitingInput{
+		After: now.Add(-domain.DefaultIntentTTL),
+		Until: now.Add(-shortest),
+		Limit: stalledScanBatch,
+	}
+	changed := 0
+	halted := make(map[domain.ChainID]bool)
+	for {
+		intents, err := s.repo.ListAwaitingConfirmation(ctx, in)
+		if err != nil {
+			return changed, err
+		}
+
+		for _, intent := range intents {
+			if s.chainHalted(ctx, halted, intent.ChainID) {
+				continue
+			}
+			ok, err := s.checkStalledIntent(ctx, intent, now)
+			if err != nil {
+				log.Printf("failed to check stalled intent %s: %v", intent.ID, err)
+				continue
+			}
+			if ok {
+				changed++
+			}
+		}
+
+		if len(intents) < in.Limit {
+			return changed, nil
+		}
+		last := intents[len(intents)-1]
+		in.After, in.AfterID = last.UpdatedAt, last.ID
+	}
+}
+
+// chainHalted asks about each chain once per scan. A chain whose liveness can't be read
+// counts as live, so a registry outage never holds stall detection.
+func (s *Service) chainHalted(ctx context.Context, seen map[domain.ChainID]bool, chainID domain.ChainID) bool {
+	if s.liveness == nil {
+		return false
+	}
+	if halted, ok := seen[chainID]; ok {
+		return halted
+	}
+	halted, err := s.liveness.ChainHalted(ctx, chainID)
+	if err != nil {
+		log.Printf("failed to read liveness of chain %s: %v", chainID, err)
+	}
+	if halted {
+		log.Printf("chain %s head stopped advancing, holding stall checks", chainID)
+	}
+	seen[chainID] = halted
+	return halted
+}
+
+func (s *Service) checkStalledIntent(ctx context.Context, intent *domain.Intent, now time.Time) (bool, error) {
+	age := now.Sub(intent.UpdatedAt)
+	if intent.TxHash == nil || age < s.stallPolicy.Timeout(intent.ChainID) {
+		return false, nil
+	}
+
+	// Confirmations only advance the cached status
+	current := &domain.IntentStatusPayload{Status: domain.IntentPending}
+	cached, err := s.statusCache.GetIntentStatus(ctx, intent.ID)
+	switch {
+	case err == nil:
+		current = cached
+	case !errors.Is(err, domain.ErrNotFound):
+		return false, err
+	}
+	if current.Status != domain.IntentPending && current.Status != domain.IntentStalled {
+		return false, nil
+	}
+
+	state, err := s.txLookup.LookupTx(ctx, intent.ChainID, *intent.TxHash)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case state == domain.TxReverted:
+		return s.failStalledIntent(ctx, intent, current.Version, errTxReverted)
+	case state == domain.TxUnknown && s.stallPolicy.FailAfter > 0 && age >= s.stallPolicy.FailAfter:
+		return s.failStalledIntent(ctx, intent, current.Version, errTxDropped)
+	case state == domain.TxUnknown:
+		return s.stallIntent(ctx, intent, current, domain.RecoveryResubmit)
+	case state == domain.TxQueued:
+		return s.stallIntent(ctx, intent, current, domain.RecoverySpeedUp)
+	case current.Status == domain.IntentStalled:
+		// Mined after all; the indexer only resolves pending intents
+		return s.replaceStatus(ctx, intent, domain.IntentStatusPayload{Status: domain.IntentPending, Version: current.Version})
+	default:
+		return false, nil
+	}
+}
+
+func (s *Service) stallIntent(ctx context.Context, intent *domain.Intent, current *domain.IntentStatusPayload, recovery domain.IntentRecovery) (bool, error) {
+	if current.Status == domain.IntentStalled && current.Recovery != nil && *current.Recovery == recovery {
+		return false, nil
+	}
+	ok, err := s.replaceStatus(ctx, intent, domain.IntentStatusPayload{
+		Status:   domain.IntentStalled,
+		Recovery: &recovery,
+		Version:  current.Version,
+	})
+	if ok {
+		log.Printf("intent %s stalled on tx %s, offering %s", intent.ID, *intent.TxHash, recovery)
+	}
+	return ok, err
+}
+
+func (s *Service) failStalledIntent(ctx context.Context, intent *domain.Intent, version int64, reason string) (bool, error) {
+	ok, err := s.replaceStatus(ctx, intent, domain.IntentStatusPayload{
+		Status:  domain.IntentFailed,
+		Error:   &reason,
+		Version: version,
+	})
+	if !ok || err != nil {
+		return ok, err
+	}
+	if err := s.repo.UpdateStatus(ctx, intent.ID, domain.IntentFailed, &reason); err != nil {
+		return true, err
+	}
+	log.Printf("intent %s failed on tx %s: %s", intent.ID, *intent.TxHash, reason)
+	return true, nil
+}
+
+// replaceStatus writes the intent's new status unless it changed since it was read, in
+// which case the intent is left for the next scan
+func (s *Service) replaceStatus(ctx context.Context, intent *domain.Intent, payload domain.IntentStatusPayload) (bool, error) {
+	payload.IntentID = intent.ID
+	payload.Kind = intent.Kind
+	payload.ChainID = &intent.ChainID
+	payload.TxHash = intent.TxHash
+
+	err := s.statusCache.ReplaceIntentStatus(ctx, payload, domain.DefaultIntentTTL)
+	if errors.Is(err, domain.ErrStatusChanged) {
+		return false, nil
+	}
+	return err == nil, err
+}
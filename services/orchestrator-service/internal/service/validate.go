@@ -3,11 +3,14 @@ package service
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/webhook"
 )
 
+// MaxCollectionAssets bounds the media assets one collection intent can hold
+const MaxCollectionAssets = 10
+
 // ValidateCreateCollectionInput validates the create collection input
 func ValidateCreateCollectionInput(in domain.PrepareCreateCollectionInput) error {
 	if in.ChainID == "" {
@@ -52,28 +55,36 @@ func ValidateCreateCollectionInput(in domain.PrepareCreateCollectionInput) error
 		return fmt.Errorf("invalid chain ID format (expected CAIP-2 format like 'eip155:1')")
 	}
 
-	if in.MintPrice != nil && *in.MintPrice > 0 {
-	}
-	if in.RoyaltyFee != nil && *in.RoyaltyFee > 10000 {
-		return fmt.Errorf("royalty fee cannot exceed 100%%")
-	}
 	if in.MaxSupply != nil && *in.MaxSupply == 0 {
 		return fmt.Errorf("max supply must be greater than 0")
 	}
-	if in.MaxSupply != nil && *in.MaxSupply > 1000000 {
-		return fmt.Errorf("max supply too high (max 1,000,000)")
-	}
 
 	if in.MintLimitPerWallet != nil && *in.MintLimitPerWallet == 0 {
 		return fmt.Errorf("mint limit per wallet must be greater than 0")
 	}
 
-	if in.MintStartTime != nil && *in.MintStartTime > 0 {
-		if *in.MintStartTime < uint64(time.Now().Unix()) {
-			return fmt.Errorf("mint start time cannot be in the past")
+	if len(in.AssetIDs) > MaxCollectionAssets {
+		return fmt.Errorf("too many assets (max %d)", MaxCollectionAssets)
+	}
+	for _, assetID := range in.AssetIDs {
+		if strings.TrimSpace(assetID) == "" {
+			return fmt.Errorf("asset id cannot be empty")
+		}
+	}
+
+	if in.CallbackURL != nil {
+		if err := webhook.ValidateURL(*in.CallbackURL); err != nil {
+			return &domain.ValidationError{Field: "callbackUrl", Reason: err.Error()}
+		}
+	}
+
+	if len(in.PayoutSplits) > 0 {
+		if err := ValidatePayoutSplits(in.PayoutSplits); err != nil {
+			return err
 		}
 	}
 
+	// Royalty, supply and schedule bounds depend on the chain; see ValidateCollectionConstraints
 	return nil
 }
 
@@ -2,46 +2,294 @@ package status
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"sync"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
 )
 
+const (
+	// DefaultFlushDelay bounds how long an update waits to share a pipeline with others
+	DefaultFlushDelay = 25 * time.Millisecond
+	// DefaultMaxBatch flushes early once this many intents are pending
+	DefaultMaxBatch = 128
+)
+
+// Store is the canonical intent status store; *redis.Redis implements it
+type Store interface {
+	WriteIntentStatuses(ctx context.Context, updates ...redis.IntentStatusUpdate) ([]int64, error)
+	ReadIntentStatus(ctx context.Context, intentID string) (contracts.IntentStatusRecord, error)
+}
+
+// StatusCache keeps one Redis hash per intent. Updates are buffered briefly and coalesced
+// per intent, so a burst of TrackTx calls reaches Redis as one pipeline; the store
+// publishes every write on contracts.IntentStatusChannel, or appends it to
+// contracts.IntentStatusStream under the stream transport.
 type StatusCache struct {
-	redis *redis.Redis
+	store      Store
+	flushDelay time.Duration
+	maxBatch   int
+
+	mu      sync.Mutex
+	pending map[string]redis.IntentStatusUpdate
+	order   []string
+	timer   *time.Timer
 }
 
 func NewStatusCache() domain.StatusCache {
-	return &StatusCache{}
+	return &StatusCache{
+		flushDelay: DefaultFlushDelay,
+		maxBatch:   DefaultMaxBatch,
+		pending:    make(map[string]redis.IntentStatusUpdate),
+	}
+}
+
+// SetRedis sets the Redis client (called from main.go)
+func (s *StatusCache) SetRedis(r *redis.Redis) {
+	s.SetStore(r)
 }
 
-// SetIntentStatus stores intent status in Redis with TTL
+// SetStore sets the backing store
+func (s *StatusCache) SetStore(store Store) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store = store
+}
+
+// SetBatching overrides the flush delay and batch size
+func (s *StatusCache) SetBatching(flushDelay time.Duration, maxBatch int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.flushDelay = flushDelay
+	s.maxBatch = maxBatch
+}
+
+// SetIntentStatus queues a status update. Updates to the same intent before the next
+// flush are merged, so only the latest value of each field is written.
 func (s *StatusCache) SetIntentStatus(ctx context.Context, payload domain.IntentStatusPayload, ttl time.Duration) error {
-	if s.redis == nil {
-		// For now, just return nil to avoid breaking the build
-		// In production, this would require proper Redis initialization
+	if payload.IntentID == "" {
+		return domain.ErrInvalidInput
+	}
+
+	s.mu.Lock()
+	if s.store == nil {
+		s.mu.Unlock()
 		return nil
 	}
 
-	key := fmt.Sprintf("intent:status:%s", payload.IntentID)
+	update := redis.IntentStatusUpdate{Record: recordFromPayload(payload), TTL: ttl}
+	if prev, ok := s.pending[payload.IntentID]; ok {
+		update.Record = prev.Record.Merge(update.Record)
+	} else {
+		s.order = append(s.order, payload.IntentID)
+	}
+	s.pending[payload.IntentID] = update
 
-	data, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("marshal payload: %w", err)
+	full := len(s.order) >= s.maxBatch
+	if !full && s.timer == nil {
+		s.timer = time.AfterFunc(s.flushDelay, func() {
+			if err := s.Flush(context.Background()); err != nil {
+				log.Printf("failed to flush intent statuses: %v", err)
+			}
+		})
+	}
+	s.mu.Unlock()
+
+	if full {
+		return s.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush writes every pending update in a single pipeline
+func (s *StatusCache) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if len(s.order) == 0 || s.store == nil {
+		s.mu.Unlock()
+		return nil
+	}
+	updates := make([]redis.IntentStatusUpdate, 0, len(s.order))
+	for _, id := range s.order {
+		updates = append(updates, s.pending[id])
+	}
+	store := s.store
+	s.pending = make(map[string]redis.IntentStatusUpdate)
+	s.order = nil
+	s.mu.Unlock()
+
+	if _, err := store.WriteIntentStatuses(ctx, updates...); err != nil {
+		return fmt.Errorf("write intent statuses: %w", err)
 	}
+	return nil
+}
+
+// GetIntentStatus reads an intent's status, including updates not flushed yet. It returns
+// domain.ErrNotFound when the intent has no cached status.
+func (s *StatusCache) GetIntentStatus(ctx context.Context, intentID string) (*domain.IntentStatusPayload, error) {
+	s.mu.Lock()
+	store := s.store
+	pending, hasPending := s.pending[intentID]
+	s.mu.Unlock()
+
+	if store == nil {
+		return nil, domain.ErrNotFound
+	}
+
+	rec, err := store.ReadIntentStatus(ctx, intentID)
+	switch {
+	case errors.Is(err, redis.ErrIntentStatusNotFound):
+		if !hasPending {
+			return nil, domain.ErrNotFound
+		}
+		rec = pending.Record
+	case err != nil:
+		return nil, err
+	case hasPending:
+		rec = rec.Merge(pending.Record)
+	}
+
+	return payloadFromRecord(rec), nil
+}
 
-	err = s.redis.SetWithExpiration(ctx, key, string(data), ttl)
+// ReplaceIntentStatus writes payload straight to the store if the intent is still at
+// payload.Version there and has no update waiting to be flushed. It returns
+// domain.ErrStatusChanged otherwise; a zero version writes unconditionally.
+func (s *StatusCache) ReplaceIntentStatus(ctx context.Context, payload domain.IntentStatusPayload, ttl time.Duration) error {
+	if payload.IntentID == "" {
+		return domain.ErrInvalidInput
+	}
+
+	s.mu.Lock()
+	store := s.store
+	_, hasPending := s.pending[payload.IntentID]
+	s.mu.Unlock()
+
+	if store == nil {
+		return nil
+	}
+	if hasPending {
+		return domain.ErrStatusChanged
+	}
+
+	update := redis.IntentStatusUpdate{Record: recordFromPayload(payload), IfVersion: payload.Version, TTL: ttl}
+	versions, err := store.WriteIntentStatuses(ctx, update)
 	if err != nil {
-		return fmt.Errorf("redis set: %w", err)
+		return fmt.Errorf("write intent status: %w", err)
+	}
+	if versions[0] < 0 {
+		return domain.ErrStatusChanged
 	}
+	return nil
+}
 
+// WriteIntentStatus writes payload straight to the store, taking over any update buffered
+// for the intent so a later flush cannot overwrite it. It returns domain.ErrStatusChanged
+// when the store already holds a status at payload.Seq or later.
+func (s *StatusCache) WriteIntentStatus(ctx context.Context, payload domain.IntentStatusPayload, ttl time.Duration) error {
+	if payload.IntentID == "" {
+		return domain.ErrInvalidInput
+	}
+
+	s.mu.Lock()
+	store := s.store
+	if store == nil {
+		s.mu.Unlock()
+		return nil
+	}
+	update := redis.IntentStatusUpdate{Record: recordFromPayload(payload), TTL: ttl}
+	if prev, ok := s.pending[payload.IntentID]; ok {
+		update.Record = prev.Record.Merge(update.Record)
+		delete(s.pending, payload.IntentID)
+		for i, id := range s.order {
+			if id == payload.IntentID {
+				s.order = append(s.order[:i], s.order[i+1:]...)
+				break
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	versions, err := store.WriteIntentStatuses(ctx, update)
+	if err != nil {
+		return fmt.Errorf("write intent status: %w", err)
+	}
+	if versions[0] < 0 {
+		return domain.ErrStatusChanged
+	}
 	return nil
 }
 
-// SetRedis sets the Redis client (called from main.go)
-func (s *StatusCache) SetRedis(r *redis.Redis) {
-	s.redis = r
+// recoveryKey holds a stalled intent's recovery in the status data
+const recoveryKey = "recovery"
+
+func recordFromPayload(p domain.IntentStatusPayload) contracts.IntentStatusRecord {
+	rec := contracts.IntentStatusRecord{
+		IntentID:  p.IntentID,
+		Kind:      string(p.Kind),
+		Status:    string(p.Status),
+		UpdatedAt: time.Now(),
+		Seq:       p.Seq,
+	}
+	if p.ChainID != nil {
+		rec.ChainID = string(*p.ChainID)
+	}
+	if p.TxHash != nil {
+		rec.TxHash = *p.TxHash
+	}
+	if p.ContractAddress != nil {
+		rec.ContractAddress = string(*p.ContractAddress)
+	}
+	if p.Error != nil {
+		rec.Error = *p.Error
+	}
+	if p.CallbackURL != nil {
+		rec.CallbackURL = *p.CallbackURL
+	}
+	if p.Recovery != nil {
+		rec.Data = map[string]interface{}{recoveryKey: string(*p.Recovery)}
+	}
+	return rec
+}
+
+func payloadFromRecord(rec contracts.IntentStatusRecord) *domain.IntentStatusPayload {
+	p := &domain.IntentStatusPayload{
+		IntentID:  rec.IntentID,
+		Kind:      domain.IntentKind(rec.Kind),
+		Status:    domain.IntentStatus(rec.Status),
+		Version:   rec.Version,
+		Seq:       rec.Seq,
+		UpdatedAt: rec.UpdatedAt,
+	}
+	if rec.ChainID != "" {
+		chainID := domain.ChainID(rec.ChainID)
+		p.ChainID = &chainID
+	}
+	if rec.TxHash != "" {
+		txHash := rec.TxHash
+		p.TxHash = &txHash
+	}
+	if rec.ContractAddress != "" {
+		addr := domain.Address(rec.ContractAddress)
+		p.ContractAddress = &addr
+	}
+	// Fields are only ever overlaid, so an error or recovery outlives the status it
+	// explained; surface them only alongside it
+	if p.Status == domain.IntentFailed && rec.Error != "" {
+		errMsg := rec.Error
+		p.Error = &errMsg
+	}
+	if recovery, ok := rec.Data[recoveryKey].(string); ok && p.Status == domain.IntentStalled {
+		r := domain.IntentRecovery(recovery)
+		p.Recovery = &r
+	}
+	return p
 }
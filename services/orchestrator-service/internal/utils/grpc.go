@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"strings"
+	"time"
+
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
 	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ConvertCreateCollectionRequest converts protobuf request to domain input
@@ -14,6 +18,7 @@ func ConvertCreateCollectionRequest(req *orchestratorpb.PrepareCreateCollectionR
 		Creator:  req.Creator,
 		TokenURI: req.TokenUri,
 		Type:     domain.Standard(req.Type),
+		AssetIDs: req.AssetIds,
 	}
 
 	// Handle optional fields properly
@@ -53,10 +58,27 @@ func ConvertCreateCollectionRequest(req *orchestratorpb.PrepareCreateCollectionR
 		allowlistStageDuration := req.AllowlistStageDuration
 		input.AllowlistStageDuration = &allowlistStageDuration
 	}
+	if req.CallbackUrl != "" {
+		callbackURL := req.CallbackUrl
+		input.CallbackURL = &callbackURL
+	}
+	input.PayoutSplits = ConvertPayoutSplits(req.PayoutSplits)
 
 	return input
 }
 
+// ConvertPayoutSplits converts protobuf payout splits to domain splits
+func ConvertPayoutSplits(splits []*orchestratorpb.PayoutSplit) []domain.PayoutSplit {
+	if len(splits) == 0 {
+		return nil
+	}
+	out := make([]domain.PayoutSplit, len(splits))
+	for i, split := range splits {
+		out[i] = domain.PayoutSplit{Recipient: split.Recipient, Bps: split.Bps}
+	}
+	return out
+}
+
 // ConvertMintRequest converts protobuf mint request to domain input
 func ConvertMintRequest(req *orchestratorpb.PrepareMintRequest) domain.PrepareMintInput {
 	return domain.PrepareMintInput{
@@ -65,6 +87,8 @@ func ConvertMintRequest(req *orchestratorpb.PrepareMintRequest) domain.PrepareMi
 		Standard: domain.Standard(req.Standard),
 		Minter:   req.Minter,
 		Quantity: req.Quantity,
+		TokenID:  req.TokenId,
+		Proof:    req.Proof,
 	}
 }
 
@@ -98,6 +122,7 @@ func ConvertCreateCollectionResponse(result *domain.PrepareCreateCollectionResul
 			Value:          result.Tx.Value,
 			PreviewAddress: previewAddr,
 		},
+		CallbackSecret: result.CallbackSecret,
 	}
 }
 
@@ -143,12 +168,437 @@ func ConvertIntentStatusResponse(result *domain.IntentStatusPayload) *orchestrat
 		contractAddr = *result.ContractAddress
 	}
 
-	return &orchestratorpb.GetIntentStatusResponse{
+	errMsg := ""
+	if result.Error != nil {
+		errMsg = *result.Error
+	}
+
+	recovery := ""
+	if result.Recovery != nil {
+		recovery = string(*result.Recovery)
+	}
+
+	resp := &orchestratorpb.GetIntentStatusResponse{
 		IntentId:        result.IntentID,
 		Kind:            string(result.Kind),
 		Status:          string(result.Status),
 		ChainId:         chainID,
 		TxHash:          txHash,
 		ContractAddress: contractAddr,
+		Error:           errMsg,
+		Recovery:        recovery,
+	}
+	if !result.UpdatedAt.IsZero() {
+		resp.UpdatedAt = timestamppb.New(result.UpdatedAt)
+	}
+	return resp
+}
+
+// ConvertListIntentsRequest converts protobuf intent listing request to domain input
+func ConvertListIntentsRequest(req *orchestratorpb.ListIntentsRequest) domain.ListIntentsInput {
+	input := domain.ListIntentsInput{
+		Signer:   req.Signer,
+		BeforeID: req.BeforeId,
+		Limit:    int(req.Limit),
+	}
+	if req.Before != nil {
+		before := req.Before.AsTime()
+		input.Before = &before
+	}
+	return input
+}
+
+// ConvertListIntentsResponse converts domain intents to protobuf response
+func ConvertListIntentsResponse(intents []*domain.Intent) *orchestratorpb.ListIntentsResponse {
+	out := make([]*orchestratorpb.Intent, 0, len(intents))
+	for _, it := range intents {
+		intent := &orchestratorpb.Intent{
+			IntentId:  it.ID,
+			Kind:      string(it.Kind),
+			Status:    string(it.Status),
+			ChainId:   it.ChainID,
+			CreatedAt: timestamppb.New(it.CreatedAt),
+			UpdatedAt: timestamppb.New(it.UpdatedAt),
+		}
+		if it.TxHash != nil {
+			intent.TxHash = *it.TxHash
+		}
+		if it.PreviewAddress != nil {
+			intent.ContractAddress = *it.PreviewAddress
+		} else if it.ContractAddress != nil {
+			intent.ContractAddress = *it.ContractAddress
+		}
+		if it.Signer != nil {
+			intent.Signer = *it.Signer
+		}
+		out = append(out, intent)
+	}
+	return &orchestratorpb.ListIntentsResponse{Intents: out}
+}
+
+// ConvertUpdateRoyaltyRequest converts protobuf royalty update request to domain input
+func ConvertUpdateRoyaltyRequest(req *orchestratorpb.PrepareUpdateRoyaltyRequest) domain.PrepareUpdateRoyaltyInput {
+	return domain.PrepareUpdateRoyaltyInput{
+		ChainID:  req.ChainId,
+		Contract: req.Contract,
+		UserID:   req.UserId,
+		Receiver: req.Receiver,
+		FeeBps:   req.FeeBps,
+	}
+}
+
+// ConvertTransferCollectionOwnershipRequest converts protobuf ownership transfer request to domain input
+func ConvertTransferCollectionOwnershipRequest(req *orchestratorpb.PrepareTransferCollectionOwnershipRequest) domain.PrepareTransferCollectionOwnershipInput {
+	return domain.PrepareTransferCollectionOwnershipInput{
+		ChainID:  req.ChainId,
+		Contract: req.Contract,
+		UserID:   req.UserId,
+		NewOwner: req.NewOwner,
+	}
+}
+
+// ConvertSetBaseURIRequest converts protobuf base URI request to domain input
+func ConvertSetBaseURIRequest(req *orchestratorpb.PrepareSetBaseURIRequest) domain.PrepareSetBaseURIInput {
+	return domain.PrepareSetBaseURIInput{
+		ChainID:  req.ChainId,
+		Contract: req.Contract,
+		UserID:   req.UserId,
+		BaseURI:  req.BaseUri,
+	}
+}
+
+// ConvertSetPayoutSplitsRequest converts protobuf payout splits request to domain input
+func ConvertSetPayoutSplitsRequest(req *orchestratorpb.PrepareSetPayoutSplitsRequest) domain.PrepareSetPayoutSplitsInput {
+	return domain.PrepareSetPayoutSplitsInput{
+		ChainID:  req.ChainId,
+		Contract: req.Contract,
+		UserID:   req.UserId,
+		Splits:   ConvertPayoutSplits(req.Splits),
+	}
+}
+
+// ConvertCollectionAdminResponse converts domain collection admin result to protobuf response
+func ConvertCollectionAdminResponse(result *domain.PrepareCollectionAdminResult) *orchestratorpb.PrepareCollectionAdminResponse {
+	return &orchestratorpb.PrepareCollectionAdminResponse{
+		IntentId: result.IntentID,
+		Tx: &orchestratorpb.TxRequest{
+			To:    result.Tx.To,
+			Data:  result.Tx.Data,
+			Value: result.Tx.Value,
+		},
+	}
+}
+
+// ConvertCreateAuctionRequest converts protobuf create auction request to domain input
+func ConvertCreateAuctionRequest(req *orchestratorpb.PrepareCreateAuctionRequest) domain.PrepareCreateAuctionInput {
+	return domain.PrepareCreateAuctionInput{
+		ChainID:      req.ChainId,
+		Collection:   req.Collection,
+		TokenID:      req.TokenId,
+		Seller:       req.Seller,
+		AuctionType:  domain.AuctionType(strings.ToLower(req.AuctionType)),
+		StartPrice:   req.StartPrice,
+		ReservePrice: req.ReservePrice,
+		EndPrice:     req.EndPrice,
+		StartTime:    req.StartTime,
+		Duration:     req.Duration,
+	}
+}
+
+// ConvertBidRequest converts protobuf bid request to domain input
+func ConvertBidRequest(req *orchestratorpb.PrepareBidRequest) domain.PrepareBidInput {
+	return domain.PrepareBidInput{
+		ChainID:   req.ChainId,
+		AuctionID: req.AuctionId,
+		Bidder:    req.Bidder,
+		Amount:    req.Amount,
+	}
+}
+
+// ConvertSettleAuctionRequest converts protobuf settle auction request to domain input
+func ConvertSettleAuctionRequest(req *orchestratorpb.PrepareSettleAuctionRequest) domain.PrepareSettleAuctionInput {
+	return domain.PrepareSettleAuctionInput{
+		ChainID:   req.ChainId,
+		AuctionID: req.AuctionId,
+		Caller:    req.Caller,
+	}
+}
+
+// ConvertAuctionResponse converts domain auction result to protobuf response
+func ConvertAuctionResponse(result *domain.PrepareAuctionResult) *orchestratorpb.PrepareAuctionResponse {
+	return &orchestratorpb.PrepareAuctionResponse{
+		IntentId: result.IntentID,
+		Tx: &orchestratorpb.TxRequest{
+			To:    result.Tx.To,
+			Data:  result.Tx.Data,
+			Value: result.Tx.Value,
+		},
+	}
+}
+
+// ConvertCollectionDefaultsResponse converts domain collection defaults to protobuf response
+func ConvertCollectionDefaultsResponse(result *domain.CollectionDefaults) *orchestratorpb.GetCollectionDefaultsResponse {
+	supportedTypes := make([]string, len(result.SupportedTypes))
+	for i, collectionType := range result.SupportedTypes {
+		supportedTypes[i] = string(collectionType)
+	}
+
+	return &orchestratorpb.GetCollectionDefaultsResponse{
+		ChainId: result.ChainID,
+		Constraints: &orchestratorpb.CollectionConstraints{
+			MaxRoyaltyBps:       result.Constraints.MaxRoyaltyBps,
+			MinStageDurationSec: result.Constraints.MinStageDurationSec,
+			MaxSupplyCap:        result.Constraints.MaxSupplyCap,
+		},
+		RoyaltyFee:             result.RoyaltyFee,
+		MaxSupply:              result.MaxSupply,
+		MintLimitPerWallet:     result.MintLimitPerWallet,
+		AllowlistStageDuration: result.AllowlistStageDuration,
+		SupportedTypes:         supportedTypes,
+	}
+}
+
+// ConvertPrepareImportCollectionRequest converts protobuf prepare import request to domain input
+func ConvertPrepareImportCollectionRequest(req *orchestratorpb.PrepareImportCollectionRequest) domain.PrepareImportCollectionInput {
+	return domain.PrepareImportCollectionInput{
+		ChainID:  req.ChainId,
+		Contract: req.Contract,
+		UserID:   req.UserId,
+	}
+}
+
+// ConvertImportChallengeResponse converts domain import challenge to protobuf response
+func ConvertImportChallengeResponse(result *domain.ImportChallenge) *orchestratorpb.PrepareImportCollectionResponse {
+	return &orchestratorpb.PrepareImportCollectionResponse{
+		Message:     result.Message,
+		IssuedAt:    result.IssuedAt.UTC().Format(time.RFC3339),
+		ExpiresAt:   result.ExpiresAt.UTC().Format(time.RFC3339),
+		ChallengeId: result.ChallengeID,
+	}
+}
+
+// ConvertImportCollectionRequest converts protobuf import request to domain input
+func ConvertImportCollectionRequest(req *orchestratorpb.ImportCollectionRequest) domain.ImportCollectionInput {
+	return domain.ImportCollectionInput{
+		ChainID:     req.ChainId,
+		Contract:    req.Contract,
+		UserID:      req.UserId,
+		ChallengeID: req.ChallengeId,
+		Signature:   req.Signature,
+	}
+}
+
+// ConvertImportCollectionResponse converts domain imported collection to protobuf response
+func ConvertImportCollectionResponse(result *domain.ImportedCollection) *orchestratorpb.ImportCollectionResponse {
+	return &orchestratorpb.ImportCollectionResponse{
+		ChainId:    result.ChainID,
+		Contract:   result.Contract,
+		Standard:   string(result.Standard),
+		Owner:      result.Owner,
+		Name:       result.Name,
+		Symbol:     result.Symbol,
+		StartBlock: result.StartBlock,
+	}
+}
+
+// ConvertPrepareAirdropRequest converts protobuf airdrop request to domain input
+func ConvertPrepareAirdropRequest(req *orchestratorpb.PrepareAirdropRequest) domain.PrepareAirdropInput {
+	input := domain.PrepareAirdropInput{
+		ChainID:         req.ChainId,
+		Contract:        req.Contract,
+		Standard:        domain.Standard(req.Standard),
+		TokenID:         req.TokenId,
+		UserID:          req.UserId,
+		SnapshotID:      req.SnapshotId,
+		AmountPerHolder: req.AmountPerHolder,
+	}
+	for _, r := range req.Recipients {
+		input.Recipients = append(input.Recipients, domain.AirdropRecipient{Address: r.Address, Amount: r.Amount})
+	}
+	return input
+}
+
+// ConvertAirdropBundleResponse converts domain airdrop bundle to protobuf response
+func ConvertAirdropBundleResponse(result *domain.AirdropBundle) *orchestratorpb.PrepareAirdropResponse {
+	batches := make([]*orchestratorpb.AirdropBatch, len(result.Batches))
+	for i, batch := range result.Batches {
+		recipients := make([]*orchestratorpb.AirdropRecipient, len(batch.Recipients))
+		for j, r := range batch.Recipients {
+			recipients[j] = &orchestratorpb.AirdropRecipient{Address: r.Address, Amount: r.Amount}
+		}
+		batches[i] = &orchestratorpb.AirdropBatch{
+			IntentId:   batch.IntentID,
+			Seq:        uint32(batch.Seq),
+			Recipients: recipients,
+			GasLimit:   batch.GasLimit,
+			Tx: &orchestratorpb.TxRequest{
+				To:    batch.Tx.To,
+				Data:  batch.Tx.Data,
+				Value: batch.Tx.Value,
+			},
+		}
+	}
+
+	return &orchestratorpb.PrepareAirdropResponse{
+		BundleId:       result.BundleID,
+		ChainId:        result.ChainID,
+		Contract:       result.Contract,
+		RecipientCount: uint32(result.RecipientCount),
+		Batches:        batches,
+	}
+}
+
+// ConvertAirdropProgressResponse converts domain airdrop progress to protobuf response
+func ConvertAirdropProgressResponse(result *domain.BundleProgress) *orchestratorpb.GetAirdropProgressResponse {
+	batches := make([]*orchestratorpb.GetIntentStatusResponse, len(result.Batches))
+	for i, batch := range result.Batches {
+		batches[i] = ConvertIntentStatusResponse(batch)
+	}
+
+	return &orchestratorpb.GetAirdropProgressResponse{
+		BundleId:       result.Bundle.ID,
+		ChainId:        result.Bundle.ChainID,
+		Contract:       result.Bundle.Contract,
+		Status:         string(result.Status),
+		RecipientCount: uint32(result.Bundle.RecipientCount),
+		BatchCount:     uint32(result.Bundle.BatchCount),
+		Confirmed:      uint32(result.Confirmed),
+		Failed:         uint32(result.Failed),
+		Batches:        batches,
+	}
+}
+
+// ConvertPrepareBulkTransferRequest converts protobuf bulk transfer request to domain input
+func ConvertPrepareBulkTransferRequest(req *orchestratorpb.PrepareBulkTransferRequest) domain.PrepareBulkTransferInput {
+	input := domain.PrepareBulkTransferInput{
+		ChainID:  req.ChainId,
+		UserID:   req.UserId,
+		From:     req.From,
+		Operator: req.Operator,
+	}
+	for _, item := range req.Items {
+		input.Items = append(input.Items, domain.BulkTransferItem{
+			Contract: item.Contract,
+			TokenID:  item.TokenId,
+			To:       item.To,
+			Amount:   item.Amount,
+		})
+	}
+	return input
+}
+
+// ConvertBulkTransferBundleResponse converts domain bulk transfer bundle to protobuf response
+func ConvertBulkTransferBundleResponse(result *domain.BulkTransferBundle) *orchestratorpb.PrepareBulkTransferResponse {
+	approvals := make([]*orchestratorpb.BulkTransferApproval, len(result.Approvals))
+	for i, approval := range result.Approvals {
+		approvals[i] = &orchestratorpb.BulkTransferApproval{
+			Contract: approval.Contract,
+			Check:    &orchestratorpb.TxRequest{To: approval.Check.To, Data: approval.Check.Data, Value: approval.Check.Value},
+			Tx:       &orchestratorpb.TxRequest{To: approval.Tx.To, Data: approval.Tx.Data, Value: approval.Tx.Value},
+		}
+	}
+	batches := make([]*orchestratorpb.BulkTransferBatch, len(result.Batches))
+	for i, batch := range result.Batches {
+		tokens := make([]*orchestratorpb.BulkTransferToken, len(batch.Tokens))
+		for j, t := range batch.Tokens {
+			tokens[j] = &orchestratorpb.BulkTransferToken{TokenId: t.TokenID, Amount: t.Amount}
+		}
+		batches[i] = &orchestratorpb.BulkTransferBatch{
+			IntentId: batch.IntentID,
+			Seq:      uint32(batch.Seq),
+			Contract: batch.Contract,
+			Standard: string(batch.Standard),
+			To:       batch.To,
+			Tokens:   tokens,
+			Tx: &orchestratorpb.TxRequest{
+				To:    batch.Tx.To,
+				Data:  batch.Tx.Data,
+				Value: batch.Tx.Value,
+			},
+		}
+	}
+
+	return &orchestratorpb.PrepareBulkTransferResponse{
+		BundleId:   result.BundleID,
+		ChainId:    result.ChainID,
+		From:       result.From,
+		Signer:     result.Signer,
+		TokenCount: uint32(result.TokenCount),
+		Approvals:  approvals,
+		Batches:    batches,
+	}
+}
+
+// ConvertBulkTransferProgressResponse converts domain bulk transfer progress to protobuf response
+func ConvertBulkTransferProgressResponse(result *domain.BundleProgress) *orchestratorpb.GetBulkTransferProgressResponse {
+	batches := make([]*orchestratorpb.GetIntentStatusResponse, len(result.Batches))
+	for i, batch := range result.Batches {
+		batches[i] = ConvertIntentStatusResponse(batch)
+	}
+
+	return &orchestratorpb.GetBulkTransferProgressResponse{
+		BundleId:       result.Bundle.ID,
+		ChainId:        result.Bundle.ChainID,
+		Status:         string(result.Status),
+		RecipientCount: uint32(result.Bundle.RecipientCount),
+		BatchCount:     uint32(result.Bundle.BatchCount),
+		Confirmed:      uint32(result.Confirmed),
+		Failed:         uint32(result.Failed),
+		Batches:        batches,
+	}
+}
+
+func ConvertCallTargetOverride(o *domain.CallTargetOverride) *orchestratorpb.CallTargetOverride {
+	return &orchestratorpb.CallTargetOverride{
+		ChainId:   o.ChainID,
+		Address:   o.Address,
+		Reason:    o.Reason,
+		GrantedBy: o.GrantedBy,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+	}
+}
+
+func ConvertListCallTargetOverridesResponse(overrides []*domain.CallTargetOverride) *orchestratorpb.ListCallTargetOverridesResponse {
+	resp := &orchestratorpb.ListCallTargetOverridesResponse{
+		Overrides: make([]*orchestratorpb.CallTargetOverride, 0, len(overrides)),
+	}
+	for _, o := range overrides {
+		resp.Overrides = append(resp.Overrides, ConvertCallTargetOverride(o))
+	}
+	return resp
+}
+
+func ConvertSponsorshipGrant(g *domain.SponsorshipGrant) *orchestratorpb.SponsorshipGrant {
+	return &orchestratorpb.SponsorshipGrant{
+		GrantId:    g.ID,
+		IntentId:   g.IntentID,
+		ChainId:    g.ChainID,
+		Collection: g.Collection,
+		UserId:     g.UserID,
+		GasGwei:    g.GasGwei,
+		ExpiresAt:  timestamppb.New(g.ExpiresAt),
+	}
+}
+
+func ConvertSponsorshipBudget(b *domain.SponsorshipBudget) *orchestratorpb.SponsorshipBudget {
+	return &orchestratorpb.SponsorshipBudget{
+		ChainId:      b.ChainID,
+		BalanceGwei:  b.BalanceGwei,
+		ToppedUpGwei: b.ToppedUpGwei,
+		SpentGwei:    b.SpentGwei,
+		Frozen:       b.Frozen,
+		FrozenReason: b.FrozenReason,
+		UpdatedBy:    b.UpdatedBy,
+		UpdatedAt:    timestamppb.New(b.UpdatedAt),
+	}
+}
+
+func ConvertListSponsorshipBudgetsResponse(budgets []*domain.SponsorshipBudget) *orchestratorpb.ListSponsorshipBudgetsResponse {
+	resp := &orchestratorpb.ListSponsorshipBudgetsResponse{
+		Budgets: make([]*orchestratorpb.SponsorshipBudget, 0, len(budgets)),
+	}
+	for _, b := range budgets {
+		resp.Budgets = append(resp.Budgets, ConvertSponsorshipBudget(b))
 	}
+	return resp
 }
@@ -0,0 +1,259 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+)
+
+const airdropSnapshot = "7b0c6c1e-3f55-4a55-9d8a-2d7e3c1f0a10"
+
+// memoryBundles keeps bundles and their intents in memory
+type memoryBundles struct {
+	bundles map[string]*domain.IntentBundle
+	intents map[string][]*domain.Intent
+}
+
+func newMemoryBundles() *memoryBundles {
+	return &memoryBundles{bundles: map[string]*domain.IntentBundle{}, intents: map[string][]*domain.Intent{}}
+}
+
+func (m *memoryBundles) CreateBundle(ctx context.Context, bundle *domain.IntentBundle, intents []*domain.Intent) error {
+	m.bundles[bundle.ID] = bundle
+	m.intents[bundle.ID] = intents
+	return nil
+}
+
+func (m *memoryBundles) GetBundle(ctx context.Context, bundleID string) (*domain.IntentBundle, error) {
+	if b, ok := m.bundles[bundleID]; ok {
+		return b, nil
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *memoryBundles) ListBundleIntents(ctx context.Context, bundleID string) ([]*domain.Intent, error) {
+	return m.intents[bundleID], nil
+}
+
+// stubSnapshots serves one snapshot taken by creator-user
+type stubSnapshots []domain.Address
+
+func (s stubSnapshots) ListSnapshotHolders(ctx context.Context, snapshotID, userID string) ([]domain.Address, error) {
+	if snapshotID != airdropSnapshot || userID != "creator-user" {
+		return nil, domain.ErrSnapshotNotFound
+	}
+	return s, nil
+}
+
+func wallet(i int) domain.Address {
+	return fmt.Sprintf("0x%040x", i+1)
+}
+
+func recipients(n int, amount uint64) []domain.AirdropRecipient {
+	out := make([]domain.AirdropRecipient, n)
+	for i := range out {
+		out[i] = domain.AirdropRecipient{Address: wallet(i), Amount: amount}
+	}
+	return out
+}
+
+func newAirdropService(statusCache *MockStatusCache, bundles *memoryBundles, snapshots domain.HolderSnapshotReader, policy domain.AirdropPolicy) *service.Service {
+	svc := createAdminTestService(&MockRepo{}, statusCache, stubCreators{creator: adminCreator}).(*service.Service)
+	svc.SetAirdrops(bundles, snapshots, policy)
+	return svc
+}
+
+func TestPrepareAirdrop_ChunksByChainGasLimit(t *testing.T) {
+	statusCache := &MockStatusCache{}
+	statusCache.On("SetIntentStatus", mock.Anything, mock.MatchedBy(func(p domain.IntentStatusPayload) bool {
+		return p.Kind == domain.IntentKindAirdrop && p.Status == domain.IntentPending
+	}), domain.DefaultIntentTTL).Return(nil)
+	bundles := newMemoryBundles()
+
+	// room for two ERC-1155 recipients per batch on eip155:137, far more elsewhere
+	svc := newAirdropService(statusCache, bundles, nil, domain.AirdropPolicy{
+		GasLimits: map[domain.ChainID]uint64{"eip155:137": 140_000},
+	})
+
+	bundle, err := svc.PrepareAirdrop(context.Background(), domain.PrepareAirdropInput{
+		ChainID:    "eip155:137",
+		Contract:   adminCollection,
+		Standard:   domain.StdERC1155,
+		TokenID:    "7",
+		UserID:     "creator-user",
+		Recipients: recipients(5, 1),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, bundle.Batches, 3)
+	assert.Equal(t, 5, bundle.RecipientCount)
+	for i, batch := range bundle.Batches {
+		assert.Equal(t, i, batch.Seq)
+		assert.LessOrEqual(t, batch.GasLimit, uint64(140_000))
+		assert.Equal(t, domain.Address(adminCollection), batch.Tx.To)
+	}
+	assert.Equal(t, wallet(4), bundle.Batches[2].Recipients[0].Address)
+
+	stored := bundles.intents[bundle.BundleID]
+	require.Len(t, stored, 3)
+	for i, intent := range stored {
+		assert.Equal(t, bundle.Batches[i].IntentID, intent.ID)
+		assert.Equal(t, domain.IntentKindAirdrop, intent.Kind)
+	}
+	assert.Equal(t, 3, bundles.bundles[bundle.BundleID].BatchCount)
+	statusCache.AssertNumberOfCalls(t, "SetIntentStatus", 3)
+
+	// the default cap fits them all in one batch
+	single, err := svc.PrepareAirdrop(context.Background(), domain.PrepareAirdropInput{
+		ChainID: "eip155:1", Contract: adminCollection, Standard: domain.StdERC1155, TokenID: "7",
+		UserID: "creator-user", Recipients: recipients(5, 1),
+	})
+	require.NoError(t, err)
+	assert.Len(t, single.Batches, 1)
+}
+
+func TestPrepareAirdrop_SnapshotHolders(t *testing.T) {
+	statusCache := &MockStatusCache{}
+	statusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	holders := stubSnapshots{wallet(0), wallet(1), wallet(2)}
+
+	svc := newAirdropService(statusCache, newMemoryBundles(), holders, domain.AirdropPolicy{MaxRecipients: 2})
+
+	bundle, err := svc.PrepareAirdrop(context.Background(), domain.PrepareAirdropInput{
+		ChainID:         "eip155:1",
+		Contract:        adminCollection,
+		Standard:        domain.StdERC721,
+		UserID:          "creator-user",
+		SnapshotID:      airdropSnapshot,
+		AmountPerHolder: 2,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, bundle.Batches, 2, "MaxRecipients splits the batches")
+	assert.Equal(t, []domain.AirdropRecipient{{Address: wallet(0), Amount: 2}, {Address: wallet(1), Amount: 2}}, bundle.Batches[0].Recipients)
+	assert.Equal(t, uint64(60_000+2*(35_000+2*25_000)), bundle.Batches[0].GasLimit)
+
+	_, err = svc.PrepareAirdrop(context.Background(), domain.PrepareAirdropInput{
+		ChainID: "eip155:1", Contract: adminCollection, Standard: domain.StdERC721,
+		UserID: "creator-user", SnapshotID: "5d1c3f7e-0000-4000-8000-000000000000",
+	})
+	assert.ErrorIs(t, err, domain.ErrSnapshotNotFound)
+}
+
+func TestPrepareAirdrop_MergesRepeatedWallets(t *testing.T) {
+	statusCache := &MockStatusCache{}
+	statusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	svc := newAirdropService(statusCache, newMemoryBundles(), nil, domain.AirdropPolicy{})
+
+	upper := "0x" + "ABCDEF0123456789ABCDEF0123456789ABCDEF01"
+	bundle, err := svc.PrepareAirdrop(context.Background(), domain.PrepareAirdropInput{
+		ChainID: "eip155:1", Contract: adminCollection, Standard: domain.StdERC721, UserID: "creator-user",
+		Recipients: []domain.AirdropRecipient{{Address: upper, Amount: 1}, {Address: wallet(0), Amount: 1}, {Address: "0xabcdef0123456789abcdef0123456789abcdef01", Amount: 2}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, bundle.RecipientCount)
+	assert.Equal(t, []domain.AirdropRecipient{
+		{Address: "0xabcdef0123456789abcdef0123456789abcdef01", Amount: 3},
+		{Address: wallet(0), Amount: 1},
+	}, bundle.Batches[0].Recipients)
+}
+
+func TestPrepareAirdrop_Rejections(t *testing.T) {
+	svc := newAirdropService(&MockStatusCache{}, newMemoryBundles(), stubSnapshots{wallet(0)}, domain.AirdropPolicy{DefaultGasLimit: 1_000_000})
+	base := domain.PrepareAirdropInput{
+		ChainID: "eip155:1", Contract: adminCollection, Standard: domain.StdERC721,
+		UserID: "creator-user", Recipients: recipients(2, 1),
+	}
+
+	cases := map[string]struct {
+		edit func(in *domain.PrepareAirdropInput)
+		code errs.Code
+	}{
+		"not the creator":      {func(in *domain.PrepareAirdropInput) { in.UserID = "other-user" }, errs.PermissionDenied},
+		"unsupported standard": {func(in *domain.PrepareAirdropInput) { in.Standard = domain.StdCustom }, errs.InvalidArgument},
+		"erc1155 without id":   {func(in *domain.PrepareAirdropInput) { in.Standard = domain.StdERC1155 }, errs.InvalidArgument},
+		"no recipients":        {func(in *domain.PrepareAirdropInput) { in.Recipients = nil }, errs.InvalidArgument},
+		"zero address": {func(in *domain.PrepareAirdropInput) {
+			in.Recipients[0].Address = "0x0000000000000000000000000000000000000000"
+		}, errs.InvalidArgument},
+		"zero amount":            {func(in *domain.PrepareAirdropInput) { in.Recipients[0].Amount = 0 }, errs.InvalidArgument},
+		"snapshot and list":      {func(in *domain.PrepareAirdropInput) { in.SnapshotID = airdropSnapshot }, errs.InvalidArgument},
+		"over one transaction":   {func(in *domain.PrepareAirdropInput) { in.Recipients[0].Amount = 100 }, errs.InvalidArgument},
+		"too many tokens":        {func(in *domain.PrepareAirdropInput) { in.Recipients[0].Amount = 1 << 62 }, errs.InvalidArgument},
+		"snapshot of other user": {func(in *domain.PrepareAirdropInput) { in.Recipients, in.SnapshotID = nil, "not-a-uuid" }, errs.NotFound},
+	}
+	for name, c := range cases {
+		in := base
+		in.Recipients = recipients(2, 1)
+		c.edit(&in)
+		_, err := svc.PrepareAirdrop(context.Background(), in)
+		assert.True(t, errs.Is(err, c.code), "%s: expected %s, got %v", name, c.code, err)
+	}
+}
+
+func TestPrepareAirdrop_Disabled(t *testing.T) {
+	svc := createAdminTestService(&MockRepo{}, &MockStatusCache{}, stubCreators{creator: adminCreator})
+
+	_, err := svc.PrepareAirdrop(context.Background(), domain.PrepareAirdropInput{})
+	assert.ErrorIs(t, err, domain.ErrAirdropsDisabled)
+}
+
+func TestGetAirdropProgress(t *testing.T) {
+	statusCache := &MockStatusCache{}
+	statusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	bundles := newMemoryBundles()
+	svc := newAirdropService(statusCache, bundles, nil, domain.AirdropPolicy{MaxRecipients: 1})
+
+	bundle, err := svc.PrepareAirdrop(context.Background(), domain.PrepareAirdropInput{
+		ChainID: "eip155:1", Contract: adminCollection, Standard: domain.StdERC721,
+		UserID: "creator-user", Recipients: recipients(3, 1),
+	})
+	require.NoError(t, err)
+	ids := []string{bundle.Batches[0].IntentID, bundle.Batches[1].IntentID, bundle.Batches[2].IntentID}
+
+	cached := func(id string, status domain.IntentStatus) {
+		statusCache.On("GetIntentStatus", mock.Anything, id).Return(&domain.IntentStatusPayload{IntentID: id, Kind: domain.IntentKindAirdrop, Status: status}, nil).Once()
+	}
+
+	// one confirmed, one stalled, and the last never cached, so read from the intent
+	cached(ids[0], domain.IntentReady)
+	cached(ids[1], domain.IntentStalled)
+	statusCache.On("GetIntentStatus", mock.Anything, ids[2]).Return(nil, domain.ErrNotFound).Once()
+
+	progress, err := svc.GetAirdropProgress(context.Background(), bundle.BundleID, "creator-user")
+	require.NoError(t, err)
+	assert.Equal(t, domain.IntentStalled, progress.Status)
+	assert.Equal(t, 1, progress.Confirmed)
+	require.Len(t, progress.Batches, 3)
+	assert.Equal(t, ids[2], progress.Batches[2].IntentID)
+	assert.Equal(t, domain.IntentPending, progress.Batches[2].Status)
+
+	// a failed batch fails the bundle
+	cached(ids[0], domain.IntentReady)
+	cached(ids[1], domain.IntentFailed)
+	cached(ids[2], domain.IntentReady)
+	progress, err = svc.GetAirdropProgress(context.Background(), bundle.BundleID, "creator-user")
+	require.NoError(t, err)
+	assert.Equal(t, domain.IntentFailed, progress.Status)
+	assert.Equal(t, 1, progress.Failed)
+
+	for _, id := range ids {
+		cached(id, domain.IntentReady)
+	}
+	progress, err = svc.GetAirdropProgress(context.Background(), bundle.BundleID, "creator-user")
+	require.NoError(t, err)
+	assert.Equal(t, domain.IntentReady, progress.Status)
+	assert.Equal(t, 3, progress.Confirmed)
+
+	_, err = svc.GetAirdropProgress(context.Background(), bundle.BundleID, "other-user")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	auctionHouse      = "0xdc64a140aa3e981100a9beca4e685f962f0cf6c9"
+	auctionCollection = "0x00000000000000000000000000000000000000c0"
+	auctionSeller     = "0x1111111111111111111111111111111111111111"
+)
+
+// auctionRegistry serves GetContractByRole like the chain registry: the contract holding
+// the requested role, or NotFound
+func auctionRegistry(contracts ...*protoChainRegistry.Contract) *MockChainRegistryClient {
+	registry := &MockChainRegistryClient{}
+	for _, contract := range contracts {
+		for _, role := range contract.Roles {
+			registry.On("GetContractByRole", mock.Anything, mock.MatchedBy(func(req *protoChainRegistry.GetContractByRoleRequest) bool {
+				return req.Role == role
+			})).Return(&protoChainRegistry.GetContractMetaResponse{ChainId: "eip155:1", Contract: contract}, nil)
+		}
+	}
+	registry.On("GetContractByRole", mock.Anything, mock.Anything).Return(nil, status.Error(codes.NotFound, "no contract holds the role"))
+	return registry
+}
+
+func auctionHouseContract() *protoChainRegistry.Contract {
+	return &protoChainRegistry.Contract{
+		Name:    "AuctionHouse",
+		Address: "0xDc64a140Aa3E981100a9becA4E685f962f0cF6C9",
+		Roles:   []string{contracts.RoleAuctionHouse},
+	}
+}
+
+func TestPrepareCreateAuction_English(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(it *domain.Intent) bool {
+		return it.Kind == domain.IntentKindCreateAuction && *it.ContractAddress == auctionHouse
+	})).Return(nil)
+	mockStatusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	svc := createTestService(mockRepo, mockStatusCache, auctionRegistry(auctionHouseContract()))
+
+	result, err := svc.PrepareCreateAuction(context.Background(), domain.PrepareCreateAuctionInput{
+		ChainID:      "eip155:1",
+		Collection:   auctionCollection,
+		TokenID:      "7",
+		Seller:       auctionSeller,
+		AuctionType:  domain.AuctionEnglish,
+		StartPrice:   "100000000000000000",
+		ReservePrice: "500000000000000000",
+		Duration:     86400,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, auctionHouse, result.Tx.To)
+	assert.Equal(t, "0", result.Tx.Value)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPrepareCreateAuction_DutchRequiresFallingPrice(t *testing.T) {
+	svc := createTestService(&MockRepo{}, &MockStatusCache{}, auctionRegistry(auctionHouseContract()))
+
+	_, err := svc.PrepareCreateAuction(context.Background(), domain.PrepareCreateAuctionInput{
+		ChainID:     "eip155:1",
+		Collection:  auctionCollection,
+		TokenID:     "7",
+		Seller:      auctionSeller,
+		AuctionType: domain.AuctionDutch,
+		StartPrice:  "100",
+		EndPrice:    "200",
+		Duration:    3600,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestPrepareBid_AttachesAmountAsValue(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(it *domain.Intent) bool {
+		return it.Kind == domain.IntentKindBid
+	})).Return(nil)
+	mockStatusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	svc := createTestService(mockRepo, mockStatusCache, auctionRegistry(auctionHouseContract()))
+
+	result, err := svc.PrepareBid(context.Background(), domain.PrepareBidInput{
+		ChainID:   "eip155:1",
+		AuctionID: "3",
+		Bidder:    auctionSeller,
+		Amount:    "250000000000000000",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "250000000000000000", result.Tx.Value)
+}
+
+func TestPrepareBid_RejectsZeroAmount(t *testing.T) {
+	svc := createTestService(&MockRepo{}, &MockStatusCache{}, auctionRegistry(auctionHouseContract()))
+
+	_, err := svc.PrepareBid(context.Background(), domain.PrepareBidInput{
+		ChainID:   "eip155:1",
+		AuctionID: "3",
+		Bidder:    auctionSeller,
+		Amount:    "0",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestPrepareSettleAuction_NoAuctionHouseRegistered(t *testing.T) {
+	svc := createTestService(&MockRepo{}, &MockStatusCache{}, auctionRegistry())
+
+	_, err := svc.PrepareSettleAuction(context.Background(), domain.PrepareSettleAuctionInput{
+		ChainID:   "eip155:1",
+		AuctionID: "3",
+		Caller:    auctionSeller,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrAuctionHouseNotSet)
+}
@@ -0,0 +1,246 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+)
+
+const (
+	transferOwner    = "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"
+	transferOperator = "0x2222222222222222222222222222222222222222"
+	transfer721      = "0x00000000000000000000000000000000000000a1"
+	transfer1155     = "0x00000000000000000000000000000000000000b1"
+)
+
+// stubHoldings indexes standards by contract and balances by contract and token id
+type stubHoldings struct {
+	standards map[domain.Address]domain.Standard
+	balances  map[domain.Address]map[string]int64
+	owner     domain.Address
+}
+
+func (s stubHoldings) GetCollectionStandard(ctx context.Context, chainID domain.ChainID, contract domain.Address) (domain.Standard, error) {
+	if std, ok := s.standards[contract]; ok {
+		return std, nil
+	}
+	return "", domain.ErrCollectionNotFound
+}
+
+func (s stubHoldings) TokenBalances(ctx context.Context, chainID domain.ChainID, contract, owner domain.Address, tokenIDs []string) (map[string]*big.Int, error) {
+	out := make(map[string]*big.Int)
+	if owner != s.owner {
+		return out, nil
+	}
+	for _, id := range tokenIDs {
+		if n, ok := s.balances[contract][id]; ok {
+			out[id] = big.NewInt(n)
+		}
+	}
+	return out, nil
+}
+
+func defaultHoldings() stubHoldings {
+	return stubHoldings{
+		standards: map[domain.Address]domain.Standard{transfer721: domain.StdERC721, transfer1155: domain.StdERC1155},
+		balances: map[domain.Address]map[string]int64{
+			transfer721:  {"1": 1, "2": 1, "3": 1},
+			transfer1155: {"7": 10, "8": 2},
+		},
+		owner: transferOwner,
+	}
+}
+
+func newBulkTransferService(statusCache *MockStatusCache, bundles *memoryBundles) *service.Service {
+	svc := createTestService(&MockRepo{}, statusCache, &MockChainRegistryClient{}).(*service.Service)
+	svc.SetCollectionAccess(stubCreators{creator: adminCreator}, stubWallets{
+		"creator-user": {transferOwner, transferOperator},
+		"other-user":   {"0x1111111111111111111111111111111111111111"},
+	})
+	svc.SetBulkTransfers(bundles, defaultHoldings())
+	return svc
+}
+
+func TestPrepareBulkTransfer_GroupsByContract(t *testing.T) {
+	statusCache := &MockStatusCache{}
+	statusCache.On("SetIntentStatus", mock.Anything, mock.MatchedBy(func(p domain.IntentStatusPayload) bool {
+		return p.Kind == domain.IntentKindBulkTransfer && p.Status == domain.IntentPending
+	}), domain.DefaultIntentTTL).Return(nil)
+	bundles := newMemoryBundles()
+	svc := newBulkTransferService(statusCache, bundles)
+
+	bundle, err := svc.PrepareBulkTransfer(context.Background(), domain.PrepareBulkTransferInput{
+		ChainID: "eip155:1",
+		UserID:  "creator-user",
+		From:    "0xABCDEFABCDEFABCDEFABCDEFABCDEFABCDEFABCD",
+		Items: []domain.BulkTransferItem{
+			{Contract: transfer1155, TokenID: "7", To: wallet(0), Amount: 3},
+			{Contract: transfer721, TokenID: "1", To: wallet(0)},
+			{Contract: transfer1155, TokenID: "08", To: wallet(0), Amount: 2},
+			{Contract: transfer721, TokenID: "2", To: wallet(1)},
+			{Contract: transfer1155, TokenID: "7", To: wallet(1)},
+			{Contract: transfer1155, TokenID: "7", To: wallet(0), Amount: 2},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.Address(transferOwner), bundle.Signer)
+	assert.Equal(t, 6, bundle.TokenCount)
+	assert.Empty(t, bundle.Approvals, "the holder needs no approval to send its own tokens")
+
+	// the ERC-1155 collection first, one batch per recipient, then each ERC-721 token
+	require.Len(t, bundle.Batches, 4)
+	first := bundle.Batches[0]
+	assert.Equal(t, domain.Address(transfer1155), first.Contract)
+	assert.Equal(t, wallet(0), first.To)
+	assert.Equal(t, []domain.BulkTransferToken{{TokenID: "7", Amount: 5}, {TokenID: "8", Amount: 2}}, first.Tokens)
+	assert.Equal(t, []domain.BulkTransferToken{{TokenID: "7", Amount: 1}}, bundle.Batches[1].Tokens)
+	assert.Equal(t, domain.StdERC721, bundle.Batches[2].Standard)
+	assert.Equal(t, wallet(1), bundle.Batches[3].To)
+	for i, batch := range bundle.Batches {
+		assert.Equal(t, i, batch.Seq)
+		assert.Equal(t, batch.Contract, batch.Tx.To)
+	}
+
+	stored := bundles.bundles[bundle.BundleID]
+	assert.Equal(t, domain.IntentKindBulkTransfer, stored.Kind)
+	assert.Empty(t, stored.Contract, "the bundle spans two collections")
+	assert.Equal(t, 2, stored.RecipientCount)
+	assert.Equal(t, 4, stored.BatchCount)
+	intents := bundles.intents[bundle.BundleID]
+	require.Len(t, intents, 4)
+	for i, intent := range intents {
+		assert.Equal(t, bundle.Batches[i].IntentID, intent.ID)
+		assert.Equal(t, domain.Address(transferOwner), *intent.Signer)
+	}
+	statusCache.AssertNumberOfCalls(t, "SetIntentStatus", 4)
+}
+
+func TestPrepareBulkTransfer_OperatorApprovals(t *testing.T) {
+	statusCache := &MockStatusCache{}
+	statusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	bundles := newMemoryBundles()
+	svc := newBulkTransferService(statusCache, bundles)
+
+	bundle, err := svc.PrepareBulkTransfer(context.Background(), domain.PrepareBulkTransferInput{
+		ChainID:  "eip155:1",
+		UserID:   "creator-user",
+		From:     transferOwner,
+		Operator: transferOperator,
+		Items: []domain.BulkTransferItem{
+			{Contract: transfer721, TokenID: "1", To: wallet(0)},
+			{Contract: transfer721, TokenID: "3", To: wallet(0)},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.Address(transferOperator), bundle.Signer)
+	require.Len(t, bundle.Approvals, 1)
+	approval := bundle.Approvals[0]
+	assert.Equal(t, domain.Address(transfer721), approval.Contract)
+	assert.Equal(t, []byte{0x07}, approval.Check.Data)
+	assert.Equal(t, []byte{0x08}, approval.Tx.Data)
+	assert.Len(t, bundle.Batches, 2)
+	assert.Equal(t, domain.Address(transfer721), bundles.bundles[bundle.BundleID].Contract)
+}
+
+func TestPrepareBulkTransfer_Rejections(t *testing.T) {
+	statusCache := &MockStatusCache{}
+	statusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	svc := newBulkTransferService(statusCache, newMemoryBundles())
+
+	valid := func() domain.PrepareBulkTransferInput {
+		return domain.PrepareBulkTransferInput{
+			ChainID: "eip155:1", UserID: "creator-user", From: transferOwner,
+			Items: []domain.BulkTransferItem{
+				{Contract: transfer721, TokenID: "1", To: wallet(0)},
+				{Contract: transfer1155, TokenID: "7", To: wallet(0), Amount: 10},
+			},
+		}
+	}
+	manyItems := make([]domain.BulkTransferItem, 101)
+	for i := range manyItems {
+		manyItems[i] = domain.BulkTransferItem{Contract: transfer1155, TokenID: "7", To: wallet(i)}
+	}
+
+	cases := map[string]struct {
+		mutate func(*domain.PrepareBulkTransferInput)
+		code   errs.Code
+	}{
+		"not the user's wallet": {func(in *domain.PrepareBulkTransferInput) { in.UserID = "other-user" }, errs.PermissionDenied},
+		"operator not linked":   {func(in *domain.PrepareBulkTransferInput) { in.Operator = wallet(5) }, errs.PermissionDenied},
+		"bad from":              {func(in *domain.PrepareBulkTransferInput) { in.From = "0x1234" }, errs.InvalidArgument},
+		"no items":              {func(in *domain.PrepareBulkTransferInput) { in.Items = nil }, errs.InvalidArgument},
+		"too many items":        {func(in *domain.PrepareBulkTransferInput) { in.Items = manyItems }, errs.InvalidArgument},
+		"bad token id":          {func(in *domain.PrepareBulkTransferInput) { in.Items[0].TokenID = "-1" }, errs.InvalidArgument},
+		"zero recipient": {func(in *domain.PrepareBulkTransferInput) {
+			in.Items[0].To = "0x0000000000000000000000000000000000000000"
+		}, errs.InvalidArgument},
+		"to itself":                {func(in *domain.PrepareBulkTransferInput) { in.Items[0].To = transferOwner }, errs.InvalidArgument},
+		"unindexed collection":     {func(in *domain.PrepareBulkTransferInput) { in.Items[0].Contract = wallet(9) }, errs.InvalidArgument},
+		"erc721 amount":            {func(in *domain.PrepareBulkTransferInput) { in.Items[0].Amount = 2 }, errs.InvalidArgument},
+		"token not held":           {func(in *domain.PrepareBulkTransferInput) { in.Items[0].TokenID = "4" }, errs.InvalidArgument},
+		"more than held":           {func(in *domain.PrepareBulkTransferInput) { in.Items[1].Amount = 11 }, errs.InvalidArgument},
+		"held across merged items": {func(in *domain.PrepareBulkTransferInput) { in.Items = append(in.Items, in.Items[1]) }, errs.InvalidArgument},
+	}
+	for name, c := range cases {
+		in := valid()
+		c.mutate(&in)
+		_, err := svc.PrepareBulkTransfer(context.Background(), in)
+		require.Error(t, err, name)
+		assert.True(t, errs.Is(err, c.code), "%s: expected %s, got %v", name, c.code, err)
+	}
+
+	_, err := svc.PrepareBulkTransfer(context.Background(), valid())
+	assert.NoError(t, err)
+}
+
+func TestPrepareBulkTransfer_Disabled(t *testing.T) {
+	svc := createAdminTestService(&MockRepo{}, &MockStatusCache{}, stubCreators{creator: adminCreator})
+
+	_, err := svc.PrepareBulkTransfer(context.Background(), domain.PrepareBulkTransferInput{})
+	assert.ErrorIs(t, err, domain.ErrTransfersDisabled)
+	_, err = svc.GetBulkTransferProgress(context.Background(), "bundle", "creator-user")
+	assert.ErrorIs(t, err, domain.ErrTransfersDisabled)
+}
+
+func TestGetBulkTransferProgress(t *testing.T) {
+	statusCache := &MockStatusCache{}
+	statusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	bundles := newMemoryBundles()
+	svc := newBulkTransferService(statusCache, bundles)
+	svc.SetAirdrops(bundles, nil, domain.AirdropPolicy{})
+
+	bundle, err := svc.PrepareBulkTransfer(context.Background(), domain.PrepareBulkTransferInput{
+		ChainID: "eip155:1", UserID: "creator-user", From: transferOwner,
+		Items: []domain.BulkTransferItem{
+			{Contract: transfer721, TokenID: "1", To: wallet(0)},
+			{Contract: transfer721, TokenID: "2", To: wallet(1)},
+		},
+	})
+	require.NoError(t, err)
+	for _, batch := range bundle.Batches {
+		statusCache.On("GetIntentStatus", mock.Anything, batch.IntentID).Return(&domain.IntentStatusPayload{
+			IntentID: batch.IntentID, Kind: domain.IntentKindBulkTransfer, Status: domain.IntentReady,
+		}, nil).Once()
+	}
+
+	progress, err := svc.GetBulkTransferProgress(context.Background(), bundle.BundleID, "creator-user")
+	require.NoError(t, err)
+	assert.Equal(t, domain.IntentReady, progress.Status)
+	assert.Equal(t, 2, progress.Confirmed)
+
+	_, err = svc.GetBulkTransferProgress(context.Background(), bundle.BundleID, "other-user")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	// bundles of other kinds are not read as bulk transfers, nor these as airdrops
+	_, err = svc.GetAirdropProgress(context.Background(), bundle.BundleID, "creator-user")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,130 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+const (
+	registeredMarketplace = "0x00000000000000000000000000000000000000a1"
+	unknownTarget         = "0x00000000000000000000000000000000000000ee"
+)
+
+// memoryCallTargets keeps overrides keyed by chain and lowercased address
+type memoryCallTargets map[[2]string]*domain.CallTargetOverride
+
+func (m memoryCallTargets) HasOverride(ctx context.Context, chainID domain.ChainID, address domain.Address) (bool, error) {
+	_, ok := m[[2]string{chainID, address}]
+	return ok, nil
+}
+
+func (m memoryCallTargets) PutOverride(ctx context.Context, o *domain.CallTargetOverride) error {
+	m[[2]string{o.ChainID, o.Address}] = o
+	return nil
+}
+
+func (m memoryCallTargets) DeleteOverride(ctx context.Context, chainID domain.ChainID, address domain.Address) (bool, error) {
+	key := [2]string{chainID, address}
+	_, ok := m[key]
+	delete(m, key)
+	return ok, nil
+}
+
+func (m memoryCallTargets) ListOverrides(ctx context.Context, chainID domain.ChainID) ([]*domain.CallTargetOverride, error) {
+	out := []*domain.CallTargetOverride{}
+	for key, o := range m {
+		if chainID == "" || key[0] == chainID {
+			out = append(out, o)
+		}
+	}
+	return out, nil
+}
+
+func callTargetService(creators domain.CollectionCreatorReader, overrides memoryCallTargets) (*service.Service, *MockRepo) {
+	repo := &MockRepo{}
+	cache := &MockStatusCache{}
+	registry := &MockChainRegistryClient{}
+	registry.On("GetContracts", mock.Anything, &protoChainRegistry.GetContractsRequest{ChainId: "eip155:8453"}).Return(&protoChainRegistry.GetContractsResponse{
+		Contracts: []*protoChainRegistry.Contract{{Name: "Marketplace", Address: registeredMarketplace}},
+	}, nil)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Intent")).Return(nil).Maybe()
+	cache.On("SetIntentStatus", mock.Anything, mock.Anything, domain.DefaultIntentTTL).Return(nil).Maybe()
+
+	svc := createTestService(repo, cache, registry).(*service.Service)
+	svc.SetCollectionAccess(creators, stubWallets{})
+	svc.SetCallTargets(overrides)
+	return svc, repo
+}
+
+func mintTo(contract domain.Address) domain.PrepareMintInput {
+	in := limitMint()
+	in.Contract = contract
+	return in
+}
+
+func TestPrepareMint_RejectsUnknownCallTarget(t *testing.T) {
+	svc, repo := callTargetService(stubCreators{err: domain.ErrCollectionNotFound}, memoryCallTargets{})
+
+	_, err := svc.PrepareMint(context.Background(), mintTo(unknownTarget))
+
+	assert.ErrorIs(t, err, domain.ErrTargetNotAllowed)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPrepareMint_AllowsRegisteredAndCatalogTargets(t *testing.T) {
+	svc, _ := callTargetService(stubCreators{err: domain.ErrCollectionNotFound}, memoryCallTargets{})
+	_, err := svc.PrepareMint(context.Background(), mintTo("0x00000000000000000000000000000000000000A1"))
+	assert.NoError(t, err)
+
+	svc, _ = callTargetService(stubCreators{creator: adminCreator}, memoryCallTargets{})
+	_, err = svc.PrepareMint(context.Background(), mintTo(unknownTarget))
+	assert.NoError(t, err)
+}
+
+func TestCallTargetOverride_AllowAndRevoke(t *testing.T) {
+	overrides := memoryCallTargets{}
+	svc, _ := callTargetService(stubCreators{err: domain.ErrCollectionNotFound}, overrides)
+	ctx := context.Background()
+
+	_, err := svc.AllowCallTarget(ctx, "eip155:8453", unknownTarget, " ", "admin-user")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = svc.AllowCallTarget(ctx, "eip155:8453", "not-an-address", "partner drop", "admin-user")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	override, err := svc.AllowCallTarget(ctx, "eip155:8453", "0x00000000000000000000000000000000000000EE", "partner drop", "admin-user")
+	require.NoError(t, err)
+	assert.Equal(t, unknownTarget, override.Address)
+	assert.Equal(t, "admin-user", override.GrantedBy)
+
+	_, err = svc.PrepareMint(ctx, mintTo(unknownTarget))
+	assert.NoError(t, err)
+
+	listed, err := svc.ListCallTargetOverrides(ctx, "eip155:8453")
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+
+	revoked, err := svc.RevokeCallTarget(ctx, "eip155:8453", unknownTarget, "admin-user")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	revoked, err = svc.RevokeCallTarget(ctx, "eip155:8453", unknownTarget, "admin-user")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	_, err = svc.PrepareMint(ctx, mintTo(unknownTarget))
+	assert.ErrorIs(t, err, domain.ErrTargetNotAllowed)
+}
+
+func TestCallTargetOverride_DisabledWithoutRepo(t *testing.T) {
+	svc := createTestService(&MockRepo{}, &MockStatusCache{}, &MockChainRegistryClient{}).(*service.Service)
+
+	_, err := svc.AllowCallTarget(context.Background(), "eip155:8453", unknownTarget, "partner drop", "admin-user")
+	assert.ErrorIs(t, err, domain.ErrOverridesDisabled)
+}
@@ -0,0 +1,227 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/infrastructure/clients"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const (
+	adminCollection = "0x00000000000000000000000000000000000000c0"
+	adminCreator    = "0xABCDEFabcdefABCDEFabcdefABCDEFabcdefABCD"
+)
+
+type stubCreators struct {
+	creator domain.Address
+	err     error
+}
+
+func (s stubCreators) GetCollectionCreator(ctx context.Context, chainID domain.ChainID, contract domain.Address) (domain.Address, error) {
+	return s.creator, s.err
+}
+
+type stubWallets map[string][]domain.Address
+
+func (s stubWallets) ListLinkedAddresses(ctx context.Context, userID string) ([]domain.Address, error) {
+	return s[userID], nil
+}
+
+type stubWalletClient struct {
+	walletpb.WalletServiceClient
+	links []*walletpb.WalletLink
+}
+
+func (s stubWalletClient) ListLinks(ctx context.Context, in *walletpb.ListLinksRequest, opts ...grpc.CallOption) (*walletpb.ListLinksResponse, error) {
+	return &walletpb.ListLinksResponse{Links: s.links}, nil
+}
+
+func TestWalletLinks_SkipsWatchOnlyWallets(t *testing.T) {
+	reader := clients.NewWalletLinks(stubWalletClient{links: []*walletpb.WalletLink{
+		{Address: adminCreator},
+		{Address: "0x2222222222222222222222222222222222222222", IsWatchOnly: true},
+	}})
+
+	addresses, err := reader.ListLinkedAddresses(context.Background(), "creator-user")
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Address{"0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"}, addresses)
+}
+
+func createAdminTestService(mockRepo *MockRepo, mockStatusCache *MockStatusCache, creators domain.CollectionCreatorReader) domain.OrchestratorService {
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+	svc.(*service.Service).SetCollectionAccess(creators, stubWallets{
+		"creator-user": {"0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"},
+		"other-user":   {"0x1111111111111111111111111111111111111111"},
+	})
+	return svc
+}
+
+func TestPrepareUpdateRoyalty_CreatorAllowed(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(it *domain.Intent) bool {
+		return it.Kind == domain.IntentKindUpdateRoyalty && *it.CreatedBy == "creator-user"
+	})).Return(nil)
+	mockStatusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	svc := createAdminTestService(mockRepo, mockStatusCache, stubCreators{creator: adminCreator})
+
+	result, err := svc.PrepareUpdateRoyalty(context.Background(), domain.PrepareUpdateRoyaltyInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "creator-user",
+		Receiver: "0x2222222222222222222222222222222222222222",
+		FeeBps:   500,
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.IntentID)
+	assert.Equal(t, domain.Address(adminCollection), result.Tx.To)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPrepareTransferCollectionOwnership_NonCreatorForbidden(t *testing.T) {
+	mockRepo := &MockRepo{}
+	svc := createAdminTestService(mockRepo, &MockStatusCache{}, stubCreators{creator: adminCreator})
+
+	_, err := svc.PrepareTransferCollectionOwnership(context.Background(), domain.PrepareTransferCollectionOwnershipInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "other-user",
+		NewOwner: "0x1111111111111111111111111111111111111111",
+	})
+
+	assert.Equal(t, domain.ErrForbidden, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPrepareSetBaseURI_UnknownCollection(t *testing.T) {
+	svc := createAdminTestService(&MockRepo{}, &MockStatusCache{}, stubCreators{err: domain.ErrCollectionNotFound})
+
+	_, err := svc.PrepareSetBaseURI(context.Background(), domain.PrepareSetBaseURIInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "creator-user",
+		BaseURI:  "ipfs://new/",
+	})
+
+	assert.Equal(t, domain.ErrCollectionNotFound, err)
+}
+
+func TestPrepareUpdateRoyalty_FeeTooHigh(t *testing.T) {
+	svc := createAdminTestService(&MockRepo{}, &MockStatusCache{}, stubCreators{creator: adminCreator})
+
+	_, err := svc.PrepareUpdateRoyalty(context.Background(), domain.PrepareUpdateRoyaltyInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "creator-user",
+		Receiver: "0x2222222222222222222222222222222222222222",
+		FeeBps:   10001,
+	})
+
+	assert.Equal(t, domain.ErrInvalidInput, err)
+}
+
+type stubOrgs struct {
+	orgID string
+}
+
+func (s stubOrgs) GetCollectionOwnerOrg(ctx context.Context, chainID domain.ChainID, contract domain.Address) (string, error) {
+	return s.orgID, nil
+}
+
+type stubMembers map[string]string
+
+func (s stubMembers) GetOrgRole(ctx context.Context, orgID, userID string) (string, error) {
+	return s[orgID+"/"+userID], nil
+}
+
+func createOrgTestService(mockRepo *MockRepo, mockStatusCache *MockStatusCache, orgID string) domain.OrchestratorService {
+	svc := createAdminTestService(mockRepo, mockStatusCache, stubCreators{creator: adminCreator})
+	svc.(*service.Service).SetOrgAccess(stubOrgs{orgID: orgID}, stubMembers{
+		"org-1/org-admin":  domain.OrgRoleAdmin,
+		"org-1/org-member": "member",
+	})
+	return svc
+}
+
+func TestPrepareSetBaseURI_OrgAdminAllowed(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(it *domain.Intent) bool {
+		return it.Kind == domain.IntentKindSetBaseURI && *it.CreatedBy == "org-admin"
+	})).Return(nil)
+	mockStatusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	svc := createOrgTestService(mockRepo, mockStatusCache, "org-1")
+
+	result, err := svc.PrepareSetBaseURI(context.Background(), domain.PrepareSetBaseURIInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "org-admin",
+		BaseURI:  "ipfs://new/",
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.IntentID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPrepareUpdateRoyalty_OrgMemberForbidden(t *testing.T) {
+	mockRepo := &MockRepo{}
+	svc := createOrgTestService(mockRepo, &MockStatusCache{}, "org-1")
+
+	_, err := svc.PrepareUpdateRoyalty(context.Background(), domain.PrepareUpdateRoyaltyInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "org-member",
+		Receiver: "0x2222222222222222222222222222222222222222",
+		FeeBps:   500,
+	})
+
+	assert.Equal(t, domain.ErrForbidden, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPrepareUpdateRoyalty_OrgOwnedCollectionNotManagedByCreatorOutsideOrg(t *testing.T) {
+	mockRepo := &MockRepo{}
+	svc := createOrgTestService(mockRepo, &MockStatusCache{}, "org-1")
+
+	_, err := svc.PrepareUpdateRoyalty(context.Background(), domain.PrepareUpdateRoyaltyInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "creator-user",
+		Receiver: "0x2222222222222222222222222222222222222222",
+		FeeBps:   500,
+	})
+
+	assert.Equal(t, domain.ErrForbidden, err)
+}
+
+func TestPrepareUpdateRoyalty_NoOrgFallsBackToCreator(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	mockRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mockStatusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	svc := createOrgTestService(mockRepo, mockStatusCache, "")
+
+	_, err := svc.PrepareUpdateRoyalty(context.Background(), domain.PrepareUpdateRoyaltyInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "creator-user",
+		Receiver: "0x2222222222222222222222222222222222222222",
+		FeeBps:   500,
+	})
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,124 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func u64(v uint64) *uint64 { return &v }
+
+func sepoliaContracts() *protoChainRegistry.GetContractsResponse {
+	return &protoChainRegistry.GetContractsResponse{
+		ChainId: "eip155:11155111",
+		Contracts: []*protoChainRegistry.Contract{
+			{Name: "ERC721CollectionFactory", Address: "0x1234567890123456789012345678901234567890", Roles: []string{contracts.RoleERC721Factory, contracts.RoleFactory}},
+		},
+		Params: &protoChainRegistry.ChainParams{MaxRoyaltyBps: 1000, MinStageDurationSec: 600},
+	}
+}
+
+func TestCollectionConstraintsFromParams(t *testing.T) {
+	assert.Equal(t, domain.DefaultCollectionConstraints, service.CollectionConstraintsFromParams(nil))
+
+	// unset limits keep their defaults
+	constraints := service.CollectionConstraintsFromParams(sepoliaContracts().Params)
+	assert.Equal(t, domain.CollectionConstraints{
+		MaxRoyaltyBps:       1000,
+		MinStageDurationSec: 600,
+		MaxSupplyCap:        domain.DefaultCollectionConstraints.MaxSupplyCap,
+	}, constraints)
+}
+
+func TestValidateCollectionConstraints(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	constraints := domain.CollectionConstraints{MaxRoyaltyBps: 1000, MinStageDurationSec: 600, MaxSupplyCap: 5000}
+
+	tests := []struct {
+		name  string
+		in    domain.PrepareCreateCollectionInput
+		field string
+	}{
+		{name: "within bounds", in: domain.PrepareCreateCollectionInput{
+			RoyaltyFee: u64(1000), MaxSupply: u64(5000), MintLimitPerWallet: u64(5),
+			MintStartTime: u64(uint64(now.Unix())), AllowlistStageDuration: u64(600),
+		}},
+		{name: "no allowlist stage", in: domain.PrepareCreateCollectionInput{AllowlistStageDuration: u64(0)}},
+		{name: "royalty over cap", in: domain.PrepareCreateCollectionInput{RoyaltyFee: u64(1001)}, field: "royaltyFee"},
+		{name: "supply over cap", in: domain.PrepareCreateCollectionInput{MaxSupply: u64(5001)}, field: "maxSupply"},
+		{name: "wallet limit over supply", in: domain.PrepareCreateCollectionInput{MaxSupply: u64(10), MintLimitPerWallet: u64(11)}, field: "mintLimitPerWallet"},
+		{name: "start in the past", in: domain.PrepareCreateCollectionInput{MintStartTime: u64(uint64(now.Unix()) - 1)}, field: "mintStartTime"},
+		{name: "stage too short", in: domain.PrepareCreateCollectionInput{AllowlistStageDuration: u64(599)}, field: "allowlistStageDuration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.ValidateCollectionConstraints(tt.in, constraints, now)
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *domain.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestPrepareCreateCollection_RejectsChainLimits(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockChainRegistry := &MockChainRegistryClient{}
+	svc := createTestService(mockRepo, &MockStatusCache{}, mockChainRegistry)
+	ctx := context.Background()
+
+	mockChainRegistry.On("GetContracts", ctx, mock.Anything).Return(sepoliaContracts(), nil)
+
+	_, err := svc.PrepareCreateCollection(ctx, domain.PrepareCreateCollectionInput{
+		ChainID:    "eip155:11155111",
+		Name:       "Test Collection",
+		Symbol:     "TEST",
+		Creator:    "0x1234567890123456789012345678901234567890",
+		Type:       domain.StdERC721,
+		RoyaltyFee: u64(2500),
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestGetCollectionDefaults(t *testing.T) {
+	mockChainRegistry := &MockChainRegistryClient{}
+	svc := createTestService(&MockRepo{}, &MockStatusCache{}, mockChainRegistry)
+	ctx := context.Background()
+
+	mockChainRegistry.On("GetContracts", ctx, &protoChainRegistry.GetContractsRequest{ChainId: "eip155:11155111"}).Return(sepoliaContracts(), nil)
+
+	defaults, err := svc.GetCollectionDefaults(ctx, "eip155:11155111")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500), defaults.RoyaltyFee)
+	assert.Equal(t, uint64(10000), defaults.MaxSupply)
+	assert.Equal(t, uint64(10), defaults.MintLimitPerWallet)
+	assert.Equal(t, uint64(86400), defaults.AllowlistStageDuration)
+	assert.Equal(t, []domain.Standard{domain.StdERC721}, defaults.SupportedTypes)
+
+	// the suggested values always pass validation
+	err = service.ValidateCollectionConstraints(domain.PrepareCreateCollectionInput{
+		RoyaltyFee:             &defaults.RoyaltyFee,
+		MaxSupply:              &defaults.MaxSupply,
+		MintLimitPerWallet:     &defaults.MintLimitPerWallet,
+		AllowlistStageDuration: &defaults.AllowlistStageDuration,
+	}, defaults.Constraints, time.Now())
+	assert.NoError(t, err)
+
+	_, err = svc.GetCollectionDefaults(ctx, "sepolia")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -0,0 +1,314 @@
+package test
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/encode"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+const factoryABIPath = "../../chain-registry-service/internal/seed/abi/ERC721CollectionFactory.json"
+
+// fakeAbiRegistry serves a fixed ABI and counts how often it is fetched
+type fakeAbiRegistry struct {
+	MockChainRegistryClient
+	abiJSON         string
+	abiSha256       string
+	registryVersion string
+	metaFetches     int
+	abiFetches      int
+}
+
+func (f *fakeAbiRegistry) GetContractMeta(ctx context.Context, req *protoChainRegistry.GetContractMetaRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetContractMetaResponse, error) {
+	f.metaFetches++
+	return &protoChainRegistry.GetContractMetaResponse{
+		ChainId:         req.ChainId,
+		Contract:        &protoChainRegistry.Contract{Address: req.Address, AbiSha256: f.abiSha256},
+		RegistryVersion: f.registryVersion,
+	}, nil
+}
+
+func (f *fakeAbiRegistry) GetAbiByAddress(ctx context.Context, req *protoChainRegistry.GetAbiByAddressRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetAbiBlobResponse, error) {
+	f.abiFetches++
+	return &protoChainRegistry.GetAbiBlobResponse{AbiJson: f.abiJSON, Etag: f.abiSha256}, nil
+}
+
+func newFakeAbiRegistry(t *testing.T) *fakeAbiRegistry {
+	abiJSON, err := os.ReadFile(factoryABIPath)
+	require.NoError(t, err)
+	return &fakeAbiRegistry{abiJSON: string(abiJSON), abiSha256: "sha-v1", registryVersion: "1"}
+}
+
+func collectionInput() domain.PrepareCreateCollectionInput {
+	return domain.PrepareCreateCollectionInput{
+		ChainID:  "eip155:1",
+		Name:     "Test",
+		Symbol:   "TST",
+		Creator:  "0x1234567890123456789012345678901234567890",
+		TokenURI: "ipfs://test",
+		Type:     domain.StdERC721,
+	}
+}
+
+func TestEncodeCreateCollection_CachesParsedABI(t *testing.T) {
+	registry := newFakeAbiRegistry(t)
+	encoder := encode.NewEncoder(registry)
+	factory := domain.Address("0x00000000000000000000000000000000000000fa")
+
+	to, data, value, _, err := encoder.EncodeCreateCollection(context.Background(), "eip155:1", factory, collectionInput())
+	require.NoError(t, err)
+	assert.Equal(t, factory, to)
+	assert.Equal(t, "0", value)
+	assert.NotEmpty(t, data)
+
+	_, again, _, _, err := encoder.EncodeCreateCollection(context.Background(), "eip155:1", factory, collectionInput())
+	require.NoError(t, err)
+	assert.Equal(t, data, again)
+	assert.Equal(t, 1, registry.abiFetches)
+	assert.Equal(t, 1, registry.metaFetches, "a cached ABI is trusted until the registry version moves")
+}
+
+func TestEncodeCreateCollection_RefetchesAfterVersionBump(t *testing.T) {
+	registry := newFakeAbiRegistry(t)
+	encoder := encode.NewEncoder(registry)
+	factory := domain.Address("0x00000000000000000000000000000000000000fa")
+
+	_, _, _, _, err := encoder.EncodeCreateCollection(context.Background(), "eip155:1", factory, collectionInput())
+	require.NoError(t, err)
+
+	registry.registryVersion = "2"
+	registry.abiSha256 = "sha-v2"
+	err = encoder.(*encode.Encoder).HandleRegistryChanged(context.Background(), &contracts.RegistryChangedEvent{ChainID: "eip155:1", RegistryVersion: "2"})
+	require.NoError(t, err)
+
+	_, _, _, _, err = encoder.EncodeCreateCollection(context.Background(), "eip155:1", factory, collectionInput())
+	require.NoError(t, err)
+	assert.Equal(t, 2, registry.metaFetches)
+	assert.Equal(t, 2, registry.abiFetches)
+}
+
+func TestEncodeCreateCollection_OtherChainsKeepTheirABIs(t *testing.T) {
+	registry := newFakeAbiRegistry(t)
+	encoder := encode.NewEncoder(registry)
+	factory := domain.Address("0x00000000000000000000000000000000000000fa")
+
+	for _, chainID := range []domain.ChainID{"eip155:1", "eip155:137"} {
+		_, _, _, _, err := encoder.EncodeCreateCollection(context.Background(), chainID, factory, collectionInput())
+		require.NoError(t, err)
+	}
+	err := encoder.(*encode.Encoder).HandleRegistryChanged(context.Background(), &contracts.RegistryChangedEvent{ChainID: "eip155:1", RegistryVersion: "2"})
+	require.NoError(t, err)
+
+	_, _, _, _, err = encoder.EncodeCreateCollection(context.Background(), "eip155:137", factory, collectionInput())
+	require.NoError(t, err)
+	assert.Equal(t, 2, registry.metaFetches, "the bump on eip155:1 leaves eip155:137 cached")
+}
+
+func TestEncoder_HandleRegistryChanged_EvictsChainContracts(t *testing.T) {
+	registry := newFakeAbiRegistry(t)
+	registry.abiSha256 = "" // resolved through the contract entry rather than the ABI hash
+	encoder := encode.NewEncoder(registry)
+	factory := domain.Address("0x00000000000000000000000000000000000000fa")
+
+	_, _, _, _, err := encoder.EncodeCreateCollection(context.Background(), "eip155:1", factory, collectionInput())
+	require.NoError(t, err)
+
+	err = encoder.(*encode.Encoder).HandleRegistryChanged(context.Background(), &contracts.RegistryChangedEvent{ChainID: "eip155:1", RegistryVersion: "2"})
+	require.NoError(t, err)
+
+	_, _, _, _, err = encoder.EncodeCreateCollection(context.Background(), "eip155:1", factory, collectionInput())
+	require.NoError(t, err)
+	assert.Equal(t, 2, registry.abiFetches)
+}
+
+func TestEncodeCreateCollection_UnknownMethod(t *testing.T) {
+	registry := newFakeAbiRegistry(t)
+	registry.abiJSON = `{"abi":[]}`
+	encoder := encode.NewEncoder(registry)
+
+	_, _, _, _, err := encoder.EncodeCreateCollection(context.Background(), "eip155:1", "0x00000000000000000000000000000000000000fa", collectionInput())
+	assert.ErrorContains(t, err, "createERC721Collection not found")
+}
+
+func TestEncodeAuction_FallsBackToBuiltinABI(t *testing.T) {
+	encoder := encode.NewEncoder(&MockChainRegistryClient{})
+
+	to, data, err := encoder.EncodeAuction(context.Background(), "eip155:1", "0xdc64a140aa3e981100a9beca4e685f962f0cf6c9", "bid", "1000", big.NewInt(3))
+	require.NoError(t, err)
+	assert.Equal(t, "0xdc64a140aa3e981100a9beca4e685f962f0cf6c9", to)
+	assert.Len(t, data, 4+32)
+
+	_, _, err = encoder.EncodeAuction(context.Background(), "eip155:1", "0xdc64a140aa3e981100a9beca4e685f962f0cf6c9", "settle", "1000", big.NewInt(3))
+	assert.Error(t, err, "value on a non-payable method must be rejected")
+}
+
+func TestEncodeAirdrop_FallsBackToBuiltinABI(t *testing.T) {
+	encoder := encode.NewEncoder(&MockChainRegistryClient{})
+	collection := "0x00000000000000000000000000000000000000c0"
+	recipients := []domain.AirdropRecipient{
+		{Address: "0x0000000000000000000000000000000000000001", Amount: 1},
+		{Address: "0x0000000000000000000000000000000000000002", Amount: 3},
+	}
+
+	to, data, err := encoder.EncodeAirdrop(context.Background(), "eip155:1", collection, domain.StdERC721, nil, recipients)
+	require.NoError(t, err)
+	assert.Equal(t, collection, to)
+	assert.Equal(t, crypto.Keccak256([]byte("airdrop(address[],uint256[])"))[:4], data[:4])
+
+	_, data, err = encoder.EncodeAirdrop(context.Background(), "eip155:1", collection, domain.StdERC1155, big.NewInt(7), recipients)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256([]byte("airdrop(uint256,address[],uint256[])"))[:4], data[:4])
+	// selector, id, two offsets, then each array's length and two items
+	assert.Len(t, data, 4+3*32+2*3*32)
+
+	_, _, err = encoder.EncodeAirdrop(context.Background(), "eip155:1", collection, domain.StdERC1155, nil, recipients)
+	assert.Error(t, err, "an ERC-1155 airdrop needs a token id")
+}
+
+func TestEncodeTokenTransfer(t *testing.T) {
+	encoder := encode.NewEncoder(&MockChainRegistryClient{})
+	collection := "0x00000000000000000000000000000000000000c0"
+	from, to := "0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002"
+
+	target, data, err := encoder.EncodeTokenTransfer(context.Background(), "eip155:1", collection, domain.StdERC721, from, to, []domain.BulkTransferToken{{TokenID: "5", Amount: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, collection, target)
+	assert.Equal(t, crypto.Keccak256([]byte("safeTransferFrom(address,address,uint256)"))[:4], data[:4])
+	assert.Len(t, data, 4+3*32)
+
+	tokens := []domain.BulkTransferToken{{TokenID: "5", Amount: 2}, {TokenID: "6", Amount: 1}}
+	_, data, err = encoder.EncodeTokenTransfer(context.Background(), "eip155:1", collection, domain.StdERC1155, from, to, tokens)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256([]byte("safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)"))[:4], data[:4])
+	// selector, from, to, three offsets, both arrays' length and two items, empty bytes
+	assert.Len(t, data, 4+5*32+2*3*32+32)
+
+	_, _, err = encoder.EncodeTokenTransfer(context.Background(), "eip155:1", collection, domain.StdERC721, from, to, tokens)
+	assert.Error(t, err, "an ERC-721 transfer sends one token")
+
+	check, approve, err := encoder.EncodeOperatorApproval(context.Background(), "eip155:1", collection, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256([]byte("isApprovedForAll(address,address)"))[:4], check[:4])
+	assert.Equal(t, crypto.Keccak256([]byte("setApprovalForAll(address,bool)"))[:4], approve[:4])
+}
+
+func TestAbiCompatWarnings_FlagsChangedEncodedMethods(t *testing.T) {
+	event := &contracts.AbiChangedEvent{
+		ChainID: "eip155:1",
+		Address: "0xdc64a140aa3e981100a9beca4e685f962f0cf6c9",
+		Diff: contracts.AbiDiff{
+			AddedFunctions:   []string{"bid(uint256,address)"},
+			RemovedFunctions: []string{"bid(uint256)", "pause()"},
+			RemovedEvents:    []string{"BidPlaced(uint256,address,uint256,uint64)"},
+		},
+	}
+
+	lines := encode.AbiCompatWarnings(event)
+
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], "service=orchestrator")
+	assert.Contains(t, lines[0], "removed=bid(uint256)|replacement=bid(uint256,address)")
+}
+
+// mintFunctionRegistry describes one contract with a registered mint function
+type mintFunctionRegistry struct {
+	MockChainRegistryClient
+	mintFunction *protoChainRegistry.MintFunction
+}
+
+func (f *mintFunctionRegistry) GetContractMeta(ctx context.Context, req *protoChainRegistry.GetContractMetaRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetContractMetaResponse, error) {
+	return &protoChainRegistry.GetContractMetaResponse{
+		ChainId:  req.ChainId,
+		Contract: &protoChainRegistry.Contract{Address: req.Address, MintFunction: f.mintFunction},
+	}, nil
+}
+
+const mintCollection = "0x00000000000000000000000000000000000000c1"
+
+func mintInput(quantity uint64) domain.PrepareMintInput {
+	return domain.PrepareMintInput{
+		ChainID:  "eip155:1",
+		Contract: mintCollection,
+		Standard: domain.StdERC721,
+		Minter:   "0x1234567890123456789012345678901234567890",
+		Quantity: quantity,
+	}
+}
+
+func TestEncodeMint_StandardERC721(t *testing.T) {
+	encoder := encode.NewEncoder(&MockChainRegistryClient{})
+
+	to, data, value, err := encoder.EncodeMint(context.Background(), "eip155:1", mintCollection, domain.StdERC721, mintInput(2))
+	require.NoError(t, err)
+	assert.Equal(t, mintCollection, to)
+	assert.Equal(t, crypto.Keccak256([]byte("mint(address,uint256)"))[:4], data[:4])
+	assert.Len(t, data, 4+2*32)
+	assert.Equal(t, "0", value)
+}
+
+func TestEncodeMint_StandardERC1155RequiresTokenID(t *testing.T) {
+	encoder := encode.NewEncoder(&MockChainRegistryClient{})
+	in := mintInput(5)
+	in.Standard = domain.StdERC1155
+
+	_, _, _, err := encoder.EncodeMint(context.Background(), "eip155:1", mintCollection, domain.StdERC1155, in)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	in.TokenID = "7"
+	_, data, _, err := encoder.EncodeMint(context.Background(), "eip155:1", mintCollection, domain.StdERC1155, in)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256([]byte("mint(address,uint256,uint256,bytes)"))[:4], data[:4])
+}
+
+func TestEncodeMint_ERC721AQuantityMint(t *testing.T) {
+	encoder := encode.NewEncoder(&mintFunctionRegistry{mintFunction: &protoChainRegistry.MintFunction{
+		Name:         "mint",
+		Args:         []string{"quantity"},
+		Payable:      true,
+		UnitPriceWei: "10000000000000000",
+		MaxPerTx:     5,
+	}})
+
+	_, data, value, err := encoder.EncodeMint(context.Background(), "eip155:1", mintCollection, domain.StdERC721, mintInput(3))
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256([]byte("mint(uint256)"))[:4], data[:4])
+	assert.Equal(t, big.NewInt(3).Bytes(), new(big.Int).SetBytes(data[4:]).Bytes())
+	assert.Equal(t, "30000000000000000", value)
+
+	_, _, _, err = encoder.EncodeMint(context.Background(), "eip155:1", mintCollection, domain.StdERC721, mintInput(6))
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "quantity above maxPerTx must be rejected")
+}
+
+func TestEncodeMint_AllowlistClaim(t *testing.T) {
+	encoder := encode.NewEncoder(&mintFunctionRegistry{mintFunction: &protoChainRegistry.MintFunction{
+		Name: "claim",
+		Args: []string{"proof", "quantity"},
+	}})
+	in := mintInput(1)
+	in.Proof = []string{
+		"0x" + strings.Repeat("ab", 32),
+		"0x" + strings.Repeat("cd", 32),
+	}
+
+	_, data, value, err := encoder.EncodeMint(context.Background(), "eip155:1", mintCollection, domain.StdERC721, in)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256([]byte("claim(bytes32[],uint256)"))[:4], data[:4])
+	// offset, quantity, length, two proof entries
+	assert.Len(t, data, 4+5*32)
+	assert.Equal(t, "0", value)
+
+	in.Proof = []string{"0xabcd"}
+	_, _, _, err = encoder.EncodeMint(context.Background(), "eip155:1", mintCollection, domain.StdERC721, in)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
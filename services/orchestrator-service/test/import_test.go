@@ -0,0 +1,241 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+const importedCollection = "0x00000000000000000000000000000000000000d1"
+
+type fakeInspector struct {
+	info       *domain.ContractInfo
+	err        error
+	startBlock uint64
+}
+
+func (f *fakeInspector) InspectCollection(ctx context.Context, chainID domain.ChainID, contract domain.Address) (*domain.ContractInfo, error) {
+	return f.info, f.err
+}
+
+func (f *fakeInspector) DeploymentBlock(ctx context.Context, chainID domain.ChainID, contract domain.Address) (uint64, error) {
+	return f.startBlock, nil
+}
+
+func createImportTestService(registry *MockChainRegistryClient, events *MockIntentEvents, inspector *fakeInspector, prover *fakeAddressProver) domain.OrchestratorService {
+	svc := createTestService(&MockRepo{}, &MockStatusCache{}, registry)
+	svc.(*service.Service).SetCollectionAccess(stubCreators{err: domain.ErrCollectionNotFound}, stubWallets{})
+	svc.(*service.Service).SetIntentEvents(events)
+	svc.(*service.Service).SetCollectionImport(inspector)
+	if prover != nil {
+		svc.(*service.Service).SetImportChallenges(prover)
+	}
+	return svc
+}
+
+// fakeAddressProver stands in for wallet-service: it hands out one challenge and proves the
+// address it was created for when given signature "0xsafe"
+type fakeAddressProver struct {
+	created *domain.AddressChallenge
+	purpose string
+	address domain.Address
+	userID  string
+	res     string
+}
+
+func (f *fakeAddressProver) CreateAddressChallenge(ctx context.Context, purpose string, chainID domain.ChainID, address domain.Address, userID, resource, statement string) (*domain.AddressChallenge, error) {
+	f.purpose, f.address, f.userID, f.res = purpose, address, userID, resource
+	now := time.Now().UTC()
+	f.created = &domain.AddressChallenge{ID: "challenge-1", Message: statement, IssuedAt: now, ExpiresAt: now.Add(10 * time.Minute)}
+	return f.created, nil
+}
+
+func (f *fakeAddressProver) VerifyAddressChallenge(ctx context.Context, challengeID, signature, purpose, userID string) (*domain.AddressProof, error) {
+	if f.created == nil || challengeID != f.created.ID {
+		return nil, domain.ErrChallengeExpired
+	}
+	f.created = nil
+	if signature != "0xsafe" || purpose != f.purpose || userID != f.userID {
+		return nil, &domain.ValidationError{Field: "signature", Reason: "invalid_signature"}
+	}
+	return &domain.AddressProof{Address: f.address, ChainID: "eip155:1", Resource: f.res, Method: "eip1271"}, nil
+}
+
+func TestImportCollection_WalletChallengeForContractOwner(t *testing.T) {
+	safe := "0x41675c099f32341bf84bfc5382af534df5c7461a"
+
+	registry := &MockChainRegistryClient{}
+	registry.On("RegisterCollection", mock.Anything, mock.MatchedBy(func(req *protoChainRegistry.RegisterCollectionRequest) bool {
+		return req.Address == importedCollection && req.Standard == protoChainRegistry.ContractStandard_STD_ERC1155 && req.StartBlock == 1234
+	})).Return(&protoChainRegistry.RegisterCollectionResponse{Created: true}, nil)
+	events := &MockIntentEvents{}
+	events.On("PublishCollectionImported", mock.Anything, mock.MatchedBy(func(e domain.ImportedCollection) bool {
+		return e.Owner == safe && e.ImportedBy == "user-1" && e.StartBlock == 1234
+	})).Return(nil)
+	inspector := &fakeInspector{info: &domain.ContractInfo{Standard: domain.StdERC1155, Owner: safe}, startBlock: 1234}
+	prover := &fakeAddressProver{}
+	svc := createImportTestService(registry, events, inspector, prover)
+
+	challenge, err := svc.PrepareImportCollection(context.Background(), domain.PrepareImportCollectionInput{
+		ChainID: "eip155:1", Contract: importedCollection, UserID: "user-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "challenge-1", challenge.ChallengeID)
+	// The challenge goes to the contract's owner, scoped to this import
+	assert.Equal(t, safe, prover.address)
+	assert.Equal(t, "collection_import", prover.purpose)
+	assert.Equal(t, "eip155:1/"+importedCollection, prover.res)
+
+	in := domain.ImportCollectionInput{
+		ChainID:     "eip155:1",
+		Contract:    strings.Replace(importedCollection, "d1", "D1", 1),
+		UserID:      "user-1",
+		ChallengeID: challenge.ChallengeID,
+		Signature:   "0xsafe",
+	}
+	_, err = svc.ImportCollection(context.Background(), in)
+	require.NoError(t, err)
+	events.AssertExpectations(t)
+
+	// The challenge is single-use
+	_, err = svc.ImportCollection(context.Background(), in)
+	assert.ErrorIs(t, err, domain.ErrChallengeExpired)
+
+	// A challenge for another collection does not import this one
+	_, err = svc.PrepareImportCollection(context.Background(), domain.PrepareImportCollectionInput{
+		ChainID: "eip155:1", Contract: "0x00000000000000000000000000000000000000d2", UserID: "user-1",
+	})
+	require.NoError(t, err)
+	_, err = svc.ImportCollection(context.Background(), in)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	registry.AssertNumberOfCalls(t, "RegisterCollection", 1)
+}
+
+func TestImportCollection_Rejections(t *testing.T) {
+	owner := "0x00000000000000000000000000000000000000a1"
+	erc721 := &domain.ContractInfo{Standard: domain.StdERC721, Owner: owner}
+
+	cases := []struct {
+		name      string
+		imported  *domain.ContractInfo // what the contract reports at import, after the challenge
+		creators  domain.CollectionCreatorReader
+		challenge string
+		signature string
+		want      error
+	}{
+		{
+			name:     "ownership moved since the challenge",
+			imported: &domain.ContractInfo{Standard: domain.StdERC721, Owner: "0x00000000000000000000000000000000000000b2"},
+			want:     domain.ErrNotContractOwner,
+		},
+		{
+			name:      "signature does not prove the owner",
+			imported:  erc721,
+			signature: "0xother",
+			want:      domain.ErrInvalidInput,
+		},
+		{
+			name:     "not an nft contract",
+			imported: &domain.ContractInfo{Owner: owner},
+			want:     domain.ErrUnsupportedStd,
+		},
+		{
+			name:     "already listed",
+			imported: erc721,
+			creators: stubCreators{creator: owner},
+			want:     domain.ErrCollectionExists,
+		},
+		{
+			name:      "unknown challenge",
+			imported:  erc721,
+			challenge: "challenge-2",
+			want:      domain.ErrChallengeExpired,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := &MockChainRegistryClient{}
+			events := &MockIntentEvents{}
+			inspector := &fakeInspector{info: erc721}
+			svc := createImportTestService(registry, events, inspector, &fakeAddressProver{})
+			if tc.creators != nil {
+				svc.(*service.Service).SetCollectionAccess(tc.creators, stubWallets{})
+			}
+
+			challenge, err := svc.PrepareImportCollection(context.Background(), domain.PrepareImportCollectionInput{
+				ChainID: "eip155:1", Contract: importedCollection, UserID: "user-1",
+			})
+			require.NoError(t, err)
+			inspector.info = tc.imported
+
+			in := domain.ImportCollectionInput{
+				ChainID:     "eip155:1",
+				Contract:    importedCollection,
+				UserID:      "user-1",
+				ChallengeID: challenge.ChallengeID,
+				Signature:   "0xsafe",
+			}
+			if tc.challenge != "" {
+				in.ChallengeID = tc.challenge
+			}
+			if tc.signature != "" {
+				in.Signature = tc.signature
+			}
+			_, err = svc.ImportCollection(context.Background(), in)
+
+			assert.ErrorIs(t, err, tc.want)
+			registry.AssertNotCalled(t, "RegisterCollection", mock.Anything, mock.Anything)
+			events.AssertNotCalled(t, "PublishCollectionImported", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// Without a wallet-service challenge there is nothing single-use to sign, so nothing imports
+func TestImportCollection_RequiresWalletChallenge(t *testing.T) {
+	owner := "0x00000000000000000000000000000000000000a1"
+	inspector := &fakeInspector{info: &domain.ContractInfo{Standard: domain.StdERC721, Owner: owner}}
+
+	t.Run("no challenge id", func(t *testing.T) {
+		registry := &MockChainRegistryClient{}
+		svc := createImportTestService(registry, &MockIntentEvents{}, inspector, &fakeAddressProver{})
+		_, err := svc.ImportCollection(context.Background(), domain.ImportCollectionInput{
+			ChainID: "eip155:1", Contract: importedCollection, UserID: "user-1", Signature: "0xsafe",
+		})
+		assert.ErrorIs(t, err, domain.ErrChallengeRequired)
+		assert.Equal(t, errs.FailedPrecondition, errs.CodeOf(err))
+		assert.Contains(t, err.Error(), "challengeId", "the error names the challenge flow")
+		registry.AssertNotCalled(t, "RegisterCollection", mock.Anything, mock.Anything)
+	})
+
+	t.Run("challenges not configured", func(t *testing.T) {
+		registry := &MockChainRegistryClient{}
+		svc := createImportTestService(registry, &MockIntentEvents{}, inspector, nil)
+		_, err := svc.PrepareImportCollection(context.Background(), domain.PrepareImportCollectionInput{
+			ChainID: "eip155:1", Contract: importedCollection, UserID: "user-1",
+		})
+		assert.ErrorIs(t, err, domain.ErrImportDisabled)
+		_, err = svc.ImportCollection(context.Background(), domain.ImportCollectionInput{
+			ChainID: "eip155:1", Contract: importedCollection, UserID: "user-1", ChallengeID: "challenge-1", Signature: "0xsafe",
+		})
+		assert.ErrorIs(t, err, domain.ErrImportDisabled)
+		registry.AssertNotCalled(t, "RegisterCollection", mock.Anything, mock.Anything)
+	})
+
+	t.Run("no code at address", func(t *testing.T) {
+		svc := createImportTestService(&MockChainRegistryClient{}, &MockIntentEvents{}, &fakeInspector{err: domain.ErrNotFound}, &fakeAddressProver{})
+		_, err := svc.PrepareImportCollection(context.Background(), domain.PrepareImportCollectionInput{
+			ChainID: "eip155:1", Contract: importedCollection, UserID: "user-1",
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+)
+
+const limitMinter = "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"
+
+func openIntent(id string, kind domain.IntentKind, chainID domain.ChainID, bundleID string) domain.OpenIntent {
+	return domain.OpenIntent{
+		Intent:   &domain.Intent{ID: id, Kind: kind, ChainID: chainID, Status: domain.IntentPending},
+		BundleID: bundleID,
+	}
+}
+
+func limitedService(limits domain.IntentLimits, open []domain.OpenIntent) (*service.Service, *MockRepo, *MockStatusCache) {
+	repo := &MockRepo{}
+	cache := &MockStatusCache{}
+	repo.On("ListOpenIntents", mock.Anything, limitMinter, mock.Anything, mock.Anything).Return(open, nil)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Intent")).Return(nil).Maybe()
+	cache.On("SetIntentStatus", mock.Anything, mock.Anything, domain.DefaultIntentTTL).Return(nil).Maybe()
+
+	svc := createTestService(repo, cache, &MockChainRegistryClient{}).(*service.Service)
+	svc.SetIntentLimits(limits)
+	return svc, repo, cache
+}
+
+func limitMint() domain.PrepareMintInput {
+	return domain.PrepareMintInput{
+		ChainID:  "eip155:8453",
+		Contract: "0x1234567890123456789012345678901234567890",
+		Standard: domain.StdERC721,
+		Minter:   "0xABCDEFabcdefabcdefabcdefabcdefabcdefABCD",
+		Quantity: 1,
+	}
+}
+
+func TestPrepareMint_RejectsOnceOpenLimitReached(t *testing.T) {
+	svc, repo, cache := limitedService(domain.IntentLimits{MaxOpen: 2}, []domain.OpenIntent{
+		openIntent("i1", domain.IntentKindMint, "eip155:8453", ""),
+		openIntent("i2", domain.IntentKindCollection, "eip155:1", ""),
+	})
+	cache.On("GetIntentStatus", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+
+	_, err := svc.PrepareMint(context.Background(), limitMint())
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrIntentLimit)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPrepareMint_PerKindAndChainLimits(t *testing.T) {
+	open := []domain.OpenIntent{
+		openIntent("i1", domain.IntentKindMint, "eip155:1", ""),
+		openIntent("i2", domain.IntentKindCollection, "eip155:8453", ""),
+	}
+
+	svc, _, cache := limitedService(domain.IntentLimits{MaxOpenPerKind: 1}, open)
+	cache.On("GetIntentStatus", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+	_, err := svc.PrepareMint(context.Background(), limitMint())
+	assert.ErrorIs(t, err, domain.ErrIntentLimit)
+
+	svc, _, cache = limitedService(domain.IntentLimits{MaxOpenPerChain: 1}, open)
+	cache.On("GetIntentStatus", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+	_, err = svc.PrepareMint(context.Background(), limitMint())
+	assert.ErrorIs(t, err, domain.ErrIntentLimit)
+
+	svc, _, cache = limitedService(domain.IntentLimits{MaxOpenPerKind: 2, MaxOpenPerChain: 2}, open)
+	cache.On("GetIntentStatus", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+	_, err = svc.PrepareMint(context.Background(), limitMint())
+	assert.NoError(t, err)
+}
+
+func TestPrepareMint_ConfirmedIntentsFreeTheirSlot(t *testing.T) {
+	svc, repo, cache := limitedService(domain.IntentLimits{MaxOpen: 2}, []domain.OpenIntent{
+		openIntent("i1", domain.IntentKindMint, "eip155:8453", ""),
+		openIntent("i2", domain.IntentKindMint, "eip155:8453", ""),
+	})
+	cache.On("GetIntentStatus", mock.Anything, "i1").Return(&domain.IntentStatusPayload{IntentID: "i1", Status: domain.IntentReady, Seq: 1}, nil)
+	cache.On("GetIntentStatus", mock.Anything, "i2").Return(nil, domain.ErrNotFound)
+
+	_, err := svc.PrepareMint(context.Background(), limitMint())
+
+	require.NoError(t, err)
+	repo.AssertCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPrepareMint_BundleCountsAsOneIntent(t *testing.T) {
+	svc, _, cache := limitedService(domain.IntentLimits{MaxOpen: 2}, []domain.OpenIntent{
+		openIntent("b1", domain.IntentKindMint, "eip155:8453", "bundle-1"),
+		openIntent("b2", domain.IntentKindMint, "eip155:8453", "bundle-1"),
+		openIntent("b3", domain.IntentKindMint, "eip155:8453", "bundle-1"),
+	})
+	cache.On("GetIntentStatus", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+
+	_, err := svc.PrepareMint(context.Background(), limitMint())
+
+	assert.NoError(t, err)
+}
+
+func TestPrepareMint_NoLimitsSkipsCount(t *testing.T) {
+	svc, repo, _ := limitedService(domain.IntentLimits{}, nil)
+
+	_, err := svc.PrepareMint(context.Background(), limitMint())
+
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "ListOpenIntents", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,174 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// fakeMediaRefs is media-service with a fixed set of pinned assets
+type fakeMediaRefs struct {
+	pinned map[string]bool
+	held   map[domain.MediaRef]bool
+	down   bool
+}
+
+func newFakeMediaRefs(pinned ...string) *fakeMediaRefs {
+	f := &fakeMediaRefs{pinned: map[string]bool{}, held: map[domain.MediaRef]bool{}}
+	for _, assetID := range pinned {
+		f.pinned[assetID] = true
+	}
+	return f
+}
+
+func (f *fakeMediaRefs) AddRef(ctx context.Context, ref domain.MediaRef) error {
+	if !f.pinned[ref.AssetID] {
+		return domain.ErrAssetNotPinned
+	}
+	f.held[ref] = true
+	return nil
+}
+
+func (f *fakeMediaRefs) ReleaseRef(ctx context.Context, ref domain.MediaRef) error {
+	if f.down {
+		return errors.New("media-service unavailable")
+	}
+	delete(f.held, ref)
+	return nil
+}
+
+func collectionWithAssets(assetIDs ...string) domain.PrepareCreateCollectionInput {
+	return domain.PrepareCreateCollectionInput{
+		ChainID:  "eip155:8453",
+		Name:     "Test Collection",
+		Symbol:   "TEST",
+		Creator:  "0x1234567890123456789012345678901234567890",
+		TokenURI: "ipfs://test",
+		Type:     domain.StdERC721,
+		AssetIDs: assetIDs,
+	}
+}
+
+func newMediaService(mockRepo *MockRepo, mockStatusCache *MockStatusCache, media domain.MediaRefs) *service.Service {
+	mockChainRegistry := &MockChainRegistryClient{}
+	mockChainRegistry.On("GetContracts", mock.Anything, mock.Anything).Return(&protoChainRegistry.GetContractsResponse{
+		ChainId: "eip155:8453",
+		Contracts: []*protoChainRegistry.Contract{
+			{Name: "ERC721CollectionFactory", Address: "0x1234567890123456789012345678901234567890", Roles: []string{contracts.RoleERC721Factory, contracts.RoleFactory}},
+		},
+	}, nil)
+	svc := createTestService(mockRepo, mockStatusCache, mockChainRegistry).(*service.Service)
+	if media != nil {
+		svc.SetMediaRefs(media)
+	}
+	return svc
+}
+
+func TestPrepareCreateCollection_HoldsPinnedAssets(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	media := newFakeMediaRefs("logo", "banner")
+	svc := newMediaService(mockRepo, mockStatusCache, media)
+
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Intent")).Return(nil)
+	mockRepo.On("AttachMedia", ctx, mock.AnythingOfType("string"), []string{"logo", "banner"}).Return(nil)
+	mockRepo.On("UpdateTxHash", ctx, mock.AnythingOfType("string"), "", mock.AnythingOfType("*string")).Return(nil)
+	mockStatusCache.On("SetIntentStatus", ctx, mock.Anything, domain.DefaultIntentTTL).Return(nil)
+
+	// Repeated ids are attached once
+	result, err := svc.PrepareCreateCollection(ctx, collectionWithAssets("logo", "banner", "logo"))
+	require.NoError(t, err)
+
+	assert.Len(t, media.held, 2)
+	assert.True(t, media.held[domain.MediaRef{IntentID: result.IntentID, AssetID: "logo"}])
+	assert.True(t, media.held[domain.MediaRef{IntentID: result.IntentID, AssetID: "banner"}])
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPrepareCreateCollection_RejectsUnpinnedAsset(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &MockRepo{}
+	media := newFakeMediaRefs("logo")
+	svc := newMediaService(mockRepo, &MockStatusCache{}, media)
+
+	var intentID string
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Intent")).
+		Run(func(args mock.Arguments) { intentID = args.Get(1).(*domain.Intent).ID }).
+		Return(nil)
+	mockRepo.On("AttachMedia", ctx, mock.AnythingOfType("string"), []string{"logo", "banner"}).Return(nil)
+	mockRepo.On("MarkMediaReleased", ctx, mock.AnythingOfType("domain.MediaRef")).Return(nil)
+	mockRepo.On("UpdateStatus", ctx, mock.AnythingOfType("string"), domain.IntentFailed, mock.AnythingOfType("*string")).Return(nil)
+
+	_, err := svc.PrepareCreateCollection(ctx, collectionWithAssets("logo", "banner"))
+	assert.ErrorIs(t, err, domain.ErrAssetNotPinned)
+	assert.Contains(t, err.Error(), "banner")
+
+	// The ref taken on the pinned logo is given back
+	assert.Empty(t, media.held)
+	mockRepo.AssertCalled(t, "MarkMediaReleased", ctx, domain.MediaRef{IntentID: intentID, AssetID: "logo"})
+	mockRepo.AssertCalled(t, "UpdateStatus", ctx, intentID, domain.IntentFailed, mock.AnythingOfType("*string"))
+}
+
+func TestPrepareCreateCollection_AssetsNeedMediaService(t *testing.T) {
+	mockRepo := &MockRepo{}
+	svc := newMediaService(mockRepo, &MockStatusCache{}, nil)
+
+	_, err := svc.PrepareCreateCollection(context.Background(), collectionWithAssets("logo"))
+	assert.ErrorIs(t, err, domain.ErrMediaNotConfigured)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestExpireIntents_CachesExpiredStatus(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := newMediaService(mockRepo, mockStatusCache, newFakeMediaRefs())
+
+	expired := &domain.Intent{ID: "unsent", Kind: domain.IntentKindCollection, ChainID: "eip155:8453", Status: domain.IntentExpired}
+	mockRepo.On("ExpireUnsent", ctx, now, mock.AnythingOfType("int")).Return([]*domain.Intent{expired}, nil)
+	mockStatusCache.On("SetIntentStatus", ctx, mock.MatchedBy(func(p domain.IntentStatusPayload) bool {
+		return p.IntentID == "unsent" && p.Status == domain.IntentExpired && p.Error != nil
+	}), domain.DefaultIntentTTL).Return(nil)
+
+	count, err := svc.ExpireIntents(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	mockStatusCache.AssertExpectations(t)
+}
+
+func TestReleaseHeldMedia_RetriesFailedReleases(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &MockRepo{}
+	media := newFakeMediaRefs("logo")
+	svc := newMediaService(mockRepo, &MockStatusCache{}, media)
+
+	ref := domain.MediaRef{IntentID: "failed-intent", AssetID: "logo"}
+	media.held[ref] = true
+	mockRepo.On("ListReleasableMedia", ctx, mock.AnythingOfType("int")).Return([]domain.MediaRef{ref}, nil)
+
+	// Left for the next sweep while media-service is down
+	media.down = true
+	released, err := svc.ReleaseHeldMedia(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, released)
+	mockRepo.AssertNotCalled(t, "MarkMediaReleased", mock.Anything, mock.Anything)
+
+	media.down = false
+	mockRepo.On("MarkMediaReleased", ctx, ref).Return(nil)
+	released, err = svc.ReleaseHeldMedia(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, released)
+	assert.Empty(t, media.held)
+}
@@ -0,0 +1,157 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/encode"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+)
+
+func validSplits() []domain.PayoutSplit {
+	return []domain.PayoutSplit{
+		{Recipient: "0x2222222222222222222222222222222222222222", Bps: 7000},
+		{Recipient: "0x3333333333333333333333333333333333333333", Bps: 3000},
+	}
+}
+
+func TestValidatePayoutSplits(t *testing.T) {
+	tooMany := make([]domain.PayoutSplit, service.MaxPayoutSplits+1)
+	for i := range tooMany {
+		tooMany[i] = domain.PayoutSplit{Recipient: common.BigToAddress(common.Big1).Hex(), Bps: 1}
+	}
+
+	tests := []struct {
+		name   string
+		splits []domain.PayoutSplit
+		reason string
+	}{
+		{"single recipient", validSplits()[:1], "at least 2"},
+		{"too many recipients", tooMany, "too many recipients"},
+		{"bad address", []domain.PayoutSplit{{Recipient: "0x22", Bps: 5000}, {Recipient: "0x3333333333333333333333333333333333333333", Bps: 5000}}, "invalid recipient"},
+		{"zero address", []domain.PayoutSplit{{Recipient: "0x0000000000000000000000000000000000000000", Bps: 5000}, {Recipient: "0x3333333333333333333333333333333333333333", Bps: 5000}}, "zero address"},
+		{"duplicate recipient", []domain.PayoutSplit{{Recipient: "0x3333333333333333333333333333333333333333", Bps: 5000}, {Recipient: "0x3333333333333333333333333333333333333333", Bps: 5000}}, "duplicate recipient"},
+		{"zero share", []domain.PayoutSplit{{Recipient: "0x2222222222222222222222222222222222222222", Bps: 10000}, {Recipient: "0x3333333333333333333333333333333333333333", Bps: 0}}, "above 0"},
+		{"not 100%", []domain.PayoutSplit{{Recipient: "0x2222222222222222222222222222222222222222", Bps: 7000}, {Recipient: "0x3333333333333333333333333333333333333333", Bps: 2000}}, "add up to 9000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.ValidatePayoutSplits(tt.splits)
+			var validationErr *domain.ValidationError
+			require.True(t, errors.As(err, &validationErr), "got %v", err)
+			assert.Equal(t, "payoutSplits", validationErr.Field)
+			assert.Contains(t, validationErr.Reason, tt.reason)
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+
+	assert.NoError(t, service.ValidatePayoutSplits(validSplits()))
+}
+
+func TestValidateCreateCollectionInput_ChecksPayoutSplits(t *testing.T) {
+	in := collectionInput()
+	in.PayoutSplits = validSplits()[:1]
+	assert.ErrorIs(t, service.ValidateCreateCollectionInput(in), domain.ErrInvalidInput)
+
+	in.PayoutSplits = validSplits()
+	assert.NoError(t, service.ValidateCreateCollectionInput(in))
+}
+
+// withSplitsFactoryABI adds a createERC721CollectionWithSplits variant to the seeded factory ABI
+func withSplitsFactoryABI(t *testing.T, seed string) string {
+	t.Helper()
+	var doc struct {
+		ABI []map[string]interface{} `json:"abi"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(seed), &doc))
+	for _, entry := range doc.ABI {
+		if entry["name"] != "createERC721Collection" {
+			continue
+		}
+		variant := make(map[string]interface{}, len(entry))
+		for k, v := range entry {
+			variant[k] = v
+		}
+		variant["name"] = "createERC721CollectionWithSplits"
+		variant["inputs"] = append(append([]interface{}{}, entry["inputs"].([]interface{})...),
+			map[string]interface{}{"name": "recipients", "type": "address[]"},
+			map[string]interface{}{"name": "shares", "type": "uint256[]"},
+		)
+		doc.ABI = append(doc.ABI, variant)
+		break
+	}
+	out, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestEncodeCreateCollection_PayoutSplits(t *testing.T) {
+	factory := domain.Address("0x00000000000000000000000000000000000000fa")
+	in := collectionInput()
+	in.PayoutSplits = validSplits()
+
+	// The seeded factory deploys no splitter
+	_, _, _, _, err := encode.NewEncoder(newFakeAbiRegistry(t)).EncodeCreateCollection(context.Background(), "eip155:1", factory, in)
+	var validationErr *domain.ValidationError
+	require.True(t, errors.As(err, &validationErr), "got %v", err)
+	assert.Equal(t, "payoutSplits", validationErr.Field)
+
+	registry := newFakeAbiRegistry(t)
+	registry.abiJSON = withSplitsFactoryABI(t, registry.abiJSON)
+	_, withSplits, _, _, err := encode.NewEncoder(registry).EncodeCreateCollection(context.Background(), "eip155:1", factory, in)
+	require.NoError(t, err)
+	_, plain, _, _, err := encode.NewEncoder(registry).EncodeCreateCollection(context.Background(), "eip155:1", factory, collectionInput())
+	require.NoError(t, err)
+	assert.NotEqual(t, withSplits[:4], plain[:4], "splits use the WithSplits selector")
+}
+
+func TestPrepareSetPayoutSplits_CreatorAllowed(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(it *domain.Intent) bool {
+		return it.Kind == domain.IntentKindSetPayoutSplits && it.ReqPayloadJSON.(map[string]interface{})["method"] == "setPayoutSplits"
+	})).Return(nil)
+	mockStatusCache.On("SetIntentStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	svc := createAdminTestService(mockRepo, mockStatusCache, stubCreators{creator: adminCreator})
+
+	result, err := svc.PrepareSetPayoutSplits(context.Background(), domain.PrepareSetPayoutSplitsInput{
+		ChainID:  "eip155:1",
+		Contract: adminCollection,
+		UserID:   "creator-user",
+		Splits:   validSplits(),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.Address(adminCollection), result.Tx.To)
+	assert.NotEmpty(t, result.Tx.Data)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPrepareSetPayoutSplits_Rejected(t *testing.T) {
+	mockRepo := &MockRepo{}
+	svc := createAdminTestService(mockRepo, &MockStatusCache{}, stubCreators{creator: adminCreator})
+
+	// Splits not adding up to 100% are refused before any intent is stored
+	splits := validSplits()
+	splits[1].Bps = 2000
+	_, err := svc.PrepareSetPayoutSplits(context.Background(), domain.PrepareSetPayoutSplitsInput{
+		ChainID: "eip155:1", Contract: adminCollection, UserID: "creator-user", Splits: splits,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	// Only the collection's creator may change the split
+	_, err = svc.PrepareSetPayoutSplits(context.Background(), domain.PrepareSetPayoutSplitsInput{
+		ChainID: "eip155:1", Contract: adminCollection, UserID: "other-user", Splits: validSplits(),
+	})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,12 @@
+package test
+
+import (
+	"testing"
+
+	orchestratorpb "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/protocheck"
+)
+
+func TestProtoContract(t *testing.T) {
+	protocheck.Check(t, orchestratorpb.File_orchestrator_proto)
+}
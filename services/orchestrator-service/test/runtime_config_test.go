@@ -0,0 +1,63 @@
+package test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	runtimeconfig "github.com/quangdang46/NFT-Marketplace/shared/config"
+)
+
+func TestRuntimeConfig_IntentLimitsReloadFromFile(t *testing.T) {
+	svc, _, cache := limitedService(domain.IntentLimits{}, []domain.OpenIntent{
+		openIntent("i1", domain.IntentKindMint, "eip155:8453", ""),
+		openIntent("i2", domain.IntentKindMint, "eip155:8453", ""),
+	})
+	cache.On("GetIntentStatus", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+
+	path := filepath.Join(t.TempDir(), "runtime.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"intent_limits": {"max_open": 5}, "other": {}}`), 0o644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloader := runtimeconfig.NewReloader(runtimeconfig.NewFileSource(path, 10*time.Millisecond))
+	base := config.IntentLimitConfig{MaxOpenPerKind: 1}
+	runtimeconfig.OnChange(reloader, config.IntentLimitsSection, base, func(l config.IntentLimitConfig) {
+		svc.SetIntentLimits(domain.IntentLimits{MaxOpen: l.MaxOpen, MaxOpenPerKind: l.MaxOpenPerKind, MaxOpenPerChain: l.MaxOpenPerChain})
+	})
+	require.NoError(t, reloader.Start(ctx))
+
+	// Fields the section leaves out keep their boot values
+	_, err := svc.PrepareMint(ctx, limitMint())
+	assert.ErrorIs(t, err, domain.ErrIntentLimit)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"intent_limits": {"max_open": 10, "max_open_per_kind": 10}}`), 0o644))
+	require.Eventually(t, func() bool {
+		_, err := svc.PrepareMint(ctx, limitMint())
+		return err == nil
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestRuntimeConfig_InvalidSectionKeepsSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runtime.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"intent_limits": {"max_open": "many"}}`), 0o644))
+
+	reloader := runtimeconfig.NewReloader(runtimeconfig.NewFileSource(path, time.Hour))
+	applied := false
+	runtimeconfig.OnChange(reloader, config.IntentLimitsSection, config.IntentLimitConfig{}, func(config.IntentLimitConfig) {
+		applied = true
+	})
+	require.NoError(t, reloader.Start(context.Background()))
+	assert.False(t, applied)
+
+	require.NoError(t, os.WriteFile(path, []byte(`not json`), 0o644))
+	assert.Error(t, runtimeconfig.NewReloader(runtimeconfig.NewFileSource(path, time.Hour)).Start(context.Background()))
+}
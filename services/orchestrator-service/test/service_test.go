@@ -2,14 +2,18 @@ package test
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 )
 
@@ -33,6 +37,11 @@ func (m *MockRepo) UpdateStatus(ctx context.Context, intentID string, status dom
 	return args.Error(0)
 }
 
+func (m *MockRepo) TrackTx(ctx context.Context, intentID string, txHash string, contractAddr *domain.Address) (int64, error) {
+	args := m.Called(ctx, intentID, txHash, contractAddr)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockRepo) GetByID(ctx context.Context, intentID string) (*domain.Intent, error) {
 	args := m.Called(ctx, intentID)
 	if args.Get(0) == nil {
@@ -49,11 +58,61 @@ func (m *MockRepo) FindByChainTx(ctx context.Context, chainID domain.ChainID, tx
 	return args.Get(0).(*domain.Intent), args.Error(1)
 }
 
+func (m *MockRepo) ListBySigner(ctx context.Context, in domain.ListIntentsInput) ([]*domain.Intent, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Intent), args.Error(1)
+}
+
+func (m *MockRepo) ListAwaitingConfirmation(ctx context.Context, in domain.ListAwaitingInput) ([]*domain.Intent, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Intent), args.Error(1)
+}
+
+func (m *MockRepo) ListOpenIntents(ctx context.Context, owner string, since time.Time, limit int) ([]domain.OpenIntent, error) {
+	args := m.Called(ctx, owner, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.OpenIntent), args.Error(1)
+}
+
 func (m *MockRepo) InsertSessionIntentAudit(ctx context.Context, sessionID string, intentID string, createdBy *string, fields any) error {
 	args := m.Called(ctx, sessionID, intentID, createdBy, fields)
 	return args.Error(0)
 }
 
+func (m *MockRepo) ExpireUnsent(ctx context.Context, now time.Time, limit int) ([]*domain.Intent, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Intent), args.Error(1)
+}
+
+func (m *MockRepo) AttachMedia(ctx context.Context, intentID string, assetIDs []string) error {
+	args := m.Called(ctx, intentID, assetIDs)
+	return args.Error(0)
+}
+
+func (m *MockRepo) ListReleasableMedia(ctx context.Context, limit int) ([]domain.MediaRef, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.MediaRef), args.Error(1)
+}
+
+func (m *MockRepo) MarkMediaReleased(ctx context.Context, ref domain.MediaRef) error {
+	args := m.Called(ctx, ref)
+	return args.Error(0)
+}
+
 // Mock status cache for testing
 type MockStatusCache struct {
 	mock.Mock
@@ -64,6 +123,39 @@ func (m *MockStatusCache) SetIntentStatus(ctx context.Context, payload domain.In
 	return args.Error(0)
 }
 
+func (m *MockStatusCache) GetIntentStatus(ctx context.Context, intentID string) (*domain.IntentStatusPayload, error) {
+	args := m.Called(ctx, intentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.IntentStatusPayload), args.Error(1)
+}
+
+func (m *MockStatusCache) ReplaceIntentStatus(ctx context.Context, payload domain.IntentStatusPayload, ttl time.Duration) error {
+	args := m.Called(ctx, payload, ttl)
+	return args.Error(0)
+}
+
+func (m *MockStatusCache) WriteIntentStatus(ctx context.Context, payload domain.IntentStatusPayload, ttl time.Duration) error {
+	args := m.Called(ctx, payload, ttl)
+	return args.Error(0)
+}
+
+// MockIntentEvents records published intent events
+type MockIntentEvents struct {
+	mock.Mock
+}
+
+func (m *MockIntentEvents) PublishTxTracked(ctx context.Context, event domain.TxTracked) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockIntentEvents) PublishCollectionImported(ctx context.Context, event domain.ImportedCollection) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
 // Mock chain registry client for testing
 type MockChainRegistryClient struct {
 	mock.Mock
@@ -86,6 +178,10 @@ func (m *MockChainRegistryClient) GetRpcEndpoints(ctx context.Context, req *prot
 	return nil, nil
 }
 
+func (m *MockChainRegistryClient) GetChainCapabilities(ctx context.Context, req *protoChainRegistry.GetChainCapabilitiesRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetChainCapabilitiesResponse, error) {
+	return nil, nil
+}
+
 func (m *MockChainRegistryClient) GetContractMeta(ctx context.Context, req *protoChainRegistry.GetContractMetaRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetContractMetaResponse, error) {
 	return nil, nil
 }
@@ -102,6 +198,50 @@ func (m *MockChainRegistryClient) BumpVersion(ctx context.Context, req *protoCha
 	return nil, nil
 }
 
+func (m *MockChainRegistryClient) UpdateContractAbi(ctx context.Context, req *protoChainRegistry.UpdateContractAbiRequest, opts ...grpc.CallOption) (*protoChainRegistry.UpdateContractAbiResponse, error) {
+	return nil, nil
+}
+
+func (m *MockChainRegistryClient) RegisterCollection(ctx context.Context, req *protoChainRegistry.RegisterCollectionRequest, opts ...grpc.CallOption) (*protoChainRegistry.RegisterCollectionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoChainRegistry.RegisterCollectionResponse), args.Error(1)
+}
+
+func (m *MockChainRegistryClient) SetMintFunction(ctx context.Context, req *protoChainRegistry.SetMintFunctionRequest, opts ...grpc.CallOption) (*protoChainRegistry.SetMintFunctionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoChainRegistry.SetMintFunctionResponse), args.Error(1)
+}
+
+func (m *MockChainRegistryClient) GetContractByRole(ctx context.Context, req *protoChainRegistry.GetContractByRoleRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetContractMetaResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoChainRegistry.GetContractMetaResponse), args.Error(1)
+}
+
+func (m *MockChainRegistryClient) SetContractRoles(ctx context.Context, req *protoChainRegistry.SetContractRolesRequest, opts ...grpc.CallOption) (*protoChainRegistry.SetContractRolesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoChainRegistry.SetContractRolesResponse), args.Error(1)
+}
+
+func (m *MockChainRegistryClient) GetChainHead(ctx context.Context, req *protoChainRegistry.GetChainHeadRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetChainHeadResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*protoChainRegistry.GetChainHeadResponse), args.Error(1)
+}
+
 func (m *MockChainRegistryClient) GetAbiByAddress(ctx context.Context, req *protoChainRegistry.GetAbiByAddressRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetAbiBlobResponse, error) {
 	return nil, nil
 }
@@ -124,6 +264,26 @@ func (m *MockEncoder) EncodeMint(ctx context.Context, chainID domain.ChainID, co
 	return to, data, value, nil
 }
 
+func (m *MockEncoder) EncodeCollectionAdmin(ctx context.Context, chainID domain.ChainID, contract domain.Address, method string, args ...interface{}) (domain.Address, []byte, string, error) {
+	return contract, []byte{0x03}, "0", nil
+}
+
+func (m *MockEncoder) EncodeAuction(ctx context.Context, chainID domain.ChainID, auctionHouse domain.Address, method string, value string, args ...interface{}) (domain.Address, []byte, error) {
+	return auctionHouse, []byte{0x04}, nil
+}
+
+func (m *MockEncoder) EncodeAirdrop(ctx context.Context, chainID domain.ChainID, contract domain.Address, standard domain.Standard, tokenID *big.Int, recipients []domain.AirdropRecipient) (domain.Address, []byte, error) {
+	return contract, []byte{0x05}, nil
+}
+
+func (m *MockEncoder) EncodeTokenTransfer(ctx context.Context, chainID domain.ChainID, contract domain.Address, standard domain.Standard, from, to domain.Address, tokens []domain.BulkTransferToken) (domain.Address, []byte, error) {
+	return contract, []byte{0x06}, nil
+}
+
+func (m *MockEncoder) EncodeOperatorApproval(ctx context.Context, chainID domain.ChainID, contract, owner, operator domain.Address) ([]byte, []byte, error) {
+	return []byte{0x07}, []byte{0x08}, nil
+}
+
 // Helper function to create service with mocked dependencies
 func createTestService(mockRepo *MockRepo, mockStatusCache *MockStatusCache, mockChainRegistry *MockChainRegistryClient) domain.OrchestratorService {
 	encoder := &MockEncoder{}
@@ -152,6 +312,7 @@ func TestPrepareCreateCollection(t *testing.T) {
 	factoryContract := &protoChainRegistry.Contract{
 		Name:    "ERC721CollectionFactory",
 		Address: "0x1234567890123456789012345678901234567890",
+		Roles:   []string{contracts.RoleERC721Factory, contracts.RoleFactory},
 	}
 	chainRegistryResp := &protoChainRegistry.GetContractsResponse{
 		ChainId:   "eip155:8453",
@@ -212,6 +373,46 @@ func TestPrepareMint(t *testing.T) {
 	mockStatusCache.AssertExpectations(t)
 }
 
+func TestTrackTx_AnnouncesCollectionIntent(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	mockEvents := &MockIntentEvents{}
+
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+	svc.(*service.Service).SetIntentEvents(mockEvents)
+
+	ctx := context.Background()
+	userID := "user-1"
+	preview := domain.Address("0x00000000000000000000000000000000000000c1")
+	input := domain.TrackTxInput{
+		IntentID: "collection-intent",
+		ChainID:  "eip155:8453",
+		TxHash:   "0xabc",
+	}
+
+	mockRepo.On("GetByID", ctx, "collection-intent").Return(&domain.Intent{
+		ID:             "collection-intent",
+		Kind:           domain.IntentKindCollection,
+		CreatedBy:      &userID,
+		PreviewAddress: &preview,
+	}, nil)
+	mockRepo.On("FindByChainTx", ctx, "eip155:8453", "0xabc").Return(nil, domain.ErrNotFound)
+	mockRepo.On("TrackTx", ctx, "collection-intent", "0xabc", (*domain.Address)(nil)).Return(int64(1), nil)
+	mockStatusCache.On("WriteIntentStatus", ctx, mock.Anything, domain.DefaultIntentTTL).Return(nil)
+	mockEvents.On("PublishTxTracked", ctx, mock.MatchedBy(func(e domain.TxTracked) bool {
+		return e.IntentID == "collection-intent" &&
+			e.CreatedBy == "user-1" &&
+			e.TxHash == "0xabc" &&
+			e.Contract != nil && *e.Contract == preview
+	})).Return(nil)
+
+	ok, err := svc.TrackTx(ctx, input)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	mockEvents.AssertExpectations(t)
+}
+
 func TestTrackTx(t *testing.T) {
 	// Arrange
 	mockRepo := &MockRepo{}
@@ -236,9 +437,10 @@ func TestTrackTx(t *testing.T) {
 	// Mock expectations
 	mockRepo.On("GetByID", ctx, "test-intent-id").Return(existingIntent, nil)
 	mockRepo.On("FindByChainTx", ctx, "eip155:8453", input.TxHash).Return(nil, domain.ErrNotFound)
-	mockRepo.On("UpdateTxHash", ctx, "test-intent-id", input.TxHash, (*domain.Address)(nil)).Return(nil)
-	mockRepo.On("UpdateStatus", ctx, "test-intent-id", domain.IntentPending, (*string)(nil)).Return(nil)
-	mockStatusCache.On("SetIntentStatus", ctx, mock.AnythingOfType("domain.IntentStatusPayload"), domain.DefaultIntentTTL).Return(nil)
+	mockRepo.On("TrackTx", ctx, "test-intent-id", input.TxHash, (*domain.Address)(nil)).Return(int64(3), nil)
+	mockStatusCache.On("WriteIntentStatus", ctx, mock.MatchedBy(func(p domain.IntentStatusPayload) bool {
+		return p.Status == domain.IntentPending && *p.TxHash == input.TxHash && p.Seq == 3
+	}), domain.DefaultIntentTTL).Return(nil)
 
 	// Act
 	ok, err := svc.TrackTx(ctx, input)
@@ -275,6 +477,7 @@ func TestPrepareCreateCollectionWithType(t *testing.T) {
 			{
 				Name:    "ERC721CollectionFactory",
 				Address: "0xabcdef1234567890abcdef1234567890abcdef12",
+				Roles:   []string{contracts.RoleERC721Factory, contracts.RoleFactory},
 			},
 		},
 	}, nil)
@@ -320,6 +523,7 @@ func TestPrepareCreateCollectionWithERC1155Type(t *testing.T) {
 			{
 				Name:    "ERC1155CollectionFactory",
 				Address: "0xabcdef1234567890abcdef1234567890abcdef12",
+				Roles:   []string{contracts.RoleERC1155Factory, contracts.RoleFactory},
 			},
 		},
 	}, nil)
@@ -340,3 +544,303 @@ func TestPrepareCreateCollectionWithERC1155Type(t *testing.T) {
 	mockStatusCache.AssertExpectations(t)
 	mockChainRegistry.AssertExpectations(t)
 }
+
+func TestPrepareCreateCollection_FactoryByRole(t *testing.T) {
+	ctx := context.Background()
+	input := domain.PrepareCreateCollectionInput{
+		ChainID:  "eip155:1",
+		Name:     "Test Collection",
+		Symbol:   "TEST",
+		Creator:  "0x1234567890123456789012345678901234567890",
+		TokenURI: "https://example.com/metadata",
+		Type:     domain.StdERC721,
+	}
+	registryWith := func(list ...*protoChainRegistry.Contract) *MockChainRegistryClient {
+		registry := new(MockChainRegistryClient)
+		registry.On("GetContracts", ctx, mock.Anything).Return(&protoChainRegistry.GetContractsResponse{Contracts: list}, nil)
+		return registry
+	}
+
+	t.Run("uses the contract holding the role whatever its name", func(t *testing.T) {
+		mockRepo := new(MockRepo)
+		mockStatusCache := new(MockStatusCache)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(it *domain.Intent) bool {
+			payload, ok := it.ReqPayloadJSON.(map[string]interface{})
+			return ok && payload["factoryAddress"] == domain.Address("0xabcdef1234567890abcdef1234567890abcdef12")
+		})).Return(nil)
+		mockRepo.On("UpdateTxHash", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockStatusCache.On("SetIntentStatus", ctx, mock.Anything, mock.Anything).Return(nil)
+		svc := createTestService(mockRepo, mockStatusCache, registryWith(
+			&protoChainRegistry.Contract{Name: "ERC721CollectionFactory", Address: "0x1234567890123456789012345678901234567890"},
+			&protoChainRegistry.Contract{Name: "CollectionFactoryV2", Address: "0xabcdef1234567890abcdef1234567890abcdef12", Roles: []string{contracts.RoleERC721Factory, contracts.RoleFactory}},
+		))
+
+		_, err := svc.PrepareCreateCollection(ctx, input)
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects a role held by several contracts", func(t *testing.T) {
+		svc := createTestService(new(MockRepo), new(MockStatusCache), registryWith(
+			&protoChainRegistry.Contract{Name: "FactoryA", Address: "0x1234567890123456789012345678901234567890", Roles: []string{contracts.RoleERC721Factory}},
+			&protoChainRegistry.Contract{Name: "FactoryB", Address: "0xabcdef1234567890abcdef1234567890abcdef12", Roles: []string{contracts.RoleERC721Factory}},
+		))
+
+		_, err := svc.PrepareCreateCollection(ctx, input)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetIntentStatus_PrefersCache(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	txHash := "0xabc"
+	mockRepo.On("GetByID", ctx, "cached-intent").Return(&domain.Intent{
+		ID:        "cached-intent",
+		Kind:      domain.IntentKindCollection,
+		Status:    domain.IntentPending,
+		TxHash:    &txHash,
+		StatusSeq: 2,
+	}, nil)
+	mockStatusCache.On("GetIntentStatus", ctx, "cached-intent").Return(&domain.IntentStatusPayload{
+		IntentID: "cached-intent",
+		Kind:     domain.IntentKindCollection,
+		Status:   domain.IntentStatus("ready"),
+		TxHash:   &txHash,
+		Seq:      2,
+	}, nil)
+
+	result, err := svc.GetIntentStatus(ctx, "cached-intent")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.IntentStatus("ready"), result.Status)
+	assert.Equal(t, &txHash, result.TxHash)
+}
+
+func TestGetIntentStatus_IgnoresCacheBehindStoredIntent(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	txHash := "0xdef"
+	errMsg := "stalled past its deadline"
+	mockRepo.On("GetByID", ctx, "tracked").Return(&domain.Intent{
+		ID:        "tracked",
+		Kind:      domain.IntentKindMint,
+		ChainID:   "eip155:8453",
+		Status:    domain.IntentFailed,
+		TxHash:    &txHash,
+		Error:     &errMsg,
+		StatusSeq: 3,
+	}, nil)
+	// Written through at seq 2; the write for the stored change at seq 3 never landed
+	mockStatusCache.On("GetIntentStatus", ctx, "tracked").Return(&domain.IntentStatusPayload{
+		IntentID: "tracked",
+		Kind:     domain.IntentKindMint,
+		Status:   domain.IntentPending,
+		TxHash:   &txHash,
+		Seq:      2,
+	}, nil)
+
+	result, err := svc.GetIntentStatus(ctx, "tracked")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.IntentFailed, result.Status)
+	assert.Equal(t, &errMsg, result.Error)
+	assert.Equal(t, int64(3), result.Seq)
+}
+
+func TestGetIntentStatus_IgnoresSeqlessCacheBehindStoredIntent(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	txHash := "0xdef"
+	mockRepo.On("GetByID", ctx, "tracked").Return(&domain.Intent{
+		ID:        "tracked",
+		Kind:      domain.IntentKindMint,
+		ChainID:   "eip155:8453",
+		Status:    domain.IntentPending,
+		TxHash:    &txHash,
+		StatusSeq: 3,
+	}, nil)
+	// Cached at preparation; TrackTx's write-through never landed
+	mockStatusCache.On("GetIntentStatus", ctx, "tracked").Return(&domain.IntentStatusPayload{
+		IntentID: "tracked",
+		Kind:     domain.IntentKindMint,
+		Status:   domain.IntentPending,
+	}, nil)
+
+	result, err := svc.GetIntentStatus(ctx, "tracked")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &txHash, result.TxHash)
+	assert.Equal(t, int64(3), result.Seq)
+}
+
+func TestGetIntentStatus_KeepsSeqlessCacheWhenCurrent(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	mockRepo.On("GetByID", ctx, "prepared").Return(&domain.Intent{
+		ID:      "prepared",
+		Kind:    domain.IntentKindBid,
+		ChainID: "eip155:8453",
+		Status:  domain.IntentPending,
+	}, nil)
+	cachedAt := time.Now()
+	mockStatusCache.On("GetIntentStatus", ctx, "prepared").Return(&domain.IntentStatusPayload{
+		IntentID:  "prepared",
+		Kind:      domain.IntentKindBid,
+		Status:    domain.IntentPending,
+		UpdatedAt: cachedAt,
+	}, nil)
+
+	result, err := svc.GetIntentStatus(ctx, "prepared")
+
+	assert.NoError(t, err)
+	assert.Equal(t, cachedAt, result.UpdatedAt, "the cached status is kept when nothing was stored since")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetIntentStatus_FallsBackWhenCacheUnavailable(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	mockStatusCache.On("GetIntentStatus", ctx, "stored-intent").Return(nil, errors.New("redis down"))
+	mockRepo.On("GetByID", ctx, "stored-intent").Return(&domain.Intent{
+		ID:        "stored-intent",
+		Kind:      domain.IntentKindMint,
+		ChainID:   "eip155:8453",
+		Status:    domain.IntentExpired,
+		StatusSeq: 1,
+	}, nil)
+
+	result, err := svc.GetIntentStatus(ctx, "stored-intent")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.IntentExpired, result.Status)
+	assert.Equal(t, int64(1), result.Seq)
+}
+
+func TestTrackTx_RetryRepairsStaleCache(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	txHash := "0xabc"
+	mockRepo.On("GetByID", ctx, "tracked").Return(&domain.Intent{
+		ID:        "tracked",
+		Kind:      domain.IntentKindMint,
+		ChainID:   "eip155:8453",
+		Status:    domain.IntentPending,
+		TxHash:    &txHash,
+		StatusSeq: 4,
+	}, nil)
+	mockStatusCache.On("GetIntentStatus", ctx, "tracked").Return(nil, domain.ErrNotFound)
+	mockStatusCache.On("WriteIntentStatus", ctx, mock.MatchedBy(func(p domain.IntentStatusPayload) bool {
+		return p.Seq == 4 && *p.TxHash == txHash
+	}), domain.DefaultIntentTTL).Return(nil)
+
+	ok, err := svc.TrackTx(ctx, domain.TrackTxInput{IntentID: "tracked", ChainID: "eip155:8453", TxHash: txHash})
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	mockRepo.AssertNotCalled(t, "TrackTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockStatusCache.AssertExpectations(t)
+}
+
+func TestTrackTx_FailsWhenStatusIsNotWritten(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	mockRepo.On("GetByID", ctx, "intent").Return(&domain.Intent{ID: "intent", Kind: domain.IntentKindMint, Status: domain.IntentPending}, nil)
+	mockRepo.On("FindByChainTx", ctx, "eip155:8453", "0xabc").Return(nil, domain.ErrNotFound)
+	mockRepo.On("TrackTx", ctx, "intent", "0xabc", (*domain.Address)(nil)).Return(int64(1), nil)
+	mockStatusCache.On("WriteIntentStatus", ctx, mock.Anything, domain.DefaultIntentTTL).Return(errors.New("redis down"))
+
+	ok, err := svc.TrackTx(ctx, domain.TrackTxInput{IntentID: "intent", ChainID: "eip155:8453", TxHash: "0xabc"})
+
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
+
+func TestGetIntentStatus_FallsBackToRepository(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	mockStatusCache.On("GetIntentStatus", ctx, "stored-intent").Return(nil, domain.ErrNotFound)
+	mockRepo.On("GetByID", ctx, "stored-intent").Return(&domain.Intent{
+		ID:      "stored-intent",
+		Kind:    domain.IntentKindMint,
+		ChainID: "eip155:8453",
+		Status:  domain.IntentPending,
+	}, nil)
+
+	result, err := svc.GetIntentStatus(ctx, "stored-intent")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.IntentPending, result.Status)
+	assert.Equal(t, "eip155:8453", *result.ChainID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListIntents_OverlaysCachedStatus(t *testing.T) {
+	mockRepo := &MockRepo{}
+	mockStatusCache := &MockStatusCache{}
+	svc := createTestService(mockRepo, mockStatusCache, &MockChainRegistryClient{})
+
+	ctx := context.Background()
+	signer := "0x1234567890123456789012345678901234567890"
+	txHash := "0xconfirmed"
+	mockRepo.On("ListBySigner", ctx, domain.ListIntentsInput{Signer: signer, Limit: 20}).Return([]*domain.Intent{
+		{ID: "confirmed", Kind: domain.IntentKindMint, Status: domain.IntentPending},
+		{ID: "prepared", Kind: domain.IntentKindBid, Status: domain.IntentPending},
+	}, nil)
+	mockStatusCache.On("GetIntentStatus", ctx, "confirmed").Return(&domain.IntentStatusPayload{
+		IntentID: "confirmed",
+		Status:   domain.IntentReady,
+		TxHash:   &txHash,
+	}, nil)
+	mockStatusCache.On("GetIntentStatus", ctx, "prepared").Return(nil, domain.ErrNotFound)
+
+	intents, err := svc.ListIntents(ctx, domain.ListIntentsInput{Signer: signer})
+
+	require.NoError(t, err)
+	require.Len(t, intents, 2)
+	assert.Equal(t, domain.IntentReady, intents[0].Status)
+	assert.Equal(t, &txHash, intents[0].TxHash)
+	assert.Equal(t, domain.IntentPending, intents[1].Status)
+}
+
+func TestListIntents_InvalidInput(t *testing.T) {
+	svc := createTestService(&MockRepo{}, &MockStatusCache{}, &MockChainRegistryClient{})
+	ctx := context.Background()
+	before := time.Now()
+
+	_, err := svc.ListIntents(ctx, domain.ListIntentsInput{Signer: "not-an-address"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.ListIntents(ctx, domain.ListIntentsInput{
+		Signer:   "0x1234567890123456789012345678901234567890",
+		Before:   &before,
+		BeforeID: "not-a-uuid",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
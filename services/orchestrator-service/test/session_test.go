@@ -0,0 +1,171 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/infrastructure/clients"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+const (
+	sessionUserID = "11111111-1111-1111-1111-111111111111"
+	sessionID     = "550e8400-e29b-41d4-a716-446655440000"
+)
+
+// stubSessions answers session checks, optionally blocking until the caller gives up
+type stubSessions struct {
+	active bool
+	block  bool
+	calls  int
+}
+
+func (s *stubSessions) ValidateSession(ctx context.Context, userID, sessionID string) (bool, error) {
+	s.calls++
+	if s.block {
+		<-ctx.Done()
+		return false, ctx.Err()
+	}
+	return s.active, nil
+}
+
+func sessionService(sessions domain.SessionValidator) (*service.Service, *MockRepo) {
+	repo := &MockRepo{}
+	cache := &MockStatusCache{}
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Intent")).Return(nil).Maybe()
+	repo.On("InsertSessionIntentAudit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	cache.On("SetIntentStatus", mock.Anything, mock.Anything, domain.DefaultIntentTTL).Return(nil).Maybe()
+
+	svc := createTestService(repo, cache, &MockChainRegistryClient{}).(*service.Service)
+	svc.SetSessionLinkedIntents(true, 20*time.Millisecond)
+	if sessions != nil {
+		svc.SetSessionValidator(sessions)
+	}
+	return svc, repo
+}
+
+func sessionMint() domain.PrepareMintInput {
+	in := limitMint()
+	userID := sessionUserID
+	in.CreatedBy = &userID
+	return in
+}
+
+func withSession(id string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-auth-session-id", id))
+}
+
+func TestPrepareMint_LinksActiveSession(t *testing.T) {
+	sessions := &stubSessions{active: true}
+	svc, repo := sessionService(sessions)
+
+	_, err := svc.PrepareMint(withSession(sessionID), sessionMint())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, sessions.calls)
+	repo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(it *domain.Intent) bool {
+		return it.AuthSessionID != nil && *it.AuthSessionID == sessionID
+	}))
+	repo.AssertCalled(t, "InsertSessionIntentAudit", mock.Anything, sessionID, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPrepareMint_RejectsInactiveSession(t *testing.T) {
+	svc, repo := sessionService(&stubSessions{active: false})
+
+	_, err := svc.PrepareMint(withSession(sessionID), sessionMint())
+
+	assert.ErrorIs(t, err, domain.ErrUnauthenticated)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPrepareMint_SessionNeedsCreator(t *testing.T) {
+	svc, repo := sessionService(&stubSessions{active: true})
+
+	_, err := svc.PrepareMint(withSession(sessionID), limitMint())
+	assert.ErrorIs(t, err, domain.ErrUnauthenticated)
+
+	_, err = svc.PrepareMint(withSession("not-a-session"), sessionMint())
+	assert.ErrorIs(t, err, domain.ErrUnauthenticated)
+
+	_, err = svc.PrepareMint(context.Background(), sessionMint())
+	assert.ErrorIs(t, err, domain.ErrUnauthenticated)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPrepareMint_SlowAuthServiceDegrades(t *testing.T) {
+	svc, repo := sessionService(&stubSessions{block: true})
+
+	start := time.Now()
+	_, err := svc.PrepareMint(withSession(sessionID), sessionMint())
+
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	repo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(it *domain.Intent) bool {
+		return it.AuthSessionID != nil && *it.AuthSessionID == sessionID
+	}))
+}
+
+// stubAuthClient answers ValidateSession; the rest of the client is unused
+type stubAuthClient struct {
+	authpb.AuthServiceClient
+	active bool
+	err    error
+	calls  int
+}
+
+func (c *stubAuthClient) ValidateSession(ctx context.Context, in *authpb.ValidateSessionRequest, opts ...grpc.CallOption) (*authpb.ValidateSessionResponse, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &authpb.ValidateSessionResponse{Active: c.active}, nil
+}
+
+func TestAuthSessions_CachesAnswers(t *testing.T) {
+	ctx := context.Background()
+	client := &stubAuthClient{active: true}
+	sessions := clients.NewAuthSessions(client, time.Minute)
+
+	for range 3 {
+		active, err := sessions.ValidateSession(ctx, sessionUserID, sessionID)
+		require.NoError(t, err)
+		assert.True(t, active)
+	}
+	assert.Equal(t, 1, client.calls)
+
+	// Answers are per user
+	client.active = false
+	active, err := sessions.ValidateSession(ctx, "someone-else", sessionID)
+	require.NoError(t, err)
+	assert.False(t, active)
+	assert.Equal(t, 2, client.calls)
+}
+
+func TestAuthSessions_StaleAnswerCoversOutage(t *testing.T) {
+	ctx := context.Background()
+	client := &stubAuthClient{active: true}
+	sessions := clients.NewAuthSessions(client, time.Millisecond)
+
+	_, err := sessions.ValidateSession(ctx, sessionUserID, sessionID)
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	client.err = errors.New("unavailable")
+	active, err := sessions.ValidateSession(ctx, sessionUserID, sessionID)
+	require.NoError(t, err)
+	assert.True(t, active)
+	assert.Equal(t, 2, client.calls)
+
+	_, err = sessions.ValidateSession(ctx, sessionUserID, "550e8400-e29b-41d4-a716-446655440009")
+	assert.Error(t, err)
+}
@@ -0,0 +1,319 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+)
+
+const sponsoredChain = "eip155:8453"
+
+// memorySponsorCounters counts the day's gas per collection and user and the gas live
+// grants hold per chain, as the Redis counters do
+type memorySponsorCounters struct {
+	day    map[string]int64
+	grants map[string]*domain.SponsorshipGrant
+}
+
+func newMemorySponsorCounters() *memorySponsorCounters {
+	return &memorySponsorCounters{day: map[string]int64{}, grants: map[string]*domain.SponsorshipGrant{}}
+}
+
+func (c *memorySponsorCounters) HoldGrant(ctx context.Context, grant *domain.SponsorshipGrant, caps domain.SponsorshipCaps, balanceGwei int64) (*domain.SponsorshipGrant, domain.SponsorshipLimit, error) {
+	for _, g := range c.grants {
+		if g.IntentID == grant.IntentID {
+			return g, domain.SponsorshipWithinLimits, nil
+		}
+	}
+	collection, user := "c:"+grant.Collection, "u:"+grant.UserID
+	if caps.CollectionDailyGwei > 0 && c.day[collection]+grant.GasGwei > caps.CollectionDailyGwei {
+		return nil, domain.SponsorshipCollectionCap, nil
+	}
+	if caps.UserDailyGwei > 0 && c.day[user]+grant.GasGwei > caps.UserDailyGwei {
+		return nil, domain.SponsorshipUserCap, nil
+	}
+	var held int64
+	for _, g := range c.grants {
+		if g.ChainID == grant.ChainID {
+			held += g.GasGwei
+		}
+	}
+	if held+grant.GasGwei > balanceGwei {
+		return nil, domain.SponsorshipChainBudget, nil
+	}
+	c.day[collection] += grant.GasGwei
+	c.day[user] += grant.GasGwei
+	c.grants[grant.ID] = grant
+	return grant, domain.SponsorshipWithinLimits, nil
+}
+
+func (c *memorySponsorCounters) GetGrant(ctx context.Context, id string) (*domain.SponsorshipGrant, error) {
+	grant, ok := c.grants[id]
+	if !ok {
+		return nil, domain.ErrGrantNotFound
+	}
+	return grant, nil
+}
+
+func (c *memorySponsorCounters) ReleaseGrant(ctx context.Context, grant *domain.SponsorshipGrant, spentGwei int64) error {
+	if _, ok := c.grants[grant.ID]; !ok {
+		return nil
+	}
+	delete(c.grants, grant.ID)
+	c.day["c:"+grant.Collection] += spentGwei - grant.GasGwei
+	c.day["u:"+grant.UserID] += spentGwei - grant.GasGwei
+	return nil
+}
+
+// memorySponsorLedger keeps budgets and the grants settled against them
+type memorySponsorLedger struct {
+	budgets map[domain.ChainID]*domain.SponsorshipBudget
+	settled map[string]int64
+}
+
+func newMemorySponsorLedger() *memorySponsorLedger {
+	return &memorySponsorLedger{budgets: map[domain.ChainID]*domain.SponsorshipBudget{}, settled: map[string]int64{}}
+}
+
+func (l *memorySponsorLedger) budget(chainID domain.ChainID) *domain.SponsorshipBudget {
+	if l.budgets[chainID] == nil {
+		l.budgets[chainID] = &domain.SponsorshipBudget{ChainID: chainID}
+	}
+	return l.budgets[chainID]
+}
+
+func (l *memorySponsorLedger) GetBudget(ctx context.Context, chainID domain.ChainID) (*domain.SponsorshipBudget, error) {
+	budget := *l.budget(chainID)
+	return &budget, nil
+}
+
+func (l *memorySponsorLedger) ListBudgets(ctx context.Context) ([]*domain.SponsorshipBudget, error) {
+	budgets := []*domain.SponsorshipBudget{}
+	for _, b := range l.budgets {
+		budgets = append(budgets, b)
+	}
+	return budgets, nil
+}
+
+func (l *memorySponsorLedger) TopUpBudget(ctx context.Context, chainID domain.ChainID, amountGwei int64, note, actorID string, at time.Time) (*domain.SponsorshipBudget, error) {
+	b := l.budget(chainID)
+	b.BalanceGwei += amountGwei
+	b.ToppedUpGwei += amountGwei
+	b.UpdatedBy, b.UpdatedAt = actorID, at
+	return b, nil
+}
+
+func (l *memorySponsorLedger) SetBudgetFrozen(ctx context.Context, chainID domain.ChainID, frozen bool, reason, actorID string, at time.Time) (*domain.SponsorshipBudget, error) {
+	b := l.budget(chainID)
+	b.Frozen, b.FrozenReason = frozen, reason
+	b.UpdatedBy, b.UpdatedAt = actorID, at
+	return b, nil
+}
+
+func (l *memorySponsorLedger) SettleGrant(ctx context.Context, grant *domain.SponsorshipGrant, spentGwei int64, txHash string, at time.Time) (bool, error) {
+	if _, ok := l.settled[grant.ID]; ok {
+		return false, nil
+	}
+	l.settled[grant.ID] = spentGwei
+	b := l.budget(grant.ChainID)
+	b.BalanceGwei -= spentGwei
+	b.SpentGwei += spentGwei
+	return true, nil
+}
+
+// sponsoredMint is a pending mint intent of the user on the sponsored chain
+func sponsoredMint(id, user string) *domain.Intent {
+	in := limitMint()
+	in.CreatedBy = &user
+	return &domain.Intent{ID: id, Kind: domain.IntentKindMint, ChainID: sponsoredChain, Status: domain.IntentPending, CreatedBy: &user, ReqPayloadJSON: in}
+}
+
+func sponsorshipService(t *testing.T, balanceGwei int64, caps domain.SponsorshipCaps, intents ...*domain.Intent) (*service.Service, *memorySponsorCounters, *memorySponsorLedger) {
+	repo := &MockRepo{}
+	cache := &MockStatusCache{}
+	for _, intent := range intents {
+		repo.On("GetByID", mock.Anything, intent.ID).Return(intent, nil)
+	}
+	cache.On("GetIntentStatus", mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+
+	counters, ledger := newMemorySponsorCounters(), newMemorySponsorLedger()
+	svc := createTestService(repo, cache, &MockChainRegistryClient{}).(*service.Service)
+	svc.SetSponsorship(counters, ledger, domain.SponsorshipPolicy{Caps: caps})
+	if balanceGwei > 0 {
+		_, err := svc.TopUpSponsorshipBudget(context.Background(), sponsoredChain, balanceGwei, "launch", "admin-1")
+		require.NoError(t, err)
+	}
+	return svc, counters, ledger
+}
+
+func TestReserveSponsorship_HoldsBudgetForMint(t *testing.T) {
+	svc, counters, _ := sponsorshipService(t, 1000, domain.SponsorshipCaps{}, sponsoredMint("mint-1", "user-1"))
+
+	grant, err := svc.ReserveSponsorship(context.Background(), "mint-1", 400)
+
+	require.NoError(t, err)
+	assert.Equal(t, "mint-1", grant.IntentID)
+	assert.Equal(t, "0x1234567890123456789012345678901234567890", grant.Collection)
+	assert.Equal(t, "user-1", grant.UserID)
+	assert.Equal(t, time.Now().UTC().Format(time.DateOnly), grant.Day)
+	assert.Contains(t, counters.grants, grant.ID)
+}
+
+func TestReserveSponsorship_ReturnsTheIntentsLiveGrant(t *testing.T) {
+	svc, counters, _ := sponsorshipService(t, 1000, domain.SponsorshipCaps{}, sponsoredMint("mint-1", "user-1"))
+	ctx := context.Background()
+
+	first, err := svc.ReserveSponsorship(ctx, "mint-1", 600)
+	require.NoError(t, err)
+	again, err := svc.ReserveSponsorship(ctx, "mint-1", 600)
+
+	require.NoError(t, err, "a retry must not be refused for the budget its first grant holds")
+	assert.Equal(t, first.ID, again.ID)
+	assert.Len(t, counters.grants, 1)
+	assert.Equal(t, int64(600), counters.day["c:"+first.Collection])
+
+	require.NoError(t, svc.ReleaseSponsorship(ctx, first.ID))
+	next, err := svc.ReserveSponsorship(ctx, "mint-1", 600)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, next.ID, "a released grant no longer answers for the intent")
+}
+
+func TestReserveSponsorship_CutsOffAtDailyCaps(t *testing.T) {
+	svc, _, _ := sponsorshipService(t, 10000, domain.SponsorshipCaps{CollectionDailyGwei: 500, UserDailyGwei: 300},
+		sponsoredMint("mint-1", "user-1"), sponsoredMint("mint-2", "user-1"), sponsoredMint("mint-3", "user-2"), sponsoredMint("mint-4", "user-3"))
+	ctx := context.Background()
+
+	_, err := svc.ReserveSponsorship(ctx, "mint-1", 200)
+	require.NoError(t, err)
+
+	_, err = svc.ReserveSponsorship(ctx, "mint-2", 200)
+	assert.ErrorIs(t, err, domain.ErrSponsorshipCap)
+	assert.ErrorContains(t, err, "daily sponsorship cap of 300 gwei")
+
+	_, err = svc.ReserveSponsorship(ctx, "mint-3", 250)
+	require.NoError(t, err)
+
+	_, err = svc.ReserveSponsorship(ctx, "mint-4", 100)
+	assert.ErrorIs(t, err, domain.ErrSponsorshipCap)
+	assert.ErrorContains(t, err, "collection 0x1234567890123456789012345678901234567890 reached its daily sponsorship cap of 500 gwei")
+}
+
+func TestReserveSponsorship_CutsOffOnceBudgetIsHeld(t *testing.T) {
+	svc, _, _ := sponsorshipService(t, 1000, domain.SponsorshipCaps{},
+		sponsoredMint("mint-1", "user-1"), sponsoredMint("mint-2", "user-2"))
+	ctx := context.Background()
+
+	_, err := svc.ReserveSponsorship(ctx, "mint-1", 800)
+	require.NoError(t, err)
+
+	_, err = svc.ReserveSponsorship(ctx, "mint-2", 300)
+	assert.ErrorIs(t, err, domain.ErrSponsorshipBudget)
+}
+
+func TestReserveSponsorship_RefusesFrozenBudget(t *testing.T) {
+	svc, counters, _ := sponsorshipService(t, 1000, domain.SponsorshipCaps{}, sponsoredMint("mint-1", "user-1"))
+	ctx := context.Background()
+
+	_, err := svc.SetSponsorshipBudgetFrozen(ctx, sponsoredChain, true, "gas spike", "admin-1")
+	require.NoError(t, err)
+
+	_, err = svc.ReserveSponsorship(ctx, "mint-1", 100)
+	assert.ErrorIs(t, err, domain.ErrSponsorshipFrozen)
+	assert.ErrorContains(t, err, "gas spike")
+	assert.Empty(t, counters.grants)
+
+	_, err = svc.SetSponsorshipBudgetFrozen(ctx, sponsoredChain, false, "", "admin-1")
+	require.NoError(t, err)
+	_, err = svc.ReserveSponsorship(ctx, "mint-1", 100)
+	assert.NoError(t, err)
+}
+
+func TestReserveSponsorship_OnlyPendingMints(t *testing.T) {
+	collection := &domain.Intent{ID: "collection-1", Kind: domain.IntentKindCollection, ChainID: sponsoredChain, Status: domain.IntentPending}
+	confirmed := sponsoredMint("mint-1", "user-1")
+	confirmed.Status = domain.IntentReady
+	svc, _, _ := sponsorshipService(t, 1000, domain.SponsorshipCaps{}, collection, confirmed)
+
+	_, err := svc.ReserveSponsorship(context.Background(), "collection-1", 100)
+	assert.ErrorIs(t, err, domain.ErrNotSponsorable)
+
+	_, err = svc.ReserveSponsorship(context.Background(), "mint-1", 100)
+	assert.ErrorIs(t, err, domain.ErrNotSponsorable)
+}
+
+func TestSettleSponsorship_ChargesBudgetAndFreesGrant(t *testing.T) {
+	svc, counters, ledger := sponsorshipService(t, 1000, domain.SponsorshipCaps{CollectionDailyGwei: 1000}, sponsoredMint("mint-1", "user-1"))
+	ctx := context.Background()
+	grant, err := svc.ReserveSponsorship(ctx, "mint-1", 400)
+	require.NoError(t, err)
+
+	charged, err := svc.SettleSponsorship(ctx, grant.ID, 250, "0xABC")
+
+	require.NoError(t, err)
+	assert.True(t, charged)
+	assert.Equal(t, int64(750), ledger.budgets[sponsoredChain].BalanceGwei)
+	assert.Equal(t, int64(250), counters.day["c:"+grant.Collection])
+	assert.Empty(t, counters.grants)
+
+	_, err = svc.SettleSponsorship(ctx, grant.ID, 250, "0xabc")
+	assert.ErrorIs(t, err, domain.ErrGrantNotFound)
+	assert.Equal(t, int64(750), ledger.budgets[sponsoredChain].BalanceGwei)
+}
+
+func TestSettleSponsorship_RefusesSpendingPastTheGrant(t *testing.T) {
+	svc, counters, ledger := sponsorshipService(t, 1000, domain.SponsorshipCaps{}, sponsoredMint("mint-1", "user-1"))
+	ctx := context.Background()
+	grant, err := svc.ReserveSponsorship(ctx, "mint-1", 400)
+	require.NoError(t, err)
+
+	_, err = svc.SettleSponsorship(ctx, grant.ID, 401, "0xabc")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Equal(t, int64(1000), ledger.budgets[sponsoredChain].BalanceGwei)
+	assert.Contains(t, counters.grants, grant.ID, "the grant stays held for a corrected settlement")
+}
+
+func TestReleaseSponsorship_ReturnsHeldBudget(t *testing.T) {
+	svc, _, ledger := sponsorshipService(t, 1000, domain.SponsorshipCaps{},
+		sponsoredMint("mint-1", "user-1"), sponsoredMint("mint-2", "user-2"))
+	ctx := context.Background()
+	grant, err := svc.ReserveSponsorship(ctx, "mint-1", 900)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.ReleaseSponsorship(ctx, grant.ID))
+
+	_, err = svc.ReserveSponsorship(ctx, "mint-2", 900)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), ledger.budgets[sponsoredChain].BalanceGwei)
+}
+
+func TestSponsorshipAdmin_ValidatesInput(t *testing.T) {
+	svc, _, _ := sponsorshipService(t, 0, domain.SponsorshipCaps{})
+	ctx := context.Background()
+
+	_, err := svc.TopUpSponsorshipBudget(ctx, sponsoredChain, 0, "", "admin-1")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.SetSponsorshipBudgetFrozen(ctx, sponsoredChain, true, " ", "admin-1")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.TopUpSponsorshipBudget(ctx, "not-a-chain", 100, "", "admin-1")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestSponsorship_DisabledWithoutStores(t *testing.T) {
+	svc := createTestService(&MockRepo{}, &MockStatusCache{}, &MockChainRegistryClient{}).(*service.Service)
+
+	_, err := svc.ReserveSponsorship(context.Background(), "mint-1", 100)
+	assert.ErrorIs(t, err, domain.ErrSponsorshipDisabled)
+
+	_, err = svc.ListSponsorshipBudgets(context.Background())
+	assert.ErrorIs(t, err, domain.ErrSponsorshipDisabled)
+}
@@ -0,0 +1,192 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/infrastructure/chain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/status"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// fakeTxLookup answers from a fixed state per tx hash and counts lookups
+type fakeTxLookup struct {
+	states  map[string]domain.TxState
+	lookups int
+}
+
+func (f *fakeTxLookup) LookupTx(ctx context.Context, chainID domain.ChainID, txHash string) (domain.TxState, error) {
+	f.lookups++
+	return f.states[txHash], nil
+}
+
+var stallPolicy = domain.StallPolicy{
+	DefaultTimeout: 10 * time.Minute,
+	Timeouts:       map[domain.ChainID]time.Duration{"eip155:1": 30 * time.Minute},
+	FailAfter:      time.Hour,
+}
+
+func sentIntent(id string, chainID domain.ChainID, txHash string, trackedAt time.Time) *domain.Intent {
+	return &domain.Intent{
+		ID:        id,
+		Kind:      domain.IntentKindMint,
+		ChainID:   chainID,
+		TxHash:    &txHash,
+		Status:    domain.IntentPending,
+		UpdatedAt: trackedAt,
+	}
+}
+
+func newStallDetector(t *testing.T, lookup *fakeTxLookup, intents ...*domain.Intent) (*service.Service, *MockRepo, *status.StatusCache) {
+	repo := &MockRepo{}
+	repo.On("ListAwaitingConfirmation", mock.Anything, mock.Anything).Return(intents, nil)
+
+	cache := newBatchingCache(newFakeStatusStore(), time.Hour, 100)
+	for _, it := range intents {
+		require.NoError(t, cache.SetIntentStatus(context.Background(), domain.IntentStatusPayload{
+			IntentID: it.ID, Kind: it.Kind, Status: domain.IntentPending, ChainID: &it.ChainID, TxHash: it.TxHash,
+		}, time.Hour))
+	}
+	require.NoError(t, cache.Flush(context.Background()))
+
+	svc := service.NewOrchestrator(repo, &MockEncoder{}, cache, &MockChainRegistryClient{}, false).(*service.Service)
+	svc.SetStallDetection(lookup, stallPolicy)
+	return svc, repo, cache
+}
+
+func cachedStatus(t *testing.T, cache *status.StatusCache, intentID string) *domain.IntentStatusPayload {
+	t.Helper()
+	payload, err := cache.GetIntentStatus(context.Background(), intentID)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestDetectStalledIntents_QueuedTxOffersSpeedUpOnce(t *testing.T) {
+	now := time.Now()
+	lookup := &fakeTxLookup{states: map[string]domain.TxState{"0xq": domain.TxQueued}}
+	svc, _, cache := newStallDetector(t, lookup, sentIntent("a", "eip155:8453", "0xq", now.Add(-15*time.Minute)))
+
+	changed, err := svc.DetectStalledIntents(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+
+	got := cachedStatus(t, cache, "a")
+	assert.Equal(t, domain.IntentStalled, got.Status)
+	require.NotNil(t, got.Recovery)
+	assert.Equal(t, domain.RecoverySpeedUp, *got.Recovery)
+
+	// Already offered; nothing is written again
+	changed, err = svc.DetectStalledIntents(context.Background(), now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 0, changed)
+}
+
+func TestDetectStalledIntents_HonoursPerChainTimeout(t *testing.T) {
+	now := time.Now()
+	lookup := &fakeTxLookup{states: map[string]domain.TxState{"0xq": domain.TxQueued}}
+	svc, _, cache := newStallDetector(t, lookup, sentIntent("a", "eip155:1", "0xq", now.Add(-15*time.Minute)))
+
+	changed, err := svc.DetectStalledIntents(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, changed)
+	assert.Equal(t, 0, lookup.lookups)
+	assert.Equal(t, domain.IntentPending, cachedStatus(t, cache, "a").Status)
+}
+
+func TestDetectStalledIntents_DroppedTxIsResubmittedThenFailed(t *testing.T) {
+	now := time.Now()
+	lookup := &fakeTxLookup{states: map[string]domain.TxState{"0xd": domain.TxUnknown}}
+	svc, repo, cache := newStallDetector(t, lookup, sentIntent("a", "eip155:8453", "0xd", now.Add(-20*time.Minute)))
+
+	_, err := svc.DetectStalledIntents(context.Background(), now)
+	require.NoError(t, err)
+	got := cachedStatus(t, cache, "a")
+	assert.Equal(t, domain.IntentStalled, got.Status)
+	require.NotNil(t, got.Recovery)
+	assert.Equal(t, domain.RecoveryResubmit, *got.Recovery)
+
+	repo.On("UpdateStatus", mock.Anything, "a", domain.IntentFailed, mock.Anything).Return(nil)
+	changed, err := svc.DetectStalledIntents(context.Background(), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+
+	got = cachedStatus(t, cache, "a")
+	assert.Equal(t, domain.IntentFailed, got.Status)
+	require.NotNil(t, got.Error)
+	assert.Contains(t, *got.Error, "dropped")
+	assert.Nil(t, got.Recovery)
+	repo.AssertCalled(t, "UpdateStatus", mock.Anything, "a", domain.IntentFailed, mock.Anything)
+}
+
+func TestDetectStalledIntents_RevertedTxFails(t *testing.T) {
+	now := time.Now()
+	lookup := &fakeTxLookup{states: map[string]domain.TxState{"0xr": domain.TxReverted}}
+	svc, repo, cache := newStallDetector(t, lookup, sentIntent("a", "eip155:8453", "0xr", now.Add(-15*time.Minute)))
+	repo.On("UpdateStatus", mock.Anything, "a", domain.IntentFailed, mock.Anything).Return(nil)
+
+	changed, err := svc.DetectStalledIntents(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, domain.IntentFailed, cachedStatus(t, cache, "a").Status)
+}
+
+func TestDetectStalledIntents_SkipsResolvedAndRevivesMined(t *testing.T) {
+	now := time.Now()
+	lookup := &fakeTxLookup{states: map[string]domain.TxState{"0xm": domain.TxMined, "0xready": domain.TxQueued}}
+	svc, _, cache := newStallDetector(t, lookup,
+		sentIntent("mined", "eip155:8453", "0xm", now.Add(-15*time.Minute)),
+		sentIntent("ready", "eip155:8453", "0xready", now.Add(-15*time.Minute)),
+	)
+	ctx := context.Background()
+
+	// The subscription-worker resolved one intent; the other was stalled on an earlier scan
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "ready", Status: domain.IntentReady}, time.Hour))
+	recovery := domain.RecoverySpeedUp
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "mined", Status: domain.IntentStalled, Recovery: &recovery}, time.Hour))
+	require.NoError(t, cache.Flush(ctx))
+
+	changed, err := svc.DetectStalledIntents(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, 1, lookup.lookups)
+
+	assert.Equal(t, domain.IntentPending, cachedStatus(t, cache, "mined").Status)
+	assert.Equal(t, domain.IntentReady, cachedStatus(t, cache, "ready").Status)
+}
+
+func TestDetectStalledIntents_HoldsIntentsOnHaltedChains(t *testing.T) {
+	now := time.Now()
+	lookup := &fakeTxLookup{states: map[string]domain.TxState{"0xh": domain.TxQueued, "0xu": domain.TxQueued}}
+	svc, _, cache := newStallDetector(t, lookup,
+		sentIntent("halted", "eip155:8453", "0xh", now.Add(-15*time.Minute)),
+		sentIntent("halted-too", "eip155:8453", "0xh", now.Add(-15*time.Minute)),
+		sentIntent("unreported", "eip155:137", "0xu", now.Add(-15*time.Minute)),
+	)
+
+	registry := &MockChainRegistryClient{}
+	registry.On("GetChainHead", mock.Anything, &protoChainRegistry.GetChainHeadRequest{ChainId: "eip155:8453"}).
+		Return(&protoChainRegistry.GetChainHeadResponse{Head: &protoChainRegistry.ChainHead{ChainId: "eip155:8453", Stale: true}}, nil).Once()
+	registry.On("GetChainHead", mock.Anything, &protoChainRegistry.GetChainHeadRequest{ChainId: "eip155:137"}).
+		Return(nil, grpcstatus.Error(codes.NotFound, "chain head not found")).Once()
+	svc.SetChainLiveness(chain.NewChainLiveness(registry))
+
+	changed, err := svc.DetectStalledIntents(context.Background(), now)
+	require.NoError(t, err)
+
+	// Only the chain without a reported head is checked, and each chain is asked about once
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, 1, lookup.lookups)
+	assert.Equal(t, domain.IntentPending, cachedStatus(t, cache, "halted").Status)
+	assert.Equal(t, domain.IntentStalled, cachedStatus(t, cache, "unreported").Status)
+	registry.AssertExpectations(t)
+}
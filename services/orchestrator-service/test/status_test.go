@@ -0,0 +1,207 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/status"
+	"github.com/quangdang46/NFT-Marketplace/services/orchestrator-service/internal/utils"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+// fakeStatusStore records pipelined writes and merges them like the Redis script
+type fakeStatusStore struct {
+	mu      sync.Mutex
+	batches [][]redis.IntentStatusUpdate
+	records map[string]contracts.IntentStatusRecord
+}
+
+func newFakeStatusStore() *fakeStatusStore {
+	return &fakeStatusStore{records: make(map[string]contracts.IntentStatusRecord)}
+}
+
+func (f *fakeStatusStore) WriteIntentStatuses(ctx context.Context, updates ...redis.IntentStatusUpdate) ([]int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batches = append(f.batches, updates)
+	versions := make([]int64, len(updates))
+	for i, u := range updates {
+		if u.IfVersion > 0 && f.records[u.Record.IntentID].Version != u.IfVersion {
+			versions[i] = -1
+			continue
+		}
+		if u.Record.Seq > 0 && u.Record.Seq <= f.records[u.Record.IntentID].Seq {
+			versions[i] = -1
+			continue
+		}
+		rec := f.records[u.Record.IntentID].Merge(u.Record)
+		rec.Version++
+		f.records[u.Record.IntentID] = rec
+		versions[i] = rec.Version
+	}
+	return versions, nil
+}
+
+func (f *fakeStatusStore) ReadIntentStatus(ctx context.Context, intentID string) (contracts.IntentStatusRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	rec, ok := f.records[intentID]
+	if !ok {
+		return contracts.IntentStatusRecord{}, redis.ErrIntentStatusNotFound
+	}
+	return rec, nil
+}
+
+func (f *fakeStatusStore) batchCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.batches)
+}
+
+func newBatchingCache(store status.Store, delay time.Duration, maxBatch int) *status.StatusCache {
+	cache := status.NewStatusCache().(*status.StatusCache)
+	cache.SetStore(store)
+	cache.SetBatching(delay, maxBatch)
+	return cache
+}
+
+func TestStatusCache_CoalescesBurstIntoOnePipeline(t *testing.T) {
+	store := newFakeStatusStore()
+	cache := newBatchingCache(store, time.Hour, 100)
+	ctx := context.Background()
+
+	chainID := "eip155:8453"
+	txHash := "0xabc"
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Kind: domain.IntentKindCollection, Status: domain.IntentPending, ChainID: &chainID}, time.Hour))
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "b", Kind: domain.IntentKindMint, Status: domain.IntentPending}, time.Hour))
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Kind: domain.IntentKindCollection, Status: domain.IntentPending, TxHash: &txHash}, time.Hour))
+
+	// Pending updates are visible before they are flushed
+	pending, err := cache.GetIntentStatus(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, &txHash, pending.TxHash)
+	assert.Equal(t, 0, store.batchCount())
+
+	require.NoError(t, cache.Flush(ctx))
+
+	require.Equal(t, 1, store.batchCount())
+	batch := store.batches[0]
+	require.Len(t, batch, 2)
+	assert.Equal(t, "a", batch[0].Record.IntentID)
+	assert.Equal(t, chainID, batch[0].Record.ChainID)
+	assert.Equal(t, txHash, batch[0].Record.TxHash)
+	assert.Equal(t, "b", batch[1].Record.IntentID)
+
+	stored, err := cache.GetIntentStatus(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, chainID, *stored.ChainID)
+	assert.Equal(t, domain.IntentKindCollection, stored.Kind)
+}
+
+func TestStatusCache_FlushesOnDelayAndBatchSize(t *testing.T) {
+	store := newFakeStatusStore()
+	cache := newBatchingCache(store, 10*time.Millisecond, 2)
+	ctx := context.Background()
+
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Status: domain.IntentPending}, time.Hour))
+	assert.Eventually(t, func() bool { return store.batchCount() == 1 }, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "b", Status: domain.IntentPending}, time.Hour))
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "c", Status: domain.IntentPending}, time.Hour))
+	assert.Equal(t, 2, store.batchCount())
+}
+
+func TestStatusCache_GetIntentStatus_NotFound(t *testing.T) {
+	cache := newBatchingCache(newFakeStatusStore(), time.Hour, 100)
+
+	_, err := cache.GetIntentStatus(context.Background(), "missing")
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestStatusCache_ReplaceIntentStatus_RejectsStaleVersion(t *testing.T) {
+	store := newFakeStatusStore()
+	cache := newBatchingCache(store, time.Hour, 100)
+	ctx := context.Background()
+
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Status: domain.IntentPending}, time.Hour))
+	// An unflushed update would land after the replacement and undo it
+	assert.ErrorIs(t, cache.ReplaceIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Status: domain.IntentStalled}, time.Hour), domain.ErrStatusChanged)
+	require.NoError(t, cache.Flush(ctx))
+
+	read, err := cache.GetIntentStatus(ctx, "a")
+	require.NoError(t, err)
+	recovery := domain.RecoverySpeedUp
+	stalled := domain.IntentStatusPayload{IntentID: "a", Status: domain.IntentStalled, Recovery: &recovery, Version: read.Version}
+	require.NoError(t, cache.ReplaceIntentStatus(ctx, stalled, time.Hour))
+	assert.ErrorIs(t, cache.ReplaceIntentStatus(ctx, stalled, time.Hour), domain.ErrStatusChanged)
+
+	read, err = cache.GetIntentStatus(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, domain.IntentStalled, read.Status)
+	assert.Equal(t, &recovery, read.Recovery)
+
+	// The recovery outlives the stall in the hash but is not surfaced with other statuses
+	require.NoError(t, cache.ReplaceIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Status: domain.IntentPending}, time.Hour))
+	read, err = cache.GetIntentStatus(ctx, "a")
+	require.NoError(t, err)
+	assert.Nil(t, read.Recovery)
+}
+
+func TestStatusCache_WriteIntentStatus_WritesThroughInOrder(t *testing.T) {
+	store := newFakeStatusStore()
+	cache := newBatchingCache(store, time.Hour, 100)
+	ctx := context.Background()
+
+	chainID := "eip155:8453"
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Kind: domain.IntentKindMint, Status: domain.IntentPending, ChainID: &chainID}, time.Hour))
+
+	txHash := "0xabc"
+	require.NoError(t, cache.WriteIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Status: domain.IntentPending, TxHash: &txHash, Seq: 2}, time.Hour))
+	// Written before returning, with the buffered update folded in rather than flushed later
+	assert.Equal(t, 1, store.batchCount())
+	require.NoError(t, cache.Flush(ctx))
+	assert.Equal(t, 1, store.batchCount())
+
+	read, err := cache.GetIntentStatus(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), read.Seq)
+	assert.Equal(t, &txHash, read.TxHash)
+	assert.Equal(t, &chainID, read.ChainID)
+
+	// A write from an older stored change loses to the one already cached
+	stale := "0xold"
+	assert.ErrorIs(t, cache.WriteIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Status: domain.IntentPending, TxHash: &stale, Seq: 1}, time.Hour), domain.ErrStatusChanged)
+	assert.ErrorIs(t, cache.WriteIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Status: domain.IntentPending, TxHash: &stale, Seq: 2}, time.Hour), domain.ErrStatusChanged)
+	read, err = cache.GetIntentStatus(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, &txHash, read.TxHash)
+}
+
+func TestStatusCache_GetIntentStatus_ReportsWriteTime(t *testing.T) {
+	store := newFakeStatusStore()
+	cache := newBatchingCache(store, time.Hour, 100)
+	ctx := context.Background()
+
+	before := time.Now()
+	require.NoError(t, cache.SetIntentStatus(ctx, domain.IntentStatusPayload{IntentID: "a", Kind: domain.IntentKindMint, Status: domain.IntentPending}, time.Hour))
+	require.NoError(t, cache.Flush(ctx))
+
+	stored, err := cache.GetIntentStatus(ctx, "a")
+	require.NoError(t, err)
+	assert.WithinDuration(t, before, stored.UpdatedAt, time.Second)
+
+	resp := utils.ConvertIntentStatusResponse(stored)
+	require.NotNil(t, resp.UpdatedAt)
+	assert.Equal(t, stored.UpdatedAt.UnixMilli(), resp.UpdatedAt.AsTime().UnixMilli())
+
+	resp = utils.ConvertIntentStatusResponse(&domain.IntentStatusPayload{IntentID: "b", Status: domain.IntentPending})
+	assert.Nil(t, resp.UpdatedAt)
+}
@@ -10,15 +10,28 @@ import (
 
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/config"
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/infrastructure/events"
+	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/infrastructure/users"
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/infrastructure/websocket"
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/webhook"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
 	cfg := config.NewConfig()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("subscription-worker"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -35,6 +48,10 @@ func main() {
 	if err := redisClient.HealthCheck(ctx); err != nil {
 		log.Fatalf("Failed to ping Redis: %v", err)
 	}
+	streamStatus := cfg.StatusTransport.Transport == contracts.IntentStatusTransportStream
+	if streamStatus {
+		redisClient.SetIntentStatusStream(int64(cfg.StatusTransport.StreamMaxLen))
+	}
 
 	// Initialize RabbitMQ for event consumption
 	amqpClient, err := messaging.NewRabbitMQ(cfg.RabbitMQ)
@@ -45,6 +62,7 @@ func main() {
 
 	// Initialize WebSocket manager
 	wsManager := websocket.NewManager(cfg.WebSocketConfig)
+	wsManager.SetTickets(redisClient)
 
 	// Initialize event consumer
 	consumer := events.NewEventConsumer(amqpClient, cfg.ConsumerConfig)
@@ -55,8 +73,80 @@ func main() {
 		wsManager,
 	)
 
+	// Blocks and mutes are looked up per alert; the connection is lazy, and alerts go out
+	// unfiltered while the user service is unreachable
+	userConn, err := grpc.Dial(cfg.UserServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to user-service: %v", err)
+	}
+	defer userConn.Close()
+	subscriptionService.SetRecipientFilter(users.NewRecipientFilter(userpb.NewUserServiceClient(userConn)))
+
+	if cfg.Webhooks.SigningSecret != "" {
+		webhookCfg := webhook.DefaultConfig()
+		webhookCfg.Timeout = time.Duration(cfg.Webhooks.TimeoutSeconds) * time.Second
+		webhookCfg.Retry.MaxRetries = cfg.Webhooks.MaxRetries
+		subscriptionService.SetWebhooks(ctx, webhook.NewDeliverer(webhookCfg), []byte(cfg.Webhooks.SigningSecret))
+	}
+
 	// Register event handlers
 	consumer.RegisterCollectionEventHandler(subscriptionService.HandleCollectionDomainEvent)
+	consumer.RegisterMarketAlertHandler(subscriptionService.HandleMarketAlert)
+	consumer.RegisterAuctionBidHandler(subscriptionService.HandleAuctionBid)
+
+	// Relay wallet, auth and profile events to per-user channels
+	if err := amqpClient.ConsumeAccountEvents("subs.account.events", "subscription-worker", subscriptionService.HandleAccountEvent); err != nil {
+		log.Printf("Account events disabled: %v", err)
+	}
+
+	// Read intent statuses from the regional replica when there is one. Published writes
+	// reach replicas too, but stream consumer groups can only be read on the primary.
+	statusSource := redisClient
+	if cfg.StatusReplica.Enabled() {
+		replicaClient, err := redis.NewRedis(cfg.StatusReplica.Redis)
+		if err != nil {
+			log.Fatalf("Failed to connect to Redis replica: %v", err)
+		}
+		defer replicaClient.Close()
+		if err := replicaClient.HealthCheck(ctx); err != nil {
+			log.Fatalf("Failed to ping Redis replica: %v", err)
+		}
+		subscriptionService.SetStatusReplica(replicaClient)
+		go subscriptionService.RunStatusHeartbeat(ctx, cfg.StatusReplica.HeartbeatInterval)
+		statusSource = replicaClient
+	}
+
+	// Push intent status writes from the orchestrator and this worker to subscribers
+	statusDone := make(chan struct{})
+	go func() {
+		defer close(statusDone)
+		var err error
+		if streamStatus {
+			err = redisClient.ConsumeIntentStatusStream(ctx, cfg.StatusTransport.StreamGroup, cfg.ConsumerConfig.ConsumerTag, subscriptionService.HandleIntentStatusChanged)
+		} else {
+			err = statusSource.SubscribeIntentStatus(ctx, subscriptionService.HandleIntentStatusChanged)
+		}
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Intent status subscription stopped: %v", err)
+		}
+	}()
+
+	// Push media upload progress to the clients following each upload ticket
+	go func() {
+		if err := redisClient.SubscribeUploadProgress(ctx, subscriptionService.HandleUploadProgress); err != nil && ctx.Err() == nil {
+			log.Printf("Upload progress subscription stopped: %v", err)
+		}
+	}()
+
+	// Push per-minute mint stats to the dashboards following each collection
+	go func() {
+		if err := redisClient.SubscribeMintStats(ctx, subscriptionService.HandleMintStats); err != nil && ctx.Err() == nil {
+			log.Printf("Mint stats subscription stopped: %v", err)
+		}
+	}()
+
+	// Report consumer lag for the catalog's systemStatus query
+	go consumer.ReportLag(ctx, time.Duration(cfg.ConsumerConfig.LagReportSeconds)*time.Second, redisClient.WriteConsumerLag)
 
 	// Start WebSocket manager
 	go func() {
@@ -89,9 +179,28 @@ func main() {
 		log.Printf("Error during consumer shutdown: %v", err)
 	}
 
+	// Relay the account events already received before sockets close
+	if err := amqpClient.Drain(shutdownCtx); err != nil {
+		log.Printf("Error draining account events: %v", err)
+	}
+
+	// Let callbacks under way finish; cancelling ctx below abandons any still retrying
+	if err := subscriptionService.WaitWebhooks(shutdownCtx); err != nil {
+		log.Printf("Error waiting for webhook deliveries: %v", err)
+	}
+
 	if err := wsManager.Stop(shutdownCtx); err != nil {
 		log.Printf("Error during WebSocket manager shutdown: %v", err)
 	}
 
+	// Nothing reads this replica's status group once it stops; don't leave one per restart
+	cancel()
+	<-statusDone
+	if streamStatus {
+		if err := redisClient.DestroyIntentStatusGroup(shutdownCtx, cfg.StatusTransport.StreamGroup); err != nil {
+			log.Printf("Error removing intent status group: %v", err)
+		}
+	}
+
 	log.Println("Subscription worker service stopped")
 }
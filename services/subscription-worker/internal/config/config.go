@@ -1,11 +1,15 @@
 package config
 
 import (
+	"os"
+	"strconv"
 	"time"
 
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	"github.com/quangdang46/NFT-Marketplace/shared/env"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 type ConsumerConfig struct {
@@ -14,6 +18,8 @@ type ConsumerConfig struct {
 	ConsumerTag   string
 	PrefetchCount int
 	AutoAck       bool
+	// How often processing lag is reported for the systemStatus query
+	LagReportSeconds int
 }
 
 type WebSocketConfig struct {
@@ -24,6 +30,56 @@ type WebSocketConfig struct {
 	MaxConnections    int
 	MaxMessageSize    int64
 	EnableCompression bool
+	// AllowUnticketed lets connections without an Origin, i.e. other services rather than
+	// browsers, subscribe without a subscription ticket. Off by default: enable it only where
+	// the WebSocket port is reachable from the internal network alone.
+	AllowUnticketed bool
+	Scaling         ScalingConfig
+}
+
+// ScalingConfig sets how /scaling-hints turns load into a recommendation. Load is the
+// highest of connection use, fan-out rate against TargetFanoutPerSecond and average
+// send-queue fill, each between 0 and 1.
+type ScalingConfig struct {
+	// TargetFanoutPerSecond is the fan-out rate one replica is sized for
+	TargetFanoutPerSecond float64
+	// ScaleUpLoad and ScaleDownLoad bound the load recommended to be left alone
+	ScaleUpLoad   float64
+	ScaleDownLoad float64
+}
+
+// StatusTransportConfig selects how intent status writes reach this worker; it must match
+// the orchestrator's
+type StatusTransportConfig struct {
+	// Transport is contracts.IntentStatusTransportPubSub or IntentStatusTransportStream
+	Transport    string
+	StreamMaxLen int
+	// StreamGroup is this worker's consumer group. Each replica serves its own sockets and
+	// so needs every write: replicas must not share a group. The group is removed when the
+	// worker stops.
+	StreamGroup string
+}
+
+// StatusReplicaConfig points intent status reads at a regional read replica of the
+// primary Redis, for geo-distributed deployments. Writes still go to the primary.
+type StatusReplicaConfig struct {
+	// Redis is the replica; an empty host reads from the primary
+	Redis redis.RedisConfig
+	// HeartbeatInterval is how often the replica's lag behind the primary is measured
+	HeartbeatInterval time.Duration
+}
+
+// Enabled reports whether a replica is configured
+func (c StatusReplicaConfig) Enabled() bool {
+	return c.Redis.RedisHost != ""
+}
+
+// WebhookConfig drives delivery of creators' intent callbacks
+type WebhookConfig struct {
+	// SigningSecret must match the orchestrator's; callbacks are dropped when empty
+	SigningSecret  string
+	TimeoutSeconds int
+	MaxRetries     int
 }
 
 type Config struct {
@@ -31,6 +87,11 @@ type Config struct {
 	RabbitMQ        messaging.RabbitMQConfig
 	ConsumerConfig  ConsumerConfig
 	WebSocketConfig WebSocketConfig
+	StatusTransport StatusTransportConfig
+	StatusReplica   StatusReplicaConfig
+	// UserServiceURL is asked which alert recipients blocked or muted the other parties
+	UserServiceURL string
+	Webhooks       WebhookConfig
 }
 
 func NewConfig() *Config {
@@ -40,6 +101,7 @@ func NewConfig() *Config {
 			RedisPort:     env.GetInt("REDIS_PORT", 6379),
 			RedisPassword: env.GetString("REDIS_PASSWORD", ""),
 			RedisDB:       env.GetInt("REDIS_DB", 0),
+			Tenant:        tenant.FromEnv(),
 		},
 		RabbitMQ: messaging.RabbitMQConfig{
 			RabbitMQHost:     env.GetString("RABBITMQ_HOST", "localhost"),
@@ -47,6 +109,7 @@ func NewConfig() *Config {
 			RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
 			RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
 			RabbitMQExchange: env.GetString("RABBITMQ_EXCHANGE", "nft-marketplace"),
+			Tenant:           tenant.FromEnv(),
 		},
 		ConsumerConfig: ConsumerConfig{
 			QueueName: env.GetString("SUBSCRIPTION_QUEUE_NAME", "subscription.collections.domain"),
@@ -56,10 +119,16 @@ func NewConfig() *Config {
 				"collections.domain.upserted.eip155-137",      // Polygon
 				"collections.domain.upserted.eip155-80001",    // Polygon Mumbai
 				"collections.domain.upserted.*",               // Catch-all for new chains
+				"offer.expiring_soon.*",                       // Scheduled market alerts from the catalog service
+				"listing.expiring_soon.*",
+				"auction.ended.*",
+				"drop.starting_soon.*",
+				"auction.bid_placed.*", // Live auction bids from the catalog service
 			},
-			ConsumerTag:   env.GetString("SUBSCRIPTION_CONSUMER_TAG", "subscription-worker"),
-			PrefetchCount: env.GetInt("SUBSCRIPTION_PREFETCH_COUNT", 10),
-			AutoAck:       env.GetBool("SUBSCRIPTION_AUTO_ACK", false),
+			ConsumerTag:      env.GetString("SUBSCRIPTION_CONSUMER_TAG", "subscription-worker"),
+			PrefetchCount:    env.GetInt("SUBSCRIPTION_PREFETCH_COUNT", 10),
+			AutoAck:          env.GetBool("SUBSCRIPTION_AUTO_ACK", false),
+			LagReportSeconds: env.GetInt("SUBSCRIPTION_LAG_REPORT_SECONDS", 15),
 		},
 		WebSocketConfig: WebSocketConfig{
 			Host:              env.GetString("WEBSOCKET_HOST", "0.0.0.0"),
@@ -69,6 +138,41 @@ func NewConfig() *Config {
 			MaxConnections:    env.GetInt("WEBSOCKET_MAX_CONNECTIONS", 1000),
 			MaxMessageSize:    int64(env.GetInt("WEBSOCKET_MAX_MESSAGE_SIZE", 1024*1024)), // 1MB
 			EnableCompression: env.GetBool("WEBSOCKET_ENABLE_COMPRESSION", true),
+			AllowUnticketed:   env.GetBool("WEBSOCKET_ALLOW_UNTICKETED", false),
+			Scaling: ScalingConfig{
+				TargetFanoutPerSecond: env.GetFloat("SCALING_TARGET_FANOUT_PER_SECOND", 2000),
+				ScaleUpLoad:           env.GetFloat("SCALING_SCALE_UP_LOAD", 0.75),
+				ScaleDownLoad:         env.GetFloat("SCALING_SCALE_DOWN_LOAD", 0.25),
+			},
+		},
+		StatusTransport: StatusTransportConfig{
+			Transport:    env.GetString("INTENT_STATUS_TRANSPORT", contracts.IntentStatusTransportPubSub),
+			StreamMaxLen: env.GetInt("INTENT_STATUS_STREAM_MAXLEN", 100000),
+			StreamGroup:  env.GetString("INTENT_STATUS_STREAM_GROUP", "subscription-worker:"+hostname()),
+		},
+		StatusReplica: StatusReplicaConfig{
+			Redis: redis.RedisConfig{
+				RedisHost:     env.GetString("REDIS_REPLICA_HOST", ""),
+				RedisPort:     env.GetInt("REDIS_REPLICA_PORT", 6379),
+				RedisPassword: env.GetString("REDIS_REPLICA_PASSWORD", env.GetString("REDIS_PASSWORD", "")),
+				RedisDB:       env.GetInt("REDIS_DB", 0),
+				Tenant:        tenant.FromEnv(),
+			},
+			HeartbeatInterval: time.Duration(env.GetInt("INTENT_STATUS_HEARTBEAT_MS", 1000)) * time.Millisecond,
 		},
+		UserServiceURL: env.GetString("USER_SERVICE_URL", "user-service:50052"),
+		Webhooks: WebhookConfig{
+			SigningSecret:  env.GetString("WEBHOOK_SIGNING_SECRET", ""),
+			TimeoutSeconds: env.GetInt("WEBHOOK_TIMEOUT_SECONDS", 10),
+			MaxRetries:     env.GetInt("WEBHOOK_MAX_RETRIES", 3),
+		},
+	}
+}
+
+// hostname names this replica, falling back to its process id
+func hostname() string {
+	if name, err := os.Hostname(); err == nil && name != "" {
+		return name
 	}
+	return strconv.Itoa(os.Getpid())
 }
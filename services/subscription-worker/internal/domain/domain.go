@@ -2,19 +2,78 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/webhook"
 )
 
-// IntentStatus represents the status of an intent
+// ErrIntentStatusConflict is returned when an intent changed since it was read
+var ErrIntentStatusConflict = errors.New("intent status changed concurrently")
+
+// IntentStatus represents the status of an intent. Version is the stored version it was
+// read at; updates only apply while it is still current.
 type IntentStatus struct {
 	IntentID        string                 `json:"intent_id"`
+	Kind            string                 `json:"kind,omitempty"`
 	Status          string                 `json:"status"`
 	TxHash          string                 `json:"tx_hash,omitempty"`
 	ContractAddress string                 `json:"contract_address,omitempty"`
 	ChainID         string                 `json:"chain_id,omitempty"`
+	Error           string                 `json:"error,omitempty"`
 	Data            map[string]interface{} `json:"data,omitempty"`
 	UpdatedAt       time.Time              `json:"updated_at"`
 	ExpiresAt       time.Time              `json:"expires_at,omitempty"`
+	Version         int64                  `json:"version"`
+	// StalenessMs bounds, in ms, how far this status may lag the latest write when it was
+	// read from a regional replica; unset while the bound is unknown
+	StalenessMs *int64 `json:"staleness_ms,omitempty"`
+	// CallbackURL is the creator's webhook; it is never sent to subscribers
+	CallbackURL string `json:"-"`
+}
+
+// IntentStatusFromRecord converts the shared status record
+func IntentStatusFromRecord(rec contracts.IntentStatusRecord) *IntentStatus {
+	return &IntentStatus{
+		IntentID:        rec.IntentID,
+		Kind:            rec.Kind,
+		Status:          rec.Status,
+		TxHash:          rec.TxHash,
+		ContractAddress: rec.ContractAddress,
+		ChainID:         rec.ChainID,
+		Error:           rec.Error,
+		Data:            rec.Data,
+		UpdatedAt:       rec.UpdatedAt,
+		ExpiresAt:       rec.ExpiresAt,
+		Version:         rec.Version,
+		CallbackURL:     rec.CallbackURL,
+	}
+}
+
+// Unresolved reports whether the intent still waits for its tx to confirm. The orchestrator
+// marks a sent tx stalled while it stays unconfirmed; it can still be mined.
+func (s *IntentStatus) Unresolved() bool {
+	return s.Status == "pending" || s.Status == "processing" || s.Status == "stalled"
+}
+
+// Record converts the status to the shared status record
+func (s *IntentStatus) Record() contracts.IntentStatusRecord {
+	return contracts.IntentStatusRecord{
+		IntentID:        s.IntentID,
+		Kind:            s.Kind,
+		Status:          s.Status,
+		ChainID:         s.ChainID,
+		TxHash:          s.TxHash,
+		ContractAddress: s.ContractAddress,
+		Error:           s.Error,
+		Data:            s.Data,
+		ExpiresAt:       s.ExpiresAt,
+		UpdatedAt:       s.UpdatedAt,
+		Version:         s.Version,
+		CallbackURL:     s.CallbackURL,
+	}
 }
 
 // DomainEvent represents a domain event from the catalog service
@@ -29,6 +88,17 @@ type DomainEvent struct {
 	Timestamp   time.Time              `json:"timestamp"`
 }
 
+// Scheduled market alerts published by the catalog service
+const (
+	EventOfferExpiringSoon   = "offer.expiring_soon"
+	EventListingExpiringSoon = "listing.expiring_soon"
+	EventAuctionEnded        = "auction.ended"
+	EventDropStartingSoon    = "drop.starting_soon"
+)
+
+// EventAuctionBidPlaced is a live bid published by the catalog service
+const EventAuctionBidPlaced = "auction.bid_placed"
+
 // WebSocketMessage represents a message sent over WebSocket
 type WebSocketMessage struct {
 	Type      string      `json:"type"`
@@ -68,7 +138,15 @@ type IntentRepository interface {
 	// GetIntentStatus retrieves the status of an intent from Redis
 	GetIntentStatus(ctx context.Context, intentID string) (*IntentStatus, error)
 
-	// UpdateIntentStatus updates the status of an intent in Redis
+	// GetLatestIntentStatus reads the intent from the primary Redis, which a regional
+	// replica serving GetIntentStatus may lag
+	GetLatestIntentStatus(ctx context.Context, intentID string) (*IntentStatus, error)
+
+	// Staleness bounds how far the statuses read may lag the primary; false while unknown
+	Staleness() (time.Duration, bool)
+
+	// UpdateIntentStatus updates the status of an intent in Redis. It returns
+	// ErrIntentStatusConflict if the intent changed since status.Version was read.
 	UpdateIntentStatus(ctx context.Context, status *IntentStatus) error
 
 	// GetPendingIntentsByContract gets pending intents for a contract address
@@ -115,6 +193,19 @@ type WebSocketManager interface {
 	HealthCheck() error
 }
 
+// RecipientFilter drops notification recipients who blocked or muted another party to
+// the notification, or were blocked by one
+type RecipientFilter interface {
+	// FilterRecipients returns the lowercase recipients that may be notified
+	FilterRecipients(ctx context.Context, recipients, parties []string) ([]string, error)
+}
+
+// IntentWebhookSender delivers signed callbacks to the backend a creator registered for
+// an intent
+type IntentWebhookSender interface {
+	Deliver(ctx context.Context, callbackURL, secret string, event webhook.Event) error
+}
+
 type EventConsumer interface {
 	// Start begins consuming events
 	Start(ctx context.Context) error
@@ -124,6 +215,12 @@ type EventConsumer interface {
 
 	// RegisterCollectionEventHandler registers a handler for collection domain events
 	RegisterCollectionEventHandler(handler CollectionEventHandler)
+
+	// RegisterMarketAlertHandler registers a handler for scheduled offer, listing and auction alerts
+	RegisterMarketAlertHandler(handler CollectionEventHandler)
+
+	// RegisterAuctionBidHandler registers a handler for live auction bids
+	RegisterAuctionBidHandler(handler CollectionEventHandler)
 }
 
 type SubscriptionWorkerService interface {
@@ -133,6 +230,21 @@ type SubscriptionWorkerService interface {
 	// ProcessCollectionUpserted processes a collection upserted event
 	ProcessCollectionUpserted(ctx context.Context, event *DomainEvent) error
 
+	// HandleMarketAlert pushes a scheduled market alert to its recipients
+	HandleMarketAlert(ctx context.Context, event *DomainEvent) error
+
+	// HandleAuctionBid streams a new bid to everyone watching the auction
+	HandleAuctionBid(ctx context.Context, event *DomainEvent) error
+
+	// HandleAccountEvent relays a wallet, auth or profile event to its user's channel
+	HandleAccountEvent(ctx context.Context, event *contracts.AccountEvent) error
+
+	// HandleUploadProgress relays a media upload progress report to its ticket's channel
+	HandleUploadProgress(progress contracts.UploadProgress)
+
+	// HandleMintStats relays an updated mint stats bucket to its collection's channel
+	HandleMintStats(update contracts.MintStatsUpdate)
+
 	// ResolveIntent resolves an intent and notifies subscribers
 	ResolveIntent(ctx context.Context, intentID string, status *IntentStatus) error
 
@@ -154,6 +266,39 @@ type CollectionEventHandler func(ctx context.Context, event *DomainEvent) error
 
 // Helper functions for domain logic
 
+// AddressTopic is the subscription key a wallet subscribes to for alerts addressed to it
+func AddressTopic(address string) string {
+	return "address:" + strings.ToLower(address)
+}
+
+// AuctionTopic is the subscription key for live bids on one auction
+func AuctionTopic(chainID, auctionID string) string {
+	return "auction:" + chainID + ":" + auctionID
+}
+
+// DropsTopic is the subscription key for drop calendar alerts on one chain
+func DropsTopic(chainID string) string {
+	return "drops:" + chainID
+}
+
+// UserTopic is the subscription key for account events of one user. The gateway follows
+// it on behalf of the authenticated user; sockets opened with a ticket may follow only
+// their own.
+func UserTopic(userID string) string {
+	return "user:" + userID
+}
+
+// MessageAccountEvent is the WebSocket message type carrying a contracts.AccountEvent
+const MessageAccountEvent = "account_event"
+
+// MessageUploadProgress is the WebSocket message type carrying a contracts.UploadProgress,
+// sent to the contracts.UploadTopic of its ticket
+const MessageUploadProgress = "upload_progress"
+
+// MessageMintStats is the WebSocket message type carrying a contracts.MintStatsUpdate,
+// sent to the contracts.MintStatsTopic of its collection
+const MessageMintStats = "mint_stats"
+
 func NewWebSocketMessage(msgType, intentID string, data interface{}) *WebSocketMessage {
 	return &WebSocketMessage{
 		Type:      msgType,
@@ -20,10 +20,13 @@ type EventConsumer struct {
 	amqp                   *messaging.RabbitMQ
 	config                 config.ConsumerConfig
 	collectionEventHandler domain.CollectionEventHandler
+	marketAlertHandler     domain.CollectionEventHandler
+	auctionBidHandler      domain.CollectionEventHandler
 	channel                *amqp.Channel
 	deliveries             <-chan amqp.Delivery
 	done                   chan error
 	consumerTag            string
+	lag                    *messaging.LagTracker
 	mu                     sync.RWMutex
 	isRunning              bool
 }
@@ -35,6 +38,7 @@ func NewEventConsumer(amqp *messaging.RabbitMQ, config config.ConsumerConfig) *E
 		config:      config,
 		done:        make(chan error),
 		consumerTag: config.ConsumerTag,
+		lag:         messaging.NewLagTracker(config.ConsumerTag, config.QueueName),
 	}
 }
 
@@ -45,6 +49,20 @@ func (c *EventConsumer) RegisterCollectionEventHandler(handler domain.Collection
 	c.collectionEventHandler = handler
 }
 
+// RegisterMarketAlertHandler registers a handler for scheduled offer, listing, auction and drop alerts
+func (c *EventConsumer) RegisterMarketAlertHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.marketAlertHandler = handler
+}
+
+// RegisterAuctionBidHandler registers a handler for live auction bids
+func (c *EventConsumer) RegisterAuctionBidHandler(handler domain.CollectionEventHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.auctionBidHandler = handler
+}
+
 // Start begins consuming events
 func (c *EventConsumer) Start(ctx context.Context) error {
 	c.mu.Lock()
@@ -201,7 +219,9 @@ func (c *EventConsumer) processMessages(ctx context.Context) {
 			}
 
 			// Process the message
+			c.lag.Received(delivery)
 			err := c.processMessage(ctx, delivery)
+			c.lag.Settled(delivery, err)
 			if err != nil {
 				log.Printf("Error processing message: %v", err)
 				// Reject the message and send to DLQ if not auto-ack
@@ -234,6 +254,10 @@ func (c *EventConsumer) processMessage(ctx context.Context, delivery amqp.Delive
 	switch eventType {
 	case "collection_upserted", "collection_created":
 		return c.processCollectionDomainEvent(msgCtx, delivery)
+	case domain.EventOfferExpiringSoon, domain.EventListingExpiringSoon, domain.EventAuctionEnded, domain.EventDropStartingSoon:
+		return c.processMarketAlertEvent(msgCtx, delivery)
+	case domain.EventAuctionBidPlaced:
+		return c.processAuctionBidEvent(msgCtx, delivery)
 	default:
 		log.Printf("Unknown event type for routing key: %s", delivery.RoutingKey)
 		return nil // Don't reject unknown events, just ignore them
@@ -242,6 +266,46 @@ func (c *EventConsumer) processMessage(ctx context.Context, delivery amqp.Delive
 
 // processCollectionDomainEvent processes collection domain events
 func (c *EventConsumer) processCollectionDomainEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.collectionEventHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no collection event handler registered")
+	}
+
+	return c.dispatchDomainEvent(ctx, delivery, handler)
+}
+
+// processMarketAlertEvent processes offer.expiring_soon, listing.expiring_soon, auction.ended and
+// drop.starting_soon alerts
+func (c *EventConsumer) processMarketAlertEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.marketAlertHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no market alert handler registered")
+	}
+
+	return c.dispatchDomainEvent(ctx, delivery, handler)
+}
+
+// processAuctionBidEvent processes auction.bid_placed events
+func (c *EventConsumer) processAuctionBidEvent(ctx context.Context, delivery amqp.Delivery) error {
+	c.mu.RLock()
+	handler := c.auctionBidHandler
+	c.mu.RUnlock()
+
+	if handler == nil {
+		return fmt.Errorf("no auction bid handler registered")
+	}
+
+	return c.dispatchDomainEvent(ctx, delivery, handler)
+}
+
+// dispatchDomainEvent decodes, validates and hands a domain event to handler
+func (c *EventConsumer) dispatchDomainEvent(ctx context.Context, delivery amqp.Delivery, handler domain.CollectionEventHandler) error {
 	// Parse the message body as domain event
 	var domainEvent domain.DomainEvent
 	err := json.Unmarshal(delivery.Body, &domainEvent)
@@ -273,16 +337,7 @@ func (c *EventConsumer) processCollectionDomainEvent(ctx context.Context, delive
 		}
 	}
 
-	// Call the registered handler
-	c.mu.RLock()
-	handler := c.collectionEventHandler
-	c.mu.RUnlock()
-
-	if handler == nil {
-		return fmt.Errorf("no collection event handler registered")
-	}
-
-	log.Printf("Processing collection domain event: EventID=%s, Type=%s, AggregateID=%s, ChainID=%s",
+	log.Printf("Processing domain event: EventID=%s, Type=%s, AggregateID=%s, ChainID=%s",
 		domainEvent.EventID, domainEvent.EventType, domainEvent.AggregateID, domainEvent.ChainID)
 
 	return handler(ctx, &domainEvent)
@@ -311,6 +366,16 @@ func (c *EventConsumer) validateDomainEvent(event *domain.DomainEvent) error {
 				return fmt.Errorf("required field '%s' is missing from event data", field)
 			}
 		}
+	case domain.EventOfferExpiringSoon, domain.EventListingExpiringSoon, domain.EventAuctionEnded, domain.EventDropStartingSoon:
+		if _, exists := event.Data["recipients"]; !exists {
+			return fmt.Errorf("required field 'recipients' is missing from event data")
+		}
+	case domain.EventAuctionBidPlaced:
+		for _, field := range []string{"auction_id", "highest_bid"} {
+			if _, exists := event.Data[field]; !exists {
+				return fmt.Errorf("required field '%s' is missing from event data", field)
+			}
+		}
 	}
 
 	return nil
@@ -319,7 +384,11 @@ func (c *EventConsumer) validateDomainEvent(event *domain.DomainEvent) error {
 // getEventTypeFromRoutingKey extracts event type from routing key
 func (c *EventConsumer) getEventTypeFromRoutingKey(routingKey string) string {
 	// Expected format: collections.domain.upserted.eip155-1
+	// or offer.expiring_soon.eip155-1 for scheduled market alerts and auction.bid_placed.eip155-1 for live bids
 	parts := strings.Split(routingKey, ".")
+	if len(parts) >= 2 && (parts[0] == "offer" || parts[0] == "listing" || parts[0] == "auction" || parts[0] == "drop") {
+		return parts[0] + "." + parts[1] // "offer.expiring_soon"
+	}
 	if len(parts) >= 3 {
 		eventType := parts[2] // "upserted"
 		if eventType == "upserted" {
@@ -334,12 +403,20 @@ func (c *EventConsumer) getEventTypeFromRoutingKey(routingKey string) string {
 func (c *EventConsumer) extractChainIDFromRoutingKey(routingKey string) string {
 	// Expected format: collections.domain.upserted.eip155-1
 	parts := strings.Split(routingKey, ".")
+	if len(parts) == 3 && (parts[0] == "offer" || parts[0] == "listing" || parts[0] == "auction" || parts[0] == "drop") {
+		return parts[2]
+	}
 	if len(parts) >= 4 {
 		return parts[3] // "eip155-1"
 	}
 	return ""
 }
 
+// ReportLag reports the consumer's processing lag through report every interval until ctx is done
+func (c *EventConsumer) ReportLag(ctx context.Context, interval time.Duration, report messaging.LagReporter) {
+	c.lag.Report(ctx, interval, report)
+}
+
 // Health check for the consumer
 func (c *EventConsumer) HealthCheck() error {
 	c.mu.RLock()
@@ -2,23 +2,20 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
+	"sync/atomic"
 	"time"
 
-	"github.com/redis/go-redis/v9"
-
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	sharedRedis "github.com/quangdang46/NFT-Marketplace/shared/redis"
 )
 
 const (
-	// Redis key patterns
-	intentStatusKeyPrefix    = "intent:status:"
-	contractIntentsKeyPrefix = "intent:contract:"
-	txHashIntentsKeyPrefix   = "intent:txhash:"
-	expiredIntentsKey        = "intent:expired"
+	expiredIntentsKey = "intent:expired"
 
 	// Default TTL for intent status
 	defaultIntentTTL = 24 * time.Hour
@@ -26,6 +23,11 @@ const (
 
 type IntentRepository struct {
 	redis *sharedRedis.Redis
+	// replica, when set, serves status reads from a regional copy of redis; writes still
+	// go to redis, the primary
+	replica *sharedRedis.Redis
+	// heartbeat is the newest primary stamp seen on the replica, in unix ms
+	heartbeat atomic.Int64
 }
 
 // NewIntentRepository creates a new Redis intent repository
@@ -35,27 +37,88 @@ func NewIntentRepository(client *sharedRedis.Redis) *IntentRepository {
 	}
 }
 
+// SetReplica reads statuses and their indexes from replica instead of the primary. Run
+// RunHeartbeat alongside so Staleness can bound the replica's lag.
+func (r *IntentRepository) SetReplica(replica *sharedRedis.Redis) {
+	r.replica = replica
+}
+
+// reader is the connection statuses are read from
+func (r *IntentRepository) reader() *sharedRedis.Redis {
+	if r.replica != nil {
+		return r.replica
+	}
+	return r.redis
+}
+
+// RunHeartbeat stamps the primary every interval and reads the stamp back from the
+// replica until ctx is done. It returns at once without a replica.
+func (r *IntentRepository) RunHeartbeat(ctx context.Context, interval time.Duration) {
+	if r.replica == nil {
+		return
+	}
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.redis.WriteStatusHeartbeat(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to write status heartbeat: %v", err)
+		}
+		if at, err := r.replica.ReadStatusHeartbeat(ctx); err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to read status heartbeat from replica: %v", err)
+			}
+		} else if !at.IsZero() {
+			r.heartbeat.Store(at.UnixMilli())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Staleness bounds how far the statuses read lag the primary: zero when reading the
+// primary, and false while the replica has shown no heartbeat yet
+func (r *IntentRepository) Staleness() (time.Duration, bool) {
+	if r.replica == nil {
+		return 0, true
+	}
+	ms := r.heartbeat.Load()
+	if ms == 0 {
+		return 0, false
+	}
+	return max(time.Since(time.UnixMilli(ms)), 0), true
+}
+
 // GetIntentStatus retrieves the status of an intent from Redis
 func (r *IntentRepository) GetIntentStatus(ctx context.Context, intentID string) (*domain.IntentStatus, error) {
-	key := intentStatusKeyPrefix + intentID
+	return r.readIntentStatus(ctx, r.reader(), intentID)
+}
 
-	data, err := r.redis.Get(ctx, key)
+// GetLatestIntentStatus reads the intent from the primary, which a replica may lag
+func (r *IntentRepository) GetLatestIntentStatus(ctx context.Context, intentID string) (*domain.IntentStatus, error) {
+	return r.readIntentStatus(ctx, r.redis, intentID)
+}
+
+func (r *IntentRepository) readIntentStatus(ctx context.Context, conn *sharedRedis.Redis, intentID string) (*domain.IntentStatus, error) {
+	rec, err := conn.ReadIntentStatus(ctx, intentID)
 	if err != nil {
-		if err == redis.Nil {
+		if errors.Is(err, sharedRedis.ErrIntentStatusNotFound) {
 			return nil, fmt.Errorf("intent not found: %s", intentID)
 		}
 		return nil, fmt.Errorf("failed to get intent status: %w", err)
 	}
 
-	var status domain.IntentStatus
-	if err := json.Unmarshal([]byte(data), &status); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal intent status: %w", err)
-	}
-
-	return &status, nil
+	return domain.IntentStatusFromRecord(rec), nil
 }
 
-// UpdateIntentStatus updates the status of an intent in Redis
+// UpdateIntentStatus writes the status if the intent is still at status.Version and
+// advances status.Version. The write is published to every intent status subscriber.
 func (r *IntentRepository) UpdateIntentStatus(ctx context.Context, status *domain.IntentStatus) error {
 	if status == nil {
 		return fmt.Errorf("intent status cannot be nil")
@@ -63,14 +126,6 @@ func (r *IntentRepository) UpdateIntentStatus(ctx context.Context, status *domai
 
 	status.UpdatedAt = time.Now()
 
-	// Serialize status to JSON
-	data, err := json.Marshal(status)
-	if err != nil {
-		return fmt.Errorf("failed to marshal intent status: %w", err)
-	}
-
-	// Store in Redis with TTL
-	key := intentStatusKeyPrefix + status.IntentID
 	ttl := defaultIntentTTL
 	if !status.ExpiresAt.IsZero() {
 		ttl = time.Until(status.ExpiresAt)
@@ -79,28 +134,14 @@ func (r *IntentRepository) UpdateIntentStatus(ctx context.Context, status *domai
 		}
 	}
 
-	err = r.redis.Set(ctx, key, string(data), ttl)
+	version, err := r.redis.CompareAndSetIntentStatus(ctx, status.Record(), status.Version, ttl)
 	if err != nil {
-		return fmt.Errorf("failed to set intent status: %w", err)
-	}
-
-	// Update contract index if contract address is available
-	if status.ContractAddress != "" && status.ChainID != "" {
-		err = r.addToContractIndex(ctx, status)
-		if err != nil {
-			// Log error but don't fail the main operation
-			fmt.Printf("Warning: failed to update contract index: %v\n", err)
-		}
-	}
-
-	// Update transaction hash index if tx hash is available
-	if status.TxHash != "" && status.ChainID != "" {
-		err = r.addToTxHashIndex(ctx, status)
-		if err != nil {
-			// Log error but don't fail the main operation
-			fmt.Printf("Warning: failed to update tx hash index: %v\n", err)
+		if errors.Is(err, sharedRedis.ErrIntentStatusConflict) {
+			return domain.ErrIntentStatusConflict
 		}
+		return fmt.Errorf("failed to set intent status: %w", err)
 	}
+	status.Version = version
 
 	// Add to expired set if the intent has expired
 	if !status.ExpiresAt.IsZero() && time.Now().After(status.ExpiresAt) {
@@ -115,10 +156,10 @@ func (r *IntentRepository) UpdateIntentStatus(ctx context.Context, status *domai
 
 // GetPendingIntentsByContract gets pending intents for a contract address
 func (r *IntentRepository) GetPendingIntentsByContract(ctx context.Context, chainID, contractAddress string) ([]*domain.IntentStatus, error) {
-	key := r.getContractKey(chainID, contractAddress)
+	key := contracts.IntentContractKey(chainID, contractAddress)
 
 	// Get all intent IDs for this contract
-	intentIDs, err := r.redis.SMembers(ctx, key)
+	intentIDs, err := r.reader().SMembers(ctx, key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get contract intents: %w", err)
 	}
@@ -131,8 +172,8 @@ func (r *IntentRepository) GetPendingIntentsByContract(ctx context.Context, chai
 			continue
 		}
 
-		// Only return pending/processing intents
-		if status.Status == "pending" || status.Status == "processing" {
+		// Only return intents still waiting on their tx
+		if status.Unresolved() {
 			intents = append(intents, status)
 		}
 	}
@@ -142,10 +183,10 @@ func (r *IntentRepository) GetPendingIntentsByContract(ctx context.Context, chai
 
 // GetIntentsByTxHash gets intents by transaction hash
 func (r *IntentRepository) GetIntentsByTxHash(ctx context.Context, chainID, txHash string) ([]*domain.IntentStatus, error) {
-	key := r.getTxHashKey(chainID, txHash)
+	key := contracts.IntentTxHashKey(chainID, txHash)
 
 	// Get all intent IDs for this transaction hash
-	intentIDs, err := r.redis.SMembers(ctx, key)
+	intentIDs, err := r.reader().SMembers(ctx, key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tx hash intents: %w", err)
 	}
@@ -173,7 +214,7 @@ func (r *IntentRepository) DeleteIntent(ctx context.Context, intentID string) er
 	}
 
 	// Delete main intent key
-	key := intentStatusKeyPrefix + intentID
+	key := contracts.IntentStatusKey(intentID)
 	err = r.redis.Delete(ctx, key)
 	if err != nil {
 		return fmt.Errorf("failed to delete intent: %w", err)
@@ -181,12 +222,12 @@ func (r *IntentRepository) DeleteIntent(ctx context.Context, intentID string) er
 
 	// Clean up indexes
 	if status.ContractAddress != "" && status.ChainID != "" {
-		contractKey := r.getContractKey(status.ChainID, status.ContractAddress)
+		contractKey := contracts.IntentContractKey(status.ChainID, status.ContractAddress)
 		_ = r.redis.SRem(ctx, contractKey, intentID)
 	}
 
 	if status.TxHash != "" && status.ChainID != "" {
-		txHashKey := r.getTxHashKey(status.ChainID, status.TxHash)
+		txHashKey := contracts.IntentTxHashKey(status.ChainID, status.TxHash)
 		_ = r.redis.SRem(ctx, txHashKey, intentID)
 	}
 
@@ -230,30 +271,10 @@ func (r *IntentRepository) HealthCheck(ctx context.Context) error {
 	return r.redis.HealthCheck(ctx)
 }
 
-// Helper methods
-
-func (r *IntentRepository) addToContractIndex(ctx context.Context, status *domain.IntentStatus) error {
-	key := r.getContractKey(status.ChainID, status.ContractAddress)
-	return r.redis.SAdd(ctx, key, status.IntentID)
-}
-
-func (r *IntentRepository) addToTxHashIndex(ctx context.Context, status *domain.IntentStatus) error {
-	key := r.getTxHashKey(status.ChainID, status.TxHash)
-	return r.redis.SAdd(ctx, key, status.IntentID)
-}
-
-func (r *IntentRepository) getContractKey(chainID, contractAddress string) string {
-	return fmt.Sprintf("%s%s:%s", contractIntentsKeyPrefix, chainID, strings.ToLower(contractAddress))
-}
-
-func (r *IntentRepository) getTxHashKey(chainID, txHash string) string {
-	return fmt.Sprintf("%s%s:%s", txHashIntentsKeyPrefix, chainID, strings.ToLower(txHash))
-}
-
 // GetIntentsByStatus gets intents by status (for monitoring/debugging)
 func (r *IntentRepository) GetIntentsByStatus(ctx context.Context, status string, limit int) ([]*domain.IntentStatus, error) {
 	// This is a more expensive operation as we need to scan keys
-	pattern := intentStatusKeyPrefix + "*"
+	pattern := contracts.IntentStatusKeyPrefix + "*"
 
 	keys, err := r.redis.Keys(ctx, pattern)
 	if err != nil {
@@ -268,7 +289,7 @@ func (r *IntentRepository) GetIntentsByStatus(ctx context.Context, status string
 			break
 		}
 
-		intentID := strings.TrimPrefix(key, intentStatusKeyPrefix)
+		intentID := strings.TrimPrefix(key, contracts.IntentStatusKeyPrefix)
 		intentStatus, err := r.GetIntentStatus(ctx, intentID)
 		if err != nil {
 			continue
@@ -288,7 +309,7 @@ func (r *IntentRepository) GetIntentStatistics(ctx context.Context) (map[string]
 	stats := make(map[string]interface{})
 
 	// Count total intents
-	pattern := intentStatusKeyPrefix + "*"
+	pattern := contracts.IntentStatusKeyPrefix + "*"
 	keys, err := r.redis.Keys(ctx, pattern)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get intent keys: %w", err)
@@ -311,7 +332,7 @@ func (r *IntentRepository) GetIntentStatistics(ctx context.Context) (map[string]
 	}
 
 	for _, key := range keys {
-		intentID := strings.TrimPrefix(key, intentStatusKeyPrefix)
+		intentID := strings.TrimPrefix(key, contracts.IntentStatusKeyPrefix)
 		intentStatus, err := r.GetIntentStatus(ctx, intentID)
 		if err != nil {
 			continue
@@ -328,7 +349,7 @@ func (r *IntentRepository) GetIntentStatistics(ctx context.Context) (map[string]
 // CleanupExpiredIndexes removes expired intents from indexes
 func (r *IntentRepository) CleanupExpiredIndexes(ctx context.Context) error {
 	// Get contract index keys
-	contractKeys, err := r.redis.Keys(ctx, contractIntentsKeyPrefix+"*")
+	contractKeys, err := r.redis.Keys(ctx, contracts.IntentContractKeyPrefix+"*")
 	if err != nil {
 		return fmt.Errorf("failed to get contract keys: %w", err)
 	}
@@ -342,7 +363,7 @@ func (r *IntentRepository) CleanupExpiredIndexes(ctx context.Context) error {
 
 		for _, intentID := range intentIDs {
 			// Check if intent still exists
-			statusKey := intentStatusKeyPrefix + intentID
+			statusKey := contracts.IntentStatusKey(intentID)
 			exists, err := r.redis.Exists(ctx, statusKey)
 			if err != nil || exists == 0 {
 				// Remove from index
@@ -358,7 +379,7 @@ func (r *IntentRepository) CleanupExpiredIndexes(ctx context.Context) error {
 	}
 
 	// Similarly clean up tx hash indexes
-	txHashKeys, err := r.redis.Keys(ctx, txHashIntentsKeyPrefix+"*")
+	txHashKeys, err := r.redis.Keys(ctx, contracts.IntentTxHashKeyPrefix+"*")
 	if err != nil {
 		return fmt.Errorf("failed to get tx hash keys: %w", err)
 	}
@@ -370,7 +391,7 @@ func (r *IntentRepository) CleanupExpiredIndexes(ctx context.Context) error {
 		}
 
 		for _, intentID := range intentIDs {
-			statusKey := intentStatusKeyPrefix + intentID
+			statusKey := contracts.IntentStatusKey(intentID)
 			exists, err := r.redis.Exists(ctx, statusKey)
 			if err != nil || exists == 0 {
 				r.redis.SRem(ctx, txHashKey, intentID)
@@ -0,0 +1,27 @@
+package users
+
+import (
+	"context"
+
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+// RecipientFilter asks the user service which alert recipients may be notified
+type RecipientFilter struct {
+	client userpb.UserServiceClient
+}
+
+func NewRecipientFilter(client userpb.UserServiceClient) *RecipientFilter {
+	return &RecipientFilter{client: client}
+}
+
+func (f *RecipientFilter) FilterRecipients(ctx context.Context, recipients, parties []string) ([]string, error) {
+	resp, err := f.client.FilterNotificationRecipients(ctx, &userpb.FilterNotificationRecipientsRequest{
+		Recipients: recipients,
+		Parties:    parties,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRecipients(), nil
+}
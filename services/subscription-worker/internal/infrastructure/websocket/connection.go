@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 // Connection implements the WebSocketConnection interface
 type Connection struct {
 	id        string
+	userID    string // who redeemed the ticket; empty for internal connections
 	conn      *websocket.Conn
 	send      chan []byte
 	manager   *Manager
@@ -57,11 +59,17 @@ func (c *Connection) Send(message *domain.WebSocketMessage) error {
 		return nil
 	default:
 		// Channel full, close connection
+		c.manager.metrics.queueFull.Add(1)
 		go c.Close()
 		return fmt.Errorf("send channel full, closing connection")
 	}
 }
 
+// queueFill is how full the send queue is, from 0 to 1
+func (c *Connection) queueFill() float64 {
+	return float64(len(c.send)) / float64(cap(c.send))
+}
+
 // Close closes the connection
 func (c *Connection) Close() error {
 	c.closeOnce.Do(func() {
@@ -83,6 +91,11 @@ func (c *Connection) GetID() string {
 	return c.id
 }
 
+// GetUserID returns the user the connection was opened for, or "" for internal connections
+func (c *Connection) GetUserID() string {
+	return c.userID
+}
+
 // GetIntentIDs returns the intent IDs this connection is subscribed to
 func (c *Connection) GetIntentIDs() []string {
 	c.mu.RLock()
@@ -212,6 +225,12 @@ func (c *Connection) handleClientMessage(data []byte) error {
 		if clientMessage.IntentID == "" {
 			return fmt.Errorf("intent_id is required for subscribe")
 		}
+		if !c.mayFollow(clientMessage.IntentID) {
+			response := domain.NewWebSocketMessage("subscribe_denied", clientMessage.IntentID, nil)
+			response.Error = "topic belongs to another user"
+			c.Send(response)
+			return fmt.Errorf("connection %s of user %s may not follow %s", c.id, c.userID, clientMessage.IntentID)
+		}
 		c.AddIntentID(clientMessage.IntentID)
 		c.manager.AddSubscription(clientMessage.IntentID, c.id)
 
@@ -248,6 +267,17 @@ func (c *Connection) handleClientMessage(data []byte) error {
 	return nil
 }
 
+// mayFollow reports whether the connection may subscribe to topic. Sockets opened with a
+// ticket only follow their own user's account events; internal, unticketed ones may follow
+// any user. Other topics aren't scoped: intent and upload topics are named by unguessable
+// ids, and the rest carry public market data.
+func (c *Connection) mayFollow(topic string) bool {
+	if c.userID == "" || !strings.HasPrefix(topic, domain.UserTopic("")) {
+		return true
+	}
+	return topic == domain.UserTopic(c.userID)
+}
+
 // Start starts the connection's read and write pumps
 func (c *Connection) Start() {
 	go c.writePump()
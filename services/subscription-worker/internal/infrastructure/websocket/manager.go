@@ -2,6 +2,7 @@ package websocket
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -13,25 +14,34 @@ import (
 
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/config"
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
 )
 
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
 	CheckOrigin: func(r *http.Request) bool {
-		// In production, implement proper origin checking
+		// Origins are checked against the subscription ticket before upgrading
 		return true
 	},
 }
 
+// TicketConsumer redeems single-use subscription tickets; *redis.Redis implements it
+type TicketConsumer interface {
+	ConsumeSubscriptionTicket(ctx context.Context, ticket string) (contracts.SubscriptionTicket, error)
+}
+
 // Manager manages WebSocket connections and subscriptions
 type Manager struct {
 	config        config.WebSocketConfig
+	tickets       TicketConsumer
 	connections   map[string]*Connection
 	subscriptions map[string]map[string]bool // intentID -> connectionID -> bool
 	mu            sync.RWMutex
 	server        *http.Server
 	isRunning     bool
+	metrics       loadMetrics
 }
 
 // NewManager creates a new WebSocket manager
@@ -43,6 +53,11 @@ func NewManager(config config.WebSocketConfig) *Manager {
 	}
 }
 
+// SetTickets sets where subscription tickets are redeemed (called from main.go)
+func (m *Manager) SetTickets(tickets TicketConsumer) {
+	m.tickets = tickets
+}
+
 // Start starts the WebSocket manager
 func (m *Manager) Start(ctx context.Context) error {
 	m.mu.Lock()
@@ -58,6 +73,8 @@ func (m *Manager) Start(ctx context.Context) error {
 	mux.HandleFunc("/ws", m.handleWebSocket)
 	mux.HandleFunc("/health", m.handleHealth)
 	mux.HandleFunc("/stats", m.handleStats)
+	mux.HandleFunc("/metrics", m.handleMetrics)
+	mux.HandleFunc("/scaling-hints", m.handleScalingHints)
 
 	// Create HTTP server
 	addr := fmt.Sprintf("%s:%s", m.config.Host, m.config.Port)
@@ -70,6 +87,7 @@ func (m *Manager) Start(ctx context.Context) error {
 
 	// Start cleanup routine
 	go m.cleanupRoutine(ctx)
+	go m.sampleRoutine(ctx)
 
 	// Start server
 	go func() {
@@ -86,16 +104,21 @@ func (m *Manager) Start(ctx context.Context) error {
 // Stop stops the WebSocket manager
 func (m *Manager) Stop(ctx context.Context) error {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	if !m.isRunning {
+		m.mu.Unlock()
 		return nil
 	}
+	m.isRunning = false
+	connections := make([]*Connection, 0, len(m.connections))
+	for _, conn := range m.connections {
+		connections = append(connections, conn)
+	}
+	m.mu.Unlock()
 
 	log.Println("Stopping WebSocket manager...")
 
-	// Close all connections
-	for _, conn := range m.connections {
+	// Closed outside the lock, since each removes itself from the manager
+	for _, conn := range connections {
 		conn.Close()
 	}
 
@@ -109,7 +132,6 @@ func (m *Manager) Stop(ctx context.Context) error {
 		}
 	}
 
-	m.isRunning = false
 	log.Println("WebSocket manager stopped")
 	return nil
 }
@@ -181,6 +203,7 @@ func (m *Manager) SendToIntent(intentID string, message *domain.WebSocketMessage
 		}
 	}
 
+	m.metrics.fannedOut.Add(int64(sentCount))
 	log.Printf("Sent message to %d/%d subscribers for intent %s", sentCount, len(subscribers), intentID)
 
 	if len(errors) > 0 {
@@ -250,6 +273,11 @@ func (m *Manager) RemoveSubscription(intentID, connID string) {
 // HTTP handlers
 
 func (m *Manager) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	userID, ok := m.authorize(w, r)
+	if !ok {
+		return
+	}
+
 	// Upgrade HTTP connection to WebSocket
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -262,6 +290,7 @@ func (m *Manager) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 
 	// Create connection wrapper
 	wsConn := NewConnection(connID, conn, m)
+	wsConn.userID = userID
 
 	// Add to manager
 	if err := m.AddConnection(wsConn); err != nil {
@@ -274,6 +303,43 @@ func (m *Manager) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 	wsConn.Start()
 }
 
+// authorize redeems the ticket a socket is opened with, before upgrading, and returns the
+// user it was issued to. Browsers always send an Origin, so their sockets need a ticket
+// issued to that origin; services on the internal network may connect without either when
+// AllowUnticketed is set. Failures are answered here.
+func (m *Manager) authorize(w http.ResponseWriter, r *http.Request) (string, bool) {
+	ticket := r.URL.Query().Get("ticket")
+	origin := r.Header.Get("Origin")
+	if ticket == "" {
+		if origin == "" && m.config.AllowUnticketed {
+			return "", true
+		}
+		http.Error(w, "subscription ticket required", http.StatusUnauthorized)
+		return "", false
+	}
+	if m.tickets == nil {
+		http.Error(w, "subscription tickets unavailable", http.StatusServiceUnavailable)
+		return "", false
+	}
+
+	rec, err := m.tickets.ConsumeSubscriptionTicket(r.Context(), ticket)
+	if errors.Is(err, redis.ErrSubscriptionTicketNotFound) {
+		http.Error(w, "invalid or expired subscription ticket", http.StatusUnauthorized)
+		return "", false
+	}
+	if err != nil {
+		log.Printf("Failed to redeem subscription ticket: %v", err)
+		http.Error(w, "subscription tickets unavailable", http.StatusServiceUnavailable)
+		return "", false
+	}
+	if rec.Origin != "" && rec.Origin != origin {
+		log.Printf("Rejected subscription ticket of user %s: issued to %q, used from %q", rec.UserID, rec.Origin, origin)
+		http.Error(w, "subscription ticket was issued to another origin", http.StatusForbidden)
+		return "", false
+	}
+	return rec.UserID, true
+}
+
 func (m *Manager) handleHealth(w http.ResponseWriter, r *http.Request) {
 	if err := m.HealthCheck(); err != nil {
 		http.Error(w, err.Error(), http.StatusServiceUnavailable)
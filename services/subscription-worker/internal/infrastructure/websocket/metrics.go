@@ -0,0 +1,253 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+)
+
+const (
+	// fanoutSampleInterval and fanoutSamples make the fan-out rate a one-minute average
+	fanoutSampleInterval = 5 * time.Second
+	fanoutSamples        = 13
+	// saturatedQueueFill is how full a send queue is before its client counts as lagging
+	saturatedQueueFill = 0.5
+
+	RecommendScaleUp   = "scale_up"
+	RecommendSteady    = "steady"
+	RecommendScaleDown = "scale_down"
+)
+
+// loadMetrics counts what the manager sends, for /metrics and /scaling-hints
+type loadMetrics struct {
+	fannedOut atomic.Int64 // messages queued to subscribers of a topic
+	queueFull atomic.Int64 // messages refused by a full send queue
+
+	mu      sync.Mutex
+	samples []fanoutSample
+}
+
+type fanoutSample struct {
+	at    time.Time
+	total int64
+}
+
+// sample records the fan-out total, keeping the last fanoutSamples
+func (l *loadMetrics) sample(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.samples = append(l.samples, fanoutSample{at: now, total: l.fannedOut.Load()})
+	if len(l.samples) > fanoutSamples {
+		l.samples = l.samples[len(l.samples)-fanoutSamples:]
+	}
+}
+
+// fanoutRate is messages fanned out per second over the sampled window
+func (l *loadMetrics) fanoutRate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.samples) < 2 {
+		return 0
+	}
+	first, last := l.samples[0], l.samples[len(l.samples)-1]
+	elapsed := last.at.Sub(first.at).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(last.total-first.total) / elapsed
+}
+
+// sampleRoutine samples the fan-out total until ctx is done
+func (m *Manager) sampleRoutine(ctx context.Context) {
+	ticker := time.NewTicker(fanoutSampleInterval)
+	defer ticker.Stop()
+
+	m.SampleFanout(time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.SampleFanout(now)
+		}
+	}
+}
+
+// SampleFanout records the fan-out total at now; the rate Load reports spans the last
+// fanoutSamples samples. Start samples every fanoutSampleInterval.
+func (m *Manager) SampleFanout(now time.Time) {
+	m.metrics.sample(now)
+}
+
+// TopicLoad is the subscriptions of one kind of topic
+type TopicLoad struct {
+	Topics      int `json:"topics"`
+	Subscribers int `json:"subscribers"`
+}
+
+// LoadSnapshot is the load of this replica at one moment
+type LoadSnapshot struct {
+	Connections    int `json:"connections"`
+	MaxConnections int `json:"max_connections"`
+	// ConnectionUtilization is Connections against MaxConnections
+	ConnectionUtilization float64 `json:"connection_utilization"`
+	FanoutPerSecond       float64 `json:"fanout_per_second"`
+	TargetFanoutPerSecond float64 `json:"target_fanout_per_second"`
+	FannedOutTotal        int64   `json:"fanned_out_total"`
+	QueueFullTotal        int64   `json:"send_queue_full_total"`
+	// AvgQueueFill and MaxQueueFill are how full the connections' send queues are, 0 to 1
+	AvgQueueFill float64 `json:"avg_send_queue_fill"`
+	MaxQueueFill float64 `json:"max_send_queue_fill"`
+	// SaturatedConnections have send queues at least half full, i.e. clients falling behind
+	SaturatedConnections int                  `json:"saturated_connections"`
+	TopicsByKind         map[string]TopicLoad `json:"topics"`
+	// Load is the highest of connection use, fan-out against target and average queue fill
+	Load           float64  `json:"load"`
+	Recommendation string   `json:"recommendation"`
+	Reasons        []string `json:"reasons"`
+}
+
+// topicKinds are the prefixes of the subscription keys this worker sends to
+var topicKinds = map[string]bool{"address": true, "auction": true, "drops": true, "user": true, "upload": true, "mint_stats": true}
+
+// topicKind groups a subscription key by what it follows, so per-topic metrics stay a
+// handful of series however many auctions or wallets are watched. Plain keys are intents;
+// clients may subscribe to any key, so unknown prefixes are "other".
+func topicKind(topic string) string {
+	kind, _, ok := strings.Cut(topic, ":")
+	if !ok {
+		return "intent"
+	}
+	if !topicKinds[kind] {
+		return "other"
+	}
+	return kind
+}
+
+// Load measures this replica's load and recommends whether to scale
+func (m *Manager) Load() LoadSnapshot {
+	snap := LoadSnapshot{
+		MaxConnections:        m.config.MaxConnections,
+		FanoutPerSecond:       m.metrics.fanoutRate(),
+		TargetFanoutPerSecond: m.config.Scaling.TargetFanoutPerSecond,
+		FannedOutTotal:        m.metrics.fannedOut.Load(),
+		QueueFullTotal:        m.metrics.queueFull.Load(),
+		TopicsByKind:          make(map[string]TopicLoad),
+		Reasons:               []string{},
+	}
+
+	m.mu.RLock()
+	snap.Connections = len(m.connections)
+	var fillSum float64
+	for _, conn := range m.connections {
+		fill := conn.queueFill()
+		fillSum += fill
+		snap.MaxQueueFill = max(snap.MaxQueueFill, fill)
+		if fill >= saturatedQueueFill {
+			snap.SaturatedConnections++
+		}
+	}
+	for topic, subscribers := range m.subscriptions {
+		kind := topicKind(topic)
+		load := snap.TopicsByKind[kind]
+		load.Topics++
+		load.Subscribers += len(subscribers)
+		snap.TopicsByKind[kind] = load
+	}
+	m.mu.RUnlock()
+
+	if snap.Connections > 0 {
+		snap.AvgQueueFill = fillSum / float64(snap.Connections)
+	}
+	if snap.MaxConnections > 0 {
+		snap.ConnectionUtilization = float64(snap.Connections) / float64(snap.MaxConnections)
+	}
+	var fanoutUtilization float64
+	if snap.TargetFanoutPerSecond > 0 {
+		fanoutUtilization = snap.FanoutPerSecond / snap.TargetFanoutPerSecond
+	}
+	snap.Load = max(snap.ConnectionUtilization, fanoutUtilization, snap.AvgQueueFill)
+
+	scaling := m.config.Scaling
+	switch {
+	case snap.Load >= scaling.ScaleUpLoad:
+		snap.Recommendation = RecommendScaleUp
+		if snap.ConnectionUtilization >= scaling.ScaleUpLoad {
+			snap.Reasons = append(snap.Reasons, fmt.Sprintf("%d of %d connections in use", snap.Connections, snap.MaxConnections))
+		}
+		if fanoutUtilization >= scaling.ScaleUpLoad {
+			snap.Reasons = append(snap.Reasons, fmt.Sprintf("fanning out %.0f messages/s against a target of %.0f", snap.FanoutPerSecond, snap.TargetFanoutPerSecond))
+		}
+		if snap.AvgQueueFill >= scaling.ScaleUpLoad {
+			snap.Reasons = append(snap.Reasons, fmt.Sprintf("send queues %.0f%% full on average, %d clients falling behind", snap.AvgQueueFill*100, snap.SaturatedConnections))
+		}
+	case snap.Load <= scaling.ScaleDownLoad:
+		snap.Recommendation = RecommendScaleDown
+		snap.Reasons = append(snap.Reasons, fmt.Sprintf("load %.2f is at or below %.2f", snap.Load, scaling.ScaleDownLoad))
+	default:
+		snap.Recommendation = RecommendSteady
+	}
+	if snap.SaturatedConnections > 0 && snap.Recommendation != RecommendScaleUp {
+		// A few slow clients back up their own queues; more replicas would not help them
+		snap.Reasons = append(snap.Reasons, fmt.Sprintf("%d clients falling behind", snap.SaturatedConnections))
+	}
+	return snap
+}
+
+// handleScalingHints summarizes load for operators and autoscalers
+func (m *Manager) handleScalingHints(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(m.Load())
+}
+
+// handleMetrics serves the load in the Prometheus text format. subscription_worker_load
+// is the gauge to point an autoscaler at.
+func (m *Manager) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	snap := m.Load()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	monitoring.WriteMetricHeader(w, "subscription_worker_connections", "gauge", "Open WebSocket connections.")
+	fmt.Fprintf(w, "subscription_worker_connections %d\n", snap.Connections)
+	monitoring.WriteMetricHeader(w, "subscription_worker_max_connections", "gauge", "Connections this replica accepts.")
+	fmt.Fprintf(w, "subscription_worker_max_connections %d\n", snap.MaxConnections)
+	monitoring.WriteMetricHeader(w, "subscription_worker_messages_fanned_out_total", "counter", "Messages queued to topic subscribers.")
+	fmt.Fprintf(w, "subscription_worker_messages_fanned_out_total %d\n", snap.FannedOutTotal)
+	monitoring.WriteMetricHeader(w, "subscription_worker_fanout_per_second", "gauge", "Messages fanned out per second over the last minute.")
+	fmt.Fprintf(w, "subscription_worker_fanout_per_second %g\n", snap.FanoutPerSecond)
+	monitoring.WriteMetricHeader(w, "subscription_worker_send_queue_full_total", "counter", "Messages refused by a full send queue, each closing its connection.")
+	fmt.Fprintf(w, "subscription_worker_send_queue_full_total %d\n", snap.QueueFullTotal)
+	monitoring.WriteMetricHeader(w, "subscription_worker_send_queue_fill", "gauge", "How full connections' send queues are, from 0 to 1.")
+	fmt.Fprintf(w, "subscription_worker_send_queue_fill{stat=\"avg\"} %g\n", snap.AvgQueueFill)
+	fmt.Fprintf(w, "subscription_worker_send_queue_fill{stat=\"max\"} %g\n", snap.MaxQueueFill)
+	monitoring.WriteMetricHeader(w, "subscription_worker_saturated_connections", "gauge", "Connections with send queues at least half full.")
+	fmt.Fprintf(w, "subscription_worker_saturated_connections %d\n", snap.SaturatedConnections)
+
+	kinds := make([]string, 0, len(snap.TopicsByKind))
+	for kind := range snap.TopicsByKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	monitoring.WriteMetricHeader(w, "subscription_worker_topics", "gauge", "Topics with subscribers, by kind of topic.")
+	for _, kind := range kinds {
+		fmt.Fprintf(w, "subscription_worker_topics{kind=%q} %d\n", kind, snap.TopicsByKind[kind].Topics)
+	}
+	monitoring.WriteMetricHeader(w, "subscription_worker_topic_subscribers", "gauge", "Subscriptions, by kind of topic.")
+	for _, kind := range kinds {
+		fmt.Fprintf(w, "subscription_worker_topic_subscribers{kind=%q} %d\n", kind, snap.TopicsByKind[kind].Subscribers)
+	}
+
+	monitoring.WriteMetricHeader(w, "subscription_worker_load", "gauge", "Highest of connection use, fan-out against target and average send-queue fill.")
+	fmt.Fprintf(w, "subscription_worker_load %g\n", snap.Load)
+}
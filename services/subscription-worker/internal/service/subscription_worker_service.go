@@ -2,19 +2,35 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 	redisClient "github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/webhook"
 )
 
+// maxIntentUpdateAttempts bounds retries when an intent changes while being updated
+const maxIntentUpdateAttempts = 3
+
+// recipientFilterTimeout bounds the user-service lookup before an alert goes out unfiltered
+const recipientFilterTimeout = 2 * time.Second
+
 type SubscriptionWorkerService struct {
 	intentRepo domain.IntentRepository
 	wsManager  domain.WebSocketManager
+	recipients domain.RecipientFilter
+	// optional; intent callbacks are dropped without them
+	webhooks      domain.IntentWebhookSender
+	webhookSecret []byte
+	webhookCtx    context.Context
+	deliveries    sync.WaitGroup
 }
 
 // NewSubscriptionWorkerService creates a new subscription worker service
@@ -30,6 +46,53 @@ func NewSubscriptionWorkerService(
 	}
 }
 
+// SetStatusReplica reads intent statuses from a regional replica of the status Redis;
+// writes still go to the primary. Run RunStatusHeartbeat so pushed statuses carry a bound
+// on the replica's lag.
+func (s *SubscriptionWorkerService) SetStatusReplica(replica *redisClient.Redis) {
+	if repo, ok := s.intentRepo.(*repository.IntentRepository); ok {
+		repo.SetReplica(replica)
+	}
+}
+
+// RunStatusHeartbeat measures the status replica's lag every interval until ctx is done
+func (s *SubscriptionWorkerService) RunStatusHeartbeat(ctx context.Context, interval time.Duration) {
+	if repo, ok := s.intentRepo.(*repository.IntentRepository); ok {
+		repo.RunHeartbeat(ctx, interval)
+	}
+}
+
+// SetRecipientFilter drops market alerts to recipients who blocked or muted the other
+// parties; without one every recipient is notified
+func (s *SubscriptionWorkerService) SetRecipientFilter(filter domain.RecipientFilter) {
+	s.recipients = filter
+}
+
+// SetWebhooks delivers intent callbacks signed with secret, which the orchestrator must
+// share; without it callback URLs are ignored. Deliveries still retrying when ctx ends are
+// abandoned.
+func (s *SubscriptionWorkerService) SetWebhooks(ctx context.Context, sender domain.IntentWebhookSender, secret []byte) {
+	s.webhooks = sender
+	s.webhookSecret = secret
+	s.webhookCtx = ctx
+}
+
+// WaitWebhooks blocks until the deliveries under way finish or ctx ends. Stop the
+// consumers first so no new ones start.
+func (s *SubscriptionWorkerService) WaitWebhooks(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.deliveries.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // HandleCollectionDomainEvent processes a collection domain event from the catalog service
 func (s *SubscriptionWorkerService) HandleCollectionDomainEvent(ctx context.Context, event *domain.DomainEvent) error {
 	if event == nil {
@@ -76,7 +139,7 @@ func (s *SubscriptionWorkerService) ProcessCollectionUpserted(ctx context.Contex
 			log.Printf("Failed to get intents by tx hash: %v", err)
 		} else {
 			for _, intent := range txIntents {
-				if intent.Status == "pending" || intent.Status == "processing" {
+				if intent.Unresolved() {
 					if err := s.resolveIntentWithCollection(ctx, intent, event); err != nil {
 						log.Printf("Failed to resolve intent %s by tx hash: %v", intent.IntentID, err)
 						continue
@@ -116,50 +179,247 @@ func (s *SubscriptionWorkerService) ProcessCollectionUpserted(ctx context.Contex
 	return nil
 }
 
-// resolveIntentWithCollection resolves an intent using collection data
-func (s *SubscriptionWorkerService) resolveIntentWithCollection(ctx context.Context, intent *domain.IntentStatus, event *domain.DomainEvent) error {
-	// Update intent status to ready (per CREATE.md line 82)
-	intent.Status = "ready"
-	intent.ContractAddress = event.Data["contract_address"].(string)
-	intent.ChainID = event.ChainID
+// HandleMarketAlert pushes an offer.expiring_soon, listing.expiring_soon, auction.ended or
+// drop.starting_soon alert to every recipient address subscribed over WebSocket. Drop alerts
+// also go to the chain's drop calendar topic.
+func (s *SubscriptionWorkerService) HandleMarketAlert(ctx context.Context, event *domain.DomainEvent) error {
+	if event == nil {
+		return fmt.Errorf("domain event cannot be nil")
+	}
 
-	// Add collection data to intent
-	if intent.Data == nil {
-		intent.Data = make(map[string]interface{})
+	if event.EventType == domain.EventDropStartingSoon {
+		topic := domain.DropsTopic(event.ChainID)
+		if err := s.wsManager.SendToIntent(topic, domain.NewWebSocketMessage(event.EventType, topic, event.Data)); err != nil {
+			log.Printf("Failed to push %s for %s: %v", event.EventType, event.AggregateID, err)
+		}
 	}
 
-	// Copy relevant collection data
-	intent.Data["collection_id"] = event.AggregateID
-	intent.Data["collection_name"] = event.Data["name"]
-	intent.Data["collection_symbol"] = event.Data["symbol"]
-	intent.Data["contract_address"] = event.Data["contract_address"]
-	intent.Data["creator"] = event.Data["creator"]
-	intent.Data["collection_type"] = event.Data["collection_type"]
-	intent.Data["chain_id"] = event.ChainID
+	recipients, ok := event.Data["recipients"].([]interface{})
+	if !ok {
+		return fmt.Errorf("recipients not found in event data")
+	}
 
-	if txHash, exists := event.Data["tx_hash"]; exists {
-		intent.TxHash = txHash.(string)
-		intent.Data["tx_hash"] = txHash
+	var addresses []string
+	for _, raw := range recipients {
+		if address, ok := raw.(string); ok && address != "" {
+			addresses = append(addresses, address)
+		}
 	}
+	addresses = s.filterRecipients(ctx, addresses)
 
-	intent.UpdatedAt = time.Now()
+	for _, address := range addresses {
+		topic := domain.AddressTopic(address)
+		message := domain.NewWebSocketMessage(event.EventType, topic, event.Data)
+		if err := s.wsManager.SendToIntent(topic, message); err != nil {
+			log.Printf("Failed to push %s to %s: %v", event.EventType, address, err)
+		}
+	}
 
-	// Update intent in Redis
-	if err := s.intentRepo.UpdateIntentStatus(ctx, intent); err != nil {
-		return fmt.Errorf("failed to update intent status: %w", err)
+	log.Printf("Pushed %s for %s to %d of %d recipients", event.EventType, event.AggregateID, len(addresses), len(recipients))
+	return nil
+}
+
+// filterRecipients drops recipients who blocked or muted another recipient of the alert,
+// since those are its parties. Alerts go out unfiltered when the user service fails.
+func (s *SubscriptionWorkerService) filterRecipients(ctx context.Context, addresses []string) []string {
+	if s.recipients == nil || len(addresses) < 2 {
+		return addresses
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, recipientFilterTimeout)
+	defer cancel()
+	kept, err := s.recipients.FilterRecipients(ctx, addresses, addresses)
+	if err != nil {
+		log.Printf("Failed to filter alert recipients, notifying all: %v", err)
+		return addresses
+	}
+
+	allowed := make(map[string]bool, len(kept))
+	for _, address := range kept {
+		allowed[address] = true
+	}
+	filtered := addresses[:0]
+	for _, address := range addresses {
+		if allowed[strings.ToLower(address)] {
+			filtered = append(filtered, address)
+		}
+	}
+	return filtered
+}
+
+// HandleAuctionBid streams an auction.bid_placed event to every client watching the auction
+func (s *SubscriptionWorkerService) HandleAuctionBid(ctx context.Context, event *domain.DomainEvent) error {
+	if event == nil {
+		return fmt.Errorf("domain event cannot be nil")
+	}
+
+	auctionID, ok := event.Data["auction_id"].(string)
+	if !ok || auctionID == "" {
+		return fmt.Errorf("auction_id not found in event data")
+	}
+
+	topic := domain.AuctionTopic(event.ChainID, auctionID)
+	message := domain.NewWebSocketMessage(event.EventType, topic, event.Data)
+	if err := s.wsManager.SendToIntent(topic, message); err != nil {
+		log.Printf("Failed to push bid on auction %s: %v", auctionID, err)
+	}
+
+	return nil
+}
+
+// HandleAccountEvent pushes a wallet, auth or profile event to every connection
+// following the user, so each of their tabs and devices sees it
+func (s *SubscriptionWorkerService) HandleAccountEvent(ctx context.Context, event *contracts.AccountEvent) error {
+	if event == nil {
+		return fmt.Errorf("account event cannot be nil")
 	}
 
-	// Notify WebSocket subscribers
-	if err := s.ResolveIntent(ctx, intent.IntentID, intent); err != nil {
-		log.Printf("Failed to notify WebSocket subscribers for intent %s: %v", intent.IntentID, err)
+	topic := domain.UserTopic(event.UserID)
+	message := domain.NewWebSocketMessage(domain.MessageAccountEvent, topic, event)
+	if err := s.wsManager.SendToIntent(topic, message); err != nil {
+		log.Printf("Failed to push %s to user %s: %v", event.Type, event.UserID, err)
+	}
+
+	return nil
+}
+
+// HandleUploadProgress pushes a media upload progress report to the clients following
+// its ticket. Reports nobody follows are dropped; the next one supersedes them anyway.
+func (s *SubscriptionWorkerService) HandleUploadProgress(progress contracts.UploadProgress) {
+	topic := contracts.UploadTopic(progress.Ticket)
+	message := domain.NewWebSocketMessage(domain.MessageUploadProgress, topic, progress)
+	if err := s.wsManager.SendToIntent(topic, message); err != nil {
+		log.Printf("Failed to push %s progress of upload %s: %v", progress.Stage, progress.Ticket, err)
+	}
+}
+
+// HandleMintStats pushes an updated mint stats bucket to the dashboards following its
+// collection. Updates nobody follows are dropped.
+func (s *SubscriptionWorkerService) HandleMintStats(update contracts.MintStatsUpdate) {
+	topic := contracts.MintStatsTopic(update.ChainID, update.ContractAddress)
+	message := domain.NewWebSocketMessage(domain.MessageMintStats, topic, update)
+	if err := s.wsManager.SendToIntent(topic, message); err != nil {
+		log.Printf("Failed to push mint stats of %s: %v", update.ContractAddress, err)
+	}
+}
+
+// resolveIntentWithCollection resolves an intent using collection data. Subscribers are
+// notified through the intent status channel once the write lands.
+func (s *SubscriptionWorkerService) resolveIntentWithCollection(ctx context.Context, intent *domain.IntentStatus, event *domain.DomainEvent) error {
+	resolved := false
+	err := s.updateIntent(ctx, intent, func(intent *domain.IntentStatus) bool {
+		// Another writer may have resolved or failed the intent since it was matched
+		resolved = intent.Unresolved()
+		if !resolved {
+			return false
+		}
+
+		// Update intent status to ready (per CREATE.md line 82)
+		intent.Status = "ready"
+		intent.ContractAddress = event.Data["contract_address"].(string)
+		intent.ChainID = event.ChainID
+
+		// Add collection data to intent
+		if intent.Data == nil {
+			intent.Data = make(map[string]interface{})
+		}
+
+		// Copy relevant collection data
+		intent.Data["collection_id"] = event.AggregateID
+		intent.Data["collection_name"] = event.Data["name"]
+		intent.Data["collection_symbol"] = event.Data["symbol"]
+		intent.Data["contract_address"] = event.Data["contract_address"]
+		intent.Data["creator"] = event.Data["creator"]
+		intent.Data["collection_type"] = event.Data["collection_type"]
+		intent.Data["chain_id"] = event.ChainID
+
+		if txHash, exists := event.Data["tx_hash"]; exists {
+			intent.TxHash = txHash.(string)
+			intent.Data["tx_hash"] = txHash
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update intent status: %w", err)
+	}
+	if resolved {
+		s.sendIntentWebhook(intent)
 	}
 
 	log.Printf("Successfully resolved intent: %s -> %s", intent.IntentID, intent.Status)
 	return nil
 }
 
+// sendIntentWebhook tells the creator's backend the intent confirmed. Delivery retries in
+// the background, so a slow receiver never holds up event processing.
+func (s *SubscriptionWorkerService) sendIntentWebhook(intent *domain.IntentStatus) {
+	if intent.CallbackURL == "" {
+		return
+	}
+	if s.webhooks == nil {
+		log.Printf("Dropping webhook for intent %s: webhooks are not configured", intent.IntentID)
+		return
+	}
+
+	intentID, callbackURL := intent.IntentID, intent.CallbackURL
+	secret := webhook.IntentSecret(s.webhookSecret, intentID)
+	event := webhook.NewEvent(webhook.EventIntentConfirmed, webhook.IntentConfirmed{
+		IntentID:        intent.IntentID,
+		Kind:            intent.Kind,
+		ChainID:         intent.ChainID,
+		TxHash:          intent.TxHash,
+		ContractAddress: intent.ContractAddress,
+	})
+	if s.webhookCtx.Err() != nil {
+		log.Printf("Dropping webhook for intent %s: shutting down", intentID)
+		return
+	}
+	s.deliveries.Add(1)
+	go func() {
+		defer s.deliveries.Done()
+		if err := s.webhooks.Deliver(s.webhookCtx, callbackURL, secret, event); err != nil {
+			log.Printf("Failed to deliver webhook for intent %s: %v", intentID, err)
+			return
+		}
+		log.Printf("Delivered %s webhook %s for intent %s", event.Type, event.ID, intentID)
+	}()
+}
+
+// updateIntent applies apply and writes the intent, re-reading and re-applying when
+// another writer got there first. apply returns false to leave the intent unchanged.
+func (s *SubscriptionWorkerService) updateIntent(ctx context.Context, intent *domain.IntentStatus, apply func(*domain.IntentStatus) bool) error {
+	for attempt := 1; ; attempt++ {
+		if !apply(intent) {
+			return nil
+		}
+
+		err := s.intentRepo.UpdateIntentStatus(ctx, intent)
+		if !errors.Is(err, domain.ErrIntentStatusConflict) || attempt == maxIntentUpdateAttempts {
+			return err
+		}
+
+		latest, err := s.intentRepo.GetLatestIntentStatus(ctx, intent.IntentID)
+		if err != nil {
+			return err
+		}
+		*intent = *latest
+	}
+}
+
+// HandleIntentStatusChanged pushes a status written by any service to the intent's subscribers
+func (s *SubscriptionWorkerService) HandleIntentStatusChanged(rec contracts.IntentStatusRecord) {
+	if err := s.ResolveIntent(context.Background(), rec.IntentID, domain.IntentStatusFromRecord(rec)); err != nil {
+		log.Printf("Failed to notify WebSocket subscribers for intent %s: %v", rec.IntentID, err)
+	}
+}
+
 // ResolveIntent resolves an intent and notifies subscribers
 func (s *SubscriptionWorkerService) ResolveIntent(ctx context.Context, intentID string, status *domain.IntentStatus) error {
+	if staleness, ok := s.intentRepo.Staleness(); ok {
+		ms := staleness.Milliseconds()
+		status.StalenessMs = &ms
+	}
+
 	// Create WebSocket message
 	message := domain.NewStatusUpdateMessage(intentID, status)
 
@@ -278,46 +538,42 @@ func (s *SubscriptionWorkerService) ProcessIntentUpdate(ctx context.Context, int
 
 	// Apply updates
 	updated := false
+	err = s.updateIntent(ctx, intent, func(intent *domain.IntentStatus) bool {
+		updated = false
 
-	if status, ok := updates["status"].(string); ok {
-		intent.Status = status
-		updated = true
-	}
-
-	if txHash, ok := updates["tx_hash"].(string); ok {
-		intent.TxHash = txHash
-		updated = true
-	}
-
-	if contractAddress, ok := updates["contract_address"].(string); ok {
-		intent.ContractAddress = contractAddress
-		updated = true
-	}
-
-	if data, ok := updates["data"].(map[string]interface{}); ok {
-		if intent.Data == nil {
-			intent.Data = make(map[string]interface{})
+		if status, ok := updates["status"].(string); ok {
+			intent.Status = status
+			updated = true
 		}
-		for k, v := range data {
-			intent.Data[k] = v
+
+		if txHash, ok := updates["tx_hash"].(string); ok {
+			intent.TxHash = txHash
+			updated = true
 		}
-		updated = true
-	}
 
-	if !updated {
-		return fmt.Errorf("no valid updates provided")
-	}
+		if contractAddress, ok := updates["contract_address"].(string); ok {
+			intent.ContractAddress = contractAddress
+			updated = true
+		}
 
-	intent.UpdatedAt = time.Now()
+		if data, ok := updates["data"].(map[string]interface{}); ok {
+			if intent.Data == nil {
+				intent.Data = make(map[string]interface{})
+			}
+			for k, v := range data {
+				intent.Data[k] = v
+			}
+			updated = true
+		}
 
-	// Save updates
-	if err := s.intentRepo.UpdateIntentStatus(ctx, intent); err != nil {
+		return updated
+	})
+	if err != nil {
 		return fmt.Errorf("failed to update intent: %w", err)
 	}
 
-	// Notify subscribers
-	if err := s.ResolveIntent(ctx, intentID, intent); err != nil {
-		log.Printf("Failed to notify subscribers of intent update: %v", err)
+	if !updated {
+		return fmt.Errorf("no valid updates provided")
 	}
 
 	log.Printf("Processed intent update: %s", intentID)
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
+)
+
+func TestConsumeIntentStatusStream_RetriesUntilCancelled(t *testing.T) {
+	host, port, err := net.SplitHostPort(testharness.FreeAddr(t))
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	// Nothing listens on the reserved port, so every call fails
+	client, err := redis.NewRedis(redis.RedisConfig{RedisHost: host, RedisPort: portNum})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	err = client.ConsumeIntentStatusStream(ctx, "worker-a", "worker-a", func(contracts.IntentStatusRecord) {
+		t.Error("no status can be read from an unreachable redis")
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.GreaterOrEqual(t, time.Since(started), 500*time.Millisecond, "returned before ctx was done")
+}
+
+// statusCollector records the statuses a consumer is handed
+type statusCollector struct {
+	mu   sync.Mutex
+	seen map[string]string
+}
+
+func (c *statusCollector) handle(rec contracts.IntentStatusRecord) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seen[rec.IntentID] = rec.Status
+}
+
+func (c *statusCollector) status(intentID string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.seen[intentID]
+}
+
+func TestConsumeIntentStatusStream_RecoversLostGroup(t *testing.T) {
+	h := testharness.New(t)
+	rds, _ := h.Redis(t)
+	rds.SetIntentStatusStream(1000)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collector := &statusCollector{seen: map[string]string{}}
+	done := make(chan error, 1)
+	go func() { done <- rds.ConsumeIntentStatusStream(ctx, "worker-a", "worker-a", collector.handle) }()
+
+	write := func(intentID, status string) {
+		t.Helper()
+		_, err := rds.WriteIntentStatuses(ctx, redis.IntentStatusUpdate{
+			Record: contracts.IntentStatusRecord{IntentID: intentID, Status: status, UpdatedAt: time.Now()},
+			TTL:    time.Minute,
+		})
+		require.NoError(t, err)
+	}
+	groups := func() []string {
+		infos, err := rds.GetClient().XInfoGroups(ctx, contracts.IntentStatusStream).Result()
+		require.NoError(t, err)
+		names := make([]string, len(infos))
+		for i, info := range infos {
+			names[i] = info.Name
+		}
+		return names
+	}
+
+	require.Eventually(t, func() bool {
+		infos, err := rds.GetClient().XInfoGroups(ctx, contracts.IntentStatusStream).Result()
+		return err == nil && len(infos) == 1
+	}, 10*time.Second, 50*time.Millisecond)
+	write("intent-1", "pending")
+	require.Eventually(t, func() bool { return collector.status("intent-1") == "pending" }, 10*time.Second, 50*time.Millisecond)
+
+	// Losing the group fails the blocked read; the consumer recreates it and carries on
+	require.NoError(t, rds.DestroyIntentStatusGroup(ctx, "worker-a"))
+	require.Eventually(t, func() bool {
+		write("intent-2", "confirmed")
+		time.Sleep(100 * time.Millisecond)
+		return collector.status("intent-2") == "confirmed"
+	}, 20*time.Second, 200*time.Millisecond)
+
+	select {
+	case err := <-done:
+		t.Fatalf("consumer stopped before ctx was done: %v", err)
+	default:
+	}
+	cancel()
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(10 * time.Second):
+		t.Fatal("consumer did not stop after ctx was done")
+	}
+
+	ctx = context.Background()
+	assert.Equal(t, []string{"worker-a"}, groups())
+	require.NoError(t, rds.DestroyIntentStatusGroup(ctx, "worker-a"))
+	assert.Empty(t, groups(), "a stopped worker's group is removed")
+}
@@ -0,0 +1,219 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/infrastructure/websocket"
+)
+
+func newLoadManager(maxConnections int, targetFanout float64) *websocket.Manager {
+	return websocket.NewManager(config.WebSocketConfig{
+		MaxConnections: maxConnections,
+		Scaling: config.ScalingConfig{
+			TargetFanoutPerSecond: targetFanout,
+			ScaleUpLoad:           0.75,
+			ScaleDownLoad:         0.25,
+		},
+	})
+}
+
+// addConnections registers idle connections; they are never started, so nothing drains
+// their send queues
+func addConnections(t *testing.T, m *websocket.Manager, n int) []string {
+	t.Helper()
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("conn-%d", i)
+		require.NoError(t, m.AddConnection(websocket.NewConnection(ids[i], nil, m)))
+	}
+	return ids
+}
+
+// queueMessages fills a connection's send queue, which holds 256 messages
+func queueMessages(t *testing.T, m *websocket.Manager, connID string, n int) {
+	t.Helper()
+	message := domain.NewWebSocketMessage(domain.MessageUploadProgress, "upload:ticket", nil)
+	for i := 0; i < n; i++ {
+		require.NoError(t, m.SendToConnection(connID, message))
+	}
+}
+
+// fanOut sends n messages to one subscriber of topic
+func fanOut(t *testing.T, m *websocket.Manager, connID, topic string, n int) {
+	t.Helper()
+	m.AddSubscription(topic, connID)
+	message := domain.NewWebSocketMessage(domain.MessageUploadProgress, topic, nil)
+	for i := 0; i < n; i++ {
+		require.NoError(t, m.SendToIntent(topic, message))
+	}
+}
+
+func TestLoad_Recommendations(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, m *websocket.Manager)
+		want    string
+		reasons []string
+	}{
+		{
+			name:    "idle replica scales down",
+			setup:   func(t *testing.T, m *websocket.Manager) {},
+			want:    websocket.RecommendScaleDown,
+			reasons: []string{"load 0.00 is at or below 0.25"},
+		},
+		{
+			name: "half the connections is steady",
+			setup: func(t *testing.T, m *websocket.Manager) {
+				addConnections(t, m, 50)
+			},
+			want:    websocket.RecommendSteady,
+			reasons: []string{},
+		},
+		{
+			name: "connections near the limit scale up",
+			setup: func(t *testing.T, m *websocket.Manager) {
+				addConnections(t, m, 80)
+			},
+			want:    websocket.RecommendScaleUp,
+			reasons: []string{"80 of 100 connections in use"},
+		},
+		{
+			name: "fan-out at the target scales up",
+			setup: func(t *testing.T, m *websocket.Manager) {
+				ids := addConnections(t, m, 1)
+				start := time.Now()
+				m.SampleFanout(start)
+				fanOut(t, m, ids[0], "auction:1", 100)
+				// The messages stay queued, at well under the scale-up fill
+				m.SampleFanout(start.Add(10 * time.Second))
+			},
+			want:    websocket.RecommendScaleUp,
+			reasons: []string{"fanning out 10 messages/s against a target of 10"},
+		},
+		{
+			name: "full send queues scale up",
+			setup: func(t *testing.T, m *websocket.Manager) {
+				ids := addConnections(t, m, 1)
+				queueMessages(t, m, ids[0], 200)
+			},
+			want:    websocket.RecommendScaleUp,
+			reasons: []string{"send queues 78% full on average, 1 clients falling behind"},
+		},
+		{
+			name: "one slow client does not scale up",
+			setup: func(t *testing.T, m *websocket.Manager) {
+				ids := addConnections(t, m, 3)
+				queueMessages(t, m, ids[0], 230)
+			},
+			want:    websocket.RecommendSteady,
+			reasons: []string{"1 clients falling behind"},
+		},
+		{
+			name: "slow clients are reported when scaling down",
+			setup: func(t *testing.T, m *websocket.Manager) {
+				ids := addConnections(t, m, 4)
+				queueMessages(t, m, ids[0], 128)
+			},
+			want:    websocket.RecommendScaleDown,
+			reasons: []string{"load 0.12 is at or below 0.25", "1 clients falling behind"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newLoadManager(100, 10)
+			tt.setup(t, m)
+
+			snap := m.Load()
+			assert.Equal(t, tt.want, snap.Recommendation)
+			assert.Equal(t, tt.reasons, snap.Reasons)
+		})
+	}
+}
+
+func TestLoad_QueueFill(t *testing.T) {
+	m := newLoadManager(10, 10)
+	ids := addConnections(t, m, 2)
+	queueMessages(t, m, ids[0], 192)
+	queueMessages(t, m, ids[1], 64)
+
+	snap := m.Load()
+	assert.Equal(t, 2, snap.Connections)
+	assert.InDelta(t, 0.2, snap.ConnectionUtilization, 1e-9)
+	assert.InDelta(t, 0.5, snap.AvgQueueFill, 1e-9)
+	assert.InDelta(t, 0.75, snap.MaxQueueFill, 1e-9)
+	assert.Equal(t, 1, snap.SaturatedConnections)
+	assert.InDelta(t, 0.5, snap.Load, 1e-9, "load is the highest utilization")
+}
+
+func TestLoad_FanoutWindow(t *testing.T) {
+	m := newLoadManager(10, 1000)
+	ids := addConnections(t, m, 1)
+	start := time.Now()
+
+	// One sample has no rate yet
+	m.SampleFanout(start)
+	assert.Zero(t, m.Load().FanoutPerSecond)
+
+	fanOut(t, m, ids[0], "drops:featured", 60)
+	m.SampleFanout(start.Add(5 * time.Second))
+	snap := m.Load()
+	assert.InDelta(t, 12, snap.FanoutPerSecond, 1e-9)
+	assert.EqualValues(t, 60, snap.FannedOutTotal)
+
+	// Idle samples dilute the burst over the window
+	for i := 2; i <= 12; i++ {
+		m.SampleFanout(start.Add(time.Duration(i) * 5 * time.Second))
+	}
+	assert.InDelta(t, 1, m.Load().FanoutPerSecond, 1e-9, "60 messages over the one-minute window")
+
+	// Once the sample before the burst leaves the window, so does the burst
+	m.SampleFanout(start.Add(65 * time.Second))
+	assert.Zero(t, m.Load().FanoutPerSecond)
+	assert.EqualValues(t, 60, m.Load().FannedOutTotal, "the total keeps counting")
+}
+
+func TestLoad_TopicsByKind(t *testing.T) {
+	m := newLoadManager(10, 10)
+	ids := addConnections(t, m, 3)
+
+	subscriptions := map[string][]string{
+		"intent-1":             {ids[0]},
+		"intent-2":             {ids[0], ids[1]},
+		"address:0xabc":        {ids[0], ids[1], ids[2]},
+		"auction:42":           {ids[1]},
+		"drops:featured":       {ids[2]},
+		"user:user-1":          {ids[0]},
+		"upload:ticket":        {ids[0]},
+		"mint_stats:1:0xabc":   {ids[1]},
+		"unknown:thing":        {ids[2]},
+		"another-unknown:what": {ids[0], ids[2]},
+	}
+	for topic, conns := range subscriptions {
+		for _, connID := range conns {
+			m.AddSubscription(topic, connID)
+		}
+	}
+
+	assert.Equal(t, map[string]websocket.TopicLoad{
+		"intent":     {Topics: 2, Subscribers: 3},
+		"address":    {Topics: 1, Subscribers: 3},
+		"auction":    {Topics: 1, Subscribers: 1},
+		"drops":      {Topics: 1, Subscribers: 1},
+		"user":       {Topics: 1, Subscribers: 1},
+		"upload":     {Topics: 1, Subscribers: 1},
+		"mint_stats": {Topics: 1, Subscribers: 1},
+		"other":      {Topics: 2, Subscribers: 3},
+	}, m.Load().TopicsByKind)
+
+	// Unsubscribing the last subscriber drops the topic from its kind
+	m.RemoveSubscription("auction:42", ids[1])
+	_, ok := m.Load().TopicsByKind["auction"]
+	assert.False(t, ok)
+}
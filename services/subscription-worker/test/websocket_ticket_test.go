@@ -0,0 +1,108 @@
+package test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	gorillaws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/subscription-worker/internal/infrastructure/websocket"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
+)
+
+// fakeTickets redeems each issued ticket once
+type fakeTickets struct {
+	mu      sync.Mutex
+	tickets map[string]contracts.SubscriptionTicket
+}
+
+func (f *fakeTickets) ConsumeSubscriptionTicket(ctx context.Context, ticket string) (contracts.SubscriptionTicket, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	rec, ok := f.tickets[ticket]
+	if !ok {
+		return contracts.SubscriptionTicket{}, redis.ErrSubscriptionTicketNotFound
+	}
+	delete(f.tickets, ticket)
+	return rec, nil
+}
+
+// startTicketedManager serves a manager with the default configuration's ticket policy
+func startTicketedManager(t *testing.T, tickets map[string]contracts.SubscriptionTicket) string {
+	t.Helper()
+	host, port, err := net.SplitHostPort(testharness.FreeAddr(t))
+	require.NoError(t, err)
+
+	cfg := config.NewConfig().WebSocketConfig
+	cfg.Host, cfg.Port = host, port
+	m := websocket.NewManager(cfg)
+	m.SetTickets(&fakeTickets{tickets: tickets})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.Start(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	addr := net.JoinHostPort(host, port)
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return "ws://" + addr + "/ws"
+}
+
+func TestWebSocket_RefusesUnticketedByDefault(t *testing.T) {
+	url := startTicketedManager(t, nil)
+
+	// No Origin, as a script would connect
+	_, resp, err := gorillaws.DefaultDialer.Dial(url, nil)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestWebSocket_TicketScopesUserTopics(t *testing.T) {
+	url := startTicketedManager(t, map[string]contracts.SubscriptionTicket{
+		"ticket-1": {UserID: "user-1", Origin: "https://app.example"},
+	})
+
+	conn, _, err := gorillaws.DefaultDialer.Dial(url+"?ticket=ticket-1", http.Header{"Origin": {"https://app.example"}})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	subscribe := func(topic string) domain.WebSocketMessage {
+		require.NoError(t, conn.WriteJSON(map[string]string{"type": "subscribe", "intent_id": topic}))
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		var reply domain.WebSocketMessage
+		require.NoError(t, conn.ReadJSON(&reply))
+		return reply
+	}
+
+	assert.Equal(t, "subscribe_denied", subscribe(domain.UserTopic("user-2")).Type)
+	assert.Equal(t, "subscribed", subscribe(domain.UserTopic("user-1")).Type)
+	assert.Equal(t, "subscribed", subscribe(domain.AuctionTopic("eip155-1", "3")).Type)
+
+	// The ticket was redeemed; it can't open a second socket
+	_, resp, err := gorillaws.DefaultDialer.Dial(url+"?ticket=ticket-1", http.Header{"Origin": {"https://app.example"}})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -3,14 +3,16 @@ package main
 import (
 	"context"
 	"log"
-	"net"
-
-	"google.golang.org/grpc"
+	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/config"
 	grpc_handler "github.com/quangdang46/NFT-Marketplace/services/user-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/infrastructure/mailer"
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/infrastructure/repository"
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/grpcserver"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	userProto "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
@@ -22,6 +24,12 @@ func main() {
 	cfg := config.LoadConfig()
 	cfg.Validate()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("user-service"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	log.Printf("Starting User Service on %s", cfg.GRPCPort)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -50,20 +58,93 @@ func main() {
 
 	userService := service.NewUserService(userRepo)
 
-	// Initialize gRPC handler
-	server := grpc.NewServer()
-
-	grpcHandler := grpc_handler.NewgRPCHandler(userService)
-	userProto.RegisterUserServiceServer(server, grpcHandler)
+	mail, err := mailer.New(cfg.Mailer)
+	if err != nil {
+		log.Fatalf("Failed to initialize mailer: %v", err)
+	}
+	emailRepo := repository.NewEmailRepository(postgresClient)
+	emailService := service.NewEmailService(emailRepo, mail, cfg.Email.VerificationSecret, cfg.Email.VerifyURL,
+		time.Duration(cfg.Email.VerificationTTL)*time.Minute)
+
+	// Notification emails render from embedded templates; a broken template fails startup
+	var notifyService *service.NotificationEmailService
+	if cfg.Notify.Enabled {
+		renderer, err := mailer.NewTemplateRenderer()
+		if err != nil {
+			log.Fatalf("Failed to load email templates: %v", err)
+		}
+		notifyService = service.NewNotificationEmailService(repository.NewNotificationEmailRepository(postgresClient),
+			emailService, renderer, mail, cfg.Notify.UnsubscribeSecret, cfg.Notify.UnsubscribeURL)
+		notifyService.SetThrottle(cfg.Notify.SendsPerSecond, cfg.Notify.PerUserPerHour)
+		go notifyService.RunDigests(ctx, time.Duration(cfg.Notify.DigestIntervalMinutes)*time.Minute)
+	}
 
-	// Start listening
-	lis, err := net.Listen("tcp", cfg.GRPCPort)
+	// Wallet events only clean up address mappings and catalog events only move profile
+	// stats, so the service runs without RabbitMQ
+	statsService := service.NewProfileStatsService(repository.NewProfileStatsRepository(postgresClient))
+	amqpClient, err := messaging.NewRabbitMQ(cfg.RabbitMQ)
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		log.Printf("rabbitmq unavailable, wallet and catalog events disabled: %v", err)
+	} else {
+		defer amqpClient.Close()
+		if err := amqpClient.ConsumeWalletUnlinked("user.wallets.unlinked", "user-service", userService.(*service.Service).HandleWalletUnlinked); err != nil {
+			log.Printf("wallet.unlinked consumer: %v", err)
+		}
+		if err := amqpClient.ConsumeCollectionUpserted(cfg.Stats.CollectionsQueue, "user-service", statsService.HandleCollectionUpserted); err != nil {
+			log.Printf("collections.domain.upserted consumer: %v", err)
+		}
+		if err := amqpClient.ConsumeHoldingsChanged(cfg.Stats.HoldingsQueue, "user-service", statsService.HandleHoldingsChanged); err != nil {
+			log.Printf("catalog.holdings_changed consumer: %v", err)
+		}
+		if err := amqpClient.ConsumeSaleIndexed(cfg.Stats.SalesQueue, "user-service", statsService.HandleSaleIndexed); err != nil {
+			log.Printf("sales.events.indexed consumer: %v", err)
+		}
+		if notifyService != nil {
+			if err := amqpClient.ConsumeSaleIndexed(cfg.Notify.SalesQueue, "user-service", notifyService.HandleSaleIndexed); err != nil {
+				log.Printf("sales.events.indexed email consumer: %v", err)
+			}
+			if err := amqpClient.ConsumeDropStartingSoon(cfg.Notify.DropsQueue, "user-service", notifyService.HandleDropStartingSoon); err != nil {
+				log.Printf("drop.starting_soon email consumer: %v", err)
+			}
+		}
 	}
 
+	orgRepo := repository.NewOrganizationRepository(postgresClient)
+	orgService := service.NewOrganizationService(orgRepo, mail, cfg.Orgs.InviteURL,
+		time.Duration(cfg.Orgs.InvitationTTL)*24*time.Hour)
+
+	prefsService := service.NewPreferencesService(repository.NewPreferencesRepository(postgresClient))
+	privacyService := service.NewPrivacyService(repository.NewPrivacyRepository(postgresClient))
+
+	// NFT avatars are re-verified against the catalog's ownership index
+	avatarService := service.NewAvatarService(repository.NewAvatarRepository(postgresClient))
+	go avatarService.RunAvatarVerification(ctx, time.Duration(cfg.Avatars.RecheckIntervalMinutes)*time.Minute)
+
+	// Initialize gRPC handler
+	server := grpcserver.New(grpcserver.LoadConfig("user-service"))
+
+	grpcHandler := grpc_handler.NewgRPCHandler(userService).
+		WithEmailService(emailService).
+		WithOrganizationService(orgService).
+		WithPreferencesService(prefsService).
+		WithPrivacyService(privacyService).
+		WithAvatarService(avatarService)
+	if notifyService != nil {
+		grpcHandler.WithNotificationEmailService(notifyService)
+	}
+	userProto.RegisterUserServiceServer(server, grpcHandler)
+
 	log.Printf("User service listening on %s", cfg.GRPCPort)
-	if err := server.Serve(lis); err != nil {
+	if err := grpcserver.ListenAndServe(server, cfg.GRPCPort); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+
+	// Finish the wallet, catalog and sale events already received before the connection closes
+	if amqpClient != nil {
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer drainCancel()
+		if err := amqpClient.Drain(drainCtx); err != nil {
+			log.Printf("Error draining consumers: %v", err)
+		}
+	}
 }
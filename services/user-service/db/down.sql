@@ -8,6 +8,25 @@ DROP TRIGGER IF EXISTS update_profiles_updated_at ON profiles;
 DROP FUNCTION IF EXISTS update_updated_at_column();
 
 -- 3) Drop indexes (safe even if tables will be dropped next)
+-- Notification emails
+DROP INDEX IF EXISTS idx_email_sale_activity_user_time;
+DROP INDEX IF EXISTS idx_notification_emails_user_sent;
+
+-- NFT avatars
+DROP INDEX IF EXISTS idx_profile_nft_avatars_checked_at;
+
+-- Privacy
+DROP INDEX IF EXISTS idx_user_relationships_target;
+
+-- Organizations
+DROP INDEX IF EXISTS idx_org_invitations_org_id;
+DROP INDEX IF EXISTS idx_org_invitations_token_hash;
+DROP INDEX IF EXISTS idx_org_memberships_user_id;
+
+-- Emails
+DROP INDEX IF EXISTS idx_email_verifications_user_created;
+DROP INDEX IF EXISTS idx_user_emails_email_unique;
+
 -- Profiles
 DROP INDEX IF EXISTS idx_profiles_username_unique;
 DROP INDEX IF EXISTS idx_profiles_updated_at;
@@ -24,6 +43,18 @@ DROP INDEX IF EXISTS idx_user_accounts_address;
 DROP INDEX IF EXISTS idx_user_accounts_user_id;
 
 -- 4) Drop tables in reverse dependency order
+DROP TABLE IF EXISTS email_sale_activity;
+DROP TABLE IF EXISTS notification_emails;
+DROP TABLE IF EXISTS profile_stats_events;
+DROP TABLE IF EXISTS wallet_stats;
+DROP TABLE IF EXISTS profile_stats;
+DROP TABLE IF EXISTS profile_nft_avatars;
+DROP TABLE IF EXISTS user_relationships;
+DROP TABLE IF EXISTS org_invitations;
+DROP TABLE IF EXISTS org_memberships;
+DROP TABLE IF EXISTS organizations;
+DROP TABLE IF EXISTS email_verifications;
+DROP TABLE IF EXISTS user_emails;
 DROP TABLE IF EXISTS user_accounts;
 DROP TABLE IF EXISTS profiles;
 DROP TABLE IF EXISTS users;
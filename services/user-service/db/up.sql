@@ -2,6 +2,8 @@
 
 -- Extensions (for gen_random_uuid)
 CREATE EXTENSION IF NOT EXISTS pgcrypto;
+-- Trigram indexes for profile autocomplete
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
 
 -- ---------- USERS ----------
 CREATE TABLE IF NOT EXISTS users (
@@ -34,6 +36,11 @@ CREATE TABLE IF NOT EXISTS profiles (
     CONSTRAINT profiles_banner_url_fmt   CHECK (banner_url IS NULL OR banner_url ~ '^https?://')
 );
 
+-- Fiat currency prices are shown in (ISO 4217)
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS preferred_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+-- Count tokens of vaults delegating to the user's wallets (delegate.cash) as the user's
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS honor_delegations BOOLEAN NOT NULL DEFAULT FALSE;
+
 CREATE INDEX IF NOT EXISTS idx_profiles_username    ON profiles(username) WHERE username IS NOT NULL;
 CREATE INDEX IF NOT EXISTS idx_profiles_updated_at  ON profiles(updated_at);
 
@@ -42,6 +49,14 @@ CREATE UNIQUE INDEX IF NOT EXISTS idx_profiles_username_unique
     ON profiles (LOWER(username))
     WHERE username IS NOT NULL;
 
+-- Autocomplete matches usernames and display names by prefix and trigram word similarity
+CREATE INDEX IF NOT EXISTS idx_profiles_username_trgm
+    ON profiles USING gin (LOWER(username) gin_trgm_ops)
+    WHERE username IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_profiles_display_name_trgm
+    ON profiles USING gin (LOWER(display_name) gin_trgm_ops)
+    WHERE display_name IS NOT NULL;
+
 -- Auto-update updated_at on profiles
 CREATE OR REPLACE FUNCTION update_updated_at_column()
 RETURNS TRIGGER AS $$
@@ -75,3 +90,174 @@ CREATE INDEX IF NOT EXISTS idx_user_accounts_user_id       ON user_accounts(user
 CREATE INDEX IF NOT EXISTS idx_user_accounts_address       ON user_accounts(address);
 CREATE INDEX IF NOT EXISTS idx_user_accounts_created_at    ON user_accounts(created_at);
 CREATE INDEX IF NOT EXISTS idx_user_accounts_last_seen_at  ON user_accounts(last_seen_at);
+
+-- ---------- USER_EMAILS ----------
+-- One verified email per user; an address can only be verified by one user
+CREATE TABLE IF NOT EXISTS user_emails (
+    user_id        UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    email          VARCHAR(320) NOT NULL,
+    verified_at    TIMESTAMPTZ  NOT NULL,
+    digest_opt_out BOOLEAN      NOT NULL DEFAULT false,
+    updated_at     TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_emails_email_unique ON user_emails (LOWER(email));
+
+-- Notification emails other than the digest the user turned off, e.g. {sale_confirmation}
+ALTER TABLE user_emails ADD COLUMN IF NOT EXISTS opt_outs TEXT[] NOT NULL DEFAULT '{}';
+
+-- ---------- EMAIL_VERIFICATIONS ----------
+-- Pending verifications; the code is stored as sha256 hex
+CREATE TABLE IF NOT EXISTS email_verifications (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id     UUID         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email       VARCHAR(320) NOT NULL,
+    code_hash   CHAR(64)     NOT NULL,
+    attempts    INTEGER      NOT NULL DEFAULT 0,
+    expires_at  TIMESTAMPTZ  NOT NULL,
+    consumed_at TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_verifications_user_created ON email_verifications(user_id, created_at DESC);
+
+-- ---------- ORGANIZATIONS ----------
+-- Teams of users that can own collections
+CREATE TABLE IF NOT EXISTS organizations (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name       VARCHAR(100) NOT NULL,
+    created_by UUID         NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ---------- ORG_MEMBERSHIPS ----------
+CREATE TABLE IF NOT EXISTS org_memberships (
+    org_id    UUID        NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id   UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role      VARCHAR(16) NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (org_id, user_id),
+    CONSTRAINT org_memberships_role_check CHECK (role IN ('owner', 'admin', 'member'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_org_memberships_user_id ON org_memberships(user_id);
+
+-- ---------- ORG_INVITATIONS ----------
+-- Emailed invitations; the token is stored as sha256 hex
+CREATE TABLE IF NOT EXISTS org_invitations (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id      UUID         NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    email       VARCHAR(320) NOT NULL,
+    role        VARCHAR(16)  NOT NULL,
+    token_hash  CHAR(64)     NOT NULL,
+    invited_by  UUID         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at  TIMESTAMPTZ  NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    accepted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT org_invitations_role_check CHECK (role IN ('admin', 'member'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_org_invitations_token_hash ON org_invitations(token_hash);
+CREATE INDEX IF NOT EXISTS idx_org_invitations_org_id ON org_invitations(org_id);
+
+-- ---------- PRIVACY ----------
+-- Who may see a profile: public, holders (of the user's collections) or private
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS visibility VARCHAR(16) NOT NULL DEFAULT 'public'
+    CONSTRAINT profiles_visibility_check CHECK (visibility IN ('public', 'holders', 'private'));
+
+-- Blocks, mutes and follows one user set on another
+CREATE TABLE IF NOT EXISTS user_relationships (
+    user_id    UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_id  UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind       VARCHAR(16) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, target_id, kind),
+    CONSTRAINT user_relationships_kind_check CHECK (kind IN ('block', 'mute', 'follow')),
+    CONSTRAINT user_relationships_not_self CHECK (user_id <> target_id)
+);
+
+-- Follows were added after blocks and mutes
+ALTER TABLE user_relationships DROP CONSTRAINT IF EXISTS user_relationships_kind_check;
+ALTER TABLE user_relationships ADD CONSTRAINT user_relationships_kind_check CHECK (kind IN ('block', 'mute', 'follow'));
+
+-- Blocks are checked from both sides
+CREATE INDEX IF NOT EXISTS idx_user_relationships_target ON user_relationships(target_id, kind);
+
+-- ---------- NFT AVATARS ----------
+-- A token the user set as their avatar; verified is re-checked periodically against the
+-- catalog's ownership index and the user's signed wallets
+CREATE TABLE IF NOT EXISTS profile_nft_avatars (
+    user_id     UUID        PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    chain_id    TEXT        NOT NULL,
+    contract    VARCHAR(42) NOT NULL,
+    token_id    TEXT        NOT NULL,
+    holder      VARCHAR(42) NOT NULL DEFAULT '',
+    verified    BOOLEAN     NOT NULL DEFAULT true,
+    verified_at TIMESTAMPTZ,
+    checked_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_nft_avatars_checked_at ON profile_nft_avatars(checked_at);
+
+-- ---------- PROFILE STATS ----------
+-- Counters shown on profile pages, kept from events instead of asking the catalog per view.
+-- Followers are counted per user as follows are added and removed.
+CREATE TABLE IF NOT EXISTS profile_stats (
+    user_id    UUID        PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    followers  BIGINT      NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Catalog counters are kept per wallet and summed over the user's accounts, so they follow
+-- wallets being linked and unlinked. Volume is ETH bought and sold, in wei.
+CREATE TABLE IF NOT EXISTS wallet_stats (
+    address             VARCHAR(42)    PRIMARY KEY,
+    collections_created BIGINT         NOT NULL DEFAULT 0,
+    items_owned         BIGINT         NOT NULL DEFAULT 0,
+    volume_wei          NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    updated_at          TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Catalog events already counted, so redeliveries count once
+CREATE TABLE IF NOT EXISTS profile_stats_events (
+    event_id   TEXT        PRIMARY KEY,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ---------- NOTIFICATION EMAILS ----------
+-- Every notification email claimed for sending, keyed so an event emails a user once. Recent
+-- rows throttle how many emails a user gets, and the latest digest spaces out digests.
+CREATE TABLE IF NOT EXISTS notification_emails (
+    user_id    UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    dedupe_key TEXT        NOT NULL,
+    kind       VARCHAR(32) NOT NULL,
+    sent_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, dedupe_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_emails_user_sent ON notification_emails(user_id, kind, sent_at DESC);
+
+-- Each side of a sale a user took part in, summed into their weekly digest
+CREATE TABLE IF NOT EXISTS email_sale_activity (
+    user_id     UUID           NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id    TEXT           NOT NULL,
+    side        VARCHAR(8)     NOT NULL,
+    chain_id    TEXT           NOT NULL DEFAULT '',
+    contract    VARCHAR(42)    NOT NULL DEFAULT '',
+    token_id    TEXT           NOT NULL DEFAULT '',
+    price_wei   NUMERIC(78, 0) NOT NULL,
+    currency    VARCHAR(16)    NOT NULL,
+    tx_hash     TEXT           NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ    NOT NULL,
+
+    PRIMARY KEY (user_id, event_id, side),
+    CONSTRAINT email_sale_activity_side_check CHECK (side IN ('sold', 'bought'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_sale_activity_user_time ON email_sale_activity(user_id, occurred_at);
@@ -4,15 +4,73 @@ import (
 	"log"
 
 	"github.com/quangdang46/NFT-Marketplace/shared/env"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
+// MailerConfig selects and configures the outgoing mail transport
+type MailerConfig struct {
+	Driver       string // log | smtp | ses
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUser     string
+	SMTPPassword string
+	SESRegion    string
+}
+
+// EmailConfig configures email verification
+type EmailConfig struct {
+	VerificationSecret string // HMAC key for magic-link tokens
+	VerifyURL          string // frontend page that receives ?token=
+	VerificationTTL    int    // minutes
+}
+
+// OrganizationConfig configures organization invitations
+type OrganizationConfig struct {
+	InviteURL     string // frontend page that receives ?token=
+	InvitationTTL int    // days
+}
+
+// AvatarConfig configures NFT avatar verification
+type AvatarConfig struct {
+	RecheckIntervalMinutes int // how often each NFT avatar's holdings are re-verified
+}
+
+// StatsConfig names the queues profile stats consume catalog events from
+type StatsConfig struct {
+	CollectionsQueue string // collections.domain.upserted, for collections created
+	HoldingsQueue    string // catalog.holdings_changed, for items owned
+	SalesQueue       string // sales.events.indexed on the configured exchange, for volume
+}
+
+// NotificationEmailConfig configures the weekly digest, sale confirmation and drop
+// reminder emails
+type NotificationEmailConfig struct {
+	Enabled               bool
+	SalesQueue            string  // sales.events.indexed on the configured exchange
+	DropsQueue            string  // drop.starting_soon on collections.events
+	UnsubscribeURL        string  // frontend page that receives ?token=
+	UnsubscribeSecret     string  // HMAC key for unsubscribe tokens
+	SendsPerSecond        float64 // across all recipients, per replica
+	PerUserPerHour        int     // cap on non-digest emails to one user
+	DigestIntervalMinutes int     // how often due digests are looked for
+}
+
 // Config contains configuration for User Service
 type Config struct {
 	GRPCPort string
 	Postgres postgres.PostgresConfig
 	Redis    redis.RedisConfig
+	RabbitMQ messaging.RabbitMQConfig
+	Mailer   MailerConfig
+	Email    EmailConfig
+	Orgs     OrganizationConfig
+	Avatars  AvatarConfig
+	Stats    StatsConfig
+	Notify   NotificationEmailConfig
 }
 
 // LoadConfig loads configuration from environment variables
@@ -23,6 +81,35 @@ func LoadConfig() *Config {
 		GRPCPort: env.GetString("USER_GRPC_PORT", ":50052"),
 		Postgres: loadPostgresConfig(),
 		Redis:    loadRedisConfig(),
+		RabbitMQ: loadRabbitMQConfig(),
+		Mailer:   loadMailerConfig(),
+		Email: EmailConfig{
+			VerificationSecret: env.GetString("EMAIL_VERIFICATION_SECRET", "default-email-secret-for-development"),
+			VerifyURL:          env.GetString("EMAIL_VERIFY_URL", "http://localhost:3000/verify-email"),
+			VerificationTTL:    env.GetInt("EMAIL_VERIFICATION_TTL_MINUTES", 15),
+		},
+		Orgs: OrganizationConfig{
+			InviteURL:     env.GetString("ORG_INVITE_URL", "http://localhost:3000/accept-invitation"),
+			InvitationTTL: env.GetInt("ORG_INVITATION_TTL_DAYS", 7),
+		},
+		Avatars: AvatarConfig{
+			RecheckIntervalMinutes: env.GetInt("NFT_AVATAR_RECHECK_INTERVAL_MINUTES", 60),
+		},
+		Stats: StatsConfig{
+			CollectionsQueue: env.GetString("PROFILE_STATS_COLLECTIONS_QUEUE", "user.stats.collections"),
+			HoldingsQueue:    env.GetString("PROFILE_STATS_HOLDINGS_QUEUE", "user.stats.holdings"),
+			SalesQueue:       env.GetString("PROFILE_STATS_SALES_QUEUE", "user.stats.sales"),
+		},
+		Notify: NotificationEmailConfig{
+			Enabled:               env.GetBool("NOTIFICATION_EMAILS_ENABLED", true),
+			SalesQueue:            env.GetString("NOTIFICATION_EMAILS_SALES_QUEUE", "user.email.sales"),
+			DropsQueue:            env.GetString("NOTIFICATION_EMAILS_DROPS_QUEUE", "user.email.drops"),
+			UnsubscribeURL:        env.GetString("EMAIL_UNSUBSCRIBE_URL", "http://localhost:3000/unsubscribe"),
+			UnsubscribeSecret:     env.GetString("EMAIL_UNSUBSCRIBE_SECRET", ""),
+			SendsPerSecond:        env.GetFloat("NOTIFICATION_EMAILS_PER_SECOND", 10),
+			PerUserPerHour:        env.GetInt("NOTIFICATION_EMAILS_PER_USER_PER_HOUR", 5),
+			DigestIntervalMinutes: env.GetInt("NOTIFICATION_EMAILS_DIGEST_INTERVAL_MINUTES", 15),
+		},
 	}
 
 	log.Printf("User Service config loaded - gRPC: %s",
@@ -39,6 +126,19 @@ func loadPostgresConfig() postgres.PostgresConfig {
 		PostgresUser:     env.GetString("POSTGRES_USER", "postgres"),
 		PostgresPassword: env.GetString("POSTGRES_PASSWORD", "password"),
 		PostgresDatabase: env.GetString("POSTGRES_DATABASE", "nft_marketplace"),
+		Tenant:           tenant.FromEnv(),
+	}
+}
+
+// loadRabbitMQConfig loads RabbitMQ configuration
+func loadRabbitMQConfig() messaging.RabbitMQConfig {
+	return messaging.RabbitMQConfig{
+		RabbitMQHost:     env.GetString("RABBITMQ_HOST", "localhost"),
+		RabbitMQPort:     env.GetInt("RABBITMQ_PORT", 5672),
+		RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
+		RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
+		RabbitMQExchange: env.GetString("RABBITMQ_EXCHANGE", "nft-marketplace"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
 
@@ -47,6 +147,20 @@ func loadRedisConfig() redis.RedisConfig {
 	return redis.RedisConfig{
 		RedisHost: env.GetString("REDIS_HOST", "localhost"),
 		RedisPort: env.GetInt("REDIS_PORT", 6379),
+		Tenant:    tenant.FromEnv(),
+	}
+}
+
+// loadMailerConfig loads mail transport configuration
+func loadMailerConfig() MailerConfig {
+	return MailerConfig{
+		Driver:       env.GetString("MAILER_DRIVER", "log"),
+		From:         env.GetString("MAILER_FROM", "no-reply@zuno.local"),
+		SMTPHost:     env.GetString("SMTP_HOST", ""),
+		SMTPPort:     env.GetInt("SMTP_PORT", 587),
+		SMTPUser:     env.GetString("SMTP_USER", ""),
+		SMTPPassword: env.GetString("SMTP_PASSWORD", ""),
+		SESRegion:    env.GetString("SES_REGION", "us-east-1"),
 	}
 }
 
@@ -62,6 +176,14 @@ func (c *Config) Validate() error {
 		log.Fatal("REDIS_HOST is required")
 	}
 
+	if c.Email.VerificationSecret == "" {
+		log.Fatal("EMAIL_VERIFICATION_SECRET is required")
+	}
+	// Unsubscribe links are signed with the verification secret unless given their own
+	if c.Notify.UnsubscribeSecret == "" {
+		c.Notify.UnsubscribeSecret = c.Email.VerificationSecret
+	}
+
 	log.Println("User Service configuration validation passed")
 	return nil
 }
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultAvatarRecheckInterval is how often NFT avatars are re-verified
+const DefaultAvatarRecheckInterval = time.Hour
+
+// MaxAvatarRecheckBatch bounds the avatars one re-verification pass checks
+const MaxAvatarRecheckBatch = 200
+
+// NFTAvatar is a token a user set as their avatar. One of their signed wallets held it when
+// it was set; Verified follows the periodic re-checks, so a sold token keeps its reference
+// but loses the flag.
+type NFTAvatar struct {
+	UserID     UserID
+	ChainID    ChainID // CAIP-2
+	Contract   Address // lowercase
+	TokenID    string
+	Holder     Address // the wallet found holding it at the last check; empty when none
+	Verified   bool
+	VerifiedAt *time.Time
+	CheckedAt  time.Time
+	CreatedAt  time.Time
+}
+
+type AvatarService interface {
+	// SetAvatarFromNFT makes the token the user's avatar, replacing any previous NFT avatar;
+	// it returns ErrNFTNotHeld unless one of the user's signed wallets holds it
+	SetAvatarFromNFT(ctx context.Context, userID UserID, chainID ChainID, contract Address, tokenID string) (*NFTAvatar, error)
+	ClearNFTAvatar(ctx context.Context, userID UserID) error
+}
+
+type AvatarRepository interface {
+	// FindNFTHolder returns the user's signed wallet holding the token as of the last
+	// indexed transfer, or "" when none does
+	FindNFTHolder(ctx context.Context, userID, chainID, contract, tokenID string) (string, error)
+	// PutNFTAvatar returns ErrUserNotFound when the user does not exist
+	PutNFTAvatar(ctx context.Context, avatar *NFTAvatar) error
+	DeleteNFTAvatar(ctx context.Context, userID string) error
+	// ListNFTAvatarsCheckedBefore returns avatars last checked before the time, oldest first
+	ListNFTAvatarsCheckedBefore(ctx context.Context, before time.Time, limit int) ([]NFTAvatar, error)
+	// RecordNFTAvatarCheck stores a re-check's holder, flag and times; an avatar replaced
+	// since it was listed is left alone
+	RecordNFTAvatarCheck(ctx context.Context, avatar *NFTAvatar) error
+}
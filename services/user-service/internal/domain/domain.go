@@ -26,10 +26,32 @@ type Profile struct {
 	Bio         string
 	Locale      string
 	Timezone    string
+	Currency    string // preferred fiat currency, ISO 4217
 	SocialsJSON string // JSON string containing social media links
 	UpdatedAt   time.Time
+	NFTAvatar   *NFTAvatar // nil unless the avatar is an NFT
+	Stats       ProfileStats
 }
 
+// UserCard is a user together with its profile, as shown on creator cards and activity rows
+type UserCard struct {
+	User    User
+	Profile Profile
+}
+
+// UserSuggestion is an autocomplete match on a username or display name; Score ranks
+// prefix matches first, then by trigram word similarity
+type UserSuggestion struct {
+	UserID      UserID
+	Username    string
+	DisplayName string
+	AvatarURL   string
+	Score       float64
+}
+
+// MaxBatchLookup bounds the keys resolved by one batch lookup
+const MaxBatchLookup = 100
+
 type EnsureUserResult struct {
 	UserID  UserID
 	Created bool // true if new user was created
@@ -37,10 +59,22 @@ type EnsureUserResult struct {
 
 type UserService interface {
 	EnsureUser(ctx context.Context, accountID AccountID, address Address, chainID ChainID) (*EnsureUserResult, error)
+	// Batch lookups return one entry per requested key in request order, nil when not found
+	GetUsersByIDs(ctx context.Context, userIDs []UserID) ([]*UserCard, error)
+	GetProfilesByAddresses(ctx context.Context, addresses []Address) ([]*UserCard, error)
+	// SuggestUsers autocompletes usernames and display names of active users, tolerating typos
+	SuggestUsers(ctx context.Context, query string, limit int) ([]UserSuggestion, error)
 }
 
 type UserRepository interface {
 	GetUserIDByAccount(ctx context.Context, accountID string) (string, error)
+	GetUserCardsByIDs(ctx context.Context, userIDs []string) (map[string]*UserCard, error)
+	// GetUserCardsByAddresses keys the result by lowercase address
+	GetUserCardsByAddresses(ctx context.Context, addresses []string) (map[string]*UserCard, error)
+	// SuggestUsers matches active profiles against a normalized query, best score first
+	SuggestUsers(ctx context.Context, query string, limit int) ([]UserSuggestion, error)
+	// RemoveUserAccount drops the user's account mapping; a missing mapping is not an error
+	RemoveUserAccount(ctx context.Context, userID, accountID string) error
 
 	WithTx(ctx context.Context, fn func(TxUserRepository) error) error
 }
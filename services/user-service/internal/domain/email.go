@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// UserEmail is the verified email linked to a user
+type UserEmail struct {
+	UserID       UserID
+	Email        string
+	VerifiedAt   time.Time
+	DigestOptOut bool // user opted out of email digests
+	// OptOuts are the other notification emails the user turned off
+	OptOuts   []EmailKind
+	UpdatedAt time.Time
+}
+
+// OptedOut reports whether the user turned off emails of kind
+func (e *UserEmail) OptedOut(kind EmailKind) bool {
+	if kind == EmailKindWeeklyDigest {
+		return e.DigestOptOut
+	}
+	for _, k := range e.OptOuts {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// EmailVerification is a pending email link; CodeHash is the sha256 hex of the code
+type EmailVerification struct {
+	ID         string
+	UserID     UserID
+	Email      string
+	CodeHash   string
+	Attempts   int
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// OutgoingEmail is a message handed to a Mailer
+type OutgoingEmail struct {
+	To      string
+	Subject string
+	Body    string // plain text
+	HTML    string // optional alternative to Body
+	// Headers are extra message headers, e.g. List-Unsubscribe
+	Headers map[string]string
+}
+
+// Mailer delivers email (SES, SMTP, or a log sink in development)
+type Mailer interface {
+	Send(ctx context.Context, msg OutgoingEmail) error
+}
+
+type EmailService interface {
+	StartEmailVerification(ctx context.Context, userID UserID, email string) (expiresAt time.Time, err error)
+	// ConfirmEmail accepts either the emailed code or the signed magic-link token
+	ConfirmEmail(ctx context.Context, userID UserID, code string) (*UserEmail, error)
+	GetEmailStatus(ctx context.Context, userID UserID) (*UserEmail, error)
+	SetEmailDigestOptOut(ctx context.Context, userID UserID, optOut bool) (*UserEmail, error)
+	SetEmailOptOut(ctx context.Context, userID UserID, kind EmailKind, optOut bool) (*UserEmail, error)
+	// GetNotificationEmail returns the address notifications may be sent to
+	GetNotificationEmail(ctx context.Context, userID UserID) (email string, deliverable bool, err error)
+}
+
+type EmailRepository interface {
+	GetEmail(ctx context.Context, userID string) (*UserEmail, error)
+	// IsEmailTaken reports whether another user already verified the address
+	IsEmailTaken(ctx context.Context, email, exceptUserID string) (bool, error)
+
+	CreateVerification(ctx context.Context, v *EmailVerification) error
+	GetVerification(ctx context.Context, id string) (*EmailVerification, error)
+	GetLatestVerification(ctx context.Context, userID string) (*EmailVerification, error)
+	IncrementVerificationAttempts(ctx context.Context, id string) error
+
+	// ConfirmVerification consumes the verification and stores the email as verified
+	ConfirmVerification(ctx context.Context, v *EmailVerification) (*UserEmail, error)
+	SetDigestOptOut(ctx context.Context, userID string, optOut bool) (*UserEmail, error)
+	// SetOptOut turns emails of a kind other than the weekly digest off or back on
+	SetOptOut(ctx context.Context, userID string, kind EmailKind, optOut bool) (*UserEmail, error)
+}
+
+func ValidateEmail(email string) error {
+	if email == "" {
+		return NewInvalidInputError("email", "cannot be empty")
+	}
+	if len(email) > 320 {
+		return NewInvalidInputError("email", "too long")
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || !strings.Contains(email, "@") {
+		return NewInvalidInputError("email", "invalid format")
+	}
+	return nil
+}
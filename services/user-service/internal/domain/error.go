@@ -1,21 +1,42 @@
 package domain
 
 import (
-	"errors"
 	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
 )
 
 // Domain errors
 var (
-	ErrUserNotFound      = errors.New("user_not_found")
-	ErrProfileNotFound   = errors.New("profile_not_found")
-	ErrDuplicateUser     = errors.New("duplicate_user")
-	ErrInvalidInput      = errors.New("invalid_input")
-	ErrInvalidAccountID  = errors.New("invalid_account_id")
-	ErrInvalidAddress    = errors.New("invalid_address")
-	ErrInvalidChainID    = errors.New("invalid_chain_id")
-	ErrDatabaseOperation = errors.New("database_operation_failed")
-	ErrAccountExists     = errors.New("account_already_exists")
+	ErrUserNotFound      = errs.New(errs.NotFound, "user_not_found")
+	ErrProfileNotFound   = errs.New(errs.NotFound, "profile_not_found")
+	ErrDuplicateUser     = errs.New(errs.AlreadyExists, "duplicate_user")
+	ErrInvalidInput      = errs.New(errs.InvalidArgument, "invalid_input")
+	ErrInvalidAccountID  = errs.New(errs.InvalidArgument, "invalid_account_id")
+	ErrInvalidAddress    = errs.New(errs.InvalidArgument, "invalid_address")
+	ErrInvalidChainID    = errs.New(errs.InvalidArgument, "invalid_chain_id")
+	ErrDatabaseOperation = errs.New(errs.Internal, "database_operation_failed")
+	ErrAccountExists     = errs.New(errs.AlreadyExists, "account_already_exists")
+
+	ErrEmailNotFound         = errs.New(errs.NotFound, "email_not_found")
+	ErrEmailTaken            = errs.New(errs.AlreadyExists, "email_already_taken")
+	ErrVerificationNotFound  = errs.New(errs.NotFound, "verification_not_found")
+	ErrVerificationExpired   = errs.New(errs.FailedPrecondition, "verification_expired")
+	ErrVerificationInvalid   = errs.New(errs.InvalidArgument, "verification_invalid")
+	ErrVerificationExhausted = errs.New(errs.ResourceExhausted, "verification_attempts_exhausted")
+	ErrUnsubscribeInvalid    = errs.New(errs.InvalidArgument, "unsubscribe_link_invalid")
+
+	ErrOrgNotFound        = errs.New(errs.NotFound, "organization_not_found")
+	ErrNotOrgMember       = errs.New(errs.NotFound, "not_organization_member")
+	ErrOrgForbidden       = errs.New(errs.PermissionDenied, "organization_forbidden")
+	ErrAlreadyOrgMember   = errs.New(errs.AlreadyExists, "already_organization_member")
+	ErrLastOrgOwner       = errs.New(errs.FailedPrecondition, "organization_needs_an_owner")
+	ErrInvitationNotFound = errs.New(errs.NotFound, "invitation_not_found")
+	ErrInvitationExpired  = errs.New(errs.FailedPrecondition, "invitation_expired")
+
+	ErrRelationshipLimit = errs.New(errs.ResourceExhausted, "relationship_limit_reached")
+
+	ErrNFTNotHeld = errs.New(errs.FailedPrecondition, "nft_not_held")
 )
 
 // Error helpers
@@ -38,4 +59,5 @@ const (
 const (
 	DefaultLocale   = "en"
 	DefaultTimezone = "UTC"
+	DefaultCurrency = "USD"
 )
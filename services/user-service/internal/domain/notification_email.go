@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// EmailKind is a kind of notification email; users turn each off on its own
+type EmailKind string
+
+const (
+	EmailKindWeeklyDigest     EmailKind = "weekly_digest"
+	EmailKindSaleConfirmation EmailKind = "sale_confirmation"
+	EmailKindDropReminder     EmailKind = "drop_reminder"
+)
+
+// EmailKinds are the notification emails users can turn off
+var EmailKinds = []EmailKind{EmailKindWeeklyDigest, EmailKindSaleConfirmation, EmailKindDropReminder}
+
+// ValidateEmailKind checks kind is one of EmailKinds
+func ValidateEmailKind(kind EmailKind) error {
+	for _, k := range EmailKinds {
+		if k == kind {
+			return nil
+		}
+	}
+	return NewInvalidInputError("kind", "must be weekly_digest, sale_confirmation or drop_reminder")
+}
+
+// DigestPeriod is how much activity a digest covers and how often it is sent
+const DigestPeriod = 7 * 24 * time.Hour
+
+// EmailRecipient is a user with a verified email and the preferences emails are written in
+type EmailRecipient struct {
+	Email    UserEmail
+	Locale   string
+	Timezone string
+}
+
+// Sale sides recorded as email activity
+const (
+	SaleSideSold   = "sold"
+	SaleSideBought = "bought"
+)
+
+// SaleActivity is one side of a sale, recorded for a party's confirmation and digest
+type SaleActivity struct {
+	UserID     UserID
+	EventID    string
+	Side       string // SaleSideSold or SaleSideBought
+	ChainID    string
+	Contract   string
+	TokenID    string
+	PriceWei   string // base units of Currency, base-10
+	Currency   string
+	TxHash     string
+	OccurredAt time.Time
+}
+
+// DigestActivity sums a user's activity over a digest period. Volumes are ETH wei.
+type DigestActivity struct {
+	Sold         int64
+	SoldWei      string
+	Bought       int64
+	BoughtWei    string
+	NewFollowers int64
+	ItemsOwned   int64
+}
+
+// Empty reports whether nothing happened worth a digest
+func (a DigestActivity) Empty() bool {
+	return a.Sold == 0 && a.Bought == 0 && a.NewFollowers == 0
+}
+
+// RenderedEmail is a notification written out in one locale
+type RenderedEmail struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// EmailRenderer writes notification emails from templates. data is one of the *EmailData
+// types matching kind; locale picks the closest translation.
+type EmailRenderer interface {
+	Render(kind EmailKind, locale string, data any) (*RenderedEmail, error)
+}
+
+// Template data, formatted for the recipient's locale and time zone
+type (
+	WeeklyDigestEmailData struct {
+		PeriodStart    string
+		PeriodEnd      string
+		Sold           int64
+		SoldVolume     string
+		Bought         int64
+		BoughtVolume   string
+		NewFollowers   int64
+		ItemsOwned     string
+		UnsubscribeURL string
+	}
+	SaleConfirmationEmailData struct {
+		Sold           bool // false when the recipient bought
+		Contract       string
+		TokenID        string
+		Price          string
+		ChainID        string
+		TxHash         string
+		OccurredAt     string
+		UnsubscribeURL string
+	}
+	DropReminderEmailData struct {
+		ChainID        string
+		Contract       string
+		StartsAt       string
+		UnsubscribeURL string
+	}
+)
+
+type NotificationEmailService interface {
+	// Unsubscribe turns off the kind of email a signed unsubscribe link names
+	Unsubscribe(ctx context.Context, token string) (*UserEmail, EmailKind, error)
+}
+
+type NotificationEmailRepository interface {
+	// RecipientsByAddresses returns the users behind the wallets that have a verified email
+	RecipientsByAddresses(ctx context.Context, addresses []string) ([]EmailRecipient, error)
+	// DueDigestRecipients returns up to limit users with a verified email, digests on, and
+	// no digest since before
+	DueDigestRecipients(ctx context.Context, before time.Time, limit int) ([]EmailRecipient, error)
+	// DigestActivity sums the user's activity since since
+	DigestActivity(ctx context.Context, userID string, since time.Time) (*DigestActivity, error)
+
+	// RecordSaleActivity stores one side of a sale; recorded is false when it already was
+	RecordSaleActivity(ctx context.Context, activity SaleActivity) (recorded bool, err error)
+
+	// ClaimSend reserves the email dedupeKey names for the user, returning false when it
+	// was already sent; ReleaseSend gives it back after a failed send
+	ClaimSend(ctx context.Context, userID, dedupeKey string, kind EmailKind) (claimed bool, err error)
+	ReleaseSend(ctx context.Context, userID, dedupeKey string) error
+	// CountSentSince counts the emails claimed for the user since since
+	CountSentSince(ctx context.Context, userID string, since time.Time) (int, error)
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Organization roles, from most to least privileged
+const (
+	OrgRoleOwner  = "owner"  // manages members, roles and collections
+	OrgRoleAdmin  = "admin"  // invites members and manages collections
+	OrgRoleMember = "member" // read access to the organization
+)
+
+// Organization is a team of users that can own collections
+type Organization struct {
+	ID        string
+	Name      string
+	CreatedBy UserID
+	CreatedAt time.Time
+}
+
+type OrgMember struct {
+	OrgID    string
+	UserID   UserID
+	Role     string
+	JoinedAt time.Time
+}
+
+// OrgMembership is a user's role together with the organization
+type OrgMembership struct {
+	Organization Organization
+	Role         string
+}
+
+// OrgInvitation is a pending invitation; TokenHash is the sha256 hex of the emailed token
+type OrgInvitation struct {
+	ID         string
+	OrgID      string
+	Email      string
+	Role       string
+	TokenHash  string
+	InvitedBy  UserID
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+}
+
+type OrganizationService interface {
+	CreateOrganization(ctx context.Context, userID UserID, name string) (*Organization, error)
+	// GetOrganization returns the organization and its members; userID must be a member
+	GetOrganization(ctx context.Context, orgID string, userID UserID) (*Organization, []OrgMember, error)
+	ListUserOrganizations(ctx context.Context, userID UserID) ([]OrgMembership, error)
+
+	InviteMember(ctx context.Context, orgID string, inviterID UserID, email, role string) (*OrgInvitation, error)
+	AcceptInvitation(ctx context.Context, userID UserID, token string) (*OrgMembership, error)
+	// RemoveMember removes userID; owners and admins remove others, anyone can leave
+	RemoveMember(ctx context.Context, orgID string, actorID, userID UserID) error
+	SetMemberRole(ctx context.Context, orgID string, actorID, userID UserID, role string) (*OrgMember, error)
+
+	GetMembership(ctx context.Context, orgID string, userID UserID) (*OrgMember, error)
+}
+
+type OrganizationRepository interface {
+	// CreateOrganization stores the organization with its creator as the first owner
+	CreateOrganization(ctx context.Context, org *Organization) error
+	GetOrganization(ctx context.Context, orgID string) (*Organization, error)
+	ListMembers(ctx context.Context, orgID string) ([]OrgMember, error)
+	ListMemberships(ctx context.Context, userID string) ([]OrgMembership, error)
+
+	// GetMember returns ErrNotOrgMember when the user is not in the organization
+	GetMember(ctx context.Context, orgID, userID string) (*OrgMember, error)
+	CountOwners(ctx context.Context, orgID string) (int, error)
+	UpdateMemberRole(ctx context.Context, orgID, userID, role string) (*OrgMember, error)
+	DeleteMember(ctx context.Context, orgID, userID string) error
+
+	CreateInvitation(ctx context.Context, inv *OrgInvitation) error
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*OrgInvitation, error)
+	// AcceptInvitation consumes the invitation and adds the user with the invited role
+	AcceptInvitation(ctx context.Context, inv *OrgInvitation, userID string) (*OrgMember, error)
+}
+
+// CanManageMembers reports whether the role may invite and remove members
+func CanManageMembers(role string) bool {
+	return role == OrgRoleOwner || role == OrgRoleAdmin
+}
+
+// CanManageCollections reports whether the role may prepare admin intents for org-owned collections
+func CanManageCollections(role string) bool {
+	return role == OrgRoleOwner || role == OrgRoleAdmin
+}
+
+func ValidateOrgRole(role string) error {
+	switch role {
+	case OrgRoleOwner, OrgRoleAdmin, OrgRoleMember:
+		return nil
+	}
+	return NewInvalidInputError("role", "must be owner, admin or member")
+}
+
+func ValidateOrgName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return NewInvalidInputError("name", "cannot be empty")
+	}
+	if len(name) > 100 {
+		return NewInvalidInputError("name", "too long")
+	}
+	return nil
+}
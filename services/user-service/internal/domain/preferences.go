@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Preferences are how a user wants dates, times and prices presented
+type Preferences struct {
+	UserID   UserID
+	Locale   string // BCP 47 tag, e.g. "en-US"
+	Timezone string // IANA zone, e.g. "Europe/Berlin"
+	Currency string // ISO 4217 fiat code, e.g. "USD"
+	// HonorDelegations counts tokens of vaults that delegated to the user's wallets
+	// as the user's own
+	HonorDelegations bool
+	UpdatedAt        time.Time
+}
+
+// PreferencesUpdate changes the non-empty fields only
+type PreferencesUpdate struct {
+	Locale           string
+	Timezone         string
+	Currency         string
+	HonorDelegations *bool
+}
+
+type PreferencesService interface {
+	GetPreferences(ctx context.Context, userID UserID) (*Preferences, error)
+	UpdatePreferences(ctx context.Context, userID UserID, update PreferencesUpdate) (*Preferences, error)
+}
+
+type PreferencesRepository interface {
+	// GetPreferences returns ErrProfileNotFound when the user has no profile
+	GetPreferences(ctx context.Context, userID string) (*Preferences, error)
+	// UpdatePreferences sets the non-empty fields and returns ErrProfileNotFound when the
+	// user has no profile
+	UpdatePreferences(ctx context.Context, userID string, update PreferencesUpdate) (*Preferences, error)
+}
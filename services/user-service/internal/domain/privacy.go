@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RelationshipKind is how one user treats another
+type RelationshipKind string
+
+const (
+	// RelationshipBlock hides each user's profile and activity from the other and drops
+	// notifications between them
+	RelationshipBlock RelationshipKind = "block"
+	// RelationshipMute only drops notifications involving the muted user
+	RelationshipMute RelationshipKind = "mute"
+	// RelationshipFollow counts the user among the target's followers
+	RelationshipFollow RelationshipKind = "follow"
+)
+
+// ProfileVisibility is who may see a user's profile and activity
+type ProfileVisibility string
+
+const (
+	VisibilityPublic ProfileVisibility = "public"
+	// VisibilityHolders admits viewers holding a token from one of the user's collections;
+	// callers check the holdings, since the catalog owns them
+	VisibilityHolders ProfileVisibility = "holders"
+	VisibilityPrivate ProfileVisibility = "private"
+)
+
+// MaxRelationships bounds the relationships of each kind one user can keep
+const MaxRelationships = 1000
+
+// Relationship is a block, mute or follow UserID set on TargetID
+type Relationship struct {
+	UserID    UserID
+	TargetID  UserID
+	Kind      RelationshipKind
+	CreatedAt time.Time
+}
+
+// ProfileAccess is what decides whether a viewer may see a profile. An empty ViewerID is
+// an anonymous viewer.
+type ProfileAccess struct {
+	OwnerID    UserID
+	ViewerID   UserID
+	Visibility ProfileVisibility
+	Blocked    bool // either user blocked the other
+}
+
+type PrivacyService interface {
+	// SetRelationship adds the block, mute or follow, or lifts it when active is false. A
+	// block ends the follows between the two users.
+	SetRelationship(ctx context.Context, userID, targetID UserID, kind RelationshipKind, active bool) error
+	// ListRelationships lists the user's blocks, mutes or follows, newest first
+	ListRelationships(ctx context.Context, userID UserID, kind RelationshipKind) ([]Relationship, error)
+	SetProfileVisibility(ctx context.Context, userID UserID, visibility ProfileVisibility) error
+	GetProfileAccess(ctx context.Context, viewerID, ownerID UserID) (*ProfileAccess, error)
+	// FilterRecipients keeps the recipients a notification involving parties may reach:
+	// those who have not blocked or muted another party's user, nor been blocked by one
+	FilterRecipients(ctx context.Context, recipients, parties []Address) ([]Address, error)
+}
+
+type PrivacyRepository interface {
+	// AddRelationship is idempotent; it returns ErrUserNotFound when the target does not
+	// exist. Adding or removing a follow moves the target's follower count with it.
+	AddRelationship(ctx context.Context, userID, targetID string, kind RelationshipKind) error
+	RemoveRelationship(ctx context.Context, userID, targetID string, kind RelationshipKind) error
+	CountRelationships(ctx context.Context, userID string, kind RelationshipKind) (int, error)
+	ListRelationships(ctx context.Context, userID string, kind RelationshipKind) ([]Relationship, error)
+	// SetVisibility returns ErrProfileNotFound when the user has no profile
+	SetVisibility(ctx context.Context, userID string, visibility ProfileVisibility) error
+	// GetProfileAccess returns ErrProfileNotFound when the owner has no profile
+	GetProfileAccess(ctx context.Context, viewerID, ownerID string) (*ProfileAccess, error)
+	// SuppressedRecipients returns the lowercase recipients whose user blocked or muted the
+	// user of another party, or was blocked by one
+	SuppressedRecipients(ctx context.Context, recipients, parties []string) (map[string]bool, error)
+}
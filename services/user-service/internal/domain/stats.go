@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"math/big"
+)
+
+// ProfileStats are the counters shown on a profile page. Followers are kept per user; the
+// others are kept per wallet from catalog events and summed over the user's accounts, so a
+// wallet brings its history along when it is linked.
+type ProfileStats struct {
+	CollectionsCreated int64
+	ItemsOwned         int64 // distinct tokens held
+	Followers          int64
+	VolumeWei          string // ETH bought and sold, base-10
+}
+
+// VolumeCurrency is the only sale currency counted in profile volume
+const VolumeCurrency = "ETH"
+
+// WalletStatsDelta moves the counters of one lowercase wallet
+type WalletStatsDelta struct {
+	Address            Address
+	CollectionsCreated int64
+	ItemsOwned         int64
+	VolumeWei          *big.Int // nil adds nothing
+}
+
+type ProfileStatsRepository interface {
+	// ApplyWalletStats applies the deltas once per event id; applied is false when the
+	// event was applied before
+	ApplyWalletStats(ctx context.Context, eventID string, deltas []WalletStatsDelta) (applied bool, err error)
+}
@@ -0,0 +1,58 @@
+package grpc_handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	userProto "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *gRPCHandler) SetAvatarFromNft(ctx context.Context, req *userProto.SetAvatarFromNftRequest) (*userProto.SetAvatarFromNftResponse, error) {
+	if s.avatarService == nil {
+		return nil, status.Error(codes.Unimplemented, "avatar service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	avatar, err := s.avatarService.SetAvatarFromNFT(ctx, req.UserId, req.ChainId, req.Contract, req.TokenId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.SetAvatarFromNftResponse{Avatar: toNftAvatar(avatar)}, nil
+}
+
+func (s *gRPCHandler) ClearNftAvatar(ctx context.Context, req *userProto.ClearNftAvatarRequest) (*userProto.ClearNftAvatarResponse, error) {
+	if s.avatarService == nil {
+		return nil, status.Error(codes.Unimplemented, "avatar service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.avatarService.ClearNFTAvatar(ctx, req.UserId); err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.ClearNftAvatarResponse{}, nil
+}
+
+func toNftAvatar(a *domain.NFTAvatar) *userProto.NftAvatar {
+	if a == nil {
+		return nil
+	}
+	out := &userProto.NftAvatar{
+		ChainId:   a.ChainID,
+		Contract:  a.Contract,
+		TokenId:   a.TokenID,
+		Verified:  a.Verified,
+		CheckedAt: a.CheckedAt.UTC().Format(time.RFC3339),
+	}
+	if a.VerifiedAt != nil {
+		out.VerifiedAt = a.VerifiedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
@@ -2,8 +2,11 @@ package grpc_handler
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
 	userProto "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,7 +14,13 @@ import (
 
 type gRPCHandler struct {
 	userProto.UnimplementedUserServiceServer
-	userService domain.UserService
+	userService    domain.UserService
+	emailService   domain.EmailService
+	orgService     domain.OrganizationService
+	prefsService   domain.PreferencesService
+	privacyService domain.PrivacyService
+	avatarService  domain.AvatarService
+	notifyService  domain.NotificationEmailService
 }
 
 func NewgRPCHandler(userService domain.UserService) *gRPCHandler {
@@ -21,6 +30,42 @@ func NewgRPCHandler(userService domain.UserService) *gRPCHandler {
 	return handler
 }
 
+// WithEmailService enables the email linking RPCs
+func (s *gRPCHandler) WithEmailService(emailService domain.EmailService) *gRPCHandler {
+	s.emailService = emailService
+	return s
+}
+
+// WithOrganizationService enables the organization RPCs
+func (s *gRPCHandler) WithOrganizationService(orgService domain.OrganizationService) *gRPCHandler {
+	s.orgService = orgService
+	return s
+}
+
+// WithPreferencesService enables the preferences RPCs
+func (s *gRPCHandler) WithPreferencesService(prefsService domain.PreferencesService) *gRPCHandler {
+	s.prefsService = prefsService
+	return s
+}
+
+// WithPrivacyService enables the block, mute and profile visibility RPCs
+func (s *gRPCHandler) WithPrivacyService(privacyService domain.PrivacyService) *gRPCHandler {
+	s.privacyService = privacyService
+	return s
+}
+
+// WithNotificationEmailService enables the unsubscribe link RPC
+func (s *gRPCHandler) WithNotificationEmailService(notifyService domain.NotificationEmailService) *gRPCHandler {
+	s.notifyService = notifyService
+	return s
+}
+
+// WithAvatarService enables the NFT avatar RPCs
+func (s *gRPCHandler) WithAvatarService(avatarService domain.AvatarService) *gRPCHandler {
+	s.avatarService = avatarService
+	return s
+}
+
 func (s *gRPCHandler) EnsureUser(ctx context.Context, req *userProto.EnsureUserRequest) (*userProto.EnsureUserResponse, error) {
 	// Validate request
 	if req == nil {
@@ -38,7 +83,7 @@ func (s *gRPCHandler) EnsureUser(ctx context.Context, req *userProto.EnsureUserR
 	// Call service layer
 	result, err := s.userService.EnsureUser(ctx, req.AccountId, req.Address, req.ChainId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, errs.ToGRPC(err)
 	}
 
 	// Return response
@@ -47,3 +92,199 @@ func (s *gRPCHandler) EnsureUser(ctx context.Context, req *userProto.EnsureUserR
 		Created: result.Created,
 	}, nil
 }
+
+func (s *gRPCHandler) GetUsersByIDs(ctx context.Context, req *userProto.GetUsersByIDsRequest) (*userProto.GetUsersByIDsResponse, error) {
+	cards, err := s.userService.GetUsersByIDs(ctx, req.UserIds)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	resp := &userProto.GetUsersByIDsResponse{Users: make([]*userProto.UserCard, len(cards))}
+	for i, card := range cards {
+		entry := &userProto.UserCard{}
+		if card != nil {
+			entry.Found = true
+			entry.User = toUser(&card.User)
+			entry.Profile = toProfile(&card.Profile)
+		}
+		resp.Users[i] = entry
+	}
+	return resp, nil
+}
+
+func (s *gRPCHandler) GetProfilesByAddresses(ctx context.Context, req *userProto.GetProfilesByAddressesRequest) (*userProto.GetProfilesByAddressesResponse, error) {
+	cards, err := s.userService.GetProfilesByAddresses(ctx, req.Addresses)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	resp := &userProto.GetProfilesByAddressesResponse{Profiles: make([]*userProto.AddressProfile, len(cards))}
+	for i, card := range cards {
+		entry := &userProto.AddressProfile{Address: strings.ToLower(req.Addresses[i])}
+		if card != nil {
+			entry.Found = true
+			entry.User = toUser(&card.User)
+			entry.Profile = toProfile(&card.Profile)
+		}
+		resp.Profiles[i] = entry
+	}
+	return resp, nil
+}
+
+func (s *gRPCHandler) SuggestUsers(ctx context.Context, req *userProto.SuggestUsersRequest) (*userProto.SuggestUsersResponse, error) {
+	suggestions, err := s.userService.SuggestUsers(ctx, req.Query, int(req.Limit))
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	resp := &userProto.SuggestUsersResponse{Users: make([]*userProto.UserSuggestion, len(suggestions))}
+	for i, suggestion := range suggestions {
+		resp.Users[i] = &userProto.UserSuggestion{
+			UserId:      suggestion.UserID,
+			Username:    suggestion.Username,
+			DisplayName: suggestion.DisplayName,
+			AvatarUrl:   suggestion.AvatarURL,
+			Score:       suggestion.Score,
+		}
+	}
+	return resp, nil
+}
+
+func toUser(u *domain.User) *userProto.User {
+	return &userProto.User{
+		Id:        u.ID,
+		Status:    u.Status,
+		CreatedAt: u.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toProfile(p *domain.Profile) *userProto.Profile {
+	return &userProto.Profile{
+		UserId:      p.UserID,
+		Username:    p.Username,
+		DisplayName: p.DisplayName,
+		AvatarUrl:   p.AvatarURL,
+		BannerUrl:   p.BannerURL,
+		Bio:         p.Bio,
+		Locale:      p.Locale,
+		Timezone:    p.Timezone,
+		Currency:    p.Currency,
+		SocialsJson: p.SocialsJSON,
+		UpdatedAt:   p.UpdatedAt.UTC().Format(time.RFC3339),
+		NftAvatar:   toNftAvatar(p.NFTAvatar),
+		Stats:       toProfileStats(p.Stats),
+	}
+}
+
+func toProfileStats(st domain.ProfileStats) *userProto.ProfileStats {
+	volume := st.VolumeWei
+	if volume == "" {
+		volume = "0"
+	}
+	return &userProto.ProfileStats{
+		CollectionsCreated: st.CollectionsCreated,
+		ItemsOwned:         st.ItemsOwned,
+		Followers:          st.Followers,
+		VolumeWei:          volume,
+	}
+}
+
+func (s *gRPCHandler) StartEmailVerification(ctx context.Context, req *userProto.StartEmailVerificationRequest) (*userProto.StartEmailVerificationResponse, error) {
+	if s.emailService == nil {
+		return nil, status.Error(codes.Unimplemented, "email service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	expiresAt, err := s.emailService.StartEmailVerification(ctx, req.UserId, req.Email)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.StartEmailVerificationResponse{
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func (s *gRPCHandler) ConfirmEmail(ctx context.Context, req *userProto.ConfirmEmailRequest) (*userProto.ConfirmEmailResponse, error) {
+	if s.emailService == nil {
+		return nil, status.Error(codes.Unimplemented, "email service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	email, err := s.emailService.ConfirmEmail(ctx, req.UserId, req.Code)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.ConfirmEmailResponse{Email: toEmailStatus(email)}, nil
+}
+
+func (s *gRPCHandler) GetEmailStatus(ctx context.Context, req *userProto.GetEmailStatusRequest) (*userProto.GetEmailStatusResponse, error) {
+	if s.emailService == nil {
+		return nil, status.Error(codes.Unimplemented, "email service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	email, err := s.emailService.GetEmailStatus(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.GetEmailStatusResponse{Email: toEmailStatus(email)}, nil
+}
+
+func (s *gRPCHandler) SetEmailDigestOptOut(ctx context.Context, req *userProto.SetEmailDigestOptOutRequest) (*userProto.SetEmailDigestOptOutResponse, error) {
+	if s.emailService == nil {
+		return nil, status.Error(codes.Unimplemented, "email service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	email, err := s.emailService.SetEmailDigestOptOut(ctx, req.UserId, req.OptOut)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.SetEmailDigestOptOutResponse{Email: toEmailStatus(email)}, nil
+}
+
+func (s *gRPCHandler) GetNotificationEmail(ctx context.Context, req *userProto.GetNotificationEmailRequest) (*userProto.GetNotificationEmailResponse, error) {
+	if s.emailService == nil {
+		return nil, status.Error(codes.Unimplemented, "email service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	email, deliverable, err := s.emailService.GetNotificationEmail(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.GetNotificationEmailResponse{
+		Email:       email,
+		Deliverable: deliverable,
+	}, nil
+}
+
+func toEmailStatus(e *domain.UserEmail) *userProto.EmailStatus {
+	out := &userProto.EmailStatus{
+		Email:        e.Email,
+		Verified:     !e.VerifiedAt.IsZero(),
+		DigestOptOut: e.DigestOptOut,
+		VerifiedAt:   e.VerifiedAt.UTC().Format(time.RFC3339),
+	}
+	for _, kind := range domain.EmailKinds {
+		if e.OptedOut(kind) {
+			out.OptOuts = append(out.OptOuts, string(kind))
+		}
+	}
+	return out
+}
@@ -0,0 +1,41 @@
+package grpc_handler
+
+import (
+	"context"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	userProto "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *gRPCHandler) SetEmailOptOut(ctx context.Context, req *userProto.SetEmailOptOutRequest) (*userProto.SetEmailOptOutResponse, error) {
+	if s.emailService == nil {
+		return nil, status.Error(codes.Unimplemented, "email service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	email, err := s.emailService.SetEmailOptOut(ctx, req.UserId, domain.EmailKind(req.Kind), req.OptOut)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.SetEmailOptOutResponse{Email: toEmailStatus(email)}, nil
+}
+
+func (s *gRPCHandler) UnsubscribeEmail(ctx context.Context, req *userProto.UnsubscribeEmailRequest) (*userProto.UnsubscribeEmailResponse, error) {
+	if s.notifyService == nil {
+		return nil, status.Error(codes.Unimplemented, "notification emails not configured")
+	}
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	email, kind, err := s.notifyService.Unsubscribe(ctx, req.Token)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.UnsubscribeEmailResponse{Kind: string(kind), Email: toEmailStatus(email)}, nil
+}
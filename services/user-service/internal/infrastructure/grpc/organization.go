@@ -0,0 +1,172 @@
+package grpc_handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	userProto "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *gRPCHandler) CreateOrganization(ctx context.Context, req *userProto.CreateOrganizationRequest) (*userProto.CreateOrganizationResponse, error) {
+	if s.orgService == nil {
+		return nil, status.Error(codes.Unimplemented, "organization service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	org, err := s.orgService.CreateOrganization(ctx, req.UserId, req.Name)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.CreateOrganizationResponse{Organization: toOrganization(org)}, nil
+}
+
+func (s *gRPCHandler) GetOrganization(ctx context.Context, req *userProto.GetOrganizationRequest) (*userProto.GetOrganizationResponse, error) {
+	if s.orgService == nil {
+		return nil, status.Error(codes.Unimplemented, "organization service not configured")
+	}
+	if req.OrgId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and user_id are required")
+	}
+
+	org, members, err := s.orgService.GetOrganization(ctx, req.OrgId, req.UserId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	resp := &userProto.GetOrganizationResponse{Organization: toOrganization(org)}
+	for i := range members {
+		resp.Members = append(resp.Members, toOrganizationMember(&members[i]))
+	}
+	return resp, nil
+}
+
+func (s *gRPCHandler) ListUserOrganizations(ctx context.Context, req *userProto.ListUserOrganizationsRequest) (*userProto.ListUserOrganizationsResponse, error) {
+	if s.orgService == nil {
+		return nil, status.Error(codes.Unimplemented, "organization service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	memberships, err := s.orgService.ListUserOrganizations(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	resp := &userProto.ListUserOrganizationsResponse{}
+	for i := range memberships {
+		resp.Memberships = append(resp.Memberships, toOrganizationMembership(&memberships[i]))
+	}
+	return resp, nil
+}
+
+func (s *gRPCHandler) InviteOrganizationMember(ctx context.Context, req *userProto.InviteOrganizationMemberRequest) (*userProto.InviteOrganizationMemberResponse, error) {
+	if s.orgService == nil {
+		return nil, status.Error(codes.Unimplemented, "organization service not configured")
+	}
+	if req.OrgId == "" || req.InviterId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and inviter_id are required")
+	}
+
+	inv, err := s.orgService.InviteMember(ctx, req.OrgId, req.InviterId, req.Email, req.Role)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.InviteOrganizationMemberResponse{
+		InvitationId: inv.ID,
+		ExpiresAt:    inv.ExpiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func (s *gRPCHandler) AcceptOrganizationInvitation(ctx context.Context, req *userProto.AcceptOrganizationInvitationRequest) (*userProto.AcceptOrganizationInvitationResponse, error) {
+	if s.orgService == nil {
+		return nil, status.Error(codes.Unimplemented, "organization service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	membership, err := s.orgService.AcceptInvitation(ctx, req.UserId, req.Token)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.AcceptOrganizationInvitationResponse{Membership: toOrganizationMembership(membership)}, nil
+}
+
+func (s *gRPCHandler) RemoveOrganizationMember(ctx context.Context, req *userProto.RemoveOrganizationMemberRequest) (*userProto.RemoveOrganizationMemberResponse, error) {
+	if s.orgService == nil {
+		return nil, status.Error(codes.Unimplemented, "organization service not configured")
+	}
+	if req.OrgId == "" || req.ActorId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id, actor_id and user_id are required")
+	}
+
+	if err := s.orgService.RemoveMember(ctx, req.OrgId, req.ActorId, req.UserId); err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.RemoveOrganizationMemberResponse{}, nil
+}
+
+func (s *gRPCHandler) SetOrganizationMemberRole(ctx context.Context, req *userProto.SetOrganizationMemberRoleRequest) (*userProto.SetOrganizationMemberRoleResponse, error) {
+	if s.orgService == nil {
+		return nil, status.Error(codes.Unimplemented, "organization service not configured")
+	}
+	if req.OrgId == "" || req.ActorId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id, actor_id and user_id are required")
+	}
+
+	member, err := s.orgService.SetMemberRole(ctx, req.OrgId, req.ActorId, req.UserId, req.Role)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.SetOrganizationMemberRoleResponse{Member: toOrganizationMember(member)}, nil
+}
+
+func (s *gRPCHandler) GetOrganizationMembership(ctx context.Context, req *userProto.GetOrganizationMembershipRequest) (*userProto.GetOrganizationMembershipResponse, error) {
+	if s.orgService == nil {
+		return nil, status.Error(codes.Unimplemented, "organization service not configured")
+	}
+	if req.OrgId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and user_id are required")
+	}
+
+	member, err := s.orgService.GetMembership(ctx, req.OrgId, req.UserId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.GetOrganizationMembershipResponse{Member: toOrganizationMember(member)}, nil
+}
+
+func toOrganization(o *domain.Organization) *userProto.Organization {
+	return &userProto.Organization{
+		Id:        o.ID,
+		Name:      o.Name,
+		CreatedBy: o.CreatedBy,
+		CreatedAt: o.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toOrganizationMember(m *domain.OrgMember) *userProto.OrganizationMember {
+	return &userProto.OrganizationMember{
+		OrgId:    m.OrgID,
+		UserId:   m.UserID,
+		Role:     m.Role,
+		JoinedAt: m.JoinedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toOrganizationMembership(m *domain.OrgMembership) *userProto.OrganizationMembership {
+	return &userProto.OrganizationMembership{
+		Organization: toOrganization(&m.Organization),
+		Role:         m.Role,
+	}
+}
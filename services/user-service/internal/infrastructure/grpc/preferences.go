@@ -0,0 +1,60 @@
+package grpc_handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	userProto "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *gRPCHandler) GetPreferences(ctx context.Context, req *userProto.GetPreferencesRequest) (*userProto.GetPreferencesResponse, error) {
+	if s.prefsService == nil {
+		return nil, status.Error(codes.Unimplemented, "preferences service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	prefs, err := s.prefsService.GetPreferences(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.GetPreferencesResponse{Preferences: toPreferences(prefs)}, nil
+}
+
+func (s *gRPCHandler) UpdatePreferences(ctx context.Context, req *userProto.UpdatePreferencesRequest) (*userProto.UpdatePreferencesResponse, error) {
+	if s.prefsService == nil {
+		return nil, status.Error(codes.Unimplemented, "preferences service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	prefs, err := s.prefsService.UpdatePreferences(ctx, req.UserId, domain.PreferencesUpdate{
+		Locale:           req.Locale,
+		Timezone:         req.Timezone,
+		Currency:         req.Currency,
+		HonorDelegations: req.HonorDelegations,
+	})
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	return &userProto.UpdatePreferencesResponse{Preferences: toPreferences(prefs)}, nil
+}
+
+func toPreferences(p *domain.Preferences) *userProto.Preferences {
+	return &userProto.Preferences{
+		UserId:           p.UserID,
+		Locale:           p.Locale,
+		Timezone:         p.Timezone,
+		Currency:         p.Currency,
+		HonorDelegations: p.HonorDelegations,
+		UpdatedAt:        p.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,95 @@
+package grpc_handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
+	userProto "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *gRPCHandler) SetRelationship(ctx context.Context, req *userProto.SetRelationshipRequest) (*userProto.SetRelationshipResponse, error) {
+	if s.privacyService == nil {
+		return nil, status.Error(codes.Unimplemented, "privacy service not configured")
+	}
+	if req.UserId == "" || req.TargetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and target_id are required")
+	}
+
+	if err := s.privacyService.SetRelationship(ctx, req.UserId, req.TargetId, domain.RelationshipKind(req.Kind), req.Active); err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.SetRelationshipResponse{}, nil
+}
+
+func (s *gRPCHandler) ListRelationships(ctx context.Context, req *userProto.ListRelationshipsRequest) (*userProto.ListRelationshipsResponse, error) {
+	if s.privacyService == nil {
+		return nil, status.Error(codes.Unimplemented, "privacy service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	rels, err := s.privacyService.ListRelationships(ctx, req.UserId, domain.RelationshipKind(req.Kind))
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+
+	resp := &userProto.ListRelationshipsResponse{Relationships: make([]*userProto.Relationship, len(rels))}
+	for i, rel := range rels {
+		resp.Relationships[i] = &userProto.Relationship{
+			UserId:    rel.UserID,
+			TargetId:  rel.TargetID,
+			Kind:      string(rel.Kind),
+			CreatedAt: rel.CreatedAt.UTC().Format(time.RFC3339),
+		}
+	}
+	return resp, nil
+}
+
+func (s *gRPCHandler) SetProfileVisibility(ctx context.Context, req *userProto.SetProfileVisibilityRequest) (*userProto.SetProfileVisibilityResponse, error) {
+	if s.privacyService == nil {
+		return nil, status.Error(codes.Unimplemented, "privacy service not configured")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.privacyService.SetProfileVisibility(ctx, req.UserId, domain.ProfileVisibility(req.Visibility)); err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.SetProfileVisibilityResponse{}, nil
+}
+
+func (s *gRPCHandler) GetProfileAccess(ctx context.Context, req *userProto.GetProfileAccessRequest) (*userProto.GetProfileAccessResponse, error) {
+	if s.privacyService == nil {
+		return nil, status.Error(codes.Unimplemented, "privacy service not configured")
+	}
+	if req.OwnerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner_id is required")
+	}
+
+	access, err := s.privacyService.GetProfileAccess(ctx, req.ViewerId, req.OwnerId)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.GetProfileAccessResponse{
+		Visibility: string(access.Visibility),
+		Blocked:    access.Blocked,
+	}, nil
+}
+
+func (s *gRPCHandler) FilterNotificationRecipients(ctx context.Context, req *userProto.FilterNotificationRecipientsRequest) (*userProto.FilterNotificationRecipientsResponse, error) {
+	if s.privacyService == nil {
+		return nil, status.Error(codes.Unimplemented, "privacy service not configured")
+	}
+
+	kept, err := s.privacyService.FilterRecipients(ctx, req.Recipients, req.Parties)
+	if err != nil {
+		return nil, errs.ToGRPC(err)
+	}
+	return &userProto.FilterNotificationRecipientsResponse{Recipients: kept}, nil
+}
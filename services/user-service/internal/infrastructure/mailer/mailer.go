@@ -0,0 +1,112 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"net/smtp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+)
+
+// New returns the mailer selected by MAILER_DRIVER (log | smtp | ses).
+// SES is reached through its SMTP interface, so both share the SMTP sender.
+func New(cfg config.MailerConfig) (domain.Mailer, error) {
+	switch strings.ToLower(cfg.Driver) {
+	case "", "log":
+		return &LogMailer{}, nil
+	case "smtp":
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.From)
+	case "ses":
+		host := cfg.SMTPHost
+		if host == "" {
+			host = fmt.Sprintf("email-smtp.%s.amazonaws.com", cfg.SESRegion)
+		}
+		return NewSMTPMailer(host, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.From)
+	default:
+		return nil, fmt.Errorf("unknown mailer driver %q", cfg.Driver)
+	}
+}
+
+// LogMailer writes messages to the log instead of sending them (development)
+type LogMailer struct{}
+
+func (m *LogMailer) Send(ctx context.Context, msg domain.OutgoingEmail) error {
+	// The HTML part is left out; the text part says the same
+	log.Printf("mailer|to=%s|subject=%s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPMailer(host string, port int, user, password, from string) (*SMTPMailer, error) {
+	if host == "" || from == "" {
+		return nil, fmt.Errorf("smtp mailer requires host and from address")
+	}
+	var auth smtp.Auth
+	if user != "" {
+		auth = smtp.PlainAuth("", user, password, host)
+	}
+	return &SMTPMailer{
+		addr: fmt.Sprintf("%s:%d", host, port),
+		auth: auth,
+		from: from,
+	}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg domain.OutgoingEmail) error {
+	headers := []string{
+		"From: " + m.from,
+		"To: " + msg.To,
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"MIME-Version: 1.0",
+	}
+	names := make([]string, 0, len(msg.Headers))
+	for name := range msg.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		headers = append(headers, name+": "+msg.Headers[name])
+	}
+
+	var body string
+	if msg.HTML == "" {
+		body = strings.Join(append(headers,
+			"Content-Type: text/plain; charset=UTF-8",
+			"",
+			msg.Body,
+		), "\r\n")
+	} else {
+		// Clients that can't show the HTML part fall back to the text one
+		boundary := "alt-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+		body = strings.Join(append(headers,
+			"Content-Type: multipart/alternative; boundary="+boundary,
+			"",
+			"--"+boundary,
+			"Content-Type: text/plain; charset=UTF-8",
+			"",
+			msg.Body,
+			"--"+boundary,
+			"Content-Type: text/html; charset=UTF-8",
+			"",
+			msg.HTML,
+			"--"+boundary+"--",
+		), "\r\n")
+	}
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, []byte(body)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/locale"
+)
+
+// defaultLocale is used when no translation is close to the recipient's locale
+const defaultLocale = "en"
+
+// Each locale directory holds common.tmpl and one <kind>.tmpl per kind defining "subject",
+// "text" and "html"; layout.html.tmpl wraps the html part of every email.
+//
+//go:embed templates
+var templateFS embed.FS
+
+type kindTemplates struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// TemplateRenderer renders notification emails from the embedded templates
+type TemplateRenderer struct {
+	locales   []string
+	templates map[string]map[domain.EmailKind]kindTemplates
+}
+
+// NewTemplateRenderer parses every translation up front, so a broken template stops the
+// service from starting instead of failing a send
+func NewTemplateRenderer() (*TemplateRenderer, error) {
+	entries, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("read email templates: %w", err)
+	}
+
+	r := &TemplateRenderer{templates: make(map[string]map[domain.EmailKind]kindTemplates)}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		lang := entry.Name()
+		r.locales = append(r.locales, lang)
+		r.templates[lang] = make(map[domain.EmailKind]kindTemplates)
+		for _, kind := range domain.EmailKinds {
+			files := []string{"templates/" + lang + "/common.tmpl", "templates/" + lang + "/" + string(kind) + ".tmpl"}
+			text, err := texttemplate.ParseFS(templateFS, files...)
+			if err != nil {
+				return nil, fmt.Errorf("parse %s %s email: %w", lang, kind, err)
+			}
+			html, err := htmltemplate.ParseFS(templateFS, append([]string{"templates/layout.html.tmpl"}, files...)...)
+			if err != nil {
+				return nil, fmt.Errorf("parse %s %s email: %w", lang, kind, err)
+			}
+			r.templates[lang][kind] = kindTemplates{text: text, html: html}
+		}
+	}
+	if _, ok := r.templates[defaultLocale]; !ok {
+		return nil, fmt.Errorf("email templates have no %s translation", defaultLocale)
+	}
+	return r, nil
+}
+
+func (r *TemplateRenderer) Render(kind domain.EmailKind, loc string, data any) (*domain.RenderedEmail, error) {
+	lang, ok := locale.Match(r.locales, loc)
+	if !ok {
+		lang = defaultLocale
+	}
+	t, ok := r.templates[lang][kind]
+	if !ok {
+		return nil, fmt.Errorf("no email template for %s", kind)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("render %s subject: %w", kind, err)
+	}
+	if err := t.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return nil, fmt.Errorf("render %s text: %w", kind, err)
+	}
+	layout := struct {
+		Lang string
+		Data any
+	}{Lang: lang, Data: data}
+	if err := t.html.ExecuteTemplate(&html, "layout", layout); err != nil {
+		return nil, fmt.Errorf("render %s html: %w", kind, err)
+	}
+
+	return &domain.RenderedEmail{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()),
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// AvatarRepository stores NFT avatars and checks holdings against the catalog's ownership
+// index and wallet-service's wallets, which share the database
+type AvatarRepository struct {
+	db *postgres.Postgres
+}
+
+func NewAvatarRepository(db *postgres.Postgres) domain.AvatarRepository {
+	return &AvatarRepository{db: db}
+}
+
+func (r *AvatarRepository) FindNFTHolder(ctx context.Context, userID, chainID, contract, tokenID string) (string, error) {
+	// The ownership index folds every indexed transfer of the token into balances; it keys
+	// chains in the indexer form (eip155-1) and addresses in lowercase
+	const q = `
+WITH moves AS (
+	SELECT to_addr AS holder, quantity FROM ownership_transfers
+	WHERE chain_id = $2 AND contract = $3 AND token_id = $4
+	UNION ALL
+	SELECT from_addr, -quantity FROM ownership_transfers
+	WHERE chain_id = $2 AND contract = $3 AND token_id = $4
+)
+SELECT holder
+FROM moves
+WHERE holder IN (
+	SELECT lower(address) FROM wallets WHERE user_id::text = $1 AND NOT is_watch_only
+)
+GROUP BY holder
+HAVING SUM(quantity) > 0
+ORDER BY holder
+LIMIT 1`
+
+	var holder string
+	err := r.db.GetClient().QueryRowContext(ctx, q,
+		userID, strings.ReplaceAll(chainID, ":", "-"), strings.ToLower(contract), tokenID,
+	).Scan(&holder)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", domain.NewDatabaseError("find_nft_holder", err)
+	}
+	return holder, nil
+}
+
+func (r *AvatarRepository) PutNFTAvatar(ctx context.Context, avatar *domain.NFTAvatar) error {
+	const q = `
+INSERT INTO profile_nft_avatars (user_id, chain_id, contract, token_id, holder, verified, verified_at, checked_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+ON CONFLICT (user_id) DO UPDATE SET
+	chain_id = EXCLUDED.chain_id, contract = EXCLUDED.contract, token_id = EXCLUDED.token_id,
+	holder = EXCLUDED.holder, verified = EXCLUDED.verified, verified_at = EXCLUDED.verified_at,
+	checked_at = EXCLUDED.checked_at, created_at = EXCLUDED.created_at`
+
+	_, err := r.db.GetClient().ExecContext(ctx, q,
+		avatar.UserID, avatar.ChainID, avatar.Contract, avatar.TokenID,
+		avatar.Holder, avatar.Verified, avatar.VerifiedAt, avatar.CheckedAt,
+	)
+	if err != nil {
+		if unknownUser(err) {
+			return domain.ErrUserNotFound
+		}
+		return domain.NewDatabaseError("put_nft_avatar", err)
+	}
+	return nil
+}
+
+func (r *AvatarRepository) DeleteNFTAvatar(ctx context.Context, userID string) error {
+	const q = `DELETE FROM profile_nft_avatars WHERE user_id::text = $1`
+
+	if _, err := r.db.GetClient().ExecContext(ctx, q, userID); err != nil {
+		return domain.NewDatabaseError("delete_nft_avatar", err)
+	}
+	return nil
+}
+
+func (r *AvatarRepository) ListNFTAvatarsCheckedBefore(ctx context.Context, before time.Time, limit int) ([]domain.NFTAvatar, error) {
+	const q = `
+SELECT user_id, chain_id, contract, token_id, holder, verified, verified_at, checked_at, created_at
+FROM profile_nft_avatars
+WHERE checked_at < $1
+ORDER BY checked_at
+LIMIT $2`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, q, before, limit)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list_nft_avatars", err)
+	}
+	defer rows.Close()
+
+	var out []domain.NFTAvatar
+	for rows.Next() {
+		var a domain.NFTAvatar
+		var verifiedAt sql.NullTime
+		if err := rows.Scan(&a.UserID, &a.ChainID, &a.Contract, &a.TokenID, &a.Holder,
+			&a.Verified, &verifiedAt, &a.CheckedAt, &a.CreatedAt); err != nil {
+			return nil, domain.NewDatabaseError("list_nft_avatars", err)
+		}
+		if verifiedAt.Valid {
+			a.VerifiedAt = &verifiedAt.Time
+		}
+		out = append(out, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("list_nft_avatars", err)
+	}
+	return out, nil
+}
+
+func (r *AvatarRepository) RecordNFTAvatarCheck(ctx context.Context, avatar *domain.NFTAvatar) error {
+	const q = `
+UPDATE profile_nft_avatars
+SET holder = $5, verified = $6, verified_at = $7, checked_at = $8
+WHERE user_id::text = $1 AND chain_id = $2 AND contract = $3 AND token_id = $4`
+
+	_, err := r.db.GetClient().ExecContext(ctx, q,
+		avatar.UserID, avatar.ChainID, avatar.Contract, avatar.TokenID,
+		avatar.Holder, avatar.Verified, avatar.VerifiedAt, avatar.CheckedAt,
+	)
+	if err != nil {
+		return domain.NewDatabaseError("record_nft_avatar_check", err)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type EmailRepository struct {
+	db *postgres.Postgres
+}
+
+func NewEmailRepository(db *postgres.Postgres) domain.EmailRepository {
+	return &EmailRepository{db: db}
+}
+
+// userEmailColumns are read by scanUserEmail
+const userEmailColumns = `user_id, email, verified_at, digest_opt_out, opt_outs, updated_at`
+
+func (r *EmailRepository) GetEmail(ctx context.Context, userID string) (*domain.UserEmail, error) {
+	q := `SELECT ` + userEmailColumns + ` FROM user_emails WHERE user_id = $1`
+
+	e, err := scanUserEmail(r.db.GetClient().QueryRowContext(ctx, q, userID))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrEmailNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_email", err)
+	}
+	return e, nil
+}
+
+func (r *EmailRepository) IsEmailTaken(ctx context.Context, email, exceptUserID string) (bool, error) {
+	const q = `SELECT EXISTS (SELECT 1 FROM user_emails WHERE LOWER(email) = LOWER($1) AND user_id <> $2)`
+
+	var taken bool
+	if err := r.db.GetClient().QueryRowContext(ctx, q, email, exceptUserID).Scan(&taken); err != nil {
+		return false, domain.NewDatabaseError("is_email_taken", err)
+	}
+	return taken, nil
+}
+
+func (r *EmailRepository) CreateVerification(ctx context.Context, v *domain.EmailVerification) error {
+	const q = `
+INSERT INTO email_verifications (user_id, email, code_hash, expires_at, created_at)
+VALUES ($1, $2, $3, $4, now())
+RETURNING id, created_at`
+
+	err := r.db.GetClient().QueryRowContext(ctx, q, v.UserID, strings.ToLower(v.Email), v.CodeHash, v.ExpiresAt).Scan(&v.ID, &v.CreatedAt)
+	if err != nil {
+		return domain.NewDatabaseError("create_verification", err)
+	}
+	return nil
+}
+
+func (r *EmailRepository) GetVerification(ctx context.Context, id string) (*domain.EmailVerification, error) {
+	const q = `
+SELECT id, user_id, email, code_hash, attempts, expires_at, consumed_at, created_at
+FROM email_verifications WHERE id = $1`
+
+	return r.scanVerification(r.db.GetClient().QueryRowContext(ctx, q, id))
+}
+
+func (r *EmailRepository) GetLatestVerification(ctx context.Context, userID string) (*domain.EmailVerification, error) {
+	const q = `
+SELECT id, user_id, email, code_hash, attempts, expires_at, consumed_at, created_at
+FROM email_verifications WHERE user_id = $1
+ORDER BY created_at DESC LIMIT 1`
+
+	return r.scanVerification(r.db.GetClient().QueryRowContext(ctx, q, userID))
+}
+
+func (r *EmailRepository) IncrementVerificationAttempts(ctx context.Context, id string) error {
+	const q = `UPDATE email_verifications SET attempts = attempts + 1 WHERE id = $1`
+	if _, err := r.db.GetClient().ExecContext(ctx, q, id); err != nil {
+		return domain.NewDatabaseError("increment_verification_attempts", err)
+	}
+	return nil
+}
+
+func (r *EmailRepository) ConfirmVerification(ctx context.Context, v *domain.EmailVerification) (*domain.UserEmail, error) {
+	tx, err := r.db.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, domain.NewDatabaseError("begin_tx", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	const consume = `UPDATE email_verifications SET consumed_at = now() WHERE id = $1 AND consumed_at IS NULL`
+	res, err := tx.ExecContext(ctx, consume, v.ID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("consume_verification", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, domain.ErrVerificationInvalid
+	}
+
+	upsert := `
+INSERT INTO user_emails (user_id, email, verified_at, updated_at)
+VALUES ($1, $2, now(), now())
+ON CONFLICT (user_id)
+DO UPDATE SET
+  email       = EXCLUDED.email,
+  verified_at = EXCLUDED.verified_at,
+  updated_at  = now()
+RETURNING ` + userEmailColumns
+
+	e, err := scanUserEmail(tx.QueryRowContext(ctx, upsert, v.UserID, v.Email))
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, domain.ErrEmailTaken
+		}
+		return nil, domain.NewDatabaseError("upsert_user_email", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, domain.NewDatabaseError("commit_tx", err)
+	}
+	return e, nil
+}
+
+func (r *EmailRepository) SetDigestOptOut(ctx context.Context, userID string, optOut bool) (*domain.UserEmail, error) {
+	q := `
+UPDATE user_emails SET digest_opt_out = $2, updated_at = now()
+WHERE user_id = $1
+RETURNING ` + userEmailColumns
+
+	e, err := scanUserEmail(r.db.GetClient().QueryRowContext(ctx, q, userID, optOut))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrEmailNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("set_digest_opt_out", err)
+	}
+	return e, nil
+}
+
+func (r *EmailRepository) SetOptOut(ctx context.Context, userID string, kind domain.EmailKind, optOut bool) (*domain.UserEmail, error) {
+	q := `
+UPDATE user_emails SET
+  opt_outs = CASE
+    WHEN $3 THEN ARRAY(SELECT DISTINCT unnest(array_append(opt_outs, $2::text)) ORDER BY 1)
+    ELSE array_remove(opt_outs, $2::text)
+  END,
+  updated_at = now()
+WHERE user_id = $1
+RETURNING ` + userEmailColumns
+
+	e, err := scanUserEmail(r.db.GetClient().QueryRowContext(ctx, q, userID, string(kind), optOut))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrEmailNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("set_email_opt_out", err)
+	}
+	return e, nil
+}
+
+// rowScanner is a *sql.Row or *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUserEmail(row rowScanner) (*domain.UserEmail, error) {
+	var e domain.UserEmail
+	var optOuts []string
+	if err := row.Scan(&e.UserID, &e.Email, &e.VerifiedAt, &e.DigestOptOut, pq.Array(&optOuts), &e.UpdatedAt); err != nil {
+		return nil, err
+	}
+	for _, kind := range optOuts {
+		e.OptOuts = append(e.OptOuts, domain.EmailKind(kind))
+	}
+	return &e, nil
+}
+
+func (r *EmailRepository) scanVerification(row *sql.Row) (*domain.EmailVerification, error) {
+	var v domain.EmailVerification
+	var consumedAt sql.NullTime
+	err := row.Scan(&v.ID, &v.UserID, &v.Email, &v.CodeHash, &v.Attempts, &v.ExpiresAt, &consumedAt, &v.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrVerificationNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_verification", err)
+	}
+	if consumedAt.Valid {
+		v.ConsumedAt = &consumedAt.Time
+	}
+	return &v, nil
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type NotificationEmailRepository struct {
+	db *postgres.Postgres
+}
+
+func NewNotificationEmailRepository(db *postgres.Postgres) domain.NotificationEmailRepository {
+	return &NotificationEmailRepository{db: db}
+}
+
+// recipientColumns are read by scanRecipient; users without a profile get the defaults
+const recipientColumns = `e.user_id, e.email, e.verified_at, e.digest_opt_out, e.opt_outs, e.updated_at,
+	COALESCE(p.locale, 'en'), COALESCE(p.timezone, 'UTC')`
+
+func (r *NotificationEmailRepository) RecipientsByAddresses(ctx context.Context, addresses []string) ([]domain.EmailRecipient, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+	q := `
+SELECT DISTINCT ON (e.user_id) ` + recipientColumns + `
+FROM user_accounts a
+JOIN users u ON u.id = a.user_id AND u.status = 'active'
+JOIN user_emails e ON e.user_id = a.user_id
+LEFT JOIN profiles p ON p.user_id = a.user_id
+WHERE a.address = ANY($1)
+ORDER BY e.user_id`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, q, pq.Array(addresses))
+	if err != nil {
+		return nil, domain.NewDatabaseError("recipients_by_addresses", err)
+	}
+	return scanRecipients(rows, "recipients_by_addresses")
+}
+
+func (r *NotificationEmailRepository) DueDigestRecipients(ctx context.Context, before time.Time, limit int) ([]domain.EmailRecipient, error) {
+	q := `
+SELECT ` + recipientColumns + `
+FROM user_emails e
+JOIN users u ON u.id = e.user_id AND u.status = 'active'
+LEFT JOIN profiles p ON p.user_id = e.user_id
+WHERE NOT e.digest_opt_out
+  AND NOT EXISTS (
+	SELECT 1 FROM notification_emails n
+	WHERE n.user_id = e.user_id AND n.kind = $1 AND n.sent_at > $2
+  )
+ORDER BY e.user_id
+LIMIT $3`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, q, string(domain.EmailKindWeeklyDigest), before, limit)
+	if err != nil {
+		return nil, domain.NewDatabaseError("due_digest_recipients", err)
+	}
+	return scanRecipients(rows, "due_digest_recipients")
+}
+
+func (r *NotificationEmailRepository) DigestActivity(ctx context.Context, userID string, since time.Time) (*domain.DigestActivity, error) {
+	// Volumes only add up ETH sales, since other currencies don't sum with it
+	const salesQ = `
+SELECT
+	COUNT(*) FILTER (WHERE side = 'sold'),
+	COALESCE(SUM(price_wei) FILTER (WHERE side = 'sold' AND currency = 'ETH'), 0)::text,
+	COUNT(*) FILTER (WHERE side = 'bought'),
+	COALESCE(SUM(price_wei) FILTER (WHERE side = 'bought' AND currency = 'ETH'), 0)::text
+FROM email_sale_activity
+WHERE user_id = $1 AND occurred_at >= $2`
+	const followersQ = `
+SELECT COUNT(*) FROM user_relationships
+WHERE target_id = $1 AND kind = 'follow' AND created_at >= $2`
+	const ownedQ = `
+SELECT COALESCE(SUM(s.items_owned), 0)
+FROM (SELECT DISTINCT address FROM user_accounts WHERE user_id = $1) a
+JOIN wallet_stats s ON s.address = a.address`
+
+	db := r.db.GetClient()
+	var a domain.DigestActivity
+	if err := db.QueryRowContext(ctx, salesQ, userID, since).Scan(&a.Sold, &a.SoldWei, &a.Bought, &a.BoughtWei); err != nil {
+		return nil, domain.NewDatabaseError("digest_sales", err)
+	}
+	if err := db.QueryRowContext(ctx, followersQ, userID, since).Scan(&a.NewFollowers); err != nil {
+		return nil, domain.NewDatabaseError("digest_followers", err)
+	}
+	if err := db.QueryRowContext(ctx, ownedQ, userID).Scan(&a.ItemsOwned); err != nil {
+		return nil, domain.NewDatabaseError("digest_items_owned", err)
+	}
+	return &a, nil
+}
+
+func (r *NotificationEmailRepository) RecordSaleActivity(ctx context.Context, a domain.SaleActivity) (bool, error) {
+	const q = `
+INSERT INTO email_sale_activity (user_id, event_id, side, chain_id, contract, token_id, price_wei, currency, tx_hash, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7::numeric, $8, $9, $10)
+ON CONFLICT (user_id, event_id, side) DO NOTHING`
+
+	res, err := r.db.GetClient().ExecContext(ctx, q, a.UserID, a.EventID, a.Side, a.ChainID, a.Contract,
+		a.TokenID, a.PriceWei, a.Currency, a.TxHash, a.OccurredAt)
+	if err != nil {
+		return false, domain.NewDatabaseError("record_sale_activity", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, domain.NewDatabaseError("record_sale_activity", err)
+	}
+	return n > 0, nil
+}
+
+func (r *NotificationEmailRepository) ClaimSend(ctx context.Context, userID, dedupeKey string, kind domain.EmailKind) (bool, error) {
+	const q = `
+INSERT INTO notification_emails (user_id, dedupe_key, kind)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, dedupe_key) DO NOTHING`
+
+	res, err := r.db.GetClient().ExecContext(ctx, q, userID, dedupeKey, string(kind))
+	if err != nil {
+		return false, domain.NewDatabaseError("claim_email_send", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, domain.NewDatabaseError("claim_email_send", err)
+	}
+	return n > 0, nil
+}
+
+func (r *NotificationEmailRepository) ReleaseSend(ctx context.Context, userID, dedupeKey string) error {
+	const q = `DELETE FROM notification_emails WHERE user_id = $1 AND dedupe_key = $2`
+	if _, err := r.db.GetClient().ExecContext(ctx, q, userID, dedupeKey); err != nil {
+		return domain.NewDatabaseError("release_email_send", err)
+	}
+	return nil
+}
+
+// CountSentSince leaves digests out: they are paced by DigestPeriod, not the hourly cap
+func (r *NotificationEmailRepository) CountSentSince(ctx context.Context, userID string, since time.Time) (int, error) {
+	const q = `
+SELECT COUNT(*) FROM notification_emails
+WHERE user_id = $1 AND kind <> $2 AND sent_at >= $3`
+
+	var n int
+	if err := r.db.GetClient().QueryRowContext(ctx, q, userID, string(domain.EmailKindWeeklyDigest), since).Scan(&n); err != nil {
+		return 0, domain.NewDatabaseError("count_emails_sent", err)
+	}
+	return n, nil
+}
+
+func scanRecipients(rows *sql.Rows, op string) ([]domain.EmailRecipient, error) {
+	defer rows.Close()
+
+	var out []domain.EmailRecipient
+	for rows.Next() {
+		var rec domain.EmailRecipient
+		var optOuts []string
+		e := &rec.Email
+		if err := rows.Scan(&e.UserID, &e.Email, &e.VerifiedAt, &e.DigestOptOut, pq.Array(&optOuts), &e.UpdatedAt,
+			&rec.Locale, &rec.Timezone); err != nil {
+			return nil, domain.NewDatabaseError(op, err)
+		}
+		for _, kind := range optOuts {
+			e.OptOuts = append(e.OptOuts, domain.EmailKind(kind))
+		}
+		out = append(out, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError(op, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type OrganizationRepository struct {
+	db *postgres.Postgres
+}
+
+func NewOrganizationRepository(db *postgres.Postgres) domain.OrganizationRepository {
+	return &OrganizationRepository{db: db}
+}
+
+func (r *OrganizationRepository) CreateOrganization(ctx context.Context, org *domain.Organization) error {
+	tx, err := r.db.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return domain.NewDatabaseError("begin_tx", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	const insertOrg = `
+INSERT INTO organizations (name, created_by, created_at)
+VALUES ($1, $2, now())
+RETURNING id, created_at`
+	if err := tx.QueryRowContext(ctx, insertOrg, org.Name, org.CreatedBy).Scan(&org.ID, &org.CreatedAt); err != nil {
+		return domain.NewDatabaseError("create_organization", err)
+	}
+
+	const insertOwner = `
+INSERT INTO org_memberships (org_id, user_id, role, joined_at)
+VALUES ($1, $2, $3, now())`
+	if _, err := tx.ExecContext(ctx, insertOwner, org.ID, org.CreatedBy, domain.OrgRoleOwner); err != nil {
+		return domain.NewDatabaseError("create_owner_membership", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.NewDatabaseError("commit_tx", err)
+	}
+	return nil
+}
+
+func (r *OrganizationRepository) GetOrganization(ctx context.Context, orgID string) (*domain.Organization, error) {
+	const q = `SELECT id, name, created_by, created_at FROM organizations WHERE id = $1`
+
+	var org domain.Organization
+	err := r.db.GetClient().QueryRowContext(ctx, q, orgID).Scan(&org.ID, &org.Name, &org.CreatedBy, &org.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrOrgNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_organization", err)
+	}
+	return &org, nil
+}
+
+func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID string) ([]domain.OrgMember, error) {
+	const q = `
+SELECT org_id, user_id, role, joined_at FROM org_memberships
+WHERE org_id = $1
+ORDER BY joined_at`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, q, orgID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list_members", err)
+	}
+	defer rows.Close()
+
+	var members []domain.OrgMember
+	for rows.Next() {
+		var m domain.OrgMember
+		if err := rows.Scan(&m.OrgID, &m.UserID, &m.Role, &m.JoinedAt); err != nil {
+			return nil, domain.NewDatabaseError("scan_member", err)
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("list_members", err)
+	}
+	return members, nil
+}
+
+func (r *OrganizationRepository) ListMemberships(ctx context.Context, userID string) ([]domain.OrgMembership, error) {
+	const q = `
+SELECT o.id, o.name, o.created_by, o.created_at, m.role
+FROM org_memberships m
+JOIN organizations o ON o.id = m.org_id
+WHERE m.user_id = $1
+ORDER BY m.joined_at`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, q, userID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list_memberships", err)
+	}
+	defer rows.Close()
+
+	var memberships []domain.OrgMembership
+	for rows.Next() {
+		var m domain.OrgMembership
+		if err := rows.Scan(&m.Organization.ID, &m.Organization.Name, &m.Organization.CreatedBy, &m.Organization.CreatedAt, &m.Role); err != nil {
+			return nil, domain.NewDatabaseError("scan_membership", err)
+		}
+		memberships = append(memberships, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("list_memberships", err)
+	}
+	return memberships, nil
+}
+
+func (r *OrganizationRepository) GetMember(ctx context.Context, orgID, userID string) (*domain.OrgMember, error) {
+	const q = `SELECT org_id, user_id, role, joined_at FROM org_memberships WHERE org_id = $1 AND user_id = $2`
+
+	var m domain.OrgMember
+	err := r.db.GetClient().QueryRowContext(ctx, q, orgID, userID).Scan(&m.OrgID, &m.UserID, &m.Role, &m.JoinedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotOrgMember
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_member", err)
+	}
+	return &m, nil
+}
+
+func (r *OrganizationRepository) CountOwners(ctx context.Context, orgID string) (int, error) {
+	const q = `SELECT COUNT(*) FROM org_memberships WHERE org_id = $1 AND role = 'owner'`
+
+	var n int
+	if err := r.db.GetClient().QueryRowContext(ctx, q, orgID).Scan(&n); err != nil {
+		return 0, domain.NewDatabaseError("count_owners", err)
+	}
+	return n, nil
+}
+
+func (r *OrganizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID, role string) (*domain.OrgMember, error) {
+	const q = `
+UPDATE org_memberships SET role = $3
+WHERE org_id = $1 AND user_id = $2
+RETURNING org_id, user_id, role, joined_at`
+
+	var m domain.OrgMember
+	err := r.db.GetClient().QueryRowContext(ctx, q, orgID, userID, role).Scan(&m.OrgID, &m.UserID, &m.Role, &m.JoinedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotOrgMember
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("update_member_role", err)
+	}
+	return &m, nil
+}
+
+func (r *OrganizationRepository) DeleteMember(ctx context.Context, orgID, userID string) error {
+	const q = `DELETE FROM org_memberships WHERE org_id = $1 AND user_id = $2`
+
+	res, err := r.db.GetClient().ExecContext(ctx, q, orgID, userID)
+	if err != nil {
+		return domain.NewDatabaseError("delete_member", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrNotOrgMember
+	}
+	return nil
+}
+
+func (r *OrganizationRepository) CreateInvitation(ctx context.Context, inv *domain.OrgInvitation) error {
+	const q = `
+INSERT INTO org_invitations (org_id, email, role, token_hash, invited_by, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, now())
+RETURNING id, created_at`
+
+	err := r.db.GetClient().QueryRowContext(ctx, q, inv.OrgID, strings.ToLower(inv.Email), inv.Role, inv.TokenHash, inv.InvitedBy, inv.ExpiresAt).
+		Scan(&inv.ID, &inv.CreatedAt)
+	if err != nil {
+		return domain.NewDatabaseError("create_invitation", err)
+	}
+	return nil
+}
+
+func (r *OrganizationRepository) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*domain.OrgInvitation, error) {
+	const q = `
+SELECT id, org_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at
+FROM org_invitations WHERE token_hash = $1`
+
+	var inv domain.OrgInvitation
+	var acceptedAt sql.NullTime
+	err := r.db.GetClient().QueryRowContext(ctx, q, tokenHash).Scan(&inv.ID, &inv.OrgID, &inv.Email, &inv.Role, &inv.TokenHash,
+		&inv.InvitedBy, &inv.ExpiresAt, &acceptedAt, &inv.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_invitation", err)
+	}
+	if acceptedAt.Valid {
+		inv.AcceptedAt = &acceptedAt.Time
+	}
+	return &inv, nil
+}
+
+func (r *OrganizationRepository) AcceptInvitation(ctx context.Context, inv *domain.OrgInvitation, userID string) (*domain.OrgMember, error) {
+	tx, err := r.db.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, domain.NewDatabaseError("begin_tx", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	const consume = `UPDATE org_invitations SET accepted_at = now(), accepted_by = $2 WHERE id = $1 AND accepted_at IS NULL`
+	res, err := tx.ExecContext(ctx, consume, inv.ID, userID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("consume_invitation", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, domain.ErrInvitationNotFound
+	}
+
+	const insert = `
+INSERT INTO org_memberships (org_id, user_id, role, joined_at)
+VALUES ($1, $2, $3, now())
+ON CONFLICT (org_id, user_id) DO NOTHING
+RETURNING org_id, user_id, role, joined_at`
+
+	var m domain.OrgMember
+	err = tx.QueryRowContext(ctx, insert, inv.OrgID, userID, inv.Role).Scan(&m.OrgID, &m.UserID, &m.Role, &m.JoinedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrAlreadyOrgMember
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("create_membership", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, domain.NewDatabaseError("commit_tx", err)
+	}
+	return &m, nil
+}
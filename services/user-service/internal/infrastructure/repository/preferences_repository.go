@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type PreferencesRepository struct {
+	db *postgres.Postgres
+}
+
+func NewPreferencesRepository(db *postgres.Postgres) domain.PreferencesRepository {
+	return &PreferencesRepository{db: db}
+}
+
+func (r *PreferencesRepository) GetPreferences(ctx context.Context, userID string) (*domain.Preferences, error) {
+	const q = `SELECT user_id, locale, timezone, preferred_currency, honor_delegations, updated_at FROM profiles WHERE user_id = $1`
+
+	var p domain.Preferences
+	err := r.db.GetClient().QueryRowContext(ctx, q, userID).Scan(&p.UserID, &p.Locale, &p.Timezone, &p.Currency, &p.HonorDelegations, &p.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrProfileNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_preferences", err)
+	}
+	return &p, nil
+}
+
+func (r *PreferencesRepository) UpdatePreferences(ctx context.Context, userID string, update domain.PreferencesUpdate) (*domain.Preferences, error) {
+	const q = `
+UPDATE profiles SET
+	locale             = COALESCE(NULLIF($2, ''), locale),
+	timezone           = COALESCE(NULLIF($3, ''), timezone),
+	preferred_currency = COALESCE(NULLIF($4, ''), preferred_currency),
+	honor_delegations  = COALESCE($5, honor_delegations)
+WHERE user_id = $1
+RETURNING user_id, locale, timezone, preferred_currency, honor_delegations, updated_at`
+
+	var p domain.Preferences
+	err := r.db.GetClient().QueryRowContext(ctx, q, userID, update.Locale, update.Timezone, update.Currency, update.HonorDelegations).
+		Scan(&p.UserID, &p.Locale, &p.Timezone, &p.Currency, &p.HonorDelegations, &p.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrProfileNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("update_preferences", err)
+	}
+	return &p, nil
+}
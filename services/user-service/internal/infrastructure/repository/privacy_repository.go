@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type PrivacyRepository struct {
+	db *postgres.Postgres
+}
+
+func NewPrivacyRepository(db *postgres.Postgres) domain.PrivacyRepository {
+	return &PrivacyRepository{db: db}
+}
+
+// unknownUser reports errors from a user id that is malformed or matches no user
+func unknownUser(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && (pqErr.Code == "23503" || pqErr.Code == "22P02")
+}
+
+func (r *PrivacyRepository) AddRelationship(ctx context.Context, userID, targetID string, kind domain.RelationshipKind) error {
+	// A new follow counts the follower in the same statement
+	const q = `
+WITH added AS (
+	INSERT INTO user_relationships (user_id, target_id, kind)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (user_id, target_id, kind) DO NOTHING
+	RETURNING target_id, kind
+)
+INSERT INTO profile_stats (user_id, followers)
+SELECT target_id, 1 FROM added WHERE kind = 'follow'
+ON CONFLICT (user_id) DO UPDATE SET followers = profile_stats.followers + 1, updated_at = CURRENT_TIMESTAMP`
+
+	if _, err := r.db.GetClient().ExecContext(ctx, q, userID, targetID, kind); err != nil {
+		if unknownUser(err) {
+			return domain.ErrUserNotFound
+		}
+		return domain.NewDatabaseError("add_relationship", err)
+	}
+	return nil
+}
+
+func (r *PrivacyRepository) RemoveRelationship(ctx context.Context, userID, targetID string, kind domain.RelationshipKind) error {
+	const q = `
+WITH removed AS (
+	DELETE FROM user_relationships WHERE user_id = $1 AND target_id = $2 AND kind = $3
+	RETURNING target_id, kind
+)
+UPDATE profile_stats
+SET followers = GREATEST(followers - 1, 0), updated_at = CURRENT_TIMESTAMP
+WHERE user_id IN (SELECT target_id FROM removed WHERE kind = 'follow')`
+
+	if _, err := r.db.GetClient().ExecContext(ctx, q, userID, targetID, kind); err != nil {
+		if unknownUser(err) {
+			return nil
+		}
+		return domain.NewDatabaseError("remove_relationship", err)
+	}
+	return nil
+}
+
+func (r *PrivacyRepository) CountRelationships(ctx context.Context, userID string, kind domain.RelationshipKind) (int, error) {
+	const q = `SELECT count(*) FROM user_relationships WHERE user_id = $1 AND kind = $2`
+
+	var n int
+	if err := r.db.GetClient().QueryRowContext(ctx, q, userID, kind).Scan(&n); err != nil {
+		return 0, domain.NewDatabaseError("count_relationships", err)
+	}
+	return n, nil
+}
+
+func (r *PrivacyRepository) ListRelationships(ctx context.Context, userID string, kind domain.RelationshipKind) ([]domain.Relationship, error) {
+	const q = `
+SELECT user_id, target_id, kind, created_at FROM user_relationships
+WHERE user_id = $1 AND kind = $2
+ORDER BY created_at DESC`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, q, userID, kind)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list_relationships", err)
+	}
+	defer rows.Close()
+
+	var out []domain.Relationship
+	for rows.Next() {
+		var rel domain.Relationship
+		if err := rows.Scan(&rel.UserID, &rel.TargetID, &rel.Kind, &rel.CreatedAt); err != nil {
+			return nil, domain.NewDatabaseError("scan_relationship", err)
+		}
+		out = append(out, rel)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("list_relationships", err)
+	}
+	return out, nil
+}
+
+func (r *PrivacyRepository) SetVisibility(ctx context.Context, userID string, visibility domain.ProfileVisibility) error {
+	const q = `UPDATE profiles SET visibility = $2 WHERE user_id = $1`
+
+	res, err := r.db.GetClient().ExecContext(ctx, q, userID, visibility)
+	if err != nil {
+		return domain.NewDatabaseError("set_visibility", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return domain.ErrProfileNotFound
+	}
+	return nil
+}
+
+func (r *PrivacyRepository) GetProfileAccess(ctx context.Context, viewerID, ownerID string) (*domain.ProfileAccess, error) {
+	// The viewer is compared as text so an anonymous (empty) viewer matches no block
+	const q = `
+SELECT p.visibility, EXISTS (
+	SELECT 1 FROM user_relationships rel
+	WHERE rel.kind = 'block'
+	  AND ((rel.user_id = p.user_id AND rel.target_id::text = $2)
+	    OR (rel.user_id::text = $2 AND rel.target_id = p.user_id))
+)
+FROM profiles p
+WHERE p.user_id = $1`
+
+	access := domain.ProfileAccess{OwnerID: ownerID, ViewerID: viewerID}
+	err := r.db.GetClient().QueryRowContext(ctx, q, ownerID, viewerID).Scan(&access.Visibility, &access.Blocked)
+	if err == sql.ErrNoRows || unknownUser(err) {
+		return nil, domain.ErrProfileNotFound
+	}
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_profile_access", err)
+	}
+	return &access, nil
+}
+
+func (r *PrivacyRepository) SuppressedRecipients(ctx context.Context, recipients, parties []string) (map[string]bool, error) {
+	const q = `
+SELECT DISTINCT rcpt.address
+FROM user_accounts rcpt
+JOIN user_accounts party ON party.address = ANY($2) AND party.user_id <> rcpt.user_id
+JOIN user_relationships rel
+  ON (rel.user_id = rcpt.user_id AND rel.target_id = party.user_id AND rel.kind IN ('block', 'mute'))
+  OR (rel.user_id = party.user_id AND rel.target_id = rcpt.user_id AND rel.kind = 'block')
+WHERE rcpt.address = ANY($1)`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, q, pq.Array(recipients), pq.Array(parties))
+	if err != nil {
+		return nil, domain.NewDatabaseError("suppressed_recipients", err)
+	}
+	defer rows.Close()
+
+	suppressed := make(map[string]bool)
+	for rows.Next() {
+		var address string
+		if err := rows.Scan(&address); err != nil {
+			return nil, domain.NewDatabaseError("scan_recipient", err)
+		}
+		suppressed[address] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("suppressed_recipients", err)
+	}
+	return suppressed, nil
+}
@@ -6,6 +6,7 @@ import (
 	"hash/fnv"
 	"strings"
 
+	"github.com/lib/pq"
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
@@ -36,6 +37,162 @@ func (r *Repository) GetUserIDByAccount(ctx context.Context, accountID string) (
 	return userID, nil
 }
 
+const userCardColumns = `
+	u.id, u.status, u.created_at,
+	COALESCE(p.username, ''), COALESCE(p.display_name, ''), COALESCE(p.avatar_url, ''),
+	COALESCE(p.banner_url, ''), COALESCE(p.bio, ''), COALESCE(p.locale, ''), COALESCE(p.timezone, ''),
+	COALESCE(p.preferred_currency, ''), COALESCE(p.socials_json::text, '{}'), COALESCE(p.updated_at, u.created_at),
+	n.chain_id, n.contract, n.token_id, n.verified, n.verified_at, n.checked_at,
+	COALESCE(ps.followers, 0), COALESCE(ws.collections_created, 0), COALESCE(ws.items_owned, 0),
+	COALESCE(ws.volume_wei, 0)::text`
+
+// userCardJoins joins what userCardColumns reads to users u. Catalog stats are summed over
+// the wallets of the user's accounts.
+const userCardJoins = `
+		LEFT JOIN profiles p ON p.user_id = u.id
+		LEFT JOIN profile_nft_avatars n ON n.user_id = u.id
+		LEFT JOIN profile_stats ps ON ps.user_id = u.id
+		LEFT JOIN LATERAL (
+			SELECT SUM(s.collections_created)::bigint AS collections_created,
+				SUM(s.items_owned)::bigint AS items_owned, SUM(s.volume_wei) AS volume_wei
+			FROM wallet_stats s
+			WHERE s.address IN (SELECT acc.address FROM user_accounts acc WHERE acc.user_id = u.id)
+		) ws ON true`
+
+func (r *Repository) GetUserCardsByIDs(ctx context.Context, userIDs []string) (map[string]*domain.UserCard, error) {
+	query := `SELECT ` + userCardColumns + `
+		FROM users u` + userCardJoins + `
+		WHERE u.id::text = ANY($1)`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, query, pq.Array(userIDs))
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_users_by_ids", err)
+	}
+	defer rows.Close()
+
+	cards := make(map[string]*domain.UserCard, len(userIDs))
+	for rows.Next() {
+		card, err := scanUserCard(rows)
+		if err != nil {
+			return nil, domain.NewDatabaseError("get_users_by_ids", err)
+		}
+		cards[card.User.ID] = card
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("get_users_by_ids", err)
+	}
+	return cards, nil
+}
+
+func (r *Repository) GetUserCardsByAddresses(ctx context.Context, addresses []string) (map[string]*domain.UserCard, error) {
+	// An address linked on several chains belongs to one user; take its most recent account
+	query := `SELECT DISTINCT ON (a.address) a.address, ` + userCardColumns + `
+		FROM user_accounts a
+		JOIN users u ON u.id = a.user_id` + userCardJoins + `
+		WHERE a.address = ANY($1)
+		ORDER BY a.address, a.last_seen_at DESC`
+
+	rows, err := r.db.GetClient().QueryContext(ctx, query, pq.Array(addresses))
+	if err != nil {
+		return nil, domain.NewDatabaseError("get_profiles_by_addresses", err)
+	}
+	defer rows.Close()
+
+	cards := make(map[string]*domain.UserCard, len(addresses))
+	for rows.Next() {
+		var address string
+		card, err := scanUserCard(rows, &address)
+		if err != nil {
+			return nil, domain.NewDatabaseError("get_profiles_by_addresses", err)
+		}
+		cards[address] = card
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("get_profiles_by_addresses", err)
+	}
+	return cards, nil
+}
+
+func (r *Repository) SuggestUsers(ctx context.Context, query string, limit int) ([]domain.UserSuggestion, error) {
+	// A profile scores by whichever of its names matches best; prefix matches rank first
+	sqlQuery := `SELECT u.id, COALESCE(p.username, ''), COALESCE(p.display_name, ''), COALESCE(p.avatar_url, ''),
+			GREATEST(
+				CASE WHEN LOWER(p.username) LIKE $2 THEN 1 ELSE 0 END + COALESCE(word_similarity($1, LOWER(p.username)), 0),
+				CASE WHEN LOWER(p.display_name) LIKE $2 THEN 1 ELSE 0 END + COALESCE(word_similarity($1, LOWER(p.display_name)), 0)
+			) AS score
+		FROM profiles p
+		JOIN users u ON u.id = p.user_id
+		WHERE u.status = $3
+			AND ($1 <% LOWER(p.username) OR LOWER(p.username) LIKE $2
+				OR $1 <% LOWER(p.display_name) OR LOWER(p.display_name) LIKE $2)
+		ORDER BY score DESC, p.username
+		LIMIT $4`
+
+	prefix := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(query) + "%"
+	rows, err := r.db.GetClient().QueryContext(ctx, sqlQuery, query, prefix, domain.UserStatusActive, limit)
+	if err != nil {
+		return nil, domain.NewDatabaseError("suggest_users", err)
+	}
+	defer rows.Close()
+
+	var suggestions []domain.UserSuggestion
+	for rows.Next() {
+		var s domain.UserSuggestion
+		if err := rows.Scan(&s.UserID, &s.Username, &s.DisplayName, &s.AvatarURL, &s.Score); err != nil {
+			return nil, domain.NewDatabaseError("suggest_users", err)
+		}
+		suggestions = append(suggestions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("suggest_users", err)
+	}
+	return suggestions, nil
+}
+
+func (r *Repository) RemoveUserAccount(ctx context.Context, userID, accountID string) error {
+	query := `DELETE FROM user_accounts WHERE account_id = $1 AND user_id::text = $2`
+
+	if _, err := r.db.GetClient().ExecContext(ctx, query, accountID, userID); err != nil {
+		return domain.NewDatabaseError("remove_user_account", err)
+	}
+	return nil
+}
+
+// scanUserCard scans userCardColumns after any leading columns
+func scanUserCard(rows *sql.Rows, leading ...interface{}) (*domain.UserCard, error) {
+	var c domain.UserCard
+	var nftChain, nftContract, nftToken sql.NullString
+	var nftVerified sql.NullBool
+	var nftVerifiedAt, nftCheckedAt sql.NullTime
+	dest := append(leading,
+		&c.User.ID, &c.User.Status, &c.User.CreatedAt,
+		&c.Profile.Username, &c.Profile.DisplayName, &c.Profile.AvatarURL,
+		&c.Profile.BannerURL, &c.Profile.Bio, &c.Profile.Locale, &c.Profile.Timezone,
+		&c.Profile.Currency, &c.Profile.SocialsJSON, &c.Profile.UpdatedAt,
+		&nftChain, &nftContract, &nftToken, &nftVerified, &nftVerifiedAt, &nftCheckedAt,
+		&c.Profile.Stats.Followers, &c.Profile.Stats.CollectionsCreated, &c.Profile.Stats.ItemsOwned,
+		&c.Profile.Stats.VolumeWei,
+	)
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	c.Profile.UserID = c.User.ID
+	if nftChain.Valid {
+		c.Profile.NFTAvatar = &domain.NFTAvatar{
+			UserID:    c.User.ID,
+			ChainID:   nftChain.String,
+			Contract:  nftContract.String,
+			TokenID:   nftToken.String,
+			Verified:  nftVerified.Bool,
+			CheckedAt: nftCheckedAt.Time,
+		}
+		if nftVerifiedAt.Valid {
+			c.Profile.NFTAvatar.VerifiedAt = &nftVerifiedAt.Time
+		}
+	}
+	return &c, nil
+}
+
 func (r *Repository) WithTx(ctx context.Context, fn func(domain.TxUserRepository) error) error {
 	tx, err := r.db.GetClient().BeginTx(ctx, nil)
 	if err != nil {
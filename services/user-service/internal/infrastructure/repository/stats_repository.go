@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+type ProfileStatsRepository struct {
+	db *postgres.Postgres
+}
+
+func NewProfileStatsRepository(db *postgres.Postgres) domain.ProfileStatsRepository {
+	return &ProfileStatsRepository{db: db}
+}
+
+func (r *ProfileStatsRepository) ApplyWalletStats(ctx context.Context, eventID string, deltas []domain.WalletStatsDelta) (bool, error) {
+	const markQ = `
+INSERT INTO profile_stats_events (event_id) VALUES ($1)
+ON CONFLICT (event_id) DO NOTHING`
+	// Counts never drop below zero, since the catalog may report a wallet selling tokens
+	// it received before indexing began
+	const applyQ = `
+INSERT INTO wallet_stats (address, collections_created, items_owned, volume_wei)
+VALUES ($1, GREATEST($2::bigint, 0), GREATEST($3::bigint, 0), $4::numeric)
+ON CONFLICT (address) DO UPDATE SET
+	collections_created = GREATEST(wallet_stats.collections_created + $2, 0),
+	items_owned = GREATEST(wallet_stats.items_owned + $3, 0),
+	volume_wei = wallet_stats.volume_wei + $4::numeric,
+	updated_at = CURRENT_TIMESTAMP`
+
+	tx, err := r.db.GetClient().BeginTx(ctx, nil)
+	if err != nil {
+		return false, domain.NewDatabaseError("begin_tx", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, markQ, eventID)
+	if err != nil {
+		return false, domain.NewDatabaseError("mark_stats_event", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return false, domain.NewDatabaseError("mark_stats_event", err)
+	} else if n == 0 {
+		return false, nil
+	}
+
+	for _, d := range deltas {
+		volume := "0"
+		if d.VolumeWei != nil {
+			volume = d.VolumeWei.String()
+		}
+		if _, err := tx.ExecContext(ctx, applyQ, d.Address, d.CollectionsCreated, d.ItemsOwned, volume); err != nil {
+			return false, domain.NewDatabaseError("apply_wallet_stats", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return false, domain.NewDatabaseError("commit_tx", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+)
+
+var (
+	caip2ChainID = regexp.MustCompile(`^[a-zA-Z0-9]+:[0-9]+$`)
+	decimalToken = regexp.MustCompile(`^[0-9]{1,78}$`)
+)
+
+type AvatarService struct {
+	avatarRepo domain.AvatarRepository
+}
+
+func NewAvatarService(avatarRepo domain.AvatarRepository) *AvatarService {
+	return &AvatarService{avatarRepo: avatarRepo}
+}
+
+func (s *AvatarService) SetAvatarFromNFT(ctx context.Context, userID domain.UserID, chainID domain.ChainID, contract domain.Address, tokenID string) (*domain.NFTAvatar, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	if !caip2ChainID.MatchString(chainID) {
+		return nil, domain.NewInvalidInputError("chain_id", "must be CAIP-2, e.g. eip155:1")
+	}
+	if err := domain.ValidateAddress(contract); err != nil {
+		return nil, err
+	}
+	if !decimalToken.MatchString(tokenID) {
+		return nil, domain.NewInvalidInputError("token_id", "must be a decimal token id")
+	}
+	contract = strings.ToLower(contract)
+
+	holder, err := s.avatarRepo.FindNFTHolder(ctx, userID, chainID, contract, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if holder == "" {
+		return nil, domain.ErrNFTNotHeld.WithMessage("none of your signed wallets holds this token")
+	}
+
+	now := time.Now().UTC()
+	avatar := &domain.NFTAvatar{
+		UserID:     userID,
+		ChainID:    chainID,
+		Contract:   contract,
+		TokenID:    tokenID,
+		Holder:     holder,
+		Verified:   true,
+		VerifiedAt: &now,
+		CheckedAt:  now,
+		CreatedAt:  now,
+	}
+	if err := s.avatarRepo.PutNFTAvatar(ctx, avatar); err != nil {
+		return nil, err
+	}
+	return avatar, nil
+}
+
+func (s *AvatarService) ClearNFTAvatar(ctx context.Context, userID domain.UserID) error {
+	if userID == "" {
+		return domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	return s.avatarRepo.DeleteNFTAvatar(ctx, userID)
+}
+
+// RecheckNFTAvatars re-verifies up to a batch of avatars not checked within staleAfter,
+// oldest first, and returns how many it checked. An avatar whose token moved to another of
+// the user's wallets stays verified.
+func (s *AvatarService) RecheckNFTAvatars(ctx context.Context, staleAfter time.Duration) (int, error) {
+	now := time.Now().UTC()
+	avatars, err := s.avatarRepo.ListNFTAvatarsCheckedBefore(ctx, now.Add(-staleAfter), domain.MaxAvatarRecheckBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	checked := 0
+	for i := range avatars {
+		a := &avatars[i]
+		holder, err := s.avatarRepo.FindNFTHolder(ctx, a.UserID, a.ChainID, a.Contract, a.TokenID)
+		if err != nil {
+			log.Printf("nft avatar check of user %s failed: %v", a.UserID, err)
+			continue
+		}
+
+		if holder == "" && a.Verified {
+			log.Printf("nft avatar of user %s is no longer held: %s %s #%s", a.UserID, a.ChainID, a.Contract, a.TokenID)
+		}
+		a.Holder = holder
+		a.Verified = holder != ""
+		a.CheckedAt = now
+		if a.Verified {
+			a.VerifiedAt = &now
+		}
+		if err := s.avatarRepo.RecordNFTAvatarCheck(ctx, a); err != nil {
+			log.Printf("nft avatar check of user %s not recorded: %v", a.UserID, err)
+			continue
+		}
+		checked++
+	}
+	return checked, nil
+}
+
+// RunAvatarVerification re-verifies NFT avatars every interval until ctx is done
+func (s *AvatarService) RunAvatarVerification(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = domain.DefaultAvatarRecheckInterval
+	}
+	// Passes run more often than the interval so a backlog beyond one batch drains
+	ticker := time.NewTicker(max(interval/10, time.Minute))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := s.RecheckNFTAvatars(ctx, interval); err != nil {
+			log.Printf("nft avatar verification failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+)
+
+const (
+	defaultVerificationTTL  = 15 * time.Minute
+	maxVerificationAttempts = 5
+)
+
+type EmailService struct {
+	emailRepo domain.EmailRepository
+	mailer    domain.Mailer
+	secret    []byte
+	verifyURL string
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+func NewEmailService(emailRepo domain.EmailRepository, mailer domain.Mailer, secret, verifyURL string, ttl time.Duration) *EmailService {
+	if ttl <= 0 {
+		ttl = defaultVerificationTTL
+	}
+	return &EmailService{
+		emailRepo: emailRepo,
+		mailer:    mailer,
+		secret:    []byte(secret),
+		verifyURL: verifyURL,
+		ttl:       ttl,
+		now:       time.Now,
+	}
+}
+
+func (s *EmailService) StartEmailVerification(ctx context.Context, userID domain.UserID, email string) (time.Time, error) {
+	if userID == "" {
+		return time.Time{}, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if err := domain.ValidateEmail(email); err != nil {
+		return time.Time{}, err
+	}
+
+	taken, err := s.emailRepo.IsEmailTaken(ctx, email, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if taken {
+		return time.Time{}, domain.ErrEmailTaken
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	v := &domain.EmailVerification{
+		UserID:    userID,
+		Email:     email,
+		CodeHash:  hashCode(code),
+		ExpiresAt: s.now().Add(s.ttl).UTC(),
+	}
+	if err := s.emailRepo.CreateVerification(ctx, v); err != nil {
+		return time.Time{}, err
+	}
+
+	link := fmt.Sprintf("%s?token=%s", s.verifyURL, s.signToken(v.ID, v.ExpiresAt))
+	msg := domain.OutgoingEmail{
+		To:      email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Your verification code is %s.\n\nOr open this link to confirm your email:\n%s\n\nThe code expires in %d minutes.",
+			code, link, int(s.ttl.Minutes())),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		return time.Time{}, err
+	}
+
+	return v.ExpiresAt, nil
+}
+
+func (s *EmailService) ConfirmEmail(ctx context.Context, userID domain.UserID, code string) (*domain.UserEmail, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, domain.NewInvalidInputError("code", "cannot be empty")
+	}
+
+	// Magic-link tokens carry the verification id; plain codes match the latest one
+	if strings.Contains(code, ".") {
+		id, err := s.verifyToken(code)
+		if err != nil {
+			return nil, err
+		}
+		v, err := s.emailRepo.GetVerification(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if v.UserID != userID {
+			return nil, domain.ErrVerificationInvalid
+		}
+		if err := s.checkUsable(v); err != nil {
+			return nil, err
+		}
+		return s.emailRepo.ConfirmVerification(ctx, v)
+	}
+
+	v, err := s.emailRepo.GetLatestVerification(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkUsable(v); err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashCode(code)), []byte(v.CodeHash)) != 1 {
+		if err := s.emailRepo.IncrementVerificationAttempts(ctx, v.ID); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrVerificationInvalid
+	}
+
+	return s.emailRepo.ConfirmVerification(ctx, v)
+}
+
+func (s *EmailService) GetEmailStatus(ctx context.Context, userID domain.UserID) (*domain.UserEmail, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	return s.emailRepo.GetEmail(ctx, userID)
+}
+
+func (s *EmailService) SetEmailDigestOptOut(ctx context.Context, userID domain.UserID, optOut bool) (*domain.UserEmail, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	return s.emailRepo.SetDigestOptOut(ctx, userID, optOut)
+}
+
+// SetEmailOptOut turns a kind of notification email off or back on; the weekly digest is
+// the same switch as SetEmailDigestOptOut
+func (s *EmailService) SetEmailOptOut(ctx context.Context, userID domain.UserID, kind domain.EmailKind, optOut bool) (*domain.UserEmail, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	if err := domain.ValidateEmailKind(kind); err != nil {
+		return nil, err
+	}
+	if kind == domain.EmailKindWeeklyDigest {
+		return s.emailRepo.SetDigestOptOut(ctx, userID, optOut)
+	}
+	return s.emailRepo.SetOptOut(ctx, userID, kind, optOut)
+}
+
+func (s *EmailService) GetNotificationEmail(ctx context.Context, userID domain.UserID) (string, bool, error) {
+	if userID == "" {
+		return "", false, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	e, err := s.emailRepo.GetEmail(ctx, userID)
+	if errors.Is(err, domain.ErrEmailNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return e.Email, !e.DigestOptOut, nil
+}
+
+func (s *EmailService) checkUsable(v *domain.EmailVerification) error {
+	if v.ConsumedAt != nil {
+		return domain.ErrVerificationNotFound
+	}
+	if v.Attempts >= maxVerificationAttempts {
+		return domain.ErrVerificationExhausted
+	}
+	if !s.now().Before(v.ExpiresAt) {
+		return domain.ErrVerificationExpired
+	}
+	return nil
+}
+
+// signToken builds "<id>.<expUnix>.<hmac>" for the magic link
+func (s *EmailService) signToken(id string, expiresAt time.Time) string {
+	payload := id + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	return payload + "." + s.sign(payload)
+}
+
+func (s *EmailService) verifyToken(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", domain.ErrVerificationInvalid
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(s.sign(payload)), []byte(parts[2])) {
+		return "", domain.ErrVerificationInvalid
+	}
+	exp, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", domain.ErrVerificationInvalid
+	}
+	if !s.now().Before(time.Unix(exp, 0)) {
+		return "", domain.ErrVerificationExpired
+	}
+	return parts[0], nil
+}
+
+func (s *EmailService) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,393 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/locale"
+)
+
+const (
+	defaultDigestCheckInterval = 15 * time.Minute
+	digestBatchSize            = 100
+)
+
+var weiPerEther = new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+
+// NotificationEmailService emails users about their sales, drops they subscribed to and a
+// weekly digest of their activity. Every email is claimed under a dedupe key before it is
+// sent, so redelivered events and overlapping digest passes email a user once.
+type NotificationEmailService struct {
+	repo     domain.NotificationEmailRepository
+	emails   domain.EmailService
+	renderer domain.EmailRenderer
+	mailer   domain.Mailer
+
+	secret         []byte
+	unsubscribeURL string
+
+	// Sends are paced to sendInterval apart; users get at most perUserPerHour emails
+	// besides their digest
+	sendInterval   time.Duration
+	perUserPerHour int
+	mu             sync.Mutex
+	nextSend       time.Time
+
+	now func() time.Time
+}
+
+func NewNotificationEmailService(repo domain.NotificationEmailRepository, emails domain.EmailService, renderer domain.EmailRenderer,
+	mailer domain.Mailer, secret, unsubscribeURL string) *NotificationEmailService {
+	return &NotificationEmailService{
+		repo:           repo,
+		emails:         emails,
+		renderer:       renderer,
+		mailer:         mailer,
+		secret:         []byte(secret),
+		unsubscribeURL: unsubscribeURL,
+		now:            time.Now,
+	}
+}
+
+// SetThrottle caps sends across all users to perSecond and non-digest emails to one user
+// to perUserPerHour; zero leaves either uncapped. The pace is kept per replica.
+func (s *NotificationEmailService) SetThrottle(perSecond float64, perUserPerHour int) {
+	s.sendInterval = 0
+	if perSecond > 0 {
+		s.sendInterval = time.Duration(float64(time.Second) / perSecond)
+	}
+	s.perUserPerHour = perUserPerHour
+}
+
+// HandleSaleIndexed records the sale for both parties' digests and confirms it to each
+func (s *NotificationEmailService) HandleSaleIndexed(ctx context.Context, event *contracts.SaleIndexedEvent) error {
+	if event.EventID == "" {
+		log.Printf("Dropping sale indexed event without id: %+v", event)
+		return nil
+	}
+	price, ok := salePrice(event.Data["price"])
+	if !ok {
+		log.Printf("Dropping sale %s without a valid price from notification emails", event.EventID)
+		return nil
+	}
+	currency, _ := event.Data["currency"].(string)
+	if currency == "" {
+		currency = domain.VolumeCurrency
+	}
+	currency = strings.ToUpper(currency)
+	tokenID, _ := event.Data["token_id"].(string)
+	contract := marketEventContract(event)
+	occurredAt := s.now().UTC()
+	if raw, _ := event.Data["occurred_at"].(string); raw != "" {
+		if t, err := time.Parse(time.RFC3339, raw); err == nil {
+			occurredAt = t
+		}
+	}
+
+	seller, buyer := event.Parties()
+	var errs []error
+	for _, party := range []struct{ address, side string }{{seller, domain.SaleSideSold}, {buyer, domain.SaleSideBought}} {
+		if party.address == "" || party.address == zeroAddress {
+			continue
+		}
+		recipients, err := s.repo.RecipientsByAddresses(ctx, []string{party.address})
+		if err != nil {
+			return err
+		}
+		for _, rec := range recipients {
+			activity := domain.SaleActivity{
+				UserID:     rec.Email.UserID,
+				EventID:    event.EventID,
+				Side:       party.side,
+				ChainID:    event.ChainID,
+				Contract:   contract,
+				TokenID:    tokenID,
+				PriceWei:   price.String(),
+				Currency:   currency,
+				TxHash:     event.TxHash,
+				OccurredAt: occurredAt,
+			}
+			// Opted out users still get the sale in their digest
+			if _, err := s.repo.RecordSaleActivity(ctx, activity); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if rec.Email.OptedOut(domain.EmailKindSaleConfirmation) {
+				continue
+			}
+			key := "sale:" + event.EventID + ":" + party.side
+			err := s.send(ctx, rec, domain.EmailKindSaleConfirmation, key, func(f *locale.Formatter, unsubscribeURL string) any {
+				return domain.SaleConfirmationEmailData{
+					Sold:           party.side == domain.SaleSideSold,
+					Contract:       contract,
+					TokenID:        tokenID,
+					Price:          formatAmount(f, price, currency),
+					ChainID:        event.ChainID,
+					TxHash:         event.TxHash,
+					OccurredAt:     f.Time(occurredAt),
+					UnsubscribeURL: unsubscribeURL,
+				}
+			})
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// HandleDropStartingSoon reminds the alert's recipients that the drop opens
+func (s *NotificationEmailService) HandleDropStartingSoon(ctx context.Context, event *contracts.DropStartingSoonEvent) error {
+	if event.EventID == "" {
+		log.Printf("Dropping drop starting soon event without id: %+v", event)
+		return nil
+	}
+	startsAt, ok := event.StartsAt()
+	if !ok {
+		log.Printf("Dropping drop starting soon event %s without starts_at", event.EventID)
+		return nil
+	}
+	addresses := event.Recipients()
+	if len(addresses) == 0 {
+		return nil
+	}
+	recipients, err := s.repo.RecipientsByAddresses(ctx, addresses)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, rec := range recipients {
+		if rec.Email.OptedOut(domain.EmailKindDropReminder) {
+			continue
+		}
+		err := s.send(ctx, rec, domain.EmailKindDropReminder, "drop:"+event.EventID, func(f *locale.Formatter, unsubscribeURL string) any {
+			return domain.DropReminderEmailData{
+				ChainID:        event.ChainID,
+				Contract:       event.Contract(),
+				StartsAt:       f.Time(startsAt),
+				UnsubscribeURL: unsubscribeURL,
+			}
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SendDueDigests sends a batch of the digests due at now and returns how many users it
+// went through. Users with nothing to report are marked done for the period without an
+// email, so they don't hold up the users after them.
+func (s *NotificationEmailService) SendDueDigests(ctx context.Context, now time.Time) (int, error) {
+	since := now.Add(-domain.DigestPeriod)
+	recipients, err := s.repo.DueDigestRecipients(ctx, since, digestBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	key := string(domain.EmailKindWeeklyDigest) + ":" + now.UTC().Format("2006-01-02")
+	var errs []error
+	for _, rec := range recipients {
+		activity, err := s.repo.DigestActivity(ctx, rec.Email.UserID, since)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if activity.Empty() {
+			if _, err := s.repo.ClaimSend(ctx, rec.Email.UserID, key, domain.EmailKindWeeklyDigest); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		err = s.send(ctx, rec, domain.EmailKindWeeklyDigest, key, func(f *locale.Formatter, unsubscribeURL string) any {
+			return domain.WeeklyDigestEmailData{
+				PeriodStart:    f.Time(since),
+				PeriodEnd:      f.Time(now),
+				Sold:           activity.Sold,
+				SoldVolume:     formatWei(f, activity.SoldWei),
+				Bought:         activity.Bought,
+				BoughtVolume:   formatWei(f, activity.BoughtWei),
+				NewFollowers:   activity.NewFollowers,
+				ItemsOwned:     f.Number(float64(activity.ItemsOwned), 0),
+				UnsubscribeURL: unsubscribeURL,
+			}
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return len(recipients), errors.Join(errs...)
+}
+
+// RunDigests looks for due digests every interval until ctx is done, draining full batches
+// back to back
+func (s *NotificationEmailService) RunDigests(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultDigestCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for {
+			n, err := s.SendDueDigests(ctx, s.now())
+			if err != nil {
+				log.Printf("weekly digests failed: %v", err)
+				break
+			}
+			if n < digestBatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+	}
+}
+
+// Unsubscribe turns off the kind of email an unsubscribe token names. Tokens don't expire,
+// since they sit in emails people may open long after.
+func (s *NotificationEmailService) Unsubscribe(ctx context.Context, token string) (*domain.UserEmail, domain.EmailKind, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, "", domain.ErrUnsubscribeInvalid
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(s.sign(payload)), []byte(parts[2])) {
+		return nil, "", domain.ErrUnsubscribeInvalid
+	}
+	kind := domain.EmailKind(parts[1])
+	e, err := s.emails.SetEmailOptOut(ctx, parts[0], kind, true)
+	if err != nil {
+		return nil, "", err
+	}
+	return e, kind, nil
+}
+
+// UnsubscribeToken builds "<userID>.<kind>.<hmac>" for an unsubscribe link
+func (s *NotificationEmailService) UnsubscribeToken(userID domain.UserID, kind domain.EmailKind) string {
+	payload := userID + "." + string(kind)
+	return payload + "." + s.sign(payload)
+}
+
+// send claims the email under key, renders it for the recipient and sends it. The claim
+// is given back when rendering or sending fails, so a redelivery tries again.
+func (s *NotificationEmailService) send(ctx context.Context, rec domain.EmailRecipient, kind domain.EmailKind, key string,
+	data func(f *locale.Formatter, unsubscribeURL string) any) error {
+	userID := rec.Email.UserID
+	if kind != domain.EmailKindWeeklyDigest && s.perUserPerHour > 0 {
+		sent, err := s.repo.CountSentSince(ctx, userID, s.now().Add(-time.Hour))
+		if err != nil {
+			return err
+		}
+		if sent >= s.perUserPerHour {
+			log.Printf("Skipping %s email %s to user %s: %d emails in the last hour", kind, key, userID, sent)
+			return nil
+		}
+	}
+
+	claimed, err := s.repo.ClaimSend(ctx, userID, key, kind)
+	if err != nil || !claimed {
+		return err
+	}
+
+	unsubscribeURL := s.unsubscribeURL + "?token=" + url.QueryEscape(s.UnsubscribeToken(userID, kind))
+	f := locale.NewFormatter(rec.Locale, rec.Timezone, "")
+	rendered, err := s.renderer.Render(kind, f.Locale(), data(f, unsubscribeURL))
+	if err == nil {
+		err = s.wait(ctx)
+	}
+	if err == nil {
+		err = s.mailer.Send(ctx, domain.OutgoingEmail{
+			To:      rec.Email.Email,
+			Subject: rendered.Subject,
+			Body:    rendered.Text,
+			HTML:    rendered.HTML,
+			Headers: map[string]string{
+				"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+				"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+			},
+		})
+	}
+	if err != nil {
+		if releaseErr := s.repo.ReleaseSend(ctx, userID, key); releaseErr != nil {
+			log.Printf("Failed to release %s email %s to user %s: %v", kind, key, userID, releaseErr)
+		}
+		return fmt.Errorf("send %s email to user %s: %w", kind, userID, err)
+	}
+	return nil
+}
+
+// wait blocks until the next send slot
+func (s *NotificationEmailService) wait(ctx context.Context) error {
+	if s.sendInterval <= 0 {
+		return nil
+	}
+	s.mu.Lock()
+	now := s.now()
+	slot := s.nextSend
+	if slot.Before(now) {
+		slot = now
+	}
+	s.nextSend = slot.Add(s.sendInterval)
+	s.mu.Unlock()
+
+	if delay := slot.Sub(now); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return nil
+}
+
+func (s *NotificationEmailService) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// formatAmount writes an ETH amount in ether; other currencies' decimals aren't known
+// here, so they stay in base units
+func formatAmount(f *locale.Formatter, amount *big.Int, currency string) string {
+	if currency != domain.VolumeCurrency {
+		return amount.String() + " " + currency
+	}
+	ether, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), weiPerEther).Float64()
+	return f.Number(ether, 6) + " " + currency
+}
+
+func formatWei(f *locale.Formatter, wei string) string {
+	amount, ok := new(big.Int).SetString(wei, 10)
+	if !ok {
+		amount = new(big.Int)
+	}
+	return formatAmount(f, amount, domain.VolumeCurrency)
+}
+
+// marketEventContract prefers the collection the sold token belongs to over the contract
+// the sale settled through
+func marketEventContract(event *contracts.SaleIndexedEvent) string {
+	if collection, ok := event.Data["collection_address"].(string); ok && collection != "" {
+		return strings.ToLower(collection)
+	}
+	return strings.ToLower(event.Contract)
+}
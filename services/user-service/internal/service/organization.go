@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+)
+
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+type OrganizationService struct {
+	orgRepo   domain.OrganizationRepository
+	mailer    domain.Mailer
+	inviteURL string
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+func NewOrganizationService(orgRepo domain.OrganizationRepository, mailer domain.Mailer, inviteURL string, ttl time.Duration) *OrganizationService {
+	if ttl <= 0 {
+		ttl = defaultInvitationTTL
+	}
+	return &OrganizationService{
+		orgRepo:   orgRepo,
+		mailer:    mailer,
+		inviteURL: inviteURL,
+		ttl:       ttl,
+		now:       time.Now,
+	}
+}
+
+func (s *OrganizationService) CreateOrganization(ctx context.Context, userID domain.UserID, name string) (*domain.Organization, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	if err := domain.ValidateOrgName(name); err != nil {
+		return nil, err
+	}
+
+	org := &domain.Organization{
+		Name:      strings.TrimSpace(name),
+		CreatedBy: userID,
+	}
+	if err := s.orgRepo.CreateOrganization(ctx, org); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+func (s *OrganizationService) GetOrganization(ctx context.Context, orgID string, userID domain.UserID) (*domain.Organization, []domain.OrgMember, error) {
+	if orgID == "" || userID == "" {
+		return nil, nil, domain.NewInvalidInputError("org_id", "org_id and user_id are required")
+	}
+	if _, err := s.orgRepo.GetMember(ctx, orgID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	org, err := s.orgRepo.GetOrganization(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	members, err := s.orgRepo.ListMembers(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return org, members, nil
+}
+
+func (s *OrganizationService) ListUserOrganizations(ctx context.Context, userID domain.UserID) ([]domain.OrgMembership, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	return s.orgRepo.ListMemberships(ctx, userID)
+}
+
+func (s *OrganizationService) InviteMember(ctx context.Context, orgID string, inviterID domain.UserID, email, role string) (*domain.OrgInvitation, error) {
+	if orgID == "" || inviterID == "" {
+		return nil, domain.NewInvalidInputError("org_id", "org_id and inviter_id are required")
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if err := domain.ValidateEmail(email); err != nil {
+		return nil, err
+	}
+	if role == "" {
+		role = domain.OrgRoleMember
+	}
+	// Ownership is granted to existing members only, never through an emailed link
+	if role != domain.OrgRoleAdmin && role != domain.OrgRoleMember {
+		return nil, domain.NewInvalidInputError("role", "must be admin or member")
+	}
+
+	inviter, err := s.orgRepo.GetMember(ctx, orgID, inviterID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.CanManageMembers(inviter.Role) {
+		return nil, domain.ErrOrgForbidden
+	}
+
+	org, err := s.orgRepo.GetOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := generateInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	inv := &domain.OrgInvitation{
+		OrgID:     orgID,
+		Email:     email,
+		Role:      role,
+		TokenHash: hashCode(token),
+		InvitedBy: inviterID,
+		ExpiresAt: s.now().Add(s.ttl).UTC(),
+	}
+	if err := s.orgRepo.CreateInvitation(ctx, inv); err != nil {
+		return nil, err
+	}
+
+	msg := domain.OutgoingEmail{
+		To:      email,
+		Subject: fmt.Sprintf("You have been invited to %s", org.Name),
+		Body: fmt.Sprintf("You have been invited to join %s as %s.\n\nOpen this link to accept the invitation:\n%s?token=%s\n\nThe invitation expires in %d days.",
+			org.Name, role, s.inviteURL, token, int(s.ttl.Hours()/24)),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+func (s *OrganizationService) AcceptInvitation(ctx context.Context, userID domain.UserID, token string) (*domain.OrgMembership, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, domain.NewInvalidInputError("token", "cannot be empty")
+	}
+
+	inv, err := s.orgRepo.GetInvitationByTokenHash(ctx, hashCode(token))
+	if err != nil {
+		return nil, err
+	}
+	if inv.AcceptedAt != nil {
+		return nil, domain.ErrInvitationNotFound
+	}
+	if !s.now().Before(inv.ExpiresAt) {
+		return nil, domain.ErrInvitationExpired
+	}
+
+	if _, err := s.orgRepo.GetMember(ctx, inv.OrgID, userID); err == nil {
+		return nil, domain.ErrAlreadyOrgMember
+	} else if !errors.Is(err, domain.ErrNotOrgMember) {
+		return nil, err
+	}
+
+	member, err := s.orgRepo.AcceptInvitation(ctx, inv, userID)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.orgRepo.GetOrganization(ctx, inv.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.OrgMembership{Organization: *org, Role: member.Role}, nil
+}
+
+func (s *OrganizationService) RemoveMember(ctx context.Context, orgID string, actorID, userID domain.UserID) error {
+	if orgID == "" || actorID == "" || userID == "" {
+		return domain.NewInvalidInputError("org_id", "org_id, actor_id and user_id are required")
+	}
+
+	target, err := s.orgRepo.GetMember(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if actorID != userID {
+		actor, err := s.orgRepo.GetMember(ctx, orgID, actorID)
+		if err != nil {
+			return err
+		}
+		if !domain.CanManageMembers(actor.Role) {
+			return domain.ErrOrgForbidden
+		}
+		// Admins cannot remove owners
+		if target.Role == domain.OrgRoleOwner && actor.Role != domain.OrgRoleOwner {
+			return domain.ErrOrgForbidden
+		}
+	}
+	if err := s.ensureAnotherOwner(ctx, target); err != nil {
+		return err
+	}
+
+	return s.orgRepo.DeleteMember(ctx, orgID, userID)
+}
+
+func (s *OrganizationService) SetMemberRole(ctx context.Context, orgID string, actorID, userID domain.UserID, role string) (*domain.OrgMember, error) {
+	if orgID == "" || actorID == "" || userID == "" {
+		return nil, domain.NewInvalidInputError("org_id", "org_id, actor_id and user_id are required")
+	}
+	if err := domain.ValidateOrgRole(role); err != nil {
+		return nil, err
+	}
+
+	actor, err := s.orgRepo.GetMember(ctx, orgID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.Role != domain.OrgRoleOwner {
+		return nil, domain.ErrOrgForbidden
+	}
+
+	target, err := s.orgRepo.GetMember(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == role {
+		return target, nil
+	}
+	if err := s.ensureAnotherOwner(ctx, target); err != nil {
+		return nil, err
+	}
+
+	return s.orgRepo.UpdateMemberRole(ctx, orgID, userID, role)
+}
+
+func (s *OrganizationService) GetMembership(ctx context.Context, orgID string, userID domain.UserID) (*domain.OrgMember, error) {
+	if orgID == "" || userID == "" {
+		return nil, domain.NewInvalidInputError("org_id", "org_id and user_id are required")
+	}
+	return s.orgRepo.GetMember(ctx, orgID, userID)
+}
+
+// ensureAnotherOwner keeps at least one owner when member stops being one
+func (s *OrganizationService) ensureAnotherOwner(ctx context.Context, member *domain.OrgMember) error {
+	if member.Role != domain.OrgRoleOwner {
+		return nil
+	}
+	owners, err := s.orgRepo.CountOwners(ctx, member.OrgID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return domain.ErrLastOrgOwner
+	}
+	return nil
+}
+
+func generateInvitationToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/locale"
+)
+
+type PreferencesService struct {
+	prefsRepo domain.PreferencesRepository
+}
+
+func NewPreferencesService(prefsRepo domain.PreferencesRepository) *PreferencesService {
+	return &PreferencesService{prefsRepo: prefsRepo}
+}
+
+func (s *PreferencesService) GetPreferences(ctx context.Context, userID domain.UserID) (*domain.Preferences, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	return s.prefsRepo.GetPreferences(ctx, userID)
+}
+
+// UpdatePreferences canonicalizes and validates the fields being changed, so every
+// service formatting with the stored values gets a tag, zone and currency it can load
+func (s *PreferencesService) UpdatePreferences(ctx context.Context, userID domain.UserID, update domain.PreferencesUpdate) (*domain.Preferences, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	if update.Locale == "" && update.Timezone == "" && update.Currency == "" && update.HonorDelegations == nil {
+		return nil, domain.NewInvalidInputError("preferences", "nothing to update")
+	}
+
+	var ok bool
+	if update.Locale != "" {
+		// profiles.locale is VARCHAR(10)
+		if update.Locale, ok = locale.ParseLocale(update.Locale); !ok || len(update.Locale) > 10 {
+			return nil, domain.NewInvalidInputError("locale", "must be a BCP 47 language tag")
+		}
+	}
+	if update.Timezone != "" {
+		if update.Timezone, ok = locale.ParseTimezone(update.Timezone); !ok {
+			return nil, domain.NewInvalidInputError("timezone", "must be an IANA time zone")
+		}
+	}
+	if update.Currency != "" {
+		if update.Currency, ok = locale.ParseCurrency(update.Currency); !ok {
+			return nil, domain.NewInvalidInputError("currency", "unsupported currency")
+		}
+	}
+
+	return s.prefsRepo.UpdatePreferences(ctx, userID, update)
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+)
+
+type PrivacyService struct {
+	privacyRepo domain.PrivacyRepository
+}
+
+func NewPrivacyService(privacyRepo domain.PrivacyRepository) *PrivacyService {
+	return &PrivacyService{privacyRepo: privacyRepo}
+}
+
+func (s *PrivacyService) SetRelationship(ctx context.Context, userID, targetID domain.UserID, kind domain.RelationshipKind, active bool) error {
+	if userID == "" {
+		return domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	if targetID == "" {
+		return domain.NewInvalidInputError("target_id", "cannot be empty")
+	}
+	if targetID == userID {
+		return domain.NewInvalidInputError("target_id", "cannot be yourself")
+	}
+	if err := validateRelationshipKind(kind); err != nil {
+		return err
+	}
+
+	if !active {
+		return s.privacyRepo.RemoveRelationship(ctx, userID, targetID, kind)
+	}
+	n, err := s.privacyRepo.CountRelationships(ctx, userID, kind)
+	if err != nil {
+		return err
+	}
+	if n >= domain.MaxRelationships {
+		return domain.ErrRelationshipLimit
+	}
+	if err := s.privacyRepo.AddRelationship(ctx, userID, targetID, kind); err != nil {
+		return err
+	}
+	if kind != domain.RelationshipBlock {
+		return nil
+	}
+	// Blocked users can't see each other, so neither keeps following the other
+	if err := s.privacyRepo.RemoveRelationship(ctx, userID, targetID, domain.RelationshipFollow); err != nil {
+		return err
+	}
+	return s.privacyRepo.RemoveRelationship(ctx, targetID, userID, domain.RelationshipFollow)
+}
+
+func (s *PrivacyService) ListRelationships(ctx context.Context, userID domain.UserID, kind domain.RelationshipKind) ([]domain.Relationship, error) {
+	if userID == "" {
+		return nil, domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	if err := validateRelationshipKind(kind); err != nil {
+		return nil, err
+	}
+	return s.privacyRepo.ListRelationships(ctx, userID, kind)
+}
+
+func (s *PrivacyService) SetProfileVisibility(ctx context.Context, userID domain.UserID, visibility domain.ProfileVisibility) error {
+	if userID == "" {
+		return domain.NewInvalidInputError("user_id", "cannot be empty")
+	}
+	switch visibility {
+	case domain.VisibilityPublic, domain.VisibilityHolders, domain.VisibilityPrivate:
+	default:
+		return domain.NewInvalidInputError("visibility", "must be public, holders or private")
+	}
+	return s.privacyRepo.SetVisibility(ctx, userID, visibility)
+}
+
+// GetProfileAccess reports the owner's visibility and whether either user blocked the
+// other; owners always see their own profile, so their access is never blocked
+func (s *PrivacyService) GetProfileAccess(ctx context.Context, viewerID, ownerID domain.UserID) (*domain.ProfileAccess, error) {
+	if ownerID == "" {
+		return nil, domain.NewInvalidInputError("owner_id", "cannot be empty")
+	}
+	if viewerID == ownerID {
+		return &domain.ProfileAccess{OwnerID: ownerID, ViewerID: viewerID, Visibility: domain.VisibilityPublic}, nil
+	}
+	return s.privacyRepo.GetProfileAccess(ctx, viewerID, ownerID)
+}
+
+// FilterRecipients returns the recipients to notify in their original order, lowercased
+// and without duplicates
+func (s *PrivacyService) FilterRecipients(ctx context.Context, recipients, parties []domain.Address) ([]domain.Address, error) {
+	if len(recipients) > domain.MaxBatchLookup || len(parties) > domain.MaxBatchLookup {
+		return nil, domain.NewInvalidInputError("recipients", "too many addresses")
+	}
+
+	normalized := lowerUnique(recipients)
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+	others := lowerUnique(parties)
+	if len(others) == 0 {
+		return normalized, nil
+	}
+
+	suppressed, err := s.privacyRepo.SuppressedRecipients(ctx, normalized, others)
+	if err != nil {
+		return nil, err
+	}
+	kept := make([]domain.Address, 0, len(normalized))
+	for _, address := range normalized {
+		if !suppressed[address] {
+			kept = append(kept, address)
+		}
+	}
+	return kept, nil
+}
+
+func validateRelationshipKind(kind domain.RelationshipKind) error {
+	switch kind {
+	case domain.RelationshipBlock, domain.RelationshipMute, domain.RelationshipFollow:
+		return nil
+	}
+	return domain.NewInvalidInputError("kind", "must be block, mute or follow")
+}
+
+func lowerUnique(addresses []domain.Address) []domain.Address {
+	out := make([]domain.Address, 0, len(addresses))
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		address = strings.ToLower(strings.TrimSpace(address))
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+		out = append(out, address)
+	}
+	return out
+}
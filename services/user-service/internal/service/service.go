@@ -3,9 +3,12 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"strings"
 
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 )
 
 type Service struct {
@@ -89,3 +92,111 @@ func (s *Service) EnsureUser(ctx context.Context, accountID domain.AccountID, ad
 	return &out, nil
 
 }
+
+func (s *Service) GetUsersByIDs(ctx context.Context, userIDs []domain.UserID) ([]*domain.UserCard, error) {
+	if len(userIDs) > domain.MaxBatchLookup {
+		return nil, domain.NewInvalidInputError("user_ids", fmt.Sprintf("at most %d per call", domain.MaxBatchLookup))
+	}
+	for _, id := range userIDs {
+		if id == "" {
+			return nil, domain.NewInvalidInputError("user_ids", "cannot contain empty ids")
+		}
+	}
+	if len(userIDs) == 0 {
+		return []*domain.UserCard{}, nil
+	}
+
+	cards, err := s.userRepo.GetUserCardsByIDs(ctx, uniqueKeys(userIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*domain.UserCard, len(userIDs))
+	for i, id := range userIDs {
+		out[i] = cards[id]
+	}
+	return out, nil
+}
+
+func (s *Service) GetProfilesByAddresses(ctx context.Context, addresses []domain.Address) ([]*domain.UserCard, error) {
+	if len(addresses) > domain.MaxBatchLookup {
+		return nil, domain.NewInvalidInputError("addresses", fmt.Sprintf("at most %d per call", domain.MaxBatchLookup))
+	}
+	normalized := make([]string, len(addresses))
+	for i, address := range addresses {
+		if err := domain.ValidateAddress(address); err != nil {
+			return nil, err
+		}
+		normalized[i] = strings.ToLower(address)
+	}
+	if len(addresses) == 0 {
+		return []*domain.UserCard{}, nil
+	}
+
+	cards, err := s.userRepo.GetUserCardsByAddresses(ctx, uniqueKeys(normalized))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*domain.UserCard, len(normalized))
+	for i, address := range normalized {
+		out[i] = cards[address]
+	}
+	return out, nil
+}
+
+const (
+	// Shorter queries match too much to rank usefully
+	minSuggestQueryLength = 2
+	maxSuggestQueryLength = 64
+	defaultSuggestLimit   = 8
+	maxSuggestLimit       = 20
+)
+
+// SuggestUsers lower-cases the query and collapses its whitespace; queries too short to
+// rank return no suggestions
+func (s *Service) SuggestUsers(ctx context.Context, query string, limit int) ([]domain.UserSuggestion, error) {
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	runes := []rune(strings.Join(strings.Fields(strings.ToLower(query)), " "))
+	if len(runes) < minSuggestQueryLength {
+		return []domain.UserSuggestion{}, nil
+	}
+	if len(runes) > maxSuggestQueryLength {
+		runes = runes[:maxSuggestQueryLength]
+	}
+
+	return s.userRepo.SuggestUsers(ctx, string(runes), limit)
+}
+
+// HandleWalletUnlinked consumes wallet.unlinked: the unlinked account no longer resolves
+// to the user, so address lookups stop showing their profile for it
+func (s *Service) HandleWalletUnlinked(ctx context.Context, event *contracts.WalletUnlinkedEvent) error {
+	if event.UserID == "" || event.AccountID == "" {
+		log.Printf("Dropping wallet.unlinked event without user or account: %+v", event)
+		return nil
+	}
+	if err := s.userRepo.RemoveUserAccount(ctx, event.UserID, event.AccountID); err != nil {
+		return fmt.Errorf("remove unlinked account %s: %w", event.AccountID, err)
+	}
+	return nil
+}
+
+// uniqueKeys drops repeated keys so dataloaders batching the same id twice query it once
+func uniqueKeys(keys []string) []string {
+	seen := make(map[string]struct{}, len(keys))
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, k)
+	}
+	return out
+}
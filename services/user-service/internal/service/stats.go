@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+// ProfileStatsService folds catalog events into the per-wallet profile counters. Each
+// event is applied once, so redeliveries are harmless.
+type ProfileStatsService struct {
+	statsRepo domain.ProfileStatsRepository
+}
+
+func NewProfileStatsService(statsRepo domain.ProfileStatsRepository) *ProfileStatsService {
+	return &ProfileStatsService{statsRepo: statsRepo}
+}
+
+// HandleCollectionUpserted counts a collection upserted for the first time for its creator
+func (s *ProfileStatsService) HandleCollectionUpserted(ctx context.Context, event *contracts.CollectionUpsertedEvent) error {
+	creator := event.Creator()
+	if creator == "" || creator == zeroAddress || event.AggregateID == "" {
+		return nil
+	}
+	// Keyed by collection, since a collection is only created once
+	return s.apply(ctx, "collection_created:"+event.AggregateID, []domain.WalletStatsDelta{
+		{Address: creator, CollectionsCreated: 1},
+	})
+}
+
+// HandleHoldingsChanged moves the item counts of the wallets a transfer touched
+func (s *ProfileStatsService) HandleHoldingsChanged(ctx context.Context, event *contracts.HoldingsChangedEvent) error {
+	if event.EventID == "" {
+		log.Printf("Dropping holdings changed event without id: %+v", event)
+		return nil
+	}
+	deltas := make([]domain.WalletStatsDelta, 0, len(event.Deltas))
+	for address, delta := range event.Deltas {
+		address = strings.ToLower(address)
+		if delta == 0 || address == zeroAddress || domain.ValidateAddress(address) != nil {
+			continue
+		}
+		deltas = append(deltas, domain.WalletStatsDelta{Address: address, ItemsOwned: delta})
+	}
+	return s.apply(ctx, event.EventID, deltas)
+}
+
+// HandleSaleIndexed adds an ETH sale to the volume of both its seller and buyer. Sales in
+// other currencies are left out, since their amounts don't add up with ETH.
+func (s *ProfileStatsService) HandleSaleIndexed(ctx context.Context, event *contracts.SaleIndexedEvent) error {
+	if event.EventID == "" {
+		log.Printf("Dropping sale indexed event without id: %+v", event)
+		return nil
+	}
+	if currency, _ := event.Data["currency"].(string); currency != "" && !strings.EqualFold(currency, domain.VolumeCurrency) {
+		return nil
+	}
+	price, ok := salePrice(event.Data["price"])
+	if !ok {
+		log.Printf("Dropping sale %s without a valid price from profile stats", event.EventID)
+		return nil
+	}
+	if price.Sign() == 0 {
+		return nil
+	}
+
+	seller, buyer := event.Parties()
+	parties := []string{seller}
+	if buyer != seller {
+		parties = append(parties, buyer)
+	}
+	var deltas []domain.WalletStatsDelta
+	for _, party := range parties {
+		if party == "" || party == zeroAddress {
+			continue
+		}
+		deltas = append(deltas, domain.WalletStatsDelta{Address: party, VolumeWei: price})
+	}
+	return s.apply(ctx, "sale:"+event.EventID, deltas)
+}
+
+func (s *ProfileStatsService) apply(ctx context.Context, eventID string, deltas []domain.WalletStatsDelta) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+	if _, err := s.statsRepo.ApplyWalletStats(ctx, eventID, deltas); err != nil {
+		return fmt.Errorf("apply profile stats of %s: %w", eventID, err)
+	}
+	return nil
+}
+
+// salePrice reads a wei price sent as a decimal string or a JSON number
+func salePrice(v interface{}) (*big.Int, bool) {
+	var raw string
+	switch p := v.(type) {
+	case string:
+		raw = p
+	case float64:
+		raw = strconv.FormatFloat(p, 'f', -1, 64)
+	default:
+		return nil, false
+	}
+	price, ok := new(big.Int).SetString(raw, 10)
+	if !ok || price.Sign() < 0 {
+		return nil, false
+	}
+	return price, true
+}
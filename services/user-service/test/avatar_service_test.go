@@ -0,0 +1,120 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+)
+
+const (
+	avatarContract = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	avatarHolder   = "0x00000000000000000000000000000000000000aa"
+)
+
+// MockAvatarRepository is a mock implementation of AvatarRepository
+type MockAvatarRepository struct {
+	mock.Mock
+}
+
+func (m *MockAvatarRepository) FindNFTHolder(ctx context.Context, userID, chainID, contract, tokenID string) (string, error) {
+	args := m.Called(ctx, userID, chainID, contract, tokenID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAvatarRepository) PutNFTAvatar(ctx context.Context, avatar *domain.NFTAvatar) error {
+	return m.Called(ctx, avatar).Error(0)
+}
+
+func (m *MockAvatarRepository) DeleteNFTAvatar(ctx context.Context, userID string) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+func (m *MockAvatarRepository) ListNFTAvatarsCheckedBefore(ctx context.Context, before time.Time, limit int) ([]domain.NFTAvatar, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.NFTAvatar), args.Error(1)
+}
+
+func (m *MockAvatarRepository) RecordNFTAvatarCheck(ctx context.Context, avatar *domain.NFTAvatar) error {
+	return m.Called(ctx, avatar).Error(0)
+}
+
+func TestSetAvatarFromNFT_StoresHeldToken(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAvatarRepository)
+	svc := service.NewAvatarService(repo)
+
+	contract := "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+	repo.On("FindNFTHolder", ctx, "user-1", "eip155:1", contract, "42").Return(avatarHolder, nil)
+	repo.On("PutNFTAvatar", ctx, mock.AnythingOfType("*domain.NFTAvatar")).Return(nil)
+
+	avatar, err := svc.SetAvatarFromNFT(ctx, "user-1", "eip155:1", avatarContract, "42")
+
+	require.NoError(t, err)
+	assert.Equal(t, contract, avatar.Contract)
+	assert.Equal(t, avatarHolder, avatar.Holder)
+	assert.True(t, avatar.Verified)
+	require.NotNil(t, avatar.VerifiedAt)
+	repo.AssertExpectations(t)
+}
+
+func TestSetAvatarFromNFT_Rejections(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAvatarRepository)
+	svc := service.NewAvatarService(repo)
+
+	_, err := svc.SetAvatarFromNFT(ctx, "user-1", "eip155-1", avatarContract, "42")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "chain ids are CAIP-2")
+	_, err = svc.SetAvatarFromNFT(ctx, "user-1", "eip155:1", "0x123", "42")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = svc.SetAvatarFromNFT(ctx, "user-1", "eip155:1", avatarContract, "0x2a")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "token ids are decimal")
+
+	repo.On("FindNFTHolder", ctx, "user-1", "eip155:1", mock.Anything, "7").Return("", nil)
+	_, err = svc.SetAvatarFromNFT(ctx, "user-1", "eip155:1", avatarContract, "7")
+	assert.ErrorIs(t, err, domain.ErrNFTNotHeld)
+	repo.AssertNotCalled(t, "PutNFTAvatar", mock.Anything, mock.Anything)
+}
+
+func TestRecheckNFTAvatars_ClearsSoldTokens(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAvatarRepository)
+	svc := service.NewAvatarService(repo)
+
+	earlier := time.Now().Add(-2 * time.Hour)
+	repo.On("ListNFTAvatarsCheckedBefore", ctx, mock.Anything, domain.MaxAvatarRecheckBatch).Return([]domain.NFTAvatar{
+		{UserID: "user-1", ChainID: "eip155:1", Contract: "0xaaa", TokenID: "1", Holder: avatarHolder, Verified: true, VerifiedAt: &earlier},
+		{UserID: "user-2", ChainID: "eip155:1", Contract: "0xaaa", TokenID: "2", Holder: avatarHolder, Verified: true, VerifiedAt: &earlier},
+	}, nil)
+	repo.On("FindNFTHolder", ctx, "user-1", "eip155:1", "0xaaa", "1").Return("", nil)
+	repo.On("FindNFTHolder", ctx, "user-2", "eip155:1", "0xaaa", "2").Return("0x00000000000000000000000000000000000000bb", nil)
+
+	var recorded []domain.NFTAvatar
+	repo.On("RecordNFTAvatarCheck", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		recorded = append(recorded, *args.Get(1).(*domain.NFTAvatar))
+	}).Return(nil)
+
+	checked, err := svc.RecheckNFTAvatars(ctx, time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, checked)
+	require.Len(t, recorded, 2)
+
+	assert.False(t, recorded[0].Verified)
+	assert.Empty(t, recorded[0].Holder)
+	assert.Equal(t, earlier, *recorded[0].VerifiedAt, "the last verification is kept")
+
+	// Moving the token to another of the user's wallets keeps it verified
+	assert.True(t, recorded[1].Verified)
+	assert.Equal(t, "0x00000000000000000000000000000000000000bb", recorded[1].Holder)
+	assert.True(t, recorded[1].VerifiedAt.After(earlier))
+}
@@ -0,0 +1,117 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/user-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+func userCard(id, username string) *domain.UserCard {
+	return &domain.UserCard{
+		User:    domain.User{ID: id, Status: domain.UserStatusActive, CreatedAt: time.Unix(0, 0)},
+		Profile: domain.Profile{UserID: id, Username: username},
+	}
+}
+
+func TestGetUsersByIDs_PreservesRequestOrder(t *testing.T) {
+	repo := new(MockUserRepository)
+	svc := service.NewUserService(repo)
+	ctx := context.Background()
+
+	// repeated ids are queried once but answered at every position
+	repo.On("GetUserCardsByIDs", ctx, []string{"u-2", "u-1", "u-missing"}).Return(map[string]*domain.UserCard{
+		"u-1": userCard("u-1", "alice"),
+		"u-2": userCard("u-2", "bob"),
+	}, nil)
+
+	cards, err := svc.GetUsersByIDs(ctx, []string{"u-2", "u-1", "u-missing", "u-2"})
+	require.NoError(t, err)
+	require.Len(t, cards, 4)
+	assert.Equal(t, "bob", cards[0].Profile.Username)
+	assert.Equal(t, "alice", cards[1].Profile.Username)
+	assert.Nil(t, cards[2])
+	assert.Equal(t, "bob", cards[3].Profile.Username)
+	repo.AssertExpectations(t)
+}
+
+func TestGetUsersByIDs_BoundsBatch(t *testing.T) {
+	repo := new(MockUserRepository)
+	svc := service.NewUserService(repo)
+
+	ids := make([]string, domain.MaxBatchLookup+1)
+	for i := range ids {
+		ids[i] = "u"
+	}
+	_, err := svc.GetUsersByIDs(context.Background(), ids)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = svc.GetUsersByIDs(context.Background(), []string{"u-1", ""})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	cards, err := svc.GetUsersByIDs(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, cards)
+	repo.AssertNotCalled(t, "GetUserCardsByIDs", mock.Anything, mock.Anything)
+}
+
+func TestGetProfilesByAddresses_NormalizesAddresses(t *testing.T) {
+	repo := new(MockUserRepository)
+	svc := service.NewUserService(repo)
+	ctx := context.Background()
+
+	addr := "0x1234567890123456789012345678901234567890"
+	other := "0x00000000000000000000000000000000000000aa"
+	repo.On("GetUserCardsByAddresses", ctx, []string{other, addr}).Return(map[string]*domain.UserCard{
+		addr: userCard("u-1", "alice"),
+	}, nil)
+
+	cards, err := svc.GetProfilesByAddresses(ctx, []string{"0x" + strings.ToUpper(other[2:]), addr})
+	require.NoError(t, err)
+	require.Len(t, cards, 2)
+	assert.Nil(t, cards[0])
+	assert.Equal(t, "u-1", cards[1].User.ID)
+
+	_, err = svc.GetProfilesByAddresses(ctx, []string{"0xnot-an-address"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestGRPC_GetProfilesByAddresses(t *testing.T) {
+	svc := new(MockUserService)
+	handler := grpcHandler.NewgRPCHandler(svc)
+	ctx := context.Background()
+
+	req := &userpb.GetProfilesByAddressesRequest{Addresses: []string{"0xAA", "0xbb"}}
+	svc.On("GetProfilesByAddresses", ctx, req.Addresses).Return([]*domain.UserCard{nil, userCard("u-1", "alice")}, nil)
+
+	resp, err := handler.GetProfilesByAddresses(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Profiles, 2)
+	assert.Equal(t, "0xaa", resp.Profiles[0].Address)
+	assert.False(t, resp.Profiles[0].Found)
+	assert.True(t, resp.Profiles[1].Found)
+	assert.Equal(t, "alice", resp.Profiles[1].Profile.Username)
+	assert.Equal(t, "1970-01-01T00:00:00Z", resp.Profiles[1].User.CreatedAt)
+}
+
+func TestGRPC_GetUsersByIDs_InvalidInput(t *testing.T) {
+	svc := new(MockUserService)
+	handler := grpcHandler.NewgRPCHandler(svc)
+	ctx := context.Background()
+
+	svc.On("GetUsersByIDs", ctx, []string{""}).Return(nil, domain.NewInvalidInputError("user_ids", "cannot contain empty ids"))
+
+	_, err := handler.GetUsersByIDs(ctx, &userpb.GetUsersByIDsRequest{UserIds: []string{""}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,273 @@
+package test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+)
+
+// MockEmailRepository is a mock implementation of EmailRepository
+type MockEmailRepository struct {
+	mock.Mock
+}
+
+func (m *MockEmailRepository) GetEmail(ctx context.Context, userID string) (*domain.UserEmail, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserEmail), args.Error(1)
+}
+
+func (m *MockEmailRepository) IsEmailTaken(ctx context.Context, email, exceptUserID string) (bool, error) {
+	args := m.Called(ctx, email, exceptUserID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEmailRepository) CreateVerification(ctx context.Context, v *domain.EmailVerification) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+func (m *MockEmailRepository) GetVerification(ctx context.Context, id string) (*domain.EmailVerification, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EmailVerification), args.Error(1)
+}
+
+func (m *MockEmailRepository) GetLatestVerification(ctx context.Context, userID string) (*domain.EmailVerification, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EmailVerification), args.Error(1)
+}
+
+func (m *MockEmailRepository) IncrementVerificationAttempts(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockEmailRepository) ConfirmVerification(ctx context.Context, v *domain.EmailVerification) (*domain.UserEmail, error) {
+	args := m.Called(ctx, v)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserEmail), args.Error(1)
+}
+
+func (m *MockEmailRepository) SetDigestOptOut(ctx context.Context, userID string, optOut bool) (*domain.UserEmail, error) {
+	args := m.Called(ctx, userID, optOut)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserEmail), args.Error(1)
+}
+
+func (m *MockEmailRepository) SetOptOut(ctx context.Context, userID string, kind domain.EmailKind, optOut bool) (*domain.UserEmail, error) {
+	args := m.Called(ctx, userID, kind, optOut)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserEmail), args.Error(1)
+}
+
+// recordingMailer keeps every message instead of sending it
+type recordingMailer struct {
+	sent []domain.OutgoingEmail
+}
+
+func (m *recordingMailer) Send(ctx context.Context, msg domain.OutgoingEmail) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// EmailServiceTestSuite defines the test suite for EmailService
+type EmailServiceTestSuite struct {
+	suite.Suite
+	emailService *service.EmailService
+	mockRepo     *MockEmailRepository
+	mailer       *recordingMailer
+}
+
+func (suite *EmailServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockEmailRepository)
+	suite.mailer = &recordingMailer{}
+	suite.emailService = service.NewEmailService(suite.mockRepo, suite.mailer, "test-secret", "https://app.test/verify", 15*time.Minute)
+}
+
+func (suite *EmailServiceTestSuite) TestStartEmailVerification_SendsCodeAndLink() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("IsEmailTaken", ctx, "alice@example.com", "user-1").Return(false, nil)
+	suite.mockRepo.On("CreateVerification", ctx, mock.AnythingOfType("*domain.EmailVerification")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*domain.EmailVerification).ID = "ver-1"
+		}).Return(nil)
+
+	expiresAt, err := suite.emailService.StartEmailVerification(ctx, "user-1", " Alice@Example.com ")
+
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), expiresAt.After(time.Now()))
+	assert.Len(suite.T(), suite.mailer.sent, 1)
+	assert.Equal(suite.T(), "alice@example.com", suite.mailer.sent[0].To)
+	assert.Regexp(suite.T(), `code is \d{6}`, suite.mailer.sent[0].Body)
+	assert.Contains(suite.T(), suite.mailer.sent[0].Body, "https://app.test/verify?token=ver-1.")
+
+	// Only the hash of the code is stored
+	v := suite.mockRepo.Calls[1].Arguments.Get(1).(*domain.EmailVerification)
+	assert.Len(suite.T(), v.CodeHash, 64)
+	assert.Equal(suite.T(), hashOf(codeFrom(suite.mailer.sent[0].Body)), v.CodeHash)
+}
+
+func (suite *EmailServiceTestSuite) TestStartEmailVerification_EmailTaken() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("IsEmailTaken", ctx, "taken@example.com", "user-1").Return(true, nil)
+
+	_, err := suite.emailService.StartEmailVerification(ctx, "user-1", "taken@example.com")
+
+	assert.ErrorIs(suite.T(), err, domain.ErrEmailTaken)
+	assert.Empty(suite.T(), suite.mailer.sent)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateVerification", mock.Anything, mock.Anything)
+}
+
+func (suite *EmailServiceTestSuite) TestStartEmailVerification_InvalidEmail() {
+	_, err := suite.emailService.StartEmailVerification(context.Background(), "user-1", "not-an-email")
+
+	assert.ErrorIs(suite.T(), err, domain.ErrInvalidInput)
+	assert.Empty(suite.T(), suite.mailer.sent)
+}
+
+func (suite *EmailServiceTestSuite) TestConfirmEmail_WithCode() {
+	ctx := context.Background()
+	v := &domain.EmailVerification{
+		ID:        "ver-1",
+		UserID:    "user-1",
+		Email:     "alice@example.com",
+		CodeHash:  hashOf("123456"),
+		ExpiresAt: time.Now().Add(10 * time.Minute),
+	}
+	confirmed := &domain.UserEmail{UserID: "user-1", Email: "alice@example.com", VerifiedAt: time.Now()}
+
+	suite.mockRepo.On("GetLatestVerification", ctx, "user-1").Return(v, nil)
+	suite.mockRepo.On("ConfirmVerification", ctx, v).Return(confirmed, nil)
+
+	email, err := suite.emailService.ConfirmEmail(ctx, "user-1", "123456")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "alice@example.com", email.Email)
+}
+
+func (suite *EmailServiceTestSuite) TestConfirmEmail_WrongCodeCountsAttempt() {
+	ctx := context.Background()
+	v := &domain.EmailVerification{
+		ID:        "ver-1",
+		UserID:    "user-1",
+		CodeHash:  hashOf("123456"),
+		ExpiresAt: time.Now().Add(10 * time.Minute),
+	}
+
+	suite.mockRepo.On("GetLatestVerification", ctx, "user-1").Return(v, nil)
+	suite.mockRepo.On("IncrementVerificationAttempts", ctx, "ver-1").Return(nil)
+
+	_, err := suite.emailService.ConfirmEmail(ctx, "user-1", "000000")
+
+	assert.ErrorIs(suite.T(), err, domain.ErrVerificationInvalid)
+	suite.mockRepo.AssertCalled(suite.T(), "IncrementVerificationAttempts", ctx, "ver-1")
+	suite.mockRepo.AssertNotCalled(suite.T(), "ConfirmVerification", mock.Anything, mock.Anything)
+}
+
+func (suite *EmailServiceTestSuite) TestConfirmEmail_ExhaustedAndExpired() {
+	ctx := context.Background()
+
+	exhausted := &domain.EmailVerification{ID: "ver-1", UserID: "user-1", CodeHash: hashOf("123456"), Attempts: 5, ExpiresAt: time.Now().Add(time.Minute)}
+	suite.mockRepo.On("GetLatestVerification", ctx, "user-1").Return(exhausted, nil)
+	_, err := suite.emailService.ConfirmEmail(ctx, "user-1", "123456")
+	assert.ErrorIs(suite.T(), err, domain.ErrVerificationExhausted)
+
+	expired := &domain.EmailVerification{ID: "ver-2", UserID: "user-2", CodeHash: hashOf("123456"), ExpiresAt: time.Now().Add(-time.Minute)}
+	suite.mockRepo.On("GetLatestVerification", ctx, "user-2").Return(expired, nil)
+	_, err = suite.emailService.ConfirmEmail(ctx, "user-2", "123456")
+	assert.ErrorIs(suite.T(), err, domain.ErrVerificationExpired)
+
+	suite.mockRepo.AssertNotCalled(suite.T(), "ConfirmVerification", mock.Anything, mock.Anything)
+}
+
+func (suite *EmailServiceTestSuite) TestConfirmEmail_WithMagicLinkToken() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("IsEmailTaken", ctx, "alice@example.com", "user-1").Return(false, nil)
+	suite.mockRepo.On("CreateVerification", ctx, mock.AnythingOfType("*domain.EmailVerification")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*domain.EmailVerification).ID = "ver-1"
+		}).Return(nil)
+
+	_, err := suite.emailService.StartEmailVerification(ctx, "user-1", "alice@example.com")
+	assert.NoError(suite.T(), err)
+
+	token := regexp.MustCompile(`token=(\S+)`).FindStringSubmatch(suite.mailer.sent[0].Body)[1]
+	v := suite.mockRepo.Calls[1].Arguments.Get(1).(*domain.EmailVerification)
+	confirmed := &domain.UserEmail{UserID: "user-1", Email: "alice@example.com", VerifiedAt: time.Now()}
+	suite.mockRepo.On("GetVerification", ctx, "ver-1").Return(v, nil)
+	suite.mockRepo.On("ConfirmVerification", ctx, v).Return(confirmed, nil)
+
+	email, err := suite.emailService.ConfirmEmail(ctx, "user-1", token)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "alice@example.com", email.Email)
+
+	// A tampered signature is rejected before touching the repository
+	_, err = suite.emailService.ConfirmEmail(ctx, "user-1", token[:len(token)-1]+"0")
+	assert.ErrorIs(suite.T(), err, domain.ErrVerificationInvalid)
+
+	// Another user cannot redeem the link
+	_, err = suite.emailService.ConfirmEmail(ctx, "user-2", token)
+	assert.ErrorIs(suite.T(), err, domain.ErrVerificationInvalid)
+}
+
+func (suite *EmailServiceTestSuite) TestGetNotificationEmail() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetEmail", ctx, "user-1").Return(&domain.UserEmail{Email: "alice@example.com", VerifiedAt: time.Now()}, nil)
+	suite.mockRepo.On("GetEmail", ctx, "user-2").Return(&domain.UserEmail{Email: "bob@example.com", VerifiedAt: time.Now(), DigestOptOut: true}, nil)
+	suite.mockRepo.On("GetEmail", ctx, "user-3").Return(nil, domain.ErrEmailNotFound)
+
+	email, deliverable, err := suite.emailService.GetNotificationEmail(ctx, "user-1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "alice@example.com", email)
+	assert.True(suite.T(), deliverable)
+
+	_, deliverable, err = suite.emailService.GetNotificationEmail(ctx, "user-2")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), deliverable)
+
+	email, deliverable, err = suite.emailService.GetNotificationEmail(ctx, "user-3")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), email)
+	assert.False(suite.T(), deliverable)
+}
+
+func TestEmailServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(EmailServiceTestSuite))
+}
+
+func hashOf(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func codeFrom(body string) string {
+	return regexp.MustCompile(`code is (\d{6})`).FindStringSubmatch(body)[1]
+}
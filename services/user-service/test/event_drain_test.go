@@ -0,0 +1,129 @@
+package test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
+)
+
+// The service consumes every queue under the "user-service" tag on one channel, and
+// shutdown drains them before the connection closes
+func TestRabbitMQ_DrainFinishesReceivedDeliveries(t *testing.T) {
+	h := testharness.New(t)
+	_, cfg := h.RabbitMQ(t)
+	ctx := context.Background()
+
+	open := func(t *testing.T, queues ...string) *messaging.RabbitMQ {
+		t.Helper()
+		rmq, err := messaging.NewRabbitMQ(cfg)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = rmq.Close() })
+		for _, q := range queues {
+			_, err := rmq.DeclareQueue(messaging.QueueConfig{Name: q, AutoDelete: true})
+			require.NoError(t, err)
+		}
+		return rmq
+	}
+	publish := func(t *testing.T, rmq *messaging.RabbitMQ, queue string) {
+		t.Helper()
+		require.NoError(t, rmq.PublishWithConfig(ctx, []byte(`{}`), messaging.PublishConfig{RoutingKey: queue}))
+	}
+	inspect := func(t *testing.T, rmq *messaging.RabbitMQ, queue string) amqp.Queue {
+		t.Helper()
+		ch, err := rmq.GetConnection().Channel()
+		require.NoError(t, err)
+		defer ch.Close()
+		q, err := ch.QueueDeclarePassive(queue, false, true, false, false, nil)
+		require.NoError(t, err)
+		return q
+	}
+
+	t.Run("SharedTagAcrossQueues", func(t *testing.T) {
+		rmq := open(t, "drain.tags.a", "drain.tags.b")
+		noop := func(context.Context, amqp.Delivery) error { return nil }
+
+		// Each consumer gets its own tag, so both register and both are cancelled
+		require.NoError(t, rmq.Consume("drain.tags.a", "user-service", noop))
+		require.NoError(t, rmq.Consume("drain.tags.b", "user-service", noop))
+		assert.Equal(t, 1, inspect(t, rmq, "drain.tags.a").Consumers)
+		assert.Equal(t, 1, inspect(t, rmq, "drain.tags.b").Consumers)
+
+		require.NoError(t, rmq.Drain(ctx))
+		assert.Eventually(t, func() bool {
+			return inspect(t, rmq, "drain.tags.a").Consumers == 0 && inspect(t, rmq, "drain.tags.b").Consumers == 0
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("WaitsForHandlerThenStopsFetching", func(t *testing.T) {
+		rmq := open(t, "drain.inflight")
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		var handled atomic.Int32
+		require.NoError(t, rmq.Consume("drain.inflight", "user-service", func(context.Context, amqp.Delivery) error {
+			started <- struct{}{}
+			<-release
+			handled.Add(1)
+			return nil
+		}))
+
+		publish(t, rmq, "drain.inflight")
+		select {
+		case <-started:
+		case <-time.After(10 * time.Second):
+			t.Fatal("delivery never reached the handler")
+		}
+
+		drained := make(chan error, 1)
+		go func() { drained <- rmq.Drain(ctx) }()
+		select {
+		case err := <-drained:
+			t.Fatalf("drain returned while a delivery was being handled: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		close(release)
+		select {
+		case err := <-drained:
+			require.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("drain did not return once the handler finished")
+		}
+		assert.Equal(t, int32(1), handled.Load())
+
+		// Nothing is fetched after draining; the message waits for the next consumer
+		publish(t, rmq, "drain.inflight")
+		assert.Eventually(t, func() bool { return inspect(t, rmq, "drain.inflight").Messages == 1 }, 5*time.Second, 50*time.Millisecond)
+		assert.Equal(t, int32(1), handled.Load())
+	})
+
+	t.Run("GivesUpWhenCtxEnds", func(t *testing.T) {
+		rmq := open(t, "drain.stuck")
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		defer close(release)
+		require.NoError(t, rmq.Consume("drain.stuck", "user-service", func(context.Context, amqp.Delivery) error {
+			started <- struct{}{}
+			<-release
+			return nil
+		}))
+
+		publish(t, rmq, "drain.stuck")
+		select {
+		case <-started:
+		case <-time.After(10 * time.Second):
+			t.Fatal("delivery never reached the handler")
+		}
+
+		drainCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, rmq.Drain(drainCtx), context.DeadlineExceeded)
+	})
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
 	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/user-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/shared/errs"
 	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
 )
 
@@ -25,6 +26,24 @@ func (m *MockUserService) EnsureUser(ctx context.Context, accountID, address, ch
 	return args.Get(0).(*domain.EnsureUserResult), args.Error(1)
 }
 
+func (m *MockUserService) GetUsersByIDs(ctx context.Context, userIDs []string) ([]*domain.UserCard, error) {
+	args := m.Called(ctx, userIDs)
+	cards, _ := args.Get(0).([]*domain.UserCard)
+	return cards, args.Error(1)
+}
+
+func (m *MockUserService) GetProfilesByAddresses(ctx context.Context, addresses []string) ([]*domain.UserCard, error) {
+	args := m.Called(ctx, addresses)
+	cards, _ := args.Get(0).([]*domain.UserCard)
+	return cards, args.Error(1)
+}
+
+func (m *MockUserService) SuggestUsers(ctx context.Context, query string, limit int) ([]domain.UserSuggestion, error) {
+	args := m.Called(ctx, query, limit)
+	suggestions, _ := args.Get(0).([]domain.UserSuggestion)
+	return suggestions, args.Error(1)
+}
+
 // UserGRPCTestSuite defines the test suite for User gRPC handler
 type UserGRPCTestSuite struct {
 	suite.Suite
@@ -164,7 +183,8 @@ func (suite *UserGRPCTestSuite) TestEnsureUser_ServiceError() {
 
 	st, ok := status.FromError(err)
 	suite.True(ok)
-	suite.Equal(codes.Internal, st.Code())
+	suite.Equal(codes.InvalidArgument, st.Code())
+	suite.ErrorIs(errs.FromGRPC(err), domain.ErrInvalidAddress)
 	suite.mockService.AssertExpectations(suite.T())
 }
 
@@ -324,13 +344,13 @@ func TestErrorMapping(t *testing.T) {
 		{
 			name:         "invalid_input_error",
 			serviceError: domain.ErrInvalidInput,
-			expectedCode: codes.Internal,
+			expectedCode: codes.InvalidArgument,
 			expectedMsg:  "invalid_input",
 		},
 		{
 			name:         "invalid_address_error",
 			serviceError: domain.ErrInvalidAddress,
-			expectedCode: codes.Internal,
+			expectedCode: codes.InvalidArgument,
 			expectedMsg:  "invalid_address",
 		},
 		{
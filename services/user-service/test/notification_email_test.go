@@ -0,0 +1,329 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/infrastructure/mailer"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// MockNotificationEmailRepository is a mock implementation of NotificationEmailRepository
+type MockNotificationEmailRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationEmailRepository) RecipientsByAddresses(ctx context.Context, addresses []string) ([]domain.EmailRecipient, error) {
+	args := m.Called(ctx, addresses)
+	recipients, _ := args.Get(0).([]domain.EmailRecipient)
+	return recipients, args.Error(1)
+}
+
+func (m *MockNotificationEmailRepository) DueDigestRecipients(ctx context.Context, before time.Time, limit int) ([]domain.EmailRecipient, error) {
+	args := m.Called(ctx, before, limit)
+	recipients, _ := args.Get(0).([]domain.EmailRecipient)
+	return recipients, args.Error(1)
+}
+
+func (m *MockNotificationEmailRepository) DigestActivity(ctx context.Context, userID string, since time.Time) (*domain.DigestActivity, error) {
+	args := m.Called(ctx, userID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DigestActivity), args.Error(1)
+}
+
+func (m *MockNotificationEmailRepository) RecordSaleActivity(ctx context.Context, activity domain.SaleActivity) (bool, error) {
+	args := m.Called(ctx, activity)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockNotificationEmailRepository) ClaimSend(ctx context.Context, userID, dedupeKey string, kind domain.EmailKind) (bool, error) {
+	args := m.Called(ctx, userID, dedupeKey, kind)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockNotificationEmailRepository) ReleaseSend(ctx context.Context, userID, dedupeKey string) error {
+	return m.Called(ctx, userID, dedupeKey).Error(0)
+}
+
+func (m *MockNotificationEmailRepository) CountSentSince(ctx context.Context, userID string, since time.Time) (int, error) {
+	args := m.Called(ctx, userID, since)
+	return args.Int(0), args.Error(1)
+}
+
+type failingMailer struct{}
+
+func (failingMailer) Send(ctx context.Context, msg domain.OutgoingEmail) error {
+	return errors.New("smtp: connection refused")
+}
+
+func emailRecipient(userID, locale string, optOuts ...domain.EmailKind) domain.EmailRecipient {
+	return domain.EmailRecipient{
+		Email:    domain.UserEmail{UserID: userID, Email: userID + "@example.com", VerifiedAt: time.Now(), OptOuts: optOuts},
+		Locale:   locale,
+		Timezone: "UTC",
+	}
+}
+
+func newNotificationEmailService(t *testing.T, repo *MockNotificationEmailRepository, mail domain.Mailer) (*service.NotificationEmailService, *MockEmailRepository) {
+	t.Helper()
+	renderer, err := mailer.NewTemplateRenderer()
+	require.NoError(t, err)
+	emailRepo := new(MockEmailRepository)
+	emails := service.NewEmailService(emailRepo, mail, "test-secret", "https://app.test/verify", 15*time.Minute)
+	return service.NewNotificationEmailService(repo, emails, renderer, mail, "unsubscribe-secret", "https://app.test/unsubscribe"), emailRepo
+}
+
+func saleEvent() *contracts.SaleIndexedEvent {
+	return &contracts.SaleIndexedEvent{
+		EventID:  "sale-1",
+		ChainID:  "eip155-1",
+		TxHash:   "0xabc",
+		Contract: "0x00000000000000000000000000000000000000cc",
+		Data: map[string]interface{}{
+			"seller":   statsSeller,
+			"buyer":    statsBuyer,
+			"price":    "1500000000000000000",
+			"token_id": "42",
+		},
+	}
+}
+
+func TestNotificationEmail_ConfirmsSaleToBothParties(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockNotificationEmailRepository)
+	repo.On("RecipientsByAddresses", ctx, []string{statsSeller}).Return([]domain.EmailRecipient{emailRecipient("seller", "en-US")}, nil)
+	repo.On("RecipientsByAddresses", ctx, []string{statsBuyer}).Return([]domain.EmailRecipient{emailRecipient("buyer", "vi")}, nil)
+	repo.On("RecordSaleActivity", ctx, mock.Anything).Return(true, nil)
+	repo.On("CountSentSince", ctx, mock.Anything, mock.Anything).Return(0, nil)
+	repo.On("ClaimSend", ctx, mock.Anything, mock.Anything, domain.EmailKindSaleConfirmation).Return(true, nil)
+	mail := &recordingMailer{}
+	svc, _ := newNotificationEmailService(t, repo, mail)
+
+	require.NoError(t, svc.HandleSaleIndexed(ctx, saleEvent()))
+
+	repo.AssertCalled(t, "ClaimSend", ctx, "seller", "sale:sale-1:sold", domain.EmailKindSaleConfirmation)
+	repo.AssertCalled(t, "ClaimSend", ctx, "buyer", "sale:sale-1:bought", domain.EmailKindSaleConfirmation)
+	require.Len(t, mail.sent, 2)
+
+	sold := mail.sent[0]
+	assert.Equal(t, "seller@example.com", sold.To)
+	assert.Equal(t, "You sold token #42", sold.Subject)
+	assert.Contains(t, sold.Body, "1.5 ETH")
+	assert.Contains(t, sold.HTML, "<strong>1.5 ETH</strong>")
+	assert.True(t, strings.HasPrefix(sold.Headers["List-Unsubscribe"], "<https://app.test/unsubscribe?token="))
+	assert.Equal(t, "List-Unsubscribe=One-Click", sold.Headers["List-Unsubscribe-Post"])
+
+	bought := mail.sent[1]
+	assert.Equal(t, "Bạn đã mua token #42", bought.Subject, "written in the buyer's locale")
+	assert.Contains(t, bought.Body, "1,5 ETH", "amounts use the buyer's decimal separator")
+}
+
+func TestNotificationEmail_OptedOutSaleStillCountsForDigest(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockNotificationEmailRepository)
+	repo.On("RecipientsByAddresses", ctx, []string{statsSeller}).
+		Return([]domain.EmailRecipient{emailRecipient("seller", "en", domain.EmailKindSaleConfirmation)}, nil)
+	repo.On("RecipientsByAddresses", ctx, []string{statsBuyer}).Return(nil, nil)
+	repo.On("RecordSaleActivity", ctx, mock.Anything).Return(true, nil)
+	mail := &recordingMailer{}
+	svc, _ := newNotificationEmailService(t, repo, mail)
+
+	require.NoError(t, svc.HandleSaleIndexed(ctx, saleEvent()))
+
+	activity := repo.Calls[1].Arguments.Get(1).(domain.SaleActivity)
+	assert.Equal(t, "seller", activity.UserID)
+	assert.Equal(t, domain.SaleSideSold, activity.Side)
+	assert.Equal(t, "1500000000000000000", activity.PriceWei)
+	assert.Equal(t, "ETH", activity.Currency)
+	repo.AssertNotCalled(t, "ClaimSend", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	assert.Empty(t, mail.sent)
+}
+
+func TestNotificationEmail_RedeliveredSaleEmailsOnce(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockNotificationEmailRepository)
+	repo.On("RecipientsByAddresses", ctx, []string{statsSeller}).Return([]domain.EmailRecipient{emailRecipient("seller", "en")}, nil)
+	repo.On("RecipientsByAddresses", ctx, []string{statsBuyer}).Return(nil, nil)
+	repo.On("RecordSaleActivity", ctx, mock.Anything).Return(false, nil)
+	repo.On("CountSentSince", ctx, "seller", mock.Anything).Return(0, nil)
+	repo.On("ClaimSend", ctx, "seller", "sale:sale-1:sold", domain.EmailKindSaleConfirmation).Return(false, nil)
+	mail := &recordingMailer{}
+	svc, _ := newNotificationEmailService(t, repo, mail)
+
+	require.NoError(t, svc.HandleSaleIndexed(ctx, saleEvent()))
+	assert.Empty(t, mail.sent)
+}
+
+func TestNotificationEmail_FailedSendReleasesClaim(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockNotificationEmailRepository)
+	repo.On("RecipientsByAddresses", ctx, []string{statsSeller}).Return([]domain.EmailRecipient{emailRecipient("seller", "en")}, nil)
+	repo.On("RecipientsByAddresses", ctx, []string{statsBuyer}).Return(nil, nil)
+	repo.On("RecordSaleActivity", ctx, mock.Anything).Return(true, nil)
+	repo.On("CountSentSince", ctx, "seller", mock.Anything).Return(0, nil)
+	repo.On("ClaimSend", ctx, "seller", "sale:sale-1:sold", domain.EmailKindSaleConfirmation).Return(true, nil)
+	repo.On("ReleaseSend", ctx, "seller", "sale:sale-1:sold").Return(nil)
+	svc, _ := newNotificationEmailService(t, repo, failingMailer{})
+
+	assert.Error(t, svc.HandleSaleIndexed(ctx, saleEvent()), "the event is redelivered")
+	repo.AssertCalled(t, "ReleaseSend", ctx, "seller", "sale:sale-1:sold")
+}
+
+func TestNotificationEmail_CapsEmailsPerUser(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockNotificationEmailRepository)
+	repo.On("RecipientsByAddresses", ctx, []string{"0x00000000000000000000000000000000000000dd"}).
+		Return([]domain.EmailRecipient{emailRecipient("fan", "en")}, nil)
+	repo.On("CountSentSince", ctx, "fan", mock.Anything).Return(3, nil)
+	mail := &recordingMailer{}
+	svc, _ := newNotificationEmailService(t, repo, mail)
+	svc.SetThrottle(0, 3)
+
+	require.NoError(t, svc.HandleDropStartingSoon(ctx, &contracts.DropStartingSoonEvent{
+		EventID: "drop.starting_soon_job-1_1700000000",
+		ChainID: "eip155-1",
+		Data: map[string]interface{}{
+			"contract_address": "0x00000000000000000000000000000000000000CC",
+			"recipients":       []interface{}{"0x00000000000000000000000000000000000000DD"},
+			"starts_at":        "2026-10-20T18:00:00Z",
+		},
+	}))
+
+	repo.AssertNotCalled(t, "ClaimSend", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	assert.Empty(t, mail.sent)
+}
+
+func TestNotificationEmail_DropReminder(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockNotificationEmailRepository)
+	repo.On("RecipientsByAddresses", ctx, []string{"0x00000000000000000000000000000000000000dd"}).
+		Return([]domain.EmailRecipient{emailRecipient("fan", "en"), emailRecipient("quiet", "en", domain.EmailKindDropReminder)}, nil)
+	repo.On("CountSentSince", ctx, "fan", mock.Anything).Return(0, nil)
+	repo.On("ClaimSend", ctx, "fan", "drop:drop-1", domain.EmailKindDropReminder).Return(true, nil)
+	mail := &recordingMailer{}
+	svc, _ := newNotificationEmailService(t, repo, mail)
+
+	require.NoError(t, svc.HandleDropStartingSoon(ctx, &contracts.DropStartingSoonEvent{
+		EventID: "drop-1",
+		ChainID: "eip155-1",
+		Data: map[string]interface{}{
+			"contract_address": "0x00000000000000000000000000000000000000CC",
+			"recipients":       []interface{}{"0x00000000000000000000000000000000000000DD"},
+			"starts_at":        "2026-10-20T18:00:00Z",
+		},
+	}))
+
+	require.Len(t, mail.sent, 1)
+	assert.Equal(t, "fan@example.com", mail.sent[0].To)
+	assert.Contains(t, mail.sent[0].Body, "0x00000000000000000000000000000000000000cc")
+	assert.Contains(t, mail.sent[0].Body, "2026-10-20 18:00 UTC")
+}
+
+func TestNotificationEmail_DigestsSkipQuietWeeks(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	since := now.Add(-domain.DigestPeriod)
+	repo := new(MockNotificationEmailRepository)
+	repo.On("DueDigestRecipients", ctx, since, 100).
+		Return([]domain.EmailRecipient{emailRecipient("busy", "en"), emailRecipient("quiet", "en")}, nil)
+	repo.On("DigestActivity", ctx, "busy", since).Return(&domain.DigestActivity{
+		Sold: 2, SoldWei: "3000000000000000000", Bought: 0, BoughtWei: "0", NewFollowers: 4, ItemsOwned: 1234,
+	}, nil)
+	repo.On("DigestActivity", ctx, "quiet", since).Return(&domain.DigestActivity{SoldWei: "0", BoughtWei: "0", ItemsOwned: 7}, nil)
+	repo.On("ClaimSend", ctx, mock.Anything, "weekly_digest:2026-10-12", domain.EmailKindWeeklyDigest).Return(true, nil)
+	mail := &recordingMailer{}
+	svc, _ := newNotificationEmailService(t, repo, mail)
+	svc.SetThrottle(0, 1)
+
+	n, err := svc.SendDueDigests(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	repo.AssertCalled(t, "ClaimSend", ctx, "quiet", "weekly_digest:2026-10-12", domain.EmailKindWeeklyDigest)
+	repo.AssertNotCalled(t, "CountSentSince", mock.Anything, mock.Anything, mock.Anything)
+	require.Len(t, mail.sent, 1, "quiet weeks are marked done without an email")
+	assert.Equal(t, "busy@example.com", mail.sent[0].To)
+	assert.Contains(t, mail.sent[0].Body, "Sold: 2 items for 3 ETH")
+	assert.Contains(t, mail.sent[0].Body, "New followers: 4")
+	assert.Contains(t, mail.sent[0].Body, "Items owned: 1,234")
+	assert.NotContains(t, mail.sent[0].Body, "Bought:")
+}
+
+func TestNotificationEmail_UnsubscribeLink(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockNotificationEmailRepository)
+	svc, emailRepo := newNotificationEmailService(t, repo, &recordingMailer{})
+	emailRepo.On("SetOptOut", ctx, "user-1", domain.EmailKindDropReminder, true).
+		Return(&domain.UserEmail{UserID: "user-1", OptOuts: []domain.EmailKind{domain.EmailKindDropReminder}}, nil)
+	emailRepo.On("SetDigestOptOut", ctx, "user-1", true).Return(&domain.UserEmail{UserID: "user-1", DigestOptOut: true}, nil)
+
+	e, kind, err := svc.Unsubscribe(ctx, svc.UnsubscribeToken("user-1", domain.EmailKindDropReminder))
+	require.NoError(t, err)
+	assert.Equal(t, domain.EmailKindDropReminder, kind)
+	assert.True(t, e.OptedOut(domain.EmailKindDropReminder))
+
+	e, _, err = svc.Unsubscribe(ctx, svc.UnsubscribeToken("user-1", domain.EmailKindWeeklyDigest))
+	require.NoError(t, err)
+	assert.True(t, e.DigestOptOut, "the digest link flips the digest switch")
+
+	// A link for one user can't be reused for another, or for another kind
+	token := svc.UnsubscribeToken("user-1", domain.EmailKindDropReminder)
+	sig := token[strings.LastIndex(token, ".")+1:]
+	for _, forged := range []string{"user-2.drop_reminder." + sig, "user-1.sale_confirmation." + sig, "user-1.drop_reminder", ""} {
+		_, _, err := svc.Unsubscribe(ctx, forged)
+		assert.ErrorIs(t, err, domain.ErrUnsubscribeInvalid, forged)
+	}
+}
+
+func TestNotificationEmail_UnsubscribeURLInEmail(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockNotificationEmailRepository)
+	repo.On("RecipientsByAddresses", ctx, []string{statsSeller}).Return([]domain.EmailRecipient{emailRecipient("seller", "en")}, nil)
+	repo.On("RecipientsByAddresses", ctx, []string{statsBuyer}).Return(nil, nil)
+	repo.On("RecordSaleActivity", ctx, mock.Anything).Return(true, nil)
+	repo.On("CountSentSince", ctx, mock.Anything, mock.Anything).Return(0, nil)
+	repo.On("ClaimSend", ctx, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	mail := &recordingMailer{}
+	svc, _ := newNotificationEmailService(t, repo, mail)
+
+	require.NoError(t, svc.HandleSaleIndexed(ctx, saleEvent()))
+	require.Len(t, mail.sent, 1)
+
+	link := strings.Trim(mail.sent[0].Headers["List-Unsubscribe"], "<>")
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+	assert.Equal(t, svc.UnsubscribeToken("seller", domain.EmailKindSaleConfirmation), u.Query().Get("token"))
+	assert.Contains(t, mail.sent[0].Body, link)
+	assert.Contains(t, mail.sent[0].HTML, strings.ReplaceAll(link, "&", "&amp;"))
+}
+
+func TestTemplateRenderer_FallsBackToEnglish(t *testing.T) {
+	renderer, err := mailer.NewTemplateRenderer()
+	require.NoError(t, err)
+
+	data := domain.DropReminderEmailData{ChainID: "eip155-1", Contract: "<script>", StartsAt: "soon", UnsubscribeURL: "https://app.test/u"}
+	for _, locale := range []string{"fr-FR", "", "en-GB"} {
+		rendered, err := renderer.Render(domain.EmailKindDropReminder, locale, data)
+		require.NoError(t, err)
+		assert.Equal(t, "A drop you follow starts soon", rendered.Subject, locale)
+	}
+
+	rendered, err := renderer.Render(domain.EmailKindDropReminder, "vi-VN", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Đợt phát hành bạn theo dõi sắp bắt đầu", rendered.Subject)
+	assert.Contains(t, rendered.HTML, `<html lang="vi">`)
+	assert.Contains(t, rendered.HTML, "&lt;script&gt;", "the html part escapes data")
+	assert.NotContains(t, rendered.HTML, "<script>")
+}
@@ -0,0 +1,270 @@
+package test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+)
+
+// MockOrganizationRepository is a mock implementation of OrganizationRepository
+type MockOrganizationRepository struct {
+	mock.Mock
+}
+
+func (m *MockOrganizationRepository) CreateOrganization(ctx context.Context, org *domain.Organization) error {
+	args := m.Called(ctx, org)
+	return args.Error(0)
+}
+
+func (m *MockOrganizationRepository) GetOrganization(ctx context.Context, orgID string) (*domain.Organization, error) {
+	args := m.Called(ctx, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationRepository) ListMembers(ctx context.Context, orgID string) ([]domain.OrgMember, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).([]domain.OrgMember), args.Error(1)
+}
+
+func (m *MockOrganizationRepository) ListMemberships(ctx context.Context, userID string) ([]domain.OrgMembership, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]domain.OrgMembership), args.Error(1)
+}
+
+func (m *MockOrganizationRepository) GetMember(ctx context.Context, orgID, userID string) (*domain.OrgMember, error) {
+	args := m.Called(ctx, orgID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.OrgMember), args.Error(1)
+}
+
+func (m *MockOrganizationRepository) CountOwners(ctx context.Context, orgID string) (int, error) {
+	args := m.Called(ctx, orgID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockOrganizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID, role string) (*domain.OrgMember, error) {
+	args := m.Called(ctx, orgID, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.OrgMember), args.Error(1)
+}
+
+func (m *MockOrganizationRepository) DeleteMember(ctx context.Context, orgID, userID string) error {
+	args := m.Called(ctx, orgID, userID)
+	return args.Error(0)
+}
+
+func (m *MockOrganizationRepository) CreateInvitation(ctx context.Context, inv *domain.OrgInvitation) error {
+	args := m.Called(ctx, inv)
+	return args.Error(0)
+}
+
+func (m *MockOrganizationRepository) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*domain.OrgInvitation, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.OrgInvitation), args.Error(1)
+}
+
+func (m *MockOrganizationRepository) AcceptInvitation(ctx context.Context, inv *domain.OrgInvitation, userID string) (*domain.OrgMember, error) {
+	args := m.Called(ctx, inv, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.OrgMember), args.Error(1)
+}
+
+// OrganizationServiceTestSuite defines the test suite for OrganizationService
+type OrganizationServiceTestSuite struct {
+	suite.Suite
+	orgService *service.OrganizationService
+	mockRepo   *MockOrganizationRepository
+	mailer     *recordingMailer
+}
+
+func (suite *OrganizationServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockOrganizationRepository)
+	suite.mailer = &recordingMailer{}
+	suite.orgService = service.NewOrganizationService(suite.mockRepo, suite.mailer, "https://app.test/invite", 7*24*time.Hour)
+}
+
+func (suite *OrganizationServiceTestSuite) member(orgID, userID, role string) *domain.OrgMember {
+	return &domain.OrgMember{OrgID: orgID, UserID: userID, Role: role, JoinedAt: time.Now()}
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization_TrimsName() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("CreateOrganization", ctx, mock.MatchedBy(func(o *domain.Organization) bool {
+		return o.Name == "Studio" && o.CreatedBy == "user-1"
+	})).Return(nil)
+
+	org, err := suite.orgService.CreateOrganization(ctx, "user-1", "  Studio ")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Studio", org.Name)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization_EmptyName() {
+	_, err := suite.orgService.CreateOrganization(context.Background(), "user-1", "  ")
+
+	assert.ErrorIs(suite.T(), err, domain.ErrInvalidInput)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrganization", mock.Anything, mock.Anything)
+}
+
+func (suite *OrganizationServiceTestSuite) TestInviteMember_SendsTokenAndStoresHash() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetMember", ctx, "org-1", "admin-1").Return(suite.member("org-1", "admin-1", domain.OrgRoleAdmin), nil)
+	suite.mockRepo.On("GetOrganization", ctx, "org-1").Return(&domain.Organization{ID: "org-1", Name: "Studio"}, nil)
+	suite.mockRepo.On("CreateInvitation", ctx, mock.AnythingOfType("*domain.OrgInvitation")).Return(nil)
+
+	inv, err := suite.orgService.InviteMember(ctx, "org-1", "admin-1", " Bob@Example.com", "")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), domain.OrgRoleMember, inv.Role)
+	assert.Equal(suite.T(), "bob@example.com", inv.Email)
+	assert.Len(suite.T(), suite.mailer.sent, 1)
+
+	token := regexp.MustCompile(`\?token=([0-9a-f]{64})`).FindStringSubmatch(suite.mailer.sent[0].Body)
+	if assert.Len(suite.T(), token, 2) {
+		assert.Equal(suite.T(), hashOf(token[1]), inv.TokenHash)
+	}
+}
+
+func (suite *OrganizationServiceTestSuite) TestInviteMember_MemberForbidden() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetMember", ctx, "org-1", "user-2").Return(suite.member("org-1", "user-2", domain.OrgRoleMember), nil)
+
+	_, err := suite.orgService.InviteMember(ctx, "org-1", "user-2", "bob@example.com", domain.OrgRoleMember)
+
+	assert.ErrorIs(suite.T(), err, domain.ErrOrgForbidden)
+	assert.Empty(suite.T(), suite.mailer.sent)
+}
+
+func (suite *OrganizationServiceTestSuite) TestInviteMember_OwnerRoleRejected() {
+	_, err := suite.orgService.InviteMember(context.Background(), "org-1", "owner-1", "bob@example.com", domain.OrgRoleOwner)
+
+	assert.ErrorIs(suite.T(), err, domain.ErrInvalidInput)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetMember", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrganizationServiceTestSuite) TestAcceptInvitation_JoinsWithInvitedRole() {
+	ctx := context.Background()
+	inv := &domain.OrgInvitation{ID: "inv-1", OrgID: "org-1", Role: domain.OrgRoleAdmin, ExpiresAt: time.Now().Add(time.Hour)}
+
+	suite.mockRepo.On("GetInvitationByTokenHash", ctx, hashOf("tok")).Return(inv, nil)
+	suite.mockRepo.On("GetMember", ctx, "org-1", "user-3").Return(nil, domain.ErrNotOrgMember)
+	suite.mockRepo.On("AcceptInvitation", ctx, inv, "user-3").Return(suite.member("org-1", "user-3", domain.OrgRoleAdmin), nil)
+	suite.mockRepo.On("GetOrganization", ctx, "org-1").Return(&domain.Organization{ID: "org-1", Name: "Studio"}, nil)
+
+	membership, err := suite.orgService.AcceptInvitation(ctx, "user-3", "tok")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "org-1", membership.Organization.ID)
+	assert.Equal(suite.T(), domain.OrgRoleAdmin, membership.Role)
+}
+
+func (suite *OrganizationServiceTestSuite) TestAcceptInvitation_Expired() {
+	ctx := context.Background()
+	inv := &domain.OrgInvitation{ID: "inv-1", OrgID: "org-1", Role: domain.OrgRoleMember, ExpiresAt: time.Now().Add(-time.Minute)}
+
+	suite.mockRepo.On("GetInvitationByTokenHash", ctx, hashOf("tok")).Return(inv, nil)
+
+	_, err := suite.orgService.AcceptInvitation(ctx, "user-3", "tok")
+
+	assert.ErrorIs(suite.T(), err, domain.ErrInvitationExpired)
+	suite.mockRepo.AssertNotCalled(suite.T(), "AcceptInvitation", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrganizationServiceTestSuite) TestRemoveMember_LastOwnerCannotLeave() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetMember", ctx, "org-1", "owner-1").Return(suite.member("org-1", "owner-1", domain.OrgRoleOwner), nil)
+	suite.mockRepo.On("CountOwners", ctx, "org-1").Return(1, nil)
+
+	err := suite.orgService.RemoveMember(ctx, "org-1", "owner-1", "owner-1")
+
+	assert.ErrorIs(suite.T(), err, domain.ErrLastOrgOwner)
+	suite.mockRepo.AssertNotCalled(suite.T(), "DeleteMember", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrganizationServiceTestSuite) TestRemoveMember_AdminCannotRemoveOwner() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetMember", ctx, "org-1", "owner-1").Return(suite.member("org-1", "owner-1", domain.OrgRoleOwner), nil)
+	suite.mockRepo.On("GetMember", ctx, "org-1", "admin-1").Return(suite.member("org-1", "admin-1", domain.OrgRoleAdmin), nil)
+
+	err := suite.orgService.RemoveMember(ctx, "org-1", "admin-1", "owner-1")
+
+	assert.ErrorIs(suite.T(), err, domain.ErrOrgForbidden)
+}
+
+func (suite *OrganizationServiceTestSuite) TestRemoveMember_AdminRemovesMember() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetMember", ctx, "org-1", "user-2").Return(suite.member("org-1", "user-2", domain.OrgRoleMember), nil)
+	suite.mockRepo.On("GetMember", ctx, "org-1", "admin-1").Return(suite.member("org-1", "admin-1", domain.OrgRoleAdmin), nil)
+	suite.mockRepo.On("DeleteMember", ctx, "org-1", "user-2").Return(nil)
+
+	err := suite.orgService.RemoveMember(ctx, "org-1", "admin-1", "user-2")
+
+	assert.NoError(suite.T(), err)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *OrganizationServiceTestSuite) TestSetMemberRole_OnlyOwners() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetMember", ctx, "org-1", "admin-1").Return(suite.member("org-1", "admin-1", domain.OrgRoleAdmin), nil)
+
+	_, err := suite.orgService.SetMemberRole(ctx, "org-1", "admin-1", "user-2", domain.OrgRoleAdmin)
+
+	assert.ErrorIs(suite.T(), err, domain.ErrOrgForbidden)
+}
+
+func (suite *OrganizationServiceTestSuite) TestSetMemberRole_PromoteToOwner() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetMember", ctx, "org-1", "owner-1").Return(suite.member("org-1", "owner-1", domain.OrgRoleOwner), nil)
+	suite.mockRepo.On("GetMember", ctx, "org-1", "user-2").Return(suite.member("org-1", "user-2", domain.OrgRoleMember), nil)
+	suite.mockRepo.On("UpdateMemberRole", ctx, "org-1", "user-2", domain.OrgRoleOwner).
+		Return(suite.member("org-1", "user-2", domain.OrgRoleOwner), nil)
+
+	member, err := suite.orgService.SetMemberRole(ctx, "org-1", "owner-1", "user-2", domain.OrgRoleOwner)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), domain.OrgRoleOwner, member.Role)
+}
+
+func (suite *OrganizationServiceTestSuite) TestGetOrganization_NonMember() {
+	ctx := context.Background()
+
+	suite.mockRepo.On("GetMember", ctx, "org-1", "stranger").Return(nil, domain.ErrNotOrgMember)
+
+	_, _, err := suite.orgService.GetOrganization(ctx, "org-1", "stranger")
+
+	assert.ErrorIs(suite.T(), err, domain.ErrNotOrgMember)
+	suite.mockRepo.AssertNotCalled(suite.T(), "ListMembers", mock.Anything, mock.Anything)
+}
+
+func TestOrganizationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationServiceTestSuite))
+}
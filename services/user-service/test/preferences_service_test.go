@@ -0,0 +1,112 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+)
+
+// MockPreferencesRepository is a mock implementation of PreferencesRepository
+type MockPreferencesRepository struct {
+	mock.Mock
+}
+
+func (m *MockPreferencesRepository) GetPreferences(ctx context.Context, userID string) (*domain.Preferences, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Preferences), args.Error(1)
+}
+
+func (m *MockPreferencesRepository) UpdatePreferences(ctx context.Context, userID string, update domain.PreferencesUpdate) (*domain.Preferences, error) {
+	args := m.Called(ctx, userID, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Preferences), args.Error(1)
+}
+
+func TestUpdatePreferences_Canonicalizes(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPreferencesRepository)
+	svc := service.NewPreferencesService(repo)
+
+	want := domain.PreferencesUpdate{Locale: "en-US", Timezone: "Europe/Berlin", Currency: "EUR"}
+	prefs := &domain.Preferences{UserID: "user-1", Locale: "en-US", Timezone: "Europe/Berlin", Currency: "EUR"}
+	repo.On("UpdatePreferences", ctx, "user-1", want).Return(prefs, nil)
+
+	got, err := svc.UpdatePreferences(ctx, "user-1", domain.PreferencesUpdate{Locale: "en_us", Timezone: "Europe/Berlin", Currency: " eur "})
+
+	assert.NoError(t, err)
+	assert.Equal(t, prefs, got)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdatePreferences_PartialUpdate(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPreferencesRepository)
+	svc := service.NewPreferencesService(repo)
+
+	repo.On("UpdatePreferences", ctx, "user-1", domain.PreferencesUpdate{Currency: "JPY"}).
+		Return(&domain.Preferences{UserID: "user-1", Locale: "ja-JP", Timezone: "Asia/Tokyo", Currency: "JPY"}, nil)
+
+	_, err := svc.UpdatePreferences(ctx, "user-1", domain.PreferencesUpdate{Currency: "jpy"})
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdatePreferences_DelegationToggleOnly(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPreferencesRepository)
+	svc := service.NewPreferencesService(repo)
+
+	honor := true
+	repo.On("UpdatePreferences", ctx, "user-1", domain.PreferencesUpdate{HonorDelegations: &honor}).
+		Return(&domain.Preferences{UserID: "user-1", Locale: "en", Timezone: "UTC", Currency: "USD", HonorDelegations: true}, nil)
+
+	got, err := svc.UpdatePreferences(ctx, "user-1", domain.PreferencesUpdate{HonorDelegations: &honor})
+
+	assert.NoError(t, err)
+	assert.True(t, got.HonorDelegations)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdatePreferences_RejectsInvalidInput(t *testing.T) {
+	cases := map[string]domain.PreferencesUpdate{
+		"empty":    {},
+		"locale":   {Locale: "not a locale"},
+		"timezone": {Timezone: "Mars/Olympus"},
+		"local":    {Timezone: "Local"},
+		"currency": {Currency: "XYZ"},
+	}
+	for name, update := range cases {
+		t.Run(name, func(t *testing.T) {
+			repo := new(MockPreferencesRepository)
+			svc := service.NewPreferencesService(repo)
+
+			_, err := svc.UpdatePreferences(context.Background(), "user-1", update)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+			repo.AssertNotCalled(t, "UpdatePreferences", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGetPreferences_ProfileNotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPreferencesRepository)
+	svc := service.NewPreferencesService(repo)
+
+	repo.On("GetPreferences", ctx, "user-1").Return(nil, domain.ErrProfileNotFound)
+
+	_, err := svc.GetPreferences(ctx, "user-1")
+
+	assert.ErrorIs(t, err, domain.ErrProfileNotFound)
+}
@@ -0,0 +1,160 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+)
+
+// MockPrivacyRepository is a mock implementation of PrivacyRepository
+type MockPrivacyRepository struct {
+	mock.Mock
+}
+
+func (m *MockPrivacyRepository) AddRelationship(ctx context.Context, userID, targetID string, kind domain.RelationshipKind) error {
+	return m.Called(ctx, userID, targetID, kind).Error(0)
+}
+
+func (m *MockPrivacyRepository) RemoveRelationship(ctx context.Context, userID, targetID string, kind domain.RelationshipKind) error {
+	return m.Called(ctx, userID, targetID, kind).Error(0)
+}
+
+func (m *MockPrivacyRepository) CountRelationships(ctx context.Context, userID string, kind domain.RelationshipKind) (int, error) {
+	args := m.Called(ctx, userID, kind)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPrivacyRepository) ListRelationships(ctx context.Context, userID string, kind domain.RelationshipKind) ([]domain.Relationship, error) {
+	args := m.Called(ctx, userID, kind)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Relationship), args.Error(1)
+}
+
+func (m *MockPrivacyRepository) SetVisibility(ctx context.Context, userID string, visibility domain.ProfileVisibility) error {
+	return m.Called(ctx, userID, visibility).Error(0)
+}
+
+func (m *MockPrivacyRepository) GetProfileAccess(ctx context.Context, viewerID, ownerID string) (*domain.ProfileAccess, error) {
+	args := m.Called(ctx, viewerID, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProfileAccess), args.Error(1)
+}
+
+func (m *MockPrivacyRepository) SuppressedRecipients(ctx context.Context, recipients, parties []string) (map[string]bool, error) {
+	args := m.Called(ctx, recipients, parties)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func TestSetRelationship_BlocksAndUnblocks(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPrivacyRepository)
+	svc := service.NewPrivacyService(repo)
+
+	repo.On("CountRelationships", ctx, "user-1", domain.RelationshipBlock).Return(3, nil)
+	repo.On("AddRelationship", ctx, "user-1", "user-2", domain.RelationshipBlock).Return(nil)
+	repo.On("RemoveRelationship", ctx, "user-1", "user-2", domain.RelationshipBlock).Return(nil)
+	// A block ends the follows both ways
+	repo.On("RemoveRelationship", ctx, "user-1", "user-2", domain.RelationshipFollow).Return(nil).Once()
+	repo.On("RemoveRelationship", ctx, "user-2", "user-1", domain.RelationshipFollow).Return(nil).Once()
+
+	assert.NoError(t, svc.SetRelationship(ctx, "user-1", "user-2", domain.RelationshipBlock, true))
+	assert.NoError(t, svc.SetRelationship(ctx, "user-1", "user-2", domain.RelationshipBlock, false))
+	repo.AssertExpectations(t)
+}
+
+func TestSetRelationship_FollowsAndUnfollows(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPrivacyRepository)
+	svc := service.NewPrivacyService(repo)
+
+	repo.On("CountRelationships", ctx, "user-1", domain.RelationshipFollow).Return(0, nil)
+	repo.On("AddRelationship", ctx, "user-1", "user-2", domain.RelationshipFollow).Return(nil)
+	repo.On("RemoveRelationship", ctx, "user-1", "user-2", domain.RelationshipFollow).Return(nil)
+
+	assert.NoError(t, svc.SetRelationship(ctx, "user-1", "user-2", domain.RelationshipFollow, true))
+	assert.NoError(t, svc.SetRelationship(ctx, "user-1", "user-2", domain.RelationshipFollow, false))
+	repo.AssertExpectations(t)
+	repo.AssertNumberOfCalls(t, "RemoveRelationship", 1)
+}
+
+func TestSetRelationship_Rejections(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPrivacyRepository)
+	svc := service.NewPrivacyService(repo)
+
+	err := svc.SetRelationship(ctx, "user-1", "user-1", domain.RelationshipMute, true)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "users cannot block or mute themselves")
+
+	err = svc.SetRelationship(ctx, "user-1", "user-2", domain.RelationshipKind("friend"), true)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	repo.On("CountRelationships", ctx, "user-1", domain.RelationshipMute).Return(domain.MaxRelationships, nil)
+	err = svc.SetRelationship(ctx, "user-1", "user-2", domain.RelationshipMute, true)
+	assert.ErrorIs(t, err, domain.ErrRelationshipLimit)
+	repo.AssertNotCalled(t, "AddRelationship", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetProfileVisibility_ValidatesLevel(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPrivacyRepository)
+	svc := service.NewPrivacyService(repo)
+
+	repo.On("SetVisibility", ctx, "user-1", domain.VisibilityHolders).Return(nil)
+
+	assert.NoError(t, svc.SetProfileVisibility(ctx, "user-1", domain.VisibilityHolders))
+	assert.ErrorIs(t, svc.SetProfileVisibility(ctx, "user-1", "friends"), domain.ErrInvalidInput)
+	repo.AssertExpectations(t)
+}
+
+func TestGetProfileAccess_OwnerAlwaysSeesOwnProfile(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPrivacyRepository)
+	svc := service.NewPrivacyService(repo)
+
+	access, err := svc.GetProfileAccess(ctx, "user-1", "user-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.VisibilityPublic, access.Visibility)
+	assert.False(t, access.Blocked)
+	repo.AssertNotCalled(t, "GetProfileAccess", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFilterRecipients_DropsSuppressedInOrder(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPrivacyRepository)
+	svc := service.NewPrivacyService(repo)
+
+	recipients := []string{"0x00000000000000000000000000000000000000aa", "0x00000000000000000000000000000000000000bb"}
+	repo.On("SuppressedRecipients", ctx, recipients, recipients).
+		Return(map[string]bool{"0x00000000000000000000000000000000000000aa": true}, nil)
+
+	kept, err := svc.FilterRecipients(ctx,
+		[]string{"0x00000000000000000000000000000000000000AA", "0x00000000000000000000000000000000000000bb", "0x00000000000000000000000000000000000000aa"},
+		recipients)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0x00000000000000000000000000000000000000bb"}, kept)
+}
+
+func TestFilterRecipients_WithoutPartiesKeepsEveryone(t *testing.T) {
+	repo := new(MockPrivacyRepository)
+	svc := service.NewPrivacyService(repo)
+
+	kept, err := svc.FilterRecipients(context.Background(), []string{"0x00000000000000000000000000000000000000aa"}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0x00000000000000000000000000000000000000aa"}, kept)
+	repo.AssertNotCalled(t, "SuppressedRecipients", mock.Anything, mock.Anything, mock.Anything)
+}
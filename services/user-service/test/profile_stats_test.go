@@ -0,0 +1,137 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+const (
+	statsSeller = "0x00000000000000000000000000000000000000aa"
+	statsBuyer  = "0x00000000000000000000000000000000000000bb"
+)
+
+// MockProfileStatsRepository is a mock implementation of ProfileStatsRepository
+type MockProfileStatsRepository struct {
+	mock.Mock
+}
+
+func (m *MockProfileStatsRepository) ApplyWalletStats(ctx context.Context, eventID string, deltas []domain.WalletStatsDelta) (bool, error) {
+	args := m.Called(ctx, eventID, deltas)
+	return args.Bool(0), args.Error(1)
+}
+
+// appliedDeltas returns the deltas of the only ApplyWalletStats call, by wallet
+func appliedDeltas(t *testing.T, repo *MockProfileStatsRepository) (string, map[string]domain.WalletStatsDelta) {
+	t.Helper()
+	require.Len(t, repo.Calls, 1)
+	byWallet := map[string]domain.WalletStatsDelta{}
+	for _, d := range repo.Calls[0].Arguments.Get(2).([]domain.WalletStatsDelta) {
+		byWallet[d.Address] = d
+	}
+	return repo.Calls[0].Arguments.String(1), byWallet
+}
+
+func TestProfileStats_CountsNewCollectionsForTheirCreator(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockProfileStatsRepository)
+	repo.On("ApplyWalletStats", ctx, mock.Anything, mock.Anything).Return(true, nil)
+	svc := service.NewProfileStatsService(repo)
+
+	require.NoError(t, svc.HandleCollectionUpserted(ctx, &contracts.CollectionUpsertedEvent{
+		EventID:     "collection_created_col-1_1",
+		AggregateID: "col-1",
+		Data:        map[string]interface{}{"is_new": true, "creator": "0x00000000000000000000000000000000000000AA"},
+	}))
+
+	eventID, deltas := appliedDeltas(t, repo)
+	assert.Equal(t, "collection_created:col-1", eventID, "keyed by collection so a republished creation counts once")
+	assert.Equal(t, int64(1), deltas[statsSeller].CollectionsCreated)
+}
+
+func TestProfileStats_IgnoresUpdatesOfKnownCollections(t *testing.T) {
+	repo := new(MockProfileStatsRepository)
+	svc := service.NewProfileStatsService(repo)
+
+	require.NoError(t, svc.HandleCollectionUpserted(context.Background(), &contracts.CollectionUpsertedEvent{
+		EventID:     "collection_upserted_col-1_2",
+		AggregateID: "col-1",
+		Data:        map[string]interface{}{"is_new": false, "creator": statsSeller},
+	}))
+	repo.AssertNotCalled(t, "ApplyWalletStats", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProfileStats_MovesItemsOwned(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockProfileStatsRepository)
+	repo.On("ApplyWalletStats", ctx, mock.Anything, mock.Anything).Return(true, nil)
+	svc := service.NewProfileStatsService(repo)
+
+	require.NoError(t, svc.HandleHoldingsChanged(ctx, &contracts.HoldingsChangedEvent{
+		EventID: "holdings_changed_evt-1",
+		Deltas: map[string]int64{
+			statsSeller:    -2,
+			statsBuyer:     1,
+			"not-a-wallet": 5,
+		},
+	}))
+
+	eventID, deltas := appliedDeltas(t, repo)
+	assert.Equal(t, "holdings_changed_evt-1", eventID)
+	assert.Len(t, deltas, 2)
+	assert.Equal(t, int64(-2), deltas[statsSeller].ItemsOwned)
+	assert.Equal(t, int64(1), deltas[statsBuyer].ItemsOwned)
+}
+
+func TestProfileStats_AddsEthSalesToBothParties(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockProfileStatsRepository)
+	repo.On("ApplyWalletStats", ctx, mock.Anything, mock.Anything).Return(true, nil)
+	svc := service.NewProfileStatsService(repo)
+
+	require.NoError(t, svc.HandleSaleIndexed(ctx, &contracts.SaleIndexedEvent{
+		EventID: "sale-1",
+		Data:    map[string]interface{}{"seller": statsSeller, "buyer": statsBuyer, "price": "1500000000000000000"},
+	}))
+
+	eventID, deltas := appliedDeltas(t, repo)
+	assert.Equal(t, "sale:sale-1", eventID)
+	assert.Equal(t, "1500000000000000000", deltas[statsSeller].VolumeWei.String())
+	assert.Equal(t, "1500000000000000000", deltas[statsBuyer].VolumeWei.String())
+}
+
+func TestProfileStats_SkipsSalesOutsideEth(t *testing.T) {
+	repo := new(MockProfileStatsRepository)
+	svc := service.NewProfileStatsService(repo)
+
+	require.NoError(t, svc.HandleSaleIndexed(context.Background(), &contracts.SaleIndexedEvent{
+		EventID: "sale-2",
+		Data:    map[string]interface{}{"seller": statsSeller, "buyer": statsBuyer, "price": "1000", "currency": "USDC"},
+	}))
+	require.NoError(t, svc.HandleSaleIndexed(context.Background(), &contracts.SaleIndexedEvent{
+		EventID: "sale-3",
+		Data:    map[string]interface{}{"seller": statsSeller, "buyer": statsBuyer, "price": "not a number"},
+	}))
+	repo.AssertNotCalled(t, "ApplyWalletStats", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProfileStats_RetriesOnDatabaseError(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockProfileStatsRepository)
+	repo.On("ApplyWalletStats", ctx, mock.Anything, mock.Anything).Return(false, errors.New("connection reset"))
+	svc := service.NewProfileStatsService(repo)
+
+	err := svc.HandleHoldingsChanged(ctx, &contracts.HoldingsChangedEvent{
+		EventID: "holdings_changed_evt-2",
+		Deltas:  map[string]int64{statsBuyer: 1},
+	})
+	assert.Error(t, err, "the delivery must be nacked so it is redelivered")
+}
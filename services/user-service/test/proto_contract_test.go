@@ -0,0 +1,12 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/protocheck"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+func TestProtoContract(t *testing.T) {
+	protocheck.Check(t, userpb.File_user_proto)
+}
@@ -3,16 +3,20 @@ package test
 import (
 	"context"
 	"database/sql"
+	"sync"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
 )
 
 type UserRepositoryTestSuite struct {
@@ -170,28 +174,85 @@ func TestUserRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(UserRepositoryTestSuite))
 }
 
-// Integration tests with actual database (would require test database setup)
+// Integration tests against Postgres and Redis started by the shared harness
 func TestUserRepositoryIntegration(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration tests in short mode")
-	}
+	h := testharness.New(t)
+	pg, _ := h.Postgres(t, testharness.Migration("user-service"))
+	rds, _ := h.Redis(t)
 
-	// These tests would require actual database connection
-	// You would set up a test database, run migrations, and test real operations
+	repo := repository.NewUserRepository(pg, rds)
+	svc := service.NewUserService(repo)
+	ctx := context.Background()
 
 	t.Run("CreateUserAndProfile", func(t *testing.T) {
-		// Test with real database connection
-		t.Skip("Requires test database setup")
+		account := testharness.NewSiweAccount(t)
+
+		result, err := svc.EnsureUser(ctx, account.AccountID(), account.Address(), "eip155:1")
+		require.NoError(t, err)
+		assert.True(t, result.Created)
+		assert.NotEmpty(t, result.UserID)
+
+		var profiles int
+		require.NoError(t, pg.GetClient().QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM profiles WHERE user_id = $1`, result.UserID).Scan(&profiles))
+		assert.Equal(t, 1, profiles)
+
+		var address string
+		require.NoError(t, pg.GetClient().QueryRowContext(ctx,
+			`SELECT address FROM user_accounts WHERE account_id = $1`, account.AccountID()).Scan(&address))
+		assert.Equal(t, account.AccountID(), address, "address is stored lowercase")
 	})
 
 	t.Run("EnsureUserFlow", func(t *testing.T) {
-		// Test complete EnsureUser flow with real database
-		t.Skip("Requires test database setup")
+		account := testharness.NewSiweAccount(t)
+
+		first, err := svc.EnsureUser(ctx, account.AccountID(), account.Address(), "eip155:1")
+		require.NoError(t, err)
+		second, err := svc.EnsureUser(ctx, account.AccountID(), account.Address(), "eip155:1")
+		require.NoError(t, err)
+
+		assert.True(t, first.Created)
+		assert.False(t, second.Created)
+		assert.Equal(t, first.UserID, second.UserID)
+
+		userID, err := repo.GetUserIDByAccount(ctx, account.AccountID())
+		require.NoError(t, err)
+		assert.Equal(t, first.UserID, userID)
+
+		_, err = repo.GetUserIDByAccount(ctx, testharness.NewSiweAccount(t).AccountID())
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
 	})
 
 	t.Run("ConcurrentUserCreation", func(t *testing.T) {
-		// Test concurrent user creation with same account_id
-		t.Skip("Requires test database setup")
+		account := testharness.NewSiweAccount(t)
+
+		const workers = 8
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			created int
+			userIDs = map[string]struct{}{}
+		)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result, err := svc.EnsureUser(ctx, account.AccountID(), account.Address(), "eip155:1")
+				if !assert.NoError(t, err) {
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				userIDs[result.UserID] = struct{}{}
+				if result.Created {
+					created++
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, created, "the account lock allows a single creation")
+		assert.Len(t, userIDs, 1)
 	})
 }
 
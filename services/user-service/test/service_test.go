@@ -22,6 +22,29 @@ func (m *MockUserRepository) GetUserIDByAccount(ctx context.Context, accountID s
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockUserRepository) GetUserCardsByIDs(ctx context.Context, userIDs []string) (map[string]*domain.UserCard, error) {
+	args := m.Called(ctx, userIDs)
+	cards, _ := args.Get(0).(map[string]*domain.UserCard)
+	return cards, args.Error(1)
+}
+
+func (m *MockUserRepository) GetUserCardsByAddresses(ctx context.Context, addresses []string) (map[string]*domain.UserCard, error) {
+	args := m.Called(ctx, addresses)
+	cards, _ := args.Get(0).(map[string]*domain.UserCard)
+	return cards, args.Error(1)
+}
+
+func (m *MockUserRepository) SuggestUsers(ctx context.Context, query string, limit int) ([]domain.UserSuggestion, error) {
+	args := m.Called(ctx, query, limit)
+	suggestions, _ := args.Get(0).([]domain.UserSuggestion)
+	return suggestions, args.Error(1)
+}
+
+func (m *MockUserRepository) RemoveUserAccount(ctx context.Context, userID, accountID string) error {
+	args := m.Called(ctx, userID, accountID)
+	return args.Error(0)
+}
+
 func (m *MockUserRepository) WithTx(ctx context.Context, fn func(domain.TxUserRepository) error) error {
 	args := m.Called(ctx, fn)
 	return args.Error(0)
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/user-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+	userpb "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+)
+
+func TestSuggestUsers_NormalizesQueryAndLimit(t *testing.T) {
+	repo := new(MockUserRepository)
+	svc := service.NewUserService(repo)
+	ctx := context.Background()
+
+	repo.On("SuggestUsers", ctx, "ali ce", 8).Return([]domain.UserSuggestion{{UserID: "u-1", Username: "alice", Score: 1.5}}, nil)
+	repo.On("SuggestUsers", ctx, strings.Repeat("b", 64), 20).Return([]domain.UserSuggestion{}, nil)
+
+	suggestions, err := svc.SuggestUsers(ctx, "  ALI   Ce ", 0)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, "alice", suggestions[0].Username)
+
+	_, err = svc.SuggestUsers(ctx, strings.Repeat("B", 100), 100)
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestSuggestUsers_SkipsShortQueries(t *testing.T) {
+	repo := new(MockUserRepository)
+	svc := service.NewUserService(repo)
+
+	suggestions, err := svc.SuggestUsers(context.Background(), " a ", 5)
+	require.NoError(t, err)
+	assert.Empty(t, suggestions)
+	repo.AssertNotCalled(t, "SuggestUsers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGRPC_SuggestUsers(t *testing.T) {
+	svc := new(MockUserService)
+	handler := grpcHandler.NewgRPCHandler(svc)
+	ctx := context.Background()
+
+	svc.On("SuggestUsers", ctx, "ali", 5).Return([]domain.UserSuggestion{
+		{UserID: "u-1", Username: "alice", DisplayName: "Alice", AvatarURL: "https://cdn.example/a.png", Score: 1.8},
+	}, nil)
+
+	resp, err := handler.SuggestUsers(ctx, &userpb.SuggestUsersRequest{Query: "ali", Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 1)
+	assert.Equal(t, "u-1", resp.Users[0].UserId)
+	assert.Equal(t, "https://cdn.example/a.png", resp.Users[0].AvatarUrl)
+	assert.Equal(t, 1.8, resp.Users[0].Score)
+}
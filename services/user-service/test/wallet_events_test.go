@@ -0,0 +1,48 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/quangdang46/NFT-Marketplace/services/user-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+func TestHandleWalletUnlinked_RemovesAccountMapping(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockUserRepository)
+	repo.On("RemoveUserAccount", ctx, "user-123", "account-456").Return(nil)
+	svc := service.NewUserService(repo).(*service.Service)
+
+	err := svc.HandleWalletUnlinked(ctx, &contracts.WalletUnlinkedEvent{
+		UserID:    "user-123",
+		AccountID: "account-456",
+		Address:   "0x1234567890123456789012345678901234567890",
+	})
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestHandleWalletUnlinked_DropsIncompleteEvents(t *testing.T) {
+	repo := new(MockUserRepository)
+	svc := service.NewUserService(repo).(*service.Service)
+
+	assert.NoError(t, svc.HandleWalletUnlinked(context.Background(), &contracts.WalletUnlinkedEvent{UserID: "user-123"}))
+	repo.AssertNotCalled(t, "RemoveUserAccount", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandleWalletUnlinked_RetriesOnDatabaseError(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockUserRepository)
+	repo.On("RemoveUserAccount", ctx, "user-123", "account-456").Return(errors.New("connection reset"))
+	svc := service.NewUserService(repo).(*service.Service)
+
+	err := svc.HandleWalletUnlinked(ctx, &contracts.WalletUnlinkedEvent{UserID: "user-123", AccountID: "account-456"})
+
+	assert.Error(t, err, "the delivery must be nacked so it is redelivered")
+}
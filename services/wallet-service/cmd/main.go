@@ -3,21 +3,24 @@ package main
 import (
 	"context"
 	"log"
-	"net"
-	"os"
-	"os/signal"
-	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/chain"
 	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/events"
 	grpcServer "github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/grpc"
 	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/screening"
 	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/grpcserver"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	chainregistrypb "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
 	"github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
 )
@@ -27,13 +30,19 @@ func main() {
 	cfg := config.LoadConfig()
 	cfg.Validate()
 
+	flushMonitoring, err := monitoring.Init(monitoring.LoadConfig("wallet-service"))
+	if err != nil {
+		log.Printf("Error reporting disabled: %v", err)
+	}
+	defer flushMonitoring()
+
 	log.Printf("Starting Wallet Service on %s", cfg.GRPCPort)
 
 	// Create context for graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	grpcSrv := grpc.NewServer()
+	grpcSrv := grpcserver.New(grpcserver.LoadConfig("wallet-service"))
 
 	postgresDB, err := postgres.NewPostgres(cfg.Postgres)
 	if err != nil {
@@ -66,28 +75,59 @@ func main() {
 
 	eventPublisher := events.NewEventPublisher(amqpClient)
 
-	walletGRPCServer := grpcServer.NewWalletGRPCServer(walletService, eventPublisher)
-	wallet.RegisterWalletServiceServer(grpcSrv, walletGRPCServer)
+	// Screen linked wallets, and wallets on their first sale, against the sanctions/AML provider
+	screeningProvider, err := screening.NewProvider(cfg.Screening)
+	if err != nil {
+		log.Fatalf("Invalid screening config: %v", err)
+	}
+	screeningService := service.NewScreeningService(
+		screeningProvider,
+		repository.NewScreeningRepository(postgresDB),
+		eventPublisher,
+		domain.ScreeningMode(cfg.Screening.Mode),
+	)
+	screeningService.SetCache(repository.NewScreeningCache(redisClient), cfg.Screening.CacheTTL)
+	if cfg.Screening.SalesQueue != "" {
+		if err := amqpClient.ConsumeSaleIndexed(cfg.Screening.SalesQueue, "wallet-service", screeningService.HandleSaleIndexed); err != nil {
+			log.Printf("Failed to start sales consumer, first sales are not screened: %v", err)
+		}
+	}
 
-	reflection.Register(grpcSrv)
+	// Track when wallets are seen and publish the daily active wallets once each UTC day ends
+	activityService := service.NewActivityService(repository.NewActivityRepository(postgresDB), eventPublisher)
+	go activityService.RunDailyReports(ctx, cfg.ActivityReportInterval)
 
-	// Start gRPC server in a goroutine
-	go func() {
-		lis, err := net.Listen("tcp", cfg.GRPCPort)
+	// Signature challenges prove control of an address for other services; contract wallets
+	// are checked with EIP-1271 over the chain registry's RPC endpoints
+	var contractSignatures domain.ContractSignatureVerifier
+	if cfg.ChainRegistryURL != "" {
+		registryConn, err := grpc.Dial(cfg.ChainRegistryURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		if err != nil {
-			log.Fatalf("Failed to listen: %v", err)
+			log.Fatalf("Failed to connect to chain-registry-service: %v", err)
 		}
-		log.Printf("Wallet service listening on %s", cfg.GRPCPort)
-		if err := grpcSrv.Serve(lis); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
-		}
-	}()
+		defer registryConn.Close()
+		contractSignatures = chain.NewContractSignatures(chainregistrypb.NewChainRegistryServiceClient(registryConn))
+	}
+	signatureService := service.NewSignatureService(repository.NewSignatureChallengeStore(redisClient), contractSignatures)
 
-	// Wait for interrupt signal to gracefully shutdown
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
-	<-c
+	walletGRPCServer := grpcServer.NewWalletGRPCServer(walletService, eventPublisher).
+		WithScreener(screeningService).
+		WithActivity(activityService).
+		WithSignatures(signatureService)
+	wallet.RegisterWalletServiceServer(grpcSrv, walletGRPCServer)
+
+	// Serve until SIGINT/SIGTERM, then shut down gracefully
+	log.Printf("Wallet service listening on %s", cfg.GRPCPort)
+	if err := grpcserver.ListenAndServe(grpcSrv, cfg.GRPCPort); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
 
 	log.Println("Shutting down Wallet Service...")
-	grpcSrv.GracefulStop()
+
+	// Finish screening the sales already received before the connection closes
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer drainCancel()
+	if err := amqpClient.Drain(drainCtx); err != nil {
+		log.Printf("Error draining consumers: %v", err)
+	}
 }
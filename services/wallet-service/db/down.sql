@@ -31,6 +31,8 @@ DROP INDEX IF EXISTS idx_wallets_last_seen_at;
 DROP INDEX IF EXISTS idx_wallets_user_id;
 
 -- 4) Tables (reverse order)
+DROP TABLE IF EXISTS wallet_activity_reports;
+DROP TABLE IF EXISTS wallet_activity_days;
 DROP TABLE IF EXISTS approvals_history;
 DROP TABLE IF EXISTS approvals;
 DROP TABLE IF EXISTS wallets;
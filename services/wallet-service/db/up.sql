@@ -9,6 +9,9 @@ CREATE TABLE IF NOT EXISTS wallets (
     connector VARCHAR(100) NOT NULL DEFAULT 'unknown', -- "metamask", "walletconnect", etc.
     is_primary BOOLEAN NOT NULL DEFAULT FALSE,
     label VARCHAR(255),
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    -- Watch-only wallets are tracked without a signature and have no account
+    is_watch_only BOOLEAN NOT NULL DEFAULT FALSE,
     verified_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
@@ -16,7 +19,8 @@ CREATE TABLE IF NOT EXISTS wallets (
     
     -- Constraints
     CONSTRAINT wallets_address_check CHECK (address ~ '^0x[a-fA-F0-9]{40}$'),
-    CONSTRAINT wallets_chain_id_check CHECK (chain_id ~ '^[a-zA-Z0-9]+:[0-9]+$')
+    CONSTRAINT wallets_chain_id_check CHECK (chain_id ~ '^[a-zA-Z0-9]+:[0-9]+$'),
+    CONSTRAINT wallets_watch_only_check CHECK (NOT is_watch_only OR (NOT is_primary AND verified_at IS NULL))
 );
 
 -- Create unique constraint to prevent duplicate wallet links
@@ -29,6 +33,25 @@ CREATE INDEX IF NOT EXISTS idx_wallets_address ON wallets (address);
 CREATE INDEX IF NOT EXISTS idx_wallets_chain_id ON wallets (chain_id);
 CREATE INDEX IF NOT EXISTS idx_wallets_is_primary ON wallets (user_id, is_primary) WHERE is_primary = TRUE;
 
+-- Set once a wallet's first sale was screened against the sanctions/AML provider
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS sale_screened_at TIMESTAMP WITH TIME ZONE;
+
+-- One row per wallet and UTC day it signed in or had a gateway session, for the
+-- wallet.daily_active aggregate. linked_on is kept so deleted wallets still count.
+CREATE TABLE IF NOT EXISTS wallet_activity_days (
+    day DATE NOT NULL,
+    wallet_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    linked_on DATE NOT NULL,
+    PRIMARY KEY (day, wallet_id)
+);
+
+-- Days whose aggregate was published
+CREATE TABLE IF NOT EXISTS wallet_activity_reports (
+    day DATE PRIMARY KEY,
+    reported_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
 -- Create approvals table
 CREATE TABLE IF NOT EXISTS approvals (
     wallet_id UUID NOT NULL REFERENCES wallets(id) ON DELETE CASCADE,
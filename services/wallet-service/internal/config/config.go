@@ -2,19 +2,41 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/shared/env"
 	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 // Config contains configuration for Wallet Service
 type Config struct {
-	GRPCPort string
-	Postgres postgres.PostgresConfig
-	Redis    redis.RedisConfig
-	RabbitMQ messaging.RabbitMQConfig
+	GRPCPort  string
+	Postgres  postgres.PostgresConfig
+	Redis     redis.RedisConfig
+	RabbitMQ  messaging.RabbitMQConfig
+	Screening ScreeningConfig
+	// How often finished days are checked for an unpublished wallet.daily_active report
+	ActivityReportInterval time.Duration
+	// Chain registry whose RPC endpoints EIP-1271 signature checks of contract wallets use;
+	// empty accepts signatures of EOAs only
+	ChainRegistryURL string
+}
+
+// ScreeningConfig selects the sanctions/AML screening provider wallets are checked with
+type ScreeningConfig struct {
+	Provider string // none, chainalysis or trm
+	Mode     string // log_only or block
+	CacheTTL time.Duration
+	// Queue sales are screened from; empty skips first-sale screening
+	SalesQueue string
+
+	ChainalysisBaseURL string
+	ChainalysisAPIKey  string
+	TRMBaseURL         string
+	TRMAPIKey          string
 }
 
 // LoadConfig loads configuration from environment variables
@@ -26,6 +48,18 @@ func LoadConfig() *Config {
 		Postgres: loadPostgresConfig(),
 		Redis:    loadRedisConfig(),
 		RabbitMQ: loadRabbitMQConfig(),
+		Screening: ScreeningConfig{
+			Provider:           env.GetString("SCREENING_PROVIDER", "none"),
+			Mode:               env.GetString("SCREENING_MODE", "log_only"),
+			CacheTTL:           time.Duration(env.GetInt("SCREENING_CACHE_TTL_MINUTES", 1440)) * time.Minute,
+			SalesQueue:         env.GetString("SCREENING_SALES_QUEUE", "wallet.sales.indexed"),
+			ChainalysisBaseURL: env.GetString("CHAINALYSIS_BASE_URL", "https://public.chainalysis.com"),
+			ChainalysisAPIKey:  env.GetString("CHAINALYSIS_API_KEY", ""),
+			TRMBaseURL:         env.GetString("TRM_BASE_URL", "https://api.trmlabs.com"),
+			TRMAPIKey:          env.GetString("TRM_API_KEY", ""),
+		},
+		ActivityReportInterval: time.Duration(env.GetInt("ACTIVITY_REPORT_INTERVAL_MINUTES", 60)) * time.Minute,
+		ChainRegistryURL:       env.GetString("CHAIN_REGISTRY_URL", "localhost:50056"),
 	}
 
 	log.Printf("Wallet Service config loaded - gRPC: %s",
@@ -42,6 +76,7 @@ func loadPostgresConfig() postgres.PostgresConfig {
 		PostgresUser:     env.GetString("POSTGRES_USER", "postgres"),
 		PostgresPassword: env.GetString("POSTGRES_PASSWORD", "password"),
 		PostgresDatabase: env.GetString("POSTGRES_DATABASE", "nft_marketplace"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
 
@@ -50,6 +85,7 @@ func loadRedisConfig() redis.RedisConfig {
 	return redis.RedisConfig{
 		RedisHost: env.GetString("REDIS_HOST", "localhost"),
 		RedisPort: env.GetInt("REDIS_PORT", 6379),
+		Tenant:    tenant.FromEnv(),
 	}
 }
 
@@ -60,6 +96,8 @@ func loadRabbitMQConfig() messaging.RabbitMQConfig {
 		RabbitMQPort:     env.GetInt("RABBITMQ_PORT", 5672),
 		RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
 		RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
+		RabbitMQExchange: env.GetString("RABBITMQ_EXCHANGE", "nft-marketplace"),
+		Tenant:           tenant.FromEnv(),
 	}
 }
 
@@ -77,6 +115,12 @@ func (c *Config) Validate() error {
 	if c.RabbitMQ.RabbitMQHost == "" {
 		log.Fatal("AMQP_HOST is required")
 	}
+	if c.Screening.Mode != "log_only" && c.Screening.Mode != "block" {
+		log.Fatal("SCREENING_MODE must be log_only or block")
+	}
+	if c.ActivityReportInterval <= 0 {
+		log.Fatal("ACTIVITY_REPORT_INTERVAL_MINUTES must be positive")
+	}
 
 	log.Println("Wallet Service configuration validation passed")
 	return nil
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// ActivityRepository records when signed wallets are seen and rolls them up per UTC day
+type ActivityRepository interface {
+	// TouchWallets sets last_seen_at of the user's signed wallets, or only walletID when it
+	// is not empty, and marks them active on at's UTC day. It returns how many were touched.
+	TouchWallets(ctx context.Context, userID UserID, walletID WalletID, at time.Time) (int, error)
+	// ListUnreportedDays returns the days with activity before the given day whose
+	// aggregate has not been published, oldest first
+	ListUnreportedDays(ctx context.Context, before time.Time) ([]time.Time, error)
+	DailyActive(ctx context.Context, day time.Time) (*WalletDailyActiveEvent, error)
+	MarkDayReported(ctx context.Context, day time.Time) error
+}
+
+// WalletActivityRecorder marks wallets as seen when they sign in
+type WalletActivityRecorder interface {
+	RecordSeen(ctx context.Context, userID UserID, walletID WalletID) (int, error)
+}
+
+type WalletDailyActiveEvent = contracts.WalletDailyActiveEvent
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
 )
 
 // Type aliases for better readability
@@ -14,30 +16,67 @@ type Address = string
 type ChainID = string // CAIP-2 format
 
 type WalletLink struct {
-	ID         WalletID
-	UserID     UserID
-	AccountID  AccountID
-	Address    Address
-	ChainID    ChainID
-	IsPrimary  bool
-	VerifiedAt *time.Time
+	ID        WalletID
+	UserID    UserID
+	AccountID AccountID
+	Address   Address
+	ChainID   ChainID
+	IsPrimary bool
+	Label     string
+	Tags      []string
+	// IsWatchOnly marks an address added without a signature for portfolio tracking.
+	// It has no account, is never primary and must not be treated as owned by the user.
+	IsWatchOnly bool
+	VerifiedAt  *time.Time
+	// LastSeenAt is the last SIWE login or gateway session with the wallet
+	LastSeenAt *time.Time
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
 
+// Limits on the user-defined label and tags of a wallet
+const (
+	MaxWalletLabelLength = 64
+	MaxWalletTags        = 10
+	MaxWalletTagLength   = 32
+)
+
+// WalletDetailsUpdate changes a wallet's label and tags; a nil field is left unchanged
+// and an empty, non-nil Tags clears them
+type WalletDetailsUpdate struct {
+	Label *string
+	Tags  []string
+}
+
 type WalletUpsertResult struct {
 	Link           *WalletLink
 	Created        bool
 	PrimaryChanged bool
 }
 
+// PrimaryChangeResult is the outcome of SetPrimaryWallet; Previous is the wallet that lost
+// primary, nil when the chain had none or nothing changed
+type PrimaryChangeResult struct {
+	Link     *WalletLink
+	Previous *WalletLink
+	Changed  bool
+}
+
 type WalletService interface {
 	UpsertLink(ctx context.Context, link WalletLink) (*WalletUpsertResult, error)
+	ListLinks(ctx context.Context, userID UserID) ([]*WalletLink, error)
+	RemoveWallet(ctx context.Context, userID UserID, walletID WalletID) (*WalletLink, error)
+	SetPrimaryWallet(ctx context.Context, userID UserID, walletID WalletID) (*PrimaryChangeResult, error)
+	// AddWatchOnlyWallet tracks an address without a signature; linking it later with a
+	// signature turns it into a regular wallet
+	AddWatchOnlyWallet(ctx context.Context, link WalletLink) (*WalletLink, error)
+	UpdateWalletDetails(ctx context.Context, userID UserID, walletID WalletID, update WalletDetailsUpdate) (*WalletLink, error)
 }
 
 // WalletRepository defines the data persistence interface
 type WalletRepository interface {
 	WithTx(ctx context.Context, fn func(TxWalletRepository) error) error
+	ListByUser(ctx context.Context, userID UserID) ([]*WalletLink, error)
 }
 
 type TxWalletRepository interface {
@@ -46,11 +85,15 @@ type TxWalletRepository interface {
 	AcquireAddressLock(ctx context.Context, chainID, address string) error
 
 	// Truy vấn tồn tại
+	GetByIDTx(ctx context.Context, id WalletID) (*WalletLink, error)                  // ErrWalletNotFound nếu không có
 	GetByAccountIDTx(ctx context.Context, accountID string) (*WalletLink, error)      // ErrWalletNotFound nếu không có
-	GetByAddressTx(ctx context.Context, chainID, address string) (*WalletLink, error) // ErrWalletNotFound nếu không có
+	GetByAddressTx(ctx context.Context, chainID, address string) (*WalletLink, error) // ErrWalletNotFound nếu không có; bỏ qua ví watch-only
 
 	// Ghi/Update
+	// InsertWalletTx turns the user's watch-only row for the address into the signed link;
+	// any other existing row for the user and address is ErrWalletAlreadyExists
 	InsertWalletTx(ctx context.Context, link WalletLink) (*WalletLink, error)
+	UpdateWalletDetailsTx(ctx context.Context, id WalletID, label *string, tags []string) (*WalletLink, error)
 	UpdateWalletMetaTx(ctx context.Context, id WalletID, isPrimary *bool, verifiedAt *time.Time, lastSeen *time.Time, label *string) (*WalletLink, error)
 
 	// Primary logic
@@ -59,10 +102,17 @@ type TxWalletRepository interface {
 	GetPrimaryByUserChainTx(ctx context.Context, userID UserID, chainID ChainID) (*WalletLink, error)
 	DemoteOtherPrimariesTx(ctx context.Context, userID UserID, chainID ChainID, keepID WalletID) error
 	UpdateWalletAddressTx(ctx context.Context, id WalletID, chainID ChainID, address Address) (*WalletLink, error)
+
+	// Xóa
+	DeleteWalletTx(ctx context.Context, id WalletID) error
 }
 
 type EventPublisher interface {
 	PublishWalletLinked(ctx context.Context, event *WalletLinkedEvent) error
+	PublishPrimaryChanged(ctx context.Context, event *WalletLinkedEvent) error
+	PublishWalletUnlinked(ctx context.Context, event *WalletUnlinkedEvent) error
+	PublishScreeningFlagged(ctx context.Context, event *WalletScreeningFlaggedEvent) error
+	PublishDailyActive(ctx context.Context, event *WalletDailyActiveEvent) error
 }
 
 type WalletLinkedEvent struct {
@@ -73,8 +123,13 @@ type WalletLinkedEvent struct {
 	ChainID   ChainID   `json:"chain_id"`
 	IsPrimary bool      `json:"is_primary"`
 	LinkedAt  time.Time `json:"linked_at"`
+	// Set on wallet.primary_changed when the chain had another primary wallet
+	PreviousWalletID WalletID `json:"previous_wallet_id,omitempty"`
+	PreviousAddress  Address  `json:"previous_address,omitempty"`
 }
 
+type WalletUnlinkedEvent = contracts.WalletUnlinkedEvent
+
 // Error definitions
 var (
 	ErrWalletNotFound      = errors.New("wallet_not_found")
@@ -85,4 +140,7 @@ var (
 	ErrCannotRemovePrimary = errors.New("cannot_remove_primary_wallet")
 	ErrApprovalNotFound    = errors.New("approval_not_found")
 	ErrInvalidStandard     = errors.New("invalid_token_standard")
+	ErrWatchOnlyWallet     = errors.New("watch_only_wallet")
+	ErrInvalidWalletLabel  = errors.New("invalid_wallet_label")
+	ErrInvalidWalletTags   = errors.New("invalid_wallet_tags")
 )
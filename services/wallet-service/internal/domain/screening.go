@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// What a screening was run for
+const (
+	ScreeningTriggerWalletLink = "wallet_link"
+	ScreeningTriggerFirstSale  = "first_sale"
+)
+
+// ScreeningMode is what a flagged screening does. Both modes publish
+// wallet.screening_flagged; only block refuses the wallet link. A first sale has settled
+// on-chain by the time it is screened, so it is never blocked.
+type ScreeningMode string
+
+const (
+	ScreeningModeLogOnly ScreeningMode = "log_only"
+	ScreeningModeBlock   ScreeningMode = "block"
+)
+
+// DefaultScreeningCacheTTL is how long a screening result is reused
+const DefaultScreeningCacheTTL = 24 * time.Hour
+
+// ScreeningResult is a provider's verdict on an address
+type ScreeningResult struct {
+	ChainID    ChainID
+	Address    Address
+	Provider   string
+	Risk       string // low, medium, high or severe
+	Flagged    bool
+	Categories []string
+	ScreenedAt time.Time
+}
+
+// ScreeningProvider screens an address against a sanctions/AML provider
+type ScreeningProvider interface {
+	Name() string
+	Screen(ctx context.Context, chainID ChainID, address Address) (*ScreeningResult, error)
+}
+
+// ScreeningCache keeps screening results for a TTL
+type ScreeningCache interface {
+	// GetScreening returns nil without an error on a miss
+	GetScreening(ctx context.Context, provider string, chainID ChainID, address Address) (*ScreeningResult, error)
+	PutScreening(ctx context.Context, result *ScreeningResult, ttl time.Duration) error
+}
+
+// ScreeningRepository finds the wallets a sale screens and remembers which were screened
+type ScreeningRepository interface {
+	// ListUnscreenedSellers returns the non-watch-only wallets of an address, across users,
+	// that have no screened sale yet
+	ListUnscreenedSellers(ctx context.Context, chainID ChainID, address Address) ([]*WalletLink, error)
+	// MarkSaleScreened records the wallet's first screened sale and returns false when one
+	// was recorded before
+	MarkSaleScreened(ctx context.Context, walletID WalletID, at time.Time) (bool, error)
+}
+
+// WalletScreener screens wallets before they are linked
+type WalletScreener interface {
+	// ScreenWalletLink returns ErrWalletScreeningBlocked when the address is flagged and
+	// screening blocks
+	ScreenWalletLink(ctx context.Context, link WalletLink) error
+}
+
+type WalletScreeningFlaggedEvent = contracts.WalletScreeningFlaggedEvent
+
+var ErrWalletScreeningBlocked = errors.New("wallet_screening_blocked")
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// SignatureScheme is how a challenge is signed
+type SignatureScheme string
+
+const (
+	SignatureSchemeEIP191 SignatureScheme = "eip191" // personal_sign
+	SignatureSchemeEIP712 SignatureScheme = "eip712" // eth_signTypedData_v4
+)
+
+// How a signature was found valid
+const (
+	SignatureMethodEOA     = "eoa"
+	SignatureMethodEIP1271 = "eip1271"
+)
+
+// Bounds of a challenge's lifetime
+const (
+	DefaultSignatureChallengeTTL = 10 * time.Minute
+	MaxSignatureChallengeTTL     = time.Hour
+)
+
+// SignatureChallenge is a single-use message that proves control of Address once signed.
+// Purpose scopes it, so a signature collected for one feature is not accepted by another.
+type SignatureChallenge struct {
+	ID        string          `json:"id"`
+	Purpose   string          `json:"purpose"`
+	Address   Address         `json:"address"`
+	ChainID   ChainID         `json:"chain_id"`
+	UserID    UserID          `json:"user_id,omitempty"`
+	Resource  string          `json:"resource,omitempty"`
+	Scheme    SignatureScheme `json:"scheme"`
+	Message   string          `json:"message"` // text for EIP-191, typed data JSON for EIP-712
+	IssuedAt  time.Time       `json:"issued_at"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+// SignatureChallengeRequest asks for a challenge; Message, when set, is signed as is
+type SignatureChallengeRequest struct {
+	Purpose   string
+	Address   Address
+	ChainID   ChainID
+	UserID    UserID
+	Resource  string
+	Statement string
+	Scheme    SignatureScheme
+	TTL       time.Duration
+	Message   string
+}
+
+// SignatureProof is a verified challenge
+type SignatureProof struct {
+	Challenge *SignatureChallenge
+	Method    string // SignatureMethodEOA or SignatureMethodEIP1271
+}
+
+// SignatureChallengeStore keeps challenges until they expire or are used
+type SignatureChallengeStore interface {
+	SaveChallenge(ctx context.Context, challenge *SignatureChallenge) error
+	// TakeChallenge removes the challenge and returns it, or ErrChallengeNotFound when it
+	// expired or was taken before
+	TakeChallenge(ctx context.Context, id string) (*SignatureChallenge, error)
+}
+
+// ContractSignatureVerifier asks a contract wallet whether it signed digest (EIP-1271)
+type ContractSignatureVerifier interface {
+	IsValidSignature(ctx context.Context, chainID ChainID, contract Address, digest [32]byte, signature []byte) (bool, error)
+}
+
+// SignatureChallenger issues and verifies signature challenges
+type SignatureChallenger interface {
+	CreateChallenge(ctx context.Context, req SignatureChallengeRequest) (*SignatureChallenge, error)
+	// VerifyChallenge uses the challenge up, whether or not the signature is valid
+	VerifyChallenge(ctx context.Context, id, signature, purpose string, userID UserID) (*SignatureProof, error)
+}
+
+var (
+	ErrInvalidChallenge       = errors.New("invalid_signature_challenge")
+	ErrChallengeNotFound      = errors.New("signature_challenge_not_found")
+	ErrChallengeMismatch      = errors.New("signature_challenge_mismatch")
+	ErrInvalidSignature       = errors.New("invalid_signature")
+	ErrContractSignatureCheck = errors.New("contract_signature_check_failed")
+)
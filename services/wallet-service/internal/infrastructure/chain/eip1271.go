@@ -0,0 +1,73 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/chainrpc"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+const eip1271ABI = `[
+	{"type":"function","name":"isValidSignature","stateMutability":"view",
+	 "inputs":[{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}],
+	 "outputs":[{"type":"bytes4"}]}
+]`
+
+// eip1271MagicValue is what isValidSignature returns for a valid signature
+var eip1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+// ContractSignatures checks contract wallet signatures with EIP-1271 over the RPC endpoints
+// the chain registry lists
+type ContractSignatures struct {
+	rpc *chainrpc.Clients
+	abi abi.ABI
+}
+
+func NewContractSignatures(registry protoChainRegistry.ChainRegistryServiceClient) domain.ContractSignatureVerifier {
+	parsed, err := abi.JSON(strings.NewReader(eip1271ABI))
+	if err != nil {
+		panic(fmt.Sprintf("invalid eip1271 abi: %v", err))
+	}
+	return &ContractSignatures{rpc: chainrpc.NewClients(registry), abi: parsed}
+}
+
+// IsValidSignature calls isValidSignature at the chain head. An address without code, or a
+// contract that reverts or answers anything but the magic value, did not sign.
+func (c *ContractSignatures) IsValidSignature(ctx context.Context, chainID domain.ChainID, contract domain.Address, digest [32]byte, signature []byte) (bool, error) {
+	client, err := c.rpc.Client(ctx, chainID)
+	if err != nil {
+		return false, err
+	}
+	data, err := c.abi.Pack("isValidSignature", digest, signature)
+	if err != nil {
+		return false, fmt.Errorf("pack isValidSignature: %w", err)
+	}
+
+	to := common.HexToAddress(contract)
+	result, err := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		if reverted(err) {
+			return false, nil
+		}
+		c.rpc.Drop(chainID, client)
+		return false, fmt.Errorf("call isValidSignature: %w", err)
+	}
+	// The magic value is left-aligned in the returned word
+	return len(result) >= len(eip1271MagicValue) && bytes.Equal(result[:len(eip1271MagicValue)], eip1271MagicValue[:]), nil
+}
+
+// reverted reports whether a call failed inside the contract rather than reaching it
+func reverted(err error) bool {
+	var dataErr rpc.DataError
+	return errors.As(err, &dataErr) || strings.Contains(err.Error(), "execution reverted")
+}
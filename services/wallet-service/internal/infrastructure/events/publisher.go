@@ -22,41 +22,97 @@ func NewEventPublisher(amqp contracts.AMQPClient) *EventPublisher {
 
 // PublishWalletLinked publishes a wallet linked event
 func (p *EventPublisher) PublishWalletLinked(ctx context.Context, event *domain.WalletLinkedEvent) error {
+	return p.publishLinkEvent(ctx, event, "wallet linked", contracts.WalletLinkedKey, "wallet.linked.v1")
+}
+
+// PublishPrimaryChanged publishes a wallet.primary_changed event when an existing link
+// becomes the user's primary wallet
+func (p *EventPublisher) PublishPrimaryChanged(ctx context.Context, event *domain.WalletLinkedEvent) error {
+	return p.publishLinkEvent(ctx, event, "primary changed", contracts.WalletPrimaryChangedKey, "wallet.primary_changed.v1")
+}
+
+func (p *EventPublisher) publishLinkEvent(ctx context.Context, event *domain.WalletLinkedEvent, name, routingKey, schema string) error {
 	// Skip publishing if AMQP is not available
 	if p.amqp == nil {
-		fmt.Printf("AMQP not available, skipping wallet linked event: %+v\n", event)
+		fmt.Printf("AMQP not available, skipping %s event: %+v\n", name, event)
 		return nil
 	}
 
 	// Create the message payload
 	payload := domain.WalletLinkedEvent{
-		UserID:    event.UserID,
-		AccountID: event.AccountID,
-		WalletID:  event.WalletID,
-		Address:   event.Address,
-		ChainID:   event.ChainID,
-		IsPrimary: event.IsPrimary,
-		LinkedAt:  event.LinkedAt,
+		UserID:           event.UserID,
+		AccountID:        event.AccountID,
+		WalletID:         event.WalletID,
+		Address:          event.Address,
+		ChainID:          event.ChainID,
+		IsPrimary:        event.IsPrimary,
+		LinkedAt:         event.LinkedAt,
+		PreviousWalletID: event.PreviousWalletID,
+		PreviousAddress:  event.PreviousAddress,
 	}
 
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to marshal wallet linked event: %w", err)
+		return fmt.Errorf("failed to marshal %s event: %w", name, err)
 	}
+	return p.publish(ctx, body, name, routingKey, schema)
+}
+
+// PublishWalletUnlinked publishes a wallet.unlinked event when a user removes a wallet
+func (p *EventPublisher) PublishWalletUnlinked(ctx context.Context, event *domain.WalletUnlinkedEvent) error {
+	if p.amqp == nil {
+		fmt.Printf("AMQP not available, skipping wallet unlinked event: %+v\n", event)
+		return nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal wallet unlinked event: %w", err)
+	}
+	return p.publish(ctx, body, "wallet unlinked", contracts.WalletUnlinkedKey, "wallet.unlinked.v1")
+}
+
+// PublishScreeningFlagged publishes a wallet.screening_flagged event for the moderation queue
+func (p *EventPublisher) PublishScreeningFlagged(ctx context.Context, event *domain.WalletScreeningFlaggedEvent) error {
+	if p.amqp == nil {
+		fmt.Printf("AMQP not available, skipping screening flagged event: %+v\n", event)
+		return nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal screening flagged event: %w", err)
+	}
+	return p.publish(ctx, body, "screening flagged", contracts.WalletScreeningFlaggedKey, "wallet.screening_flagged.v1")
+}
+
+// PublishDailyActive publishes a wallet.daily_active event with a finished day's active wallets
+func (p *EventPublisher) PublishDailyActive(ctx context.Context, event *domain.WalletDailyActiveEvent) error {
+	if p.amqp == nil {
+		fmt.Printf("AMQP not available, skipping daily active event: %+v\n", event)
+		return nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal daily active event: %w", err)
+	}
+	return p.publish(ctx, body, "daily active", contracts.WalletDailyActiveKey, "wallet.daily_active.v1")
+}
 
-	// Publish to wallets.events exchange with routing key "wallet.linked"
+func (p *EventPublisher) publish(ctx context.Context, body []byte, name, routingKey, schema string) error {
 	if err := p.amqp.Publish(ctx, contracts.AMQPMessage{
 		Exchange:   contracts.WalletsExchange,
-		RoutingKey: contracts.WalletLinkedKey,
+		RoutingKey: routingKey,
 		Body:       body,
 		Headers: map[string]interface{}{
-			"event_type":   "wallet.linked",
-			"schema":       "wallet.linked.v1",
+			"event_type":   routingKey,
+			"schema":       schema,
 			"published_at": time.Now().Format(time.RFC3339),
 			"service":      "wallet-service",
 		},
 	}); err != nil {
-		return fmt.Errorf("failed to publish wallet linked event: %w", err)
+		return fmt.Errorf("failed to publish %s event: %w", name, err)
 	}
 	return nil
 }
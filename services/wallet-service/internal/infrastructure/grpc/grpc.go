@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -17,6 +18,12 @@ type WalletGRPCServer struct {
 	wallet.UnimplementedWalletServiceServer
 	service   domain.WalletService
 	publisher domain.EventPublisher
+	// nil skips compliance screening of linked wallets
+	screener domain.WalletScreener
+	// nil leaves last_seen_at to UpsertLink and disables TouchWallets
+	activity domain.WalletActivityRecorder
+	// nil disables signature challenges
+	signatures domain.SignatureChallenger
 }
 
 func NewWalletGRPCServer(service domain.WalletService, publisher domain.EventPublisher) *WalletGRPCServer {
@@ -26,6 +33,24 @@ func NewWalletGRPCServer(service domain.WalletService, publisher domain.EventPub
 	}
 }
 
+// WithScreener screens wallets before UpsertLink links them
+func (s *WalletGRPCServer) WithScreener(screener domain.WalletScreener) *WalletGRPCServer {
+	s.screener = screener
+	return s
+}
+
+// WithActivity records wallets as seen on sign-in and through TouchWallets
+func (s *WalletGRPCServer) WithActivity(activity domain.WalletActivityRecorder) *WalletGRPCServer {
+	s.activity = activity
+	return s
+}
+
+// WithSignatures issues and verifies signature challenges
+func (s *WalletGRPCServer) WithSignatures(signatures domain.SignatureChallenger) *WalletGRPCServer {
+	s.signatures = signatures
+	return s
+}
+
 func (s *WalletGRPCServer) UpsertLink(ctx context.Context, req *wallet.UpsertLinkRequest) (*wallet.UpsertLinkResponse, error) {
 	// Validate request
 	if err := s.validateUpsertLinkRequest(req); err != nil {
@@ -35,13 +60,19 @@ func (s *WalletGRPCServer) UpsertLink(ctx context.Context, req *wallet.UpsertLin
 	// Convert gRPC request to domain model
 	domainLink := s.requestToDomain(req)
 
+	if s.screener != nil {
+		if err := s.screener.ScreenWalletLink(ctx, domainLink); err != nil {
+			return nil, mapDomainErrorToGRPC(err)
+		}
+	}
+
 	// Call service layer
 	result, err := s.service.UpsertLink(ctx, domainLink)
 	if err != nil {
 		return nil, mapDomainErrorToGRPC(err)
 	}
 
-	// Publish event if wallet was linked (created or primary changed)
+	// Publish wallet.linked for new links and wallet.primary_changed when an existing link becomes primary
 	if result.Created || result.PrimaryChanged {
 		event := &domain.WalletLinkedEvent{
 			UserID:    result.Link.UserID,
@@ -55,19 +86,236 @@ func (s *WalletGRPCServer) UpsertLink(ctx context.Context, req *wallet.UpsertLin
 
 		// Publish asynchronously - don't fail the response if publishing fails
 		go func() {
-			if publishErr := s.publisher.PublishWalletLinked(context.Background(), event); publishErr != nil {
+			publish, name := s.publisher.PublishWalletLinked, "wallet linked"
+			if !result.Created {
+				publish, name = s.publisher.PublishPrimaryChanged, "primary changed"
+			}
+			if publishErr := publish(context.Background(), event); publishErr != nil {
 				// Log error in production
-				fmt.Printf("Failed to publish wallet linked event: %v\n", publishErr)
+				fmt.Printf("Failed to publish %s event: %v\n", name, publishErr)
 			}
 		}()
 	}
 
+	// Every SIWE login upserts its link, so it counts towards the wallet's activity
+	if s.activity != nil {
+		if _, err := s.activity.RecordSeen(ctx, result.Link.UserID, result.Link.ID); err != nil {
+			fmt.Printf("Failed to record wallet activity: %v\n", err)
+		}
+	}
+
 	// Convert domain result to gRPC response
 	response := s.domainToResponse(result)
 
 	return response, nil
 }
 
+func (s *WalletGRPCServer) ListLinks(ctx context.Context, req *wallet.ListLinksRequest) (*wallet.ListLinksResponse, error) {
+	if req == nil || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	links, err := s.service.ListLinks(ctx, req.UserId)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	response := &wallet.ListLinksResponse{Links: make([]*wallet.WalletLink, 0, len(links))}
+	for _, link := range links {
+		response.Links = append(response.Links, s.domainLinkToProto(link))
+	}
+
+	return response, nil
+}
+
+func (s *WalletGRPCServer) RemoveWallet(ctx context.Context, req *wallet.RemoveWalletRequest) (*wallet.RemoveWalletResponse, error) {
+	if req == nil || req.UserId == "" || req.WalletId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and wallet_id are required")
+	}
+
+	removed, err := s.service.RemoveWallet(ctx, req.UserId, req.WalletId)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	// Watch-only wallets were never announced as linked
+	if !removed.IsWatchOnly {
+		event := &domain.WalletUnlinkedEvent{
+			UserID:     removed.UserID,
+			AccountID:  removed.AccountID,
+			WalletID:   removed.ID,
+			Address:    removed.Address,
+			ChainID:    removed.ChainID,
+			UnlinkedAt: time.Now(),
+		}
+		go func() {
+			if publishErr := s.publisher.PublishWalletUnlinked(context.Background(), event); publishErr != nil {
+				fmt.Printf("Failed to publish wallet unlinked event: %v\n", publishErr)
+			}
+		}()
+	}
+
+	return &wallet.RemoveWalletResponse{Link: s.domainLinkToProto(removed)}, nil
+}
+
+func (s *WalletGRPCServer) SetPrimaryWallet(ctx context.Context, req *wallet.SetPrimaryWalletRequest) (*wallet.SetPrimaryWalletResponse, error) {
+	if req == nil || req.UserId == "" || req.WalletId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and wallet_id are required")
+	}
+
+	result, err := s.service.SetPrimaryWallet(ctx, req.UserId, req.WalletId)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+
+	if result.Changed {
+		event := &domain.WalletLinkedEvent{
+			UserID:    result.Link.UserID,
+			AccountID: result.Link.AccountID,
+			WalletID:  result.Link.ID,
+			Address:   result.Link.Address,
+			ChainID:   result.Link.ChainID,
+			IsPrimary: true,
+			LinkedAt:  time.Now(),
+		}
+		if result.Previous != nil {
+			event.PreviousWalletID = result.Previous.ID
+			event.PreviousAddress = result.Previous.Address
+		}
+		go func() {
+			if publishErr := s.publisher.PublishPrimaryChanged(context.Background(), event); publishErr != nil {
+				fmt.Printf("Failed to publish primary changed event: %v\n", publishErr)
+			}
+		}()
+	}
+
+	return &wallet.SetPrimaryWalletResponse{
+		Link:           s.domainLinkToProto(result.Link),
+		PrimaryChanged: result.Changed,
+	}, nil
+}
+
+// AddWatchOnlyWallet publishes no event: the address is tracked, not linked
+func (s *WalletGRPCServer) AddWatchOnlyWallet(ctx context.Context, req *wallet.AddWatchOnlyWalletRequest) (*wallet.AddWatchOnlyWalletResponse, error) {
+	if req == nil || req.UserId == "" || req.Address == "" || req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id, address and chain_id are required")
+	}
+
+	link, err := s.service.AddWatchOnlyWallet(ctx, domain.WalletLink{
+		UserID:  req.UserId,
+		Address: req.Address,
+		ChainID: req.ChainId,
+		Label:   req.Label,
+		Tags:    req.Tags,
+	})
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	return &wallet.AddWatchOnlyWalletResponse{Link: s.domainLinkToProto(link)}, nil
+}
+
+func (s *WalletGRPCServer) UpdateWalletDetails(ctx context.Context, req *wallet.UpdateWalletDetailsRequest) (*wallet.UpdateWalletDetailsResponse, error) {
+	if req == nil || req.UserId == "" || req.WalletId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and wallet_id are required")
+	}
+
+	update := domain.WalletDetailsUpdate{Label: req.Label}
+	if req.ReplaceTags {
+		update.Tags = append([]string{}, req.Tags...)
+	}
+	link, err := s.service.UpdateWalletDetails(ctx, req.UserId, req.WalletId, update)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	return &wallet.UpdateWalletDetailsResponse{Link: s.domainLinkToProto(link)}, nil
+}
+
+// TouchWallets marks the user's signed wallets as seen during a gateway session
+func (s *WalletGRPCServer) TouchWallets(ctx context.Context, req *wallet.TouchWalletsRequest) (*wallet.TouchWalletsResponse, error) {
+	if req == nil || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if s.activity == nil {
+		return nil, status.Error(codes.Unimplemented, "wallet activity tracking is disabled")
+	}
+
+	touched, err := s.activity.RecordSeen(ctx, req.UserId, "")
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(err)
+	}
+	return &wallet.TouchWalletsResponse{Touched: int32(touched)}, nil
+}
+
+// CreateSignatureChallenge issues a challenge for the address to sign
+func (s *WalletGRPCServer) CreateSignatureChallenge(ctx context.Context, req *wallet.CreateSignatureChallengeRequest) (*wallet.CreateSignatureChallengeResponse, error) {
+	if req == nil || req.Purpose == "" || req.Address == "" || req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "purpose, address and chain_id are required")
+	}
+	if s.signatures == nil {
+		return nil, status.Error(codes.Unimplemented, "signature challenges are disabled")
+	}
+
+	scheme, ok := signatureSchemes[req.Scheme]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown signature scheme %s", req.Scheme)
+	}
+	challenge, err := s.signatures.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+		Purpose:   req.Purpose,
+		Address:   req.Address,
+		ChainID:   req.ChainId,
+		UserID:    req.UserId,
+		Resource:  req.Resource,
+		Statement: req.Statement,
+		Scheme:    scheme,
+		TTL:       time.Duration(req.TtlSeconds) * time.Second,
+		Message:   req.Message,
+	})
+	if err != nil {
+		return nil, mapSignatureErrorToGRPC(err)
+	}
+
+	response := &wallet.CreateSignatureChallengeResponse{
+		ChallengeId: challenge.ID,
+		Message:     challenge.Message,
+		Scheme:      wallet.SignatureScheme_SIGNATURE_SCHEME_EIP191,
+		IssuedAt:    timestamppb.New(challenge.IssuedAt),
+		ExpiresAt:   timestamppb.New(challenge.ExpiresAt),
+	}
+	if challenge.Scheme == domain.SignatureSchemeEIP712 {
+		response.Scheme = wallet.SignatureScheme_SIGNATURE_SCHEME_EIP712
+	}
+	return response, nil
+}
+
+// VerifySignatureChallenge checks a signed challenge and returns the address it proves
+func (s *WalletGRPCServer) VerifySignatureChallenge(ctx context.Context, req *wallet.VerifySignatureChallengeRequest) (*wallet.VerifySignatureChallengeResponse, error) {
+	if req == nil || req.ChallengeId == "" || req.Signature == "" || req.Purpose == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_id, signature and purpose are required")
+	}
+	if s.signatures == nil {
+		return nil, status.Error(codes.Unimplemented, "signature challenges are disabled")
+	}
+
+	proof, err := s.signatures.VerifyChallenge(ctx, req.ChallengeId, req.Signature, req.Purpose, req.UserId)
+	if err != nil {
+		return nil, mapSignatureErrorToGRPC(err)
+	}
+	return &wallet.VerifySignatureChallengeResponse{
+		Address:  proof.Challenge.Address,
+		ChainId:  proof.Challenge.ChainID,
+		UserId:   proof.Challenge.UserID,
+		Purpose:  proof.Challenge.Purpose,
+		Resource: proof.Challenge.Resource,
+		Method:   proof.Method,
+	}, nil
+}
+
+var signatureSchemes = map[wallet.SignatureScheme]domain.SignatureScheme{
+	wallet.SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED: domain.SignatureSchemeEIP191,
+	wallet.SignatureScheme_SIGNATURE_SCHEME_EIP191:      domain.SignatureSchemeEIP191,
+	wallet.SignatureScheme_SIGNATURE_SCHEME_EIP712:      domain.SignatureSchemeEIP712,
+}
+
 func (s *WalletGRPCServer) validateUpsertLinkRequest(req *wallet.UpsertLinkRequest) error {
 	if req == nil {
 		return fmt.Errorf("request cannot be nil")
@@ -104,19 +352,25 @@ func (s *WalletGRPCServer) requestToDomain(req *wallet.UpsertLinkRequest) domain
 
 func (s *WalletGRPCServer) domainLinkToProto(link *domain.WalletLink) *wallet.WalletLink {
 	protoLink := &wallet.WalletLink{
-		Id:        link.ID,
-		UserId:    link.UserID,
-		AccountId: link.AccountID,
-		Address:   link.Address,
-		ChainId:   link.ChainID,
-		IsPrimary: link.IsPrimary,
-		CreatedAt: timestamppb.New(link.CreatedAt),
-		UpdatedAt: timestamppb.New(link.UpdatedAt),
+		Id:          link.ID,
+		UserId:      link.UserID,
+		AccountId:   link.AccountID,
+		Address:     link.Address,
+		ChainId:     link.ChainID,
+		IsPrimary:   link.IsPrimary,
+		Label:       link.Label,
+		Tags:        link.Tags,
+		IsWatchOnly: link.IsWatchOnly,
+		CreatedAt:   timestamppb.New(link.CreatedAt),
+		UpdatedAt:   timestamppb.New(link.UpdatedAt),
 	}
 
 	if link.VerifiedAt != nil {
 		protoLink.VerifiedAt = timestamppb.New(*link.VerifiedAt)
 	}
+	if link.LastSeenAt != nil {
+		protoLink.LastSeenAt = timestamppb.New(*link.LastSeenAt)
+	}
 
 	return protoLink
 }
@@ -143,15 +397,30 @@ func mapDomainErrorToGRPC(err error) error {
 	switch err {
 	case domain.ErrWalletNotFound:
 		return status.Error(codes.NotFound, err.Error())
-	case domain.ErrUnauthorizedAccess:
+	case domain.ErrUnauthorizedAccess, domain.ErrWalletScreeningBlocked:
 		return status.Error(codes.PermissionDenied, err.Error())
-	case domain.ErrInvalidAddress, domain.ErrInvalidChainID:
+	case domain.ErrInvalidAddress, domain.ErrInvalidChainID, domain.ErrInvalidWalletLabel, domain.ErrInvalidWalletTags:
 		return status.Error(codes.InvalidArgument, err.Error())
 	case domain.ErrWalletAlreadyExists:
 		return status.Error(codes.AlreadyExists, err.Error())
-	case domain.ErrCannotRemovePrimary:
+	case domain.ErrCannotRemovePrimary, domain.ErrWatchOnlyWallet:
 		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Errorf(codes.Internal, "internal server error: %v", err)
 	}
 }
+
+// mapSignatureErrorToGRPC maps signature challenge errors, which may carry a reason
+func mapSignatureErrorToGRPC(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidChallenge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrChallengeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrChallengeMismatch), errors.Is(err, domain.ErrInvalidSignature):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrContractSignatureCheck):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return mapDomainErrorToGRPC(err)
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+)
+
+// dayLayout keys activity days by UTC date
+const dayLayout = "2006-01-02"
+
+// ActivityRepository keeps wallets' last_seen_at and their daily activity
+type ActivityRepository struct {
+	postgres *postgres.Postgres
+}
+
+func NewActivityRepository(pg *postgres.Postgres) domain.ActivityRepository {
+	return &ActivityRepository{postgres: pg}
+}
+
+func (r *ActivityRepository) TouchWallets(ctx context.Context, userID domain.UserID, walletID domain.WalletID, at time.Time) (int, error) {
+	// Watch-only wallets never sign in, so they are never seen
+	query := `
+		WITH touched AS (
+			UPDATE wallets SET last_seen_at = $3
+			WHERE user_id = $1 AND NOT is_watch_only AND ($2 = '' OR id::text = $2)
+			RETURNING id, user_id, created_at
+		), recorded AS (
+			INSERT INTO wallet_activity_days (day, wallet_id, user_id, linked_on)
+			SELECT $4::date, id, user_id, (created_at AT TIME ZONE 'UTC')::date FROM touched
+			ON CONFLICT (day, wallet_id) DO NOTHING
+			RETURNING 1
+		)
+		SELECT count(*) FROM touched`
+
+	var touched int
+	if err := r.postgres.GetClient().QueryRowContext(ctx, query,
+		userID, walletID, at, at.UTC().Format(dayLayout),
+	).Scan(&touched); err != nil {
+		return 0, fmt.Errorf("failed to touch wallets: %w", err)
+	}
+	return touched, nil
+}
+
+func (r *ActivityRepository) ListUnreportedDays(ctx context.Context, before time.Time) ([]time.Time, error) {
+	query := `
+		SELECT DISTINCT d.day
+		FROM wallet_activity_days d
+		LEFT JOIN wallet_activity_reports r ON r.day = d.day
+		WHERE r.day IS NULL AND d.day < $1::date
+		ORDER BY d.day`
+
+	rows, err := r.postgres.GetClient().QueryContext(ctx, query, before.UTC().Format(dayLayout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unreported activity days: %w", err)
+	}
+	defer rows.Close()
+
+	var days []time.Time
+	for rows.Next() {
+		var day time.Time
+		if err := rows.Scan(&day); err != nil {
+			return nil, fmt.Errorf("failed to scan activity day: %w", err)
+		}
+		days = append(days, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate activity days: %w", err)
+	}
+	return days, nil
+}
+
+func (r *ActivityRepository) DailyActive(ctx context.Context, day time.Time) (*domain.WalletDailyActiveEvent, error) {
+	query := `
+		SELECT count(*), count(DISTINCT user_id),
+		       count(*) FILTER (WHERE linked_on = day), count(*) FILTER (WHERE linked_on < day)
+		FROM wallet_activity_days
+		WHERE day = $1::date`
+
+	event := &domain.WalletDailyActiveEvent{Day: day.UTC().Format(dayLayout)}
+	if err := r.postgres.GetClient().QueryRowContext(ctx, query, event.Day).Scan(
+		&event.ActiveWallets, &event.ActiveUsers, &event.NewWallets, &event.ReturningWallets,
+	); err != nil {
+		return nil, fmt.Errorf("failed to aggregate daily active wallets: %w", err)
+	}
+	event.ComputedAt = time.Now().UTC()
+	return event, nil
+}
+
+func (r *ActivityRepository) MarkDayReported(ctx context.Context, day time.Time) error {
+	if _, err := r.postgres.GetClient().ExecContext(ctx,
+		`INSERT INTO wallet_activity_reports (day, reported_at) VALUES ($1::date, now()) ON CONFLICT (day) DO NOTHING`,
+		day.UTC().Format(dayLayout),
+	); err != nil {
+		return fmt.Errorf("failed to mark activity day reported: %w", err)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
@@ -22,6 +23,33 @@ type txRepo struct {
 	tx *sql.Tx
 }
 
+// walletColumns is the column list every wallet query selects, in scanWallet's order
+const walletColumns = `id, user_id, account_id, address, chain_id, is_primary,
+       COALESCE(label, ''), tags, is_watch_only, verified_at, created_at, updated_at, last_seen_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWallet(row rowScanner) (*domain.WalletLink, error) {
+	var link domain.WalletLink
+	var verifiedAt, lastSeenAt sql.NullTime
+	if err := row.Scan(
+		&link.ID, &link.UserID, &link.AccountID, &link.Address, &link.ChainID, &link.IsPrimary,
+		&link.Label, pq.Array(&link.Tags), &link.IsWatchOnly, &verifiedAt, &link.CreatedAt, &link.UpdatedAt,
+		&lastSeenAt,
+	); err != nil {
+		return nil, err
+	}
+	if verifiedAt.Valid {
+		link.VerifiedAt = &verifiedAt.Time
+	}
+	if lastSeenAt.Valid {
+		link.LastSeenAt = &lastSeenAt.Time
+	}
+	return &link, nil
+}
+
 func NewWalletRepository(pg *postgres.Postgres, rds *redis.Redis) domain.WalletRepository {
 	return &Repository{
 		postgres: pg,
@@ -57,6 +85,38 @@ func (r *Repository) WithTx(ctx context.Context, fn func(domain.TxWalletReposito
 	return tx.Commit()
 }
 
+func (r *Repository) ListByUser(ctx context.Context, userID domain.UserID) ([]*domain.WalletLink, error) {
+	if r.postgres == nil || r.postgres.GetClient() == nil {
+		return nil, fmt.Errorf("database operation unavailable: postgres client is nil")
+	}
+
+	query := `
+		SELECT ` + walletColumns + `
+		FROM wallets
+		WHERE user_id = $1
+		ORDER BY is_primary DESC, is_watch_only ASC, created_at ASC`
+
+	rows, err := r.postgres.GetClient().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets by user: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*domain.WalletLink
+	for rows.Next() {
+		link, err := scanWallet(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan wallet: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate wallets: %w", err)
+	}
+
+	return links, nil
+}
+
 func (r *txRepo) AcquireAccountLock(ctx context.Context, accountID string) error {
 	// Use advisory lock to prevent concurrent operations on same account
 	lockKey := fmt.Sprintf("account_%s", accountID)
@@ -81,64 +141,51 @@ func (r *txRepo) AcquireAddressLock(ctx context.Context, chainID, address string
 	return nil
 }
 
+func (r *txRepo) GetByIDTx(ctx context.Context, id domain.WalletID) (*domain.WalletLink, error) {
+	const q = `SELECT ` + walletColumns + `
+               FROM wallets WHERE id=$1 FOR UPDATE`
+	out, err := scanWallet(r.tx.QueryRowContext(ctx, q, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrWalletNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet by ID: %w", err)
+	}
+	return out, nil
+}
+
 func (r *txRepo) GetByAccountIDTx(ctx context.Context, accountID string) (*domain.WalletLink, error) {
 	query := `
-		SELECT id, user_id, account_id, address, chain_id, is_primary, 
-		       verified_at, created_at, updated_at
-		FROM wallets 
+		SELECT ` + walletColumns + `
+		FROM wallets
 		WHERE account_id = $1
 		LIMIT 1`
 
-	var link domain.WalletLink
-	var verifiedAt sql.NullTime
-
-	err := r.tx.QueryRowContext(ctx, query, accountID).Scan(
-		&link.ID, &link.UserID, &link.AccountID, &link.Address, &link.ChainID,
-		&link.IsPrimary, &verifiedAt, &link.CreatedAt, &link.UpdatedAt,
-	)
-
+	link, err := scanWallet(r.tx.QueryRowContext(ctx, query, accountID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrWalletNotFound
 		}
 		return nil, fmt.Errorf("failed to get wallet by account ID: %w", err)
 	}
-
-	if verifiedAt.Valid {
-		link.VerifiedAt = &verifiedAt.Time
-	}
-
-	return &link, nil
+	return link, nil
 }
 
 func (r *txRepo) GetByAddressTx(ctx context.Context, chainID, address string) (*domain.WalletLink, error) {
 	query := `
-		SELECT id, user_id, account_id, address, chain_id, is_primary, 
-		       verified_at, created_at, updated_at
-		FROM wallets 
-		WHERE chain_id = $1 AND address = $2
+		SELECT ` + walletColumns + `
+		FROM wallets
+		WHERE chain_id = $1 AND address = $2 AND NOT is_watch_only
 		LIMIT 1`
 
-	var link domain.WalletLink
-	var verifiedAt sql.NullTime
-
-	err := r.tx.QueryRowContext(ctx, query, chainID, address).Scan(
-		&link.ID, &link.UserID, &link.AccountID, &link.Address, &link.ChainID,
-		&link.IsPrimary, &verifiedAt, &link.CreatedAt, &link.UpdatedAt,
-	)
-
+	link, err := scanWallet(r.tx.QueryRowContext(ctx, query, chainID, address))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrWalletNotFound
 		}
 		return nil, fmt.Errorf("failed to get wallet by address: %w", err)
 	}
-
-	if verifiedAt.Valid {
-		link.VerifiedAt = &verifiedAt.Time
-	}
-
-	return &link, nil
+	return link, nil
 }
 
 func (r *txRepo) InsertWalletTx(ctx context.Context, link domain.WalletLink) (*domain.WalletLink, error) {
@@ -151,38 +198,40 @@ func (r *txRepo) InsertWalletTx(ctx context.Context, link domain.WalletLink) (*d
 	link.CreatedAt = now
 	link.UpdatedAt = now
 
-	// Set verified_at to now if this is being created
-	if link.VerifiedAt == nil {
+	// Set verified_at to now if this is being created; watch-only wallets are never verified
+	if link.IsWatchOnly {
+		link.VerifiedAt = nil
+	} else if link.VerifiedAt == nil {
 		link.VerifiedAt = &now
 	}
+	if link.Tags == nil {
+		link.Tags = []string{}
+	}
 
+	// A signed link replaces the user's watch-only row for the same address, keeping its
+	// id, label and tags
 	query := `
-		INSERT INTO wallets (id, user_id, account_id, address, chain_id, is_primary, 
-		                    verified_at, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING id, user_id, account_id, address, chain_id, is_primary, 
-		          verified_at, created_at, updated_at`
-
-	var result domain.WalletLink
-	var verifiedAt sql.NullTime
-
-	err := r.tx.QueryRowContext(ctx, query,
-		link.ID, link.UserID, link.AccountID, link.Address, link.ChainID,
-		link.IsPrimary, link.VerifiedAt, link.CreatedAt, link.UpdatedAt,
-	).Scan(
-		&result.ID, &result.UserID, &result.AccountID, &result.Address, &result.ChainID,
-		&result.IsPrimary, &verifiedAt, &result.CreatedAt, &result.UpdatedAt,
-	)
-
+		INSERT INTO wallets (id, user_id, account_id, address, chain_id, is_primary,
+		                    label, tags, is_watch_only, verified_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (user_id, address, chain_id) DO UPDATE
+		SET account_id = EXCLUDED.account_id, is_primary = EXCLUDED.is_primary,
+		    is_watch_only = FALSE, verified_at = EXCLUDED.verified_at, updated_at = EXCLUDED.updated_at
+		WHERE wallets.is_watch_only AND NOT EXCLUDED.is_watch_only
+		RETURNING ` + walletColumns
+
+	result, err := scanWallet(r.tx.QueryRowContext(ctx, query,
+		link.ID, link.UserID, link.AccountID, link.Address, link.ChainID, link.IsPrimary,
+		sql.NullString{String: link.Label, Valid: link.Label != ""}, pq.Array(link.Tags), link.IsWatchOnly,
+		link.VerifiedAt, link.CreatedAt, link.UpdatedAt,
+	))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrWalletAlreadyExists
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to insert wallet: %w", err)
 	}
-
-	if verifiedAt.Valid {
-		result.VerifiedAt = &verifiedAt.Time
-	}
-
-	return &result, nil
+	return result, nil
 }
 
 func (r *txRepo) UpdateWalletMetaTx(ctx context.Context, id domain.WalletID, isPrimary *bool, verifiedAt *time.Time, lastSeen *time.Time, label *string) (*domain.WalletLink, error) {
@@ -231,60 +280,59 @@ func (r *txRepo) UpdateWalletMetaTx(ctx context.Context, id domain.WalletID, isP
 		UPDATE wallets 
 		SET %s
 		WHERE id = $%d
-		RETURNING id, user_id, account_id, address, chain_id, is_primary, 
-		          verified_at, created_at, updated_at`,
+		RETURNING `+walletColumns,
 		setClause, argIndex)
 
-	var result domain.WalletLink
-	var verifiedAtResult sql.NullTime
-
-	err := r.tx.QueryRowContext(ctx, query, args...).Scan(
-		&result.ID, &result.UserID, &result.AccountID, &result.Address, &result.ChainID,
-		&result.IsPrimary, &verifiedAtResult, &result.CreatedAt, &result.UpdatedAt,
-	)
-
+	result, err := scanWallet(r.tx.QueryRowContext(ctx, query, args...))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrWalletNotFound
 		}
 		return nil, fmt.Errorf("failed to update wallet: %w", err)
 	}
+	return result, nil
+}
 
-	if verifiedAtResult.Valid {
-		result.VerifiedAt = &verifiedAtResult.Time
+func (r *txRepo) UpdateWalletDetailsTx(ctx context.Context, id domain.WalletID, label *string, tags []string) (*domain.WalletLink, error) {
+	// NULL arguments keep the current value; an empty label clears it
+	var labelArg, tagsArg interface{}
+	if label != nil {
+		labelArg = *label
+	}
+	if tags != nil {
+		tagsArg = pq.Array(tags)
 	}
 
-	return &result, nil
+	const q = `
+UPDATE wallets SET label = CASE WHEN $2::text IS NULL THEN label ELSE NULLIF($2, '') END,
+                   tags = COALESCE($3::text[], tags), updated_at = now()
+WHERE id = $1
+RETURNING ` + walletColumns
+	out, err := scanWallet(r.tx.QueryRowContext(ctx, q, id, labelArg, tagsArg))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrWalletNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("update wallet details: %w", err)
+	}
+	return out, nil
 }
 
 func (r *txRepo) GetPrimaryByUserTx(ctx context.Context, userID domain.UserID) (*domain.WalletLink, error) {
 	query := `
-		SELECT id, user_id, account_id, address, chain_id, is_primary, 
-		       verified_at, created_at, updated_at
-		FROM wallets 
+		SELECT ` + walletColumns + `
+		FROM wallets
 		WHERE user_id = $1 AND is_primary = true
 		LIMIT 1`
 
-	var link domain.WalletLink
-	var verifiedAt sql.NullTime
-
-	err := r.tx.QueryRowContext(ctx, query, userID).Scan(
-		&link.ID, &link.UserID, &link.AccountID, &link.Address, &link.ChainID,
-		&link.IsPrimary, &verifiedAt, &link.CreatedAt, &link.UpdatedAt,
-	)
-
+	link, err := scanWallet(r.tx.QueryRowContext(ctx, query, userID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrWalletNotFound
 		}
 		return nil, fmt.Errorf("failed to get primary wallet: %w", err)
 	}
-
-	if verifiedAt.Valid {
-		link.VerifiedAt = &verifiedAt.Time
-	}
-
-	return &link, nil
+	return link, nil
 }
 
 func (r *txRepo) DemoteOtherPrimariesTx(ctx context.Context, userID domain.UserID, chainID domain.ChainID, keepID domain.WalletID) error {
@@ -298,36 +346,32 @@ func (r *txRepo) DemoteOtherPrimariesTx(ctx context.Context, userID domain.UserI
 }
 
 func (r *txRepo) UpdateWalletAddressTx(ctx context.Context, id domain.WalletID, chainID domain.ChainID, address domain.Address) (*domain.WalletLink, error) {
+	// The signed address supersedes the user's watch-only row for it
+	const drop = `
+DELETE FROM wallets
+WHERE user_id = (SELECT user_id FROM wallets WHERE id=$1) AND chain_id=$2 AND address=$3 AND is_watch_only`
+	if _, err := r.tx.ExecContext(ctx, drop, id, chainID, address); err != nil {
+		return nil, fmt.Errorf("drop watch-only wallet: %w", err)
+	}
+
 	const q = `
 UPDATE wallets SET chain_id=$2, address=$3, updated_at=now(), verified_at=COALESCE(verified_at, now())
 WHERE id=$1
-RETURNING id,user_id,account_id,address,chain_id,is_primary,verified_at,created_at,updated_at`
-	var out domain.WalletLink
-	var ver sql.NullTime
-	err := r.tx.QueryRowContext(ctx, q, id, chainID, address).Scan(
-		&out.ID, &out.UserID, &out.AccountID, &out.Address, &out.ChainID, &out.IsPrimary, &ver, &out.CreatedAt, &out.UpdatedAt,
-	)
+RETURNING ` + walletColumns
+	out, err := scanWallet(r.tx.QueryRowContext(ctx, q, id, chainID, address))
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrWalletNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("update address: %w", err)
 	}
-	if ver.Valid {
-		out.VerifiedAt = &ver.Time
-	}
-	return &out, nil
+	return out, nil
 }
 
 func (r *txRepo) GetPrimaryByUserChainTx(ctx context.Context, userID domain.UserID, chainID domain.ChainID) (*domain.WalletLink, error) {
-	const q = `SELECT id,user_id,account_id,address,chain_id,is_primary,verified_at,created_at,updated_at
+	const q = `SELECT ` + walletColumns + `
                FROM wallets WHERE user_id=$1 AND chain_id=$2 AND is_primary=true LIMIT 1`
-	var out domain.WalletLink
-	var ver sql.NullTime
-	err := r.tx.QueryRowContext(ctx, q, userID, chainID).Scan(
-		&out.ID, &out.UserID, &out.AccountID, &out.Address, &out.ChainID,
-		&out.IsPrimary, &ver, &out.CreatedAt, &out.UpdatedAt,
-	)
+	out, err := scanWallet(r.tx.QueryRowContext(ctx, q, userID, chainID))
 	if err == sql.ErrNoRows {
 		return nil, domain.ErrWalletNotFound
 	}
@@ -335,11 +379,18 @@ func (r *txRepo) GetPrimaryByUserChainTx(ctx context.Context, userID domain.User
 	if err != nil {
 		return nil, fmt.Errorf("failed to get primary wallet by chain: %w", err)
 	}
+	return out, nil
+}
 
-	if ver.Valid {
-		out.VerifiedAt = &ver.Time
+func (r *txRepo) DeleteWalletTx(ctx context.Context, id domain.WalletID) error {
+	res, err := r.tx.ExecContext(ctx, `DELETE FROM wallets WHERE id=$1`, id)
+	if err != nil {
+		return fmt.Errorf("delete wallet: %w", err)
 	}
-	return &out, nil
+	if n, _ := res.RowsAffected(); n == 0 {
+		return domain.ErrWalletNotFound
+	}
+	return nil
 }
 
 // Helper function to hash strings for advisory locks
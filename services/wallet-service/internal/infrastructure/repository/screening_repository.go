@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+// ScreeningRepository tracks which wallets had their first sale screened
+type ScreeningRepository struct {
+	postgres *postgres.Postgres
+}
+
+func NewScreeningRepository(pg *postgres.Postgres) domain.ScreeningRepository {
+	return &ScreeningRepository{postgres: pg}
+}
+
+func (r *ScreeningRepository) ListUnscreenedSellers(ctx context.Context, chainID domain.ChainID, address domain.Address) ([]*domain.WalletLink, error) {
+	query := `
+		SELECT ` + walletColumns + `
+		FROM wallets
+		WHERE chain_id = $1 AND lower(address) = $2 AND NOT is_watch_only AND sale_screened_at IS NULL`
+
+	rows, err := r.postgres.GetClient().QueryContext(ctx, query, chainID, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unscreened wallets: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*domain.WalletLink
+	for rows.Next() {
+		link, err := scanWallet(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan wallet: %w", err)
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate wallets: %w", err)
+	}
+	return links, nil
+}
+
+func (r *ScreeningRepository) MarkSaleScreened(ctx context.Context, walletID domain.WalletID, at time.Time) (bool, error) {
+	result, err := r.postgres.GetClient().ExecContext(ctx,
+		`UPDATE wallets SET sale_screened_at = $2 WHERE id = $1 AND sale_screened_at IS NULL`,
+		walletID, at,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark sale screened: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return n > 0, nil
+}
+
+// ScreeningCache keeps screening results in Redis, keyed by provider so switching providers
+// screens again
+type ScreeningCache struct {
+	redis *redis.Redis
+}
+
+func NewScreeningCache(rds *redis.Redis) domain.ScreeningCache {
+	return &ScreeningCache{redis: rds}
+}
+
+func screeningCacheKey(provider string, chainID domain.ChainID, address domain.Address) string {
+	return fmt.Sprintf("wallet_screening:%s:%s:%s", provider, chainID, address)
+}
+
+func (c *ScreeningCache) GetScreening(ctx context.Context, provider string, chainID domain.ChainID, address domain.Address) (*domain.ScreeningResult, error) {
+	raw, err := c.redis.Get(ctx, screeningCacheKey(provider, chainID, address))
+	if err == redislib.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var result domain.ScreeningResult
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		return nil, fmt.Errorf("failed to decode cached screening: %w", err)
+	}
+	return &result, nil
+}
+
+func (c *ScreeningCache) PutScreening(ctx context.Context, result *domain.ScreeningResult, ttl time.Duration) error {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode screening: %w", err)
+	}
+	return c.redis.SetWithExpiration(ctx, screeningCacheKey(result.Provider, result.ChainID, result.Address), string(raw), ttl)
+}
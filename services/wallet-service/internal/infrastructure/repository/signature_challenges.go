@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+// SignatureChallengeStore keeps signature challenges in Redis until they expire. Taking one
+// is a single GETDEL, so concurrent verifications of a challenge cannot both get it.
+type SignatureChallengeStore struct {
+	redis *redis.Redis
+}
+
+func NewSignatureChallengeStore(rds *redis.Redis) domain.SignatureChallengeStore {
+	return &SignatureChallengeStore{redis: rds}
+}
+
+func signatureChallengeKey(id string) string {
+	return "wallet_signature_challenge:" + id
+}
+
+func (s *SignatureChallengeStore) SaveChallenge(ctx context.Context, challenge *domain.SignatureChallenge) error {
+	raw, err := json.Marshal(challenge)
+	if err != nil {
+		return fmt.Errorf("failed to encode signature challenge: %w", err)
+	}
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl <= 0 {
+		return fmt.Errorf("signature challenge %s has already expired", challenge.ID)
+	}
+	return s.redis.SetWithExpiration(ctx, signatureChallengeKey(challenge.ID), string(raw), ttl)
+}
+
+func (s *SignatureChallengeStore) TakeChallenge(ctx context.Context, id string) (*domain.SignatureChallenge, error) {
+	raw, err := s.redis.GetClient().GetDel(ctx, signatureChallengeKey(id)).Result()
+	if err == redislib.Nil {
+		return nil, domain.ErrChallengeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to take signature challenge: %w", err)
+	}
+	var challenge domain.SignatureChallenge
+	if err := json.Unmarshal([]byte(raw), &challenge); err != nil {
+		return nil, fmt.Errorf("failed to decode signature challenge: %w", err)
+	}
+	return &challenge, nil
+}
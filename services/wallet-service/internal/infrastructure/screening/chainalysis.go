@@ -0,0 +1,87 @@
+package screening
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+)
+
+// ChainalysisClient screens addresses with Chainalysis' sanctions screening API, which lists
+// the sanctions identifications of an address. It is chain-agnostic for EVM addresses.
+type ChainalysisClient struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+func NewChainalysisClient(baseURL, apiKey string) *ChainalysisClient {
+	return &ChainalysisClient{
+		httpClient: &http.Client{Timeout: requestTimeout},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+	}
+}
+
+func (c *ChainalysisClient) Name() string { return ProviderChainalysis }
+
+func (c *ChainalysisClient) Screen(ctx context.Context, chainID domain.ChainID, address domain.Address) (*domain.ScreeningResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/v1/address/"+url.PathEscape(address), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-API-Key", c.apiKey)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, fmt.Errorf("chainalysis: screening failed: %s: %s", res.Status, strings.TrimSpace(string(b)))
+	}
+
+	var body struct {
+		Identifications []struct {
+			Category string `json:"category"`
+			Name     string `json:"name"`
+		} `json:"identifications"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("chainalysis: invalid response: %w", err)
+	}
+
+	result := &domain.ScreeningResult{
+		ChainID:    chainID,
+		Address:    address,
+		Provider:   ProviderChainalysis,
+		Risk:       "low",
+		ScreenedAt: time.Now().UTC(),
+	}
+	// Every identification is a sanctions designation
+	for _, id := range body.Identifications {
+		result.Flagged = true
+		result.Risk = "severe"
+		if id.Category != "" && !contains(result.Categories, id.Category) {
+			result.Categories = append(result.Categories, id.Category)
+		}
+	}
+	return result, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
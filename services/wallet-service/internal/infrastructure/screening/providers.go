@@ -0,0 +1,50 @@
+// Package screening adapts sanctions/AML screening providers to domain.ScreeningProvider
+package screening
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/config"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+)
+
+// Provider names used in SCREENING_PROVIDER and on screening results
+const (
+	ProviderNone        = "none"
+	ProviderChainalysis = "chainalysis"
+	ProviderTRM         = "trm"
+)
+
+const requestTimeout = 10 * time.Second
+
+// NewProvider builds the provider named in the screening config
+func NewProvider(cfg config.ScreeningConfig) (domain.ScreeningProvider, error) {
+	switch cfg.Provider {
+	case ProviderNone, "":
+		return NoopProvider{}, nil
+	case ProviderChainalysis:
+		return NewChainalysisClient(cfg.ChainalysisBaseURL, cfg.ChainalysisAPIKey), nil
+	case ProviderTRM:
+		return NewTRMClient(cfg.TRMBaseURL, cfg.TRMAPIKey), nil
+	default:
+		return nil, fmt.Errorf("unknown screening provider %q", cfg.Provider)
+	}
+}
+
+// NoopProvider clears every address; it keeps the screening path exercised where no
+// provider is contracted
+type NoopProvider struct{}
+
+func (NoopProvider) Name() string { return ProviderNone }
+
+func (NoopProvider) Screen(_ context.Context, chainID domain.ChainID, address domain.Address) (*domain.ScreeningResult, error) {
+	return &domain.ScreeningResult{
+		ChainID:    chainID,
+		Address:    address,
+		Provider:   ProviderNone,
+		Risk:       "low",
+		ScreenedAt: time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package screening
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+)
+
+// trmChains maps CAIP-2 chain ids to TRM's chain names
+var trmChains = map[string]string{
+	"eip155:1":     "ethereum",
+	"eip155:10":    "optimism",
+	"eip155:56":    "binance_smart_chain",
+	"eip155:137":   "polygon",
+	"eip155:8453":  "base",
+	"eip155:42161": "arbitrum",
+	"eip155:43114": "avalanche_c_chain",
+}
+
+// riskOrder ranks TRM's risk score levels; an address is flagged from high up
+var riskOrder = map[string]int{"unknown": 0, "low": 1, "medium": 2, "high": 3, "severe": 4}
+
+// TRMClient screens addresses with TRM Labs' address screening API, which scores the risk of
+// the entities an address is exposed to
+type TRMClient struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+func NewTRMClient(baseURL, apiKey string) *TRMClient {
+	return &TRMClient{
+		httpClient: &http.Client{Timeout: requestTimeout},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+	}
+}
+
+func (c *TRMClient) Name() string { return ProviderTRM }
+
+func (c *TRMClient) Screen(ctx context.Context, chainID domain.ChainID, address domain.Address) (*domain.ScreeningResult, error) {
+	chain, ok := trmChains[chainID]
+	if !ok {
+		return nil, fmt.Errorf("trm: chain %s is not supported", chainID)
+	}
+	payload, err := json.Marshal([]map[string]string{{"address": address, "chain": chain}})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/public/v2/screening/addresses", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.apiKey, c.apiKey)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, fmt.Errorf("trm: screening failed: %s: %s", res.Status, strings.TrimSpace(string(b)))
+	}
+
+	var body []struct {
+		AddressRiskIndicators []struct {
+			Category                    string `json:"category"`
+			CategoryRiskScoreLevelLabel string `json:"categoryRiskScoreLevelLabel"`
+		} `json:"addressRiskIndicators"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("trm: invalid response: %w", err)
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("trm: empty response")
+	}
+
+	result := &domain.ScreeningResult{
+		ChainID:    chainID,
+		Address:    address,
+		Provider:   ProviderTRM,
+		Risk:       "low",
+		ScreenedAt: time.Now().UTC(),
+	}
+	for _, indicator := range body[0].AddressRiskIndicators {
+		level := strings.ToLower(indicator.CategoryRiskScoreLevelLabel)
+		if riskOrder[level] > riskOrder[result.Risk] {
+			result.Risk = level
+		}
+		if riskOrder[level] >= riskOrder["high"] && !contains(result.Categories, indicator.Category) {
+			result.Categories = append(result.Categories, indicator.Category)
+		}
+	}
+	result.Flagged = riskOrder[result.Risk] >= riskOrder["high"]
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+)
+
+// ActivityService tracks when wallets are seen and publishes the daily active wallets, so
+// retention can be measured without instrumenting the frontend
+type ActivityService struct {
+	repo      domain.ActivityRepository
+	publisher domain.EventPublisher
+}
+
+func NewActivityService(repo domain.ActivityRepository, publisher domain.EventPublisher) *ActivityService {
+	return &ActivityService{
+		repo:      repo,
+		publisher: publisher,
+	}
+}
+
+// RecordSeen marks the user's signed wallets, or only walletID when set, as seen now
+func (s *ActivityService) RecordSeen(ctx context.Context, userID domain.UserID, walletID domain.WalletID) (int, error) {
+	if strings.TrimSpace(userID) == "" {
+		return 0, fmt.Errorf("user ID is required")
+	}
+	return s.repo.TouchWallets(ctx, userID, walletID, time.Now())
+}
+
+// RunDailyReports publishes the aggregates of finished days at startup and then every
+// interval, catching up on days missed while the service was down
+func (s *ActivityService) RunDailyReports(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.ReportDailyActive(ctx); err != nil {
+			log.Printf("Daily active wallet report failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReportDailyActive publishes wallet.daily_active for every finished UTC day not reported
+// yet. A day is marked reported only once published, so a failure is retried next run.
+func (s *ActivityService) ReportDailyActive(ctx context.Context) error {
+	days, err := s.repo.ListUnreportedDays(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, day := range days {
+		event, err := s.repo.DailyActive(ctx, day)
+		if err != nil {
+			return err
+		}
+		if err := s.publisher.PublishDailyActive(ctx, event); err != nil {
+			return err
+		}
+		if err := s.repo.MarkDayReported(ctx, day); err != nil {
+			return err
+		}
+		log.Printf("Reported daily active wallets for %s: %d wallets, %d users", event.Day, event.ActiveWallets, event.ActiveUsers)
+	}
+	return nil
+}
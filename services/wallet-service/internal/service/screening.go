@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// ScreeningService screens addresses against a sanctions/AML provider when they are linked
+// and when one of their wallets first sells. A provider that cannot be reached lets the link
+// through: screening is a compliance signal, and an outage must not lock users out.
+type ScreeningService struct {
+	provider  domain.ScreeningProvider
+	repo      domain.ScreeningRepository
+	publisher domain.EventPublisher
+	mode      domain.ScreeningMode
+	cache     domain.ScreeningCache // nil screens every time
+	cacheTTL  time.Duration
+}
+
+func NewScreeningService(provider domain.ScreeningProvider, repo domain.ScreeningRepository, publisher domain.EventPublisher, mode domain.ScreeningMode) *ScreeningService {
+	return &ScreeningService{
+		provider:  provider,
+		repo:      repo,
+		publisher: publisher,
+		mode:      mode,
+		cacheTTL:  domain.DefaultScreeningCacheTTL,
+	}
+}
+
+// SetCache reuses screening results for ttl; ttl <= 0 uses domain.DefaultScreeningCacheTTL
+func (s *ScreeningService) SetCache(cache domain.ScreeningCache, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = domain.DefaultScreeningCacheTTL
+	}
+	s.cache = cache
+	s.cacheTTL = ttl
+}
+
+// ScreenWalletLink screens an address before it is linked. A flagged address is reported for
+// moderation and, in block mode, refused with ErrWalletScreeningBlocked.
+func (s *ScreeningService) ScreenWalletLink(ctx context.Context, link domain.WalletLink) error {
+	chainID, address := normalizeChainID(link.ChainID), normalizeAddress(link.Address)
+	result, fresh, err := s.screen(ctx, chainID, address)
+	if err != nil {
+		log.Printf("Screening of %s on %s failed, linking unscreened: %v", address, chainID, err)
+		return nil
+	}
+	if !result.Flagged {
+		return nil
+	}
+
+	blocked := s.mode == domain.ScreeningModeBlock
+	// A cached flag was reported when it was screened
+	if fresh {
+		s.reportFlagged(ctx, result, link.UserID, "", domain.ScreeningTriggerWalletLink, blocked)
+	}
+	if blocked {
+		return domain.ErrWalletScreeningBlocked
+	}
+	return nil
+}
+
+// HandleSaleIndexed screens the signed wallets of a sale's seller and buyer the first time
+// they take part in a sale
+func (s *ScreeningService) HandleSaleIndexed(ctx context.Context, event *contracts.SaleIndexedEvent) error {
+	// Sales carry the indexer's eip155-1 chain ids; wallets are keyed by CAIP-2
+	chainID := normalizeChainID(strings.Replace(event.ChainID, "-", ":", 1))
+	seller, buyer := event.Parties()
+
+	for _, address := range []string{seller, buyer} {
+		if address == "" {
+			continue
+		}
+		wallets, err := s.repo.ListUnscreenedSellers(ctx, chainID, address)
+		if err != nil {
+			return err
+		}
+		if len(wallets) == 0 {
+			continue
+		}
+
+		// A wallet whose screening failed stays unscreened and is retried on its next sale
+		result, _, err := s.screen(ctx, chainID, address)
+		if err != nil {
+			log.Printf("Screening of %s on %s after sale %s failed: %v", address, chainID, event.EventID, err)
+			continue
+		}
+		for _, w := range wallets {
+			first, err := s.repo.MarkSaleScreened(ctx, w.ID, time.Now())
+			if err != nil {
+				return err
+			}
+			// A cached verdict is reused, but every first sale of a flagged wallet is reported
+			if first && result.Flagged {
+				s.reportFlagged(ctx, result, w.UserID, w.ID, domain.ScreeningTriggerFirstSale, false)
+			}
+		}
+	}
+	return nil
+}
+
+// screen returns the cached result for the address, or screens it and caches the result;
+// fresh reports that the provider was asked
+func (s *ScreeningService) screen(ctx context.Context, chainID domain.ChainID, address domain.Address) (*domain.ScreeningResult, bool, error) {
+	if s.cache != nil {
+		cached, err := s.cache.GetScreening(ctx, s.provider.Name(), chainID, address)
+		if err != nil {
+			log.Printf("Screening cache read failed: %v", err)
+		} else if cached != nil {
+			return cached, false, nil
+		}
+	}
+
+	result, err := s.provider.Screen(ctx, chainID, address)
+	if err != nil {
+		return nil, false, err
+	}
+	if s.cache != nil {
+		if err := s.cache.PutScreening(ctx, result, s.cacheTTL); err != nil {
+			log.Printf("Screening cache write failed: %v", err)
+		}
+	}
+	return result, true, nil
+}
+
+func (s *ScreeningService) reportFlagged(ctx context.Context, result *domain.ScreeningResult, userID domain.UserID, walletID domain.WalletID, trigger string, blocked bool) {
+	log.Printf("Screening flagged %s on %s (%s, risk %s, trigger %s, blocked %t)",
+		result.Address, result.ChainID, result.Provider, result.Risk, trigger, blocked)
+
+	err := s.publisher.PublishScreeningFlagged(ctx, &domain.WalletScreeningFlaggedEvent{
+		UserID:     userID,
+		WalletID:   walletID,
+		Address:    result.Address,
+		ChainID:    result.ChainID,
+		Trigger:    trigger,
+		Provider:   result.Provider,
+		Risk:       result.Risk,
+		Categories: result.Categories,
+		Blocked:    blocked,
+		ScreenedAt: result.ScreenedAt,
+	})
+	if err != nil {
+		log.Printf("Failed to publish screening flagged event: %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
@@ -135,6 +136,198 @@ func (s *Service) UpsertLink(ctx context.Context, link domain.WalletLink) (*doma
 	return result, nil
 }
 
+func (s *Service) ListLinks(ctx context.Context, userID domain.UserID) ([]*domain.WalletLink, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	return s.repo.ListByUser(ctx, userID)
+}
+
+// RemoveWallet unlinks one of the user's wallets. A chain's primary wallet is kept until
+// another wallet is made primary, so signing in with it keeps working.
+func (s *Service) RemoveWallet(ctx context.Context, userID domain.UserID, walletID domain.WalletID) (*domain.WalletLink, error) {
+	if strings.TrimSpace(userID) == "" || strings.TrimSpace(walletID) == "" {
+		return nil, fmt.Errorf("user ID and wallet ID are required")
+	}
+
+	var removed *domain.WalletLink
+	err := s.repo.WithTx(ctx, func(tx domain.TxWalletRepository) error {
+		link, err := tx.GetByIDTx(ctx, walletID)
+		if err != nil {
+			return err
+		}
+		// Another user's wallet is reported as missing rather than forbidden
+		if link.UserID != userID {
+			return domain.ErrWalletNotFound
+		}
+		if link.IsPrimary {
+			return domain.ErrCannotRemovePrimary
+		}
+		if err := tx.DeleteWalletTx(ctx, link.ID); err != nil {
+			return err
+		}
+		removed = link
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+// SetPrimaryWallet makes one of the user's wallets the primary wallet of its chain and
+// reports which wallet it replaced
+func (s *Service) SetPrimaryWallet(ctx context.Context, userID domain.UserID, walletID domain.WalletID) (*domain.PrimaryChangeResult, error) {
+	if strings.TrimSpace(userID) == "" || strings.TrimSpace(walletID) == "" {
+		return nil, fmt.Errorf("user ID and wallet ID are required")
+	}
+
+	var result *domain.PrimaryChangeResult
+	err := s.repo.WithTx(ctx, func(tx domain.TxWalletRepository) error {
+		link, err := tx.GetByIDTx(ctx, walletID)
+		if err != nil {
+			return err
+		}
+		if link.UserID != userID {
+			return domain.ErrWalletNotFound
+		}
+		if link.IsWatchOnly {
+			return domain.ErrWatchOnlyWallet
+		}
+		if link.IsPrimary {
+			result = &domain.PrimaryChangeResult{Link: link}
+			return nil
+		}
+
+		previous, err := tx.GetPrimaryByUserChainTx(ctx, link.UserID, link.ChainID)
+		if err != nil && err != domain.ErrWalletNotFound {
+			return err
+		}
+		if err := tx.DemoteOtherPrimariesTx(ctx, link.UserID, link.ChainID, link.ID); err != nil {
+			return err
+		}
+		t := true
+		updated, err := tx.UpdateWalletMetaTx(ctx, link.ID, &t, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		result = &domain.PrimaryChangeResult{Link: updated, Previous: previous, Changed: true}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddWatchOnlyWallet tracks an address the user has not signed for. It is never primary;
+// a later signed UpsertLink of the same address turns it into a regular wallet.
+func (s *Service) AddWatchOnlyWallet(ctx context.Context, link domain.WalletLink) (*domain.WalletLink, error) {
+	if strings.TrimSpace(link.UserID) == "" || link.Address == "" || link.ChainID == "" {
+		return nil, fmt.Errorf("user ID, address and chain ID are required")
+	}
+	if !isValidEthereumAddress(link.Address) {
+		return nil, domain.ErrInvalidAddress
+	}
+	if !isValidChainID(link.ChainID) {
+		return nil, domain.ErrInvalidChainID
+	}
+	details, err := normalizeWalletDetails(domain.WalletDetailsUpdate{Label: &link.Label, Tags: link.Tags})
+	if err != nil {
+		return nil, err
+	}
+
+	link = domain.WalletLink{
+		UserID:      link.UserID,
+		Address:     normalizeAddress(link.Address),
+		ChainID:     normalizeChainID(link.ChainID),
+		Label:       *details.Label,
+		Tags:        details.Tags,
+		IsWatchOnly: true,
+	}
+
+	var added *domain.WalletLink
+	err = s.repo.WithTx(ctx, func(tx domain.TxWalletRepository) error {
+		if err := tx.AcquireAddressLock(ctx, link.ChainID, link.Address); err != nil {
+			return fmt.Errorf("lock address: %w", err)
+		}
+		inserted, err := tx.InsertWalletTx(ctx, link)
+		if err != nil {
+			return err
+		}
+		added = inserted
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+// UpdateWalletDetails sets the label and tags of one of the user's wallets
+func (s *Service) UpdateWalletDetails(ctx context.Context, userID domain.UserID, walletID domain.WalletID, update domain.WalletDetailsUpdate) (*domain.WalletLink, error) {
+	if strings.TrimSpace(userID) == "" || strings.TrimSpace(walletID) == "" {
+		return nil, fmt.Errorf("user ID and wallet ID are required")
+	}
+	update, err := normalizeWalletDetails(update)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *domain.WalletLink
+	err = s.repo.WithTx(ctx, func(tx domain.TxWalletRepository) error {
+		link, err := tx.GetByIDTx(ctx, walletID)
+		if err != nil {
+			return err
+		}
+		if link.UserID != userID {
+			return domain.ErrWalletNotFound
+		}
+		if update.Label == nil && update.Tags == nil {
+			updated = link
+			return nil
+		}
+		updated, err = tx.UpdateWalletDetailsTx(ctx, link.ID, update.Label, update.Tags)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// normalizeWalletDetails trims the label and lowercases, trims and dedupes the tags,
+// dropping empty ones
+func normalizeWalletDetails(update domain.WalletDetailsUpdate) (domain.WalletDetailsUpdate, error) {
+	if update.Label != nil {
+		label := strings.TrimSpace(*update.Label)
+		if utf8.RuneCountInString(label) > domain.MaxWalletLabelLength {
+			return update, domain.ErrInvalidWalletLabel
+		}
+		update.Label = &label
+	}
+	if update.Tags != nil {
+		tags := make([]string, 0, len(update.Tags))
+		seen := make(map[string]bool, len(update.Tags))
+		for _, tag := range update.Tags {
+			tag = strings.ToLower(strings.TrimSpace(tag))
+			if tag == "" || seen[tag] {
+				continue
+			}
+			if utf8.RuneCountInString(tag) > domain.MaxWalletTagLength {
+				return update, domain.ErrInvalidWalletTags
+			}
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+		if len(tags) > domain.MaxWalletTags {
+			return update, domain.ErrInvalidWalletTags
+		}
+		update.Tags = tags
+	}
+	return update, nil
+}
+
 func (s *Service) validateWalletLink(link domain.WalletLink) error {
 	if link.UserID == "" {
 		return fmt.Errorf("user_id is required")
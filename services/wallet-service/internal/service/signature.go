@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/google/uuid"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+)
+
+// defaultChallengeStatement opens generated challenges that bring no statement of their own
+const defaultChallengeStatement = "Sign this message to prove you control this address on Zuno Marketplace."
+
+// typedDataDomainName is the EIP-712 domain of typed data challenges
+const typedDataDomainName = "Zuno Marketplace"
+
+var purposePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// SignatureService issues purpose-scoped, single-use signature challenges and verifies them
+// for EOAs by signer recovery and for contract wallets with EIP-1271
+type SignatureService struct {
+	store domain.SignatureChallengeStore
+	// nil rejects every signature an EOA did not make
+	contracts domain.ContractSignatureVerifier
+	now       func() time.Time
+}
+
+func NewSignatureService(store domain.SignatureChallengeStore, contracts domain.ContractSignatureVerifier) *SignatureService {
+	return &SignatureService{
+		store:     store,
+		contracts: contracts,
+		now:       time.Now,
+	}
+}
+
+// CreateChallenge stores a challenge for req.Address to sign. Unless req.Message is given,
+// the message names the purpose, address, chain, resource, user and a fresh nonce.
+func (s *SignatureService) CreateChallenge(ctx context.Context, req domain.SignatureChallengeRequest) (*domain.SignatureChallenge, error) {
+	if !purposePattern.MatchString(req.Purpose) {
+		return nil, fmt.Errorf("%w: purpose must be lowercase letters, digits and underscores", domain.ErrInvalidChallenge)
+	}
+	address := normalizeAddress(req.Address)
+	if !isValidEthereumAddress(address) {
+		return nil, domain.ErrInvalidAddress
+	}
+	chainID := normalizeChainID(req.ChainID)
+	if !isValidChainID(chainID) {
+		return nil, domain.ErrInvalidChainID
+	}
+	if req.Scheme == "" {
+		req.Scheme = domain.SignatureSchemeEIP191
+	}
+	if req.Scheme != domain.SignatureSchemeEIP191 && req.Scheme != domain.SignatureSchemeEIP712 {
+		return nil, fmt.Errorf("%w: unknown scheme %q", domain.ErrInvalidChallenge, req.Scheme)
+	}
+	if req.Message != "" && req.Scheme != domain.SignatureSchemeEIP191 {
+		return nil, fmt.Errorf("%w: a given message is signed with EIP-191 only", domain.ErrInvalidChallenge)
+	}
+	ttl := req.TTL
+	if ttl <= 0 {
+		ttl = domain.DefaultSignatureChallengeTTL
+	}
+	if ttl > domain.MaxSignatureChallengeTTL {
+		return nil, fmt.Errorf("%w: ttl exceeds %s", domain.ErrInvalidChallenge, domain.MaxSignatureChallengeTTL)
+	}
+
+	issuedAt := s.now().UTC().Truncate(time.Second)
+	challenge := &domain.SignatureChallenge{
+		ID:        uuid.New().String(),
+		Purpose:   req.Purpose,
+		Address:   address,
+		ChainID:   chainID,
+		UserID:    req.UserID,
+		Resource:  req.Resource,
+		Scheme:    req.Scheme,
+		Message:   req.Message,
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(ttl),
+	}
+
+	if challenge.Message == "" {
+		nonce, err := challengeNonce()
+		if err != nil {
+			return nil, err
+		}
+		statement := strings.TrimSpace(req.Statement)
+		if statement == "" {
+			statement = defaultChallengeStatement
+		}
+		if challenge.Scheme == domain.SignatureSchemeEIP712 {
+			challenge.Message, err = challengeTypedData(challenge, statement, nonce)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			challenge.Message = challengeText(challenge, statement, nonce)
+		}
+	}
+
+	if err := s.store.SaveChallenge(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// VerifyChallenge takes the challenge and checks signature against it. The challenge is used
+// up first, so a signature is accepted once at most and a failed attempt needs a new one.
+func (s *SignatureService) VerifyChallenge(ctx context.Context, id, signature, purpose string, userID domain.UserID) (*domain.SignatureProof, error) {
+	if id == "" || signature == "" || purpose == "" {
+		return nil, fmt.Errorf("%w: challenge id, signature and purpose are required", domain.ErrInvalidChallenge)
+	}
+
+	challenge, err := s.store.TakeChallenge(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.now().After(challenge.ExpiresAt) {
+		return nil, domain.ErrChallengeNotFound
+	}
+	if challenge.Purpose != purpose || (challenge.UserID != "" && challenge.UserID != userID) {
+		return nil, domain.ErrChallengeMismatch
+	}
+
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return nil, domain.ErrInvalidSignature
+	}
+	digest, err := challengeDigest(challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &domain.SignatureProof{Challenge: challenge}
+	if signer, ok := recoverSigner(digest, sig); ok && signer == challenge.Address {
+		proof.Method = domain.SignatureMethodEOA
+	} else {
+		// Not the address's own key, so the address may be a contract wallet
+		if s.contracts == nil {
+			return nil, domain.ErrInvalidSignature
+		}
+		valid, err := s.contracts.IsValidSignature(ctx, challenge.ChainID, challenge.Address, digest, sig)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrContractSignatureCheck, err)
+		}
+		if !valid {
+			return nil, domain.ErrInvalidSignature
+		}
+		proof.Method = domain.SignatureMethodEIP1271
+	}
+
+	log.Printf("audit|event=signature_challenge_verified|purpose=%s|chain_id=%s|address=%s|user_id=%s|method=%s|timestamp=%s",
+		challenge.Purpose, challenge.ChainID, challenge.Address, challenge.UserID, proof.Method, s.now().UTC().Format(time.RFC3339Nano))
+	return proof, nil
+}
+
+// ChallengeDigest is the hash a challenge's signer signs, exposed for tests
+func ChallengeDigest(challenge *domain.SignatureChallenge) ([32]byte, error) {
+	return challengeDigest(challenge)
+}
+
+func challengeDigest(challenge *domain.SignatureChallenge) ([32]byte, error) {
+	var digest [32]byte
+	switch challenge.Scheme {
+	case domain.SignatureSchemeEIP712:
+		var typedData apitypes.TypedData
+		if err := json.Unmarshal([]byte(challenge.Message), &typedData); err != nil {
+			return digest, fmt.Errorf("failed to decode typed data challenge: %w", err)
+		}
+		hash, _, err := apitypes.TypedDataAndHash(typedData)
+		if err != nil {
+			return digest, fmt.Errorf("failed to hash typed data challenge: %w", err)
+		}
+		copy(digest[:], hash)
+	default:
+		copy(digest[:], accounts.TextHash([]byte(challenge.Message)))
+	}
+	return digest, nil
+}
+
+// recoverSigner returns the EOA that signed digest, if sig is an ECDSA signature
+func recoverSigner(digest [32]byte, sig []byte) (domain.Address, bool) {
+	if len(sig) != crypto.SignatureLength {
+		return "", false
+	}
+	sig = append([]byte{}, sig...)
+	// Wallets return v as 27/28; crypto expects the raw recovery id
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(digest[:], sig)
+	if err != nil {
+		return "", false
+	}
+	return normalizeAddress(crypto.PubkeyToAddress(*pub).Hex()), true
+}
+
+func challengeNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate challenge nonce: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func challengeText(c *domain.SignatureChallenge, statement, nonce string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\nAddress: %s\nChain ID: %s\nPurpose: %s\n", statement, c.Address, c.ChainID, c.Purpose)
+	if c.Resource != "" {
+		fmt.Fprintf(&b, "Resource: %s\n", c.Resource)
+	}
+	if c.UserID != "" {
+		fmt.Fprintf(&b, "User: %s\n", c.UserID)
+	}
+	fmt.Fprintf(&b, "Nonce: %s\nIssued At: %s\nExpiration Time: %s",
+		nonce, c.IssuedAt.Format(time.RFC3339), c.ExpiresAt.Format(time.RFC3339))
+	return b.String()
+}
+
+// challengeTypedData is the eth_signTypedData_v4 payload of a challenge. Its domain carries
+// the numeric EVM chain id, so only eip155 chains can use it.
+func challengeTypedData(c *domain.SignatureChallenge, statement, nonce string) (string, error) {
+	namespace, reference, _ := strings.Cut(c.ChainID, ":")
+	chainID, ok := new(big.Int).SetString(reference, 10)
+	if namespace != "eip155" || !ok {
+		return "", fmt.Errorf("%w: EIP-712 needs an eip155 chain", domain.ErrInvalidChallenge)
+	}
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"SignatureChallenge": {
+				{Name: "statement", Type: "string"},
+				{Name: "address", Type: "address"},
+				{Name: "purpose", Type: "string"},
+				{Name: "resource", Type: "string"},
+				{Name: "user", Type: "string"},
+				{Name: "nonce", Type: "string"},
+				{Name: "issuedAt", Type: "string"},
+				{Name: "expiresAt", Type: "string"},
+			},
+		},
+		PrimaryType: "SignatureChallenge",
+		Domain: apitypes.TypedDataDomain{
+			Name:    typedDataDomainName,
+			Version: "1",
+			ChainId: (*math.HexOrDecimal256)(chainID),
+		},
+		Message: apitypes.TypedDataMessage{
+			"statement": statement,
+			"address":   c.Address,
+			"purpose":   c.Purpose,
+			"resource":  c.Resource,
+			"user":      c.UserID,
+			"nonce":     nonce,
+			"issuedAt":  c.IssuedAt.Format(time.RFC3339),
+			"expiresAt": c.ExpiresAt.Format(time.RFC3339),
+		},
+	}
+	raw, err := json.Marshal(typedData)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode typed data challenge: %w", err)
+	}
+	return string(raw), nil
+}
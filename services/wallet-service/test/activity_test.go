@@ -0,0 +1,119 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/service"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+// MockActivityRepository is a mock implementation of ActivityRepository
+type MockActivityRepository struct {
+	mock.Mock
+}
+
+func (m *MockActivityRepository) TouchWallets(ctx context.Context, userID domain.UserID, walletID domain.WalletID, at time.Time) (int, error) {
+	args := m.Called(ctx, userID, walletID, at)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockActivityRepository) ListUnreportedDays(ctx context.Context, before time.Time) ([]time.Time, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).([]time.Time), args.Error(1)
+}
+
+func (m *MockActivityRepository) DailyActive(ctx context.Context, day time.Time) (*domain.WalletDailyActiveEvent, error) {
+	args := m.Called(ctx, day)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WalletDailyActiveEvent), args.Error(1)
+}
+
+func (m *MockActivityRepository) MarkDayReported(ctx context.Context, day time.Time) error {
+	args := m.Called(ctx, day)
+	return args.Error(0)
+}
+
+func TestUpsertLink_RecordsSignedInWalletAsSeen(t *testing.T) {
+	ctx := context.Background()
+	walletService := new(MockWalletService)
+	publisher := new(MockEventPublisher)
+	repo := new(MockActivityRepository)
+	handler := grpcHandler.NewWalletGRPCServer(walletService, publisher).
+		WithActivity(service.NewActivityService(repo, publisher))
+
+	seenAt := time.Now()
+	walletService.On("UpsertLink", ctx, mock.Anything).Return(&domain.WalletUpsertResult{
+		Link: &domain.WalletLink{ID: "wallet-1", UserID: "user-1", Address: "0x1234567890123456789012345678901234567890", ChainID: "eip155:1", LastSeenAt: &seenAt},
+	}, nil)
+	repo.On("TouchWallets", ctx, "user-1", "wallet-1", mock.Anything).Return(1, nil).Once()
+
+	resp, err := handler.UpsertLink(ctx, &walletpb.UpsertLinkRequest{
+		UserId: "user-1", AccountId: "account-1", Address: "0x1234567890123456789012345678901234567890", ChainId: "eip155:1",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Link.LastSeenAt)
+	assert.Equal(t, seenAt.Unix(), resp.Link.LastSeenAt.AsTime().Unix())
+	repo.AssertExpectations(t)
+}
+
+func TestTouchWallets(t *testing.T) {
+	ctx := context.Background()
+	publisher := new(MockEventPublisher)
+	repo := new(MockActivityRepository)
+	handler := grpcHandler.NewWalletGRPCServer(new(MockWalletService), publisher)
+
+	_, err := handler.TouchWallets(ctx, &walletpb.TouchWalletsRequest{UserId: "user-1"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	handler.WithActivity(service.NewActivityService(repo, publisher))
+	_, err = handler.TouchWallets(ctx, &walletpb.TouchWalletsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// An empty wallet id touches every signed wallet of the user
+	repo.On("TouchWallets", ctx, "user-1", "", mock.Anything).Return(2, nil)
+	resp, err := handler.TouchWallets(ctx, &walletpb.TouchWalletsRequest{UserId: "user-1"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, resp.Touched)
+}
+
+func TestReportDailyActive_PublishesEachUnreportedDay(t *testing.T) {
+	ctx := context.Background()
+	publisher := new(MockEventPublisher)
+	repo := new(MockActivityRepository)
+	activity := service.NewActivityService(repo, publisher)
+
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+	repo.On("ListUnreportedDays", ctx, mock.Anything).Return([]time.Time{monday, tuesday}, nil)
+	repo.On("DailyActive", ctx, monday).Return(&domain.WalletDailyActiveEvent{Day: "2026-10-12", ActiveWallets: 5, ActiveUsers: 4, NewWallets: 1, ReturningWallets: 4}, nil)
+	repo.On("DailyActive", ctx, tuesday).Return(&domain.WalletDailyActiveEvent{Day: "2026-10-13", ActiveWallets: 3}, nil)
+	repo.On("MarkDayReported", ctx, monday).Return(nil).Once()
+
+	var published []string
+	publisher.On("PublishDailyActive", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		published = append(published, args.Get(1).(*domain.WalletDailyActiveEvent).Day)
+	}).Return(nil).Once()
+	publisher.On("PublishDailyActive", ctx, mock.Anything).Return(errors.New("broker down")).Once()
+
+	// Tuesday failed to publish, so it stays unreported for the next run
+	err := activity.ReportDailyActive(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, []string{"2026-10-12"}, published)
+	repo.AssertNotCalled(t, "MarkDayReported", ctx, tuesday)
+	repo.AssertExpectations(t)
+}
@@ -89,6 +89,65 @@ func (suite *EventPublisherTestSuite) TestPublishWalletLinked_Success() {
 	suite.mockAMQP.AssertExpectations(suite.T())
 }
 
+func (suite *EventPublisherTestSuite) TestPublishPrimaryChanged_Success() {
+	ctx := context.Background()
+	event := &domain.WalletLinkedEvent{
+		UserID:    "user-123",
+		WalletID:  "wallet-789",
+		Address:   "0x1234567890123456789012345678901234567890",
+		ChainID:   "eip155:1",
+		IsPrimary: true,
+		LinkedAt:  time.Now(),
+	}
+
+	suite.mockAMQP.On("Publish", ctx, mock.MatchedBy(func(msg contracts.AMQPMessage) bool {
+		var payload domain.WalletLinkedEvent
+		if err := json.Unmarshal(msg.Body, &payload); err != nil {
+			return false
+		}
+		return msg.Exchange == contracts.WalletsExchange &&
+			msg.RoutingKey == contracts.WalletPrimaryChangedKey &&
+			msg.Headers["schema"] == "wallet.primary_changed.v1" &&
+			payload.UserID == event.UserID &&
+			payload.IsPrimary
+	})).Return(nil)
+
+	err := suite.publisher.PublishPrimaryChanged(ctx, event)
+
+	suite.NoError(err)
+	suite.mockAMQP.AssertExpectations(suite.T())
+}
+
+func (suite *EventPublisherTestSuite) TestPublishWalletUnlinked_Success() {
+	ctx := context.Background()
+	event := &domain.WalletUnlinkedEvent{
+		UserID:     "user-123",
+		AccountID:  "account-456",
+		WalletID:   "wallet-789",
+		Address:    "0x1234567890123456789012345678901234567890",
+		ChainID:    "eip155:1",
+		UnlinkedAt: time.Now(),
+	}
+
+	suite.mockAMQP.On("Publish", ctx, mock.MatchedBy(func(msg contracts.AMQPMessage) bool {
+		var payload contracts.WalletUnlinkedEvent
+		if err := json.Unmarshal(msg.Body, &payload); err != nil {
+			return false
+		}
+		return msg.Exchange == contracts.WalletsExchange &&
+			msg.RoutingKey == contracts.WalletUnlinkedKey &&
+			msg.Headers["schema"] == "wallet.unlinked.v1" &&
+			payload.UserID == event.UserID &&
+			payload.AccountID == event.AccountID &&
+			payload.Address == event.Address
+	})).Return(nil)
+
+	err := suite.publisher.PublishWalletUnlinked(ctx, event)
+
+	suite.NoError(err)
+	suite.mockAMQP.AssertExpectations(suite.T())
+}
+
 func (suite *EventPublisherTestSuite) TestPublishWalletLinked_AMQPUnavailable() {
 	ctx := context.Background()
 	event := &domain.WalletLinkedEvent{
@@ -26,6 +26,46 @@ func (m *MockWalletService) UpsertLink(ctx context.Context, link domain.WalletLi
 	return args.Get(0).(*domain.WalletUpsertResult), args.Error(1)
 }
 
+func (m *MockWalletService) ListLinks(ctx context.Context, userID domain.UserID) ([]*domain.WalletLink, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WalletLink), args.Error(1)
+}
+
+func (m *MockWalletService) RemoveWallet(ctx context.Context, userID domain.UserID, walletID domain.WalletID) (*domain.WalletLink, error) {
+	args := m.Called(ctx, userID, walletID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WalletLink), args.Error(1)
+}
+
+func (m *MockWalletService) SetPrimaryWallet(ctx context.Context, userID domain.UserID, walletID domain.WalletID) (*domain.PrimaryChangeResult, error) {
+	args := m.Called(ctx, userID, walletID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PrimaryChangeResult), args.Error(1)
+}
+
+func (m *MockWalletService) AddWatchOnlyWallet(ctx context.Context, link domain.WalletLink) (*domain.WalletLink, error) {
+	args := m.Called(ctx, link)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WalletLink), args.Error(1)
+}
+
+func (m *MockWalletService) UpdateWalletDetails(ctx context.Context, userID domain.UserID, walletID domain.WalletID, update domain.WalletDetailsUpdate) (*domain.WalletLink, error) {
+	args := m.Called(ctx, userID, walletID, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WalletLink), args.Error(1)
+}
+
 // MockEventPublisher is a mock implementation of EventPublisher
 type MockEventPublisher struct {
 	mock.Mock
@@ -36,6 +76,26 @@ func (m *MockEventPublisher) PublishWalletLinked(ctx context.Context, event *dom
 	return args.Error(0)
 }
 
+func (m *MockEventPublisher) PublishPrimaryChanged(ctx context.Context, event *domain.WalletLinkedEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishWalletUnlinked(ctx context.Context, event *domain.WalletUnlinkedEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishScreeningFlagged(ctx context.Context, event *domain.WalletScreeningFlaggedEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) PublishDailyActive(ctx context.Context, event *domain.WalletDailyActiveEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
 // WalletGRPCTestSuite defines the test suite for Wallet gRPC handler
 type WalletGRPCTestSuite struct {
 	suite.Suite
@@ -156,6 +216,119 @@ func (suite *WalletGRPCTestSuite) TestUpsertLink_Success_ExistingWallet() {
 	suite.mockService.AssertExpectations(suite.T())
 }
 
+func (suite *WalletGRPCTestSuite) TestUpsertLink_PrimaryChanged_PublishesPrimaryChanged() {
+	ctx := context.Background()
+	req := &walletpb.UpsertLinkRequest{
+		UserId:    "user-123",
+		AccountId: "account-456",
+		Address:   "0x1234567890123456789012345678901234567890",
+		ChainId:   "eip155:1",
+		IsPrimary: true,
+	}
+
+	expectedResult := &domain.WalletUpsertResult{
+		Link: &domain.WalletLink{
+			ID:        "wallet-789",
+			UserID:    req.UserId,
+			AccountID: req.AccountId,
+			Address:   req.Address,
+			ChainID:   req.ChainId,
+			IsPrimary: true,
+			CreatedAt: time.Now().Add(-1 * time.Hour),
+			UpdatedAt: time.Now(),
+		},
+		Created:        false,
+		PrimaryChanged: true,
+	}
+	suite.mockService.On("UpsertLink", ctx, mock.AnythingOfType("domain.WalletLink")).
+		Return(expectedResult, nil)
+
+	published := make(chan *domain.WalletLinkedEvent, 1)
+	suite.mockPublisher.On("PublishPrimaryChanged", mock.Anything, mock.AnythingOfType("*domain.WalletLinkedEvent")).
+		Run(func(args mock.Arguments) { published <- args.Get(1).(*domain.WalletLinkedEvent) }).
+		Return(nil)
+
+	resp, err := suite.handler.UpsertLink(ctx, req)
+
+	suite.NoError(err)
+	suite.True(resp.PrimaryChanged)
+	select {
+	case event := <-published:
+		suite.Equal("wallet-789", event.WalletID)
+		suite.True(event.IsPrimary)
+	case <-time.After(time.Second):
+		suite.Fail("primary changed event was not published")
+	}
+	suite.mockPublisher.AssertNotCalled(suite.T(), "PublishWalletLinked", mock.Anything, mock.Anything)
+}
+
+func (suite *WalletGRPCTestSuite) TestRemoveWallet_PublishesUnlinked() {
+	ctx := context.Background()
+	removed := &domain.WalletLink{
+		ID:        "wallet-789",
+		UserID:    "user-123",
+		AccountID: "account-456",
+		Address:   "0x1234567890123456789012345678901234567890",
+		ChainID:   "eip155:1",
+	}
+	suite.mockService.On("RemoveWallet", ctx, "user-123", "wallet-789").Return(removed, nil)
+
+	published := make(chan *domain.WalletUnlinkedEvent, 1)
+	suite.mockPublisher.On("PublishWalletUnlinked", mock.Anything, mock.AnythingOfType("*contracts.WalletUnlinkedEvent")).
+		Run(func(args mock.Arguments) { published <- args.Get(1).(*domain.WalletUnlinkedEvent) }).
+		Return(nil)
+
+	resp, err := suite.handler.RemoveWallet(ctx, &walletpb.RemoveWalletRequest{UserId: "user-123", WalletId: "wallet-789"})
+
+	suite.NoError(err)
+	suite.Equal("wallet-789", resp.Link.Id)
+	select {
+	case event := <-published:
+		suite.Equal("user-123", event.UserID)
+		suite.Equal("account-456", event.AccountID)
+		suite.Equal(removed.Address, event.Address)
+	case <-time.After(time.Second):
+		suite.Fail("wallet unlinked event was not published")
+	}
+}
+
+func (suite *WalletGRPCTestSuite) TestRemoveWallet_Primary() {
+	ctx := context.Background()
+	suite.mockService.On("RemoveWallet", ctx, "user-123", "wallet-1").Return(nil, domain.ErrCannotRemovePrimary)
+
+	_, err := suite.handler.RemoveWallet(ctx, &walletpb.RemoveWalletRequest{UserId: "user-123", WalletId: "wallet-1"})
+
+	suite.Equal(codes.FailedPrecondition, status.Code(err))
+	suite.mockPublisher.AssertNotCalled(suite.T(), "PublishWalletUnlinked", mock.Anything, mock.Anything)
+}
+
+func (suite *WalletGRPCTestSuite) TestSetPrimaryWallet_PublishesPreviousPrimary() {
+	ctx := context.Background()
+	suite.mockService.On("SetPrimaryWallet", ctx, "user-123", "wallet-2").Return(&domain.PrimaryChangeResult{
+		Link:     &domain.WalletLink{ID: "wallet-2", UserID: "user-123", Address: "0x2222222222222222222222222222222222222222", ChainID: "eip155:1", IsPrimary: true},
+		Previous: &domain.WalletLink{ID: "wallet-1", UserID: "user-123", Address: "0x1111111111111111111111111111111111111111", ChainID: "eip155:1"},
+		Changed:  true,
+	}, nil)
+
+	published := make(chan *domain.WalletLinkedEvent, 1)
+	suite.mockPublisher.On("PublishPrimaryChanged", mock.Anything, mock.AnythingOfType("*domain.WalletLinkedEvent")).
+		Run(func(args mock.Arguments) { published <- args.Get(1).(*domain.WalletLinkedEvent) }).
+		Return(nil)
+
+	resp, err := suite.handler.SetPrimaryWallet(ctx, &walletpb.SetPrimaryWalletRequest{UserId: "user-123", WalletId: "wallet-2"})
+
+	suite.NoError(err)
+	suite.True(resp.PrimaryChanged)
+	select {
+	case event := <-published:
+		suite.Equal("wallet-2", event.WalletID)
+		suite.Equal("wallet-1", event.PreviousWalletID)
+		suite.Equal("0x1111111111111111111111111111111111111111", event.PreviousAddress)
+	case <-time.After(time.Second):
+		suite.Fail("primary changed event was not published")
+	}
+}
+
 func (suite *WalletGRPCTestSuite) TestUpsertLink_InvalidRequest() {
 	ctx := context.Background()
 
@@ -406,6 +579,7 @@ func TestDomainToProtoConversion(t *testing.T) {
 	assert.Equal(t, domainLink.Address, protoLink.Address)
 	assert.Equal(t, domainLink.ChainID, protoLink.ChainId)
 	assert.Equal(t, domainLink.IsPrimary, protoLink.IsPrimary)
+	assert.False(t, protoLink.IsWatchOnly)
 	assert.NotNil(t, protoLink.CreatedAt)
 	assert.NotNil(t, protoLink.UpdatedAt)
 	assert.NotNil(t, protoLink.VerifiedAt)
@@ -539,6 +713,42 @@ func TestUpsertLink_ConcurrentRequests(t *testing.T) {
 }
 
 // Error mapping tests
+func TestUpdateWalletDetails_OnlyReplacesTagsWhenAsked(t *testing.T) {
+	ctx := context.Background()
+	label := "cold storage"
+	link := &domain.WalletLink{ID: "wallet-1", UserID: "user-1", Label: label, Tags: []string{"vault"}}
+
+	mockService := new(MockWalletService)
+	mockService.On("UpdateWalletDetails", ctx, "user-1", "wallet-1", domain.WalletDetailsUpdate{Label: &label}).Return(link, nil)
+	mockService.On("UpdateWalletDetails", ctx, "user-1", "wallet-1", domain.WalletDetailsUpdate{Tags: []string{}}).Return(link, nil)
+	handler := grpcHandler.NewWalletGRPCServer(mockService, new(MockEventPublisher))
+
+	resp, err := handler.UpdateWalletDetails(ctx, &walletpb.UpdateWalletDetailsRequest{UserId: "user-1", WalletId: "wallet-1", Label: &label, Tags: []string{"ignored"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vault"}, resp.Link.Tags)
+
+	// Clearing the tags sends an empty list rather than leaving them unchanged
+	_, err = handler.UpdateWalletDetails(ctx, &walletpb.UpdateWalletDetailsRequest{UserId: "user-1", WalletId: "wallet-1", ReplaceTags: true})
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+}
+
+func TestRemoveWallet_WatchOnlyPublishesNoEvent(t *testing.T) {
+	ctx := context.Background()
+	watched := &domain.WalletLink{ID: "wallet-1", UserID: "user-1", Address: "0x1234567890123456789012345678901234567890", ChainID: "eip155:1", IsWatchOnly: true}
+
+	mockService := new(MockWalletService)
+	mockService.On("RemoveWallet", ctx, "user-1", "wallet-1").Return(watched, nil)
+	mockPublisher := new(MockEventPublisher)
+	handler := grpcHandler.NewWalletGRPCServer(mockService, mockPublisher)
+
+	resp, err := handler.RemoveWallet(ctx, &walletpb.RemoveWalletRequest{UserId: "user-1", WalletId: "wallet-1"})
+	assert.NoError(t, err)
+	assert.True(t, resp.Link.IsWatchOnly)
+	time.Sleep(10 * time.Millisecond)
+	mockPublisher.AssertNotCalled(t, "PublishWalletUnlinked", mock.Anything, mock.Anything)
+}
+
 func TestErrorMapping(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -570,6 +780,12 @@ func TestErrorMapping(t *testing.T) {
 			expectedCode: codes.InvalidArgument,
 			expectedMsg:  "invalid_chain_id",
 		},
+		{
+			name:         "watch_only_wallet",
+			serviceError: domain.ErrWatchOnlyWallet,
+			expectedCode: codes.FailedPrecondition,
+			expectedMsg:  "watch_only_wallet",
+		},
 		{
 			name:         "generic_error",
 			serviceError: assert.AnError,
@@ -0,0 +1,12 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/protocheck"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+func TestProtoContract(t *testing.T) {
+	protocheck.Check(t, walletpb.File_wallet_proto)
+}
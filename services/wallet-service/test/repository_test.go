@@ -3,17 +3,23 @@ package test
 import (
 	"context"
 	"database/sql"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
 	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/repository"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/service"
 	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
 	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/testharness"
 )
 
 type WalletRepositoryTestSuite struct {
@@ -432,31 +438,140 @@ func TestWalletRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(WalletRepositoryTestSuite))
 }
 
-// Integration tests with actual database (would require test database setup)
+// Integration tests against Postgres and Redis started by the shared harness
 func TestWalletRepositoryIntegration(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration tests in short mode")
-	}
+	h := testharness.New(t)
+	pg, _ := h.Postgres(t, testharness.Migration("wallet-service"))
+	rds, _ := h.Redis(t)
 
-	// These tests would require actual database connection
-	// You would set up a test database, run migrations, and test real operations
+	repo := repository.NewWalletRepository(pg, rds)
+	svc := service.NewWalletService(repo)
+	ctx := context.Background()
 
 	t.Run("CreateAndRetrieveWallet", func(t *testing.T) {
-		// Test with real database connection
-		t.Skip("Requires test database setup")
+		userID := uuid.NewString()
+		account := testharness.NewSiweAccount(t)
+
+		result, err := svc.UpsertLink(ctx, domain.WalletLink{
+			UserID:    userID,
+			AccountID: account.AccountID(),
+			Address:   account.Address(),
+			ChainID:   "eip155:1",
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Created)
+		assert.True(t, result.Link.IsPrimary, "first wallet on a chain becomes primary")
+
+		links, err := svc.ListLinks(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, result.Link.ID, links[0].ID)
+		assert.Equal(t, account.AccountID(), links[0].Address)
+
+		again, err := svc.UpsertLink(ctx, domain.WalletLink{
+			UserID:    userID,
+			AccountID: account.AccountID(),
+			Address:   account.Address(),
+			ChainID:   "eip155:1",
+		})
+		require.NoError(t, err)
+		assert.False(t, again.Created)
+		assert.Equal(t, result.Link.ID, again.Link.ID)
 	})
 
 	t.Run("PrimaryWalletLogic", func(t *testing.T) {
-		// Test primary wallet promotion/demotion with real database
-		t.Skip("Requires test database setup")
+		userID := uuid.NewString()
+		first := testharness.NewSiweAccount(t)
+		second := testharness.NewSiweAccount(t)
+
+		_, err := svc.UpsertLink(ctx, domain.WalletLink{
+			UserID: userID, AccountID: first.AccountID(), Address: first.Address(), ChainID: "eip155:1",
+		})
+		require.NoError(t, err)
+
+		promoted, err := svc.UpsertLink(ctx, domain.WalletLink{
+			UserID: userID, AccountID: second.AccountID(), Address: second.Address(), ChainID: "eip155:1", IsPrimary: true,
+		})
+		require.NoError(t, err)
+		assert.True(t, promoted.PrimaryChanged)
+
+		links, err := svc.ListLinks(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, links, 2)
+		primaries := 0
+		for _, link := range links {
+			if link.IsPrimary {
+				primaries++
+				assert.Equal(t, promoted.Link.ID, link.ID)
+			}
+		}
+		assert.Equal(t, 1, primaries, "only one primary wallet per user and chain")
+	})
+
+	t.Run("AddressOwnedByAnotherUser", func(t *testing.T) {
+		account := testharness.NewSiweAccount(t)
+
+		_, err := svc.UpsertLink(ctx, domain.WalletLink{
+			UserID: uuid.NewString(), AccountID: account.AccountID(), Address: account.Address(), ChainID: "eip155:1",
+		})
+		require.NoError(t, err)
+
+		_, err = svc.UpsertLink(ctx, domain.WalletLink{
+			UserID: uuid.NewString(), AccountID: account.AccountID(), Address: account.Address(), ChainID: "eip155:1",
+		})
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedAccess)
 	})
 
 	t.Run("ConcurrentWalletOperations", func(t *testing.T) {
-		// Test concurrent wallet operations with advisory locks
-		t.Skip("Requires test database setup")
+		testConcurrentUpserts(t, svc)
 	})
 }
 
+// testConcurrentUpserts links the same wallet from many goroutines; the advisory locks
+// must serialize them into exactly one insert
+func testConcurrentUpserts(t *testing.T, svc domain.WalletService) {
+	userID := uuid.NewString()
+	account := testharness.NewSiweAccount(t)
+	link := domain.WalletLink{
+		UserID:    userID,
+		AccountID: account.AccountID(),
+		Address:   account.Address(),
+		ChainID:   "eip155:1",
+	}
+
+	const workers = 8
+	var (
+		wg      sync.WaitGroup
+		created atomic.Int32
+		errs    = make(chan error, workers)
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := svc.UpsertLink(context.Background(), link)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if result.Created {
+				created.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("concurrent upsert: %v", err)
+	}
+	assert.Equal(t, int32(1), created.Load())
+
+	links, err := svc.ListLinks(context.Background(), userID)
+	require.NoError(t, err)
+	assert.Len(t, links, 1)
+}
+
 // Test helper functions
 func TestHashString(t *testing.T) {
 	// Test that hash generation is consistent
@@ -575,12 +690,11 @@ func TestDatabaseErrors(t *testing.T) {
 
 // Test concurrent operations
 func TestConcurrentWalletOperations(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping concurrent test in short mode")
-	}
+	h := testharness.New(t)
+	pg, _ := h.Postgres(t, testharness.Migration("wallet-service"))
+	rds, _ := h.Redis(t)
 
-	// This would test concurrent wallet operations with proper locking
-	t.Skip("Requires proper concurrent test setup")
+	testConcurrentUpserts(t, service.NewWalletService(repository.NewWalletRepository(pg, rds)))
 }
 
 // Test transaction edge cases
@@ -0,0 +1,226 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/screening"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/service"
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const sanctionedAddress = "0x8589427373d6d84e98730d7795d8f6f8731fda16"
+
+type MockScreeningProvider struct {
+	mock.Mock
+}
+
+func (m *MockScreeningProvider) Name() string { return "mock" }
+
+func (m *MockScreeningProvider) Screen(ctx context.Context, chainID domain.ChainID, address domain.Address) (*domain.ScreeningResult, error) {
+	args := m.Called(ctx, chainID, address)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ScreeningResult), args.Error(1)
+}
+
+type MockScreeningRepository struct {
+	mock.Mock
+}
+
+func (m *MockScreeningRepository) ListUnscreenedSellers(ctx context.Context, chainID domain.ChainID, address domain.Address) ([]*domain.WalletLink, error) {
+	args := m.Called(ctx, chainID, address)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WalletLink), args.Error(1)
+}
+
+func (m *MockScreeningRepository) MarkSaleScreened(ctx context.Context, walletID domain.WalletID, at time.Time) (bool, error) {
+	args := m.Called(ctx, walletID, at)
+	return args.Bool(0), args.Error(1)
+}
+
+// memoryScreeningCache is a ScreeningCache without expiry
+type memoryScreeningCache map[string]*domain.ScreeningResult
+
+func (c memoryScreeningCache) GetScreening(_ context.Context, provider string, chainID domain.ChainID, address domain.Address) (*domain.ScreeningResult, error) {
+	return c[provider+chainID+address], nil
+}
+
+func (c memoryScreeningCache) PutScreening(_ context.Context, result *domain.ScreeningResult, _ time.Duration) error {
+	c[result.Provider+result.ChainID+result.Address] = result
+	return nil
+}
+
+func flaggedResult(chainID, address string) *domain.ScreeningResult {
+	return &domain.ScreeningResult{
+		ChainID: chainID, Address: address, Provider: "mock",
+		Risk: "severe", Flagged: true, Categories: []string{"sanctions"}, ScreenedAt: time.Now(),
+	}
+}
+
+func TestScreenWalletLink_BlockModeRefusesFlaggedAddress(t *testing.T) {
+	ctx := context.Background()
+	provider := new(MockScreeningProvider)
+	publisher := new(MockEventPublisher)
+	screener := service.NewScreeningService(provider, new(MockScreeningRepository), publisher, domain.ScreeningModeBlock)
+	screener.SetCache(memoryScreeningCache{}, time.Hour)
+
+	provider.On("Screen", ctx, "eip155:1", sanctionedAddress).Return(flaggedResult("eip155:1", sanctionedAddress), nil).Once()
+	var flagged *domain.WalletScreeningFlaggedEvent
+	publisher.On("PublishScreeningFlagged", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		flagged = args.Get(1).(*domain.WalletScreeningFlaggedEvent)
+	}).Return(nil).Once()
+
+	// Addresses are screened in their normalized form
+	link := domain.WalletLink{UserID: "user-1", ChainID: "EIP155:1", Address: "0x8589427373D6D84E98730D7795D8f6f8731FDA16"}
+	err := screener.ScreenWalletLink(ctx, link)
+	assert.ErrorIs(t, err, domain.ErrWalletScreeningBlocked)
+	require.NotNil(t, flagged)
+	assert.Equal(t, "user-1", flagged.UserID)
+	assert.Empty(t, flagged.WalletID)
+	assert.Equal(t, domain.ScreeningTriggerWalletLink, flagged.Trigger)
+	assert.True(t, flagged.Blocked)
+
+	// The cached verdict still blocks, without asking the provider or reporting again
+	assert.ErrorIs(t, screener.ScreenWalletLink(ctx, link), domain.ErrWalletScreeningBlocked)
+	provider.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestScreenWalletLink_LogOnlyAndProviderOutageAllowLink(t *testing.T) {
+	ctx := context.Background()
+	provider := new(MockScreeningProvider)
+	publisher := new(MockEventPublisher)
+	screener := service.NewScreeningService(provider, new(MockScreeningRepository), publisher, domain.ScreeningModeLogOnly)
+
+	provider.On("Screen", ctx, "eip155:1", sanctionedAddress).Return(flaggedResult("eip155:1", sanctionedAddress), nil)
+	publisher.On("PublishScreeningFlagged", ctx, mock.MatchedBy(func(e *domain.WalletScreeningFlaggedEvent) bool {
+		return !e.Blocked
+	})).Return(nil)
+	assert.NoError(t, screener.ScreenWalletLink(ctx, domain.WalletLink{UserID: "user-1", ChainID: "eip155:1", Address: sanctionedAddress}))
+
+	provider.On("Screen", ctx, "eip155:1", "0x00000000000000000000000000000000000000aa").Return(nil, errors.New("timeout"))
+	assert.NoError(t, screener.ScreenWalletLink(ctx, domain.WalletLink{UserID: "user-1", ChainID: "eip155:1", Address: "0x00000000000000000000000000000000000000aa"}))
+	publisher.AssertNumberOfCalls(t, "PublishScreeningFlagged", 1)
+}
+
+func TestHandleSaleIndexed_ScreensFirstSaleOnce(t *testing.T) {
+	ctx := context.Background()
+	provider := new(MockScreeningProvider)
+	repo := new(MockScreeningRepository)
+	publisher := new(MockEventPublisher)
+	screener := service.NewScreeningService(provider, repo, publisher, domain.ScreeningModeBlock)
+
+	sale := &contracts.SaleIndexedEvent{
+		EventID: "sale-1",
+		ChainID: "eip155-1",
+		Data:    map[string]interface{}{"seller": "0x8589427373D6D84E98730D7795D8f6f8731FDA16"},
+	}
+	repo.On("ListUnscreenedSellers", ctx, "eip155:1", sanctionedAddress).Return([]*domain.WalletLink{
+		{ID: "wallet-1", UserID: "user-1"},
+		{ID: "wallet-2", UserID: "user-2"},
+	}, nil).Once()
+	provider.On("Screen", ctx, "eip155:1", sanctionedAddress).Return(flaggedResult("eip155:1", sanctionedAddress), nil).Once()
+	repo.On("MarkSaleScreened", ctx, "wallet-1", mock.Anything).Return(true, nil)
+	// Another consumer screened wallet-2 in the meantime
+	repo.On("MarkSaleScreened", ctx, "wallet-2", mock.Anything).Return(false, nil)
+
+	var events []*domain.WalletScreeningFlaggedEvent
+	publisher.On("PublishScreeningFlagged", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		events = append(events, args.Get(1).(*domain.WalletScreeningFlaggedEvent))
+	}).Return(nil)
+
+	require.NoError(t, screener.HandleSaleIndexed(ctx, sale))
+	require.Len(t, events, 1)
+	assert.Equal(t, "wallet-1", events[0].WalletID)
+	assert.Equal(t, domain.ScreeningTriggerFirstSale, events[0].Trigger)
+	assert.False(t, events[0].Blocked, "a settled sale is never blocked")
+
+	// Later sales find no unscreened wallets and skip the provider
+	repo.On("ListUnscreenedSellers", ctx, "eip155:1", sanctionedAddress).Return([]*domain.WalletLink{}, nil)
+	require.NoError(t, screener.HandleSaleIndexed(ctx, sale))
+	provider.AssertExpectations(t)
+}
+
+func TestUpsertLink_ScreeningBlockIsPermissionDenied(t *testing.T) {
+	ctx := context.Background()
+	provider := new(MockScreeningProvider)
+	publisher := new(MockEventPublisher)
+	walletService := new(MockWalletService)
+	screener := service.NewScreeningService(provider, new(MockScreeningRepository), publisher, domain.ScreeningModeBlock)
+	handler := grpcHandler.NewWalletGRPCServer(walletService, publisher).WithScreener(screener)
+
+	provider.On("Screen", ctx, "eip155:1", sanctionedAddress).Return(flaggedResult("eip155:1", sanctionedAddress), nil)
+	publisher.On("PublishScreeningFlagged", ctx, mock.Anything).Return(nil)
+
+	_, err := handler.UpsertLink(ctx, &walletpb.UpsertLinkRequest{
+		UserId: "user-1", AccountId: "account-1", Address: sanctionedAddress, ChainId: "eip155:1",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	walletService.AssertNotCalled(t, "UpsertLink", mock.Anything, mock.Anything)
+}
+
+func TestChainalysisClient_FlagsIdentifiedAddress(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "key", r.Header.Get("X-API-Key"))
+		if r.URL.Path == "/api/v1/address/"+sanctionedAddress {
+			w.Write([]byte(`{"identifications":[{"category":"sanctions","name":"SANCTIONS: OFAC SDN Tornado Cash"}]}`))
+			return
+		}
+		w.Write([]byte(`{"identifications":[]}`))
+	}))
+	defer srv.Close()
+
+	client := screening.NewChainalysisClient(srv.URL, "key")
+	result, err := client.Screen(context.Background(), "eip155:1", sanctionedAddress)
+	require.NoError(t, err)
+	assert.True(t, result.Flagged)
+	assert.Equal(t, "severe", result.Risk)
+	assert.Equal(t, []string{"sanctions"}, result.Categories)
+
+	result, err = client.Screen(context.Background(), "eip155:1", "0x00000000000000000000000000000000000000aa")
+	require.NoError(t, err)
+	assert.False(t, result.Flagged)
+}
+
+func TestTRMClient_FlagsHighRiskExposure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, _, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "key", user)
+		var req []map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "polygon", req[0]["chain"])
+		w.Write([]byte(`[{"address":"` + req[0]["address"] + `","addressRiskIndicators":[
+			{"category":"Gambling","categoryRiskScoreLevelLabel":"Medium"},
+			{"category":"Sanctions","categoryRiskScoreLevelLabel":"Severe"}]}]`))
+	}))
+	defer srv.Close()
+
+	client := screening.NewTRMClient(srv.URL, "key")
+	result, err := client.Screen(context.Background(), "eip155:137", sanctionedAddress)
+	require.NoError(t, err)
+	assert.True(t, result.Flagged)
+	assert.Equal(t, "severe", result.Risk)
+	assert.Equal(t, []string{"Sanctions"}, result.Categories)
+
+	_, err = client.Screen(context.Background(), "eip155:999", sanctionedAddress)
+	assert.Error(t, err, "unsupported chains are not screened")
+}
@@ -2,6 +2,8 @@ package test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -23,6 +25,14 @@ func (m *MockWalletRepository) WithTx(ctx context.Context, fn func(domain.TxWall
 	return args.Error(0)
 }
 
+func (m *MockWalletRepository) ListByUser(ctx context.Context, userID domain.UserID) ([]*domain.WalletLink, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WalletLink), args.Error(1)
+}
+
 // MockTxWalletRepository is a mock implementation of TxWalletRepository
 type MockTxWalletRepository struct {
 	mock.Mock
@@ -78,6 +88,21 @@ func (m *MockTxWalletRepository) UpdateWalletAddressTx(ctx context.Context, id d
 	return args.Get(0).(*domain.WalletLink), args.Error(1)
 }
 
+func (m *MockTxWalletRepository) GetByIDTx(ctx context.Context, id domain.WalletID) (*domain.WalletLink, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*domain.WalletLink), args.Error(1)
+}
+
+func (m *MockTxWalletRepository) UpdateWalletDetailsTx(ctx context.Context, id domain.WalletID, label *string, tags []string) (*domain.WalletLink, error) {
+	args := m.Called(ctx, id, label, tags)
+	return args.Get(0).(*domain.WalletLink), args.Error(1)
+}
+
+func (m *MockTxWalletRepository) DeleteWalletTx(ctx context.Context, id domain.WalletID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 // WalletServiceTestSuite defines the test suite for WalletService
 type WalletServiceTestSuite struct {
 	suite.Suite
@@ -433,6 +458,179 @@ func (suite *WalletServiceTestSuite) TestUpsertLink_AddressUpdate() {
 	mockTxRepo.AssertExpectations(suite.T())
 }
 
+// txWalletRepository runs every transaction against tx and returns its error
+type txWalletRepository struct {
+	MockWalletRepository
+	tx *MockTxWalletRepository
+}
+
+func (r *txWalletRepository) WithTx(ctx context.Context, fn func(domain.TxWalletRepository) error) error {
+	return fn(r.tx)
+}
+
+func txService(tx *MockTxWalletRepository) *service.Service {
+	return service.NewWalletService(&txWalletRepository{tx: tx}).(*service.Service)
+}
+
+func (suite *WalletServiceTestSuite) TestRemoveWallet() {
+	ctx := context.Background()
+	secondary := &domain.WalletLink{ID: "wallet-2", UserID: "user-123", AccountID: "account-2",
+		Address: "0x1234567890123456789012345678901234567890", ChainID: "eip155:1"}
+
+	mockTxRepo := new(MockTxWalletRepository)
+	mockTxRepo.On("GetByIDTx", ctx, "wallet-2").Return(secondary, nil)
+	mockTxRepo.On("DeleteWalletTx", ctx, "wallet-2").Return(nil)
+	walletService := txService(mockTxRepo)
+
+	removed, err := walletService.RemoveWallet(ctx, "user-123", "wallet-2")
+	suite.NoError(err)
+	suite.Equal(secondary, removed)
+	mockTxRepo.AssertExpectations(suite.T())
+}
+
+func (suite *WalletServiceTestSuite) TestRemoveWallet_KeepsPrimaryAndOtherUsersWallets() {
+	ctx := context.Background()
+	mockTxRepo := new(MockTxWalletRepository)
+	mockTxRepo.On("GetByIDTx", ctx, "wallet-1").Return(&domain.WalletLink{ID: "wallet-1", UserID: "user-123", IsPrimary: true}, nil)
+	mockTxRepo.On("GetByIDTx", ctx, "wallet-9").Return(&domain.WalletLink{ID: "wallet-9", UserID: "user-999"}, nil)
+	walletService := txService(mockTxRepo)
+
+	_, err := walletService.RemoveWallet(ctx, "user-123", "wallet-1")
+	suite.ErrorIs(err, domain.ErrCannotRemovePrimary)
+
+	_, err = walletService.RemoveWallet(ctx, "user-123", "wallet-9")
+	suite.ErrorIs(err, domain.ErrWalletNotFound)
+	mockTxRepo.AssertNotCalled(suite.T(), "DeleteWalletTx", mock.Anything, mock.Anything)
+}
+
+func (suite *WalletServiceTestSuite) TestSetPrimaryWallet_ReportsPreviousPrimary() {
+	ctx := context.Background()
+	previous := &domain.WalletLink{ID: "wallet-1", UserID: "user-123", ChainID: "eip155:1", IsPrimary: true}
+	target := &domain.WalletLink{ID: "wallet-2", UserID: "user-123", ChainID: "eip155:1"}
+	promoted := &domain.WalletLink{ID: "wallet-2", UserID: "user-123", ChainID: "eip155:1", IsPrimary: true}
+
+	mockTxRepo := new(MockTxWalletRepository)
+	mockTxRepo.On("GetByIDTx", ctx, "wallet-2").Return(target, nil)
+	mockTxRepo.On("GetPrimaryByUserChainTx", ctx, "user-123", "eip155:1").Return(previous, nil)
+	mockTxRepo.On("DemoteOtherPrimariesTx", ctx, "user-123", "eip155:1", "wallet-2").Return(nil)
+	isPrimary := true
+	mockTxRepo.On("UpdateWalletMetaTx", ctx, "wallet-2", &isPrimary, (*time.Time)(nil), (*time.Time)(nil), (*string)(nil)).Return(promoted, nil)
+	walletService := txService(mockTxRepo)
+
+	result, err := walletService.SetPrimaryWallet(ctx, "user-123", "wallet-2")
+	suite.NoError(err)
+	suite.True(result.Changed)
+	suite.Equal(promoted, result.Link)
+	suite.Equal(previous, result.Previous)
+	mockTxRepo.AssertExpectations(suite.T())
+}
+
+func (suite *WalletServiceTestSuite) TestSetPrimaryWallet_AlreadyPrimary() {
+	ctx := context.Background()
+	primary := &domain.WalletLink{ID: "wallet-1", UserID: "user-123", ChainID: "eip155:1", IsPrimary: true}
+
+	mockTxRepo := new(MockTxWalletRepository)
+	mockTxRepo.On("GetByIDTx", ctx, "wallet-1").Return(primary, nil)
+	walletService := txService(mockTxRepo)
+
+	result, err := walletService.SetPrimaryWallet(ctx, "user-123", "wallet-1")
+	suite.NoError(err)
+	suite.False(result.Changed)
+	mockTxRepo.AssertNotCalled(suite.T(), "UpdateWalletMetaTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *WalletServiceTestSuite) TestSetPrimaryWallet_RejectsWatchOnly() {
+	ctx := context.Background()
+	mockTxRepo := new(MockTxWalletRepository)
+	mockTxRepo.On("GetByIDTx", ctx, "wallet-3").Return(&domain.WalletLink{ID: "wallet-3", UserID: "user-123", ChainID: "eip155:1", IsWatchOnly: true}, nil)
+	walletService := txService(mockTxRepo)
+
+	_, err := walletService.SetPrimaryWallet(ctx, "user-123", "wallet-3")
+	suite.ErrorIs(err, domain.ErrWatchOnlyWallet)
+	mockTxRepo.AssertNotCalled(suite.T(), "DemoteOtherPrimariesTx", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *WalletServiceTestSuite) TestAddWatchOnlyWallet() {
+	ctx := context.Background()
+	want := domain.WalletLink{
+		UserID:      "user-123",
+		Address:     "0xabcdef0123456789abcdef0123456789abcdef01",
+		ChainID:     "eip155:1",
+		Label:       "Vault",
+		Tags:        []string{"cold", "long-term"},
+		IsWatchOnly: true,
+	}
+	stored := want
+	stored.ID = "wallet-4"
+
+	mockTxRepo := new(MockTxWalletRepository)
+	mockTxRepo.On("AcquireAddressLock", ctx, want.ChainID, want.Address).Return(nil)
+	mockTxRepo.On("InsertWalletTx", ctx, want).Return(&stored, nil)
+	walletService := txService(mockTxRepo)
+
+	// Primary and the account are ignored; tags are lowercased and deduped
+	added, err := walletService.AddWatchOnlyWallet(ctx, domain.WalletLink{
+		UserID:    "user-123",
+		AccountID: "account-1",
+		Address:   "0xABCDEF0123456789abcdef0123456789abcdef01",
+		ChainID:   "eip155:1",
+		IsPrimary: true,
+		Label:     " Vault ",
+		Tags:      []string{"Cold", "long-term", "cold", " "},
+	})
+	suite.NoError(err)
+	suite.Equal(&stored, added)
+	mockTxRepo.AssertExpectations(suite.T())
+}
+
+func (suite *WalletServiceTestSuite) TestAddWatchOnlyWallet_AlreadyTracked() {
+	ctx := context.Background()
+	mockTxRepo := new(MockTxWalletRepository)
+	mockTxRepo.On("AcquireAddressLock", ctx, mock.Anything, mock.Anything).Return(nil)
+	mockTxRepo.On("InsertWalletTx", ctx, mock.Anything).Return((*domain.WalletLink)(nil), domain.ErrWalletAlreadyExists)
+	walletService := txService(mockTxRepo)
+
+	_, err := walletService.AddWatchOnlyWallet(ctx, domain.WalletLink{UserID: "user-123", Address: "0x1234567890123456789012345678901234567890", ChainID: "eip155:1"})
+	suite.ErrorIs(err, domain.ErrWalletAlreadyExists)
+}
+
+func (suite *WalletServiceTestSuite) TestUpdateWalletDetails() {
+	ctx := context.Background()
+	link := &domain.WalletLink{ID: "wallet-2", UserID: "user-123"}
+	label := "Trading"
+	updated := &domain.WalletLink{ID: "wallet-2", UserID: "user-123", Label: label, Tags: []string{"hot"}}
+
+	mockTxRepo := new(MockTxWalletRepository)
+	mockTxRepo.On("GetByIDTx", ctx, "wallet-2").Return(link, nil)
+	mockTxRepo.On("GetByIDTx", ctx, "wallet-9").Return(&domain.WalletLink{ID: "wallet-9", UserID: "user-999"}, nil)
+	mockTxRepo.On("UpdateWalletDetailsTx", ctx, "wallet-2", &label, []string{"hot"}).Return(updated, nil)
+	walletService := txService(mockTxRepo)
+
+	got, err := walletService.UpdateWalletDetails(ctx, "user-123", "wallet-2", domain.WalletDetailsUpdate{Label: &label, Tags: []string{"HOT", "hot"}})
+	suite.NoError(err)
+	suite.Equal(updated, got)
+
+	_, err = walletService.UpdateWalletDetails(ctx, "user-123", "wallet-9", domain.WalletDetailsUpdate{Label: &label})
+	suite.ErrorIs(err, domain.ErrWalletNotFound)
+	mockTxRepo.AssertExpectations(suite.T())
+}
+
+func (suite *WalletServiceTestSuite) TestUpdateWalletDetails_RejectsOversizedInput() {
+	ctx := context.Background()
+	walletService := txService(new(MockTxWalletRepository))
+
+	long := strings.Repeat("x", domain.MaxWalletLabelLength+1)
+	_, err := walletService.UpdateWalletDetails(ctx, "user-123", "wallet-2", domain.WalletDetailsUpdate{Label: &long})
+	suite.ErrorIs(err, domain.ErrInvalidWalletLabel)
+
+	tags := make([]string, domain.MaxWalletTags+1)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("tag-%d", i)
+	}
+	_, err = walletService.UpdateWalletDetails(ctx, "user-123", "wallet-2", domain.WalletDetailsUpdate{Tags: tags})
+	suite.ErrorIs(err, domain.ErrInvalidWalletTags)
+}
+
 func TestWalletServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(WalletServiceTestSuite))
 }
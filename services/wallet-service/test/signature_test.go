@@ -0,0 +1,269 @@
+package test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/domain"
+	grpcHandler "github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/infrastructure/grpc"
+	"github.com/quangdang46/NFT-Marketplace/services/wallet-service/internal/service"
+	walletpb "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+const safeAddress = "0x41675c099f32341bf84bfc5382af534df5c7461a"
+
+// memoryChallenges is a SignatureChallengeStore without expiry
+type memoryChallenges struct {
+	mu         sync.Mutex
+	challenges map[string]*domain.SignatureChallenge
+}
+
+func newMemoryChallenges() *memoryChallenges {
+	return &memoryChallenges{challenges: make(map[string]*domain.SignatureChallenge)}
+}
+
+func (m *memoryChallenges) SaveChallenge(_ context.Context, challenge *domain.SignatureChallenge) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.challenges[challenge.ID] = challenge
+	return nil
+}
+
+func (m *memoryChallenges) TakeChallenge(_ context.Context, id string) (*domain.SignatureChallenge, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	challenge, ok := m.challenges[id]
+	if !ok {
+		return nil, domain.ErrChallengeNotFound
+	}
+	delete(m.challenges, id)
+	return challenge, nil
+}
+
+// fakeContractWallet accepts the signatures its owner key makes of a digest, like a 1-of-1 Safe
+type fakeContractWallet struct {
+	contract domain.Address
+	owner    *ecdsa.PrivateKey
+	err      error
+	calls    int
+}
+
+func (f *fakeContractWallet) IsValidSignature(_ context.Context, _ domain.ChainID, contract domain.Address, digest [32]byte, signature []byte) (bool, error) {
+	f.calls++
+	if f.err != nil {
+		return false, f.err
+	}
+	if contract != f.contract {
+		return false, nil
+	}
+	expected, err := crypto.Sign(digest[:], f.owner)
+	if err != nil {
+		return false, err
+	}
+	return hexutil.Encode(expected) == hexutil.Encode(signature), nil
+}
+
+func signChallenge(t *testing.T, key *ecdsa.PrivateKey, challenge *domain.SignatureChallenge) string {
+	t.Helper()
+	digest, err := service.ChallengeDigest(challenge)
+	require.NoError(t, err)
+	sig, err := crypto.Sign(digest[:], key)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27 // as wallets return it
+	return hexutil.Encode(sig)
+}
+
+func newSigner(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return key, strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())
+}
+
+func TestSignatureChallenge_EIP191SignedOnce(t *testing.T) {
+	ctx := context.Background()
+	key, address := newSigner(t)
+	svc := service.NewSignatureService(newMemoryChallenges(), nil)
+
+	challenge, err := svc.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+		Purpose:  "collection_import",
+		Address:  strings.ToUpper(address[:2]) + address[2:],
+		ChainID:  "EIP155:1",
+		UserID:   "user-1",
+		Resource: "eip155:1/0xabc",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.SignatureSchemeEIP191, challenge.Scheme)
+	assert.Equal(t, "eip155:1", challenge.ChainID)
+	assert.Contains(t, challenge.Message, "Purpose: collection_import")
+	assert.Contains(t, challenge.Message, "Resource: eip155:1/0xabc")
+	assert.Contains(t, challenge.Message, "User: user-1")
+	assert.Equal(t, domain.DefaultSignatureChallengeTTL, challenge.ExpiresAt.Sub(challenge.IssuedAt))
+
+	signature := signChallenge(t, key, challenge)
+	proof, err := svc.VerifyChallenge(ctx, challenge.ID, signature, "collection_import", "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.SignatureMethodEOA, proof.Method)
+	assert.Equal(t, address, proof.Challenge.Address)
+
+	// The challenge is used up, so the same signature cannot be replayed
+	_, err = svc.VerifyChallenge(ctx, challenge.ID, signature, "collection_import", "user-1")
+	assert.ErrorIs(t, err, domain.ErrChallengeNotFound)
+}
+
+func TestSignatureChallenge_ScopedToPurposeAndUser(t *testing.T) {
+	ctx := context.Background()
+	key, address := newSigner(t)
+	svc := service.NewSignatureService(newMemoryChallenges(), nil)
+
+	create := func() *domain.SignatureChallenge {
+		challenge, err := svc.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+			Purpose: "token_gate", Address: address, ChainID: "eip155:1", UserID: "user-1",
+		})
+		require.NoError(t, err)
+		return challenge
+	}
+
+	challenge := create()
+	_, err := svc.VerifyChallenge(ctx, challenge.ID, signChallenge(t, key, challenge), "collection_import", "user-1")
+	assert.ErrorIs(t, err, domain.ErrChallengeMismatch)
+	// A failed attempt uses the challenge up too
+	_, err = svc.VerifyChallenge(ctx, challenge.ID, signChallenge(t, key, challenge), "token_gate", "user-1")
+	assert.ErrorIs(t, err, domain.ErrChallengeNotFound)
+
+	challenge = create()
+	_, err = svc.VerifyChallenge(ctx, challenge.ID, signChallenge(t, key, challenge), "token_gate", "user-2")
+	assert.ErrorIs(t, err, domain.ErrChallengeMismatch)
+
+	other, _ := newSigner(t)
+	challenge = create()
+	_, err = svc.VerifyChallenge(ctx, challenge.ID, signChallenge(t, other, challenge), "token_gate", "user-1")
+	assert.ErrorIs(t, err, domain.ErrInvalidSignature)
+}
+
+func TestSignatureChallenge_EIP712(t *testing.T) {
+	ctx := context.Background()
+	key, address := newSigner(t)
+	svc := service.NewSignatureService(newMemoryChallenges(), nil)
+
+	challenge, err := svc.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+		Purpose: "token_gate", Address: address, ChainID: "eip155:137", Scheme: domain.SignatureSchemeEIP712,
+	})
+	require.NoError(t, err)
+
+	var typedData map[string]any
+	require.NoError(t, json.Unmarshal([]byte(challenge.Message), &typedData))
+	assert.Equal(t, "SignatureChallenge", typedData["primaryType"])
+	assert.Equal(t, address, typedData["message"].(map[string]any)["address"])
+
+	proof, err := svc.VerifyChallenge(ctx, challenge.ID, signChallenge(t, key, challenge), "token_gate", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.SignatureMethodEOA, proof.Method)
+
+	// The typed data domain needs a numeric EVM chain id
+	_, err = svc.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+		Purpose: "token_gate", Address: address, ChainID: "solana:101", Scheme: domain.SignatureSchemeEIP712,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidChallenge)
+}
+
+func TestSignatureChallenge_EIP1271ContractWallet(t *testing.T) {
+	ctx := context.Background()
+	owner, _ := newSigner(t)
+	wallet := &fakeContractWallet{contract: safeAddress, owner: owner}
+	svc := service.NewSignatureService(newMemoryChallenges(), wallet)
+
+	challenge, err := svc.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+		Purpose: "collection_import", Address: safeAddress, ChainID: "eip155:1",
+	})
+	require.NoError(t, err)
+
+	// The owner's key recovers to the owner, not the Safe, so the Safe is asked
+	digest, err := service.ChallengeDigest(challenge)
+	require.NoError(t, err)
+	sig, err := crypto.Sign(digest[:], owner)
+	require.NoError(t, err)
+
+	proof, err := svc.VerifyChallenge(ctx, challenge.ID, hexutil.Encode(sig), "collection_import", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.SignatureMethodEIP1271, proof.Method)
+	assert.Equal(t, 1, wallet.calls)
+
+	// Without a contract verifier only EOAs can prove an address
+	eoaOnly := service.NewSignatureService(newMemoryChallenges(), nil)
+	challenge, err = eoaOnly.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+		Purpose: "collection_import", Address: safeAddress, ChainID: "eip155:1",
+	})
+	require.NoError(t, err)
+	_, err = eoaOnly.VerifyChallenge(ctx, challenge.ID, signChallenge(t, owner, challenge), "collection_import", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidSignature)
+}
+
+func TestSignatureChallenge_GivenMessageSignedAsIs(t *testing.T) {
+	ctx := context.Background()
+	key, address := newSigner(t)
+	svc := service.NewSignatureService(newMemoryChallenges(), nil)
+
+	message := "example.com wants you to sign in with your Ethereum account:\n" + address
+	challenge, err := svc.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+		Purpose: "siwe_login", Address: address, ChainID: "eip155:1", Message: message,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, message, challenge.Message)
+
+	_, err = svc.VerifyChallenge(ctx, challenge.ID, signChallenge(t, key, challenge), "siwe_login", "")
+	require.NoError(t, err)
+
+	_, err = svc.CreateChallenge(ctx, domain.SignatureChallengeRequest{
+		Purpose: "siwe_login", Address: address, ChainID: "eip155:1", Message: message, Scheme: domain.SignatureSchemeEIP712,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidChallenge)
+}
+
+func TestSignatureChallenge_GRPCErrors(t *testing.T) {
+	ctx := context.Background()
+	owner, _ := newSigner(t)
+	wallet := &fakeContractWallet{contract: safeAddress, owner: owner, err: errors.New("rpc down")}
+	server := grpcHandler.NewWalletGRPCServer(nil, nil).
+		WithSignatures(service.NewSignatureService(newMemoryChallenges(), wallet))
+
+	_, err := server.CreateSignatureChallenge(ctx, &walletpb.CreateSignatureChallengeRequest{
+		Purpose: "Token Gate", Address: safeAddress, ChainId: "eip155:1",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	created, err := server.CreateSignatureChallenge(ctx, &walletpb.CreateSignatureChallengeRequest{
+		Purpose: "token_gate", Address: safeAddress, ChainId: "eip155:1", Scheme: walletpb.SignatureScheme_SIGNATURE_SCHEME_EIP712,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, walletpb.SignatureScheme_SIGNATURE_SCHEME_EIP712, created.Scheme)
+
+	// A contract wallet that can't be asked is an outage, not a bad signature
+	_, err = server.VerifySignatureChallenge(ctx, &walletpb.VerifySignatureChallengeRequest{
+		ChallengeId: created.ChallengeId, Signature: "0x1234", Purpose: "token_gate",
+	})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = server.VerifySignatureChallenge(ctx, &walletpb.VerifySignatureChallengeRequest{
+		ChallengeId: created.ChallengeId, Signature: "0x1234", Purpose: "token_gate",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	disabled := grpcHandler.NewWalletGRPCServer(nil, nil)
+	_, err = disabled.CreateSignatureChallenge(ctx, &walletpb.CreateSignatureChallengeRequest{
+		Purpose: "token_gate", Address: safeAddress, ChainId: "eip155:1",
+	})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
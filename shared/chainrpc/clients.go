@@ -0,0 +1,77 @@
+// Package chainrpc dials chains over the RPC endpoints the chain registry lists, for services
+// that read contract state directly
+package chainrpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+// Clients keeps one client per chain, dialed over the endpoints the chain registry lists
+type Clients struct {
+	registry protoChainRegistry.ChainRegistryServiceClient
+
+	mu      sync.Mutex
+	clients map[string]*ethclient.Client
+}
+
+func NewClients(registry protoChainRegistry.ChainRegistryServiceClient) *Clients {
+	return &Clients{
+		registry: registry,
+		clients:  make(map[string]*ethclient.Client),
+	}
+}
+
+// Client dials the first active endpoint that answers and keeps it for the chain
+func (c *Clients) Client(ctx context.Context, chainID string) (*ethclient.Client, error) {
+	c.mu.Lock()
+	client, ok := c.clients[chainID]
+	c.mu.Unlock()
+	if ok {
+		return client, nil
+	}
+
+	resp, err := c.registry.GetRpcEndpoints(ctx, &protoChainRegistry.GetRpcEndpointsRequest{ChainId: chainID})
+	if err != nil {
+		return nil, fmt.Errorf("get rpc endpoints for %s: %w", chainID, err)
+	}
+
+	lastErr := fmt.Errorf("no active rpc endpoints registered for chain %s", chainID)
+	// Endpoints arrive ordered by priority, then weight
+	for _, endpoint := range resp.GetEndpoints() {
+		if !endpoint.GetActive() || endpoint.GetUrl() == "" {
+			continue
+		}
+		client, err := ethclient.DialContext(ctx, endpoint.GetUrl())
+		if err != nil {
+			lastErr = fmt.Errorf("dial rpc for %s: %w", chainID, err)
+			continue
+		}
+
+		c.mu.Lock()
+		if existing, ok := c.clients[chainID]; ok {
+			c.mu.Unlock()
+			client.Close()
+			return existing, nil
+		}
+		c.clients[chainID] = client
+		c.mu.Unlock()
+		return client, nil
+	}
+	return nil, lastErr
+}
+
+// Drop forgets a client after an RPC failure so the next lookup re-reads the registry.
+// The client is left open because other goroutines may still be using it for an in-flight call
+func (c *Clients) Drop(chainID string, client *ethclient.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.clients[chainID] == client {
+		delete(c.clients, chainID)
+	}
+}
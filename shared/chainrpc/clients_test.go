@@ -0,0 +1,77 @@
+package chainrpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+)
+
+type fakeRegistry struct {
+	protoChainRegistry.ChainRegistryServiceClient
+	endpoints []*protoChainRegistry.RpcEndpoint
+	err       error
+	calls     int
+}
+
+func (f *fakeRegistry) GetRpcEndpoints(ctx context.Context, in *protoChainRegistry.GetRpcEndpointsRequest, opts ...grpc.CallOption) (*protoChainRegistry.GetRpcEndpointsResponse, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &protoChainRegistry.GetRpcEndpointsResponse{Endpoints: f.endpoints}, nil
+}
+
+func TestClients_KeepsClientUntilDropped(t *testing.T) {
+	registry := &fakeRegistry{endpoints: []*protoChainRegistry.RpcEndpoint{
+		{Url: "http://inactive.invalid", Active: false},
+		{Url: "http://127.0.0.1:1", Active: true},
+	}}
+	clients := NewClients(registry)
+
+	first, err := clients.Client(context.Background(), "eip155:1")
+	if err != nil {
+		t.Fatalf("Client: %v", err)
+	}
+	second, err := clients.Client(context.Background(), "eip155:1")
+	if err != nil {
+		t.Fatalf("Client: %v", err)
+	}
+	if first != second || registry.calls != 1 {
+		t.Fatalf("expected the cached client and one registry call, got calls=%d", registry.calls)
+	}
+
+	clients.Drop("eip155:1", first)
+	third, err := clients.Client(context.Background(), "eip155:1")
+	if err != nil {
+		t.Fatalf("Client after Drop: %v", err)
+	}
+	if third == first || registry.calls != 2 {
+		t.Fatalf("expected a fresh client after Drop, got calls=%d", registry.calls)
+	}
+	clients.Drop("eip155:1", third)
+}
+
+func TestClients_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		registry *fakeRegistry
+		want     string
+	}{
+		{"registry error", &fakeRegistry{err: errors.New("unavailable")}, "get rpc endpoints for eip155:1"},
+		{"no active endpoint", &fakeRegistry{endpoints: []*protoChainRegistry.RpcEndpoint{{Url: "http://127.0.0.1:1"}}}, "no active rpc endpoints"},
+		{"undialable endpoint", &fakeRegistry{endpoints: []*protoChainRegistry.RpcEndpoint{{Url: "ftp://127.0.0.1:1", Active: true}}}, "dial rpc for eip155:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClients(tt.registry).Client(context.Background(), "eip155:1")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
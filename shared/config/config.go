@@ -0,0 +1,138 @@
+/*
+Package config reloads runtime settings without a restart. A Source holds one JSON
+document whose top-level keys are sections, such as "rate_limit" or "log"; services
+subscribe only to the sections they use and are called back when those change, while
+everything else keeps coming from the environment at boot. The source is optional:
+without RUNTIME_CONFIG_FILE or RUNTIME_CONFIG_CONSUL_KEY services run on env alone.
+*/
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Source is where runtime config is read from
+type Source interface {
+	// Read returns the current document; an empty document has no sections
+	Read(ctx context.Context) ([]byte, error)
+	// Watch calls changed whenever the document may have changed, until ctx is done
+	Watch(ctx context.Context, changed func()) error
+}
+
+// Reloader reads a Source and calls each section's subscribers when that section changes
+type Reloader struct {
+	source Source
+
+	mu       sync.Mutex
+	sections map[string][]byte
+	handlers map[string][]func(json.RawMessage)
+}
+
+// NewReloader creates a reloader; nothing is read until Start
+func NewReloader(source Source) *Reloader {
+	return &Reloader{
+		source:   source,
+		sections: make(map[string][]byte),
+		handlers: make(map[string][]func(json.RawMessage)),
+	}
+}
+
+// Subscribe calls apply with the section's JSON every time it changes, and right away when
+// it has already been read. A section removed from the document is not reported: services
+// keep their last settings.
+func (r *Reloader) Subscribe(section string, apply func(json.RawMessage)) {
+	r.mu.Lock()
+	r.handlers[section] = append(r.handlers[section], apply)
+	current, ok := r.sections[section]
+	r.mu.Unlock()
+
+	if ok {
+		apply(current)
+	}
+}
+
+// OnChange decodes a section over base, so fields it leaves out keep their boot values,
+// and passes the result to apply. A section that does not decode is logged and skipped.
+func OnChange[T any](r *Reloader, section string, base T, apply func(T)) {
+	r.Subscribe(section, func(raw json.RawMessage) {
+		value := base
+		if err := json.Unmarshal(raw, &value); err != nil {
+			log.Printf("config: ignoring invalid %q section: %v", section, err)
+			return
+		}
+		apply(value)
+	})
+}
+
+// Start applies the current document and then reloads it on every change until ctx is
+// done. It fails only when the first read does.
+func (r *Reloader) Start(ctx context.Context) error {
+	if err := r.reload(ctx); err != nil {
+		return err
+	}
+	go func() {
+		err := r.source.Watch(ctx, func() {
+			if err := r.reload(ctx); err != nil {
+				log.Printf("config: reload failed, keeping current settings: %v", err)
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			log.Printf("config: watch stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+func (r *Reloader) reload(ctx context.Context) error {
+	data, err := r.source.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read runtime config: %w", err)
+	}
+	sections := map[string]json.RawMessage{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &sections); err != nil {
+			return fmt.Errorf("failed to parse runtime config: %w", err)
+		}
+	}
+
+	type call struct {
+		apply func(json.RawMessage)
+		raw   json.RawMessage
+	}
+	var calls []call
+	var changed []string
+
+	r.mu.Lock()
+	for name, raw := range sections {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, raw); err != nil {
+			continue
+		}
+		if previous, ok := r.sections[name]; ok && bytes.Equal(previous, compact.Bytes()) {
+			continue
+		}
+		r.sections[name] = compact.Bytes()
+		changed = append(changed, name)
+		for _, apply := range r.handlers[name] {
+			calls = append(calls, call{apply: apply, raw: compact.Bytes()})
+		}
+	}
+	r.mu.Unlock()
+
+	if len(changed) > 0 {
+		sort.Strings(changed)
+		log.Printf("config: loaded sections %s", strings.Join(changed, ", "))
+	}
+	// Subscribers run outside the lock so they may subscribe or read other settings
+	for _, c := range calls {
+		c.apply(c.raw)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// consulWait is how long one blocking query waits for the key to change
+const consulWait = 5 * time.Minute
+
+// ConsulSource reads runtime config from a Consul KV key and watches it with blocking
+// queries, so changes arrive as soon as the key is written
+type ConsulSource struct {
+	addr   string
+	key    string
+	token  string
+	client *http.Client
+}
+
+// NewConsulSource reads key from the Consul agent at addr; token may be empty
+func NewConsulSource(addr, key, token string) *ConsulSource {
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	return &ConsulSource{
+		addr:   strings.TrimRight(addr, "/"),
+		key:    strings.Trim(key, "/"),
+		token:  token,
+		client: &http.Client{Timeout: consulWait + 30*time.Second},
+	}
+}
+
+func (c *ConsulSource) Read(ctx context.Context) ([]byte, error) {
+	body, _, err := c.get(ctx, 0)
+	return body, err
+}
+
+func (c *ConsulSource) Watch(ctx context.Context, changed func()) error {
+	var index uint64
+	for {
+		_, next, err := c.get(ctx, index)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			log.Printf("config: consul watch of %s failed, retrying: %v", c.key, err)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(5 * time.Second):
+			}
+			continue
+		}
+
+		if index != 0 && next != index {
+			changed()
+		}
+		// Consul may reset its index, after which blocking restarts from scratch
+		if next < index {
+			next = 0
+		}
+		index = next
+	}
+}
+
+// get fetches the key's raw value, blocking until the key moves past index when it is set.
+// A missing key reads as an empty document.
+func (c *ConsulSource) get(ctx context.Context, index uint64) ([]byte, uint64, error) {
+	query := url.Values{}
+	query.Set("raw", "")
+	if index > 0 {
+		query.Set("index", strconv.FormatUint(index, 10))
+		query.Set("wait", fmt.Sprintf("%ds", int(consulWait.Seconds())))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.addr+"/v1/kv/"+c.key+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	next, _ := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		return body, next, err
+	case http.StatusNotFound:
+		return nil, next, nil
+	default:
+		return nil, 0, fmt.Errorf("consul returned %s for key %s", resp.Status, c.key)
+	}
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/env"
+)
+
+// LoadSource picks the runtime config source from the environment: RUNTIME_CONFIG_FILE,
+// else the Consul key RUNTIME_CONFIG_CONSUL_KEY at CONSUL_HTTP_ADDR. It returns nil when
+// neither is set.
+func LoadSource() Source {
+	if path := env.GetString("RUNTIME_CONFIG_FILE", ""); path != "" {
+		interval := time.Duration(env.GetInt("RUNTIME_CONFIG_POLL_MS", 0)) * time.Millisecond
+		return NewFileSource(path, interval)
+	}
+	if key := env.GetString("RUNTIME_CONFIG_CONSUL_KEY", ""); key != "" {
+		return NewConsulSource(
+			env.GetString("CONSUL_HTTP_ADDR", "127.0.0.1:8500"),
+			key,
+			env.GetString("CONSUL_HTTP_TOKEN", ""),
+		)
+	}
+	return nil
+}
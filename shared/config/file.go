@@ -0,0 +1,73 @@
+package config
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultPollInterval is how often a FileSource checks its file
+const DefaultPollInterval = 2 * time.Second
+
+// FileSource reads runtime config from a JSON file. Changes are noticed by polling the
+// file's modification time and size, which also catches mounted ConfigMaps that are
+// updated by swapping a symlink.
+type FileSource struct {
+	path     string
+	interval time.Duration
+
+	mu   sync.Mutex
+	read os.FileInfo // the file as of the last successful Read
+}
+
+// NewFileSource watches path every interval; interval <= 0 uses DefaultPollInterval
+func NewFileSource(path string, interval time.Duration) *FileSource {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return &FileSource{path: path, interval: interval}
+}
+
+func (f *FileSource) Read(ctx context.Context) ([]byte, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	f.read = info
+	f.mu.Unlock()
+	return data, nil
+}
+
+// Watch reports the file as changed until a Read catches up with it, so a file that could
+// not be read is retried on the next poll
+func (f *FileSource) Watch(ctx context.Context, changed func()) error {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		// A missing file keeps the last settings until it is back
+		info, err := os.Stat(f.path)
+		if err != nil {
+			continue
+		}
+		f.mu.Lock()
+		last := f.read
+		f.mu.Unlock()
+		if last == nil || !info.ModTime().Equal(last.ModTime()) || info.Size() != last.Size() {
+			changed()
+		}
+	}
+}
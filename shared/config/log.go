@@ -0,0 +1,31 @@
+package config
+
+import (
+	"log"
+	"log/slog"
+	"strings"
+)
+
+// LogSection is the section every service reads its log level from, e.g. {"level": "debug"}
+const LogSection = "log"
+
+// LogSettings is the log section
+type LogSettings struct {
+	Level string `json:"level"`
+}
+
+// WatchLogLevel applies the log section's level to slog's default logger
+func WatchLogLevel(r *Reloader) {
+	OnChange(r, LogSection, LogSettings{}, func(s LogSettings) {
+		if s.Level == "" {
+			return
+		}
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(strings.TrimSpace(s.Level))); err != nil {
+			log.Printf("config: ignoring log level %q: %v", s.Level, err)
+			return
+		}
+		slog.SetLogLoggerLevel(level)
+		log.Printf("config: log level set to %s", level)
+	})
+}
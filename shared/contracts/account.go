@@ -0,0 +1,51 @@
+package contracts
+
+import "time"
+
+// Account event types streamed to the user they belong to
+const (
+	AccountEventWalletLinked   = "wallet_linked"
+	AccountEventWalletUnlinked = "wallet_unlinked"
+	AccountEventPrimaryChanged = "primary_changed"
+	AccountEventProfileUpdated = "profile_updated"
+	AccountEventSessionRevoked = "session_revoked"
+	AccountEventSessionEvicted = "session_evicted"
+	AccountEventExportReady    = "export_ready"
+)
+
+// AccountEvent is a wallet, auth, profile or export event addressed to one user. Data is the
+// published payload as-is, so it always carries user_id alongside the event fields.
+type AccountEvent struct {
+	Type       string                 `json:"type"`
+	UserID     string                 `json:"user_id"`
+	Data       map[string]interface{} `json:"data"`
+	OccurredAt time.Time              `json:"occurred_at"`
+}
+
+// AccountEventBinding ties a published routing key to the account event type it becomes
+type AccountEventBinding struct {
+	Exchange   string
+	RoutingKey string
+	Type       string
+}
+
+// AccountEventBindings lists every event relayed to users' account event streams
+var AccountEventBindings = []AccountEventBinding{
+	{Exchange: WalletsExchange, RoutingKey: WalletLinkedKey, Type: AccountEventWalletLinked},
+	{Exchange: WalletsExchange, RoutingKey: WalletUnlinkedKey, Type: AccountEventWalletUnlinked},
+	{Exchange: WalletsExchange, RoutingKey: WalletPrimaryChangedKey, Type: AccountEventPrimaryChanged},
+	{Exchange: UsersExchange, RoutingKey: UserProfileUpdatedKey, Type: AccountEventProfileUpdated},
+	{Exchange: AuthExchange, RoutingKey: SessionRevokedKey, Type: AccountEventSessionRevoked},
+	{Exchange: AuthExchange, RoutingKey: SessionEvictedKey, Type: AccountEventSessionEvicted},
+	{Exchange: CollectionsExchange, RoutingKey: CollectionExportReadyKey, Type: AccountEventExportReady},
+}
+
+// AccountEventType returns the account event type for a delivery, empty if it is not one
+func AccountEventType(exchange, routingKey string) string {
+	for _, b := range AccountEventBindings {
+		if b.Exchange == exchange && b.RoutingKey == routingKey {
+			return b.Type
+		}
+	}
+	return ""
+}
@@ -28,6 +28,9 @@ const (
 	UsersExchange       = "users.events"
 	CollectionsExchange = "collections.events"
 	MintsExchange       = "mints.events"
+	RegistryExchange    = "registry.events"
+	MediaExchange       = "media.events"
+	ChainsExchange      = "chains.events"
 	DLXExchange         = "dlx.events"
 )
 
@@ -51,12 +54,31 @@ const (
 // Routing keys - configurable constants
 const (
 	// Auth routing keys
-	UserLoggedInKey = "user.logged_in"
+	UserLoggedInKey   = "user.logged_in"
+	SessionRevokedKey = "session.revoked"
+	SessionEvictedKey = "session.evicted"
 
 	// Wallet routing keys
-	WalletLinkedKey    = "wallet.linked"
-	WalletUnlinkedKey  = "wallet.unlinked"
-	ApprovalUpdatedKey = "approval.updated"
+	WalletLinkedKey         = "wallet.linked"
+	WalletUnlinkedKey       = "wallet.unlinked"
+	WalletPrimaryChangedKey = "wallet.primary_changed"
+	ApprovalUpdatedKey      = "approval.updated"
+	// Published when a sanctions/AML screening flags a wallet, for the moderation queue
+	WalletScreeningFlaggedKey = "wallet.screening_flagged"
+	// Published once per UTC day with the previous day's active wallet counts
+	WalletDailyActiveKey = "wallet.daily_active"
+
+	// User routing keys
+	UserProfileUpdatedKey = "user.profile_updated"
+
+	// Published when a collection export generated in the background finished
+	CollectionExportReadyKey = "catalog.export_ready"
+	// Published once a transfer is in the catalog's ownership index, for profile stats
+	HoldingsChangedKeyPattern = "catalog.holdings_changed.*" // catalog.holdings_changed.{eip155-1}
+	// Catalog domain events of created and updated collections
+	CollectionDomainUpsertedKeyPattern = "collections.domain.upserted.#" // collections.domain.upserted.{chainId}[.{contract}]
+	// Published by the catalog scheduler shortly before a drop a wallet subscribed to opens
+	DropStartingSoonKeyPattern = "drop.starting_soon.*" // drop.starting_soon.{eip155-1}
 
 	// Collection routing keys
 	CollectionCreatedKeyPattern  = "created.eip155.*" // created.eip155.{chainNum}
@@ -65,4 +87,17 @@ const (
 	// Mint routing keys
 	MintCreatedKeyPattern  = "minted.eip155.*" // minted.eip155.{chainNum}
 	MintUpsertedKeyPattern = "upserted.*"      // upserted.{chainId}.{contract}.{tokenId}
+
+	// Registry routing keys
+	AbiChangedKeyPattern      = "registry.abi_changed.*" // registry.abi_changed.{eip155-1}
+	RegistryChangedKeyPattern = "registry.changed.*"     // registry.changed.{eip155-1}
+
+	// Media routing keys
+	QuotaExceededKey = "media.quota_exceeded"
+
+	// Chain routing keys
+	ChainHeadAdvancedKeyPattern = "chain.head_advanced.*" // chain.head_advanced.{eip155-1}
+
+	// Sale routing keys
+	SaleIndexedKeyPattern = "sales.events.indexed.*" // sales.events.indexed.{eip155-1}
 )
@@ -0,0 +1,33 @@
+package contracts
+
+import "time"
+
+// ChainHeadKey is the Redis hash the chain registry keeps the last head the indexer reported
+// on every chain in, one JSON ChainHead per CAIP-2 chain id
+const ChainHeadKey = "chain:head"
+
+// ChainHead is a chain's latest and finalized blocks as the indexer last polled them
+type ChainHead struct {
+	ChainID         string    `json:"chain_id"` // CAIP-2, e.g. eip155:1
+	LatestBlock     uint64    `json:"latest_block"`
+	LatestBlockHash string    `json:"latest_block_hash"`
+	LatestBlockTime time.Time `json:"latest_block_time"`
+	FinalizedBlock  uint64    `json:"finalized_block"`
+	// FinalizedByTag is false on chains without a "finalized" block tag, where
+	// FinalizedBlock is the latest block less the chain's reorg depth
+	FinalizedByTag bool      `json:"finalized_by_tag"`
+	AdvancedAt     time.Time `json:"advanced_at"` // when the indexer saw LatestBlock
+}
+
+// Stale reports whether the head has not advanced for longer than after, which covers
+// both a halted chain and an indexer that stopped reporting it
+func (h ChainHead) Stale(now time.Time, after time.Duration) bool {
+	return now.Sub(h.AdvancedAt) > after
+}
+
+// ChainHeadAdvancedEvent is published by the indexer on chain.head_advanced.<chain>
+// whenever a poll finds a chain's latest block moved
+type ChainHeadAdvancedEvent struct {
+	EventID string `json:"event_id"`
+	ChainHead
+}
@@ -0,0 +1,29 @@
+package contracts
+
+import "time"
+
+// ConsumerLagKey is the Redis hash holding the last lag report of every queue consumer,
+// one JSON ConsumerLag per consumer tag
+const ConsumerLagKey = "consumer:lag"
+
+// ConsumerLag is how far a queue consumer is behind, as it last reported it.
+// OldestUnackedSeconds counts from when the oldest delivery it holds was published,
+// so it includes the time the message waited in the queue.
+type ConsumerLag struct {
+	Consumer             string    `json:"consumer"`
+	Queue                string    `json:"queue"`
+	MessagesPerSecond    float64   `json:"messages_per_second"`
+	InFlight             int       `json:"in_flight"`
+	OldestUnackedSeconds float64   `json:"oldest_unacked_seconds"`
+	Processed            int64     `json:"processed"`
+	Failed               int64     `json:"failed"`
+	ReportedAt           time.Time `json:"reported_at"`
+}
+
+// QueueDepth is the backlog of a queue as the broker reports it. Messages only counts
+// messages ready for delivery, not those delivered and awaiting an ack.
+type QueueDepth struct {
+	Name      string `json:"name"`
+	Messages  int    `json:"messages"`
+	Consumers int    `json:"consumers"`
+}
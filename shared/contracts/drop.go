@@ -0,0 +1,43 @@
+package contracts
+
+import (
+	"strings"
+	"time"
+)
+
+// DropStartingSoonEvent is the catalog's drop.starting_soon alert as consumers outside the
+// subscription worker read it. Data carries the drop's contract_address, starts_at and the
+// recipients subscribed to it.
+type DropStartingSoonEvent struct {
+	EventID     string                 `json:"event_id"`
+	EventType   string                 `json:"event_type"`
+	AggregateID string                 `json:"aggregate_id"`
+	ChainID     string                 `json:"chain_id"`
+	Data        map[string]interface{} `json:"data"`
+	Timestamp   time.Time              `json:"timestamp"`
+}
+
+// Recipients returns the alert's recipient addresses in lowercase
+func (e *DropStartingSoonEvent) Recipients() []string {
+	raw, _ := e.Data["recipients"].([]interface{})
+	out := make([]string, 0, len(raw))
+	for _, r := range raw {
+		if address, ok := r.(string); ok && address != "" {
+			out = append(out, strings.ToLower(address))
+		}
+	}
+	return out
+}
+
+// Contract returns the lowercase address of the collection dropping
+func (e *DropStartingSoonEvent) Contract() string {
+	contract, _ := e.Data["contract_address"].(string)
+	return strings.ToLower(contract)
+}
+
+// StartsAt returns when the drop opens; ok is false when the alert doesn't say
+func (e *DropStartingSoonEvent) StartsAt() (time.Time, bool) {
+	raw, _ := e.Data["starts_at"].(string)
+	t, err := time.Parse(time.RFC3339, raw)
+	return t, err == nil
+}
@@ -0,0 +1,17 @@
+package contracts
+
+import "time"
+
+// CollectionExportReadyEvent is published on catalog.export_ready when an export generated
+// in the background finished. Failed exports carry Error and no artifact.
+type CollectionExportReadyEvent struct {
+	UserID          string    `json:"user_id"`
+	ExportID        string    `json:"export_id"`
+	Kind            string    `json:"kind"` // sales or mints
+	ChainID         string    `json:"chain_id"`
+	ContractAddress string    `json:"contract_address"`
+	Status          string    `json:"status"` // ready or failed
+	RowCount        int       `json:"row_count"`
+	Error           string    `json:"error,omitempty"`
+	CompletedAt     time.Time `json:"completed_at"`
+}
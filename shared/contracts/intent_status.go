@@ -0,0 +1,189 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Intent status keys shared by the orchestrator, which writes them, and the
+// subscription-worker, which resolves intents and streams changes to clients
+const (
+	IntentStatusKeyPrefix   = "intent:status:"
+	IntentTxHashKeyPrefix   = "intent:txhash:"
+	IntentContractKeyPrefix = "intent:contract:"
+
+	// IntentStatusChannel carries every status write as a JSON IntentStatusRecord
+	IntentStatusChannel = "intent:status:changed"
+	// IntentStatusStream replaces IntentStatusChannel under the stream transport; each
+	// entry holds the JSON IntentStatusRecord in its "state" field
+	IntentStatusStream = "intent:status:stream"
+	// IntentStatusHeartbeatKey holds the time, in unix ms, last stamped on the primary. A
+	// replica holding a stamp holds every status written before it, so the stamp's age
+	// bounds how stale the replica's statuses are.
+	IntentStatusHeartbeatKey = "intent:status:heartbeat"
+)
+
+// Intent status transports, chosen per deployment. Every status writer and the
+// subscription-worker must use the same one.
+const (
+	// IntentStatusTransportPubSub publishes writes on IntentStatusChannel; subscribers that
+	// are down miss them
+	IntentStatusTransportPubSub = "pubsub"
+	// IntentStatusTransportStream appends writes to IntentStatusStream, read by consumer
+	// groups that pick up where they left off after a restart
+	IntentStatusTransportStream = "stream"
+)
+
+// IntentStatusRecord is the canonical intent status, stored as one Redis hash per intent.
+// Version is bumped by every write and lets readers update without WATCH: a write made
+// with the version it read fails if someone else wrote in between. Seq is the
+// orchestrator's stored status sequence the record was written at; a write carrying a Seq
+// is dropped unless it is newer than the stored one. CallbackURL is the creator's webhook,
+// called by the subscription-worker once the intent resolves.
+type IntentStatusRecord struct {
+	IntentID        string                 `json:"intent_id"`
+	Kind            string                 `json:"kind,omitempty"`
+	Status          string                 `json:"status"`
+	ChainID         string                 `json:"chain_id,omitempty"`
+	TxHash          string                 `json:"tx_hash,omitempty"`
+	ContractAddress string                 `json:"contract_address,omitempty"`
+	Error           string                 `json:"error,omitempty"`
+	Data            map[string]interface{} `json:"data,omitempty"`
+	CallbackURL     string                 `json:"callback_url,omitempty"`
+	ExpiresAt       time.Time              `json:"expires_at,omitempty"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+	Version         int64                  `json:"version"`
+	Seq             int64                  `json:"seq,omitempty"`
+}
+
+// IntentStatusKey is the hash holding an intent's status
+func IntentStatusKey(intentID string) string {
+	return IntentStatusKeyPrefix + intentID
+}
+
+// IntentTxHashKey is the set of intents that sent a tx. Chain IDs are keyed in the
+// indexer's dash form so CAIP-2 ids from the orchestrator match catalog events.
+func IntentTxHashKey(chainID, txHash string) string {
+	return fmt.Sprintf("%s%s:%s", IntentTxHashKeyPrefix, indexChainID(chainID), strings.ToLower(txHash))
+}
+
+// IntentContractKey is the set of intents expecting a contract
+func IntentContractKey(chainID, contractAddress string) string {
+	return fmt.Sprintf("%s%s:%s", IntentContractKeyPrefix, indexChainID(chainID), strings.ToLower(contractAddress))
+}
+
+func indexChainID(chainID string) string {
+	return strings.ReplaceAll(strings.ToLower(chainID), ":", "-")
+}
+
+// HashFields returns the fields a write sets. Empty fields are left out so a partial
+// record only overwrites what it carries; version is owned by the store.
+func (r IntentStatusRecord) HashFields() (map[string]string, error) {
+	fields := map[string]string{"intent_id": r.IntentID}
+	set := func(name, value string) {
+		if value != "" {
+			fields[name] = value
+		}
+	}
+	set("kind", r.Kind)
+	set("status", r.Status)
+	set("chain_id", r.ChainID)
+	set("tx_hash", r.TxHash)
+	set("contract_address", r.ContractAddress)
+	set("error", r.Error)
+	set("callback_url", r.CallbackURL)
+	if len(r.Data) > 0 {
+		data, err := json.Marshal(r.Data)
+		if err != nil {
+			return nil, fmt.Errorf("marshal intent data: %w", err)
+		}
+		fields["data"] = string(data)
+	}
+	if !r.ExpiresAt.IsZero() {
+		fields["expires_at"] = strconv.FormatInt(r.ExpiresAt.UnixMilli(), 10)
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = time.Now()
+	}
+	fields["updated_at"] = strconv.FormatInt(r.UpdatedAt.UnixMilli(), 10)
+	if r.Seq > 0 {
+		fields["seq"] = strconv.FormatInt(r.Seq, 10)
+	}
+	return fields, nil
+}
+
+// Merge overlays the non-empty fields of next onto r
+func (r IntentStatusRecord) Merge(next IntentStatusRecord) IntentStatusRecord {
+	pick := func(cur, upd string) string {
+		if upd != "" {
+			return upd
+		}
+		return cur
+	}
+	r.IntentID = pick(r.IntentID, next.IntentID)
+	r.Kind = pick(r.Kind, next.Kind)
+	r.Status = pick(r.Status, next.Status)
+	r.ChainID = pick(r.ChainID, next.ChainID)
+	r.TxHash = pick(r.TxHash, next.TxHash)
+	r.ContractAddress = pick(r.ContractAddress, next.ContractAddress)
+	r.Error = pick(r.Error, next.Error)
+	r.CallbackURL = pick(r.CallbackURL, next.CallbackURL)
+	if len(next.Data) > 0 {
+		merged := make(map[string]interface{}, len(r.Data)+len(next.Data))
+		for k, v := range r.Data {
+			merged[k] = v
+		}
+		for k, v := range next.Data {
+			merged[k] = v
+		}
+		r.Data = merged
+	}
+	if !next.ExpiresAt.IsZero() {
+		r.ExpiresAt = next.ExpiresAt
+	}
+	if !next.UpdatedAt.IsZero() {
+		r.UpdatedAt = next.UpdatedAt
+	}
+	if next.Version > r.Version {
+		r.Version = next.Version
+	}
+	if next.Seq > r.Seq {
+		r.Seq = next.Seq
+	}
+	return r
+}
+
+// IntentStatusRecordFromHash decodes a status hash as returned by HGETALL
+func IntentStatusRecordFromHash(fields map[string]string) (IntentStatusRecord, error) {
+	r := IntentStatusRecord{
+		IntentID:        fields["intent_id"],
+		Kind:            fields["kind"],
+		Status:          fields["status"],
+		ChainID:         fields["chain_id"],
+		TxHash:          fields["tx_hash"],
+		ContractAddress: fields["contract_address"],
+		Error:           fields["error"],
+		CallbackURL:     fields["callback_url"],
+	}
+	if data := fields["data"]; data != "" {
+		if err := json.Unmarshal([]byte(data), &r.Data); err != nil {
+			return IntentStatusRecord{}, fmt.Errorf("decode intent data: %w", err)
+		}
+	}
+	if ms, err := strconv.ParseInt(fields["expires_at"], 10, 64); err == nil {
+		r.ExpiresAt = time.UnixMilli(ms)
+	}
+	if ms, err := strconv.ParseInt(fields["updated_at"], 10, 64); err == nil {
+		r.UpdatedAt = time.UnixMilli(ms)
+	}
+	if v, err := strconv.ParseInt(fields["version"], 10, 64); err == nil {
+		r.Version = v
+	}
+	if seq, err := strconv.ParseInt(fields["seq"], 10, 64); err == nil {
+		r.Seq = seq
+	}
+	return r, nil
+}
@@ -0,0 +1,29 @@
+package contracts
+
+import "time"
+
+// Storage quota levels: crossing a soft limit only notifies, a hard limit rejects the upload
+const (
+	QuotaLevelSoft = "soft"
+	QuotaLevelHard = "hard"
+)
+
+// Storage quota resources
+const (
+	QuotaResourceBytes  = "bytes"
+	QuotaResourceAssets = "assets"
+)
+
+// StorageQuotaExceededEvent is published on media.quota_exceeded when an upload crosses a
+// user's soft limit or is rejected by a hard one. Kind is set for per-file-type limits.
+type StorageQuotaExceededEvent struct {
+	EventID    string    `json:"event_id"`
+	UserID     string    `json:"user_id"`
+	Level      string    `json:"level"`
+	Resource   string    `json:"resource"`
+	Kind       string    `json:"kind,omitempty"`
+	Used       int64     `json:"used"`
+	Requested  int64     `json:"requested"`
+	Limit      int64     `json:"limit"`
+	ExceededAt time.Time `json:"exceeded_at"`
+}
@@ -0,0 +1,78 @@
+package contracts
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+)
+
+// MintArg names one argument of a collection's mint function. The chain registry stores
+// the layout and the orchestrator fills each argument from the mint request.
+type MintArg string
+
+const (
+	MintArgRecipient MintArg = "recipient" // address receiving the tokens
+	MintArgQuantity  MintArg = "quantity"  // uint256 token count, or the ERC-1155 amount
+	MintArgTokenID   MintArg = "token_id"  // uint256 ERC-1155 token id
+	MintArgProof     MintArg = "proof"     // bytes32[] allowlist merkle proof
+	MintArgData      MintArg = "data"      // bytes forwarded to ERC-1155 receivers, sent empty
+)
+
+var mintArgs = map[MintArg]bool{
+	MintArgRecipient: true,
+	MintArgQuantity:  true,
+	MintArgTokenID:   true,
+	MintArgProof:     true,
+	MintArgData:      true,
+}
+
+var solidityIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// MintFunction describes how a collection is minted when it does not follow the standard
+// signatures, e.g. ERC-721A `mint(quantity)` or an allowlist `claim(proof, quantity)`
+type MintFunction struct {
+	Name string    `json:"name"`
+	Args []MintArg `json:"args"`
+	// Payable mints attach UnitPriceWei per token; an empty price attaches nothing
+	Payable      bool   `json:"payable"`
+	UnitPriceWei string `json:"unitPriceWei,omitempty"`
+	// MaxPerTx caps the quantity of one mint; 0 leaves it to the contract
+	MaxPerTx uint64 `json:"maxPerTx,omitempty"`
+}
+
+// Has reports whether the function takes arg
+func (f MintFunction) Has(arg MintArg) bool {
+	for _, a := range f.Args {
+		if a == arg {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the descriptor can be encoded: a valid function name, known
+// arguments used at most once, and a price only on payable functions
+func (f MintFunction) Validate() error {
+	if !solidityIdentifier.MatchString(f.Name) {
+		return fmt.Errorf("invalid mint function name %q", f.Name)
+	}
+	seen := make(map[MintArg]bool, len(f.Args))
+	for _, arg := range f.Args {
+		if !mintArgs[arg] {
+			return fmt.Errorf("unknown mint argument %q", arg)
+		}
+		if seen[arg] {
+			return fmt.Errorf("mint argument %q used twice", arg)
+		}
+		seen[arg] = true
+	}
+	if f.UnitPriceWei != "" {
+		if !f.Payable {
+			return fmt.Errorf("unit price set on a non-payable mint function")
+		}
+		if price, ok := new(big.Int).SetString(f.UnitPriceWei, 10); !ok || price.Sign() < 0 {
+			return fmt.Errorf("invalid unit price %q", f.UnitPriceWei)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	"strings"
+	"time"
+)
+
+// MintStatsChannel carries every update of a collection's per-minute mint stats as JSON
+// MintStatsUpdate
+const MintStatsChannel = "mint:stats"
+
+// MintStatsUpdate announces that a minute bucket of a collection's mints changed. Revenue is
+// in wei, as a base-10 string.
+type MintStatsUpdate struct {
+	ChainID         string    `json:"chain_id"`
+	ContractAddress string    `json:"contract_address"`
+	Minute          time.Time `json:"minute"`
+	Mints           string    `json:"mints"`
+	UniqueMinters   int64     `json:"unique_minters"`
+	Revenue         string    `json:"revenue"`
+	EmittedAt       time.Time `json:"emitted_at"`
+}
+
+// MintStatsTopic is the subscription key for the mint stats of one collection
+func MintStatsTopic(chainID, contract string) string {
+	return "mint_stats:" + chainID + ":" + strings.ToLower(contract)
+}
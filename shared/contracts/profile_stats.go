@@ -0,0 +1,37 @@
+package contracts
+
+import (
+	"strings"
+	"time"
+)
+
+// HoldingsChangedEvent is published by the catalog on catalog.holdings_changed.<chain> once a
+// transfer is in its ownership index. Deltas maps each lowercase wallet the transfer
+// touched to the change in the number of distinct tokens it holds: a wallet receiving a
+// token it did not hold gains one, a wallet sending its last unit of a token loses one.
+type HoldingsChangedEvent struct {
+	EventID    string           `json:"event_id"`
+	ChainID    string           `json:"chain_id"` // eip155-1
+	Contract   string           `json:"contract"`
+	Deltas     map[string]int64 `json:"deltas"`
+	OccurredAt time.Time        `json:"occurred_at"`
+}
+
+// CollectionUpsertedEvent is the catalog's collections.domain.upserted event as profile
+// stats read it; Data carries the collection, with is_new set on its first upsert
+type CollectionUpsertedEvent struct {
+	EventID     string                 `json:"event_id"`
+	AggregateID string                 `json:"aggregate_id"` // collection id
+	ChainID     string                 `json:"chain_id"`
+	Data        map[string]interface{} `json:"data"`
+}
+
+// Creator returns the lowercase creator of a collection upserted for the first time, or ""
+// for updates of a known collection
+func (e *CollectionUpsertedEvent) Creator() string {
+	if isNew, _ := e.Data["is_new"].(bool); !isNew {
+		return ""
+	}
+	creator, _ := e.Data["creator"].(string)
+	return strings.ToLower(creator)
+}
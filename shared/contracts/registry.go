@@ -0,0 +1,120 @@
+package contracts
+
+import (
+	"strings"
+	"time"
+)
+
+// AbiDiff lists the canonical signatures, e.g. "Transfer(address,address,uint256)",
+// that an ABI replacement added or removed. A changed signature shows up as one
+// removal plus one addition under the same name.
+type AbiDiff struct {
+	AddedEvents      []string `json:"added_events"`
+	RemovedEvents    []string `json:"removed_events"`
+	AddedFunctions   []string `json:"added_functions"`
+	RemovedFunctions []string `json:"removed_functions"`
+}
+
+// Empty reports whether the replacement kept every event and function signature
+func (d AbiDiff) Empty() bool {
+	return len(d.AddedEvents) == 0 && len(d.RemovedEvents) == 0 &&
+		len(d.AddedFunctions) == 0 && len(d.RemovedFunctions) == 0
+}
+
+// AbiBreak is one handled signature an ABI replacement took away
+type AbiBreak struct {
+	Kind        string // "event" or "function"
+	Removed     string
+	Replacement string // same-named signature added alongside, if any
+}
+
+// Breaks checks the removals against what a consumer handles. Handled entries are
+// either full signatures, for exact matches, or bare names, for any overload.
+func (d AbiDiff) Breaks(handledEvents, handledFunctions []string) []AbiBreak {
+	breaks := signatureBreaks("event", d.RemovedEvents, d.AddedEvents, handledEvents)
+	return append(breaks, signatureBreaks("function", d.RemovedFunctions, d.AddedFunctions, handledFunctions)...)
+}
+
+func signatureBreaks(kind string, removed, added, handled []string) []AbiBreak {
+	var breaks []AbiBreak
+	for _, sig := range removed {
+		name := SignatureName(sig)
+		if !handles(handled, sig, name) {
+			continue
+		}
+		b := AbiBreak{Kind: kind, Removed: sig}
+		for _, candidate := range added {
+			if SignatureName(candidate) == name {
+				b.Replacement = candidate
+				break
+			}
+		}
+		breaks = append(breaks, b)
+	}
+	return breaks
+}
+
+func handles(handled []string, sig, name string) bool {
+	for _, h := range handled {
+		if h == sig || h == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SignatureName returns the name part of a canonical signature
+func SignatureName(sig string) string {
+	if i := strings.IndexByte(sig, '('); i >= 0 {
+		return sig[:i]
+	}
+	return sig
+}
+
+// AbiChangedEvent is published by the chain registry on registry.abi_changed.<chain>
+// whenever the ABI registered for a contract is replaced
+type AbiChangedEvent struct {
+	EventID         string    `json:"event_id"`
+	ChainID         string    `json:"chain_id"` // CAIP-2, e.g. eip155:1
+	Address         string    `json:"address"`
+	Name            string    `json:"name"`
+	OldAbiSHA256    string    `json:"old_abi_sha256"`
+	NewAbiSHA256    string    `json:"new_abi_sha256"`
+	RegistryVersion string    `json:"registry_version"`
+	Reason          string    `json:"reason"`
+	Diff            AbiDiff   `json:"diff"`
+	ChangedAt       time.Time `json:"changed_at"`
+}
+
+// RegistryChangedEvent is published by the chain registry on registry.changed.<chain>
+// whenever a chain's registry version moves, e.g. after RPC endpoints, chain params or
+// contracts were edited. Consumers refetch what they cache for the chain.
+type RegistryChangedEvent struct {
+	EventID         string    `json:"event_id"`
+	ChainID         string    `json:"chain_id"` // CAIP-2, e.g. eip155:1
+	RegistryVersion string    `json:"registry_version"`
+	Reason          string    `json:"reason"`
+	ChangedAt       time.Time `json:"changed_at"`
+}
+
+// Well-known contract roles in the chain registry. Roles are lowercase kebab-case tags, so
+// new ones can be assigned without a proto change; a contract may hold several.
+const (
+	RoleFactory         = "factory" // any collection factory the indexer follows
+	RoleERC721Factory   = "erc721-factory"
+	RoleERC1155Factory  = "erc1155-factory"
+	RoleMarketplace     = "marketplace"
+	RoleAuctionHouse    = "auction-house"
+	RoleRoyaltyRegistry = "royalty-registry"
+	RoleForwarder       = "forwarder"
+)
+
+// HasRole reports whether roles contains role
+func HasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package contracts
+
+import "strings"
+
+// SaleIndexedEvent is a sale the indexer reported on sales.events.indexed.<chain>. The
+// parties, token and price travel in Data.
+type SaleIndexedEvent struct {
+	EventID   string                 `json:"event_id"`
+	EventType string                 `json:"event_type"`
+	ChainID   string                 `json:"chain_id"` // eip155-1
+	TxHash    string                 `json:"tx_hash"`
+	Contract  string                 `json:"contract"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// Parties returns the sale's seller and buyer in lowercase; either may be empty
+func (e *SaleIndexedEvent) Parties() (seller, buyer string) {
+	seller, _ = e.Data["seller"].(string)
+	buyer, _ = e.Data["buyer"].(string)
+	return strings.ToLower(seller), strings.ToLower(buyer)
+}
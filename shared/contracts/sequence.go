@@ -0,0 +1,40 @@
+package contracts
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Events about the same aggregate, a collection, a token or an intent, carry the aggregate's
+// id and a sequence its producer stamps: 1 for the aggregate's first event, then one more per
+// event. Consumers apply an aggregate's events in sequence order, dropping those at or below
+// the last one applied and parking those that arrive ahead of a gap. A republished event keeps
+// the sequence it was first stamped with.
+const (
+	// AggregateIDHeader and SequenceHeader repeat the envelope's aggregate_id and sequence
+	// in the message headers
+	AggregateIDHeader = "aggregate_id"
+	SequenceHeader    = "sequence"
+)
+
+// CollectionAggregate is the aggregate of a collection's own events: its creation,
+// confirmations and admin changes
+func CollectionAggregate(chainID, contract string) string {
+	return fmt.Sprintf("collection:%s:%s", aggregateChain(chainID), strings.ToLower(contract))
+}
+
+// TokenAggregate is the aggregate of a token's mints, transfers and sales
+func TokenAggregate(chainID, contract, tokenID string) string {
+	return fmt.Sprintf("token:%s:%s:%s", aggregateChain(chainID), strings.ToLower(contract), tokenID)
+}
+
+// IntentAggregate is the aggregate of an orchestrator intent's events
+func IntentAggregate(intentID string) string {
+	return "intent:" + intentID
+}
+
+// aggregateChain writes chain ids the way routing keys do, eip155-1, whichever form a
+// producer holds
+func aggregateChain(chainID string) string {
+	return strings.ToLower(strings.ReplaceAll(chainID, ":", "-"))
+}
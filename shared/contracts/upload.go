@@ -0,0 +1,37 @@
+package contracts
+
+import "time"
+
+// Upload stages reported while media-service receives, pins and records a file
+const (
+	UploadStageUploading  = "uploading"
+	UploadStagePinning    = "pinning"
+	UploadStageProcessing = "processing"
+	UploadStageDone       = "done"
+	UploadStageFailed     = "failed"
+)
+
+// UploadProgressChannel carries every progress report of a ticketed upload as JSON UploadProgress
+const UploadProgressChannel = "upload:progress"
+
+// UploadProgress is a progress report of one upload, keyed by the ticket the client
+// chose when starting it. TotalBytes is 0 when the client did not announce the size.
+type UploadProgress struct {
+	Ticket        string    `json:"ticket"`
+	Stage         string    `json:"stage"`
+	BytesReceived int64     `json:"bytes_received"`
+	TotalBytes    int64     `json:"total_bytes,omitempty"`
+	AssetID       string    `json:"asset_id,omitempty"`
+	Error         string    `json:"error,omitempty"`
+	EmittedAt     time.Time `json:"emitted_at"`
+}
+
+// Done reports whether no further progress follows
+func (p UploadProgress) Done() bool {
+	return p.Stage == UploadStageDone || p.Stage == UploadStageFailed
+}
+
+// UploadTopic is the subscription key for the progress of one upload
+func UploadTopic(ticket string) string {
+	return "upload:" + ticket
+}
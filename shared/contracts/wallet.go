@@ -0,0 +1,42 @@
+package contracts
+
+import "time"
+
+// WalletUnlinkedEvent is published on wallet.unlinked when a user removes a linked wallet
+type WalletUnlinkedEvent struct {
+	UserID     string    `json:"user_id"`
+	AccountID  string    `json:"account_id"`
+	WalletID   string    `json:"wallet_id"`
+	Address    string    `json:"address"`
+	ChainID    string    `json:"chain_id"`
+	UnlinkedAt time.Time `json:"unlinked_at"`
+}
+
+// WalletScreeningFlaggedEvent is published on wallet.screening_flagged when a sanctions/AML
+// screening flags an address a user links or sells from. WalletID is empty when a link was
+// blocked before the wallet was created.
+type WalletScreeningFlaggedEvent struct {
+	UserID     string    `json:"user_id"`
+	WalletID   string    `json:"wallet_id,omitempty"`
+	Address    string    `json:"address"`
+	ChainID    string    `json:"chain_id"`
+	Trigger    string    `json:"trigger"` // wallet_link or first_sale
+	Provider   string    `json:"provider"`
+	Risk       string    `json:"risk"`
+	Categories []string  `json:"categories,omitempty"`
+	Blocked    bool      `json:"blocked"`
+	ScreenedAt time.Time `json:"screened_at"`
+}
+
+// WalletDailyActiveEvent is published on wallet.daily_active once a UTC day has ended.
+// A wallet is active on a day it signed in with SIWE or its user had a gateway session.
+// Returning wallets were linked before the day, so ReturningWallets/ActiveWallets is the
+// day's retention.
+type WalletDailyActiveEvent struct {
+	Day              string    `json:"day"` // YYYY-MM-DD, UTC
+	ActiveWallets    int       `json:"active_wallets"`
+	ActiveUsers      int       `json:"active_users"`
+	NewWallets       int       `json:"new_wallets"`
+	ReturningWallets int       `json:"returning_wallets"`
+	ComputedAt       time.Time `json:"computed_at"`
+}
@@ -16,3 +16,25 @@ type WSMessage[T any] struct {
 	Data          T         `json:"data"`
 	Error         *WSError  `json:"error,omitempty"`
 }
+
+// SubscriptionTicketTTL bounds how long a subscription ticket waits to be redeemed
+const SubscriptionTicketTTL = 30 * time.Second
+
+// SubscriptionTicketKeyPrefix prefixes tickets, which are stored under the SHA-256 of their
+// value so a Redis dump does not hand out usable tickets
+const SubscriptionTicketKeyPrefix = "ws:ticket:"
+
+// SubscriptionTicket is what auth-service records for a single-use WebSocket ticket and the
+// subscription worker checks when a socket is opened with it. Origin is empty when the ticket
+// was issued outside a browser.
+type SubscriptionTicket struct {
+	UserID    string    `json:"user_id"`
+	SessionID string    `json:"session_id"`
+	Origin    string    `json:"origin,omitempty"`
+	IssuedAt  time.Time `json:"issued_at"`
+}
+
+// SubscriptionTicketKey is the key holding the ticket with the given SHA-256 hex digest
+func SubscriptionTicketKey(ticketHash string) string {
+	return SubscriptionTicketKeyPrefix + ticketHash
+}
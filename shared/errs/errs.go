@@ -0,0 +1,120 @@
+// Package errs gives service errors a code that survives the gRPC hop, so every service
+// maps a failure to the same status and the gateway can tell clients what went wrong and
+// whether trying again can help.
+package errs
+
+import (
+	"context"
+	"errors"
+)
+
+// Code classifies a failure independently of the transport it crosses
+type Code string
+
+const (
+	InvalidArgument    Code = "INVALID_ARGUMENT"
+	NotFound           Code = "NOT_FOUND"
+	AlreadyExists      Code = "ALREADY_EXISTS"
+	PermissionDenied   Code = "PERMISSION_DENIED"
+	Unauthenticated    Code = "UNAUTHENTICATED"
+	FailedPrecondition Code = "FAILED_PRECONDITION"
+	ResourceExhausted  Code = "RESOURCE_EXHAUSTED" // rate limits and quotas
+	Aborted            Code = "ABORTED"            // lost a race with a concurrent write
+	Unavailable        Code = "UNAVAILABLE"
+	DeadlineExceeded   Code = "DEADLINE_EXCEEDED"
+	Canceled           Code = "CANCELED"
+	Unimplemented      Code = "UNIMPLEMENTED"
+	Internal           Code = "INTERNAL"
+)
+
+// Error is a failure with a code. Reason names a specific failure, e.g. "wallet_not_found";
+// errors with the same code and reason match with errors.Is, including ones rebuilt on the
+// other side of a gRPC call.
+type Error struct {
+	Code    Code
+	Reason  string
+	Message string
+	cause   error
+}
+
+// New creates a sentinel error whose reason is also its message
+func New(code Code, reason string) *Error {
+	return &Error{Code: code, Reason: reason, Message: reason}
+}
+
+// WithMessage returns a copy of a sentinel with a human message; the reason stays the
+// machine-readable name
+func (e *Error) WithMessage(message string) *Error {
+	out := *e
+	out.Message = message
+	return &out
+}
+
+// Wrap classifies err under code, keeping it as the cause. It returns nil for a nil err.
+func Wrap(err error, code Code, message string) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Message: message, cause: err}
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Message + ": " + e.cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error { return e.cause }
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Reason != "" && t.Code == e.Code && t.Reason == e.Reason
+}
+
+// As finds the classified error in err's chain. gRPC statuses and context errors count as
+// classified; anything else is not.
+func As(err error) (*Error, bool) {
+	if err == nil {
+		return nil, false
+	}
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	if appErr, ok := fromStatus(err); ok {
+		return appErr, true
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return &Error{Code: DeadlineExceeded, Message: err.Error(), cause: err}, true
+	case errors.Is(err, context.Canceled):
+		return &Error{Code: Canceled, Message: err.Error(), cause: err}, true
+	}
+	return nil, false
+}
+
+// CodeOf returns the code of err, Internal when it is unclassified
+func CodeOf(err error) Code {
+	if appErr, ok := As(err); ok {
+		return appErr.Code
+	}
+	return Internal
+}
+
+// Is reports whether err is classified under code
+func Is(err error, code Code) bool {
+	appErr, ok := As(err)
+	return ok && appErr.Code == code
+}
+
+// Retryable reports whether the same call may succeed later: the callee was down, slow,
+// rate limited, or lost a race. Everything else fails the same way again.
+func Retryable(err error) bool {
+	switch CodeOf(err) {
+	case Unavailable, DeadlineExceeded, ResourceExhausted, Aborted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,110 @@
+package errs
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain tags the ErrorInfo detail a status carries the reason in
+const errorDomain = "nft-marketplace"
+
+var toGRPCCodes = map[Code]codes.Code{
+	InvalidArgument:    codes.InvalidArgument,
+	NotFound:           codes.NotFound,
+	AlreadyExists:      codes.AlreadyExists,
+	PermissionDenied:   codes.PermissionDenied,
+	Unauthenticated:    codes.Unauthenticated,
+	FailedPrecondition: codes.FailedPrecondition,
+	ResourceExhausted:  codes.ResourceExhausted,
+	Aborted:            codes.Aborted,
+	Unavailable:        codes.Unavailable,
+	DeadlineExceeded:   codes.DeadlineExceeded,
+	Canceled:           codes.Canceled,
+	Unimplemented:      codes.Unimplemented,
+	Internal:           codes.Internal,
+}
+
+var fromGRPCCodes = func() map[codes.Code]Code {
+	out := make(map[codes.Code]Code, len(toGRPCCodes)+1)
+	for code, grpcCode := range toGRPCCodes {
+		out[grpcCode] = code
+	}
+	out[codes.OutOfRange] = InvalidArgument
+	return out
+}()
+
+// ToGRPC converts err into a gRPC status error for a handler to return. Unclassified
+// errors become Internal. The reason travels as an ErrorInfo detail so FromGRPC can
+// rebuild an error that matches the sentinel.
+func ToGRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok && !hasAppError(err) {
+		// already a status, e.g. from a downstream call; pass it through
+		return err
+	}
+
+	appErr, ok := As(err)
+	if !ok || appErr.Code == Internal {
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+
+	st := status.New(toGRPCCodes[appErr.Code], err.Error())
+	if reason := reasonOf(err); reason != "" {
+		if detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}); detailErr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
+// FromGRPC rebuilds a classified error from a gRPC status error returned by a client call.
+// Errors that carry no status are returned unchanged.
+func FromGRPC(err error) error {
+	if appErr, ok := fromStatus(err); ok {
+		return appErr
+	}
+	return err
+}
+
+func fromStatus(err error) (*Error, bool) {
+	if err == nil {
+		return nil, false
+	}
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return nil, false
+	}
+
+	code, known := fromGRPCCodes[st.Code()]
+	if !known {
+		code = Internal
+	}
+	appErr := &Error{Code: code, Message: st.Message()}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == errorDomain {
+			appErr.Reason = info.Reason
+		}
+	}
+	return appErr, true
+}
+
+func hasAppError(err error) bool {
+	var appErr *Error
+	return errors.As(err, &appErr)
+}
+
+// reasonOf finds the first reason in err's chain; wrappers carry none of their own
+func reasonOf(err error) string {
+	for err != nil {
+		if appErr, ok := err.(*Error); ok && appErr.Reason != "" {
+			return appErr.Reason
+		}
+		err = errors.Unwrap(err)
+	}
+	return ""
+}
@@ -0,0 +1,277 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+)
+
+// RequestIDHeader is the metadata key used to propagate request ids between services
+const RequestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the request id attached by the tracing interceptor
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// RecoveryUnaryInterceptor converts handler panics into codes.Internal
+func RecoveryUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("panic in %s: %v\n%s", info.FullMethod, r, debug.Stack())
+				monitoring.CaptureError(monitoring.WithTags(ctx, append(requestTags(req), "grpc_method", info.FullMethod)...),
+					fmt.Errorf("panic in %s: %v", info.FullMethod, r))
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamInterceptor converts stream handler panics into codes.Internal
+func RecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("panic in %s: %v\n%s", info.FullMethod, r, debug.Stack())
+				monitoring.CaptureError(monitoring.WithTags(ss.Context(), "grpc_method", info.FullMethod),
+					fmt.Errorf("panic in %s: %v", info.FullMethod, r))
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// TracingUnaryInterceptor reuses the incoming x-request-id or generates a new one
+// and makes it available on the context and the response headers
+func TracingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, RequestIDFromContext(ctx)))
+		return handler(ctx, req)
+	}
+}
+
+// TracingStreamInterceptor is the streaming counterpart of TracingUnaryInterceptor
+func TracingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRequestID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, RequestIDFromContext(ctx)))
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// LoggingUnaryInterceptor logs method, status code and latency of every call
+func LoggingUnaryInterceptor(serviceName string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		log.Printf("grpc|service=%s|method=%s|code=%s|duration=%s|request_id=%s",
+			serviceName, info.FullMethod, status.Code(err), time.Since(start), RequestIDFromContext(ctx))
+		return resp, err
+	}
+}
+
+// LoggingStreamInterceptor logs method, status code and lifetime of every stream
+func LoggingStreamInterceptor(serviceName string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		log.Printf("grpc|service=%s|stream=%s|code=%s|duration=%s|request_id=%s",
+			serviceName, info.FullMethod, status.Code(err), time.Since(start), RequestIDFromContext(ss.Context()))
+		return err
+	}
+}
+
+// reportedCodes are the status codes that indicate a server-side failure; client
+// mistakes such as InvalidArgument or NotFound are not reported
+var reportedCodes = map[codes.Code]bool{
+	codes.Unknown:  true,
+	codes.Internal: true,
+	codes.DataLoss: true,
+}
+
+// ErrorReportingUnaryInterceptor reports server-side failures with the method, request id
+// and any chain_id, intent_id or event_id fields of the request message
+func ErrorReportingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		tags := append(requestTags(req), "grpc_method", info.FullMethod, "request_id", RequestIDFromContext(ctx))
+		ctx = monitoring.WithTags(ctx, tags...)
+
+		resp, err := handler(ctx, req)
+		if err != nil && reportedCodes[status.Code(err)] {
+			monitoring.CaptureError(monitoring.WithTags(ctx, "grpc_code", status.Code(err).String()), err)
+		}
+		return resp, err
+	}
+}
+
+// ErrorReportingStreamInterceptor is the streaming counterpart of ErrorReportingUnaryInterceptor
+func ErrorReportingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := monitoring.WithTags(ss.Context(), "grpc_method", info.FullMethod, "request_id", RequestIDFromContext(ss.Context()))
+
+		err := handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+		if err != nil && reportedCodes[status.Code(err)] {
+			monitoring.CaptureError(monitoring.WithTags(ctx, "grpc_code", status.Code(err).String()), err)
+		}
+		return err
+	}
+}
+
+// requestTags reads the standard monitoring tags from string fields of the request message
+func requestTags(req interface{}) []string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	var tags []string
+	for _, name := range []string{monitoring.TagChainID, monitoring.TagIntentID, monitoring.TagEventID} {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			continue
+		}
+		tags = append(tags, name, m.Get(fd).String())
+	}
+	return tags
+}
+
+// validator is implemented by request messages that can check themselves
+type validator interface {
+	Validate() error
+}
+
+// ValidationUnaryInterceptor rejects requests whose Validate method fails
+func ValidationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if v, ok := req.(validator); ok {
+			if err := v.Validate(); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// MethodStats holds the counters collected for a single RPC method
+type MethodStats struct {
+	Calls         int64
+	Errors        int64
+	TotalDuration time.Duration
+}
+
+// Metrics collects per-method call counts, error counts and latency
+type Metrics struct {
+	mu      sync.RWMutex
+	methods map[string]*MethodStats
+}
+
+var defaultMetrics = NewMetrics()
+
+// NewMetrics creates an empty metrics collector
+func NewMetrics() *Metrics {
+	return &Metrics{methods: make(map[string]*MethodStats)}
+}
+
+// DefaultMetrics returns the collector used by servers created with New
+func DefaultMetrics() *Metrics {
+	return defaultMetrics
+}
+
+func (m *Metrics) record(method string, err error, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.methods[method]
+	if !ok {
+		s = &MethodStats{}
+		m.methods[method] = s
+	}
+	s.Calls++
+	if err != nil {
+		s.Errors++
+	}
+	s.TotalDuration += d
+}
+
+// Snapshot returns a copy of the collected counters keyed by full method name
+func (m *Metrics) Snapshot() map[string]MethodStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	out := make(map[string]MethodStats, len(m.methods))
+	for k, v := range m.methods {
+		out[k] = *v
+	}
+	return out
+}
+
+// UnaryInterceptor records metrics for unary calls
+func (m *Metrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.record(info.FullMethod, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// StreamInterceptor records metrics for streaming calls
+func (m *Metrics) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.record(info.FullMethod, err, time.Since(start))
+		return err
+	}
+}
+
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDHeader); len(ids) > 0 && ids[0] != "" {
+			return context.WithValue(ctx, requestIDKey{}, ids[0])
+		}
+	}
+	return context.WithValue(ctx, requestIDKey{}, newRequestID())
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,159 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const echoMethod = "/grpcserver.test.Echo/Echo"
+
+// echoRequest is a request message that checks itself, as generated ones with rules do
+type echoRequest struct {
+	*wrapperspb.StringValue
+}
+
+func (r *echoRequest) Validate() error {
+	if r.GetValue() == "" {
+		return errors.New("value is required")
+	}
+	return nil
+}
+
+// echoServer answers with the request id it sees, and panics when asked to
+type echoServer struct {
+	calls atomic.Int32
+}
+
+func (s *echoServer) echo(ctx context.Context, req *echoRequest) (*wrapperspb.StringValue, error) {
+	s.calls.Add(1)
+	if req.GetValue() == "panic" {
+		panic("handler blew up")
+	}
+	return wrapperspb.String(RequestIDFromContext(ctx)), nil
+}
+
+var echoServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcserver.test.Echo",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Echo",
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := new(wrapperspb.StringValue)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.(*echoServer).echo(ctx, req.(*echoRequest))
+			}
+			return interceptor(ctx, &echoRequest{in}, &grpc.UnaryServerInfo{Server: srv, FullMethod: echoMethod}, handler)
+		},
+	}},
+}
+
+// startEchoServer serves echoServer with the standard interceptor stack over an
+// in-memory listener
+func startEchoServer(t *testing.T) (*echoServer, *grpc.ClientConn) {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	server := New(Config{ServiceName: "grpcserver-test"})
+	echo := &echoServer{}
+	server.RegisterService(&echoServiceDesc, echo)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return echo, conn
+}
+
+func callEcho(ctx context.Context, conn *grpc.ClientConn, value string) (string, metadata.MD, error) {
+	var header metadata.MD
+	out := new(wrapperspb.StringValue)
+	err := conn.Invoke(ctx, echoMethod, wrapperspb.String(value), out, grpc.Header(&header))
+	return out.GetValue(), header, err
+}
+
+func TestInterceptors_PanicReturnsInternal(t *testing.T) {
+	echo, conn := startEchoServer(t)
+
+	_, _, err := callEcho(context.Background(), conn, "panic")
+	if status.Code(err) != codes.Internal || status.Convert(err).Message() != "internal error" {
+		t.Fatalf("expected Internal without panic details, got %v", err)
+	}
+
+	// The server survives the panic and keeps answering
+	if _, _, err := callEcho(context.Background(), conn, "hello"); err != nil {
+		t.Fatalf("call after panic: %v", err)
+	}
+	if got := echo.calls.Load(); got != 2 {
+		t.Fatalf("expected 2 handler calls, got %d", got)
+	}
+}
+
+func TestInterceptors_ValidationFailureIsInvalidArgument(t *testing.T) {
+	echo, conn := startEchoServer(t)
+
+	_, _, err := callEcho(context.Background(), conn, "")
+	if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != "value is required" {
+		t.Fatalf("expected InvalidArgument with the validation error, got %v", err)
+	}
+	if got := echo.calls.Load(); got != 0 {
+		t.Fatalf("handler ran %d times for an invalid request", got)
+	}
+}
+
+func TestInterceptors_PropagatesRequestID(t *testing.T) {
+	_, conn := startEchoServer(t)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), RequestIDHeader, "req-123")
+	seen, header, err := callEcho(ctx, conn, "hello")
+	if err != nil {
+		t.Fatalf("Echo: %v", err)
+	}
+	if seen != "req-123" {
+		t.Fatalf("handler saw request id %q, want req-123", seen)
+	}
+	if got := header.Get(RequestIDHeader); len(got) != 1 || got[0] != "req-123" {
+		t.Fatalf("response header %v, want req-123", got)
+	}
+}
+
+func TestInterceptors_GeneratesRequestID(t *testing.T) {
+	_, conn := startEchoServer(t)
+
+	first, header, err := callEcho(context.Background(), conn, "hello")
+	if err != nil {
+		t.Fatalf("Echo: %v", err)
+	}
+	if len(first) != 16 {
+		t.Fatalf("expected a 16 hex character request id, got %q", first)
+	}
+	if got := header.Get(RequestIDHeader); len(got) != 1 || got[0] != first {
+		t.Fatalf("response header %v, want %s", got, first)
+	}
+
+	second, _, err := callEcho(context.Background(), conn, "hello")
+	if err != nil {
+		t.Fatalf("Echo: %v", err)
+	}
+	if second == first {
+		t.Fatalf("two calls share request id %s", first)
+	}
+}
@@ -0,0 +1,187 @@
+// Package locale formats prices, numbers and times the way a user asked for in their
+// preferences. Services composing user-facing text (digests, formatted price strings)
+// build a Formatter from the preferences user-service returns.
+package locale
+
+import (
+	"strings"
+	"time"
+	_ "time/tzdata" // service images ship without a zoneinfo database
+	"unicode"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Defaults used when a preference is unset or invalid
+const (
+	DefaultLocale   = "en"
+	DefaultTimezone = "UTC"
+	DefaultCurrency = "USD"
+)
+
+// Currencies are the fiat currencies prices can be converted to and shown in
+var Currencies = []string{"USD", "EUR", "GBP", "JPY", "KRW", "CNY", "VND", "SGD", "AUD", "CAD", "CHF", "INR", "BRL"}
+
+// ParseLocale canonicalizes a BCP 47 tag, accepting "en_US" style input
+func ParseLocale(locale string) (string, bool) {
+	tag, err := language.Parse(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if err != nil || tag == language.Und {
+		return "", false
+	}
+	return tag.String(), true
+}
+
+// ParseAcceptLanguage returns the tags of an Accept-Language header, most preferred first.
+// A malformed header yields none.
+func ParseAcceptLanguage(header string) []string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag != language.Und {
+			out = append(out, tag.String())
+		}
+	}
+	return out
+}
+
+// Match picks the available locale closest to the preferred ones, earlier preferences
+// first, so a pt-PT reader gets pt-BR content rather than none. ok is false when no
+// available locale is close to any preference.
+func Match(available []string, preferred ...string) (string, bool) {
+	if len(available) == 0 || len(preferred) == 0 {
+		return "", false
+	}
+	supported := make([]language.Tag, len(available))
+	for i, locale := range available {
+		supported[i] = language.Make(locale)
+	}
+	wanted := make([]language.Tag, 0, len(preferred))
+	for _, locale := range preferred {
+		if canonical, ok := ParseLocale(locale); ok {
+			wanted = append(wanted, language.Make(canonical))
+		}
+	}
+	if len(wanted) == 0 {
+		return "", false
+	}
+
+	_, index, confidence := language.NewMatcher(supported).Match(wanted...)
+	if confidence == language.No {
+		return "", false
+	}
+	return available[index], true
+}
+
+// ParseTimezone checks an IANA time zone name
+func ParseTimezone(timezone string) (string, bool) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" || strings.EqualFold(timezone, "local") {
+		return "", false
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return "", false
+	}
+	return timezone, true
+}
+
+// ParseCurrency upper-cases an ISO 4217 code and checks it is one of Currencies
+func ParseCurrency(code string) (string, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	for _, c := range Currencies {
+		if c == code {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// Format describes how a locale writes numbers and a currency, for clients formatting on
+// their own
+type Format struct {
+	DecimalSeparator string
+	GroupSeparator   string
+	CurrencySymbol   string
+	FractionDigits   int
+}
+
+// Formatter formats values for one user's locale, time zone and currency
+type Formatter struct {
+	tag      language.Tag
+	location *time.Location
+	currency currency.Unit
+	printer  *message.Printer
+}
+
+// NewFormatter builds a Formatter, falling back to the defaults for invalid preferences
+func NewFormatter(locale, timezone, currencyCode string) *Formatter {
+	tag := language.Make(DefaultLocale)
+	if canonical, ok := ParseLocale(locale); ok {
+		tag = language.Make(canonical)
+	}
+
+	location := time.UTC
+	if name, ok := ParseTimezone(timezone); ok {
+		location, _ = time.LoadLocation(name)
+	}
+
+	unit := currency.USD
+	if code, ok := ParseCurrency(currencyCode); ok {
+		unit, _ = currency.ParseISO(code)
+	}
+
+	return &Formatter{tag: tag, location: location, currency: unit, printer: message.NewPrinter(tag)}
+}
+
+// Locale is the canonical tag the formatter writes in
+func (f *Formatter) Locale() string { return f.tag.String() }
+
+// Timezone is the zone times are shown in
+func (f *Formatter) Timezone() string { return f.location.String() }
+
+// Currency is the ISO 4217 code prices are shown in
+func (f *Formatter) Currency() string { return f.currency.String() }
+
+// Format returns the separators, symbol and precision the formatter uses
+func (f *Formatter) Format() Format {
+	// x/text keeps its number patterns internal; read the separators off a sample
+	sample := []rune(f.printer.Sprint(number.Decimal(1234567.5, number.MinFractionDigits(1), number.MaxFractionDigits(1))))
+	format := Format{
+		CurrencySymbol: f.printer.Sprint(currency.NarrowSymbol(f.currency)),
+		FractionDigits: f.fractionDigits(),
+	}
+	if len(sample) >= 3 {
+		format.DecimalSeparator = string(sample[len(sample)-2])
+		if !unicode.IsDigit(sample[1]) {
+			format.GroupSeparator = string(sample[1])
+		}
+	}
+	return format
+}
+
+// Number writes x with the locale's separators and at most maxFractionDigits decimals
+func (f *Formatter) Number(x float64, maxFractionDigits int) string {
+	return f.printer.Sprint(number.Decimal(x, number.MaxFractionDigits(maxFractionDigits)))
+}
+
+// Price writes an amount already converted to the formatter's currency, e.g. "€ 1.234,50"
+func (f *Formatter) Price(amount float64) string {
+	digits := f.fractionDigits()
+	value := f.printer.Sprint(number.Decimal(amount, number.MinFractionDigits(digits), number.MaxFractionDigits(digits)))
+	return f.printer.Sprint(currency.NarrowSymbol(f.currency)) + " " + value
+}
+
+// Time writes t in the formatter's time zone, with the zone abbreviation
+func (f *Formatter) Time(t time.Time) string {
+	return t.In(f.location).Format("2006-01-02 15:04 MST")
+}
+
+func (f *Formatter) fractionDigits() int {
+	scale, _ := currency.Standard.Rounding(f.currency)
+	return scale
+}
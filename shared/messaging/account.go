@@ -0,0 +1,67 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// AccountEventHandler handles a wallet, auth or profile event addressed to one user
+type AccountEventHandler func(ctx context.Context, event *contracts.AccountEvent) error
+
+// ConsumeAccountEvents binds queueName to every routing key in contracts.AccountEventBindings
+// and hands each event, tagged with its account event type, to handler. Messages without a
+// user_id are logged and dropped.
+func (r *RabbitMQ) ConsumeAccountEvents(queueName, consumerTag string, handler AccountEventHandler) error {
+	var exchanges []ExchangeConfig
+	var bindings []BindingConfig
+	declared := make(map[string]bool)
+	for _, b := range contracts.AccountEventBindings {
+		if !declared[b.Exchange] {
+			declared[b.Exchange] = true
+			exchanges = append(exchanges, ExchangeConfig{Name: b.Exchange, Type: "topic", Durable: true})
+		}
+		bindings = append(bindings, BindingConfig{QueueName: queueName, ExchangeName: b.Exchange, RoutingKey: b.RoutingKey})
+	}
+
+	if err := r.SetupInfrastructure(exchanges, []QueueConfig{{Name: queueName, Durable: true}}, bindings); err != nil {
+		return fmt.Errorf("failed to setup account events queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		eventType := contracts.AccountEventType(delivery.Exchange, delivery.RoutingKey)
+		if eventType == "" {
+			log.Printf("Dropping unexpected account event %s/%s", delivery.Exchange, delivery.RoutingKey)
+			return nil
+		}
+
+		var data map[string]interface{}
+		if err := json.Unmarshal(delivery.Body, &data); err != nil {
+			log.Printf("Dropping malformed %s event: %v", eventType, err)
+			return nil
+		}
+		userID, _ := data["user_id"].(string)
+		if userID == "" {
+			log.Printf("Dropping %s event without user_id", eventType)
+			return nil
+		}
+
+		occurredAt := delivery.Timestamp
+		if occurredAt.IsZero() {
+			occurredAt = time.Now()
+		}
+
+		return handler(ctx, &contracts.AccountEvent{
+			Type:       eventType,
+			UserID:     userID,
+			Data:       data,
+			OccurredAt: occurredAt,
+		})
+	})
+}
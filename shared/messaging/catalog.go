@@ -0,0 +1,96 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// HoldingsChangedHandler handles a catalog.holdings_changed event
+type HoldingsChangedHandler func(ctx context.Context, event *contracts.HoldingsChangedEvent) error
+
+// CollectionUpsertedHandler handles a collections.domain.upserted event
+type CollectionUpsertedHandler func(ctx context.Context, event *contracts.CollectionUpsertedEvent) error
+
+// DropStartingSoonHandler handles a drop.starting_soon alert
+type DropStartingSoonHandler func(ctx context.Context, event *contracts.DropStartingSoonEvent) error
+
+// ConsumeHoldingsChanged binds queueName to catalog.holdings_changed.* and hands each
+// decoded event to handler. Malformed messages are logged and dropped.
+func (r *RabbitMQ) ConsumeHoldingsChanged(queueName, consumerTag string, handler HoldingsChangedHandler) error {
+	err := r.SetupInfrastructure(
+		[]ExchangeConfig{{Name: contracts.CollectionsExchange, Type: "topic", Durable: true}},
+		[]QueueConfig{{Name: queueName, Durable: true}},
+		[]BindingConfig{{QueueName: queueName, ExchangeName: contracts.CollectionsExchange, RoutingKey: contracts.HoldingsChangedKeyPattern}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup holdings changed queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		var event contracts.HoldingsChangedEvent
+		if err := json.Unmarshal(delivery.Body, &event); err != nil {
+			log.Printf("Dropping malformed holdings changed event: %v", err)
+			return nil
+		}
+		if event.EventID == "" {
+			event.EventID = delivery.MessageId
+		}
+		return handler(ctx, &event)
+	})
+}
+
+// ConsumeCollectionUpserted binds queueName to the catalog's collections.domain.upserted.#
+// and hands each decoded event to handler. Malformed messages are logged and dropped.
+func (r *RabbitMQ) ConsumeCollectionUpserted(queueName, consumerTag string, handler CollectionUpsertedHandler) error {
+	err := r.SetupInfrastructure(
+		[]ExchangeConfig{{Name: contracts.CollectionsExchange, Type: "topic", Durable: true}},
+		[]QueueConfig{{Name: queueName, Durable: true}},
+		[]BindingConfig{{QueueName: queueName, ExchangeName: contracts.CollectionsExchange, RoutingKey: contracts.CollectionDomainUpsertedKeyPattern}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup collection upserted queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		var event contracts.CollectionUpsertedEvent
+		if err := json.Unmarshal(delivery.Body, &event); err != nil {
+			log.Printf("Dropping malformed collection upserted event: %v", err)
+			return nil
+		}
+		if event.EventID == "" {
+			event.EventID = delivery.MessageId
+		}
+		return handler(ctx, &event)
+	})
+}
+
+// ConsumeDropStartingSoon binds queueName to the catalog's drop.starting_soon.* alerts and
+// hands each decoded event to handler. Malformed messages are logged and dropped.
+func (r *RabbitMQ) ConsumeDropStartingSoon(queueName, consumerTag string, handler DropStartingSoonHandler) error {
+	err := r.SetupInfrastructure(
+		[]ExchangeConfig{{Name: contracts.CollectionsExchange, Type: "topic", Durable: true}},
+		[]QueueConfig{{Name: queueName, Durable: true}},
+		[]BindingConfig{{QueueName: queueName, ExchangeName: contracts.CollectionsExchange, RoutingKey: contracts.DropStartingSoonKeyPattern}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup drop starting soon queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		var event contracts.DropStartingSoonEvent
+		if err := json.Unmarshal(delivery.Body, &event); err != nil {
+			log.Printf("Dropping malformed drop starting soon event: %v", err)
+			return nil
+		}
+		if event.EventID == "" {
+			event.EventID = delivery.MessageId
+		}
+		return handler(ctx, &event)
+	})
+}
@@ -0,0 +1,37 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// ChainHeadAdvancedHandler handles a chain.head_advanced event
+type ChainHeadAdvancedHandler func(ctx context.Context, event *contracts.ChainHeadAdvancedEvent) error
+
+// ConsumeChainHeadAdvanced binds queueName to every chain.head_advanced event and hands
+// each decoded event to handler. Malformed messages are logged and dropped.
+func (r *RabbitMQ) ConsumeChainHeadAdvanced(queueName, consumerTag string, handler ChainHeadAdvancedHandler) error {
+	err := r.SetupInfrastructure(
+		[]ExchangeConfig{{Name: contracts.ChainsExchange, Type: "topic", Durable: true}},
+		[]QueueConfig{{Name: queueName, Durable: true}},
+		[]BindingConfig{{QueueName: queueName, ExchangeName: contracts.ChainsExchange, RoutingKey: contracts.ChainHeadAdvancedKeyPattern}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup chain.head_advanced queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		var event contracts.ChainHeadAdvancedEvent
+		if err := json.Unmarshal(delivery.Body, &event); err != nil {
+			log.Printf("Dropping malformed chain.head_advanced event: %v", err)
+			return nil
+		}
+		return handler(ctx, &event)
+	})
+}
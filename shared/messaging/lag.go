@@ -0,0 +1,132 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// LagReporter stores a consumer's lag report where operators can read it
+type LagReporter func(ctx context.Context, lag contracts.ConsumerLag) error
+
+// LagTracker measures how fast a consumer settles its deliveries and how long the oldest
+// delivery it holds has been waiting since it was published
+type LagTracker struct {
+	consumer string
+	queue    string
+
+	mu        sync.Mutex
+	inFlight  map[uint64]time.Time // delivery tag -> published at
+	processed int64
+	failed    int64
+
+	// settle count and time of the previous snapshot, for the rate
+	lastProcessed int64
+	lastSnapshot  time.Time
+}
+
+// NewLagTracker creates a tracker for the consumer with tag consumer reading queue
+func NewLagTracker(consumer, queue string) *LagTracker {
+	return &LagTracker{
+		consumer:     consumer,
+		queue:        queue,
+		inFlight:     make(map[uint64]time.Time),
+		lastSnapshot: time.Now(),
+	}
+}
+
+// Received marks a delivery as handed to the consumer. Deliveries published without a
+// timestamp count from now.
+func (t *LagTracker) Received(delivery amqp.Delivery) {
+	publishedAt := delivery.Timestamp
+	if publishedAt.IsZero() {
+		publishedAt = time.Now()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inFlight[delivery.DeliveryTag] = publishedAt
+}
+
+// Settled marks a delivery as acked or rejected; err is the handler's result
+func (t *LagTracker) Settled(delivery amqp.Delivery, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.inFlight, delivery.DeliveryTag)
+	t.processed++
+	if err != nil {
+		t.failed++
+	}
+}
+
+// Snapshot reports the lag at now. MessagesPerSecond is the settle rate since the
+// previous snapshot.
+func (t *LagTracker) Snapshot(now time.Time) contracts.ConsumerLag {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lag := contracts.ConsumerLag{
+		Consumer:   t.consumer,
+		Queue:      t.queue,
+		InFlight:   len(t.inFlight),
+		Processed:  t.processed,
+		Failed:     t.failed,
+		ReportedAt: now,
+	}
+	for _, publishedAt := range t.inFlight {
+		if age := now.Sub(publishedAt).Seconds(); age > lag.OldestUnackedSeconds {
+			lag.OldestUnackedSeconds = age
+		}
+	}
+	if elapsed := now.Sub(t.lastSnapshot).Seconds(); elapsed > 0 {
+		lag.MessagesPerSecond = float64(t.processed-t.lastProcessed) / elapsed
+	}
+
+	t.lastProcessed = t.processed
+	t.lastSnapshot = now
+	return lag
+}
+
+// Report hands a snapshot to report every interval until ctx is done. Failed reports are
+// logged and retried with the next snapshot.
+func (t *LagTracker) Report(ctx context.Context, interval time.Duration, report LagReporter) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := report(ctx, t.Snapshot(now)); err != nil {
+				log.Printf("Failed to report lag of consumer %s: %v", t.consumer, err)
+			}
+		}
+	}
+}
+
+// InspectQueue reads the depth of a queue without declaring it. A passive declare of a
+// missing queue closes the channel it ran on, so it gets a channel of its own.
+func (r *RabbitMQ) InspectQueue(name string) (contracts.QueueDepth, error) {
+	if r.closed {
+		return contracts.QueueDepth{}, fmt.Errorf("connection is closed")
+	}
+
+	ch, err := r.conn.Channel()
+	if err != nil {
+		return contracts.QueueDepth{}, fmt.Errorf("failed to create channel: %w", err)
+	}
+	defer ch.Close()
+
+	queue, err := ch.QueueDeclarePassive(name, true, false, false, false, nil)
+	if err != nil {
+		return contracts.QueueDepth{}, fmt.Errorf("failed to inspect queue %s: %w", name, err)
+	}
+	return contracts.QueueDepth{Name: queue.Name, Messages: queue.Messages, Consumers: queue.Consumers}, nil
+}
@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
+	"sync"
 	"time"
 
 	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+	"github.com/quangdang46/NFT-Marketplace/shared/monitoring"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
@@ -18,6 +22,8 @@ type RabbitMQConfig struct {
 	RabbitMQUser     string `json:"rabbitmq_user"`
 	RabbitMQPassword string `json:"rabbitmq_password"`
 	RabbitMQExchange string `json:"rabbitmq_exchange"`
+	// Tenant, when set, connects to the tenant's vhost; see package tenant
+	Tenant string `json:"tenant,omitempty"`
 }
 
 // ExchangeConfig defines exchange configuration
@@ -41,6 +47,32 @@ type QueueConfig struct {
 	MaxLength  int32  `json:"max_length,omitempty"` // Max queue length
 	DLX        string `json:"dlx,omitempty"`        // Dead Letter Exchange
 	DLRKey     string `json:"dlr_key,omitempty"`    // Dead Letter Routing Key
+	// SingleActiveConsumer delivers to one consumer at a time, keeping the queue's order
+	// across replicas; the others take over when it goes away. Queue arguments are fixed
+	// at declaration, so an existing queue must be deleted before this is turned on.
+	SingleActiveConsumer bool `json:"single_active_consumer,omitempty"`
+}
+
+// Args returns the queue declaration arguments
+func (c QueueConfig) Args() amqp.Table {
+	args := amqp.Table{}
+
+	if c.TTL > 0 {
+		args["x-message-ttl"] = c.TTL
+	}
+	if c.MaxLength > 0 {
+		args["x-max-length"] = c.MaxLength
+	}
+	if c.DLX != "" {
+		args["x-dead-letter-exchange"] = c.DLX
+	}
+	if c.DLRKey != "" {
+		args["x-dead-letter-routing-key"] = c.DLRKey
+	}
+	if c.SingleActiveConsumer {
+		args["x-single-active-consumer"] = true
+	}
+	return args
 }
 
 // BindingConfig defines queue-to-exchange binding
@@ -92,11 +124,18 @@ type RabbitMQ struct {
 	channel *amqp.Channel
 	config  RabbitMQConfig
 	closed  bool
+
+	// Consumers started by Consume, cancelled and waited for by Drain
+	consumersMu  sync.Mutex
+	consumerTags []string
+	consumers    sync.WaitGroup
 }
 
 // NewRabbitMQ creates a new RabbitMQ client with configuration
 func NewRabbitMQ(config RabbitMQConfig) (*RabbitMQ, error) {
-	// Set defaults
+	if err := tenant.Validate(config.Tenant); err != nil {
+		return nil, err
+	}
 
 	rmq := &RabbitMQ{
 		config: config,
@@ -115,13 +154,17 @@ func (r *RabbitMQ) buildURL() string {
 	if r.config.RabbitMQPort == 5671 {
 		scheme = "amqps"
 	}
-	return fmt.Sprintf("%s://%s:%s@%s:%d",
+	base := fmt.Sprintf("%s://%s:%s@%s:%d",
 		scheme,
 		r.config.RabbitMQUser,
 		r.config.RabbitMQPassword,
 		r.config.RabbitMQHost,
 		r.config.RabbitMQPort,
 	)
+	if r.config.Tenant == "" {
+		return base
+	}
+	return base + "/" + url.PathEscape(tenant.VHost(r.config.Tenant))
 }
 
 // connect establishes connection to RabbitMQ
@@ -171,28 +214,13 @@ func (r *RabbitMQ) DeclareExchange(config ExchangeConfig) error {
 
 // DeclareQueue declares a queue
 func (r *RabbitMQ) DeclareQueue(config QueueConfig) (amqp.Queue, error) {
-	args := amqp.Table{}
-
-	if config.TTL > 0 {
-		args["x-message-ttl"] = config.TTL
-	}
-	if config.MaxLength > 0 {
-		args["x-max-length"] = config.MaxLength
-	}
-	if config.DLX != "" {
-		args["x-dead-letter-exchange"] = config.DLX
-	}
-	if config.DLRKey != "" {
-		args["x-dead-letter-routing-key"] = config.DLRKey
-	}
-
 	return r.channel.QueueDeclare(
 		config.Name,
 		config.Durable,
 		config.AutoDelete,
 		config.Exclusive,
 		config.NoWait,
-		args,
+		config.Args(),
 	)
 }
 
@@ -308,12 +336,16 @@ func (r *RabbitMQ) PublishJSON(ctx context.Context, exchange, routingKey string,
 	})
 }
 
-// Consume starts consuming messages from a queue
+// Consume starts consuming messages from a queue. Drain stops it and waits for the
+// deliveries already received to be handled.
 func (r *RabbitMQ) Consume(queueName, consumerTag string, handler MessageHandler) error {
 	if r.closed {
 		return fmt.Errorf("connection is closed")
 	}
 
+	// Tags must be unique per channel for Drain to cancel each consumer
+	consumerTag = fmt.Sprintf("%s-%s", consumerTag, queueName)
+
 	msgs, err := r.channel.Consume(
 		queueName,
 		consumerTag,
@@ -327,11 +359,18 @@ func (r *RabbitMQ) Consume(queueName, consumerTag string, handler MessageHandler
 		return fmt.Errorf("failed to register consumer: %w", err)
 	}
 
+	r.consumersMu.Lock()
+	r.consumerTags = append(r.consumerTags, consumerTag)
+	r.consumersMu.Unlock()
+
+	r.consumers.Add(1)
 	go func() {
+		defer r.consumers.Done()
 		ctx := context.Background()
 		for msg := range msgs {
 			if err := handler(ctx, msg); err != nil {
 				log.Printf("Message handler error: %v", err)
+				monitoring.CaptureError(deliveryTags(ctx, queueName, msg), err)
 				// Reject and requeue the message
 				msg.Nack(false, true)
 			} else {
@@ -344,6 +383,55 @@ func (r *RabbitMQ) Consume(queueName, consumerTag string, handler MessageHandler
 	return nil
 }
 
+// Drain stops every consumer started by Consume from fetching and waits until the
+// deliveries they already received are handled and acked, so a shutdown neither drops
+// nor reorders in-flight messages. Whatever is unacked when ctx ends is redelivered
+// once the connection closes.
+func (r *RabbitMQ) Drain(ctx context.Context) error {
+	r.consumersMu.Lock()
+	tags := r.consumerTags
+	r.consumerTags = nil
+	r.consumersMu.Unlock()
+
+	for _, tag := range tags {
+		// The broker stops delivering once it confirms; received deliveries still arrive
+		if err := r.channel.Cancel(tag, false); err != nil {
+			log.Printf("Error cancelling consumer %s: %v", tag, err)
+		}
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		r.consumers.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("consumers still handling messages: %w", ctx.Err())
+	}
+}
+
+// deliveryTags attaches the queue, routing key and the standard ids found in the message body
+func deliveryTags(ctx context.Context, queueName string, msg amqp.Delivery) context.Context {
+	var ids struct {
+		EventID  string `json:"event_id"`
+		ChainID  string `json:"chain_id"`
+		IntentID string `json:"intent_id"`
+	}
+	_ = json.Unmarshal(msg.Body, &ids)
+
+	return monitoring.WithTags(ctx,
+		"queue", queueName,
+		"routing_key", msg.RoutingKey,
+		monitoring.TagEventID, ids.EventID,
+		monitoring.TagChainID, ids.ChainID,
+		monitoring.TagIntentID, ids.IntentID,
+	)
+}
+
 // SetupInfrastructure sets up exchanges, queues, and bindings
 func (r *RabbitMQ) SetupInfrastructure(exchanges []ExchangeConfig, queues []QueueConfig, bindings []BindingConfig) error {
 	// Declare exchanges
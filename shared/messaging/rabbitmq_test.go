@@ -0,0 +1,41 @@
+package messaging
+
+import "testing"
+
+func TestBuildURL(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  RabbitMQConfig
+		want string
+	}{
+		{
+			name: "default vhost",
+			cfg:  RabbitMQConfig{RabbitMQHost: "mq", RabbitMQPort: 5672, RabbitMQUser: "guest", RabbitMQPassword: "guest"},
+			want: "amqp://guest:guest@mq:5672",
+		},
+		{
+			name: "tenant vhost",
+			cfg:  RabbitMQConfig{RabbitMQHost: "mq", RabbitMQPort: 5672, RabbitMQUser: "guest", RabbitMQPassword: "guest", Tenant: "pr123"},
+			want: "amqp://guest:guest@mq:5672/tenant_pr123",
+		},
+		{
+			name: "tenant vhost over TLS",
+			cfg:  RabbitMQConfig{RabbitMQHost: "mq", RabbitMQPort: 5671, RabbitMQUser: "guest", RabbitMQPassword: "guest", Tenant: "pr123"},
+			want: "amqps://guest:guest@mq:5671/tenant_pr123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RabbitMQ{config: tt.cfg}
+			if got := r.buildURL(); got != tt.want {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRabbitMQ_RefusesInvalidTenant(t *testing.T) {
+	if _, err := NewRabbitMQ(RabbitMQConfig{RabbitMQHost: "mq", Tenant: "../other"}); err == nil {
+		t.Fatal("expected an invalid tenant to be refused")
+	}
+}
@@ -0,0 +1,62 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// AbiChangedHandler handles a registry.abi_changed event
+type AbiChangedHandler func(ctx context.Context, event *contracts.AbiChangedEvent) error
+
+// ConsumeAbiChanged binds queueName to every registry.abi_changed event and hands
+// each decoded event to handler. Malformed messages are logged and dropped.
+func (r *RabbitMQ) ConsumeAbiChanged(queueName, consumerTag string, handler AbiChangedHandler) error {
+	err := r.SetupInfrastructure(
+		[]ExchangeConfig{{Name: contracts.RegistryExchange, Type: "topic", Durable: true}},
+		[]QueueConfig{{Name: queueName, Durable: true}},
+		[]BindingConfig{{QueueName: queueName, ExchangeName: contracts.RegistryExchange, RoutingKey: contracts.AbiChangedKeyPattern}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup abi_changed queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		var event contracts.AbiChangedEvent
+		if err := json.Unmarshal(delivery.Body, &event); err != nil {
+			log.Printf("Dropping malformed abi_changed event: %v", err)
+			return nil
+		}
+		return handler(ctx, &event)
+	})
+}
+
+// RegistryChangedHandler handles a registry.changed event
+type RegistryChangedHandler func(ctx context.Context, event *contracts.RegistryChangedEvent) error
+
+// ConsumeRegistryChanged binds queueName to every registry.changed event and hands
+// each decoded event to handler. Malformed messages are logged and dropped.
+func (r *RabbitMQ) ConsumeRegistryChanged(queueName, consumerTag string, handler RegistryChangedHandler) error {
+	err := r.SetupInfrastructure(
+		[]ExchangeConfig{{Name: contracts.RegistryExchange, Type: "topic", Durable: true}},
+		[]QueueConfig{{Name: queueName, Durable: true}},
+		[]BindingConfig{{QueueName: queueName, ExchangeName: contracts.RegistryExchange, RoutingKey: contracts.RegistryChangedKeyPattern}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup registry.changed queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		var event contracts.RegistryChangedEvent
+		if err := json.Unmarshal(delivery.Body, &event); err != nil {
+			log.Printf("Dropping malformed registry.changed event: %v", err)
+			return nil
+		}
+		return handler(ctx, &event)
+	})
+}
@@ -0,0 +1,40 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// SaleIndexedHandler handles a sale indexed event
+type SaleIndexedHandler func(ctx context.Context, event *contracts.SaleIndexedEvent) error
+
+// ConsumeSaleIndexed binds queueName to sales.events.indexed.* on the configured exchange and
+// hands each decoded event to handler. Malformed messages are logged and dropped.
+func (r *RabbitMQ) ConsumeSaleIndexed(queueName, consumerTag string, handler SaleIndexedHandler) error {
+	err := r.SetupInfrastructure(
+		[]ExchangeConfig{{Name: r.GetExchange(), Type: "topic", Durable: true}},
+		[]QueueConfig{{Name: queueName, Durable: true}},
+		[]BindingConfig{{QueueName: queueName, ExchangeName: r.GetExchange(), RoutingKey: contracts.SaleIndexedKeyPattern}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup sales indexed queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		var event contracts.SaleIndexedEvent
+		if err := json.Unmarshal(delivery.Body, &event); err != nil {
+			log.Printf("Dropping malformed sale indexed event: %v", err)
+			return nil
+		}
+		if event.EventID == "" {
+			event.EventID = delivery.MessageId
+		}
+		return handler(ctx, &event)
+	})
+}
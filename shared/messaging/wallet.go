@@ -0,0 +1,37 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// WalletUnlinkedHandler handles a wallet.unlinked event
+type WalletUnlinkedHandler func(ctx context.Context, event *contracts.WalletUnlinkedEvent) error
+
+// ConsumeWalletUnlinked binds queueName to wallet.unlinked and hands each decoded event
+// to handler. Malformed messages are logged and dropped.
+func (r *RabbitMQ) ConsumeWalletUnlinked(queueName, consumerTag string, handler WalletUnlinkedHandler) error {
+	err := r.SetupInfrastructure(
+		[]ExchangeConfig{{Name: contracts.WalletsExchange, Type: "topic", Durable: true}},
+		[]QueueConfig{{Name: queueName, Durable: true}},
+		[]BindingConfig{{QueueName: queueName, ExchangeName: contracts.WalletsExchange, RoutingKey: contracts.WalletUnlinkedKey}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to setup wallet.unlinked queue: %w", err)
+	}
+
+	return r.Consume(queueName, consumerTag, func(ctx context.Context, delivery amqp.Delivery) error {
+		var event contracts.WalletUnlinkedEvent
+		if err := json.Unmarshal(delivery.Body, &event); err != nil {
+			log.Printf("Dropping malformed wallet.unlinked event: %v", err)
+			return nil
+		}
+		return handler(ctx, &event)
+	})
+}
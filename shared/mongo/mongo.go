@@ -7,12 +7,16 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 // MongoConfig holds MongoDB connection configuration
 type MongoConfig struct {
 	MongoURI      string `json:"mongo_uri"`
 	MongoDatabase string `json:"mongo_database"`
+	// Tenant, when set, swaps the database for the tenant's own; see package tenant
+	Tenant string `json:"tenant,omitempty"`
 }
 
 // MongoDB represents a MongoDB connection wrapper
@@ -23,6 +27,9 @@ type MongoDB struct {
 
 // NewMongoFromConfig creates a new MongoDB connection from configuration
 func NewMongo(cfg MongoConfig) (*MongoDB, error) {
+	if err := tenant.Validate(cfg.Tenant); err != nil {
+		return nil, err
+	}
 
 	clientOptions := options.Client().
 		ApplyURI(cfg.MongoURI)
@@ -33,7 +40,7 @@ func NewMongo(cfg MongoConfig) (*MongoDB, error) {
 	}
 
 	// Get database reference
-	database := client.Database(cfg.MongoDatabase)
+	database := client.Database(tenant.Database(cfg.MongoDatabase, cfg.Tenant))
 
 	return &MongoDB{
 		client:   client,
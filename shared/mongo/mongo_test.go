@@ -0,0 +1,34 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewMongo_TenantDatabase(t *testing.T) {
+	tests := []struct {
+		name   string
+		tenant string
+		want   string
+	}{
+		{name: "no tenant", want: "indexer"},
+		{name: "tenant", tenant: "pr123", want: "indexer_pr123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Connecting is lazy, so nothing needs to listen
+			m, err := NewMongo(MongoConfig{MongoURI: "mongodb://127.0.0.1:1", MongoDatabase: "indexer", Tenant: tt.tenant})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer m.Close(context.Background())
+			if got := m.GetDatabase().Name(); got != tt.want {
+				t.Fatalf("database = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewMongo(MongoConfig{MongoURI: "mongodb://127.0.0.1:1", MongoDatabase: "indexer", Tenant: "PR 123"}); err == nil {
+		t.Fatal("expected an invalid tenant to be refused")
+	}
+}
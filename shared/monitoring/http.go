@@ -0,0 +1,65 @@
+package monitoring
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+// requestIDHeader matches grpcserver.RequestIDHeader for requests entering over HTTP
+const requestIDHeader = "X-Request-Id"
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Flush and Hijack keep streaming and websocket upgrades working through the middleware
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return h.Hijack()
+}
+
+// HTTPMiddleware reports panics and 5xx responses with the request method, path
+// and request id. Panics are answered with 500 instead of dropping the connection.
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithTags(r.Context(),
+			"http_method", r.Method,
+			"http_path", r.URL.Path,
+			"request_id", r.Header.Get(requestIDHeader),
+		)
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		defer func() {
+			if p := recover(); p != nil {
+				CaptureError(ctx, fmt.Errorf("panic: %v", p))
+				http.Error(w, "internal error", http.StatusInternalServerError)
+			}
+		}()
+
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		if rec.status >= http.StatusInternalServerError {
+			CaptureError(WithTags(ctx, "http_status", strconv.Itoa(rec.status)),
+				fmt.Errorf("%s %s returned %d", r.Method, r.URL.Path, rec.status))
+		}
+	})
+}
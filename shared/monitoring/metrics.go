@@ -0,0 +1,12 @@
+package monitoring
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteMetricHeader writes the HELP and TYPE lines that open a metric in the Prometheus
+// text format; kind is counter, gauge or histogram
+func WriteMetricHeader(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
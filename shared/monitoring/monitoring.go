@@ -0,0 +1,228 @@
+/*
+Package monitoring reports errors to an external tracker (Sentry) or to the log,
+with a common set of tags so events from every service can be filtered the same
+way. Services call Init once from main and then CaptureError wherever an error
+is dropped rather than returned; the gRPC and HTTP middleware cover the rest.
+*/
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/env"
+)
+
+// Standard tags attached to reported errors
+const (
+	TagService  = "service"
+	TagChainID  = "chain_id"
+	TagIntentID = "intent_id"
+	TagEventID  = "event_id"
+)
+
+// Providers accepted in MonitoringConfig.Provider
+const (
+	ProviderSentry = "sentry"
+	ProviderLog    = "log"
+	ProviderNone   = "none"
+)
+
+// MonitoringConfig selects the error reporting provider and its sampling
+type MonitoringConfig struct {
+	ServiceName string
+	Provider    string
+	DSN         string
+	Environment string
+	Release     string
+	// SampleRate is the fraction of captured errors that are reported, 0..1
+	SampleRate float64
+	// TracesSampleRate is forwarded to Sentry performance tracing, 0 disables it
+	TracesSampleRate float64
+	Debug            bool
+}
+
+// LoadConfig reads the monitoring configuration from the environment. Sentry is
+// used when SENTRY_DSN is set, otherwise errors are reported to the log.
+func LoadConfig(serviceName string) MonitoringConfig {
+	dsn := env.GetString("SENTRY_DSN", "")
+	provider := ProviderLog
+	if dsn != "" {
+		provider = ProviderSentry
+	}
+
+	return MonitoringConfig{
+		ServiceName:      serviceName,
+		Provider:         env.GetString("MONITORING_PROVIDER", provider),
+		DSN:              dsn,
+		Environment:      env.GetString("APP_ENV", "dev"),
+		Release:          env.GetString("APP_RELEASE", ""),
+		SampleRate:       env.GetFloat("MONITORING_SAMPLE_RATE", 1.0),
+		TracesSampleRate: env.GetFloat("MONITORING_TRACES_SAMPLE_RATE", 0),
+		Debug:            env.GetBool("MONITORING_DEBUG", false),
+	}
+}
+
+// Reporter delivers one error event to a provider
+type Reporter interface {
+	Report(err error, tags map[string]string)
+	Flush(timeout time.Duration)
+}
+
+var (
+	mu          sync.RWMutex
+	reporter    Reporter = nopReporter{}
+	serviceName string
+	sampleRate  = 1.0
+)
+
+// Init installs the configured reporter and returns a function that flushes
+// pending events, to be deferred in main
+func Init(cfg MonitoringConfig) (func(), error) {
+	var r Reporter
+	switch cfg.Provider {
+	case ProviderSentry:
+		sr, err := newSentryReporter(cfg)
+		if err != nil {
+			return func() {}, err
+		}
+		r = sr
+	case ProviderLog, "":
+		r = logReporter{}
+	case ProviderNone:
+		r = nopReporter{}
+	default:
+		return func() {}, fmt.Errorf("unknown monitoring provider %q", cfg.Provider)
+	}
+
+	SetReporter(cfg.ServiceName, r, cfg.SampleRate)
+	log.Printf("Error reporting for %s via %s (sample_rate=%.2f, env=%s)", cfg.ServiceName, cfg.Provider, cfg.SampleRate, cfg.Environment)
+	return func() { r.Flush(2 * time.Second) }, nil
+}
+
+// SetReporter replaces the active reporter; Init uses it and tests can too
+func SetReporter(service string, r Reporter, rate float64) {
+	mu.Lock()
+	defer mu.Unlock()
+	reporter = r
+	serviceName = service
+	sampleRate = rate
+}
+
+type tagsKey struct{}
+
+// WithTags returns a context carrying extra tags for errors captured under it.
+// Pairs are key, value; empty values are skipped.
+func WithTags(ctx context.Context, kv ...string) context.Context {
+	merged := make(map[string]string)
+	for k, v := range TagsFromContext(ctx) {
+		merged[k] = v
+	}
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i+1] != "" {
+			merged[kv[i]] = kv[i+1]
+		}
+	}
+	return context.WithValue(ctx, tagsKey{}, merged)
+}
+
+// TagsFromContext returns the tags attached with WithTags
+func TagsFromContext(ctx context.Context) map[string]string {
+	if tags, ok := ctx.Value(tagsKey{}).(map[string]string); ok {
+		return tags
+	}
+	return nil
+}
+
+// CaptureError reports err with the service tag and every tag on ctx.
+// Context cancellations are not errors worth reporting and are skipped.
+func CaptureError(ctx context.Context, err error) {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+
+	mu.RLock()
+	r, service, rate := reporter, serviceName, sampleRate
+	mu.RUnlock()
+
+	if rate < 1 && rand.Float64() >= rate {
+		return
+	}
+
+	tags := map[string]string{TagService: service}
+	for k, v := range TagsFromContext(ctx) {
+		tags[k] = v
+	}
+	r.Report(err, tags)
+}
+
+type nopReporter struct{}
+
+func (nopReporter) Report(error, map[string]string) {}
+func (nopReporter) Flush(time.Duration)             {}
+
+// logReporter writes one structured line per error
+type logReporter struct{}
+
+func (logReporter) Report(err error, tags map[string]string) {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString("error_report")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "|%s=%s", k, tags[k])
+	}
+	fmt.Fprintf(&b, "|error=%v", err)
+	log.Print(b.String())
+}
+
+func (logReporter) Flush(time.Duration) {}
+
+type sentryReporter struct{}
+
+func newSentryReporter(cfg MonitoringConfig) (*sentryReporter, error) {
+	if cfg.DSN == "" {
+		return nil, errors.New("SENTRY_DSN is required for the sentry provider")
+	}
+
+	err := sentry.Init(sentry.ClientOptions{
+		Dsn:              cfg.DSN,
+		Environment:      cfg.Environment,
+		Release:          cfg.Release,
+		ServerName:       cfg.ServiceName,
+		Debug:            cfg.Debug,
+		EnableTracing:    cfg.TracesSampleRate > 0,
+		TracesSampleRate: cfg.TracesSampleRate,
+		// Sampling is applied in CaptureError so every provider honours it
+		SampleRate: 1.0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to init sentry: %w", err)
+	}
+	return &sentryReporter{}, nil
+}
+
+func (sentryReporter) Report(err error, tags map[string]string) {
+	hub := sentry.CurrentHub().Clone()
+	hub.WithScope(func(scope *sentry.Scope) {
+		scope.SetTags(tags)
+		hub.CaptureException(err)
+	})
+}
+
+func (sentryReporter) Flush(timeout time.Duration) {
+	sentry.Flush(timeout)
+}
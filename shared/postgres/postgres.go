@@ -5,8 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"time"
 
 	_ "github.com/lib/pq"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 type PostgresConfig struct {
@@ -16,13 +19,21 @@ type PostgresConfig struct {
 	PostgresPassword string
 	PostgresDatabase string
 	PostgresSSLMode  string
+	// Tenant, when set, puts the tenant's schema first on the search path; see package tenant
+	Tenant string
 }
 
+// schemaCheckTimeout bounds the check that a tenant's schema exists
+const schemaCheckTimeout = 10 * time.Second
+
 type Postgres struct {
 	conn *sql.DB
 }
 
 func NewPostgres(cfg PostgresConfig) (*Postgres, error) {
+	if err := tenant.Validate(cfg.Tenant); err != nil {
+		return nil, err
+	}
 	dsn := buildDSN(cfg)
 	log.Println("===>Postgres DSN: ", dsn)
 	db, err := sql.Open("postgres", dsn)
@@ -30,9 +41,32 @@ func NewPostgres(cfg PostgresConfig) (*Postgres, error) {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	if cfg.Tenant != "" {
+		if err := checkSchema(db, tenant.Schema(cfg.Tenant)); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
 	return &Postgres{conn: db}, nil
 }
 
+// checkSchema refuses a tenant whose schema was never created: its queries would fall
+// through the search path to the shared tables in public
+func checkSchema(db *sql.DB, schema string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), schemaCheckTimeout)
+	defer cancel()
+
+	var exists bool
+	err := db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM pg_namespace WHERE nspname = $1)`, schema).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check schema %s: %w", schema, err)
+	}
+	if !exists {
+		return fmt.Errorf("schema %s does not exist, create the tenant with tools/tenant first", schema)
+	}
+	return nil
+}
+
 func (p *Postgres) HealthCheck(ctx context.Context) error {
 	return p.conn.PingContext(ctx)
 }
@@ -52,7 +86,7 @@ func buildDSN(cfg PostgresConfig) string {
 	if cfg.PostgresSSLMode == "" {
 		cfg.PostgresSSLMode = "disable"
 	}
-	return fmt.Sprintf(
+	dsn := fmt.Sprintf(
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		cfg.PostgresHost,
 		cfg.PostgresPort,
@@ -61,6 +95,11 @@ func buildDSN(cfg PostgresConfig) string {
 		cfg.PostgresDatabase,
 		cfg.PostgresSSLMode,
 	)
+	if cfg.Tenant != "" {
+		// public stays on the path for the extensions installed there
+		dsn += " search_path=" + tenant.Schema(cfg.Tenant) + ",public"
+	}
+	return dsn
 }
 
 func (p *Postgres) GetClient() *sql.DB {
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBuildDSN(t *testing.T) {
+	base := PostgresConfig{
+		PostgresHost:     "db",
+		PostgresPort:     5432,
+		PostgresUser:     "app",
+		PostgresPassword: "secret",
+		PostgresDatabase: "nft_marketplace",
+	}
+	tests := []struct {
+		name   string
+		tenant string
+		want   string
+	}{
+		{
+			name: "no tenant",
+			want: "host=db port=5432 user=app password=secret dbname=nft_marketplace sslmode=disable",
+		},
+		{
+			name:   "tenant schema first, public kept",
+			tenant: "pr123",
+			want:   "host=db port=5432 user=app password=secret dbname=nft_marketplace sslmode=disable search_path=tenant_pr123,public",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := base
+			cfg.Tenant = tt.tenant
+			if got := buildDSN(cfg); got != tt.want {
+				t.Fatalf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckSchema(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT EXISTS (SELECT 1 FROM pg_namespace WHERE nspname = $1)`)
+	tests := []struct {
+		name    string
+		expect  func(mock sqlmock.Sqlmock)
+		wantErr string
+	}{
+		{
+			name: "schema exists",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs("tenant_pr123").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+		},
+		{
+			name: "schema missing",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs("tenant_pr123").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+			wantErr: "create the tenant with tools/tenant first",
+		},
+		{
+			name: "lookup fails",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs("tenant_pr123").WillReturnError(errors.New("connection refused"))
+			},
+			wantErr: "failed to check schema tenant_pr123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			tt.expect(mock)
+
+			err = checkSchema(db, "tenant_pr123")
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestNewPostgres_RefusesInvalidTenant(t *testing.T) {
+	if _, err := NewPostgres(PostgresConfig{PostgresHost: "db", Tenant: "pr-123; drop"}); err == nil {
+		t.Fatal("expected an invalid tenant to be refused")
+	}
+}
@@ -131,6 +131,8 @@ type VerifySiweRequest struct {
 	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // client IP as seen by the gateway, located for the session
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -186,6 +188,20 @@ func (x *VerifySiweRequest) GetSignature() string {
 	return ""
 }
 
+func (x *VerifySiweRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *VerifySiweRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
 type VerifySiweResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
@@ -574,60 +590,1548 @@ func (x *RevokeSessionByRefreshTokenResponse) GetSuccess() bool {
 	return false
 }
 
-var File_auth_proto protoreflect.FileDescriptor
+// StartImpersonation lets an admin act as another user. The token is short-lived, cannot be
+// refreshed and carries the impersonator, so downstream services can tell it apart.
+type StartImpersonationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminUserId   string                 `protobuf:"bytes,1,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"`
+	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_auth_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"auth.proto\x12\x04auth\"c\n" +
-	"\x0fGetNonceRequest\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
-	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x16\n" +
-	"\x06domain\x18\x03 \x01(\tR\x06domain\"(\n" +
-	"\x10GetNonceResponse\x12\x14\n" +
-	"\x05nonce\x18\x01 \x01(\tR\x05nonce\"j\n" +
-	"\x11VerifySiweRequest\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
-	"\tsignature\x18\x03 \x01(\tR\tsignature\"\xc9\x01\n" +
-	"\x12VerifySiweResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
-	"\n" +
-	"expires_at\x18\x03 \x01(\tR\texpiresAt\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x18\n" +
-	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x19\n" +
-	"\bchain_id\x18\x06 \x01(\tR\achainId\"z\n" +
-	"\x15RefreshSessionRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x1d\n" +
-	"\n" +
-	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x03 \x01(\tR\tipAddress\"\x98\x01\n" +
-	"\x16RefreshSessionResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
-	"\n" +
-	"expires_at\x18\x03 \x01(\tR\texpiresAt\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\"5\n" +
-	"\x14RevokeSessionRequest\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"1\n" +
-	"\x15RevokeSessionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"I\n" +
-	"\"RevokeSessionByRefreshTokenRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"?\n" +
-	"#RevokeSessionByRefreshTokenResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x94\x03\n" +
+func (x *StartImpersonationRequest) Reset() {
+	*x = StartImpersonationRequest{}
+	mi := &file_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartImpersonationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartImpersonationRequest) ProtoMessage() {}
+
+func (x *StartImpersonationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartImpersonationRequest.ProtoReflect.Descriptor instead.
+func (*StartImpersonationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartImpersonationRequest) GetAdminUserId() string {
+	if x != nil {
+		return x.AdminUserId
+	}
+	return ""
+}
+
+func (x *StartImpersonationRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *StartImpersonationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StartImpersonationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken    string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	ExpiresAt      string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ImpersonatorId string                 `protobuf:"bytes,4,opt,name=impersonator_id,json=impersonatorId,proto3" json:"impersonator_id,omitempty"`
+	SessionId      string                 `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartImpersonationResponse) Reset() {
+	*x = StartImpersonationResponse{}
+	mi := &file_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartImpersonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartImpersonationResponse) ProtoMessage() {}
+
+func (x *StartImpersonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartImpersonationResponse.ProtoReflect.Descriptor instead.
+func (*StartImpersonationResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StartImpersonationResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *StartImpersonationResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *StartImpersonationResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartImpersonationResponse) GetImpersonatorId() string {
+	if x != nil {
+		return x.ImpersonatorId
+	}
+	return ""
+}
+
+func (x *StartImpersonationResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type EndImpersonationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndImpersonationRequest) Reset() {
+	*x = EndImpersonationRequest{}
+	mi := &file_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndImpersonationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndImpersonationRequest) ProtoMessage() {}
+
+func (x *EndImpersonationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndImpersonationRequest.ProtoReflect.Descriptor instead.
+func (*EndImpersonationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EndImpersonationRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type EndImpersonationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndImpersonationResponse) Reset() {
+	*x = EndImpersonationResponse{}
+	mi := &file_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndImpersonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndImpersonationResponse) ProtoMessage() {}
+
+func (x *EndImpersonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndImpersonationResponse.ProtoReflect.Descriptor instead.
+func (*EndImpersonationResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EndImpersonationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// IssueSubscriptionTicket hands out a single-use ticket for opening a subscription socket,
+// so the access token never travels in a WebSocket URL. The ticket is bound to the session
+// and origin it was issued for and expires after a few seconds.
+type IssueSubscriptionTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Origin        string                 `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSubscriptionTicketRequest) Reset() {
+	*x = IssueSubscriptionTicketRequest{}
+	mi := &file_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSubscriptionTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSubscriptionTicketRequest) ProtoMessage() {}
+
+func (x *IssueSubscriptionTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSubscriptionTicketRequest.ProtoReflect.Descriptor instead.
+func (*IssueSubscriptionTicketRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IssueSubscriptionTicketRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IssueSubscriptionTicketRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *IssueSubscriptionTicketRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+type IssueSubscriptionTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSubscriptionTicketResponse) Reset() {
+	*x = IssueSubscriptionTicketResponse{}
+	mi := &file_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSubscriptionTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSubscriptionTicketResponse) ProtoMessage() {}
+
+func (x *IssueSubscriptionTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSubscriptionTicketResponse.ProtoReflect.Descriptor instead.
+func (*IssueSubscriptionTicketResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IssueSubscriptionTicketResponse) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *IssueSubscriptionTicketResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// ValidateSession tells another service whether a session is active and belongs to a user,
+// so it can tie work to the login that asked for it
+type ValidateSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateSessionRequest) Reset() {
+	*x = ValidateSessionRequest{}
+	mi := &file_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSessionRequest) ProtoMessage() {}
+
+func (x *ValidateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSessionRequest.ProtoReflect.Descriptor instead.
+func (*ValidateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidateSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidateSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ValidateSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // set when active
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateSessionResponse) Reset() {
+	*x = ValidateSessionResponse{}
+	mi := &file_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSessionResponse) ProtoMessage() {}
+
+func (x *ValidateSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSessionResponse.ProtoReflect.Descriptor instead.
+func (*ValidateSessionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidateSessionResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *ValidateSessionResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// ApiKey is a developer key acting as its user; the secret is only returned on creation
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"` // start of the secret, to tell keys apart
+	Plan          string                 `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`     // "free" or "pro"
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // to the hour; empty if never used
+	RevokedAt     string                 `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`      // empty while active
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ApiKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+// ApiPlanLimits are the limits of a plan; 0 leaves a limit off
+type ApiPlanLimits struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Plan           string                 `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	DailyQuota     int64                  `protobuf:"varint,2,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`
+	BurstPerSecond int64                  `protobuf:"varint,3,opt,name=burst_per_second,json=burstPerSecond,proto3" json:"burst_per_second,omitempty"`
+	Enforcement    string                 `protobuf:"bytes,4,opt,name=enforcement,proto3" json:"enforcement,omitempty"` // "hard" rejects requests over the daily quota, "soft" flags them
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApiPlanLimits) Reset() {
+	*x = ApiPlanLimits{}
+	mi := &file_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiPlanLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiPlanLimits) ProtoMessage() {}
+
+func (x *ApiPlanLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiPlanLimits.ProtoReflect.Descriptor instead.
+func (*ApiPlanLimits) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApiPlanLimits) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *ApiPlanLimits) GetDailyQuota() int64 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+func (x *ApiPlanLimits) GetBurstPerSecond() int64 {
+	if x != nil {
+		return x.BurstPerSecond
+	}
+	return 0
+}
+
+func (x *ApiPlanLimits) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateApiKeyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *ApiKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateApiKeyResponse) GetKey() *ApiKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListApiKeysRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*ApiKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListApiKeysResponse) GetKeys() []*ApiKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeApiKeyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeApiKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{25}
+}
+
+// SetApiKeyPlan moves a key to another plan; only admins may
+type SetApiKeyPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminUserId   string                 `protobuf:"bytes,1,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Plan          string                 `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetApiKeyPlanRequest) Reset() {
+	*x = SetApiKeyPlanRequest{}
+	mi := &file_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetApiKeyPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApiKeyPlanRequest) ProtoMessage() {}
+
+func (x *SetApiKeyPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApiKeyPlanRequest.ProtoReflect.Descriptor instead.
+func (*SetApiKeyPlanRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetApiKeyPlanRequest) GetAdminUserId() string {
+	if x != nil {
+		return x.AdminUserId
+	}
+	return ""
+}
+
+func (x *SetApiKeyPlanRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *SetApiKeyPlanRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+type SetApiKeyPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *ApiKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetApiKeyPlanResponse) Reset() {
+	*x = SetApiKeyPlanResponse{}
+	mi := &file_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetApiKeyPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApiKeyPlanResponse) ProtoMessage() {}
+
+func (x *SetApiKeyPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApiKeyPlanResponse.ProtoReflect.Descriptor instead.
+func (*SetApiKeyPlanResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetApiKeyPlanResponse) GetKey() *ApiKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// AuthorizeApiKey counts one request made with an API key against its plan. Unknown and
+// revoked keys fail with UNAUTHENTICATED; a refused request is allowed = false.
+type AuthorizeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeApiKeyRequest) Reset() {
+	*x = AuthorizeApiKeyRequest{}
+	mi := &file_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeApiKeyRequest) ProtoMessage() {}
+
+func (x *AuthorizeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AuthorizeApiKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type AuthorizeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Limits        *ApiPlanLimits         `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	UsedToday     int64                  `protobuf:"varint,5,opt,name=used_today,json=usedToday,proto3" json:"used_today,omitempty"`
+	OverQuota     bool                   `protobuf:"varint,6,opt,name=over_quota,json=overQuota,proto3" json:"over_quota,omitempty"`
+	RejectReason  string                 `protobuf:"bytes,7,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`    // "burst" or "quota" when refused
+	ResetsAt      string                 `protobuf:"bytes,8,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"`                // when the daily quota starts over
+	RetryAfterMs  int64                  `protobuf:"varint,9,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"` // set when refused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeApiKeyResponse) Reset() {
+	*x = AuthorizeApiKeyResponse{}
+	mi := &file_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeApiKeyResponse) ProtoMessage() {}
+
+func (x *AuthorizeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AuthorizeApiKeyResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *AuthorizeApiKeyResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthorizeApiKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *AuthorizeApiKeyResponse) GetLimits() *ApiPlanLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *AuthorizeApiKeyResponse) GetUsedToday() int64 {
+	if x != nil {
+		return x.UsedToday
+	}
+	return 0
+}
+
+func (x *AuthorizeApiKeyResponse) GetOverQuota() bool {
+	if x != nil {
+		return x.OverQuota
+	}
+	return false
+}
+
+func (x *AuthorizeApiKeyResponse) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *AuthorizeApiKeyResponse) GetResetsAt() string {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return ""
+}
+
+func (x *AuthorizeApiKeyResponse) GetRetryAfterMs() int64 {
+	if x != nil {
+		return x.RetryAfterMs
+	}
+	return 0
+}
+
+type ApiUsageHour struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hour          string                 `protobuf:"bytes,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	Requests      int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Rejected      int64                  `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiUsageHour) Reset() {
+	*x = ApiUsageHour{}
+	mi := &file_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiUsageHour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiUsageHour) ProtoMessage() {}
+
+func (x *ApiUsageHour) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiUsageHour.ProtoReflect.Descriptor instead.
+func (*ApiUsageHour) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ApiUsageHour) GetHour() string {
+	if x != nil {
+		return x.Hour
+	}
+	return ""
+}
+
+func (x *ApiUsageHour) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ApiUsageHour) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+type ApiKeyUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *ApiKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Limits        *ApiPlanLimits         `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	UsedToday     int64                  `protobuf:"varint,3,opt,name=used_today,json=usedToday,proto3" json:"used_today,omitempty"`
+	Hours         []*ApiUsageHour        `protobuf:"bytes,4,rep,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyUsage) Reset() {
+	*x = ApiKeyUsage{}
+	mi := &file_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyUsage) ProtoMessage() {}
+
+func (x *ApiKeyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyUsage.ProtoReflect.Descriptor instead.
+func (*ApiKeyUsage) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ApiKeyUsage) GetKey() *ApiKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ApiKeyUsage) GetLimits() *ApiPlanLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ApiKeyUsage) GetUsedToday() int64 {
+	if x != nil {
+		return x.UsedToday
+	}
+	return 0
+}
+
+func (x *ApiKeyUsage) GetHours() []*ApiUsageHour {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+// GetApiUsage returns each of the user's keys with their plan, today's use and the last
+// days of hourly usage
+type GetApiUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // 7 when unset, at most 30
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiUsageRequest) Reset() {
+	*x = GetApiUsageRequest{}
+	mi := &file_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiUsageRequest) ProtoMessage() {}
+
+func (x *GetApiUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetApiUsageRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetApiUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetApiUsageRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetApiUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*ApiKeyUsage         `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiUsageResponse) Reset() {
+	*x = GetApiUsageResponse{}
+	mi := &file_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiUsageResponse) ProtoMessage() {}
+
+func (x *GetApiUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetApiUsageResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetApiUsageResponse) GetKeys() []*ApiKeyUsage {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+var File_auth_proto protoreflect.FileDescriptor
+
+const file_auth_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"auth.proto\x12\x04auth\"c\n" +
+	"\x0fGetNonceRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"(\n" +
+	"\x10GetNonceResponse\x12\x14\n" +
+	"\x05nonce\x18\x01 \x01(\tR\x05nonce\"\xa8\x01\n" +
+	"\x11VerifySiweRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\"\xc9\x01\n" +
+	"\x12VerifySiweResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x06 \x01(\tR\achainId\"z\n" +
+	"\x15RefreshSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\"\x98\x01\n" +
+	"\x16RefreshSessionResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"1\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"I\n" +
+	"\"RevokeSessionByRefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"?\n" +
+	"#RevokeSessionByRefreshTokenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"}\n" +
+	"\x19StartImpersonationRequest\x12\"\n" +
+	"\radmin_user_id\x18\x01 \x01(\tR\vadminUserId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\tR\ftargetUserId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xbf\x01\n" +
+	"\x1aStartImpersonationResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12'\n" +
+	"\x0fimpersonator_id\x18\x04 \x01(\tR\x0eimpersonatorId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\"8\n" +
+	"\x17EndImpersonationRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"4\n" +
+	"\x18EndImpersonationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"p\n" +
+	"\x1eIssueSubscriptionTicketRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06origin\x18\x03 \x01(\tR\x06origin\"X\n" +
+	"\x1fIssueSubscriptionTicketResponse\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"P\n" +
+	"\x16ValidateSessionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"P\n" +
+	"\x17ValidateSessionResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"\xb8\x01\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04plan\x18\x04 \x01(\tR\x04plan\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x06 \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\a \x01(\tR\trevokedAt\"\x90\x01\n" +
+	"\rApiPlanLimits\x12\x12\n" +
+	"\x04plan\x18\x01 \x01(\tR\x04plan\x12\x1f\n" +
+	"\vdaily_quota\x18\x02 \x01(\x03R\n" +
+	"dailyQuota\x12(\n" +
+	"\x10burst_per_second\x18\x03 \x01(\x03R\x0eburstPerSecond\x12 \n" +
+	"\venforcement\x18\x04 \x01(\tR\venforcement\"B\n" +
+	"\x13CreateApiKeyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"N\n" +
+	"\x14CreateApiKeyResponse\x12\x1e\n" +
+	"\x03key\x18\x01 \x01(\v2\f.auth.ApiKeyR\x03key\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"-\n" +
+	"\x12ListApiKeysRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
+	"\x13ListApiKeysResponse\x12 \n" +
+	"\x04keys\x18\x01 \x03(\v2\f.auth.ApiKeyR\x04keys\"E\n" +
+	"\x13RevokeApiKeyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\"\x16\n" +
+	"\x14RevokeApiKeyResponse\"e\n" +
+	"\x14SetApiKeyPlanRequest\x12\"\n" +
+	"\radmin_user_id\x18\x01 \x01(\tR\vadminUserId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04plan\x18\x03 \x01(\tR\x04plan\"7\n" +
+	"\x15SetApiKeyPlanResponse\x12\x1e\n" +
+	"\x03key\x18\x01 \x01(\v2\f.auth.ApiKeyR\x03key\"*\n" +
+	"\x16AuthorizeApiKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xb6\x02\n" +
+	"\x17AuthorizeApiKeyResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06key_id\x18\x03 \x01(\tR\x05keyId\x12+\n" +
+	"\x06limits\x18\x04 \x01(\v2\x13.auth.ApiPlanLimitsR\x06limits\x12\x1d\n" +
+	"\n" +
+	"used_today\x18\x05 \x01(\x03R\tusedToday\x12\x1d\n" +
+	"\n" +
+	"over_quota\x18\x06 \x01(\bR\toverQuota\x12#\n" +
+	"\rreject_reason\x18\a \x01(\tR\frejectReason\x12\x1b\n" +
+	"\tresets_at\x18\b \x01(\tR\bresetsAt\x12$\n" +
+	"\x0eretry_after_ms\x18\t \x01(\x03R\fretryAfterMs\"Z\n" +
+	"\fApiUsageHour\x12\x12\n" +
+	"\x04hour\x18\x01 \x01(\tR\x04hour\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x03R\brequests\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x03R\brejected\"\xa3\x01\n" +
+	"\vApiKeyUsage\x12\x1e\n" +
+	"\x03key\x18\x01 \x01(\v2\f.auth.ApiKeyR\x03key\x12+\n" +
+	"\x06limits\x18\x02 \x01(\v2\x13.auth.ApiPlanLimitsR\x06limits\x12\x1d\n" +
+	"\n" +
+	"used_today\x18\x03 \x01(\x03R\tusedToday\x12(\n" +
+	"\x05hours\x18\x04 \x03(\v2\x12.auth.ApiUsageHourR\x05hours\"A\n" +
+	"\x12GetApiUsageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"<\n" +
+	"\x13GetApiUsageResponse\x12%\n" +
+	"\x04keys\x18\x01 \x03(\v2\x11.auth.ApiKeyUsageR\x04keys2\xa8\t\n" +
 	"\vAuthService\x129\n" +
 	"\bGetNonce\x12\x15.auth.GetNonceRequest\x1a\x16.auth.GetNonceResponse\x12?\n" +
 	"\n" +
 	"VerifySiwe\x12\x17.auth.VerifySiweRequest\x1a\x18.auth.VerifySiweResponse\x12K\n" +
 	"\x0eRefreshSession\x12\x1b.auth.RefreshSessionRequest\x1a\x1c.auth.RefreshSessionResponse\x12H\n" +
 	"\rRevokeSession\x12\x1a.auth.RevokeSessionRequest\x1a\x1b.auth.RevokeSessionResponse\x12r\n" +
-	"\x1bRevokeSessionByRefreshToken\x12(.auth.RevokeSessionByRefreshTokenRequest\x1a).auth.RevokeSessionByRefreshTokenResponseB\x18Z\x16shared/proto/auth;authb\x06proto3"
+	"\x1bRevokeSessionByRefreshToken\x12(.auth.RevokeSessionByRefreshTokenRequest\x1a).auth.RevokeSessionByRefreshTokenResponse\x12W\n" +
+	"\x12StartImpersonation\x12\x1f.auth.StartImpersonationRequest\x1a .auth.StartImpersonationResponse\x12Q\n" +
+	"\x10EndImpersonation\x12\x1d.auth.EndImpersonationRequest\x1a\x1e.auth.EndImpersonationResponse\x12f\n" +
+	"\x17IssueSubscriptionTicket\x12$.auth.IssueSubscriptionTicketRequest\x1a%.auth.IssueSubscriptionTicketResponse\x12N\n" +
+	"\x0fValidateSession\x12\x1c.auth.ValidateSessionRequest\x1a\x1d.auth.ValidateSessionResponse\x12E\n" +
+	"\fCreateApiKey\x12\x19.auth.CreateApiKeyRequest\x1a\x1a.auth.CreateApiKeyResponse\x12B\n" +
+	"\vListApiKeys\x12\x18.auth.ListApiKeysRequest\x1a\x19.auth.ListApiKeysResponse\x12E\n" +
+	"\fRevokeApiKey\x12\x19.auth.RevokeApiKeyRequest\x1a\x1a.auth.RevokeApiKeyResponse\x12H\n" +
+	"\rSetApiKeyPlan\x12\x1a.auth.SetApiKeyPlanRequest\x1a\x1b.auth.SetApiKeyPlanResponse\x12N\n" +
+	"\x0fAuthorizeApiKey\x12\x1c.auth.AuthorizeApiKeyRequest\x1a\x1d.auth.AuthorizeApiKeyResponse\x12B\n" +
+	"\vGetApiUsage\x12\x18.auth.GetApiUsageRequest\x1a\x19.auth.GetApiUsageResponseB\x18Z\x16shared/proto/auth;authb\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -641,7 +2145,7 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_auth_proto_goTypes = []any{
 	(*GetNonceRequest)(nil),                     // 0: auth.GetNonceRequest
 	(*GetNonceResponse)(nil),                    // 1: auth.GetNonceResponse
@@ -653,23 +2157,75 @@ var file_auth_proto_goTypes = []any{
 	(*RevokeSessionResponse)(nil),               // 7: auth.RevokeSessionResponse
 	(*RevokeSessionByRefreshTokenRequest)(nil),  // 8: auth.RevokeSessionByRefreshTokenRequest
 	(*RevokeSessionByRefreshTokenResponse)(nil), // 9: auth.RevokeSessionByRefreshTokenResponse
+	(*StartImpersonationRequest)(nil),           // 10: auth.StartImpersonationRequest
+	(*StartImpersonationResponse)(nil),          // 11: auth.StartImpersonationResponse
+	(*EndImpersonationRequest)(nil),             // 12: auth.EndImpersonationRequest
+	(*EndImpersonationResponse)(nil),            // 13: auth.EndImpersonationResponse
+	(*IssueSubscriptionTicketRequest)(nil),      // 14: auth.IssueSubscriptionTicketRequest
+	(*IssueSubscriptionTicketResponse)(nil),     // 15: auth.IssueSubscriptionTicketResponse
+	(*ValidateSessionRequest)(nil),              // 16: auth.ValidateSessionRequest
+	(*ValidateSessionResponse)(nil),             // 17: auth.ValidateSessionResponse
+	(*ApiKey)(nil),                              // 18: auth.ApiKey
+	(*ApiPlanLimits)(nil),                       // 19: auth.ApiPlanLimits
+	(*CreateApiKeyRequest)(nil),                 // 20: auth.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),                // 21: auth.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),                  // 22: auth.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),                 // 23: auth.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),                 // 24: auth.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),                // 25: auth.RevokeApiKeyResponse
+	(*SetApiKeyPlanRequest)(nil),                // 26: auth.SetApiKeyPlanRequest
+	(*SetApiKeyPlanResponse)(nil),               // 27: auth.SetApiKeyPlanResponse
+	(*AuthorizeApiKeyRequest)(nil),              // 28: auth.AuthorizeApiKeyRequest
+	(*AuthorizeApiKeyResponse)(nil),             // 29: auth.AuthorizeApiKeyResponse
+	(*ApiUsageHour)(nil),                        // 30: auth.ApiUsageHour
+	(*ApiKeyUsage)(nil),                         // 31: auth.ApiKeyUsage
+	(*GetApiUsageRequest)(nil),                  // 32: auth.GetApiUsageRequest
+	(*GetApiUsageResponse)(nil),                 // 33: auth.GetApiUsageResponse
 }
 var file_auth_proto_depIdxs = []int32{
-	0, // 0: auth.AuthService.GetNonce:input_type -> auth.GetNonceRequest
-	2, // 1: auth.AuthService.VerifySiwe:input_type -> auth.VerifySiweRequest
-	4, // 2: auth.AuthService.RefreshSession:input_type -> auth.RefreshSessionRequest
-	6, // 3: auth.AuthService.RevokeSession:input_type -> auth.RevokeSessionRequest
-	8, // 4: auth.AuthService.RevokeSessionByRefreshToken:input_type -> auth.RevokeSessionByRefreshTokenRequest
-	1, // 5: auth.AuthService.GetNonce:output_type -> auth.GetNonceResponse
-	3, // 6: auth.AuthService.VerifySiwe:output_type -> auth.VerifySiweResponse
-	5, // 7: auth.AuthService.RefreshSession:output_type -> auth.RefreshSessionResponse
-	7, // 8: auth.AuthService.RevokeSession:output_type -> auth.RevokeSessionResponse
-	9, // 9: auth.AuthService.RevokeSessionByRefreshToken:output_type -> auth.RevokeSessionByRefreshTokenResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	18, // 0: auth.CreateApiKeyResponse.key:type_name -> auth.ApiKey
+	18, // 1: auth.ListApiKeysResponse.keys:type_name -> auth.ApiKey
+	18, // 2: auth.SetApiKeyPlanResponse.key:type_name -> auth.ApiKey
+	19, // 3: auth.AuthorizeApiKeyResponse.limits:type_name -> auth.ApiPlanLimits
+	18, // 4: auth.ApiKeyUsage.key:type_name -> auth.ApiKey
+	19, // 5: auth.ApiKeyUsage.limits:type_name -> auth.ApiPlanLimits
+	30, // 6: auth.ApiKeyUsage.hours:type_name -> auth.ApiUsageHour
+	31, // 7: auth.GetApiUsageResponse.keys:type_name -> auth.ApiKeyUsage
+	0,  // 8: auth.AuthService.GetNonce:input_type -> auth.GetNonceRequest
+	2,  // 9: auth.AuthService.VerifySiwe:input_type -> auth.VerifySiweRequest
+	4,  // 10: auth.AuthService.RefreshSession:input_type -> auth.RefreshSessionRequest
+	6,  // 11: auth.AuthService.RevokeSession:input_type -> auth.RevokeSessionRequest
+	8,  // 12: auth.AuthService.RevokeSessionByRefreshToken:input_type -> auth.RevokeSessionByRefreshTokenRequest
+	10, // 13: auth.AuthService.StartImpersonation:input_type -> auth.StartImpersonationRequest
+	12, // 14: auth.AuthService.EndImpersonation:input_type -> auth.EndImpersonationRequest
+	14, // 15: auth.AuthService.IssueSubscriptionTicket:input_type -> auth.IssueSubscriptionTicketRequest
+	16, // 16: auth.AuthService.ValidateSession:input_type -> auth.ValidateSessionRequest
+	20, // 17: auth.AuthService.CreateApiKey:input_type -> auth.CreateApiKeyRequest
+	22, // 18: auth.AuthService.ListApiKeys:input_type -> auth.ListApiKeysRequest
+	24, // 19: auth.AuthService.RevokeApiKey:input_type -> auth.RevokeApiKeyRequest
+	26, // 20: auth.AuthService.SetApiKeyPlan:input_type -> auth.SetApiKeyPlanRequest
+	28, // 21: auth.AuthService.AuthorizeApiKey:input_type -> auth.AuthorizeApiKeyRequest
+	32, // 22: auth.AuthService.GetApiUsage:input_type -> auth.GetApiUsageRequest
+	1,  // 23: auth.AuthService.GetNonce:output_type -> auth.GetNonceResponse
+	3,  // 24: auth.AuthService.VerifySiwe:output_type -> auth.VerifySiweResponse
+	5,  // 25: auth.AuthService.RefreshSession:output_type -> auth.RefreshSessionResponse
+	7,  // 26: auth.AuthService.RevokeSession:output_type -> auth.RevokeSessionResponse
+	9,  // 27: auth.AuthService.RevokeSessionByRefreshToken:output_type -> auth.RevokeSessionByRefreshTokenResponse
+	11, // 28: auth.AuthService.StartImpersonation:output_type -> auth.StartImpersonationResponse
+	13, // 29: auth.AuthService.EndImpersonation:output_type -> auth.EndImpersonationResponse
+	15, // 30: auth.AuthService.IssueSubscriptionTicket:output_type -> auth.IssueSubscriptionTicketResponse
+	17, // 31: auth.AuthService.ValidateSession:output_type -> auth.ValidateSessionResponse
+	21, // 32: auth.AuthService.CreateApiKey:output_type -> auth.CreateApiKeyResponse
+	23, // 33: auth.AuthService.ListApiKeys:output_type -> auth.ListApiKeysResponse
+	25, // 34: auth.AuthService.RevokeApiKey:output_type -> auth.RevokeApiKeyResponse
+	27, // 35: auth.AuthService.SetApiKeyPlan:output_type -> auth.SetApiKeyPlanResponse
+	29, // 36: auth.AuthService.AuthorizeApiKey:output_type -> auth.AuthorizeApiKeyResponse
+	33, // 37: auth.AuthService.GetApiUsage:output_type -> auth.GetApiUsageResponse
+	23, // [23:38] is the sub-list for method output_type
+	8,  // [8:23] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -683,7 +2239,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
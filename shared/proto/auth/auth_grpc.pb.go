@@ -25,6 +25,16 @@ const (
 	AuthService_RefreshSession_FullMethodName              = "/auth.AuthService/RefreshSession"
 	AuthService_RevokeSession_FullMethodName               = "/auth.AuthService/RevokeSession"
 	AuthService_RevokeSessionByRefreshToken_FullMethodName = "/auth.AuthService/RevokeSessionByRefreshToken"
+	AuthService_StartImpersonation_FullMethodName          = "/auth.AuthService/StartImpersonation"
+	AuthService_EndImpersonation_FullMethodName            = "/auth.AuthService/EndImpersonation"
+	AuthService_IssueSubscriptionTicket_FullMethodName     = "/auth.AuthService/IssueSubscriptionTicket"
+	AuthService_ValidateSession_FullMethodName             = "/auth.AuthService/ValidateSession"
+	AuthService_CreateApiKey_FullMethodName                = "/auth.AuthService/CreateApiKey"
+	AuthService_ListApiKeys_FullMethodName                 = "/auth.AuthService/ListApiKeys"
+	AuthService_RevokeApiKey_FullMethodName                = "/auth.AuthService/RevokeApiKey"
+	AuthService_SetApiKeyPlan_FullMethodName               = "/auth.AuthService/SetApiKeyPlan"
+	AuthService_AuthorizeApiKey_FullMethodName             = "/auth.AuthService/AuthorizeApiKey"
+	AuthService_GetApiUsage_FullMethodName                 = "/auth.AuthService/GetApiUsage"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -36,6 +46,16 @@ type AuthServiceClient interface {
 	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
 	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
 	RevokeSessionByRefreshToken(ctx context.Context, in *RevokeSessionByRefreshTokenRequest, opts ...grpc.CallOption) (*RevokeSessionByRefreshTokenResponse, error)
+	StartImpersonation(ctx context.Context, in *StartImpersonationRequest, opts ...grpc.CallOption) (*StartImpersonationResponse, error)
+	EndImpersonation(ctx context.Context, in *EndImpersonationRequest, opts ...grpc.CallOption) (*EndImpersonationResponse, error)
+	IssueSubscriptionTicket(ctx context.Context, in *IssueSubscriptionTicketRequest, opts ...grpc.CallOption) (*IssueSubscriptionTicketResponse, error)
+	ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...grpc.CallOption) (*ValidateSessionResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	SetApiKeyPlan(ctx context.Context, in *SetApiKeyPlanRequest, opts ...grpc.CallOption) (*SetApiKeyPlanResponse, error)
+	AuthorizeApiKey(ctx context.Context, in *AuthorizeApiKeyRequest, opts ...grpc.CallOption) (*AuthorizeApiKeyResponse, error)
+	GetApiUsage(ctx context.Context, in *GetApiUsageRequest, opts ...grpc.CallOption) (*GetApiUsageResponse, error)
 }
 
 type authServiceClient struct {
@@ -96,6 +116,106 @@ func (c *authServiceClient) RevokeSessionByRefreshToken(ctx context.Context, in
 	return out, nil
 }
 
+func (c *authServiceClient) StartImpersonation(ctx context.Context, in *StartImpersonationRequest, opts ...grpc.CallOption) (*StartImpersonationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartImpersonationResponse)
+	err := c.cc.Invoke(ctx, AuthService_StartImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EndImpersonation(ctx context.Context, in *EndImpersonationRequest, opts ...grpc.CallOption) (*EndImpersonationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndImpersonationResponse)
+	err := c.cc.Invoke(ctx, AuthService_EndImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) IssueSubscriptionTicket(ctx context.Context, in *IssueSubscriptionTicketRequest, opts ...grpc.CallOption) (*IssueSubscriptionTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueSubscriptionTicketResponse)
+	err := c.cc.Invoke(ctx, AuthService_IssueSubscriptionTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...grpc.CallOption) (*ValidateSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_ValidateSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SetApiKeyPlan(ctx context.Context, in *SetApiKeyPlanRequest, opts ...grpc.CallOption) (*SetApiKeyPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetApiKeyPlanResponse)
+	err := c.cc.Invoke(ctx, AuthService_SetApiKeyPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AuthorizeApiKey(ctx context.Context, in *AuthorizeApiKeyRequest, opts ...grpc.CallOption) (*AuthorizeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeApiKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_AuthorizeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetApiUsage(ctx context.Context, in *GetApiUsageRequest, opts ...grpc.CallOption) (*GetApiUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApiUsageResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetApiUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -105,6 +225,16 @@ type AuthServiceServer interface {
 	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
 	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
 	RevokeSessionByRefreshToken(context.Context, *RevokeSessionByRefreshTokenRequest) (*RevokeSessionByRefreshTokenResponse, error)
+	StartImpersonation(context.Context, *StartImpersonationRequest) (*StartImpersonationResponse, error)
+	EndImpersonation(context.Context, *EndImpersonationRequest) (*EndImpersonationResponse, error)
+	IssueSubscriptionTicket(context.Context, *IssueSubscriptionTicketRequest) (*IssueSubscriptionTicketResponse, error)
+	ValidateSession(context.Context, *ValidateSessionRequest) (*ValidateSessionResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	SetApiKeyPlan(context.Context, *SetApiKeyPlanRequest) (*SetApiKeyPlanResponse, error)
+	AuthorizeApiKey(context.Context, *AuthorizeApiKeyRequest) (*AuthorizeApiKeyResponse, error)
+	GetApiUsage(context.Context, *GetApiUsageRequest) (*GetApiUsageResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -130,6 +260,36 @@ func (UnimplementedAuthServiceServer) RevokeSession(context.Context, *RevokeSess
 func (UnimplementedAuthServiceServer) RevokeSessionByRefreshToken(context.Context, *RevokeSessionByRefreshTokenRequest) (*RevokeSessionByRefreshTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessionByRefreshToken not implemented")
 }
+func (UnimplementedAuthServiceServer) StartImpersonation(context.Context, *StartImpersonationRequest) (*StartImpersonationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartImpersonation not implemented")
+}
+func (UnimplementedAuthServiceServer) EndImpersonation(context.Context, *EndImpersonationRequest) (*EndImpersonationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndImpersonation not implemented")
+}
+func (UnimplementedAuthServiceServer) IssueSubscriptionTicket(context.Context, *IssueSubscriptionTicketRequest) (*IssueSubscriptionTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueSubscriptionTicket not implemented")
+}
+func (UnimplementedAuthServiceServer) ValidateSession(context.Context, *ValidateSessionRequest) (*ValidateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSession not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedAuthServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedAuthServiceServer) SetApiKeyPlan(context.Context, *SetApiKeyPlanRequest) (*SetApiKeyPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApiKeyPlan not implemented")
+}
+func (UnimplementedAuthServiceServer) AuthorizeApiKey(context.Context, *AuthorizeApiKeyRequest) (*AuthorizeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeApiKey not implemented")
+}
+func (UnimplementedAuthServiceServer) GetApiUsage(context.Context, *GetApiUsageRequest) (*GetApiUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiUsage not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -241,6 +401,186 @@ func _AuthService_RevokeSessionByRefreshToken_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_StartImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartImpersonationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).StartImpersonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_StartImpersonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).StartImpersonation(ctx, req.(*StartImpersonationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EndImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndImpersonationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EndImpersonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EndImpersonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EndImpersonation(ctx, req.(*EndImpersonationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_IssueSubscriptionTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueSubscriptionTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).IssueSubscriptionTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_IssueSubscriptionTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).IssueSubscriptionTicket(ctx, req.(*IssueSubscriptionTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ValidateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ValidateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ValidateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ValidateSession(ctx, req.(*ValidateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SetApiKeyPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApiKeyPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SetApiKeyPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SetApiKeyPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SetApiKeyPlan(ctx, req.(*SetApiKeyPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AuthorizeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AuthorizeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_AuthorizeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AuthorizeApiKey(ctx, req.(*AuthorizeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetApiUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetApiUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetApiUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetApiUsage(ctx, req.(*GetApiUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,6 +608,46 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeSessionByRefreshToken",
 			Handler:    _AuthService_RevokeSessionByRefreshToken_Handler,
 		},
+		{
+			MethodName: "StartImpersonation",
+			Handler:    _AuthService_StartImpersonation_Handler,
+		},
+		{
+			MethodName: "EndImpersonation",
+			Handler:    _AuthService_EndImpersonation_Handler,
+		},
+		{
+			MethodName: "IssueSubscriptionTicket",
+			Handler:    _AuthService_IssueSubscriptionTicket_Handler,
+		},
+		{
+			MethodName: "ValidateSession",
+			Handler:    _AuthService_ValidateSession_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _AuthService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _AuthService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _AuthService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "SetApiKeyPlan",
+			Handler:    _AuthService_SetApiKeyPlan_Handler,
+		},
+		{
+			MethodName: "AuthorizeApiKey",
+			Handler:    _AuthService_AuthorizeApiKey_Handler,
+		},
+		{
+			MethodName: "GetApiUsage",
+			Handler:    _AuthService_GetApiUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
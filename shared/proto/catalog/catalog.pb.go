@@ -0,0 +1,7947 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.32.0--rc1
+// source: catalog.proto
+
+package catalog
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Collection struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug                  string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId               string                 `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress       string                 `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Creator               string                 `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	Owner                 string                 `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	CollectionType        string                 `protobuf:"bytes,9,opt,name=collection_type,json=collectionType,proto3" json:"collection_type,omitempty"` // ERC721 | ERC1155
+	MaxSupply             string                 `protobuf:"bytes,10,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	TotalSupply           string                 `protobuf:"bytes,11,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	RoyaltyRecipient      string                 `protobuf:"bytes,12,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+	RoyaltyPercentage     uint32                 `protobuf:"varint,13,opt,name=royalty_percentage,json=royaltyPercentage,proto3" json:"royalty_percentage,omitempty"` // basis points
+	TokenUri              string                 `protobuf:"bytes,14,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`
+	ImageUrl              string                 `protobuf:"bytes,15,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	IsVerified            bool                   `protobuf:"varint,16,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Flagged               bool                   `protobuf:"varint,17,opt,name=flagged,proto3" json:"flagged,omitempty"`   // hidden by moderation; only returned with include_flagged
+	Reported              bool                   `protobuf:"varint,18,opt,name=reported,proto3" json:"reported,omitempty"` // user reports pending review
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OwnerOrgId            string                 `protobuf:"bytes,21,opt,name=owner_org_id,json=ownerOrgId,proto3" json:"owner_org_id,omitempty"`                                 // organization managing the collection, empty for creator-managed
+	IntentId              string                 `protobuf:"bytes,22,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`                                         // orchestrator intent that deployed the collection, if any
+	CreatedByUserId       string                 `protobuf:"bytes,23,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`                // user who sent that intent
+	Confirmations         int32                  `protobuf:"varint,24,opt,name=confirmations,proto3" json:"confirmations,omitempty"`                                              // depth of the deployment block
+	RequiredConfirmations int32                  `protobuf:"varint,25,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"` // chain registry depth for finality; pending while confirmations is below it
+	Localized             []*LocalizedContent    `protobuf:"bytes,26,rep,name=localized,proto3" json:"localized,omitempty"`                                                       // creator-written content per locale; only set by GetCollection and GetCollectionBySlug
+	Category              string                 `protobuf:"bytes,27,opt,name=category,proto3" json:"category,omitempty"`                                                         // creator-set, e.g. "art"; empty when unset
+	Tags                  []string               `protobuf:"bytes,28,rep,name=tags,proto3" json:"tags,omitempty"`                                                                 // creator-set, lowercase with dashes
+	PayoutSplits          []*PayoutSplit         `protobuf:"bytes,29,rep,name=payout_splits,json=payoutSplits,proto3" json:"payout_splits,omitempty"`                             // on-chain payout split; only set by GetCollection and GetCollectionBySlug
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Collection) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Collection) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *Collection) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Collection) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Collection) GetCollectionType() string {
+	if x != nil {
+		return x.CollectionType
+	}
+	return ""
+}
+
+func (x *Collection) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *Collection) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+func (x *Collection) GetRoyaltyRecipient() string {
+	if x != nil {
+		return x.RoyaltyRecipient
+	}
+	return ""
+}
+
+func (x *Collection) GetRoyaltyPercentage() uint32 {
+	if x != nil {
+		return x.RoyaltyPercentage
+	}
+	return 0
+}
+
+func (x *Collection) GetTokenUri() string {
+	if x != nil {
+		return x.TokenUri
+	}
+	return ""
+}
+
+func (x *Collection) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Collection) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *Collection) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *Collection) GetReported() bool {
+	if x != nil {
+		return x.Reported
+	}
+	return false
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetOwnerOrgId() string {
+	if x != nil {
+		return x.OwnerOrgId
+	}
+	return ""
+}
+
+func (x *Collection) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *Collection) GetCreatedByUserId() string {
+	if x != nil {
+		return x.CreatedByUserId
+	}
+	return ""
+}
+
+func (x *Collection) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *Collection) GetRequiredConfirmations() int32 {
+	if x != nil {
+		return x.RequiredConfirmations
+	}
+	return 0
+}
+
+func (x *Collection) GetLocalized() []*LocalizedContent {
+	if x != nil {
+		return x.Localized
+	}
+	return nil
+}
+
+func (x *Collection) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Collection) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Collection) GetPayoutSplits() []*PayoutSplit {
+	if x != nil {
+		return x.PayoutSplits
+	}
+	return nil
+}
+
+// LocalizedContent is the creator's description and tagline in one locale
+// PayoutSplit is one recipient's share of a collection's payouts, in basis points
+type PayoutSplit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Bps           uint64                 `protobuf:"varint,2,opt,name=bps,proto3" json:"bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutSplit) Reset() {
+	*x = PayoutSplit{}
+	mi := &file_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutSplit) ProtoMessage() {}
+
+func (x *PayoutSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutSplit.ProtoReflect.Descriptor instead.
+func (*PayoutSplit) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayoutSplit) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *PayoutSplit) GetBps() uint64 {
+	if x != nil {
+		return x.Bps
+	}
+	return 0
+}
+
+type LocalizedContent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Locale          string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // BCP 47, e.g. pt-BR
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Tagline         string                 `protobuf:"bytes,3,opt,name=tagline,proto3" json:"tagline,omitempty"`
+	UpdatedByUserId string                 `protobuf:"bytes,4,opt,name=updated_by_user_id,json=updatedByUserId,proto3" json:"updated_by_user_id,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LocalizedContent) Reset() {
+	*x = LocalizedContent{}
+	mi := &file_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalizedContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalizedContent) ProtoMessage() {}
+
+func (x *LocalizedContent) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalizedContent.ProtoReflect.Descriptor instead.
+func (*LocalizedContent) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LocalizedContent) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *LocalizedContent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LocalizedContent) GetTagline() string {
+	if x != nil {
+		return x.Tagline
+	}
+	return ""
+}
+
+func (x *LocalizedContent) GetUpdatedByUserId() string {
+	if x != nil {
+		return x.UpdatedByUserId
+	}
+	return ""
+}
+
+func (x *LocalizedContent) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ModerationFlag struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId         string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"` // empty when the whole collection is flagged
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                  // "reported" | "flagged" | "cleared"
+	Reason          string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                  // "stolen" | "infringing" | "other"
+	Note            string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	Source          string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`                  // "admin" | "report"
+	ActorId         string                 `protobuf:"bytes,9,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // user id of the admin or reporter
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModerationFlag) Reset() {
+	*x = ModerationFlag{}
+	mi := &file_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationFlag) ProtoMessage() {}
+
+func (x *ModerationFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationFlag.ProtoReflect.Descriptor instead.
+func (*ModerationFlag) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ModerationFlag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ModerationFlag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModerationFlag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type FlagItemRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"` // optional
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Note            string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	Source          string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"` // "admin" hides the item, "report" only sets the badge
+	ActorId         string                 `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FlagItemRequest) Reset() {
+	*x = FlagItemRequest{}
+	mi := &file_catalog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagItemRequest) ProtoMessage() {}
+
+func (x *FlagItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagItemRequest.ProtoReflect.Descriptor instead.
+func (*FlagItemRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlagItemRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *FlagItemRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *FlagItemRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *FlagItemRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FlagItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *FlagItemRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FlagItemRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type FlagItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *ModerationFlag        `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagItemResponse) Reset() {
+	*x = FlagItemResponse{}
+	mi := &file_catalog_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagItemResponse) ProtoMessage() {}
+
+func (x *FlagItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagItemResponse.ProtoReflect.Descriptor instead.
+func (*FlagItemResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FlagItemResponse) GetFlag() *ModerationFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type UnflagItemRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"` // optional
+	Note            string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	ActorId         string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnflagItemRequest) Reset() {
+	*x = UnflagItemRequest{}
+	mi := &file_catalog_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnflagItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnflagItemRequest) ProtoMessage() {}
+
+func (x *UnflagItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnflagItemRequest.ProtoReflect.Descriptor instead.
+func (*UnflagItemRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnflagItemRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *UnflagItemRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *UnflagItemRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *UnflagItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *UnflagItemRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type UnflagItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *ModerationFlag        `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnflagItemResponse) Reset() {
+	*x = UnflagItemResponse{}
+	mi := &file_catalog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnflagItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnflagItemResponse) ProtoMessage() {}
+
+func (x *UnflagItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnflagItemResponse.ProtoReflect.Descriptor instead.
+func (*UnflagItemResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnflagItemResponse) GetFlag() *ModerationFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type SetCollectionOrganizationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	OrgId           string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"` // empty returns the collection to its creator
+	ActorId         string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetCollectionOrganizationRequest) Reset() {
+	*x = SetCollectionOrganizationRequest{}
+	mi := &file_catalog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionOrganizationRequest) ProtoMessage() {}
+
+func (x *SetCollectionOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetCollectionOrganizationRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SetCollectionOrganizationRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SetCollectionOrganizationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SetCollectionOrganizationRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type SetCollectionOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollectionOrganizationResponse) Reset() {
+	*x = SetCollectionOrganizationResponse{}
+	mi := &file_catalog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionOrganizationResponse) ProtoMessage() {}
+
+func (x *SetCollectionOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetCollectionOrganizationResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type SetCollectionContentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Locale          string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"` // empty description and tagline remove the locale
+	Tagline         string                 `protobuf:"bytes,5,opt,name=tagline,proto3" json:"tagline,omitempty"`
+	ActorId         string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetCollectionContentRequest) Reset() {
+	*x = SetCollectionContentRequest{}
+	mi := &file_catalog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionContentRequest) ProtoMessage() {}
+
+func (x *SetCollectionContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionContentRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionContentRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetCollectionContentRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SetCollectionContentRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SetCollectionContentRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetCollectionContentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SetCollectionContentRequest) GetTagline() string {
+	if x != nil {
+		return x.Tagline
+	}
+	return ""
+}
+
+func (x *SetCollectionContentRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type SetCollectionContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollectionContentResponse) Reset() {
+	*x = SetCollectionContentResponse{}
+	mi := &file_catalog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionContentResponse) ProtoMessage() {}
+
+func (x *SetCollectionContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionContentResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionContentResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetCollectionContentResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// Replaces the category and tags; an empty category clears it
+type SetCollectionClassificationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Category        string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"` // "art" | "collectibles" | "gaming" | "memberships" | "music" | "pfp" | "photography" | "sports" | "utility" | "virtual_worlds"
+	Tags            []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	ActorId         string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetCollectionClassificationRequest) Reset() {
+	*x = SetCollectionClassificationRequest{}
+	mi := &file_catalog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionClassificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionClassificationRequest) ProtoMessage() {}
+
+func (x *SetCollectionClassificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionClassificationRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionClassificationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetCollectionClassificationRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SetCollectionClassificationRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SetCollectionClassificationRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SetCollectionClassificationRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SetCollectionClassificationRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type SetCollectionClassificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollectionClassificationResponse) Reset() {
+	*x = SetCollectionClassificationResponse{}
+	mi := &file_catalog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionClassificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionClassificationResponse) ProtoMessage() {}
+
+func (x *SetCollectionClassificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionClassificationResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionClassificationResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetCollectionClassificationResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// Collections similar to one, by shared holders, category and tags, as of the last refresh
+type ListRelatedCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // default 10, max 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelatedCollectionsRequest) Reset() {
+	*x = ListRelatedCollectionsRequest{}
+	mi := &file_catalog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedCollectionsRequest) ProtoMessage() {}
+
+func (x *ListRelatedCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRelatedCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListRelatedCollectionsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListRelatedCollectionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRelatedCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelatedCollectionsResponse) Reset() {
+	*x = ListRelatedCollectionsResponse{}
+	mi := &file_catalog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedCollectionsResponse) ProtoMessage() {}
+
+func (x *ListRelatedCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRelatedCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListRelatedCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type GetCollectionRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ChainId            string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress    string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	IncludeFlagged     bool                   `protobuf:"varint,3,opt,name=include_flagged,json=includeFlagged,proto3" json:"include_flagged,omitempty"`
+	IncludeUnconfirmed bool                   `protobuf:"varint,4,opt,name=include_unconfirmed,json=includeUnconfirmed,proto3" json:"include_unconfirmed,omitempty"` // also return a collection pending finality
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_catalog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCollectionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetCollectionRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetCollectionRequest) GetIncludeFlagged() bool {
+	if x != nil {
+		return x.IncludeFlagged
+	}
+	return false
+}
+
+func (x *GetCollectionRequest) GetIncludeUnconfirmed() bool {
+	if x != nil {
+		return x.IncludeUnconfirmed
+	}
+	return false
+}
+
+type GetCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionResponse) Reset() {
+	*x = GetCollectionResponse{}
+	mi := &file_catalog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionResponse) ProtoMessage() {}
+
+func (x *GetCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// Slugs a collection had before a rename still resolve; collection.slug is the current one
+type GetCollectionBySlugRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Slug               string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	IncludeFlagged     bool                   `protobuf:"varint,2,opt,name=include_flagged,json=includeFlagged,proto3" json:"include_flagged,omitempty"`
+	IncludeUnconfirmed bool                   `protobuf:"varint,3,opt,name=include_unconfirmed,json=includeUnconfirmed,proto3" json:"include_unconfirmed,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetCollectionBySlugRequest) Reset() {
+	*x = GetCollectionBySlugRequest{}
+	mi := &file_catalog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionBySlugRequest) ProtoMessage() {}
+
+func (x *GetCollectionBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCollectionBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetCollectionBySlugRequest) GetIncludeFlagged() bool {
+	if x != nil {
+		return x.IncludeFlagged
+	}
+	return false
+}
+
+func (x *GetCollectionBySlugRequest) GetIncludeUnconfirmed() bool {
+	if x != nil {
+		return x.IncludeUnconfirmed
+	}
+	return false
+}
+
+type ListCollectionsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ChainId            string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // optional filter
+	Limit              int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset             int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeFlagged     bool                   `protobuf:"varint,4,opt,name=include_flagged,json=includeFlagged,proto3" json:"include_flagged,omitempty"`
+	CreatedByUserId    string                 `protobuf:"bytes,5,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`       // optional filter: collections deployed through this user's intents
+	IncludeUnconfirmed bool                   `protobuf:"varint,6,opt,name=include_unconfirmed,json=includeUnconfirmed,proto3" json:"include_unconfirmed,omitempty"` // also list collections pending finality
+	Creator            string                 `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`                                                  // optional filter: deployer address
+	VerifiedOnly       bool                   `protobuf:"varint,8,opt,name=verified_only,json=verifiedOnly,proto3" json:"verified_only,omitempty"`
+	FloorPrice         *PriceRange            `protobuf:"bytes,9,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	Sort               string                 `protobuf:"bytes,10,opt,name=sort,proto3" json:"sort,omitempty"` // "created_at" (default, newest first) | "floor" | "volume"
+	Descending         bool                   `protobuf:"varint,11,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_catalog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCollectionsRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetIncludeFlagged() bool {
+	if x != nil {
+		return x.IncludeFlagged
+	}
+	return false
+}
+
+func (x *ListCollectionsRequest) GetCreatedByUserId() string {
+	if x != nil {
+		return x.CreatedByUserId
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetIncludeUnconfirmed() bool {
+	if x != nil {
+		return x.IncludeUnconfirmed
+	}
+	return false
+}
+
+func (x *ListCollectionsRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetVerifiedOnly() bool {
+	if x != nil {
+		return x.VerifiedOnly
+	}
+	return false
+}
+
+func (x *ListCollectionsRequest) GetFloorPrice() *PriceRange {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return nil
+}
+
+func (x *ListCollectionsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+// Inclusive bounds in wei, base-10; empty ends are open
+type PriceRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           string                 `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           string                 `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceRange) Reset() {
+	*x = PriceRange{}
+	mi := &file_catalog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRange) ProtoMessage() {}
+
+func (x *PriceRange) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRange.ProtoReflect.Descriptor instead.
+func (*PriceRange) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PriceRange) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *PriceRange) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_catalog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type Report struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetType    string                 `protobuf:"bytes,2,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // "collection" | "token"
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`       // <chain_id>/<contract>[/<token_id>]
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // "open" | "upheld" | "dismissed"
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_catalog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Report) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Report) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *Report) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Report) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Report) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Report) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ReportContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details       string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,5,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportContentRequest) Reset() {
+	*x = ReportContentRequest{}
+	mi := &file_catalog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportContentRequest) ProtoMessage() {}
+
+func (x *ReportContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportContentRequest.ProtoReflect.Descriptor instead.
+func (*ReportContentRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReportContentRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ReportContentRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ReportContentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportContentRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *ReportContentRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+type ReportContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // the reporter already has an open report on this target
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportContentResponse) Reset() {
+	*x = ReportContentResponse{}
+	mi := &file_catalog_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportContentResponse) ProtoMessage() {}
+
+func (x *ReportContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportContentResponse.ProtoReflect.Descriptor instead.
+func (*ReportContentResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReportContentResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ReportContentResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// Open reports collapsed per target for admin triage
+type ReportQueueItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TargetType      string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId        string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ReportCount     int32                  `protobuf:"varint,3,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	Reasons         []string               `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	FirstReportedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_reported_at,json=firstReportedAt,proto3" json:"first_reported_at,omitempty"`
+	LastReportedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_reported_at,json=lastReportedAt,proto3" json:"last_reported_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReportQueueItem) Reset() {
+	*x = ReportQueueItem{}
+	mi := &file_catalog_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportQueueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportQueueItem) ProtoMessage() {}
+
+func (x *ReportQueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportQueueItem.ProtoReflect.Descriptor instead.
+func (*ReportQueueItem) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReportQueueItem) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ReportQueueItem) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ReportQueueItem) GetReportCount() int32 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ReportQueueItem) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ReportQueueItem) GetFirstReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstReportedAt
+	}
+	return nil
+}
+
+func (x *ReportQueueItem) GetLastReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReportedAt
+	}
+	return nil
+}
+
+type ListReportQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportQueueRequest) Reset() {
+	*x = ListReportQueueRequest{}
+	mi := &file_catalog_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportQueueRequest) ProtoMessage() {}
+
+func (x *ListReportQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListReportQueueRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListReportQueueRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReportQueueRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListReportQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ReportQueueItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportQueueResponse) Reset() {
+	*x = ListReportQueueResponse{}
+	mi := &file_catalog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportQueueResponse) ProtoMessage() {}
+
+func (x *ListReportQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListReportQueueResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListReportQueueResponse) GetItems() []*ReportQueueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ResolveReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "uphold" flags the item, "dismiss" clears the badge
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	ActorId       string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportsRequest) Reset() {
+	*x = ResolveReportsRequest{}
+	mi := &file_catalog_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsRequest) ProtoMessage() {}
+
+func (x *ResolveReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResolveReportsRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ResolveReportsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ResolveReportsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ResolveReportsRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ResolveReportsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ResolveReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resolved      int32                  `protobuf:"varint,1,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	Flag          *ModerationFlag        `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportsResponse) Reset() {
+	*x = ResolveReportsResponse{}
+	mi := &file_catalog_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsResponse) ProtoMessage() {}
+
+func (x *ResolveReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResolveReportsResponse) GetResolved() int32 {
+	if x != nil {
+		return x.Resolved
+	}
+	return 0
+}
+
+func (x *ResolveReportsResponse) GetFlag() *ModerationFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+// Royalties earned by a set of recipient addresses on one collection in one currency
+type EarningsTotal struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	CollectionName  string                 `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount          string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // smallest unit, base-10
+	SaleCount       int32                  `protobuf:"varint,6,opt,name=sale_count,json=saleCount,proto3" json:"sale_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EarningsTotal) Reset() {
+	*x = EarningsTotal{}
+	mi := &file_catalog_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EarningsTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EarningsTotal) ProtoMessage() {}
+
+func (x *EarningsTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EarningsTotal.ProtoReflect.Descriptor instead.
+func (*EarningsTotal) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EarningsTotal) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *EarningsTotal) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *EarningsTotal) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *EarningsTotal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *EarningsTotal) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EarningsTotal) GetSaleCount() int32 {
+	if x != nil {
+		return x.SaleCount
+	}
+	return 0
+}
+
+type GetEarningsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipients    []string               `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients,omitempty"` // royalty recipient addresses
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`         // "day" | "week" | "month" | "year" | "all"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEarningsRequest) Reset() {
+	*x = GetEarningsRequest{}
+	mi := &file_catalog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEarningsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEarningsRequest) ProtoMessage() {}
+
+func (x *GetEarningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEarningsRequest.ProtoReflect.Descriptor instead.
+func (*GetEarningsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetEarningsRequest) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *GetEarningsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type GetEarningsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Totals        []*EarningsTotal       `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"` // unset for "all"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEarningsResponse) Reset() {
+	*x = GetEarningsResponse{}
+	mi := &file_catalog_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEarningsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEarningsResponse) ProtoMessage() {}
+
+func (x *GetEarningsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEarningsResponse.ProtoReflect.Descriptor instead.
+func (*GetEarningsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetEarningsResponse) GetTotals() []*EarningsTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *GetEarningsResponse) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// Auction state folded from indexed AuctionHouse events
+type Auction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AuctionId       string                 `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	AuctionHouse    string                 `protobuf:"bytes,3,opt,name=auction_house,json=auctionHouse,proto3" json:"auction_house,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // NFT collection
+	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Seller          string                 `protobuf:"bytes,6,opt,name=seller,proto3" json:"seller,omitempty"`
+	AuctionType     string                 `protobuf:"bytes,7,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"` // "english" | "dutch"
+	StartPrice      string                 `protobuf:"bytes,8,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`    // wei, base-10
+	ReservePrice    string                 `protobuf:"bytes,9,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	EndPrice        string                 `protobuf:"bytes,10,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	HighestBid      string                 `protobuf:"bytes,11,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"` // "0" until the first bid
+	HighestBidder   string                 `protobuf:"bytes,12,opt,name=highest_bidder,json=highestBidder,proto3" json:"highest_bidder,omitempty"`
+	BidCount        int32                  `protobuf:"varint,13,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`
+	Status          string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"` // "active" | "settled" | "cancelled"
+	Winner          string                 `protobuf:"bytes,15,opt,name=winner,proto3" json:"winner,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Auction) Reset() {
+	*x = Auction{}
+	mi := &file_catalog_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auction) ProtoMessage() {}
+
+func (x *Auction) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auction.ProtoReflect.Descriptor instead.
+func (*Auction) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Auction) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Auction) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *Auction) GetAuctionHouse() string {
+	if x != nil {
+		return x.AuctionHouse
+	}
+	return ""
+}
+
+func (x *Auction) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *Auction) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *Auction) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *Auction) GetAuctionType() string {
+	if x != nil {
+		return x.AuctionType
+	}
+	return ""
+}
+
+func (x *Auction) GetStartPrice() string {
+	if x != nil {
+		return x.StartPrice
+	}
+	return ""
+}
+
+func (x *Auction) GetReservePrice() string {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return ""
+}
+
+func (x *Auction) GetEndPrice() string {
+	if x != nil {
+		return x.EndPrice
+	}
+	return ""
+}
+
+func (x *Auction) GetHighestBid() string {
+	if x != nil {
+		return x.HighestBid
+	}
+	return ""
+}
+
+func (x *Auction) GetHighestBidder() string {
+	if x != nil {
+		return x.HighestBidder
+	}
+	return ""
+}
+
+func (x *Auction) GetBidCount() int32 {
+	if x != nil {
+		return x.BidCount
+	}
+	return 0
+}
+
+func (x *Auction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Auction) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Auction) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Auction) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Auction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetAuctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AuctionId     string                 `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuctionRequest) Reset() {
+	*x = GetAuctionRequest{}
+	mi := &file_catalog_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuctionRequest) ProtoMessage() {}
+
+func (x *GetAuctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuctionRequest.ProtoReflect.Descriptor instead.
+func (*GetAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAuctionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetAuctionRequest) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+type GetAuctionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auction       *Auction               `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuctionResponse) Reset() {
+	*x = GetAuctionResponse{}
+	mi := &file_catalog_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuctionResponse) ProtoMessage() {}
+
+func (x *GetAuctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuctionResponse.ProtoReflect.Descriptor instead.
+func (*GetAuctionResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAuctionResponse) GetAuction() *Auction {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+// Token with ERC-1155 supply folded from indexed TransferSingle/TransferBatch mints and burns
+type Token struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ChainId          string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress  string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId          string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Standard         string                 `protobuf:"bytes,4,opt,name=standard,proto3" json:"standard,omitempty"`                    // "ERC721" | "ERC1155"
+	Supply           string                 `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply,omitempty"`                        // circulating, base-10
+	MaxSupply        string                 `protobuf:"bytes,6,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"` // empty when uncapped
+	Minted           string                 `protobuf:"bytes,7,opt,name=minted,proto3" json:"minted,omitempty"`
+	Burned           string                 `protobuf:"bytes,8,opt,name=burned,proto3" json:"burned,omitempty"`
+	ModerationStatus string                 `protobuf:"bytes,9,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status,omitempty"`
+	// Set by ListTokens
+	Name          string                  `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl      string                  `protobuf:"bytes,11,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Owner         string                  `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`
+	RarityScore   *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=rarity_score,json=rarityScore,proto3" json:"rarity_score,omitempty"` // higher is rarer; unset when unscored
+	Price         string                  `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`                                // lowest active listing in wei; empty when unlisted
+	Rentals       []*TokenRental          `protobuf:"bytes,15,rep,name=rentals,proto3" json:"rentals,omitempty"`                            // in effect now; empty unless rentals are indexed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_catalog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Token) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Token) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *Token) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *Token) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *Token) GetSupply() string {
+	if x != nil {
+		return x.Supply
+	}
+	return ""
+}
+
+func (x *Token) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *Token) GetMinted() string {
+	if x != nil {
+		return x.Minted
+	}
+	return ""
+}
+
+func (x *Token) GetBurned() string {
+	if x != nil {
+		return x.Burned
+	}
+	return ""
+}
+
+func (x *Token) GetModerationStatus() string {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ""
+}
+
+func (x *Token) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Token) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Token) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Token) GetRarityScore() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.RarityScore
+	}
+	return nil
+}
+
+func (x *Token) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Token) GetRentals() []*TokenRental {
+	if x != nil {
+		return x.Rentals
+	}
+	return nil
+}
+
+// TokenRental is user rights to a token: its ERC-4907 user or an ERC-5006 user record
+type TokenRental struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standard      string                 `protobuf:"bytes,1,opt,name=standard,proto3" json:"standard,omitempty"` // "ERC4907" | "ERC5006"
+	Renter        string                 `protobuf:"bytes,2,opt,name=renter,proto3" json:"renter,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // base-10; 1 for ERC-4907
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RecordId      string                 `protobuf:"bytes,5,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"` // ERC-5006 only
+	Owner         string                 `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`                       // ERC-5006 only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenRental) Reset() {
+	*x = TokenRental{}
+	mi := &file_catalog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRental) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRental) ProtoMessage() {}
+
+func (x *TokenRental) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRental.ProtoReflect.Descriptor instead.
+func (*TokenRental) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TokenRental) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *TokenRental) GetRenter() string {
+	if x != nil {
+		return x.Renter
+	}
+	return ""
+}
+
+func (x *TokenRental) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TokenRental) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *TokenRental) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *TokenRental) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type GetTokenRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	IncludeFlagged  bool                   `protobuf:"varint,4,opt,name=include_flagged,json=includeFlagged,proto3" json:"include_flagged,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTokenRequest) Reset() {
+	*x = GetTokenRequest{}
+	mi := &file_catalog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenRequest) ProtoMessage() {}
+
+func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetTokenRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetTokenRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetTokenRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *GetTokenRequest) GetIncludeFlagged() bool {
+	if x != nil {
+		return x.IncludeFlagged
+	}
+	return false
+}
+
+type GetTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenResponse) Reset() {
+	*x = GetTokenResponse{}
+	mi := &file_catalog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenResponse) ProtoMessage() {}
+
+func (x *GetTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTokenResponse) GetToken() *Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+// Tokens having any of values for the trait; several trait filters must all match
+type TraitFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraitFilter) Reset() {
+	*x = TraitFilter{}
+	mi := &file_catalog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraitFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraitFilter) ProtoMessage() {}
+
+func (x *TraitFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraitFilter.ProtoReflect.Descriptor instead.
+func (*TraitFilter) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TraitFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TraitFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ListTokensRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                         // optional filter
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // optional filter
+	Owner           string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`                                            // optional filter
+	Price           *PriceRange            `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`                                            // lowest active listing; a bounded range skips unlisted tokens
+	Traits          []*TraitFilter         `protobuf:"bytes,5,rep,name=traits,proto3" json:"traits,omitempty"`
+	Sort            string                 `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"` // "created_at" (default, newest first) | "price" | "rarity"
+	Descending      bool                   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	Limit           int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeFlagged  bool                   `protobuf:"varint,10,opt,name=include_flagged,json=includeFlagged,proto3" json:"include_flagged,omitempty"`
+	Owners          []string               `protobuf:"bytes,11,rep,name=owners,proto3" json:"owners,omitempty"` // optional filter: held by any of them
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListTokensRequest) Reset() {
+	*x = ListTokensRequest{}
+	mi := &file_catalog_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensRequest) ProtoMessage() {}
+
+func (x *ListTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListTokensRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListTokensRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ListTokensRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *ListTokensRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListTokensRequest) GetPrice() *PriceRange {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ListTokensRequest) GetTraits() []*TraitFilter {
+	if x != nil {
+		return x.Traits
+	}
+	return nil
+}
+
+func (x *ListTokensRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListTokensRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListTokensRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTokensRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTokensRequest) GetIncludeFlagged() bool {
+	if x != nil {
+		return x.IncludeFlagged
+	}
+	return false
+}
+
+func (x *ListTokensRequest) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+type ListTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*Token               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokensResponse) Reset() {
+	*x = ListTokensResponse{}
+	mi := &file_catalog_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensResponse) ProtoMessage() {}
+
+func (x *ListTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListTokensResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListTokensResponse) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// Wallet activity: indexed transfers and sales, newest first. before/before_id are the
+// occurred_at and id of the last activity of the previous page.
+type WalletActivity struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId         string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Kind            string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"` // "mint" | "burn" | "transfer" | "sale"
+	FromAddress     string                 `protobuf:"bytes,6,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress       string                 `protobuf:"bytes,7,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Quantity        string                 `protobuf:"bytes,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price           string                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"` // sales only, wei
+	Currency        string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	TxHash          string                 `protobuf:"bytes,11,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	OccurredAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WalletActivity) Reset() {
+	*x = WalletActivity{}
+	mi := &file_catalog_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletActivity) ProtoMessage() {}
+
+func (x *WalletActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletActivity.ProtoReflect.Descriptor instead.
+func (*WalletActivity) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WalletActivity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *WalletActivity) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type ListWalletActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	BeforeId      string                 `protobuf:"bytes,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // default 20, max 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletActivityRequest) Reset() {
+	*x = ListWalletActivityRequest{}
+	mi := &file_catalog_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletActivityRequest) ProtoMessage() {}
+
+func (x *ListWalletActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletActivityRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListWalletActivityRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ListWalletActivityRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListWalletActivityRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+func (x *ListWalletActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWalletActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activities    []*WalletActivity      `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletActivityResponse) Reset() {
+	*x = ListWalletActivityResponse{}
+	mi := &file_catalog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletActivityResponse) ProtoMessage() {}
+
+func (x *ListWalletActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListWalletActivityResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListWalletActivityResponse) GetActivities() []*WalletActivity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+// Watchlist: favorited collections and tokens with live floor deltas, plus saved searches
+type WatchlistItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetType      string                 `protobuf:"bytes,2,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // "collection" | "token"
+	ChainId         string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"` // empty for collections
+	CollectionName  string                 `protobuf:"bytes,6,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FloorAtAdd      string                 `protobuf:"bytes,7,opt,name=floor_at_add,json=floorAtAdd,proto3" json:"floor_at_add,omitempty"`                 // wei, collection floor when favorited
+	CurrentFloor    string                 `protobuf:"bytes,8,opt,name=current_floor,json=currentFloor,proto3" json:"current_floor,omitempty"`             // wei
+	FloorDelta      string                 `protobuf:"bytes,9,opt,name=floor_delta,json=floorDelta,proto3" json:"floor_delta,omitempty"`                   // current_floor - floor_at_add, may be negative
+	FloorAlertBelow string                 `protobuf:"bytes,10,opt,name=floor_alert_below,json=floorAlertBelow,proto3" json:"floor_alert_below,omitempty"` // wei, empty when no alert is set
+	AlertFiredAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=alert_fired_at,json=alertFiredAt,proto3" json:"alert_fired_at,omitempty"`          // set while the floor stays below the threshold
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchlistItem) Reset() {
+	*x = WatchlistItem{}
+	mi := &file_catalog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistItem) ProtoMessage() {}
+
+func (x *WatchlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistItem.ProtoReflect.Descriptor instead.
+func (*WatchlistItem) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WatchlistItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetFloorAtAdd() string {
+	if x != nil {
+		return x.FloorAtAdd
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetCurrentFloor() string {
+	if x != nil {
+		return x.CurrentFloor
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetFloorDelta() string {
+	if x != nil {
+		return x.FloorDelta
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetFloorAlertBelow() string {
+	if x != nil {
+		return x.FloorAlertBelow
+	}
+	return ""
+}
+
+func (x *WatchlistItem) GetAlertFiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AlertFiredAt
+	}
+	return nil
+}
+
+func (x *WatchlistItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SavedSearch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Filters       map[string]string      `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_catalog_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SavedSearch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type FavoriteRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetType      string                 `protobuf:"bytes,2,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // "collection" | "token"
+	ChainId         string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	FloorAlertBelow string                 `protobuf:"bytes,6,opt,name=floor_alert_below,json=floorAlertBelow,proto3" json:"floor_alert_below,omitempty"` // optional wei threshold for a "floor dropped below" alert
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FavoriteRequest) Reset() {
+	*x = FavoriteRequest{}
+	mi := &file_catalog_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteRequest) ProtoMessage() {}
+
+func (x *FavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteRequest.ProtoReflect.Descriptor instead.
+func (*FavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FavoriteRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *FavoriteRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *FavoriteRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *FavoriteRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *FavoriteRequest) GetFloorAlertBelow() string {
+	if x != nil {
+		return x.FloorAlertBelow
+	}
+	return ""
+}
+
+type FavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *WatchlistItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteResponse) Reset() {
+	*x = FavoriteResponse{}
+	mi := &file_catalog_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteResponse) ProtoMessage() {}
+
+func (x *FavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteResponse.ProtoReflect.Descriptor instead.
+func (*FavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FavoriteResponse) GetItem() *WatchlistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_catalog_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RemoveFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteResponse) Reset() {
+	*x = RemoveFavoriteResponse{}
+	mi := &file_catalog_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{51}
+}
+
+type SaveSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // defaults to the query
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Filters       map[string]string      `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSearchRequest) Reset() {
+	*x = SaveSearchRequest{}
+	mi := &file_catalog_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSearchRequest) ProtoMessage() {}
+
+func (x *SaveSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSearchRequest.ProtoReflect.Descriptor instead.
+func (*SaveSearchRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SaveSearchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type SaveSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Search        *SavedSearch           `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSearchResponse) Reset() {
+	*x = SaveSearchResponse{}
+	mi := &file_catalog_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSearchResponse) ProtoMessage() {}
+
+func (x *SaveSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSearchResponse.ProtoReflect.Descriptor instead.
+func (*SaveSearchResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SaveSearchResponse) GetSearch() *SavedSearch {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_catalog_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteSavedSearchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteSavedSearchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	mi := &file_catalog_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{55}
+}
+
+type GetWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWatchlistRequest) Reset() {
+	*x = GetWatchlistRequest{}
+	mi := &file_catalog_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWatchlistRequest) ProtoMessage() {}
+
+func (x *GetWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetWatchlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*WatchlistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	SavedSearches []*SavedSearch         `protobuf:"bytes,2,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWatchlistResponse) Reset() {
+	*x = GetWatchlistResponse{}
+	mi := &file_catalog_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWatchlistResponse) ProtoMessage() {}
+
+func (x *GetWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*GetWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetWatchlistResponse) GetItems() []*WatchlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetWatchlistResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+// Autocomplete over collection and token names; queries shorter than two characters
+// return nothing
+type Suggestion struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "collection" | "token"
+	ChainId         string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string                 `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                      // tokens only
+	Slug            string                 `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`                                           // collections only
+	Label           string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`                                         // the matched name
+	CollectionName  string                 `protobuf:"bytes,7,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"` // tokens only
+	ImageUrl        string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Score           float64                `protobuf:"fixed64,9,opt,name=score,proto3" json:"score,omitempty"` // comparable across kinds and with user suggestions
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_catalog_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Suggestion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Suggestion) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Suggestion) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *Suggestion) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *Suggestion) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Suggestion) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Suggestion) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *Suggestion) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Suggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SuggestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // default 8, at most 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	mi := &file_catalog_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequest) ProtoMessage() {}
+
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SuggestRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // best first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	mi := &file_catalog_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse) ProtoMessage() {}
+
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SuggestResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// System status: how far the event consumers are behind the indexer
+type QueueStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Messages      int32                  `protobuf:"varint,2,opt,name=messages,proto3" json:"messages,omitempty"` // ready for delivery, not counting unacked
+	Consumers     int32                  `protobuf:"varint,3,opt,name=consumers,proto3" json:"consumers,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // set when the broker could not be asked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueStatus) Reset() {
+	*x = QueueStatus{}
+	mi := &file_catalog_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStatus) ProtoMessage() {}
+
+func (x *QueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStatus.ProtoReflect.Descriptor instead.
+func (*QueueStatus) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QueueStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueueStatus) GetMessages() int32 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *QueueStatus) GetConsumers() int32 {
+	if x != nil {
+		return x.Consumers
+	}
+	return 0
+}
+
+func (x *QueueStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ConsumerStatus struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Consumer             string                 `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Queue                string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessagesPerSecond    float64                `protobuf:"fixed64,3,opt,name=messages_per_second,json=messagesPerSecond,proto3" json:"messages_per_second,omitempty"`
+	InFlight             int32                  `protobuf:"varint,4,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	OldestUnackedSeconds float64                `protobuf:"fixed64,5,opt,name=oldest_unacked_seconds,json=oldestUnackedSeconds,proto3" json:"oldest_unacked_seconds,omitempty"` // since the oldest held message was published
+	Processed            int64                  `protobuf:"varint,6,opt,name=processed,proto3" json:"processed,omitempty"`
+	Failed               int64                  `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	ReportedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	Stale                bool                   `protobuf:"varint,9,opt,name=stale,proto3" json:"stale,omitempty"` // stopped reporting
+	FallingBehind        bool                   `protobuf:"varint,10,opt,name=falling_behind,json=fallingBehind,proto3" json:"falling_behind,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ConsumerStatus) Reset() {
+	*x = ConsumerStatus{}
+	mi := &file_catalog_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerStatus) ProtoMessage() {}
+
+func (x *ConsumerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerStatus.ProtoReflect.Descriptor instead.
+func (*ConsumerStatus) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ConsumerStatus) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *ConsumerStatus) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ConsumerStatus) GetMessagesPerSecond() float64 {
+	if x != nil {
+		return x.MessagesPerSecond
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetInFlight() int32 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetOldestUnackedSeconds() float64 {
+	if x != nil {
+		return x.OldestUnackedSeconds
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return nil
+}
+
+func (x *ConsumerStatus) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *ConsumerStatus) GetFallingBehind() bool {
+	if x != nil {
+		return x.FallingBehind
+	}
+	return false
+}
+
+type GetSystemStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemStatusRequest) Reset() {
+	*x = GetSystemStatusRequest{}
+	mi := &file_catalog_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemStatusRequest) ProtoMessage() {}
+
+func (x *GetSystemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{63}
+}
+
+type GetSystemStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queues        []*QueueStatus         `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	Consumers     []*ConsumerStatus      `protobuf:"bytes,2,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemStatusResponse) Reset() {
+	*x = GetSystemStatusResponse{}
+	mi := &file_catalog_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemStatusResponse) ProtoMessage() {}
+
+func (x *GetSystemStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemStatusResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetSystemStatusResponse) GetQueues() []*QueueStatus {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+func (x *GetSystemStatusResponse) GetConsumers() []*ConsumerStatus {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+func (x *GetSystemStatusResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// Holder snapshots: holders of a collection as of a block, for airdrops
+type SnapshotExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"` // signed; valid until url_expires_at
+	UrlExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"`
+	Bytes         int64                  `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotExport) Reset() {
+	*x = SnapshotExport{}
+	mi := &file_catalog_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotExport) ProtoMessage() {}
+
+func (x *SnapshotExport) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotExport.ProtoReflect.Descriptor instead.
+func (*SnapshotExport) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SnapshotExport) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *SnapshotExport) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *SnapshotExport) GetUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return nil
+}
+
+func (x *SnapshotExport) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type HolderSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId         string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	BlockNumber     uint64                 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	RequestedBy     string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	HolderCount     int32                  `protobuf:"varint,6,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	TotalQuantity   string                 `protobuf:"bytes,7,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"` // decimal
+	Csv             *SnapshotExport        `protobuf:"bytes,8,opt,name=csv,proto3" json:"csv,omitempty"`                                          // unset while the export is unavailable
+	Json            *SnapshotExport        `protobuf:"bytes,9,opt,name=json,proto3" json:"json,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HolderSnapshot) Reset() {
+	*x = HolderSnapshot{}
+	mi := &file_catalog_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HolderSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HolderSnapshot) ProtoMessage() {}
+
+func (x *HolderSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HolderSnapshot.ProtoReflect.Descriptor instead.
+func (*HolderSnapshot) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *HolderSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HolderSnapshot) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *HolderSnapshot) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *HolderSnapshot) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *HolderSnapshot) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *HolderSnapshot) GetHolderCount() int32 {
+	if x != nil {
+		return x.HolderCount
+	}
+	return 0
+}
+
+func (x *HolderSnapshot) GetTotalQuantity() string {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return ""
+}
+
+func (x *HolderSnapshot) GetCsv() *SnapshotExport {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *HolderSnapshot) GetJson() *SnapshotExport {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *HolderSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateHolderSnapshotRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	ChainId         string                  `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                  `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	BlockNumber     *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"` // unset = last indexed block
+	RequestedBy     string                  `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateHolderSnapshotRequest) Reset() {
+	*x = CreateHolderSnapshotRequest{}
+	mi := &file_catalog_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHolderSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHolderSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateHolderSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHolderSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateHolderSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateHolderSnapshotRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *CreateHolderSnapshotRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *CreateHolderSnapshotRequest) GetBlockNumber() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return nil
+}
+
+func (x *CreateHolderSnapshotRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type CreateHolderSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *HolderSnapshot        `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHolderSnapshotResponse) Reset() {
+	*x = CreateHolderSnapshotResponse{}
+	mi := &file_catalog_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHolderSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHolderSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateHolderSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHolderSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateHolderSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateHolderSnapshotResponse) GetSnapshot() *HolderSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type GetHolderSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolderSnapshotRequest) Reset() {
+	*x = GetHolderSnapshotRequest{}
+	mi := &file_catalog_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolderSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolderSnapshotRequest) ProtoMessage() {}
+
+func (x *GetHolderSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolderSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetHolderSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetHolderSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetHolderSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *HolderSnapshot        `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolderSnapshotResponse) Reset() {
+	*x = GetHolderSnapshotResponse{}
+	mi := &file_catalog_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolderSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolderSnapshotResponse) ProtoMessage() {}
+
+func (x *GetHolderSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolderSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetHolderSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetHolderSnapshotResponse) GetSnapshot() *HolderSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// Collection exports: a collection's sales or mints over a period as CSV, for its creator
+// and admins. Small exports are ready on creation; larger ones are generated in the
+// background and announced on catalog.export_ready.
+type CollectionExport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // sales or mints
+	ChainId         string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	From            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"` // exclusive
+	RequestedBy     string                 `protobuf:"bytes,7,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // pending, running, ready or failed
+	RowCount        int32                  `protobuf:"varint,9,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Error           string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"` // why a failed export failed
+	Csv             *SnapshotExport        `protobuf:"bytes,11,opt,name=csv,proto3" json:"csv,omitempty"`     // set once ready
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CollectionExport) Reset() {
+	*x = CollectionExport{}
+	mi := &file_catalog_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionExport) ProtoMessage() {}
+
+func (x *CollectionExport) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionExport.ProtoReflect.Descriptor instead.
+func (*CollectionExport) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CollectionExport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CollectionExport) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CollectionExport) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *CollectionExport) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *CollectionExport) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CollectionExport) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *CollectionExport) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CollectionExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CollectionExport) GetRowCount() int32 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *CollectionExport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CollectionExport) GetCsv() *SnapshotExport {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *CollectionExport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CollectionExport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type CreateCollectionExportRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ChainId         string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	From            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	RequestedBy     string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateCollectionExportRequest) Reset() {
+	*x = CreateCollectionExportRequest{}
+	mi := &file_catalog_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionExportRequest) ProtoMessage() {}
+
+func (x *CreateCollectionExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionExportRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionExportRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateCollectionExportRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateCollectionExportRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *CreateCollectionExportRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *CreateCollectionExportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CreateCollectionExportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *CreateCollectionExportRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type CreateCollectionExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *CollectionExport      `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionExportResponse) Reset() {
+	*x = CreateCollectionExportResponse{}
+	mi := &file_catalog_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionExportResponse) ProtoMessage() {}
+
+func (x *CreateCollectionExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionExportResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionExportResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateCollectionExportResponse) GetExport() *CollectionExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetCollectionExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionExportRequest) Reset() {
+	*x = GetCollectionExportRequest{}
+	mi := &file_catalog_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionExportRequest) ProtoMessage() {}
+
+func (x *GetCollectionExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionExportRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionExportRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetCollectionExportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCollectionExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *CollectionExport      `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionExportResponse) Reset() {
+	*x = GetCollectionExportResponse{}
+	mi := &file_catalog_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionExportResponse) ProtoMessage() {}
+
+func (x *GetCollectionExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionExportResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionExportResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetCollectionExportResponse) GetExport() *CollectionExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+// Token gating: whether the user's wallets hold at least min_balance of a collection per the
+// ownership index, with a short-lived gate token when they do. The caller passes the user's
+// signed wallets; watch-only wallets prove nothing.
+type VerifyTokenGateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Owners          []string               `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty"`
+	ChainId         string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	MinBalance      string                 `protobuf:"bytes,5,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"` // decimal; empty = 1
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VerifyTokenGateRequest) Reset() {
+	*x = VerifyTokenGateRequest{}
+	mi := &file_catalog_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTokenGateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTokenGateRequest) ProtoMessage() {}
+
+func (x *VerifyTokenGateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTokenGateRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTokenGateRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *VerifyTokenGateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyTokenGateRequest) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *VerifyTokenGateRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *VerifyTokenGateRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *VerifyTokenGateRequest) GetMinBalance() string {
+	if x != nil {
+		return x.MinBalance
+	}
+	return ""
+}
+
+type VerifyTokenGateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Held          bool                   `protobuf:"varint,1,opt,name=held,proto3" json:"held,omitempty"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`                      // decimal, summed over owners
+	GateToken     string                 `protobuf:"bytes,3,opt,name=gate_token,json=gateToken,proto3" json:"gate_token,omitempty"` // set when held
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // of gate_token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTokenGateResponse) Reset() {
+	*x = VerifyTokenGateResponse{}
+	mi := &file_catalog_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTokenGateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTokenGateResponse) ProtoMessage() {}
+
+func (x *VerifyTokenGateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTokenGateResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTokenGateResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *VerifyTokenGateResponse) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
+func (x *VerifyTokenGateResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *VerifyTokenGateResponse) GetGateToken() string {
+	if x != nil {
+		return x.GateToken
+	}
+	return ""
+}
+
+func (x *VerifyTokenGateResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Vaults delegating to the wallets in the delegation registry (delegate.cash), read at
+// the chain head and cached
+type ListDelegatedVaultsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Delegates       []string               `protobuf:"bytes,2,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // empty = wallet-wide delegations only
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListDelegatedVaultsRequest) Reset() {
+	*x = ListDelegatedVaultsRequest{}
+	mi := &file_catalog_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDelegatedVaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDelegatedVaultsRequest) ProtoMessage() {}
+
+func (x *ListDelegatedVaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDelegatedVaultsRequest.ProtoReflect.Descriptor instead.
+func (*ListDelegatedVaultsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListDelegatedVaultsRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ListDelegatedVaultsRequest) GetDelegates() []string {
+	if x != nil {
+		return x.Delegates
+	}
+	return nil
+}
+
+func (x *ListDelegatedVaultsRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+type ListDelegatedVaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vaults        []string               `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDelegatedVaultsResponse) Reset() {
+	*x = ListDelegatedVaultsResponse{}
+	mi := &file_catalog_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDelegatedVaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDelegatedVaultsResponse) ProtoMessage() {}
+
+func (x *ListDelegatedVaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDelegatedVaultsResponse.ProtoReflect.Descriptor instead.
+func (*ListDelegatedVaultsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListDelegatedVaultsResponse) GetVaults() []string {
+	if x != nil {
+		return x.Vaults
+	}
+	return nil
+}
+
+// Collection resync: catalog rows compared with on-chain state at one block
+type ResyncDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "total_supply" | "contract_uri" | "owner" | "balance"
+	TokenId       string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Holder        string                 `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"` // balance drifts only
+	Catalog       string                 `protobuf:"bytes,4,opt,name=catalog,proto3" json:"catalog,omitempty"`
+	Chain         string                 `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"` // "" owner = the token does not exist on chain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncDrift) Reset() {
+	*x = ResyncDrift{}
+	mi := &file_catalog_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncDrift) ProtoMessage() {}
+
+func (x *ResyncDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncDrift.ProtoReflect.Descriptor instead.
+func (*ResyncDrift) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ResyncDrift) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResyncDrift) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *ResyncDrift) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *ResyncDrift) GetCatalog() string {
+	if x != nil {
+		return x.Catalog
+	}
+	return ""
+}
+
+func (x *ResyncDrift) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type ResyncCollectionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	SampleSize      int32                  `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"` // tokens whose ownership is checked; 0 = default
+	Repair          bool                   `protobuf:"varint,4,opt,name=repair,proto3" json:"repair,omitempty"`
+	RequestedBy     string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResyncCollectionRequest) Reset() {
+	*x = ResyncCollectionRequest{}
+	mi := &file_catalog_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncCollectionRequest) ProtoMessage() {}
+
+func (x *ResyncCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ResyncCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ResyncCollectionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ResyncCollectionRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *ResyncCollectionRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *ResyncCollectionRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *ResyncCollectionRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type ResyncCollectionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId         string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Standard        string                 `protobuf:"bytes,4,opt,name=standard,proto3" json:"standard,omitempty"`
+	BlockNumber     uint64                 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	TokensSampled   int32                  `protobuf:"varint,6,opt,name=tokens_sampled,json=tokensSampled,proto3" json:"tokens_sampled,omitempty"`
+	Drifts          []*ResyncDrift         `protobuf:"bytes,7,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	Repaired        bool                   `protobuf:"varint,8,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	CheckedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResyncCollectionResponse) Reset() {
+	*x = ResyncCollectionResponse{}
+	mi := &file_catalog_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncCollectionResponse) ProtoMessage() {}
+
+func (x *ResyncCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ResyncCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ResyncCollectionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResyncCollectionResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ResyncCollectionResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *ResyncCollectionResponse) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *ResyncCollectionResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ResyncCollectionResponse) GetTokensSampled() int32 {
+	if x != nil {
+		return x.TokensSampled
+	}
+	return 0
+}
+
+func (x *ResyncCollectionResponse) GetDrifts() []*ResyncDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+func (x *ResyncCollectionResponse) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *ResyncCollectionResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// Drop calendar: upcoming mints of indexed collections merged with curated off-platform drops
+type UpcomingDrop struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // collection id or drop submission id
+	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // "collection" | "submission"
+	ChainId         string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"` // may be empty for submitted drops not yet deployed
+	Name            string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl        string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ExternalUrl     string                 `protobuf:"bytes,8,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
+	Slug            string                 `protobuf:"bytes,9,opt,name=slug,proto3" json:"slug,omitempty"`                             // collections only
+	MintPrice       string                 `protobuf:"bytes,10,opt,name=mint_price,json=mintPrice,proto3" json:"mint_price,omitempty"` // wei; empty when unknown
+	IsVerified      bool                   `protobuf:"varint,11,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"` // unset when open-ended
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpcomingDrop) Reset() {
+	*x = UpcomingDrop{}
+	mi := &file_catalog_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpcomingDrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingDrop) ProtoMessage() {}
+
+func (x *UpcomingDrop) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingDrop.ProtoReflect.Descriptor instead.
+func (*UpcomingDrop) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpcomingDrop) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetMintPrice() string {
+	if x != nil {
+		return x.MintPrice
+	}
+	return ""
+}
+
+func (x *UpcomingDrop) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *UpcomingDrop) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *UpcomingDrop) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type ListUpcomingDropsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // empty = every chain
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                      // unset = now
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                          // unset = from + 30 days; at most 90 days after from
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingDropsRequest) Reset() {
+	*x = ListUpcomingDropsRequest{}
+	mi := &file_catalog_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingDropsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingDropsRequest) ProtoMessage() {}
+
+func (x *ListUpcomingDropsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingDropsRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingDropsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListUpcomingDropsRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ListUpcomingDropsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListUpcomingDropsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListUpcomingDropsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUpcomingDropsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drops         []*UpcomingDrop        `protobuf:"bytes,1,rep,name=drops,proto3" json:"drops,omitempty"` // ordered by starts_at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingDropsResponse) Reset() {
+	*x = ListUpcomingDropsResponse{}
+	mi := &file_catalog_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingDropsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingDropsResponse) ProtoMessage() {}
+
+func (x *ListUpcomingDropsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingDropsResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingDropsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListUpcomingDropsResponse) GetDrops() []*UpcomingDrop {
+	if x != nil {
+		return x.Drops
+	}
+	return nil
+}
+
+// DropSubmission is an off-platform drop a creator submitted for the calendar; only
+// approved submissions are listed
+type DropSubmission struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId         string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl        string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ExternalUrl     string                 `protobuf:"bytes,7,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
+	MintPrice       string                 `protobuf:"bytes,8,opt,name=mint_price,json=mintPrice,proto3" json:"mint_price,omitempty"` // wei
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	SubmittedBy     string                 `protobuf:"bytes,11,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	Status          string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"` // "pending" | "approved" | "rejected"
+	ReviewedBy      string                 `protobuf:"bytes,13,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewNote      string                 `protobuf:"bytes,14,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DropSubmission) Reset() {
+	*x = DropSubmission{}
+	mi := &file_catalog_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropSubmission) ProtoMessage() {}
+
+func (x *DropSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropSubmission.ProtoReflect.Descriptor instead.
+func (*DropSubmission) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DropSubmission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetMintPrice() string {
+	if x != nil {
+		return x.MintPrice
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *DropSubmission) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *DropSubmission) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *DropSubmission) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DropSubmission) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SubmitDropRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl        string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ExternalUrl     string                 `protobuf:"bytes,6,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
+	MintPrice       string                 `protobuf:"bytes,7,opt,name=mint_price,json=mintPrice,proto3" json:"mint_price,omitempty"`
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	SubmittedBy     string                 `protobuf:"bytes,10,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubmitDropRequest) Reset() {
+	*x = SubmitDropRequest{}
+	mi := &file_catalog_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDropRequest) ProtoMessage() {}
+
+func (x *SubmitDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDropRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDropRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SubmitDropRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SubmitDropRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SubmitDropRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubmitDropRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SubmitDropRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *SubmitDropRequest) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *SubmitDropRequest) GetMintPrice() string {
+	if x != nil {
+		return x.MintPrice
+	}
+	return ""
+}
+
+func (x *SubmitDropRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SubmitDropRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SubmitDropRequest) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+type SubmitDropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *DropSubmission        `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDropResponse) Reset() {
+	*x = SubmitDropResponse{}
+	mi := &file_catalog_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDropResponse) ProtoMessage() {}
+
+func (x *SubmitDropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDropResponse.ProtoReflect.Descriptor instead.
+func (*SubmitDropResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SubmitDropResponse) GetSubmission() *DropSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type ListDropSubmissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                              // empty = every status
+	SubmittedBy   string                 `protobuf:"bytes,2,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"` // empty = every submitter
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDropSubmissionsRequest) Reset() {
+	*x = ListDropSubmissionsRequest{}
+	mi := &file_catalog_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDropSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDropSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListDropSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDropSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDropSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListDropSubmissionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDropSubmissionsRequest) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *ListDropSubmissionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDropSubmissionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDropSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*DropSubmission      `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDropSubmissionsResponse) Reset() {
+	*x = ListDropSubmissionsResponse{}
+	mi := &file_catalog_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDropSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDropSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListDropSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDropSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDropSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListDropSubmissionsResponse) GetSubmissions() []*DropSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type ReviewDropSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "approve" | "reject"
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewDropSubmissionRequest) Reset() {
+	*x = ReviewDropSubmissionRequest{}
+	mi := &file_catalog_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewDropSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewDropSubmissionRequest) ProtoMessage() {}
+
+func (x *ReviewDropSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewDropSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewDropSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ReviewDropSubmissionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewDropSubmissionRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ReviewDropSubmissionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReviewDropSubmissionRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ReviewDropSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *DropSubmission        `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewDropSubmissionResponse) Reset() {
+	*x = ReviewDropSubmissionResponse{}
+	mi := &file_catalog_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewDropSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewDropSubmissionResponse) ProtoMessage() {}
+
+func (x *ReviewDropSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewDropSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ReviewDropSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ReviewDropSubmissionResponse) GetSubmission() *DropSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// Mints of a collection in one minute
+type MintStatsBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Minute        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=minute,proto3" json:"minute,omitempty"`
+	Mints         string                 `protobuf:"bytes,2,opt,name=mints,proto3" json:"mints,omitempty"` // base-10
+	UniqueMinters int64                  `protobuf:"varint,3,opt,name=unique_minters,json=uniqueMinters,proto3" json:"unique_minters,omitempty"`
+	Revenue       string                 `protobuf:"bytes,4,opt,name=revenue,proto3" json:"revenue,omitempty"` // wei, base-10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintStatsBucket) Reset() {
+	*x = MintStatsBucket{}
+	mi := &file_catalog_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintStatsBucket) ProtoMessage() {}
+
+func (x *MintStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintStatsBucket.ProtoReflect.Descriptor instead.
+func (*MintStatsBucket) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *MintStatsBucket) GetMinute() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Minute
+	}
+	return nil
+}
+
+func (x *MintStatsBucket) GetMints() string {
+	if x != nil {
+		return x.Mints
+	}
+	return ""
+}
+
+func (x *MintStatsBucket) GetUniqueMinters() int64 {
+	if x != nil {
+		return x.UniqueMinters
+	}
+	return 0
+}
+
+func (x *MintStatsBucket) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+type GetMintStatsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Window          string                 `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"` // "15m" | "1h" | "24h"; empty = "1h"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMintStatsRequest) Reset() {
+	*x = GetMintStatsRequest{}
+	mi := &file_catalog_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMintStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMintStatsRequest) ProtoMessage() {}
+
+func (x *GetMintStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMintStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMintStatsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetMintStatsRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetMintStatsRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetMintStatsRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+type GetMintStatsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Window          string                 `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	Since           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Mints           string                 `protobuf:"bytes,5,opt,name=mints,proto3" json:"mints,omitempty"`
+	UniqueMinters   int64                  `protobuf:"varint,6,opt,name=unique_minters,json=uniqueMinters,proto3" json:"unique_minters,omitempty"` // distinct wallets across the window
+	Revenue         string                 `protobuf:"bytes,7,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Buckets         []*MintStatsBucket     `protobuf:"bytes,8,rep,name=buckets,proto3" json:"buckets,omitempty"` // oldest first, minutes without mints omitted
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMintStatsResponse) Reset() {
+	*x = GetMintStatsResponse{}
+	mi := &file_catalog_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMintStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMintStatsResponse) ProtoMessage() {}
+
+func (x *GetMintStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMintStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMintStatsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetMintStatsResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetMintStatsResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetMintStatsResponse) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *GetMintStatsResponse) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetMintStatsResponse) GetMints() string {
+	if x != nil {
+		return x.Mints
+	}
+	return ""
+}
+
+func (x *GetMintStatsResponse) GetUniqueMinters() int64 {
+	if x != nil {
+		return x.UniqueMinters
+	}
+	return 0
+}
+
+func (x *GetMintStatsResponse) GetRevenue() string {
+	if x != nil {
+		return x.Revenue
+	}
+	return ""
+}
+
+func (x *GetMintStatsResponse) GetBuckets() []*MintStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+var File_catalog_proto protoreflect.FileDescriptor
+
+const file_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\rcatalog.proto\x12\acatalog\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x97\b\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x19\n" +
+	"\bchain_id\x18\x05 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x06 \x01(\tR\x0fcontractAddress\x12\x18\n" +
+	"\acreator\x18\a \x01(\tR\acreator\x12\x14\n" +
+	"\x05owner\x18\b \x01(\tR\x05owner\x12'\n" +
+	"\x0fcollection_type\x18\t \x01(\tR\x0ecollectionType\x12\x1d\n" +
+	"\n" +
+	"max_supply\x18\n" +
+	" \x01(\tR\tmaxSupply\x12!\n" +
+	"\ftotal_supply\x18\v \x01(\tR\vtotalSupply\x12+\n" +
+	"\x11royalty_recipient\x18\f \x01(\tR\x10royaltyRecipient\x12-\n" +
+	"\x12royalty_percentage\x18\r \x01(\rR\x11royaltyPercentage\x12\x1b\n" +
+	"\ttoken_uri\x18\x0e \x01(\tR\btokenUri\x12\x1b\n" +
+	"\timage_url\x18\x0f \x01(\tR\bimageUrl\x12\x1f\n" +
+	"\vis_verified\x18\x10 \x01(\bR\n" +
+	"isVerified\x12\x18\n" +
+	"\aflagged\x18\x11 \x01(\bR\aflagged\x12\x1a\n" +
+	"\breported\x18\x12 \x01(\bR\breported\x129\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12 \n" +
+	"\fowner_org_id\x18\x15 \x01(\tR\n" +
+	"ownerOrgId\x12\x1b\n" +
+	"\tintent_id\x18\x16 \x01(\tR\bintentId\x12+\n" +
+	"\x12created_by_user_id\x18\x17 \x01(\tR\x0fcreatedByUserId\x12$\n" +
+	"\rconfirmations\x18\x18 \x01(\x05R\rconfirmations\x125\n" +
+	"\x16required_confirmations\x18\x19 \x01(\x05R\x15requiredConfirmations\x127\n" +
+	"\tlocalized\x18\x1a \x03(\v2\x19.catalog.LocalizedContentR\tlocalized\x12\x1a\n" +
+	"\bcategory\x18\x1b \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x1c \x03(\tR\x04tags\x129\n" +
+	"\rpayout_splits\x18\x1d \x03(\v2\x14.catalog.PayoutSplitR\fpayoutSplits\"=\n" +
+	"\vPayoutSplit\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x10\n" +
+	"\x03bps\x18\x02 \x01(\x04R\x03bps\"\xce\x01\n" +
+	"\x10LocalizedContent\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\atagline\x18\x03 \x01(\tR\atagline\x12+\n" +
+	"\x12updated_by_user_id\x18\x04 \x01(\tR\x0fupdatedByUserId\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xee\x02\n" +
+	"\x0eModerationFlag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x16\n" +
+	"\x06source\x18\b \x01(\tR\x06source\x12\x19\n" +
+	"\bactor_id\x18\t \x01(\tR\aactorId\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd1\x01\n" +
+	"\x0fFlagItemRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\"?\n" +
+	"\x10FlagItemResponse\x12+\n" +
+	"\x04flag\x18\x01 \x01(\v2\x17.catalog.ModerationFlagR\x04flag\"\xa3\x01\n" +
+	"\x11UnflagItemRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\"A\n" +
+	"\x12UnflagItemResponse\x12+\n" +
+	"\x04flag\x18\x01 \x01(\v2\x17.catalog.ModerationFlagR\x04flag\"\x9a\x01\n" +
+	" SetCollectionOrganizationRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\"X\n" +
+	"!SetCollectionOrganizationResponse\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.catalog.CollectionR\n" +
+	"collection\"\xd2\x01\n" +
+	"\x1bSetCollectionContentRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\atagline\x18\x05 \x01(\tR\atagline\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\"S\n" +
+	"\x1cSetCollectionContentResponse\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.catalog.CollectionR\n" +
+	"collection\"\xb5\x01\n" +
+	"\"SetCollectionClassificationRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\"Z\n" +
+	"#SetCollectionClassificationResponse\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.catalog.CollectionR\n" +
+	"collection\"I\n" +
+	"\x1dListRelatedCollectionsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"W\n" +
+	"\x1eListRelatedCollectionsResponse\x125\n" +
+	"\vcollections\x18\x01 \x03(\v2\x13.catalog.CollectionR\vcollections\"\xb6\x01\n" +
+	"\x14GetCollectionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0finclude_flagged\x18\x03 \x01(\bR\x0eincludeFlagged\x12/\n" +
+	"\x13include_unconfirmed\x18\x04 \x01(\bR\x12includeUnconfirmed\"L\n" +
+	"\x15GetCollectionResponse\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.catalog.CollectionR\n" +
+	"collection\"\x8a\x01\n" +
+	"\x1aGetCollectionBySlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12'\n" +
+	"\x0finclude_flagged\x18\x02 \x01(\bR\x0eincludeFlagged\x12/\n" +
+	"\x13include_unconfirmed\x18\x03 \x01(\bR\x12includeUnconfirmed\"\x91\x03\n" +
+	"\x16ListCollectionsRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_flagged\x18\x04 \x01(\bR\x0eincludeFlagged\x12+\n" +
+	"\x12created_by_user_id\x18\x05 \x01(\tR\x0fcreatedByUserId\x12/\n" +
+	"\x13include_unconfirmed\x18\x06 \x01(\bR\x12includeUnconfirmed\x12\x18\n" +
+	"\acreator\x18\a \x01(\tR\acreator\x12#\n" +
+	"\rverified_only\x18\b \x01(\bR\fverifiedOnly\x124\n" +
+	"\vfloor_price\x18\t \x01(\v2\x13.catalog.PriceRangeR\n" +
+	"floorPrice\x12\x12\n" +
+	"\x04sort\x18\n" +
+	" \x01(\tR\x04sort\x12\x1e\n" +
+	"\n" +
+	"descending\x18\v \x01(\bR\n" +
+	"descending\"0\n" +
+	"\n" +
+	"PriceRange\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\tR\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\tR\x03max\"P\n" +
+	"\x17ListCollectionsResponse\x125\n" +
+	"\vcollections\x18\x01 \x03(\v2\x13.catalog.CollectionR\vcollections\"\xdb\x01\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtarget_type\x18\x02 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa7\x01\n" +
+	"\x14ReportContentRequest\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\adetails\x18\x04 \x01(\tR\adetails\x12\x1f\n" +
+	"\vreporter_id\x18\x05 \x01(\tR\n" +
+	"reporterId\"^\n" +
+	"\x15ReportContentResponse\x12'\n" +
+	"\x06report\x18\x01 \x01(\v2\x0f.catalog.ReportR\x06report\x12\x1c\n" +
+	"\tduplicate\x18\x02 \x01(\bR\tduplicate\"\x9a\x02\n" +
+	"\x0fReportQueueItem\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12!\n" +
+	"\freport_count\x18\x03 \x01(\x05R\vreportCount\x12\x18\n" +
+	"\areasons\x18\x04 \x03(\tR\areasons\x12F\n" +
+	"\x11first_reported_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ffirstReportedAt\x12D\n" +
+	"\x10last_reported_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReportedAt\"F\n" +
+	"\x16ListReportQueueRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"I\n" +
+	"\x17ListReportQueueResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.catalog.ReportQueueItemR\x05items\"\x9c\x01\n" +
+	"\x15ResolveReportsRequest\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\"a\n" +
+	"\x16ResolveReportsResponse\x12\x1a\n" +
+	"\bresolved\x18\x01 \x01(\x05R\bresolved\x12+\n" +
+	"\x04flag\x18\x02 \x01(\v2\x17.catalog.ModerationFlagR\x04flag\"\xd1\x01\n" +
+	"\rEarningsTotal\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12'\n" +
+	"\x0fcollection_name\x18\x03 \x01(\tR\x0ecollectionName\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1d\n" +
+	"\n" +
+	"sale_count\x18\x06 \x01(\x05R\tsaleCount\"L\n" +
+	"\x12GetEarningsRequest\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x01 \x03(\tR\n" +
+	"recipients\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"w\n" +
+	"\x13GetEarningsResponse\x12.\n" +
+	"\x06totals\x18\x01 \x03(\v2\x16.catalog.EarningsTotalR\x06totals\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\x8e\x05\n" +
+	"\aAuction\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x02 \x01(\tR\tauctionId\x12#\n" +
+	"\rauction_house\x18\x03 \x01(\tR\fauctionHouse\x12)\n" +
+	"\x10contract_address\x18\x04 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06seller\x18\x06 \x01(\tR\x06seller\x12!\n" +
+	"\fauction_type\x18\a \x01(\tR\vauctionType\x12\x1f\n" +
+	"\vstart_price\x18\b \x01(\tR\n" +
+	"startPrice\x12#\n" +
+	"\rreserve_price\x18\t \x01(\tR\freservePrice\x12\x1b\n" +
+	"\tend_price\x18\n" +
+	" \x01(\tR\bendPrice\x12\x1f\n" +
+	"\vhighest_bid\x18\v \x01(\tR\n" +
+	"highestBid\x12%\n" +
+	"\x0ehighest_bidder\x18\f \x01(\tR\rhighestBidder\x12\x1b\n" +
+	"\tbid_count\x18\r \x01(\x05R\bbidCount\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x12\x16\n" +
+	"\x06winner\x18\x0f \x01(\tR\x06winner\x129\n" +
+	"\n" +
+	"start_time\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x129\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"M\n" +
+	"\x11GetAuctionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x02 \x01(\tR\tauctionId\"@\n" +
+	"\x12GetAuctionResponse\x12*\n" +
+	"\aauction\x18\x01 \x01(\v2\x10.catalog.AuctionR\aauction\"\xe6\x03\n" +
+	"\x05Token\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x1a\n" +
+	"\bstandard\x18\x04 \x01(\tR\bstandard\x12\x16\n" +
+	"\x06supply\x18\x05 \x01(\tR\x06supply\x12\x1d\n" +
+	"\n" +
+	"max_supply\x18\x06 \x01(\tR\tmaxSupply\x12\x16\n" +
+	"\x06minted\x18\a \x01(\tR\x06minted\x12\x16\n" +
+	"\x06burned\x18\b \x01(\tR\x06burned\x12+\n" +
+	"\x11moderation_status\x18\t \x01(\tR\x10moderationStatus\x12\x12\n" +
+	"\x04name\x18\n" +
+	" \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\v \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05owner\x18\f \x01(\tR\x05owner\x12?\n" +
+	"\frarity_score\x18\r \x01(\v2\x1c.google.protobuf.DoubleValueR\vrarityScore\x12\x14\n" +
+	"\x05price\x18\x0e \x01(\tR\x05price\x12.\n" +
+	"\arentals\x18\x0f \x03(\v2\x14.catalog.TokenRentalR\arentals\"\xc7\x01\n" +
+	"\vTokenRental\x12\x1a\n" +
+	"\bstandard\x18\x01 \x01(\tR\bstandard\x12\x16\n" +
+	"\x06renter\x18\x02 \x01(\tR\x06renter\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1b\n" +
+	"\trecord_id\x18\x05 \x01(\tR\brecordId\x12\x14\n" +
+	"\x05owner\x18\x06 \x01(\tR\x05owner\"\x9b\x01\n" +
+	"\x0fGetTokenRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12'\n" +
+	"\x0finclude_flagged\x18\x04 \x01(\bR\x0eincludeFlagged\"8\n" +
+	"\x10GetTokenResponse\x12$\n" +
+	"\x05token\x18\x01 \x01(\v2\x0e.catalog.TokenR\x05token\"9\n" +
+	"\vTraitFilter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"\xeb\x02\n" +
+	"\x11ListTokensRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12)\n" +
+	"\x05price\x18\x04 \x01(\v2\x13.catalog.PriceRangeR\x05price\x12,\n" +
+	"\x06traits\x18\x05 \x03(\v2\x14.catalog.TraitFilterR\x06traits\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\x12\x1e\n" +
+	"\n" +
+	"descending\x18\a \x01(\bR\n" +
+	"descending\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\t \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_flagged\x18\n" +
+	" \x01(\bR\x0eincludeFlagged\x12\x16\n" +
+	"\x06owners\x18\v \x03(\tR\x06owners\"<\n" +
+	"\x12ListTokensResponse\x12&\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x0e.catalog.TokenR\x06tokens\"\xfb\x02\n" +
+	"\x0eWalletActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kind\x12!\n" +
+	"\ffrom_address\x18\x06 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\a \x01(\tR\ttoAddress\x12\x1a\n" +
+	"\bquantity\x18\b \x01(\tR\bquantity\x12\x14\n" +
+	"\x05price\x18\t \x01(\tR\x05price\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12\x17\n" +
+	"\atx_hash\x18\v \x01(\tR\x06txHash\x12;\n" +
+	"\voccurred_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x9c\x01\n" +
+	"\x19ListWalletActivityRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x122\n" +
+	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x1b\n" +
+	"\tbefore_id\x18\x03 \x01(\tR\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"U\n" +
+	"\x1aListWalletActivityResponse\x127\n" +
+	"\n" +
+	"activities\x18\x01 \x03(\v2\x17.catalog.WalletActivityR\n" +
+	"activities\"\xdb\x03\n" +
+	"\rWatchlistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtarget_type\x18\x02 \x01(\tR\n" +
+	"targetType\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x04 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12'\n" +
+	"\x0fcollection_name\x18\x06 \x01(\tR\x0ecollectionName\x12 \n" +
+	"\ffloor_at_add\x18\a \x01(\tR\n" +
+	"floorAtAdd\x12#\n" +
+	"\rcurrent_floor\x18\b \x01(\tR\fcurrentFloor\x12\x1f\n" +
+	"\vfloor_delta\x18\t \x01(\tR\n" +
+	"floorDelta\x12*\n" +
+	"\x11floor_alert_below\x18\n" +
+	" \x01(\tR\x0ffloorAlertBelow\x12@\n" +
+	"\x0ealert_fired_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\falertFiredAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xfb\x01\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12;\n" +
+	"\afilters\x18\x04 \x03(\v2!.catalog.SavedSearch.FiltersEntryR\afilters\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd8\x01\n" +
+	"\x0fFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vtarget_type\x18\x02 \x01(\tR\n" +
+	"targetType\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x04 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12*\n" +
+	"\x11floor_alert_below\x18\x06 \x01(\tR\x0ffloorAlertBelow\">\n" +
+	"\x10FavoriteResponse\x12*\n" +
+	"\x04item\x18\x01 \x01(\v2\x16.catalog.WatchlistItemR\x04item\"@\n" +
+	"\x15RemoveFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x18\n" +
+	"\x16RemoveFavoriteResponse\"\xd5\x01\n" +
+	"\x11SaveSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12A\n" +
+	"\afilters\x18\x04 \x03(\v2'.catalog.SaveSearchRequest.FiltersEntryR\afilters\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"B\n" +
+	"\x12SaveSearchResponse\x12,\n" +
+	"\x06search\x18\x01 \x01(\v2\x14.catalog.SavedSearchR\x06search\"C\n" +
+	"\x18DeleteSavedSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteSavedSearchResponse\".\n" +
+	"\x13GetWatchlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x81\x01\n" +
+	"\x14GetWatchlistResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.catalog.WatchlistItemR\x05items\x12;\n" +
+	"\x0esaved_searches\x18\x02 \x03(\v2\x14.catalog.SavedSearchR\rsavedSearches\"\x87\x02\n" +
+	"\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\x12\x19\n" +
+	"\btoken_id\x18\x04 \x01(\tR\atokenId\x12\x12\n" +
+	"\x04slug\x18\x05 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\x12'\n" +
+	"\x0fcollection_name\x18\a \x01(\tR\x0ecollectionName\x12\x1b\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05score\x18\t \x01(\x01R\x05score\"<\n" +
+	"\x0eSuggestRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"H\n" +
+	"\x0fSuggestResponse\x125\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x13.catalog.SuggestionR\vsuggestions\"q\n" +
+	"\vQueueStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bmessages\x18\x02 \x01(\x05R\bmessages\x12\x1c\n" +
+	"\tconsumers\x18\x03 \x01(\x05R\tconsumers\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xf5\x02\n" +
+	"\x0eConsumerStatus\x12\x1a\n" +
+	"\bconsumer\x18\x01 \x01(\tR\bconsumer\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12.\n" +
+	"\x13messages_per_second\x18\x03 \x01(\x01R\x11messagesPerSecond\x12\x1b\n" +
+	"\tin_flight\x18\x04 \x01(\x05R\binFlight\x124\n" +
+	"\x16oldest_unacked_seconds\x18\x05 \x01(\x01R\x14oldestUnackedSeconds\x12\x1c\n" +
+	"\tprocessed\x18\x06 \x01(\x03R\tprocessed\x12\x16\n" +
+	"\x06failed\x18\a \x01(\x03R\x06failed\x12;\n" +
+	"\vreported_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reportedAt\x12\x14\n" +
+	"\x05stale\x18\t \x01(\bR\x05stale\x12%\n" +
+	"\x0efalling_behind\x18\n" +
+	" \x01(\bR\rfallingBehind\"\x18\n" +
+	"\x16GetSystemStatusRequest\"\xb9\x01\n" +
+	"\x17GetSystemStatusResponse\x12,\n" +
+	"\x06queues\x18\x01 \x03(\v2\x14.catalog.QueueStatusR\x06queues\x125\n" +
+	"\tconsumers\x18\x02 \x03(\v2\x17.catalog.ConsumerStatusR\tconsumers\x129\n" +
+	"\n" +
+	"checked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"\xac\x01\n" +
+	"\x0eSnapshotExport\x12\x1f\n" +
+	"\vartifact_id\x18\x01 \x01(\tR\n" +
+	"artifactId\x12!\n" +
+	"\fdownload_url\x18\x02 \x01(\tR\vdownloadUrl\x12@\n" +
+	"\x0eurl_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\furlExpiresAt\x12\x14\n" +
+	"\x05bytes\x18\x04 \x01(\x03R\x05bytes\"\x89\x03\n" +
+	"\x0eHolderSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\x12!\n" +
+	"\fblock_number\x18\x04 \x01(\x04R\vblockNumber\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\x12!\n" +
+	"\fholder_count\x18\x06 \x01(\x05R\vholderCount\x12%\n" +
+	"\x0etotal_quantity\x18\a \x01(\tR\rtotalQuantity\x12)\n" +
+	"\x03csv\x18\b \x01(\v2\x17.catalog.SnapshotExportR\x03csv\x12+\n" +
+	"\x04json\x18\t \x01(\v2\x17.catalog.SnapshotExportR\x04json\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc7\x01\n" +
+	"\x1bCreateHolderSnapshotRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12?\n" +
+	"\fblock_number\x18\x03 \x01(\v2\x1c.google.protobuf.UInt64ValueR\vblockNumber\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\"S\n" +
+	"\x1cCreateHolderSnapshotResponse\x123\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x17.catalog.HolderSnapshotR\bsnapshot\"*\n" +
+	"\x18GetHolderSnapshotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x19GetHolderSnapshotResponse\x123\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x17.catalog.HolderSnapshotR\bsnapshot\"\xeb\x03\n" +
+	"\x10CollectionExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x04 \x01(\tR\x0fcontractAddress\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12!\n" +
+	"\frequested_by\x18\a \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1b\n" +
+	"\trow_count\x18\t \x01(\x05R\browCount\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12)\n" +
+	"\x03csv\x18\v \x01(\v2\x17.catalog.SnapshotExportR\x03csv\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xf8\x01\n" +
+	"\x1dCreateCollectionExportRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\"S\n" +
+	"\x1eCreateCollectionExportResponse\x121\n" +
+	"\x06export\x18\x01 \x01(\v2\x19.catalog.CollectionExportR\x06export\",\n" +
+	"\x1aGetCollectionExportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x1bGetCollectionExportResponse\x121\n" +
+	"\x06export\x18\x01 \x01(\v2\x19.catalog.CollectionExportR\x06export\"\xb0\x01\n" +
+	"\x16VerifyTokenGateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06owners\x18\x02 \x03(\tR\x06owners\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x04 \x01(\tR\x0fcontractAddress\x12\x1f\n" +
+	"\vmin_balance\x18\x05 \x01(\tR\n" +
+	"minBalance\"\xa1\x01\n" +
+	"\x17VerifyTokenGateResponse\x12\x12\n" +
+	"\x04held\x18\x01 \x01(\bR\x04held\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12\x1d\n" +
+	"\n" +
+	"gate_token\x18\x03 \x01(\tR\tgateToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x80\x01\n" +
+	"\x1aListDelegatedVaultsRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1c\n" +
+	"\tdelegates\x18\x02 \x03(\tR\tdelegates\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\"5\n" +
+	"\x1bListDelegatedVaultsResponse\x12\x16\n" +
+	"\x06vaults\x18\x01 \x03(\tR\x06vaults\"\x84\x01\n" +
+	"\vResyncDrift\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06holder\x18\x03 \x01(\tR\x06holder\x12\x18\n" +
+	"\acatalog\x18\x04 \x01(\tR\acatalog\x12\x14\n" +
+	"\x05chain\x18\x05 \x01(\tR\x05chain\"\xbb\x01\n" +
+	"\x17ResyncCollectionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1f\n" +
+	"\vsample_size\x18\x03 \x01(\x05R\n" +
+	"sampleSize\x12\x16\n" +
+	"\x06repair\x18\x04 \x01(\bR\x06repair\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\"\xdb\x02\n" +
+	"\x18ResyncCollectionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\x12\x1a\n" +
+	"\bstandard\x18\x04 \x01(\tR\bstandard\x12!\n" +
+	"\fblock_number\x18\x05 \x01(\x04R\vblockNumber\x12%\n" +
+	"\x0etokens_sampled\x18\x06 \x01(\x05R\rtokensSampled\x12,\n" +
+	"\x06drifts\x18\a \x03(\v2\x14.catalog.ResyncDriftR\x06drifts\x12\x1a\n" +
+	"\brepaired\x18\b \x01(\bR\brepaired\x129\n" +
+	"\n" +
+	"checked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"\xb4\x03\n" +
+	"\fUpcomingDrop\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x04 \x01(\tR\x0fcontractAddress\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x12!\n" +
+	"\fexternal_url\x18\b \x01(\tR\vexternalUrl\x12\x12\n" +
+	"\x04slug\x18\t \x01(\tR\x04slug\x12\x1d\n" +
+	"\n" +
+	"mint_price\x18\n" +
+	" \x01(\tR\tmintPrice\x12\x1f\n" +
+	"\vis_verified\x18\v \x01(\bR\n" +
+	"isVerified\x127\n" +
+	"\tstarts_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"\xa7\x01\n" +
+	"\x18ListUpcomingDropsRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"H\n" +
+	"\x19ListUpcomingDropsResponse\x12+\n" +
+	"\x05drops\x18\x01 \x03(\v2\x15.catalog.UpcomingDropR\x05drops\"\xdc\x04\n" +
+	"\x0eDropSubmission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x03 \x01(\tR\x0fcontractAddress\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12!\n" +
+	"\fexternal_url\x18\a \x01(\tR\vexternalUrl\x12\x1d\n" +
+	"\n" +
+	"mint_price\x18\b \x01(\tR\tmintPrice\x127\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12!\n" +
+	"\fsubmitted_by\x18\v \x01(\tR\vsubmittedBy\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\r \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreview_note\x18\x0e \x01(\tR\n" +
+	"reviewNote\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xff\x02\n" +
+	"\x11SubmitDropRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12!\n" +
+	"\fexternal_url\x18\x06 \x01(\tR\vexternalUrl\x12\x1d\n" +
+	"\n" +
+	"mint_price\x18\a \x01(\tR\tmintPrice\x127\n" +
+	"\tstarts_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12!\n" +
+	"\fsubmitted_by\x18\n" +
+	" \x01(\tR\vsubmittedBy\"M\n" +
+	"\x12SubmitDropResponse\x127\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x17.catalog.DropSubmissionR\n" +
+	"submission\"\x85\x01\n" +
+	"\x1aListDropSubmissionsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\fsubmitted_by\x18\x02 \x01(\tR\vsubmittedBy\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"X\n" +
+	"\x1bListDropSubmissionsResponse\x129\n" +
+	"\vsubmissions\x18\x01 \x03(\v2\x17.catalog.DropSubmissionR\vsubmissions\"t\n" +
+	"\x1bReviewDropSubmissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\"W\n" +
+	"\x1cReviewDropSubmissionResponse\x127\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x17.catalog.DropSubmissionR\n" +
+	"submission\"\x9c\x01\n" +
+	"\x0fMintStatsBucket\x122\n" +
+	"\x06minute\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06minute\x12\x14\n" +
+	"\x05mints\x18\x02 \x01(\tR\x05mints\x12%\n" +
+	"\x0eunique_minters\x18\x03 \x01(\x03R\runiqueMinters\x12\x18\n" +
+	"\arevenue\x18\x04 \x01(\tR\arevenue\"s\n" +
+	"\x13GetMintStatsRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\tR\x06window\"\xb1\x02\n" +
+	"\x14GetMintStatsResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\tR\x06window\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x14\n" +
+	"\x05mints\x18\x05 \x01(\tR\x05mints\x12%\n" +
+	"\x0eunique_minters\x18\x06 \x01(\x03R\runiqueMinters\x12\x18\n" +
+	"\arevenue\x18\a \x01(\tR\arevenue\x122\n" +
+	"\abuckets\x18\b \x03(\v2\x18.catalog.MintStatsBucketR\abuckets2\xb7\x18\n" +
+	"\x0eCatalogService\x12N\n" +
+	"\rGetCollection\x12\x1d.catalog.GetCollectionRequest\x1a\x1e.catalog.GetCollectionResponse\x12Z\n" +
+	"\x13GetCollectionBySlug\x12#.catalog.GetCollectionBySlugRequest\x1a\x1e.catalog.GetCollectionResponse\x12T\n" +
+	"\x0fListCollections\x12\x1f.catalog.ListCollectionsRequest\x1a .catalog.ListCollectionsResponse\x12r\n" +
+	"\x19SetCollectionOrganization\x12).catalog.SetCollectionOrganizationRequest\x1a*.catalog.SetCollectionOrganizationResponse\x12c\n" +
+	"\x14SetCollectionContent\x12$.catalog.SetCollectionContentRequest\x1a%.catalog.SetCollectionContentResponse\x12x\n" +
+	"\x1bSetCollectionClassification\x12+.catalog.SetCollectionClassificationRequest\x1a,.catalog.SetCollectionClassificationResponse\x12i\n" +
+	"\x16ListRelatedCollections\x12&.catalog.ListRelatedCollectionsRequest\x1a'.catalog.ListRelatedCollectionsResponse\x12?\n" +
+	"\bFlagItem\x12\x18.catalog.FlagItemRequest\x1a\x19.catalog.FlagItemResponse\x12E\n" +
+	"\n" +
+	"UnflagItem\x12\x1a.catalog.UnflagItemRequest\x1a\x1b.catalog.UnflagItemResponse\x12N\n" +
+	"\rReportContent\x12\x1d.catalog.ReportContentRequest\x1a\x1e.catalog.ReportContentResponse\x12T\n" +
+	"\x0fListReportQueue\x12\x1f.catalog.ListReportQueueRequest\x1a .catalog.ListReportQueueResponse\x12Q\n" +
+	"\x0eResolveReports\x12\x1e.catalog.ResolveReportsRequest\x1a\x1f.catalog.ResolveReportsResponse\x12H\n" +
+	"\vGetEarnings\x12\x1b.catalog.GetEarningsRequest\x1a\x1c.catalog.GetEarningsResponse\x12E\n" +
+	"\n" +
+	"GetAuction\x12\x1a.catalog.GetAuctionRequest\x1a\x1b.catalog.GetAuctionResponse\x12?\n" +
+	"\bGetToken\x12\x18.catalog.GetTokenRequest\x1a\x19.catalog.GetTokenResponse\x12E\n" +
+	"\n" +
+	"ListTokens\x12\x1a.catalog.ListTokensRequest\x1a\x1b.catalog.ListTokensResponse\x12<\n" +
+	"\aSuggest\x12\x17.catalog.SuggestRequest\x1a\x18.catalog.SuggestResponse\x12]\n" +
+	"\x12ListWalletActivity\x12\".catalog.ListWalletActivityRequest\x1a#.catalog.ListWalletActivityResponse\x12?\n" +
+	"\bFavorite\x12\x18.catalog.FavoriteRequest\x1a\x19.catalog.FavoriteResponse\x12Q\n" +
+	"\x0eRemoveFavorite\x12\x1e.catalog.RemoveFavoriteRequest\x1a\x1f.catalog.RemoveFavoriteResponse\x12E\n" +
+	"\n" +
+	"SaveSearch\x12\x1a.catalog.SaveSearchRequest\x1a\x1b.catalog.SaveSearchResponse\x12Z\n" +
+	"\x11DeleteSavedSearch\x12!.catalog.DeleteSavedSearchRequest\x1a\".catalog.DeleteSavedSearchResponse\x12K\n" +
+	"\fGetWatchlist\x12\x1c.catalog.GetWatchlistRequest\x1a\x1d.catalog.GetWatchlistResponse\x12T\n" +
+	"\x0fGetSystemStatus\x12\x1f.catalog.GetSystemStatusRequest\x1a .catalog.GetSystemStatusResponse\x12c\n" +
+	"\x14CreateHolderSnapshot\x12$.catalog.CreateHolderSnapshotRequest\x1a%.catalog.CreateHolderSnapshotResponse\x12Z\n" +
+	"\x11GetHolderSnapshot\x12!.catalog.GetHolderSnapshotRequest\x1a\".catalog.GetHolderSnapshotResponse\x12i\n" +
+	"\x16CreateCollectionExport\x12&.catalog.CreateCollectionExportRequest\x1a'.catalog.CreateCollectionExportResponse\x12`\n" +
+	"\x13GetCollectionExport\x12#.catalog.GetCollectionExportRequest\x1a$.catalog.GetCollectionExportResponse\x12T\n" +
+	"\x0fVerifyTokenGate\x12\x1f.catalog.VerifyTokenGateRequest\x1a .catalog.VerifyTokenGateResponse\x12`\n" +
+	"\x13ListDelegatedVaults\x12#.catalog.ListDelegatedVaultsRequest\x1a$.catalog.ListDelegatedVaultsResponse\x12W\n" +
+	"\x10ResyncCollection\x12 .catalog.ResyncCollectionRequest\x1a!.catalog.ResyncCollectionResponse\x12Z\n" +
+	"\x11ListUpcomingDrops\x12!.catalog.ListUpcomingDropsRequest\x1a\".catalog.ListUpcomingDropsResponse\x12E\n" +
+	"\n" +
+	"SubmitDrop\x12\x1a.catalog.SubmitDropRequest\x1a\x1b.catalog.SubmitDropResponse\x12`\n" +
+	"\x13ListDropSubmissions\x12#.catalog.ListDropSubmissionsRequest\x1a$.catalog.ListDropSubmissionsResponse\x12c\n" +
+	"\x14ReviewDropSubmission\x12$.catalog.ReviewDropSubmissionRequest\x1a%.catalog.ReviewDropSubmissionResponse\x12K\n" +
+	"\fGetMintStats\x12\x1c.catalog.GetMintStatsRequest\x1a\x1d.catalog.GetMintStatsResponseB\x1eZ\x1cshared/proto/catalog;catalogb\x06proto3"
+
+var (
+	file_catalog_proto_rawDescOnce sync.Once
+	file_catalog_proto_rawDescData []byte
+)
+
+func file_catalog_proto_rawDescGZIP() []byte {
+	file_catalog_proto_rawDescOnce.Do(func() {
+		file_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_catalog_proto_rawDesc), len(file_catalog_proto_rawDesc)))
+	})
+	return file_catalog_proto_rawDescData
+}
+
+var file_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
+var file_catalog_proto_goTypes = []any{
+	(*Collection)(nil),                          // 0: catalog.Collection
+	(*PayoutSplit)(nil),                         // 1: catalog.PayoutSplit
+	(*LocalizedContent)(nil),                    // 2: catalog.LocalizedContent
+	(*ModerationFlag)(nil),                      // 3: catalog.ModerationFlag
+	(*FlagItemRequest)(nil),                     // 4: catalog.FlagItemRequest
+	(*FlagItemResponse)(nil),                    // 5: catalog.FlagItemResponse
+	(*UnflagItemRequest)(nil),                   // 6: catalog.UnflagItemRequest
+	(*UnflagItemResponse)(nil),                  // 7: catalog.UnflagItemResponse
+	(*SetCollectionOrganizationRequest)(nil),    // 8: catalog.SetCollectionOrganizationRequest
+	(*SetCollectionOrganizationResponse)(nil),   // 9: catalog.SetCollectionOrganizationResponse
+	(*SetCollectionContentRequest)(nil),         // 10: catalog.SetCollectionContentRequest
+	(*SetCollectionContentResponse)(nil),        // 11: catalog.SetCollectionContentResponse
+	(*SetCollectionClassificationRequest)(nil),  // 12: catalog.SetCollectionClassificationRequest
+	(*SetCollectionClassificationResponse)(nil), // 13: catalog.SetCollectionClassificationResponse
+	(*ListRelatedCollectionsRequest)(nil),       // 14: catalog.ListRelatedCollectionsRequest
+	(*ListRelatedCollectionsResponse)(nil),      // 15: catalog.ListRelatedCollectionsResponse
+	(*GetCollectionRequest)(nil),                // 16: catalog.GetCollectionRequest
+	(*GetCollectionResponse)(nil),               // 17: catalog.GetCollectionResponse
+	(*GetCollectionBySlugRequest)(nil),          // 18: catalog.GetCollectionBySlugRequest
+	(*ListCollectionsRequest)(nil),              // 19: catalog.ListCollectionsRequest
+	(*PriceRange)(nil),                          // 20: catalog.PriceRange
+	(*ListCollectionsResponse)(nil),             // 21: catalog.ListCollectionsResponse
+	(*Report)(nil),                              // 22: catalog.Report
+	(*ReportContentRequest)(nil),                // 23: catalog.ReportContentRequest
+	(*ReportContentResponse)(nil),               // 24: catalog.ReportContentResponse
+	(*ReportQueueItem)(nil),                     // 25: catalog.ReportQueueItem
+	(*ListReportQueueRequest)(nil),              // 26: catalog.ListReportQueueRequest
+	(*ListReportQueueResponse)(nil),             // 27: catalog.ListReportQueueResponse
+	(*ResolveReportsRequest)(nil),               // 28: catalog.ResolveReportsRequest
+	(*ResolveReportsResponse)(nil),              // 29: catalog.ResolveReportsResponse
+	(*EarningsTotal)(nil),                       // 30: catalog.EarningsTotal
+	(*GetEarningsRequest)(nil),                  // 31: catalog.GetEarningsRequest
+	(*GetEarningsResponse)(nil),                 // 32: catalog.GetEarningsResponse
+	(*Auction)(nil),                             // 33: catalog.Auction
+	(*GetAuctionRequest)(nil),                   // 34: catalog.GetAuctionRequest
+	(*GetAuctionResponse)(nil),                  // 35: catalog.GetAuctionResponse
+	(*Token)(nil),                               // 36: catalog.Token
+	(*TokenRental)(nil),                         // 37: catalog.TokenRental
+	(*GetTokenRequest)(nil),                     // 38: catalog.GetTokenRequest
+	(*GetTokenResponse)(nil),                    // 39: catalog.GetTokenResponse
+	(*TraitFilter)(nil),                         // 40: catalog.TraitFilter
+	(*ListTokensRequest)(nil),                   // 41: catalog.ListTokensRequest
+	(*ListTokensResponse)(nil),                  // 42: catalog.ListTokensResponse
+	(*WalletActivity)(nil),                      // 43: catalog.WalletActivity
+	(*ListWalletActivityRequest)(nil),           // 44: catalog.ListWalletActivityRequest
+	(*ListWalletActivityResponse)(nil),          // 45: catalog.ListWalletActivityResponse
+	(*WatchlistItem)(nil),                       // 46: catalog.WatchlistItem
+	(*SavedSearch)(nil),                         // 47: catalog.SavedSearch
+	(*FavoriteRequest)(nil),                     // 48: catalog.FavoriteRequest
+	(*FavoriteResponse)(nil),                    // 49: catalog.FavoriteResponse
+	(*RemoveFavoriteRequest)(nil),               // 50: catalog.RemoveFavoriteRequest
+	(*RemoveFavoriteResponse)(nil),              // 51: catalog.RemoveFavoriteResponse
+	(*SaveSearchRequest)(nil),                   // 52: catalog.SaveSearchRequest
+	(*SaveSearchResponse)(nil),                  // 53: catalog.SaveSearchResponse
+	(*DeleteSavedSearchRequest)(nil),            // 54: catalog.DeleteSavedSearchRequest
+	(*DeleteSavedSearchResponse)(nil),           // 55: catalog.DeleteSavedSearchResponse
+	(*GetWatchlistRequest)(nil),                 // 56: catalog.GetWatchlistRequest
+	(*GetWatchlistResponse)(nil),                // 57: catalog.GetWatchlistResponse
+	(*Suggestion)(nil),                          // 58: catalog.Suggestion
+	(*SuggestRequest)(nil),                      // 59: catalog.SuggestRequest
+	(*SuggestResponse)(nil),                     // 60: catalog.SuggestResponse
+	(*QueueStatus)(nil),                         // 61: catalog.QueueStatus
+	(*ConsumerStatus)(nil),                      // 62: catalog.ConsumerStatus
+	(*GetSystemStatusRequest)(nil),              // 63: catalog.GetSystemStatusRequest
+	(*GetSystemStatusResponse)(nil),             // 64: catalog.GetSystemStatusResponse
+	(*SnapshotExport)(nil),                      // 65: catalog.SnapshotExport
+	(*HolderSnapshot)(nil),                      // 66: catalog.HolderSnapshot
+	(*CreateHolderSnapshotRequest)(nil),         // 67: catalog.CreateHolderSnapshotRequest
+	(*CreateHolderSnapshotResponse)(nil),        // 68: catalog.CreateHolderSnapshotResponse
+	(*GetHolderSnapshotRequest)(nil),            // 69: catalog.GetHolderSnapshotRequest
+	(*GetHolderSnapshotResponse)(nil),           // 70: catalog.GetHolderSnapshotResponse
+	(*CollectionExport)(nil),                    // 71: catalog.CollectionExport
+	(*CreateCollectionExportRequest)(nil),       // 72: catalog.CreateCollectionExportRequest
+	(*CreateCollectionExportResponse)(nil),      // 73: catalog.CreateCollectionExportResponse
+	(*GetCollectionExportRequest)(nil),          // 74: catalog.GetCollectionExportRequest
+	(*GetCollectionExportResponse)(nil),         // 75: catalog.GetCollectionExportResponse
+	(*VerifyTokenGateRequest)(nil),              // 76: catalog.VerifyTokenGateRequest
+	(*VerifyTokenGateResponse)(nil),             // 77: catalog.VerifyTokenGateResponse
+	(*ListDelegatedVaultsRequest)(nil),          // 78: catalog.ListDelegatedVaultsRequest
+	(*ListDelegatedVaultsResponse)(nil),         // 79: catalog.ListDelegatedVaultsResponse
+	(*ResyncDrift)(nil),                         // 80: catalog.ResyncDrift
+	(*ResyncCollectionRequest)(nil),             // 81: catalog.ResyncCollectionRequest
+	(*ResyncCollectionResponse)(nil),            // 82: catalog.ResyncCollectionResponse
+	(*UpcomingDrop)(nil),                        // 83: catalog.UpcomingDrop
+	(*ListUpcomingDropsRequest)(nil),            // 84: catalog.ListUpcomingDropsRequest
+	(*ListUpcomingDropsResponse)(nil),           // 85: catalog.ListUpcomingDropsResponse
+	(*DropSubmission)(nil),                      // 86: catalog.DropSubmission
+	(*SubmitDropRequest)(nil),                   // 87: catalog.SubmitDropRequest
+	(*SubmitDropResponse)(nil),                  // 88: catalog.SubmitDropResponse
+	(*ListDropSubmissionsRequest)(nil),          // 89: catalog.ListDropSubmissionsRequest
+	(*ListDropSubmissionsResponse)(nil),         // 90: catalog.ListDropSubmissionsResponse
+	(*ReviewDropSubmissionRequest)(nil),         // 91: catalog.ReviewDropSubmissionRequest
+	(*ReviewDropSubmissionResponse)(nil),        // 92: catalog.ReviewDropSubmissionResponse
+	(*MintStatsBucket)(nil),                     // 93: catalog.MintStatsBucket
+	(*GetMintStatsRequest)(nil),                 // 94: catalog.GetMintStatsRequest
+	(*GetMintStatsResponse)(nil),                // 95: catalog.GetMintStatsResponse
+	nil,                                         // 96: catalog.SavedSearch.FiltersEntry
+	nil,                                         // 97: catalog.SaveSearchRequest.FiltersEntry
+	(*timestamppb.Timestamp)(nil),               // 98: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil),              // 99: google.protobuf.DoubleValue
+	(*wrapperspb.UInt64Value)(nil),              // 100: google.protobuf.UInt64Value
+}
+var file_catalog_proto_depIdxs = []int32{
+	98,  // 0: catalog.Collection.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 1: catalog.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 2: catalog.Collection.localized:type_name -> catalog.LocalizedContent
+	1,   // 3: catalog.Collection.payout_splits:type_name -> catalog.PayoutSplit
+	98,  // 4: catalog.LocalizedContent.updated_at:type_name -> google.protobuf.Timestamp
+	98,  // 5: catalog.ModerationFlag.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 6: catalog.ModerationFlag.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 7: catalog.FlagItemResponse.flag:type_name -> catalog.ModerationFlag
+	3,   // 8: catalog.UnflagItemResponse.flag:type_name -> catalog.ModerationFlag
+	0,   // 9: catalog.SetCollectionOrganizationResponse.collection:type_name -> catalog.Collection
+	0,   // 10: catalog.SetCollectionContentResponse.collection:type_name -> catalog.Collection
+	0,   // 11: catalog.SetCollectionClassificationResponse.collection:type_name -> catalog.Collection
+	0,   // 12: catalog.ListRelatedCollectionsResponse.collections:type_name -> catalog.Collection
+	0,   // 13: catalog.GetCollectionResponse.collection:type_name -> catalog.Collection
+	20,  // 14: catalog.ListCollectionsRequest.floor_price:type_name -> catalog.PriceRange
+	0,   // 15: catalog.ListCollectionsResponse.collections:type_name -> catalog.Collection
+	98,  // 16: catalog.Report.created_at:type_name -> google.protobuf.Timestamp
+	22,  // 17: catalog.ReportContentResponse.report:type_name -> catalog.Report
+	98,  // 18: catalog.ReportQueueItem.first_reported_at:type_name -> google.protobuf.Timestamp
+	98,  // 19: catalog.ReportQueueItem.last_reported_at:type_name -> google.protobuf.Timestamp
+	25,  // 20: catalog.ListReportQueueResponse.items:type_name -> catalog.ReportQueueItem
+	3,   // 21: catalog.ResolveReportsResponse.flag:type_name -> catalog.ModerationFlag
+	30,  // 22: catalog.GetEarningsResponse.totals:type_name -> catalog.EarningsTotal
+	98,  // 23: catalog.GetEarningsResponse.since:type_name -> google.protobuf.Timestamp
+	98,  // 24: catalog.Auction.start_time:type_name -> google.protobuf.Timestamp
+	98,  // 25: catalog.Auction.end_time:type_name -> google.protobuf.Timestamp
+	98,  // 26: catalog.Auction.updated_at:type_name -> google.protobuf.Timestamp
+	33,  // 27: catalog.GetAuctionResponse.auction:type_name -> catalog.Auction
+	99,  // 28: catalog.Token.rarity_score:type_name -> google.protobuf.DoubleValue
+	37,  // 29: catalog.Token.rentals:type_name -> catalog.TokenRental
+	98,  // 30: catalog.TokenRental.expires_at:type_name -> google.protobuf.Timestamp
+	36,  // 31: catalog.GetTokenResponse.token:type_name -> catalog.Token
+	20,  // 32: catalog.ListTokensRequest.price:type_name -> catalog.PriceRange
+	40,  // 33: catalog.ListTokensRequest.traits:type_name -> catalog.TraitFilter
+	36,  // 34: catalog.ListTokensResponse.tokens:type_name -> catalog.Token
+	98,  // 35: catalog.WalletActivity.occurred_at:type_name -> google.protobuf.Timestamp
+	98,  // 36: catalog.ListWalletActivityRequest.before:type_name -> google.protobuf.Timestamp
+	43,  // 37: catalog.ListWalletActivityResponse.activities:type_name -> catalog.WalletActivity
+	98,  // 38: catalog.WatchlistItem.alert_fired_at:type_name -> google.protobuf.Timestamp
+	98,  // 39: catalog.WatchlistItem.created_at:type_name -> google.protobuf.Timestamp
+	96,  // 40: catalog.SavedSearch.filters:type_name -> catalog.SavedSearch.FiltersEntry
+	98,  // 41: catalog.SavedSearch.created_at:type_name -> google.protobuf.Timestamp
+	46,  // 42: catalog.FavoriteResponse.item:type_name -> catalog.WatchlistItem
+	97,  // 43: catalog.SaveSearchRequest.filters:type_name -> catalog.SaveSearchRequest.FiltersEntry
+	47,  // 44: catalog.SaveSearchResponse.search:type_name -> catalog.SavedSearch
+	46,  // 45: catalog.GetWatchlistResponse.items:type_name -> catalog.WatchlistItem
+	47,  // 46: catalog.GetWatchlistResponse.saved_searches:type_name -> catalog.SavedSearch
+	58,  // 47: catalog.SuggestResponse.suggestions:type_name -> catalog.Suggestion
+	98,  // 48: catalog.ConsumerStatus.reported_at:type_name -> google.protobuf.Timestamp
+	61,  // 49: catalog.GetSystemStatusResponse.queues:type_name -> catalog.QueueStatus
+	62,  // 50: catalog.GetSystemStatusResponse.consumers:type_name -> catalog.ConsumerStatus
+	98,  // 51: catalog.GetSystemStatusResponse.checked_at:type_name -> google.protobuf.Timestamp
+	98,  // 52: catalog.SnapshotExport.url_expires_at:type_name -> google.protobuf.Timestamp
+	65,  // 53: catalog.HolderSnapshot.csv:type_name -> catalog.SnapshotExport
+	65,  // 54: catalog.HolderSnapshot.json:type_name -> catalog.SnapshotExport
+	98,  // 55: catalog.HolderSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	100, // 56: catalog.CreateHolderSnapshotRequest.block_number:type_name -> google.protobuf.UInt64Value
+	66,  // 57: catalog.CreateHolderSnapshotResponse.snapshot:type_name -> catalog.HolderSnapshot
+	66,  // 58: catalog.GetHolderSnapshotResponse.snapshot:type_name -> catalog.HolderSnapshot
+	98,  // 59: catalog.CollectionExport.from:type_name -> google.protobuf.Timestamp
+	98,  // 60: catalog.CollectionExport.to:type_name -> google.protobuf.Timestamp
+	65,  // 61: catalog.CollectionExport.csv:type_name -> catalog.SnapshotExport
+	98,  // 62: catalog.CollectionExport.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 63: catalog.CollectionExport.completed_at:type_name -> google.protobuf.Timestamp
+	98,  // 64: catalog.CreateCollectionExportRequest.from:type_name -> google.protobuf.Timestamp
+	98,  // 65: catalog.CreateCollectionExportRequest.to:type_name -> google.protobuf.Timestamp
+	71,  // 66: catalog.CreateCollectionExportResponse.export:type_name -> catalog.CollectionExport
+	71,  // 67: catalog.GetCollectionExportResponse.export:type_name -> catalog.CollectionExport
+	98,  // 68: catalog.VerifyTokenGateResponse.expires_at:type_name -> google.protobuf.Timestamp
+	80,  // 69: catalog.ResyncCollectionResponse.drifts:type_name -> catalog.ResyncDrift
+	98,  // 70: catalog.ResyncCollectionResponse.checked_at:type_name -> google.protobuf.Timestamp
+	98,  // 71: catalog.UpcomingDrop.starts_at:type_name -> google.protobuf.Timestamp
+	98,  // 72: catalog.UpcomingDrop.ends_at:type_name -> google.protobuf.Timestamp
+	98,  // 73: catalog.ListUpcomingDropsRequest.from:type_name -> google.protobuf.Timestamp
+	98,  // 74: catalog.ListUpcomingDropsRequest.to:type_name -> google.protobuf.Timestamp
+	83,  // 75: catalog.ListUpcomingDropsResponse.drops:type_name -> catalog.UpcomingDrop
+	98,  // 76: catalog.DropSubmission.starts_at:type_name -> google.protobuf.Timestamp
+	98,  // 77: catalog.DropSubmission.ends_at:type_name -> google.protobuf.Timestamp
+	98,  // 78: catalog.DropSubmission.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 79: catalog.DropSubmission.updated_at:type_name -> google.protobuf.Timestamp
+	98,  // 80: catalog.SubmitDropRequest.starts_at:type_name -> google.protobuf.Timestamp
+	98,  // 81: catalog.SubmitDropRequest.ends_at:type_name -> google.protobuf.Timestamp
+	86,  // 82: catalog.SubmitDropResponse.submission:type_name -> catalog.DropSubmission
+	86,  // 83: catalog.ListDropSubmissionsResponse.submissions:type_name -> catalog.DropSubmission
+	86,  // 84: catalog.ReviewDropSubmissionResponse.submission:type_name -> catalog.DropSubmission
+	98,  // 85: catalog.MintStatsBucket.minute:type_name -> google.protobuf.Timestamp
+	98,  // 86: catalog.GetMintStatsResponse.since:type_name -> google.protobuf.Timestamp
+	93,  // 87: catalog.GetMintStatsResponse.buckets:type_name -> catalog.MintStatsBucket
+	16,  // 88: catalog.CatalogService.GetCollection:input_type -> catalog.GetCollectionRequest
+	18,  // 89: catalog.CatalogService.GetCollectionBySlug:input_type -> catalog.GetCollectionBySlugRequest
+	19,  // 90: catalog.CatalogService.ListCollections:input_type -> catalog.ListCollectionsRequest
+	8,   // 91: catalog.CatalogService.SetCollectionOrganization:input_type -> catalog.SetCollectionOrganizationRequest
+	10,  // 92: catalog.CatalogService.SetCollectionContent:input_type -> catalog.SetCollectionContentRequest
+	12,  // 93: catalog.CatalogService.SetCollectionClassification:input_type -> catalog.SetCollectionClassificationRequest
+	14,  // 94: catalog.CatalogService.ListRelatedCollections:input_type -> catalog.ListRelatedCollectionsRequest
+	4,   // 95: catalog.CatalogService.FlagItem:input_type -> catalog.FlagItemRequest
+	6,   // 96: catalog.CatalogService.UnflagItem:input_type -> catalog.UnflagItemRequest
+	23,  // 97: catalog.CatalogService.ReportContent:input_type -> catalog.ReportContentRequest
+	26,  // 98: catalog.CatalogService.ListReportQueue:input_type -> catalog.ListReportQueueRequest
+	28,  // 99: catalog.CatalogService.ResolveReports:input_type -> catalog.ResolveReportsRequest
+	31,  // 100: catalog.CatalogService.GetEarnings:input_type -> catalog.GetEarningsRequest
+	34,  // 101: catalog.CatalogService.GetAuction:input_type -> catalog.GetAuctionRequest
+	38,  // 102: catalog.CatalogService.GetToken:input_type -> catalog.GetTokenRequest
+	41,  // 103: catalog.CatalogService.ListTokens:input_type -> catalog.ListTokensRequest
+	59,  // 104: catalog.CatalogService.Suggest:input_type -> catalog.SuggestRequest
+	44,  // 105: catalog.CatalogService.ListWalletActivity:input_type -> catalog.ListWalletActivityRequest
+	48,  // 106: catalog.CatalogService.Favorite:input_type -> catalog.FavoriteRequest
+	50,  // 107: catalog.CatalogService.RemoveFavorite:input_type -> catalog.RemoveFavoriteRequest
+	52,  // 108: catalog.CatalogService.SaveSearch:input_type -> catalog.SaveSearchRequest
+	54,  // 109: catalog.CatalogService.DeleteSavedSearch:input_type -> catalog.DeleteSavedSearchRequest
+	56,  // 110: catalog.CatalogService.GetWatchlist:input_type -> catalog.GetWatchlistRequest
+	63,  // 111: catalog.CatalogService.GetSystemStatus:input_type -> catalog.GetSystemStatusRequest
+	67,  // 112: catalog.CatalogService.CreateHolderSnapshot:input_type -> catalog.CreateHolderSnapshotRequest
+	69,  // 113: catalog.CatalogService.GetHolderSnapshot:input_type -> catalog.GetHolderSnapshotRequest
+	72,  // 114: catalog.CatalogService.CreateCollectionExport:input_type -> catalog.CreateCollectionExportRequest
+	74,  // 115: catalog.CatalogService.GetCollectionExport:input_type -> catalog.GetCollectionExportRequest
+	76,  // 116: catalog.CatalogService.VerifyTokenGate:input_type -> catalog.VerifyTokenGateRequest
+	78,  // 117: catalog.CatalogService.ListDelegatedVaults:input_type -> catalog.ListDelegatedVaultsRequest
+	81,  // 118: catalog.CatalogService.ResyncCollection:input_type -> catalog.ResyncCollectionRequest
+	84,  // 119: catalog.CatalogService.ListUpcomingDrops:input_type -> catalog.ListUpcomingDropsRequest
+	87,  // 120: catalog.CatalogService.SubmitDrop:input_type -> catalog.SubmitDropRequest
+	89,  // 121: catalog.CatalogService.ListDropSubmissions:input_type -> catalog.ListDropSubmissionsRequest
+	91,  // 122: catalog.CatalogService.ReviewDropSubmission:input_type -> catalog.ReviewDropSubmissionRequest
+	94,  // 123: catalog.CatalogService.GetMintStats:input_type -> catalog.GetMintStatsRequest
+	17,  // 124: catalog.CatalogService.GetCollection:output_type -> catalog.GetCollectionResponse
+	17,  // 125: catalog.CatalogService.GetCollectionBySlug:output_type -> catalog.GetCollectionResponse
+	21,  // 126: catalog.CatalogService.ListCollections:output_type -> catalog.ListCollectionsResponse
+	9,   // 127: catalog.CatalogService.SetCollectionOrganization:output_type -> catalog.SetCollectionOrganizationResponse
+	11,  // 128: catalog.CatalogService.SetCollectionContent:output_type -> catalog.SetCollectionContentResponse
+	13,  // 129: catalog.CatalogService.SetCollectionClassification:output_type -> catalog.SetCollectionClassificationResponse
+	15,  // 130: catalog.CatalogService.ListRelatedCollections:output_type -> catalog.ListRelatedCollectionsResponse
+	5,   // 131: catalog.CatalogService.FlagItem:output_type -> catalog.FlagItemResponse
+	7,   // 132: catalog.CatalogService.UnflagItem:output_type -> catalog.UnflagItemResponse
+	24,  // 133: catalog.CatalogService.ReportContent:output_type -> catalog.ReportContentResponse
+	27,  // 134: catalog.CatalogService.ListReportQueue:output_type -> catalog.ListReportQueueResponse
+	29,  // 135: catalog.CatalogService.ResolveReports:output_type -> catalog.ResolveReportsResponse
+	32,  // 136: catalog.CatalogService.GetEarnings:output_type -> catalog.GetEarningsResponse
+	35,  // 137: catalog.CatalogService.GetAuction:output_type -> catalog.GetAuctionResponse
+	39,  // 138: catalog.CatalogService.GetToken:output_type -> catalog.GetTokenResponse
+	42,  // 139: catalog.CatalogService.ListTokens:output_type -> catalog.ListTokensResponse
+	60,  // 140: catalog.CatalogService.Suggest:output_type -> catalog.SuggestResponse
+	45,  // 141: catalog.CatalogService.ListWalletActivity:output_type -> catalog.ListWalletActivityResponse
+	49,  // 142: catalog.CatalogService.Favorite:output_type -> catalog.FavoriteResponse
+	51,  // 143: catalog.CatalogService.RemoveFavorite:output_type -> catalog.RemoveFavoriteResponse
+	53,  // 144: catalog.CatalogService.SaveSearch:output_type -> catalog.SaveSearchResponse
+	55,  // 145: catalog.CatalogService.DeleteSavedSearch:output_type -> catalog.DeleteSavedSearchResponse
+	57,  // 146: catalog.CatalogService.GetWatchlist:output_type -> catalog.GetWatchlistResponse
+	64,  // 147: catalog.CatalogService.GetSystemStatus:output_type -> catalog.GetSystemStatusResponse
+	68,  // 148: catalog.CatalogService.CreateHolderSnapshot:output_type -> catalog.CreateHolderSnapshotResponse
+	70,  // 149: catalog.CatalogService.GetHolderSnapshot:output_type -> catalog.GetHolderSnapshotResponse
+	73,  // 150: catalog.CatalogService.CreateCollectionExport:output_type -> catalog.CreateCollectionExportResponse
+	75,  // 151: catalog.CatalogService.GetCollectionExport:output_type -> catalog.GetCollectionExportResponse
+	77,  // 152: catalog.CatalogService.VerifyTokenGate:output_type -> catalog.VerifyTokenGateResponse
+	79,  // 153: catalog.CatalogService.ListDelegatedVaults:output_type -> catalog.ListDelegatedVaultsResponse
+	82,  // 154: catalog.CatalogService.ResyncCollection:output_type -> catalog.ResyncCollectionResponse
+	85,  // 155: catalog.CatalogService.ListUpcomingDrops:output_type -> catalog.ListUpcomingDropsResponse
+	88,  // 156: catalog.CatalogService.SubmitDrop:output_type -> catalog.SubmitDropResponse
+	90,  // 157: catalog.CatalogService.ListDropSubmissions:output_type -> catalog.ListDropSubmissionsResponse
+	92,  // 158: catalog.CatalogService.ReviewDropSubmission:output_type -> catalog.ReviewDropSubmissionResponse
+	95,  // 159: catalog.CatalogService.GetMintStats:output_type -> catalog.GetMintStatsResponse
+	124, // [124:160] is the sub-list for method output_type
+	88,  // [88:124] is the sub-list for method input_type
+	88,  // [88:88] is the sub-list for extension type_name
+	88,  // [88:88] is the sub-list for extension extendee
+	0,   // [0:88] is the sub-list for field type_name
+}
+
+func init() { file_catalog_proto_init() }
+func file_catalog_proto_init() {
+	if File_catalog_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_proto_rawDesc), len(file_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   98,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_proto_goTypes,
+		DependencyIndexes: file_catalog_proto_depIdxs,
+		MessageInfos:      file_catalog_proto_msgTypes,
+	}.Build()
+	File_catalog_proto = out.File
+	file_catalog_proto_goTypes = nil
+	file_catalog_proto_depIdxs = nil
+}
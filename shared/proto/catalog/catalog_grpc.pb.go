@@ -0,0 +1,1488 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0--rc1
+// source: catalog.proto
+
+package catalog
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CatalogService_GetCollection_FullMethodName               = "/catalog.CatalogService/GetCollection"
+	CatalogService_GetCollectionBySlug_FullMethodName         = "/catalog.CatalogService/GetCollectionBySlug"
+	CatalogService_ListCollections_FullMethodName             = "/catalog.CatalogService/ListCollections"
+	CatalogService_SetCollectionOrganization_FullMethodName   = "/catalog.CatalogService/SetCollectionOrganization"
+	CatalogService_SetCollectionContent_FullMethodName        = "/catalog.CatalogService/SetCollectionContent"
+	CatalogService_SetCollectionClassification_FullMethodName = "/catalog.CatalogService/SetCollectionClassification"
+	CatalogService_ListRelatedCollections_FullMethodName      = "/catalog.CatalogService/ListRelatedCollections"
+	CatalogService_FlagItem_FullMethodName                    = "/catalog.CatalogService/FlagItem"
+	CatalogService_UnflagItem_FullMethodName                  = "/catalog.CatalogService/UnflagItem"
+	CatalogService_ReportContent_FullMethodName               = "/catalog.CatalogService/ReportContent"
+	CatalogService_ListReportQueue_FullMethodName             = "/catalog.CatalogService/ListReportQueue"
+	CatalogService_ResolveReports_FullMethodName              = "/catalog.CatalogService/ResolveReports"
+	CatalogService_GetEarnings_FullMethodName                 = "/catalog.CatalogService/GetEarnings"
+	CatalogService_GetAuction_FullMethodName                  = "/catalog.CatalogService/GetAuction"
+	CatalogService_GetToken_FullMethodName                    = "/catalog.CatalogService/GetToken"
+	CatalogService_ListTokens_FullMethodName                  = "/catalog.CatalogService/ListTokens"
+	CatalogService_Suggest_FullMethodName                     = "/catalog.CatalogService/Suggest"
+	CatalogService_ListWalletActivity_FullMethodName          = "/catalog.CatalogService/ListWalletActivity"
+	CatalogService_Favorite_FullMethodName                    = "/catalog.CatalogService/Favorite"
+	CatalogService_RemoveFavorite_FullMethodName              = "/catalog.CatalogService/RemoveFavorite"
+	CatalogService_SaveSearch_FullMethodName                  = "/catalog.CatalogService/SaveSearch"
+	CatalogService_DeleteSavedSearch_FullMethodName           = "/catalog.CatalogService/DeleteSavedSearch"
+	CatalogService_GetWatchlist_FullMethodName                = "/catalog.CatalogService/GetWatchlist"
+	CatalogService_GetSystemStatus_FullMethodName             = "/catalog.CatalogService/GetSystemStatus"
+	CatalogService_CreateHolderSnapshot_FullMethodName        = "/catalog.CatalogService/CreateHolderSnapshot"
+	CatalogService_GetHolderSnapshot_FullMethodName           = "/catalog.CatalogService/GetHolderSnapshot"
+	CatalogService_CreateCollectionExport_FullMethodName      = "/catalog.CatalogService/CreateCollectionExport"
+	CatalogService_GetCollectionExport_FullMethodName         = "/catalog.CatalogService/GetCollectionExport"
+	CatalogService_VerifyTokenGate_FullMethodName             = "/catalog.CatalogService/VerifyTokenGate"
+	CatalogService_ListDelegatedVaults_FullMethodName         = "/catalog.CatalogService/ListDelegatedVaults"
+	CatalogService_ResyncCollection_FullMethodName            = "/catalog.CatalogService/ResyncCollection"
+	CatalogService_ListUpcomingDrops_FullMethodName           = "/catalog.CatalogService/ListUpcomingDrops"
+	CatalogService_SubmitDrop_FullMethodName                  = "/catalog.CatalogService/SubmitDrop"
+	CatalogService_ListDropSubmissions_FullMethodName         = "/catalog.CatalogService/ListDropSubmissions"
+	CatalogService_ReviewDropSubmission_FullMethodName        = "/catalog.CatalogService/ReviewDropSubmission"
+	CatalogService_GetMintStats_FullMethodName                = "/catalog.CatalogService/GetMintStats"
+)
+
+// CatalogServiceClient is the client API for CatalogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CatalogServiceClient interface {
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error)
+	GetCollectionBySlug(ctx context.Context, in *GetCollectionBySlugRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	SetCollectionOrganization(ctx context.Context, in *SetCollectionOrganizationRequest, opts ...grpc.CallOption) (*SetCollectionOrganizationResponse, error)
+	// SetCollectionContent stores localized content; the caller authorizes the creator
+	SetCollectionContent(ctx context.Context, in *SetCollectionContentRequest, opts ...grpc.CallOption) (*SetCollectionContentResponse, error)
+	// SetCollectionClassification sets category and tags; the caller authorizes the creator
+	SetCollectionClassification(ctx context.Context, in *SetCollectionClassificationRequest, opts ...grpc.CallOption) (*SetCollectionClassificationResponse, error)
+	ListRelatedCollections(ctx context.Context, in *ListRelatedCollectionsRequest, opts ...grpc.CallOption) (*ListRelatedCollectionsResponse, error)
+	// Moderation
+	FlagItem(ctx context.Context, in *FlagItemRequest, opts ...grpc.CallOption) (*FlagItemResponse, error)
+	UnflagItem(ctx context.Context, in *UnflagItemRequest, opts ...grpc.CallOption) (*UnflagItemResponse, error)
+	// Reports
+	ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*ReportContentResponse, error)
+	ListReportQueue(ctx context.Context, in *ListReportQueueRequest, opts ...grpc.CallOption) (*ListReportQueueResponse, error)
+	ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error)
+	// Creator earnings
+	GetEarnings(ctx context.Context, in *GetEarningsRequest, opts ...grpc.CallOption) (*GetEarningsResponse, error)
+	// Auctions
+	GetAuction(ctx context.Context, in *GetAuctionRequest, opts ...grpc.CallOption) (*GetAuctionResponse, error)
+	// Tokens
+	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error)
+	ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error)
+	// Search
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	// Wallet activity
+	ListWalletActivity(ctx context.Context, in *ListWalletActivityRequest, opts ...grpc.CallOption) (*ListWalletActivityResponse, error)
+	// Watchlist
+	Favorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error)
+	RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteResponse, error)
+	SaveSearch(ctx context.Context, in *SaveSearchRequest, opts ...grpc.CallOption) (*SaveSearchResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	GetWatchlist(ctx context.Context, in *GetWatchlistRequest, opts ...grpc.CallOption) (*GetWatchlistResponse, error)
+	// Operations
+	GetSystemStatus(ctx context.Context, in *GetSystemStatusRequest, opts ...grpc.CallOption) (*GetSystemStatusResponse, error)
+	// Holder snapshots; CreateHolderSnapshot fails with FAILED_PRECONDITION past the indexed block
+	CreateHolderSnapshot(ctx context.Context, in *CreateHolderSnapshotRequest, opts ...grpc.CallOption) (*CreateHolderSnapshotResponse, error)
+	GetHolderSnapshot(ctx context.Context, in *GetHolderSnapshotRequest, opts ...grpc.CallOption) (*GetHolderSnapshotResponse, error)
+	// Collection exports; callers authorize the creator or admin. CreateCollectionExport fails
+	// with INVALID_ARGUMENT when the period holds more rows than an export may
+	CreateCollectionExport(ctx context.Context, in *CreateCollectionExportRequest, opts ...grpc.CallOption) (*CreateCollectionExportResponse, error)
+	GetCollectionExport(ctx context.Context, in *GetCollectionExportRequest, opts ...grpc.CallOption) (*GetCollectionExportResponse, error)
+	// Token gating; fails with UNAVAILABLE when gate tokens are not configured
+	VerifyTokenGate(ctx context.Context, in *VerifyTokenGateRequest, opts ...grpc.CallOption) (*VerifyTokenGateResponse, error)
+	ListDelegatedVaults(ctx context.Context, in *ListDelegatedVaultsRequest, opts ...grpc.CallOption) (*ListDelegatedVaultsResponse, error)
+	// Admin-triggered reconciliation of a collection against the chain; callers authorize the admin
+	ResyncCollection(ctx context.Context, in *ResyncCollectionRequest, opts ...grpc.CallOption) (*ResyncCollectionResponse, error)
+	// Drop calendar; callers authorize the reviewing admin
+	ListUpcomingDrops(ctx context.Context, in *ListUpcomingDropsRequest, opts ...grpc.CallOption) (*ListUpcomingDropsResponse, error)
+	SubmitDrop(ctx context.Context, in *SubmitDropRequest, opts ...grpc.CallOption) (*SubmitDropResponse, error)
+	ListDropSubmissions(ctx context.Context, in *ListDropSubmissionsRequest, opts ...grpc.CallOption) (*ListDropSubmissionsResponse, error)
+	ReviewDropSubmission(ctx context.Context, in *ReviewDropSubmissionRequest, opts ...grpc.CallOption) (*ReviewDropSubmissionResponse, error)
+	// Per-minute mint analytics for live drop dashboards
+	GetMintStats(ctx context.Context, in *GetMintStatsRequest, opts ...grpc.CallOption) (*GetMintStatsResponse, error)
+}
+
+type catalogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogServiceClient(cc grpc.ClientConnInterface) CatalogServiceClient {
+	return &catalogServiceClient{cc}
+}
+
+func (c *catalogServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetCollectionBySlug(ctx context.Context, in *GetCollectionBySlugRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetCollectionBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SetCollectionOrganization(ctx context.Context, in *SetCollectionOrganizationRequest, opts ...grpc.CallOption) (*SetCollectionOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCollectionOrganizationResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SetCollectionOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SetCollectionContent(ctx context.Context, in *SetCollectionContentRequest, opts ...grpc.CallOption) (*SetCollectionContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCollectionContentResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SetCollectionContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SetCollectionClassification(ctx context.Context, in *SetCollectionClassificationRequest, opts ...grpc.CallOption) (*SetCollectionClassificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCollectionClassificationResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SetCollectionClassification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListRelatedCollections(ctx context.Context, in *ListRelatedCollectionsRequest, opts ...grpc.CallOption) (*ListRelatedCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRelatedCollectionsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListRelatedCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) FlagItem(ctx context.Context, in *FlagItemRequest, opts ...grpc.CallOption) (*FlagItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlagItemResponse)
+	err := c.cc.Invoke(ctx, CatalogService_FlagItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UnflagItem(ctx context.Context, in *UnflagItemRequest, opts ...grpc.CallOption) (*UnflagItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnflagItemResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UnflagItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*ReportContentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportContentResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ReportContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListReportQueue(ctx context.Context, in *ListReportQueueRequest, opts ...grpc.CallOption) (*ListReportQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportQueueResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListReportQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ResolveReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetEarnings(ctx context.Context, in *GetEarningsRequest, opts ...grpc.CallOption) (*GetEarningsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEarningsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetEarnings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetAuction(ctx context.Context, in *GetAuctionRequest, opts ...grpc.CallOption) (*GetAuctionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuctionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetAuction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTokenResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTokensResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, CatalogService_Suggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListWalletActivity(ctx context.Context, in *ListWalletActivityRequest, opts ...grpc.CallOption) (*ListWalletActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWalletActivityResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListWalletActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) Favorite(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteResponse)
+	err := c.cc.Invoke(ctx, CatalogService_Favorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFavoriteResponse)
+	err := c.cc.Invoke(ctx, CatalogService_RemoveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SaveSearch(ctx context.Context, in *SaveSearchRequest, opts ...grpc.CallOption) (*SaveSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSearchResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SaveSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetWatchlist(ctx context.Context, in *GetWatchlistRequest, opts ...grpc.CallOption) (*GetWatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWatchlistResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetSystemStatus(ctx context.Context, in *GetSystemStatusRequest, opts ...grpc.CallOption) (*GetSystemStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSystemStatusResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetSystemStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateHolderSnapshot(ctx context.Context, in *CreateHolderSnapshotRequest, opts ...grpc.CallOption) (*CreateHolderSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHolderSnapshotResponse)
+	err := c.cc.Invoke(ctx, CatalogService_CreateHolderSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetHolderSnapshot(ctx context.Context, in *GetHolderSnapshotRequest, opts ...grpc.CallOption) (*GetHolderSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHolderSnapshotResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetHolderSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateCollectionExport(ctx context.Context, in *CreateCollectionExportRequest, opts ...grpc.CallOption) (*CreateCollectionExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionExportResponse)
+	err := c.cc.Invoke(ctx, CatalogService_CreateCollectionExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetCollectionExport(ctx context.Context, in *GetCollectionExportRequest, opts ...grpc.CallOption) (*GetCollectionExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionExportResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetCollectionExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) VerifyTokenGate(ctx context.Context, in *VerifyTokenGateRequest, opts ...grpc.CallOption) (*VerifyTokenGateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTokenGateResponse)
+	err := c.cc.Invoke(ctx, CatalogService_VerifyTokenGate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListDelegatedVaults(ctx context.Context, in *ListDelegatedVaultsRequest, opts ...grpc.CallOption) (*ListDelegatedVaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDelegatedVaultsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListDelegatedVaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ResyncCollection(ctx context.Context, in *ResyncCollectionRequest, opts ...grpc.CallOption) (*ResyncCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResyncCollectionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ResyncCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListUpcomingDrops(ctx context.Context, in *ListUpcomingDropsRequest, opts ...grpc.CallOption) (*ListUpcomingDropsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUpcomingDropsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListUpcomingDrops_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) SubmitDrop(ctx context.Context, in *SubmitDropRequest, opts ...grpc.CallOption) (*SubmitDropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitDropResponse)
+	err := c.cc.Invoke(ctx, CatalogService_SubmitDrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListDropSubmissions(ctx context.Context, in *ListDropSubmissionsRequest, opts ...grpc.CallOption) (*ListDropSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDropSubmissionsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListDropSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ReviewDropSubmission(ctx context.Context, in *ReviewDropSubmissionRequest, opts ...grpc.CallOption) (*ReviewDropSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewDropSubmissionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ReviewDropSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetMintStats(ctx context.Context, in *GetMintStatsRequest, opts ...grpc.CallOption) (*GetMintStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMintStatsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetMintStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogServiceServer is the server API for CatalogService service.
+// All implementations must embed UnimplementedCatalogServiceServer
+// for forward compatibility.
+type CatalogServiceServer interface {
+	GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error)
+	GetCollectionBySlug(context.Context, *GetCollectionBySlugRequest) (*GetCollectionResponse, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	SetCollectionOrganization(context.Context, *SetCollectionOrganizationRequest) (*SetCollectionOrganizationResponse, error)
+	// SetCollectionContent stores localized content; the caller authorizes the creator
+	SetCollectionContent(context.Context, *SetCollectionContentRequest) (*SetCollectionContentResponse, error)
+	// SetCollectionClassification sets category and tags; the caller authorizes the creator
+	SetCollectionClassification(context.Context, *SetCollectionClassificationRequest) (*SetCollectionClassificationResponse, error)
+	ListRelatedCollections(context.Context, *ListRelatedCollectionsRequest) (*ListRelatedCollectionsResponse, error)
+	// Moderation
+	FlagItem(context.Context, *FlagItemRequest) (*FlagItemResponse, error)
+	UnflagItem(context.Context, *UnflagItemRequest) (*UnflagItemResponse, error)
+	// Reports
+	ReportContent(context.Context, *ReportContentRequest) (*ReportContentResponse, error)
+	ListReportQueue(context.Context, *ListReportQueueRequest) (*ListReportQueueResponse, error)
+	ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error)
+	// Creator earnings
+	GetEarnings(context.Context, *GetEarningsRequest) (*GetEarningsResponse, error)
+	// Auctions
+	GetAuction(context.Context, *GetAuctionRequest) (*GetAuctionResponse, error)
+	// Tokens
+	GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error)
+	ListTokens(context.Context, *ListTokensRequest) (*ListTokensResponse, error)
+	// Search
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+	// Wallet activity
+	ListWalletActivity(context.Context, *ListWalletActivityRequest) (*ListWalletActivityResponse, error)
+	// Watchlist
+	Favorite(context.Context, *FavoriteRequest) (*FavoriteResponse, error)
+	RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteResponse, error)
+	SaveSearch(context.Context, *SaveSearchRequest) (*SaveSearchResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	GetWatchlist(context.Context, *GetWatchlistRequest) (*GetWatchlistResponse, error)
+	// Operations
+	GetSystemStatus(context.Context, *GetSystemStatusRequest) (*GetSystemStatusResponse, error)
+	// Holder snapshots; CreateHolderSnapshot fails with FAILED_PRECONDITION past the indexed block
+	CreateHolderSnapshot(context.Context, *CreateHolderSnapshotRequest) (*CreateHolderSnapshotResponse, error)
+	GetHolderSnapshot(context.Context, *GetHolderSnapshotRequest) (*GetHolderSnapshotResponse, error)
+	// Collection exports; callers authorize the creator or admin. CreateCollectionExport fails
+	// with INVALID_ARGUMENT when the period holds more rows than an export may
+	CreateCollectionExport(context.Context, *CreateCollectionExportRequest) (*CreateCollectionExportResponse, error)
+	GetCollectionExport(context.Context, *GetCollectionExportRequest) (*GetCollectionExportResponse, error)
+	// Token gating; fails with UNAVAILABLE when gate tokens are not configured
+	VerifyTokenGate(context.Context, *VerifyTokenGateRequest) (*VerifyTokenGateResponse, error)
+	ListDelegatedVaults(context.Context, *ListDelegatedVaultsRequest) (*ListDelegatedVaultsResponse, error)
+	// Admin-triggered reconciliation of a collection against the chain; callers authorize the admin
+	ResyncCollection(context.Context, *ResyncCollectionRequest) (*ResyncCollectionResponse, error)
+	// Drop calendar; callers authorize the reviewing admin
+	ListUpcomingDrops(context.Context, *ListUpcomingDropsRequest) (*ListUpcomingDropsResponse, error)
+	SubmitDrop(context.Context, *SubmitDropRequest) (*SubmitDropResponse, error)
+	ListDropSubmissions(context.Context, *ListDropSubmissionsRequest) (*ListDropSubmissionsResponse, error)
+	ReviewDropSubmission(context.Context, *ReviewDropSubmissionRequest) (*ReviewDropSubmissionResponse, error)
+	// Per-minute mint analytics for live drop dashboards
+	GetMintStats(context.Context, *GetMintStatsRequest) (*GetMintStatsResponse, error)
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+// UnimplementedCatalogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogServiceServer struct{}
+
+func (UnimplementedCatalogServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetCollectionBySlug(context.Context, *GetCollectionBySlugRequest) (*GetCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionBySlug not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedCatalogServiceServer) SetCollectionOrganization(context.Context, *SetCollectionOrganizationRequest) (*SetCollectionOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionOrganization not implemented")
+}
+func (UnimplementedCatalogServiceServer) SetCollectionContent(context.Context, *SetCollectionContentRequest) (*SetCollectionContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionContent not implemented")
+}
+func (UnimplementedCatalogServiceServer) SetCollectionClassification(context.Context, *SetCollectionClassificationRequest) (*SetCollectionClassificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionClassification not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListRelatedCollections(context.Context, *ListRelatedCollectionsRequest) (*ListRelatedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelatedCollections not implemented")
+}
+func (UnimplementedCatalogServiceServer) FlagItem(context.Context, *FlagItemRequest) (*FlagItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagItem not implemented")
+}
+func (UnimplementedCatalogServiceServer) UnflagItem(context.Context, *UnflagItemRequest) (*UnflagItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnflagItem not implemented")
+}
+func (UnimplementedCatalogServiceServer) ReportContent(context.Context, *ReportContentRequest) (*ReportContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportContent not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListReportQueue(context.Context, *ListReportQueueRequest) (*ListReportQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportQueue not implemented")
+}
+func (UnimplementedCatalogServiceServer) ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReports not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetEarnings(context.Context, *GetEarningsRequest) (*GetEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEarnings not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetAuction(context.Context, *GetAuctionRequest) (*GetAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuction not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListTokens(context.Context, *ListTokensRequest) (*ListTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokens not implemented")
+}
+func (UnimplementedCatalogServiceServer) Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListWalletActivity(context.Context, *ListWalletActivityRequest) (*ListWalletActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalletActivity not implemented")
+}
+func (UnimplementedCatalogServiceServer) Favorite(context.Context, *FavoriteRequest) (*FavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Favorite not implemented")
+}
+func (UnimplementedCatalogServiceServer) RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedCatalogServiceServer) SaveSearch(context.Context, *SaveSearchRequest) (*SaveSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSearch not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetWatchlist(context.Context, *GetWatchlistRequest) (*GetWatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchlist not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetSystemStatus(context.Context, *GetSystemStatusRequest) (*GetSystemStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemStatus not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateHolderSnapshot(context.Context, *CreateHolderSnapshotRequest) (*CreateHolderSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHolderSnapshot not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetHolderSnapshot(context.Context, *GetHolderSnapshotRequest) (*GetHolderSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHolderSnapshot not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateCollectionExport(context.Context, *CreateCollectionExportRequest) (*CreateCollectionExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectionExport not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetCollectionExport(context.Context, *GetCollectionExportRequest) (*GetCollectionExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionExport not implemented")
+}
+func (UnimplementedCatalogServiceServer) VerifyTokenGate(context.Context, *VerifyTokenGateRequest) (*VerifyTokenGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTokenGate not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListDelegatedVaults(context.Context, *ListDelegatedVaultsRequest) (*ListDelegatedVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelegatedVaults not implemented")
+}
+func (UnimplementedCatalogServiceServer) ResyncCollection(context.Context, *ResyncCollectionRequest) (*ResyncCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResyncCollection not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListUpcomingDrops(context.Context, *ListUpcomingDropsRequest) (*ListUpcomingDropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpcomingDrops not implemented")
+}
+func (UnimplementedCatalogServiceServer) SubmitDrop(context.Context, *SubmitDropRequest) (*SubmitDropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDrop not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListDropSubmissions(context.Context, *ListDropSubmissionsRequest) (*ListDropSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDropSubmissions not implemented")
+}
+func (UnimplementedCatalogServiceServer) ReviewDropSubmission(context.Context, *ReviewDropSubmissionRequest) (*ReviewDropSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewDropSubmission not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetMintStats(context.Context, *GetMintStatsRequest) (*GetMintStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMintStats not implemented")
+}
+func (UnimplementedCatalogServiceServer) mustEmbedUnimplementedCatalogServiceServer() {}
+func (UnimplementedCatalogServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogServiceServer will
+// result in compilation errors.
+type UnsafeCatalogServiceServer interface {
+	mustEmbedUnimplementedCatalogServiceServer()
+}
+
+func RegisterCatalogServiceServer(s grpc.ServiceRegistrar, srv CatalogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCatalogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CatalogService_ServiceDesc, srv)
+}
+
+func _CatalogService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetCollectionBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetCollectionBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetCollectionBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetCollectionBySlug(ctx, req.(*GetCollectionBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SetCollectionOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SetCollectionOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SetCollectionOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SetCollectionOrganization(ctx, req.(*SetCollectionOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SetCollectionContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SetCollectionContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SetCollectionContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SetCollectionContent(ctx, req.(*SetCollectionContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SetCollectionClassification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionClassificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SetCollectionClassification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SetCollectionClassification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SetCollectionClassification(ctx, req.(*SetCollectionClassificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListRelatedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelatedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListRelatedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListRelatedCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListRelatedCollections(ctx, req.(*ListRelatedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_FlagItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).FlagItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_FlagItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).FlagItem(ctx, req.(*FlagItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UnflagItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnflagItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UnflagItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UnflagItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UnflagItem(ctx, req.(*UnflagItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ReportContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ReportContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ReportContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ReportContent(ctx, req.(*ReportContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListReportQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListReportQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListReportQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListReportQueue(ctx, req.(*ListReportQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ResolveReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ResolveReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ResolveReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ResolveReports(ctx, req.(*ResolveReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetEarnings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetEarnings(ctx, req.(*GetEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetAuction(ctx, req.(*GetAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListTokens(ctx, req.(*ListTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListWalletActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListWalletActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListWalletActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListWalletActivity(ctx, req.(*ListWalletActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_Favorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).Favorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_Favorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).Favorite(ctx, req.(*FavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RemoveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RemoveFavorite(ctx, req.(*RemoveFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SaveSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SaveSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SaveSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SaveSearch(ctx, req.(*SaveSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetWatchlist(ctx, req.(*GetWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetSystemStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetSystemStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetSystemStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetSystemStatus(ctx, req.(*GetSystemStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateHolderSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHolderSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateHolderSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateHolderSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateHolderSnapshot(ctx, req.(*CreateHolderSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetHolderSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHolderSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetHolderSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetHolderSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetHolderSnapshot(ctx, req.(*GetHolderSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateCollectionExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateCollectionExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateCollectionExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateCollectionExport(ctx, req.(*CreateCollectionExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetCollectionExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetCollectionExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetCollectionExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetCollectionExport(ctx, req.(*GetCollectionExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_VerifyTokenGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTokenGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).VerifyTokenGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_VerifyTokenGate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).VerifyTokenGate(ctx, req.(*VerifyTokenGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListDelegatedVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelegatedVaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListDelegatedVaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListDelegatedVaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListDelegatedVaults(ctx, req.(*ListDelegatedVaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ResyncCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ResyncCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ResyncCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ResyncCollection(ctx, req.(*ResyncCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListUpcomingDrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpcomingDropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListUpcomingDrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListUpcomingDrops_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListUpcomingDrops(ctx, req.(*ListUpcomingDropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_SubmitDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).SubmitDrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_SubmitDrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).SubmitDrop(ctx, req.(*SubmitDropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListDropSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDropSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListDropSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListDropSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListDropSubmissions(ctx, req.(*ListDropSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ReviewDropSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewDropSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ReviewDropSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ReviewDropSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ReviewDropSubmission(ctx, req.(*ReviewDropSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetMintStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMintStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetMintStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetMintStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetMintStats(ctx, req.(*GetMintStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CatalogService_ServiceDesc is the grpc.ServiceDesc for CatalogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CatalogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "catalog.CatalogService",
+	HandlerType: (*CatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCollection",
+			Handler:    _CatalogService_GetCollection_Handler,
+		},
+		{
+			MethodName: "GetCollectionBySlug",
+			Handler:    _CatalogService_GetCollectionBySlug_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _CatalogService_ListCollections_Handler,
+		},
+		{
+			MethodName: "SetCollectionOrganization",
+			Handler:    _CatalogService_SetCollectionOrganization_Handler,
+		},
+		{
+			MethodName: "SetCollectionContent",
+			Handler:    _CatalogService_SetCollectionContent_Handler,
+		},
+		{
+			MethodName: "SetCollectionClassification",
+			Handler:    _CatalogService_SetCollectionClassification_Handler,
+		},
+		{
+			MethodName: "ListRelatedCollections",
+			Handler:    _CatalogService_ListRelatedCollections_Handler,
+		},
+		{
+			MethodName: "FlagItem",
+			Handler:    _CatalogService_FlagItem_Handler,
+		},
+		{
+			MethodName: "UnflagItem",
+			Handler:    _CatalogService_UnflagItem_Handler,
+		},
+		{
+			MethodName: "ReportContent",
+			Handler:    _CatalogService_ReportContent_Handler,
+		},
+		{
+			MethodName: "ListReportQueue",
+			Handler:    _CatalogService_ListReportQueue_Handler,
+		},
+		{
+			MethodName: "ResolveReports",
+			Handler:    _CatalogService_ResolveReports_Handler,
+		},
+		{
+			MethodName: "GetEarnings",
+			Handler:    _CatalogService_GetEarnings_Handler,
+		},
+		{
+			MethodName: "GetAuction",
+			Handler:    _CatalogService_GetAuction_Handler,
+		},
+		{
+			MethodName: "GetToken",
+			Handler:    _CatalogService_GetToken_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _CatalogService_ListTokens_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _CatalogService_Suggest_Handler,
+		},
+		{
+			MethodName: "ListWalletActivity",
+			Handler:    _CatalogService_ListWalletActivity_Handler,
+		},
+		{
+			MethodName: "Favorite",
+			Handler:    _CatalogService_Favorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _CatalogService_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "SaveSearch",
+			Handler:    _CatalogService_SaveSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _CatalogService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetWatchlist",
+			Handler:    _CatalogService_GetWatchlist_Handler,
+		},
+		{
+			MethodName: "GetSystemStatus",
+			Handler:    _CatalogService_GetSystemStatus_Handler,
+		},
+		{
+			MethodName: "CreateHolderSnapshot",
+			Handler:    _CatalogService_CreateHolderSnapshot_Handler,
+		},
+		{
+			MethodName: "GetHolderSnapshot",
+			Handler:    _CatalogService_GetHolderSnapshot_Handler,
+		},
+		{
+			MethodName: "CreateCollectionExport",
+			Handler:    _CatalogService_CreateCollectionExport_Handler,
+		},
+		{
+			MethodName: "GetCollectionExport",
+			Handler:    _CatalogService_GetCollectionExport_Handler,
+		},
+		{
+			MethodName: "VerifyTokenGate",
+			Handler:    _CatalogService_VerifyTokenGate_Handler,
+		},
+		{
+			MethodName: "ListDelegatedVaults",
+			Handler:    _CatalogService_ListDelegatedVaults_Handler,
+		},
+		{
+			MethodName: "ResyncCollection",
+			Handler:    _CatalogService_ResyncCollection_Handler,
+		},
+		{
+			MethodName: "ListUpcomingDrops",
+			Handler:    _CatalogService_ListUpcomingDrops_Handler,
+		},
+		{
+			MethodName: "SubmitDrop",
+			Handler:    _CatalogService_SubmitDrop_Handler,
+		},
+		{
+			MethodName: "ListDropSubmissions",
+			Handler:    _CatalogService_ListDropSubmissions_Handler,
+		},
+		{
+			MethodName: "ReviewDropSubmission",
+			Handler:    _CatalogService_ReviewDropSubmission_Handler,
+		},
+		{
+			MethodName: "GetMintStats",
+			Handler:    _CatalogService_GetMintStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog.proto",
+}
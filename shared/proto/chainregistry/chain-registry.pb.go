@@ -140,6 +140,9 @@ type Contract struct {
 	Standard      ContractStandard       `protobuf:"varint,5,opt,name=standard,proto3,enum=chainregistry.ContractStandard" json:"standard,omitempty"` // <— thêm
 	ImplAddress   string                 `protobuf:"bytes,6,opt,name=impl_address,json=implAddress,proto3" json:"impl_address,omitempty"`             // <— thêm (proxy)
 	AbiSha256     string                 `protobuf:"bytes,7,opt,name=abi_sha256,json=abiSha256,proto3" json:"abi_sha256,omitempty"`                   // <— thêm
+	Imported      bool                   `protobuf:"varint,8,opt,name=imported,proto3" json:"imported,omitempty"`                                     // externally deployed collection followed by the indexer
+	MintFunction  *MintFunction          `protobuf:"bytes,9,opt,name=mint_function,json=mintFunction,proto3" json:"mint_function,omitempty"`          // unset: the standard mint signature for the collection
+	Roles         []string               `protobuf:"bytes,10,rep,name=roles,proto3" json:"roles,omitempty"`                                           // e.g. factory, marketplace, auction-house; see shared/contracts
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -223,6 +226,105 @@ func (x *Contract) GetAbiSha256() string {
 	return ""
 }
 
+func (x *Contract) GetImported() bool {
+	if x != nil {
+		return x.Imported
+	}
+	return false
+}
+
+func (x *Contract) GetMintFunction() *MintFunction {
+	if x != nil {
+		return x.MintFunction
+	}
+	return nil
+}
+
+func (x *Contract) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// How a collection is minted when it departs from the standard signatures. Args lists the
+// calldata layout in order: recipient, quantity, token_id, proof or data.
+type MintFunction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args          []string               `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Payable       bool                   `protobuf:"varint,3,opt,name=payable,proto3" json:"payable,omitempty"`
+	UnitPriceWei  string                 `protobuf:"bytes,4,opt,name=unit_price_wei,json=unitPriceWei,proto3" json:"unit_price_wei,omitempty"` // attached per token on payable mints
+	MaxPerTx      uint64                 `protobuf:"varint,5,opt,name=max_per_tx,json=maxPerTx,proto3" json:"max_per_tx,omitempty"`            // 0: no limit enforced before encoding
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintFunction) Reset() {
+	*x = MintFunction{}
+	mi := &file_chain_registry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintFunction) ProtoMessage() {}
+
+func (x *MintFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintFunction.ProtoReflect.Descriptor instead.
+func (*MintFunction) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MintFunction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MintFunction) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *MintFunction) GetPayable() bool {
+	if x != nil {
+		return x.Payable
+	}
+	return false
+}
+
+func (x *MintFunction) GetUnitPriceWei() string {
+	if x != nil {
+		return x.UnitPriceWei
+	}
+	return ""
+}
+
+func (x *MintFunction) GetMaxPerTx() uint64 {
+	if x != nil {
+		return x.MaxPerTx
+	}
+	return 0
+}
+
 type GasPolicy struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	MaxFeeGwei              float64                `protobuf:"fixed64,1,opt,name=max_fee_gwei,json=maxFeeGwei,proto3" json:"max_fee_gwei,omitempty"`
@@ -236,7 +338,7 @@ type GasPolicy struct {
 
 func (x *GasPolicy) Reset() {
 	*x = GasPolicy{}
-	mi := &file_chain_registry_proto_msgTypes[1]
+	mi := &file_chain_registry_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +350,7 @@ func (x *GasPolicy) String() string {
 func (*GasPolicy) ProtoMessage() {}
 
 func (x *GasPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[1]
+	mi := &file_chain_registry_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +363,7 @@ func (x *GasPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GasPolicy.ProtoReflect.Descriptor instead.
 func (*GasPolicy) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{1}
+	return file_chain_registry_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GasPolicy) GetMaxFeeGwei() float64 {
@@ -313,7 +415,7 @@ type RpcEndpoint struct {
 
 func (x *RpcEndpoint) Reset() {
 	*x = RpcEndpoint{}
-	mi := &file_chain_registry_proto_msgTypes[2]
+	mi := &file_chain_registry_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +427,7 @@ func (x *RpcEndpoint) String() string {
 func (*RpcEndpoint) ProtoMessage() {}
 
 func (x *RpcEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[2]
+	mi := &file_chain_registry_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +440,7 @@ func (x *RpcEndpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RpcEndpoint.ProtoReflect.Descriptor instead.
 func (*RpcEndpoint) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{2}
+	return file_chain_registry_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RpcEndpoint) GetUrl() string {
@@ -388,13 +490,17 @@ type ChainParams struct {
 	RequiredConfirmations uint32                 `protobuf:"varint,1,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
 	ReorgDepth            uint32                 `protobuf:"varint,2,opt,name=reorg_depth,json=reorgDepth,proto3" json:"reorg_depth,omitempty"`      // <— thêm
 	BlockTimeMs           uint32                 `protobuf:"varint,3,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"` // <— thêm
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Collection creation limits; the orchestrator validates PrepareCreateCollection against them
+	MaxRoyaltyBps       uint64 `protobuf:"varint,4,opt,name=max_royalty_bps,json=maxRoyaltyBps,proto3" json:"max_royalty_bps,omitempty"`
+	MinStageDurationSec uint64 `protobuf:"varint,5,opt,name=min_stage_duration_sec,json=minStageDurationSec,proto3" json:"min_stage_duration_sec,omitempty"`
+	MaxSupplyCap        uint64 `protobuf:"varint,6,opt,name=max_supply_cap,json=maxSupplyCap,proto3" json:"max_supply_cap,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ChainParams) Reset() {
 	*x = ChainParams{}
-	mi := &file_chain_registry_proto_msgTypes[3]
+	mi := &file_chain_registry_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +512,7 @@ func (x *ChainParams) String() string {
 func (*ChainParams) ProtoMessage() {}
 
 func (x *ChainParams) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[3]
+	mi := &file_chain_registry_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +525,7 @@ func (x *ChainParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainParams.ProtoReflect.Descriptor instead.
 func (*ChainParams) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{3}
+	return file_chain_registry_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ChainParams) GetRequiredConfirmations() uint32 {
@@ -443,6 +549,112 @@ func (x *ChainParams) GetBlockTimeMs() uint32 {
 	return 0
 }
 
+func (x *ChainParams) GetMaxRoyaltyBps() uint64 {
+	if x != nil {
+		return x.MaxRoyaltyBps
+	}
+	return 0
+}
+
+func (x *ChainParams) GetMinStageDurationSec() uint64 {
+	if x != nil {
+		return x.MinStageDurationSec
+	}
+	return 0
+}
+
+func (x *ChainParams) GetMaxSupplyCap() uint64 {
+	if x != nil {
+		return x.MaxSupplyCap
+	}
+	return 0
+}
+
+// Marketplace features deployed on a chain; clients hide options a Prepare call would reject
+type ChainCapabilities struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Erc721Factory  bool                   `protobuf:"varint,1,opt,name=erc721_factory,json=erc721Factory,proto3" json:"erc721_factory,omitempty"`
+	Erc1155Factory bool                   `protobuf:"varint,2,opt,name=erc1155_factory,json=erc1155Factory,proto3" json:"erc1155_factory,omitempty"`
+	AllowlistMint  bool                   `protobuf:"varint,3,opt,name=allowlist_mint,json=allowlistMint,proto3" json:"allowlist_mint,omitempty"`
+	LazyMint       bool                   `protobuf:"varint,4,opt,name=lazy_mint,json=lazyMint,proto3" json:"lazy_mint,omitempty"`
+	Version        uint64                 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`                     // bumped whenever the chain's capabilities change
+	UpdatedAt      string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339, empty for chains on the defaults
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChainCapabilities) Reset() {
+	*x = ChainCapabilities{}
+	mi := &file_chain_registry_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainCapabilities) ProtoMessage() {}
+
+func (x *ChainCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainCapabilities.ProtoReflect.Descriptor instead.
+func (*ChainCapabilities) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChainCapabilities) GetErc721Factory() bool {
+	if x != nil {
+		return x.Erc721Factory
+	}
+	return false
+}
+
+func (x *ChainCapabilities) GetErc1155Factory() bool {
+	if x != nil {
+		return x.Erc1155Factory
+	}
+	return false
+}
+
+func (x *ChainCapabilities) GetAllowlistMint() bool {
+	if x != nil {
+		return x.AllowlistMint
+	}
+	return false
+}
+
+func (x *ChainCapabilities) GetLazyMint() bool {
+	if x != nil {
+		return x.LazyMint
+	}
+	return false
+}
+
+func (x *ChainCapabilities) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ChainCapabilities) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
 // ===== Requests / Responses =====
 type GetContractsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -453,7 +665,7 @@ type GetContractsRequest struct {
 
 func (x *GetContractsRequest) Reset() {
 	*x = GetContractsRequest{}
-	mi := &file_chain_registry_proto_msgTypes[4]
+	mi := &file_chain_registry_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +677,7 @@ func (x *GetContractsRequest) String() string {
 func (*GetContractsRequest) ProtoMessage() {}
 
 func (x *GetContractsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[4]
+	mi := &file_chain_registry_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +690,7 @@ func (x *GetContractsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContractsRequest.ProtoReflect.Descriptor instead.
 func (*GetContractsRequest) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{4}
+	return file_chain_registry_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetContractsRequest) GetChainId() string {
@@ -502,7 +714,7 @@ type GetContractsResponse struct {
 
 func (x *GetContractsResponse) Reset() {
 	*x = GetContractsResponse{}
-	mi := &file_chain_registry_proto_msgTypes[5]
+	mi := &file_chain_registry_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -514,7 +726,7 @@ func (x *GetContractsResponse) String() string {
 func (*GetContractsResponse) ProtoMessage() {}
 
 func (x *GetContractsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[5]
+	mi := &file_chain_registry_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +739,7 @@ func (x *GetContractsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetContractsResponse.ProtoReflect.Descriptor instead.
 func (*GetContractsResponse) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{5}
+	return file_chain_registry_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetContractsResponse) GetChainId() string {
@@ -581,7 +793,7 @@ type GetGasPolicyRequest struct {
 
 func (x *GetGasPolicyRequest) Reset() {
 	*x = GetGasPolicyRequest{}
-	mi := &file_chain_registry_proto_msgTypes[6]
+	mi := &file_chain_registry_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +805,7 @@ func (x *GetGasPolicyRequest) String() string {
 func (*GetGasPolicyRequest) ProtoMessage() {}
 
 func (x *GetGasPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[6]
+	mi := &file_chain_registry_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +818,7 @@ func (x *GetGasPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGasPolicyRequest.ProtoReflect.Descriptor instead.
 func (*GetGasPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{6}
+	return file_chain_registry_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetGasPolicyRequest) GetChainId() string {
@@ -627,7 +839,7 @@ type GetGasPolicyResponse struct {
 
 func (x *GetGasPolicyResponse) Reset() {
 	*x = GetGasPolicyResponse{}
-	mi := &file_chain_registry_proto_msgTypes[7]
+	mi := &file_chain_registry_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +851,7 @@ func (x *GetGasPolicyResponse) String() string {
 func (*GetGasPolicyResponse) ProtoMessage() {}
 
 func (x *GetGasPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[7]
+	mi := &file_chain_registry_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +864,7 @@ func (x *GetGasPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGasPolicyResponse.ProtoReflect.Descriptor instead.
 func (*GetGasPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{7}
+	return file_chain_registry_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetGasPolicyResponse) GetChainId() string {
@@ -685,7 +897,7 @@ type GetRpcEndpointsRequest struct {
 
 func (x *GetRpcEndpointsRequest) Reset() {
 	*x = GetRpcEndpointsRequest{}
-	mi := &file_chain_registry_proto_msgTypes[8]
+	mi := &file_chain_registry_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -697,7 +909,7 @@ func (x *GetRpcEndpointsRequest) String() string {
 func (*GetRpcEndpointsRequest) ProtoMessage() {}
 
 func (x *GetRpcEndpointsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[8]
+	mi := &file_chain_registry_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +922,7 @@ func (x *GetRpcEndpointsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRpcEndpointsRequest.ProtoReflect.Descriptor instead.
 func (*GetRpcEndpointsRequest) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{8}
+	return file_chain_registry_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetRpcEndpointsRequest) GetChainId() string {
@@ -731,7 +943,7 @@ type GetRpcEndpointsResponse struct {
 
 func (x *GetRpcEndpointsResponse) Reset() {
 	*x = GetRpcEndpointsResponse{}
-	mi := &file_chain_registry_proto_msgTypes[9]
+	mi := &file_chain_registry_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -743,7 +955,7 @@ func (x *GetRpcEndpointsResponse) String() string {
 func (*GetRpcEndpointsResponse) ProtoMessage() {}
 
 func (x *GetRpcEndpointsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[9]
+	mi := &file_chain_registry_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +968,7 @@ func (x *GetRpcEndpointsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRpcEndpointsResponse.ProtoReflect.Descriptor instead.
 func (*GetRpcEndpointsResponse) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{9}
+	return file_chain_registry_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetRpcEndpointsResponse) GetChainId() string {
@@ -780,29 +992,28 @@ func (x *GetRpcEndpointsResponse) GetRegistryVersion() string {
 	return ""
 }
 
-type GetContractMetaRequest struct {
+type GetChainCapabilitiesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContractMetaRequest) Reset() {
-	*x = GetContractMetaRequest{}
-	mi := &file_chain_registry_proto_msgTypes[10]
+func (x *GetChainCapabilitiesRequest) Reset() {
+	*x = GetChainCapabilitiesRequest{}
+	mi := &file_chain_registry_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContractMetaRequest) String() string {
+func (x *GetChainCapabilitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContractMetaRequest) ProtoMessage() {}
+func (*GetChainCapabilitiesRequest) ProtoMessage() {}
 
-func (x *GetContractMetaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[10]
+func (x *GetChainCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,49 +1024,42 @@ func (x *GetContractMetaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContractMetaRequest.ProtoReflect.Descriptor instead.
-func (*GetContractMetaRequest) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetChainCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetChainCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetContractMetaRequest) GetChainId() string {
+func (x *GetChainCapabilitiesRequest) GetChainId() string {
 	if x != nil {
 		return x.ChainId
 	}
 	return ""
 }
 
-func (x *GetContractMetaRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-type GetContractMetaResponse struct {
+type GetChainCapabilitiesResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Contract        *Contract              `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Capabilities    *ChainCapabilities     `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 	RegistryVersion string                 `protobuf:"bytes,3,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetContractMetaResponse) Reset() {
-	*x = GetContractMetaResponse{}
-	mi := &file_chain_registry_proto_msgTypes[11]
+func (x *GetChainCapabilitiesResponse) Reset() {
+	*x = GetChainCapabilitiesResponse{}
+	mi := &file_chain_registry_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContractMetaResponse) String() string {
+func (x *GetChainCapabilitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContractMetaResponse) ProtoMessage() {}
+func (*GetChainCapabilitiesResponse) ProtoMessage() {}
 
-func (x *GetContractMetaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[11]
+func (x *GetChainCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,54 +1070,166 @@ func (x *GetContractMetaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContractMetaResponse.ProtoReflect.Descriptor instead.
-func (*GetContractMetaResponse) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetChainCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetChainCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetContractMetaResponse) GetChainId() string {
+func (x *GetChainCapabilitiesResponse) GetChainId() string {
 	if x != nil {
 		return x.ChainId
 	}
 	return ""
 }
 
-func (x *GetContractMetaResponse) GetContract() *Contract {
+func (x *GetChainCapabilitiesResponse) GetCapabilities() *ChainCapabilities {
 	if x != nil {
-		return x.Contract
+		return x.Capabilities
 	}
 	return nil
 }
 
-func (x *GetContractMetaResponse) GetRegistryVersion() string {
+func (x *GetChainCapabilitiesResponse) GetRegistryVersion() string {
 	if x != nil {
 		return x.RegistryVersion
 	}
 	return ""
 }
 
-type GetAbiBlobRequest struct {
+type GetContractMetaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AbiSha256     string                 `protobuf:"bytes,1,opt,name=abi_sha256,json=abiSha256,proto3" json:"abi_sha256,omitempty"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAbiBlobRequest) Reset() {
-	*x = GetAbiBlobRequest{}
-	mi := &file_chain_registry_proto_msgTypes[12]
+func (x *GetContractMetaRequest) Reset() {
+	*x = GetContractMetaRequest{}
+	mi := &file_chain_registry_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAbiBlobRequest) String() string {
+func (x *GetContractMetaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAbiBlobRequest) ProtoMessage() {}
+func (*GetContractMetaRequest) ProtoMessage() {}
 
-func (x *GetAbiBlobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[12]
+func (x *GetContractMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContractMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetContractMetaRequest) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetContractMetaRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetContractMetaRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetContractMetaResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract        *Contract              `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	RegistryVersion string                 `protobuf:"bytes,3,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetContractMetaResponse) Reset() {
+	*x = GetContractMetaResponse{}
+	mi := &file_chain_registry_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContractMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContractMetaResponse) ProtoMessage() {}
+
+func (x *GetContractMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContractMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetContractMetaResponse) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetContractMetaResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetContractMetaResponse) GetContract() *Contract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *GetContractMetaResponse) GetRegistryVersion() string {
+	if x != nil {
+		return x.RegistryVersion
+	}
+	return ""
+}
+
+type GetAbiBlobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AbiSha256     string                 `protobuf:"bytes,1,opt,name=abi_sha256,json=abiSha256,proto3" json:"abi_sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAbiBlobRequest) Reset() {
+	*x = GetAbiBlobRequest{}
+	mi := &file_chain_registry_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbiBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbiBlobRequest) ProtoMessage() {}
+
+func (x *GetAbiBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1242,7 @@ func (x *GetAbiBlobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAbiBlobRequest.ProtoReflect.Descriptor instead.
 func (*GetAbiBlobRequest) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{12}
+	return file_chain_registry_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetAbiBlobRequest) GetAbiSha256() string {
@@ -946,7 +1262,7 @@ type GetAbiBlobResponse struct {
 
 func (x *GetAbiBlobResponse) Reset() {
 	*x = GetAbiBlobResponse{}
-	mi := &file_chain_registry_proto_msgTypes[13]
+	mi := &file_chain_registry_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +1274,7 @@ func (x *GetAbiBlobResponse) String() string {
 func (*GetAbiBlobResponse) ProtoMessage() {}
 
 func (x *GetAbiBlobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[13]
+	mi := &file_chain_registry_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1287,7 @@ func (x *GetAbiBlobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAbiBlobResponse.ProtoReflect.Descriptor instead.
 func (*GetAbiBlobResponse) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{13}
+	return file_chain_registry_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetAbiBlobResponse) GetAbiJson() string {
@@ -999,7 +1315,7 @@ type GetAbiByAddressRequest struct {
 
 func (x *GetAbiByAddressRequest) Reset() {
 	*x = GetAbiByAddressRequest{}
-	mi := &file_chain_registry_proto_msgTypes[14]
+	mi := &file_chain_registry_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1011,7 +1327,7 @@ func (x *GetAbiByAddressRequest) String() string {
 func (*GetAbiByAddressRequest) ProtoMessage() {}
 
 func (x *GetAbiByAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[14]
+	mi := &file_chain_registry_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +1340,7 @@ func (x *GetAbiByAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAbiByAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetAbiByAddressRequest) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{14}
+	return file_chain_registry_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetAbiByAddressRequest) GetChainId() string {
@@ -1051,7 +1367,7 @@ type ResolveProxyRequest struct {
 
 func (x *ResolveProxyRequest) Reset() {
 	*x = ResolveProxyRequest{}
-	mi := &file_chain_registry_proto_msgTypes[15]
+	mi := &file_chain_registry_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1063,7 +1379,7 @@ func (x *ResolveProxyRequest) String() string {
 func (*ResolveProxyRequest) ProtoMessage() {}
 
 func (x *ResolveProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[15]
+	mi := &file_chain_registry_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1392,7 @@ func (x *ResolveProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveProxyRequest.ProtoReflect.Descriptor instead.
 func (*ResolveProxyRequest) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{15}
+	return file_chain_registry_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ResolveProxyRequest) GetChainId() string {
@@ -1106,7 +1422,7 @@ type ResolveProxyResponse struct {
 
 func (x *ResolveProxyResponse) Reset() {
 	*x = ResolveProxyResponse{}
-	mi := &file_chain_registry_proto_msgTypes[16]
+	mi := &file_chain_registry_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1118,7 +1434,7 @@ func (x *ResolveProxyResponse) String() string {
 func (*ResolveProxyResponse) ProtoMessage() {}
 
 func (x *ResolveProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[16]
+	mi := &file_chain_registry_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1447,7 @@ func (x *ResolveProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveProxyResponse.ProtoReflect.Descriptor instead.
 func (*ResolveProxyResponse) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{16}
+	return file_chain_registry_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ResolveProxyResponse) GetChainId() string {
@@ -1179,7 +1495,7 @@ type BumpVersionRequest struct {
 
 func (x *BumpVersionRequest) Reset() {
 	*x = BumpVersionRequest{}
-	mi := &file_chain_registry_proto_msgTypes[17]
+	mi := &file_chain_registry_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +1507,7 @@ func (x *BumpVersionRequest) String() string {
 func (*BumpVersionRequest) ProtoMessage() {}
 
 func (x *BumpVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[17]
+	mi := &file_chain_registry_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1520,7 @@ func (x *BumpVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BumpVersionRequest.ProtoReflect.Descriptor instead.
 func (*BumpVersionRequest) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{17}
+	return file_chain_registry_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *BumpVersionRequest) GetChainId() string {
@@ -1231,7 +1547,7 @@ type BumpVersionResponse struct {
 
 func (x *BumpVersionResponse) Reset() {
 	*x = BumpVersionResponse{}
-	mi := &file_chain_registry_proto_msgTypes[18]
+	mi := &file_chain_registry_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1243,7 +1559,7 @@ func (x *BumpVersionResponse) String() string {
 func (*BumpVersionResponse) ProtoMessage() {}
 
 func (x *BumpVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_registry_proto_msgTypes[18]
+	mi := &file_chain_registry_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1572,7 @@ func (x *BumpVersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BumpVersionResponse.ProtoReflect.Descriptor instead.
 func (*BumpVersionResponse) Descriptor() ([]byte, []int) {
-	return file_chain_registry_proto_rawDescGZIP(), []int{18}
+	return file_chain_registry_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *BumpVersionResponse) GetOk() bool {
@@ -1273,99 +1589,1007 @@ func (x *BumpVersionResponse) GetNewVersion() string {
 	return ""
 }
 
-var File_chain_registry_proto protoreflect.FileDescriptor
+// Canonical signatures added/removed by an ABI replacement, e.g. "Transfer(address,address,uint256)"
+type AbiDiff struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AddedEvents      []string               `protobuf:"bytes,1,rep,name=added_events,json=addedEvents,proto3" json:"added_events,omitempty"`
+	RemovedEvents    []string               `protobuf:"bytes,2,rep,name=removed_events,json=removedEvents,proto3" json:"removed_events,omitempty"`
+	AddedFunctions   []string               `protobuf:"bytes,3,rep,name=added_functions,json=addedFunctions,proto3" json:"added_functions,omitempty"`
+	RemovedFunctions []string               `protobuf:"bytes,4,rep,name=removed_functions,json=removedFunctions,proto3" json:"removed_functions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
 
-const file_chain_registry_proto_rawDesc = "" +
-	"\n" +
-	"\x14chain-registry.proto\x12\rchainregistry\"\xf9\x01\n" +
-	"\bContract\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1f\n" +
-	"\vstart_block\x18\x03 \x01(\x05R\n" +
-	"startBlock\x12\x1f\n" +
-	"\vverified_at\x18\x04 \x01(\tR\n" +
-	"verifiedAt\x12;\n" +
-	"\bstandard\x18\x05 \x01(\x0e2\x1f.chainregistry.ContractStandardR\bstandard\x12!\n" +
-	"\fimpl_address\x18\x06 \x01(\tR\vimplAddress\x12\x1d\n" +
-	"\n" +
-	"abi_sha256\x18\a \x01(\tR\tabiSha256\"\xd6\x01\n" +
-	"\tGasPolicy\x12 \n" +
-	"\fmax_fee_gwei\x18\x01 \x01(\x01R\n" +
-	"maxFeeGwei\x12*\n" +
-	"\x11priority_fee_gwei\x18\x02 \x01(\x01R\x0fpriorityFeeGwei\x12\x1e\n" +
-	"\n" +
-	"multiplier\x18\x03 \x01(\x01R\n" +
-	"multiplier\x12<\n" +
-	"\x1blast_observed_base_fee_gwei\x18\x04 \x01(\x01R\x17lastObservedBaseFeeGwei\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"\xc3\x01\n" +
-	"\vRpcEndpoint\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
-	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x16\n" +
-	"\x06weight\x18\x03 \x01(\x05R\x06weight\x127\n" +
-	"\tauth_type\x18\x04 \x01(\x0e2\x1a.chainregistry.RpcAuthTypeR\bauthType\x12\x1d\n" +
-	"\n" +
-	"rate_limit\x18\x05 \x01(\x05R\trateLimit\x12\x16\n" +
-	"\x06active\x18\x06 \x01(\bR\x06active\"\x89\x01\n" +
-	"\vChainParams\x125\n" +
-	"\x16required_confirmations\x18\x01 \x01(\rR\x15requiredConfirmations\x12\x1f\n" +
-	"\vreorg_depth\x18\x02 \x01(\rR\n" +
-	"reorgDepth\x12\"\n" +
-	"\rblock_time_ms\x18\x03 \x01(\rR\vblockTimeMs\"0\n" +
-	"\x13GetContractsRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\"\x91\x02\n" +
-	"\x14GetContractsResponse\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x12#\n" +
-	"\rchain_numeric\x18\x02 \x01(\x04R\fchainNumeric\x125\n" +
-	"\tcontracts\x18\x03 \x03(\v2\x17.chainregistry.ContractR\tcontracts\x122\n" +
-	"\x06params\x18\x04 \x01(\v2\x1a.chainregistry.ChainParamsR\x06params\x12)\n" +
-	"\x10registry_version\x18\x05 \x01(\tR\x0fregistryVersion\x12#\n" +
-	"\rnative_symbol\x18\x06 \x01(\tR\fnativeSymbol\"0\n" +
-	"\x13GetGasPolicyRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\"\x8e\x01\n" +
-	"\x14GetGasPolicyResponse\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x120\n" +
-	"\x06policy\x18\x02 \x01(\v2\x18.chainregistry.GasPolicyR\x06policy\x12)\n" +
-	"\x10registry_version\x18\x03 \x01(\tR\x0fregistryVersion\"3\n" +
-	"\x16GetRpcEndpointsRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\"\x99\x01\n" +
-	"\x17GetRpcEndpointsResponse\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x128\n" +
-	"\tendpoints\x18\x02 \x03(\v2\x1a.chainregistry.RpcEndpointR\tendpoints\x12)\n" +
-	"\x10registry_version\x18\x03 \x01(\tR\x0fregistryVersion\"M\n" +
-	"\x16GetContractMetaRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x94\x01\n" +
-	"\x17GetContractMetaResponse\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x123\n" +
-	"\bcontract\x18\x02 \x01(\v2\x17.chainregistry.ContractR\bcontract\x12)\n" +
-	"\x10registry_version\x18\x03 \x01(\tR\x0fregistryVersion\"2\n" +
-	"\x11GetAbiBlobRequest\x12\x1d\n" +
-	"\n" +
-	"abi_sha256\x18\x01 \x01(\tR\tabiSha256\"C\n" +
-	"\x12GetAbiBlobResponse\x12\x19\n" +
-	"\babi_json\x18\x01 \x01(\tR\aabiJson\x12\x12\n" +
-	"\x04etag\x18\x02 \x01(\tR\x04etag\"M\n" +
-	"\x16GetAbiByAddressRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\"J\n" +
-	"\x13ResolveProxyRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\"\xc3\x01\n" +
-	"\x14ResolveProxyResponse\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x12#\n" +
-	"\rproxy_address\x18\x02 \x01(\tR\fproxyAddress\x12!\n" +
-	"\fimpl_address\x18\x03 \x01(\tR\vimplAddress\x12\x1d\n" +
-	"\n" +
-	"abi_sha256\x18\x04 \x01(\tR\tabiSha256\x12)\n" +
-	"\x10registry_version\x18\x05 \x01(\tR\x0fregistryVersion\"G\n" +
-	"\x12BumpVersionRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"F\n" +
-	"\x13BumpVersionResponse\x12\x0e\n" +
-	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x1f\n" +
-	"\vnew_version\x18\x02 \x01(\tR\n" +
-	"newVersion*[\n" +
+func (x *AbiDiff) Reset() {
+	*x = AbiDiff{}
+	mi := &file_chain_registry_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbiDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbiDiff) ProtoMessage() {}
+
+func (x *AbiDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbiDiff.ProtoReflect.Descriptor instead.
+func (*AbiDiff) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AbiDiff) GetAddedEvents() []string {
+	if x != nil {
+		return x.AddedEvents
+	}
+	return nil
+}
+
+func (x *AbiDiff) GetRemovedEvents() []string {
+	if x != nil {
+		return x.RemovedEvents
+	}
+	return nil
+}
+
+func (x *AbiDiff) GetAddedFunctions() []string {
+	if x != nil {
+		return x.AddedFunctions
+	}
+	return nil
+}
+
+func (x *AbiDiff) GetRemovedFunctions() []string {
+	if x != nil {
+		return x.RemovedFunctions
+	}
+	return nil
+}
+
+type UpdateContractAbiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AbiJson       string                 `protobuf:"bytes,3,opt,name=abi_json,json=abiJson,proto3" json:"abi_json,omitempty"` // raw ABI array or artifact with an "abi" field
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContractAbiRequest) Reset() {
+	*x = UpdateContractAbiRequest{}
+	mi := &file_chain_registry_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContractAbiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContractAbiRequest) ProtoMessage() {}
+
+func (x *UpdateContractAbiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContractAbiRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContractAbiRequest) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateContractAbiRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *UpdateContractAbiRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateContractAbiRequest) GetAbiJson() string {
+	if x != nil {
+		return x.AbiJson
+	}
+	return ""
+}
+
+func (x *UpdateContractAbiRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateContractAbiResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changed       bool                   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"` // false when the ABI hash is unchanged
+	OldAbiSha256  string                 `protobuf:"bytes,2,opt,name=old_abi_sha256,json=oldAbiSha256,proto3" json:"old_abi_sha256,omitempty"`
+	NewAbiSha256  string                 `protobuf:"bytes,3,opt,name=new_abi_sha256,json=newAbiSha256,proto3" json:"new_abi_sha256,omitempty"`
+	NewVersion    string                 `protobuf:"bytes,4,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	Diff          *AbiDiff               `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContractAbiResponse) Reset() {
+	*x = UpdateContractAbiResponse{}
+	mi := &file_chain_registry_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContractAbiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContractAbiResponse) ProtoMessage() {}
+
+func (x *UpdateContractAbiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContractAbiResponse.ProtoReflect.Descriptor instead.
+func (*UpdateContractAbiResponse) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateContractAbiResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *UpdateContractAbiResponse) GetOldAbiSha256() string {
+	if x != nil {
+		return x.OldAbiSha256
+	}
+	return ""
+}
+
+func (x *UpdateContractAbiResponse) GetNewAbiSha256() string {
+	if x != nil {
+		return x.NewAbiSha256
+	}
+	return ""
+}
+
+func (x *UpdateContractAbiResponse) GetNewVersion() string {
+	if x != nil {
+		return x.NewVersion
+	}
+	return ""
+}
+
+func (x *UpdateContractAbiResponse) GetDiff() *AbiDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// Adds an already-deployed ERC-721/1155 collection to the chain's indexing set, named
+// collection-<address>; the indexer backfills it from start_block after registry.changed
+type RegisterCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Standard      ContractStandard       `protobuf:"varint,3,opt,name=standard,proto3,enum=chainregistry.ContractStandard" json:"standard,omitempty"` // STD_ERC721 or STD_ERC1155
+	StartBlock    int32                  `protobuf:"varint,4,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`               // deployment block
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterCollectionRequest) Reset() {
+	*x = RegisterCollectionRequest{}
+	mi := &file_chain_registry_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCollectionRequest) ProtoMessage() {}
+
+func (x *RegisterCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RegisterCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RegisterCollectionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *RegisterCollectionRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RegisterCollectionRequest) GetStandard() ContractStandard {
+	if x != nil {
+		return x.Standard
+	}
+	return ContractStandard_STD_CUSTOM
+}
+
+func (x *RegisterCollectionRequest) GetStartBlock() int32 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *RegisterCollectionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RegisterCollectionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Contract        *Contract              `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Created         bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // false when the address was already registered
+	RegistryVersion string                 `protobuf:"bytes,3,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RegisterCollectionResponse) Reset() {
+	*x = RegisterCollectionResponse{}
+	mi := &file_chain_registry_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCollectionResponse) ProtoMessage() {}
+
+func (x *RegisterCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RegisterCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RegisterCollectionResponse) GetContract() *Contract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *RegisterCollectionResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *RegisterCollectionResponse) GetRegistryVersion() string {
+	if x != nil {
+		return x.RegistryVersion
+	}
+	return ""
+}
+
+// Sets the mint function of a registered collection; an unset mint_function restores the
+// standard signature
+type SetMintFunctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	MintFunction  *MintFunction          `protobuf:"bytes,3,opt,name=mint_function,json=mintFunction,proto3" json:"mint_function,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMintFunctionRequest) Reset() {
+	*x = SetMintFunctionRequest{}
+	mi := &file_chain_registry_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMintFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMintFunctionRequest) ProtoMessage() {}
+
+func (x *SetMintFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMintFunctionRequest.ProtoReflect.Descriptor instead.
+func (*SetMintFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetMintFunctionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SetMintFunctionRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetMintFunctionRequest) GetMintFunction() *MintFunction {
+	if x != nil {
+		return x.MintFunction
+	}
+	return nil
+}
+
+func (x *SetMintFunctionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetMintFunctionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RegistryVersion string                 `protobuf:"bytes,1,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetMintFunctionResponse) Reset() {
+	*x = SetMintFunctionResponse{}
+	mi := &file_chain_registry_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMintFunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMintFunctionResponse) ProtoMessage() {}
+
+func (x *SetMintFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMintFunctionResponse.ProtoReflect.Descriptor instead.
+func (*SetMintFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetMintFunctionResponse) GetRegistryVersion() string {
+	if x != nil {
+		return x.RegistryVersion
+	}
+	return ""
+}
+
+// Looks up the one contract on a chain holding a role. NOT_FOUND when none does and
+// FAILED_PRECONDITION when several do.
+type GetContractByRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContractByRoleRequest) Reset() {
+	*x = GetContractByRoleRequest{}
+	mi := &file_chain_registry_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContractByRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContractByRoleRequest) ProtoMessage() {}
+
+func (x *GetContractByRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContractByRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetContractByRoleRequest) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetContractByRoleRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetContractByRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Replaces a contract's roles; an empty list clears them
+type SetContractRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetContractRolesRequest) Reset() {
+	*x = SetContractRolesRequest{}
+	mi := &file_chain_registry_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetContractRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContractRolesRequest) ProtoMessage() {}
+
+func (x *SetContractRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContractRolesRequest.ProtoReflect.Descriptor instead.
+func (*SetContractRolesRequest) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetContractRolesRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SetContractRolesRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetContractRolesRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *SetContractRolesRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetContractRolesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RegistryVersion string                 `protobuf:"bytes,1,opt,name=registry_version,json=registryVersion,proto3" json:"registry_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetContractRolesResponse) Reset() {
+	*x = SetContractRolesResponse{}
+	mi := &file_chain_registry_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetContractRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContractRolesResponse) ProtoMessage() {}
+
+func (x *SetContractRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContractRolesResponse.ProtoReflect.Descriptor instead.
+func (*SetContractRolesResponse) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetContractRolesResponse) GetRegistryVersion() string {
+	if x != nil {
+		return x.RegistryVersion
+	}
+	return ""
+}
+
+// The last head the indexer reported on chain.head_advanced. NOT_FOUND until it reported one.
+type GetChainHeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainHeadRequest) Reset() {
+	*x = GetChainHeadRequest{}
+	mi := &file_chain_registry_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainHeadRequest) ProtoMessage() {}
+
+func (x *GetChainHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainHeadRequest.ProtoReflect.Descriptor instead.
+func (*GetChainHeadRequest) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetChainHeadRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+type ChainHead struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	LatestBlock     uint64                 `protobuf:"varint,2,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
+	LatestBlockHash string                 `protobuf:"bytes,3,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+	LatestBlockTime string                 `protobuf:"bytes,4,opt,name=latest_block_time,json=latestBlockTime,proto3" json:"latest_block_time,omitempty"` // RFC3339
+	FinalizedBlock  uint64                 `protobuf:"varint,5,opt,name=finalized_block,json=finalizedBlock,proto3" json:"finalized_block,omitempty"`
+	FinalizedByTag  bool                   `protobuf:"varint,6,opt,name=finalized_by_tag,json=finalizedByTag,proto3" json:"finalized_by_tag,omitempty"` // false: latest_block less the chain's reorg depth
+	AdvancedAt      string                 `protobuf:"bytes,7,opt,name=advanced_at,json=advancedAt,proto3" json:"advanced_at,omitempty"`                // RFC3339, when the indexer saw latest_block
+	Stale           bool                   `protobuf:"varint,8,opt,name=stale,proto3" json:"stale,omitempty"`                                           // the head stopped advancing, or the indexer stopped reporting it
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChainHead) Reset() {
+	*x = ChainHead{}
+	mi := &file_chain_registry_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainHead) ProtoMessage() {}
+
+func (x *ChainHead) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainHead.ProtoReflect.Descriptor instead.
+func (*ChainHead) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChainHead) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ChainHead) GetLatestBlock() uint64 {
+	if x != nil {
+		return x.LatestBlock
+	}
+	return 0
+}
+
+func (x *ChainHead) GetLatestBlockHash() string {
+	if x != nil {
+		return x.LatestBlockHash
+	}
+	return ""
+}
+
+func (x *ChainHead) GetLatestBlockTime() string {
+	if x != nil {
+		return x.LatestBlockTime
+	}
+	return ""
+}
+
+func (x *ChainHead) GetFinalizedBlock() uint64 {
+	if x != nil {
+		return x.FinalizedBlock
+	}
+	return 0
+}
+
+func (x *ChainHead) GetFinalizedByTag() bool {
+	if x != nil {
+		return x.FinalizedByTag
+	}
+	return false
+}
+
+func (x *ChainHead) GetAdvancedAt() string {
+	if x != nil {
+		return x.AdvancedAt
+	}
+	return ""
+}
+
+func (x *ChainHead) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type GetChainHeadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Head          *ChainHead             `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainHeadResponse) Reset() {
+	*x = GetChainHeadResponse{}
+	mi := &file_chain_registry_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainHeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainHeadResponse) ProtoMessage() {}
+
+func (x *GetChainHeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_registry_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainHeadResponse.ProtoReflect.Descriptor instead.
+func (*GetChainHeadResponse) Descriptor() ([]byte, []int) {
+	return file_chain_registry_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetChainHeadResponse) GetHead() *ChainHead {
+	if x != nil {
+		return x.Head
+	}
+	return nil
+}
+
+var File_chain_registry_proto protoreflect.FileDescriptor
+
+const file_chain_registry_proto_rawDesc = "" +
+	"\n" +
+	"\x14chain-registry.proto\x12\rchainregistry\"\xed\x02\n" +
+	"\bContract\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1f\n" +
+	"\vstart_block\x18\x03 \x01(\x05R\n" +
+	"startBlock\x12\x1f\n" +
+	"\vverified_at\x18\x04 \x01(\tR\n" +
+	"verifiedAt\x12;\n" +
+	"\bstandard\x18\x05 \x01(\x0e2\x1f.chainregistry.ContractStandardR\bstandard\x12!\n" +
+	"\fimpl_address\x18\x06 \x01(\tR\vimplAddress\x12\x1d\n" +
+	"\n" +
+	"abi_sha256\x18\a \x01(\tR\tabiSha256\x12\x1a\n" +
+	"\bimported\x18\b \x01(\bR\bimported\x12@\n" +
+	"\rmint_function\x18\t \x01(\v2\x1b.chainregistry.MintFunctionR\fmintFunction\x12\x14\n" +
+	"\x05roles\x18\n" +
+	" \x03(\tR\x05roles\"\x94\x01\n" +
+	"\fMintFunction\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04args\x18\x02 \x03(\tR\x04args\x12\x18\n" +
+	"\apayable\x18\x03 \x01(\bR\apayable\x12$\n" +
+	"\x0eunit_price_wei\x18\x04 \x01(\tR\funitPriceWei\x12\x1c\n" +
+	"\n" +
+	"max_per_tx\x18\x05 \x01(\x04R\bmaxPerTx\"\xd6\x01\n" +
+	"\tGasPolicy\x12 \n" +
+	"\fmax_fee_gwei\x18\x01 \x01(\x01R\n" +
+	"maxFeeGwei\x12*\n" +
+	"\x11priority_fee_gwei\x18\x02 \x01(\x01R\x0fpriorityFeeGwei\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x03 \x01(\x01R\n" +
+	"multiplier\x12<\n" +
+	"\x1blast_observed_base_fee_gwei\x18\x04 \x01(\x01R\x17lastObservedBaseFeeGwei\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"\xc3\x01\n" +
+	"\vRpcEndpoint\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\x127\n" +
+	"\tauth_type\x18\x04 \x01(\x0e2\x1a.chainregistry.RpcAuthTypeR\bauthType\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x05 \x01(\x05R\trateLimit\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\"\x8c\x02\n" +
+	"\vChainParams\x125\n" +
+	"\x16required_confirmations\x18\x01 \x01(\rR\x15requiredConfirmations\x12\x1f\n" +
+	"\vreorg_depth\x18\x02 \x01(\rR\n" +
+	"reorgDepth\x12\"\n" +
+	"\rblock_time_ms\x18\x03 \x01(\rR\vblockTimeMs\x12&\n" +
+	"\x0fmax_royalty_bps\x18\x04 \x01(\x04R\rmaxRoyaltyBps\x123\n" +
+	"\x16min_stage_duration_sec\x18\x05 \x01(\x04R\x13minStageDurationSec\x12$\n" +
+	"\x0emax_supply_cap\x18\x06 \x01(\x04R\fmaxSupplyCap\"\xe0\x01\n" +
+	"\x11ChainCapabilities\x12%\n" +
+	"\x0eerc721_factory\x18\x01 \x01(\bR\rerc721Factory\x12'\n" +
+	"\x0ferc1155_factory\x18\x02 \x01(\bR\x0eerc1155Factory\x12%\n" +
+	"\x0eallowlist_mint\x18\x03 \x01(\bR\rallowlistMint\x12\x1b\n" +
+	"\tlazy_mint\x18\x04 \x01(\bR\blazyMint\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x04R\aversion\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"0\n" +
+	"\x13GetContractsRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\"\x91\x02\n" +
+	"\x14GetContractsResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12#\n" +
+	"\rchain_numeric\x18\x02 \x01(\x04R\fchainNumeric\x125\n" +
+	"\tcontracts\x18\x03 \x03(\v2\x17.chainregistry.ContractR\tcontracts\x122\n" +
+	"\x06params\x18\x04 \x01(\v2\x1a.chainregistry.ChainParamsR\x06params\x12)\n" +
+	"\x10registry_version\x18\x05 \x01(\tR\x0fregistryVersion\x12#\n" +
+	"\rnative_symbol\x18\x06 \x01(\tR\fnativeSymbol\"0\n" +
+	"\x13GetGasPolicyRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\"\x8e\x01\n" +
+	"\x14GetGasPolicyResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x120\n" +
+	"\x06policy\x18\x02 \x01(\v2\x18.chainregistry.GasPolicyR\x06policy\x12)\n" +
+	"\x10registry_version\x18\x03 \x01(\tR\x0fregistryVersion\"3\n" +
+	"\x16GetRpcEndpointsRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\"\x99\x01\n" +
+	"\x17GetRpcEndpointsResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x128\n" +
+	"\tendpoints\x18\x02 \x03(\v2\x1a.chainregistry.RpcEndpointR\tendpoints\x12)\n" +
+	"\x10registry_version\x18\x03 \x01(\tR\x0fregistryVersion\"8\n" +
+	"\x1bGetChainCapabilitiesRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\"\xaa\x01\n" +
+	"\x1cGetChainCapabilitiesResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12D\n" +
+	"\fcapabilities\x18\x02 \x01(\v2 .chainregistry.ChainCapabilitiesR\fcapabilities\x12)\n" +
+	"\x10registry_version\x18\x03 \x01(\tR\x0fregistryVersion\"M\n" +
+	"\x16GetContractMetaRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x94\x01\n" +
+	"\x17GetContractMetaResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x123\n" +
+	"\bcontract\x18\x02 \x01(\v2\x17.chainregistry.ContractR\bcontract\x12)\n" +
+	"\x10registry_version\x18\x03 \x01(\tR\x0fregistryVersion\"2\n" +
+	"\x11GetAbiBlobRequest\x12\x1d\n" +
+	"\n" +
+	"abi_sha256\x18\x01 \x01(\tR\tabiSha256\"C\n" +
+	"\x12GetAbiBlobResponse\x12\x19\n" +
+	"\babi_json\x18\x01 \x01(\tR\aabiJson\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"M\n" +
+	"\x16GetAbiByAddressRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"J\n" +
+	"\x13ResolveProxyRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\xc3\x01\n" +
+	"\x14ResolveProxyResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12#\n" +
+	"\rproxy_address\x18\x02 \x01(\tR\fproxyAddress\x12!\n" +
+	"\fimpl_address\x18\x03 \x01(\tR\vimplAddress\x12\x1d\n" +
+	"\n" +
+	"abi_sha256\x18\x04 \x01(\tR\tabiSha256\x12)\n" +
+	"\x10registry_version\x18\x05 \x01(\tR\x0fregistryVersion\"G\n" +
+	"\x12BumpVersionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"F\n" +
+	"\x13BumpVersionResponse\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x1f\n" +
+	"\vnew_version\x18\x02 \x01(\tR\n" +
+	"newVersion\"\xa9\x01\n" +
+	"\aAbiDiff\x12!\n" +
+	"\fadded_events\x18\x01 \x03(\tR\vaddedEvents\x12%\n" +
+	"\x0eremoved_events\x18\x02 \x03(\tR\rremovedEvents\x12'\n" +
+	"\x0fadded_functions\x18\x03 \x03(\tR\x0eaddedFunctions\x12+\n" +
+	"\x11removed_functions\x18\x04 \x03(\tR\x10removedFunctions\"\x82\x01\n" +
+	"\x18UpdateContractAbiRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x19\n" +
+	"\babi_json\x18\x03 \x01(\tR\aabiJson\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xce\x01\n" +
+	"\x19UpdateContractAbiResponse\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\bR\achanged\x12$\n" +
+	"\x0eold_abi_sha256\x18\x02 \x01(\tR\foldAbiSha256\x12$\n" +
+	"\x0enew_abi_sha256\x18\x03 \x01(\tR\fnewAbiSha256\x12\x1f\n" +
+	"\vnew_version\x18\x04 \x01(\tR\n" +
+	"newVersion\x12*\n" +
+	"\x04diff\x18\x05 \x01(\v2\x16.chainregistry.AbiDiffR\x04diff\"\xc6\x01\n" +
+	"\x19RegisterCollectionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12;\n" +
+	"\bstandard\x18\x03 \x01(\x0e2\x1f.chainregistry.ContractStandardR\bstandard\x12\x1f\n" +
+	"\vstart_block\x18\x04 \x01(\x05R\n" +
+	"startBlock\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x96\x01\n" +
+	"\x1aRegisterCollectionResponse\x123\n" +
+	"\bcontract\x18\x01 \x01(\v2\x17.chainregistry.ContractR\bcontract\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x12)\n" +
+	"\x10registry_version\x18\x03 \x01(\tR\x0fregistryVersion\"\xa7\x01\n" +
+	"\x16SetMintFunctionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12@\n" +
+	"\rmint_function\x18\x03 \x01(\v2\x1b.chainregistry.MintFunctionR\fmintFunction\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"D\n" +
+	"\x17SetMintFunctionResponse\x12)\n" +
+	"\x10registry_version\x18\x01 \x01(\tR\x0fregistryVersion\"I\n" +
+	"\x18GetContractByRoleRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"|\n" +
+	"\x17SetContractRolesRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"E\n" +
+	"\x18SetContractRolesResponse\x12)\n" +
+	"\x10registry_version\x18\x01 \x01(\tR\x0fregistryVersion\"0\n" +
+	"\x13GetChainHeadRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\"\xab\x02\n" +
+	"\tChainHead\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12!\n" +
+	"\flatest_block\x18\x02 \x01(\x04R\vlatestBlock\x12*\n" +
+	"\x11latest_block_hash\x18\x03 \x01(\tR\x0flatestBlockHash\x12*\n" +
+	"\x11latest_block_time\x18\x04 \x01(\tR\x0flatestBlockTime\x12'\n" +
+	"\x0ffinalized_block\x18\x05 \x01(\x04R\x0efinalizedBlock\x12(\n" +
+	"\x10finalized_by_tag\x18\x06 \x01(\bR\x0efinalizedByTag\x12\x1f\n" +
+	"\vadvanced_at\x18\a \x01(\tR\n" +
+	"advancedAt\x12\x14\n" +
+	"\x05stale\x18\b \x01(\bR\x05stale\"D\n" +
+	"\x14GetChainHeadResponse\x12,\n" +
+	"\x04head\x18\x01 \x01(\v2\x18.chainregistry.ChainHeadR\x04head*[\n" +
 	"\vRpcAuthType\x12\x11\n" +
 	"\rRPC_AUTH_NONE\x10\x00\x12\x10\n" +
 	"\fRPC_AUTH_KEY\x10\x01\x12\x12\n" +
@@ -1378,17 +2602,24 @@ const file_chain_registry_proto_rawDesc = "" +
 	"STD_ERC721\x10\x01\x12\x0f\n" +
 	"\vSTD_ERC1155\x10\x02\x12\r\n" +
 	"\tSTD_PROXY\x10\x03\x12\x0f\n" +
-	"\vSTD_DIAMOND\x10\x042\xeb\x05\n" +
+	"\vSTD_DIAMOND\x10\x042\xb5\v\n" +
 	"\x14ChainRegistryService\x12W\n" +
 	"\fGetContracts\x12\".chainregistry.GetContractsRequest\x1a#.chainregistry.GetContractsResponse\x12W\n" +
 	"\fGetGasPolicy\x12\".chainregistry.GetGasPolicyRequest\x1a#.chainregistry.GetGasPolicyResponse\x12`\n" +
-	"\x0fGetRpcEndpoints\x12%.chainregistry.GetRpcEndpointsRequest\x1a&.chainregistry.GetRpcEndpointsResponse\x12`\n" +
+	"\x0fGetRpcEndpoints\x12%.chainregistry.GetRpcEndpointsRequest\x1a&.chainregistry.GetRpcEndpointsResponse\x12o\n" +
+	"\x14GetChainCapabilities\x12*.chainregistry.GetChainCapabilitiesRequest\x1a+.chainregistry.GetChainCapabilitiesResponse\x12`\n" +
 	"\x0fGetContractMeta\x12%.chainregistry.GetContractMetaRequest\x1a&.chainregistry.GetContractMetaResponse\x12Q\n" +
 	"\n" +
 	"GetAbiBlob\x12 .chainregistry.GetAbiBlobRequest\x1a!.chainregistry.GetAbiBlobResponse\x12[\n" +
 	"\x0fGetAbiByAddress\x12%.chainregistry.GetAbiByAddressRequest\x1a!.chainregistry.GetAbiBlobResponse\x12W\n" +
-	"\fResolveProxy\x12\".chainregistry.ResolveProxyRequest\x1a#.chainregistry.ResolveProxyResponse\x12T\n" +
-	"\vBumpVersion\x12!.chainregistry.BumpVersionRequest\x1a\".chainregistry.BumpVersionResponseB*Z(shared/proto/chainregistry;chainregistryb\x06proto3"
+	"\fResolveProxy\x12\".chainregistry.ResolveProxyRequest\x1a#.chainregistry.ResolveProxyResponse\x12d\n" +
+	"\x11GetContractByRole\x12'.chainregistry.GetContractByRoleRequest\x1a&.chainregistry.GetContractMetaResponse\x12W\n" +
+	"\fGetChainHead\x12\".chainregistry.GetChainHeadRequest\x1a#.chainregistry.GetChainHeadResponse\x12T\n" +
+	"\vBumpVersion\x12!.chainregistry.BumpVersionRequest\x1a\".chainregistry.BumpVersionResponse\x12f\n" +
+	"\x11UpdateContractAbi\x12'.chainregistry.UpdateContractAbiRequest\x1a(.chainregistry.UpdateContractAbiResponse\x12i\n" +
+	"\x12RegisterCollection\x12(.chainregistry.RegisterCollectionRequest\x1a).chainregistry.RegisterCollectionResponse\x12`\n" +
+	"\x0fSetMintFunction\x12%.chainregistry.SetMintFunctionRequest\x1a&.chainregistry.SetMintFunctionResponse\x12c\n" +
+	"\x10SetContractRoles\x12&.chainregistry.SetContractRolesRequest\x1a'.chainregistry.SetContractRolesResponseB*Z(shared/proto/chainregistry;chainregistryb\x06proto3"
 
 var (
 	file_chain_registry_proto_rawDescOnce sync.Once
@@ -1403,59 +2634,97 @@ func file_chain_registry_proto_rawDescGZIP() []byte {
 }
 
 var file_chain_registry_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_chain_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_chain_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_chain_registry_proto_goTypes = []any{
-	(RpcAuthType)(0),                // 0: chainregistry.RpcAuthType
-	(ContractStandard)(0),           // 1: chainregistry.ContractStandard
-	(*Contract)(nil),                // 2: chainregistry.Contract
-	(*GasPolicy)(nil),               // 3: chainregistry.GasPolicy
-	(*RpcEndpoint)(nil),             // 4: chainregistry.RpcEndpoint
-	(*ChainParams)(nil),             // 5: chainregistry.ChainParams
-	(*GetContractsRequest)(nil),     // 6: chainregistry.GetContractsRequest
-	(*GetContractsResponse)(nil),    // 7: chainregistry.GetContractsResponse
-	(*GetGasPolicyRequest)(nil),     // 8: chainregistry.GetGasPolicyRequest
-	(*GetGasPolicyResponse)(nil),    // 9: chainregistry.GetGasPolicyResponse
-	(*GetRpcEndpointsRequest)(nil),  // 10: chainregistry.GetRpcEndpointsRequest
-	(*GetRpcEndpointsResponse)(nil), // 11: chainregistry.GetRpcEndpointsResponse
-	(*GetContractMetaRequest)(nil),  // 12: chainregistry.GetContractMetaRequest
-	(*GetContractMetaResponse)(nil), // 13: chainregistry.GetContractMetaResponse
-	(*GetAbiBlobRequest)(nil),       // 14: chainregistry.GetAbiBlobRequest
-	(*GetAbiBlobResponse)(nil),      // 15: chainregistry.GetAbiBlobResponse
-	(*GetAbiByAddressRequest)(nil),  // 16: chainregistry.GetAbiByAddressRequest
-	(*ResolveProxyRequest)(nil),     // 17: chainregistry.ResolveProxyRequest
-	(*ResolveProxyResponse)(nil),    // 18: chainregistry.ResolveProxyResponse
-	(*BumpVersionRequest)(nil),      // 19: chainregistry.BumpVersionRequest
-	(*BumpVersionResponse)(nil),     // 20: chainregistry.BumpVersionResponse
+	(RpcAuthType)(0),                     // 0: chainregistry.RpcAuthType
+	(ContractStandard)(0),                // 1: chainregistry.ContractStandard
+	(*Contract)(nil),                     // 2: chainregistry.Contract
+	(*MintFunction)(nil),                 // 3: chainregistry.MintFunction
+	(*GasPolicy)(nil),                    // 4: chainregistry.GasPolicy
+	(*RpcEndpoint)(nil),                  // 5: chainregistry.RpcEndpoint
+	(*ChainParams)(nil),                  // 6: chainregistry.ChainParams
+	(*ChainCapabilities)(nil),            // 7: chainregistry.ChainCapabilities
+	(*GetContractsRequest)(nil),          // 8: chainregistry.GetContractsRequest
+	(*GetContractsResponse)(nil),         // 9: chainregistry.GetContractsResponse
+	(*GetGasPolicyRequest)(nil),          // 10: chainregistry.GetGasPolicyRequest
+	(*GetGasPolicyResponse)(nil),         // 11: chainregistry.GetGasPolicyResponse
+	(*GetRpcEndpointsRequest)(nil),       // 12: chainregistry.GetRpcEndpointsRequest
+	(*GetRpcEndpointsResponse)(nil),      // 13: chainregistry.GetRpcEndpointsResponse
+	(*GetChainCapabilitiesRequest)(nil),  // 14: chainregistry.GetChainCapabilitiesRequest
+	(*GetChainCapabilitiesResponse)(nil), // 15: chainregistry.GetChainCapabilitiesResponse
+	(*GetContractMetaRequest)(nil),       // 16: chainregistry.GetContractMetaRequest
+	(*GetContractMetaResponse)(nil),      // 17: chainregistry.GetContractMetaResponse
+	(*GetAbiBlobRequest)(nil),            // 18: chainregistry.GetAbiBlobRequest
+	(*GetAbiBlobResponse)(nil),           // 19: chainregistry.GetAbiBlobResponse
+	(*GetAbiByAddressRequest)(nil),       // 20: chainregistry.GetAbiByAddressRequest
+	(*ResolveProxyRequest)(nil),          // 21: chainregistry.ResolveProxyRequest
+	(*ResolveProxyResponse)(nil),         // 22: chainregistry.ResolveProxyResponse
+	(*BumpVersionRequest)(nil),           // 23: chainregistry.BumpVersionRequest
+	(*BumpVersionResponse)(nil),          // 24: chainregistry.BumpVersionResponse
+	(*AbiDiff)(nil),                      // 25: chainregistry.AbiDiff
+	(*UpdateContractAbiRequest)(nil),     // 26: chainregistry.UpdateContractAbiRequest
+	(*UpdateContractAbiResponse)(nil),    // 27: chainregistry.UpdateContractAbiResponse
+	(*RegisterCollectionRequest)(nil),    // 28: chainregistry.RegisterCollectionRequest
+	(*RegisterCollectionResponse)(nil),   // 29: chainregistry.RegisterCollectionResponse
+	(*SetMintFunctionRequest)(nil),       // 30: chainregistry.SetMintFunctionRequest
+	(*SetMintFunctionResponse)(nil),      // 31: chainregistry.SetMintFunctionResponse
+	(*GetContractByRoleRequest)(nil),     // 32: chainregistry.GetContractByRoleRequest
+	(*SetContractRolesRequest)(nil),      // 33: chainregistry.SetContractRolesRequest
+	(*SetContractRolesResponse)(nil),     // 34: chainregistry.SetContractRolesResponse
+	(*GetChainHeadRequest)(nil),          // 35: chainregistry.GetChainHeadRequest
+	(*ChainHead)(nil),                    // 36: chainregistry.ChainHead
+	(*GetChainHeadResponse)(nil),         // 37: chainregistry.GetChainHeadResponse
 }
 var file_chain_registry_proto_depIdxs = []int32{
 	1,  // 0: chainregistry.Contract.standard:type_name -> chainregistry.ContractStandard
-	0,  // 1: chainregistry.RpcEndpoint.auth_type:type_name -> chainregistry.RpcAuthType
-	2,  // 2: chainregistry.GetContractsResponse.contracts:type_name -> chainregistry.Contract
-	5,  // 3: chainregistry.GetContractsResponse.params:type_name -> chainregistry.ChainParams
-	3,  // 4: chainregistry.GetGasPolicyResponse.policy:type_name -> chainregistry.GasPolicy
-	4,  // 5: chainregistry.GetRpcEndpointsResponse.endpoints:type_name -> chainregistry.RpcEndpoint
-	2,  // 6: chainregistry.GetContractMetaResponse.contract:type_name -> chainregistry.Contract
-	6,  // 7: chainregistry.ChainRegistryService.GetContracts:input_type -> chainregistry.GetContractsRequest
-	8,  // 8: chainregistry.ChainRegistryService.GetGasPolicy:input_type -> chainregistry.GetGasPolicyRequest
-	10, // 9: chainregistry.ChainRegistryService.GetRpcEndpoints:input_type -> chainregistry.GetRpcEndpointsRequest
-	12, // 10: chainregistry.ChainRegistryService.GetContractMeta:input_type -> chainregistry.GetContractMetaRequest
-	14, // 11: chainregistry.ChainRegistryService.GetAbiBlob:input_type -> chainregistry.GetAbiBlobRequest
-	16, // 12: chainregistry.ChainRegistryService.GetAbiByAddress:input_type -> chainregistry.GetAbiByAddressRequest
-	17, // 13: chainregistry.ChainRegistryService.ResolveProxy:input_type -> chainregistry.ResolveProxyRequest
-	19, // 14: chainregistry.ChainRegistryService.BumpVersion:input_type -> chainregistry.BumpVersionRequest
-	7,  // 15: chainregistry.ChainRegistryService.GetContracts:output_type -> chainregistry.GetContractsResponse
-	9,  // 16: chainregistry.ChainRegistryService.GetGasPolicy:output_type -> chainregistry.GetGasPolicyResponse
-	11, // 17: chainregistry.ChainRegistryService.GetRpcEndpoints:output_type -> chainregistry.GetRpcEndpointsResponse
-	13, // 18: chainregistry.ChainRegistryService.GetContractMeta:output_type -> chainregistry.GetContractMetaResponse
-	15, // 19: chainregistry.ChainRegistryService.GetAbiBlob:output_type -> chainregistry.GetAbiBlobResponse
-	15, // 20: chainregistry.ChainRegistryService.GetAbiByAddress:output_type -> chainregistry.GetAbiBlobResponse
-	18, // 21: chainregistry.ChainRegistryService.ResolveProxy:output_type -> chainregistry.ResolveProxyResponse
-	20, // 22: chainregistry.ChainRegistryService.BumpVersion:output_type -> chainregistry.BumpVersionResponse
-	15, // [15:23] is the sub-list for method output_type
-	7,  // [7:15] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	3,  // 1: chainregistry.Contract.mint_function:type_name -> chainregistry.MintFunction
+	0,  // 2: chainregistry.RpcEndpoint.auth_type:type_name -> chainregistry.RpcAuthType
+	2,  // 3: chainregistry.GetContractsResponse.contracts:type_name -> chainregistry.Contract
+	6,  // 4: chainregistry.GetContractsResponse.params:type_name -> chainregistry.ChainParams
+	4,  // 5: chainregistry.GetGasPolicyResponse.policy:type_name -> chainregistry.GasPolicy
+	5,  // 6: chainregistry.GetRpcEndpointsResponse.endpoints:type_name -> chainregistry.RpcEndpoint
+	7,  // 7: chainregistry.GetChainCapabilitiesResponse.capabilities:type_name -> chainregistry.ChainCapabilities
+	2,  // 8: chainregistry.GetContractMetaResponse.contract:type_name -> chainregistry.Contract
+	25, // 9: chainregistry.UpdateContractAbiResponse.diff:type_name -> chainregistry.AbiDiff
+	1,  // 10: chainregistry.RegisterCollectionRequest.standard:type_name -> chainregistry.ContractStandard
+	2,  // 11: chainregistry.RegisterCollectionResponse.contract:type_name -> chainregistry.Contract
+	3,  // 12: chainregistry.SetMintFunctionRequest.mint_function:type_name -> chainregistry.MintFunction
+	36, // 13: chainregistry.GetChainHeadResponse.head:type_name -> chainregistry.ChainHead
+	8,  // 14: chainregistry.ChainRegistryService.GetContracts:input_type -> chainregistry.GetContractsRequest
+	10, // 15: chainregistry.ChainRegistryService.GetGasPolicy:input_type -> chainregistry.GetGasPolicyRequest
+	12, // 16: chainregistry.ChainRegistryService.GetRpcEndpoints:input_type -> chainregistry.GetRpcEndpointsRequest
+	14, // 17: chainregistry.ChainRegistryService.GetChainCapabilities:input_type -> chainregistry.GetChainCapabilitiesRequest
+	16, // 18: chainregistry.ChainRegistryService.GetContractMeta:input_type -> chainregistry.GetContractMetaRequest
+	18, // 19: chainregistry.ChainRegistryService.GetAbiBlob:input_type -> chainregistry.GetAbiBlobRequest
+	20, // 20: chainregistry.ChainRegistryService.GetAbiByAddress:input_type -> chainregistry.GetAbiByAddressRequest
+	21, // 21: chainregistry.ChainRegistryService.ResolveProxy:input_type -> chainregistry.ResolveProxyRequest
+	32, // 22: chainregistry.ChainRegistryService.GetContractByRole:input_type -> chainregistry.GetContractByRoleRequest
+	35, // 23: chainregistry.ChainRegistryService.GetChainHead:input_type -> chainregistry.GetChainHeadRequest
+	23, // 24: chainregistry.ChainRegistryService.BumpVersion:input_type -> chainregistry.BumpVersionRequest
+	26, // 25: chainregistry.ChainRegistryService.UpdateContractAbi:input_type -> chainregistry.UpdateContractAbiRequest
+	28, // 26: chainregistry.ChainRegistryService.RegisterCollection:input_type -> chainregistry.RegisterCollectionRequest
+	30, // 27: chainregistry.ChainRegistryService.SetMintFunction:input_type -> chainregistry.SetMintFunctionRequest
+	33, // 28: chainregistry.ChainRegistryService.SetContractRoles:input_type -> chainregistry.SetContractRolesRequest
+	9,  // 29: chainregistry.ChainRegistryService.GetContracts:output_type -> chainregistry.GetContractsResponse
+	11, // 30: chainregistry.ChainRegistryService.GetGasPolicy:output_type -> chainregistry.GetGasPolicyResponse
+	13, // 31: chainregistry.ChainRegistryService.GetRpcEndpoints:output_type -> chainregistry.GetRpcEndpointsResponse
+	15, // 32: chainregistry.ChainRegistryService.GetChainCapabilities:output_type -> chainregistry.GetChainCapabilitiesResponse
+	17, // 33: chainregistry.ChainRegistryService.GetContractMeta:output_type -> chainregistry.GetContractMetaResponse
+	19, // 34: chainregistry.ChainRegistryService.GetAbiBlob:output_type -> chainregistry.GetAbiBlobResponse
+	19, // 35: chainregistry.ChainRegistryService.GetAbiByAddress:output_type -> chainregistry.GetAbiBlobResponse
+	22, // 36: chainregistry.ChainRegistryService.ResolveProxy:output_type -> chainregistry.ResolveProxyResponse
+	17, // 37: chainregistry.ChainRegistryService.GetContractByRole:output_type -> chainregistry.GetContractMetaResponse
+	37, // 38: chainregistry.ChainRegistryService.GetChainHead:output_type -> chainregistry.GetChainHeadResponse
+	24, // 39: chainregistry.ChainRegistryService.BumpVersion:output_type -> chainregistry.BumpVersionResponse
+	27, // 40: chainregistry.ChainRegistryService.UpdateContractAbi:output_type -> chainregistry.UpdateContractAbiResponse
+	29, // 41: chainregistry.ChainRegistryService.RegisterCollection:output_type -> chainregistry.RegisterCollectionResponse
+	31, // 42: chainregistry.ChainRegistryService.SetMintFunction:output_type -> chainregistry.SetMintFunctionResponse
+	34, // 43: chainregistry.ChainRegistryService.SetContractRoles:output_type -> chainregistry.SetContractRolesResponse
+	29, // [29:44] is the sub-list for method output_type
+	14, // [14:29] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_chain_registry_proto_init() }
@@ -1469,7 +2738,7 @@ func file_chain_registry_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chain_registry_proto_rawDesc), len(file_chain_registry_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
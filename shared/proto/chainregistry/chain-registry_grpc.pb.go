@@ -20,14 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChainRegistryService_GetContracts_FullMethodName    = "/chainregistry.ChainRegistryService/GetContracts"
-	ChainRegistryService_GetGasPolicy_FullMethodName    = "/chainregistry.ChainRegistryService/GetGasPolicy"
-	ChainRegistryService_GetRpcEndpoints_FullMethodName = "/chainregistry.ChainRegistryService/GetRpcEndpoints"
-	ChainRegistryService_GetContractMeta_FullMethodName = "/chainregistry.ChainRegistryService/GetContractMeta"
-	ChainRegistryService_GetAbiBlob_FullMethodName      = "/chainregistry.ChainRegistryService/GetAbiBlob"
-	ChainRegistryService_GetAbiByAddress_FullMethodName = "/chainregistry.ChainRegistryService/GetAbiByAddress"
-	ChainRegistryService_ResolveProxy_FullMethodName    = "/chainregistry.ChainRegistryService/ResolveProxy"
-	ChainRegistryService_BumpVersion_FullMethodName     = "/chainregistry.ChainRegistryService/BumpVersion"
+	ChainRegistryService_GetContracts_FullMethodName         = "/chainregistry.ChainRegistryService/GetContracts"
+	ChainRegistryService_GetGasPolicy_FullMethodName         = "/chainregistry.ChainRegistryService/GetGasPolicy"
+	ChainRegistryService_GetRpcEndpoints_FullMethodName      = "/chainregistry.ChainRegistryService/GetRpcEndpoints"
+	ChainRegistryService_GetChainCapabilities_FullMethodName = "/chainregistry.ChainRegistryService/GetChainCapabilities"
+	ChainRegistryService_GetContractMeta_FullMethodName      = "/chainregistry.ChainRegistryService/GetContractMeta"
+	ChainRegistryService_GetAbiBlob_FullMethodName           = "/chainregistry.ChainRegistryService/GetAbiBlob"
+	ChainRegistryService_GetAbiByAddress_FullMethodName      = "/chainregistry.ChainRegistryService/GetAbiByAddress"
+	ChainRegistryService_ResolveProxy_FullMethodName         = "/chainregistry.ChainRegistryService/ResolveProxy"
+	ChainRegistryService_GetContractByRole_FullMethodName    = "/chainregistry.ChainRegistryService/GetContractByRole"
+	ChainRegistryService_GetChainHead_FullMethodName         = "/chainregistry.ChainRegistryService/GetChainHead"
+	ChainRegistryService_BumpVersion_FullMethodName          = "/chainregistry.ChainRegistryService/BumpVersion"
+	ChainRegistryService_UpdateContractAbi_FullMethodName    = "/chainregistry.ChainRegistryService/UpdateContractAbi"
+	ChainRegistryService_RegisterCollection_FullMethodName   = "/chainregistry.ChainRegistryService/RegisterCollection"
+	ChainRegistryService_SetMintFunction_FullMethodName      = "/chainregistry.ChainRegistryService/SetMintFunction"
+	ChainRegistryService_SetContractRoles_FullMethodName     = "/chainregistry.ChainRegistryService/SetContractRoles"
 )
 
 // ChainRegistryServiceClient is the client API for ChainRegistryService service.
@@ -39,13 +46,20 @@ type ChainRegistryServiceClient interface {
 	GetContracts(ctx context.Context, in *GetContractsRequest, opts ...grpc.CallOption) (*GetContractsResponse, error)
 	GetGasPolicy(ctx context.Context, in *GetGasPolicyRequest, opts ...grpc.CallOption) (*GetGasPolicyResponse, error)
 	GetRpcEndpoints(ctx context.Context, in *GetRpcEndpointsRequest, opts ...grpc.CallOption) (*GetRpcEndpointsResponse, error)
+	GetChainCapabilities(ctx context.Context, in *GetChainCapabilitiesRequest, opts ...grpc.CallOption) (*GetChainCapabilitiesResponse, error)
 	// mới:
 	GetContractMeta(ctx context.Context, in *GetContractMetaRequest, opts ...grpc.CallOption) (*GetContractMetaResponse, error)
 	GetAbiBlob(ctx context.Context, in *GetAbiBlobRequest, opts ...grpc.CallOption) (*GetAbiBlobResponse, error)
 	GetAbiByAddress(ctx context.Context, in *GetAbiByAddressRequest, opts ...grpc.CallOption) (*GetAbiBlobResponse, error)
 	ResolveProxy(ctx context.Context, in *ResolveProxyRequest, opts ...grpc.CallOption) (*ResolveProxyResponse, error)
+	GetContractByRole(ctx context.Context, in *GetContractByRoleRequest, opts ...grpc.CallOption) (*GetContractMetaResponse, error)
+	GetChainHead(ctx context.Context, in *GetChainHeadRequest, opts ...grpc.CallOption) (*GetChainHeadResponse, error)
 	// admin:
 	BumpVersion(ctx context.Context, in *BumpVersionRequest, opts ...grpc.CallOption) (*BumpVersionResponse, error)
+	UpdateContractAbi(ctx context.Context, in *UpdateContractAbiRequest, opts ...grpc.CallOption) (*UpdateContractAbiResponse, error)
+	RegisterCollection(ctx context.Context, in *RegisterCollectionRequest, opts ...grpc.CallOption) (*RegisterCollectionResponse, error)
+	SetMintFunction(ctx context.Context, in *SetMintFunctionRequest, opts ...grpc.CallOption) (*SetMintFunctionResponse, error)
+	SetContractRoles(ctx context.Context, in *SetContractRolesRequest, opts ...grpc.CallOption) (*SetContractRolesResponse, error)
 }
 
 type chainRegistryServiceClient struct {
@@ -86,6 +100,16 @@ func (c *chainRegistryServiceClient) GetRpcEndpoints(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *chainRegistryServiceClient) GetChainCapabilities(ctx context.Context, in *GetChainCapabilitiesRequest, opts ...grpc.CallOption) (*GetChainCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChainCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, ChainRegistryService_GetChainCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainRegistryServiceClient) GetContractMeta(ctx context.Context, in *GetContractMetaRequest, opts ...grpc.CallOption) (*GetContractMetaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetContractMetaResponse)
@@ -126,6 +150,26 @@ func (c *chainRegistryServiceClient) ResolveProxy(ctx context.Context, in *Resol
 	return out, nil
 }
 
+func (c *chainRegistryServiceClient) GetContractByRole(ctx context.Context, in *GetContractByRoleRequest, opts ...grpc.CallOption) (*GetContractMetaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContractMetaResponse)
+	err := c.cc.Invoke(ctx, ChainRegistryService_GetContractByRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainRegistryServiceClient) GetChainHead(ctx context.Context, in *GetChainHeadRequest, opts ...grpc.CallOption) (*GetChainHeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChainHeadResponse)
+	err := c.cc.Invoke(ctx, ChainRegistryService_GetChainHead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainRegistryServiceClient) BumpVersion(ctx context.Context, in *BumpVersionRequest, opts ...grpc.CallOption) (*BumpVersionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BumpVersionResponse)
@@ -136,6 +180,46 @@ func (c *chainRegistryServiceClient) BumpVersion(ctx context.Context, in *BumpVe
 	return out, nil
 }
 
+func (c *chainRegistryServiceClient) UpdateContractAbi(ctx context.Context, in *UpdateContractAbiRequest, opts ...grpc.CallOption) (*UpdateContractAbiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateContractAbiResponse)
+	err := c.cc.Invoke(ctx, ChainRegistryService_UpdateContractAbi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainRegistryServiceClient) RegisterCollection(ctx context.Context, in *RegisterCollectionRequest, opts ...grpc.CallOption) (*RegisterCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterCollectionResponse)
+	err := c.cc.Invoke(ctx, ChainRegistryService_RegisterCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainRegistryServiceClient) SetMintFunction(ctx context.Context, in *SetMintFunctionRequest, opts ...grpc.CallOption) (*SetMintFunctionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMintFunctionResponse)
+	err := c.cc.Invoke(ctx, ChainRegistryService_SetMintFunction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainRegistryServiceClient) SetContractRoles(ctx context.Context, in *SetContractRolesRequest, opts ...grpc.CallOption) (*SetContractRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetContractRolesResponse)
+	err := c.cc.Invoke(ctx, ChainRegistryService_SetContractRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChainRegistryServiceServer is the server API for ChainRegistryService service.
 // All implementations must embed UnimplementedChainRegistryServiceServer
 // for forward compatibility.
@@ -145,13 +229,20 @@ type ChainRegistryServiceServer interface {
 	GetContracts(context.Context, *GetContractsRequest) (*GetContractsResponse, error)
 	GetGasPolicy(context.Context, *GetGasPolicyRequest) (*GetGasPolicyResponse, error)
 	GetRpcEndpoints(context.Context, *GetRpcEndpointsRequest) (*GetRpcEndpointsResponse, error)
+	GetChainCapabilities(context.Context, *GetChainCapabilitiesRequest) (*GetChainCapabilitiesResponse, error)
 	// mới:
 	GetContractMeta(context.Context, *GetContractMetaRequest) (*GetContractMetaResponse, error)
 	GetAbiBlob(context.Context, *GetAbiBlobRequest) (*GetAbiBlobResponse, error)
 	GetAbiByAddress(context.Context, *GetAbiByAddressRequest) (*GetAbiBlobResponse, error)
 	ResolveProxy(context.Context, *ResolveProxyRequest) (*ResolveProxyResponse, error)
+	GetContractByRole(context.Context, *GetContractByRoleRequest) (*GetContractMetaResponse, error)
+	GetChainHead(context.Context, *GetChainHeadRequest) (*GetChainHeadResponse, error)
 	// admin:
 	BumpVersion(context.Context, *BumpVersionRequest) (*BumpVersionResponse, error)
+	UpdateContractAbi(context.Context, *UpdateContractAbiRequest) (*UpdateContractAbiResponse, error)
+	RegisterCollection(context.Context, *RegisterCollectionRequest) (*RegisterCollectionResponse, error)
+	SetMintFunction(context.Context, *SetMintFunctionRequest) (*SetMintFunctionResponse, error)
+	SetContractRoles(context.Context, *SetContractRolesRequest) (*SetContractRolesResponse, error)
 	mustEmbedUnimplementedChainRegistryServiceServer()
 }
 
@@ -171,6 +262,9 @@ func (UnimplementedChainRegistryServiceServer) GetGasPolicy(context.Context, *Ge
 func (UnimplementedChainRegistryServiceServer) GetRpcEndpoints(context.Context, *GetRpcEndpointsRequest) (*GetRpcEndpointsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRpcEndpoints not implemented")
 }
+func (UnimplementedChainRegistryServiceServer) GetChainCapabilities(context.Context, *GetChainCapabilitiesRequest) (*GetChainCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainCapabilities not implemented")
+}
 func (UnimplementedChainRegistryServiceServer) GetContractMeta(context.Context, *GetContractMetaRequest) (*GetContractMetaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContractMeta not implemented")
 }
@@ -183,9 +277,27 @@ func (UnimplementedChainRegistryServiceServer) GetAbiByAddress(context.Context,
 func (UnimplementedChainRegistryServiceServer) ResolveProxy(context.Context, *ResolveProxyRequest) (*ResolveProxyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveProxy not implemented")
 }
+func (UnimplementedChainRegistryServiceServer) GetContractByRole(context.Context, *GetContractByRoleRequest) (*GetContractMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractByRole not implemented")
+}
+func (UnimplementedChainRegistryServiceServer) GetChainHead(context.Context, *GetChainHeadRequest) (*GetChainHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainHead not implemented")
+}
 func (UnimplementedChainRegistryServiceServer) BumpVersion(context.Context, *BumpVersionRequest) (*BumpVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BumpVersion not implemented")
 }
+func (UnimplementedChainRegistryServiceServer) UpdateContractAbi(context.Context, *UpdateContractAbiRequest) (*UpdateContractAbiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContractAbi not implemented")
+}
+func (UnimplementedChainRegistryServiceServer) RegisterCollection(context.Context, *RegisterCollectionRequest) (*RegisterCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCollection not implemented")
+}
+func (UnimplementedChainRegistryServiceServer) SetMintFunction(context.Context, *SetMintFunctionRequest) (*SetMintFunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintFunction not implemented")
+}
+func (UnimplementedChainRegistryServiceServer) SetContractRoles(context.Context, *SetContractRolesRequest) (*SetContractRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractRoles not implemented")
+}
 func (UnimplementedChainRegistryServiceServer) mustEmbedUnimplementedChainRegistryServiceServer() {}
 func (UnimplementedChainRegistryServiceServer) testEmbeddedByValue()                              {}
 
@@ -261,6 +373,24 @@ func _ChainRegistryService_GetRpcEndpoints_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChainRegistryService_GetChainCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainRegistryServiceServer).GetChainCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainRegistryService_GetChainCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainRegistryServiceServer).GetChainCapabilities(ctx, req.(*GetChainCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChainRegistryService_GetContractMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetContractMetaRequest)
 	if err := dec(in); err != nil {
@@ -333,6 +463,42 @@ func _ChainRegistryService_ResolveProxy_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChainRegistryService_GetContractByRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractByRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainRegistryServiceServer).GetContractByRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainRegistryService_GetContractByRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainRegistryServiceServer).GetContractByRole(ctx, req.(*GetContractByRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainRegistryService_GetChainHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainRegistryServiceServer).GetChainHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainRegistryService_GetChainHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainRegistryServiceServer).GetChainHead(ctx, req.(*GetChainHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChainRegistryService_BumpVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BumpVersionRequest)
 	if err := dec(in); err != nil {
@@ -351,6 +517,78 @@ func _ChainRegistryService_BumpVersion_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChainRegistryService_UpdateContractAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContractAbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainRegistryServiceServer).UpdateContractAbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainRegistryService_UpdateContractAbi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainRegistryServiceServer).UpdateContractAbi(ctx, req.(*UpdateContractAbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainRegistryService_RegisterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainRegistryServiceServer).RegisterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainRegistryService_RegisterCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainRegistryServiceServer).RegisterCollection(ctx, req.(*RegisterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainRegistryService_SetMintFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMintFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainRegistryServiceServer).SetMintFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainRegistryService_SetMintFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainRegistryServiceServer).SetMintFunction(ctx, req.(*SetMintFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainRegistryService_SetContractRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContractRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainRegistryServiceServer).SetContractRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainRegistryService_SetContractRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainRegistryServiceServer).SetContractRoles(ctx, req.(*SetContractRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChainRegistryService_ServiceDesc is the grpc.ServiceDesc for ChainRegistryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -370,6 +608,10 @@ var ChainRegistryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRpcEndpoints",
 			Handler:    _ChainRegistryService_GetRpcEndpoints_Handler,
 		},
+		{
+			MethodName: "GetChainCapabilities",
+			Handler:    _ChainRegistryService_GetChainCapabilities_Handler,
+		},
 		{
 			MethodName: "GetContractMeta",
 			Handler:    _ChainRegistryService_GetContractMeta_Handler,
@@ -386,10 +628,34 @@ var ChainRegistryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveProxy",
 			Handler:    _ChainRegistryService_ResolveProxy_Handler,
 		},
+		{
+			MethodName: "GetContractByRole",
+			Handler:    _ChainRegistryService_GetContractByRole_Handler,
+		},
+		{
+			MethodName: "GetChainHead",
+			Handler:    _ChainRegistryService_GetChainHead_Handler,
+		},
 		{
 			MethodName: "BumpVersion",
 			Handler:    _ChainRegistryService_BumpVersion_Handler,
 		},
+		{
+			MethodName: "UpdateContractAbi",
+			Handler:    _ChainRegistryService_UpdateContractAbi_Handler,
+		},
+		{
+			MethodName: "RegisterCollection",
+			Handler:    _ChainRegistryService_RegisterCollection_Handler,
+		},
+		{
+			MethodName: "SetMintFunction",
+			Handler:    _ChainRegistryService_SetMintFunction_Handler,
+		},
+		{
+			MethodName: "SetContractRoles",
+			Handler:    _ChainRegistryService_SetContractRoles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chain-registry.proto",
@@ -0,0 +1,10 @@
+// Package proto holds the Go code generated from the service definitions in /proto.
+//
+// After changing a .proto file, regenerate with go generate ./shared/proto: buf generates
+// the code with the plugin versions pinned in proto/buf.gen.yaml, then protocheck refreshes
+// the API locks the services' tests verify. A breaking change stops the second step; accept
+// one on purpose with go run ./tools/protocheck -update -allow-breaking.
+package proto
+
+//go:generate buf generate ../../proto --template ../../proto/buf.gen.yaml --output ../..
+//go:generate go run ../../tools/protocheck -proto ../../proto -update
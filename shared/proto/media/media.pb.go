@@ -192,6 +192,64 @@ func (PinStatus) EnumDescriptor() ([]byte, []int) {
 	return file_media_proto_rawDescGZIP(), []int{2}
 }
 
+type UploadStage int32
+
+const (
+	UploadStage_UPLOAD_STAGE_UNSPECIFIED UploadStage = 0
+	UploadStage_UPLOAD_STAGE_UPLOADING   UploadStage = 1
+	UploadStage_UPLOAD_STAGE_PINNING     UploadStage = 2
+	UploadStage_UPLOAD_STAGE_PROCESSING  UploadStage = 3
+	UploadStage_UPLOAD_STAGE_DONE        UploadStage = 4
+	UploadStage_UPLOAD_STAGE_FAILED      UploadStage = 5
+)
+
+// Enum value maps for UploadStage.
+var (
+	UploadStage_name = map[int32]string{
+		0: "UPLOAD_STAGE_UNSPECIFIED",
+		1: "UPLOAD_STAGE_UPLOADING",
+		2: "UPLOAD_STAGE_PINNING",
+		3: "UPLOAD_STAGE_PROCESSING",
+		4: "UPLOAD_STAGE_DONE",
+		5: "UPLOAD_STAGE_FAILED",
+	}
+	UploadStage_value = map[string]int32{
+		"UPLOAD_STAGE_UNSPECIFIED": 0,
+		"UPLOAD_STAGE_UPLOADING":   1,
+		"UPLOAD_STAGE_PINNING":     2,
+		"UPLOAD_STAGE_PROCESSING":  3,
+		"UPLOAD_STAGE_DONE":        4,
+		"UPLOAD_STAGE_FAILED":      5,
+	}
+)
+
+func (x UploadStage) Enum() *UploadStage {
+	p := new(UploadStage)
+	*p = x
+	return p
+}
+
+func (x UploadStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UploadStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_media_proto_enumTypes[3].Descriptor()
+}
+
+func (UploadStage) Type() protoreflect.EnumType {
+	return &file_media_proto_enumTypes[3]
+}
+
+func (x UploadStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UploadStage.Descriptor instead.
+func (UploadStage) EnumDescriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{3}
+}
+
 type MediaVariant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -560,6 +618,340 @@ func (x *UploadAndPinResponse) GetDeduplicated() bool {
 	return false
 }
 
+// UploadStreamRequest streams a file: the first message carries meta, the rest chunks of data
+type UploadStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadStreamRequest_Meta
+	//	*UploadStreamRequest_Chunk
+	Payload       isUploadStreamRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadStreamRequest) Reset() {
+	*x = UploadStreamRequest{}
+	mi := &file_media_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadStreamRequest) ProtoMessage() {}
+
+func (x *UploadStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadStreamRequest.ProtoReflect.Descriptor instead.
+func (*UploadStreamRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UploadStreamRequest) GetPayload() isUploadStreamRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadStreamRequest) GetMeta() *UploadStreamMeta {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadStreamRequest_Meta); ok {
+			return x.Meta
+		}
+	}
+	return nil
+}
+
+func (x *UploadStreamRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadStreamRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadStreamRequest_Payload interface {
+	isUploadStreamRequest_Payload()
+}
+
+type UploadStreamRequest_Meta struct {
+	Meta *UploadStreamMeta `protobuf:"bytes,1,opt,name=meta,proto3,oneof"`
+}
+
+type UploadStreamRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadStreamRequest_Meta) isUploadStreamRequest_Payload() {}
+
+func (*UploadStreamRequest_Chunk) isUploadStreamRequest_Payload() {}
+
+type UploadStreamMeta struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Filename      string                  `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Mime          string                  `protobuf:"bytes,2,opt,name=mime,proto3" json:"mime,omitempty"`
+	Kind          MediaKind               `protobuf:"varint,3,opt,name=kind,proto3,enum=media.MediaKind" json:"kind,omitempty"`
+	Width         *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=width,proto3" json:"width,omitempty"`                                   // optional
+	Height        *wrapperspb.UInt32Value `protobuf:"bytes,5,opt,name=height,proto3" json:"height,omitempty"`                                 // optional
+	OwnerId       string                  `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                // optional (audit/link)
+	UploadTicket  string                  `protobuf:"bytes,7,opt,name=upload_ticket,json=uploadTicket,proto3" json:"upload_ticket,omitempty"` // optional, progress is also published under it
+	TotalBytes    uint64                  `protobuf:"varint,8,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`      // optional, lets progress report a percentage
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadStreamMeta) Reset() {
+	*x = UploadStreamMeta{}
+	mi := &file_media_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadStreamMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadStreamMeta) ProtoMessage() {}
+
+func (x *UploadStreamMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadStreamMeta.ProtoReflect.Descriptor instead.
+func (*UploadStreamMeta) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UploadStreamMeta) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadStreamMeta) GetMime() string {
+	if x != nil {
+		return x.Mime
+	}
+	return ""
+}
+
+func (x *UploadStreamMeta) GetKind() MediaKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MediaKind_MEDIA_KIND_UNSPECIFIED
+}
+
+func (x *UploadStreamMeta) GetWidth() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Width
+	}
+	return nil
+}
+
+func (x *UploadStreamMeta) GetHeight() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *UploadStreamMeta) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UploadStreamMeta) GetUploadTicket() string {
+	if x != nil {
+		return x.UploadTicket
+	}
+	return ""
+}
+
+func (x *UploadStreamMeta) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+type UploadProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadTicket  string                 `protobuf:"bytes,1,opt,name=upload_ticket,json=uploadTicket,proto3" json:"upload_ticket,omitempty"`
+	Stage         UploadStage            `protobuf:"varint,2,opt,name=stage,proto3,enum=media.UploadStage" json:"stage,omitempty"`
+	BytesReceived uint64                 `protobuf:"varint,3,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	TotalBytes    uint64                 `protobuf:"varint,4,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProgress) Reset() {
+	*x = UploadProgress{}
+	mi := &file_media_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProgress) ProtoMessage() {}
+
+func (x *UploadProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProgress.ProtoReflect.Descriptor instead.
+func (*UploadProgress) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UploadProgress) GetUploadTicket() string {
+	if x != nil {
+		return x.UploadTicket
+	}
+	return ""
+}
+
+func (x *UploadProgress) GetStage() UploadStage {
+	if x != nil {
+		return x.Stage
+	}
+	return UploadStage_UPLOAD_STAGE_UNSPECIFIED
+}
+
+func (x *UploadProgress) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *UploadProgress) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+// UploadStreamResponse is a progress report, or the result as the last message
+type UploadStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*UploadStreamResponse_Progress
+	//	*UploadStreamResponse_Result
+	Event         isUploadStreamResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadStreamResponse) Reset() {
+	*x = UploadStreamResponse{}
+	mi := &file_media_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadStreamResponse) ProtoMessage() {}
+
+func (x *UploadStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadStreamResponse.ProtoReflect.Descriptor instead.
+func (*UploadStreamResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UploadStreamResponse) GetEvent() isUploadStreamResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UploadStreamResponse) GetProgress() *UploadProgress {
+	if x != nil {
+		if x, ok := x.Event.(*UploadStreamResponse_Progress); ok {
+			return x.Progress
+		}
+	}
+	return nil
+}
+
+func (x *UploadStreamResponse) GetResult() *UploadAndPinResponse {
+	if x != nil {
+		if x, ok := x.Event.(*UploadStreamResponse_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+type isUploadStreamResponse_Event interface {
+	isUploadStreamResponse_Event()
+}
+
+type UploadStreamResponse_Progress struct {
+	Progress *UploadProgress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type UploadStreamResponse_Result struct {
+	Result *UploadAndPinResponse `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*UploadStreamResponse_Progress) isUploadStreamResponse_Event() {}
+
+func (*UploadStreamResponse_Result) isUploadStreamResponse_Event() {}
+
 type GetAssetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -569,7 +961,7 @@ type GetAssetRequest struct {
 
 func (x *GetAssetRequest) Reset() {
 	*x = GetAssetRequest{}
-	mi := &file_media_proto_msgTypes[4]
+	mi := &file_media_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +973,7 @@ func (x *GetAssetRequest) String() string {
 func (*GetAssetRequest) ProtoMessage() {}
 
 func (x *GetAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[4]
+	mi := &file_media_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +986,7 @@ func (x *GetAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssetRequest.ProtoReflect.Descriptor instead.
 func (*GetAssetRequest) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{4}
+	return file_media_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetAssetRequest) GetId() string {
@@ -613,7 +1005,7 @@ type GetAssetByCidRequest struct {
 
 func (x *GetAssetByCidRequest) Reset() {
 	*x = GetAssetByCidRequest{}
-	mi := &file_media_proto_msgTypes[5]
+	mi := &file_media_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -625,7 +1017,7 @@ func (x *GetAssetByCidRequest) String() string {
 func (*GetAssetByCidRequest) ProtoMessage() {}
 
 func (x *GetAssetByCidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[5]
+	mi := &file_media_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +1030,7 @@ func (x *GetAssetByCidRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssetByCidRequest.ProtoReflect.Descriptor instead.
 func (*GetAssetByCidRequest) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{5}
+	return file_media_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetAssetByCidRequest) GetCid() string {
@@ -657,7 +1049,7 @@ type GetAssetResponse struct {
 
 func (x *GetAssetResponse) Reset() {
 	*x = GetAssetResponse{}
-	mi := &file_media_proto_msgTypes[6]
+	mi := &file_media_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +1061,7 @@ func (x *GetAssetResponse) String() string {
 func (*GetAssetResponse) ProtoMessage() {}
 
 func (x *GetAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[6]
+	mi := &file_media_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,12 +1074,1156 @@ func (x *GetAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssetResponse.ProtoReflect.Descriptor instead.
 func (*GetAssetResponse) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{6}
+	return file_media_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+// Refs are named by their holder (e.g. "intent:<id>") so retried calls count once
+type AddRefRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Ref           string                 `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRefRequest) Reset() {
+	*x = AddRefRequest{}
+	mi := &file_media_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRefRequest) ProtoMessage() {}
+
+func (x *AddRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRefRequest.ProtoReflect.Descriptor instead.
+func (*AddRefRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddRefRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *AddRefRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+type AddRefResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRefResponse) Reset() {
+	*x = AddRefResponse{}
+	mi := &file_media_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRefResponse) ProtoMessage() {}
+
+func (x *AddRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRefResponse.ProtoReflect.Descriptor instead.
+func (*AddRefResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddRefResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type ReleaseRefRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Ref           string                 `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseRefRequest) Reset() {
+	*x = ReleaseRefRequest{}
+	mi := &file_media_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRefRequest) ProtoMessage() {}
+
+func (x *ReleaseRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRefRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseRefRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReleaseRefRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *ReleaseRefRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+type ReleaseRefResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Released      bool                   `protobuf:"varint,2,opt,name=released,proto3" json:"released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseRefResponse) Reset() {
+	*x = ReleaseRefResponse{}
+	mi := &file_media_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRefResponse) ProtoMessage() {}
+
+func (x *ReleaseRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRefResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseRefResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReleaseRefResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *ReleaseRefResponse) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+// The uploader gives the asset up; it stops counting against their storage quota
+type ReleaseAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseAssetRequest) Reset() {
+	*x = ReleaseAssetRequest{}
+	mi := &file_media_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAssetRequest) ProtoMessage() {}
+
+func (x *ReleaseAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAssetRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseAssetRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReleaseAssetRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *ReleaseAssetRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type ReleaseAssetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Released      bool                   `protobuf:"varint,2,opt,name=released,proto3" json:"released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseAssetResponse) Reset() {
+	*x = ReleaseAssetResponse{}
+	mi := &file_media_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAssetResponse) ProtoMessage() {}
+
+func (x *ReleaseAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAssetResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseAssetResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReleaseAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *ReleaseAssetResponse) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+// StorageLimits of a quota; 0 = unlimited
+type StorageLimits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bytes         uint64                 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Assets        uint64                 `protobuf:"varint,2,opt,name=assets,proto3" json:"assets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageLimits) Reset() {
+	*x = StorageLimits{}
+	mi := &file_media_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageLimits) ProtoMessage() {}
+
+func (x *StorageLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageLimits.ProtoReflect.Descriptor instead.
+func (*StorageLimits) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StorageLimits) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *StorageLimits) GetAssets() uint64 {
+	if x != nil {
+		return x.Assets
+	}
+	return 0
+}
+
+type KindStorageUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          MediaKind              `protobuf:"varint,1,opt,name=kind,proto3,enum=media.MediaKind" json:"kind,omitempty"`
+	Bytes         uint64                 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Assets        uint64                 `protobuf:"varint,3,opt,name=assets,proto3" json:"assets,omitempty"`
+	HardLimit     *StorageLimits         `protobuf:"bytes,4,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"` // limit of this file type; unset when there is none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KindStorageUsage) Reset() {
+	*x = KindStorageUsage{}
+	mi := &file_media_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KindStorageUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KindStorageUsage) ProtoMessage() {}
+
+func (x *KindStorageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KindStorageUsage.ProtoReflect.Descriptor instead.
+func (*KindStorageUsage) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *KindStorageUsage) GetKind() MediaKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MediaKind_MEDIA_KIND_UNSPECIFIED
+}
+
+func (x *KindStorageUsage) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *KindStorageUsage) GetAssets() uint64 {
+	if x != nil {
+		return x.Assets
+	}
+	return 0
+}
+
+func (x *KindStorageUsage) GetHardLimit() *StorageLimits {
+	if x != nil {
+		return x.HardLimit
+	}
+	return nil
+}
+
+type GetStorageUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageRequest) Reset() {
+	*x = GetStorageUsageRequest{}
+	mi := &file_media_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageRequest) ProtoMessage() {}
+
+func (x *GetStorageUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStorageUsageRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type GetStorageUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bytes         uint64                 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Assets        uint64                 `protobuf:"varint,2,opt,name=assets,proto3" json:"assets,omitempty"`
+	Kinds         []*KindStorageUsage    `protobuf:"bytes,3,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	SoftLimit     *StorageLimits         `protobuf:"bytes,4,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"` // crossing it only notifies
+	HardLimit     *StorageLimits         `protobuf:"bytes,5,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"` // uploads over it fail with RESOURCE_EXHAUSTED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageResponse) Reset() {
+	*x = GetStorageUsageResponse{}
+	mi := &file_media_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageResponse) ProtoMessage() {}
+
+func (x *GetStorageUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetStorageUsageResponse) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetAssets() uint64 {
+	if x != nil {
+		return x.Assets
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetKinds() []*KindStorageUsage {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *GetStorageUsageResponse) GetSoftLimit() *StorageLimits {
+	if x != nil {
+		return x.SoftLimit
+	}
+	return nil
+}
+
+func (x *GetStorageUsageResponse) GetHardLimit() *StorageLimits {
+	if x != nil {
+		return x.HardLimit
+	}
+	return nil
+}
+
+type GetPinHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPinHealthRequest) Reset() {
+	*x = GetPinHealthRequest{}
+	mi := &file_media_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPinHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinHealthRequest) ProtoMessage() {}
+
+func (x *GetPinHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetPinHealthRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{21}
+}
+
+type GetPinHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastPinnedAt  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_pinned_at,json=lastPinnedAt,proto3" json:"last_pinned_at,omitempty"` // unset until an upload was pinned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPinHealthResponse) Reset() {
+	*x = GetPinHealthResponse{}
+	mi := &file_media_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPinHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPinHealthResponse) ProtoMessage() {}
+
+func (x *GetPinHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPinHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetPinHealthResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPinHealthResponse) GetLastPinnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPinnedAt
+	}
+	return nil
+}
+
+// Artifacts are generated files (e.g. exports) downloaded through signed, expiring URLs
+type Artifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Mime          string                 `protobuf:"bytes,3,opt,name=mime,proto3" json:"mime,omitempty"`
+	Bytes         uint64                 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Sha256        string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,8,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	UrlExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"`
+	Gate          *TokenGate             `protobuf:"bytes,10,opt,name=gate,proto3" json:"gate,omitempty"` // unset = the signed URL alone downloads it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
+	mi := &file_media_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Artifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifact) ProtoMessage() {}
+
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Artifact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Artifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Artifact) GetMime() string {
+	if x != nil {
+		return x.Mime
+	}
+	return ""
+}
+
+func (x *Artifact) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Artifact) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Artifact) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Artifact) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Artifact) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *Artifact) GetUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return nil
+}
+
+func (x *Artifact) GetGate() *TokenGate {
+	if x != nil {
+		return x.Gate
+	}
+	return nil
+}
+
+// TokenGate restricts downloads to holders of min_balance of a collection, proven with a gate
+// token from the catalog
+type TokenGate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // CAIP-2
+	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	MinBalance    string                 `protobuf:"bytes,3,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"` // decimal; empty = 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenGate) Reset() {
+	*x = TokenGate{}
+	mi := &file_media_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenGate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenGate) ProtoMessage() {}
+
+func (x *TokenGate) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetAssetResponse) GetAsset() *Asset {
+// Deprecated: Use TokenGate.ProtoReflect.Descriptor instead.
+func (*TokenGate) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TokenGate) GetChainId() string {
 	if x != nil {
-		return x.Asset
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *TokenGate) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *TokenGate) GetMinBalance() string {
+	if x != nil {
+		return x.MinBalance
+	}
+	return ""
+}
+
+type StoreArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Mime          string                 `protobuf:"bytes,2,opt,name=mime,proto3" json:"mime,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`           // optional (audit)
+	TtlSeconds    uint32                 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 0 = default retention
+	Gate          *TokenGate             `protobuf:"bytes,6,opt,name=gate,proto3" json:"gate,omitempty"`                                // optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreArtifactRequest) Reset() {
+	*x = StoreArtifactRequest{}
+	mi := &file_media_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreArtifactRequest) ProtoMessage() {}
+
+func (x *StoreArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreArtifactRequest.ProtoReflect.Descriptor instead.
+func (*StoreArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StoreArtifactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoreArtifactRequest) GetMime() string {
+	if x != nil {
+		return x.Mime
+	}
+	return ""
+}
+
+func (x *StoreArtifactRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *StoreArtifactRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *StoreArtifactRequest) GetTtlSeconds() uint32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *StoreArtifactRequest) GetGate() *TokenGate {
+	if x != nil {
+		return x.Gate
+	}
+	return nil
+}
+
+type StoreArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifact      *Artifact              `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreArtifactResponse) Reset() {
+	*x = StoreArtifactResponse{}
+	mi := &file_media_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreArtifactResponse) ProtoMessage() {}
+
+func (x *StoreArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreArtifactResponse.ProtoReflect.Descriptor instead.
+func (*StoreArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StoreArtifactResponse) GetArtifact() *Artifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+// GetArtifact signs a fresh download URL
+type GetArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArtifactRequest) Reset() {
+	*x = GetArtifactRequest{}
+	mi := &file_media_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtifactRequest) ProtoMessage() {}
+
+func (x *GetArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtifactRequest.ProtoReflect.Descriptor instead.
+func (*GetArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetArtifactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifact      *Artifact              `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArtifactResponse) Reset() {
+	*x = GetArtifactResponse{}
+	mi := &file_media_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtifactResponse) ProtoMessage() {}
+
+func (x *GetArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtifactResponse.ProtoReflect.Descriptor instead.
+func (*GetArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetArtifactResponse) GetArtifact() *Artifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+// DownloadArtifact takes the query of a signed download URL
+type DownloadArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Expires       int64                  `protobuf:"varint,2,opt,name=expires,proto3" json:"expires,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	GateToken     string                 `protobuf:"bytes,4,opt,name=gate_token,json=gateToken,proto3" json:"gate_token,omitempty"` // required for gated artifacts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadArtifactRequest) Reset() {
+	*x = DownloadArtifactRequest{}
+	mi := &file_media_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadArtifactRequest) ProtoMessage() {}
+
+func (x *DownloadArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadArtifactRequest.ProtoReflect.Descriptor instead.
+func (*DownloadArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DownloadArtifactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DownloadArtifactRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *DownloadArtifactRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DownloadArtifactRequest) GetGateToken() string {
+	if x != nil {
+		return x.GateToken
+	}
+	return ""
+}
+
+type DownloadArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifact      *Artifact              `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadArtifactResponse) Reset() {
+	*x = DownloadArtifactResponse{}
+	mi := &file_media_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadArtifactResponse) ProtoMessage() {}
+
+func (x *DownloadArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadArtifactResponse.ProtoReflect.Descriptor instead.
+func (*DownloadArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DownloadArtifactResponse) GetArtifact() *Artifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *DownloadArtifactResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
 	}
 	return nil
 }
@@ -732,13 +2268,118 @@ const file_media_proto_rawDesc = "" +
 	"\bowner_id\x18\a \x01(\tR\aownerId\"^\n" +
 	"\x14UploadAndPinResponse\x12\"\n" +
 	"\x05asset\x18\x01 \x01(\v2\f.media.AssetR\x05asset\x12\"\n" +
-	"\fdeduplicated\x18\x02 \x01(\bR\fdeduplicated\"!\n" +
+	"\fdeduplicated\x18\x02 \x01(\bR\fdeduplicated\"g\n" +
+	"\x13UploadStreamRequest\x12-\n" +
+	"\x04meta\x18\x01 \x01(\v2\x17.media.UploadStreamMetaH\x00R\x04meta\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xb3\x02\n" +
+	"\x10UploadStreamMeta\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04mime\x18\x02 \x01(\tR\x04mime\x12$\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x10.media.MediaKindR\x04kind\x122\n" +
+	"\x05width\x18\x04 \x01(\v2\x1c.google.protobuf.UInt32ValueR\x05width\x124\n" +
+	"\x06height\x18\x05 \x01(\v2\x1c.google.protobuf.UInt32ValueR\x06height\x12\x19\n" +
+	"\bowner_id\x18\x06 \x01(\tR\aownerId\x12#\n" +
+	"\rupload_ticket\x18\a \x01(\tR\fuploadTicket\x12\x1f\n" +
+	"\vtotal_bytes\x18\b \x01(\x04R\n" +
+	"totalBytes\"\xa7\x01\n" +
+	"\x0eUploadProgress\x12#\n" +
+	"\rupload_ticket\x18\x01 \x01(\tR\fuploadTicket\x12(\n" +
+	"\x05stage\x18\x02 \x01(\x0e2\x12.media.UploadStageR\x05stage\x12%\n" +
+	"\x0ebytes_received\x18\x03 \x01(\x04R\rbytesReceived\x12\x1f\n" +
+	"\vtotal_bytes\x18\x04 \x01(\x04R\n" +
+	"totalBytes\"\x8b\x01\n" +
+	"\x14UploadStreamResponse\x123\n" +
+	"\bprogress\x18\x01 \x01(\v2\x15.media.UploadProgressH\x00R\bprogress\x125\n" +
+	"\x06result\x18\x02 \x01(\v2\x1b.media.UploadAndPinResponseH\x00R\x06resultB\a\n" +
+	"\x05event\"!\n" +
 	"\x0fGetAssetRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
 	"\x14GetAssetByCidRequest\x12\x10\n" +
 	"\x03cid\x18\x01 \x01(\tR\x03cid\"6\n" +
 	"\x10GetAssetResponse\x12\"\n" +
-	"\x05asset\x18\x01 \x01(\v2\f.media.AssetR\x05asset*S\n" +
+	"\x05asset\x18\x01 \x01(\v2\f.media.AssetR\x05asset\"<\n" +
+	"\rAddRefRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId\x12\x10\n" +
+	"\x03ref\x18\x02 \x01(\tR\x03ref\"4\n" +
+	"\x0eAddRefResponse\x12\"\n" +
+	"\x05asset\x18\x01 \x01(\v2\f.media.AssetR\x05asset\"@\n" +
+	"\x11ReleaseRefRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId\x12\x10\n" +
+	"\x03ref\x18\x02 \x01(\tR\x03ref\"T\n" +
+	"\x12ReleaseRefResponse\x12\"\n" +
+	"\x05asset\x18\x01 \x01(\v2\f.media.AssetR\x05asset\x12\x1a\n" +
+	"\breleased\x18\x02 \x01(\bR\breleased\"K\n" +
+	"\x13ReleaseAssetRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"V\n" +
+	"\x14ReleaseAssetResponse\x12\"\n" +
+	"\x05asset\x18\x01 \x01(\v2\f.media.AssetR\x05asset\x12\x1a\n" +
+	"\breleased\x18\x02 \x01(\bR\breleased\"=\n" +
+	"\rStorageLimits\x12\x14\n" +
+	"\x05bytes\x18\x01 \x01(\x04R\x05bytes\x12\x16\n" +
+	"\x06assets\x18\x02 \x01(\x04R\x06assets\"\x9b\x01\n" +
+	"\x10KindStorageUsage\x12$\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x10.media.MediaKindR\x04kind\x12\x14\n" +
+	"\x05bytes\x18\x02 \x01(\x04R\x05bytes\x12\x16\n" +
+	"\x06assets\x18\x03 \x01(\x04R\x06assets\x123\n" +
+	"\n" +
+	"hard_limit\x18\x04 \x01(\v2\x14.media.StorageLimitsR\thardLimit\"3\n" +
+	"\x16GetStorageUsageRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\"\xe0\x01\n" +
+	"\x17GetStorageUsageResponse\x12\x14\n" +
+	"\x05bytes\x18\x01 \x01(\x04R\x05bytes\x12\x16\n" +
+	"\x06assets\x18\x02 \x01(\x04R\x06assets\x12-\n" +
+	"\x05kinds\x18\x03 \x03(\v2\x17.media.KindStorageUsageR\x05kinds\x123\n" +
+	"\n" +
+	"soft_limit\x18\x04 \x01(\v2\x14.media.StorageLimitsR\tsoftLimit\x123\n" +
+	"\n" +
+	"hard_limit\x18\x05 \x01(\v2\x14.media.StorageLimitsR\thardLimit\"\x15\n" +
+	"\x13GetPinHealthRequest\"X\n" +
+	"\x14GetPinHealthResponse\x12@\n" +
+	"\x0elast_pinned_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\flastPinnedAt\"\xf1\x02\n" +
+	"\bArtifact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04mime\x18\x03 \x01(\tR\x04mime\x12\x14\n" +
+	"\x05bytes\x18\x04 \x01(\x04R\x05bytes\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12!\n" +
+	"\fdownload_url\x18\b \x01(\tR\vdownloadUrl\x12@\n" +
+	"\x0eurl_expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\furlExpiresAt\x12$\n" +
+	"\x04gate\x18\n" +
+	" \x01(\v2\x10.media.TokenGateR\x04gate\"c\n" +
+	"\tTokenGate\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x1f\n" +
+	"\vmin_balance\x18\x03 \x01(\tR\n" +
+	"minBalance\"\xba\x01\n" +
+	"\x14StoreArtifactRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04mime\x18\x02 \x01(\tR\x04mime\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\x12\x1f\n" +
+	"\vttl_seconds\x18\x05 \x01(\rR\n" +
+	"ttlSeconds\x12$\n" +
+	"\x04gate\x18\x06 \x01(\v2\x10.media.TokenGateR\x04gate\"D\n" +
+	"\x15StoreArtifactResponse\x12+\n" +
+	"\bartifact\x18\x01 \x01(\v2\x0f.media.ArtifactR\bartifact\"$\n" +
+	"\x12GetArtifactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x13GetArtifactResponse\x12+\n" +
+	"\bartifact\x18\x01 \x01(\v2\x0f.media.ArtifactR\bartifact\"\x80\x01\n" +
+	"\x17DownloadArtifactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aexpires\x18\x02 \x01(\x03R\aexpires\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"gate_token\x18\x04 \x01(\tR\tgateToken\"a\n" +
+	"\x18DownloadArtifactResponse\x12+\n" +
+	"\bartifact\x18\x01 \x01(\v2\x0f.media.ArtifactR\bartifact\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent*S\n" +
 	"\tMediaKind\x12\x1a\n" +
 	"\x16MEDIA_KIND_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05IMAGE\x10\x01\x12\t\n" +
@@ -760,11 +2401,28 @@ const file_media_proto_rawDesc = "" +
 	"\n" +
 	"\x06PINNED\x10\x03\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\x042\xdf\x01\n" +
+	"\x06FAILED\x10\x04*\xae\x01\n" +
+	"\vUploadStage\x12\x1c\n" +
+	"\x18UPLOAD_STAGE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16UPLOAD_STAGE_UPLOADING\x10\x01\x12\x18\n" +
+	"\x14UPLOAD_STAGE_PINNING\x10\x02\x12\x1b\n" +
+	"\x17UPLOAD_STAGE_PROCESSING\x10\x03\x12\x15\n" +
+	"\x11UPLOAD_STAGE_DONE\x10\x04\x12\x17\n" +
+	"\x13UPLOAD_STAGE_FAILED\x10\x052\xf5\x06\n" +
 	"\fMediaService\x12K\n" +
-	"\x10UploadSingleFile\x12\x1a.media.SingleUploadRequest\x1a\x1b.media.UploadAndPinResponse\x12;\n" +
+	"\x10UploadSingleFile\x12\x1a.media.SingleUploadRequest\x1a\x1b.media.UploadAndPinResponse\x12O\n" +
+	"\x10UploadFileStream\x12\x1a.media.UploadStreamRequest\x1a\x1b.media.UploadStreamResponse(\x010\x01\x12;\n" +
 	"\bGetAsset\x12\x16.media.GetAssetRequest\x1a\x17.media.GetAssetResponse\x12E\n" +
-	"\rGetAssetByCid\x12\x1b.media.GetAssetByCidRequest\x1a\x17.media.GetAssetResponseB\x1aZ\x18shared/proto/media;mediab\x06proto3"
+	"\rGetAssetByCid\x12\x1b.media.GetAssetByCidRequest\x1a\x17.media.GetAssetResponse\x125\n" +
+	"\x06AddRef\x12\x14.media.AddRefRequest\x1a\x15.media.AddRefResponse\x12A\n" +
+	"\n" +
+	"ReleaseRef\x12\x18.media.ReleaseRefRequest\x1a\x19.media.ReleaseRefResponse\x12G\n" +
+	"\fReleaseAsset\x12\x1a.media.ReleaseAssetRequest\x1a\x1b.media.ReleaseAssetResponse\x12P\n" +
+	"\x0fGetStorageUsage\x12\x1d.media.GetStorageUsageRequest\x1a\x1e.media.GetStorageUsageResponse\x12J\n" +
+	"\rStoreArtifact\x12\x1b.media.StoreArtifactRequest\x1a\x1c.media.StoreArtifactResponse\x12D\n" +
+	"\vGetArtifact\x12\x19.media.GetArtifactRequest\x1a\x1a.media.GetArtifactResponse\x12S\n" +
+	"\x10DownloadArtifact\x12\x1e.media.DownloadArtifactRequest\x1a\x1f.media.DownloadArtifactResponse\x12G\n" +
+	"\fGetPinHealth\x12\x1a.media.GetPinHealthRequest\x1a\x1b.media.GetPinHealthResponseB\x1aZ\x18shared/proto/media;mediab\x06proto3"
 
 var (
 	file_media_proto_rawDescOnce sync.Once
@@ -778,49 +2436,116 @@ func file_media_proto_rawDescGZIP() []byte {
 	return file_media_proto_rawDescData
 }
 
-var file_media_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_media_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_media_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_media_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_media_proto_goTypes = []any{
-	(MediaKind)(0),                 // 0: media.MediaKind
-	(VariantFormat)(0),             // 1: media.VariantFormat
-	(PinStatus)(0),                 // 2: media.PinStatus
-	(*MediaVariant)(nil),           // 3: media.MediaVariant
-	(*Asset)(nil),                  // 4: media.Asset
-	(*SingleUploadRequest)(nil),    // 5: media.SingleUploadRequest
-	(*UploadAndPinResponse)(nil),   // 6: media.UploadAndPinResponse
-	(*GetAssetRequest)(nil),        // 7: media.GetAssetRequest
-	(*GetAssetByCidRequest)(nil),   // 8: media.GetAssetByCidRequest
-	(*GetAssetResponse)(nil),       // 9: media.GetAssetResponse
-	(*wrapperspb.UInt32Value)(nil), // 10: google.protobuf.UInt32Value
-	(*wrapperspb.StringValue)(nil), // 11: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+	(MediaKind)(0),                   // 0: media.MediaKind
+	(VariantFormat)(0),               // 1: media.VariantFormat
+	(PinStatus)(0),                   // 2: media.PinStatus
+	(UploadStage)(0),                 // 3: media.UploadStage
+	(*MediaVariant)(nil),             // 4: media.MediaVariant
+	(*Asset)(nil),                    // 5: media.Asset
+	(*SingleUploadRequest)(nil),      // 6: media.SingleUploadRequest
+	(*UploadAndPinResponse)(nil),     // 7: media.UploadAndPinResponse
+	(*UploadStreamRequest)(nil),      // 8: media.UploadStreamRequest
+	(*UploadStreamMeta)(nil),         // 9: media.UploadStreamMeta
+	(*UploadProgress)(nil),           // 10: media.UploadProgress
+	(*UploadStreamResponse)(nil),     // 11: media.UploadStreamResponse
+	(*GetAssetRequest)(nil),          // 12: media.GetAssetRequest
+	(*GetAssetByCidRequest)(nil),     // 13: media.GetAssetByCidRequest
+	(*GetAssetResponse)(nil),         // 14: media.GetAssetResponse
+	(*AddRefRequest)(nil),            // 15: media.AddRefRequest
+	(*AddRefResponse)(nil),           // 16: media.AddRefResponse
+	(*ReleaseRefRequest)(nil),        // 17: media.ReleaseRefRequest
+	(*ReleaseRefResponse)(nil),       // 18: media.ReleaseRefResponse
+	(*ReleaseAssetRequest)(nil),      // 19: media.ReleaseAssetRequest
+	(*ReleaseAssetResponse)(nil),     // 20: media.ReleaseAssetResponse
+	(*StorageLimits)(nil),            // 21: media.StorageLimits
+	(*KindStorageUsage)(nil),         // 22: media.KindStorageUsage
+	(*GetStorageUsageRequest)(nil),   // 23: media.GetStorageUsageRequest
+	(*GetStorageUsageResponse)(nil),  // 24: media.GetStorageUsageResponse
+	(*GetPinHealthRequest)(nil),      // 25: media.GetPinHealthRequest
+	(*GetPinHealthResponse)(nil),     // 26: media.GetPinHealthResponse
+	(*Artifact)(nil),                 // 27: media.Artifact
+	(*TokenGate)(nil),                // 28: media.TokenGate
+	(*StoreArtifactRequest)(nil),     // 29: media.StoreArtifactRequest
+	(*StoreArtifactResponse)(nil),    // 30: media.StoreArtifactResponse
+	(*GetArtifactRequest)(nil),       // 31: media.GetArtifactRequest
+	(*GetArtifactResponse)(nil),      // 32: media.GetArtifactResponse
+	(*DownloadArtifactRequest)(nil),  // 33: media.DownloadArtifactRequest
+	(*DownloadArtifactResponse)(nil), // 34: media.DownloadArtifactResponse
+	(*wrapperspb.UInt32Value)(nil),   // 35: google.protobuf.UInt32Value
+	(*wrapperspb.StringValue)(nil),   // 36: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),    // 37: google.protobuf.Timestamp
 }
 var file_media_proto_depIdxs = []int32{
 	1,  // 0: media.MediaVariant.format:type_name -> media.VariantFormat
 	0,  // 1: media.Asset.kind:type_name -> media.MediaKind
-	10, // 2: media.Asset.width:type_name -> google.protobuf.UInt32Value
-	10, // 3: media.Asset.height:type_name -> google.protobuf.UInt32Value
-	11, // 4: media.Asset.ipfs_cid:type_name -> google.protobuf.StringValue
+	35, // 2: media.Asset.width:type_name -> google.protobuf.UInt32Value
+	35, // 3: media.Asset.height:type_name -> google.protobuf.UInt32Value
+	36, // 4: media.Asset.ipfs_cid:type_name -> google.protobuf.StringValue
 	2,  // 5: media.Asset.pin_status:type_name -> media.PinStatus
-	12, // 6: media.Asset.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 7: media.Asset.variants:type_name -> media.MediaVariant
-	11, // 8: media.Asset.gateway_url:type_name -> google.protobuf.StringValue
+	37, // 6: media.Asset.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 7: media.Asset.variants:type_name -> media.MediaVariant
+	36, // 8: media.Asset.gateway_url:type_name -> google.protobuf.StringValue
 	0,  // 9: media.SingleUploadRequest.kind:type_name -> media.MediaKind
-	10, // 10: media.SingleUploadRequest.width:type_name -> google.protobuf.UInt32Value
-	10, // 11: media.SingleUploadRequest.height:type_name -> google.protobuf.UInt32Value
-	4,  // 12: media.UploadAndPinResponse.asset:type_name -> media.Asset
-	4,  // 13: media.GetAssetResponse.asset:type_name -> media.Asset
-	5,  // 14: media.MediaService.UploadSingleFile:input_type -> media.SingleUploadRequest
-	7,  // 15: media.MediaService.GetAsset:input_type -> media.GetAssetRequest
-	8,  // 16: media.MediaService.GetAssetByCid:input_type -> media.GetAssetByCidRequest
-	6,  // 17: media.MediaService.UploadSingleFile:output_type -> media.UploadAndPinResponse
-	9,  // 18: media.MediaService.GetAsset:output_type -> media.GetAssetResponse
-	9,  // 19: media.MediaService.GetAssetByCid:output_type -> media.GetAssetResponse
-	17, // [17:20] is the sub-list for method output_type
-	14, // [14:17] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	35, // 10: media.SingleUploadRequest.width:type_name -> google.protobuf.UInt32Value
+	35, // 11: media.SingleUploadRequest.height:type_name -> google.protobuf.UInt32Value
+	5,  // 12: media.UploadAndPinResponse.asset:type_name -> media.Asset
+	9,  // 13: media.UploadStreamRequest.meta:type_name -> media.UploadStreamMeta
+	0,  // 14: media.UploadStreamMeta.kind:type_name -> media.MediaKind
+	35, // 15: media.UploadStreamMeta.width:type_name -> google.protobuf.UInt32Value
+	35, // 16: media.UploadStreamMeta.height:type_name -> google.protobuf.UInt32Value
+	3,  // 17: media.UploadProgress.stage:type_name -> media.UploadStage
+	10, // 18: media.UploadStreamResponse.progress:type_name -> media.UploadProgress
+	7,  // 19: media.UploadStreamResponse.result:type_name -> media.UploadAndPinResponse
+	5,  // 20: media.GetAssetResponse.asset:type_name -> media.Asset
+	5,  // 21: media.AddRefResponse.asset:type_name -> media.Asset
+	5,  // 22: media.ReleaseRefResponse.asset:type_name -> media.Asset
+	5,  // 23: media.ReleaseAssetResponse.asset:type_name -> media.Asset
+	0,  // 24: media.KindStorageUsage.kind:type_name -> media.MediaKind
+	21, // 25: media.KindStorageUsage.hard_limit:type_name -> media.StorageLimits
+	22, // 26: media.GetStorageUsageResponse.kinds:type_name -> media.KindStorageUsage
+	21, // 27: media.GetStorageUsageResponse.soft_limit:type_name -> media.StorageLimits
+	21, // 28: media.GetStorageUsageResponse.hard_limit:type_name -> media.StorageLimits
+	37, // 29: media.GetPinHealthResponse.last_pinned_at:type_name -> google.protobuf.Timestamp
+	37, // 30: media.Artifact.created_at:type_name -> google.protobuf.Timestamp
+	37, // 31: media.Artifact.expires_at:type_name -> google.protobuf.Timestamp
+	37, // 32: media.Artifact.url_expires_at:type_name -> google.protobuf.Timestamp
+	28, // 33: media.Artifact.gate:type_name -> media.TokenGate
+	28, // 34: media.StoreArtifactRequest.gate:type_name -> media.TokenGate
+	27, // 35: media.StoreArtifactResponse.artifact:type_name -> media.Artifact
+	27, // 36: media.GetArtifactResponse.artifact:type_name -> media.Artifact
+	27, // 37: media.DownloadArtifactResponse.artifact:type_name -> media.Artifact
+	6,  // 38: media.MediaService.UploadSingleFile:input_type -> media.SingleUploadRequest
+	8,  // 39: media.MediaService.UploadFileStream:input_type -> media.UploadStreamRequest
+	12, // 40: media.MediaService.GetAsset:input_type -> media.GetAssetRequest
+	13, // 41: media.MediaService.GetAssetByCid:input_type -> media.GetAssetByCidRequest
+	15, // 42: media.MediaService.AddRef:input_type -> media.AddRefRequest
+	17, // 43: media.MediaService.ReleaseRef:input_type -> media.ReleaseRefRequest
+	19, // 44: media.MediaService.ReleaseAsset:input_type -> media.ReleaseAssetRequest
+	23, // 45: media.MediaService.GetStorageUsage:input_type -> media.GetStorageUsageRequest
+	29, // 46: media.MediaService.StoreArtifact:input_type -> media.StoreArtifactRequest
+	31, // 47: media.MediaService.GetArtifact:input_type -> media.GetArtifactRequest
+	33, // 48: media.MediaService.DownloadArtifact:input_type -> media.DownloadArtifactRequest
+	25, // 49: media.MediaService.GetPinHealth:input_type -> media.GetPinHealthRequest
+	7,  // 50: media.MediaService.UploadSingleFile:output_type -> media.UploadAndPinResponse
+	11, // 51: media.MediaService.UploadFileStream:output_type -> media.UploadStreamResponse
+	14, // 52: media.MediaService.GetAsset:output_type -> media.GetAssetResponse
+	14, // 53: media.MediaService.GetAssetByCid:output_type -> media.GetAssetResponse
+	16, // 54: media.MediaService.AddRef:output_type -> media.AddRefResponse
+	18, // 55: media.MediaService.ReleaseRef:output_type -> media.ReleaseRefResponse
+	20, // 56: media.MediaService.ReleaseAsset:output_type -> media.ReleaseAssetResponse
+	24, // 57: media.MediaService.GetStorageUsage:output_type -> media.GetStorageUsageResponse
+	30, // 58: media.MediaService.StoreArtifact:output_type -> media.StoreArtifactResponse
+	32, // 59: media.MediaService.GetArtifact:output_type -> media.GetArtifactResponse
+	34, // 60: media.MediaService.DownloadArtifact:output_type -> media.DownloadArtifactResponse
+	26, // 61: media.MediaService.GetPinHealth:output_type -> media.GetPinHealthResponse
+	50, // [50:62] is the sub-list for method output_type
+	38, // [38:50] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_media_proto_init() }
@@ -828,13 +2553,21 @@ func file_media_proto_init() {
 	if File_media_proto != nil {
 		return
 	}
+	file_media_proto_msgTypes[4].OneofWrappers = []any{
+		(*UploadStreamRequest_Meta)(nil),
+		(*UploadStreamRequest_Chunk)(nil),
+	}
+	file_media_proto_msgTypes[7].OneofWrappers = []any{
+		(*UploadStreamResponse_Progress)(nil),
+		(*UploadStreamResponse_Result)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_proto_rawDesc), len(file_media_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
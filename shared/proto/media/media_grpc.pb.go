@@ -21,8 +21,17 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	MediaService_UploadSingleFile_FullMethodName = "/media.MediaService/UploadSingleFile"
+	MediaService_UploadFileStream_FullMethodName = "/media.MediaService/UploadFileStream"
 	MediaService_GetAsset_FullMethodName         = "/media.MediaService/GetAsset"
 	MediaService_GetAssetByCid_FullMethodName    = "/media.MediaService/GetAssetByCid"
+	MediaService_AddRef_FullMethodName           = "/media.MediaService/AddRef"
+	MediaService_ReleaseRef_FullMethodName       = "/media.MediaService/ReleaseRef"
+	MediaService_ReleaseAsset_FullMethodName     = "/media.MediaService/ReleaseAsset"
+	MediaService_GetStorageUsage_FullMethodName  = "/media.MediaService/GetStorageUsage"
+	MediaService_StoreArtifact_FullMethodName    = "/media.MediaService/StoreArtifact"
+	MediaService_GetArtifact_FullMethodName      = "/media.MediaService/GetArtifact"
+	MediaService_DownloadArtifact_FullMethodName = "/media.MediaService/DownloadArtifact"
+	MediaService_GetPinHealth_FullMethodName     = "/media.MediaService/GetPinHealth"
 )
 
 // MediaServiceClient is the client API for MediaService service.
@@ -30,8 +39,21 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MediaServiceClient interface {
 	UploadSingleFile(ctx context.Context, in *SingleUploadRequest, opts ...grpc.CallOption) (*UploadAndPinResponse, error)
+	UploadFileStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[UploadStreamRequest, UploadStreamResponse], error)
 	GetAsset(ctx context.Context, in *GetAssetRequest, opts ...grpc.CallOption) (*GetAssetResponse, error)
 	GetAssetByCid(ctx context.Context, in *GetAssetByCidRequest, opts ...grpc.CallOption) (*GetAssetResponse, error)
+	// AddRef fails with FAILED_PRECONDITION until the asset is pinned
+	AddRef(ctx context.Context, in *AddRefRequest, opts ...grpc.CallOption) (*AddRefResponse, error)
+	ReleaseRef(ctx context.Context, in *ReleaseRefRequest, opts ...grpc.CallOption) (*ReleaseRefResponse, error)
+	ReleaseAsset(ctx context.Context, in *ReleaseAssetRequest, opts ...grpc.CallOption) (*ReleaseAssetResponse, error)
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error)
+	StoreArtifact(ctx context.Context, in *StoreArtifactRequest, opts ...grpc.CallOption) (*StoreArtifactResponse, error)
+	GetArtifact(ctx context.Context, in *GetArtifactRequest, opts ...grpc.CallOption) (*GetArtifactResponse, error)
+	// DownloadArtifact fails with PERMISSION_DENIED on a bad or expired signature, or a gated
+	// artifact without a gate token covering it
+	DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (*DownloadArtifactResponse, error)
+	// GetPinHealth reports when content was last pinned, for the platform status page
+	GetPinHealth(ctx context.Context, in *GetPinHealthRequest, opts ...grpc.CallOption) (*GetPinHealthResponse, error)
 }
 
 type mediaServiceClient struct {
@@ -52,6 +74,19 @@ func (c *mediaServiceClient) UploadSingleFile(ctx context.Context, in *SingleUpl
 	return out, nil
 }
 
+func (c *mediaServiceClient) UploadFileStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[UploadStreamRequest, UploadStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MediaService_ServiceDesc.Streams[0], MediaService_UploadFileStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadStreamRequest, UploadStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MediaService_UploadFileStreamClient = grpc.BidiStreamingClient[UploadStreamRequest, UploadStreamResponse]
+
 func (c *mediaServiceClient) GetAsset(ctx context.Context, in *GetAssetRequest, opts ...grpc.CallOption) (*GetAssetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAssetResponse)
@@ -72,13 +107,106 @@ func (c *mediaServiceClient) GetAssetByCid(ctx context.Context, in *GetAssetByCi
 	return out, nil
 }
 
+func (c *mediaServiceClient) AddRef(ctx context.Context, in *AddRefRequest, opts ...grpc.CallOption) (*AddRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddRefResponse)
+	err := c.cc.Invoke(ctx, MediaService_AddRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) ReleaseRef(ctx context.Context, in *ReleaseRefRequest, opts ...grpc.CallOption) (*ReleaseRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseRefResponse)
+	err := c.cc.Invoke(ctx, MediaService_ReleaseRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) ReleaseAsset(ctx context.Context, in *ReleaseAssetRequest, opts ...grpc.CallOption) (*ReleaseAssetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseAssetResponse)
+	err := c.cc.Invoke(ctx, MediaService_ReleaseAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, MediaService_GetStorageUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) StoreArtifact(ctx context.Context, in *StoreArtifactRequest, opts ...grpc.CallOption) (*StoreArtifactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StoreArtifactResponse)
+	err := c.cc.Invoke(ctx, MediaService_StoreArtifact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) GetArtifact(ctx context.Context, in *GetArtifactRequest, opts ...grpc.CallOption) (*GetArtifactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArtifactResponse)
+	err := c.cc.Invoke(ctx, MediaService_GetArtifact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (*DownloadArtifactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadArtifactResponse)
+	err := c.cc.Invoke(ctx, MediaService_DownloadArtifact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) GetPinHealth(ctx context.Context, in *GetPinHealthRequest, opts ...grpc.CallOption) (*GetPinHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPinHealthResponse)
+	err := c.cc.Invoke(ctx, MediaService_GetPinHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MediaServiceServer is the server API for MediaService service.
 // All implementations must embed UnimplementedMediaServiceServer
 // for forward compatibility.
 type MediaServiceServer interface {
 	UploadSingleFile(context.Context, *SingleUploadRequest) (*UploadAndPinResponse, error)
+	UploadFileStream(grpc.BidiStreamingServer[UploadStreamRequest, UploadStreamResponse]) error
 	GetAsset(context.Context, *GetAssetRequest) (*GetAssetResponse, error)
 	GetAssetByCid(context.Context, *GetAssetByCidRequest) (*GetAssetResponse, error)
+	// AddRef fails with FAILED_PRECONDITION until the asset is pinned
+	AddRef(context.Context, *AddRefRequest) (*AddRefResponse, error)
+	ReleaseRef(context.Context, *ReleaseRefRequest) (*ReleaseRefResponse, error)
+	ReleaseAsset(context.Context, *ReleaseAssetRequest) (*ReleaseAssetResponse, error)
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error)
+	StoreArtifact(context.Context, *StoreArtifactRequest) (*StoreArtifactResponse, error)
+	GetArtifact(context.Context, *GetArtifactRequest) (*GetArtifactResponse, error)
+	// DownloadArtifact fails with PERMISSION_DENIED on a bad or expired signature, or a gated
+	// artifact without a gate token covering it
+	DownloadArtifact(context.Context, *DownloadArtifactRequest) (*DownloadArtifactResponse, error)
+	// GetPinHealth reports when content was last pinned, for the platform status page
+	GetPinHealth(context.Context, *GetPinHealthRequest) (*GetPinHealthResponse, error)
 	mustEmbedUnimplementedMediaServiceServer()
 }
 
@@ -92,12 +220,39 @@ type UnimplementedMediaServiceServer struct{}
 func (UnimplementedMediaServiceServer) UploadSingleFile(context.Context, *SingleUploadRequest) (*UploadAndPinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadSingleFile not implemented")
 }
+func (UnimplementedMediaServiceServer) UploadFileStream(grpc.BidiStreamingServer[UploadStreamRequest, UploadStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFileStream not implemented")
+}
 func (UnimplementedMediaServiceServer) GetAsset(context.Context, *GetAssetRequest) (*GetAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAsset not implemented")
 }
 func (UnimplementedMediaServiceServer) GetAssetByCid(context.Context, *GetAssetByCidRequest) (*GetAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssetByCid not implemented")
 }
+func (UnimplementedMediaServiceServer) AddRef(context.Context, *AddRefRequest) (*AddRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRef not implemented")
+}
+func (UnimplementedMediaServiceServer) ReleaseRef(context.Context, *ReleaseRefRequest) (*ReleaseRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseRef not implemented")
+}
+func (UnimplementedMediaServiceServer) ReleaseAsset(context.Context, *ReleaseAssetRequest) (*ReleaseAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseAsset not implemented")
+}
+func (UnimplementedMediaServiceServer) GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (UnimplementedMediaServiceServer) StoreArtifact(context.Context, *StoreArtifactRequest) (*StoreArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreArtifact not implemented")
+}
+func (UnimplementedMediaServiceServer) GetArtifact(context.Context, *GetArtifactRequest) (*GetArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtifact not implemented")
+}
+func (UnimplementedMediaServiceServer) DownloadArtifact(context.Context, *DownloadArtifactRequest) (*DownloadArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadArtifact not implemented")
+}
+func (UnimplementedMediaServiceServer) GetPinHealth(context.Context, *GetPinHealthRequest) (*GetPinHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinHealth not implemented")
+}
 func (UnimplementedMediaServiceServer) mustEmbedUnimplementedMediaServiceServer() {}
 func (UnimplementedMediaServiceServer) testEmbeddedByValue()                      {}
 
@@ -137,6 +292,13 @@ func _MediaService_UploadSingleFile_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MediaService_UploadFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MediaServiceServer).UploadFileStream(&grpc.GenericServerStream[UploadStreamRequest, UploadStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MediaService_UploadFileStreamServer = grpc.BidiStreamingServer[UploadStreamRequest, UploadStreamResponse]
+
 func _MediaService_GetAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAssetRequest)
 	if err := dec(in); err != nil {
@@ -173,6 +335,150 @@ func _MediaService_GetAssetByCid_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MediaService_AddRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).AddRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_AddRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).AddRef(ctx, req.(*AddRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_ReleaseRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).ReleaseRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_ReleaseRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).ReleaseRef(ctx, req.(*ReleaseRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_ReleaseAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).ReleaseAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_ReleaseAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).ReleaseAsset(ctx, req.(*ReleaseAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_GetStorageUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_StoreArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).StoreArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_StoreArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).StoreArtifact(ctx, req.(*StoreArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_GetArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).GetArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_GetArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).GetArtifact(ctx, req.(*GetArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_DownloadArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).DownloadArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_DownloadArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).DownloadArtifact(ctx, req.(*DownloadArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_GetPinHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPinHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).GetPinHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_GetPinHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).GetPinHealth(ctx, req.(*GetPinHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MediaService_ServiceDesc is the grpc.ServiceDesc for MediaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,7 +498,46 @@ var MediaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAssetByCid",
 			Handler:    _MediaService_GetAssetByCid_Handler,
 		},
+		{
+			MethodName: "AddRef",
+			Handler:    _MediaService_AddRef_Handler,
+		},
+		{
+			MethodName: "ReleaseRef",
+			Handler:    _MediaService_ReleaseRef_Handler,
+		},
+		{
+			MethodName: "ReleaseAsset",
+			Handler:    _MediaService_ReleaseAsset_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _MediaService_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "StoreArtifact",
+			Handler:    _MediaService_StoreArtifact_Handler,
+		},
+		{
+			MethodName: "GetArtifact",
+			Handler:    _MediaService_GetArtifact_Handler,
+		},
+		{
+			MethodName: "DownloadArtifact",
+			Handler:    _MediaService_DownloadArtifact_Handler,
+		},
+		{
+			MethodName: "GetPinHealth",
+			Handler:    _MediaService_GetPinHealth_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFileStream",
+			Handler:       _MediaService_UploadFileStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "media.proto",
 }
@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -106,7 +107,10 @@ type PrepareCreateCollectionRequest struct {
 	AllowlistMintPrice     uint64                 `protobuf:"varint,12,opt,name=allowlist_mint_price,json=allowlistMintPrice,proto3" json:"allowlist_mint_price,omitempty"`
 	PublicMintPrice        uint64                 `protobuf:"varint,13,opt,name=public_mint_price,json=publicMintPrice,proto3" json:"public_mint_price,omitempty"`
 	AllowlistStageDuration uint64                 `protobuf:"varint,14,opt,name=allowlist_stage_duration,json=allowlistStageDuration,proto3" json:"allowlist_stage_duration,omitempty"`
-	Type                   string                 `protobuf:"bytes,15,opt,name=type,proto3" json:"type,omitempty"` // ERC721 or ERC1155 - specifies the collection type
+	Type                   string                 `protobuf:"bytes,15,opt,name=type,proto3" json:"type,omitempty"`                                     // ERC721 or ERC1155 - specifies the collection type
+	AssetIds               []string               `protobuf:"bytes,16,rep,name=asset_ids,json=assetIds,proto3" json:"asset_ids,omitempty"`             // pinned media assets the collection uses; held until the intent fails or expires
+	CallbackUrl            string                 `protobuf:"bytes,17,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`    // optional https webhook called once the collection confirms
+	PayoutSplits           []*PayoutSplit         `protobuf:"bytes,18,rep,name=payout_splits,json=payoutSplits,proto3" json:"payout_splits,omitempty"` // optional; needs a factory that deploys a splitter
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -246,17 +250,92 @@ func (x *PrepareCreateCollectionRequest) GetType() string {
 	return ""
 }
 
-type PrepareCreateCollectionResponse struct {
+func (x *PrepareCreateCollectionRequest) GetAssetIds() []string {
+	if x != nil {
+		return x.AssetIds
+	}
+	return nil
+}
+
+func (x *PrepareCreateCollectionRequest) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *PrepareCreateCollectionRequest) GetPayoutSplits() []*PayoutSplit {
+	if x != nil {
+		return x.PayoutSplits
+	}
+	return nil
+}
+
+// PayoutSplit is one recipient's share of a collection's payouts; a split adds up to 10000 bps
+type PayoutSplit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
-	Tx            *TxRequest             `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Bps           uint64                 `protobuf:"varint,2,opt,name=bps,proto3" json:"bps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *PayoutSplit) Reset() {
+	*x = PayoutSplit{}
+	mi := &file_orchestrator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutSplit) ProtoMessage() {}
+
+func (x *PayoutSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutSplit.ProtoReflect.Descriptor instead.
+func (*PayoutSplit) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PayoutSplit) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *PayoutSplit) GetBps() uint64 {
+	if x != nil {
+		return x.Bps
+	}
+	return 0
+}
+
+type PrepareCreateCollectionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IntentId       string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	Tx             *TxRequest             `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	CallbackSecret string                 `protobuf:"bytes,3,opt,name=callback_secret,json=callbackSecret,proto3" json:"callback_secret,omitempty"` // signs the callback_url webhooks; set only when one was given
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *PrepareCreateCollectionResponse) Reset() {
 	*x = PrepareCreateCollectionResponse{}
-	mi := &file_orchestrator_proto_msgTypes[2]
+	mi := &file_orchestrator_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -268,7 +347,7 @@ func (x *PrepareCreateCollectionResponse) String() string {
 func (*PrepareCreateCollectionResponse) ProtoMessage() {}
 
 func (x *PrepareCreateCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orchestrator_proto_msgTypes[2]
+	mi := &file_orchestrator_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +360,7 @@ func (x *PrepareCreateCollectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareCreateCollectionResponse.ProtoReflect.Descriptor instead.
 func (*PrepareCreateCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_orchestrator_proto_rawDescGZIP(), []int{2}
+	return file_orchestrator_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PrepareCreateCollectionResponse) GetIntentId() string {
@@ -298,20 +377,29 @@ func (x *PrepareCreateCollectionResponse) GetTx() *TxRequest {
 	return nil
 }
 
+func (x *PrepareCreateCollectionResponse) GetCallbackSecret() string {
+	if x != nil {
+		return x.CallbackSecret
+	}
+	return ""
+}
+
 type PrepareMintRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
 	Minter        string                 `protobuf:"bytes,3,opt,name=minter,proto3" json:"minter,omitempty"`
 	Standard      string                 `protobuf:"bytes,4,opt,name=standard,proto3" json:"standard,omitempty"`
-	Quantity      uint64                 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"` // ERC721: 1
+	Quantity      uint64                 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`             // ERC721: 1
+	TokenId       string                 `protobuf:"bytes,6,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"` // ERC1155 token id, decimal
+	Proof         []string               `protobuf:"bytes,7,rep,name=proof,proto3" json:"proof,omitempty"`                    // allowlist merkle proof, 0x-prefixed bytes32 each
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PrepareMintRequest) Reset() {
 	*x = PrepareMintRequest{}
-	mi := &file_orchestrator_proto_msgTypes[3]
+	mi := &file_orchestrator_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +411,7 @@ func (x *PrepareMintRequest) String() string {
 func (*PrepareMintRequest) ProtoMessage() {}
 
 func (x *PrepareMintRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orchestrator_proto_msgTypes[3]
+	mi := &file_orchestrator_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +424,7 @@ func (x *PrepareMintRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareMintRequest.ProtoReflect.Descriptor instead.
 func (*PrepareMintRequest) Descriptor() ([]byte, []int) {
-	return file_orchestrator_proto_rawDescGZIP(), []int{3}
+	return file_orchestrator_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PrepareMintRequest) GetChainId() string {
@@ -374,6 +462,20 @@ func (x *PrepareMintRequest) GetQuantity() uint64 {
 	return 0
 }
 
+func (x *PrepareMintRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *PrepareMintRequest) GetProof() []string {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 type PrepareMintResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
@@ -384,7 +486,7 @@ type PrepareMintResponse struct {
 
 func (x *PrepareMintResponse) Reset() {
 	*x = PrepareMintResponse{}
-	mi := &file_orchestrator_proto_msgTypes[4]
+	mi := &file_orchestrator_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +498,7 @@ func (x *PrepareMintResponse) String() string {
 func (*PrepareMintResponse) ProtoMessage() {}
 
 func (x *PrepareMintResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orchestrator_proto_msgTypes[4]
+	mi := &file_orchestrator_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +511,7 @@ func (x *PrepareMintResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareMintResponse.ProtoReflect.Descriptor instead.
 func (*PrepareMintResponse) Descriptor() ([]byte, []int) {
-	return file_orchestrator_proto_rawDescGZIP(), []int{4}
+	return file_orchestrator_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PrepareMintResponse) GetIntentId() string {
@@ -438,7 +540,7 @@ type TrackTxRequest struct {
 
 func (x *TrackTxRequest) Reset() {
 	*x = TrackTxRequest{}
-	mi := &file_orchestrator_proto_msgTypes[5]
+	mi := &file_orchestrator_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -450,7 +552,7 @@ func (x *TrackTxRequest) String() string {
 func (*TrackTxRequest) ProtoMessage() {}
 
 func (x *TrackTxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orchestrator_proto_msgTypes[5]
+	mi := &file_orchestrator_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +565,7 @@ func (x *TrackTxRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackTxRequest.ProtoReflect.Descriptor instead.
 func (*TrackTxRequest) Descriptor() ([]byte, []int) {
-	return file_orchestrator_proto_rawDescGZIP(), []int{5}
+	return file_orchestrator_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TrackTxRequest) GetIntentId() string {
@@ -503,7 +605,7 @@ type TrackTxResponse struct {
 
 func (x *TrackTxResponse) Reset() {
 	*x = TrackTxResponse{}
-	mi := &file_orchestrator_proto_msgTypes[6]
+	mi := &file_orchestrator_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +617,7 @@ func (x *TrackTxResponse) String() string {
 func (*TrackTxResponse) ProtoMessage() {}
 
 func (x *TrackTxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orchestrator_proto_msgTypes[6]
+	mi := &file_orchestrator_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +630,7 @@ func (x *TrackTxResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackTxResponse.ProtoReflect.Descriptor instead.
 func (*TrackTxResponse) Descriptor() ([]byte, []int) {
-	return file_orchestrator_proto_rawDescGZIP(), []int{6}
+	return file_orchestrator_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TrackTxResponse) GetOk() bool {
@@ -538,28 +640,33 @@ func (x *TrackTxResponse) GetOk() bool {
 	return false
 }
 
-type GetIntentStatusRequest struct {
+// Post-deploy collection management; user_id is the caller and must own the creator wallet
+type PrepareUpdateRoyaltyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Receiver      string                 `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	FeeBps        uint64                 `protobuf:"varint,5,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"` // basis points, max 10000
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIntentStatusRequest) Reset() {
-	*x = GetIntentStatusRequest{}
-	mi := &file_orchestrator_proto_msgTypes[7]
+func (x *PrepareUpdateRoyaltyRequest) Reset() {
+	*x = PrepareUpdateRoyaltyRequest{}
+	mi := &file_orchestrator_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIntentStatusRequest) String() string {
+func (x *PrepareUpdateRoyaltyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIntentStatusRequest) ProtoMessage() {}
+func (*PrepareUpdateRoyaltyRequest) ProtoMessage() {}
 
-func (x *GetIntentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orchestrator_proto_msgTypes[7]
+func (x *PrepareUpdateRoyaltyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,45 +677,71 @@ func (x *GetIntentStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIntentStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetIntentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_orchestrator_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PrepareUpdateRoyaltyRequest.ProtoReflect.Descriptor instead.
+func (*PrepareUpdateRoyaltyRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetIntentStatusRequest) GetIntentId() string {
+func (x *PrepareUpdateRoyaltyRequest) GetChainId() string {
 	if x != nil {
-		return x.IntentId
+		return x.ChainId
 	}
 	return ""
 }
 
-type GetIntentStatusResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	IntentId        string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
-	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending|ready|failed|expired
-	ChainId         string                 `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	TxHash          string                 `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	ContractAddress string                 `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *PrepareUpdateRoyaltyRequest) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
 }
 
-func (x *GetIntentStatusResponse) Reset() {
-	*x = GetIntentStatusResponse{}
-	mi := &file_orchestrator_proto_msgTypes[8]
+func (x *PrepareUpdateRoyaltyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PrepareUpdateRoyaltyRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *PrepareUpdateRoyaltyRequest) GetFeeBps() uint64 {
+	if x != nil {
+		return x.FeeBps
+	}
+	return 0
+}
+
+type PrepareTransferCollectionOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewOwner      string                 `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareTransferCollectionOwnershipRequest) Reset() {
+	*x = PrepareTransferCollectionOwnershipRequest{}
+	mi := &file_orchestrator_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIntentStatusResponse) String() string {
+func (x *PrepareTransferCollectionOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIntentStatusResponse) ProtoMessage() {}
+func (*PrepareTransferCollectionOwnershipRequest) ProtoMessage() {}
 
-func (x *GetIntentStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orchestrator_proto_msgTypes[8]
+func (x *PrepareTransferCollectionOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,116 +752,4017 @@ func (x *GetIntentStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIntentStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetIntentStatusResponse) Descriptor() ([]byte, []int) {
-	return file_orchestrator_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PrepareTransferCollectionOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*PrepareTransferCollectionOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetIntentStatusResponse) GetIntentId() string {
+func (x *PrepareTransferCollectionOwnershipRequest) GetChainId() string {
 	if x != nil {
-		return x.IntentId
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *GetIntentStatusResponse) GetKind() string {
+func (x *PrepareTransferCollectionOwnershipRequest) GetContract() string {
 	if x != nil {
-		return x.Kind
+		return x.Contract
 	}
 	return ""
 }
 
-func (x *GetIntentStatusResponse) GetStatus() string {
+func (x *PrepareTransferCollectionOwnershipRequest) GetUserId() string {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetIntentStatusResponse) GetChainId() string {
+func (x *PrepareTransferCollectionOwnershipRequest) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+type PrepareSetBaseURIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BaseUri       string                 `protobuf:"bytes,4,opt,name=base_uri,json=baseUri,proto3" json:"base_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareSetBaseURIRequest) Reset() {
+	*x = PrepareSetBaseURIRequest{}
+	mi := &file_orchestrator_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareSetBaseURIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareSetBaseURIRequest) ProtoMessage() {}
+
+func (x *PrepareSetBaseURIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareSetBaseURIRequest.ProtoReflect.Descriptor instead.
+func (*PrepareSetBaseURIRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PrepareSetBaseURIRequest) GetChainId() string {
 	if x != nil {
 		return x.ChainId
 	}
 	return ""
 }
 
-func (x *GetIntentStatusResponse) GetTxHash() string {
+func (x *PrepareSetBaseURIRequest) GetContract() string {
 	if x != nil {
-		return x.TxHash
+		return x.Contract
 	}
 	return ""
 }
 
-func (x *GetIntentStatusResponse) GetContractAddress() string {
+func (x *PrepareSetBaseURIRequest) GetUserId() string {
 	if x != nil {
-		return x.ContractAddress
+		return x.UserId
 	}
 	return ""
 }
 
-var File_orchestrator_proto protoreflect.FileDescriptor
+func (x *PrepareSetBaseURIRequest) GetBaseUri() string {
+	if x != nil {
+		return x.BaseUri
+	}
+	return ""
+}
 
-const file_orchestrator_proto_rawDesc = "" +
-	"\n" +
-	"\x12orchestrator.proto\x12\forchestrator\"n\n" +
-	"\tTxRequest\x12\x0e\n" +
-	"\x02to\x18\x01 \x01(\tR\x02to\x12\x12\n" +
-	"\x04data\x18\x02 \x01(\fR\x04data\x12\x14\n" +
-	"\x05value\x18\x03 \x01(\tR\x05value\x12'\n" +
-	"\x0fpreview_address\x18\x04 \x01(\tR\x0epreviewAddress\"\xa6\x04\n" +
-	"\x1ePrepareCreateCollectionRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
-	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x18\n" +
-	"\acreator\x18\x04 \x01(\tR\acreator\x12\x1b\n" +
-	"\ttoken_uri\x18\x05 \x01(\tR\btokenUri\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"mint_price\x18\a \x01(\x04R\tmintPrice\x12\x1f\n" +
-	"\vroyalty_fee\x18\b \x01(\x04R\n" +
-	"royaltyFee\x12\x1d\n" +
-	"\n" +
-	"max_supply\x18\t \x01(\x04R\tmaxSupply\x121\n" +
-	"\x15mint_limit_per_wallet\x18\n" +
-	" \x01(\x04R\x12mintLimitPerWallet\x12&\n" +
-	"\x0fmint_start_time\x18\v \x01(\x04R\rmintStartTime\x120\n" +
-	"\x14allowlist_mint_price\x18\f \x01(\x04R\x12allowlistMintPrice\x12*\n" +
-	"\x11public_mint_price\x18\r \x01(\x04R\x0fpublicMintPrice\x128\n" +
-	"\x18allowlist_stage_duration\x18\x0e \x01(\x04R\x16allowlistStageDuration\x12\x12\n" +
-	"\x04type\x18\x0f \x01(\tR\x04type\"g\n" +
-	"\x1fPrepareCreateCollectionResponse\x12\x1b\n" +
-	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12'\n" +
-	"\x02tx\x18\x02 \x01(\v2\x17.orchestrator.TxRequestR\x02tx\"\x9b\x01\n" +
-	"\x12PrepareMintRequest\x12\x19\n" +
-	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
-	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x16\n" +
-	"\x06minter\x18\x03 \x01(\tR\x06minter\x12\x1a\n" +
-	"\bstandard\x18\x04 \x01(\tR\bstandard\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\x04R\bquantity\"[\n" +
-	"\x13PrepareMintResponse\x12\x1b\n" +
-	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12'\n" +
-	"\x02tx\x18\x02 \x01(\v2\x17.orchestrator.TxRequestR\x02tx\"}\n" +
-	"\x0eTrackTxRequest\x12\x1b\n" +
-	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12\x19\n" +
-	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x17\n" +
+type PrepareSetPayoutSplitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Splits        []*PayoutSplit         `protobuf:"bytes,4,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareSetPayoutSplitsRequest) Reset() {
+	*x = PrepareSetPayoutSplitsRequest{}
+	mi := &file_orchestrator_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareSetPayoutSplitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareSetPayoutSplitsRequest) ProtoMessage() {}
+
+func (x *PrepareSetPayoutSplitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareSetPayoutSplitsRequest.ProtoReflect.Descriptor instead.
+func (*PrepareSetPayoutSplitsRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PrepareSetPayoutSplitsRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareSetPayoutSplitsRequest) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *PrepareSetPayoutSplitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PrepareSetPayoutSplitsRequest) GetSplits() []*PayoutSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type PrepareCollectionAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	Tx            *TxRequest             `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareCollectionAdminResponse) Reset() {
+	*x = PrepareCollectionAdminResponse{}
+	mi := &file_orchestrator_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareCollectionAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareCollectionAdminResponse) ProtoMessage() {}
+
+func (x *PrepareCollectionAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareCollectionAdminResponse.ProtoReflect.Descriptor instead.
+func (*PrepareCollectionAdminResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PrepareCollectionAdminResponse) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *PrepareCollectionAdminResponse) GetTx() *TxRequest {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+// Auctions run on the AuctionHouse contract registered in the chain registry.
+// Amounts are wei as base-10 strings; times are unix seconds.
+type PrepareCreateAuctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Collection    string                 `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	TokenId       string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Seller        string                 `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
+	AuctionType   string                 `protobuf:"bytes,5,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"` // english | dutch
+	StartPrice    string                 `protobuf:"bytes,6,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	ReservePrice  string                 `protobuf:"bytes,7,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"` // english only
+	EndPrice      string                 `protobuf:"bytes,8,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`             // dutch only, price reached at end_time
+	StartTime     uint64                 `protobuf:"varint,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`         // 0 = now
+	Duration      uint64                 `protobuf:"varint,10,opt,name=duration,proto3" json:"duration,omitempty"`                           // seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareCreateAuctionRequest) Reset() {
+	*x = PrepareCreateAuctionRequest{}
+	mi := &file_orchestrator_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareCreateAuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareCreateAuctionRequest) ProtoMessage() {}
+
+func (x *PrepareCreateAuctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareCreateAuctionRequest.ProtoReflect.Descriptor instead.
+func (*PrepareCreateAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PrepareCreateAuctionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareCreateAuctionRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *PrepareCreateAuctionRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *PrepareCreateAuctionRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *PrepareCreateAuctionRequest) GetAuctionType() string {
+	if x != nil {
+		return x.AuctionType
+	}
+	return ""
+}
+
+func (x *PrepareCreateAuctionRequest) GetStartPrice() string {
+	if x != nil {
+		return x.StartPrice
+	}
+	return ""
+}
+
+func (x *PrepareCreateAuctionRequest) GetReservePrice() string {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return ""
+}
+
+func (x *PrepareCreateAuctionRequest) GetEndPrice() string {
+	if x != nil {
+		return x.EndPrice
+	}
+	return ""
+}
+
+func (x *PrepareCreateAuctionRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PrepareCreateAuctionRequest) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type PrepareBidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AuctionId     string                 `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder        string                 `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // sent as msg.value
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareBidRequest) Reset() {
+	*x = PrepareBidRequest{}
+	mi := &file_orchestrator_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareBidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareBidRequest) ProtoMessage() {}
+
+func (x *PrepareBidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareBidRequest.ProtoReflect.Descriptor instead.
+func (*PrepareBidRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PrepareBidRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareBidRequest) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *PrepareBidRequest) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *PrepareBidRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type PrepareSettleAuctionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AuctionId     string                 `protobuf:"bytes,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Caller        string                 `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareSettleAuctionRequest) Reset() {
+	*x = PrepareSettleAuctionRequest{}
+	mi := &file_orchestrator_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareSettleAuctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareSettleAuctionRequest) ProtoMessage() {}
+
+func (x *PrepareSettleAuctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareSettleAuctionRequest.ProtoReflect.Descriptor instead.
+func (*PrepareSettleAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PrepareSettleAuctionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareSettleAuctionRequest) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *PrepareSettleAuctionRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+type PrepareAuctionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	Tx            *TxRequest             `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareAuctionResponse) Reset() {
+	*x = PrepareAuctionResponse{}
+	mi := &file_orchestrator_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareAuctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareAuctionResponse) ProtoMessage() {}
+
+func (x *PrepareAuctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareAuctionResponse.ProtoReflect.Descriptor instead.
+func (*PrepareAuctionResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PrepareAuctionResponse) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *PrepareAuctionResponse) GetTx() *TxRequest {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type GetIntentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIntentStatusRequest) Reset() {
+	*x = GetIntentStatusRequest{}
+	mi := &file_orchestrator_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIntentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIntentStatusRequest) ProtoMessage() {}
+
+func (x *GetIntentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIntentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetIntentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetIntentStatusRequest) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+type GetIntentStatusResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntentId        string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending|ready|failed|expired|stalled
+	ChainId         string                 `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TxHash          string                 `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Error           string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                          // why a failed intent failed
+	Recovery        string                 `protobuf:"bytes,8,opt,name=recovery,proto3" json:"recovery,omitempty"`                    // speed_up|resubmit, offered while stalled
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // when the status was last written, unset if unknown
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetIntentStatusResponse) Reset() {
+	*x = GetIntentStatusResponse{}
+	mi := &file_orchestrator_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIntentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIntentStatusResponse) ProtoMessage() {}
+
+func (x *GetIntentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIntentStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetIntentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetIntentStatusResponse) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *GetIntentStatusResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetIntentStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetIntentStatusResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetIntentStatusResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *GetIntentStatusResponse) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *GetIntentStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetIntentStatusResponse) GetRecovery() string {
+	if x != nil {
+		return x.Recovery
+	}
+	return ""
+}
+
+func (x *GetIntentStatusResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Intents a wallet was asked to sign, newest first. before/before_id are the
+// created_at and intent_id of the last intent of the previous page.
+type ListIntentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signer        string                 `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	BeforeId      string                 `protobuf:"bytes,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // default 20, max 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntentsRequest) Reset() {
+	*x = ListIntentsRequest{}
+	mi := &file_orchestrator_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntentsRequest) ProtoMessage() {}
+
+func (x *ListIntentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntentsRequest.ProtoReflect.Descriptor instead.
+func (*ListIntentsRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListIntentsRequest) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *ListIntentsRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListIntentsRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+func (x *ListIntentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Intent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntentId        string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ChainId         string                 `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TxHash          string                 `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Signer          string                 `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Intent) Reset() {
+	*x = Intent{}
+	mi := &file_orchestrator_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Intent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Intent) ProtoMessage() {}
+
+func (x *Intent) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Intent.ProtoReflect.Descriptor instead.
+func (*Intent) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Intent) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *Intent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Intent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Intent) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Intent) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Intent) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *Intent) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *Intent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Intent) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListIntentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intents       []*Intent              `protobuf:"bytes,1,rep,name=intents,proto3" json:"intents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntentsResponse) Reset() {
+	*x = ListIntentsResponse{}
+	mi := &file_orchestrator_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntentsResponse) ProtoMessage() {}
+
+func (x *ListIntentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntentsResponse.ProtoReflect.Descriptor instead.
+func (*ListIntentsResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListIntentsResponse) GetIntents() []*Intent {
+	if x != nil {
+		return x.Intents
+	}
+	return nil
+}
+
+// Form bounds and starting values for PrepareCreateCollection on a chain
+type GetCollectionDefaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionDefaultsRequest) Reset() {
+	*x = GetCollectionDefaultsRequest{}
+	mi := &file_orchestrator_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionDefaultsRequest) ProtoMessage() {}
+
+func (x *GetCollectionDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCollectionDefaultsRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+type CollectionConstraints struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MaxRoyaltyBps       uint64                 `protobuf:"varint,1,opt,name=max_royalty_bps,json=maxRoyaltyBps,proto3" json:"max_royalty_bps,omitempty"`
+	MinStageDurationSec uint64                 `protobuf:"varint,2,opt,name=min_stage_duration_sec,json=minStageDurationSec,proto3" json:"min_stage_duration_sec,omitempty"`
+	MaxSupplyCap        uint64                 `protobuf:"varint,3,opt,name=max_supply_cap,json=maxSupplyCap,proto3" json:"max_supply_cap,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CollectionConstraints) Reset() {
+	*x = CollectionConstraints{}
+	mi := &file_orchestrator_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionConstraints) ProtoMessage() {}
+
+func (x *CollectionConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionConstraints.ProtoReflect.Descriptor instead.
+func (*CollectionConstraints) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CollectionConstraints) GetMaxRoyaltyBps() uint64 {
+	if x != nil {
+		return x.MaxRoyaltyBps
+	}
+	return 0
+}
+
+func (x *CollectionConstraints) GetMinStageDurationSec() uint64 {
+	if x != nil {
+		return x.MinStageDurationSec
+	}
+	return 0
+}
+
+func (x *CollectionConstraints) GetMaxSupplyCap() uint64 {
+	if x != nil {
+		return x.MaxSupplyCap
+	}
+	return 0
+}
+
+type GetCollectionDefaultsResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ChainId                string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Constraints            *CollectionConstraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	RoyaltyFee             uint64                 `protobuf:"varint,3,opt,name=royalty_fee,json=royaltyFee,proto3" json:"royalty_fee,omitempty"`
+	MaxSupply              uint64                 `protobuf:"varint,4,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	MintLimitPerWallet     uint64                 `protobuf:"varint,5,opt,name=mint_limit_per_wallet,json=mintLimitPerWallet,proto3" json:"mint_limit_per_wallet,omitempty"`
+	AllowlistStageDuration uint64                 `protobuf:"varint,6,opt,name=allowlist_stage_duration,json=allowlistStageDuration,proto3" json:"allowlist_stage_duration,omitempty"`
+	SupportedTypes         []string               `protobuf:"bytes,7,rep,name=supported_types,json=supportedTypes,proto3" json:"supported_types,omitempty"` // collection types with a factory on the chain
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetCollectionDefaultsResponse) Reset() {
+	*x = GetCollectionDefaultsResponse{}
+	mi := &file_orchestrator_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionDefaultsResponse) ProtoMessage() {}
+
+func (x *GetCollectionDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCollectionDefaultsResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetCollectionDefaultsResponse) GetConstraints() *CollectionConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *GetCollectionDefaultsResponse) GetRoyaltyFee() uint64 {
+	if x != nil {
+		return x.RoyaltyFee
+	}
+	return 0
+}
+
+func (x *GetCollectionDefaultsResponse) GetMaxSupply() uint64 {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return 0
+}
+
+func (x *GetCollectionDefaultsResponse) GetMintLimitPerWallet() uint64 {
+	if x != nil {
+		return x.MintLimitPerWallet
+	}
+	return 0
+}
+
+func (x *GetCollectionDefaultsResponse) GetAllowlistStageDuration() uint64 {
+	if x != nil {
+		return x.AllowlistStageDuration
+	}
+	return 0
+}
+
+func (x *GetCollectionDefaultsResponse) GetSupportedTypes() []string {
+	if x != nil {
+		return x.SupportedTypes
+	}
+	return nil
+}
+
+// Importing a collection deployed outside the factory: the contract owner signs the
+// returned message, then ImportCollection verifies it and registers the contract
+type PrepareImportCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareImportCollectionRequest) Reset() {
+	*x = PrepareImportCollectionRequest{}
+	mi := &file_orchestrator_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareImportCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareImportCollectionRequest) ProtoMessage() {}
+
+func (x *PrepareImportCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareImportCollectionRequest.ProtoReflect.Descriptor instead.
+func (*PrepareImportCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PrepareImportCollectionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareImportCollectionRequest) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *PrepareImportCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PrepareImportCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	IssuedAt      string                 `protobuf:"bytes,2,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"` // RFC3339
+	ExpiresAt     string                 `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ChallengeId   string                 `protobuf:"bytes,4,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"` // wallet-service challenge; passed back to ImportCollection
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareImportCollectionResponse) Reset() {
+	*x = PrepareImportCollectionResponse{}
+	mi := &file_orchestrator_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareImportCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareImportCollectionResponse) ProtoMessage() {}
+
+func (x *PrepareImportCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareImportCollectionResponse.ProtoReflect.Descriptor instead.
+func (*PrepareImportCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PrepareImportCollectionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PrepareImportCollectionResponse) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *PrepareImportCollectionResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *PrepareImportCollectionResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type ImportCollectionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ChainId  string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	UserId   string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Deprecated: Marked as deprecated in orchestrator.proto.
+	IssuedAt      string `protobuf:"bytes,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`          // ignored; only wallet-service challenges are accepted
+	Signature     string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`                        // EIP-191 or EIP-1271 signature of the challenge
+	ChallengeId   string `protobuf:"bytes,6,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"` // required
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCollectionRequest) Reset() {
+	*x = ImportCollectionRequest{}
+	mi := &file_orchestrator_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCollectionRequest) ProtoMessage() {}
+
+func (x *ImportCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ImportCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportCollectionRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ImportCollectionRequest) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *ImportCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in orchestrator.proto.
+func (x *ImportCollectionRequest) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *ImportCollectionRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ImportCollectionRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type ImportCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Standard      string                 `protobuf:"bytes,3,opt,name=standard,proto3" json:"standard,omitempty"` // ERC721 | ERC1155
+	Owner         string                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string                 `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	StartBlock    uint64                 `protobuf:"varint,7,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"` // indexing backfills from here
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCollectionResponse) Reset() {
+	*x = ImportCollectionResponse{}
+	mi := &file_orchestrator_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCollectionResponse) ProtoMessage() {}
+
+func (x *ImportCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ImportCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportCollectionResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ImportCollectionResponse) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *ImportCollectionResponse) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *ImportCollectionResponse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ImportCollectionResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportCollectionResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ImportCollectionResponse) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+// Airdrops mint a collection's tokens to many wallets. They are split into batches that each
+// fit the chain's gas cap for one transaction, and every batch is an intent tracked on its own.
+// user_id must manage the collection.
+type AirdropRecipient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AirdropRecipient) Reset() {
+	*x = AirdropRecipient{}
+	mi := &file_orchestrator_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AirdropRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirdropRecipient) ProtoMessage() {}
+
+func (x *AirdropRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirdropRecipient.ProtoReflect.Descriptor instead.
+func (*AirdropRecipient) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AirdropRecipient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AirdropRecipient) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PrepareAirdropRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChainId         string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract        string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	UserId          string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Standard        string                 `protobuf:"bytes,4,opt,name=standard,proto3" json:"standard,omitempty"`                                         // ERC721 mints new tokens | ERC1155 mints token_id
+	TokenId         string                 `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                            // ERC1155 only, decimal
+	SnapshotId      string                 `protobuf:"bytes,6,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`                   // the holders of a holder snapshot user_id took...
+	Recipients      []*AirdropRecipient    `protobuf:"bytes,7,rep,name=recipients,proto3" json:"recipients,omitempty"`                                     // ...or these wallets
+	AmountPerHolder uint64                 `protobuf:"varint,8,opt,name=amount_per_holder,json=amountPerHolder,proto3" json:"amount_per_holder,omitempty"` // for snapshot holders, default 1
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PrepareAirdropRequest) Reset() {
+	*x = PrepareAirdropRequest{}
+	mi := &file_orchestrator_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareAirdropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareAirdropRequest) ProtoMessage() {}
+
+func (x *PrepareAirdropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareAirdropRequest.ProtoReflect.Descriptor instead.
+func (*PrepareAirdropRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PrepareAirdropRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareAirdropRequest) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *PrepareAirdropRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PrepareAirdropRequest) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *PrepareAirdropRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *PrepareAirdropRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *PrepareAirdropRequest) GetRecipients() []*AirdropRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *PrepareAirdropRequest) GetAmountPerHolder() uint64 {
+	if x != nil {
+		return x.AmountPerHolder
+	}
+	return 0
+}
+
+type AirdropBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	Seq           uint32                 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Recipients    []*AirdropRecipient    `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	GasLimit      uint64                 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"` // estimate to send the tx with
+	Tx            *TxRequest             `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AirdropBatch) Reset() {
+	*x = AirdropBatch{}
+	mi := &file_orchestrator_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AirdropBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirdropBatch) ProtoMessage() {}
+
+func (x *AirdropBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirdropBatch.ProtoReflect.Descriptor instead.
+func (*AirdropBatch) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AirdropBatch) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *AirdropBatch) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AirdropBatch) GetRecipients() []*AirdropRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *AirdropBatch) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *AirdropBatch) GetTx() *TxRequest {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type PrepareAirdropResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BundleId       string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	ChainId        string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract       string                 `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	RecipientCount uint32                 `protobuf:"varint,4,opt,name=recipient_count,json=recipientCount,proto3" json:"recipient_count,omitempty"`
+	Batches        []*AirdropBatch        `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"` // in the order to send them
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PrepareAirdropResponse) Reset() {
+	*x = PrepareAirdropResponse{}
+	mi := &file_orchestrator_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareAirdropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareAirdropResponse) ProtoMessage() {}
+
+func (x *PrepareAirdropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareAirdropResponse.ProtoReflect.Descriptor instead.
+func (*PrepareAirdropResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PrepareAirdropResponse) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *PrepareAirdropResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareAirdropResponse) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *PrepareAirdropResponse) GetRecipientCount() uint32 {
+	if x != nil {
+		return x.RecipientCount
+	}
+	return 0
+}
+
+func (x *PrepareAirdropResponse) GetBatches() []*AirdropBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type GetAirdropProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAirdropProgressRequest) Reset() {
+	*x = GetAirdropProgressRequest{}
+	mi := &file_orchestrator_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAirdropProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAirdropProgressRequest) ProtoMessage() {}
+
+func (x *GetAirdropProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAirdropProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetAirdropProgressRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAirdropProgressRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *GetAirdropProgressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAirdropProgressResponse struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	BundleId       string                     `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	ChainId        string                     `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Contract       string                     `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Status         string                     `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // failed once any batch failed or expired, ready once all are, else stalled|pending
+	RecipientCount uint32                     `protobuf:"varint,5,opt,name=recipient_count,json=recipientCount,proto3" json:"recipient_count,omitempty"`
+	BatchCount     uint32                     `protobuf:"varint,6,opt,name=batch_count,json=batchCount,proto3" json:"batch_count,omitempty"`
+	Confirmed      uint32                     `protobuf:"varint,7,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Failed         uint32                     `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	Batches        []*GetIntentStatusResponse `protobuf:"bytes,9,rep,name=batches,proto3" json:"batches,omitempty"` // in batch order
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAirdropProgressResponse) Reset() {
+	*x = GetAirdropProgressResponse{}
+	mi := &file_orchestrator_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAirdropProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAirdropProgressResponse) ProtoMessage() {}
+
+func (x *GetAirdropProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAirdropProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetAirdropProgressResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAirdropProgressResponse) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *GetAirdropProgressResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetAirdropProgressResponse) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *GetAirdropProgressResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAirdropProgressResponse) GetRecipientCount() uint32 {
+	if x != nil {
+		return x.RecipientCount
+	}
+	return 0
+}
+
+func (x *GetAirdropProgressResponse) GetBatchCount() uint32 {
+	if x != nil {
+		return x.BatchCount
+	}
+	return 0
+}
+
+func (x *GetAirdropProgressResponse) GetConfirmed() uint32 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return 0
+}
+
+func (x *GetAirdropProgressResponse) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *GetAirdropProgressResponse) GetBatches() []*GetIntentStatusResponse {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+// Bulk transfers send tokens the user's wallet holds, as far as the catalog has indexed, to
+// other wallets. Tokens are grouped by collection: ERC-1155 tokens go in one
+// safeBatchTransferFrom per recipient, ERC-721 tokens in one safeTransferFrom each. Every
+// transfer is an intent tracked on its own. from, and operator if set, must be wallets
+// linked to user_id.
+type BulkTransferItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contract      string                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	TokenId       string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"` // ERC1155 only, default 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTransferItem) Reset() {
+	*x = BulkTransferItem{}
+	mi := &file_orchestrator_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTransferItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTransferItem) ProtoMessage() {}
+
+func (x *BulkTransferItem) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTransferItem.ProtoReflect.Descriptor instead.
+func (*BulkTransferItem) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BulkTransferItem) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *BulkTransferItem) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *BulkTransferItem) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *BulkTransferItem) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PrepareBulkTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`         // the wallet holding the tokens
+	Operator      string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"` // sends the transfers in from's place when set; must be approved per collection
+	Items         []*BulkTransferItem    `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareBulkTransferRequest) Reset() {
+	*x = PrepareBulkTransferRequest{}
+	mi := &file_orchestrator_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareBulkTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareBulkTransferRequest) ProtoMessage() {}
+
+func (x *PrepareBulkTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareBulkTransferRequest.ProtoReflect.Descriptor instead.
+func (*PrepareBulkTransferRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PrepareBulkTransferRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareBulkTransferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PrepareBulkTransferRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PrepareBulkTransferRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *PrepareBulkTransferRequest) GetItems() []*BulkTransferItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BulkTransferToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenId       string                 `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTransferToken) Reset() {
+	*x = BulkTransferToken{}
+	mi := &file_orchestrator_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTransferToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTransferToken) ProtoMessage() {}
+
+func (x *BulkTransferToken) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTransferToken.ProtoReflect.Descriptor instead.
+func (*BulkTransferToken) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BulkTransferToken) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *BulkTransferToken) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type BulkTransferApproval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contract      string                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Check         *TxRequest             `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"` // eth_call returning whether operator is already approved
+	Tx            *TxRequest             `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`       // setApprovalForAll, for from to send when it is not
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTransferApproval) Reset() {
+	*x = BulkTransferApproval{}
+	mi := &file_orchestrator_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTransferApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTransferApproval) ProtoMessage() {}
+
+func (x *BulkTransferApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTransferApproval.ProtoReflect.Descriptor instead.
+func (*BulkTransferApproval) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BulkTransferApproval) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *BulkTransferApproval) GetCheck() *TxRequest {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *BulkTransferApproval) GetTx() *TxRequest {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type BulkTransferBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	Seq           uint32                 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Contract      string                 `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Standard      string                 `protobuf:"bytes,4,opt,name=standard,proto3" json:"standard,omitempty"`
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Tokens        []*BulkTransferToken   `protobuf:"bytes,6,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Tx            *TxRequest             `protobuf:"bytes,7,opt,name=tx,proto3" json:"tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTransferBatch) Reset() {
+	*x = BulkTransferBatch{}
+	mi := &file_orchestrator_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTransferBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTransferBatch) ProtoMessage() {}
+
+func (x *BulkTransferBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTransferBatch.ProtoReflect.Descriptor instead.
+func (*BulkTransferBatch) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BulkTransferBatch) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *BulkTransferBatch) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *BulkTransferBatch) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *BulkTransferBatch) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *BulkTransferBatch) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *BulkTransferBatch) GetTokens() []*BulkTransferToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *BulkTransferBatch) GetTx() *TxRequest {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type PrepareBulkTransferResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	BundleId      string                  `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	ChainId       string                  `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	From          string                  `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	Signer        string                  `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"` // signer sends the batches: operator if set, else from
+	TokenCount    uint32                  `protobuf:"varint,5,opt,name=token_count,json=tokenCount,proto3" json:"token_count,omitempty"`
+	Approvals     []*BulkTransferApproval `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"` // to check before sending the batches
+	Batches       []*BulkTransferBatch    `protobuf:"bytes,7,rep,name=batches,proto3" json:"batches,omitempty"`     // in the order to send them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareBulkTransferResponse) Reset() {
+	*x = PrepareBulkTransferResponse{}
+	mi := &file_orchestrator_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareBulkTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareBulkTransferResponse) ProtoMessage() {}
+
+func (x *PrepareBulkTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareBulkTransferResponse.ProtoReflect.Descriptor instead.
+func (*PrepareBulkTransferResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PrepareBulkTransferResponse) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *PrepareBulkTransferResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PrepareBulkTransferResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PrepareBulkTransferResponse) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *PrepareBulkTransferResponse) GetTokenCount() uint32 {
+	if x != nil {
+		return x.TokenCount
+	}
+	return 0
+}
+
+func (x *PrepareBulkTransferResponse) GetApprovals() []*BulkTransferApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *PrepareBulkTransferResponse) GetBatches() []*BulkTransferBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type GetBulkTransferProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBulkTransferProgressRequest) Reset() {
+	*x = GetBulkTransferProgressRequest{}
+	mi := &file_orchestrator_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBulkTransferProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBulkTransferProgressRequest) ProtoMessage() {}
+
+func (x *GetBulkTransferProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBulkTransferProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetBulkTransferProgressRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetBulkTransferProgressRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *GetBulkTransferProgressRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetBulkTransferProgressResponse struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	BundleId       string                     `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	ChainId        string                     `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Status         string                     `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // failed once any batch failed or expired, ready once all are, else stalled|pending
+	RecipientCount uint32                     `protobuf:"varint,4,opt,name=recipient_count,json=recipientCount,proto3" json:"recipient_count,omitempty"`
+	BatchCount     uint32                     `protobuf:"varint,5,opt,name=batch_count,json=batchCount,proto3" json:"batch_count,omitempty"`
+	Confirmed      uint32                     `protobuf:"varint,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Failed         uint32                     `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Batches        []*GetIntentStatusResponse `protobuf:"bytes,8,rep,name=batches,proto3" json:"batches,omitempty"` // in batch order
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBulkTransferProgressResponse) Reset() {
+	*x = GetBulkTransferProgressResponse{}
+	mi := &file_orchestrator_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBulkTransferProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBulkTransferProgressResponse) ProtoMessage() {}
+
+func (x *GetBulkTransferProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBulkTransferProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetBulkTransferProgressResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetBulkTransferProgressResponse) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *GetBulkTransferProgressResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetBulkTransferProgressResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetBulkTransferProgressResponse) GetRecipientCount() uint32 {
+	if x != nil {
+		return x.RecipientCount
+	}
+	return 0
+}
+
+func (x *GetBulkTransferProgressResponse) GetBatchCount() uint32 {
+	if x != nil {
+		return x.BatchCount
+	}
+	return 0
+}
+
+func (x *GetBulkTransferProgressResponse) GetConfirmed() uint32 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return 0
+}
+
+func (x *GetBulkTransferProgressResponse) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *GetBulkTransferProgressResponse) GetBatches() []*GetIntentStatusResponse {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+// Call target overrides let mints call contracts neither the chain registry nor the catalog
+// knows. Callers authorize the admin.
+type CallTargetOverride struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedBy     string                 `protobuf:"bytes,4,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallTargetOverride) Reset() {
+	*x = CallTargetOverride{}
+	mi := &file_orchestrator_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallTargetOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallTargetOverride) ProtoMessage() {}
+
+func (x *CallTargetOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallTargetOverride.ProtoReflect.Descriptor instead.
+func (*CallTargetOverride) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CallTargetOverride) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *CallTargetOverride) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CallTargetOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CallTargetOverride) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *CallTargetOverride) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AllowCallTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllowCallTargetRequest) Reset() {
+	*x = AllowCallTargetRequest{}
+	mi := &file_orchestrator_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowCallTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowCallTargetRequest) ProtoMessage() {}
+
+func (x *AllowCallTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowCallTargetRequest.ProtoReflect.Descriptor instead.
+func (*AllowCallTargetRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AllowCallTargetRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *AllowCallTargetRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AllowCallTargetRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AllowCallTargetRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type AllowCallTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Override      *CallTargetOverride    `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllowCallTargetResponse) Reset() {
+	*x = AllowCallTargetResponse{}
+	mi := &file_orchestrator_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowCallTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowCallTargetResponse) ProtoMessage() {}
+
+func (x *AllowCallTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowCallTargetResponse.ProtoReflect.Descriptor instead.
+func (*AllowCallTargetResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AllowCallTargetResponse) GetOverride() *CallTargetOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type RevokeCallTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCallTargetRequest) Reset() {
+	*x = RevokeCallTargetRequest{}
+	mi := &file_orchestrator_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCallTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCallTargetRequest) ProtoMessage() {}
+
+func (x *RevokeCallTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCallTargetRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCallTargetRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RevokeCallTargetRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *RevokeCallTargetRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RevokeCallTargetRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type RevokeCallTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       bool                   `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCallTargetResponse) Reset() {
+	*x = RevokeCallTargetResponse{}
+	mi := &file_orchestrator_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCallTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCallTargetResponse) ProtoMessage() {}
+
+func (x *RevokeCallTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCallTargetResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCallTargetResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RevokeCallTargetResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type ListCallTargetOverridesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallTargetOverridesRequest) Reset() {
+	*x = ListCallTargetOverridesRequest{}
+	mi := &file_orchestrator_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallTargetOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallTargetOverridesRequest) ProtoMessage() {}
+
+func (x *ListCallTargetOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallTargetOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListCallTargetOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListCallTargetOverridesRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+type ListCallTargetOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overrides     []*CallTargetOverride  `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallTargetOverridesResponse) Reset() {
+	*x = ListCallTargetOverridesResponse{}
+	mi := &file_orchestrator_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallTargetOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallTargetOverridesResponse) ProtoMessage() {}
+
+func (x *ListCallTargetOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallTargetOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListCallTargetOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListCallTargetOverridesResponse) GetOverrides() []*CallTargetOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+// Sponsorship lets a relayer have mint gas paid from per-chain budgets, in gwei. A grant
+// holds budget for one pending mint intent until it is settled, released or expires.
+type SponsorshipGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantId       string                 `protobuf:"bytes,1,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty"`
+	IntentId      string                 `protobuf:"bytes,2,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	ChainId       string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Collection    string                 `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GasGwei       int64                  `protobuf:"varint,6,opt,name=gas_gwei,json=gasGwei,proto3" json:"gas_gwei,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SponsorshipGrant) Reset() {
+	*x = SponsorshipGrant{}
+	mi := &file_orchestrator_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SponsorshipGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SponsorshipGrant) ProtoMessage() {}
+
+func (x *SponsorshipGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SponsorshipGrant.ProtoReflect.Descriptor instead.
+func (*SponsorshipGrant) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SponsorshipGrant) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+func (x *SponsorshipGrant) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *SponsorshipGrant) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SponsorshipGrant) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *SponsorshipGrant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SponsorshipGrant) GetGasGwei() int64 {
+	if x != nil {
+		return x.GasGwei
+	}
+	return 0
+}
+
+func (x *SponsorshipGrant) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ReserveSponsorshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntentId      string                 `protobuf:"bytes,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	GasGwei       int64                  `protobuf:"varint,2,opt,name=gas_gwei,json=gasGwei,proto3" json:"gas_gwei,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSponsorshipRequest) Reset() {
+	*x = ReserveSponsorshipRequest{}
+	mi := &file_orchestrator_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSponsorshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSponsorshipRequest) ProtoMessage() {}
+
+func (x *ReserveSponsorshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSponsorshipRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReserveSponsorshipRequest) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *ReserveSponsorshipRequest) GetGasGwei() int64 {
+	if x != nil {
+		return x.GasGwei
+	}
+	return 0
+}
+
+type ReserveSponsorshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *SponsorshipGrant      `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSponsorshipResponse) Reset() {
+	*x = ReserveSponsorshipResponse{}
+	mi := &file_orchestrator_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSponsorshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSponsorshipResponse) ProtoMessage() {}
+
+func (x *ReserveSponsorshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSponsorshipResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReserveSponsorshipResponse) GetGrant() *SponsorshipGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+type SettleSponsorshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantId       string                 `protobuf:"bytes,1,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty"`
+	SpentGwei     int64                  `protobuf:"varint,2,opt,name=spent_gwei,json=spentGwei,proto3" json:"spent_gwei,omitempty"`
+	TxHash        string                 `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleSponsorshipRequest) Reset() {
+	*x = SettleSponsorshipRequest{}
+	mi := &file_orchestrator_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleSponsorshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleSponsorshipRequest) ProtoMessage() {}
+
+func (x *SettleSponsorshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleSponsorshipRequest.ProtoReflect.Descriptor instead.
+func (*SettleSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SettleSponsorshipRequest) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+func (x *SettleSponsorshipRequest) GetSpentGwei() int64 {
+	if x != nil {
+		return x.SpentGwei
+	}
+	return 0
+}
+
+func (x *SettleSponsorshipRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type SettleSponsorshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charged       bool                   `protobuf:"varint,1,opt,name=charged,proto3" json:"charged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleSponsorshipResponse) Reset() {
+	*x = SettleSponsorshipResponse{}
+	mi := &file_orchestrator_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleSponsorshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleSponsorshipResponse) ProtoMessage() {}
+
+func (x *SettleSponsorshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleSponsorshipResponse.ProtoReflect.Descriptor instead.
+func (*SettleSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SettleSponsorshipResponse) GetCharged() bool {
+	if x != nil {
+		return x.Charged
+	}
+	return false
+}
+
+type ReleaseSponsorshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantId       string                 `protobuf:"bytes,1,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSponsorshipRequest) Reset() {
+	*x = ReleaseSponsorshipRequest{}
+	mi := &file_orchestrator_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSponsorshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSponsorshipRequest) ProtoMessage() {}
+
+func (x *ReleaseSponsorshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSponsorshipRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReleaseSponsorshipRequest) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+type ReleaseSponsorshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSponsorshipResponse) Reset() {
+	*x = ReleaseSponsorshipResponse{}
+	mi := &file_orchestrator_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSponsorshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSponsorshipResponse) ProtoMessage() {}
+
+func (x *ReleaseSponsorshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSponsorshipResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{56}
+}
+
+// Sponsorship budget admin; callers authorize the admin
+type SponsorshipBudget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BalanceGwei   int64                  `protobuf:"varint,2,opt,name=balance_gwei,json=balanceGwei,proto3" json:"balance_gwei,omitempty"`
+	ToppedUpGwei  int64                  `protobuf:"varint,3,opt,name=topped_up_gwei,json=toppedUpGwei,proto3" json:"topped_up_gwei,omitempty"`
+	SpentGwei     int64                  `protobuf:"varint,4,opt,name=spent_gwei,json=spentGwei,proto3" json:"spent_gwei,omitempty"`
+	Frozen        bool                   `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	FrozenReason  string                 `protobuf:"bytes,6,opt,name=frozen_reason,json=frozenReason,proto3" json:"frozen_reason,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SponsorshipBudget) Reset() {
+	*x = SponsorshipBudget{}
+	mi := &file_orchestrator_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SponsorshipBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SponsorshipBudget) ProtoMessage() {}
+
+func (x *SponsorshipBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SponsorshipBudget.ProtoReflect.Descriptor instead.
+func (*SponsorshipBudget) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SponsorshipBudget) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SponsorshipBudget) GetBalanceGwei() int64 {
+	if x != nil {
+		return x.BalanceGwei
+	}
+	return 0
+}
+
+func (x *SponsorshipBudget) GetToppedUpGwei() int64 {
+	if x != nil {
+		return x.ToppedUpGwei
+	}
+	return 0
+}
+
+func (x *SponsorshipBudget) GetSpentGwei() int64 {
+	if x != nil {
+		return x.SpentGwei
+	}
+	return 0
+}
+
+func (x *SponsorshipBudget) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *SponsorshipBudget) GetFrozenReason() string {
+	if x != nil {
+		return x.FrozenReason
+	}
+	return ""
+}
+
+func (x *SponsorshipBudget) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *SponsorshipBudget) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TopUpSponsorshipBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AmountGwei    int64                  `protobuf:"varint,2,opt,name=amount_gwei,json=amountGwei,proto3" json:"amount_gwei,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpSponsorshipBudgetRequest) Reset() {
+	*x = TopUpSponsorshipBudgetRequest{}
+	mi := &file_orchestrator_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpSponsorshipBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpSponsorshipBudgetRequest) ProtoMessage() {}
+
+func (x *TopUpSponsorshipBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpSponsorshipBudgetRequest.ProtoReflect.Descriptor instead.
+func (*TopUpSponsorshipBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TopUpSponsorshipBudgetRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *TopUpSponsorshipBudgetRequest) GetAmountGwei() int64 {
+	if x != nil {
+		return x.AmountGwei
+	}
+	return 0
+}
+
+func (x *TopUpSponsorshipBudgetRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TopUpSponsorshipBudgetRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type TopUpSponsorshipBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *SponsorshipBudget     `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpSponsorshipBudgetResponse) Reset() {
+	*x = TopUpSponsorshipBudgetResponse{}
+	mi := &file_orchestrator_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpSponsorshipBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpSponsorshipBudgetResponse) ProtoMessage() {}
+
+func (x *TopUpSponsorshipBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpSponsorshipBudgetResponse.ProtoReflect.Descriptor instead.
+func (*TopUpSponsorshipBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TopUpSponsorshipBudgetResponse) GetBudget() *SponsorshipBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type SetSponsorshipBudgetFrozenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Frozen        bool                   `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSponsorshipBudgetFrozenRequest) Reset() {
+	*x = SetSponsorshipBudgetFrozenRequest{}
+	mi := &file_orchestrator_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSponsorshipBudgetFrozenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSponsorshipBudgetFrozenRequest) ProtoMessage() {}
+
+func (x *SetSponsorshipBudgetFrozenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSponsorshipBudgetFrozenRequest.ProtoReflect.Descriptor instead.
+func (*SetSponsorshipBudgetFrozenRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetSponsorshipBudgetFrozenRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SetSponsorshipBudgetFrozenRequest) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *SetSponsorshipBudgetFrozenRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetSponsorshipBudgetFrozenRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type SetSponsorshipBudgetFrozenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *SponsorshipBudget     `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSponsorshipBudgetFrozenResponse) Reset() {
+	*x = SetSponsorshipBudgetFrozenResponse{}
+	mi := &file_orchestrator_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSponsorshipBudgetFrozenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSponsorshipBudgetFrozenResponse) ProtoMessage() {}
+
+func (x *SetSponsorshipBudgetFrozenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSponsorshipBudgetFrozenResponse.ProtoReflect.Descriptor instead.
+func (*SetSponsorshipBudgetFrozenResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetSponsorshipBudgetFrozenResponse) GetBudget() *SponsorshipBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type ListSponsorshipBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSponsorshipBudgetsRequest) Reset() {
+	*x = ListSponsorshipBudgetsRequest{}
+	mi := &file_orchestrator_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSponsorshipBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSponsorshipBudgetsRequest) ProtoMessage() {}
+
+func (x *ListSponsorshipBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSponsorshipBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListSponsorshipBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{62}
+}
+
+type ListSponsorshipBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*SponsorshipBudget   `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSponsorshipBudgetsResponse) Reset() {
+	*x = ListSponsorshipBudgetsResponse{}
+	mi := &file_orchestrator_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSponsorshipBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSponsorshipBudgetsResponse) ProtoMessage() {}
+
+func (x *ListSponsorshipBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orchestrator_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSponsorshipBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListSponsorshipBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_orchestrator_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListSponsorshipBudgetsResponse) GetBudgets() []*SponsorshipBudget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+var File_orchestrator_proto protoreflect.FileDescriptor
+
+const file_orchestrator_proto_rawDesc = "" +
+	"\n" +
+	"\x12orchestrator.proto\x12\forchestrator\x1a\x1fgoogle/protobuf/timestamp.proto\"n\n" +
+	"\tTxRequest\x12\x0e\n" +
+	"\x02to\x18\x01 \x01(\tR\x02to\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12'\n" +
+	"\x0fpreview_address\x18\x04 \x01(\tR\x0epreviewAddress\"\xa6\x05\n" +
+	"\x1ePrepareCreateCollectionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x18\n" +
+	"\acreator\x18\x04 \x01(\tR\acreator\x12\x1b\n" +
+	"\ttoken_uri\x18\x05 \x01(\tR\btokenUri\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"mint_price\x18\a \x01(\x04R\tmintPrice\x12\x1f\n" +
+	"\vroyalty_fee\x18\b \x01(\x04R\n" +
+	"royaltyFee\x12\x1d\n" +
+	"\n" +
+	"max_supply\x18\t \x01(\x04R\tmaxSupply\x121\n" +
+	"\x15mint_limit_per_wallet\x18\n" +
+	" \x01(\x04R\x12mintLimitPerWallet\x12&\n" +
+	"\x0fmint_start_time\x18\v \x01(\x04R\rmintStartTime\x120\n" +
+	"\x14allowlist_mint_price\x18\f \x01(\x04R\x12allowlistMintPrice\x12*\n" +
+	"\x11public_mint_price\x18\r \x01(\x04R\x0fpublicMintPrice\x128\n" +
+	"\x18allowlist_stage_duration\x18\x0e \x01(\x04R\x16allowlistStageDuration\x12\x12\n" +
+	"\x04type\x18\x0f \x01(\tR\x04type\x12\x1b\n" +
+	"\tasset_ids\x18\x10 \x03(\tR\bassetIds\x12!\n" +
+	"\fcallback_url\x18\x11 \x01(\tR\vcallbackUrl\x12>\n" +
+	"\rpayout_splits\x18\x12 \x03(\v2\x19.orchestrator.PayoutSplitR\fpayoutSplits\"=\n" +
+	"\vPayoutSplit\x12\x1c\n" +
+	"\trecipient\x18\x01 \x01(\tR\trecipient\x12\x10\n" +
+	"\x03bps\x18\x02 \x01(\x04R\x03bps\"\x90\x01\n" +
+	"\x1fPrepareCreateCollectionResponse\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12'\n" +
+	"\x02tx\x18\x02 \x01(\v2\x17.orchestrator.TxRequestR\x02tx\x12'\n" +
+	"\x0fcallback_secret\x18\x03 \x01(\tR\x0ecallbackSecret\"\xcc\x01\n" +
+	"\x12PrepareMintRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x16\n" +
+	"\x06minter\x18\x03 \x01(\tR\x06minter\x12\x1a\n" +
+	"\bstandard\x18\x04 \x01(\tR\bstandard\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x04R\bquantity\x12\x19\n" +
+	"\btoken_id\x18\x06 \x01(\tR\atokenId\x12\x14\n" +
+	"\x05proof\x18\a \x03(\tR\x05proof\"[\n" +
+	"\x13PrepareMintResponse\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12'\n" +
+	"\x02tx\x18\x02 \x01(\v2\x17.orchestrator.TxRequestR\x02tx\"}\n" +
+	"\x0eTrackTxRequest\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x17\n" +
 	"\atx_hash\x18\x03 \x01(\tR\x06txHash\x12\x1a\n" +
 	"\bcontract\x18\x04 \x01(\tR\bcontract\"!\n" +
 	"\x0fTrackTxResponse\x12\x0e\n" +
-	"\x02ok\x18\x01 \x01(\bR\x02ok\"5\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\"\xa2\x01\n" +
+	"\x1bPrepareUpdateRoyaltyRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\breceiver\x18\x04 \x01(\tR\breceiver\x12\x17\n" +
+	"\afee_bps\x18\x05 \x01(\x04R\x06feeBps\"\x98\x01\n" +
+	")PrepareTransferCollectionOwnershipRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tnew_owner\x18\x04 \x01(\tR\bnewOwner\"\x85\x01\n" +
+	"\x18PrepareSetBaseURIRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
+	"\bbase_uri\x18\x04 \x01(\tR\abaseUri\"\xa2\x01\n" +
+	"\x1dPrepareSetPayoutSplitsRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x121\n" +
+	"\x06splits\x18\x04 \x03(\v2\x19.orchestrator.PayoutSplitR\x06splits\"f\n" +
+	"\x1ePrepareCollectionAdminResponse\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12'\n" +
+	"\x02tx\x18\x02 \x01(\v2\x17.orchestrator.TxRequestR\x02tx\"\xcc\x02\n" +
+	"\x1bPrepareCreateAuctionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x02 \x01(\tR\n" +
+	"collection\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06seller\x18\x04 \x01(\tR\x06seller\x12!\n" +
+	"\fauction_type\x18\x05 \x01(\tR\vauctionType\x12\x1f\n" +
+	"\vstart_price\x18\x06 \x01(\tR\n" +
+	"startPrice\x12#\n" +
+	"\rreserve_price\x18\a \x01(\tR\freservePrice\x12\x1b\n" +
+	"\tend_price\x18\b \x01(\tR\bendPrice\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\t \x01(\x04R\tstartTime\x12\x1a\n" +
+	"\bduration\x18\n" +
+	" \x01(\x04R\bduration\"}\n" +
+	"\x11PrepareBidRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x02 \x01(\tR\tauctionId\x12\x16\n" +
+	"\x06bidder\x18\x03 \x01(\tR\x06bidder\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\"o\n" +
+	"\x1bPrepareSettleAuctionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"auction_id\x18\x02 \x01(\tR\tauctionId\x12\x16\n" +
+	"\x06caller\x18\x03 \x01(\tR\x06caller\"^\n" +
+	"\x16PrepareAuctionResponse\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12'\n" +
+	"\x02tx\x18\x02 \x01(\v2\x17.orchestrator.TxRequestR\x02tx\"5\n" +
 	"\x16GetIntentStatusRequest\x12\x1b\n" +
-	"\tintent_id\x18\x01 \x01(\tR\bintentId\"\xc1\x01\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\"\xae\x02\n" +
 	"\x17GetIntentStatusResponse\x12\x1b\n" +
 	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12\x12\n" +
 	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x19\n" +
 	"\bchain_id\x18\x04 \x01(\tR\achainId\x12\x17\n" +
 	"\atx_hash\x18\x05 \x01(\tR\x06txHash\x12)\n" +
-	"\x10contract_address\x18\x06 \x01(\tR\x0fcontractAddress2\x89\x03\n" +
+	"\x10contract_address\x18\x06 \x01(\tR\x0fcontractAddress\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x1a\n" +
+	"\brecovery\x18\b \x01(\tR\brecovery\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x93\x01\n" +
+	"\x12ListIntentsRequest\x12\x16\n" +
+	"\x06signer\x18\x01 \x01(\tR\x06signer\x122\n" +
+	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x1b\n" +
+	"\tbefore_id\x18\x03 \x01(\tR\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xbe\x02\n" +
+	"\x06Intent\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x19\n" +
+	"\bchain_id\x18\x04 \x01(\tR\achainId\x12\x17\n" +
+	"\atx_hash\x18\x05 \x01(\tR\x06txHash\x12)\n" +
+	"\x10contract_address\x18\x06 \x01(\tR\x0fcontractAddress\x12\x16\n" +
+	"\x06signer\x18\a \x01(\tR\x06signer\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"E\n" +
+	"\x13ListIntentsResponse\x12.\n" +
+	"\aintents\x18\x01 \x03(\v2\x14.orchestrator.IntentR\aintents\"9\n" +
+	"\x1cGetCollectionDefaultsRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\"\x9a\x01\n" +
+	"\x15CollectionConstraints\x12&\n" +
+	"\x0fmax_royalty_bps\x18\x01 \x01(\x04R\rmaxRoyaltyBps\x123\n" +
+	"\x16min_stage_duration_sec\x18\x02 \x01(\x04R\x13minStageDurationSec\x12$\n" +
+	"\x0emax_supply_cap\x18\x03 \x01(\x04R\fmaxSupplyCap\"\xd7\x02\n" +
+	"\x1dGetCollectionDefaultsResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12E\n" +
+	"\vconstraints\x18\x02 \x01(\v2#.orchestrator.CollectionConstraintsR\vconstraints\x12\x1f\n" +
+	"\vroyalty_fee\x18\x03 \x01(\x04R\n" +
+	"royaltyFee\x12\x1d\n" +
+	"\n" +
+	"max_supply\x18\x04 \x01(\x04R\tmaxSupply\x121\n" +
+	"\x15mint_limit_per_wallet\x18\x05 \x01(\x04R\x12mintLimitPerWallet\x128\n" +
+	"\x18allowlist_stage_duration\x18\x06 \x01(\x04R\x16allowlistStageDuration\x12'\n" +
+	"\x0fsupported_types\x18\a \x03(\tR\x0esupportedTypes\"p\n" +
+	"\x1ePrepareImportCollectionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x9a\x01\n" +
+	"\x1fPrepareImportCollectionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1b\n" +
+	"\tissued_at\x18\x02 \x01(\tR\bissuedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\x12!\n" +
+	"\fchallenge_id\x18\x04 \x01(\tR\vchallengeId\"\xcb\x01\n" +
+	"\x17ImportCollectionRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1f\n" +
+	"\tissued_at\x18\x04 \x01(\tB\x02\x18\x01R\bissuedAt\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\x12!\n" +
+	"\fchallenge_id\x18\x06 \x01(\tR\vchallengeId\"\xd0\x01\n" +
+	"\x18ImportCollectionResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x1a\n" +
+	"\bstandard\x18\x03 \x01(\tR\bstandard\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x06 \x01(\tR\x06symbol\x12\x1f\n" +
+	"\vstart_block\x18\a \x01(\x04R\n" +
+	"startBlock\"D\n" +
+	"\x10AirdropRecipient\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"\xab\x02\n" +
+	"\x15PrepareAirdropRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bstandard\x18\x04 \x01(\tR\bstandard\x12\x19\n" +
+	"\btoken_id\x18\x05 \x01(\tR\atokenId\x12\x1f\n" +
+	"\vsnapshot_id\x18\x06 \x01(\tR\n" +
+	"snapshotId\x12>\n" +
+	"\n" +
+	"recipients\x18\a \x03(\v2\x1e.orchestrator.AirdropRecipientR\n" +
+	"recipients\x12*\n" +
+	"\x11amount_per_holder\x18\b \x01(\x04R\x0famountPerHolder\"\xc3\x01\n" +
+	"\fAirdropBatch\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\rR\x03seq\x12>\n" +
+	"\n" +
+	"recipients\x18\x03 \x03(\v2\x1e.orchestrator.AirdropRecipientR\n" +
+	"recipients\x12\x1b\n" +
+	"\tgas_limit\x18\x04 \x01(\x04R\bgasLimit\x12'\n" +
+	"\x02tx\x18\x05 \x01(\v2\x17.orchestrator.TxRequestR\x02tx\"\xcb\x01\n" +
+	"\x16PrepareAirdropResponse\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x03 \x01(\tR\bcontract\x12'\n" +
+	"\x0frecipient_count\x18\x04 \x01(\rR\x0erecipientCount\x124\n" +
+	"\abatches\x18\x05 \x03(\v2\x1a.orchestrator.AirdropBatchR\abatches\"Q\n" +
+	"\x19GetAirdropProgressRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xc9\x02\n" +
+	"\x1aGetAirdropProgressResponse\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x1a\n" +
+	"\bcontract\x18\x03 \x01(\tR\bcontract\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12'\n" +
+	"\x0frecipient_count\x18\x05 \x01(\rR\x0erecipientCount\x12\x1f\n" +
+	"\vbatch_count\x18\x06 \x01(\rR\n" +
+	"batchCount\x12\x1c\n" +
+	"\tconfirmed\x18\a \x01(\rR\tconfirmed\x12\x16\n" +
+	"\x06failed\x18\b \x01(\rR\x06failed\x12?\n" +
+	"\abatches\x18\t \x03(\v2%.orchestrator.GetIntentStatusResponseR\abatches\"q\n" +
+	"\x10BulkTransferItem\x12\x1a\n" +
+	"\bcontract\x18\x01 \x01(\tR\bcontract\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\"\xb6\x01\n" +
+	"\x1aPrepareBulkTransferRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\x124\n" +
+	"\x05items\x18\x05 \x03(\v2\x1e.orchestrator.BulkTransferItemR\x05items\"F\n" +
+	"\x11BulkTransferToken\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\tR\atokenId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"\x8a\x01\n" +
+	"\x14BulkTransferApproval\x12\x1a\n" +
+	"\bcontract\x18\x01 \x01(\tR\bcontract\x12-\n" +
+	"\x05check\x18\x02 \x01(\v2\x17.orchestrator.TxRequestR\x05check\x12'\n" +
+	"\x02tx\x18\x03 \x01(\v2\x17.orchestrator.TxRequestR\x02tx\"\xec\x01\n" +
+	"\x11BulkTransferBatch\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\rR\x03seq\x12\x1a\n" +
+	"\bcontract\x18\x03 \x01(\tR\bcontract\x12\x1a\n" +
+	"\bstandard\x18\x04 \x01(\tR\bstandard\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x127\n" +
+	"\x06tokens\x18\x06 \x03(\v2\x1f.orchestrator.BulkTransferTokenR\x06tokens\x12'\n" +
+	"\x02tx\x18\a \x01(\v2\x17.orchestrator.TxRequestR\x02tx\"\x9f\x02\n" +
+	"\x1bPrepareBulkTransferResponse\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x16\n" +
+	"\x06signer\x18\x04 \x01(\tR\x06signer\x12\x1f\n" +
+	"\vtoken_count\x18\x05 \x01(\rR\n" +
+	"tokenCount\x12@\n" +
+	"\tapprovals\x18\x06 \x03(\v2\".orchestrator.BulkTransferApprovalR\tapprovals\x129\n" +
+	"\abatches\x18\a \x03(\v2\x1f.orchestrator.BulkTransferBatchR\abatches\"V\n" +
+	"\x1eGetBulkTransferProgressRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xb2\x02\n" +
+	"\x1fGetBulkTransferProgressResponse\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x0frecipient_count\x18\x04 \x01(\rR\x0erecipientCount\x12\x1f\n" +
+	"\vbatch_count\x18\x05 \x01(\rR\n" +
+	"batchCount\x12\x1c\n" +
+	"\tconfirmed\x18\x06 \x01(\rR\tconfirmed\x12\x16\n" +
+	"\x06failed\x18\a \x01(\rR\x06failed\x12?\n" +
+	"\abatches\x18\b \x03(\v2%.orchestrator.GetIntentStatusResponseR\abatches\"\xbb\x01\n" +
+	"\x12CallTargetOverride\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x04 \x01(\tR\tgrantedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x80\x01\n" +
+	"\x16AllowCallTargetRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\"W\n" +
+	"\x17AllowCallTargetResponse\x12<\n" +
+	"\boverride\x18\x01 \x01(\v2 .orchestrator.CallTargetOverrideR\boverride\"i\n" +
+	"\x17RevokeCallTargetRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\"4\n" +
+	"\x18RevokeCallTargetResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\bR\arevoked\";\n" +
+	"\x1eListCallTargetOverridesRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\"a\n" +
+	"\x1fListCallTargetOverridesResponse\x12>\n" +
+	"\toverrides\x18\x01 \x03(\v2 .orchestrator.CallTargetOverrideR\toverrides\"\xf4\x01\n" +
+	"\x10SponsorshipGrant\x12\x19\n" +
+	"\bgrant_id\x18\x01 \x01(\tR\agrantId\x12\x1b\n" +
+	"\tintent_id\x18\x02 \x01(\tR\bintentId\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x04 \x01(\tR\n" +
+	"collection\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x19\n" +
+	"\bgas_gwei\x18\x06 \x01(\x03R\agasGwei\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"S\n" +
+	"\x19ReserveSponsorshipRequest\x12\x1b\n" +
+	"\tintent_id\x18\x01 \x01(\tR\bintentId\x12\x19\n" +
+	"\bgas_gwei\x18\x02 \x01(\x03R\agasGwei\"R\n" +
+	"\x1aReserveSponsorshipResponse\x124\n" +
+	"\x05grant\x18\x01 \x01(\v2\x1e.orchestrator.SponsorshipGrantR\x05grant\"m\n" +
+	"\x18SettleSponsorshipRequest\x12\x19\n" +
+	"\bgrant_id\x18\x01 \x01(\tR\agrantId\x12\x1d\n" +
+	"\n" +
+	"spent_gwei\x18\x02 \x01(\x03R\tspentGwei\x12\x17\n" +
+	"\atx_hash\x18\x03 \x01(\tR\x06txHash\"5\n" +
+	"\x19SettleSponsorshipResponse\x12\x18\n" +
+	"\acharged\x18\x01 \x01(\bR\acharged\"6\n" +
+	"\x19ReleaseSponsorshipRequest\x12\x19\n" +
+	"\bgrant_id\x18\x01 \x01(\tR\agrantId\"\x1c\n" +
+	"\x1aReleaseSponsorshipResponse\"\xad\x02\n" +
+	"\x11SponsorshipBudget\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12!\n" +
+	"\fbalance_gwei\x18\x02 \x01(\x03R\vbalanceGwei\x12$\n" +
+	"\x0etopped_up_gwei\x18\x03 \x01(\x03R\ftoppedUpGwei\x12\x1d\n" +
+	"\n" +
+	"spent_gwei\x18\x04 \x01(\x03R\tspentGwei\x12\x16\n" +
+	"\x06frozen\x18\x05 \x01(\bR\x06frozen\x12#\n" +
+	"\rfrozen_reason\x18\x06 \x01(\tR\ffrozenReason\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8a\x01\n" +
+	"\x1dTopUpSponsorshipBudgetRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1f\n" +
+	"\vamount_gwei\x18\x02 \x01(\x03R\n" +
+	"amountGwei\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\"Y\n" +
+	"\x1eTopUpSponsorshipBudgetResponse\x127\n" +
+	"\x06budget\x18\x01 \x01(\v2\x1f.orchestrator.SponsorshipBudgetR\x06budget\"\x89\x01\n" +
+	"!SetSponsorshipBudgetFrozenRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x16\n" +
+	"\x06frozen\x18\x02 \x01(\bR\x06frozen\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\"]\n" +
+	"\"SetSponsorshipBudgetFrozenResponse\x127\n" +
+	"\x06budget\x18\x01 \x01(\v2\x1f.orchestrator.SponsorshipBudgetR\x06budget\"\x1f\n" +
+	"\x1dListSponsorshipBudgetsRequest\"[\n" +
+	"\x1eListSponsorshipBudgetsResponse\x129\n" +
+	"\abudgets\x18\x01 \x03(\v2\x1f.orchestrator.SponsorshipBudgetR\abudgets2\xb8\x17\n" +
 	"\x13OrchestratorService\x12v\n" +
 	"\x17PrepareCreateCollection\x12,.orchestrator.PrepareCreateCollectionRequest\x1a-.orchestrator.PrepareCreateCollectionResponse\x12R\n" +
 	"\vPrepareMint\x12 .orchestrator.PrepareMintRequest\x1a!.orchestrator.PrepareMintResponse\x12F\n" +
 	"\aTrackTx\x12\x1c.orchestrator.TrackTxRequest\x1a\x1d.orchestrator.TrackTxResponse\x12^\n" +
-	"\x0fGetIntentStatus\x12$.orchestrator.GetIntentStatusRequest\x1a%.orchestrator.GetIntentStatusResponseB(Z&shared/proto/orchestrator;orchestratorb\x06proto3"
+	"\x0fGetIntentStatus\x12$.orchestrator.GetIntentStatusRequest\x1a%.orchestrator.GetIntentStatusResponse\x12R\n" +
+	"\vListIntents\x12 .orchestrator.ListIntentsRequest\x1a!.orchestrator.ListIntentsResponse\x12o\n" +
+	"\x14PrepareUpdateRoyalty\x12).orchestrator.PrepareUpdateRoyaltyRequest\x1a,.orchestrator.PrepareCollectionAdminResponse\x12\x8b\x01\n" +
+	"\"PrepareTransferCollectionOwnership\x127.orchestrator.PrepareTransferCollectionOwnershipRequest\x1a,.orchestrator.PrepareCollectionAdminResponse\x12i\n" +
+	"\x11PrepareSetBaseURI\x12&.orchestrator.PrepareSetBaseURIRequest\x1a,.orchestrator.PrepareCollectionAdminResponse\x12s\n" +
+	"\x16PrepareSetPayoutSplits\x12+.orchestrator.PrepareSetPayoutSplitsRequest\x1a,.orchestrator.PrepareCollectionAdminResponse\x12g\n" +
+	"\x14PrepareCreateAuction\x12).orchestrator.PrepareCreateAuctionRequest\x1a$.orchestrator.PrepareAuctionResponse\x12S\n" +
+	"\n" +
+	"PrepareBid\x12\x1f.orchestrator.PrepareBidRequest\x1a$.orchestrator.PrepareAuctionResponse\x12g\n" +
+	"\x14PrepareSettleAuction\x12).orchestrator.PrepareSettleAuctionRequest\x1a$.orchestrator.PrepareAuctionResponse\x12p\n" +
+	"\x15GetCollectionDefaults\x12*.orchestrator.GetCollectionDefaultsRequest\x1a+.orchestrator.GetCollectionDefaultsResponse\x12v\n" +
+	"\x17PrepareImportCollection\x12,.orchestrator.PrepareImportCollectionRequest\x1a-.orchestrator.PrepareImportCollectionResponse\x12a\n" +
+	"\x10ImportCollection\x12%.orchestrator.ImportCollectionRequest\x1a&.orchestrator.ImportCollectionResponse\x12[\n" +
+	"\x0ePrepareAirdrop\x12#.orchestrator.PrepareAirdropRequest\x1a$.orchestrator.PrepareAirdropResponse\x12g\n" +
+	"\x12GetAirdropProgress\x12'.orchestrator.GetAirdropProgressRequest\x1a(.orchestrator.GetAirdropProgressResponse\x12j\n" +
+	"\x13PrepareBulkTransfer\x12(.orchestrator.PrepareBulkTransferRequest\x1a).orchestrator.PrepareBulkTransferResponse\x12v\n" +
+	"\x17GetBulkTransferProgress\x12,.orchestrator.GetBulkTransferProgressRequest\x1a-.orchestrator.GetBulkTransferProgressResponse\x12^\n" +
+	"\x0fAllowCallTarget\x12$.orchestrator.AllowCallTargetRequest\x1a%.orchestrator.AllowCallTargetResponse\x12a\n" +
+	"\x10RevokeCallTarget\x12%.orchestrator.RevokeCallTargetRequest\x1a&.orchestrator.RevokeCallTargetResponse\x12v\n" +
+	"\x17ListCallTargetOverrides\x12,.orchestrator.ListCallTargetOverridesRequest\x1a-.orchestrator.ListCallTargetOverridesResponse\x12g\n" +
+	"\x12ReserveSponsorship\x12'.orchestrator.ReserveSponsorshipRequest\x1a(.orchestrator.ReserveSponsorshipResponse\x12d\n" +
+	"\x11SettleSponsorship\x12&.orchestrator.SettleSponsorshipRequest\x1a'.orchestrator.SettleSponsorshipResponse\x12g\n" +
+	"\x12ReleaseSponsorship\x12'.orchestrator.ReleaseSponsorshipRequest\x1a(.orchestrator.ReleaseSponsorshipResponse\x12s\n" +
+	"\x16TopUpSponsorshipBudget\x12+.orchestrator.TopUpSponsorshipBudgetRequest\x1a,.orchestrator.TopUpSponsorshipBudgetResponse\x12\x7f\n" +
+	"\x1aSetSponsorshipBudgetFrozen\x12/.orchestrator.SetSponsorshipBudgetFrozenRequest\x1a0.orchestrator.SetSponsorshipBudgetFrozenResponse\x12s\n" +
+	"\x16ListSponsorshipBudgets\x12+.orchestrator.ListSponsorshipBudgetsRequest\x1a,.orchestrator.ListSponsorshipBudgetsResponseB(Z&shared/proto/orchestrator;orchestratorb\x06proto3"
 
 var (
 	file_orchestrator_proto_rawDescOnce sync.Once
@@ -742,34 +4776,170 @@ func file_orchestrator_proto_rawDescGZIP() []byte {
 	return file_orchestrator_proto_rawDescData
 }
 
-var file_orchestrator_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_orchestrator_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_orchestrator_proto_goTypes = []any{
-	(*TxRequest)(nil),                       // 0: orchestrator.TxRequest
-	(*PrepareCreateCollectionRequest)(nil),  // 1: orchestrator.PrepareCreateCollectionRequest
-	(*PrepareCreateCollectionResponse)(nil), // 2: orchestrator.PrepareCreateCollectionResponse
-	(*PrepareMintRequest)(nil),              // 3: orchestrator.PrepareMintRequest
-	(*PrepareMintResponse)(nil),             // 4: orchestrator.PrepareMintResponse
-	(*TrackTxRequest)(nil),                  // 5: orchestrator.TrackTxRequest
-	(*TrackTxResponse)(nil),                 // 6: orchestrator.TrackTxResponse
-	(*GetIntentStatusRequest)(nil),          // 7: orchestrator.GetIntentStatusRequest
-	(*GetIntentStatusResponse)(nil),         // 8: orchestrator.GetIntentStatusResponse
+	(*TxRequest)(nil),                                 // 0: orchestrator.TxRequest
+	(*PrepareCreateCollectionRequest)(nil),            // 1: orchestrator.PrepareCreateCollectionRequest
+	(*PayoutSplit)(nil),                               // 2: orchestrator.PayoutSplit
+	(*PrepareCreateCollectionResponse)(nil),           // 3: orchestrator.PrepareCreateCollectionResponse
+	(*PrepareMintRequest)(nil),                        // 4: orchestrator.PrepareMintRequest
+	(*PrepareMintResponse)(nil),                       // 5: orchestrator.PrepareMintResponse
+	(*TrackTxRequest)(nil),                            // 6: orchestrator.TrackTxRequest
+	(*TrackTxResponse)(nil),                           // 7: orchestrator.TrackTxResponse
+	(*PrepareUpdateRoyaltyRequest)(nil),               // 8: orchestrator.PrepareUpdateRoyaltyRequest
+	(*PrepareTransferCollectionOwnershipRequest)(nil), // 9: orchestrator.PrepareTransferCollectionOwnershipRequest
+	(*PrepareSetBaseURIRequest)(nil),                  // 10: orchestrator.PrepareSetBaseURIRequest
+	(*PrepareSetPayoutSplitsRequest)(nil),             // 11: orchestrator.PrepareSetPayoutSplitsRequest
+	(*PrepareCollectionAdminResponse)(nil),            // 12: orchestrator.PrepareCollectionAdminResponse
+	(*PrepareCreateAuctionRequest)(nil),               // 13: orchestrator.PrepareCreateAuctionRequest
+	(*PrepareBidRequest)(nil),                         // 14: orchestrator.PrepareBidRequest
+	(*PrepareSettleAuctionRequest)(nil),               // 15: orchestrator.PrepareSettleAuctionRequest
+	(*PrepareAuctionResponse)(nil),                    // 16: orchestrator.PrepareAuctionResponse
+	(*GetIntentStatusRequest)(nil),                    // 17: orchestrator.GetIntentStatusRequest
+	(*GetIntentStatusResponse)(nil),                   // 18: orchestrator.GetIntentStatusResponse
+	(*ListIntentsRequest)(nil),                        // 19: orchestrator.ListIntentsRequest
+	(*Intent)(nil),                                    // 20: orchestrator.Intent
+	(*ListIntentsResponse)(nil),                       // 21: orchestrator.ListIntentsResponse
+	(*GetCollectionDefaultsRequest)(nil),              // 22: orchestrator.GetCollectionDefaultsRequest
+	(*CollectionConstraints)(nil),                     // 23: orchestrator.CollectionConstraints
+	(*GetCollectionDefaultsResponse)(nil),             // 24: orchestrator.GetCollectionDefaultsResponse
+	(*PrepareImportCollectionRequest)(nil),            // 25: orchestrator.PrepareImportCollectionRequest
+	(*PrepareImportCollectionResponse)(nil),           // 26: orchestrator.PrepareImportCollectionResponse
+	(*ImportCollectionRequest)(nil),                   // 27: orchestrator.ImportCollectionRequest
+	(*ImportCollectionResponse)(nil),                  // 28: orchestrator.ImportCollectionResponse
+	(*AirdropRecipient)(nil),                          // 29: orchestrator.AirdropRecipient
+	(*PrepareAirdropRequest)(nil),                     // 30: orchestrator.PrepareAirdropRequest
+	(*AirdropBatch)(nil),                              // 31: orchestrator.AirdropBatch
+	(*PrepareAirdropResponse)(nil),                    // 32: orchestrator.PrepareAirdropResponse
+	(*GetAirdropProgressRequest)(nil),                 // 33: orchestrator.GetAirdropProgressRequest
+	(*GetAirdropProgressResponse)(nil),                // 34: orchestrator.GetAirdropProgressResponse
+	(*BulkTransferItem)(nil),                          // 35: orchestrator.BulkTransferItem
+	(*PrepareBulkTransferRequest)(nil),                // 36: orchestrator.PrepareBulkTransferRequest
+	(*BulkTransferToken)(nil),                         // 37: orchestrator.BulkTransferToken
+	(*BulkTransferApproval)(nil),                      // 38: orchestrator.BulkTransferApproval
+	(*BulkTransferBatch)(nil),                         // 39: orchestrator.BulkTransferBatch
+	(*PrepareBulkTransferResponse)(nil),               // 40: orchestrator.PrepareBulkTransferResponse
+	(*GetBulkTransferProgressRequest)(nil),            // 41: orchestrator.GetBulkTransferProgressRequest
+	(*GetBulkTransferProgressResponse)(nil),           // 42: orchestrator.GetBulkTransferProgressResponse
+	(*CallTargetOverride)(nil),                        // 43: orchestrator.CallTargetOverride
+	(*AllowCallTargetRequest)(nil),                    // 44: orchestrator.AllowCallTargetRequest
+	(*AllowCallTargetResponse)(nil),                   // 45: orchestrator.AllowCallTargetResponse
+	(*RevokeCallTargetRequest)(nil),                   // 46: orchestrator.RevokeCallTargetRequest
+	(*RevokeCallTargetResponse)(nil),                  // 47: orchestrator.RevokeCallTargetResponse
+	(*ListCallTargetOverridesRequest)(nil),            // 48: orchestrator.ListCallTargetOverridesRequest
+	(*ListCallTargetOverridesResponse)(nil),           // 49: orchestrator.ListCallTargetOverridesResponse
+	(*SponsorshipGrant)(nil),                          // 50: orchestrator.SponsorshipGrant
+	(*ReserveSponsorshipRequest)(nil),                 // 51: orchestrator.ReserveSponsorshipRequest
+	(*ReserveSponsorshipResponse)(nil),                // 52: orchestrator.ReserveSponsorshipResponse
+	(*SettleSponsorshipRequest)(nil),                  // 53: orchestrator.SettleSponsorshipRequest
+	(*SettleSponsorshipResponse)(nil),                 // 54: orchestrator.SettleSponsorshipResponse
+	(*ReleaseSponsorshipRequest)(nil),                 // 55: orchestrator.ReleaseSponsorshipRequest
+	(*ReleaseSponsorshipResponse)(nil),                // 56: orchestrator.ReleaseSponsorshipResponse
+	(*SponsorshipBudget)(nil),                         // 57: orchestrator.SponsorshipBudget
+	(*TopUpSponsorshipBudgetRequest)(nil),             // 58: orchestrator.TopUpSponsorshipBudgetRequest
+	(*TopUpSponsorshipBudgetResponse)(nil),            // 59: orchestrator.TopUpSponsorshipBudgetResponse
+	(*SetSponsorshipBudgetFrozenRequest)(nil),         // 60: orchestrator.SetSponsorshipBudgetFrozenRequest
+	(*SetSponsorshipBudgetFrozenResponse)(nil),        // 61: orchestrator.SetSponsorshipBudgetFrozenResponse
+	(*ListSponsorshipBudgetsRequest)(nil),             // 62: orchestrator.ListSponsorshipBudgetsRequest
+	(*ListSponsorshipBudgetsResponse)(nil),            // 63: orchestrator.ListSponsorshipBudgetsResponse
+	(*timestamppb.Timestamp)(nil),                     // 64: google.protobuf.Timestamp
 }
 var file_orchestrator_proto_depIdxs = []int32{
-	0, // 0: orchestrator.PrepareCreateCollectionResponse.tx:type_name -> orchestrator.TxRequest
-	0, // 1: orchestrator.PrepareMintResponse.tx:type_name -> orchestrator.TxRequest
-	1, // 2: orchestrator.OrchestratorService.PrepareCreateCollection:input_type -> orchestrator.PrepareCreateCollectionRequest
-	3, // 3: orchestrator.OrchestratorService.PrepareMint:input_type -> orchestrator.PrepareMintRequest
-	5, // 4: orchestrator.OrchestratorService.TrackTx:input_type -> orchestrator.TrackTxRequest
-	7, // 5: orchestrator.OrchestratorService.GetIntentStatus:input_type -> orchestrator.GetIntentStatusRequest
-	2, // 6: orchestrator.OrchestratorService.PrepareCreateCollection:output_type -> orchestrator.PrepareCreateCollectionResponse
-	4, // 7: orchestrator.OrchestratorService.PrepareMint:output_type -> orchestrator.PrepareMintResponse
-	6, // 8: orchestrator.OrchestratorService.TrackTx:output_type -> orchestrator.TrackTxResponse
-	8, // 9: orchestrator.OrchestratorService.GetIntentStatus:output_type -> orchestrator.GetIntentStatusResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2,  // 0: orchestrator.PrepareCreateCollectionRequest.payout_splits:type_name -> orchestrator.PayoutSplit
+	0,  // 1: orchestrator.PrepareCreateCollectionResponse.tx:type_name -> orchestrator.TxRequest
+	0,  // 2: orchestrator.PrepareMintResponse.tx:type_name -> orchestrator.TxRequest
+	2,  // 3: orchestrator.PrepareSetPayoutSplitsRequest.splits:type_name -> orchestrator.PayoutSplit
+	0,  // 4: orchestrator.PrepareCollectionAdminResponse.tx:type_name -> orchestrator.TxRequest
+	0,  // 5: orchestrator.PrepareAuctionResponse.tx:type_name -> orchestrator.TxRequest
+	64, // 6: orchestrator.GetIntentStatusResponse.updated_at:type_name -> google.protobuf.Timestamp
+	64, // 7: orchestrator.ListIntentsRequest.before:type_name -> google.protobuf.Timestamp
+	64, // 8: orchestrator.Intent.created_at:type_name -> google.protobuf.Timestamp
+	64, // 9: orchestrator.Intent.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 10: orchestrator.ListIntentsResponse.intents:type_name -> orchestrator.Intent
+	23, // 11: orchestrator.GetCollectionDefaultsResponse.constraints:type_name -> orchestrator.CollectionConstraints
+	29, // 12: orchestrator.PrepareAirdropRequest.recipients:type_name -> orchestrator.AirdropRecipient
+	29, // 13: orchestrator.AirdropBatch.recipients:type_name -> orchestrator.AirdropRecipient
+	0,  // 14: orchestrator.AirdropBatch.tx:type_name -> orchestrator.TxRequest
+	31, // 15: orchestrator.PrepareAirdropResponse.batches:type_name -> orchestrator.AirdropBatch
+	18, // 16: orchestrator.GetAirdropProgressResponse.batches:type_name -> orchestrator.GetIntentStatusResponse
+	35, // 17: orchestrator.PrepareBulkTransferRequest.items:type_name -> orchestrator.BulkTransferItem
+	0,  // 18: orchestrator.BulkTransferApproval.check:type_name -> orchestrator.TxRequest
+	0,  // 19: orchestrator.BulkTransferApproval.tx:type_name -> orchestrator.TxRequest
+	37, // 20: orchestrator.BulkTransferBatch.tokens:type_name -> orchestrator.BulkTransferToken
+	0,  // 21: orchestrator.BulkTransferBatch.tx:type_name -> orchestrator.TxRequest
+	38, // 22: orchestrator.PrepareBulkTransferResponse.approvals:type_name -> orchestrator.BulkTransferApproval
+	39, // 23: orchestrator.PrepareBulkTransferResponse.batches:type_name -> orchestrator.BulkTransferBatch
+	18, // 24: orchestrator.GetBulkTransferProgressResponse.batches:type_name -> orchestrator.GetIntentStatusResponse
+	64, // 25: orchestrator.CallTargetOverride.created_at:type_name -> google.protobuf.Timestamp
+	43, // 26: orchestrator.AllowCallTargetResponse.override:type_name -> orchestrator.CallTargetOverride
+	43, // 27: orchestrator.ListCallTargetOverridesResponse.overrides:type_name -> orchestrator.CallTargetOverride
+	64, // 28: orchestrator.SponsorshipGrant.expires_at:type_name -> google.protobuf.Timestamp
+	50, // 29: orchestrator.ReserveSponsorshipResponse.grant:type_name -> orchestrator.SponsorshipGrant
+	64, // 30: orchestrator.SponsorshipBudget.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 31: orchestrator.TopUpSponsorshipBudgetResponse.budget:type_name -> orchestrator.SponsorshipBudget
+	57, // 32: orchestrator.SetSponsorshipBudgetFrozenResponse.budget:type_name -> orchestrator.SponsorshipBudget
+	57, // 33: orchestrator.ListSponsorshipBudgetsResponse.budgets:type_name -> orchestrator.SponsorshipBudget
+	1,  // 34: orchestrator.OrchestratorService.PrepareCreateCollection:input_type -> orchestrator.PrepareCreateCollectionRequest
+	4,  // 35: orchestrator.OrchestratorService.PrepareMint:input_type -> orchestrator.PrepareMintRequest
+	6,  // 36: orchestrator.OrchestratorService.TrackTx:input_type -> orchestrator.TrackTxRequest
+	17, // 37: orchestrator.OrchestratorService.GetIntentStatus:input_type -> orchestrator.GetIntentStatusRequest
+	19, // 38: orchestrator.OrchestratorService.ListIntents:input_type -> orchestrator.ListIntentsRequest
+	8,  // 39: orchestrator.OrchestratorService.PrepareUpdateRoyalty:input_type -> orchestrator.PrepareUpdateRoyaltyRequest
+	9,  // 40: orchestrator.OrchestratorService.PrepareTransferCollectionOwnership:input_type -> orchestrator.PrepareTransferCollectionOwnershipRequest
+	10, // 41: orchestrator.OrchestratorService.PrepareSetBaseURI:input_type -> orchestrator.PrepareSetBaseURIRequest
+	11, // 42: orchestrator.OrchestratorService.PrepareSetPayoutSplits:input_type -> orchestrator.PrepareSetPayoutSplitsRequest
+	13, // 43: orchestrator.OrchestratorService.PrepareCreateAuction:input_type -> orchestrator.PrepareCreateAuctionRequest
+	14, // 44: orchestrator.OrchestratorService.PrepareBid:input_type -> orchestrator.PrepareBidRequest
+	15, // 45: orchestrator.OrchestratorService.PrepareSettleAuction:input_type -> orchestrator.PrepareSettleAuctionRequest
+	22, // 46: orchestrator.OrchestratorService.GetCollectionDefaults:input_type -> orchestrator.GetCollectionDefaultsRequest
+	25, // 47: orchestrator.OrchestratorService.PrepareImportCollection:input_type -> orchestrator.PrepareImportCollectionRequest
+	27, // 48: orchestrator.OrchestratorService.ImportCollection:input_type -> orchestrator.ImportCollectionRequest
+	30, // 49: orchestrator.OrchestratorService.PrepareAirdrop:input_type -> orchestrator.PrepareAirdropRequest
+	33, // 50: orchestrator.OrchestratorService.GetAirdropProgress:input_type -> orchestrator.GetAirdropProgressRequest
+	36, // 51: orchestrator.OrchestratorService.PrepareBulkTransfer:input_type -> orchestrator.PrepareBulkTransferRequest
+	41, // 52: orchestrator.OrchestratorService.GetBulkTransferProgress:input_type -> orchestrator.GetBulkTransferProgressRequest
+	44, // 53: orchestrator.OrchestratorService.AllowCallTarget:input_type -> orchestrator.AllowCallTargetRequest
+	46, // 54: orchestrator.OrchestratorService.RevokeCallTarget:input_type -> orchestrator.RevokeCallTargetRequest
+	48, // 55: orchestrator.OrchestratorService.ListCallTargetOverrides:input_type -> orchestrator.ListCallTargetOverridesRequest
+	51, // 56: orchestrator.OrchestratorService.ReserveSponsorship:input_type -> orchestrator.ReserveSponsorshipRequest
+	53, // 57: orchestrator.OrchestratorService.SettleSponsorship:input_type -> orchestrator.SettleSponsorshipRequest
+	55, // 58: orchestrator.OrchestratorService.ReleaseSponsorship:input_type -> orchestrator.ReleaseSponsorshipRequest
+	58, // 59: orchestrator.OrchestratorService.TopUpSponsorshipBudget:input_type -> orchestrator.TopUpSponsorshipBudgetRequest
+	60, // 60: orchestrator.OrchestratorService.SetSponsorshipBudgetFrozen:input_type -> orchestrator.SetSponsorshipBudgetFrozenRequest
+	62, // 61: orchestrator.OrchestratorService.ListSponsorshipBudgets:input_type -> orchestrator.ListSponsorshipBudgetsRequest
+	3,  // 62: orchestrator.OrchestratorService.PrepareCreateCollection:output_type -> orchestrator.PrepareCreateCollectionResponse
+	5,  // 63: orchestrator.OrchestratorService.PrepareMint:output_type -> orchestrator.PrepareMintResponse
+	7,  // 64: orchestrator.OrchestratorService.TrackTx:output_type -> orchestrator.TrackTxResponse
+	18, // 65: orchestrator.OrchestratorService.GetIntentStatus:output_type -> orchestrator.GetIntentStatusResponse
+	21, // 66: orchestrator.OrchestratorService.ListIntents:output_type -> orchestrator.ListIntentsResponse
+	12, // 67: orchestrator.OrchestratorService.PrepareUpdateRoyalty:output_type -> orchestrator.PrepareCollectionAdminResponse
+	12, // 68: orchestrator.OrchestratorService.PrepareTransferCollectionOwnership:output_type -> orchestrator.PrepareCollectionAdminResponse
+	12, // 69: orchestrator.OrchestratorService.PrepareSetBaseURI:output_type -> orchestrator.PrepareCollectionAdminResponse
+	12, // 70: orchestrator.OrchestratorService.PrepareSetPayoutSplits:output_type -> orchestrator.PrepareCollectionAdminResponse
+	16, // 71: orchestrator.OrchestratorService.PrepareCreateAuction:output_type -> orchestrator.PrepareAuctionResponse
+	16, // 72: orchestrator.OrchestratorService.PrepareBid:output_type -> orchestrator.PrepareAuctionResponse
+	16, // 73: orchestrator.OrchestratorService.PrepareSettleAuction:output_type -> orchestrator.PrepareAuctionResponse
+	24, // 74: orchestrator.OrchestratorService.GetCollectionDefaults:output_type -> orchestrator.GetCollectionDefaultsResponse
+	26, // 75: orchestrator.OrchestratorService.PrepareImportCollection:output_type -> orchestrator.PrepareImportCollectionResponse
+	28, // 76: orchestrator.OrchestratorService.ImportCollection:output_type -> orchestrator.ImportCollectionResponse
+	32, // 77: orchestrator.OrchestratorService.PrepareAirdrop:output_type -> orchestrator.PrepareAirdropResponse
+	34, // 78: orchestrator.OrchestratorService.GetAirdropProgress:output_type -> orchestrator.GetAirdropProgressResponse
+	40, // 79: orchestrator.OrchestratorService.PrepareBulkTransfer:output_type -> orchestrator.PrepareBulkTransferResponse
+	42, // 80: orchestrator.OrchestratorService.GetBulkTransferProgress:output_type -> orchestrator.GetBulkTransferProgressResponse
+	45, // 81: orchestrator.OrchestratorService.AllowCallTarget:output_type -> orchestrator.AllowCallTargetResponse
+	47, // 82: orchestrator.OrchestratorService.RevokeCallTarget:output_type -> orchestrator.RevokeCallTargetResponse
+	49, // 83: orchestrator.OrchestratorService.ListCallTargetOverrides:output_type -> orchestrator.ListCallTargetOverridesResponse
+	52, // 84: orchestrator.OrchestratorService.ReserveSponsorship:output_type -> orchestrator.ReserveSponsorshipResponse
+	54, // 85: orchestrator.OrchestratorService.SettleSponsorship:output_type -> orchestrator.SettleSponsorshipResponse
+	56, // 86: orchestrator.OrchestratorService.ReleaseSponsorship:output_type -> orchestrator.ReleaseSponsorshipResponse
+	59, // 87: orchestrator.OrchestratorService.TopUpSponsorshipBudget:output_type -> orchestrator.TopUpSponsorshipBudgetResponse
+	61, // 88: orchestrator.OrchestratorService.SetSponsorshipBudgetFrozen:output_type -> orchestrator.SetSponsorshipBudgetFrozenResponse
+	63, // 89: orchestrator.OrchestratorService.ListSponsorshipBudgets:output_type -> orchestrator.ListSponsorshipBudgetsResponse
+	62, // [62:90] is the sub-list for method output_type
+	34, // [34:62] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_orchestrator_proto_init() }
@@ -783,7 +4953,7 @@ func file_orchestrator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orchestrator_proto_rawDesc), len(file_orchestrator_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
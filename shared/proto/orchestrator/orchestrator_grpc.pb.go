@@ -20,10 +20,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrchestratorService_PrepareCreateCollection_FullMethodName = "/orchestrator.OrchestratorService/PrepareCreateCollection"
-	OrchestratorService_PrepareMint_FullMethodName             = "/orchestrator.OrchestratorService/PrepareMint"
-	OrchestratorService_TrackTx_FullMethodName                 = "/orchestrator.OrchestratorService/TrackTx"
-	OrchestratorService_GetIntentStatus_FullMethodName         = "/orchestrator.OrchestratorService/GetIntentStatus"
+	OrchestratorService_PrepareCreateCollection_FullMethodName            = "/orchestrator.OrchestratorService/PrepareCreateCollection"
+	OrchestratorService_PrepareMint_FullMethodName                        = "/orchestrator.OrchestratorService/PrepareMint"
+	OrchestratorService_TrackTx_FullMethodName                            = "/orchestrator.OrchestratorService/TrackTx"
+	OrchestratorService_GetIntentStatus_FullMethodName                    = "/orchestrator.OrchestratorService/GetIntentStatus"
+	OrchestratorService_ListIntents_FullMethodName                        = "/orchestrator.OrchestratorService/ListIntents"
+	OrchestratorService_PrepareUpdateRoyalty_FullMethodName               = "/orchestrator.OrchestratorService/PrepareUpdateRoyalty"
+	OrchestratorService_PrepareTransferCollectionOwnership_FullMethodName = "/orchestrator.OrchestratorService/PrepareTransferCollectionOwnership"
+	OrchestratorService_PrepareSetBaseURI_FullMethodName                  = "/orchestrator.OrchestratorService/PrepareSetBaseURI"
+	OrchestratorService_PrepareSetPayoutSplits_FullMethodName             = "/orchestrator.OrchestratorService/PrepareSetPayoutSplits"
+	OrchestratorService_PrepareCreateAuction_FullMethodName               = "/orchestrator.OrchestratorService/PrepareCreateAuction"
+	OrchestratorService_PrepareBid_FullMethodName                         = "/orchestrator.OrchestratorService/PrepareBid"
+	OrchestratorService_PrepareSettleAuction_FullMethodName               = "/orchestrator.OrchestratorService/PrepareSettleAuction"
+	OrchestratorService_GetCollectionDefaults_FullMethodName              = "/orchestrator.OrchestratorService/GetCollectionDefaults"
+	OrchestratorService_PrepareImportCollection_FullMethodName            = "/orchestrator.OrchestratorService/PrepareImportCollection"
+	OrchestratorService_ImportCollection_FullMethodName                   = "/orchestrator.OrchestratorService/ImportCollection"
+	OrchestratorService_PrepareAirdrop_FullMethodName                     = "/orchestrator.OrchestratorService/PrepareAirdrop"
+	OrchestratorService_GetAirdropProgress_FullMethodName                 = "/orchestrator.OrchestratorService/GetAirdropProgress"
+	OrchestratorService_PrepareBulkTransfer_FullMethodName                = "/orchestrator.OrchestratorService/PrepareBulkTransfer"
+	OrchestratorService_GetBulkTransferProgress_FullMethodName            = "/orchestrator.OrchestratorService/GetBulkTransferProgress"
+	OrchestratorService_AllowCallTarget_FullMethodName                    = "/orchestrator.OrchestratorService/AllowCallTarget"
+	OrchestratorService_RevokeCallTarget_FullMethodName                   = "/orchestrator.OrchestratorService/RevokeCallTarget"
+	OrchestratorService_ListCallTargetOverrides_FullMethodName            = "/orchestrator.OrchestratorService/ListCallTargetOverrides"
+	OrchestratorService_ReserveSponsorship_FullMethodName                 = "/orchestrator.OrchestratorService/ReserveSponsorship"
+	OrchestratorService_SettleSponsorship_FullMethodName                  = "/orchestrator.OrchestratorService/SettleSponsorship"
+	OrchestratorService_ReleaseSponsorship_FullMethodName                 = "/orchestrator.OrchestratorService/ReleaseSponsorship"
+	OrchestratorService_TopUpSponsorshipBudget_FullMethodName             = "/orchestrator.OrchestratorService/TopUpSponsorshipBudget"
+	OrchestratorService_SetSponsorshipBudgetFrozen_FullMethodName         = "/orchestrator.OrchestratorService/SetSponsorshipBudgetFrozen"
+	OrchestratorService_ListSponsorshipBudgets_FullMethodName             = "/orchestrator.OrchestratorService/ListSponsorshipBudgets"
 )
 
 // OrchestratorServiceClient is the client API for OrchestratorService service.
@@ -34,6 +58,30 @@ type OrchestratorServiceClient interface {
 	PrepareMint(ctx context.Context, in *PrepareMintRequest, opts ...grpc.CallOption) (*PrepareMintResponse, error)
 	TrackTx(ctx context.Context, in *TrackTxRequest, opts ...grpc.CallOption) (*TrackTxResponse, error)
 	GetIntentStatus(ctx context.Context, in *GetIntentStatusRequest, opts ...grpc.CallOption) (*GetIntentStatusResponse, error)
+	ListIntents(ctx context.Context, in *ListIntentsRequest, opts ...grpc.CallOption) (*ListIntentsResponse, error)
+	PrepareUpdateRoyalty(ctx context.Context, in *PrepareUpdateRoyaltyRequest, opts ...grpc.CallOption) (*PrepareCollectionAdminResponse, error)
+	PrepareTransferCollectionOwnership(ctx context.Context, in *PrepareTransferCollectionOwnershipRequest, opts ...grpc.CallOption) (*PrepareCollectionAdminResponse, error)
+	PrepareSetBaseURI(ctx context.Context, in *PrepareSetBaseURIRequest, opts ...grpc.CallOption) (*PrepareCollectionAdminResponse, error)
+	PrepareSetPayoutSplits(ctx context.Context, in *PrepareSetPayoutSplitsRequest, opts ...grpc.CallOption) (*PrepareCollectionAdminResponse, error)
+	PrepareCreateAuction(ctx context.Context, in *PrepareCreateAuctionRequest, opts ...grpc.CallOption) (*PrepareAuctionResponse, error)
+	PrepareBid(ctx context.Context, in *PrepareBidRequest, opts ...grpc.CallOption) (*PrepareAuctionResponse, error)
+	PrepareSettleAuction(ctx context.Context, in *PrepareSettleAuctionRequest, opts ...grpc.CallOption) (*PrepareAuctionResponse, error)
+	GetCollectionDefaults(ctx context.Context, in *GetCollectionDefaultsRequest, opts ...grpc.CallOption) (*GetCollectionDefaultsResponse, error)
+	PrepareImportCollection(ctx context.Context, in *PrepareImportCollectionRequest, opts ...grpc.CallOption) (*PrepareImportCollectionResponse, error)
+	ImportCollection(ctx context.Context, in *ImportCollectionRequest, opts ...grpc.CallOption) (*ImportCollectionResponse, error)
+	PrepareAirdrop(ctx context.Context, in *PrepareAirdropRequest, opts ...grpc.CallOption) (*PrepareAirdropResponse, error)
+	GetAirdropProgress(ctx context.Context, in *GetAirdropProgressRequest, opts ...grpc.CallOption) (*GetAirdropProgressResponse, error)
+	PrepareBulkTransfer(ctx context.Context, in *PrepareBulkTransferRequest, opts ...grpc.CallOption) (*PrepareBulkTransferResponse, error)
+	GetBulkTransferProgress(ctx context.Context, in *GetBulkTransferProgressRequest, opts ...grpc.CallOption) (*GetBulkTransferProgressResponse, error)
+	AllowCallTarget(ctx context.Context, in *AllowCallTargetRequest, opts ...grpc.CallOption) (*AllowCallTargetResponse, error)
+	RevokeCallTarget(ctx context.Context, in *RevokeCallTargetRequest, opts ...grpc.CallOption) (*RevokeCallTargetResponse, error)
+	ListCallTargetOverrides(ctx context.Context, in *ListCallTargetOverridesRequest, opts ...grpc.CallOption) (*ListCallTargetOverridesResponse, error)
+	ReserveSponsorship(ctx context.Context, in *ReserveSponsorshipRequest, opts ...grpc.CallOption) (*ReserveSponsorshipResponse, error)
+	SettleSponsorship(ctx context.Context, in *SettleSponsorshipRequest, opts ...grpc.CallOption) (*SettleSponsorshipResponse, error)
+	ReleaseSponsorship(ctx context.Context, in *ReleaseSponsorshipRequest, opts ...grpc.CallOption) (*ReleaseSponsorshipResponse, error)
+	TopUpSponsorshipBudget(ctx context.Context, in *TopUpSponsorshipBudgetRequest, opts ...grpc.CallOption) (*TopUpSponsorshipBudgetResponse, error)
+	SetSponsorshipBudgetFrozen(ctx context.Context, in *SetSponsorshipBudgetFrozenRequest, opts ...grpc.CallOption) (*SetSponsorshipBudgetFrozenResponse, error)
+	ListSponsorshipBudgets(ctx context.Context, in *ListSponsorshipBudgetsRequest, opts ...grpc.CallOption) (*ListSponsorshipBudgetsResponse, error)
 }
 
 type orchestratorServiceClient struct {
@@ -84,6 +132,246 @@ func (c *orchestratorServiceClient) GetIntentStatus(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *orchestratorServiceClient) ListIntents(ctx context.Context, in *ListIntentsRequest, opts ...grpc.CallOption) (*ListIntentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIntentsResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_ListIntents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareUpdateRoyalty(ctx context.Context, in *PrepareUpdateRoyaltyRequest, opts ...grpc.CallOption) (*PrepareCollectionAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareCollectionAdminResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareUpdateRoyalty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareTransferCollectionOwnership(ctx context.Context, in *PrepareTransferCollectionOwnershipRequest, opts ...grpc.CallOption) (*PrepareCollectionAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareCollectionAdminResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareTransferCollectionOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareSetBaseURI(ctx context.Context, in *PrepareSetBaseURIRequest, opts ...grpc.CallOption) (*PrepareCollectionAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareCollectionAdminResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareSetBaseURI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareSetPayoutSplits(ctx context.Context, in *PrepareSetPayoutSplitsRequest, opts ...grpc.CallOption) (*PrepareCollectionAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareCollectionAdminResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareSetPayoutSplits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareCreateAuction(ctx context.Context, in *PrepareCreateAuctionRequest, opts ...grpc.CallOption) (*PrepareAuctionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareAuctionResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareCreateAuction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareBid(ctx context.Context, in *PrepareBidRequest, opts ...grpc.CallOption) (*PrepareAuctionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareAuctionResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareSettleAuction(ctx context.Context, in *PrepareSettleAuctionRequest, opts ...grpc.CallOption) (*PrepareAuctionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareAuctionResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareSettleAuction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) GetCollectionDefaults(ctx context.Context, in *GetCollectionDefaultsRequest, opts ...grpc.CallOption) (*GetCollectionDefaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionDefaultsResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_GetCollectionDefaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareImportCollection(ctx context.Context, in *PrepareImportCollectionRequest, opts ...grpc.CallOption) (*PrepareImportCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareImportCollectionResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareImportCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) ImportCollection(ctx context.Context, in *ImportCollectionRequest, opts ...grpc.CallOption) (*ImportCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCollectionResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_ImportCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareAirdrop(ctx context.Context, in *PrepareAirdropRequest, opts ...grpc.CallOption) (*PrepareAirdropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareAirdropResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareAirdrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) GetAirdropProgress(ctx context.Context, in *GetAirdropProgressRequest, opts ...grpc.CallOption) (*GetAirdropProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAirdropProgressResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_GetAirdropProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) PrepareBulkTransfer(ctx context.Context, in *PrepareBulkTransferRequest, opts ...grpc.CallOption) (*PrepareBulkTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareBulkTransferResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_PrepareBulkTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) GetBulkTransferProgress(ctx context.Context, in *GetBulkTransferProgressRequest, opts ...grpc.CallOption) (*GetBulkTransferProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBulkTransferProgressResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_GetBulkTransferProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) AllowCallTarget(ctx context.Context, in *AllowCallTargetRequest, opts ...grpc.CallOption) (*AllowCallTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllowCallTargetResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_AllowCallTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) RevokeCallTarget(ctx context.Context, in *RevokeCallTargetRequest, opts ...grpc.CallOption) (*RevokeCallTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeCallTargetResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_RevokeCallTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) ListCallTargetOverrides(ctx context.Context, in *ListCallTargetOverridesRequest, opts ...grpc.CallOption) (*ListCallTargetOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCallTargetOverridesResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_ListCallTargetOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) ReserveSponsorship(ctx context.Context, in *ReserveSponsorshipRequest, opts ...grpc.CallOption) (*ReserveSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveSponsorshipResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_ReserveSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) SettleSponsorship(ctx context.Context, in *SettleSponsorshipRequest, opts ...grpc.CallOption) (*SettleSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleSponsorshipResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_SettleSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) ReleaseSponsorship(ctx context.Context, in *ReleaseSponsorshipRequest, opts ...grpc.CallOption) (*ReleaseSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseSponsorshipResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_ReleaseSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) TopUpSponsorshipBudget(ctx context.Context, in *TopUpSponsorshipBudgetRequest, opts ...grpc.CallOption) (*TopUpSponsorshipBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpSponsorshipBudgetResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_TopUpSponsorshipBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) SetSponsorshipBudgetFrozen(ctx context.Context, in *SetSponsorshipBudgetFrozenRequest, opts ...grpc.CallOption) (*SetSponsorshipBudgetFrozenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSponsorshipBudgetFrozenResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_SetSponsorshipBudgetFrozen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorServiceClient) ListSponsorshipBudgets(ctx context.Context, in *ListSponsorshipBudgetsRequest, opts ...grpc.CallOption) (*ListSponsorshipBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSponsorshipBudgetsResponse)
+	err := c.cc.Invoke(ctx, OrchestratorService_ListSponsorshipBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrchestratorServiceServer is the server API for OrchestratorService service.
 // All implementations must embed UnimplementedOrchestratorServiceServer
 // for forward compatibility.
@@ -92,6 +380,30 @@ type OrchestratorServiceServer interface {
 	PrepareMint(context.Context, *PrepareMintRequest) (*PrepareMintResponse, error)
 	TrackTx(context.Context, *TrackTxRequest) (*TrackTxResponse, error)
 	GetIntentStatus(context.Context, *GetIntentStatusRequest) (*GetIntentStatusResponse, error)
+	ListIntents(context.Context, *ListIntentsRequest) (*ListIntentsResponse, error)
+	PrepareUpdateRoyalty(context.Context, *PrepareUpdateRoyaltyRequest) (*PrepareCollectionAdminResponse, error)
+	PrepareTransferCollectionOwnership(context.Context, *PrepareTransferCollectionOwnershipRequest) (*PrepareCollectionAdminResponse, error)
+	PrepareSetBaseURI(context.Context, *PrepareSetBaseURIRequest) (*PrepareCollectionAdminResponse, error)
+	PrepareSetPayoutSplits(context.Context, *PrepareSetPayoutSplitsRequest) (*PrepareCollectionAdminResponse, error)
+	PrepareCreateAuction(context.Context, *PrepareCreateAuctionRequest) (*PrepareAuctionResponse, error)
+	PrepareBid(context.Context, *PrepareBidRequest) (*PrepareAuctionResponse, error)
+	PrepareSettleAuction(context.Context, *PrepareSettleAuctionRequest) (*PrepareAuctionResponse, error)
+	GetCollectionDefaults(context.Context, *GetCollectionDefaultsRequest) (*GetCollectionDefaultsResponse, error)
+	PrepareImportCollection(context.Context, *PrepareImportCollectionRequest) (*PrepareImportCollectionResponse, error)
+	ImportCollection(context.Context, *ImportCollectionRequest) (*ImportCollectionResponse, error)
+	PrepareAirdrop(context.Context, *PrepareAirdropRequest) (*PrepareAirdropResponse, error)
+	GetAirdropProgress(context.Context, *GetAirdropProgressRequest) (*GetAirdropProgressResponse, error)
+	PrepareBulkTransfer(context.Context, *PrepareBulkTransferRequest) (*PrepareBulkTransferResponse, error)
+	GetBulkTransferProgress(context.Context, *GetBulkTransferProgressRequest) (*GetBulkTransferProgressResponse, error)
+	AllowCallTarget(context.Context, *AllowCallTargetRequest) (*AllowCallTargetResponse, error)
+	RevokeCallTarget(context.Context, *RevokeCallTargetRequest) (*RevokeCallTargetResponse, error)
+	ListCallTargetOverrides(context.Context, *ListCallTargetOverridesRequest) (*ListCallTargetOverridesResponse, error)
+	ReserveSponsorship(context.Context, *ReserveSponsorshipRequest) (*ReserveSponsorshipResponse, error)
+	SettleSponsorship(context.Context, *SettleSponsorshipRequest) (*SettleSponsorshipResponse, error)
+	ReleaseSponsorship(context.Context, *ReleaseSponsorshipRequest) (*ReleaseSponsorshipResponse, error)
+	TopUpSponsorshipBudget(context.Context, *TopUpSponsorshipBudgetRequest) (*TopUpSponsorshipBudgetResponse, error)
+	SetSponsorshipBudgetFrozen(context.Context, *SetSponsorshipBudgetFrozenRequest) (*SetSponsorshipBudgetFrozenResponse, error)
+	ListSponsorshipBudgets(context.Context, *ListSponsorshipBudgetsRequest) (*ListSponsorshipBudgetsResponse, error)
 	mustEmbedUnimplementedOrchestratorServiceServer()
 }
 
@@ -114,6 +426,78 @@ func (UnimplementedOrchestratorServiceServer) TrackTx(context.Context, *TrackTxR
 func (UnimplementedOrchestratorServiceServer) GetIntentStatus(context.Context, *GetIntentStatusRequest) (*GetIntentStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIntentStatus not implemented")
 }
+func (UnimplementedOrchestratorServiceServer) ListIntents(context.Context, *ListIntentsRequest) (*ListIntentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIntents not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareUpdateRoyalty(context.Context, *PrepareUpdateRoyaltyRequest) (*PrepareCollectionAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareUpdateRoyalty not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareTransferCollectionOwnership(context.Context, *PrepareTransferCollectionOwnershipRequest) (*PrepareCollectionAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareTransferCollectionOwnership not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareSetBaseURI(context.Context, *PrepareSetBaseURIRequest) (*PrepareCollectionAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareSetBaseURI not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareSetPayoutSplits(context.Context, *PrepareSetPayoutSplitsRequest) (*PrepareCollectionAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareSetPayoutSplits not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareCreateAuction(context.Context, *PrepareCreateAuctionRequest) (*PrepareAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareCreateAuction not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareBid(context.Context, *PrepareBidRequest) (*PrepareAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareBid not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareSettleAuction(context.Context, *PrepareSettleAuctionRequest) (*PrepareAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareSettleAuction not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) GetCollectionDefaults(context.Context, *GetCollectionDefaultsRequest) (*GetCollectionDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionDefaults not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareImportCollection(context.Context, *PrepareImportCollectionRequest) (*PrepareImportCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareImportCollection not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) ImportCollection(context.Context, *ImportCollectionRequest) (*ImportCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCollection not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareAirdrop(context.Context, *PrepareAirdropRequest) (*PrepareAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareAirdrop not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) GetAirdropProgress(context.Context, *GetAirdropProgressRequest) (*GetAirdropProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAirdropProgress not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) PrepareBulkTransfer(context.Context, *PrepareBulkTransferRequest) (*PrepareBulkTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareBulkTransfer not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) GetBulkTransferProgress(context.Context, *GetBulkTransferProgressRequest) (*GetBulkTransferProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkTransferProgress not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) AllowCallTarget(context.Context, *AllowCallTargetRequest) (*AllowCallTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowCallTarget not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) RevokeCallTarget(context.Context, *RevokeCallTargetRequest) (*RevokeCallTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCallTarget not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) ListCallTargetOverrides(context.Context, *ListCallTargetOverridesRequest) (*ListCallTargetOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCallTargetOverrides not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) ReserveSponsorship(context.Context, *ReserveSponsorshipRequest) (*ReserveSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveSponsorship not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) SettleSponsorship(context.Context, *SettleSponsorshipRequest) (*SettleSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleSponsorship not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) ReleaseSponsorship(context.Context, *ReleaseSponsorshipRequest) (*ReleaseSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSponsorship not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) TopUpSponsorshipBudget(context.Context, *TopUpSponsorshipBudgetRequest) (*TopUpSponsorshipBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpSponsorshipBudget not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) SetSponsorshipBudgetFrozen(context.Context, *SetSponsorshipBudgetFrozenRequest) (*SetSponsorshipBudgetFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsorshipBudgetFrozen not implemented")
+}
+func (UnimplementedOrchestratorServiceServer) ListSponsorshipBudgets(context.Context, *ListSponsorshipBudgetsRequest) (*ListSponsorshipBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSponsorshipBudgets not implemented")
+}
 func (UnimplementedOrchestratorServiceServer) mustEmbedUnimplementedOrchestratorServiceServer() {}
 func (UnimplementedOrchestratorServiceServer) testEmbeddedByValue()                             {}
 
@@ -207,28 +591,556 @@ func _OrchestratorService_GetIntentStatus_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-// OrchestratorService_ServiceDesc is the grpc.ServiceDesc for OrchestratorService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var OrchestratorService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "orchestrator.OrchestratorService",
-	HandlerType: (*OrchestratorServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PrepareCreateCollection",
-			Handler:    _OrchestratorService_PrepareCreateCollection_Handler,
-		},
-		{
-			MethodName: "PrepareMint",
-			Handler:    _OrchestratorService_PrepareMint_Handler,
-		},
-		{
-			MethodName: "TrackTx",
-			Handler:    _OrchestratorService_TrackTx_Handler,
-		},
-		{
-			MethodName: "GetIntentStatus",
-			Handler:    _OrchestratorService_GetIntentStatus_Handler,
+func _OrchestratorService_ListIntents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).ListIntents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_ListIntents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).ListIntents(ctx, req.(*ListIntentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareUpdateRoyalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareUpdateRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareUpdateRoyalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareUpdateRoyalty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareUpdateRoyalty(ctx, req.(*PrepareUpdateRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareTransferCollectionOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareTransferCollectionOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareTransferCollectionOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareTransferCollectionOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareTransferCollectionOwnership(ctx, req.(*PrepareTransferCollectionOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareSetBaseURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareSetBaseURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareSetBaseURI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareSetBaseURI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareSetBaseURI(ctx, req.(*PrepareSetBaseURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareSetPayoutSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareSetPayoutSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareSetPayoutSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareSetPayoutSplits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareSetPayoutSplits(ctx, req.(*PrepareSetPayoutSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareCreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareCreateAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareCreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareCreateAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareCreateAuction(ctx, req.(*PrepareCreateAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareBid(ctx, req.(*PrepareBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareSettleAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareSettleAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareSettleAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareSettleAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareSettleAuction(ctx, req.(*PrepareSettleAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_GetCollectionDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).GetCollectionDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_GetCollectionDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).GetCollectionDefaults(ctx, req.(*GetCollectionDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareImportCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareImportCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareImportCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareImportCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareImportCollection(ctx, req.(*PrepareImportCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_ImportCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).ImportCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_ImportCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).ImportCollection(ctx, req.(*ImportCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareAirdropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareAirdrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareAirdrop(ctx, req.(*PrepareAirdropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_GetAirdropProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAirdropProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).GetAirdropProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_GetAirdropProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).GetAirdropProgress(ctx, req.(*GetAirdropProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_PrepareBulkTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareBulkTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).PrepareBulkTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_PrepareBulkTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).PrepareBulkTransfer(ctx, req.(*PrepareBulkTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_GetBulkTransferProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkTransferProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).GetBulkTransferProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_GetBulkTransferProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).GetBulkTransferProgress(ctx, req.(*GetBulkTransferProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_AllowCallTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllowCallTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).AllowCallTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_AllowCallTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).AllowCallTarget(ctx, req.(*AllowCallTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_RevokeCallTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCallTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).RevokeCallTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_RevokeCallTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).RevokeCallTarget(ctx, req.(*RevokeCallTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_ListCallTargetOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCallTargetOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).ListCallTargetOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_ListCallTargetOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).ListCallTargetOverrides(ctx, req.(*ListCallTargetOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_ReserveSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).ReserveSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_ReserveSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).ReserveSponsorship(ctx, req.(*ReserveSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_SettleSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).SettleSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_SettleSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).SettleSponsorship(ctx, req.(*SettleSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_ReleaseSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).ReleaseSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_ReleaseSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).ReleaseSponsorship(ctx, req.(*ReleaseSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_TopUpSponsorshipBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpSponsorshipBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).TopUpSponsorshipBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_TopUpSponsorshipBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).TopUpSponsorshipBudget(ctx, req.(*TopUpSponsorshipBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_SetSponsorshipBudgetFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSponsorshipBudgetFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).SetSponsorshipBudgetFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_SetSponsorshipBudgetFrozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).SetSponsorshipBudgetFrozen(ctx, req.(*SetSponsorshipBudgetFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestratorService_ListSponsorshipBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSponsorshipBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServiceServer).ListSponsorshipBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestratorService_ListSponsorshipBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServiceServer).ListSponsorshipBudgets(ctx, req.(*ListSponsorshipBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrchestratorService_ServiceDesc is the grpc.ServiceDesc for OrchestratorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrchestratorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "orchestrator.OrchestratorService",
+	HandlerType: (*OrchestratorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PrepareCreateCollection",
+			Handler:    _OrchestratorService_PrepareCreateCollection_Handler,
+		},
+		{
+			MethodName: "PrepareMint",
+			Handler:    _OrchestratorService_PrepareMint_Handler,
+		},
+		{
+			MethodName: "TrackTx",
+			Handler:    _OrchestratorService_TrackTx_Handler,
+		},
+		{
+			MethodName: "GetIntentStatus",
+			Handler:    _OrchestratorService_GetIntentStatus_Handler,
+		},
+		{
+			MethodName: "ListIntents",
+			Handler:    _OrchestratorService_ListIntents_Handler,
+		},
+		{
+			MethodName: "PrepareUpdateRoyalty",
+			Handler:    _OrchestratorService_PrepareUpdateRoyalty_Handler,
+		},
+		{
+			MethodName: "PrepareTransferCollectionOwnership",
+			Handler:    _OrchestratorService_PrepareTransferCollectionOwnership_Handler,
+		},
+		{
+			MethodName: "PrepareSetBaseURI",
+			Handler:    _OrchestratorService_PrepareSetBaseURI_Handler,
+		},
+		{
+			MethodName: "PrepareSetPayoutSplits",
+			Handler:    _OrchestratorService_PrepareSetPayoutSplits_Handler,
+		},
+		{
+			MethodName: "PrepareCreateAuction",
+			Handler:    _OrchestratorService_PrepareCreateAuction_Handler,
+		},
+		{
+			MethodName: "PrepareBid",
+			Handler:    _OrchestratorService_PrepareBid_Handler,
+		},
+		{
+			MethodName: "PrepareSettleAuction",
+			Handler:    _OrchestratorService_PrepareSettleAuction_Handler,
+		},
+		{
+			MethodName: "GetCollectionDefaults",
+			Handler:    _OrchestratorService_GetCollectionDefaults_Handler,
+		},
+		{
+			MethodName: "PrepareImportCollection",
+			Handler:    _OrchestratorService_PrepareImportCollection_Handler,
+		},
+		{
+			MethodName: "ImportCollection",
+			Handler:    _OrchestratorService_ImportCollection_Handler,
+		},
+		{
+			MethodName: "PrepareAirdrop",
+			Handler:    _OrchestratorService_PrepareAirdrop_Handler,
+		},
+		{
+			MethodName: "GetAirdropProgress",
+			Handler:    _OrchestratorService_GetAirdropProgress_Handler,
+		},
+		{
+			MethodName: "PrepareBulkTransfer",
+			Handler:    _OrchestratorService_PrepareBulkTransfer_Handler,
+		},
+		{
+			MethodName: "GetBulkTransferProgress",
+			Handler:    _OrchestratorService_GetBulkTransferProgress_Handler,
+		},
+		{
+			MethodName: "AllowCallTarget",
+			Handler:    _OrchestratorService_AllowCallTarget_Handler,
+		},
+		{
+			MethodName: "RevokeCallTarget",
+			Handler:    _OrchestratorService_RevokeCallTarget_Handler,
+		},
+		{
+			MethodName: "ListCallTargetOverrides",
+			Handler:    _OrchestratorService_ListCallTargetOverrides_Handler,
+		},
+		{
+			MethodName: "ReserveSponsorship",
+			Handler:    _OrchestratorService_ReserveSponsorship_Handler,
+		},
+		{
+			MethodName: "SettleSponsorship",
+			Handler:    _OrchestratorService_SettleSponsorship_Handler,
+		},
+		{
+			MethodName: "ReleaseSponsorship",
+			Handler:    _OrchestratorService_ReleaseSponsorship_Handler,
+		},
+		{
+			MethodName: "TopUpSponsorshipBudget",
+			Handler:    _OrchestratorService_TopUpSponsorshipBudget_Handler,
+		},
+		{
+			MethodName: "SetSponsorshipBudgetFrozen",
+			Handler:    _OrchestratorService_SetSponsorshipBudgetFrozen_Handler,
+		},
+		{
+			MethodName: "ListSponsorshipBudgets",
+			Handler:    _OrchestratorService_ListSponsorshipBudgets_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,42 @@
+package protocheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Check verifies each proto file against its lock in a subtest named after the file, for
+// the proto contract test of the services that serve it
+func Check(t *testing.T, fds ...protoreflect.FileDescriptor) {
+	t.Helper()
+	protoDir := filepath.Join(repoRoot(t), "proto")
+	for _, fd := range fds {
+		t.Run(fd.Path(), func(t *testing.T) {
+			if err := Verify(protoDir, fd); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// repoRoot is the closest directory above the test's working directory holding go.mod
+func repoRoot(t *testing.T) string {
+	t.Helper()
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			t.Fatal("go.mod not found above the test directory")
+		}
+		dir = parent
+	}
+}
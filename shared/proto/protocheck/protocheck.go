@@ -0,0 +1,271 @@
+/*
+Package protocheck keeps the generated Go code in step with the service definitions in /proto.
+
+Each proto file has a lock next to it, auth.proto has auth.lock, written when the code is
+generated. It records the hash of the source the code was generated from and the API surface
+of the generated descriptors: every message field, enum value and rpc. Services verify their
+proto in tests:
+
+	func TestProtoContract(t *testing.T) {
+		protocheck.Check(t, protoAuth.File_auth_proto)
+	}
+
+Verify fails when the source changed without regenerating, or the generated code no longer
+matches the lock. Update refuses to write a lock that breaks the previous one, such as one
+with a field removed, renumbered or retyped, unless breaking changes are allowed.
+*/
+package protocheck
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	lockHeader = "# Generated by go generate ./shared/proto. DO NOT EDIT."
+	regenerate = "run go generate ./shared/proto"
+)
+
+// Lock is what a lock file records about one proto file
+type Lock struct {
+	// SourceHash is the sha256 of the proto source the code was generated from
+	SourceHash string
+	// Surface is one line per message, field, enum, enum value, service and rpc, sorted
+	Surface []string
+}
+
+// LockPath is where the lock of the proto file at protoPath lives
+func LockPath(protoDir, protoPath string) string {
+	return filepath.Join(protoDir, strings.TrimSuffix(protoPath, ".proto")+".lock")
+}
+
+// Verify checks that the proto source, its lock and the generated descriptor agree
+func Verify(protoDir string, fd protoreflect.FileDescriptor) error {
+	lock, err := ReadLock(LockPath(protoDir, fd.Path()))
+	if err != nil {
+		return fmt.Errorf("%s: %w; %s", fd.Path(), err, regenerate)
+	}
+
+	hash, err := SourceHash(filepath.Join(protoDir, fd.Path()))
+	if err != nil {
+		return err
+	}
+	if hash != lock.SourceHash {
+		return fmt.Errorf("%s changed since its code was generated; %s", fd.Path(), regenerate)
+	}
+
+	surface := Describe(fd)
+	if broken := Breaking(lock.Surface, surface); len(broken) > 0 {
+		return fmt.Errorf("generated code for %s breaks its lock:\n  %s", fd.Path(), strings.Join(broken, "\n  "))
+	}
+	if !slices.Equal(lock.Surface, surface) {
+		return fmt.Errorf("generated code for %s does not match its lock; %s", fd.Path(), regenerate)
+	}
+	return nil
+}
+
+// Update writes the lock of fd. With allowBreaking unset it fails, leaving the lock as it
+// was, when the new surface breaks the locked one.
+func Update(protoDir string, fd protoreflect.FileDescriptor, allowBreaking bool) error {
+	path := LockPath(protoDir, fd.Path())
+	surface := Describe(fd)
+
+	previous, err := ReadLock(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	case !allowBreaking:
+		if broken := Breaking(previous.Surface, surface); len(broken) > 0 {
+			return fmt.Errorf("%s has breaking changes:\n  %s", fd.Path(), strings.Join(broken, "\n  "))
+		}
+	}
+
+	hash, err := SourceHash(filepath.Join(protoDir, fd.Path()))
+	if err != nil {
+		return err
+	}
+	return WriteLock(path, Lock{SourceHash: hash, Surface: surface})
+}
+
+// SourceHash hashes a proto source with line endings normalised, so checkouts on any OS agree
+func SourceHash(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read proto source: %w", err)
+	}
+	sum := sha256.Sum256(bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n")))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Describe lists the API surface of a file descriptor. Each line is a kind, a key and what
+// the key maps to; fields and enum values are keyed by number, as the wire format is.
+func Describe(fd protoreflect.FileDescriptor) []string {
+	var lines []string
+	var messages func(protoreflect.MessageDescriptors)
+	var enums func(protoreflect.EnumDescriptors)
+
+	enums = func(eds protoreflect.EnumDescriptors) {
+		for i := 0; i < eds.Len(); i++ {
+			ed := eds.Get(i)
+			lines = append(lines, fmt.Sprintf("enum %s", ed.FullName()))
+			values := ed.Values()
+			for j := 0; j < values.Len(); j++ {
+				v := values.Get(j)
+				lines = append(lines, fmt.Sprintf("value %s.%d %s", ed.FullName(), v.Number(), v.Name()))
+			}
+		}
+	}
+	messages = func(mds protoreflect.MessageDescriptors) {
+		for i := 0; i < mds.Len(); i++ {
+			md := mds.Get(i)
+			if md.IsMapEntry() {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("message %s", md.FullName()))
+			fields := md.Fields()
+			for j := 0; j < fields.Len(); j++ {
+				lines = append(lines, describeField(md, fields.Get(j)))
+			}
+			enums(md.Enums())
+			messages(md.Messages())
+		}
+	}
+
+	enums(fd.Enums())
+	messages(fd.Messages())
+	services := fd.Services()
+	for i := 0; i < services.Len(); i++ {
+		sd := services.Get(i)
+		lines = append(lines, fmt.Sprintf("service %s", sd.FullName()))
+		methods := sd.Methods()
+		for j := 0; j < methods.Len(); j++ {
+			m := methods.Get(j)
+			lines = append(lines, fmt.Sprintf("rpc %s.%s %s%s %s%s", sd.FullName(), m.Name(),
+				streaming(m.IsStreamingClient()), m.Input().FullName(), streaming(m.IsStreamingServer()), m.Output().FullName()))
+		}
+	}
+
+	sort.Strings(lines)
+	return lines
+}
+
+func describeField(md protoreflect.MessageDescriptor, f protoreflect.FieldDescriptor) string {
+	line := fmt.Sprintf("field %s.%d %s %s", md.FullName(), f.Number(), f.Name(), fieldType(f))
+	if oneof := f.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		line += " oneof " + string(oneof.Name())
+	}
+	return line
+}
+
+func fieldType(f protoreflect.FieldDescriptor) string {
+	if f.IsMap() {
+		return fmt.Sprintf("map<%s,%s>", fieldType(f.MapKey()), fieldType(f.MapValue()))
+	}
+	var typ string
+	switch {
+	case f.Message() != nil:
+		typ = string(f.Message().FullName())
+	case f.Enum() != nil:
+		typ = string(f.Enum().FullName())
+	default:
+		typ = f.Kind().String()
+	}
+	if f.IsList() {
+		return "repeated " + typ
+	}
+	if f.HasOptionalKeyword() {
+		return "optional " + typ
+	}
+	return typ
+}
+
+func streaming(stream bool) string {
+	if stream {
+		return "stream "
+	}
+	return ""
+}
+
+// Breaking lists what the next surface removes or changes from the previous one; additions
+// are compatible
+func Breaking(previous, next []string) []string {
+	nextByKey := keyed(next)
+	var broken []string
+	for key, was := range keyed(previous) {
+		now, ok := nextByKey[key]
+		switch {
+		case !ok:
+			broken = append(broken, fmt.Sprintf("removed %s", key))
+		case now != was:
+			broken = append(broken, fmt.Sprintf("changed %s from %q to %q", key, was, now))
+		}
+	}
+	sort.Strings(broken)
+	return broken
+}
+
+// keyed maps each surface line's kind and key to the rest of it
+func keyed(surface []string) map[string]string {
+	m := make(map[string]string, len(surface))
+	for _, line := range surface {
+		parts := strings.SplitN(line, " ", 3)
+		key := strings.Join(parts[:min(2, len(parts))], " ")
+		if len(parts) == 3 {
+			m[key] = parts[2]
+		} else {
+			m[key] = ""
+		}
+	}
+	return m
+}
+
+// ReadLock reads a lock file
+func ReadLock(path string) (Lock, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Lock{}, err
+	}
+	defer f.Close()
+
+	var lock Lock
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "source sha256:"):
+			lock.SourceHash = strings.TrimPrefix(line, "source sha256:")
+		default:
+			lock.Surface = append(lock.Surface, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Lock{}, fmt.Errorf("read lock %s: %w", path, err)
+	}
+	return lock, nil
+}
+
+// WriteLock writes a lock file
+func WriteLock(path string, lock Lock) error {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, lockHeader)
+	fmt.Fprintf(&buf, "source sha256:%s\n", lock.SourceHash)
+	for _, line := range lock.Surface {
+		fmt.Fprintln(&buf, line)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write lock %s: %w", path, err)
+	}
+	return nil
+}
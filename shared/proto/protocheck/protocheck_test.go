@@ -0,0 +1,37 @@
+package protocheck
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	authpb "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+)
+
+func TestBreaking(t *testing.T) {
+	locked := Describe(authpb.File_auth_proto)
+
+	var next []string
+	for _, line := range locked {
+		switch {
+		case strings.HasPrefix(line, "field auth.GetNonceRequest.3 "):
+			// domain removed
+		case strings.HasPrefix(line, "field auth.GetNonceRequest.2 "):
+			next = append(next, "field auth.GetNonceRequest.2 chain_id int64")
+		default:
+			next = append(next, line)
+		}
+	}
+	next = append(next, "field auth.GetNonceRequest.4 origin string")
+
+	want := []string{
+		`changed field auth.GetNonceRequest.2 from "chain_id string" to "chain_id int64"`,
+		"removed field auth.GetNonceRequest.3",
+	}
+	if got := Breaking(locked, next); !slices.Equal(got, want) {
+		t.Fatalf("Breaking = %q, want %q", got, want)
+	}
+	if got := Breaking(locked, append(locked, "rpc auth.AuthService.Ping auth.PingRequest auth.PingResponse")); len(got) != 0 {
+		t.Fatalf("additions are compatible, got %q", got)
+	}
+}
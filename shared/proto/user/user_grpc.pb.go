@@ -20,7 +20,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_EnsureUser_FullMethodName = "/user.UserService/EnsureUser"
+	UserService_EnsureUser_FullMethodName                   = "/user.UserService/EnsureUser"
+	UserService_GetUsersByIDs_FullMethodName                = "/user.UserService/GetUsersByIDs"
+	UserService_GetProfilesByAddresses_FullMethodName       = "/user.UserService/GetProfilesByAddresses"
+	UserService_SuggestUsers_FullMethodName                 = "/user.UserService/SuggestUsers"
+	UserService_StartEmailVerification_FullMethodName       = "/user.UserService/StartEmailVerification"
+	UserService_ConfirmEmail_FullMethodName                 = "/user.UserService/ConfirmEmail"
+	UserService_GetEmailStatus_FullMethodName               = "/user.UserService/GetEmailStatus"
+	UserService_SetEmailDigestOptOut_FullMethodName         = "/user.UserService/SetEmailDigestOptOut"
+	UserService_SetEmailOptOut_FullMethodName               = "/user.UserService/SetEmailOptOut"
+	UserService_UnsubscribeEmail_FullMethodName             = "/user.UserService/UnsubscribeEmail"
+	UserService_GetNotificationEmail_FullMethodName         = "/user.UserService/GetNotificationEmail"
+	UserService_GetPreferences_FullMethodName               = "/user.UserService/GetPreferences"
+	UserService_UpdatePreferences_FullMethodName            = "/user.UserService/UpdatePreferences"
+	UserService_CreateOrganization_FullMethodName           = "/user.UserService/CreateOrganization"
+	UserService_GetOrganization_FullMethodName              = "/user.UserService/GetOrganization"
+	UserService_ListUserOrganizations_FullMethodName        = "/user.UserService/ListUserOrganizations"
+	UserService_InviteOrganizationMember_FullMethodName     = "/user.UserService/InviteOrganizationMember"
+	UserService_AcceptOrganizationInvitation_FullMethodName = "/user.UserService/AcceptOrganizationInvitation"
+	UserService_RemoveOrganizationMember_FullMethodName     = "/user.UserService/RemoveOrganizationMember"
+	UserService_SetOrganizationMemberRole_FullMethodName    = "/user.UserService/SetOrganizationMemberRole"
+	UserService_GetOrganizationMembership_FullMethodName    = "/user.UserService/GetOrganizationMembership"
+	UserService_SetRelationship_FullMethodName              = "/user.UserService/SetRelationship"
+	UserService_ListRelationships_FullMethodName            = "/user.UserService/ListRelationships"
+	UserService_SetProfileVisibility_FullMethodName         = "/user.UserService/SetProfileVisibility"
+	UserService_GetProfileAccess_FullMethodName             = "/user.UserService/GetProfileAccess"
+	UserService_FilterNotificationRecipients_FullMethodName = "/user.UserService/FilterNotificationRecipients"
+	UserService_SetAvatarFromNft_FullMethodName             = "/user.UserService/SetAvatarFromNft"
+	UserService_ClearNftAvatar_FullMethodName               = "/user.UserService/ClearNftAvatar"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -28,6 +55,33 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserServiceClient interface {
 	EnsureUser(ctx context.Context, in *EnsureUserRequest, opts ...grpc.CallOption) (*EnsureUserResponse, error)
+	GetUsersByIDs(ctx context.Context, in *GetUsersByIDsRequest, opts ...grpc.CallOption) (*GetUsersByIDsResponse, error)
+	GetProfilesByAddresses(ctx context.Context, in *GetProfilesByAddressesRequest, opts ...grpc.CallOption) (*GetProfilesByAddressesResponse, error)
+	SuggestUsers(ctx context.Context, in *SuggestUsersRequest, opts ...grpc.CallOption) (*SuggestUsersResponse, error)
+	StartEmailVerification(ctx context.Context, in *StartEmailVerificationRequest, opts ...grpc.CallOption) (*StartEmailVerificationResponse, error)
+	ConfirmEmail(ctx context.Context, in *ConfirmEmailRequest, opts ...grpc.CallOption) (*ConfirmEmailResponse, error)
+	GetEmailStatus(ctx context.Context, in *GetEmailStatusRequest, opts ...grpc.CallOption) (*GetEmailStatusResponse, error)
+	SetEmailDigestOptOut(ctx context.Context, in *SetEmailDigestOptOutRequest, opts ...grpc.CallOption) (*SetEmailDigestOptOutResponse, error)
+	SetEmailOptOut(ctx context.Context, in *SetEmailOptOutRequest, opts ...grpc.CallOption) (*SetEmailOptOutResponse, error)
+	UnsubscribeEmail(ctx context.Context, in *UnsubscribeEmailRequest, opts ...grpc.CallOption) (*UnsubscribeEmailResponse, error)
+	GetNotificationEmail(ctx context.Context, in *GetNotificationEmailRequest, opts ...grpc.CallOption) (*GetNotificationEmailResponse, error)
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error)
+	ListUserOrganizations(ctx context.Context, in *ListUserOrganizationsRequest, opts ...grpc.CallOption) (*ListUserOrganizationsResponse, error)
+	InviteOrganizationMember(ctx context.Context, in *InviteOrganizationMemberRequest, opts ...grpc.CallOption) (*InviteOrganizationMemberResponse, error)
+	AcceptOrganizationInvitation(ctx context.Context, in *AcceptOrganizationInvitationRequest, opts ...grpc.CallOption) (*AcceptOrganizationInvitationResponse, error)
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error)
+	SetOrganizationMemberRole(ctx context.Context, in *SetOrganizationMemberRoleRequest, opts ...grpc.CallOption) (*SetOrganizationMemberRoleResponse, error)
+	GetOrganizationMembership(ctx context.Context, in *GetOrganizationMembershipRequest, opts ...grpc.CallOption) (*GetOrganizationMembershipResponse, error)
+	SetRelationship(ctx context.Context, in *SetRelationshipRequest, opts ...grpc.CallOption) (*SetRelationshipResponse, error)
+	ListRelationships(ctx context.Context, in *ListRelationshipsRequest, opts ...grpc.CallOption) (*ListRelationshipsResponse, error)
+	SetProfileVisibility(ctx context.Context, in *SetProfileVisibilityRequest, opts ...grpc.CallOption) (*SetProfileVisibilityResponse, error)
+	GetProfileAccess(ctx context.Context, in *GetProfileAccessRequest, opts ...grpc.CallOption) (*GetProfileAccessResponse, error)
+	FilterNotificationRecipients(ctx context.Context, in *FilterNotificationRecipientsRequest, opts ...grpc.CallOption) (*FilterNotificationRecipientsResponse, error)
+	SetAvatarFromNft(ctx context.Context, in *SetAvatarFromNftRequest, opts ...grpc.CallOption) (*SetAvatarFromNftResponse, error)
+	ClearNftAvatar(ctx context.Context, in *ClearNftAvatarRequest, opts ...grpc.CallOption) (*ClearNftAvatarResponse, error)
 }
 
 type userServiceClient struct {
@@ -48,11 +102,308 @@ func (c *userServiceClient) EnsureUser(ctx context.Context, in *EnsureUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) GetUsersByIDs(ctx context.Context, in *GetUsersByIDsRequest, opts ...grpc.CallOption) (*GetUsersByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByIDsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUsersByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProfilesByAddresses(ctx context.Context, in *GetProfilesByAddressesRequest, opts ...grpc.CallOption) (*GetProfilesByAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfilesByAddressesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetProfilesByAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SuggestUsers(ctx context.Context, in *SuggestUsersRequest, opts ...grpc.CallOption) (*SuggestUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SuggestUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartEmailVerification(ctx context.Context, in *StartEmailVerificationRequest, opts ...grpc.CallOption) (*StartEmailVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartEmailVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_StartEmailVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmEmail(ctx context.Context, in *ConfirmEmailRequest, opts ...grpc.CallOption) (*ConfirmEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetEmailStatus(ctx context.Context, in *GetEmailStatusRequest, opts ...grpc.CallOption) (*GetEmailStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmailStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_GetEmailStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetEmailDigestOptOut(ctx context.Context, in *SetEmailDigestOptOutRequest, opts ...grpc.CallOption) (*SetEmailDigestOptOutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEmailDigestOptOutResponse)
+	err := c.cc.Invoke(ctx, UserService_SetEmailDigestOptOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetEmailOptOut(ctx context.Context, in *SetEmailOptOutRequest, opts ...grpc.CallOption) (*SetEmailOptOutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEmailOptOutResponse)
+	err := c.cc.Invoke(ctx, UserService_SetEmailOptOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnsubscribeEmail(ctx context.Context, in *UnsubscribeEmailRequest, opts ...grpc.CallOption) (*UnsubscribeEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_UnsubscribeEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetNotificationEmail(ctx context.Context, in *GetNotificationEmailRequest, opts ...grpc.CallOption) (*GetNotificationEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_GetNotificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationResponse)
+	err := c.cc.Invoke(ctx, UserService_GetOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserOrganizations(ctx context.Context, in *ListUserOrganizationsRequest, opts ...grpc.CallOption) (*ListUserOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserOrganizationsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) InviteOrganizationMember(ctx context.Context, in *InviteOrganizationMemberRequest, opts ...grpc.CallOption) (*InviteOrganizationMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, UserService_InviteOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AcceptOrganizationInvitation(ctx context.Context, in *AcceptOrganizationInvitationRequest, opts ...grpc.CallOption) (*AcceptOrganizationInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptOrganizationInvitationResponse)
+	err := c.cc.Invoke(ctx, UserService_AcceptOrganizationInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetOrganizationMemberRole(ctx context.Context, in *SetOrganizationMemberRoleRequest, opts ...grpc.CallOption) (*SetOrganizationMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOrganizationMemberRoleResponse)
+	err := c.cc.Invoke(ctx, UserService_SetOrganizationMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetOrganizationMembership(ctx context.Context, in *GetOrganizationMembershipRequest, opts ...grpc.CallOption) (*GetOrganizationMembershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationMembershipResponse)
+	err := c.cc.Invoke(ctx, UserService_GetOrganizationMembership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetRelationship(ctx context.Context, in *SetRelationshipRequest, opts ...grpc.CallOption) (*SetRelationshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRelationshipResponse)
+	err := c.cc.Invoke(ctx, UserService_SetRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListRelationships(ctx context.Context, in *ListRelationshipsRequest, opts ...grpc.CallOption) (*ListRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRelationshipsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetProfileVisibility(ctx context.Context, in *SetProfileVisibilityRequest, opts ...grpc.CallOption) (*SetProfileVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProfileVisibilityResponse)
+	err := c.cc.Invoke(ctx, UserService_SetProfileVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProfileAccess(ctx context.Context, in *GetProfileAccessRequest, opts ...grpc.CallOption) (*GetProfileAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileAccessResponse)
+	err := c.cc.Invoke(ctx, UserService_GetProfileAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FilterNotificationRecipients(ctx context.Context, in *FilterNotificationRecipientsRequest, opts ...grpc.CallOption) (*FilterNotificationRecipientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterNotificationRecipientsResponse)
+	err := c.cc.Invoke(ctx, UserService_FilterNotificationRecipients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetAvatarFromNft(ctx context.Context, in *SetAvatarFromNftRequest, opts ...grpc.CallOption) (*SetAvatarFromNftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAvatarFromNftResponse)
+	err := c.cc.Invoke(ctx, UserService_SetAvatarFromNft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ClearNftAvatar(ctx context.Context, in *ClearNftAvatarRequest, opts ...grpc.CallOption) (*ClearNftAvatarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearNftAvatarResponse)
+	err := c.cc.Invoke(ctx, UserService_ClearNftAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	EnsureUser(context.Context, *EnsureUserRequest) (*EnsureUserResponse, error)
+	GetUsersByIDs(context.Context, *GetUsersByIDsRequest) (*GetUsersByIDsResponse, error)
+	GetProfilesByAddresses(context.Context, *GetProfilesByAddressesRequest) (*GetProfilesByAddressesResponse, error)
+	SuggestUsers(context.Context, *SuggestUsersRequest) (*SuggestUsersResponse, error)
+	StartEmailVerification(context.Context, *StartEmailVerificationRequest) (*StartEmailVerificationResponse, error)
+	ConfirmEmail(context.Context, *ConfirmEmailRequest) (*ConfirmEmailResponse, error)
+	GetEmailStatus(context.Context, *GetEmailStatusRequest) (*GetEmailStatusResponse, error)
+	SetEmailDigestOptOut(context.Context, *SetEmailDigestOptOutRequest) (*SetEmailDigestOptOutResponse, error)
+	SetEmailOptOut(context.Context, *SetEmailOptOutRequest) (*SetEmailOptOutResponse, error)
+	UnsubscribeEmail(context.Context, *UnsubscribeEmailRequest) (*UnsubscribeEmailResponse, error)
+	GetNotificationEmail(context.Context, *GetNotificationEmailRequest) (*GetNotificationEmailResponse, error)
+	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error)
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error)
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
+	ListUserOrganizations(context.Context, *ListUserOrganizationsRequest) (*ListUserOrganizationsResponse, error)
+	InviteOrganizationMember(context.Context, *InviteOrganizationMemberRequest) (*InviteOrganizationMemberResponse, error)
+	AcceptOrganizationInvitation(context.Context, *AcceptOrganizationInvitationRequest) (*AcceptOrganizationInvitationResponse, error)
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+	SetOrganizationMemberRole(context.Context, *SetOrganizationMemberRoleRequest) (*SetOrganizationMemberRoleResponse, error)
+	GetOrganizationMembership(context.Context, *GetOrganizationMembershipRequest) (*GetOrganizationMembershipResponse, error)
+	SetRelationship(context.Context, *SetRelationshipRequest) (*SetRelationshipResponse, error)
+	ListRelationships(context.Context, *ListRelationshipsRequest) (*ListRelationshipsResponse, error)
+	SetProfileVisibility(context.Context, *SetProfileVisibilityRequest) (*SetProfileVisibilityResponse, error)
+	GetProfileAccess(context.Context, *GetProfileAccessRequest) (*GetProfileAccessResponse, error)
+	FilterNotificationRecipients(context.Context, *FilterNotificationRecipientsRequest) (*FilterNotificationRecipientsResponse, error)
+	SetAvatarFromNft(context.Context, *SetAvatarFromNftRequest) (*SetAvatarFromNftResponse, error)
+	ClearNftAvatar(context.Context, *ClearNftAvatarRequest) (*ClearNftAvatarResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -66,6 +417,87 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) EnsureUser(context.Context, *EnsureUserRequest) (*EnsureUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnsureUser not implemented")
 }
+func (UnimplementedUserServiceServer) GetUsersByIDs(context.Context, *GetUsersByIDsRequest) (*GetUsersByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIDs not implemented")
+}
+func (UnimplementedUserServiceServer) GetProfilesByAddresses(context.Context, *GetProfilesByAddressesRequest) (*GetProfilesByAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfilesByAddresses not implemented")
+}
+func (UnimplementedUserServiceServer) SuggestUsers(context.Context, *SuggestUsersRequest) (*SuggestUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestUsers not implemented")
+}
+func (UnimplementedUserServiceServer) StartEmailVerification(context.Context, *StartEmailVerificationRequest) (*StartEmailVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEmailVerification not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmEmail(context.Context, *ConfirmEmailRequest) (*ConfirmEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmail not implemented")
+}
+func (UnimplementedUserServiceServer) GetEmailStatus(context.Context, *GetEmailStatusRequest) (*GetEmailStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmailStatus not implemented")
+}
+func (UnimplementedUserServiceServer) SetEmailDigestOptOut(context.Context, *SetEmailDigestOptOutRequest) (*SetEmailDigestOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmailDigestOptOut not implemented")
+}
+func (UnimplementedUserServiceServer) SetEmailOptOut(context.Context, *SetEmailOptOutRequest) (*SetEmailOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmailOptOut not implemented")
+}
+func (UnimplementedUserServiceServer) UnsubscribeEmail(context.Context, *UnsubscribeEmailRequest) (*UnsubscribeEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEmail not implemented")
+}
+func (UnimplementedUserServiceServer) GetNotificationEmail(context.Context, *GetNotificationEmailRequest) (*GetNotificationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationEmail not implemented")
+}
+func (UnimplementedUserServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedUserServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedUserServiceServer) GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserOrganizations(context.Context, *ListUserOrganizationsRequest) (*ListUserOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserOrganizations not implemented")
+}
+func (UnimplementedUserServiceServer) InviteOrganizationMember(context.Context, *InviteOrganizationMemberRequest) (*InviteOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteOrganizationMember not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptOrganizationInvitation(context.Context, *AcceptOrganizationInvitationRequest) (*AcceptOrganizationInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrganizationInvitation not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+}
+func (UnimplementedUserServiceServer) SetOrganizationMemberRole(context.Context, *SetOrganizationMemberRoleRequest) (*SetOrganizationMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationMemberRole not implemented")
+}
+func (UnimplementedUserServiceServer) GetOrganizationMembership(context.Context, *GetOrganizationMembershipRequest) (*GetOrganizationMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationMembership not implemented")
+}
+func (UnimplementedUserServiceServer) SetRelationship(context.Context, *SetRelationshipRequest) (*SetRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRelationship not implemented")
+}
+func (UnimplementedUserServiceServer) ListRelationships(context.Context, *ListRelationshipsRequest) (*ListRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelationships not implemented")
+}
+func (UnimplementedUserServiceServer) SetProfileVisibility(context.Context, *SetProfileVisibilityRequest) (*SetProfileVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfileVisibility not implemented")
+}
+func (UnimplementedUserServiceServer) GetProfileAccess(context.Context, *GetProfileAccessRequest) (*GetProfileAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileAccess not implemented")
+}
+func (UnimplementedUserServiceServer) FilterNotificationRecipients(context.Context, *FilterNotificationRecipientsRequest) (*FilterNotificationRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterNotificationRecipients not implemented")
+}
+func (UnimplementedUserServiceServer) SetAvatarFromNft(context.Context, *SetAvatarFromNftRequest) (*SetAvatarFromNftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAvatarFromNft not implemented")
+}
+func (UnimplementedUserServiceServer) ClearNftAvatar(context.Context, *ClearNftAvatarRequest) (*ClearNftAvatarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearNftAvatar not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -105,16 +537,610 @@ func _UserService_EnsureUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "user.UserService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EnsureUser",
-			Handler:    _UserService_EnsureUser_Handler,
+func _UserService_GetUsersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUsersByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByIDs(ctx, req.(*GetUsersByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetProfilesByAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfilesByAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetProfilesByAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetProfilesByAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetProfilesByAddresses(ctx, req.(*GetProfilesByAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuggestUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuggestUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuggestUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuggestUsers(ctx, req.(*SuggestUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StartEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartEmailVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartEmailVerification(ctx, req.(*StartEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmEmail(ctx, req.(*ConfirmEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetEmailStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmailStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetEmailStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetEmailStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetEmailStatus(ctx, req.(*GetEmailStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetEmailDigestOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEmailDigestOptOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetEmailDigestOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetEmailDigestOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetEmailDigestOptOut(ctx, req.(*SetEmailDigestOptOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetEmailOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEmailOptOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetEmailOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetEmailOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetEmailOptOut(ctx, req.(*SetEmailOptOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnsubscribeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnsubscribeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnsubscribeEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnsubscribeEmail(ctx, req.(*UnsubscribeEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetNotificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetNotificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetNotificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetNotificationEmail(ctx, req.(*GetNotificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserOrganizations(ctx, req.(*ListUserOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_InviteOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).InviteOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_InviteOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).InviteOrganizationMember(ctx, req.(*InviteOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptOrganizationInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrganizationInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptOrganizationInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptOrganizationInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptOrganizationInvitation(ctx, req.(*AcceptOrganizationInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetOrganizationMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetOrganizationMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetOrganizationMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetOrganizationMemberRole(ctx, req.(*SetOrganizationMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetOrganizationMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetOrganizationMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetOrganizationMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetOrganizationMembership(ctx, req.(*GetOrganizationMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetRelationship(ctx, req.(*SetRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListRelationships(ctx, req.(*ListRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetProfileVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetProfileVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetProfileVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetProfileVisibility(ctx, req.(*SetProfileVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetProfileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetProfileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetProfileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetProfileAccess(ctx, req.(*GetProfileAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FilterNotificationRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterNotificationRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FilterNotificationRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FilterNotificationRecipients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FilterNotificationRecipients(ctx, req.(*FilterNotificationRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetAvatarFromNft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvatarFromNftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetAvatarFromNft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetAvatarFromNft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetAvatarFromNft(ctx, req.(*SetAvatarFromNftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ClearNftAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearNftAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ClearNftAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ClearNftAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ClearNftAvatar(ctx, req.(*ClearNftAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnsureUser",
+			Handler:    _UserService_EnsureUser_Handler,
+		},
+		{
+			MethodName: "GetUsersByIDs",
+			Handler:    _UserService_GetUsersByIDs_Handler,
+		},
+		{
+			MethodName: "GetProfilesByAddresses",
+			Handler:    _UserService_GetProfilesByAddresses_Handler,
+		},
+		{
+			MethodName: "SuggestUsers",
+			Handler:    _UserService_SuggestUsers_Handler,
+		},
+		{
+			MethodName: "StartEmailVerification",
+			Handler:    _UserService_StartEmailVerification_Handler,
+		},
+		{
+			MethodName: "ConfirmEmail",
+			Handler:    _UserService_ConfirmEmail_Handler,
+		},
+		{
+			MethodName: "GetEmailStatus",
+			Handler:    _UserService_GetEmailStatus_Handler,
+		},
+		{
+			MethodName: "SetEmailDigestOptOut",
+			Handler:    _UserService_SetEmailDigestOptOut_Handler,
+		},
+		{
+			MethodName: "SetEmailOptOut",
+			Handler:    _UserService_SetEmailOptOut_Handler,
+		},
+		{
+			MethodName: "UnsubscribeEmail",
+			Handler:    _UserService_UnsubscribeEmail_Handler,
+		},
+		{
+			MethodName: "GetNotificationEmail",
+			Handler:    _UserService_GetNotificationEmail_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _UserService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _UserService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _UserService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _UserService_GetOrganization_Handler,
+		},
+		{
+			MethodName: "ListUserOrganizations",
+			Handler:    _UserService_ListUserOrganizations_Handler,
+		},
+		{
+			MethodName: "InviteOrganizationMember",
+			Handler:    _UserService_InviteOrganizationMember_Handler,
+		},
+		{
+			MethodName: "AcceptOrganizationInvitation",
+			Handler:    _UserService_AcceptOrganizationInvitation_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationMember",
+			Handler:    _UserService_RemoveOrganizationMember_Handler,
+		},
+		{
+			MethodName: "SetOrganizationMemberRole",
+			Handler:    _UserService_SetOrganizationMemberRole_Handler,
+		},
+		{
+			MethodName: "GetOrganizationMembership",
+			Handler:    _UserService_GetOrganizationMembership_Handler,
+		},
+		{
+			MethodName: "SetRelationship",
+			Handler:    _UserService_SetRelationship_Handler,
+		},
+		{
+			MethodName: "ListRelationships",
+			Handler:    _UserService_ListRelationships_Handler,
+		},
+		{
+			MethodName: "SetProfileVisibility",
+			Handler:    _UserService_SetProfileVisibility_Handler,
+		},
+		{
+			MethodName: "GetProfileAccess",
+			Handler:    _UserService_GetProfileAccess_Handler,
+		},
+		{
+			MethodName: "FilterNotificationRecipients",
+			Handler:    _UserService_FilterNotificationRecipients_Handler,
+		},
+		{
+			MethodName: "SetAvatarFromNft",
+			Handler:    _UserService_SetAvatarFromNft_Handler,
+		},
+		{
+			MethodName: "ClearNftAvatar",
+			Handler:    _UserService_ClearNftAvatar_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -23,6 +23,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How a signature challenge is signed
+type SignatureScheme int32
+
+const (
+	SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED SignatureScheme = 0 // EIP-191
+	SignatureScheme_SIGNATURE_SCHEME_EIP191      SignatureScheme = 1 // personal_sign of the message
+	SignatureScheme_SIGNATURE_SCHEME_EIP712      SignatureScheme = 2 // eth_signTypedData_v4 of the message, which is the typed data JSON
+)
+
+// Enum value maps for SignatureScheme.
+var (
+	SignatureScheme_name = map[int32]string{
+		0: "SIGNATURE_SCHEME_UNSPECIFIED",
+		1: "SIGNATURE_SCHEME_EIP191",
+		2: "SIGNATURE_SCHEME_EIP712",
+	}
+	SignatureScheme_value = map[string]int32{
+		"SIGNATURE_SCHEME_UNSPECIFIED": 0,
+		"SIGNATURE_SCHEME_EIP191":      1,
+		"SIGNATURE_SCHEME_EIP712":      2,
+	}
+)
+
+func (x SignatureScheme) Enum() *SignatureScheme {
+	p := new(SignatureScheme)
+	*p = x
+	return p
+}
+
+func (x SignatureScheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignatureScheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_proto_enumTypes[0].Descriptor()
+}
+
+func (SignatureScheme) Type() protoreflect.EnumType {
+	return &file_wallet_proto_enumTypes[0]
+}
+
+func (x SignatureScheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignatureScheme.Descriptor instead.
+func (SignatureScheme) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{0}
+}
+
 type WalletLink struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -34,6 +84,10 @@ type WalletLink struct {
 	VerifiedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Label         string                 `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`                                     // lowercase, user-defined
+	IsWatchOnly   bool                   `protobuf:"varint,12,opt,name=is_watch_only,json=isWatchOnly,proto3" json:"is_watch_only,omitempty"` // added without a signature; tracked only, never primary or a signer
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`     // last SIWE login or gateway session; unset for watch-only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +185,34 @@ func (x *WalletLink) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *WalletLink) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WalletLink) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WalletLink) GetIsWatchOnly() bool {
+	if x != nil {
+		return x.IsWatchOnly
+	}
+	return false
+}
+
+func (x *WalletLink) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
 type UpsertLinkRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -292,45 +374,1091 @@ func (x *UpsertLinkResponse) GetPrimaryChanged() bool {
 	return false
 }
 
-var File_wallet_proto protoreflect.FileDescriptor
+type ListLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_wallet_proto_rawDesc = "" +
-	"\n" +
-	"\fwallet.proto\x12\x06wallet\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x02\n" +
-	"\n" +
-	"WalletLink\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x03 \x01(\tR\taccountId\x12\x18\n" +
-	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x19\n" +
-	"\bchain_id\x18\x05 \x01(\tR\achainId\x12\x1d\n" +
-	"\n" +
-	"is_primary\x18\x06 \x01(\bR\tisPrimary\x12;\n" +
-	"\vverified_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"verifiedAt\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe7\x01\n" +
-	"\x11UpsertLinkRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x02 \x01(\tR\taccountId\x12\x18\n" +
-	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x19\n" +
-	"\bchain_id\x18\x04 \x01(\tR\achainId\x12\x1d\n" +
-	"\n" +
-	"is_primary\x18\x05 \x01(\bR\tisPrimary\x12\x12\n" +
-	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1c\n" +
-	"\tconnector\x18\a \x01(\tR\tconnector\x12\x14\n" +
-	"\x05label\x18\b \x01(\tR\x05label\"\x7f\n" +
-	"\x12UpsertLinkResponse\x12&\n" +
-	"\x04link\x18\x01 \x01(\v2\x12.wallet.WalletLinkR\x04link\x12\x18\n" +
-	"\acreated\x18\x02 \x01(\bR\acreated\x12'\n" +
-	"\x0fprimary_changed\x18\x03 \x01(\bR\x0eprimaryChanged2T\n" +
+func (x *ListLinksRequest) Reset() {
+	*x = ListLinksRequest{}
+	mi := &file_wallet_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinksRequest) ProtoMessage() {}
+
+func (x *ListLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListLinksRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListLinksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*WalletLink          `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinksResponse) Reset() {
+	*x = ListLinksResponse{}
+	mi := &file_wallet_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinksResponse) ProtoMessage() {}
+
+func (x *ListLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListLinksResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListLinksResponse) GetLinks() []*WalletLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// RemoveWallet unlinks one of the user's wallets; the primary wallet of a chain
+// cannot be removed until another wallet is made primary
+type RemoveWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletId      string                 `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWalletRequest) Reset() {
+	*x = RemoveWalletRequest{}
+	mi := &file_wallet_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWalletRequest) ProtoMessage() {}
+
+func (x *RemoveWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWalletRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWalletRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemoveWalletRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveWalletRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+type RemoveWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *WalletLink            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"` // the removed link
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWalletResponse) Reset() {
+	*x = RemoveWalletResponse{}
+	mi := &file_wallet_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWalletResponse) ProtoMessage() {}
+
+func (x *RemoveWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWalletResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWalletResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveWalletResponse) GetLink() *WalletLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+// SetPrimaryWallet makes one of the user's wallets the primary wallet of its chain
+type SetPrimaryWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletId      string                 `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryWalletRequest) Reset() {
+	*x = SetPrimaryWalletRequest{}
+	mi := &file_wallet_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryWalletRequest) ProtoMessage() {}
+
+func (x *SetPrimaryWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryWalletRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryWalletRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetPrimaryWalletRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetPrimaryWalletRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+type SetPrimaryWalletResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Link           *WalletLink            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	PrimaryChanged bool                   `protobuf:"varint,2,opt,name=primary_changed,json=primaryChanged,proto3" json:"primary_changed,omitempty"` // false when the wallet was already primary
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetPrimaryWalletResponse) Reset() {
+	*x = SetPrimaryWalletResponse{}
+	mi := &file_wallet_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryWalletResponse) ProtoMessage() {}
+
+func (x *SetPrimaryWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryWalletResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryWalletResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetPrimaryWalletResponse) GetLink() *WalletLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *SetPrimaryWalletResponse) GetPrimaryChanged() bool {
+	if x != nil {
+		return x.PrimaryChanged
+	}
+	return false
+}
+
+// AddWatchOnlyWallet tracks an address the user has not signed for. It is never primary
+// and is not accepted as the user's wallet anywhere ownership matters.
+type AddWatchOnlyWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId       string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // CAIP-2
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatchOnlyWalletRequest) Reset() {
+	*x = AddWatchOnlyWalletRequest{}
+	mi := &file_wallet_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatchOnlyWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchOnlyWalletRequest) ProtoMessage() {}
+
+func (x *AddWatchOnlyWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchOnlyWalletRequest.ProtoReflect.Descriptor instead.
+func (*AddWatchOnlyWalletRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddWatchOnlyWalletRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddWatchOnlyWalletRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddWatchOnlyWalletRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *AddWatchOnlyWalletRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddWatchOnlyWalletRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddWatchOnlyWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *WalletLink            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatchOnlyWalletResponse) Reset() {
+	*x = AddWatchOnlyWalletResponse{}
+	mi := &file_wallet_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatchOnlyWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchOnlyWalletResponse) ProtoMessage() {}
+
+func (x *AddWatchOnlyWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchOnlyWalletResponse.ProtoReflect.Descriptor instead.
+func (*AddWatchOnlyWalletResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddWatchOnlyWalletResponse) GetLink() *WalletLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+// UpdateWalletDetails sets the label and tags of one of the user's wallets
+type UpdateWalletDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletId      string                 `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Label         *string                `protobuf:"bytes,3,opt,name=label,proto3,oneof" json:"label,omitempty"` // unset keeps the label, empty clears it
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	ReplaceTags   bool                   `protobuf:"varint,5,opt,name=replace_tags,json=replaceTags,proto3" json:"replace_tags,omitempty"` // tags are only written when set, so an empty list clears them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWalletDetailsRequest) Reset() {
+	*x = UpdateWalletDetailsRequest{}
+	mi := &file_wallet_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWalletDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWalletDetailsRequest) ProtoMessage() {}
+
+func (x *UpdateWalletDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWalletDetailsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWalletDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateWalletDetailsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateWalletDetailsRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *UpdateWalletDetailsRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *UpdateWalletDetailsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateWalletDetailsRequest) GetReplaceTags() bool {
+	if x != nil {
+		return x.ReplaceTags
+	}
+	return false
+}
+
+type UpdateWalletDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *WalletLink            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWalletDetailsResponse) Reset() {
+	*x = UpdateWalletDetailsResponse{}
+	mi := &file_wallet_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWalletDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWalletDetailsResponse) ProtoMessage() {}
+
+func (x *UpdateWalletDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWalletDetailsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWalletDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateWalletDetailsResponse) GetLink() *WalletLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+// TouchWallets marks the user's signed wallets as seen, once per gateway session interval
+type TouchWalletsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchWalletsRequest) Reset() {
+	*x = TouchWalletsRequest{}
+	mi := &file_wallet_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchWalletsRequest) ProtoMessage() {}
+
+func (x *TouchWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchWalletsRequest.ProtoReflect.Descriptor instead.
+func (*TouchWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TouchWalletsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type TouchWalletsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Touched       int32                  `protobuf:"varint,1,opt,name=touched,proto3" json:"touched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchWalletsResponse) Reset() {
+	*x = TouchWalletsResponse{}
+	mi := &file_wallet_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchWalletsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchWalletsResponse) ProtoMessage() {}
+
+func (x *TouchWalletsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchWalletsResponse.ProtoReflect.Descriptor instead.
+func (*TouchWalletsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TouchWalletsResponse) GetTouched() int32 {
+	if x != nil {
+		return x.Touched
+	}
+	return 0
+}
+
+// CreateSignatureChallenge issues a single-use message that proves control of an address
+// once signed. Contract wallets are verified with EIP-1271 against the signed digest.
+type CreateSignatureChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purpose       string                 `protobuf:"bytes,1,opt,name=purpose,proto3" json:"purpose,omitempty"`                // what the proof is for, e.g. "collection_import"; verification must name it
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                // lowercase 0x…, the address expected to sign
+	ChainId       string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // CAIP-2; EIP-1271 is checked on this chain
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // optional; binds the proof to a user
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`              // optional; what the proof is about, e.g. "eip155:1/0xabc…"
+	Statement     string                 `protobuf:"bytes,6,opt,name=statement,proto3" json:"statement,omitempty"`            // optional; human-readable first line of the message
+	Scheme        SignatureScheme        `protobuf:"varint,7,opt,name=scheme,proto3,enum=wallet.SignatureScheme" json:"scheme,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,8,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 0 uses the service default
+	Message       string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`                          // optional; signed as is instead of a generated message, EIP-191 only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSignatureChallengeRequest) Reset() {
+	*x = CreateSignatureChallengeRequest{}
+	mi := &file_wallet_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSignatureChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSignatureChallengeRequest) ProtoMessage() {}
+
+func (x *CreateSignatureChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSignatureChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CreateSignatureChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateSignatureChallengeRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *CreateSignatureChallengeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateSignatureChallengeRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *CreateSignatureChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateSignatureChallengeRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *CreateSignatureChallengeRequest) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *CreateSignatureChallengeRequest) GetScheme() SignatureScheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
+func (x *CreateSignatureChallengeRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateSignatureChallengeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateSignatureChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // text for EIP-191, typed data JSON for EIP-712
+	Scheme        SignatureScheme        `protobuf:"varint,3,opt,name=scheme,proto3,enum=wallet.SignatureScheme" json:"scheme,omitempty"`
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSignatureChallengeResponse) Reset() {
+	*x = CreateSignatureChallengeResponse{}
+	mi := &file_wallet_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSignatureChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSignatureChallengeResponse) ProtoMessage() {}
+
+func (x *CreateSignatureChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSignatureChallengeResponse.ProtoReflect.Descriptor instead.
+func (*CreateSignatureChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateSignatureChallengeResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *CreateSignatureChallengeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateSignatureChallengeResponse) GetScheme() SignatureScheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
+func (x *CreateSignatureChallengeResponse) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *CreateSignatureChallengeResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// VerifySignatureChallenge checks a signed challenge and uses it up, whatever the outcome
+type VerifySignatureChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`         // 0x…
+	Purpose       string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`             // must match the challenge's
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // must match the challenge's when it has one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySignatureChallengeRequest) Reset() {
+	*x = VerifySignatureChallengeRequest{}
+	mi := &file_wallet_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySignatureChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignatureChallengeRequest) ProtoMessage() {}
+
+func (x *VerifySignatureChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignatureChallengeRequest.ProtoReflect.Descriptor instead.
+func (*VerifySignatureChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VerifySignatureChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *VerifySignatureChallengeRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *VerifySignatureChallengeRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *VerifySignatureChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type VerifySignatureChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // the proven address
+	ChainId       string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Purpose       string                 `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Method        string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"` // "eoa" or "eip1271"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySignatureChallengeResponse) Reset() {
+	*x = VerifySignatureChallengeResponse{}
+	mi := &file_wallet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySignatureChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignatureChallengeResponse) ProtoMessage() {}
+
+func (x *VerifySignatureChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignatureChallengeResponse.ProtoReflect.Descriptor instead.
+func (*VerifySignatureChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VerifySignatureChallengeResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VerifySignatureChallengeResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *VerifySignatureChallengeResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifySignatureChallengeResponse) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *VerifySignatureChallengeResponse) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *VerifySignatureChallengeResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+var File_wallet_proto protoreflect.FileDescriptor
+
+const file_wallet_proto_rawDesc = "" +
+	"\n" +
+	"\fwallet.proto\x12\x06wallet\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe7\x03\n" +
+	"\n" +
+	"WalletLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x05 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x06 \x01(\bR\tisPrimary\x12;\n" +
+	"\vverified_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
+	"\x05label\x18\n" +
+	" \x01(\tR\x05label\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\"\n" +
+	"\ris_watch_only\x18\f \x01(\bR\visWatchOnly\x12<\n" +
+	"\flast_seen_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\"\xe7\x01\n" +
+	"\x11UpsertLinkRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x04 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x05 \x01(\bR\tisPrimary\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1c\n" +
+	"\tconnector\x18\a \x01(\tR\tconnector\x12\x14\n" +
+	"\x05label\x18\b \x01(\tR\x05label\"\x7f\n" +
+	"\x12UpsertLinkResponse\x12&\n" +
+	"\x04link\x18\x01 \x01(\v2\x12.wallet.WalletLinkR\x04link\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x12'\n" +
+	"\x0fprimary_changed\x18\x03 \x01(\bR\x0eprimaryChanged\"+\n" +
+	"\x10ListLinksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"=\n" +
+	"\x11ListLinksResponse\x12(\n" +
+	"\x05links\x18\x01 \x03(\v2\x12.wallet.WalletLinkR\x05links\"K\n" +
+	"\x13RemoveWalletRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\tR\bwalletId\">\n" +
+	"\x14RemoveWalletResponse\x12&\n" +
+	"\x04link\x18\x01 \x01(\v2\x12.wallet.WalletLinkR\x04link\"O\n" +
+	"\x17SetPrimaryWalletRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\tR\bwalletId\"k\n" +
+	"\x18SetPrimaryWalletResponse\x12&\n" +
+	"\x04link\x18\x01 \x01(\v2\x12.wallet.WalletLinkR\x04link\x12'\n" +
+	"\x0fprimary_changed\x18\x02 \x01(\bR\x0eprimaryChanged\"\x93\x01\n" +
+	"\x19AddWatchOnlyWalletRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\"D\n" +
+	"\x1aAddWatchOnlyWalletResponse\x12&\n" +
+	"\x04link\x18\x01 \x01(\v2\x12.wallet.WalletLinkR\x04link\"\xae\x01\n" +
+	"\x1aUpdateWalletDetailsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\tR\bwalletId\x12\x19\n" +
+	"\x05label\x18\x03 \x01(\tH\x00R\x05label\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12!\n" +
+	"\freplace_tags\x18\x05 \x01(\bR\vreplaceTagsB\b\n" +
+	"\x06_label\"E\n" +
+	"\x1bUpdateWalletDetailsResponse\x12&\n" +
+	"\x04link\x18\x01 \x01(\v2\x12.wallet.WalletLinkR\x04link\".\n" +
+	"\x13TouchWalletsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"0\n" +
+	"\x14TouchWalletsResponse\x12\x18\n" +
+	"\atouched\x18\x01 \x01(\x05R\atouched\"\xaf\x02\n" +
+	"\x1fCreateSignatureChallengeRequest\x12\x18\n" +
+	"\apurpose\x18\x01 \x01(\tR\apurpose\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\tR\achainId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x1c\n" +
+	"\tstatement\x18\x06 \x01(\tR\tstatement\x12/\n" +
+	"\x06scheme\x18\a \x01(\x0e2\x17.wallet.SignatureSchemeR\x06scheme\x12\x1f\n" +
+	"\vttl_seconds\x18\b \x01(\x05R\n" +
+	"ttlSeconds\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\"\x84\x02\n" +
+	" CreateSignatureChallengeResponse\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x06scheme\x18\x03 \x01(\x0e2\x17.wallet.SignatureSchemeR\x06scheme\x127\n" +
+	"\tissued_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x95\x01\n" +
+	"\x1fVerifySignatureChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"\xbe\x01\n" +
+	" VerifySignatureChallengeResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x18\n" +
+	"\apurpose\x18\x04 \x01(\tR\apurpose\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method*m\n" +
+	"\x0fSignatureScheme\x12 \n" +
+	"\x1cSIGNATURE_SCHEME_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SIGNATURE_SCHEME_EIP191\x10\x01\x12\x1b\n" +
+	"\x17SIGNATURE_SCHEME_EIP712\x10\x022\x9e\x06\n" +
 	"\rWalletService\x12C\n" +
 	"\n" +
-	"UpsertLink\x12\x19.wallet.UpsertLinkRequest\x1a\x1a.wallet.UpsertLinkResponseB\x1cZ\x1ashared/proto/wallet;walletb\x06proto3"
+	"UpsertLink\x12\x19.wallet.UpsertLinkRequest\x1a\x1a.wallet.UpsertLinkResponse\x12@\n" +
+	"\tListLinks\x12\x18.wallet.ListLinksRequest\x1a\x19.wallet.ListLinksResponse\x12I\n" +
+	"\fRemoveWallet\x12\x1b.wallet.RemoveWalletRequest\x1a\x1c.wallet.RemoveWalletResponse\x12U\n" +
+	"\x10SetPrimaryWallet\x12\x1f.wallet.SetPrimaryWalletRequest\x1a .wallet.SetPrimaryWalletResponse\x12[\n" +
+	"\x12AddWatchOnlyWallet\x12!.wallet.AddWatchOnlyWalletRequest\x1a\".wallet.AddWatchOnlyWalletResponse\x12^\n" +
+	"\x13UpdateWalletDetails\x12\".wallet.UpdateWalletDetailsRequest\x1a#.wallet.UpdateWalletDetailsResponse\x12I\n" +
+	"\fTouchWallets\x12\x1b.wallet.TouchWalletsRequest\x1a\x1c.wallet.TouchWalletsResponse\x12m\n" +
+	"\x18CreateSignatureChallenge\x12'.wallet.CreateSignatureChallengeRequest\x1a(.wallet.CreateSignatureChallengeResponse\x12m\n" +
+	"\x18VerifySignatureChallenge\x12'.wallet.VerifySignatureChallengeRequest\x1a(.wallet.VerifySignatureChallengeResponseB\x1cZ\x1ashared/proto/wallet;walletb\x06proto3"
 
 var (
 	file_wallet_proto_rawDescOnce sync.Once
@@ -344,25 +1472,69 @@ func file_wallet_proto_rawDescGZIP() []byte {
 	return file_wallet_proto_rawDescData
 }
 
-var file_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_wallet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_wallet_proto_goTypes = []any{
-	(*WalletLink)(nil),            // 0: wallet.WalletLink
-	(*UpsertLinkRequest)(nil),     // 1: wallet.UpsertLinkRequest
-	(*UpsertLinkResponse)(nil),    // 2: wallet.UpsertLinkResponse
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(SignatureScheme)(0),                     // 0: wallet.SignatureScheme
+	(*WalletLink)(nil),                       // 1: wallet.WalletLink
+	(*UpsertLinkRequest)(nil),                // 2: wallet.UpsertLinkRequest
+	(*UpsertLinkResponse)(nil),               // 3: wallet.UpsertLinkResponse
+	(*ListLinksRequest)(nil),                 // 4: wallet.ListLinksRequest
+	(*ListLinksResponse)(nil),                // 5: wallet.ListLinksResponse
+	(*RemoveWalletRequest)(nil),              // 6: wallet.RemoveWalletRequest
+	(*RemoveWalletResponse)(nil),             // 7: wallet.RemoveWalletResponse
+	(*SetPrimaryWalletRequest)(nil),          // 8: wallet.SetPrimaryWalletRequest
+	(*SetPrimaryWalletResponse)(nil),         // 9: wallet.SetPrimaryWalletResponse
+	(*AddWatchOnlyWalletRequest)(nil),        // 10: wallet.AddWatchOnlyWalletRequest
+	(*AddWatchOnlyWalletResponse)(nil),       // 11: wallet.AddWatchOnlyWalletResponse
+	(*UpdateWalletDetailsRequest)(nil),       // 12: wallet.UpdateWalletDetailsRequest
+	(*UpdateWalletDetailsResponse)(nil),      // 13: wallet.UpdateWalletDetailsResponse
+	(*TouchWalletsRequest)(nil),              // 14: wallet.TouchWalletsRequest
+	(*TouchWalletsResponse)(nil),             // 15: wallet.TouchWalletsResponse
+	(*CreateSignatureChallengeRequest)(nil),  // 16: wallet.CreateSignatureChallengeRequest
+	(*CreateSignatureChallengeResponse)(nil), // 17: wallet.CreateSignatureChallengeResponse
+	(*VerifySignatureChallengeRequest)(nil),  // 18: wallet.VerifySignatureChallengeRequest
+	(*VerifySignatureChallengeResponse)(nil), // 19: wallet.VerifySignatureChallengeResponse
+	(*timestamppb.Timestamp)(nil),            // 20: google.protobuf.Timestamp
 }
 var file_wallet_proto_depIdxs = []int32{
-	3, // 0: wallet.WalletLink.verified_at:type_name -> google.protobuf.Timestamp
-	3, // 1: wallet.WalletLink.created_at:type_name -> google.protobuf.Timestamp
-	3, // 2: wallet.WalletLink.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 3: wallet.UpsertLinkResponse.link:type_name -> wallet.WalletLink
-	1, // 4: wallet.WalletService.UpsertLink:input_type -> wallet.UpsertLinkRequest
-	2, // 5: wallet.WalletService.UpsertLink:output_type -> wallet.UpsertLinkResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	20, // 0: wallet.WalletLink.verified_at:type_name -> google.protobuf.Timestamp
+	20, // 1: wallet.WalletLink.created_at:type_name -> google.protobuf.Timestamp
+	20, // 2: wallet.WalletLink.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 3: wallet.WalletLink.last_seen_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: wallet.UpsertLinkResponse.link:type_name -> wallet.WalletLink
+	1,  // 5: wallet.ListLinksResponse.links:type_name -> wallet.WalletLink
+	1,  // 6: wallet.RemoveWalletResponse.link:type_name -> wallet.WalletLink
+	1,  // 7: wallet.SetPrimaryWalletResponse.link:type_name -> wallet.WalletLink
+	1,  // 8: wallet.AddWatchOnlyWalletResponse.link:type_name -> wallet.WalletLink
+	1,  // 9: wallet.UpdateWalletDetailsResponse.link:type_name -> wallet.WalletLink
+	0,  // 10: wallet.CreateSignatureChallengeRequest.scheme:type_name -> wallet.SignatureScheme
+	0,  // 11: wallet.CreateSignatureChallengeResponse.scheme:type_name -> wallet.SignatureScheme
+	20, // 12: wallet.CreateSignatureChallengeResponse.issued_at:type_name -> google.protobuf.Timestamp
+	20, // 13: wallet.CreateSignatureChallengeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 14: wallet.WalletService.UpsertLink:input_type -> wallet.UpsertLinkRequest
+	4,  // 15: wallet.WalletService.ListLinks:input_type -> wallet.ListLinksRequest
+	6,  // 16: wallet.WalletService.RemoveWallet:input_type -> wallet.RemoveWalletRequest
+	8,  // 17: wallet.WalletService.SetPrimaryWallet:input_type -> wallet.SetPrimaryWalletRequest
+	10, // 18: wallet.WalletService.AddWatchOnlyWallet:input_type -> wallet.AddWatchOnlyWalletRequest
+	12, // 19: wallet.WalletService.UpdateWalletDetails:input_type -> wallet.UpdateWalletDetailsRequest
+	14, // 20: wallet.WalletService.TouchWallets:input_type -> wallet.TouchWalletsRequest
+	16, // 21: wallet.WalletService.CreateSignatureChallenge:input_type -> wallet.CreateSignatureChallengeRequest
+	18, // 22: wallet.WalletService.VerifySignatureChallenge:input_type -> wallet.VerifySignatureChallengeRequest
+	3,  // 23: wallet.WalletService.UpsertLink:output_type -> wallet.UpsertLinkResponse
+	5,  // 24: wallet.WalletService.ListLinks:output_type -> wallet.ListLinksResponse
+	7,  // 25: wallet.WalletService.RemoveWallet:output_type -> wallet.RemoveWalletResponse
+	9,  // 26: wallet.WalletService.SetPrimaryWallet:output_type -> wallet.SetPrimaryWalletResponse
+	11, // 27: wallet.WalletService.AddWatchOnlyWallet:output_type -> wallet.AddWatchOnlyWalletResponse
+	13, // 28: wallet.WalletService.UpdateWalletDetails:output_type -> wallet.UpdateWalletDetailsResponse
+	15, // 29: wallet.WalletService.TouchWallets:output_type -> wallet.TouchWalletsResponse
+	17, // 30: wallet.WalletService.CreateSignatureChallenge:output_type -> wallet.CreateSignatureChallengeResponse
+	19, // 31: wallet.WalletService.VerifySignatureChallenge:output_type -> wallet.VerifySignatureChallengeResponse
+	23, // [23:32] is the sub-list for method output_type
+	14, // [14:23] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_wallet_proto_init() }
@@ -370,18 +1542,20 @@ func file_wallet_proto_init() {
 	if File_wallet_proto != nil {
 		return
 	}
+	file_wallet_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wallet_proto_rawDesc), len(file_wallet_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_wallet_proto_goTypes,
 		DependencyIndexes: file_wallet_proto_depIdxs,
+		EnumInfos:         file_wallet_proto_enumTypes,
 		MessageInfos:      file_wallet_proto_msgTypes,
 	}.Build()
 	File_wallet_proto = out.File
@@ -20,7 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WalletService_UpsertLink_FullMethodName = "/wallet.WalletService/UpsertLink"
+	WalletService_UpsertLink_FullMethodName               = "/wallet.WalletService/UpsertLink"
+	WalletService_ListLinks_FullMethodName                = "/wallet.WalletService/ListLinks"
+	WalletService_RemoveWallet_FullMethodName             = "/wallet.WalletService/RemoveWallet"
+	WalletService_SetPrimaryWallet_FullMethodName         = "/wallet.WalletService/SetPrimaryWallet"
+	WalletService_AddWatchOnlyWallet_FullMethodName       = "/wallet.WalletService/AddWatchOnlyWallet"
+	WalletService_UpdateWalletDetails_FullMethodName      = "/wallet.WalletService/UpdateWalletDetails"
+	WalletService_TouchWallets_FullMethodName             = "/wallet.WalletService/TouchWallets"
+	WalletService_CreateSignatureChallenge_FullMethodName = "/wallet.WalletService/CreateSignatureChallenge"
+	WalletService_VerifySignatureChallenge_FullMethodName = "/wallet.WalletService/VerifySignatureChallenge"
 )
 
 // WalletServiceClient is the client API for WalletService service.
@@ -28,6 +36,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WalletServiceClient interface {
 	UpsertLink(ctx context.Context, in *UpsertLinkRequest, opts ...grpc.CallOption) (*UpsertLinkResponse, error)
+	ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error)
+	RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error)
+	SetPrimaryWallet(ctx context.Context, in *SetPrimaryWalletRequest, opts ...grpc.CallOption) (*SetPrimaryWalletResponse, error)
+	AddWatchOnlyWallet(ctx context.Context, in *AddWatchOnlyWalletRequest, opts ...grpc.CallOption) (*AddWatchOnlyWalletResponse, error)
+	UpdateWalletDetails(ctx context.Context, in *UpdateWalletDetailsRequest, opts ...grpc.CallOption) (*UpdateWalletDetailsResponse, error)
+	TouchWallets(ctx context.Context, in *TouchWalletsRequest, opts ...grpc.CallOption) (*TouchWalletsResponse, error)
+	CreateSignatureChallenge(ctx context.Context, in *CreateSignatureChallengeRequest, opts ...grpc.CallOption) (*CreateSignatureChallengeResponse, error)
+	VerifySignatureChallenge(ctx context.Context, in *VerifySignatureChallengeRequest, opts ...grpc.CallOption) (*VerifySignatureChallengeResponse, error)
 }
 
 type walletServiceClient struct {
@@ -48,11 +64,99 @@ func (c *walletServiceClient) UpsertLink(ctx context.Context, in *UpsertLinkRequ
 	return out, nil
 }
 
+func (c *walletServiceClient) ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinksResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWalletResponse)
+	err := c.cc.Invoke(ctx, WalletService_RemoveWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetPrimaryWallet(ctx context.Context, in *SetPrimaryWalletRequest, opts ...grpc.CallOption) (*SetPrimaryWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryWalletResponse)
+	err := c.cc.Invoke(ctx, WalletService_SetPrimaryWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AddWatchOnlyWallet(ctx context.Context, in *AddWatchOnlyWalletRequest, opts ...grpc.CallOption) (*AddWatchOnlyWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWatchOnlyWalletResponse)
+	err := c.cc.Invoke(ctx, WalletService_AddWatchOnlyWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UpdateWalletDetails(ctx context.Context, in *UpdateWalletDetailsRequest, opts ...grpc.CallOption) (*UpdateWalletDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWalletDetailsResponse)
+	err := c.cc.Invoke(ctx, WalletService_UpdateWalletDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TouchWallets(ctx context.Context, in *TouchWalletsRequest, opts ...grpc.CallOption) (*TouchWalletsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TouchWalletsResponse)
+	err := c.cc.Invoke(ctx, WalletService_TouchWallets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateSignatureChallenge(ctx context.Context, in *CreateSignatureChallengeRequest, opts ...grpc.CallOption) (*CreateSignatureChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSignatureChallengeResponse)
+	err := c.cc.Invoke(ctx, WalletService_CreateSignatureChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifySignatureChallenge(ctx context.Context, in *VerifySignatureChallengeRequest, opts ...grpc.CallOption) (*VerifySignatureChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySignatureChallengeResponse)
+	err := c.cc.Invoke(ctx, WalletService_VerifySignatureChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletServiceServer is the server API for WalletService service.
 // All implementations must embed UnimplementedWalletServiceServer
 // for forward compatibility.
 type WalletServiceServer interface {
 	UpsertLink(context.Context, *UpsertLinkRequest) (*UpsertLinkResponse, error)
+	ListLinks(context.Context, *ListLinksRequest) (*ListLinksResponse, error)
+	RemoveWallet(context.Context, *RemoveWalletRequest) (*RemoveWalletResponse, error)
+	SetPrimaryWallet(context.Context, *SetPrimaryWalletRequest) (*SetPrimaryWalletResponse, error)
+	AddWatchOnlyWallet(context.Context, *AddWatchOnlyWalletRequest) (*AddWatchOnlyWalletResponse, error)
+	UpdateWalletDetails(context.Context, *UpdateWalletDetailsRequest) (*UpdateWalletDetailsResponse, error)
+	TouchWallets(context.Context, *TouchWalletsRequest) (*TouchWalletsResponse, error)
+	CreateSignatureChallenge(context.Context, *CreateSignatureChallengeRequest) (*CreateSignatureChallengeResponse, error)
+	VerifySignatureChallenge(context.Context, *VerifySignatureChallengeRequest) (*VerifySignatureChallengeResponse, error)
 	mustEmbedUnimplementedWalletServiceServer()
 }
 
@@ -66,6 +170,30 @@ type UnimplementedWalletServiceServer struct{}
 func (UnimplementedWalletServiceServer) UpsertLink(context.Context, *UpsertLinkRequest) (*UpsertLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertLink not implemented")
 }
+func (UnimplementedWalletServiceServer) ListLinks(context.Context, *ListLinksRequest) (*ListLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
+}
+func (UnimplementedWalletServiceServer) RemoveWallet(context.Context, *RemoveWalletRequest) (*RemoveWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) SetPrimaryWallet(context.Context, *SetPrimaryWalletRequest) (*SetPrimaryWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) AddWatchOnlyWallet(context.Context, *AddWatchOnlyWalletRequest) (*AddWatchOnlyWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWatchOnlyWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) UpdateWalletDetails(context.Context, *UpdateWalletDetailsRequest) (*UpdateWalletDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWalletDetails not implemented")
+}
+func (UnimplementedWalletServiceServer) TouchWallets(context.Context, *TouchWalletsRequest) (*TouchWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchWallets not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateSignatureChallenge(context.Context, *CreateSignatureChallengeRequest) (*CreateSignatureChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSignatureChallenge not implemented")
+}
+func (UnimplementedWalletServiceServer) VerifySignatureChallenge(context.Context, *VerifySignatureChallengeRequest) (*VerifySignatureChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySignatureChallenge not implemented")
+}
 func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
 func (UnimplementedWalletServiceServer) testEmbeddedByValue()                       {}
 
@@ -105,6 +233,150 @@ func _WalletService_UpsertLink_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListLinks(ctx, req.(*ListLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RemoveWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RemoveWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_RemoveWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RemoveWallet(ctx, req.(*RemoveWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetPrimaryWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetPrimaryWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SetPrimaryWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetPrimaryWallet(ctx, req.(*SetPrimaryWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AddWatchOnlyWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWatchOnlyWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AddWatchOnlyWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_AddWatchOnlyWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AddWatchOnlyWallet(ctx, req.(*AddWatchOnlyWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_UpdateWalletDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWalletDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UpdateWalletDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_UpdateWalletDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UpdateWalletDetails(ctx, req.(*UpdateWalletDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_TouchWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TouchWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_TouchWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TouchWallets(ctx, req.(*TouchWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateSignatureChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSignatureChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateSignatureChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CreateSignatureChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateSignatureChallenge(ctx, req.(*CreateSignatureChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VerifySignatureChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySignatureChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VerifySignatureChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_VerifySignatureChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VerifySignatureChallenge(ctx, req.(*VerifySignatureChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -116,6 +388,38 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertLink",
 			Handler:    _WalletService_UpsertLink_Handler,
 		},
+		{
+			MethodName: "ListLinks",
+			Handler:    _WalletService_ListLinks_Handler,
+		},
+		{
+			MethodName: "RemoveWallet",
+			Handler:    _WalletService_RemoveWallet_Handler,
+		},
+		{
+			MethodName: "SetPrimaryWallet",
+			Handler:    _WalletService_SetPrimaryWallet_Handler,
+		},
+		{
+			MethodName: "AddWatchOnlyWallet",
+			Handler:    _WalletService_AddWatchOnlyWallet_Handler,
+		},
+		{
+			MethodName: "UpdateWalletDetails",
+			Handler:    _WalletService_UpdateWalletDetails_Handler,
+		},
+		{
+			MethodName: "TouchWallets",
+			Handler:    _WalletService_TouchWallets_Handler,
+		},
+		{
+			MethodName: "CreateSignatureChallenge",
+			Handler:    _WalletService_CreateSignatureChallenge_Handler,
+		},
+		{
+			MethodName: "VerifySignatureChallenge",
+			Handler:    _WalletService_VerifySignatureChallenge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "wallet.proto",
@@ -0,0 +1,44 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// ErrChainHeadNotFound is returned for a chain the indexer has not reported a head for
+var ErrChainHeadNotFound = errors.New("chain head not found")
+
+// WriteChainHead stores the latest head reported for a chain. Heads are never expired: a
+// chain the indexer stopped reporting keeps its last one, which readers judge by its age.
+func (r *Redis) WriteChainHead(ctx context.Context, head contracts.ChainHead) error {
+	payload, err := json.Marshal(head)
+	if err != nil {
+		return fmt.Errorf("failed to encode chain head: %w", err)
+	}
+	if err := r.conn.HSet(ctx, contracts.ChainHeadKey, head.ChainID, payload).Err(); err != nil {
+		return fmt.Errorf("failed to write chain head: %w", err)
+	}
+	return nil
+}
+
+// ReadChainHead returns the last head stored for a CAIP-2 chain id
+func (r *Redis) ReadChainHead(ctx context.Context, chainID string) (contracts.ChainHead, error) {
+	var head contracts.ChainHead
+	payload, err := r.conn.HGet(ctx, contracts.ChainHeadKey, chainID).Bytes()
+	if errors.Is(err, redislib.Nil) {
+		return head, ErrChainHeadNotFound
+	}
+	if err != nil {
+		return head, fmt.Errorf("failed to read chain head: %w", err)
+	}
+	if err := json.Unmarshal(payload, &head); err != nil {
+		return head, fmt.Errorf("failed to decode chain head: %w", err)
+	}
+	return head, nil
+}
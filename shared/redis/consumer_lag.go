@@ -0,0 +1,40 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// WriteConsumerLag stores the latest lag report of a consumer. Reports are never expired:
+// a consumer that stopped reporting keeps its last one, which readers judge by its age.
+func (r *Redis) WriteConsumerLag(ctx context.Context, lag contracts.ConsumerLag) error {
+	payload, err := json.Marshal(lag)
+	if err != nil {
+		return fmt.Errorf("failed to encode consumer lag: %w", err)
+	}
+	if err := r.conn.HSet(ctx, contracts.ConsumerLagKey, lag.Consumer, payload).Err(); err != nil {
+		return fmt.Errorf("failed to write consumer lag: %w", err)
+	}
+	return nil
+}
+
+// ReadConsumerLags returns the latest lag report of every consumer; unreadable ones are skipped
+func (r *Redis) ReadConsumerLags(ctx context.Context) ([]contracts.ConsumerLag, error) {
+	fields, err := r.conn.HGetAll(ctx, contracts.ConsumerLagKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read consumer lag: %w", err)
+	}
+
+	lags := make([]contracts.ConsumerLag, 0, len(fields))
+	for _, payload := range fields {
+		var lag contracts.ConsumerLag
+		if err := json.Unmarshal([]byte(payload), &lag); err != nil || lag.Consumer == "" {
+			continue
+		}
+		lags = append(lags, lag)
+	}
+	return lags, nil
+}
@@ -0,0 +1,323 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+var (
+	// ErrIntentStatusNotFound is returned when an intent has no status hash
+	ErrIntentStatusNotFound = errors.New("intent status not found")
+	// ErrIntentStatusConflict is returned when a conditional write lost to another writer
+	ErrIntentStatusConflict = errors.New("intent status version conflict")
+)
+
+// writeIntentStatusScript merges fields into the status hash, bumps its version, refreshes
+// the TTL and publishes the resulting state in one round trip. A non-zero expected version
+// must match the stored one, and a non-zero seq must be past the stored one, otherwise
+// nothing is written and -1 is returned.
+//
+// KEYS[1] status hash; KEYS[2] optional status stream; ARGV[1] expected version; ARGV[2]
+// ttl in ms; ARGV[3] channel, or the stream's max length when KEYS[2] is given; ARGV[4]
+// seq; ARGV[5..] field/value pairs
+var writeIntentStatusScript = redislib.NewScript(`
+local current = tonumber(redis.call('HGET', KEYS[1], 'version') or '0')
+local expected = tonumber(ARGV[1])
+if expected > 0 and current ~= expected then
+	return -1
+end
+local seq = tonumber(ARGV[4])
+if seq > 0 and seq <= tonumber(redis.call('HGET', KEYS[1], 'seq') or '0') then
+	return -1
+end
+if #ARGV > 4 then
+	redis.call('HSET', KEYS[1], unpack(ARGV, 5))
+end
+local version = redis.call('HINCRBY', KEYS[1], 'version', 1)
+local ttl = tonumber(ARGV[2])
+if ttl > 0 then
+	redis.call('PEXPIRE', KEYS[1], ttl)
+end
+local flat = redis.call('HGETALL', KEYS[1])
+local state = {}
+for i = 1, #flat, 2 do
+	state[flat[i]] = flat[i + 1]
+end
+local payload = cjson.encode(state)
+if #KEYS > 1 then
+	redis.call('XADD', KEYS[2], 'MAXLEN', '~', ARGV[3], '*', 'state', payload)
+else
+	redis.call('PUBLISH', ARGV[3], payload)
+end
+return version
+`)
+
+// statusStreamBlock is how long a stream read waits for new status writes
+const statusStreamBlock = 5 * time.Second
+
+// statusStreamBatch bounds the status writes read from the stream at once
+const statusStreamBatch = 100
+
+// statusStreamRetryMin and statusStreamRetryMax bound the backoff between failed stream
+// reads
+const (
+	statusStreamRetryMin = 100 * time.Millisecond
+	statusStreamRetryMax = 5 * time.Second
+)
+
+// SetIntentStatusStream sends status writes to contracts.IntentStatusStream, trimmed to
+// about maxLen entries, instead of publishing them on contracts.IntentStatusChannel
+func (r *Redis) SetIntentStatusStream(maxLen int64) {
+	r.statusStreamMaxLen = maxLen
+}
+
+// IntentStatusUpdate is one write to an intent status hash
+type IntentStatusUpdate struct {
+	Record contracts.IntentStatusRecord
+	// IfVersion makes the write conditional on the stored version; 0 writes unconditionally
+	IfVersion int64
+	TTL       time.Duration
+}
+
+// WriteIntentStatuses applies updates in a single pipeline and returns the new version of
+// each intent, or -1 where a conditional write lost or the record's seq was stale. Tx hash and contract indexes are
+// maintained alongside so intents can be resolved from chain events.
+func (r *Redis) WriteIntentStatuses(ctx context.Context, updates ...IntentStatusUpdate) ([]int64, error) {
+	if len(updates) == 0 {
+		return nil, nil
+	}
+
+	// The script publishes itself, so the channel is not a key the hook would prefix
+	var target interface{} = r.channel(contracts.IntentStatusChannel)
+	if r.statusStreamMaxLen > 0 {
+		target = r.statusStreamMaxLen
+	}
+
+	pipe := r.conn.Pipeline()
+	cmds := make([]*redislib.Cmd, len(updates))
+	for i, u := range updates {
+		fields, err := u.Record.HashFields()
+		if err != nil {
+			return nil, err
+		}
+		args := make([]interface{}, 0, 4+2*len(fields))
+		args = append(args, u.IfVersion, u.TTL.Milliseconds(), target, u.Record.Seq)
+		for name, value := range fields {
+			args = append(args, name, value)
+		}
+		keys := []string{contracts.IntentStatusKey(u.Record.IntentID)}
+		if r.statusStreamMaxLen > 0 {
+			keys = append(keys, contracts.IntentStatusStream)
+		}
+		cmds[i] = writeIntentStatusScript.Eval(ctx, pipe, keys, args...)
+
+		rec := u.Record
+		if rec.ChainID != "" && rec.TxHash != "" {
+			r.addIntentIndex(ctx, pipe, contracts.IntentTxHashKey(rec.ChainID, rec.TxHash), rec.IntentID, u.TTL)
+		}
+		if rec.ChainID != "" && rec.ContractAddress != "" {
+			r.addIntentIndex(ctx, pipe, contracts.IntentContractKey(rec.ChainID, rec.ContractAddress), rec.IntentID, u.TTL)
+		}
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to write intent statuses: %w", err)
+	}
+
+	versions := make([]int64, len(cmds))
+	for i, cmd := range cmds {
+		v, err := cmd.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("failed to write intent status %s: %w", updates[i].Record.IntentID, err)
+		}
+		versions[i] = v
+	}
+	return versions, nil
+}
+
+func (r *Redis) addIntentIndex(ctx context.Context, pipe redislib.Pipeliner, key, intentID string, ttl time.Duration) {
+	pipe.SAdd(ctx, key, intentID)
+	if ttl > 0 {
+		pipe.Expire(ctx, key, ttl)
+	}
+}
+
+// CompareAndSetIntentStatus writes rec if the stored version still equals ifVersion, as
+// read through ReadIntentStatus. It returns the new version or ErrIntentStatusConflict.
+func (r *Redis) CompareAndSetIntentStatus(ctx context.Context, rec contracts.IntentStatusRecord, ifVersion int64, ttl time.Duration) (int64, error) {
+	versions, err := r.WriteIntentStatuses(ctx, IntentStatusUpdate{Record: rec, IfVersion: ifVersion, TTL: ttl})
+	if err != nil {
+		return 0, err
+	}
+	if versions[0] < 0 {
+		return 0, ErrIntentStatusConflict
+	}
+	return versions[0], nil
+}
+
+// ReadIntentStatus returns the stored status of an intent with its version
+func (r *Redis) ReadIntentStatus(ctx context.Context, intentID string) (contracts.IntentStatusRecord, error) {
+	fields, err := r.conn.HGetAll(ctx, contracts.IntentStatusKey(intentID)).Result()
+	if err != nil {
+		return contracts.IntentStatusRecord{}, fmt.Errorf("failed to read intent status: %w", err)
+	}
+	if len(fields) == 0 {
+		return contracts.IntentStatusRecord{}, ErrIntentStatusNotFound
+	}
+	return contracts.IntentStatusRecordFromHash(fields)
+}
+
+// SubscribeIntentStatus calls handler with every status written until ctx is done
+func (r *Redis) SubscribeIntentStatus(ctx context.Context, handler func(contracts.IntentStatusRecord)) error {
+	sub := r.conn.Subscribe(ctx, r.channel(contracts.IntentStatusChannel))
+	defer sub.Close()
+
+	if _, err := sub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to intent status: %w", err)
+	}
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			rec, err := decodeIntentStatus(msg.Payload)
+			if err != nil {
+				continue
+			}
+			handler(rec)
+		}
+	}
+}
+
+// ConsumeIntentStatusStream calls handler with every status written to the status stream
+// until ctx is done, reading as consumer of group. The group is created at the end of the
+// stream if missing. Entries are acknowledged once handled, so a restarted consumer first
+// replays the ones it had read but not finished. Every group sees every write; consumers
+// sharing a group split them.
+//
+// Redis errors are logged and retried with backoff, so it only returns once ctx is done.
+func (r *Redis) ConsumeIntentStatusStream(ctx context.Context, group, consumer string, handler func(contracts.IntentStatusRecord)) error {
+	wait := statusStreamRetryMin
+	retry := func(err error) bool {
+		log.Printf("Intent status stream: %v, retrying in %v", err, wait)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(wait):
+		}
+		wait = min(2*wait, statusStreamRetryMax)
+		return true
+	}
+
+	for {
+		err := r.createIntentStatusGroup(ctx, group)
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil || !retry(err) {
+			return ctx.Err()
+		}
+	}
+
+	// "0" reads this consumer's unacknowledged entries, ">" the ones never delivered
+	start := "0"
+	for {
+		streams, err := r.conn.XReadGroup(ctx, &redislib.XReadGroupArgs{
+			Group:    group,
+			Consumer: consumer,
+			Streams:  []string{contracts.IntentStatusStream, start},
+			Count:    statusStreamBatch,
+			Block:    statusStreamBlock,
+		}).Result()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil && !errors.Is(err, redislib.Nil) {
+			// The stream or group went away, e.g. the stream key was deleted: start over
+			if strings.HasPrefix(err.Error(), "NOGROUP") {
+				if err := r.createIntentStatusGroup(ctx, group); err != nil && ctx.Err() == nil {
+					log.Printf("Intent status stream: %v", err)
+				}
+				start = "0"
+			}
+			if !retry(fmt.Errorf("failed to read intent status stream: %w", err)) {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		var read int
+		var ackErr error
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				read++
+				if payload, ok := msg.Values["state"].(string); ok {
+					if rec, err := decodeIntentStatus(payload); err == nil {
+						handler(rec)
+					}
+				}
+				if err := r.conn.XAck(ctx, contracts.IntentStatusStream, group, msg.ID).Err(); err != nil && ackErr == nil {
+					ackErr = fmt.Errorf("failed to ack intent status %s: %w", msg.ID, err)
+				}
+			}
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if ackErr != nil {
+			// Entries left unacknowledged are read again from "0"
+			start = "0"
+			if !retry(ackErr) {
+				return ctx.Err()
+			}
+			continue
+		}
+		wait = statusStreamRetryMin
+		if start == "0" && read == 0 {
+			start = ">"
+		}
+	}
+}
+
+// createIntentStatusGroup creates group at the end of the status stream, creating the
+// stream too, unless the group exists
+func (r *Redis) createIntentStatusGroup(ctx context.Context, group string) error {
+	err := r.conn.XGroupCreateMkStream(ctx, contracts.IntentStatusStream, group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create intent status group: %w", err)
+	}
+	return nil
+}
+
+// DestroyIntentStatusGroup removes a consumer group from the status stream. Groups outlive
+// their consumers, so one nobody will read again, such as a stopping replica's, should be
+// removed rather than left to pile up.
+func (r *Redis) DestroyIntentStatusGroup(ctx context.Context, group string) error {
+	if err := r.conn.XGroupDestroy(ctx, contracts.IntentStatusStream, group).Err(); err != nil {
+		return fmt.Errorf("failed to destroy intent status group: %w", err)
+	}
+	return nil
+}
+
+// decodeIntentStatus parses the JSON state published by writeIntentStatusScript
+func decodeIntentStatus(payload string) (contracts.IntentStatusRecord, error) {
+	var fields map[string]string
+	if err := json.Unmarshal([]byte(payload), &fields); err != nil {
+		return contracts.IntentStatusRecord{}, err
+	}
+	return contracts.IntentStatusRecordFromHash(fields)
+}
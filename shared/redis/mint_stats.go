@@ -0,0 +1,49 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// PublishMintStats announces an updated mint stats bucket. Updates are fire-and-forget:
+// dashboards refetch the window, so a missed one is covered by the next.
+func (r *Redis) PublishMintStats(ctx context.Context, update contracts.MintStatsUpdate) error {
+	payload, err := json.Marshal(update)
+	if err != nil {
+		return fmt.Errorf("failed to encode mint stats: %w", err)
+	}
+	if err := r.conn.Publish(ctx, contracts.MintStatsChannel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish mint stats: %w", err)
+	}
+	return nil
+}
+
+// SubscribeMintStats calls handler with every mint stats update until ctx is done
+func (r *Redis) SubscribeMintStats(ctx context.Context, handler func(contracts.MintStatsUpdate)) error {
+	sub := r.conn.Subscribe(ctx, r.channel(contracts.MintStatsChannel))
+	defer sub.Close()
+
+	if _, err := sub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to mint stats: %w", err)
+	}
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var update contracts.MintStatsUpdate
+			if err := json.Unmarshal([]byte(msg.Payload), &update); err != nil || update.ContractAddress == "" {
+				continue
+			}
+			handler(update)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
 )
 
 type RedisConfig struct {
@@ -14,13 +16,23 @@ type RedisConfig struct {
 	RedisPort     int
 	RedisPassword string
 	RedisDB       int
+	// Tenant, when set, prefixes every key and channel; see package tenant
+	Tenant string
 }
 
 type Redis struct {
 	conn *redislib.Client
+	// statusStreamMaxLen, when set, sends intent status writes to the status stream instead
+	// of the pub/sub channel and roughly caps its length
+	statusStreamMaxLen int64
+	// prefix is the tenant's key prefix, empty without a tenant
+	prefix string
 }
 
 func NewRedis(cfg RedisConfig) (*Redis, error) {
+	if err := tenant.Validate(cfg.Tenant); err != nil {
+		return nil, err
+	}
 
 	url := fmt.Sprintf("%s:%d", cfg.RedisHost, cfg.RedisPort)
 	log.Println("===>Redis URL: ", url)
@@ -30,7 +42,11 @@ func NewRedis(cfg RedisConfig) (*Redis, error) {
 		DB:       cfg.RedisDB,
 	})
 
-	return &Redis{conn: conn}, nil
+	prefix := tenant.KeyPrefix(cfg.Tenant)
+	if prefix != "" {
+		conn.AddHook(keyPrefixHook{prefix: prefix})
+	}
+	return &Redis{conn: conn, prefix: prefix}, nil
 }
 
 func (r *Redis) HealthCheck(ctx context.Context) error {
@@ -0,0 +1,34 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// WriteStatusHeartbeat stamps the status store with at. It must be called on the primary;
+// replicas pick the stamp up in order with the status writes made before it.
+func (r *Redis) WriteStatusHeartbeat(ctx context.Context, at time.Time) error {
+	if err := r.conn.Set(ctx, contracts.IntentStatusHeartbeatKey, at.UnixMilli(), 0).Err(); err != nil {
+		return fmt.Errorf("failed to write status heartbeat: %w", err)
+	}
+	return nil
+}
+
+// ReadStatusHeartbeat returns the newest stamp this connection sees, or the zero time
+// when no heartbeat was written yet
+func (r *Redis) ReadStatusHeartbeat(ctx context.Context) (time.Time, error) {
+	ms, err := r.conn.Get(ctx, contracts.IntentStatusHeartbeatKey).Int64()
+	if errors.Is(err, redislib.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read status heartbeat: %w", err)
+	}
+	return time.UnixMilli(ms), nil
+}
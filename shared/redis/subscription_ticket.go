@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+var (
+	// ErrSubscriptionTicketNotFound is returned for a ticket that was never issued, has
+	// expired or was already used
+	ErrSubscriptionTicketNotFound = errors.New("subscription ticket not found")
+	// ErrSubscriptionTicketExists is returned when a ticket value is already stored
+	ErrSubscriptionTicketExists = errors.New("subscription ticket already exists")
+)
+
+func subscriptionTicketKey(ticket string) string {
+	sum := sha256.Sum256([]byte(ticket))
+	return contracts.SubscriptionTicketKey(hex.EncodeToString(sum[:]))
+}
+
+// StoreSubscriptionTicket records a ticket until it is consumed or ttl passes
+func (r *Redis) StoreSubscriptionTicket(ctx context.Context, ticket string, rec contracts.SubscriptionTicket, ttl time.Duration) error {
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode subscription ticket: %w", err)
+	}
+	ok, err := r.conn.SetNX(ctx, subscriptionTicketKey(ticket), payload, ttl).Result()
+	if err != nil {
+		return fmt.Errorf("failed to store subscription ticket: %w", err)
+	}
+	if !ok {
+		return ErrSubscriptionTicketExists
+	}
+	return nil
+}
+
+// ConsumeSubscriptionTicket redeems a ticket, deleting it in the same round trip so it
+// cannot be used twice
+func (r *Redis) ConsumeSubscriptionTicket(ctx context.Context, ticket string) (contracts.SubscriptionTicket, error) {
+	var rec contracts.SubscriptionTicket
+	payload, err := r.conn.GetDel(ctx, subscriptionTicketKey(ticket)).Bytes()
+	if errors.Is(err, redislib.Nil) {
+		return rec, ErrSubscriptionTicketNotFound
+	}
+	if err != nil {
+		return rec, fmt.Errorf("failed to consume subscription ticket: %w", err)
+	}
+	if err := json.Unmarshal(payload, &rec); err != nil {
+		return rec, fmt.Errorf("failed to decode subscription ticket: %w", err)
+	}
+	return rec, nil
+}
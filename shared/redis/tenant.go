@@ -0,0 +1,165 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	redislib "github.com/redis/go-redis/v9"
+)
+
+// keyPrefixHook puts a tenant's prefix in front of every key a command names, and takes it
+// off the keys KEYS and SCAN return, so callers keep using plain key names. Pub/sub
+// subscriptions don't go through hooks; Redis.channel prefixes those.
+type keyPrefixHook struct {
+	prefix string
+}
+
+// unkeyedCommands take no key arguments
+var unkeyedCommands = map[string]bool{
+	"auth": true, "client": true, "cluster": true, "command": true, "config": true,
+	"dbsize": true, "discard": true, "echo": true, "exec": true, "flushall": true,
+	"flushdb": true, "hello": true, "info": true, "multi": true, "ping": true,
+	"quit": true, "readonly": true, "role": true, "script": true, "select": true,
+	"slowlog": true, "time": true, "unwatch": true, "wait": true,
+}
+
+func (h keyPrefixHook) DialHook(next redislib.DialHook) redislib.DialHook {
+	return next
+}
+
+func (h keyPrefixHook) ProcessHook(next redislib.ProcessHook) redislib.ProcessHook {
+	return func(ctx context.Context, cmd redislib.Cmder) error {
+		h.prefixKeys(cmd)
+		err := next(ctx, cmd)
+		h.stripKeys(cmd)
+		return err
+	}
+}
+
+func (h keyPrefixHook) ProcessPipelineHook(next redislib.ProcessPipelineHook) redislib.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redislib.Cmder) error {
+		for _, cmd := range cmds {
+			h.prefixKeys(cmd)
+		}
+		err := next(ctx, cmds)
+		for _, cmd := range cmds {
+			h.stripKeys(cmd)
+		}
+		return err
+	}
+}
+
+// prefixKeys rewrites the key arguments of cmd in place
+func (h keyPrefixHook) prefixKeys(cmd redislib.Cmder) {
+	args := cmd.Args()
+	name := cmd.Name()
+	if len(args) < 2 || unkeyedCommands[name] {
+		return
+	}
+
+	switch name {
+	case "del", "unlink", "exists", "touch", "mget", "watch", "sinter", "sunion", "sdiff",
+		"sinterstore", "sunionstore", "sdiffstore", "pfcount", "pfmerge":
+		h.prefixRange(args, 1, len(args), 1)
+	case "mset", "msetnx":
+		h.prefixRange(args, 1, len(args), 2)
+	case "blpop", "brpop", "bzpopmin", "bzpopmax":
+		// the last argument is the timeout
+		h.prefixRange(args, 1, len(args)-1, 1)
+	case "rename", "renamenx", "smove", "rpoplpush", "brpoplpush", "lmove", "blmove", "copy":
+		h.prefixRange(args, 1, 3, 1)
+	case "eval", "evalsha", "eval_ro", "evalsha_ro", "fcall", "fcall_ro":
+		h.prefixCounted(args, 2)
+	case "zunionstore", "zinterstore", "zdiffstore":
+		h.prefixRange(args, 1, 2, 1)
+		h.prefixCounted(args, 2)
+	case "xgroup", "xinfo", "object", "memory":
+		// subcommand first, then the key
+		h.prefixRange(args, 2, 3, 1)
+	case "xread", "xreadgroup":
+		for i := 1; i < len(args); i++ {
+			if strings.EqualFold(argString(args[i]), "streams") {
+				// the streams, then as many ids
+				streams := (len(args) - i - 1) / 2
+				h.prefixRange(args, i+1, i+1+streams, 1)
+				break
+			}
+		}
+	case "scan":
+		// Without MATCH a scan walks every tenant's keys; nothing here scans that way
+		for i := 2; i+1 < len(args); i++ {
+			if strings.EqualFold(argString(args[i]), "match") {
+				h.prefixRange(args, i+1, i+2, 1)
+				break
+			}
+		}
+	default:
+		// Every other command, PUBLISH and KEYS included, names its key or channel first
+		h.prefixRange(args, 1, 2, 1)
+	}
+}
+
+// prefixCounted prefixes the keys that follow the key count at args[at]
+func (h keyPrefixHook) prefixCounted(args []interface{}, at int) {
+	if at >= len(args) {
+		return
+	}
+	n, err := strconv.Atoi(argString(args[at]))
+	if err != nil || n <= 0 {
+		return
+	}
+	h.prefixRange(args, at+1, min(at+1+n, len(args)), 1)
+}
+
+func (h keyPrefixHook) prefixRange(args []interface{}, from, to, step int) {
+	for i := from; i < to && i < len(args); i += step {
+		key := argString(args[i])
+		// A command processed twice keeps a single prefix
+		if !strings.HasPrefix(key, h.prefix) {
+			args[i] = h.prefix + key
+		}
+	}
+}
+
+// stripKeys takes the prefix off the key names KEYS and SCAN return
+func (h keyPrefixHook) stripKeys(cmd redislib.Cmder) {
+	switch c := cmd.(type) {
+	case *redislib.StringSliceCmd:
+		if c.Name() == "keys" {
+			c.SetVal(h.strip(c.Val()))
+		}
+	case *redislib.ScanCmd:
+		if c.Name() == "scan" {
+			page, cursor := c.Val()
+			c.SetVal(h.strip(page), cursor)
+		}
+	}
+}
+
+func (h keyPrefixHook) strip(keys []string) []string {
+	for i, key := range keys {
+		keys[i] = strings.TrimPrefix(key, h.prefix)
+	}
+	return keys
+}
+
+func argString(arg interface{}) string {
+	switch v := arg.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	default:
+		return ""
+	}
+}
+
+// channel is the name a pub/sub channel is subscribed and published under
+func (r *Redis) channel(name string) string {
+	return r.prefix + name
+}
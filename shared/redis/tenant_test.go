@@ -0,0 +1,248 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	redislib "github.com/redis/go-redis/v9"
+)
+
+const testPrefix = "tenant:pr1:"
+
+// captureHook answers commands instead of sending them, recording the arguments that
+// would have gone out. KEYS and SCAN answer with prefixed names, as Redis would.
+type captureHook struct {
+	sent *[][]string
+}
+
+func (h captureHook) DialHook(next redislib.DialHook) redislib.DialHook {
+	return next
+}
+
+func (h captureHook) ProcessHook(next redislib.ProcessHook) redislib.ProcessHook {
+	return func(ctx context.Context, cmd redislib.Cmder) error {
+		h.answer(cmd)
+		return nil
+	}
+}
+
+func (h captureHook) ProcessPipelineHook(next redislib.ProcessPipelineHook) redislib.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redislib.Cmder) error {
+		for _, cmd := range cmds {
+			h.answer(cmd)
+		}
+		return nil
+	}
+}
+
+func (h captureHook) answer(cmd redislib.Cmder) {
+	args := make([]string, len(cmd.Args()))
+	for i, arg := range cmd.Args() {
+		args[i] = fmt.Sprint(arg)
+	}
+	*h.sent = append(*h.sent, args)
+
+	switch c := cmd.(type) {
+	case *redislib.StringSliceCmd:
+		c.SetVal([]string{testPrefix + "session:a", testPrefix + "session:b"})
+	case *redislib.ScanCmd:
+		c.SetVal([]string{testPrefix + "session:a"}, 0)
+	}
+}
+
+// newCaptureClient returns a client prefixing keys for tenant pr1 that never hits the network
+func newCaptureClient(t *testing.T) (*redislib.Client, *[][]string) {
+	t.Helper()
+	sent := &[][]string{}
+	client := redislib.NewClient(&redislib.Options{Addr: "127.0.0.1:0"})
+	t.Cleanup(func() { _ = client.Close() })
+	// Hooks run in the order added: prefixing happens before the capture sees the command
+	client.AddHook(keyPrefixHook{prefix: testPrefix})
+	client.AddHook(captureHook{sent: sent})
+	return client, sent
+}
+
+func TestKeyPrefixHook_PrefixesKeyArguments(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		send func(c *redislib.Client)
+		want []string
+	}{
+		{
+			name: "GETDEL",
+			send: func(c *redislib.Client) { c.GetDel(ctx, "nonce:abc") },
+			want: []string{"getdel", testPrefix + "nonce:abc"},
+		},
+		{
+			name: "SETNX",
+			send: func(c *redislib.Client) { c.SetNX(ctx, "lock:job", "1", 0) },
+			want: []string{"setnx", testPrefix + "lock:job", "1"},
+		},
+		{
+			name: "EVAL prefixes numkeys keys only",
+			send: func(c *redislib.Client) { c.Eval(ctx, "return 1", []string{"a", "b"}, "arg") },
+			want: []string{"eval", "return 1", "2", testPrefix + "a", testPrefix + "b", "arg"},
+		},
+		{
+			name: "EVALSHA",
+			send: func(c *redislib.Client) { c.EvalSha(ctx, "f00d", []string{"a"}, "b") },
+			want: []string{"evalsha", "f00d", "1", testPrefix + "a", "b"},
+		},
+		{
+			name: "EVAL without keys",
+			send: func(c *redislib.Client) { c.Eval(ctx, "return 1", nil, "a") },
+			want: []string{"eval", "return 1", "0", "a"},
+		},
+		{
+			name: "XREADGROUP prefixes streams, not ids",
+			send: func(c *redislib.Client) {
+				c.XReadGroup(ctx, &redislib.XReadGroupArgs{
+					Group:    "workers",
+					Consumer: "w1",
+					Streams:  []string{"s1", "s2", ">", ">"},
+					Count:    10,
+					Block:    time.Second,
+				})
+			},
+			want: []string{"xreadgroup", "group", "workers", "w1", "count", "10", "block", "1000",
+				"streams", testPrefix + "s1", testPrefix + "s2", ">", ">"},
+		},
+		{
+			name: "XREAD",
+			send: func(c *redislib.Client) { c.XRead(ctx, &redislib.XReadArgs{Streams: []string{"s1", "0"}, Block: -1}) },
+			want: []string{"xread", "streams", testPrefix + "s1", "0"},
+		},
+		{
+			name: "XGROUP CREATE prefixes the stream after the subcommand",
+			send: func(c *redislib.Client) { c.XGroupCreateMkStream(ctx, "s1", "workers", "$") },
+			want: []string{"xgroup", "create", testPrefix + "s1", "workers", "$", "mkstream"},
+		},
+		{
+			name: "MGET",
+			send: func(c *redislib.Client) { c.MGet(ctx, "a", "b") },
+			want: []string{"mget", testPrefix + "a", testPrefix + "b"},
+		},
+		{
+			name: "DEL",
+			send: func(c *redislib.Client) { c.Del(ctx, "a", "b", "c") },
+			want: []string{"del", testPrefix + "a", testPrefix + "b", testPrefix + "c"},
+		},
+		{
+			name: "MSET prefixes keys, not values",
+			send: func(c *redislib.Client) { c.MSet(ctx, "a", "1", "b", "2") },
+			want: []string{"mset", testPrefix + "a", "1", testPrefix + "b", "2"},
+		},
+		{
+			name: "SCAN MATCH",
+			send: func(c *redislib.Client) { c.Scan(ctx, 0, "session:*", 100) },
+			want: []string{"scan", "0", "match", testPrefix + "session:*", "count", "100"},
+		},
+		{
+			name: "KEYS",
+			send: func(c *redislib.Client) { c.Keys(ctx, "session:*") },
+			want: []string{"keys", testPrefix + "session:*"},
+		},
+		{
+			name: "PUBLISH",
+			send: func(c *redislib.Client) { c.Publish(ctx, "events", "payload") },
+			want: []string{"publish", testPrefix + "events", "payload"},
+		},
+		{
+			name: "already prefixed key",
+			send: func(c *redislib.Client) { c.Get(ctx, testPrefix+"a") },
+			want: []string{"get", testPrefix + "a"},
+		},
+		{
+			name: "unkeyed command",
+			send: func(c *redislib.Client) { c.Echo(ctx, "hello") },
+			want: []string{"echo", "hello"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, sent := newCaptureClient(t)
+			tt.send(client)
+			if len(*sent) != 1 {
+				t.Fatalf("expected one command, got %v", *sent)
+			}
+			if got := (*sent)[0]; !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got  %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyPrefixHook_StripsReturnedKeys(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newCaptureClient(t)
+
+	keys, err := client.Keys(ctx, "session:*").Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keys, []string{"session:a", "session:b"}) {
+		t.Fatalf("KEYS returned %q", keys)
+	}
+
+	page, cursor, err := client.Scan(ctx, 0, "session:*", 10).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(page, []string{"session:a"}) || cursor != 0 {
+		t.Fatalf("SCAN returned %q at cursor %d", page, cursor)
+	}
+}
+
+func TestKeyPrefixHook_Pipelines(t *testing.T) {
+	ctx := context.Background()
+	client, sent := newCaptureClient(t)
+
+	pipe := client.Pipeline()
+	pipe.SetNX(ctx, "lock", "1", 0)
+	pipe.Eval(ctx, "return 1", []string{"a"})
+	keys := pipe.Keys(ctx, "session:*")
+	if _, err := pipe.Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]string{
+		{"setnx", testPrefix + "lock", "1"},
+		{"eval", "return 1", "1", testPrefix + "a"},
+		{"keys", testPrefix + "session:*"},
+	}
+	if !reflect.DeepEqual(*sent, want) {
+		t.Fatalf("got  %q\nwant %q", *sent, want)
+	}
+	if !reflect.DeepEqual(keys.Val(), []string{"session:a", "session:b"}) {
+		t.Fatalf("pipelined KEYS returned %q", keys.Val())
+	}
+}
+
+func TestNewRedis_Tenant(t *testing.T) {
+	if _, err := NewRedis(RedisConfig{RedisHost: "localhost", RedisPort: 6379, Tenant: "PR-1"}); err == nil {
+		t.Fatal("expected an invalid tenant to be refused")
+	}
+
+	r, err := NewRedis(RedisConfig{RedisHost: "localhost", RedisPort: 6379, Tenant: "pr1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if got := r.channel("intent:status"); got != testPrefix+"intent:status" {
+		t.Fatalf("channel = %q", got)
+	}
+
+	plain, err := NewRedis(RedisConfig{RedisHost: "localhost", RedisPort: 6379})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plain.Close()
+	if got := plain.channel("intent:status"); got != "intent:status" {
+		t.Fatalf("channel without a tenant = %q", got)
+	}
+}
@@ -0,0 +1,49 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/contracts"
+)
+
+// PublishUploadProgress announces an upload progress report. Reports are fire-and-forget:
+// nobody may be following the ticket, and a missed one is superseded by the next.
+func (r *Redis) PublishUploadProgress(ctx context.Context, progress contracts.UploadProgress) error {
+	payload, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("failed to encode upload progress: %w", err)
+	}
+	if err := r.conn.Publish(ctx, contracts.UploadProgressChannel, payload).Err(); err != nil {
+		return fmt.Errorf("failed to publish upload progress: %w", err)
+	}
+	return nil
+}
+
+// SubscribeUploadProgress calls handler with every upload progress report until ctx is done
+func (r *Redis) SubscribeUploadProgress(ctx context.Context, handler func(contracts.UploadProgress)) error {
+	sub := r.conn.Subscribe(ctx, r.channel(contracts.UploadProgressChannel))
+	defer sub.Close()
+
+	if _, err := sub.Receive(ctx); err != nil {
+		return fmt.Errorf("failed to subscribe to upload progress: %w", err)
+	}
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var progress contracts.UploadProgress
+			if err := json.Unmarshal([]byte(msg.Payload), &progress); err != nil || progress.Ticket == "" {
+				continue
+			}
+			handler(progress)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Package tenant names the slice of shared infrastructure a deployment works in, so that
+short-lived preview deployments can share one Postgres, Redis, RabbitMQ and MongoDB
+without seeing each other's data. The tenant comes from TENANT_ID; when it is unset every
+name falls back to the default one and nothing changes.
+
+A tenant gets its own Postgres schema, a Redis key prefix, a RabbitMQ vhost and a MongoDB
+database; tools/tenant creates and drops them.
+*/
+package tenant
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/env"
+)
+
+// EnvKey is the environment variable holding the tenant
+const EnvKey = "TENANT_ID"
+
+// idPattern keeps tenants usable unquoted as schema, vhost and database names
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_]{0,31}$`)
+
+// FromEnv returns the tenant set in TENANT_ID, or "" for none
+func FromEnv() string {
+	return strings.ToLower(strings.TrimSpace(env.GetString(EnvKey, "")))
+}
+
+// Validate checks a tenant is 1-32 lowercase letters, digits or underscores. No tenant
+// is valid.
+func Validate(id string) error {
+	if id != "" && !idPattern.MatchString(id) {
+		return fmt.Errorf("invalid tenant %q: use 1-32 lowercase letters, digits or underscores", id)
+	}
+	return nil
+}
+
+// Schema is the Postgres schema holding the tenant's tables
+func Schema(id string) string {
+	if id == "" {
+		return "public"
+	}
+	return "tenant_" + id
+}
+
+// KeyPrefix is put in front of every Redis key and channel of the tenant
+func KeyPrefix(id string) string {
+	if id == "" {
+		return ""
+	}
+	return "tenant:" + id + ":"
+}
+
+// VHost is the RabbitMQ vhost carrying the tenant's exchanges and queues
+func VHost(id string) string {
+	if id == "" {
+		return "/"
+	}
+	return "tenant_" + id
+}
+
+// Database is the MongoDB database standing in for base in the tenant
+func Database(base, id string) string {
+	if id == "" {
+		return base
+	}
+	return base + "_" + id
+}
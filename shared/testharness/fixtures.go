@@ -0,0 +1,64 @@
+package testharness
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spruceid/siwe-go"
+)
+
+// SiweAccount is a throwaway Ethereum key that signs Sign-In with Ethereum messages
+type SiweAccount struct {
+	key *ecdsa.PrivateKey
+}
+
+// NewSiweAccount generates a fresh account
+func NewSiweAccount(t testing.TB) *SiweAccount {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return &SiweAccount{key: key}
+}
+
+// Address is the checksummed account address
+func (a *SiweAccount) Address() string {
+	return crypto.PubkeyToAddress(a.key.PublicKey).Hex()
+}
+
+// AccountID is the lowercase address the services key accounts by
+func (a *SiweAccount) AccountID() string {
+	return strings.ToLower(a.Address())
+}
+
+// SignIn builds a SIWE message for the nonce and returns it with its EIP-191 signature
+func (a *SiweAccount) SignIn(t testing.TB, domain, nonce string, chainID int) (message, signature string) {
+	t.Helper()
+
+	msg, err := siwe.InitMessage(domain, a.Address(), fmt.Sprintf("https://%s", domain), nonce, map[string]interface{}{
+		"chainId":        chainID,
+		"issuedAt":       time.Now().UTC().Format(time.RFC3339),
+		"expirationTime": time.Now().UTC().Add(5 * time.Minute).Format(time.RFC3339),
+		"statement":      "Sign in to the marketplace",
+	})
+	if err != nil {
+		t.Fatalf("build siwe message: %v", err)
+	}
+
+	message = msg.String()
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	sig, err := crypto.Sign(hash, a.key)
+	if err != nil {
+		t.Fatalf("sign siwe message: %v", err)
+	}
+	sig[64] += 27
+
+	return message, hexutil.Encode(sig)
+}
@@ -0,0 +1,244 @@
+/*
+Package testharness starts the infrastructure the services depend on in throwaway Docker
+containers so integration tests run against real Postgres, Redis, RabbitMQ and MongoDB.
+
+Tests that use it are skipped with -short or when no Docker daemon is reachable:
+
+	h := testharness.New(t)
+	pg := h.Postgres(t, testharness.Migration("user-service"))
+*/
+package testharness
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/mongo"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+const (
+	postgresImage = "postgres"
+	postgresTag   = "15-alpine"
+	redisImage    = "redis"
+	redisTag      = "7-alpine"
+	rabbitImage   = "rabbitmq"
+	rabbitTag     = "3-alpine"
+	mongoImage    = "mongo"
+	mongoTag      = "6"
+
+	// containerTTL lets Docker reap containers a crashed test run left behind
+	containerTTL = 10 * time.Minute
+	readyTimeout = 2 * time.Minute
+)
+
+// Harness owns the containers started for a test
+type Harness struct {
+	pool *dockertest.Pool
+}
+
+var (
+	poolOnce sync.Once
+	pool     *dockertest.Pool
+	poolErr  error
+)
+
+// New returns a harness, skipping the test in -short mode or when Docker is unavailable
+func New(t testing.TB) *Harness {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	poolOnce.Do(func() {
+		pool, poolErr = dockertest.NewPool("")
+		if poolErr == nil {
+			poolErr = pool.Client.Ping()
+		}
+		if pool != nil {
+			pool.MaxWait = readyTimeout
+		}
+	})
+	if poolErr != nil {
+		t.Skipf("Skipping integration test, docker unavailable: %v", poolErr)
+	}
+
+	return &Harness{pool: pool}
+}
+
+// Postgres starts Postgres, applies migrations in order and returns a connected client
+func (h *Harness) Postgres(t testing.TB, migrations ...string) (*postgres.Postgres, postgres.PostgresConfig) {
+	t.Helper()
+
+	cfg := postgres.PostgresConfig{
+		PostgresUser:     "postgres",
+		PostgresPassword: "postgres",
+		PostgresDatabase: "nft_marketplace",
+	}
+	resource := h.run(t, &dockertest.RunOptions{
+		Repository: postgresImage,
+		Tag:        postgresTag,
+		Env: []string{
+			"POSTGRES_USER=" + cfg.PostgresUser,
+			"POSTGRES_PASSWORD=" + cfg.PostgresPassword,
+			"POSTGRES_DB=" + cfg.PostgresDatabase,
+		},
+	})
+	cfg.PostgresHost, cfg.PostgresPort = hostPort(t, resource, "5432/tcp")
+
+	client, err := postgres.NewPostgres(cfg)
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	h.retry(t, "postgres", func() error { return client.Ping(context.Background()) })
+
+	for _, path := range migrations {
+		if err := applySQL(client.GetClient(), path); err != nil {
+			t.Fatalf("apply migration %s: %v", path, err)
+		}
+	}
+
+	return client, cfg
+}
+
+// Redis starts Redis and returns a connected client
+func (h *Harness) Redis(t testing.TB) (*redis.Redis, redis.RedisConfig) {
+	t.Helper()
+
+	resource := h.run(t, &dockertest.RunOptions{Repository: redisImage, Tag: redisTag})
+	var cfg redis.RedisConfig
+	cfg.RedisHost, cfg.RedisPort = hostPort(t, resource, "6379/tcp")
+
+	client, err := redis.NewRedis(cfg)
+	if err != nil {
+		t.Fatalf("open redis: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	h.retry(t, "redis", func() error { return client.HealthCheck(context.Background()) })
+	return client, cfg
+}
+
+// RabbitMQ starts RabbitMQ and returns a connected client
+func (h *Harness) RabbitMQ(t testing.TB) (*messaging.RabbitMQ, messaging.RabbitMQConfig) {
+	t.Helper()
+
+	cfg := messaging.RabbitMQConfig{RabbitMQUser: "guest", RabbitMQPassword: "guest"}
+	resource := h.run(t, &dockertest.RunOptions{Repository: rabbitImage, Tag: rabbitTag})
+	cfg.RabbitMQHost, cfg.RabbitMQPort = hostPort(t, resource, "5672/tcp")
+
+	var client *messaging.RabbitMQ
+	h.retry(t, "rabbitmq", func() error {
+		var err error
+		client, err = messaging.NewRabbitMQ(cfg)
+		return err
+	})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client, cfg
+}
+
+// Mongo starts MongoDB and returns a connected client
+func (h *Harness) Mongo(t testing.TB) (*mongo.MongoDB, mongo.MongoConfig) {
+	t.Helper()
+
+	resource := h.run(t, &dockertest.RunOptions{Repository: mongoImage, Tag: mongoTag})
+	host, port := hostPort(t, resource, "27017/tcp")
+	cfg := mongo.MongoConfig{
+		MongoURI:      fmt.Sprintf("mongodb://%s:%d", host, port),
+		MongoDatabase: "nft_marketplace",
+	}
+
+	var client *mongo.MongoDB
+	h.retry(t, "mongo", func() error {
+		var err error
+		client, err = mongo.NewMongo(cfg)
+		return err
+	})
+	t.Cleanup(func() { _ = client.Close(context.Background()) })
+
+	return client, cfg
+}
+
+func (h *Harness) run(t testing.TB, opts *dockertest.RunOptions) *dockertest.Resource {
+	t.Helper()
+
+	resource, err := h.pool.RunWithOptions(opts, func(hc *docker.HostConfig) {
+		hc.AutoRemove = true
+		hc.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+	if err != nil {
+		t.Fatalf("start %s:%s: %v", opts.Repository, opts.Tag, err)
+	}
+	_ = resource.Expire(uint(containerTTL.Seconds()))
+	t.Cleanup(func() { _ = h.pool.Purge(resource) })
+
+	return resource
+}
+
+func (h *Harness) retry(t testing.TB, name string, fn func() error) {
+	t.Helper()
+	if err := h.pool.Retry(fn); err != nil {
+		t.Fatalf("%s not ready: %v", name, err)
+	}
+}
+
+func hostPort(t testing.TB, resource *dockertest.Resource, port string) (string, int) {
+	t.Helper()
+
+	n, err := strconv.Atoi(resource.GetPort(port))
+	if err != nil {
+		t.Fatalf("container port %s: %v", port, err)
+	}
+	host := os.Getenv("DOCKER_HOST_IP")
+	if host == "" {
+		host = "localhost"
+	}
+	return host, n
+}
+
+func applySQL(db *sql.DB, path string) error {
+	script, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(string(script))
+	return err
+}
+
+// Migration is the up.sql of a service, e.g. Migration("wallet-service")
+func Migration(service string) string {
+	return filepath.Join(RepoRoot(), "services", service, "db", "up.sql")
+}
+
+// RepoRoot is the directory holding go.mod
+func RepoRoot() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "."
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "."
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,112 @@
+package testharness
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+// Env is the environment a service binary runs with
+type Env map[string]string
+
+// InfraEnv points a service at the harness containers
+func InfraEnv(pg postgres.PostgresConfig, rds redis.RedisConfig, rmq messaging.RabbitMQConfig) Env {
+	return Env{
+		"POSTGRES_HOST":     pg.PostgresHost,
+		"POSTGRES_PORT":     fmt.Sprint(pg.PostgresPort),
+		"POSTGRES_USER":     pg.PostgresUser,
+		"POSTGRES_PASSWORD": pg.PostgresPassword,
+		"POSTGRES_DATABASE": pg.PostgresDatabase,
+		"REDIS_HOST":        rds.RedisHost,
+		"REDIS_PORT":        fmt.Sprint(rds.RedisPort),
+		"RABBITMQ_HOST":     rmq.RabbitMQHost,
+		"RABBITMQ_PORT":     fmt.Sprint(rmq.RabbitMQPort),
+		"RABBITMQ_USER":     rmq.RabbitMQUser,
+		"RABBITMQ_PASSWORD": rmq.RabbitMQPassword,
+	}
+}
+
+// With returns a copy of env with extra variables set
+func (e Env) With(key, value string) Env {
+	out := make(Env, len(e)+1)
+	for k, v := range e {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
+
+// FreeAddr reserves a loopback port for a service to listen on
+func FreeAddr(t testing.TB) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("reserve port: %v", err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+// StartService builds services/<service>/cmd and runs it until the test ends. addr is
+// the address the service listens on, set through env; it returns once addr accepts
+// connections.
+func StartService(t testing.TB, service, addr string, env Env) {
+	t.Helper()
+
+	bin := filepath.Join(t.TempDir(), service)
+	build := exec.Command("go", "build", "-o", bin, "./services/"+service+"/cmd")
+	build.Dir = RepoRoot()
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("build %s: %v\n%s", service, err, out)
+	}
+
+	cmd := exec.Command(bin)
+	cmd.Env = os.Environ()
+	for k, v := range env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	logFile, err := os.Create(filepath.Join(t.TempDir(), service+".log"))
+	if err != nil {
+		t.Fatalf("create %s log: %v", service, err)
+	}
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("start %s: %v", service, err)
+	}
+
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		<-exited
+		_ = logFile.Close()
+	})
+
+	deadline := time.Now().Add(readyTimeout)
+	for {
+		select {
+		case err := <-exited:
+			logs, _ := os.ReadFile(logFile.Name())
+			t.Fatalf("%s exited during startup: %v\n%s", service, err, logs)
+		default:
+		}
+		if conn, err := net.DialTimeout("tcp", addr, time.Second); err == nil {
+			conn.Close()
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s did not listen on %s", service, addr)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,240 @@
+// Package webhook delivers signed event callbacks to creators' own backends. Each payload
+// is signed with HMAC-SHA256 over "<timestamp>.<body>", sent in the Zuno-Signature header
+// as "t=<unix seconds>,v1=<hex>", so receivers can check both origin and freshness.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quangdang46/NFT-Marketplace/shared/retry"
+)
+
+// Headers set on every delivery
+const (
+	SignatureHeader = "Zuno-Signature"
+	EventHeader     = "Zuno-Event"
+	DeliveryHeader  = "Zuno-Delivery"
+)
+
+// MaxURLLength bounds a callback URL
+const MaxURLLength = 2048
+
+// Event types
+const (
+	EventIntentConfirmed = "intent.confirmed"
+)
+
+// ErrBlockedAddress is returned when a callback host resolves to a private, loopback or
+// otherwise internal address
+var ErrBlockedAddress = errors.New("webhook host resolves to a blocked address")
+
+// ValidateURL checks a callback URL creators register: absolute HTTPS without
+// credentials, on a public host
+func ValidateURL(raw string) error {
+	if len(raw) > MaxURLLength {
+		return fmt.Errorf("callback URL too long (max %d characters)", MaxURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid callback URL")
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("callback URL must use https")
+	}
+	if u.User != nil {
+		return fmt.Errorf("callback URL must not carry credentials")
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("callback URL needs a host")
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return fmt.Errorf("callback URL must be on a public host")
+	}
+	if ip := net.ParseIP(host); ip != nil && blockedIP(ip) {
+		return fmt.Errorf("callback URL must be on a public host")
+	}
+	return nil
+}
+
+// blockedIP reports addresses callbacks may never reach
+func blockedIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast()
+}
+
+// IntentSecret derives the secret an intent's callbacks are signed with. The creator
+// receives it when preparing the intent; deliverers derive it again from the same master
+// secret, so it is never stored.
+func IntentSecret(master []byte, intentID string) string {
+	mac := hmac.New(sha256.New, master)
+	mac.Write([]byte("intent:" + intentID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign returns the Zuno-Signature header value for body sent at timestamp
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Event is the JSON body of a delivery
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// NewEvent creates an event with a fresh id
+func NewEvent(eventType string, data any) Event {
+	return Event{ID: uuid.New().String(), Type: eventType, CreatedAt: time.Now().UTC(), Data: data}
+}
+
+// IntentConfirmed is the data of an intent.confirmed event
+type IntentConfirmed struct {
+	IntentID        string `json:"intent_id"`
+	Kind            string `json:"kind"`
+	ChainID         string `json:"chain_id"`
+	TxHash          string `json:"tx_hash,omitempty"`
+	ContractAddress string `json:"contract_address,omitempty"`
+}
+
+// Config tunes deliveries
+type Config struct {
+	// Timeout bounds one attempt, connection included
+	Timeout time.Duration
+	Retry   retry.Config
+}
+
+// DefaultConfig tries a delivery four times over about a minute
+func DefaultConfig() Config {
+	return Config{
+		Timeout: 10 * time.Second,
+		Retry: retry.Config{
+			MaxRetries:  3,
+			InitialWait: 5 * time.Second,
+			MaxWait:     30 * time.Second,
+		},
+	}
+}
+
+// Deliverer posts signed events. Its client refuses redirects and connections to
+// internal addresses, since callback hosts are chosen by users.
+type Deliverer struct {
+	client *http.Client
+	retry  retry.Config
+}
+
+// NewDeliverer creates a deliverer
+func NewDeliverer(cfg Config) *Deliverer {
+	dialer := &net.Dialer{
+		Timeout: cfg.Timeout,
+		// Checked on the resolved address, so DNS can't point a public name inside
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || blockedIP(ip) {
+				return ErrBlockedAddress
+			}
+			return nil
+		},
+	}
+	return &Deliverer{
+		client: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: cfg.Timeout},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		retry: cfg.Retry,
+	}
+}
+
+// permanentError stops retries, e.g. when the receiver rejected the delivery
+type permanentError struct{ err error }
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Deliver posts event to callbackURL signed with secret, retrying network errors, 429s
+// and 5xx responses with backoff. Any 2xx acknowledges the delivery; other responses and
+// blocked hosts fail it at once.
+func (d *Deliverer) Deliver(ctx context.Context, callbackURL, secret string, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal webhook event: %w", err)
+	}
+
+	var rejected *permanentError
+	err = retry.WithBackoff(ctx, d.retry, func() error {
+		err := d.post(ctx, callbackURL, secret, event, body)
+		if errors.As(err, &rejected) {
+			return nil
+		}
+		return err
+	})
+	if rejected != nil {
+		return fmt.Errorf("deliver %s webhook %s: %w", event.Type, event.ID, rejected)
+	}
+	if err != nil {
+		return fmt.Errorf("deliver %s webhook %s: %w", event.Type, event.ID, err)
+	}
+	return nil
+}
+
+func (d *Deliverer) post(ctx context.Context, callbackURL, secret string, event Event, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return &permanentError{err: fmt.Errorf("build webhook request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Zuno-Webhooks/1")
+	req.Header.Set(EventHeader, event.Type)
+	req.Header.Set(DeliveryHeader, event.ID)
+	// Signed per attempt so retries carry a fresh timestamp
+	req.Header.Set(SignatureHeader, Sign(secret, time.Now(), body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		// The host won't move out of the blocked range between attempts
+		if errors.Is(err, ErrBlockedAddress) {
+			return &permanentError{err: err}
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("webhook answered %d", resp.StatusCode)
+	default:
+		return &permanentError{err: fmt.Errorf("webhook rejected with status %d", resp.StatusCode)}
+	}
+}
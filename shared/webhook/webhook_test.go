@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/retry"
+)
+
+const testSecret = "test-secret"
+
+func testConfig() Config {
+	return Config{
+		Timeout: 2 * time.Second,
+		Retry:   retry.Config{MaxRetries: 2, InitialWait: time.Millisecond, MaxWait: time.Millisecond},
+	}
+}
+
+// newTestDeliverer targets srv, which listens on loopback, so it swaps in the server's
+// transport; the redirect policy and everything above the dial stay as in production
+func newTestDeliverer(srv *httptest.Server) *Deliverer {
+	d := NewDeliverer(testConfig())
+	d.client.Transport = srv.Client().Transport
+	return d
+}
+
+func TestDeliver_SignsBody(t *testing.T) {
+	var (
+		body    []byte
+		headers http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		headers = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	event := NewEvent(EventIntentConfirmed, IntentConfirmed{IntentID: "intent-1", Kind: "mint", ChainID: "eip155:1"})
+	if err := newTestDeliverer(srv).Deliver(context.Background(), srv.URL, testSecret, event); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+
+	if headers.Get(EventHeader) != EventIntentConfirmed || headers.Get(DeliveryHeader) != event.ID {
+		t.Fatalf("unexpected event headers: %v", headers)
+	}
+	var got Event
+	if err := json.Unmarshal(body, &got); err != nil || got.ID != event.ID {
+		t.Fatalf("unexpected body %s: %v", body, err)
+	}
+
+	// Receivers recompute the signature over the timestamp they were sent and the raw body
+	signature := headers.Get(SignatureHeader)
+	parts := strings.SplitN(signature, ",", 2)
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "t=") {
+		t.Fatalf("malformed signature %q", signature)
+	}
+	ts, err := strconv.ParseInt(strings.TrimPrefix(parts[0], "t="), 10, 64)
+	if err != nil {
+		t.Fatalf("malformed timestamp in %q", signature)
+	}
+	if want := Sign(testSecret, time.Unix(ts, 0), body); signature != want {
+		t.Fatalf("signature %q, want %q", signature, want)
+	}
+	if Sign("other-secret", time.Unix(ts, 0), body) == signature {
+		t.Fatal("signature does not depend on the secret")
+	}
+}
+
+func TestDeliver_RetriesTransientStatuses(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) < 3 {
+					w.WriteHeader(status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			event := NewEvent(EventIntentConfirmed, nil)
+			if err := newTestDeliverer(srv).Deliver(context.Background(), srv.URL, testSecret, event); err != nil {
+				t.Fatalf("Deliver: %v", err)
+			}
+			if got := attempts.Load(); got != 3 {
+				t.Fatalf("expected 3 attempts, got %d", got)
+			}
+		})
+	}
+}
+
+func TestDeliver_GivesUpAfterRetries(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	err := newTestDeliverer(srv).Deliver(context.Background(), srv.URL, testSecret, NewEvent(EventIntentConfirmed, nil))
+	if err == nil || !strings.Contains(err.Error(), "webhook answered 502") {
+		t.Fatalf("expected the last 502, got %v", err)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestDeliver_ClientErrorsFailAtOnce(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound, http.StatusGone} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(status)
+			}))
+			defer srv.Close()
+
+			err := newTestDeliverer(srv).Deliver(context.Background(), srv.URL, testSecret, NewEvent(EventIntentConfirmed, nil))
+			if err == nil || !strings.Contains(err.Error(), "webhook rejected with status") {
+				t.Fatalf("expected a rejection, got %v", err)
+			}
+			if got := attempts.Load(); got != 1 {
+				t.Fatalf("expected 1 attempt, got %d", got)
+			}
+		})
+	}
+}
+
+func TestDeliver_RefusesRedirects(t *testing.T) {
+	var followed atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			followed.Store(true)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+
+	err := newTestDeliverer(srv).Deliver(context.Background(), srv.URL+"/hook", testSecret, NewEvent(EventIntentConfirmed, nil))
+	if err == nil || !strings.Contains(err.Error(), "status 307") {
+		t.Fatalf("expected the redirect to fail the delivery, got %v", err)
+	}
+	if followed.Load() {
+		t.Fatal("redirect was followed")
+	}
+}
+
+func TestDeliver_BlocksInternalAddresses(t *testing.T) {
+	var reached atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached.Store(true)
+	}))
+	defer srv.Close()
+
+	// The production dialer, with a wait long enough that a retry would hit the deadline
+	cfg := testConfig()
+	cfg.Retry.InitialWait = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := NewDeliverer(cfg).Deliver(ctx, srv.URL, testSecret, NewEvent(EventIntentConfirmed, nil))
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("expected ErrBlockedAddress, got %v", err)
+	}
+	if reached.Load() {
+		t.Fatal("loopback server was reached")
+	}
+}
+
+func TestDeliver_StopsWithContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	d := newTestDeliverer(srv)
+	d.retry.InitialWait = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := d.Deliver(ctx, srv.URL, testSecret, NewEvent(EventIntentConfirmed, nil))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline, got %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spruceid/siwe-go"
+
+	protoAuth "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+	protoWallet "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+// account is a seeded user: the key it signs in with and the vault wallet linked to it
+type account struct {
+	key    *ecdsa.PrivateKey
+	vault  string
+	userID string
+}
+
+// deriveKey turns the seed and a label into a private key, so every run signs in the same users
+func deriveKey(seed, label string) (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(crypto.Keccak256([]byte(seed + ":" + label)))
+}
+
+// deriveAddress turns the seed and a label into a lowercase address nobody holds the key of
+func deriveAddress(seed, label string) string {
+	return strings.ToLower(common.BytesToAddress(crypto.Keccak256([]byte(seed + ":" + label))[12:]).Hex())
+}
+
+// deriveHash turns the seed and a label into a transaction hash
+func deriveHash(seed, label string) string {
+	return hexutil.Encode(crypto.Keccak256([]byte(seed + ":" + label)))
+}
+
+// address is the lowercase address the services key accounts by
+func (a *account) address() string {
+	return strings.ToLower(crypto.PubkeyToAddress(a.key.PublicKey).Hex())
+}
+
+// seedAccounts signs each user in over SIWE, which creates the user and its primary wallet
+// link, then links a vault wallet next to it
+func (s *seeder) seedAccounts(ctx context.Context) ([]*account, error) {
+	accounts := make([]*account, 0, s.opts.users)
+	for i := 0; i < s.opts.users; i++ {
+		key, err := deriveKey(s.opts.seed, fmt.Sprintf("user:%d", i))
+		if err != nil {
+			return nil, fmt.Errorf("derive key of user %d: %w", i, err)
+		}
+		acct := &account{key: key, vault: deriveAddress(s.opts.seed, fmt.Sprintf("vault:%d", i))}
+
+		if acct.userID, err = s.signIn(ctx, acct); err != nil {
+			return nil, fmt.Errorf("sign in user %d: %w", i, err)
+		}
+		if _, err := s.wallet.UpsertLink(ctx, &protoWallet.UpsertLinkRequest{
+			UserId:    acct.userID,
+			AccountId: acct.vault,
+			Address:   acct.vault,
+			ChainId:   s.opts.chainID,
+			Type:      "eoa",
+			Connector: "devseed",
+			Label:     "Vault",
+		}); err != nil {
+			return nil, fmt.Errorf("link vault wallet of user %d: %w", i, err)
+		}
+
+		accounts = append(accounts, acct)
+		fmt.Printf("user %s  wallet %s  vault %s\n", acct.userID, acct.address(), acct.vault)
+	}
+	return accounts, nil
+}
+
+// signIn runs the SIWE flow the gateway runs for a browser wallet and returns the user ID
+func (s *seeder) signIn(ctx context.Context, acct *account) (string, error) {
+	nonce, err := s.auth.GetNonce(ctx, &protoAuth.GetNonceRequest{
+		AccountId: acct.address(),
+		ChainId:   s.opts.chainID,
+		Domain:    s.opts.domain,
+	})
+	if err != nil {
+		return "", fmt.Errorf("get nonce: %w", err)
+	}
+
+	chainID, err := strconv.Atoi(strings.TrimPrefix(s.opts.chainID, "eip155:"))
+	if err != nil {
+		return "", fmt.Errorf("parse chain %s: %w", s.opts.chainID, err)
+	}
+	now := time.Now().UTC()
+	msg, err := siwe.InitMessage(s.opts.domain, crypto.PubkeyToAddress(acct.key.PublicKey).Hex(), "http://"+s.opts.domain, nonce.GetNonce(), map[string]interface{}{
+		"chainId":        chainID,
+		"issuedAt":       now.Format(time.RFC3339),
+		"expirationTime": now.Add(5 * time.Minute).Format(time.RFC3339),
+		"statement":      "Sign in to the marketplace",
+	})
+	if err != nil {
+		return "", fmt.Errorf("build siwe message: %w", err)
+	}
+
+	message := msg.String()
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	sig, err := crypto.Sign(hash, acct.key)
+	if err != nil {
+		return "", fmt.Errorf("sign siwe message: %w", err)
+	}
+	sig[64] += 27
+
+	resp, err := s.auth.VerifySiwe(ctx, &protoAuth.VerifySiweRequest{
+		AccountId: acct.address(),
+		Message:   message,
+		Signature: hexutil.Encode(sig),
+		UserAgent: "devseed",
+	})
+	if err != nil {
+		return "", fmt.Errorf("verify siwe: %w", err)
+	}
+	return resp.GetUserId(), nil
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+)
+
+const (
+	// collectionsExchange is where the indexer publishes what it reads off chain
+	collectionsExchange = "collections.events"
+	eventSchemaV1       = "marketplace.events.v1"
+	// firstBlock is the block the synthetic history starts at
+	firstBlock = 1000
+)
+
+// indexerEvent is the envelope the indexer publishes events in
+type indexerEvent struct {
+	Schema    string                 `json:"schema"`
+	Version   string                 `json:"version"`
+	EventID   string                 `json:"event_id"`
+	EventType string                 `json:"event_type"`
+	ChainID   string                 `json:"chain_id"`
+	TxHash    string                 `json:"tx_hash"`
+	Contract  string                 `json:"contract"`
+	Data      map[string]interface{} `json:"data"`
+	Timestamp time.Time              `json:"timestamp"`
+}
+
+// publishEvent publishes an event under <prefix>.<chain> the way the indexer does. Event IDs
+// derive from the transaction hash and log index, so the catalog skips events a previous run
+// already delivered.
+func (s *seeder) publishEvent(ctx context.Context, prefix, eventType, contract, txHash string, logIndex int, data map[string]interface{}) error {
+	evt := indexerEvent{
+		Schema:    eventSchemaV1,
+		Version:   "1.0",
+		EventID:   fmt.Sprintf("%s_%s_%d", s.opts.chainID, txHash, logIndex),
+		EventType: eventType,
+		ChainID:   s.opts.chainID,
+		TxHash:    txHash,
+		Contract:  contract,
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("marshal %s event: %w", eventType, err)
+	}
+
+	message := &messaging.Message{
+		Exchange:   collectionsExchange,
+		RoutingKey: prefix + "." + s.opts.chainID,
+		Body:       body,
+		Headers: map[string]interface{}{
+			"event_type":   evt.EventType,
+			"chain_id":     evt.ChainID,
+			"schema":       evt.Schema,
+			"version":      evt.Version,
+			"published_at": evt.Timestamp.Unix(),
+			"content_type": "application/json",
+		},
+		Timestamp: evt.Timestamp,
+		MessageID: evt.EventID,
+	}
+	if err := s.amqp.Publish(ctx, message.ToAMQPMessage()); err != nil {
+		return fmt.Errorf("publish %s event: %w", eventType, err)
+	}
+	return nil
+}
+
+// publishCollectionCreated announces the collection as the indexer does once the factory's
+// CollectionCreated log is confirmed
+func (s *seeder) publishCollectionCreated(ctx context.Context, c *collectionFixture, block int) error {
+	data := map[string]interface{}{
+		"collection_address":     c.address,
+		"creator":                c.owner.address(),
+		"name":                   c.name,
+		"symbol":                 c.symbol,
+		"collection_type":        c.kind,
+		"description":            fmt.Sprintf("%s is seed data for local development.", c.name),
+		"max_supply":             strconv.Itoa(s.opts.tokens * 10),
+		"total_supply":           strconv.Itoa(s.opts.tokens),
+		"royalty_recipient":      c.owner.address(),
+		"royalty_percentage":     500,
+		"block_number":           strconv.Itoa(block),
+		"tx_hash":                c.txHash,
+		"log_index":              0,
+		"confirmations":          12,
+		"required_confirmations": 12,
+	}
+	if c.imageURL != "" {
+		data["image_url"] = c.imageURL
+	}
+	return s.publishEvent(ctx, "collections.events.created", "collection_created", c.address, c.txHash, 0, data)
+}
+
+// publishMint announces the k-th token's mint as a decoded Transfer (ERC-721) or
+// TransferSingle (ERC-1155) from the zero address
+func (s *seeder) publishMint(ctx context.Context, c *collectionFixture, k int, owner string, block int, at time.Time) error {
+	txHash := deriveHash(s.opts.seed, fmt.Sprintf("mint:%s:%d", c.address, k))
+	args := map[string]interface{}{
+		"from": zeroAddress,
+		"to":   owner,
+	}
+	eventName, eventType := "Transfer", "transfer"
+	if c.kind == "ERC1155" {
+		eventName, eventType = "TransferSingle", "transfer_single"
+		args["operator"] = owner
+		args["id"] = strconv.Itoa(k)
+		args["value"] = strconv.Itoa(mintedAmount(c, k))
+	} else {
+		args["tokenId"] = strconv.Itoa(k)
+	}
+
+	return s.publishEvent(ctx, "collections.events.decoded", eventType, c.address, txHash, 0, map[string]interface{}{
+		"event_name":   eventName,
+		"args":         args,
+		"block_number": strconv.Itoa(block),
+		"tx_hash":      txHash,
+		"log_index":    0,
+		"occurred_at":  at.UTC().Format(time.RFC3339),
+	})
+}
+
+// mintedAmount is how many of the k-th token were minted; always one for ERC-721
+func mintedAmount(c *collectionFixture, k int) int {
+	if c.kind == "ERC1155" {
+		return 1 + k%5
+	}
+	return 1
+}
+
+// waitForCollection polls the catalog until it has indexed the collection and returns its ID
+func (s *seeder) waitForCollection(ctx context.Context, c *collectionFixture) (string, error) {
+	deadline := time.Now().Add(s.opts.wait)
+	for {
+		var id string
+		err := s.catalogDB.GetClient().QueryRowContext(ctx,
+			`SELECT id FROM collections WHERE chain_id = $1 AND lower(contract_address) = $2`,
+			s.opts.chainID, c.address).Scan(&id)
+		if err == nil {
+			return id, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("look up %s: %w", c.name, err)
+		}
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("catalog did not index %s within %s; is catalog-service consuming %s?", c.name, s.opts.wait, collectionsExchange)
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// upsertToken writes the k-th token's row. No service writes token rows yet, so the catalog
+// has nothing to list without them.
+func (s *seeder) upsertToken(ctx context.Context, collectionID string, c *collectionFixture, k int, owner string, block int, at time.Time) error {
+	id := uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s:%s:%s:%d", s.opts.seed, s.opts.chainID, c.address, k)))
+	_, err := s.catalogDB.GetClient().ExecContext(ctx, `
+		INSERT INTO tokens (id, collection_id, chain_id, family, contract_address, token_number, token_standard,
+			supply, burned, name, image_url, owner_address, minted_block, minted_at)
+		VALUES ($1, $2, $3, 'evm', $4, $5, $6, $7, false, $8, NULLIF($9, ''), $10, $11, $12)
+		ON CONFLICT (collection_id, token_number) DO UPDATE SET
+			name = EXCLUDED.name,
+			image_url = EXCLUDED.image_url,
+			owner_address = EXCLUDED.owner_address,
+			supply = EXCLUDED.supply`,
+		id, collectionID, s.opts.chainID, c.address, strconv.Itoa(k), c.kind,
+		mintedAmount(c, k), fmt.Sprintf("%s #%d", c.name, k), c.imageURL, owner, block, at)
+	if err != nil {
+		return fmt.Errorf("upsert %s #%d: %w", c.name, k, err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	protoMedia "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const imageSize = 256
+
+var (
+	adjectives = []string{"Neon", "Quiet", "Cosmic", "Paper", "Velvet", "Broken", "Golden", "Pixel", "Lunar", "Feral"}
+	nouns      = []string{"Foxes", "Gardens", "Machines", "Tides", "Masks", "Orbits", "Monsters", "Lanterns", "Ruins", "Whales"}
+)
+
+// collectionFixture describes one seeded collection
+type collectionFixture struct {
+	owner    *account
+	name     string
+	symbol   string
+	kind     string // ERC721 or ERC1155
+	address  string
+	txHash   string
+	imageURL string
+}
+
+// newCollectionFixture names the j-th collection of the i-th user; names repeat past 100
+// collections, addresses do not
+func (s *seeder) newCollectionFixture(owner *account, i, j int) *collectionFixture {
+	n := i*s.opts.collections + j
+	name := fmt.Sprintf("%s %s", adjectives[n%len(adjectives)], nouns[(n/len(adjectives))%len(nouns)])
+	kind := "ERC721"
+	if n%3 == 2 {
+		kind = "ERC1155"
+	}
+	label := fmt.Sprintf("collection:%d:%d", i, j)
+	return &collectionFixture{
+		owner:   owner,
+		name:    name,
+		symbol:  fmt.Sprintf("%c%c%d", name[0], nouns[(n/len(adjectives))%len(nouns)][0], n),
+		kind:    kind,
+		address: deriveAddress(s.opts.seed, label),
+		txHash:  deriveHash(s.opts.seed, label),
+	}
+}
+
+// uploadImage pins a generated cover image for the collection and returns its asset ID
+func (s *seeder) uploadImage(ctx context.Context, c *collectionFixture) (string, error) {
+	data, err := coverImage(c.address)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.media.UploadSingleFile(ctx, &protoMedia.SingleUploadRequest{
+		FileData: data,
+		Filename: c.symbol + ".png",
+		Mime:     "image/png",
+		Kind:     protoMedia.MediaKind_IMAGE,
+		Width:    wrapperspb.UInt32(imageSize),
+		Height:   wrapperspb.UInt32(imageSize),
+		OwnerId:  c.owner.userID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("upload image of %s: %w", c.name, err)
+	}
+
+	asset := resp.GetAsset()
+	switch {
+	case asset.GetGatewayUrl().GetValue() != "":
+		c.imageURL = asset.GetGatewayUrl().GetValue()
+	case asset.GetIpfsCid().GetValue() != "":
+		c.imageURL = "ipfs://" + asset.GetIpfsCid().GetValue()
+	}
+	return asset.GetId(), nil
+}
+
+// coverImage draws a two-colour gradient picked from the collection address, so each
+// collection gets its own image and reruns upload the same bytes
+func coverImage(address string) ([]byte, error) {
+	h := crypto.Keccak256([]byte(address))
+	from := color.RGBA{h[0], h[1], h[2], 0xff}
+	to := color.RGBA{h[3], h[4], h[5], 0xff}
+
+	img := image.NewRGBA(image.Rect(0, 0, imageSize, imageSize))
+	for y := 0; y < imageSize; y++ {
+		for x := 0; x < imageSize; x++ {
+			t := (x + y) * 255 / (2 * (imageSize - 1))
+			img.Set(x, y, color.RGBA{
+				R: mix(from.R, to.R, t),
+				G: mix(from.G, to.G, t),
+				B: mix(from.B, to.B, t),
+				A: 0xff,
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encode cover image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func mix(a, b uint8, t int) uint8 {
+	return uint8((int(a)*(255-t) + int(b)*t) / 255)
+}
@@ -0,0 +1,187 @@
+// Command devseed fills a local stack with realistic data: users signed in over SIWE with
+// linked wallets, media fixtures, collection intents, and the indexer events that bring
+// collections, mints and ownership into the catalog. It talks to the services over gRPC and
+// RabbitMQ like the gateway and the indexer do; only token rows are written to the catalog
+// database, as no service writes them yet.
+//
+// Accounts, addresses and event IDs derive from -seed, so running it again updates the same
+// users and collections instead of adding new ones. Intents are the exception: each run
+// prepares a fresh create-collection intent per collection.
+//
+//	make seed
+//	go run ./tools/devseed -users 10 -collections 3 -tokens 20
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/env"
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	protoAuth "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+	protoMedia "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+	protoOrchestrator "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	protoWallet "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
+)
+
+type options struct {
+	seed        string
+	users       int
+	collections int
+	tokens      int
+	chainID     string
+	domain      string
+	media       bool
+	intents     bool
+	wait        time.Duration
+
+	authURL         string
+	walletURL       string
+	mediaURL        string
+	orchestratorURL string
+	postgres        postgres.PostgresConfig
+	rabbitMQ        messaging.RabbitMQConfig
+}
+
+func main() {
+	opts := parseOptions()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	s, err := newSeeder(opts)
+	if err != nil {
+		log.Fatalf("devseed: %v", err)
+	}
+	defer s.close()
+
+	if err := s.run(ctx); err != nil {
+		log.Fatalf("devseed: %v", err)
+	}
+}
+
+func parseOptions() options {
+	opts := options{
+		authURL:         env.GetString("AUTH_SERVICE_URL", "localhost:50051"),
+		walletURL:       env.GetString("WALLET_SERVICE_URL", "localhost:50053"),
+		mediaURL:        env.GetString("MEDIA_SERVICE_URL", "localhost:50055"),
+		orchestratorURL: env.GetString("ORCHESTRATOR_SERVICE_URL", "localhost:50054"),
+		postgres: postgres.PostgresConfig{
+			PostgresHost:     env.GetString("POSTGRES_HOST", "localhost"),
+			PostgresPort:     env.GetInt("POSTGRES_PORT", 5432),
+			PostgresUser:     env.GetString("POSTGRES_USER", "postgres"),
+			PostgresPassword: env.GetString("POSTGRES_PASSWORD", "postgres"),
+			PostgresDatabase: env.GetString("POSTGRES_DATABASE", "nft_marketplace"),
+			PostgresSSLMode:  env.GetString("POSTGRES_SSL_MODE", "disable"),
+			Tenant:           tenant.FromEnv(),
+		},
+		rabbitMQ: messaging.RabbitMQConfig{
+			RabbitMQHost:     env.GetString("RABBITMQ_HOST", "localhost"),
+			RabbitMQPort:     env.GetInt("RABBITMQ_PORT", 5672),
+			RabbitMQUser:     env.GetString("RABBITMQ_USER", "guest"),
+			RabbitMQPassword: env.GetString("RABBITMQ_PASSWORD", "guest"),
+			Tenant:           tenant.FromEnv(),
+		},
+	}
+
+	flag.StringVar(&opts.seed, "seed", "devseed", "derives every account, address and event ID; change it for a second data set")
+	flag.IntVar(&opts.users, "users", 5, "users to sign in, each with a second linked wallet")
+	flag.IntVar(&opts.collections, "collections", 2, "collections per user")
+	flag.IntVar(&opts.tokens, "tokens", 8, "tokens minted per collection")
+	flag.StringVar(&opts.chainID, "chain", "eip155:31337", "CAIP-2 chain the data is seeded on")
+	flag.StringVar(&opts.domain, "domain", "localhost:3000", "SIWE domain users sign in to")
+	flag.BoolVar(&opts.media, "media", true, "upload collection images to media-service; off leaves collections without images")
+	flag.BoolVar(&opts.intents, "intents", true, "prepare a create-collection intent per collection in orchestrator-service")
+	flag.DurationVar(&opts.wait, "wait", 30*time.Second, "how long to wait for the catalog to index each seeded collection")
+	flag.Parse()
+
+	if opts.users < 1 || opts.collections < 0 || opts.tokens < 0 {
+		fmt.Fprintln(os.Stderr, "-users must be positive, -collections and -tokens not negative")
+		os.Exit(2)
+	}
+	if !strings.HasPrefix(opts.chainID, "eip155:") {
+		fmt.Fprintln(os.Stderr, "-chain must be an eip155 CAIP-2 chain ID, e.g. eip155:31337")
+		os.Exit(2)
+	}
+	return opts
+}
+
+// seeder holds the clients of the services it seeds
+type seeder struct {
+	opts options
+
+	conns        []*grpc.ClientConn
+	auth         protoAuth.AuthServiceClient
+	wallet       protoWallet.WalletServiceClient
+	media        protoMedia.MediaServiceClient
+	orchestrator protoOrchestrator.OrchestratorServiceClient
+	amqp         *messaging.RabbitMQ
+	catalogDB    *postgres.Postgres
+}
+
+func newSeeder(opts options) (*seeder, error) {
+	s := &seeder{opts: opts}
+
+	dial := func(name, url string) (*grpc.ClientConn, error) {
+		conn, err := grpc.Dial(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("dial %s at %s: %w", name, url, err)
+		}
+		s.conns = append(s.conns, conn)
+		return conn, nil
+	}
+
+	conn, err := dial("auth-service", opts.authURL)
+	if err != nil {
+		return nil, err
+	}
+	s.auth = protoAuth.NewAuthServiceClient(conn)
+	if conn, err = dial("wallet-service", opts.walletURL); err != nil {
+		return nil, err
+	}
+	s.wallet = protoWallet.NewWalletServiceClient(conn)
+	if opts.media {
+		if conn, err = dial("media-service", opts.mediaURL); err != nil {
+			return nil, err
+		}
+		s.media = protoMedia.NewMediaServiceClient(conn)
+	}
+	if opts.intents {
+		if conn, err = dial("orchestrator-service", opts.orchestratorURL); err != nil {
+			return nil, err
+		}
+		s.orchestrator = protoOrchestrator.NewOrchestratorServiceClient(conn)
+	}
+
+	if s.amqp, err = messaging.NewRabbitMQ(opts.rabbitMQ); err != nil {
+		s.close()
+		return nil, fmt.Errorf("connect rabbitmq: %w", err)
+	}
+	if s.catalogDB, err = postgres.NewPostgres(opts.postgres); err != nil {
+		s.close()
+		return nil, fmt.Errorf("connect postgres: %w", err)
+	}
+	return s, nil
+}
+
+func (s *seeder) close() {
+	for _, conn := range s.conns {
+		_ = conn.Close()
+	}
+	if s.amqp != nil {
+		_ = s.amqp.Close()
+	}
+	if s.catalogDB != nil {
+		_ = s.catalogDB.Close()
+	}
+}
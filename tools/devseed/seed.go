@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/messaging"
+	protoOrchestrator "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+)
+
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+// run seeds users first, as collections are created and tokens minted by their wallets
+func (s *seeder) run(ctx context.Context) error {
+	if err := s.amqp.DeclareExchange(messaging.ExchangeConfig{Name: collectionsExchange, Type: "topic", Durable: true}); err != nil {
+		return fmt.Errorf("declare %s exchange: %w", collectionsExchange, err)
+	}
+
+	accounts, err := s.seedAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Each collection gets a block range of its own, minted over the past days
+	block := firstBlock
+	var collections, tokens int
+	for i, owner := range accounts {
+		for j := 0; j < s.opts.collections; j++ {
+			c := s.newCollectionFixture(owner, i, j)
+			if err := s.seedCollection(ctx, c, accounts, block); err != nil {
+				return err
+			}
+			block += s.opts.tokens + 1
+			collections++
+			tokens += s.opts.tokens
+		}
+	}
+
+	fmt.Printf("seeded %d users, %d collections and %d tokens on %s\n", len(accounts), collections, tokens, s.opts.chainID)
+	return nil
+}
+
+// seedCollection uploads the collection's image, prepares its intent, announces its
+// deployment and mints its tokens, handing them round the users so every wallet holds some
+func (s *seeder) seedCollection(ctx context.Context, c *collectionFixture, holders []*account, block int) error {
+	var assetIDs []string
+	if s.media != nil {
+		assetID, err := s.uploadImage(ctx, c)
+		if err != nil {
+			return err
+		}
+		assetIDs = append(assetIDs, assetID)
+	}
+	if s.orchestrator != nil {
+		if err := s.prepareIntent(ctx, c, assetIDs); err != nil {
+			return err
+		}
+	}
+
+	if err := s.publishCollectionCreated(ctx, c, block); err != nil {
+		return err
+	}
+	collectionID, err := s.waitForCollection(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now().Add(-time.Duration(s.opts.tokens) * time.Hour)
+	for k := 1; k <= s.opts.tokens; k++ {
+		owner := holders[k%len(holders)].address()
+		mintBlock := block + k
+		at := start.Add(time.Duration(k) * time.Hour)
+		if err := s.publishMint(ctx, c, k, owner, mintBlock, at); err != nil {
+			return err
+		}
+		if err := s.upsertToken(ctx, collectionID, c, k, owner, mintBlock, at); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("collection %s (%s, %s) at %s with %d tokens\n", c.name, c.symbol, c.kind, c.address, s.opts.tokens)
+	return nil
+}
+
+// prepareIntent prepares the collection's create intent and tracks the seeded deployment
+// transaction against it, so the catalog links the two. A rerun's intent stays pending, as
+// the transaction is tracked by the first run's intent already.
+func (s *seeder) prepareIntent(ctx context.Context, c *collectionFixture, assetIDs []string) error {
+	resp, err := s.orchestrator.PrepareCreateCollection(ctx, &protoOrchestrator.PrepareCreateCollectionRequest{
+		ChainId:            s.opts.chainID,
+		Name:               c.name,
+		Symbol:             c.symbol,
+		Creator:            c.owner.address(),
+		Description:        fmt.Sprintf("%s is seed data for local development.", c.name),
+		RoyaltyFee:         500,
+		MaxSupply:          uint64(s.opts.tokens * 10),
+		MintLimitPerWallet: 5,
+		Type:               c.kind,
+		AssetIds:           assetIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("prepare create intent of %s: %w", c.name, err)
+	}
+
+	_, err = s.orchestrator.TrackTx(ctx, &protoOrchestrator.TrackTxRequest{
+		IntentId: resp.GetIntentId(),
+		ChainId:  s.opts.chainID,
+		TxHash:   c.txHash,
+		Contract: c.address,
+	})
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		return fmt.Errorf("track deployment of %s: %w", c.name, err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Command protocheck verifies the generated proto code against the API locks in /proto, or
+// with -update rewrites the locks after regenerating. Run by go generate ./shared/proto; run
+// it with -update -allow-breaking to accept a breaking change on purpose.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	protoAuth "github.com/quangdang46/NFT-Marketplace/shared/proto/auth"
+	protoCatalog "github.com/quangdang46/NFT-Marketplace/shared/proto/catalog"
+	protoChainRegistry "github.com/quangdang46/NFT-Marketplace/shared/proto/chainregistry"
+	protoMedia "github.com/quangdang46/NFT-Marketplace/shared/proto/media"
+	protoOrchestrator "github.com/quangdang46/NFT-Marketplace/shared/proto/orchestrator"
+	"github.com/quangdang46/NFT-Marketplace/shared/proto/protocheck"
+	protoUser "github.com/quangdang46/NFT-Marketplace/shared/proto/user"
+	protoWallet "github.com/quangdang46/NFT-Marketplace/shared/proto/wallet"
+)
+
+var files = []protoreflect.FileDescriptor{
+	protoAuth.File_auth_proto,
+	protoCatalog.File_catalog_proto,
+	protoChainRegistry.File_chain_registry_proto,
+	protoMedia.File_media_proto,
+	protoOrchestrator.File_orchestrator_proto,
+	protoUser.File_user_proto,
+	protoWallet.File_wallet_proto,
+}
+
+func main() {
+	protoDir := flag.String("proto", "proto", "directory holding the .proto sources and their locks")
+	update := flag.Bool("update", false, "rewrite the locks from the generated code")
+	allowBreaking := flag.Bool("allow-breaking", false, "with -update, accept breaking changes")
+	flag.Parse()
+
+	failed := false
+	for _, fd := range files {
+		var err error
+		if *update {
+			err = protocheck.Update(*protoDir, fd, *allowBreaking)
+		} else {
+			err = protocheck.Verify(*protoDir, fd)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,213 @@
+// Command tenant creates and drops the isolated slices of shared infrastructure that
+// preview deployments run in (see shared/tenant). A tenant is created with an expiry;
+// prune drops every tenant past it, so a scheduled prune keeps previews time-boxed.
+//
+//	go run ./tools/tenant -ttl 72h create pr123   # schema with every service's tables, vhost
+//	go run ./tools/tenant drop pr123              # schema, Redis keys, vhost, Mongo databases
+//	go run ./tools/tenant prune                   # drop every expired tenant
+//
+// Services then run against it with TENANT_ID=pr123. It reads the same POSTGRES_*,
+// REDIS_*, RABBITMQ_* and MONGO_URI variables as the services, plus
+// RABBITMQ_MANAGEMENT_URL for the vhost.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/env"
+	"github.com/quangdang46/NFT-Marketplace/shared/mongo"
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
+)
+
+// migrations are applied in the order docker-compose applies them to the shared schema
+var migrations = []string{
+	"auth-service",
+	"user-service",
+	"wallet-service",
+	"chain-registry-service",
+	"orchestrator-service",
+	"catalog-service",
+	"indexer-service",
+}
+
+type options struct {
+	ttl            time.Duration
+	mongoDatabases []string
+
+	postgres      postgres.PostgresConfig
+	redis         redis.RedisConfig
+	mongoURI      string
+	rabbitMQ      rabbitMQManagement
+	skipRabbitMQ  bool
+	skipMongo     bool
+	migrationsDir string
+}
+
+func main() {
+	opts := options{
+		postgres: postgres.PostgresConfig{
+			PostgresHost:     env.GetString("POSTGRES_HOST", "localhost"),
+			PostgresPort:     env.GetInt("POSTGRES_PORT", 5432),
+			PostgresUser:     env.GetString("POSTGRES_USER", "postgres"),
+			PostgresPassword: env.GetString("POSTGRES_PASSWORD", "postgres"),
+			PostgresDatabase: env.GetString("POSTGRES_DATABASE", "nft_marketplace"),
+			PostgresSSLMode:  env.GetString("POSTGRES_SSL_MODE", "disable"),
+		},
+		redis: redis.RedisConfig{
+			RedisHost:     env.GetString("REDIS_HOST", "localhost"),
+			RedisPort:     env.GetInt("REDIS_PORT", 6379),
+			RedisPassword: env.GetString("REDIS_PASSWORD", ""),
+			RedisDB:       env.GetInt("REDIS_DB", 0),
+		},
+		mongoURI: env.GetString("MONGO_URI", "mongodb://localhost:27017"),
+		rabbitMQ: rabbitMQManagement{
+			URL:      env.GetString("RABBITMQ_MANAGEMENT_URL", "http://localhost:15672"),
+			User:     env.GetString("RABBITMQ_USER", "guest"),
+			Password: env.GetString("RABBITMQ_PASSWORD", "guest"),
+		},
+	}
+
+	flag.DurationVar(&opts.ttl, "ttl", 72*time.Hour, "how long a created tenant lives before prune drops it")
+	mongoDatabases := flag.String("mongo-databases", "indexer,nft_marketplace", "comma-separated MongoDB databases the services use, dropped per tenant")
+	flag.BoolVar(&opts.skipRabbitMQ, "skip-rabbitmq", false, "leave the RabbitMQ vhost alone")
+	flag.BoolVar(&opts.skipMongo, "skip-mongo", false, "leave the MongoDB databases alone")
+	flag.StringVar(&opts.migrationsDir, "services", "services", "directory holding each service's db/up.sql")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: tenant [flags] create|drop <tenant>, or tenant [flags] prune")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	opts.mongoDatabases = splitList(*mongoDatabases)
+
+	action, id := flag.Arg(0), flag.Arg(1)
+	if id == "" {
+		id = tenant.FromEnv()
+	}
+	if action != "prune" && id == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := tenant.Validate(id); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	var err error
+	switch action {
+	case "create":
+		err = create(ctx, opts, id)
+	case "drop":
+		err = drop(ctx, opts, id)
+	case "prune":
+		err = prune(ctx, opts)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("tenant: %v", err)
+	}
+}
+
+// create makes the tenant's schema with every service's tables and its vhost. Redis and
+// MongoDB need nothing up front. Creating an existing tenant re-applies the migrations and
+// extends its expiry.
+func create(ctx context.Context, opts options, id string) error {
+	pg, err := postgres.NewPostgres(opts.postgres)
+	if err != nil {
+		return err
+	}
+	defer pg.Close()
+
+	expiresAt := time.Now().Add(opts.ttl).UTC()
+	if err := createSchema(ctx, pg, opts.migrationsDir, id, expiresAt); err != nil {
+		return err
+	}
+	if !opts.skipRabbitMQ {
+		if err := opts.rabbitMQ.createVHost(ctx, tenant.VHost(id)); err != nil {
+			return err
+		}
+	}
+	log.Printf("tenant %s created, expires %s", id, expiresAt.Format(time.RFC3339))
+	return nil
+}
+
+// drop removes everything the tenant stored
+func drop(ctx context.Context, opts options, id string) error {
+	pg, err := postgres.NewPostgres(opts.postgres)
+	if err != nil {
+		return err
+	}
+	defer pg.Close()
+	return dropTenant(ctx, opts, pg, id)
+}
+
+// prune drops every tenant whose expiry has passed
+func prune(ctx context.Context, opts options) error {
+	pg, err := postgres.NewPostgres(opts.postgres)
+	if err != nil {
+		return err
+	}
+	defer pg.Close()
+
+	expired, err := expiredTenants(ctx, pg, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, id := range expired {
+		if err := dropTenant(ctx, opts, pg, id); err != nil {
+			return err
+		}
+	}
+	log.Printf("pruned %d expired tenants", len(expired))
+	return nil
+}
+
+func dropTenant(ctx context.Context, opts options, pg *postgres.Postgres, id string) error {
+	if err := deleteKeys(ctx, opts.redis, tenant.KeyPrefix(id)); err != nil {
+		return err
+	}
+	if !opts.skipRabbitMQ {
+		if err := opts.rabbitMQ.deleteVHost(ctx, tenant.VHost(id)); err != nil {
+			return err
+		}
+	}
+	if !opts.skipMongo {
+		if err := dropMongoDatabases(ctx, opts.mongoURI, opts.mongoDatabases, id); err != nil {
+			return err
+		}
+	}
+	// The schema goes last: it holds the expiry prune finds the tenant by
+	if err := dropSchema(ctx, pg, id); err != nil {
+		return err
+	}
+	log.Printf("tenant %s dropped", id)
+	return nil
+}
+
+// dropMongoDatabases drops the tenant's copy of each database
+func dropMongoDatabases(ctx context.Context, uri string, databases []string, id string) error {
+	client, err := mongo.NewMongo(mongo.MongoConfig{MongoURI: uri})
+	if err != nil {
+		return err
+	}
+	defer client.Close(ctx)
+
+	for _, base := range databases {
+		name := tenant.Database(base, id)
+		if err := client.GetClient().Database(name).Drop(ctx); err != nil {
+			return fmt.Errorf("drop mongo database %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+	"github.com/quangdang46/NFT-Marketplace/shared/tenant"
+)
+
+// expiryComment is the schema comment holding a tenant's expiry
+const expiryComment = "expires_at="
+
+// createSchema creates the tenant's schema and applies every migration inside it. The
+// session keeps public on its search path so extensions installed there stay usable.
+func createSchema(ctx context.Context, pg *postgres.Postgres, dir, id string, expiresAt time.Time) error {
+	schema := tenant.Schema(id)
+	conn, err := pg.GetClient().Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("connect to postgres: %w", err)
+	}
+	defer conn.Close()
+
+	// Tenants are validated to bare identifiers, so they are safe to splice in
+	statements := []string{
+		`CREATE SCHEMA IF NOT EXISTS ` + schema,
+		`COMMENT ON SCHEMA ` + schema + ` IS '` + expiryComment + expiresAt.Format(time.RFC3339) + `'`,
+		`SET search_path TO ` + schema + `, public`,
+	}
+	for _, stmt := range statements {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("prepare schema %s: %w", schema, err)
+		}
+	}
+	for _, service := range migrations {
+		script, err := os.ReadFile(filepath.Join(dir, service, "db", "up.sql"))
+		if err != nil {
+			return fmt.Errorf("read %s migration: %w", service, err)
+		}
+		if _, err := conn.ExecContext(ctx, string(script)); err != nil {
+			return fmt.Errorf("apply %s migration to %s: %w", service, schema, err)
+		}
+	}
+	return nil
+}
+
+func dropSchema(ctx context.Context, pg *postgres.Postgres, id string) error {
+	if _, err := pg.GetClient().ExecContext(ctx, `DROP SCHEMA IF EXISTS `+tenant.Schema(id)+` CASCADE`); err != nil {
+		return fmt.Errorf("drop schema %s: %w", tenant.Schema(id), err)
+	}
+	return nil
+}
+
+// expiredTenants lists the tenants whose schema expiry is before now. Schemas without an
+// expiry were not made by this tool and are left alone.
+func expiredTenants(ctx context.Context, pg *postgres.Postgres, now time.Time) ([]string, error) {
+	rows, err := pg.GetClient().QueryContext(ctx, `
+SELECT nspname, COALESCE(obj_description(oid, 'pg_namespace'), '')
+FROM pg_namespace
+WHERE nspname LIKE 'tenant\_%'`)
+	if err != nil {
+		return nil, fmt.Errorf("list tenant schemas: %w", err)
+	}
+	defer rows.Close()
+
+	var expired []string
+	for rows.Next() {
+		var schema, comment string
+		if err := rows.Scan(&schema, &comment); err != nil {
+			return nil, fmt.Errorf("list tenant schemas: %w", err)
+		}
+		raw, ok := strings.CutPrefix(comment, expiryComment)
+		if !ok {
+			continue
+		}
+		expiresAt, err := time.Parse(time.RFC3339, raw)
+		if err != nil || expiresAt.After(now) {
+			continue
+		}
+		expired = append(expired, strings.TrimPrefix(schema, "tenant_"))
+	}
+	return expired, rows.Err()
+}
+
+// deleteKeys removes every Redis key under prefix. The client carries no tenant, so it
+// sees the prefixed names.
+func deleteKeys(ctx context.Context, cfg redis.RedisConfig, prefix string) error {
+	client, err := redis.NewRedis(cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	iter := client.GetClient().Scan(ctx, 0, prefix+"*", 500).Iterator()
+	var batch []string
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := client.GetClient().Unlink(ctx, batch...).Err()
+		batch = batch[:0]
+		return err
+	}
+	for iter.Next(ctx) {
+		batch = append(batch, iter.Val())
+		if len(batch) == 500 {
+			if err := flush(); err != nil {
+				return fmt.Errorf("delete redis keys %s*: %w", prefix, err)
+			}
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("scan redis keys %s*: %w", prefix, err)
+	}
+	if err := flush(); err != nil {
+		return fmt.Errorf("delete redis keys %s*: %w", prefix, err)
+	}
+	return nil
+}
+
+// rabbitMQManagement manages vhosts over the RabbitMQ management API, since AMQP can't
+type rabbitMQManagement struct {
+	URL      string
+	User     string
+	Password string
+}
+
+// createVHost creates the vhost and lets the user do everything in it
+func (m rabbitMQManagement) createVHost(ctx context.Context, vhost string) error {
+	if err := m.do(ctx, http.MethodPut, "/api/vhosts/"+url.PathEscape(vhost), ""); err != nil {
+		return fmt.Errorf("create vhost %s: %w", vhost, err)
+	}
+	path := "/api/permissions/" + url.PathEscape(vhost) + "/" + url.PathEscape(m.User)
+	if err := m.do(ctx, http.MethodPut, path, `{"configure":".*","write":".*","read":".*"}`); err != nil {
+		return fmt.Errorf("grant vhost %s to %s: %w", vhost, m.User, err)
+	}
+	return nil
+}
+
+// deleteVHost deletes the vhost with its exchanges and queues; a missing vhost is fine
+func (m rabbitMQManagement) deleteVHost(ctx context.Context, vhost string) error {
+	if err := m.do(ctx, http.MethodDelete, "/api/vhosts/"+url.PathEscape(vhost), ""); err != nil {
+		return fmt.Errorf("delete vhost %s: %w", vhost, err)
+	}
+	return nil
+}
+
+func (m rabbitMQManagement) do(ctx context.Context, method, path, body string) error {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(m.URL, "/")+path, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(m.User, m.Password)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 && !(method == http.MethodDelete && resp.StatusCode == http.StatusNotFound) {
+		return fmt.Errorf("management API answered %s", resp.Status)
+	}
+	return nil
+}
+
+// splitList splits a comma-separated flag, dropping empty entries
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/quangdang46/NFT-Marketplace/shared/postgres"
+	"github.com/quangdang46/NFT-Marketplace/shared/redis"
+)
+
+func TestCreateSchema_AppliesMigrationsInTenantSchema(t *testing.T) {
+	dir := t.TempDir()
+	for _, service := range migrations {
+		path := filepath.Join(dir, service, "db", "up.sql")
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("CREATE TABLE "+strings.ReplaceAll(service, "-", "_")+" (id int)"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	expiresAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mock.ExpectExec(`CREATE SCHEMA IF NOT EXISTS tenant_pr123`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`COMMENT ON SCHEMA tenant_pr123 IS 'expires_at=2026-01-02T03:04:05Z'`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`SET search_path TO tenant_pr123, public`).WillReturnResult(sqlmock.NewResult(0, 0))
+	for _, service := range migrations {
+		mock.ExpectExec("CREATE TABLE " + strings.ReplaceAll(service, "-", "_") + " (id int)").WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	if err := createSchema(context.Background(), postgres.NewPostgresWithDB(db), dir, "pr123", expiresAt); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExpiredTenants(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM pg_namespace`)).WillReturnRows(sqlmock.NewRows([]string{"nspname", "comment"}).
+		AddRow("tenant_old", "expires_at=2026-05-31T00:00:00Z").
+		AddRow("tenant_live", "expires_at=2026-06-02T00:00:00Z").
+		AddRow("tenant_manual", "").
+		AddRow("tenant_garbled", "expires_at=tomorrow"))
+
+	expired, err := expiredTenants(context.Background(), postgres.NewPostgresWithDB(db), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expired, []string{"old"}) {
+		t.Fatalf("expected only the expired tenant, got %v", expired)
+	}
+}
+
+func TestRabbitMQManagement_VHosts(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	deleteStatus := http.StatusNotFound
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, fmt.Sprintf("%s %s %s:%s %s", r.Method, r.URL.EscapedPath(), user, pass, body))
+		mu.Unlock()
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(deleteStatus)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	m := rabbitMQManagement{URL: srv.URL + "/", User: "guest", Password: "pw"}
+	ctx := context.Background()
+	if err := m.createVHost(ctx, "tenant_pr123"); err != nil {
+		t.Fatal(err)
+	}
+	// A vhost that is already gone is fine to drop
+	if err := m.deleteVHost(ctx, "tenant_pr123"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"PUT /api/vhosts/tenant_pr123 guest:pw ",
+		`PUT /api/permissions/tenant_pr123/guest guest:pw {"configure":".*","write":".*","read":".*"}`,
+		"DELETE /api/vhosts/tenant_pr123 guest:pw ",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Fatalf("got  %q\nwant %q", requests, want)
+	}
+
+	deleteStatus = http.StatusInternalServerError
+	if err := m.deleteVHost(ctx, "tenant_pr123"); err == nil {
+		t.Fatal("expected a failed delete to be reported")
+	}
+}
+
+// fakeRedis answers the few commands deleteKeys sends and records the keys it unlinks
+type fakeRedis struct {
+	keys []string
+
+	mu       sync.Mutex
+	matches  []string
+	unlinked []string
+}
+
+func (f *fakeRedis) serve(t *testing.T) redis.RedisConfig {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go f.handle(conn)
+		}
+	}()
+	addr := l.Addr().(*net.TCPAddr)
+	return redis.RedisConfig{RedisHost: addr.IP.String(), RedisPort: addr.Port}
+}
+
+func (f *fakeRedis) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		var reply string
+		switch strings.ToLower(args[0]) {
+		case "hello":
+			reply = "-ERR unknown command 'HELLO'\r\n"
+		case "scan":
+			f.mu.Lock()
+			for i := 2; i+1 < len(args); i++ {
+				if strings.EqualFold(args[i], "match") {
+					f.matches = append(f.matches, args[i+1])
+				}
+			}
+			f.mu.Unlock()
+			reply = "*2\r\n$1\r\n0\r\n" + bulkArray(f.keys)
+		case "unlink":
+			f.mu.Lock()
+			f.unlinked = append(f.unlinked, args[1:]...)
+			f.mu.Unlock()
+			reply = ":" + strconv.Itoa(len(args)-1) + "\r\n"
+		default:
+			reply = "+OK\r\n"
+		}
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err := r.ReadString('\n'); err != nil { // $len
+			return nil, err
+		}
+		arg, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(arg, "\r\n")
+	}
+	return args, nil
+}
+
+func bulkArray(items []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(items))
+	for _, item := range items {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(item), item)
+	}
+	return b.String()
+}
+
+func TestDeleteKeys_UnlinksPrefixedKeys(t *testing.T) {
+	fake := &fakeRedis{keys: []string{"tenant:pr123:session:a", "tenant:pr123:intent:status:b"}}
+	cfg := fake.serve(t)
+
+	if err := deleteKeys(context.Background(), cfg, "tenant:pr123:"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The tool's client has no tenant: it matches and deletes the full prefixed names
+	if !reflect.DeepEqual(fake.matches, []string{"tenant:pr123:*"}) {
+		t.Fatalf("scanned %q", fake.matches)
+	}
+	if !reflect.DeepEqual(fake.unlinked, fake.keys) {
+		t.Fatalf("unlinked %q, want %q", fake.unlinked, fake.keys)
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	if got := splitList(" indexer, ,nft_marketplace,"); !reflect.DeepEqual(got, []string{"indexer", "nft_marketplace"}) {
+		t.Fatalf("got %q", got)
+	}
+}